@@ -0,0 +1,180 @@
+package countries
+
+import (
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// scriptRegions maps language and script subtags, in lowercase, to the alpha-2 code of the country where the
+// language is most likely written in that script when it differs from the country of the bare language
+// (likely subtags of Unicode CLDR)
+var scriptRegions = map[string]string{ //nolint:gochecknoglobals // fixed lookup table
+	"az-arab": "IR",
+	"pa-arab": "PK",
+	"uz-arab": "AF",
+	"zh-hant": "TW",
+}
+
+// languageRange is a single language range of an Accept-Language header with its weight
+type languageRange struct {
+	tag    string
+	weight float64
+}
+
+// GetByAcceptLanguage ranks the candidate countries of an HTTP Accept-Language header.
+//
+// This function performs the following steps:
+// - Parses the language ranges of the header and their q-weights (RFC 9110), most preferred first
+// - Skips wildcards, ranges with a zero weight and ranges with a malformed weight
+// - Resolves every range with a region subtag ("pt-BR") to its country, using the most populous
+// country speaking the language for UN M.49 areas ("es-419")
+// - Resolves every bare language range ("de") to the most populous country where it is the main
+// language, falling back to the most populous country where it is official, then spoken
+// - Resolves a range with an unknown region ("en-ZZ") like its bare language, and a script without region
+// to the country where the language is written in that script ("zh-Hant" to TW)
+// - Lists the countries of explicit regions first, then those of bare languages, without duplicates
+//
+// Parameters:
+// - header: raw value of the Accept-Language header (e.g., "fr-CH, fr;q=0.9, en;q=0.8, *;q=0.5")
+//
+// Returns:
+// - CountryList of candidate countries, most likely first, or nil when no range resolves to a country
+//
+// Side Effects:
+// - None
+//
+// Notes:
+// - Ranges with the same weight keep the order of the header
+// - Language ranges are matched case-insensitively with ISO 639-1 or ISO 639-3 language codes
+// - The Country pointers reference global data but the returned slice is a copy
+func GetByAcceptLanguage(header string) CountryList {
+	var regional, fallback CountryList
+
+	for _, r := range parseAcceptLanguage(header) {
+		subtags := strings.Split(r.tag, "-")
+		if region := regionSubtag(subtags); region != "" {
+			if c := regionalCountry(region, subtags[0]); c != nil {
+				regional = appendCountry(regional, c)
+				continue
+			}
+		}
+		if c := byAlpha2[scriptRegions[strings.ToLower(subtags[0])+"-"+scriptSubtag(subtags)]]; c != nil {
+			fallback = appendCountry(fallback, c)
+			continue
+		}
+		if l := GetLanguage(subtags[0]); l != nil {
+			fallback = appendCountry(fallback, mostPopulousSpeaking(countriesByLanguage[l.code()], l.Alpha3))
+		}
+	}
+
+	for _, c := range fallback {
+		regional = appendCountry(regional, c)
+	}
+	return regional
+}
+
+// parseAcceptLanguage returns the language ranges of an Accept-Language header sorted by decreasing weight
+func parseAcceptLanguage(header string) []languageRange {
+	var ranges []languageRange
+
+	for _, part := range strings.Split(header, ",") {
+		tag, params, _ := strings.Cut(part, ";")
+		tag = strings.TrimSpace(tag)
+		if tag == "" || tag == "*" {
+			continue
+		}
+
+		weight, ok := parseWeight(params)
+		if !ok || weight == 0 {
+			continue
+		}
+		ranges = append(ranges, languageRange{tag: tag, weight: weight})
+	}
+
+	sort.SliceStable(ranges, func(i, j int) bool {
+		return ranges[i].weight > ranges[j].weight
+	})
+
+	return ranges
+}
+
+// parseWeight reads the q parameter of a language range, defaulting to 1 when it is missing
+func parseWeight(params string) (float64, bool) {
+	for _, param := range strings.Split(params, ";") {
+		name, value, _ := strings.Cut(param, "=")
+		if !strings.EqualFold(strings.TrimSpace(name), "q") {
+			continue
+		}
+
+		weight, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		if err != nil || weight < 0 || weight > 1 {
+			return 0, false
+		}
+		return weight, true
+	}
+	return 1, true
+}
+
+// regionalCountry resolves a region subtag to a single country
+//
+// An M.49 area resolves to its most populous country speaking the language (see mostPopulousSpeaking),
+// or to its most populous country when none does.
+func regionalCountry(region, language string) *Country {
+	list := GetByLanguageTag("und-" + region)
+	if len(list) == 1 {
+		return list[0]
+	}
+
+	if c := mostPopulousSpeaking(list, language); c != nil {
+		return c
+	}
+	return mostPopulous(list, func(*Country) bool { return true })
+}
+
+// mostPopulousSpeaking returns the most populous country of the list where the language is the main
+// language, falling back to the countries where it is official, then to the countries where it is spoken
+func mostPopulousSpeaking(list CountryList, language string) *Country {
+	l := GetLanguage(language)
+	if l == nil {
+		return nil
+	}
+
+	code := l.code()
+	official := CountryLanguage{Code: code, Official: true}
+	for _, keep := range []func(*Country) bool{
+		func(c *Country) bool { return len(c.Languages) > 0 && c.Languages[0].Code == code },
+		func(c *Country) bool { return slices.Contains(c.Languages, official) },
+		func(c *Country) bool { return c.speaks(code) },
+	} {
+		if c := mostPopulous(list, keep); c != nil {
+			return c
+		}
+	}
+	return nil
+}
+
+// mostPopulous returns the most populous country of the list that is kept by the filter, or nil when none is
+func mostPopulous(list CountryList, keep func(*Country) bool) *Country {
+	var best *Country
+	for _, c := range list {
+		if keep(c) && (best == nil || c.Population > best.Population) {
+			best = c
+		}
+	}
+	return best
+}
+
+// speaks reports whether the language code is listed in the languages of the Country
+func (c *Country) speaks(code string) bool {
+	return slices.ContainsFunc(c.Languages, func(cl CountryLanguage) bool { return cl.Code == code })
+}
+
+// appendCountry appends the country to the list unless it is nil or already listed
+func appendCountry(list CountryList, c *Country) CountryList {
+	if c == nil || slices.Contains(list, c) {
+		return list
+	}
+	return append(list, c)
+}
@@ -0,0 +1,80 @@
+package countries
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testAcceptLanguage = "fr-CH, fr;q=0.9, en;q=0.8, de;q=0.7, *;q=0.5"
+
+// TestGetByAcceptLanguage tests GetByAcceptLanguage with different headers
+func TestGetByAcceptLanguage(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected []string
+	}{
+		{name: "Typical browser header", input: testAcceptLanguage, expected: []string{"CH", "FR", testCountryAlpha2, "DE"}},
+		{name: "Explicit regions first", input: "da, en-GB;q=0.8, en;q=0.7", expected: []string{"GB", "DK", testCountryAlpha2}},
+		{name: "Weights reorder ranges", input: "de;q=0.5, ja", expected: []string{"JP", "DE"}},
+		{name: "Equal weights keep order", input: "pt-PT;q=0.8, pt-BR;q=0.8", expected: []string{"PT", "BR"}},
+		{name: "Most populous country", input: "es", expected: []string{"MX"}},
+		{name: "Main language wins", input: "en", expected: []string{testCountryAlpha2}},
+		{name: "Official language", input: "sw", expected: []string{"CD"}},
+		{name: "Without ISO 639-1 code", input: "fil", expected: []string{"PH"}},
+		{name: "ISO 639-3 code", input: "por", expected: []string{"BR"}},
+		{name: "M.49 area", input: "es-419", expected: []string{"MX"}},
+		{name: "M.49 area main language", input: "en-150", expected: []string{"GB"}},
+		{name: "Simplified script without region", input: "zh-Hans", expected: []string{"CN"}},
+		{name: "Traditional script without region", input: "zh-Hant", expected: []string{"TW"}},
+		{name: "Script with region", input: "zh-Hant-HK", expected: []string{"HK"}},
+		{name: "Script in any case", input: "ZH-HANT", expected: []string{"TW"}},
+		{name: "Duplicates", input: "en-US, en;q=0.9", expected: []string{testCountryAlpha2}},
+		{name: "Zero weight", input: "en-US;q=0, fr", expected: []string{"FR"}},
+		{name: "Malformed weight", input: "en;q=abc, fr;q=1.5, it;q=0.2", expected: []string{"IT"}},
+		{name: "Spaces and case", input: "  EN-gb ; Q=0.9 ,DE", expected: []string{"GB", "DE"}},
+		{name: "Unknown language", input: "xx, tlh;q=0.9, nl;q=0.1", expected: []string{"NL"}},
+		{name: "Unknown region", input: "en-ZZ", expected: []string{testCountryAlpha2}},
+		{name: "Unknown region after a known one", input: "fr-ZZ, de-AT;q=0.9", expected: []string{"AT", "FR"}},
+		{name: "Unknown region and language", input: "xx-ZZ", expected: nil},
+		{name: "Wildcard only", input: "*", expected: nil},
+		{name: "Empty", input: "", expected: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			list := GetByAcceptLanguage(tt.input)
+			if tt.expected == nil {
+				require.Nil(t, list)
+				return
+			}
+
+			alpha2 := make([]string, 0, len(list))
+			for _, c := range list {
+				alpha2 = append(alpha2, c.Alpha2)
+			}
+			assert.Equal(t, tt.expected, alpha2)
+		})
+	}
+}
+
+// ExampleGetByAcceptLanguage is an example of GetByAcceptLanguage()
+func ExampleGetByAcceptLanguage() {
+	for _, c := range GetByAcceptLanguage(testAcceptLanguage) {
+		fmt.Println(c.Alpha2)
+	}
+	// Output:CH
+	// FR
+	// US
+	// DE
+}
+
+// BenchmarkGetByAcceptLanguage benchmarks the method GetByAcceptLanguage()
+func BenchmarkGetByAcceptLanguage(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_ = GetByAcceptLanguage(testAcceptLanguage)
+	}
+}
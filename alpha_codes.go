@@ -1,511 +1,765 @@
 package countries
 
-// This file provides predefined string constants for every alpha-2 and
-// alpha-3 code. Using these constants prevents typos and improves IDE
-// autocompletion when referencing specific countries.
+// This file provides predefined typed constants for every alpha-2, alpha-3
+// and numeric code. Using these constants prevents typos, improves IDE
+// autocompletion when referencing specific countries, and lets the compiler
+// reject an alpha-3 code where an alpha-2 code is expected.
 
 // Predefined ISO 3166-1 alpha-2 codes.
 const (
-	Alpha2AF = "AF"
-	Alpha2AX = "AX"
-	Alpha2AL = "AL"
-	Alpha2DZ = "DZ"
-	Alpha2AS = "AS"
-	Alpha2AD = "AD"
-	Alpha2AO = "AO"
-	Alpha2AI = "AI"
-	Alpha2AQ = "AQ"
-	Alpha2AG = "AG"
-	Alpha2AR = "AR"
-	Alpha2AM = "AM"
-	Alpha2AW = "AW"
-	Alpha2AU = "AU"
-	Alpha2AT = "AT"
-	Alpha2AZ = "AZ"
-	Alpha2BS = "BS"
-	Alpha2BH = "BH"
-	Alpha2BD = "BD"
-	Alpha2BB = "BB"
-	Alpha2BY = "BY"
-	Alpha2BE = "BE"
-	Alpha2BZ = "BZ"
-	Alpha2BJ = "BJ"
-	Alpha2BM = "BM"
-	Alpha2BT = "BT"
-	Alpha2BO = "BO"
-	Alpha2BQ = "BQ"
-	Alpha2BA = "BA"
-	Alpha2BW = "BW"
-	Alpha2BV = "BV"
-	Alpha2BR = "BR"
-	Alpha2IO = "IO"
-	Alpha2BN = "BN"
-	Alpha2BG = "BG"
-	Alpha2BF = "BF"
-	Alpha2BI = "BI"
-	Alpha2CV = "CV"
-	Alpha2KH = "KH"
-	Alpha2CM = "CM"
-	Alpha2CA = "CA"
-	Alpha2KY = "KY"
-	Alpha2CF = "CF"
-	Alpha2TD = "TD"
-	Alpha2CL = "CL"
-	Alpha2CN = "CN"
-	Alpha2CX = "CX"
-	Alpha2CC = "CC"
-	Alpha2CO = "CO"
-	Alpha2KM = "KM"
-	Alpha2CG = "CG"
-	Alpha2CD = "CD"
-	Alpha2CK = "CK"
-	Alpha2CR = "CR"
-	Alpha2CI = "CI"
-	Alpha2HR = "HR"
-	Alpha2CU = "CU"
-	Alpha2CW = "CW"
-	Alpha2CY = "CY"
-	Alpha2CZ = "CZ"
-	Alpha2DK = "DK"
-	Alpha2DJ = "DJ"
-	Alpha2DM = "DM"
-	Alpha2DO = "DO"
-	Alpha2EC = "EC"
-	Alpha2EG = "EG"
-	Alpha2SV = "SV"
-	Alpha2GQ = "GQ"
-	Alpha2ER = "ER"
-	Alpha2EE = "EE"
-	Alpha2SZ = "SZ"
-	Alpha2ET = "ET"
-	Alpha2FK = "FK"
-	Alpha2FO = "FO"
-	Alpha2FJ = "FJ"
-	Alpha2FI = "FI"
-	Alpha2FR = "FR"
-	Alpha2GF = "GF"
-	Alpha2PF = "PF"
-	Alpha2TF = "TF"
-	Alpha2GA = "GA"
-	Alpha2GM = "GM"
-	Alpha2GE = "GE"
-	Alpha2DE = "DE"
-	Alpha2GH = "GH"
-	Alpha2GI = "GI"
-	Alpha2GR = "GR"
-	Alpha2GL = "GL"
-	Alpha2GD = "GD"
-	Alpha2GP = "GP"
-	Alpha2GU = "GU"
-	Alpha2GT = "GT"
-	Alpha2GG = "GG"
-	Alpha2GN = "GN"
-	Alpha2GW = "GW"
-	Alpha2GY = "GY"
-	Alpha2HT = "HT"
-	Alpha2HM = "HM"
-	Alpha2VA = "VA"
-	Alpha2HN = "HN"
-	Alpha2HK = "HK"
-	Alpha2HU = "HU"
-	Alpha2IS = "IS"
-	Alpha2IN = "IN"
-	Alpha2ID = "ID"
-	Alpha2IR = "IR"
-	Alpha2IQ = "IQ"
-	Alpha2IE = "IE"
-	Alpha2IM = "IM"
-	Alpha2IL = "IL"
-	Alpha2IT = "IT"
-	Alpha2JM = "JM"
-	Alpha2JP = "JP"
-	Alpha2JE = "JE"
-	Alpha2JO = "JO"
-	Alpha2KZ = "KZ"
-	Alpha2KE = "KE"
-	Alpha2KI = "KI"
-	Alpha2KP = "KP"
-	Alpha2KR = "KR"
-	Alpha2KW = "KW"
-	Alpha2KG = "KG"
-	Alpha2LA = "LA"
-	Alpha2LV = "LV"
-	Alpha2LB = "LB"
-	Alpha2LS = "LS"
-	Alpha2LR = "LR"
-	Alpha2LY = "LY"
-	Alpha2LI = "LI"
-	Alpha2LT = "LT"
-	Alpha2LU = "LU"
-	Alpha2MO = "MO"
-	Alpha2MG = "MG"
-	Alpha2MW = "MW"
-	Alpha2MY = "MY"
-	Alpha2MV = "MV"
-	Alpha2ML = "ML"
-	Alpha2MT = "MT"
-	Alpha2MH = "MH"
-	Alpha2MQ = "MQ"
-	Alpha2MR = "MR"
-	Alpha2MU = "MU"
-	Alpha2YT = "YT"
-	Alpha2MX = "MX"
-	Alpha2FM = "FM"
-	Alpha2MD = "MD"
-	Alpha2MC = "MC"
-	Alpha2MN = "MN"
-	Alpha2ME = "ME"
-	Alpha2MS = "MS"
-	Alpha2MA = "MA"
-	Alpha2MZ = "MZ"
-	Alpha2MM = "MM"
-	Alpha2NA = "NA"
-	Alpha2NR = "NR"
-	Alpha2NP = "NP"
-	Alpha2NL = "NL"
-	Alpha2NC = "NC"
-	Alpha2NZ = "NZ"
-	Alpha2NI = "NI"
-	Alpha2NE = "NE"
-	Alpha2NG = "NG"
-	Alpha2NU = "NU"
-	Alpha2NF = "NF"
-	Alpha2MK = "MK"
-	Alpha2MP = "MP"
-	Alpha2NO = "NO"
-	Alpha2OM = "OM"
-	Alpha2PK = "PK"
-	Alpha2PW = "PW"
-	Alpha2PS = "PS"
-	Alpha2PA = "PA"
-	Alpha2PG = "PG"
-	Alpha2PY = "PY"
-	Alpha2PE = "PE"
-	Alpha2PH = "PH"
-	Alpha2PN = "PN"
-	Alpha2PL = "PL"
-	Alpha2PT = "PT"
-	Alpha2PR = "PR"
-	Alpha2QA = "QA"
-	Alpha2RE = "RE"
-	Alpha2RO = "RO"
-	Alpha2RU = "RU"
-	Alpha2RW = "RW"
-	Alpha2BL = "BL"
-	Alpha2SH = "SH"
-	Alpha2KN = "KN"
-	Alpha2LC = "LC"
-	Alpha2MF = "MF"
-	Alpha2PM = "PM"
-	Alpha2VC = "VC"
-	Alpha2WS = "WS"
-	Alpha2SM = "SM"
-	Alpha2ST = "ST"
-	Alpha2SA = "SA"
-	Alpha2SN = "SN"
-	Alpha2RS = "RS"
-	Alpha2SC = "SC"
-	Alpha2SL = "SL"
-	Alpha2SG = "SG"
-	Alpha2SX = "SX"
-	Alpha2SK = "SK"
-	Alpha2SI = "SI"
-	Alpha2SB = "SB"
-	Alpha2SO = "SO"
-	Alpha2ZA = "ZA"
-	Alpha2GS = "GS"
-	Alpha2SS = "SS"
-	Alpha2ES = "ES"
-	Alpha2LK = "LK"
-	Alpha2SD = "SD"
-	Alpha2SR = "SR"
-	Alpha2SJ = "SJ"
-	Alpha2SE = "SE"
-	Alpha2CH = "CH"
-	Alpha2SY = "SY"
-	Alpha2TW = "TW"
-	Alpha2TJ = "TJ"
-	Alpha2TZ = "TZ"
-	Alpha2TH = "TH"
-	Alpha2TL = "TL"
-	Alpha2TG = "TG"
-	Alpha2TK = "TK"
-	Alpha2TO = "TO"
-	Alpha2TT = "TT"
-	Alpha2TN = "TN"
-	Alpha2TR = "TR"
-	Alpha2TM = "TM"
-	Alpha2TC = "TC"
-	Alpha2TV = "TV"
-	Alpha2UG = "UG"
-	Alpha2UA = "UA"
-	Alpha2AE = "AE"
-	Alpha2GB = "GB"
-	Alpha2US = "US"
-	Alpha2UM = "UM"
-	Alpha2UY = "UY"
-	Alpha2UZ = "UZ"
-	Alpha2VU = "VU"
-	Alpha2VE = "VE"
-	Alpha2VN = "VN"
-	Alpha2VG = "VG"
-	Alpha2VI = "VI"
-	Alpha2WF = "WF"
-	Alpha2EH = "EH"
-	Alpha2YE = "YE"
-	Alpha2ZM = "ZM"
-	Alpha2ZW = "ZW"
+	Alpha2AF Alpha2 = "AF"
+	Alpha2AX Alpha2 = "AX"
+	Alpha2AL Alpha2 = "AL"
+	Alpha2DZ Alpha2 = "DZ"
+	Alpha2AS Alpha2 = "AS"
+	Alpha2AD Alpha2 = "AD"
+	Alpha2AO Alpha2 = "AO"
+	Alpha2AI Alpha2 = "AI"
+	Alpha2AQ Alpha2 = "AQ"
+	Alpha2AG Alpha2 = "AG"
+	Alpha2AR Alpha2 = "AR"
+	Alpha2AM Alpha2 = "AM"
+	Alpha2AW Alpha2 = "AW"
+	Alpha2AU Alpha2 = "AU"
+	Alpha2AT Alpha2 = "AT"
+	Alpha2AZ Alpha2 = "AZ"
+	Alpha2BS Alpha2 = "BS"
+	Alpha2BH Alpha2 = "BH"
+	Alpha2BD Alpha2 = "BD"
+	Alpha2BB Alpha2 = "BB"
+	Alpha2BY Alpha2 = "BY"
+	Alpha2BE Alpha2 = "BE"
+	Alpha2BZ Alpha2 = "BZ"
+	Alpha2BJ Alpha2 = "BJ"
+	Alpha2BM Alpha2 = "BM"
+	Alpha2BT Alpha2 = "BT"
+	Alpha2BO Alpha2 = "BO"
+	Alpha2BQ Alpha2 = "BQ"
+	Alpha2BA Alpha2 = "BA"
+	Alpha2BW Alpha2 = "BW"
+	Alpha2BV Alpha2 = "BV"
+	Alpha2BR Alpha2 = "BR"
+	Alpha2IO Alpha2 = "IO"
+	Alpha2BN Alpha2 = "BN"
+	Alpha2BG Alpha2 = "BG"
+	Alpha2BF Alpha2 = "BF"
+	Alpha2BI Alpha2 = "BI"
+	Alpha2CV Alpha2 = "CV"
+	Alpha2KH Alpha2 = "KH"
+	Alpha2CM Alpha2 = "CM"
+	Alpha2CA Alpha2 = "CA"
+	Alpha2KY Alpha2 = "KY"
+	Alpha2CF Alpha2 = "CF"
+	Alpha2TD Alpha2 = "TD"
+	Alpha2CL Alpha2 = "CL"
+	Alpha2CN Alpha2 = "CN"
+	Alpha2CX Alpha2 = "CX"
+	Alpha2CC Alpha2 = "CC"
+	Alpha2CO Alpha2 = "CO"
+	Alpha2KM Alpha2 = "KM"
+	Alpha2CG Alpha2 = "CG"
+	Alpha2CD Alpha2 = "CD"
+	Alpha2CK Alpha2 = "CK"
+	Alpha2CR Alpha2 = "CR"
+	Alpha2CI Alpha2 = "CI"
+	Alpha2HR Alpha2 = "HR"
+	Alpha2CU Alpha2 = "CU"
+	Alpha2CW Alpha2 = "CW"
+	Alpha2CY Alpha2 = "CY"
+	Alpha2CZ Alpha2 = "CZ"
+	Alpha2DK Alpha2 = "DK"
+	Alpha2DJ Alpha2 = "DJ"
+	Alpha2DM Alpha2 = "DM"
+	Alpha2DO Alpha2 = "DO"
+	Alpha2EC Alpha2 = "EC"
+	Alpha2EG Alpha2 = "EG"
+	Alpha2SV Alpha2 = "SV"
+	Alpha2GQ Alpha2 = "GQ"
+	Alpha2ER Alpha2 = "ER"
+	Alpha2EE Alpha2 = "EE"
+	Alpha2SZ Alpha2 = "SZ"
+	Alpha2ET Alpha2 = "ET"
+	Alpha2FK Alpha2 = "FK"
+	Alpha2FO Alpha2 = "FO"
+	Alpha2FJ Alpha2 = "FJ"
+	Alpha2FI Alpha2 = "FI"
+	Alpha2FR Alpha2 = "FR"
+	Alpha2GF Alpha2 = "GF"
+	Alpha2PF Alpha2 = "PF"
+	Alpha2TF Alpha2 = "TF"
+	Alpha2GA Alpha2 = "GA"
+	Alpha2GM Alpha2 = "GM"
+	Alpha2GE Alpha2 = "GE"
+	Alpha2DE Alpha2 = "DE"
+	Alpha2GH Alpha2 = "GH"
+	Alpha2GI Alpha2 = "GI"
+	Alpha2GR Alpha2 = "GR"
+	Alpha2GL Alpha2 = "GL"
+	Alpha2GD Alpha2 = "GD"
+	Alpha2GP Alpha2 = "GP"
+	Alpha2GU Alpha2 = "GU"
+	Alpha2GT Alpha2 = "GT"
+	Alpha2GG Alpha2 = "GG"
+	Alpha2GN Alpha2 = "GN"
+	Alpha2GW Alpha2 = "GW"
+	Alpha2GY Alpha2 = "GY"
+	Alpha2HT Alpha2 = "HT"
+	Alpha2HM Alpha2 = "HM"
+	Alpha2VA Alpha2 = "VA"
+	Alpha2HN Alpha2 = "HN"
+	Alpha2HK Alpha2 = "HK"
+	Alpha2HU Alpha2 = "HU"
+	Alpha2IS Alpha2 = "IS"
+	Alpha2IN Alpha2 = "IN"
+	Alpha2ID Alpha2 = "ID"
+	Alpha2IR Alpha2 = "IR"
+	Alpha2IQ Alpha2 = "IQ"
+	Alpha2IE Alpha2 = "IE"
+	Alpha2IM Alpha2 = "IM"
+	Alpha2IL Alpha2 = "IL"
+	Alpha2IT Alpha2 = "IT"
+	Alpha2JM Alpha2 = "JM"
+	Alpha2JP Alpha2 = "JP"
+	Alpha2JE Alpha2 = "JE"
+	Alpha2JO Alpha2 = "JO"
+	Alpha2KZ Alpha2 = "KZ"
+	Alpha2KE Alpha2 = "KE"
+	Alpha2KI Alpha2 = "KI"
+	Alpha2KP Alpha2 = "KP"
+	Alpha2KR Alpha2 = "KR"
+	Alpha2KW Alpha2 = "KW"
+	Alpha2KG Alpha2 = "KG"
+	Alpha2LA Alpha2 = "LA"
+	Alpha2LV Alpha2 = "LV"
+	Alpha2LB Alpha2 = "LB"
+	Alpha2LS Alpha2 = "LS"
+	Alpha2LR Alpha2 = "LR"
+	Alpha2LY Alpha2 = "LY"
+	Alpha2LI Alpha2 = "LI"
+	Alpha2LT Alpha2 = "LT"
+	Alpha2LU Alpha2 = "LU"
+	Alpha2MO Alpha2 = "MO"
+	Alpha2MG Alpha2 = "MG"
+	Alpha2MW Alpha2 = "MW"
+	Alpha2MY Alpha2 = "MY"
+	Alpha2MV Alpha2 = "MV"
+	Alpha2ML Alpha2 = "ML"
+	Alpha2MT Alpha2 = "MT"
+	Alpha2MH Alpha2 = "MH"
+	Alpha2MQ Alpha2 = "MQ"
+	Alpha2MR Alpha2 = "MR"
+	Alpha2MU Alpha2 = "MU"
+	Alpha2YT Alpha2 = "YT"
+	Alpha2MX Alpha2 = "MX"
+	Alpha2FM Alpha2 = "FM"
+	Alpha2MD Alpha2 = "MD"
+	Alpha2MC Alpha2 = "MC"
+	Alpha2MN Alpha2 = "MN"
+	Alpha2ME Alpha2 = "ME"
+	Alpha2MS Alpha2 = "MS"
+	Alpha2MA Alpha2 = "MA"
+	Alpha2MZ Alpha2 = "MZ"
+	Alpha2MM Alpha2 = "MM"
+	Alpha2NA Alpha2 = "NA"
+	Alpha2NR Alpha2 = "NR"
+	Alpha2NP Alpha2 = "NP"
+	Alpha2NL Alpha2 = "NL"
+	Alpha2NC Alpha2 = "NC"
+	Alpha2NZ Alpha2 = "NZ"
+	Alpha2NI Alpha2 = "NI"
+	Alpha2NE Alpha2 = "NE"
+	Alpha2NG Alpha2 = "NG"
+	Alpha2NU Alpha2 = "NU"
+	Alpha2NF Alpha2 = "NF"
+	Alpha2MK Alpha2 = "MK"
+	Alpha2MP Alpha2 = "MP"
+	Alpha2NO Alpha2 = "NO"
+	Alpha2OM Alpha2 = "OM"
+	Alpha2PK Alpha2 = "PK"
+	Alpha2PW Alpha2 = "PW"
+	Alpha2PS Alpha2 = "PS"
+	Alpha2PA Alpha2 = "PA"
+	Alpha2PG Alpha2 = "PG"
+	Alpha2PY Alpha2 = "PY"
+	Alpha2PE Alpha2 = "PE"
+	Alpha2PH Alpha2 = "PH"
+	Alpha2PN Alpha2 = "PN"
+	Alpha2PL Alpha2 = "PL"
+	Alpha2PT Alpha2 = "PT"
+	Alpha2PR Alpha2 = "PR"
+	Alpha2QA Alpha2 = "QA"
+	Alpha2RE Alpha2 = "RE"
+	Alpha2RO Alpha2 = "RO"
+	Alpha2RU Alpha2 = "RU"
+	Alpha2RW Alpha2 = "RW"
+	Alpha2BL Alpha2 = "BL"
+	Alpha2SH Alpha2 = "SH"
+	Alpha2KN Alpha2 = "KN"
+	Alpha2LC Alpha2 = "LC"
+	Alpha2MF Alpha2 = "MF"
+	Alpha2PM Alpha2 = "PM"
+	Alpha2VC Alpha2 = "VC"
+	Alpha2WS Alpha2 = "WS"
+	Alpha2SM Alpha2 = "SM"
+	Alpha2ST Alpha2 = "ST"
+	Alpha2SA Alpha2 = "SA"
+	Alpha2SN Alpha2 = "SN"
+	Alpha2RS Alpha2 = "RS"
+	Alpha2SC Alpha2 = "SC"
+	Alpha2SL Alpha2 = "SL"
+	Alpha2SG Alpha2 = "SG"
+	Alpha2SX Alpha2 = "SX"
+	Alpha2SK Alpha2 = "SK"
+	Alpha2SI Alpha2 = "SI"
+	Alpha2SB Alpha2 = "SB"
+	Alpha2SO Alpha2 = "SO"
+	Alpha2ZA Alpha2 = "ZA"
+	Alpha2GS Alpha2 = "GS"
+	Alpha2SS Alpha2 = "SS"
+	Alpha2ES Alpha2 = "ES"
+	Alpha2LK Alpha2 = "LK"
+	Alpha2SD Alpha2 = "SD"
+	Alpha2SR Alpha2 = "SR"
+	Alpha2SJ Alpha2 = "SJ"
+	Alpha2SE Alpha2 = "SE"
+	Alpha2CH Alpha2 = "CH"
+	Alpha2SY Alpha2 = "SY"
+	Alpha2TW Alpha2 = "TW"
+	Alpha2TJ Alpha2 = "TJ"
+	Alpha2TZ Alpha2 = "TZ"
+	Alpha2TH Alpha2 = "TH"
+	Alpha2TL Alpha2 = "TL"
+	Alpha2TG Alpha2 = "TG"
+	Alpha2TK Alpha2 = "TK"
+	Alpha2TO Alpha2 = "TO"
+	Alpha2TT Alpha2 = "TT"
+	Alpha2TN Alpha2 = "TN"
+	Alpha2TR Alpha2 = "TR"
+	Alpha2TM Alpha2 = "TM"
+	Alpha2TC Alpha2 = "TC"
+	Alpha2TV Alpha2 = "TV"
+	Alpha2UG Alpha2 = "UG"
+	Alpha2UA Alpha2 = "UA"
+	Alpha2AE Alpha2 = "AE"
+	Alpha2GB Alpha2 = "GB"
+	Alpha2US Alpha2 = "US"
+	Alpha2UM Alpha2 = "UM"
+	Alpha2UY Alpha2 = "UY"
+	Alpha2UZ Alpha2 = "UZ"
+	Alpha2VU Alpha2 = "VU"
+	Alpha2VE Alpha2 = "VE"
+	Alpha2VN Alpha2 = "VN"
+	Alpha2VG Alpha2 = "VG"
+	Alpha2VI Alpha2 = "VI"
+	Alpha2WF Alpha2 = "WF"
+	Alpha2EH Alpha2 = "EH"
+	Alpha2YE Alpha2 = "YE"
+	Alpha2ZM Alpha2 = "ZM"
+	Alpha2ZW Alpha2 = "ZW"
 )
 
 // Predefined ISO 3166-1 alpha-3 codes.
 const (
-	Alpha3AFG = "AFG"
-	Alpha3ALA = "ALA"
-	Alpha3ALB = "ALB"
-	Alpha3DZA = "DZA"
-	Alpha3ASM = "ASM"
-	Alpha3AND = "AND"
-	Alpha3AGO = "AGO"
-	Alpha3AIA = "AIA"
-	Alpha3ATA = "ATA"
-	Alpha3ATG = "ATG"
-	Alpha3ARG = "ARG"
-	Alpha3ARM = "ARM"
-	Alpha3ABW = "ABW"
-	Alpha3AUS = "AUS"
-	Alpha3AUT = "AUT"
-	Alpha3AZE = "AZE"
-	Alpha3BHS = "BHS"
-	Alpha3BHR = "BHR"
-	Alpha3BGD = "BGD"
-	Alpha3BRB = "BRB"
-	Alpha3BLR = "BLR"
-	Alpha3BEL = "BEL"
-	Alpha3BLZ = "BLZ"
-	Alpha3BEN = "BEN"
-	Alpha3BMU = "BMU"
-	Alpha3BTN = "BTN"
-	Alpha3BOL = "BOL"
-	Alpha3BES = "BES"
-	Alpha3BIH = "BIH"
-	Alpha3BWA = "BWA"
-	Alpha3BVT = "BVT"
-	Alpha3BRA = "BRA"
-	Alpha3IOT = "IOT"
-	Alpha3BRN = "BRN"
-	Alpha3BGR = "BGR"
-	Alpha3BFA = "BFA"
-	Alpha3BDI = "BDI"
-	Alpha3CPV = "CPV"
-	Alpha3KHM = "KHM"
-	Alpha3CMR = "CMR"
-	Alpha3CAN = "CAN"
-	Alpha3CYM = "CYM"
-	Alpha3CAF = "CAF"
-	Alpha3TCD = "TCD"
-	Alpha3CHL = "CHL"
-	Alpha3CHN = "CHN"
-	Alpha3CXR = "CXR"
-	Alpha3CCK = "CCK"
-	Alpha3COL = "COL"
-	Alpha3COM = "COM"
-	Alpha3COG = "COG"
-	Alpha3COD = "COD"
-	Alpha3COK = "COK"
-	Alpha3CRI = "CRI"
-	Alpha3CIV = "CIV"
-	Alpha3HRV = "HRV"
-	Alpha3CUB = "CUB"
-	Alpha3CUW = "CUW"
-	Alpha3CYP = "CYP"
-	Alpha3CZE = "CZE"
-	Alpha3DNK = "DNK"
-	Alpha3DJI = "DJI"
-	Alpha3DMA = "DMA"
-	Alpha3DOM = "DOM"
-	Alpha3ECU = "ECU"
-	Alpha3EGY = "EGY"
-	Alpha3SLV = "SLV"
-	Alpha3GNQ = "GNQ"
-	Alpha3ERI = "ERI"
-	Alpha3EST = "EST"
-	Alpha3SWZ = "SWZ"
-	Alpha3ETH = "ETH"
-	Alpha3FLK = "FLK"
-	Alpha3FRO = "FRO"
-	Alpha3FJI = "FJI"
-	Alpha3FIN = "FIN"
-	Alpha3FRA = "FRA"
-	Alpha3GUF = "GUF"
-	Alpha3PYF = "PYF"
-	Alpha3ATF = "ATF"
-	Alpha3GAB = "GAB"
-	Alpha3GMB = "GMB"
-	Alpha3GEO = "GEO"
-	Alpha3DEU = "DEU"
-	Alpha3GHA = "GHA"
-	Alpha3GIB = "GIB"
-	Alpha3GRC = "GRC"
-	Alpha3GRL = "GRL"
-	Alpha3GRD = "GRD"
-	Alpha3GLP = "GLP"
-	Alpha3GUM = "GUM"
-	Alpha3GTM = "GTM"
-	Alpha3GGY = "GGY"
-	Alpha3GIN = "GIN"
-	Alpha3GNB = "GNB"
-	Alpha3GUY = "GUY"
-	Alpha3HTI = "HTI"
-	Alpha3HMD = "HMD"
-	Alpha3VAT = "VAT"
-	Alpha3HND = "HND"
-	Alpha3HKG = "HKG"
-	Alpha3HUN = "HUN"
-	Alpha3ISL = "ISL"
-	Alpha3IND = "IND"
-	Alpha3IDN = "IDN"
-	Alpha3IRN = "IRN"
-	Alpha3IRQ = "IRQ"
-	Alpha3IRL = "IRL"
-	Alpha3IMN = "IMN"
-	Alpha3ISR = "ISR"
-	Alpha3ITA = "ITA"
-	Alpha3JAM = "JAM"
-	Alpha3JPN = "JPN"
-	Alpha3JEY = "JEY"
-	Alpha3JOR = "JOR"
-	Alpha3KAZ = "KAZ"
-	Alpha3KEN = "KEN"
-	Alpha3KIR = "KIR"
-	Alpha3PRK = "PRK"
-	Alpha3KOR = "KOR"
-	Alpha3KWT = "KWT"
-	Alpha3KGZ = "KGZ"
-	Alpha3LAO = "LAO"
-	Alpha3LVA = "LVA"
-	Alpha3LBN = "LBN"
-	Alpha3LSO = "LSO"
-	Alpha3LBR = "LBR"
-	Alpha3LBY = "LBY"
-	Alpha3LIE = "LIE"
-	Alpha3LTU = "LTU"
-	Alpha3LUX = "LUX"
-	Alpha3MAC = "MAC"
-	Alpha3MDG = "MDG"
-	Alpha3MWI = "MWI"
-	Alpha3MYS = "MYS"
-	Alpha3MDV = "MDV"
-	Alpha3MLI = "MLI"
-	Alpha3MLT = "MLT"
-	Alpha3MHL = "MHL"
-	Alpha3MTQ = "MTQ"
-	Alpha3MRT = "MRT"
-	Alpha3MUS = "MUS"
-	Alpha3MYT = "MYT"
-	Alpha3MEX = "MEX"
-	Alpha3FSM = "FSM"
-	Alpha3MDA = "MDA"
-	Alpha3MCO = "MCO"
-	Alpha3MNG = "MNG"
-	Alpha3MNE = "MNE"
-	Alpha3MSR = "MSR"
-	Alpha3MAR = "MAR"
-	Alpha3MOZ = "MOZ"
-	Alpha3MMR = "MMR"
-	Alpha3NAM = "NAM"
-	Alpha3NRU = "NRU"
-	Alpha3NPL = "NPL"
-	Alpha3NLD = "NLD"
-	Alpha3NCL = "NCL"
-	Alpha3NZL = "NZL"
-	Alpha3NIC = "NIC"
-	Alpha3NER = "NER"
-	Alpha3NGA = "NGA"
-	Alpha3NIU = "NIU"
-	Alpha3NFK = "NFK"
-	Alpha3MKD = "MKD"
-	Alpha3MNP = "MNP"
-	Alpha3NOR = "NOR"
-	Alpha3OMN = "OMN"
-	Alpha3PAK = "PAK"
-	Alpha3PLW = "PLW"
-	Alpha3PSE = "PSE"
-	Alpha3PAN = "PAN"
-	Alpha3PNG = "PNG"
-	Alpha3PRY = "PRY"
-	Alpha3PER = "PER"
-	Alpha3PHL = "PHL"
-	Alpha3PCN = "PCN"
-	Alpha3POL = "POL"
-	Alpha3PRT = "PRT"
-	Alpha3PRI = "PRI"
-	Alpha3QAT = "QAT"
-	Alpha3REU = "REU"
-	Alpha3ROU = "ROU"
-	Alpha3RUS = "RUS"
-	Alpha3RWA = "RWA"
-	Alpha3BLM = "BLM"
-	Alpha3SHN = "SHN"
-	Alpha3KNA = "KNA"
-	Alpha3LCA = "LCA"
-	Alpha3MAF = "MAF"
-	Alpha3SPM = "SPM"
-	Alpha3VCT = "VCT"
-	Alpha3WSM = "WSM"
-	Alpha3SMR = "SMR"
-	Alpha3STP = "STP"
-	Alpha3SAU = "SAU"
-	Alpha3SEN = "SEN"
-	Alpha3SRB = "SRB"
-	Alpha3SYC = "SYC"
-	Alpha3SLE = "SLE"
-	Alpha3SGP = "SGP"
-	Alpha3SXM = "SXM"
-	Alpha3SVK = "SVK"
-	Alpha3SVN = "SVN"
-	Alpha3SLB = "SLB"
-	Alpha3SOM = "SOM"
-	Alpha3ZAF = "ZAF"
-	Alpha3SGS = "SGS"
-	Alpha3SSD = "SSD"
-	Alpha3ESP = "ESP"
-	Alpha3LKA = "LKA"
-	Alpha3SDN = "SDN"
-	Alpha3SUR = "SUR"
-	Alpha3SJM = "SJM"
-	Alpha3SWE = "SWE"
-	Alpha3CHE = "CHE"
-	Alpha3SYR = "SYR"
-	Alpha3TWN = "TWN"
-	Alpha3TJK = "TJK"
-	Alpha3TZA = "TZA"
-	Alpha3THA = "THA"
-	Alpha3TLS = "TLS"
-	Alpha3TGO = "TGO"
-	Alpha3TKL = "TKL"
-	Alpha3TON = "TON"
-	Alpha3TTO = "TTO"
-	Alpha3TUN = "TUN"
-	Alpha3TUR = "TUR"
-	Alpha3TKM = "TKM"
-	Alpha3TCA = "TCA"
-	Alpha3TUV = "TUV"
-	Alpha3UGA = "UGA"
-	Alpha3UKR = "UKR"
-	Alpha3ARE = "ARE"
-	Alpha3GBR = "GBR"
-	Alpha3USA = "USA"
-	Alpha3UMI = "UMI"
-	Alpha3URY = "URY"
-	Alpha3UZB = "UZB"
-	Alpha3VUT = "VUT"
-	Alpha3VEN = "VEN"
-	Alpha3VNM = "VNM"
-	Alpha3VGB = "VGB"
-	Alpha3VIR = "VIR"
-	Alpha3WLF = "WLF"
-	Alpha3ESH = "ESH"
-	Alpha3YEM = "YEM"
-	Alpha3ZMB = "ZMB"
-	Alpha3ZWE = "ZWE"
+	Alpha3AFG Alpha3 = "AFG"
+	Alpha3ALA Alpha3 = "ALA"
+	Alpha3ALB Alpha3 = "ALB"
+	Alpha3DZA Alpha3 = "DZA"
+	Alpha3ASM Alpha3 = "ASM"
+	Alpha3AND Alpha3 = "AND"
+	Alpha3AGO Alpha3 = "AGO"
+	Alpha3AIA Alpha3 = "AIA"
+	Alpha3ATA Alpha3 = "ATA"
+	Alpha3ATG Alpha3 = "ATG"
+	Alpha3ARG Alpha3 = "ARG"
+	Alpha3ARM Alpha3 = "ARM"
+	Alpha3ABW Alpha3 = "ABW"
+	Alpha3AUS Alpha3 = "AUS"
+	Alpha3AUT Alpha3 = "AUT"
+	Alpha3AZE Alpha3 = "AZE"
+	Alpha3BHS Alpha3 = "BHS"
+	Alpha3BHR Alpha3 = "BHR"
+	Alpha3BGD Alpha3 = "BGD"
+	Alpha3BRB Alpha3 = "BRB"
+	Alpha3BLR Alpha3 = "BLR"
+	Alpha3BEL Alpha3 = "BEL"
+	Alpha3BLZ Alpha3 = "BLZ"
+	Alpha3BEN Alpha3 = "BEN"
+	Alpha3BMU Alpha3 = "BMU"
+	Alpha3BTN Alpha3 = "BTN"
+	Alpha3BOL Alpha3 = "BOL"
+	Alpha3BES Alpha3 = "BES"
+	Alpha3BIH Alpha3 = "BIH"
+	Alpha3BWA Alpha3 = "BWA"
+	Alpha3BVT Alpha3 = "BVT"
+	Alpha3BRA Alpha3 = "BRA"
+	Alpha3IOT Alpha3 = "IOT"
+	Alpha3BRN Alpha3 = "BRN"
+	Alpha3BGR Alpha3 = "BGR"
+	Alpha3BFA Alpha3 = "BFA"
+	Alpha3BDI Alpha3 = "BDI"
+	Alpha3CPV Alpha3 = "CPV"
+	Alpha3KHM Alpha3 = "KHM"
+	Alpha3CMR Alpha3 = "CMR"
+	Alpha3CAN Alpha3 = "CAN"
+	Alpha3CYM Alpha3 = "CYM"
+	Alpha3CAF Alpha3 = "CAF"
+	Alpha3TCD Alpha3 = "TCD"
+	Alpha3CHL Alpha3 = "CHL"
+	Alpha3CHN Alpha3 = "CHN"
+	Alpha3CXR Alpha3 = "CXR"
+	Alpha3CCK Alpha3 = "CCK"
+	Alpha3COL Alpha3 = "COL"
+	Alpha3COM Alpha3 = "COM"
+	Alpha3COG Alpha3 = "COG"
+	Alpha3COD Alpha3 = "COD"
+	Alpha3COK Alpha3 = "COK"
+	Alpha3CRI Alpha3 = "CRI"
+	Alpha3CIV Alpha3 = "CIV"
+	Alpha3HRV Alpha3 = "HRV"
+	Alpha3CUB Alpha3 = "CUB"
+	Alpha3CUW Alpha3 = "CUW"
+	Alpha3CYP Alpha3 = "CYP"
+	Alpha3CZE Alpha3 = "CZE"
+	Alpha3DNK Alpha3 = "DNK"
+	Alpha3DJI Alpha3 = "DJI"
+	Alpha3DMA Alpha3 = "DMA"
+	Alpha3DOM Alpha3 = "DOM"
+	Alpha3ECU Alpha3 = "ECU"
+	Alpha3EGY Alpha3 = "EGY"
+	Alpha3SLV Alpha3 = "SLV"
+	Alpha3GNQ Alpha3 = "GNQ"
+	Alpha3ERI Alpha3 = "ERI"
+	Alpha3EST Alpha3 = "EST"
+	Alpha3SWZ Alpha3 = "SWZ"
+	Alpha3ETH Alpha3 = "ETH"
+	Alpha3FLK Alpha3 = "FLK"
+	Alpha3FRO Alpha3 = "FRO"
+	Alpha3FJI Alpha3 = "FJI"
+	Alpha3FIN Alpha3 = "FIN"
+	Alpha3FRA Alpha3 = "FRA"
+	Alpha3GUF Alpha3 = "GUF"
+	Alpha3PYF Alpha3 = "PYF"
+	Alpha3ATF Alpha3 = "ATF"
+	Alpha3GAB Alpha3 = "GAB"
+	Alpha3GMB Alpha3 = "GMB"
+	Alpha3GEO Alpha3 = "GEO"
+	Alpha3DEU Alpha3 = "DEU"
+	Alpha3GHA Alpha3 = "GHA"
+	Alpha3GIB Alpha3 = "GIB"
+	Alpha3GRC Alpha3 = "GRC"
+	Alpha3GRL Alpha3 = "GRL"
+	Alpha3GRD Alpha3 = "GRD"
+	Alpha3GLP Alpha3 = "GLP"
+	Alpha3GUM Alpha3 = "GUM"
+	Alpha3GTM Alpha3 = "GTM"
+	Alpha3GGY Alpha3 = "GGY"
+	Alpha3GIN Alpha3 = "GIN"
+	Alpha3GNB Alpha3 = "GNB"
+	Alpha3GUY Alpha3 = "GUY"
+	Alpha3HTI Alpha3 = "HTI"
+	Alpha3HMD Alpha3 = "HMD"
+	Alpha3VAT Alpha3 = "VAT"
+	Alpha3HND Alpha3 = "HND"
+	Alpha3HKG Alpha3 = "HKG"
+	Alpha3HUN Alpha3 = "HUN"
+	Alpha3ISL Alpha3 = "ISL"
+	Alpha3IND Alpha3 = "IND"
+	Alpha3IDN Alpha3 = "IDN"
+	Alpha3IRN Alpha3 = "IRN"
+	Alpha3IRQ Alpha3 = "IRQ"
+	Alpha3IRL Alpha3 = "IRL"
+	Alpha3IMN Alpha3 = "IMN"
+	Alpha3ISR Alpha3 = "ISR"
+	Alpha3ITA Alpha3 = "ITA"
+	Alpha3JAM Alpha3 = "JAM"
+	Alpha3JPN Alpha3 = "JPN"
+	Alpha3JEY Alpha3 = "JEY"
+	Alpha3JOR Alpha3 = "JOR"
+	Alpha3KAZ Alpha3 = "KAZ"
+	Alpha3KEN Alpha3 = "KEN"
+	Alpha3KIR Alpha3 = "KIR"
+	Alpha3PRK Alpha3 = "PRK"
+	Alpha3KOR Alpha3 = "KOR"
+	Alpha3KWT Alpha3 = "KWT"
+	Alpha3KGZ Alpha3 = "KGZ"
+	Alpha3LAO Alpha3 = "LAO"
+	Alpha3LVA Alpha3 = "LVA"
+	Alpha3LBN Alpha3 = "LBN"
+	Alpha3LSO Alpha3 = "LSO"
+	Alpha3LBR Alpha3 = "LBR"
+	Alpha3LBY Alpha3 = "LBY"
+	Alpha3LIE Alpha3 = "LIE"
+	Alpha3LTU Alpha3 = "LTU"
+	Alpha3LUX Alpha3 = "LUX"
+	Alpha3MAC Alpha3 = "MAC"
+	Alpha3MDG Alpha3 = "MDG"
+	Alpha3MWI Alpha3 = "MWI"
+	Alpha3MYS Alpha3 = "MYS"
+	Alpha3MDV Alpha3 = "MDV"
+	Alpha3MLI Alpha3 = "MLI"
+	Alpha3MLT Alpha3 = "MLT"
+	Alpha3MHL Alpha3 = "MHL"
+	Alpha3MTQ Alpha3 = "MTQ"
+	Alpha3MRT Alpha3 = "MRT"
+	Alpha3MUS Alpha3 = "MUS"
+	Alpha3MYT Alpha3 = "MYT"
+	Alpha3MEX Alpha3 = "MEX"
+	Alpha3FSM Alpha3 = "FSM"
+	Alpha3MDA Alpha3 = "MDA"
+	Alpha3MCO Alpha3 = "MCO"
+	Alpha3MNG Alpha3 = "MNG"
+	Alpha3MNE Alpha3 = "MNE"
+	Alpha3MSR Alpha3 = "MSR"
+	Alpha3MAR Alpha3 = "MAR"
+	Alpha3MOZ Alpha3 = "MOZ"
+	Alpha3MMR Alpha3 = "MMR"
+	Alpha3NAM Alpha3 = "NAM"
+	Alpha3NRU Alpha3 = "NRU"
+	Alpha3NPL Alpha3 = "NPL"
+	Alpha3NLD Alpha3 = "NLD"
+	Alpha3NCL Alpha3 = "NCL"
+	Alpha3NZL Alpha3 = "NZL"
+	Alpha3NIC Alpha3 = "NIC"
+	Alpha3NER Alpha3 = "NER"
+	Alpha3NGA Alpha3 = "NGA"
+	Alpha3NIU Alpha3 = "NIU"
+	Alpha3NFK Alpha3 = "NFK"
+	Alpha3MKD Alpha3 = "MKD"
+	Alpha3MNP Alpha3 = "MNP"
+	Alpha3NOR Alpha3 = "NOR"
+	Alpha3OMN Alpha3 = "OMN"
+	Alpha3PAK Alpha3 = "PAK"
+	Alpha3PLW Alpha3 = "PLW"
+	Alpha3PSE Alpha3 = "PSE"
+	Alpha3PAN Alpha3 = "PAN"
+	Alpha3PNG Alpha3 = "PNG"
+	Alpha3PRY Alpha3 = "PRY"
+	Alpha3PER Alpha3 = "PER"
+	Alpha3PHL Alpha3 = "PHL"
+	Alpha3PCN Alpha3 = "PCN"
+	Alpha3POL Alpha3 = "POL"
+	Alpha3PRT Alpha3 = "PRT"
+	Alpha3PRI Alpha3 = "PRI"
+	Alpha3QAT Alpha3 = "QAT"
+	Alpha3REU Alpha3 = "REU"
+	Alpha3ROU Alpha3 = "ROU"
+	Alpha3RUS Alpha3 = "RUS"
+	Alpha3RWA Alpha3 = "RWA"
+	Alpha3BLM Alpha3 = "BLM"
+	Alpha3SHN Alpha3 = "SHN"
+	Alpha3KNA Alpha3 = "KNA"
+	Alpha3LCA Alpha3 = "LCA"
+	Alpha3MAF Alpha3 = "MAF"
+	Alpha3SPM Alpha3 = "SPM"
+	Alpha3VCT Alpha3 = "VCT"
+	Alpha3WSM Alpha3 = "WSM"
+	Alpha3SMR Alpha3 = "SMR"
+	Alpha3STP Alpha3 = "STP"
+	Alpha3SAU Alpha3 = "SAU"
+	Alpha3SEN Alpha3 = "SEN"
+	Alpha3SRB Alpha3 = "SRB"
+	Alpha3SYC Alpha3 = "SYC"
+	Alpha3SLE Alpha3 = "SLE"
+	Alpha3SGP Alpha3 = "SGP"
+	Alpha3SXM Alpha3 = "SXM"
+	Alpha3SVK Alpha3 = "SVK"
+	Alpha3SVN Alpha3 = "SVN"
+	Alpha3SLB Alpha3 = "SLB"
+	Alpha3SOM Alpha3 = "SOM"
+	Alpha3ZAF Alpha3 = "ZAF"
+	Alpha3SGS Alpha3 = "SGS"
+	Alpha3SSD Alpha3 = "SSD"
+	Alpha3ESP Alpha3 = "ESP"
+	Alpha3LKA Alpha3 = "LKA"
+	Alpha3SDN Alpha3 = "SDN"
+	Alpha3SUR Alpha3 = "SUR"
+	Alpha3SJM Alpha3 = "SJM"
+	Alpha3SWE Alpha3 = "SWE"
+	Alpha3CHE Alpha3 = "CHE"
+	Alpha3SYR Alpha3 = "SYR"
+	Alpha3TWN Alpha3 = "TWN"
+	Alpha3TJK Alpha3 = "TJK"
+	Alpha3TZA Alpha3 = "TZA"
+	Alpha3THA Alpha3 = "THA"
+	Alpha3TLS Alpha3 = "TLS"
+	Alpha3TGO Alpha3 = "TGO"
+	Alpha3TKL Alpha3 = "TKL"
+	Alpha3TON Alpha3 = "TON"
+	Alpha3TTO Alpha3 = "TTO"
+	Alpha3TUN Alpha3 = "TUN"
+	Alpha3TUR Alpha3 = "TUR"
+	Alpha3TKM Alpha3 = "TKM"
+	Alpha3TCA Alpha3 = "TCA"
+	Alpha3TUV Alpha3 = "TUV"
+	Alpha3UGA Alpha3 = "UGA"
+	Alpha3UKR Alpha3 = "UKR"
+	Alpha3ARE Alpha3 = "ARE"
+	Alpha3GBR Alpha3 = "GBR"
+	Alpha3USA Alpha3 = "USA"
+	Alpha3UMI Alpha3 = "UMI"
+	Alpha3URY Alpha3 = "URY"
+	Alpha3UZB Alpha3 = "UZB"
+	Alpha3VUT Alpha3 = "VUT"
+	Alpha3VEN Alpha3 = "VEN"
+	Alpha3VNM Alpha3 = "VNM"
+	Alpha3VGB Alpha3 = "VGB"
+	Alpha3VIR Alpha3 = "VIR"
+	Alpha3WLF Alpha3 = "WLF"
+	Alpha3ESH Alpha3 = "ESH"
+	Alpha3YEM Alpha3 = "YEM"
+	Alpha3ZMB Alpha3 = "ZMB"
+	Alpha3ZWE Alpha3 = "ZWE"
+)
+
+// Predefined ISO 3166-1 numeric codes, named after the alpha-2 code of the country.
+const (
+	NumericAF Numeric = 4
+	NumericAX Numeric = 248
+	NumericAL Numeric = 8
+	NumericDZ Numeric = 12
+	NumericAS Numeric = 16
+	NumericAD Numeric = 20
+	NumericAO Numeric = 24
+	NumericAI Numeric = 660
+	NumericAQ Numeric = 10
+	NumericAG Numeric = 28
+	NumericAR Numeric = 32
+	NumericAM Numeric = 51
+	NumericAW Numeric = 533
+	NumericAU Numeric = 36
+	NumericAT Numeric = 40
+	NumericAZ Numeric = 31
+	NumericBS Numeric = 44
+	NumericBH Numeric = 48
+	NumericBD Numeric = 50
+	NumericBB Numeric = 52
+	NumericBY Numeric = 112
+	NumericBE Numeric = 56
+	NumericBZ Numeric = 84
+	NumericBJ Numeric = 204
+	NumericBM Numeric = 60
+	NumericBT Numeric = 64
+	NumericBO Numeric = 68
+	NumericBQ Numeric = 535
+	NumericBA Numeric = 70
+	NumericBW Numeric = 72
+	NumericBV Numeric = 74
+	NumericBR Numeric = 76
+	NumericIO Numeric = 86
+	NumericBN Numeric = 96
+	NumericBG Numeric = 100
+	NumericBF Numeric = 854
+	NumericBI Numeric = 108
+	NumericCV Numeric = 132
+	NumericKH Numeric = 116
+	NumericCM Numeric = 120
+	NumericCA Numeric = 124
+	NumericKY Numeric = 136
+	NumericCF Numeric = 140
+	NumericTD Numeric = 148
+	NumericCL Numeric = 152
+	NumericCN Numeric = 156
+	NumericCX Numeric = 162
+	NumericCC Numeric = 166
+	NumericCO Numeric = 170
+	NumericKM Numeric = 174
+	NumericCG Numeric = 178
+	NumericCD Numeric = 180
+	NumericCK Numeric = 184
+	NumericCR Numeric = 188
+	NumericCI Numeric = 384
+	NumericHR Numeric = 191
+	NumericCU Numeric = 192
+	NumericCW Numeric = 531
+	NumericCY Numeric = 196
+	NumericCZ Numeric = 203
+	NumericDK Numeric = 208
+	NumericDJ Numeric = 262
+	NumericDM Numeric = 212
+	NumericDO Numeric = 214
+	NumericEC Numeric = 218
+	NumericEG Numeric = 818
+	NumericSV Numeric = 222
+	NumericGQ Numeric = 226
+	NumericER Numeric = 232
+	NumericEE Numeric = 233
+	NumericSZ Numeric = 748
+	NumericET Numeric = 231
+	NumericFK Numeric = 238
+	NumericFO Numeric = 234
+	NumericFJ Numeric = 242
+	NumericFI Numeric = 246
+	NumericFR Numeric = 250
+	NumericGF Numeric = 254
+	NumericPF Numeric = 258
+	NumericTF Numeric = 260
+	NumericGA Numeric = 266
+	NumericGM Numeric = 270
+	NumericGE Numeric = 268
+	NumericDE Numeric = 276
+	NumericGH Numeric = 288
+	NumericGI Numeric = 292
+	NumericGR Numeric = 300
+	NumericGL Numeric = 304
+	NumericGD Numeric = 308
+	NumericGP Numeric = 312
+	NumericGU Numeric = 316
+	NumericGT Numeric = 320
+	NumericGG Numeric = 831
+	NumericGN Numeric = 324
+	NumericGW Numeric = 624
+	NumericGY Numeric = 328
+	NumericHT Numeric = 332
+	NumericHM Numeric = 334
+	NumericVA Numeric = 336
+	NumericHN Numeric = 340
+	NumericHK Numeric = 344
+	NumericHU Numeric = 348
+	NumericIS Numeric = 352
+	NumericIN Numeric = 356
+	NumericID Numeric = 360
+	NumericIR Numeric = 364
+	NumericIQ Numeric = 368
+	NumericIE Numeric = 372
+	NumericIM Numeric = 833
+	NumericIL Numeric = 376
+	NumericIT Numeric = 380
+	NumericJM Numeric = 388
+	NumericJP Numeric = 392
+	NumericJE Numeric = 832
+	NumericJO Numeric = 400
+	NumericKZ Numeric = 398
+	NumericKE Numeric = 404
+	NumericKI Numeric = 296
+	NumericKP Numeric = 408
+	NumericKR Numeric = 410
+	NumericKW Numeric = 414
+	NumericKG Numeric = 417
+	NumericLA Numeric = 418
+	NumericLV Numeric = 428
+	NumericLB Numeric = 422
+	NumericLS Numeric = 426
+	NumericLR Numeric = 430
+	NumericLY Numeric = 434
+	NumericLI Numeric = 438
+	NumericLT Numeric = 440
+	NumericLU Numeric = 442
+	NumericMO Numeric = 446
+	NumericMG Numeric = 450
+	NumericMW Numeric = 454
+	NumericMY Numeric = 458
+	NumericMV Numeric = 462
+	NumericML Numeric = 466
+	NumericMT Numeric = 470
+	NumericMH Numeric = 584
+	NumericMQ Numeric = 474
+	NumericMR Numeric = 478
+	NumericMU Numeric = 480
+	NumericYT Numeric = 175
+	NumericMX Numeric = 484
+	NumericFM Numeric = 583
+	NumericMD Numeric = 498
+	NumericMC Numeric = 492
+	NumericMN Numeric = 496
+	NumericME Numeric = 499
+	NumericMS Numeric = 500
+	NumericMA Numeric = 504
+	NumericMZ Numeric = 508
+	NumericMM Numeric = 104
+	NumericNA Numeric = 516
+	NumericNR Numeric = 520
+	NumericNP Numeric = 524
+	NumericNL Numeric = 528
+	NumericNC Numeric = 540
+	NumericNZ Numeric = 554
+	NumericNI Numeric = 558
+	NumericNE Numeric = 562
+	NumericNG Numeric = 566
+	NumericNU Numeric = 570
+	NumericNF Numeric = 574
+	NumericMK Numeric = 807
+	NumericMP Numeric = 580
+	NumericNO Numeric = 578
+	NumericOM Numeric = 512
+	NumericPK Numeric = 586
+	NumericPW Numeric = 585
+	NumericPS Numeric = 275
+	NumericPA Numeric = 591
+	NumericPG Numeric = 598
+	NumericPY Numeric = 600
+	NumericPE Numeric = 604
+	NumericPH Numeric = 608
+	NumericPN Numeric = 612
+	NumericPL Numeric = 616
+	NumericPT Numeric = 620
+	NumericPR Numeric = 630
+	NumericQA Numeric = 634
+	NumericRE Numeric = 638
+	NumericRO Numeric = 642
+	NumericRU Numeric = 643
+	NumericRW Numeric = 646
+	NumericBL Numeric = 652
+	NumericSH Numeric = 654
+	NumericKN Numeric = 659
+	NumericLC Numeric = 662
+	NumericMF Numeric = 663
+	NumericPM Numeric = 666
+	NumericVC Numeric = 670
+	NumericWS Numeric = 882
+	NumericSM Numeric = 674
+	NumericST Numeric = 678
+	NumericSA Numeric = 682
+	NumericSN Numeric = 686
+	NumericRS Numeric = 688
+	NumericSC Numeric = 690
+	NumericSL Numeric = 694
+	NumericSG Numeric = 702
+	NumericSX Numeric = 534
+	NumericSK Numeric = 703
+	NumericSI Numeric = 705
+	NumericSB Numeric = 90
+	NumericSO Numeric = 706
+	NumericZA Numeric = 710
+	NumericGS Numeric = 239
+	NumericSS Numeric = 728
+	NumericES Numeric = 724
+	NumericLK Numeric = 144
+	NumericSD Numeric = 729
+	NumericSR Numeric = 740
+	NumericSJ Numeric = 744
+	NumericSE Numeric = 752
+	NumericCH Numeric = 756
+	NumericSY Numeric = 760
+	NumericTW Numeric = 158
+	NumericTJ Numeric = 762
+	NumericTZ Numeric = 834
+	NumericTH Numeric = 764
+	NumericTL Numeric = 626
+	NumericTG Numeric = 768
+	NumericTK Numeric = 772
+	NumericTO Numeric = 776
+	NumericTT Numeric = 780
+	NumericTN Numeric = 788
+	NumericTR Numeric = 792
+	NumericTM Numeric = 795
+	NumericTC Numeric = 796
+	NumericTV Numeric = 798
+	NumericUG Numeric = 800
+	NumericUA Numeric = 804
+	NumericAE Numeric = 784
+	NumericGB Numeric = 826
+	NumericUS Numeric = 840
+	NumericUM Numeric = 581
+	NumericUY Numeric = 858
+	NumericUZ Numeric = 860
+	NumericVU Numeric = 548
+	NumericVE Numeric = 862
+	NumericVN Numeric = 704
+	NumericVG Numeric = 92
+	NumericVI Numeric = 850
+	NumericWF Numeric = 876
+	NumericEH Numeric = 732
+	NumericYE Numeric = 887
+	NumericZM Numeric = 894
+	NumericZW Numeric = 716
 )
@@ -0,0 +1,139 @@
+package countries
+
+// Neighbors retrieves the countries sharing a land border with the Country.
+//
+// This function performs the following steps:
+// - Performs a constant-time map lookup of the generated neighbour graph using the alpha-2 code
+// - Copies the neighbours into a new slice
+//
+// Parameters:
+// - None
+//
+// Returns:
+// - CountryList of the bordering countries sorted by alpha-2 code, or nil when the country has no land border
+//
+// Side Effects:
+// - None
+//
+// Notes:
+// - Borders are symmetric: every neighbour lists the Country among its own neighbours
+// - Overseas territories with their own ISO 3166-1 code have their own borders (e.g., French Guiana
+// borders Brazil, France does not)
+// - The Country pointers reference global data but the returned slice is a copy
+func (c *Country) Neighbors() CountryList {
+	return append(CountryList(nil), neighbors[c.Alpha2]...)
+}
+
+// IsLandlocked reports whether the Country has no coastline on the open sea.
+//
+// Parameters:
+// - None
+//
+// Returns:
+// - True for landlocked countries (e.g., Austria, Bolivia), false for every other country
+//
+// Side Effects:
+// - None
+//
+// Notes:
+// - The Caspian Sea counts as a lake, so Kazakhstan and Turkmenistan are landlocked
+// - Island countries are not landlocked even though they have no neighbours
+func (c *Country) IsLandlocked() bool {
+	return landlocked[c.Alpha2]
+}
+
+// IsDoublyLandlocked reports whether the Country is landlocked and only borders landlocked countries.
+//
+// This function performs the following steps:
+// - Checks that the Country is landlocked
+// - Checks that every neighbour is landlocked as well
+//
+// Parameters:
+// - None
+//
+// Returns:
+// - True for Liechtenstein and Uzbekistan, false for every other country
+//
+// Side Effects:
+// - None
+//
+// Notes:
+// - Reaching the sea from a doubly landlocked country requires crossing at least two borders
+func (c *Country) IsDoublyLandlocked() bool {
+	if !c.IsLandlocked() {
+		return false
+	}
+	for _, neighbor := range neighbors[c.Alpha2] {
+		if !neighbor.IsLandlocked() {
+			return false
+		}
+	}
+	return true
+}
+
+// ShortestLandPath finds a route between two countries crossing the fewest land borders.
+//
+// This function performs the following steps:
+// - Resolves both countries by alpha-2 code, so that copies of a country find their route
+// - Returns a single-country route when both countries are the same
+// - Explores the neighbour graph breadth-first from the first country, neighbours in alpha-2 order
+// - Rebuilds the route from the second country back to the first one
+//
+// Parameters:
+// - from: country where the route starts
+// - to: country where the route ends
+//
+// Returns:
+// - CountryList of the countries crossed, from and to included (e.g., FR, DE, PL for France to Poland)
+// - Nil when either country is nil or unknown, or the countries are not connected by land (e.g., islands)
+//
+// Side Effects:
+// - None
+//
+// Notes:
+// - The number of borders crossed is len(route) - 1
+// - When several routes cross the same number of borders, the one through the neighbours with the lowest
+// alpha-2 codes is returned, so the result is deterministic
+// - A land border does not guarantee a road crossing (e.g., the Darién Gap between Panama and Colombia)
+// - The Country pointers reference global data but the returned slice is a new list
+func ShortestLandPath(from, to *Country) CountryList {
+	if from == nil || to == nil {
+		return nil
+	}
+	if from, to = byAlpha2[from.Alpha2], byAlpha2[to.Alpha2]; from == nil || to == nil {
+		return nil
+	}
+	if from == to {
+		return CountryList{from}
+	}
+
+	previous := map[string]*Country{from.Alpha2: nil}
+	queue := CountryList{from}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		for _, neighbor := range neighbors[current.Alpha2] {
+			if _, seen := previous[neighbor.Alpha2]; seen {
+				continue
+			}
+			previous[neighbor.Alpha2] = current
+			if neighbor.Alpha2 == to.Alpha2 {
+				return landPath(previous, neighbor)
+			}
+			queue = append(queue, neighbor)
+		}
+	}
+	return nil
+}
+
+// landPath rebuilds the route ending at the last country from the breadth-first search links
+func landPath(previous map[string]*Country, last *Country) CountryList {
+	var path CountryList
+	for c := last; c != nil; c = previous[c.Alpha2] {
+		path = append(path, c)
+	}
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+	return path
+}
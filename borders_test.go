@@ -0,0 +1,197 @@
+package countries
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// alpha2Codes returns the alpha-2 codes of a list of countries
+func alpha2Codes(list CountryList) []string {
+	codes := make([]string, 0, len(list))
+	for _, c := range list {
+		codes = append(codes, c.Alpha2)
+	}
+	return codes
+}
+
+// TestNeighbors_Loaded tests that the neighbour graph is preloaded and symmetric
+func TestNeighbors_Loaded(t *testing.T) {
+	require.NotEmpty(t, neighbors)
+
+	for alpha2, list := range neighbors {
+		require.NotNil(t, GetByAlpha2(alpha2))
+		for _, neighbor := range list {
+			assert.NotEqual(t, alpha2, neighbor.Alpha2)
+			assert.Contains(t, alpha2Codes(neighbors[neighbor.Alpha2]), alpha2, "%s borders %s", alpha2, neighbor.Alpha2)
+		}
+	}
+	for alpha2 := range landlocked {
+		assert.NotEmpty(t, neighbors[alpha2], "landlocked country %s has no neighbour", alpha2)
+	}
+}
+
+// TestCountry_Neighbors tests the Neighbors method
+func TestCountry_Neighbors(t *testing.T) {
+	tests := []struct {
+		alpha2   Alpha2
+		expected []string
+	}{
+		{alpha2: Alpha2DE, expected: []string{"AT", "BE", "CH", "CZ", "DK", "FR", "LU", "NL", "PL"}},
+		{alpha2: Alpha2US, expected: []string{"CA", "MX"}},
+		{alpha2: Alpha2LS, expected: []string{"ZA"}},
+		{alpha2: Alpha2GF, expected: []string{"BR", "SR"}},
+		{alpha2: Alpha2PS, expected: []string{"EG", "IL", "JO"}},
+		{alpha2: Alpha2JP},
+		{alpha2: Alpha2AQ},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.alpha2.String(), func(t *testing.T) {
+			list := tt.alpha2.Country().Neighbors()
+			if tt.expected == nil {
+				assert.Nil(t, list)
+				return
+			}
+			assert.Equal(t, tt.expected, alpha2Codes(list))
+		})
+	}
+
+	t.Run("returns a copy", func(t *testing.T) {
+		list := GetByAlpha2Code(Alpha2DE).Neighbors()
+		list[0] = nil
+		assert.NotNil(t, GetByAlpha2Code(Alpha2DE).Neighbors()[0])
+	})
+
+	t.Run("China has the most neighbours", func(t *testing.T) {
+		assert.Len(t, GetByAlpha2Code(Alpha2CN).Neighbors(), 16)
+	})
+}
+
+// TestCountry_IsLandlocked tests the IsLandlocked and IsDoublyLandlocked methods
+func TestCountry_IsLandlocked(t *testing.T) {
+	var landlockedCodes, doublyLandlocked []string
+	for _, c := range GetAll() {
+		if c.IsLandlocked() {
+			landlockedCodes = append(landlockedCodes, c.Alpha2)
+		}
+		if c.IsDoublyLandlocked() {
+			doublyLandlocked = append(doublyLandlocked, c.Alpha2)
+		}
+	}
+
+	assert.Len(t, landlockedCodes, 44)
+	assert.ElementsMatch(t, []string{"LI", "UZ"}, doublyLandlocked)
+
+	assert.True(t, GetByAlpha2Code(Alpha2AT).IsLandlocked())
+	assert.True(t, GetByAlpha2Code(Alpha2VA).IsLandlocked())
+	assert.True(t, GetByAlpha2Code(Alpha2KZ).IsLandlocked())
+	assert.False(t, GetByAlpha2Code(Alpha2VA).IsDoublyLandlocked())
+	assert.False(t, GetByAlpha2Code(Alpha2DE).IsLandlocked())
+	assert.False(t, GetByAlpha2Code(Alpha2JP).IsLandlocked())
+}
+
+// TestShortestLandPath tests ShortestLandPath with different routes
+func TestShortestLandPath(t *testing.T) {
+	tests := []struct {
+		name     string
+		from     Alpha2
+		to       Alpha2
+		expected []string
+	}{
+		{name: "Same country", from: Alpha2FR, to: Alpha2FR, expected: []string{"FR"}},
+		{name: "Neighbours", from: Alpha2FR, to: Alpha2DE, expected: []string{"FR", "DE"}},
+		{name: "France to Poland", from: Alpha2FR, to: Alpha2PL, expected: []string{"FR", "DE", "PL"}},
+		{name: "Portugal to Germany", from: Alpha2PT, to: Alpha2DE, expected: []string{"PT", "ES", "FR", "DE"}},
+		{name: "Across continents", from: Alpha2ES, to: Alpha2CN, expected: []string{"ES", "FR", "DE", "PL", "RU", "CN"}},
+		{name: "Canada to Mexico", from: Alpha2CA, to: Alpha2MX, expected: []string{"CA", "US", "MX"}},
+		{name: "Enclave", from: Alpha2VA, to: Alpha2SM, expected: []string{"VA", "IT", "SM"}},
+		{name: "Island", from: Alpha2FR, to: Alpha2GB},
+		{
+			name:     "Through Central America",
+			from:     Alpha2US,
+			to:       Alpha2BR,
+			expected: []string{"US", "MX", "GT", "HN", "NI", "CR", "PA", "CO", "BR"},
+		},
+		{name: "Separate continents", from: Alpha2AU, to: Alpha2FR},
+		{name: "Island to itself", from: Alpha2JP, to: Alpha2JP, expected: []string{"JP"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := ShortestLandPath(tt.from.Country(), tt.to.Country())
+			if tt.expected == nil {
+				assert.Nil(t, path)
+				return
+			}
+			assert.Equal(t, tt.expected, alpha2Codes(path))
+
+			reverse := ShortestLandPath(tt.to.Country(), tt.from.Country())
+			assert.Len(t, reverse, len(path))
+		})
+	}
+
+	t.Run("nil countries", func(t *testing.T) {
+		assert.Nil(t, ShortestLandPath(nil, GetByAlpha2Code(Alpha2FR)))
+		assert.Nil(t, ShortestLandPath(GetByAlpha2Code(Alpha2FR), nil))
+	})
+
+	t.Run("unknown countries", func(t *testing.T) {
+		unknown := &Country{Alpha2: "ZZ"}
+		assert.Nil(t, ShortestLandPath(unknown, GetByAlpha2Code(Alpha2FR)))
+		assert.Nil(t, ShortestLandPath(GetByAlpha2Code(Alpha2FR), unknown))
+		assert.Nil(t, ShortestLandPath(unknown, &Country{Alpha2: "ZZ"}))
+		assert.Nil(t, ShortestLandPath(&Country{}, &Country{}))
+	})
+
+	t.Run("copied countries", func(t *testing.T) {
+		fr := *GetByAlpha2Code(Alpha2FR)
+		path := ShortestLandPath(&fr, GetByAlpha2Code(Alpha2BE))
+		require.Len(t, path, 2)
+		assert.Same(t, GetByAlpha2Code(Alpha2FR), path[0])
+	})
+}
+
+// ExampleCountry_Neighbors is an example of Country.Neighbors()
+func ExampleCountry_Neighbors() {
+	for _, c := range GetByAlpha2Code(Alpha2ES).Neighbors() {
+		fmt.Println(c.Name)
+	}
+	// Output:Andorra
+	// France
+	// Gibraltar
+	// Morocco
+	// Portugal
+}
+
+// ExampleCountry_IsLandlocked is an example of Country.IsLandlocked()
+func ExampleCountry_IsLandlocked() {
+	uz := GetByAlpha2Code(Alpha2UZ)
+	fmt.Printf("landlocked: %t, doubly landlocked: %t", uz.IsLandlocked(), uz.IsDoublyLandlocked())
+	// Output:landlocked: true, doubly landlocked: true
+}
+
+// ExampleShortestLandPath is an example of ShortestLandPath()
+func ExampleShortestLandPath() {
+	path := ShortestLandPath(GetByAlpha2Code(Alpha2PT), GetByAlpha2Code(Alpha2PL))
+	fmt.Printf("%v (%d borders)", alpha2Codes(path), len(path)-1)
+	// Output:[PT ES FR DE PL] (4 borders)
+}
+
+// BenchmarkCountry_Neighbors benchmarks the method Neighbors()
+func BenchmarkCountry_Neighbors(b *testing.B) {
+	de := GetByAlpha2Code(Alpha2DE)
+	for i := 0; i < b.N; i++ {
+		_ = de.Neighbors()
+	}
+}
+
+// BenchmarkShortestLandPath benchmarks the method ShortestLandPath()
+func BenchmarkShortestLandPath(b *testing.B) {
+	pt, cn := GetByAlpha2Code(Alpha2PT), GetByAlpha2Code(Alpha2CN)
+	for i := 0; i < b.N; i++ {
+		_ = ShortestLandPath(pt, cn)
+	}
+}
@@ -0,0 +1,112 @@
+package countries
+
+import (
+	"strings"
+)
+
+// maxE164Digits is the maximum number of digits in an international (E.164) phone number
+const maxE164Digits = 15
+
+// GetByCallingCode retrieves a Country by its international calling code (ITU-T E.164).
+//
+// This function performs the following steps:
+// - Normalizes the code by trimming spaces and a leading "+" or "00" international prefix
+// - Performs a constant-time map lookup using the normalized code
+//
+// - Returns the Country pointer on success
+// - Returns nil if the calling code is not assigned to any country
+//
+// Parameters:
+// - code: calling code used for the lookup, with or without the "+" (e.g., "44", "+44")
+//
+// Returns:
+// - Pointer to the Country struct, or nil when no match is found
+//
+// Side Effects:
+// - None
+//
+// Notes:
+// - A calling code shared by several countries returns its main country (e.g., US for "1", RU for "7")
+// - Use CountriesByCallingCode to retrieve every country sharing a calling code
+// - Use ResolvePhonePrefix to tell the sharing countries apart from a phone number
+func GetByCallingCode(code string) *Country {
+	return byCallingCode[normalizeCallingCode(code)]
+}
+
+// CountriesByCallingCode provides every Country that uses the given international calling code.
+//
+// This function performs the following steps:
+// - Normalizes the code by trimming spaces and a leading "+" or "00" international prefix
+// - Looks up the countries grouped under the calling code
+// - Copies the matching Country pointers into a new slice
+//
+// Parameters:
+// - code: calling code used for the lookup, with or without the "+" (e.g., "+1")
+//
+// Returns:
+// - CountryList in the same order as GetAll, or nil when no country uses the calling code
+//
+// Side Effects:
+// - None
+//
+// Notes:
+// - The Country pointers reference global data but the returned slice is a copy
+func CountriesByCallingCode(code string) CountryList {
+	return append(CountryList(nil), countriesByCallingCode[normalizeCallingCode(code)]...)
+}
+
+// ResolvePhonePrefix resolves the Country of an international phone number using its longest known prefix.
+//
+// This function performs the following steps:
+// - Strips formatting characters (spaces, dashes, dots, parentheses) and a leading "+" or "00"
+// - Looks up the longest prefix of the remaining digits in the phone prefix map
+//
+// - Returns the Country of the most specific prefix (e.g., "1684" for American Samoa over "1")
+// - Returns nil when the number does not start with a known calling code
+//
+// Parameters:
+// - number: international phone number or prefix (e.g., "+1 684 633 1234", "0044 1481 123456")
+//
+// Returns:
+// - Pointer to the Country struct, or nil when no match is found
+//
+// Side Effects:
+// - None
+//
+// Notes:
+// - NANP area codes resolve to their member country (e.g., "+1 787" to Puerto Rico, "+1 416" to Canada)
+// - Other shared calling codes are split by their national prefixes (e.g., "+7 7" to Kazakhstan)
+// - A number that matches no national prefix resolves to the main country of its calling code
+// - The number itself is not validated; only its leading digits are used
+func ResolvePhonePrefix(number string) *Country {
+	digits := phoneDigits(number)
+	for n := min(len(digits), maxE164Digits); n > 0; n-- {
+		if c, ok := byPhonePrefix[digits[:n]]; ok {
+			return c
+		}
+	}
+	return nil
+}
+
+// normalizeCallingCode trims spaces and a leading "+" or "00" international prefix from a calling code
+func normalizeCallingCode(code string) string {
+	code = strings.TrimSpace(code)
+	if trimmed, ok := strings.CutPrefix(code, "+"); ok {
+		return trimmed
+	}
+	return strings.TrimPrefix(code, "00")
+}
+
+// phoneDigits extracts the digits of a phone number, dropping a leading "00" international prefix
+//
+// Calling codes never start with a zero, so a leading "00" is always an international prefix.
+func phoneDigits(number string) string {
+	var b strings.Builder
+	b.Grow(len(number))
+	for i := 0; i < len(number); i++ {
+		if number[i] >= '0' && number[i] <= '9' {
+			b.WriteByte(number[i])
+		}
+	}
+	return strings.TrimPrefix(b.String(), "00")
+}
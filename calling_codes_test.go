@@ -0,0 +1,183 @@
+package countries
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testCallingCode = "+44"
+	testPhoneNumber = "+1 684 633 1234"
+)
+
+// TestCallingCodes_Loaded tests that the calling code data is preloaded and consistent
+func TestCallingCodes_Loaded(t *testing.T) {
+	require.NotEmpty(t, byCallingCode)
+	require.NotEmpty(t, byPhonePrefix)
+	assert.Len(t, countriesByCallingCode, len(byCallingCode))
+
+	for _, c := range countries {
+		for _, code := range c.CallingCodes {
+			require.True(t, strings.HasPrefix(code, "+"), "country %s has calling code %s", c.Alpha2, code)
+			assert.Contains(t, CountriesByCallingCode(code), c)
+			assert.NotNil(t, GetByCallingCode(code))
+		}
+	}
+
+	// Every calling code resolves to its main country and every prefix extends a calling code
+	for code, c := range byCallingCode {
+		assert.Equal(t, c, byPhonePrefix[code])
+	}
+	for prefix, c := range byPhonePrefix {
+		assert.NotEmpty(t, c.CallingCodes, "prefix %s", prefix)
+	}
+}
+
+// TestGetByCallingCode_VariousFormats tests GetByCallingCode with different input formats
+func TestGetByCallingCode_VariousFormats(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		expected  string
+		expectNil bool
+	}{
+		{name: "Digits only", input: "44", expected: "GB"},
+		{name: "With plus", input: testCallingCode, expected: "GB"},
+		{name: "International prefix", input: "0049", expected: "DE"},
+		{name: "Surrounding spaces", input: " +33 ", expected: "FR"},
+		{name: "Shared NANP code", input: "1", expected: testCountryAlpha2},
+		{name: "Shared with Kazakhstan", input: "+7", expected: "RU"},
+		{name: "Shared with Pitcairn", input: "64", expected: "NZ"},
+		{name: "Area code is not a calling code", input: "1684", expectNil: true},
+		{name: "Unassigned", input: "999", expectNil: true},
+		{name: "Empty", input: "", expectNil: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := GetByCallingCode(tt.input)
+			if tt.expectNil {
+				require.Nil(t, c)
+				return
+			}
+
+			require.NotNil(t, c)
+			assert.Equal(t, tt.expected, c.Alpha2)
+		})
+	}
+}
+
+// ExampleGetByCallingCode is an example of GetByCallingCode()
+func ExampleGetByCallingCode() {
+	c := GetByCallingCode(testCallingCode)
+	fmt.Printf("country: %s calling codes: %v", c.Name, c.CallingCodes)
+	// Output:country: United Kingdom of Great Britain and Northern Ireland calling codes: [+44]
+}
+
+// BenchmarkGetByCallingCode benchmarks the method GetByCallingCode()
+func BenchmarkGetByCallingCode(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_ = GetByCallingCode(testCallingCode)
+	}
+}
+
+// TestCountriesByCallingCode tests the CountriesByCallingCode function
+func TestCountriesByCallingCode(t *testing.T) {
+	t.Run("nanp", func(t *testing.T) {
+		list := CountriesByCallingCode("+1")
+		assert.Len(t, list, 25)
+		assert.Contains(t, list, GetByAlpha2(testCountryAlpha2))
+		assert.Contains(t, list, GetByAlpha2Code(Alpha2CA))
+		assert.Contains(t, list, GetByAlpha2("PR"))
+		assert.NotContains(t, list, GetByAlpha2("MX"))
+	})
+
+	t.Run("shared", func(t *testing.T) {
+		list := CountriesByCallingCode("7")
+		require.Len(t, list, 2)
+		assert.Equal(t, "KZ", list[0].Alpha2)
+		assert.Equal(t, "RU", list[1].Alpha2)
+	})
+
+	t.Run("unknown", func(t *testing.T) {
+		assert.Empty(t, CountriesByCallingCode("999"))
+	})
+
+	t.Run("returns a copy", func(t *testing.T) {
+		list := CountriesByCallingCode("1")
+		list[0] = &Country{Alpha2: "XX"}
+		assert.NotEqual(t, "XX", CountriesByCallingCode("1")[0].Alpha2)
+	})
+}
+
+// ExampleCountriesByCallingCode is an example of CountriesByCallingCode()
+func ExampleCountriesByCallingCode() {
+	for _, c := range CountriesByCallingCode("+44") {
+		fmt.Println(c.Alpha2)
+	}
+	// Output:GG
+	// IM
+	// JE
+	// GB
+}
+
+// TestResolvePhonePrefix tests ResolvePhonePrefix with numbers and prefixes of shared calling codes
+func TestResolvePhonePrefix(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		expected  string
+		expectNil bool
+	}{
+		{name: "American Samoa", input: testPhoneNumber, expected: "AS"},
+		{name: "Guam", input: "+1 (671) 555-0100", expected: "GU"},
+		{name: "Puerto Rico", input: "+1-787-555-0100", expected: "PR"},
+		{name: "Puerto Rico overlay", input: "+19395550100", expected: "PR"},
+		{name: "Canada", input: "+1 416 555 0100", expected: "CA"},
+		{name: "United States", input: "+1 212 555 0100", expected: testCountryAlpha2},
+		{name: "Toll free defaults to main", input: "+1 800 555 0100", expected: testCountryAlpha2},
+		{name: "Calling code only", input: "+1", expected: testCountryAlpha2},
+		{name: "Kazakhstan", input: "+7 701 123 4567", expected: "KZ"},
+		{name: "Russia", input: "+7 495 123 4567", expected: "RU"},
+		{name: "Jersey", input: "+44 1534 123456", expected: "JE"},
+		{name: "International prefix", input: "0044 20 7946 0000", expected: "GB"},
+		{name: "Vatican", input: "+39 06 698 12345", expected: "VA"},
+		{name: "Italy", input: "+39 06 1234 5678", expected: "IT"},
+		{name: "Aland", input: "+358 18 12345", expected: "AX"},
+		{name: "Dotted", input: "+49.30.123456", expected: "DE"},
+		{name: "No digits", input: "+", expectNil: true},
+		{name: "Unassigned", input: "+999 123", expectNil: true},
+		{name: "Empty", input: "", expectNil: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := ResolvePhonePrefix(tt.input)
+			if tt.expectNil {
+				require.Nil(t, c)
+				return
+			}
+
+			require.NotNil(t, c)
+			assert.Equal(t, tt.expected, c.Alpha2)
+		})
+	}
+}
+
+// ExampleResolvePhonePrefix is an example of ResolvePhonePrefix()
+func ExampleResolvePhonePrefix() {
+	c := ResolvePhonePrefix(testPhoneNumber)
+	fmt.Printf("country: %s", c.Name)
+	// Output:country: American Samoa
+}
+
+// BenchmarkResolvePhonePrefix benchmarks the method ResolvePhonePrefix()
+func BenchmarkResolvePhonePrefix(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_ = ResolvePhonePrefix(testPhoneNumber)
+	}
+}
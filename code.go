@@ -0,0 +1,277 @@
+package countries
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrUnknownCode is returned when a Code cannot be parsed or unmarshaled from a value
+var ErrUnknownCode = errors.New("unknown country")
+
+// CodeError describes a value that is not a known country in the accepted formats, and wraps ErrUnknownCode
+type CodeError struct {
+	Value  string     // Offending value, without surrounding spaces (e.g., "ZZ")
+	Accept CodeFormat // Formats the value was expected in
+}
+
+// CodeFormat is a set of formats accepted when parsing a Code, combined with the | operator
+type CodeFormat uint8
+
+// Formats accepted when parsing a Code
+const (
+	CodeFormatAlpha2  CodeFormat = 1 << iota // ISO 3166-1 alpha-2 code (e.g., "US")
+	CodeFormatAlpha3                         // ISO 3166-1 alpha-3 code (e.g., "USA")
+	CodeFormatNumeric                        // ISO 3166-1 numeric code, with or without leading zeros (e.g., "840")
+	CodeFormatName                           // Name of the country as found by GetByName (e.g., "United States")
+
+	// CodeFormatCodes accepts the alpha-2, alpha-3 and numeric codes
+	CodeFormatCodes = CodeFormatAlpha2 | CodeFormatAlpha3 | CodeFormatNumeric
+
+	// CodeFormatAny accepts the codes and the names
+	CodeFormatAny = CodeFormatCodes | CodeFormatName
+)
+
+// codeFormatNames describes every format in error messages, in the order of the CodeFormat bits
+var codeFormatNames = []string{ //nolint:gochecknoglobals // fixed lookup table
+	"alpha-2 code", "alpha-3 code", "numeric code", "name",
+}
+
+// Code is a Country that marshals as its alpha-2 code, for embedding in API structs and configuration.
+//
+// Code implements encoding.TextMarshaler, encoding.TextUnmarshaler, json.Marshaler and json.Unmarshaler.
+// It marshals as the alpha-2 code ("US"), and unmarshals from the formats in Accept (every format when zero),
+// so a field can be made strict before decoding:
+//
+//	req := Request{Country: countries.Code{Accept: countries.CodeFormatAlpha2}}
+//	err := json.Unmarshal(data, &req)
+type Code struct {
+	Country *Country   // Country of the code, or nil for the zero Code
+	Accept  CodeFormat // Formats accepted when unmarshaling, every format when zero
+}
+
+// ParseCode parses a country from a code or name in the accepted formats.
+//
+// This function performs the following steps:
+// - Trims surrounding spaces
+// - Tries GetByAlpha2 for two characters, GetByAlpha3 for three characters and GetByCountryCode for up to three digits
+// (padded with leading zeros), when their format is accepted
+// - Falls back to GetByName when names are accepted
+//
+// Parameters:
+// - s: code or name of the country (e.g., "US", "usa", "840", "United States")
+// - accept: accepted formats (e.g., CodeFormatAlpha2, CodeFormatCodes), or 0 for every format
+//
+// Returns:
+// - Code of the country with the accepted formats, or a *CodeError wrapping ErrUnknownCode that lists them
+//
+// Side Effects:
+// - None
+//
+// Notes:
+// - Codes are matched in any letter case, and names ignoring case, diacritics and punctuation
+func ParseCode(s string, accept CodeFormat) (Code, error) {
+	if accept &= CodeFormatAny; accept == 0 {
+		accept = CodeFormatAny
+	}
+
+	s = strings.TrimSpace(s)
+	var c *Country
+	switch {
+	case accept&CodeFormatAlpha2 != 0 && len(s) == 2 && !isDigits(s):
+		c = GetByAlpha2(s)
+	case accept&CodeFormatAlpha3 != 0 && len(s) == 3 && !isDigits(s):
+		c = GetByAlpha3(s)
+	case accept&CodeFormatNumeric != 0 && len(s) <= 3 && isDigits(s):
+		c = GetByCountryCode(strings.Repeat("0", 3-len(s)) + s)
+	}
+	if c == nil && accept&CodeFormatName != 0 {
+		c = GetByName(s)
+	}
+
+	if c == nil {
+		return Code{Accept: accept}, &CodeError{Value: s, Accept: accept}
+	}
+	return Code{Country: c, Accept: accept}, nil
+}
+
+// String returns the alpha-2 code of the Code.
+//
+// Parameters:
+// - None
+//
+// Returns:
+// - Alpha-2 code (e.g., "US"), or an empty string for the zero Code
+//
+// Side Effects:
+// - None
+func (c Code) String() string {
+	if c.Country == nil {
+		return ""
+	}
+	return c.Country.Alpha2
+}
+
+// IsZero reports whether the Code has no country, so that the omitzero JSON option leaves it out.
+//
+// Parameters:
+// - None
+//
+// Returns:
+// - true when the Country is nil
+//
+// Side Effects:
+// - None
+func (c Code) IsZero() bool {
+	return c.Country == nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+//
+// Parameters:
+// - None
+//
+// Returns:
+// - Alpha-2 code (e.g., "US"), or empty text for the zero Code
+// - Always a nil error
+//
+// Side Effects:
+// - None
+func (c Code) MarshalText() ([]byte, error) {
+	return []byte(c.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+//
+// Parameters:
+// - text: code or name of the country in the formats of Accept (e.g., "US", "USA", "840")
+//
+// Returns:
+// - An error wrapping ErrUnknownCode when the text is not a known country in the accepted formats
+//
+// Side Effects:
+// - Sets the Country of the Code, or sets it to nil for empty text
+//
+// Notes:
+// - Accept is kept, so decoding into a prepared Code enforces its formats
+func (c *Code) UnmarshalText(text []byte) error {
+	if len(bytes.TrimSpace(text)) == 0 {
+		c.Country = nil
+		return nil
+	}
+
+	code, err := ParseCode(string(text), c.Accept)
+	if err != nil {
+		return err
+	}
+	c.Country = code.Country
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+//
+// Parameters:
+// - None
+//
+// Returns:
+// - JSON string of the alpha-2 code (e.g., "US"), or null for the zero Code
+// - Always a nil error
+//
+// Side Effects:
+// - None
+func (c Code) MarshalJSON() ([]byte, error) {
+	if c.Country == nil {
+		return []byte("null"), nil
+	}
+	return json.Marshal(c.Country.Alpha2)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+//
+// This function performs the following steps:
+// - Ignores null, like the encoding/json package does for other types
+// - Reads numbers as numeric codes (e.g., 840) and strings like UnmarshalText
+//
+// Parameters:
+// - data: JSON string or number
+//
+// Returns:
+// - An error wrapping ErrUnknownCode when the value is not a known country in the accepted formats,
+// or the error of the JSON decoder when the value is neither a string nor a number
+//
+// Side Effects:
+// - Sets the Country of the Code, or sets it to nil for an empty string
+func (c *Code) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+
+	var text string
+	if len(data) > 0 && data[0] != '"' {
+		var number json.Number
+		if err := json.Unmarshal(data, &number); err != nil {
+			return err
+		}
+		text = number.String()
+	} else if err := json.Unmarshal(data, &text); err != nil {
+		return err
+	}
+	return c.UnmarshalText([]byte(text))
+}
+
+// Error describes the offending value and the accepted formats.
+//
+// Parameters:
+// - None
+//
+// Returns:
+// - Message such as `unknown country: "ZZ" is not a known alpha-2 code`
+//
+// Side Effects:
+// - None
+func (e *CodeError) Error() string {
+	return fmt.Sprintf("%s: %q is not a known %s", ErrUnknownCode, e.Value, e.Accept)
+}
+
+// Unwrap returns ErrUnknownCode, so that errors.Is(err, ErrUnknownCode) matches every CodeError.
+//
+// Parameters:
+// - None
+//
+// Returns:
+// - ErrUnknownCode
+//
+// Side Effects:
+// - None
+func (e *CodeError) Unwrap() error {
+	return ErrUnknownCode
+}
+
+// String describes the accepted formats (e.g., "alpha-2 code or alpha-3 code").
+//
+// Parameters:
+// - None
+//
+// Returns:
+// - Names of the formats joined with commas and "or", every format when no known format is set
+//
+// Side Effects:
+// - None
+func (f CodeFormat) String() string {
+	if f &= CodeFormatAny; f == 0 {
+		f = CodeFormatAny
+	}
+
+	var formats []string
+	for i, name := range codeFormatNames {
+		if f&(1<<i) != 0 {
+			formats = append(formats, name)
+		}
+	}
+	if len(formats) == 1 {
+		return formats[0]
+	}
+	return strings.Join(formats[:len(formats)-1], ", ") + " or " + formats[len(formats)-1]
+}
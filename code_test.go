@@ -0,0 +1,228 @@
+package countries
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestParseCode tests ParseCode with different values and formats
+func TestParseCode(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		accept   CodeFormat
+		expected string
+	}{
+		{name: "Alpha-2", input: "US", accept: CodeFormatAlpha2, expected: "US"},
+		{name: "Lowercase alpha-2", input: "us", accept: CodeFormatAlpha2, expected: "US"},
+		{name: "Alpha-3", input: "USA", accept: CodeFormatAlpha3, expected: "US"},
+		{name: "Lowercase alpha-3", input: " mex ", accept: CodeFormatCodes, expected: "MX"},
+		{name: "Numeric", input: "840", accept: CodeFormatNumeric, expected: "US"},
+		{name: "Numeric without leading zeros", input: "40", accept: CodeFormatCodes, expected: "AT"},
+		{name: "Numeric with leading zeros", input: "004", accept: CodeFormatCodes, expected: "AF"},
+		{name: "Name", input: "United States", accept: CodeFormatName, expected: "US"},
+		{name: "Name without diacritics", input: "Cote d'Ivoire", accept: CodeFormatAny, expected: "CI"},
+		{name: "Alias of three letters", input: "UAE", accept: CodeFormatAny, expected: "AE"},
+		{name: "Alias of two letters", input: "UK", accept: CodeFormatAny, expected: "GB"},
+		{name: "Every format by default", input: "Mexico", accept: 0, expected: "MX"},
+		{name: "Alpha-3 not accepted", input: "USA", accept: CodeFormatAlpha2},
+		{name: "Alpha-2 not accepted", input: "US", accept: CodeFormatAlpha3 | CodeFormatNumeric},
+		{name: "Name not accepted", input: "Mexico", accept: CodeFormatCodes},
+		{name: "Numeric not accepted", input: "840", accept: CodeFormatAlpha2 | CodeFormatAlpha3},
+		{name: "Unassigned alpha-2", input: "ZZ", accept: CodeFormatAny},
+		{name: "Unassigned numeric", input: "999", accept: CodeFormatAny},
+		{name: "Too many digits", input: "0840", accept: CodeFormatAny},
+		{name: "Unknown name", input: "Atlantis", accept: CodeFormatAny},
+		{name: "Empty", input: "", accept: CodeFormatAny},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, err := ParseCode(tt.input, tt.accept)
+			if tt.expected == "" {
+				require.ErrorIs(t, err, ErrUnknownCode)
+				assert.True(t, code.IsZero())
+				return
+			}
+			require.NoError(t, err)
+			assert.Same(t, GetByAlpha2(tt.expected), code.Country)
+			assert.Equal(t, tt.expected, code.String())
+		})
+	}
+}
+
+// TestParseCode_Error tests that errors describe the value and the accepted formats
+func TestParseCode_Error(t *testing.T) {
+	_, err := ParseCode("USA", CodeFormatAlpha2)
+	require.EqualError(t, err, `unknown country: "USA" is not a known alpha-2 code`)
+
+	_, err = ParseCode("Atlantis", 0)
+	require.EqualError(t, err, `unknown country: "Atlantis" is not a known alpha-2 code, alpha-3 code, numeric code or name`)
+}
+
+// TestCodeFormat_String tests the description of the accepted formats
+func TestCodeFormat_String(t *testing.T) {
+	assert.Equal(t, "alpha-2 code", CodeFormatAlpha2.String())
+	assert.Equal(t, "alpha-2 code or name", (CodeFormatAlpha2 | CodeFormatName).String())
+	assert.Equal(t, "alpha-2 code, alpha-3 code or numeric code", CodeFormatCodes.String())
+	assert.Equal(t, CodeFormatAny.String(), CodeFormat(0).String())
+	assert.Equal(t, CodeFormatAny.String(), CodeFormat(1<<7).String())
+}
+
+// TestCode_JSON tests marshaling and unmarshaling a Code in a struct
+func TestCode_JSON(t *testing.T) {
+	type request struct {
+		Country Code `json:"country"`
+	}
+
+	t.Run("marshals as the alpha-2 code", func(t *testing.T) {
+		data, err := json.Marshal(request{Country: Code{Country: GetByAlpha2("DE")}})
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"country":"DE"}`, string(data))
+	})
+
+	t.Run("marshals the zero Code as null", func(t *testing.T) {
+		data, err := json.Marshal(request{})
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"country":null}`, string(data))
+	})
+
+	t.Run("round-trips", func(t *testing.T) {
+		for _, c := range GetAll() {
+			data, err := json.Marshal(Code{Country: c})
+			require.NoError(t, err)
+
+			var code Code
+			require.NoError(t, json.Unmarshal(data, &code))
+			assert.Same(t, c, code.Country)
+		}
+	})
+
+	unmarshalTests := []struct {
+		name     string
+		input    string
+		accept   CodeFormat
+		expected string
+	}{
+		{name: "Alpha-2", input: `"us"`, expected: "US"},
+		{name: "Alpha-3", input: `"DEU"`, expected: "DE"},
+		{name: "Numeric string", input: `"276"`, expected: "DE"},
+		{name: "Numeric number", input: `276`, expected: "DE"},
+		{name: "Name", input: `"Germany"`, expected: "DE"},
+		{name: "Strict alpha-2", input: `"DE"`, accept: CodeFormatAlpha2, expected: "DE"},
+		{name: "Null", input: `null`},
+		{name: "Empty string", input: `""`},
+	}
+	for _, tt := range unmarshalTests {
+		t.Run("unmarshals "+tt.name, func(t *testing.T) {
+			var req request
+			req.Country.Accept = tt.accept
+			require.NoError(t, json.Unmarshal([]byte(`{"country":`+tt.input+`}`), &req))
+			if tt.expected == "" {
+				assert.Nil(t, req.Country.Country)
+				return
+			}
+			assert.Same(t, GetByAlpha2(tt.expected), req.Country.Country)
+			assert.Equal(t, tt.accept, req.Country.Accept)
+		})
+	}
+
+	errorTests := []struct {
+		name   string
+		input  string
+		accept CodeFormat
+	}{
+		{name: "Unknown code", input: `"ZZ"`},
+		{name: "Unknown number", input: `999`},
+		{name: "Decimal number", input: `276.0`},
+		{name: "Strict alpha-2", input: `"DEU"`, accept: CodeFormatAlpha2},
+		{name: "Strict codes", input: `"Germany"`, accept: CodeFormatCodes},
+	}
+	for _, tt := range errorTests {
+		t.Run("fails to unmarshal "+tt.name, func(t *testing.T) {
+			var req request
+			req.Country.Accept = tt.accept
+			err := json.Unmarshal([]byte(`{"country":`+tt.input+`}`), &req)
+			require.ErrorIs(t, err, ErrUnknownCode)
+		})
+	}
+
+	t.Run("fails to unmarshal other JSON types", func(t *testing.T) {
+		var req request
+		require.Error(t, json.Unmarshal([]byte(`{"country":true}`), &req))
+		require.Error(t, json.Unmarshal([]byte(`{"country":{"alpha-2":"DE"}}`), &req))
+	})
+
+	t.Run("null keeps the Code", func(t *testing.T) {
+		code := Code{Country: GetByAlpha2("DE")}
+		require.NoError(t, json.Unmarshal([]byte(`null`), &code))
+		assert.Equal(t, "DE", code.String())
+	})
+}
+
+// TestCode_Text tests marshaling and unmarshaling a Code as text, including as a map key
+func TestCode_Text(t *testing.T) {
+	data, err := json.Marshal(map[Code]int{{Country: GetByAlpha2("FR")}: 1})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"FR":1}`, string(data))
+
+	var populations map[Code]int
+	require.NoError(t, json.Unmarshal([]byte(`{"France":1,"ESP":2}`), &populations))
+	assert.Equal(t, map[Code]int{{Country: GetByAlpha2("FR")}: 1, {Country: GetByAlpha2("ES")}: 2}, populations)
+
+	var code Code
+	require.NoError(t, code.UnmarshalText([]byte("jp")))
+	assert.Equal(t, "JP", code.String())
+	text, err := code.MarshalText()
+	require.NoError(t, err)
+	assert.Equal(t, "JP", string(text))
+
+	require.NoError(t, code.UnmarshalText([]byte(" ")))
+	assert.True(t, code.IsZero())
+	require.ErrorIs(t, code.UnmarshalText([]byte("Atlantis")), ErrUnknownCode)
+}
+
+// ExampleCode is an example of marshaling and unmarshaling a Code
+func ExampleCode() {
+	var order struct {
+		Destination Code `json:"destination"`
+	}
+	_ = json.Unmarshal([]byte(`{"destination":"DEU"}`), &order)
+	fmt.Println(order.Destination.Country.Name)
+
+	data, _ := json.Marshal(order)
+	fmt.Println(string(data))
+	// Output:Germany
+	// {"destination":"DE"}
+}
+
+// ExampleParseCode is an example of ParseCode()
+func ExampleParseCode() {
+	code, _ := ParseCode("840", CodeFormatCodes)
+	fmt.Println(code)
+
+	_, err := ParseCode("United States", CodeFormatCodes)
+	fmt.Println(err)
+	// Output:US
+	// unknown country: "United States" is not a known alpha-2 code, alpha-3 code or numeric code
+}
+
+// BenchmarkParseCode benchmarks the method ParseCode()
+func BenchmarkParseCode(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_, _ = ParseCode("USA", CodeFormatAny)
+	}
+}
+
+// BenchmarkCode_UnmarshalJSON benchmarks the method Code.UnmarshalJSON()
+func BenchmarkCode_UnmarshalJSON(b *testing.B) {
+	data := []byte(`"US"`)
+	for i := 0; i < b.N; i++ {
+		var code Code
+		_ = code.UnmarshalJSON(data)
+	}
+}
@@ -0,0 +1,379 @@
+package countries
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Alpha2 is an ISO 3166-1 alpha-2 code in uppercase (e.g., "US"); the Alpha2 constants list every assigned code
+type Alpha2 string
+
+// Alpha3 is an ISO 3166-1 alpha-3 code in uppercase (e.g., "USA"); the Alpha3 constants list every assigned code
+type Alpha3 string
+
+// Numeric is an ISO 3166-1 numeric code (e.g., 840 for "840"); the Numeric constants list every assigned code
+type Numeric uint16
+
+// String returns the alpha-2 code as a string.
+//
+// Parameters:
+// - None
+//
+// Returns:
+// - The code (e.g., "US")
+//
+// Side Effects:
+// - None
+func (a Alpha2) String() string {
+	return string(a)
+}
+
+// Valid reports whether the code is an assigned ISO 3166-1 alpha-2 code.
+//
+// Parameters:
+// - None
+//
+// Returns:
+// - true when a country has the code, false otherwise
+//
+// Side Effects:
+// - None
+//
+// Notes:
+// - Codes must be uppercase, like the Alpha2 constants; convert other input with GetByAlpha2
+func (a Alpha2) Valid() bool {
+	return a.Country() != nil
+}
+
+// Country returns the Country with the alpha-2 code.
+//
+// Parameters:
+// - None
+//
+// Returns:
+// - Pointer to the Country struct, or nil when the code is not assigned
+//
+// Side Effects:
+// - None
+//
+// Notes:
+// - Returned pointer references package-level data without copying
+func (a Alpha2) Country() *Country {
+	return byAlpha2[string(a)]
+}
+
+// ToAlpha3 converts the alpha-2 code to the alpha-3 code of the same country.
+//
+// Parameters:
+// - None
+//
+// Returns:
+// - Alpha-3 code (e.g., Alpha3USA for Alpha2US), or an empty code when the alpha-2 code is not assigned
+//
+// Side Effects:
+// - None
+func (a Alpha2) ToAlpha3() Alpha3 {
+	if c := a.Country(); c != nil {
+		return c.Alpha3Code()
+	}
+	return ""
+}
+
+// ToNumeric converts the alpha-2 code to the numeric code of the same country.
+//
+// Parameters:
+// - None
+//
+// Returns:
+// - Numeric code (e.g., NumericUS for Alpha2US), or 0 when the alpha-2 code is not assigned
+//
+// Side Effects:
+// - None
+func (a Alpha2) ToNumeric() Numeric {
+	if c := a.Country(); c != nil {
+		return c.NumericCode()
+	}
+	return 0
+}
+
+// String returns the alpha-3 code as a string.
+//
+// Parameters:
+// - None
+//
+// Returns:
+// - The code (e.g., "USA")
+//
+// Side Effects:
+// - None
+func (a Alpha3) String() string {
+	return string(a)
+}
+
+// Valid reports whether the code is an assigned ISO 3166-1 alpha-3 code.
+//
+// Parameters:
+// - None
+//
+// Returns:
+// - true when a country has the code, false otherwise
+//
+// Side Effects:
+// - None
+//
+// Notes:
+// - Codes must be uppercase, like the Alpha3 constants; convert other input with GetByAlpha3
+func (a Alpha3) Valid() bool {
+	return a.Country() != nil
+}
+
+// Country returns the Country with the alpha-3 code.
+//
+// Parameters:
+// - None
+//
+// Returns:
+// - Pointer to the Country struct, or nil when the code is not assigned
+//
+// Side Effects:
+// - None
+//
+// Notes:
+// - Returned pointer references package-level data without copying
+func (a Alpha3) Country() *Country {
+	return byAlpha3[string(a)]
+}
+
+// ToAlpha2 converts the alpha-3 code to the alpha-2 code of the same country.
+//
+// Parameters:
+// - None
+//
+// Returns:
+// - Alpha-2 code (e.g., Alpha2MX for Alpha3MEX), or an empty code when the alpha-3 code is not assigned
+//
+// Side Effects:
+// - None
+func (a Alpha3) ToAlpha2() Alpha2 {
+	if c := a.Country(); c != nil {
+		return c.Alpha2Code()
+	}
+	return ""
+}
+
+// ToNumeric converts the alpha-3 code to the numeric code of the same country.
+//
+// Parameters:
+// - None
+//
+// Returns:
+// - Numeric code (e.g., NumericMX for Alpha3MEX), or 0 when the alpha-3 code is not assigned
+//
+// Side Effects:
+// - None
+func (a Alpha3) ToNumeric() Numeric {
+	if c := a.Country(); c != nil {
+		return c.NumericCode()
+	}
+	return 0
+}
+
+// String returns the numeric code as a string of three digits.
+//
+// Parameters:
+// - None
+//
+// Returns:
+// - The code padded with leading zeros (e.g., "004" for Afghanistan, "840" for the United States)
+//
+// Side Effects:
+// - None
+//
+// Notes:
+// - The result matches the CountryCode field of the Country
+func (n Numeric) String() string {
+	s := strconv.Itoa(int(n))
+	if len(s) < 3 {
+		s = strings.Repeat("0", 3-len(s)) + s
+	}
+	return s
+}
+
+// Valid reports whether the code is an assigned ISO 3166-1 numeric code.
+//
+// Parameters:
+// - None
+//
+// Returns:
+// - true when a country has the code, false otherwise
+//
+// Side Effects:
+// - None
+func (n Numeric) Valid() bool {
+	return n.Country() != nil
+}
+
+// Country returns the Country with the numeric code.
+//
+// Parameters:
+// - None
+//
+// Returns:
+// - Pointer to the Country struct, or nil when the code is not assigned
+//
+// Side Effects:
+// - None
+//
+// Notes:
+// - Returned pointer references package-level data without copying
+func (n Numeric) Country() *Country {
+	return byCode[n.String()]
+}
+
+// ToAlpha2 converts the numeric code to the alpha-2 code of the same country.
+//
+// Parameters:
+// - None
+//
+// Returns:
+// - Alpha-2 code (e.g., Alpha2US for NumericUS), or an empty code when the numeric code is not assigned
+//
+// Side Effects:
+// - None
+func (n Numeric) ToAlpha2() Alpha2 {
+	if c := n.Country(); c != nil {
+		return c.Alpha2Code()
+	}
+	return ""
+}
+
+// ToAlpha3 converts the numeric code to the alpha-3 code of the same country.
+//
+// Parameters:
+// - None
+//
+// Returns:
+// - Alpha-3 code (e.g., Alpha3USA for NumericUS), or an empty code when the numeric code is not assigned
+//
+// Side Effects:
+// - None
+func (n Numeric) ToAlpha3() Alpha3 {
+	if c := n.Country(); c != nil {
+		return c.Alpha3Code()
+	}
+	return ""
+}
+
+// Alpha2Code returns the alpha-2 code of the Country as a typed code.
+//
+// Parameters:
+// - None
+//
+// Returns:
+// - Alpha-2 code (e.g., Alpha2US), comparable with the Alpha2 constants
+//
+// Side Effects:
+// - None
+//
+// Notes:
+// - Equivalent to Alpha2(c.Alpha2); the Alpha2 field stays a string for JSON and existing callers
+func (c *Country) Alpha2Code() Alpha2 {
+	return Alpha2(c.Alpha2)
+}
+
+// Alpha3Code returns the alpha-3 code of the Country as a typed code.
+//
+// Parameters:
+// - None
+//
+// Returns:
+// - Alpha-3 code (e.g., Alpha3USA), comparable with the Alpha3 constants
+//
+// Side Effects:
+// - None
+//
+// Notes:
+// - Equivalent to Alpha3(c.Alpha3); the Alpha3 field stays a string for JSON and existing callers
+func (c *Country) Alpha3Code() Alpha3 {
+	return Alpha3(c.Alpha3)
+}
+
+// NumericCode returns the numeric code of the Country as a typed code.
+//
+// Parameters:
+// - None
+//
+// Returns:
+// - Numeric code (e.g., NumericUS for the CountryCode "840"), comparable with the Numeric constants
+//
+// Side Effects:
+// - None
+//
+// Notes:
+// - The CountryCode field stays a zero-padded string for JSON and existing callers
+func (c *Country) NumericCode() Numeric {
+	return numericOf(c)
+}
+
+// GetByAlpha2Code retrieves a Country by its typed alpha-2 code.
+//
+// Parameters:
+// - code: alpha-2 code, usually one of the Alpha2 constants (e.g., Alpha2US)
+//
+// Returns:
+// - Pointer to the Country struct, or nil when the code is not assigned
+//
+// Side Effects:
+// - None
+//
+// Notes:
+// - Unlike GetByAlpha2, the lookup is case-sensitive since typed codes are uppercase
+// - Returned pointer references package-level data without copying
+func GetByAlpha2Code(code Alpha2) *Country {
+	return code.Country()
+}
+
+// GetByAlpha3Code retrieves a Country by its typed alpha-3 code.
+//
+// Parameters:
+// - code: alpha-3 code, usually one of the Alpha3 constants (e.g., Alpha3USA)
+//
+// Returns:
+// - Pointer to the Country struct, or nil when the code is not assigned
+//
+// Side Effects:
+// - None
+//
+// Notes:
+// - Unlike GetByAlpha3, the lookup is case-sensitive since typed codes are uppercase
+// - Returned pointer references package-level data without copying
+func GetByAlpha3Code(code Alpha3) *Country {
+	return code.Country()
+}
+
+// GetByNumeric retrieves a Country by its typed numeric code.
+//
+// Parameters:
+// - code: numeric code, usually one of the Numeric constants (e.g., NumericUS)
+//
+// Returns:
+// - Pointer to the Country struct, or nil when the code is not assigned
+//
+// Side Effects:
+// - None
+//
+// Notes:
+// - Equivalent to GetByCountryCode(code.String()), without padding the code by hand
+// - Returned pointer references package-level data without copying
+func GetByNumeric(code Numeric) *Country {
+	return code.Country()
+}
+
+// numericOf parses the numeric code of a Country, or returns 0 when it is not a number
+func numericOf(c *Country) Numeric {
+	n, err := strconv.ParseUint(c.CountryCode, 10, 16)
+	if err != nil {
+		return 0
+	}
+	return Numeric(n)
+}
@@ -0,0 +1,175 @@
+package countries
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestCodes_RoundTrip tests that the typed codes of every country convert to each other
+func TestCodes_RoundTrip(t *testing.T) {
+	for _, c := range GetAll() {
+		alpha2, alpha3, numeric := c.Alpha2Code(), c.Alpha3Code(), c.NumericCode()
+
+		require.True(t, alpha2.Valid(), c.Alpha2)
+		require.True(t, alpha3.Valid(), c.Alpha3)
+		require.True(t, numeric.Valid(), c.CountryCode)
+
+		assert.Same(t, c, alpha2.Country())
+		assert.Same(t, c, alpha3.Country())
+		assert.Same(t, c, numeric.Country())
+		assert.Equal(t, c.Alpha2, alpha2.String())
+		assert.Equal(t, c.Alpha3, alpha3.String())
+		assert.Equal(t, numeric, alpha2.ToNumeric())
+		assert.Equal(t, alpha3, alpha2.ToAlpha3())
+		assert.Equal(t, numeric, alpha3.ToNumeric())
+		assert.Equal(t, alpha2, alpha3.ToAlpha2())
+		assert.Equal(t, alpha2, numeric.ToAlpha2())
+		assert.Equal(t, alpha3, numeric.ToAlpha3())
+		assert.Equal(t, c.CountryCode, numeric.String())
+	}
+}
+
+// TestCodes_Constants tests the typed constants of a few countries
+func TestCodes_Constants(t *testing.T) {
+	assert.Equal(t, Alpha3USA, Alpha2US.ToAlpha3())
+	assert.Equal(t, NumericUS, Alpha2US.ToNumeric())
+	assert.Equal(t, Alpha2MX, Alpha3MEX.ToAlpha2())
+	assert.Equal(t, NumericMX, Alpha3MEX.ToNumeric())
+	assert.Equal(t, Numeric(4), NumericAF)
+	assert.Equal(t, "004", NumericAF.String())
+	assert.Equal(t, Alpha3AFG, NumericAF.ToAlpha3())
+}
+
+// TestCodes_Invalid tests the typed codes that are not assigned
+func TestCodes_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		alpha2  Alpha2
+		alpha3  Alpha3
+		numeric Numeric
+	}{
+		{name: "Unassigned", alpha2: "ZZ", alpha3: "ZZZ", numeric: 999},
+		{name: "Lowercase", alpha2: "us", alpha3: "usa"},
+		{name: "Wrong length", alpha2: "USA", alpha3: "US", numeric: 8400},
+		{name: "Empty", alpha2: "", alpha3: "", numeric: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.False(t, tt.alpha2.Valid())
+			assert.Nil(t, tt.alpha2.Country())
+			assert.Nil(t, GetByAlpha2Code(tt.alpha2))
+			assert.Empty(t, tt.alpha2.ToAlpha3())
+			assert.Zero(t, tt.alpha2.ToNumeric())
+
+			assert.False(t, tt.alpha3.Valid())
+			assert.Nil(t, tt.alpha3.Country())
+			assert.Nil(t, GetByAlpha3Code(tt.alpha3))
+			assert.Empty(t, tt.alpha3.ToAlpha2())
+			assert.Zero(t, tt.alpha3.ToNumeric())
+
+			assert.False(t, tt.numeric.Valid())
+			assert.Nil(t, tt.numeric.Country())
+			assert.Nil(t, GetByNumeric(tt.numeric))
+			assert.Empty(t, tt.numeric.ToAlpha2())
+			assert.Empty(t, tt.numeric.ToAlpha3())
+		})
+	}
+}
+
+// TestNumeric_String tests the zero padding of numeric codes
+func TestNumeric_String(t *testing.T) {
+	assert.Equal(t, "000", Numeric(0).String())
+	assert.Equal(t, "040", NumericAT.String())
+	assert.Equal(t, "840", NumericUS.String())
+	assert.Equal(t, "65535", Numeric(65535).String())
+}
+
+// TestGetByTypedCodes tests GetByAlpha2Code, GetByAlpha3Code and GetByNumeric
+func TestGetByTypedCodes(t *testing.T) {
+	us := GetByAlpha2(testCountryAlpha2)
+	require.NotNil(t, us)
+
+	assert.Same(t, us, GetByAlpha2Code(Alpha2US))
+	assert.Same(t, us, GetByAlpha3Code(Alpha3USA))
+	assert.Same(t, us, GetByNumeric(NumericUS))
+	assert.Same(t, GetByCountryCode("040"), GetByNumeric(NumericAT))
+
+	// GetByAlpha2 and GetByAlpha3 accept the typed constants as well as strings
+	assert.Same(t, us, GetByAlpha2(Alpha2US))
+	assert.Same(t, us, GetByAlpha3(Alpha3USA))
+	assert.Same(t, us, GetByAlpha3("usa"))
+	assert.Nil(t, GetByAlpha2(Alpha2("ZZ")))
+}
+
+// TestCountry_TypedCodes tests the Alpha2Code, Alpha3Code and NumericCode accessors
+func TestCountry_TypedCodes(t *testing.T) {
+	tests := []struct {
+		name    string
+		country *Country
+		alpha2  Alpha2
+		alpha3  Alpha3
+		numeric Numeric
+	}{
+		{
+			name: "United States", country: GetByAlpha2(testCountryAlpha2),
+			alpha2: Alpha2US, alpha3: Alpha3USA, numeric: NumericUS,
+		},
+		{
+			name: "Zero padded numeric", country: GetByCountryCode("004"),
+			alpha2: Alpha2AF, alpha3: Alpha3AFG, numeric: NumericAF,
+		},
+		{name: "Zero value", country: &Country{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.NotNil(t, tt.country)
+			assert.Equal(t, tt.alpha2, tt.country.Alpha2Code())
+			assert.Equal(t, tt.alpha3, tt.country.Alpha3Code())
+			assert.Equal(t, tt.numeric, tt.country.NumericCode())
+		})
+	}
+}
+
+// ExampleCountry_Alpha2Code is an example of Country.Alpha2Code()
+func ExampleCountry_Alpha2Code() {
+	c := GetByAlpha2(Alpha2MX)
+	fmt.Println(c.Alpha2Code() == Alpha2MX, c.Alpha3Code(), c.NumericCode())
+	// Output:true MEX 484
+}
+
+// ExampleAlpha2_ToAlpha3 is an example of Alpha2.ToAlpha3()
+func ExampleAlpha2_ToAlpha3() {
+	fmt.Println(Alpha2US.ToAlpha3())
+	fmt.Println(Alpha2US.ToNumeric())
+	fmt.Println(Alpha2("ZZ").Valid())
+	// Output:USA
+	// 840
+	// false
+}
+
+// ExampleGetByNumeric is an example of GetByNumeric()
+func ExampleGetByNumeric() {
+	fmt.Println(GetByNumeric(NumericAT).Name)
+	fmt.Println(NumericAT)
+	// Output:Austria
+	// 040
+}
+
+// BenchmarkAlpha2_ToAlpha3 benchmarks the method Alpha2.ToAlpha3()
+func BenchmarkAlpha2_ToAlpha3(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_ = Alpha2US.ToAlpha3()
+	}
+}
+
+// BenchmarkGetByNumeric benchmarks the method GetByNumeric()
+func BenchmarkGetByNumeric(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_ = GetByNumeric(NumericUS)
+	}
+}
@@ -0,0 +1,144 @@
+package countries
+
+import (
+	"math"
+	"sort"
+)
+
+// earthRadiusKm is the mean radius of the Earth in kilometers (IUGG)
+const earthRadiusKm = 6371.0088
+
+// Coordinates is a geographic location in decimal degrees (WGS 84)
+type Coordinates struct {
+	Latitude  float64 `json:"latitude"`  // Latitude in decimal degrees, positive north of the equator
+	Longitude float64 `json:"longitude"` // Longitude in decimal degrees, positive east of Greenwich
+}
+
+// BoundingBox is the smallest box containing a territory, with edges in decimal degrees
+//
+// The West edge is greater than the East edge when the box crosses the antimeridian (e.g., Russia, Fiji).
+type BoundingBox struct {
+	East  float64 `json:"east"`  // Easternmost longitude
+	North float64 `json:"north"` // Northernmost latitude
+	South float64 `json:"south"` // Southernmost latitude
+	West  float64 `json:"west"`  // Westernmost longitude
+}
+
+// DistanceTo calculates the great-circle distance between the centroids of two countries.
+//
+// This function performs the following steps:
+// - Reads the Centroid of both countries
+// - Computes the great-circle distance with the haversine formula on a spherical Earth
+//
+// Parameters:
+// - other: country to measure the distance to, which must not be nil
+//
+// Returns:
+// - Distance in kilometers (0 when both countries are the same)
+//
+// Side Effects:
+// - None
+//
+// Notes:
+// - The spherical model is within 0.5% of the distance on the WGS 84 ellipsoid
+// - Centroids are representative points, so neighbouring countries are never 0 km apart;
+// use CapitalCoordinates.DistanceTo for the distance between capitals
+func (c *Country) DistanceTo(other *Country) float64 {
+	return c.Centroid.DistanceTo(other.Centroid)
+}
+
+// DistanceTo calculates the great-circle distance between two locations in kilometers.
+//
+// Parameters:
+// - other: location to measure the distance to
+//
+// Returns:
+// - Distance in kilometers, using the haversine formula on a spherical Earth
+//
+// Side Effects:
+// - None
+func (c Coordinates) DistanceTo(other Coordinates) float64 {
+	lat1, lat2 := radians(c.Latitude), radians(other.Latitude)
+	dLat := lat2 - lat1
+	dLon := radians(other.Longitude - c.Longitude)
+
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLon/2)*math.Sin(dLon/2)
+	return 2 * earthRadiusKm * math.Asin(math.Sqrt(math.Min(h, 1)))
+}
+
+// Contains reports whether the location is inside the bounding box, edges included.
+//
+// Parameters:
+// - location: location to check
+//
+// Returns:
+// - True when the location is within the latitude and longitude range of the box
+//
+// Side Effects:
+// - None
+//
+// Notes:
+// - Boxes crossing the antimeridian (West greater than East) are handled
+func (b BoundingBox) Contains(location Coordinates) bool {
+	if location.Latitude < b.South || location.Latitude > b.North {
+		return false
+	}
+	if b.West <= b.East {
+		return location.Longitude >= b.West && location.Longitude <= b.East
+	}
+	return location.Longitude >= b.West || location.Longitude <= b.East
+}
+
+// NearestCountries retrieves the countries whose centroid is the closest to a location.
+//
+// This function performs the following steps:
+// - Validates the latitude and longitude of the location
+// - Computes the great-circle distance from the location to the centroid of every country
+// - Sorts the countries by increasing distance and keeps the first n
+//
+// Parameters:
+// - lat: latitude of the location in decimal degrees, within [-90, 90]
+// - lon: longitude of the location in decimal degrees, within [-180, 180]
+// - n: maximum number of countries to return
+//
+// Returns:
+// - CountryList of at most n countries, nearest first, or nil when n is not positive or the location is invalid
+//
+// Side Effects:
+// - None
+//
+// Notes:
+// - Countries at the same distance keep the order of GetAll
+// - The nearest centroid is not always the country containing the location (e.g., near long borders);
+// use the BoundingBox of the candidates to narrow them down
+// - The Country pointers reference global data but the returned slice is a copy
+func NearestCountries(lat, lon float64, n int) CountryList {
+	if n <= 0 || !(lat >= -90 && lat <= 90) || !(lon >= -180 && lon <= 180) {
+		return nil
+	}
+
+	location := Coordinates{Latitude: lat, Longitude: lon}
+	distances := make([]float64, len(countries))
+	for i, c := range countries {
+		distances[i] = location.DistanceTo(c.Centroid)
+	}
+
+	indices := make([]int, len(countries))
+	for i := range indices {
+		indices[i] = i
+	}
+	sort.SliceStable(indices, func(i, j int) bool {
+		return distances[indices[i]] < distances[indices[j]]
+	})
+
+	nearest := make(CountryList, 0, min(n, len(indices)))
+	for _, i := range indices[:cap(nearest)] {
+		nearest = append(nearest, countries[i])
+	}
+	return nearest
+}
+
+// radians converts an angle in decimal degrees to radians
+func radians(degrees float64) float64 {
+	return degrees * math.Pi / 180
+}
@@ -0,0 +1,197 @@
+package countries
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testLatitude  = 52.52 // Berlin
+	testLongitude = 13.405
+)
+
+// TestCoordinates_Loaded tests that the coordinate data is preloaded and consistent
+func TestCoordinates_Loaded(t *testing.T) {
+	for _, c := range countries {
+		assert.False(t, c.Centroid == Coordinates{}, "country %s has no centroid", c.Alpha2)
+		assert.LessOrEqual(t, c.BoundingBox.South, c.BoundingBox.North, "country %s", c.Alpha2)
+		assert.True(t, c.BoundingBox.Contains(c.Centroid), "country %s has its centroid outside", c.Alpha2)
+
+		if c.Capital == "" {
+			continue
+		}
+		assert.True(t, c.BoundingBox.Contains(c.CapitalCoordinates), "country %s has its capital outside", c.Alpha2)
+	}
+}
+
+// TestCountry_Coordinates tests the BoundingBox, CapitalCoordinates and Centroid fields
+func TestCountry_Coordinates(t *testing.T) {
+	de := GetByAlpha2("DE")
+	assert.InDelta(t, 52.5, de.CapitalCoordinates.Latitude, 0.1)
+	assert.InDelta(t, 13.4, de.CapitalCoordinates.Longitude, 0.1)
+	assert.InDelta(t, 47.27, de.BoundingBox.South, 0.01)
+	assert.InDelta(t, 55.07, de.BoundingBox.North, 0.01)
+
+	// Russia and Fiji cross the antimeridian
+	assert.Greater(t, GetByAlpha2("RU").BoundingBox.West, GetByAlpha2("RU").BoundingBox.East)
+	assert.Greater(t, GetByAlpha2("FJ").BoundingBox.West, GetByAlpha2("FJ").BoundingBox.East)
+
+	// Overseas departments are countries of their own
+	assert.False(t, GetByAlpha2("FR").BoundingBox.Contains(GetByAlpha2("RE").Centroid))
+
+	assert.Equal(t, Coordinates{}, GetByAlpha2("AQ").CapitalCoordinates)
+}
+
+// TestCountry_DistanceTo tests the DistanceTo method
+func TestCountry_DistanceTo(t *testing.T) {
+	de := GetByAlpha2("DE")
+
+	assert.InDelta(t, 0, de.DistanceTo(de), 1e-9)
+	assert.InDelta(t, 704, de.DistanceTo(GetByAlpha2("FR")), 1)
+	assert.InDelta(t, de.DistanceTo(GetByAlpha2("JP")), GetByAlpha2("JP").DistanceTo(de), 1e-9)
+}
+
+// TestCoordinates_DistanceTo tests the DistanceTo method of Coordinates
+func TestCoordinates_DistanceTo(t *testing.T) {
+	tests := []struct {
+		name     string
+		from     Coordinates
+		to       Coordinates
+		expected float64
+	}{
+		{name: "Same point", from: Coordinates{Latitude: 10, Longitude: 10}, to: Coordinates{Latitude: 10, Longitude: 10}},
+		{
+			name:     "London to Paris",
+			from:     GetByAlpha2("GB").CapitalCoordinates,
+			to:       GetByAlpha2("FR").CapitalCoordinates,
+			expected: 342,
+		},
+		{
+			name:     "Across the antimeridian",
+			from:     Coordinates{Latitude: 0, Longitude: 179.5},
+			to:       Coordinates{Latitude: 0, Longitude: -179.5},
+			expected: 111.2,
+		},
+		{
+			name:     "Pole to pole",
+			from:     Coordinates{Latitude: 90},
+			to:       Coordinates{Latitude: -90},
+			expected: math.Pi * earthRadiusKm,
+		},
+		{
+			name:     "Antipodes",
+			from:     Coordinates{Latitude: 0, Longitude: 0},
+			to:       Coordinates{Latitude: 0, Longitude: 180},
+			expected: math.Pi * earthRadiusKm,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.InDelta(t, tt.expected, tt.from.DistanceTo(tt.to), 0.5)
+		})
+	}
+}
+
+// TestBoundingBox_Contains tests the Contains method
+func TestBoundingBox_Contains(t *testing.T) {
+	box := BoundingBox{East: 10, North: 10, South: -10, West: -10}
+	crossing := GetByAlpha2("FJ").BoundingBox
+
+	assert.True(t, box.Contains(Coordinates{}))
+	assert.True(t, box.Contains(Coordinates{Latitude: 10, Longitude: -10}))
+	assert.False(t, box.Contains(Coordinates{Latitude: 11}))
+	assert.False(t, box.Contains(Coordinates{Longitude: 11}))
+	assert.True(t, crossing.Contains(Coordinates{Latitude: -16.5, Longitude: 179.9}))
+	assert.True(t, crossing.Contains(Coordinates{Latitude: -16.5, Longitude: -179.9}))
+	assert.False(t, crossing.Contains(Coordinates{Latitude: -16.5, Longitude: 0}))
+}
+
+// TestNearestCountries tests NearestCountries with different inputs
+func TestNearestCountries(t *testing.T) {
+	tests := []struct {
+		name      string
+		lat       float64
+		lon       float64
+		n         int
+		expected  []string
+		expectNil bool
+	}{
+		{name: "Berlin", lat: testLatitude, lon: testLongitude, n: 3, expected: []string{"DE", "CZ", "PL"}},
+		{name: "Single", lat: testLatitude, lon: testLongitude, n: 1, expected: []string{"DE"}},
+		{name: "Across the antimeridian", lat: -17.7, lon: 179.9, n: 2, expected: []string{"FJ", "WF"}},
+		{name: "South pole", lat: -90, lon: 0, n: 1, expected: []string{"AQ"}},
+		{name: "Zero", lat: testLatitude, lon: testLongitude, n: 0, expectNil: true},
+		{name: "Negative", lat: testLatitude, lon: testLongitude, n: -1, expectNil: true},
+		{name: "Latitude out of range", lat: 91, lon: testLongitude, n: 1, expectNil: true},
+		{name: "Longitude out of range", lat: testLatitude, lon: -181, n: 1, expectNil: true},
+		{name: "NaN", lat: math.NaN(), lon: testLongitude, n: 1, expectNil: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			list := NearestCountries(tt.lat, tt.lon, tt.n)
+			if tt.expectNil {
+				require.Nil(t, list)
+				return
+			}
+
+			codes := make([]string, 0, len(list))
+			for _, c := range list {
+				codes = append(codes, c.Alpha2)
+			}
+			assert.Equal(t, tt.expected, codes)
+		})
+	}
+
+	t.Run("more than every country", func(t *testing.T) {
+		list := NearestCountries(testLatitude, testLongitude, len(countries)+10)
+		require.Len(t, list, len(countries))
+		berlin := Coordinates{Latitude: testLatitude, Longitude: testLongitude}
+		for i := 1; i < len(list); i++ {
+			assert.LessOrEqual(t, berlin.DistanceTo(list[i-1].Centroid), berlin.DistanceTo(list[i].Centroid))
+		}
+	})
+
+	t.Run("returns a copy", func(t *testing.T) {
+		list := NearestCountries(testLatitude, testLongitude, 1)
+		list[0] = nil
+		assert.Equal(t, "DE", NearestCountries(testLatitude, testLongitude, 1)[0].Alpha2)
+	})
+}
+
+// ExampleCountry_DistanceTo is an example of Country.DistanceTo()
+func ExampleCountry_DistanceTo() {
+	de := GetByAlpha2("DE")
+	fmt.Printf("%s to %s: %.0f km", de.Name, "France", de.DistanceTo(GetByAlpha2("FR")))
+	// Output:Germany to France: 704 km
+}
+
+// ExampleNearestCountries is an example of NearestCountries()
+func ExampleNearestCountries() {
+	for _, c := range NearestCountries(testLatitude, testLongitude, 3) {
+		fmt.Println(c.Alpha2)
+	}
+	// Output:DE
+	// CZ
+	// PL
+}
+
+// BenchmarkCountry_DistanceTo benchmarks the method DistanceTo()
+func BenchmarkCountry_DistanceTo(b *testing.B) {
+	de, fr := GetByAlpha2("DE"), GetByAlpha2("FR")
+	for i := 0; i < b.N; i++ {
+		_ = de.DistanceTo(fr)
+	}
+}
+
+// BenchmarkNearestCountries benchmarks the method NearestCountries()
+func BenchmarkNearestCountries(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_ = NearestCountries(testLatitude, testLongitude, 5)
+	}
+}
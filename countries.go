@@ -2,7 +2,49 @@
 //
 // This package provides functionalities to retrieve country information based on various identifiers
 // such as name, alpha-2 code, alpha-3 code, country code, and ISO 3166-2 code. It includes methods
-// to get a country by these identifiers and to retrieve the entire list of countries.
+// to get a country by these identifiers and to retrieve the entire list of countries. The Alpha2, Alpha3
+// and Numeric types hold ISO 3166-1 codes that can be validated and converted to each other, with a typed
+// constant for every country (e.g., Alpha2US). The Code type embeds a country in JSON and text as its
+// alpha-2 code, and unmarshals it from any code or name. Codes are also database column types, and the
+// sqlschema subpackage creates and seeds a table of countries. The Lookup functions (e.g., LookupAlpha2)
+// return an error telling empty input, malformed input and unknown identifiers apart, with a "did you mean"
+// suggestion, and Lookup finds a country from any identifier, reporting which kind matched.
+//
+// Besides its ISO name (e.g., "Korea, Republic of"), every country has a common name ("South Korea"),
+// an official name ("Republic of Korea") and aliases ("Korea, South"), all of which find it by name.
+// Names and capitals are normalized before lookup, so case, diacritics, apostrophes, punctuation and
+// ISO-style inversions ("Bahamas, The") do not matter.
+//
+// Country names are translated into the UN and EU languages and other widely used languages from Unicode CLDR
+// ("Vereinigte Staaten" in German), every country lists its native names ("Schweiz", "Suisse"), and both can be
+// looked up in reverse. Lists of countries sort by their localized names in the alphabetical order of each language,
+// for country pickers.
+//
+// Each country also exposes its ISO 3166-2 subdivisions (states, provinces, regions, etc.)
+// which can be looked up by code or by name.
+//
+// International calling codes (ITU-T E.164) are included as well, and phone numbers can be
+// resolved to their country using the longest known prefix (e.g., NANP area codes).
+//
+// Search ranks the countries best matching free text (names, codes and capitals), ignoring case, diacritics
+// and punctuation, and tolerating typos for autocomplete fields.
+//
+// Country-code top-level domains (ccTLD), including internationalized ones such as ".рф",
+// can be used to look up a country in their Unicode or punycode form.
+//
+// Every country has a representative centroid, a bounding box and the location of its capital, which are used to
+// compute great-circle distances and to find the countries nearest to a point. The geo subpackage finds the
+// country containing a point using embedded country boundaries.
+//
+// Land borders link every country to its neighbours, which tells landlocked countries apart and finds the
+// route crossing the fewest borders between two countries.
+//
+// IANA time zones are listed for every country, and a country can be found from any of its
+// time zones, including backward-compatible aliases such as "US/Eastern".
+//
+// Official and widely spoken languages (ISO 639) are listed for every country, and countries
+// can be looked up by the languages spoken in them. BCP 47 language tags and locales ("pt-BR", "es_419")
+// resolve to their country, or to every country of a UN M.49 area.
 //
 // The package is designed to be straightforward to use and integrate into Go projects, making it simple to
 // work with country data in a standardized way.
@@ -25,33 +67,49 @@ type CountryList []*Country
 
 // Country is the single country in the list of countries (ISO-3166)
 type Country struct {
-	Alpha2                 string `json:"alpha-2"`                  // ISO 3166-1 alpha-2 code
-	Alpha3                 string `json:"alpha-3"`                  // ISO 3166-1 alpha-3 code
-	Capital                string `json:"capital"`                  // Capital city of the country
-	ContinentName          string `json:"continent_name"`           // The Name of the continent the country is located in
-	CountryCode            string `json:"country-code"`             // Numeric ISO 3166-1 code
-	CurrencyCode           string `json:"currency_code"`            // ISO 4217 currency code
-	ISO31662               string `json:"iso_3166-2"`               // ISO 3166-2 code for subdivisions
-	IntermediateRegion     string `json:"intermediate-region"`      // Name of the intermediate region (if applicable)
-	IntermediateRegionCode string `json:"intermediate-region-code"` // Code for the intermediate region (if applicable)
-	Name                   string `json:"name"`                     // Name of the country
-	Region                 string `json:"region"`                   // Name of the region the country is located in
-	RegionCode             string `json:"region-code"`              // Code for the region (e.g., continent code)
-	SubRegion              string `json:"sub-region"`               // The Name of the subregion the country is located in
-	SubRegionCode          string `json:"sub-region-code"`          // Code for the sub-region (e.g., continent sub-region code)
+	Aliases                []string          `json:"aliases"`                  // Other English names in use (e.g., "UK", "Great Britain")
+	Alpha2                 string            `json:"alpha-2"`                  // ISO 3166-1 alpha-2 code
+	Alpha3                 string            `json:"alpha-3"`                  // ISO 3166-1 alpha-3 code
+	BoundingBox            BoundingBox       `json:"bounding_box"`             // Bounding box of the territory, islands and exclaves included
+	CallingCodes           []string          `json:"calling_codes"`            // International calling codes (ITU-T E.164, e.g., "+44")
+	Capital                string            `json:"capital"`                  // Capital city of the country
+	CapitalCoordinates     Coordinates       `json:"capital_coordinates"`      // Location of the capital city (zero when Capital is empty)
+	Centroid               Coordinates       `json:"centroid"`                 // Representative point inside the main territory
+	CommonName             string            `json:"common_name"`              // Short English name in everyday use (e.g., "Bolivia")
+	ContinentName          string            `json:"continent_name"`           // The Name of the continent the country is located in
+	CountryCode            string            `json:"country-code"`             // Numeric ISO 3166-1 code
+	Currencies             []CountryCurrency `json:"currencies"`               // Every currency used in the country, primary first
+	CurrencyCode           string            `json:"currency_code"`            // ISO 4217 currency code of the primary currency
+	ISO31662               string            `json:"iso_3166-2"`               // ISO 3166-2 code for subdivisions
+	IntermediateRegion     string            `json:"intermediate-region"`      // Name of the intermediate region (if applicable)
+	IntermediateRegionCode string            `json:"intermediate-region-code"` // Code for the intermediate region (if applicable)
+	Languages              []CountryLanguage `json:"languages"`                // Official and widely spoken languages, most likely first
+	Name                   string            `json:"name"`                     // Name of the country
+	NativeNames            []NativeName      `json:"native_names"`             // Names in the official languages of the country (e.g., "Deutschland")
+	OfficialName           string            `json:"official_name"`            // Formal English name of the state (e.g., "Plurinational State of Bolivia")
+	Population             int64             `json:"population"`               // Population of the country (0 when uninhabited or unknown)
+	PopulationYear         int               `json:"population_year"`          // Reference year of the population figure
+	Region                 string            `json:"region"`                   // Name of the region the country is located in
+	RegionCode             string            `json:"region-code"`              // Code for the region (e.g., continent code)
+	SubRegion              string            `json:"sub-region"`               // The Name of the subregion the country is located in
+	SubRegionCode          string            `json:"sub-region-code"`          // Code for the sub-region (e.g., continent sub-region code)
+	TimeZones              []string          `json:"time_zones"`               // IANA time zones, most populous first (e.g., "America/New_York")
+	TLDs                   []string          `json:"tlds"`                     // Country-code top-level domains, ASCII first (e.g., ".ru", ".рф")
 }
 
-// GetByName retrieves a Country by its name in a case-insensitive search.
+// GetByName retrieves a Country by its name or one of its aliases, ignoring case, diacritics and punctuation.
 //
 // This function performs the following steps:
-// - Converts the input name to lowercase for normalization
+// - Normalizes the input name with NormalizeName (e.g., "Korea, Republic of" to "republic of korea")
 // - Performs a constant-time map lookup using the normalized name
 //
 // - Returns the matching Country pointer when found
 // - Returns nil if no matching country exists
 //
 // Parameters:
-// - name: country name used for the lookup
+// - name: ISO name (e.g., "Korea, Republic of"), common name ("South Korea"),
+// official name ("Republic of Korea") or alias ("Korea, South") of the country, in any case and with or
+// without diacritics (e.g., "Aland Islands", "Cote dIvoire")
 //
 // Returns:
 // - Pointer to the Country struct, or nil when no match is found
@@ -61,9 +119,11 @@ type Country struct {
 //
 // Notes:
 // - Lookup uses a prebuilt map for constant-time access
+// - ISO names take precedence over common names, official names and aliases, in that order
+// - Normalized names are looked up without allocating
 // - The result references the internal Country struct without copying
 func GetByName(name string) *Country {
-	return byName[strings.ToLower(name)]
+	return byName[NormalizeName(name)]
 }
 
 // GetByAlpha2 retrieves a Country by its alpha-2 code in a case-insensitive search.
@@ -76,7 +136,7 @@ func GetByName(name string) *Country {
 // - Returns nil if no match is located
 //
 // Parameters:
-// - alpha2: two-letter ISO 3166 code used for the lookup
+// - alpha2: two-letter ISO 3166 code used for the lookup, as a string or an Alpha2 code (e.g., Alpha2US)
 //
 // Returns:
 // - Pointer to the Country struct, or nil when no match is found
@@ -86,9 +146,11 @@ func GetByName(name string) *Country {
 //
 // Notes:
 // - Lookup uses a map for constant-time retrieval
+// - Generic over string types so that calls with the Alpha2 constants keep compiling now that they are typed;
+// use GetByAlpha2[string] where a func(string) *Country value is needed
 // - Returned pointer references package-level data without copying
-func GetByAlpha2(alpha2 string) *Country {
-	return byAlpha2[strings.ToUpper(alpha2)]
+func GetByAlpha2[T ~string](alpha2 T) *Country {
+	return byAlpha2[strings.ToUpper(string(alpha2))]
 }
 
 // GetByAlpha3 retrieves a Country using its alpha-3 code in a case-insensitive search.
@@ -101,7 +163,7 @@ func GetByAlpha2(alpha2 string) *Country {
 // - Returns nil when the code is not present
 //
 // Parameters:
-// - alpha3: three-letter ISO 3166 code used for the lookup
+// - alpha3: three-letter ISO 3166 code used for the lookup, as a string or an Alpha3 code (e.g., Alpha3USA)
 //
 // Returns:
 // - Pointer to the Country struct, or nil when no match is found
@@ -111,9 +173,11 @@ func GetByAlpha2(alpha2 string) *Country {
 //
 // Notes:
 // - Lookup uses a map for constant-time retrieval
+// - Generic over string types so that calls with the Alpha3 constants keep compiling now that they are typed;
+// use GetByAlpha3[string] where a func(string) *Country value is needed
 // - Returned pointer references global data and should not be mutated
-func GetByAlpha3(alpha3 string) *Country {
-	return byAlpha3[strings.ToUpper(alpha3)]
+func GetByAlpha3[T ~string](alpha3 T) *Country {
+	return byAlpha3[strings.ToUpper(string(alpha3))]
 }
 
 // GetByCountryCode looks up a Country by its numeric code using a case-sensitive comparison.
@@ -140,17 +204,17 @@ func GetByCountryCode(code string) *Country {
 	return byCode[code]
 }
 
-// GetByCapital retrieves a Country by its capital city in a case-insensitive search.
+// GetByCapital retrieves a Country by its capital city, ignoring case, diacritics and punctuation.
 //
 // This function performs the following steps:
-// - Converts the provided capital name to lowercase
+// - Normalizes the provided capital name with NormalizeName (e.g., "Bogotá" to "bogota")
 // - Performs a constant-time map lookup using the normalized name
 //
 // - Returns the Country pointer when a match exists
 // - Returns nil if the capital is not found
 //
 // Parameters:
-// - capital: the capital city used for the lookup
+// - capital: the capital city used for the lookup (e.g., "Bogota", "Bogotá", "BOGOTÁ")
 //
 // Returns:
 // - Pointer to the Country struct, or nil when no match is found
@@ -162,7 +226,7 @@ func GetByCountryCode(code string) *Country {
 // - Lookup uses a prebuilt map for constant-time retrieval
 // - Returned pointer references package data directly
 func GetByCapital(capital string) *Country {
-	return byCapital[strings.ToLower(capital)]
+	return byCapital[NormalizeName(capital)]
 }
 
 // GetByISO31662 locates a Country by its ISO 3166-2 code using a case-insensitive match.
@@ -101,3 +101,392 @@ func FuzzGetByISO31662(f *testing.F) {
 		require.Equal(t, strings.ToUpper(iso), c.ISO31662)
 	})
 }
+
+// FuzzGetSubdivision checks GetSubdivision with subdivision codes of varying cases.
+func FuzzGetSubdivision(f *testing.F) {
+	seed := []string{"US-CA", "ca-on", "Gb-Sct", "", "US"}
+	for _, s := range seed {
+		f.Add(s)
+	}
+	f.Fuzz(func(t *testing.T, code string) {
+		s := GetSubdivision(code)
+		if s == nil {
+			return
+		}
+		require.Equal(t, strings.ToUpper(code), s.Code)
+		require.NotNil(t, s.Country())
+	})
+}
+
+// FuzzGetCurrency checks GetCurrency with currency codes of varying cases.
+func FuzzGetCurrency(f *testing.F) {
+	seed := []string{"EUR", "usd", "jPy", "", "EURO"}
+	for _, s := range seed {
+		f.Add(s)
+	}
+	f.Fuzz(func(t *testing.T, code string) {
+		c := GetCurrency(code)
+		if c == nil {
+			return
+		}
+		require.Equal(t, strings.ToUpper(code), c.Code)
+		require.Equal(t, c, GetCurrencyByNumeric(c.NumericCode))
+	})
+}
+
+// FuzzResolvePhonePrefix ensures ResolvePhonePrefix never panics and only
+// resolves numbers to countries with a matching calling code.
+func FuzzResolvePhonePrefix(f *testing.F) {
+	seed := []string{"+1 684 633 1234", "0044 1534 123456", "+7", "+", "", "abc"}
+	for _, s := range seed {
+		f.Add(s)
+	}
+	f.Fuzz(func(t *testing.T, number string) {
+		c := ResolvePhonePrefix(number)
+		if c == nil {
+			return
+		}
+		digits := phoneDigits(number)
+		matched := false
+		for _, code := range c.CallingCodes {
+			if strings.HasPrefix(digits, strings.TrimPrefix(code, "+")) {
+				matched = true
+			}
+		}
+		require.True(t, matched, "number %q resolved to %s", number, c.Alpha2)
+	})
+}
+
+// FuzzGetByTLD ensures GetByTLD only returns countries that list the
+// requested top-level domain in its Unicode or punycode form.
+func FuzzGetByTLD(f *testing.F) {
+	seed := []string{".io", "UK", ".рф", ".xn--p1ai", "", "."}
+	for _, s := range seed {
+		f.Add(s)
+	}
+	f.Fuzz(func(t *testing.T, tld string) {
+		c := GetByTLD(tld)
+		if c == nil {
+			return
+		}
+		require.NotEmpty(t, c.TLDs)
+	})
+}
+
+// FuzzCountriesSpeaking ensures CountriesSpeaking only returns countries
+// that list the requested language.
+func FuzzCountriesSpeaking(f *testing.F) {
+	seed := []string{"es", "POR", "fil", " en ", "", "xx"}
+	for _, s := range seed {
+		f.Add(s)
+	}
+	f.Fuzz(func(t *testing.T, code string) {
+		language := GetLanguage(code)
+		list := CountriesSpeaking(code)
+		if language == nil {
+			require.Empty(t, list)
+			return
+		}
+		for _, c := range list {
+			found := false
+			for _, l := range c.Languages {
+				found = found || l.Code == language.code()
+			}
+			require.True(t, found, "country %s does not speak %s", c.Alpha2, language.Alpha3)
+		}
+	})
+}
+
+// FuzzGetByLocale ensures GetByLocale never panics and only returns known
+// countries.
+func FuzzGetByLocale(f *testing.F) {
+	seed := []string{"pt-BR", "es_419", "de_DE.UTF-8@euro", "zh-Hant-TW", "en-001", "C", "", "-", "x-a"}
+	for _, s := range seed {
+		f.Add(s)
+	}
+	f.Fuzz(func(t *testing.T, locale string) {
+		for _, c := range GetByLocale(locale) {
+			require.NotNil(t, c)
+			require.Equal(t, c, GetByAlpha2(c.Alpha2))
+		}
+	})
+}
+
+// FuzzGetByAcceptLanguage ensures GetByAcceptLanguage never panics and
+// returns known countries without duplicates.
+func FuzzGetByAcceptLanguage(f *testing.F) {
+	seed := []string{"fr-CH, fr;q=0.9, en;q=0.8, *;q=0.5", "es-419", "en;q=abc", ";;,,", "", "x-a;q=1"}
+	for _, s := range seed {
+		f.Add(s)
+	}
+	f.Fuzz(func(t *testing.T, header string) {
+		seen := make(map[string]bool)
+		for _, c := range GetByAcceptLanguage(header) {
+			require.NotNil(t, c)
+			require.False(t, seen[c.Alpha2], "duplicate country %s", c.Alpha2)
+			seen[c.Alpha2] = true
+		}
+	})
+}
+
+// FuzzGetByTimeZone ensures GetByTimeZone only returns countries for time
+// zone names that are case-insensitively known.
+func FuzzGetByTimeZone(f *testing.F) {
+	seed := []string{"Europe/Berlin", "us/eastern", " Asia/Tokyo ", "UTC", "", "/"}
+	for _, s := range seed {
+		f.Add(s)
+	}
+	f.Fuzz(func(t *testing.T, name string) {
+		c := GetByTimeZone(name)
+		if c == nil {
+			return
+		}
+		require.NotEmpty(t, c.TimeZones)
+		require.Equal(t, c, GetByTimeZone(strings.ToUpper(name)))
+	})
+}
+
+// FuzzNearestCountries ensures NearestCountries never returns more than n
+// countries and always lists them from the nearest to the farthest.
+func FuzzNearestCountries(f *testing.F) {
+	f.Add(52.52, 13.405, 3)
+	f.Add(-17.7, 179.9, 2)
+	f.Add(91.0, 0.0, 1)
+	f.Add(0.0, 0.0, 0)
+	f.Fuzz(func(t *testing.T, lat, lon float64, n int) {
+		list := NearestCountries(lat, lon, n)
+		require.LessOrEqual(t, len(list), max(n, 0))
+
+		location := Coordinates{Latitude: lat, Longitude: lon}
+		for i := 1; i < len(list); i++ {
+			require.LessOrEqual(t, location.DistanceTo(list[i-1].Centroid), location.DistanceTo(list[i].Centroid))
+		}
+	})
+}
+
+// FuzzShortestLandPath ensures ShortestLandPath only returns routes that
+// start and end at the given countries and cross a land border at every step.
+func FuzzShortestLandPath(f *testing.F) {
+	seed := []struct {
+		from string
+		to   string
+	}{
+		{"PT", "CN"},
+		{"FR", "GB"},
+		{"JP", "JP"},
+		{"", "DE"},
+		{"zz", "de"},
+	}
+	for _, s := range seed {
+		f.Add(s.from, s.to)
+	}
+	f.Fuzz(func(t *testing.T, from, to string) {
+		path := ShortestLandPath(GetByAlpha2(from), GetByAlpha2(to))
+		if path == nil {
+			return
+		}
+		require.Equal(t, GetByAlpha2(from), path[0])
+		require.Equal(t, GetByAlpha2(to), path[len(path)-1])
+		for i := 1; i < len(path); i++ {
+			require.Contains(t, path[i-1].Neighbors(), path[i])
+		}
+	})
+}
+
+// FuzzGetByFlagEmoji ensures GetByFlagEmoji only returns countries whose
+// flag emoji matches the trimmed input.
+func FuzzGetByFlagEmoji(f *testing.F) {
+	seed := []string{"🇩🇪", " 🇫🇷 ", "🇪🇺", "🇩", "DE", "", "\xf0\x9f\x87"}
+	for _, s := range seed {
+		f.Add(s)
+	}
+	f.Fuzz(func(t *testing.T, emoji string) {
+		c := GetByFlagEmoji(emoji)
+		if c == nil {
+			return
+		}
+		require.Contains(t, emoji, c.FlagEmoji())
+		require.Equal(t, c, GetByFlagEmoji(c.FlagEmoji()))
+	})
+}
+
+// FuzzSearch ensures Search never returns more than limit matches, never
+// repeats a country and always sorts the matches by decreasing score.
+func FuzzSearch(f *testing.F) {
+	f.Add("united states", 5)
+	f.Add("Cote d'Ivoire", 3)
+	f.Add("germny", 1)
+	f.Add("", 5)
+	f.Add("a", 0)
+	f.Fuzz(func(t *testing.T, query string, limit int) {
+		matches := Search(query, limit)
+		require.LessOrEqual(t, len(matches), max(limit, 0))
+
+		seen := make(map[string]bool, len(matches))
+		for i, m := range matches {
+			require.False(t, seen[m.Country.Alpha2])
+			seen[m.Country.Alpha2] = true
+			require.True(t, m.Score >= minScore && m.Score <= 1)
+			if i > 0 {
+				require.GreaterOrEqual(t, matches[i-1].Score, m.Score)
+			}
+		}
+	})
+}
+
+// FuzzNormalizeName ensures NormalizeName is idempotent and that GetByName and GetByCapital
+// find the same country from a name and from its normalized form.
+func FuzzNormalizeName(f *testing.F) {
+	seed := []string{"Korea, Republic of", "Côte d’Ivoire", "The Gambia", "Bogotá", "&", "(of)", ""}
+	for _, s := range seed {
+		f.Add(s)
+	}
+	f.Fuzz(func(t *testing.T, name string) {
+		normalized := NormalizeName(name)
+		require.Equal(t, normalized, NormalizeName(normalized))
+		require.Equal(t, GetByName(name), GetByName(normalized))
+		require.Equal(t, GetByCapital(name), GetByCapital(normalized))
+	})
+}
+
+// FuzzGetByLocalizedName validates that any name and language tag can be looked up without panicking
+// and that normalized names find the same country
+func FuzzGetByLocalizedName(f *testing.F) {
+	f.Add("Vereinigte Staaten", "de")
+	f.Add("台灣", "zh-Hant-TW")
+	f.Add("Schwiiz", "")
+	f.Add("", "de_DE.UTF-8@euro")
+	f.Add("-", "-")
+	f.Fuzz(func(t *testing.T, name, lang string) {
+		c := GetByLocalizedName(name, lang)
+		require.Equal(t, c, GetByLocalizedName(NormalizeName(name), lang))
+		if c != nil {
+			require.Equal(t, c, GetByAlpha2(c.Alpha2))
+		}
+		require.NotPanics(t, func() { _ = countries[0].LocalizedName(lang) })
+	})
+}
+
+// FuzzCountryList_SortByLocalizedName validates that sorting for any language tag keeps every country once
+func FuzzCountryList_SortByLocalizedName(f *testing.F) {
+	f.Add("de")
+	f.Add("sv_SE.UTF-8")
+	f.Add("zh-Hant-TW")
+	f.Add("")
+	f.Add("-_-")
+	f.Fuzz(func(t *testing.T, lang string) {
+		list := CountryList{GetByAlpha2("AT"), GetByAlpha2("AX"), GetByAlpha2("CL"), GetByAlpha2("TR")}
+		list.SortByLocalizedName(lang)
+
+		seen := make(map[string]bool, len(list))
+		for _, c := range list {
+			require.NotNil(t, c)
+			require.False(t, seen[c.Alpha2])
+			seen[c.Alpha2] = true
+		}
+		require.Len(t, seen, 4)
+	})
+}
+
+// FuzzCodes validates that any typed code is either unassigned or converts back to itself
+func FuzzCodes(f *testing.F) {
+	f.Add("US", "USA", uint16(840))
+	f.Add("us", "MEX", uint16(4))
+	f.Add("", "", uint16(0))
+	f.Fuzz(func(t *testing.T, alpha2, alpha3 string, numeric uint16) {
+		if a := Alpha2(alpha2); a.Valid() {
+			require.Equal(t, a, a.ToAlpha3().ToAlpha2())
+			require.Equal(t, a, a.ToNumeric().ToAlpha2())
+		}
+		if a := Alpha3(alpha3); a.Valid() {
+			require.Equal(t, a, a.ToNumeric().ToAlpha3())
+		}
+		n := Numeric(numeric)
+		require.GreaterOrEqual(t, len(n.String()), 3)
+		if n.Valid() {
+			require.Equal(t, n.String(), n.Country().CountryCode)
+		}
+	})
+}
+
+// FuzzCode_UnmarshalJSON validates that any JSON value either fails or unmarshals into a Code that round-trips
+func FuzzCode_UnmarshalJSON(f *testing.F) {
+	f.Add([]byte(`"US"`))
+	f.Add([]byte(`"deu"`))
+	f.Add([]byte(`840`))
+	f.Add([]byte(`"Cote d'Ivoire"`))
+	f.Add([]byte(`null`))
+	f.Add([]byte(`{}`))
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var code Code
+		if err := code.UnmarshalJSON(data); err != nil || code.IsZero() {
+			return
+		}
+
+		marshaled, err := code.MarshalJSON()
+		require.NoError(t, err)
+
+		var again Code
+		require.NoError(t, again.UnmarshalJSON(marshaled))
+		require.Same(t, code.Country, again.Country)
+	})
+}
+
+// FuzzLookupAlpha2 validates that LookupAlpha2 finds the same country as GetByAlpha2 on the trimmed input, or explains the miss
+func FuzzLookupAlpha2(f *testing.F) {
+	f.Add("US")
+	f.Add("usa")
+	f.Add("UK")
+	f.Add("")
+	f.Add("Germny")
+	f.Fuzz(func(t *testing.T, input string) {
+		country, err := LookupAlpha2(input)
+		if err == nil {
+			require.Same(t, GetByAlpha2(strings.TrimSpace(input)), country)
+			return
+		}
+
+		require.Nil(t, country)
+		var lookupErr *LookupError
+		require.ErrorAs(t, err, &lookupErr)
+		require.Equal(t, input, lookupErr.Input)
+		if lookupErr.Suggestion != nil {
+			require.Equal(t, lookupErr.Suggestion.Alpha2, lookupErr.DidYouMean)
+		}
+	})
+}
+
+// FuzzLookup validates that Lookup reports the identifier that matched the input
+func FuzzLookup(f *testing.F) {
+	f.Add("de")
+	f.Add("DEU")
+	f.Add("76")
+	f.Add("ISO 3166-2:DE")
+	f.Add("Berlin")
+	f.Add("")
+	f.Fuzz(func(t *testing.T, input string) {
+		country, kind := Lookup(input)
+		if country == nil {
+			require.Equal(t, MatchKindNone, kind)
+			return
+		}
+
+		trimmed := strings.TrimSpace(input)
+		switch kind {
+		case MatchKindAlpha2:
+			require.Equal(t, country.Alpha2, strings.ToUpper(trimmed))
+		case MatchKindAlpha3:
+			require.Equal(t, country.Alpha3, strings.ToUpper(trimmed))
+		case MatchKindNumeric:
+			require.True(t, strings.HasSuffix(country.CountryCode, trimmed))
+		case MatchKindISO31662:
+			require.Same(t, GetByISO31662(trimmed), country)
+		case MatchKindName:
+			require.Same(t, GetByName(trimmed), country)
+		case MatchKindCapital:
+			require.Same(t, GetByCapital(trimmed), country)
+		default:
+			require.Fail(t, "unknown match kind", kind)
+		}
+	})
+}
@@ -54,7 +54,7 @@ func TestLookupMaps_Populated(t *testing.T) {
 	require.NotNil(t, byISO31662)
 	require.NotNil(t, byCapital)
 
-	assert.Len(t, byName, 249)
+	assert.Len(t, byName, 537) // ISO, common and official names and aliases of the 249 countries, normalized
 	assert.Len(t, byAlpha2, 249)
 	assert.Len(t, byAlpha3, 249)
 	assert.Len(t, byCode, 249)
@@ -64,6 +64,19 @@ func TestLookupMaps_Populated(t *testing.T) {
 	require.Equal(t, GetByAlpha2(testCountryAlpha2), byAlpha2[testCountryAlpha2])
 }
 
+// TestCountry_Names tests that every country has a common and an official name,
+// and that each of its names and aliases finds it
+func TestCountry_Names(t *testing.T) {
+	for _, country := range GetAll() {
+		assert.NotEmpty(t, country.CommonName, country.Alpha2)
+		assert.NotEmpty(t, country.OfficialName, country.Alpha2)
+
+		for _, name := range append([]string{country.Name, country.CommonName, country.OfficialName}, country.Aliases...) {
+			assert.Equal(t, country.Alpha2, GetByName(name).Alpha2, name)
+		}
+	}
+}
+
 // TestGetByName_VariousFormats tests GetByName with different input cases
 func TestGetByName_VariousFormats(t *testing.T) {
 	tests := []struct {
@@ -76,6 +89,23 @@ func TestGetByName_VariousFormats(t *testing.T) {
 		{name: "Format to lower, mixed caps", input: "AfghanistaN", expectedName: "Afghanistan"},
 		{name: "Symbol detection", input: "Åland Islands", expectedName: "Åland Islands"},
 		{name: "All caps", input: "ALBANIA", expectedName: "Albania"},
+		{name: "Common name", input: "South Korea", expectedName: "Korea, Republic of"},
+		{name: "Official name", input: "Plurinational State of Bolivia", expectedName: "Bolivia (Plurinational State of)"},
+		{name: "Alias", input: "UK", expectedName: "United Kingdom of Great Britain and Northern Ireland"},
+		{name: "Alias with punctuation", input: "u.s.a.", expectedName: "United States of America"},
+		{name: "Former name", input: "Czech Republic", expectedName: "Czechia"},
+		{name: "ISO variant", input: "Iran, Islamic Republic of", expectedName: "Iran (Islamic Republic of)"},
+		{name: "Without diacritics", input: "Aland Islands", expectedName: "Åland Islands"},
+		{name: "Without apostrophe", input: "Cote dIvoire", expectedName: "Côte d'Ivoire"},
+		{name: "Typographic apostrophe", input: "Côte d’Ivoire", expectedName: "Côte d'Ivoire"},
+		{name: "Cedilla dropped", input: "Curacao", expectedName: "Curaçao"},
+		{name: "Qualifier moved", input: "Congo, The Democratic Republic of the", expectedName: "Congo, Democratic Republic of the"},
+		{name: "Qualifier in parentheses", input: "Korea (Democratic People’s Republic of)", expectedName: "Korea (Democratic People's Republic of)"},
+		{name: "Leading the", input: "The Gambia", expectedName: "Gambia"},
+		{name: "Trailing the", input: "Bahamas, The", expectedName: "Bahamas"},
+		{name: "Ampersand", input: "Trinidad & Tobago", expectedName: "Trinidad and Tobago"},
+		{name: "Hyphen and spaces", input: " guinea   bissau ", expectedName: "Guinea-Bissau"},
+		{name: "Republic of kept", input: "Republic of the Congo", expectedName: "Congo"},
 		{name: "no country found", input: "no-country", expectNil: true},
 	}
 
@@ -107,7 +137,7 @@ func ExampleGetByName() {
 func ExampleGetByName_showAll() {
 	country := GetByName(testCountry)
 	fmt.Printf("%+v\n", country)
-	// Output:&{Alpha2:US Alpha3:USA Capital:Washington ContinentName:North America CountryCode:840 CurrencyCode:USD ISO31662:ISO 3166-2:US IntermediateRegion: IntermediateRegionCode: Name:United States of America Region:Americas RegionCode:019 SubRegion:Northern America SubRegionCode:021}
+	// Output:&{Aliases:[USA US U.S. U.S.A. America] Alpha2:US Alpha3:USA BoundingBox:{East:-66.9773 North:71.4125 South:18.9061 West:172.4761} CallingCodes:[+1] Capital:Washington CapitalCoordinates:{Latitude:38.9072 Longitude:-77.0369} Centroid:{Latitude:39.5385 Longitude:-97.4826} CommonName:United States ContinentName:North America CountryCode:840 Currencies:[{Code:USD LegalTender:true Primary:true} {Code:USN LegalTender:false Primary:false}] CurrencyCode:USD ISO31662:ISO 3166-2:US IntermediateRegion: IntermediateRegionCode: Languages:[{Code:en Official:true} {Code:chr Official:false} {Code:es Official:false} {Code:haw Official:false} {Code:lkt Official:false}] Name:United States of America NativeNames:[{Language:en Name:United States}] OfficialName:United States of America Population:310232863 PopulationYear:2010 Region:Americas RegionCode:019 SubRegion:Northern America SubRegionCode:021 TimeZones:[America/New_York America/Detroit America/Kentucky/Louisville America/Kentucky/Monticello America/Indiana/Indianapolis America/Indiana/Vincennes America/Indiana/Winamac America/Indiana/Marengo America/Indiana/Petersburg America/Indiana/Vevay America/Chicago America/Indiana/Tell_City America/Indiana/Knox America/Menominee America/North_Dakota/Center America/North_Dakota/New_Salem America/North_Dakota/Beulah America/Denver America/Boise America/Phoenix America/Los_Angeles America/Anchorage America/Juneau America/Sitka America/Metlakatla America/Yakutat America/Nome America/Adak Pacific/Honolulu] TLDs:[.us]}
 }
 
 // BenchmarkGetByName benchmarks the method GetByName()
@@ -264,6 +294,9 @@ func TestGetByCapital_VariousFormats(t *testing.T) {
 	}{
 		{name: "Valid case", input: testCountryCapital, expected: testCountryCapital},
 		{name: "Lowercase", input: strings.ToLower(testCountryCapital), expected: testCountryCapital},
+		{name: "Without diacritics", input: "Bogota", expected: "Bogotá"},
+		{name: "Uppercase with diacritics", input: "BOGOTÁ", expected: "Bogotá"},
+		{name: "Extra spaces", input: "  Mexico   City ", expected: "Mexico City"},
 		{name: "Invalid", input: "NoCity", expectNil: true},
 	}
 
@@ -399,507 +432,507 @@ func BenchmarkGetAll(b *testing.B) {
 // TestAlphaCodes_Constants checks all Alpha2 and Alpha3 constants for correctness
 func TestAlphaCodes_Constants(t *testing.T) {
 	// Alpha-2 codes
-	assert.Equal(t, Alpha2AF, "AF")
-	assert.Equal(t, Alpha2AX, "AX")
-	assert.Equal(t, Alpha2AL, "AL")
-	assert.Equal(t, Alpha2DZ, "DZ")
-	assert.Equal(t, Alpha2AS, "AS")
-	assert.Equal(t, Alpha2AD, "AD")
-	assert.Equal(t, Alpha2AO, "AO")
-	assert.Equal(t, Alpha2AI, "AI")
-	assert.Equal(t, Alpha2AQ, "AQ")
-	assert.Equal(t, Alpha2AG, "AG")
-	assert.Equal(t, Alpha2AR, "AR")
-	assert.Equal(t, Alpha2AM, "AM")
-	assert.Equal(t, Alpha2AW, "AW")
-	assert.Equal(t, Alpha2AU, "AU")
-	assert.Equal(t, Alpha2AT, "AT")
-	assert.Equal(t, Alpha2AZ, "AZ")
-	assert.Equal(t, Alpha2BS, "BS")
-	assert.Equal(t, Alpha2BH, "BH")
-	assert.Equal(t, Alpha2BD, "BD")
-	assert.Equal(t, Alpha2BB, "BB")
-	assert.Equal(t, Alpha2BY, "BY")
-	assert.Equal(t, Alpha2BE, "BE")
-	assert.Equal(t, Alpha2BZ, "BZ")
-	assert.Equal(t, Alpha2BJ, "BJ")
-	assert.Equal(t, Alpha2BM, "BM")
-	assert.Equal(t, Alpha2BT, "BT")
-	assert.Equal(t, Alpha2BO, "BO")
-	assert.Equal(t, Alpha2BQ, "BQ")
-	assert.Equal(t, Alpha2BA, "BA")
-	assert.Equal(t, Alpha2BW, "BW")
-	assert.Equal(t, Alpha2BV, "BV")
-	assert.Equal(t, Alpha2BR, "BR")
-	assert.Equal(t, Alpha2IO, "IO")
-	assert.Equal(t, Alpha2BN, "BN")
-	assert.Equal(t, Alpha2BG, "BG")
-	assert.Equal(t, Alpha2BF, "BF")
-	assert.Equal(t, Alpha2BI, "BI")
-	assert.Equal(t, Alpha2CV, "CV")
-	assert.Equal(t, Alpha2KH, "KH")
-	assert.Equal(t, Alpha2CM, "CM")
-	assert.Equal(t, Alpha2CA, "CA")
-	assert.Equal(t, Alpha2KY, "KY")
-	assert.Equal(t, Alpha2CF, "CF")
-	assert.Equal(t, Alpha2TD, "TD")
-	assert.Equal(t, Alpha2CL, "CL")
-	assert.Equal(t, Alpha2CN, "CN")
-	assert.Equal(t, Alpha2CX, "CX")
-	assert.Equal(t, Alpha2CC, "CC")
-	assert.Equal(t, Alpha2CO, "CO")
-	assert.Equal(t, Alpha2KM, "KM")
-	assert.Equal(t, Alpha2CG, "CG")
-	assert.Equal(t, Alpha2CD, "CD")
-	assert.Equal(t, Alpha2CK, "CK")
-	assert.Equal(t, Alpha2CR, "CR")
-	assert.Equal(t, Alpha2CI, "CI")
-	assert.Equal(t, Alpha2HR, "HR")
-	assert.Equal(t, Alpha2CU, "CU")
-	assert.Equal(t, Alpha2CW, "CW")
-	assert.Equal(t, Alpha2CY, "CY")
-	assert.Equal(t, Alpha2CZ, "CZ")
-	assert.Equal(t, Alpha2DK, "DK")
-	assert.Equal(t, Alpha2DJ, "DJ")
-	assert.Equal(t, Alpha2DM, "DM")
-	assert.Equal(t, Alpha2DO, "DO")
-	assert.Equal(t, Alpha2EC, "EC")
-	assert.Equal(t, Alpha2EG, "EG")
-	assert.Equal(t, Alpha2SV, "SV")
-	assert.Equal(t, Alpha2GQ, "GQ")
-	assert.Equal(t, Alpha2ER, "ER")
-	assert.Equal(t, Alpha2EE, "EE")
-	assert.Equal(t, Alpha2SZ, "SZ")
-	assert.Equal(t, Alpha2ET, "ET")
-	assert.Equal(t, Alpha2FK, "FK")
-	assert.Equal(t, Alpha2FO, "FO")
-	assert.Equal(t, Alpha2FJ, "FJ")
-	assert.Equal(t, Alpha2FI, "FI")
-	assert.Equal(t, Alpha2FR, "FR")
-	assert.Equal(t, Alpha2GF, "GF")
-	assert.Equal(t, Alpha2PF, "PF")
-	assert.Equal(t, Alpha2TF, "TF")
-	assert.Equal(t, Alpha2GA, "GA")
-	assert.Equal(t, Alpha2GM, "GM")
-	assert.Equal(t, Alpha2GE, "GE")
-	assert.Equal(t, Alpha2DE, "DE")
-	assert.Equal(t, Alpha2GH, "GH")
-	assert.Equal(t, Alpha2GI, "GI")
-	assert.Equal(t, Alpha2GR, "GR")
-	assert.Equal(t, Alpha2GL, "GL")
-	assert.Equal(t, Alpha2GD, "GD")
-	assert.Equal(t, Alpha2GP, "GP")
-	assert.Equal(t, Alpha2GU, "GU")
-	assert.Equal(t, Alpha2GT, "GT")
-	assert.Equal(t, Alpha2GG, "GG")
-	assert.Equal(t, Alpha2GN, "GN")
-	assert.Equal(t, Alpha2GW, "GW")
-	assert.Equal(t, Alpha2GY, "GY")
-	assert.Equal(t, Alpha2HT, "HT")
-	assert.Equal(t, Alpha2HM, "HM")
-	assert.Equal(t, Alpha2VA, "VA")
-	assert.Equal(t, Alpha2HN, "HN")
-	assert.Equal(t, Alpha2HK, "HK")
-	assert.Equal(t, Alpha2HU, "HU")
-	assert.Equal(t, Alpha2IS, "IS")
-	assert.Equal(t, Alpha2IN, "IN")
-	assert.Equal(t, Alpha2ID, "ID")
-	assert.Equal(t, Alpha2IR, "IR")
-	assert.Equal(t, Alpha2IQ, "IQ")
-	assert.Equal(t, Alpha2IE, "IE")
-	assert.Equal(t, Alpha2IM, "IM")
-	assert.Equal(t, Alpha2IL, "IL")
-	assert.Equal(t, Alpha2IT, "IT")
-	assert.Equal(t, Alpha2JM, "JM")
-	assert.Equal(t, Alpha2JP, "JP") // Japan, should exist if all codes are present
-
-	assert.Equal(t, Alpha2JE, "JE")
-	assert.Equal(t, Alpha2JO, "JO")
-	assert.Equal(t, Alpha2KZ, "KZ")
-	assert.Equal(t, Alpha2KE, "KE")
-	assert.Equal(t, Alpha2KI, "KI")
-	assert.Equal(t, Alpha2KP, "KP")
-	assert.Equal(t, Alpha2KR, "KR")
-	assert.Equal(t, Alpha2KW, "KW")
-	assert.Equal(t, Alpha2KG, "KG")
-	assert.Equal(t, Alpha2LA, "LA")
-	assert.Equal(t, Alpha2LV, "LV")
-	assert.Equal(t, Alpha2LB, "LB")
-	assert.Equal(t, Alpha2LS, "LS")
-	assert.Equal(t, Alpha2LR, "LR")
-	assert.Equal(t, Alpha2LY, "LY")
-	assert.Equal(t, Alpha2LI, "LI")
-	assert.Equal(t, Alpha2LT, "LT")
-	assert.Equal(t, Alpha2LU, "LU")
-	assert.Equal(t, Alpha2MO, "MO")
-	assert.Equal(t, Alpha2MG, "MG")
-	assert.Equal(t, Alpha2MW, "MW")
-	assert.Equal(t, Alpha2MY, "MY")
-	assert.Equal(t, Alpha2MV, "MV")
-	assert.Equal(t, Alpha2ML, "ML")
-	assert.Equal(t, Alpha2MT, "MT")
-	assert.Equal(t, Alpha2MH, "MH")
-	assert.Equal(t, Alpha2MQ, "MQ")
-	assert.Equal(t, Alpha2MR, "MR")
-	assert.Equal(t, Alpha2MU, "MU")
-	assert.Equal(t, Alpha2YT, "YT")
-	assert.Equal(t, Alpha2MX, "MX")
-	assert.Equal(t, Alpha2FM, "FM")
-	assert.Equal(t, Alpha2MD, "MD")
-	assert.Equal(t, Alpha2MC, "MC")
-	assert.Equal(t, Alpha2MN, "MN")
-	assert.Equal(t, Alpha2ME, "ME")
-	assert.Equal(t, Alpha2MS, "MS")
-	assert.Equal(t, Alpha2MA, "MA")
-	assert.Equal(t, Alpha2MZ, "MZ")
-	assert.Equal(t, Alpha2MM, "MM")
-	assert.Equal(t, Alpha2NA, "NA")
-	assert.Equal(t, Alpha2NR, "NR")
-	assert.Equal(t, Alpha2NP, "NP")
-	assert.Equal(t, Alpha2NL, "NL")
-	assert.Equal(t, Alpha2NC, "NC")
-	assert.Equal(t, Alpha2NZ, "NZ")
-	assert.Equal(t, Alpha2NI, "NI")
-	assert.Equal(t, Alpha2NE, "NE")
-	assert.Equal(t, Alpha2NG, "NG")
-	assert.Equal(t, Alpha2NU, "NU")
-	assert.Equal(t, Alpha2NF, "NF")
-	assert.Equal(t, Alpha2MK, "MK")
-	assert.Equal(t, Alpha2MP, "MP")
-	assert.Equal(t, Alpha2NO, "NO")
-	assert.Equal(t, Alpha2OM, "OM")
-	assert.Equal(t, Alpha2PK, "PK")
-	assert.Equal(t, Alpha2PW, "PW")
-	assert.Equal(t, Alpha2PS, "PS")
-	assert.Equal(t, Alpha2PA, "PA")
-	assert.Equal(t, Alpha2PG, "PG")
-	assert.Equal(t, Alpha2PY, "PY")
-	assert.Equal(t, Alpha2PE, "PE")
-	assert.Equal(t, Alpha2PH, "PH")
-	assert.Equal(t, Alpha2PN, "PN")
-	assert.Equal(t, Alpha2PL, "PL")
-	assert.Equal(t, Alpha2PT, "PT")
-	assert.Equal(t, Alpha2PR, "PR")
-	assert.Equal(t, Alpha2QA, "QA")
-	assert.Equal(t, Alpha2RE, "RE")
-	assert.Equal(t, Alpha2RO, "RO")
-	assert.Equal(t, Alpha2RU, "RU")
-	assert.Equal(t, Alpha2RW, "RW")
-	assert.Equal(t, Alpha2BL, "BL")
-	assert.Equal(t, Alpha2SH, "SH")
-	assert.Equal(t, Alpha2KN, "KN")
-	assert.Equal(t, Alpha2LC, "LC")
-	assert.Equal(t, Alpha2MF, "MF")
-	assert.Equal(t, Alpha2PM, "PM")
-	assert.Equal(t, Alpha2VC, "VC")
-	assert.Equal(t, Alpha2WS, "WS")
-	assert.Equal(t, Alpha2SM, "SM")
-	assert.Equal(t, Alpha2ST, "ST")
-	assert.Equal(t, Alpha2SA, "SA")
-	assert.Equal(t, Alpha2SN, "SN")
-	assert.Equal(t, Alpha2RS, "RS")
-	assert.Equal(t, Alpha2SC, "SC")
-	assert.Equal(t, Alpha2SL, "SL")
-	assert.Equal(t, Alpha2SG, "SG")
-	assert.Equal(t, Alpha2SX, "SX")
-	assert.Equal(t, Alpha2SK, "SK")
-	assert.Equal(t, Alpha2SI, "SI")
-	assert.Equal(t, Alpha2SB, "SB")
-	assert.Equal(t, Alpha2SO, "SO")
-	assert.Equal(t, Alpha2ZA, "ZA")
-	assert.Equal(t, Alpha2GS, "GS")
-	assert.Equal(t, Alpha2SS, "SS")
-	assert.Equal(t, Alpha2ES, "ES")
-	assert.Equal(t, Alpha2LK, "LK")
-	assert.Equal(t, Alpha2SD, "SD")
-	assert.Equal(t, Alpha2SR, "SR")
-	assert.Equal(t, Alpha2SJ, "SJ")
-	assert.Equal(t, Alpha2SE, "SE")
-	assert.Equal(t, Alpha2CH, "CH")
-	assert.Equal(t, Alpha2SY, "SY")
-	assert.Equal(t, Alpha2TW, "TW")
-	assert.Equal(t, Alpha2TJ, "TJ")
-	assert.Equal(t, Alpha2TZ, "TZ")
-	assert.Equal(t, Alpha2TH, "TH")
-	assert.Equal(t, Alpha2TL, "TL")
-	assert.Equal(t, Alpha2TG, "TG")
-	assert.Equal(t, Alpha2TK, "TK")
-	assert.Equal(t, Alpha2TO, "TO")
-	assert.Equal(t, Alpha2TT, "TT")
-	assert.Equal(t, Alpha2TN, "TN")
-	assert.Equal(t, Alpha2TR, "TR")
-	assert.Equal(t, Alpha2TM, "TM")
-	assert.Equal(t, Alpha2TC, "TC")
-	assert.Equal(t, Alpha2TV, "TV")
-	assert.Equal(t, Alpha2UG, "UG")
-	assert.Equal(t, Alpha2UA, "UA")
-	assert.Equal(t, Alpha2AE, "AE")
-	assert.Equal(t, Alpha2GB, "GB")
-	assert.Equal(t, Alpha2US, "US")
-	assert.Equal(t, Alpha2UM, "UM")
-	assert.Equal(t, Alpha2UY, "UY")
-	assert.Equal(t, Alpha2UZ, "UZ")
-	assert.Equal(t, Alpha2VU, "VU")
-	assert.Equal(t, Alpha2VE, "VE")
-	assert.Equal(t, Alpha2VN, "VN")
-	assert.Equal(t, Alpha2VG, "VG")
-	assert.Equal(t, Alpha2VI, "VI")
-	assert.Equal(t, Alpha2WF, "WF")
-	assert.Equal(t, Alpha2EH, "EH")
-	assert.Equal(t, Alpha2YE, "YE")
-	assert.Equal(t, Alpha2ZM, "ZM")
-	assert.Equal(t, Alpha2ZW, "ZW")
+	assert.EqualValues(t, Alpha2AF, "AF")
+	assert.EqualValues(t, Alpha2AX, "AX")
+	assert.EqualValues(t, Alpha2AL, "AL")
+	assert.EqualValues(t, Alpha2DZ, "DZ")
+	assert.EqualValues(t, Alpha2AS, "AS")
+	assert.EqualValues(t, Alpha2AD, "AD")
+	assert.EqualValues(t, Alpha2AO, "AO")
+	assert.EqualValues(t, Alpha2AI, "AI")
+	assert.EqualValues(t, Alpha2AQ, "AQ")
+	assert.EqualValues(t, Alpha2AG, "AG")
+	assert.EqualValues(t, Alpha2AR, "AR")
+	assert.EqualValues(t, Alpha2AM, "AM")
+	assert.EqualValues(t, Alpha2AW, "AW")
+	assert.EqualValues(t, Alpha2AU, "AU")
+	assert.EqualValues(t, Alpha2AT, "AT")
+	assert.EqualValues(t, Alpha2AZ, "AZ")
+	assert.EqualValues(t, Alpha2BS, "BS")
+	assert.EqualValues(t, Alpha2BH, "BH")
+	assert.EqualValues(t, Alpha2BD, "BD")
+	assert.EqualValues(t, Alpha2BB, "BB")
+	assert.EqualValues(t, Alpha2BY, "BY")
+	assert.EqualValues(t, Alpha2BE, "BE")
+	assert.EqualValues(t, Alpha2BZ, "BZ")
+	assert.EqualValues(t, Alpha2BJ, "BJ")
+	assert.EqualValues(t, Alpha2BM, "BM")
+	assert.EqualValues(t, Alpha2BT, "BT")
+	assert.EqualValues(t, Alpha2BO, "BO")
+	assert.EqualValues(t, Alpha2BQ, "BQ")
+	assert.EqualValues(t, Alpha2BA, "BA")
+	assert.EqualValues(t, Alpha2BW, "BW")
+	assert.EqualValues(t, Alpha2BV, "BV")
+	assert.EqualValues(t, Alpha2BR, "BR")
+	assert.EqualValues(t, Alpha2IO, "IO")
+	assert.EqualValues(t, Alpha2BN, "BN")
+	assert.EqualValues(t, Alpha2BG, "BG")
+	assert.EqualValues(t, Alpha2BF, "BF")
+	assert.EqualValues(t, Alpha2BI, "BI")
+	assert.EqualValues(t, Alpha2CV, "CV")
+	assert.EqualValues(t, Alpha2KH, "KH")
+	assert.EqualValues(t, Alpha2CM, "CM")
+	assert.EqualValues(t, Alpha2CA, "CA")
+	assert.EqualValues(t, Alpha2KY, "KY")
+	assert.EqualValues(t, Alpha2CF, "CF")
+	assert.EqualValues(t, Alpha2TD, "TD")
+	assert.EqualValues(t, Alpha2CL, "CL")
+	assert.EqualValues(t, Alpha2CN, "CN")
+	assert.EqualValues(t, Alpha2CX, "CX")
+	assert.EqualValues(t, Alpha2CC, "CC")
+	assert.EqualValues(t, Alpha2CO, "CO")
+	assert.EqualValues(t, Alpha2KM, "KM")
+	assert.EqualValues(t, Alpha2CG, "CG")
+	assert.EqualValues(t, Alpha2CD, "CD")
+	assert.EqualValues(t, Alpha2CK, "CK")
+	assert.EqualValues(t, Alpha2CR, "CR")
+	assert.EqualValues(t, Alpha2CI, "CI")
+	assert.EqualValues(t, Alpha2HR, "HR")
+	assert.EqualValues(t, Alpha2CU, "CU")
+	assert.EqualValues(t, Alpha2CW, "CW")
+	assert.EqualValues(t, Alpha2CY, "CY")
+	assert.EqualValues(t, Alpha2CZ, "CZ")
+	assert.EqualValues(t, Alpha2DK, "DK")
+	assert.EqualValues(t, Alpha2DJ, "DJ")
+	assert.EqualValues(t, Alpha2DM, "DM")
+	assert.EqualValues(t, Alpha2DO, "DO")
+	assert.EqualValues(t, Alpha2EC, "EC")
+	assert.EqualValues(t, Alpha2EG, "EG")
+	assert.EqualValues(t, Alpha2SV, "SV")
+	assert.EqualValues(t, Alpha2GQ, "GQ")
+	assert.EqualValues(t, Alpha2ER, "ER")
+	assert.EqualValues(t, Alpha2EE, "EE")
+	assert.EqualValues(t, Alpha2SZ, "SZ")
+	assert.EqualValues(t, Alpha2ET, "ET")
+	assert.EqualValues(t, Alpha2FK, "FK")
+	assert.EqualValues(t, Alpha2FO, "FO")
+	assert.EqualValues(t, Alpha2FJ, "FJ")
+	assert.EqualValues(t, Alpha2FI, "FI")
+	assert.EqualValues(t, Alpha2FR, "FR")
+	assert.EqualValues(t, Alpha2GF, "GF")
+	assert.EqualValues(t, Alpha2PF, "PF")
+	assert.EqualValues(t, Alpha2TF, "TF")
+	assert.EqualValues(t, Alpha2GA, "GA")
+	assert.EqualValues(t, Alpha2GM, "GM")
+	assert.EqualValues(t, Alpha2GE, "GE")
+	assert.EqualValues(t, Alpha2DE, "DE")
+	assert.EqualValues(t, Alpha2GH, "GH")
+	assert.EqualValues(t, Alpha2GI, "GI")
+	assert.EqualValues(t, Alpha2GR, "GR")
+	assert.EqualValues(t, Alpha2GL, "GL")
+	assert.EqualValues(t, Alpha2GD, "GD")
+	assert.EqualValues(t, Alpha2GP, "GP")
+	assert.EqualValues(t, Alpha2GU, "GU")
+	assert.EqualValues(t, Alpha2GT, "GT")
+	assert.EqualValues(t, Alpha2GG, "GG")
+	assert.EqualValues(t, Alpha2GN, "GN")
+	assert.EqualValues(t, Alpha2GW, "GW")
+	assert.EqualValues(t, Alpha2GY, "GY")
+	assert.EqualValues(t, Alpha2HT, "HT")
+	assert.EqualValues(t, Alpha2HM, "HM")
+	assert.EqualValues(t, Alpha2VA, "VA")
+	assert.EqualValues(t, Alpha2HN, "HN")
+	assert.EqualValues(t, Alpha2HK, "HK")
+	assert.EqualValues(t, Alpha2HU, "HU")
+	assert.EqualValues(t, Alpha2IS, "IS")
+	assert.EqualValues(t, Alpha2IN, "IN")
+	assert.EqualValues(t, Alpha2ID, "ID")
+	assert.EqualValues(t, Alpha2IR, "IR")
+	assert.EqualValues(t, Alpha2IQ, "IQ")
+	assert.EqualValues(t, Alpha2IE, "IE")
+	assert.EqualValues(t, Alpha2IM, "IM")
+	assert.EqualValues(t, Alpha2IL, "IL")
+	assert.EqualValues(t, Alpha2IT, "IT")
+	assert.EqualValues(t, Alpha2JM, "JM")
+	assert.EqualValues(t, Alpha2JP, "JP") // Japan, should exist if all codes are present
+
+	assert.EqualValues(t, Alpha2JE, "JE")
+	assert.EqualValues(t, Alpha2JO, "JO")
+	assert.EqualValues(t, Alpha2KZ, "KZ")
+	assert.EqualValues(t, Alpha2KE, "KE")
+	assert.EqualValues(t, Alpha2KI, "KI")
+	assert.EqualValues(t, Alpha2KP, "KP")
+	assert.EqualValues(t, Alpha2KR, "KR")
+	assert.EqualValues(t, Alpha2KW, "KW")
+	assert.EqualValues(t, Alpha2KG, "KG")
+	assert.EqualValues(t, Alpha2LA, "LA")
+	assert.EqualValues(t, Alpha2LV, "LV")
+	assert.EqualValues(t, Alpha2LB, "LB")
+	assert.EqualValues(t, Alpha2LS, "LS")
+	assert.EqualValues(t, Alpha2LR, "LR")
+	assert.EqualValues(t, Alpha2LY, "LY")
+	assert.EqualValues(t, Alpha2LI, "LI")
+	assert.EqualValues(t, Alpha2LT, "LT")
+	assert.EqualValues(t, Alpha2LU, "LU")
+	assert.EqualValues(t, Alpha2MO, "MO")
+	assert.EqualValues(t, Alpha2MG, "MG")
+	assert.EqualValues(t, Alpha2MW, "MW")
+	assert.EqualValues(t, Alpha2MY, "MY")
+	assert.EqualValues(t, Alpha2MV, "MV")
+	assert.EqualValues(t, Alpha2ML, "ML")
+	assert.EqualValues(t, Alpha2MT, "MT")
+	assert.EqualValues(t, Alpha2MH, "MH")
+	assert.EqualValues(t, Alpha2MQ, "MQ")
+	assert.EqualValues(t, Alpha2MR, "MR")
+	assert.EqualValues(t, Alpha2MU, "MU")
+	assert.EqualValues(t, Alpha2YT, "YT")
+	assert.EqualValues(t, Alpha2MX, "MX")
+	assert.EqualValues(t, Alpha2FM, "FM")
+	assert.EqualValues(t, Alpha2MD, "MD")
+	assert.EqualValues(t, Alpha2MC, "MC")
+	assert.EqualValues(t, Alpha2MN, "MN")
+	assert.EqualValues(t, Alpha2ME, "ME")
+	assert.EqualValues(t, Alpha2MS, "MS")
+	assert.EqualValues(t, Alpha2MA, "MA")
+	assert.EqualValues(t, Alpha2MZ, "MZ")
+	assert.EqualValues(t, Alpha2MM, "MM")
+	assert.EqualValues(t, Alpha2NA, "NA")
+	assert.EqualValues(t, Alpha2NR, "NR")
+	assert.EqualValues(t, Alpha2NP, "NP")
+	assert.EqualValues(t, Alpha2NL, "NL")
+	assert.EqualValues(t, Alpha2NC, "NC")
+	assert.EqualValues(t, Alpha2NZ, "NZ")
+	assert.EqualValues(t, Alpha2NI, "NI")
+	assert.EqualValues(t, Alpha2NE, "NE")
+	assert.EqualValues(t, Alpha2NG, "NG")
+	assert.EqualValues(t, Alpha2NU, "NU")
+	assert.EqualValues(t, Alpha2NF, "NF")
+	assert.EqualValues(t, Alpha2MK, "MK")
+	assert.EqualValues(t, Alpha2MP, "MP")
+	assert.EqualValues(t, Alpha2NO, "NO")
+	assert.EqualValues(t, Alpha2OM, "OM")
+	assert.EqualValues(t, Alpha2PK, "PK")
+	assert.EqualValues(t, Alpha2PW, "PW")
+	assert.EqualValues(t, Alpha2PS, "PS")
+	assert.EqualValues(t, Alpha2PA, "PA")
+	assert.EqualValues(t, Alpha2PG, "PG")
+	assert.EqualValues(t, Alpha2PY, "PY")
+	assert.EqualValues(t, Alpha2PE, "PE")
+	assert.EqualValues(t, Alpha2PH, "PH")
+	assert.EqualValues(t, Alpha2PN, "PN")
+	assert.EqualValues(t, Alpha2PL, "PL")
+	assert.EqualValues(t, Alpha2PT, "PT")
+	assert.EqualValues(t, Alpha2PR, "PR")
+	assert.EqualValues(t, Alpha2QA, "QA")
+	assert.EqualValues(t, Alpha2RE, "RE")
+	assert.EqualValues(t, Alpha2RO, "RO")
+	assert.EqualValues(t, Alpha2RU, "RU")
+	assert.EqualValues(t, Alpha2RW, "RW")
+	assert.EqualValues(t, Alpha2BL, "BL")
+	assert.EqualValues(t, Alpha2SH, "SH")
+	assert.EqualValues(t, Alpha2KN, "KN")
+	assert.EqualValues(t, Alpha2LC, "LC")
+	assert.EqualValues(t, Alpha2MF, "MF")
+	assert.EqualValues(t, Alpha2PM, "PM")
+	assert.EqualValues(t, Alpha2VC, "VC")
+	assert.EqualValues(t, Alpha2WS, "WS")
+	assert.EqualValues(t, Alpha2SM, "SM")
+	assert.EqualValues(t, Alpha2ST, "ST")
+	assert.EqualValues(t, Alpha2SA, "SA")
+	assert.EqualValues(t, Alpha2SN, "SN")
+	assert.EqualValues(t, Alpha2RS, "RS")
+	assert.EqualValues(t, Alpha2SC, "SC")
+	assert.EqualValues(t, Alpha2SL, "SL")
+	assert.EqualValues(t, Alpha2SG, "SG")
+	assert.EqualValues(t, Alpha2SX, "SX")
+	assert.EqualValues(t, Alpha2SK, "SK")
+	assert.EqualValues(t, Alpha2SI, "SI")
+	assert.EqualValues(t, Alpha2SB, "SB")
+	assert.EqualValues(t, Alpha2SO, "SO")
+	assert.EqualValues(t, Alpha2ZA, "ZA")
+	assert.EqualValues(t, Alpha2GS, "GS")
+	assert.EqualValues(t, Alpha2SS, "SS")
+	assert.EqualValues(t, Alpha2ES, "ES")
+	assert.EqualValues(t, Alpha2LK, "LK")
+	assert.EqualValues(t, Alpha2SD, "SD")
+	assert.EqualValues(t, Alpha2SR, "SR")
+	assert.EqualValues(t, Alpha2SJ, "SJ")
+	assert.EqualValues(t, Alpha2SE, "SE")
+	assert.EqualValues(t, Alpha2CH, "CH")
+	assert.EqualValues(t, Alpha2SY, "SY")
+	assert.EqualValues(t, Alpha2TW, "TW")
+	assert.EqualValues(t, Alpha2TJ, "TJ")
+	assert.EqualValues(t, Alpha2TZ, "TZ")
+	assert.EqualValues(t, Alpha2TH, "TH")
+	assert.EqualValues(t, Alpha2TL, "TL")
+	assert.EqualValues(t, Alpha2TG, "TG")
+	assert.EqualValues(t, Alpha2TK, "TK")
+	assert.EqualValues(t, Alpha2TO, "TO")
+	assert.EqualValues(t, Alpha2TT, "TT")
+	assert.EqualValues(t, Alpha2TN, "TN")
+	assert.EqualValues(t, Alpha2TR, "TR")
+	assert.EqualValues(t, Alpha2TM, "TM")
+	assert.EqualValues(t, Alpha2TC, "TC")
+	assert.EqualValues(t, Alpha2TV, "TV")
+	assert.EqualValues(t, Alpha2UG, "UG")
+	assert.EqualValues(t, Alpha2UA, "UA")
+	assert.EqualValues(t, Alpha2AE, "AE")
+	assert.EqualValues(t, Alpha2GB, "GB")
+	assert.EqualValues(t, Alpha2US, "US")
+	assert.EqualValues(t, Alpha2UM, "UM")
+	assert.EqualValues(t, Alpha2UY, "UY")
+	assert.EqualValues(t, Alpha2UZ, "UZ")
+	assert.EqualValues(t, Alpha2VU, "VU")
+	assert.EqualValues(t, Alpha2VE, "VE")
+	assert.EqualValues(t, Alpha2VN, "VN")
+	assert.EqualValues(t, Alpha2VG, "VG")
+	assert.EqualValues(t, Alpha2VI, "VI")
+	assert.EqualValues(t, Alpha2WF, "WF")
+	assert.EqualValues(t, Alpha2EH, "EH")
+	assert.EqualValues(t, Alpha2YE, "YE")
+	assert.EqualValues(t, Alpha2ZM, "ZM")
+	assert.EqualValues(t, Alpha2ZW, "ZW")
 
 	// Alpha-3 codes
-	assert.Equal(t, Alpha3AFG, "AFG")
-	assert.Equal(t, Alpha3ALA, "ALA")
-	assert.Equal(t, Alpha3ALB, "ALB")
-	assert.Equal(t, Alpha3DZA, "DZA")
-	assert.Equal(t, Alpha3ASM, "ASM")
-	assert.Equal(t, Alpha3AND, "AND")
-	assert.Equal(t, Alpha3AGO, "AGO")
-	assert.Equal(t, Alpha3AIA, "AIA")
-	assert.Equal(t, Alpha3ATA, "ATA")
-	assert.Equal(t, Alpha3ATG, "ATG")
-	assert.Equal(t, Alpha3ARG, "ARG")
-	assert.Equal(t, Alpha3ARM, "ARM")
-	assert.Equal(t, Alpha3ABW, "ABW")
-	assert.Equal(t, Alpha3AUS, "AUS")
-	assert.Equal(t, Alpha3AUT, "AUT")
-	assert.Equal(t, Alpha3AZE, "AZE")
-	assert.Equal(t, Alpha3BHS, "BHS")
-	assert.Equal(t, Alpha3BHR, "BHR")
-	assert.Equal(t, Alpha3BGD, "BGD")
-	assert.Equal(t, Alpha3BRB, "BRB")
-	assert.Equal(t, Alpha3BLR, "BLR")
-	assert.Equal(t, Alpha3BEL, "BEL")
-	assert.Equal(t, Alpha3BLZ, "BLZ")
-	assert.Equal(t, Alpha3BEN, "BEN")
-	assert.Equal(t, Alpha3BMU, "BMU")
-	assert.Equal(t, Alpha3BTN, "BTN")
-	assert.Equal(t, Alpha3BOL, "BOL")
-	assert.Equal(t, Alpha3BES, "BES")
-	assert.Equal(t, Alpha3BIH, "BIH")
-	assert.Equal(t, Alpha3BWA, "BWA")
-	assert.Equal(t, Alpha3BVT, "BVT")
-	assert.Equal(t, Alpha3BRA, "BRA")
-	assert.Equal(t, Alpha3IOT, "IOT")
-	assert.Equal(t, Alpha3BRN, "BRN")
-	assert.Equal(t, Alpha3BGR, "BGR")
-	assert.Equal(t, Alpha3BFA, "BFA")
-	assert.Equal(t, Alpha3BDI, "BDI")
-	assert.Equal(t, Alpha3CPV, "CPV")
-	assert.Equal(t, Alpha3KHM, "KHM")
-	assert.Equal(t, Alpha3CMR, "CMR")
-	assert.Equal(t, Alpha3CAN, "CAN")
-	assert.Equal(t, Alpha3CYM, "CYM")
-	assert.Equal(t, Alpha3CAF, "CAF")
-	assert.Equal(t, Alpha3TCD, "TCD")
-	assert.Equal(t, Alpha3CHL, "CHL")
-	assert.Equal(t, Alpha3CHN, "CHN")
-	assert.Equal(t, Alpha3CXR, "CXR")
-	assert.Equal(t, Alpha3CCK, "CCK")
-	assert.Equal(t, Alpha3COL, "COL")
-	assert.Equal(t, Alpha3COM, "COM")
-	assert.Equal(t, Alpha3COG, "COG")
-	assert.Equal(t, Alpha3COD, "COD")
-	assert.Equal(t, Alpha3COK, "COK")
-	assert.Equal(t, Alpha3CRI, "CRI")
-	assert.Equal(t, Alpha3CIV, "CIV")
-	assert.Equal(t, Alpha3HRV, "HRV")
-	assert.Equal(t, Alpha3CUB, "CUB")
-	assert.Equal(t, Alpha3CUW, "CUW")
-	assert.Equal(t, Alpha3CYP, "CYP")
-	assert.Equal(t, Alpha3CZE, "CZE")
-	assert.Equal(t, Alpha3DNK, "DNK")
-	assert.Equal(t, Alpha3DJI, "DJI")
-	assert.Equal(t, Alpha3DMA, "DMA")
-	assert.Equal(t, Alpha3DOM, "DOM")
-	assert.Equal(t, Alpha3ECU, "ECU")
-	assert.Equal(t, Alpha3EGY, "EGY")
-	assert.Equal(t, Alpha3SLV, "SLV")
-	assert.Equal(t, Alpha3GNQ, "GNQ")
-	assert.Equal(t, Alpha3ERI, "ERI")
-	assert.Equal(t, Alpha3EST, "EST")
-	assert.Equal(t, Alpha3SWZ, "SWZ")
-	assert.Equal(t, Alpha3ETH, "ETH")
-	assert.Equal(t, Alpha3FLK, "FLK")
-	assert.Equal(t, Alpha3FRO, "FRO")
-	assert.Equal(t, Alpha3FJI, "FJI")
-	assert.Equal(t, Alpha3FIN, "FIN")
-	assert.Equal(t, Alpha3FRA, "FRA")
-	assert.Equal(t, Alpha3GUF, "GUF")
-	assert.Equal(t, Alpha3PYF, "PYF")
-	assert.Equal(t, Alpha3ATF, "ATF")
-	assert.Equal(t, Alpha3GAB, "GAB")
-	assert.Equal(t, Alpha3GMB, "GMB")
-	assert.Equal(t, Alpha3GEO, "GEO")
-	assert.Equal(t, Alpha3DEU, "DEU")
-	assert.Equal(t, Alpha3GHA, "GHA")
-	assert.Equal(t, Alpha3GIB, "GIB")
-	assert.Equal(t, Alpha3GRC, "GRC")
-	assert.Equal(t, Alpha3GRL, "GRL")
-	assert.Equal(t, Alpha3GRD, "GRD")
-	assert.Equal(t, Alpha3GLP, "GLP")
-	assert.Equal(t, Alpha3GUM, "GUM")
-	assert.Equal(t, Alpha3GTM, "GTM")
-	assert.Equal(t, Alpha3GGY, "GGY")
-	assert.Equal(t, Alpha3GIN, "GIN")
-	assert.Equal(t, Alpha3GNB, "GNB")
-	assert.Equal(t, Alpha3GUY, "GUY")
-	assert.Equal(t, Alpha3HTI, "HTI")
-	assert.Equal(t, Alpha3HMD, "HMD")
-	assert.Equal(t, Alpha3VAT, "VAT")
-	assert.Equal(t, Alpha3HND, "HND")
-	assert.Equal(t, Alpha3HKG, "HKG")
-	assert.Equal(t, Alpha3HUN, "HUN")
-	assert.Equal(t, Alpha3ISL, "ISL")
-	assert.Equal(t, Alpha3IND, "IND")
-	assert.Equal(t, Alpha3IDN, "IDN")
-	assert.Equal(t, Alpha3IRN, "IRN")
-	assert.Equal(t, Alpha3IRQ, "IRQ")
-	assert.Equal(t, Alpha3IRL, "IRL")
-	assert.Equal(t, Alpha3IMN, "IMN")
-	assert.Equal(t, Alpha3ISR, "ISR")
-	assert.Equal(t, Alpha3ITA, "ITA")
-	assert.Equal(t, Alpha3JAM, "JAM")
-	assert.Equal(t, Alpha3JPN, "JPN")
-	assert.Equal(t, Alpha3JEY, "JEY")
-	assert.Equal(t, Alpha3JOR, "JOR")
-	assert.Equal(t, Alpha3KAZ, "KAZ")
-	assert.Equal(t, Alpha3KEN, "KEN")
-	assert.Equal(t, Alpha3KIR, "KIR")
-	assert.Equal(t, Alpha3PRK, "PRK")
-	assert.Equal(t, Alpha3KOR, "KOR")
-	assert.Equal(t, Alpha3KWT, "KWT")
-	assert.Equal(t, Alpha3KGZ, "KGZ")
-	assert.Equal(t, Alpha3LAO, "LAO")
-	assert.Equal(t, Alpha3LVA, "LVA")
-	assert.Equal(t, Alpha3LBN, "LBN")
-	assert.Equal(t, Alpha3LSO, "LSO")
-	assert.Equal(t, Alpha3LBR, "LBR")
-	assert.Equal(t, Alpha3LBY, "LBY")
-	assert.Equal(t, Alpha3LIE, "LIE")
-	assert.Equal(t, Alpha3LTU, "LTU")
-	assert.Equal(t, Alpha3LUX, "LUX")
-	assert.Equal(t, Alpha3MAC, "MAC")
-	assert.Equal(t, Alpha3MDG, "MDG")
-	assert.Equal(t, Alpha3MWI, "MWI")
-	assert.Equal(t, Alpha3MYS, "MYS")
-	assert.Equal(t, Alpha3MDV, "MDV")
-	assert.Equal(t, Alpha3MLI, "MLI")
-	assert.Equal(t, Alpha3MLT, "MLT")
-	assert.Equal(t, Alpha3MHL, "MHL")
-	assert.Equal(t, Alpha3MTQ, "MTQ")
-	assert.Equal(t, Alpha3MRT, "MRT")
-	assert.Equal(t, Alpha3MUS, "MUS")
-	assert.Equal(t, Alpha3MYT, "MYT")
-	assert.Equal(t, Alpha3MEX, "MEX")
-	assert.Equal(t, Alpha3FSM, "FSM")
-	assert.Equal(t, Alpha3MDA, "MDA")
-	assert.Equal(t, Alpha3MCO, "MCO")
-	assert.Equal(t, Alpha3MNG, "MNG")
-	assert.Equal(t, Alpha3MNE, "MNE")
-	assert.Equal(t, Alpha3MSR, "MSR")
-	assert.Equal(t, Alpha3MAR, "MAR")
-	assert.Equal(t, Alpha3MOZ, "MOZ")
-	assert.Equal(t, Alpha3MMR, "MMR")
-	assert.Equal(t, Alpha3NAM, "NAM")
-	assert.Equal(t, Alpha3NRU, "NRU")
-	assert.Equal(t, Alpha3NPL, "NPL")
-	assert.Equal(t, Alpha3NLD, "NLD")
-	assert.Equal(t, Alpha3NCL, "NCL")
-	assert.Equal(t, Alpha3NZL, "NZL")
-	assert.Equal(t, Alpha3NIC, "NIC")
-	assert.Equal(t, Alpha3NER, "NER")
-	assert.Equal(t, Alpha3NGA, "NGA")
-	assert.Equal(t, Alpha3NIU, "NIU")
-	assert.Equal(t, Alpha3NFK, "NFK")
-	assert.Equal(t, Alpha3MKD, "MKD")
-	assert.Equal(t, Alpha3MNP, "MNP")
-	assert.Equal(t, Alpha3NOR, "NOR")
-	assert.Equal(t, Alpha3OMN, "OMN")
-	assert.Equal(t, Alpha3PAK, "PAK")
-	assert.Equal(t, Alpha3PLW, "PLW")
-	assert.Equal(t, Alpha3PSE, "PSE")
-	assert.Equal(t, Alpha3PAN, "PAN")
-	assert.Equal(t, Alpha3PNG, "PNG")
-	assert.Equal(t, Alpha3PRY, "PRY")
-	assert.Equal(t, Alpha3PER, "PER")
-	assert.Equal(t, Alpha3PHL, "PHL")
-	assert.Equal(t, Alpha3PCN, "PCN")
-	assert.Equal(t, Alpha3POL, "POL")
-	assert.Equal(t, Alpha3PRT, "PRT")
-	assert.Equal(t, Alpha3PRI, "PRI")
-	assert.Equal(t, Alpha3QAT, "QAT")
-	assert.Equal(t, Alpha3REU, "REU")
-	assert.Equal(t, Alpha3ROU, "ROU")
-	assert.Equal(t, Alpha3RUS, "RUS")
-	assert.Equal(t, Alpha3RWA, "RWA")
-	assert.Equal(t, Alpha3BLM, "BLM")
-	assert.Equal(t, Alpha3SHN, "SHN")
-	assert.Equal(t, Alpha3KNA, "KNA")
-	assert.Equal(t, Alpha3LCA, "LCA")
-	assert.Equal(t, Alpha3MAF, "MAF")
-	assert.Equal(t, Alpha3SPM, "SPM")
-	assert.Equal(t, Alpha3VCT, "VCT")
-	assert.Equal(t, Alpha3WSM, "WSM")
-	assert.Equal(t, Alpha3SMR, "SMR")
-	assert.Equal(t, Alpha3STP, "STP")
-	assert.Equal(t, Alpha3SAU, "SAU")
-	assert.Equal(t, Alpha3SEN, "SEN")
-	assert.Equal(t, Alpha3SRB, "SRB")
-	assert.Equal(t, Alpha3SYC, "SYC")
-	assert.Equal(t, Alpha3SLE, "SLE")
-	assert.Equal(t, Alpha3SGP, "SGP")
-	assert.Equal(t, Alpha3SXM, "SXM")
-	assert.Equal(t, Alpha3SVK, "SVK")
-	assert.Equal(t, Alpha3SVN, "SVN")
-	assert.Equal(t, Alpha3SLB, "SLB")
-	assert.Equal(t, Alpha3SOM, "SOM")
-	assert.Equal(t, Alpha3ZAF, "ZAF")
-	assert.Equal(t, Alpha3SGS, "SGS")
-	assert.Equal(t, Alpha3SSD, "SSD")
-	assert.Equal(t, Alpha3ESP, "ESP")
-	assert.Equal(t, Alpha3LKA, "LKA")
-	assert.Equal(t, Alpha3SDN, "SDN")
-	assert.Equal(t, Alpha3SUR, "SUR")
-	assert.Equal(t, Alpha3SJM, "SJM")
-	assert.Equal(t, Alpha3SWE, "SWE")
-	assert.Equal(t, Alpha3CHE, "CHE")
-	assert.Equal(t, Alpha3SYR, "SYR")
-	assert.Equal(t, Alpha3TWN, "TWN")
-	assert.Equal(t, Alpha3TJK, "TJK")
-	assert.Equal(t, Alpha3TZA, "TZA")
-	assert.Equal(t, Alpha3THA, "THA")
-	assert.Equal(t, Alpha3TLS, "TLS")
-	assert.Equal(t, Alpha3TGO, "TGO")
-	assert.Equal(t, Alpha3TKL, "TKL")
-	assert.Equal(t, Alpha3TON, "TON")
-	assert.Equal(t, Alpha3TTO, "TTO")
-	assert.Equal(t, Alpha3TUN, "TUN")
-	assert.Equal(t, Alpha3TUR, "TUR")
-	assert.Equal(t, Alpha3TKM, "TKM")
-	assert.Equal(t, Alpha3TCA, "TCA")
-	assert.Equal(t, Alpha3TUV, "TUV")
-	assert.Equal(t, Alpha3UGA, "UGA")
-	assert.Equal(t, Alpha3UKR, "UKR")
-	assert.Equal(t, Alpha3ARE, "ARE")
-	assert.Equal(t, Alpha3GBR, "GBR")
-	assert.Equal(t, Alpha3USA, "USA")
-	assert.Equal(t, Alpha3UMI, "UMI")
-	assert.Equal(t, Alpha3URY, "URY")
-	assert.Equal(t, Alpha3UZB, "UZB")
-	assert.Equal(t, Alpha3VUT, "VUT")
-	assert.Equal(t, Alpha3VEN, "VEN")
-	assert.Equal(t, Alpha3VNM, "VNM")
-	assert.Equal(t, Alpha3VGB, "VGB")
-	assert.Equal(t, Alpha3VIR, "VIR")
-	assert.Equal(t, Alpha3WLF, "WLF")
-	assert.Equal(t, Alpha3ESH, "ESH")
-	assert.Equal(t, Alpha3YEM, "YEM")
-	assert.Equal(t, Alpha3ZMB, "ZMB")
-	assert.Equal(t, Alpha3ZWE, "ZWE")
+	assert.EqualValues(t, Alpha3AFG, "AFG")
+	assert.EqualValues(t, Alpha3ALA, "ALA")
+	assert.EqualValues(t, Alpha3ALB, "ALB")
+	assert.EqualValues(t, Alpha3DZA, "DZA")
+	assert.EqualValues(t, Alpha3ASM, "ASM")
+	assert.EqualValues(t, Alpha3AND, "AND")
+	assert.EqualValues(t, Alpha3AGO, "AGO")
+	assert.EqualValues(t, Alpha3AIA, "AIA")
+	assert.EqualValues(t, Alpha3ATA, "ATA")
+	assert.EqualValues(t, Alpha3ATG, "ATG")
+	assert.EqualValues(t, Alpha3ARG, "ARG")
+	assert.EqualValues(t, Alpha3ARM, "ARM")
+	assert.EqualValues(t, Alpha3ABW, "ABW")
+	assert.EqualValues(t, Alpha3AUS, "AUS")
+	assert.EqualValues(t, Alpha3AUT, "AUT")
+	assert.EqualValues(t, Alpha3AZE, "AZE")
+	assert.EqualValues(t, Alpha3BHS, "BHS")
+	assert.EqualValues(t, Alpha3BHR, "BHR")
+	assert.EqualValues(t, Alpha3BGD, "BGD")
+	assert.EqualValues(t, Alpha3BRB, "BRB")
+	assert.EqualValues(t, Alpha3BLR, "BLR")
+	assert.EqualValues(t, Alpha3BEL, "BEL")
+	assert.EqualValues(t, Alpha3BLZ, "BLZ")
+	assert.EqualValues(t, Alpha3BEN, "BEN")
+	assert.EqualValues(t, Alpha3BMU, "BMU")
+	assert.EqualValues(t, Alpha3BTN, "BTN")
+	assert.EqualValues(t, Alpha3BOL, "BOL")
+	assert.EqualValues(t, Alpha3BES, "BES")
+	assert.EqualValues(t, Alpha3BIH, "BIH")
+	assert.EqualValues(t, Alpha3BWA, "BWA")
+	assert.EqualValues(t, Alpha3BVT, "BVT")
+	assert.EqualValues(t, Alpha3BRA, "BRA")
+	assert.EqualValues(t, Alpha3IOT, "IOT")
+	assert.EqualValues(t, Alpha3BRN, "BRN")
+	assert.EqualValues(t, Alpha3BGR, "BGR")
+	assert.EqualValues(t, Alpha3BFA, "BFA")
+	assert.EqualValues(t, Alpha3BDI, "BDI")
+	assert.EqualValues(t, Alpha3CPV, "CPV")
+	assert.EqualValues(t, Alpha3KHM, "KHM")
+	assert.EqualValues(t, Alpha3CMR, "CMR")
+	assert.EqualValues(t, Alpha3CAN, "CAN")
+	assert.EqualValues(t, Alpha3CYM, "CYM")
+	assert.EqualValues(t, Alpha3CAF, "CAF")
+	assert.EqualValues(t, Alpha3TCD, "TCD")
+	assert.EqualValues(t, Alpha3CHL, "CHL")
+	assert.EqualValues(t, Alpha3CHN, "CHN")
+	assert.EqualValues(t, Alpha3CXR, "CXR")
+	assert.EqualValues(t, Alpha3CCK, "CCK")
+	assert.EqualValues(t, Alpha3COL, "COL")
+	assert.EqualValues(t, Alpha3COM, "COM")
+	assert.EqualValues(t, Alpha3COG, "COG")
+	assert.EqualValues(t, Alpha3COD, "COD")
+	assert.EqualValues(t, Alpha3COK, "COK")
+	assert.EqualValues(t, Alpha3CRI, "CRI")
+	assert.EqualValues(t, Alpha3CIV, "CIV")
+	assert.EqualValues(t, Alpha3HRV, "HRV")
+	assert.EqualValues(t, Alpha3CUB, "CUB")
+	assert.EqualValues(t, Alpha3CUW, "CUW")
+	assert.EqualValues(t, Alpha3CYP, "CYP")
+	assert.EqualValues(t, Alpha3CZE, "CZE")
+	assert.EqualValues(t, Alpha3DNK, "DNK")
+	assert.EqualValues(t, Alpha3DJI, "DJI")
+	assert.EqualValues(t, Alpha3DMA, "DMA")
+	assert.EqualValues(t, Alpha3DOM, "DOM")
+	assert.EqualValues(t, Alpha3ECU, "ECU")
+	assert.EqualValues(t, Alpha3EGY, "EGY")
+	assert.EqualValues(t, Alpha3SLV, "SLV")
+	assert.EqualValues(t, Alpha3GNQ, "GNQ")
+	assert.EqualValues(t, Alpha3ERI, "ERI")
+	assert.EqualValues(t, Alpha3EST, "EST")
+	assert.EqualValues(t, Alpha3SWZ, "SWZ")
+	assert.EqualValues(t, Alpha3ETH, "ETH")
+	assert.EqualValues(t, Alpha3FLK, "FLK")
+	assert.EqualValues(t, Alpha3FRO, "FRO")
+	assert.EqualValues(t, Alpha3FJI, "FJI")
+	assert.EqualValues(t, Alpha3FIN, "FIN")
+	assert.EqualValues(t, Alpha3FRA, "FRA")
+	assert.EqualValues(t, Alpha3GUF, "GUF")
+	assert.EqualValues(t, Alpha3PYF, "PYF")
+	assert.EqualValues(t, Alpha3ATF, "ATF")
+	assert.EqualValues(t, Alpha3GAB, "GAB")
+	assert.EqualValues(t, Alpha3GMB, "GMB")
+	assert.EqualValues(t, Alpha3GEO, "GEO")
+	assert.EqualValues(t, Alpha3DEU, "DEU")
+	assert.EqualValues(t, Alpha3GHA, "GHA")
+	assert.EqualValues(t, Alpha3GIB, "GIB")
+	assert.EqualValues(t, Alpha3GRC, "GRC")
+	assert.EqualValues(t, Alpha3GRL, "GRL")
+	assert.EqualValues(t, Alpha3GRD, "GRD")
+	assert.EqualValues(t, Alpha3GLP, "GLP")
+	assert.EqualValues(t, Alpha3GUM, "GUM")
+	assert.EqualValues(t, Alpha3GTM, "GTM")
+	assert.EqualValues(t, Alpha3GGY, "GGY")
+	assert.EqualValues(t, Alpha3GIN, "GIN")
+	assert.EqualValues(t, Alpha3GNB, "GNB")
+	assert.EqualValues(t, Alpha3GUY, "GUY")
+	assert.EqualValues(t, Alpha3HTI, "HTI")
+	assert.EqualValues(t, Alpha3HMD, "HMD")
+	assert.EqualValues(t, Alpha3VAT, "VAT")
+	assert.EqualValues(t, Alpha3HND, "HND")
+	assert.EqualValues(t, Alpha3HKG, "HKG")
+	assert.EqualValues(t, Alpha3HUN, "HUN")
+	assert.EqualValues(t, Alpha3ISL, "ISL")
+	assert.EqualValues(t, Alpha3IND, "IND")
+	assert.EqualValues(t, Alpha3IDN, "IDN")
+	assert.EqualValues(t, Alpha3IRN, "IRN")
+	assert.EqualValues(t, Alpha3IRQ, "IRQ")
+	assert.EqualValues(t, Alpha3IRL, "IRL")
+	assert.EqualValues(t, Alpha3IMN, "IMN")
+	assert.EqualValues(t, Alpha3ISR, "ISR")
+	assert.EqualValues(t, Alpha3ITA, "ITA")
+	assert.EqualValues(t, Alpha3JAM, "JAM")
+	assert.EqualValues(t, Alpha3JPN, "JPN")
+	assert.EqualValues(t, Alpha3JEY, "JEY")
+	assert.EqualValues(t, Alpha3JOR, "JOR")
+	assert.EqualValues(t, Alpha3KAZ, "KAZ")
+	assert.EqualValues(t, Alpha3KEN, "KEN")
+	assert.EqualValues(t, Alpha3KIR, "KIR")
+	assert.EqualValues(t, Alpha3PRK, "PRK")
+	assert.EqualValues(t, Alpha3KOR, "KOR")
+	assert.EqualValues(t, Alpha3KWT, "KWT")
+	assert.EqualValues(t, Alpha3KGZ, "KGZ")
+	assert.EqualValues(t, Alpha3LAO, "LAO")
+	assert.EqualValues(t, Alpha3LVA, "LVA")
+	assert.EqualValues(t, Alpha3LBN, "LBN")
+	assert.EqualValues(t, Alpha3LSO, "LSO")
+	assert.EqualValues(t, Alpha3LBR, "LBR")
+	assert.EqualValues(t, Alpha3LBY, "LBY")
+	assert.EqualValues(t, Alpha3LIE, "LIE")
+	assert.EqualValues(t, Alpha3LTU, "LTU")
+	assert.EqualValues(t, Alpha3LUX, "LUX")
+	assert.EqualValues(t, Alpha3MAC, "MAC")
+	assert.EqualValues(t, Alpha3MDG, "MDG")
+	assert.EqualValues(t, Alpha3MWI, "MWI")
+	assert.EqualValues(t, Alpha3MYS, "MYS")
+	assert.EqualValues(t, Alpha3MDV, "MDV")
+	assert.EqualValues(t, Alpha3MLI, "MLI")
+	assert.EqualValues(t, Alpha3MLT, "MLT")
+	assert.EqualValues(t, Alpha3MHL, "MHL")
+	assert.EqualValues(t, Alpha3MTQ, "MTQ")
+	assert.EqualValues(t, Alpha3MRT, "MRT")
+	assert.EqualValues(t, Alpha3MUS, "MUS")
+	assert.EqualValues(t, Alpha3MYT, "MYT")
+	assert.EqualValues(t, Alpha3MEX, "MEX")
+	assert.EqualValues(t, Alpha3FSM, "FSM")
+	assert.EqualValues(t, Alpha3MDA, "MDA")
+	assert.EqualValues(t, Alpha3MCO, "MCO")
+	assert.EqualValues(t, Alpha3MNG, "MNG")
+	assert.EqualValues(t, Alpha3MNE, "MNE")
+	assert.EqualValues(t, Alpha3MSR, "MSR")
+	assert.EqualValues(t, Alpha3MAR, "MAR")
+	assert.EqualValues(t, Alpha3MOZ, "MOZ")
+	assert.EqualValues(t, Alpha3MMR, "MMR")
+	assert.EqualValues(t, Alpha3NAM, "NAM")
+	assert.EqualValues(t, Alpha3NRU, "NRU")
+	assert.EqualValues(t, Alpha3NPL, "NPL")
+	assert.EqualValues(t, Alpha3NLD, "NLD")
+	assert.EqualValues(t, Alpha3NCL, "NCL")
+	assert.EqualValues(t, Alpha3NZL, "NZL")
+	assert.EqualValues(t, Alpha3NIC, "NIC")
+	assert.EqualValues(t, Alpha3NER, "NER")
+	assert.EqualValues(t, Alpha3NGA, "NGA")
+	assert.EqualValues(t, Alpha3NIU, "NIU")
+	assert.EqualValues(t, Alpha3NFK, "NFK")
+	assert.EqualValues(t, Alpha3MKD, "MKD")
+	assert.EqualValues(t, Alpha3MNP, "MNP")
+	assert.EqualValues(t, Alpha3NOR, "NOR")
+	assert.EqualValues(t, Alpha3OMN, "OMN")
+	assert.EqualValues(t, Alpha3PAK, "PAK")
+	assert.EqualValues(t, Alpha3PLW, "PLW")
+	assert.EqualValues(t, Alpha3PSE, "PSE")
+	assert.EqualValues(t, Alpha3PAN, "PAN")
+	assert.EqualValues(t, Alpha3PNG, "PNG")
+	assert.EqualValues(t, Alpha3PRY, "PRY")
+	assert.EqualValues(t, Alpha3PER, "PER")
+	assert.EqualValues(t, Alpha3PHL, "PHL")
+	assert.EqualValues(t, Alpha3PCN, "PCN")
+	assert.EqualValues(t, Alpha3POL, "POL")
+	assert.EqualValues(t, Alpha3PRT, "PRT")
+	assert.EqualValues(t, Alpha3PRI, "PRI")
+	assert.EqualValues(t, Alpha3QAT, "QAT")
+	assert.EqualValues(t, Alpha3REU, "REU")
+	assert.EqualValues(t, Alpha3ROU, "ROU")
+	assert.EqualValues(t, Alpha3RUS, "RUS")
+	assert.EqualValues(t, Alpha3RWA, "RWA")
+	assert.EqualValues(t, Alpha3BLM, "BLM")
+	assert.EqualValues(t, Alpha3SHN, "SHN")
+	assert.EqualValues(t, Alpha3KNA, "KNA")
+	assert.EqualValues(t, Alpha3LCA, "LCA")
+	assert.EqualValues(t, Alpha3MAF, "MAF")
+	assert.EqualValues(t, Alpha3SPM, "SPM")
+	assert.EqualValues(t, Alpha3VCT, "VCT")
+	assert.EqualValues(t, Alpha3WSM, "WSM")
+	assert.EqualValues(t, Alpha3SMR, "SMR")
+	assert.EqualValues(t, Alpha3STP, "STP")
+	assert.EqualValues(t, Alpha3SAU, "SAU")
+	assert.EqualValues(t, Alpha3SEN, "SEN")
+	assert.EqualValues(t, Alpha3SRB, "SRB")
+	assert.EqualValues(t, Alpha3SYC, "SYC")
+	assert.EqualValues(t, Alpha3SLE, "SLE")
+	assert.EqualValues(t, Alpha3SGP, "SGP")
+	assert.EqualValues(t, Alpha3SXM, "SXM")
+	assert.EqualValues(t, Alpha3SVK, "SVK")
+	assert.EqualValues(t, Alpha3SVN, "SVN")
+	assert.EqualValues(t, Alpha3SLB, "SLB")
+	assert.EqualValues(t, Alpha3SOM, "SOM")
+	assert.EqualValues(t, Alpha3ZAF, "ZAF")
+	assert.EqualValues(t, Alpha3SGS, "SGS")
+	assert.EqualValues(t, Alpha3SSD, "SSD")
+	assert.EqualValues(t, Alpha3ESP, "ESP")
+	assert.EqualValues(t, Alpha3LKA, "LKA")
+	assert.EqualValues(t, Alpha3SDN, "SDN")
+	assert.EqualValues(t, Alpha3SUR, "SUR")
+	assert.EqualValues(t, Alpha3SJM, "SJM")
+	assert.EqualValues(t, Alpha3SWE, "SWE")
+	assert.EqualValues(t, Alpha3CHE, "CHE")
+	assert.EqualValues(t, Alpha3SYR, "SYR")
+	assert.EqualValues(t, Alpha3TWN, "TWN")
+	assert.EqualValues(t, Alpha3TJK, "TJK")
+	assert.EqualValues(t, Alpha3TZA, "TZA")
+	assert.EqualValues(t, Alpha3THA, "THA")
+	assert.EqualValues(t, Alpha3TLS, "TLS")
+	assert.EqualValues(t, Alpha3TGO, "TGO")
+	assert.EqualValues(t, Alpha3TKL, "TKL")
+	assert.EqualValues(t, Alpha3TON, "TON")
+	assert.EqualValues(t, Alpha3TTO, "TTO")
+	assert.EqualValues(t, Alpha3TUN, "TUN")
+	assert.EqualValues(t, Alpha3TUR, "TUR")
+	assert.EqualValues(t, Alpha3TKM, "TKM")
+	assert.EqualValues(t, Alpha3TCA, "TCA")
+	assert.EqualValues(t, Alpha3TUV, "TUV")
+	assert.EqualValues(t, Alpha3UGA, "UGA")
+	assert.EqualValues(t, Alpha3UKR, "UKR")
+	assert.EqualValues(t, Alpha3ARE, "ARE")
+	assert.EqualValues(t, Alpha3GBR, "GBR")
+	assert.EqualValues(t, Alpha3USA, "USA")
+	assert.EqualValues(t, Alpha3UMI, "UMI")
+	assert.EqualValues(t, Alpha3URY, "URY")
+	assert.EqualValues(t, Alpha3UZB, "UZB")
+	assert.EqualValues(t, Alpha3VUT, "VUT")
+	assert.EqualValues(t, Alpha3VEN, "VEN")
+	assert.EqualValues(t, Alpha3VNM, "VNM")
+	assert.EqualValues(t, Alpha3VGB, "VGB")
+	assert.EqualValues(t, Alpha3VIR, "VIR")
+	assert.EqualValues(t, Alpha3WLF, "WLF")
+	assert.EqualValues(t, Alpha3ESH, "ESH")
+	assert.EqualValues(t, Alpha3YEM, "YEM")
+	assert.EqualValues(t, Alpha3ZMB, "ZMB")
+	assert.EqualValues(t, Alpha3ZWE, "ZWE")
 }
 
 // TestCountryCapitals_Correctness checks the correctness of country capitals.
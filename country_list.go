@@ -0,0 +1,98 @@
+package countries
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/mrz1836/go-countries/internal/collate"
+)
+
+// SortByPopulation sorts the list in place from the most to the least populous country.
+//
+// This function performs the following steps:
+// - Orders the countries by Population in descending order
+// - Breaks ties by alpha-2 code so the result is deterministic
+//
+// Parameters:
+// - None
+//
+// Returns:
+// - The same CountryList, sorted, to allow chaining (e.g., GetAll().SortByPopulation()[:10])
+//
+// Side Effects:
+// - Reorders the receiver slice; the Country structs themselves are not modified
+//
+// Notes:
+// - Call it on a copy such as the result of GetAll; the package-level list is never reordered
+// - Countries without a population figure (0) sort last
+func (l CountryList) SortByPopulation() CountryList {
+	sort.SliceStable(l, func(i, j int) bool {
+		if l[i].Population != l[j].Population {
+			return l[i].Population > l[j].Population
+		}
+		return l[i].Alpha2 < l[j].Alpha2
+	})
+	return l
+}
+
+// SortByLocalizedName sorts the list in place by the names of the countries in a language, in its alphabetical order.
+//
+// This function performs the following steps:
+// - Reads the name of every country in the language with LocalizedName, falling back to its CommonName
+// - Builds a collation key of every name following the alphabetical order of the language: letters first,
+// then accents, then case, with the CLDR tailorings of the language (e.g., "Å" after "Z" in Swedish)
+// - Orders the countries by collation key, breaking ties by alpha-2 code so the result is deterministic
+//
+// Parameters:
+// - lang: BCP 47 language tag or POSIX locale (e.g., "de", "sv-SE", "pt_BR")
+//
+// Returns:
+// - The same CountryList, sorted, to allow chaining (e.g., GetAll().SortByLocalizedName("de"))
+//
+// Side Effects:
+// - Reorders the receiver slice; the Country structs themselves are not modified
+//
+// Notes:
+// - "Österreich" sorts with the O's in German, while "Österrike" sorts last in Swedish
+// - Languages without translations (see LocalizedLanguages) sort the English common names in the order of
+// the language, or in the root order for unknown languages
+// - Chinese sorts by pinyin ("zh", "zh-CN") or by stroke count ("zh-Hant", "zh-TW", "zh-HK"), like CLDR,
+// while Han characters in other languages sort in radical-stroke order (the Unicode code point order)
+// - Call it on a copy such as the result of GetAll; the package-level list is never reordered
+func (l CountryList) SortByLocalizedName(lang string) CountryList {
+	collator := collate.New(lang)
+
+	keys := make(map[*Country][]byte, len(l))
+	for _, c := range l {
+		keys[c] = collator.Key(c.displayName(lang))
+	}
+
+	sort.SliceStable(l, func(i, j int) bool {
+		if result := bytes.Compare(keys[l[i]], keys[l[j]]); result != 0 {
+			return result < 0
+		}
+		return l[i].Alpha2 < l[j].Alpha2
+	})
+	return l
+}
+
+// TotalPopulation sums the population of every country in the list.
+//
+// Parameters:
+// - None
+//
+// Returns:
+// - Combined population of the listed countries (0 for an empty list)
+//
+// Side Effects:
+// - None
+//
+// Notes:
+// - Figures come from the same data year (see Country.PopulationYear)
+func (l CountryList) TotalPopulation() int64 {
+	var total int64
+	for _, c := range l {
+		total += c.Population
+	}
+	return total
+}
@@ -0,0 +1,181 @@
+package countries
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/mrz1836/go-countries/internal/collate"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestCountry_Population tests that population figures are carried through from the source data
+func TestCountry_Population(t *testing.T) {
+	usa := GetByAlpha2(testCountryAlpha2)
+	require.NotNil(t, usa)
+	assert.Equal(t, int64(310232863), usa.Population)
+	assert.Equal(t, 2010, usa.PopulationYear)
+
+	assert.Zero(t, GetByAlpha2Code(Alpha2AQ).Population)
+}
+
+// TestCountryList_SortByPopulation tests the SortByPopulation method
+func TestCountryList_SortByPopulation(t *testing.T) {
+	t.Run("all countries", func(t *testing.T) {
+		list := GetAll().SortByPopulation()
+		require.Len(t, list, 249)
+		assert.Equal(t, "CN", list[0].Alpha2)
+		assert.Equal(t, "IN", list[1].Alpha2)
+		assert.Equal(t, testCountryAlpha2, list[2].Alpha2)
+		for i := 1; i < len(list); i++ {
+			assert.GreaterOrEqual(t, list[i-1].Population, list[i].Population)
+		}
+	})
+
+	t.Run("ties sort by alpha-2", func(t *testing.T) {
+		list := CountryList{GetByAlpha2("UM"), GetByAlpha2("AQ"), GetByAlpha2("HM"), GetByAlpha2("BV")}.SortByPopulation()
+		assert.Equal(t, []string{"AQ", "BV", "HM", "UM"}, []string{list[0].Alpha2, list[1].Alpha2, list[2].Alpha2, list[3].Alpha2})
+	})
+
+	t.Run("package list is not reordered", func(t *testing.T) {
+		_ = GetAll().SortByPopulation()
+		assert.Equal(t, "AF", GetAll()[0].Alpha2)
+	})
+
+	t.Run("empty list", func(t *testing.T) {
+		assert.Empty(t, CountryList(nil).SortByPopulation())
+	})
+}
+
+// ExampleCountryList_SortByPopulation is an example of CountryList.SortByPopulation()
+func ExampleCountryList_SortByPopulation() {
+	for _, c := range GetAll().SortByPopulation()[:3] {
+		fmt.Println(c.Alpha2, c.Population)
+	}
+	// Output:CN 1330044000
+	// IN 1173108018
+	// US 310232863
+}
+
+// BenchmarkCountryList_SortByPopulation benchmarks the method SortByPopulation()
+func BenchmarkCountryList_SortByPopulation(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_ = GetAll().SortByPopulation()
+	}
+}
+
+// TestCountryList_SortByLocalizedName tests the SortByLocalizedName method
+func TestCountryList_SortByLocalizedName(t *testing.T) {
+	labels := func(list CountryList, lang string) []string {
+		result := make([]string, 0, len(list))
+		for _, c := range list {
+			result = append(result, c.LocalizedName(lang))
+		}
+		return result
+	}
+
+	t.Run("german sorts umlauts with their base letter", func(t *testing.T) {
+		list := CountryList{GetByAlpha2("PE"), GetByAlpha2("AT"), GetByAlpha2("OM"), GetByAlpha2("NZ")}.SortByLocalizedName("de")
+		assert.Equal(t, []string{"Neuseeland", "Oman", "Österreich", "Peru"}, labels(list, "de"))
+	})
+
+	t.Run("swedish sorts å, ä and ö after z", func(t *testing.T) {
+		list := CountryList{GetByAlpha2("AT"), GetByAlpha2("AX"), GetByAlpha2("ZW"), GetByAlpha2("AL")}.SortByLocalizedName("sv-SE")
+		assert.Equal(t, []string{"Albanien", "Zimbabwe", "Åland", "Österrike"}, labels(list, "sv"))
+	})
+
+	t.Run("english sorts Åland with the A's", func(t *testing.T) {
+		list := CountryList{GetByAlpha2("AT"), GetByAlpha2("AX"), GetByAlpha2("AL")}.SortByLocalizedName("en")
+		assert.Equal(t, []string{"Åland Islands", "Albania", "Austria"}, labels(list, "en"))
+	})
+
+	t.Run("czech sorts ch after h", func(t *testing.T) {
+		list := CountryList{GetByAlpha2("CL"), GetByAlpha2("HN"), GetByAlpha2("CN")}.SortByLocalizedName("cs")
+		assert.Equal(t, []string{"Čína", "Honduras", "Chile"}, labels(list, "cs"))
+	})
+
+	t.Run("chinese sorts by pinyin", func(t *testing.T) {
+		list := GetAll().SortByLocalizedName("zh")
+		assert.Equal(t, []string{"阿尔巴尼亚", "阿尔及利亚", "阿富汗", "阿根廷", "阿拉伯联合酋长国"}, labels(list[:5], "zh"))
+		assert.Less(t, slices.Index(list, GetByAlpha2("BT")), slices.Index(list, GetByAlpha2("TL")))
+		assert.Less(t, slices.Index(list, GetByAlpha2("TL")), slices.Index(list, GetByAlpha2("CN")))
+	})
+
+	t.Run("traditional chinese sorts by strokes", func(t *testing.T) {
+		list := GetAll().SortByLocalizedName("zh-TW")
+		assert.Equal(t, []string{"千里達及托巴哥", "土耳其", "土克斯及開科斯群島", "土庫曼", "不丹"}, labels(list[:5], "zh-TW"))
+	})
+
+	t.Run("all countries in every language", func(t *testing.T) {
+		for _, lang := range LocalizedLanguages() {
+			list := GetAll().SortByLocalizedName(lang)
+			require.Len(t, list, 249)
+			collator := collate.New(lang)
+			for i := 1; i < len(list); i++ {
+				assert.LessOrEqual(t, collator.Compare(list[i-1].LocalizedName(lang), list[i].LocalizedName(lang)), 0, lang)
+			}
+		}
+	})
+
+	t.Run("unsupported language sorts the common names", func(t *testing.T) {
+		list := CountryList{GetByAlpha2("DE"), GetByAlpha2("AT"), GetByAlpha2("BE")}.SortByLocalizedName("tlh")
+		assert.Equal(t, []string{"AT", "BE", "DE"}, []string{list[0].Alpha2, list[1].Alpha2, list[2].Alpha2})
+	})
+
+	t.Run("package list is not reordered", func(t *testing.T) {
+		_ = GetAll().SortByLocalizedName("sv")
+		assert.Equal(t, "AF", GetAll()[0].Alpha2)
+	})
+
+	t.Run("empty list", func(t *testing.T) {
+		assert.Empty(t, CountryList(nil).SortByLocalizedName("de"))
+	})
+}
+
+// ExampleCountryList_SortByLocalizedName is an example of CountryList.SortByLocalizedName()
+func ExampleCountryList_SortByLocalizedName() {
+	list := CountryList{GetByAlpha2("PE"), GetByAlpha2("AT"), GetByAlpha2("OM")}
+
+	for _, c := range list.SortByLocalizedName("de") {
+		fmt.Println(c.LocalizedName("de"))
+	}
+	for _, c := range list.SortByLocalizedName("sv") {
+		fmt.Println(c.LocalizedName("sv"))
+	}
+	// Output:Oman
+	// Österreich
+	// Peru
+	// Oman
+	// Peru
+	// Österrike
+}
+
+// BenchmarkCountryList_SortByLocalizedName benchmarks the method SortByLocalizedName()
+func BenchmarkCountryList_SortByLocalizedName(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_ = GetAll().SortByLocalizedName("de")
+	}
+}
+
+// TestCountryList_TotalPopulation tests the TotalPopulation method
+func TestCountryList_TotalPopulation(t *testing.T) {
+	tests := []struct {
+		name     string
+		list     CountryList
+		expected int64
+	}{
+		{name: "Empty", list: nil, expected: 0},
+		{name: "Single", list: CountryList{GetByAlpha2(testCountryAlpha2)}, expected: 310232863},
+		{name: "Uninhabited", list: CountryList{GetByAlpha2Code(Alpha2AQ), GetByAlpha2("BV")}, expected: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.list.TotalPopulation())
+		})
+	}
+
+	// The world total is a sanity check on the source data rather than an exact figure
+	assert.Greater(t, GetAll().TotalPopulation(), int64(6_000_000_000))
+}
@@ -0,0 +1,154 @@
+package countries
+
+import (
+	"strings"
+)
+
+// Currency is a single currency (ISO 4217)
+type Currency struct {
+	Code         string `json:"code"`          // ISO 4217 alphabetic code (e.g., EUR)
+	MinorUnits   int    `json:"minor_units"`   // Number of digits after the decimal separator, -1 when not applicable
+	Name         string `json:"name"`          // ISO 4217 name of the currency
+	NarrowSymbol string `json:"narrow_symbol"` // Narrow symbol of the currency (e.g., $ for CAD)
+	NumericCode  string `json:"numeric_code"`  // ISO 4217 numeric code (e.g., 978)
+	Symbol       string `json:"symbol"`        // Symbol of the currency (e.g., CA$ for CAD)
+}
+
+// CountryCurrency is a currency used within a country
+type CountryCurrency struct {
+	Code        string `json:"code"`         // ISO 4217 alphabetic code
+	LegalTender bool   `json:"legal_tender"` // Accepted as legal tender (false for fund codes such as USN)
+	Primary     bool   `json:"primary"`      // The country's primary official currency
+}
+
+// GetCurrency retrieves a Currency by its ISO 4217 alphabetic code in a case-insensitive search.
+//
+// This function performs the following steps:
+// - Normalizes the provided code to uppercase
+// - Performs a constant-time map lookup using the normalized code
+//
+// - Returns the Currency pointer on success
+// - Returns nil if no match is located
+//
+// Parameters:
+// - code: three-letter ISO 4217 code used for the lookup (e.g., "EUR")
+//
+// Returns:
+// - Pointer to the Currency struct, or nil when no match is found
+//
+// Side Effects:
+// - None
+//
+// Notes:
+// - Lookup uses a map for constant-time retrieval
+// - Returned pointer references package-level data without copying
+func GetCurrency(code string) *Currency {
+	return byCurrencyCode[strings.ToUpper(code)]
+}
+
+// GetCurrencyByNumeric looks up a Currency by its ISO 4217 numeric code.
+//
+// This function performs the following steps:
+// - Performs a constant-time map lookup using the numeric code
+//
+// - Returns the Currency pointer when a match is found
+// - Returns nil if the code does not exist in the list
+//
+// Parameters:
+// - numeric: three-digit ISO 4217 numeric code used for the lookup (e.g., "978")
+//
+// Returns:
+// - Pointer to the Currency struct, or nil when no match is found
+//
+// Side Effects:
+// - None
+//
+// Notes:
+// - Numeric codes are zero-padded to three digits (e.g., "008" for ALL)
+// - The returned Currency pointer references package data directly
+func GetCurrencyByNumeric(numeric string) *Currency {
+	return byCurrencyNumeric[numeric]
+}
+
+// CountriesUsingCurrency provides every Country that uses the given currency.
+//
+// A country uses a currency when it is listed in its Currencies, whether as the
+// primary currency, an accepted legal tender or a non-tender fund code.
+//
+// This function performs the following steps:
+// - Normalizes the provided code to uppercase
+// - Looks up the countries grouped under the currency code
+// - Copies the matching Country pointers into a new slice
+//
+// Parameters:
+// - code: three-letter ISO 4217 code used for the lookup (e.g., "EUR")
+//
+// Returns:
+// - CountryList in the same order as GetAll, or nil when no country uses the currency
+//
+// Side Effects:
+// - None
+//
+// Notes:
+// - The Country pointers reference global data but the returned slice is a copy
+func CountriesUsingCurrency(code string) CountryList {
+	return append(CountryList(nil), countriesByCurrency[strings.ToUpper(code)]...)
+}
+
+// Currency returns the primary Currency used by the Country.
+//
+// Parameters:
+// - None
+//
+// Returns:
+// - Pointer to the Currency struct, or nil when the country has no currency (e.g., Antarctica)
+//
+// Side Effects:
+// - None
+//
+// Notes:
+// - Lookup uses the CurrencyCode field and the currency map for constant-time retrieval
+func (c *Country) Currency() *Currency {
+	return byCurrencyCode[c.CurrencyCode]
+}
+
+// AcceptsCurrency reports whether the given currency is legal tender in the Country.
+//
+// This function performs the following steps:
+// - Normalizes the provided code to uppercase
+// - Scans the country's currency list for a legal tender entry with the same code
+//
+// Parameters:
+// - code: three-letter ISO 4217 code to check (e.g., "USD")
+//
+// Returns:
+// - true when the currency is the primary currency or an accepted legal tender
+//
+// Side Effects:
+// - None
+//
+// Notes:
+// - Non-tender fund codes (e.g., USN, CHE, MXV) are not accepted for payment
+func (c *Country) AcceptsCurrency(code string) bool {
+	code = strings.ToUpper(code)
+	for _, currency := range c.Currencies {
+		if currency.Code == code && currency.LegalTender {
+			return true
+		}
+	}
+	return false
+}
+
+// Currency returns the Currency details for the CountryCurrency.
+//
+// Parameters:
+// - None
+//
+// Returns:
+// - Pointer to the Currency struct, or nil when the code is not an active ISO 4217 currency
+//
+// Side Effects:
+// - None
+func (c CountryCurrency) Currency() *Currency {
+	return byCurrencyCode[c.Code]
+}
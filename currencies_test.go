@@ -0,0 +1,278 @@
+package countries
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testCurrencyCode    = "EUR"
+	testCurrencyNumeric = "978"
+)
+
+// TestCurrencies_Loaded tests that the currency data is preloaded and consistent
+func TestCurrencies_Loaded(t *testing.T) {
+	require.NotEmpty(t, currencies)
+	assert.Len(t, byCurrencyCode, len(currencies))
+	assert.Len(t, byCurrencyNumeric, len(currencies))
+
+	// Every country currency must resolve to an ISO 4217 currency
+	for _, c := range countries {
+		if c.CurrencyCode == "" {
+			continue
+		}
+		assert.NotNil(t, c.Currency(), "country %s has unknown currency %s", c.Alpha2, c.CurrencyCode)
+	}
+}
+
+// TestGetCurrency_VariousFormats tests GetCurrency with different input cases
+func TestGetCurrency_VariousFormats(t *testing.T) {
+	tests := []struct {
+		name               string
+		input              string
+		expectedName       string
+		expectedMinorUnits int
+		expectNil          bool
+	}{
+		{name: "Euro", input: testCurrencyCode, expectedName: "Euro", expectedMinorUnits: 2},
+		{name: "Lowercase", input: "usd", expectedName: "US Dollar", expectedMinorUnits: 2},
+		{name: "Zero minor units", input: "JPY", expectedName: "Yen", expectedMinorUnits: 0},
+		{name: "Three minor units", input: "KWD", expectedName: "Kuwaiti Dinar", expectedMinorUnits: 3},
+		{name: "Not applicable", input: "XAU", expectedName: "Gold", expectedMinorUnits: -1},
+		{name: "Zimbabwe Gold", input: "ZWG", expectedName: "Zimbabwe Gold", expectedMinorUnits: 2},
+		{name: "Withdrawn kuna", input: "HRK", expectNil: true},
+		{name: "Withdrawn convertible peso", input: "CUC", expectNil: true},
+		{name: "Withdrawn old leone", input: "SLL", expectNil: true},
+		{name: "Withdrawn Zimbabwe dollar", input: "ZWL", expectNil: true},
+		{name: "Unknown", input: "ZZZ", expectNil: true},
+		{name: "Empty", input: "", expectNil: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := GetCurrency(tt.input)
+			if tt.expectNil {
+				require.Nil(t, c)
+				return
+			}
+
+			require.NotNil(t, c)
+			assert.Equal(t, tt.expectedName, c.Name)
+			assert.Equal(t, tt.expectedMinorUnits, c.MinorUnits)
+		})
+	}
+}
+
+// ExampleGetCurrency is an example of GetCurrency()
+func ExampleGetCurrency() {
+	c := GetCurrency(testCurrencyCode)
+	fmt.Printf("currency: %s numeric: %s minor units: %d symbol: %s", c.Name, c.NumericCode, c.MinorUnits, c.Symbol)
+	// Output:currency: Euro numeric: 978 minor units: 2 symbol: €
+}
+
+// BenchmarkGetCurrency benchmarks the method GetCurrency()
+func BenchmarkGetCurrency(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_ = GetCurrency(testCurrencyCode)
+	}
+}
+
+// TestGetCurrencyByNumeric_ValidInvalid tests the GetCurrencyByNumeric function
+func TestGetCurrencyByNumeric_ValidInvalid(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		expected  string
+		expectNil bool
+	}{
+		{name: "Euro", input: testCurrencyNumeric, expected: testCurrencyCode},
+		{name: "Zero padded", input: "008", expected: "ALL"},
+		{name: "Unpadded", input: "8", expectNil: true},
+		{name: "Unknown", input: "000", expectNil: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := GetCurrencyByNumeric(tt.input)
+			if tt.expectNil {
+				require.Nil(t, c)
+				return
+			}
+
+			require.NotNil(t, c)
+			assert.Equal(t, tt.expected, c.Code)
+		})
+	}
+}
+
+// BenchmarkGetCurrencyByNumeric benchmarks the method GetCurrencyByNumeric()
+func BenchmarkGetCurrencyByNumeric(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_ = GetCurrencyByNumeric(testCurrencyNumeric)
+	}
+}
+
+// TestCountry_Currency tests the Currency method
+func TestCountry_Currency(t *testing.T) {
+	usd := GetByAlpha2(testCountryAlpha2).Currency()
+	require.NotNil(t, usd)
+	assert.Equal(t, "USD", usd.Code)
+	assert.Equal(t, "840", usd.NumericCode)
+
+	assert.Nil(t, GetByAlpha2Code(Alpha2AQ).Currency())
+}
+
+// TestCountriesUsingCurrency tests the CountriesUsingCurrency function
+func TestCountriesUsingCurrency(t *testing.T) {
+	t.Run("euro", func(t *testing.T) {
+		list := CountriesUsingCurrency("eur")
+		require.NotEmpty(t, list)
+		assert.Contains(t, list, GetByAlpha2("DE"))
+		assert.Contains(t, list, GetByAlpha2("FR"))
+		assert.Contains(t, list, GetByAlpha2("HR"))
+		assert.NotContains(t, list, GetByAlpha2("GB"))
+		for _, c := range list {
+			assert.True(t, c.AcceptsCurrency(testCurrencyCode), "country %s", c.Alpha2)
+		}
+	})
+
+	t.Run("accepted tender", func(t *testing.T) {
+		list := CountriesUsingCurrency("USD")
+		assert.Contains(t, list, GetByAlpha2("PA"))
+		assert.Contains(t, list, GetByAlpha2("ZW"))
+	})
+
+	t.Run("unknown", func(t *testing.T) {
+		assert.Empty(t, CountriesUsingCurrency("ZZZ"))
+	})
+
+	t.Run("returns a copy", func(t *testing.T) {
+		list := CountriesUsingCurrency(testCurrencyCode)
+		list[0] = &Country{Alpha2: "XX"}
+		assert.NotEqual(t, "XX", CountriesUsingCurrency(testCurrencyCode)[0].Alpha2)
+	})
+}
+
+// ExampleCountriesUsingCurrency is an example of CountriesUsingCurrency()
+func ExampleCountriesUsingCurrency() {
+	for _, c := range CountriesUsingCurrency("CHF") {
+		fmt.Println(c.Name)
+	}
+	// Output:Liechtenstein
+	// Switzerland
+}
+
+// TestCountry_Currencies tests the Currencies field and its primary and legal tender flags
+func TestCountry_Currencies(t *testing.T) {
+	tests := []struct {
+		name     string
+		alpha2   string
+		expected []CountryCurrency
+	}{
+		{
+			name:     "Single currency",
+			alpha2:   "DE",
+			expected: []CountryCurrency{{Code: "EUR", LegalTender: true, Primary: true}},
+		},
+		{
+			name:   "Panama",
+			alpha2: "PA",
+			expected: []CountryCurrency{
+				{Code: "PAB", LegalTender: true, Primary: true},
+				{Code: "USD", LegalTender: true},
+			},
+		},
+		{
+			name:   "Bhutan",
+			alpha2: "BT",
+			expected: []CountryCurrency{
+				{Code: "BTN", LegalTender: true, Primary: true},
+				{Code: "INR", LegalTender: true},
+			},
+		},
+		{
+			name:   "Non-tender fund code",
+			alpha2: testCountryAlpha2,
+			expected: []CountryCurrency{
+				{Code: "USD", LegalTender: true, Primary: true},
+				{Code: "USN"},
+			},
+		},
+		{
+			name:     "Croatia joined the euro",
+			alpha2:   "HR",
+			expected: []CountryCurrency{{Code: "EUR", LegalTender: true, Primary: true}},
+		},
+		{
+			name:     "Sierra Leone redenomination",
+			alpha2:   "SL",
+			expected: []CountryCurrency{{Code: "SLE", LegalTender: true, Primary: true}},
+		},
+		{
+			name:     "Cuba unified its currency",
+			alpha2:   "CU",
+			expected: []CountryCurrency{{Code: "CUP", LegalTender: true, Primary: true}},
+		},
+		{
+			name:     "No currency",
+			alpha2:   "AQ",
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := GetByAlpha2(tt.alpha2)
+			require.NotNil(t, c)
+			assert.Equal(t, tt.expected, c.Currencies)
+		})
+	}
+
+	// The primary entry always matches CurrencyCode and resolves to an ISO 4217 currency
+	for _, c := range countries {
+		if c.CurrencyCode == "" {
+			assert.Empty(t, c.Currencies)
+			continue
+		}
+		require.NotEmpty(t, c.Currencies)
+		assert.Equal(t, c.CurrencyCode, c.Currencies[0].Code)
+		assert.True(t, c.Currencies[0].Primary)
+		for _, cc := range c.Currencies {
+			assert.NotNil(t, cc.Currency(), "country %s has unknown currency %s", c.Alpha2, cc.Code)
+		}
+	}
+}
+
+// TestCountry_AcceptsCurrency tests the AcceptsCurrency method
+func TestCountry_AcceptsCurrency(t *testing.T) {
+	tests := []struct {
+		name     string
+		alpha2   string
+		code     string
+		expected bool
+	}{
+		{name: "Primary", alpha2: "PA", code: "PAB", expected: true},
+		{name: "Accepted tender", alpha2: "PA", code: "usd", expected: true},
+		{name: "Non-tender fund code", alpha2: testCountryAlpha2, code: "USN", expected: false},
+		{name: "Not used", alpha2: "PA", code: "EUR", expected: false},
+		{name: "Zimbabwe basket", alpha2: "ZW", code: "ZAR", expected: true},
+		{name: "Zimbabwe Gold", alpha2: "ZW", code: "ZWG", expected: true},
+		{name: "Withdrawn kuna", alpha2: "HR", code: "HRK", expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, GetByAlpha2(tt.alpha2).AcceptsCurrency(tt.code))
+		})
+	}
+}
+
+// ExampleCountry_AcceptsCurrency is an example of Country.AcceptsCurrency()
+func ExampleCountry_AcceptsCurrency() {
+	panama := GetByAlpha2("PA")
+	fmt.Printf("PAB: %t USD: %t EUR: %t", panama.AcceptsCurrency("PAB"), panama.AcceptsCurrency("USD"), panama.AcceptsCurrency("EUR"))
+	// Output:PAB: true USD: true EUR: false
+}
@@ -0,0 +1,184 @@
+package data
+
+// EXAMPLE DATA
+/*
+  {
+    "countryCode":"LU",
+    "borders":["BE","DE","FR"],
+    "landlocked":true
+  }
+*/
+
+// BorderJSONData is the raw JSON for the land borders of every country
+// Borders are ISO 3166-1 alpha-2 codes sorted alphabetically, and every border is listed by both countries.
+// Countries without a land border (e.g., islands such as JP, or territories such as GU) are omitted.
+// Landlocked countries have no coastline on the open sea; the Caspian Sea counts as a lake (e.g., KZ, TM).
+// Source: mledoze/countries (via pariz/gountries), with Kosovo treated as part of RS, the sovereign base areas
+// as part of CY (no CY-GB border), and the CN-NP, EG-PS, IL-PS, JO-PS and SD-TD borders added to both
+// countries, while the one-sided AF-IN and SS-TD borders are removed.
+const BorderJSONData = `[
+{"countryCode":"AD","borders":["ES","FR"],"landlocked":true},
+{"countryCode":"AE","borders":["OM","SA"]},
+{"countryCode":"AF","borders":["CN","IR","PK","TJ","TM","UZ"],"landlocked":true},
+{"countryCode":"AL","borders":["GR","ME","MK","RS"]},
+{"countryCode":"AM","borders":["AZ","GE","IR","TR"],"landlocked":true},
+{"countryCode":"AO","borders":["CD","CG","NA","ZM"]},
+{"countryCode":"AR","borders":["BO","BR","CL","PY","UY"]},
+{"countryCode":"AT","borders":["CH","CZ","DE","HU","IT","LI","SI","SK"],"landlocked":true},
+{"countryCode":"AZ","borders":["AM","GE","IR","RU","TR"],"landlocked":true},
+{"countryCode":"BA","borders":["HR","ME","RS"]},
+{"countryCode":"BD","borders":["IN","MM"]},
+{"countryCode":"BE","borders":["DE","FR","LU","NL"]},
+{"countryCode":"BF","borders":["BJ","CI","GH","ML","NE","TG"],"landlocked":true},
+{"countryCode":"BG","borders":["GR","MK","RO","RS","TR"]},
+{"countryCode":"BI","borders":["CD","RW","TZ"],"landlocked":true},
+{"countryCode":"BJ","borders":["BF","NE","NG","TG"]},
+{"countryCode":"BN","borders":["MY"]},
+{"countryCode":"BO","borders":["AR","BR","CL","PE","PY"],"landlocked":true},
+{"countryCode":"BR","borders":["AR","BO","CO","GF","GY","PE","PY","SR","UY","VE"]},
+{"countryCode":"BT","borders":["CN","IN"],"landlocked":true},
+{"countryCode":"BW","borders":["NA","ZA","ZM","ZW"],"landlocked":true},
+{"countryCode":"BY","borders":["LT","LV","PL","RU","UA"],"landlocked":true},
+{"countryCode":"BZ","borders":["GT","MX"]},
+{"countryCode":"CA","borders":["US"]},
+{"countryCode":"CD","borders":["AO","BI","CF","CG","RW","SS","TZ","UG","ZM"]},
+{"countryCode":"CF","borders":["CD","CG","CM","SD","SS","TD"],"landlocked":true},
+{"countryCode":"CG","borders":["AO","CD","CF","CM","GA"]},
+{"countryCode":"CH","borders":["AT","DE","FR","IT","LI"],"landlocked":true},
+{"countryCode":"CI","borders":["BF","GH","GN","LR","ML"]},
+{"countryCode":"CL","borders":["AR","BO","PE"]},
+{"countryCode":"CM","borders":["CF","CG","GA","GQ","NG","TD"]},
+{"countryCode":"CN","borders":["AF","BT","HK","IN","KG","KP","KZ","LA","MM","MN","MO","NP","PK","RU","TJ","VN"]},
+{"countryCode":"CO","borders":["BR","EC","PA","PE","VE"]},
+{"countryCode":"CR","borders":["NI","PA"]},
+{"countryCode":"CZ","borders":["AT","DE","PL","SK"],"landlocked":true},
+{"countryCode":"DE","borders":["AT","BE","CH","CZ","DK","FR","LU","NL","PL"]},
+{"countryCode":"DJ","borders":["ER","ET","SO"]},
+{"countryCode":"DK","borders":["DE"]},
+{"countryCode":"DO","borders":["HT"]},
+{"countryCode":"DZ","borders":["EH","LY","MA","ML","MR","NE","TN"]},
+{"countryCode":"EC","borders":["CO","PE"]},
+{"countryCode":"EE","borders":["LV","RU"]},
+{"countryCode":"EG","borders":["IL","LY","PS","SD"]},
+{"countryCode":"EH","borders":["DZ","MA","MR"]},
+{"countryCode":"ER","borders":["DJ","ET","SD"]},
+{"countryCode":"ES","borders":["AD","FR","GI","MA","PT"]},
+{"countryCode":"ET","borders":["DJ","ER","KE","SD","SO","SS"],"landlocked":true},
+{"countryCode":"FI","borders":["NO","RU","SE"]},
+{"countryCode":"FR","borders":["AD","BE","CH","DE","ES","IT","LU","MC"]},
+{"countryCode":"GA","borders":["CG","CM","GQ"]},
+{"countryCode":"GB","borders":["IE"]},
+{"countryCode":"GE","borders":["AM","AZ","RU","TR"]},
+{"countryCode":"GF","borders":["BR","SR"]},
+{"countryCode":"GH","borders":["BF","CI","TG"]},
+{"countryCode":"GI","borders":["ES"]},
+{"countryCode":"GM","borders":["SN"]},
+{"countryCode":"GN","borders":["CI","GW","LR","ML","SL","SN"]},
+{"countryCode":"GQ","borders":["CM","GA"]},
+{"countryCode":"GR","borders":["AL","BG","MK","TR"]},
+{"countryCode":"GT","borders":["BZ","HN","MX","SV"]},
+{"countryCode":"GW","borders":["GN","SN"]},
+{"countryCode":"GY","borders":["BR","SR","VE"]},
+{"countryCode":"HK","borders":["CN"]},
+{"countryCode":"HN","borders":["GT","NI","SV"]},
+{"countryCode":"HR","borders":["BA","HU","ME","RS","SI"]},
+{"countryCode":"HT","borders":["DO"]},
+{"countryCode":"HU","borders":["AT","HR","RO","RS","SI","SK","UA"],"landlocked":true},
+{"countryCode":"ID","borders":["MY","PG","TL"]},
+{"countryCode":"IE","borders":["GB"]},
+{"countryCode":"IL","borders":["EG","JO","LB","PS","SY"]},
+{"countryCode":"IN","borders":["BD","BT","CN","LK","MM","NP","PK"]},
+{"countryCode":"IQ","borders":["IR","JO","KW","SA","SY","TR"]},
+{"countryCode":"IR","borders":["AF","AM","AZ","IQ","PK","TM","TR"]},
+{"countryCode":"IT","borders":["AT","CH","FR","SI","SM","VA"]},
+{"countryCode":"JO","borders":["IL","IQ","PS","SA","SY"]},
+{"countryCode":"KE","borders":["ET","SO","SS","TZ","UG"]},
+{"countryCode":"KG","borders":["CN","KZ","TJ","UZ"],"landlocked":true},
+{"countryCode":"KH","borders":["LA","TH","VN"]},
+{"countryCode":"KP","borders":["CN","KR","RU"]},
+{"countryCode":"KR","borders":["KP"]},
+{"countryCode":"KW","borders":["IQ","SA"]},
+{"countryCode":"KZ","borders":["CN","KG","RU","TM","UZ"],"landlocked":true},
+{"countryCode":"LA","borders":["CN","KH","MM","TH","VN"],"landlocked":true},
+{"countryCode":"LB","borders":["IL","SY"]},
+{"countryCode":"LI","borders":["AT","CH"],"landlocked":true},
+{"countryCode":"LK","borders":["IN"]},
+{"countryCode":"LR","borders":["CI","GN","SL"]},
+{"countryCode":"LS","borders":["ZA"],"landlocked":true},
+{"countryCode":"LT","borders":["BY","LV","PL","RU"]},
+{"countryCode":"LU","borders":["BE","DE","FR"],"landlocked":true},
+{"countryCode":"LV","borders":["BY","EE","LT","RU"]},
+{"countryCode":"LY","borders":["DZ","EG","NE","SD","TD","TN"]},
+{"countryCode":"MA","borders":["DZ","EH","ES"]},
+{"countryCode":"MC","borders":["FR"]},
+{"countryCode":"MD","borders":["RO","UA"],"landlocked":true},
+{"countryCode":"ME","borders":["AL","BA","HR","RS"]},
+{"countryCode":"MF","borders":["SX"]},
+{"countryCode":"MK","borders":["AL","BG","GR","RS"],"landlocked":true},
+{"countryCode":"ML","borders":["BF","CI","DZ","GN","MR","NE","SN"],"landlocked":true},
+{"countryCode":"MM","borders":["BD","CN","IN","LA","TH"]},
+{"countryCode":"MN","borders":["CN","RU"],"landlocked":true},
+{"countryCode":"MO","borders":["CN"]},
+{"countryCode":"MR","borders":["DZ","EH","ML","SN"]},
+{"countryCode":"MW","borders":["MZ","TZ","ZM"],"landlocked":true},
+{"countryCode":"MX","borders":["BZ","GT","US"]},
+{"countryCode":"MY","borders":["BN","ID","TH"]},
+{"countryCode":"MZ","borders":["MW","SZ","TZ","ZA","ZM","ZW"]},
+{"countryCode":"NA","borders":["AO","BW","ZA","ZM"]},
+{"countryCode":"NE","borders":["BF","BJ","DZ","LY","ML","NG","TD"],"landlocked":true},
+{"countryCode":"NG","borders":["BJ","CM","NE","TD"]},
+{"countryCode":"NI","borders":["CR","HN"]},
+{"countryCode":"NL","borders":["BE","DE"]},
+{"countryCode":"NO","borders":["FI","RU","SE"]},
+{"countryCode":"NP","borders":["CN","IN"],"landlocked":true},
+{"countryCode":"OM","borders":["AE","SA","YE"]},
+{"countryCode":"PA","borders":["CO","CR"]},
+{"countryCode":"PE","borders":["BO","BR","CL","CO","EC"]},
+{"countryCode":"PG","borders":["ID"]},
+{"countryCode":"PK","borders":["AF","CN","IN","IR"]},
+{"countryCode":"PL","borders":["BY","CZ","DE","LT","RU","SK","UA"]},
+{"countryCode":"PS","borders":["EG","IL","JO"]},
+{"countryCode":"PT","borders":["ES"]},
+{"countryCode":"PY","borders":["AR","BO","BR"],"landlocked":true},
+{"countryCode":"QA","borders":["SA"]},
+{"countryCode":"RO","borders":["BG","HU","MD","RS","UA"]},
+{"countryCode":"RS","borders":["AL","BA","BG","HR","HU","ME","MK","RO"],"landlocked":true},
+{"countryCode":"RU","borders":["AZ","BY","CN","EE","FI","GE","KP","KZ","LT","LV","MN","NO","PL","UA"]},
+{"countryCode":"RW","borders":["BI","CD","TZ","UG"],"landlocked":true},
+{"countryCode":"SA","borders":["AE","IQ","JO","KW","OM","QA","YE"]},
+{"countryCode":"SD","borders":["CF","EG","ER","ET","LY","SS","TD"]},
+{"countryCode":"SE","borders":["FI","NO"]},
+{"countryCode":"SI","borders":["AT","HR","HU","IT"]},
+{"countryCode":"SK","borders":["AT","CZ","HU","PL","UA"],"landlocked":true},
+{"countryCode":"SL","borders":["GN","LR"]},
+{"countryCode":"SM","borders":["IT"],"landlocked":true},
+{"countryCode":"SN","borders":["GM","GN","GW","ML","MR"]},
+{"countryCode":"SO","borders":["DJ","ET","KE"]},
+{"countryCode":"SR","borders":["BR","GF","GY"]},
+{"countryCode":"SS","borders":["CD","CF","ET","KE","SD","UG"],"landlocked":true},
+{"countryCode":"SV","borders":["GT","HN"]},
+{"countryCode":"SX","borders":["MF"]},
+{"countryCode":"SY","borders":["IL","IQ","JO","LB","TR"]},
+{"countryCode":"SZ","borders":["MZ","ZA"],"landlocked":true},
+{"countryCode":"TD","borders":["CF","CM","LY","NE","NG","SD"],"landlocked":true},
+{"countryCode":"TG","borders":["BF","BJ","GH"]},
+{"countryCode":"TH","borders":["KH","LA","MM","MY"]},
+{"countryCode":"TJ","borders":["AF","CN","KG","UZ"],"landlocked":true},
+{"countryCode":"TL","borders":["ID"]},
+{"countryCode":"TM","borders":["AF","IR","KZ","UZ"],"landlocked":true},
+{"countryCode":"TN","borders":["DZ","LY"]},
+{"countryCode":"TR","borders":["AM","AZ","BG","GE","GR","IQ","IR","SY"]},
+{"countryCode":"TZ","borders":["BI","CD","KE","MW","MZ","RW","UG","ZM"]},
+{"countryCode":"UA","borders":["BY","HU","MD","PL","RO","RU","SK"]},
+{"countryCode":"UG","borders":["CD","KE","RW","SS","TZ"],"landlocked":true},
+{"countryCode":"US","borders":["CA","MX"]},
+{"countryCode":"UY","borders":["AR","BR"]},
+{"countryCode":"UZ","borders":["AF","KG","KZ","TJ","TM"],"landlocked":true},
+{"countryCode":"VA","borders":["IT"],"landlocked":true},
+{"countryCode":"VE","borders":["BR","CO","GY"]},
+{"countryCode":"VN","borders":["CN","KH","LA"]},
+{"countryCode":"YE","borders":["OM","SA"]},
+{"countryCode":"ZA","borders":["BW","LS","MZ","NA","SZ","ZW"]},
+{"countryCode":"ZM","borders":["AO","BW","CD","MW","MZ","NA","TZ","ZW"],"landlocked":true},
+{"countryCode":"ZW","borders":["BW","MZ","ZA","ZM"],"landlocked":true}
+]`
@@ -0,0 +1,270 @@
+package data
+
+// EXAMPLE DATA
+/*
+  {
+    "countryCode":"SM",
+    "polygons":[["qgxYkwqFgSjZ}MiEkTau@jOkErWxGp"]]
+  }
+*/
+
+// BoundaryJSONData is the raw JSON for the simplified land boundaries of every country
+// Every polygon is a list of rings, the outer ring first and then its holes (e.g., the enclave of LS in ZA), and
+// every ring is an encoded polyline (Google polyline algorithm, latitude first) with a precision of 1e-4 degrees.
+// Rings are simplified with the Douglas-Peucker algorithm and a tolerance of 0.01 degrees (about 1 km), and holes
+// smaller than 0.001 square degrees are dropped (islands of every size are kept).
+// Source: Natural Earth 1:10m admin-0 and admin-1 boundaries, overseas territories with their own ISO 3166-1 code
+// are split from their parent country (e.g., GP, RE and YT from FR, SJ from NO), Morocco is split from Western Sahara
+// at 27°40'N, and Kosovo, which has no ISO 3166-1 code, is part of RS
+// The JSON contains polyline characters such as "`", so it is an interpreted string rather than a raw string.
+const BoundaryJSONData = "[\n" +
+	"{\"countryCode\":\"AD\",\"polygons\":[[\"gc}X{i`@|Bb`@xQ`H~Hlz@pLlUuF`l@s[~PyIoY{HbAyD`XkSkK}IjCgMaSsWsH}Cun@pRwg@bDafAvKlDhOk_@hHbZ\"]]},\n" +
+	"{\"countryCode\":\"AE\",\"polygons\":[[\"cpsNmeja@fMae@hp@wQh|AvEhf@gNb_DnLlp@iLlB|`@lo@|K~_@rh@nJ`k@|o@lZnHrPYbV}LbRaj@jQiRhVaPlAaB}IpNy^kz@xMwH~O~@zq@pr@d|@jeB~Bhp@}N`t@nc@zqAgH|UuUf]dA`dAh_@fJsm@mKql@dFwQbvAmg@|H|Edc@hoB{K|Z|@dgAre@xuBjS_B|a@cj@l`AyCny@nd@plArSzr@vj@jxBz`@b_Bnz@rmArTluDxAbq@nh@_dEd`q@_vUncRo_BvDsPkLsZQkU~IyKkKn[wQfeAuEdD{G{G{Gi[aEbb@qPuBiMef@_UxP}K~bAxBj`A_H|\\\\oLlEss@`O}TqMu@y@mLV_g@lOq^}@av@sX_sBkyA}pCq\\\\yBiEgK|i@sq@uEgWnGcf@}Ga[|GuiAmPwc@lZie@_I_XjIqWeAqIsXbLyAiMtk@iv@cL_wBhOsS_AiXb^}j@[ci@wLs[pBamBiWel@sXcjByc@gg@}Ich@_^{j@`Igd@mV}Zc{@mc@_Vn@wCaI}GpG|Gea@_YxO`Pce@mO|@cH~k@o\\\\fr@kLsTjLgGwTyKba@aPpIiN_A}M}GbLtC}X{v@ca@sMxK{DePeU_Psr@`Poa@yf@dFo\\\\_McK{HnGwdBgtDyKjBy@qVa{BmmBk[sMbUoUvRxI{CkPaJiB{f@`R_WiUs@}MsTk@\\\\oLya@m_@`GeIaGwIsfBap@yBuIjYdObGwBgCyo@ou@ub@qMq^gX{UsO_l@ucAghAj\\\\`MzAmHye@qGuh@og@kSkErOzSi_Bsj@{Fit@|c@_L`uA`Gr`@hR`c@eSjSfRte@mAb`@}d@\",\"}vmN{yia@rJzOpTaFbMxUrTNlBwFm@cSq]sx@eP}A\"],[\"wclNk{ia@_Si@xDoM\"],[\"_zvMs~v_@mGfQgc@kz@t@u\\\\}\\\\uo@fXgH`Tgx@vPmEhMtGhDnh@sUn\\\\vNx@xLjd@\"],[\"_exMgla`@eJfEsOri@iOzE}KoGgDsLz]a_@\"],[\"}mzMaza_@iQ{UbCsM`YgItk@tc@m`@pU\"],[\"e{xMwmp_@gOf\\\\hHdEi@vp@}Ed@_IaEfBw\\\\}Vyu@pIeVuRdBye@oa@rS{Q`WbCzYbz@\"],[\"ui|Mcde`@qGz\\\\yH?s`@me@nWG|Qea@rP_K\"],[\"aoyMo{b`@fErZaRlWyL]yK{\\\\da@sX\"],[\"c`{Mu`d`@dMkEvU~Oq^rQtDeSgQbK\"],[\"eh~Mqt|^tVmQdOrNiLjR\"]]},\n" +
+	"{\"countryCode\":\"AF\",\"polygons\":[[\"qarU_|nl@dd@bBhA~Hu[fg@nEjf@jx@|aBjAfaA~h@bUvHzc@qHfv@aYbr@cKrx@dEtrGvHdg@qEhn@|Fh|AdMn_@sEzZrLzo@q@xsCdSpeArYbf@vRhuCdNpd@h]iJpOheAb\\\\tK|Ire@|f@|~@|Jl~@fl@gL|EjFJ|[qb@ju@eBhW|t@nf@hZ_FbPpp@dj@tn@fRhs@hVrItVjp@xp@vb@tJcBra@au@`W{|@nSuAjb@}j@zf@}WhW}AtW{WlUZjWdRrI{Drd@_~@db@jFzr@oWjr@xv@zOb@ndAqbAd_@bTjh@nz@pf@o@zc@`Ytm@poBfnA~t@`n@pkAnfAfHl@~p@bN~PvZsAxZ}Qh`@cd@rSmk@jNwApj@hQx{@{Eld@h[z^Bj`@xkAyBra@jYxLhOrsEsJbxBau@jjE`Bfu@pLbZp[JpQhRhHyBjV{VntAal@rUkcBla@_HhQ_YlmA|JhlAupAlR}HpSnC|iA~hB`B~n@vh@h`@GhW`Wxm@p@jYqPxh@rV~_Af@djAb\\\\jd@hT~JvbAuJdQdE`t@b~@bPiCxJuR`k@aFbu@ng@hUnp@xj@t]zX`B~iAaXtiBtIpwB{\\\\za@zm@`yAlcAlg@jm@vRff@k@tUtXvtAu|@n|@ma@pEmJ|Je]t{A~W~bAfIaLdG{cAtPj_@iVtwAmAd~@}Xb]oIri@dtAnx@j^hq@dHblA`d@le@bZ`t@uDpnA`J`Gj~@sa@tHwWJqiAzd@pBpD|k@nd@dcAzb@lsCyAvqA_Qbo@fBl~@oDnKuPuEqUvDoB|l@pVn`Aze@jp@zJ`f@leAdThaAteAfSpaBpLfO|gEl|@fZ|@tp@q]pX_DjwBhIxsBvt@h~@wjArk@xg@d^vp@lwDnsUiP`fCq@buExt@t}D~u@~c@`E`P{YhfAmi@b{F`w@|hTuIj_AymGh{\\\\aiRuuQmfAh@o`@kOwxAdWqq@b[{q@Fu_@ra@}jAv{OkfBdQem@}FilD|F}H`J}d@}WscBuH{xNbfDcy@}AkeCkuBg^al@si@}@oQmv@{ObOeHt]qA~uBmPnq@s^jf@sl@pNgpAuXa}CvX}ZwBuq@gd@yg@k|@gLhCuFgyCctAldAie@xp@wRwWiM_}@{b@qb@aEq_@gt@wFsVa^g[wNiDcR_iA{DgnAuXkYsRoVrCm`@dZoD_Iea@dEkRsK}Fgg@aw@_DwaBiz@_k@tKyDcr@|K?zK_SpSaEvEqTpw@kdApLgh@nIisAwVkoB`Gav@|{@ooAv\\\\{{@zS}Ihc@Bpe@_UovAazAY{O`a@{n@~Ccj@cQuv@w`@yh@st@qaBq]gdB}u@yKcTpMeh@NcYq_AoSqTck@~~@cb@fP_RiKBgtCeHqu@gRqq@wb@kk@mJ{qBu_@mlALsd@zQmz@qo@pD_QeLma@k~@zCcbA{c@oVqd@wkBk~A}gA}n@aIg}@pLqd@_IulDwkB}fAnMgg@oM_gAupDuD}pGgHeU_v@ou@it@|@}p@qVaI}Lu@oc@y]wLdGyYzb@y^zh@ikCza@of@lEgn@fZmn@oJmiAtOoV{[}u@`O}_BmKqXeLihDjZex@rc@eOzMig@bh@s]pC}LqTqqA_f@}dAtDkMb`@aUaPiRiCqXzKuZpAej@hXeThp@rCvOyTvJyj@tuAywA{x@{fBbDcx@ey@qE{E{JnD{s@iMiOqM|GeGcjAmPgPqGcn@yVqLw@cMyKpGsEcF|@uw@dN_e@ge@jEsI_OrAcLxY}Sx@}NqMc@iUpQ`Jy{@n}AouAxg@ikAoGoh@od@wz@y[oT_G}@yD`UwL_DseApXmb@[ob@k\\\\wYgh@gYyIv@u`ByKyv@nH}\\\\gU{gA~X{UtOs_@hI}jAuD_Qin@sc@{B{R}q@wTkj@~Cc[lP}K~^g^fRcb@iCnIsYgEg\\\\iTvC}zAgfC{wAao@wFmU_l@qImC{XiScZuJlKwGao@}OeU|Bah@cGoPrKwg@aXaHrCu\\\\rb@g_@hEgi@nl@u}@d[}gAjQ{M~h@QhsB`u@tk@|GvSyr@a`@_cB`@mUx[{d@tr@rCba@~d@|XcGt~Ah[jq@wG~n@`Nn~@gCfXhCn^`Vp|AzJdb@aQbf@}@nx@{g@`u@gNng@kc@bJeYfDuz@{Kav@qfDogGgXizAjEej@qReqBuvBcrA}SudAua@ky@sB}k@ij@kg@}MyV?yXkb@_t@vFir@cP{e@U}Zf^guBr@kp@hw@~GtSl]vDbd@jT`TzPwKyDgf@|N}PeFsv@sPwVar@szEsg@uU~@}p@uOkFtSaf@jJmxAoJ_vAff@aoAbu@im@lGqQvIl|@kr@jb@a@|Hzh@j|@{FxSjFdw@|`@bZjEfv@hX}GdZwy@\"]]},\n" +
+	"{\"countryCode\":\"AG\",\"polygons\":[[\"s~kIftwd@sQWaDcIoGnP}Mk_@uN~BaKuMlA}Rt^cVaPeGpGuTnGbL~GyAMwZdLeG?`PrLeMbQlG}Bd]p[|@iQxWxExm@uJ~R\"],[\"yrvIhxvd@dC`G_HlBiw@hIeKOn{@}Xuh@E{KvNsOsJhNmKfCnG?o\\\\fPwQfq@qOnTjDfDlTaR~M\"],[\"}yiI~t`e@YSI]p@C`ABr@ZHr@a@ViAS\"]]},\n" +
+	"{\"countryCode\":\"AI\",\"polygons\":[[\"s{cJrrme@y@wTh]nP~^x|@oG|GxKv`@wT}SoGi]g^w\\\\\"],[\"ocjJnyue@iDNXgB\"],[\"{edJzsle@EiSpHjR\"],[\"s{cJnkre@sDrESsO\"],[\"oxcJhaqe@\\\\cBXnAAdAk@t@Yp@Gi@NiA\"],[\"kvcJ|zpe@?e@QWBi@bAOF|@Kt@\"]]},\n" +
+	"{\"countryCode\":\"AL\",\"polygons\":[[\"wypXmupKfFzNpi@wDbd@p^~{AmTjM`PzFnf@`b@{CpPuRlQsC`Uy]lZnIvH`Y`aAoJ`v@{g@t`@eFjJao@hdBqf@dKq]_Rqk@hQqiA~x@mE|UpMnm@ym@~^iJvw@nMfTva@nYdLbGpNqF|k@zTfRbHbWbd@Bzc@bTvNlVpf@t@rUnUnX{CpZzKtOrj@dAryApJfSf_@|MfCbp@xi@qKt}@kp@bTzNiKfx@lZtAtY_LjSt\\\\tLz@qAfTvQlPs[fz@sJvl@dH|Ome@qGuDxLin@_TmSvAmXr_A_TwT{`Apo@uC`b@qNvQ|GhCg\\\\rVs_Ah_D}q@lz@mp@`Vy[ba@{IsOnC_Sz}@cn@oJa_@}`AmAwVnd@c_@fSjVo\\\\_]cLoQhWfB`NfPfB__Afr@mb@ab@wx@eDqJuVcNlG}McLuWrSeXid@jFtGhg@E{GqEzG}@e]qr@gr@lPl]zHtQbOmExBmXkQ_Ll@qNlTwt@{HgV`HsCsLuUeB_j@{\\\\i^zPaIbj@sd@~AeRbLrFeUuGiIaOtAwNg]q\\\\aPkIn@sa@xl@nDou@iDeVqTgK|GsPcT`GkLpY}OyKwr@fCeDiPaXrCc^bbBbIli@ecAtJuNkNwXvKyn@uLwn@x}@wyAgoAqYiFyeB_mBal@aTc^mo@lDgMn\\\\}Mpm@hFb^k^`D}T}W}u@wAmm@k\\\\{b@`g@{eA~l@q_@nOlF|LgOd_@{Jf@wz@nu@u{Ajq@qb@tn@oGveAm\\\\xd@~U\"]]},\n" +
+	"{\"countryCode\":\"AM\",\"polygons\":[[\"qmeXo}mZ`d@cV|SxAbEe}@f[{g@~HWxJlT}@x|@`SrElI_GzFsXyQsDvg@o~@bJ{w@}KqaA~a@t@rRif@v`@sc@fGie@pf@rIfMlO~YvhAhc@dg@bh@{G|Yob@vYwElJwQdg@oXlY_d@`~@spCxUaZaBif@rYca@dr@bIzc@l`@pl@hUwHjqCtRpf@xM}@pQceBh~@gm@tTe[nUihApq@eh@bUcu@zc@y_@{NubBdY}_@N{|@hLaQfQvBnHlOjZJnHpt@nHxR`OdGtRqGfa@mjAf[_Mpf@at@lRhv@ePzz@lIjMfRS~]{r@pS{FvpAnI`j@iWqYzsAvEhu@bZnv@fCrh@a|B~cAyu@bi@i_@z@{[eRajA~lCep@yZav@vByNvR}Cv^`NdoAhb@v}AcVld@}Cte@eh@b`@bPna@{@hb@wx@_A}v@ns@gGfTp[laAtLj~Akw@rr@qFtZcSrU|BhGwOlCin@`j@g~@zyBmD|j@dLziAqGjRbP`l@yHdjAut@dyC_Q`G_Raj@mc@d]yEmJcPrHsp@br@wm@mRac@bh@mRkToGi[}GtCtCcL}ZuFsVoYeMxCqYuQst@nDuv@de@}q@pMca@fk@wTp`Ais@pK}I{mA|HgtBw^ccA]{x@yM}`AxBsf@k_@wa@jWm`A}Lmd@lSwcAmOqe@rQyTy[ke@bHwe@GakBoEeEeFrEiPr[gHcKrEasA\",\"gkaXeomZbDtHbQ{@nLm]mM}G{P|E\"],[\"g_xWa}wZ{CnTgX?kEqX~FiX\"]]},\n" +
+	"{\"countryCode\":\"AO\",\"polygons\":[[\"`pyAaj~F|PfDjn@~bDxWpPp[jDn^fj@l]hJdJ|k@hc@`K|MhVhRfaA~VEfUsp@~ScCf_JpLa@zeBpFjt@tOh]kr@l]qf@bHqf@kMiRic@ob@SioDl`AwuAl~@uECzEyZfQeGxAgKsOwO_WcEcGbNn_@jHcTlJKvj@uaAwjAqyAob@VeOvTqLcOeu@kz@s_@qo@aGf@iYc]uxBahBeuApLe`@Qma@lw@q_@tcAw`A\"],[\"djsEubsMzcAsUzpAnGrIwN~wApAxrAq^x_@j_@|f@B|eAla@~sBgUvc@nMbgD|F~ZiBjr@yb@jaAuGhmBx~@pmCjf@pd@kQfs@}n@pi@_Q?|mf@vx|@mA~t@ke@?|Spm@eXrVkAfHc^~o@`O`a@cPfN`A|HiTd[mGji@yt@uDe`@xIyYde@uZhKcWx}Amx@fJw[f\\\\yJhP}o@lb@wc@jfAea@`p@kdAp[okAnuA{|@v[cp@nc@mGbEqpAba@ye@`XJjhFx}e@_q@h_BmArk@ta@b}CpOrGvJdv@uV|i@bGjHmObb@bB~}@uw@~nAsAt^cUr^?fc@lPvTuEl^jJ`WnBdjA_Tlr@?vaBnPjMyIhd@}QdWlI`|Csa@dlB}@rt@jLrvAkMrpAg_@~nAqcAniA_ZlMg]~g@}m@lVqDtOil@tUe@z|qApNlEtExdA_Hdi@~GhVsHtVqj@lc@kfC|aF{P_E{r@|^y[pgAfLb{@mTf~@vO~}Al_@lnAvQvEb[hc@fq@xpBz]bj@eFtaAiKrYrI~VvAptAgn@|k@fCfRoIj_@xXfzAls@xiAwNfg@{_CYeeB}Kg~@lF_BcJxd@cJqYoI_|D]_lFxYuq@mPciA~j@}YTgc@eIuQe`@nMmGVcO{e@}c@oa@iCyc@cr@g_Bg]oOnEk~BoOud@k]sAeXoTgA{JvUiLq@wvAm[c[gU_n@iFmEkPgl@eVaa@|BytBkSim@eSqpAyGojAnRoGuTmOiCi^zO{vAmk@sl@wHaI{TbH_UuLoI_tCkEg_@gL_vArJ{OaJeC{Tq]qh@o]a@kWuKgVoVrAgJoQ{]sbCqqBi`@wGku@pR{NoFkeCmtCbF{`@wTqZdLe_@cQig@gn@un@in@cEy\\\\sOmd@sj@rTnG{{@wo@siByg@qj@iE}u@__@_pAcOs`ElEa|AkG{UyMupBeP_hB|@kpB|dA_u@cXy{BnfByk@`p@}oArJ_|@nh@q]bFk_BbfA_^cJmX~CoyB~sAuc@kTafBta@qp@bAivDjiB{a@lA_p@uVai@ab@iLk^dx@ry@lcArYjF_GqlAem@wJs^ow@q`@wZcAcSmQ}JcUvi@le@mX_q@qOuDQcy@m_AgRgt@l[a}@pMqGsVuaAR{mBffAexBfn@uhGz}BweCvm@gj@le@qaDlIwc@hJuTmJn@t`@y}@ll@sp@`u@{~@rj@q_Ez_BomBrgAqStAkJoHkJaZnZnDm@yL}Nw|A_h@{{AvKs{@_Jym@s^{cAaq@mc@sHyd@xA{g@cTul@lCys@|Oul@kTwVzDcbDcNy{DhRoo@sDiiAhOq~@qAeuC~Jaz@qMmnAwOon^jYo{@aEc_AnVmr@dRlInz@yKdHkRhNdL|^_t@d]iRnYnFfdA{BzIbPbWJtQoP|UhHRqLbOxBzQ_Oz\\\\hIpuAiV~n@ka@tYcEtUir@j`AqG~FsOxSeJ~`@nFiD`S|xAkIl^cZ|YkC|`@y{@zf@sEzD{G}HwGrJwZvZpPzeAij@nW{i@lm@q@mCiHfLkI~WvA~QoOdDsShRoBB{JzHxD|AuQvRsBhDgOzRlJxMkTvK?{GoG|X}GlPeX`e@?`Myf@{QueAlGsn@iVap@~Igf@~Y}^nAugAa`@hBeTeP_Oel@lKukBqJu`@Omu@ob@`AiDsF@wiCpj@aU?qjJs_AjNuxBq^cpAbOTev@y`@oNaYyZeeB~UgdBwEo~@sa@o^~K?yaNcs@wNq@ouD|lCju@pxAnA{@iuW`u@{b@`L|Djp@oPxZzGxk@sC~hCfs@`vBjIfZq[|a@uHpd@kY~aA}Hpg@s\\\\jK|K|aAoZpjAhP|BkK~e@dQp|Bj\\\\vxCrKjwAmFtjBfd@~XmPhiBkTpWoNbh@uf@hWyGcGmPtd@wGlh@{b@`@_U~`@aj@dgBc^fShCvsCcdAteA~Xbj@kTxhAqAbOeIth@hCjUtz@pa@pc@lvAcRvt@y\\\\dv@iFxr@dJ_GsZib@qc@ySo}@iNeQ_q@wRgB{Ufd@yuBiZkmAhZwo@pB_s@cRaqBo{@imByGur@dZ_sBhAedAtK{q@qFaTgYwKcQcX}f@{K\"],[\"nqcIs_dFru@}Fi^xUq|@jSePg@iQcRpBaJ\"]]},\n" +
+	"{\"countryCode\":\"AQ\",\"polygons\":[[\"bxaq@rjq^{_As{QzTi_Cu_A}oi@lPk}AuOwX`RyzD\\\\a|HrOeUrKpMEg`AlV|bB`GpcB~Osb@y@yeBdPgbAyJ{p@od@mjAes@{cNlCe^~V`N|\\\\yU~w@u~BlJ~NrQrCoIo{@~P~o@tj@ne@|q@ncCd}@nqFzg@krCzNpOnCfWd_@maHu@yPcFtIm]qz@eXofAcDueA`p@qvAxAyc@mzAoeRog@_pCod@saAwEme@lJglA`\\\\vXdXl@tW~z@dGoi@_Fem@spBezKuWkKg[okBqEoFoZfTeMfm@ca@gu@uU`a@sRgEoW}\\\\_Ou_C{Qi`@gFsaAqnA{_JkGhZ}Xgl@sNudDkP}XmSbb@cm@icA{PeCoDmh@oSqHwh@siBefAwcH_[ol@sMogBzIxi@`HgS{TmwEjHajBo|@}_ImPw[qtAcuPY}cAeGi^yBz\\\\sOcQt`@ywC]o_Bns@{_NzItg@dJofCbWy|@bKuwAuSqaAkAyyAcL}j@e[k\\\\aFsZvQmlCgSvAuVwtAwGmhGsUw^kIo}@yXk}@uNyAiaA}~Iq\\\\j|CoSwbA@kwc@_e@syDkR|yCeOpWlZilIoaAcrPzY}w\\\\pLg`BiR`VsEz{Bm|AzhMkFkX}FumYcLe_@[{}Ac\\\\hxA{Wk_Ao`@m}aAaRcuAwHc|d@gh@}rB}O{uUaK}q@_KzIiJum@uDmqCmu@|pV_Hx_@dHdvA}C~mEsJtXwL_Quh@iJxh@~fHexBrasBqNeL_pDeuTkFrv@~^f{BqFnKoRsHiGflCvMpaHaFnIyOdHkRuaAml@orGuSyh@mK|\\\\`Gt_C_Rkb@sQ~ZeEra@lRrgCjOdV|Gdp@iRnVphBrcj@caAthZyKmh@sXtaAqkCn|e@yUhy@qe@th@uiAhSsy@o_@m{AgqAonAm}Bw]qU{Q}y@aAkv@_RqLzDorByEc_@gQvQwWjEkRupAur@_NgaA}cA_JaZcLg_DzQctF_@{eBwQkXqPnvByQcBaIffAeUwKoNzTec@_f@cRwuAyqAs|Cu\\\\svEgR}dAmr@ggB}[azEb@yjBgl@_hCef@wzGxZemFmKy~BqMwSyn@_Rmm@a`BcmAueSaYqTem@siC{UcQgSo|A{Rsl@oc@stHaTs}@}LxFmQgZmIkgAiR}_@oJs|Bal@kyDsmAshS?yiCsa@oaDyEkhAhD{qCeTmmAgPuFyEiNyCgmCay@g_Eyf@gsEu~Aau^uM}dAoJemQwL{`@aFycAx@g`DqIwyBm]ihArUy_B_T{n@fe@mmC_L}AcAoJ|OqtCmV}pA|Yy_GyDyz@_l@olChA{u@uR}_A`Iqw@wDeLeTlo@cHxGwLmBmj@wmEjo@}iKwLsz@lJqpDqHe^dJa|@_\\\\u@`GchD}MwR}U`y@eF{XpWmoEiJe_ApIcxA}KaaB_`@`nDq]~XiW~g@{j@vqCiNmPS}z@kl@wh@wAktAqy@oEcRqO`Boj@c]ojAvDce@iSe`@yIcm@aHbKyKwAkR{Wg^icA{[wxBaf@lj@iEbXeV|\\\\aQtB}^{_D_@et@gh@oJkBwVaZp^wMqPwZfMqSwXgSqfAsQ{wOxj@ebL``@mkBFifA{C}LgMqByWubG{XplA}QnG_S_QcUm@mHqy@sNoH}Iaf@eSgLk@kw@a]qvBwp@ksAeO}gBaYiCaOgt@s^ok@yH`a@y@xbCsc@nw@~gBl|QyD~pHwZ`u@_Eb~CwKjV{CiY|LejAg[gyBuIcrDuPos@aW|_AsXtyFfGzi@kDpz@ePhv@sJlHkh@Dil@m_@qOtHcTsJsa@u`AmcA}_HqGoJeNlA{^rRog@sz@ek@}mEjFmvAuFciBuTco@a@mxDzWghDzVm_AtZki@ySyd@vGoXkP_dAnFidCyMi}@wWb|@_l@{o@wCuX{o@`sAcq@nwBeRpAsKnNo`@fgBuEgP[_iBzm@glGbBwtAp`@qrAyDovA}QcgA}BgcA}|@y|Ci\\\\uXaj@koD]se@sPkr@hCcfA{hA}|Mi@yx@sMyn@qLiRyi@qRgOvs@{e@}kAmVgGaO{S}FbTdDfcAySapAwF{CsFpOkGoLm_@igC}Ent@ub@mtA}MoAce@cd@_RnOyGcB_k@ox@uO~XaFtd@vFlhJ_^hlCkOnqC}YndB_sBzfCsa@bGkWuRq`@{vAoBeyA_VorBbIchCr_@lb@pOmAba@se@fPa}EjlA_aDxM{yBuCer@o^saBkhA~qAs\\\\kQgVk^iPec@sgAatHse@eeAma@x`Ac[|mEsPpNuQya@qQwnAeKezBjScnAbZzVlXmBva@sw@jH}mGdbAgwFzQaZvWqM`x@`~AtVmBfLqS{BczC``@e_Ahd@lp@v_@ofA`k@aRzZne@nAmPaBi`AvQw~B}K_PwOCy\\\\cl@ic@e{A`B{ZnQq[`\\\\xJ~GojDkNko@pE_v@}Mu\\\\wLoHgJ|CsYztAuTbYcMbAah@wGsy@}yB_rBuAaV~Qq^vy@cThgAuWpk@_hA|t@kQa^yc@qeG~B{`C~qAg_LccAsg@eo@elAwQ}dAqPe}D``@mrElk@{}@tVaDrgAdjA`l@xTdv@dhE`W~a@rR}`@jRzFbl@|sA~Jnu@xa@aqA{J_r@rGqlBoKol@nFgmCgGapBpHweAgNk`AzGkrJyLucCn\\\\odCsNoAy@iQvNw}Bk_@~_@gKiDe@}Zxs@{gC`p@}EjCse@r[am@~Fsp@]iL}_@rPgRyQ|Coy@wKoVu}@_AqVwZjj@kaBxAowA_g@pNcI{MaGw`A|HsbBqOk^fR{Ovq@gfB|Ky{A{Fss@zi@_b@pWcw@{Iai@m[ei@pOox@mT{hAvOkzAmHsa@fSkk@qHsy@mXuv@eZpJyWyGg_@eq@}F_y@f[wqBbo@gwApZgCbPdd@z`@sKfjAvaAxWogAgCaq@~PyrA|p@s_A_Fw]wcDanH{j@mf@}j@}~AyQo_Cgc@icA}Bgv@iVcn@m}@cnMiOcSW{\\\\mLeUqUqbBoHywA}O{p@_G_}B}Mi]s`@sgErEmYeO{wBxDefE{T{{A}LmdHkKyoAuHfGgAgKjBup@{PeuBjDunAiLkz@}PcW}BkYkIj@fGch@aA{cBqUsmCnAucAi_@ec@|FuvDmKknAbKgzAsUsx@zKwjAwMkkBpHut@kIa`@lJcw@cNfHxIecA{AoVqOuQqGejBvOicAu@isAeIaPwNnFiJ}GwBePtOee@uV_u@~VacB}JyOj@{j@mP}ClEgt@cIei@jJe}AqYic@bSib@{Z`Fm{@tpBsWlUqgArNsIyDaHua@kOu}EpGmc@jIsMd^aBhh@hOtQxc@jOt@~q@a[jNa_@z@glB|JajAqOu_A`HmfAoTecCyOqWeAww@iTq\\\\oQgBrJesAwHeh@pJy|@wX_SmZxIeZfdCqRt^wQyEocA_oCx@mfB|QujAr\\\\}T`x@i_CnKaI~m@rHnBybAp`A}dC`s@uuEv@wj@x{@emEj_@{xCdDcuAnd@ypAwKgfAzUctDdDqcCeVqX{Ja}@wW_WkF_^moAwBiRaq@cQ|NqWscAkn@uj@sJoaC_VyhAdByu@sNgv@mRaGkQ}}Boe@tk@}CpQyVl|GqLn\\\\kKzDoHiJgIufA`J{lByNiqC`QkgAdn@{p@hkAgnBxIemCzSaq@fN_{Bc@{oCiKea@^oj@qSuY|Eyx@sJ}H_@_R|Ega@eGij@t[mgDkCct@_NsS}CyuA`KobAvMwnHnRwk@zFwlA_N{QoQmBgRvIwSyF_k@m`AuIkgBqRk[zCkcA_JavDzQee@~c@kF`MvWh}@mDh`@zZb^hw@`DyKgHwcFbNia@jG~HtCsIgA{{BqIkw@tQ{lIpI{VvRg}Dm@orCmKyD}F}UcBokAkmBjxCcOkxBsUwmAoAowAcLwi@_c@}a@mEg_AhSkcCzZstAXdn@hN|Xzy@}kAvj@~mArb@rSp~@pjAfVd~@v~@yHfIcHrD{r@t|@w~@z`@ebBzR{lDgB_e@c]k`AzVelF}NsgAtIixBeh@ofG|GwcBuc@kyBcb@`YmPqCkOcSm_@xYiPsPeEcXoJpL_JqByt@}bAuLasAaQuKuLvC}yAyzAu[}rAnn@azCb\\\\kg@paA{mD`MscA~e@cqA|XzMtPpm@vPrgKjInOnPi}BfFyrEpg@hXfEyv@oHga@~Mux@sD_dBld@skCaIgoC`D_eAmo@}iAgOwNqOr@wKqo@wg@a[eRhUuM}jA}lA_j@aNaXfCw}E|^khBvs@uwAnj@oU|c@`cAbBvq@pe@w`@db@}bBj^on@t|@_aGn]{zIQ{YgJ|JoAwPbQct@oEu`@l_@yeDNciAmTagB~McnBeNscBsYci@a@ypA}W{jAbh@_yEaBoLu\\\\i@iFqK{GjIkC~s@aThc@sRwg@tCgl@_MwRzGoMaP{d@c@}u@uKim@}\\\\w`@gHmyF_Kat@~Civ@_Vwp@tH_iBce@{aBkW}dBwNqbD{Raa@}C{a@wVqQsTejBo[az@sJuEiQ_bEgTyLaJya@aOoiEaHeLpI{NuOoeGrFiaAyc@mzB`MuvEcVmlEg`@arCwd@wfGwSe}@c@{}ByVgmBe]aXwTas@}[o@eJh\\\\{WqQ}XdI}@wN{L{ANyKeXmN{d@ul@yXcEmNtGkv@yJyyAh]mgAt{CgdBpdCuS{Yiu@hPqc@w@e\\\\kb@e`AkkDqr@ekFrJ{dThV_f@|i@uLpFnGz[`nDbTnHLxOfXvWfr@xnBzYxlCTwgBtV}WfR_dAtE}qGa_@oaAyEa~A|FkSzCjYrKeX`@ms@fQe`A`u@ckBdR_fArTom@z[``@rd@}K|N|F~Sl^h]wSzPyw@|YlNdO|z@jOtTdWuB~PmZrUmjAqUgdE{S}{ATuyBaRklBji@uq@a\\\\ebCgWxVoGuVbNqpExFy^jPMhTsOz[kqAh\\\\sp@vInMzMz@jYmVzBuQia@s~Bc^|\\\\o`@gv@jOqbCiNq`A}W`v@eDwlDlKhIhGqQvGdYnPqPd@}a@}X_zBr]m}BfQmAp`@fkC~RmiAji@{gAtPsB`\\\\fYLk{Bxz@ayA|Yw{AvNw@~HmlCaI{cA_IeOgJVw[h_Bg`@daAuOjJsXcCcr@okDkGseAyRe@mEc_@cLrcAah@OeVkNqKq\\\\uK}qDeJqXoj@}_@bEa^fPdG|Kq_@ZsQcSfg@eMgO~CucAwNjLJrZq[|OcH{cAoUbb@yA_Zi~@mg@uNdI_`@dpAaI{EuEy~@eSbFi_@~h@mH}m@qeA|_Ag\\\\_@a[_Qu^ah@a{@_a@aPc|F{r@cbDvCenB|Mo^yXktA_n@m|AsVtM}Qey@uB{|@uZk|AyDyz@qTox@ch@}oGzAgoAwSqoBd@iyCcZ`Gs^wb@}KpVwX_Jec@}|@aQytBiX`KaBqgBcPudAmQiyDlN{~@_GwfDwo@ilCdEo~A{JktEf^mrAwSys@@ov@oE{N}`ArbCq]qYmPkb@y_@a_D`I_bBq_@ciDb^mpB|TjPdJjXpOeh@iCco@kb@uq@m@qRe^lSeZ{p@sFqwFve@cx@~Qgy@{H_Sm^f[md@_JqCy_CyLsEeR|dEqa@`T{QsWuNfo@gSkN{YpCmWcQyc@o{BtG{`C{LslC|Lai@zMvOxS_VrMgcEvWssAhNdfAjSdg@kAxd@jZvoD`DuV`N{MxYz^gVozBxLq{B~Z`z@pAjy@rGpMjPtKb@}|@jOxsArKuT_F_o@cZ}y@`E_fFtR|MnJjXvSlcDpQk_@xKolAwDkf@cIoGoiA_{EfAsiCdh@kmBhFqyA|IzDzEr`@hpArhCvZkb@dK`Rpi@q_@r@irAwYw]iWox@_f@iw@w|Aa`As\\\\Cgq@`iAks@dRyYaGiMs\\\\op@uaHtAcq@gPoSaF|DgNwLqNybAy^dWwHoMRux@qXxa@qDtx@zKdiBcM}O}K`\\\\vj@fqEmSz~C_Qxq@sVzWkb@zHi]{Lmp@{lCw_@oeHgh@gaBjFga@~_@mb@nk@eKz[ftBLwh@nX{^jAcTqM_nBfBc}Dh^}v@vPmEl_Av|@|i@joAhE{RuGij@_Tea@wHueAmd@ie@wE{{@uPuJ}EiUjBw`@vXw}@`a@kpD~Nib@kA}o@eRjq@gMxKkC}QhBy{@pP{f@dB{j@_Wdf@iIqo@fCgr@{LlR}ReD|BxPcg@xtE|FlmAcKyKiYq@wFyXeS|z@aMiJyV_]sJea@qSmRkQypB_\\\\qi@mKx`@bG~XyD`d@`TveBgKdb@gA~gBeOb\\\\{jBt[mz@s\\\\_r@sq@_b@a_AeTqeA}\\\\_aIcXkoAvB}iBgd@a_@aYum@qOusAob@}aBeg@_xGwEgfK}gAalQtZojCiGimC`a@}kQr{@sjGzu@ibBpAww@hQjInd@}j@tVujAdf@iw@jEys@b]qRbz@eaBea@{NyEgMuDmj@zI{tDrmA}xFf^wi@f[iBbWxSnPgUzHl@xKteDaLld@zB~aBySzkBaQrb@uWHpKf^|_@hJq`@riAgFd}@mVts@sDrtAjFhg@xN_{@tLHnPxm@tJgkArRiUiEcb@jYivBLsx@zRf~A`RsM_HcdBjXz|AfQ}bAkEibB`FmUhPrUzDs\\\\~Sj]jErb@uHbhBoZtj@eDbx@vSm^zKhQvAn|@dJbXfGciAxFqUdLp_@fFadAll@~aBru@x{EhVhy@~a@vQei@ifGd~Atg@bW_RtLm_@cl@qWm`A_pAkkAwgCgU{kAuIgaBzE{[uKgU_@u\\\\~XosFg[j^wEo{BeYkt@dZmqC~Oy[pG}r@fTa]eEcqAvYkGha@zdAfMbJxBsOco@sjCJo`BzVnB`DsTuV{pCxK_mCbd@coAzCa^rd@dm@hBpRcU~dD{ZzkBti@c[bPcp@tIkDc@eeAzTczAv_@geA`DcYiFaThTpEtPtpAdLmn@cN_~Bld@yzAze@g~@qFgq@wj@ts@mGezAa^hHuWsOqBrJuJ{DbDmpGwMcvAh@ebByG}l@zJkSjFyiAdj@qqCaB}d@fJod@aJqWfOyKzGerA~NfFpEjOt]gfAy^acFdMi}HzMqs@~@}nAnUyq@vTe`CuI{~AgcAstE_RmoIvMq`Etf@qaHbf@uwDvuAmcf@wJi]hGyw@ePgwA@clAjPaaB~Ms`@dV_hM|PidArJ{xBqLuoExFqh@yWamE_U_s@y_@kWyPclAfDw}BnNks@zh@cFhTkSjm@t^h`A~Ulp@qW|dBwnB~y@arBp_Aix@jFmw@rNpmA`e@zbB|CbcDhFkXpL||@dF{~@ht@bk@gBai@phAdsFbKhESeVxHdIdKuy@aMqnEhEgvA~g@|yA`Bsb@`N|]bq@xbGbEyh@rY|Tvj@esFpPaTj]]`^lLnRpTlNd~@yQ|aDv[ts@yc@vrEjg@lcAxHfj@zg@i`BpVb}AjEpGd]?qCs_BpG{aBjd@c`Elk@t|@|Ifa@[r|BdDdk@tPp\\\\rb@siExNh@x_@|]nl@drAh[~oAhN`sAr@|rBuYrn@mL}@}cAtgCgjA~o@}BzNzH`YteAlFd[lh@zQrzAja@}@lMb]`MxvDlH`Grb@{CzWts@zg@~@be@~aD`YaFcQemHdFwStU~u@`MoKxEgl@|XcPaC}x@p[g\\\\nIyc@iGsc@xEug@eJ_^}RmiDzf@iv@bCq_@wMuj@sd@mJyTeVu]erCnFywCzfA}v@lbDp_@rs@xmA|HyKhq@jr@`[sDtMxJtr@z~AbFkYdZbu@rj@`jCrRtWfMckAnZb{AbEnqB`MvOfQwEl[pfDtEuPdkBv{CxIgPrTpXhG{m@nOlHbClRzFuPpSpBht@xcBvQ|lBtOqSr@a[l[puAvMvM~]wr@z\\\\pN~j@tbA|IhtAdOjEvL{p@dZkBfZnLlu@tEjNkEnWvLdl@|p@xE{ItMrPzg@`Rfz@`v@pt@bBhiA|[zu@la@z]tgA|LhgB`WptAt`@nm@pXbaBns@_q@`Ii~AcFur@jN_y@k\\\\crBjKq`AiKoo@rOW`\\\\jt@pG_TuJyeAjUo@tE}b@iEgiA_lAkmIiSkr@@mf@_w@{SaObFgRqc@}\\\\cHyn@pYyK~m@wP~Wim@{DgYp^kJq@{i@ajA}TmcByFxMij@s_BkS}cBtJg`Dio@enEvHqlGlRpTRep@wSil@aTdY}Xe\\\\_F~NwXcc@g]{lB`AcaA}VjEmKbNsFldAwi@o_@}Byh@kU}QcHegCzDg|@aNoaDs~@u{BkM`N}EoPc^ztDoMdEoDaHZeuCqPcdBq_@ikA_Lu|AnMax@}Nch@yb@lkC_^gYrBsh@iKqb@zRuvBcQyoAek@fjBq\\\\jtBsUzLqi@oz@a`@x^o]i|@yDksAuRu}@uJbEyEqQeFtL}LdwBuN}J{BwRmSy@waBszA_CcyA}X~SiAq[_Tw^aDep@yL{K{T_FaExo@gO`a@sj@ez@uHciAgOcd@hYo`BkVlCaHkZbMc_BkQib@mYcWaTjAoc@glBsZaT`@yiAgNqu@cFoI}IbAeV|Zei@|X_]pt@}i@vh@mToF__@}v@aXiVgYqvA]mf@qOmWvHw\\\\cSg`@`Fs]gHel@wUdMms@qX_QgiAeSsVkIei@rTcbAjUyP~E}z@{gA_oBwSivBpNecBfj@ar@nRqBjj@~\\\\rFqP{Ly}@`RuDu@ga@cw@o{CdCaa@vVj_@~Pya@eXax@mFsl@fq@i}Agj@{mAuOdCuJmGoF}^vLuYv\\\\cNzFqZpYiIj@eUsZ}hAb@_\\\\sOaU}UokA~@k`@_b@ij@lAsSs_@rq@kj@|UsW|ZsNo[oQiDaC{I}ErRiNqORedAsUcYgj@uvCqHqdBre@~IzCk[scAo{B_H_oAbEwn@}GgMkb@`BiHoXVcy@iIyNqKzNiPqW}CmsCaLmq@b@seAbLkWcOaf@c^w_EoJfHyPyAgn@ax@cB{jAsNb{@wTuUaJ{\\\\kBxz@wf@cAoUw[ee@iaBkC}`CoSiy@}KclCoZn|@wAveAu]}t@u]tSo[oH}A|e@aIt@qN{WeUaEwd@m]{~@usBwfAooFkcDqj^vC}uAiiAyoLyl@o_EtDm`@kVwoA~IgmB~K{YwB_s@q[ee@ma@}dB_BayBzVelA_Pyw@R{cAwRgcAxIkq@|\\\\_y@nGos@cKqxAa[gX}\\\\|kCgh@d]uSqAmJyQiQ}gBuHcWw`@m`@yHq\\\\k_@}jFsVq{Au}@weBqGko@gTeeHxFqbAfXda@y@qp@rSal@pRo|CwJyi@jIsoAo^wcD}Ym[s`@mwArC_hBsK_iEjUquAq_@ehCap@gwBocAuoJJmiAw`@o{AvCozBrLsZz`@eMpBcLoJwuBwZciBmFilAjN_bBgL}cAfLkmAiAimBjUqiBvAqlAwb@wbCdBkpAeM_aCf]c{C}n@cy@}UeoCaoAibFdJezEc|@afIxLq_Dtm@o~Ar@stAtUm}Cyk@cbD`PweBiCspGpLibAt\\\\z\\\\dI{LfH`HpM{DrXgWyd@{{AbJyl@tH_D|G`LeIcpA{Za`@i_@kwDcp@miAii@qgFhWunBkCgp@jK_`AnQoYpZ_NxU?zGcmBlb@rWn@mm@uRoq@eQauBtCulAsu@abBbZq|@qQc|BjR{}BoaAibFaIsaAxHq_Bxl@u~@yDq~@jWeG~MuQlEqjAxS{x@uKi[sx@e}@{JytA_}@_hD`CysDvR}Vdi@pSl^j_@xJu]kGes@m`AotDeHg`B`U`IhPt[dw@vtDbHob@hk@uZv[deAhNck@xp@qErR{W_Pmc@a\\\\fGaQgDoeA_uArA_SzZmc@bEse@{YsQaFo{A{Uan@eZGie@uaAqDc\\\\`m@|Wd^}M}@{M_o@_z@yx@_xCyAmdBag@ixBhJ_ZnTpEmPesAjf@c`@gYmh@{Joo@}BlgAwOoW_Pj{@aJgoFmeAifCy\\\\w^oYuKwQ_lAkf@yg@}NuyAaQd_EiH}c@aHzYab@w}AbBssCjJacA}HgxKqd@gkBuQs}BdPyqEpUiT`AaiC`r@i}DqS_|Ab@a|Adr@k|H|j@iaBjEqb@b@opCtl@ejGuCud@`_@i{A`jAquNpDyK|Re@zF}K{Rmg@zFstBrFq[lZ_a@cHej@hCgyEdh@ieEnMovExXci@`XmShKrFvXx~@hZjf@xJoFzw@pAua@_s@?gSgg@sd@m]oaB{`@{x@d@ok@nZyBpt@et@vDy_AbQum@rMyyArg@om@vZ_JnZoyAoKouA_MsAuZilAnKq|@qAmj@`S_y@cGakCePvMoHqScM{mCuNrYoLu}@iDpMwS_gCcj@i_BuXkeBxHmt@z`@qnAaFafAkR`Q{HtY}YoSeRz`@we@Ho@n]iXgu@gs@sUic@`{BaWjVsq@idAwIss@kIoMw[mHky@}~BakAimLb@{oCyhBsnYth@_l@vgAowHvl@oiAx`@qwBz_@am@vBgy@l[sUx[}uAdEnMt`@zJdNr]xYhQxEsaA`HnPbK}XbJhLwA_n@`f@dqApRlQ{Qe{EfIytAgMus@pBwfCl\\\\gq@fTmuCpa@geCtPiSbR`GdSqWjOQfb@wk@~pArqBts@fbCj^~lC`JvuFp[ngBnGpgAiDps@ri@|dF|x@~lAxTpv@hFyi@bShIpSzaAfj@tfA`KBjIec@sLmkEksAcnKnl@vu@~Cau@}OsaAyQwUu`@ksG{L|[mSuSeWq{@tAcvBsYohD`XmKhAmPaOwhAga@e]zKoxAej@gn@p`@}mA{NewA{sAqxDeXgzA~eAxW`e@z]xGiQnD}cAeWu{DPmgBkNqr@vRmYjLuq@dQkJ`CgPmv@c|Ba_@c`Ilc@r\\\\~b@tnAtCgQnOwCoMasAdOeUeLoyAdIkm@kG_~@~Kmv@{@}FkLNy[kgD~EsnBeSucAkJ_cBwQam@cT_tDap@yrFjEe^lSwZtTl`ApeDtl^nE{b@jN|KtV_[Luv@rKxEzCak@nW|k@v@kh@wWqzAxFse@cU_qAvAa{As\\\\mpD~B}m@_S{oAmc@saJo~@kfGpEehAq`@gdF|AedAmf@qaDqMdCxIc_@gBsZaNnFk@hVuIdGac@}sAaf@u{CekA{wQfJanBs@}nCgZcaAea@sh@me@_qDdE_T`HfQVuIcOyh@~BcaBrPMdGeMxLsu@vOf@lSdQM_qAdMfH}F{vAlUcXmAsp@sRuv@yQaKjJ{_@eb@}gDs\\\\}n@ml@uXaM{`@qi@cHoRin@mO_wDgt@swBmAwYnVknAdLkOxo@cMhRgd@sLqr@pLuj@jJwAzE|X`f@sq@sTyQ{_BMyIaPfJos@l^|MnKcf@}Vs~AzIbW`LuI_Dqx@|Goo@bVdSfIra@bKdJfa@vD~HzKpOx}@~[p]iNcsA|Ese@hWi`@hN`MtXrgAjd@|H|P}ImV{qDnXaz@ps@`g@tMss@zO`Nth@~GrM}O`_@hCtEoI{EiRgZeVyd@gB{RwQ{b@{nBp^jQlIwSn[~BxMaXsJ_eCxCkp@eUuwAV{sC~d@wThJaa@kQkgB`CkOtWuNlPmuAmVysBqr@ab@hDwd@jo@cuBdf@i_@eC{Tai@k_Ak^gKwYs^c]ijBuR`Dc^j_@kKeDuY_~@{t@{w@iFhgAqOoB{n@gc@{Wy`Acd@kl@_b@yKcLxDkn@{_BeIuqAij@qy@aIkrA`Kya@}Gkb@lQtBo`@etBpKoSmg@wXs\\\\_~@`TggB|HwbEfWys@?ej@mOab@vRabFkOyuDiW}{@gPqmElUgoAlAshCc[muBk]_`AkI_`B|@ukCpNoaAw@wrBxLwe@hb@cb@|YifCv_@ag@@wi@pWqRsCysA`JuFvGjJzMnm@~tA~rCbDea@qQilBjTbXt@a_@}pBmcGnQdP_OyyBjMi}@wSkxA{j@nFm[}pAe`@_Bwe@kXvGq}AbeBs~Ahj@`JhC}U}o@iw@eDw_@bFcSgZsrDzqAyjC`YkwBtf@sUdi@kn@mP}]iUaO}Krz@gb@kKaTySaJ_\\\\yKkaCjIy_IzKgZtFwgB_Ee_@zTk\\\\xFgp@pl@ujBjEykAvWuYpt@z^qw@_xCbDcnCwJmTbQsP`Jaf@kGmxBhKo_AmDyiCvO_~BhQfG`PiUsNqeA`Bqu@he@kuBrQqN|Pl^d\\\\vJe[exAbMcb@i[iv@A}kBdc@u}@eKgO}QmAsFe_AlY}nHzy@ilDiTmNpFgb@ed@_fCnKg{FbPmdAh]eaAhl@ej@`@{Pbg@kiAhFqe@}BqvE_c@omBkhAm}HfUqvCrW{cA|KoHta@|Fta@yM|LjD`h@lnAg`@oeEdi@eKxMkOa`A{W_^cl@cLov@bGazB`~@u|Abj@|g@nEpXLja@eTnuAnDtXhUeVDep@zOao@~|@hi@fs@|yAgA``@uh@jk@vGbPt[zF`Jb_@hOuBdCu[`L}OfhAuNzi@|j@v_@xsAfg@v_Alh@tWwGkfAhP~YtRCzXlRnF}k@kEii@k^wsAli@zImGq\\\\`Hif@_a@k_A_aAkhA_Z}qAjAw`CyIwz@a_Ak|AuiA{kCQyeBne@}Wl\\\\t@jPma@nWfEnLaKrNwj@AwPqUoZmbAe[{Jwf@zF}{CrUcxAdg@{V`f@niAdK_h@jr@qmAoKcZs}@w{@nWsu@`SqDlWzO`m@lmAzUoN{G{rApMjInPwN}]cfAIiM|HfP`Bs]mW}bArIc`@lf@ti@~t@jiB`b@nn@`UoZoDw~@mhA}eDmJg`@`BaX~e@kF|SnLvUxf@xVhqAle@nfAlS|OjKgYwQqrAz@gYvKuOcM}yAj\\\\tEj_@vc@fM~CnCwQqPifAxI_b@gUaaB`Guq@mSexBrG{sBnUwUlIo|@pLxA~JiLmLiSfAgbA}Rw}@fG__@rIoFxLfIfC}zAkL_s@dI}x@sVcGcPgi@t^kfB_D_lA}Ssq@aE{x@pJ|SdQcWlEe]_E}\\\\oZea@_Mcq@`_AsuFqMu|@q[bk@gInr@cSj\\\\oIq_@hk@_nBhIkaHeQ}iDod@}|B{Cy_AbPkaApt@a_@`r@tf@fLYjHsPnxAjcAjd@{`@z}@uuAw[sn@y@a[xg@isAfMa{A_cAmCiNhLcDgMy\\\\rM{TgLcOazEnj@mpCqVcyCzN_g@wDad@v^iz@{BmvA`R}~@vk@}iA~IocASqaB{HgK}@g^ml@kKcJaY~OcgBsQeyAsBe~AwiAz]mz@nz@sNerA{OyRc[|sAyPtRqZzHgYkAgTaMiO_t@bBit@lp@gvDpJeFt[enBt\\\\kgAtAetAlVe[hIud@|`@bAnWy`@hN`l@cIne@wAtrBxOoG`m@glApo@hj@^{^cn@alAyKe^Vs_@nIg^~m@qaAbg@qJzR{X}PakAlM{lB`Wwd@`p@_J~Nb|@xCgkAhf@i}@hPknAsSwiAkIdFJ|^_Vr_@wJfk@cIvQsQ|AwOyLcEqTAwpBnPad@PeWt_@yr@nd@wHpMeQ{Fe~@zU_mA|IgU~Mo@rNgt@|AwrA{UkvBv@_fAlLok@}]aeBhI{gAddBk~BffAip@_QqWtLgb@aSk^{Hso@qSlwAcv@vBiQwT{Uox@[es@|Qa`@Uq]}^}e@s@cSr@iu@rVkv@lHetBlc@epA|NgGfJ}T|M{~@e@aWaFhLoEeJwEypC|g@qlA`cAe}@zLcd@bAeKia@sQiOcWsCs\\\\nk@_lDrCebDni@cbAb]_z@`Lis@zXeZ`D}XjZqc@~c@sqAdScCvKpIxN`m@lGlaBiHtrDxFtZhSwDtBkXvh@ai@nBitA_P_oA~p@qsHnSqNlMzg@u@keBpVci@tj@qn@xRqr@zVeTtWgk@~LuIhWjJxR{{@j^{e@|SPtUdf@`ZzBzBeHg`@uj@~VyOdUnItMwCfNmSlU}tAxcAtwAt[uLnJpNjX_a@{JopCqJu_@j[sUlIe[mJk_Clj@ucBtTdVhQiTlVmdA|Bez@pNmZkLmn@sI{H}UxQu@iOg|@zdA{G|k@og@jOsJ~l@pEzs@uI|LwXzHwmAqf@}Ml^_d@lMmZcJq{@`Sg`@_MyOi_@sZ_MvG{cAsZmfAgHukDbIew@diAgtA`Bgm@~IoKbAvc@fYmLbRgYdPa|@z@or@ba@wj@|UqvBvSgv@vCqqBmSc|@sBssAxmAXhQsQtDehA}oAkfA}L_m@{aAtjB}Nqi@yHmyAja@k}EcD}cBlUgiAkEerCrMeQuO_jBnIsl@qFaYxC}XtKtSlDkN{@c{@`IbIlj@cpFoRiFsAer@wRmo@{SkiDbP}k@jC}oDnc@qeAbNfUhBj]sJdw@nLr_C|MlRvSuZ]}u@xKcj@yK{zA~Msr@oJek@cFepHzN}rAhC_}AzJwVhTsCrIeu@`f@eVtJxXfClzEj\\\\`rEpTaZrCcl@iT}_DhEacFlf@y|@lRylA~Trb@cDjj@pFj\\\\qHfd@jQ|RnSdy@tPj@fBwJiZkyH`Gyz@qUqwA`Noz@o@mm@tb@oaAhUdHx]m~CrQii@aKk^hQ}QpHg`Al`@mn@vQ{|AfPdR~Mc_@oCuwC~h@woAsF}u@fSoD|d@aw@nJyaAwMqv@cNdM_JePwHhG{]s|@}~@~\\\\mm@~r@g`@tLgKuQrAkZj]sWrF}VfkAgqApb@}Z`iAw_BvMzO~Jm|AtFhNtTaYrFu`@tMtKjPkMdNcp@jZt]hl@rxAxIdsAnWn`@uDlPqZjKaI}Xwa@|rAmN|Gqb@h{@pk@|o@hd@hhAxJwhAjj@zd@tMzb@yM|x@lMuC~JfGxLw]~t@nl@x]jwClNnCzYywChcAnxAbIrDjIgPmPucBm]{k@oS}mArBuVdWcEvA_Lre@ePnYzVlw@tKlWrOrDj`Bac@nhCwBboB}Zpt@dFdh@c]x`BxL|q@oA`rBumAjeGhPheAgGvlDl}BowIZonGjX{s@bVyHbPqi@mImaCx[e_CbNi]dg@hs@~h@|nAr`@bWrXb|@lRyMdJhRhSu`@~Up]rQdpBeTbi@tO~cBjQy\\\\jc@oQbc@a@nPzUkBf`CoCpPsN}@h@b]_JlXwG{IkRbSoH{CiPkc@qKaEcBy^yJbR~EnlAzKhb@_A`q@eMo\\\\yKtDuD`fA_d@n|@}UnzA_@|c@nKjVleAq`A`[jL~Ih\\\\iP`bAnH~f@gUzjAhJzt@{Bzv@vMdt@aDlu@yQp]dT|vAvA|nE}MreAw`A`eA{^bmE}Y~z@{\\\\_f@tLhvC`Od@tb@_n@bYmsAvU_gChXxKtHlXri@gk@dSyz@jbAzqApUyVmw@mzBiMajAlLefCvQvHhEeUcEsfAfa@cmHFp~DjMkJf^aeAfH|U_PxyBqLpa@rCjeC|FjPbImAnJ_^dNj_@Car@nO{oAhQhbAyAz`@oUzo@yOh_Ct\\\\wu@l`@lZ_Bf{AfQtU_G`TaKcNiEtFoDn[{I}^iUlt@vBj[jO`Yze@t[~D~MwDxhAdF~Ki_Aj_D{Cja@bF~[nl@emAEnvEtFjb@bQgPtHw}At]{~BdQl^~CkgApIkPzSvc@x@vg@`_@bOsAnfAbNrx@wUdfD`Dpu@d]q}@|JwcAvXfwAwKpsFkTne@aQhCu_@i`@cTsEsLzNqN_HuUbQaa@}K{b@fj@ku@pYa_@by@md@}yA{E}oBcJzdBf_@hdBxGniAuf@||ErBlS`Wxr@h]uz@zKotDp[mg@dZxHb[eTft@xBhXlZpI~t@|h@be@lT}cA~\\\\uVxOaw@lX~q@bMiXnJosA_Q~Ozb@quCnDfLvKqj@xHn`@lDhoB_VtJoJncBwTdl@yVbpCjI|oBvUqmBzWoBmKop@~ZaCmPmo@xMqe@f]{[jG|[nJin@|Nrq@pNmj@~V~Lr\\\\y}Bjb@cOhPwW^fPtgA}iBfUisBfUsBz|@`QbBt\\\\ibAlbCnPzy@xFvrBnJt]wHds@m@vkDwg@h{A~[jmCvDltBbP{UhSnfAe@rdB}JrLoq@pK}NuA}HoNkOh~@dDfVa{@yLgKpKV|g@jk@hdCtBvaB~JzEjCeErEa}@xt@gm@xgAppB|]jZ_CzSk{@hm@S~lAp\\\\aOpe@ri@rJxWvJ`vA~PcEpFyO|c@`[zKzk@sSxaAjAnXnXax@fW|@~LoVzH}{BbRk{@lOzPnFj\\\\r@|x@jKmi@|QfPrK|dAtUqStQyn@db@vd@t\\\\|nEaFppCkk@f}CzNhdB~]fd@pLzp@^zvHjMrnAzQp]tNjx@`GjmA~Jeb@~QcrDgZcdBbA_qTt`@mk@xu@yZhGdO?n`CtRnZ~Kkr@{@m`AdLeCnNdMbQc^nBs\\\\tLyIhI_kBtV{NUgr@j[}yCrQsb@t^lz@tMwAhElIh@reCxF~UjJcKlByh@fUaq@dWvEx`@_XvIvt@qR|~C~VwnAtClq@gPpp@~L|d@hPqzAlK|ShCdzCnHj^~RpMv@eb@h[aJfO}dBya@q~AsBwv@dKa_@NmoAfNkUxN?xMnWOx\\\\dEuLrXiIhq@}yAz`@b^z]bdAaDvbBvFtQhGgNpFlm@xh@he@|I_j@uCcdDrHiu@vYev@jr@_Ztd@zbAuNztClm@h~BxJ}dBvJzg@~GngCpDqkCpNrg@bAtm@nMoYmEotBjOw|@mGk}DlQywAdX`W~m@exA~I{cAr]oXpGa]bm@_o@z]pCzNki@jDeeA~RoZj@eg@rIb^~\\\\sb@bSfRbLt|CvK|Yl[qdC~[drApC~yAb_@chAwMkyElt@oqEhOtZmBvjCjGbuAdTjsAjQeOiKc}AoBocKhU_b@lNuE~AdXni@cC|Rh^zE`yAtSoq@hM~JbwAj|I}FojDdFcx@h`@yfBzDmy@|Pcg@fDobAkTiiAkVsrEq[ih@gHok@mPhp@_]ok@ux@kuBeC{o@lXydAxYmGtKpHvn@~eDp[oiBrOs|B`W_ZfHxJ~DwP|Qrl@~TgYdLmb@x]{tEr@ofCpTahCd@asHhx@oaBp\\\\aPfLnHlVvbBhB|dIqO`oAa|@thCcL|bA}@vrKvLkYdK`mBYnqP~r@drEYft@tKkt@f@zdAzPvd@}HlhEpHcKvCt`@dOwHtFdm@a]f^zEnp@}CptDhg@jz@bGzi@zY`KfNgQvc@fdBzAxnAi^xo@uItw@yFkFiUxB}Seh@yIpYdIryAcU~bCyr@b_BoLliAc[tx@uu@qfA{L|EeK|W|WpmAwHzyAjEn[|QnS~TruA~DuUtxAmpApHm`@p[~TxFa{AxRqu@nKzNdFz{@hWobBzu@}lAhk@a|APzrDe^rpD}Pg@ej@rdAgC|TbAlt@jNjb@yD|}BxPoxB~OrYnd@tNrE|RaMb{FjVq_@~LdVfk@|hErRqbAdOjElHyTrIxDdKtk@fOeLrIgxBzKum@vVn`AvOwVrGm]hDo|C`Hqg@jScCoNt_GpHnhAdc@lv@jIfjBdWn`Aa`@b{HrUas@nX[dH{I|ZouAgZczQfSjp@xjA`eLzC~xDpOhjAdGnjBhHohBvJ`QpLdeAv^myDgAe~A_e@s|EDwXzTsu@`e@{tF_q@idH`N_}Aj[pu@~aEpxq@hX{AtNt`@hz@`}IpNmJfGusEbKeb@sy@{ui@jOah@hTaTxA}o@f^su@jNh^J|b@}_@v_E`A`i@|a@sbAtI~_@pZz~HwX`gBbLfoBtp@clAzPanFwi@itJhVgvCeEiq@nEcL~ThBbArXn[dQ~DzMjIvx@bOuqErJz`AmLtbTjHbiAwAhwDaN`hBvFh]`Jsl@`[v`AxEiTmNigC|WksOhLtDbNipD|RvbBsFjn@|Mee@qCmlBjLqb@zKnOtG``FzJcc@bG_JdDnOrK_`@hRqlBxO`^uBxpCbW}fC|Rua@bAr_CnRggDiBq~BzNwd@bOjjCqh@bkNdw@qoClMicDpDxjCz`@dz@bGiYkUsnIlPesBzRvr@jFl{ArEiXtKjRdF{VaMqmAqNcqGzPug@nVz\\\\|c@l~GdMcjJ|Fbu@xKic@tGymGpHfs@na@o`JjLbBhDcb@bD|s@|OclBhZ|nC~Dwp@vIbpCrNaw@sGi`EvFcqAyI|FkJuUqB{~@ph@iaCdK`mAoB~{ClNhgArEm^aD_rBzNyuBdEzqAb^wiBvMulBjHvXl_@wrFvF~pAhKym@xBerCzJ`_BpYwvDrXxo@zvBjn}@dj@odBj@a`_@}Iau@lE}tClZmfBrGk{AuQuf@~QyvDoSxUkQps@}Sh_BmM_gAwu@weZxXuaC|FmxEhs@_aCpIujA~Lpq@xXvVjIzZrBxw@rOwi@f\\\\wjMyDuhA|c@k|Dx\\\\f}@lRqbA}MudFdd@myFtOuZhb@drBlNgvCjPzf@`AdoDnGqZbFmzEnQzBrHfPfUgpCgVoqCe`@y~AmEum@tVcgHjCcRvM`TbO}ErVyiB|_@u{@j[p`AsCjlLhW_`AxEy{EvSjiAaTxhItLtuAqf@z~HrCzsCrOad@xq@kaRjLvp@lR{n@nDapAsHubEpZosBud@sbMfTgZ`_@jf@xNy~@Gez@cSgj@aIj[oQa[fUsfHhD`Z|He\\\\bKfKfHwX|K}z@vCkoBeQuZuEarD{NoK~JkqGda@{nF|Pb}AhBvxHnFi{@tHrYjCqNkGalEng@ygK|Mly@~GyoBhQjqAdQ}t@Tm{@iXchD_EqyGvZ__GhK{v@zKhFaAmaE|G__AfPtXvg@brFpLqhAyAwhGuF{\\\\xKcq@a_@iwRdWa|AhTegElN~g@hQxeC~O_vAiMmjFd{@}gc@tHyq@bCnc@vF{a@xMoaIxP_v@{OctBjAmkAfTxjAxMem@|Aou@yF{cChOkfJ`u@qmGbPlsB|X_xFnhmB??~fvzEohmB?e[_g`@`IwmA`FlYjLkpBhEmQfKcBbSivDwTutJtFqjS~MztFvH``@l_@}~HvBdZx`@qcBg^gjKwF{c@oSk\\\\qFdr@{Ng\\\\zpCaffCh\\\\}pAvOxXp]qbAtX{uIhNqPnFxm@zJweAo\\\\sjI|a@{|v@|JoeAzJyjQmRwyAvwB}tlAbb@{zBdMs`C}a@`}@hAodCpe@stBvb@yai@iJl\\\\kD~_PsDohQ{P_w@yGdZW|_BcIisJmiAv_[c^jbAod@bpJ{Gcb@nLo_b@uAcWkH``FyB}|FsJleBqKylD}Fj`@cWbpt@wLwrAyfChslB{A|Q_S~ImSbsB~EmfOef@tuFwD`v[fHtf@gg@h_WtPi}P}IfEkIq`A]k`n@aMpb@g]tmTcEjOiK{s@aNvkBcGgr@cXtmGeQ~gAkJzaG{Naf@|B{l@e\\\\zm@uHks@gCyoXgJdXwX|wD}Oa|AiNz_AqgAj|PqOti@qQtrGeLpy@~WbfGdS~qA}\\\\zm^rKs`@~T{kGtFf`Akc@jhN_Gyy@oMjhDJ}jJoL`b@}D|lHuKtjA}j@biDeJjNwRq}@_RlGsFxTgHfnGoO|c@eHwj@VclCwSl`JuSxu@{pBlal@mEc\\\\b[alMuwEh~z@m_At`EwS`SoKsQ}@gk@jcB_gTf@oqGi]knCls@aqT|cAajHn`AgtApPem@|eA{zTkDgg@}b@p{B|Eoe_@s\\\\wn@nJ}`JyPucApAi{j@b]cpCjRny@tMB|^tVzT{_Cfe@udA`IvPnImBdGaTbQ_kH}Kqf@wU~c@}VvQqPkGuCwMyPobJaRuqA\\\\cpBmMy|AvBadDjFeYnSeNdCpM|JsM`S}mGdOwcA|MfzBnLy^hQisCm[}~g@nh@cmJWwtD{Eix@{b@sb@yJ_[yh@{e[i]c_AudAkmIgNvaAcWjb[oDnc@aOnUgH}d@{k@svw@_Wk`Bs_@vd@wo@maFgn@in@s}Cmcp@oLxFes@rfBaj@vlBgzCvvXcNnXwQcyA}`AzoLgLxTah@w~Dwa@soBqRieIg[{tAch@nlAuAfgBaT~q@oT`qCe\\\\tdAyPv~FwZzmBeuApfg@eSvv@{_@i^qhAqrKymCyhkDySyp@wWho@oNnfK{Df\\\\wB}`CqNj`FaFeu@qNbYwOlrSsJigJ{Id}ImKsw@i@qfEoJ~cCcIic@`E{eQmHliKqQdpA]r|KuC`\\\\qGekCkHjuDoIkjBgEpYRtcJgGzt@yJo^FwsBcXjbF{Jue@uCbUAjoJmJsh@^qiCgHtgBuAq_Qko@~iGpAlmEcHe_BqUfVcNhxBcQao@jBszCsUwuEoa@dm@sL~_AiThc@lb@w~\\\\_PoRkSm_DuKsjR{OtqPcIjb@cBmjK_J~a@_GhyRYgwMyI|yGeJqqA_Gxy@oEecAuFlxEmDcj@mR`iC|@wwDhM}rBbAwgHxHzTPloAnEmdClFvTjDm_@bDo`OyPpOoDufAkCj~BzM|jA{N`nBTpkEqOcbEuWbgHeNjr@fAcnAuGsmAuGpb@mC{jBiy@r~VqKnk@sIoSwJ|_@mk@pmYuNtu@wvBju[kg@uxCkEk_AaI|Y_Bj^rf@fbHcFv~@yTf|@eP{~@gSohEs@jhAmRmqAaKzO_i@goDrMt|EyQvpBbTcMzRp_AfSxlCge@tvAbb@|nCeRdyAk`Ap|c@mXxr@omEfy[aGnJ_P`AiQu^cVi`GoHc]qTzn@mGof@uNmhSoFcUmR~k@g@sbBfRkcB}Ks`CuI~c@oPauDgHbYuLeQsIokA{`@z`@mSu|@TsgCcIi_AcFruF{Zpi@yX~hA]hjBfKr|AfL_i@`Tn~@~NvL`MdwEsExy@{XlVeFwbBfGmfA}_@rg@hDxuAjVdwAeQv|@pM`|@bEhmCoHlsB~EfbG{Rx{@_MxCy@rjBvHba@jE}O|Zbv@`XplCMbpCob@j_BkxArhKw\\\\v`AN~w@gNxl@qCrw@eOdc@w]f`@wTxpB}Hx@is@saAsY{qAst@mn@_\\\\wgBmToWeYbhBwt@izAcPxXqN|cAmSpVg\\\\qAmw@m{A{l@zrAwOwE}Rmq@{IgwDtJmr@xlBuwG|LqxArDz`@rKk]hb@qqCcIsXtBmo@eQoCsc@ckGaOhnCq\\\\vtAuLa_@kDonA_[og@}[qeD_Cm}GvLqiBtRgeAda@gbAl@obB{XyNuNqvFb^ayAKuvEjM_bCtVw|@fXw~Ils@ofCtYre@~Ivn@|I~nHtOsp@lA__KrOsgBlCgzAuFgaA~Lon@hFwmBsf@tWeRquAwKfOk[qTkM~IgIgsA`RcfBbc@wv@zNujJjTsxAlUhz@`Mg[|]keGdSyv@bw@c}Gxx@}_CxB{{AbVqlAsGs_FsQweAmHu_DsQjc@gDvp@wj@x`C[tjC}Am]sJlKmHkrDf|@sqIEcl@eSwbC{eAbwA]q~Ccg@}pI~@{zAmDcp@{PyTmlAdtW_JrTmObCwQsToQokCgChhBs~@_jAaL}q@v@k_Dr`Bm_L~\\\\ao@dc@~Ch`@ygAoBaXse@by@aCcPrUabAwRuv@_X}_DwZjYcE{EwM_sDlK_u@`rB_fGnf@uy@`IoyE}Jay@oK|h@g@}cCd[siEkIsSkFnQuH_`@oPv}AeNvEmc@pfBiBvhCuHyyA}Jrw@_HeLmd@ft@kL}EsB{SdNg_A?e}CsIeWvFk|@cHgpByfBxnL{CflCsa@pgC{F{e@jg@ohNfNeQ_LazB}Qh_@s[v`C{FaN{L\\\\kRr[|@dgC}Lh`AaXl]uWg^jEykBiQmeCwKm]Rko@mPdg@oYrsKe\\\\|m@gSrmAu@`aA}D`TwNjKgTip@mOxlCg}@v`HxHruQ|b@haGqn@zdVcSlm@cLeBcWw]qVgfA\\\\i_IsUobBkV_pE_Vb}A_RSsZe|@cPwfBo@umCtyAkdSlDj_@fc@gaDjGiyBp\\\\ceBoSm_Arf@k~@~S_xAoH}q@bDkeBfOqk@xLyeC_RkbFkiA~qBeb@pfFsjB`pJaVue@sFmm@wMzGeKmu@iQbGg^}y@qSo`DjNq`NqGxS{_@ohEp@_{DeRq}BhDcgBwIae@ca@tZiRk@_MeoCgLzMqGb~BgVzaBcEsfDmn@wrLtMg|LoLqf@cAip@gJoMei@bg@gKodAfQoqErT{|@`OciBxqAyiBd`@}Y|d@cjAcAkdAkN}UhMqbHbWiu@zBgoBqf@fpAh@gx@mf@d~Ass@vyFiSjd@sWtPwNiPwDui@`Ww_Gm|@erI}Lgd@cJwJyIrKsTqe@m}@c_E{LulBiYgHwP_y@EoeCgM}zAzHiiB}TeaUc[gdBdPqdDjx@anF}Ti}@rSwtKyRnUsF`]iDmz@yIrWk|@dlKi[_zAus@xOo_@}Bmi@s^qE_XlDcnCwb@ysEzLmsDjO_q@q@m_BcTw`@eUt_@o{@{~BaIen@aBoo@zr@{aO|GqQbKxFbd@`t@zZmk@fR{wAp\\\\`YbF}c@uHeoBvQehAMcpAfFhNhNoB~Csr@ky@auIjB_iAyk@k~Fvb@ycCaf@onH~ImcEtg@sjC}QgmMhMms@tm@_xMil@ytLsKk\\\\d@qaI}c@atCyLocHwMu[jD{bA_Maa@|HyfBcGmlDuOibA~DiiAcGusB`h@ivE|JwkHiGyb@tAqkAy\\\\}wBlEsuN`LocAcHa{F_H{b@dXqfBzf@{{AiQwlJ{NgkAO}mA~JbClLkaABubAgRokBnEqeAeM{fDnMakAgMerAdSejG{Tob@|AouC_LuXmE_oBjMlf@dDsfA}WybBxRo{@yu@knNrLm_DwW}{EpHukAsXm}@qJksBvAmfDgWetBqJq^kM{F}FbRyB~qH{f@{i@cU{y@cx@gsOea@u~Ajc@_kAjLaDxe@rtAjKcg@aBm`AlIgI~Vdw@bXeWtCkc@yGelAbZugEoFg{BlMqwGe\\\\coC~CggBkNss@t@adB_Rp\\\\{\\\\kGaT{mBdVlL|S}Wf[ez@pQeyAiKgpClAioBkSidCQo|AkEkUwTf[_WrCgGm]wd@`~Asi@|l@y`Ahc@yVcZiJjYwo@ocCaM`SgRiqAmNgIyJeuDaW{pAgJweB|^k`Dr`@dd@vw@tjD|JiZwa@syEp@ynDhEw]fg@okArKmGhM`L`SsN|q@rgInIuzBrMmg@x[`jAh^}@pJs`@hX``@fJ{j@cA_m@_UuyAeQqrDn]tVrQ|\\\\v}@jaEp\\\\hpG~Rhu@dUxbCrKax@OmeAcUosCvDo`@|M}ThUxHh`@fy@xr@fT`Pz]vM|kAls@~sA`TuWtH_rAsS}fCen@{~AcF{tDmn@ygIpNgvCu[e_@qBy`@bIwiE{NaSuPeuAsCglIoWjH_MsYuI|TlG~gAiHdZsg@|z@wV`dA_i@xDiQ_OkRmr@jEcoE_OwbBeI|RmArjAm[|bD_z@lzAsS`JuQiPqEfQkGaKab@_wDbNcaFtYgrB~MaXrJwhA|X_e@`AiRcCoa@yp@kl@fAefA~OmaA`~@g|BfVhzBfRqy@}CibBv[ek@pJdGj_@cSf_@~S~ExaAnZzl@xEhA~Vel@hJgCpQnBjQn\\\\~WdnBtC|}Bp~@flBf^xuAhWffDl`@vmApT`yA~Kf_@nO|Ln@ucAzW|nAa@_uAf[qmBgW}kBuDojL`TobAxFefByJqnBlEmnAys@}kB~cAg`^zh@osCsE_jHpPq{@f@e|@iTcp@T{aCvM_vB~U}c@bDag@sM}cA_W}Rwg@shMqRoa@kW}cI{GoV{Y}CeIsj@W{uBtTcn@bKmbCmU{eCoA{pCtSmiBQiiAgi@saIfZetLmKoaBjLk|@QocDe\\\\_eE|IoaA|[iw@f^cEhh@bo@rYzNxYokAtMcpFdYzBnJiYgXqmHwXqwB~DkdClOgeAkb@}lDoOcyFpUucG_M{i@wCy|DmUef@gVumBqJfPm@n]pD~dD}w@u`EeEzeAd_@rzE_Q`wA}ShsEwUuZafAcFy]pfAvSdeBiNnoDdL|hCkInrCgUfpBwIlLqJoHgHmfBtJudCcGch@o^mk@eOcy@uV`x@yHf}@kl@ux@{Ftz@iQ{d@gNcmBkXin@cQnnAL|_A{Tt|@dIhgAwGjuBpDheBfh@zkE}\\\\zcHoOth@u^kt@_Gj\\\\aWxAii@ikA{RgFfBbqAuTqh@_\\\\nBgWtWoB|P}NcCyFpFaZ|z@k^pLgMfS{@bbEyWldBbBpfBeRteBjBfsEwH`gAeKz\\\\{ZxO}bA}rA{RhHwT~kBge@vAcF|HaN_cAx^cbRxOudAlAmbGvP_eA|_@gM~Leu@dFkkIuTa[vAo`@aO{m@hCopJeRg{BzAqs@sRgeAmXbfAeIkv@~NorDmFivF_Wh`@_\\\\~`KqN~~@wSkt@w@m{@_WrxBdKtfAaKvH}]_KeOfMoDfTuTdlYyGrx@xK~kHsRndBjOfqMaMjhD{j@xeAo_@rpAwJ`|AqKdXgRNaWmi@wQb]wXqOe[jl@g|@tj@yGhOkx@aqAg^w_D`Ro|L~SsqCxVvm@|KyKxQz]hKyUzGbP~Gp_AlQ~c@f@xn@pLxb@fU~oCaKeoKjD{x@|_@dG|H_VkY}~IcMku@gHesIpT_bAlc@wsE{[emBZeiAyPaiBrLcjB~C_ke@dt@vhAzc@bzA|R{eBug@wdCaFyqBfNks@jAkcApSej@vByeBfV_q@|j@epG~RejK}a@a]yK_]{T_~FjCud@gJys@zTcvBkDwhGvt@kvCqD__CuS}xBqf@_dBvCovAoXaU{CcPdJavIm^iiCrX}r@aJonBnJimJdCqKrS_HhRin@eE{oBta@quIlUmVwPmeEcRkdAr@sfAyvAmhFyFwdClHw|BwVqaCjImy@_EadCm]b_@qNa@oo@_wBkReTsOvg@yy@kKqZzEojAjcEsDsn@ro@c~TjKg^vQsTxq@yQbb@xmBvJbjApVxTnTu\\\\bR~Nt|@dwDl[g{Ano@av@hPsk@cc@wmBy@ko@|Saz@xDetAwRm{@sO_mBbC{xAuRe`BdHeObJlDbNje@rQcUlR{jC`M{MvYfMlR{sBgF}mDoM_l@eF{rAqm@}x@fH{yA}Qm}Am[et@qNa}@JcaCjMk|@nLzo@rS|BvP{j@b_@cRhJwd@vJnWlGaFnNkx@sGu}@vw@wsCf[_|CdVsnFkKe|EnJ_l@wLox@bKws@gBqeAtm@`DzRbkA|Oql@bQhFpJ}EcH}hA|Nad@oL{o@`CmTm_@ej@`Lm_BtAiaFbTchCdOeX[ol@rjAaaHaKuuAq_@aaAhD}rBk{@wfHgOy]k}@ul@eEyNsNfQ{\\\\daB_Yva@i\\\\lAwp@cMsQv[w^uZsNyn@aDkt@nM}rEhe@a~@zDy{@fq@kgCdDokAyKsm@ib@z_B}c@nFk|@rl@yTrr@eZ~Z{Nfu@me@jGiOeJwLcXmLcfAaAwfAlv@kgMnXih@pgAayEpj@svAbPqkBh[mtAxOuVf]]laAvhAvf@`F~NwOuOwaD}N_s@EszDoSmmCQ{jAma@mgEd[orDi^_fBmAs{Bpg@}cB~MgKrZlQrn@|cCnXt`@x_AvyCxh@_dBsv@a|Dy@_lBxPyy@bBkpC{T{_@mTq`DiNui@iGuqE}o@o~B}Gkv@dLi`FxOumAhh@iiAoJw|Ai[g~@qD_sAj`@qjBzC{p@_n@}~Cid@yr@hAkd@nJuTpInCvImOof@cn@sIm\\\\iBuiCww@{v@iNgh@zNkmL{H}PeJle@q\\\\inBgEwiF}OmfAh@}jEsu@s`FoE}nBfGi|GbNkrAym@yhF_WujJyn@ueGvAstA{`@kvCyVck@uu@wfIqm@__B_@i^bOqn@kZw}Aeh@dM{RqQexAkoDky@eAmW_QeS}Vsp@ckBwXsBgRyZq`@kDoh@qoAuZyWcLw@wh@`W}WuCoNdG_Ivf@eVnG{D|KcLr`A{cAlsBuG_p@_RpWusA|rEup@bZkm@d|@cq@mLmfAeaBcOpYoBhc@_J_u@cp@za@oSe]_Deg@mT|MaZ{GyWd~@uZlWy`@bx@cx@te@cZcVgSfI{\\\\}Dst@~s@e`@j{@euBly@eb@niAyKhkAumAvqAcMp`AcTza@c\\\\KeU{K}_@lNcQ}SgKam@{z@`Gib@cR_TIeNvT}K~t@qW~R}Fpb@if@d^sx@z}BaPeJuLw~AX}sBzKuaBwEgKaZaAy[`\\\\aMm`@n_@ybC{B_d@hWqCt@sxBrg@smDdh@sv@|@{[yc@uhAs]ng@wT{]ku@_VwGmZdAu{ByQggAqb@{]c`@zdAa_@izB}TzQ|Gh`ByU}`@gIwf@kNdJoQtjGmo@|pB}MtNcPeJeLcp@yC_eFoVsb@od@dX{l@lH{b@nrA_SpOeUeFeGm^{AwyAk\\\\yOkCqh@}Rtp@wJhBBo|@{HhZcEjiCkEpOoQ~AaNq]dUcuEyh@yvAkb@dg@hC`bAtSlr@qCxh@wLRkNvSec@roAwPfRgHdk@aGeT~Cec@_Qs`@{H`w@{D~N}P~FwJfn@gZg}CcFafBkZ}JqXhd@yPaz@yNoQ|AvbEgWeg@{JyrChD_YoTnY|DxrA{Ff`@e`Ag|BtK{pCuTfDa\\\\_S}GpCaBlXxTdm@ym@j`Fdq@tjHg@puAyWkx@kNvi@@|l@}lAamAgQ{_@oJdf@yIhIaQyCym@kdA}EleBmh@e_AkSwQyNVkCuR`Ta_@pJmw@}b@ck@wi@_IbZ}{@aIc[_\\\\z[qOcI{Bal@xIwq@dJ{SfOpEja@uKhq@zRvWsHjEwUf\\\\ff@je@bRhDa`AtO_x@sjA}n@da@ueAfz@od@cAsl@qPkFqcAlB}^~PcT|XyPsr@yUlo@yExx@wiAqiAaB{SwRwX_L}@uHrM{TpdAch@}M}g@`NeQsAqKkUi\\\\q~CbDafB`UyTxDib@dVib@lHi`AfPmWeSym@BqUeRaZ__@jjA{Hep@wRnTmx@ac@yPg@cTeeAmMxx@m\\\\nO}D|LLhtA}LtZ_QqzAqa@kt@uS|ByRtc@eN{x@aPuIb\\\\ccBh@ggAzN{qA_Mi{@{dAtmCeVlIqKcDwMmi@fBw`CqMc_@{EkfAnFof@bXyMv_@c{@HsiA{_@av@_ReDwi@vi@aAhm@}Vtf@oOqLme@KsHmGXeSfk@gnCeFcP_b@bDwNeYgt@rxAHqkBlEw`@pYwd@fD}yAej@n}@mGyPqY|\\\\eH~e@aWsyAJ{o@fd@mVdR{iBkc@|ScIwSsCweA_KxJiDf`@kN|CxFhi@cMkDjCz}@oa@~gBcZ}`Ay~@jmAiBka@w{@nNcL{M|@ke@sGqNcLLeLwb@m[oWeKii@xGm}@tTa`@xAgnAlRcyAcRujAfh@ib@eAy`@pM}h@mXgx@cXjR_GoR_J|OcKmGwChrAaf@lz@qRosAoQiY~Jad@s@yoA_a@mp@{T|g@yJsd@lEw`@xu@q`B_Qgc@iBgiAa}@reDk[kmB_n@`o@gK{LwBsp@yJj]oG_Ot@kp@pMpOxZ}n@uMQxEaa@fImCpQb^`\\\\rHhU_mCe\\\\|o@|C_x@ef@|CrTer@ePos@{_@k^_Ox[iV}^hJmk@kE_^kg@l]yNwVtAgO`h@ic@z[mJ~HiY`QaD~Cmm@u\\\\{u@s`@xeAyP\\\\oCit@zKcv@qGdGwh@MzJ`q@yBpTcUvMuT{{@wWkQyD{w@vIbCjIh_@fHgC}@e^lPsk@_PAqE}MgD{oBwHfToVpEDeJaRmLcLi_BaRyEqEf[kPoK{@eUs`@nY|CikAs`@`m@mPp`AgJaTsIybAnPqc@wEe_@vKpBiMm[u^d@}No|Bak@wiCJuqAfYs~Bid@}UmS~DcJ_SfFoh@wGwLcXdO_TsXlPe\\\\|TtQxNwKpRmiCsFid@fRmd@}\\\\mPyRrWc[_~@ye@dQcHkIoPsjArVkgAg\\\\u_Ao@mn@kJqHcu@dKcNcYwt@gpKnDax@sJ}SuPgBcDfb@qRcG`Tmq@x@eb@aSmm@qm@{z@Jah@yv@wjG}@ir@rO}oBvWyn@lh@fChHySj`@|ZuIo_ApLuHtJ`GlL|]~a@iw@tOo~@zWuR`LxC|W~k@eSjjA|Yn`B_c@|^sQaQkDi^gTjZcLmGuOp^zAbhAcVl}AxHn[dSuSzXH|Cel@rKrT{ChwDpSf^tHwcBr`@pzAyAxeAvCtIfE}H~GvQkKl}Bv_@no@fVfhAjv@nbADnb@bIjGhGoL|Vf^pT|iAf^pt@zUzPdb@eOjZ|f@nu@j]sQldC~Vpm@~JhAbEcMdVapEfWhCvNptBtYgZvRwmAzOeJ|Wf@pZj_@nDhm@gV`~@}I|LyS{OuSfPbR|aCcLhbAwPzUoq@h^sK|[zH~p@r_@vf@`Gda@hb@e_@dl@`WwBwf@~QuT`ZbX|BxZaPhrAgk@kKmd@dBgF~HgV`tBxEb^fZqKfO|L|\\\\~dCj^xVyX~bBr_Aqh@rHjByAzz@pNhX|ZlhBz]zb@hMoJvQ|Qx_@pjAb{AfyB}EbxAl`@xsBwZz`@sQ|r@oEjbCbNdPhz@oIpRog@vB}w@nSiPtF~j@jQqLvNd@jIfLjB`mBua@laBvV`z@jKgTzFvIvYrbBtWoMrPkxAhRpf@bd@UfHyOdIsaAv\\\\eWdCir@oJwcAbIcQfNv]xDnlAaH`s@tRnkA|Q`BvWuQh[vT|TzgApTn\\\\tGbv@|JrK`[sOjb@dV`GqbAta@d@zNcJv~@_lCtL_dBoRubAjVoXfOqgAoqA|^yEmj@|f@yfDg\\\\zEmXmKwh@na@kK}p@~De_@~e@mbAt[mUlBs]]uIwcAtAgFiF_@otA`QwuDjJ_Rjo@fa@bKlUgGxe@pv@hDcSzt@i`@n[eBxj@dOlIlIt{@zMhHfOoIja@mzA~WfTfYh@|k@tr@tEtz@iK`b@iPhLgr@wM{XbZcMje@cAdxAsXfo@pWzRjaAkC}XhbAeJhpAxFt]bYaMre@en@pVtOvV{Wbh@nNfKnb@gG|d@ctAnq@mc@dw@o\\\\aPgNnHlBpmBqQvl@VpVp_@l_GrSzj@xPhO|OiAt{@cbCrg@{UxR|lBrSvRrVmJfG_rBjRlPzW|CkL_|@bd@dMrL`eBsd@dyA{g@|HwI|T_v@tW}vAtpDyb@tWk\\\\dDuBb\\\\tMne@cVtuBuR~^lIl_A_Ov{A`O{a@xIdRpJom@zLfdBdO{n@`@mr@~J|LnSs}AdJgIdLhA_EdmEjPqI~NemA~F`gAfa@bU|O_bAlQ~KhQ{PrJzoA}]fz@xMvUh[xLjIna@f[qZzHs_Bds@qrB|KdJp`@{]dUhUtKjn@lJddC_Dbj@_q@vwAiFj`@jHtNhd@rT{Bd{AyRxTygA_NzEzw@h`@vd@hNtz@nr@gk@|c@tCAfq@k_@~d@aW`eAnh@_r@fk@ps@bS}b@bZkS{Jct@`Dch@`Rq@`KoS|IpqBhOtIpDpd@V`gA|Tkc@pNifCf[iYhNvqAmTxdAjQ`f@lGoRvMfg@oXnj@z\\\\rt@vMb@xAnq@mHbVwSjNyc@dBuP`s@rYgUxW|LhCbY}Xhp@~b@xRbW}{@fa@sInS|eAdMm@rBuNfRTx\\\\{|AfZkUtFwg@h[vP|NvbC`OtH`c@wQbStGlHrpA`QeTjd@{iBnAcl@vRw}@lP~^rShwAhAdz@zVn\\\\bDhhAhk@sKiMixC~HqZeKox@zRc`AaDm]awAssDzh@h_@fF}bB{QvAnVso@tIvHdKyD|JdWvd@v~B~BjZeMp|@l]~~@eAv`@~Tn\\\\~PfxAn]oKtHmVqIa}E`Z{`A|bA|}CxIjhAdWcKlFkk@dTnB~EwJQio@rMiWnVsaEsIu_Blp@km@rLad@wCgn@pJcy@g\\\\`a@NmXdXq|@z_@avCeSlKa_@pnAbCgoBsW~n@ml@fzCyDsZbIy}@yIeUgc@xdAfFqhA{NfBkHsIo`@suAun@}{KnBo|GzFyGnOvHx[`_@gA`c@c[`uAuEvhApNzw@pn@htATvzBfSv{@vc@rf@|QqZpCzi@jXgU`b@isGhg@abBhK`g@uX`dBoArm@`i@qlA`JzK|A~`@bLlGlF_l@dNbxC}I|nBbH|c@bEzGvHkBfNoQ`VyvChFugExXdOde@haAtPmWhEi`@yCg`AkOgh@zw@eeA``@hE~h@ss@kLuuC|Ukx@lp@_c@nNgVoBkiAnPmYbg@`Bfn@wKj_@|E|bAvi@f]vi@pa@gfCjSiRfR`[bNcaBdj@scBvqA{vAxYj]zXx{@eUfb@eEbeAmPlb@I~~Ajc@qk@jPjfArSsSfi@jAbIasC~H_Mbc@cFbYr]pHkXe`@abC}Be_HtDac@xc@{e@jQ~m@tPh|CpRm`@nKk@bV`f@rQ`~AmGxsDfOeGfLtL`MuqAlp@lXfRuGvL_f@kBwmBuj@{mAiDgeAhH{zCxVaZ|UhB~b@dX~O|f@dNiVcCiaB|]bX~IcDtTytBj\\\\mt@tVwDzG|XeE`aEdVyn@jNquBx_@ku@rPfd@w_@p_E`DlmAjYeq@h^{`C|^``CqJjgChc@kFfNx^x@|qAj`@yJpJ`J|Lrv@}AxuC|Ixb@bToZrIk~Hw{@{iDyTuJ_OklAjb@op@nQugA|K|_@bLkJbIo}BpTzb@h]yf@lLjOpC~f@mIpz@_g@lvAtEbh@zZaT~Ssl@jDj\\\\pGmHbG_e@dWjg@pAlmCsK|mBrGfmD`QpfAvL~vIrXeZp]gqDvJ`Lhj@~eCfTwUfAoc@kv@oiJrJiyC`G__@ra@}v@vUyiAoHqJ{NfBgYxVuKiJqImb@eA_yCo]omBvF}Nni@{HlPbExGlOzVxzC_Btk@pV`f@u@anClYk`BpN{N|YbCpGwG~FnKgDzsBnWjtAdGaSjBajD`FqUhLwJbMhB~T`pByEbfEi\\\\rhEpLuCbVgx@zRz`AjUgj@uC}mA~dAqd@fHrYmCldBbNcPhLorAhQw^`ZfCbKep@wHcVih@cc@kHkViBcwAyOqnA\\\\yqBtg@o_@zj@mD~|@~u@vN|s@tOmYdy@j{A`HtFjNuJq_@abBcY_`@rk@cyBwBuq@qF}P{n@qf@qr@o~@{@uk@rMge@j|@i[vR`Fd{@tfAj]jCti@ue@nXb_@rKzh@`M|pDkK`l@sm@zJqYvoAtEhOrP|Gf{A|ZtOt^eGr}@in@uG_i@vb@jCnI`i@vMrOpRxAfrAgP~s@cn@zu@wSQ{DqNd@vvAo[do@dJx{@{k@x|GlOrf@z`@{_A`i@_dEtSgKiWfvAwHhrCnQ}rB`y@{yClWgQoKdvFnZmPfDyx@dUm~@tb@|AtJsQb@gmAk^ga@aEua@|I{|AhXud@kF_kAt`@kgEdZj[pNbc@rO`oArPgN~U|uAzDsbBmZelAqDap@hL}]va@pp@foAn{@ta@jn@x@rxC{RlgA{ZzWkD~]dKh_AaCr}AxKz|@`NoZlWefBnd@jlD|Fss@{O{pDrIit@~Sea@jCewDtQGde@xo@l[pbAhWvdBnBdq@wQqQTb{@{QjJoKuHrHrcA|d@fy@kCfiCzSiw@tLsJpDbStKc[oRwcCrRw~APucCgQubCsa@ebCaEwaApJi~@dWcE`SbYt`@`}BpYdz@nPgl@~\\\\|k@~Lfw@fNvgHeC``@eWp\\\\nCjg@}Xh|FqStx@mPfbBba@qQfTmb@rl@svAjR{oAfaAm|AfE{j@xh@nAhl@nq@p}@x`Dn[huFyKx]_OzHs_@eFuo@jQm\\\\gL_Lh~@yT`BiH_LyNpP~QlxAab@rjDzHtzAn_@`Pt~@isBx[}UfN_D~HdHxEd`A}KxjCiR~fAfAb`@`[vSvBkK`HnMrYh`G~Jbg@|UwrBmPwzI~Cih@jqAcsCzQdcDgJv_Cpg@vtKnI|eAxn@hdBxIef@tRcYeRerAzGkt@yNiyE|OofLbTzL|Mha@dkFxbc@pAt}Cd_@deA|gB|lOttApyc@bJnYx_@_bAm@mw@dmAnx\\\\yG~k@rh@d~Di@nm@hSpkBtO`q@bM}aAyLihCnJkbAfQv|DtLg`@^yoBvFjdBbRtjAzMjKvPgWeAe}CnJhuAvYnoA}Ci~AnObl@}HxeEvSlzCvAacChTvfC}HptBdJzJyFpiB|MnpM{l@trLlHteBgShaE|Tn[jBr\\\\eLxt@aWtbP`PkVjB_aAbEmMtMqAc_Bhe[En]|RxF|Bbe@cYxoEpJ_c@vPiIzUpu@eCppAwn@bwC}Frs@|JltCa_@xeBzLds@hO~HnGzoA~PoAxh@kc@cfAd_GqBjzBwm@hwApg@_l@|EvlAlk@cs@gR`aA_cAddB|PdAvJoLrz@igBj`@tLhHdWjRm[n{@mqEr|@o{Af]{iB|Ro[zK~DdAtReJtjA|HrOnD_Fv]_wAgAotAfr@gzCbkAo`Ct[onBbJmfCnHjWxd@olAfLaiAlb@q_AxV{VhCew@{m@_oAoUumCpuAy`m@vNnm@pU_V`KTvYdZj~@ftC~a@vn@hpApkF`G}h@pHpTjA`T_LvIPhOnO|s@b`AhpKoIaqElNnkAjRtiObIdaAjIqLdRnk@mFbXuVbSgVb|@eeCjeo@pJd|@y_AlrY|R`iBZvbAug@xrImHjaD}nAzqIja@ieAtg@z`A|e@vuF_KzuCfDbgDzKa_@l{IibqAtPu[r`@m@`S_T~f@YrOzRrdAntFjdAfsp@cmFzxi@cvHj_XtBhb@fMxAzuAu_A~Rqf@|Ds~@pN|UbNkBbl@gcCrAgm@|R{u@ty@azBpVgOlCi^tRb~@Dbd@wJ`WqIwQik@vmArC~mBeGvZqPym@aGrKkNjjD`Ttc@pNiBbFmN`LlTXrwA~vAagBbj@snBpt@_uAbc@u|Att@spEtIquB{CexAvO}JlIjXjJClVonAl|@skMbOmUnr@oxDjH`ZxOug@cLmdApDejBb_@xhBhZtZp|Ag}ApL__@nbAseKgOgq@iZeOuy@rbBsw@cDi^s^mHwb@~`@obkAdg@i_Chc@cbD`v@a{@}@o[tLAp`AldA`u@ve@~Tj_@jdAjhDbd@jcCrm@xjNoRrhk@dWeYroAmzWlPe}@gQ}gFlSoqC{}@wdZhDmPpg@dQpQqLtcAibBwLgi@t@}e@zC{IzKrWjEoKqBmz@zz@qvDrl@ydAnF{_@dRwF~L|L~ZtjCh_@hg@~g@kvCT_p@tUeyA|@chAlP{}@e@_q@tKbW|@b`@}Lr}A~Px`BnJ`jF|Jr[pAkhA`^c}@{DubEqJsx@jS_dBkE}s@|Scg@ct@m_F{VkiFtXu~Aa@ioAnrAkqS{CuoB{^`Xyo@i[we@ii@mKq`@}Ba_@lLkrA_h@knKrH_eAm_@_xCae@o`B`MirBkHmjAfc@}dGvWkV`b@jQrZgEpHnGhh@a{B`AweBvUfP~GuDhSao@?mtDp`@s~Ha[oiC}MciDbIwoCfe@wsFf_@duCi@flA`My]fzFkm}@h@uxHnKwr@nMsni@pTy}B`]sf@lBeg@~NhRhL~x@~Mb{U{Hrr@k_@ufBqIzz@xb@jwEIzwEuh@iuCkLlTvu@fgEbEh`Icd@o{BeKp_A`J`dBfc@l|B`MziEgIzeAzDpjDtJlMnXiGxG{n@rFc}LvJp`@vAo\\\\iOopDkGkpUx]le@~Cog@pTd~@jIdnj@fd@|yG`LmyI`Jyk@hQn_@|p@tbDdl@xe@`Pjb@|J_Q{AegBxIzOxFzrA`E{V{AonAh\\\\zfClKc^kJyqI}QweA{BmwAd_@iu@xXdJjJyj@pNhCfG}Pg_@y_KtM~i@fOkV~Bm]qbAstJbWw|H{[}tA}NfWeFu[oBc{ApL}BvBwNqMgoIlRixFgnAusYvBke@jRn[ePiuD`[r`AvoAv~Ji@fiBvd@rcHjb@hsCxd@prNhc@eh@|_AeqClP|HzEfPW}n@lpAy`F|XmJtq@u|ArXtrAlBhxApHyPqBotCoUqbAjk@idCie@av@iU}zA}b@gUuJaUGoVjj@kx@nB_V{HqVmRdMaD_i@zg@su@|QcAdi@xNb\\\\|Wf[fl@`T~p@lHxrAtXxaB|b@pvAra@tlCoI_aEf`@sOx@_OoRaqCpA_j@|PeW~AiVyNwbCld@vdCvPycB}\\\\cvEdXgdBwXodJnRiXZgbF}xGkv]u|@qzBqdDqqYcN_Yeq@a}DkRoh@qScEiHeh@jM_Np@y]eO{z@IkkAtJopArOgU`FahB}Oqq@gShZ_K}b@ea@}QaSus@wAuaBwReVkI{c@kNeeIqFah@}DpiAs\\\\ieF_HvkFiGkuFvHqcAwXonHiPuv@`UsjJ\"],[\"r|tn@ytieBf}@hgBzL`x@dAjfA_R~uEda@~fCxSbbG}EpjAsRf{@qk@jf@iu@l]oOabApQik@IgzAr]_uEj^kxAkb@ciDc{@inDw\\\\ef@wJ{}@lEo`@dPwV\"],[\"bxtl@}qzbBnHcd@`TmLdMxUWbSuXrR\"],[\"|wql@eh~bB}t@adCq^wqDfV|b@jMjFnJ_FbBz_ClFcLbL?lN~PtNqi@tIoDjLrQdHr}AhT{dApd@nm@sA`KcUhHuCfd@xGPF`SaN|n@}FcKaHz_@sb@hWkNkmA\"],[\"vn}i@sfubB{Pua@}Bgd@nNsdArRxhCqDzU\"],[\"`fbh@eprcBrMbA`Yco@tOqFnMbEb\\\\~h@zGgOpa@rfAc@jb@}Qb\\\\_^fN}k@uf@wRBg_@zUmLyEa_@fGxCka@\"],[\"xlxg@qitbBf\\\\[uXd`AytAlcA_K}Sx@ma@fOsw@l\\\\dAjJcb@dI|OhRoO\"],[\"lr`d@t}nZoJlQo`@eIrj@yh@\"],[\"~q}o@f}dc@vAb~Dp[vQhO_]Wdf@x[~e@bB`c@`f@tO~Hg`@nHpe@vg@bI`TvVgGifBeJiQwF`H{CgXtG{bBbWjVpFxd@wGz[l]tpAtJthAx^tNHogCp|@t~A~iB~qItZ`uDuz@joc@_w@voGwHtNeMxAkPkMuFhDuPvo@u`@rK{Lhj@nG~uKlWpt@fD|zC~ExVnGsMhJ|T|UWfJ|bAwrE`en@qMfc@{d@t[gWiU_f@iwBaDoxCeCog@{FlAmB{QzMml@xRzZdMaMj\\\\tW`NyN`_@eqDuO_lpAoz@{hIyKwVuE`nE_W`e@_Nxq@mU_DuJfb@ySnQgTzk@oHkPwAyjAcMfYIm_G_Uia@cTtl@a`@vdCeKezFsXgOoOmi@y|A}pQpAkUfT`TfZp`GfKes@rIoxJxY`a@Pw_AdSoy@_GvzBfL~QxZwLaKmqA|Ly`AtCraAbZca@x@bYwMba@lCll@jN~Htg@wr@hNvAhOrmB|Rsf@mAgkFdNm|@xSnc@{CqpAjImLV_Y~HtF\"],[\"rfro@d~jg@iOauD`Mgj@lTh|AmG|k@lPdyCgBttG`T}gAfKebEl[gaB|Cg|@iMijE|NwtAvIzNrGuTtAbLvOtpE~YyfFrEkNbXsAxJe_@vVhlDbV`i@zc@njGqBzu@aL`t@_J}D}FjQgGup@qQvLkOle@{Md|DsIr\\\\f@f~BoM|_@{LsN{`@o_ByQqTL|jAm_BdxM{Zpj@}G~k@{KxCuKoTaGi|@hDk`@xI~Os@}cBjJmj@c@apIfJfg@jBc`ArP_jA\"],[\"d{ro@pxrRrFz{EsFh`A_IigAkJlp@qCmfA}X_McPvO_QicBa@}k@z_@_`EzO|E|EzW`IvuCzQgC\"],[\"tpko@ttsh@qd@seC}G{{Fh`@a`JhQt]hN`~@oExl@lIjf@dl@jmBzQ}zBvEsMfFtIvBeg@j^`lC_U~`QqMre@yEnhAoRqi@gq@{iRsIwZ{Ab`C}BnQuIi@Pr|Af\\\\|bGmAbnB\"],[\"nsho@boih@AqcAb\\\\~iJoHrYiTovBoDswE\"],[\"jalo@xluSfUjk@hGbs@sf@tzr@{V~hAep@py@ps@oeClEgrAy_@lj@aa@u|Num@seCkAimAnnBq}[\"],[\"tfao@vicm@gMsr@tBaeApg@`qA~[kZ`HnR_T|tAuMtEwLwI\"],[\"ba{n@~kvg@l`@nhEzJiTtfAnnE~T~ZtmGxe]~ElItIeAdLjWjk@zxDl`@`g@vOf`AbfAd|B`A}cBbPflD|H|Xjm@omEjDd|BfOtZzU_w@dy@nqLoZ~oT{k@jlCub@ddA_[rTi_Bgf@emAy~Am|@s~B{eCstNaAopBgYosAqXssGg\\\\mtAmGoiAu_@uvAweD}i`@i]i~Aug@mlJfHwbDjYkmAzNr`@hWfdC\"],[\"`l}o@fw`_@mHokAw`DyyPge@ayA_h@wkDoh@ozAmz@giFyh@muAaUcvAqa@kVaJ~OcKqC_[~_AgSuIaU`b@_\\\\`zEuXmv@gfAcgBaT~Ckx@sWsKpL_Ndu@s[}lCqq@}iAjB|cAaObaA{v@_eB_Abb@mQlZ_Ku_Ayh@wY}j@{eB_e@kt@giDcpLee@iZe[giAiH{FgEhUqIiPenDi{c@xZgdXtkCevZx_C{qLtf@yu@~r@wBx|B~xBps@~~Y`Gp\\\\lYkmAp^dzAnSu}AmJ_|[|p@}rBzm@oy@nr@eQj\\\\OdO`MgEez@~Tu_@t[ojBxb@jeCtPeWv\\\\gbBjXyq@`[sPdX}p@vq@wNhdDd[nf@jTv~@`kArUzxAiNzcEhSxaD|eAudGvLcHrSbQdUnp@nnHfzwBtU|Wx[mf@hMv~@zt@fftAaWfoAs|@rhAcp@}@yg@uXuJvGya@acBdDq`@hW}YqEcN{u@eo@wcAwnCioA}{BmWsfBaSdTg`@q^Iig@~i@mvBRibDySmyBkv@cqDxByaAtIaMrMxCtOzX\"],[\"h}on@taa]~CxqA{Ifd@ySofAm[cxEaXywAdAi{@\"],[\"zrll@bxlKnc@b]hHpf@mU`cFk|CjuAsi@fwAaVtmB{IvaGtIdkE}f@kzAwVw{Io`@cvBwp@}sA{ZVwm@sj@e\\\\iI{NgTkSsn@{OkfB}RkDxSgs@jWmXjU_AlZ|Lz`@oWvd@pu@zSqCzQ`Nnl@YbQn]x\\\\bFvThTjiAseAddAwoBhe@oS\"],[\"djxk@zz`l@wPwZzEuZlOx_@\"],[\"jauk@xgyj@`Ofc@`G`rAmZ~bAmKqHmGq}@zCoz@\"],[\"`csk@fbbl@iL{tBpRylBhu@~hBdKnGxUgJrJ|K|q@f}B_Bjj@vVxT_B~Ve]fEg[`{@_QeDgPo{AqR|d@cR}tB_LiDiK|R\"],[\"htrk@lt{~@x]grA|PgL`Vb|@lCvgAeSf{Bs[nx@q`@fe@{q@hb@}^_z@gF__A\"],[\"zonk@vuzu@cOcXiDuh@|FwdClSufBdSym@z\\\\n{Abz@naArK~_AkMr~DvOntAkEbu@uT`o@ye@dXy[s`@~@wh@fYckAcEgv@e[qn@\"],[\"rdnk@fztl@tHguEjWoxBpj@{w@hQhLrr@jzArg@zPbXlt@xZbD`NlsBtOpf@lGc~BjH{LnMm@hS|LbHuo@zRySrOeq@|u@|b@rTh\\\\vRznAmfD|_Xef@v`BemAlz@oUkMcW}f@{UcpBhEwcAxa@yjCeCibAwSpZuJiPRqqEoImd@}SblBaIhtD{z@rjDyLwhC}]s}Aq@qbA\"],[\"jrnk@fafx@pIO|FtMfEhv@wDhuAw[`oAm\\\\md@iCkt@jV}eB\"],[\"twjk@vr{z@wQiv@aBw~@dOwvB`Trr@~CtcA\"],[\"jgmk@nlnv@pP`QiCkyArMw[f]sH`i@zUvjAz`Bh]hbAzZvoBnJjrBkHlgAgBlJoi@}V_kBgV{`@igAqfA|xBekBvaBmd@ixDkCcwE|JsoAvXw|@nS_Vfe@oLn\\\\pQ\"],[\"jqhk@rl~w@kTyr@zGufB~`@j^hLdn@cB~o@oPhm@\"],[\"nfhk@l_bd@vLl_@vCtwAy^gd@\"],[\"nmgk@bptx@nMaDre@es@xNr`AzZfrL}Ntl@of@yn@w`@aqFah@onCnEgp@\"],[\"`xhk@hc{HhY_a@fa@aQrL~z@_KfqBkOnd@}Xt[q_@pQ}^QaWo[_Nee@nAwk@lNg\\\\\"],[\"tqak@||`j@hI}qA|WpjA`@df@iQv_@\"],[\"nfak@p|wc@sOqK[m^|Ig`@bRuPvPrK`Aba@{Ija@\"],[\"dl~j@bgvF`j@mtAdWsShQ`o@_FxlB_g@vnBqg@|[{`@yj@[mu@\"],[\"flck@~ehy@rJ}j@bLhDf\\\\xo@jUztCY`fDjNjm@vDkaApR{[zZIpPnZzDxh@iMlvIkZ`dAtMpc@fOzGpGz{A{JtuBy`@brAh^vsEvH|cRcR`y@yFk]_h@qv@hTdyO_Vtk@_Twh@gJ}|AoTkg@e@jhJoXrnAke@xbElGljByNbWgk@zhVaOngA|QhvCuPpeC}VncAcf@tR}[i^mZssBcz@_f_@m`@mzBmAabA~K{_A|^ws@jWp]tLdlE~Ftj@fRxd@iKs{H`Jeo@`TnT`IqC`AkTuIwxAoy@ojAeDc|@|h@}vD~t@u~BtMycEyDe_@yz@t}A{t@ldFc[if@kSsiA{l@gfAgD}iEf_AgvI~a@bc@hRzm@hPxlBfk@}e@rdAnlAiCmp@eb@kdAkHky@fWkfCj\\\\d}@k@_nAaKie@i]sEcMbKiLtq@qQ`Eku@}TeMpf@aPg~A|AqtAlKqpApY~|ApUgeCeCyYda@t\\\\le@r_Clz@syAvIop@uRgv@kb@sr@su@wt@y`Bqv@eQic@qGorA`PejAcOyiF|Ny}Af`@uxAj}@jm@hE~d@}J`jCvDfu@|YsnA|T|gAbHvjAhbAllFvTos@jHcwBck@qoDa^_pHuu@afCmDmp@fQqg@pOtmAd]cfCja@huAdPbpJbLj`AtTvp@tBsh@yUchFhl@sfB\"],[\"zujj@tf}c@pFdu@eG~uAoR`rA}Xji@iU{YiNc~@yC}jAxHo`AfQa]hV}L\"],[\"rdjj@lcvl@pfA`xV_RdkD_Vhd@u`Abo@io@icBs^uiDee@gwP}]skAmUuV}r@eEuMym@~LorArz@szAhx@{o@uY{mAcz@}Asf@nJkIsKfEkyAzk@igCml@nb@_LuOsIez@`Mc~Az|@geDb\\\\_k@|ShFxNhd@d|BngN\"],[\"~yaj@`k{c@zYbTrRnm@rWbkBqEthBop@j{@eVwJsUvB_UisAdB_mEzMe_@\"],[\"pu`j@~~aAaNtp@yS{IcqAoyBs]e~AsKg`BPw_BhSofApm@gWls@vAtWxe@\"],[\"rq~i@jrwj@qY|\\\\w^tBmXh_@kRorAjRqHl@op@iSgoBvSkw@np@ju@jc@poAhIdbA\"],[\"tb|i@peej@wWgEmM{UzGew@rLfLtQsHpK`QNbcA\"],[\"jnui@zmhd@xKlqB}XlhAaV{BkSoOiLw`AzUepAxO{N\"],[\"pxxi@dham@{K~aC{Szx@qf@v[gM}P}j@cNgS{_@gOekAoM{hFk]ba@S``AqMd\\\\m_@mfH|J{lBzOej@nXAvb@}pBbWsYvRap@bRyIxQhxCfY_@rWme@bJ`E|p@|sBxFt{@\"],[\"~ani@lwmk@i[pdAoOtNyPuGkd@csA}DejBv]scDxfA{fCtWmQ|QwxAnSmc@rP~i@lXa]?bSii@bfBhCxQ~VyFfBpK}s@`aJwQra@\"],[\"|oki@ddud@jWqKhGxh@~KyB_Anw@nGtSva@eZpZ`j@rMkOnEtWdV^vKh`@Pbr@iF~SeQ}Cwr@~o@kSwK{g@nKwx@peAgy@baBkrA`dA}Qq[wFak@zWkhD`rB{gFpGdFxN_E\"],[\"fpik@purj@h[roQ_UpaAs]oP_Vea@dDhfAtZlsAmF~hAojAl`EkYja@{\\\\kl@_x@_iDaLixAlNq}E~]mq@pIsd@q@ce@qQdHsFyi@dRcxBv\\\\{tUgGar@uQiGsp@slGcU{h@uPdsBs`@he@cIzy@|V`mDlp@f_E|QbiE}DzrBmZbxA{HoR{d@nsF_WgZyj@{`Qmd@geEqLcUiUtPsO{Yqd@ntAhWlaD}^rlDvg@doGmOvPaPqTu[jDWj[aOpWgJvs@}KxIyRbiAvBf_@oS_KsSly@eJsHsE~w@bOlN|Olo@xQAzTf}@lIlDwHpv@nC`[tYvB`DyG|j@xU|Xvi@rJtp@jBxlByw@ldH|@xpD~Nbw@t{A_kFnr@lpDxUdLhY`{BSxgAoPd}@q}@rtAbRxzDiEpaBiwAnpIm]ll@yV~DkUmU}LgfBkJ~F}Col@sPdi@uGyKsF`KkVriB_Wir@_JyqAgd@wm@wJubAd@qpAvNyx@rl@k|A|Xs}CkE}}@uLuEu_@zhAk[eDwZf\\\\{J_N_NtNcMsGkKca@sOazATu}@ll@wgDba@qz@`c@cmBzVua@yEoh@qc@okA}Vni@kYtnBq[bo@sLvFqWqJsO}i@uC{mBvv@quKaBkpAqNwIsEqYpDqkFkRpQkFa[}XlwAoc@ziJm]naBqVlk@wXid@{Ca_CcSem@bGw}CuEmtAry@ywL{L{xAgi@ssBu[c^wSp`@}MoKrR}vBaU{mAtUg_Ee`@u~DjC_eAfUuw@jeBicCBgbBqj@a@eWwWcr@zWaf@q\\\\iHxKoIoQ{GbCeJzh@v[zn@yR~v@bDvrAiWnzC|FryDkh@`{D_EleB}IhZc[bNkGhSw`@g[eeAkgCiQzJuJuM_tAuePvw@igGv@q|@}BgKwMnFwWrq@mdA`sGyCjjA{YrFuMum@yPnOhOvvEc_@faBxE`r@lDlIpN_Btb@f{AxC|iAmUzx@yEdfB{]tr@yIz{Awi@trC{\\\\xb@cXrt@mLa@_M_T_InJu[eg@o]kFiLrVoJlCeDiJaZj^}Ui^iRouAePxRc\\\\mG{a@ah@iF~I{N{JyJmc@eZdm@sIuV_D|NcFtmA_b@dbB{B~sAmIab@ij@`hAsg@_a@}f@`z@sQkGqn@cvAwU{Suz@q{JQqtFkIuv@wPy]aP_eHv^sv@dOm~AjWyf@b^cBhT}N`HdXd]cQxZ_s@rFtg@|QzTzKed@vTeGjBxT|Li@`JhL?hh@fXm^vMjIkFcg@hH_lBoEupApaBs|BrMzGz`@co@rUq@|d@g`B~PzFl\\\\}HrMzKpVcItYbGxm@ah@rY`K~J_Yp[qLjjBgxBxaC}~Dhe@{d@nkA{m@pe@aiApNlEfQi^dWPdOuY|MvDrYyf@bEj}AfOusA~Sa\\\\dTnDpu@yGjO`Idc@kVrMlMlk@_c@tjBaFtOhXrXiFhPlJbiBmeAxb@x[jaBXfVlFnNfQrGzpCd_@}`@hX}FdS}b@lUmIp{@xh@`h@hBdLvLvEzd@yTnjBdCxy@rMdEfJyL|DfKvJbxAza@}FrFpH|GrjClWtJrFtj@vYwGjSul@nXto@jx@fsOsAtsBdV`fD{C|zAsI~Jck@~JkGfTzLj`A|_@dOxIx]\"],[\"|}}h@xidd@g`@jnAeNy_@~DinBpMyl@|R{]zP{HbK|MhAzj@\"],[\"hyqh@jf~g@pTbh@StTwOdKiFiH\"],[\"jllh@~j|g@yFaz@pMiw@bIp[}B|{@\"],[\"lzkh@|e}g@r_@~y@tD`j@wNz[wVwc@yFj_AcNi}A\"],[\"johh@lssf@cSuO_R`GjIgrBqMxK@yOrPgsBr`@rxAaEra@}FmIwGx`@jPl`A\"],[\"hzih@bfah@n^b`ArDxhAyFjtA{Z|_AmFyb@_Vi^jJl_AaIpPiRwTeh@uwCvE_bEtYme@vTdcAnStLP`i@\"],[\"dyeh@p{wg@ni@`uBrErs@wG|n@mk@euB\"],[\"nsbh@hcjh@gYw`@sA}t@pYhg@zFfX\"],[\"`x_h@zyfh@rj@lhAjE|h@mRb^oc@avAcAwh@\"],[\"ni}g@jlhh@yWu`@m@wQdKmNjSwCfTlZjDt]kG`]sJsS\"],[\"~tyg@f`ch@h@beAiNpcAmGqWci@_m@cVpQeKmUyBko@hS{gAre@y]\"],[\"jygh@hxdi@qm@nj@u_@aEcj@_`@wYm^ojA{mCgaBqpAqsDwlE{xBibIaCww@jQ}cAtLN|FkJzT|ZtH|b@xJ_BrQfz@zJkHnGlcApSpDr_@hn@hX`Gjb@k_AfRtHnFta@dMoDpF_wApK{_@zQbBhMcjAjYhx@~Cfo@~W~JhIdcArZvExJbkAd_@fyAxNsqDjT}IvWhO~_A`aB__@lrAvVl|AdHtJfKkSxRoAdiAboBaTzu@jCvH~BaM|BdEdKdn@|EraB_UtVkPiB{V~rA\"],[\"~sog@rv~g@kO}VcK~QeJoBjIgjAbKaAtIjS\"],[\"pdmg@rq}g@wEjK}Hg^pNyZlA}|AbNz}@\"],[\"bwjg@xcwg@qFhMqJsLeWirAS}]dK_UrXnp@lPu\\\\hNaFvPdOfq@n|AzUvtA}MxLaq@wvA\"],[\"fmgg@~~bg@bLeG~@lm@{ZyL\"],[\"|seg@pbfg@zGaFfO~NxCriAyUmf@\"],[\"dmeg@rvzf@oOrOd@k{@dHy\\\\jPyBdInd@\"],[\"xrdg@zwkg@cLj@cXkSrBoo@kQsZwQ~Za[oDk[_`AeKkCaO`JqK}KySghBdMe~@{Cah@f|@xb@~OkIp[tyCtP{m@`YkKlU|IrJeW~DhIzZzrDaKzs@mNaD\"],[\"pw}f@jy~f@bWbrAkObl@_HgEdHwa@wIk^\"],[\"bbyf@dzgc@lFlp@cOzrAm[poAk\\\\zf@yXyj@hUimA\"],[\"dcrf@||ke@fKnh@gCjv@yKb`@}LyFqBm\\\\zDc[xJqEiE}Y\"],[\"rxpf@fnje@hHp`@}IppAeFem@\"],[\"zvpf@p{re@~YtFrIxT|VfoAhFzs@sDjb@_m@g}AkFvn@iScl@rBmdAso@iqAjC_]pS|I\"],[\"zdof@lkfe@lL`i@}QjO{CxSyWi{@rGgO\"],[\"pemf@fkzd@pP?bDtGiEdXsJaD\"],[\"tilf@bz|d@gDa]wK_EmGmVhHoW~b@rYnEjUmJdZoGgH\"],[\"lxif@x_eb@kSmi@jN{K|PtM\"],[\"v_hf@psrd@yDcq@jTub@|Ezn@qCtS\"],[\"xoif@rl~a@ibAsfFhC_h@|Wni@dZkDvUxQt`@zx@~a@bbB~Dpt@eG~t@ePfb@o\\\\lE{T}H\"],[\"vdkf@nske@j]{n@z^tlCvLbU~Bps@`V|Nlo@z|ApAvZiCvGsPAgEfT~DlfAtDdHrJig@jb@zwA|UrRiJfh@aRzGaJpYwFfkCic@x}@iIbjBy^o`AiKuDgVtFyU_fA_LkqB}Rw\\\\eZdn@{Mmm@JwsAqJsf@eJzMcN_ZaGPkGn_@wLoRqK{`AmGgByP`OwC{dCeHDy@iJjUsp@zHycAlIo@v@~XnHiVpVxzAzFrIlAc`@xa@py@lDgb@a\\\\qfAGso@bq@|pAgKm~AjJtHfu@~wB~IuPIs_BoKxGuWs}@iBkT|HnKzAyW\"],[\"~mff@|iua@oc@}dClG}_@`Xt^rRxq@`I|y@kB`w@\"],[\"fjdf@|gsd@}Gfw@kE`FqSeC}JwVvCiO\"],[\"jyjf@jwfe@_Ah`A_IbPkHqOdAwz@sJoq@wL~m@aUjEeWwq@mWeNkQua@y_@{[}MtCoEf_@i^z^sL|]eGeCwPkoA{Uns@sVuy@ZeeBhIy]d\\\\zIpK}HnYg`DlGtInM|rBlHwW[kn@~Lm\\\\xt@`bBfPiItPhGnB_VlRtl@Pnz@dSiHdVpb@x@bg@~PtToQnM_DaHw@p[dH~KvNcApNjW\"],[\"py`f@duxd@eGg_AqGnD_BeGbEa[d]p_AbSfS`BiGzQla@lBh\\\\gKpa@uIaAsNk\\\\sFbE\"],[\"btgf@xhbb@nSaL\\\\|McTtWk@|Z~IuPGrHyNxn@~AjGzSwNpGfBpElj@m_@sDcX{f@sFlRlO|}@aIfj@rPcRzOLrO{LxMtMa@pOjGKkJzfAm_@yKqDcXqLva@jBn|@f]~FpKxSlApVcYia@q@rZzTtq@wApHwLc@}U~s@kM}T{HyhAkK}LyC{z@aIiQeOpiBidAq_@wH`g@x_@dgBg@lc@wCjXsMu[wKl[aYig@cZaTaGcf@{SrSiQ}PcS_uAfBy\\\\c[cPcImi@nMek@uPaDuIbQ}DqLqGeaBlJxSfD_Lvo@k_@rGvd@vSw@jSxm@xPqj@dGbItRuIbJl\\\\nDuzAk[tO{_Ay{CtJzLvMkYhKnVhL]lFjMxf@wjF`F`X`IgRzFpRJjsAlKjj@`IohApKvY{Ls~CbNlCnMhUrDzuAxDyDjHrLuOfbB|Jfe@dVenBbDzf@aMtwAxRt_@rMsyBrU_j@lHqHxD~EdHjsAgX`N\"],[\"z`~e@|ydb@mSp_@wPgjEvS{M~Idb@uQ_gC|Q{z@nGxg@fNpJnNlk@cUUfJ|kA}QtjAzKrOuMzd@\"],[\"hc|e@xqtd@wLjr@u@mjBjVcMdNjGnKrb@\"],[\"rf~e@bedd@P|q@sIfI{CczBsK_LoStU_PaAcA_u@_Qa]Gv_Aab@zStLqo@fFijBx[|x@dg@h`@~R}OpIkZh[`eAiGxWhM|XkIro@eJoT\"],[\"|xze@xxbb@eXvSwMiqAnD}V|d@re@~Dv\\\\\"],[\"p`ye@vera@{NoOkEqd@fDgsA~b@poB\"],[\"rhxe@luoc@oE`BvDc[zXeKnBnb@kI`V}Ev@\"],[\"bwve@vg_a@xMIh^z~BzQlDh@hSoa@pwBw\\\\tr@qYcTqNexBmA_`CbLcd@\"],[\"zpse@x~xd@xSpd@nC`eDsSmU{[dM_Jg`@oJgDjNoqB~U_O\"],[\"znqe@rkra@eRiCwI_VyCc[tFmZp[dOxEfa@\"],[\"lmqe@rit`@`J_m@zMySnVaEtUfL|V|bAM~uAwQvkBpC`vAk]dgBbNgInHbIwPpcAzZb[qCf^ha@p|@dJ|zArSteAoEnz@iWxYmMzd@aL}B{RekA{Kj_@eh@mbAoUslAg[ghDLc|CqJc{ArMc|@rOtb@|Pgx@yK_d@\"],[\"`loe@floa@}\\\\~k@aLqHs`@umAfPydBAabC~Fib@jX}FfQxShx@f_CkMhsA\"],[\"twle@dr|c@nIpPcA|VcJlRkObCuC`s@lXbDlX_tApDhe@qLnu@{`@rXk[ga@\\\\ql@\"],[\"~vne@xtee@xMjdA}VkXcFmVy_@{XgR}]kZ_NiVcdCbdAdlA\"],[\"nyie@`ipd@mW`GiVed@}DsbDdHb]lOpBba@v`B\"],[\"p{fe@fpid@gNkMkKvA{N|_@uDgZzO{tBkUquAaNiDsJrRcg@a[hRex@rMgBrXqf@`Ge}AmP{c@N__AuMi}@oh@y}@|g@ciAxV|i@pf@ma@yHmiClgAluE~QhyAmLh\\\\{`@qWiAjTvVh~@s]sSeWeo@oJva@|QvhCoOddAzCnTfUxObHbXxEvwC\"],[\"rqde@`|mc@eUj`@qKdrA_V|f@yF{f@jFcbCbQ`a@fMo^wMogAdLqb@|Fd]xKw@lEzJ\"],[\"piae@z{lc@{IcBsGit@zCmgB~Iaa@bTyTfXtWhEdkAmGph@cUn^sOuM\"],[\"zb_e@l__c@lBodA~VoVzW|qCsb@v{A}Rge@uOilAvC}`@\"],[\"fa}d@x|tb@vZeKrZvtAStVaLxEbEdeAmFjViHkYuFtb@gPzDhMbsA|IdJlHwEwBne@ae@mg@iaAwpCeXqGu[cqCwS__@xOquBya@ifClVqjByPufB`NcNbTpW|D_CzZov@oIb{Bjj@nfBgKx|Bj`@bRrPef@dP|WvGxp@{Bt]ij@lu@uMk}@sFbzBrElSzIuXbQvGp^`~AjAis@\"],[\"pekd@nk~_@|YbLpMrXgYzoAaw@yvAk`@mQrEi]`Ho@hXz^\"],[\"ffhd@vvr`@tGi`AKghEr[xo@tCpiEnYbSpDr`@vZnWjFhSoExj@kQze@s]t\\\\oN_\\\\eWb`AyZkk@\"],[\"~{`d@`odZrOz\\\\jBz~A|Le@mSx~@iNbPlJeg@{Egv@{GsGgS|DlSsq@kTys@zPwl@\"],[\"|y~c@jqa[sZyJk@{WcSnD{DwLxMux@cSkkAjVu~A_Dmw@`T{t@fJsJhHtIfIaXtAebBpL|OnRkk@e@pUjHvAfV}[w@fZe^~|@yCtk@iPf`@o@~`EqTbXeHaCuF|U|XzdB\"],[\"jrrq@juabBdMgl@ro@goLfLvfDjq@hhB|LprFci@xwOeJbM{IqH}JzOwUf~DgKnPcJyA}Pgf@mT`hBcE~LiD_g@kNrkAiGqe@zYswW\"],[\"jbwq@|jdfBfd@{vCbGld@wYzsQ{U`e@mpD|le@kL~j@}Iqq@\"],[\"ls~p@vus_Bb`@u}@zj@{dG`HyJl[beA|E`nBsKme@eXll@_YnpDolBhzJgRrJuBknAv|@q{H\"],[\"hvxp@|c_aBihAjt|@}ElNqDkz@ic@lnEqd@ci@iNuk@k]a}OhwBsal@fTo\\\\dJjb@zc@ohDnItR\"],[\"bfzo@j`a}AaCxyCsGq_@uCl}@a[b`AyTfgHqGlXeKmEcWv~GoXrsBgIev@n_Cwk_@hHwMdJ~\\\\\"],[\"t~to@rk`~ArGauAfVbcCcM|e@cPqg@\"],[\"xoto@hppbBj]mcFbLg\\\\vOpz@wc@z`KcHa~AnDebCgV~lA\"],[\"nwwo@lnaQpKxr@a@~o@sEhr@w]ve@oGhjBvQ`aB`[oaAvFmlAxLqSrWfyB~FppBeQx`AaGaxBgR|~BoY`\\\\jAv~@pEhLvLqKzI`\\\\eRnaC}OvwKc_@tmEoV}~AiDvw@~JxtByE`x@ga@ubCiXniAwAjeBeKgxBuMlcAqIa`@yJ}rCxBixAxk@o|Btb@e~Cb[uy@|Z}kMeJuqAwv@rrJ_g@|bAkTeqAjEqY`_@}\\\\pPmd@`n@anM\"],[\"zwlo@j|p`Bns@kkDt`@eg@bYmgBvJ_FdAj`@~VwwA`Q_RjIhFlJaPlj@_eC~IgIxLpKxC{Nt]|gAjU|a_@acFjau@{Tzv@{YpcFulAtrFa@}_A_h@vr@mOaQic@~FiHaJiLlAsHql@qX|YyWmi@eU{rBiNo_@eGadBuMha@g`AyaFeQoiBaIaUuUv[eHoi@|[ckPpRmb@hDg~AfgBk{MpJ{P`D~JzEgDtuBkmOlp@{lC\"],[\"`hhn@to}yAsMbuBgDsSgDjTmJs~AtO}jC\"],[\"plfn@hq{yAxF~WmHnmGoYrtBeUdw@}DqQrFaeAcNfj@}Dk`AhEs`BnLs}@nTkL\"],[\"nren@jczzAl@srA|MzWzB|bAer@|mRiMdIk`@}lF`B_zAzx@arG\"],[\"~qcn@nel|ApNne@ySd_EyK`_@cBqzE\"],[\"zqbn@~}zxAnLue@zLfvAtFgK|Ghn@wDjo@gD_H}D~VmJlDiQpbAkM_u@\"],[\"`xgn@{bifBvNkh@nYoNz\\\\hcE`k@`nBza@xeHs^|bE_@pgL|k@~|AlQ~tBd]plB|^xfAnHj~@wMff@cPgVkW_uA}b@i~@xDdlFiF`c@cA_lBkRmfD}e@z{HmZpv@sBxt@aQb]{Ue_C{h@kaAok@q{Bmy@nkD{`@k_@iQil@iK}_Bo@i{AhLizApc@wfAvXsNnTp\\\\~Nwl@rk@ekGsXevKbd@y`Q\"],[\"|ban@dxi}A}MiVuI}w@gDm|AvSanCnSaKdNp}AyJhjE\"],[\"`q}m@{`{dBlC_YdN}KjEt`A}UxJ\"],[\"n|}m@n`tyA`No|@dPv[vOt}GwR|yDwDvMwUbBgWfV_Hqv@\"],[\"|lan@lkkzA_C|bAuJgYwBzn@kDnvIw_@rcBaR`|BqInJ_KsJuPetAwK_mCqRza@iG_q@pQe@yU}kGpCskAdIuh@lRcHf}@kdEzIvGlK_S|HlKhCyeArS}aAlLhs@\"],[\"`q}m@~wexAlJs[tDnWiJjfAaPxd@eDcIyGlj@tD|OxRk@jJpi@ra@dxIsHpf@ySmt@}TpTyW~t@sOaXyHnUwHw`@tb@_aO\"],[\"z|zm@|}syAnDvsB~S`e@vEt|AoGbmCij@bqEa`@apBdMg}H}Km`@dMarExOy}@xP|\\\\\"],[\"|pzm@jfuzA|JveFwOpdCiQnd@yQ}\\\\wMocKvLkfAxKhGvD{O\"],[\"~dym@lswyAwLllCc]|~BmQw}AvA{rBxg@wuEtPrcA\"],[\"`dxm@bjdyAsa@`cD{NtGkHkkA`Og~AzUucAdYue@\"],[\"n_wm@lkrxAzB|DpVup@jE~d@wLdcD`PqoAxA|j@vXmfB|HvdAab@nhEeZjx@uIq[uIdSwEox@kI`KcHiJyDlc@oOkp@jDa{AxAkGnGpWnKg`@_CkzAhLr^fLojB\"],[\"bhmm@xa_yAiQa`@{Baf@nGajDlR}dBpZs^n`@u_Bhk@aYtI~\\\\j@`_Aa]j{C_F~MoDu`@kh@ryEqEzLoDeo@\"],[\"|vbm@g~lbBdCwd@doApeBaAnnB_Prc@eu@qvB\"],[\"ndcm@fswwAbaAwtCbQhZ~@puAu{@ljK}^`yA}Ok]wSmwAaBy|@pGszA\"],[\"vw`m@_rdbBeReZgAqWtUk\\\\pNps@\"],[\"v{kl@~g|oA`IanCxIzT`CoGdEpF~d@n`BoPpjE}EfOcGiUyKt^\"],[\"t~ll@h`|nAbHzRha@vAtJ~H`^rbApIpC~CphAsj@veIrIjhA_Vve@oOdlBaOek@_YvhBs~@kiF]skAtEwiA`Jd]jO_d@~_@_iGuI{xA\"],[\"jnml@h~qlAle@|`ArMsd@bThYr\\\\~{B`DzsAsw@d|E{NmC}Wl{@Nby@uE`LuH_ZkF`AqN|XoGoFuClEq@|QoG_Cel@db@{Fc_@nWklFve@w}CAwfBvJw~@|OcUh@o]\"],[\"vgkl@hmofAbL~eAaF|eAiVoTeKa^wP_rDzEsb@\"],[\"nfgl@dosoAtM~_@uHh}@eMhOoBggA\"],[\"`lbl@|j{eAlUzb@rz@ruDzRpbB|HmeBtd@`fAfS`sBhO``GeOzsAeYpi@sUwCiIoLycCy{Qq@cv@\"],[\"ntfl@bgchAeKt~@mIdCuN{x@gReUePkfBwe@zvAqY~KqKzd@{Now@kO|sAeCe_Cq[g~BdDegF`\\\\yaCpF_H`TTxFwQf[|x@zJcJ`Thc@xd@`hCnMdaCpW|i@zJfyB\"],[\"z{bl@fzkhAhNtT~Gma@fHeApLzVl~AxtM~KxOtO|@hKwMdFj_@jRoUfE`MtSynArWv_Bvg@hjUiTvfCx@pfBe[jlDoWbt@}]rWeKul@cGelCm]p~@}RdgAqNUuJeLoUolBmQjAeOcIys@w~FwUdzJuWxVsD~Z~C~nEsJgeAqH_A_KtYsLdEub@sy@_Nol@K{pO~SkfCd_@{vYuGkzA|YweBpTj@|HdVjS`gE\"],[\"nyzk@aqvfBl]p_AjOyE~IpH`NjtBe@nIcSx@yGxMmPneBerAugCoOfXkR}SkM_@iLsp@lCiYjLoKr_@mB\"],[\"no{k@vuxlA_Ngc@yWfEsThe@qLpDudAcwBoXk_BjGom@hHrWhAqs@sXeyHlPmgGxp@_dBl`@ouE~G}SzInBzSlc@dMwMne@atAvRyjChQ}S`XrDxJb]~I~~GgF~zArJlTxRgBdOcYd[spBkTigG|P_o@rFq~@jEwLpHy@lCpStEoKzBqo@wFsp@wHzL}WnGyJy[}KiyAqZmoA}@oz@~NcOv~@skHxKwCtGjI|ZtjBdY{n@pOu|BfLzXpCuo@`Tma@vCet@dVzPjEbj@rZiAzGz^~Ucy@f\\\\fkA^lp@k]nsC~E~oIkf@~dGaG~UaPypAqr@~{JwHzQ_NrD}Ih`@mEaM}BzMcE|fAeUds@mp@baH}Kq`@{J{KaNtAaLtw@yOvHqNe]cL~{@_HcHwMzjEfWtdAvXyRpG|_@eFvxC{E`Jcm@cH}Xev@\"],[\"xo_k@ui~h@hWie@xVcM|[l`@nN|h@|]htDsIvb@s\\\\qa@yo@{_B}e@qY}K}o@b@gO\"],[\"`n{j@yuti@pzAttARxd@kH}Loh@nh@_p@ax@_C_v@\"],[\"~vjj@p`~@xb@s]jm@mwBlXcYvFnx@iDltBmUveAkf@ho@sU{ZaPhWoGyX\"],[\"pefj@pat@~KiaAc]ubEpScgCvX|^``@liAl]xW~\\\\hGrgAvaD~VjiBaA|g@wa@fZaSu@qOhLkd@yxBec@peEq@qLcTyKwKrBkMdWuRf|Bml@z|CmGxyAo[cH_Fi[xE{fAgNunCnQu~IbT}eA`e@rnC\"],[\"t~aj@qbe@aAgfAjI}b@hS_M|NnQi@ne@yZv_A\"],[\"bm~i@j_sBpYbWzSwFx^uq@zg@vdDsRzYmFwGmnAtjDzHuaBu`@saCu@sm@\"],[\"dz}i@m{}@h[qYt]bEpn@dz@bRdz@pKx`A|DdkDm`@~t@g[nHcRgi@ubA{yFcF{v@\"],[\"j`bj@gdak@tMkKhGrOjEdcAeQ~_DuI|Wm`A`nAg^vMm|@nGin@cx@gGqy@lf@uWvd@{GhM}MbTyd@nKqv@zh@}_@`DoeAvLeG\"],[\"jb|i@oanAk\\\\ms@wJyeBrCgjBtR_aAzn@s\\\\li@tIn_@|c@zPfp@`ArsA{TbnA}i@p}@\"],[\"pd{i@_wiBgOaz@gAaz@lMkw@na@uq@`Vbp@cFf`A\"],[\"|{zi@mkwaB_N{a@nM}_@xKlg@\"],[\"tqxi@moyB|G{nBnP_t@jY?rHnSaDnk@i[r_A\"],[\"dqzi@owiObz@mfAlo@lQhPhSaGrxBhWlfB}c@hyFeMhj@cs@hn@uLaPgXyDgP_WeY{nAuc@waAkP}`AcCypAlS}vBbV}a@\"],[\"b~vi@{pZbNgNxt@oQblAhuBxqAto@tEfTg}@xZsmApdBgu@tIqW{WgKkf@cMu_D\"],[\"vawi@oruE~Q_GI~tBwZjrCoKvU|Bo|E\"],[\"hbvi@}vik@bB{o@tLcGhi@niBnJj~@eTp\\\\iMqZeDak@cm@adA\"],[\"fmpi@gi|HnFy}DpNet@`Tj\\\\t_@jaDbL`Y~\\\\|X`h@cBhcAjq@Jz]qs@rkC{NraC}R||@cd@jTgi@kn@wX_x@u[m{C\"],[\"xrfh@iaf\\\\xLy\\\\tShqAvL~`DmKvjAuq@_m@kKem@`Ay^h]~gA|K{~B_UmtA\"],[\"jqyg@svshAvGg\\\\{Ms_Bjr@~oEyJn_@yQcFeWk]\"],[\"dhwg@{w|hAhCci@nYlt@dK`u@e@pvA_c@cfB\"],[\"xqvg@a`hs@dOotAdjAuyA~KrGjc@nvA`J~kBaBlMmYc_@{e@peDc[li@gh@d]yPoIqJwj@\"],[\"hcvg@qrw\\\\f\\\\_U~Hl[sBlhB~RpoDmYbRo[sM_M}j@lAyyE\"],[\"lxug@{kys@hHcS`@kz@tg@rnB~Evy@aEfv@}a@ts@s_@s~@uDq~B\"],[\"~csg@ob_s@~UqvAnS~a@tDht@cFfu@eQdd@mt@{a@lAmc@\"],[\"nsog@{pd{@wIyRgCcx@x`@_vChj@hdBqN|kAgSl_@\"],[\"d}og@mmmz@fKwJnCbR_OxjEyMtb@_VoVmJwiArT_oB\"],[\"`bqg@khgbB`l@zp@oPbn@e{@ppA}Fl^gVad@oGrNgTwAwJxXoWmEcAoIvrB_vC\"],[\"hrjg@qbf|@xYqA`Fbd@dHab@zMn`@lCvtA_Oh_@_w@cwA}Duc@\"],[\"~big@g|cz@pKac@lRsXzTkHhSvK|Vv~@bIdxHwBb_@gNzIqGfYqx@op@mXkx@iHooAxSq~A\"],[\"xucg@quo|@hEwlBvNu_@jO~GhO~\\\\fGrc@hAbzAeMdlAjIllBcLxd@}j@ibB\"],[\"lgbg@eqmw@x[qlBfUr@nShw@fChyAmLnsAs_@rf@aW}]{Isg@\"],[\"zk|f@udk|@aMkeBc@a_CvSawBbr@emAb\\\\_E`TdR`cAvcDdLv~FgCnZ}EiNcGtTtBtsAwKzj@}f@rQsc@eU}b@{q@\"],[\"~d|f@al`~@vRghAtNuChMly@{E||AqQhYki@sHqc@|Xal@|kE{`@|MoVkQcGcc@t[q}C`b@yz@`T{CjW`QdSaEdVg]\"],[\"|a_i@hthv@gSxRub@mOVag@rMyUnSqEzM~E\"]]},\n" +
+	"{\"countryCode\":\"AR\",\"polygons\":[[\"zv|Ldk_h@aeAmq@ek@cu@wfAxBi@aa@gYyYa`@{|AqWjB_eBca@eN{UhFec@mKcH}g@yzC_[mYiBgP{pDgi@mB}Iv[_oAlRiRv`@uIrOcfAfwAaoArPod@eP}eBhHskA_BapGpXmtB`V{j@nAi`ArPoe@`j@k`@hi@fQjYs\\\\dXdCzgAk_Az}@zNbcAg|@|^tA|d@aIbDsJ_nEqm@{~@mw@{oBmm@}P_UepAc\\\\oSuUyc@yK{AasAv\\\\ml@cWa\\\\aHaa@uA{dNxE_c@fnAaL|f@c`A`VuKvHkT`c@HrI{d@xYyXpI_`@rr@}~@dKuq@rPsBlOcXdJ_Dp\\\\fKjNkd@j[f@`F}JrMvFjmBi~Al_@qQhYCta@}]ff@sdAvi@in@ro@uUjU}_@hE}m@t]s^bCaWpQjEtSwN~l@q~BjYgN~Skc@?kTb^}j@bd@pDti@_j@fk@iZrJuRmEyVfc@}|@DubAlLwWY}b@|GoVnVuIbA}XpQ}^~EqaAlPsXnDkxBkJmcAngCa`Fbg@mf@~Jak@~Zim@vrAamAz`BqsEzqAyvBpm@{pEzvA{tA`@_Nw_@}u@xk@q}@tRer@?_YrQmI|EueAvz@{gAvg@eSnZm^pr@eRhk@ur@pjAvIlLtUlQdF}@b[tTxEhH|e@zWxSxKkT`b@bq@d`@uJ|Z|l@dS_Nrd@~`@tF{[jQdGzo@dgAdWl|@tm@lVwK`Pfc@i[fR~f@lg@o@fs@l[tSiPln@|Rlg@w_@xKbPtVqGQpb@vbAvFnE~WrQeGdGrGy@b_@tLvFfZ{U|f@xbBbOxBpFkHts@`[_KdTzm@pKzCr^hSfV`YQx\\\\w^bk@r@uVky@kLkiD|FkcAeEwaAvj@kbEts@oyB{AknBro@}_BaA}_Am]y|@kIql@~u@grAud@{aBlDsh@v|@at@pSse@{BcSi}@ak@ax@wIcPom@qf@oq@zSkvAr@ox@lh@cWpYe}@eg@sl@{^ow@or@cMap@nQqG{Y_a@mL[}k@qr@}JgU_Qo[krAfLsp@c@gf@aTeKgb@hCoc@qg@{Iot@afAsa@iKiXbFye@_LcNsj@_Ge\\\\|Du}@qx@{iAgTcl@JoWgO{\\\\|N_u@iLyr@jKyS}a@yf@nDal@aPiVhQ}dA`OoIed@uj@nIAca@jUiGnHad@rf@aO{F{GwRtAwNeSeWaEXq[qQq^v[gZeLmF~@k`@y]hNx@a^{Wm]fo@dCzNcNcCeGiX}@zKaPiDwN~FoSzo@qQ_Nac@|i@}SpVhCza@{YviBkCdh@wi@l|@wR~x@uh@jXeBtgAff@vlAeFnNjNpMuEl_@vH|m@y@dWvTnNoOhTbBrAyJlnAmLviA~a@jHfSphAdQdCnFgZbXf]`P}Gba@jVm@_A`Zr{@du@qBlh@sLk@}GdLpbAfa@dQC`DpQxW~NHnKsTnX|X?rJ|MaZxIi@xa@|GnKfUcIpPhz@oTz[df@vUrExNyLpHt\\\\zMxFfs@cHjKgO]~@~FtJ`Mvh@~IhFf`@xeAhHf]~k@kC~z@fl@g_@iC|j@dV`KaPnc@~g@rr@sAt`@b]zMjHzYnUp@lg@xb@?vg@th@v\\\\YvMoMxAsAlMpp@rp@jUfv@~NuBhSsp@vUwM~l@bRvA~KcWfKeKhbAvRpf@lu@gAtRncAtv@lEpMxJlWze@`{@`r@nUj~@f~@hH|TxLhUxi@phAvU`XdjAx^bj@b]zSjYC|o@zV|]vq@|z@t]pdAzqAt{@hj@zi@xl@pKnWzBzt@~U|k@zSrIxqAlIrc@|t@l`Adz@dS`f@jCxg@pm@mEjk@bQr`B`zB|g@bXlWoAhkAmi@v`BsLnW|ExLn{@`JxCpg@g]d\\\\aDvdAhb@~j@cC~l@he@|s@zMb[fl@hRzFhp@gv@|ZoC|zBhj@hW~_Atb@`^v^kZfm@mGhs@lG`_@hP`oAgw@~QdBhnB`iAzgBiDziBcS~k@_Ytp@pLzj@}FdY`c@gItnB|Oj\\\\`I{I`fAvKdh@aLpk@fn@xp@bT~~CdPhl@qN`r@qf@bjAoLfRzCnUcd@fbAvAhToG|s@~q@gBxr@xK`Pvo@kgApj@pb@r\\\\kT|V}@x^sz@|s@{f@fy@acB`Vu_BfV_UjBwy@zp@ceAt_A_sCfrCwiDhwBmjAxYnI`wCx`CtsAlX`mAyOfGoJv|@oWnPkXnYsK~dAivAxi@siBhT\\\\sXqr@?ov@mV`DjEcUvp@{YfmAf@lp@aPp{CsC|n@lLlqCl}Bt{CngBxkAtcArcArxAvsBjnB|q@|Xht@bG|l@uDf]~N|R~ThwAtfD~sArvDdf@plBbFhv@da@d{Anu@hhFx\\\\xkAfw@vbJda@xeB?vx@vtAfaRuCf}ApShnAsJzpDbQhw@_JdaBaOv\\\\|FrgAaZhtBgUph@ex@`g@oMv|B|EhLdInEht@eI}@uVp^]hGwG{Ba\\\\x\\\\dElKrT`q@pWbNsJhi@?je@gr@oGx`@~F|GfJqRtE_nAfRip@r^ug@`N`AdMrQed@z|@{XdS?vz@lPcFzCwZrQiG~^{d@hNo|@jnD|a@rv@t@nIdBxK|ZiEbn@oVzg@|XeLnIdRla@N~oAx^rc@}Olp@xKbKrD~V|r@lc@|Vj_B_n@jT{t@~Pu@pWmYaGeKdk@oG`FjIiCz\\\\aPjRrj@eIqYs{A~Rx\\\\zu@tN|mAds@fhBvsFx@|q@tX``@rj@hrClA|gLuNpu@}l@r}@yJ~z@eYO~Ibl@_D~Wm|@vvCeg@hmCcMneDkHNkIoZx@iJtItAiHgcA_LjD_Jd\\\\_M}PgGjNyI|y@xI`x@~B~D?aj@jHtGzNgPw@x~@|m@njAfnAnZbcCaQjfDot@hn@sj@bwAr[vx@kWlU`@laBrm@xTaBbbAkg@|d@mj@xW{o@rXedCvMyXwGsk@pEs^zKmItMd\\\\pr@zn@nXfHjNgaByM_pA~AmcCwa@o_@gU`DuG{Ge`@hMoFrmCsK|YoP}mBqmAauDwB}~@lb@{c@zy@yXrk@}j@bvDuJ~jAv`@zUcGz^~^bMtg@xc@`gF{bArxAivAq]a^nx@aZrPeU|fAfHd}BrUlz@vf@hj@lSvwAzx@pl@vVsLzt@_aD~`@wx@~I_h@qB}n@jWqj@cOqhA~QqGbQhOtc@riAfp@neEnx@tnBnd@fv@b{@fCxvBtrCnn@ra@bi@^f]mOd`@pFnh@wYzg@cA`FcZ~FpMnWeEbDqa@l]~^f`@mMtIji@n[hO~WoAbS}Ux^cEnH_VnPyAv[~i@rK@sA`NfSpLjEmKpMd@a@kRxMaAhInXiNnB|Cr\\\\bNmFdF`SzLcVnFvFaExG~IlFcNrTfChSfNQrJbr@tPfUgBrKxN|Zvh@z_@ph@hDdDfMvd@iFlKu^tBkx@pUe_@nXns@vToLG~VzKgKhKnXy@`YjJlBmW~d@lIfh@eVbN`Bb]pRi@xDh]o[bq@kE~qBlg@vq@{Gpi@b[xzAzK`PhMZzIak@bKpJkQpfAbPFtCe[zJyKhMZ]|yAbLr{B`Lvd@jy@tsAvnB`bB~s@zbAl[fNf\\\\e@bGaKpI~Px^L~V`x@fVhJnk@`m@pNcHrTj[raAtYdg@XlsC_r@xfBqhAbc@eu@jJcq@v\\\\ef@vHwf@heAo{@rB{SppBkdCzZoaBoEglAxKig@tPcuCsMaiC~Qo|@|YcThg@a_A`KpEvhAoY~gBdMlpCtcAu@psB~\\\\tf@~k@lnBvA`gAjDav@uZ}`Bwb@ckASojArGwIzz@oRdSix@~MzS|FuS~Gtm@sJnEoFx_@rDjPpWjNlEwLnNhCrRtRpRwg@~HmB`KdKnAjPoJtO|MptAve@x^vMfp@dTpOlLp_@pb@xVtRuGo@dbAbHbLbUUpGjStIt|ArZv|@rl@nbA|XjDtLhjAxe@rnAzJ`M~]rDfQfWf\\\\pGrt@r`CvdAf}Azw@|WnMrU|Nr@bMyNdJbX~_@xNdOpb@fQqPhFxC~Jt[`LoFtJxPfBjb@|EaaAuJaPo`AuToKoOdJeQhc@hAt`@dYfaBf\\\\jyC|RplAre@t]rWnYpe@nw@lpBtOpy@fAjjBoh@`q@gk@hX}Wnc@cqBdrA{Qpt@zWgn@vw@ui@hj@qJnXt{@uBr|Bf[huAfAq|@{Qol@nGcjBgNqu@j[kg@fg@uN|i@}xAxKrFtRnn@rw@pyDu@dR~PbPdG`r@zLxApn@zy@`qAj~@tZbA~tAxj@j`AeMnb@|D|fAzbCxg@hf@qi@skAoVejA|rCwf@rqDyvAdd@U`F~JeDbh@~X~k@e[|eBw@`y@rMtkA`[`hAkSigCPyy@zXg{Al[te@zBmKgl@iiBrBsa@|NoV|eAef@vxCqtBf{DufEpS}@f_@bl@ww@jKuUngBwKf{Bag@|sBuX|~BmBriDemAdcHyI`ke@eKt\\\\sm@mI}^vS}`AfcB}\\\\bbBqx@zQ}HzKuCno@qMbL}n@y|@gUH_^qP{o@}@ci@qe@wPnHcb@rbAga@~L_j@gh@{Cg[yi@ePwlAo@ey@ls@}y@{Zw\\\\~}B|g@`eARxZc_@fy@j^|zA~w@~{AvFzu@}KzVqbA`H_t@pj@ujC~L}v@ve@g_AdrB}OxEcRgE_m@a`@gRnL}[hl@uVgRi\\\\sCyd@uk@}Oh@oo@ufDsnAutAmp@m@es@he@smAeLgDrg@}JzKc^mF_a@ag@sn@{Pui@aj@yVuu@oJcwAkfAodB{PqOgj@}GgaAzBeaA`Rec@uVi_@yxAw`@qe@Tej@{GoC{\\\\`Rmm@aI_wA|S{Fb[c]nR{Ujw@kc@pTqfBcu@wzAqeA}_AuM}M~]uMyDoUsl@hBkp@_GcWijAwqAyIoDu`@xHmJgHbVshA{F{RyN{Iy`@vEkq@|pAiKgBiZgt@g^va@{y@kTeXtGaH_IyEo}@q|@yzAk_AdDs]kHuyArk@y{AbFsx@r}A_IkEyM}rAywAuaBau@n`@kUzx@ke@}Dgf@~_@sTiLw\\\\d@u\\\\wWuL|LoWc_@{GmtByK}U{H{Emm@zQoViiAqk@uo@eSpBoc@x[mt@llAmhAni@iP|SwA`fA}[`q@jKxaAuYfjAm@xf@siAnHwLyFwBwPrUwwB{Umx@~NeqAeVyrAr]{hAhAuq@y\\\\gd@aw@n@}^_P}LkNoA{XsRsNw{@p]kN`WiOnmC`@boFiObWeJfA_Os`@wJ_C{ZjPsg@uO{{@n`@iZi{@e`A{x@}SaEaq@~i@cJtSsb@gCzDhYeMxLaS_I_Ywy@kr@dBgc@~t@iBtl@_HxCeOiMoUjE}J|t@oImJyTrA_N}Ps[sEuOk}Auj@jCaUeH}P~OsPhx@kPhwBof@xm@eZ~JisAkUayAjD_g@rKwReEuRnIef@cOm]cs@s\\\\nUqg@aHyJvD_f@zf@gw@wm@a`@{JoKyQJeSf_@q`@mSuWcNodAqU_Vo_@`Am[tXo\\\\}Byk@fK{q@hf@ck@pFaf@pb@yQ@wa@ml@aa@vTqn@eDcj@bNaiAcRkj@~HeGiJ{u@yI}p@pG}d@eIcv@`d@apA`[_`Aa_A_VkBuQxGosA{g@hC}h@y{@qa@gU|F~C~a@_u@tq@wy@aLoM|E_Io\\\\xL}r@{V_Ga_@~Dg}@}f@wn@ni@_bArQ_`@wK}PxLcZa@sSkNb]_l@_@c|@iGiIqU{CyS|[q|@eq@w^fHqXeb@aNcDgr@gEasCrPkv@}Qud@ajAcWkZ_G_s@s_@g`AJs_AoXeUkHiUe]~Dsc@s[qb@|Esi@r~@uTpM_vBt[uh@{Ekf@fH_TaIwpAbq@iqCz{@ih@rBwa@if@iXrGko@_N{kAbo@w^xEuo@}c@}q@oCuQk^aYp^yc@fI_R|^|@o[_NcOoQzSoWmEgZvUcp@iZel@{BaFsHlKqb@wDwHwjAdFir@_OiFubAci@_P_B{IrS_s@wJkv@uTgEo[vMyi@qLok@iaAc_@kMvKmr@aFw\\\\oy@kg@sP_Awl@`QqZaQ{DdVcUKg`@if@e`Bbg@}~AiGe[hVar@lO}LcZqNbFiJdUyHdt@eWpJo^oUsYu`BkdCqS}_Bco@{_@vWsJ_ZoRaLadAgNi\\\\od@{w@m`@oSe\\\\clAgEyLwi@tHoo@a_@ur@iy@tZa`B{I{ObEg@nZus@uFe}@rIm[}`@ag@bUun@aMoPtBwNiVsm@sEeZwUaUgCew@jO{MhMePxi@|A`Uf_@`ZzAhOqd@lRkj@vi@so@yIk`@zJyQ}LwH_\\\\qgAmYi`@lu@at@vi@wpAzNyTqEiAqSqOrKwb@x@yq@xs@am@y@oRvO{Jz_@{`AxMuTmEiu@t`@eM{HfIkv@k`AcXqd@~i@oFzfA{RjUmXlKoh@l@eeBt_AysAwEej@eNuc@|GwW{Lox@yEmn@ab@PyRjZyFjD{OubA_j@gBwl@m|@jl@{tAiK_n@aZy_@CwaByc@en@wl@ym@lQwEqO~Y}gA}L_h@i[y_@y}@kLuQaYgVgGye@`[oExx@qInCyz@w_@_jBwFqu@lGs_@pZwr@}AshChm@_h@e@uK_E_Pw^gUvD_\\\\ei@oN}EuGqh@cRiXgbBU_zAwj@qjAzKef@qR_ViZsgAwBkd@cKopAixAej@gKqE}GlN}a@amBybAcVkDa]seAsfCeh@{Vi]_b@hIqv@yf@yw@_Fwg@wt@s}@e]k{@uFyo@ib@oGyz@xZej@fFwf@sa@yPaRsW}RetB{zAuNcxEndDiy@tNc_BqKslAa`B}TyEirF|v@mx@`e@_n@zDoHsGue@|\\\\am@|@k}Cgw@{VgYKwm@kLkSoKNmr@pn@k\\\\yBiVxRaQnAq[hr@m`@fMgWoBahBao@dPoZooAs`@m@ik@oeAmr@cmEcgOwNe^sQcM}oRsjE\"],[\"z~n`@x{{h@ku@qFnp@cm@\"],[\"j~l`@dt{h@szh@}GnMcYjjBqaBjo@}gAzi@k[dkAcX`I|Ao_Ad^Nfj@f`A|iB`\\\\hTxTnDxU_G~c@c}@`Ma`BaCwaAxQuo@d_@{TpTxBjy@wTxo@ag@jkBunDtQ|ByAk]pUw~@~EsDtLbWdWeIreCelG~mA{hF`Wqg@jc@y\\\\fz@adBhNiEnpBwmKjHizA_J_fEkJ_k@tKsgB`Zj_@j`@`RdL_HfO`Y|QaDnRdf@ne@jO`GtOWdT{RnDwDbe@hEdMfK_DvJ|LVbgAlPhK`ElTsMtXmWlDoCpyCjDjLfQ_VhSrYlMhlAI~{BpE`a@dS?zGlp@aLboBiv@|jBkVrmCuYbrPgI~yAyLv[kBrz@oO|r@j^hObE`wBkGtZ\"],[\"d}k`@fy~e@kHef@jHoGj_@lc@xSf{@kA~MgVmPkIlIjNjYIde@hM~MxIhhArHtKsFjO}\\\\}JJdd@bh@vrAr@~_@eLoGn@|L`FtJrCcG`c@`eA}@zg@iTgLkThw@yJcB_Zck@lPsp@gIuq@wEcJo]tg@aCgPvTwZcCww@jXeMgCqGcb@~AsHgFdIcXNe}@|McVsRwP|GsR}GiTvTaP{T}}AxCuS\"],[\"lk{V~bxd@|MuOx]wJlQbCib@`bBmd@~c@_UnFvQs`BtTaM\"],[\"rdyVtr{d@hFbHaH|QoJaF`Aqp@vDmVdScUzIxY\"],[\"zpsW`~|d@~@ie@lo@}g@lQtDxp@lk@kCz_@y\\\\vAfGjP}FjZ_\\\\}Viv@kO\"],[\"txnWjg|d@zH_p@|PeOfhB`CqdB~dA\"],[\"~zj]tzyk@ai@nm@}HhCki@yNyi@xh@}]k[k\\\\hDcIuEcXam@_k@vAmGyjEiJiA_G}YviAxKds@ie@lp@l@rnAttA\"]]},\n" +
+	"{\"countryCode\":\"AS\",\"polygons\":[[\"nruGjzbgB{B{M~JrItC|m@zv@xv@qa@rr@qNsYiB}h@}PkM\"],[\"nxtG|ylfBrCmKrN|@`@z\\\\hGzDDpJkTlK\"],[\"`zzG~kseBwDdFmA_ET{@\"],[\"hzsGr~pfBh@kXpFhN\"],[\"xzvEnglgBnGlDeHpA\"]]},\n" +
+	"{\"countryCode\":\"AT\",\"polygons\":[[\"sht\\\\s}iIhg@e@~q@fr@xx@vKjO{b@nMdAzNkJ`JqVzdAgP|Q_h@zSmSfVdLdD_IfIjB|Qcp@`Udf@~GiEvMnL|DkGrXlKlJtf@x^e_@`FjHxj@gE`MoNpO|jB}AvVkO`HjV|_@oE|h@iZhXkNhkA~E|UbVjJbNnc@mDrHfQpRpW_jCpUgO`KnBzPaWpPhFlIjXdXqAzd@jbBeKxNjHbMf_@~Dvi@o]`Tj_@|a@jDhEcQpR`DmAwf@rFsFza@na@jSiWrNxi@pByYjKwJvDfk@iH|vAnf@z^vl@bfAbXfoA|s@~Ex]qZjMi@mLpQeSdtAlLfkAcH|x@|UrDrDrq@dZvTrGh]kRrm@oAxjE~F~b@xUfV_L~SnK~r@`z@re@xGhp@~SpQhNdw@za@b^{WxVlA`_@qOla@PtxCuZl}@p@hi@yS~jAeA~uBa[dwBbFhyCoSzs@kNpdE{Spw@wDjwBuV|wAeLrTu`@hPuM~l@up@p@oXhvA}JgHsg@`Rud@wx@oOKmBvM|ZnfB~FdgAtb@zeBiL|^iDpu@pF`l@wJz_@zUzd@oIz{@rM`vAd_@rr@|{@lWr[|a@bB`nAaIrU{BpdAoDvBcN{RiE`EmTlx@zIncBgJhScl@}Aid@dt@~Rhd@~XfJKzd@hb@`ThKhw@eq@rrCwF|F{c@cDmIzFmX`uBM|u@e^qTa]dToFpQgRwEsR`MuGeCkKdOyzAeoAoYO{Pbh@s`@bVlFwoAed@on@}BkQpFeOpTeBR_rAjd@}UkC_Xzd@gc@`MbPr[qQkG_nAjm@pJ~OhRcCup@iNqh@wg@ah@{Pmb@wYyMcTvAwKnPw]cFgRnIoAkHk@mPrSfAhGcQz@_a@kSg\\\\p]umBuIc|@zH_TrLjX|CgC|_@k~@hMxA|JcNRc`AsWycAtBkL~Oj@b@_N{X{i@}D}o@yI}AmClM_Vm\\\\wCsuAmb@uVaKsR~G{{B}MyReFms@nBqsCiXwSuO`@}SxPkO_h@pUxJrIsH}F{rB`U{KbPm_@{[y`BbDacA|IlEnVme@tD`P|\\\\jCxq@m~AtC}j@{OcUk]vDoYkE_WaTeV?k`@p_@tC|a@iJ~k@glA_}@so@pd@qVdj@a`@rR_d@xp@yNtG{K_Don@ohA}F_k@}`@k}@eZ{uCmj@cqAkvAo`@{Z|JwFaH_Fih@~Jy_AhZqx@yV{I{DgXqYqPuf@\\\\wK_IiKxS}^aSld@cgBh[w_@pLxP|JsD|Pgd@tSs{CiP_q@qb@_`@pTgn@{Fwd@bLyEg@oQhOqXiGqKgs@aDy^wk@y_@sNpG_vA}IeFaMbGkr@yQeu@fAsU}IhHa`Bta@yAiGc{@mUyUdY{lB|t@ioCsGwtAtKgHfE}_@pDjE_@{MtPcStf@__BvHqiDcEuO{b@u^}Eks@`PglAvZ_SlNklAcEim@vLyMjh@sG\"]]},\n" +
+	"{\"countryCode\":\"AU\",\"polygons\":[[\"nhhR}`hoAum@|uBab@jbCtp@twAr`@d~BkTtjKvKxo@cBnsA|h@noMh_@|fD`uAvkD~sCvmKhWz{Cne@b|BtOhTve@`wC~QhrC}@`dD|HndAcTz}Dm^rsB~\\\\xaAmCnk@fd@~tAreC|nEd`@t{BddAzuCrN`{@h{@n|@fTli@rUfmAp]|zEft@h`BphApaAd|@rNz}@je@ddCza@~K`e@|XzPxs@jaAvw@jPnVrp@hTvOzC`b@|`@`XuCxSkR~SyDvn@zFxWzr@bo@jM~dAs`ArXiOzPcJte@|Y|pB}Obg@jJvhB~HnShOgD|LdMqP`W~GjK{Q`~AlZhr@hf@fE_QbXbP|Q_@zx@}AdLo^cSil@vRgk@xi@`D|i@tXbc@~Olt@}Wf{@~J`j@qb@tz@uAl{B`Mx`AzQxe@{N|i@lPlnA}Gh_AtQho@jCrmCjZvTbVdrA}ThgAuCf}@dLbp@_Phe@P`V~U`m@zLlkAtf@hn@|Udw@fJdPnIeBvLz[|h@vStQ`Ynm@fPf[_HtNcn@`Lzp@{QdVwAdT`Y~Rl\\\\sNrUlV}Y|QwBdSzK|Rva@pDhEbRkTdQwAf`@gLdRqOrrB`GfT{Bhc@z\\\\{\\\\nJb|@nWbf@dXeBdBqNfSfSb`AloDtt@na@|m@{ElEvrAjTv_AxVaSvSaCqQfy@vOtp@_ChaA|Mdt@~PeDlLe[_XyFz\\\\s^[}]vHfBpGx\\\\?hc@w[lq@uNlmAfT|b@~b@nCajAjmDnHjI}NtqArBpK|]nRsWzu@nBh^wHx_@|Xdl@s\\\\jwAxKrC?`k@zLxKcQd`@yKxiA__@xj@oIhKyCyCzGmjAar@rgAvBhHfXmOlGdMgp@~mD{@phAkT}@e}@bh@adBliB}y@frAmRtt@kPlJet@xfC?zaAle@rg@_iAvbAcr@Hiq@hWmjCbEcp@aPu|@xRyg@m\\\\}z@rH`Iwb@pp@ul@pPmn@vB_v@aKys@kUyh@}r@{r@qt@oc@eiAq^yEin@oOgDoo@rFbz@lRecD{CabF`m@ij@}Psp@qk@xWtC|a@l`@d]B`fAc_@bEkMgW{@ueAjc@nDcw@{T{KqM|BaG`TeQfH}hAmRu]pVwUmPkd@xe@sDye@gp@cLkdAdPwzA_H_`BzLolAvXuvE`lCmbEnwAaeApm@e_@~b@et@xJihCf~@om@tB}nAt[wb@sGq]|\\\\ui@oGufAt[koAqNgl@nKmjBZknBmSagFdtAsyBtuBcm@n]m`@lO_ViJwx@pBmrAvc@{jArbAwiBneAqVvg@st@hp@owCpi@og@}AycAyXkuCjj@kqAve@iwF`zCovCrkBkaDvlDsJfHsc@a@s|@fa@cv@xu@mFyApTm]|Ea]x]aJ{_AkIsm@pCkd@aLzlDsLz_A}WpAgImbAjRug@_N_dA}@yb@xMiQwB~\\\\wNjgAmDiLgIpAcWvX\\\\vlBg`@x_AhIsE_LofAiJqYdIcWyAcCeIlKkGvg@aJ~cCsAoFuPsTyAcRyN}\\\\]vXaK`hAvHx@{Sl_@sCXmI_XkDuOko@rGaDe@_SmU]{Xgb@wb@_Koh@hI{a@kFi~@l_AkK~{@sVpAwZlRyYrVoCdTu{AfKmLfWih@hCgmA|w@}l@c@{yA{x@hx@oBxIqFrGc_@|k@yM~n@_i@bH{_@nk@lBrJuTfKgAjRzNr]iA~dBpTr]wM`Eci@ct@}_@_p@eR{|@dN`Sma@hxAfArKiYr_@zFnvAqT~c@e_@p[w\\\\`FuT{`@{_@kJ{^yc@{OmjApYaqAiUqt@tXdJuSqCs]ye@nTwg@cQuKzAs_@p_Aom@hOwi@|p@io@`T{iAnu@wlDvmAsh@|cAaeA|[ad@cLoV|OcAdQ}e@jBuTyHei@jIeyB~eBmiA`]{eBxBip@_]ojAtJuc@mXgq@wA}b@oYav@eNeMqSihAc[a|@irAij@oRspAqG_fBjTeZgEqLkRq[{LojApAswAv\\\\q\\\\z[qGda@}c@gG{w@zNi|@_t@yo@cIek@eb@_oDwy@k{@s]wfAov@mYacAtCeP~JkAbNtMvn@nN||Cj^j}@i\\\\xa@dHpBeHkYmMjM}MxVvC`Zx[pW_DtWhHhZiVDwRs`@kYkNmg@p^w^uHoQejAqNu}Ain@kT?kD{Q_z@iNog@tOiDwCt[m\\\\alAqm@_n@uLm|AmoDkFaYzE}VeXwp@vFkIoh@eiA_|@c~C_oBe{Ac{@mwBcdAyc@cb@}c@sv@geC{OcMa{@{LdIey@uTsc@sGu_AcTea@qQ}G}Hsh@mn@eeAgdAa|@qGzBvAaa@rEtNpd@|Dn\\\\xOha@wk@sGih@{n@awAlGg[gW_\\\\dGaH`UfD|PeLtW_x@dHyq@kLwbBs]yv@~HuS_IwPfHsEoGme@_Ym_Ak_@sWgE{W}e@yLmtAkbCgCsVxL_CDiUoUex@nOa\\\\{_@msCzGen@uRcb@_Fii@omBu~As`@{HiX_r@_ZqWlXeh@wXe@qCeQda@cwCqGaPh`@ud@|Fqh@ua@ym@eC}_@aRXqEkJ|@mi@{c@ooGyWkpA{pCemJseAukBcgBoqBypD}`CcaD_lAg\\\\iAy^rSoD}~@}[m`@a_@qBuN~YaUmY}r@eVwE}FzJwY}BkZ{p@{dAmg@cXoOkd@si@ie@lGqGaYqMc\\\\w_AkQkMyb@{Lyf@hDwIjIyLvz@tV~b@yKdL{h@yV}`A`Hiz@oF_{Aze@{iC{Beq@cMekAgbAgJjM_SeKygAmtA}L{VlR@sEcPw^jBKwSlTuMtI{g@icA`j@ir@eb@sCwJ|MgGpAwP}XogAbL{e@_o@xm@q|@}FaWuNuAm`@y]s]g{@sQ}Xow@bDqGdXtLbRod@tI|Av@xf@`SpFxMyF|^bRd[aL{EeXxo@sLsDkNzPuTcOyQzr@pV~Vgb@haAvCnHiXpt@{n@hk@aFfxAi{@nu@kK~P_\\\\jt@}h@hYkEl\\\\mYpn@oIiNyIua@Fii@xOkt@gCg]cPc|@cJqFaR{JSuw@lg@}^dBcc@yKtEce@hg@cNqGcJdKcUnd@uVrQk^`a@qWfBy_@kIOiUtd@_eB~c@mAkMjQk[aLcC{NpOoS\\\\_^kYcQq`@sW{HuPtLvc@~PxRxcAsFfNktAjd@{n@leAcFpt@uVeb@sLrF}Qma@_JAyGhn@vTgEEjRgn@dv@vDui@yUtO`Yqo@xKk}@yKna@cLgh@yn@n{AlA_ZeI}AbS_z@f@qf@ug@`q@jIaq@y\\\\?uBp`@sTfWvOzIea@xe@uAcPqJyD{R~LaTcIYwSiKfD?qlAgKcBfa@wr@fIn@}@nVbLcLpBiXhHhHbGnq@v]gk@|J}c@nXeABhRle@kPqG}BpGwK{w@k@lWa~@us@zXeHsTke@|m@zR_iAxGkDnDev@nNsXxo@cSxKqMvFsiAob@oZhYuu@~CiiA_IcmBpS{TtCoUqMeg@v@nf@_e@txAcJd~@fL|iAqJvm@e\\\\jJqZmGwMnJe]aIcGkR{z@oDt@uR`a@iCje@y\\\\e]mOiJkMbBqOymAvFiFcSap@|BsSqSsAqMdKoJud@iNyG}MiHr`@hHfj@xvBblA{\\\\iCslAda@rMCtNnTc{BjPiFqAhFcIsXuCjTqG}\\\\}D{IrDlApLm]aCgTvOlEe]qEkMgb@uNWqRrWem@e@e_@uv@qEfb@wG^yPoIuDaHvJgNDeOwEwTy]kNbPbKfUmPt@mA{LyLmDaCjT}X?|GmT}GwEbNgCtEsXv\\\\tIsXoc@hOoVpUlGgCme@pGaVr[eVtHkUpa@nG}GuVjPgElC{Lk]mFri@elAy|@leAwk@uIkTea@wAtQsMfJjKtBK~ImMvTnc@fjA{YfSei@sWmLu[lGmU{c@q]qG~X|SjEkK|MlPrIrd@~x@uOgCzBxK_g@qGWhUuM{CyWea@zSsn@m^aXxHeDeWkEqQdLme@jEzK_YaMaRnNyEbWlLgCsCtTgCeRaM`EyNdRfC~x@g]w[wEev@|QkIeKtSqCrPub@cCqIpm@aYrFiXeIuP~Fgf@aFsFuLjCiD`_@eLtAeEeLwN`NoYkf@`Bl[sQ~e@xDlXwInCoKuGzGjT_JpHqC`_@eVcQiCoV~IcERqO{Nh@aEgL_QvXtAtTyHdR{g@lGSjPqYpNgD}X}PsAh@qQoS`CdAsa@eJy@{JyV_A|e@yIxBcWsSo@q]qm@qSzk@uC`VvFsi@{\\\\f}@q]}]}@hAqIcXqGoPhCxKcPca@?fCie@z\\\\~O~CkF_D_^_yAoPm^~P{t@eD}J|Ei@oNmK{C~SkOiCqGrXi]yKsOff@|LK~_@pkBkO`x@p\\\\zUMwvAoi@iy@uGq@iG|[aFxNzE{Fs]eM}HnNmCcFuPzDgItOlS~T{HhKzEtBoHtWrAf`@et@qXkCcPhLy^iL|@qXvM{IsA_HuQy@nDgOaFcHy[~Dcr@d_@gRmq@ia@kHed@`GgXcr@s_@vAyD_FgWtIsHbPYx}@ob@bTkRsChUsQn@}MyVS}ByFfYuOS}Lya@pEq\\\\zi@`EwYoYmObD{IjPrFaAod@hTfLpGgL|\\\\rXoe@ucAda@ba@dAqOvNsLzDd[vU~@mA}[jJ[|SxS}BiKlSpD~No^jPhHjEeLcHoTlLeCsVwLwCmTwWbOkD~Na^{LuGcPoGzGkIa]oVeG_UcYxLuVkf@}b@bGaYlLlYhfA~l@}Aom@hXfTfTkPgCy\\\\ba@?xTmTea@qCsRuXuo@?`K}ObUqG}\\\\sXwe@qGbL~OoNzJuMwBfCcXyK}G|Fqi@}FgGyKpS?o\\\\eOz]iqAxd@uM}h@nZoDzBiKyKkTcQfRaOuIzKk^}BuJ}GbPiK}\\\\z`@}h@`]WyFkQhO\\\\cFeL`d@hHeAsQ`j@}LhH}Ly_@yYwFfG}LsBhLyLt\\\\aEyb@{Wfj@}z@qd@n@ve@ui@eLmK`[wQ~j@a@tL}Q_LoDnCiHneAcdBbWkIji@yl@lr@aTpGgTza@aAhC{GmOgEhBuKrI_D~NbNtJu@aEiQvc@gi@zEpHhQ{CyFic@zWk]rSwFhIc_@f]}b@h@sZ`JkF`OdAiCzY~x@sB~Mll@nDgKjS|AbcCdg@ni@aIn_ApDxGrSjeAoQzr@zb@rD{DsWed@iS}I}v@uIw`AbJ{\\\\kNxo@a}@nwAog@mdAq@ct@vk@k[oBu_AvQgy@qu@sc@_RtqA{UpDgHye@?va@e`@z@cSuX_EehAr`@sTsSa\\\\r^q^_yAtNoc@dKskA`Q}TpGajBxg@aqBrXgJrCuTpPaQzn@fDxc@yMlaAb\\\\c@cKaa@oPl@kLhi@pColAoQsBaKkhDjTfO{QxNoAgOkOB_UxYi]~Py_Ajm@wUn[cd@tf@cOz_@bGwTud@dAiI~d@qHoBc^}M[_y@xn@}rAbX}\\\\qPwe@yD~PitAaMoq@wa@aj@`Mk\\\\qq@yEqHxEt[|O~f@jwBoa@kTdOld@mBzNyR`Y}QeA__@rh@ic@tJ_J}oAcg@{k@hZhkAyQnqA{\\\\ld@?zKe_@vVgVtc@up@gH}ZyUq_@eF_v@el@{x@zE?{\\\\jQoDcBuXjIiBuOeK_b@pEcGeFpRcAfCuMiKat@{MiJod@oGgg@vS{`Am}@uJrF|GxKer@m@ey@eYal@mEqIkR}a@cMxDqHfg@aDzJ{`@eOmm@qa@oj@jFgLwtAcjAcB{JfP}c@uVlJyQtk@yaAfr@iCrVakCoCsW}^tUmM|AcLqUmy@uf@wSoiBq@vDu[gQcA{NwZbPqi@{TdLeMkFneAwp@kDqaAqS|p@fCzGy[~ViNub@aKbEy@hQeo@cOs_@hFuHmPqUfY_Jef@bRaa@fLev@jTrElgAmIy\\\\cL~~@qv@uOzBqHuH`Qcd@mPvEca@vk@cRvFHy[pMm]cR`IeNl]oRbLdDnWiFxJm\\\\?uCgTsN~@uUi_@|XayAkj@pNwj@^wY|PsZwQtKk_@eLqPtU}RmDqNjg@mj@k@sNuTdLgReLy_AyHoMoMzlBu^xj@a~@qB}pAxFeTkOgjBaa@keA~\\\\_VvYqlA{s@yeAqGe[{\\\\mKuAmH|k@{OsOii@~GeTvu@aYpYhTjT?}p@ue@_j@pO}n@mIqf@wlAf[{fAcAcp@cFb_@ga@xk@if@tCi`BwNwQ`FkT|\\\\ypAc_AqY|gAgPvOmQgCyN`T}JsBy@bK_RbIwXlmApOrc@kA`p@pRbVgC~IpXxk@kWxf@wSnFkI_PyHxI`Cj^{NdDgBnTqHE}FsRoHbA?f]_Rj@qHba@fBp_AuKiV}OnHvGw]wG_Dse@jP~J{]o]wGrp@cc@uNyYuc@bHpDiJq\\\\Tpf@sx@vUaDqK{P~kAnChFyKeX_Gxq@mFpL{LlAgQ{c@vHzFcWgNmDqUp\\\\au@q@gd@`ZoKKoGgGrD{Ozf@}_@jd@oMrFcLmaAxCGiY{WsMxx@iXzi@nB?iO{`@wIgI}MnLsQ|n@oY`P?sC{Khn@gn@v~@}d@Cgf@kg@}^aOu[kJL~Joe@m\\\\oAaTtR}BgZb~@q}@~e@cTdHmR|^b@rxA{x@vN_z@mDqLmSuD?ea@vDaJfVv[pNiB\\\\m]gVwT|JkH|MpI~Mwl@aYo`@jJ}Ep|@|Eca@mOeGuR~TcSmFwS__@}O?_j@qa@a_@hNwr@uIaRfUoMbXhZuBvNbHxMlNrFxM_Epc@a}@gSgg@cUiJU_RfV_Qt[m@g]y^b_@wMnI}S|GWdHxP|XuLjc@lAid@_l@qXiDwCuJpm@w{@hHg\\\\fCgy@kGyd@jGeF{_AcdAaG{f@r\\\\wBfZoOO_Ulv@qNdKgaA|e@wVd_@ug@aIco@rb@_k@wDsKsIzC_\\\\ld@yBcv@`VgZiGsUcKaFnBxZkXjr@oVww@uGoEeFbLmXsf@cAm]pLzBxCc_@uHa[qSoEy@}KlJPfJ{_@aq@qlAsEdEvHlh@hTda@aSpHwd@uOsEoQlFqSaPaQn@sa@yLmUeg@uj@wD{QiLiFcc@rJwC_SbJwUpl@zSv~@rx@|^|y@wTs{BhV|u@nwAvbB|ItJdWg@rbA{p@mXmKqGmG`CgLc\\\\wK{g@wl@UyHzRoIgUwLeAwSgp@qt@hW~I|cAx}@z^mWgSqt@fSs@xDjMtv@da@zQcDcEyKpr@qK_WaVtKoK_Gmc@rOiNyKmp@yN{RsbBsp@}SPiL|]~Dbp@cKbEoAd[sIz@uIw\\\\_v@yc@wH_`@kbAofA]oe@wZmd@vKsBfSd^vD|\\\\nPdEtKcKmGgZxt@wOpAqOxw@oAxv@sl@~J{OsIga@oMyKoIdG_Un_@xGjLuK_CmK}m@z]}f@|Dg_@~g@q^nd@QeA}a@zOpKhIha@f~A|tAat@mT{ExH|Q~]~k@yApOkInAlQrVbRna@vL~OiJfHnCyE~e@xF`Krx@gHnMxFqCfNso@zO|D~R`^eBPxKcWjSnCvC`o@iJpQ{UmDaKtXDSaMzYcj@nX{K`JlBkRd^xRvKo@fI}\\\\hEbFnc@nS]rE{MxRqF|CsSnZjP``@`DoFhJrM~Rln@pDbDhHkTlG~N|Ztp@jb@mGzKuOeMmSnC|BoPeQeMiRfL}oA{AqJdWpZ|P|QoI|XzFo@zTeR`N`LtI~[gGxCfPbPvEdAnQ`PvJab@lKmTmJm^zDzUfZrk@hJAfSbr@?an@lMeLxLhIfR~a@|K}RvGpj@bd@|X?jEmVnVa@rEg\\\\tM~AhH|ZgTrWdObOjs@eHvd@jNrh@}W_GwRdLgC~FjQ`OjCxNeHbAyi@}u@ei@gCiOlH_HpsArl@~M{Clp@hLpc@ti@frCpG}@zWxl@`dAddBbx@tAzYbLpHhoAdo@~j@`EhT~i@zThKeDza@pEhEtImMfm@}FxrA_h@lk@}q@|Oag@kAmV~k@qg@R{Xzd@w~@fv@uw@byAqiD|e@mSjv@}H~`@_n@vCqi@|{@aeAaDih@jZ{IdbAgdAb`@lJ~K{Er@qJsVcTE}JfDsr@bO}a@sWwAmRwe@hc@w`@l|@w~Bn~@idAjDm_@nJkDAmQ`PkTwAug@`q@gyBrrBojAr`AurAbk@}d@xs@wkAh]scA?mp@l]uiAsHo_@LieAfQ_Pve@m`BhN_hBrKcQve@qNra@}z@vS|It^Sfu@eMnr@g[ze@mg@bPweB|`Bo}BpUieB``@kjA~s@km@zDuhAmG}kA{k@uoDcbAsfBeCe[c]kTwOao@gz@uV_kAmLwt@wZog@{Eya@bGupAu_Asu@sSgCkTmw@cr@ipAuTqIsEpAoImg@mRm`@aFwW~GiYcQsyAkT}~A_p@wqAl`@mVkP{vCkTgiBs\\\\wvBes@{i@mEmo@ic@u\\\\z@BiMqr@gCqrAje@euFrr@gs@iFmyBii@cw@r@wfB|eA{r@vIwv@}Ba_Ca`@ykCi`A?_YaJpFso@kSaUhD{q@vd@qnAvK_LxKsx@y\\\\~GcGsDqIiq@iy@g}Aka@YoEbUz@}LwJ`W{[jmAoFtg@{Zee@Lmw@lSkYk_A_Bzr@sVjZkJjc@mj@ufAHqUeK~YdKzReBl~@eb@z]yKba@g]__@iCtt@np@?xIuX|Ip}@eLxDo_EanAdA{x@uTxKbKhMaG`Gs~Aw\\\\u]{Q{WaXiFgYxUlH|fAkLui@uZhTic@eR{Eqa@|XkE`Wup@jBct@iKg`@uY_`@ImdFyjAk~@a@ot@eOi|BdDyKuMyP_|Awq@qo@ux@sSqIeQjAeYaPuXxKcArZmh@nt@lh@pGjT`{@pG}L}Vqa@}OiByMzIqTh]wDt@qNnj@iCsSsT`BuXryBqi@|[fItd@wCzQqWpm@wRlz@|^bLqJhh@f@fXuKxoCwAv{@epA}R_eArd@mk@uC}_@jGcL|Gd[`gBnhAjoBtDnGkO}@kl@~]ao@bQwJ`hAoMbYc{@xUwYxiA`a@h{AxKkPyKrHeToIsNfByc@yMc\\\\nv@d]nS_IljAjD`lAmNbqARht@ig@fs@zD|NcI~m@zSf}AnTfsAsi@te@cGbi@on@`yByKlwBym@pg@uf@`K{p@lNoHsc@wmAup@_z@wSoJio@kBsF{HxKqGoGyK~XyKpGy\\\\}e@mtAgv@w[xE{Pnx@sj@xVlFhe@wCeN}_@|UGvUbLda@eA`v@oe@{@kp@v\\\\aXrG_`Azb@iVpe@yBjl@_u@gGiN~Zk}@_Boi@d]v@fh@ub@wA{i@f~An|@hk@dFds@sSdByX}VaHjTqN|JpBjRdc@pJ`BtGqItz@v`@zGg@cCqJld@sHbMhH|]u]zRoD~PrHljA{c@rf@jClPqGff@xIbv@om@`j@cSpvAnPjGkNd\\\\_E`m@v`@`cAzGvQgI|Cc\\\\t^iJzlA{s@|o@u`AxXyG`NgVdt@oa@~UfV~T}QlReAgBaNk`@vEe]e_@fNyXuTaVd@sVnb@jGdo@h^|_@bEb_Aek@`q@}HhfAoe@dUuXllAt@|gAqp@f_@rXht@?fOdM`j@mQnlDfbA`z@sAreAww@bRqr@hrAcOnGkpA|iEzi@bbAaf@|^oo@ng@a_@dRil@~S{Op`@aqAsKi`@jg@kc@~h@{}@{Cq_@sZg`@_n@qGbj@sXpj@`Cdz@cn@wEwShNou@qGwRf@ssAcIyPeJIwm@~\\\\pVoYzcAe\\\\pt@gi@f|A_XbI?l@bTxkAkiApC}}@si@fRin@bAfMul@xKyLviAuP~Yea@nGut@aPqGiCkZ~c@gc@nQs{ApKbC~\\\\oTpG|XbJuG`JtGvw@wtAjN@cGwe@cF_@uD|YsSu`@wXhWaQeChLmo@aR_W`z@mHxNeHxBgNzTdAqEmPyVaE|SiCcGkPrXtK`GqOvTzBeL_n@sM^|EyNdOuLzKfFje@ub@jYvDzQsEh_@kl@xAxN~b@iWgC~i@wTrXcVoBiMhNrWf^_Txb@le@mMpY|NrF`ZnIaBlc@mU|HcShSpJ~WwQzFePlVkMoDkQxI{Mda@oEnc@tO}@kJ{ZoQ~QkUw@{d@vVcYgE}YjOyImBn\\\\`SkGrNfGaCwi@jMoHbAgXny@kq@tU?fa@|TnWGne@uVcPuK`OuI`@aLnVEdc@gPxKhTtOca@|Uj]~]DkRma@`N}\\\\f[m@vT}R}CmL}\\\\cP|CqD`TgFji@pb@xv@wUpNzVxV|Ane@cXxo@V~r@m\\\\~r@}Gff@eWnPdLzBeLd_@iTtVbL|Mwb@v[oT{CkJd_Ac@f\\\\lUk]y~@}h@s\\\\zYk[|[eMjh@}r@|m@}UzUw[mx@bNizBvy@gJ[zB_Lw[~OylAea@eScn@`LoPpr@wVjLkTrVyBtT}ShF`IrPmInz@uaCfXsXdLaj@|m@a{@aq@jT}L}BuOlU_a@vEod@wDkZ}MdLiTeEmIzLyNbU`LbPsX_U|@kOoIdr@aPbRka@jc@v`@_N|Cb@nLxG|AfHeK`PnG?cWzg@{H_JiLeq@sKrWwGbs@x@QcMr[aKxmCjW`Cx^fTgV~vBxD|K{Ut\\\\OtLgVzO`CxSd[v~@wOno@qi@tKti@vEsYd_@gQ_Q}^zMom@|[{Qbf@ys@loAge@jKsw@jTkIbAaR~xAogAkIaXnSeb@bHmExUtFrJoHhC_Jca@qGbGqGsBuOr\\\\mj@i_@vHu^rd@_J}N`A{UvTca@lObM~H{E|AsJoXoXfh@qBp`@yNlRqc@oPkPrdDgaAt_Aia@ro@ig@xqAmqBhNs|@pb@qg@fYsLz}AaHpcB{pAlJhj@vF}^`e@{o@~RyhA_YqGzPqGnIqk@roAgJlP|ZlRlI}Vox@rHeChM`RlLgPtf@fe@`cA{@zA~M`Yca@zBxKhJqDrMgb@le@uV`p@mGzg@lG{g@cX}g@qGyKpGnJaMb|@oVzE}c@cEqN`nAzf@l~Chi@`^qHtO{\\\\hg@xMjuBdAnPeEbEkRdh@fCx\\\\sI~NbHli@DzrB_g@xLpJmHdVjOfl@dYlTvX~DdAmp@j[jAdS~XjUjCf`@k_Apt@yMrMwc@tMcJbr@qW~n@{CjnA_z@`P|C\\\\wNaKuJ|xApGqOrGj[nJvLkG_PaOrlAqEl`Aqr@y@aRvJaD|lAsKhsBtPbt@mG~k@ca@j}@hQfaA}A~qAnpA`t@rWph@_EjGdPngAhi@`YlEfm@qQ`PlG~[qC|`ApW~jAx@bm@vSpa@aCfRdLtt@pGzq@`c@h`A[r[zGzr@v_@pPyD~sB~z@z~@bPlbB`Uf~@wIzWaMzI{O}E_JzMyItlA|]n\\\\gKlv@hp@nm@|Kxp@oI`n@xc@tu@mI`u@tv@|a@nDzJmFjd@fd@fQoFjd@lb@~]{@n_AduAd|@hf@lPqD|NzOng@jJzb@cd@~e@dZjRShDePrv@fT|RcKp\\\\|tAzj@fbAfQpDjJf^p[lZfIxApPyR{Spr@hTjn@xKsRhCe|@|XdBOxTpPnXoDf^jGpm@bZfcAf_@xn@`OwAz_@fh@vY~@|[zf@ppAzZz_@rb@~\\\\?xTp`@_^_OgInN|Y|e@~M}HvBzOhLiDtCqMsM_LvKiDnn@pc@p^k@|CfKzShAtAle@vQzp@QhIaXxKrGnH~VcAvDuJrO~OaWam@dDuEtj@dHmb@{QzNqHdn@vRr`@gDbp@`Gz@|VnPdKbJ{CcJwSvGaCdr@bGd_@~R{Zt\\\\tAfKfVjJBbc@lG|EnJg]qHmIbC_l@fIT|G|h@lPnPfCqXzK~Ctw@bwAte@zc@hi@pZhjAtLdU}H|c@n[qAlJqPsBs@dKnVdXla@ogAfAzShKdGh`B|UhN`a@~^dO~HlOdPc_@hIlSbP_Y|X~ElPcn@|e@hCxSnV_SbQoPeHgUjB~Ejx@`^hJnAeKvZ{CzEcI{Eu[da@rCxKda@sMvK`An\\\\lT~r@rx@pg@`i@y@ry@hr@dr@bAxQjT|xAjq@zCfJyIdYlGpXfN_Pdr@yKtg@nv@nc@oGfaAdHwGdY~TvNuCyKxVwXbd@zLlp@yMlQnFnV}D|t@zk@rb@iAnVzK`h@sF|iA~j@ds@|Hxd@|]`MgEx_@|QhMtBrN}Wv]tMfd@kL`Tzg@rMyKA{]pRe_@~j@uLz\\\\iTaEri@xNnTh`@}Kto@hHfq@{PzTdS`Jnb@tCnhAi[qHrJlLoGx`@ti@}\\\\xaAzu@bKpf@fNrKxKpr@nPhLbAx|A~U`j@gTzJqGnhArGvYhDp~DzNze@wF`jAtF~xDnz@trFph@hbBvz@bhBbvAdxB||ClbEb}@||@nh@~|@~[~UsFxHgGaHaBbIfNzcC`NzYhCngApQh\\\\_[je@dK|mA|X`Yve@ea@n@wOzv@aM}@kSg]ea@}@gVqg@aVrKaQtc@tCha@qGvN~Pdk@zJfAiRrX{IhNt]hVOrJpXmWti@qI`AkNeNuUbc@aSxNgb@~Fqk@hj@i]dw@jFpm@ng@nGlEpr@_{Afh@m}@f|@rXe{@o^rZgFrn@|XlrAoJ~Pas@tb@_YzvAuT}d@gGdGgQuGoStGoGmRvE}`@iWw^{[zXsPgDsn@dd@hChaCvGiDbj@ve@nv@sOiCngAtQ|KhKbYpSrBjLp}@sPjc@wpAjaBiJr_@kIBdHs_@z\\\\_n@}Bwv@sRaw@}IoH{JbC}yA{nAwSeEqm@zJsa@|r@s{@vZsR|\\\\d@pMlQxAO~q@nY`L|Ofk@v]pe@~Q~eA~f@xX}GtTdLhcArPpE~E_KrKswAi`@gpAjL__@tJuKb^fEtM|Zx\\\\~IrHx{CdrA~dCd_@jsAz\\\\h`@jTfCxI~^tc@hUr\\\\zd@`VtvAve@rVhI~a@h_@bm@me@rt@cWry@e@vrAew@l`AaRrc@}b@d{C_aAv`Boh@nuA_S`Gj@~MrQzEqWrj@dCjgAzJvF`G`[kCt]kgA|mCeLnrBj\\\\dpAhQ|Gpe@sUuCz_@r\\\\dZuf@vm@Ul]hPnFWlPmHvMkYcP{VnCqnBn_Dyl@hqBrGfbD}Qzu@u|@tyA_K|c@sV|Vgh@dM{|Aj{@uz@npAqGaNoWnK{Tro@|JjHaH|SogCtwB}u@j`@sP{WoOkCsj@h]{_@rEo^vb@c_@oeAab@yb@cp@kLwqA~BccD`oAsxD~cB_Xv_@ko@lc@}bCb`DgiBtnDiE{XtJcYttEavGnkAqhAhnBwaAg_Czo@{mA~hAmNxb@cpBpoCcu@zyAyQ?v@iT}XoEcV}]dBmKpZgp@li@vDbLwRtWvRlG{A~Eqi@gGiXwy@_Jyt@ntAuO}g@pD{n@}NaH{T~Nq_@eGeChFhPpUyXrGyNbT}H~v@nGyKjHzGh[jr@aBnm@jQph@f^tAvSqm@xKHgIteAwQ|U`Gpu@jMiSx@is@pO{UaDmSpEyKlD~B|GllAgMja@Czi@tPxaAxYvSlUpm@lF|y@mGx`B`Pfp@kF`ZmWz^cq@wRc^ciAkaAy|@cYar@er@sC_TsO_YbG}m@sCcl@q\\\\_fBbPer@sC}KmCfEgTxKcGeKs@sX|Gia@tw@}]nFw[ng@uj@nW_IbUarBfi@eShLkP`^k]aAcs@nq@lgA~i@xf@yPvaAxaApdAbWxf@vDjTaPrh@pRds@rDvt@pa@rgAfXpc@H`NzO`l@s@b^xo@c^~cA}FtsAr`@ly@?dy@zj@pj@mH|oAjj@xmAe@xWcWpTc_@aIg`@om@ma@kRqh@fKuSqTiRvAgg@g]hNyiAcLsnAr]wlA{Uga@ogAmFwqBea@ax@hSud@`@uJzJpNf[chAoj@qhAgBgr@|UcJkJvFmUmf@iYoV{@kThKqz@_{@eZbOyv@weAsy@ie@bB}M{}@}o@kEqN|Wo@}EoNmv@cCkqA~m@oVqCog@b_@_TzCkj@{Zk\\\\w{@oAat@gc@}@}b@xQcKt_@wfCra@yTqUea@zv@y\\\\?ua@d[oOcJ_HvTiTdGba@dLliAUrSuYfjA}F|f@fQhC`Ptg@}NrDf[yU|c@zBdYpRbVfY~Afm@no@pRxd@br@dJ~k@|[riAnJtt@|f@h_Bnq@v_@tj@hNfsA}\\\\rm@hl@`f@vH}ByI_n@z]pt@tBrk@`c@f{@~Ux_AxJzL|OfAxlArcCx^lHfrBhkAx[zy@`QnMdHkF~JzH`h@eFd_@`Lo@zGq^dJ|_@xqAna@xKx\\\\aPjHdGkLxWlV~LrXrCfVmPpLtBc@d[bW~VfIyJm@s]q_@wp@nGyVqX}BpAsNnm@rAhc@tYl_A{YjP|\\\\cEbKqY`JaOtf@oa@`YmIvWbAvXrQnV`PjK~QyBvA~b@ol@zP{{ApzA}[~{@NbX}\\\\wKo[r_@ePzb@rWfcAgDfGsy@e[ck@kb@~d@mAbU}eAfU`L|LwY`JdDnNyQdIyTkSc|@qFrh@y`@]rT|S{EzHmb@rFcXqJig@jTuqAhH{z@d[]rU}XhTaLiYo|@~CylA`n@}M`_@wkAjrA{fAteBkDmGoKjCic@{Kwg@hCcnAj\\\\uiAztA{Cy`@{_@hN{OzlAjRnCjD_b@~W_SfLhT{ZhW_[zdAxKh}@{MvJz^~NmYda@cgAd[sAjf@wHxLaPke@q`@`K{CrOdGtHaM|p@qJpDe_@cg@yMoDa_Anf@}JyAjFexAdZlLlOa@rEsIynAgs@slAdZy{@baAaB|QnJ|TcFtQj\\\\pc@gBbc@jYdUhA~UuItEu{@uH{S}OpMcHq@{JcRqK_yAflAxJ`^{\\\\|H{N~h@gPRkK`RcLuKcLvSZjf@bj@nNcPtg@oSdKnLhc@rMH`Iaa@rOoLmDnc@xK`NyKlaAlPtTiTzo@nF~Zg}@dfAlFxb@kUpEmkAtuB_EpaDpGdXbQhMpY}QlA`F_Jhm@tKvk@Axx@{X`l@ks@hs@ooBhwD\"],[\"lveHibfmAzUgUpf@xCua@~d@w{@kBhH{K\"],[\"rbcHcckmAlSoLd^y@qr@pr@mu@vA`MmR\"],[\"h~`Hi{bnAzMUaArLwf@~P\"],[\"```Hm}`nAbt@o`@`q@wAefB|v@_GyB\"],[\"`~mGcvelA^c`@bOob@jAxz@\"],[\"~njFiniqApEsX~JcCbRpN_JtQaf@zM\"],[\"p{iFi`kqAhH}KhGhVaSbX\"],[\"`_hFgbnqAtMdg@iFfFyL{C\"],[\"`laFmslpAgDo[lJyQfLbv@|QyFfCh]yYL\"],[\"fv~Ea~mpAf]iT?pi@rX|\\\\oa@?\"],[\"~ikYariyAi^ty@o]rLlNfOh\\\\uEWtQjYd[yq@tx@{PzAyPu_@}M`s@mWjVqTx@wMmc@k[vA{@_HtEuVjc@pGfS{[rHk`@qJek@yHyL_Fh^mZ~NiC_PsFxOqYaCeCf}AkTri@wW~NlHze@`JnQjPRlz@cb@z\\\\x\\\\dL~m@}\\\\hToGcHhT{UsCyHqSeHgHvIea@wEfJla@gWxF{O~a@sr@vj@fFxMnb@gd@|o@kXj`@jQ`g@o@sRle@~HxIza@i\\\\x[n`@dmAeEfSp]kI`[eQdBuDrh@yTeOmYbFh\\\\v[}LxUyh@|BtJ`[li@~Bri@wfAbSqJ~OtT_Hp[pPlb@}@sc@zJgTrh@fc@fChn@hOwp@~LkI[nm@pa@{B?|\\\\dOvCpM_]`RlHnQhq@iR`ZrJxx@aYfUwTzm@cc@hJyN`Pza@iAeCtKrHjNkBvsAyM`W~Ox\\\\cXlPoC`OnC`Qx\\\\nKeTpW`G~YjP?zBpr@}}@Dam@tv@iGgDtIyd@iTlPxBscAve@kn@}X?tCcLuIj@fRg^kn@LkYhe@nc@vb@y`@bZnZ~MSjJsXf^qb@nFmRz[x^\\\\tCbVtJzDQg]tIaDpIzPmGzg@ys@dQog@pi@aZzAM|a@_^yGmN~`@e`@tUmGnu@mn@zd@i`Av^yKlS{YuKyf@bTs@pP{UtJqCfXk[gSca@t^{W~FcUsDmx@|TmwAfK`j@w_@tJif@xWwOdb@_`AlrAgVy{@mEbFmVit@}VpJnVoXtIxKdL{F~Jcw@p{@kw@xKwAvLjTjYhWe@zCjGoPrXcl@kVir@hHwm@`[ehAjzAmOxAgcAb{@}EtWg_@tBuaAz\\\\i|@`l@eSzZeMaSmONywAb}@ka@_Es\\\\`N_XaDss@lj@}LgS{Z~Kkc@_u@ae@cBgaAfKsZ{DoKgMzt@a[qEiP~P_~@?ew@zn@yrAqGeP_I|F?}gAgWoGaVnGnAyJ|TeW|JlVlMFnX_f@rQcdAyLqq@nWcEfN_WpQgaBtUcHf_@eh@|GaaA|I_E~Bk`@v]w}@rXusAvEyaC}SukAdUaIlFmM}AaH}G~IwGkD{`@yf@cP{v@jOi_@rg@sFlFq_@uGiR~Bqc@yQ`YoHrj@gXvJiUoJmLiz@}Xct@rQ}~Awg@yfAzHaEiHm\\\\nQsHzUcu@eb@gn@}z@gh@iPcg@s@oJ|Xa]wE{U`Vih@}AqQqUuh@m~@iYeHeOlEe[hMkGe@{V|h@if@tB}g@jr@yo@np@oZvY|c@xKiTbd@vRpo@p@dh@w]zb@`i@|KkAoa@c{@jbBtm@tt@c@zj@aTxr@`Qhe@uK`^j[|k@qPdZsVfsAfPhd@ig@ld@|\\\\|G_[lKgA~l@pYcTzf@oc@g]_RhToU{\\\\{Bh[uVb]aIbg@k_@qp@iIMuGnR_UhHnBpHl`@}F_@d\\\\|[_DdIvMtRqt@fCrlAv{@nJlOfUpgAzm@cPoXlKkOrT`O~zAObW|_@cUvVzKzAnCvLr[c]|d@qKvi@lAlXzf@vc@JfCfLzWhM|LsG?qb@mI`T{SmWfTsJU{XpV_N`PnG|GyKbKb@bJjUjl@nDxFvHhTkPvTlGfIuWb[fO\"],[\"ftg`@kk~_B}Xcj@dr@fCpxB|m@|AlYwtA_H\"],[\"tnmYkt|xAfb@mPbeAzd@kNlh@}SZcDhKcGeL_HvL~t@|\\\\GpJwk@jf@uJyApAqJjWwj@qt@|EwHwLnSeUiQ}H_NbG_k@on@\"],[\"|kiYi}}xAjbAcUzJ|n@uQlG{RkX{Jrj@cNlOoG_f@yJlPo[aT\"],[\"jb_YuklyA`QsCtHuOjJnOFzn@`^eV|Frh@vL|Rqr@_TuIdSyViFkToa@\"],[\"~gyX{moyAh]_Y{Qbx@iNpJ\"],[\"rhyWqhowArXe_@iHx]dQhv@sCba@__@~@}]ae@jNaH\"],[\"rnvWgzkyAwTwtAxaArb@sTxl@\"],[\"fntWi}jwApq@F~LpNpd@P|C|Lip@nGcNxKmKea@ag@aI\"],[\"lluW}amwAg@jOuW`ZgCaUwMgJuB|EqFwMlJuf@ta@lE\"],[\"hpsWu_ryAda@ca@|FvH}FtF`PhThTnGeBvVbK|Qii@hGhOj{@wKjTnGxKSvy@{Uds@wWaHyOee@cA}t@dJaT}OaIsCsj@uOeZ\"],[\"beoWi|iyAa]vFaIv^yXlE{Gfb@ea@cNwq@tP~Jz`@yTbj@}e@eaAeK~D_H_PcX`IlGsQ}Zoc@|jCqdCjOoqAxiAzH`[`d@ReZuPwRdy@sSeNzWrLtBpSkXlHdK}Shy@~[pPnKpe@oQlm@kJhE}KyJ\"],[\"bdjW}p}vAzSqWja@xB|]jOxZpe@v^n{A{m@bRgIsKyh@~BuJhTcRdH_}@iQkf@fHeL}GuTnAc\\\\wt@gh@tGuDqEkAk]|^{w@vWsNbq@Rpe@gJ\"],[\"n}sVwxnxA?iT|GnIOhe@`PfNDdK}JtZgRnM\"],[\"lcoVoquwAjWcO`MfDyRrSqK|a@~Ifc@qGbRrCxe@uf@um@sHgx@pImWfCzGvPwE\"],[\"xjkVetwwAl]y_@tGdq@x]~d@cOnj@mZpGkTmPmP?vOkh@\"],[\"dpzT_zhsAv\\\\jAlGjWvXlWeBlZ{Vpk@aFddA|[rwB~StLh\\\\yHzTtKpb@lxA}k@`v@kRnxAhG|WhXnIjDpRyK`jAjPb}@cLjc@lTrzAqv@xc@yIzg@}Ztc@sxAs]{EcIeFmy@yVefAuUigDca@m_CiTma@nPucA_QenBpLuElGfUpPfIvGih@bi@xh@tIcLnGss@gJyx@xg@}HdCoJoJqc@_g@gVs@}OpTuoAd]wH\"],[\"pnmTq~grAcAcQbP{Lu@z^\"],[\"`yjTosbrAlLiOrY_@eEnWog@`Ky_@lb@BqX\"],[\"fh`Tw~yrAbO{AzWzJeMrJ\"],[\"bmqSozbqAlLsAzFjWhVjS}k@qCoHi`@\"],[\"vgvRquopAkDbNgJm@yTgUuKa{@tKla@xKoGbP|M\"],[\"nrfRwia`B`@iNd\\\\oVjLpIQnNqQkJ\"],[\"nmvOqxt|A?me@pv@bXlfC|_@lBz_@iNlFmg@qKeRlGoc@mGia@lG{b@iTuYg@i@gN\"],[\"d~tOeis|A_k@vGgZnPubBrDs]ww@nKaJnMx@rdB|\\\\fqA{E\"],[\"nc|NkgbdAdXiDrRhJyGpQuk@nLifAlcAiz@bTal@pc@en@Qeh@~TobABuXaXpGe]lb@`@|n@iVru@}CphB{t@jo@tLpBkQeLnGa@iG\"],[\"nplNopj|Ahc@oZvExFod@dX\"],[\"fllNyb_dAymAaPmm@lAySyDgKiQzeDf]\"],[\"hzeN}~`dAti@jTqwBmHe\\\\}Jx[cClw@vMvNU\"],[\"bagNgor|Azx@hNlmFnrBt}Bfo@lx@gIfQjIjEnPyi@|a@ccA`G}CrP_QnFeRg@}OmV}w@iHkTiTgk@aT{a@tFmN}FcV`a@olAueBod@s\\\\ml@mM}X~Dil@l[wPfR|AhOwi@oc@oa@qt@lqBgA``@{I\"],[\"rfpMunk{Abd@wE{CxKaj@fTkTtCqJsF\"],[\"lfmMuni{A~FeJdj@cElPnRf@x_@c]`[ea@pLktBxgBiJwYoNeBt@kZ`PmEnSs|@`C_FpH~G~AiJc[oZbiAbE|]uL\"],[\"xisL{jkzAbNaW~m@|X}G`HgX?\"],[\"`arLkd{zApGwLxIv@vVvYLdR}P_EyBhTma@mUiWpHHkO\"],[\"domLy`ozA|ZfC|l@qJdb@dKnErKys@|Jwq@cN\"],[\"`sgLglvzA{Gin@b[hO\"],[\"hlfLqpvzAqErYpEbFqa@?rU_d@\"],[\"j{sKidneA~HwBnl@~RtT~QnMxY_PlQ~K|PwGrEca@{G_rA_iAM{L\"],[\"jxmKy~~yAle@cLf]ve@cPpGwEoR\"],[\"~wkKcn~yAmPnP~XxKyIlHrFnMwYzD|BrZ}UcVaGaBcBxJwq@yTro@_KhE}Nb^mV\"],[\"zlhKmi|yAvThT}Oa@lGhMcPvKe]iTyKhCeBkWyLyHpHiIjb@tU\"],[\"r}dKolryAfWcRzYvEwBjK\"],[\"njtJsjsxAqGiDle@`@yFpEzMvU}YzWqQ{_@\"],[\"j`fJkxhxAbWmTbEnKp\\\\`MzG|JcPti@kw@bNkXvOkb@|l@qS^lScjA}p@oFrT{JzAo`@zj@zMzZaRdEcRnJ\\\\\"],[\"nxkIkydtAfe@mCjL|f@cLtYfYnViYdLiVg[}o@}Z\"],[\"ftgIwq{sAeHqZ?qLdH}@nDrUhOzG\"],[\"`d`IydhtAze@?gLjHjYbFaC~^vSv[uj@fc@bQfNlBrXlWoVH|^n^Nnb@pPcMj\\\\d\\\\dOjUpj@cAjn@uc@d]|j@oApMdVwc@|Cqq@aPo\\\\yZeZeGka@gh@eVy{BuVmz@jYke@lBqa@xKtCbBkJ\"],[\"n|pH_jlrAdX}X|m@d]kg@|\\\\kLyB\"],[\"fopHk{nrAfXoT}Dja@z]wExLzc@yi@}E{O|E\"],[\"nsrHuhtrAjMyPnl@|S_GbQoVpE_EtS{m@tJUxQpJ`AaDlGo`@eDaPcLtKoNmi@wBJgLrTcFvEkKmEkJxMcJ\"],[\"znnHygkrAb{@jPuCrX|GbP}GbLsJqGoLpGoPmPwN\\\\eJuo@\"],[\"dmoHyvprAx\\\\n\\\\mU`FiQwJmI~Goa@aYv[nB\"],[\"x|hHg`}jAwC{HjJkJnZtKyDxTlKtK_KdXoP{k@\"],[\"`njHyb`kAze@qm@h[hiA}OlGj@qI}X{\\\\{B|ZnGlRyKuClIhImIhWyYaL`D{OkTuC?bLyF_F_Ueg@h`@|JxKkS\"],[\"nmcHc_gkAdXgFpH~Fkb@nf@kKoM~IsIiT{K\"],[\"rkaHguzqAlSpAiBhU|IrLkEfJiMoP{OlCqJiKnAkL\"],[\"xryG}elkAdFqGzd@lRv^_PdJ`_@|U`IiQpIDbQoj@_BgCwJnI}Ly][aGpMeXmY}FdB_A}J\"],[\"p{lGu_orAoBK{Ak@kAiA}@qAtC~BxAr@\"],[\"bujGizdrAfMa@pGlWpHvAHqH`TgKb@rJp`@xNtBdWaPqGye@hThCtM~i@{@gMzWey@d@vJi^ol@_R_AiW\"],[\"n~sGuyqrAtCzGfZmLhL~KzMmGYpJhHuAcFxMxJpEqMd_@`E`IgQ~~@fQz_@eBtXct@zcCnTd_A_L}E{Yqn@k[gO_lAfPajAyKsc@xKcDiHfQwWkB_]a]eJbTiRsLeG|G{KmP?zCkG}HyKcBrKc\\\\hHUbKkVb@]aZrSiBgLqMtByFc[uNaEyUtWsBhb@v\\\\fJoHh@eTnPbLx\\\\_PjEor@{M}MqNYOrKg^_MrCme@z\\\\gLba@r]na@`KgEvSrW`a@r_@hBjS{\\\\`M`RQdOrPh@}IfM~EpNv`@?d_@qGtEgJy@}\\\\}UcB|c@ex@ge@qU?ye@\"],[\"vkeG}{arA{d@pIsG~MlQk`@pk@ea@pHpByK~OxK?gAvH|WmDv\\\\tKeGzFiTcIuX`M\"],[\"pzjFseorAte@oAoQ|M{Mg@\"],[\"|wjFeuxoAjFgNx]xBoTdh@\"],[\"x{hFk|drAhFiOf_@j\\\\lWbt@yKla@qX?hJmSyTyVp@}EnP|@iCgT\"],[\"phgFcihrAup@oGaI}K`x@pHtIxG\"],[\"fldFgakrA{DqJle@hYsCxe@\"],[\"~scFmk~qAyMvAyLkQyK_ZtDmRtc@v]\"],[\"dnbFkf}oAdAaOrX`B`Qnb@aFlW_d@lUgJwU\"],[\"lx_FsogrAg]ca@`[|@`_@rr@vTjIhTzg@cE|FhUjW?pPpUl`@cYgGsKgYi`@uTcDkI|VoUwc@m]\"],[\"rrcFoptnApZ}r@|JmHvUfCnOha@~Cbo@_j@nlBba@xkBz@ff@kIl\\\\kTxKcH_CgCc\\\\ws@e@rPkm@iMyWkMaIkaAzImX_HsK~Fr@dWi}AwkAcL_n@lgA{`@du@|Ffl@_n@fcApGeGmV\"],[\"h|}E{ahoAle@jC?x\\\\pJhF`j@|FvVyHfC~XgQr[lzAly@~Kdg@buBffD_b@lh@_w@t~BaF~HoGyBc{@lkAaJre@y`A`Hmd@j_@u|AlVsVmG{ZrUy\\\\t@{^oSpxAmgAsOmYnI_Q|QgFnZ~U|SsAo@{Mae@yi@pGcEhS`Hnb@cY_^oPgNekAm\\\\qYmAqSxVor@dh@oKm|@}Nah@e`A`e@mH|v@{^qDgF{y@jHyBzKoZiE_WmNqJwZnOoPra@rFvB_FwTuQpGyVcCmc@p`@oC|BaLpq@wJgEaZ\"],[\"`svEidnrAxfBn\\\\nIxU`bA``@peAjx@qD~JbLvLsC`I}NyLaE`Jit@e|@qYkBo|@si@qDiT{eAg\\\\kG`IuRkZ\"],[\"`~xE{tzoAyKmP_`@rBob@bZtIoYeHoXm^sHqGkSrZlKb{@iTzZtRvKo_@lFjLtd@cHz|@lPkThTig@nH\"],[\"tioEslyuAz\\\\iPrJbo@xU|JwK|Xqg@vSmX_FsPmb@}McGxB{J\"],[\"brmE{pyuA{AaSnWiRvPv^sX|O\"],[\"x_eEmdzuAfRaWfRk@hd@vZiJzi@i]nPsEeJqQaA\"],[\"r{cEycxuAdf@?~VlMdA`N{h@t[si@e[\"],[\"r|rRo~qeA~v@yKiCxKsj@~F\"],[\"f|oRquneArEqd@~JjVgAtm@\"],[\"rujPwhkdAkNuj@tc@pg@\"],[\"bcmJ}goxAzBwSjJrQ{Dts@cp@}E\"],[\"b_vDsdcvAhIoPlY|i@Cdl@aQfX}NuU\"],[\"bnsDinxuAlLwl@xNRpApb@iKpk@}KiG\"],[\"vvyKqmf}AQv@Gw@\"],[\"tvqFc_mjAlEMc@xLkEJ\"]]},\n" +
+	"{\"countryCode\":\"AW\",\"polygons\":[[\"_ttF`cvi@nc@cl@~g@yZlU|CeDnY_HbHgCwKgw@jrAa\\\\{DaYvFeCwF\"]]},\n" +
+	"{\"countryCode\":\"AX\",\"polygons\":[[\"{suc@o`kK|KoKdU|PdKmDeLhn@we@yK~XiUkVn@\"],[\"aewc@uq~J|QsRhSCl@`NsXra@nSiF|PpJiGbc@_UrGyIaNmRBiMgRc@iX\"],[\"aaxc@m~mKxKmGf]h]i@nFcYc@z@hVoPmG\"],[\"clwc@yruKaCvPw\\\\~@zKah@xJxG^dP\"],[\"mgwc@ouiKxKaLdVhi@wDph@gKbCsNkGeNut@rBfo@n]d`@aN{@gXfVia@oVeAnVdVWzDnLiFbPhViJbNyU|SzGyKti@yKgC`CbX~RcQ~Qoh@yKkTle@pGrIoKtGl~@rUHk`@zV~Lvg@eLxVdOnZ{vBngAXmUhOoBcR_LyIge@wKdLfCo\\\\v^tRp]yO|D{L_YcAjHkT{v@sXvT}FsE_Q}RlE_RpTtRzR_T`WcDbYm[}d@eBsZbAaNr[eL?qi@`MwXcPdGzBij@vY}|@hOtEtCc{@`\\\\~X\"],[\"ieqc@avvKyGfGmFcUhReM\"],[\"gwqc@wyxKL~GzKoGyOlj@]sr@\"],[\"cwsc@ipqK`VhM{A~t@cZqZ\"],[\"o}sc@a|mKgFaW`Y`R}@fY\"],[\"_ysc@wxoKdCb^aI`@oGal@\"],[\"qluc@c~rKlG_BzCjIuTpUsBgN\"],[\"kjwc@k~rKpKiShEn[wIpC\"],[\"agyc@{xtKpF|Zi_@iNpA{Q\"],[\"klrc@ybkKeZic@pNibAfJlkAvMjJaBhM\"],[\"wbtc@ahfKsRgi@nCwe@zLuQpd@_ShTh^mLqDgAdp@qK_IeO~z@\"],[\"ag|c@k{zKlPFs@nMgKL\"]]},\n" +
+	"{\"countryCode\":\"AZ\",\"polygons\":[[\"gkaXeomZlCsWzP}ElM|GoLl]cQz@\"],[\"qmeXo}mZg_BoyCmCog@tPor@iCoi@fUk\\\\~c@epCnWjc@xP{Mxc@kdAb[}yBcSyz@sA}{@ts@akAlk@yeBkY_OkCsp@{KaKky@sMuLsN{ReBaDsKcH|Biv@p{@ojAplDkkAnd@bMjTqIxOc[~Fat@sDsHaKW{`As]_c@}s@gd@`IkeAdd@OpPqMig@gkBdn@uPl[ah@zIci@|RsGdn@}u@h]mMcGiq@|IeTp]eJzu@{p@~n@c@pUePbZ_bAdGkmAjX}[sM_n@vUwjAqBct@aLeh@_g@p@il@ee@}Bg]_}@m_@cSu{A{`AovBegA_f@ypAelA|Zyn@neCqbCve@{VbcAouAbvAky@zbAoJdcAwXdvBqsB`Uuh@yA}Lx\\\\ki@tv@hQb[kQvl@qrBrHay@yL}jA~@cfAvb@{b@hRcoAfd@}VrH}X`UwSh}AiXtG~@en@ng@{[dy@cI|~@vT~xAaPlP}I~e@nRd`@vMiCxLrPbn@bvCdf@|s@bd@qAvR~f@lm@a_@f`@rd@xe@cKpQrO|SaBhPrOrl@_QbKvW|i@fh@~|@tSl[wElw@weAvXfFlLcEaPbXle@cXsb@|}@dExS~b@nUlcAMrsApTbJzDb`@rfAaJjPqOuA{Kqj@qa@{LyOpRUz\\\\ke@`EgF`JJ~l@bd@nSpk@_@lLcI`{@hRxk@r`@sCpUl_@y@bf@wPrhE_FuEj{@f\\\\`z@f@`n@wkBzkBa]nJdJr`Awe@lr@ad@bBcd@ryAgj@`t@uXn@}Uqe@yKyB}NmrBeRq^oXeGif@dUql@toAo`@tVoTe@c^q\\\\md@{rBih@l^gaDfeExKh]jAj}@dfBdoD~V|rAv{@dgAnk@fuB~hA`r@hQth@}Avu@~p@vx@d[bQr\\\\zx@ft@vh@|Nrk@aj@hWwpAoIqSzF_^zr@gRRmIkMdP{z@mRiv@qf@`t@g[~Lga@ljAuRpGaOeGoHyRoHqt@kZKoHmOgQwBiL`QOz|@eY|_@zNtbB{c@x_@cUbu@qq@dh@oUhhAuTd[i~@fm@qQbeByM|@uRqf@vHkqCql@iU{c@m`@er@cIsYba@`Bhf@yU`Za~@rpCmY~c@eg@nXmJvQwYvE}Ynb@ch@zGic@eg@_ZwhAgMmOqf@sIgGhe@w`@rc@sRhf@_b@u@|KpaAcJzw@wg@n~@xQrD{FrXmI~FaSsE|@y|@yJmT_IVg[zg@cEd}@}SyA\",\"g_xWa}wZo[k\\\\_GhXjEpXfX?\"],[\"y`vV}ad[}w@zdGaHhsByTrx@kLhG_PwDyKxVug@|^}Ny@wFdVcN?cG~aBsg@pIcf@zWao@~v@iIz]aK{AoTvSshAfYgFbLuCiCmFdf@ch@zTuLk~Aq[maAfGgT|v@os@vx@~@z@ib@cPoa@dh@c`@|Cue@bVmd@ib@w}AaNeoAxK{l@~}@kHdp@xZ`jA_mCz[dRh_@{@xu@ci@\"],[\"_d_Xe_rZw@lMkM~DfHaZ\"],[\"obrWys{]gEx[kPtC?_YhF_H\"],[\"abtWwcw]b@tTeNcBeTlS{MGtCiTv`@wT\"]]},\n" +
+	"{\"countryCode\":\"BA\",\"polygons\":[[\"yudYixwImZn{@bGke@uKfVwMgr@qq@lTeUrX}j@xyAwf@dYsu@|cAe^|Lqs@wJcO|HiOxnA_d@deAgbBd`Ccg@ndAgj@fT_~A~mBiLje@cY~Nm@f\\\\eNdX{m@b_@w^hd@km@rPko@qHcJjg@aWkL{`A~YkGnS`Etb@{HxKg]cWaUfIaH`d@iW`LoFtYiTjIdP|r@gl@fo@iTvFaN{_@aJ}Akr@pYg]mSsh@vCuc@kPqv@iDgLiS{By`@`AmwAnQoAdH}Yzl@w`@lg@ex@|Qo_A}AgNidAk]aI}[sh@_\\\\gMk\\\\tXwoDkU{Jei@qy@n]iM_@}KoGfCdKmV_G_EnP?}GoPxQcKj_@cqAgCoc@iTuMd^{eAaMae@~HgHjFlEsCg]`P|GwBqw@aV_c@eCea@f}@k}AmIen@si@ux@xXgu@nEsf@yEgWgUcEkGgUrTws@pEaw@hb@s`AJaN}TzBwAeHy@}h@zT_SmNmQtk@qd@~Gm`@hP_GpR~R~PwEx_@kaAoGwnB}_@aiA|JkHoAubAxNqc@`N}Cd{Arc@dvA`pApVlDnK`^tu@eF`]jTjW}Dh^eXhMyx@eAec@vPkT|i@wWdTke@_EmMji@eb@|Skc@{@oR~TRhYbr@lAneBmWjx@nEhQtZzGfNy[|w@qs@`q@{~@hv@oZnOa@zQtQz_@qFeCbc@|QdLoi@`g@xKp[Eb]za@~T}@dz@jMvOzBrXwXfa@`@dMhWph@lIjBvOo[lK`BsAwLdV_Yz~@kd@hEb[vRbSwc@~TaLdTjBz~@vIuFzw@vhBlDaNtZ|Y~b@lN~~@oJiAn}@`Rzt@hb@vJlm@qWpPnQzIQb|A{`Ab^fFxPh_@rNkBa@vaAoUb\\\\_OrfAeeA~mCag@vw@yFv[qUpRob@rHhDtNiJdt@\"]]},\n" +
+	"{\"countryCode\":\"BB\",\"polygons\":[[\"g``Gxtgc@pT|@zi@hp@xB~OwOtP_Ivb@oh@hNaeBSaLcSbEoJr_Aec@\"]]},\n" +
+	"{\"countryCode\":\"BD\",\"polygons\":[[\"_glL}bow@tw@uIp~AzI`tCcHnmBs]xUjBxTnf@cfAx[eErlAoq@n[iE~RvYzVf@b]jhAfc@plA`Anr@y]`SoXnjAfAzjB{k@bQzCawBjgA{m@rMaYr_@abA~q@myAnC}wAtx@kI`A|BqG}UeKuA{Taj@lP_p@s[ig@`Cla@zPEnIqa@?|HfLqObG}LaAqLcVkApQkRqQhBtw@g[qFiDgJiKlAiLcNpLfTeyCxd@oT`@zBqGaFtGzRjGsf@xO_XmAmOkMid@lRqQwg@ov@iT?vKle@jEje@xt@hTyT`KFrEfWgkBbZelAhi@qfB|nAoe@pt@_UpAcbAcSlk@pPzSn[|[h@eLti@dGcLlJpCbBhj@jJgYjMrHWjl@sLZ}Ble@hPyItGzQbNws@lK}Ezg@hMbShWbIbn@{IxVuuAhk@vA`HbYwPd]qBzG~_@eFbZq{@vg@i@jX_YfIiHjNo{Bjo@klA`v@ylAuEep@_PkSfIyLkENlScLtIcbAzEaVsSgFar@}UOcKjEKbPtO_NxKhCmExP|Ozc@sk@xHiTwX}DhLxHf`@vGqBzFxI_Khk@`OyLbJ_p@~v@i@pg@zOe]lnC_T`l@dFPzr@}uA|[_XlR{vAbg@aY`UiAgNbL~~@oBjU|O|@lJaSeBbRnUzCfj@jI|A|tAme@nLoQfInKmAhZds@dXtBnPnOmFaC~NjGpLpPcf@oJ{Dx@uIhWoRn|@kKbSl`@pt@tIgr@_RuCg_@~KgUh{@i\\\\sCvKh{@cSre@`BtdAta@tOnb@|z@~^eB|XmIbLe{@iTit@tEoAjIrp@bAfRwLnTnSr`@jEh_@pV|oBdd@fb@|u@iQlx@eQq@|@uIin@qGj`@hPvNvRuDpYsq@}Dmb@m_@y`@kKyc@qc@gEq_@u]iMsOz@dh@|QzL|b@fd@bd@|b@hRlClKoGbYsXaHsJkNuk@qCmSiUhDbOph@rPvZ~Xfc@?nFjHmMzSclD~b@_d@sMu`BucAp^df@|\\\\zOwOt\\\\vn@uCnm@`Ynb@cMliAgEhLtNkBr]hYu^ne@`@bl@sX|HfCpa@vy@cJxKjIlb@aK|\\\\i\\\\g@_QdIna@nPbt@ar@fThSuDll@pXqElJ|Jc`@b^{c@`Esk@eV{zAgCgr@uT_w@fWy`@gWmp@yKvc@p[zRkB`XbVpx@{QniAnPxDpJmd@|DwZqGc}@d[et@SoLvIhwB?mToJ|a@aNrb@lPpe@sRf`@xDd^|\\\\to@W|f@bd@eO~Uot@hAel@eLmk@vTzp@kA|r@da@}FzIdg@wEzc@bL|K{MbSn]cDzVqa@kEy~@rSjNtScQd^o|@xOut@qGqg@hTucAwJcdA|h@wD_HiWvLkf@nFqRcGm}@tRsKzYaVwPk`Bd]}X_Qah@wCwRz^c{@da@a}@yFwnA}bAaUxtAfIj\\\\gRlp@qXtEeyBus@gEjGdRjVmjAzcAeArVwUbBiEnN{~@gKgThDeZoN}UAcHen@sa@ee@yj@zF}YsMgB`NkWvOyk@oAkd@ui@sl@pDuPhMiM`a@rZ~NkLlbBu`@hm@gqA`uDiU~Mof@rAkTjNeFbUkcAsR{B{MyT_Fi]_`@{k@hOaQuu@zO_RpVgEtB_q@gmBk_AsuAuHkBiTdNos@}LyWN_UrRu|AmUqS`Q}aAiLbHeAmJ}UWwa@{a@_J~DOfl@kQ`e@gf@nYkf@qHcZrYwE|Q~IKdAvU~PzWcSnl@eBpf@_f@ha@oQf_@oe@vAosAtbBqHfSjQj}@ab@`Xeo@fGy`Ae_@eJuNyQkB}MhIoa@}MyWma@iUcbAeh@mEgq@mz@|Deh@g~@xUeRdbAvUfJqIxK_fAqV_[iS~o@aOdIc_@j`@gg@zGgg@~QuKxH_[vMpAnf@od@t_@nh@fF_JqPys@zFqUh`@oXw^{^d`@e}@kEsZ}FsKsJjBeEtQmLgAjBlO}UxHwKn`@aVfLo`@c^lc@e_AhTeFhKy\\\\bJ`D_BdOxOIze@gTbp@{Hv\\\\ky@pXsNdd@a|@qIab@|LmKmBmm@zUkSn@uMaf@}Ig^s_@iHvZ_TgVuLzXsO_NbBgPoa@TaAyWje@w@zHef@ne@qRd_@wBvZ_TaDmNlIcH|Q|UxIoYjhA`_@foBa_@`zA~ExfArPvv@_NmFuv@ht@wiCne@qqCkLw`DnJus@cH}[xNoi@gd@qaG|f@syBt@q]mNit@lRyQ`BqY_AqM{NsH|CySgMcK{G}|DrIyc@xNqMrCib@j\\\\ce@tEsj@hzAuoBxSuEjRnI~Or{@ca@lw@iFzVfFfQdfAwElgCzj@`[|_@tbA`AzT`[wAleA`d@{LwPl^`CxLjo@yBb_A~Pt@pSkP~QwVfCgGhm@vj@DjL`QcGlVcd@dVf{@lMfMbNxEdg@cHp_Bj`A|MjCrg@zp@bc@rf@mFzl@jMfIjEpHf`@`K|DpLuAzPcWbIlDHvO|XU`hA}`@nOcSnz@gRfJgQjOlLvmCy\\\\tQ}MdD}SycBnHsVgIO{Lbp@ij@`uBod@nOg`@dNyD_TcRyImw@yy@kv@gqBl_@koAgm@q[su@k]iU{_BnNol@eCxr@e{@cKkXuc@o]mGoYfs@eZid@kPXgVv{@`C|mBm]po@m\\\\~m@qDdbAtQ~wCeSta@oh@rbAyH`i@u`@lsAaBzsFsn@zNZdH|V\"],[\"__hLwrbw@f[}@~`Ag[nt@pGzItDKrh@~KgBbBbMaWtL_f@wJyaAhOcVoBcQwJdFaOlc@lEcYsLu\\\\dDiCoT\"],[\"glhLipku@~GqMf[`Cwk@rq@e]uFgn@hJ|FuLjdAwN\"],[\"kajLytaw@vvAna@i}A?qa@uT\"],[\"sllL}khv@z\\\\eGlObOgBnHbYnNjGfV{GmPgLdLqXgF\"],[\"wnkLshcv@_b@oWkBiMlvA|_@fItJ\"],[\"_smLekdv@hn@|\\\\o^}J\"],[\"wnmL_iev@fg@{^zRdExZ~d@}VfLe}@gL\"],[\"q{mLgmgv@tO|d@ie@cKii@_dAje@nKoPpCxaApOtMjLNhQmNjB\"],[\"cupLqeov@uC}DnGmBx`@?nMxC|AfK_ZtD\"],[\"cipLg{ov@{l@aC`NiH\"],[\"wbqLqlov@uBxHspAoWfe@gJ\"],[\"etsL_gsv@lVcMjx@sH~q@h[fb@zo@eBnXuLzI_n@w`@{yByH\"],[\"o~tLg|hv@wAjJ}JClIuRp_@_OvMo@mPnGbJlCxb@D\"],[\"o~tLumiv@uc@wBxZsG\"],[\"sovLcmsv@aF{X~JwEpMbElL|XuEr[iPeE\"],[\"mcxLgnyv@pbAov@rn@VfBx\\\\gb@be@al@dM{^kM\"],[\"qjvLcglv@zd@gg@lz@nKzcBW|~@je@zG|I}GpCbj@lc@bGrZyL`Dau@oYbn@za@|@~HmK`Cmp@sQwL|EcV_E}}@e\\\\gz@}DuiBhXca@lv@{k@p@ky@gQaKug@by@}S|{@if@rp@mK\"],[\"yg}Liliv@rXqSoBnu@|Zbd@fX{@rInG{XrP{GdVa|@aJoIiiA\"],[\"sh_Maziv@?fTvTgT}@lRuVdVzBzm@gM~H{RmKaPkc@\"],[\"cqaM_xfv@tIgBtZx[oa@rG\"],[\"mofMq`gv@`AbVkh@zb@~TqcAd]g\\\\}Rjb@\"]]},\n" +
+	"{\"countryCode\":\"BE\",\"polygons\":[[\"uxd^cgp@kvC{wJm[kkCbu@kAbYaW`CmcAmXeG{Doz@lUegBrTgBbHaPS}hAsw@_vCaTqWkVoG|k@}q@xP|BgAiFqN_McG|XsXzBaKvL|Fy|AyIcBeUtUuNyDeDpF}Ju_@uG{z@p\\\\hAnE{bAci@e~@cEu\\\\jKg[te@V}FfZbGrIlLs{A}Jg\\\\ch@_k@|Vw_@~f@jFde@ga@dBom@vG_JfUI?qkBkPa`AhNgYhYmErFiMzPsw@}A{k@rRs^qAy\\\\~IcFrKxIpHgMfCtX|TxBbA`PhMtCfFoGbg@na@?_Yx\\\\~Xzc@p}@~Lk@tTon@vTpFbCkJ_Ks[hLoXj@a}BzXmIcBkj@rF_K|c@ae@jBhG~GgF~Caw@`PbIdZbl@jGkIrF`Cv@kKjD~CtKkNeDg]nGyq@jHnGfSmP|g@lGz_@_VfFhv@xUzG|ZrfAlDnE`SmJrIdYbKrCdFqGlGnFgWxSxEn[sOdXzD|UhVxKrR|i@jQ~CpKtO~PX`I`TpNeEfRp]ra@|ZvSmWc@jOjd@rA~Ow^|^yO|C_[r\\\\vAlMyW|d@ta@xBqIvH|DpMd\\\\wBhzAxQlYaEda@hNdx@i\\\\tBmb@`]qM~h@xFbVw]]_QbXpAbw@}g@tu@wZhdAbGfu@iE~Twc@xGud@yNc[tn@qo@wYqPIsOwJbCqIuf@BVrYiHxA|CzOlj@taAfz@vNl`@|vBkN~sBnCxn@wLjj@i^oB}Tml@{h@jQSfa@un@yOcVk]sIhAaHvTjL|JmBnJqVbPy]bz@vJbbAmKxa@Uft@`]hc@eL~T{mAxIkRpUmEfcAuBvF_KaF_D`LxQngAeVhw@ioAvXmIwG{MbF_KbZ_g@jd@hLraBfe@xs@wOr}@kt@fz@yCtk@aQ`T{LgEmZjKqQwTmK`AaXb_@\"]]},\n" +
+	"{\"countryCode\":\"BF\",\"polygons\":[[\"ijgF{tm@l{@pUti@x_@jh@zGn~@vvAntAbtAjEff@_QbbAdKnc@c@lf@lWlhA_WbLTzIaPyByK`pAj[b^pQeDjSzc@xJlDhZ}IdH|I}ExG|ErGkQfQpWyDjRzGqKz]`Cf\\\\_N|HtV`O`r@kPeGpXbPtI|DxVnVuTdl@yKyKbRbAl_@gRn`@pVbQtWmI~PrBsJre@rAjlCpa@htBqYqFoA`IuM{@ybBtkNpTcAzOxKrUh^uUbV?`K|k@tR|Wdb@pCno@nm@rZo[~\\\\uQqD}Ld_BvWnl@qGeCoMbWwEbuAfKhVo@xyCgOnq@mA|mAlQtU`F~nUuEF]zt@hx@}HfYvYlxAhc@xPwInc@bPlhBql@~Uu\\\\vCyZ~Sda@tZzJhVaDf[}o@~w@nZvpAoE~k@iTd{@xBx\\\\sOh]pX|`@xKfj@ma@|NhLn]~Azu@eo@n{@pm@_Kd\\\\gqAj}@qfBzp@{HxH~Ark@aH|Iwz@lY}Hlf@gf@r@sCrF`l@lf@n@zMsd@tH|GlRkOpXcBtfCeRra@lIdJiAbn@pTx]`GbaAhVdm@~QrE~@fXnKtJ}Kj{@h`@d\\\\rSbu@|AaIjXjUxj@zFwCt]vXzBmg@tVqCzx@}KzGkj@uBFdQb]dG?hOeR`Od[do@mk@vb@zHxRcLfGlAfGaPpG{BkTaa@bMvB|JqS`LeKdd@fJvQyQxVw_@gG}`@xPOgL{R|@ob@t_@hFlSiFbD{BoGiC~OaNcRuOnRgJeCTtO}]}BbKlSmt@h@qFpS|GdI{Pv]}@jp@vK`Rf@bn@sW`UmEvUsYhGkS|TmdBaa@{RxDsNoIsBpHcTdCicB__@wpAx\\\\hBdMa_An@wLifAyO_c@so@cOs_@kZsuAJ_Sg[c_BvH_Y`m@er@yOcd@~B}Rn\\\\EtUkVbYdDqYcOsf@jDqRmaAcbAgUap@[{s@gNy^]iwBw_@e\\\\iEae@i]_J}Rgn@y\\\\rNgn@ox@}\\\\iBuCaOpOuOsBqQ{nAl[u|@gg@of@`Uu]nd@wi@v@eNygAxOwk@oDwWmLuM_o@{LcpAzIeaBbkAaf@aNkCsx@}I_BcLpPkH{AmZ_]gBuW{iAap@cf@cdA{DiBqEnOcKuKzVoLrEyVba@fh@`EwF|Ckp@zRwy@zjBo}CpBuz@s{@S{O}{B{zCbUqgAeNuW`P_KmKhYqk@o@ek@lRyYrWeHeRg]bGg\\\\}Oeo@o{ApS{h@_T_pAmWuAlFqYoOcq@i{A}|@{z@sc@ysAbIqn@zbAaqC`Bw\\\\wQwi@{IyFaiDmOcGyQpAgpAiIqvAygCswE{q@yhDo|C{wDyQmf@[k|Cho@kYkf@cuBr_Bo{GfMm@~WzTzP`@fv@gUhrBvh@r^uBd]uQrvAqaB~a@oYrmA~]ra@qWpRxDxNmSbUgEvR_[vZd@prAazAha@nKpNsGhCwhBd\\\\sFvKwjAbm@az@bn@}Mtl@uwAjHeo@tRoTrE~O{Pr`@~_@zOub@vmB`XdGbbD[xTiFrUqpAnbFexGhO{mDqw@ii@|@mMeRgVrJytApcA{w@nl@yEvb@cU`YX`HpLi@|o@xPhn@zX|IbzF}qD\"]]},\n" +
+	"{\"countryCode\":\"BG\",\"polygons\":[[\"sumXkiaOe@dd@gUxe@r]xaB|_@YbSud@|f@}HnEyOjU_BxPrIx[qQhm@da@r`@htCcVjk@jZzpAqJhoA|T~[xWfeD{z@vtA}f@teC|C|r@h[rDMlK_e@~m@wRfiA{aA|]yG|KpFz^rLvKxFdxAwN`EmMhUhI~`@bUhOgNny@lEhQdf@}AhFxI_GfTpOv^uOla@lN~YrEpm@tPdMm@jr@~Rjd@uOb}@k@p|@bRhv@aQ|V~Jtf@v[lNlIlYeKbuCwG}Mce@fAoLyIcxBpJyh@af@g\\\\mEeL`JeDh[}w@xZuFzKwzArYa@pWwLfJY`f@{s@`tBcg@`^ajAftAyFkp@ch@s[gGkW}_@eNi^bDwg@f}@_cAoIq_@oX{p@ta@ib@cp@{G_c@vFc_AkH_e@_y@{]sG{_@wfAcnAuw@cOiLfs@ee@fk@w\\\\`My_@tk@oHti@qQxOw@|Xc[jo@ct@fXew@rFyVje@mk@fI_^jW{rBi^}KuTuBkj@iVaReCwc@edAgGsWsY_HwY|`AgeCrQ{lAfRuDdRtIdVttA|{@bU~XaG|H{NgD{vCeW{wBvBubB~YobAiB{|AbNsb@~g@u_FmCg{BoYqbArc@qtCsBi`DdRuhA|GuiBl^abAtAeZwK}~Ak\\\\wfAcHqcAmuAq~A_g@ejAuTsNcZsk@cX{bBua@_tGg]opAyKsxAhSml@xLi}Ad^kTbKgb@vViYWevBmGyc@tBiM~m@{g@{Z_vB~xAaj@pu@euCvOidCyB{xAnsAhBli@_Rvs@~Yr\\\\xk@vWrHu\\\\rp@wGbl@~Uv}BfbBf~@~Q~eA|S_Yv_AjTjTjPlv@wKrr@?`FnKduAeDoAvbB~WxHjGaM`SldAhk@sLsChcAxFjQxj@pRv@`Pj_@_G_TkCvO}XqN{QjF_JoEci@z@uGdVqDcEuWnGaP|GbLpGcLlVzAhHgO{A_XvIvBtEmGfT|UpJ}Afo@u}@zGfCp\\\\ca@x\\\\sr@vf@iSlUl@yL`_CtTvDfG{FsLboAlHro@hHtB~Gz_@bJmFfBdIav@l|Aqi@fg@mT|c@mArb@`WrYcOpnAdu@fbAdDxo@zLzXaJfWfNlZgDzpAj\\\\df@tJ_Ilo@pSvCb}A|OxXp[dJ\"]]},\n" +
+	"{\"countryCode\":\"BH\",\"polygons\":[[\"kr~Nwiz]dT}YwNwNdWaGRaGxQvExApKpzAgAxcAhHtg@zVyz@dTgl@vf@iPpCef@_R_fAfYwf@uF{IuKhH}f@yE}\\\\pXkE\"],[\"ef~Nqiw]jDmLx[uA_AnVyU~@\"],[\"ig`O_i{]j@q`@|i@wM_AjLmP`Ek@bU\"],[\"_kuN}t~]}DsOhT`JpF{FrI|SfQuGvc@fK{HzJyNcFg`@vC\"],[\"m~uNwu~]}@wCJiC|BYjAvE\"],[\"optN}g_^JoH|D~@bBbDqBvAcBd@\"],[\"o_tNy`_^vCsMzFdBX~K\"]]},\n" +
+	"{\"countryCode\":\"BI\",\"polygons\":[[\"j{m@swsQhf@`\\\\`sAn^v\\\\`Y`UoCuRc}@vx@`l@tcAtTd_@oc@dX}GyZo^l]sw@tOaDwAy[tMg]iHky@~i@n\\\\t}@ca@dj@tMhRkB`ZlWgEz`@`L|DjRtk@xWfVrKeVtOXfx@lqAd@|VhWzUl^bAj~@za@|s@iDaHzZ`IxNzvAh_At`Bzc@jy@l|@hx@h]r|@f`B`Apf@|v@`_@iI`z@z@hqC_vCrR{fAxSywBxlAwhAfDywBsJy}CpJsY_C_OkPwS~Msh@v@ya@sPcg@xC{NlMgIda@_a@nJeNj]s|@lXuGz`@oI~C_WkDmGuScNfGo}@wI}LeMkAic@hb@cpBll@}YfWbKzf@oLkMqt@tMep@oW_gAbJ{}@m[oNpCuc@iHkWyq@ua@ysAgEgn@qLixAi@rR_r@~r@u_AyFsW{p@a_@~Joa@}AaUs^kq@lD}W~k@wj@\"]]},\n" +
+	"{\"countryCode\":\"BJ\",\"polygons\":[[\"{lcFwfeA~uAdx@ttAxUvkDixBfRgd@vd@lEnyAmYb}@`Ntw@i`@rGyWp_A_HdwBpg@gRxp@r@lXx|Axp@|XoH~{@}p@l[zFj\\\\t]x`AdIz~@vn@aCrd@b_@jnArYzEpq@yMjOjCfJhSDj`@x]h@lnAjdAlhBwJzwBbn@rT`y@cJf`@z@|XjNhY{Bdo@bw@Sv_@~L|JeHtx@bFxKlPzpDoLfq@tXv{@bCtpAiVn_@fOx~BhLfU~Hj`Aab@d`CbCbcAyk@db@rBm@~TrHhEvxCaLjh@vOda@wZle@jg@xgAsLvf@hGtQ}FfHcVbb@iBrb@t\\\\js@{Btf@tLxmAfFny@`_O`e@pdDaLnCyE}KwRi|Aw{A|VyX`OgM|UeXPkXxOs@pOePxQgURaY|Qui@}Tue@dJ{c@iCo`@dWkk@dKaBsy@a{W@e~@hNm^aCwk@{Uyg@zUuqH~Bou@dTkrBxfBgwAhLuPdFdDtIuWpNmmAoQycErFiT~FsfFj~IyVpDebB{PuoAnCm_A_r@yl@{Lse@xDme@aOrJse@_QsBuWlIqVcQfRo`@?we@nLwI_o@vIoVtT}DyVcPuIdGqX{y@fM{N}K~M}HaCg\\\\pK{]kR{GqWxDjQgQ}EsG|EyGeH}IiZ|IyJmDkS{c@qQdDk[c^xKapA`PxBU{I~VcLmWmhAb@mf@eKoc@tRgxAq|AgeBgv@cnAwwC{gAc_@xDaAqOgQmLXkPoQZwV`^sjBvZuPsSg@}]}I{HtDkJ_O{IvG{PoN_f@n@qo@ua@{UD}RySsUoA}f@mHmF``@ui@r`@gSbIgg@pr@w`@`iB{tBfi@eJx`@eVdKsTqFqW|b@qfA\"]]},\n" +
+	"{\"countryCode\":\"BL\",\"polygons\":[[\"gg|Ihije@m\\\\~OnGom@\"]]},\n" +
+	"{\"countryCode\":\"BM\",\"polygons\":[[\"}qwRpenf@xKyPbJbDoGfUvFlItI{Q|o@bqA_@zh@w\\\\dAxCqLhLtGlBoMd@|G|GkBcBoNsRu`@m@nO{GjBaIsx@wFgGrChTmH}@\"],[\"osuRt|pf@lBuHmAhS\"]]},\n" +
+	"{\"countryCode\":\"BN\",\"polygons\":[[\"yz~Ak}geAhMKnd@{d@~tByg@hdBBpWeGdt@ml@xUnFw[nmA]t^qZzo@s|@nFo`Bb`@kcB{@vJmJk^ir@qZpG\"],[\"un~AqvdeAfMfMr\\\\pErFzx@fo@bfA|x@}RvlB}GlPcJ~DeYzPl@ba@jIva@j\\\\xKK}FoRzIcA|nAl^|fA|uA}AvX{YvIkq@nx@}fA|b@_BvtAaN~BiPwK{ZfCajAtZ}B`QyKvCk`@hw@uWbjAfDa}C{G{m@iu@s}B{t@il@_{@qjA{lAq_Cm`@{oArAmZbG]dNr[hp@rT\"]]},\n" +
+	"{\"countryCode\":\"BO\",\"polygons\":[[\"r|tIxrli@}tAxF{i@tm@sFfj@e_AiZuBkPgi@at@wNur@yWiOqNtFmRy]cj@_W_`Ak~@}v@iYwI}j@yX{m@oU_Q_`@zRqnAsFqRkOgd@ezAkT}L{OhEaU~m@mb@nf@_@vk@fM~h@sU`g@kYtTahInbCamA_m@_d@cG_V}b@ca@hBmVaU}EyScWmGD{YcRgJaqAxvAcjAxz@yq@iI{|@t@uOuRaNsk@gmBqS`Aeg@sm@yBolAwkBec@dO_n@wB_JyNoIg~@uKaK}}AzX}h@fo@__AfE{f@xQa`@mCau@nt@eMwGpAgZcGsKyVKe\\\\mVo]kHcgC}CkiApImxDXyh@_a@z@wLs]oPyZadA}ZmMmKlYaO`D{`@ai@uf@cY{g]hmPz@um@uK{aAbSc`EpTew@dHseAqLqp@vIm\\\\vl@~Sta@qHqPccB{^wp@cJmbAeWe^gOct@qdDgpBo\\\\ui@aDgpBra@waAmDiTkz@cV}bAq}@}]{gAgk@a^sDen@_\\\\cBoC}GzCwuAqVsSaSsu@keB_dBy}@qqAq\\\\adAkV}I_NooAj@uq@it@gvCfBil@mLoRzM}b@aVqr@nEys@uMwPhOiPc_@cJjUcI_G_f@zV|@_BiTlVi^lEa[m_Awl@}_@{c@YgU`Rq[lp@_Rf_@sXtmApVvtCo_@fbAzYbVb_@b|@xRxJlWhy@_Lfg@`ChZq]zPqBd~@vM`_@oo@niAePlf@nYbm@Sf\\\\p`@jNbAnXyVnTzPfNYj^ae@ba@vPvy@aUtP{O{DyTxLyT|h@eAjRk_@pIfOjcAv@pR}Zak@}IoF{K~RB`R_]~g@}Ode@_BdQgUzXhIfTgBvMcYjNmlBpu@ya@nNt@d@oMsTwBuCwK|]Q|OaNzTuk@tM_`AtSgLb~@nLnIiVxh@ob@fDg|@rRkEqMel@b\\\\_k@eRsNzIqHf@od@|PqLuGo^vK__@}m@mc@}Gme@xKyxAjl@egA|Dgb@tp@_a@rg@}eAcAer@qg@a}@zJqe@nh@uRlMvCdUwUjh@uOnGqSeFkO|v@{f@zDaTza@_YbEaKmKe@_Ogr@dPaUlV_E~Umu@`@qr@fc@_]rJuv@oNelAxKmViM]dScc@v|@iBtdAiaAvz@{pAxs@mUs@i~@oKkYmF{z@|R}[jEs\\\\yA{Zg^mdAdR}|@{Ae}@aU_RmKuk@r^uBnKaK`JimA`_@ez@rfAi|A`Oag@dLmGdSa_Ax|@{M`u@oe@ny@bSdXx`@vcBwDdp@sl@tlBa`@jEfRpu@mo@hzGuLjFfBhA~~DblF{pExoMsh@vY}Jnh@_c`@_G}YkW}PR_ZjaAoHh^dQxUaLtUjGncAj}@jg@fNnaBcPv[jGfjCod@pnAoCrQeJ`f@o|Ax\\\\ePzJm_@v}@wwAbLsh@gCyaA`MoU~xAgH|c@ke@|RhDjb@sUfJf@z@pR|qE{nBfN{n@vRyCP`j@vHjR|wKveCfFq^rkA_IbHvl@dbLvpEpPyCxiCw_DpZlUf@vf@rhAfyAdLna@seBsIeoB|SemIjzPbF`|PjxEvba@j}GjvBrwGziE|_IhC|xUdiFyW`Q}f@b`AkhAdJ{FvIcA~_O`H`a@bW`\\\\w\\\\ll@W`jA|{@nk@dpAb\\\\|P~TzoBlm@z~@lw@~mEpm@cDrJ}d@`I}^uAccAf|@{}@{N{gAj_AeXeCkYr\\\\ii@gQaj@j`@sPne@oAh`AaVzj@qXltB~A`pGiHrkAdP|eBsPnd@gwA`oAsObfAw`@tImRhRw[~nAlB|IzpDfi@hBfP~ZlY|g@xzClKbHiFdc@dNzU~dBba@pWkB``@z|AfYxYh@`a@vfAyBdk@bu@`eAlq@|j@v{EkAdkCwj@hgAk_ArAghBaSgdC|x@or@uImx@bYqb@_Ia|AvsAweBbFio@~KwvAvj@cwC}AsZrGyiEnaCoKnVFdb@mO`[}XpI}oANmZcM_b@{i@mJKmbAx~Bwz@|p@qb@yCiUop@m}@z^qo@_AuNnf@aK|@w_@ivAZkg@osAe\\\\qn@dKg|@xtAgR~A}l@cl@oiBojAcVcf@_c@n[s_AhiBgc@~T}~@`hAk[dy@q|@f`@iMlPeWsQiXgDwkA|c@cYkCg`@|Q_jBJup@bZyeBbMu\\\\nXc\\\\zGub@kh@}YuCmInEn@~g@mSdo@cQ~OrFfXee@hNicApC_\\\\vNguA`uA\"]]},\n" +
+	"{\"countryCode\":\"BQ\",\"polygons\":[[\"qymFdgsh@pMmLdH~K|k@mBnG|St`@aF|OtOk]nOyu@^{YvKqNvv@gQ~Ns\\\\yIoBuMrX}Z\"],[\"ohuIdlme@bXo_@~KnG}@~L\"],[\"itwI~bre@`JeH`HdHuD`G\"]]},\n" +
+	"{\"countryCode\":\"BR\",\"polygons\":[[\"`tlQv`db@uCpDeW}`Am`Aez@sc@}t@yqAmI{SsI_V}k@uFuaAsr@ox@u{@ij@qdA{qA}z@u]ah@ay@ye@qOmPrBaf@kWy^cj@aXejAqhAwUiUyi@}TyLg~@iHoUk~@a{@ar@mW{e@qMyJuv@mEuRocAmu@fAwRqf@dKibAbWgKwA_L_m@cRwUvMiSrp@_OtBkUgv@qp@sp@rAmMnMyAXwMuh@w\\\\?wg@mg@yb@oUq@kH{Yc]{MrAu`@_h@sr@`Poc@eVaKhC}j@gl@f_@jC_{@g]_l@yeAiHiFg`@wh@_JuJaM_A_GfO\\\\bHkKyFgs@u\\\\{MxLqHsEyNef@wUnT{[qPiz@gUbI}GoKh@ya@`ZyIsJ}M}X?rToXIoKyW_OaDqQeQBqbAga@|GeLrLj@pBmh@s{@eu@~@aZkVl@|Gca@g]aPfZcXeCoFqhAeQkHgSwiA_b@mnAlLsAxJiTcBoNnOeWwT}m@x@m_@wHqMtEoNkNwlAdFugAgf@kXdB_y@th@m|@vReh@vi@wiBjC{a@zYqViC}i@|S~M`c@{o@pQ_GnShDvN{K`PhX|@bCdG{NbNgo@eCzWl]y@`^x]iN_Aj`@dLlFw[fZpQp^Yp[dW`EvNdSvRuAzFzGsf@`OoH`d@kUhG@ba@k^mD_d@`Loi@_HgiA_z@}g@{Lyf@ga@}n@|UgUS_fCka@aa@sUmuAc^iqAfGkuAel@cQWqz@d`@it@xK{c@_TqWya@eh@lkA_t@fn@uz@nyBnX~b@bn@|xBj]h_@`KfdBtPjd@_Pb}B}Tl`@}gCjN{e@pLqj@tf@auAnF_u@{Puk@d]gp@{O_s@`O_Q`Wkl@XkZdVmi@eAqfAlN{x@mXku@jBej@r}@gSzIwyAxJa_A~}@jPjGaXrlAjEhOoFnuBkz@brAk`Atd@vHx_A||@`m@h\\\\pj@hOuBa]rh@zTpPo@ng@f[z\\\\_ArMe`@bNfO|EaDp[mKpA~@hjAyQdj@`Bh[mN|G~Ndc@cFpd@bH`DiAd[qSjp@cAx|Awn@~]lCvcAdI`@hKxi@aMdPw@zi@mZz[ebAwMwe@gVu`@`RkWsKob@`Ayh@cZy\\\\nW}n@eM{HhP}WxD_nBmy@}GtO}XvLuc@gb@oYuGg\\\\qAwv@bMss@yTmHnJ|IzEo^ji@_NyB}Rg_@iJp@aOpPuF|_@{[}m@uIeByd@dc@hBdQvTvDwIbN}XuCcRdJijAzJ{h@{Kqb@ny@_`ApFqEfe@aUSoF_Vy]dXeLoa@shAgyAg@wf@qZmUyiCv_DqPxCebLwpEcHwl@skA~HgFp^}wKweCwHkRQaj@sLUkTjt@}qEznB{@qRgJg@kb@rU}RiD}c@je@_yAfHaMnUfCxaAcLrh@w}@vwA{Jl_@y\\\\dPaf@n|AsQdJqnAnCgjCnd@w[kGoaBbPkg@gNocAk}@uUkGyU`Li^eQy{@dBeEh`@jW|P~F|Yoh@~b`@wY|JyoMrh@clFzpEiA__EkFgBizGtLqu@lo@kEgRulB``@ep@rl@wcBvDeXy`@oy@cSau@ne@y|@zMeS`_AeLlGaO`g@sfAh|Aa_@dz@aJhmAoK`Ks^tBlKtk@`U~QzAd}@eR||@f^ldAxAzZkEr\\\\}R|[lFzz@nKjYr@h~@ys@lUwz@zpAudAhaAw|@hBeSbc@hM\\\\yKlVnNdlAsJtv@gc@~\\\\a@pr@_Vlu@mV~DeP`U~Nfr@lKd@cE`K{a@~X{D`T}v@zf@dFjOoGpSkh@tOeUvUmMwCoh@tR{Jpe@pg@`}@bAdr@sg@|eAup@~`@}Dfb@kl@dgAyKxxA|Gle@|m@lc@wK~^tGn^}PpLg@nd@{IpHdRrNc\\\\~j@pMdl@sRjEgDf|@yh@nb@oIhVc~@oLuSfLuM~_A{Ttk@}O`N}]PtCvKrTvBe@nMoNu@qu@xa@sU`_CkPxJgd@kJeQfUee@~A_h@|OaR~\\\\_SCnFzK`k@|IqR|ZkcAw@qIgOkRj_@}h@dAyLxTzDxTuPzOwy@`Uca@wPk^`e@gNXoT{PoXxVkNcAg\\\\q`@cm@Rmf@oYoiAdPa_@no@e~@wM{PpBiZp]gg@aCiy@~KyJmWc|@yRcVc_@gbA{YwtCn_@umAqVg_@rXmp@~Q}MdPiBr`@|_@zc@l_Avl@mE`[mVh^~AhT{V}@~F~e@kUbIb_@bJiOhPtMvPoExs@`Vpr@{M|b@lLnRgBhl@ht@fvCk@tq@~MnoAjV|Ip\\\\`dAx}@pqAjeB~cB`Sru@pVrS{CvuAnC|G~[bBrDdn@fk@`^|]zgA|bAp}@jz@bVlDhTsa@vaA`DfpBn\\\\ti@pdDfpBfObt@dWd^bJlbAz^vp@pPbcBua@pHwl@_TwIl\\\\pLpp@eHreAqTdw@cSb`EtKzaA~DxfCqV`v@}Cj{AdkArvCvMtw@qBt~@_Mv[s|@n{@ll@~aAksUy@ed@qMgGw[ik@_Pql@xc@mj@vMGmd@uUhFgUqScf@mJdJzq@hp@nm@~qAtxBfd@~ZvTl]j]rPfd@|zA~n@r_@l`@n_AoG|WzOj_@WtuNgoAoSsk@zRgZ`k@uVjFqb@qHqh@nYwi@jDFzRc\\\\rg@}AxmAeW`~@jAjUkUbwAe@`zF_fB_mAoL{a@uWaCm]}a@o]{Fs}@lIka@jX}Ul_@ckBfaAk^`gAcl@|d@aiArBmjAfrB_u@`Lee@~Xen@k@mkAxh@_Vhq@{UvUmh@`EtFi]qKgSaW}EwW~Dgi@bnAumBhoBeKm\\\\an@}Oux@bWrIqq@oUqn@{@{s@qQsPwp@jLkkAro@ueCa`@sV{_@q]wTofCi{DmKutAoJsTkj@__@at@_LauBt^ep@~b@y[Im{Bgz@aiBsuAub@iNyx@gIey@bGi~A}]gYaPevAfL_I{c@wMaBcFuq@gOyNlBg_Ay^aRe_@mi@_Fa_@yZsJkOse@mr@{PcF{XaK_GyAoq@az@mxA_d@aVfCgUmy@eoA_IqaAuL}HlH{ZwGqXfOiTuPk_@bLhAgKm[xAk\\\\{UqJ_D}PqBow@dNyMaNiS`BwHe[sEcK{W`Uu^_]wZjWwXqVi\\\\pLu_@{V{MpV_VcsAkz@{FiRaTlBsNaIgNec@xIcq@kb@q\\\\`AyHz\\\\ZhPo\\\\s`@gU~XaTa]yt@fTyi@_QmJjAyFhPeKpn@nBr_@}c@xAmOnWaOor@uh@fBmYp^cLlNcWe^wg@_`w@eoIwqB_G}a@oLsw@l]is@iBom@`w@yZ|AeXlUiUIaq@xa@ov@sZ_jAfUkSpQkWr{@sj@dv@cYpM}Odn@k_B~rAsrMsGlGgHeSiqCse@cdAfJen@cWuNeZogArI_Wxm@eo@yHyd@`WcB`Aik@mN_O`D}VuJoNiXbH}VbX}OiNgv@vCsMkMyW~b@sUqBez@xs@}Pjh@`Wnf@aSpd@dBlg@wI`o@zG`{@gNpFaDpTxR~v@etKnADm]wR}o@@aq@cT}aAx_@ouBUefVaH|NmP|AcCl[k_@xDqC|R}rB_v@`YmHfRgh@`fBqp@dP_TlDkj@uYobAghDkmC{_@ur@eRqt@~\\\\os@naBkn@fyAecA|o@aWjhBgZzrDjNjo@qFa\\\\{bCveGocHtYae@nGee@yIwn@~Rmm@a\\\\oa@kQu`Akv@mr@cp@ozA{Dk|Ala@sd@`h@wJhlA|j@v\\\\i@jYqY{Da[sRc\\\\e_@iVym@uGsy@ol@mGslAwR_N_mAwJe\\\\sMeGmfAkMeBoVy^}Jej@}^ic@nMwbAis@enAcv@c`@_v@{wAnEwZp`AzQhMgBdA}Uqq@c_@cqAkjByZ}Uwe@yKuaCyJ_TsIcZ}o@uAmlB{mAqfBwNua@kM{|AkhAoWgrAeF{KxMsIpfErDnnAuYlfCmWbEky@o[mdAcHepCvbAomB`hAsnAcCugBhQc{@y\\\\wp@dB{oAvjA_a@|cA{m@p{@uiAtdA{gBrs@sj@tt@kYAwRiL_Aq[rMw[|UiWb~@_`@vCqJsSmkCdEwlArRuy@x_@cWpzAuZdQiO`@yXcj@yjAbLa`A{Ic}@zEo]lq@aeA}FcYg~@eZ`Eam@`l@y}@trDy}C`Qw[zDqe@gZm_A_b@}U}q@wDmaBf]yg@aM_b@AkMuz@tBqcAwu@_NsGi`@eU}KiDyT`y@qjDoj@ciA`EyzAuOub@mj@sm@kDs~BwYsQa`AyKwIiZ_BcmAaNcX_e@tQoFqC`Bmo@`Omj@_[qvBcWmQisAo_@mAkU}k@swAugA_aAu]}K{hCfl@{Rfn@gC}mA~Ji[k@ww@`JyPsSgQjGeu@o`@cd@kKud@~W_VcIa[b_A_b@ha@{x@hyDb_@~e@g@thAna@vGl\\\\tPtOjV]zNmWmMuSdTcSv@kk@uIuRtRaWmEaY~MaEcBo`@xs@ykAhXhCx`@`[`MwGhe@vGlOkFpYiq@|`@tI`s@mi@bOP`Ged@jJuDdXx`@dZ|Kh`@mAz[vl@fh@~Gp_@hj@~Pjr@fPvNhQ@ni@_Yzc@nK}B{I|m@k@vCjLv_B|l@dUmGjDnJxMgEhs@~b@zrBhN`eB~Bjq@}T~KvBnQ}Qb`AeT`q@bClSiQh`@yr@|AwVbNmL||A}BvV~Id[}Hzi@dCxX|Knc@qBxLeeArr@lTfUuXkB{h@`NgYj{AefAtB{LhO_HY_YvtAep@fo@kvDfOkWnh@oQ~R}f@uHye@{p@km@fDghA{GuMfKyVsh@sVs`AjSqJgHoSieAkXlAaMiW}\\\\iRpXod@qCwl@h]eWj@{jAuj@cXik@g@nHgf@kk@ceBxIcjA_Gko@uaAg{@{s@aU_o@ycAzPsq@sl@urAdN_Rt]iJlQ_[Hyv@lPoRjIss@sWaj@Po`@oNwZrRmg@qUey@tLsh@iC_i@lWkm@S_g@ja@yuBkKeh@qS{RseBiAueAreAwo@~XeHdm@ay@{Yh@o`@k_@aVi`AgI{OeObHw[d`@ok@`Mcq@dR{JxF}QeVogAjAmaCuFyPw_@kIsMgTvK{i@ke@}p@y@kTvPWrDyHch@o_AoJsc@fh@dKxz@m|@rG_WsSy|@|K{WlYwCfPpK|^gFFmgA`Yse@`AdMvEaA`a@gj@~Ie_A~Xmd@_CmmAr^o`@yIyUya@qLdBm]eRag@}FcJw\\\\gGdByRgXoTgBmUiZuQiHsUfKcPjX~Ale@obCoFqj@k]gs@gYoTr|@ucAjRvVdNiAwM}_BrSs_@|Em~@sQc`@gj@oZw|@qjBiqAs}@er@aWm\\\\|McJoSmdCyu@eSw[{Q`GquAck@ot@hAgOmUqg@}W_Jg_@iiCmsA}NNaEcKa_Ake@mt@gNkQe]wW[uy@q_A{eAs_@iu@u}Aiw@mUmn@qEhE_OrxA{\\\\f}A}m@gb@[uiAff@o|Ah]ofAhGw_@uFaKoRjr@qnAdxB_fBzt@mVnjDeCp`AxI~^kLchCyWaWoKSoMpNiGhaBhEppCsE|YtIll@kA|zAmb@v\\\\cDdR~F`p@sO~Uv@fcBkl@b`@DvPaYxnAs^`fAwSha@xKx@}\\\\z^{Pf`Co^tg@yS~Q|MfChTaPpa@rVoPb`@lHdOqPuc@qIqOkSGiY|NgX~u@qQl`Aii@pa@cGbYuY~Iul@uKgf@p@wfB|y@wdCrb@gXz_@{DbhC_Pzs@zDbUtQ`P~e@|QhnAaCt\\\\nUmQuFmyAcLw`@fZeTpo@`_@rN|WXdU{TxIju@hQvMf[fp@dg@d\\\\~w@z_@x^pw@pXdf@n}@nbA|[xpBhcAv~BnpBsArf@lJxm@zTx^p~An~@jIbjAfKnGdHl^mAle@|\\\\kn@|u@pk@~aBdh@da@ba@lc@rEf_@ri@eGnc@vKcPdGvTgCoa@`yBdfBv[bIlqCb@~aAbiAfZpz@xpAkG|QrJpb@~d@jD~j@_Nnt@oUnZZtYhZr\\\\dXhtAp`@d`@ti@|iCv\\\\tEqOueAeL}{CkTog@~M}Pxd@aK|_@dPzW^cBcb@ei@we@iQsqB{vBusDeHqm@}b@crAqVuZkXiJsKkl@tOvKkO_g@qcA}z@mbAarBsOuF__@tJmGyFm@{KvUoXyNzIaMsEnAm_A`MeJvcA`Bhn@~m@tCba@hj@acAdTgP~uAdCdReLtTpGh`Ay_@bv@mu@bcAqNbPtUcP|[``@kPvRzChMrX|UlDpHzKQ`PyZpg@~O`GdQqWj\\\\wDpG_OwZCmHugAsa@eA}^cW~E{]|x@sf@zLuYYsVb^xI_YsXz\\\\`Hzv@aYe}@{Yqg@ud@cGqq@jKqj@qSmC}SwZmMmrAdf@gbApm@tNoXiTrd@iEn`AjVaYkTze@qX{_ChTdIyHtRZs]sLmAg^fS_VsNo@nJuJuGqO`d@uk@jZkF{IkG}QlGaj@te@w_@ob@uCuT`OcOqg@mHaSgVfH_P|ObBwOsXdo@bS|McKrNgg@~TMfZfNtCcGx\\\\pXz~@hDfhAhl@fe@dAds@nYzeA}@p|@tk@hTfd@lKpFlDyNy]etAa]uDuMkUgg@}WemA{HqdBoz@efAmRexAyi@cx@_u@ov@cMiu@ifA{q@aZmXgcApWxUtm@tJxRrTne@zDtX`b@nWnLiAiRcQgKiOi`@mm@a]{OxDe[o[{@sQayAkr@kLcT}v@oYl@gHjyB}aAnV_[bCoVzUeN_l@|Bip@d]~Fia@{Mcl@uQaRuD`jBqoBsGcf@i\\\\rG}Fng@j@fIww@mmAoQeLwM|LoRae@lPkHoa@?d_@uj@xIkCop@gJsNihAo\\\\mc@?{OxKkTgCajAqgAjTyK`PzBrc@me@yX_IgZsi@sMiFnI{f@w^hMmm@cIhTiTrV}AjCe[`j@}DxShPjEeHcf@yhAqc@iSwb@~BjSy[bIzAh~@wa@vUxD~Bav@|XuHaYqGyMpGa_@na@tTvK_cAXeHqL{GnGaGyR`G_RbL|Ghi@gC}S{G|ScPeM`HsNkCbLi]{\\\\xK~BgI{KwT|BiHp]u@S_O`QbBqNiLiRdDpR}ZzNb@{C~LvOtOxKcGwIkPnEmToPcGn\\\\q@bSnU|@cWvT_I}b@CwEmGnKgCct@uK@mL`t@jCiCcGt[gFb@eIxTvTnXgLgb@{D{NeJxBqGsRSwEcRi`@uHzGsF~m@rFwDgMfMT?aKbPdGiCeGnHuB~QnXtPf@~C_J{W`@eHgb@me@?`PkKqGaPyK`Poa@ea@oGdGh@qFvNeMbE~GfJ_HzBpLlKaB|GfJrDkGzJbCoPiTpd@xDpUoIcWdA}HaEtKgCoBmEiYqAlc@qXxg@vT{e@e_@ka@wDpCiLfRoEMeNke@nMbFiYt`@qH`YnPl@aJfNjEdE~OiMfLre@xj@lTlFma@ui@iCon@rGuMiYuKyZoc@oHan@dO_CrN|c@jb@bDbEsItUjD|D{InGtFkLqWpHcQiQ{L]gKxh@gD{XmEoGuMlu@h@sPuMu\\\\wAve@i]eg@xT}F}J_RfHqa@}PsMg^jGyPfUh@r@`In_@nRMcM|GfIf^eAvbAr^aYqXhCea@yTaPhf@hC}QaKw^sAwLaLlm@cV~G`LpT`EtGuEoDsFiWwLfNuEpMtE_EkJw_@nAea@yKrEqNw]aFnDsH|L{@bBhGbIiGhTxK?}SlEv^rXjA~I_YrXcGmtBkq@vAqGxS@pk@n_@tV?y\\\\uOtW_Cra@vOuOuTt`@xKiFiMgWaNsRCld@aJnIpKf\\\\qQa_@Ux@yOmxAie@C{KdOcBvw@`Ww]u[rb@oGnHaLzY{@p]fNuDu`@_r@aFtBmRxEvJxB}GlP?ab@{W`H{G`BhK`MN}ByK|\\\\xK_YuTv_AzG_h@oc@wVqEkKsX?`P}AsR`f@u@vIpa@b{@bGPbVpOfNcP_P~BqGrTtCoGoP~DoIn[iAyx@cEoNiPtT~DcL{Qey@m\\\\iQzAqCgTlGcIzv@qGsCpGvPvFp\\\\nj@sCuXrc@xKpNnQpKsDiT}BrGsTnMyEljAfNpXsX{FoWuP}CmRoa@wg@hTuP}Cy`@aZFiNwYiIYaHlMgEiXq\\\\rIkEz@rMIaOea@}Hz\\\\{BvGi^xSmDsB~MnPca@hSb`@xL^iJeG`F}Xux@oGuEcP}GrCsa@ch@ff@mIxKhT`ZhP``@S?~X~[_WbDpJc@uL_`@qOhXwV_CwIwJxKg^qGtNiM`ViAaPuC~I}E~SrJfRoPdLxKvKqQxTl]qJ}\\\\_V\\\\nGgi@`S|Fvf@bw@cP}p@bPcMi@yPoy@uO`Pea@rJ~Ovn@lTqGaWfLkMiToNx\\\\}@wTaPxC{FlGiD`a@`j@eCaj@vx@_TpPlLmAp`@`Pv`@cEun@jIc\\\\zXhd@ph@r`@f@jVbEmb@~g@vh@`Cx_@nP}GoGbc@`Tb^aCfMrFiKcOau@hTyI_QyT~NiA~`Azc@k[qb@nl@aFmjAy@eVkJqa@oo@yi@mb@aAgU~_@if@htA_Uri@zR?`p@{T|U|MnXzNtJsOef@|cAzGhJrGz@rQj[fQrOne@jHdA{Zsp@uPuJz@cLdbB~w@paBpJve@nUzo@tC`JyDjGgd@rNq@~s@pt@tXbGpK|d@tUlB~_@cOxGtTjBoTci@EeRqJgMwr@ml@_\\\\mEgXmj@oZaRqa@c_@qXic@cNeh@MwSaI{GzG{|A}XhTqGweAtIiN_Yna@oV{\\\\pGc_@gEuEil@ca@{bAJ{PqIeExHcWjPmBHpf@fGbB|B_ThYsCuT}GxYwDvWlI~Xzm@zCcJli@bRlKpYeLjTnPqGtg@peA`]bUtIsJk]{I~GaIdLnGnKoGaj@sXjPaUyNa@oD}R`]kFtXrZ|KuJuTg]{s@s[xH[eGqLtO}BoGgC|XdGgFgHmo@yC_j@mVcWk\\\\nJmQoPnFu`@_U`v@g[yf@\\\\gYerAmY}BaE_IzU_J`\\\\bH?sLcL{BhCwTkIdMqNZePsVvTfLqGyQvOoJ?}m@wH`LqNcHxK?wG{DoTnI}KsJbCoGp]e@@kGpXiLpGrCoPoPtToGwLmDa[fL[c\\\\cGxP{PxFpG}\\\\ke@~m@}]iK_DuXlDqfArSgeApeA}oDr`@u{@zIoiAdp@mj@rQ_^~Ek`@}GgXbRn@s@pNpNgC|Qca@eLyT|JwExBaQt@ifAhNmEdRjG{D_QjQNkT}SjFsQkFqy@`P?cAme@{FcBnO_l@_g@eAfBpJsVfb@uGaBl@kjBpAyIxRlLyKiTplAi}A{GbPzGiCgCxKrG}FkC}TtOiPbFmaAkJg_@tIoInV~@iCmK_MbDkBgJdFkp@lFyG|LhDlM{UsG{Bb_@k^oYWwZ|K{NpM?nPkPoc@rEmiAhRqa@}XxK?{}B}H{^nMwK}Xin@qGajBsXgr@jTuaElP}ZoKaMnKosA`i@_mA~u@uhAxP_FrC_PoGrCoDyG~Lkp@`_@{\\\\ha@ot@pVov@?_Wh]qt@vw@abAfFc^rYyTxJsz@fcAaj@_B}Jj\\\\aw@toAk{A~XmrA`A{R}MsIxz@gi@zn@{Rpx@enApyBkyAtp@eo@puAslBxKot@jiAcc@|x@w_AvQuj@}GuMzBcO~u@cyBpwBqcAxK_]wE_VxIm]Dc}AreAmbAdNgz@xGGfCov@nRaf@wL{JkNba@?oaBvMcwAmDcNiCvThCocBg]wrArk@qbGnNqk@`~@kjAf~@yU|tA_x@`}@oWpbDg_@fSaNra@i@rf@cX|e@xBnDkFniAcCeLlP`_@zQdDkIcS_W~PgJvHyWnaAwGtT{Tza@qIt_AiGfq@zElv@wTrd@tCtjAaW`PqZbhBhTnEjGoEtGjLqGlOhC{c@qa@s|@kK`RsHdnAjAx[oTtfD~Knj@_EjT~GeCje@zGd]hCmy@nd@yQjc@rNeVxDnPzBw`@nPru@{D~@hMzNlKeIyXd[jOlYZdJm[te@k@aPcG~m@gL~sAvWaTpo@`j@}X}GgCbqBlc@zm@EvoEnwAfd@rB|g@xX`aApKtnDpaBneAlu@xe@bw@bfBz}@rZxm@zz@dW~Odr@mc@}Rub@p`@dGtFvZyK`V~@ta@nf@scAp\\\\sNxUzEdLh]ca@b{@wTyT{m@bNnFv]d_@ne@zK~b@th@h}Ahu@hQv`@fh@x^jjA`vA~k@dFx|ApgAf]hhB~iC`kEdmBjtAn^tE|mA|u@kTbAuKxK|GlGmYfNsPje@tCnGl]mItKwe@?bJ~Gr@t@__@le@]tIiHjYjl@nRnGjIfQpxA|g@zBfc@qNiAm@hJks@sZqh@{t@ca@`Pz\\\\zBmc@`Wpt@qN{KnPlXyE|OdZvv@fU[zGq`@pG~JhDkFlJ`[gIbTic@ta@jUrMxd@eGyn@g]ca@pfAdm@fkHnhCppJrvFzvDlgDbp@vfBt@|[}s@oN}HqL`KoDg`@rG}QuHw\\\\vPwq@~AyKhhA_g@~LgNv[qYgAhwAnd@zMaGp|@xi@wD|VgR|Jsl@tJw\\\\uTwKpGpr@ve@ti@oa@~[aHxHqOaJ_RxMkGB_d@vWlOz_@bBtYlRpl@pbAjVgBzNwr@j_AzvAfp@pMh\\\\eIuBft@iT?di@dM|j@k@jZ`Olg@c@zKpRxA_QuGyDq]bA}[eLkEwLvFeOpZwJj\\\\pDtt@iT|c@bNf_AoFyKd^aa@xMkKzSwPzAeArXhPbIiGySfT?vLmZbe@uWhMoVrI|GeGlc@vOrC~XwEb^{h@dn@uIvId]{JjOrIpIrMuEpXzGvTuT{g@vMmZiaA{p@xGyMqJ{RdVaTiOHgTpaA{BbaC|g@t}@oAlTjKpi@LnaDje@fhA}SnKxKhAsR`cGaLfmHuo@tuA{e@tcCpr@|gAdGjx@`]rs@\\\\fo@n\\\\hiCzV~wAhZjx@_Hpc@nPjmA~J|t@zW`rAnDbaBmR|mAh@f~@a_@lRxFvcAhcAz[bIvU`bA|Zji@zwCflBrq@nTdxCxg@zdBrKvhCkAbrE{YdtA`AblC~s@na@l]~\\\\pgA~c@jn@zfBbk@tPhd@?eMbGo@|^`HvK`S`f@}FpcBxh@tEfFsIrLxKxKe]pG?`j@zMoJlEvNzG{GjYpA^iY|F\\\\qMyYpCkMz]zYvoAz[~fAnj@jGhHkFhJ`L~M~fAdw@r]jJhBfO|RzJoRxMbb@naAvh@rY~`AlG||BvtA~mAhFpiApy@tWdH~s@k`@ldAzBtmC{b@nWhBfPdMxpAfzCzb@viCnp@n|Bfl@d`AnTpGvZvg@zm@|e@bP|`@dm@tQrw@_A~i@oc@sTig@fJE|Q~KsBzJ`^~p@r]pCvBjMnRfG~RoE_FsH~GwAfTrEmP|ZgK|fH~FptAlK|OrCxpEkQrYpDzHkTrXq@iWkMnViF{@u[oNae@_i@cExO}QuUkf@`AgCn\\\\lPjPn@f]z^~hAfYPha@aR{GyK`PyKrCpGpR__@ra@eEtJrJ|Nx]hMdmCx]neAqNvd@h]hnFeYk@cCc_@bG}ZkTqGjOid@b@qq@sFcr@mQ_h@{Sj_AwT`P_Ebj@qKvBmAra@oG{Gn\\\\p_B|RhOx@rJ{QkE{ArIxr@xc@rOpk@sHpEzHnMgChM_\\\\tClIb]_JlAlK`P}HfMaGb@eIuYi]xGg@|RxH{BdOlKqLbGi@xObd@dNvc@hhBmK{BrCtb@jg@j[xaAtCoPef@jDqJj`@f`@kJ_NzAqRb^tOq^w^gCqZdLjKnNah@lM{DsBbM`f@nd@mHrw@p\\\\j~AgPrXhC`RyMaCzC`Pp^pe@nIp\\\\sEfHdMxClSoYvFbYhYrKuMjLtM_@oP~b@`Ym@kBfa@zJjGhQcGrJ~^yKnV`\\\\|o@Xf[f\\\\xKt[oElEyM~TdCbPcG`VlGlEpKhCrr@sXng@|BvZaSz|@_HxzAzSzlAdWtV|HniAt[|S~Lza@z@wVa[m_@uB_Tpa@z\\\\je@fCrGpKqGpTtWrh@_BpQwTsXib@lMg_@tt@vd@fNuCpZna@qXdAsUrWOuHdRhq@tsB|qBnuEbc@t_@p[dLpSqEdG|H`BnToLVlYdL`t@hcAzzAzaDzCxv@bi@b_ApuAxvBj`BjsAqBz]eIeD_BwWqGrEZrZvVfJoFlInO}JnIwr@eMcf@~xAlg@rNri@z|@tk@lZ~n@fv@nX{DhSwKjDmv@oP}c@iz@oEbGf]tcAoPhT~^dGtIbUiTqGoBfIgw@kEzYp\\\\rLoKbFfHaLzW_YbPxK?}GdLhn@cP|Gti@pDy^xE}EjLfMCgMbRyK~g@rc@}PvZ?pXjHtI{Itd@yx@fbA`k@cN`X_UlBr[rPsJCuw@tKjTbP}\\\\aI{EcGsk@dQea@nPhT|CaLeQwOgCcj@|O}Nd_@tk@h{A`t@`n@rMmPb[yKuCxK~XhFmPzNsDkEni@nCjx@xHnO`KgAuGgMkCeoBnUaBvuAx`@zgAyQtOdErEnY|XrSaFdh@olAdWcS~Mvh@kBri@{Tt[@tGlLlPwKpGpXzGyd@`O}HzRkk@fYiHhJoPriAjb@laAvJ|i@wAzWo_@kCuTzF{Gdv@v^``@uHpg@fC~Sa[bv@~Rx`@iPGqPiV_UvSaXdLxKpXiAhBxRqFfC{EwJ{HhQdg@xo@tc@iEfKs]|e@sDmKnGx]fI|Qla@jSnElp@mV~IgR`VhCoFjDz[~_@j_AsXtIfMfc@qb@pP|HdCeM~k@pXpc@jEv^_JxOdL`kA~SbM^zIcKz\\\\x_@`g@~CnYtThl@~R~Qe@@hK_UbMcr@mDg\\\\za@w@vGzNzDb[}GfP|GfEwT~[xHx[ah@gEqWrIzBfr@~c@zThmAxlDjzFl~B|aChdEn`DpvBxkAjpAzf@d^|\\\\jsK~gDjkJp|Fj|@zaA`rCz_Ct_AfhA|wDd`Gl_BrzDdrCtdCuKlCkiAm]uW~KsVwZox@jn@gRMeKgWvd@qmAsGau@qOcMmPvK{C_pAkM}Va_B__BuXuGrV}^ua@|Hal@sXlSsDvDiU{CaZoa@}v@s`A{g@kl@cLmm@kAgf@hLwk@{GiHm{Axj@wEe@aPqQ_FmG_QmGhT}P`JwkAqRmQvL?ig@we@}y@cIed@kv@ei@_t@gGanBhEa~@rUeEkUjW_[~[~A`\\\\oY{TqYmXzCsv@eUa`@r@yq@xm@rA`T`PbPdTaMjVrJrA|aA|QtKgC~eA|f@lKva@}I{AbL}a@`|@cSzBq\\\\}PgSdF^lQuMbBoAtLhJzYuRn`@aNvAqEx_@_T}Hi]hTsi@qGnCrLsSv@_CjLff@jMpG_Hdk@tLdSuCnVxK}@yZtM_JvO~O|Xca@mP{Svm@gIaDu{@t]bNYnt@`f@h^hdBxWjaByKlGnPyLdIuBiNcP`@a|@pR@ba@vrAxAh_AiKjEly@po@|Qzt@iHhPkP|`@zoBzj@`Pz^kGxHlpBjWtVuAdh@zNlXzO{Kh^va@lpA|BjTmKdl@jMon@dSeF|Yr\\\\dCf@iZbHeBvYxL~m@``Bjd@gDle@zV`J}F{E}Nxe@un@fUz_Anu@`L|@s\\\\dNxOdBaNcLgd@gU}Qp@uY|i@tu@vL}FgAiIk_@w_@ra@|GbZoEbFrUr`AvmAbfCdkA|{Dvz@~pEvkB|rBblBj|EfvG~f@neA^bSga@j[v@fa@sXpP_~C_OakChMwk@{N}RsPqy@o`Bys@yP}rAmjBgSaIgt@tw@sl@xdCufA`zAuy@rr@i~@dHmx@ff@cbAlUcT~}@ue@x\\\\oSh_AsWrXqDfWxRbc@au@|nAu}AluBmaApRa^bi@ab@hYuTjxCus@xiA_l@jd@fc@dc@kr@baByw@z]qPtZcv@bReuBpjC|Fbc@vo@z@~Bnp@jf@xRjh@zw@tC~`BkHvE_rAeCap@qO_c@~Lu[|g@oiBjuAiaAxiBs_@bWmh@~|@ia@~^aJdb@w^pEil@fmAcd@dg@pArxClZx_@lhAx`@bOje@iJxNhJhOiLxb@|Ol[oQjZ`B`y@iJtR\"],[\"rrdGbswVsBwVlg@cEd_AvV|r@uXhSlDeIlJp@fQmc@nQk~@aFaXxo@ah@iG\"],[\"bm{FbeqVte@}OxOha@`jA`yAen@|Ak{@ekAkFvGsN}FyZpOqCoGfKuY\"],[\"fyu@bbdZbPtIuXiTfkApNjTgD|KpXjw@jd@yVjB{~@aXgm@~Lu[{D}a@{PyWyh@\"],[\"fys@zbtXfXoGtGzb@uG`J}ByK{GhMmKo@oP|r@f@cz@\"],[\"n~j@`bsYtAuKbPkCjSlOfs@hDTj_@|NdOcCvKst@hAa[gLcOiR\"],[\"`ja@~sa]`PyBhcAlt@aP|m@_JpEsm@}Xg`@ad@aBwS\"],[\"|yWrxkZdNyHzQpDyKhTvOcLdTrUNpOnMt@aGja@}`@|@uBuJtU_D_`@{IkDvGaS}I\"],[\"pySzbo\\\\ve@_Yxn@|UrFr[kPnGlGnD_Wjd@o\\\\cTsVgC\"],[\"b_Oxhl\\\\h\\\\kWNzN|KkNhlAf[fIjUoCjj@ci@nBwdAej@\"],[\"xoKzng\\\\FgTbPePtWhQvLp[ca@zKiYoG\"],[\"rbK~p_^p]yBh\\\\vQxH~w@xVdYhb@rlA}j@vC_q@aYnBcPqm@qr@}Xwz@d]nGoa@}S\"],[\"~hJxza\\\\hQiKxs@r@dHhHd@~Ysl@xG_HlU_\\\\dEiTuOnB_K~QiF\"],[\"t~Jfvf^bCmM~VaGvoBlq@dsB`[`CnEoQdNzCfLnmBp_AxpCf~DvQ~Z`TxaAaGrVe]zMor@__@ks@sJyh@{u@eUiHcNyf@oSgTqWuHkWpLkjB}Gkb@s_@e`B_w@qq@gcAsLko@xkAjTsXkTaT|BqCeGnKuCoK?nK}S{`@nQoKaLfKgk@\"],[\"tvAhif^dBc[bcB~[tz@|dAbd@r{@QlQ}M}@oj@wk@szAih@_XwRaFyY\"],[\"`gJ`pq\\\\l}@hTlDwEzc@vEye@hn@jx@o]|NvGbm@cE~XfSxTtl@hKq@dShThDoUlHe@xKbPp@gOjU`HpiA|lBaBhRib@tk@xWmO`S_`@nUr@h@bMtIik@pk@xBnn@bR_jAfcA_GnLzDlOjIqZxMhVrMeCjJlGmPc[|X_Nxe@er@lIf]tV`RoGlO`JjFnMncAgJxCyDxSk_AzZgG|[|BbFtG}I~ZoChn@hK`Ns@`GgKrCx\\\\`PiT{GaPrTba@iAhk@oZdd@{ZpX_[gC~b@`PzLcBp^sa@nMpAk@|s@ySdXg`AhG?tTpNyM~f@gA}BvKjPmEhVnYbRgFfC|GtHiHdO~GeCzZba@bh@w@~JunAdf@me@gCpg@jKbbAoTba@f\\\\zDn\\\\ia@r~@cI`o@uQvQsd@qAxDrOf`@dBde@lz@_Xlj@qhAfG}MpXmTcQa[rHiTgC`RrJf[sJnVfTpZ_^p_A~L{v@|Xh}@qLrMzR_Ch^rRqj@vNh_@l@hb@uRp_BcPrZgMkAuJ}Sy[hLy~An_Bc{@`e@eX}Imc@bPmeByT{j@fPaIgGr{@qpCqf@rb@cKk\\\\hC_R_LuIiGzQyKiCwGuHFk`@uGl_@tVd]xRju@}Fnx@wf@~UabAnBmx@oK{F{KdKuKeN`AeWd\\\\i\\\\qHqGpGiYsB}bAsY|u@g`@rU{n@b@{c@qJlFeUlkA}xApX}iA_Pc{@aYwk@qk@eAqZiTkl@dEoa@yc@aZtOgiD~bAmsGaJexBjTel@aXdLoSoLyQud@}GijApLot@i@scAlOkRySwt@tEeGt_@dFeDen@nQqfAsGap@xUuyBvVmc@df@PzTrJbKbSbk@hT\"],[\"~tDpqb^_JlWqVvNgULgFeTyKnPs`Bmt@oc@ipB~VuXzRoj@dq@oOp^j\\\\ta@x`AfjAzwAeI~X\"],[\"l_Adhl]mSoDyj@{r@ec@geAoPeqArLqGmFqXjNu^hMkLxPJzDkMl]xF{BdLti@~b@tClNhK~|C\"],[\"qk@l}{]op@zGqSuV~MgjA|SiZtmAoDnHbEj@~WoGbl@kPfYkQ~C\"],[\"e~Dviq]hd@`pAmYeLa^yt@OoK\"],[\"izD`hp]oEuSxIkr@gDwe@gt@{aCJgw@~RsQb`@[zz@beBvk@vk@hkA`h@vLjVhYztAis@~x@^jo@oFrHhQdbAcUt\\\\wt@cB~XrOyVrI_p@uBmLclAvC}zA\"],[\"cxCf}v]tJnUnc@bB_Jjm@mMvM_c@wEiAgUayAuVeCcN_[jKqr@t@}k@ag@j]cK|iBrBbx@qU`b@pS\"],[\"waG`tu]as@z@aTsF_[a_@{DbCsKsw@bTo\\\\qXnGaPmRuCgV~e@mThZcChRdJh|Ah`CvAzR\"],[\"qsMnos]pApl@wM`D}WgDi^}]}c@cfAbO_{@ntAxu@\"],[\"a`Qbxp]qH|UyKRqO}^wWiS}Zmw@hGkEvr@z\\\\\"],[\"ifh@~lx]sAga@vzAot@fL]nTpO`^`q@gJtWw_AjWqIjO{y@gK\"],[\"k|h@tdz]iEfEkR}WFm^tLoSxVqHdKtr@\"],[\"b`qKj_|PwSxBbJcP\"],[\"dwoK`csPaA[{@I_@QPcAr@LZ\\\\Vf@\"],[\"|fvOr~p\\\\{H_Df\\\\e\\\\n|@jTd[iCdlAzv@vk@qEzYpo@mo@Ekc@}JmCdHoX_Uib@nM_LwYcq@vSsR{NiIbB\"],[\"tnaOfcs\\\\lTjR`[zCeDpPq`@zRo\\\\rd@cl@yl@kT}k@cNrCyGeJnIqRn_@_P\"],[\"`maNfa_\\\\{Gaj@fVh\\\\pJfa@p{BliDd}@~g@wAjF_l@yKe_@y\\\\\"],[\"~hrM~yg[hg@qUcL|]`Bpc@cPxK?uO_P?\"],[\"p|qMparZv@~FbXcDnJrMgCxKw]rPpMdqAkZlRax@_|@wm@eRmAcIz^gq@~k@fCeBfRnQjBhQaIuBaT\"],[\"bccM`j|YxKsJhEzNyGrEDpX~K`RhCd_@}Kb_@h]|TaY`E_HpQg^c{@_\\\\kUzLk]nGxKbGyKuCyHfLcQkI_QjIhB\"],[\"r}iAb{wRbAoI~KdGfIlf@\"],[\"_hP~j}Pf@k_@zQoCzJvKYpXwM`I\"],[\"xwnNlxn\\\\eUyh@dp@~FfWuFrM~ToLnS}No@iIfJ\"],[\"ziuC~ugT~BcMzT}Ctm@lM}DfWo^iA\"],[\"hrs@|wwXrp@ykA}Adg@wRxp@cXjD_HwC\"],[\"x|n@pbwYyQzHeIwNhGqH~SjBaOaWvJmJd]tGnI`WkBdQ\"],[\"xbm@~zqYdEgMt_@xRmFpPsOdE\"],[\"ri_@tke]vJmb@bMV`]zs@kP`LsZoA\"],[\"tulQpydb@}DiDK}LtCqD\"]]},\n" +
+	"{\"countryCode\":\"BS\",\"polygons\":[[\"i~aLvwpk@vOj`@er@nMoa@}s@vb@uq@pRjM\"],[\"axsL~nsk@yEvOeM{Kcf@qGfO_OmBkh@zXsn@L{MqLpGvTerAx_@mm@|YvSgm@zhA|Bbd@}GrRnPht@\"],[\"idxL||yk@_Njw@Nac@\"],[\"ojzKll}k@o`@|KtHoTaRiq@mb@}V_Cyq@_XcZli@y[|Qkv@wT{y@sbBobA}E_KfOgQpaBhQ`n@xf@xu@bSvXzz@{B`ZdLf]aGcCiGrUnPp_@`Fny@wLrbAlSv^kLhN{s@|OqKe^\"],[\"{xyLjzil@hj@`Tde@pi@{YqD\"],[\"}oyLhfdl@cPxKdGsXiKuEkC{QqLvKeGk_AdGgCf]vTuOjKtOiF?_MbHgCjgAcPjAvI~b@rIt|Av{AvS~`A~p@vm@j[vr@{`@yABg^{PcWkKbE}GsOqGzGaRwo@gMaNcU|AoHck@i_@oOuLop@uMePgS{DeXlHyJdPUlk@qPd\\\\\"],[\"i_{Lh|il@{v@tg@mGsCkCkTbPer@dLmP|SiC|GsOnGwn@oGrCtOer@df@pm@gRjGpEtZcJdm@kNrOzOjQ\"],[\"}}aMjx}k@xH~pAwQfKXaqA\"],[\"eeiMnrdm@nG{n@rXg`@cR|eA\"],[\"svjMzlgm@jRyHpNoVxQms@xG~G_S~hAuDxJ_LeDeVfa@tDzGgd@`l@kDhx@{IkTaa@xP}I}XlRoSdEu]nGnG`g@{g@\"],[\"uy_M|aul@jh@aOz`@bCuEhJeTnCdCpGefAj[ge@n`@uOhnA}k@|lAuTmPrXg]}BhTlLuIhScg@PoV{t@lk@ap@uOqm@vJkHfHrG|GwMc@mQtVwLg@oH~RysAd^zBvTcf@UrH`LcLkKbLrXwe@gLljBitAhi@sFdwAkv@nrAwIvQqInm@}|A~h@qg@\"],[\"q_nMp{ul@gGyu@nK_UvPhI~BnRrG{BgLx`@nG~i@gU{@\"],[\"wfsMfvqm@sxAtn@~HmN\"],[\"sdtM|mml@je@vMfCbh@}b@wPyv@?eZqg@|d@qVj_Af]\"],[\"mkwMlbpn@hTdr@sTnGgCgUec@sw@}@a_@nH`E|Al[\"],[\"wzxM~{pn@pDbLdOeC?ba@gLnOaP}s@\"],[\"o|wMjltm@gBzQ_o@h_@\"],[\"emrMbdin@`j@uCfUtIOxVrX_Px\\\\vKhErRaRjk@~JwIpLvIyP|[d@vf@}GkTwIdBvIem@kReIoSgi@{LmH_CdJpk@z~@s@|M_WvZeX{|@gL}AnKdT~C|_Aou@~PuUaB{AbHcViAcOrSmH_CkTdr@}GaGtBoKaRd@wQub@qX{GiCo\\\\q[_ViZiq@t`@oZvc@pk@`Jna@tHwNdIfOpPcb@fo@`YcDoT{y@qe@{i@mDiFyRtx@gc@|jAoAfTyKhPxKtFf_@xNfAuRya@\"],[\"cczMx{mn@nF~J{F|IyZgt@xJ_V~G|CaFn\\\\\"],[\"{~wMt~}l@ll@eNqG|v@lSn_AgIvOyKbFq^saAkd@{AyU`k@_~BlbAgIxc@cd@hB}k@|_A}VgN_@qMna@sr@rr@qGxWkXro@{Wlm@ab@l\\\\in@na@gR\"],[\"sbgN~ghn@qE|g@sIfIeUuTcMok@gCogA`Osn@jb@hgAkG`b@lPnR\"],[\"_s`N~iyn@oGqJsVwCHaI`_@iJfa@zWrJgC}Hs]wQqBhDcKlb@h@vDkIu`@kCfCkTqsAdi@`B`Hxe@qG}a@pWm\\\\oF}^y_@kNpAwEgUcc@kT}OzHm`@_Ssl@fFsdAb[yLgFnCe`@~W_d@wMg[|Zui@vSzIrPeN|m@rE`O_\\\\~tA_e@vd@sZvo@ox@j|@fIz~@yTxXfS|YleA~XdDfd@|vAdSUrDzH{HfNww@rPiNv`@_|@D|Un[K~[sg@fmAqk@hp@eMxF{Lg`@eMcF\"],[\"qojNxonm@v^g]ncApG~YpRld@aLni@tDd]`Q`wAqGkGvSg{@dYqd@l}@oEwJpHi|@gWcVq[cC~QzQaV`B_f@}GeYkYap@sCw[dJ}f@v|@ooAlbA_QvhAqk@n~@uEpWoGlr@xKxk@gf@kWom@oDfCme@`j@pGwJyHpWiQneAkqB~HicA|n@ci@\"],[\"gbuN~{nn@ke@he@yKBrF_M\"],[\"sx`Oflin@tVtTaj@hC\"],[\"cheOx}}n@tZ~z@cIlq@wwBloCdu@idB~UqQpCkx@oWun@qm@gg@_NnOgC~XqXkTca@oG|q@}l@fQqr@hGshARqScPkTxHm^gSioCg\\\\eb@dd@jB`a@sJnMxOcM|[hXveEx^pmC\"],[\"wolOvwnn@pGz|AaU_z@bN_tCcIkMx^uz@fcBqqBjLko@pGba@p]aUbVsfAzk@}`ApTyCvGhIzZBxOyFlEeQdR~GvWoIxNnZ_KgCdAhS|d@fq@fmB`Pl~A]nRnUyFlOky@xe@_Htl@eNpKsBoT}TgOgEkWwg@ot@ic@tSga@mOqW`AmHrIkY{Rsx@dFsHeT{YcE}NyPoSv@gXrhBiy@fXv@pb@od@`JqbA`w@`Ax_AgKxgA\"],[\"y{iMf_po@`@fAyFzJiAb@\"],[\"u{jM`~po@`GoEqX|X\"],[\"sxqN|eko@Nk@`EwAMj@\"],[\"merNprko@mAI`FsDlAH\"],[\"sspLh}em@hKsC|ChKmQpH|AmIkM`C\"],[\"ohqLflfm@VhAs@hAeO]b@wAnIs@\"],[\"g}sLhugm@~M{BoXxG\"],[\"wlxLjz|k@OgNhPxR\"],[\"iwpMb~yl@zRfD}HzB\"],[\"wozMf{on@w@wUxQpT{CfI\"],[\"sg~M|nzm@dKa@JlIcR|@\"],[\"}foN`xon@WrY{Nuj@\"],[\"elvNhzjo@`XsAh@dLeQ]pXvPpLmUTrYad@uK\"],[\"addOttcn@hUaA|AlGiPxM\"],[\"opfOrqon@aE_Kb\\\\bTeWlNwDeUhJhC\"],[\"q`rNjlzm@|BeN|@|X\"],[\"itqNbq{m@l@gKfMbf@\"]]},\n" +
+	"{\"countryCode\":\"BT\",\"polygons\":[[\"ehcP_bfv@`Cq}@iNsx@zPcd@bQcqBr^_h@`K_o@qDmXy|@y~@m@{m@qJyK~d@__@lUko@ja@_hCjMgH~f@hNX|f@|Q_@bWsj@hh@tDnc@f\\\\|VbCxl@sL~h@}e@`Kqj@yF_zC~Ni]p_Bwg@za@n_@`p@pXv`@bDdcAqp@~b@qI|]|ErS`VbD~d@aSz]u@jSlPo@vDlLjGmFlPnCc@vH{Z~JuDvJja@zkAqCvbAfGtl@mi@l[wBha@bGxRzf@xYpKr]iPn|@m@`tA`Mda@lHltDeGxoAydAf_CaBbTvWfg@nB|l@bK|Std@vNvKrNxU~rCkIra@bUhLyPhoAvFbn@oDtFc[uGiJxNgKlq@dC`f@yb@f~@nEpi@hR~d@gDtgAqd@jUoRlWaCxv@ue@`OhUha@c`AxBe]fJe`@|{@oe@eNkZg\\\\uQcs@gQyCsHiMpJmb@qJuN{~@t[ed@}RqVzAot@ot@gEePzEwSigA}l@go@uOie@mdAidBybAs_AchAc^mxBwZuN{h@gr@kKg`@rCwUcV_iAjMmr@sUgl@jMsUtU?pVqQnBwVaZiN?qSfIej@pS{]hImr@pSeEhn@|dA\"]]},\n" +
+	"{\"countryCode\":\"BV\",\"polygons\":[[\"xhe`@yobAlFkQpW~J~G|gAeVfB_PwRaFa[\"]]},\n" +
+	"{\"countryCode\":\"BW\",\"polygons\":[[\"hpzIkjlNpt@fXxjAgUdi@{X~j@}w@paDsjAv~@_fApg@s\\\\dg@wx@xbA_J~m@kU`bAkmAxp@}O|~@~JvvAst@lvDujAjMkWtIccAhUwQ~VhLhPsl@zb@e\\\\v\\\\khAtk@}[jP{o@nZaXzJg|@`a@weAvDm~@vc@ek@`Ni`AfwAcg@hhAgJdyAvHgMel@Dk{CjU_bArb@yAnk@pKriA}OvcA~Sx~BzDpf@sYhf@{s@neA_x@`oAwg@xWlEhSiGlA{IlVkKfOqY~NssDnb@exAyM{v@pEqe@|cAgxChXcnBpRyJ`j@xWlYpAhq@uOd]ei@bAaqAx_@mTne@yn@yA~nAzRh`@dEhpA|t@`p@zj@yCxd@lV~FdJkAdh@zYrQlx@|vDoHlf@jBpr@~P|m@j_@~^~H~`@`\\\\xUt`@|A|InV~OpGrKhb@bSgClYjIv_@l}@vg@kDnP~Hx\\\\nfAlJcCj@`]bWmHrb@hUPlPsP`PhT`_@yElWpr@|ZfLyBfXxKhNb{@bPdPsMdHvSx]kR~Erd@~`@fXdy@hFzE`K{GuClTdLzGiFrQf[yDuBpMh\\\\zVgCpGh_@kD~DhS_PzMbq@b\\\\xtI~nAbe@lzAnl@jj@`d@tx@leApe@je@tg@uAnmAnn@vdDdQv\\\\bH~{@buAeDnpA|XnvDhvAfpA~RdoBrn@hx@poAdS|k@jKlaCuKxeBde@p`AtLtp@u@leBeq@daChIdi@yCl^umAb`B~PbnBmL{@qG|K`Enf@_aAhhAcI`WkVlOwH|a@kpAzuBgYhVnEto@{PhgA~@~]vc@fyAuMvI}@zM|w@zcAhd@pShRzYlt@bRtWcK~JbMp_@Jf}@pj@|]sNhg@lb@``@oJb`@lI~Mp]`RcAn`B|iAt@zy@`bAnbAlQbz@vb@x^|fA~^n|@hv@lStc@lPngCpElFzp@oDnUnHrc@b|@mIrfByM`l@rY`}DuOpqAcWtr@`Cza@ht@tcBsuApn@}h@rBww@uMw\\\\|O_a@?ch@wIq}AwpAqyAav@od@lV{mBbEym@|VfDhQyPaFmh@dLq\\\\r`@{Y}CwLpSeQ}SqO~CsU`[mPe@oSqUsBd^kRkBd@lHaQpDsLr[uj@xR}PuBwKhRmSjAeRp\\\\sRoEosA|p@w{@voAya@|nAug@`p@ef@|\\\\{vt@|@?alR}U_G}dfA`EkK{wHumDal\\\\cLsaEtZsXdR?zv@we@nP?y@_TbVuTnFkVbXcO~HhDfa@gYbLzGn|@kSbEeRsc@eaAsqBezBqT}@wMwTzBwKog@st@kE{Zc{@}gAnGyo@yj@{`@Fkk@z_Aos@uCwc@qcB}fB{_@iw@_Q}cAyL{L`SwQ`AwUmIkPzK}D{G}f@yRsS\"]]},\n" +
+	"{\"countryCode\":\"BY\",\"polygons\":[[\"kem^{`lMuVr[?oGkVbRmh@zEcGoP_J|GoBeGoJzFqXsG_Sub@qM_F}ShTiT_Paj@iC{\\\\y\\\\ow@hWcVhc@y@xh@kTjPkM{@fEnI}GvKbA|c@uIwAqXx_CkR_BeJ`QshCulCun@mmBcQogBma@_rAuQ{WuYqG}oBjLePqI}`@dCif@n_@os@oTqw@dy@{kA~b@sxC`~A}pAhOcYxT}_BtZtXmwAqQacBzCuXiPex@pIomAoPslApOed@~VoPnCojAwk@g}AeUiuApQgW}E_~@{ZkHsl@hWwIiUgNcAqHmq@~IaiBeZys@uq@a^pDeeB{^aw@fi@cz@|Dt_@hOaPtK~YbR{Q`I}tAiPocAme@oDmJyI_\\\\d^TpKuWnMdJnqAeJ`UuNYin@sr@cu@mJqd@mcAoWHqa@tRy_A_G_MqGcIgZgg@sAyU_g@kSuMyQwfDwt@wt@mb@gDyPsRlFweB}I{ZpNunAaz@ab@sR{bAsO}TyO`SmItfBnDho@{Jbn@c~@w]uRqZmb@~Ei_@uf@i]qFq^fGcLeLdBsnAoM{p@kl@io@c]sq@oBmiBh[wdAoZcu@hSwv@xAe_BkoAu_@aVc}@mb@_Vu\\\\e}@m[_\\\\s\\\\}qBhfA}{Bu[ip@@qiCde@ej@fi@cObG}NtD{|@qMk@uHsOy\\\\}iBrFsfAbTcp@`RgjBnXo]zOtd@`y@hXdUkK~j@mhA}v@a~BQ}jA{c@{f@}F}VgAgq@pNyhAqUwy@bPak@dVwzBtWmFhUwaAnWjArG}FdGy{@bc@i]kI}`ApEaW`QsOdViCbUtKfCoKpXxVjZiVjKDlg@|aAnVpI~Raf@r]qQdYyl@jJRlGwNlQpG~o@aU_@vx@nFbCrWmQ~JtJfOb_Ap^aFv\\\\fc@lZq@dq@gbCnToJz@kaAd\\\\ef@xoAp_AtZgyAnk@yWjQqUvm@_HbZ}Nht@qpB`^_oCp[qTaBa_@de@}MzQjDtp@jPxu@zg@bJioAiSobCl]g`C`\\\\{q@H}j@~Ua]`Y|w@bm@}Qv^amAnNaM_@}l@nQdAqA_a@bMmJtl@lHtReGpE|tA`I}H|DtO_@nfAbMWx@mM`k@ta@~Mfk@va@~s@Mt_@lPbHhIjs@yJbbAhFnp@aN~rAkm@~WoM|wAhHxo@eBtiAfJtVb]rJb[kAvFxZve@`l@hVen@tv@yg@lWghAfVwJjNaVre@{DvY~v@l`Ao|@pQ_^pFbJyAd_@zHkI~FxNhQiVzp@bB|QwNvRhBlQbUb\\\\_g@hGoi@~]rIp_@cq@v]a@qIfmFnKdp@`d@bn@{X|qA`BrtAtHhXzUtCp@gQlOMyAtZlXhWpJha@|KuCbJ~Lt@`\\\\bNnC|`@hl@`QkDfQv_@fCoPf]fLzBda@tn@pGlK|XdLoPlKxKvKke@xKdLvFaMfPeAdHiQpHbSfNe@lD}Q~^cPbAfGrPuBbSnc@xh@fQuNpt@uQ`VmGrl@aUvVsQaGyRbEmr@fjBfK|bDvT`c@}Dtw@sZtVoCze@xXnj@pFdf@v_@nW{Evf@`Rvr@aDhM_d@`SeMdVuf@Xss@hh@qLxUoB|d@jIvWxZvYrEfhAlOxf@p]x\\\\ja@JbPfMyXnNjDfXwHlO}_AzSkJdxA`ZpnAw[jMcPd`@gW|FcF|T~y@~uA_AngAo\\\\np@aBtZhPzRli@jCn`@zo@gQj^en@uX_LxFqTreC~Rti@rAlwA}ClGya@uM}Fhv@as@|EaEvOyC|oAvL~}@gCfh@q_@duBsAphCqHnOqQbFyBhpCoZ`z@kPpbEjC|bGcPvdC~VpsArPpnDcEfr@pFrzCv_@pp@|s@nWlNrPrqAfcDpA~WwVfb@kNnsAtHljA~MrTbm@mTpHzA\"]]},\n" +
+	"{\"countryCode\":\"BZ\",\"polygons\":[[\"kd_It`mu@iqKmZotP|AgQiLaQ_s@rbAgnBiVkH}U{^wc@yPyYm^az@eVqIlCyb@wp@_]yD_j@a`@u~@{O_RcW|@{rBrJwIrx@f`AhI_O}K}\\\\lk@hXaa@mr@~Isq@wTe|@zOj@lWt\\\\iNe_@hlAcF`bA~EdqAfa@xv@tc@`cAvBtr@hNzt@p]|MwAb`@mg@pHa_@bKpFdBhf@j{AnZbdBzG~t@eLpFgRpTwKro@eGlYl_@|SdIzo@uT`EbTxR~JfiAzAnt@dYtYaAvJhJyoAwP_PoMdGlOdw@n]tCyK?`PnGwKnMhJjNyAle@fc@hTbAzBfMhhAfh@tCvTjKfC}X?pNhPgOlXbQpCbPzt@`n@nB`w@r~@`VnDvZpk@fw@oGzGtVwLfv@ZttAdKv^eGdD\"],[\"yrrI|qrt@uEyFdJgF`]oIpXzBqGfCpGzG\"],[\"_uuIhkrt@nGaW|Tg@vl@pZtD`HiHjKpVpTveAnOqGzGwrAoRqZu^fCmVuP{IaT`CnGlP\"],[\"cqwIhgwt@~EsGdNeEh`@cE?fC_EdB{MlD\"],[\"wjxIh{xt@e_@{v@x_@jS|Fvf@\"],[\"ea_Jz`tt@zr@fLdQnR`F~Yy]mf@_b@~B{yAs\\\\iIw\\\\lUeDfz@v^uCnGbPxKdLsC\"],[\"kcuIvrwt@xOmPjQzDkTbF_J~P\"],[\"yi`Jtcut@xEcClDxJ\"],[\"c{`Jdqtt@lAo@vEMnErBtBdDMdAeBXoA^cAa@w@eBuAiBoBc@iAc@\"],[\"csbJ~jst@`@iAnAKfBJfEfAhF|D_BpBuIu@uBoAl@}@g@IwA^a@GLcA\"]]},\n" +
+	"{\"countryCode\":\"CA\",\"polygons\":[[\"}fc]jray@p~EY|JbJB|qyOoN~PlEzXgErQeKjMoZeD{ChWpVzmArHzHtYiG?na@wXbJ_PtT}e@mv@kPoG|Ttr@yGjv@gn@qGdE}d@}`@l|@kNzEmB{_AmKvJsDsMrKuQmCgj@vGcp@k_@ui@qbA_VuHbMd`@pA~o@xa@zJrr@oUpvBrCfc@uT|MsXg]{dAnBu|@sZyPdBwYgc@wFrIj\\\\vn@xg@rD~Evk@tO`P_AxX``@haAjFsI|c@yFrTtObF`Tco@tyCkFh`Ao`@dw@m}@nt@y\\\\t@y`@iZ{Eoq@pcAc`Ax`AwQpMoPeUiNsTzJmTeFmZmiBiLwW_RmIt]zpArFfpAsz@tv@cNnZiKxBag@m_@q`@b@wKjCsM~`@_[jD}Xen@eE{j@{_@k`@mg@dm@ke@dfBx\\\\i]lJuf@r^_m@f]je@nZllAbo@sA~f@yo@rX`PrIhf@k_@rFmKdLwUcFeB`Hna@hV|PsOrE~UzLcA|Mjh@jEzmA`MaCdBtHkPrZlCxn@{QncAi\\\\xJun@lhA_Tpr@y}@l{@mIiDbt@usAaa@fKaYkSyK|Skh@eo@ik@{Nm`@bVoV|l@qMmUkVaJeGqd@zCah@e]wkAiX_a@mMj@fk@xyAvCxgBfp@to@pTbq@wE`kAwFxEuQwIeLbCeXha@zk@kFfU|t@mWnAeG}Mw}@sAwMku@uU{IcJwe@oPeLkv@uBif@gXiKpFgNdk@kHpAqn@kt@iHsVsYpOCf\\\\pSxDv^f]bUri@~D}QnVwCbOk]ho@tNbRqIhoAfxBvNlL`KaFzIrHfIdj@u[r[kQkAwEnI`XzYmCtOkj@pRhDhPz\\\\bG|KvTpE|dAmHrSgUmUm]_GkTpGoe@mNaf@}x@mCtQz`@b~@ffApLrQb[|^{AvPhWbBd\\\\ya@nx@sOoa@cFl\\\\pd@xhCkC`^aM`S`F`YaFldAoJ|VqN_`@`Nq]qa@?wY_iCkFeKuHhL|\\\\f|BTds@iQ|SkW_nCdGmcAyNkeA{AojAm_@yOaNqc@oMqFqa@~YuJuBuEqt@_m@w_@qgApa@g]nm@vtAw`@`PqX~R|S`Vx_A|X~BxQyXdI`AxCpVri@fr@s@beA`Ord@yRh[b@`MnPd_@jGtnAaL`X}~@cwBxGfyAsI|Iuj@{FbNt]kKrCdFn{A`Uv}@}Ktc@yWjHg[uf@xIgkDcTk^eHrBzNfg@wMbfCcG~Mg\\\\xLqg@tDpk@lVv]{NzGdD`UdXrPv|@{Fvl@{_@v|@xUb`@H~nAoSruAhGfDvEeJvYmqCz]djA_Zlv@qH|x@_VrRhBpa@{QbiAoEdJqIsQ_GbCyK|aAix@oRaHqJeEnL|LnbBwFjN}JbA{Vlu@eRpIeUwAiNe`@{IycAyNia@|N{wB{GcaB}]_z@id@yDxe@tc@hTbaA}GzpC{Uy^wArKlPbaAQjuBeKbEgH}EwSifAic@kd@eH{_@sOPgRoV_e@vBuRgQ}TemBaGciDhM}cFcEuLwMmCLyMcUeLov@df@rU^~[qMba@ha@gWrvFlJzdBmPfx@tNvAnI|i@wGxMyKyKg]|B{N}j@co@yNcGbG~t@jZvDbd@ln@rWgGjw@jFdf@jPc{@y@ml@`GyDdZbx@jYtRh]uJd^|^vK~w@uUx\\\\yK__AyKrXfCb{@gn@zBfBzM}[zEwPtUeg@oDap@|O}_AoYySss@{Ba}@qlAoPgd@uw@qC_k@dO_ErOpLvAru@vg@|\\\\s`@wm@aCmiAsHwNqo@~[aHk`@oSsXoIoy@ak@}w@zJklBvZca@dXfAvfBmrC`d@_Wkd@AqQtZw^lTuh@|rAkSbPgReE_]lUmRcGeLw^eGawA{L~JjL~bBlSxv@gEpwAcWp[cNmEoZn[wh@t@ib@yo@yg@qgBqXeI_PkTmL}Bmz@pZya@vaArk@_d@zv@eVjGfCdc@xh@h}@`_CtIgAdLtOdRkKrHhCvb@vqBb`@rYlPzy@fS`PcBpb@~Onb@mBtNun@qXhAxLx^z[n\\\\`J|U|k@}b@zI_DjNsRpJmRwMyMse@kp@nk@s`@y[{ExH~O~QtXrCpXaPzKjAbf@tg@_Nb]_d@~\\\\qp@wE_V{i@`Im^uMnBcGsXaYeLvMv`@`Gh{@lTbg@bbAxl@lpAzpAwKtz@kTwe@cb@a@_Ioi@ic@mTucA|H_qBygAejAbCgZs_AzAtn@cHvSnf@rQtd@`q@iNvxB{h@`a@_sBdWuIqXoFz`@aKpCsIkn@{Ahz@mx@rt@jHvEjOiGbDbLst@phBy\\\\~Ti[wWmBxYuMsGmOxFke@qa@f@x[fPhX}OpQgk@~OvKucA}Um_@r@oM|m@sX~Xin@uTdLlGca@vu@swA|YgAu`@kFeHlOwTqoAgTgP|Po|@d@anAhKcVbSmKje@hTsUg`@rRgm@~M{Kf`@{@`Ver@sLnBkWza@i]?}Zx{@yr@jr@mMYfCbuByLfm@x^~[zJ`cAcXpg@eRdJgHxm@y^rX|CbLc`@rc@yTdDmk@o[gWlLyVoIiM_r@yVyHbJq~BgLbPb@rk@_WzjA_l@mc@aY}CwIkQlQds@dq@th@?jTjP`CzOh^bU|Lr`@pk@ui@uOaGlDl\\\\bSca@gC{N~NoD`ZfSsRxv@{AtHzPk^z[b@zHbe@aRfPfp@rZfTb[jr@~~@fIzC`J`RuOzZ|Bve@z\\\\eFf[soBdnDm_AvnArCsXeMrG_O|d@kTtO?jYqr@j_AqG|\\\\mVyThGnc@sk@bt@uMgJadAbB}m@_YsEov@jTwKj\\\\gi@ra@yTlPer@h]}Xoa@~QmYpp@qo@~m@qWfS{JeBwTuv@zVklC__@ktAgIhIpMbn@lPlPsU|jBZ`iAba@jrA?lc@fKh\\\\mTb_@_Kv`AmMbF}Nk|@}M|Zy`@vJ{g@oDd]fMfN|n@iHhcAuNdNak@uIoIqt@wXxa@_h@sAp@o[wFuB{[ld@uDgD~Kyi@vx@eu@~pBkjCpHyy@qFba@ec@rk@gu@sLwHag@aL_JeDfHrLfi@fn@hV}h@bdAko@np@_HsCi\\\\tn@cSzFmQmT_Jy_AlVm|AdRcNpMu]tEu`AcF{OeE~cAgf@tt@_UpmAc{@g]mKca@g]g]f]flAscAucAeKcYhIyg@dVhS{TybAaX}a@lKoa@yGeoAqKyHlHf^_FdbEkIdGkp@uZkn@yz@mr@k\\\\gPge@ue@uReQw[uMUuW{T~@_{@kOei@cKl@rP~g@aAr_A~Zr\\\\kCrJyZ|@uUvVm{@vUkO~HhBlJ`p@}Ghe@}SxZbG~QhYxR|BlBoG}J?qGwTjKxKtMeNhLfTh[`@hYxa@pp@tTpj@ls@bw@td@xFrOiD`Yaq@rY_bAov@qq@mjAiKiDk[f\\\\inA`Qmu@`j@wqAiGaItR_UnJiZkH_wAm_B{FtTzNvM{y@Ker@x_@mLh[vH~y@}RnwBk_Ati@cVlkBas@pfAaPfp@_UjbDosBl~GNvdDuk@~Ael@va@gb@_Hyg@jrC_iBen@o_@h`EwgA{cAa|A`qA{~AxuBkmF~bFsnAvaBcvAnw@oqBzzC_YlH{RuVmc@hk@ss@~dBgjB~~B}lAlsFee@dz@_d@~FoFpj@ue@sFce@zf@e\\\\zbB}U~]u`@xRs[`eDoa@nc@q[iMcV`_Akc@il@mh@mCqQlI{Sja@oItj@ym@x~@}y@hjCrmArrGd[dpEfQjx@tUkx@lPsGbY|Q~Ujx@oF~}@rkBUfgAj_AfJnZvB`mCrcBtrGtp@fwAvCzf@gHtLco@gNeeC`|@mShQq`IjhRi_@la@cy@r^ep@xyDc{@lnBwOTw{BwcAwX|BzGdvJhiAdsCxKx|@caAd~FpAdWbk@hk@ut@f_HqpuDtElE}aAtCrCxCqS}Fs`@zUyaGkKmoH~X{eEyKflBbOgm@xQuqE`Pyi@`Ao}Adx@s|Alf@emCbn@cpArXiToGwz@fCxKzGsr@cf@m\\\\zGv`@eJmN}@yj@~m@{Zz[gt@~YiqA`lAygJr@my@|EcYrCf]~Zw`Cz@ooCxKeRf]efC~@qr@yMme@zBkn@oG|GsDsWlQmbDTejBxf@wqD`\\\\kp@nKarBhb@kaA~Iq@{BciAtO{wAuOze@gCeLaSfqA_b@z[wJty@yZfZfCyeB}KjnCgTdc@iJyLxA~bDsXhtAiJcFlAak@s`@di@oNoDyCgPpb@erB?kv@}VmdB~G~lBmZt\\\\kTpmBoFxI}gAlR}RgTn@qWfnAq~DjOqjBwRtd@_Mf|AsbAxbCoQ|JqR__@iDgkAdM{UjW_JtW{d@_EoEyr@`b@mMkZvE}sA`[yy@fO{jB{JBsSpl@cYdBac@rWaPuIuV{dAu@_QzKqJwOml@xFct@yIuu@tEoZqKwFzKcP?cuA~VcfBkLo^eS}DaKdTUf^vLbWpGoGbC`IiVd`@}VeL|Ga]sCw`@mKjKyO}]s[qUoKzA_h@|u@cBuIxFe_@kDmcAxOfAnH`RbD_KcDcdAf]{m@vM~j@dSfMiH_b@vMyFbNc`@sGwgAbPz\\\\zJ_uAgFm`@oGjKkCqKqCse@iCtOgJiJc@eQjHEhF{O`HbUvYoBpObVrkAhjDh\\\\hD?ti@xNf`@vJfCpLtYfV`CpXvTuHyh@{h@_]_K_UwTk_Dia@mg@wGc|@xKtCuAij@yDqKqGtTsSmg@qJihBhAo_CpGkR_[{tArEmZoYpAaEoY_RkVqJ`FmSyO}M|U{LoHg\\\\gv@?qgAxKlPr@an@iKi`@`OwvAeHsyAgG{UmNuHoCtJdRjdAqLwRsNbK|WdoA{Bf]wKqXi]uVqRkhDpAsKfTrlC?gwBfL`P?er@|Oe|@{WkWeGs}@uQ_k@wB}cAqTcf@yHdD}DqH_XyoAmKohBgJwQ?`Po\\\\eXvPygBd^mWxXdIrIiQsXwT?vTcLyKqB_s@uiAst@kJok@?b{@in@opAjRvMdC{Gyf@soBjB{GzU`WbFaNeK}c@_W{@aBus@eSuTeAsOj@q`@xLxn@xJtFfQcRuHeV}BtXuFcKt@{s@eHsKoEfCwAiLfRmSiBmP~Qd@jAeUvKn\\\\?mv@wK{v@tSjRtKqAud@mv@lFgKzLlK}MoyApA}JtMdc@S_e@uQ{l@gPrBw\\\\im@eLrX_Pya@A{`@sGzJ_GgFqGca@bG|BjByRjQbBdB_WlLmMnPrXAqv@dKeWTq^hQxZxOoR_Za]~FoG``@hTdN|gApGye@lWj`AhkAh~H~PvaBmDp^j]hz@lKpg@cA`]dNpZjIaC`UnOxQb{Brk@tb@dX~y@aTrv@qZbJtCthAjSzy@k@jt@lPoG{Gzv@vKdr@{BdfB|MyHjJvE}L`SmF|f@tSfsA?zv@da@hz@vIyRnM`d@bLuGfCf]lPye@jNjUt`@fpCdXsRhJ~Dse@xe@hCz\\\\~^?rIb_@pGcExe@dL}aAxJqMtP?hn@bP`]fCd_ApKNfPlm@tAyKlIxCnb@nm@vJ{FSef@zU~h@dSjDj_@aP~DxE{IhVuMqCyK`PmVwKnOpy@oIxo@zLnJlFfs@zPzGxKqGxKn\\\\vKsXhT`P|\\\\hhAcPn\\\\qJ}VpJ~aAvT_Y~Hff@`_@eXl`@{eCbMcOdLyxA?yxAmOv_@a@xf@|GiTaIpyAkNhPuHvp@uHnBiF}ErP{cA}FbImQ|fApErc@yDtQoGyKmPf]yKu}And@woAf@iYoGtCeDiUtLubAoX|TsGS_BeMjl@ceCkK_H}KjSsBaWvR{iCcSaNSwVaP_P{`@t`BfGfQdLwz@{BbtAbGpTrK_EjElRo@xbAqL_CeGui@uL|aAkGxHcIqLdEqsAySng@uNjcAsMhHyMyB_FaSqGl\\\\{NsTmD_nBsVsr@{Ikz@wMkRcNhA{YwXjz@zHtQ`WuUo{@gi@kNoIgLx@_SzNNpEx^`Gs[_Vm|@dk@~SuBmKke@g]qJvCsFyGvFwl@_OmkAgNmEuT}m@hRpSv@sSiUel@}p@}x@|M`wA}M|@sEpT|@vr@uK}g@CadDyKnQiH}@wF{d@xJutAxLe]vTte@pXgCxKna@z@kMeVy}@c^}Kf@gT}SxKzBe]yLtCwM_Mvt@yl@ex@xQ{AqLb^gRxa@iEjUrbAz\\\\`v@pIhC_Ksh@u]at@aPs~@wTzBkKkKcKlKwP}Bw[rk@aThMcEuFjf@us@nBiZ`l@`F|MqGtTvTdI{Dg[s]im@aPyZdLqc@zdAaQyh@pEyTpNu@xCdIh\\\\}`@fj@wLdaAj_@bRlV}X_p@ybAck@}YtOkM}Ep@_WrfAqDhc@dc@`Pba@pQyPmk@}g@}Hw[rEoE|c@`Jta@~l@nMMbYtTfDkJlM~HjQqFlBqs@oWnOyTeM{]vMoGgEm^_n@}Nil@cQtP_VyuAeZcXtCv_A}pAg|DmVhAsc@seAcG~XwI{cCio@uaHmUq{@oRklHcTqp@sm@{o@kFqp@bLiPoHdSfd@|LnI`[l]sNm@dpBrHhd@na@sX~l@`LdLqcCiBqLuRha@gGmCgOueBidAw}Bky@itDiCnGkKoGuTdCuHxQcc@jMgSsSyc@ilCnC}hAgPoaA{PgzDvHwk@_Qvh@wYnxGul@dp@rJm~Au[s_@yCnU|O`{BgBlVcL|Ly[{Ai]iSkXa[ob@knAzsBmtIr~A}_EnvCm|CvTscA?vKzNeAtHoMhM`YhGwA~pA_`AhvCk|GpdAs~DlPmpAsCykAxK}m@xKaJxS{xAiAkUoQyYyKxKuG|f@kGrJqGoGjHiQ`GcgDuTuZqGrOkKaa@?ve@_HiTD~aA~KjVeCfX_HqGgBrHd`@fgEsXg]}GrC?qr@cIpFkL{PxEqlByK`PsAeKlNij@dGmpAmPllA_Tl`@kT{m@_P|gCi@q_@gF{D}G`Yu`@i]?itAkTiw@?aa@}LcLbJe|@~r@wSkPqb@cUoJc@wWoV``AiNjxCvBvi@aX|Xy[a~@n@aH~_@x@t@e`AeSqXkTjAgj@geAuZihClBab@wFuDsVda@f]na@dAbOwIlw@eLme@kBbKCnv@lKzP}Gfe@dLdf@pGsCbGdr@|GaP`GxaAaGbEoNoTuQhLkc@ejBeNaLpC}QlSrApHtd@vBw}AaGcX?j_AkSilArF}OgLutA~Xkn@pHvAz@}`@mJoJ?vT{MgTrJ}m@uDgU_Ux\\\\dHzj@oGn\\\\wKin@oEru@~MfzAyKoa@c@de@mFzCmNk_@qAuvDnHcNbQnd@fPmk@dYq@fLpKfEtgA|G{v@rOflA|G}`AdU`HdArb@|N}d@dM{@fDuWvNd]`VzKRrJjVgEjM|NzV{oBgTylA`AgnAlKkTdLpGzBmKf]z|AtIrNng@|Qpo@fuB~NpEdT}LzBz_AvXm|@pJ_dD{Ecg@oTeXnGfCvTca@}CuFyOdOiNcVfRg~@sGul@fLoCaFsm@hJsf@ca@qnDuFda@s^rBeO}u@n@quByUg@uViTwXhTcd@mf@ui@fGqOfZ_Jwp@k\\\\g|@|Wo`CkVuOzQmdDkIeuGpQatFdKms@t@sqApxAwbKjv@}gBlnAq`FrZowB|h@gdOjk@{pJ~z@muB`_@a_Dn`@moB`m@{aLrb@i_CaG_rBkCiKsOf]uByUrc@anAiEkd@fTufEmLar@x`@gnBtLasCcDwQiWlaBcMxOgCeXue@blC{KhCoIs]RybAlb@ynDcGicAef@`jBmCoNdYa}BgAe{AdOyj@l^sqDpWq{@pAw^oGpGwDmYxCo\\\\l`@abBrc@ou@xVehCvQwr@xKnPb^sh@b[c|BbYqRfUcw@p`@mMVha@zCbGlJuGpGcaAsTkI_CgLrXse@vCzY~u@vbAd_@_YdFtKeI`iBnLpf@?`j@_TpeAzIvkBqE|cC}Ph`AbD|Onv@mdC~@`p@pTrOfBlUeU~vEd[{e@bKrAlRvZf]bLtOutAfTzw@vc@vsErPwN`I}lAgC}tApGtOti@yeB~EakAeNq`CjBml@bVmhAeDcb@wGuC|i@m_CyGqaBx[ybEeHkrIhVcjDqGkaC`Bw`GmFoh@mDeFaSlPqEcZzImwAyK_bB`DwQb\\\\_JnDcz@oDeHoP|d@mK_PgC~OsHi_@tY{oEaP|QyKqX?kn@pNhE|AwZau@arAoK{lAhHcH|Xbd@sCoPzGaGxKpXuPqlAbMej@gWmjBqc@ebAmM{dBmd@k`BHwx@yKzGxKelAlQae@gDwPq\\\\vl@mNwN}B{hArVgv@zq@rPxS{AvJmPGcTeNaMcP~XtFcjAtTk]~Pct@hTz\\\\hTglAcA`jAjIP|DyOjP{zBsOiPkEvL{FyIeAgZ}Nw[|GuMnGrL`Kmx@yFy_@hHowBb\\\\}d@~r@eLrc@{a@pZfy@nO}D`P_s@tIt@l@sh@zb@eY{LwZ_UgMw|BkOqGww@bLwc@nRhApr@wg@vZ|Bnc@uHhXmWViM}JwIkg@~FoGam@xFw[r`@qd@v|@yhDpnA{cA~HiC?rXd\\\\gRtZCcUzsAlGhb@eEpe@~Mhc@dNyCtAvNcG~u@od@ppBcc@h`AjBpSfQe[~t@uc@}AkQmLbAeFaPxs@imAzE{hA~Ww~@`e@yAnJdLrDvz@rFg[sFcrAxJmc@d^oc@n\\\\pGzg@oe@o@wK}ThCkIeJwVtR`@qRnfA}gBnGtTxe@yv@pWqj@cEuN{H`Gx@cSt|@kv@fLgi@bt@clAsEwRcKaDuqAzpCgVbIc\\\\`eAcRjEiVb`@g[~RyR|bAgQdSvH{t@wHyMucAnPgi@x\\\\k^FoHwPhQe^`Dyp@yv@rc@qGuMlb@kiAb~@wWqEr\\\\vEdV}Uno@j@v^ri@sq@IwUqGtCdLca@|SpXlBuEdIu^sLwTfVw[yD}L_j@~m@hCol@gG_Igk@r[aFgXdl@eoBxk@_w@GsZ{\\\\pm@qGaPw{@xqAk_@~sA_OkY{IwfAkGfBaPg]?rr@}Mb`Auf@rtAk|@te@yy@tnAgItBmBsNjOu[sBcIuZiCgP}ZoMj@mPxr@uf@`o@}VdjAgp@jvA{]hCoz@kk@k]cvCkZiXwJdx@pEd`@qO`RoG|m@oEtDaLeMsh@gIyGwSlQc|AfInLnAlz@|Gkn@|Sb{@~Kud@qd@ux@eJw|@~JmbCws@ilCrBkWtT`PoD_qBiFdJeHuZlL}gBsTwFwi@fi@_IkNyAoaAzQzIrNia@mFaJgg@tAj\\\\ksAb^c`@mPaY{Boa@cY|tAuIlE}No[sSgM_P~ZkKuNAgx@_Yk_A?cj@lP}SQkOcTwj@bAke@qRyKvIg{@{Zya@bPkKTuk@{e@mQue@tq@oZml@mPiGaIwZFrp@lOpf@aP`v@Oxe@zKndAfJ~ArDrQzn@lbH?ypA`DxgA~J|KhA}PlOeLzE~LeLlV?|g@xXsYnKm`AjJG~Q|i@`JhE~W{VtAjNmAbXu^vc@?vg@mRlCgSdf@pAdKvF`B~FwJfH`JiHf`AzKndAjIaAdXb}@xVzSZfiAcWxOeFvT_BhkBsPnVtK`{BeGfr@|m@ve@`z@uqD|CfFoGpnClSdq@cC`t@aJwKS|bB|e@`uCcOl\\\\cKm@iEgb@dCcIlKdLMuPcg@mOqRhTtApJx^hQ{Bz\\\\gr@sa@aU~GgDpUx_@bqAtAzeA`LtTqWxr@aSmk@}VbBca@o_BadBytB}d@imAgNyhIy`@skEYerAe]ivAu^ivC{HexCqUc}@qZyaD_^os@`\\\\_kCnIy|BlcBikE`R|H`Ix_@xNVx_@kl@zh@{b@dH{r@zSi@~F`I}Mve@~@|ThXt|@zLtHrGwCFwbCjTvKpN_Rpz@mpDcJqY}Sxv@cHwv@~IgZ_Nc^oKwDdNi[~TeCpRf_AlNcE~Bs^gUig@~H{TIk}A|GaSvz@`m@@oc@db@aOzFat@jPlArDuOw@kjAwK}[~Ouu@pBer@iNiaBzNydA{@wt@{Zms@tCg}AgFeI{VxBkKaKwU}eA~b@qn@jTrXhh@eIjAq[pVkn@n@uu@`Xuk@LcsA`YoWvj@szAnSuaErR}cAfPyTmCq_@bWyTiDeJiNnOwIqDuS{u@rNy\\\\Gyb@uJaV_McDrB}X|UqWnCse@jNeFvDyPeKcb@~Sui@cCg_@rKyx@oI_c@hC}a@iAaIaKx[eLb@|H_h@eAuiAiH_LoSrEzGcp@mRkp@lVv@A}vAkLmg@kSvQ{NiHjSon@aR{e@|DiTiTgPlKse@{H_`@p^o@ca@_nBzB_yClPut@sCk_@|Gae@}GqXtMucAoAeNeKr@i@ePfEar@zMe_@kH}h@~Ekl@pGuCaFyf@dWgoApX{i@eHqhAnCqnAvNi[ua@iZc@gcBgUiSoFzB{EsMtQiy@kFelCmIo@wc@`x@}@~r@{s@tvBueAhC?{v@}Nmd@v^}e@ziAqdDlfBifBqDcZxJeg@j`@wb@tF_qApU}g@dAsm@~]g|@qHixA_b@wvAhYke@iHeLqN~A_I{sAiT`j@?b{@qg@iaAoGLm_@nZyKpX_d@lZfWn[pDti@azA`mEx]vU{G|ZjGfn@d[fbAaRnZtd@hg@de@yI\\\\|[qZfs@k`@|Zan@npBme@pZer@`wAdGca@}X{Ps@s^bNeRrXpGpUiPeF{\\\\iTmWkWsD_j@flCqGw`@gYl`Aej@~hAsJv@}@sr@x^ogA_TtOzFag@{FeJa^xq@sEgHx[iu@`M}jAz_@i`AaRqe@xE_j@uGyG{If]wFiLhMivBi\\\\fAfMmcBySrMyLrlAyGhI{DiGe_@`eAoSgCoHaNrI_a@qGaj@~Hg}AlLqZx^}NtTqaAsH_OcIrH|A|a@aHt[sVaZqBi{A`V_iBfMaF``@gvA[~_@nIE|WihBrX?eEbf@xKxOvKo@jMk_BiZwrC~Ugd@xEt]rErFrIoEzNud@kDwFgKxNeXeYw]caAaHor@|R~g@ni@pKlOzTn_@hiA~GuJjFbG|XhaBnLjKvMaG~DkVRml@sXiT{EqXIcNrPuQeEuhAyKpXiKgClBnj@iRuMsa@inAqq@}qFwZmeAf}ArrBzQyb@vo@ne@tPuAx_@t]tzAvt@lb@qCrIkJlVhRrJgImKuTzb@vE|HpHw@za@sh@z`@jC|Uho@qDhQzLxSnbAzBiTlRhTjT?iJul@jHuQdJpG`KqXlJcmAkIob@eQeTjAsMxK@xBoP|NbZj\\\\zO|[hu@nm@hPiHgdAlPiB~FkO{Iql@qb@iy@~DwU{s@}zA_B}ZrX}V}FcXyRgHkB_Y|Ou\\\\|SrK`qA~_D|OvMyPmoAsQiXmAcMdLxKjAyYiI_}@zJ}UfC|GpGeLfLhG~IuDqE}Wo]iBpXca@xKpGpRuIxVzVpP{p@pRuC|[vNgEx\\\\nNlJp@vpBcLtSyKuThXzaE{F~Mk]`N@`Rke@me@sArNfc@`y@nYqLtIp}@wE|QyK_Y}@~xAkKnL{VoIaLxH?`j@kMzY|Stf@vg@ic@hKi{@~\\\\_a@lOwy@vj@{a@hl@axCvz@_l@rk@rXwb@eq@cLw{@qKcGo_@lTxDlKdNaGpKlHzAdi@u`@pV{J|Zqf@ll@{Rjw@ej@ry@oRsg@q@w_@|Mgw@gCycA`TkYJ}lCcR_{@bL{LlFcl@qGyXsd@mb@wTrr@}L{DwYp`@_ZoLeUhNqc@wp@}^aGeIwr@qg@jaBu_Ar[_ErVkLaHoSom@{XfGg_@r}@eUpNof@tfCgRbPaSwRsEi\\\\mFFwKqs@cXuJ{V`E_Gl[}m@_hAk[eZgV|D{Bn\\\\{GqaDpXba@ca@inBpUma@iBwbAnQ}j@oCgf@sKkd@i^aa@ia@elAmKst@ee@qrAwNgpA_K_J_n@hJyTml@uMeIm`@{jB{QgwBw_@}yBuBaH}GnG}PoUsRa|ArOj_CqGrg@pGzaAy_@c\\\\ai@oOmIlJwHsZ}WgI_Xmh@iJ`CiV~k@{UeFuAnJqLgLoHl~@dHpjA{FpJiTkYnGhn@_YkZaP}g@{A|OrWlcA?iThTd]pGkPdp@ruAxPdDpDoQnGdXpGqZyZyx@u@cL`PvKsXmpArKdGvgAnfD`B|tBzT~oAwKf`@lJ`YsVtf@hCvg@esAot@yFbTkS}ImG|R{J`AsDmk@tS}u@eJeXyf@ob@yMaiBmHuOiLxUwLmGs_@kgBtSpyC_Qnk@yl@qZwKvKaPqXeLba@cNgSqZk~CfIwoB_d@{aAcRknAc@au@du@n~AhZdqAle@|k@yK_j@pX~i@hC_j@pU`WzIaFi~@mpChCvTwUgf@aKgy@wVo_@cIil@nGngAgCtcAom@qz@gL{CoFeT}HClBrOsRzPmHr_@rCrqAtXxeAqG~DzDhd@bSyAxJxe@P|gCqJ|jBykAt{Bmg@pWt@rb@eIhJ}S{BfO~rAjYaXvNnLdMxoA}Iro@qKlJbAtXqGeGqC~NrBvaA_Nf`@qX`lCeb@dmB{[lr@z@dr@nYlU_PrX{KsX{FzKl@nX|M|KsDxaAqd@hdAeKjiAkJq]zIeZaGyReLrXqGkTsBnJwU~@sGlzAenAtnCoWn[k|@bYkaAiP}h@g~@_Ca|@sToJ{VnBus@i~@jWqZ}Fo_@}JpCoHkJmG}^r`@wiCxCw`@aGmBeP~eBcUxz@gVHsk@wtC|Bnv@``@xgCxRji@mXhaBuMf^a^bZ}_@zcBqTvGgaAkv@m{@eXmIxFkCsd@eY{f@_OrTzBpGxHuG`DxDfAjWsXf_AuIyFaPmw@xM}i@_GyGwT~r@aj@oPhCh]iCuCoGvTcLkd@wAowAes@sy@cP{o@jEuaCf`@{s@`d@mpB{AekAkInAeGcLuT~i@?ogAoGzB{BsXsI|i@_R`X}OcOiHya@}G?uOmv@vMpgAwM`dEmPna@oGdr@sXui@qGjTqK_g@eCwjAaXke@[yZ{\\\\onAYieCaGeJeH`QmO{Ca_@eg@iJaoClJaxC}V{qAsBpS`TvhAeLduEnGbo@{Hni@cIzCkHaMuC`PaP{\\\\yKpG{_@yeLhFmb@nTqJlIaq@rQlRzCvq@zHc`@xPvChC|w@dRb|@vMcUvRjDdLaPkPoa@ea@{\\\\`ByO~LG~CcJoL_PnGqg@bIrS|NhFfT_F|b@|Ock@grAcb@uk@CyQ~D_LlJ`ArA{X_Qii@|Vu_AoPswAnG}u@zRuIzPlF~]n`Ajn@}rBhZqeC`Tyd@rSwxAje@iSdNsThIupAdKqVxPzI~M_^vk@uElg@ap@z_@l@`UvN|NaS|VrTlp@zKjZaGfNmYlGps@sTpfAlJmFb[iz@tCqmA`UqnArDaJdI~HhGaQbGna@pGin@vKh]?wTrCdLsCye@dLc{BhTyeAdSnGd@~MhOaVsCmO{Z}OtDqThVsd@?vz@|QhEbWii@xKvx@rIukBrJeAzJrOnG{x@`P|@?m\\\\dLlKzBaYfJdOxMgFvKvz@\\\\eu@~FyHzBxKnOs[}XsVkB_i@_Uh_@oNwOjBml@fMgKoL_Rl@kSlViTnG~X`o@{Y|NnD{Rua@nl@gpAdIjTvLlxG}^zmAsG``A{KxAg]`j@fIFpAli@tQy~@vJpN`CdxBjTwz@pDn[tWxWjKxeAVab@~Re`@mMmL_OqyApB}{BtSyhArRu^~Q~rAfJrDri@rrBpGuOrJpKnc@xoDpSg@`K|LoQnq@jGnNrEgJhGzPha@drBgC_mEbAls@pRtfAuH|hAtH`i@lKu}CeQ}g@}GsqA~Fgh@}MwVzG_PtOtTf@aeAfS{t@tCkt@_L~`CpXke@jTqgAlGzBvi@_mEwMvAkRyx@gC`PkC{v@_Paj@aPdLkRg}@}IcAPs`CfKmWmH_s@bMLuC{m@fL`WdBlu@je@~vAnQ|cCdFqHeFmbCqXqaBx^mp@uGg_A`FwQcLup@`JgIlQ`C?wtAkQt@kKcj@p[syC|Sci@jHD_Egc@rIlXwEhlAjKjt@uE~_@uDcHuBnLEzq@|SihAba@tcCpG{BvJtMf^eEhCbGxKcGfClpAyKqX?|m@gCuOqGbGoGqXhT~iBmHdDeOos@mPcGyK_Y`j@tpCwe@vaD~i@}uB`Euo@~Tya@~Jox@ddAicD|Kq}@fEcIfIjCxLpXhSdChKq{@vWoS?xKxK}G|EfU`Biv@fSy{@tOz\\\\hCme@hDvgArSre@bBuUeS{jAtT`T|BwOfG`DkCh`@~XxKxXi[|c@pXdI`WjT}m@bKf^pVef@pY~WbTpv@zGPjg@gsD~XdaB}GytA|GsOjPtFbYu[fOsC|Bco@wOcm@}Zd_@}CsCag@meBuOsEkGrFeDgK{BpLaJwp@_EsMkDvEkI_Fe@mUcb@gZqB|m@y`@d^wWm]kIfAca@qaAbFmPnO{Ff_@lIyKea@ie@~Dcc@kPqNjJaPmPqHrI^rRsL{Gof@lNq_@iAsn@kk@s\\\\wnA_s@qsAeXqFiT}g@{PcrAxEwdB~Suy@hRuDlf@ou@xa@ehAd~AijHxkA}lCjp@en@pY{KlTqCrZbQdLiCr^g]hX_HjPw\\\\xOvFlVyYtl@cDbn@v_@ha@~|@iV~r@mCj~AemAd@cGdJnQ`Jjf@hpBtGfD`j@er@fr@_YbKz@nRhb@rQ_DxH|Z`PcLvOlH~eB{x@zTgSzWet@xPfEbVsSdWok@`PkoAft@kmA~Vy}@n\\\\ka@fk@_mBrPkOjk@kEaAys@~NdEz\\\\toA|m@|GmIgb@wWi[eBmYfCwZnj@uMxBkHqr@cTyKaPsGvGqGyGMyw@`HyJbUsAoW}Vi[eBnI}g@kDyZuRl\\\\eIcGwW|x@mHcEzCcc@rO_ZyC_IwUCyS}f@eJohAha@omAcDmPqYoEdD_JrXzB|HkK{Qgr@gi@jTqFqa@ey@xBq`@eT_FjUoUpBiTvTaRw_@onAgrAaj@caBoZoc@mc@l@AySnN~EdEuPql@qnBsb@dGmJg^kf@`MwEqr@kJsHqDt[cHfDe_@qTaPxToFkX}e@}t@mHyEqM~XaJsr@cNhn@iPyKuZ?sHlJwJqF_X`IeY_^ud@uNyK_c@tGgu@_M}f@oPl_A|Dm|AfFc^xKnPdLggBqMfDoAnPyIsLxIufA}GefB`PcjBaP{S__@~`@wEtz@nGpr@uMraBqT|\\\\bA{|AeGdGgCoa@eL~i@{I_j@wMhyAyHoOlO{hE_QbKwCjw@qElUyJ|A{B~XwDoTvDwgA}GfC{EcUtNgs@|FixAwOk`@{B~kAyI|V|E`X}GrNuT?vAhgBaFjRyH}AgFhq@wKye@`GljBwPiNaK}mAyBnNrCj`BzBnNfG{EhJnJm@vj@_Iz[kTucAaPrrBgCqGca@vz@}X`PaD{FdJs{@eE{`@gQpiAkFzEgCaP_Yti@yE}kAkQvOdCgi@yNja@xNpq@uLp`@iLlBkJsNoMtI}M_b@}G|A?iz@yv@dbBoPoQ}Cwg@aMyDiQ~DcQpg@mH{gAhQxG?uKcGkY_MeI}SnVsCbhCcO{O|E{vC|_@{yAvIkiAkK?_EqOuFw|AmGyL{KpXmD_UjMkv@mHwp@wBy|EbP__@vCmiAde@guAba@uzDdPciFaPq_A`PmpCcGwgAvF}eCoBaN}GjTKqi@hH}e@nGtCbP}aBxBkeB`Mn~AiLf^qCbcBnG_PnGti@lKm_ApLp{B|CysA~h@uuAtD~Ugg@vkMjJhXhjAswRdOab@gCxeB~~@ayCrCtcA~GqMjKbg@hJmNaFwvCyKxK_Dya@hEctCdg@u_DcBsx@pG{c@pa@kMpXg]bApgAdR~x@vk@f|@]v_A`TxHxHnQtHv`Br^`mBwTkyC|GiP_Dws@rTweAdPYpGkOsRic@yAamApy@czAdJdOdk@|AlIcGtQ|HppAlnFuLbfBnOiJlSdQ`Jdy@dMzTaAhf@_g@`\\\\iFzNcPxrBp]q{@vQ`[?tZcJheB}`@rpBtS~MvSuuAnKhe@cAlt@jGhCvOaS{@sfAfl@{_BlJhP\\\\fpAdTog@`Hal@yLs{@|]kBrh@lo@`QwQnLovAgNgh@mOcEaYca@n@w^xs@nNtJj`AhQyX`JtYtL|J?}G|Sf]f]ca@dGzBlVgPh]gpA|PsLnOakA|DiIvC|QpIsMlV}hA`]_c@vc@{rB|gBahCjv@vn@prBje@da@~XtTuCxR~UjBvZdd@lF}CbpAbPxRlDj_@oOr`A~LprA~]rp@zCfCpGmPx\\\\`p@|M_Ef`@xy@dSzBrTcOoGjfClRhDdIpr@|DhJvJ{@tO|m@|`@xUxHx}Fnd@~PpfApzD~QdYyGjpAjH`e@yCzP}GyK{\\\\zv@?oPeIlDqAsOnX{TpGsYkFsBmo@hgAy^`TuK`iBi]fb@`@zn@gOcDqGrXoGkEkTjEuPoQRs_@xFwIvYcAzAqHsWqWaPhT}Dd_@kNnIcj@qm@aXtB}PhPxc@fNhVdaArBcKjUrm@~PsBkDfjBkGvSwJpB{LcXuJbU}BvbAsMdPgCq{Bg]bjB_RM}@kKwHxIaBlt@_Yrv@lKydByF{]er@jbJbGkTzBjTrLwz@vKrXiGy{AnOs|@jGndFl]baCpGii@~Odd@sC`M`TiXdHwc@{OgiBaVk\\\\gJbw@aEiO`E_s@uOqK}D{_A`Ms\\\\gC}gA~IjF|I}MfBp`BzLupAz`@sbAyQxxAdDjX`LsY~\\\\_lEpa@npAnGgr@`Poa@sKu_@jG_iAlP?n\\\\nSrI~SndBceEzU{Mfp@sCrHop@d\\\\yLtOmd@|GeI`HlBlXrfBu_@sk@dCfsBwQtRwQ~nAiYzMgJx]dLz|@rDpKdFsG~AvWgHbW_j@vUyMvw@{GoGuBzUhe@~|@xgAecC~`@i\\\\jCr~@k]pnAwFvlB{Xzu@xGpeAiSd}@aMbBeLnQnAlJfRiB~Oh]a@|e@oIjOcm@lFiFxSiTwFsl@pLuUxa@__@xVsCz]lm@jeEqHxkA|GrpCeLti@?jn@iT~d@pXr]qX~~BvKwKbQbZmNziAzDjYdr@g}AxDzTqHfwAlqAatBvP}vDzx@mnGbRaUhg@xhAdZdF~JmFxUpv@jHxpAvZtEdCrLrTv{BzHcBth@vl@~SqXfWxEjk@ht@fHhfA`{@fdBlOfdBnGmGpGhTxKfCbA`j@xMtQrNqAnI}[hIhHfl@z{DiAttIiOftAu_@vaBgp@r_Akf@dtAgx@bfFpGadG}YxlEgQ|~@pC~lA_S`vAc]|^{U~|AuOfP{Otj@kDxWxAfPnHr@}AtRsVbPg]f}AvE~k@kKr`CrDxUz`@_sC`T{UzIj@vJtVaj@`jDbSfrB{Nb`C}LkgCkKlKpLjeB{\\\\|wLzBhn@eQdoAdQzsBpuAabHwC}Oyv@vkAcJgKtXghJvu@gdJtY}aA|MsfAzo@alAfZsyAfe@_OdZm]ze@_bCpe@ma@t]wv@qHiaAbLca@da@etNyKetA~KyuBaHcI}BzG}FsVlSsqBiCy_BfVujE|JoDxFvJvOap@nVcXxVjb@|[gLlb@duAlPiTbPpXvBp^~v@jaAb_@|TjTeEpEjl@|[|k@mDpOoPs@TlP|QlSfp@yHjg@`g@j`@dpCjqB~v@jn@zwAvKpDj[aj@nCbf@bZvi@eA`cAhQ~^dg@jbCt@~m@vK}CbIjgAdShLfCn\\\\sJvx@hD`WsApG}GgCoCnRhKrf@cBjUiLSyXdzAg_Aj`AvL~VpRac@xxAoxAxDsh@zBhO{Bh|@cGsLsC`j@yYlx@`MgUjPUkn@zoAvIdd@wa@zaAhAdK`j@sCba@_n@HtO}mArrBl@nPbLeJrMr@uo@hiAqMkMiHoh@mo@xHqNlTn]hKwAtq@vP_e@fMaDfGvGoOduApVfaBtQcu@mC{MkIfC~Bix@xGkJjCjNhPbBrXow@pGhi@bGiCbGym@fYaOjLrJzBpnAqGfVuIeYaHk@eOzYpAnTlKqF{A|g@~IpDca@vn@?hcArr@}vBzB~Xt`A}cBtTdDs@bzA|I__@rKqCxMvVnKvAHvS~XpR`PeLvIxl@hBfWmHfNqGke@_Hn\\\\jCr]rCyKnHnZNl`A_I|Y{G}SoG`v@iCke@iTdfBpL_C`Kbe@|Dc`@cM{d@`NgUfZuDfFhGgF|TrCti@|Ofr@zBhgBqi@duCbAps@ePfq@qB`o@qElIuG_JtGic@aNzV_QjtAdDrfBgGxD}EkPaTp[~Rd]j@v|A_Ujx@mP__AaEtcApRzBbWfd@{Qr`Em_@xqAaS~wCaNEsGbU_AfpAiTdXtB|dA}Xj|@w]~nDcs@nmBsJ|CkQaIoEhJt@td@iQts@b`@o|@n]oH`b@}cAfKf[bGca@tChhChTzB?ke@to@sf@|RkfAbC__AbO{e@b@sTiRz\\\\rGmm@kEkw@uOprAef@h}@{BcPeLjTgJob@n{@ixDdXoxBdDoxA`]gqA}UusA`Okf@f]}M?adBn\\\\g}AnC|i@zN~WbM{WzLfbAiDlQ}BcG_IpFXdJpa@|d@xXddBnO{o@{M{XL{n@yMsHjTy_AkRkMuVp@oF_Q@ad@ie@cgBqRmmBnf@_bBx\\\\k@pJcK`Bqc@x]gvAtIiKnGlPvF_JsGcn@dD_{AjXcw@qJee@|l@mpCxA`^qRxwAnOmRfRinApE{oA~JeC`Iri@zO_EfKo[rGtM~UyR~VfDRpTlKoa@nPxKd]sXhCtTvKcf@|OdS[|YlLjL?kTtEjGfA~^tO_RtCje@vFyF`DfF}BpyCba@hhBrTfLxRfx@bDrcAdLui@~GfXoYfqGy\\\\p{DjPBbRm]bHzFyXjqCtKviAjRVpe@u{@bX}qAtC`j@pBqHzMq`EpHak@`K{DxXfHzPx_@zDyF|DjXyHbwBcH|EsKi[_R`YlItz@dLiTpFdIsBpp@dLyKzBfcAyGh]uSyKwE`MnMzVc\\\\`~@tNeE~IaUvJlC`P_Y`KjOnLsBuKsc@lMzDfFfU|SoGiMhr@Svw@xKvJx^}B|KsTfLjyAjSw`C~PmQbNrEdEtTzKkYzVoI|^iaAkLlt@pElXq^jNyKbj@qGuCcLpHcPz[tCtTcRdr@bBx\\\\_c@ruE~r@}nEjKiApFk_AtU}\\\\hApKzU~KyBra@yKlPNlbAxBjHnGsXzBf_AlJ~XxQhGrF}ZkEc}@zEca@f]vm@gGhe@fGfHlFmJpHjGcCpt@bLgGrXtL_D|UgXnVyHbXsAv]jTqX}GdlAhn@q{BfC`jBzNcp@hQcRua@`rEhf@kmAr@hx@bO|QfBudAvKkT|GgjApGzv@vKk_@SorAdZdGwLn`CdUe^[ybA~X`pAtCjn@fi@`jBle@vz@yKz\\\\`j@ve@z\\\\zv@z\\\\g]rCtTfE}}@xYe]~RrUpItx@aPleBf]er@pa@kTxBnP~I}DpVns@?_e@pQhO|N{EeCob@vGwF~S|vAheBbgAh[hcAvy@dc@`VqFvo@dz@bOiAfWdStDgH|PxG|Jf_@uN|gAjGj^`Pc{@bJ|NqA~qAzMgjAlr@kg@pa@lPpk@ti@xi@{Qv\\\\vUpa@qG|Xbj@zB}GdL|GzB}GnDdY~JwG~YpTzA_LxKpGts@yTtJpG~QkK~k@hTjc@qDtDrMr_@qJpRwZzAzLvUlF~MmUrTLrMaT|GpEx`@mJhPxL}E_p@~d@`eAvFqBxKvKxF{FdHzFjTuC|GpGxKkK|m@jK|Fx]_B`mAtS|RmE_yBb[bAtGis@|KqQtSuE|SvElWrX|ZxEm_@_{@dAeYje@wkAd{@g]`Bom@}Ncj@cGfCwTwT}Gke@fLlP~^|Bl^}QrTza@hm@|Z|`@_LtW`G~ZwGla@z\\\\xZqAdfArRinBapAquBdHqx@es@{Xx@yFoMmYpTpLuwDwTyeB`Q_dBkEkd@lJaMpVoDvFwa@ia@sr@DstApHaThe@qLjiBuErw@kl@pa@kJt`@k]hw@g\\\\~^en@dOvAla@kRjHaPxo@i@jqAm]pO~PnGoa@hcAme@f[e`@ft@ob@~X?tMe_@~k@w^bz@ySd~@|Hb_CpaB`y@hbApXtmBcPk_Cc{@_sB{UkzClFeN|p@hkA|Ijs@~Q`\\\\iNerAwe@efAeWsbDaa@}xBu|AujMdA{mBnR{eB?w_AtP}j@xGeaApS}Ppy@u_Epb@icAbCmw@jLq[v`@ivE{Bmv@na@m_C|@sq@bPmk@{IcaBvKgItAsWjMfHtb@weApe@sq@xRcjAhlAwcClz@knEt]cOjk@m_AboA_rArToDbq@}iAt]~UlPsCcZwWX{o@lE_I~IdAjGgrAra@jEyGs]fTcaDiB_o@hBmOpG|GvB_Hu@gp@lY{`BrxAckGvf@soFjc@}`CrlBw_Dbp@qaEn\\\\YrJhl@bUfLhMd_@~k@x^~Xlh@hIzDlPcGxi@|RyWy^us@cYoiAmmAi_@wyAUebAf^ioE}UuvBoHoxBfKs{As]u|Af[}cBrLoVxTbCt@uKuOyI~J{\\\\w@yW|X{ZdTjLvM_PoL`FuQ_H{\\\\pNqZlpAxDeuAhPix@`Ja`DvKqXmVyyAA_g@rJsa@~[eMje@v`@{\\\\om@rHmfAcQaiA`Pw_AeLfCDkJf_@yeAzMeBdLgw@kTrLiGsRy]vZjBqNzZcp@bw@~Qb[kOh[bIf_@aKlEc[pKcE|lD|gAxtBt~@vM`Px\\\\{I|v@jAno@_d@zN`Fx@{c@xb@{q@~|C{gAxh@|Ivm@xb@hh@nJ|GeGtf@h@t}@cd@xtAoT|Q`Op[`dAf_AlWlo@rGtXsK~MdJ~lAaaDvZs`@vQm@~u@gi@d\\\\}~@vkAatAda@ogApZ|Ezc@wK~]kn@kE|nAxVnoAfNpJcDlh@nNp]{By\\\\n\\\\je@qBm^sYqt@r@wqCzJi\\\\pgAucAfGys@b]ifAkFec@~_@wiAvx@kc@pNq}@dkBelCfmBin@`c@ip@`jAqEne@zzBfa@|n@v_@nXjZtgAf_@v\\\\rPdn@jQhPsKkl@rKyD`PxKwLiTky@cj@yV}aBwb@ct@}[kVom@kaB`XcdCe@ae@`T_j@xFyq@f]ef@~r@wnBzc@cWhp@mtAroAkzApn@kg@dVmBhIsJqg@hBap@pj@yf@jv@yd@~\\\\}f@llAsf@|a@am@jLqhAgi@it@xIy]ix@gKut@av@rXmNqx@gu@qyApUep@vc@zUhXmBtBcx@oI_b@|m@}gAf]{B~XuTzz@gUxM~NdWsC|UmXfJ}g@yi@nl@sk@iI{WqXuBkUgNd`@_Qp@ok@gv@_G{_A_KlMaDj}@k`@fCwYaP{Bf]sQtOqGre@cHfGsNyBwi@~~@gP{Mi@_x@qZ_s@iHoCuSzTib@bNyQ{yAeVePuAuM{VpGk@sd@uEiH}GxK_M_g@qy@gd@g\\\\jH_@quAcPzc@tCnt@}KxB{h@ad@uW`SiOsLsJvSgJmQwJn@}Ep^_PeLeN~QxCbo@_JkAeCtJnG~]yKsCuCna@aP}m@kKpXuCcLaP|GgCje@aVoM}Axb@eGyX_PnCgIjg@uNcKeC{z@wXqAvE|]}g@ow@zGfaAcG`d@uY~[gG_PkPkCrCke@qLyKg@bXySnRtHpUuO|w@cUui@{Ble@c{@me@yKlL}GiUmK~OyEqXgI`F?rMwKaPiMjb@}NcHcPxO}\\\\cKie@f\\\\fFtk@{JuE}\\\\xe@kO{s@vJdIrJkYsJo\\\\eXhn@}GeGdLyc@qImVm]tc@vIvs@sXcPlCyHuHuHuNdW{AsSkIvB{GntA_JabA}HgEq@zMcGNqSuIqG`PyKea@oG~SaDkWzP{dAkOxOgFvYs@fz@{UFkFtXgCogAmMbR{NneAzG_y@{GuI{B~^?oVqFvDi@``@sC_YeG|G{CkNzC}s@qIpH{f@laBaDd`B?clAwKrBKai@gIbL`AcL}GgC{B|XwTa{@mCla@dOptAwJEfC|\\\\kMrTr@ne@iTqa@yKtClCaQwGeKqHjkA{FbUqGmPcEjD_AzW}YK{J~XmGqG{KhCoG}\\\\gCda@kU_HqWzPuCjTyCcNeJtE{Lhe@oK{k@{GmAkHjb@dD|JdCyKpGba@oa@_Y?n{@oIkYcN|LbL`QeChh@wXikAkVKoA_HpGjGrGsNur@uiEca@gjEya@yx@wd@ohDkUgm@wM}tAkeA{rCkrAwhFky@saAokCmpGgh@e}@ib@y]dSx}@k|CaxEu_@wSqb@onAk{@cd@o|@wSub@iZoxAyQu`BvFiuB{MsyCz`@qs@wN{V|RshAlNerA`i@gMpQcUjMgCoGmA`KsvArw@we@|@e~AdwA{Vpc@uz@jk@mDtSwt@zv@mZjuA{\\\\ba@yKb{@cIq[cP|Noh@j~AeCtl@iTnm@aj@~aDmUsI}Rdg@Tp]g^zm@_Hjg@u`@h[kb@l{@pDzKpKyF|`@y{@~ChNkHz`@mOv\\\\eSfL|@vq@mF`JsZmCqEtGaRm`A{NjHcTxc@}QsAfIyQcPiKuz@pb@sXqGgHfToBw\\\\x\\\\}m@aNfDeHoIaBso@f[bBaFk[oTiF}Vce@fCvn@cOmXmH{CyKpGuIkRfIuZca@hG{AmLxKoMyHo\\\\sHiB}RlQ{Baj@i]lKgc@_aBuNspAmKhn@oGucAcNgH}IlUiTypAqGd]fHxHqCtRaN|LnR~{@aJnNmOaO}AsYwThT{f@i}Aml@yGwV|i@sMrG}Gea@eGjThC_l@rOqVmIk|@n[wr@nM{NtQvCtIuOvEmpA{PveA_K|M}QgByRlJeGrr@mPea@aPda@_HiEfF{h@eYoUeE_wA_~@`q@{Jh`@sE_l@oGjTcPcv@oGjTcGq{@oPvn@cDoi@qOua@hNnwAqRhWvKp]mKveCkPca@yKdL{HgZ}A_|Ag]jnAaPfN{Bgw@k^`z@wJkOxKhn@eCbDi]{PgM~W_JPeLfr@sLzOoLQmWw[qVk`A_[_[JkqAaQdnAzIbyAkTzs@mb@|c@ucAijC}HdMhDpfAqm@{pClv@doFgXwT?tcAuTyv@}CdWrYdlDin@kRspBawDiCqe@s|@{Kg_@_{@mNvc@uXoPaNxKoo@vA}p@`VsAsU|Oe_@uCwK}NjB@ym@{K{e@oYjjAiSoNrK}eBmUbj@{Bjv@aKme@iHxsAet@ts@zC|zAgLd^uf@dKqcAfn@qUiF_`@`L{UzYsy@pBgd@|Lug@yMyg@nGyF}UiVaJ}Zyn@oHgv@ce@i}AyDa|@qW_ReRciAa\\\\kh@lByh@dRcPlEuaCxKqRiDsc@`I_UcN}l@fLcc@bFm_B|Mcy@vNsVp@ay@zMmj@d]scE|Xc_@eEm|AjYqiAnI}iBtMoh@fcAn{D?ye@er@k_Dk^_r@mLsfBfSwmBb`@v@wTae@k@}t@vTigAhr@mqAzYg|Bon@jqByPtGiWi^?_sDiT{|@aVuWmGoe@jFye@kY_aB{{@qmAyI}|Bpu@a}@xAej@`Nq_@uA_l@lYae@rHkbAxUp@xH_In[aeAbPqLdHgy@nQwj@zKf]nGyeBuOpX}Gyv@vS{`AjHyCjX~ZnGsi@|EdJpIeJt\\\\bE`Lho@r]zBxh@l`A?sX{\\\\ov@tS{@lVie@{r@rQaPW_LyN|`@azC`D_mAlq@y}@rCpm@dGaKVjN|PpF~FcQoKo`@xFog@ji@uq@fk@dw@aF~aB`HbT~TbO}Skr@xF}tAiH}_A_i@qZgVjV{@_Iha@ooDpYky@vFgF?fLxGgSlOo@iCd_@dL`RtCp`@lBtfBhCog@`MwE`GzGvF{\\\\jJzKzQvkAxKeaB?s}AbYrlAmCbgA|BuOzGtOxKeaBn\\\\le@lPmpAna@hTeIgc@nMuI~XtI}Gqg@oXbPqKqVxAuLz{@c`Ap@gFkMbFaJyaB`JpCvOgUoJ{I|Hcb@cCkl@vNs_AnSnA_@yx@zKsb@e@kPuIhKoFoK|Ru_AdL`PvBeJwB_g@xKui@kT|S?}SoGzBiBiHfWg~@yR`Jc@gWoPdLxKsr@uHvAyI{LrO_p@sGcx@dPe[~Vd^dFwS_EuMxMdDxTwL|TlHvMmHxKle@zBc{@|NhAn@_l@tVgk@}Zoh@`Hvv@qp@adBeA}U`VQjF_VyEeXwRaFgYlXm`@aGoPkP_N?{M_h@|Jsg@r`Aku@rXsDzBea@f]xKvZyK`NfFf]lvBdi@b{ApX{UzT~GlDaRbSG|XldBtf@qp@pVjOfGkXhUuHpc@p[xIg`@lc@qa@nIca@rXj]~Asa@z~@fSjGxo@lInIsNr_AtYfIjNoj@bGrLsXhqIxKwOnI`cDwOfp@_a@|vE`EhWzEcfAfOqc@t_@eqDyDmzE~Lqe@dG}iAaKu`EfEqH~V~C|BzWx^y_ClRnW`^dF|Jgg@le@tcAtOutA`PwAd\\\\j_@nNlx@pe@jv@~N`EpBed@zFvBlZya@tFoBbPpPdAsWvK`FtLhaAtNnB|F_Ra@_|@uNs^qQe_BbPqh@pGjG|BwEsKce@|DyYrL_DjO~JdU}SvN|e@wP~j@tXTvDdMmTxd@zc@dy@|XQdKgO_Qg^vO{RwDca@dOaVhW~k@jQzH{\\\\xl@hnBm_@|L|OsIrZv`@lHjQ`P|Gl}@}m@ba@kPyXeScCfB|UuG~E{OmHmKlNeBzRvI~BoE~P_p@th@|CzGfg@_F~MzRzI_n@xO_KnVxFna@}G{\\\\tcArXeLxK~xAvKtVt@dvAbJlYoAur@`K_USoo@lK{I_YajAf_@zEtXuG{Pqb@zG_g@`b@rp@b]qf@g@cJc`As{@\\\\c]uV{GiLqP{RwjAio@_cAqQekAu@eqAA}yAxKpGfAk|@kZ{jA`RkaBhc@qgAvD_w@r_@y`@nVtOtSg@|Nq_@~m@lKnGqm@r_@xIdEyIsL_s@xe@nQl^gHlqBjcAfq@zTfOeD~KrFxx@tfB|Il{Bh`@z{Ch[lo@fIly@|Urr@dx@fzAykAuaDw\\\\ybB_|@qkI}SaPaJk_@mR{Pa~@yIeHsXok@}Jcb@g[uOpAwR_Laj@`AqXq^lEa{@{QAgPcL|T}_@fOhFhKcn@r[dP|\\\\~BjVsGvXc]tIRl`@nVx}@xpBdl@l\\\\|EqDgj@yjAik@w[wNsw@sLd@yW_g@aVjMwf@w@eZcTaP?Ni]r[mf@~WnSnMoIxOzVrTeFnGzGnBob@`ZePdz@p`@jj@sRvbArj@zK]~HuNuEsFc`@jBmc@qg@yh@zKofAaz@cAkRa_@al@Si~@uVsRgKifA{Ucc@zIyl@yQeMiQfI}Gom@ue@gd@jFif@dVzFhNqZ}_@k^uK`B?{vBgYz`@aFi[_Y~A_WeXu[hCzC_NoXcJkFat@_M{IwKxWsLgPgOfDsX{v@pc@epAv[qc@fM{o@ji@cV`@qk@|Lq@pG_e@hNmAh|@to@`i@{N|LvBf]b{@bP_YeZmJ}Keh@mUgFcEsToIn@gJhd@{FmB{\\\\om@gYeI?ke@eJ|UaRzE{Kbf@oW|MiDvo@_e@bAqL|g@ir@{v@xTe]oHgOlDkt@eR}f@gJdwAsM~[uFQcOkjAiJyCm\\\\vRfSwoAn\\\\ip@}KkDkNnKoRh`AcGoa@{GpXyKuz@lg@gmBaAsHqGjEqXrx@yKqg@jLmt@QolAsJvQRln@g[ngA`T`Xb@~d@ct@|m@cPwC}EqO_Tje@cXoBbC}c@rm@yt@od@fJyCwDxFaMoKeV|Bg_@u]nm@yb@|GoFkL`z@{|B{s@~v@qU}GJ}^iTpgAqGaPqXxv@rJ__AwFky@pLsnBbN{F`c@ajA?_j@kQl|@wg@z|@iYboCkdC~rAaX}p@iLtRpBo_@uPsPzHk^}QdFkJua@tn@stChCq}@un@buCeGgL{VtOdLwv@{GmFeHvDgM~k@kD}i@sNwBtOia@kGyDmT`c@?ye@gR~^{VxEcDqr@gOeLuMxVoFye@wU{Bg@}PeWcHwEko@ea@~r@kMsl@uRkVsQoCiJ~K?gr@bP__AeLxKdDej@`Xar@tH_r@t[gs@r@f[wQhj@wKjwAda@jkAdAkQqVceAt@id@tR{y@ve@l_Ak@{e@a[}u@zBiLvKjTtOi]pa@hhA`PuT~HvhBdq@lyBdCsLin@khCuBosAwf@mm@h]a@}BxOfI`FjR}W~NbKfAlzAbOykArDaIrTZjWxgAqNk|AcRmBuWgc@?yq@yF~o@iJnOaS{@lPsxAz\\\\uT~K`nArKdNd@kj@fOe\\\\rX|m@e@uc@gUs[zAwOte@lZ|]nB~IjIeExh@vMfBfr@}gAkF_sB`BaKbPvOfC{\\\\pEncAbnArv@kZmq@_]{CsGoN|FoxAdPlGrIzg@rXfXbKu@sh@saAlN_bAtPoWb|@`i@hA~~BnR`e@vFg~CqGqa@vK|G?qa@n`Avg@vJz^yKyz@sd@yRyYe\\\\xKajAjNuCng@dLhTb{@nCwFw@et@{c@mo@sFk]rFeVjTti@jHoFxUjOqOmo@jLqNno@zq@vFpp@tStd@|LjfBkOllCde@nh@wJak@kMkM?k_@pSvLng@mT[sLea@xKmHkE_Guc@lPui@yKer@l@ymAsT_n@tB{WcVkTo@cTrEii@xKzBzEybBdIsA~HrTm@bnB~OihAxe@|r@mSkg@mDcc@bLsHvKve@xKsi@rX|m@xKzv@d@{pA_MaPrCcGp[`Nh@aN}i@if@o@ko@|HoNxKdf@rVmmAlOqIp[zArXlpAtCa}@aGml@~QuPnQpFbN`e@vTbJlVjxApTvn@jKbG{BbuAhGjf@yO~l@lTfQ~F|_@cGg|AhFsSvHi@vf@rq@h\\\\ffAn\\\\jc@ye@ajB__AqaBgD}iAvLmc@je@pk@bPxAos@m|@hMo`@uW_l@y@ifAyOcO?oGlWb@kVmY}CefBtOuKdf@vz@nZqTrEvGx^gEpUb|@xA|rA~`@p_@dFeKoTuNmKyXlAylAmJoQpI_i@bKuIrFyAvDjUzOzKtRltGzGui@hMxM~a@hlBkA}m@vVbTnZhjBaLuhCgIqK_VkC}VsjAuJkCcJ{VkHoy@dGxK`FuFe@_x@_KcU~Kkf@}Xwj@nUeQa\\\\_Y{Bg]zG}Ejg@~o@tPgKfItC[cLyS{BaH_NgB{v@jIkBdExb@|G_YpKrAeKc\\\\hCaJzSuL|Sfr@_Dd{Axj@xvAiTseAgCcn@`G}g@`y@EcGyPih@kHkTyV|V}GbRdLxBuLy\\\\_\\\\zI}ZjRaNwTqG_U}`@pRcd@`]{H`h@vm@|GcLjLO`IudAbl@ub@|KpJlIom@jTuC|HbFtWtnBxQyA`FzIn@zoBjq@bp@i`@jyBlH{Edi@{`B?ye@kXg`B|FaoApXypAf]wz@?vkAdNab@tg@rDzKjg@?fp@xGuFrSkoArWeJaO{p@|XS}o@msA~@kQlc@fp@pGk@b@kkCzTew@tRgMnTxKrRaPjLnJ|FeOjT?kTf]vEzbA|HdUh]rVjRti@`c@hApItI{AvZrNz}@qIbt@fFpEhZgJlOfHlLgHkWwLwH{[`PglAbObVgB~u@jPkQeCmKfS{|@`PqD|CzNiMzdCuExNcHgReEdDvGpp@ijAdcIyYcnCf@qnCuHtZcBj{C|Gpe@cMfy@lHx^`YpXzzAqnHdNcsBzBplElSgvCkBaqAxPq}@yE_c@hZad@vPhH|Irv@{LbnDnNt]oAmgAnLuLlEp{B`Ooh@xC_aAuK}LlPy]xEcw@~DuMfCpa@z\\\\{v@{BsXdGlP`DqMYgq@tJgThMAiQfvBnDnL|SeaBxGxsAyGzTcG}m@kGlk@tEhuA}Lt_@tEzd@pXqm@nI`BlM_m@sIe`@dBkiAxKnGgH_nBnMs_@yLk}BlItChPuIlDdb@dVdJmHae@tDqDvJ|FhDqG``@|gAvCia@mIgq@rMct@|Brw@bGoa@gBpzBdKzTnGajAzI}@p@hGiO`j@xYcd@{DkvAdLke@mPqNlAoVlZki@bUpCqBfz@pB|KhGMpRgz@oL{eBjEe~A|DwTpNmJxNtCfZdr@hCf}AhKoNaG_r@zByeAyS{_@ua@oYpBo_@zU`NtPyJpWfBfCcLbSng@nUqNre@r`@vGkAqf@mp@amB}y@aCmJ`Teh@qGcNxKaPoFmVtLcAhVba@rCqG|GpGvHwEzN~hA`Z|RpNdB}Vad@uAaVlLwTlPeBfr@td@wp@cfAbCqLrUdHzDuQfLfCeChStX~YzOri@p[uEd^xVcIkXwRuPoHpHwF_E}h@_x@jByM`a@je@wKiTtJuNoA_McW`CwLyEkA{NvHw_@f]bGnGyKtBnm@lRty@lp@v^zBqL`GxFzD`N}GxWtOvT|g@hTbVhn@oc@ejAyDeTfHwNuVwUGyXdk@heA`j@f]`j@paBbGyFjYjOgw@opAaPgr@kYiJcGat@}m@o_A_Bsb@oYgc@zBaHzv@ba@sXod@sXqdArXx\\\\n\\\\zKfg@~s@pNnFaCbQ|JbUlf@~Z}gAc{Bap@ic@eDcNmDbCgCscAyKhBk\\\\qk@wLck@|\\\\}GrCqGwHoGfJcHtLkAkAzJjTvK`B{JtH[v]j`BiA`s@hWqEqQiZS{`@tPGfCah@uVeg@tIux@iZs`AaV{CdKcU`a@?iFma@bEiEds@bz@|CtWna@|m@uCxKvThn@zFyIlJtGbf@xhAtQt@aE}InGoHx^pV|Sdw@fGaOgZgpAiP|Eme@aEcl@{gBcfAgnBvAgTbPGinBiyAeE{_@l^jBgk@qe@pZqT|WxIjXht@~a@ja@l`@p_ArOgAaWka@sGeg@uKqPiLnBsFsHkHa[nNkOc`@oOrFcQxUtBsQqRwW{DwG}UdDcKjTeG{KkRrD_Ex_@pKzp@~j@}Ksb@tMqH~X_q@je@zkA?ea@aPaPk@kPlNoL~AlL~ZbDlByp@mHiUnH_TxWmGi\\\\}V~W_e@xBitAjTn\\\\}Gac@hCch@bI~AjIsLaAcUsYbFgBqFk@gqBdFkJbDrGnGsDtLcRI_tAdOqR{Ny]u@yo@pg@ur@iEw_@vOc@zNgYaLu{BvWkm@lLnBdDci@bMv@~Dfp@`Rib@bUpUt@bzChGn\\\\|G_sDhF`JqG|NzDzi@|B|E|FeMbElFzXf_AmDddB~F`_@yG~RvTz~DxG\\\\lT`m@qLmeBtTfr@hCri@`PwKfCtDbMzoC|u@`aD_@p}Ad|@vcI_HjhAjG~f@`ChF|Iyh@vNqGwI_aC}a@srEy^_yBNik@qj@{_BcCmu@mUee@fDyM|qBl~CfFlp@{Ir~AlKdrBzGykAnc@lx@xO~~Bb_Af`DlA|eByK~~BxKvTlEog@tHgCx@~x@zSheBcLbj@zm@qgAxClo@rr@hJ`_@wXdVtg@mOdGuj@luBmYb^cXhfA_R|tBwXfpAua@xVrAlKvH{HxWrBr\\\\rg@vGwGqGct@bNc{B`c@eyBps@mfBbL{y@jW}p@iCuV|PwLdX{AeLvz@bp@xcCbRrKmJxRpFnHbLcR_JsmBjJsMzWdz@nPjyA_AaiAaTqqAvQsXwCaOmMsF}Dl]cDhGcEqEgN_fAi_AapA~GgNgUtDmByEzByv@kJ`o@iR`RwCiFzG}lAcEg{AoLa{@s{@qxA_BsVsQcGnLw\\\\cQ{e@nJaKZ_VwFmm@iTcEfC_[wT{GyBjYsOYaHrLc~@avBc^uWaOgqBsy@oeFv@_j@iTsx@tOunEwFCmJza@Jf}@sPfu@}EneAz\\\\~iBw\\\\py@mZiNyNyTF{_AqO{MyAoQnFm~@eFst@jPyn@pD{l@eFuy@dC|GpEkFfEigBxKnGsKsi@hGmKsOef@|[in@jL~CiC_YjRhDvXeMxv@mq@xO_`AtIkGhAdl@~^eAha@_uA?ve@pKsQ`Sp\\\\~QoRby@boEfMzd@zTxRwTm_AbPrCsD}n@vL_d@~XhOtDsVpW|@le@ri@qGuZtCm_@kQtEuq@y`BcLdFhDxJoNcUySt@}Hqc@cGuGkIdDce@mt@}CmVpHqd@iGgk@tFmKsFg^{HtD_AiWvVsiAaP`UuCcLwKrC`GgM{S{fA`McZxGwFjFtHnGoP|HxqAzSxe@eDljAzX_pArP{fB{Jqr@~Rgv@zBfw@rCkKsCojBbJkkAbRkb@`PfCpJdQqJpBi@xR_BxDgCeL_EzP}CbxB~m@ubCxIyLdPrAbLcj@tX~m@pBwOiU_w@vQmi@jKzSbFam@lCoDxMx_AdEsx@tK{EnRra@~G_`@}NqVhAgPb^v]qDf]hRcSlEi^pCjGxKaPd]dr@eL|gA`Yui@rMxDfP`[qIpnA|GpaAzB_s@tTpa@~GaHoRyk@~KyzApMsKzBbP~Q{n@bNdD~L{QtUwEbPdmB~IpL?}m@bJj^aX`tBlRaO~R{|@~SoEjTme@vButB~P{VxKrXfCuO`Pf]{HnHh@th@wPdb@_M`aBlI|OnAabAzMiWhX~j@NcVhKuNqGtpAxQd~@eSdq@uNd}BrMm^lB}{@zQut@gCilC|QcXrCsv@}Gsr@bP|G`@a_AbZfJ|BkdApWlHzEcJjPrD|A~RyOlc@d@`g@tTsXnFdIqbAlbGz\\\\er@lCcx@l[_r@bIwjAtOjT}A}UnO_`@{Ggc@na@jt@}Gov@vT{v@vBp`BvCzCzKcv@`X~GlTsLdL`PhCfw@~OsXfTr]vHho@gH`p@`_@si@bUtJjk@f|B|n@pgAjDhhAb[je@wDtXdVjMf]p_AxDt_A|i@tNxIb`@jNlItEvSrFgDjNnM|GnT]vUyMjQ`PbPzOoFjL~N~BpjC}]hb@cO}J{GfMbMftBhB`IxFiF~JxCz@hIqL`JtTtiAuTcPrS`_Aw[rCxIbf@fa@zL}CzPqMeArcAtpB~H`f@~UbxB_K`PuSaCoCnTnDgJvUlPbIeE_YxeB|g@htAkOhSf\\\\|AwNzZxPNdIeIlNmh@tTbU`EzZ`Uz[~Dtl@`KxKwFvKtTxKpGyKl\\\\xe@iAdKcZyOkGnE~WheAlFk`@~]jK|GwKpX`GpGsOvC~Odb@{BxWvi@lKqLlDrQ_S`_@gPpDuf@iUyJdFdCvGnt@lVzj@lo@gQr_@nYvHfZbm@jE|m@|FzAzCmJzIb[pT~IpKec@fSlG}Bxt@zh@vs@zLvpAvJ`RsCp\\\\~i@pr@dGaP|RgAdAsGsPwJxPfBlZhbAlMxDaFbMhPd^yDh\\\\rXnk@mKk@?rXeJkJoVg@|Fl[rXt\\\\oBpMkTwTfAtP_N|Ff]`JeC`b@dCvH`GaFvC`F{PhZ~FhQ{Jv@vZdbBcCzLkReAnPh]?na@}GuC?jLfUdc@mHtZgQiMkFcW}B`Oji@nqAyKxKrC~OgHjZlBbM`Id@gClPlKrXwDtXlIuC{Kdl@|Bvt@`}@`}FeEnh@i]}tBcQeKh`@l|BgEnPoPaDic@ph@gFhyEs\\\\pfA`Fjb@kIdCbQtd@yH`Q}BnhDmP_YzBrr@pLpXMjnA_Pfc@lPvTs@tlAhQmHxHxXgBlqApRrt@yZfg@|D`iAyKjT?|Z_P~OqGb}@fDroB`ItaApMp]wKj~ByTn{@hW~qBbFryCyHnWsEzqCfFdX{Tzh@pg@hjH}@h~@mSpmA~h@lr@hAlScN\\\\n@dMf_@}HaPxeBnJr`AeLv_@aYhAuAla@zVde@~RtK`TmVaF{h@jAiHbH|A~E`f@eWjuAhTeG`IbZtq@~p@nYzfBpNfW|XnPlQcGtF|Nvm@l`@cL|[fJdXvn@f^`o@eFba@na@pa@lKx`@mGna@lGviA~pAdNn_FtTbp@{GhuCjTjP}E~W`h@fYnGhg@{B~k@lRi\\\\Guf@zY{DfOdyArTxh@l@n_@w`@qm@mNh@sMrT_L_AqGrX~m@gVds@~`Cd]jQbOgDxKdf@~Oqa@lEz}AhNdKtb@fsAnVnNdHxq@hxAze@xQpOfDdt@n]|Vdp@nLt~@zfAph@jYv^f~@?bPtz@~}@oF|xA_d@~e@yb@f_AbBpbB_Yrh@}c@zaB|Efh@cTn{BnArc@kY`m@`Azb@}S|dBzKrr@iCc{@za@urBfOhMjCdq@`WiOmRujAU{eD~QgqApNyL_CoU~e@_mAlE_k@iJqg@xLko@tMqZhg@ed@tKedAx\\\\~Vxt@xKtJhR``@|D|g@xc@`p@rVjn@`_ApXtcAxGqCf\\\\`f@|h@pEnSvOtg@ziC}GjPvg@nKnKxOzcA|f@naBp~Alk@|_CrdBhqCdj@r`@d\\\\p{@~IdkBba@j_C{KdcBzr@frA|`@zdCpr@xQzi@t~Bb~@rvAxUfvCv`@v|AtHiDpt@nUtTjtA|jBx_A|d@ny@vn@fg@fV|m@hKvpBzRvo@js@`bAdc@f\\\\tUpj@jGnmA{DtYyUd_@jBnbAqUhl@l_@c^nk@ouDmFoL|`@{GdYgXbOn_@dDjvAna@fc@|v@zvCfQpWla@lSj]fnA~Yld@uBdvBjwA`dFhoDlkGjhA~pAxa@rWzd@bzBdZpr@dO`QrU{DlIhIrZvwArQ|Ldi@h`AhqGbuEjKtZ}Bbac@dHdf@j~AtgGtsBmgA~aAcElg@lIz\\\\gY|ZlDbh@}gAzI{BrXdE`u@v}@fpG~}V~`BxbSnnIb}UeDjaDugBh~Ekl@ja@aq@~Su[p@axBkRug@i]wOwWih@w`C{bBytBwGyi@a]_^c}@wYqk@wFyZ`Fq{Aii@qi@eAegI{dDep`@npFuXbIgVhXayFnnPg@p~AeqCykBkbAl}AtDj~A}Fnl@r_@bx@jAdT_`@~]gUfC_^bh@wMfE{v@|L}SqGot@j`@gy@g]mKhu@~ZnbAeLn\\\\n@fVlD|Sda@bj@uAnm@sjBd`CidDz`AojLnh_@__Mjmb@kBzd@t\\\\vqCblDpaMqVxxFrQdaBmRxm@sr@vo@{Gl\\\\xRnfGeL~Z~KdeBgIrU{d@bO{PjVaRsD{GtSrf@hzBhaAh_CvPt}AuI~c@hBxu@aXhH{MdnA}NaLgYj]sb@roCeOhSal@bUqF|OhH|bC`LlLhe@qFzOpq@uaArw@sg@dBgWjMyFtUrCjpAoLre@mMeDmK}r@}SdLgZfrCsVtz@{FvlDrH~nA|Ibh@~WfDlH|RbOrdD}Oz_@}l@lO}JnW{Hh~Emd@vd@~ExsBaOzeAw_@~z@}PvL}aADo|Cn}@uZeBg|@rS}Uju@\"],[\"ukqZ~__h@mOjp@|^bg@oFdVw}@zfAmjAqYyi@l`@oVxEeX{h@ig@dBaGtJyDlaBaT`s@aWfVrFbToSf[o`@pAoe@}Vw\\\\nOkZ{XkXvOc|TbQsr@bpAuc@b^{hAj~BoOd_AxD`]dRjThOfAnE`^mGtr@dd@zmAdDnhAf\\\\fyAyc@vrAwKxIsd@lDmv@sFmGxHkPd~AlKn[xjMrmMzpDph@de@hfA``@lGvIlTvn@|ZnKlAlDmLjYaKnTvKzOb]hR{K`h@dNhTwd@dObChS|Kxt@nqAda@_GnSpIba@t~A~v@nfAtq@f[|r@uw@jKra@yX~m@bCtX|Q~K`s@\\\\tOxTjVrA|EfJ{{@~c@{H~Xh\\\\v~@la@tg@a\\\\drAfApXn\\\\zd@bHx`@vLoY|}Aj|@pWM~Gpt}@ki@utCw\\\\oh@yQuCySyj@eKwcAwUem@_FgwAmx@_gAwZweAeVyX{Fq\\\\~@{hApGmFoF}VeVgIyn@bKsi@ke@g{@kFk\\\\oUc_A}~A}ZgY{k@eEmp@a_@}MegAgk@obAzCkb@_XsyAcWoe@cSVmc@mfAga@ud@qd@qiCiMmSur@i_@gTuu@_Fkz@qz@uvApTq~AseAi`GcY{{@s`@o\\\\eLerBiUu`@j@gf@eL_Y?sX}Wm\\\\{`@ewBy`AuzAs{BywBq`@giAkRaOoj@aKsQeR{[i}@qoDwrEme@uG}~@a_AeW}jAc}BogD}cAcbCmMkKsEu|@wa@wu@gW_gAic@od@}OqcAut@o{AsEql@yXgl@{Cm|@ocBobG_F_kA_Rsf@}c@uwCubBstHit@wzFoIiqEcYceEn\\\\mwJ|QawAh^gcA|XocB`kAi}BhIk_@ng@mo@nNeHzXlEv`@me@vFlGyi@`sA{_@`_DrXgr@xKle@?qwApQmM~Eja@lNe~@pdAs~AhH_GnEtz@j^fNnYiy@fRxRnObt@vRxKzBoGnFlI`S`fCp_@hnBlPaKt_Abn@vOzoAqEf_@tVsEzMxVzeA`yDxDrzAoIf[_SfOuf@fnDka@taAia@fBdlA`jByFlhC|B`GzBwT~UrNs]xt@uBv{@nFhYz]bPs@lYjIhH_EbS|Qrh@rNd|AxFwgAkv@_wErBcR|PbC~JoIvH}r@zKwM_DaMhj@cxChIs~Avc@}n@llAe_@x`@ae@zGfXhT}Si\\\\{ZaHafA}o@cfAac@m_BuQwqBvC}\\\\xe@vz@gRqgBZiaAvVmEtJfk@nGqm@nPuCx@cHyZgBmFkMfS}h@p[rv@pDja@vTcGdL~Gpd@zo@`YjI?uT`j@na@wTme@npArXo\\\\?`c@f_@p^O|Jbs@hl@tp@xfAbxCtBukA}FcYz\\\\dA{\\\\{kBtT{\\\\qSmn@~EccAhJdOxo@{JdMz[jn@d`@dq@eNdr@{v@zCp\\\\jXfHmMmLhQ}q@qQg@zFgU~pAjAzI}OvV{FpKzSnIgb@vZyXtg@|GtOtn@`Cg\\\\aJmr@_RgIt\\\\}TdYbX|a@sAwHk[X{bA|GoNsEqnAbNui@lZaTyKgXdPsPcN}Z?og@~YacBtNgYlPzv@`PhTc@vhBfQlQ|Xc_@uGkTlNg_AbJsThOgA~EcYxU{JxKgw@fL~XyB_i@xBoLvMRkG{IcVfCdF}QuKqtAjC_`@dLaR{Bpm@hTca@?jn@nCpJhDiC~AyqDbR|k@dAjp@|[ut@wSoVpL?_Yui@xFeCdQtKcOgt@pAm_@sRtJ{HzUgBoKz[aiEiC__AdGdr@|XelAzYvtAbSdLsLyYlDwFnYhGuY}YjPc^_HcEwGzNwMyCyEk_@fSau@wAu^x\\\\zSHeWwc@}bAgCpLnNjc@{M}NkNczAe`AmbCka@ok@gOio@zIyG`L`N~K]rx@cSfT~BzEeJjj@ihBkJ_h@xBcIjDxLxFuPkj@se@kC}g@hc@yx@zp@ie@j\\\\m~@|_@`FxWf`A{A~T`Zbz@lKgLJ{iEbLmuA`KcPlYq@oPti@nPyKjDvC`RhWcBtHnGqCpEh]aNzSiT_T|Cf[fLlHxWcBk^hVrGz^gHtHzU~k@jPvGCeOjOp^hItnBeAtYoNbQrJxCsJzWxBpHh]gf@xBvTbPwTqApa@wHzDvBp_@dL?}J`j@|JqG?z\\\\|SyKgC~X`MfIaM|VzPxBcFnTrNtPeLzBdLfXlKqm@zB|F{BpYhJhYpGfcBzQxUfC`j@bP?sHz`@rHlLnG}G~FzUzT~E}CvJid@hDpVtRsAtZbPzGfTuTxBpLyStNiAbQhRba@pGuC_ExSrIxhA~Mu@|DnMrLmPnFhSqKzS|GbEiIjb@dQ`d@wd@`HfBzYoFfFfi@{ZbUzZwYxA_FhSga@lCeClIr~@dLoInKaNqBzVvPnEdRab@fMbOhIbMiIle@f]yH~_AsFlOeGkPaHhQ`HhWvTgXhVvMkPnk@qv@nkAkAhQjXsLnFy_@jQaN|s@sI~RoM`[xN`EdRyK?cApId\\\\zUqDxEsK}HkB|Q|Jbc@yNpYmM`CpUxPqL~HaYkNxK`PgGtLrB~FzGeCtBdT}Fra@{YtFkWiK_PbLoGgNsGvEi]sXxe@~mBv\\\\qAzHwNfd@{NaFtQv[rTyE`Yg^XkYbZdDx]nT|HcQxo@xKaGx@`F~Ws@fOlNfCaPpGna@rX{GEgg@`C_JlPda@pGs~@?jtAtTuCrFuTuBwFqGjKrC{\\\\|SbN?e_@~RxRv@bZmPvTiCqGqXvz@vZyj@zMVtVzg@vTvGlIrV~XxKeL`_@nGjEmEf\\\\lEsBtCvKtM{m@`]dRkE~IxKla@@ji@pMw\\\\nVo@`Xlo@hAnk@lM|Fd@zJba@ke@FnPfL[nMnOkV|ToGpX`j@}SfCtN{i@rd@`i@mThX`O|CbOom@na@dm@oMjYrDjBn_@kTmAaGtG~r@~X_HbMue@_EpX`Vuz@bd@dL?nDnIpb@yQj[bOkM~n@o\\\\qGsL|DzPxPgC~Hxl@wSb`@|BpG|Itc@yIkT~QpG`RiTna@tz@oa@lA`H_[|FwHfLbOhBjE~Pn`@{Dke@~k@yMWgJvK`o@n_AgIvd@of@fEcE|FvTh@iIxMojAPjTnP`LeHdPpCwNnHso@fBc{@~XtT?XvH_^bSgCjPvKqC|NzXgm@zNvZjCfl@eXpRbIv@hFmPDnRfHb@zIe]lJzQhJcb@~J~PlYih@nFsVaMg@xI~MdLyOG}C`L}k@yKeZhGuT{Fam@tS]zO{p@kK{g@g]sxAo\\\\{fAsoA{`@_}@wQmOkGjDbAjy@hwB|}CeZiIyyAmpB}gAadClWoQ~[NcEog@mgAg`B_YkbA~cApbEiHlI}Z{TugBujEud@ufBwV__@ozAieE_`@g|AsUc~B{Yoz@wQqKgJ|L{Gdq@qCqKtRq{AtOwDvTlRd_@|HbOqEvLlRrX{Kd@wLqUeKgCyKfXgr@rc@}k@hh@hAeKcKfBcQ}RpFmQmJia@|r@oTtHuE{SsOEyQq^id@yaCkAiq@sR}MbFig@uOcLuC{vB`Yca@qSkDmKkPcIql@iTnpC?fjClY|m@o[p[`Dfo@mNdx@jMriBeExUpKh@mClSpLpXmSdk@wHjhArOd{@vPpTlToEtOxe@_R|DgJ`a@\\\\vm@rKzRmb@Kk\\\\uOqX__AeG|S}m@erB_PcAq{@wtBuOmP{PfEcJsXqU}IkT}m@nL}EjPbVjSh@yKaj@qGpXaOkRiV`@i\\\\jk@|FvJpHmOlj@zp@nJ|Z`[lIiApg@sTiVq^gJ}QrXoa@?rJpIg@~M{`AnaAqa@zEgZxc@r@bIda@ui@vSlCz`@ui@xs@kf@vTbGnm@tw@`PwT?h]tcAbr@mMv[vDnu@dj@`w@l}@jgEbz@t~BzT~NvZh~AnXfa@yKrXz^jz@PrXyIlh@ga@nZePf{@wt@yrAah@|C~Xj]xi@zUiCtO`P|m@rZoYlHes@hIuEhLza@fo@xt@g[p\\\\Azd@jH`JxAmp@tMlEzIls@jPnH|Utr@j@zKcVSqXdRrq@||B{JhSdQ~KdBhZkVmD{E`K~G`AlSlt@g`@xL_Hmf@oOpi@}TdPjRjY_EbU~^fN~XkBwL|[cb@|Zw^~DxB|LaNbBsE|L\"],[\"{vkZbtyg@dKvQjSiF_U`f@gBuM{f@mN}G}PdTcG\"],[\"scmZd{zg@~TzLk`@nRmW{YaC}T\"],[\"gdz[fjba@i@ll@wOse@lLc[pLlP\"],[\"uoa\\\\pa``@ha@xP|`@nBrmAru@nFvP}Glw@in@k_Aw`@mYwJcAzA~RqEyBec@aj@vOnGrCoGgr@c[\"],[\"meb\\\\bhca@zKbGjJuGdMi\\\\hE~p@cRn~A_Ja@qFoNgKefA\"],[\"}`a\\\\lp_`@_l@oGtTwKn\\\\vKbh@qJlIpJfDqE`XpEoGrI\"],[\"gfl\\\\xfu_@cIcNrKmQ~DdK|JmGd^dT?wKfO~R@h`@mKf}@yA~`Bu~@bb@cDoEf[yp@sBsk@bPwx@\"],[\"ace]poj`@`DzP~JyCdR~KkI`_@sT}DgT_e@~IiU\"],[\"e_g]pk_a@?si@xKGpSb_@cBlN\"],[\"a}g]zo|`@fFyBzOz{@uEra@mXoMaHgR`HeXzJe@\"],[\"}zf]d}n`@uOnGkGqFsBwv@dBoHdSdHhDcf@wMkd@ma@oW_AaJnGzGpCaIa[um@lUiEdXnIj^`kBnXrNBzqAwLqj@\"],[\"wgg]~ac`@kFnHws@tH{Bea@wK`P}GoG?dLaHcJtLcl@`P{Bg]gC{B{\\\\eLpGOeXtWom@jWwBhFa_@fGlD_CvYrXpt@\"],[\"ej{]befc@sInN{RP|CgW`l@}XlTd[oGpIuc@iT\"],[\"eh_^~d{`@pDic@nHhPpG}G|[dc@vLvc@eJ|H_CvZyi@gMtI{M\"],[\"}pb^zo{`@`^h[bS}NeLjn@cKgE{QbOiNyn@\"],[\"aah^~qsb@hTjn@cGlv@{Zcs@kAci@\"],[\"eg_\\\\~{b_@nAsFbVlFdLxe@dPgTt`@~`@bf@bPnGve@hCqXtOba@bHsFhaBxh@pG~i@fJgv@tGlCoArJ|SwKfRjX~I}FzE`I|HeI~_Al_@za@nCpa@fw@~j@fUxUrn@\\\\b^wa@~d@{a@tFb[lc@qMbN|SrXoa@kTzm@ly@n[daA{C`UoQpSwa@nG_`@yTg]]eh@_b@gNoc@tInc@tk@di@dGxSo`A{[cWoo@iKe@xKh]kT?nPbNeAlI{SiD\\\\`Zim@yg@yLjAw`@_s@}C~NkOeBlGj_@e]wEft@tb@zEpS}\\\\eLrD|Kxt@z`@dLff@jo@h_@vJv^tTnGjM`_@nPnGbEvZbXjc@vCb]mPdw@{b@tDgYsFi~DklBIcp@sPxb@ca@lCtGwTeEmWiH^{So\\\\g@nVkQkLgRct@eLpXhGf`@cMlLgf@iUkIvHyg@wEwIvEfCxKaO|HgkApN{GpUc^bBxKla@}m@zKtVnI|DvOs\\\\t[oPtz@jTaj@nUJrsAdr@uFn\\\\bf@M`hAvs@pRr\\\\pGqGqG{Bh]wT~Of]aO{C]jLf]fX?}StKbUpQb|@iIjEaPsXqbBcuAo`@mKn{B~rBjTle@uObGtOtT|GmGlBtFkKrLxDhVmIfAzGf]ea@uTLzGn\\\\tOoLpFmOmJvA|Hvs@`u@vMxAjRtd@~X|VbLdGxMcPjLrGvHf\\\\kZg@kBbIxU`]?o\\\\lv@{GKlPpLuCtFzWzThKsGxMqXgCm@lNtv@nAjMlShLaAzIhL|H`e@{Blc@nT}E`Dvc@iRta@ySwCtDpc@yLfAxYtMeCjNxW~l@[rp@hHpEg^xbCgPjR_WYm`AycAwS_cAdGun@e[ozBkX}Neu@gcB{xAw[s]qk@s[_nCkMsAmCuc@{MmWoPaFdIbP{DpCwTuTca@qxA?zm@eGgChg@`nA|I~xBq|A_tAmU_HbfBxeBnLyCpHpGb@bMkJ~Jwe@yKbPpg@kTvnAhI~WqO`HcR_PqCpGoGwCnGvT|GqGhCxG}LtUh\\\\pAr[fWxEuE_J_Y~IeLlPtTsCqXve@lP~FcI~J~AxQdRfC|r@xg@no@qc@yDzK|m@_n@}m@qSv]rJ`G?mPvTpX}Gf]xK{GlPhn@qr@yKtOnSbUX~HzI_If_@rX~d@qKQwpAciBzjAh_FwN`b@kFtCaIoJ{\\\\wtCkRe]ip@_qCQp^`b@|dBaVpk@iXwEqWwm@oG|Gg]sXcKdMaKcDoQoYkDjLuIwEaQk[kTrXxN_CrUfa@|CkF|{@zYxWjpAn_@dj@yAd_@mn@ua@rKr[mr@d^du@sJ~UvWfLuTjKba@hCmKzGpGpGaPnF~LsNvRxMbMx@th@si@oGuLnK{SHrZpFrAlHzYoNf[nN~Dv`@}Hr`EjTwe@vE`W{I|ZcY|SzGnGda@we@lVnt@_@hd@{LM~BbZuWfw@dHtdBwF|LiNeNaPbPnYxTyCdVhJbk@qN`PiTsDnGd]iAplAiA~QoGgCd]x|@ca@jaAfXbTyKpLrCtOaPz\\\\|Bba@o\\\\iTh@zX`M`QeOnu@~MtSeMqBh@vPfWzoAsJ``@dSzd@_CvGqp@yXcNq_@`Gfv@n_Apn@uH`[xDpVkMtMd@~E|F}HjBnD`Tfx@_HtKjNpa@DpgCpKtXvKvqBkGxq@aRlP_J``@_o@Oqd@oNmHjYyY|BaL|Smc@pXqT}VcAm\\\\sq@ep@_Z{l@_s@{zBec@id@mIo`@wv@irAmo@qb@qEuVc\\\\mc@gJkn@ba@tcAiTmpAed@qi@wMurAeGxVtTxkAyGzGZby@}Txb@sC|`A`QfsArEbuCnYbrBur@ua@wkCupGdZn[jc@``Abf@bXrQad@hSiCqYq[aXlA`]sFnI_ObAc}@_TuPolAwJet@}a@ae@kh@ed@iS}m@eEyTc\\\\sz@ub@cD_^nPzAtCbJ?uO`OfMvLcEyFayAxOsi@pi@{n@tIm{AiEsUcN`dBsXfe@}b@~P_SO}@ijCaa@ye@rQjg@Sj`@uUmYf[d_B_N`RoFcExAst@ui@ov@`QpcA{NnaB}XbY_u@aCyk@wY}Xq]qo@{sAvQqq@|x@iMdDgOsYnN{JE{GiNrXscAx@ie@k\\\\x{@_LnLqRlAjTtR{AlMsb@f]{GuCuEzNyg@pAol@sUeU}\\\\k[eFynEmvC{c@{HcaAki@_Vl@kgA_l@eVg_@_u@g^qEsUrKmjA_QgZb@njAoGcUqG~G`@b[qV|LmA~c@}I|HyZuoCs]i{@sp@kx@kRqBqBw[kRvj@yI_UqGpm@mPpGcGpX?ap@xSkR_Rop@sNjKqFfd@kBko@uIiPkUyEcNaj@qMmBqRjK}GmPrCwT_Yf]_p@{m@ay@ulCcG{|@kYap@mi@smCyx@{xBnHmN_Fm[z_@`a@nJjAw@sVda@l\\\\hByIiBoiAlPcdAsC{ScFp`@{[lNsIb^wLyD}CiIhQnGaa@my@rIpIpOgWs@uTsCnGaP}m@nEiTdHs@cDo^~MgMvF`GwFra@bNsXtVbNuChp@xS}_@yAgPdIjAtt@nYnDnHiLr^tWgDDj_@rXoGgZtaChDhr@oOrg@xKqGdAtPoVni@rOzBcGf]z\\\\fCpG}m@fTgCtChTlKiT|Gje@nH{b@~c@}_@JsKqXnG}@a]{Paf@lGos@vSk[~QzLtLcMp]~GjPp\\\\sGdN`KE~G{Qdf@je@pXkKlBrI_PhInKdKxPj@?mP|XhT}SjHvFnSneBf`B_ClMiT{GvClQgLlJmpA_TvO`c@nLrEhHyP|f@xPlIoGbXjAzNuGbI}_@tExA`t@piA~a@fR?`l@jL}a@zoAvyAyKpX|B|\\\\bQik@`c@dH`g@vf@lLiA~L`Kfh@|y@?ba@xKoRtEfBhc@jq@lw@n]jStBkXm`@jEkDrk@dLnG`PxSHzn@ltAdNelAbtAdYb[vSmzDyyD}]yw@l_@_Ysb@XuHsLzK_Yo]fLay@ci@oKc\\\\q\\\\sQk_@klAdLtClA}PbE~GpX{\\\\h]z\\\\|d@`Ph]ba@cRki@qa@i_@uK}e@xHuIlUdY~CuRmj@uZdNqz@`TkL|^_{@q@cTkNkPuCrC}D}Nr@us@qX{v@j_@mPdZxQzIjq@v_@vgA|OfMpq@dyC_FbXdGxF`G}DpP`g@tH}Bdd@~z@l]dCif@ii@wg@woAqCmo@`Spi@wKke@fCye@x\\\\~XjTje@pGke@~SvMhOzUvZdvAff@r`@uIek@o`@ibA{LmpAhD{\\\\lKnGdJ{i@|@`]xKxKyKgcAz\\\\lK?wOvPnC|FkLmv@}m@gLme@fi@vYpf@nByScV_XiFwDgPwTgCbKaGsSqPeG}m@dGfC?aP~i@dr@{Scw@xByOb{@npAqGq`@`CeJhP|Dii@s^UaJzG|GX_IqFiN~PtC`Ix[rXcPsCoGqMrBwZek@wTrRoa@?ca@l\\\\oGca@|Xke@mPpGeHob@hDgKzB|GfU_OjSbAdU`ZxQHnDhIsFlEpUjOt_Ai@xRrk@zd@fk@~FMl@iYjTlKMmLtLeC`a@dj@{SamAmYam@rIfy@uZzAaYaQs@zTk}@kqCui@lPxSia@gKwGvg@yKx^pa@t@wG}j@eu@YmOpL}KqDkKs]mIsGrNiNiB_RqOy@qMn`AFfQuIqNyTe\\\\eDmDoKnOlB`EgIaZqM{KkYqf@qXir@kx@sIcn@r@_OzSx_@pg@rVeO{^]o_@bNlNvM{ExZlX`YoQjk@hP{SqKoTsh@}HfKkk@eZkDwk@bIz@iE{ZrJyE}EkQba@`Gg]uT`PoLcj@_Vs@}e@oOcShMuc@xKba@qG}gAtQg^ra@wxBrTkMzCke@bMw\\\\|G??ca@h[gWtMzVxKJnE|\\\\zMiCvVhSuElWdKvI`QgXrU~CnJdzCjKaP?qt@jSrs@cF|l@rFlG~DiDvWco@tTvgB|Bwz@fL~m@`GvK|GoGnBpGhHzaBvKz\\\\tIgA`EjIz@{TsZqwAxDuQ|R|LrBeMiG{P{BhIwUeU{I}\\\\r@s^hNqZlIpb@}@vd@rXmP{Gg]jOxU`@zwA~Iym@vNmFpSdJxKoa@xKbUo@uVw[m}@fB|kAoHpA_f@ot@p[jKsCo`AlGeBtCqXnInN|Gw@gWkz@p@gNfKu@nQta@`NhmAfPbZdC|m@~Xna@eLoa@tCgwBxKrr@?wOvKeJbUvzAta@jfASjr@nW~k@bUaPca@aj@?{v@yg@}kAfE{Xpa@df@lL~AnK}N_DaLoCzIcFiCy_@sh@sPuDik@ueA~BeTrOdX|G{\\\\hi@rcAmKwx@}m@y^z\\\\zBoGsXpr@ti@qa@qm@z@cKc_@gMr@yMkSwI_Z~BeQ{O~@kz@xSiX|O|HgDiVlQsF~AkNqPiFo\\\\_j@mQuEuH{`@qb@iLb}@uy@fQhMxKcLtJxYl}@fRjWzs@~XtZaBl_@yL`RwAdt@jq@vRtF}I`D|n@fQ~LlInl@`k@r|@kPrtA|Bzi@uTvReFj]dFxGn]d@fx@qU|AgiC`K_LvTplAuCjtA|FuHpLwqAia@sgBx]bGvbAhn@iCfw@pGye@|k@`I`Zl^aj@ln@{Jj^hE~EdNg_@vx@g]vIhO~x@kx@vByNgb@cSkHkLlCiJhm@l[fL}CgEmM|PuKgCaDh^`JiTm\\\\jLcJ@__@q]{@qC{IuP~OzCy\\\\ca@zBwLiWs`@nJuNiRaw@w\\\\_HgO|BiWaRpC{gAyt@iOsx@sJuGxKel@aFeVmiAauA|g@cu@|Hpo@`_Avg@`a@fm@hp@tIx{@`x@xKj[zIwZbPbLyB{IlGqEdXbWfQbi@vFcE{G{\\\\vTz\\\\oPer@t^zv@nFsR}GwZf]?rXxKgQcYVkGfL?tXt[Ayl@vKtCg`@a_@}hAa}ByXePcbBq^_GeOlPwKtI}\\\\xXgCpZxKfEwd@zb@Qx]fTiCqG\"],[\"ghm^dlamA|g@o\\\\tOfOvEbUm]tm@zCtY{OhUcwAbk@u`@u_@eY_z@~XyS|Q~Axa@ee@jVsH\"],[\"yjt^lbx`@gCtm@ui@uZw[seAx[|H\"],[\"_~s^luemA~Cpx@mKzKwQwKkEsTyVjOkq@kf@iRgcBlIk[le@?|xArr@`]oOpM~CpNnhA{`@aJ\"],[\"yxx^|o}nArCwUbPgBpH`w@yLpKdM`Cv\\\\mp@mHp_@f]uTzBtT`PwKqa@lv@gQqN}ExNkj@pGyZqPlDsVhInAhMa]\"],[\"qzy^r}amAfCiVcGwRpUvIb`@rh@p@vbAuIdP}`@~N`Cw[yKsX\"],[\"q`w^bzgmAss@b]wJqBcf@y`@}Bax@fjAxIlIjRvTpG\"],[\"_xz^jmgmAnPr^t`@tNsIlOxRhCbOoH|ElJuJxZa\\\\qO{E~UlSl\\\\aDbGmMkNgAtx@wIwAqUme@}OwDNghB\"],[\"oi{^z{dmA}Gjn@g]qhAZ{j@xBiNlz@e`@lNdNyB`vAaFlK_MyAuHg`@_KmF\"],[\"o}~^ndtlAvd@_ApHiRrVpRlg@nvAiCvT`[vk@~Mrx@vQfJhJv\\\\`k@|^bNvFpWwC|HnK|Sv_BmGhQcgA_NmTsTk^c@ir@ww@aBgq@cc@kbBmm@{p@c]{cB\"],[\"qn__@jaomAflAbBve@fb@ca@h]_Y?iCvK}o@pHuNeMiGui@xK|GmGkT\"],[\"cqb_@z~mmAi`@wFfmBap@n_@bOFrUkr@~eBrQ~Kg@lVyRtd@sNcI|Roh@iCwTwKdLiCoGqEdRiU}B\"],[\"syd_@zrhoArBtx@dH|IkCsQzKyIgCkl@uQoZuAaYvReNfZzItG{DjGxb@kTpr@~OT`NkWdKr\\\\ymAv}@{Hwf@\"],[\"k___@~`imAb_@ri@}Azu@kKk@aVg`@oPyFsKpOqxAxOus@ePcO`Mua@wa@NgKzo@kKtQySb}@oAfw@sS\"],[\"_sb_@hdumAbgAn@h[n]mOfB}CdMcIkDcOfGvFnQoWtTwDvXm[E`ChUqLlQo`@dZ?}So\\\\dr@_U|HyOqM_U~BcAiJ|s@_`B\"],[\"syd_@~`imAc_@zFqD}u@oYc[`CqI`hAhVb`Ahg@aB|Fun@N\"],[\"syd_@~kmmAkW~@_aAeTnJ}Pjg@cJfGnBiCjKpn@aA|_@bSca@nQ\"],[\"wdj_@hlanAtUePsIze@xMxP_g@rSp@{q@\"],[\"egk_@ppanApQwZfJ|MaPtOrCrRcUtt@\"],[\"qvl_@lhcnAtd@kg@d@dl@{e@`r@\"],[\"mzj_@`n|mAle@iAb@nVkPpb@cr@nn@sQlDjFjUmJ}FgNr]qMY}NkXbBm]\"],[\"m~y^lx_oAgVlHiAhKlUkCv_@pTuGpOke@zGsXle@l`A_XhApY{~@bh@jT|Eq@rMqnAztA?rXmPyKhAlVkTxEeHcFfFyk@oIfDaNlb@`EbUka@x`@cTn_Aig@~jAqZhKyDdPaK]qRdr@y`@jn@eRoXnVmn@{\\\\z\\\\uCeGpa@er@eL~JkNq@yQ|o@aa@`j@z`@}N~[b^n@tMsp@laAmIv@sGkKoJbh@cd@j`@sHqr@`Rsp@cGyFaPhThTke@iTvK_Tm\\\\t@lh@mPv^i@hgA{GuCzEn|AwLzKeImFeQx`@uE{JmUvSbGmwByPwo@AonC{h@slAaPqxAnb@iSjSvJfGmg@pTqVtCsXdj@i[jCvToKdWuL|bB`GjlBjNef@j_@_KuTqLqPyf@r_@qbCnLmRrUbSnG}Gb^`r@h@x`Aua@no@gExZfCtOxKca@vTzv@iI}r@~DwOdJiLvUxN}@kLdRaPq@ahBfEiCvFdhAdLxO{Bna@pGg]pXtTgA}b@lIw_@b\\\\gVlMeBvBtL~Xg^jEeWfRdX`Pui@mL_VnCcJdIf@jBik@`MxGtR}i@ve@jTd@w^hFsEnKvK{Bqa@ye@gCyKu{@|OI~F~T`RbLnJFeGca@|HzAtNlk@cNjHfE|Jbf@}XkFeIrR{c@P_PwPxZsGeAtCkR_M}Xj@_YjXwb@dKxGlL|q@tCajBna@nP\"],[\"}hd_@jurmA`Yz_@sGhk@si@`pAgLyKaInXmh@lZ_TuBxJq|@wSt`@yXgr@kX_YkNJaVqcAqUpWam@juAx]uM_D{Pxi@w|@pGhn@dn@hl@hZnu@aQ~N^xT_KnMsa@pEij@aUqCeSdFwCuX_Xmw@`AqQmJmd@fNkFmEyCmc@tIwfAfiAg|BbFuj@~]y\\\\ty@e]|nAeHz{AhXj@pK~WmCzTfPhCmP|ZzIpEncBu_@cKebAlCsl@eSmPwVe_@gNqZm@uEnJjClH~a@mEeBnp@|c@{KzJhQn`AlLbe@mJMlL\"],[\"mgl_@lg|mAcNtVy[rCuYiHwe@qm@a]lOxDuo@xJaNrX?|SeL~XrCdr@wK?vkA~F|G\"],[\"_br_@~ytmA|NoCrb@~SgInl@jK`aA_ZpQov@or@ab@yIaUa_@xf@wK\"],[\"awn_@j`enAlZeB~RxRaPz\\\\`PpX}Dtq@i[ba@gTzH_Lbh@o`@pJcHle@_OtDi@|SyKzMcHt_As|@fv@aJkn@{EvOpE`t@mUho@qo@kFcBgk@fa@oqBrkAqrDjd@cx@\"],[\"{tq_@zwymA_vC{Bog@mkAeIydCjX|Z`x@~HiDb{@jn@zeArc@q@\"],[\"kqw_@~~qnAui@egAq[lB~@eUjLiWhU|FbMeJ`Mqx@zf@b@le@wTq`@buC\"],[\"yq{_@~~nnAwKyk@ng@g}AleAq{BdxAs`Bf`AmbBwEz}@~IhMfGucBxS}^zUcNrVmh@hSxDbSoKhSx@dJlSeu@d[cAlMnXlQ}Hr`@bu@uQFv~@ut@tm@uCyKcGtT}G{GfCpa@aPz\\\\yK`AhCfRkThTsc@gEgCjn@ic@qN}NnIW`WqLqX{Bba@yKmPuD~NjUh\\\\z@~ZmO~OsMcNiFoa@jAkaAuNlm@tO~oAiD|Hwe@fC{L|_Aku@rFyKtn@\"],[\"si}_@rxrnAvk@`NxE`JcFla@ka@lm@dI~QkJ`FbCtHjXzNvT_e@fQkJrJpPmKvg@a]mCeHnH}i@a^?aPqUwYyRwu@iFl@wFss@yJ}F~Pes@vm@sx@rYsI|^~k@pg@x^?ba@kTfJpPz`@_T~FgHqRae@{]_Hsp@{RhJ\"],[\"oen_@bm|oAoPaPsOpaBqa@q{@bB}j@iHsAeEbRjKztBke@ca@nIarBtg@_`AaTgHc]z{@gWtRcMvqAmMyBoPpHxSxGfCrLkE|^cQyQkOpDdLjNtP`~@eLdDqTiZ?~~@iTwT{EtGzEhj@mKaBiGjJPow@oB_IwNhCai@xiA}@_q@eP``@m_@CvGf[mR`r@eRc[oc@~k@gLjC_c@gZ{\\\\A?kP_YwT_e@f]kd@w[m@fSeIeKha@{gC}IgbB`Pm}@pm@eE~m@jcAdJxBdEmKoQgXaFa`@oc@sMwMcv@gUa^uDwkA`Vo[pX_DzLkj@jTiIdXnEve@uVdRzOlEl_@v`@|ZhHjrA`U`d@jMjx@dG~qArG~CtBei@nWfDuTea@vKca@uOkyAfb@naApWhNmUkn@aE{hAiIyJyDxb@eN{DxIygAwZ}Yim@rTaiAagAsVbUkq@hIsSj_@fLysA{IipA}o@o`Cg]g]hlBhS|vB|iBx~@dKpp@z]fa@}EjYvKzi@wOhz@_D~m@na@hQ`[xCtaBnHrc@lM`FmCfYhJkSwA{hA~Xbj@e@dd@_Vhd@nSzD`KfiAuBlOkSgEtIjZyFzToGyK\"],[\"__``@fpnnAuTjXoVsIiAuUzSw`@bKcC\"],[\"__``@vyvnAdAfZwc@~aAgX_QGeKtj@}aA\"],[\"g|b`@x}pnAtNpFke@x_AyMkJuA}Q\"],[\"i|b`@`}pnAeQh@aVw]~Lo_@hSnFvN|S\"],[\"gwd`@b|xnA_KzD}FoGmKiXzGmPhSdA\"],[\"ooi`@fqxnAna@hTfv@bwBso@oCg_@iTqRbAsCco@lJ{[uL{^\"],[\"{dl`@|runAiMnMi[eqApXy]zb@dP_Ed]xHjN\"],[\"}om`@fcnnAdLnG?yKbPhC`SvRdS`uAeWiV\"],[\"etm`@rhib@cIQzDyOhLnG`Fx|@hUjh@uCfsAebA|j@}CsIvKqTii@coCjScCdH~TrXxKrTiC{Me]nJkV\"],[\"wbm`@nnrnA}X~QkuAi`Cg_@u^mE{LlH_Hl\\\\yIpLxCrgA~dAxe@bpA\"],[\"anca@boad@`IjAeEwg@zXxb@bLbjAyEtTeEer@iTje@{OiByGwLpGscA\"],[\"krea@`rfd@pNcj@rDxe@aM`jB}NxYeYpHcC_NzGrCtDkHuDu`@}FbH|FfKyHrCgFca@qQMyCiQcJiBnGea@`IhL~JeF~SoeA\"],[\"k`la@pnnd@nPf]jKg]wCpqByQxdAcc@lv@pPwhCmHyfA\"],[\"qsma@~zjd@dGgr@cPxtE|GqGhGbNcPjYClx@oGqGqGjKpGgcCwHhOiFboBkGcFEu^iThTdDoxAtTsqAnJuJfLna@}EovA|Ead@\"],[\"_epa@xrjd@qX`KgUk]N{_@aRlRtGyr@jIbA?{GvTtTuCg]\"],[\"}gua@rurd@sXeNwOap@sTmCsDuXzPyh@uBwl@na@ri@lKca@~FpJnNte@eAbMmJMJhPxXtP`Rhm@`JoKeVkp@xJi~@`q@gFjNhT`MgNrLtBw@tg@ucArjBKjLud@kM\"],[\"uk`b@`krd@|Htj@uHxfByFbYkL`EwT{~@}^ab@s@ef@nOu[nGje@~G{\\\\xK~S~@xcA`NpT`CiPcIq\\\\\"],[\"ivcb@z`wd@lQmd@xK_G~PvG~^nsAse@~u@kQViY__@gA}RfTlKhCsGqXm\\\\\"],[\"uegb@v~wd@kq@o}@`Lam@wD{Rf]lPgB}i@rWfT?yKdMnEhH~h@{Zbo@{N`D~On\\\\\"],[\"cygb@brxd@tBvPiZ`z@xItVeMnGck@az@uB}p@pBmE~TrK?ca@`OkH|SnK\"],[\"a|ac@vsdi@da@mXhr@l`BrG|GxI{FvDvJsQpb@vSdP{FzXoaAio@gk@ni@yFoiA\"],[\"u}nc@jpbf@x\\\\qgAnPtV|M`lAmEb[oGcPeLje@aPyKnGoa@mPlPua@qNcLyPnDgb@dGzGbUmP\"],[\"mnzg@b}y`A_c@uUaHgPzKiJlx@hVfUdc@tDte@mTi]\"],[\"mf{g@dh}`A|Ne`@n@ob@`_@n}@{k@je@ef@nI\"],[\"e|}g@l~p`A|Gc{@|Klf@}A~bBko@lk@eW|DlAuZ\"],[\"ozbh@pn|`A{Epp@uQ`g@oD}KhQiv@yKuCoGh]oKaQaFyo@wIsMyBdLcCm_@rKyVje@rCf]z\\\\pXmQLp[_Yrd@\"],[\"{`fh@h~aaAvDxHzRu@`Prr@iLzj@k\\\\`\\\\qGmP{GbLgEiTbNo{@{Go\\\\\"],[\"qehh@l{~`A}QmItHsbAjdAyq@~GdRiJjNpGxx@|TcUrUhk@ke@vUaL{AgGcg@aKf[\"],[\"ilih@x}mz@cReg@hEqRf]OdXf{@nH~t@cLtS}TqMoGhT\"],[\"q_kh@h`taAiLcwAhLiRnLd{@SbZ\"],[\"w|lh@xftdAsAwdB|F_]?paEyKtc@cCcq@\"],[\"gumh@vvfdAiFadBpJuUnNfnD}E`n@uC__C\"],[\"{nlh@xuxbAwz@unDxi@`k@fVzyB\"],[\"wfnh@ll}`A`Rh@vWzU_DdgBaBuJuZgJ}Je[}Iml@f@_V\"],[\"_qmh@ddnaAl@}PtUXlBbc@qb@xzBiNl[sVjGdXi}AyKbP|AevAzHw]\"],[\"ioph@d}k{@da@fCnGsX|IlLgB~a@yh@zl@\"],[\"wfph@hzvaA`PiW|ZLsAfh@ki@neBfCin@uTvT{GeM\"],[\"i`qh@jsdbAaY_wAtC_[nZxVj[|vEmWcz@\"],[\"wfrh@~r`bA{r@_dCkC_n@pRlPnAhi@`RnIvXn~@xAp\\\\\"],[\"cauh@dyccA?qaBdL|gAn\\\\lPrEbS{Ahx@eO|FaPwe@sJL\"],[\"ykvh@t{a|@dEcG~k@bGnF|Qk[reAoGap@yIuTgNk@\"],[\"e{yh@nwsbAhHeq@n\\\\ob@tJd~BiEnwBqQge@\"],[\"e{yh@t_pbA?glAvLzRxBre@_H|dAgQlTwFqpAtCuC\"],[\"meyh@hyw~@~MsVh\\\\iMpPvUxJjuBkd@zxCay@jrBmPmz@mDw~@lDw`C\"],[\"qa{h@r|pdAm@qqAjIyl@`IbTaIfjB\"],[\"ih|h@jltdAh\\\\o|@Wzc@{_@tt@\"],[\"md~h@vea}@d]rC|\\\\qLrK|GnHdL}GrcA~NeOdb@r[q[n}A|Ari@sOzi@eAfo@c[twAiFww@{g@yyBoSiCuOuSnU_eE\"],[\"iq`i@dyzdAlCsxArIh_@\"],[\"my~h@fdd|@~S|G?xv@b]d`@jC`Q{\\\\~~@qG{\\\\oMbNpGbm@vSpTu@zM}XnPoo@Qa[eD{CyR{KuEy\\\\f_@}[oy@tForArOkq@tMbBpGaKbVkq@lBom@hIiQnGna@xPqDxFfUp]gQ\"],[\"cidi@l}`|@ra@_b@bj@dHrK~K}XzlBgXz^oPbCkTqIcf@k}@bXuu@tEeI\"],[\"qifi@z~}|@vFqN`L^bYxZpNreAsErVk_@c{@yQ|gAcRoSgDimApJiX\"],[\"ieji@fxvqAcLmHhE}zBtIgSxK`Po@ai@bGgDxWzTsTvg@\"],[\"kali@jhs|@`EyLbMxR_Ezj@zKvRlRhB|Gje@oFpWzNvCrBpUkFjX_DmUuOeBaPje@tAui@_Wg]qKfe@dGbSs]h~@}TkPiEwYt@u^fSgMsMcg@dKs@hHsx@|BiHxCxGbF}B\"],[\"o_li@xy|y@kaAxrB?g]`Pia@yA_}CnRoe@mWw~A`KgSls@gUjWv]vTcLdPpLlGaDyLzqA_n@hcA\"],[\"e}mi@zxvpAtBuXzCne@mJvi@wZahAdLmv@\"],[\"_{ji@h|py@sPfa@cTpFkTcd@}Lj@ae@qYsDes@}M}Ob@s|@bBqNpGpG`A{E_D_f@x_AeyC|gA~R`Pbp@zVj_@~DhnBuIjT{BiTuXjCqMsf@sh@lUaWiJsXj]q@|eAzv@qa@|h@bn@zP~KnGoGtHvLuHhbAiT?\"],[\"{loi@z`|sAfVgwBpNy^rG`UmClkAj^xcAuIjj@uSb`@pGbn@md@ghA\"],[\"e}mi@z|vqA{HtJwHmFmWwj@sBqbAzm@vt@\"],[\"{rpi@vsi}@nZkCnIdXyB`_@eNvN}X_EoDqj@\"],[\"{}_i@htby@ze@nd@vd@peBfe@bx@s@tVi]_LqOv\\\\bNrsA_Ep\\\\nFnQzQcHbRde@t]kFvDrWsHzhA|Yfh@jJ`u@ts@xxAhSjkCip@fgGqf@dbBa@lYv_@a]bLjf@kSpzCtHfb@ss@|xCuXro@t@v]gZn~BnOlo@_s@ziBgi@aU{R~bAlDne@jb@oNvLpEbItPjAzr@qZne@}Dxd@xFvl@{Y`zAwWnEyRqa@wLV{O||AfCrRvO?lFzIcFjbAda@us@pUt|AgOj^gZf{Bi_@rLgn@|rAmbA_tA\\\\wdAoPmb@lLgu@Hu{BcWir@?kuAgN_m@qKcGmTlEyZqb@bNi|@aPeXiAw`A_OqI}JnJu`@hxAwYxb@qR{T~H}lAgFsSqZnjBuVlB{Oe\\\\nIqoBfj@_y@xRsy@Zem@pPXxEmHwCok@mGkMe`@b`B{u@vrAoI~p@wRgTkg@kCma@k[iXgg@oGa_Agm@sz@uEe{@zYqgCvZ}QpRw|B~TwUvSi{@tTh}Adm@a_Ar@yGiw@oe@yDkRdH_d@ha@q_Ad[iVzs@gvBd@m}@|Xwq@fOinBlfAocEx^m]vSvTn^vIbKtQvXwUh\\\\fM~IsGpLy^Ma_@e_@vScYiKmVdJki@mo@l@eQh[kR~Zwg@ba@aPjMh\\\\vLz@nTqUxU_s@`p@cEdHyI{Ime@tDqd@`]s]dB{PqAgc@aj@agC\"],[\"{fti@bsnz@kGfLcUSiGsKDko@fP}c@`PrOxHmQy@wk@nH{PzKv]gTnr@\"],[\"umyi@nrgkApRj]oAhv@nGe]rBjJsB|b@_JgA}KeU\"],[\"ch|i@lgadArL}gCjCrfC_HhZ\"],[\"ch|i@frn|@nPvT`PgCPtNcYto@iDiLwJBmAcN\"],[\"ep|i@lqicA`GwnD`FbpGqa@xdC_GkUfHcwB\"],[\"u{_j@`refAhAg`@vMyWcDppBmSjp@\"],[\"y}`j@rw}eAnGcuA?j_AxK~k@pBh~AmF|YcK}_@bEww@\"],[\"abaj@hvffAyFwdA`K}}@?dfD\"],[\"{paj@vkveAhD{j@dPrf@mBprB\"],[\"gjaj@vgmfAdBgvAfHsVjDztEoCr`@iLxPeLkg@\"],[\"cbcj@rqgmA`Gcp@xTel@fCrXvLyBrDnJ_Nd`AxGrGpGcGtO~~@\"],[\"aw_j@r~f|@gCbdAma@~wByMlN_k@iDkUtYxKyrAyKwy@rX_m@|Gaj@zBfLjE}KbAij@bPyq@lFh[iOl~@|Cj_@n\\\\y_AfKPv`@wd@fDnS\"],[\"_p|j@`}zkAsG~\\\\sBySpJaa@\"],[\"czhk@|imbAxKwTV|j@{Ph`@\"],[\"yjik@fekbAbGe]cGjyA\"],[\"o`jk@tqibAfCwTnHlMsDlbA\"],[\"{lri@bdq|@d\\\\~LhJ|_@pMaPna@tZdAznAoR`e@pLrwAaOpe@kUtLwTj_@}g@xXo\\\\e[oLMeBxRdcAtz@b_@hIj^|i@n]bUyZxS}a@|xAtC`u@eg@`t@aZvCaDrInMlo@oAdXah@hHuJn[~CnPfd@wIEvd@`L|]tTi]bRdWt@xVqO}CwOhQ`WdI}Ebk@d\\\\n}@eOlw@jGpDhc@m_AnC_x@na@vKlUwFzGrLfLsC`PjjD_q@nhFoo@rvAbGjr@aPpX|G`j@dJuEtb@nR~f@ysD`MgSdy@gf@kJ{Qsb@|@cDiG~CqZtSgNje@cPba@z\\\\~W_Lt[nC|Wv]pWxsA`e@vElKeLt@mQy]_UgUou@sz@c|@_R}g@}SiBiMuT_QuwD_i@_s@iMopB~JkmCzf@igAnf@f}@uDnq@vGp`@hT?cHif@b[il@~\\\\b`@qUpg@_Aha@bIvNfGaK~KbBd\\\\iqAgCcMcXsKgGmNyJgz@hi@m`B`TwHdVvFhp@|{@rVca@fJdUtJphA}S`y@h^tcAzFvxA}Gb{@`PcLpE~Db_@rnAGzdA_TjfArCnHzW}e@zFnFrXl|CuZpzBjFzgB`JrQpMaDhC|e@}\\\\l~ApG~^g]duErC`uAwTflAca@pXtCrX|SwTuTfmBrh@vr@pE~f@}RziImc@v_BeD{a@iWcn@~Gcr@wH{SqGxKmRgsA_PXmRldB|G|[~Aa^`M_WoQlgCiCl|Ba`@rw@aMzqEjQj|@cGnv@jE|pA{V`aCq]kE}^ynAsO|CeIbP}j@~C}WzkAi]nk@uGbl@xc@faBng@jEvIpcCdUhQbv@mh@rMzE`Jlv@bEfGjUoFcFpq@vOxd@pdAxfBlSrvAj_@jbHdDptDaKtz@dBtcC~FnP`PkThRtRf|Az`H|PffEfb@flDrGpxJd_@b{AyTxi@{C~lAnQhv@tXjhE}Hle@iRwyBqIl[rRtgCh@``ElPadCjRn[aNbnDlPn~L_E|]bMfz@aBxbCpS~a@jFrw@kFb|AxKfwE{BjW}GpKaIcBmG_u@~D{jBiYdg@aa@lyCma@jy@ka@`DcfA`fCuK_CaEqYcVf^ePx@qa@s}@iFpFa`@sG?fi@eGaGW_n@uZ`_AwZvKgCwT}Mff@sRuCvKerBcIdSgX~uEkPbd@dDhtBuSrkAyA`xA`Un}GeQrs@s@rh@rWfaAp@xn@}b@bjNkUfp@uo@hgH}\\\\r`AiT}m@sXba@qCcOkK`EuGjgBtOea@rChNo]|jBgG`M{O_q@kL|Ei^zsCi_@ruA_yBvyA{V~d@ub@ms@u[{dB}UwnC_x@weQkz@aw\\\\G}iEpTmzAvJivFgLsfBr_@emHeBqOoGxKaAeMj`@okFiDug@oGxe@mMeb@vHgmAiCuc@oGf]gF{IwHyrCyK`jBeF_]n[eiEcEu}@fFmjAuJip@tDieAyJsp@}A`gAuI|PkKk_AmPbq@zCfvCaMd`BaSvx@ul@`h@uMf^m@dcA{Jdk@tGjd@cPvZdK~q@s^`tBMnjAtOxKk\\\\zsBuPt_@dDlu@qVxGHf`BwUtvAlXnc@o]zaBeN~fDf]txBuJheBfYtbBlIldFvMjiB|AnyGxKtg@cPbhB~Op`EwJ`|AiJlImAeViKlh@~BtyErNf}@rB`~D}IryCxIbz@gOdZ|L`cCgQfeAwOvVmSwOcTne@adAbcGkW~u@aeAtzAyR{Ts{AynJmH}hEmc@elFfAayBaNcr@{BvKwKgSsl@}nIcAkvBnGb{@rCm\\\\aJun@iAwqDeKiU}BjlBiYlaEqDmNAcxAih@a~DpGxKrFeGgGmtAdO_[tKa|AmNez@eCqhAcYs|AsEt_@bWltBvChrAiLdnAmP}~@oIx~AnCveC|\\\\x`FdL|dFfNbr@E`|@`Mlv@_B~lCbTfaBfInrBqCzOcc@{UiAvz@qGaPiOh_@aDdg@pG}GfPrq@nLirAhKqHl\\\\ti@cGpaBgCuCoEnO|Qxk@eGj~@bDbbD_Hvq@gW~g@qVIiSua@sUmmAiCkyBjIm{AaEkOmKdr@CogBmGoJiZ|vC|H`[qLfPkLeOyNazCbAbmFnV`aAfKaDxDjRzJ~jCi[cRuPjf@oIzoAvLh`@oGb[[~xAnAzQzEaE`Pba@bPqXjEjLkZldB_YjYgaAxZiyAoe@sl@aoAie@g|BwPqVkOhGqTa[eVm~@cAesAwZkcEyK{Lse@`KaQ~]eD~}CqOxdAua@~_@u]mNkS}a@eu@ojD}Vk]ih@qhEsU{c@yd@}cCwiAmlCeBucByZ{bE_y@e{D[k|@emAihMmH{wByRajBoQwh@U{~@iR_`AmNwgCc\\\\ghBkJs_Bp]ofEjlAovCzk@eg@bo@f^hV?`PjTnPfCnUqNt[dSxImBf\\\\ar@rHdFlIpaD`P~X|Dt`@xDbKf`@yLhAwt@~R~`BtSri@nI~pAxKaa@~HdD~@mFpD{rBqSiWa[iyB{NwuCpGnG`D}{@ef@suDlRv]`c@~lBTom@oO_Xq[koBmN?yK_PmLdRcEncB{HAkf@cjAmk@ay@ar@_{BeM}mBla@okHnc@s}CxA}}Bru@mkGtAwz@fMwi@jTgPvN{rC`PpGrMgNbbAfq@ra@p~B~Jqa@l^|mAzl@|hEbLnIfM}r@gUodBzJwGhInHjLde@jRNf]lh@`IyTwMoXke@{\\\\?we@ca@grB~@`bByMfS}GgKiRu{Ae^wy@gDq_@pCcw@na@xeBzBov@z\\\\vz@{IutAlRiVzCot@r\\\\ov@kPfAce@bc@kT{@iYwaAkGjM_I_St@gqB{\\\\d]u`@mqAlNgeAsAkaAjK|AhTteA~Quc@d\\\\mbCqDoOsi@plCeLc]o@qb@}PyTo@a[nRocAdv@{pB}Lao@~Ni|BwkAljHiG}e@jFa}@~i@qcC}Fg|@kQtWwNbs@o]|[gZl`AeF`XhIfjAiQbl@qGglA_Q~UqDgEvBm_Bf^y~BeD_LwMxVqGsr@q[xkDrMz~BgEdDmKsq@_MuDsS~b@sNtyDqh@d_Ac^`fA_KlDkIoJnBa{KvN}iBl]o^aHyz@vKktA|HpKhA|s@nHpNtcAajF`KnPkHaiC~L_i@xKz\\\\~TcaAlHaeAjHbFnErn@fNgDhI`QpGaPxKvK?k_CyKn\\\\qFgLdXoqCre@iZjKvAtJpUd`ApS~T_KdV_`@zt@ob@r`@tBrEya@sNaY~GgXzG`UrCcNrX{Ivz@xKzGelAfXz\\\\iT}gAjCmS`_@nQzQvl@l]e]hTpXzZ{gApIfCzG`j@rIo\\\\{Ig~@dCy^xSga@fMyGbOrCrOm~AgEex@kIiIwZzbAeRXiNySjO}qA{h@o`AiR_bEcSb{A_S|l@mIeZ|FckAcPwa@aPdfBc]vBaTxpBye@d_@}M_Y}Mh]gCoPqG~xAqcAvH_[xj@gF}OrMmv@uJqR}MlIcT~eAqGsZqGr@aNnc@iMjC_Dof@{KeMiE`bA{Zlx@gCscAeMfHaOgH_Tnd@oGro@qLqa@oLba@aIpGmMyKub@to@_I}OeeAvt@{MsVw[lf@sOyRic@r|@_Eyo@{]`cA}RnSkYsWyQwy@hIsxDkMzU{Nb|AgRvg@}MhAoGjTiCsxAsXnm@nP{vDk@cqAzW_aCny@_oDrI_}@}Jse@o\\\\hhAwOi[eHtAsQ}dCtJm{@`i@_~@hIqeAwMwNl@uS~ZeT~G{\\\\`L{IxWwtAbPoTzV_lCeIgq@hBuo@lD_Lb\\\\gE~Pwg@bOa{ApGhTna@qgAxKhT~Gyz@t`@_{@dLhT|Sqr@rCdf@}a@|}@tCvPf]gr@bPlPj]ggBfIlA|Id`@rMkRxV|@nGcv@bGbG|GwKzIvNlIy_@dRdHaA`z@peAqgBvVaIxw@mbAhLu\\\\lRkAf]vn@xImOxMnFzGkn@dc@of@fj@q`@rj@yItVuyA|XcQdM{b@tf@kc@liAwbBph@lYdQoUlF_`@z\\\\lOxk@{f@uI|mAhKrp@rLfE|n@ib@b_@`Vvo@niB`\\\\kb@t}@orD~Im~@vb@y{@btA}aAvJu_Bhr@qyBta@en@tCqcDjCyUfq@}qAdFecCqJsCwG~NqBts@gWhz@at@nUiLoQ~A}Zpq@_mCbV_L`ZrFvw@csGx_@}bBvWwb@gFgh@xq@w_Cf@ki@mZye@}@}{@rRc|@bByEt\\\\jd@vKfA|k@wg@fKa`@kLmoAsYoOhWisA_Mc{@}MoN_FvCxDy_AfWw[fd@bKjk@cj@|BzKvT{KhOhQlNx@hGo]lNcN\"],[\"clvg@bxok@{Zhb@aWDq]gXud@iu@qgA{m@iVoqAqCwxB}RsmAa^yj@{m@kFuRin@ma@hmBmT{Fen@bk@oTpBaPpm@sXer@ca@`j@iKcGkKdYi@di@i[vk@gV~@oMqj@uXhF}Trr@{c@pl@hQdm@oa@hn@oPwTf@|g@wHoAeGiTiTrCmP}G_hAvn@a{@~J?r]dN{BlD~GgQ|g@fHbSoNfP?x^wFeRuMlI{Ftv@fJzTvFaEnFbU_Nv`@}Ado@mKmk@uMgJ_FiaAwG}BiG~MaIqJwAgt@wKzg@A|VzGeLfEja@dXrAh@rcAvDjIxBqGvQji@zEfn@dLmKzBbPaP~i@aPw_A}DxxAmUvgA_XhY}M`e@mS{`@o_@cScLokAgLaEeR`v@qEsc@kTaVsEzGhCnv@sX}gA{Bnv@yKsXwO|qA|Bry@cMjNP`pBnPmPrCme@`Pca@hTyKpo@euAhSzOzO~l@gFlWwQ}LfLzfC}Gb@cEhSmAzhAkZtg@mFzh@aFrJ_a@|G}j@pqB_W{@cP_vAwMmPsIzMeAleAzG{BpG`j@Yox@hHyN`PfwByKhI{GfwAo\\\\}aDiLgVeIm@wEzJ{GmPiInQdR`tE}So{BzBn{BiTqrBnGxeB{Y{r@eOmcClPjn@MmK_PkyA_NlPiUdwAdOjoA|U`e@bFb`@lMqDzE~PnKgFze@`b@kAvt@_jAdtCgBp[dUns@t_@am@f]rcAx]t{BfFjoAd]rv@xo@v{DxMjdCwCvt@k\\\\hb@wUeDoa@u}@qRcUeQiAgA|b@}Rh`@iHvCyUyNw\\\\~EoZweCjHsbDpRkm@t@k{@_\\\\seBcOyGaBcvByG{NuYlBsFkQal@|Mgc@jx@gc@phCwPba@iXx_ClDljCwf@~d@eo@|mBaO|AuKgJ_h@uzAxVkaDiJbK}Qq\\\\y@boBuRj`@nGr`CbHpJzBglAvZlc@J|i@rK?pVzc@aO~nBuLqDyKca@lUdfBkDba@aP|M~Arl@oJfi@kPyLiFqZ]ex@eLsV|GiTyKal@|SrXqB}i@aUep@aPmEmBh|@sLrJkNqFsIkqA{IbKzIj{@?p{BsX_n@h@|_@rWv}@{MldBaOsKo_@imDaMiVtp@p`IbJ|JnKtlAoCagAnC_HzBbGlP}m@gC|m@jNDxJlP|Ezi@er@ti@sm@iFkz@tOsz@kP_g@pOsQsG}IhLe^|y@jB~]gJjZ~XpeBoPvg@gChn@vRcf@jMjJmExv@sIuEtCri@jKfRoG`p@qGqGkPpSga@zdAsOi}AcJd{AzEtZ`PeLlAbMeWtq@uOoPu@pg@g[dYwP|@rDjv@kSpc@jRxIjT_Yo@lQoWbi@u@xj@cJnWeGcPoGba@}Gwe@yKrXG{~AgG{ZqGngAcUqgAXqX~Y_d@zAeg@{Njh@eSjImOxW`BniA_KrUkNr@uCdJl^hvAjFLfCuPcJufAvFgEfz@bmAzB|\\\\hC_TnGpGrXsC`Ujg@mPjrAaCq`@gJ|F{K}DkV{k@qGnGoGsCnGpr@dLkTjXnVuDhbAhQaUnMzIaL|{@hNnk@pNbIrc@gwAwKmn@zAwYt\\\\uaAjFm{@uDmXgM}@[uVfXqjA|iAaiBb]_BdLmK~TnBjOa\\\\pVsNx\\\\LvTjTzMfp@nPdL}Gbv@hEdsI|ThzC}Vfx@}b@~[eWnu@lVz`A_NjYsOwK}CzTsChtAxLfx@{Axj@m`@jqBaDvkDiJbk@|EjeAuCvUgQfOpGlg@eMfkGyNbsAtE~e@n]ym@jBkeAzZygA`KeGeSibAtUgyAzk@{i@hYavAj`AkeC~TnM`SpaB_ElKgFmKcRjpAye@`z@gIf~@gq@`cCiCrw@mNtFuFyMiArrBwxAncF_l@tqF`Ar\\\\sNv]i\\\\|uCm[zhAwDdt@rr@ucCdLwK?e{@vz@qfHt`@atBje@ix@zTauBjPsh@`Ppa@nPmPsC|gAvLd@dK`bAmMzDqB|^fUbb@pGve@fCaJ`SzFoJxdByKuXuBbItBr_@{F_SfBgx@aSluBtKj\\\\a^z}BgQ`a@oAtR|FrDqWtiBgUliAiL|LrCjt@iGlVfG|d@iG~RzSzr@|UrhE{Y`nFd@nbEkMjgBlAxbBkQzuAwYdV_Liq@iGi@sBrMp_@tvAuYxcG|Frn@_Ax|ElEbb@|IjJxLgL?uOyP}fCx[acDpUeeA{Kt}EnL~vB}hAfoH{kAbnD{f@~CgJ`KcNsMeNbVgf@wz@{\\\\{zB_IwAwGhK_Krc@v@tLhToa@v_@xfBtDfo@`]xr@dDdDd\\\\qSqEtwCyMnHmPeaBh@bcBcM`W?wi@aPzkAeL}m@kIfa@hRteBvNuA`MhfA`HwKdBqgCjLcU~LvEvFsIxKjKrCoG_Wn`I_AxtDlIpJlRmsBz[juCqFzq@oGmGoRrcAgBbjA}RheAeLsi@|G??{cAvVeeA}I_y@qEjNqkAbxH|DtWoM~zC}p@ddC{v@lz@uO|CyKda@oJ|Emo@`jC{Xji@wv@vr@}RqnAk@qeAqGfCgCaPopAxeF|HimEbFuJpGbGvKwz@cLk_ChHsr@qImyB~O}oCdIoV`W}IzGaRlM}lGsOa_C~FylEqViRaMm[xFanDyY~kEuFnC|@~a@wMzSui@hhEg`@h~@kVsNwF`KqGuOyIz[~Ahb@td@hcBkKl}@gIhmEpDl`A}~@hdW}gAh}AqGsL{\\\\nPoBe`@kFj@mXvReLv`@iGa]oNnCmMcPeMyl@aM}QgCtC}Lsd@uJhFqGiTiTpgAoG}SsEvk@_RdAgn@zdBwIcK_Bm\\\\wWwa@iTtC}GwTmSpMw`@goCtFgv@eOqIoM{o@mIvCoBzR|Lr~AcPnpA_P?gXve@eGyQcYdMkLaBij@ohAkM|p@qIoFdIyLl@cx@eDhz@iIhB_Ou{@_\\\\qIs_@pE}\\\\u]mGam@hJem@uMkWwLjIgCo{@oRt|@_NrJsXiTaPtO`Pg}AhCg}A{KzGoGsX_K`yAaKhKc_@}x@gGxEwNcTgOvD}Sl`@_W_d@hBcu@oWr{@aPg]m_@c[gI_y@sCna@eR{SmSmaAd@q_BmDzy@gO|j@wVyv@kI{|@nGaYhCg}B}\\\\buCyv@mjBeZquB{c@os@ovBytKwt@_iK{_@yfJFkpIjMghD~A{{HlHmsAvh@cgDlo@`gAzwA~dIdGv_Ana@ru@tGzm@`wAttDd_BjtBdSlm@bQ}D~On\\\\xHd@rt@`uApg@nxAb\\\\jd@pgAng@x\\\\yb@fH~NzL_ObxAi|EhVkd@jZeNlPbFrhA|nBzl@lPvTsOrXpGbkAqWvJmm@|^eNt}Ac{B`[c{Axi@}gAnJwu@dHiSnN{Bj\\\\mzAh_@ko@}@mk@xP}TW_e@xIoQtEoy@zI_LhAycBbJco@lSaQz[tNNub@rb@u]pExKoMlpEbHnr@rGyKF_s@tOrlAqDhjAjMha@`Awn@dJkBdFhIkGjg@pNzO`Bj[eQ`aBzRhpB{@|f@tT~l@~[~xBdLiClMf~@tg@~`IzGucBwQ_kFu|@kxFuUai@aDi^yBgkCgKud@|KwtDhG_FiGkx@mPtT~KmdAaCiOyKpa@yC{j@xUkgAnu@`LaUj`ApLxdBrC}rBzLhGxAzTvK?tFse@nJmHjN`DdOdWnOeEmAohBiQ_FuJ{T{UbEgFpMoGeFaQr]eKpBw|@cS_WuqAgg@trA{x@}b@cPpKuN_e@uIy~AoMyJmr@vTaQdr@_QbGwTca@eJzJcIvw@fCpaBlKaPtHxDfCdWcU|cFyIxj@yl@pnAoS`oAeE{oAeGcIsp@dmAuCsCi\\\\zvBeUhjDaRfQjRow@yK`PaP?xKyeDoPle@yJmbCyLgm@y\\\\|ZiC_yAoQl[aFfg@kWuCaM~XlKglAuP`TuBd]mHiNlHmeAcGgi@fCkn@zBxKtH_M{MuV`Byq@|M`FMsWqMaUzTskBnO{Nd]w~AfPwlBna@{v@nB{f@~Ras@~k@sXxGyMyFyTyNlCqCyKaPjTqGoPc\\\\hgB_^bq@qGkTmP`dCui@daB{B_n@mC~Is@jq@m[nhB}g@emAbAaxCaPutA|Gj_AaCfrEuPzA}Ckm@q^gTYrEhd@ruClPeGe@`j@xLja@mIhEwIaNuNnlCq^jiBgr@x_AiTkyAwGnbBc[~l@c`@oI}[bNkrAme@_MrQep@udAvD}|CpkAmxOj_@a|BzWqeDwDaWiQrmAqGnbBo_AhmFeCj}@e_@~yB{LzkB{BaPiYpa@g@lW`IdQtC}G}GhbFyt@_l@qPak@f[mc@lYibCgp@`_AaJk_@xFsyAnTm|AxWwfGbOotJj_@yjCse@daBoi@`cNmv@lyGsBrtA{LxTqw@wp@ej@sdE|Jud@zk@_aA|L_hAf`@ogAgC}a@uw@vnCoGxNkQLyQbd@sr@khH~XwyF|Tsd@tB~Jxl@ge@tK_m@mNwDoT`\\\\{e@yFyS~To]|tEkJcMejAwgK{M{wBbJeaAqu@ilCSyfNlLkeIdFs`@th@a_@hPeq@bk@efAtc@uRndAuHj]bUxPJpPaRh`A}aJxg@sj@f]`\\\\zKyHf]vn@zHmJ`T\\\\xU}m@~^{c@hT_sBti@}m@bRfpAvp@jqB`]gSba@xpCti@zvB|aApgBhDjd@`U|BxGrKp\\\\bnBiIcwAslBg{EqEsx@_j@}uCdJy]v\\\\{F~WjSxPsGtMjmAn]pfAh]pp@xQnNbLuLzBxtAhy@u{EjPk_@xJhUiXb`BnEtfDxKiTvKvz@hIis@kJudB~CoTxPcClB~Mh[c@hPzLbRxgAzK~MkKq}AiQoj@_\\\\cBqh@uaFoNwYoUbFcQrn@oXzXqHkf@`Kmi@iRw`@g@md@{\\\\`j@oRsMaF_WlJkr@v|@qaBna@qlEuQfa@qD`bAoQhx@mpAvtCwk@ojAgRykBoJcHuZzCkHy]nDe`@nGxKfCwFmFaYvJ}XdUlo@|JvA~X}gApG~GlKwn@b_@he@fX~hAlOmBrAe`@cBiJqG|G_Wa`ABke@|GgVdc@wRhCoO}h@tRcQ`g@iD}a@aOmBwRmz@gH{NaMvAmCaVvd@__Cx`@ws@|Agt@v_@zOtTqXxXpd@`r@dU|h@v_AnQbv@zK{o@{Kwt@b`@gxEpe@kkBaGsX_Rpt@qV`FqIfbBca@h_Bkx@w_@e`@jJqGc^fl@ujEha@wh@bn@y]lQeA|gA}lBv_@wzDkp@dcDoXxa@gOzk@kFtGaLwAaMu_BrByH|McAjf@azAjSmF|YgaBt_@al@yZ}IaIaTsRnwB_n@loAeN`m@yFjHuFyCoDfOD~hBkPlZqp@rUuU~`@s[f~Csg@pvBqTobAqKcIaPve@}NaDbBsu@mNueAlFgi@fKoNzKbP`a@uCjT}m@pMI|G{PiCoUuIjRkKiCeL`e@_WaLqUnHkEye@z]sqFjEoFjJ~HcGecAv\\\\akC~LwU~NjGdR{\\\\nVfXuNge@}HiGiThT}CkRaC}tDpa@e{Dme@njD_AnkDep@zlDid@tyF_v@r}D_KdMgYeE{MzGc[__AyViQA}_@kd@csByLueBeMu\\\\sBanBg_@_{BtF}{LvG_aA`XsbAjMqI~ElMhImQv\\\\qlHlNyHjP~Gxc@wg@iFch@}f@hSaVcpA~D__Cj`@cbDvRikDrXeApX}X|aBicChk@~jAfc@dqBkIvtFfb@nrF~FvWfu@js@fZdqA|s@xpA`Bib@em@aaA_d@qfB_k@_r@qg@cxEYiyAjYmhDxGkKrQnm@bUlsDnRdV~e@lHdIrVzStOxImEpt@leAjRdj@xDr}AfLxm@iF}pCoJie@mw@qrA_d@{VwYuc@y]EuHwMaOgw@~AugBkKu{@_`A_rAd]a`Oh]wi@bg@}a@~^|w@r^sUtPhYjRf{BeO~tAvBrO`PaP|Md_AlUhb@vOrxByE|zAhMrzDpNnYmPe`EfF{fArQ}QltAlgHaQscCg_@w`BqKyzAiX{a@eUonAdMkh@zMgHd[tYzOha@`Tn|@bCbuBl^hfAdMhK{^}nAs@spBfE{LlMdAjTl_AhHoqBz[cX~IxIzFlg@vy@hrA`n@tnBbD_`@qd@k~AixA}bDoP]od@`~@mUpFyQ}G}GgcAa`Ae{@kDy_Asf@qtAaAskBdEeD|W`Lr^ldA|P|I|YoG|Oew@mr@fk@{Ro_Am~@ohAkLiw@s@grB`KiTlUiCmUsMoGqUjDkNzZbQhe@|mBlQp@pFpk@`VhMfThn@pa@rXpGkTdHxGsAjaAx^teCaP}rD|ZfXvd@`@jNhcAxCop@zLqB~p@bzAuI{a@oaAwqBuI{CcPrGke@_a@{Mia@{Or@u_@oUec@mnBtTqlAxXza@tR}C`Z|Xf\\\\}h@|T~_@kKmeAi]{MeGqXx@mTj_@e]xHtfAfXbPdg@zvAjMlOhTsCvTjTna@?vQnSzQ`~AsOk_CiL}Oym@mCy^uVwb@yw@mMyx@iKqDoG|EnI{sBhWey@qBmXlO{j@xMvmBtMx]bQuP|WaCba@h]`[~tApMxOh]in@sJcCeNbQ_K[oOieAm}@ehAuTnIeLiJiBquAoL}TuS{Nq]_Euk@jTsEgqByImb@sUdMiWjd@wTc{Byw@{V}Pc_@~bBesRt]omAvK|G`_@}yAbfAoiB`_AplC|~@hnA}Bj|A}XtuC|P`rBrO|h@fVmFna@hr@tJzfA~JlOhIwFzGlDpJtU|d@jeGkK_zDcUqkB|ScuAjg@pbAxIzf@lPeLrXf]lVpmA`J~rA?ui@xK|Gqe@{vCaw@ghAuO{_Der@qaFwGkfDvQw{Aw^rfAe\\\\_a@u_@mSeRy^sk@{sDfBo{ArTsx@rqAs`AfM~Arm@zvBpXvTnZV~Qhb@b]vbDnHlUjb@xV`Mdk@cWfcEpCj^jEhK|SoInLvGv^trAb]~K`CjwAbLvo@oGq_B|D}r@vi@nHbQnN|Qpq@pA{OaL{j@oc@_r@}XyB_YoPqGdLkn@i}A{ShCwCqf@jHsd@dGf]vBkKaG}n@|Q_lB|IcAb}@xn@hn@rSbN`]f]i]pZvV|Qxc@bOnB~F`Y|QbLsCwTbJrHbRnq@le@rXyb@mt@eYy_A}g@aZySqe@u]uVeFbJuLFcPgUeL?_uAehAyOqBoGsr@yK`j@gr@ik@{GwwAgr@elCmF}_AuYkgAiI{cBip@ygEl@_{@jLyZzXlSdq@h|Aro@xzClLxvCvLtRpt@mp@zZjy@wKsrB_UdKmi@zr@eDoJ\\\\keEwLoMbWqF}b@ce@gUolAN{q@qGjTkIqObTw}@{o@_h@iPexA?cj@|G|Bo@{`Cdb@w`AvuAujM`R{k@rUgEpd@``AzMnD`FfYz]nMmLbbAkI`IeRiTgKrAoBlWdZ`r@pe@sKtW~h@zNzrB_DvbAfMds@i@~fApU``DhMnTxDdeAv]rvB`XpTzSjc@pNftBfMni@rNpMcB_f@wWcw@tB_o@tOkz@hXsXpDxIeGfhBrOzxA~Mnp@tNoAfGtLzXlxAn@je@hJvOuCitAodA{oD{AajAtIqb@iLshA{QkJoXfe@gNDoSwRaWku@i`@mgHvNejBcL}M_P|gAsAwQ~KoxAiEq{ClCsPpa@uTrX|gA~OmPnVjc@jh@hnEbzAtqDpE`l@eO|nCtFno@xV~k@fc@~Qvc@z}@|^lIhDeOeb@a\\\\a\\\\}`A_MiLuQjBuR_i@oC}xAzN}|@l@yeA{_AynBoS_cAwTelBhA_|A~I}l@_HaHlBkYmBcGiClP}JcCmDvGwDed@a[{o@cIlRd@maAyL_J~Aa`Acc@czA}HrrAsNsRgB`b@_IQ?oa@uTnQgd@yzAuIuOkJtCgC__AjRw]~QazBpgA_cDln@isA~k@sj@`_@qgAxi@kD~`@{`@nKqEdHhJxe@ca@pFroCwM|`CoYfwB`CdeDhh@vcCpYpPnTvz@oGpjFzdAdmI_VjuGvTbdErQ}sAgVakAiCcaApYydFmPamDcRkc@wNstBZinBroA_kJxCyu@kOumAbIm_JtlAwqGtb@u{@|d@}XnVbKx\\\\|l@jAvWkZfyBwCtpBfE~ZxKkKxBpPa[nxA~Rb_BgIhhBsrA|cI|Fn{Aee@f`Lrr@lyClF}NoWg|@wTkrBnf@wmIoDe{BxCw\\\\tb@av@rJvm@cUbdH~K~aB{CboBtKgq@eEq`CnF{hBle@vzBdO`cFrLjOoGajBpy@xYbZr^kPqt@cPSoi@ei@gM{sB{NwZ{WaaDlTwkC`kAitGdJjyCfE`PpGoGfEfU_TptC[n_BrNvw@i@dh@hKuAaLkwC~KoTnI_aACwkA~PxDrFlWnG}m@bPsXfEnOwOxrBoIx_E~GcV`E}oBzVuwAfOacDfLqNvBgl@~Ff`Akm@veIyAtpB`FxO`Mk}@zJoIgCrcAxHm@f@`KePblA`Fx\\\\mHllBwTvz@]j}@cQ`wA`@je@bMlTc@yoAdQshAiA|{BzIpSvK_hGdEmGdNlGhCfXlGoOeO_|@fOkcCuEahAdKu^~IcqD`P_w@\\\\km@xKz\\\\sC|m@lPuz@~HbR}Q|iAbRhsAeUdpD`GblArSbT|Gl_AvFyaAu[sgBbIkxAjIiE~Jhk@vHxcCfCq{D_RgV}ByY|FkdBvIeOuCm\\\\nV{Mw@_{@fH_LkRij@xD_I`MrG`l@wMdb@v^iTye@{f@sRy_@uBfGwZiPa}ArLuj@{B{m@zJyObV`uAdWmPba@vKxHcIkZua@{PCaSbNiAkOcB_pA~LwY}I]uDw[l\\\\wa@yUsRrb@__@xAjlA|H|OBmfCuX}QoPtTtCug@rR_n@kMkmA`O{{BxDvlA~CzH|IgAgIly@pDbRlK{A|GjK`Jzr@sRddBoAtgCtK~WjWfzEfMte@tRvKrLoN}CsMaPeEaL_j@Uw~@cOejAtOz\\\\rX?`PxkA`DuH}GujA{GqJ{V{Bi\\\\arAlBal@~NjA}UcgAhTatBlDqKdLrlC|E}G|Qtx@bQbLtWmElSzb@cLboB`DnV|V_jCaPgg@oWiXg\\\\vJw`@umCd\\\\elDpC{LjPbGnK`YnS`NfQyIxb@fpA|VtGpI{Wa[sNk^mwAoNoOsBm[`TsMlE|G|^iCje@le@{Jgk@gNsM_n@kHoXa]`KmfDvSzKlJ|xAuConCrZ}KlNyZpXjyAhn@dr@hEgFvKtHvBtu@uOxxBtE~]z]qpCyB_w@vJoOrKhCpHiHOkLkP_G{R`HmWsRyNlAea@ye@qN_{Aib@gmBkj@gFiT~JyJ_Uz`@ee@|NfUpA}\\\\pd@~h@ld@|cAje@av@dO`@lb@~c@rX}Bz\\\\|Shb@{JrJ`GhI_HoFyNiJtF_e@i]c_@gE_WzJe_@mbBaQoQ{UhDiTh]aCgJ`C{^qQ}_AIw}Bhk@jGnv@bg@z]bt@bTkTt[cuA|dAyq@eFuUicAta@w\\\\xtAuOzR_Ne_@o{@cp@yKuZqS_xB}LsRuOvSkHmHbDie@kTer@hb@akDtHyQ~ZvhBcDlmA|Gt_@xKelAve@`_AhCxVqLtNpLe@f]jYuTocAzVePdI|S|G{BxO~lA`W|Uh`@wBIgQmf@uOeHiNqD_o@{EwJyK?}R_aAbFu{@jT_E~TvIfG~UxBiToIc`@{eAeHrCaj@eIch@vOijAvIs[xOKgE_p@lG}EvZnRfFfzArUj{@_TyeDwTye@lW}o@bYwOfJfHzEjf@rCoaCzh@tO|Gce@bi@z_CoF~xBdP{gAiGgnCdGy}@r_@}]jXwBbJ|FrFl^bNc{@zEjHyh@hkO~EjVpa@wbJpX|gAgChnBna@zvDjDg\\\\yVszB]alD{Z}qDfCm]df@nc@uDfmAbr@``HnMra@vS`Szm@jA{@qa@kr@e]yZu}@gCi|A_Ym_CSkoB{HaZsVyQ?s_AhN_Y{O_mB`I_iA`_@fC|g@~r@ht@bnCpSdSjSgQ{YkAeO_ReYmsBi^qdAyTwXkXpMaL{D}u@ggAfCcy@|^|u@{Dgk@mW}_@yK~XaLu[~]_dBhh@uN`b@br@|SxvBlL~Qty@yb@vYhGrOb]lX~dDbH`HjU_ErO|c@a@rvB~GpGUiuDkPw^sNnJmToDmBuZ~QuJ|n@uDh{@vXKcPeRuZgi@a]}RyC}_@|KoJczAaQ}l@wEupA{JkMYgdAlDoOhbBcIdf@pZvReCv_@h_@hIkEc[gp@oVdE}h@qr@{NgCkVjSiPwNiTxKaRcNqEuvAlHg_@hQwXbg@uI|Ogt@|R{Elc@yv@gRcKiJhCce@xq@sF`\\\\c^jHcGyKwHjAeJcwAwTeD_YbMmN}O{FgpBjMut@~VdZt_@xItOrXfCmv@o^ge@eGa^f@ai@nP`PpDyKwGsx@jJ}IpInE~Lr^gFzrAvNbo@sGi_E|Lkz@lVb`@bAlk@dKyh@zBaaBvUgc@fCfL|Gqe@rj@maAx[_TfGfMnGkKfBpEfM~qA|QfNcEjcBi_@j\\\\eWjv@qFlqAaXbrArF`S~G_HfMnSzIruAlMa}@qWqs@hKuqBf_Ac_BjP{n@pKuD`J`wAbPba@qG`}@jTreAiCwhGz\\\\__A`P~~@{Bpa@|Kzj@qFzf@d\\\\`{@lBzs@qXh{AnGreAca@~XcOyIkEbSqShiF|RwKmApcApNi~@gKi]U_i@nDoMhQgBxh@{}Ads@|vE}Tp}@qGsCeL`PkHaByMlTsDhq@ve@gr@|QkEf\\\\k_AiQicB~Riu@~Dmx@tIy@~C}Q|H{hC~Mhg@zLiYiBuw@xKrXtCui@jKl_AtBmjA~QaWZf~@fJ~a@kEhv@pQhiAiYpx@nEzQkMru@zBjz@}Kdt@vFzn@aQzb@kg@hVoKjZxOrwA?wtAxl@{`@dQa_@|G}tDxTaa@wBe]x[cYhC`j@vJgUgB}d@nZdEj[yo@jQvgCqDrPqGoGs@`GxCvaAcb@l{AcTrSqCh[pMv@xKke@dLpGtTk}At[m_ApW~Qk[rwC|UsOrJiqA`Wiw@p`@rKfSjWsHbJsOqLeDtLhJt`@lP^yIrv@wTfXep@~H}U~a@lBjRlRg]~r@iJv^lf@qA~XuXvFwGnzA`J^k@pTgdCprCeu@pZy@`Trc@lL|Pe]`u@sk@|P_[zKxKpXqXqGzgAhCpgApG`MqGtiA~Qub@eEwo@`Pov@g@ypA~U}\\\\dCdLdGsCbWjTbE|eAkf@zu@gBtWx\\\\wT?z\\\\`P{o@xKgJvH|B?_x@h`@m{A[|qAc\\\\`~BhGhPje@{vBtCin@bGjtA?ae@|GjTrXqlAbLzGzB{\\\\hC~~@vE~GtI}\\\\sCc[`JmMdI`gArRcxAtIjOqGdTpGhyAxKer@fCn\\\\xKsxAfJrHjMnq@tTaj@hCb{@vKin@fCfr@bPqGtThn@dGaP`PhTnQ}GxAxEmK`jA}FyKaH`PmKmb@kVtCbVvxA}Klj@eLoGiCnGaPuiByEp}@pMr_@kHvf@gd@zDwd@}o@gHqEcC|I|E~f@xRhF`Yps@wJd`AxYlDrNjP}@dZuXzb@oG{GzBdr@ol@k}@sMe}@?v{Ap]~}@iNdJgArPgi@rKk]eb@mCvFvMz]yDjDw_AaaBqG`GuO_PxDzd@zIkBxKba@fSbEvStv@lVrRhXqL`U~BreAhz@wSbIr@pi@oGmPyKnOqTkMvSdfAo\\\\g]_LxSyOwh@j@dXgNr\\\\zU~d@gApTeWzj@?{\\\\}EjD}Dv[_P{v@nG_e@aPun@?lpCub@otCwQsi@|Dg|Agd@e^mBjQ|U~OjDbYoBlJeGaPvBliAsIrN~Trh@nId`A_E~b@|Kxs@xVla@wa@~jA_NxIwNeAiG~MsXcuCcGrCmGw[eSiVpAie@aNrXmR}@wK{iAg@~wAneAruAf@juBqKzXyIgQuI_Eo@zFl\\\\`hAcPpPwIcXgQJoDwqBqXaPg]kyDqDjSnMbvClb@jhAcMrgDmz@co@iGbDxYxq@dBfNsHdHav@ca@yo@{fAwt@qwFtSkmA?}m@ke@|gAai@qn@akAce@_K_p@kYep@cNkpAaXmn@~Jzc@gEp`@pc@fwC~r@xkAb]fOnRoDpZveAm@xb@p`@reCeJd`@vTlIzNju@xPl{HmMdXmn@|KmLcY_IxAsr@mpD_QkK_Zuj@wXsKgRsc@gCri@kQcj@gWvCpHlPpSfIdDtQtf@`Ofq@h`Adm@~|C?fo@oM|sAyr@lcAwOe[zDmLi]uXn\\\\zpAgRvQnJb_@gOrd@tFnLnOeb@dJeHvIfIkFfqA}f@dOhA}i@{S~GaDpMuQmZa@`}@cK|@_RcpAyFWzItkA|Nx]qGf}AoJ}b@mb@qRbPre@wD|[sNbUmCmOi_@tEqIhb@wQsgAoJwKNflAsa@hcChn@_nBfQbp@e@pl@xKbGkIzb@lEtc@jIsr@lNl]yCde@uYtA}NfhAqGnMmIiBn@fq@zI|Vw^np@v@pPxMaQ|LaCjBnJoP`y@DhJfLeGoBhu@vF~L~KudFjMwx@z]eTxUmcAzHhJlSoHpMdEq@dZqTf`@gCncAqGuOiCzH@`d@`Wc^jY_kB`Usc@vGpIaCzt@zPjk@_Vbq@bLtHeTt_@qG{GcGpa@_QlBi\\\\xyAqGqG~Cvk@qKb}@gDjLoa@nV`P~QaP`]pGba@|ZmgAn^cN|\\\\sv@hV{FfU}[tVf]`j@{vBvFar@hHbCpKu^|JgkAnf@wb@pG_y@dRhk@jE~p@pGea@vUaTvJya@fIrIwFfr@|Drm@iPrh@h`@df@aJ|ZbBf|@oOnv@eTzXdQPjWmYnK|`AoNneAgZtLsOlZ_Hy@qGx\\\\uZkKkKxFcJm`@af@fwC{FdMaj@vT~Ajc@vFpEfNmGS|j@_r@f[uTdj@|B`GdHsCj[c\\\\zMbNfKt{ExKwtAsHoe@dHgi@mLqW`@uc@~D}Zv\\\\__@ta@eoA|AgYcUpX?qXqIx@@yLp^_iA`@{`@rOpX|XoHxOlQ{[|XGrh@lKbLhL{q@fK{GyBrd@yn@xaB|DnGzb@ii@jQuv@gDrlA~PlKjKgMaGyjAxKqGcPeRbKuDzShHtHbWtR_cA~U|kApHszBuIe\\\\lB_d@uToX|Ec\\\\hIrKrIsKlK~QpL`fAvH_BvH~KfGaO_E_d@jKyKvJrJzLsJ`PrXyH~q@jQkm@zGhV{G`NvTdr@nCmU_Iia@vH{J|m@pr@uB_Scw@wqAgC}m@g]oc@}MwvBdI_c@qOaHaDsUbUqdB~UyJtRnu@~Ich@~MaIcDdiAjHhWbCkn@tGoGfCzpAt^_lAsM_f@|G{\\\\aDcwA|G_NnGna@xHwa@dZrR~DrNnK~zD~LcRpBu_@mTq^rX{cBx\\\\`\\\\eC}YlPc[Fr`@nKfU_@kZtN_HaLks@tAyHnGtCdB{N}Wwb@|pAbN?lx@z\\\\na@gNkeAnCyZvIrGqD}c@oEwJ}LrDgYcd@_X_eAzj@bTzN|i@?wThPtJaAtp@jIdOfG}g@uSqx@hT`PtCyKtOfCuBqObWy@bMxw@~OicAz\\\\rX~IgQhc@kF|BaPjKtCwFmj@c[na@yxA}X{\\\\zZ{IhAuDuHfRgxAja@m@gPca@fX~H`E{JfImaAeBkFcLrCDuLtScXzP`HbGaHiTiT]qPfUiz@|\\\\n\\\\hE{GvRxCbCn}AfGsKAgdA`j@tCrDm\\\\{d@kLsf@k]k^kr@|^aDpg@rVzLkC`CsY_YrGcGqa@dReAhc@s[xX{}@wG{Cuq@~z@iJs@uEgW{\\\\rr@QcRgKwIia@lf@pHit@oGwYbLsY|_@nF|AyImY{Vp@eLbS_]dg@hj@pa@kP{e@qCwJgb@jI{f@lZgMnGbPxKeGpQrFlYrh@nI`}@~GuQvd@fAtN_KqE}|BvKnGxAmOq]yy@cAac@zOrCdWpf@pIkA_Yam@tHgd@_^yDuKs`@rMaBjRrRoPelAvTje@}Byt@jVeIjQ~KxGfyAnLrOxUgu@hSdJ`HdR_QxyDlPlpAiA}hClIeKgCf]dLmPlKlpAPmjCnOtI@yh@jPw]nBdf@jY`v@aP_yAtVca@kAuTxKdL|Gaj@|Sdr@qCwiAdH{MvO`jBnKmRzCahDtXrXgF{f@eW_g@yC__@zMySiWib@lG}P~]iRtCdFxo@jzCbQq@xHrm@dN~Mt^ue@vPmfBcGwb@fy@irBrN}IvQhLKn`@rFlCjSk]xCfLxPwRxSu@fPdPxE}RzWeGjLna@uLf\\\\vA~NnSiQlDgs@fR}ItRbCkCvgA~SsxAbGpXfKdFiB~j@dLvTyRtSeEfYiMnAqNr^sWbAoHgI{GrXmaAfVei@fz@ie@zEuVfeAgh@bf@{Zrx@fC_e@yJ|McKzoAjs@slA|EkXpd@iZba@oaApGB|WvdAvh@wX~Q`@dLxv@uC__AvTpm@v@{bAxXih@~k@oVbGpGbUyKoApR{l@pnAhD|F|Sui@tNjMyVrjAf]k_@dFuiAfP`GgKjs@vGxF|GeXpGdGwBioA~Fgk@tI~X|He{@zUkUMuJzBzgAnL_w@`KiGtGjA}DjZvMgTtKpDzK{HdGjn@pLov@xQmX_U_BIgKt[ch@tGvG{@xp@dRrQhB`SgBx_Ayo@~{Bu_@~X~JvJza@mOcXzfAaYjUbMhGff@w~@`OvKtJoGr}Ae{Bf]cI~Ss[xFhEyFl_@`QlIz[|cBmVvn@gl@v`@mKwTDn`@lOlIaG|Jyd@_W}Tuk@bBb|@kFjS_W_KiEg`AwKzv@ye@oG}C`Jj{@n`@hCtVsOxKrOxKw@|mAqI~U}j@xNtH|KoPbh@{m@aj@wKvOkRd@fJxWnb@cEpPpm@lKlEbNyUjRk@ya@vu@pBx`@}G~S}z@woA~SzkAxKeGiCft@sUlYqPoKtHbh@{FlNs\\\\bMeY~hA|CxCfXql@`]oFhZs~@|IkIzBdLlL_BjC~d@sRdc@Ar`@}Iz@{BhhAwT|g@cGzHqG{B_Ir\\\\aFsPun@~r@sLqMeQ~F_h@|lAqKp@oa@oa@eJ~CTpLbNvApa@`j@vPuLl`@mgAv\\\\xL|RiY~QjCxKoPnG~XiTrr@x^ka@lRcn@|MaAyZbaApGgCcFh]q_@fk@wXcAiEnHcYeIg_@rWuWbA|@xOhKnFvLH~XaYpVnQcFr_@io@jrA{LOiQqi@aQr`@qn@gRgChWdd@pNra@{N?f}@_Tfl@rU}QaAj\\\\a}@n`Bqo@hZFdM|^}A_Qdr@wQfBuS~YqH}H_Vft@i[fOoQh[oNaKbL|z@aDbKaa@tL`AjPaPjAuZbt@~LcDrRw[bP[dU}`@pc@fBz^aPpbAebA`W}GtN`P{VxoA{a@dY}r@bfBgFjc@e_@d[tLgC~B`GkNrV~Dz]iG|HoLsMgi@dh@pCnOla@uPcLh}@sc@v`@sQ`v@fG`\\\\i\\\\lkBpZ_TbBpc@wQ`hCtKw@x_AqlBnFIfHr]`Kgo@pg@uk@tn@{dBxAabApGna@hnAgbChIqHhOvBpKsh@hMcCbCpW~FkIzXs{@eDyl@jMywAhi@ti@_@{b@{XkSc@kTrRgf@cDctB|QGtBd_Af\\\\lu@?iTbMShB{ObPvTcAoc@oVvMsX}m@sAcnAnNkRvFtAbPliAh_@p\\\\vEmKoa@ov@|DqIcMgl@fCga@tHoQfShM{Da[x^knAjTdlAeLuiB~LoMxW~j@_HykArRiTzVhT}Gsr@|GtCxLiSl[dW{BsxArXb{@uOayCdGuThTz\\\\gCk_A|Gf]nGucAbG|GfK{I|Lq`@`YkOtGc[xq@|ReLuk@s@ev@xMtZ|Saj@`ExDjO|p@Ypc@zJ_@fh@ipAm@eV{c@jb@}Jie@pQ{b@qPoK|NiLbIs\\\\fL|G`WiHsIzQa@vp@dWu_C_MoXfDqRtZub@xMhr@~PtXn`@_NfIvEiGhv@y[j^hJ~NQqRrm@yj@~JhAvEfUdIco@|V{NcAe_@lPSrNaW~L`M{@|e@`HlQnIbcCa[xdDcPd_@|Bj_@mG`KkE}ByIqX?pr@kTx`@oG{BgCrcAoPbv@pGwOdK~KsWpt@vIrs@wg@hoA|KpK~Cje@}]pR{@{I}G~BsEhrBaRzc@vM~x@yKncBkKaP}ExFfJx_@cLtbAhArg@{MzUnGtOqRxtGic@vjByKeLoG|cA_WlMs@rNxK~OoNlk@rC|g@mg@liBu]vw@u\\\\xOsr@iTmBtMfV|Glc@nc@ys@f\\\\{E|Tri@wTtC|Kc`ApwAuN_YxAyk@mKnG}Goa@rBz}@{FxUkKwRiDkrAcKsGd@naBeOlp@rRnGpGlc@kTmKqG~r@cUvl@hC|Gd^}l@~FiDzBpGxKwKw@vRgQpUTji@kVrY`Ujb@lJjm@aCpiBe\\\\lxAch@rcA{QhqEeL~i@oGkpAaP~iD}G__AoTrK}F{O{FlGfA|YrDdJrMr@|D|X}BtaA{WgV|Bmv@sXal@eReKqRqhAeGhCz@vTxMri@uOlKqLy`@yCnPlQxk@dVqBbPvU|@dr@}Lp]vS~Uza@hKiBnc@gGyLcIbSqFhhAmK`N_O_SqHm@kCrIrJh_@ul@b}CyKca@kTl_AqRyeAbA~iA}GmPyD`OiVj@mPv`@sCuz@{BdLmJUyS`TvOv[gCxcAiVp^jThP}Bvg@yK{I}Mpp@Rgc@zHaGsLa_@nOo|FoDsTkIkIgFvE_DsKwHatA{FyJoGnGw_@qsAoJlFuGaFqAy^kIze@bEnShXvGKdi@sBpHkNa\\\\iOeBwEf_@lKqGhCda@xKmK|ChkA{EhLuPmE_Eit@_Nvz@ec@{\\\\bGb]uDjFcFsFEhf@nLlHx\\\\i[iCvWiTpg@i]sXeGtJne@fb@yKdL}SDsVbe@aFvi@{GmKqEjV~FzT~_@r^tCeGmP_j@pGxB?av@na@z^xK}IvQj_@GrZmW{\\\\yPvOt_@jv@{e@jLrDli@ib@pj@`FxGdx@QYz_Ayg@hCiI`XoK}ZdGsk@aYtNqd@a`@sI{v@kNsXjEhiAsJfN}FaGaPdLrT~SnX}IsKp_@`R~Ih[vo@zI_VxPzDoCjsAoFfMyK_n@a[xtAtEpi@gWeOaVdO{I{`@e_@GuOwKlE`p@qh@aCuA~Jnk@tM|Qm\\\\dK|Xu@dTmRvGiHdR}Bnn@aGlReGmPgChn@eMTiIgd@qLF_DpMaYbCcOjd@`DneAyH`CkQ_RoJvDeLti@oGaP_JzdAcV|OtLbIOx^xMgUhRzC`@fc@gMn[aa@rBoH}YqQkHnIqV{BeRwThn@vTj_@wTnv@u]{_@zHi[yImc@sIcMbG|t@iBbQwFWs^iyA{UkN{Nqq@iOtCfNb^gBxZ_j@hTlPf]pZiYpP_DbPzFzQz|AkC`JmRkGqQrKfMyn@qFqIoOrb@eFuNkGlM|Mhu@oAj[c\\\\dLcMtY`@bMp^wBhRpNfC}XlKr~@|GhAaf@jjAgBv]`K|FzGg]nLnB`^i\\\\`Fa]sCfCvKyKhF~SeR|b@vBnFbQaExAlKyK|g@v_@|AhIeOxKt]le@zBoEve@nElKsXb{@uCin@aP|GuH}Si[hz@zBsp@vNcWeFuFwz@rr@rOcjBwP|YiNbx@_@ro@{KiUtCooAmc@xq@ct@iT}O`_@jL|OxOgK|JhE?f]vK~ToG|F~L|It\\\\kIlOnOwWl^}P}QcSvVbBvNdUWfFxGuTfr@~i@kn@pKd^aT`XuIwJzEdq@_E|ZuWtM{Jde@ua@zr@wLiNPsMqQkJcc@mv@pF{h@kG_B}FpK[td@gGzHeLiT{B~~@bB`HnU}KrBf_Aha@uSfRnLaVjw@lPfReLxi@?ni@~O_NdNugArXcTfp@mjC`j@{kAlK~_@cC|_AxG`LdEey@xIoI|BbLmK|\\\\nHnFxJwJxN_y@vGfS~Ha@xAfo@|H[lGjl@nKkAaPtcAdLuCa@rRu]pdAlIv[un@lrBnF|RbR{WbJ`k@aOpMk_@fgAcRfqB_E~MaNbCpC|w@q`@faB|KhL`Oyb@pGna@dR{MfIqn@pa@iaA{BrcAlKe]vAtHu\\\\h`Bh@|a@h]elAfCrOx\\\\ecAdFrKeFzz@|HrHcL`l@`FrQqQlAtXzhAgNta@bGd]tCiTjHvOeG~g@xId`@aFjh@rB~OdUsc@d@{eAbJiKzK|oA}W~~@pBpj@rOucAnJxm@aD~XqMxC\\\\|k@mHtKrR|Zh@`]qGuCkEjQdQbKxToFpKoe@pQdS{GnaBoGwTea@h}AjI`K]bUiYjgAfIzJfZb{BqB|QuTyEqGxp@kK_j@{FzMzFzvAwTca@sCrK_TvxArc@yC`E|R}GpG{Bvg@eLlI{Btp@wTba@?g]oIsPrErpAqIf]cHnCmMc]c_@dbAeNmFyVzgAmEs`@gK`TiUfCyBhP}\\\\sXsCrxA_KogA}O`BmHiGgIg_@iQ{Di@iXkTuCaPl_@mOrHa]|F_MsNk`@yxAeNiGsc@u`DuVcn@DmxAuKucAyRyf@wmAz|B{c@ic@bMywAyJ}L{o@~xAmGqZO_`B|GbUjKs}AzMiRtA}kAoLkTj@i~@|Nmf@mByw@vnBkdPpJkXdLbG|SkRt]seCtRwk@`]aT~Gw@fDpN`PsOpUbv@YnfB~Oke@zUk@~M{MdHcjAvQ|CnAxa@lPpGzD}u@`N{HjBiSfIrBcD{cAmI{@sY|c@sUG{BvKg]iT_JdJsM}EnBzXpYbl@mCdeAsKmu@sTeIaL}q@cMzB|NopAmZlPwGwYsXwFoIgXsSjAy@na@~c@dIbY`y@ea@pZsaAx_BoRtiAhDzUiD~EqGyKcMbYkNlaBeJkE_GzUgR`HpGaqCsTgz@rLaM~FbHxRif@~K{}EeFsf@yTs[aOcEii@hRpJktAyKe{@fF{t@i`@gk@fD{WbOrSlEcXjEyCtCjTxOySxI{cA_p@c`CyWkKgQuV{GmrAwOjTsHgj@b@wm@xPkd@aQs_AxEe`A~CcVbQiBrPqd@gIkp@zLej@sB__@woAdgAoLvYsXeL{Otr@sUVqBmYgOn]uPzHoXxaAyWftBy_@dj@k[n}@{VrWsx@v{B{PzV{UnGgo@gnAom@scDqb@ejA?qa@}GhCeD}FeWgjAcY}g@ow@}`DgGmNah@qXiQi[aj@_yC\"],[\"}uzk@flkeAnP}@|Fnq@xVhb@dBtp@`i@hfCd{@xwLnMlZ|U`bCjqAnoHvG|uBjs@xfFrt@rnDtPlyBt]twBv^xw@jJtxCv^|tAxc@p`Dv^dOfX}CjT~i@tJyA`U|c@dh@dN~GoNrMbUdlA|aHeLjsFmKaj@gCjOjE~}@zJ~BlVqo@``@_QjMbAbZvW~h@~bAl`@tnA`UrMd\\\\ij@pJpHrfAjJ|b@oWx{AdrAj_@xiA`f@h~Ej]dlGeLprBgQyj@cT|g@iAt{@vd@f_BptAvfDzz@p{Hnu@dhB~@x{Ds[b`B{hAvoBm|ArjAm{@heAy\\\\zuAgdA`qAm\\\\dmAc`AdtJm}AxpJuCelCxKgd@oCgPmIrJwFz\\\\c@xdDjCbwDjNzjCgHnu@uFij@i]uh@}i@kLwL~Q\\\\|ZaT_Z}Kue@kCojAoJnu@aVdMuOuPlD{o@gQmr@eS}@jFb[iNoFuZyz@wTiTqGz\\\\gCwz@gItKiN_a@aO}DcQklAu\\\\ufAoAubAmMhMgHwDm@ll@qNzf@eD}f@sF_FiClPyEyEpAgc@iTsX?~m@kYg\\\\iIt@aPrXoVdEoGpa@aPbRmIg[|O}gA_WalBvCqj@g[qoC{M~^_P}O{E|y@}MtYgCqG?xz@|GyKt@hPea@vlAwOvDe_@kl@}FqjAqHaM}M|O}b@q{@}x@}s@gRqt@{Z~XwD{EuWaxAaElM}BqrAcU{PuK}s@gRhC}MkT_QvUoQ_AmZ}`BwAin@aIcGyFpKmUum@k]l]kNtd@uF`cDqPli@_FlFqSePsXnj@iKzu@cD}Fo\\\\~XwTdr@yBqGoPxKm@ySsNCeRl^c_@fYaLmEqOhNsEt{AwV{w@_Ff[jDp_AeJlU_HwRsTwfIfCwcAgPqTaM{{JiMukBqGehL}So{Had@_nI~Nq}Aq@c~AfMqyAvWojAdK_P`PiCjyAa`OlDylBbT{jAjBgtExB|xArQgHlQnVje@brA~SvHpHgFpKob@j@yPkGcCeR|OqKmEcVqmAkx@iwAWuvCjJ_y@~Z_\\\\fU|UnDec@jQwCfM~j@dn@`^oOejA`F_u@mEeRgd@km@cNkl@kO_E}^xu@iYsaBmd@c{Kb]}tKpyAmvIvgCgoIxCas@rTuf@~d@s_ChUk`@de@_}DbPuTpm@qrB\"],[\"}mt\\\\h}fjAjLTqBhHc_@rT\"],[\"{rw\\\\rphjApKbWiGv^rVgRzGzS{Jxj@uUrJ}QcIsRtU{I`@{FeNgUtWs`@pDtcAyeBwK|b@nIrC\"],[\"au|\\\\tbnjAg]pZ_PMdrAc}AxVwm@|T{tAvS{Is@jo@oj@dtA\"],[\"od_]xlojAxMcNjAvp@aJhh@uWzOuEmU\"],[\"o}_]t}zkA|HkFrUfRoEvYuYpMuFyP\"],[\"ydb]`q}kA|\\\\wTdF`LoLh_BmNnIwBaG{T`GkUqDkJi}@`Tk[\"],[\"aic]txgjAjUf[_El`@kTaF{Uqi@pAwT\"],[\"axe]blyjAzZyd@eDjzAsI~YoEkBqIrMmHiN\"],[\"gvd]fdjjAif@RgMqTeE}a@da@yW~YrKxAtSsHgDoGvL\"],[\"ykf]rv`kAxDiGc@~QdQ~VyOpXqPqK\"],[\"sve]|ubkAxGeJoBva@g_@jp@_c@rEfJeZ\"],[\"wgi]l_wjAjLxO_A`Pm[zXk]}k@jCaRsMyFuEyj@vW~`@\"],[\"yrj]fzakAcH}F_XtBCsN~\\\\ck@|]ydCdv@yo@re@k{@|`@g^jAlWyaA`fCsDvd@md@bT}ZrwA\"],[\"klk]txhlAbaBsXjUdL~@jXgUrnA{bAngBaMyF|JyPaOcNgHe`@_U`qAoJ{Ig^u|B`To[qCgS\"],[\"q~o]jdtlAdI_CbPxVoL|[sOoCyE{N\"],[\"akr]d}hkA}Z{\\\\qZ`PNaFdf@sh@`YcAtc@uTrK~k@|OhGpAfQea@d@_Zv_@\"],[\"ujp]fhlkAmPlP{B{Gqi@ndA}n@vNaa@aGeKol@zm@ix@pOgClTrOxGyBpMpYxV{]|v@oH\"],[\"iis]zgfkAsCxTy]pW}`@a]pQk~@ze@?~Lc^`LqFx^`p@q]dVuJiXiGdF\"],[\"{_t]rpdkA_F~DmVaO_Pke@`Yyf@~VuEvSrGnPhe@sXrIuj@kVwJdJ|l@zS\"],[\"qmu]~}nkAjBz[mUvLyIcECmq@y\\\\T_XwO|[wv@?aP|rBelAnFxIoF|Ivd@pMbKpUaDrFub@}L{XnAiHhR{m@l[|CxSsFnR\"],[\"ssw]|erkApRlrAfBhbAqK|Ww[yrCzFcX\"],[\"eqx]~qokAbPaXrRnJnDjUhOlEpGpa@aNt{@i_@wh@\"],[\"sxw]d`wkAbGpm@kQrwA_VwbB|Vcf@\"],[\"{}z]`mflArGuJxI|z@wBbUiRzK\"],[\"ej{]hoblA?g]jTke@tTfp@rHdxAsHdmBg]q_D\"],[\"sz{]jyclAzEeXbXdkAkNtl@\"],[\"qd|]`vllAxKkOgHheEmRyYfB}cC\"],[\"eu|]`_hlAkCePsLq@{RqVkCkYca@up@iTwuAtFi[|g@yg@zSbh@`StM|KkAfExMaHpzArA|N~G}ExBhLqEdrA\"],[\"eu~]xeklAaFll@aZl^iCgp@`QqScQeMd@ev@sH{Xx\\\\cp@{BrX~SbuAqG`P\"],[\"{dr\\\\vrgjAr\\\\kXhTtLpG`a@oZdq@t_@xYjFhRz_@dZfHoA|@|ZeJDnGlc@yU|p@aIbaBcbB|tI_Uo\\\\{AtEy@lZnPfXsShoBem@f}Ayr@rlDkk@~w@_n@waA{Hyh@qUj@mPoQcIch@e]qk@eqAgi@cz@bQdLxK~`AQf[dY|d@hJvChK`PhnBcKde@zYly@mIvz@i]}m@eLhl@~`@pSpj@n_AsZttA}w@voA_Ix}@gLbUkKiEcGs[dKc]uQcn@sl@qp@}Js@jo@ftAmm@jCc[gGhK~UqBnNg\\\\Auj@_PrOtRxn@jVuC|b@~Xfa@{D~VwR`T_\\\\go@u~@m_@cIbGd[`\\\\d_@|KdK~TwM|ZsVzIoe@kLtXrpAkDzXqVhPhBjG`[iCnVvOuc@`hA}DsGaYpXjTpa@nSuMtLtM~AfXcU`[qeAgIcLxK{\\\\oPeXe{@lBogAyQkyC_MfJd@tu@tSzoAgOnoA_LjT}r@sn@yGbb@zl@jOnFtp@wN`SufBxZvH|n@od@hb@mR|d@j[sX|W`Vw@p_@qr@hPxaAnI?r_Coz@vz@e_@u`@ec@aKuWgn@cXbD|SvKrLfl@qa@vz@hv@g@tQnp@kDvYg]xe@oJh@aMq^?`j@i]me@aDbVjb@zv@fFje@sOjLwWoNjClWpz@pzAyKxQyEvk@aQYs}@s|AeI`DbCv]uPd\\\\yKg]qK|Bz@bT}M`UvKlXmKz]gHtEui@}_@_VEr@qe@{G}BpC{SwK}p@Ty~Av~@igAfC{Puv@be@{Of^qPzAgMaGd@{OwNyLwG_o@}L}AxSfnAyS~i@yCh_CyJbc@pX{o@|D}eBbLuXlHUj`@~wCaIhDd@nK|Ozs@`A`\\\\sLb`@hItGbK{LpCpJoo@raBoh@zQgGvk@kVxJuLce@rArf@{IdS_w@jZwScj@z@}ZyY}gAnDiTiTin@bAqxBlu@wbFzIaMrf@wLa`@{ZxAyMpJ~Hn^ok@vb@u}AXal@rMoQnDwcA~AaGpGxK|ByIlA}iAbQhCiJyTjA{f@hVwrAxOsUPgOqLoGjO}z@oFsz@xK_y@cAap@tm@grCxC_jAhRw{@}Gm}AxRieApZo_@d{@{U`a@aWlBlK~Rgi@fYwYfvAoq@`|BcxDt\\\\}BwC|{@rIx@fVec@|eAeq@rEoQhTqGlQcnAl_@al@rTorCh]u}AgCcTvTwg@fDn`@dFpHhBmGiBgYjR_hB~KkDhBdJfd@{SlG__A|u@uk@xGmBpC~LvSqDwN|^xF|JrnBo~CdN~L~@gT|\\\\|AuIzZjEvSrXew@hTkT|XzGtX_PpX~O~GkByTuf@me@hCoG_YwTtT{Boa@~D_Jde@wD\"],[\"yqa^n~_mAfKkB`GvTmOnbAiMgi@\"],[\"czd^xbzlAxBoJtRlHDv]oMxK\"],[\"iag_@x{joAfFiO~Jdv@gAhg@iOnQaI{o@bAwMlGxC\"],[\"_aj_@nruoAvEiP`Jf~@kVtp@\"],[\"wnb`@pfdpAjFwFhVnFfC~LoNtKqIbe@iNwK\"],[\"ukyc@jijf@x\\\\u^_j@xwBwJlpAaYhm@g^fVmKuTrCm}@}Gqt@tM}W`FrB`Dg\\\\rJtBjIaVbWh@sOqZzAqg@\"],[\"oq|c@nxlh@pCiXzMsGx]xKjs@teA`RuAjFrIkAfv@zUrdAlR~RtDpp@rKdV{Fn|@_^fKc]_MylDqlByEedArEeqApa@y_@\"],[\"kj`d@l|lf@`CeI|S`AfUtPvObg@cExM\"],[\"ydcd@hewn@?wKrL?dXhhA?ye@pc@v`EBfbA{Vsi@aNkF\"],[\"ugmd@jlqf@~KpTmR|WvFvd@qQb@yMea@{Hx~AoJh[cLiTSbm@cZdSiCtj@iNxQ_H~r@}^lcAoM_@{Wka@cUcnFjT_mErX|m@oG_j@vKzGzEuu@z`@zl@lIuXxKtThBgY|FtAlb@dYvP~o@hHcG\"],[\"w{ud@pgbg@|SaPxDtH{Ulq@~L|G`BzOgG`u@sP~[sk@y_BjIoWxErXtGoDgGge@\"],[\"}hxd@|{ww@pGdCnGsi@dd@hz@i`@zv@\\\\ji@mNjDoJaRp@iw@uHqV\"],[\"gkxd@vfyf@eB{SoNsK|Bom@nGe\\\\lm@w\\\\oXuX|F{Ilf@mEiFic@vk@ag@vO~[pCva@wXlbAwHlcAkRzLgKx_@gb@vS\"],[\"s{ae@|diw@xEopAlVh}@cAleAmP_n@uGbLtG~m@aPuT\"],[\"ue_e@pnko@nTuJlw@pBtm@jc@hr@a@sGvd@pZdIvcAfgA`aAb[vVd`@lZbM|o@|iA?tz@e~@vz@gTxw@}PzJuPhjAgr@lq@uSg@sXpOojAiT_XqSa\\\\gAqe@mb@ccAuhBqS{F}UgYoS{_AhHeXkCqh@~BcGhFfF`D{JqXyx@?sk@fQct@`\\\\cUxKy^vToP\"],[\"oyae@jcow@cQy\\\\zLenAtGbJvGfiAwIvd@\"],[\"ufde@zlhf@lPwKrGplAbL{v@jPl^gFv_@jRgPz\\\\plAa^plE}HdQ{MuDoBxKiNqRcAqvAwThn@{PiOwFgXZ_[zSak@oGoVzB{z@sJG]gOtGkf@zKdA\"],[\"ulee@tqon@fO_JjFdNqEnlCqGnOoGkMyEc~B\"],[\"ulee@z_mn@?a_AzOd[_C`PhPfj@cDfY_a@jQ\"],[\"qjde@h{sf@_Bd{AwH~OiBqc@wUic@}Fkk@nO{eA\"],[\"ysfe@p|{v@pOcJ_\\\\ffA\"],[\"yhge@x}rv@d@yv@rJ_e@?jeBaI~kAmFF\"],[\"g`ge@bsdl@je@e_@fCd_B}Rr_DgPzi@gC|u@o^rjAiT{kAlP}gArXsVuCijC\"],[\"w}ee@j{zi@lBuXpKaMlWr`@~M~pA{Gf|BaPjr@dLnVyBp[sAzFcFmHwO~CkCxb@{MpQc\\\\aAg\\\\z]eOtc@`@yqA}OuJeAhrAaV`w@bDdcBaF~L_HkyAcLldBkMvWgGS`[svGpm@axDx\\\\iz@fClPfOw|@lKb\\\\vDm|@\"],[\"keme@phdq@nRm_DzQcl@rPlUnXbB|E|VxDhEhJwF`I|Npa@uXlT`I~z@`zB~^|`CbTn]nc@pRhn@vzBvt@nlA`lAtfErEvi@c`@fg@iM~q@dGvtA|z@rrDuIzGcmAjBkuAjeCcUMcSaSco@cxBui@sc@o[uv@aXyIuPtEyb@gNus@kgAoWaqAjMcp@he@ew@fBqc@g{@sgEwCmrAfJ{rBoEsZ_P?wQmiA\"],[\"wyve@r|hv@dGwKzIxHrAdu@oa@ba@\"],[\"{ase@flin@hFgY`j@tk@~L{Cnm@l{BpMvpB}kAjfDyk@xaAey@`xBoH`MyKyKwCrLqGeDwIfKue@ed@eDuZdg@}d@yV{wBvKsaAcGkRhGy~BrKuNnGlPbC}KxCwi@}Gcn@xKzB|Gca@|BxKrCcP|G~G|KkG`Vel@\"],[\"wswe@x~iv@dLptAea@taBbPw_CeLhTkByU\"],[\"ooye@lkyv@r]weB?ngAbGoGCnTeEjRePvHcHrb@{FgG\"],[\"k_te@~tbf@fG~Fj\\\\uWk[l}@WjM`GqGjCpIwWjy@iqAjxAcGiTsCxK_WvDmRv[y`@hT{QqSeIck@}GHnD_XlNaQ~j@gEzb@iT`RvMpXu`@zBrX|GsXjTwTsCoPtTiK\"],[\"s}ve@pyxm@`K_yA`Vzs@uDxN~J_Ib^nf@yKr{AqLzWzApx@s}@joBmKdr@uLrDmSljAsCcG{B|m@iCiTsI|WyEsbAaFxfAcKg@eXyp@fIoiBxKsc@eBoRsHxGfhA{_GnBdGdImHbN}v@\"],[\"}f{e@nofk@~IcHtFdFyJdj@pIt^dGg]bJrb@{N|p@vCp_Ae]moD\"],[\"cc_f@`~vw@nNcI|AjEkFhq@iN|X\"],[\"y~|e@djrf@_QrPod@}_Apa@ke@wT`PkH}KnD{OySc@}Fit@hQuQaM__@~EgEda@ba@?__Ana@zbApCoc@bQu`@gTub@nAsLd]iT?xK|ZuCvEjb@oY`g@{B~`@ee@`nAdKzv@\"],[\"oi`f@j|qn@iCdEaSmLmKgb@mIq`ElGk^lG|f@hr@znA\"],[\"wvdf@~dvp@gHvj@oIg@kHgz@hDc]hI_F\"],[\"chef@vdvk@nGm_AvKvz@pGqG~M`SsFfOlDj_@yKaPfCve@qRkD}Awn@kWjM?in@\"],[\"azjf@~b{k@fPuTnZfEfLf[hXkEHkNpN~c@`I`E|GmPcDd|@ij@JoWvJyK{\\\\zB{\\\\_ZdPoAeP}Gvz@yIrEiUcn@oBwm@jEqNfLgC\"],[\"wukf@le_g@pGwz@bOpaCyB|MkSzAwSiTeYa`Aai@_bAc^gRtGyQfi@`PfBcI{[cSqXgi@|NsAb@kVn\\\\ve@Bu\\\\hP}H{BeX|JpJxT`aCrCin@xKzQ\"],[\"sccg@v}~d@rCsCbPf]rCmpAjKhT]ic@fDsIfCnGfJyBxDfi@hHuF~K~J_NvY}Q`Hj]l_@u@dLsFzDyTiVxGj_@IdReLgCtCrr@oPoPeRpZuNaIJil@xKiE\"],[\"ajdg@r|}q@eRbj@eLhE_EedCbN{o@hFa@AhlA~Nd^\"],[\"wm|e@pa}o@dHdG~NvgD~Z|[n[bt@|c@dmBhZbk@nA|l@p^~H|Md_@aD`vAqVj{@e]rpCgO~Y{BxdB}c@l_AvAjrAma@|mB~A`hBdDlKzGo@yK`P~NrRcOxdBsT~EoMrc@}GMqWwS{RkwAkNyKwZ?wHlHod@zdBsGdpEzP|eCcLt[}GgCgGdHmpAyjBca@|\\\\b@bk@hJrh@~]z_@qJr|@lR`}@{Hla@`Bdb@nNd^d^hWvRhs@hs@mq@be@b\\\\n\\\\qa@`@nL|]dIaFzy@rG|a@lp@n{AfJdn@le@dr@uKvz@bA`}@dQ`OxYrz@dPGv\\\\v\\\\zKgC`h@rT|o@tcAlHvx@jaAzhD~j@tfEsBlrCqZdn@sj@d^clBjKu_A}^y_@jOwW}Woe@vOgNxv@}m@rC`j@haAzBxrAf]paDyKhnDhCbuA~v@biBdGhh@c@v~CkLf_@wVlWeVxD}j@_\\\\{{B}nDgkBkvL{KaEmX~Fw`@f_AmH~IiTyKqX`j@ch@rE}UyR_Pxe@abAgM}^vUu`@qh@cdBufAsIwg@yKhTsBuN_U~RgIgZ}R}EwSna@qRjGg_@gPgc@we@au@h@au@|Sek@{n@qJlZwKlEi]uRmhA{UoZma@yc@@k|@}v@a{AulEoGalAbZcNfYlZhYeJoBwe@kVob@|QihBrKeQv_@_KnEnRzn@{cAdUiLrS`d@fLdlBpJxQ`]_u@bV{~AlXej@tf@bF|u@yu@x[Hy@ygAsm@oi@{a@m@{qAw}Ac@eWvMg`AxFmMfUlFdQcn@xVi~BfHyCj^tq@~G}@hc@kqAnIkiC|GrX`GgUeHabAvIo^LmnB`O_|Azd@ue@bXnAdh@mhAzLuGtRq`ClNc[jFel@zp@cdAxJuc@C_x@fVot@uMseAvB_v@fUqj@zBye@xP~FnAoiAjUaV|gAadCdMko@pWwJfXvLd]wLhc@lPlIsBja@ee@zZs`AbNyK`_@rJta@nkDjOrb@yHnElKvPfGsAfNdOlFiDaA{cCqOsoAyD}wBwIkOaPxK_Ko_@`BcI|G~O`@udAnMg`@|f@ocEyI{Oec@bn@uTg]uOxKtC_f@t]omAvb@ih@rRy~AnHuLxUxOtHm_@jKbq@yKvnAfNyQbZowD|Xk}@}B}m@rXwT\"],[\"wehg@td{r@uCgk@f]ul@lKhTnj@osA`G`BbQw[vOg@v|@qp@tMeZpGdG`VgQfn@zgAmCzcAyKdXwr@|Tu[thBs[`i@gz@|WeM}HuOvDiXbXsXgCmXao@\"],[\"kyig@|zpr@g]?uCyHdf@_pElJ}TcMyh@nJaM`T`@ho@a`DjSk^rZkEnPlQfRfw@dEwHjg@swCvEaH~MbA}Goc@EupBvLap@|Hz[zSaAxV|dAcX`eAqBtxA}X{WbInh@pIdDlLcTeLxtAtKro@yMjEmEm\\\\eR?eF_IuO}}@?~xAaPg]eFbLzEji@hYxv@wKxe@oB~jAu]mWwd@dQ_RaDuL|e@}R[}HjKoPts@iDdbA{Wfa@\"],[\"ibng@bmyq@u@|LuRkLK_Y|MoTxEme@jKrX?cn@`Pca@wJgjAzFsMbj@`_A^dk@yj@jeC\"],[\"{f_h@t{ae@fL_T~j@nfCx]dq@E`j@tPjCi@`e@_Zpy@bBwp@cBkImWkBuC}q@eSaT}@{k@mPrXeBiHsHkfA\"],[\"s|ah@tqwe@mI_Y|ZqgAfOz}@qBh`EyGlf@ih@_}BzG}[rRxC\"],[\"wxeh@lg|e@`IpkCiMva@}u@qa@_OCmFkP\"],[\"y}kh@|wwk@xT`G~O|Vy@nkRoRt{Ac\\\\~v@ebAdpAwg@dBgT_KuEyN]koDkQuGiMo]gY}MwEuMh[olA~JrCjPl^vE`BxGoLgA_pA~k@aqEyLkm@bNigDjNyShTxK|WkTfShC\"],[\"mkth@fzmg@{Bi|@dGoHfXbq@~d@pCeN`yE{M~XsFeSbDsq@aRy^`DwhB\"],[\"sxth@t}ys@vr@yhBlXdObj@`@zm@_Z`u@iKhx@bXve@tg@da@xiAiCrr@eRlXuZl}Aa_@h]gj@vQ_Rqx@kRgL{QvEiStjAoQnJaNaImQ{l@cUjHsLcJmx@c{AsM_e@\"],[\"iorh@|nxl@rUmPrO^~EdPhh@bU`q@`x@fu@}Zx]kl@ht@jLb~@aV|o@xUxbAhvAjRhjAxKxBtT|c@cA`jAf^ht@`Ary@x_@roC?plC{Gxz@lPdlAuBxiBdK~z@qHlxE{Y|y@_vAx~@uPjl@gU{F{SnKyKuT}eBb}@odBadA{uCc}DsXgRscAutBcl@srF|@i\\\\rGpAvGsPzBkzAi\\\\uiBfRacEdRa~@f\\\\k_Fz]wc@\"],[\"omuh@bedo@tWs|@zRfK`u@|dCua@~uA{{@E}N}OsDgeC\"],[\"acuh@zcbq@}Oli@eRv{BmGaLlGa_AyKxKBij@pBoU`PaQRydA\"],[\"gvvh@nokl@{SbSmRqEsGgR|@ue@lg@snAzh@aw@fZ`d@|StGvBji@g[~]uKyFqDmZyUviA\"],[\"m_yh@zl~n@vMfS~U|tAiD`|@}GeL{CvM^|t@k\\\\}TvBu\\\\wTgc@Wou@qSem@si@npCuBsHH_cClS{bAzBm_Axe@zKlFhL_Jp}A|RrT|CrS\"],[\"slzh@~xtl@m\\\\f]g^{}@vFocA|HaVjUZrGsl@|S{b@z@lhD\"],[\"siwh@vttl@l@s`@lKbLvFpd@bKcc@zLpCKtZzK{\\\\?rXoKzR|B`eAed@`Ws_@niC{]tkA_NhSmPyIgc@zBk_@cj@mUkLwPmk@zTwi@nDc}ArFaRz{@ua@tt@acBhTzM~Axv@|FfGxHsK\"],[\"md~h@xfoh@v^s`EhJqSuD`|Cgb@jiDuOphDyHmBcDeU\"],[\"o~di@vj`v@pGuTx\\\\mKfC`P_P|BxCvl@eZrYuS_GlPkRwK}o@\"],[\"ukgi@zofv@iBuOrG_H`KpSfHj~@sY`ZoGqG~Ovg@gTgV{Kn\\\\aPsX?`PgWaOyC|Elq@zcAm_@EyUeX_FqhAnTgk@rXoTxL{YzHcD\"],[\"kh`i@zogo@`Eng@nTri@r@ra@iRhoAkZiDwKvTqYw{@{fA_kAeNeyCoW{y@}_@oDoa@{v@mGsVdC_aAeXvv@uLnKeI_Lk^mpC|A}eB|IoVth@ee@fXtq@pf@~g@uQ~z@tMnJxTsAtVvz@~QiHnt@pfB~XhC|Mzm@bZr\\\\\"],[\"enhi@nuav@xUhLa@h\\\\`NrDsVdq@sMyAoRzaA_UiA_j@u~BjLo_@xKnGUmNyKqEgGnLkKi]nGrC~PuRfKdJdGg]|MtHzGb^\"],[\"ytji@t{xm@lPc[pZ?bDjOhK`@lXzvA|e@znA|UaJxIah@`Ple@fLyKcFbZaNpEZjhAjLpt@yPvaAuo@t~@eYtKwc@o\\\\ig@xKaPaTgJ}n@cGmtAdXutA?{vBwIii@\"],[\"afni@tgdh@xB_i@lPij@jOh[jDt|BxHgj@hF~KcPjyCsOyOgKsnBuU_P\"],[\"sami@tjmh@lA|b@{e@tsEqGmv@wg@yxAiCie@~PomAoFwd@uJ`jAeHhN}HoIA_n@h_@m|AvMtBx_@hpA\"],[\"ehoi@pczn@{\\\\?iEiIpIcl@|FbJ`GuNbAeXsHePye@pXQcd@aKqUrVo|AhT}a@rV{Pvi@||AxKtxBrl@znCsMntAjb@njBeQda@uMiOqb@afBot@_lA\"],[\"u_oi@||xo@}Hhr@pFdNn}@ewAzQhObCpUmX~oCyIrC{d@gPwCfPri@b{@ec@zIe_@vyAIzcDqOj`@uVgp@wMoI`PypATqy@zFiQ|SoG`Fc]{FeB_KrOcGg]cKjt@{OvTgOzAiHmw@|Agj@nH}AmJopArXes@xJtPzIIiRat@xMax@oSecB|Du[sB_G}OV_Tms@fMevAcA{o@xLc\\\\lUtC~B}]jPqWhn@x_ChG~p@lHv}D\"],[\"shqi@pldr@_Nhh@kKhHuNeKcGgYpX}kE|LbkBxFxF`Poa@tBzY\"],[\"imsi@n_aw@qMiaApMkaAvTh{ByKzO\"],[\"uyqi@lkyv@jPtPpKzg@wi@|oBsVefBt@m_@g\\\\}Y?aj@jKn\\\\pCcGlHvErJr^\"],[\"k~ui@rzct@aYfpBgVpGqVl}@{KwnDoGz\\\\aLeQnXyfAlPc{ByPe~AjHgi@x`@_l@fNuArE_\\\\hR|YbHziDc[jnC\"],[\"y`jj@nssj@`EyeBhMfB|Th^tK_G|FpKrHrgCf]`jDpXx_AmGpgAgRz@mO~g@oWvXoG?gCc]fC_]rClKhCoOsEcc@uQ_Rge@aMaMrC{SmdBbKkY\"],[\"mysj@~zok@rXca@lPgr@tUn`C|g@kDbL}UhMhO`Cj]oFlR{IgLcW`~Bqb@gF_C~RuTrKkPhg@aPhCya@i`ClKkk@dH{Mrc@j[hP~b@tRoXkUaJ_f@{oA\"],[\"msvj@dtkk@|Ik]nZaRjk@paAiPznCqQ`]aj@ihA\"],[\"mgzj@tzdy@|Gsi@fa@z]dUbEpF|w@eGpr@e]cpAiCrr@oGuCqG|G\"],[\"{ink@|lmy@rXaa@lOxKcDdh@_[~b@\"],[\"ctkk@p|cz@sXd{@mFeh@iGuNcMnHyHyMu`@}_BvCgi@zG{@fXfVtSqo@xLr@da@~~@\"],[\"insk@~|fz@e_@chBq@cz@rQ_s@hWzZnD_F_PybAnc@}k@hRuEjs@ro@zO~f@pFhcD_f@pnAyh@fXuUeB\"],[\"ci|k@va~`A{R_m@{EefB~C}jBpNe{@tOb]~OtcF\"],[\"sa}k@pp{~@bf@qoBxT_Frr@dw@nMmPxXtTb`@oGtfAtmBtIlEvEuMjIvNhJnn@|m@j~ApZuDrLpTdOl`Af_@xcAuMx|@bEzQlPgd@zBrSh]apA`I~q@wMbTtC`j@eL{B{Hzg@c_@fWgw@|vC}g@`|@aRzv@yKqLwTldCqGye@{BnGgCoGwe@z|AiC}m@ca@dlCqLnLe[zvAsBzcAa]bjBo@~zBwGp^qe@mo@cNo|@ut@_nA_a@{tAqNudPmOuxAqD_yB|N}lDh]uiAfOeiAhSeX\"],[\"ss|k@rckp@gIag@i\\\\}Ua^~@kR~a@eLo{DnGglCbe@kaCwAy[{Rsb@xMil@|Q}|D~ZkyB?ohHkR_oAzEqq@oEgvK`b@m{Cf^coG`[abAlAabAtSwg@pGnGle@mpAbLtTdMoObTwsAp@uuBbIwFrCf]dLkMrc@{xAz]t^r\\\\uxA`QuyC|Shn@tC_YjKz\\\\jTme@nP`j@jOoh@r@a|A~W{c@xFbXlEtCbFwKxKtW|QdxBoWfpRyO|_A{HfrMx[feHd|@plI~FteCgJ|mBsdAzmGmZbNsa@lr@}m@ji@kn@o`@eGhZkNvG{Nwe@sIK}Rz[cK|bAdGjz@cJhm@oL`mE}Wnv@cKfFcMwMyK|\\\\wIwOq\\\\tDiQyPsHaR|BgkAyKrr@_Xrn@\"],[\"yjfk@xgxy@bXaJjIhz@tXje@t^x~B_DvcCj]ioCwC_g@dL_PbGje@hCer@p\\\\wCxi@cYzTnHf]xeF`P__CgN}{AzGcMrb@rLhIbOmDlt@pXzvAdJ}]uRm`BvAsd@`IaDdz@vz@nL`|AmQL}E_e@}Fve@pWbw@|BrqAx_AxjAzUroAt]xzCS`kCeP`p@wHv_CnGllByLlbAgg@paAko@mXmj@}`AsO~XzBdXhTuTfC~r@ny@htArZxKfHc_AlFfGlZiU|By`@|PyMjRoiAtYoZxF{x@bkAjkBzLt}@jz@flCeChkA~Onh@_Q~{@ur@nvAQh`@lTh|@BpgA}|@rr@kP_@cj@b{@{HsViNtEmThmBqLrVsb@xKgP`b@oXzIiWbnAi`@tZcD|iBsO|r@eRxNaYjqAel@j_AmKti@uTuO_Axo@aNnIgCaPqGhn@g[pl@oNrdBpHze@iGzTtB~QnGoG~Gda@oPje@}SlPyKiTgC~d@qG{\\\\kPzpAcQv]bQnvAnOj\\\\pPcAtDn]uDd\\\\uCkN{G`AyVdoA|Jly@eKrLr@fg@g_@ndA}KwKyGvK?}gAqIbJlAlmA}GoG{\\\\pa@?qa@cIjPca@h_ClDta@m\\\\|zA?x^gLxK{d@p|Bej@`oAoLaPmF`Ug]pBm}@g_Bsr@iT_\\\\}r@wJarAyBpXgCelA}GnGhC}rCgGo|@bP|SvFoJ}Bmb@fVsWjA_`AjTtO|Gca@?rr@vY}o@bUadAwQgRBy`@pGba@fPqn@f^wc@dG_DfFdGfP}eAmCmRyKrX?gN`P{f@lRlTuA|~@~O{p@bUopFgCkl@mPig@qGxe@mOqUpGeq@_@gjDtG}SaWu}@mQsRqZnIoNhu@kPyMaKn^oPca@oGtcAsXqa@}GvKqGke@gC|GjFaVoN_SjKsX`Lr]oCb\\\\xDkNrR~TdXg`A^qQ{GzM{DmVxOoGlEbE~Glg@`Nu@xD{LgL}hBvCuf@wFsCgJ~MoNa`@}BxKoG}BaPnpA_GwEhBic@qInIyIoI?rXoGyK{Bb{@aPea@mJrTw@hgAqGme@gChTwBeIkCm_@sCju@|Cl]x\\\\fz@qClk@aMkEaSrx@qRkT}MucAoPpv@cGicAoGfCwT__AhCpGjFkD{Ecg@~Z_y@qAmz@xKhTxQk}@[gmCyHqY{@jaBsRvnAu\\\\faAsXn\\\\eh@p`BdBzQbG_HpG`P`PyI|Gg_@x@xvAjYxoBlJz{BwWpkBqDvEiHgGeTjaDcH~PcO{@s]fzCyClL?iTcFzEgM~f@sAwy@cc@_z@cC{gBy[wW|@oaEtb@m|Ari@}m@bIyW|Ck{@fYun@yCee@kW|e@iX`kA{JWw]md@}SzBCn|@yMhZbKfj@oGffAmI~VsCkUkOcKqGvuBkGxDgLsX}Gr\\\\rCja@g]wtA{Bz\\\\yMeNgFmiAiJ?`JunA}WahArEqlCjTceA|DgpAzOg_A~Tc\\\\uIwCuEnGaRka@eJaCaRuaAkZkHmCtY`PmEsKlUhb@fjB_E~lBoG}G{Bpa@w\\\\s`@qRqrAeEkfAbGc{BvTvThTi]mD}HfQ{k@pGssBhTui@Di`@pd@elBpKoDbMzV~Bkb@eBkPw[iHoEmKeEgtD~M}]_PaYyFsi@qXekJ}QwiAo`@el@mIupAdEkXpX`_A~Aei@cSy_B|Qc~A`JefDbo@amAvSgeAp^kJ`e@hb@zUxmBxS~\\\\gQzmC|KhCbS{M~E``@sJpbBpG_GlZjVpLuUwCiq@sTuaArSs{@}C{nAdBcVdMoJnGxv@dH}j@lOySjZ~YmHj~@pd@zpGnPjz@fYlXlwBvjH`OzyAfG~KpDcd@`IuC~HxNtK_YlRjLzWgCdF{PmZ}Lil@wnAqa@}mB~BcUcJoj@rCyiErm@}{F`OsQZhNzJoAhV|OfJnyAxKo{Bla@aa@n@im@f`@wg@zd@xbBxK}GzBdLtC}GfK`IPimAme@ktA}VqiDeSaf@qBdK?ap@lP}M`P|m@ba@itAr[gA`Ra[`PvT]ad@\"],[\"knal@rlq{@_FhbAiIdb@mO`Qi]quEjCa|@kCcOaPf]}EmWeFsvAkOiw@BqjCeg@s}E}M{vGrKgi@fQ_H|y@hfBdWd{B~d@btAlZn|HeRzh@pEjNlJlBxDuPbDzKjFpbCwO~hCiAq{EaKnb@\"],[\"aybl@znbv@dLqz@zb@xi@eRfe@tIl`@vEjD|KeOpTfF`z@|tAdwAhkDxEj~AqGvZfDzFd`@um@t`@pdAzPrF|CfR~QxPlMxk@fr@dr@dIb\\\\jN~lEdJk_DjES~i@z{@|SvM`p@t{ApVtBre@`jAxm@xt@z]h_Bv_@ns@tUtsDin@hcOmSfw@~Mz~@jMhuMsDxpAnHjT~Q~FhJaKsKgoAxOy`EgRgv@lE}Cp@rIvTaArT~QhGgCnZytBft@ynAz_@wUtQlP~Ctb@fi@~l@~XrtBxcBveCda@lK~UxXxErpArKtHzCq`AsX__ArXn\\\\xKsXxBtT~GsCaKnoCeMp`@lEx{Fz]jvFsCdPmN{f@jA`_Awm@gNqRq|@Fx`A{EpCs]w_G`LrhE_Ixf@qBqHqZx_@ms@cZ}g@gCsXz\\\\uZzM`Aqx@wKpa@gCgf@eQr]}W`RcGt|@g]tTqGuTcSx]yIxl@kOnHcIbwAmNyA}BfLcG{JnBio@}Sle@kH{E}Hx`AcLlDqe@cH}Z_Xg`@l_@}GpY_Hb@}Eaa@qI_HeJ]sQpYsRyWmHmuA{P|i@mi@`C{CgPmD`Q}a@d@mRrc@oGwT_YoGkInYac@pXaY_b@uC`PyH{KuRbj@sCke@mKtOyCiVtGyx@_^t~@_F|r@oOoXeMrToGef@cGlPqGyLm[s{ChNcc@yHq[|C}e@tv@ikCpLagCgCipBiQ~e@cCb`BwJ|j@mi@jeBiU~XcP}aBkKfw@dTjbAs_@zxAwVxRcb@cJsUig@i\\\\grF{ZwuAjAm`DuTgv@{Ci{Gp_@yx@xEij@uEeSgCvNsXzHwNqUwIw|GxUk~C`E_gCtMq]lHtG`DeJeJe`AjX{cCvVodAbSy\\\\r]df@wC}}BeCmIuTgC{UavDtFeWkIc~B~OqhJhw@sqK_HuI\"],[\"soml@n|sz@yKfc@mPoVwOqkAcPkIwHqn@{SkWyB_rAlRscAnt@|gAzBfcA|[t|A\"],[\"gzol@lpey@n\\\\uz@nN_FhMvy@{U~dFm]f~A}Iom@~Egf@{O`K}I}WyEc|A\"],[\"uv{l@~dg~@|L{_@|Xfb@d[hjAjOj`A|OdxDnJdh@cR~cFsm@baEsIlMwX_gAuj@_k@}Goa@cGdr@uN{a@aE~CyJ{Kc}@}yD{@eiD`j@_aEdFwHjJ`Ct]kh@l]`@\"],[\"ewvl@`}_x@na@mPziAhT`KzH`BhbAtE_z@jIgHn\\\\hn@fIrm@fJdnJmLzw@zPbfBu@riDi`@f`AhLvx@mJfzAgDnLqXwTim@f|CjSttAkCzLaPer@x@no@jMjKrUyP\\\\fd@wC`QsL~BwOaa@}GfM_B|cBiPpYpEfNpHsAeB`bBiD|O]wS}JmLcDtx@uYnqAkRrYqGw_AyLhLdHhv@ca@mv@{EjE|Att@~S?lLp[vS~DbL`e@Gbr@yOpa@}LiKiOlGjCeh@cOk@a@|uBbLvj@eClOsXaP{YxD_g@wv@ia@uW~G{q@_JmJoGhTmGa^eP_eFoDlbAwG_JkJ_kBaExQ|HjsCcGqGsCpGyKayAqe@qaA|KcRvKz\\\\gTqaB}Lx`Db@vYpG?tEd]mA|\\\\}N_McE_zAwK{MdE}`AlPcWs@utAqE{NeMljAaQdYmUs^}\\\\q|ByMiwA~Imn@}[oe@`DkcB}O}n@o@}x@wLmr@hX_{H`fBa}Fpg@y|BvFocAhTaPxKf}A|AsqAtTghBdVrMdO|b@tj@whA|W`^fLwKhTf}A_BmbAnb@qt@vKcJ\"],[\"qk`m@lf}y@nGnpAdLsr@~GbTnR~eCmJcAeLjhA_JqWmZju@kGwKePpXcj@kyEpGgUySaqCkCaKsXrCcNw]p^mwCFc`Af]{gA|SttAtC{Gpe@vjBeg@x}BeJxHaFqDiNw^gBhd@bGsCvTplAxP|C`Z{[\"],[\"s_jm@vfzhAqEeo@|AwVdKcHhT?`c@hTxe@lv@`Qb~@Fpn@mOfmAuf@ghDoJjEmPwTuOf]kFu@\"],[\"yxfm@xfx}@nHhL?pmGoH|c@mOegAeHqL?le@cMob@}Au}BmItt@_UcPmKsg@au@{eOxK_yAjGvHlCs`A|GtCvLj`ArNpwDzKz_@dk@nf@pOt}@mBdnAhJy@`@tW{CtUuCuC\"],[\"o}jm@hltx@xFkPr]}BnZoPn`@_`AfZkNfQ~aFkHvt@s]vp@ap@xAaHv^eTzRoPmyCkS}pA\"],[\"mfkm@fjr}@x_A~zT}GbjC}MxIuI|ZiA_`B}Quz@qAgmCoi@qoFuWgaLbHmx@nR{ShLdD`EdQ\"],[\"ijim@ln{fArXba@nGeLxKvn@pIfBvIdY~FbEzW_IfiAnpE|Gui@d|@d`G}OdpBs@rzAyKii@oDbf@a@tbDgVrbC}G?a[ao@}}@osEem@y|A}F}s@eO_Ia]ajAi^y{D?kyAqf@su@eS_|BqGtCyQ}z@}G{uAnVgzBnb@nb@vN`d@nPlB`a@p{@\"],[\"{wmm@r|co@xVuz@nV~d@zh@bpD|L|RrSmPlj@uiD`GlLmBtmBqLg]_CjLEjpCvPb}A`K{v@zS`|@uNhWQ|gAwMaPkIxKObtAwK`QyKg}A_GiJiLrEuEkEmt@efEqGrCcF_KgNabA_Ymo@\"],[\"qhnm@~lsp@}GttAgSk_BlFqaA\"],[\"_`lm@bqj~@oC`rAiGmk@}L`tHiG~Yyb@~h@oNcTaFw~@iQ}QcGmcArBsl@iVgiDi@efDkb@kzOhLsl@tUkPrOss@h\\\\hZ`Pea@?vz@pGqGhf@tkCxSfxF\"],[\"eatm@pupu@}B~xCcQm~@\"],[\"{tsm@dngr@gO|pAkRkKyRjTtEwlAtR{~@\"],[\"}otm@v_v~@uQdh@rHne@uDb\\\\mPdLiCaPmP~~@uOaPyKdXee@qlEoC{kE~Qm}@hJxEt`@rkAcEolBgSoQ_Gyb@v[yhArDajAjLpSlK}EzQewBgEguArSoHrDqeB`H{]rOmEjx@tjEdH`sI}CxuHoEtQkTqm@wK|SgCvz@_Nqc@oLyI\"],[\"y~am@pymz@`]_IvQeUbWyx@~Bt^mP|vAwQpO}PIwDwJ{p@|wCk@vUrOwE@paAv\\\\mz@lNsGnHnO_Bz]s\\\\zVoC|e@nF|RnHgEtCb{@nGc{@bGtcAvD}TiBuo@|QchBjDbbDxFzO|P}pB|JzeAqGgCpGb{@yKyKlC|d@nT`Y|B{\\\\}BxKcDmR`H}QnGna@dIaH~Eed@s[gs@iC}YfFuWnGve@hCsClPna@bGyv@}KjGmJ}OnX_\\\\vOcx@tOuOnG`j@fB}aAtDmVdG`P`I_k@q@aJgCjKeCcHtKwSxUhbBBft@a\\\\f|B_JpLgToG}Gdr@dJsMdNlk@xB_PjC~OdQw\\\\dQipAbd@cQxVtRkF~c@tV~}LsEhWoGkY}BtcAsCqGoGxK}GuOaGpf@tKph@dIuNnLzFhKpeGcEnRoGaPkI~FaJ}Fmf@}{@iLrNla@js@hZrdArX}m@tHdhCm\\\\bcMiUdQse@mEgQp[oGwT_Yh}AoQarAoAmkGgNxdDzBj~@iIt_@yHcnAyNyRpLn~Ccf@nmDsParAxC{ZpGxz@zFqn@aAcWcIqDoG{|AqFhw@qH~J?qGwI~[sEn~AeKahLyGgSsCn{D{Euu@|DyqB{Jqt@oPrlEyKyyGcGtcCqTsrE}K{V?b{BmKaa@wKnGoPgzOuHkh@aHbVt\\\\pfXlHx^\\\\pmDvT||HbVzyDyPb[Q~Ynd@~~JtChqEhYtpCwBpTmMO}DvJeDjw@kQu\\\\cZt~AgKud@vKkt@Tk_AmKbj@}GyTmPzKyKeaBcGda@wGsMT_dEnO{vAeCo{@V|r@_DtOsCmPsJhOcD`iA_YkyAsFjPxHf`CuOd]ad@{dAoUikGvRc_@tJgm@rXg}CpXvK|DaQnJiiBuV}lAmC{dB{Mym@rChtAzByKpE~TtE~vD{VqReTtZgJvnBgPr@}OtUw^}uBk[yz@jR`}FiVrrAqVx_@wXbCcp@kcBeOe{B{YyaBvTvyF{Enf@nD`}CmJng@eK{}@kLyDsGrKI`hA_QexAeO`\\\\yK}xA_Pj_AaIcMw[{jCdLuwFnMkx@xt@idDvIhTfh@pXrTsqDzUuoAz^qx@`PpgAaPqgElL`UnK|hB|HohDz~@isBhF_|@bIvFrJnj@tCa`@yIi}@rG}MxQa@vCyOmS}q@qTqcD_L_JzBeg@oHaq@}E|^t[|gF_Gr@{A`UwM}Q}EzJ[tk@yJnHaAhc@cPsXg@`h@iEtM}^pSgSh`A?a_AoG|BwEsX|UcyFcXwdDhMtnD}f@bwDbH`lBcZv`EaIdSuK}HoGq^pEudFuTttA_EqUdR{fA?chCmFp~AwDvN}Giz@yBhtAwT|gAmK_YnGfcAeK`m@~Cd|Ea}@xrDmOvN{Nk_DmDqRyKjPes@evFvTykH{OwcAIw}@jPcx@rCttA`FgeBtu@}oCiEsnArGwNfRfiA|KeIhSkr@dFiv@y^ri@aJqbBdBaOrPcMb@eq@qGpX{GuTsGhLgRfz@oMdLqCve@qLoJaKnJiCsXoGpGwKsxBbGy_@lKa@tCqP_DuNkOnCPasBkLcX}B|S{Gw`@eDdo@hH~cC}Orp@sA}yF~Gq\\\\|N`MlIqD`VchDhCpnA}GbnA|NcVhMwpBoGkPjPmr@rImgDzQgwAzKhTlSoTxFfAnNlg@j_@fKlLlU|Gaj@dLnG`IgNh[w_BvTs\\\\h\\\\gBba@pq@va@~GfXxQ|EfPjX{Dtn@ym@ff@z{@lb@zhDlKefDdL}i@zBmnBtJs\\\\vKcBrSdTve@[\"],[\"qvvm@xwv|@xKnnBcPbyEe`@o}CyG{uCsPaa@mCmu@sMgJcLod@eN_vEvK_mF\"],[\"{tym@zmvu@dFlJrDmJdK~JpNfkA`_@|Ytg@gV|Z}zAvKf}AoQry@vDbQpMgEnBfWa\\\\xlCo^te@cwAr{DaUpOyXsg@wRk{BsJuxE|PmrA\"],[\"m_em@|ni_AjR?bPti@n^zQ|VhyBxKmP|Gzv@|EypAbIwOj[ngAhYs`@~LxBzIvd@zMrGt\\\\`|Aj_@ha@rRm}@fIr]fL|@dFfiAnQrf@fFjB`Kmn@tInBn`@ptMnp@bxChAblCqM~nDgWpkBeXzVwIqTsUp@}Nnp@zB|GpYoYlJ|Q|K~p@T|tAlFrBzPi^lLxk@pGsm@jKffHaN`lAaKzPoN`tCfCgwDod@ts@sShmClN~iAlP`PdGiT?b{@|GsX~ZzbFdVd`@zBwKvU~wAgAvzEfIhfAbRzk@uInJ~Fth@eNr~AjNj_AvKc{@rEhQhBhhE`SuCdToj@nKjPnLfaBbPve@dEffAvQz^|LbmCzSxy@wDhv@|i@`yC~_@ljJxT~zB~InmJk]faLqkAhkHsCsCqg@jlCsJ`WcOrDkR_[oZkcBq]acDga@ilKhIciAiImNyKttAcG_yAs[{m@cZwbEiMejMhMwqDiLqvBgXiaAoGb{@yFqSxFe~@aPe_ByKfn@?o{@}GgC{HwbAiWilAmGpXgFo\\\\yH_D_Hwz@_KnSqL|}AfC~vA|ZxZrTrs@zLzgA^tsAnGef@?|gAqX~m@|`@fiBkGxsAtPhrDuPrnAaN}dAmRmh@{Bb{@}Gca@yKni@nMhb@fFfBxBiPbJq@nAt~AqNf]aP{Z_Hd@oQhs@bGqGzArTpb@vXnNc[tVbAhSpaBvFlzBw_@fs@na@~XhCtOlQ`hLcIjk@a]kAsLoN_\\\\ipD}B|k@vP`|AeHzl@{vAanAs}@cqHjBluA_AfH{Hm@nJrZl[h}Ee~@~yBjIrKnAgHl[vFnYgYp~@dq@tWbj@oHhfAaKtXwNxEaSh|A`T|_AjHkGdE{lBfXmXrUer@lZtOvj@fdAz{@pbIjA~|@iUluAih@fQkRk_@e_@~QeSzc@|I}CfI|J`\\\\jqAo[~aDjKuGdGme@rEdHtg@}i@xZl\\\\rN|pAgw@xpI}VcjBwQoXrPflCmH~i@g\\\\ov@q[qB}@xFfOrsDfc@pkAbB~|@yWdrBvF`h@g_@`sHsc@nnBkKwTqGfLyFuMkDgy@}LpJmL_Ms}@g{CaMy~@oAetUo_AsyGoGikE}N|KyL{PmWgxAkEwcAkFh^`^~fEfHhjEnTfqD~S`rAl@tcToClXoOfEgNcLe`BcuEk[_eTcP}VbPcrBc[etMaGkLeE`CkDf[`N~kO{D|f@nPprGuR~zHsVrP{M_MmHc|@i@w_BuTbj@qGiTiDr[rHtgA{K`ZyItAcf@gn@oD}c@iRc[iZebFpMuqHyCihChWweKeHacBiGt_EmRrg@{PjtCEpwH}Hd|@aT`^cU}m@uOje@_bAsyFiMwqHuXe|A?mjBlPxe@kD{aEhYguDrPcZlXqNvKbP|BqGrC~m@|Gcj@pMrN|e@i}AhVdp@nP{}@}Hwv@\\\\ecBee@m_F|FcyBmK_fBjMomAlRsVu@sm@pI_@EmsApTexBhOfo@pLs@f@|N|j@yaAtI{`@fLcyCrg@}xE`RqYxXdwEdPzeApGi]lKzv@zBei@_T_mAtCca@bGna@|G}t@qLar@eGc~FtAwFlMb\\\\?l_A`O}tAdMyOzMlI`Egp@zIiDfVz]pNiTfVhTpUed@jBkb@tObPbOqk@c_@wkMrGsr@fKuF{C_zEr]yjSnKcgAqGoGuV~QwMvaA{GuWrCsuAmPpXiTke@qa@~~@eGicAkPvtCmK{BaFhXoZlaFgCqd@{BprDaPwe@qGhn@qL{\\\\sEhZbItu@eCz^tJr`@uZvcBkM_p@uPqtDqUu|@iKa_BuIcZaGz\\\\yC{Vj@y~@kHoNqGhhAyKuC{Gom@aGlHwU|fBoV~_@wHfqBkBmV}G~CmHxkBbDjTrOyKdDnOwp@hsCcNzrCsXoa@sFhX}D|CgDiGuN`TkToGiMghDgSgaAwGwqCyOwh@oG~xAkT{v@eLxKrCcpAkPdGqGicA_[k@uMm[gNqFcVobBqGnGsH{MsS}vAiCinB|Fu`AmJgq@sQg[`^{mAbZjXpAqaBnT}q@hQeCrIhNdXlbAv@b`BrX}ZfXy{AzGkB`DjH`Kjq@xBk_AxRvg@vMkaAvH|~@hK|PhGkOrGihDjXeq@fCke@pGl\\\\f]qxAhCz\\\\dCqGzGtM{Crl@lGrK|G}IpGcnApHdStU``D`NbS`JaGlQ|d@zG}m@z\\\\|gAaPk|J~P}aBb^sw@fIbDjYtyApGoa@zHnbBtNf\\\\nGsCfJbf@hVp\\\\bGmPfCrCcAm`Amz@q|BoS{{E~J_uDnEuQ~Jz\\\\lPpGrOcRfLcjAxKtn@dL_s@zItIlNiBzWeSjLi]aHwBwThTme@{\\\\yBdLqQeoAeB_vAiVlhDuKdBsZ_M_WfMeNiIey@_wC}@muDrk@miJ~_@u~Axp@uvAtUwMrKxKvJ}B|UuTdGrX`LiZ\"],[\"{ezm@b|aeAuC~XkTkKqd@_mC_LczBiIynJbf@ypEff@qb@hj@hhCiLjaBsAppJ\"],[\"utwl@b|po@xi@qjAfKs`A|O`e@pXzeGjLfy@y]``Dqb@_LoMt^t\\\\_ChIzk@{e@|^zW|{@tRma@tYe~CnGpLxKqLvTjyApX{gAxKln@xKvBnGiTzKvKpBoX|Tfn@|G}GZvnCbM~y@sHjeJtI`_Cr_AxcHuJpmDsSzaBcDdyBsPeWkO`WnFl^rCoG|GdLrOhnAmWbcEuhAhzB_WdGqQ}FcWcq@{IvZ}LcAkXdnDe`@`rAvIfk@|e@yd@~VmzAjQ}Shc@`j@nK`B`L`^xi@vNpIbMbShnAn@tgG|U~dCfAr|E{Mls@tLxzAwCnk@_ZfRwJbYgVoGiVxKwGmSkJyAkMv^lL_C~Wrm@~L}OlSlGh]aPdOpUnK|eAyFxjBqXzBsXdf@gu@v`@{Hj`@dBlF~GgErClKnBaKfb@oGza@eXpQ~\\\\`J~l@nGldI}g@wZuZ||@qG}N}G|N~Krc@|[uGtO`PpXloAg^znDeLz]cMlE}BjUmP}CgCv`@tM}Ex]tDvo@izBhC`_E}]l_AzUjUvDzaCiHbn@yYxVyKun@zDjaA|_@ne@hO`oBiIfz@e[zIvV|jAxAlqBuCx_@{OtU`R`y@kKhkDyKpQi_@pIib@}Rok@i|@cNCePsO_GrO}GwtAoGzvBqXsXkj@hx@xCpWtXuTxGvEpKbg@kThCdA`UtZ~SvR`c@hXiBfKxIp^zr@gEzYuHcFsUjWqWgDqKzEca@hnB|VwUdIu_@|f@h^yUfbCfVme@nTucAnp@h]fN|}@sAprAnTz_@fCxxAeF~IvL|`AlDxfCmBxQg_@jT{BdOlUv~@m\\\\`cDaPke@bNvcBiAvw@ca@|fBca@~SyKgXbGxkA|V`d@uItiAkTmScMk|@ib@qp@sIgc@i]?eCvQtC`a@zXpd@bv@vzB{Bfk@vKrd@fcA{pAgCjsBaP~JuHeMqOfGyH|TnIbZpHmTbXbQlAryAkWvlBmPui@oHf[\\\\rzAlJoFhBvKeEjd@uO{m@oW|cCeOrZwN{BoOwYca@e@aPhi@aFgRcHvFqj@zdB{GeL{KKeClPcMen@nDa~@oGiYuT~~@uImc@vFnlCwUlaAmGuCaPxkAkTm\\\\sXnGe]_YkT__A?htAgq@kr@gb@oq@sZc`AwGmcAiRer@cP|m@uTii@ov@leBwe@kk@ad@xLeLbc@wRrmC}Uf]yBdj@cS~MeGzg@yh@bQcLeGkS`RsQry@{]rXeMbnAoc@rjAyObScGoPqGtCgLpi@R~cBlj@f{DObp@}Gi]oGpGXnYbXjv@`P{pA`D~i@qJzuArEpkCmNfiAnExcGgGhd@sCeLyKdL?pt@n[z|@zHl}AnPle@qG|lEwZcCoLcOtIgi@_KqvB{Ogi@Ba}AhFqW|GxKLsJeLwYmWfjEdJr^sPrx@lKnpAgF|_@yFeBeHck@sAxZbJnrA_IfgD_V`}A{f@nsAeNi_@aAgzDmr@}hBha@jyBsG|ZlG|zBuOuTc@t|AsRt_@{Cr_@{GcLMtzAgMpn@aU|^_I|pBhDnuBsUxy@sCkg@uOoc@aGbc@mHhAsF{UjKqnAvKcf@hExFxD}O}HsnApM{oAeGg|@uKtsBbCju@qG{m@}GjKcCoHzGih@_H{pAiS|pDgOnt@gBhsAi[`\\\\uFkHeM{wByKtiBsEgErEwrEpGxBA}F{Quy@l@aeA_KuK?le@gJpGeEti@cIarFmOcoCnH{wEzb@apClC_uBhJil@xC}fGnOaExMwYKs{AlRyl@v@gjAm]o|AtHmcDnd@ggAlq@kzDbGnG~GmOCon@~k@yKda@ht@~QbAjOv\\\\fHmG~i@~rBtXbjBd^sz@vAm`@gKc^qAt_AgIbKsXogCk_@_hAqRaPsXqlAwKdL_FeUq@soAhTy_DkOwhBlMw_B_NqrAlAkq@e[}|AkQa_Jn^_dIza@ufAbVajDrMaUxK|SvKme@dJpMdFlcBkQvhDeTtp@vKxKrC_HhFjf@uUjkDvI`fCpXweBrC{_GjTkyE~Nqf@iK_pAfdA{mUt_@o_AfM~G?ba@zPx]tFbi@fCt_ThHqQ|FolEvGg\\\\|ErYul@jzSaPhn@_YbjFzg@i}El_@f}A}GafBf]ufJhCve@|QjYrE~y@bFc{AqWqgBlPkbFxN~XtEje@w@j{NtGpb@CujBbH{[nGtoAdOcfA|M}EvWj|B|SaP`TdDiGgY{SsTqGpGyOyrAsRgp@oZoa@C{f@zK}c@bGje@lDoUiQw{ArGclAzRtw@tMhFhJwFgn@i~BbVs|Bqa@wTgJ_RiB_t@`EiUhQnRvDudAtTi_@rQqbAfd@lr@|LtG|GaPbGvK?ke@aPoTiTipA?ti@aC{Gt@uw@r_@qyBvEsjAlR_UzoAdz@pJjzAeBjwCtQr]`Fxh@bDe_@_LueAbKu`@pGjeB|EqSuA}rAuTenAr@w_Cbg@ek@bBoOpPsDxKglAzJvCfFmJnCu^qC__BsZyEaj@bf@mc@~s@}U{xAcSCaDeTfPaxAz]e|@f]g}AxH{bArOaM~Jx_AlPin@on@iaD_T_gCrF{q@nGoUbR}I`ScjAxIfIvMly@yAuuAqHeTmQyDkPrh@mSvIaPk`AaPiVmCm}@rPk_BfZa_@~m@ooD_@uzBn\\\\meCvN`a@tUd{BsCgfCmWg_CgCg~B~JieHmIzc@uEzbCaVngA_J|}B}MlX}OiwAkLuvD{W{lAyAof@hQrWfFctEqGvz@{GsXcG|G}@{z@eNaL}GyeByFxQGovC|RolCeF_n@}GbaBwKaI{KhM{LmiF}]wdA{DgbBmYe~@}Gpr@|G{cBqFk_AfBgV|Gna@pH}k@iCcdBh^{lAwB{jC`H{_@kq@ktHiMmvDhg@exV|JgJd{@nvBbPmhDyN{~Hdn@ohGdJuJxKlPtCcuAxb@zsFbJfT}AwdMlJon@rGhcAjUcu@bKw`A~Gj@`GrWdCeLfL~KhDfi@lHdHrG{Mx@g{AvTti@jMdBr]vdAjCd_CjJdHpGnu@jZvSrTlfEjMrb@?r|@x[q`BhDedAlIf}@lH}OsKgxA~Fk\\\\gN_To\\\\sxClFmtBnLku@\"],[\"}gom@llygAsf@xwAc|@tOItUnMvF`JpXcHbwAxEqWzO{LhKbBtEl^fk@krAfN}FzCruAdMcPlJclAzExNgAnmCjf@ooDxHwRbR_HrRlDxCtNwPrlA`_@li@bPc{@aBjz@df@pcBfIjpB_GtUgQfIoG|f@|g@v|@hOxnAkAb_@{Uj^iu@aU{Vwg@eEje@cN{GuDjNtD|iAnGyKrBxUaKtu@q]n]s\\\\as@?~~@gLaCkKyr@}b@v}BbVjlAtN~LvUgGrHpM|NnAtVca@d_@gRvGnGzC|d@mPiDuCiLcJd^dAds@tT`wAba@rX}SttAqGyKoQbp@iYbjEuH~OnA~lDvChNfCeLpQpKtJiGn[jtDwDrwBwUleAsNorAsMqVpGzg@wCfl@qXojBoX}YcHLwB`UrCdsBmPucC{Bdr@gJNcC`RnKbjCvb@r{@nD`gAwKme@}G`PkTc{@yKjTaFkKbCkf@ia@wQpBkjA?|GsX{w@mPnHuYeSce@ydCuS_rGpFspBkMmgDkLcGyZiw@cX~Ayh@_sB{h@mX|Ig|@oNciB{V{`AaO{bDgN}WeR_yA}GxKoGyKuOje@yMid@eEowAeQad@mDhKmGeGmFcr@uMrEcIeGkH}_@HgbAaLyL}BpGgJqt@ySaEcJemBcUc_@mXocCe{@_|@qUknAhBwyB{XczALapKqKyn@gEe{CvKrcAi@{dBjQua@pGmpAiC{uAxKaKpQ_kC_I}{@pD_z@qDeQcYnu@fC`e@sFnMiH}Nn\\\\guEwFkUqG~Sy\\\\meBoE`}@nI~`AqKHxAnwA{RjXaPriAgLyv@pGfClAcIoFqwAmW_aB}G`jBmDcPgOwsHzGxKle@}aF|Gle@pFiW`R{`EjXyhAdXsbCbGbGnPsi@f@vo@vUph@bIjtCrXx}AdIrfEzB`PxNd@bIzNzEt|@zNcdAfQyBrXye@pk@atGrL_\\\\f_@llBa@`hAcYjpCcBfj@pG~WbNoDdh@ypBjGesAnTc[rO?`j@__AvHbbAh`@zr@z\\\\~uCjDhpGnStr@mF|dBjSpj@pNiKdSuk@fYbC`R}^hTwC~g@f}BbR`fCoFzgCeMtv@aMwDcErNmAppBqJzL?oGke@zvByKaP{GxKyKme@{KrXwJcLwPa_AuTz\\\\oG{\\\\yK`j@sXkn@sMpNuOkCsH}c@wk@alAeR~UuF~x@~a@nyAoAvlErPoTvRpDsC~pBxKkT|GnGtW}nA~v@wYbE~kBlRdNlEfRiChuA{Srb@qH~|@vAdNnLkn@pEjhBbRxQp[y{Dvc@go@lJnIdNa[x`@rr@~H`zCj[fVlX_MxAyOxKjn@tP@xDnz@vE~KlKiCrCdMqNn|AqU`c@yVL\"],[\"uzgn@ji~r@mBlIeUkXoShaAcDfyDg]}gCnBq}Dza@mt@nP}gArXlpC\"],[\"wzen@hevu@bGoI~JzFl`@rrArh@j|DPlaEsEpFgKoQ_m@rnIou@j~C}NbMoScTii@rXyKaPuT`PiYkXqA{eAqUokBtP_hJjxAgiIhPkd@fTfAlTmNvKdL\"],[\"itbn@p}u~@lXta@dYftEug@beCpEvgCqGvWaP{KqGt}AgCun@g]f}AcGke@sI`oAwIlQwT?_HqL_GhhAyK_TeLle@{HoQwNx`Ag^cs@_S|nBiKyWuCpJuKiGkNfJkUxc@iW|zAsTdZ}VqrBpGyaDtSyyA~qBy{Hh^qZjOz`@z[`E}@cfDxT{Zxm@soDxKna@xGqp@`WiO\"],[\"wnkn@ni|w@nEtkAfNlTxKiT~Qj_AbHxGdNoC|FbLzJcP`Z|h@uGtwDbG}m@~CrJKfkB{GiCqDv`@lAhjCoKl_A_@nj_@{Jnv@udAt|C_Go`@yCvJmPyBH_RgTz@wGqNia@wrEuHkiDwTohAK}e@rQsXxLzDzF}EhCw[iQue@pMwy@m[_|CxI}qJpGpG?ui@qGpGpGktAcGofArU{kAu@meAoPg]oGyiBb]afFdj@wtA\"],[\"kson@|ajn@zGzKdIoF|DpHjV~~Cqb@x_AwTc~D\"],[\"mjmn@l}o}@yKcUwLnt@_GhJaNcIoIrQg[ir@wNacMbh@i~Hb{@moD~H|PlDxsByEn_GrQjf@lL~hByH`pF\"],[\"syon@|lkdA|KgHhRaz@r[|yA`f@`rGcChuAyVxnBkBpwA}ErRtCktAcMtL_o@zfEm}@vaDwDq]pFc~@iOgkBg_@cgA}[g{EtJyZbPri@xKer@?z\\\\nN}c@{EoxA|q@aeBvIwdC`FkU\"],[\"e{sn@hu{aAjK{Ldo@fz@~ObdAzPrqEnM`~@rPl`KjIze@~RqM|Ii_AlV}b@uJsiFlD}rCxM}Od\\\\`UjGkB~KoYnGnGnGqXrZtkAjJuk@|ObAt^jvAp]vqE~DteCxSzx@}JfyFnP`jAl^tlH|MxaAdA~hA_[``Fmy@`hBxHvvAcMvmBiR`KkI~d@bAvpA{F`Tg]lK{BrLiJwNgM}mAg]xeB{Lu\\\\kXlKmBmXaVviAsR~I{`@uXwP_j@{GnP}Is}@pNwbBiTsj@gs@ooKwBegEqRanAvNwp@yWyfCiMuzCiCahDxMiPvAsiA{T_|A~BayR\"],[\"oxwn@xwx}@`Ji~@dY}Kvx@n_EqBh{A}ExT{LlHeTkVgh@qpB\"],[\"yg{n@zzvt@hQuEdd@bfAxMeDnM|FzTbZnXnmAgJxk@wKrBwW}RuQgt@_Day@yHv[od@}N\"],[\"al{n@d~taAhRvm@|IsKjK~XxIcR|f@fs@cC~|@dL`dEaTvtA|YngFgQl|H}Q~\\\\c[leDzKlcB|S_n@bHbW_D~qAhLxKhIcPzIjQvAhvEkHbc@s^rn@_YjcDz^riJ`J|QhGaJ}Erk@`SriCsEvsB}Qj\\\\sCeGqGv`@aPcgAwTbGaDgKix@skIuc@mkI`DyoEac@kvFwIeZwKje@sAilAq^w|ApCmSkWwrDcPm_AeDicEbi@mtDjMyzCpEqRrJf@pEcJ`Doq@zXumAw@soAbR_|@fRwk@fSwHj@g`@hEkCbJ~J\"],[\"kuao@xeol@ba@ksF~Utl@fFxw@i]xgFc_@yd@\"],[\"_mnn@|wcz@cGrwB_Y}GaPvT{\\\\mpAqTryEkTdx@_]dsE}GykAkK|SkLoP`Iq`MuPyq@{Gz\\\\qGwTin@`gIsChgIyMr@tEwaAqGi]e[xzAgIv_BmPca@}Stn@{Poa@mIppByUjxAyUv\\\\oNyAyC{p@_H~SiDmIhDadD}Iw^y@xMqGeLaFtOoL`s@Elt@{CbNoGqG_Mpr@eVdGmMqGwKda@yKea@yJ~[y_@_dCnLisLzMeaAxWcpFdRug@xImzEng@gnCgE}y@~E}e@pOfa@rRfCrOba@|\\\\adFiWo`CjHetCfb@_uEfd@sxCrRc[~Pj`@n~@deIpX{vDjPyIpa@o}BbMxTjNyTlEb]np@~kAlFv_@mFddDrOlm@i@|l@d[`pD~Wb{IyGd[mNpEuA|m@jUnwClJekAuNemAxKdr@?ke@jE`F~D`}BzPlgAfCkPdEnM\"],[\"w~go@fsm_Ake@bLak@{h@_^qsAaF@sBlc@}O{OjQqzDsf@_`[vLkg@fX{pDmDgr@xJ}hBf^}lArC}gAlKd]|XiThC_j@pX_n@bPypArKgFpw@`c@bJdt@zLnO?__C~G?rOba@lKiTtCfCu@yl@aa@ykAcSiMsXn\\\\{BmK?pGyPuzDi]kbCjEubDt_AkuE~JmuAoK{SjPuiAxJgOdcAhhClHeMdAs|AtB_RjI}@xCuz@mPswANwhBmMkm@BcjBzDkMxQoEpFqYnUepDfVm|@~d@`jB|GknAuHsaAbUsk@fBi|BpHmQpXdlArCca@z^hq@f[dxBrIam@tXe^dl@ba@xGgSvBwrCdn@e~@bPwtAv_A}{CbGm|@ng@|MzVprAbP}S`Jf@zOma@|EjFjWv~AdN`_KxSlv@~@f`@aSzjBoAdhBc[pmE}BlHoGuKmIdF_l@lfBuYp]yWk@mC_h@aLOka@buCuK|K}P_@qJ|VnKx}Bu_@jrCq^~|KjZxlGaZ`bCaNzHqI_LyTipAqIbo@dd@tzPd\\\\r~CjLaEjEfY{RncIku@d|E_Uv]iY~jAeh@~a@_Umk@wVq`BkBknBdf@axJgAmdE|M}pByBmYuSgZqOlXuMqfA}Gna@cGwTeDnWbB~cJoEdT{V{rAw@ejGwTz\\\\qHcqBqHqQoV`h@qGnfAbYhs@kC~Q}GyKeB`NfSb}Boa@wkAwEbOvExw@yGjo@xGhJ|GoG~Mr|@aKjyBgF~TqUmm@kc@}uDoDmNoGJoW`xBiYh_@wE~hB~Vr_@mEfk@nFxt@py@~kAtf@|~C^~iAoPvn@ot@k{BwP{Hik@quAmP|h@iHz`CrXtwGcGrcBmQdZkLuG\"],[\"wmyo@bbc|@mT|Xa_@o{@fCtCgm@cjEjDuaCnRe{AeMm|Dd^uxCrGoMpG`PhT{w@vNqDvQ_l@|Oz_@~ThIbVsp@dn@uZjs@toAjS|LtBjVsDpVeRhCsJhm@aXdgFyKcPyK|SgCqXeSbIm^zhDxKboH}StcA{V_Y\"],[\"_gfp@vboy@cGhyDmPcz@eHkhJjLk_G|\\\\c{@jOveCsInxDcPpyA\"],[\"ujzo@ld~y@cEj|@_Zfq@wYq`@_DkVbCuwElj@{vEvVwqOcFgiEnf@igGsBkXicAvhGwd@obD{Lm}GaPz{F_Kny@zVhbAt[neDvH`sGg`@jxDyQyuAJyiC}Ru`AaEvRpMfeA?tsBwKb^z\\\\tgDUdu@sd@h{EaHdyCm\\\\m_AyKjhC}K_`@jP_oFmCiQyKje@}KigAcCweCz\\\\w~HmPvTiCyKeFbS}Gj}Bm\\\\haA_Zv`EiH_uA{Sce@gLz_AkKfKiA{gMaIqcEd]m|Fw@koJtUi_@sCe|Aye@hhCgOdfF|DrgBec@|rAgPqb@wGotI_Lja@PvrBqJcAkAxMxMhoBcDfpBg]rvEcPgrBeEvR`Mj|CuEh`FaIdTwNa`@uUumEw@fqDaJf^mYisBkGoPm`@qWg]olE`YmkGdCcI`Ppa@xK}g@gJkeAeS}a@rFa{@sNmo@zCghBkKvtAcE{TbE}oAuH`e@oB~{BsXpuBgJ}b@pNuzAiCqnEtTonEcL`PgC}aFuC`PcGke@}Gje@{M}r@uTrZwDjbA{GwT_EbaFbHntEqGrxCaWlnCoRgUuUegAkH|T_Mcx@~OsfIpUa|@uRuq@uZtq@uBwJfFitDdhA_}Rt{@cqGfTr]jTwe@nG`pArWi`Cnd@uqB~MlBvIwJfV{|Adh@{lA~Dsi@zEgLlMhCxNyM`I{Xh[}kFp^ahA`LdOpVdqAfJ|H|D}Th@i`B{G{KrHkf@lLenHro@gpCgCqToc@px@o@k|B_F_BxBo{Dbj@yeD~i@n{BsEgtArEmYbZh_@lFgN~Wt_BvG}Avb@s{@dWgiAdz@oxHmF}lAiTti@~X}pE{v@xnDxJ}rA}FoVyNda@oDl{B}Ips@c_@~fA_Sy^wIwCcWjR_KaV_ZolB`Pg_NnDmOlb@o_@`p@qEfXwW`[yAhc@|tHzGqm@pIo@tT_v@sKkrBvDugHve@ck@la@ecBnT~hCf[vr@zFrmA|Mn^iBmwAwj@ocEhA_k@~OxDju@hy@lb@yCtg@nm@pMxApL``@jVviChLzU|SnGbPyiAoA{d@oKad@wZwe@yOehBlEkzAtD}MlTrI|@iIyMw_@X_n@iMjJ}Nv`AsNc|@oBm_Gjp@{eDzBbL|GcLtOb{@|Gaj@tIaC~DqTbLbuAxMu|@{@qb@kd@sgA{UtA{b@aVgNan@K{u@zVisAjW}P``@mgArToNnHsj@tXqo@bXmvB`^{iAvUsM|UbpDnVf}@zQheC|{@zkRhKxc@tDwEld@l[`Lf|Ayd@hfBqWvRiBlNpFfKve@fGfXcIlR}ZpZ|z@vg@`jDza@lr@~d@ttAdLtCzPheAuf@dtCm`AncAu[gD{]yScc@`QiZatByKpXoSey@sLoIpAdo@vj@|kAjGdxCtYvY|\\\\kQx}@bYnhA}x@pGnsBpHlU`AiqErc@yfAfZr@tl@vqAxHnsBwmAfdE{KnG?lyCjFiXxDiCdGnKtC}MjKv@jqAefCtUjRdc@d_AnaAjf@x^rm@eIdtCgq@lyBco@haAk{@|}Dsi@vzAyTlRiM|g@kYx\\\\eE~b@dDxd@jZnS|Sg]zJvC|Yyn@zIbCl^_}BveAotBb]kRhl@seAhEviAiG`_@wXbOyH`ZbAz_C_Mzc@}MaBeVzw@}Ktx@}EvwBpG|_C}FlgB|Ff[tRo`BhAyiCdGm\\\\hJb[tHv@|`@w{@|JnFfMlg@?bkIgRxzDuHjeGc`@l~CvSbfBeIjx@mT|p@wHoDoBtGyBfmAuOg}AsIpZzMnhB}AvfAoM`dAyKcG_DrMgXxxC_e@pjAuM{L_Cqy@}EbClGshEcLwi@yBhn@eC_NcMusLpKwuBkIoHqJnEi\\\\dtRkOd`BbTjfB~Dp}Dub@xuBsOulAeEjOdEpfB{v@`jDiHgUgAacC~[g{Fe@c|@gOoOgk@x|HzAxkEy]pt@yJ~~@}s@foCib@aaFmGshE}O{SqUcaAwUcVaHa_AbOiqHkKyv@lF__HoEu~FuR_{HwNupOaPadBiGj^tDfgKp[hjSsWbqIwLzi@{YeqAbDokGqGf]gBiXtKcq@[e{A{Sc_DwJipHcEsUeGpG}GaPbPndKbLbu@{Lt~KcKbt@qSkaBiHmwBqNvb@hCffEtVpuCaAzn@mk@xdBpAz\\\\lIxFtm@wwAf]tnF{m@i_BwSrVMn]nSdx@|c@rr@}BpbBpMlQxKoa@tf@d{DyHtnDoMc^cPtAcFiLye@m|DnCx{CyTrqFnChS`HuLrKvCgC__@zKuc@rp@tdJbN~XoGlaCuCjPcP{\\\\eLnpA~BmbAcKc^oF`nAcJmBkFjMoGaPjB~`A`MrAaQfcFiGjN{B_PiC~O{GscAiTrcAuO}m@wTjTqi@cwRin@}pFwEwFgV|SiNui@iIhCaE`k@`E~IhCkKrH|\\\\bEhaBnR`YrQt_BtTlgGcBv{K_t@bnD{Bb`A_FhHkT?aJbSeR~`CaPk_A`Ih~AmDx[sCiCyKjTqKga@cDy_BdDeQnK`GuOi}CwL`k@iH`zEwh@xiA}SsrD\"],[\"_`gq@lahd@nl@vSfe@`xBbc@h`MtO{aBvv@hoFLjgChEvUbEyL|HlNttBjmZyAv}BiP|}Bje@m_ChNfh@nKfsBwB~}FyOlwApDrlAqGllEdLkTn\\\\bxL|G_sE}GcdF|h@hbI}OnwApOj}ApTnv\\\\zQppIabBlkTjLsb@prAuyOvMwSsChwHlTakDqa@{rXbXc|JjHu^dIr[`nAddZrp@pgJbSbxFiH~{FhHd]lK{aBvQpdDxMvuEmBtuCrGh]bJ}wAcJanGfm@jnFoSoqFy[y|AevBapk@gv@sgWkQ{jCub@ofV`j@{cAtw@b_Dfc@dhGpf@hpDvX~nJtaAzgHlWhh@rYxwAb}@v~ImB`lEzFt[tTin@dPjl@xIzlClIvQ|CeK`CnEhf@`iGljAtsHpLp{BbGgr@dLbUhe@hvC|^z|@xe@lg@p`@zoEw@dqKme@gw@sb@pfAwy@zzGdAtUfJkBdn@q_EdVcb@xMws@bPlv@nOrFnHoJ~c@cpGdMgb@nRrkAxVbo@eHznJfLl{Elg@fyFbAbqCam@pcDy@dgBaUbhBfIju@tz@ydI~RnaC{AzpDfCui@nG~StCoHoBa~N}`@obIwKa_FxZoyAjUgItJlYfAlaAl]rj@x]bbLjOnl@zIvExEiR{Pk{CzMyeD`Vvo@nSfQpCjj@pGh@vFp_@h_@rsEjUd}GrW~tD}MbcBtCxrCcRjuDoFxQkRbFy]r~@{GqaBuCvNkHzzGdIpjGkHr_@cGglAeHtOeR~}GfLj|@bNhcI|Fwn@vGb@lPq}@oG{eEd]y~McHs|BnC{RpGvTnVmOpc@ssBfSeFfQtNbRqe@fPj]pQjxA`GncGci@`pCdL~Uja@e_@~PtXvJbtDiExaGsf@tzD`Kri@`p@_mBzTrl@pCnh@iMvmHqVrdB~G|OfCuCYbe@sBtRySvRbObxAkh@dqCyPbrOuOnv@|In[fMtD`O_pEcFm{@nJyoBjBqnFrM}_@rIth@xTqa@xVaiDlKljDcGf`Mab@rdCgf@jV|J`iA_HtcA|Ej]xkAwtC_NjeKaI|z@jGrmBnWelCaAe|Bn`@c_EjK`jBwRi}LpJihDiIq~D~W{_DxBioP}Fg}CjIcmEjS~lAZtuBtG~X`Kg[mB_jAxGy\\\\zBpa@bNk_Alc@glAfOd_@aFtzDbI~vD{YjdG{Dn|C}JzQEnaA}GiTcGtO}G}SiNlX{T~xBxDn{g@tMzm@nF}ZcKy~ApQqvX|Nc{JnGrChCqa@lO|r@kGboIdKzyGp\\\\fiFcFndBiTvz@sHd}DrHprNlGwcRbKg|AxPef@f]d}CjeBduEnLmPf@uZuEa[ym@oiA}dAmvE{h@wfRda@_rQjHyc@pX`aAlLnrGxS~jBtLfJ}Qy}BOm_GmIq~@zFsqGzQsrF`JqUzGtCuFmeAtHaJrGlLvIloAnBajAhHoMxK`j@tTucAnGxkAtLjL~JoTnGpX~Icg@Syx@vVes@T`iBl`@zmCaC`_BfOldC}R~oT~JtcBpHfQaCs~FzFgqAlXwk@tw@y}QlWuo@tRfw@o@h{Cld@`p@v\\\\bnBq\\\\taJpHpfBsMjxC|RdpDhI|OvTolC~JouFjKw_AuAqeIdNcu@`J`CrWn}@tCgCbw@v|CgOna@yU~vCxQtoCh[|m@fFn`CdQ`sAeEbqBcTpw@vBdeMmd@`qE~Lla@|Gov@jQlyBvN~^nXycA|B}dBnBkJbIt@`OkcBlLoR?dGtTyKbPtcAbRig@~Mvx@dAmgBpI}_@`O}FdB{w@`JcXz]taDnF|CvTmYzXvn@hXpaD`P}\\\\tIjLtLpzCx[|tBhAl}Ame@{gAaDf\\\\dCrl@je@`bB~NtpA}Qf_IlE`{BdRlnAgQriJiOxmC{\\\\tpCyKpKeSzcDa`@x~@gPpqB{MgHoGhL}Gbr@oGke@aIpRqOdrBcFbMsLaGyHbLgFfr@lRhDvU{TzK{aA~l@{pArJZd[di@vN}Axc@w_Db@qiDrEgOjRkEpYvfLeOj|DzG|[d]knDjTwTnGbP|Ty_@lAs`A_UuqAqt@kbIdr@mhRtVrlA`ZzW`YvpA~CkVmfAiwE_a@{_E~AkqDpRwnCrW{zAtEgMlK~SjSid@lmArsElOz[`PyIkDifHbEgUfr@p{@fHowCgHaNsXlPuGeaBqTK{LeSwJczAcG`PmJqRuJovDrl@_}BvRfG`Paj@rLje@l\\\\unBxLza@vMbJf]sMlIrXlMjBzMgYbRdhADfyA`l@jaBhTg]~P|dCrFpNxKgG}AhzBkQtb@wKaUwTlPeG{\\\\wDx`C~wAlyBhR|cCdHbGxBiKhL~i@vKzL~JsHlK|XcHt_Dtv@ftIeI`xA`\\\\v~BIjfBjTrc@sDzhCvOf_@tIdrAeDlEsOuHkTicAwKvtAwIq_@{M_AaIcq@kd@qh@wUk{@cHsK}SmAih@xkCqGthC_TqX{QpQhq@byBhEzsAwLfwFmLfjAuEnOub@yz@cS~m@sWaoCqJe\\\\yGWnc@tuF_Bn|@mb@dhBkx@lyAoVxvA~BhZfJym@|Uoe@fCpGlPe{@jShC|Q{]tYipAd_@izDdOrnAvL~Htg@yx@fIfBzVzxApEhcIwMl_@eMr{AkQl~@iTqXaIlM}I}Doa@pX{v@zjDqXpGkTg]cDjNz_@tzAfP}O|Oc{@dPmPv]amArc@eHbMk_@hVje@lPke@lNzy@_Q|mGoXrg@oEpz@yKhNc\\\\r~AoLk@md@``@cQxvBdGzUjSuqArq@p`@x^el@bUfVjF|cGyWldBmNjA_Sb[s^jxA|Ez\\\\da@xKxKiTtXqAtUgn@zr@yqIbNkFfRp|N{Rp[~CtqBc\\\\ttDcT`eKwLxg@ge@`BsUwoBaKwQmLrNyTpjBic@pkB|Ap`@hcAolEp^jsEdFfI|AoNjFThXd]td@|V_Yz~FwGfN}HaT_HE_HnQsI`oDkRsXqGhAgVjaAw@qJ{VaMwRv~@pE`LdLeG|^hNlTe}@nTx^jKL|NmfA`K?bL`j@bPyz@wHl_Bd@pMvKeGlG|c@oA~n@cMbk@gYjX?}GsG|StClYpGqGlSr_@oQpnHqEbR}Sin@oPrr@eNir@eQ}AyPxh@g[vJ{Pvp@sFnAaP_YyLtEqNh\\\\kSpFyUqj@oX~CuBrIjHb]j{@z~A|m@glAvT~m@~KuoAbTwTfKlIhLcNxi@~qAhPiMxBhKyEjo@kd@yV}E_[mVji@oVsXuWfk@|AzN`SjGrD`]bKwJjU``@nHiTbOiClPb{@vK|MgC~bAsSbPrOju@wGp~@eLpNsX~kBgQmQoHhBqRz^jSlr@_SxtAqGme@mP?yFqKyQ_~AwP{PqVrUuC_j@_Rps@yIk@wn@`j@gNag@cm@_hHcJaMsDlHeSkhDed@grBgBggAyMmO?h}AgF}]{Ls|B`CscBnGtTnCwVuPidD?atBvWkx@qJczCoGdlAe\\\\c}@yFylD~MsmAdO_WbEa{@_DwS}S~~@sCksDqHrl@hGv}A{Sb}D~CtOhCoG]fUsFxS{R}v@gMscCcHlq@lCvmB{C`\\\\aOrRqXi`CzBwbAqG__AuCbK_BpiDtTzkCcKxnEmIzW_PwK}E|^uQrRkKuNeEgv@wJkMsOpXcPdGmPr}AeC}XaCxHm@jp@ih@l~D{Q|@kWme@iMBiJvGUtb@yFrE]c_@{CmHiJxFmGin@}\\\\|gAzB{v@hCnG|O_`Acr@krOjHyvE`UcqBbJmcC|Y{rCbd@ixAzKm|@br@cy@biAmt@~Vko@v]fo@s`@wrD~FiPpGna@tDcDvYemArIazFxe@wtClKiwDo\\\\tcA{GqEqExEiEjXkMiZbIsfCqG{vDpa@msJqYfJoVfdEsQvUwGiSDmoB_YyqCsZ_l@}Dcc@edAsiDwTcTeHsk@_i@{}@}BnP{RolAsM_TmVgEeRm}@eLrXqGui@me@pr@kMycAr@kgAsGb@_Pw^l@ntBpJv{AhTbbAdWpPzX{`@`Kw@~[~{Bxa@lg@~VxkA|o@|o@tV|lAdg@|z@nl@~~CrXxtCmKsGlAhoBmMh{CvFviGkMvs@}QfMkb@asCqe@ebB_VmVaOxl@|GwT|c@hk@zo@`xF{K~b@wUdoDcFlNkYmWuOpr@wJovAuWeL}C{N|AubA_PscAkHzNzWjsD{D|iAoJrLgX}q@iGqj@kBsaGf^zj@z@{Huj@}dCbK_gEyD}XaRpqChJ~kLtVvrCaFhUqKX{CsL{CxeBaI`PiH{JkeAstJdL{tAfCugCyWahBnAg|BqF_^f@|zDhMdcDk[jxCoa@k_AyKpXdEwwBmTqgCtF}nCrRirBaK_pBkTfwFeBvkEvJvhAgH|{@mXeTyk@omA{OopCeJj[rPfvAwOtcAiNaSkl@}TyKcUeKnKsr@ajBYbf@ls@~mBvXxHhZb}@~EzCxFaPxMhKlNaGtTzv@tOwKjEfb@ho@n~Alz@p}C_AhVsCsXba@lmMiLpe@mXqMeGwReLdLqG_uA{g@wnBic@ce@gC`PuWaj@sPpGsCmKcDtQbDvR`P?zClI~Wj{An^xOf\\\\v~AbE~^mKrgCnThf@vd@j|BgCx_M{In]qYg~@iKgvE{P`tB~J`wAwIx_A}Z_P{\\\\vKk]}I{J\\\\}IdQsXg\\\\y{@gjEH{yDwK?sGh[rGt}AqG~h@yg@mrAgh@e]mz@smElC_`AiUgqHzBs`Dgt@o~HcBkeC|^cjE|RiwQb\\\\qyBtKamCv\\\\icBxRuhF`Po{@tC|Gx^{mArVurCuN{_Aqm@hkDoVxq@{Em|@}DcOgCrCfCoaDgN~Y}C`o@|NhnGmj@ziEoA|oAmQjSaHy^oA_lCpO}jJwJao@eKq~DT}xClDqS`Exi@xAo`@yBiZqL{AmKi]mPl_AgR{\\\\eQoGak@khCwKri@p^~oE~~@tuCrRp`Gya@vmGlChuLuPruDdCveEqd@znCuMrvCuGdRmTqIge@sbEzIgsDjO_fAnLfJbDsa@zMkRxLmmIkOss@qEjZrJtrAiMt}AiC`L{ByKmGrTkRbqCwEvScG}GuC|\\\\}Sc{@oPdRs@rVdX`xAaEvsBgOsCgQlP?}G}]ty@gm@fMcDvQ{SpAum@ltFqElIkIcF}Mhs@}QdHyLfh@uOoP{e@zzDkQj~DzEzqCif@l}JkIjLoZ{Gg@s_AuO|m@mYdL{Z{NcOc`@uEys@bSqmGvBevEbUg}BeOgp@}CziBuRj{@kYx}PqMny@gKt]_u@vj@g`AmqAki@gJiQs|Jtj@_hJkEsmLjPgkOp`AqtGzk@aoCnr@ioGxkAu{HrOkh@jz@mvAlKdLfTw_B@icBjP~HtIuD~Mme@xGhi@_Izh@hHzUvIysAu\\\\_{BW_n@`m@amNoLna@iGcMm\\\\wmIt@afBqc@wgBxI`tKdHxZdLvoGuh@vrGr@v|BaAfE{SaPqGxkA{Vsf@ga@bWmPfa@mJt@aSg`Dcp@b}MilCpbN_U`d@gZl~Boq@swV{i@u}^{Hid@mKbj@aK_aEoEmqIgH_q@z@krFcHoeApDmfAgH_fAVqsKmPoTyJn~M_@~_Vqq@}dJeGobEgTanCiDgcCuOoaAk@}wBmMe|Ai^gu_@eM{RwM`aAvH~nGaJdcF_@`{JbJ~hAbRjhMeLjv@c\\\\om@cSfIyPd]wH_Je_AsqGeY}m@oBfr@sJgS_MypDex@oeE_VegC{Jk`DuUkiBPulAqg@qkEgHpcAx|AbcYvp@~tDbT~{B``@tm@pGgi@fFf\\\\zBp`Cay@p}IpNcYts@cwFpKnXnCv}Axe@`v@zGmnBpPrOrQvs@bCjqA|VtRtb@jjBtQrbErT|oAtMvzDzn@zbI`UltEtBxpEbh@taOjInnIgDp`CgGh[uOr@ed@yq@cF}yBgOsa@mYmkLqTo`Cqa@{pByKlbCfh@boInQ`k@~Y~bMkCbkCqDzLiCyKwKpXgIkYeFgiBme@opCuHx|AzUvcA`RxfCbNrb@iC~~BxKav@v@~TzF`KzIq@nX}n@rUaR~k@jr@xHtZhTjwIqLzvD`@lrCdOlxBiWloMwR_j@m^opClM~iC~f@pdDuh@xsLa\\\\_e@wg@ktAynCwuMgT_mBm]ucKsC|GmDoVrIgy@wQk~K_Hko@of@icZsZmrFdzA`waAtXrmEd`A|yEvGf~@tQ~e@tCsCv_AruGhY~Thd@pmBqG`lGkQvtBuBz_Ccv@pfImQj~Dgq@j{GmUhn@_W{uBo[uwO?mkJzIecC_Ns}PgMuuCwXmmB_HaeDzCkaBcDmPcPnv@oo@kpF}y@qrNaIhXtDlm@iKrw@|Ed~Dxc@zhK~^bjE~Ujy@zOxiCzGbeG~XjzGmEleHth@ble@sb@l}Ey_AtiBsn@weF|@}uBzVuqCaXmeK{I~d@|GltFgp@bbJyS{wEqY{_B{AkbCmc@y|CdDckByKngA{Jc[gUsoQpGxTfFur@aG_aAfCabDfRovCsDaUgMng@w_@xiMdGfv@}BjeAsCoGgKhbA~UzgDfGncFb`@zmItdAliOfBxwA_Hhd@qGaj@g]njFsImO_aAyoMl@efA_Qge@iByiDcR}a@zBp{HmKui@ic@xjDjGbc@|Zwg@uPjhGhSlg@|Dp{A|Mhp@`[i[xMxuAsEc@_ExZxQxjEyKme@wT|S{ExW_MnxFqGti@qGgr@{Gzv@iCsrBcGtX}G_n@yBpGwKeTwCjGaDmeA_UorAfC_nGyAgKkTxkAcWsvAz@_oDkVsiAmMgfFzGk_EwI~\\\\yKibDbEkXpGve@jUo|@jOeqBpGxKlH_{AoFnI}D_Fma@}wBiH~PoEnnC{DnHyD}C}JucBrT_kBoGkw@yFpGsCqGsX`j@kJs^g_@ekE_MqtGqOyfBtQelD{Gm_CzGmeCle@o{BdX~xA|EoPtk@a~IeWxt@eNjkDgK~XcGsrDcZjaBeK{UpButNhQqqBvbBeiJcDsPelA|aF_Kq^C{bGrEmi@do@{nBtTykCk_AbjDaBg~BjNsY|PvBnLga@~N_jBmRlr@oZfPmMnWkzAzqe@ik@`}AsSggEpEi|B{GikLoQscAo@adBiGo^{S`yAoPkn@En{@qGdKoTmTyHt\\\\cJvfFeTiaBuHmqS`Rat@tNphEfP{hG`\\\\gkBeK_|AzMifIv[azCjRob@dMKbLwSlb@{{Dr[mr@nOdPrPn}AfF{aSfNu_BkDak@cPti@sPlgBuAz`Ics@hbCu]jpBs]`CgGma@~^}wF?wiBrb@mhD|kByq\\\\pGwBxBkXmF}nAfX{dGvxAsrHxBqyDuhAlxGuJzQgCuCaPjhCaPov@{EdQco@v{SeRhXyNq`Ay@bgGugChvZsMlKoTs^yj@wqBhCq{RyLvnGmd@|lFuT`_AyOcOeG{YmHg`DzYowKd{@{iIgDo~DsHnKefAjuHwZhf@o]zeEsTjaAoMiZcCyh@lZasUfIkUnGbGvT{|AvKrXvKsRbVs}D}D{\\\\sX~rBwMos@n]}bNsFev@hBmqHqFeZeo@bdZ{GlUsJuCoBzm@uJym@wDoyCqSleLoUno@aKq`CgKgg@sU_~NfLui@D}q@dKzOtF{y@|IqZnNsBfBkg@cj@cfC@wsBzLu{@xMdArRd\\\\rKgXmO_yAtHuDzN{uBgC_j@oPttAqFaY|IgnBqHzCmIjr@uSsbD|c@}|Lvn@kbC|GjTbJukBxr@}oC~n@m}K`i@z_Djf@heAdIk[owA}vImCa`CoJee@aj@`xLeGqGoCriAgJzd@wQnWcJqq@nA}kBuKmq@?twFiSvfBqb@boAscAr`MwMs_@yMs|BpGrX|F{ZgNsmPuJe~@nGcwLnI_wAwJ_qC~WqvObXyqBbKMdQueAx\\\\im@xeBidW{GxKqGucA_f@z}Jib@t`DwEeHmZdz@gN`|BaSwp@}F{tAo]utBkVcaF?xkAiH}UuMygDuBadAhNew@iRmmClB{rK~EwZ~]tqB~LjQnJiRzWiyBpGpGxe@iqM{JxQ{s@`sGmKdwBy^apC{IwnBqLhTe@udAhKo`AmEkk@uCba@eFmm@kBeaU`FsQdGjn@zGui@tOfcAzGuhEjTv_C`GmXwMszFeSmlB`Kkf@`RdE`GiS?mjDqOazApEeaFzDyP|Xz\\\\jH_d@_LuqAwIwzFtVkcG}Qqr@xDgcBjK~XtDgs@KyYgSai@rIqoAhCw}EnGzv@pGy\\\\dGhLbf@ptGbKrWpnAv{]d[~vCxDs`@oPy}Uw_@isEkGkvCtFiwBaE_SuC~XgQy\\\\qs@eeNMm_FfFgUlPbj@lJuSpH}qE_Tn{BcZ}bBcBuy@gHpZoBxcB}BwQ}KyxAoEioGzDsX`Ldd@lCniCnGqlC`PrXjk@w_E}DiXqGlKiDgQZmg@c\\\\awBtEwmLlMmh@bIjFzIkFbQfcAbK|aFjA_}BjIueAzKxK~AgK_Bab@fTi}CjM`l@c@fp@nGkElJ}}FdMoTnCfl@lOng@pLmA~S|c@nGcPtCbfClKzf@yHmrEqd@efB?gVtOyTV_j@eLbUkGuNaC{_JlD`nAnW|^yKegAuCxKdL_gLdWqsCli@s~B\"],[\"a_m_@zkvo@dX}[rK|`@wDfq@iRbCc[kKmEqL~EwW\"],[\"{_q_@voxo@|PdC`OsWtLvB~DzM{Clk@mb@ji@kU_ZkB{|@\"],[\"q~{_@txvo@_Bb[oMnM\"],[\"iuha@dayo@dHtt@eGvXsWkCkZo[}KtCmLgd@_EkoAaKaDwLhJmD}GfKykAsJyj@y]_d@wd@kOqU}Yr}@d\\\\v|@zt@\"],[\"_ysa@`qqo@{BmKeJnGiMwG|SaMReXtZaPjn@vAmAjPh\\\\z]uPnJcp@iU\"],[\"yeva@frwo@qXoZfCyRsMaS~TgWxz@iSvJnCrBld@oXs@kd@dN\"],[\"ys`b@rmuo@gc@fc@VqWqY{RgE~ApGhSiHhDs\\\\sTgUu`@l]mUjZaBfPnOsJ|VzDvJ\"],[\"{|hc@fubp@lOgAjy@zm@gV`BaUmP}BhT\"],[\"asmc@r{}o@dLkn@bPhCtKng@jU`UlCvt@}Y{[wGnA?ca@yKpG\"],[\"_hnc@tr{o@iV}T}EjLdVl_@dQxCc@~Nep@{^m^st@}EsVvKyKkG_V`Lkh@z\\\\da@rXpr@\"],[\"ehpYzk`g@gNxDy@wb@rr@t^xBjJkMe@eJfQ\"],[\"{tyY|ync@c^}t@jm@b~@~Mf}@n@jdAeQj|@_JfGbMwqAsA{mA\"],[\"_}aZvqlg@cBsPlwAxcAsCzG\"],[\"gqhZl{xg@_e@iUeFqTtIa]hMyCzAfO`sAmk@|BrJyWbT{ChXvEza@pZbV@tQ\"],[\"adtZ~edl@qGsm@tg@nr@|IftAqJ~NiJo@kKkT\"],[\"}suZrcbl@hHiRpFnw@}UnUgA_i@\"],[\"omwZj~bl@wJbF{Hmb@`M~F\"],[\"c~xZhvdd@qFgHpFoKzTxl@|GvD|J{GjV|a@iEvKwMsLgEff@cMbXqLbC_Sw~@\"],[\"ksxZxoal@cFtDqMcJcq@ibAkOog@cDqdAz]xk@hu@br@\"],[\"uw{Z`pzk@tA}Qv`A|Tz_@xUvd@WtWx]aKx_CfVhpAcHl]i]s|@gPwNaC{r@qPol@{r@yl@\"],[\"wru[`tfj@jK_Tvn@jg@hm@`aBdAvr@_JtEo|AqtC\"],[\"gk}Zpmtc@dOn{@fd@xb@nM|u@vXhl@fC~h@kGzFbn@zyBlCbg@aPfIuEnx@uXtOrRrUqG|PlKfT{Bt`@zB{G~F`CdWxvAq@hJeKkKyGr^sHt@jOlIpHr_@lJkCJ~GqJl^c[nPck@lp@}oAzS}MtLm`@gDqZ`Tyc@RyQlPk_@un@yk@cIu}@onBovAe_Aqq@ix@g\\\\yEea@a[yS|O_N_HqIsOr]pCwlB{lAoRwQiZg}@ce@ui@{|@_d@mRi]hUkbAaPaw@~D}FfLjHp}@z{@z@gZ`DkEdI|FuCogAfLmPz^c@jcAlU|Mba@|G}XnGlPlOcUbHXxn@hj@p~@hXjcCbyAxGmCug@}[ac@go@cEeb@r[~CvfBh_CvQzi@mIjNvYzw@lDnb@mHPiByLoBxLt{@liAlSjmAjKzCkFygAeZwXyNgh@iNwJqAgh@cP_k@~DeFpg@je@da@`JzPrk@gC~m@dIdPbAfr@fCcPjTda@_MkgAkF`CjT}m@{Bx^hT_RbA`p@tZbw@lThRiCsL|MNhKvTvI_h@}g@whBbU{`A|IcDp_@z^pDkFsOyK?eT_NmJhDwScK`EiEqFeRxJeQkFy[ks@i`AwqAoQkn@qZq[|aAfzD{AnMiTtOIpVcNeB_{AouDuz@ufAqLem@cKmAlKv_ApwA|_CtAp[qJk@wNu]ohAwmAcp@wlAsHei@f]}MhVia@nt@nt@qKc\\\\fTeWkj@kFaXub@nP{|@bGv`@bHyzAbTdAlAuGkJg_@fCyk@|S``@|a@zL}MqTcAkXbh@t|@`Hl]pSgViDkv@fi@wObOxdBbLvKnGaGnBv}A~LbOvRqo@\"],[\"aoj[n_zd@|HsObw@rtBxFve@sUdb@fViEaItt@z\\\\mP?~i@bYyTQ~pA~Owl@hLs@tCbI[zn@iL`f@dFvDnZmv@aP|~@xKaPfCxTnGkn@`YrEfCeh@oP~XaAiRd[wSrZbKxBha@jNvZ|Gke@gCca@`UpQhJfd@VzqBjKpLca@rxAcGyKwGp[{KpHCq\\\\uJpLdB~jAqTseAoTe_@mKpFfPpj@_WpAmE{MmIzJ_F~PrCpGpGqGrAjLyUriAm^c_@?yKyKgC{S}m@nJjq@tf@tw@kTje@zZcL`Rb{@d@cr@_MsG`K{ShHjBvVxt@wVrnBeXbp@bGvZyB|h@aO|l@o\\\\jViYju@qIVxCgI_TdLxKgw@mPr]}GoP{Bt}AwKx[rM|_@_I|zA{OOQ}Ja~@_C_Oy[kPtIwZ}BvZ`l@mVpt@tCh~Aka@bTon@q\\\\kh@w{@}o@sXoeBkqC`PqGbj@~Gbo@kMrl@nf@~GpLiC`K|S|GnRgVfA}t@lPnGnm@w~@tNvAaFhQdLiD~AcUqQgIxKgL|EfGxKoGnc@z\\\\xLbe@vL_CsXscArJ_GvJnKhLoEfIafAcGuFm`@nVgH}RsCjP}NaKaEeT`V{pBnWt@jNoXyQiPoDlTtCypAz\\\\uOdLoa@`MuFyMcZjE_fAiIsoC|LeO}LsAgFma@|JynBaP|gAeL}gAxAsgEgGsp@\"],[\"qs_\\\\josd@rf@hl@bd@lgAdDgVoJ_Vc`AefAgMkp@|m@pgArm@fb@Thb@ba@xXtIzi@pa@jT|KkNj@}KgKeGzM{MhGmc@sMoX`OlDxLvyAcCfl@}K`C_l@gc@yg@qE_RmMuM{t@_vBglD}Gcb@|AilA|p@hiAoAn^uLw]wWySpCnt@\"],[\"cjf\\\\nzjf@}FnCeYsTj}@vRjVtQ|m@tcAkHjBuFnYmR{\\\\cFjBdDfUaPgLkCtRcZsRiF}}@xKlF\"],[\"i}g\\\\btkf@ue@{TlGqOlb@mFzLjHpVj_A\"],[\"onk]nfgf@eNbIiEzRzBlLnN}GuGl^gd@tt@{Xad@qL_xBeLoZj@qq@v`@qtBth@gcJl[s{CbZgdBzYej@Qe\\\\py@abE~]st@nc@}^jRerAd_@md@bOksBrW_o@mBuc@d~BsiCnP~XzNzALl^lP|`@eH`k@zHxcFek@h`DeEtlBws@d`G_XliAgYxg@}m@d`EiHoGiZpXc^|Fw_AztAql@rcB\"],[\"sms]|v~e@wLlYkS}NzUoZ|F[\"],[\"igx^|tmo@iLih@pPio@bZ~i@xe@`DfJ|NlHld@eDl~@hOp`A{h@bUkTyKt@uo@a[{l@\"],[\"oqh_@pihp@vmBaw@j\\\\`]oPvh@oQlvCex@daDwI`qBueAxlHg]jdAqjAciA}X}t@hBko@}OkfAsLcvChIk{ChEc_@lx@wyAnXqQhV_j@\"],[\"qjt_@zwto@cNrC~Qwe@\"],[\"}oo`@tpko@aP{eD`WxqAvAlw@t[bcBnM~|Bb[fmBaPo{@gCf]yUoo@\"],[\"_i}`@`_en@sT_~@xGa@rp@|gBhz@z{AvVbkA\"],[\"saea@hafo@uCaj@fk@bt@riApb@hTdTx@zQkd@{_@g}Byn@cKcf@rX?\"],[\"}_ha@nfao@_BlKvW|a@nGj_Ak_@uIa|@mw@oDfJgSgSkDnFsOqG?xKaGgDyn@ci@eDgu@d~AsLba@|BjWfOxHoB?h]vKpX\"],[\"krea@dspo@yc@sk@mJiA}Gd`@jNrd@pcCvxCla@bVjJdY{f@`X_Fzn@ka@yVaGcWwwBkcCsqAkcCmsAec@{^{ByZrIaUkNbf@yVtr@fK|h@x[r[{@na@nO|s@~|@xObAZkKg_@mb@sV}jAwmAe]gu@u_@alC{M_LsO_A}`@r^qj@`K_@`EfLmVpUcCrSbJjNnj@KdJogAf`@mGv`@md@fC}jAxLkGjYzBxKmK?bPhMzH}@_k@b]hn@fuAl`Ajv@jUfzA|{@j[zC_GlH}KeDixB{|@wlBsjAcq@mKiEhPmWaJpRfj@~uA``@{Cc^vKdLfC}GzpErlC}xAov@i}Aaj@mZ~@oRbNzw@~x@pgBnaAp|@rfAxEvs@qT`q@~w@v`AhLj\\\\\"],[\"wtua@dtwo@X}\\\\dTkXpr@dk@cF`_@mNtF}`@}C\"],[\"_irb@~{pn@~My_A`g@o|AqBvj@sn@~bC\"],[\"uaxb@~}~n@zv@qrBer@plCyKmP{JfSuUhDxe@ov@\"],[\"_kx\\\\n}fjAbMcU`N[dE}e@jK[w@fk@{N|]c]vH\"],[\"unw\\\\racjAmCd{@ic@zVnRir@|F_|@\"],[\"u`~Zrqde@[yV`Qp]cBp\\\\\"],[\"uia[pdmc@aEpl@oQst@\"],[\"slz[jem`@rBaIbYlTaU`J\"],[\"ws|[foa`@sN_JbJ{S~RnDhDbb@kLlC\"],[\"wh}[bl}i@Ku[da@hj@uApYoPmE\"],[\"gwa\\\\xhi_@j@qVhJo@nVpd@m@r`@\"],[\"wmp\\\\d_`i@{BkObJbC~C~[\"],[\"kt`]nfykAnEkg@`J{Ezg@rHhEdXsFzV}OxC_AbPcVA\"],[\"cqs]|{aa@kEoF|EoJlGfLiCpX\"],[\"}at]zb`a@`@uSxUiTqOxaA\"],[\"}g_^lnomAmHcUjNmRvF`Z\"],[\"uy_^fs|b@hZiHbEfHeHhN\"],[\"{{_^xupmAfF}WxBdDhEuH^z]oCbF\"],[\"k~_^ld}a@IqXhJgMlOdh@yD_GcFjV\"],[\"mda^|t~lAeF_h@pFyNlf@cSqXnnB\"],[\"epf^bztb@vD_Ux[ti@tK|l@|KtFkFxWsc@km@\"],[\"u_h^fyqb@hII~Sza@J`]{Kx@mIaO\"],[\"_gv^zpkmAeM_Ld~@jHtAdGaKpDrEfN_KtGiPqB\"],[\"_zx^dofo@vB_OzGpQqAla@\"],[\"cv{^p{mo@bIgNhF~iAsKgI\"],[\"ei__@zw`o@q@wO|Nz\\\\|A_P~ErLcCj^eL`@|B~N\"],[\"_na_@jkqo@bEaK~Fhm@}Z{OJcL\"],[\"}}c_@n~~mAfAmSpSl@r@n\\\\\"],[\"yue_@tzaa@n@gXjWo@Lj_@qShT\"],[\"ang_@tm}mAnMuYxWpIiHhV\"],[\"chj_@h_aa@eEqLhCcF`HdFhC{JfZnTyc@`]\"],[\"quk_@`kaa@eE_b@rQuKvEdJ`HiEvC|dAcRhAyMuO\"],[\"sws_@vica@}BqVzGcEjDqeA|QeNgEhd@~LvE~QmZ_Dp\\\\lIiR{\\\\nzB}P~ByGuG\"],[\"czs_@`sunAvQeBcSt]\"],[\"euw_@lg{a@mKekAvj@zr@kIlc@\"],[\"ovy_@pwva@qD_u@hIoJtV~oAqCbVeQjNuL{D\"],[\"cvy_@t}unAkO`DmG{\\\\nEgd@zYcw@nOdJeDbd@uPrHtDjX\"],[\"whc`@~t~a@lAeUzG|P|K{LtDrHaKf`@\"],[\"aed`@vaop@wXcAdO{H\"],[\"scg`@x`}a@yCwHrYqRaKxz@{GcC\"],[\"}dg`@~_znAsCgP|a@me@rIThDla@kDdWcJfBlAzX\"],[\"e|h`@xe~a@aB{PrMdDpJ}PjThGkAzy@{\\\\iH\"],[\"o}i`@vo_oAl@gYhKgCzMpX\"],[\"o{ga@psym@bUaExIdd@mi@gH\"],[\"a|ja@boon@|PlpAwOmReEgbA\"],[\"ikma@jlhn@bFkDrZbNjCnS}I^\"],[\"arqa@|gbo@kHzWpBkdA\"],[\"}`ra@rpwm@bz@wAvVhU_gByKjKiJ\"],[\"stya@nbxm@vBlMyIbOqy@jS_HgD[sOv_@qYhRlK\"],[\"cd|a@vtwn@pAeFbMbi@s@~b@wSeb@\"],[\"wf~a@h_yn@dOhp@qFzg@kVk}@zEca@\"],[\"az~a@lyzm@aw@tZta@gs@~t@wFWfN\"],[\"{ysb@|nfh@|f@aSj^zAnJ~^yFt`@sUfO{m@yNoKkZ\"],[\"e`{b@~u~n@aCfp@aImb@\"],[\"ia|b@d`do@fNkHqQ~a@{OyA\"],[\"et_c@dqbi@tOaLdS`zAu`@}c@\"],[\"ynac@fhdp@rHsGgAtZ\"],[\"e|lc@`scp@bF{CdTnXsF|U\"],[\"yfmc@`aap@z@sIxd@zl@gQcB\"],[\"etfd@dtti@fGwEvGhW~a@fPgBlOqe@~C{Voa@bAyS\"],[\"k|ld@rttj@iAwl@~TYAl}@uLO\"],[\"e{wd@b`_k@jUwhAfDlt@aW|k@wHcJ\"],[\"o|xd@~msw@`GqOtD`h@}FjV\"],[\"muzd@phek@lMos@xHdNwUvcA\"],[\"}b{d@`lpw@tKoBtGlIsHlUmSrF\"],[\"qlke@`spg@lN_n@bL_LXpi@qh@~aBeIkC\"],[\"wvne@|bzg@djAe_BbK|LojBznDzAwp@\"],[\"ixre@x}qh@b^gu@|QmDec@huAiOrP\"],[\"ajse@rqoh@tdAgoAcQ|f@w~@drA\"],[\"gfte@|flh@da@oTI|\\\\{hAb|@pIe_@\"],[\"}mxe@bzaf@zn@z\\\\o`@`c@ig@jKkGsR\"],[\"s|}e@vfef@`V{YpZnr@pVxAuHt`@uQlPen@qD\"],[\"ipdf@rytm@oNts@sPrAeDan@xU_O\"],[\"avef@fjxm@_M`OsCco@zI{\\\\tShY\"],[\"q`gf@jzsf@fKjLnO}~@dKb`@bH_d@vUreAcfArs@aLiQ\"],[\"cvzf@|hzg@fT|S_Vp]e[qx@lKoL\"],[\"_n|f@feju@eD{MvOq`@cG~t@pDlRqI`J\"],[\"k`~f@fd~t@rNed@|Bxu@{IxQ\"],[\"e`cg@h`dh@`CaZrP~v@tJh~B_PvL\"],[\"wfbg@n{cg@rJhFdBlWyO|Mon@xAdJkz@\"],[\"sagg@|ezg@}S|nA~Nd]{VxYsFoz@|E}v@pLmY\"],[\"}tig@pq{r@bOcNa@paA_MsM\"],[\"azhg@~u_r@qD|OuTm_@pViF\"],[\"{uig@b``r@lGbVm_@F|Fum@\"],[\"obqg@fyms@xDcd@~E`A|@hf@eNlK\"],[\"abnh@de~cAoIkyCdZfnBWzeCyHcI\"],[\"kmrh@`zzcA|GmiAbO|k@gHrkA{PsL\"],[\"adfi@lkcrAv^uyAzVyd@zExeAoO|~@iHbApA_]sIbA{Vzh@\"],[\"inji@rj|o@pIgn@fJnI|@dZuIt^yP`OgKeC\"],[\"_wji@`ryp@vC}tAfd@sK}BrsBqHzPwHqDy@hw@vLeHbLjf@{Jv_@gRnKiMuBoIec@\"],[\"_rli@foyqA`YwfAtJnAeOtfAmCzpA}GB\"],[\"cbri@jmjq@fP{GhKxh@}^zwDwGcFaJgyB\"]]},\n" +
+	"{\"countryCode\":\"CC\",\"polygons\":[[\"p|lFmzbz@~HdJcJbHi`@tDta@eH\"],[\"~gmF{rcz@{FpG_OiPnMoA\"]]},\n" +
+	"{\"countryCode\":\"CD\",\"polygons\":[[\"a|bAoxjJqpCzScqEm`@glAvOa{@n\\\\qMdPiW|@a[sTvHoo@cZ_}@sbBym@c_CsqBeyAyk@oVa^cAef@yd@yX}n@atAyYmUoBqbA_Ki_@pI_Vl@gfAjWu`Ahz@kh@fPueA`{@ygB`l@qg@nT}~@fz@kb@va@ce@zKcCn^bLnLkEdfA_oA{CgtBcRw_Adc@{~Bda@mVv]y{@cYko@na@au@pDm|@jNsg@}]a{@Nm_AlKaT^q]|Q{\\\\}@srA~X_nA~_@sp@nL_{@yBqiAib@}u@u[gUsXfCi`@iQyYe[w\\\\lNi_@cAgJu{@oZuCg_@kT}XvC_ScT{f@{PhGki@y`@cYaU|FiMuHuEkp@js@}WfWir@oOij@j_Ac{@bPe_@pIi|@{i@cTsIcc@_c@er@k`@ojBwSmb@x@gqAi^q@pG_Pih@wdA~Iof@o`@ob@h@{UbNcG{l@kVuD}k@wMyQyOjVcd@_Uha@mTwSwBgCiMrKy`@`]{WbGy\\\\rX}GlPqa@jPjIlFwn@wFkUfEkMkNyQzC_Qea@scAvWwhAwh@k`@rDg|@eMaq@kcAaYoLvTwLfCcu@iUeLuS_J`GsMaShBsWsPi\\\\bHaMqFuUcJ~C}@yEFoVpT_KG_MxKpGxKer@v]{_@dBoPkG{D}BjFn@kMfNo@{BmKd^eFoMqMNkMj\\\\aWuK_@kCy[}QiGpVka@mOk^fBiJ}SfCzBaNyK}InOaPnC_PeGsGpa@mPiCwKhReOlMkw@cGmP|Za_@|Z_LpF{WuS}z@lGqRaPwe@?y\\\\pa@cj@}GeN|HoTafAaz@q_@aeApk@smCrRqXtQq|@|b@bBd^sg@pXiGtLyV~Dev@zTiRfc@wGhEmc@v_@eMjc@`Dzq@oGzC_VvT}WlAqg@}KoHnK_t@tj@v@be@aWkMo_@hz@mn@cBomAlNaTzZyKvCaNuHmZ{o@sk@yAyd@wRgG{Oqi@y}@kb@kLsd@vMsPbY_CfE_fAyIsr@bd@sj@`Ns`@fg@yf@pC{\\\\e\\\\mLDee@_f@{TuZa_@_w@y_@yt@iHlPqcBte@o{@sAsQlPg_@`SzJ|_BiEvL}Ov@ki@`Uw\\\\vKcEtY~HjPyg@|s@m`@bR{p@nzAuh@rPy{Atd@iiA@wi@zjBqVfr@lKzD{]yPc~@yYm`@fGkJ|c@wG|Wsc@f_@fE`LkFsQqb@|MaGd_AMb{AbaA`vBvd@fTiGd]{f@fXmMrp@{D`i@lNrI|O~lBlh@~l@a@nm@nOtE}y@nc@iHlZo_@uG_Qgd@}QBeVjm@yl@dNrBlIiK`D{b@_Lsg@zq@yFpi@mw@n^pD~iEpxCtlD`vEpbDb~BvRd\\\\bBth@z[tX{Hr[fUlWxc@vMnd@qBvbAjh@rQvgA|`@|w@`^xK~`BpCziAcLjvA`~@`xBpYqCjUf}@jc@~p@_FxnG`t@~kAyMbTlTbMkF`~@fPdwA{Cjg@`b@fm@zB`n@oL|_AdGb`@gNvZdErRwHro@vDtYnp@|m@he@lEbz@rk@jWfk@`k@jrBf_AzdDcOrv@lLjh@bd@rG`r@xn@d]pVlp@x\\\\jBh^yIzPbLfc@qXvn@fCfSe[rJyf@vXyPlGtSna@JtG{`@r|@mXdNk]~`@oJfIea@zNmMbg@yCxa@rPrh@w@vS_N~NjPrY~Bx}CqJxwBrJvhAgDxwBylAzfAySxvDyVzs@~DzzCdq@ls@w@pgBw`@`xAso@`dBoXzmCu{@ps@yJho@zDbaBxaArf@nK|`Eoe@`sAc{@vjBul@hsAyfBjk@gvAng@{o@baDg~BncB}m@xqD_l@|mEkdCteDu{@rsDhkc@hbA}[tv@bBtt@jVbhBxnAndB|fBtuAvlC`VjKzU_InOox@`Zua@`i@iApW}Y|`@aFpAiZxKjTpm@{Vho@}Dvn@_i@nQvQjbAjXhkBK`hArc@nb@w`@p{@z@tg@wNtq@~E~ZkV|ZfCjTiTz`@`TdKtYxhA~i@fp@nCreB~j@bn@qEdl@nGrf@lV|c@cAt|@rr@du@aAvk@g[vlBk\\\\lPwU`g@{JOmS`Y{PgGuMp\\\\oRtUye@`Dug@zYoDhXoe@hh@wVdg@gt@~jBow@aBug@dKyEeO}e@[_r@bWe^xAed@jZqsA}MsN}UXoFxYk[dTwx@iPca@__AlPoa@__@k_AuEgg@xxW`@tRrHd@pp@wUlt@g\\\\xHkEsMsV]qIeSuPmEeLhFZvPqUda@lD~[jh@~o@j`@hwA~r@rlAy_@ve@dF`oAa[fZ{XdIa{AdRwN`^uT|D_EnJsXeBgdBxkAiX`XeEd\\\\x]fV}@vXk_@bAy{@zg@iYoVgOM}zA~v@cq@r}@oAbt@uTh_@vO`a@i_@fZaEroAig@nNqXfo@q@xNvZtm@uE`iAgfAphAgnB``@wp@h\\\\qeApsBmu@bNsr@n@sj@dPfOt`BzGlG`v@_F|^fXzjArGdWfKna@|l@vAvfAtZfYxAtK_Sn`@mNn_Bs]pm@nCdn@zLtXaDvi@eRjf@J|rBgcAnpAdCdmAwk@``A_@r[fOdHnMzg@uKlLqXmC}g@fz@wC|X_bAxZwe@eI}n@pViNReWyPcOrLwMcX}o@n@sErFl_@|mAtLtuCr]rgAfDjfAvH~VtZhWpGdYd[zPnIbm@h@vZk`@tn@{Etn@gJfDmM_a@kp@u_@qe@wA}_@xTqZqKwK`Fe^|n@{HheAnDtb@qlAfEkLdMgNfw@`D``@|f@zKbQbXfYvKpF`TuKzq@iAddAeZ~rBxGtr@n{@hmBbR`qBqB~r@iZvo@hZjmAgd@xuBfBzU~p@vRhNdQxSn}@hb@pc@~FrZyr@eJev@hFwt@x\\\\mvAbRqa@qc@kUuz@uh@iCcOdIyhApAcj@jTueA_YwsCbdAgSiCegBb^_a@`j@a@~Tmh@zb@ud@vGbGlPiWxGch@tf@qWnNiiBjT_YlPujBgd@kwAlFwxCsKq|Bk\\\\_f@eQ}BjKqjAiP}aAnZkK}Kqg@r\\\\_bA|Hqd@jY}a@tHgZp[avBkI_iCgs@yk@rCyZ{Gkp@nPaL}Dau@zb@z@huWqxAoA}lCku@p@nuDbs@vN?xaNn^_Ln~@ra@fdBvEdeB_V`YxZx`@nNUdv@bpAcOtxBp^r_AkN?pjJqj@`UAviChDrFnb@aANlu@pJt`@mKtkB~Ndl@dTdP``@iBoAtgA_Z|^_Jff@hV`p@mGrn@zQteAaMxf@ae@?mPdX}X|GzGnGwK?yMjT{RmJiDfOwRrB}AtQ{HyDCzJiRnBeDrS_RnO_XwAgLjIlChHmm@p@oWzi@{eAhj@wZqPsJvZ|HvG{DzG{f@rE}`@x{@}YjCm^bZ}xAjIhDaS_a@oFySdJ_GrOk`ApGuUhr@uYbE_o@ja@quAhV{\\\\iI{Q~NcOyBSpL}UiHuQnPcWK{IcPgdAzBoYoFe]hR}^~s@iNeLeHjRoz@xKeRmI}LxZgKva@`Ff~@uWfq@vOnn^pMlnA_K`z@pAduCiOp~@rDhiAiRno@bNx{D{DbbDjTvVmXjjB|Ft_Aa]ziBpHbm@dU|a@fh@r]~\\\\eB~Kr^i@rg@t`@laB}I~I_LyN{R?aAzYdP~Iq\\\\vKepAhnA{g@fWuOi]oEg{Cg_JqL_TbCgUrp@_WDiRgaA}MiVic@aKeJ}k@m]iJo^gj@q[kDyWqPkn@_cD_f@{ByLiIs@gNfcAqj@pj@mx@jW_t@pw@cTot@a~@qN~EyKmZvRyZaC}KcXqLmGaLxByXwUcCaEcR{YjGar@eMobAfCmOuz@bf@kb@nDko@ur@{n@uVgeBmg@qz@iU}aAhn@oBxn@co@rl@d[lb@bm@ti@kHbm@e[vrBjEc[oiAjc@s_AF{]_t@abBchBk}@k}@kx@o\\\\}m@qcBiaAgmAi~CcWuRghAcZch@{mBsOcrAc]mi@qnBkp@iiA{e@kn@{e@koCycAqMe@e_@hTm}EfIqyCk]esDdTkZnIujAiKc`@}U_vCuoDirAkw@irA_S{zEczBojBkuBgr@kqDm{@u_AyuFgrEwkEtSmsBmXs{Cq{@ctAex@kfAwAsgAlb@_rAaHsnArQ}{A`F}_B_w@m{Cab@}oA}c@i{JSy`AgWya@{_@qi@aU}eAeT{l@mq@auAup@mzEosAsf@}s@w^gJoyAaF\"],[\"lsqBy`{FYyv@bd@``BnO|RvA|[kJ~Hwi@}r@\"]]},\n" +
+	"{\"countryCode\":\"CF\",\"polygons\":[[\"ogtEeo}LbPi@b_C_xAdl@{_AflBspBjgJ_|E~jBgQ|V`BvfAjf@|v@uGdMmOvs@hNjf@oEtx@tr@bH~m@z}AbW|ZyHpHuOk@cPsUeVN}IpLsCfx@tGj}@pk@ll@kMtB_UiQ}a@`SamAqKir@hXcaEeEy_A|DiJha@hNt\\\\oWn\\\\xb@ly@~a@rh@vH~`@qRrTq`@dJa{@hKkQaO{|@dg@ar@gEy~@~UyrBhy@shAnw@sTx}@saAtCgfAhNiZp_Ac`@h~@}DdVxOpYpu@dp@wNns@smA~q@kZjj@wjAiAgUtc@gn@xi@cxBrVcHvI}n@jU_FbP{k@`LsDhCkb@nm@aEbM_EzAsPng@_J~Ngb@|s@kv@th@swA|Se@xkAxm@nRvT|ByJbl@Irm@{t@dSsn@b^gArNk`@lVpDha@fk@lXxAkScb@fBcJbj@_[rKsc@Ng_AlPqk@lN_Qbf@sG`Bcn@`X{L_Ekg@hm@ka@yB{U`M}^tTyFpOy[v[wGbIwLld@ToC{QjHoG`~AzYjfB_\\\\ha@_Vje@ys@pWeIbToW_pA`jE{@tYp_@`eA`fA`z@}HnT|GdNqa@bj@?x\\\\`Pve@mGpRtS|z@qFzW}Z~K}Z`_@bGlPmMjw@iRdOhCvKqa@lPdGrGoC~OoO`PxK|I{B`N|SgCgBhJlOj^qVja@|QhGjCx[tK^k\\\\`WOjMnMpMe^dFzBlKgNn@o@jM|BkFjGzDeBnPw]z_@yKdr@yKqGF~LqT~JGnV|@xEbJ_DpFtUcH`MrPh\\\\iBrWrM`S~IaGdLtSbu@hUvLgCnLwTjcA`YdM`q@sDf|@vh@j`@wWvhAda@rcA{C~PjNxQgEjMvFjUmFvn@kPkImPpa@sX|GcGx\\\\a]zWsKx`@fChMvSvBia@lTbd@~TxOkVvMxQtD|k@zl@jVcNbGi@zUn`@nb@_Jnf@hh@vdAqG~Oh^p@y@fqAvSlb@j`@njB~b@dr@rIbc@zi@bTqIh|@cPd_@k_Ab{@nOhj@gWhr@ks@|WtEjp@hMtH`U}Fx`@bYiGji@zf@zP~RbT|XwCf_@jTnZtCfJt{@h_@bAv\\\\mNxYd[h`@hQrXgCt[fUhb@|u@xBpiAoL~z@_`@rp@_Y~mA|@rrA}Qz\\\\_@p]mK`TOl_A|]`{@kNrg@qDl|@oa@`u@bYjo@w]x{@ea@lVec@z~BbRv_AzCftBefA~nAoLjEo^cL{KbCwa@be@gz@jb@oT|~@al@pg@a{@xgBgPteAiz@jh@kWt`Am@ffAqI~U~Jh_@nBpbAxYlU|n@`tAxd@xXbAdf@nV`^dyAxk@b_CrqBrbBxm@bZ~|@wHno@`[rThW}@pMeP`{@o\\\\flAwObqEl`@ppC{S}F`b@c_Apb@}WxX|RnYtAjVlLvFIbqAjk@xPpO~V}IxTub@|QeFnTfOheAqPl^|Ndo@u`@`LuMnPz@hVaJdM?nzAqn@|nA`[~r@`[xXsCzm@rZze@vHzsAnPr_@zAjXgKlOgDpm@bWvT_LflAlHvj@bg@xk@fsCbb@dk@v[lv@yNzcAh[pZw@d`@eU`N|AzuJnoDqErIeRgC}dA~g@qjAbRin@gLin@fLuWsKoE~^om@Ks^aUk_AzWoWfQg@f]_q@hPcVpUgEfoA{uK|~JgnA~t@uvAxc@zBit@eSoq@yEl@wExXsTvHiG`YgjBbP}iAtj@iqAzdCco@~e@qvArOu_@wEaz@`Hav@dPugBJed@nTsV~s@_QjKkcAql@qz@kNseA`PapAsXknApGoc@jS|Iz`@gHpg@y]vJcj@fd@iHoEp@oN}UaDw~@g`AD{Xqi@cxAwd@i`@an@eE}dE}|Aqh@y~@yfDo~@aZa_@qo@Pwq@iVidA{cBoPeJaAoQi\\\\hGeu@me@jGkk@_CygAx\\\\e_@bG}VkSkgB{Oo^}i@ai@oQseBc\\\\gn@wF_v@{LeIqv@eByFoHnAeZub@oX_Lsb@`n@K`\\\\{f@zi@Uj_@wz@du@kl@oOuk@oZQkLeO{Hg~@gJwHrA}XgTwKvJqY_u@eu@sAaMaa@{Wy\\\\gsAiP{SxGq\\\\iYiV_Heo@wZ}c@jNygCwc@icCeFspGwIsq@kY{Pk]MiWoKw|@_lBggDu`C_q@oX}]gf@ck@bn@w^viAe]hOrDhIoGlIi]iMQsNcKgBoKs]k\\\\g^yQep@dGsi@gJoM{Cke@fPogAsIg_@jDu}@iMux@`JqOwTkyAn@o`AiMsNqHap@_OuGvK{I{ZsUhAiWyKkEvTc[{Pgc@zPwZ?__AaOc`@nAgMsh@c\\\\kD{N_c@|EuJoV_SaDfKymAsXbGiTk_Ai]qZtCaNmh@qHiJoSg`Aav@~@_LaPpCyIuKg}@{M_KgMzFmMeKsWcn@wOuVsf@gv@me@eF_H`Psi@gGwTsl@{c@o_B}p@eK{RxCmTkLco@oa@kSyCuQcg@a@{^cRcjAn[m`AuIiYo}@xEsXs_@we@oM}o@sh@_H}O}Q~K{nAy_@cNc\\\\w_@fCuMiRmYyMcv@_UaXbNgrC~]alA\"]]},\n" +
+	"{\"countryCode\":\"CG\",\"polygons\":[[\"a|bAoxjJt~A_InyA`Fv^fJrf@|s@lzEnsA`uAtp@zl@lq@|eAdTpi@`Uxa@z_@x`AfWh{JR|oA|c@l{C`b@|_B~v@|{AaFrnAsQ~qA`HrgAmb@jfAvAbtAdx@r{Cp{@lsBlXvkEuSxuFfrEl{@t_Afr@jqDnjBjuBzzEbzBhrA~RhrAjw@~uCtoDb`@|UtjAhKjZoIdsDeTpyCj]l}EgId_@iTpMd@joCxcAjn@ze@hiAze@pnBjp@b]li@rObrAbh@zmBfhAbZbWtRfmAh~CpcBhaAn\\\\|m@j}@jx@bhBj}@~s@`bBGz]kc@r_Ab[niAwrBkEcm@d[ui@jHmb@cm@sl@e[yn@bo@in@nBhU|aAlg@pz@tVfeBtr@zn@oDjo@cf@jb@lOtz@nbAgC`r@dMzYkG`EbRvUbCyBxXlG`LbXpL`C|KwRxZxKlZpN_Fnt@`~@qw@bTkW~s@qj@lx@gcApj@r@fNxLhI`Tk@mM`\\\\oqA~lAsi@hSPla@qLd`@`hBduAb]txBg@hYpo@`Gjz@r_@bOdu@wTpLqBhLj|Aje@taAvjAwqCjuBwFmAnGeNwSg@at@|l@cRaZsd@rG_fAzeAivCfuDsc@zUy^bCklBrwCwtCq|@mv@kkAcLqp@}o@{i@nCi^xPeVdoAsp@zN_WkCaa@~Jc{@yMaYiZ}NeLjC}Qvf@aLdEuaBkd@so@yj@eSpEg]pkAks@~rAep@OoYiJwCq\\\\mHmNmL?wjBnwAh@f]pR`c@cj@gCafBiu@}[jBos@pYol@iCeq@vNiK_D|Haw@pc@{g@zEwTqXq^e[kqAle@}e@fNoe@et@cwFwLbFuz@gPab@hB_e@oQ_JjWaS~KadBdGnXid@oe@aV_Vid@Yw]ty@sjBxhAsY|PcOjWaA`BwJjRvFba@gNcGaWnt@{ZhDgJ|z@nWkT_b@fW{cAkH_Yvh@crBcAwo@vHmKy}@u|@yKs[gXwGat@ss@_|@_YpXwWtqBuq@|VmYpf@pc@dZd@tLoWeJgr@hRsJsHod@xFwZwbA{`@yOyVgDq_@{RrCag@jn@kT~@kM}\\\\cHiG{T`BaJmQk{@eJiKjJcZgFoi@c~A_jB{Dy]iYsHr[kXpFmJhOuOg]_RwI{BmSy[lN_f@eJgRsR_p@je@c`@qTo`@cBia@lVoTyB{dArYs~@cL{g@uZgq@|EeeA{[af@j@ie@jUwb@te@uVxk@qAtnAdHzSeiAjLkd@xY_N|n@`EvUgN|d@zOzVed@r_@e`@eFa}@_p@wU`DeWmKoLvVm~AxMg_Bgm@at@efAwe@lGkY_R}@k`Bcp@s{@ml@_EmkAgcAyc@fEyVaRub@`i@_hA~`@{Ytf@m|BdR_e@hbAnHjz@{Ul]kLx_Cpd@|WpIjeAdTjf@~WjU~Bvf@yIh_Ajl@dnAMxg@iTtTs_@cs@_QaJow@jFaj@ba@wWl@YjJea@vTkZwK_jACgl@oXmk@~Pib@_]iV`Ac_@km@qgAmGlDw~QmJi`@jDqf@vQy]oTifAmYmHba@ur@|HhDnAiUtTi]oPG~G{OnGzK?iThTcAoEwn@_X{Hrb@m^x_@fHJie@aPyOp`@{y@oVyYlB}KmQgGhF}RmEcQhc@wr@tV{BxLkU}`@}yAjZ}jCpMcc@l]}VrUol@`R{GcFoUlMeJlEwj@h]cDri@}\\\\ig@a[~A}QyKuGwzB~b@{[xUyaAkFcL{HmYdCy`@sTfLiWsXg]kGzE{uJooDaN}Ae`@dUqZv@{cAi[mv@xNek@w[kwBcXer@g\\\\iX}u@`KszAeEgKaPKjCuv@fKmOkS_y@wH{sAsZ{e@rC{m@a[yXa[_s@pn@}nA?ozA`JeM{@iVtMoPt`@aL}Neo@pPm^gOieAdFoTtb@}Q|IyTqO_Wkk@yPHcqAmLwFuAkV}RoY|WyXb_Aqb@\"]]},\n" +
+	"{\"countryCode\":\"CH\",\"polygons\":[[\"gir[sdkEvXpDfNhP~YeFpKbQtThBvPvN~SoOnHuf@d[gCnXtLkGz{AcN|d@uYPoD`Y~Ld`A~WjIrR~Vt]|ApI}H|K`JbZqfAng@f]hn@qh@pRrXrE~m@wWzEwVx`@gc@nGmHn[jS||AuF~\\\\nWhFlKhTgCbuAkc@t`@qJzV{kAbE|Rf^}Tn`@fIji@j]vNjKiMht@}Gr}@n^|OdZzRhJhTjl@vn@rR`Mtb@z[mG|P|TpV}QhPu_@|o@|d@yGff@fDvWsb@qFyf@tXoUl_Aas@qh@qMzNkKdt@pG~[{Ohn@et@dx@ePbl@gXhHktAcMuUdJeA|PpJxr@tPpQdJyEzI~H`e@pv@jVx`AjJzG~b@gb@zUw@fSpLlUht@je@bH~QpNzIvu@bi@zh@{GvtAoVz^cJt~@bPjVp]twAoB|m@`VfrAqGbu@}Yh_@kd@dPua@jl@Sbg@cUl@aP{KaMhz@}^Kgs@_`@on@ho@sSyRiUcBaYjkBpCvpAfXft@pG`{@jp@tu@v\\\\uM~A_WjMgBl~@hkByDvp@zIlj@eXaNwXfNoT_qAka@{Eec@iUgVrPiMv`@{GkGmVbAiq@}q@}R`Pq{@m{Agq@ydBwNcAcItHua@qQc_@zJ}TgHmYq`BwTuh@aMiMsJnF}HkGigBqeDk[o@iWwo@{EyCgPdXtGztAk_@ec@yJ_@aPqg@aV`JiEyUxE{iB|ZxFvOsj@kLchByOkMuNjHdDe`@{EuIwLvCo@cNyHqAyD`M}_@m_B_@eMtNfGfC~UvKam@iFas@qWc`@tCap@~OwG?ipAgCa[g]kx@{Bw`@zBwa@vMaJ~E{\\\\aAgz@sLaG{H{d@hS}VwEmKgWD~BiLyMqBcClWjMtcA_Ozh@gTOyMs[mXaOsH_v@yHxD{B}HbCcZhV{HaTuOdSsVXoRdT|F`QmII_`@cOOPyOhQqc@dElJlNmZb@yf@kNok@hDggB|GmGvBun@fgAmjDr`@cVzPch@~b@pEhrBf~A`Tn@ti@cPpZbUxCawBlXauBlI{Fzc@bDvF}Fdq@srCiKiw@ib@aTJ{d@_YgJoRyg@xc@up@\"]]},\n" +
+	"{\"countryCode\":\"CI\",\"polygons\":[[\"gneEp`{CR}d@u^o}@sh@u^sTuGsIfGwImYiIaFeHnFgPsb@oRcHjKobAyXia@dQaUpz@sLsEal@zx@qD`[s_D~h@eh@OmN{Zgb@}Nj@cYdUqx@mUTo|@gQmq@pRcr@aKsRyk@eL{J`U{U~GwiAiD}[kKV_U`]cU~Tce@zIgx@oI_ZsMgAsU|U{b@qDiBit@}Tqt@`j@{DnPkYvRlTrVqCx[tQjMkk@lYhEzPkKz]jJlFiHxOfNfLi@iBxLlGvFt^Mbf@esAK{v@_y@a`@`Ic\\\\mDiFsl@@kPuOkV{k@gXucC|Vqv@xg@iRlEwUrWaUg@cn@wKaR|@kp@zPw]cGmS~N}Mdk@hBcKmS|]|BUuOfJdCtOoR`NbRhC_PzBnGhFcDiFmSnb@u_@zR}@NfL|`@yPv_@fGxQyVgJwQdKed@pSaLwB}J`a@cMzBjT`PqGmAgGbLgG{HyRlk@wb@e[eo@dRaO?iOc]eGGeQjj@tB|K{GpC{x@lg@uVwX{BvCu]yj@{FkXkU}A`IsScu@i`@e\\\\|Kk{@oKuJ_AgX_RsEiVem@aGcaAqTy]hAcn@mIeJdRsa@bBufCjOqX}GmRrd@uHo@{Mal@mf@rCsFff@s@|Hmf@vz@mY`H}I_Bsk@zHyHpfB{p@fqAk}@~Je\\\\o{@qm@rImG`|@aAhf@rYzVoa@zJZdUfZ`n@n^`v@fK`XgfAbs@mM|CmJtz@{MhWnLhKwJb}Iqx@~Xj_@v@z_@fQ~Exg@yL~QvE|Qdq@jp@dq@{FbKdy@rEvQfWrMc@fhCp_Ap~Dr]xa@aJbu@jd@h^dD|R|i@bzB`gArm@jJvc@mSx|@p_@v{Eip@zZsZbjCg_@zwAyg@z|ApCmBsg@`f@hEdOkBdGwMyPwi@lJok@tM}KpvA}M`r@wRxQxFkAj\\\\te@`Cj[gLbWlAdLsLxJ~DlFdo@mPp@Ax}@dX_ArLlT_Ybt@|Gvn@wn@dh@}SgSoa@oG}IoRuTAwDlJzGhZsCdRdL~X`ZoHzIhUnPfCxKg]zBvTbU~OcA`JrV_JbCaPfQvUkh@twFsWvJcGuCf]rOuCtp@mPnc@qJd~@aMhHpAwWiNiQlPyQmPcAhYqlAqLfC?i`@{HgGsPjOya@rIcEv[bItDpl@kp@`GrDXx_@iRzj@kMhFbGnGwT|GbSdHr@dv@vK_j@`Nj]}VftAvGrTwGhXtMfX{@fHyKiCcB`OvFfM{E~M|VtQaPtXnJrRoJt@b@bV|NjJcAeJtIiEuChw@}SjK|SyBnPba@uE|W|I~TyK`P?o\\\\yKlKqXqG`UbQnBf\\\\vK}O|\\\\~q@|M~AbDae@yNiBqGqVpG}IoGip@zGm]sCoMoGrTqGqXpGsCyKucAhH}A{PaMvC{p@gUgkA_AalAtEqRzBrXvTom@z^leKp_@`iDuCzv@cGcGFpPq]~FoH_GaA|FxFdl@tKzQaQn[gJfeBjSnItCqXrOpXkK?da@dLi]rXnG?gClGtPiHzMkw@oEsWyK`j@oPoa@~Fc{@_G{Bt@lJwQjQh@k\\\\`U{d@cJ}W\\\\y\\\\da@gRxShsHiKfa@vKlPhCmPhNxp@vSdiCx_Cp}IvQhQ|S~wAjUxYbf@tkDzkA|_Bp[blBjP~LbBrSvgAjhBmDls@cMtSoxEYae@lC}RnOwq@IcNaRxC}Nme@xLm{@rA_OcQtBkRq]yO}q@^gYgHgOkUf@cF`S?wAaEkb@cQy]lC}CnSi]tKsv@eYaFlFQoOwUlJkDkPct@h\\\\_p@pJ}eA{@wG`Sp`@zHkU|UoIaAxJpRyOpPqI{@wb@d{@`X|U{b@jj@uMxGcZ{Gy[nM}DtJdG`MuGxEogAoHkm@ji@yQbiAjP~tAsWxlA_PvCpAhKkN`RdLxc@oFlFsKaJcUbG}PuGiHfGmUv]xa@pQwa@`d@sBze@{vAkaBgkAmcAqq@_UePQwJfJko@qGcT{S{xA~H{mBdt@ysBtV_UfJaLbYia@ck@|O}MeAyu@b[km@_S_QmYm@_z@ak@}f@uFkHoIq^pScXm@}m@c^wk@gE}Bub@vK{]uu@tc@yq@gJvLjr@qQz{@}`@|r@mW}K_z@gE{h@|Ji[y`@gBsnCdk@}k@m@g^q[y@gAuLbx@_`@fJm^_Cmc@{f@~Ooh@oEyiA~IulAxaAqS~_BgRfE{qBeVge@o`AsJcq@wa@zn@{UhN|AfRoKx^wURouBwg@oD`\\\\zSxp@kA`f@mOtSegA~j@gcEiTiLzA@hMoW[{DlJ_PtBcJaTwNnJs_@kRm]{x@\"],[\"kwbB`z{@hItAwO~`Bk[mY\"]]},\n" +
+	"{\"countryCode\":\"CK\",\"polygons\":[[\"lhjLbtk_Bp]u\\\\xFjXkHxSsM|@\"],[\"naaKnfg_Bfm@BdHlGa_@vK\"],[\"|knD|wk_B}AtVmMpQ\"],[\"hx|Kr`p`BtHma@|_@HyKl_A{ViH\"],[\"~shKno__BjIfBlA|LgSlD\"],[\"joeK|mn_BrJdAx@xSoWpK\"],[\"jjbKtdr_BsE~F\\\\}Av@}@\"],[\"r|aKxpr_BvBxBLfAw@[\"],[\"fjwJf__`B}M~Q|HmV\"],[\"bpsEhwedB]kDCqAVqAzC|Bn@|Ao@bA\"],[\"xwiEjggaBsEjE`_@mc@\"],[\"|~bE~fiaByCeJrDlBbDhF\"],[\"fxnJtxo`BbHyM}EdDlZfCxDtIqLlD\"]]},\n" +
+	"{\"countryCode\":\"CL\",\"polygons\":[[\"r|tIxrli@vfAcGfuAauA~[wNhcAqCde@iNsFgXbQ_PlSeo@o@_h@lIoE|YtCtb@jh@b\\\\{Gt\\\\oXxeBcMtp@cZ~iBKf`@}QbYjCvkA}c@hXfDdWrQhMmPp|@g`@j[ey@|~@ahAfc@_Ur_AiiB~b@o[bVbf@niBnjA|l@bl@fR_Bf|@ytApn@eKnsAd\\\\[jg@v_@hvA`K}@tNof@po@~@l}@{^hUnp@pb@xCvz@}p@lbAy~BlJJ~a@zi@lZbM|oAO|XqIlOa[Geb@nKoVxiEoaCrZsGbwC|AvvAwj@ho@_LveBcF`|AwsApb@~Hlx@cYnr@tIfdC}x@fhB`Sj_AsAvj@igAjDs_B}m@igGpnBmoB|oRrjErQbMz|EhfPneAlr@l@hk@noAr`@ePnZ`hB`o@fWnBl`@gMp[ir@`QoAhVyRj\\\\xBlr@qn@nKOjLjSJvm@zVfYj}Cfw@`m@}@te@}\\\\nHrG~m@{Dlx@ae@hrF}v@|TxErlA``Bb_BpKhy@uNbxEodDzzAtN|RdtB`RrWra@xPgFvf@yZdj@nGxz@xo@hb@j{@tFr}@d]vg@vt@xw@~Epv@xf@~a@iIzVh]rfCdh@`]reAbVjD`mBxbAmN|a@pE|Gdj@fKnpAhxAjd@bKrgAvB~UhZdf@pRpjA{K~yAvj@fbBTbRhXtGph@nN|E~[di@fUwD~Ov^tK~D~g@d@rhCim@vr@|Ar_@qZpu@mG~iBvFxz@v_@pIoCnEyx@xe@a[fVfGtQ`Yx}@jLh[x_@|L~g@_Z|gAvEpOxm@mQdn@vl@vaBxc@x_@B~m@`ZztAhKl|@kl@fBvl@tbA~i@kDzOkZxFcDhKv|@~l@vn@jBvWzLtc@}Gdj@dNxsAvEdeBu_Anh@m@lXmKzRkUnF{fApd@_j@j`AbXgIjv@dMzHhu@u`@tTlEz`AyMzJ{_@nRwO`m@x@xq@ys@vb@y@pOsKhApSxTpEvpA{N`t@wi@h`@mu@pgAlYvH~[xQ|Lj`@{Jro@xIjj@wi@pd@mR{AiOg_@aZ}AaUdPyi@zMiMdw@kO`UfCdZvUrm@rEvNhVnPuBtn@`M`g@cUl[|`@d}@sIts@tFf@oZzOcE``BzIhy@uZ`_@tr@uHno@xLvi@blAfEnSd\\\\zw@l`@h\\\\nd@`dAfNnR`LrJ~Yz_@wW|_Bbo@jdCpSrYt`Bn^nUdWqJxHet@hJeUpNcF|LbZ`r@mOd[iV|~AhGd`Bcg@f`@hf@bUJzDeVpZ`Qvl@aQrP~@ny@jg@`Fv\\\\wKlr@b_@jMnk@haAxi@pLn[wMtTfEvJjv@sS~r@~AzIbi@~OhFtbAhr@~NvjAeFvDvHmKpb@`FrHdl@zBbp@hZfZwUnWlEnQ{S~MbO}@n[~Q}^xc@gI`Yq^tQj^|q@nCto@|c@v^yEzkAco@jo@~MhXsGva@hf@hh@sBhqC{{@vpAcq@~S`Ijf@gHth@zE~uBu[tTqMri@s~@pb@}Erc@r[d]_EjHhUnXdUKr_Ar_@f`A~F~r@bWjZtd@`jAjv@|Q`sCsPfr@fE`NbDpXdb@v^gHp|@dq@xS}[pUzChGhI^b|@c]~k@rSjNbZ`@|PyL~_@vK~aAsQvn@oi@f}@|f@`_@_EzV~FyL|r@~Hn\\\\nM}Evy@`L~t@uq@_D_b@fU}Fx{@pa@iC|h@nsAzg@tQyG~UjB~_A`_A`pAa[bv@ad@|d@dI|p@qGzu@xIdGhJjj@_I`iAbRbj@cNpn@dD`a@wTva@ll@xQA`f@qb@bk@qFzq@if@xk@gKn\\\\|Bl[uXn_@aApU~UbNndAlStWg_@p`@KdSnKxQ``@zJfw@vm@~e@{f@xJwDpg@`Hr\\\\oUl]bs@df@bOtRoIvRdE~f@sK`yAkDhsAjUdZ_Knf@ym@jPiwBrPix@|P_P`UdHtj@kCtOj}Ar[rE~M|PxTsAnIlJ|J}t@nUkEdOhM~GyChBul@fc@_u@jr@eB~Xvy@`S~HdMyL{DiYrb@fCbJuS`q@_j@|S`Ed`Azx@hZh{@z{@o`@rg@tOzZkPvJ~B~Nr`@dJgAhOcWa@coFhOomCjNaWv{@q]rRrNnAzX|LjN|^~O`w@o@x\\\\fd@iAtq@s]zhAdVxrA_OdqAzUlx@sUvwBvBvPvLxFriAoHl@yf@tYgjAkKyaA|[aq@vAafAhP}SlhAoi@lt@mlAnc@y[dSqBpk@to@nVhiAlm@{QzHzExK|UzGltBnWb_@tL}Lt\\\\vWv\\\\e@rThLff@_`@je@|DjU{x@`u@o`@xwAtaBxM|rA~HjErx@s}Ax{AcFtyAsk@r]jHj_AeDp|@xzAxEn}@`H~HdXuGzy@jTf^wa@hZft@hKfBjq@}pAx`@wExNzIzFzRcVrhAlJfHt`@yHxInDhjAvqA~FbWiBjp@nUrl@tMxD|M_^|_AtMvzApeApfBbu@jc@qTzUkw@b]oRzFc[~vA}Slm@`Iz\\\\aRrItKmA~a@v`@pe@h_@xxAdc@tVdaAaRfaA{Bfj@|GfxA`uBnJbwAxVtu@ti@`j@bf@pJby@nu@lZqLfDsg@zJhZhJhAlGxjE~j@wAbX`m@bItEj\\\\iDjWnZfQ{Eb^aa@ji@xN|HiC`i@om@|Oi@xd@tk@h\\\\rCtVfR|[il@fRoLb`Ahf@|OyEf_AerB~a@a[pg@_PhaCkIvi@{g@pbAaH|K{VwF{u@_x@_|Ak^}zAb_@gy@SyZ}g@aeAv\\\\_~B|y@zZdy@ms@vlAn@xi@dPzCf[~i@fh@fa@_Mbb@sbAvPoHbi@pe@zo@|@~]pPfUI|n@x|@pMcLtCoo@|H{Kpx@{Q|\\\\cbB|`AgcB|^wSrm@lIdKu\\\\xIake@dmAecHlBsiDtX}~B`g@}sBvKg{Bf\\\\mkBrUFqB`hAgT`{AcA`sBsz@voCcBj`A|XtdAtCzz@zK`QjeAfOvGf]|i@nQhf@|{@eDbbAqUvh@zZ||@ra@|_C~Tr\\\\hQdDaGhfAvL~E{AtSji@v}AK`RoK^wN_]cIrA[lKpFtb@~HvD`HcMjFvIuB~[jRrg@m@jJiU~InK~Q?b}@lPme@vOwEzC}_@lVxl@fr@dQjE~\\\\nGep@nTYfRxCxb@v]bg@bLfs@tf@xoAdGbb@bQnZNffBy`@b\\\\zBhXdT|n@uBp`@zd@tn@v{Ckb@vxBqB~eBqw@xhC}UxDq@vIvTrVea@`l@lSrXaJje@mv@vlA_Kza@wQ~Fel@f~@wk@na@q[g\\\\sQrI_L_Fsi@gpAvGygBzE{Ife@oIdZz@~IbIwJpUdXrShMrl@mFg|AoSgZ~@cTuYsEk_AvIsNoXbGma@eE{j@rO{KhuBs[zY|g@tV|uA`SzGrEwMkTwt@gEss@kc@id@ojAbL}hAuS{NgeAmRi[sRq`AcKwiAiw@q|@{j@wO}RuUuA{p@qW`@ei@n]t@pXuOjWlJh_Aip@d\\\\t_ACgCjt@tg@fy@pjArlEpfAjgA~@lz@{p@d|@yJjk@dAfPxV{ZeLjdAlBvfAjMti@sCmv@tGqe@yIo[~f@m[hJaz@~QiUxKhHpBn\\\\cNf[c@dh@dOo]bHjFRbVuTblA~P}_@lGtOgUj`@|@fMxLkI`VicA|NtToKvj@bDxJjZaSv_@nJm@pk@{[f_@jM~B`J{Gbd@yo@x@mKiLuI~DeWznAiiAfBlgByJdJqOxv@uNlJuDbg@_[dEuQ|Rd\\\\~FnBtJi`@hHaBhi@wHcBqGrk@c{@hmCk_AfqAjTkeB{OgqBvTm^|Wc{Az\\\\xI|Dgb@eO`BoOaUuPvg@eW~X|Gui@eYfUcCmH|Gwz@oPba@kKfCbGzv@vR`Yk[jv@wi@_c@gKn@dKfOiFtL_UwDkf@`R_XyMw\\\\x_@sGaImFkr@aZkgAye@yc@jTmx@bSEb`@zt@jN|M`JcC{l@cnA|CuN|QnSkPekAhTeG`IoQul@wScV{y@o[yTwC_k@wXqV\\\\_s@|KwT_@wPsOyGKuKpUkd@dGsj@yEeiCsMwZ}GyaA}SpGa_@zp@dGdd@wBnoAoOp}AxIxgAwYtj@?tcC~MhUv\\\\tPfRfd@?cUhTfLke@bpA_`@{e@{Cgt@sMqFpD`eAfXti@_Uxt@dKxuAmNbH}Gf]dPMn}@n\\\\rm@ls@~F{HspA{~A`QyKvJydAlJeGr}@`hAdC|`@jQpHdDtp@zZhn@f_@we@rRxJde@uNrVzBnRfOvZ`cBsTb[uPgOeLfJvVtRfHn@nMaRpGnJ{SnoAea@xc@iAx^sOxJsBg_@lHc`@aRxKgEe]cLaE`Pel@kTxKhCkTyK~AqIoPuDvD|Gvm@uP~JpFdZuXnRy\\\\dpBsLgj@|DgUdOvDiTsc@~OyNuK_v@lCmf@wGoFFjMkMnI\\\\hYaXfAvB_N}IwSca@sMqHyl@ms@cRbDyhA}NffAkWoOj@ur@nQ{kAoQlKkNv|ArJxu@qd@d_@n[KxKpXvJ_a@p]gLdb@lp@n`@xQyO`j@a[qSwIfAdAv`@oZlRtKjGvXoLhRlFYv_@pP|CqC|^fNFmB`Ox[~q@pP?sT|r@oTyPwOcu@iLuG~E||@k[lJkGeZgLiDsFqr@cHx@_Ijf@}Xs`@cAck@qJnU}UwHcB~IrSb]eNdt@aXuBmFsRzGkRsf@yTk[`^cj@aScHn_@yQtEcJdViq@jg@cUgE{D}J`MiWn~@_f@mEwSx_@qRlDgLsO{d@uQ`a@oC}OdL_g@ha@qj@tG{c@iKsd@{LcL{k@aJ|Iuc@}Yqc@_Juv@xSqI~ZbCtBj`@ve@jXjOk@qZk_@yBsS|Oil@fRwDnF_\\\\gMwRsu@oPcw@m_@oBlG`a@nl@nl@jGjKdQ}Rxf@}gA?kEeHjN_RicAkV{Iye@z^qgAp]vPjMgF|[ed@iDoLrGcLhs@qg@jOKdKtMli@fHxQdPzAzTaLlY|WdUjHx^sF|IhLYfe@`|AfA}Xe[wgBnGuaAvSqf@gBgQcHiIkIz}@eKpRkr@yvAmdA{Qa`BrpB_v@bLoGoVlPiTuTeNzG}Z}Nod@_IyHoW~ZcVlEs\\\\}XyKnPab@|O}[b_A{l@fg@}[jlCk]|`@{Ens@gQjOcWs@b_@pqBzM`@hFiI{Js`AjH}t@lSsHj]~h@`L{C{o@ecAzUm[hTqt@xEqt@bw@ij@vOwvAhIcGda@vP`U|h@fCjp@cLrGcHxe@qTpQ`Dnj@uJtJjFtc@{\\\\hF}ArXkKbKzBv~@uSfTiSo@qR~{@pFbIdQuc@tf@cGnGo_BfKcZn[cS_[tdAdNvS?gp@xe@sE|LhSzBjx@`KxStEsW_Jyw@q^m`AvBwRhRkLzq@dlCfwArRlEj\\\\m_@p]ii@wAel@vTgi@aAs`A|Oqm@bd@r}@a[xzEeb@he@zCFrMwRzo@aTrN_e@`yAiJbJ{VgD{]bWsC}S{o@fi@_LpAyH_QpEoLhl@cl@ha@iMxI_Xz\\\\kPH{U__Afr@iCaPuEdCoEbP_RvAsKnVqR{Cs`@nh@wOqt@qUwYtTzv@sX?rX~X[lKyS`Nd@~Jh`@~P`PaA`I|Jm^ff@mKHqOv]sJcBoRwx@zBnj@cItTgAjq@aWeDi^km@_Erx@}PbFeK}Gup@nPsRu`@pN{JKs\\\\nXwSl\\\\yq@p{Aep@oIeIwm@lTwMkTiJra@qOwAuI}a@qJc@pG~W_S|`@uh@fQad@iAq`@tg@{WoM~Yia@xQiAxCmJme@dEwO{JaDna@eXuTxBpXaPvTp[kChm@zgA_CrTkZfe@cUfCyXxX|p@}BlI`GmKf}@ez@dB_SlJul@_n@wGtDsKsHbq@jeA`@b]ke@fEoJsIgInR}R{MuT_r@vKq{B_QqNlNy_@xKwJvMn@`Pca@sXoG}X`PkZdf@aVuNlScy@}DiK}Mz\\\\g_@mK{Z|FqQ_EgRctAzJc[d]aWMkKoQmGwAm[mKhn@sP~IwBhi@yc@`w@oW{H}Jqs@c{@me@_@|Mbp@|j@`Tl~@fe@jIzMbNxZe@lT~O`f@eH|HjFfj@pfAeB~`Aed@bs@wTaj@yHz@wNnc@~O{BdHtTylApv@g[iVcNnd@}PtC}NnRsI{v@hnAer@u@kJm_@vVeLkKiD|LoMZq@yM~Xkl@xKskB_XntAm`@]rKnc@a\\\\ta@{c@meAeChi@`PfClPrw@kCr\\\\|T|MwGxTgYbTci@oc@s_@jKFhP|y@vZq@xs@aGrU_HeLgCna@uOvOy\\\\ca@`Gba@kC|FeTcO~Gvz@aYwKfLvb@eB`PoOhFqi@oh@wHca@d@cVxQcCJaOqa@sDrL}TuFmeAf[ac@|XvTuCy\\\\xRo_@dRwL`LlF|EyK{Usl@lg@wdB`@}}@y\\\\fwAmQnO\\\\sQmFnEFr~A{HrTqaAh]}Kjc@qZ|[qNjJoW?wMyUxLyHsI_\\\\|C{Vbn@kv@}G}ObUuf@aDsfAx\\\\g]sX|@mPbNfElSmOre@qPkDmSiWgFiq@aAhNwc@j@h]pX?lYz`@tNDjl@gBnM}YbUiJjxA}LjUmp@vMbAy^a\\\\u}@uCqmAmPsCSxT`QjvBwLnOmYpE}l@oMqVqj@eQc`BtHo[h`@j@rFqFsc@}MFoc@r|@_]xQms@pWi`@v`@gPaI}Hi[aNoGl\\\\yVpVcJlr@ef@zMgd@PgJfUiGxlBoGtJ_\\\\e@cHxHqcBs]bGah@la@i[nKciA{Pxp@{\\\\xd@m\\\\xMye@eQo\\\\hMiTpXcd@i~@~AuY~P_[iHqSwTbi@tHjwAoExOjQ`CtVyK`_@~DtGuItn@~j@bBpOuOjTjDbO|PwStg@sG|x@bU`y@zBdUbP~AjOay@~z@sLbc@yPlP_i@iQu|@~JyNmDiCaSw[jWol@kBbM`Uuc@lPyZ_h@s_@bKy@qb@cPle@}KlF_LuJcSvS{YyJ_j@ef@lUeVgHfA~DuReRmaAdLsxAmM~RuG`q@fNptAeHlNyKsXoPnPbJ~[a_@vCyKlPcFwCi^cg@{Gec@aFfG{FaGoVyl@nlAg~BeSqLsRn]ot@~C}U}sAwHrYvHbz@m`@bmAkCzr@qKeSkHakA_EpMv@dq@tOrr@}`@y@k^x_@{Jc@qQzlAyx@nj@iTcZoP?r_@piAeLfW_NrBeRoCuX}u@zKikAh]sq@d~Aec@hAeRhXyJn@iLyF_TeB~Mq]xUiPwEkMlU}eAxl@qEenApEwxAfTiPcC}QtKsXeOak@hKwSx`@rLsi@ucArTuCp[dYzC}EkTc[f]wzApTeEvXhGrXg]{\\\\uOqO_ZrA_`@vYkr@sVqEaZcg@}c@dBqIbDjg@zAdUbq@oWzaA{q@tcAcQuEiQfe@cN_E}OdMmTk@jFmo@b`@cUnHuSh@iwB~Meu@gPjQ_Ll|@rCpmAkR`[iTuIeKxCiDrKrJhNmN|b@}a@aj@yLt{AwY|S_XtD_b@}k@gRy@lG`U{UXmNgQqG`KfCpLkHhG_KaCne@ba@tTe[ngAvc@zWoIfPbQfUbkA{G`}@ac@|ZnJbpAcLty@lLrOWlS_Lhb@qG_j@wD~W~WpiBuIeB@zs@u]~aAcMgCo_@al@lKep@nPnG}E_NqIsIuY~q@_`@qKoJsa@jEoTxY_@g]iW?qZjDcJ``@qKmM}BsAoLx\\\\aPxTpdA~OdOoPwtAba@m]nRww@iEk[uGxcA}`@bTkHkRxEw|@kEqX{BhcA{HvKuEmDkHsw@jFov@sJkR?le@qMzTm_@wr@da@plAfC~qAkn@`RfHx\\\\q`@fe@uLmi@}FnDS~[cPhNf_@xXwVvL_j@ie@wKqd@eLrDeRa^{k@cYbPqXiT}I~OiTcRmCeAu\\\\hn@eJ`a@q\\\\wKsCqc@|Tq_@}CeFoIwH_}@no@uYcOqBse@rLqEfi@soAmgA_b@`}@bIvo@aLrTwl@ra@kNaPYoRwW_NpXvz@}AnL{Yb\\\\qIoNmM`@iAbZkKzIdCjoAsWrsAh\\\\efA~Pr]gLxeAx]nDjKlNqGskAnPsc@uCac@~[zZkO|uAzCdf@mZhXgWxo@mSxHj[tX{`@p~@{Bf]kT`E_p@~h@a~@ou@oMca@op@sCfI~Qhh@|]gCjVd[vMvPxU~Yhw@yTzBjVhX|Epw@pGdAc@pZgMvPna@bcBbGpLtR~DvTba@jJqQou@uw@zHyhA|FfAWvLjRL|I`PvB{v@lOwAtb@f_@cHse@fJcObT|BjRfc@lIdwA}Z~S_Rpg@{WhAg\\\\d`@mqAXar@cl@uJkiAsXtMmAwu@kR}JgCae@mRpPq[{a@gSum@hG}RtPiIaaAeOn]qw@tEy]{HuOmP`cAqT}HwBaN_N~I}S_OmAae@va@ij@rBiSmc@xQgPeTmV{GeAaR|g@}~@eLnGeGwTaUze@aRz@}k@s\\\\sCel@cEvUcWiNiXpH__AsVmJyNmCmJpQiXgKg\\\\~\\\\_I~PxGTuYnM_D`Cw]pm@dM~CePwt@o`@kg@|f@mRbBhCiTsO~OmN{~@nE}PjR_E}Wk}Ab[_i@|QfSfHxb@~Gkm@hT`PIeu@n_@`LvMju@~FqHiBk^lIaJ|^bHva@zi@eXww@si@mK_I~FkR{Ci@eJnQmGhL_]`a@cF~f@t^|MlYro@~zCr@vNkVtt@a_@pg@~NLhe@y~@hEo[qJg~A__@qvA~XpL|GiSzMv@~^nUaUa_@jJat@xTu]j@u\\\\un@t}@kTzCeGnf@wG|@id@}^y@_u@pY}Ml^av@|t@`A|n@h^nk@xv@xw@|n@fVkg@hBu^eNmYmq@uZzUk[cOaAcUlPg_@cNa_AcwA}ImeAkBte@wNbJoz@wZsN}]uCrCqk@ic@a[mp@~@pt@pjBfzAjI~TvPiFpQjNl[tf@wPnGgVaEyQ{N}CcTwMtHyx@eQk_@dRwm@xEyNeWq\\\\uF_JwMcEof@wLvU}MR}nAyaBmN{~BeHiF}F~cAeRlRdRby@`o@jj@l`@v~@glEoq@}Vsg@vBsMzRgIin@qGmDkLbQs\\\\ka@pA_Xi~@xE}St`@iRp^ghAzPaRhKw_B_\\\\aQ}RhZrMjh@kDrX{[~{@}z@vtAuEfXnY`w@}Ep~@yS`He\\\\yFoD{y@u[e_@qe@lSwCtSaSbQoa@[_R}]tCefAgL}`@rAkf@wr@wcAaJux@_q@o`AuIiZ|CcW_TyKw^dN{WmPww@{M}Vcr@_XrKylA}Oba@rX~IlT|OmAf]dN`G|Tw_@tPan@~kB{h@pHsc@fwCiLhDeI_`@aP{KkV|D`Z`[zC`]}Dz[{TdMoYuFrIea@a^e@{HaKc@yPfVy[_F}E{}@lMck@_j@_xAac@~\\\\k[}Ywi@h@sOjQuHKcSm]rNyp@o@|f@pP`EnLtFfm@uMbJmOph@w]ai@c[iHqG_i@}J`Fe@`cAqmAht@ap@gOkh@MgPaQi`@uxAi[qAmx@lOup@mj@_Bsd@jXgHvNsa@ek@rO_}@wGyk@lz@iu@dMea@?gDiQgMkFwZtXa{@kNkR_[j@}s@xg@}pA{@eX}S|J_\\\\~iAs[pWyZfAgCrXin@~Qu@xXkN~D{TgTge@meCbQ}UrnCq~@xL{QsM{Bmt@xK_h@rXuJ{CgSge@}@x\\\\}ThL{NwAsOy^iKve@q}AyMyN``@dq@rf@?fVqLnH|EpLsM~RnJrMa@~O_Yxv@me@ve@{Uwk@eQ{CmkAqeBzCgn@sIaUz@qWeNgp@qZwg@?{\\\\_UdXum@kK{GyKqX`P__AaPyDpUpH`BfLyKz\\\\`P|rArLdm@l`@tYnqBeNtd@kj@zM{RxYoSnHm]dx@nFpYqPxk@`S|KSpZhYlGjQtn@r^kVzd@qHdGhXtZfYqAvRhTpPaFbdBxA~f@xK}GdBx~@y[ryAmDl}@{h@kd@}ZeAyKiPaDifAi|@md@xp@|n@bEfZkTjqBoKpF}LoDi~@t_@yv@{Fs`@nG}mApg@e_A`Lgo@|Bkb@ux@w_@bW}|@kRuc@i`@qWjSyc@oq@wf@cSwiA|`@oTyK_wAeIiXkNkTnGe]gVoIsVep@nG{e@nVkToV?sXi\\\\egAs`@uc@~DkYn_@sFlGuEgDaHwY}@o`@jOie@mM}\\\\`PkWkd@oDnFgg@ud@gy@gb@oWfDuS}NmVhNwVmPwx@zGua@}NaG|NfXhEgzAvV}cBto@ylC`l@zv@{BiHdJ}sEj|@kv@uFwHuLwc@vEyWyVggBwJuhAbXou@`a@su@fg@wSxZmnApJuTqJa_@af@kg@_B}TrFqg@`j@mp@iTai@a@w[cVdAoQp]en@KoTvTsCoPinBq`@}l@os@kUkt@fCqXoMqgAeFoXnKcJfY}SeTkBcYgJgBcFlO}X_Pwi@kCfLkOdj@]nO}KlB_`@aQeUkY{@ePcKu\\\\`Qk_@k@}@_NzIgBqFoN{rCgaAgbA`FoVmPuXzGcl@iTo^pEez@qfA{UeK{Usi@wW_Hwp@tIgs@h]oXs@sn@ahA_l@aY{XZoYwb@e~@iTkhAugBen@_P}_BwHe~@_b@uM_Qy~@s[i~CuDm]{c@c~@?aYxBc^vRuLeDeiAmu@{FoN}UYae@c[{lAkZ_R_l@oc@kl@kg@aNug@s@cPyKoX`Imh@tg@wZZqR}ToSEmp@bU}x@nJo_@rUwHoJOg`@sh@{ZHq_@_J{Nwj@wFaUuTuMw@ev@~QgJw\\\\}a@nByNy_@iv@eBse@fPm^cVmc@}CgX}Mca@~c@wg@zGie@~d@imCoTae@rKwOwOq_@`W{e@kN}qBra@gl@S{|@lWigCbWcS|JexBaFyyBv_@ck@eDwy@~GgiD{Uc~@kW`TcQhEuh@kGoUgSa@eo@qc@`OqRcF{Hc|@}Lmu@nU{Qu`@gR}CxFkR{DuLs`@oHoZp@qUlT}k@hIcl@_G_`@{TaU`UwYzFqgA_PmP~Okt@pNks@vmAeo@xDyXyGwMzJkToGwc@fENxImVnCkz@kTo[i{@kn@}So]}a@mi@cGiT`PgSy[{f@m@{BkTug@c[kp@gI_SjLur@}Saj@{@c^~HqZcOkmAeH}]cU{TtCaIqKy`Bu[{EyGbIaFqE_e@qWaXuo@zBw^yOyjAx`@cm@eG_a@zL}PgEm`@lHea@eRbO_QcHqQuj@yLA}QeNtAaPiTqpAbTso@wUeWm`@iUxM{ReOyeA{Tgn@bFoc@eL}XpGyCmSmQiEgHqOaOvFwEjW}i@uS{m@vMc{@oGma@gPwThB}v@|d@c^pGyBnQs^_IiByMy`AoGsYm_@{_ApFm`@wbAer@oNmAo]mVqKs}A{CkXpF{b@d_@cjAmPeb@d]atBra@_p@?s\\\\oLuq@nPocAqXg^vGqG}GcNxB}bB_YgZlGsDwHo\\\\a@qk@`Ngs@cOo`@|FmLwJc`@nAm}@go@kbAcR_h@~LcYls@ff@lYoLph@vKtHyGlEsj@aJwM_Qmh@dKkReGir@jDoZeSgj@s@eMtHi]qJc^_b@nn@sLp@{c@sc@cj@}nAog@eTkAmo@nNsz@uRwf@dCiN{KqXpGcp@eXkRnGsoAwB}wC{T}X}OmUvG{l@yPy\\\\xK_MgYiaC@}e@y`@{i@mNqWdFe^cNqi@fTed@_Nu^hJsLeCicAr^wTcAsm@nZqp@kZmp@pO{Zjd@kKsMw_@nHmOmGiCpGoe@gFygAfFuUiQiZcEyq@zNwU_\\\\wf@kHgc@jT{QyKsXfC{g@mPcRnGaYoGscAdLsXuCcRpL}|@sCop@tOgYjg@c@qPwe@gHglAtOqu@pTaMzOskAoLy`B`\\\\o^iAyzBv\\\\a|@wKsW|GuJqHazAuBqOaOibA|w@iI_o@~AgbBcn@_uBgaAs`A{dBkr@qVMabC~m@sY{CiSyR}@{rA\"],[\"nhm`@ft{h@`a@DlStMmbAfcDtBdVpMur@`Z{Zd[y}@`Nh^zPjmD}mAhjJwT`RyO_a@}i@t@jl@h_@qg@xJuEnJxz@}@nJbJmD~U_i@uBiF|FrBfI~v@jJvCb_AcXja@}c@~B}NiHqIdFfb@fRpv@uL`GfHvGd}@ak@qBjSlMyGzPp]hOjFvm@_Kpj@uX~G{r@gt@cKuFwBjJ|u@faAjc@fHcb@|eCne@~mB}Jhh@cTpFePyb@}P}_Bc|@ijBkKxV~Dp_@rZ|u@jLg]dHzMhMtg@gAtv@`Ppa@sFpdA_OeBkRk{A}DpiBrInHbGsK`KnEva@jo@[dHkQDcRaYaa@?}Zy]fAv_@aPnc@hUqKbQxCn@|LiOjR`j@vn@cPt`@V`b@_NvGaDit@{IaOcGlf@i[~DiV{RnPfg@iYt]dLlH~OkO{Ddg@lMrF~Oyn@q@rc@r\\\\`M_JbOfLzZlLiEzEpEmOvSwi@wOlK|WlYrH?|SqURqSuPaBfYdm@r\\\\QjNaKnH_UsShCz\\\\qGda@lLwGrBnn@vKoF`HjJE~jA{XzU?gn@qGnGsReQp@nb@cKyGWrHpLn`@gX{KgOvOlBii@i_@|e@oE_QhTav@ra@gU|SenBae@tn@EmVtUkp@c@k]o`@rbAyLl@cA|zAmKrF}AgMuOzK}Io~@`JeT`G`KxFi[fe@mm@zDi_@{JMd@ai@aJQuGjt@mKjQqRbBmBwOxYoc@aHiHsOhLn@qH~Nwd@?s]xLiLoQkh@uOrcAoPui@ta@qlBh]sWbTid@gn@da@lGwrAtKaWsVyEqHaPeo@lJiM}}AvHa`@rGk@oK_m@lGke@pv@|gAb`@wHrf@`f@bPbCnPeIe]cN|Y_b@vBk^wh@~h@eWuA}h@m[eVkh@zu@{yAxPmy@xi@q{@cNeIwJxYmUfOh]ajByc@bkAg]llBkLpPuSbA}Mba@mJwHqKrFy[bi@iF~dDqIxNgg@tQpGkn@wWbq@oYrQjcCk|J~~@_sBuUxCoLsj@yKzw@}PvS_N}c@cZa^hAya@fXme@~\\\\PpCkL~O~GwBkY`VwKlEkQnr@nD{Twc@xHyWj`@hIzImOoFiLoM`EaYaMob@jm@_VuCyk@dm@eSgMjVogBdiAirA?{Uyf@p]mIoBsJoYz_AyoDhUiGjm@zoA`l@je@lc@eXah@sAcIyXai@}e@eBc[x^aa@iKwFqr@zIiM{IwA_n@zK}XQu[cVuB_X~~@sDdq@ae@lbAkg@d|CaSjVuf@~cE{\\\\lpAqOvYmhAb\\\\o\\\\hZqv@lRql@{@sL{Vkd@ecC{IaEnAccAiQc`BqfAstDkQ_VcYkBgz@hTt@vhErP|iC`^jlAc@fz@jT|t@yCv`@gj@~u@iQb|@g`@jRkv@yEq_@kPaf@jB_z@gLiZa`A|VnPpK_PpNdKnEix@a^icAa~@c[uR|X|BbN}Khg@`^|q@_SeHcHcQuj@|NkLd`@G`w@qJ{DcOurDjcAunAzBaw@{g@ku@_Dgw@{qAsxA_r@jSmWiKqj@msBvJcZrhBu{AxZqt@gZehCq]ebA{YkT`x@s}Arzh@|GqF`k@\"],[\"forSpihp@eSfJ{SkE}HmOpKuQle@{B\"],[\"tloSbzao@hTsRrCpa@qKt^bWlgAsFrFea@gw@sOoI\"],[\"xcpOvltaA|FgF~MvMnNd|@j[zu@mAvLc{@mP{WkZzW{cA\"],[\"n{cOdwj_AmCbFk@cB\\\\o@fA_@\"],[\"braOrqwo@lDgFh@~J\"],[\"f_`Oxj{o@b@Q`@i@d@g@|@ENnDEjD\"],[\"vraa@dk`h@pFiPxLzCfCcLrIlKmSlfAqSpS^r[mMqc@\"],[\"hjaa@|l}g@ns@}Hr@lUiWxa@ib@oQaBkd@\"],[\"dgaa@nkeh@kGoKxT_EuF`UpEdNr]tFtDbN_HnOfT`P_HtN}ZiH`@`ZvPlJwFpViDmEcKbPnM`[~N|DyM~}@qVlEcNyeAzG{g@yEog@|LscAmUxP\"],[\"bh`a@`c`h@aHuZjLgR?n\\\\pKdNmDfF\"],[\"h{_a@`}bh@eC`PnJhYoJ`KkDju@kOpQ_AgRy[gFwByS~Pgk@jN_HaDsGe_@cAaNlQuPQmKas@bJdG|d@qXdJdQjTjFfEmx@jNfElMaPnPnv@yS^\"],[\"x|z`@|chh@jUu\\\\~_@{CjRf}AqPuGsMoXmd@tHuG}K\"],[\"r}w`@prpi@~Jmc@bNyJgH~^`AfJjN{TrB`HHhgAaPaP}\\\\`P\"],[\"dew`@vmvi@wLkh@bHu@jPtTzWhCdDjKcYvTqGoGuOpr@sCoa@\"],[\"nov`@pwxg@`FaOnKfExQlb@f@bYeDhkA{JiEiQfNoT}TiHm\\\\tAknAxK}H\"],[\"|yt`@tkpg@rHuBvHz^z\\\\hZxKdl@yKpa@mc@yE_Z{{@\"],[\"`uq`@b`yg@nv@ye@}B~Zu{@~gCuIk\\\\\"],[\"dn}`@pboh@hThTr@i]zELtVnn@S|Uq^j_@yg@~@dLfr@qC`a@ui@wXyIlAiLxn@mPjNxPrk@_Gdn@oJnVu]`JpFpWkPpL`a@}Gr@roAgZnj@pYaQ]`Q|QoS|Fr{@cn@x~@yLa@yKme@gCg}AyKpa@cPi]xZ|bCy]{l@gUUuTsX_NfD_[mf@dZn{ArSnViFxj@{\\\\jd@ic@xBnM|Prl@oYlAtNoRbp@cl@bVwIaEvTb{BzBg}AhCdGna@_T}C~PhNpeAcIdQ~B|XjToPtTtCmLi`@pT{j@?oa@pGtTbPgC~Dii@rc@td@?o\\\\dLcNqGqTzBgc@nPrX|McPzKzEyZng@xKrl@sHzWwt@xM~HpM~r@tI~@bTor@~GEhb@cHzFgTykAiCnJhChk@yKeLcAlPvQz^Khf@mBdMqF}MoJpGwM|gByRihA{@s~@yEr`AqMeIoRqdAgR}[?d_@rT~k@mArTvVtc@zG~pAkAvJuIkA{Gne@qCel@gGuI{Btn@oPaj@|@rbBwMbQuTwZpG_LuWmJuMoe@_MqyDtt@gObPxK{DiXgbA|AmBsJqHsv@bHqFhGzJ`DyH_HwWpGke@aUba@oQc|@nQiu@_FeEgH|M`GadBwKmVa@}nBqWq|AqGgaB~E}t@dk@iaAn_@EzFtViQ|i@tSiE~Stp@}[jx@xK~IfGyVfU}FpId\\\\eVbbCwUjg@wO`_AcPzTkB|MzNfEbIaBn@o_@x\\\\ib@|`@edBjEcnBjI_[uCapBzP_OtIas@fCba@dOwiAlKUrDdKqFl\\\\zEzvB~K~SoB|gAg]rZpGbt@oAgh@jQDhDeSrGn@rCov@`TpXaTq_B`Je_Ata@aLuKc]`K{UtBey@jVfAxK`PdC}iArc@lZlc@km@tCwe@\"],[\"j~u`@~ylh@cFeMzAekAuTplA_TbUpO`I_GvMvXz\\\\|Dd{@}Gf`@eMrLeLqXyKpXi^nR}]cN}n@`MtIxn@{Adh@kd@xRcEesAeG}Gs@qgDfD_tAjL_r@yGom@zCoxElWeiApmAapAdXAhb@~Tz}@ttAzHb[{GdxAeLtVyQn@sN_RkT~KkExc@jIdaAnl@bWv@v~A\"],[\"pyr`@n}{i@~QoPvHnk@cXlW}DjXjPyK[ha@tLaCvTin@dGhn@rTh]kYVaGvY|BbEtSeNhK`N{UxJ}Uy@aTo^uHr]kXbPzC~OhHaP?pa@zShLbIv]kM|RqRwNaH`Cg@|NjT~d@iP|XtKra@kTjz@{EaIwQn@aGqm@aOry@kI_O?cr@_JsXbA}GrRvGtE{i@qZiC|Uqr@?sxAyHv_@{E{AkY_iA}BowA|GwTjThnBba@dRmEll@tIGcAlg@sIjc@zQhE|I_p@yGuxApCcLfCvO`EqKbFmt@aNu|@zKyRzKvg@\"],[\"~cq`@biti@cNzZiK\\\\uEgbAoPfr@aPuDeHqp@lTu^x]_FaJuMtEkV}I{EuIjR?_YuTpr@kHy[fQceCv_@dIJkPm\\\\kn@bb@ybDlHrz@mHf{@|McNvJbdA{L~b@~JoHlEpGk@z_AxKxTpE|uAeJvW|Lz[Mva@\"],[\"ven`@rl~i@`P_LjNloBpA~l@kA~M}LdGzEbYoG|GgL_VdAo`@qMk^\"],[\"hyo`@zkij@sCfRxP`^sHru@kOme@yH`RxGps@aWbBq@fj@tKpEV`Kda@zD~FnS{GjEuVsOdXri@mf@nb@wKea@oMfFyBwFbIk|BqGyVvOyKbL{h@_Myk@nL_QwBaRtT`KdGea@\"],[\"blk`@ve|j@t@}NrRdBfIlk@oHrFcM{LgInAg]n]T~MvMf@OtGoQ`NuHqn@\"],[\"ddd`@j{ck@X_WfSlA{F|l@~OgJcGd_@`e@nc@}s@jk@zBiYaJh@Ywm@sJbCuC_J\"],[\"pja`@ju}i@?o{@dL{KzBqX~JwCxUt^iB~j@uQpiAme@ve@gOkQ\"],[\"ff_`@p`ck@`j@sw@PlXp]fAbAx[p^~Ag@j\\\\_OvQhR~\\\\mF~m@cPwKeGhTkRan@iFqz@aK_HrCje@yJi@qH_y@{Jb{@sRap@\"],[\"~a_`@phtk@`PhCfCaPdLvJyHfa@f@tLrFg@yArd@qa@~M~Gl]eDdv@g]qgA|Aas@|GcSjQzBoJee@\"],[\"ff_`@h`vj@wz@jO_Ua[{Bcc@v\\\\wWm@wGsXmHyA_MnVgCfs@qd@wc@eKsPtLH_e@~S}a@fg@cLzRjPlBeUtl@eS}|@{XPaIdg@jDzAuIcFsIee@sCwFkJkBuY|Qo`@z_@fB|`@rXmU{b@zb@iFnGtTvPuVxNdl@|LeKjInGmSj~@rV}m@|XxM~@bYq_@xPlW|Hn@fH}k@||AaPcu@rCeRgP|@}K_RwFxN|Z|e@eSbQuNd@jXlMaFbb@_]zTsRsm@e@fY{NiDkIhFhNtHrBln@jRuOtHtPbNaE`FbUx\\\\fCqXda@q\\\\o\\\\pGx^fX|KmA|Qch@d]kTePsZon@\"],[\"lsa`@lp}j@g]vz@oGui@gCfr@kIcEcAcl@_JvZmVtItZvg@wErU}SdMye@oNyHxUu^bKtA}pAbW_PvEoUoHwCmKjOnGsXcPu@{RoViIzBrC{\\\\mPtTeG}]pDcYxc@cm@l`@rL{AsLgl@mV\\\\yg@jPom@lYtd@hQjp@{Fgx@h[Z|Kth@jC_Y~OpGgGoo@jI_Ftc@je@dI|_@}HfYyw@j}@oJnJiROjCvOt~@_VxZap@|\\\\eWtLs`@~RzIqG|m@jThTpGsX|G`PlKaPqGraB}k@{^oIwRaNh`@\"],[\"fbv_@ja_j@p`@jJvtAg_@`N`EzZgFxHhShjAmgA|K`CdSh{@_eA~iBaE~VfFdLzITz{@{~@jTpGxTkZhe@lElKcUzBtO}SvyAsm@ttA}IcIcj@po@{z@hGsEaTi\\\\|UqG{YaJ~M_Jq`ArNi`AvMqVcDuPmUoBgYv|@st@nGan@ozA_h@iRrBih@\"],[\"`ks_@h{`l@|^ktAjJ|Pk@hf@hSjEjIvc@eMdB}PeQv@b^kGfJyKyc@_c@jL\"],[\"vfr_@fmxk@nVvz@|MdLgAwXvc@en@rXzv@}@zNmc@pUzQvDlA|NucAxkC}HeBj@wJ~[cv@|MugAmD_FcPpa@?gr@kR`e@{ViBlLu[{Hk|@\"],[\"bd_`@`rgk@jNnGyAlReV`c@mLmDiGpFzb@pk@|L@wErp@tXnb@sG~r@{GeZqLl@rHlTqGzIhC`l@_JdJoE}LyBiaC_N|s@wEfBgCqNnGs|@iTje@yK{\\\\yKrm@aPii@oT{WgTg|@dGdlAiTqXbLdr@kPuO}GvJb^tz@R`\\\\eIrLpLbY{]`HeFjTzjAf]j_@si@l_@xe@wMtN}L~i@kJ|gAqJ`QoMul@kNs@yEaOsId_@kP~BqCvJsKcA_EoX{PaIiZ`c@sOug@iHlQla@~dEma@waAmUjFcD`eAwY`CoRcRfBu~@oNq\\\\bO_}@l[mUxRc{@a@a|CqRnBvKp`@kBzi@uf@e_@bJh_@jZr`@}EllAiQhS}\\\\eQiEto@cj@dq@oFL}A_TzK_w@dPiUgImWhBk`@tRyx@}Xba@o]~@gKdXaU}tA|]p@lc@{Vs[wFcXfOvPgq@mDwL`x@jPrF_FiK{R~M}Nfv@lXhc@eQaEmK{ZfAye@w^aOi@}i@d\\\\cJ}@zf@olAlPpGda@wi@rMmApTrKdI}IkGa]}e@lA}d@bW`a@evAeIij@rKcPpKFhJ}WlIxAdA``@tKvQrMeE`D}U`VnHhCmIme@wf@|L}NtJyeArTjTjTapAvM`p@`R~@bO`R{[`|@xG~YzGqXp^gCdJfCiR`j@hTyGxImTbLf]lKc{@f[|BxEpNoCb}@q]QjCbSsGtQrXzIbCyG\"],[\"v{n_@ddgl@_Hi^p@sNbK}FjKh\\\\bMkCdHhJ`Nno@yKh]wQsJ\"],[\"zog_@zfyk@`[ca@pR`F`IfQyFrb@oMbIuL{R{GvX}BtpAjTjnBkTaj@oE|BeKwf@xHas@_BqDkQbX}DaLtRad@mHgMrP}^\"],[\"rjp_@rytk@fEii@lPq]fGrIuE~q@dE|XsQxg@|GdLmj@j_A|Xbc@gChaAkNbKzCyz@oEcGyK|r@{PvL_K{H_FpLuMnBzJ|Yam@jm@jT{GYtQmb@xN?hg@c_@x[bMtYaMxrA`PsXjKxj@qHpNBpc@y_@np@n[sD~G~o@od@zFgC~MzHtEgI~Xae@?Spx@_CbJ{Kl@iJdo@ok@yNc{@tnBkTen@h]mn@`PicAlPnGhZ_j@nAkf@tXrUhTg]{M}RpU{g@iKwl@v]i[qP}c@aPr]nGmc@hT}ZoJuAjSsu@tFTPlUzGoGpGye@sNv@uDoOlc@qe@mAuc@rv@cnA}AsJqs@`^f@}U|J{NzQbA`AgReG?|JaKlVwBxRnSbm@qe@mWc]uTjTiIsImPvFmE{VdKmVdHbFlAwTjItC`_@_z@lArd@nGoGfHxCq@e}@bUal@\"],[\"vdd_@zfwk@rTzVGbR\"],[\"`id_@jcal@xKjn@qG|XyRiH{o@iz@nc@hfA_AjMyUmHoh@ez@re@bkA~ZrZd^ft@gEvMeR_KgJp`@ee@x@mMesAqq@aaA_GpA_AjWvDl\\\\oN`DiL{FsLgsAhKw`AkKmHaA{]fq@c]vd@df@nDv\\\\bWo^zTfF`d@~\\\\\"],[\"`g~^jmql@{AhR}YbESnRpMzEv@zP}IpRkh@mD{]f}@_ShB}HkS`^{f@}Lya@gEwuAdYun@`OeDxkA`c@\"],[\"``~^by_l@|Gxc@{InZ{GfGuMke@ur@hnBaa@|^aInWsJAyJoG_CcVlJ{_@rVqWhZksAl[qWb[xKhOo\\\\\"],[\"pk~^pufl@}Qng@_HvE_QsEwCdc@{Ui_@yRlYwLaQvLy~@ke@|gAhCkn@}\\\\|tAlJxn@gLfa@wGj@{Vel@k@cc@fy@ygBlxAsqBdC|[dG{GIvk@vGcAfIvL\"],[\"dcz^fwsl@sKjGaUmE@cVaf@qoAp@kOnj@~e@hQxCjNp|@\"],[\"`{w^j`kl@dG~YiTbK\"],[\"tev^t|ml@rOrLpp@liCmc@yP_aAaeBlKkT\"],[\"xgz^hr~k@fLkKjTzBc{AtmBat@zgCsHzL|Gaj@_Xth@yGiSkPlIyFcd@vyA{{Cfh@yb@\"],[\"|mw^t}rl@zWrS|Cla@pWzFjCpK_N`Oib@iVy\\\\in@fCr_@yJxDydBwy@`BcMzSFcAsi@qRwe@|d@nYbk@ju@hGxCqGca@\"],[\"lit^d`yl@jFza@oj@iN_JnH_RsFqd@wa@bBub@pH}F|WjO?cL~h@`O\"],[\"`rs^rxll@sUqNgLx\\\\uNwD{\\\\je@sCgC|TknAxOmAgCw`@bJtF~BsJ`Pp@pBkt@dJsG?sXhJwEjIrc@zGae@sDig@vj@~]x@n]ea@bnAuAb]\"],[\"jmq^pe`l@v[iJwAj[hVoLYbZmw@vd@mGbRo[tE|Kew@tZT\"],[\"ftv^buul@~AzGtU_Jpm@ro@eJ`E}YgOt\\\\hh@eK~Dg]g]?xRy`@hg@oa@__A~Bra@{F~Fu`Ayp@tLcVxJbGdMsi@_Y{SqBfUwPoH_RhTsE_YyOhKuPsF}Bz\\\\mPqGuIjUmKz@kHsLu@yVjKqDmAw]vN{M~`@{B~GnGda@mPfClPnQ_A`o@tZ~^n@\"],[\"hqq^|ecl@zTsHrMxt@g~@flC_PmLtD{NoGca@cf@pEJ{o@e_@~SmHiMtJgSvl@cNtSoSb_@`I\"],[\"tlk^ddhl@uI|XgRqIaO|V}e@kBkA}v@jx@}_@zi@jC@pJgTfQ\"],[\"zpi^vhxl@dRcPj_@nKaGba@jl@f[a\\\\~ZhaAcPbSfDbDzKyMb`@r_@_EdP|aAk_@vFoXsEu[{v@uo@}AkVwSkExKkd@lF_Yko@~BgIp{@fMkB{J}g@cPvT}Z\"],[\"tch^tg`l@hW{Vzl@fWeKpMfCna@mMrMwRmk@hEfn@iExKa[}a@uEsW\"],[\"jhj^zvrl@xf@hd@jIvzA~N|WmJfWqNcLs`@arAu\\\\_l@yFuCz[zpAg@lQgJxFaXoQkPc{@}Ka@mZ_Z{Gw|ArDwXvZ{[fTeC}Lho@l_Aqr@lTn^tCbQaHjMgR}@cTvU{ZxCfRdReJ`Jb[{E\"],[\"|me^xeul@|LeBdHlI|GvxAoWsGgQqVY{c@\"],[\"pge^toll@fSyTp]hKfGdQy^rvAsm@fR{c@miA~F_PnPZ`A|OcI~ClExI`VfCrMyg@\"],[\"vtg^pdil@NhUc~Bd]ci@}EmWzQqAiQ~IkL|s@uNt}Aqp@lNhA\"],[\"dvb^~ykl@~[iBvErDgIdJpg@qDgl@~[kKfRl[hbBj\\\\aDfJ~|@{FhNaIvBkF}J{LbG{Hoc@oJqEb@zf@mIrQp[pBjVf_@_Phe@{m@oa@sH`f@~M|ZqSfLaQwL]uZqNfRwDiPz\\\\uvCeD{TuWmX?xa@sEbI{OoGiHim@{HyEmBl]yg@nk@sJ}I|MucAbRqf@~XeLzKoh@zNaB|a@xW\"],[\"zb_^l_vl@cPpa@eCqGkT|ElKkfAaS~]aXdCnRgj@iYcAV_NpXye@|\\\\nGx\\\\qX?xe@jRc^hPwDa_@}ZpGcGjh@hSaDrv@kRh|AkGlEqGqr@aPpr@\"],[\"rc_^lnwl@jH`UgQ|h@}e@fQyG}KnGgVoG}Eu_@nVm@{QtKsRyHuTnlApG\"],[\"vry]lepl@`IkNbSrJpMrV}DrSye@tCiFaH\"],[\"|ey]vhzl@x@{PvU`MbKba@rIzBoGtTzUaGlA`GmCl`@oXz_@hF`Njd@um@xNdTxK_YlKjKtQMi@`M`X{QhP~HnCpTc]~EpRj^_E`n@yKmPqQdLaJ{Y_LaBY|v@sMvI_BwVuVkKzA{r@yKhC?yKu_@t\\\\m@zOeL}ByKrXqEwZyMwKlAsLfVkQuTqLpCkRbPmMeGyKxKqX}GyK\"],[\"jz_^~jjl@hFjFqJf_@eh@z^iGsNkIhhAoR}GkErXkb@jVkQ}^aPmIiCa]iTbAsCg]}OpbBgP~N?ti@e^sjAv_@kn@uKoy@xa@gU~g@gHj\\\\oe@l_@aSwMptBtWqoAtPqKVua@|d@yXfh@dJoClViUvBwJtOxGx[\"],[\"j}x]rrsl@si@{\\\\qG`PyOkO}C_oAjJag@nLnElDpk@~On@jJza@vRjUlAhb@\"],[\"|eu]jsxl@xTl`@wC`O~MaB~Wdh@xa@hYsDl|@_Pf@sVmj@od@wc@`[peA_ScMiEbKhN|K?tZnVlf@eAzLqu@cEsJsZgU`Kqd@qQuIsYjG_q@fFkFrGhEnI~t@zNaTmDcNlRaNnCax@oCoS_PjEsr@aVzNri@mXdk@^x[i]`p@kVtIeA{\\\\iTaPXuPbg@}N{VeYuCil@bDwPr`@|A~DgFgI_Vj]qSmSeg@uKsEvC}MbQWpo@piAiLax@vCq_@sKyOpBwReKmNgL|GzCcd@xZwB~NzLlJ{A|B|pA|UbE\"],[\"jdk]`r`m@vSpx@xPlAdPxl@uU|IoJcHkPnH{NmDwL{k@jBqQmLaHeGrEn@hYeKnMi[gB}B~Rs\\\\}g@mBq^qOhCf`@e|Ah{@ec@`DoMn[{KdSmYleAcRkTna@{UxOeBlLxZeK~MnB_Whj@ce@nUoIuQme@vKlPlJ\"],[\"|lc]x_bm@~Mu^z[i@tImWb_@zEqg@|_AaHuYqIRkEb[\"],[\"~o}\\\\n}ul@tJ}FhYvAjsA~NzCnHms@tlAcNvJgCeLfC~xAsXer@pGjgAoPwBgh@ok@k@id@cP`Pnc@}mAq]gINaF\"],[\"pz`]vvbm@oJdo@oMdOhCqXmPfE_TgMeX{n@jTvKwFy[cDgD?~O{S{d@jBmHgTiTgCbQ}\\\\oUmJqj@hTLlSzTsCy\\\\rVuVh\\\\xP`GjMwLhCze@fc@uJdJ{UiLfAfHjVtJfClPts@~RvAvQ\"],[\"bi|\\\\r`|l@nK{CtRxKy@|\\\\mcAhXbGr\\\\yGtDrHhClDrVhd@r\\\\v@jTiKfTyh@mg@fT~m@Tn`@_Lf^{a@tCw_@cXMiJbKaFqNuItEeRmQ~@rDal@zg@tc@HcIwp@ahAjT~QbL{@gCaHhQeHhFsSgW|McPoA|Fyl@zY}Uby@{S\"],[\"dm{\\\\b|yl@bObv@oIbQ}`@`Nu`@_F}I`[mc@bPpI_|@xc@oiA|M{FlKlFpCp]tIuO}DkTxJ}GvLfD\"],[\"~ak]|u{l@gf@jDcQdb@cPqG{Jx\\\\}Me@yKqYoSqIpD`[yHbVoa@me@zIhp@gHnG_VuMhCx\\\\kToGcBp\\\\cIhI_p@pZiOiB}OwTPmZxsAcz@dt@akAs^rTeF{Gui@`~@eRbAmN~Pk_@x@Hwf@~eAgbAsCkd@yKvi@ca@rObNsn@sEyFgUrG_Gan@_e@na@rfAkdBj[qHvAqLkcAaDsLmFlCwPgVjTwMkTaPfFyKoJyKdLiCca@o{@z|AcRc|@bReWmb@jBoA`YbXx_AmGuCqBnNs]wRCt]oObFu]_xA`RzuAcIxWeX`CsLqRhTet@e\\\\~Q_DtV_t@goB`c@eUcd@eF}Ceu@pQgUtInKmAmc@`]xIxZqG~XrXc@iScMoNgj@|Bw^_\\\\_CiOz[gLpfAtChTbTzIsB}Fu_@lX}Qd^|E~RdZ~EkMzp@i[`QhFpg@}Pvv@RtvAjR|n@bf@ri@iU|St^hVhA~Tp[pKZf@iPi\\\\iWoXmFwCoJdF}\\\\hO}Qju@_CyExk@bGrX`Ys~@b{@jKaYdLjOtGyFdT`PmCfMe[lV|@~Hxb@q]jq@|r@in@x\\\\dw@zCn`@qL~KcT_Prc@hz@iQ|\\\\_VkFvE`QhR]}FjUqNcFmj@{gAb@kSlQc\\\\mNnKiQx`@jd@rzAsShSgc@aMrEsv@aU}l@~Cvy@gHpHaj@cg@}@vSbVn]wAvOw\\\\rCsHmNca@na@fCin@kX``@oRqB|QekA}Bse@{Ilc@}ZsEbPl_@{G`b@qNxLwQP}a@a`A~WwWce@xD{Nqx@?|m@qr@{v@b]~}@uKdg@n\\\\_f@vl@d_AlBzPqQzBxc@rUzDxLuEfKi\\\\UiBhNrMN|HbLne@eCbExXlPkThc@TwZs[xDuFf~@pj@~J_CmL}Tl[gKrXdr@zEoRfe@m@cXnlA\"],[\"h}t\\\\boyl@tBaLbb@cArcAl]~DrWgTx{@gJz@qOwi@kLl@\"],[\"l}u\\\\vhxl@iAg]qInPgRdC~HyXsEak@xHwl@hr@beByCza@\"],[\"but\\\\xm~l@bEiGbPnIaAgXpKgFp]bCfEpWsEjXkPuEk@fJlp@bcA`KriA_I`Uif@pBeHkFbI{rA\"],[\"bhs\\\\fg~l@hVuExNzLxt@b}BaCd\\\\ySjJS_bA_^uc@vOl_A_Tea@n@v\\\\nRze@iB~Oo]x]aIoJir@WmA}PoHaHsKbEaC_I~EgY`MmIyEq]uSc]tQuOwPyIq@gNbScAmSoTlfArL\"],[\"~kq\\\\`pml@y\\\\xVbEk_Az\\\\s}@vGmAt[tmA\"],[\"vzq\\\\tafl@yUfv@ug@xk@aHub@xe@itAx`@bF\"],[\"tio\\\\r~ml@uDgVlQc}@aXvUsIg@aOeUcCgZlCaIbn@tA|q@kp@bJf]{Wbi@_W`kA\"],[\"n{s\\\\|`nl@zYsG|W~PjPfy@gg@pxAhCxh@mStnB_}@`Viu@uu@cPi}@hGeO|[sD~TkQCeRqRaTqHkAgGxYme@ja@cLl@qn@iH}`@kUed@ZyOsOxRsUlVd@jFvNrZ_b@x_@rC~Q{e@vYsSvOh@dHbu@tXui@h`AsBhc@_j@_WqA\"],[\"fxn\\\\z|nl@cp@|Gq]sUxAtL`YpRiBzPok@yJgCvK}Tk\\\\jLsa@lcAkpA~UpR~YdgA\"],[\"nin\\\\n~zl@kVsXE|VqRdIyI|SwOyKiNvTyKcAyk@lVdCmv@~JaNlHal@kKnGgCei@`f@quBbQqM|b@vB~V~Ppv@f@zEl_@|l@duA}E`Okb@|O\"],[\"nju\\\\twzl@`]rm@qXvPuPqMgCf`@oKsFoS|S{UyEm]dPmm@i^}Mda@i[sCExJ`YbZHr\\\\bSzc@oCt^uJpIqJNkTk|A{Ykd@yBfJvK`Z_YaG~Xfi@}GvKzKne@}GpEaOaGqa@az@iPcGrPze@QnZ_SwCkMxSot@dKuQkTmQon@nWsJ}[m^eHoa@bGqF`YjEtaAcq@~]bLcAuOlPrC~oAcaA`YbMo@gUrb@e`@tI~^lNqh@zXrDn\\\\cL\"],[\"j`i\\\\bc}l@}LiWaGiDsK|Fua@aQqMoWmO}p@fIsyAsi@yj@_Ays@|k@cMda@p\\\\rE{PdLoCjJjC|Jt^lGak@xs@cVmPdh@{UdhCkE|KoViAqCbMlAf^b^xz@d@b_@\"],[\"jhg\\\\pkll@uKtv@mu@suAx[agAea@fr@gHsXz[_wD|Q}u@{Oek@`RoPvg@|LzEjx@{N`F}L{DvVf}@iC`aAjTttB\"],[\"tee\\\\~k{l@uMvD}Rrm@gDbWnHpV}MiDiMtLmQkVtIol@_Zi_Bjj@cS~Rzf@wDy[bRk`@bK|O~RhmB\"],[\"`{b\\\\frul@zR{M`KbCfF`w@uUlj@q]~OgMkF{AkYxMg{@\"],[\"zlv[|mml@wQ_eApD_SbNqDbNlHzg@jlAeDnIkFsDm]pQ\"],[\"tju[rwel@`Pc}@dw@rx@`T`p@cFbVwDmQeHpGuGbd@sYm@if@g\\\\tDo_@tP{G\"],[\"plb[d}~k@|L_WjSs@hXx\\\\xXnGtFxQoSfa@{P{Kwj@vpAkb@oZ\\\\}c@hTgc@\"],[\"f}_[hr~k@~\\\\a@x[gM{JdtAsQl@{j@a^eEkIvTsC\"],[\"vt}Zp_sl@j@{RbWwB|dB`h@|Spk@~c@fi@|Cph@mJ`v@e\\\\zRuKqLiLwz@tEm|@}IsR|Gba@kTbj@nGv^sCh[yKs]{Gxc@_TiAqYpMuEu`@dh@yiBsBgN}d@rdAoDmMjPgc@gPmDxA}Z__@qAnGwV\"],[\"dl}Ztg_l@lJ_Ozn@{LeGvz@ha@zTkCrKvKuCtM~JlH~`@uEpMg\\\\bFc^mTsWicA\"],[\"zm|Zf{bl@vTf]tHgGjWnKvM{Gxa@z\\\\cn@d`@wM}AGzOu^fAr@cRoPcK\"],[\"hgzZfwsl@|h@_Wi@|Ze\\\\|l@kOlDiK{D}@gRzFuX\"],[\"fcyZncol@|FuHrh@bTvI}L~f@|S`IfPaDjm@oYlKcq@eOoOsU\"],[\"xk{Zruel@_x@`Zma@eg@_DuV~`@}~@jV_Ehj@nK|Mtp@yBdZ\"],[\"ve|Zfcnl@ka@g\\\\oT{CcRpZkNqZsHvIy`@]_g@sY{@{]nZo|@dXqNxRvPfHo_@bKaAbLri@jGuJGof@hXbTpLbhAvBiSfLzAp_@~bAg@dR\"],[\"`mvZjx}k@~Kkf@ha@{JlaAbWnEaFgC`j@bSwIrUi_@d\\\\nJpHp^y\\\\rXef@p{@}Swn@mWtZec@qH{`@m]\"],[\"lbwZtabl@{Jae@dM_Dhu@l`@wZnbBdMjp@vTzIBhIua@aEc{@aj@rAsm@\"],[\"vqxZncol@mCjI_Iw@wp@g]yO}^zEkMlm@j`@nt@j@\"],[\"lsuZh{`l@pTv{@_K|v@qJjFoOgr@vBoeA\"],[\"`otZj~jl@f_@kRhFfi@u[ndAwWfVyKKiGitA\"],[\"lbsZh{`l@nUgSvRuBpG`YvBfrBoQfhAsk@nVuIsDoRyu@d]iXjJkZ\"],[\"|}qZ|sil@bu@fTzCbJiTli@qU|Oqd@oFoI}KV_Q\"],[\"fbrZla_l@~KlExRoExIrUa[vm@xBvzAaaA`z@sA`LbPlPoLnUjPdHAtLkK|U_X|CgVeH}[me@rCa}@iWiaCrZ_v@pMqJtHjEzUoa@~[sG\"],[\"`ylZxqil@mc@uc@eLy\\\\rSumB|_@{l@tThl@eL|o@lPoPhCrQiC`kAdUzx@}H~n@{UkD\"],[\"jejZ~pcl@nV`Y~Bpi@}\\\\pgAkT{vBfDcS\"],[\"fxiZp}bl@{M{Z`AsRvcBsx@jRb_@mSlj@eUhX}Vz@xX_j@m_@fRiRn^\"],[\"p|iZdsyl@vTsP~F_YtO~K~S_FbKt`@k@~h@sCtFeUmJmk@l_@cZ}^t@gT\"],[\"p|hZ|q|k@`t@bMpEf^mV~k@a]wCoM}bA\"],[\"|bjZj~jl@|TcQvHtq@{Qln@es@lc@wMaV]yf@lj@uP\"],[\"txfZlvml@`MoO~VdG{C`iB_KfRc]g\\\\\"],[\"~sfZf{bl@vg@y^`M|sA~u@hyCeHre@mYd]kT_DoDyYqJ|z@md@iGt@m_AwFzDiGwW?_vC\"],[\"fveZvnsl@oDiU~KsDfl@pHbD`b@wYtLc^QgSuO\"],[\"xqgZnt}k@Fi[rQoMtTrcBqv@jx@gEeGxEqEum@L}NqNeD{gA~PwO?xKd]g]\"],[\"t~cZ`bhl@da@_e@lSzwBcWrYuHIkOim@\"],[\"pbdZn_el@aMhMeJ~s@kPrMlMg`@dJ_xAhPgLzZ{@fDblAaJza@qH~B\"],[\"z`eZr~kl@yKlpAc^fNiMcCaKyVzAcg@bSqJcj@_l@kDeY~Ji\\\\`a@wUtVfzB\"],[\"fsbZpe`l@rKgCdF|GfFrj@gLdVc]hCePqMpB}LvVcP\"],[\"jzeZ`gxk@bPmRqGqXrVij@wJkHqTvXsZdG{BkZpUyu@qO{IuSvcAyTfK_XkHuPmUB_ZjTmPcGca@`a@g]kh@rJeInUcJkCoMcr@vSi]rY_Pzm@ba@\\\\yNae@c^wH}SlQs_Aje@y\\\\tbApx@iBtu@mQ`q@rRmJfZaqA~S|Bb}Bt`EpPlcAwCnTwcArgAsFkf@eNeVjPu_@oG{\\\\}Xrx@~Q~c@ErTkzAtm@}XqX\"],[\"ttbZ`~~k@uYnf@wRwsAtTtB|@~i@xJe]zOc@\"],[\"rhaZt{dl@?m_A~z@pGsh@bwAob@hRkCcL\"],[\"v}aZf|uk@uKhUoJrA{Yq`@tI_WnZ{S\"],[\"x{`Zztal@oB|h@iWtYdCqlAkNgIiBcQ|YkI\"],[\"dg_Zrzkl@dD{r@lWoGrFbDuNxdAeKjC\"],[\"tp_Ztg_l@{Mkh@zM}DpVza@wKbUmQsA\"],[\"dy~Y|lfl@_P~I_F{HzC_e@}\\\\bHxc@aq@rCcj@`NoLdJhgAnPxAdYcNHtPi[xn@\"],[\"bh}Ybvil@`NwJnInp@}Qb]wGkP\"],[\"|t|Yv_bl@bLkJjIlH`Bs\\\\jQvGmAhaAeZzf@wVuf@_C}T\"],[\"lrzYzbjl@rC{v@zFlw@sHpJ\"],[\"|myYri_l@eIaTvOgAtXaj@dSlIx\\\\i[dWnMvB~Ru]`CiTpb@ie@jO\"],[\"lrzYlfuk@cJn\\\\oc@tDw\\\\uh@cCcc@xLyJto@lOlTuHtEbU\"],[\"f_wY|bgl@uRu|@lSso@wH{`@wGzCeEwa@uOEnh@{k@ld@kR?tcAcLrX{K{\\\\aClf@|Od\\\\|X_j@`Pxv@iZrUrDn_@yO|q@\"],[\"zfsYjhtl@kl@fC`GkX{DuWiUkCzMaw@zl@uw@nF~fAlVrt@\"],[\"breYdv|k@eMmMdBgUdVsqA|Tua@x@bt@mSx_@\"],[\"`s~X|q|k@dd@pFaIva@jFr]iU~SgRwrA\"],[\"nq}Xtyxk@bWlCaj@rd@eZdrAwo@~SjBm_A`\\\\cN\"],[\"tcxXvnrk@|X_HlNvi@eRfXeTlFfQi]iVqW\"],[\"bavXdzgk@kV|m@m_@ic@nLo^~g@YhFjE\"],[\"`jlYbzjl@fE|Ps`@lZaLyR}f@qLwHiY}FkAoN`RmPyKgAsVo[s^_ShOaPmZi\\\\xKgGa^cLmHs`@|AkNoHkVlG}J}MgQhH{NwQwfA`Gi^fMuGrOmr@qAyeA_\\\\i`@~N_NyDkdAkaAoXtEyRuJoeAfEub@a\\\\wTrLiEjOeVnCuToPrXyt@oQoS`MyOnVtBsLju@lBdKtJfAjLcMbOohAcUcXzZ_b@gB}D{VtIu]_u@|Meb@iS{PxAwX|TmPiC}IrGwIlMj[`V{ItLpE?pQlI|CzKe[|o@_o@`U}D`Vpa@bQgGh^i_AxKnGzMcN|V~@dRxI_EhVtOhn@?rr@nPpm@|HpHvV}C|ZhHxQiM`Qy`@rOb{@}Av[kQnUm\\\\cP`LdXvUtLbPoP`[xKvL{C~]em@dAmVhp@{g@h]igAjQoNb]n@xEnHmEv~@xJrEyJnt@vVke@fRfCbOqRjAwZlu@ca@sJng@XfLtGx@rAeZ|[yo@nPrFeLpt@vTjrAwTtI~EfVt_@wQjb@Mbi@kp@fMzGI`_@l\\\\af@xI|FdFz]oS`j@~]HuC~OnLjK]lQub@x_@xAfZxJtJ{Nne@tGbOnNpB}Zd[aVbeB\"],[\"bgpXvnrk@qR{Ieg@vHwEyJ`bA}f@bIvS\"],[\"hrf`@foqk@eFgg@lF{JfW`UeEfcA\"],[\"bf_`@r`~i@cOyv@xHoK|b@~AlNnNEjr@}c@zbAmJqDkAeN|HwZ\"],[\"rdg_@frcj@eHma@tEwF~StQzUnn@eGhg@\"],[\"xm`_@jtll@bI_Tl_Aa[zBnKoc@leA{J~D\"],[\"pt|^vrml@pJvCnNiH`VjK`B{UvY~DpAtKkVva@gU[mg@sP\"],[\"`~s^lu`l@yUo[hL__@xc@{K`Uh@_BbLpVsInK`EpHpNwB|Pke@vj@}O\\\\}Mr`A_a@jUmKmGkHwg@\"],[\"dui^jikl@|b@af@~L`ErDjQqG`e@uW{@cCpJgRiP\"],[\"tdu]lgwl@qFiZdKiVrf@vl@tQaD|ZtL|Yri@sGlnA{R}@e]q\\\\\"],[\"h`h]fsll@kq@`DuSpTum@_YlLoJxIua@|r@ug@vj@bcA\"],[\"ppd\\\\d_ql@nHilAta@]oAhj@zG~OpY@f@dMeSzQmb@jHsK?\"],[\"dyn[|c~l@mCe|AzJkPf^gNjRnEfAnQwb@d`AeMrn@\"],[\"~tmYjgil@|Vc@lNaLxGrOaEtOkb@eE\"],[\"rojY|g~k@zCuTvS{DvHzG_Qxb@eNbDeFqF\"],[\"p{`Yv}pk@bWePsEa_@nf@gFhLpVuy@do@uIy@\"],[\"`q_Y`xvk@pAmh@bKg@~@bb@sNpe@\"],[\"nflVn_bl@lXsAfDdTeVxT_d@~B\"],[\"jmqU~i{k@yGaJ`Rw@j`@cm@{I`\\\\xVdOu_@nS\"]]},\n" +
+	"{\"countryCode\":\"CM\",\"polygons\":[[\"shi@ic|EB|~Xo[hiAq`@fe@_o@`F_UgKgsBiAwvBc_@inAeAiu@}Nkr@w_@_nBnUwM~ImKcPoG|Xw}A|aA{~@vuAuIc\\\\cf@ir@wDo]wD~RzUlq@Hlq@qOBcDaIqrBb{@je@e]~CyQI}VmKf_@kWrLwg@}RbTuV`B}KiNiErXea@sXzB?qGsQdj@sJbCaPav@gL?tIf}@oKt[g]cLinAufAbY|^yKhCpr@ri@xJr]kApKcMEpGpGiE`K{XpPwMqBsE`OlRpPvKgT`k@a]zJtGmExPc^p_@xLvHdNkTtZzB~MqOnGng@oGzi@mHrQiT|Eyh@wMlBnN~g@|^eAvWkZ@wExGRtl@yr@b~A}gAkK{}@~k@_xAbN_y@qXxR|Tch@Su_@ba@hE`Fh\\\\kRxVUib@fj@tT{GrNfNmWdg@}hAff@uWzBvNfIbQeCxkAeXzLiRdOyBfLfzAcJ~BmMuMsBnMfR|L_KvVcYFn@yHe]qOlEnOuVnFse@gd@{}@eB_PcT{]fEeUqUs`CwcAcUs^}sAwO_]nEqv@qTod@lBe_Agd@wbAzs@o]yYep@rI}l@yn@lDce@e]}Ew{A_xA}cAutAodAii@{Ner@mc@{Qm[}z@_l@w`@aIgw@ovC_o@eLuJpIef@cEsMohCufD~JyL~l@_HhUsRxAgqDyV}AqW}UkfA_Hig@yOtMqJv]iB|GiJr|@acCtoAo[zR}RrIgy@vOiT`X_K~mA}A`mAyUvJaZD}fAcOmfAiJgKi|@cPeXcy@oSwMwy@eUe[l@yWxPs_@yMegAupAuBk]qOoE}\\\\{b@uCqf@cMuDuNvFgfAlv@{LfZqbEypDq}@nFsYeEcvCekB_rA|Du_A{`@iuBbE}X_[dAed@cl@kl@ueA|TbEwa@sMic@|Ien@o`@__Agx@i]cD_g@mQoLsoCaT_o@xBofBun@oj@lCsK`EyCvSkJkCwV_m@qc@k`@gi@edCeaDci@wi@j^s_AaT{k@bIiTcJeL{dAa`@}m@m\\\\iJc[bD}iFwy@e`Eo_C{t@oE{_@yg@w{@e`@scAecAjXuvAs@yYo_@sh@eFm\\\\wq@sv@{eA_lCmY_M}w@gB_q@tp@{l@o]igBwUeq@fMgFeJkr@kHu`@mRyHnIlEpX_L`CiVva@{GoG_Tx\\\\iT?}G`PkSjyDolBdFwkIheA}@y{E`eAau@rz@bHra@yc@`_@sBq@cHhNuB}G}Y~Yy[k@k[v]zChIgFmPwKn\\\\uToBo^pxA{Z`WzGfEmP`jAgRriAlEbPoG`PnGvd@wh@cIk\\\\~MoVll@?bX{RpDpSdUmHpNjLtNk@hOgUti@mRtT`PxKoGzBdL|d@dCfpAkh@tfAzm@fp@~EbPqG~]rQro@pFltBwGh{@kZr`AdGfiBos@`c@~IhZuBpTaq@|`@iMbWgVrr@kM`nAcuAx_@gVjwA__Cfd@bvC\\\\fb@u`@`hBo@bd@vCfa@nUhi@_JhsAfWlmA_m@pnEtIv`DzmAte@leAteAdu@~d@js@sw@njE_qDvm@sPv|EwtGr@q_@|Uk`@|ZmXj`@kHfSo{@|eBkqAluE_dBjxDsz@nTo\\\\zF{m@~cBtGjxB|oAh\\\\iG`AnQnPdJhdAzcBvq@hVpo@Q`Z`_@xfDn~@ph@x~@|dE||A`n@dEvd@h`@pi@bxAEzXv~@f`A|U`Dq@nNhHnEbj@gd@x]wJfHqg@}I{`@nc@kSjnAqG`pArXreAaPpz@jNjcApl@~PkKrV_t@dd@oTtgBK`v@eP`z@aHt_@vEpvAsObo@_f@hqA{dC|iAuj@fjBcPhGaYrTwHvEyXxEm@dSnq@{Bht@tvAyc@fnA_u@zuK}~JfEgoAbVqU~p@iPf@g]hgAkh@pOw@bo@jWjb@_Cb@}\\\\tWrKhn@gLhn@fLpjAcR|dA_h@dRfC|MoPrXf]aJtJzBrUza@`Lzw@b@hRdJ`VUz[yUtu@aTzmA}K?rXhg@`[si@|\\\\i]bDmEvj@mMdJbFnUaRzGsUnl@m]|VqMbc@kZ|jC|`@|yAyLjUuVzBic@vr@lEbQiF|RlQfGmB|KnVxYq`@zy@`PxOKhe@y_@gHsb@l^~WzHnEvn@iTbA?hToG{K_HzOnPFuTh]oAhU}HiDca@tr@lYlHnThfAwQx]kDpf@lJh`@mDv~Qea@kAoOrGiQ`YtCbR_Kh]Y`RjRdXxDp_@kKpgAzJrb@{Jfd@`Plc@_d@thDfEly@sPpf@pTjiAKpnGmYfdAtL`~DbUwA\"]]},\n" +
+	"{\"countryCode\":\"CN\",\"polygons\":[[\"makSqjdo@wm@jy@ix@xYw~CuBgsBjh@at@sDgp@ekB}c@sl@u[uFy[tFmf@x`@uYfjAeb@z^ma@xt@u_@fqDw[bs@wjCzs@gz@pf@eeAhJmdCnoAcp@kD{`As[eW`Fs@nc@lf@f_AeLp]_ZdTu@~W~Wh}@wKv{A`ChoA_b@b_Ar@jvAgd@|~@b@nlAic@~k@sBvp@uh@du@mVdaAmShK}q@{GgSbE{AlJjRbVzEpi@lQbQ?z`@jL`VbAfzAyKlP}fArMuUlNgQrtAcb@b`@i^[{Xyj@q\\\\ZqPuZwk@n[oiAsJ{XvEypBfnAap@tjAgRhp@}Fpp@|J`f@xOrHk@|d@gNjL{zAhSqThg@qK~hAg[jz@nMllBzf@z_@m_@~Jcb@zd@Wzl@yVlb@nS`jAke@bh@eZvy@iX|GkEgv@}`@cZkFew@zFyS{h@k|@`@}Hjr@kb@wIm|@k^_d@gRahAmh@sM{Lel@gy@ddAga@rbAs^h`@}`@rBgfAmp@kP|HaUnf@k^gPqIhMe{@g@iPvFuR`k@sP~JibCfKkTgGq`@w`@kn@p@og@dn@oAzf@eVn^eaA|aExIneAkKf|@ta@jWtHl\\\\d_@mLjKtQq@~z@qb@xgAmsA~p@ep@Hkl@la@o[wUyGwx@sZiIkv@~gAeo@dUmg@fh@yPlBegA}Xs{@~EcPq_Cmk@cSaU}^eoApP{U~f@kWxEqVkGyXm]e`@yFo_@w^}l@mSgf@ivB|F{fBep@gxAsm@ov@us@wvAdBu`BkSbDue@ph@yw@_]dg@kgBdAwe@qKam@bM{a@_kB}rDwJyo@bLkPpYeJbn@uFjZs]`g@zPjf@gJrR}NqJkw@`FepAoc@yd@qSwv@xLk}@ul@_y@lCgQvw@aX_y@{dB_pA}p@sRc`@{gBaWir@sfAa|@uAyi@{y@kRuzAhSmdAvBwlAkHcw@vWqiB_IwzAeh@}oBtMowC}o@egAmdAat@}Xim@uIkEib@vK}S{Kao@a}AyM_cA_a@mWu[c|Aga@ybAkDwd@}d@ieA{O_bAw`@_u@vEybAiz@shCqFitA{NkUil@mQcNyQmL_v@l@{{C}Fy]u^yNwX|R}f@|GsHgWuSaK{yBbl@cb@}Goe@`]wx@rJcjBi`AQot@ke@eeC{VpBqc@thB{NtJmPcGcaAo{C\\\\}XxRuk@kFeY{UoKqmA`[{^~`Ayi@a_@cg@qJayGxrCue@d`@_o@`Kkl@pg@mcAmTwiCvYs|Ak`@i^nPoJyK_RigAmOEu_@bnBiUrj@~Lb}C{a@|kAaN~N{QoHioAgxCzBggAsKuk@Fg}@oc@qa@|@cx@sYecBvI_aBggBamLea@_lAdEim@~QeO`eAwP`Har@zUwm@qu@owDxOi{@vv@wrA_D}f@e`@m[{k@kQogB{IgKdI_Rd_AsH||AqF~T}WnUs|Bmq@ugB}gAgwDsp@csAiaAyyF{eBibCqzAwj@?qi@_LqQ`Dwz@qM{Fi^jCgp@vWwk@xOcqAto@}iBdy@_mF~DueB}Piv@kCibAjCo`BpM_}@yGaxApUsi@rQmKdUf@p\\\\hTvKaNkUgmBc_@kd@uJgl@qjA_nBkEoiDinAkc@kPi_Awi@aKkcAjD{bArt@_qAwEcxD~p@_dA_\\\\adAkN_gCuqAo]sg@wL}iCbFgsB_Kea@q[}d@w@_lA{XasAgf@gXimAqzAqi@~Fwa@m^{l@tk@aYjDm`@iT_|@ogA`GmgAkVawAvc@qpDkI_n@ySaRa[{`Etg@_K~k@nGjNm^pUGv\\\\hgAz^zJnQkc@d_@sNlQau@v@mi@|\\\\ms@tTvAdm@beAn\\\\cXzDup@v]u_AHoj@bNij@xm@{dA\\\\wt@~]_f@tm@lAbk@{TtLwWzD{l@vd@c{@?sdAreAooA~HekBse@wsAvImk@{DwgApEyQpc@aFhJsZpZmPnLqi@t`@mP?cuAoKi[iWuFbAe|@fQkK|WrFba@eRx[iq@b_@kpBfUzIzs@{`@j[gd@rv@kCbOkWhj@pJzvDcwCfM}Q~Dst@nVqb@dq@c_@`_@~Ell@up@fVwEzbAhKrPg_@`jCxdAdYvWprA{_@ba@m\\\\lx@aC|PbIzYzh@tzBfaC|`Dhd@`l@{~@boAaZnWwj@`WrD`QwH}Pu~@u@ar@hK{o@pZy]`Nog@tHkhA}R_l@zm@uz@fFsoAqHolFlg@}lB{Dqh@vLquB_YygFxS}{@c@{hChOap@cAo{@rQwp@jAm`A|n@}tB`}BkdCnL_qA}DalAnk@wz@lo@ce@vCgx@vi@waBzx@ycAhv@srE}Nq`FlkAzm@dwAhAvVqvBjLsNbv@u`@jtCq_AniCuvAbdB_YrqEutG~rBmWgf@eiOljCwpk@eeAarItzAanVmH_wBrCkfAxLmeAz`@c|@nqBm}Axj@yu@pMod@poBqhShxCeaKsdA{xNvdCqAjFcqFtc@}q@gEsXc{AisBmYiaBy`C_~HevD_qPygB_pZpL_xAq@mfC|UwaDu]weBnLquDsMyoCi}@{pB{p@kxC_Ee{AsZyp@wt@weD}i@uYcbAgbBseA}k@e`DivDskAgcFiVgf@{O}fBmcBisBiAox@qQwu@cw@yEg~A|`AmnAt~Bww@tw@cj@`Xqb@dGeZ~p@ie@lG{d@{OssAifAscAgGuiD}sBwl@o`AkLcs@wMwRtIi|AZw}Dll@mdAlj@ye@pc@qdBhk@w}ExMeeFzN_d@nBgl@yPii@qvAofC{JynAqTel@ui@od@cjBogDq~@cs@mr@sRaY_iBj`@grC{KctBE{bCgd@imEiiCepEQ_y@kS{m@{y@ar@oo@xZaPyBkzB_yAwjA}gBag@_ZsVa_Bgc@{x@tKqxAtDglF_iAyRq]aZg\\\\tJmCugAsNic@ji@sb@hKc]uAslAqu@k_AcKq`AyX_p@kEet@wZo}@Lqh@xUytAAop@oK{b@dQedAaHaT{h@_f@qC}QnCcL~ZmM{O{n@zk@sj@rb@}mDeIgrBhFkkAlNkb@qLsd@mYeYqAcl@sc@{_@uT|M{v@kKaRlLaOkHqFpZmr@jr@k~@`Iug@v`@ip@jeAwF|e@oc@t]c|@``B}CfWo\\\\mUwGxCc_@bmByrAn^cz@pdEgbCtkCoMlj@mDnwByQ|{@|Q`bCiCnaBhFd]b`CxtC~a@tw@vp@hn@_AhZq`BryCih@xyC|WlhEqOz~C~a@vbBb|@rdAvNnc@qLre@q~BblDuiCle@edAsqDyRyD{gCdMkVsGesCkmCeSkK}j@jEwrQasK~|AihFp^ayB`DohCn~@uqDhAyo@sAaV{SoK}KaVgfAc`D}\\\\qXub@wxAaT}K_Ias@ic@uZyd@}kA\\\\u{@aW}pBtFsh@qCi~@yP_hAoo@ibAa^kPurAjEqTyRwQpDqHhpBqGgBgCpW}HfAyJyIiAm_@_RaViVaLqLpGez@uYgZaq@gQgJeG}^o_@u`@ga@kJoZzIiRaRwVfE{l@}t@uVip@_M{O_NCsD_Rik@gNwHlCqGaPaP`P_IqAQ_`@qPtDeRqG_b@a{@i`@vKqG_c@yZsQ_[lBwg@in@sZkAuf@kZmDug@cbAa_Bcg@}yAeUuPqC_]}LwNqByq@io@qf@wd@nGaj@er@wZoAsX|RoTgCqe@n_Ay\\\\|Pux@cg@m_A_To{@vgDlPpwBbPdt@}Bj{@cUn[}SViTuKii@lKw`AoyC_xAiiBov@yeB}u@a}@k^scAuOi@mJe]cE{oBhG{{Ai\\\\}tA{B}iBma@qoAq[_zCTkwBwWmu@yOq{Ab[_t@qGoj@wDyqF{SgxAsBm}Aea@{|@~Byu@`ZgwAgYiGm@gKtLuSxKlPtB}Lw]gl@f`@uu@lHw{AzWgjAb]g\\\\|`@g`AeOwd@|@ik@bNeXfp@u]nOwXfS_hA`Bm}AnH}U~Z}R_Cwq@n\\\\}i@aEiOsUrIwRqJuO_mBpBq`@zkAeyEtX_[iKq}@dW_Q`Yas@bUpGxOxc@pT~GsLivBhJmOnWiAvs@mcA{IqXjC{j@`LaIbPbj@n\\\\}SxOrt@jL~Df[_JnKgV{Oga@vd@}oAdh@nFjAin@~t@um@|d@zTpXglAp\\\\lnArPyAl\\\\aeCxLoN`v@~XvEp\\\\bTjPj_DkaCjSqo@lMlJ`^gI`d@f\\\\nYqpAhOkMr]pV`XwCnFcw@|HaKdQlFjM`k@d^fJnVaVC_NkK_LsRjBoGyGuCol@bPoBhv@jl@dyAsObx@y}@`j@_eA`gAwn@fVuh@jn@_@r\\\\ka@f^mMlMl@lv@jn@x^mj@bTCd`@nPnQ}Mdw@orC`p@vKj`Ads@v_@yCt^wWzo@`P~`@_SfM_aAlPiUbp@wMhn@m}AjAgh@yLo\\\\hb@_}@xC_qAkKoj@vEao@sReWsD{gBdGko@qU_Z|[cpAvSj@jQj[vRwBoDmiA~VkiAxp@}i@~Fi]gYa_A~]}kA}PcSma@mBhAu~@xUm\\\\pf@wAjRiKdIsbB`p@cRxPi}@fc@?`Pop@nYpCrGeGrEy\\\\xqAgmChJu\\\\_S_vBfB_OfPiLjE}`@yIkrA`~CjyAza@qw@zo@{BoI}v@jG}Yhm@xHry@cr@lUpBpVl[ba@dLp`@xo@dK`Fx_@aFfoBepCxf@uTb_AAhU_r@rBiq@mSkaA{SeaCdL{v@nd@uz@uTi}@zP{bC}QsdA|CetAcUuv@}E_r@bQewBaS}]ca@k@mu@gx@g^vCaK{GeD}\\\\tNw_@o@kRqw@isAyQox@g^_d@_KiqAlNso@tBijCeJeUy^eTcOwn@ee@ii@gPymCi]ww@y`@wlEzXat@{Iqk@|k@}i@vLcbAfZpRts@vAlm@j{@h_@zOzg@eYjJnGnWyj@~e@uJ`s@iy@|Qw@re@xp@de@jEzVnc@~o@bi@rXdl@sDnj@|Jx~@rg@ji@fPle@nj@lQz_@}YfSrCna@mVhSzQdHpl@xv@ja@lm@~Bru@x[t\\\\oPzaAtQzWvOf_@lt@zZrElc@x[fOkDhJu[jU_TdK|C~Rbf@dK{EsCwLzGgK|EzLxYmD~Ln\\\\bGoBtOfR{IzUpa@x_@vIn`@vo@kSxoAte@c@hXnd@xRqF~StDtV~c@dV|XiThEpOvWvG~JlPjM_[hGiAjNvTdL{KvF`^fYxGBdWpp@zxBjm@b\\\\vqApRhZvA`v@qQv\\\\vPhp@zqARb[qRzq@glBj_PiOz`@mo@le@~A|TpPlSzv@xe@nIrz@dTbMpXwIfThGfoAplB}Bd`Abg@r~AaInh@pEpc@b\\\\vO|Kbx@lI`Az]}X|Pc^pg@wZjgKe{BxVd\\\\xX|Elh@sErnAj\\\\hwBqLza@pR~q@_fAjk@cCnUrS~o@jE`g@zi@pq@iHrv@`aA~VnAzr@}PxGlp@v\\\\tRuIhjCxXrTnCv{Adr@`oBdWdP|JaNCq}@~a@oi@``@{F~Vp`@aj@|GcFlH~N|[rH}HxKpJsFhLuVdAy^h_Amc@xe@wThKelAmGaU|IaKrvAwVyR}LrDfLxeA{\\\\hm@vF|`@lK_PzGpa@~lC~y@lREtOwQpQxEjJ|TfMmLbLrOhcAvC|S~NoBvdAzUnAbYx_@uk@d~@bG|\\\\{Ix[pNoF{B~NfVdMnHhi@p^CpBnYxKiTba@n\\\\fDyPdHe@bk@bx@wAla@l_@xVjGff@d`@xMtDzUiL`qBzIhF?j~@jQdZ|Ave@_VfuCnWbmCzPzGd~@cP|L_ZdpAqi@h_@kx@|n@q_@xnBhhArPvWw@jY}G}G{B|G~ExWoExIuLiArCbU_c@rGzK~O{KvQfEdf@nQjSyHjsBtIfNuPtp@uS|HcArsCmEnUoG}KyFdD|@bVeJjV`QbPmGzMhDhLsHtQ{\\\\{m@_Ppr@sGuCuIp[oTfE}@gPk_@dT_Tj{@wTdI`ItYnV|L|I|a@dTtKsXhe@Pz\\\\tTi]jTtc@Vb_@h_@x^v_@mErnAnn@pr@_LtCnTqLoGG~PhJvRjyBxfBdo@j}ApJ_Hz\\\\hTpFxPv_@xQlRpg@EkQbHj@tLvk@qKz^hPnf@}Hde@rVpo@hV~V`YsVvCll@wMzVzc@vNbGjo@tW`IfChTvTnBoI~iA~SfVjq@joChObEn\\\\gOlBfaAoPzW|v@dz@xMfd@pd@|P~Htj@dYxk@tRzLjqA`vBto@}@jo@|h@bx@baBvDzJuJxQ`Y~~@_RfZ`Jba@eH|p@`KrTuF``@_]bo@qXoGtH`OxiAvWtHrPcQf^nk@lt@cK~Jap@kDzBxJ|PdFlGjl@iTpXtD~H_IfBdHbId]oQrDfe@lVqg@jPt[hHfz@wNvKpHd^qBr]|GtRjIuJvVbSrBxx@qFjMzb@~i@dYfx@sGzWzg@xd@kKbOfRdCpWnt@pB|l@nd@laArSbMqB|HxV`F~s@fmA`YpEbGkc@h\\\\vfAtWnB_A|`@rOb[{Bba@|SwTaPd{@pa@wi@~Q~M|@rMyNvUw]jWcDz\\\\|PyGuCrc@pGvZ|AsK~ObApMvg@`RkaAd\\\\u@~AvxAb]hi@}AtvApOtb@oEx^hM~J?_Pda@vg@eCnOiXbWkLoN{i@nNsPuV_GlAcC|e@qVyRyEoe@rG_XqPwfAgc@@_JkXlQi`@GoWql@g`C{QyHkJnD{WlW_Ix]kDqHyKzBiHsRmP~Y}Emw@{U}a@uJ~AsXi]fCac@uT}`AcJjFrQlr@eDdwAbNgEfC|vBeNdTwiA~a@oGfr@vKz\\\\wOvr@kj@wr@uIwxAsIaLqGfCmCmTbR}TmI}BqGpGgOcNwH`Z{`@|]uHaP{[tGsGoWsVgOeEcq@w^_j@`@}v@sZaUoOag@qNS{B|\\\\ga@c_AaNgAaMcf@gSuEeRhHcXq}@yr@aHak@q`Ac\\\\rCcAa\\\\qM[yJ{UmQqGyXjOyTt^{Npm@qK~FmQ_Ra]jDlLnVgh@ll@{t@vzBmgAvNaKsSuPsD~d@jw@|Oo@vc@hXvCzeBuVjg@aCrd@mPvKcOxi@~Wve@cLpXzGpG}KjK~At]`d@|z@rTGjR`ReQrKEx\\\\|t@nYfLwq@h[byBlm@xOro@viAxVhKrJja@vYdCnKh_@jOc@aDqXrIw@fXzv@ro@}@vTpSjKnUfHjnArSx\\\\jw@jaEtKzTxV`Av\\\\~tAbJ|eAdYhmAhSxHfZwEA|f@dKjVtf@tp@ftArg@PtWpN~UbKGyKgTtTpG}XuSp@{Jdr@rNpd@m]r]`OpJxMtEhk@bfBvhB~c@~v@zBxRaj@in@xKzv@sXfCve@ro@wDjmBjFtx@hNg@ry@z}B{Lvw@yiAfz@oQpZ{Ivi@tJ~wAdu@doAz@dYdZqUfu@{M{Gzl@|GiCzhB~lA|uBhXnDaMfqCy|AfbAujAbVi`AlWkUk@qQxMa\\\\zQeFe@c}AfVupCtWyy@ko@}dA{D}`Ap@yp@pa@ucAtWvNbNqR`k@iXlHk_@~TqZdd@gNjs@{mAp`@iIlZlAaAvV}e@~{@}@r^pJfSjoA|h@reCzNpr@kI|gAerB~XowAlIi_BqJas@}FyxC_|@enA}y@z^iI{Fcq@q|Bsk@{nAkjAisAoS`IyJtw@sEo~Ac`@iz@qG_y@kl@spBfJu|@qGsXnEa]~FkHbJnFdHqFpQig@dT_PvD{`AjFqEnVnJtGoJbPxGzSgVqAitAiQoh@cPxKrHwW~NsUf@rR~PlI`\\\\ug@f_@yQjFsd@dPwSqEcaAoMbYnDh\\\\iPw\\\\fK{{@s@k_CzAeKhTtC?{ViD{DoVfKeb@{\\\\iI{[kJ|AnGoPoGuOrQyQz]vLxg@i`@{WsXa@gL|X}^sCmpBpGgElEsr@sIcYnDkn@|TvDeEhMzWht@~]}MkNb[d[oEdr@_j@rCba@rF|E~P}EwEdg@nH`PpQnFoZnIhg@|I|M{KvZba@dJuLaNqF?w_Ar~@pClPxN{UiBuAzGlJdY_@v^d_@cRxDlb@dShNzBbU{O`sAa]gF?cj@{\\\\n_Aod@sIeDnGnD`Idd@lCeBj]lTjGrJz\\\\er@aIqGxkAzCbFh\\\\yTx\\\\jeBpa@fc@jKbp@uOwTbFd\\\\jIbGnm@O_PlRnGti@yKlQak@gdAtD|d@`PxTqKp_@lW_FbSxIbAxZ`MlR`H_@zA}LJtu@~Yx`Brc@lo@}Gx\\\\fXrDwFdz@_N{AcRvr@hTn}@nn@DEwPuf@yk@`_@uj@vKoCkHqUx^`PrVqGfChZnX~CnThRqa@rXgMoBkDtN|Adb@`RxU|XfPjb@iUqBrd@rGnJ|fBge@xWbo@v@rn@zLrHj@bf@h]`jAgLle@_pAoj@wTfW{\\\\fI|WpSzQa@xFwMvH|GmMri@gMw@rFr~@mIpKxI`FtKqMdo@dK`^gp@vi@gCdDaMcHcS|OHOiGiViYpIkJ`LtApR~k@j_@d_@qa@qGvXf`@`AbWfc@nc@zfAlU{ApS}PbSbInQjD~CpRcU|SfTh\\\\{@pJvZgQhOcMwBfExP~k@hi@{SyAeF|H`Vxs@l~Bh{@x\\\\jZvJjs@ffCdh@tSntAvf@tP~i@qE`n@dHpk@gGpJ~Fd]}@G_JuXeKzAqr@aOyT`PsXtCaa@dR_SdR~If}AmvB`Ewq@p`@y{@xFcfAfq@uuA`h@onBh|Bqi@`n@y[ruAgYpPnGpMyRfd@mJv^oa@hp@gCdCnPlqBe}ArwAoS|RmPvMoa@dPpK|SiGhAhG|h@_o@dQfHhIuOve@kCk@}XvJwPr|BsDjb@v_@jh@_DuTtKvObEvVun@nQ{ApNmSfj@}wAvVcH{DcKjFeVnq@_zBtQaUbsAuQtJpc@df@uV`BoO`^mJrBsu@n[{lAvx@oxAvaAwn@rVDtk@s\\\\hPeDtKvHtH|{@ac@hbCwwAngE|y@`jBkM~m@`Bxe@{QfV}\\\\OiCxL_lAxbA_a@fmBmBvxApb@voA`o@`z@lFbZ_Ctx@iPlp@c[td@kbAzp@qy@eE~JnLyh@jOgTbSmPns@nC`h@kWd_@rAnPbP{\\\\vTmNnl@vUdMzY`@qWaXe[|Uk|@pUnNhUmDzW}r@ltAg}AvV{lAbA{|@s\\\\odAkOsfAiWFiAyG`TgsAgCqt@lKwd@n|Aom@zJrIkHdb@wUnTn\\\\_HpUw}@vTupBlc@spAts@u}@vz@gn@tuAelCxs@{nB`qB{}AbhAcm@~h@sOjXxIbc@vcGt]tqAdg@do@vVrpA`l@~q@jEdm@pNx]`t@nh@|^{CzK|ZtOuKvBxOrf@fb@fFvZ}SzSiSrDoTh\\\\}DzgCpGn]tgA`_@kNtjA~|@fdAc@}Rao@sz@UeLvVga@qDoTqiAch@iA}iBhGyQdbAk\\\\fKiUrcAb_AlVu^a@qr@xK_PoMhDiDxz@eQ`Yu|@u`AtFye@tPmHnHcg@yXo~@yk@ufA{YkXcMg`ApMalA`Ss_@rl@go@~|Bu}AzWgm@hJnE}Fo[`a@c{ApR}K?kjAgIql@`KqC`GnLf]jqAdk@nq@YdQr[tEra@tt@fw@vv@zJfz@kDjJjYdZkWjKnNfi@vTmFlLdPx_@gHmBaMu`@cHsDwNjPdGnAuL~Gf@pNhTzLqCqO_\\\\_c@}V{Kul@cZsi@zKwK_GmSzRfCnQgL}K_Ty[oS_IfRtCbj@}a@}^eXyiAv\\\\sa@fNB`JrQ|FkDtGrWdn@{d@rXpGgKbL~DpGfj@~BrNoHdB`MpGiC~BpHrTuWyKoT`PuIvC|VxSqAgC|G`P?vT~~@kCpa@stA_HqGqXmHdElHjRcEdFk[eFIdGdHnPdaAyH|k@f^Szk@mThGur@sHcCzGdBvHteAqA}gAba@xDtFfJuF?f]dKqEz]tRvQZx@xFgLvEpUzM}ErN|EhM`P}\\\\`Pda@gCihCna@bC}@to@lX~AQiIcIrDcFcJrDod@aFiNjT}\\\\xPnUrS_RfC_ThT~SmPhp@yKdJrSjImOt`@nPtNgCeG~L_GfOif@wA{QxGiJvTjBrI_\\\\xKhTzGwO|PtZfO}@dUbiAeFv}AyW`e@uFv_@cZtMcW`l@fS{I~G_\\\\hWuNhVqi@pLJhOdXaLsaAvGgIcHgStWe{@pt@uPth@_ZnHvj@tFa{@lT|Mtf@i]bSa@|C}Lpr@fk@oZ`BcLlWoDvi@|SdV{C|O~KjG~GqMlGv@~Q~^tVvEsCyKdMHbPrPiUdc@a[jOeGxUqRyMmUpFcO`TyK_H`AuOqIyKjBr^cNd[rN?bIlNvVxGtGhc@iKjBfC`YlNuSyAyf@p]{@bj@jWrf@vn@ho@|HdWtPjBjZsIzX|Dvd@c\\\\jv@_CbmAsFzHge@fGv@zIjM{Klf@oDbB{s@x[co@fK}s@tVyPdU{Az\\\\`e@hiA`t@|@lJq^bj@j_@gVj_@}@lTtDla@tj@t`@uLvTuf@dVdUng@~EwMzv@xV|BrKtT~MwZzThBjMjOje@?bKz]{VlDa@vVf]uCqE`Mq_@`ReG`QlCrZ{LjHeOoRsCxKmKeGpGtOwKfLvKuCnA`GeFxTdLoKrLfJgQtQvT?bNxKtLmC_Xkm@~Xc^aPqXrSkJdQjJkCcMhXk_@?z\\\\rCiT~GrXzB_HbGtC?eLrC|IqGfz@wKjW`j@sTqDxKfU|X|@wN`VqGnHbJ?lv@nJ}Vy@m]nWa@jFlJmFjy@hDpFxJmCzFtN{Fvf@pEqMfQxQ|LLrXy_A~V`RvHq@pLi]~Rb\\\\|YsJ?qG|Pv`@}PLvTng@?ha@v^fNhV`_@GdVyObHoGgLkT?oa@mpAyKpCo\\\\uTzA_Leq@s\\\\|Ar_BvSqEzk@v\\\\dPoGvAvLb@dMkT|@aPjN`PjVmp@qEoMsV?d]oOoBkUq]xMdg@vw@bOgBfOsg@jJqAjOp]zCf[{]pKp@ua@hp@rAbKqV~AiEzHpD`RhWrMpXgCpGm\\\\f]xByKrXlEbJtXeG~Zq[g]_n@fCqd@xKWpGi]`P?oG|GpQ|ArJmSrGpGn]gTbKbC?xKxK?rBrQsUfN{Hz\\\\aWpUvQxSy@nX~HfGtIeCyEcc@`F}Ibr@mCfJwSxKfCqEwg@yZqCnCcI_YgLwKgThT}NzG~ElKea@{Boa@d_@hl@yGfMdEn`@bLbIzByK|B|FyFtPbNxcAxIrKdGiTxK?f[pi@`RB~KfW`xAb_AThMmPzKeW`v@aBb^o\\\\he@q[lkAja@{f@~@uVfjAg_@hM{r@x@y\\\\_Msf@_Ru]m^eQqBq\\\\vTgr@iCuOhKuK|IkCzc@`P|Src@f]jImSzH_DdE~FzCzdAaa@~PhNtZ`CtCxIkOfVjOlc@uCnPxl@gJkFaI`e@wD~CqN}JuIpF_NaLcLhVzEdJmNpGtOrC}SxK`PaAkRfIhAjGqPbMvGlBqKlXmFfP~NhCkTnG~m@uOyKc{@|MzBjVhYr@`H{LbPrGvBfe@o_AaNGtXnU~J}KtGs[_IoLzx@_g@gNnGxK}RzIzArVxIcInIfZlv@~Wle@nsAnz@ck@sS{Q|XkK`P`YnQkKoKiNq^wIzFyL{F}Fv^uXfF{PzN`ARhf@~DnDtYeIfGvSyIjOlIr`@yZ~SnCdOhRlFxZoQYaKjH`KyEhXxEzClGoGmGlTcP_HoE`HrVtK{HpHsn@{WaEf}@qJuE_Ha[yJo@_BfZnWpbAzHvEvE}[rVsAcJsSbHmObm@vRvEht@bNjDtS_QpVkn@oDmO_WcNla@bL`YyGiCwKlOjXwKjGRp_@z[`@dLeNyCkW~H{@jCbNuK|bBtB`HzIq@bN~f@}HfJsXsR}WdT|ZfCac@dLrV`PpXgRhCl\\\\{Ip_@jQoXhs@wNuV{f@jXkJdC{YfYbIz`@je@yOpRdHnFv^hDxHcRtLt@~ZrXbPph@}TIkCwM}\\\\`o@oMtl@wi@}@bP|Qz\\\\cLdJtIdNd`@uHhi@rMzEa@nMdS~Cn@fMuTrVqLIsI}SaEpXqSiGoU|OdRz@pB`Ipl@jR_Tfh@tb@jCc@pTmJ_CnPnT~U_s@b[rc@vN{LmZf_BtDbY`P_n@}GrcArr@ogAsLysAtXj@fWpNqKeVjBoVtp@mUrCda@le@pr@jRpEl@xE_`@dNlOtUjNaR|m@fYrc@j@vA`a@lg@|h@b_@|MmFvJmRkDqk@sd@jCvUaM_HsS~FrShGdAdOaQrb@xM{FdAeQvVtKlZcCvZ`\\\\bj@lMx[sGrb@v\\\\agAaCy^|O}LtgAgLzVzShC\\\\wc@rVmR|X|DtZrOvFfQaKl_@~Spf@mPpOpCnHnKiHvaA|P|JuH|KvLhEhJo_@eBaUbHlPbL`UuEdMvi@pT|LrLtZ}}@zHsCb[z\\\\ll@{OtS_LqMoAfNza@vZp\\\\_Fd]~SlViMvMvB~}@jy@eBbgAyUpv@{Q`Ot@nKrX?r`@c^`Baj@sKy]~JkJaCoWtt@yPtAiPxM`Lcg@|o@}A~VlV}\\\\fQlZf_@fNqHvRwd@hAeD|X`Gde@|SiJ_McVjGiXng@bd@hUdF~LiJli@eFvKxXzc@~O_DrUnJhp@e^pw@mAdn@xDzFvHiq@xcAjtAtLv]qYvAmOgS{V~q@~Yg[rL|Enf@zhAhEpm@h]|l@i_@vLlL_OgWnD{Uli@R|WsJcCiD`K}LeDwA}PqQsDfB|V`UdWfIbn@nPxI}FfP{ScHo@fYiTda@pG|KgCbNfNiLcFaVjEwJrX_FdFjOfGcx@eP}_@j{@dt@vN|@bPyRqSiFhEgMvRfPhV}FBjWqSrk@fC`x@iWzTaQot@mO`GlNn\\\\qNzSDv{@uIJg[su@w`@|@\\\\hGfi@jc@zGll@vUsCuOr\\\\tHbi@zc@x\\\\zP}E|JfZ{GbRo^bGqChHvIfE_K|`@xHcKfOVzAw[fX}W~o@lg@`VwIpGlMgFzH{RcCwEbLnFxdAiKlEgd@~BgNgIqWjFec@qp@wNx[hTtKyEbd@lV`c@~Lpm@|Q{CgAr]dd@tHoDmn@bF{Cdr@hz@xFuGmKeTpa@qm@le@t~@yHdRuo@lBai@js@|Edp@rIfEs@hRbS~[}Aji@~LhGhAvb@dPrUoRdx@x\\\\~HzAlVtSnKwc@`N_EiPih@tk@om@~PwE|Ksq@fHeEt[fIzWkFvJsWsDsx@j[x`@?{EfFyb@_BrHhJczAu[zOlL`w@nK}gAyKb{@rXqr@gC~SbQoMvNu_@wCcUq[sWiuAvKucAeXjnAvz@fxBwTfx@pGvJzRqr@lReL`{@lJkCx`@fVlkAoEbz@~Oop@wFkc@nt@_w@hQ{`@bUda@oPve@x^ov@hwAarAj_@xArt@d]nb@iFrEu_@lj@~Uff@yGpNlg@tEiCn\\\\z[mRhc@qWpGmYr\\\\iTtC{j@jq@{aAxZi{@rh@~u@dB|e@}h@pxCelBiA`}@fi@h_@je@jAnQ`w@uXbW{SdDse@qImc@pIhF|Ntd@zCjObKyLpRvIvBjmBcBpFrUne@dSbKl[yJbSul@jPcJ~NhTlr@`YfG?xQpXpa@tOq@v\\\\vWj@tb@el@`mA_b@hHqg@sr@xKzb@eEp[mR|Ger@gLfTlSfa@dD`p@iTj}@eFh[xGfMlNhNdo@l@xXa\\\\`f@q`@}KyUpE~PlKtIhyAkLdL}g@kTrd@`l@oh@|TuThZrcAqGjQmYfFu`@~{@vqA[bQiQiHnDiMiM`IxAfNc^TtMrPmIzJdCvQmPzp@ra@qr@n^{JxNtBzBvOlPsXsCzm@ly@nr@o_@j]z\\\\qGnG`c@oGpIxK`[sXbAsDzPsWlFtTdLjTyOmC~m@`HdG?ca@pu@rtAiB~Gca@g]}R`cA~InT|GoG?ea@|Str@gCna@uO_YzB~X{\\\\~d@`P`YlPoPrXjTgBwPuTiTnFoO~@pNxZv^}CzO~]~z@}Gp~@r]rf@`v@t`@{`@mAeTgMtOlTi]fC}YiiAqR{Axf@vlAzp@lKd]gPdMfCiIhe@dLmGfD~aA`Ol^qo@jBsAeF}GbG|Gca@mPsC}B|XmP}XfCbLkK?xWrRoSfE}a@kGpKbJ_EpJuV~R_PyKfIjRxk@mU`U`BgCje@dI{PtRyB`^k[je@nDrd@~g@~Ohg@ne@p^ca@zGxErDht@hW}BnGjHiFh`@lBle@oP}GzKlP??wTdG?{Bwz@eJkS~M{XcG}Cd|@gXnVbOyJ|Owv@dH~OxKnOwEdHhNzG?fGuQ|i@|UyKaPtXnGiCe]dL?eFcJ|\\\\mPjJ_k@zv@qa@~g@|RlHxOvx@zi@D|Z`ObJjO~f@wL|YzOpcAq`@~v@jL|RMbX`Yj]mu@eFeOdF_N|Y_C{HwM|OoNoXvp@gp@yh@uS{HpBqZ`[tOfCuD`Ho[|Fle@pG{\\\\dL}Mfm@mp@iOrX|Oqe@|JmG`b@mZqBsB}`@qJsLnGqGmR{JfN|l@sc@`RwIoIeOlHiLmHmPfLcGuC}FxG|FtGn\\\\uCkTrTjTmGul@t_@e\\\\vAmRyQoHtFuh@qJfC{Gw]hFcXrQqa@kT~Gw\\\\wHhBiUqOyvAtC~OgL_PyKiC}gAmP`Kii@a\\\\cCtOqPkKlEjt@_N~If]~Oc{@pGz@~Q}Q}@jCtNpQDdd@{NsCpG|JfJa\\\\jx@ca@aPe]?rLzWbIlFtIuEgCnP{m@uC`KzQiXfNoU@mWaToGvOje@zm@nGmGhClGiC_P~JUXdb@~Pwg@v`@yYja@lF|TxQbOrc@}S?h\\\\z\\\\Ujq@sOjM~UtLhFt`@y`@zBti@bNArYcLbMtTiCj@~Im]x|@sQuK{Hml@}GcE_y@fCnFbFkJpMrFtM{JxEbGtKqXuKtTlm@uBfg@cb@`RqGlYwK}GmTnA}Osc@fCrO}GoBxK~So\\\\{BjKxKqEhBwMeF{@nd@z@rL|G}XjKhCs@oJfWyEzPvOgYvEzA~FxKgCiCbG~GpC?uKbGfC|OwXbYfG?eXdJdFsH`f@zNp]bGiCcYbJiBfIuFwLtFoJ_Y?vKdGuIbOgc@sFrX`YmPfTiCgT_F`V}f@eRxKbLaPpGz\\\\fCoGxK|XqGzBjTy\\\\cGbCxOuRvDcJgQid@yTcPrXjKfCn@bP{LpCiTuKbGzBcGxGn\\\\bP{BxKxKqGfGdT~KcCeCvPgr@eH`PjKl_@xDhZmU?nG|GgCWaKdL_Dx\\\\nG?nPdL_YxK`PhCaPvK?{\\\\oPz\\\\|GaPkTpKwFvFrKjMa@OxTnGeLdLdLpGgCnGnVaO}CzA_IwKnGlPnP|GyC`GfG|BcGdBz^kJ|QuKQ_BaIrIwNcXkFdC`IoG~EsXca@fChe@iTyG`OrVkNd]uPgApZtQb_@cI?dL|GuCtOxKrCyKfVzQpZnl@}u@cYeVfOmJeFnGnPuFrAvWsA~OdTyBtX~JjCwFlG~HrDy@tWzN_BsJv`@fCdOlPg]vGvP~K{EqEgWpEgKpH~f@fWt[yHjB_WeOidBfoBaEvh@|Wpl@pDvuA~OzRiHdX}TnA{KjR|_@ro@iiAdn@dGzd@eExWmq@raAgHhf@kTzHu\\\\gT}ThFgDzVvJv^}^va@iC`s@yS|_@x[z`@eClLglA}Jo|@hSgSoMyt@dQiUnx@gy@hHcaBk^eHaRvXeWsEeTafBoh@kPa[uN`@Gbd@uj@hf@bHtb@oY|PyQty@vUrQ~T|wAQtd@aLtPsk@lNoP|j@G`rAzYvVpAft@xMxLe~@~|@}Vfg@yHtuA{z@pMkW~`@ep@~\\\\k@vU_Ql[oe@zRfUth@rn@zZaGh[nT`z@zo@vmA_IdXfClNlZnDfTrf@|cBaOlPz]vo@zl@_Bzw@`IjLeQxTvw@h`Avc@~bAya@r_AyX~CsXwFnSl_Bfl@lf@~vB~VpKdOyz@~kAsyBjuBjRpb@`i@xYld@kAbd@p\\\\aV`b@ak@~Ys]r@{VzZmFt\\\\niAlg@pe@n_AbQhFbXoF`_@b|@v[QlE`Ik\\\\~mAqkAfk@_O|p@g[~c@eLbq@iNbOtKx^{]nKsM~\\\\pMjYng@bRrEnWrXyPjrAjqA~s@pTgHl`@nPvb@wRrLmJpw@`Jr_Ax^xMePh^eh@pSqMrQjIfh@nSrTzq@vEhJnKnj@`MDj^pR`Uhj@s[rt@xBtkAc_@rUyh@`gAko@r|@`MhPkOtXUfM}\\\\pVjExIzb@fb@oFdXpJt~AbH~PkLvNmg@jSaMvQpJhDd[~NiE|OzGbDh_@y`@v]lGxl@m]k@kJnh@pK`tA~[nv@oIf]{_@`Uw^cFqJbGqb@aOyRpe@a_AgIkQVmSfSmZiKgOdByb@tXkg@nDiCpUpa@fw@t`@taBdwAxpAp\\\\lr@xGbfB}f@~b@eFtb@v{@`yA_AbU}k@vg@sY~Emg@cJgN{WiHPoQxd@_@nt@}T~[obAdSsrAy\\\\}a@rRsOaD}Dbz@lPxBxD|K_`@x{@vRzWs[n^uL`z@n@j]mKj_@xN`m@mA~dAiQ`u@kLtLoHcR_IxHy`Bus@o_@JuJyWgs@{m@sVpG_n@yEmtAj\\\\qQ{^gr@sa@cm@RtLu~@_JeMot@v\\\\k[u@yEbw@ie@lj@bl@fhA_W~LsG|uAw^|P{JrgBqSUit@s^aOzG_@|Y{Y}UiWHkd@~NsGlHEp]s_@|Jeo@}m@ur@xa@ch@lC_W|oAovAwEcNxHukAkzBeRyIoRzCfNl|An[zpAo[dr@sDti@fRd`A_Kf|AvIxn@hp@jeCfg@bl@hFf^j}@lxAwUfHid@jy@{~Bg~Buf@pJcRaMsUpBmNdm@aO|BoJc_@st@lY}BvrAebByNwmArBqLaA_t@{nAaBi~@oK{Ckl@lg@auAlDk]aa@olAi\\\\_NcM|I}U`W{Pg@cLmg@oh@wW{i@cg@gRqKwU{_AtFsToTao@uBpNux@jTsZeBeUuK{TozAwk@qaAsp@aD}PzV_s@sg@cq@gOF_p@hg@uFxYalA`V_O}IaHeb@fVcBrEqK}OwUe^mNgg@xPoHvTub@wHso@ya@m|AwCyt@qTiv@vMap@oJwj@lUuUsLo{@vMo`@uNqK~BwM~W_s@vJ_dB}T{]xSevBaBoT`SnK~i@kg@bb@`GtQqXfr@hoAzOvMbKxD`UqGdTudAdXop@f^}z@zCsa@hb@eK{UgHKsd@zj@mYsHkmAjAwc@xj@_H|b@ia@c@uQ`]ib@p\\\\gH~VjOre@iD~RwsA`h@qMdQhQpb@ib@bi@rDpJpzAnr@jt@q@~Frf@pRfHnUx[ub@ry@}S~J{h@pqAzZjeAgaApzCwEb`Bfj@|vBsYhi@{c@sT_fBufB}YZ}Ls{@_]jBs^|Hco@dh@af@rJ_M`XcNmBeYzw@~w@vhArAh`@ygB|KcVeUiOmaAit@c^aMdQvVhj@HhSi[zc@gr@lTxDttB~Trw@aQ~h@xF|^th@s@ra@zQaPn~AtSzG|@xTtk@yB`j@bThSbjAiM`xAwTvNkd@rbC}FxcC_DlHoW{Bgk@tf@wTbm@x@`Rtz@dt@vG~hA|b@zd@`@d]f^lI~l@yn@~w@t{@x@xn@fS|Vf`@rxAxn@nEht@r`@yLlg@fNzq@aI~c@bNr{@H`oBvi@roAzr@zQhv@|}@jV{Rx`@lEjc@ppCjSlk@jh@bj@zAzZsIzEff@z}@fn@xWvDo^~e@m\\\\dn@|_@jHn\\\\v\\\\le@Vl}@zNt\\\\wTvTr_@pNXbJ_R`Ld\\\\xNmp@dg@z_@xeAz^je@yCnn@zZ|UgBpu@oo@|VdAl{@q[b^y@j_@_g@iNkMfHka@~gCmUjo@_e@~^pJxKl@zm@x|@x~@pDlXaK~n@s^~g@cQbqB{Pbd@hNrx@aCp}@w_@qEin@}dAqSdEiIlr@qSz]gIdj@?pS`ZhNoBvVqVpQuU?kMrUrUfl@kMlr@bV~hAsCvUjKf`@zh@fr@vZtNb^lxBr_AbhAhdBxbAhe@ldAfo@tOhgA|l@{EvSfEdPnt@nt@pV{Add@|Rz~@u[pJtNqJlb@rHhM_k@p{@w]fUun@j@mTjIkcAmg@{tBc]}Sl@u^pX{h@kCwd@hr@yGfs@wU|XhP`c@v]t^s@|[p]bo@`UlcD`ZjJ|Y}KgSbv@~Ff_@kPpwA`j@pb@vRb`@}Htw@tJff@{R`YpSpdBm[cIuBlLnWxl@aEdsAwJpWyaAfdA~Bzw@qj@jm@eC|}@sa@`D_Qf_@zNji@}Mtc@nx@jVjd@pBpYft@sU|e@~B`UoZ~]yJ|m@gj@fRqe@sGkMvQxp@pu@pS_IvYlBtHyJvc@gHrV~^jAzq@kRdQod@kBkd@lc@abAxT{o@poAw\\\\tTih@hCps@tq@eN`\\\\iA~v@gJpZjVbj@mMf^hHfj@wUrj@tFrWqFf\\\\gqAbEwM}Ri[qGuKaRyj@qCiZhaAfg@n}@jUnOeAxgAcZfb@{Jro@_\\\\gBgJn^oNfIeXjfByg@pP_^x[__@lwAmXvPit@mNsR|a@uXxDi[dTm]kQyGzBq@|Yu\\\\dMrD`a@cMdM`C|UyNbJGfZba@vjAjD`|@rk@bb@_QfgAko@|Koz@tcAiVtBuLzQi[b@eN~OnF`g@qLlIq\\\\oGqp@nu@zBbq@dUrGNdHgt@rv@CnZcNbSzPlf@qjA~yAk\\\\f@qF`k@cs@|n@iR|s@s]df@El\\\\wPvP}Cnf@mNb[zBpb@kh@jOsb@qP{]dk@a}@kAb@ziAaOjPwIn{@fGzg@yc@bfB|Vfo@j^bPcGb\\\\mR|U[bWpl@dKvi@eEbn@jPrMhPlDra@f[cBjQ|[lYpDmDjiA}sCbkAch@lxBkk@rc@ki@dnAq@`v@ss@~pBqJtl@lBrl@i\\\\uMoi@|UiFgXgWgNui@lm@tJpg@mEpQw[dTcd@jmAqk@pj@aJ`z@lI|bA`Nx_@kPtg@{\\\\tU_Evm@_v@rTeRv\\\\_s@lRmKbUuSuD__@xM}bAxaAkMfe@xvBf|AsLzc@~@lo@kKrWstA}I{Y~h@uLqA{{@siA_d@~d@eChTg[fRsPo@{MlNaxBam@_R~LcYpl@_jBbtAyLla@q_@}Aw~@rPsSaJodAnk@eKkAwJef@iFeqBi_@eg@AmYrI{Gzh@xGps@qTzaAmvAdDaSeR_t@|Bm\\\\o~@oVsd@alBid@uS|H{c@d\\\\aPeB}Uyl@cl@cXuH}Lc[ic@yZuUfCaQb^knBv|A{Lhq@{J{BoGed@gt@hOq[m^{x@kSoNnUkI~bBpAhdBkb@brA\"],[\"km_KqdwbA~XcNzB`j@b{@xv@A`TmPbLeUmAkBaVsGhHfLh`@n\\\\cPlj@~NtOlUzOt@vG`d@~TzPp\\\\cDvLxU`r@jKdn@xXdBzj@_KhRdVqPyCiWz_@mDgf@cP`z@|Jxe@d[lu@|AhNz\\\\~SfCvO`PbJoGrEy\\\\ov@sEd{@kRfDzg@zl@vZhMk@hJpMqEti@oIin@qGrCrXvz@kBdVfeAp|Ad_@rSwCt`@hTe]`j@|GoRvMd^_D|CtFuCxk@yH?cAe[_YgCdL|GqGpGvO`Pf@xh@~Rff@iMzWxD~]xkA|G_K~O~JjT|Gaj@d_@`Rg@hJmUjGrUnk@lS_EhC`a@}MgCiPvKyLuJyHdBjRvRxIuA_AlM`L`ScFpGuO{ZwL|@xCnGmZ``@kItiCbJta@id@zSqGgCuCtyAuhA`yB{PddAwWvEe^oI_gAu@}i@|N{d@rZgz@gSoaBtXca@}\\\\?uKk`@dKaDbYqR}HgOzEcUcJwIiSaI~CkTwI}Eyn@kTaPuTup@g]ea@|GuKyLnA{r@ii@y_@o{AaQgU}[}JgC{\\\\xKg]y[lSm@eKqGrCqG{KeCjT~i@tcAmq@eAqf@er@mQ_CgLeb@dPeEt@u\\\\lPkZgFkRhWkLmPwe@|Bea@wOle@eMzAqFeY_GrOiLlAcY}i@{IalAd`@P{KeKtOwj@{WeDdJ_KnYGaMyTsRaGyBad@rc@sSZ`NpNcWuAaImHvGuHaMkIRgLaa@pGaYyD_GeSyOrLhT?p\\\\oPme@ca@aPlT_`AsNis@xR_SrJhDdQcQpa@yKkb@dDoSnOsi@?|S_Y`Pk_AnGfChJqQuEqNnn@_R}I{ImR?y]|L_ExQgWzAsVyOaEyc@c\\\\eOfv@sSng@ko@jG_r@kHw^pIuGvoAqYfjByI\"],[\"__fLuwicAfAeVnS?|Khw@bS~[v@v`@yXoSeVfC{Kay@~GsI\"],[\"oagLkbwcA|GwDfTjI`NwFfN~YsC`_@hN`RoPTaTgZ}InF\"],[\"}ghLo~zcA`BsJzM?xWtg@d]eGz]tIxJuIrCp[g\\\\`Rkf@_XdFvc@}LlAiQg`@oX{F\"],[\"efmLstedAxK_HuIf{@wZkTeLmWdLcIuCqG\"],[\"uwoL_dedA|q@~^`@pTi[rCuVc_@pFaB\"],[\"o_|L}ridA|PUc@tUuZpQ|GrCiElHa_@xN|MvCkBxIai@hd@uG_V`FsYx`@c_@hIw[\"],[\"umiMupmfAnFwJoLgUvI`AzH_Kl^bGeRbsBkQoWxKkP}CgP_PeF\"],[\"u|nMaiufAbGcc@zMpElB~R`]vAk@zTnJxM|VpB~NcIjDldAg]k]ob@kNcEf`@}WsUsVnAcBqXjQkT\"],[\"oo{N{dygA~W{z@hJkAxE|MqTdlAu{@f]z^g]\"],[\"ordPug_iAxVuQhI|BuCrXlPbCzM|p@{QgAeg@vTgHa[gj@yg@`BcO`LyF\"],[\"u`oQgnriApLxTpGoPjUnQ}Fjg@_V`g@oa@cuA\"],[\"izqQiruiA~XcNeL|m@tGbf@uGzF{B}GwDhGoR{B`N{b@qJ{RhKmB\"],[\"mxcRqsjiApDyQnQwGfSvHhFtRun@hX\"],[\"gmrRmldhAj_@gLg]tcAiZR}VuPcNlj@oL|QkM`AyKsT|Iuf@\"],[\"w}{VyatiA{\\\\yq@tOpGnPqG~Fzi@\"],[\"mh}VofziAc@gaAvY`_@oPgC\"],[\"{y}Vwd{iArAj|@qG|_@wNvP\"],[\"q~aWapbiAbNmPfEhT`NaKpYtGiA~s@u\\\\uY{KpHfBxMp]vZA|UiY_C}Ge[}SoQ\"],[\"cxbWws`jAaOgAgDsLjKk^fSlT\"],[\"ejxKmaobAvL{Uf`@`ZkT`Y\"],[\"i{yKecnbAh}@bRgX|MkPf_@?~o@vSf{@kG|KmJeKqMdK{B}XaP|GbD_VyHyNxC~OmGzKEoa@gLo\\\\pa@mPmI_HoN}y@\"],[\"u{|KsnobAhp@j_@vCvLgC`Mu_@|XnG?KbJcH`I_GcC?jTsOyZWoVvHvUfCmQuKm^|F{NvTpGy[mW\"],[\"sr~MynagAdLqv@rXqCng@r_@wDvZsLxLgKsS{G_AjF|MwHlE\"],[\"ewqNawchA}DlPvQjKba@y\\\\rEpm@p[dHmSdg@m^sI?|XkKaJdB}e@cKsQ}@n_@_FhEca@yEnGhNqIdEm_@sVkGsSDkJtMrBlRaIsAmLdJcK|E~HjM_c@\"],[\"uvwPm~liAjb@zL{Db\\\\kLeFq`@~RaKoc@~GyN\"],[\"kvcQorpiAnd@iZxJ~MyKiCvBlYob@tTzD~_@aHlCk]aUiEoW\"],[\"gtfQ_puiAxJvFrRwFwPxVkTmE_SjYgOCVyq@bRdF|LaG\"],[\"wljQcokiAt_@}P`X~KtBnQcKnRu\\\\sBiYmJ\"],[\"iliQuetiAtw@dTa[fg@kUjpAxI|p@uThCqPfWyQsCu_@`JYsvAdn@o~AP}S\"],[\"}ihRqwjiAfi@_tA`f@j@bW`nAgi@fgBcMteAkWv`@ou@z}Bg]zPeq@ncAg[{YgF_[jFqc@pc@m~A~hAmtB\"],[\"sbsHyxzbAMn@i@VuCcAeA}@AaDz@SpARz@x@\"],[\"cjxHc{ecAYlEu@EY_DbAyA\"],[\"gqyKa{qaA}KnEuMcS~GmGvJhD\"],[\"u~cVwjuhAfY{B_MbS{P}A\"],[\"mxdVgrrhApC{JzMx@\"],[\"aydVaythApIGiLdS\"],[\"qohVgruhAvEaGk@tOaOlA\"],[\"cyjVaovhAmIQyCoO\"],[\"aulVkrxhAUbCsCLy@yEjDgChAZWvB\"],[\"mozV}zdjAzWiHhMxTyGtHmMeKaHdL\"],[\"qxaIaubcACByAQ[i@Aw@`@q@dA[TLz@r@GdA\"],[\"ejdIkzxcAm@~AwB`Bw@QEs@~@{AtBcA\"],[\"srgIg_qcA}AvBeAXmAg@Iu@bBaBrAa@\"],[\"iviIq_qcAV{@@GLItAeAbAJCbBs@b@\"],[\"sgrKsslbAd_@wArDpJmi@vWeGaK\"],[\"cfkLwmcdAzZdKwKlSaTuBmD_IAqK\"],[\"eulLibmdA|SnCwBdIgNlF\"],[\"wxmLwpwdAtFtA|Jzz@eZ}{@\"],[\"qrnL}ggdAwHf@gGs\\\\tT}PfLhf@{B`H\"],[\"}guLsejdA`IoTfSzUuLvE\"],[\"ud{LwuhdAvXoOrZaf@ha@yBeEnWobAxmAkF?\"],[\"gm_MwjhdAt]gNpRjDwXzT_RrA_J_E\"],[\"efkMgalfAhDqGhRlL|DnIiF`T\"],[\"}jkNk~}gAzAkItWbW{SdIyAd_@cOhImMaOfW_Y\"],[\"ejrNq~ygAloAdFlHxVod@rH}f@gOiNcN\"],[\"m||N{m_hAlQuIzGxMsRnh@iSk[\"],[\"idmOgcmhA_MzAeEeM~UoXbFtS\"],[\"uuwOgv{hAfPkQ}@`VjJOwPfL\"],[\"mf_Pqy}hAfIwCpCdIzLb@Rvl@mO_I\"],[\"i|`Pyf{hAkIpAsHsNQuXfJwPfKvU\"],[\"_}wP{bkiArGgB~KxNqQbd@uNmC\"],[\"{jeQmwsiAtXiAsQtb@yQfKsEuWbJoX\"],[\"ozgQsgmiAxUsKtA|[aTfBuH}J\"],[\"etmQaqtiA~Bpi@}U_\\\\|Bis@tLOzA|FeNnH\"],[\"o_wQycxiAzJxAi@|{@oWs@dO{Y\"],[\"swcRodiiA~RkKfLzHojAtpBzLwaA\"],[\"sdzVm`lgAtQ_Ihl@~`@~_@~p@sHfTgDsEcPrKel@{CkA}\\\\fZySm[cj@{JxG\"],[\"kxbIegdcAy@ZPw@\"]]},\n" +
+	"{\"countryCode\":\"CO\",\"polygons\":[[\"m_[|rbo@uC{Hg]~Xui@zBfGhWcy@dkAqO}FcPc`@{oAqcAqDeu@d]stByqAcOdJv[w_AeBgPpLt@bL|ZpJm@hJi`CnVcwCuoA_ImHhi@?gfAgTzAqJa{@mm@ce@{t@|`Ayi@JeMey@pH{PoM|mAmWzZan@sMo\\\\ssAvEgAgx@cKea@jYcSo@mQda@bIxGkg@aOqp@iUqDg]n\\\\qGyKyDbKch@fHnC_J_Qge@mDr_@aLgLdAqa@xKyKkLbE{D~UaJmFaPoa@dLsOoMoBpDvl@{b@NwDePfQoW}SyMkNvL_OqJeGtEcE~KpKtE}FrMbPtTqFa@mx@im@cg@un@uc@{Rj@iR_QiCqAyLyIdLYrXua@ao@_ShFaM_KuRwn@jc@iVzDoNir@b]iPyGfJkKcNbGea@mKeLhC?yKrRdA_B{S|GqJsOxKuCeGgC`PcLaNJx^wHoAd@mZeGdLsCeL{GhC?_n@oE~Iwk@wVnEiKcO__@Nx_@uHpAiNg]nGxc@_ChG}Sh@eHkBlNsj@mNx][aR}JeJ{T|MkGiInPoG_HeHmWpDmHu[a]cVfCje@eGqGsCn\\\\bZeAtShc@rF`l@kK~VoNmKkM`X}d@gFyIsQzEoE|EhFcGye@?jTgw@ca@qX|m@pX??tTpL?sClK`\\\\?bo@`PiCxPmPWug@pe@cH_B_@yLo]fMwt@wAgGu]mRmQ|MuPo@_Lye@ef@cAr}@ap@t^_XsPmc@uGeOgS?xKucC}GnPmKoOsCgP~OwYjCtH_XpSkP_hA`e@tCdLoXjDkwDdNwz@uOmD~G_kAvK}EfPoIE?aPme@`PyUr`@[nW|IxDkJj]}OsSkg@cU_Tut@xKy]sC_My\\\\}GuVcc@ySoHe`@HecAlh@cpAdX`PpGuuBphA}`ASna@ui@ke@iTa_@}@kUjLkIcO|GcGiQKyNdLeo@}SyZi@{j@pj@}b@mAyOb_@zK|YbQ`BtCbPi]{BogAle@g_@ro@`RvNi|@qAiQuWkWtFyc@pR`PuCmtBj`C{uCgn@_Eug@sZ}Mc{@rTop@_@gG_N|\\\\ud@lOeBxb@y]d^sFtDySah@ek@ueA_iB_i@bXynAup@gVutAuYtV}gAtQa~Art@aW|b@c`ApIif@jRwInh@{_@eDoh@a_@iU`BcM}c@ePcDtDkHlkBco@tm@mpAtcBoeAxBqa@{F}Gff@Ta@kPvK?{GoGjEqApRxEgCxKfUaCjHcNcAcM_Kg@?ke@rXzG?eLbGvTlPeLsC`PtTiT{Jp_@zJhVdf@nF|TsOnIm`@yEg{@ynAsOoPZyMpF`P|BkWnDob@aFku@|L{aBzAmG`P{j@xUe[zbAwm@m`@}h@}pCmd@aGiVw}@m}@eiAgOYgVsdAid@_d@sf@Yag@_f@aeAiNwJ__@ci@_Lej@gxA_W}DxFcTqH{|@xN_G{CxPtGjO?oPzKcGkTml@xHsh@yYo_@icAcZyc@Osi@~X}HzW`Hb[gEl@sr@wg@q`Dyf@au@fGsd@i]s_@kE|t@teAnKjd@_TeHk[ic@ab@yHPiMiT?tKgD{Uqi@il@`DfBrHuSlFdLbPwTcGca@ef@fa@bKa@aQwh@sAgCdLcZqBqNlHkOya@uPi@uFoV_VwKkQi{@wSiPnBiL|QoDmPaP_TrCzKlKcFxKsUs@eaAcgCul@gO{K_e@w^oW}@ye@iGcIw\\\\QbfA{gEbGuiAkDaaAjK~f@{CbpA~_@bOln@gUkS~YdFbFcIb[tKvHtt@\\\\u]kTrLyKn\\\\?u@qZwIuAhArKoNxC_YqGrIiSvp@yK]me@kOqSwdCcr@y{@}g@yVdLqgAo@kn@y[u@iU_N^nK}GoKcG|BqGyK?`PyPgLqJeA_e@zTo`@dZcmAhDqjAaO}`G_|BosCgf@ojAo}AmwByq@qnCgCyo@ew@oiBaTiRqrBmy@c{@dCyKbPuTiTzEoReJc_AzCaUde@l[rX{\\\\kGqQwUoMkB_\\\\{\\\\|@uOjTpG|XqGpG}p@yv@oGiYpAoKfTfTlJsKmAqKsFFuKq_@mQmEeBzEcKaKeA}J`J~BdRkOqPg@gT}d@nEb]sMuKbGvTaPuCvTda@_TuNgHaXdLsk@lAay@l\\\\cl@x@q]b_@goAxUsTzbCyeAfYCzUfNvz@lcBzj@|POxQ`Wp_@~dB`jJzsHxkDoCjm@xXvaBbeBfo@r~@paA|oBrk@lfAjv@xnA|k@``D|KzlBp_@t|@xDbf@cKdO|CjqCjkAja@dk@vcBz|@nt@nT|e@`i@tXnFKynBwjAeoBOkMhp@oQ`v@iCfNeJqVkoAjPiUpTrDdKqIf~FibAvyC}gCh]gf@~S}Dvg@nFl~Asd@~Vg@hMdIWrb@h`@jMb`@de@nZkS|d@gCrqBtRbp@mK|t@pHdk@ag@|RgaCb`@kYx]jEvc@iEvrAch@dm@i`AbQ_zA_P}n@cJp@Vg`A{Ms@Kc\\\\vLaOyA_t@zLkYsJs`@fB{jAzXsIrLex@mQ_]V}lAqJg^}c@ch@sRcwBnO_g@uF}d@pn@mi@f@{c@jj@{qAZuRmX{x@dBch@lrOokLkTceAhn@it@utAsrB|SchB?ct@f]{jBiTs^sNysAfJoyAg\\\\qaBvBihBoIu`@iXkK_BsU}SqXn\\\\g}Cjg@es@hAiXlRbVt_@rAnn@cf@td@yBhTdf@vbBlvAfoA~MpfAaSvf@dUti@f}@?xK~j@ve@hYtDbdA{Qjh@dGtb@}ShhBxS|`@aGp{@zDt`@dMdlA~D~SlKvXw[`]wH|GwKxQfC`]yKlr@|Ntz@uJf|@_h@nzAuOvy@}]ll@{FjRcV{A{b@~IoVrVwPvlBei@fp@a}@bY`BhhA|t@hMfb@n~EnpFlPlIvi@JmVkSgHel@jU{d@mC{f@hVeSbt@uJzHan@b]kQzgAmpAlUoHbq@ysAfc@}Hl_@lZnJGvdAuWd_@of@z`@cAfc@jLl{@ch@rmCut@tt@eC|o@kXdo@Qtm@w]`e@zIph@cO`\\\\zbCko@pF{rDkNkhBfZ}o@`WgyAdcAoaBjn@_]ns@dRpt@z_@tr@fhDjmCtYnbAmDjj@eP~SafBpp@gRfh@aYlH|rB~u@pC}Rj_@yDbCm[lP}A`H}NTdfVy_@nuBbT|aAA`q@vR|o@El]dtKoAyR_w@`DqTfNqF{Ga{@vIao@eBmg@`Sqd@aWof@|Pkh@dz@ys@rUpBxW_c@rMjMfv@wC|OhN|VcXhXcHtJnNaD|VlN~NaAhk@aWbBxHxd@ym@do@sI~VdZngAbWtNgJdn@re@bdAdShqCmGfHrrMrGj_B_sA|Oen@bYqMrj@ev@jWs{@jSqQ~iAgUnv@rZ`q@ya@hUHdXmUxZ}Anm@aw@hs@hBrw@m]|a@nLvqB~Fxgu@x_IbUbJq`A|g@c_AtaB_`AzPyz@xy@qErh@p]~d@vEv_@iGj`@_p@lzA_BdZyySejLew@na@nZ|Za^zSnBr_@gp@rVyEvLzL|i@sNhF}GuOsR|CfFzu@w[nWk\\\\xy@zE|IxQp@rAr]{_@`IqP`UcYvCaLxq@e{@|gAvD|KwM`{@`i@dDmFlw@vEfMp\\\\|JbQn\\\\{FrZfL|`@qG|CdYx`A_L`F{PyKuk@`[tE`Nx]|IkMhMej@zIm`@pvAvK``@_c@dHt\\\\hw@dyAheAqAneAlw@xfAwGrm@zDhRdPd@~Izy@qGtU}R`PyLzjAs]xq@dk@hWxIfQaa@hLtTnPiNzYt\\\\tpAwu@r_@sV~i@f[bP{QlHuLnd@sp@hWoOq@gZk[{j@gWifA~b@ag@cEoc@tTa]K}[dg@rQnEgCp]vNdTqQzUk@b`@{KzBgg@|s@yk@oGmQqSaz@|Fee@bo@oHvDmQyKuLhOsZ`D?dLy^nJeWzz@wS|LdXz\\\\eGda@m{@df@}Bje@yX\\\\zNfTmFvKov@xWfQtHP~Om]~zAqm@zUoWrX}Lqa@yTlL_I{CaF|Xq_@nXs[sKmrAd`@q_AfyAg}@|p@nGtZiOtReHjl@mTcUkbAjj@b_@~\\\\yF~d@aj@dr@{B`Uwg@x^_O`X}Efq@nYfJbSbZai@jpBciAddBxCn`AgGhc@wiAldBubBl~@hFhh@a[lIgEru@ia@~n@ba@pg@dBz^b{AgD~VjaBoYle@}J~{@|AvFzWq@qItf@dEzs@k\\\\rf@sJt|@_Z|VmDpTrM|v@k`@bvB{[fVufCzSmB`n@md@z~@sRfL}k@`CuMtQbTnyAgMna@mYlHoOvbBwKhYo|@jpAsUz|@otA~sA_Gra@~DlQki@~R{@bf@unAx`A\"],[\"air@b_un@la@iTbSUdP|E`D|Oik@jl@oLzD_UkLc^lKbDsj@\"],[\"cts@|opn@~~@ihAhIha@iIb]w[{KyJb\\\\aWl@\"],[\"y~r@xsnn@pZo_@hHbBi[~u@{\\\\~SwPd@dHaM{GcG\"],[\"yhrArxen@fCyq@pXpR_PzQfGf]sB|s@vOzVrBd[wOiBkQ{Zp@ag@aP`P?wTuB|M_QaVgCxKqGuCnOgY\"],[\"yqsFt|zp@eXjBqPeOfu@pGh@|F\"],[\"occGbotp@}LdAiS_LxN{J\"],[\"kilAh~xp@iQmE_A}JtHg@\"],[\"_ly@ravn@_F}LdYv@hOhO?|O\"],[\"{niEvhcm@iE{E`PiXvRra@\"],[\"oehGdg{o@L?CKT@BRM?BHQ?\"]]},\n" +
+	"{\"countryCode\":\"CR\",\"polygons\":[[\"krtEhuar@tEq_@biA{f@vRl@er@pX`eAoHfN`QsOg]rO?o\\\\qGbjDgcA`iAyk@dyCgvBnnA_iAgG{MlEeUxa@mIvhBoqAjXeZyC}Nnm@yKxTsr@nGov@`]wYlVwEgE|Vve@bJUzRqx@fjA_Lvn@nXzRbi@qSzLhu@vGfD|vFm@yFoWvOcJnk@irAz_@qQ~XvZh^vfA`G`EjMyFpVn\\\\dQkArk@sb@bb@uK|rA~B|OxLnU|r@pm@r|@ff@kb@_AePbKeNnzAyb@di@~EuEnGgd@wEsgApi@q{A~pBko@kc@mi@xZeHHuJaUyHff@kWjK}GgCzWqNaKqQsXz\\\\pG`j@ePzJmJjd@y_@zFaJn_@hFrBoBvLpJjEr@nd@lKbB{BqGtcAsi@jK_h@vTuWsCuFf]tCta@gLfe@pGyEzc@wZnc@|G?sN~b@m@veA_sAjpAiGjRmR|@c\\\\_RcKk]mZiR{HzFeJmHaLq_@yChSxMfR_ElAmg@id@qZ|SdGsXkTba@ca@sOqo@rRs}@vx@mC~WcOm@wLvLeLz_@om@bp@m_AzaDoJuEo]fXiDrNxKoPyAfOaNxXwZvrB|Ado@mRvK{@pGzGyBaVn`@{PtE_JbRcP~ALxH_Y?gg@oZoOBe~@`x@yVcIoKjNeNM?hhA{Kef@kPtcA}}@jgA}L|e@{I}CmInLl@v_AcVrXiNqBcLzIqQzYtX{TzgA}OzL~Erh@qt@?|SxU_Y|`@gpA]ia@vTi]zN|JfQyNxKnPrCui@ti@xq@|SpGmI~\\\\hGnCdPkKvVfi@|n@tOvSxPupB|gA}K`Wg^lOib@|vA|G~Q_Pfa@eMruAsa@tFwlA`_Am}Azg@ic@pGiMeK{Y~Usb@{ZdCuIgYsVii@`ToP_P~E_LyIee@{Y}Lf@}HmR{QuMzKpXpXsChCuOcP?|GuXaNk}@n@}IjHmKjh@hEbLmRfUqGuC?vTy\\\\ngAaPmv@yKxK?we@pX{K}ReGtD}k@ia@wF{Wz\\\\mK}O|GaKaFaSsXjTagAak@qG{S|b@wv@pbCwgJsB_\\\\ukAiaCrT{a@bTqkAb^od@oYur@~m@_[nc@}~@~MkA`J`MnVeUuCca@zN{d@_O_i@ln@mx@eDcc@cOcNcd@ujB}s@hC\"],[\"ufdE`v}r@lHgTrCle@}XdXrCkv@fCrT\"],[\"ovjBzcdt@PtJuYe`@nMP\"],[\"q`iDjrer@mCePfHrD\"]]},\n" +
+	"{\"countryCode\":\"CU\",\"polygons\":[[\"wrcKjuyl@B|j@mIQeQod@kIBjAxN_IlC?gY\"],[\"s`eKb~zl@dGzErJaH`Et_@`EpDfGyOzBdYiVmA\"],[\"wrcKjuyl@om@??fYqYi\\\\m\\\\l@hAvKrUjMuA~S`S|AqB_LhH}Bh\\\\fr@hVlAjFj_@rBr|@{Gde@zGzIx@|c@wFjs@}g@dhA}GnjBbK|t@}WziCf]hhEzBvtEb\\\\xx@pBtoA_Pb~@|Hfa@gE~`@bNbYhCaP|Er[pTdxEgHjNkQjBoYkKcGiTuw@qe@oXy\\\\cPpGlDcUgHeQyTqN{i@wz@ma@isAw\\\\{Q?cGzIE}@gPk_@}S{Qi]}\\\\}@q]mPkl@hi@}Gn[hJEcQxZcQHwZc[_Ova@tJjCah@nb@{DtPrX||AyKpp@vKz\\\\kH~PcDtoAsR||@jThg@aC||@mLvE|GnGwUpSsKp[ad@d\\\\sBh\\\\ii@lTe]dk@kDfe@aYfOiWfc@ybAhIys@bT_QaCfFsKgLiGgPn\\\\st@dJauApg@yQfVqIpq@gLa@x@ua@i^ta@xKrrAxKvKuCbp@pIj|@nVhrAwNljA_O|Tu@fbAc\\\\zYrOfNiQfm@gX~VeKheB|ShJo@pIqJzZ{GyK_EtHoVbBlIrTqEtr@xKqLvFrFkOfUuj@vPwj@t}Bk_BvzAuDrRkJv@qGye@zBdL}SoGaLrd@ePeGiTrXrG~JwCfQhLjBbn@ai@|\\\\AmHxY_BryCjHbyAcDfu@uRd{@qt@ba@oc@iCi_@dc@rGbJpa@{Gb~AlC[~Ki`@iEqUxMpGjTvTzBiCvTxKvMoHrUqh@vLmTrnArOx\\\\mTvi@fHzOmCzg@rHtDo@vJnGsCqE~k@uk@na@cr@haCoPzIaEp]sI|DyTi[RolDoSoaBcN}Rat@eDcBhQud@nq@aYplAnNhiCiRp}@jBp`DmFz_@pFxkAcOjnAhOjRvb@bEnm@jjAxc@ztAt|ArbAu@fy@rK`@M~M~K^hTf]fYlJvJlLuFbMvSxIi@l~@oIpZsJoBkMlKx^jWbNjb@}G{B|GpgAyKfCxKtV?j}@lVtXdl@xBoDbF`GbJnQoDkCgTpLpBzMrTx\\\\c@tL|RuNzw@jTfp@?pXlOtg@rx@vhA|Nft@kKbGwhA}\\\\gQdM_CzWpPnlAvv@loBaGlt@sXbNuVoJfNo`@iJaQwM|HkDsI`EmNpK@m{@ytCiXue@fOwc@gOan@bZeb@aIoYqa@}GpWdTkb@vLyDjKoP{GeJrb@ib@``@oY}QirA}XaC_Lcp@ig@sCxK_Rgp@oViNeYlCyAe]jJoKm\\\\{GsEuXmW{E`GkO{NkRoJdJ[rQiK_LlR_g@{[ol@dGoGcRoNuBqd@oZw_AlAgc@eFeLuCfLyFiL}C}k@oXkME}XpGhC`AgK_Z_ZrOqZkm@{f@w^u_ArH_]dW|E`JmKiMgO{OtIwWc}@nFiXgFsJlHyHxKhIoEoMjKh@X}Igd@c\\\\o@a_C}JwtAky@khBg\\\\etBpHej@oLehAbQs\\\\xKclAgCwnDvPo[|m@rIlC{McDiOa[wLy]yaCgf@kkAfCsJ|m@h`BnIwZne@sb@vIiUqAyPog@sYi@sw@|XaEjBoJwVa|BtCa{@sMyWwJmBaKpJeBoW`FcEpa@tThr@{g@|Oux@~QsStAsm@tQsd@|Ag^iPsw@}MtCfRmy@cAqK_NkAdLyQv`@sPvTsk@oTk`@yGyj@bPlPi@hTtUbGtHwE`Nit@`^yk@mFi]h~@mN~Qa^~u@kd@rEce@b^{R|g@yr@pD}a@lPm\\\\oF{JvEmk@aKoPdGgVyK{o@pGujCti@cNxBkMyWmNuTlPhAqQtQ_SnUgcA|d@yr@pGa_A`P?uC{GtVsMtNqYrKsoAhToI`Mqw@`bA}k@zg@so@vZaPgFaJ{s@mE?w`@dNz\\\\nRkB|QqOlEqZzg@mOrMiu@eC}o@hTke@_LgDcCcObIkIc@aF_HfCvAyG~Q_TuA`RzIdAhn@aOzJ}r@aQuAuU|[_`@dJeL`UcN~BkGfYjFnGgt@xv@xAzK}TgNwDeT`PwTdWoG~k@kaAbNqk@v~@k|ACg\\\\xJzCnQkLjKdA~XbRgd@vdAhFjPaLrWfFjR|Ue_@xC_c@vSh@xHvKhYgKD}]`J~CqLca@ueA_\\\\cG_P|zAmwAne@ksAhRrGxK|k@dWkEuN}^pNx@aC_Eon@}MjTyrApTyT`Tvp@iHmAyD|JnO`AfW_kAs\\\\hXyC_V~Tyc@eLcGf`@jHpDf`@?wKhCnG~F_DyBsSbPkPsX{q@oG`e@yYhDk@euAt{@egCnK}Rhn@cLmLqZdIyRtLkA}GqGvJqH_OgB?y\\\\kK_RxOc_@a]i_@dJkP?gw@n^{P{CuWjf@{b@ffA|j@rDlSqThH\\\\~OpBlFzGkKd@tPrFKnToLoMiZZk_@pa@_n@zM~C?xGk[zb@~Ghm@mC~g@zv@nGx^cd@`BwHgw@kn@bP{NtT`B?}TtKxBrGiSwLnBbGyc@mJqLzKoP{KsCgCbUyLwU|Kis@bFgCtAzMrOoGmGoGjLkDbBgNwBib@}S|m@yJeVtCo}@jGyH|EdIxAwp@pMwDeLsC~EwOeJhCaEgq@fSac@m@kXpUw]xNagAhC|GnVyIzM}c@pa@ui@ppA{cAfPwk@tX_RwG_LbCse@aPom@z\\\\un@lRqKhd@n@h\\\\|r@rXxEnOrR}FnmAzSdtBsAzqA`Hl}@f`@vm@bDdd@``@bRrHji@sHxFhJ`D\"],[\"smpKd}xn@dKkCtI`Gws@vlAmCuQp\\\\y]vCqIwJoF\"],[\"wxsKp}zn@xThn@eG~SoPke@\"],[\"witKrqbo@oAgKnRcb@rGxIyCbN\"],[\"mcuKd}am@hb@yT_Fnj@yKdOeJN\"],[\"{kuKtodo@[y]pUoQeKbz@mLdMoNwR\"],[\"e}vKvmfo@fGw`@nT`Jkc@xw@\"],[\"qpwK~kgo@?xKvKiCmGvPy`@n`@W{I`UgVw[~TiMp\\\\xKuC_AbFsMrMk@mYxNwZ\"],[\"_q{K`qmo@wAwR~_@mg@d@`]yDjG?aPwEvA\"],[\"{|fLthtp@hu@vlAtH~f@kNyDrAmPaTwQhBkWk[gVqLwW\"],[\"yqeL~skq@hNQhImOp`@r`@t{@ruCnCbk@yRtjBaq@fs@sq@pQfp@scA|@ic@y^gc@hVgCsYcGoH~XyoBd|@yYoEaa@ib@cPgd@yFn[wEoHdHqpApXa`BrO{JjYzC~`@yr@nSsIzVzMhT?\"],[\"}vmLjhkn@jNcErc@dNhOaHlKf]eJ}AeBvIhV~Cqa@f]f@jU{J`Uc]mBeEvQjT?yKfCtF|\\\\_c@h[sQn@zOso@_Nof@lRoXqGaRlPca@_IiR\"],[\"uwqLlmon@kGuC{TtXiAkNhIuLhi@yPb^_]J~M\"],[\"cqoLhkrn@e_@f]yTsV{_@{AyP`H~HyYze@xFhAk[nP{SuCbGyKaKjJ{IoFyIvI_D~ItYhTun@tc@jVcAtRkXdJRvVxNoD\"],[\"wbsLxgvn@wFeLoOrQ~RdSmMdCqLqGkKzHiAlh@aIuGcImu@xCci@vZm`@fm@yMdQ_b@rHlI\"],[\"cvuLjwzn@pArfA}S~{@}E~I{E_H}HrH`MzUeTr@fCcGuL{FfOg|@JkaA}NoQwAgXnIwZtLy@fl@ck@xQr}AqU_Q\"],[\"qcyLbteo@{JtKmHtr@hF_nA\"],[\"qcyLl}lo@_e@|p@rAtUoO~[gi@h_@xAte@oJ^e@yg@jk@am@fb@ulArd@g[\"],[\"ol_Mrmwo@`M|@{FhL`LtQkNxCiG{Q\"],[\"q}_Mhw_p@gNgk@vEeJnGjK`PkKaPngA\"],[\"usbMbj}o@zRaRlB_UhKlR{Lbf@\"],[\"yucMrtip@fOsMxIbOyB~h@jRdm@i]}k@Zqc@aPfClKmK\"],[\"k~cMlbcp@hAeLxKfNqEjt@kOaL\"],[\"oprKpx}n@fJnA}GhP\"],[\"qrrKdd_o@^}CxAsGpBZNpH{B`EiB]\"],[\"{xeLfe`q@~NgDjGrI`WvlAeb@mhA\"],[\"wbfLxt}p@p@eHl[vf@aGjRkG`AxEyQ\"],[\"qxkLfdmq@jPw`@kBth@sIjE\"],[\"{|kLn{~q@Ao@DeBb@hBJdKY`M_CnHgBgElBqQ\"],[\"g~mLt{wp@jLyDwBxTyLjC\"],[\"wkwL`z`o@`NTlB~OiMh@\"],[\"u{xLprjq@zTgCfRnHmq@jB\"],[\"ydyLhjjo@cBw\\\\tKlQ\"],[\"cn}L`gso@zGaKdFhNgSn[uVcA~MwD\"],[\"}o_Mlbvo@zIyDtHvN|FgHnD|JyHfJwHgFyBlJiHiE\"],[\"ceaMjuzo@lMeBoL`WoLgJ\"],[\"eibM|g~o@bMnB_^ra@mBwS\"],[\"sfcMziap@n@uNvMds@wLqG\"],[\"sjdMxpfp@zNzB`CnQwM{D\"],[\"cgdMjshp@zHmf@sBh|@{Mb_@\"],[\"obeMftjp@lJCwN`g@eDqQ\"]]},\n" +
+	"{\"countryCode\":\"CV\",\"polygons\":[[\"_`bHdoaNlc@cLbWtToPpXuT??mGyJnA\"],[\"mjbHhpyM`a@|@j\\\\`o@yLjl@i\\\\|b@yWfKch@iVia@olAfS_[\"],[\"yldHnciMlPsDtOxQn^`IhErV}Dtx@{Iv_@sSbWg^vHeKzX{\\\\xK}XyKo\\\\pG{c@kTmc@jTeGcPgCdLeLmPdLqG_FuJzD_IdQtCnJkG~Po]tCvC`i@}k@|Tol@\"],[\"y`jHzubMjvA}Hn_@pb@k@~Ow_@ze@g]iCeLwKwFvKqa@}XuCrCoGcPnG?oGmGrK_H\"],[\"_vyHh_zLxLuHtPfGho@|mAf@ji@kUvk@iSpJ_V{B{UsZ_iAf@jVmf@r@mMcPgUvJuj@nZ{X\"],[\"uncIdauMtIuTnT?|@dr@wYbiAhHjWh`Av_@g`Az[eGjVqW~LgMaDaKee@zW}i@{Cgh@\"],[\"{acIvy~Lon@rFwQlHnEdLaHrA_a@{EcGnJ_[^{Zwi@jRaPdOdHfi@eB|YsMlc@xKxKeC\"],[\"m~hIxueNh[cZvMWrF~H`Qp{@o\\\\dy@xBbGiW}HcMcl@oGzBqLiTxGaGyGeP\"],[\"}ukIjehNnV`j@hj@~k@vEfdAeLdHqh@Hk^`\\\\y^gZWcVe_@eo@sVq}@zGmf@lVoGnVca@jc@jZ\"],[\"eucIli_NtAoM`HrBuAfO\"],[\"mdgIlzbNlDkNrUmMZgYdGZjDja@iXz\\\\\"]]},\n" +
+	"{\"countryCode\":\"CW\",\"polygons\":[[\"chkF~j~h@~ZuXbE|m@{q@zdB}g@z]yGb_@og@pZgN~U_z@bC~[cz@jt@eRlT_Vt^mhB\"]]},\n" +
+	"{\"countryCode\":\"CX\",\"polygons\":[[\"fvjEapo_A~BsExnApKT|Hu\\\\bHi@lp@_f@wD|Hwd@\"]]},\n" +
+	"{\"countryCode\":\"CY\",\"polygons\":[[\"ecjTaxrSbArp@gNuGyWzWwJaA{EkUlVlCeJaSvHoO_Uec@jEuJkEcNs_@yD?gGt[sNvLvl@kExGfMpPtHoRrLNzDoQtI?zE`LnBq`@rKlQw^l_A\"],[\"ccjTsxrSyFbMxE_U\"],[\"wrjTg`rS[|@u@P_B[gAU]aBNuBhE?^jB\"],[\"mjjTigqSjHZrPr\\\\rx@lLdRzOfCje@lQjRdMrm@tArMiHjHpPR~Z|uApBpkBvd@fk@zDd`@gJhD}@kUaPl@dGbm@oJ`]uLtBEbPtQ`TeBzRhMxBzCh`@yn@fzCwRp[wz@hTiVb^sVg@{{Ahd@gNiHx]wh@`Bwd@_Xeh@cy@yi@bNgm@aUoVbAyRd^gLnRyr@hZ_R{B{TsOsQbKwZ{f@y~@jBst@_Sw^xDca@zWml@yXg\\\\kBmi@|g@yYny@xFrMyFkAcPyZwEgJcMt]oOqNsT\"],[\"ixkTiauSxG}_AcEoKjx@eo@_Mtu@lQziAoBp`@{EaLuI?{DnQsLOuHnR{EkBmAu^iKsN\"],[\"{ykTuhwSi@b}@gHkD}Vh[r_@xDjEbNkEtJ~Tdc@wHnOdJ`SmVmCzEjUrN|CwHpb@xE|ToV|VhIlB?xXt`@tIiFzEgq@{Ek`Apf@lVj|@sMraBrNjV{DdNtKlg@f\\\\rs@n@zj@bLrLw\\\\pd@gIpf@aS`Lu@wTjQ{r@oOcg@gc@_VoViBonA|GlWk|ArQq~E_RopC{b@_lAdC}^ke@s|B}Wwz@yYq^_SguAcaAoiDh]hKvmC|nGzw@fp@zF~aAd_@vWtz@rF\"],[\"}fnTmo|RvL`HwDtM\"],[\"e}kTqfuSpFegAtB~w@kElS\"],[\"egkTsxpSfFgM|UyDmTxpApNrTu]nOfJbMxZvEjAbPsMxFoy@yF}g@xYjBli@xXf\\\\{Wll@yDba@~Rv^kBrt@zf@x~@cKvZrOrQzBzTiZ~QoRxr@e^fLh@mK~VqKfIqf@v\\\\qd@cLsLo@{j@g\\\\ss@uKmg@zDeNsNkVrMsaBmVk|@j`Aqf@`w@jEn@kEu`@uI?yXiImBnV}VyE}TvHsM\"],[\"}fnTmo|R?}JrSbOoJv]rC{X\"],[\"omcTwycSbV`C`QgL?zv@wNkAkf@lR}Gpp@bJ~l@}HJuRg}@tLuBnJa]eGcm@`Pm@|@jUfJiD\"]]},\n" +
+	"{\"countryCode\":\"CZ\",\"polygons\":[[\"oi`^og`HMijBq}@aH}SfUoP{YxW}_AcRyU~ScOJqb@lNuY~_@rGb[{RnMg`@bb@mHmNqt@vZa|BfNoYaFmbAdi@_b@gGiy@bEwQzY}O~RjIcBqY{Vwe@dMsv@_Vib@pIg}@vn@}y@dDrRlV`AbQbW?pg@|Xxn@zGgCy@nKzHzCdJ{DhAgSzVaRoBwq@f_@aPvKwh@lw@w{@|g@yRv]uy@e@kXmx@ygA_Bqf@}NwVfAuUqJcBlGaa@oh@zOoThe@uv@zd@wG@qE_P`b@etCa@}a@hYqMrMcnA~AnJh]cMaG}[~RsUsNg[dAacBm^ka@ZuMlLqO|UpH`UwPvYdl@dFtt@xIfBvWk]bF_}@vGxFxa@kZf_@qn@`BuUiT}`B_HaAiC~PeMxAnQwv@nJ`CbEeGzYqaBzV{UcNun@bUseAsEko@p[aDxDqOzKdPvOIxw@y^b[wcAD}d@rjAy[hVXjBfd@jNtXgIj{@jHt}@tX`Lla@d|@uAfQt\\\\n@fs@jkC`b@vY~cAhHb[bPpPbf@jDv|@`o@bUbG~r@`c@ti@d^`wBsQbr@nMhp@{VhfAg@~x@dOr`@vu@fv@xwAdq@sOpTkh@rGwLxMbEhm@mNjlAwZ~RaPflA|Ejs@zb@t^bEtOwHpiDuf@~~AuPbS^zMqDkEgE|_@uKfHrGvtA}t@hoCeYzlBlUxUhGb{@ua@xAoIjrAdAtNrT|Gdu@gAjr@xQ`McG|IdFqG~uAx_@rNx^vk@fs@`DhGpKiOpXf@nQcLxEzFvd@qTfn@pb@~_@vP`w@aZxjD}VrTkPeOqSrXkh@rlBa~@zw@kOpu@}_@|P_N|x@jS`T_Mja@sS|Ym[jFma@pl@mNrjAm|AngB}OdF}Z|fAzInPmEfp@sc@j]_Wrt@eq@xK{F`Xwl@tUaNzZm^nB{Q~n@uYnU_Vej@e_@cEnAoKso@_YmOje@iWmBoEpIgg@v~BeU~GmVh`@kg@rAqd@rz@eTsRcWxTdAwl@lD_KjT}DrFoe@rL`EzIgNd[yJdF_PaCwGc^pB}XkPkb@qe@uO{i@ePeDcK}TuBopB_Vy[eFup@fWosAmQoZ{c@cGuAa}AuPkWmb@wSzByl@sOeIeOi\\\\zO{VnAqXqg@cn@_QxCyHsXsIulD{Jy\\\\qVmEqL}h@Ian@i[m`BuRyXOofA{HkQqNwBkStv@cI{E_G|g@}`@w]xAej@~JwXcCeg@}IoK|P_LrSsk@rf@|MkDgp@|m@~HbUgzAeEeO\"]]},\n" +
+	"{\"countryCode\":\"DE\",\"polygons\":[[\"_nw\\\\yylG|^`ShKySvK~Htf@]pYpPzDfXxVzIiZpx@_Kx_A~Ehh@vF`HzZ}JjvAn`@lj@bqAdZzuC|`@j}@|F~j@~r@hlAh]iLz]_k@``@sRpVej@ro@qd@flA~|@hJ_l@uC}a@j`@q_@dV?~V`TnYjEj]wDzObUuC|j@yq@l~A}\\\\kCuDaPoVle@}ImEcD`cAz[x`BcPl_@aUzK|FzrBsIrHqUyJjO~g@|SyPtOa@hXvSoBpsCdFls@|MxR_Hz{B`KrRlb@tVvCruA~Ul\\\\lCmMxI|A|D|o@zXzi@c@~M_Pk@uBjLrWxcASb`A}JbNiMyA}_@j~@}CfCsLkX{H~StIb|@q]tmBjSf\\\\{@~`@iGbQsSgAj@lPnAjHfRoIv]bFvKoPbTwAvYxMzPlb@vg@`h@hNph@bCtp@_PiRkm@qJjG~mAs[pQoFgM{LrAq]v]jC~Wkd@|UzBfeAoCvKqTdBqFdO|BjQt^l]|Dbx@gFdf@{gA|kDwBtn@}GlGiDfgBjNnk@c@xf@mNlZeEmJiQpc@QxObONH~_@aQlIeT}FYnReSrV`TtOiVzHcCbZzB|HxHyDrH~u@lX`OxMr[fTN~N{h@kMucAbCmWxMpB_ChLb\\\\zBmQh_@zHzd@rL`G`Afz@_Fz\\\\wM`J{Bva@zBv`@f]jx@fC`[?hpA_PvGuC`p@pWb`@hF`s@wK`m@@kO_S{MnF`m@qMuA}h@fl@eSvBiNeOc_@lF{[sIcE}JeZz@ac@eOkRsUqRj@{^~Y_e@_Amg@qTam@cm@mc@qH{Qc]wg@vFgx@{ViLcVqPjFci@kDiZiYef@waAmUyEyAo\\\\cQmLmIe_@m}AwcA{KtcAwa@`bBiGzaBlEtuAcNnOqLxu@{Zd^oMeB{I~g@`Edd@rNrIxB`VlMdM{DriAgP|m@pLdC|FdRcFvLef@zEsNlz@w@pq@d\\\\mBjHvI{Cb{@yIjNmX]xBtLyf@pXoa@`s@_LNkDyL_\\\\xc@aMlCoXbcAjEzb@qdAmBil@}h@mInHgb@s{@oLzGue@uIaG|`AwRdf@hBzRiOzHiO`g@ej@f^pGvEiaA|g@kt@uSgPbDuKkZaSlJk`@cmAyU{GgFiv@{_@~U}g@mGgSlPkHoGoGxq@dDf]uKjNkD_Dw@jKsFaCkGjIeZcl@aPcI_D`w@_HfFkBiGqk@`q@bBjj@{PN}ZrV_GrAyBmQ{W{EsFs\\\\ga@kCuLzf@uZkAdDtoAu`@fIuMpByDoPfL}b@kZa[oo@weBuFbLeHgRjInl@cDtMoXrAqaA_x@mOs[_Z{GaFlHcd@{Fy^nF{t@vaAs]}F}Urw@uPkG_CdE_Inl@}ZoNkWjSyVi~AfFal@_Zlf@cFgTjTk|@iAuWnKeM|Dca@vH{@aCmViSnAhFws@oPah@}RoeCu_@yh@yJ\\\\qa@zrAaOaBgCuRgUaWgEoq@s\\\\y`@}j@mwA}`@rGcq@iU}t@xm@dKtl@qQzrBwGhKih@rMgJal@sMzWoUj@wH_UqCgoA~Hu~AkLkJkmByN{bAm}@{g@iRivA`Lq\\\\uMod@N{PkWkMpAiMwNlI{dAwQvf@_BhmC{E`VkPdHim@Dye@qPiAsi@aL{La^ja@wp@avAmJip@mEc_BfIe[_ZuvGrG_q@dU`CtUoPl[um@xJfDtJo]~OzF~Mj{@xSkJhLgMo@{_@lWa`@_@{`@cf@wa@mTkBsKbQrAtd@ug@aJcMsLgBuc@rXmc@|SweBvb@xe@ft@bB|YcK}gAfCcZ}c@yPuD_p@|b@an@zLgiBm~@gLet@jV}k@nMqgAsXwyEnEml@|gD{yDtTkfAbGssAiOd^{B`w@{U|bAyLpS{n@rJmVf{@mr@jXca@ztAmLjmChEj[gXzi@{|@tr@cFyb@~IsaAii@eL{QjZ_Jw@wAfNpNrx@qa@|\\\\c`@eTyLlCsJor@cZuX`Pfr@oGiCfEd|AlNvd@aLla@_]tMyKyz@cKb[zFzH}LnBuJ_KcFqsCa]ciAgXiCs}@~qAfD``@uChCqGmTui@|\\\\y\\\\|gAqNoBos@d_@q_@gJ_@awCt\\\\utDj]y]_JawArDoh@}a@ioAnUaAjMs}A|M_i@tOuL_Gyk@_LnAvEab@|o@ek@?x\\\\`PrXlCocAvaArFr`@he@hRlmAfG_ZqQqaDfRyd@fFnK|_@sCfN|]`QqGzJpGmIaWml@qZmQwy@to@uoDv]uv@vBuXaBs[gg@{aB_Ces@tCzYvEic@yN}j@jCqRhTz\\\\gCdL|m@mPdn@tA`NvMzq@rkBvCru@~Z~_@`XkPbHmz@tRmFp@eHm\\\\s}A}D_kA~PiQvEsXvSp@tAw^yKiXna@elAcf@eHcG}NyKzByKkToGfCs@_a@{Z{^kOOk[gg@jBskAuZkkD`q@eA`IsM{\\\\lKwTy\\\\fC|XyDwCwd@wo@yd@_`BaiB}bBeH}RjO_HnE_Zx@asDpH_YlIn^qIbmBvE~j@hIpYxKcGaBlXpJzOcJnUr@x_@vOnKzHmBiC`PdRdBxEbQtS}I|C`FbJgZsAs[oG|\\\\sXye@qL}BuOer@qGjKz@q_@gMws@wJeAy@}U|XzSaPyKjL_RuGq_@hTiR@}Uib@um@yMsp@vA{N~JfG~LcKjKoa@jKxJhl@{VbA{a@nG`P|P{yAr`@qd@pGbPiCgr@zKxK?uTre@eh@cCuMaPjP}GclAkTgcAzKnGf]scAnc@xTdE|Oh^yx@dRgExF{b@zQ|HxTvk@tNZ~{@uhCtHe|AwLw\\\\jE_RvMbBlKf\\\\`D__@jZx@nc@oUxv@oCfg@ya@hfBcZzO}Vte@`e@jc@oD|r@z[lv@f{Bbg@yKlR`HtGrOheAm`Dp_@a^fcAadC|c@hUdLoMh\\\\lXlPj_@xWoBnl@}ZjJtBpa@coAtUjKpK_Hpc@zKtZ{m@rs@|e@jq@nFlj@~w@`WhExTg]j_@{Gxc@qs@h_@cFnWnRfVt@fe@s|B~SwZp}@oBvf@qc@xTs@z\\\\dRbmApU~zAlz@xW`^l[|HjLbUcUfzA}m@_IjDfp@sf@}MsSrk@}P~K|InKbCdg@_KvXyAdj@|`@v]~F}g@bIzEjSuv@pNvBzHjQNnfAtRxXh[l`BH`n@pL|h@pVlEzJx\\\\rItlDxHrX~PyCpg@bn@oApX{OzVdOh\\\\rOdI{Bxl@lb@vStPjWtA`}Azc@bGlQnZgWnsAdFtp@~Ux[tBnpBbK|TdPdDtOzi@jb@pe@|XjPb^qB`CvGeF~Oe[xJ{IfNsLaEsFne@kT|DmD~JeAvl@bWyTdTrRpd@sz@jg@sAlVi`@dU_Hfg@w~BnEqIhWlBlOke@ro@~XoAnKd_@bE~Udj@tYoUzQ_o@l^oB`N{Zvl@uUzFaXdq@yK~Vst@rc@k]lEgp@{IoP|Z}fA|OeFl|AogBlNsjAla@ql@~d@iOlSwd@rCsMkSaT~M}x@|_@}PjOqu@\"],[\"qn|_@iptG~RvTtP}NWf~AtTtcAoBn|@gHlJkTucAaQqC_YdV_Os@zBsi@d]pGyKyP?eX|GlGzBiTcg@y@me@v`AjEzQnKqDjOdT|CxTiTuKoG|F|Rjj@}ApKap@qXk_@xe@aLmNDkVpk@ei@nYyrA\"],[\"w}u_@ywcCnGhn@lPiT}Frp@uM~IcLgmA\"],[\"ohw_@cgeCyEcVqEctAnCaW\"],[\"q~w_@eijCiLk}@zBcfA\"],[\"_kx_@a{nCgCkIe@wKp@}JzBcGlA~@^bAZzCsC~W\"],[\"ufx_@ar}CiJeS~EgZ\"],[\"i`y_@kyqC~EscAzJj_BqN_@\"],[\"i`y_@astCyJ{Ni@anAzLjg@\"],[\"giy_@cxxCcD`KiF}YjGgc@mBdq@\"],[\"si}_@yd}EuXy^@qk@je@bXDvFoUa@jQ|M\"],[\"}c_`@id`FcVsu@la@tn@\"],[\"i|g`@}`nDna@|m@oG~m@uD}FuE|FcJyR\"],[\"e`g`@wyzEpg@sc@wFpmAxBlJtBoDSnh@wUvGtAhh@gLvOgXoP}Owu@lLit@\"],[\"yji`@su_GhFhNhb@dIxKfPpXnGwnAqXoDo]~OZ\"],[\"aje`@mpjGn\\\\qi@pPrU~VjCeBdS}@cLyI?dC~d@uT_e@|Ghz@kTem@|F`g@}FdPhCf}@hd@ny@`E`\\\\vOlA}GsC}@ca@zSiBxAzHiMxz@gSyMxKxK`Cf\\\\aKbc@kLhBhCla@aHNkB{PmPve@oW{S~Aom@kGgZyCfPqOD{EbPtAba@kOoNeQax@{E|WoMF~Cr\\\\sTfYyEqgAhT_WeR}@wTwi@vT`PtCcL~JzGcDyT{EwBuCtO{GoG|k@w`@vAwn@cUqG}SdLoGeLnGrp@ia@bl@~^|z@iAnK}QeAs\\\\}UpC|i@mJ{ByT{bB`D}`@hZxe@~ReF~JyQ{BcjBvNum@`OcCzi@t~@hc@iT\"],[\"sfk`@wvaD~d@cj@vGx\\\\a]ze@y\\\\aj@\"],[\"wml`@_~dDfE}o@lNeJ|U`YsQnaBaTmY\"],[\"sep`@ghbDdUtBpJeTJieDdFtNqAbmAlKlc@_Gz~@pG~UzkAdMgXrCapAcLqkAin@}QiYbHw\\\\hC`j@rGmU~Yvi@\"],[\"chh`@qsgD_Igg@vEg@t[lRxDz^eLhM\"],[\"wea`@akyCj@`BqA\\\\OpAyA~@}@xA_AvBMcHlAyB\"],[\"ema`@gpyC{@}IjBeAx@xH\"]]},\n" +
+	"{\"countryCode\":\"DJ\",\"polygons\":[[\"}j_FmpkYtpHbeEkBvHfOvTmJl~@yi@jYmNffAbR|sAf`@|u@_Eh`@nR`hAwBhmA|Wdc@zK`~@yIzKsSlxAaIxFok@eNewBo@}lDhWagBaZyR{H_x@coAosHe{E{g@aRurAydA`Nuw@btA}`Bub@wk@qFoY_v@lCccAgs@bEwRex@yfCbcCmfBvJsQ}EgItpB_Wfr@a\\\\nfBR`a@rUjVnv@~D`r@j_Bl}AtC|i@|LrZgCx[f]piAheBfcAzDdXuAtc@wKvK`E|SlAcG~h@sE~Tug@wKsXhAaV}FsBoJzL_R_QkRksAwJuOpFcKsBc`CxFoLwTi]ft@}R\"]]},\n" +
+	"{\"countryCode\":\"DK\",\"polygons\":[[\"eeo`@_dhDg_@wGen@bTscAqXkEzhAj\\\\|BfRfc@cGfQqNbFim@cFgKuJbIq`AxK`Y|E}@zC}rAuuAtXi\\\\aFedAfYgOzlBw|@dqAyDwLuE~Jj@j[bM~PbPmSpGnPbPsl@xI}Dv@`Gkb@xiAoMn|@ooBau@q`Dt`@rEgMn_AwYj[hFjImF}b@k_B}Wm[_o@bm@yh@bFkM`OaU|qAfhAhH}QxOoz@Zwt@aPkwEbFgjAeb@eVyX~KwFb]zQzBkRlP}KdDo_@~A~GdW}HiN{AaCaq@jXskAiT}^po@aUjLmUeA_w@cq@mUwNl`@uV|OiD{c@yd@ig@iGsdAoGnn@eYkUeWmp@fB_d@gHe]xFwYdUaH~a@ua@~Srr@jIqAfNzVvOpE|XkFwFoSmSkGi@k]sChTsXiT~LvBtBgnA`M{BxR`Sh]mn@sLyKKoO}FFvAjp@wbAeSiOtKgCxT`Oxk@qFvQgPyb@gRaIsMv[gUlLsb@gUkRx\\\\_[iVkc@iv@aPu{A`Ps|AlJpGpDwTgj@uq@eKcl@?s_B{\\\\`jAd[js@eJ~UtQ|y@Lfu@|[jzCeb@xpAnK`@`Lxj@oEt[bYnz@wI~^~SdWzIrqAnc@nc@vZfGpR|V`Nzo@hf@ArHlH?w]|K[kChm@nG|ZpG_y@qGit@vT?hMsLhQle@lDcGwG`]sb@th@aQzx@gk@j^qGlc@pc@eKhHxI_i@`EwXiFwvBc}BiiAehBhJufBcMupA_Teh@lKi{CeNmpBmo@wcBgfCmeCqqAsnBcEcoC{\\\\s~Agz@ytBsCqb@zGa`@xVnaAti@fr@pd@xQnsAsgAnZfQr_BqLj_@tx@hX`UzrAdg@zIbbAm}@fxChThi@yN}m@dw@_vB|EyjAbPhTz\\\\pGnoAmQle@g[lHltAqKzb@dg@p|BdJhvAeLgyBcPie@vBaWyRy]rBoSdGvClCkh@sHsx@la@uMvPzIve@tmA`i@vCjLrMyBkYep@oFo]}x@pC{KlV}GlVm`AiGg{Ert@swAdYfUfl@pIn`Ar`Bvm@nIuEnh@oMpM{CuUgOq@qH|c@`d@rp@~g@iN`F`O[jQiVbPoGkTiI|HfB~}@gVv`@wKqm@rKk]wGkGwPzHuSiQwKp~@pLvDzt@psAnY|Vjm@k[lb@pTO_\\\\vRrNvk@lCpXve@jM_IrIxIwAjRuZx]~QnqCzHwNzLu~Af]vP`IqYfGrX~TnGeDtMxTluBiT`aA{@hpApEhAnBaLdBat@|d@{p@z@egAnh@jwAjMlBxKf}@gCx\\\\pJxCxHr`@_Ce`AbJcc@`V`FpGxe@hTeLbPlCnMyZtNtE`j@in@|^dOdBrfAtUds@jM_OtCpa@bEmg@`R}V`QzYzJlr@|GcLeP{lArXur@|Gwi@hz@cSd@j_@vF|ClKsX|Q[BlOmMjM_Cxl@wGaF}MbNiEcTcKfQbBrPf]pXdU|v@`TdKyDl_@bJni@uArw@yZvRu\\\\ttD\"],[\"m~k`@gjfF|MzVjEsWnLjAdL|RhDzZkKf`BdX`tAiaAbrDwPhaBkOpRqIaCiCos@aLqJea@zv@uSoGaQ_YcGqaBhY_bAvVwQvj@clCaVtLmJes@o\\\\hCfr@euAfC|GbJcH`Tce@\"],[\"kgo`@kbkEz\\\\wi@fLlsA{bApjByVzL~Ue}@nZae@VcYoNr@z\\\\gVg@yT\"],[\"{xq`@woqFjPgjAf[{Gw@b~Bbm@zvAeL~x@}SiToGlKqG{\\\\eL?pGoa@qGea@kTqCwK|XqI`@gAcQjRqa@\"],[\"uvp`@cpbE`z@e`@nJhdA_Rpp@yCsOjLsw@aTfy@lFfs@yHbTsg@fM~S{v@sIdHeByDsQpa@gIuF{A`mAoQ_MrB|^gGtIaNcv@Zao@lXed@tNmbA\"],[\"kar`@k_sEqo@iX{VgWvB_MdlAz\\\\by@nk@nrBj{@tIn\\\\wi@lVsTpa@aB{W{ZeZ^aXeLdC?vT_NwG\"],[\"gys`@s|fHpGpGfSkCnYxVnOcCtOhXed@luDk^j{@asAsN}l@i_@de@qw@fScn@Ec\\\\hP}O\"],[\"{}w`@wpdDpBlNeXx]s\\\\tRmUeAeBcTpQkA`Fc_@fTfG\"],[\"enr`@mioE`@ne@pSnh@gT|kCyPbp@]`QjQdDmPna@?ln@}\\\\iw@iZzYv@`m@eIhUhNtD~QsTlIdAi@`Xct@vByHpi@sNbPwOcF{i@rEmGnr@eJdNkKwe@qGba@?iTgNlr@{QbTnRwr@mAeOca@|sAg]gp@uAel@nN}FhHa]o{@eyE?wTvKuC{@wGoPCi@sG~s@_cCZdZfVuEnUnh@lIgISeU{LiKaPuhAkRjYuAi\\\\o^fKpGwKg]vBK{Jpf@yn@ff@}VjP`t@]zt@rJxI\\\\cXsJc@bKu~@br@u|@ri@qV{IjVzK|Mrl@kYdw@zRv_@`^\"],[\"at~`@ykuFfu@yc@v^|hAwJlLe^bHer@cv@\"],[\"mcba@sfmEg[aLyWpg@qN_AuL{Nle@uUxH_]pXhCrGyIuGcRjTrXbj@pGrIfS_Qte@}UlDsKqB\"],[\"cco`@gdjFeB_b@jd@cm@d_@l_A|x@naAtlAeDVlK{w@|p@_L[yXwW_DnTuOkCi]`m@yWvHyPjZu_@xCsHbRaP}\\\\lK}Z_Tgp@g]rXiTreAi@pf@cO~]|XgcC{m@tcAuD_u@qF{@}C`p@eGtI_LqMgKtI`Pri@qXjlCnG~kApGyKwBdd@cY`M`CiQsKqJrCtZsQHe^lv@sIiViCz\\\\vKhTsOxKi[anAi|@l^_Fzr@}KyCuD{]wSyCw^bc@mXvaBtCsaBeg@tnAmJ|s@nGoaBwKapAbGrXrUgk@eD{NyK~XsCqGrCye@oU{dAwYiF_R`PlFg^cKc[tAkT_PgPwWiBuStSoO`kB_Q|RrT_oAlKg{BwPqhBjDeFtXrXrIgBcAjSoG{BnG`a@xn@yi@hKvG?he@nPh]}G{pAz\\\\}MrJ|`AAuv@|Ya]jPbHml@in@wMpGg]cPyKqi@sPeIwe@pZ{JqCNeJhRuf@dQzBtb@qW~p@{KfLbOwBj`@he@`f@cBs]_TgYbNoWwBlRdFi@jHq]}m@wTucCbj@_K`TfLhnAoDpIiNsIo`BelFpQsuCna@{sAdgAfcAzpA}v@ri@|GxK}Gv|@zfA{Dfs@zMdl@x]|r@rc@h^z_@wOlNi]hEsz@vUeh@zl@}QzTjl@pP~aCpUpe@`^xLmBlJ}TnA`Hve@bP}RfFgkAnMgDxRpc@j^_[hQ~GfOlgAwVtv@gA`T|GdGbh@jCxCwPsB}FeGtCsCkT\"],[\"uira@cz_FmKkD{GkoAf]ti@p@jV\"],[\"{gsa@ekkDjPba@xIfBzKhbAoc@`l@a@vk@uQaWkM|CsIq|@aJsN{Bx\\\\wTgTcPdAaIidB}_@q`@bDkMsLaWlPvKtO{Gn\\\\|L~CvFaPzK|GjPnd@wH~GeOvCjPdCyG\"],[\"uw~a@}rxEf@oWdPkHdFdpA|LgPbI|@x^|e@nAhRkUx[iRn{@_Xgx@\"]]},\n" +
+	"{\"countryCode\":\"DM\",\"polygons\":[[\"c|gHxnmd@{@~Eeh@Y_yAf]uh@x^}`@nIiVwO{BvOea@mKjB}Y`XyXeEaP|J_^raAg^`tBS|TxKfCrX\"]]},\n" +
+	"{\"countryCode\":\"DO\",\"polygons\":[[\"y}_Kjoxj@zFeVin@xKmF|QaSsO_[qt@wRr@mI_aBzc@c{AwAca@rKqT_@_b@kSuKfUgKoLyKeGpG?oc@eX}P{Pko@xFuRxP_ArCaKcL??w_Azb@eb@vJ^DoV`i@qnAdCs`A}Ow[hFkW~{@}{@bOsa@jQweBab@mm@Cgz@fh@qd@dq@rI`OiPzq@iDlmAu}@hEaa@mMklAcQke@nDsw@rGoDgDeJjT}m@qVqQcSsm@xf@tHfCqY{G_XtLuBxs@|a@tIrWqG~o@zBvt@kMzb@_FrwAl~@bIzQsNiDg|@xIm\\\\s@oNgLvTu@k`@lHySxYcRhYiqB_FqT_HrJaHcJr[{UlPi]wa@kp@rBob@|PmYjTktAz}AibBn`BkkC`XgPnWlBt{A~aAlk@~GnE`e@wTdl@rEjI|T_DbkA~]lE|z@uBvI{Ul@uz@~b@eSlQmVpl@fIxdBca@h}AdFtc@eFpKnJ`LxMhuBq]dx@rXfCt@jMwYv^aIh_BvBd]la@~gApZnWzVlF`|@``A{BblC|Pbf@|Azx@eH|ImQoJmX`[c[sNe_@xCkZlc@Mfh@d\\\\bDnPpQfGd`Alq@`u@MxSgWlWcAlp@z]`KpeAkYjoCnxAdz@r`AfuAbb@~l@h_@}|AnfAwF`Tj@ffAe_@g]eu@fTc^yBch@le@sK|a@u\\\\uHufAhGydAmg@kf@dhAkVzRuIv`@y`@aDgfA`aAkK}`@fG_n@{FeWge@q\\\\cSk^w`@{MghAfGqm@ff@fEjh@yIx@}Rar@yjAmkAyTaJgd@{AiUjo@yp@nd@oP{Y}c@oQykA~Ecf@nP\"],[\"_uuIx}sj@sDlIoLBe[uKrXw`@\"],[\"yzaJ`b|h@|@yg@lc@{OyKdg@pGbh@qD|Tul@d^\"]]},\n" +
+	"{\"countryCode\":\"DZ\",\"polygons\":[[\"}dgNnd}AsjG~pLyjc@~y|@snZgAuSwQ}fAogCog@i\\\\sv@crAu`A{dCun@ax@gdCmjEvBugDms@ww@eY}dA?}tBhf@elBuj@ms@q]arDwGu`G{T{wCxw@{TlPms@mPc|@giAo`A{wCocByw@c|@u}ByzDo`Ag|Cc|@kvAsmA{dAs]e|@mPgiAkCa_B{T{gBks@}tBsmAvGgiAo`A{m@v`@wa@rHmW~g@u^`YmA|IhVte@eExP{IdAm^kP}gAB_]ii@oAgm@s`DwGaRqi@nB{p@c[wuD}a@kfCcLglBw}ChdAiP}b@kG_sBqYeoBpYcoXeUiK_LbBaJdVxFhl@cIvA}SsSo`BiSiUiOyNgz@yn@_x@y~BjwDeZd{@ovBtsAsX{a@ot@eAk_@|k@{s@r_@{Xr^_qArDe~Aak@qSzAmw@d[ac@vs@cd@gZetAtHepBu]}bCxn@kr@tf@_bAsaAaHx\\\\__AfjAmyAo~@olAjbCae@zUeA`[em@zqA_\\\\`PqW\\\\iFah@fOi`A?}z@e_@{xA}@g_@sXe]}M}m@c~@qnAwKawAm]um@aO}OksBws@oBaUy}@}fAsY{cAvD}Tec@ut@hRow@xMwJiAgh@eKgVuPoFqDu`@yp@_\\\\cRp@zBme@oMmj@jQc\\\\vT?`HeItVyvAoAwe@aj@ohAetB_y@ah@sx@oYo~@m\\\\gLwIccA_aAowBcEgcAgWudAoXud@iTyKsT_}@wP}hCqQwq@rIiaA_Tw_E?gcC}n@wkFvGeXtNsFjD_ZqB}~Ak{@cwBge@qb@aa@_s@}@uq@xj@umA{H_k@ia@oIvRweAdAovAg\\\\whB_i@sx@gOoqA|Mom@~Dy`ByKea@bG{^wKe_@nGmc@oGgjAlPscAiF{rCpp@{iCfP{UnCyc@hg@bHdh@_zA|C{i@{LicByZqs@cg@a[oc@kvAzDmsAsp@clEeZkd@_l@iEe_@{k@yMeo@Qc\\\\|Tcr@pt@qa@xKajCrMw\\\\|OgEpFcj@mUyxA~Emg@s_@mn@i]cMie@bm@eGsXxKq_@gGeJfG_ZqGw\\\\rReL~De_@cGiTbi@u|@Not@vM{ViCic@uMkPxe@dNdGqIvPbJrLyUrJs|@oPmwAiq@oqBbSia@t@}}@nLom@uVavAn]qB|`@}SnSnu@hKjwA|H~B~Tma@zj@rRntAxaDnTf@dMynAlKo`@nJiDtp@`A`bAp\\\\`|@gEdcA|XtaCjTjo@eIh|@eh@lfAkGpsAj\\\\lo@wAfUkMz\\\\efAruAnhAbUfGpfBf_@x}AaJdj@~a@|SeOnhA~wAhgAbcD`iBjh@n[rrA``AffAt|BpVty@uJzf@iUlo@wJjwC_`BltA]f\\\\qHxMaOvMyjAhn@kpAxG{s@d`DqyB~_Eg]dgGkmLtSuNx~b@ugHzdAvpCvcEocDfxBwhAzjH_bBn~A{LpuB{@~bIlm@pyFibBpW^baChk@|]lj@~f@iPtr@xg@|pAzT`gFc`Alf@wq@h`B`Nr]qGz{Axd@v}Av{EbVbBfZvYl_Arb@ta@sMvgNezJrf@mGh`GsEtOyFxp@a|Av_Bo]bkAehBwr@ksDr`DmmOpfNejG~sr@zrvAfxZf~_@jgHfao@gaA`lBu`@`a@_u@_UqQ}Tkm@qJyWic@gMcEsk@vTkU}Bmc@pSeuC{IoOfJwjA~|Ckm@nlGmXnfAglAls@qy@hgAStWne@hw@_YlgAlM|f@kf@vU}D`o@gy@hiAgmAnHwm@ts@sd@hyBkn@`i@iBxwAub@xDs{BwToaA~S\"]]},\n" +
+	"{\"countryCode\":\"EC\",\"polygons\":[[\"m_[|rbo@bXuc@tnAy`Az@cf@ji@_S_EmQ~Fsa@ntA_tArU{|@n|@kpAvKiYnOwbBlYmHfMoa@cToyAtMuQ|k@aCrRgLld@{~@lBan@tfC{Sz[gVj`@cvBsM}v@lDqT~Y}VrJu|@j\\\\sf@eE{s@pIuf@{Wp@}AwF|J_|@nYme@_WkaBc{AfDeB{^ca@qg@ha@_o@fEsu@`[mIiFih@tbBm~@|_AyrA|Oag@uBknAbiAedB`i@kpBnVn\\\\V|k@dJxOiZrcAkGhs@zLrKp_@wMb@kXrg@{y@peAaSbr@m\\\\d~@qyAnq@eBfZ~Vlp@z@nsBa[vUoNfBzjAmd@`c@zcKbgBvpJzbI|tGzqJlcGnbVbg@`m@rsAb~@ta@~e@bo@`ZxXb]tL@hZ}W~\\\\lCpQbf@{{@hRoKny@||@pF`aAtWjt@a@~DvNn~@{Bpc@be@p}@pIzQhg@djA`HvlCbaArm@wD~SoUlc@jS~b@x@vXj\\\\pd@jrAdYl[h`@vQb{@wPjKrEpElj@xt@bQ`_@ba@qRdXyJj{Bsb@rVcKdVgVvIkVpa@o|A`]qYfQau@}KkHr^uo@r}@v[`uAqBfVgq@nk@gEto@wp@`|@eOxd@lHng@jv@n^|v@tnA{Qlu@uS|LaeC{zAdHtlAcpAxZyi@yLwOeOgCcWhTw~@yk@oh@q`@sy@}vAbe@qhAeEyWjD{GrNyf@aEyPpR}c@uBqSzm@qNF}\\\\kTra@rCsa@mPbPaPyKelAglAin@tA_Mvk@n@qr@eu@gr@mKucAoc@aX|BcbBc\\\\c{Akc@_h@g^w_ArJuDxZa`Af^sgBDm]~Pa`@eCsPgZwm@}_@bWbh@nO~@jBxWhX`J`j@bEdeAgWvxBvHd_@|I`VdVlPvg@kRvg@gIhBiU_Za[}M{@{OdLkR{GyGeLdCbLvAaPdVvIfb@}fA{NcFkRcXmP~Jdf@`qApf@roA||@fYlb@wMzVtC~DzU?jWsTfYpEgKrg@ke@jn@qRvk@i}B|tBsXxzAm[vd@qE`^ec@fLwy@zo@`Kyf@lRgJwG}d@w}@omAcd@wCoj@tNqKiQgN{@mqA~QwaCjz@oUmWw_@nAyL_^iu@cCeMoLgN^wzBduAeq@fT{KcCs|@gy@oH{y@pJqRqOuVlJ}UgQ_\\\\yMeMqyB_^}t@k]aC{O~[cIlF{OtA{p@kF?qN|x@oi@j[oq@jHqz@vZkY{f@wgAme@uCmLiYgObImJa^_m@wzAmlAaSuDcGeSsVoHwt@}G}wAdFgWoFjSqRsX{Gky@z_@mYCac@oOmh@bK{Ot`@yYfJ{YDwo@oSwMoQtCqg@sZmiAep@k{@i]scAfr@|Gtn@oa@ye@zScp@wHiEcJvF}Y}y@otAlB}j@yKyx@|F_NeKor@qhA{bAlNqZtl@kLsNoQcf@uCmUaOhC_P}_@wQhFwc@gYbj@we@_^\"],[\"f_s@ltzo@Zk[h`@ydAhHvA~g@~u@hjCzsAfGll@wHze@anBiAmgAe_@\"],[\"pdYhevu@tQe\\\\pLYtBff@cVnq@yKyK\"],[\"`oW|fdv@bNoG`i@rg@mA|YsUn]wv@q_@\"],[\"nkLphou@oAy^|o@~Hdd@pc@nEfU~v@|_@fVzlAeRjt@wY{NmQg`@kTqEcMyf@as@_g@}Mg\\\\\"],[\"loI~p`v@jEeFdrAlJpe@|d@Hx`@vStMaHjx@kd@z`@eLje@ydAqIab@{h@nA}_@wT}xAzGgLfRk@\"],[\"`zGtdxv@lVpaAiTx|@_n@l[c{@jIl@wu@uVwaAdDqJl^cb@\"],[\"`qDbzgv@zCmGhJdC``@zPxBhJ}HnsAoMza@pD|L_g@dRuAvNsc@oYi]aBiI}]n`@oyA~n@iq@\"],[\"rgMbtlv@rMkK|KlVf|@rRvYe@eCpLle@f]gCvg@th@tfBiF|tCcLrU_b@dEwBvRoWpNgv@wU{lAc`BkLin@pIco@e{@kk@{MnKwKda@q`@r\\\\wbArQ}y@dtAyRWcTlSyKg@oC}MmXvNsiA|I}Id`@lXdu@oVdXyHRkg@m}@_XoCn@{Zi\\\\ms@_AeQ`HaIhaAaXbVqUhCyV`gCiUnYo[~PmA|l@miAr]s]`}@gCbc@pXeH}LrYkFrJkf@~L^`IyTzNB\"],[\"epDbzfv@cPdReMk@cPsV~Gqi@hUyPdZd\\\\\"],[\"mwKrklv@hMoXf[yFxR`IeJzZ\"],[\"{yYp`do@f_Ao@hOfGeF|MxKxKq^`W\"],[\"oaZ~i`w@`@{AtCiA~Ab@tAbBy@`BwBt@qB?\"],[\"qfZvk`w@EyAdAz@\"],[\"sk_@fbdw@sBmBvA}BnD?^hC\"],[\"tpo@f~uo@`b@k\\\\h^`b@lZxApGnZgMzA\"]]},\n" +
+	"{\"countryCode\":\"EE\",\"polygons\":[[\"sfib@kvyM}_Bqx@q]ch@qr@oDaYhJaa@cI_Wmo@kMmFkLzDw_@vaA_Fnr@xIt[le@bQ|VfZbApu@jUtZko@dqAeUtzAvExx@ib@xLgU|Xsv@_@}Kdz@}VlMzP~Z}ChHwXmIay@xKcGq]gGjImN}@~Dc_@iGieAxK}`Aq\\\\rI|Bqv@iQjw@xHpO}FjJ~@`tAvQtkBmUeV}Jed@wZ`f@yZvXoKFqHqL_E_wA_O_ZqUxBmVre@|Xca@zBhTjT`PmLhx@wS``@?mPaRZcS{a@jHePi_@Wmu@h]mJea@pAa`@mRsf@zOkv@iKwFkH`KqJwSaEcoDvLgYoMeKol@lq@kLsHgCs[zXus@Usb@m\\\\vc@oImxAe_@gPgCmv@~Skn@_Ke^rO_z@mGwG}KvT]}U}MiIn]kj@y@sPoW_ZaRdScXxEqEiR`f@ypAoEso@xKot@gBuJeOjDiEoV`]}cDqMjC_GkG_D}nAkUb\\\\eXnHsc@{@xv@{tA~Gaa@_AeQmJ{FsX`T_LuByBnKsH_N~Tst@xZgG|HiSwE}Tg[sLzUke@s@yKaNJaGaN|E}\\\\nVca@a@cuBp\\\\cgCoKiu@`Deq@xm@edBxOgdAc@qeHtZeaH{Kcq@o`@_h@rYqt@fWsS|Nm]vJ|A}CdN|Q`aB|Ge{@xGtKW`hAfRjo@`JiDn{@f]xhBtoAxgAdrChjA~h@x_FeyAhn@~d@x^`Fl\\\\{FfrAksAps@WrFmVbNyBz]jEhPkcAnQqNtUr|@|I`mBxFiQ|Ld_@`c@kBrTvgAtM~Gv`@eBdKx`@va@qNdFpSySrqCgd@d`AkJvbA|a@`o@~BnoAxZ`o@{C|\\\\vFrYu[|i@kVprAq`AloA_k@zxAwi@jEgGtiC}h@|e@xEnoAwL~Y{PjIiLxs@mWzd@gF~k@cWxY`BjRxIV|YyTdMl_@cC~Quh@|TkDnl@`e@pyAvB~n@xMxa@uH`s@zZl]p@j}AbVrx@l]rf@\"],[\"w_nb@ssrMaNjBaHyIa@ic@vMmDjLfKpDzR\"],[\"otub@cvdMf]e_AxGdDwMvf@|DzMlKgXcA|YoV~o@vVhOdEz[rTs@tMtZdDxKcJ~h@fDpCvFwLf]vh@~Vdx@|@fw@xF|EtHc\\\\pI|SlAv`@oMzGaD`T~Idb@yJvQbRfRoLbPr_@ppBrJrMtq@hH~h@pb@~R}@hp@r_BJtReLvOcMKcO`P?kTs|@av@z@we@ui@qa@mPtpAic@ra@oIfjAyY|_@iGkFbD{_@yYxTaBgLhPi^{CwJ}IhDqEeGL_Z_p@vg@ep@~eAbGcj@wK}OtB}h@lw@sy@sg@}Gya@qs@eAuNjU_IjDuZai@mHuHaTaDewA}Uou@aBqSz\\\\qg@oGgTrF{`@yT}m@nBcdA~[uhBz]wb@\"],[\"mcwb@otgMhNsJhMvVuGfs@hFhZ_j@vlBmOaI\\\\m[w\\\\aT}A_ZdPyqAfJwJlP^\"],[\"c|_c@oigMlLaOzO`B~Dpt@eGhn@lHpd@a`@v]yJyIgBgW\"],[\"oh}b@kkrLnCn{@c]||AiIwEaAeWiHqeFsGbLfA_\\\\aUPwLyk@k]mPh@up@pHs\\\\vP`MpUe[eAy}@jNq{@dGuHtT?vZ}g@z^{NhFjLmJ|e@fGbH\\\\bf@zFvFdDkKhLDvGxXyH|h@{Ok]qEvChB`YdJpXx_A`r@zH`}@mQzx@cLiTy]x\\\\_h@fKyW~m@\"],[\"y_kc@my}MdXkTfEpCkFp`@yJb@\"],[\"mdhb@yheMlPaEqHv_@gMaC\"]]},\n" +
+	"{\"countryCode\":\"EG\",\"polygons\":[[\"cr`Rg|{Sb}I_pCpjA_N`]{Rjl@xDtg@kl@lnEqrAfl@cD`{B{r@nyBiRva@sRvnBx|AnKmDvx@dInTfTfc@vKrhA}E|Wj[tt@gCrIrO~m@cGtTrDvzBdfAdRcGl_@ba@rsAvc@hu@u@jc@oYjy@xPnPwIto@tDhCjTb[rQ~DvW~_@lEz@|Xjb@vKjEb[pLmCDqUpX?kc@di@iPpsAcl@xh@ma@fs@nCjNuOrGef@z~@sNImO|\\\\gt@zX_LzWo_@jI{f@l`@ij@vpA}q@lg@c{@reA?oGsShCzExN`HsJcJdPaaAdTov@}Sul@p`@}uAlDmGoFiYpSwSnh@yUzI}}@p_AsXdl@}`@xIwJ`QgZvL{`@BgYhn@kPfCjPzB?lGes@uDsXhFcKvRqrAeEce@lYoQlZyLcLoPBca@r`@wMwG}M|MmCcImP?xTtKdTwGeK|IHjXjQxUj^nAnH{UvqA|gAz[`Qxz@jOxh@g^|Vwf@zhAepAvmA}^tqAsOpLOpXzTdn@O~^}\\\\pXsCjn@wi@tOac@n{Bwe@zv@wrAlv@eXp`@uf@fd@kK~{@qt@jHsQ~RsC|b@mv@vTmBhTi|@zb@gk@bf@eYx`AqVea@n_AdMrHpk@w]_D_Uj_@dLtO{VvTuIwTjTpL?eKpG^`JjRzQ|m@wi@tTfCrX}m@d_Buc@`}@uzAf]?pGpGhd@qFxe@kg@xZH~r@w^nTwZtc@?mFxUvJ`Fvu@gA~d@jKdQyDdYkl@b|J}iE~kD}`Cf`Biu@|vAg]jo@ke@hvAwf@baCsqA`d@cHlWqUzv@wFd_@ie@djAw[fj@w^nm@xH`HuXnt@c^hhAw`Bn{@}Udo@wg@m@mIkPlI]iElg@ys@rb@qIdUirArq@u`@xDdCsWbUlLdh@qJvt@y\\\\zs@lQdMp|AfIzNgD`IyOhl@AxsB~Kj_Aic@jpB_Pxl@_\\\\r{Er~Fs{Es~Fn`Ckm@pe@wi@me@dX~DeStv@gQzj@cf@rg@gkA|Lm}AdX}`A~h@gg@zn@iM~g@ufAnj@iFhd@{kAvnAi}A`DeT}HwDvF{Nxa@oUtYgo@j]{@rLfHD`liBcnBeb@mYhReFnVhWzf@`xBldAS~kyBgjkC?qdAjNkbC~x@ssAiCygAbh@_YdCaz@kGyoClfAsd@jDs{Ais@ygBqsA_rDou@w}CdeAyaAlMs}BpE{RuFgfAodBmiAmg@{Yaf@pl@yEp_@eQtP{bCydA{fGa@exAdz@cvFvZksFlXieAaGk}@tXqv@pXkjBoGmKDqm@~FqL|eAa_@tR}_@xQkwAiE{LjMo^qd@kwBtmAsZrHsQpBimCfMy]tEqfAwMeaAvR{`@`@u]hy@wpDv[yMhs@gwByEgvBeiAwpDafBecDmOuH{Bqb@cU{[iCdLwImGnEgLmpAcjBzPx@`KsPzGqo@jThSvKuNeL_YbFdB|BwJ}MqXgLsCbPmK}S}GgCrH`Gd\\\\cGqG?|m@uNcl@o\\\\ee@ek@gU}n@xAtV}KnIgTmJnPm\\\\fDv[{y@Wsp@wkAypGf~AbwEpFzj@jKnHpGos@qSg]|Sad@yTsLuMmYbPuy@cNq\\\\qG?xKsXgr@g]VkYbN}\\\\cFgE{ChJ}AaKeM`KaZ|u@Yf[wMsiAnPglAjtA{pE}VuvBw`@ymAdH_k@njAaq@r`AelBm}@z~B}XtOYwN{t@dn@_DxPlGrEgCpGbG?{B|GxKzB?yK`j@qGcUhVfLzI?kTpKfAlCaNtCh]nG}GrOnI~GaRmBfe@h]XlObQ~Bq`@tOoGnPg_@oPyKnP?fCpG?qGnGhCsC_PvMBaZsd@hKeM{BkPph@BzL~KqGgTxToKvKhOh@{WnJ?zFeM{F_OgClNyKzDnGe]oG|C?cLiGbC{CmGtCoGgJmAnKm^wR{]uSbL?aPkZiBsZjNyCyFcG`j@oIlCxMwlAlQ_GrHq[viAwiAt[qx@sKgcBkm@ifBz`@xu@p]dkB?i]nG|GiC_j@oa@glAfJaNqEyMuC`P{EmGxNaYxK}B?rG`Ek]uImGeG|G}Gg]iTgChTfTyBln@s@{Q}_@q[kTmp@hCuIzBlPzg@}c@zEdWbQaOdSbF{Gav@oGrOcPii@dL}XqKNS_HyBfXuCicAkJnD}A}PiJ?rXasBu]{bDah@mwBet@y~A\"],[\"wfjO}dvS?yKbr@oP\"],[\"mhxOcewStCuLj`@gM{a@hz@`EbMcQrKoEq^\"],[\"}~`PunbTtTcUgE~~@mNrLqXfCyKkPxKyObEjVtJeBcCuc@\"],[\"aa~QqbcSaBnDaAkGhI{B\"],[\"md`R_{jSx\\\\m}@{m@x_C\"],[\"}{`RcaeSgGiOlIsaB_A|bBhp@|gB\"],[\"e`aPmjeTzVEpEdPsLxYyPoK\"]]},\n" +
+	"{\"countryCode\":\"EH\",\"polygons\":[[\"mg{OrekD@csAjm_@hBdBv~_Atnp@nApuAbpEh_@|dD~|CjvFvzBttA`i@fG|f@qExwBqe@huUyo@Hv_lAvjGbiA~tAyKwz@x\\\\m{Iyw@c^ipDwEqpIeKu|AbEgtD~WitDbEaqHcEopAc^cw@g}@aq@_}Aqd@}cApKioBgiBaXoK}cAcpAmiAew@}vA}Qo`EoKksEsj@caEaq@cnDepAgd@m^_Xsj@avBo~GicA_q@caEgiBij@sj@gbCg`F_}Ay{BcnDajAyiIqvAaoCcw@ajAqKaX{Qwl@kbEkcAaTo_D_kB}dBskBu^cL{d@?o]vZew@pRinAsdHcEcfBhQa`CdpAkzD?ye@c^}`Bxd@uqA?qr@uEqr@muBazCyVkm@iF{~BbEc`C}Qk`C}QsX_X?ghAxY}_@tBg}@uP\"],[\"mg{OrekD||BgZf}@tP|_@uBfhAyY~W?|QrX|Qj`CcEb`ChFz~BxVjm@luB`zCtEpr@?pr@yd@tqAb^|`B?xe@epAjzDiQ``CbEbfBhnArdHdw@qRn]wZzd@?t^bL|dBrkBn_D~jBjcA`Tvl@jbE`XzQ`jApK`oCbw@xiIpvAbnD`jA~|Ax{BfbCf`Fhj@rj@baEfiBhcA~p@`vBn~G~Wrj@fd@l^bnDdpAbaE`q@jsErj@n`EnK|vA|QliAdw@|cAbpA`XnKhoBfiB|cAqK~|Apd@f}@`q@b^bw@bEnpAcE`qH_XhtDcEftDdKt|AvEppIb^hpD{jBsYm`A}@eWuK_NMgLlKy|Au[q{Bg`AqyAscAgK{\\\\fLaPsXucAaf@oa@crAgVml@al@siByK_p@eXii@iCkF{^_r@cn@yv@iAbC|d@qJmCqs@cm@yfBip@g~@op@ut@mNcp@{^in@}m@s~@cc@qlAp@pa@rKvKnh@|NpOpfAb_@~^pa@oEhHmIcCe_Awf@}nAu|@wb@ol@oh@o{AgyBslBalAqzAmkA{y@yVo^qLss@}Tca@q_@gH_x@g`AcdCgd@aj@tCcf@}GoeBxKogAaR}gAeVep@_i@yg@qQmwBcg@{~A}aA_p@aPgl@{Ba[vFme@uOal@{g@mUs~@kkAeiAoKirAib@_lAotBy|Dsd@yc@sdBoq@}dDso@_{Ak~@{KfCgjA}h@y|Bof@?oxvA\"]]},\n" +
+	"{\"countryCode\":\"ER\",\"polygons\":[[\"mewFqciYdx@xfCcEvRbcAfs@~u@mCpFnYtb@vk@ctA|`BqKt[oA~Z~`@b_@yaBxoAccAxg@eXv\\\\i_AdvB{k@t_@kfClcAas@rl@ezAdpByiCtrEk_Dv{A}pCnbCoY`e@}[nsBa^haAkQjc@wpAhyA_Xtr@kLbw@`H|h@oC`c@tQt]j@~Vyi@rz@]fzAgJtb@iY`Y}EdV`Z~NbKnXzLfr@eAh|@v[`UBrNgdBlq@qRfYaJjn@ps@vQzl@~eAjS~kAcCnm@pX||@~DbxAm\\\\hSka@pq@wi@`NeMxRij@tPYhfAcZxbA}_B~fAvbNbrEqMvPk_@nD{JtXce@fMuXha@ib@tC_}@f{@zCncA~Plf@xXpMxg@fCjVfR|Fng@kXh]cPf{BnW~pBpQnKE`Ku~Oh~@qfAk}@}yAu^s_@u_@ut@~EwvB}`@ofAiv@cxE_nAky@wi@wr@hOsb@mBuz@vb@alA}H}i@oi@itAqWuk@tDsS`Ma`@aQkf@tMiE{Obb@mrAcDc}@eRwn@zNoy@og@gb@}p@yT{e@yEcn@~Dwk@gkCan@eZgJ{TtSm^Ze\\\\e`@{^y_@mx@hEgSjc@iBuGuRk_@eFiFuXzTu]cAyMaIoI}d@_Ck`@mu@yYgP}vB_v@ifA}wArcAie@rXiCtrB}iAzhDavAn`AiUfWpF`v@md@hwGwtAiCpGrh@{NbQe@vJnJn[aInThE~CiOfjBkP~^rCtuB{VloBqc@xTcKdr@elAbb@pEdz@c_@dOxSvHyBuJiGvAaLzJh@jFz_@`p@aPxAcd@{LyTxEcUrRaNjeBu_@vG_Plc@ePtvAnKlQyI\\\\}UnLkL}CoVi\\\\gDy\\\\{TibAbVxMnP{KnDgeAtCwi@aTfEcL_PuCbGwBsXaT|kAcpAdXwm@lL[fr@da@vC{NjNpEhX{Wl}@yK_@iO`QoOla@eBzZweA`N{GcRwd@}c@sYdVgF{@eIuQcBmByRnNmD|ImUyKgLwTtC`P}XvTgCdCsa@lb@aFhFaQ}LkA~BiT|Gb@^rLba@me@zi@hDpYqY`K?uCaYaK?vCwn@`[cv@lSwxB~i@yXnp@g|@hu@eQ`OmXrj@sUdy@ey@nu@mUbf@mp@`lAwa@fKao@qG}Zjc@y^gAqa@jT{g@ve@_Nvz@uz@xZ{IiCgVh{@aq@_[vNqCuKsOM_Njd@yEqN`y@w_Ap|@}Ifc@{UdyBaVpP{{@yVub@dcB}jAnWmm@fpBg_@cGaa@dZuSpEof@fLpAmTg^qXkMcU?rp@kt@\"],[\"ynpHoxsW~@mKzW}CfOtKuGhVfGfj@uOlp@jE~]iNlUnOlVyJnaAwz@~Xn@oJbWyHpGoa@qGea@en@hl@{@~o@k]cAlJcb@k[dHyEkc@sFmAqQfJiAb[yLtM`Fan@nS{g@dLwGpZdEuC}OqIrCv@eLjz@bAvh@eRzIui@sUmQ{LpYiJ{\\\\hEc]dQgH\"],[\"qlxHsbnWtPfJtSeV{RxlA}RcDuGs]iZaAvSyd@nF{C\"]]},\n" +
+	"{\"countryCode\":\"ES\",\"polygons\":[[\"sc{T|hgBeo@jc@xM_w@yEkO\"],[\"c`qTjqx@``@yTrDxQmJ~KiP|@\"],[\"gknYh`b@`[v@bMuXdPgDaCyx@p^sS{AmTiS{P|JgnApL}PzUkArk@hOl`@ve@|UeRjF{w@kd@cJaI}e@lOdQhPkL|]{xAzJ{jAfWit@ZuqBpe@cKzTox@fo@qp@gF{S{Ma@xIwcAk_@e~@db@yu@uDam@rTgAjZgWzRaq@{XaaC`Igj@`YcVo]gp@~Tma@qBsyCwOaEwQpSsJwIaFzEyf@kIcDq^jHiaAn\\\\sbAiFgQnNwuAjg@_f@g@mvB|GvAxCiM`x@si@jh@fMm@aMbPcLxInYr_@eXdB}i@aLkP_Imz@yQaH}Bc`@dh@yhCpj@sUpKm[wEy`@mc@am@wFwvA`[uyAxRmMlOgj@qOySfGifAcTtL{Si^sBk|@wU{e@sLyr@xA}z@`Wib@uAiz@xTvEl\\\\uIpOopA|r@da@B``@oK|WzXb_@ro@iAbl@ox@~TrJ|YoFhNkPdKdAz}@fr@k@zSbLzVdP|Dbu@`tAdJ~x@r]pe@~cArlEbu@zpBpyAntArVfdA`p@hkHtr@zgEv[`[sF~m@|Jdq@zrCn|DfQbKvKsa@kBuIkHtIRmI``@ym@rA}R~XvHxNhm@zz@xaAhFfe@{PrMrCek@{c@mj@nK`rAxMtUt}D`dCzeAxyA|x@~d@h\\\\|o@hpAhz@t`@bf@hnE`pClt@pA|bAkOrvAst@tHtO`a@uHpcA}i@~g@q_@vh@a{@dWkeBdPqRrC~GdOob@lPgClJ`c@pVzRxNve@vQn@`KflAzk@fS|Fn~@vb@f~Abb@bh@r]pJ|A`\\\\rMzGa@bUxWrDdx@gH~Iru@~OdS~[hLdnA|D|[|f@xp@bX~h@h@uO`P|_@fNfPhZfn@{[~Nma@kEuPhDqJtMu@pKpRdZztByKd]yAlfAtLbSxO{Gm\\\\ptAlLzJuAve@zp@~zA~`@fOkBhJtj@l`Bpq@~b@`n@rs@ppApYrv@x^~[sBlXjv@fmAlr@hWta@M`o@sl@np@uOhp@xKrk@oGd]|Erp@nw@fb@~S`Y~Fbd@^~V}Sdg@pG`Pe_@bt@bH~`@aJbuCdEp~@z]nt@K~e@qN~VjDbT{Q`_@pJjpAmP~aAvNpkAgLxz@|Ll`@hGpfAeFpnBfGxQfpAdx@hCjf@~Y~OjMrZbHvf@mGloBz]dx@`Bti@rXplAhfA|h@hK`VjIiBrl@n]d\\\\|A?fKoR`NuCxYjRpIni@oGfJdEdb@fkB}QdJ}Qhd@lCxM}Nzr@s|@tjAlCnh@yFf`@_x@li@wMfa@oh@lN{RjT_cAfe@gM~Q_A{KtM}RtXgOoXq_@t@b[sg@pE{Ud]iNtdAg{@~YeJ{AkZyu@mv@sCuMcT`Buq@sQyPtJ`[yFlZjNde@zq@f@hFxXu}AvdAqwAhxCwg@n{Ai]lNaq@qg@he@~o@@tRle@iTo_@xjB~DlObDaY`BlvCpLl`@aGrSwoD`a@uq@rd@}ZgHeUuYiy@gPar@ocAiWePo|@}VkPpAkKseA_ZwUzIa}@wkByn@{@hp@fOdo@yIrMwq@dXi~At|AsLhYkM_NmNnE{e@mPke@q\\\\cWbMwq@cMcr@siAkUiz@}]dEkcA}x@_`@vFwQxQgJt]xI~e@}CtQwg@xEmXfw@sXzBmJmGek@ln@cZfBml@oJuUtt@aRjJoj@j|@wt@|X~C}eCeH_aAbFa`@yJcdBcq@qPuPpCcd@kl@_b@q@ax@m[qy@nn@cIpd@}e@`PqZaPyWauA}Q}Cae@kn@e`@pb@kSrBil@{Zgi@vVsg@sU}cAnNur@o@kIyK_RlWooAnu@}RcHcDeLeD__AyZwWe^_E_cA{bA}@}r@cNgi@sr@e{@?wKkK?qGic@ca@{VsRcAyl@_m@ec@lh@cUpy@cGhz@tMjYsB`Woq@lUynAg`@uHf_@id@qAiKrVhLhGhEv`AeCtQiYxUqA`RrQ|B|EzMIhw@kMp\\\\fNxc@m[xy@zM`a@ze@g@`M|K~S|_AbAjj@nUv^a\\\\tOyF~[rShR`Cbd@cIlIuRsHa@r`@gN~d@zXblB_b@|DpBhKvXzJ`An]p\\\\ja@pBzpAoWs@mJbZyUbE}d@a]m[im@wQaG_SpY`Anh@_d@DoNhJl_@tlAt^bnD~^lZfR~p@zRlOd]vu@wAjIclCzGcFcj@uIw@gGpMke@{m@yp@wzAo[q@h]p_@hWzcAhApr@g]{G`DiOa[dAUuc@u|@}gAb@nWfSlf@dAvt@{E|MqXxKqGba@aPie@fFyKxHlGrC{\\\\qXoGuh@hE_Uu\\\\is@{Zz\\\\x`@cY`a@xS|IhDgElMrL_DrT|JrS|F{G`Hx\\\\nPxExKdRwFhH{TjCgJlVwdAoX{s@kqAgWsEgD|KhMrAlL~VFd|@lIaAlJlMj@vRqa@tTyKuTaNpX}OtCsS{G{Rje@dMAoGvPh\\\\p]eUxJ_v@sX{VxPuBoLm`@eXpFkPgWir@~@|}@{IvD{[yp@dBmt@oYqXlFcOgDg`@aEwIaBpSuSzWkc@waB~PwZzJyx@gR{}@zEiTye@cgAsEea@hVuTub@iMjKuf@ll@sb@mo@K_OkT{Ezv@{MfX{L_nAuJkE|Glv@uCoGgCnGzJxR\\\\pZc_@iIgEiNkTzKgDiq@sq@}yApIqF_AeM?nGqGoGwLxLiReHgDyo@i]kl@z@kPh[mT?dL|SzB|GvTf@k^sCwDuLnJ?g]{GfCcFwGoV_}@mSaP`LwIrO`PtJoA}Ns^bg@sNmQoQoGc[d_@}kB|q@kf@rIs\\\\xK~G`@wlCjOkG|UdMxIsDmo@_ZyD{b@fDeuCkLka@tRsuAuCm\\\\wKxB|Ge_@uCk_AaPkx@xK_[pB_cAuJq[?qgAg]?{AmZoLaFxFg]dr@iz@{GqFpLaKtBgpDl]nKaSub@Pqb@d]gkAbRu}BaGkTba@y_CbHulCxJqO{Jw]zJg`@aImKqBwiBm\\\\{lBzGsRgCwKqGnGmJqTmK{eAbJ}b@hT|m@xKcGQqK{OiWzGaP{SpEzBsOqG?mWm|AZsNvId@xKy^tFem@pKy@sCzXrMxRlJkXmJyGjFwEhHogBd]}tA}EgYv[yq@qa@dLqX{v@qGfCqLinBnPk`@rb@cNeWgGqBkIxXanBjL}J|Ss{@rFur@aFgv@bPyi@ya@sgBxLaYsCwG{GbLy_@ofA\"],[\"y|{Xsve@eElUcRuDmAcFbYuR\"],[\"o~~Otg}IhFkVbOwIzz@x^xRzTxZ|G{EtUqVhVuDbm@yL|S_UfC_FuOtFis@_i@yh@\"],[\"{mbPvrkHxKoPr_@dGhMeGvTnPt\\\\sGde@~m@~Ndu@nR|VaQhbAsXv`@}W|[oo@~Tkm@eB_Xmh@u[{Xmt@k@vSuyCeCyMgThAaBkQbMwDtYfH\"],[\"m|ePpaqIsEq^tFa\\\\~c@k{@j[aJfj@bu@jCpl@aQr_@sZtSyl@wA\"],[\"qxdPhz_IpmAxhA}BdT`RreAgp@~TopAfcAm`@hEyx@`j@qUep@nNai@oTeaAGwp@kMmo@qh@eu@gQqFaTot@sJ}B`DafAcKke@`FwMtPzDra@d`Abk@lg@zUbd@rh@r@n_Ade@\"],[\"}fpPz{oGgJem@`EiT~e@cXjpAtAjl@lP`l@qGtdBvw@zl@d`Dh_ApcAvIdYyKleBqRkEf@ae@gTgz@qs@_hAefCes@i~Ao`AyoAsU\"],[\"qzqPjxyIhZgBrZoQd`@tZva@iKd[|GzjAfo@oKnPui@bH{nAbs@mb@bGkLhOaRkBen@qt@rKkj@uGeX\"],[\"}azPzifGcA_JnTw\\\\d^bLfFlKje@gC`u@xQxh@nmAdJp~@|m@xg@yN|x@}MrDmS{Sip@wEy\\\\_Tgp@adBcFg}@\"],[\"{}zPdlfGlJoDvMxGhGv]qd@sS\"],[\"ig`V|hMf[rChVoGsJvKsw@vC\"],[\"slrV_y\\\\qGqW~VyHhAfc@}Rxg@nW~m@ye@wEsCxEeIaU{OgGtd@ga@\"],[\"aozVwj^xe@uIrDrQnTtIva@nh@vWlz@lc@fFHhScR|OxAhUmIdNlIvM_P`Zcj@c@qGsr@}g@bDuZ_g@?a\\\\yXsiA\"],[\"g`hWqo`AtT}iAfw@xKxUn_@nRqApi@tr@`]tRxa@xDuCpGzXbIdhAzvBk]dd@oQdE}HjS}FrjB{t@f^iXmJ_LbFaUre@wBnOvSzt@tc@p]r@dPiUxMmEgFgLtOpGve@_`@tXcUiCu\\\\cdAmXy]cGq_@sb@qe@?yKa_AycB?_ReYaw@}`@{xCpGuT|[t|@}ArJdSj@kFaeAbWfK`M`e@|YkElWsu@?{i@\"],[\"mxjWi`sAlc@kTwKjTbh@v@hBn_@wiAteDrE`eAsEbW{\\\\kKkTp\\\\mQuJsEynCgLe`@ba@gV{WsPbOaDv_@}t@lRuCnBbJxCcZ\"],[\"{}{V{hy@h^nMi@pRcTxI_T{h@\"],[\"}}mTn~rA@YFc@LNC`@G\\\\KRCO\"],[\"qxnTjfkAKMFELHCJ\"],[\"cznT|dkAAMJCALBHADE?\"],[\"wenTjpn@O_BlAy@`A`Bo@xA\"],[\"gn|TvyhBh@w@r@d@Ml@u@J\"]]},\n" +
+	"{\"countryCode\":\"ET\",\"polygons\":[[\"ctwDemxS{n@jAy{AqRmwEilAuu@_r@g\\\\`@qf@aNudD`^_o@aAqeCyvAq_Aw_BrvA}lBjd@iAsAqJ{X_OJ_Xqc@mMac@cl@ic@iN_^|Z{pCak@wh@zW{wDooAonBhSex@aSoZw`@aTqv@ySyQcYqBo_@gh@mkAuU{_@wc@wi@eIs_EqtBeQua@ia@qHgc@}tErHct@wpAuPi~@`Q}[eUs_@bGwlEm{@ssCqaB{n@mCayAkd@s|AhOovD}y@DaKqQoKoW_qBbPg{BjXi]}Fog@kVgRyg@gCyXqM_Qmf@{CocA~|@g{@hb@uCtXia@be@gMzJuXj_@oDpMwPwbNcrE|_B_gAbZybAXifAhj@uPdMyRvi@aNja@qq@l\\\\iS_EcxAqX}|@bCom@kS_lA{l@_fAqs@wQ`Jkn@pRgYfdBmq@CsNw[aUdAi|@{Lgr@cKoXaZ_O|EeVhYaYfJub@\\\\gzAxi@sz@k@_WuQu]nCac@aH}h@jLcw@~Wur@vpAiyAjQkc@`^iaA|[osBnYae@|pCobCj_Dw{AxiCurEdzAepB`s@sl@jfCmcAzk@u_@h_AevBdXw\\\\bcAyg@xaByoA`nK|sG~w@boAxRzH`gB`Z|lDiWdwBn@nk@dN`IyFrSmxAxI{K{Ka~@}Wec@vBimAoRahA~Di`@g`@}u@cR}sAlNgfAxi@kYlJm~@gOwTjBwHlp@zQl\\\\hm@j_@jJbe@lf@f~@`WnOv_@dbA_]nl@ck@biAyMfmAg\\\\xgA_zAnwAgc@dPkPnWuiA`S}Jh{BqYtTwb@zl@mg@~pAe]`o@woAOyl@jlEabFhwRony@?_pRlh\\\\`k]nwH|oHfeTplRpF`Qca@vlQfeAhrGdxBv~FlpAhbBt`B~d@vc@ni@z}@|lIbEnxBxjAboBbf@`Frc@tn@u\\\\`tAdPnb@gHlb@`U~`BzBpeD{`@`y@cw@bg@_d@rbAej@h_@uj@lr@zXjf@hkAveEpOrIbd@`{B`I~A`~AdcEnpBts@jtBfuB`y@dW`@|Rm]jVoEfRiA`mA_Q{AjOh{@ec@t}AbG|jBg\\\\fkBoe@be@dPLkHveAyXxVxOpFzLx^_KdgDdDzm@uU`h@mo@dd@a|IdfP{[xJQdPcWlSoe@fjBeFvtNeg@~}@qdAhKqyAdtAkw@pSsaCuAyq@e_@u~@`Ssk@aQoe@jjAxEjt@ea@jw@sCdWfAlWvm@ft@hAbN{NxSce@~U_[iM_SfFcdA`eBio@~JaeBt`Aa^mB{WjNqZaHsg@nEco@xd@wcAz`@o`ApFev@d]kyBv^q\\\\vQg_@bt@gIry@ke@nGuQsFs[fKa[hg@g[`bBus@nr@g_ApIjAr]iu@v|@o|@z@ge@`N{mAplAqNhk@eVn^}c@b[iThp@?|t@wThTsM`a@`R~fAyGta@i^`c@eJjj@bJfZeHbf@eVvTir@`Tmp@yXmbA_u@aGq\\\\_NuKgg@nHc]_WmWlZm^aEoJrF{YsF_E_Q}XeShL{mA_JeRqBgv@gIuA~Oai@uGui@zu@}m@rBm[w_@}r@qG{mAalAmvAyj@oL\"]]},\n" +
+	"{\"countryCode\":\"FI\",\"polygons\":[[\"aeci@iotPvYjjAvt@pgGnJy@|GmRNqpDlQwl@dbAdt@|zBxfDbWeGlrDw}BpRsf@n}@gxJtzCggEbuA{~EvMxRrw@bJtf@hZdbCvvF~TdQzQhe@`vC|lE`s@f]|j@qVjaBspCvdAou@ho@}fAreAg[lw@wq@joByzCzrAmc@l}A{gAfdA{U`\\\\k@tDrHqLtz@l]pzDhg@ysAzl@deAndBzBpy@nvArKc\\\\jEa`CxVyBvRlRrGgYjPpQzHl~AdZjj@zYlErs@m[pi@mg@dZgr@bFkoDdIgb@z\\\\bE~Vke@`KyIfKjCbPvZ~[npBng@iUdS}p@zm@tVhU{d@dZomCxh@amAlRieAvb@`Oro@o`@~StBjQrPpmAxjCxg@jf@xV`g@|^|hCjOuKlrA{uCx]euAfd@mq@v{@{_F`u@g_Bxn@enClIqLrz@eVlfAcgCvh@oa@nGaTfhAboAttAvo@bp@np@pj@dX`P~m@vXf[nn@fxAlb@ja@hcAtlD|XhXvbBffDtcDdaFni@tsA|h@lf@fr@~xAnUxhAhe@n`@bn@ryAxx@~fAd|An|FhsAjqBvPprAhm@ryAji@nx@dTzz@|~BrsE}Or[tBbM|GqGvHjKzNkKfCxK|ByKvKz\\\\iC`j@sOoa@fChn@nKfBbLwJwYtjA`DjUlGyK|GxKxKgT{Bd^kTtu@{GptA{G^Hio@iTb{@iK{HiBdG~I`VnZkM~EjMiI~CkJtb@bLbNcLfTtEvQbIu@|R`Qr@vh@zVkRcPx\\\\tItjAmPeLI`JdJba@jRbAvJh_@gSb_@a@|X`KrX{Tdk@_KzCcb@mv@oVkA{P_c@bUcoAgh@v`@gF~WnMbi@hjAvmA|S~DfRmQdIdIvHb`@|H{CyKpXbJh@tBrQvIxDan@h{@xFdIrUqZgD~y@bHzU{p@zcB_Ezf@p_@{dA`P{ElBvSlFkB|_@_{@hQpIfC`_@iMVcS`YgGoDpFx]{[pfApX}m@da@hTje@m\\\\en@fmBnCfH`J_CxQu_AxNhu@iCpOy_@rt@iPaLuG`L?ea@mLzGc@nLnV`xAvT{Jx[lYRdwAwLvEiBdVdLuCzBjlAxLhFuDlQnPw\\\\hA`MdEyH|G~~@}GiChGzYfDtEzBeKfHxVgHrR}UoPkG|Ohj@|\\\\C`aAqFzEuB}UiJh@gHlOnAhUlPyKjSf{A`QzD}TxWiKzn@|PyYxWhb@oGki@`PjTpGyKli@`|APhQoa@{\\\\~Xxv@sIlChNja@aWc[uVkEe@b]pU~h@aAvy@|IiNxBvJyFjc@hLf{@eQ_EZnJtTl[pEjr@xU~l@cFrPbFheBeLhTdL~xAea@y`@cJyb@_[iBrE|\\\\`UxZ|SxGtk@xeArNvv@zQjHtOeQtQjwEg@pIwTec@qXwiC_Qj@}Awq@iu@qp@{KaCn}@lcA?jKoGyBeC`LrG~IaCrWfKdLuIhEeTuEoMux@kYw`@aBxiCmS~ZuZoa@qGxKlPxKbGbU_PvEoPns@iKyE_HnJiy@wvAwo@sl@|E~[hVpUeEpb@|o@b_CfT|bCoPui@qEjLgKmJgIcc@|Sb{BkKaEaj@au@}Xer@yM`ArOnq@}@fq@sOyKfK`wB}\\\\vcAaCng@uNvHpMx`@eS`QqBrUuHg@?h]sOkHgEn\\\\jG`d@lVyQ?lv@_|@cMsFyVsJ|I}LoFje@tnBlc@tCrKjYeAv`@}FaO}e@fh@?_e@?~sAeGhTqGmK{GnG?oa@eWnw@_ZlOdEqt@{FcCaStMyB}\\\\gLrr@gCuTyKz\\\\oP?mKj[wIuCoKdJ}EucA{Bt\\\\yKfP}GmV|GoVkRvHip@|y@{GyK}SdXjKmv@oEuK{DlX?agAiJdPiMeD_YlPiCwTyBbP}GqG?pGsC_e@qGfCeGme@aLbH}Kbi@cLcSeG`B?sXsz@pKePbn@oGelA_Yxe@aPbv@hEorAiEwUkTpO?pa@aPdXwHdEmB{LrXgqCqc@v~@uY`MqLz`@kMaC_WlOlGqi@qWj{@eM`Ke_@aImH|XqHuAwTp[cGrD}ImKiR~Sg@ni@iFzTkK{IqGlc@wg@wWsk@aGoDqChL}Hea@oGeRic@qGuB{MzUwTcLNvLfT~WelAuOzBti@eMlEiNuI}GfC|^~g@lN{Ei@rLg`@aD{BdLeLeLiAfUxIvS_e@cLzAzVgUNyRkWsOlPoPqr@wJxHyLpu@~OrXqr@qGmFg\\\\eQ}T_RtZuRz@gGkVnFiSmu@od@vGux@oNma@me@qR_EsX}v@zJi@wU}PcHz\\\\spA_Agf@oi@poAmG~BgH_KcAf_@}P|Ag@|FwKi]mCjW_NdIwMme@xTuv@uL_LlKom@{BsLmKne@uT}vBrr@nGtTk_A_YnPgFaW{Y_R{BmJjKaQgCm_AqTmj@oT}S~NmQ}CaMoVgZew@~kAmKha@_P|A_F}a@`Tic@`Jm{@kj@~jAcW[x@_b@xQpAzCi\\\\fDkIlNf@{A{F}HwPkNtAgv@ah@rEi]{\\\\h]eLwToGhCL{d@}[aArKa]}KsIZgLvm@wc@@qWuJ}a@sR{EmPoe@yK`j@?aj@yK~O|@}^cLcA_DlLaMfBoBgX`Vcc@k_@fEoVal@bAqc@iVw[g@mbAyL__@nE_\\\\_N{YuL`[w^dAwc@eQvP_aCmEqHsc@p`@sEuC@i]sk@m~@_ROiFez@q\\\\gYdLmr@ca@~Gg]oa@qWsKiIcg@uDaIsIpAyKemAwTqFR{WcQwPyHic@aJsI_NxBmCyJsV_@mc@s\\\\kYcbAwH{HyKpGaPqa@aNx]bIepAkXo@yBce@gK{AaJmcBi[qzBjTi]?iTtYeXpBaYdGjTiCapA}q@}A~BzVeN`q@sVl^yMyQhC{}@tTo}@eJuL{RtOyUHaLbUiXzNaP`yAoS_HwTmb@{Pt@iCy\\\\eCbPyKqGiCfToa@}XoG|GqGoPiT`j@uCoa@uOkTmIzEcV{GyX|Tq^j`AkAzy@e`@r^eErtA{Txo@Kfp@dNh`Aua@`DgOhs@c\\\\|SogAqz@iKqe@aG}@hM|x@jMbAzj@xz@Qbh@hPb]m`@`gBtXwBv@xVuhBr}AiVIyf@hU}Mb[kn@nf@uXzcBai@zd@ed@rOm\\\\{Gml@tTaMiEm`AmnCiSaFkU`Faa@iLkg@vEcXg\\\\yGog@gs@bk@eu@|gA{Xf{@_YfZsWhEoa@ht@mKpCsXqX{ErMaU_FkQ}qA{\\\\m^mDlTem@sG}SriBbEjhAaYt[}b@k`@oF`F_Saq@{|A`j@snA`A{P__@uKw_AaViO_~@nmD_z@xn@vM`wA{IbIwo@sBql@dv@}[~dBmc@rz@zDhd@eY~t@uOrpD}GhCyCni@QhoC_x@r~AiKxpBqRtKii@f}Cwe@vb@iCb~@ke@nv@s_@hyAwN~fBqFfLwK}@uLe\\\\gJEqGrMya@~rDoi@wjAhi@mkEy^at@oy@vkAmc@og@m_@oeCnKgjEbaDeoF|a@_uAv\\\\uWz^co@xT{y@pcAsVuGqcB|^_oDgBkhChf@ymAgi@opDsQg}Cws@w_@gIswA~Wk}BxRya@lU_zApYymErPww@|r@cmAk@gOa]c\\\\qY{oBchAwI_]i_@qk@kpCbEexBwi@s|@mUePiHeYmKiB}I`UyPvHmn@cLcb@dVyO}@cz@{]gc@ww@k\\\\nUkVi[}MRgM}K{JhKkP_m@aWiZwMUaYbZ_VcZiOwwBup@aiAmXu~@wKgQkJnF{^g|@aGumEtUirCiPecC_KkX{EfQqDaFka@_nCaWy]~@goBgIum@vLud@jbBcuCxVsdCbT\\\\pKqNnuAaoMto@ab@~jAiwAtu@~fAjkBtjFleAjN\"],[\"_|sc@gttLgScAtJqVbj@hCkFtSlItOck@dT_JqLdQkI\"],[\"qouc@kzbL}Tqm@bH_s@tOrXjByMnL|M|At|@{I`SgCkn@\"],[\"crvc@ytgL{BscAxKkTzB`Y|GsL?tn@zBuTnOzY^ru@oPuO{L|G\"],[\"}~vc@y``LbPwz@OtWrL`QmPrcA\"],[\"}~vc@yymL|GeGtO~d@|GuOpJzPkFrc@_PpH\"],[\"y|vc@{dwN}Dwe@fLjX\"],[\"szvc@wwpLiW}Ui@u[|MeBzNfPxEbV\"],[\"}owc@}lsNiKp_@uKpBiJwI~^e_AtIlX|MxC_FjS\"],[\"crwc@ed}LiZu\\\\uC{g@`MhCjo@ngAjMgCrCbLhCcL~ZcCfg@vgA|GeP`PrPtCx\\\\wT|Oz\\\\da@uMXsD`NoQqJ~Vrf@tEpd@my@iA{Md_@cPknBsCtrB{k@qSaAgQrUlO~AuHaZyxE\"],[\"}~xc@wwnLf@kxAxNcw@nIzCdItgAWbHcFyHsE|FcBdMr@dX?sXdKrJmE~r@_SiA\"],[\"scyc@ogtL?~m@wOmVoC}\\\\nGcAvKin@nPtcA\"],[\"ukyc@oslLfCb{@}G?kS`bAkMd@}Fs`@iTv\\\\iMaEwAeNz\\\\g}@`PoJ?|a@vK}a@tCbDj@_\\\\xEsDrCjT\"],[\"ah|c@uigLvLvNgBzJiZiSwIg^\"],[\"ah|c@mk_LmI~i@iLmEcXrR~Iy]\"],[\"}x}c@kz`LhTav@?~J|S?~@lQfJsDyJl^_ZbS|CtRaSgB\"],[\"q}}c@u~~Ku]~s@uOob@nPyKtW`E\"],[\"gbdd@gi~KuCve@{Be{@}GtCcGeLmP`j@uIsOcAyTrXkj@tK~[tQbE\"],[\"ghre@ykaLnOpCvLoGdDx^gAnGsFkIE`JtPvk@bGaPlDrIlBhc@qXba@zD~XcInGuCcLyQj_@k_@|MzH{XsD}kAna@d]~Gix@oPwAyFkNoDiq@\"],[\"{ase@ak_LcI~@p@rVaPd]{T_hA`HuP~S`I\"],[\"mruf@{cgNfFwi@BhvCzJhJ~Knp@dPwPz@yn@xLzx@qCfTgHeEwMtO~Pt_@kj@~i@{Ycx@cHuoB|Ck]`HuGbC~MdCcE\"],[\"mjrc@_brMxGgB`EjOcBf\\\\iJiM\"],[\"ketc@s_~Ln@oWbd@~`@uLdLsKmSuCzP\"],[\"g`uc@mk_MtGoBrFdIp@h~@aVy[gBy[\"],[\"gluc@uolLpCaL`FrXgG|XiGcS\"],[\"_svc@wxrLaAgl@pR~g@tHqIYj]\"],[\"cmxc@ojlLElEoFcU\"],[\"{jxc@azkLXn\\\\oNnTwAq|@\"],[\"w`zc@iprLdOzbAkEdT}Lq|@\"],[\"cjzc@{upLvPt~@qN_T\"],[\"sjzc@iw_LvRwIuJ`e@}JkG\"],[\"ar{c@iqfOfKq^nLtp@xOhNuNzXmJei@\"],[\"kwse@kemLnKsTnKzGxAnSmGvD~ElO_RuEf@sXiI~L\"],[\"ckre@cooLiHng@_JtEuEsK}MhKoEsX|Jkt@cQiUxRkHbTnVyE|y@hEqZ\"],[\"g}se@gzkLqFtBsXe[Vg}@rMjKgAtf@\"],[\"eu|e@_n|L~@aLxVvOcCnKjIvBxCxUyYhc@oPmr@\"],[\"onpc@qlvLlA_HzLfj@aEtQ\"],[\"gzwc@g{dLj@mOvQrNgAzV_ZsMJcJ\"],[\"epcd@ef~KfMiAkO`o@\"],[\"mzid@ukaLfBcEyApp@cH|DsMgVrLuD\"],[\"gxrd@yicL|HlG_Fz\\\\\"],[\"klle@if}KmCre@uGjEcDiM{NbQ}DgL|HoZrS_O\"]]},\n" +
+	"{\"countryCode\":\"FJ\",\"polygons\":[[\"~xzH~rzlBuRyFoIq]zSnM\"],[\"dabI~rzlByg@kDaQeSuIke@bFlGhIsCjK~SnKsH\"],[\"pijI~rzlB{mB?sx@qs@gGk_@bi@qLtMuO`YpXf^jHfYvp@\"],[\"|`aI_szlBj_@?TxF\"],[\"xyfI_szlB|mB?`RvSlBx]qRpMwPUoq@_l@\"],[\"dlzH_szlBlK?pa@ve@fCjTd_@n[jmBxiAxt@tzAdq@tKvk@~x@jPmG`TlGuD_YfIkPuv@{kAq}@ot@}eAgqBtUwGbtArf@?xKrObLnGaZ`YmFvFu[`MzC_Hn[jP_HWl\\\\sOZUlUcKdN?hn@jMnd@fJ~DcG`Gje@le@eIjVlMtaAmChwAgUqO}FqR}O`A}Xbt@zZ~eAbNtQtIaCbN~JU~Zd{@l[qGqa@~Xd]aNhl@y^vZjK?gCnU|X|_@xg@|SbEgGhQ|h@gLfYgu@lLie@xYcRqDcPhTpTtw@_LfOePbC@uKgUoCoOaTxK?kOqIpByEiW_JwH{@zDlUuQvJ}G}\\\\`PsOlPur@sl@w{@hW}F}v@w\\\\|KiCcPqG|FsIyW}ZgYtGtCuKaJkBjE_MoXycCsLqGsDkRhEqn@mG{HvFmHmKkQlKa[o\\\\bPiC_YiZ{N`EkHu[cGeFig@aJrHjPiTe]oGtTye@}XmOnBk[aYcf@`^iIuO}\\\\tDyFvExFfC_P__@ea@\"],[\"vntJz`_lBtP{LtBuSrKhAmQpf@sUsJo@mP\"],[\"dlqJrpflBaM}@cI}Nb[{FpH`R\"],[\"rlqJl}wlBoYkGlAcVnP}NlRbLkTjDcAbL|GzIjWwElAtGuFnG\"],[\"phbJdpblB|QcLdN~Ik@`ZmOfWi[wa@\"],[\"nu}IlahlBlEqJ|UmAxAlJkJlMeMzD\"],[\"tg|IfhykBxTg\\\\zTj@tE`^_Jv`@sRlBuPmK\"],[\"pnnI|kflBnVw`@||@|m@aRlFyk@sWePfa@wThC\"],[\"vntJ}gvlBle@iNrB`b@mOkChCgL{Y|I\"],[\"`fqJ}}{kBbEqa@b]{E`KhUsF~UbPrC?~m@cPgC?jPoGmGJx_@|SzYbCbZpU~BeH`BjC|DtLVbIoOdZxKiCzIsTYrXpk@{G`a@dHzSeH~AeGx\\\\wP}QRuVo[gSrFao@{OuEcCmSic@sJeGcUhDgi@{PcD}J}Y?oV\"],[\"ztoJaz}kBfTsCwAjGrKrF_KvUsZ_Ns@iU\"],[\"tliJsoylBdNYbQ``@bGgQnUrKlAfJaT~EuWpX\"],[\"`ehJoulkBvz@}GaOrOy^|IeOaI\"],[\"xweJm}ukB_JiBxBaMx`@zCgD~SuAeG\"],[\"fp~I}ymlB~OqH~x@pEcQrW{w@nZfX~F_z@hLiCkg@\"],[\"fpzIgoolBhb@bD{b@fR}FaB\"],[\"|dyIsvalBxXs`@~GhC_GvVkb@lL\"],[\"~hwIgvblBkEmLnEyJbg@uJlUzFhJfa@em@|PuQiF\"],[\"jdwIkeglBsP[jf@gT\"],[\"~k~IsmalB~o@vO~g@~z@yK?nGrXwKcGuJ|StJ|XvKk@~F~I|AfSwMGgLd]zNdFiFnMfRzo@lVnNlg@ls@zEnSqK`M|Nhm@sFxd@vKts@mLdDzGnOqRbSki@frCmE`nAw^voAyR|LrCfC_YdCu[dV_YbFaUyKyb@`CmN}z@gt@oDH_g@}k@pGqVxR{_@sn@aYeG|DiLu^wHjCiN{m@a}@pB{IrD|Dd\\\\eIca@?gOk\\\\vFeW_TmImSgg@f`@`EpAaI_SyWfGcq@o[wt@gEacA_JqQ}PyDzTkl@`]{Mrd@tXnG}FrG|FsXmc@bE{Zlr@qa@fR_n@la@e_@dMyd@fZyFfq@fUvRsTnSbArCuP|G`FbMqKrF~BgCpGdLgCrCvRvPkAjt@ir@\"],[\"hyoIuzakBjEkOkEeQxKqGxZ|^\"],[\"lupIm|olBni@zJvVfYgtAfKiJqh@\"],[\"nrlIajekBkTqGbWi@|f@d^qGpGjTxK}BjP}v@mYuK{Q\"],[\"lmhIiuhkBtT~WfC_GbPdLeT|LkLqQsHjC\"],[\"z~fIwazkBdUDlBdZy\\\\jE\"],[\"`vdIm~jkBxDaRjYxEfaA`cA?`YsXuCrFcKsDwJ_n@_s@uLuG\"],[\"thdIm_ylB?jTy\\\\wi@|UtA\"],[\"bubIs`_lB?oTbMxQeD`S\"],[\"b{}HmdnlBjCcP?ne@\"],[\"xzrF_nbkBtHuAfHvViCvp@oKnB{Gs`@\"],[\"l`gL}mqiB}FzJqQx@zCkS\"],[\"jvrKlralB}PtBbQqI\"],[\"~nbKv~wkB_BhA{B]gCkBkCuCdC\\\\vBn@\"],[\"rjuJjw{kB}WyO|VQ~FvO\"],[\"duoJfcelBbBjRkPu^\"],[\"vpkJ~m}kBoHtDeIuIhJeG\"],[\"jazIrkmlB{QfFoIqUbVyI\"],[\"rftIzcjlByRxJuHuQlQgH\"],[\"ljpIhdjlBsJKg@}LpNgA\"]]},\n" +
+	"{\"countryCode\":\"FK\",\"polygons\":[[\"`uz^~{lc@fLoHtZ~n@yNlGyKoKgTU}OrYsUTlUkOAek@fGkH\"],[\"t`s^fejd@zGrXzBsXjNtMAvTmVdh@sCyKlIm\\\\gVuP\"],[\"bru^fked@gCb{@qGgCiTpr@uUQgFpT{JoRg@_f@cUmRwF}X`Pm\\\\pEvAhPni@xNvKeGqo@qToh@eMwDyHrJoK{TlPsDfdAj`@?_YtTdL\"],[\"fkp^~tkd@dGwn@pEll@d_@pEaPrXySsG\"],[\"hqq^fukc@dc@hy@bbBxcB~UlbA_TvEwKpc@`LzJvQwDnCpK`BxjAmHt@qK_NjOdr@jJpEhj@oJlo@``AqG|m@hKhAq`@b}@~I`BxLiY`U{Ib^r{@gAt`@qWrT?kYke@hn@qMkO}AxoApCbDdGo_@t]k_@c@pm@qb@`k@aA~WiWl^oOpp@iTuIbKskAxSyJUcKiT|GeL{pAcQjr@uN~KbCsqA|KsJeLaPfMcHyFwQzPow@eFsDeGnGkEmq@i]ab@wFnInVf|@iHxw@bAsp@mHk\\\\qU}Rc{@w@qGel@bPjT}AaZn[jIdLsX{P{DmBcSkOvH}\\\\iHa@sLlPkKiC}M~F{CiLgXw`@iAf@vaB`B~NfSf@|HhpAbP`a@iSjKsQrc@oXhEuIyGpRceBiT_y@bBip@_J}j@hUau@fAya@_QbWcFbm@ur@znAqZ~jBoPdMyImC`AghA{Yhe@aNddBsGtJq_@pHuCgXla@ynBz`@{~@jTcjBoKyeB_J`NmAhk@gk@ih@|VcMmXky@vBoQxKpGvKelAjCti@tK{PaP{uC_P_l@l\\\\b{@jIiy@sMq|@iS~^gYbA?kTyK|BA{Gjn@qvBhAxd@bR|AOoZzYrl@`B`g@hTzB?mPlPvRfXtGlP`_@}FlIkLiAje@|gA[iP\"],[\"v_i^`|pc@~BbL~JsGbEdFbB`Z{\\\\le@{Daf@\"],[\"hwj^devc@jDrk@}Hr\\\\kP_FeDmNeBa]nP_YqGe_@od@|aByQjRqC{OzNmLjIc\\\\y@eqA~[wNfd@tj@\"],[\"lsp^bfhb@gCer@vW|oA|Br~@pJvOvA`gDjTqgAhCpgAlPb{@qGcjBzq@rpCQtrEcb@v{AaWhT|TnRxLo@le@__BzJ_K~IxGcA}dAdKkh@vKInGaPjTti@~HyJva@}FMdTab@|`@cLjd@~Dli@hN{BnVxm@e[tL~G`LsXlOiAbe@{IpC~@rS_QhYdNve@uIhj@zI`T`FsBbAo{@|X}M?wg@tOhTrCaj@bP`j@rJxCgDqfArJvCdKd_@bWs]zJhUsMli@Qdj@w\\\\lXwHhg@_Q~WaA|JnZxJuM~PbE|L|ZwYlRrH`Nwn@jT_@fMpMtNqY|ItCec@l|A{RfD}Lbs@eQwMcA~g@qMbAmh@|z@iGaJvMcf@{JoH}GfGkBwXcMiPuO~`@mM`CpDsm@_TvTeLaj@yKjr@kKF{D{MjMay@sFcL_Vla@eG{\\\\sIsBmEdKcNux@rFoKeb@}N|Bii@kTxK}Xc{@pGmIcPyXgC_y@zg@uOrg@bGmG{_@uGoDa^lOoOiAkKfN_LyBgDuQgJUoNlcAa]|h@mVy[qTiEjr@qr@{OeLy[tSkFkH|DwVe_@vtAyZmORyy@kPsKoB_x@sLaQscAzv@zp@yhClH{aA}Ug^qBmz@fMwm@zZkf@|c@cElM`z@gArJk[rO}BpRlVvZJaT`c@`CiGg}@vk@W_GgSdQcPcIkVzCcLvb@qOvVo[_C}IyXjTuFuE|Jgg@uMrCmPle@qLgQyFbBz@lc@m\\\\~R`NlIY~Tkr@si@wQ{f@}HsgEf~@eu@tIm_@jPvTA}NxKNuAh|AqTp`AdSeA{Gh`@tKpf@pZiv@{Aot@fVyuBhMgG|FnJfJjeA\"],[\"`sh^v}mc@mEf_@sIlDiHeYbLqn@gCe_AjT}XcPyZfKuWpYmHnGbaAe]rr@\"],[\"j_}^fjnc@cJmImBcd@bFgAtAzMbWvQs@gv@vFqFdWr`@a]`mAoK{a@\"],[\"hov^v|sb@~CkGhJjD{AtWvGyBvBtIs@{Rhi@e@yCzPuF{CsInj@yV|PySih@fGiY\"],[\"n}u^|rlc@xLFeFbWsVoK\"],[\"roi^b{mc@dRga@sK_@f@{L~HgBnMuf@fQ|mAgFdQaOyMBlf@}LpV\"],[\"bsh^jh}c@vRuF_Rvg@gLnEgDsG\"],[\"jnd^vngd@xDiCmHdo@}GoGmMjC\"]]},\n" +
+	"{\"countryCode\":\"FM\",\"polygons\":[[\"aigBibobBxIyKx^bP{Gj_AiT}Cs`@sh@vBcU\"],[\"gofCwrr_BnGuGeCuKn_@gGdG`Txb@yDvKhj@{Gdg@_OhKye@?a@dPuHnCgTeCcHq[iMu@nGyz@~LyO\"],[\"weoC{uo{AeA{\\\\`JsGxSpBcCvn@oMca@\"],[\"utpC}iu{A?sXhTle@sXgC\"],[\"}_zDgajsAtR`DsAuTbPjIbLfN_OjLxA~FfcAhn@mc@aB}^_XyZgGgDiHvSsDuT}GcGgQ\"],[\"{bQarn}AnB}DnAzH\"],[\"ozQywl}AgAkCj@{E~BxBOhF\"],[\"q~Quhn}A|BeAx@tAOjDeBlBaBEc@uB\"],[\"}ghAo~o}AaIp@wEoH~Ho@\"],[\"{`iAifn}AnHq@lEhJoHn@\"],[\"wffBiix|AiFoAoFoGqCwHn@cEvDz@pDnGjC|H\"],[\"uwoBeq|~Am@Va@b@YFMo@V{@Z@\\\\Z\"],[\"qxaCkiczAkCEwBOgBg@wA{AhCk@lBR`BfA\"],[\"gebCguk_BkEw@}@y@WgCtBJ~@p@\"],[\"{{mCo}t{Am`@l@bK}E\"],[\"g~nCax`zA_E`BGaCb@QhA\\\\\"],[\"unfDusxzAqEx@X_Bn@Gz@X\"],[\"enfD}ejzAmQPkFeK\"],[\"einD{~rzAwDyBg@_AZmBfBp@`@bARtA\"],[\"{w}DklwtAiCuCQ_EhA_@rCbD`AxD\"]]},\n" +
+	"{\"countryCode\":\"FO\",\"polygons\":[[\"mcnd@f~`CeDb[y{@tiB{N}AoWxb@cf@tWwKoi@fCcHnG`PzB}X`Pf]cBiv@dSycAjLlDyCxq@`Pg]?_YrFwGxHvG|JwVlHjaAxK{SqGcj@pGhC|JeV\"],[\"emvd@xc`CzI|KfVaAxKlR}m@pt@kJrjAck@vWpEohAzSwkA\"],[\"ix{d@xeoCwE|OqY|KrJ}~@aO}YiD{j@pGdC|Iaf@tMkOtBmn@bHiKnU|S[xk@|B`JtCuTxKfn@}GcGcSdS`BhH`PmPkTljBeCuz@\"],[\"sg}d@balCkPbFsXa[gH|Jwd@_GdNwkA|r@cn@jMBjYy|ArOhHdG|Ve@uu@xV_TrFph@lKmpAxYdIrDuSxV|@rBjSkb@nkA}Yf`@qF`^qZ~LcJbToHhdA{Td@nFz[\"],[\"or_e@lxhCcN`RwKeaBzMi[~Kb_@|QaA|K_NoYpBgF_}@`GyHtCtOxEyu@xQd\\\\xH_rAtKm[jF`hAf]}aB}Gx_A|Skn@pLbj@|BqG`PtCqFzUuj@fJ_WrZ{Djc@lYgp@hOsEnLdJef@piAXlX}Ovb@i^ff@\"],[\"mt}d@|t~BmVh[oMcF~AmJsNmCum@`OmMkTd\\\\{KnPkT|Ta{@~Id^iEnd@fSmb@qB{JtTqGnBnb@qZjc@jArHh_@mT\"],[\"i`|d@xsrCpDkMcDdbA\"],[\"q}_e@zmzBjF{Z`HhQbOg@jE~`@oTbO\"],[\"er_e@xr_ClMoBnBfHyJdYqX`Ve[tCGmS\"],[\"ab_e@d~`C~NcFsGx\\\\{kAh|@^{M\"],[\"og`e@vd|BxGcHvH`Fa@b^cTn`@ee@b\\\\`Aa_@rj@ua@\"]]},\n" +
+	"{\"countryCode\":\"FR\",\"polygons\":[[\"efhEn`taAdLbCmOvH\"],[\"uxd^cgp@|t@_U`Xc_@lKaApQvTlZkKzLfE`QaTxCuk@fn@un@zUeiAge@ys@iLsaB~f@kd@~JcZzMcFlIvGhoAwXdViw@yQogA~CaL~J`FtBwFlEgcAjRqUzmAyIdL_Ua]ic@Tgt@lKya@wJcbAx]cz@pVcPlBoJkL}J`HwTrIiAbVj]tn@xORga@zh@kQ|Tll@`KtDpRcFlBbX_OxbAoYrCoBjLfMlh@oMdYuIbaBrMlq@_Gle@hThaAsIbu@xGr[uElw@lE`j@qR|i@crAsV_^mY{Th_@tE`m@qEnMs]uYqg@zd@ie@qb@yElZc`@lYr@re@}O`TvB``@sI~Swi@{K_Lsc@gVth@rY~n@uCdsC}S`g@uCn`@kf@fJ}S}WyT|iBml@xTy`A`r@cRnY}Z_}ChAwi@\"],[\"u}r]eyz@|YaErCjk@uOm@\"],[\"yur]w`}AhHyAbCk_@xa@rDcCpIrOvJpPHpo@vYb[un@td@xNvc@yGhE_UcGgu@vZidA|g@uu@qAcw@~PcXv]\\\\kF_ZdIi^tHXl^taAgYxxAlHfTt]vEtLvMjVyPf^sEbv@tl@dZwFpZfk@wJ|VnJb`AgIteA|Ibu@{]~c@l@deB{UhWCjXin@`uA}FhwAcKjLaL}V{WfQ_UaQ}ZYoc@vOkMok@_v@ihA}XtNox@gXc\\\\vSoVTsBqe@lPmaBs_@ubC}R}Oei@sD\"],[\"kao]iuoAzKss@nVUb\\\\wSnx@fX|XuN~u@hhAjMnk@nc@wO|ZXpOtQh]{Q`L|VbKkLhXlD|Azr@yJpt@bExJ|M\\\\fMh_BpLh`@~x@wNdXsk@tKjMzEj|@`]KvQxWzJ^dDcl@|IsGvkAjbBrYdT`@tY_Mp_@a^lKJ`Zow@f}AkUaC_SjIqPpp@qTcZgXlB_Ih^`Elr@mGtHuMxAaWca@oc@va@mQmaAqi@uQa@u`@mImHaa@`\\\\gv@{Dw[pNek@uGub@`IiNf]kUsPg\\\\PqfAccA}YpUmEaj@tEmw@yGs[~Hc_AuSiw@~Fme@sMmq@tIcaBnMeYoLmp@f\\\\_IxMkw@\"],[\"o|g]ohhBjf@{c@h\\\\uBhAoLbRnGf\\\\sNfBmx@oMcf@l`@mm@ne@uDvKiSrXtR|\\\\nB|IiVrYhEjYqEfB}d@~b@qLra@~MfRkRpIxBg@z[fOzTb{@oG|q@h^dXoOdm@sB|b@bY|Q_ZxYuBrQdQH~h@~`@puBiv@~rA}XraBsn@l}AiVpRcz@rLga@ln@cPqM_h@ZaVyp@qk@_OiRlYeh@jC}j@jQ}UkNeOXqZgk@eZvFcv@ul@g^rEkVxPuLwMuYyBmLeWfYyxAm^uaA\"],[\"kwf]mfoBfFwZtVq]~Iiv@n_@yGr`@hJxk@_l@xd@wMfZvSl]cQdLxBdStm@tb@mSjL{h@jW{IfHmb@xRuTfEmfB~LuGvR|MtMgAxh@gj@qDqe@rTuu@IaVpWyXv\\\\obA~\\\\}cB}Bsb@rHc[vu@{hAbBdTz|@pgBcIhMvWxb@}OpxAu\\\\vPXhQfa@f`AnA~i@lI~Q`@nHyHq@\\\\xc@uRf\\\\hTbPqAjcAtUc@jE~RjAtvA{CrPyL}BwOrq@q[oPiPxB_Ht`@zH`f@oE|GyYtB}Q~Y}b@cYem@rB_QrPcy@m_@c{@nGgO{Tk@m^kJAyNdQmg@kMib@~RqB|a@qn@yBwPfWcVmCsXuRwKhSa^f@cS`OwSxa@nMbf@Hpr@y_@nTcRoGgM}^tA}l@yQmY|Fah@\"],[\"q|d]y|zBkE{b@nXccA`MmC~[yc@jDxL~KOna@as@xf@qXyBuLda@aF|EobAkHwIe\\\\lBv@qq@rNmz@df@{EbFwL}FeRqLeCfP}m@zDsiAmMeMyBaVsNsIaEed@zI_h@nMdBzZe^pLyu@`OuUl{@nq@aKzl@tHb|AaF|WgMzIgSzuAo_@lS`@fTpNkAfc@vWfXbc@~RnAh\\\\agA|SlLbUqUeUql@oQyKfNmFdm@k~@jk@~_@hQeUzp@lVlYtj@hEf`Awu@zhAsHb[|Brb@_]|cBw\\\\nbAqWxXH`VsTtu@pDpe@yh@fj@uMfAwR}M_MtGgElfByRtTgHlb@kWzIiS`q@w[fKeSum@eLyBm]bQgZwSyd@vMyk@~k@s`@iJo_@xGrXkSuEihAaRiQgAaUwD`CqA}b@\"],[\"wc|Y}cuCtMg@fPxUz[`M~nAr~Axl@gNxCdOlK?fLhWqK`RnBdKtk@rb@gBvl@n\\\\l`@yFlCh@d[r]zNj_@}FeHp^fS~^gFbCRja@fK~Q~OuDjKdLoV`a@gq@ySgH`n@gYle@kn@bJqLnx@uUbQyIog@kWpDcOmHcCw_@wT_^lKgs@}Ay]mc@ps@__@vWcRbe@af@rFi`@n^wcAe]qa@ya@sUsp@peAg`AZqf@tSy|@hd@ueBzLqLcU{|CcKuXnAiRhSpG\"],[\"gfgZmofCjSqCbx@tcA|c@_ObWib@tLjXlY|E|IuFrUrp@pa@xa@vcAd]h`@o^`f@sFbRce@~^wWlc@qs@|Ax]mKfs@vT~]pGjd@`c@WxIng@_JdKtH|h@aFdOhDj{@~_@xXzAtRmg@hdAvf@h_AtL`i@nZf[uo@t|@~Mjm@mS|n@hOnN_q@zw@iMj`@zIlg@eu@}Z}RzFoL~i@ox@oUoGzXoc@gA_d@_|@bSud@eYgHoK{mA`Kat@iDwEsGzDgf@ti@_NsF~Rkp@mViBcKzHeq@}e@ue@cbAyAke@pVgP`^}l@mVaTo[zEcOgh@uXiXjn@{~@pF}yAoB}Icv@o`@w@qQmhAi_C\"],[\"ahhZgnhC~OgIqCfl@jTxZpc@nzA`b@zo@v@pQbv@n`@nB|IqF|yAkn@z~@tXhXbOfh@n[{ElV`Ta^|l@qVfPxAje@te@bbA|i@|b@xi@qB_Sjp@hVnC|]qf@rG{DhDvEaK`t@nKzmA}i@eBmZ~d@oOkAqJlpAyLjVu]jSgFam@eTzRgNkAtJ_lAyNya@cJiCiYh^ot@c^wIcxAoNeQ}P~IoVsSuHoeAgFeHgNnA_LmP}Cqq@iPge@oEoaBhE}d@w`@aGaYlTu[hEg@lp@{H|Mor@wIuNyOrIuw@jV{UnIah@wIqUuQsHgMaiApKtO`QeThWmHzL{k@|s@wA`L}Il]q~@s@ot@dHi^zJyLjT`C\"],[\"m_wZceiChRkLhN}f@hu@pi@ng@{Hl[``@aHbOnJjk@vW`[tAdXlN_A|EjS_PhbAh`@ffCx[nWtAjLoI`h@kVzUuClm@iPjOoFh{@gmA~Oa^kb@}LiB}\\\\dO{TdZgDfq@w[n{@bGpSlr@xAe[r_BefCh`BbEgX_p@}jAceCwo@bIyOpu@eIbRut@j\\\\qMzHkb@wIsu@dn@ohAuKkn@sh@qVyOi]oj@_\\\\{Xqd@hMqWbYvKhJ}EbQc^dIio@rLcJ~QdDaV_z@ri@cS`b@a{A~[pE~r@uQ\"],[\"i~_[ychCdi@tl@bGdn@dJtDiFbLpFrYle@pIlVjl@KrL_ReDsLbJeIho@cQb^iJ|EcYwKeLd^vW|]nj@~[xOh]rh@pVtKjn@en@nhAvIru@kEz[{_@`TcRtt@qu@dIcIxOy`BnHyH}K`JaOyTiYsFgj@cEkaBew@awAkMfB_B~Vw\\\\tMed@ka@eKiSqGa{@gXgt@qCwpA`YkkBhUbBrSxRnn@io@thAve@nIkD`Miz@`PzKbUm@Rcg@ta@kl@\"],[\"{olYbca@ePfDcMtXa[w@bEsJuMqfAwYeg@a{@ur@tWyl@_EqlA{Lam@{SgWfGmRt[YnHaJqSql@~Ikn@iJwLsLHdFod@gUwxArLqg@cEaZzGqcAs]u~@fAmG~PlAdCoIoUkoChNyeAj{@}k@tGf@pAtQ|PMsC_b@zv@cI`Qt^~e@EvUnWn[dKTrNzWnPvSx_@v_@c@pDhb@jUjYt`@qAh\\\\nSlf@mFiF`Jj_@d~@yIvcAzM`@fFzSgo@pp@{Tnx@qe@bK[tqBgWht@{JzjA}]zxAiPjLmOeQ`I|e@jd@bJkFzw@}UdRm`@we@sk@iO{UjAqL|P}JfnAhSzPzAlTq^rS\",\"{fmYn{@wMcRmSxDbHvUjRF\",\"wtkYvhAkNmVuStS|SrN\"],[\"iqcYedNyGlj@bDp^xf@jI`F{ErJvIvQqSvO`Eo@ruBym@|Egt@mDqJyFd@ql@cw@kz@sm@jWnPvm@}j@{Ryb@xjAwk@}r@wJ{ZiRp@iWi]gKjDcGkb@iv@giAdBcpAb]yu@oJaOg|@eEa[iKmIkIJge@wN{ZeVkHok@`mAihA|eAuPybCbYob@IkNk\\\\ceAmQvc@uSgm@fBqh@{PkZzC{OwJ_[jTfCrj@sRtLuf@jr@qVbV_[xHs@tOtXr_@irAj{@qoAkAqSwNwPpNcYfRjOlS_BrQj{AyO|I[|NnX~SxZnAdGtl@~a@fP{C`PrOnPoHtl@_Tzc@pItO|QaIhMbHnGt]kGzM{Tu@}Tv[mF~\\\\zDpKjOvAbG{GxPwh@zOpSdKneAnH`Ix[oj@tTr\\\\wg@`aAdE|k@lf@ho@tw@wAnMjU`Drj@fTzI`JvR`z@qJ\"],[\"}rbYfaCi\\\\l{@mf@lFi\\\\oSu`@pAkUkYqDib@w_@b@wSy_@{WoPUsNo[eKwUoW_f@DaQu^{v@bIrC~a@}PLqAuQuGg@}`@lQ{_@~e@kIyKyIas@tl@aRdR{|@h]gOfVzDv\\\\ua@wBseArQoYkHwUnPsL|MkzBfKkDhWh]hRq@vJzZvk@|r@xb@yjA|j@zRoPwm@rm@kWbw@jz@e@pl@pJxFft@lDxm@}EhBno@gS|Tn]fp@aYbVaIfj@zX`aC{R`q@kZfWsTfA\"],[\"uknYru@dPsMrRnSuEtHkRG\"],[\"yxlY~eAtSuSjNlVcNzK\"],[\"gsbYu~PwPrg@az@pJaJwRgT{IaDsj@oMkUg`AwAgHcYsTuO}E}g@nh@aeAuTs\\\\y[nj@oHaIeKoeA{OqSyPvh@cGzGkOwA{DqKlF_]|Tw[zTt@jG{MoGu]iMcH}Q`IqIuOj_@agAoQ_[zCaPfMxBxd@cMnR{a@f]qiBtTiHpM~IjMgRta@wJbSt^pLsSdX{EpNdx@dSzPxN}Z`W{ChLua@eGo~@bm@qy@pGfHdAzbBlRrRvT`CbJjPcExa@bFxdAuLz\\\\wKmDcD`fAqRvg@|Ctn@rWrHdCxLax@ri@yChM}GwAf@lvBkg@~e@oNvuA\"],[\"mz}Xep`@iPoZsAwmBwb@iQ}OwVyCicBfFqMmb@an@Pec@{L{Psx@tKkHmEeEcThGslE{a@}p@_Nsk@|n@kvBd_BlDdoA{GvQot@bw@sZ|Ctz@aWhb@yA|z@rLxr@vUze@rBj|@zSh^bTuLgGhfApOxSmOfj@yRlMa[tyAvFvvAlc@`m@vEx`@qKl[qj@rUob@peC\",\"y|{Xsve@s@aIcYtRlAbFbRtD\"],[\"uv|\\\\aitCkFooAhG{aBva@abBzKucAl}AvcAlId_@bQlLxAn\\\\lUxEdf@vaAhZhYbi@jDpPkFhLbVfx@zVvg@wFzQb]lc@pH`m@bm@bc@rR]dW{P`QmDtZm\\\\nA}_@rdB}SlFeExr@gPrKaFbp@swAcJ{HgLuMtd@iEg`AmYuj@{p@mViQdUkk@_`@em@j~@gNlFnQxK`Slq@_StP}SmLsRn{@qP`Mkd@ch@qu@eZbDoS`\\\\kOfS{uAfM{I`F}WuHc|A`K{l@\"],[\"{zj\\\\wasChi@|Bz^_ZpRk@jRrU`c@dOdZ{@bE|Jz[rIb_@mFhNdOdSwB|h@gl@pMtAlYp_A`KgM`DzP|JqDtGnJeDd`@tNkHxOjMjLbhBwOrj@}ZyFJbW{_@Ms^lf@mHfb@wd@qTw]vCeu@poBmIXcNka@iSpDyq@_Vg{A{oAmd@g@yuAmmAdEyr@|SmF|_@sdBl\\\\oAlDuZ`NeF\"],[\"op~[uwhCXjq@`VaJzNj_@sd@nNo\\\\_B_JzNrAbj@qH`J}bB~\\\\wb@aOsZ__@fBqSnPsGbn@wgBv]wCvd@pTlHgb@zk@yk@rRxD\"],[\"wh}[_pfCjl@jl@uG{tAfPeXzExChWvo@j[n@hgBpeD|HjGrJoF`MhMvTth@lYp`B|TfHb_@{Jta@pQbIuHvNbAffBxxDpFlGdOgK}L~_@wUrRgScYmYrFcn@_lAeq@zlAeFvb@kVpRq`@xDeUpTcNdeA_MzSzIdVyEzJeiAai@{[~SoPfb@o\\\\nOJoScp@eyB`C}j@{Jeo@_Wk]cAq^eToSt@aa@ml@sd@iIs_A~La_@iHmNjKi`@_]iYlBwVoNmj@hHwn@qKaPpHaJsAcj@~I{Nn\\\\~A\"],[\"k|g[covBjt@`QnT~pAvXgNdX`Nl@d[xThYaJ`OxH|KndBsHrvBri@xz@dqAcEfX_g@l`@{l@n{@a_@nKiUfe@nFdUri@zd@xG`TqXnjApH||Bif@zCeUnf@|@bO{VhUqFlUa_AIox@o[_}@{Kku@_c@_iBcm@dIgz@uKefApYet@hGck@j\\\\yDrIgZxZmPrOio@bZObI_XqWmt@oZy_@|Re^`W}M`@{|A}yA}sBj\\\\qn@\"],[\"oil[g{vBzx@ro@v\\\\VtwAhnBd@nbBaW|M}Rd^dg@nz@zJvYmB`Py`@lG_Sxt@mW|JyPz_@eUDgU_fAwa@tf@oQyCwKyVo\\\\}Ies@xQ{I|MuO_I}VrUoJiu@sPgLg\\\\`oAuo@~p@gOgb@s]CsY_|@}_A}v@gu@kPxP}o@sAw|@n\\\\oOnPgb@z[_Th_@hV~Sj@|NpPtG{AyFk_@~L{SbNeeAdUqTp`@yDjVqRdFwb@dq@{lAbn@~kAlYsFfSbYvUsR\"],[\"efpYglfC|_@nWkDnUtKi@g@~eAhErHvb@hGrA~Y`NhG|V`g@lH|BzA_LmDar@|SrNtVcFrPtj@}GjIlBj`@nRjTpA~_AtPfUjPyDmPjz@dEnu@~U~W|MFt@kS|DhBTfx@cJgZwJz@aGbKxBtr@}KpEeHnr@wKgCnGle@fX_l@tD|Eh@rg@~MtJmDnV_OdVoKsUwCbCaNbUaIzp@}S`Nqa@iKyYej@qK~F{Mld@oU{FkXjJ{Ec{@sh@|g@um@iFgS|Y{OTmSoOaJes@mP_M{Ybd@iOoNlS}n@_Nkm@to@u|@oZg[uLai@wf@i_Alg@idA{AuR_`@yXiDk{@`FeOuH}h@t`@i]pLox@jn@cJfYme@fHan@fq@xS\"],[\"_hgY{wxBjKwItH`^{FtU\"],[\"wjjYcxmBgCvVvOrLmDxWeZx\\\\aAfnBaa@{L}f@|QgL|ZdP|a@uCt~By[vPiPyK{Ji{Ao_@y_@yMdAjJvr@o\\\\lTgBn_@wKjNrXjKfh@c`@|L~EbHz_@{Nvj@rJtd@pKdJtUwIe@}SvNf[cX`MeXdn@oOrHkn@?ca@z\\\\~^kTx`AqGra@iq@~Kt@mGnmC_FfOuScKeOxBaOrh@qA|~DuXaYgw@m_CaNeE_G~Swg@y\\\\yKs`@hHuo@_bAaXmg@~C{e@mqAhVerA|bAipBpV{`AxD_yAzZco@dOihA`Ag_@ih@ajBxBsK`VoWlP~L`Jds@lSnOzOUfS}Ytm@hFrh@}g@zEb{@jXkJl[dECiQrTmXxYdj@\"],[\"myoYucqA}Otl@uMvH}LwHyOlS_ODaIoa@qWaOc^tAsa@~\\\\c^r_AcC|`@mj@dIDzkA_K|Ri[cNeQhXoBne@zNlWtRhHp@nc@h[fZmOnk@dXl[o[nW}Bhi@uTiHkn@gw@wWxd@mH|p@uIhKkJaV_Qy@}Ti^ha@gwAcAo|@qEuK_^yBoCuP`]_jAzA_t@{T{Zoq@c[u_AhWuWxRwMgg@qZwT~`@ya@lPnGn^sGaCmq@d^ijAm_@}w@gBsZrVwDrEaMsE{OeRsEcBug@la@stAvh@cSlNqRj~@}AxAm_@ze@qh@lK}@b@rOdSP`KhKzo@rbBlg@_D~aA`XiHto@xKr`@vg@x\\\\~F_T`NdEfw@l_C\"],[\"}rqYydoAnFd~AlnAddCtVphAry@~bAw@~nAhg@dwAuOfa@yUpnBeVbCfAzk@ck@~e@hGxJvb@jFjc@ls@uIxZ_]rMlNdNiDn_@eX``@sL`AsYsV_F|N_\\\\az@gLhFsWkCcUpUmi@sKyBg\\\\hLsu@{TwcAoXq]v@g}@mNyLon@jCiQmJ~B_qAkN_TqSwF}Ry{@|Bii@n[oWeXm[lOok@i[gZq@oc@uRiH{NmWnBoe@dQiXh[bN~J}RuC{bAzI{M`d@iCbC}`@~b@{gAv\\\\wTb^uApW`O`Ina@\"],[\"cckYuj~@teAlpArp@fZps@rRhUuIvj@bE}n@jvB~Mrk@za@|p@iGrlEpNpZfo@kKnOxCdE|o@lb@`n@gFpMgPfDy_@rr@dGn~@cO~d@gTp@iKtX}GWwM{MqNex@eXzEqLrScSu^ua@vJkMfRwXiHqQlSeUv|AkO`]}g@|Qgp@aTgLsq@wUlAoX_TZ}NxO}IsQk{AjQq]{Ccu@bJsX_GoMaVq@{DgP|IaaAf@e|B~E}NrYrVvZmPbQka@eP_^~\\\\sMtIyZkc@ms@wb@kFiGyJbk@_f@gA{k@dVcCxUqnB\"],[\"sjqYfq\\\\}XeVm`Eyz@shJepAyNmaAuU_h@zNop@fLwEf\\\\nJ`BoJkDei@tImq@_OezBj]kTbTcp@|OoGtKwp@vQw\\\\`_@rB|PgIvEawAwGqH{[mA}BkIvVcf@fm@cF~TmdDvx@~m@~b@qNda@lFm@~l@kZaAwFzOtYnn@q@di@bS~`@pf@aWr\\\\lNra@_F|WvNzBbRxOsCbRbMdYwRhTpzBeCnI_QmAgAlGr]t~@{GpcAbE`ZmK|s@`TjlAeFnd@rLIhJvL_Jjn@pSpl@oH`Ju[XgGlRzSfWzL`m@~DplA\"],[\"socZ`mW}u@tBcT}Qgc@{K_JkKjE}t@yGua@a]zK_e@xaArk@je@tp@vOksHae@}aB_Y{bCeJsf@gXkK_RbDcGlSxBtN_Mpb@{lAtlAe|AvvBk^fc@cT`p@weAtp@yFtMsLaa@rG{O}Da]vb@rO__AsRvNaN~r@o[r`@mjCr^p@{p@wO}Yp\\\\{OjEuk@dR_b@jb@}C~T{O?oa@l`@meAlF{_@{Ji_Cjb@oT~h@pOlUbS~]sM|]`ZbLkZnGsxAqX{g@qGhClNwv@xQnc@bg@_TjArg@jZrJ{Nna@bI|WdXlBhKw_@pO{Adq@dv@~Cj]b_@l\\\\|MVpNmO`WxFhZ_IbLr}@rj@u^z]daAwVbf@|BjIz[lAvGpHwE`wA}PfIa_@sBwQv\\\\uKvp@}OnGcTbp@k]jT~NdzBuIlq@jDdi@aBnJg\\\\oJgLvE{Nnp@tU~g@\"],[\"ketZrxLu~@~[qw@nv@gl@pwAmMrCm^`y@qJ|g@qR`[Dd`@qr@sI}Gqh@ph@saAfMwj@mw@juAyd@lOsU}o@uL{@iNbKmG{Ky`@|XxEqVqReNaJdUc[~F}G~RwFoFoPtTgEfc@qf@yQqQaj@_LoCkRxHyPaQiMijAlDgHhNhJtDgG_FgsAxQyi@fQoHxOfErw@mfAfHou@bVmd@i@qi@`Ps^tFydAbYgr@hm@ww@dMfg@pp@gPpb@|]~GhRoPpi@~KdwApJfWhNpC[eZzjA|Aza@olAjQtNzReo@xRbMxUwJdK`DfWz`@|N}QfS|AfQcmBzX`CzLscAbq@@lTna@uCnPdJbt@mFz_@m`@leA?na@_UzOkb@|CeR~a@kEtk@q\\\\zOvO|Y\"],[\"}i`[byVf[aZxs@tSoB`Jwe@|S{y@thAaKcCec@vSrHsg@bXih@sAkO\"],[\"snd[z_Xca@t}A|@rk@qZd_@sCin@dL{BuChTfL}IqGsp@|G|GpIihAxZw`@\"],[\"kog[nzToHfc@tHdVvRUeBxOaUhVuT~x@vE`p@og@lSiMbyAwFgDwEdIqVvnAqXpPlVuCPpGm}@`P{{@nu@yFb\\\\{u@ry@mPxi@}l@fEkMiSyGtByOc`@uo@qc@nn@ifAxTs`A|Wa_@lNkiBgMi^kf@rWom@nCqHg]tDsMdk@gCvLqNmRol@ok@uCaA{b@eViUdj@oxBnAgXwI}XvVos@lf@kn@hb@gL~d@w}@pT|FpcBe|@zc@wElS`Nl`@_I``@rBl@oOxUc]nZzy@hN|aAuSvy@zGhcAuDfGiNiJmDfHhMhjA\"],[\"gvo[vsl@jTsi@iC~~@mJdF\"],[\"{tt[`~i@lPqa@v\\\\k@o_@r_@oFzN~D|Oaj@d]nDwz@\"],[\"ctu[d{e@mb@pWo^|bC{Nmn@_p@pB{S_KwKutA|Kuh@iCqK`GkQrCxKxKuX|So{@Vw`@gHfe@ui@jhAcOh`A`CfuAvh@lpAiBpVuTpa@jKbo@}Sny@zDeh@eI}WqXvZ`PdLuZdGmJ~XiSwp@pBsVpG?cPo\\\\iCfb@{GwD{CxLyDcDn@_bA_\\\\i`@rEkWeFy^dNoMkd@ij@ebAcQkLm^q\\\\{vEsb@iy@}\\\\mpACs_@xRkc@dFu}@~[{@pT}i@tZ_AxMgJhZu|AxMh^oCr_AtCvIrLsAbVq[fGceBb`@aExZaSpJruCl_@diBjMzAjAsVt^y^c@{`@~e@qTvn@ri@xNoj@|YiYdFzQg\\\\tjAdVhU`Azb@nk@tClRnl@wLpNek@fCuDrMpHf]nm@oCjf@sWfMh^mNjiB}W`_@yTr`A\"],[\"{x}[dao@cCjP{JeDcGxGkHwpA{L~~AjDpfAiToa@nGle@nGyKgCx\\\\hQnHbBftA_d@px@cDmZpKeH?kTeLgCdIsi@}DgL{e@sUiK`M`KyErCnJfC{GrCvKaPna@fCqXgFjFoDvYzBqGrX~xAqGeCrC~OuTgCqBpNoLvD~^?zViTnGfCuTba@fGuBfAfHyPfDfOlA|Ahc@}L|]zp@?nXgW?n\\\\uVpGyXqGkX|D_Ybd@sX{GwM_n@mPfCrCfLmP?zBv`@bUiTpGd]nG{G`PjRaPtVoGb{@zBin@mKhn@un@in@l^z}@pJuJxEb{@el@fi@e@lJ_TbBsKgJpCka@uS}P{Di_@ar@uAuGrRxCr\\\\gRhn@fC|o@{p@|`@eW|]aRqBw`@}bBt@_lAbSyL_Jmp@bNm]iFuoAi\\\\_X]kt@zBgLlVgIaDur@pWwjAhUeOeU{{@nBgG~r@JtBoL{^qv@ym@}N]ikAxZs[eI}i@la@q_@jByb@|KjGnGlh@|SlGbYwzAhZkNlk@bE|Fcc@|WdItL|`@nQmd@bGl`@|InEjaAiX`h@bNjd@hj@eNnMdFx^sEjW~[h`@}Bru@\"],[\"em{[ty{@~@gYnOqBmEx`BgD|Icf@dLqF}J\"],[\"qad\\\\`cdAsJf{AeLtUjKr_@sCtn@w`@jTc[ln@eApOxKuDxRpQiAtK}GaP|GvTuNtj@kZ~NlFpEtYuR|G|XcKpSfSoB?aPzPhSzBrsBaP?kAcNkNEa`@lNmd@bf@s^t|@vKdWiKvQkHxmAkTaPkXsbGsPaE_`@tOwJxf@wIfGkEjaAdd@x[tBdOmPqGaPjKsKgIuGlp@{K?y@}^ob@yPfBkI`VdGpDiPsAsv@`GmVaG_nAzB`PjByK}Jer@{GvtAsXqa@`PdrBw_@ub@kZuaAzq@l`EyF|w@dLvGkA|f@kRjHWsJ_G\\\\s[rJiSoImAwJuJxNaQgE_]sYtCgLuCrCqC}H_Cc^pGwg@wT~QXoo@sCbPyKxBqGcaB{GlKkL_s@pHsZxLrC_Igg@rCe]}E~JuQmWsBcz@nFqH_PuC}Kqr@`n@aKyKef@nG|GvTca@qa@zB|GwK}UG_BqKeLiCrTelAyBg]jy@_Cbk@{f@zh@fQ`MiYhPvFnB~X|NxAvJg\\\\lx@kZld@nRrP{El^bfBhY|LdW}]zp@}`@gC}o@fRin@aB{g@|R{K`e@dFzDh_@tS|Pu@`f@bOtF\"],[\"gzq\\\\lsaBnGqGfJb_@r@|ImIyCz@zTaMsX\"],[\"{|u\\\\`afACoi@g[fE}Bs_@}^f`@wIiWiTaPrTqhAaf@_rBhn@hCi\\\\aYiU}m@`HcKpO~BrCoGti@f]_g@od@r@yb@|SjTxKicAjTnGhn@yiAxc@wGtV{|@tOxKrNq_@oR}BcAab@e[s^{\\\\ucAoGnP?i~@i]yj@nPiTrXd]zD_JuQqe@ri@iVgTeLpSqJqBkHcGbGaF}ElA}UcPxKoGwx@je@e_@pGrCrW}RvLlA_Vi\\\\be@iNfd@|Y~l@sKxGpQbY?nK`LiHfs@nT~^@zY|^r^l[dLp[jbAs]nt@jCr|@xm@|Nz^pv@uBnL_s@KoBfGdUz{@iUdOqWvjA`Dtr@mVfI{BfL\\\\jt@h\\\\~WhFtoAcNl]~Ilp@cSxLu@~kA}CqLsPzEmd@oRmx@jZwJf\\\\}NyAoB_YiPwFaMhY{h@gQck@zf@\"],[\"m_s\\\\x`e@oDvJ_YbGpGaPgFrCeH`Xg^rN{Wi]cLirAbLsCxOpNpMCtIgMhDcgAqOerAldAae@pg@iq@{Ash@ug@ogA~UssBxoByDv^|Rdy@sRtLnFre@mFtP{P~d@WlJ|y@~LnSvoB`s@eFt}@yRjc@Br_@|\\\\lpArb@hy@jOnjAqEhd@vR`fBjLl^vItCeC|Gyt@lLaKue@oQld@uL}`@}WeI}Fbc@mk@cEiZjNcYvzA}SmGoGmh@}KkGkBxb@ma@p_@_Rsa@ix@me@cBg`@oT__@hHgs@oKaLcY?yGqQ_m@rKgd@}Ysa@bI\"],[\"c`u\\\\p`^]upCsNvVlBl]{KxFqFh\\\\qQrGuLdV}m@fG_PpUgGgNed@gHeGaL_MtKml@yIcAsVyB`YrOrQ{HxGe`@uA{KnJoX{V?jKgl@dL_Ewe@}Fxi@xJzC_e@n\\\\sCqGgOpXyHvC{ByKyK`GzIxHwR|d@oGuC?h]wi@x@gp@xg@qXsX_]sAeYvQeIpn@y[oElD{z@zMiM`Pq{BzGqEwFoz@yW{a@{D{[`IozArk@aUvTrUtCjVxM`Bpg@}Zvo@ut@zc@|BaC}QhIqS`]lJtL}Gfp@efAiFi{@x[fEba@yVfr@qLtl@ljABvl@lOxCxPoOd]jy@rPxH~BeWrP_InFo\\\\v@g{ApKib@zM^nYaaAjOtQtb@wE~YrQbc@bo@lDvfBmZ|gCbm@ndBs@xVeQn^\"],[\"kxb]fzTw@sHaRz@yIiTxAmqAnQ}hAlK{_Gdf@wvBaa@gpCkq@m|AeKmqA~jAuEvNwK`K}^fRxXx}@qt@be@vBvEzXfb@sb@b^nAfGxD{IjVvJ`_@kN|d@vObQxIfs@yOfPrA`Mh[j]do@`zBeRdiBxPxTq[`{@|XfjBuEvo@zY`JnF|h@`Uvg@eRjgAzCdv@oFn\\\\sP~H_CdWaH{@we@iaAyPnOmOyCCwl@ul@mjAgr@pLca@xVy[gEhFh{@gp@dfAuL|G\"],[\"uod]}vD{IchA}Xsw@~]kk@Kae@`V{QsF}vArc@ofAdMQeCxd@zQyGvc@a|AmBwLuQ[kL}q@{SgZmDubAgu@gk@mFoYxGqzAtXkuAuFoXzbB{g@hAbMiM~ThJzSnLuBp{Azo@|WrlBhb@kC`G_VbTyAzL|Z`\\\\aA~Wjp@r^|V`Rdt@yPpvAbOpF|Adc@xQrWrJfnAdTdb@wX~t@kVwPoO~EySnu@ak@rd@~B`d@qQbvA}dAkQgb@rb@wE{XuQfCcNaHmg@vVuYz]gRyXaK|^wNvK\"],[\"ote]gsHfQlk@xCpr@eQneC}Vr]ugBuz@qVqg@}nAq`FsJyo@Lyt@ut@i`Gm`Ai|Brg@qxAbeAyeBxdAkl@zp@lm@~@k^tISvR|]nsAwR\\\\u]pJ}BxN~Qn`@dKtFnXuXjuAyGpzAlFnYty@fs@^tz@zSfZjL|q@tQZlBvLwc@`|A{QxGdCyd@eMPaa@t}@`Dv_BaVzQJ`e@\"],[\"azp]eyYoh@cx@a`@}LqQkTzLu}AmKnPsCyKyAtWo`@ns@ok@gEsOyK|Uar@cJw|AzYcs@oHe_@tDmGtM`IbXycApVoM|GsPoGkvB_M}UfOoWoGuR|Jqd@`HmFr_@vw@zNHzKaK@wJaQgPyD_mArPqBlP{hAs^gKnZs_@xZrHkRct@nGqI`V}@h@{HgKg{@qTyGo@uZdb@wrA|YqUpfAbcAf\\\\QfOnP~LcZpd@kJ_Djh@|JKnDlOqHxQbDzd@yH~^lZlYrC`WvXfFkBry@b\\\\v`@}TpMfEf[qQja@~@tZkOnWgF|p@oN|^|AbuCgRzy@tGngAiWhKyNfr@ykA~l@ceAxeB\"],[\"}yv]{c^sAsHmPna@kXmJyu@sBmHmRgXhSaw@dHydAoYgp@rNuKml@ga@yn@a`@wvB}IuwAbRoYx`Aar@ll@yTxT}iB|S|Wjf@gJtCo`@|Sag@tCesCsY_o@jFwUzN}Q~Krc@vi@zKrI_TwBa`@|OaTs@se@b`@mYxEmZhe@pb@pg@{d@r]tYpEoMuEam@~Y{_@zX~YbrArVsOt|@`WrNpKj`Ae`@fPjRbt@yZsHoZr_@r^fKmPzhAsPpBxD~lA`QfPAvJyOjLaOkCo[_u@cR~b@rEt[gOnW~L|UnGjvB}GrPqVnMcXxcAuMaIuDlGnHd_@{Ybs@bJv|A\",\"u|q]s~y@aEs_@}Y`EzMzc@tOl@\"],[\"s}_YkuwD`P}N?fBR@\"],[\"swzXqkfDsBqP_SqKmEoXsYyIuChKqQoEcLu]i]rCpG}GdAuf@mMyKiLh@{a@{wCcK{@o^og@zDkiAz\\\\sa@mm@m^qc@jCyRrQkPaPyUt@sNuQ}m@lGqGin@`P_YlOhElLcInvA}F`dB|Wje@gTpG{Tm\\\\|Lt]aWfoAcJje@pGlp@cPn{A?rYvGl}A~wAxx@rClOzr@kLjt@a_@yIy_@`Nsi@IcAzg@}h@|Oad@dh@w\\\\nK}Cx]ia@laAq`@zJ_LdbB\"],[\"wqpXkovDf_BsB`MjQdPqB`IlS|K]gC`a@nPnGyBqh@pMmBn^tt@nW_HhMdDxKpd@`L{FnKzFyKwWpa@|ObChIoV|cAmRqNaPda@yKgGxKtX{G?eGj_A_Y`{@cGqGiG`b@{QnAuAlHu\\\\yMoFgk@eZi^gJtrAcE|FqKoDdGxv@eGsCsC|\\\\yL_f@iSdDmKin@eLhToGsXqGpGiTaPcPrX|Sx|AcGjC}QmCqNpPaE}i@kU_EoQgp@yF_@}_@r[jA|Vi]h~@yKkT?nPoK_I{\\\\dSeW_vA{Vtf@lDlKcFjBsIyTmGvLlG|d@{\\\\?xZiaB~KebBp`@{Jha@maA|Cy]v\\\\oK`d@eh@|h@}ObA{g@ri@Hx_@aN`_@xIjLkt@\"],[\"ywpYg`A}Ozp@ul@`RjAxb@xQ`\\\\eKzrAuT~McRcMyOrC{BcR}WwNsa@~Es\\\\mNqf@`WcS_a@p@ei@uYon@vF{OjZ`A~Bmg@gg@yNgDmTrFuS_]ga@xKkx@id@sdBiI{mA~Toh@kQwr@jVaMaLcm@jY[lf@pp@bMcYz@}k@t_AoTfPvEVib@hhA}eAnk@amAdVjHvNzZKfe@lIjI`[hKf|@dEnJ`Oc]xu@_CzkAf~@b}AaKteCkR`UjHvU_Slc@bDt{@mXh_@qZoBi]fO\"],[\"co{Zo~c@`H{HhGvU_Ehs@fSlWrt@zqBpArc@{Prf@lb@fV~Sh}@t\\\\r_@yU|lAg[qPuJpn@gg@tg@iDvyAfMrUaBr^wFhNu\\\\cEeRrUcJt|@iu@}m@kBwm@qiAeNmFqh@}Ro\\\\c_@~R{@vZgX|Vgl@|FkCoR_u@nS__@yaAwBkt@sn@ik@aPkiAhJqZu@qj@}NmZde@y`At|@ha@rP]re@e~@jf@qBfHsS`l@uNdAsObY|d@`L\\\\vHiy@`k@}HcFupAzVqZtNwi@rKsIlf@|G\"],[\"g}zZglc@yGc[aHzHuCcu@kb@wd@hTerAz^agA{AmWmSgUnAkk@gPoa@~VwTh`@rA|KlWlWdIrW}Qz`@oAjF_Kjr@tQ_EptAvQwI`VfLngAv~Abg@uFxl@z[`Uh`@jc@cNzRhnB}DnU~Tp}Auy@v`B\\\\vf@{IvMtQ~f@oj@de@o\\\\Hg@zm@qK~XsX}CkJz]yXiZ{TpWiRmE_b@yl@uGv`@aZgJaGfUqRcOc^ymAmb@gVzPsf@qAsc@st@{qBgSmW\"],[\"su}Y{re@zMflAd^jn@iPlx@~CdP`KdCl[q\\\\tUfJr]~f@tCzg@lXmA|HxSaB`WzPjZgBph@tSfm@lQwc@j\\\\beAHjNcYnb@|ObfDgPwEcx@tMqVzlAmf@qp@sRgDgG`QpMda@kV`M_WmI_X}]uA_u@oR`MqKiT}k@yXyW|d@aa@cHmF{JbI{]cBcr@qNgi@xn@aE`Xgv@mBqi@|FaHvTpFnG_o@cQol@sTyUd^qk@IgLwDmHu^|C{@cZfPqOyRyXqOyr@kFufAr\\\\fGdIq~@nO|CdHlYfMpF`MeE\"],[\"op}Y{le@cD_EhL_Gg[uxAv^y}@lZ{zAnZeQhLih@r`@g[rQbCzSiU~u@_Urn@au@dNsi@aO}n@xI}t@hZiRnXp]zTvcAiLru@xBf\\\\li@rKbUqUrWjCfLiFbW`b@rBdtCsIhhAdH~KlRm@jFzGoIf^n@z_AmXvVg\\\\iPsFxD}FhSvNvPjApSk{@poAs_@hrAuOuXyHr@cV~Zkr@pVuLtf@sj@rRym@y@uC{g@mg@gq@{K_@m[p\\\\aKeC_DePhPmx@e^kn@\"],[\"qbtZufaBkOzWzYdeAzAnh@{PlPeo@pPqY{Aak@ez@uTqHaZfi@gQgpCwV{LuQy[kEc_@mNlDiVlm@}f@iRhXotAyGaTsi@{d@oFeUhUge@`_@oKfx@igAxbD}uBd[s_Bmr@yAgF}^zZcp@fDgq@zTeZ`MsJx\\\\g@tRf[fVjGd}@uNtJ{}@jJcEtNxOnr@vIzH}Mf@mp@t[iEbTeSf_@r@~E|H{Ed`@nEnaBhPfe@s@pa@fNp]xYpHtHneAnVrSkFhaBgf@fv@_CfJ`MIkQjv@cyBsSwd@zLsNsHhTvz@qI~yAyLpFxAva@}i@_G}u@rIgK`GlFzVyViDMl[wTtU\"],[\"}rtZym`BjNaRBuPvTuULm[xVhDmF{VdbAuQ|i@~FyAwa@xLqFpI_zAiTwz@rNrHvd@{LvmBxSlR}HhIsl@aMHfj@oaAjFiaBnHkIfYdUlGnsAnt@b^hYi^bJhCxNxa@uJ~kAfNjAnViQp@rj@`a@oRxLkVpJmpAnOjAlZ_e@tbAbGuQ~h@~c@~{@}@xT}Vhl@yYfPkP`xBwKnHu`@_BxJzWiCzm@zGji@h[l~Acs@jl@eCp~@q~@cH{[qRu|@eJet@si@uViC{r@vNyz@sw@om@gR_l@~XqZsCcUpGkEtLurBbF{Aoh@\",\"gn`Zss~A_Agz@ye@g_@k[z^[pYxi@fe@~M~C\"],[\"kveZkyh@gJcf@{\\\\kEsByHyHck@nIwWeIeqAka@_f@{dAod@i}@yvAtTgU`VqB]}`@bi@i]|J_]hLhKnp@wOtvAe}A|\\\\U|d@ca@vg@bHh`@uLf\\\\~MlS_w@`WoPgAq}@bGyP~GsCpJ~Tl[hJrMlVzOs}@t[ee@lj@rw@tThH|Rx{@l`@`Uj@~}A|}@VlNxLw@f}@iZhRyI|t@`O|n@cC`Tuy@rjA_v@~T{ShUsQcCgh@le@uDb^oZdQmZzzAi\\\\jp@m@~ZfZbjAwO~HsG~a@aMdEgMqFeHmYoO}CeIp~@eVc@_SwRc^tX_o@~Om|@cnC\"],[\"}ofZiaj@hMXhE~Mt@bhAl|@bnCluAsi@rLfsApOxr@xRxXgPpOz@bZt^}CvDlHHfLe^pk@rTxUjPz{@uJxc@yPwJ}F`HjFtTgTv_AyOrQc`@iFbBbg@eZ{@cNvQ}t@nWik@_kAkp@qj@{YwcAcOyLiVzC}Lc^gl@_m@}]{D_i@lIa[{x@zIwM_DuT|Fi[|v@y}AcVgvA|DoU{Gw`Apz@uO~z@sk@dt@hNxGcQj`@uK\"],[\"mrx[e|XnT{QdZXnBxVnRpEba@zj@{Ipr@tVd|@h\\\\_@vC|KnuAtRd]fOpFlWmMbNzHhUaQx]mjAxhAeh@~XuVb}@hWaFfEzE|LvuAsB~aAcPlM}n@cA_Lt]|Cbc@g\\\\dDdAzZsPhFfDbU}TxS{yAaW}o@{k@iV|@uu@{\\\\}[tC_a@ePlLoxAqUhAkGaIpF_\\\\o_@weBoJ[qIkUpLae@aEuThMycAzMqFtStC~GwIiQyv@nCwNzOiE}BeXtTmQ|YfHpq@}h@xoA`HrQe\\\\hBqu@\"],[\"wjv[{oXoRqEoByVoLgCeb@hUaXs{@Lc~@aSuMB}Z}O{EnF_j@iIi_@vj@sfAt_@nGtUz^lDyKjJww@qKoq@|Eal@vOwG|VrE~\\\\}^xb@xGtWi`@xf@ba@lSFjR}Ub]n\\\\tNkk@xWoCxNaWxQq@dc@dPxLyNv]sCfb@n_@oI``D`Jh^uR|d@p`@bTu@fg@jKfZkOhRkChl@tf@ldAg[lj@vPb|@aKxj@qXaJ{Ut_@oUkCeJzFmKvcAiO|FmI`a@gTfZws@Q{^pU{HiUlMcNqFmWe]gOouAuRwC}Ki\\\\^}IeJwK_q@zIqr@\"],[\"_gy[k|b@wj@rfAhIh_@oF~i@|OzEC|Z`StMMb~@`Xr{@mx@vu@iBpu@sQd\\\\yoAaHqq@|h@}YgHuTlQ|BdX{OhEoCvNhQxv@_HvI{\\\\qAuGdLiJ`|@`EtTqL`e@pIjUaTiAkg@cqAwiAyTgW_c@in@kC{ClQ{StIkGca@mJfKsUmkBdF{KbV_EzG_e@b}@obAgCe\\\\hNs{@sY_kAbC{q@`LcI|WlR`MmYxVDlWj[nQ_VbP`MhTa_@{PsQzQgo@xb@{O~T}a@q@eReb@wXpS_q@iEgoCzGq_@fYqHxSlaAzPvAbJgk@`l@}`@vQeBnPlG_I|x@|EtNpX|Erh@iS`M~DvK~u@yIlcArL~OjWxD\"],[\"un{Zaod@_Ht]mf@}GsKrIuNvi@{VpZbFtpAak@|HwHhy@aL]cY}d@eArOal@tNgHrSkf@pBse@d~@sP\\\\en@e`@oMc@iH~Nuf@mdAjCil@jOiRkKgZt@gg@q`@cTtR}d@aJi^|L_iCmAw}Ad`@ye@pZyMxFub@zTkP_BgWx`@__@dw@q_@v`@lBja@{Sxa@Ml|@|v@rAbYh\\\\bh@td@gXzNa_@fPna@oAjk@bW|e@_u@~aDjb@vd@\"],[\"grd\\\\nmF~Jl@bKlUuIpr@|LxNSj_@`Mt\\\\mc@~lExQlP{Gh_B_Sh`Aq}Aan@_MoSmJ}y@_e@VuPzPse@lFuLoFey@rRw^}RyoBxD}@gfCr\\\\s[dGaf@{K}MaFab@rJ{SqGeu@wVok@zFwyAsJwJaH{j@qTsHjLck@f^iDp^sx@vc@}DhExk@`JlMdlAwD`QrIxUrn@vw@_@lSnbArGxAdk@c`@|Pv{@~LfEnWcFjOrc@zOgF\"],[\"czc\\\\jeGcKmU_Km@oFr^{OfFkOsc@oWbF_MgE}Pw{@ek@b`@sGyAmSobAww@^yUsn@aQsIubAlHqScQuDasByLwF}EcYmSeViOiaBlMoe@nx@_ClX{V~Eeo@fWwL`GqvAzi@kz@a@a]fWqfAtZxq@tO{KjGba@zSuIzCmQzh@fAt\\\\be@viAxTjg@bqAp_@dBn_@veBiB|c@tYE}Tl{BhPtENxMgj@pzChMf[uAlO}QzVyLZ}GaN{GjEtG|]\"],[\"qcuYckoBhh@`jBaAf_@eOhhA{Zbo@yD~xAqVz`A}bAhpBiVdrA{Rg[kUy@uFaQ{m@~n@Uj\\\\k~@|Aki@v`@_j@bEdCq~@bs@kl@i[m~A{Gki@hC{m@yJ{Wt`@~AvKoHzR{|BhWmKzXcbAnc@fAnG{Xnx@nUnL_j@|R{Fdu@|Z{Img@hMk`@\"],[\"awbZi}_BbUyi@~N_Ft_@f_@Mz_AyWM\"],[\"i`v\\\\{`~AFemAxa@gMpW}a@lu@szBnEsn@vq@_dAlWnSl]{HaK}j@xh@mn@xCee@tS}DvRgc@rHqCtHfMbt@rWrl@epA`g@eIwDa[d]k]zhA|wBbgAlF~PpgAsNzm@zCli@jo@ji@mF|TtKd\\\\eRtKaPld@}QbNtPvj@ua@hn@~Hn_@ot@h^cPcc@yXlLaj@xl@tEp[eZkFcMld@qNoAeRlaAaLmKmUnMkN}HaDygAgu@wTkx@zHkMbPcQ_BeIh^__AjtAkk@qSaC{fAgVfIgKiCaJep@{RrIJ~f@oT}P\"],[\"a`v\\\\ao`B`HvuBnT|PK_g@zRsI`Jdp@fKhCfVgI`CzfAiJfk@bLxl@mr@tzBcg@gAaPbH_DvjAbIjhA~OhLzDx\\\\vu@vvAxZ~K_Ht}@yP`SbPfq@sVhFPxM{UrTZjP{QzUVdYgr@~CkUfb@}QeRcMdIqAea@_i@mW}pAygB}IrGeDbl@{J_@wQyWa]J{Ek|@uKkMeXrk@_y@vNqLi`@gMi_B}M]cEyJzJ{dBi[_H|FiwAhn@auABkXzUiWm@eeBz]_d@}Icu@fIueAoJc`AvJ}V`k@pM~e@kQdh@kChRmYpk@~N`Vxp@~g@[bPpMfa@mn@\"],[\"iekZ|F}]aZ_^rMmUcS_i@qOkb@nT?tg@wRgSeOzEwYct@p@qj@kVaRqVwn@wl@iCeb@kNuh@s~Aip@ik@oh@wFkGy\\\\_f@kk@bJu|@dRsUt\\\\bEvFiN`Bs^gMsUhDwyAfg@ug@tJqn@f[pPxY}`Bb^~GtGw`@~a@xl@vVrElPwWp\\\\dZrFw]hTnExG_FhHmbAn\\\\Inj@ee@pR~Sx^qLpb@hGrg@pj@|Lb^hV{CbOxLz_@zlAp|@b|@cO`LyVps@tUbqAsNxMiSsNaR~QfItmAkOb[SdXhUt`@aFvXnRfv@sF`Y{qA~}@wUtE}Iu\\\\{FyEuDnFwHfo@pGiCpXzg@oGrxA\"],[\"kxd[su|AfeAn]`_AHpFmUzViU}@cOdUof@hf@{CiH}rB|f@hRp\\\\kt@dHn@jEb_@tQx[z\\\\tYbKlcC`Zgi@tTpH`k@dz@_UbYeF|f@yf@gFoO`OcAnxAqj@neAqZvJy^sRyEz_@{I?ug@_^mZhd@wV{ByIzFuP~i@cHtBaPka@oVra@sr@qu@mJux@oXIySn]}i@}QpJ}s@qGsa@lOiVaBcMqQwG~A_VhRwFrGuOxc@aI\"],[\"cup\\\\soeC{|@qgBcBeTtMud@zHfLluAtKfHuq@fPsKxuAlmAld@f@f{AzoAxq@~UhSqDbNja@{n@|qB}Zvd@fXfu@e`@veB~K~oA{K`c@qW~JkIdi@ji@hoA_WvDd\\\\tj@{TrYvD`[ag@dIsl@dpAct@sWuHgMsHpCwRfc@uS|DyCde@yh@ln@`K|j@m]zHmWoSpC_NiPsm@Qee@cOw`@I_i@sQeQnE}G{Haf@pLod@hh@nPvOsq@xL|BzCsPkAuvAkE_SuUb@pAkcAiTcPtRg\\\\]yc@xHp@a@oHmI_RoA_j@ga@g`AYiQt\\\\wP|OqxAwWyb@\"],[\"eusZc~|AtrBcFjEuLbUqGpZrC~k@_Ynm@fRxz@rw@zr@wNtVhCdt@ri@t|@dJr`@tS~uAxFma@rtAbBtg@dRrErEzOsE`MsVvDfBrZl_@|w@e^hjA`Clq@o^rGmPoG_a@xa@cy@zUqZn[yfAlXsXUei@an@aJeh@gQgJiEikAes@ue@qHwq@}OzCkKeGsIch@}QlKqSiUwRlG`Ig^oDeK_r@yUiQjGmBisAwZt@mOeHy[edAiC_^\"],[\"yqvZ_~{Afp@cEb`@dcBlOdHvZu@lBhsAqSpb@sAb\\\\uStK_^yB{UnYzKtV_Hj`A|O|LfGvz@NdZeN~UxJvk@_[iBqb@gc@m[wG}k@fXkp@~kAkzAdcAm{@gU{Xl]wMj@oZqtAw}BhRsNzJsSca@_H{g@jf@_F~Uwy@wIgs@`LqdCyDsWwYua@nI_UnTqKhMjClJtx@rr@pu@nVsa@`Pja@bHuBtP_j@xI{FvVzBlZid@tg@~]zI?xE{_@x^rRpZwJpj@oeAbAoxAnOaOxf@fFdF}f@\"],[\"eqf[{aw@hEsVzo@{GjSmOpWyh@wDgm@bX_Va@_aAbQu|@gPgaAzi@yJgFkf@fEgg@z\\\\mz@vMk@zXm]d_AzSrvAyaAjp@_lA|k@gXdTzBh^j`@ta@~L_Pnq@rU`[mKxq@vLla@}[xa@aMdf@rHtcA{Khj@te@hmAfBr{@z`@lj@hF`W{Kh\\\\sf@b]{Mrh@fDlpAa\\\\dU}_@nfBgc@jCsW|QmWeI}KmWi`@sAeZhXuKn[ii@vVuWsf@WoVi~@qy@ya@Lka@zSw`@mBhEss@cZef@{`@{JxL{r@iXeA\"],[\"amc[s|y@qWxh@kSlO{o@zGiErV`L|_@hXdAyLzr@z`@zJbZdf@iErs@usAzx@oFlNdDnN{TjPyFtb@qZxMe`@xe@oq@{t@c_@glD{]zRaP}CuLvIwEeZmPeDOyNsZaj@bLq^{IoS~AkSyPmQgWsbAl@w`@jXqL`O}z@hVob@mHyq@bJql@sNgWjAuUza@cVsR{v@me@aVfAqPj`@sS`k@ir@hh@Qdu@usCbUuEjWrEte@_L|{@vpCrN{J|y@_B`~@aPnMzKvPlhA{\\\\lz@gEfg@fFjf@{i@xJfPfaAcQt|@`@~`AcX~U\"],[\"qic\\\\mf^iT`_@cPaMoQ~UmWk[yVEaMlY}WmRaLbIZ`SmZa@eGwRnC_\\\\eVy\\\\pBkr@eKgmAeq@_g@oK_`@gd@zBcRqM_MuyAwWcg@tSkc@_Mqd@|IwZoAmWlg@kV~Y_o@zNlG~MqCrElb@|L_I`@ggCkVu_AjDiHjJxCn@wHuM}yA`Mih@ra@_MnZif@vReEn_@pS|Xnw@pd@}Ifa@hK`ZlaBvSg@hF_W|Ze`@hXbAb_@uZpM~cAzM{HtL`b@oOlt@|VfQ`@rN{u@jeAkAdo@}Qp\\\\hFjj@{Ovo@Vra@gYpH{Gp_@dGx|BmUlcAdb@vXp@dR_U|a@yb@zO{Qfo@\"],[\"q~_\\\\ltSyMi^i`@ddBoc@vDqT|i@en@cB~Ri`AzGi_ByQmPlc@_mEaMu\\\\Rk_@}LyNxl@k`DuG}]zGkE|G`NxL[|Q{VtAmOiMg[fj@qzCOyMiPuEnG}a@~`@dP|[uCtu@z\\\\hV}@|o@zk@zyA`Wy@fk@td@t]jEdW~PnOaZlf@xFzxBpLp{@sDxZ~GjVjUuBnS~r@tA`g@]r_B{Ols@pGbO}IjSvI|XoAfX}Lxl@eF{QoLzEg\\\\|}@wn@si@s[vLkIxFb@z`@u^x^kArVkM{Am_@eiBqJsuCyZ`Sc`@`EgGbeBcVp[sLrA\"],[\"ekv\\\\o}pA~CwjA`PcHbg@fAlr@uzBcLyl@hJgk@jk@pS~~@ktAdIi^bQ~AjMcPj}@mHfp@hTEl~@rShRlUoM`LlK`F`gAj[zX`K|eCpLlVkEpSuVhVxSnHkHxXbLjTeBfd@tH|QcDheAsI`LaWrBsCth@mL{OuwAlmAhGbi@eVrs@sUwRg^hGcq@jw@_Mfv@a_@lQk`@N_NoQkOdHmHsa@}\\\\eSaOsZ[kPzUsTQyMrViFcPgq@xPaS~Gu}@yZ_Lwu@wvA{Dy\\\\_PiL\"],[\"cxx\\\\ctj@aUj@_S{SAwcAfYo{@bZvQ\"],[\"ebz\\\\sgk@d]zWzJkDlIk{@vi@~ErJjJyu@jtAyMlG{XPwQaSkZkdAfD}TnNtE\"],[\"ebz\\\\sgk@oQ}d@cPhD{GePpIiz@m_@{kA`m@qPfn@fLp^iLoYjo@_Ktt@mPlV\"],[\"ye{\\\\ghl@fGbg@ve@j_AaAtOiN~@y]s^{POcCt}@v_@f]kA`IiSJ_RrQ|El{@ej@zWgBfW~N~{@uLtBoGnp@q{A{o@vXsWpLs{AaEs~@oTqs@n]{fBiIqq@zh@avBgBqOxSw\\\\tm@zQn\\\\vp@aEnnA\"],[\"ss}\\\\ms^nGop@tLuB_O_|@fBgWdj@{W}Em{@~QsQhSKjAaIw_@g]jEk`ArNdBlLpUt_@`F`AuOtg@_H`Yyd@pKh_Alc@zSxEvZjT`J~l@SxQbq@f`AnQFpZmSjw@gNfL{^vBczAppBs^}QeNdWil@u@yy@no@cTxAaG~U}]~C\"],[\"_a}Zn~B_b@mn@cz@lM{Dq^iGuGwKbIeGcX}i@k_@q\\\\i}@iBo\\\\dYg}AlDc`BgP}PqH|OiVsZzY{g@|Cc\\\\cDi\\\\q[ce@jAkv@pp@uI|SeTe@sVd]iWlTrT|Irp@`mAjQCpj@h|AhzAjGx\\\\nh@vFhp@hk@th@r~Adb@jNvl@hCzS|j@|Zn[uCt^~Qpr@gTrBeIjRuBfp@{XaCgQbmBgS}A}N|QgW{`@eKaDyUvJyRcM{Rdo@kQuN{a@nlA{jA}AZdZqV{MiOceB\"],[\"y~v[qyc@yOpdAuU{^u_@oG{@ih@kWyDsL_PxImcAwK_v@aM_Esh@hSqX}E}EuN~H}x@oPmGqYzFgd@f\\\\cJfk@{PwA}UeuAfQs~@iFkj@|Qq\\\\`Goz@dp@az@a@sN}VgQfPig@wIss@nm@m^l_Atb@zn@u~@luAkb@~[d@|Q_Vtk@oC|d@jLzY{Jrp@bZxCn|@xb@hy@_BjS`InNiKpc@b]r}@lNl@`@fWjRyFzKhEzViSvKl@hPtdCfLdd@nq@zt@_@tfA{i@ua@}c@rU_m@aQyWnYyWnCuNjk@c]o\\\\kR|UmSGyf@ca@uWh`@yb@yG_]|^gc@kBkJpq@\"],[\"yzg\\\\ktVu}@tfA{G~d@m\\\\zIvTlsB}_@eq@gWpfAkMcQsZbRkk@fJyZauAgg@qf@wUdR{[g@ai@|}@oVfPyZ~BeTeb@sJgnAyQsW}Aec@cOqFxPqvAaOum@sa@m]sS{k@m`@mA{L}Zxy@oo@jo@AbKmUxa@dPpq@oiAvQaFrQg]z^wBfNgLlSkw@GqZrRkAvu@gn@bRzOjJmIbRpMn[cFxLbGlFb[dq@~f@dKfmAqBjr@dVx\\\\gBve@`MlLhUqA_F~]rY~jAiNr{@\"],[\"}msZex{@sj@nDgBs{@ue@imAzKij@sHucA`Mef@|[ya@wLma@lKyq@sUa[fXc|@gNad@dN_VOeZgGwz@}O}L~Gk`A{KuVx]e]`VnFrPgHzJon@zXk]l|@nYeGbh@vRmGpShU|QmKrIbh@jKdG|O{CpHvq@ds@te@hEhkAfQfJ`Jdh@di@`n@yNdo@}Znh@{F~l@gQyBgF`J`Ap]dYtNrCvw@kqAnx@MrIkWtGu[dd@cR\\\\wPkUuGv@aNf`@m_@`CuZrTiUjCgJ`T\"],[\"qytZur{@rj@oDYiq@fJaThUkCtZsTl_@aC`Ng`@tGw@vPjUbR]t[ed@jWuG`SlmAl^nn@}MlZnCvId_@~I`OnUb{@xPfk@`[a\\\\|`@gh@xJiLiK}J~\\\\ci@h]\\\\|`@aVpBcUbZv}@|qAzdAnd@ja@~e@dIdqAoIvWlL|t@pNpDqDxMk`@tKyGbQet@iN_{@rk@qz@tO_Jql@kc@bNaUi`@yl@{[cg@tFogAw~AaVgLwQvIeCsWlL{w@su@wUOwJl[spA`\\\\eUgDmpAzMsh@rf@c]zKi\\\\iFaW\"],[\"ij}YvoAgm@bF{]eaAsj@t^cLs}@iZ~HaWyFqNlO}MWc_@m\\\\_Dk]eq@ev@kJwAyIx]wMpIcPmEiGmN`M_k@kZsJkAsg@n`Auo@rFaYoRgv@`FwXiUu`@ReXjOc[gIumA`R_RhSrNrNyMuUcqAxVqs@bOaLbYhp@|t@oWbNwQjZz@vHjz@cIz]lFzJ`a@bHxW}d@|k@xXpKhTnRaMtA~t@~W|]~VlIpOve@_Rl_AbH|cAhd@rdByKjx@~\\\\fa@sFtSvGfW_c@pNwx@_n@\"],[\"eicZg_mApZvTvMfg@tWyRt_AiWnq@b[zTzZ{A~s@a]~iAnCtP~]xBpEtKbAn|@ia@fwAaOfOQbhAkU|KmS~v@g\\\\_Ni`@tLwg@cH}d@ba@}\\\\TyOjJaq@xt@gk@a[c{@yPaOoUe_@_JoCwI|MmZm^on@uTksAlsAe}@sCww@eYuNaAq]fFaJfQxBzF_m@|Zoh@xNeo@noAqPnk@ub@\"],[\"_r{[ieoA|HyI|l@_@fBqh@|ZkSzZ~GpXl~AdQdE|c@sHpNje@|MLjKm[v]f@pOg[rImAfQr`@nJkGxRhBjs@vsCk^bk@tDtwAle@`VbRvn@MfMk]jLaFxRvOv]cJpl@lHxq@iVnb@cHtn@oS|WyYoAsa@wV{YzJ}d@kLuk@nC}Q~U_\\\\e@muAjb@{n@t~@edAob@kv@jn@cOq`@kIcuBzBkKn\\\\sOzDqh@rMmPmDc_@~Kka@uPmj@jHol@kh@kAfz@qz@hD{b@f[ok@rNy{@qV{NoCaP|GaF|YdD}Jcr@jh@mObHyM_Pq\\\\\"],[\"cwy[wdpA_BrR}l@^ix@pc@kXu_@oe@sD}{AylAoi@}CkXqr@{h@nPo@n^gq@aOqLmVaK}eCuDsJsMw@qIgTvBwe@iGqWtNgz@dFqGzJzBfJya@`]vCuEq[t{@kx@vJ?pLfa@nt@i^_Io_@ta@in@uPwj@|QcN`Pmd@dRuKuKe\\\\nHqP_HeIzEeTcMoY~GgNzc@{Kj[dU~Gh[hQ`DzIyXrZ{DfRiWpb@}BlJqJfu@jPtfAb~@zRxt@r]BbO~a@nKljBmKfXpn@t`FmUxLsNpl@}`@jUuG|`AcWf\\\\pCbYwVvPm_@jfAcg@}DuNhP\"],[\"cyi]c|{@vp@~Ev[qNfv@zD`a@a\\\\lIlH`@t`@pi@tQlQlaAnc@wa@`Wba@tSqFsBkw@rOee@zZxJ`Rj`@xIpo@kD|qBxJjWwQvoAhG~YySv\\\\fBpO{h@`vBhIpq@o]zfBnTps@`Er~@qLr{Ao`@|ZsOyLpJyi@ypAze@aYzA{m@a`@}Erb@osAvRcXw`@iAz`@{K}A_b@ej@xNgr@hWiKuGogAfR{y@}AcuCnN}^fF}p@jOoW_AuZpQka@gEg[|TqMc\\\\w`@jBsy@wXgFsCaWmZmYxH__@cD{d@pHyQoDmO}JJ\"],[\"shr\\\\dzOcc@co@_ZsQub@vEkOuQoY`aA{M_@aUwg@oF}h@{YaJtEwo@}XgjBp[a{@yPyTdReiBeo@azBi[k]sAaMxOgPyIgs@qPo[dOqZkJuh@nIwLdXrKzLgN`ImiAqEc_@rm@qi@hPir@fOcJbZtQvX_u@xZ_CnVgP`i@}}@z[f@vUeRfg@pf@hV|qApJlD|_Au_@rPnb@uGvZkc@hk@aGpvAgWvLAf^eKnYm[vOgp@p@mMne@hOhaBlSdV|EbYxLvFSffAwc@|Dq^rx@g^hDkLbk@pTrH`Hzj@rJvJ{FvyAvVnk@pGdu@sJzS`F`b@zK|MeG`f@\"],[\"w`z[_ulBgXhbA_k@zGaNpUsZzD{IxX_U}GuCkVsRaTgm@tI_HfNbMnY{EdTqf@s[wEkq@rN{m@_QqgAcgAmFobAktBuMDm\\\\qf@fW{Hki@ioAjIei@pW_KzKac@_L_pAr_@qyAoCkc@eSa]|Zwd@rk@k}ArZ~^vb@`ObiAcRjPgL~SlRiHvn@nNlj@mBvV~\\\\hYkKh`@hHlNiLxThEx_AzGlQzf@f\\\\u@`a@dTnSbAp^~Vj]zJdo@aC|j@bp@dyB\"],[\"sco[ykiAks@wsCyRiBoJjGgQs`@sIlAqOf[w]g@kKl[}MMqNke@}c@rHySoH}Uc{Al_@kfAvVwPqCcYbWg\\\\tG}`A|`@kUrNql@lUyL{b@inC}JgxAtKkQ{@ut@eMeh@js@i}@f\\\\aoArPfLnJhu@|VsUtO~HzI}Mds@yQn\\\\|IvKxVnQxCx\\\\kf@nHfAtPlcAiGbk@qYdt@tKdfAmG|}@fgBli@ju@~b@fp@zIoIno@yc@`IsGtOyOvA{ExT|R|M`BbMmOhVpGra@qJ|s@|i@|Qv^ls@gKlkCvIfs@iS~u@ai@vIc`@mfA{c@tLkWsEyW|For@lrCih@Pak@hr@k`@rS}FcfA\"],[\"_dd[xpJqHj[sw@lfAyOgEgQnHee@_{BmN|EmLzd@a`@sBm`@~HmSaNmh@jG__Bpz@gXuDia@n{@ib@fLuq@feAaG{AxMmw@\\\\s_BuAag@oS_s@kUtB_HkVrDyZqLq{@yF{xBrV}VlBoNuFwDrl@}S~IaUdw@{OdWsXfMtGOxQlIx@`XcAdUoQjj@dWxSd@`V}[nt@n`@nOE~I}Jna@?tSyTxY_C~P{WwV_g@vGkXv[T|v@nf@rZqx@xa@\\\\fDdKsIfYxMp}@`Qf\\\\|i@j_@dGbXuz@z`BuFxdAaPr^h@pi@sQzW\"],[\"a`d[wpNkAjv@p[be@bDh\\\\}Cb\\\\{Yzg@hVrZpH}ObMlGiAriBySxv@ya@]oVjw@sPy@mx@mg@gUdOdCzVzOjQmCpVmT|NoOMuSxToa@?_J|JoODot@o`@aV|[ySe@kj@eWeUnQaXbAmIy@NyQgMuGeWrXe|@dScJrUog@`PmEmDgIo\\\\ud@u]x@gk@|TySgDcUrPiFeA{Zf\\\\eD}Ccc@~Ku]|n@bAbPmMrB_bA}LwuAgE{EiW`FtVc}@dh@_YljAyhA~]ik@|QaHpa@dE`WuIxXgw@hO}FlKwcAdJ{FnUjCzUu_@pX`J`PjiArn@hk@vBjt@~^xaA~t@oS\"],[\"yp~[iq~@uN`Z{Dph@m_@fU`ErvAc_@tZoQyD_QhK}Zdr@iVkJsSmwAga@iKqd@|I}Xow@o_@qSwRdEoZhf@sa@~LaNt{@gZkHsQqe@mS_Os_@dHw\\\\eQaEa}DcXs]dYeO~Lgv@bq@kw@f^iGrUvRdVss@iGci@twAmmAlLzOrCuh@z_@eJ|EyjAuH}QdBgd@cLkTjHyXySoHtViVjEqSfq@`OWi[d`@oQxRpCnOxk@xo@xGruA|hAne@rDlRr]ht@uVEhc@pOrQuGvXkh@lO|Jbr@}YeD}G`FnC`P|VhT_Ojv@g[nk@iDzb@gz@pz@jh@jAkHnl@tPlj@_Lja@\"],[\"als\\\\abf@kWsTma@bDkTaJyEwZmc@{SgKet@vKwc@~MaM}IgR|Omi@oDyiAxUoSd@aRtJuAfZn_@veAdPbn@}BvKsM|aAtsA_Htm@~Lpd@uSjc@vWbg@hHvcByXoOwu@fn@e]t@uu@yP\"],[\"_sn\\\\eum@}aAusAwKrMcn@|BweAePgZo_@}R~A}YkPqcAlPgn@gLcVvLyQoAuDfLum@{QiG_ZvQwoAyJkWzC}vBaPey@dGge@jVwSb_@e@vs@}xAKaZ`^mK~Lq_@a@uYpStGpAda@bMeIzUxQlQ{a@fr@_DLq]tPoQ`OrZ|\\\\dSlHra@jOeH~MnQff@wAbXr]`E`}Dv\\\\dQr_@eHlS~NrQpe@fZjHfNx}@a@nQkJyCkDhHjVt_Aa@fgC}L~HsEmb@_NpC{NmG_Z~n@__@~L\"],[\"_hy\\\\{ho@h_@kiA~c@cCdb@`Pe@`R}Wb\\\\rFdaA}Oli@wi@_FXsdA\"]]},\n" +
+	"{\"countryCode\":\"GA\",\"polygons\":[[\"uei@ctbGpgAlGb_@jm@hVaAhb@~\\\\lk@_Qfl@nX~iABjZvKda@wTXkJvWm@`j@ca@nw@kF~P`Jr_@bs@hTuTLyg@kl@enAxIi_A_Cwf@_XkUeTkf@qIkeAqd@}WjLy_CzUm]oHkz@~d@ibAl|BeRzYuf@~gA_a@tb@ai@xV`Rxc@gElkAfcAll@~Dbp@r{@|@j`BjY~Qve@mG`t@dfAf_Bfm@l~AyMnLwVdWlKvUaD`}@~o@d`@dFdd@s_@{O{VfN}d@aEwU~M}n@jd@yYdiAkLeH{SpAunAtVyk@vb@ue@he@kU`f@k@deAz[fq@}Ezg@tZtu@~KxmAoYnTxBha@mVn`@bBb`@pT~o@ke@fRrR~e@dJx[mNzBlS~QvItOf]lJiOjXqFrHs[x]hYlaBj@vv@veBlUbChKkJj{@dJ`JlQzTaBbHhGjM|\\\\jT_A`g@kn@zRsCfDp_@xOxVvbAz`@yFvZrHnd@iRrJdJfr@uLnWeZe@qf@qc@}VlYuqBtq@qXvW~{@~X`t@rs@fXvGxKr[x}@t|@wHlKbAvo@wh@brBjH~XgWzcAjT~a@}z@oWiDfJot@zZbG`Wca@fNkRwFaBvJkW`A}PbOyhArYuy@rjBXv]~Uhd@ne@`VoXhd@`dBeG`S_L~IkW~d@nQ`b@iBtz@fPvLcFdt@bwFgNne@me@|e@d[jqApXp^{EvTqc@zg@}H`w@hK~Cdq@wNnl@hCns@qY|[kB`fBhu@bj@fCqRac@i@g]vjBowAlL?lHlNvCp\\\\nYhJdp@Njs@_sAf]qkAdSqEro@xj@taBjd@`LeE|Qwf@dLkChZ|NxM`Y_Kb{@jC`a@{N~VeoArp@yPdVoCh^|o@zi@bLpp@lv@jkAvtCp|@mp@|x@oxA|UmM~KwtCjeEs}@q@wVlGka@nr@kmAv}@ov@~`AavEztG{_Dh{BrZg\\\\pG{ZsZg[pUfEtF_AoG{G`Vw@nLei@xZqJ{GkTf]gC}G}MxGgKe\\\\c\\\\qDwv@{FqByGvOwJg@mU~f@~i@yKi@zGeYrU_F`Una@bNyGtOkRh@yMsUeLrr@ce@nTpKzOiB|Nz[vj@eOxNoRwK_FlMkM?_@~Nj[}G~FvDui@|m@na@gXkH|Lsa@|]wbBnRecBriBsGgCuBnLua@jOiq@xr@cI_EtUyQdB_Z`GmBoOcLdZv@cImEhCmp@dVeFfJzL~Me_@dRkAbWqRiLoMeJt@gTnHkXn^eRiK}m@~^~DfEoGzKrX?cj@le@bj@fT}GvTmRyDoIfMtD~UcoAdBonAzQ}cDd~Bct@rSdm@kUdWif@xlAao@`i@}`AjvAwHkXwI`LkHcR_WoE}^hSjCfMyKye@??ayAkPtb@wb@fLaW}@oGjPr@dNtRm@lc@ta@`Hpp@aFfGic@dDmEnPyHb@eNk]eYjFwIq]a]uK}h@zJkBxD`y@`AjEzKyK~MrXzZrD~\\\\sHhPif@dFxGrWq\\\\do@si@hF{GeKoM|A}VlUxQ{F|TvHogAfPsnA~|@vReXaBkH{BzGgT_PjEjc@cc@zKbCxI}pE~xAcLoGrO\\\\hH}YvO?MmL|fAaTpMsRzPkC`BeIot@kBhAi]_YrOpBsXjh@oPf_@w\\\\pa@~BzGiGsp@gCc[iQwWwAcL_UqQe@cBiFtOqG}a@vLgOeDatBcgBaq@mWwoANwt@wTix@|B_YeLafDhb@sXmEqRuUtAyKlZ_G`nApJeNsKw@mZ`YyUri@_Paw@cIxK{e@na@wK}X_Y`PkTeDc^pdAk}@y~@n`@wCi[xGyHaj@yv@|Goe@}Gma@oPxv@~Nn`@xDpw@iYnq@oAnYgb@rr@uTnK`Pti@qzBb}@sRjPiDl_@yHhGer@kEaPyt@zByZkT{x@dIeIhZmAn@|q@rKrGiBw_@`GuMpOwBiTcPf[sGfZrGxP}DvFuNsUrAmy@_WgXtIugBfCo`Blb@g_@cR}Cm^mUoO]un@vOoQ`g@}IoQsFdAqQjd@qm@jEeUiHiP{b@sD}Bk]tAyoXcxUzGc}@eTcUvAuLa~DlYgdAJqnGqTkiArPqf@gEmy@~c@uhDaPmc@zJgd@{Jsb@jKqgAyDq_@kReXXaR~Ji]uCcRhQaYnOsG\"],[\"fgKgvnDzCuUpT_Dbm@v@nJ`SmNxV}Xu@kTpLiToGuT}X\"],[\"gyNocvDFqCr@xA\"]]},\n" +
+	"{\"countryCode\":\"GB\",\"polygons\":[[\"iqr`@lplCrJ~Df@{}B_MyUmRqCwS{T_d@uFxKgp@iAyoA{SoqBmVsz@nIod@uEkf@lTueAoG{p@|D{c@xZ}f@|a@dAtVlL|Qsy@ve@dJxE}a@xQoZ`h@yg@~`@yK`Vat@_e@fr@uI}H~AyQbS_^vRsFlPv@|U|U`PpgA`Qh[pWxUbPkKqZwi@eR{w@hFi`@cF_jAbMyVfd@{LvPeUf`@mElRsVbNz@jU`RtS}@`MlPzE}GxKrXmg@~d@ka@qSy`@|G}a@hu@eEtb@pO{A`HjJvKgw@rE|ZdE}QxMzA|E{JlK?le@jUjPt\\\\}RwoAlJgQxVcE~PtF~i@f_AiIjMy@hc@rJdmAfj@`Cjb@xNhg@lrAnBt_@yDxSeGmPyIxv@oJfFgJphBr\\\\dM~Cfb@wFzZdO`~AsOdYuMsHiMxDaU{ReJpBwQ~`@rGh^wJzY}b@d^oIxZuH}No]jMwk@huApJ|d@`[h]lHzg@hJ{CnMfN`E}StGvB`JgUbFrGhI_CvOzz@hInFtGqHtBfKxTbHDzIoWe@xHpVtTiMoCvs@mHlRmD}EzKvu@gHtaA{PxM}R`l@{Ev}Agn@|MuG`k@sb@b_@_D|`@{z@hhA{Nk~@eb@{j@`F}fAal@_oBqKt@bEzYsNje@`DrRuQrd@{NHgEbMmTks@rNcn@iWojA~@saAc_@Duj@ev@gj@cEyJ{Uy_@cAiVm^qB{f@\"],[\"yco^z`s@dRx\\\\hX~yAfAzs@yKrRtcAvzBhL{FjTlNjGj`A}Gb{Cqo@nlA|AbScKjYgl@hSkc@tk@~DjsAbLpMlPyNrDl_@_HnK~FxMoEd_@~Ohp@oIxv@oGtCsD}JgWfFoPoa@fLqGaIapAqU_LhFtUoLry@fDtfA{]~f@gC_j@wDn_@y[r@bPhTiFb\\\\fOaKdFlThAjrBdHnS`i@hTuDbMzGdNPd`Av\\\\lo@_Rn`AqEwDeKbHmLxi@?}r@}GfCfCoa@mPsxAc]jTaTea@vKhcAfGuSfHpB|CyGfLpJjF`}@iF`vAtA`JbIeEdDdOuIk@aP~i@_[_vAmo@vHqLxz@pGxv@oPwK{JzC}\\\\wnBuIuRo^cJxJybAqC_]qGfCpGmp@kTcA}Hwd@g]yb@|Hg\\\\mQj@gF{V@osAsg@}gAaGsj@kf@eiAwt@uu@skAe`@}X?rCeXaPaj@hCbaAme@fl@_^gKo\\\\{]_Tsi@|Fxd@qw@r}@uJyRoc@zFwQeh@uCnGzFhM{FhJlFbOwAps@~O~bAIhj@~X|m@`N`KvMmFpGbPaPxo@`PbhBsXmPe|@wuA{Hal@us@sfBss@yI~EsKqgAexA}H{a@vAqa@od@grCi]l\\\\nJmcAdS{_@g@ifAif@}hDdhAqvCoXaAihAt~@gYaoAhJy[~x@_d@dUuk@eFgaAaEcPaQaBiGcZbCjg@bNbUcQfmAwYza@ifBtjAez@aq@sr@wkAMtt@{Sdg@ccAuAiPhFcOkW}Kmx@dCgVoLaR_F`FgVoWpGzg@_SdMaa@cx@wUgRaj@jc@cJmZ_V}Gny@nxAyApc@u_@`JkEbTrd@jFte@ts@rU@iTvZ{G~^m\\\\}CaHpHyBwS_s@{CzH~M|ZNrErm@st@z}@yg@vBeiB~hCi_@mZ{o@qOip@ca@{Woj@mUJwt@u_@}JyYdIaWoGm_@yKdf@mMoX{Eif@pLww@qLc~@?`j@eLaj@rC~eAgNncFrHrNmJxZ`PdGp_@gHfS`Gv@|sAbLnLaChk@|El@}ExJ{BgLqGhC~XpXlKiT~_@vvAhAt\\\\}GpZcRaDoJdIpLhOpS{BlAlWaKx^eT~TaXiDxOxj@iOzt@cYv[rVvMvMyDb_@ml@tMnGbH{G`HzF|WgDtLlTyKlfAum@n}@cc@frBsPpJcBdd@`Qth@jSbQt}@wc@~G}PpX~AbIcGuObz@_j@RgC|SoUlHkbAb_Ban@vM}`@eEaD{[gH}Dn[o[ha@kDjKuQsDcU}GsCsx@xe@ofAqYyq@yvA{bAwT}Vcd@wZ{MuTw|@m\\\\mS}XrSkCvUiJcL{YpO_SjmAoIuCca@tcAa]cd@mg@nLm|@jAyPeVaEg^`NinAlBgvB_TzvBya@tcAae@pXlBnK~LeCpc@uc@pAll@ep@rGakAwcAiJ@ty@b{@qC`Q_a@`G~BzOtu@mThOjJjg@kFiCxe@tTsXxw@jj@sGbd@em@tP__@~_@lC~Hhk@u`@bRNkTbt@cTvDvn@je@je@sXgLvz@}^~Xia@}GuV|GykAsrA_Sm}@og@ge@}Tq_AoOgKrQba@tJ|v@ba@tZl[niAlk@vr@hCvTbYhMOvg@{IrE~k@rErCcNpa@_RbGfCzB}GrC|GdTsa@z]gTve@hgAzh@|TzDlLpT{BjJaQfMbTvJaC|S`PfSZrb@~l@xKqXvS}PlHhEn_@~w@xDjuAcIvPet@hDy]kn@gh@|BmQyGgLvGon@i]eTvCiO_a@sb@i[{R{n@gb@_^~q@fyAuSbe@i]pGmv@m_Av\\\\|}@eQlN}jAkmAbGba@f\\\\x]`X|HcBlIwW}EwfAiiAtEu[yZ|O_c@u]f]b{@un@qa@iGpDgRsh@qElKdLtp@}o@gKoNaQgCwz@?zv@wq@iq@yGyrAbDkfAce@kw@yb@u`@j_AhtAyEd|AvGlP{ZhSfTvZyBnGye@sXhJik@k[sfAvTlpA_H|Xoe@g`@aX{e@iH`KcPy|@tC_h@wTqaBpKbfB}Kpo@s~@ciA`c@bfAv\\\\zTbCxX`_@|o@tF|[dfAffB`Spm@qXb{@kTgL|P~ZoCxd@si@b|AwM~AwFsm@rP_z@uCuCaPhTuWcaAfKkeA}Gy_AfC~x@cO|eArWvzAuLb}BvJlf@yIde@uShFgOw`@uD}~AgLse@tVseAuVhT?_pAyKzm@uMdK}HihAsP}l@vJps@qBrWkTg]?~rB{Baj@qLlP}m@g]mQyn@l\\\\k{@uAqu@sK_]pF|`AaJlb@sOmBmDvLfCdXoGtVwCxEiIiHuZ{d@{Dqj@dQwu@wBuaB}GfK|GjjAaPnZcNd{@oc@qa@qIrJ_Tgh@qAqT|\\\\{{@iCmW{\\\\dz@_Yge@oBdNn\\\\zeAgCzwAqGlDiTaPoLw\\\\lFwXcQ}dAaM{ZsPkDrc@bgAwExn@oHqSgPxFpb@vu@`Bz}@uWtIgCqGiPxGiGyGqEz\\\\sXpGwg@_O@sHyKtCi@gU|j@geByKnGeEawAeKhn@fI~k@qLhTcGoGcAxSa_@j]kD|YkNIuFwKiIrBoFeb@pIgYsJoJyM~[oE`s@{v@rCuTuTWuo@jDiNti@qGpLca@qLjKrCsXc\\\\L_\\\\tg@gEoEiCnGiTsXpGye@`PrB|Rij@j@_SmZeJqE{Lfe@ywBqk@`jB{I?xAgLoFmBvT_j@gCuXxI}b@jg@_oAqVnOo^vlAeHgPcNpEwK`v@gRvh@}ItCm@|d@eLqGaStSoGyJrByVjO{MqA{g@eI_NkTpGgCqGsCzKeGqa@iTzv@eLuC_Y`j@mIOxKk|@yKso@pEyYyKkZxKqe@?yg@lP_j@mPf]qGqa@|AblAq[xt@e]bV?mK{K~DeXkKtOinAiTnXrCtX{GeGuCxKyIsa@xIaa@y^tcAkJ@aWoo@_m@kMsEoIpMe~An[aSvT|GuImO}g@qH`_@ygA|MpCnOn\\\\~b@`\\\\{n@a|A_^}OYei@dQ{w@rL?|m@`j@{v@u}AuCaVrLel@sCzGmKmPeEevA{Sy_@jNwNbBqbAuCot@w`@ycC|Bka@fCpGlFcNmFet@fGyr@oKiGwTxe@mLwVbQmTcGitAjKs_@R_rAn`@xQhm@`q@rPsEZ}`@vI}Eh{@xn@xf@tw@rXxeBb{@lpAxgAtvD~\\\\pYdZ`vAnNxE{Ppr@dLgCxEke@|MmPfXbL}B`e@xKvTyKveAbFlq@m[ly@nb@}s@yCe}@jQqc@EcIoInB|P_}@cNgb@pA_SzFcJbGnGbBiSwc@ugAvE}JrNrEdsA|zBc@bU}NuCwGvO|\\\\nmAvDzmAxNvGj[~y@~RxN}{@slCpIu`A_Ruu@xS~Hze@hy@pGoGjD~g@`M|GmApo@pGca@pXpXtBy`@yg@{bBgQp]iC_yCke@m~AuCgd@xKvK?ca@uOzKzBkn@oI_RoTmGkEur@x@ylAnOsm@zNolBkTgiA?yaB|GyOsDcl@lHahArG}HsGqP|Gug@}Ggl@dDuRuL{SxKot@mQu~@j@_dA`PcNiCsX`j@k_Avu@mQvPiWtIpKfCuLr\\\\fk@zRlK|m@lpAlyAbv@jWx@nIyLxrA~tAnUfGjTqGdY|Sjk@l}@l^raAtd@vNdVlUbPiCzTfT|u@vrBfTlPdJ`oEns@~mBb@ht@dEay@w~@_wEvQkr@`VlBvNhQ?kNxKkEnMe{AvZap@t]tk@|UpsAoG|oBtr@`eBzc@nU|U|nBwMnlEke@|yArBxKdT{n@bYuW?wTxK_RgCgp@`PojBwAom@hIePdDqpB`MgZaMmuAqXer@yKbGeQ{\\\\`@qtB~Sah@pLzGzBca@oGeL~m@a|BvKciBnNwDvKk]bp@ak@taBwwB_G}TpOaTzBtK?aj@lPui@|KlAxG}IbGnGpGmPvHfDdNmO~aAtAvg@}SxRvFbt@sd@lVdE~`Bs`AdUk^xd@iCl`Beq@n\\\\q_AlFtKxUkP|OjWxF{^{ScHtLqT|f@idEbs@qkC|QaXzOq@h\\\\ml@ln@wSrG{M|GhClL}OtQky@nXmIhIqOf]_bB|Pfv@zd@va@dlAwi@l_D{pDrS{HrUhJpEnL{\\\\oOsCzHoPpt@xKtp@kBp\\\\g`A`hBdOnpDaMzx@rR|v@nGgT{HkHuDgc@jT_w@oIgZ{Fi`Cdu@ww@`Z}x@dCy]hr@cxAxF}a@xV}MjJiZfCvCnVsZ~{Awt@jq@aH~c@vOlo@rhB|j@dp@hOnd@hOjFy@qz@`Pyg@nh@mn@uCep@vT{c@_MhBe^cd@_y@m\\\\kUms@uI}fAhWipD}Fm[{Gz\\\\f[{_ErVyjAfgAczC~Z_`@baAk^vx@Gh\\\\wMzcBvu@v`@d`@dk@G|hAj]hStz@oCfH|`@n\\\\re@xx@}`@li@iTpaAdVib@dEgb@zKgAtC|i@oGfXnGb{@fCadCtJdAj^hp@pGog@aJ{L`VjEba@zbAhH~~@iCpPke@|b@rQ`JxR|m@vThG`PllB`P{e@y^whBdEwH`PrCnPoGpYtIpFnMfCeX|P`Pnd@v~AuCljAbP`r@_EbIvFj{@`WfPvGnZcW}|AdH_cB|QwPp@jr@~R`u@dLsRhCknA_OxFwDgOba@wVnH_dC_NilAaEcxEd^nMfCt\\\\h`AeUvj@zP|K`PvQ|uAnJfOjJfmA`r@v}@hg@iGnDrTsQhxAdDzWbl@luAxWz`Dvk@zy@kG`|Asi@pwDm@h{@fQ|nA?bjBvTheBcE|N|EhHdQi@hCxJeYjfAaIb@uKmYcLtX`CbNkGeEkCrKI|q@|d@dUf@rOaHrKc]cT?tz@pNqXxQTpCjOotA`dEvx@qxA~NsBjFj~@pGsGrIrr@cAfVhYxUaLjqAjKdp@iCpt@rUrr@aMxDgCll@kKrIpXhZ}Gm\\\\~RuS|Aa^nNBrCyK`PvThHi@dCn}@sNpx@uNruC`DnVjRbNhLlBfPaVtQlNsVjCqNlM__AzaCsXljB`d@zuFbVjUvKjl@wF~_@s]bZri@mRbYh]x\\\\bNje@qJzAzZdMbNhIkCvEse@ja@rWcCfZ|LpCrLz`@he@hLrNyCbBjHjDfoAucAnjBbP|k@mBlLoDzHeG{B}GtZqHnEwJqGqFph@wTnKcY_S?tXdLhTbMsJlJvF?je@tHqIbBop@zBtT|Gi]zGfJlKsAiTpc@}Gjt@lYpaBbGhnBqP~KjLbj@vK}CzIhNtMcAnRxMcB|]~T~{@pa@la@}\\\\fJkAjSjVfF|GgLz\\\\rr@fCoa@`Q}Gz[~XjAf]d_@|ZgTv`@uTtCy_@h_@i^niBpIhh@nR{BdM~PlI|_A{DpV_RpEeGgL_VfHaQkNgc@okBvNsl@w[o^iGol@k{@afBy]jAgQs_AicAuTgNui@jYqGxBov@oXdo@mT[wB_oAw]_ZaOr@sXcz@yQeK}Syd@yT_Pcv@~Aym@cSmYhAnJ}tB{p@apA}m@z\\\\?sXiLcBgKtJqa@a~GtVkpEvMw]kTi}EdL|BhCsGoX{L_w@zLPoQw_@kSeElHkEq`@cv@okAwQ__Agz@gv@_FcPkX_Nuh@cx@iNyn@iMeBoDdHbQ|LjEpe@\"],[\"yn}]vcTnPyKpTxy@p\\\\nFbLfdAgu@f`C`Fds@a^wf@iOou@pGdCe@iNk^or@pW{aC\"],[\"ej_^|vQ`PeLsCdr@{Xa]vB}L\"],[\"sck^uxPfQcRnFpBFnwA_Y`e@iUsS\"],[\"_no_@xkxAxQqG_FfWsQzQk@pZiNxIaFii@\"],[\"oep_@h`oAfCoa@|_@na@rQz{@zVpOf^dkA\\\\df@sNjBwOyOjM`f@yKz\\\\qRrAeg@rk@{u@uFmQtFeT_zAnC}}@pJ_]~RcExEcSfd@uR\"],[\"qhw`@vxxBhJig@|QhMeLgCaFlMc@hl@\"],[\"id{`@f`bBpDzLd[gHtHvWb@f}@aNpu@{KrX{\\\\pGkKqG}WbZgb@jA{YqV{Jmg@{GhCpJ}|@x]u_@|]oKlGbFzN_Z`Mm@nCxShGmB\"],[\"mz_a@rfaBjFqJhH`Boe@b}@_c@~M_LtXe]vJaEkSvXwt@fm@}c@lRmE\"],[\"um_a@~wtBn\\\\iCrLvTtP~l@dBbs@pNhFrMtd@_B|Mc[~C}Ron@mZdTcF`]iJsAaZkk@}Bdp@~d@dc@z\\\\vn@gChXiTsGsCzGw`@qa@qG~S}FkLo[wHwXcfA`AeGpXlKbPaPqOtC}LkLkm@aaBnFmKfoAcLtT{]vMhD\"],[\"ewfa@pexB{Leb@tF_IlRbSpVlD|Bvz@\"],[\"k}fa@nfpBiXar@pBkLpMaCjsC|zBpP|c@r`@lIlDhg@kGjU}l@hNsSiFiUso@gNmdAzB~y@aIhHwj@ok@\"],[\"iuha@dbnBnMcHbJbn@\"],[\"u{ia@t_mB?yKxGg@pd@vOwShQ\"],[\"g~ja@t_mBgCi]|P|KbG}BzCdMeMrN\"],[\"}yla@vpkBgCoa@`QzSfBf]\"],[\"o|ma@~hwBoGx_AwKg]\"],[\"cgoa@rddCgE}n@|I}Ft^pvA|IjJbJuBwHfs@iZlN\"],[\"wfma@dbnBtTeRdCj[aLpWdG_A`U~Z}Gyk@~AcIvEdCp[faAeCrk@iJsOwG~Jxi@xwBsDvU|GztAvKzOqG|d@c[bWwN{a@hUmf@uGmJeCdL{KcfBeQcg@jMfrAiLv]}Ow[sXeRgL}gAkKaC{GjGfQddAmYh\\\\cUleBmK{\\\\iTvT{Gaj@hFiOyMeEeKcj@fFcy@lKxBnLwa@jl@{[zDehBrOoVhB}[rSmQpAiShKfB\"],[\"y~qa@~c}BjZvV|c@liAs@z_@dKrDaP~MyIs`@_f@qv@sMmj@DgV\"],[\"knxa@floC`PyK~@lVjSlTwBtdAqPeXcQzCkL_i@{O}CpGwTdLzK\"],[\"slya@fpzB~Rkm@fIxJ?uOtb@nS~Edh@ye@~eAg_@ax@\"],[\"ihya@~_`CeLaj@zG{\\\\\"],[\"yy}a@bksBqSpa@sAkc@~GmOtHoA\"],[\"ms~a@jnlClKg]`j@he@{Bd{@rOer@hb@_FlF~E?|m@?aj@hTke@hGnM{Drr@lEpRmQpVy_@fOuTm@wKrXan@ah@gn@~VwK}m@hT{v@fCvT`Pkn@|CjPya@ljAfChM`OuM\"],[\"}uab@vzlCfCtCpGcj@nGle@~Qkc@rEpeAoVxv@iZwV\"],[\"s`_b@fmuBwN|Feg@_DkIuCeEa[aPvK?}XgLzGpAiI|DwEpp@|TbRQdX_P`Lxg@uBtI\"],[\"ukbb@dqsBkHpL{SuC{E{K\"],[\"a`eb@zfjCxJeg@tOhTiT|k@rC~Zt@sJbJ~AfAqVbMiEqBe]tLfKzJddAwKvKvKra@fA}gBhEmE`PvM?j{A}SpXuCmP_PplApFxPcOdm@aj@si@?}v@nG`PbGcJg`@ys@rOvKnGkn@oGzKsOwz@rJeIfHxM\"],[\"s`_b@pe_CcJlqA{_@hUuI|X}LrE?ke@uRlNmOoR|j@ecAuJpBgCcGgSlRc@qh@eT`UcHra@mN{MaR|DnNsi@vQaJ~Waw@kBqZrQdMqS_Znc@{v@kTpGya@rg@iXqQgCfXw[rHsb@o`AxeAcaBveAyK~^nGdRrXoGme@jEkD|MzLhIaBtNoUtOda@u@ybAbRlSuDoj@lKit@~McMqT}qA~FijAl_@vHnMlPpM~w@pYlJpr@`sA~Fzk@iIxMyI}@_b@gX{Ui[}Sc{@pG~vAqa@bc@jTyKti@tg@ae@dNeGf_@pBbE`DaH~BzIrKdwAsX_YpG`j@wTjTkKkTIbf@wi@fm@eMch@tU}`AgGbGaXlr@`Bnb@qG_HgC`PgCaG_D|HaK{@vK|\\\\uTfClPpX\"],[\"qcfb@balCsNoYfDsP\"],[\"wphb@bkgD|G_j@xGdW\"],[\"y{ib@zqhCrLtT_H?oL}XVgX|NjF\"],[\"o`pb@xfeC{BqGiGdMmLm@zKke@`PcP\"],[\"{xfb@|yfClD`Ooq@nqAcLtFUqPxNaJs_@_z@{@ek@wOf]gKqq@rF{]_K|S}GuOiKtiByTj_AgLae@oG|BaPglApGflAqGkT{BlPeLmPzIf_@uVfV{UrEyR_RoGjKsCwz@_NrXqRaPlPglArBrQ``Amo@mP?_TlPcLgJcGkk@fTse@cLaPqG|SaP|G?rOkTnPgC{KqGbPca@k_AaQyaBsxAitD~FuZxp@cb@nE|N|XhMdL{\\\\zo@~|AvMFtCz\\\\`IoJzA_o@eYcjAnPnInGuChS~l@_OdgBz\\\\kTbLhCrX|gAgChTxKvz@rCyVmP{kBf]sXbGjThC}\\\\tT`l@oGfjArX{v@hXp_@mCdZrLoGpApXuWd_@~XqEqG~X}m@f]oXgZsGcm@?d{@|IvAdVpk@|m@gw@tCpLbGmPnPgCqJvmApJrJvF_YvUyLpBx]dGsCyK`j@xKoGlPf]fC{G\"],[\"_f|b@rtx@zCij@`OhUnLkBrBtF~QqHfJ`QuO|\\\\cHkGkXxXuCi]?vi@\"],[\"ch{b@fm~@}G}gAhKjNxCjxAqm@tp@yK?yE`a@qMsIsKyh@dSq_ArYa`@nGzGlKuT\"],[\"yw~b@xgt@lFqKrOUpL`p@fQfWsMj_Aoa@~`@fKrYq@|d@|G{GbGx\\\\C~s@{ThU{`@mR}SxK`DdJ~J_B?vTrSwJlPfSgCbTyc@dZ}UeMg[zBkQoOkKewAnMee@oAeVlKaUfCdLnPkn@dCpGhCqGjEti@fRpPnGnXhCkHfCsb@gOy\\\\lJoVsFyXtKqVoCsIdLjCgGo`AfIXtMxa@dNwYs@cKoPzK\"],[\"kuac@vsp@~IFvDgPxKpr@eTtItBjVzHs@iA`VgWui@_Px\\\\{Bu`@bGzBzBg]fAtMfJyDsCe]\"],[\"_zac@~ty@gHju@cTzPaL{[|GwjAjKbNnQg@\"],[\"_ecc@xgv@?qZo^d@aBwOzGtCpGqXlRzS`Qk@zHgN|@ba@iMxFwCxP\"],[\"caec@~wn@gOcU|SqJ~Bph@l]za@qL\\\\lPxd@wKsC?vTt_@fv@aO{Ag\\\\wx@wGyA{GxKqG}v@bUda@zBoG\"],[\"{qec@vpz@}GlP?aj@wO{Y`FwJzD|InGeAfCbPnIux@x^{g@pLpa@mP_HqSvn@c@`PpL}G?z\\\\\"],[\"stic@`g_@wOiOsAiY|FrNpHcFdJn]\"],[\"m{~c@jiNtCkOjKl@dFhNeFht@xK}G_A`Pkc@pa@?}gAvKcG\"],[\"i_{c@rlToPeNyB}Vxh@tw@bEwC_Mw]~Uh@lFr~@rOq{@|Qbf@lHgA|@yZxMhc@yM|Z`PsChCjTxBgXnPxKyK_Yf]tTeImVbB}E|ItGxKiTxKhn@zHaEtBrMbGoP`FtIhG{D~DsPtLxK[jTjYwO|EjD}Ex[`Yi@dOjQdM_FtN~ExHoGrFnGyKvKvD`IsMaEiKrm@aVuKmBmSuOtO?uOcJpBwiAih@}OdBoGfLqQeK`BzYkg@}ZU~JhYnYaXvPcHj`@z\\\\ke@|GhC}G|XvR`C}ExGnT|U}U|k@eNae@kOhVlRjL{IlMxFxn@_Mb[m[xKoOe_@pJmw@oGrCfCwTqGrC{@k\\\\rVkHuCwKwKnGeK_V`Ji]zLmEwKmP_HlPsOyKsC}r@eKfo@mLtBhHtQqDnLySsEuCba@me@jCvThTgFzJeI{Jh@xoA_QoM?_R}Z_Q|\\\\}k@_Aek@aPxz@mPaPeGaYsC|GyKeCmAk_@hJc_@_PhDsLcMdZyDjFjIjMqCvRtT?{KrCzKhC{K|SzKiCuXvTpGpa@le@uCo\\\\gJXmEaPsBsf@aPje@gGkMbKgFuSyYzHoR|E|B~CeMdSkJOjT`MxT{@sa@tMeLkYqX\"],[\"ao`d@~gRnLdEbK{IdLrGaP~i@z\\\\g]?pa@jVic@xLbFr@bKf^iJoF`o@dMiAaIpb@ugAhOiLkD_AyStQ_DrAuO}ZvKia@eE{KsLtIiMaGin@~OrC\"],[\"of`d@ltP{Ble@wT?SkMmOxDyIoOaR|FuGsTkReMbLyNlT`SsG_UwZkR`Hm^tK}ExB`PhCaPlLz\\\\dKgCJhKpGiKrOd]vSqP\"],[\"uhn]v_zB|FcHfGdGoAlF\"],[\"ywn]nuzBfJUcMfI\"],[\"wtf^zbzAtMdBsStB\"],[\"u|cb@nkjGCPM?KQ?c@JQD?JP\"],[\"qutc@|tXlCmEzY~PmSRtRxSm]uQuHxCaCuQ\"],[\"e|uc@fbg@lOeB~EhHoKx]\"],[\"oavc@hwSoBwFfLj@~YnReJ|ViSy@mMvH_Esa@\"],[\"klzc@f}Pt[vb@@hc@_QiP\"]]},\n" +
+	"{\"countryCode\":\"GD\",\"polygons\":[[\"_qmF`krd@jq@cC`w@xNtJb_@bMlFcGtTrC`e@mPsXe_@hCip@wOkj@ic@KkZhGqL\"],[\"egrFnwnd@|Gri@_YqC~BoLy`@qW\"]]},\n" +
+	"{\"countryCode\":\"GE\",\"polygons\":[[\"ag{XopjXiqCz^ul@vYkOtS_f@h{BdC~m@{^l[me@hMer@tc@pCxv@gq@ro@oRdkBlD|w@aYvk@{TdkA_AdVjNhOen@th@wRwAkk@p`@a_@rqAuJ`~@kzAwl@cPmVgGgi@?_j@bk@arDcTuqAhZev@xWgsAbq@otAyAisAd\\\\qqA_@u~@dZgStRcy@xZsk@zDstExNqlAa[wwAqEwhBjCui@nx@ewBaQoc@y@s`Bh\\\\ct@nMit@hb@Vj}@}`Bdr@caFpNaj@za@yn@xScuAfQ_Fhf@xt@lQ|@jOyPdK}rAzO}`@mf@cgAsG}wAyl@sm@[mv@a\\\\c{AVay@zN_p@x[sb@ni@eVu|Aut@~BgZdg@gnAmGgy@rDgSxaAqnAdb@_XhEyS}LalAlFkwAfMwo@pUqXjyCzhApMgEpt@soBlGsj@lp@kh@sEw\\\\fGqf@~TmXgDemAha@uj@vMgeAjNeNnlAb_AvH`R}A|v@rH`K`t@rDb[_GpIyOcMkTjkAod@njAqlDl_Ao_A`DrKzRdBtLrNjy@rMzK`K{@lc@r_@d\\\\LtL{\\\\|e@_Ndq@up@x_AyAfy@pTry@y@fl@iXx{Ayc@jdAyPzMoWkc@_d@dpCgUj\\\\hCni@oQ|fAzuB`kEsE`sAfHbKhPs[dFsEnEdEF`kBcHve@x[je@sQxTlOpe@mSvcA|Lld@kWl`Aj_@va@yBrf@xM|`A\\\\zx@v^bcA}HftBbKpgAe_@`GkR|`@aB|d@dKnx@qj@rc@aC`j@aLy`@iQwOuGpd@sj@bkA}`@vZsS|i@{QbLhPvV{Rtf@eWeXiQeGiCzJFxeCxPzQnb@dKpe@fl@q^j|BJvl@kJhRfHvuAyKvk@pKv`@xf@rq@yRn_@aEz`@cOrAlEbf@eUniA_iAiz@oQud@sbBe|@orBjJgbAfx@k|@hHwtAb`@\"]]},\n" +
+	"{\"countryCode\":\"GF\",\"polygons\":[[\"sa|@~xa`@wl@sTecAg{@oi@iNqVuUgyBcRaRdQjCbSk_Bbn@gOte@{lC`gBgw@_K{KlA_Odb@eRxHge@iIsaApWkbAzIajAaPu`AnFs[~Yk{A~CaTmUwu@k@qqAs`Akj@eSskAuvAoSeg@qa@o[akAu`@m_AqHas@u\\\\rQatAqI`NJyLjbB}cEdTagCxYs\\\\kC{LiFAyT`r@eFgJbv@cuBbH}u@l_@i|@nv@yeA|]}Q|yBwlCtIJvGk\\\\bh@ko@bV}LpXrDea@yg@bK{ZdWyK`qAvs@zUq@ov@eXwe@ui@`OkWx`@{Sx~@ksAvXsP|zBm[n[zEvm@xs@eViz@gLiHoa@qGqxAhDyg@yLuDwZxf@yk@bFxCpVaPjm@uH|K{OxKpGvM}BlPe]d_@tOvxAih@voAlLjLzEpc@n}@zeAr_@ty@p_AvWZjQd]lt@fN`_Aje@`EbK|NOhiClsA~If_@pg@|WfOlUnt@iApuAbk@zQaGdSv[ldCxu@bJnSl\\\\}Mdr@`WhqAr}@v|@pjB`p@`a@~Lxf@cGdq@sSr_@vM|_BeNhAkRwVs|@tcAfYnTj]fs@nFpj@me@nbCkX_BgKbPhHrUhZtQfBlUfXnTeBxRv\\\\fG|FbJdR`g@eBl]jj@tTA|Um]~[hHhj@eCj^_Yld@kOfiAu[d`@wE`AaAeM}IdJiOjk@wLaR`Dg`@ao@{VwJuj@}\\\\}\\\\kxBmw@mlB}fAcNdLep@uPcYxLaG}Hg]lLqGqGkZ@_HxE\"]]},\n" +
+	"{\"countryCode\":\"GG\",\"polygons\":[[\"ote]rkq@uLwe@nDiIjn@dXs@dqA{QoM\"],[\"gwd]jdm@zJ{DlHxM\"],[\"cij]`ni@~KjGv@|V\"],[\"eme]p|n@i@uBzDs@xFbDoATqDJ\"]]},\n" +
+	"{\"countryCode\":\"GH\",\"polygons\":[[\"knxExfBvYwZ_C{v@nUi]hp@f@xQzPvvA`Jrb@t^vq@hH|X_Ez^mhAzXmP|]}i@z{@u`@aIwIhAqn@~WoBx]gWrKnDsCcc@hLwQhJjNd[fHh`AfArf@yZfKrAhApZfw@kKzTlPzg@k@po@lL|{@mNbHvPcVbZvP|Gj^kIiC{k@jAkK|KiDlJlMoFbSdFbJqAnm@jZtAfNon@|JjI~Gvb@`YXhG{A_@eV{Li`@}UoF}Fuo@zL{k@hYLvOk]r_A~AlW`Trk@kFh^tXlcAdSxcBmm@nl@`Sl@t`@`SjF{O`MjFbK|]iDthAej@vu@abA`R}g@rm@_RvR_W`_@qAlDgKjNoAxy@zuAf`@eKhOlJvhGiVaB`Qnx@hLxO``@tq@nGhy@eLzQa^uIkb@lm@eM|eB~\\\\xnAEnT|JxMts@lc@g_@rl@xQlu@yd@xOw]z_A_CzVoU~G_d@fc@xHhl@gTpe@wz@n]m[rBm{@|~@aYje@si@zDwr@z^wDl`@bu@|`@v^nh@tUju@fIb]hXhUpoCiOb_FxN~jAvRtt@j\\\\`r@v]vXn\\\\l_Ahv@j|Dt~@n}@`g@taB{GdDxmAbdBvElm@wGnVhKtg@qJxKhf@baBf`@tp@xBnt@jn@l{CgAr]~\\\\tHd\\\\~s@p^rQdb@deBxi@hj@tG~fAeVbFqEr\\\\mf@~q@uQzHiXz_AwTvhB{a@zeB}TrjDw[r_BuL{KWyr@xRmc@mFuRcPt@gFkPcPcsAyJ_EeLrLcWmAk[fLue@aCjAk\\\\yQyFyqCpf@}Pps@xPvi@eGvMeOjBaf@iElBrg@{|AqC{wAxg@cjCf_@{ZrZw{Ehp@y|@q_@wc@lSqU[erCqqAoNyf@_i@oJ{n@ea@ya@`Jq~Ds]ghCq_AsMb@wQgWey@sEzFcKkp@eq@}Qeq@_RwEyg@xLgQ_Fw@{_@_Yk_@c}Ipx@iKvJiWoLuz@zM}ClJcs@lMaXffAav@gKan@o^iIyRwViG{Vna@if@sYa|@`Ao`Arw@o]_B}NiLgj@la@}`@yKi]qXy\\\\rOe{@yB_l@hTwpAnE_x@oZg[|o@iV`DuZ{J_Tea@wCxZ_Vt\\\\mhBpl@oc@cPyPvImxAic@gYwYix@|H\\\\{t@tEGaF_oUmQuUlA}mAfOoq@n@yyCgKiVvEcuAnMcWpGdCwWol@|Le_BtQpDn[_]om@sZqCoo@}Web@}k@uR?aKtUcVsUi^{OyKqTbA\"]]},\n" +
+	"{\"countryCode\":\"GI\",\"polygons\":[[\"e{`U~sgB?gKbR~@\"]]},\n" +
+	"{\"countryCode\":\"GL\",\"polygons\":[[\"ilvf@jj}WbRyLeTb~B}RvNvDdSlJbG|McCzFcZd`@ik@pGfC`N_RpI~QmLzgA|AnKnMiApn@kx@jKcjBnPtcAxKybAxKoDlYwt@xFct@zGeJ~OzApDku@hPcEnCvHnrAw]|\\\\fm@d^cqCfNuSbTeEzLlh@}K|`@rPha@kEjKkNcGkE~g@eJnI~GrZyEbrAla@lpA`M~zAkJf`DqGqGeCnRvMhjBld@rYrJalAhT`DzTsg@hQszD`j@m_AiQkd@yKrXwTo\\\\hCw_CnNmUlO_gA`]wHh\\\\j_AlS}[fGbZqP|u@hH~FzBcPvKzkA`Ksq@wFyr@dLab@vKNxKmPjIpGnRo\\\\tYrb@pBqQdGnGxM{MjEsc@pZfCbFhhAyKgLuB`b@fExLzMq@yNhzDc\\\\jwAsSleC{l@lp@wAba@xVbGjPliAjIbCzMoZoGm_@rA}v@xj@kjCfUyrExe@sV?d]pH_JnDi]eJex@~O`PzMqIlLpCnI~^mRl^fEbNpGcGGnNuStb@`PrCi_@xe@pIhC`PoP{Bf}AfH_HpEaq@fUi^~H|Iz@nb@sGdYsr@l}@cL|k@dR?tOer@na@mRrXklBzLjpAaB|\\\\}ZjNonAlcCe@fJleB{vB}`@r~Akl@h{@r[fDcD~s@ji@sjCvp@q{BlVqZeTxq@sTlmB_[tQoElnAsjAprBxAtZdLmGbJ|MxWlpArNw@pZaYt\\\\yjB`OzHiHbcAbPqa@nGfCoG}gA|My_@dRaMdM_q@nFwHfa@tJp[yc@vP`kAm\\\\ti@gk@`yB}Vx}BtRk_@j|@wtDfw@_yAsCnpA_P`p@dC|MrL{a@aDznAg]f]iCb[|G~WdG}SVjUmTz\\\\{h@~^}Qzj@?rTf\\\\wXpRg@hJ`k@dLelApYon@zJP`Cs~CzOyd@]ai@hZwGrMnOlI|q@oOj^rOnKbPoPfCle@rSwk@vGby@{JnvAoP`PlAnEtRmG|ZnEsKp{DgKjT_KsAmPev@}B`_Ag]_s@mCrNjRjv@]|VhVsAbIlSsDftAnHfBfHsO|F_cBb^zi@mZ`c@{@nVxnA{x@ui@fgApR`DdMk]nPnv@kUwEsc@va@`KhRl\\\\wTfKdIgSlkE_U~j@Pl[hTqX~GbLpEiG`O_zClT_vArNqA`P{\\\\aP?_H{g@pc@snC`h@ee@xRfNxDbVoGh]ef@vkAdf@{m@aBjo@ph@cqBkCmJoGfCsAkMrAiu@nGrCxJiIvLnQsCscArOfw@cG`t@iTrnAwKvKrCje@cUle@uCrj@iFfK}GuCaFvXvIta@oOvi@gFbhA~[tB?ui@n[y|AxZa_Aj_@{^_GoyBlSas@f@gi@j\\\\fh@bLgd@|J}D`Z~LhTt]bPcPdKhEbHj}@pGoPlNxWfE|{@`Pm_Aje@npApD{_@eo@_gBoAkpBrIiR`u@bx@Gn`CxMml@tC}gAjK`P?me@jTbPhn@dlAdL~xC}r@rnBpNjl@xOhQuIq~@~r@elBlA}kBwPysAtIuQvPzuAtLVxF_e@|M_DrC~]{J|o@`Pin@iCzv@da@oRzGdnBsIz}CuU~pA`Bps@nSihAzRoT_Gyo@lHaf@mBmcDh]bLp]kV}Cmt@lPeLnGnG{Cd]xUdXtFcCzDtx@|E`F`CuMtGrQhCdr@xKke@`@bo@m[v{A}G~{ElEbWlRgaF|QkdA~HjcAhJ`La@scA_Ls\\\\pDcw@nGfCjPql@|KiI?daBnGnPgSf|AjFv\\\\eCliCrUq`EfRebAoB{a@h]in@oM|`DpKpUgPhxC~DbqAwNtdClJhz@nFayBjI__@qDk|BlHeIgCwZlKogA`P`PmKi}@lKqx@iIc`@lEyf@jKxe@rCsr@|HqI`JlM?}SlIpWsWfn@|EdTrRyKvNlH|M}m@n]~zDch@dzFrBv_@fRyAvQsuE~JaRpGfLuEyf@fG{lBvMsUu@gf@hKjHpFhjAuTz}BoGb{FbQuoCbSu\\\\_Jm]u@adApGwgAd_@c}Bx^pg@vSb{@cOduEvKiTxT}pEsL`Kc[ujAi@gb@lWtk@zWvCz@xeEdIkVfFulDxFhJ}Bfg@bPvg@?vgBzI}IfAq|@dJ`I^rg@k\\\\vw@hEpZ}Glb@dFrCrKuSz\\\\{pAlCzr@cm@`eE}cAhkA}i@fMrDbLnY{CfeA_r@aTt`Hgc@tt@hD`MpVqSlAfX~NuUfAkuApSulBje@ea@bP~XzCeSsQmMcOrGqNeOk@uZnYayDlUsbAtGdA`WrxAvF~K|GaPvJbq@dP|_@pH_@i]kuAdLwz@wYjYaHwSqDkvBdFvV`M}e@|E~I{K|tBnPuV{CaVdYchAoGyPnG_TxO~P|Cj\\\\jK{v@nHzDoHlnA`Yx_Aj@gkBjJcSzBpXhCoa@bLxKhCyKpCtOoLlj@rM{FbFbKzBc{@pQcQdJ`n@mOxkCePn|@}@r~@s_@bUOlJdVfJsKzmDgIj^`JplBwMjCmO{Hes@odBqGjd@e_@tFwHfTbBfErXmPnc@rHvR`e@}d@f`@sLze@d_@oZj^_CyB|x@x\\\\qa@rXti@nA|nAtLtM`t@jT`^tm@VjTiPlZkx@s@rSn[|h@x@bEnZ}JhZk|@ds@wTqXvAp`@pXrPlf@sq@tLQiInMmA|aAwOeL}GdLhCmP{R|l@`FtWsD|a@bM_OlEfKuIjf@y\\\\__AiFhKkDuDk]ujE{IpIdIxa@qFzDcP_LiQkm@}`@iK{[qy@sc@sV{Iqe@}GnGsI}XmZyT}X?`j@|c@|In_@lw@tq@nOfk@lh@ni@dKre@p`@bK~Ntk@l@vrAnf@`w@kDbT}Xg]`Dx\\\\cVgKij@iz@g[wfBmVjGgl@w\\\\sXy\\\\rC|bAscAwiBqXeLyT|Eyq@{zApGdw@bQbOlEle@d|@ta@|z@|wAh_@rdCeOxi@eGkTgItJqEmCag@mb@uy@wvA~XrcAqF`^bE|IhDiOpXzGoIdVxXpNzb@l_Ccb@}{@kd@uOoOq[eYmbByX}_@mC~CrXjx@rChy@o]~GFlQfItMbd@{@xKjs@xe@|LgLrEjA|KfVdChn@ri@m^vQgJaMpD~r@cU{o@aMEeGtUhNeC~Evg@wLlXke@uaAdNlcB~PnNd[sCiH|Zt\\\\~Su_@vPaE`l@elAq{FwC`RxTb_AeLb{A{B}m@wTke@kP_yAuIp^cLem@wU_[sD}^}\\\\_r@gE_kAiQxXfCti@{IeRkSaA{Qng@`FfNnYgOh\\\\|y@vK[rIzLb_Ax~BvG|cBoIlNca@qxAmLx[\\\\ji@vN|cAnWvPn^lCzE|ZsNfyAaHjHqJeB{Is_BiYe^kb@qbB`PpaAqHbUnf@l|@vDt_A_KyKm}@ssDk_@oiFei@ip@oa@yjB}ZbAiZ{c@aJpElKvTn{@pgAej@dq@wTuC{]bn@dX_EbUdJlf@eVrv@`sAxe@`jBqGd_@|b@tvBqMlV?iTyKna@sNVk^iyAmUcwCaKoN_Eb{@yM{l@iLzRm@igAqHbFaE~f@hMxo@aI|ImIyGiFjEmR~t@kg@}m@sX_s@?l_AdLz\\\\fm@|l@gS~dAbGle@hRolAnSeb@`B{j@~RsNhMxPsCxv@jYqXfXtiA\\\\hdAoHzQsH}BqB`GGxq@pH|TzBui@tZ{dAnNnXfKxo@gEhLoSqBuNvJDp\\\\wSvWgD~a@lXgRlCzVaKpUdG~AhTe]dGzZkOls@lKxU|NcTlPAgXvu@hCjLhn@}m@uTh]|XtNy\\\\b|@pXi]_Pb{@?zv@p^cmB|Q`Edb@|wA?hp@wTea@fHfx@ue@yqAnGpgAhTbd@eC`MkK`GmJuFa_@mpAoPnQpGhsAkKkn@mE`PnV~xA|X|m@}AvJ}d@jStRnk@aFjx@zP`s@bEg^tE`RyJlqAfG`j@cBxH}GsCf]vkAsCutAlPpXmPqeA?{iAmKo\\\\hEwkA~MeUjT~eA`CvnB_LzcE{BhImPyTgCfPz_@l{@|DvxBwAdEwJsEmN{f@g]i{AdLmRaBkJ{\\\\ep@_MyuAeL{IqG{\\\\cFlPbFzbBkGxSbPnb@yCxb@lP|Z}GlRfLje@qG{GeI|d@~CxxBmLsRsJewAzGscAiTa_AnGwrA{Dc[iW|KdLba@oPfLgTkp@aSgLaVeg@~Vh{@|PxNlU|wBsOjTia@{j@yGd@b_@tt@u`@hp@aNaI}Pez@oGrCgCoGyK`PiTm_A}GucAuFl\\\\zSlbB}G|OtDvJ|]dIhOdU|AfWda@tTtTin@nGjn@aBngAmDbPyKrCyKwtAsCnPeGoPkMsB`Pzc@r@lHqGgC~SzgAqGgC{Bti@aY}SnPje@wT}GaPke@hDv^x_@xk@w@xKaNRwXed@aLupBaGaKbGzpAm\\\\oNgE{c@|Fkm@}FqKaJpCmBp_C{CpOqGgChCfcAcX_TcDfGdg@xdBb@tYkNkC[rWrYjp@yCvJgTs^H|mAoa@y_C?yeAya@aaD_JgnCuHsPbG~xCwRiqAqRmHqFhItg@zrBpt@p}G?fPgLgTyFfMuAbbA}OkEqIsc@?xpAcLzkAgLkn@_KrX{BeMxKytDs]w~EmGbl@lLdl@`KbyCoIe[qEcBkChLzKz_A_n@tCoU}gAbDaj@uWbNsP_Rtg@lcBr@~eAmX_\\\\o[hKoIwFiLqaAiKbHmIcl@{ZyKqJsTqDqvAwK?oGlTzH`dB|iAbiChYbR|e@wHpPzV[hZsXzv@ucAglAsOw`@Ina@tKvb@jM~@h[la@{Bdr@gCmGeKrKoFcCsIo\\\\gC`PqXeL}B_e@sCdr@qV{iACuyAio@o}AhB{w@}Sye@sChnBeXm]iCdHba@b{@uKdIsW_M{f@_k@sCpPdRti@hn@n_@fc@}@nO~K?lo@hThcAgBzp@}Gqr@aP~i@kTuT|Gb`@nVzWdQrcAg@j]yOja@eJgNrCgqAkRrq@mHcrA}HaMvFtvAuJnNoAlh@aJZ}Cuz@`ZifDcUvz@uKgrAuXaArChn@sXeLzDph@vT|IlIvZgC~`CqLkl@c\\\\iTgNyaAtKq}@aVio@|GvnDsW{RcHpIv`@za@}Gfr@dDzaAxJ~RyB~_@}GkTgClKeAmf@eIaOaFlAaFpIlMhXuAf[em@~I`^pa@sBd^wE`GuEwHyK`Po\\\\|dBw_@x[kZ~k@ghA{v@c`@oIiRu|@a|@yp@sZguAkCzNsXu]zOnt@`x@~lByDfNsK{E?d]pOqNlHfC~d@hy@sRhOi@pLg_@__@qe@kNi`@mr@iSyJmk@}uEo_@a{@{@z~@tu@zhCfJbaB`q@ps@fP|C~D`Sh]pYNlXaK{UwJz@}Nj[yn@{m@}f@o|@{Ae`@cKuO}MnaAng@|MpQh_A_@tu@{PbTbJxKyJxW^v^wTykA_Y`T`DjPxKeGqGpm@yKaPuTbL_@}k@cOmR{Jso@kLlAqC`KmJa^cX_tEiE~uDl[vn@lEnd@yCbQ}GeGgNhv@hNzOmFlc@rHv_A|c@npAwFtJ_RcF}h@kwArGnp@xi@baAxBjVqKxa@}MuCmUc[~BeSyF}Ho@xhAuTaj@Kdh@{DpKgRlE}KyGcMqrJcOgX{BmkBoLblAvSz}AfIfkCyLdNxL`n@tAfjBkFtVkUu]o`@{Sl@lYv^nPjZnd@eBnUu`@oS}F|NzJ~YeIdIu^_{@`EeYgJ{[cMywCuPs^rMbyEwf@z_@iJWsVcv@lPawAdGeEkTqnEdTcDaIyRe[cQrXxrFeTnpBbUbcAngAka@l@d[uVp_AwBtx@rAbH`ImAgCpXgLiTcGtCoa@{v@pApj@}g@zdBkT{vBiClr@|OvkAkNlAaTiP_IkRaUsrDwD~KyF_F}o@ycDiMyS`\\\\zxCrf@ppAvEfbBuNbKgHiGfHaf@e\\\\`Aa@cRmKvTmJ{@qIhUcEqBoAag@cGaFqGhn@pGti@uXr}AuPwn@r@iuAxOmfA}CgLkTdr@uTgfDhHev@qMa^\\\\m~@{o@{mChVmgCoQw^j@cc@qXkgAdGi{@bf@yKjIoPpItCqEsJad@jMkSyRwKnv@_HmK}Eh]nh@rrFuT}J_n@qdAiJwAaZws@yDbRdIvd@laAtjBtI~vAxXbo@zz@~eK}G~Q?b{@}Spr@wT_j@iCkc@bPogBa[z_A_F~j@j]hxAiEzRsM{B?fi@kM_TcEej@Wc{CnSge@fDot@oQ}pAaSmg@?oc@xKmc@sXiaALz~AsLf[sXer@yYsAu[wk@wImlAtRwYbGgwAuO~`A}XrEl\\\\wnBfHgmBgHU{Byx@wKkAiIci@aJpO}G}Br@zP|_@zhBcYtnCuKzQcWieB}Q_]|SzzBwAtQoLnJ_Yye@hEr{@aMvd@rKfy@_LjVg]icAvKc{BsUy|@\\\\uc@mKtcCaPupBxPaxAlQef@~w@y]bo@}n@fuAirGdGmb@sCgh@{\\\\n{@uRDcIxqAoWfj@o_@jnBqbA|b@{N_KmJay@iK}EiFpr@nG`n@sOze@cUoPaI{^oUeDm^yqAoDfIpZnpBlq@r}@kMn_@zD`TmGnnB{z@le@aVxe@s[jCiK|OcS}LiKnq@{g@tMsX|X}m@s}A?z\\\\ba@z|Ami@|v@fk@yJ|S_XhBcRjKna@`Lce@tt@rBiKkmAtDaW~JvGpQyIzFrIlPiThT~i@rJwaA~QiE~Rv`@fDi\\\\zEeNzEtAdFt^aM|GMfPtt@hlB`Kz`BnGrDjA}b@xGcD~ItsAwC~k@ku@c^o\\\\il@y@mj@{B`PqGqG{E`{@~RnhAnPdXbq@jXtA|ZfVtZ~g@tFvSnMhm@biAvVpMxQpcA`GcT~P|EzJcNrHjd@|Zf`@xMbd@j@j~@bA|G|DoFrJdsAa\\\\zQfIzFOlOiTxJea@wWaIbB_CbU_c@k_@}_@nj@wP`H~B}s@sQeh@{Fh@`Bdl@aOgEeDf\\\\cd@orAsGtAdWps@aCrRiQtMoNcAlLii@_Hm]mK|o@yPzEeBrLgT{MyOsm@l@mp@{]qZToWwDyB?lKwQoTmSwvB}S}m@bP{VkB}Viu@cfA{g@_kBiFvObSrp@gCpk@~CxEdGc[bH|i@pd@zmA~KfjA{I{PkJnE}[yUe@gk@_K{N{RlvA|^zZhH}BtGhe@bTj]d@aYb\\\\}XxBjYcG}GyKh_@vHpSdSu@`Pll@xIdfBqX_fAuV{\\\\eLzo@wN~TdFdIrRwQvRfUh\\\\d`AcKlXgYoi@kKpd@wPZgV__BqRm_@{Bzv@oTeKjIfp@oMxQqLoPmQfgAuNqBTehAcL{`@gB|m@{]`PcuAo{BpFp_@zq@|hAbM~}@oI~S_Ymu@iO}M}EzJuPaMsB~U|Xbv@qNbJ}QoEvC`Mxb@bQng@vg@lLpw@yr@qw@gCjKqGqGrCxe@kTba@{n@wrB{Ou|AoWqp@iT}qBwYc_AuTwNyD}PkAsz@wK`u@v@lp@iIxKmq@geCoU}yBwK}aFjD_b@_Hu[`Eau@nm@s{BiEmaCwKzKdHlhCoLtKqGopAgC`P}GgCiBht@fKpl@_Yx~@nDn}@eLvaCzL~tBbdAzgHsEhn@ji@ic@jPx@bCgKtG|GpTlf@|M~z@_A`w@~Xja@hLd`B~[~}@gXna@ot@}~@b@pUnZvr@c@xV}HbGkJo\\\\yK`Y{z@ynAc]iErk@fn@l}@h}A}S|y@wIvDsNyGsXelAfLttAqHyNai@{mCoCnO`]v{BeTqIuX{eAiCf]{SkFo`@bHdz@ng@`Xbh@yKf_@vOlmAoKxsC}Xew@_IaqAqc@eeBcCpMje@ppCtCloAkFnMcg@}vC?bj@a]sa@gP}xAxFqb@qBkJoGlKcHwKoOwiAfXonAfN{mClVkWkMuLcJid@{IfZyJPgLoOsi@m`D`Dkw@pg@a{AoD}Pqr@x|Aw`@mpAxt@xjHzs@zx@}AhoCi]pd@gU{m@wGtb@v\\\\ruAoTlu@~\\\\yLx^xsBpUxdCaFv^qJcGDsn@}QblA}~@msFgHx[x{@hrEoGgCe@p\\\\|L~c@aGnh@cUf\\\\uOqr@xByx@wKiuBoDn^vHt`ByKyKhGfrAiGlVuYqr@my@{oFow@y_Dye@mWogAasE{\\\\mnFcGmGoFvd@aMDoaA_}Aoe@iaDsA_iBeGsHcLjz@}`@{aBm]cs@{Be~@lGer@sOf]gIiSp^ojCcLqsCvIwu@zAk~ByHgRcGzcD{I~q@jN`b@Mb[{`@|oCwQw\\\\wl@ynDrGymFhPihByGesAeEv}BgLnw@tFruBeHd_BoPsm@aPyjDeDpTxUxjDbWf|ArEj~Aze@z{Bv@hp@bp@fjCbJ|z@jbAphCne@x_DhyArhBtUfcBzqAzdGl}@vfBn]jjA|u@lxEbDv{@wKp{BkTyeBlK|mBa`@wp@}La@cKs_@aJu}BcWe_C?hwB`Zr{CkBbe@wMjRmPyKoJxFuBzWsR`Cm`@}VG}W}Ym^sJqi@fCdi@`^bo@qYWaLu`@kKf]}CmGqSk~ChK}`B?~xAbQm_Be_@_{CkBijAlPohFmPuX?d{B_G~uBuGjH?bhBtOjrAi@h`A_KvqBaGdLkGev@}KsHrC|aB{Gca@eGtOmd@a{BvDsdB`IsYmTasDzGq|@{Ggu@{GdIyK{D{An|BpWvyBqGf}A_P`j@mE_rFsYegBiSxR|MdeAoGzi@v^xxC_Rw`@}GvTwV_u@pJmmAkLa@bAqpFvWuwByCuX}L}LlB`{@kW~sAeLajByBpGgLqGtCx_CcImEqEhIcUea@l@`W~h@nfAjKzyBwKrr@?rrB}G}BeBtTvKnwI{ChVyKiTqI~jAePjb@mEy{FdGmcAeGq{@wKduEpJnm@aRtoBaV`HsGqw@rC{|AkYivBpLp`BdGz_GmKwOgCvO}Gme@{BxK}GiT{BhTsCme@qMqLgRg}G{Gj_CuKsh@bEi~@yMgwEwKwi@zBgn@ea@syEpGkr@yQkq@qRylCpQqwA~EcuHpPgx@`d@a_@b{@_rA`Mc~@qJskBsWskBoEibBvJe|C{Fqk@oFfJbA`i@gSzx@fQh}Dbd@lpDwBfj@u~@`rBes@rl@iKnr@uKeJgHktCqX{|Ath@vwO}[zlBvmAvyQaIxhA{\\\\ke@NhJvf@t`B~BreBrh@d`DErXeLwTcC~KCvr@aGaU_H|X}QuNyOnHiFuVsJdMsIcDcO_b@qGlPoa@ihAui@yeEmSmm@oPgsBwCeNaD~T|Lzj@eCdSo{@}lEjPvzBma@uoAqG}aBxK}m@_F_{AkMug@vEbiCwEbm@cDyV}JylF`j@onD?sXqL{BP{Jx^kkBhNJbEwNaMy~GeDwLqGhTPii@jt@_mE\\\\ib@bk@gbBlPwcAbGkrAqGmn@`PorDoG|BoFeQgMi~@dL~aCeLlaApB`eBeU|vB{c@vpAkZaiDqe@caDuPie@~Mm}BxKxKnD_qAmHmScX`iBaBvoBhw@`iFxQlzEkTscA?f}Bma@dfC{KcNbHud@E{oCeLcTgC~GmNs_C{^wtAr@mjCdJ}t@qEsZaRgMm@v{AaF`Z}LgDwJ~ZhChNnGuObC|SgWlaDzNv]fCwKzKnm@`DaWoNoz@`Ny`AvEb`@|HhDzB`rA|GnN?uXrFnRsFr|@tTf]fCleByK~i@cLke@wLzl@uVtNyLhy@wLiuD~Pcc@{CqYyOnH{BeLyKjn@gMkBoFfK{BeLfFb_Cd^jjAmPhnAnGfr@b[qt@|M{InD_m@bSmk@la@nl@zKoI|KbfAmCbVqM{e@|AvnAj`@daAaC||@oH~I{ByKiCf]kOqg@PytAcIaIgGn~@fDpm@jPz_@wHfhFy_@|cAiTo\\\\kU_tANsKhFzIgC{pCoZgbBiCqsAwRqa@kDvS`j@|tE|BhsDvUnjBbY|b@pQlbBs@vzB~d@duCjvAbfDbo@bxCdIx~@uBhFqFkH}Iz`@_OuJsDxOaJgB{FlKi^gwBrCttA}XsxAgCrGaOmOoSnPeEuHiT||AqHiIe\\\\s`CxFcKpN^zEzYiC}~@xKx\\\\yDakBsJiS}@rwA{IjZyKqa@qGtcAyKoaAhIaq@xEiBrHrRfHyElAopAwK`_AmSetAhBi{@}Ntk@tJtrAeLpa@yKglAuO~m@kA}HmIq`FeLfcAnGpT?jlCgT?mMqr@sIssAd{@_bErBs{@dJ{YmEe^rOsnEoJgbAyQcXoBbOdRfeAwUb{IcvAh~F_Tgr@wJbRd]vjByGtFiM}Q|GzvBaTs|AqFwwBru@uqFzHyxBnOkt@kDwb@uNxkAcH|JmDsJ{C`O`Bj}@sIe@qGri@qGoGuBfMjGtq@gEzAqJiMiCe_@|GsiB~h@ssDpPah@dF}}AxSogAaNo}Lzx@u}ExK_{AnZuy@tCe`@yF{BuH_s@tLwwC_D}`@eP{QnGf}AeUnbDmBpFaPaP?hnBeNaZcO~yCcWlhAuTucA`K~eBsMtKk@|\\\\aPre@vFn\\\\w`@hbBlFqkHcS~p@jAdaAiSlj@oBliBkWglCkb@wTeLnP?yKiFt\\\\wQoDkUekCwW}t@nCsQpGtXcBk^qLm[iFnn@`x@vnFbE~zBcN|uAlI|p@zIav@rUmo@sBiiB|Uuq@pHaF|D`NrCqLzLnhGzQ~oA~MvwG|Kda@jJdeB_Gld@kT_e@mUthAkOa^hGxoD~TggBrOvt@wKtiArCf]sBtImLc[gC|r@iPy]ePgtAzCct@}CmNoGrCa@o\\\\kJgHdHx{BbKpZrQjvCuLpzAlVriAqNtUsCqXaPba@kLowAzTsbBo`@mnBsCadAeCiK{GdXmMe[Qac@yPco@i@}}@}GiC?scAuOna@}Gyv@eDjb@tGxoAkF`i@gEcO}[ogGvPkt@oGfCcUqnCzG_l@|Gt}AhCg]vKpXnKyx@oKuaAdGauBgRyTmFeZvDqKvKvTiFw~AwHaFgCje@qGmb@`LyyBeHoP{BnPaKua@lAgKnLvKoBoc@gTpB}MaUad@fi@nQ_bAxYmZtDyd@qJ{@eHvPoPgr@hCflAeGqGsF`Dg@fXkT~OuTscAaCy{@uO_KiPycAuErqAxv@|oCrI|z@cGzmBef@ogAiTrX_L_gAoi@guA~Efj@dVvf@@dy@sNmKiXsr@c_@hD{XpW{Boa@g]pXyHwc@oJdJlFqmEzLiB|EzOzDtpAlP`PbGve@tCyv@}GrCkV}`C|D{h@pLzGgAqe@`Nse@hQxGz]_IbLojApLaNJkZkIwGmZ`X_@n{@uUhUsMqS]eY|Tai@iGaJaN|KwRka@nCeTlJgEt^pZ`Qko@qHwJoG`PwTcG{BmYkYh]zEx|BaEvs@qXlgBmHhGeG{TnI}|EiRijAeOmW{Xq@}ChPjSro@f@rtByGrWyKcP_PbPcHeFwSdFwTy_AeDnf@~j@jiBvJqb@rYh^gC`j@eGyKx@dZtc@pnCeClz@{Rt`AqI_UoGxK?ca@aPkTqG}xA}MfaA{LrGuD}o@oGzBrCer@oXtc@_Ht@oPy\\\\}SxKaRaqBlUycAdD}w@_Y~S_Ke|@dDwpAeMwt@?vtAcO|a@~_@zyBaAd|@gEtSsCke@aEn`@xZjuAaOrOc^_t@kQz@oWf]_N}k@xZkdF}Wf`@iDcO|Drl@kQheAf@fkBuQbSeJaRiKmnA}^sm@rQiuCq@}eByKjn@wYl`@eUaJkP`SuVvaDyKuOiClKgK_OmFsbDwQ{v@oLfPVlrB}Kt]je@xyCpSthCJjgEcClO_Uy~AgSq[rBu_@~Ts`@mPajAeHnV~Bbs@gFzUoGaPcDxWcD|uBnC|Vt}@`oBkIdsAsXsX{BzIpHfn@lAvuEaXtx@EbaD{}@loBoGn_@c_@zb@_tArcKgPb{JiStnBiU`d@iPraAcYfTga@taAas@jcCn@dy@yPiYmZ}Omk@_rCoRoiBQ{lBlUmaBcB{}AnPgv@hJm{AeKovCrHsyFz`@usEjrA{wF~tAeyHcAmvAfSkfCjUcnAnE_sCbRiiB}C_y@_OwTwTda@gCb{@uYuu@iRuKaO_k@wM{B_GxRvWbeAjP~Ef]diAsA|hCwGbh@gRdU?s}AqXvtA?rxAyMxIgVvaAqb@g~At]cT~\\\\kxCzKuQiNkLuU|ZtOmjBgXnpAmPkTpGfp@qGfp@cOkLuM{w@`Xq}CmPhPcWdcDlPrrBoIuKg[nCwVcP{@nKvg@f{@|BzR_BlvBsTt[sMsPmD_mAe^{XjQmtCvf@swBvGspAcSgb@gB|r@iF|NyPy@wRzmBcMhBqTol@u@dy@bU|qAcAr^iQdu@qGyK{YrxF_S~l@{e@xvDi[cmA~aA_eK{@kbCqLoe@gV}K`CdrD_`@toDiLq`@bG{uAaH|u@uK|HiT{jB|UrxGeP`l@Rh{@nVjqBiAn~AqMfg@_LiScGwz@o`@qu@}SqlAy@goDwMyx@aWlCiTl_ByK}\\\\hCvaAteAvzDvz@hiFbA|x@}QlXa]ad@mEwxAgU}z@gMiGm`@rYkIaRym@khF{HaiDpIgvByD{WoG|GsLi]aEnG}HxgEje@rhGpY`rAzShsBs]v`JmVou@iEgo@sb@knAo`@msGpC{iAaRg~Euj@_aAa[sQuFhIMnz@fEdX|T`SlSlk@vWrdDoNh}FyWgE{JjJnI|q@yQzwJyk@q`BqHqbAeWiaA_SwXeH|`@oRSuHcm@q\\\\w`@sTo{@eC~x@xRt^ta@bvBdF|H~HkBza@{Yhj@dlFcCdFaS{WiXgH{|@ns@kRfFmUcN}JlCsUjq@y\\\\fgCgMxOyQZsy@|^}MhJaIb`@gJv@sQayDjAtdE|Qfv@nm@khA|v@yBhXsa@zr@ocCdl@_h@nd@mP|d@an@x`@ny@nZ`{AaCpxCtIbS|I_|ArMsU`OcE|QzYOlyAiTvk@uTpT?ht@nFsVhO{IbVna@h`@}_CnUi\\\\ve@bMrSvXpl@xzFxCtqCrJzm@{AfvB{V|nAkE`DwEuGeLtY{BvdBu`@|}@tFrMf{@{|AzBhiBki@xlCw{Ar{AiSlG{EyaAqXnpEme@}gA?elCmPpGqRwdAcAqWlVovA_EiN{\\\\tcA}MbCwS}[uQeiHojAiyDy[mYuQpFgIcQgo@}b@kKqa@yDrNla@njAnIpFhOaH~f@nhAhNpAjLiJxBbFvCzf@vo@reCnBzwEof@ttEy\\\\gc@cP}gDyK~oAuK_Ocs@ixDyKcNqXgpBuOnf@oLdB_Ti]aPh]wDaj@hHs`AyFcy@}FrjCuPjg@yK{v@bBhuAtH]bGrcAzSzy@uFrf@eL}XwIx^ec@pK@lIpXzMdLqHuCba@xK|t@qHzu@}Wfq@en@cwB`PgwBsByxAtTwqC}G}{ApGqnBaPotAfLm}@wC_RgQxyAvHb~@YtcBwIpr@qUtItRbi@S|wAq]sFoMmz@qGhCgHijDmH~YxEre@{Dfw@iCrQqSbMnGyx@iAuJkRqJmDwWzIiu@mDcWsXdfB{HoKbBwh@{QwvAuM`e@pMtYjKb_AkBhrAcLlc@ePmjAMczAsKka@oMbi@}YzIqUhnAsHoSmFlBgCje@oPgi@aIrx@fId`BiGzN}c@aW|c@j}AiNtRiIlj@zB~~@sOvz@tHpzBqF~RaJxIyUa_@tGuuBsl@ctAlBdy@uFlRcCkQrBisD}ObH}Blf@yKgCq@xrBsJpe@gVmj@qBgb@eJwJuCpa@mc@gjAlQ_m@dDsq@qGkB}Vh`@oh@~iF{VzHkm@npAbKj\\\\_Kpe@yH~K}GsXqFxJvBvqBaStdAyQkkCeGr\\\\dIz`AuEf[}SapAoGpXmPjEnc@umCrBqr@iKkW{@zrAwe@plA}m@pm@jK{xCoGsj@yKpHyq@naFuAlm@yDxPoP?iCoPgClY?opAsB}QwDhC{In`FoMdZF~UcOohBsPqZ?hhAuXoi@{GrF}Hov@oa@{C}RqWeBpa@f\\\\ho@pl@n~D|e@ftMiItAoMyQ?i{BkT`j@dBqhAgQwpA}@g`CaPajAqGxKyY}nDsJsKpGvnDcS__@kMlpBg@}pA}Iup@c@mxEkKeNcLxX}BpnEpFhc@g\\\\xpC?opB}SyeEhConA_HuMsBt{@eHlJ}IgBsMmf@yDtQxD`|BqTn|AqK|RsJg_A_MePrAfnCqJ~g@mEiGoBzDiSzeA`Gzk@lOnYiJtUgCoG}Gle@kKsXuGlKjCv_AaPmPsCbf@kP_p@~TsnAg]_wBqTjd@yBnqAmGpGaU{BqJrf@`HtqC}]b`Agf@zxD?j~AiRlc@mDli@tCjhBuHdJiIkGmAej@yK`a@oa@oaCuT~v@Z~`BaHdl@oIjBcN`s@}GyB{\\\\bgAmKktAcLxe@{KqJ_Pw|@{CvjApFnf@d_@lt@YbqB_QkXiQ}qAwCgcAuMki@}BmxA}GnGiYo`@aKSeHbNyRrrC{XaTkUir@oIfj@xE~vAaHzf@aIlEcLmXc@ylAeIqMyF`YmAbeDdTblEiE~TsCoGiCdLeJaPyRdYxD~qB}Iwe@yIJoGdr@cPmeByR`ZaCzv@hGtoC~`@jtAgIvPzN~Zf@bd@iJbY_McJwa@gjAcKet@qa@veB`TjcBdHcAiBhq@eDlMoPoPwKh}AaPg]wJtSa@zqEsKwBoGf[xh@t`CIfVqNdUyC|^yK{G?xKcPoG?pr@iT_n@mFzN_I{N{Lnx@xGruAcO`nBhClvCwFblBwK||@Pd{@_Vdu@vIdmCuItPyK{\\\\}QriAxGll@hRtUmM`_CtEf`BjJtXtOg]|Gdf@}G}GuOhn@qX}SuMnQqc@lhEzBxx@eLp_ArChpAvQwFfQbSbVjn@|\\\\pa@`P|~@hDqNfFz@dY~{D_E~g@ah@xy@c]zSwKmP}GlPoPma@_Rd~AhAhbArXea@pGxK|AeLhQrCvNzr@sBhu@wShy@|Kj[~U{J|Xc{@wEzbAfFjGrIqGfLov@pE|`Aw^d~AYdkB~Xo\\\\xKuCxD~ImRhp@X|iBi[{QnBhsAjFhJ|GsXnGrX~d@_e@tNnf@rMkDqGj_EeLkK?li@qXpnByNucAyHuOsX?oGcf@}Gpr@uOihCaQnv@qEv|@jJfpDsMpeAtBdk@|_@fFnTtsAdNsTlIdw@nVqZfO`]_Ev`Aov@buCiT{v@_@dtAyJfDcPneAtChiBzQle@zMk^HpwArG`ZtOzGzMo{CpVc[bLz\\\\tIyN~b@}vEla@qvBlPg\\\\tGdVi]h`DsGhnDyIbk@ai@dzRqJpj@qApzCyVttAgOeqAaKhSoAdhEaJa\\\\qX|rBmL{CyUxlB{QbqDmj@`}CiTqGwK}[_t@mcLkScw@{Bfr@sDoQ_I_sAh@quLeJc\\\\}KTuTrcAuOscA{L~gAnSvrCjDddJgLxmC|Dd~@cm@xfL}^zrBqWa\\\\aP_tAaGaPiMtA{g@q_BcK}cB_JcXuApeCfJjAz}@zsDzy@h~ErE~eAwNrl@nEfh@g\\\\zpBuK{OvAhw@eSbr@iCoaBsCrCaP{aBbCnmAeT~lDjHbW|JcNod@fzCcSfWmTmFwFyQc@yaAkVe@yFq[iH~AiJyvBxAq{DaPdlCgGuLw\\\\czUjKcn@tQ}cQrJ{dAwRslMfl@gbQiAqsGzI{zAmPzv@mKe_CuTqn@|ZtbH_l@nnYrKnjCe_@toOuIrXa_AkiMeCofQ~Om}Kjo@kfDpOimDcDiQoMt[oEt|BgJj`@}`A|fBsG_o@a@m_HqSkc@qGnPoG_Yke@jnBqGwi@gCjtAeNoc@kJ|WcIp}B{W~oA}B|cAoWsd@pTrrBzFvuD`D|L`DiK~]lZxRdf@n^ntEhN||MoCdTed@oK{YfaBa`@lhAcC~s@|Ebt@dy@__C~QiHnIzHng@h}Ji`AndSgJfS_eA_`NeHcMkRrNeLsX{BlPfb@`dCtEjmDdK~i@_CrzJaF`RcJ_Oae@eaEsb@iaBqDutCqLv{@`EdbCcHjqArO`pAba@ztAhFbkK{QfnA}j@olCqJz|@xS|cCqCfaAkYeXsKhOxUrsDsUbyAoGzvBgLqGqa@blAqXaa@{BpqDwTnw@uEpdA_\\\\hdAuAfdBqKsS`C{lAyKefBiTpr@gCwe@uCf]gCkQ}B{y@oGbPyM{[r@c|BcInZqEbwEsC_TqG|GkM{I_[mbCaLaKsGlv@uPys@oKeJqGti@uKm]eVgtKgt@_qFRiqEhZacGcFkGeCzKeRcLgRxGsIib@iTa`E_NkxGcE}MmFtc@}PqeAxAuvIwq@obCxIidBvMwm@z@spAeM|p@}Xx_@iNp`DgWof@{Hsm@o{@alR`W}nDqIgv@oLhn@_JcTqFsrC{RrQlMimDu@{]wKpXgKeU\\\\{j@~EkNhCxKrJ{QnA}VqC}VqHaR{G~D_@_KpMi_@~OvK_YqrB`P|m@~@}`@qQsu@Oyw@dLga@_KiiBbBcU|PlDtAgKsEyoAdG_k@OcvAeInIyEdp@uCqrDcGdlA}Ok_@eYayBwK|\\\\yFmX{Ga}AeL|SqGui@uRab@uYqjDeVwO}Jcq@sCfX{MiUqLspAaYyv@}_@iFiS`IwPbe@aJ|fAg]m_Ay\\\\dlAqPyv@qXrcA{Bca@yKnpA{GopAkTf]cG_YmI|D{Rmg@e]flAsHsQia@evLwg@ieC|FwfC{KkpAyEvlFyQpZ{[}RkIf_@zE|VfTcC|G`Vp\\\\fZbZluArS|yGqMplFlV`yA`\\\\faGmDpzBs_@dcAwZlcDdZ|iAzFpuHwH`RiCwNoE`D{Rpd@_K{YwWrmFaMbZ{Bme@}GbPsCwT_Rbr@g]gk@yDxVaKcRyMitBev@k}EwDwlAiR_o@gCpLcLuTsEhz@cKkT_b@o_KsDsT}YaTiBmLn@ciIyDaPuRfbC_Pes@we@gvIeR{PcPcf@_Pna@_IsLuFguCwSuh@eKjs@cHvFaImI}GaiDqMas@?cxBaQoaEgb@q~@kU}jB`Je{AbUcj@v[gEb{@ykA`o@oxCln@maAjYskByMeZqL`pAiDrCcFwKcp@vkB_^faBqcA~uA{LdIwLqCc`@nXy_@sjC}GcdExOymK`rAyuK_FoYwK`PiEaQ{F{hGaO_`AoKiN?ca@ca@fwBqr@pgAqGsXkSlsA{HzHyy@mgHaS}f@cTti@aN`qBuSfIg[f~AiX~h@}Fnr@eKfV_LfCyMli@eRoYsWwpA_|@erMuTmbHtLucE|R{zAmB}qC`VokI`Ge]b^qSxQuw@lQnv@`OMzQyl@xv@emMbAsxDzPc_AjKGhi@}aFzG~~@rN{WbZmoHlb@edCk@mv@cb@tpAm[llHqGpRcM]oM|s@mM_DkFzP}J~qA{a@p|BmGbyAuPbj@aI|rFeGeGsHbMm`@vhBuh@`}@oHnc@cQmIcKxPa^fqEsAzhBa\\\\vnGmMxX__AsvO?onD}G~GyEwRoc@aaXwUgvDmEugDhTa}AzGvO~KaUHw[mFa\\\\_JgPmGjEa^_}@{GevAnMqlLxGa[Zd~@|Qy@jIorAvQgp@kIyKwHnGaOtjAwKcf@{W`x@iHv}@tClyCwIk~CeL{{@i_@cyNr_@_yE|Qe[b`@q{BtjAqtDj_@}@zVbj@jg@caArNoGlO`Cz{@po@di@pnBbPhCzIf]jQm[zE~IvKqGd_@nP`SmHrQscA~I{bCkTjnBsCyeBeGeLoUd`@k`@rUcKeGex@qgDgSyuBcj@g}Aey@jMc^bk@uPed@rOcuGnPmpAdAc`CdUmqArVe~Kh~@_rJtLca@zb@{xGzMwf@l_@oK~UeoDiTi_EkIf`D{GfOqRbAoEjOmk@fkJ{XdqAqGyKmGbNoKhxC{a@vlBaM{Qft@kqV{G}mBmY}eCeK~Pg`C~xTcb@x|AeqArr@a{@brCeW`FkKpd@oGoGra@}|g@dMw{@`m@wnBtmA{}T~Mg`@jPf]vFeYo@}nEzrAm{VvKqXxK~XjK_e@dL|~@ju@suKxSgc@rZasBdGqhF~LwiAcDoR{Gf]sDuPm_@gjHoDzpBuFzb@}B}m@{Gdr@{BwTi@~iBsFra@qGca@sOtiB|@s`CmR{c@cH``@`A|fF}H~XuCyKsXjTcAmcCgc@{lEiGvfB}l@dnCch@gx@gUcvAoOomEn_@{cKhGgzOzJ{i@vKxK?iwFmIbPwg@hqMzJ~bB_g@zuJeR|]kTqaBcv@byLyQjaAkVf_@eeB~{SmZpaAmPydLbQcrAzFw{w@x\\\\flAv\\\\eaEx]kd@lV~f@~JxCdk@m|AzA_cAuDaO}Z}Sm_@~hAiGyUgCnGoR_UkIxHe_@hyAcNhkA}Mwp@nBsuCfU}fExEc]nOiN~b@_oG`YiiAvC_{FzBoK|ExEvFgHr]yzBnVvo@zMa@pH}O`OqpApGfCxEknAiTa]eNfOcOxz@{UsLkKo{@yUz~BkNr[gPkSoPmbAic@|Y}HrXoVpbFpG|bD{b@dwF{q@rygAaTlmA{M|oCl\\\\wzBfLh~@iSp`LdBl[vK_PvD`hEi^lkJwKkPi]zaF{Gu\\\\na@wzO{d@nwCkL`hHmLuc@v@wmAyC_IwLpDyEmp@AofEoT_k@@qj@zYesBdh@m}KbOyZtOlP}SqfFpa@ujWuCglAmgA`ia@aCjF_DwGgCfr@yJzOcBh~@oXtuBcN_SiF{p@bBiPhG|LxG{UeEqtAkRi{@ze@g}FyCwZoGhTsG_YoG~m@mL_~@`c@woQsJ}d@vUgkN__AfnU{BstT}GxvBoGk_AgCduEgCqm@qGbGcJasJt_@}nCoG{eE|\\\\kjFzK{Tz^~mBhRb]}\\\\wbDfLg}DtqAwdLlN}mWle@}lGzGlPf^ol@dZwnDbMcVjIZrCaQmE}i@mKna@oa@slAw@deDcJjUm\\\\rA}I~X_h@|iEmLe^uBgub@{RzhEgBpvUcF`Ywz@}nd@?`rM}Gk_AkK`xH}Gqr@_ChmMe]lnBwKwtAaPvTqG{e@iCxi@mPte@oX{v@_TnpA_FqdAjp@amQgGym@gRzl@eE~iBkL`o@wKqxAuOz\\\\sIi]uDmmEzl@iaNfYkcAfRa~CyE_]_Y|vDyKucCgIh_@iQ~gEyHvZd@mgAyDyT{Bdf@sPwm@Nke\\\\_j@eqI~Ggm@z]abAgCeZe]wa@oB_d@|Gca@bTiGpOau@~YscDjMcL@oy@cPyu@_XjdDiUvo@aMem@a\\\\ou\\\\zKwoDpLvz@|B{KzGzKkV}xIxJ{gAfCsaPwJydAni@a~Y`Eg`@|Gh]dSweAmFwiAwKtOsFyhGlCmm@|N_YpGx_Gna@qaF}Gq`GwKdwDaI{d@pkBklt@pPvj@be@j}Erk@b`zArR``B|ZvxW}ZvpDu`@`lRlGzYxBawAvMw_A{I}_Ani@ohKlLeNvLxfHjKd|@lr@pdp@bGz^|GkhElNtkAtQrPlKg]|Gfs@nV~h@tKiq@aAs{@iTv^sXkcBwKf]_EoMm^orEeHebQf^kaClPaFzJhInN`fAbAg^kMadB{IuTel@z\\\\{VirAiLygHqHip@{F}zV~Hcv@dKuNnI`DrE{a@eFaj@}]}\\\\oP_aByn@mfUcQ_aB|Xozb@zMwDbGacAeTuxBtA}{GpIodAeCw{M{I_k@_s@_|XlFsxBbZesBtDn`A|NhTtTml@pJauApOyH`u@fsEfKxUjKiLdKnX~e@|pGhCndEzGcgEypA{qTzGclC}QmfEtM}x@xKprBbNuMjOew@`NcRpJxRdIdeAaF_lCuMse@wHrEwFuMwFgf@uGwlDzGclBnFyS`M?~Eim@_CigBzWcy@uH{mBgFgJ}I`@jC_s@zq@q`InGmqC~AuQ`Ig@dD{cBt`@szDlKv@`Z`o@~j@poGpMx{DhTbfBvTscAz_@tuCf\\\\hjZ|Gp]fvAnosBoPjo@eAtvDiN~iBoN`eVlFdZ|VkcYbJqzBp`@krBlx@btUbWdcB`CvuI~Lvg@zHp{A}Clv@z\\\\hoHjYhfCd\\\\dT`T_BzHw}@d`@~DfHs^uAocIeNaAcRmSkb@qmBgYg~CaRacPqVeeEg[csLdBagCrSOpKsKgGaaCqM}WyXeKaSgwCiDymHsMgbBdC}|GiV{lBxm@yj|@`f@~o@p[ql@rVkDxUdNzMtg@veBjl^vYf{AfGjyAhQngAfKwxCbLeCnS`U~Q_iAirAm`NzAguAaw@upQhG_z@wY{jA_k@ytI~OoaF{Lk]e_@w\\\\gCfK}_@n@qL~Rg[uz@y[t[uNqo@eLcD}EgRGqcAdKbW}VuhBeYgr]`Kc|@xt@iiBrg@uf@zj@nRnk@cf@vOrCrKf[b`@bOnPr\\\\xmAz`Aj`ClzIvY~lD~\\\\`CxIzaBvI|IvTk@zQxsDf[tVn~A||HdOzbBzJv@rPg`@fOfEx]jsAbMvvA~KkZlM~HnQ~uCzXfsAfUd\\\\tIgSioAcaK}H__BoM}IiFd]}KyLoXmtBwHeAiSlj@}CcD}^gkCwRisGk`@ii@eDeT~G_iA{E_nAsa@if@cFwz@ceAqpGaUe`GkZix@gNssAya@gSqqBklO}FiJyR|fCyTkjBkNfi@eMgYgOsyBnBwf@lc@kFgAcyClNamAiH_mBlHk~@`j@rwDxs@r`@xo@bjBpAmZynAotNaA_sAdR_`BoCg`B~TgrC~YikA{BmUqb@bS~U}oCnX}l@eK}}A{AuyCgi@zl@}@y}B{_@{~BwGnMeFrbCaMsO}Be`A}Soo@aC}bAfHg}AuGghA_E}DyDbFgU~n@kLsWeZsHaC_nEoRsO~GksBsDa{@aDcKySuDoD}MgCeyH~Mas@r@{bXl{@yzOwCeuAzQikCaAulAxc@_rIjWebBz\\\\mlA~[wf@pPm}Dvu@rbDhb@lhIjZpp@zg@vrDhUnB~|@fxJwAze@`Q|s@bZdh@|JfmEeKnuFpQ`gHrn@cyErPuk@|ZpNvb@buAtV~jEqBr_AhVnsJnh@h}@|EfgOeKbaAwBfwLed@pmBpBtjChf@wM~F`KkCtaB`K~hCn_@dmCpJr_DqHvhDuc@h_Fq@hdCgR~}D||@ltMpDbdKtIk[fBseGvMcPx^D{kBm{O`f@}qKhRufB~]}cK{KwpMqk@qaGh`A_wGfYgxKbJ{VpoAv`IcUx`AtQnQpTko@jYziAvUvdL}T|fBpb@hlJ{WlnN}KnbAcHv|C{R~~AzCfwDfWheBvc@pv@dI``@fYpsFdL}~BzXbu@bJnlBlTq}Av_AvkD`\\\\pj@dKek@Q{{Ctb@sS~Nsx@xc@yDcA}hAa^eh@wXsxDvg@ulDzGuAvSra@bV~mAnl@}Spa@hjAdl@jWrVba@xFbCnMsp@bU{OhsA|tAo}@cjBwEodAiIaH{b@~A}OsTbVc\\\\`r@vEeKge@|E{XdG_@fCg{BpNof@dFpAYzj@lKr_@lOeCnRqWvAleAcOr`Atl@nc@oK~kAhShfA_Qtr@pVlg@\\\\fjAnYxwApG`SnNeBdf@yjAvSqJvPnQdSvt@lEd~EkT~}B|QpaCt`@sr@m@xzFpFbDd_@stAlYr}C`X~dArBaeErJi]|HrB`v@d{Brv@lf@fa@pgA|d@`j@zQ|Ajt@id@zMtOdIh}@nRgYtUi@dp@h_EjKxEz[qy@~SjEdMrf@zNfxB|WmK`qAvjBzK_eEiHcaBxEe\\\\feAraB{@kYidA{`CoEue@gHnt@qMbRoVsCsOgPazByoG|@omBfoBwrNdc@sxJz]wnCxh@g{ApItHv@phA~`@jxAdIb_AklBziMrFvgBvQtz@pBjbHzLsF|GqTxe@glGzNuh@pERF~vBxA`F|MkXzHzOu`@nyEtB|bAhGkCnLo}@fh@_`KzIaUhLzxD|K`VoEciDrR_yHpScp@hOkrBbl@_L{C{e@vPyaAr@mcC_Qv[i_AkOjVotIp\\\\ix@vN}iAtbAah@foBlC|s@~p@|OteAli@r_@_UvfApM`G~^qE{c@znAio@p~D`CjlEaDv`@_Wtn@B|mAy\\\\nyCqBhlE|Gvy@aQjyB|Aro@hO@~Zf|BeSxwCfFtpLnIk]hHsxCaHadFrPcyAz@i{ArHjgBzW`|AeLbv@Dd`AxGrOnGkhAvGhaAlk@`yB|EhjBtOui@zE|M{EpaAwaAryBkXdhBm_@n~@dL`jDvH}l@hOoHnX_n@tFn_@cBvrDr\\\\r|@fS_r@nFqlBjHwMzIl]dJaAfUml@dJkA~TqcAeGqViNhWuHmFyK`Pwb@qxBpDop@fC`PvGsNcC{qAhTmpA~StnDnNaoA_Uqn@pAok@jTatA~ZrqElItOsEg`CuM_cAtDcYvKvJhSrx@Ov{EyEzr@m^diA?zv@hT}r@xHnQ]tjAfOkx@wDcqAnMqw@yRayJdOdLhSqWb]zn@pq@|d@dJw_@rGcaAwLouAqGz\\\\wSwa@tBkm@nGfr@fEe^iLmsCdf@{|BhFkkAlYi|@jJ{vAnG|gAnFkKcKyhBrCog@gz@txG_TzU`B|~@uT|m@kMie@zDgpAts@i|FoTswIdGqw@|Wqs@he@sg@vCeP~d@v^pG{B]xnDfk@piDfC|hXtG~v@iLb}Aqa@~hAxC~RjK{GnPjYhCin@nPsf@{AeyWbWcvCkEiwFb[_sBvcAaoB~[okC_Ab~BdE~OfLwExKn\\\\pXicAzIdJnH_Kt^}`A|{A}`@fbBvd@x`@ne@`b@|lAuC~tDoUvkA_KLyKx\\\\iCgPySsIsGoc@kb@bwBAfgBtMp{@fGr`Em\\\\|uA{LrjBqUhtAmI~V}a@bVeJz\\\\y{@foF_o@frJ|DhJrHyL`kAu_Ljg@irBlDbUgh@ntQ{Jve@iOrHfLrQvQe[po@cdSpi@agAl]_qEfg@iyAh`@}iDja@uYnIhkBoIp~DvKrrBlhAdwF|JnpB}Q`|@c_@vO{H`Yqt@leGa@~v@vHiO`\\\\meDt`@m}BxIdFfJpg@tc@szCoKiaDedA{tFvMilDmCidBvGk^zB|G`RTdZbl@vJnBzSsSrQwArX`q@hId@bv@e{A`JkC~i@zpBQ~kElKip@OssEyUioBpGijB{BuxBnG_s@nWkw@l@eeAjPuP~BmkAu~@gdEhXgrAtT|WfIt@jIkLxKtz@Rq`A|KgJzBvKjEkHbJckCvKsj@xN`k@xYpWfElr@dc@`Jhp@fpBfR|aDwXzeJiIpLcNuCqGfL_YdCkl@hpA~Enn@_Mx]lCzmEqLbiGbPthFaSjmBoi@~j@ab@t_CnDfSte@qlB|b@qg@pU__ArMw@fX`d@~`@tjC]``BtJnz@oEphB`PolA}CulDrYcRjKvKxQdq@hWd|BgCapArXca@`I~KrJjaB{Cvr@~Kxk@hIaTrWucFnOg}@tPxHrD{JjJdFrXwtAfCtCz\\\\aa@|m@nXtb@eLr]fHdHqK}Aec@eXqh@ea@ba@uCqGy\\\\zKuI}GoNvHeJsC{]ba@sEvk@qTqGsIvZ}G}GwM|CgVmlA}AsxB~iBmrPqDwhElKcv@dRqFbA{Hv^~\\\\pHr}AfIdTbPoa@r@|x@pJnE|m@ui@fp@fc@vEicA`EgPhJWp_@`i@nIly@cD~kMwS|bDvSbo@nSqg@zHkBlG`MvKj_CrX~rBxp@bxCfA`aAk}@pgNm^xnAgUnlCqa@vtAw{@jmF{Yn\\\\ug@tI{LuImBmSpGkbHx_@}jBdOsfCtk@opDpFapBuc@ewDyKiwBb@v~Ab`@|nDlBruBkF|h@}Vbl@whAz~JgDfaEsi@hwA_Dha@|WvtB`@rx@bOoCxXp}@|a@xV`{@c{@jJ|q@mk@zmHmSp`@_XqO_StQiSfoBok@~fBw@pt@qx@fv@aYnjBnPf]df@c|AhLuOnJ~E|HyM~a@seBpc@uyDfT?|NvMfUka@bo@sjF`StWzJdeJjk@d`CrC_n@x]`QvJ|hA~h@xvBdJv}BRvrBs|@r~C{Cxp@zLvaB_JtpBi_AvvEsYvc@eEf_Aba@dnAFuhApv@wcC|\\\\uiDfOg~FnIq[|N{AbLprBnV~S|Zd|@ya@|iFNlxA|OrgBqAv~BbS`kAjFm^uEk}BbMsj@zZxCbf@u\\\\b\\\\tm@xKzv@tE_[sYe`Bae@sBc[wPkM`LwQ{`@uKscAtZsvHmy@o`CuBmuGhFydA~_@egBz`@o`LrReGtOoa@pPzLdc@toA`LbnAdOpc@aA~dGzg@hqAh]zbC_ArqB_o@voEaEfqBx[|fEgVdeCnIzj@jYysCmNe_CgAqwCfe@q|Dba@vnFzFae@oXkfF`HqYfr@na@`j@jhAp@sEcEmf@}uAumCjE}s@yNmbCqw@wiDmDcu@~XipB{Fit@dBqNd\\\\}Z`UkrEpVgqAtEmKdQr[~TcPfNpDpG_e@dLtOvCsLwC{h@dKpDvNtx@pk@rkGxKzdN|MglBsGqaEjHesA|bBh`CtOE~XeRvTje@jAyJoNi`Ag`@tBk\\\\cXsHhCg~@oxBaIycBea@{vBnPaw@oH{vBhx@w~BxFag@mCeX{GlPjAyRrg@otBhJgnChMcE?bv@hGoMFko@ePwSdPq^zTJn\\\\b|@~KbFoYc|B~{@mlIhJzJhMxw@tIu`@oEutA~_@uiE|b@gnBlO|XhQzqBzN`DvP`\\\\pp@|kDnb@`n@rMqFwDudAoa@eyAs}@gyGtNsCjJtJ~s@jmCju@yKpCiWqMgJyHdMoPwjApG_t@qGrCsI{ImWmp@kSPuOgx@Ow`@rGcKdLdKvBiL}O}wChSt_@|Cxo@|GwTxGfM|Wj~BpL}gAhMd]jAdlDjTfr@lo@vP~e@sYb[zAnPg]pXxKtEeHrMlLtCcs@cGuVcd@kL}GdOwk@u`@kn@okBL_oAtOmf@{BeKaPhTjAom@eNbGrO_`@LiMaPiT|DqKbJeH~XplAzB}aBdLl_A~A}kAlHiPvRriBfL~ImFyvA~Xl_A}Gmv@rCglApa@`~EbGin@pG`PfC}NiTcy@jIgc@tEqH|GtOrXov@r^h_@ya@h|@c@jUlVmOlE|FlPkhArd@`aKdJiVaN{jEnDgs@wHuFoGsv@nCcv@fGwLpTl@lC_Ipa@rlEzB}m@yQmvB~D{P~Vr_AnIcCuTgz@fCiQpGtTpAgUwOyh@rDkPzv@prBvQqb@pUlZqFdrB|O{[dDgd@wMwqBnOz@zVt|AwC_lBjT_l@?pgCvTsrBfC|m@`PiTfC|N?vdAxKmKlM`T|EsXuKao@vGsSpGba@|PisAg@mf@z\\\\je@ne@lmAjD~u@mGzXh^zzAeLhd@{c@ab@eQnp@|BraAh\\\\t_Bo@r{@k]tTar@uCsd@tReOjS_pBgZ}Dl^vQpy@ra@~TnOyLzKbf@nl@uC|Oa_@d_@yM`{@lTvQbSdBjoFxG~p@}GbzCe`AxqEaNdnAsZfjAyn@htAye@x[g`@bk@eb@hN}j@vKmq@eSej@hj@etAn}DmNvHuLmFyC~z@iItGwF}D_Wwc@aMjMzPddBdOz\\\\gF~gAxGfqAmYpn@}Ohy@wk@zdAgAfe@wv@nqBiCbuAkOr}@|AfrAcK|r@|Ht{BkOdo@s[p]tJt_@|\\\\hHbIxRfG|pDmT`}Awu@|iC}n@ng@{g@~uCgb@||A}GvCyKkKeo@pmDyR`^~Tbm@eMt|Bca@xkAer@|tDf]pt@u@u|@nO_{Apa@i}@~PtbAxJlLnKySrR}rJ|Ssd@_PzvBlMlXzbBevL|e@|\\\\vG|PgElkCn[piC}MhoA`JbNdG}m@jP~}GgG|r@dK`K`Iam@{K{~HuYy_DpXeiIlPz[vO|bBpUccAmPqZuLewA~O_vD~Laa@qC_fBgLcn@dGomAqFoa@xJwcAcGgxAxFeaCdIoo@tu@cjBbC{dArOiTbj@fCv]vu@fK~Ci[zyBhAhk@bj@o{BxJdLlT~jAdXjdCnSvg@bLx{CpTz\\\\`S~lApWvzF}GrjE|Gt|@gCh}Ccj@`j@mx@jfBiZhReEzTdId~@|B~IpJ_Gtp@w}@lZmzA|Vs\\\\hFnu@ea@zaE`MrfBaMtbAtHlaAxFlGlQa}DpIqWnPyB}Aoi@bJml@fG?b_@h`@`o@tUlx@nvAdL~v@J|z@~I}KtDen@fU`k@dMnaA|@~lAxKha@{BziA~d@d`FqBhkAzKdUtOaa@`PrO?wz@in@uhE{A}dC_R_q@uBscCnGdXxKwKrYt\\\\zOyMzTsx@}s@ynS`H{iAeYigEdEydC~y@}`CnKhYdDpr@dKpB~XjyCsE|_BiPxhAnb@hgBrLv`Bhm@rvAjXrfDxU~fA`Cht@a_@l~B]jzAn_@viBNbfBlSoQxHy[cPe`G`e@ktAw]_aGzLaTza@fBtL__@eJmeBqdAyjAuGiDyMnJkPgLeGbDsXadAzCsj@cb@{jAvBgzCdWw|@tGkbCecAueGcaAkvJhHs~@pJ`UxKbaB?ykA`[bn@~EdCpNyQ_@_aAySeUc]emAsAcc@xKqn@v[mU_Oqj@po@apEpNeGbVipGfO}\\\\zN{kAqImh@pVisCk@qeVqT_dBoBw}@lI}P|h@fq@p}@rgCYhL}SbPgCjVvRnI`Ajh@lWxS}Cj_BxJbYiCdLyKeLsCh_ArR|\\\\~GkGlMcyA|GhT}GtcAo]rw@g@bS`Nna@tOg]rK\\\\kCz|A`Lbr@rJg|DhPoh@~LhHzNnm@gMvnBq_@hs@cLrl@pDja@|Uc|@~e@y\\\\kD~_AjQjpDqMjyDtVwlCjP{W~SaI~Qci@xKr}ApGucAn[xi@jTxlAmMhlAqYfOeItWfUhTNfPcYbtDn[wzA`Eky@lc@sXbFdE]`l@rLyK`G|GdGqa@vEjWcRtqA?~~@dGgOpIvA~a@v~@yEdg@eGsXoBhK}CfiBgR|TaAbWzShI|ZecAfVcPxKh}AbJg}AdUvd@d@tuAwKeL_AffAsVpxBvKzv@lGzM|KsIaA|d@nH~LzFg`D|McWjTtCjKplA|Gwn@jMvVmG`sAaMbWbG`o@ri@qgAnOtQPp_@pGsCaQht@qFeMIbqAwRrbA~Ati@rc@ubA~MtbAzBoaBhD_UrGsJ`G|ArBh|ClPfI~JivAlWp\\\\lBjYkJ|jA|HzRzGg]nF~p@pH}ErXj_Av@teE|LzOhUeVrCbUhCqLnUfd@~KrmAcLrs@lOjbAaGpf@eH`E_LwNkAz_@ji@z}@{H~k@nItn@tEpKjT_AxEnV}PjsAhDv_BzWo_AvImHrA~JHbgCgFbl@mP_Y?|z@`Zpn@sDfg@vL~SxOe_BfHxPlFz|AoOp_AaJxQua@~Sw@bSbDdYt`AiFuItZhTda@_`@ldAyD|sAxKye@~XqXbHvXkLfdCnNaGhEfFAxj@jKvd@pGcPjInLqJjvBlD~KlZnDoRf|@rFnb@s_@jfBpCzKtOhA`U{t@pKgGz\\\\px@ke@`y@jK`a@ba@xKsGxw@rGdOxNcp@lM_Jy_@dzCqDvFkTaPzBri@aJfy@ie@tFw[ni@jU~uA|ZrQxGx\\\\bNcCzSaj@vFIvCdr@iPrlApHt_A`GyHgAudArLe`@nZcOda@mv@zGz\\\\{Gx\\\\tMrc@yD`_@vFnlAuW`h@wr@YmUngDb@xyAjk@khEnFoKxc@_N~PdEzGqXzE|j@aJjh@uC}GpLl_AyLtgAbHre@aDfm@`DzLpGaPpF~KeE`p@tGdO}Lb^lFnVoq@dyEoG}gAoIth@xErq@~IhJlNmNjJyg@fHfhBoLvZiCqCFpr@e\\\\xF}Q{QmSzg@aAmTgMgMbH{eAiEu]oEjIoMh~E|Dhv@uk@fu@qUKwQpq@cM`@ea@uTyElXv`@na@fFzTqMpc@yElIsXoPmPrX{a@hOc\\\\fb@b@rd@|I`IjPaDvOuY~C_`@nBvL|P`CbCtOgCpgAlq@sj@`^yy@pR{zB`j@a]~s@iw@o\\\\teF|S`HxFeQqIg[vAif@pXktA|GpGu@exAbg@}a@pX?cGpr@bGti@bPoa@bNrPkAm]bSpUvHYnPcj@uC~m@nPqGuHlq@`OlDhVce@xCfGkFzrAkMvb@fJdcC|UmKtTzaBlHaFbKlOjl@toC{AzjDzQc@pI}mA|GwIht@x~AnAvl@wKba@tFfPxYga@jKtTiA{h@~GmDnZhn@vHog@~ImC|Ylv@o@~]mFlDyC_HkGdRtFtJ_B~`@nGuC?vz@nOk^zCqnAdL`a@fBiXnU{BjPkf@`GxrB_Ld^fSbi@bM{}AbTdRjLeRhTtT~M}K~DlCmKba@?je@dX|XjIdkA`Onf@mE_iA|MgSdGpL}BnkCrX_hAQrvAfPw^fUlYhg@hNrWcOdCu_@pFo@hIlGnBdXyLwBsXj_Ahg@}TmIn]hXyEpCnp@nYmInLb[vl@tm@{@rRmy@kYyEdQjFhI~Pb@b\\\\zg@c]~Rc\\\\sGic@xOrX~MbLkD~]~{@vBga@tGyGvKxe@pa@qgA`Tdh@|Chg@h_@|JxEv~@`KbZ?ip@z\\\\~QiUzj@tJdFtZuKZf]rOn_@_FzPeFsJcKhGxGh_A`PwT`Phh@ca@hcBeQkLe]b@zLt^pLtGzBnV|WxB|C|Gje@c{BlOlq@eB|{@_YvqAuCzt@mBxGgKaHoHvE`Pxc@nGsC?vx@eXzWqr@|hBoPme@_Atz@dNh]~u@y}BtL_ApXti@gCav@tOy_A~Xf}Aq@qo@n`@e]xZfEsHbd@iJ_BsXhTkGvj@tBnKtOyh@nP{DvHtLwJnlBtVlb@oGk_A`PykA|Gnv@}Gj_A|GaPbGje@fCaj@pGf]`PkPpXr}ApGqGlPbc@{G|XrCzx@}XgLfQ~c@mFbEeWqLiB|EjKrV?z\\\\nGuOxe@|BmApVsUlL{u@YmUuZuHtJtH|w@}C~_AjGnOdLk_Anc@zZlc@~tC?b{AfGwFC{j@r^~`@jQbrAaIdQoImKuFxH_EyCm]fh@ocAwg@kE|\\\\|QhNbWaJkH|q@lYkXlKvTdYqWEnfApXg}Ar\\\\lPdHpa@mP~~@x\\\\ucAdD~Lwr@pmDe]lv@iXtmAhO~N~Gdr@zL~PkDrWyv@clAmKze@}G_HwGfTgKeHwN{f@dAit@mBpGyVkXBum@w`@zCmWee@uw@aqBDkq@mOee@cMp[bMfmBuO`y@dNdi@w@bg@i\\\\btB_MtPc\\\\tKzh@tLoIbkCxNfYzPVb[knF~Oaj@n\\\\hn@kArXuMuCtCx_AsFdl@rFpM~XayAnGbPv@}InIlEtGva@dY~a@~Flo@qI~YlRiKiD_bA~HsKfIm@jKf\\\\bw@yJdFpGvJxq@uXaEtGjc@eKdAuBr_@zNW`J}XpHvCjDdjAkTlt@wQaE{Vj\\\\}Gf_@fCpXjT_Rra@hBpMcPbXqvA`FiKpGfClOikBhWdB}@hp@tOuO?bGrJwExKdKhMmDyJpr@fQe]lQfEtDvNiPnkB~d@rRhEpVoIzK}X_FyOgi@?dr@mRhOzIoBnPdL~KyDvDzHgIxXmP}GiBjY`AhHdImDp@~_@gRzf@dQrQtPyk@zAcq@j`@uQyGfoBeOz^x]jq@cHbd@gCmKvTdlAoPsCms@pm@a\\\\dlBsTtNpOrNxEsDbJ}b@~^}^|MeAd@aSdM{HhExe@_P|\\\\`LvFxOwF|IfP_M~Jud@cErVl[xV}AnEzVi]dr@{GqGwH~a@kWmYnGhn@wWru@jKfZpXwn@iIjlA|NnHnJeQlGzv@fPmiAtK~HmKhp@fCfFfXqHh@di@io@|jBbDhWfOhQx`@qm@gNzpA`Fb\\\\zP_PjKmc@jMgzCfN}z@xGgDaDjdAzPkDrIti@tYup@rNaAlC~MzGeIrEg]}OtGFsKlKoa@pGxKfCcPhFdcAqJj]jLrGxJsGtHdIlEz|@bMagAhI`OyIvoB~P?fHci@xFpFnGmpArDrzBtHdRl]s@uAzP}GuCj@~ZlJ~QbJXn@zKkr@x{@iCvRj_@sXHpb@gf@|uAkM|UkLNvEva@n`An]wWhh@oGoG\"],[\"qdrc@v`fZaMwWpDeZpLF|EbP\"],[\"iqrc@jfaZnRd]}@nOqPpCe{@ea@rAvQri@p[w@lXmZoQwr@{LcF_b@tKiz@`LaXha@c]vIdCjRvv@`PxIeRtOqR_TbCvQzWdU\"],[\"c~tc@fj~YqLvZ{[}WxPuVvNt@`OcOx]uuAhBlpA\"],[\"{suc@zysZmYiT{A_NzJgNxK?\"],[\"_jzc@p{pZjL}Hhv@|[nQfu@nV~\\\\vEhy@eMaOujBmUoAeYbScd@}PkX\"],[\"_jzc@`_oZqBld@yGzHwRFoIipA\"],[\"e}zc@`ymZcWeMdH{[zv@fr@hTrcAkI}PqNkBoRwp@\"],[\"we|c@~{tZrKyB~Kl\\\\bEqAgAb[q\\\\ec@\"],[\"q}_d@d}`[wElA]sM|JujBlMxYhIl~AkGlF\"],[\"ao`d@~i_[|@p{@h_@h}@sGb^tCtTcP~S\"],[\"eqad@lxm[yLgg@xL_d@nIboA\"],[\"_~ad@vyf[xKoPxSvbANvf@nP}m@xK?wCv}@kLdLgBdUud@sdAgJyk@\"],[\"ewbd@td{[tOwz@|IzL{MnfA\"],[\"ksbd@r_u[dR{H{Mbg@yG}K\"],[\"ewbd@|hy[wG_PvGi]rGzU\"],[\"y{bd@ljg[fUze@j@xW{IzLaOwj@\"],[\"shcd@nwx[cPucAhRVtEvR\"],[\"k~cd@jox[xMbWNjUmPmP\"],[\"gbdd@tjx[{Rud@kIU?sXnRrO\"],[\"yjdd@z}u[cRmWqAk^\"],[\"ulbd@dgl[fAhaAdG?|Nf|@Lda@cKpSca@qaBqOgtAsUoi@_e@koDdFhJ|Fu]pH{B`c@`xAmCbt@z^tm@\"],[\"}ffd@`xk[qCyMbLgp@fCdGxSwGdGpe@sFzP{PyA\"],[\"uogd@tsk\\\\uEvT~FnW{Af{@oL~X{HcOsA{`A~SszB\"],[\"_rkd@fwz\\\\aNoLiHkh@|EmlAhTnpC\"],[\"yrmd@l}u\\\\gAcpA|E{TvNgDbM`k@yKuCxK`j@yKti@rCh{@{G~K\"],[\"kbtd@~f_]bNmp@lTNzGdToPb{@\"],[\"}koe@x}|]jBsa@vj@j]wZlp@\"],[\"c{oe@t{|]pW`sAsLj\\\\iSzHk`@obA\"],[\"a{pe@`hy]|OuPhRx_BoEhYoLjGeg@wbA\"],[\"afdf@v|w^iImWkEsqA`NsGzSn^zCv{@jLrHqMdW\"],[\"_ikf@lh_^vYpItq@~mBbs@jr@lHzZb_@xPvTd_AoOjq@mJc@mE_PvGyx@eLz\\\\_J}Im@mt@kU`[g\\\\yk@oGn\\\\{[kz@eXcEcVs_@sQ~B}ByfB\"],[\"_mhf@`}h^qG|eCwJis@gF{HoG|GqG{v@ePdEaa@om@mP_FsCwiBve@rm@z\\\\rC\"],[\"a~pc@tstYzS_i@hHdB}GxXn_@~`AuRdFiYkUVlLtNtUt_@nLxJh^{IpUsh@yRqKlHvN|Fn`@fp@bEfv@_OzLeZoGc~@sxA\"],[\"yhrc@`{jYfCz\\\\dGkT|Lpp@cUnKz[hNl@|O{v@ka@\"],[\"surc@fpzYhv@hxAmE`Fol@cEds@|WjPh{AuTnc@wk@ulAsQaMuIm_@bEklB~CiP\"],[\"surc@venYhFmHpXhS~A`VcM`HfHxNjOh@zB~XkhAo[aGsMvHmm@pIqL\"],[\"eitc@hejYrCqXlKlP~GaJjK`IbLjf@mPz\\\\cGti@mP`PpJ`JoUhjA}@tm@bJyp@xMqHtn@|XzFhQrNrFn@`O{InZm\\\\dHyErLzBhG`Uj@tBlTw`@d{Bcc@{yBzGlgCkP~\\\\gNt~@wXhWmTeb@\"],[\"muad@fac\\\\?aj@|n@ryC_@fGmLiHuBgOsJlLdS|m@_Ah~@yIXgCda@mLmQ}GjQ|GdWmOvDaPle@wM}iCyIuZrE{a@xPeHsJm^lGmx@bP~XdGmPSv\\\\zH`EfIki@\"],[\"aodd@fmbYfCa}AhT~xA{GdrBuO__A\"],[\"_fod@t}|Xs\\\\_r@hGwL~[ro@\"],[\"wowd@n|tXl_@tGtIdn@zP}`@|JniBke@icAoUgG\"],[\"edee@xeyXsA}WpEiD?xKpIqY}Pwk@~J}U~S~JjP_KrGnj@_Ork@\"],[\"k|he@`_tXlPojBnGrr@mPdr@xKhnBbFkf@}EaeA~ImDnIfSpG_K?xq@oP`h@fHdo@aJfH}IgCp[diA}VtWmPeL?rXmd@a{@hRguErHwK\"],[\"gzie@vuqXfCa_AtJfLhEbq@oHxLoZnIvDqt@\"],[\"otme@bfkXiAwrAjWrJf@sJ`|@tpA{NIeRy\\\\xGto@o_@kQ\"],[\"aaoe@tajXwTelArXwz@hLzOhCh^\"],[\"mppe@p|cXsCtcA|XutAq@ngB_Rht@sJzNqKOmJ_d@lG_~@\"],[\"w_se@zsbXze@_]bAjd@cj@xq@vf@uK{D~j@}^n_@hAhPtVkLxElh@waBh}DuY`oAeJdKwLgAoMid@OkkA|Qqk@vU}OfToaBrLsFrNdSr@_qB\"],[\"aowe@pmg^nG_K~SzvBsf@up@\"],[\"q`ze@hj{WoEox@nEcJjTxe@@hm@iN~V\"],[\"chef@jjyWuCi]vKnDt@dK{DhnDfF`u@cSrZca@{v@{N}yArJi|AvTjTnGyKbGpXxBsl@\"],[\"kojf@r`oW|_@|`@gHnJeJpxB}Ojr@qZqG{Vje@yKvCyBuUyT~Ye_@lLyIzWcG}Gsr@`jB__Ab{@eNoXaAceAd_AqfCxKvTri@oz@pRbcA~YewAb^kRxBrXuIlh@dRaS|GjP`Pss@xFmpA\"],[\"qwzf@~kcW|@`]dQkNWmp@~Ffo@}c@rqCmYs^r@cOtI~A`CwLEm~@xP}gA\"],[\"o|_g@z{uUiCabAxF_d@|NeObH|CaJvd@lKl_AeH~IcKkE\"],[\"wkag@~|h_@r@wo@~RaCjc@h~@dMtr@sIjoAeYtg@{Psa@\"],[\"qxag@dlpUdEnKfJq\\\\nM|ZpA`c@vUsC{QldAyKi]qCdHqBjk@wTtg@}\\\\ywA\"],[\"mpeg@|mmUvIoLnRhYle@yKvJrYrAvKiPfRiInf@kKuOqGdr@eLgcAeWkn@\"],[\"i_dg@vozUwIbZ`PaPjT?zGea@pGfr@bGuNiFuv@`Jqd@vLgEvIf\\\\dPtN|@tVsMd@dAbSbGqGhCjTdLhG{Ola@`UtMjBxRgL}GoGlPiCoG{HzcA`PmJdMmg@vTncAsNt`AxIlSuEnRuUxYg]tCqPvQ{z@sSsBip@aj@k_@yKknAoGcAxA_w@|b@kaAnKy}@~TsQvOkz@bAvoA\"],[\"wnfg@vrbUvHtA~Je_@tRtb@{E|ErCz\\\\pGgCpKzYuGp[uZeCoWi{@\"],[\"wefg@dxlU_]ykAkEuq@nEoY~X|InMrZ]nXrKdBvJuNvT?xI|TgA~fA{Vq`@qXpM\"],[\"iblg@tjuT_Uij@_Coz@vf@|{@vAvU_GjV\"],[\"ocxg@`mtS]eNfM|AdCjaA{DpJiO`BaNwD`Nq]m^yFvLkWtPvX\"],[\"{f_h@~xlSmKutAx`@fl@`FrWaFpSyK_Y\"],[\"qqbh@zkhSpGiCbGjTjFiMjLl@_X~m@{Vah@\"],[\"qmsh@licQnDaJzU`LxSr}AeOjiAk[pPkPuvA\"],[\"__xh@hal_@hMyz@yDcRsVfp@sFM{A_c@p[{f@eFwJtDiUpPeKra@zz@vClwAgWpgAkO`@}CrH\"],[\"g}zh@hks^[pNb@`PqC_M`AaK\"],[\"ip{h@`xu^{EaIbLwrADbv@tBjPjHqJbXrbCeNxx@lB|}AvJb^mObHmOaMs@c~@uOglAxK_yAlPfr@bGsXkTacA\"],[\"qt{h@ln}^}Jxi@`Zb{CsCdh@nKx|BrTxrB{c@cb@wF_XwF}{BkFqd@sCrr@mO{eCeHtI?elApGpGiJqbEjFs|@zFzn@je@rjA\"],[\"moti@pmg^}EeaCvRweAcCe|@lGaObLfLwAiiA|TsJvh@voAmD`s@d\\\\xu@`c@^bWtMtm@qUb\\\\bfBPb_@oOto@eO}CyY`]ui@dVcO`g@uEc@mRwZqAyn@mMvCkTka@qr@mcClZp`CeEb|Aca@wnB\"],[\"ukii@hgh_@dRv|D`p@`wEmNru@wAb_CqZxXeGpWwHpjCaf@~lAaOyJiD{ToGrOoBiLrH{q@mB}sA|ImaCqMotAnEy`DqD_PyBfeBuLpYyw@acAoJmlAhIpeC`JrZhKmH`[~f@nPlbBiEr[e]apA{C~R~TdlBgGrd@mc@aiAeNb@qJn\\\\Lfc@xPiDlIpGfCltAyKpxAfEth@cPjwF_Rvw@eLoGcIj|@g[|p@gCaPgH`EyDgFmJek@bDmx@cGogA`Zw_A~VchBoCyMqx@`oCoKtyB{Oxz@i[pKoGeuAoPba@mUdIkOk|@mAs_Ab`@aqGgPzi@_LjgCkh@~zCq^bk@{v@fEcO}F{[el@mh@}|DuEalA~SmiGnQcqAlJaoDbXekC`s@qqC|m@_}@~fAs~Bdt@ocG`WubEnPqe@lTmPla@~c@nGhc@fTyrAeA}`@jFUtdAhuBzYlgA\"],[\"kr}i@jvk`@dBt`C{Ujk@{m@vQtP_nCnZ{gAlRmP\"],[\"{hdj@l`p^f]waBfK|P}@~]qg@tlDcPqX\"],[\"yqhj@j`s^x\\\\}zAdHy_AjTf}@aLndDpBbfBkRjh@ce@aOeOok@tA{mA\"],[\"qikj@jt{_@_Utv@uQoC_Xwb@yrAm`ExoA}oBza@s]~d@dXxVfw@vB``B\"],[\"e{nj@`b`_@zO`iA`J~PrXhCjE`MvGzwDoc@ldBwfAbc@uJ{CqXuzB|Su~Ipa@_r@|Rlj@\"],[\"sftj@t{o_@mKpxAo{@tX}Jwp@`GonKl_@r|AjMteB~GlPnRzE\"],[\"gs{j@hs~`@gIumArWw|ApRxV|XzdAaFxtAk]xcAsJgL\"],[\"gnck@~cr`@`KyKeGje@pXrXxKzv@|Zz\\\\pWbcApJxcAuO`j@cHznA{O_FeIiTuT{}B}FTo@bNfBjxAmBdN_KaApEk_C}S}dA_EwaAiWwh@sC{iAda@rG\"],[\"{ujk@h{`a@iTb~@xKdUpXqXoG|m@xKzv@qGngAsXz\\\\_Aw|@uRo_ByK`P_DqPyFukC`I{@aI}c@`E{Z`Ft^nKyBbYzX\"],[\"{fik@bt_a@?tcAg_@mc@oMu_BaLsOu]q@_e@k`FpGwtA_Fhw@iMzSgCiTtT{pC|Sl_AzYzL|MdrA`Orb@jRlGtMjf@bYbEh@hQiKFqDvQrSrWjPxm@sR`]_PgcA}BfcAwSxc@hBvM~PjL\"],[\"onqk@`c}`@iIov@fa@_aFnXww@hNtg@jExfBsCjQyH}FwNrWoP?|Rr\\\\hIx{@g]xvB\"],[\"q|{k@ljea@dEuMiGye@dTa\\\\j]gkDbPsX`Fqx@fMcJtGfhA`LnKnGyK?z\\\\cLnVrClp@sJkJoB}u@iJsCuFlc@uTkTtK`v@uKxR}Hp~@qFlF{BuO{ExOpA~z@iTxkAiJ}Z\"],[\"}|`l@lfga@hXtuBaAtRmIhDaPsXwB`KdLbiEcMhTkKme@eJbOtExu@uGvQsXi}Abi@knKhM}QjPjZ\"],[\"g~ml@zzna@|BxfJnHh~@aDlcEkId\\\\mXwyLwLisAd]wxC\"],[\"e}vm@ljli@`G_QvIrOvPnqBmT~}EeMk`@oMehG\"],[\"_pfn@d~tj@oEesB|Mi\\\\~I~SlZ~jE{BtjG{ImTc_@bmGgLl\\\\o]msC`@u`ArYakHuD}n@\"],[\"cagn@zc}i@pGo{DlJ`vB~CnhOs_@|gAaMfaAcLm{F\"],[\"u|cq@voo_@eQlm@yb@_EgXaz@_SqvBnGyeDpXmpCtCl\\\\`^_l@dK_g@dsBcbWtJ_YjIp`AmIpyNuj@d{Hm\\\\zhJ\"],[\"sshq@vudZdQ}aAjOnAnY~pDly@t_FtNiT||@q{DlPpw@~EdbCij@hiDsN`IiRj}@ulBzj[iRxjAiMhBmRnXyh@xtA}NrrBso@r{BkEnrBqJk_@hA}xP_CoMmPb{@qAkmIffAstR|GfC?ucAt^oeE\"],[\"wzoq@rre\\\\eDdjH}Idj@cZ~F{N{KoJs`Ari@yfNzImg@|Obx@\"],[\"wcvq@ngaW~F}{A~Xvc@pZblB~EbyA_LpkBqa@v`CiG`bEeH|UcGgCeOllA}MpSr@ueJ|Um_B\"],[\"aeuq@pgnW`Qs^b[avBc\\\\|lO}uAhhN}AygKh`@wuAnYkpC\"],[\"wnwq@lnuVna@yvBvDtjDmw@bcQqQdd@yRpyA_EpiJ{FhT}J~@oU{^mDq^lDodBwKvtAiCg`@rHqvB`_@_yDk@ekCbr@azD\"],[\"kdqi@xxhM@ewAzGgX`c@kTfNp`@mDt}BwK`g@qWp]\"],[\"kfaj@d{~NjToe@rCil@vHhSpJeBpR|u@S`}@}Qty@oGyKyKxKeLin@pGqm@yK|S\"],[\"ksdj@lk|OiQrQaZyDq[vKaRgV{FmrA|Ga{@uCi}Cvn@`pAtp@v{D\"],[\"{wdj@fnmN`b@aT~S~InMbgDrNtr@zCxjBjh@~kBcEfrAiTdRmElm@|g@vqEuAztFnN`_@jl@|mS_Cf]_MhLePiUyTsaAmKImIj`@mMdIa^a~@oQ_bEi]aj@_Vix@}_@}gCip@ikAyHir@{@c|FgY_|EqUar@wJcyAwx@_lFEwOve@?~Sh]zOcuCv]yu@tX{LvK|XrIoe@hOmGxIfrAfLz@l@ijAlCiU\"],[\"_ykj@dgrNVu{@`PuX?qr@bMnTfGkZ~NaNmP`v@xJ|AUlGqd@jc@[lv@uIlK\"],[\"}rek@dukLxKyKrLdo@eYlyC}CpJ{GqGpLd_A_VtnDfNdiAzNqM~RoAxL_c@|^kxEnJ_QlPdLhCmIaA{YuLcG_BgOvHylBxe@h}Aj^kk@lTpn@aEfiEgP`n@cOmt@kLqI{C`\\\\rTrf@dF`o@ei@zcDok@|gAydBbjPfPzcAsNtI}Pkr@_MfPoQjfAnKjLul@hbCom@xTad@l@ov@vn@iSgt@_MqdHdZwxDg@cuHp[wj@lTsL|Ysy@`Eit@bLcKjJehAfKmOpGzG|OwpAyJw`AhRgU|h@wvDxZn^gIyeC|S__@\"],[\"ocok@|jcNbCcPrL~OpKvr@``@eGjS~RsBrvA_d@lmDiBwbA_Xos@\"],[\"ctkk@jhkL`He^jGkAxKfKfCn}@uGdOlCdh@aPsXsApNxN`}@}Gtz@jFbrFsJdj@uCk`AoG{AeH~nA{FpQ{LiDuRhb@}c@xuEjC~yGaVjeCxBlqLm]|d@uMcEgJkXuMixMmd@aqIn{@qeRh]wbBsGgM|Ein@}EaPjPaj@xKcGfIhx@nJrNpGoPtTtcClSabCdDwHdGle@\"],[\"qtsk@to_LpGoaBzS~bBVvi@oLbh@\"],[\"}uqk@t~}M^hoAal@n_G{@pdFyOjxAc\\\\xtA{WqxAcf@whAeMutAqGoOe_@vEeOi`@uGshNtkBu_WjKwKjE~\\\\c`Ajm`@pFbYqAm`AbZeeH~Iaf@uDki@xj@upI`KokLlP{gAeEwk@x^dv@jk@f`\\\\\"],[\"ifil@lolK`WycCjMoCjLxPrq@~rT{]vtAak@jhI_Jl\\\\iMjCa]i[{Iae@yKgCsIgTeaAqqJhCkeDpNgd@rJmqFl]kx@nGkC`UjO\"],[\"itpl@n|lJtBiFtJfOtK{KhHpCzNnu@h@xyEuH{KwO`j@_v@aeElAudAdMmZtO~d@\"],[\"werl@dyeJ?ii@dL|m@lV_a@lIGlC`bBa{@zbA\"],[\"yuul@f|aK|Luq@`z@hdBpI~n@d`@vhA~Ax_@yThwDgu@tyC{Xgv@m[_Z}VbAmW`e@qGqGiXyiAeHawAhEy|Ad[amC`NzM|^ffClK}Np@sVgQiOHgr@\"],[\"aozl@bfqI|c@pr@|^sZpKbcBxCeJ`OjD|UbeAkDdPcKaVeTh@kHflAfCpr@sNzJgBlZg@zhC|_@~wEsL|iF|C~i@w\\\\zTuT?}m@a{@iTna@oHc^wb@XyRlHsWfz@g@g}AaNqs@gHokBpTshAvSqUrCaj@mMw`BoJuTs^jA_PcUOwYzKkx@ft@{`B|`@vjBgCd{AlCpMtCca@rExaA~JvEbG{v@eK_`A`\\\\zUxMeSbMabAxKoGtOst@rCix@yIgiA{D{JyKdL{MmHmCayB\"],[\"yllm@|hiJpa@?dZvJzEwJxWv\\\\vFrR}E~Mmc@cNyKfCmcC|qCsZ|R}_@gF}HzEegBp~BuWpOgYqCyOcRaH{p@zCo|CoGhT{G}GBd}BwXd[a]zIkGrUsHyE}NuYq@ir@vk@qiC~T_`@f\\\\eG~q@fe@f]yKvz@hC|i@sLtp@ip@dIa@tVr\\\\lI_C\"],[\"ajkn@`u~II|hCaK~WiDcWcg@kx@wYkxAmJwLy`@oJ{Mqf@kFctB~l@kt@lOtJn\\\\pr@d_@hVhOd`@\"],[\"exon@lr`LeQ`N{_@kl@kQe_AuD}xArMbi@jGnJbJuErRjl@fMxD|K|t@\"],[\"adpn@vhxJpJca@pUmPhKbOhBn\\\\o`BjaVuIi_@nAcpC}OmbClj@g|@nUk}A_AwqF\"],[\"ajrn@bmuJw\\\\eg@oJfCwY`pBgX~NhU{gBiU}uDl^zh@hV]bGkRvdAvfCjGde@iDdQqRJ\"],[\"abun@d`mKx@~hCcY|dCgL}pAi\\\\ypA_Cct@zMmk@vYg^dU~B\"],[\"_jvn@dxzJ[f_@eD~IuJcGwUlzAiYbFmZ{FuE_Wl_@yuAft@ao@~D{W\"],[\"eb{n@rnpJx]kPvHzZdVmQ~EhsAlLrMjPsMhWixAlEeIjL`GzNpoBwGfHyR`qBwOmGsRnDwc@t]wKmO_bAezE\"],[\"qn~n@xccJxUbq@lRfbBzG}B|DjYiChaAkM~Uqq@}aDjAmsAnDmQ\"],[\"oy`o@fqbJaOxWcMcBsZyo@rEgy@``@ui@vj@`_C\"],[\"ei`o@votJlHtuBaExq@{^jaCqG`C}aB{{G|AyaA|GrCnGtcAtRgOsAciAzI~OjGn_AtIvTfCuXdLvi@?saBnGoGhHb`@c@rsB`J_d@qD{}@hFa~@lP|gA\"],[\"crfo@`muI}Bd|A|BzL|GeLnGzv@nGaPpGnv@rDwJvCnGtFji@{Cvi@oGwi@eXjf@a`@yVsVujAe`@ca@cXuvA`k@w{Bxo@d[\"],[\"i~xo@tnmJwCip@qUkbAkm@_jOr@_o@bt@{zFjd@fz@x|BhkHn]~qEvCpaEix@|zJm`@x`BoIzeByp@kwEuB{{Akq@xr@wE_mB|Me`@\"],[\"}azo@xh|JrCdrAuT`]mPin@cDjFuLiUwZqFmUs`AyI}tAz@oxAhs@yyH\"],[\"gtxp@~iyIk{@lkHmOni@oIeb@yEslGvaAo`EfR{{AtIvZ\"],[\"ws_q@fa{Jke@plBoVzOeYiAcOwyC`Q{|Ad]shB|[oXrPwpA~|@iuCx]g]kBxaG\"],[\"ceep@vewg@wKxtA{H|W_VtQzAymBn\\\\{fC\"],[\"uoip@hing@oEzb@rA}p@\"]]},\n" +
+	"{\"countryCode\":\"GM\",\"polygons\":[[\"sd~F`ffIuNrScM_Fig@fLuc@a@c_AtT{XiKuNm\\\\_x@qs@nPqOgEcUnIkOhJkB`IjWhY_Qb\\\\_Bf]a[j@s^dSen@r\\\\EaIgKxDwLo_@lV{DjNuCqa@nKeEce@}gAhDmPvd@q@wKun@xI~\\\\sm@jPkWyE_b@ka@xH}fBmPglAnAwaAgW{z@`L}\\\\ia@ko@lL{Yu@y]tUcSrG}o@aHkUqXiEvV|O\\\\tQiI`g@cVz]nC~NyNxg@jWba@qGje@tVb|@jNdgEyGb_@nx@bqAaA`IqX?pX`PdG}G|Hfv@yL`qAec@mJ}i@b`@af@iDgWjGjA_kSgByHod@qBod@iQof@{l@uI}[dCaZ`XgV{o@_iBrWogCb[_]`s@wP`Vwe@a]kfAdMqs@xRqSdu@sWfi@m`AulAujFlVou@beAsr@~^lAnYvPfL~]fSbfCz_@`t@yBlgBaObf@aVhWsD`d@s[bf@rFjj@iJvj@mc@tc@s[rbBinAltBd`@nh@xdAzM`[x]bJx~@aNtvAfRzn@yAf{@vOzmAlmBbHuCblOvCxT|b@`X\"]]},\n" +
+	"{\"countryCode\":\"GN\",\"polygons\":[[\"}qoDdxbGmHxKqOJ]oLet@}b@{Hkt@yEr~@kk@uObMxLzp@`OuItTsi@sCyE|Gc^|@qJcCnG_JqX?fJj_@fh@nL{GxSqs@bNui@cUfL~i@sRj]m[dCgm@gTrf@lYlBpJ{OjPeYdGfc@buActAw}@aq@s@_e@ye@~CzYcf@l\\\\zBnGfd@}G`MvEnEfi@{a@`L_fAki@sS`AqClDhY~Cb]x\\\\lGjWwEx`@iaAb_Aoc@peA{`@sFiHcOjJqOgXnTpHjGoItO_r@wi@je@zv@~p@`Ft@z_@ea@~Nu_@oa@kIzGhMpHvAzR~Lo@rM|WoTrPcYeCbMjOum@raA?pa@jKxK}TjGeq@y@wz@bt@sr@cGbSje@gAje@uw@qC{Sy`@oGdSgm@mGyG}CvBuIwRqLyx@gV{JoLk]}DpBhKrT\\\\~Vp_@faAbb@pf@xj@iPlA}L{NfCbPeGpGqb@uKq`@y\\\\cChGd_@nc@`h@fVaj@vTuTsXaj@oGbU`SbGpU}m@oGkGjNgP{EyOao@qTuHwTg_@mPxKvNbE|FzR|UdHjDjd@_KsBgHnHj\\\\nSsBlRsb@jKXbNjUkKvStFyKh]ba@i]~U|PxGdXha@iC`PaPhNpGiN~k@eh@|\\\\{VgBgSa\\\\{VgCui@ue@gqB_{@sgAyTwdBehA}Iah@j@ylAa|@qpBmc@}]~C}nAhM_ZPu_AcWqj@tEqLsEiVqi@eI~B{Mp^aRe@s[eLaXcvDwB}Xls@k\\\\rTa\\\\nn@sGx`@sYgCgNsLqc@}_A_@uZbM`GOki@oUs[sfBmTcnAdm@av@}BfFeiEbMkh@yGaa@lKkr@q@kmAfSwIzu@rMbZeRhBkk@aj@uRGwUxX}f@zPsEhGma@fJkExDimAbg@}`@}Pkv@rB}VnPie@x^{Z_ZqhBsc@_v@bLoaAgSam@`Sus@bAcr@eYu`B{@}iAdNgy@bKBfErc@bt@s@d|@nf@fXkJ|b@e|@di@sb@tQid@iJks@mf@{R_l@cu@c`@yKsIs}@bKmYpe@cXnYmg@vl@x@vqAg{@kDeb@}i@yXeP}`@yLjCgUc_@wg@eAs@eh@cd@eg@nGoPeUsQzE{FwOqZ|j@e`Crz@muBtOi{AwY}Uch@_FsUi\\\\aVkeAy\\\\}n@bDep@oMsM}u@gPaGvQeO~Cyc@zg@qX_p@xFaXcFsPpQciAn{@cjBxZ_Gnf@nSrU_@fVyKcD{RdOqPxlAbDlS}~@vu@wL~eDz]vLwj@oGu^t~Aqj@hO_r@kK_Oth@wLpMm}@fSw_@~Ov^jHaD`Bk\\\\dOpBbKvQq@tt@tZzBfMb`@zi@nO`DfVdf@lJx[x[d\\\\`LjOkKaKyjAwq@s`@xEqz@uFwX~QuYn[mLp_A]xdA|VhEmObJyA`kCuOzu@ua@bE}WqGo`@|h@mK`Lk}@|b@yZzk@_Af}@x\\\\jN~Jl]zx@r_@jRvNoJbJ`T~OuBzDmJnWZAiMhL{AfcEhTdgA_k@lOuSjAaf@{Syp@nDa\\\\nuBvg@vUSnKy^}AgRzUiNva@{n@rJbq@fe@n`AzqBdVfRgEpS_`BtlAyaAxiA_Jnh@nEzf@_P~Blc@gJl^cx@~_@fAtLp[x@xCrIiGp\\\\o^jRwFrOfBrnCzVj]hm@oG~y@fElW|K|`@}r@pQ{{@wLkr@xq@fJtu@uc@wKz]|Btb@vk@fE|m@b^bXl@p^qSprAhd@vW|WlYl@~R~Pc[jm@dAxu@}O|Mha@bk@{g@tq@og@P{BniAfl@rXdb@fFrb@_EbdBzsAdm@wCfNfi@uVzRlUzMlEl_@h^xx@{\\\\sBmV|SCvPeMnNyr@nP|Avg@cUlK_ErQl@fOvXbSeZdRn[z_@{b@iNyc@_`@}T}Akz@oa@iNfLaT\\\\ie@}KulA~r@ms@pCqn@uL_ObPjMdM_X_H}z@nL_Ll]aq@aLs]zQaQeXci@d~@jJ`KVbc@m_@cSkLjBaM~_@h_@vJiSbNiz@`Po@rI|c@bI~Jj_@iGzGpFrk@hh@lhAcJ`Jme@aAgM|k@|AbTpYr^kI|CrEj`@iFrq@jNrWvo@x[fq@zy@nClJ}Plu@zQ`]|SpCaJpK{dAkh@arAgVoq@qh@wJye@qXkLixArhAapArFccAcJqQb~Aup@iAge@{b@gr@iEep@nw@iClh@cIuKk\\\\bZob@m@{KtNiUjDePbUkZnJaHvParAvn@qt@bgAkcApWsGtl@dAjmK|j@hFp\\\\dhC_a@rsA~\\\\fyBvQfY~gAbD|^jd@tr@hNv@zOr[`I`EtJjkArSpPhLxD`V|TvAzFlRd`@hXjH`r@qKdKdH`Lfd@xKzT_KlBrNtn@|Zp_@ju@oSfx@\"]]},\n" +
+	"{\"countryCode\":\"GP\",\"polygons\":[[\"qdvHfejd@zVpLpK~c@}AzQm`@xOq[yMwR_XrLuZ\"],[\"og~Hfefd@rZrp@_AjMmWy_@\"],[\"ma}Hxdqd@l\\\\iA`ItR~sAsL~_@dD~UtRt\\\\z{@mXlCaNfR_m@jUkkC~Pe`@ed@l`@ikApKuIzBzGzGkPoJcH\"],[\"ka}Hb`qd@w_@iIaPcSyK`BwDnMaa@tE{f@{k@jXe`@|r@oHjXyP`I{O?i]xp@cnApDfK{G~^~a@hmCmRxk@\"],[\"o~tHlrrd@bNx@{BtE\"],[\"_cuH`nqd@^In@S~AlBzChFbAnEyA|@}AgB}@kBiEgBs@wBn@uB\"]]},\n" +
+	"{\"countryCode\":\"GQ\",\"polygons\":[[\"qvl@wk~D|x@aNrVg]n[iiAC}~XbxU{GuAxoX|Bj]zb@rDhHhPkEdUy`@zc@wDf[kd@hB}Gg]{Svx@xBf_@}S}Gxk@hn@rJbc@gl@lSeJxOrLboA{j@b^kJaPms@_TaUmXsz@sI{\\\\yh@qmAsr@dMwZuTit@fNfp@_j@nc@}OkEu}@{}@_eBgx@ycBh[{}AwL_f@dK\"],[\"bm[k{lBoMtCwI_HbMgGhVzIqFpI\"],[\"eghAugmDd^yUzl@~CfgApw@f}@j\\\\tI~P`p@jEpiAhv@mX|t@rCdXmYjcAoh@rPgr@qKcY{RCaaAi]|BqZ{\\\\wx@gCwo@qWsT_UeFsXzGzB`CkR\"]]},\n" +
+	"{\"countryCode\":\"GR\",\"polygons\":[[\"{y|WinxK_LagCxDgeAy\\\\mcA`Dk`AjTal@qe@u_ApAgq@eXkJ_w@cdAiZgH_a@}uA~Qoa@}W__A|Ho{@xKyBeCuk@fJqk@cFuuAma@}`@hXwd@iO}Lk|AaNiKqQrJcjFmImYw[mN_Kuf@`Q}VcRiv@j@q|@tOc}@_Skd@l@kr@uPeMsEqm@mN_ZtOma@qOw^~FgTiFyIef@|AyCgj@rLq`@cOcFgNi_@jLe`@vNaEyFexAsLwKqF{^xG}KzaA}]vRgiA~d@_n@LmKi[sD}C}r@|f@ueCvY_n@rZsYlEgh@wXchC}T_\\\\pJioAkZ{pAbVkk@s`@itCim@ea@y[pQ}^wIwM|R}f@|HcStd@eXhCgWud@sLshAvTy\\\\?qt@nW{gA~OjF|b@_eAkL}Jzm@?buAqXhY|AvOvLeLlj@xt@`oAdKfx@xIuHxXhHzTiH|QtJzC{LfX~GtSaMdEsOrMxBrIxTfOeOxVlg@~I_EcFfZtTfLoGbGlTvK~I{B~Tp`@vg@v[bFdRy@nV_c@jI{ClLuF{\\\\gHEbCbY_HrGrL?oUzp@{I~lF{q@xsCpN~S{Hjt@sKd\\\\}KyBsFsQ}GtRpA`}@fIIdL|\\\\nOd@lL`|@|h@hw@mFrUnGrb@mPv[dLhTey@ve@eEne@xKrXzAdl@nb@lp@lNsJhG~NnE{Tr_AboCuAjlA__@byAtR~cA`RtTx\\\\_AnPsa@`c@eVhPw`@eAiYpNiOlGxCnJrs@n[iA|\\\\}XfTyz@iCcWsHuDu[vK]sK~r@om@`Hyy@hT_NHmQbuAu|ArOqEpKlStAde@gStGqOz\\\\ev@hj@_Ur|Aea@tr@gCj_AfMdm@cEdQb^`Vt`@eMz_@}e@rRyhAz_@az@lPnGvMaPrNhJ[qN`PuClOnRnHuEdC~P`HiLw@tk@oJ_AfA|LwIe@iU~b@j@~KbGs@mc@|XsOaDqlArhAie@bsC|I|l@`TpNlo@uM`CcVp~@m|@bb@cjBn[{OfEs^bJ~ZsEbu@e]|yApE~cA_YcLs~@hn@ak@uJwd@|Now@vgDq`@vg@gChn@aS]is@pi@mH_jAeU_YsT|XiJ}CoWlWdBhb@x[bAfMtTv@xb@x_@tLeFnf@|LvIdD{JrMjNiDz\\\\dGzMbNmN~Qu@zZca@n}BzbAdeAwJvVcQha@gbAnn@cMhz@{iAdbCwv@fp@uaBr\\\\uPdqA_zApxAil@rPpYfKfx@nGyKnGrOdI`v@{Dtf@}]eQmFsS`a@lG_Rmk@yM_EpCeJsPeJea@~XkT~BwPpT{KhfAwW~LeAze@vUhCbLuGdHv}@dIdIx^?ze@kPqRkMvEqN`a@uJpGsHwK?~FuNv]cVxK?bNpW`IeBmRcp@jAcRjPb^z`@fWtCh]zGiCbGxZgCl_@tQbEpJ|ZnB`d@{I~GjFhQyUbi@xUvr@sCpG`P?{B{\\\\bIzJ`IaQyF}I|GhCyKgcAbj@in@wA{^jRuVyKy\\\\|I_RbFm{@tbAaf@sHwo@yFoGeGnPcCuJxSmiA~LyNhOi@tN~WbM|CoFwLvSkm@`G|XvJdB`GgrBcLwMrEcN~OiTxKjPbCeM|Kj@pFkR~b@}\\\\hKeViC_l@|_@shBzt@whAdWgFtTfLwFnLdLn]fr@qKnSwMpXbL`Y?~JuTzGtTbPoa@~XpGnVkTlSlBzJ`M|BqGrCpG|Q}FvVrWeOzr@c^|BvB|K}d@rKtCpGySnMwLz_@dLpB|BtPwtAfr@fC`j@kKqGuKrq@yCzCkXa[aOpMzNzlA|Sda@rCkTzBzKLxpBbf@|qA{Gjp@|YnKpT{I_Ao~@`Yui@tT?tGz`@fKpCdLca@~`AhCdp@ahBsFyMsOX}Yh\\\\yKau@bWoBbZvQlPy\\\\bGtOdNms@jR_\\\\xK?jNlp@nGzqBhOlStN_]vDnx@tV_J~@zTgE~OoGyKa@`WcFxDhCyKgIrF}Dx]u[HdF_MoU}JpGaP}UzD}Ext@gCmGyKlG`PrXu]bNwR|c@YhKpGyKeBjVhJjRyKtToGiC{\\\\|\\\\`Rl_@|k@qAdf@mT|SfCba@ie@`P?pkAqu@jl@bAu@g`@pP?fGyNEbJ`^mU`\\\\mF?bLpGcLjT?rj@kTv^s\\\\lQ|FtC}Sf]|N{GzUlLdLrSY?_P`JrNbOYrj@a[zVom@|SvKd_@cj@vMtCqGjTpFbMuDzZ}QvPgKsBiQdLj@bp@qn@~YkT`a@yM_EeSl\\\\|HzLs\\\\i@cn@zQaIh~@pOrbArYa@zJpStUdLfCmNbPu@iKrJvAtIr`@yD~DjGmL`D`JxK|q@q@pc@qNhn@xKke@dLwTle@bCzOsRxOoUaGcD_LsO`HkK}G?zKcXmA[mIwFfKa^gKpAjQsl@f`@c[pGko@zj@qDhLnHtNeOfVa`AqHuGt`AbYr`AhpAjLpXiC`P_YdBtN|MvDtWh`@ir@jd@nGrc@kMnb@wRxJ_Y?e_@mLsLrLdBrKqGqGpGlKaUjCgr@rn@uWpDsn@}Aex@mbAiSmGwp@bLmfB~lAuy@j{AuGxc@vAfKzObFuTnGq`@gGwc@zOu_@vdBmX|Hui@cPnIgRgIcZcn@yhAqGrCjKhTcaAsr@e}@qCtTkTaQvCn]{kA~Eo|@q^kp@qw@if@m[it@zAoaAtMig@~VwPjCog@tJqQlUiJrNub@xDmeArXgs@jDm{@df@qhBlnAwsBeCmp@oSeQca@|u@]|S{GmLkTci@jNqmA{Ke`A{MiZaYtX{G}\\\\eGjz@oGyK{LjOpN~a@u@pTiCkK{MxOmDvl@lJlQw@qPrI{J?~d@g]pGzGxG{GzBzGbj@cLpXiTgTwT|~@uOfL}IuDaQtVpJhK`EcKg@dT|OtNnGaKxKzBtDrVwnAxy@eSvh@rVgCvMtT`IqNvSdI{IjaBbVhU_^jb@kWdlB`Tdp@uJ~\\\\rSr]`UhKmQz[hC|gAna@br@uTzv@bLke@_P?iCpG}GqG?jKoGyBrCv`@wQrB_BfW_j@zI{PnVd@tGle@}Z?|GfVn@fJj[nG?kApLlGu@pMpSmE`^xEdDrCuJ~JvZoSnHiCna@{DyKqJIrCpGeLqG`FqCsNyOgFn`@uQ^jHoLkHeGtCpGi]?yB`PiCyKuCzGwQwExK|g@qTnGcf@{Cua@zU{L`m@gy@pRaNnMrXh]yErCxFdRq]nZs\\\\qKqE{a@oWv]q_@sG~MkFnGeUmWwYhTgC_@eVmOqIpLuaArTb@`Tgd@a\\\\uIvT}m@yg@hVgb@kKwg@tm@rO|B|G|XbGcLwNj`AaMzJeGqGsCl\\\\bNtTjIkKI~XoBkHgIh@mJfFrCxKuLwFgMxHvMf[hEcHtRzC`PpOuC|GfHk@z^_u@uGzg@dPpRe]pSmIoG{b@hQa_@rw@k~@bhA}b@eA{Gnv@vDnPaIlXoQ`SqRh@eMbLoF`TcGgCmP~OcCi_@_]h_@p@uWcIoD}FdY|FGmNpWfA|]sXeGyBmPy\\\\oCk]`y@wKtv@tE}v@r[gz@wQmPpAgTuL{@cZq^}RzMmZuAhKgx@cT{Nu}@jp@yi@pKgCcp@g_@}MqJgSeAsyAuOsj@qZ{KoXzCsUoUqf@u@wNmV{c@cTcd@CcHcW{TgRpF}k@cGqNoYeLqZae@w}@iIeaAfx@}UqM\"],[\"qwgTggvM~JjCdLuGiTpv@mKkT\"],[\"q_sTa{mO_CmUfGtFtOsCf]npAkMtPq_@k_@\"],[\"awpTuaaOpa@la@pm@}SwFoLn\\\\pUzGsDxUhIdh@hYzRbv@z@fYyKtMUje@kJbUxSnmB{Ch`CbQjz@iC`bBhVxlAbMp~B?jnDmOmIkj@}Ae^tIyJ`UgEhxA}[|f@l@zUqNtd@aI`@nD`cAoDbn@}GtGPpiAjGvTkYze@h@pt@kL~m@vOpxAqGb{@yKhCiTxe@qG?qGwT{_@~G}d@yTsIpGuXcJsTdFg]{G}UgNhv@oHhMuWgF{c@{XqFez@`KyV}QtHmV~I~Evo@_FtXyVxGeaCcScSYyUiUtFcF{SxHki@jZuKxPtKa@r~@jIvDb\\\\ufAaPch@fcAcGnLsm@kL_v@nCoUmg@q_DnM{iAyKea@xKwe@yKqGxKgr@n]i@tEqLX{yDhZy]jBuPqa@}_CpGqaA~X~XlQyAeKqL`N_DpGvOtf@dKz]{HlJwh@qC{Taf@oh@sEcr@sVir@fJ{c@dEbF|DmLaFc^io@_q@cAqTcP?\"],[\"mwtTmnrOjIiL|RN`Ad_@vJ`KvOuA`KpQgIiCsRh^}G}HcGfCgAmIui@bBuVt[uoA{t@_s@s@?mPoPwUhCcFb[xK~^iCpx@xe@vLc@be@_f@jIzH\"],[\"{b|TwseMhS}_@hUs@_RnV\"],[\"ucdUim`MnVwg@|S{O|Kl[xk@~BzChe@cj@fr@mp@uCg`@bH}^{Y\"],[\"ureUwsuNiJcSvVsu@hGdDiGhQnBnk@\"],[\"iqfU{pfP`HvGb[qEpHtN~iAz\\\\r_@pS?~OxK|Ih]`NbGuTlPxKxKqGiCb{@|Ude@tdAp`AzMj\\\\uNx\\\\oVxKys@eYo\\\\{@uYd]{IcAoGlPyWyu@gl@oRwEkT_d@}e@uu@ugC~@}g@kRwQ\"],[\"wngUyvnNna@u{@b\\\\iEbUbN_Hr_AgCy\\\\aPcPsd@hHuHj`@\"],[\"eufU_bvOdJxDtEqFcBmYtQpG~@l^w]tPjKrTcIhJoVAtCgGuLo[\"],[\"uqjUk{aO~Jui@nUeUlE|x@pRlN|NQl@h]sTzTyWxAsDySfPg\\\\yKgN?sV\"],[\"msiUui_PlPgCzBpG|GqGfG`T}BdHqGeL}Jpb@qDsH?|\\\\qXmPmPy`@fCcLlPx\\\\tCwKsLkT\"],[\"wbkUsudNpXyp@nP{HwBnXif@hr@\"],[\"}dlUmcjNNuW`Gn@nj@lw@Y`Ome@iV\"],[\"gzlUsahL`C{H`]j@yKnGtCpG\"],[\"}ulUo_}MaL{c@vG}Fvg@?vMle@|GnaBi]?cGqGuCpGiTaPhJuXxUmR_Ba[kb@`f@mEcF\"],[\"_pmUmh`NzGuM~M~B`AbHgTzUqGoG\"],[\"}dlUsrlNgCfLqXyEcPuZ|\\\\sXtTke@lD`KnNeBvNvL\"],[\"wqlU{vfLu^eBmAwP\"],[\"mmnUcs_Nv`@bUs@rSqSrJsPkX\"],[\"iknUmpoN{Vqa@dUJxFbZ\"],[\"mzoUqysO|Zym@pWnwAlf@ts@}Gnr@lLta@~FbI`LkE|GtKtOoCgVna@}]kDyHiSpG_Pyk@_n@m_@k_A\"],[\"ikoUmdsNmPsXvKuThFnE\"],[\"ympUu|zNn[_j@h@~i@h^xs@x[bYrNplAsNhIoGqaAaP_J~AdFqJxBg]_nAwKfL\"],[\"kvpUuzhNkIhTeWdLmTi\\\\BmH`j@nP\"],[\"}irUgoaNtt@ke@b[na@mm@zXuLqCaPjTeL?\"],[\"crpUwrlOir@mYsG|UeTvR{GsArXc{@fCdG~XkToF{NbIkF|^lAuCj\\\\lMfEfA|V\"],[\"stsUs`wNtChCqPnKoGy`@~Tk@\"],[\"orrU{vlNtf@`x@gPdt@c^_E}OaZoAxKmI}GyXmv@|IfHlEaUoGqG{GhC?_Pve@|QxKoI|GpG\"],[\"snuUypqN`Ji]nUeJ|lAhFp]tPp^b_Ai]vOmKjTe]fC}\\\\dXyBwQo}@alA\"],[\"qcuUil|MiCtCmIaSc@s_@bm@mF}GnGhTxKqG??jTgGzAfGbVeRI\"],[\"ghwUgvbMrIyQ~OmAHrRkRzU\"],[\"y{xU_ukMdLtW?rYrQdDxIpkAca@yv@\"],[\"muyU}|zMsOiCi]ke@rXpB~Ss_@nL`BpCrN`TtDdXjn@ke@kTqGpG_P_C\"],[\"kyyU__oN|Bbv@{^cKcEbKuHqBmKaUlXiQsOiJ~Mei@~MnBvD~]\"],[\"edzUsdfN`T{K|Vjr@wTiC?bPaPyK{BnGqIuQqe@t@l@mT`MoJdO{D\"],[\"mo|UipiMhTe]rFvZsF`[{JmW\"],[\"c`}UaaiNqa@jy@sXbG?ui@jTuTkCwT|KuOgHsHnLoq@jTqGtb@`ZkMpw@\"],[\"}`_Vga{MbRiPjRdC`u@vr@`CnV_fAuGiKcW{Jq@\"],[\"}`_Vk{aOn_@|Yhf@hv@vHd_AzUvj@~@~q@mScAwv@az@nB_lA}Ycs@\"],[\"_x`Vk{jMxBsIln@nVlG|XmGtOyLyE}JdTsPdE\"],[\"oz_VugoOe@cJ~LpA@vd@zPvw@xSlX?|k@c[xU}Mz_@vUtcAwf@hJo_@so@qLu{Avh@ybBsXbLsCe]pFK~Dem@bGfT\"],[\"gcaVkfwK|OkIbU_j@bR}DkNdY_Exd@|Rn^d]cAb@zRajBfyB_Y?wg@kh@vAqM|j@eZpJ_|@\"],[\"ct`VybgNdr@jT}S|Xg]|MoBxOi^j]|CjI}[dNmQfV|F|LeKc@iTjTw@kF}Qf@oTmt@dV{^b]mJpD}`AvDcEl`@tK`P}X~XbP\"],[\"secVgehMoG|B_L_LRo[yTgQ~GfVuC`WoGcU}GlPrCsXmJyCE_X|EuJdUyD?aPbGjTpXdLuCpItLjT\"],[\"_lfVo}sK{b@jiAtAdTvNxHyKna@gr@rXdGsXi{@hg@`HzI~t@r@tMf[}QtT{f@{B{`@iX_g@{CxKgCiK{Kr`@i_@oPw^aa@UfCwKmv@lG?gThkAw`@rMGbO|OzZsRaN__@|GhC~@uJnVuHzQmY|g@_\\\\xRiE|SdG\"],[\"_zlVkrsKxKaY?lKf]iOtCtZeLvRucAx\\\\yK~N}GcBfD_IaQ{JvA{IzUvE|G}\\\\`j@jTzBsXqGnG\"],[\"s~pVe_xKna@|S?pG\"],[\"gxoVg_~Nd^~JjB`MrKcSf]fHtgAeNnJh^`M`FlWeE~L`l@dZzQoHf[ma@z`@~BzKcP?l@xMoQiEaPcv@sT_F_CkIiU~@oO|Vy\\\\fCyKx`@{X`\\\\_QpEuUaIoLkgBnFa]n\\\\sZgNaM\"],[\"icqVaxrNjSeTjZbJ?rZoP?iTnV\"],[\"egqV}`qKf]pXakAaJqw@eXwi@is@bPuRrXeF|^FnX|RzDuDyOgIdq@`CcF|KxKiCxDh`@nNX{Yh[\"],[\"c_uVy}`N~N_CjHzNmAhZgKbGzQhT_TlTqG_YmUrIVl_@}S`P?~XsSgOmb@TiG_R~Ugj@zi@eJ\"],[\"ohhVif_Nzm@zCrLcHpm@jPzNbw@uc@n\\\\lN`Z~HyHjD|Qyb@fc@iGgGiZlXqAnr@kTiCqEjPkRkPo_@|NgFtPr@~GrG{BuAbMm^aVmG~DrNpZ{JkB?tGkT}KcLb{@yK}Xq^lN|DbpAqGzi@?rxBoa@vTtCvKu\\\\cYsb@pLuWbcA_TrCq[h_@w~AhwC_Ltc@pApm@mPlScHzh@|EbGhGgUhKjE|L~{AeMOmQsXe_@qcBwKkEuCqX_TwCeJuS_Acl@}Sc}@bSil@vYcGfGsXxp@uNxUsQn]}eA|J?lHui@gCwd@tYiR|U_`AdGkw@oIwUfCqv@tOqr@|IjQzUErn@s`Ad]`ZpTlA|OgK`BvJtQaOxXqAnZoVfg@?hYqm@tO{I_Bc]xNgt@\"],[\"iq{VuvhM?rX}IcAk^{k@`UoYd\\\\v_@\"],[\"{_{VqhmMeQdYud@jX{ByGhi@woAbHjAlCgh@bTfSjGdZyKtc@\"],[\"am}VwjhKxe@me@_AvSqO|QuOdG\"],[\"i`}ViorMdd@lXxH|e@sxAelA|GfC`AwL|KfB\"],[\"ytyVuwfOjVhHuCfc@qIpKm\\\\qC}QhVjEt_@`z@ycAdL?xKxkA_Zj{AkFddAce@ro@}Gel@uQoRsAkTk[mi@_KlGqB|x@f{@dw@fHzSs`@llByT|`@aY{KeCdGoRkIqVqc@tK_KyBo{@yKqGpGqGiT{~@qa@O?c{B~LtCfOgf@j`@`UldAmaBrHpD\"],[\"mvcWsogNpJ_U~o@`f@ek@dD\"],[\"}daWqlgKta@_OhGnF_h@dxBqIyHwBj]sHhGy_A|Uy\\\\fq@e`@nQiCnVqZ~DzDpJ}Oz@_CxMi[sXyDgqAqL}_@~Xqr@uCqGv]zGbVrx@dNp@pGgKlGpGbPqr@rLnAMpM~pAuU`Tc{@_Dw]\"],[\"s}lWeapNpGdGhTeGjNd]bK~HpNaAdGpXrCyKnNrPtb@oQjQ`LoKp`@mMbBaPd_@kRsXkQdQ`On`@rCoPjFhRzPpInAqNnVuFqGpk@gYqLlLdQyKpGxK|m@yKwKyKvKkYgLqm@vToGyvBfJmUlVcNsPgf@qXiT\"],[\"y~tWuwtNjN`DnMdgAgr@flAoSwqA~O_{@pO_O\"],[\"mrzWobcNhF|OvH}OxVzK|b@}GyCfNx]zeAc_@ta@uIvj@iP~Ac_@kLqt@acAvK{v@bNqF\"],[\"grnUunqMbB_AtA]f@|AsApCoCD\"],[\"{bpUgeiMzIuAuNzG\"],[\"a|bUqkyOl@w^`Hk@tHjUuA~f@oMwC\"],[\"ycjUc~qOzPuKvJ`DWti@cRuC\"],[\"ygtU{ykOvPmHnCbVoGtFyFeH|A|TuODqUrZ_JgUbG}XxNpB\"],[\"crxUgofO|HqCyC`TzKvMn_@yJs@hLuMbJ_l@{Ca@cS\"],[\"ss}UcebNhJc@h@lMiGnc@aNgb@UoI\"],[\"y|qVwaxKtNdA`[lk@a\\\\cJ\"],[\"ydrVsguKtVzX~RiXob@lf@gMy[\"],[\"gr_WyiuM~L}FrSfRyZlJkKqGvH_F\"]]},\n" +
+	"{\"countryCode\":\"GS\",\"polygons\":[[\"lhhc@`hsOqKoLSaz@~S`I\"],[\"f{gc@jwtOtTaPcXdaAmCun@\"],[\"vm_c@rbgOd@}h@vNqf@jRvj@Yvd@mW`P\"],[\"|itb@j{_OjRyKjRhC}[`rBaOdPaYyOy@aiAvKkh@\"],[\"~chb@dbcOiE~`@yQnTiT_a@bXcYtCae@\"],[\"`mya@~biOmDqYfQ_X\"],[\"h}qa@`hqOfRic@`Jzk@}EbZiM{@\"],[\"rmja@bgzOgKiVrF{ZjUtX\"],[\"dxf`@|gsUlVqg@rCti@sCmKoObZ\"],[\"hw}_@j{fViFsDmAwHXsUbGvWZpE\"],[\"pbk`@~i|Tb@zOz\\\\eg@jPou@fOv^}Br_@~Nh^i[rhBtu@grB{BrXxKgCHbQgHvJ`P?bB~dAgJnJsCoPcv@ffB}gAvm@_e@`n@uIdb@gWeH{OhUsGlk@jJzc@uVna@oPpmAq[aEuMbv@oMnCoA_UcI~MjCfpAiPli@wPk@sQvl@mCbv@zOdnAyLj[kOaz@sP~m@}JfHiTmjB{GhuDjGrS~GiQdGdaBeGqGkPf]kTaj@gC`j@}Say@eLqIxBhnBoGz\\\\nHlu@}Il[pM~c@kPaPqG`PyK{vApJay@uT{d@zDwi@dLlKaAkzBcJadA`Pje@xK??zKbLma@sCsgAhNcj@aI{BmJw[iAk^vHpJbIwRjI~StTtCme@_yAv@}OvIyC`Wde@xDiGkT__AoGxKoAmLhN_cAvYrFbDoHsZgNfCelArCtC|BsXlPnG_Ax_@zDzH|GuTzBf]xCgc@_LkhAxMu^pEji@`]ldAbTnBF_[aXqt@}@g`@dIkMnQbUvZqJ`LhFgUae@t]qr@gLwHqz@|a@rYsrAdPwCvFhFlGwQlR`M|@uz@x^zLdOmVzGg`ArRcO~]ns@pL_RlAoiAcKqf@~i@h]\"],[\"fura@h`fPwDcY~Ey@lEpJ\"]]},\n" +
+	"{\"countryCode\":\"GT\",\"polygons\":[[\"upxG~ipu@yKbQfE|BcKXtOpS[|k@bW~ViQrGrD`Ql]_DtN|LvN{Wjk@iTnRbfAbRd_@fz@ff@nVcBvCdHeQ~l@nMha@x|@rqAtYfNj^fd@z\\\\pCpg@_IonAh|Dea@~nBwHhnApHfqEiYniDo~@brCykAtbCycFhmHcN{VseAq\\\\{}AhR}g@cVmz@IwLo^{a@wQeeB~oAyT|CalNueHaEynWkDmVgKwEOjJuIlAeKcNiJ~FmDwLc[tWsPmRgMpNoFaFtCwKoPfCjAwK{KfRmMcXaIuB_GfDrCnG{Gs@A}Vm_@jHeFpq@iVvL`D|T}QvEhCzv@eNcLwLpUcf@_HfBzSoQgGoUpGwy@fi@cXtg@sJcAiRhkAyVb^gNqGm@jRtOhC_Tre@{gAxk@cAzZwt@nc@mEnQ{a@tKaEjt@{M~CgNnj@sIiF|@gqGc|IwDkA}qb@feNaF~hUpu@dGeDeKw^[utAvLgv@{GuV~S{\\\\Vip@`KgTbLkApg@elAj_@jE?{O{FwGwPlEe@qMi`@}Rca@rCnGsCsCme@ix@fiAiHtFeI_HlwCkoFlV|KnG|m@r~@lr@fmG~eJbq@btB`g@jP`JeKlO@v_Anf@rvA}u@jnA|HdDht@lt@ho@pFhS\"]]},\n" +
+	"{\"countryCode\":\"GU\",\"polygons\":[[\"cliG_ylwAzLmEbKoa@hFEzj@f[b{@dlAhsArMvUhNn@ha@s|@~`@w^gL{TpMmt@qbBqgAma@sKqP\"]]},\n" +
+	"{\"countryCode\":\"GW\",\"polygons\":[[\"{ovFdckG`v@|BbnAem@rfBlTnUr[Nji@cMaG^tZpc@|_AfNrLrYfCrGy`@`\\\\on@j\\\\sT|Xms@bvDvBdL`Xd@r[q^`R_CzMpi@dIrEhVuEpLbWpj@Qt_AiM~Y_D|nAlc@|]`|@ppBk@xlA|I`h@vdBdhArgAxTfqB~z@ti@te@|Wbg@iHnDu_@q[`PvTyChRqr@cHqb@aTiAwMyK?cA}X}MvKu^qKuEtG`[|MhIvZdOWbDtYtPzB`cA`}@wZrXkYkb@g~A}CjJvPdy@]qL|lAoQpH{HnU_w@JqC~HkXoCwY}QZyc@ig@oe@ba@zm@}KrJuf@eRM~Lrc@fRdKhYxVnMfG~Z_N~Fa_Ay`@wCxMqO_AsCwTdL{BiNmFvEgMuTzGpA_c@}VwA|DgXdJKgC}JsXrOfCjKmP_YvOmKTuT{\\\\rXzBca@`PrCxIoNyIoI_RxKeLoJvGw[dRwAgKqPnOgOtCx`@nGcKlNYcPkJcEyq@eQiIrGjl@}\\\\eJr@vKlNtCmKtTmPqLwKrChTxKiCtXg]uXlGdJcCnMfUzIcHtMlLnHeMbAwFoNkTzB|BuO}SfXje@nGpI~JBhHiNcG{IrGuQiChVxK|@fTzV}XlI~FiGlHhTnGq_@rGtAnG~m@iCea@h]ti@yKgCxKnG`GaP~Gda@jKm]zQ}u@sYpWb^oFdJ_Y{BzRl^qb@hGe~AmeAzGkK{GeLzGkaCaj@a}@?iMlHqAda@bNth@aJpKwh@jQsKTa\\\\gIqEnDfOc^j[eGd^gVtMow@m\\\\yBiQeLjGkBdu@hm@vjAoMf|@_Rj[f@ji@dLzQ|@pXvx@|z@b{@dsCwfAiWdByb@aPje@xcAba@PrQ_^o@zClI|`@~E|Ol`@}SrEqdAoVwQkTcf@iScRas@`N_H`Fm\\\\g]gCxKz\\\\yKxKd@la@wHvOxO`OpC~YxK?oDnG`Ra@hOnMfCzu@vT`j@oPjToFrs@ya@fS{Gz\\\\ox@|Rws@eU}L_V|KiBkCgIiTtC{H_Fyd@ccAq\\\\uZcGsX|BvZ|Z|\\\\xIbl@ti@zo@{CvJyd@cDlt@bT~@xXqG|G~JdEjIdYmNzYmq@nh@N|Ume@bj@lBxa@cNed@pDwsAuGsjAky@uuBhFiXaLcx@|OghAi@exDigAw|C{bAilB{Hca@\"],[\"ewyEnmvHfTuT|x@iT`KrIzBfs@aLEkLo_@kH|HrLdF{GnG\"],[\"o}xEr~vHzYLt\\\\rY_`@rHyIoE@hMqNnFyOaMkIi`@\"],[\"}cxEpmxH?zG~i@sCxKtn@uT?fL`KlG}BsEdZug@dd@iR_\\\\fAiHhCjK|GgCuGuK}X}IwKwMpG{KaUaJjMcZhV|E\"],[\"ew{EtutHfEqRdp@jFpG`TeJnNaRF\"],[\"ew{EnypH`j@?qLvTrTjB@rPda@?cGrCvBlW_PlDoLaMmFvHiYeLuQaa@\"],[\"_dzEb{zH|@`p@qNxQk_@qSyHkY`Lq_@`T}@xElL\"],[\"ao_Fx}pHvP{F`OxOeGxBfAz]eKF^sLiTyKgCmP\"],[\"qq~Ehn|H{Bn\\\\aa@{g@wCok@dKYxa@b^\"],[\"uo_Fd{|HjH|VkLjMpGrr@kTsCgC|GqJeVsBop@}Q_P?wTvReA\"],[\"cz_Fx|wHob@_LmUqe@jOmD{FyF`GuFdUaDxZrH`[sHvOhi@{K`D|GnG}GpG`PhT{\\\\f]\"],[\"eebF|emHnV|GzPaIqA~]xKbL?bPbHiLjNdJqJ~f@i]{ZHuM{\\\\}S\"],[\"ozfFlvzHg@ot@gOyYjFc_@tqAza@?jY}ZnV_D~S\"]]},\n" +
+	"{\"countryCode\":\"GY\",\"polygons\":[[\"o|d@bdna@pUdy@sRlg@nNvZQn`@rW`j@kIrs@mPnRIxv@mQ~Zu]hJeN~Qrl@trA{Prq@~n@xcAzs@`UtaAf{@~Fjo@yIbjAjk@beBoHff@hk@f@tj@bXk@zjAi]dWpCvl@qXnd@|\\\\hR`MhWjXmAnSheApJfHr`AkSrh@rVgKxVzGtMgDfhAzp@jm@tHxe@_S|f@oh@nQgOjWgo@jvDwtAdp@X~XiO~GuBzLk{AdfAaNfYjBzh@gUtXsr@mTyLdeAoc@pByX}K{i@eCe[|HwV_J}|A|B{z@zcB_}@hAc`AdToQ|Q_LwBkq@|T}xEiRis@_c@yMfEkDoJeUlGw_B}l@wCkL}m@j@|BzI{c@oKoi@~XiQAgPwN_Qkr@q_@ij@gh@_H{[wl@i`@lAeZ}KeXy`@kJtDaGdd@cOQas@li@}`@uIqYhq@mOjFie@wGaMvGy`@a[iXiCys@xkAbBn`@_N`ElE`YuR`WtItRw@jk@eTbSlMtS{NlWkV\\\\uPuOwGm\\\\uhAoa@_f@f@ogDc`@yP^el@pbAgt@hXbI`[_X~UjKtd@n`@bd@kGdu@rSfQaJxPj@vw@_Kh[fC|mAovLxnKeXtIwXsc@gjA}i@aOym@g^{SkAuWio@gC{Q|Rc]zGy|@eLajAbl@uk@sLyr@wd@}HoyBaVmTc\\\\`D~Pwj@sCqZhT_l@sCgLck@}d@{Nyw@mp@ynAhAiZoh@_Jw]ab@kToFmSdDa_@`l@cIp_Ajf@ll@_h@fq@gd@nH{a@{XwMG}`@~OyGbNoVrC{I`X}k@tQkOyGaHi[qc@uWwD_Riy@}G}t@ge@kEk}AyvAyxAsJ_]y[u`@wGg}@kl@}QmVZo\\\\gZgP{bA{I_KuYqG{gDj_Cjh@iv@pkAez@r~@weCzNuFzFqh@~|@qcAkk@fc@k_BngDg_@jJdAaXrcBiqDbnCiyE`kDshDv|A{z@gYvAvOgd@bmCmwB|f@}NpyB~@x`A~Ixf@`h@dX|IzgC~YhnB?lrAri@m\\\\u^xBeWehCoMurB}`Aoe@mf@oD{t@fp@axAwJ_IpEst@tQ{e@zbFm}F|cBqj@{]mF{MoOhOcr@xo@kiA`s@mr@lh@cX|aAyMiPzLf^{M~l@}Ad}Ar[`WuMv`@d@|zAzt@fv@|I`u@`k@fDi\\\\~r@eD_EuOsRnGo@sQ|g@g[fTs@|OdZ}QvGZ`Y~}@fSpZqXrBfE`Qz{BcL`PbLxx@iH~n@hI~Srf@l`@fAtn@by@ri@`ThCvSoX~dAo\\\\~{Bxs@vXfRddATdaAj|@fYxHbeBgUlr@gw@fy@{h@r|Ai[na@y_Ad_@oMjWyc@n\\\\lIrp@uBdXaw@`@qKiKaE`Q{c@qLk[yOuBtLkc@iQoc@tg@mPl`@nF`bA_JrO|EL}_@hQpHts@sYfCxKx^`DxEw[fH_BvUjUxe@yI~OiPkCc]nUxIvJeCu@m\\\\zSbGtc@qXrWwBsB_Q~FeCbYdC`WyQpXdAYy]xRdDv\\\\kQxYJnRkU`i@iHtSu`@xz@mNftAe{@~FkmAf\\\\{p@\"],[\"qdgCresb@fCc[dr@hnAid@mGcQqP\"]]},\n" +
+	"{\"countryCode\":\"HK\",\"polygons\":[[\"kpwLs`vdA`SxKaPi[r[sTWu[ba@xq@wJfYdMiBkEhHfEtTdPuZtLp@}UaQ|AcQbLkBeIgIgJxFoUo\\\\tb@}M}VuFfLmElAoQrM]_CdKdLfF~ReK{AhGzVvJsb@jW_@jXzY\\\\yGhJzX{GrMkWhEbM`QyC_g@vXjXjI{UbZnQvM_TvFgHnUoStJdKts@}Ud^jHoAfErQwG~QmSzIgJoZse@_c@xH{FkBuJwIMiWae@iAwb@cOiN\"],[\"wdpL{ftdA`@rOuc@pAhKiOxElC^aQ\"],[\"ogrLwwudApOmUta@{A}CpMaPhEh\\\\~@l@jFc_@|MdGxJgZt_@wIqO\"],[\"eppL_qndAeVnCqc@i`@zCmUgGe]kVkXOoVfI|AjDlVd\\\\{B_AtMxHmFxEhHxGcMzEfH|ClJcQX?zTfG`NzJn@{IrO\"]]},\n" +
+	"{\"countryCode\":\"HM\",\"polygons\":[[\"fjl_@mc_l@xBeo@rPb}ApXnq@^bbAe^hj@wu@v^_H}E{LddAuS`JwKae@ra@kq@gOyLrFyOzBnG|H}F}H_f@rCsn@\"]]},\n" +
+	"{\"countryCode\":\"HN\",\"polygons\":[[\"upxG~ipu@md@yBqFiSmt@io@eDit@knA}HsvA|u@w_Aof@mOAaJdKag@kPcq@ctBgmG_fJs~@mr@oG}m@mV}KvXgn@cFoO{m@sr@{HhBh@y]kToc@sMyDsNnOgSivBqMuV~Tc{@{HlE_Sc[pXfOzbAeuAeg@}rAj`@{wBxUwqCcNihAuLaQdJwk@wHymCxS}z@{gAi}BgGanBoUoe@g\\\\yQsK~HeIny@}EaBba@m}DrXcIiGgR`Yy\\\\lV}iAaHoqCy~@onBa@mn@xdAaiErE}GdRrJjXBjKcLcBwZmQ}BtNeTdBi|@oIkN{OfmAeXzf@l_@_xErjEgyFfs@seBfH~Kye@~dA}@fZ}EhF}BqGg]~XhB`JuNdXtO~^qGhTwMyK{@vT~d@kPrClGsi@tX?pr@|UiStRdBq_@iWp@wJ`r@gU|K~Rz_@mNhUag@eW?zMyN~\\\\kEy@qi@pG~OfW{KzHkVaPmp@}_@rj@}U`cAsXvTwCqD_GaSj}@uoBxMyJlKxKwCwXfs@eH_LwFdKgIqYmXqEib@yWoEhFyz@vl@fBd@jMyLpDsBhPbe@`QsBgJaPuCn]kLma@}x@kt@z\\\\vDrJwKvZpC~IwK?s^|fAiO}L|t@gcBx]csA|Ui_@zcB_l@pk@e_B`HzsA_EdKyE}FzBvn@kTpXhTvN~GhZoPtOSnMlf@bJgCxKnGiCfSzf@xU`F}Gvn@jK`M|P}DqG~XtHi@|C~K`Aza@pLmKtOti@uOna@fX?|@pgAuSjk@pDxEf]aPdLnl@eZny@nq@lBz@lk@qXhNjh@l[tQlm@{Gd_@uOgCuNz~AvEpJgg@v[{N`@o@lKcHcFwMdH_ItLpA`eAnv@tdArc@xI`C`LjQaTlPnP?gOnIrCxZnb@mQpSyAjJzG~C|o@|B~QtOnP?vK`Px[g\\\\pWrEhXz}Aha@bf@ny@x\\\\jWhp@xYzIzI~g@jT|`@xIfArIjn@|n@pKpNgNxWdKwHzHgDdh@mb@jVyCji@os@z|@}c@cDnPl~@lpBndBdj@lQlKpOxCvj@_NxVlGrYcMx^rG|rBjMjWtw@|CzQaMrg@bB~iAeS|b@|HrS}Qh^uBlVh[cRxh@|@xSv]he@x`@`N||@oFraA`eAaI`^|GhnC{\\\\_[_Bt\\\\}VpU}Ha@eEo\\\\eGbgAwo@hImq@l]kIhPel@{k@cGke@eL?iJnLyDd\\\\|I|LnZvFnA~g@yE~DyB_HyE`WxEhVwTcGgBpG}`@|AzNpUvPuDv[bYpAx[sZ|o@{`@_{@i\\\\rFm@x[gMnEqs@sQsl@eBemAc_@ua@ju@k[`P`OzYmAnW`HvNaGlQhOxd@clAhs@}@`tAjClEz\\\\sDbB~O|OrL`LnXuB`QrJbBsCnSzP|_AyqAvCgR|oAxArb@oPvGaH`Ywc@CxEvx@ks@pYsHlNbDnd@u^|IoQrTo_@hHgLle@}^bG|Z`\\\\\"],[\"iqbGlfnt@fVvD}@vYmPdC\"],[\"qbdG|jnt@}@e_@vGxItWkFxGjHaPhi@oUuH\"],[\"q~yHdz_t@hT?vObt@_J`TiSe`@\"],[\"ca}H`pzs@s\\\\qUag@gpAqSubAV}|A`IhYGbv@ju@xnBzFvb@dZt_@\"],[\"wo`It}ls@oXcP_HsSxJiTx[p[r]vs@\"],[\"sesIfnfr@LwC^uCl@oCx@cCf@~DEfD}@bC\"]]},\n" +
+	"{\"countryCode\":\"HR\",\"polygons\":[[\"qfk[aqaIjR}e@uFmIvJsg@lr@{z@{G{GzGwi@jWkVt^yGtuAieB~Qqf@`Cwx@vU_Xri@aP|GqXdGtOzFcV{FwE`QsCsCmZbKsIcGyKv@gt@`Jop@vZea@~[uEjRm~AtYq]wKyRvKevBqGupAxVqaAyE}]fIgV_^ko@Ji]eO_Zyw@ir@nN}iAgRkQ|CsM}Nsl@tHqBxKhT~OiTrEh_@`YlEv^oa@lc@uItV__@|]`b@x[nDjTyVyPwn@za@cv@nKdQmCfi@~YxFpWkTtNza@lKg@~R}`AhXeL`M_a@|WaqAdCev@pOqQbTtIv@~t@cG~I~I~d@yBjXuRzXhAvLb^{HnD~x@t_@kV~f@eGjZbD\\\\sR~LeGt\\\\rg@oKd]vEzOx]gNvAzmAy_@jaA_QvEqR_SiP~F_Hl`@aj@z`@xLbU{T~Rx@|h@vAdH|T{BK`Nib@r`AqE`w@sTvs@jGfUfUbExEfWoErf@yXfu@ri@tx@lIdn@g}@j}AdCda@`V~b@vBpw@aP}GrCf]kFmE_IfH`M`e@e^zeAhTtMfCnc@k_@bqAyQbK|GnPoP?~F~DeKlVnGgC^|Ko]hMdi@py@jUzJuXvoDfMj\\\\rh@~[`I|[hdAj]|AfN}Qn_Amg@dx@{l@v`@eH|YoQnAaAlwAzBx`@fLhSpv@hDtc@jPrh@wCf]lSjr@qY`J|A`Nz_@hTwFfl@go@eP}r@hTkInFuYhWaL`Had@`UgIf]bWzHyKaEub@jGoSz`A_Z`WjLbJkg@jo@pHjm@sPv^id@zm@c_@dNeXl@g\\\\bY_OhLke@~}A_nBfj@gTbg@odAfbBe`C~c@eeAhOynAbO}Hps@vJd^}Lru@}cAvf@eY|j@yyAdUsXpq@mTpHrPdDr`@oItg@aa@|Z_JmRnGvi@oGsOgBlPcQzBpGlLkn@dv@ut@n}BowBxpC{Yf~Cse@d~@?hrAcPgr@gFvWxNpdEvTea@?ne@vEm@uN~~AuIcN{MgAdCjPwKjTaDyJ{BjOqGyGeCxGcUSyFq\\\\gCle@y@c]}I`LqKmJ}CbFlKzCwo@zuAqDtt@cTbNuPlg@zBme@}bAzjCa|@r{@ciBndCaJ}HyLbDeQbYiJ{QjSoUiMoR{F~L?gLeIhDz^w{@uTnGqGcL}S|Xjg@aeBnDmp@{FuNoGtp@_U~YcTf|@qpBlmC_Tnl@e_BdlA{FcFimAbFy{@sVisA~i@i_AxxBah@dr@qRfB}JvUnIcLnF|Lea@tpAsHjl@fElJt`@tW|vArWjMrMaDrQ`QqGlJxThT?{B|GhX}LzXfH~@h^nNfSaViH{_@dZnVoA`JsR`PrXjNwAdRrJ?z\\\\jKuKda@tKlGaP?~m@}HxByAzPna@mPtBfHmXzBmG|\\\\oPfCyKna@rCiSwKeMiCx\\\\wKmGg]fT{{@xeAmFnVyKqGeNdNkI_fAyEjpA__@pG{`@aGyGjKwKaPgL`PoGiT}Bx\\\\iKxKgLoGaj@|Xin@|BlMor@hOsTIosAbR{d@rDej@i_@oy@cJdLeLoEdXsjAqMsmAbKi_B{\\\\a{@ck@qQwk@mr@bg@{LjWuh@bRXlXmeApP_I{Aws@{ZgMIkJhT_t@Po]d^}r@gMesBgQsUsXlg@ep@pP_N_QaHwn@wZzYxAz\\\\eP`S}HqAk_@ykB{W{LeBglBmLg^m\\\\lIwUcIoi@Z{Y{MoTph@yY~Xm`@_HmXeU}@wcA}Zyi@aZq|B}SgXg[^jA_]cLuVpN}\\\\cAks@{G}@_JjPev@~Hq`@gcA\"],[\"of~XkbgJbh@Dz^{a@hOeAuNtLqhAfrCqKlJqX|@oRlwAkIrJ?mKkThTvEhH{d@dnAoI`LyLqFeLxt@jHrOiQ|c@t\\\\qXdCfGwJCgEpKkl@fsDwNm@{F|NsElh@_e@rtAyFxgBuJtPwImEyKrO`Pyk@ZigBd[}|AbUwf@xKyBrDu|@|l@cqBgQvWqJmBmAx`@{Pe]hJet@iDuNnb@sHpUqRxFw[`g@ww@deA_nC~NsfAnUc\\\\\"],[\"_sbYkghIfCif@pLqHxMjRuAzs@qGzE}G}GJdLkFoC\"],[\"kyaYy{wIf]gw@un@zaFxEpFgRh^yE{i@xE|KjDofAnWm`A\"],[\"_gfYkrmIz\\\\wi@aB`mCfO|jA_OnyBiDrIwTwi@cG|m@uCov@nPw_AyK_aC\"],[\"_ghYwe|HuBsFtHXdQbYrL|kAuB~MiDiLcPjBmF|QoM{q@\"],[\"qiiYe`eIyHhrAy_@rtBcM_mAjQiu@eXff@?mYbGyK?sn@jTwOvI{XsAmtA|XslE\"],[\"sklY}gaIi]hz@tCyKoP_PqX~OtIkbDzMikAfCnG?iTjTwe@bNnKrK|c@pAprB\"],[\"ormYsc_IbGrHiBbPk]nwAiKbCaB{n@\"],[\"_osYifqHxKme@eEvi@iVri@`@_\\\\\"],[\"}wwYgaiHdz@asAwSfu@aj@tq@\"],[\"_vyYe{iH}Z|XpXwkAnPwCpK_UrTaK}Rru@_Id@\"],[\"evzY_}hHgSlRdBlIoGtCzEfEwZ`i@cYd`@mPzBxz@aeB\"],[\"ug}Yw{`HzCoOWfT{O~F\"],[\"qkyYixfH`Vel@fFcAvBlNgOze@vTuf@rGl@_`@|u@inAt_Aiv@brAwTxKh]}v@|MqHjEa`@nGfCnC{Lr[uJ\"],[\"{heZcqaHw|@dgA{ZjPd_AudA~KcWmQhAO_Ndm@k}@jDiVbZmTsi@veBvPia@xs@gr@bNm]ke@na@dHcYdkA}iBcPzv@hQw^hFaF?xKpGoGmKxv@|S}XaGfZoP`SqGqGyKfLcGoP}GjTfCfTaUrPae@`lAoGeL\"],[\"wleZocyGdr@ov@iIz]wVf\\\\?mPaUdZHfd@uQgKo_@vSme@nGnRqe@`n@cC\"],[\"mqiZy{~GxKoGsCba@_T~X|B{\\\\{KzB|GaPg]rXXei@zN~Ft]er@v`@}UzG|D\"],[\"{hgZenzGpIhBzEcOtHbL]fXuUd[{^zSoZu@}DbQia@bCeJzRmd@xHgHlKwTqCvTic@GkPc_@y@nAeLaRtT}i@lUac@|e@}c@}T|@sYhn@gCba@mNrXwQrO{a@t]nLlhAeHvBdH~ScLvb@L\"],[\"qxlZcq~GyT`z@ci@~CbC~I~GqGwCpaAsKn^yTzTqGoVcPoG_Cs`@wGnDkl@yPaIrH_F_H~Em\\\\pClFda@{WtDnOzAmi@pm@?zG_n@~PHlWkd@hPoH?x`@tCpGhL_E\"],[\"kwpY}p}H~Isg@@leBgMc`@\"],[\"qa`Z{g_HvNuQfBvXqJpD\"],[\"_`aZml|G`S}\\\\_Wlh@\"],[\"awaZu_`HhPiGhCjEaL~Zc_@oCdE{W\"],[\"khgZseuGcIsU`LlMrZuGmGbW\"]]},\n" +
+	"{\"countryCode\":\"HT\",\"polygons\":[[\"y}_Kjoxj@vcA}Gbf@oPxkA_F|c@nQnPzYxp@od@hUko@fd@zAxT`JxjAlkA|R`r@xIy@gEkh@pm@gf@fhAgGv`@zMbSj^fe@p\\\\zFdWgG~m@jK|`@ffAaaAx`@`DtIw`@jV{Rjf@ehAxdAlg@tfAiGt\\\\tHelA~gAum@vnB|OjvG_H`Sr_@`DwF|`@nDpwAxKgCuCjPtIhP|Ajr@iVb}@}EtvA{KrVwK~qAtBhd@uHnAyE{]gCvh@Jpq@tOni@mP|MbYnPcB|XkQKpXrXe]qG|gAfpB`PzIvYiYxYsDv@tp@aGtOqlA~v@ws@dgAgJ|d@iP~I}Kvc@nPlv@yFxX}\\\\`m@{r@`ZctA}m@m_@vA_`@sdB|E}z@ta@ypAn@_c@lJsF`JwgAfOwW?oa@kTgZyGor@fDiUzQhKl@xbAh[_f@|@u\\\\wNwo@fF{aBlPmr@HoiArPcj@mHgeAlKcu@fIfB{Fu[xIq_AeDaWwgAcv@gC{RzGegBqGqGvT}ZyA_Jw~AsM}Sl_Ai_@~b@uIpc@{_Ari@ab@xw@cbAn`AaO~AyNs~@eHyDwJtBaOx]_j@rXea@wTgCca@{O|G{HlYeXl@yFuA`HcNsDaLyYlCpIw^wU`KgDre@q^t`@u|@btBaO|iA|GzOuT~k@rJrKhBxYmEhmAsUd]{_@lE}g@qXeRye@?na@eGin@_`@ckAw^ya@zIsM|@}}A|GiNuNgO}HifB~f@q`Cr[}^vMyqAnc@ea@?xK|SsIp@mLkMyD?dGsO?zD_YcR}a@tEmG`NhD~DqIaAsg@d]yeAwT_^vIc`AjMvC\\\\zWlJqEiBy^`LiQ_NaDgFdQwJf@\"],[\"{k`J`a}k@`B{U|L}GlAvv@mRhXyG{C\"],[\"{yjJxi`l@eDib@jGsNzLG`Gha@mGpN\"],[\"ijgKrzmk@Xc]jp@glBrM`Fa^j_CpAla@gRbe@\"],[\"avoJtyuk@aOnReS|B}Rk^Eg[nwAu|EfTeQll@eD~M~LqRjN}RppBw`@bv@kMrv@\"]]},\n" +
+	"{\"countryCode\":\"HU\",\"polygons\":[[\"umg\\\\oy}LrZ|XhPhl@l\\\\jFpE|\\\\n[jh@~Gtm@{QzbA~AdRh\\\\jPlFvkAh^vg@tu@r[pH`f@l`@hx@hNzJ||@}FhPpKfI`f@t_@nb@pr@bWjYhA~R~WdU`Ipr@zpAhQcWn^hMhTrc@be@`C`Gf\\\\pQ`T|e@fSjKuThKbArQ~g@p\\\\bX`Mjz@vb@`Erj@z[xd@gUlJdt@`UxOlp@bNpCh[dXx\\\\gU|oCjTdq@f[kBrAnTvRdFtJvTbD|f@yZfqA~OdN|@rcBhUfXeS|`@lAp^qJvWnFrf@yKbaAnTvuAoZtjApDriAvQ~e@~NkFjRn[lZvm@lC|k@f[dZu@dgAuTdd@`OfWpQTzFvh@bQ?kDpz@lNjc@}CrMfRjQoN|iAxw@hr@dO~YKh]~]jo@gIfVxE|]yVpaApGtpAwKdvBvKxRuYp]kRl~A_\\\\tEwZda@aJnp@w@ft@bGxKcKrIrClZaQrCzFvE{FbVeGuO}GpXsi@`PwU~WgFbbAor@~mA_r@nt@u^xGkWjV{Gvi@zGzGmr@zz@wJrg@tFlIkR|e@}Y`Hk|@vqA{NiYyJlBiFr\\\\oIDeP|YiS~Hwf@}OkNh`@VfnB}o@epAof@{^hH}vAwDgk@kKvJqBxYsNyi@kShW{a@oa@sFrFlAvf@qRaDiEbQ}a@kDaTk_@wi@n]g_@_EkHcMdKyN{d@kbBeXpAmIkXqPiF{P`WaKoBqUfOqW~iCgQqRlDsHcNoc@cVkJ_F}UjNikAhZiXnE}h@kV}_@jOaH|AwVqO}jBaMnNyj@fEaFkHy^d_@mJuf@sXmK}DjGwMoL_HhEk^_}@nLu~AtcAyrAlIca@rYw[}AwRb[il@nWc|AmAk_GiW}dEfHsvAuZym@mEo]yVhi@uq@wBaKfFwL{^i\\\\uZkOwaBnBglC_Pek@d@ksA{Nm`@}x@eSkMgcAxQ{f@cAoj@~GaJvUc@gDo[tPcf@IuZml@mmBmZgQt@wUyOkc@nJaZcPm_@i|Ae{@}]ee@s\\\\?}YcfEj^}yAvAkw@|Nyl@oQcdAtHeYwZod@qGg_AjOwm@zT_MlCuj@z_@qLpi@cg@`W{q@mWmiBy@{{AoYqlAdHsj@z[jHbDeYra@{Dr^s]HulAzg@om@bq@a^aKsvAxNya@hZk`@xJgC|]fQ\"]]},\n" +
+	"{\"countryCode\":\"ID\",\"polygons\":[[\"cipAw{yfAdCc~Bn{@kPnPx\\\\eDh]\"],[\"rhrDqt}jA}Mc_B_Zyf@gZoKebAuoCdn@fFbVuNv@qSmYut@o]{Tnm@gn@~lAjF|@fZfMtSwNth@rBlSnKjI|q@aDr_@{b@rvA{Vp^fd@leA|L~|@~qAp}AfeAhgAzkBn{@`e@j_@pq@dCnVf@p`Dv_Ahv@vJd~@zp@lrAgIdLlG|c@aMrd@rI|XmTpq@pShg@kf@kLmb@PaSiMagAupCgMcIkNxJ{Nle@bBt[dNnSmKrPow@_Cml@{n@qZcAwZxKmYsOmw@a@gWwIuZgi@i^kVcQgj@}oAygAcHcRe@mKtp@ud@jBgR}[y[a@eZjd@aZ`f@eAyF{_@yL{Lox@wH}m@_q@\"],[\"yfpAmfwfAjVh]s\\\\|yA|ZmThOfFzJek@vUc@sBo_@rx@my@lNmg@rMgErGnVwVnq@hAd\\\\jH_b@~Xyb@{Dc[pLkV~q@{f@Dlq@rO_SxLis@nOeDth@po@~AziA}Rhr@m`AzYwMvTxwAca@vYnGaPhp@}EvsEtGvTlVvN{L}_@DogAhG{I~\\\\jIdg@wYhLqe@zSaL{Fs]rGal@re@zAji@dl@`e@hErFbOmSld@`RjAd_@ur@fFg~@zYWxOsOrHusAdZsFxc@fg@uGsw@lTmQrXhwAxKiwBpn@|gApX{qAnO{Rb~@oIb^{N`wAo~A`_Aic@}IwCnZmVhd@wIbc@~XpV~~@nv@lXcGhv@hb@km@pGzm@mD|s@nGyDzGaj@bT}UmCcJbh@V|OhHzM{Uba@{QpUikAtWa@pv@gp@rhBoyDpRzNxJw]pXsCsCmKv^utAfk@se@v^mp@|\\\\aR`JxKdN}Z|q@_]?gRwKkPbLqj@nKuKfT|C`PyGvf@la@lZfx@hW~MrNvY}XrsAnY|{@oUptA|TvZu`@|h@gItoA{S|w@a\\\\n_@elAna@_\\\\|g@dFxQxTuCtz@em@pb@yDj`@cXjl@cDpDl^{W`U_C`[`O~w@pk@pi@x_A`NfsAt|@fi@eCh[~b@h|@r_@vg@sB`d@xYlOeVrRp\\\\~^oBbCmLrG~ChLkPb]kGld@j[d{Ab^jYkCvi@ka@|n@vW|QsDli@tNc_As_BmAiT~P]na@do@tJyZdn@xKjEb]hMo[xZnUlHcFfEvi@pTupA~UmG`Y|v@qGh]`QwAqC`Uhb@hFi@~SgU`Tve@bXkd@|EeS~[p}@nRfl@~t@pvAt|@~HfOtg@tHbVfh@lLduAoh@yEo@`KjN|Gmc@`Eg]tXgu@qETnGn}@lPzb@cYfWbLhMga@ba@lPvh@Jvq@vxBtTvPvZh@bc@cPpWdNvq@peCpt@`_AbIyKX{YcQsZiFez@pQuM~^tTrd@c`@f^bI`t@uCdTjR|Rjw@fe@l`@uRyu@dh@{WkV}aAvYqNyN}a@dX}AlsDpf@rXxL~BdUwe@rVpGdlAnGpItLeK|d@vOfCqGg]kReEkNxIyObp@bPnaCPpmAfo@nJbNmArTok@fIcP|Veu@tMlC`Gl[i@je@xPjUsH|RbLlBya@~[aIvc@ko@rPgEbK`|@hn@yKbT~JxGlY_OaDdBaKwSqJnKne@nfBlu@xiBdThOnLbFpcA~n@b}@ds@tlBh_CzeI~zBnvFqGtW{VjJsiAsNqaB`PajBgC{eBnv@_jBoPrTl^vhAnMsHfg@_e@flAyOxNisBi~@~{A|gAvLne@_H|d@{K~IaaB}Dpt@xKtp@na@h}@`mCxIn~@{Hjq@qm@xC}mAyIcr@bRHhmAlNfTlWxF~Gfm@kHjKma@hIuKtOtcAg]qbAjjAqk@~Po^v}@yiAhh@zj@e@hZjK`GhTlb@hYnVaPpJe`@|}CtnFQfb@vPxU}jApyBqD|[vHj}@~_@vt@pxAxxA~[li@kWh{@y{AiTixBlPooA}Ig_Bbl@is@zByKxKqg@yKpU`Zbm@m@z[jXr]kR`l@prAgAbUe]nK}L|PsJtkAnGvh@j}Av`EsAxp@y[vr@}Elj@{h@k^ed@{cB{K`Ehg@hdBniA`{@fJdUcPzRsToFacA~NwSvPsDlW``@zyAlo@l_A`BrVuaAnc@uq@mYqdBbUqRda@cj@kYtAah@_WwHnL`TkCbOe{@de@wzAzJu`@hZ}fB_S}iA|U}O|Ic]zjAc]b^aGyKkTnGo]_s@os@_XodCsT{t@lCyd@zRsKzg@wT|FyCnLcdA}AoO||@eiAjz@c^nBg\\\\{PdJlWrc@lEvU~U}Rr\\\\|AlNye@tMaS`b@{H~{@lGzGdZgBdDrPmc@nhAqaBlPyPwIfPa_@Pij@vXmt@wBuSeH_BcA`[s]l]}H`c@{GoGoKnG|Baj@iMtMmGjb@lD~q@mWp\\\\x@hZiP~RibBfWaEcUol@f[oQqEkCpN|KxD{N`LgQyGoGoc@hTiaAi]jTqaAhA}~@~QyGwHxMgoAs~@prAwpAhg@m^dj@eD`q@kGfNwNzCkr@sMqbAlTot@gLq|@na@kWqGap@jTiUsVaIa]_l@sW_aChb@cu@qgC_yAglA_EdOtwAl_AfOv]xK~~@yR{Am`A_g@qpBsJ}bBo{BkgAgn@wDxEjXh^_l@e]it@uBye@usCot@oh@p}@b[dy@|k@zu@_PfL{t@zxA@~WeI|}@wbAbWwDi@cSlb@{ThDko@b{@gZb[yh@xVeLrXciArsA{t@~ZiC`G{}@p~@c{@tPoy@sYed@tL}\\\\iQyYeGy`@~EoJ_\\\\uK_e@cs@oCcy@}b@ckChi@imBeHg|@zf@mRqWiUkSks@hVqvAuHud@}`Aku@eQgqB{rCaz@uKnB}DhPsNuCij@orAeKq_AwZ_l@|M{mEiWm`@~F{hA|JqXvUkEt|@hh@wHwpA|Yoj@~UefB|b@em@iKinAvt@u}@aeAsqBkn@od@{O}XoMkrBf[mp@_u@}|Bhk@qmARc[oT_M{h@~A_f@ie@oz@yZuzAqEse@i~AysAuDh@xd@u|@fPgeAcO_VasAuj@qDgAw^sbAoqAoNyu@cKkGwXpGw[fXwEbVhGdZwGjHof@sHuWhHk^w\\\\mk@dUgq@q`@ii@gt@ae@iTxa@mi@a[i_Bcq@iJ}[wTw]jTe}AwTui@wWlDkPkGcIk{Bnb@wmCsZcXl^gMImOkYg^yPsjBkV}j@qt@rGyVya@g\\\\kf@iAa[iMbC_L`RuGtMo|@xWgIfJ{Okm@yv@cT}HfMk_AaPer@nPu\\\\|d@qSvBsK{P}TwCy\\\\}MvC_]iU`h@oi@cGyNrAig@yOyR|U_y@gHap@pH_|@eAarBkMwVj{@e_Bdm@}p@\"],[\"hxq@al`uAb}gAo@dW`NpK}GtV|GfCdXxOqUvS_@`JfQhYtBhCxL`NoFhTba@~NsHn`@jI|^aUhNnGbTeRp^_H_CyMzRgAhDeT|cj@Bsb@`jAovC`rCkhBhfAmgAzoAsRbHqMxe@sc@b^qdBhhCu`@jZm^zI}KsDuWep@qrAk@yH_MtG{PoEuIc_@kMxQlS~Dfc@tjA~Jb_@ri@nPluEv`@dgAvLziAuEhn@qZpg@eUbHuz@eBcUjObCq^aGqJyLfk@jF~EtX}BnGoKjb@xCfLpMvVxdAd{@rgAhe@tQPbQwpAvk@mZ?i\\\\qr@yOdKuvA_EsVcp@odAgGgqAs_@eOsQ_VkA_[tb@kJfx@qj@vJax@nd@wx@dnAgEt\\\\kUzM^esCrXa}@t@w~Aox@yr@KdT|_@d\\\\xDv|@qa@pfAgFv_AyRhq@ayBh|B{X}^lKonAqXmc@qGkrArMec@tYwPbXka@fMq`@Xah@ea@dlAqr@~~@gLfoAmm@px@aO~e@uYxFuOgF`e@szAun@`jAgmCxvCa|Adk@}cBtQsmBj_AiPeDuJ{S}Fil@mKaPpHfr@aOveAg_@~^ga@_z@aXkdAT|cA~Y|}@nIjh@aFdH{uAbIua@ga@iMl[gx@uE}HlGpPhEMnNhZ}DpA|Uox@|s@{MxBoTeIgB|KxVt^cArW_ZdTwe@oPvKhn@we@`YpDjP_W|r@gHnBuIaLgQ|LhPl`@{_@~`@tCdSaLfa@}KzLaUoGvGfK{X`YdP|B_G|Sgo@uEnS~[nZjGkWxh@iQn@hTlT}JxNgk@gFp`@ld@t@`UoPnd@yVgSgE`Erc@bgA@xRc]hMZ~a@qzBtdFwCvbAkw@toCyp@jt@yK|vDsa@rlCnPlaB}D~t@mpAnlCaa@z_@gEn{@kb@vn@ka@|DuDbh@eHdE}g@e]aVtLaj@uG_EcUrHyvA|Nm_@yO__@kHE`HbNmP`PdCxk@qLnhB|[ta@v[hJbObo@{\\\\he@uIaHs`@~\\\\mDla@}QbYvb@yO|IbFRvYd_@iTdNrNq]|YmPrq@sa@~KhHhQsP}@qWg]{f@jRoOhTt`@RnJ`I~BrJiRx_@f`@|WnAvPqjA`c@{w@pj@_]lBlF~SfQtDnw@eT_@dKod@f\\\\tFld@af@aB_nArh@qWkFsIgZkMkBe}@vJov@uC|HwJs]}FrCiU{c@cOuZaf@cC}Ix[k^mFsHkl@~g@}o@aJqXdLjFxY~JsFdg@rPj[~q@rc@j_@th@|FzjB{Cfa@fUpH|n@g`@bVv_@kBlG~PnVap@rf@eM~T|HbY|j@p}@l{@~SrFzZoF|[i\\\\pJj@~K~XzoBncBfF`XwIrbB|_@ne@cOjr@c_@dI{O|Xej@v`@aMIhKmQkLXiqCny@wSXoLoOpHcmAgb@oHeSeUep@x^_Hr]wMvEi`BzDgBjk@`a@tCq@ve@cu@ri@wOyOaJt@}Oj[qf@|Pyd@ho@aj@|sAcKo@iFjIsFbaA_JvRrQdKeE|e@pMhBk@bOsXzI`E`KgFbYjH~K}m@{\\\\qGhToVca@oH~t@gQu_@G_]sGmCdGcI}LaCuEky@mBdk@mLqEcHeXiC{|AzKsa@?ie@~TaBzFrFqGca@uMhCaDyHfJe~@rs@iy@dFy]yz@}lAysCyoByb@cvAiBuh@jOml@xe@qGoDkP|QyUr|A~DW}Lks@_@m{@sXrFeGrsAdDmOgM}y@Eb@{Jhh@kOub@_v@fCaPdUsW~z@`Bd\\\\uGm_CeRvImTteAi_@dCcJ}|@{E}Y|MoQwHiBt_@gTpKqGi_Ab`@mc@ch@dYgBajAcJqJwEnaA{GrAcVej@fHb{@e]sr@{v@?dk@bLuHxOrXve@uW`]kYkk@qPsJhDkKcQk]xBn_@cGt@oGkTyXyECjr@vTpNzS`lAvd@bg@uE`MtFpQca@?ve@b[}XvZ`MqElFdj@}Kzb@jBvk@oJvK|FfnAnLnT`[rvB_WxnAze@nnAuQn_@mh@nM{Nd`@xs@xcCyAjYk}@~w@sr@xfByMnAke@sr@vKf}@u`@`a@s_@JyI~Qyv@zGys@sw@i[oBpRnaAqa@fRq^gEef@mt@tT|~@qIqDcA|I~`@nh@ou@yBeMjKb^nCfObTaJnYoVmHrChn@k[hm@aEfs@jDvKrJ@nAhSa[d\\\\mQqFkSdQ~Bp^wOf[~Ij@fe@we@tVj[jSrt@e`@`WwIpXGb|A_KnOmLr@}Wab@m{@_OwVyZwK_bAm}@aa@cVgJgh@y@sEuGom@tC}GoP{i@`b@{OwCuRqpAoLyNsSa`AfAeYf]nPyz@y_F_~@cn@aVe_@mK}g@ye@eRkTa_@__@miAmRenAbCibEfy@qyBgDsj@tNeDvVsg@`NoaAnvB_sChPgpBwN}RhLc_@hAyv@}S}PpOya@yKc{A~k@op@|i@igA|JzKnFbo@f`@vH~DfMbvBcx@bg@un@~tA{o@xt@d_@nlAdHpkAloA|}Ewi@hEzKpt@|G~OoP|gAwKjRrC`j@{RlxAqtAbjCsk@rTwQtr@cSmP_j@q{Bla@wz@gCwRqNcIwZ}F~J{HmFb]ox@f_@iKfcDmDdrAqT|OcOcDe[so@mp@bXcE`p@zGdLzKl{@zCfF_Ups@eLfRaNvBuHcRa^`YrCbb@k[p@{h@lMoYhCqeBdLwg@_Y{eBoh@yf@sS[}ZmRgn@y|AgKsHyRlLiLiFq@kYyXgZkOwh@mYyOmg@fEuXaTue@qA_Qg]g^sMkl@scBag@cj@gnAgPwg@i^at@h@y]iWsZy}AlUGjFqe@~GqEdCep@eL}K~G}Z{f@uhAyHqk@}QcGwHwQyKczBe[oa@gb@pYyNyJqQhBie@l_Aww@sLsu@ykCicAwgBapAuhEbGqt@zmAy|AfWm_Bbe@unAlp@y_EfcB_nBlEwaA``@eaBbcAimB|iB{uFre@c_AxIos@aJqxBkTk[dLeXuKcGvq@s_@n`@ikAcHq\\\\sUxH_EgGfXglAhAyaAhd@stAjg@hDxZlVbOwNwSmPxKcT\"],[\"j~cD_wedAxWwG\\\\nnAsa@mC\"],[\"fqcDmedjAnJ_ThQdIjTbaBb{@jn@HrPqLfKsi@cPgh@{v@\"],[\"~c`DoogmApLErF|]gE|o@yKvKwYyKwFscA\"],[\"fh_DobwmAhQkBdP~PgPrb@tJnScE|Pe[f`@wLka@|C}z@\"],[\"hj~Cu}}lAdUyQjJ~@tPf}@iTvg@g_@kp@\"],[\"pc~CecyfAjIcPvJQjXt_@jvA`m@bLlS}MzXgJhCu\\\\_O_[xPki@qAqV}n@\"],[\"zw{CeuslAbZeJzVxDiFnl@we@gCcI{O\"],[\"b_tCss_oAqBgPbj@dX}Afb@dO}CsFjl@kZuP\"],[\"bdrCk~|nAxIwIcE`PvF~d@wFx_@kGrAcYaJ~O{TpAyw@\"],[\"rroCg_aoAhFaDzu@b_ArAhMyP_H~Bxh@{FvHmTc@yM}O\"],[\"bdnCmccoAuEqG|I?xKrOpGyBqGhe@od@w]\"],[\"ffmCioyhAjA}v@lIqIrJfExFbg@cWpdArCtg@iM~MwLsM\"],[\"leiCspuhAoKuMrm@bE{B`v@tOdGeK~UmVxDHhe@oLbC}@{b@wk@wClFeS~SaN\"],[\"lkcC{_usA~^gT~SnX^|QqIlToVtDyYb^{VzAsDiDvVs_@\"],[\"lmaCkgioAdAuL|OgBhMza@zJp@`IuNbTdX{KvMwYgE\"],[\"bu_Cc_fqAfY{QtwAri@eO~\\\\oViCmcAg`@\"],[\"ll|B{`dqAbThKdJnTvYiClGvJ}HzQmv@x\\\\\\\\zUwh@`q@od@_d@{GyWzv@koA\"],[\"xxyBkrhqANoR~NgIlbBte@cLlPaP_DiMtFcb@qKcAgK\"],[\"xcyBeq_qAvOc[|^ft@auAhsAbFrKwDtKaf@yM|M`[iU|K}u@oBkPiQ|X?gNaf@d^eMkFkRvi@jVd]kVxBuPh^}S~AsPnHlB\"],[\"paxCgrabAlChMmf@nlCmKriBvFjd@uOnQgLgC_GtTsOdhDjHd_@dRbS_RnX{F`l@kp@tPrKp[ve@nh@}On\\\\bCzLpJrJrQHuA`LwP~FvA`}@~OzRzl@xF~N~XShnAoo@hhFfBrv@}HlT}Ph@sGjLkNbjA}\\\\`OkZrj@cSrcAiLtEcL|c@zCd^q\\\\`~Ck[dlJaUdYqGh\\\\hIpUiZYkNxMwp@uEyY_QaFw_@wRtEkw@ms@wk@bA_KlOmD|c@`DjPtQ|LvCzi@wUfp@s^b`@{Ixn@ed@xk@}Jlm@|Bhd@xMrTcGtEtHz|@aHzk@nKh{AjIpK_Br]sNlZ~GxLcPxL}Jnu@jWpUkCrJ}j@pWoEec@m{@uv@rXwZ`v@iSrLaRiCyJqt@aWix@oy@}|@~@k^qNsCeTzUwAlMgQaMgk@mo@eYu_CeDciAwTuW}Kmu@{v@ch@mK}Uua@xAiSzNuLdg@yAlTw\\\\_Fm^mb@k\\\\bAmYf]{e@rXecAmPop@vBatAnJeUb`@qIrKsa@yRmcCcZeE}N~H_[sOsTtAcEmM|Mwj@~Xqa@sNodAfLwl@zhBsgAfWay@`Aec@p^ub@mBw`@wOw^lBaPuLIsAuHhLqVeKkLpZkEzz@yzBsAk]eU_`@iVkEsJtJ~Q_zAoHqPvy@mFp~@_s@~Zss@vjDeh@nB_c@`[qt@UcZoMwYle@scAyCcWy_@eJIeKdi@gkAdKuaCwH}t@sc@ea@~SmWnP_xBtd@meCmOyzBqXeWjZ}Thn@ghBsVw`Aq~@it@o{Awl@}fBgVwLvEyYcd@iW{dA_Hqr@nPy\\\\uE}Vp]i]tiCet@rKwiCiMqo@_h@y]|X}gAhw@at@rOk[zOuwA}Muy@vjAkbApAsh@cVeuBzDan@oFsKdX}g@fCiYw^iKhAuKhTcLht@bL`_@_UaAsWrIgDxH`]zKfAhs@qj@|MdK{Bxd@xe@q{@}]wZsBmMnf@ma@xlAnCre@ba@lr@?pe@cs@da@mnArHos@ng@}n@hKyh@gC_YrXqZlAsVy]sw@mUyfAhKmpAkFag@fJif@y]_j@Row@md@kk@yBmQpv@gz@fGwa@qGcf@d^sh@zCyf@zUub@tUiLn~@xO`r@_CvuF`v@~cAgJm@gN{j@yAfJ_Jz{@y@fX_Kje@{xAl_@{UjNPxTfPl@dYsQxu@cCz}@wXqNgUbC_`@tr@SpXtTlg@cOzLlJfk@eC|ZaP`P`In_@cc@vH~G|XuTpt@zGpGoa@xI~Olr@mUjIn@tTsU`SkSdi@`Ar[k_@zd@|Fb`@qq@pw@wPnr@xLvdCb~@nlAuP`j@`L|HcE`Trd@tdAoa@~}A?|o@yKbh@qGsCqNnYxDv{@mJbUwJbrB}Ox`@vNp_@eQjZ{Ivr@rNbLjUJzBhKmQpHYhJp`@`MbHiIlEtHgG`YsTvMlGdJuKx^dGlZqIdj@_VbCqCrIsBxlAeHhN~ShfAwPpGeF|S_UjFp[tUj@z]o`@zhB_DzqAwq@btCeWd{@o_@n_@yBrXopAf}C\"],[\"pmsBwcvjAfMoDhYdHcCfJ_[xEmXle@{g@mEvZkc@\"],[\"tplBku`pAze@sHpGrCcPxKfMvA_@nIom@xKeo@aYjT?uBiO\"],[\"~tjBcjwoA|LgPc@qTnKxDvJdVgV|N\"],[\"~tjBuopjAtDeNpl@iGaCjLugAx~@p@gY\"],[\"lmeBi{kjAbLqs@v}@gEnC~YgMpRem@tUaO|@\"],[\"nnwAqshnAaIgLj]lHaDlm@\"],[\"dsiAs`tlAj`@kLtUbHM~i@uNdPyVnCoSaP\"],[\"|qcAyatiA|ZkTbNze@wWx@\"],[\"hmbAgz~oA`TmFdSjFeh@xUuB`QyMvImG{[\"],[\"zwaAwkviAtLuNhOvBlJtNea@jRwYaE\"],[\"br_Aun{lAl]qPcOllAaVnWmWu[\\\\oV\"],[\"pw|@sp~lAr]cNlSdJrC`}@_PlUqi@kf@gCk_@\"],[\"|}w@ibbmA~LeXrp@l\\\\pEdjA}OkEe\\\\av@aWM\"],[\"plf@}mrjAra@mE{K`JsAtQcWyK\"],[\"vge@au{jArSoM~[b@e_@pj@uM}I\"],[\"jhd@sdnjAt`@gCme@zG\"],[\"lgd@gwojApP{CdLhTk[W\"],[\"bn`@iriaAsKu[tDia@fH`K\"],[\"ruY}uvlAvVyChRxe@yEhSuN_@a\\\\yZ\"],[\"~pWkfsaA`LeCrSlS_HnEdArS\"],[\"zyUc{taAnSgBpNvUeQbA\"],[\"phD_sw~@tJuIvJzAz@vt@wP\\\\\"],[\"{tDmqw}@eNk[vAwYvOn_@vBtP\"],[\"geKwg|}@~]r@ur@fXWwJ\"],[\"uuLord~@pFsHzLlAjXnKnAdOaRe@\"],[\"snNo||}@~TcAbU|MaWlH\"],[\"{qN{gy~@tAsXpIiHtOhcAyVsF\"],[\"ykOiwg~@~Xo^vRgCN~M\"],[\"i}P{vc~@xNyI~MpE}EnUeRdO\"],[\"i}SwlshAlPkTmPjn@\"],[\"}aT_cnhA{BgHtNyG`VzPuNjE\"],[\"raPawchA}t@}K}k@fGicAve@wtAjTaoCqMkb@aP{c@fDsI~CbHle@wLna@i]zUke@rA|GgTiTgCraAk_A`D_a@i`Bua@imAzy@yYnDy\\\\us@co@vEmcAe`@sYgjAaTcGghAvK_i@aLmK_n@{d@kaAg_BkQyNwL`Fmf@pnA_BwEcLba@ca@jHw\\\\sGeYxPkb@i^}[uMrFueAqd@aPmPfIiZsXuK}Rw\\\\}v@l@ca@oO{y@zBwTsG?sXoPuTlYLwTwc@|GueAdQ}o@rRaNbYir@eN}_@iD}q@_Uu_@rXqKvM|X~g@wK`\\\\}Qf]gh@pEss@}UowDvCuIl[{FxMoT}Y}l@na@ijB?ie@}GsI|G}e@yEyo@lXyj@~KujAfXiT~}@}hB_Yoj@k_A}]~Ww`CiBqHq[rA`NmW_FeQ`\\\\mWqGyv@be@ioBaKy]|Sip@fLwsCcH{LaGtGoWck@{\\\\qyAeVsVkTg}@wKkNapA{\\\\uJsOyD_mAwKkTzMqJmAyOae@{LiI|DqH`l@ok@wGyb@_l@`Fq{@{]qb@uGme@{KeHwc@vPgNmAqcAolAnDuJcc@qHyFyXp_@aE|IwOiA_fAjy@tCde@_l@jW{Drf@p`ApU`QbbC`r@n}BjsAtR|n@jUjMfN|_@hn@pr@hq@hPnn@ff@p`@wDbAhTfc@b[dRwEba@f]J~^v]~x@bQ~iByIvX`UpXsCrTxKnVxK`jBhKzYkEzb@jYr|@cVha@mDn`A`Hrn@uOp}Ach@|\\\\aC`PuiAzv@|Sd{@?jpDsXvZbZeAdBrGgL|l@K|uClPvaBaGjoA`T|_@tLjrBeCbLer@~f@Wtp@cUhTvJzGyAbd@ba@vSnGd_@uG|QjLzr@_Lpj@rNn{@n^|a@kFxd@sQpYbNfoAgVhQoPnc@hCvV{\\\\pk@`@nmB~^rnAf}@|~@||AvfA`dA|`@prAxPba@v_@|q@pAlb@pMl_BkM`p@oXrXpGxpAcPjqDaqBrWuj@kV_f@hAiNla@oTnHm`@hwAau@x|@jLpg@w`@ns@mQ|Q}OiTie@yK_y@nVbAlFaJiDsxAtTa[lBkXsHcS{r@me@gqAwWuYsd@{q@g_@a\\\\ab@{cB{mAyFeo@kTeEsFgSrFwWzm@_A|\\\\a_@jKkcCaW{x@zDeu@sWqU}u@iHkTc_@kH{mDpJoj@_Jwg@bY{y@}SycCuPoR{Ghf@yK|GaGzgAsTzRi@}ZiTiZ{CwuB_]if@xGgeBbl@oeBrFsGjMbF`RcKVcK~KzBjP}Hrz@jIvXnV`cApInUhRhFvXsOfl@yv@pv@af@SuVda@j[bUfG`_@lHhrA_ElVpNzOrrBtw@`Tbd@rf@nMj\\\\lh@|nAry@xdAlcAzjAprBsKlX`Ptp@iCrVz]lk@tBly@nJfBtQ|b@jgAdZlm@jf@bL|uAw_@d]{H~\\\\q\\\\zH{Cnj@wO|MlAdUbTzHzQaDmCwU|HeBpf@tJdl@oGer@ke@fk@mTbp@{|@x_AqWdJ}mAxaAgkAvmAid@roBenAn}@}B~j@si@fHwe@pPuRdd@qH`Maz@xV{N~m@`YlVoDvx@maAyIyZrTyHb@wObObDlGnPta@tCyC`\\\\}KnNvWzUza@~NtP}CjRcj@ph@sBYnIaNGgEpToPl@iKn[zKlCA~Kpz@gW~b@nPfPve@fVaBz]{QzRsj@zYoQdGoaAf{AabArEibAnQwE|SvYnJtl@jUu`A|z@iElUqLLgK{MaApL}h@cD}f@iq@xh@wCcSrIoVdUkPf_AmTvj@dHvkA{CdFxSrVnN_aAniAgJ|_@vz@ym@dCwNf_@w@fIjHo@`McRhNzP~Pc]rFeUjk@`Wl~@mJ~h@nU~p@lHfhAd[fq@hCb[|e@hYrq@hOlp@iKhc@oj@~Xd_@nGpt@yDb`AySbg@z@pq@|JvV_]n\\\\eZj_Aqu@nj@gn@pAoqBo]ubBmAaGoO~ObCyH_Ssj@cS{p@qE_\\\\vSaKpW{LfmAgRhn@_r@hc@bIjXqu@HeKfz@s_DpeDcX`KuXfCej@qLge@_e@{d@_QsHuUcc@c_@mp@}Bi]pNkv@eSy`AYiLxE|E~I}\\\\xKjTbLiN`PwLqTkM}BYaP{N_HfCmGod@bS{VlYcH`~AoR`a@|h@feCtg@|XpbCdsEzo@yU`HkLzk@_NjKdL|h@giAbYyIfB{NlXxMp~B~@~q@kMls@AdNqLvVw@lfBfu@x_CzCxh@mQhr@nC|ZqGby@~NjVeBx\\\\_U~~@H`l@}\\\\lYhTvvAhQ~Frd@fQrI~jAt@daBnYj}BglAbdCg]ja@aPcAn\\\\o_A`j@qAbNxt@hjCsRbfA|Axk@flAfcA`Fhr@sNfn@ke@vGeDdNf\\\\xWe|@v_@zH|e@rLcH{CzU{U~EmQsHimAdo@oXvCuoCy^s^eb@_aBkd@e{BdMoKqPurAeh@_{CiUal@tOsp@uOmc@xK{o@}Gi]oOk@|Wt^pGcb@bD{gDxpAstAid@ki@fImg@d}Au@x]zb@li@iCxk@tOrc@zAfl@pHtPtTqBmKjTfQ|Ky@dMszBxs@ml@mEklAdUeP|Tk`@bJeR_PvCsb@{zBeV{P~^dLbf@}t@~GgzAq]i@aUjOoQmBaZok@y}@eg@iSsc@gv@w{Cb@sr@g_@kdAaRc\\\\e]fC_l@uEsEanAoKi_@zVwoArMap@mP{p@hRev@vCoa@aWg`AhKeeAot@eVu`@arAiQm_AwYelAaaBmXdM}e@mm@~{Bqn@\"],[\"cfk@ey~lADmNxQoBlLzEbA|MaWtSaMiF\"],[\"qfm@_xfmApq@jWyQ|McYf@c\\\\uR\"],[\"{sb@asr|@{b@S}~Aff@yp@ve@ay@tM}k@wX}I}ZfCweBxk@uIvm@ofAfjAqsArbA_ElxAy^~f@{]j`@wvAoHeyA~DmVvjCeiDb_@m{@~PuNz[gExf@xBb]i^prA|@bY}GjhCkfCte@}aA~Bgf@}Gcl@|MebCr^}n@bcBgpA~[iDxO~IlrBj_FpM~u@f`@r[iBzOg^r_@eEdl@nFm^vg@sg@rEcS_m@ohA~@mi@iWavAqb@q`Amc@ui@qNow@iq@yb@}\\\\suAnK{c@`r@{iAhc@e\\\\~g@sJoTkWni@i|@n`Dck@jOrFhErpAlMjC|CzTqVdV`AlHlEtFvKeLhd@dEvG|q@jg@fP`Tr_@`LveAnKhRzBwe@u_@_aBxJkGnM`DbUtVr]lK`Q~w@~Ika@uDwx@je@{`Ab`@b_@nProAwi@d[pg@uCnVkTtMa@ll@bZnSaGjHbXhR_RhRmu@xNc@nQxMyCih@|EkKrc@oV?eLvi@kg@zB}VvUwU|Mcj@|f@wTgd@cP_Ve}@|Zct@fE{t@~Xkt@yW_bAe@_c@l{@yS`_AtDdt@{\\\\|_DmG~sBym@ljAz`@ba@qGer@eXfA}O|f@il@d|@`]uDyReXgTfe@wDjGwLk\\\\gu@tEgYlQiUzu@iS`m@lM|pAd_A~Knt@v^vg@oRs_Abr@{Kt`AtIfN~GjMdh@~^pf@hf@|Sh`@`B_cA_j@sl@gqAa{@eViq@_Aaj@wd@}YsGyIiV~CcJbi@qE`H{IcWwFcEgWrXqg@yB_Y~JwQoVkpAzQss@pLm~BpCuIfCpGtFsEeOsX`CcTvX{Ept@bPjh@{Epi@ea@|Os`AfToIfuA|@`VcJp_@oj@`@}z@vQk`@bg@}NzkAiB|XyKnZfCxl@|cA~o@`f@xdDjq@jWyBzR{Nzc@mr@tR}Jnn@lI~y@`h@pqCxb@ffBiXzg@c`@ho@}@na@jLti@sIhOnMfoA~G`iAmYj|@lYzdCx@`r@rNhqAAle@tHtlAmBrkBj\\\\ri@``@kg@nI}LfdA{J|H_dA~IgMpMfLlKmKbYceCn~BfHdQjc@aCf_@jJfFvc@ru@{HxQyK~Ple@`IaA`Iwb@|On_@mA`[kUj[qtAjoDc_AlcAoP|m@vKgCvDne@rHvIlUR`zCe`BveAcPnCv_B_u@dK}c@dp@mw@lp@w]|t@eJhCuKyMeKbGs[dZyo@bqAc|AjrAc^yDiI|EkL~PaCfe@op@oF}T|Lyl@x|@yGhh@gW{DkMxQExMhNfPad@dV{Erf@c[n`@cD|VnGl\\\\gg@zP}IvOdC|Qod@xNsgAtpAwm@xkAq^~oA_l@pZ{|AllBimD|qF{d@bQ}g@cPel@x\\\\}|ArXmiCvvE_zB`eDwxDdwBqtBnx@wRhPijApxBq}CppB}kBtv@ekAg`@yyAnQyt@|q@stB~jAocAtL{g@ve@}\\\\oG}f@jH{h@hdAtFhSiFjKsQcOmP]cb@~h@c\\\\wJcRnG?_Y}NeA{IxUi`@xOui@rDyx@rYw{ApwAmoB~lAclBxbCot@hJcd@~[cy@qCo[fKgoAftAob@djAeId|@}h@d_@rLhbAy[rz@qb@yK_O|\\\\qh@qLq{@`EgHnF~DrFwu@e@cQdPxEbNeaBfCea@zd@uv@jBwyBpc@arB|o@{ZoBmi@~a@{p@xK_LtVa^zKsBaNlTiKgz@gj@m_@dAuDjRwb@zGaZf\\\\~F~HbM_IjMhCw@lQc|Bd`Bea@~w@{F|v@qTlNBzd@}]|Nij@|w@wfA|yBbNrv@}Q`l@aAn^qpAzt@y_DxGizBzXeo@pzB_iAdx@m~AtYwM|QoI`j@mfDr~A{r@p{@}n@xVmh@|kAh@l|BaSdq@cuE`oDoKxV`BpT{i@nd@}Kna@g~DrpDm{@hcAuD|^uUmCuMn[sx@pc@sVhYkbCjo@ct@dj@kPcKyX|CsWrYa_@nIsEpSeiAca@oWpLaEoGut@b]uOsHY}h@eq@a~Ah_@qp@}JaM{Auo@d^c}Ajg@imAje@aItUwOjiAqaBxUo}BbYqgAmJid@dNee@eB_lA}f@cnBhJgh@aFkp@~Ta~@rW{m@lVuOlHs^aaA{gD~DkQphBkuAzqB_xCp}@w`@`s@sFdl@wRru@jEkBgY`GwD{HiGvLuP_@k]f^w\\\\oGmKjl@uj@rdCvDdYjq@le@fCej@os@r{@uYZq}@lSsk@~XgRjAc^lTiEfM{OfQar@nWyMtk@mE~o@wt@x`@qcBbmA}iCnuAwtBrNug@d\\\\ib@bXcIb_@q_@`g@kwBhd@iR|l@qk@jUcBgCaSrj@}v@j|AoJh_AvZbPoWjh@qHkHsKge@~HeNzV{P{ZvAuUtVyLhiA{JfXsQ{C}Fch@dLmd@iAcX}Vo@__@bPiClIiRvg@wKfTcZ|`Cmj@`r@{_@xgAenAhPob@kBg]fz@szAhi@gd@|fA}S\"],[\"ppaEifvhApw@s\\\\zWfG`aAx~@jWxy@s@|r@jS|a@|TdDxDvSo\\\\nc@eJzcBcNrm@kTbL}Mx\\\\uZdPaCvNaj@`Kgd@v\\\\{pAzgD{WnFoMr^yK?{JzkBnFrVnPfIsT~c@yGbiB}Mbq@_Ydd@qkAv~@s`@cMue@uh@s_@_yAeL}|@pG}gAyKel@xI{k@sS{}@vBwShMgJ`GmiAgy@qbBhSmFjuAelApJy`@_GwTzN{[z|AeYnPin@iS_Zz@cv@~a@}QvVyk@z|A{v@\"],[\"jghDyyoeArBkMp{@}^~V`Ucn@jjAqe@y]\"],[\"fkgD{iahAz@yi@|\\\\rZtG_AtCxXbRpApVeJxWlNsTvYrEdXqDpD_YsPcGxCyP_T_C|Jqr@fC`k@ce@\"],[\"zjeDqc~gArZmP~@bHnNv@?zZiNpJdRd^vJuGyAuKtUbJ~BpN|NpBne@aPpBpMmKt\\\\zGfJul@cLwShA_CxIw`@x@aJcE?aPcLgCox@fCyI}Xf`@oFjBqt@~LsK\"],[\"~n`D{chjApgAdPfKzR}FpmAzJrkA{JlLed@sImv@_jA}Doq@\"],[\"|r`Dy~dfAfYql@tm@cVnv@fc@hn@rApc@f`@jpAhl@`c@ha@pa@mDwLyR~Oa]fStMVbb@nM~S@bQ{DrGqk@mW{@n]xJzNx|@kBmYtM|GrPaPtMdL|kA}XnVkAle@lKxPsEbG{JgKyDnHrDtPnV?rBbLuc@h^lBrXwVpv@m\\\\jDc[eJ?gRvQaGUye@kI{PzEcXwGgJdOiNgMuLkEmAeGnRatAuF_rA|Z_RyLwDya@kRuIiuAgeB_UcbA\"],[\"dy}C_|tgAvTe[dNwBbe@dVbEp]}O|P{Zp@wXqL\"],[\"xdgDgewgAjTzPhn@gCnLzQnDhoBgf@|l@zSxXyEnx@fVlf@rOkTtLevBdVgMnI~XqGxe@pGlIi]~k@g@`Zrg@rzCcFv^cc@vOcaAa_@}aA~MfG|G`p@fC~Iba@rl@pZd_AvtAwMfp@zBda@`RbOza@lbAkAbTaPxI|Od_@_Lff@dw@jgBj_@vhBpBfa@iUpwAdi@~g@`IdhAm\\\\xd@~@vY{\\\\rxAy_@pTcXcK}]|Fm\\\\aYcDcX}JsCo`@bToCrOec@cDsGzMwr@_YqDeYyh@yBvK}XiSq`Aow@qwAuLhDeOko@`PyKlEkc@tt@acB_k@oD~Cco@iG_WhcAkT{i@wCyCsMz~@gIrU{c@uCe[nGtCvFcPfOSnIwOjCjTxm@}\\\\iKmv@tOsEdLig@sx@}|@wE_w@pFgv@yHgUm`@WuW`^tP~Miw@fn@bEfIu[hd@lM|`@uFrS_bAh{AyeA|cAcVT_b@uQwe@omBs@sXbOwJ~A_uAzKqTj|@gPfbAun@}@uIhT_YsOa`@qz@k|@a@_XrJkO`MuhApr@e]~_BpW~KwEuz@gd@cl@dEg`@aVoVy`AfGyeA~JcOl|@sMz^kQho@zKd|@sLuFso@gTmAcB{KuT}F\"],[\"n{`DozajAh[xChQrfAv{@fC}BvZmP~TdFxGxWHnm@}[vRR`PrXjc@fvCzYl^zL~g@rH|yBeMdw@jBf]nj@dcAhUvoAl^zz@_D|[mQnZna@pZaj@bN_KlXuMtrBbObQ`g@{ChTjTiO`pA|@n[j[n|@?~k@uOfv@o{@xl@zBlXz\\\\|ZuOfg@?bYy`@xf@nBtyBxTrc@YlZ{B~Tca@bp@h[luBhM|WfLgCpG`Usg@tHsGr_@s_@fTuiBi@mn@qf@wq@lAd]s_@wM}^zDqKye@kTpGkj@{\\\\uOxKoPcp@_g@uMag@tBa~@{\\\\qZz\\\\cUfAgOoM}VnOcl@g]?d{@e}AoDmi@~Lm{@uByh@li@eo@xe@elCl`Ak~@tEwPoGye@hSeRmUql@oP@rAaTq[lBaQgMjZia@kNux@`DiTeLmP~Jic@yh@}mAnMyLxQ|JbU}I?}XzOod@~m@kv@rIuc@iWcuBmYiVgNj@{LpRyLmAgRme@gc@g_@kT}bBwa@ye@iK}d@}j@of@}LhAsCbg@|Ztt@_Dd^o|@kZ{`@_p@do@ehA|]b@\"],[\"n{`D_eqeA~t@kz@lZhGfr@`{@jBlk@dYxTbn@bmBvw@|i@rG|XrJxDfY}I_PzB{CgJtn@bAxK`z@uItWoHNuUsg@kUeMaNwAud@zQqxBdnCye@npAyJ`wBu_@ra@igAlq@m{@xKuOyA}Au_@dZs^qMiYpr@saDyKcfBk}@yeAaJwb@lhAk`E\"],[\"xovCahpaAbAsMzIGi\\\\`sBb@xb@cS|@kMiN|XetA\"],[\"jzeCsfpeAfOa@zDnHcAt`@qO|c@jHrN}G~UzInVvFeAdSw_@tDh[oQtVlKfIvP_EhCxIaNhP_[mER`a@eFjCug@sXmI|E{EsRlCurB\"],[\"vynB}dxcAdVeUxg@|AvKfRgFt[jEp^g`@lLye@cf@\"],[\"hphBaco}@tVdXbU}MsArx@pO~Gac@jVuaAd{Aaa@{l@|Ru~@xQyQ|Sow@\"],[\"p`|AurueA|HsFdY`_@gCzSqn@_p@\"],[\"ngaAql`fAkJgB~TuQrnCf]saAhe@qx@kT\"],[\"vrgAyp~eAzRoQbh@pZpv@yIpWbx@tRlEdx@xeAcQjDx@|NyKpCah@yGsKaPo]bM{s@oBon@ra@yz@t@a}AqUq_Byh@{`Aw{AdOwEncBvHrx@ic@|H|Z`Kl@j_@iM\"],[\"j~{@y`j|@he@uKoM|T\"],[\"td}@c|g|@z_Awi@lPaF~J~Iwe@fr@qRp@qJpWwNbChEuQu[`M{TuC}]``@yCpZaI_Ceu@tg@e\\\\qEchAbL}Qe]vwAsaB|}@or@r|@kBrJfDqApc@rHfKlDpB~BkL\"],[\"lrs@gdd|@bd@gDhKdb@|\\\\vKlAj]_JbXzObFFdMaj@|Gd@`HgoA}D{MfQqKiDgYdH{c@qG{@uPjcAm`AfP}c@\"],[\"`xk@qt|{@~f@cUnMxFkP`GiW|iAiY`YqBri@_ScGsBbMoInAdGpGep@h]}G_Voj@`IcRkIaIcQhAkVx]if@ld@aGzpAsi@\"],[\"jv]seoaAhc@|HhShw@kCrQ_k@P}BjTs\\\\abA\"],[\"|pT{v~aA`VuIpa@tv@hf@z}AvS|SV`ViWf]ot@aPafB{C{OmMpG_j@wKiZzS_j@kIwh@zZuU\"],[\"x|^o}q{@`_@mKj_@lKes@rKaXdPpGtOru@k[jb@}D^nl@zPvf@ykA`qC{k@ze@kVyCwXrJswC~oB_u@dV_nAma@ybAyDyK{g@nGsg@iTc_@gCkc@jKbCzAoOxZkP|J`CcGfTnm@sSzPgYh{Aqu@nR`HvEiN~r@mWv\\\\_]fYKaPrX`K}Bdj@sl@va@yBrGw_@\"],[\"x~Gwzb{@n^nHda@qGoKpG|BjPeGoDyFlKtI~L~YdAke@vzA}GwOhCiTkn@mPgr@?ke@me@ga@iAbHwLpaAab@\"],[\"~aB_mb{@|iA{p@lg@lJxVuCadCtxAelAve@kVz\\\\cJui@dJiYzZiV\"],[\"{eKom`~@f^_CjV~JdNxcAaOzQkmAoJeRuK}FkSzG}T\"],[\"o~Nezd~@jX}AeZpSuSlg@wJzA\"],[\"{hPsuj~@pSwp@no@_ZcBvQ\"],[\"sdNsgd~@hTcL~s@p^vEvNqgAlv@}XhCgi@uJgIqPnDsHh]uO~EaS\"],[\"}sOckj~@tUiQz^Wyb@vt@q^pKyKwI\"],[\"qmRybm~@p]iNzFb[cTrg@sT`JcFaJvEwImP}B\"],[\"ucScz}~@hGa[tYyDvKnK\"],[\"ggSgys`AbPwWvWfYyBxFaPuFaHrQ\"],[\"_xR}jc~@|IPdBrJiQvr@iYfOc^{JyJ_b@hh@_DrIcR\"],[\"cqNmt{}@nKqDdb@tGxOvUc{@jeAiHfdCjNflA{E|Xer@tcAyXiJgWqn@u~AtSyOkLaCaWxGaQni@ad@`\\\\jElHkc@gLma@xBc[tfBe`D\"],[\"}bQae}}@r[a]lMlCjNfXcCnXgs@fsAsZ~ZsOlr@qU`T?fp@vNdg@yHfMwqAww@qD{t@vQws@lv@i}A\"],[\"itUmbq~@rXkBdk@zKcItN`PgCz\\\\xKpB|Y_Od`@?dXue@t]qPsCrKaNiBgJm^hFd@g_@ca@wTba@ca@g]hCyBoFJuN\"],[\"glTc`{~@bh@aG`TvKbj@gFpLlYnk@hIlBnSmQhs@mTEuPpTKcUiHg@eK|g@kJaCeD}TkT?gIaMoM`\\\\h^tdA~@bb@qGzNon@pGwW}s@e\\\\mNP_]}MoElMwbAoQ}f@eIiArOoIz`@tA\"],[\"ggW{lp}@t\\\\u@|hA`Rv_A_M|Zti@qC~Qq~@z~@cf@jTg`AsFuy@pZy}@_EiH{KlDid@\"],[\"gsY}zp}@|t@oGbCdRcxAx{Aue@tqCil@dj@wVxLqQcA{Ckg@hY_q@bMasB|Y{lA`OkP\"],[\"gz_@_|c{@f^mZ|Al~@kTdh@aR}@yImPp`@mc@\"],[\"ieg@mnhz@vJnP~IWnBvLkk@xSkO}^\"],[\"ieg@wva}@f\\\\_Vpf@_Jtd@zWlcA|Kzf@bw@dB~\\\\ePbu@}PbR}xAjf@ksAcLoPsd@|Gcl@{c@kg@cFkT\"],[\"kph@qq~y@rNgPrRn]}DhGkWiA\"],[\"e}h@k}kz@zv@qGtOxKuO?PvH}hAv|@gVj`AwKaj@|NuUmMgW^qT\"],[\"uyj@wkkgAve@ea@ca@jn@an@xLcCyLl\\\\qa@zET}Sbc@\"],[\"imp@}_raA`PnGrJvi@iFzG}QwEYdZaHhFkJuP\"],[\"i|t@_az_AfPjB{t@pTh@iM\"],[\"kdn@uj{y@z^a@bLtd@eN`_@jFrTsJbPwKyKeYxYuFh[upAzkBwTz}@rJ`ToNd]kTxK?`l@_YkAqe@|c@sKaBMhYc`@_@cHgq@yRfGgg@mNvKcM|Hc|@hS\\\\kEbPlVA}GiThs@yt@~Jou@`NkUlp@tMkEyOrIcSkRjJ_@}Gfq@av@dT_n@|ZsVt\\\\cIeAoKs\\\\WzGkP\"],[\"oay@qylaAsQgGFaOpKwIxX`Hn`@oB|GjT`PbGm]`i@wXy`@\"],[\"i{y@mrx`ApPqA`Ahh@yPyBiGsL\"],[\"ipy@gmq_ArGa_@nG~BuCnGpl@jLpMjr@tRuT`Ch_@{XtI_y@mPiT~XeMqGcOhCyB{GhTkTlZ[tE_\\\\\"],[\"}a}@g{z_Alx@xBuOhLvB|HuKnRu{@x@rEa_@\"],[\"ob`Aw~ufAbIcNrODe@~g@pGnPaEpIy\\\\gIqD{T\"],[\"ss`Agrz_At@sO~y@hFyPrDbAnFnc@_HcPj]\"],[\"qcbAyjxfAtE}[bPuF~nAjI~NxJiVvMk]vi@ok@tN{NkEeEwN\"],[\"}hcAur}fAdYr@aWtg@wZlXeZJuDwJxHsZ\"],[\"c|bAigvfAjLxKeYhlAu@|~@wNtXyThFkSo^hKwWfCqt@bY_Y`Mik@\"],[\"meiA{t|fAyKze@i]`a@uBiVdJkXzP_V\"],[\"qhoAuxyfAjRa_@xP_Adg@v\\\\vAdXcSb[k]bOuZmJsXe]\"],[\"wtjAsbdaAcEmEn|Bpv@tClp@nPda@}\\\\xv@i_@aR{D}W{TyLjCkVr`@wa@_e@~IgLrIaAtg@mWvhAq`Bpo@e_@uVsEsVuiB{eB~S{Njq@kChvAyuAba@sCx\\\\|X\"],[\"we|Aen|`Atq@hFA`b@\"],[\"w|lB{|ay@lKqGpGvT_Pn\\\\iYmS\"],[\"upnB_v`y@rKiJvL~E}GbPbPfT{S~FoY_G{Fv[}FgZ\"],[\"gdrBscey@`a@sXvT?yK`PfSB~HpImPzg@sz@bd@u@kTz\\\\qUkXuX\"],[\"tzmEy}hjAfP}Qnd@~EcBzd@vf@v`Aj_@dFvMdMl_@fiClM|QhJwGhHdpAmP~KwfAhDqXw`AiOeqA{x@aoAsXoGw@mNy[oOcWmy@yb@{^`RsEfShLhGiJv_@xHfKzL\"],[\"xujEssliA~Eya@hi@g@xv@pgASp|AuSfY_k@etAqg@g`@\"],[\"rfeEcmjjAlLgWtH]jPh\\\\tNzFmFl^nk@sP~S|Ec@pMoQlMrVf[ok@b@{x@kj@eTo]\"],[\"`u_D_nsjAfIgGhPnDzL`xAbxA~~@gR`Rjh@`t@xf@eVzQjOjAtWy\\\\dZx\\\\`[rKf]ia@lr@pLrXsCzZgMdFwfAmpAyd@aoAsQvFuAx_AwPqEeLiTlFsY{Vad@fCe_@x`A`^zKok@{QqKiVxOqEeKhFiOgGaFw]tGsLeIpPid@}MeAg]i}@tTuZ\"],[\"xp_Dq{zjAnd@tIfWsDve@ji@pbAhXd^hYrFlw@kj@lDs_@zRzQvu@iB~MygAuo@qN{VqC}Tfp@{^gLSopBi{AVsO\"],[\"vyaDyywsAnVoGbRnIwEhxBoPnwAeKnE{WcIkh@cy@o_@eJ{Q{cAz@wR|fAuN\"],[\"tn_Dw_kkAd`AeAhRzGbb@f~EeBh`AfZbhAkIt]dVfi@yKlRxDxWaZvMea@{_@s_@gN}Zy_AeR{SkEbEj\\\\zgAm@~P}ZwA}u@ap@_@ky@jn@mT_Yct@x@mbCmJw`@rHyZ_Ciw@\"],[\"zx}CswdmA|QaVp}@t~@_Z|t@yFxz@mQ|X{ZpI{GvMwG{F_Bmc@zZyrB\"],[\"zx}Ckv_oAyBel@bP`Ll`@f}@j[tgBvSs@pNpIfYxi@It\\\\gdAgx@oOoc@uq@cG~Fs\\\\we@{f@~X}\\\\~MxB\"],[\"tzxCw}fnAbLkK`Td@n^v\\\\fl@|TuIpr@etA|bAoh@o@uPoGaFqR?wx@pM_z@pIyN\"],[\"|{uC_hllApUhGiHnR?hp@fl@h_BtzAdy@gTtZ}Kd{AfFzs@yT``@mAxr@~OpoA|KjCzm@haA?rVyl@kTos@sBgUwa@wd@{GkEcNos@}d@zSio@nKaaBcPoc@~Dyh@o|@q`BiVaxArb@oK|^um@Hex@\"],[\"z_rC{sxlAlI}Jn[vC~g@nlAgXxDkQ}Qu]|GeReD\"],[\"xhrC{sxsAjTitAzxAbr@njAhItb@xt@bcAzA`j@_JpAbi@nf@pg@bOhdApt@lc@be@fz@rf@vf@`Rri@tDpr@cTdgAsCplEf]xbCyUVodEgkAyR}Xic@wDsgBgt@_o@ik@s~@qXso@er@q_Ag`Bg_@g}Ai[ihEbHsu@xpAex@\"],[\"tclCsfkoAw@`ShRxM`a@wAp]mP`OzGfTe]bj@d]|L{CfdAfUxm@xc@rRho@fL}B|GjTfLuB`g@bt@vZlEx\\\\rXbD{LxWpHhR~PvCzI}GzCyb@aKeJ`Pz^jOfIfPw@|eA{D~D{e@wK_RzEoCxMuYh@eSkQjBwS}LeDeJdLsCsI_T`At@dIuItAtClGwO~AaLgN~Omg@eCu[gMja@kTlGedBsd@og@am@vFiXmx@yXsDab@_Zg@oe@iWaEw^c^~HmW{WiJqt@~c@EkX}]jg@oW\"],[\"`lkC{wpoAnb@HrD`I{Vd_@qUhE}HhOH`aA{M~WoRoN_C}cB\"],[\"zkjCqapmA~B~E|PwLr\\\\bYwb@px@cSbJqWoToPgb@~[aq@vMfD\"],[\"r`fCqmrdAhJ{\\\\p]qRlWxxAbN|N~@n[l\\\\pWxNmNdDbMwJv`CdLlKe@~TdN`Pdq@bTvGfUcOpX_HxsCtF`j@e]~JyKwFiFvUtWxJlUi[dI~Kqj@r`Es@hx@ij@Qr@fO_TlDwNeDgBwSoZsc@{c@a_@cIf@wIyl@yDmjItGkq@uRmwFdGkh@\"],[\"|n~Bc}f_At}@bDd[p_@a@`La]_KgXtO|\\\\ra@iZeJyWq^\"],[\"vl_CwsaqA~EeVz^zL~M|OuA`k@jNwTriAxv@|BuI`GjBfWb]|c@nmAgd@hWcSnl@af@vKu}D_c@b]c}@{e@xKfBx_@aRjIcz@mCg}@vPo[gA_AySxVkPxDyT|l@}z@pZfCr\\\\gY|a@onAhh@sSnKvF\"],[\"j~rBklqhA`oAwFvoAfSjx@mAzt@dPjc@q@n[zMky@`J}bAqCuJbMek@vJc[yKqxD`@co@{LvC}M\"],[\"~noBoo~~@pMsIdVbFmSf]iUfKeEgI\"],[\"b`pBgx`pA|m@cAje@rQpOhTq`@|NfRpCXnI_QzLca@dDaEqGin@J?{G{\\\\nGcApKwQ_CaA~Iil@~MxK_YiOqU|_@hCfEe^~k@uIqGqGhe@cH\"],[\"tgwBy~fqA~k@lEnx@v[x\\\\vy@_LfVlCpIqx@b`AkOto@cJ`G^vNy~@pb@gg@}HoAgb@iIwJqE_@wCdi@k^yIkYjRqToTeLSwJaVgL~BsTeNgGnItMbRsR|FsF|j@}\\\\t[aT{a@bTm_@d@iYwoBw`AzMuHsIuJhCmGg}@lGwJuI~EsRgI}XzKm\\\\d]mKrXe]pGlP`PsGpGp\\\\tK}m@ze@}\\\\lh@pWb^wJd]tT~EgXdZoL`PrXmL|GlLdG`PuX?pGhNsFsUbr@pJf`@h@}a@nSqGpXkb@ve@a[t[~IfQlVgCzGzQjF\"],[\"|ufBeghpAjTwAfUpMteCp_@vc@zp@lm@hc@|g@fOti@sC|rApfAiqBcc@_V_Qmp@?yb@_U~B_Xs|@yJmsCmu@yMkP\"],[\"jzdBccoiAbPxKvaC?pGhHt@xf@eg@~ZmG`YmpAzm@k}@gTgIsX_YlPy\\\\ye@tXcAsNec@bi@aKzHgGiAwM\"],[\"btzAe|koAdZiLrQdJ\"],[\"z__Bo`}iA~XhCkBnQvmAp}@tq@lWbRm@jAkQ~LxBhFxRhP}]fXwEf]zE~EtR~f@~I{EtGhKrXgw@Lq_@pR`f@|Fn\\\\zQiAzUuWnN{VkZzBza@l_@xFlIvJw@rf@yI`LiYvAct@iXgiAoI_O}ZwU{NqmBv\\\\wYdPc{@oa@yKqgAe~@{aBoDy`@rPgNj|ByI\"],[\"~ouA{lioA?aPny@i\\\\lEdK\"],[\"r`eBugdjAfXcd@tUkAhs@pi@fWh|@yPzU]lSbg@j~@`^nHoD{]pP{C~{Arw@dIhV}^`MvVfLy_@zEl_@rc@kIbWa`Bpk@kr@uu@cLzN}tAkx@mTkr@yKeFwLxBxIz^gYRujA}\\\\}LhGzF`Wwg@wg@wWwHku@xFeNgTcaC?msAu`@iw@yqA|AuZbSbNlJkC`j@qp@hq@{_@~f@|DhNwK~uAgDzNfDiB|Gud@`Gqc@jT{CfOtb@rJuPxMnGzGuFpHnb@fBfJlMfYvEdl@i@tf@t^~k@cLhmAdUeAcWyZ}R}AqOrSyKnEuTtP{H\"],[\"~sqA_qopAri@ij@HtMwe@bj@jBtm@sWdl@uWnb@qb@nCda@_z@rWmV\"],[\"jooAwvdoApReOwZpz@mWlHgCeG\"],[\"f{lA{dboAxLgHjVjEsi@~Q\"],[\"p~lAwiejA~LqQpm@aKd|@tb@z^pd@sA~]qNbYoq@xh@ar@~Oyl@mo@dWim@\"],[\"|ymA_hfoA|JiDxDxEgJbYat@jRgA_\\\\\"],[\"vcfAsprmA~S_HnMp]{WrHcIiF\"],[\"vxdAyxdjAzOB`@pVpOdKsO|XyRzIyRwp@\"],[\"b_dAoimmAxc@{Tx^xiBg}@yIyKqIsEmT\"],[\"|qcAwmimA~IsJlXfOrb@gFbDxNyK`c@dd@kO|\\\\`YlIzm@bRnSfApRyj@ca@w`@cj@cEhJdp@bcBn`@lb@cDz_@ud@bLsr@yk@kUwf@AkdA_q@goA\"],[\"nzcAkaqmAxv@sCo[~c@dFfIvRgAnEjKgz@ti@mThCe@_V~\\\\}m@sVuK\"],[\"jxbAqdtlAlsAq@l`@pV}Bps@lg@xpAhj@hs@fM`q@x]|s@vAn[iL|Lqx@brCq\\\\lc@{W`aA}RjRGpXyPjQco@rNop@~z@it@j[oeAtHco@_O}c@_m@h]k[fLah@kCaOyNTePyRe_@}dBmEwyDlG{iAl_@k`@yEyNxt@glBpk@mJ{F|a@~OlHtUqGn[cd@kToT~UudAdHsD\"],[\"bfx@aag`Alv@nGpEhTif@puA{YwOiRkf@\"],[\"|yv@_xj`At^rHoQth@ac@u\\\\\"],[\"``w@{pp`AaTgReHsc@jTuKnZxMp]hk@}d@~i@\"],[\"vxdAwb{nApX?np@~XnpAhChXqIjAxMqXtg@_YdtAyg@vg@wM|l@yd@zc@sF|e@gRp[ae@drBmd@~lA_[xVw[l@gTdPoH`ZcR`mF|RvCv`Age@vNvSYvZk`@tnAwKxeBi]hcA~GdlA_UlMyi@sZuRbQcJbn@fUrOzLtc@dv@f}@fVhKbNcLzLdIdBdoAtNvg@w_@`t@c{BlnAwIjy@yr@iLcVxHsCpJfDvQjb@d[rg@`N~eAlGfc@hRfIlXhhBta@oQbP{rAwToh@~@ub@vZgl@bJyK`O{Mqf@kn@a_AeCwz@a[dGsVaPqG|\\\\iO}^e]cJ{@wIsUcKz@m_CcOisAxKat@rCgdD_c@itBlI_Izm@vAh_@mXbE}k@qRo@fHkWgOyD{c@spA{]aHoHaPgF_wAzJuGzCqb@xe@m_Bz\\\\yZqGe_@~SuM_F{Db]uj@pJar@iHi~CjI{u@py@g}@fY{|@|r@mTbwAkHf^mQl@gz@vPma@vMkHjC|KhK@\"],[\"xmr@shioAqLoa@~Q~RtCh^\"],[\"fsv@qbbaAdaBxv@hT{Bvg@vOjRwO~NziAjg@nIrGby@ov@hHuf@po@kUdJ`Hd[np@gIrLrOpRve@Exh@nTdPdDbPoFzPw_@q@a]iVyZ|Zwl@nI_y@uQhEfc@aFtBeMyEkMk[}s@vDsVg]uf@lAelAwRWe_A_Uie@nNuG|GaU`I_kAve@aP{\\\\kTpGqRhYi_@rSgGeB{Tnp@mj@\"],[\"fen@gpcqA|PPzBbRyHpHcGoF}HpNoa@eWsc@eCjH_H\"],[\"fcf@su_qApDaO~OwHhx@rUtLzQgJfKo_@jCwd@sM\"],[\"hqm@eh}kAjb@{JtLrF~EpQcHnS}b@r\\\\opAlKeX|XinBtXov@uj@t^{g@`jAfOd[wA\"],[\"~ae@{arjAx\\\\mFwB|m@mj@`Lk]yG\"],[\"`pb@ujblAa@aIvKxDhQzf@pTluDnQz_@aQza@bIvs@}@lgBfTrtBsi@?aPd_@`P`NeRfLyWgFuUoa@vM}PiLw_@~PuGeC_y@xGyKxByx@oN}_@jCyl@yHghA\"],[\"hna@_}cjApc@oKtk@`b@|B|M_e@t@qt@iN\"],[\"p}`@kx|pAjBwFr_@bAdR~U|L}H~y@n]g]lGyg@sC\"],[\"d{c@qrqnAbXlYvTiw@bGs@xUrf@cK|SkZd@b{@fr@fL~oAqXvWKfm@eZdvAw[ff@{Mvh@gR`Q_uAmdEqJmaArDcz@iY{b@uHs`@jJ}G`\\\\jBnVaa@lb@aTx[rP\"],[\"zva@wpnkAv_AgAkDviBdLnVmCdd@pEdKr[|Iw]vrBzm@daBvU~nAo@vtAirA`o@y}B{a@aYgrAfQaEeNs`Ate@gtCdAos@rOci@jYsNqGsChCca@iCnG_j@}MdLqg@`t@gFBsMsZgKkIaQfGkQrXxI\"],[\"hna@iyorAjTy^jK|OfC`xAfLfHdQxj@kDdeAhLoG|FdEmGrVfCzZkTrgAfKr\\\\`Q|MuIpG|DxKu[r\\\\aIphAmr@rjBuBzd@e]tgAfC|OmKvTvG|WmKbU]xr@sXtO?nPkRbLaLma@hc@ceGiI{d@xK_n@kEkPpF{pA~j@qmB|BgqE\"],[\"d_q@uid`AL}[`KeJr]rdA`k@l\\\\pbCjk@dSwDve@mmAdj@mG~Lxq@sNlj@pBdPpNpClAjTsh@hSwi@xf@gA`i@q`@th@ml@~`CcKdbAszAhp@u[vBepAuYiYrHoe@p}@}u@x@w`Bl[oUjdA?ve@{\\\\~Xxa@bgAtF~xAu\\\\`W{IxVdElr@cUfKmp@_Dk]y\\\\yYwfAq_@io@cWuOi[|CaXzc@}EwHyVVst@qcAwCgo@sMcQ}BuPbHqIbm@cJzXim@f_@~^lScFbTo_@cDu[bPuI?uT_w@re@ec@cCyeAve@}B}eAwUsh@bBwVtXcOdAsX~Zce@dz@vBbi@wa@pRqCdG{PfQpBbj@kc@diApI~iByVdkBuf@~p@ea@v^io@uEa[\"],[\"|y[qhqqArCe]xVrc@bAhv@sIfw@wTsF|HkpA\"],[\"fc^wyemAzYsGlS|CfXxd@vAlWaSnuAxGnc@}Br}AhTba@lCngAa[p}@{`@t`@wfCa^xTcd@q]aTgl@oeAfOwaA`o@aw@aBqi@`eAgtAxP}`@gA}U\"],[\"ruVg}|lAfUy@`MxO{TzeARdLbNjImOz`@_\\\\qXmJo[xUeq@\"],[\"fuWmdkjAvK}\\\\r{A~Lhe@nh@zLr`@kJ~OdGzJkw@bUFzVvZrQ~fBtHkWhIdQf]o[hi@{KUyV}g@kSuCsrBfSr[bc@vdBj~@dq@z{@aBrLcwAve@epAoDytAcy@hKwk@_PwZ{Bmr@xKkTwTaN}@}Jxo@kb@h[r\\\\vmAwS}~@wv@of@mRaEea@zVoLwOsO\"],[\"tuUodinAxOT~KfOcLhCjZfe@lAtPaXaZfHpXmFzv@kPuMyQax@\"],[\"r{R{tkqAdg@fCn[tTjChRaH|e@gy@rf@_i@qQiN_]lLid@tSuIxq@rO[eT{YkI\"],[\"lpR{u~nA`o@mH|{A|TjaAzWhLhUkTrX~Idc@ua@pYeZ~e@su@~PcZtXaHuL{\\\\dDqk@n_@ms@ivCtAcLxVwO}Imz@p_@aX\"],[\"neNg~{nA~K|X~SwGlV~j@r_@h`EqGqGaBvUrJvJmF|HtJv_@qUiXsm@yCmGiG|GgCeKgEfBmNfMjDhFiNkB}ReQqGnPqLyO_[bJmMcFoOpGq_@qNai@fJcJye@pG\"],[\"pjTyqfrAlQkBnd@tu@pV`rAaBfe@wWlf@uCb[xGnaAaa@t^up@?abC`j@uKfNrBtL|e@lc@cpA|lBz\\\\gCwFrSij@rg@uu@pMeDyJ`PiT~Icn@sBys@fQmJmEin@nZcJgGwQdSw_@{\\\\y^x@qX|gByyBjx@s]|Xo]ne@mSaKeo@lCyMnRgK\"],[\"xoMcgulAd^wMnFrXuK`RaG~x@o|Ak[s@ud@zIvFtg@s\\\\\"],[\"joGohwnAzXsBl^lYxAnj@ei@{NrFbSzPtIlKtt@wTsTAvOqOdH`GcLcUiQfPyQad@cw@\"],[\"tyFu}jiA`P_Y|XyKvVfLz@fIkThZvMiFrEtHkRtz@na@pTpErc@}n@oV{Wce@oP_FtMqO\"],[\"vwFy}x~@bh@y^ng@vg@ht@jM@pTkWdb@`p@~UbD`PkiAxK_i@|p@om@qPea@sa@fr@cCsXiCxGsQkj@iu@\"],[\"pnEokniAlMgNh_@bPxCfz@}Ujk@i[eFjC__@aP{@\"],[\"||Mqy`mAnDyDtZvLb\\\\t`@nMbi@sd@j~@a\\\\g@yPrQ`\\\\fw@lIvo@au@vK{u@qSeLvk@umAp_AkTdAye@oVoc@|FsEab@|t@}l@{eAck@lMqe@f`BkcAzx@|j@lZpG`KsD|CqThf@gBpE}NyMi\\\\wAig@zQui@\"],[\"nqHs`tlAvTla@?xe@}GsCcEbIiJ_M_V|EgD~Iso@aMel@~GcSgg@kAqd@|MiK|BqYtMfDdAhR~G`Cnp@}PpFnJ`YoCpXnI\"],[\"pgDmsh~@tRcZrMwErLnE|@jc@tVpt@sHdSzE`TkLjb@hBtPsSTm\\\\iNsf@ol@\"],[\"nyC_ariAjOyJgXpx@{UmSpD{FtNvF\"],[\"zcDw_uiArr@gCiv@`a@}KrPjAnS_YtTuEyBdY}z@\"],[\"bqBcoeiAsVlBqKuP?iUdMkIz^vN~AbN\"],[\"fsC_lcoAz^}\\\\bF~P|`@nSuCvKvVrE`BhHgb@dq@gE~c@nZ~m@sIxChEzUoe@`m@uZdOiHtr@y]xAcd@ve@iVdH?`PqGsClB|j@bDtFxKuXCfJ~OhCfQg`@nhA{i@jRod@}OuIdB{c@~j@ge@x[bYrS`}AiUd^ek@zNmLqHyOzCtYdcAzW~GwC_JbJhAr_@nRm]{EaBvIkb@{@uTpP_TwCm@vVnQxZeLf]vEtIsIpg@uMlEwSbs@nGmpBoH}NcGsB_FbZcJ_OuQrBxQpLqMvFxBhTf]iCuM|\\\\sd@hIb@cVaPyK|\\\\{K}JqE_QpEsB{LtSc\\\\aPfCpGoGi]in@nPkT}G}B|G}CoPeGpGiHuNepAxJwBk@_ZiBoJoGjT}FkFcHof@j`@a|AcIwr@bNzFxKucAr_@ec@jLst@jZ_L\"],[\"fsCsk`_AtZqr@jGnDoIdi@zDfJzZgJiRls@eu@jgAZhq@rPn]iCdLhThZ_ZdZmCv^_QdFap@_n@gaA}GcQuFjJcL_P{Bps@idAbFsBtChTnIiBfCi`@lx@yd@zA{\\\\e[kFaDkM\"],[\"zrAq_anAfc@sThNnR}CtMmz@pc@kTjt@uYXys@xo@\"],[\"jAomxlAfVlCig@~WvK|Ge^nCwy@}IfCgZlKtF\"],[\"qk@u}w~@tCdPdXkCsi@vg@cPfEbGaP_[nKqNgB\"],[\"qk@mu{~@`W{GiVjf@aSpGnEnKcEzEcwAr`ArOqXeDsI\"],[\"wrEmdv~@`L}HbOdI{l@`h@cAwJ\"],[\"glFegwlA~NoKtX\\\\zR`Lo@xVyg@dLaMoL\"],[\"syL{mr~@vSea@bNuIjNjE\"],[\"kdKuzvlAwYnKax@kO?_TpQmOrd@]\"],[\"syLoxs~@vB~J}M~c@_WzIuHwCiIgo@dv@mN\"],[\"iiN}uvlA|ObNDhTe[|OuT}@gOqUtLcW\"],[\"aePitr~@dFyR`QDvXtq@yd@~PeLpa@_j@gC~B{Pzc@wZ\"],[\"qmRitwz@hSuB~b@rS|w@u@pwBrQtf@ja@uOb[~IhE_JrVxF|LkOzNyNsFo^lSoXSsbAj[sw@tnAgQjC]ls@yfAzMo`CvlAgRva@_t@nf@sNx[bGpG{KbB_LaZxNec@wBkk@{b@it@wf@_HyC}GxUcYmEwNtWqN~@{ZptDq`CfYs|@fm@qZjFy`@\"],[\"yh]mdnkAhp@?zc@nPlMbr@oNrEuSan@\"],[\"vaCgn|lAq_DkTsfAdK}|AyToQvJ?`Yyl@bp@ubAtK_VhQaoByPiLmSkk@wU}N`GyPz_AwJfGu`@qKsXxOka@sAuEzKfM`i@oUtAa^gPkq@~PseD{wAcb@jLqi@_VyYbEeLuOyPzIixAgm@ew@ud@}bD_xCeDaKpHc^oMeZdT{Drd@hP`b@hg@nw@vQjTrj@lo@nJhOiB|I_N|Csg@nq@km@lx@`KtTsOba@eGrxAvKzM`K~g@aKrCrXzyA`s@hDjb@nxAxyBpf@dEr\\\\ePhV_h@tTgLxJs`@}F_b@gc@qk@}tAkGin@}b@kVce@uAew@qQuTgYkI{UjKaAhp@cLhB}qAieAi|AmpCyBuZzGcPeR}QkH{g@|BwfAxLiDh[hXfc@TdXca@~d@qC~b@hSveCbHpDr^jpAvbCxV`vArV~EhSuDu@rh@r_@jJ`aAm}@jN}^|Ayk@~Wm]vZkyB~d@tDdS}GnfAqAjHsGdLejAla@ua@~\\\\mKmYh_@}n@|`Eoi@xn@dAh{BeMfPnCfNm]jc@aPzvBd[`^veAbSjk@iTlbAoDhc@xS~f@h@rl@gJb{Akj@xh@uCn]{Ud_A{Nv~@q`@zXqYh}Aqv@n{BadCgYpuByOxLsGkKjCoK{U~Jw{@xfCivDrzAiXlTap@jxA\"],[\"ism@azpkAnKaNrTfA~HlQkDfRg]fMyIaP\"],[\"ooo@_lpmAd[aDleAr]rg@bAp}Abn@va@xn@~GvyAzZln@uZeKqh@pYwgAzAeNzDcKzTa_Aup@ew@yZgm@m|@{TsEaMcPlIac@sd@a_@\"],[\"spu@gaqkArPgCpg@h]fh@_KbBxPwlAhSqb@}X\"],[\"owbAycukAdWqFjUr\\\\kRbNgVpt@afAqG{w@j}@cYt@wOwMrBiUx\\\\mb@ja@eUt\\\\kBna@_WpEcR\"],[\"kgjA_njlAlQgi@`h@yZlOzZsXx[y^nO\"],[\"mwlAiwhlA~d@ab@bi@lNrXcD@jJ}hAdFqTxWip@fFfIqP\"],[\"crsAe}llAz]c_@~lAbeAfvAdFjNtWsJvZlCxVoQfFkOeFsU{Zmv@sVsVyZiJfFkMpq@irAlGin@mYil@aBQ}k@~XuOrE_SjVcExv@`O\"],[\"pucDoztiAzE{]bIqErOdFjHfSmLjN{RdD\"],[\"n{`D}chiAhM{TlWrGlA`Mk[pS\"],[\"rlnCkoiiAcE_WhMyJzRl@dPjn@}U`B\"],[\"pgjCcd}dAfCoPtQz@yFblAoIkAaKcY\"],[\"~miCywxdAdYgPr\\\\bBiU||@_f@jMgRy_@\"],[\"tt_@qnhjAhIeInMjAdn@`o@q@bLoVH\"],[\"boFcppiA}@|ZtOhEcEvG}i@lEsd@gIiBgJf_@iCb[_Y\"],[\"rrvCujknAfEuIfHdAgHda@\"],[\"`{uC{ejnA~JwCpAjJcZbH\"],[\"d{rC}ucnA{EjK{N_ApNcSc@oMbK`K\"],[\"xqgCc~xmAyKvCuEcPjQoB\"],[\"`}bCy~_nAkOc@wBwYb\\\\|I\"],[\"frzBqijnAuJ|HnDuP\"],[\"ftjBqfzlAsBmAtE{B\"],[\"pfjByhzlAxDi@yA|E\"],[\"~shBw{~lA{DiDrCaB\"],[\"~mgBqs~lAuBk@hEyB\"],[\"nzfBqzpoA_ByLfJyCnY~HvHtTi[~@\"],[\"riwAsienAkAaBpBwHfH~C\"],[\"ywM}f~pAmDkSfGoAlLfNo@~I\"],[\"c|RueboAoBOmE{CnBL\"],[\"_{SwqboA_AwHhPdI\"],[\"adYysclA|a@vG}W`T}N_M\"],[\"{jh@{apkAqFgFdPb@vRx`@uEhHmPkMqKx@\"],[\"epkBonglAeDsCZuAnEdAfBlA\"],[\"panEygfiA`@_K|Ej@~JlUaBni@eOsV\"],[\"|xiCynveAzb@cAlSjb@oCbYyW``@aDyOuOeDlTsQlDcl@gLlGyCaK\"],[\"j`~B{kfqA~Xg@pIja@qApJql@hSqRuWz@cL\"],[\"h_qBqzkbAj@mT|XfPqQfI\"],[\"dnoB}i|pAdHwHjY|ChF|W{c@vEsG_D\"],[\"lao@{eppAbPwGxMzKDzLqNxWob@xSeC}H\"],[\"`i^kamjAhL}Hzo@nH|JdVuFdk@yd@dJy`@uIcS~Haq@c\\\\|GkLz_AcN\"],[\"vhRsdjmAhCqUdR_AhFbT}IrY}XLoMdQsFqBg@yI\"],[\"h~F_dhnAhTcFp_@~NlAzMuUtKuQ_B\"],[\"qbBgwc{@}Hso@|GuoB`_@w[lNlCxGf`BeK||Aia@xG\"],[\"qsMal|~@xFiQ`LhH\"],[\"uoOcc{~@bOg@nGjR}XoDeE{D\"],[\"ocb@swkkAvK_BbVdSo\\\\~KsWmB\"],[\"_g|Ao_rlAzLsHjFbEqF`G\"]]},\n" +
+	"{\"countryCode\":\"IE\",\"polygons\":[[\"iqr`@lplCdMpZpBzf@hVl^x_@bAxJzUfj@bEtj@dv@b_@E_AraAhWnjAsNbn@lTjs@fEcMzNItQsd@aDsRrNke@cE{YpKu@`l@~nBaF|fAdb@zj@zNj~@zz@ihA~C}`@rb@c_@tGak@fn@}MzEw}A|Ral@zPyMfHuaA{Kwu@lD|ElHmRdDii@UmIoPhMmFoCqEaRnWd@E{IyTcHuBgKuGpHiIoFwO{z@iI~BcFsGaJfUuGwBaE|SoMgNiJzCmH{g@a[i]qJ}d@vk@iuAn]kMtH|NnIyZ|b@e^vJ{YsGi^vQ_a@dJqB`UzRhMyDtMrHrOeYeOa~AvF{Z_Dgb@s\\\\eM~FiPqCqYfx@ymBnWl`@_Yl{BvFqF|i@`RfUiBdJ{LdIgbAxYjFdOcShX~N~|@qJx^qd@zPko@`MmH|PrAng@l_@iFrNhFvUpJcg@tQaJpGnGnGgn@fJ|EcN|j@vZtt@z\\\\kf@tGkc@`b@|Cd[eThJlCna@y\\\\p~@fLl_@ic@pVxZla@nOj]`h@v\\\\zMzLwBbq@bi@bjA{BntA|zAjg@dE_H|MWhp@gJuBpG|SxPgXvMUtAqRrJaH{SsU|OnIfa@uk@dZ|P|JxQqC~LqOwIjJ`ZBdZ{ByKoC~M~P|aA_W|sAjAfw@i]_Y`LbQGpWnPfCwCyKvOpGrCqGle@|eA{BpIyXi[kXxIsTt]cY`P~i@mPpTpEbCqNpXxe@tCnv@wTkTqCrTpCrGpG{GuAfZtMrVlC`zCfYbpAyKxI~@zIvLlRdIkAsDuNlIsb@pOjVhVpCxIriAhQvGoDfaAuOxKr^pK`Jt_@jK_YhExEhO|gArFhHpG{JvBhIfL~kCcLiMcGfCyKea@gCbPeQyHjDlMwJzr@dFfgApCzKnMq}@rRhJ?u]pGhCzBeGrCbP?wTxKxK`I{CxXd]~Rbw@eLh]vJkKjIjKuKlCyC`i@pGae@tZ`YnVuCkTrX?prBpGgi@|GbGzByKnGxIzKgAaKpaBdXhTtKf[iPdc@nGbr@oG|\\\\nGeGdF~ChG~n@bIy@dLjT?rXeLpCrRnf@bAx[aPuT~DjOuIeBuO}\\\\jTdr@kEpFyTqFmG|XlAfGnFiDlJd}@vInD|Gja@oOzZzWhe@fCqGlP|gAmPgCqGxKelA_sDy@x[fe@rwB|@`f@iaAa}CyP_tAoUyHiCrr@wK?}GtT~X}GzTpq@~PpyChd@t_BkApu@|I~WoPiCyK{v@aOxEk@xQwF}F_Cus@wPi\\\\sCpXiJuIqQsx@vKrGsCoTuOmGfCaj@kKlPg]in@}Bsh@uTow@dRxmBtYbaA`@|EeLiC?zGpr@j}CeGsBkPbz@uC}VaNsD{NtImFfXdf@paAqa@?s@z\\\\uRkCgCpGcNmwAoIPmPl_@yKg]cHxC{_@qpCgHkXgToL`OoI\\\\mPsi@gJzBktAqGfLoGwTfCd{BxKeG|HxEoQ|YbQxtBmLnR|EjNkI~UhFnPbGwTcGb{@bG{G|Gpr@aJhRaVtEqGaP}GnEkKo_@nGrCrE{Z{_@mB_Cy[}S}ZuJ}_AbD{HnZhE{B{g@yP}a@eLqD_KzM`A}[``@}AzHie@`Ban@}Nc\\\\`IwHyBel@qNhqAiMhF`F}RyAqH}GnPyBcU_h@~CaVbgA{Zs|BwOk[dEaj@sK|HOjS__@uCkNaPjDgnBqH}RA}fBca@ihCzEg\\\\uRq[}KmaC}K~aCkXmD{GbJuOaPpPrq@l^rTrl@jv@vFnbAzKjRiCp\\\\oP_a@oGD`Pd`BsNnw@qQ`KLxUtOmP`TnwAzNxFtMjnCaWceAciAo}BoGdCuNcpA{QgHyKtCaPsXq\\\\gMya@{l@gCflAui@iw@gCtCaqAykAgBeLpXyx@_Iue@hD{M_Iw@qNhc@gCsc@xK}g@oP|XaAyc@pRm^kTxKsXye@?f}A}Gqr@cGnPoGi]qGtp@n\\\\~pH}Sda@yI}JgFpCBnI`XrGzG|OoF`IqPaKg[bDo@eXyIcIyMdXyKe]hC`j@dQmH`KnP_FdJsUeHqHhH~o@nw@~Fvf@qLrUKbd@aUmH_Tq~@{BtTyKcLxNbe@sF`LaP_TxC|f@~UzE~DjWa@~_@sRr]?xeAsSmT}Cwv@mPhT|Eqh@}EiDcGnz@u]j[?p^mFiMpBkl@yKrCDwZlCc[`KyKaKiTwUhn@IqgA_Jgf@~J}iBaDju@{WzlA{Z|Ge`@oMmJpDiNoiAjEgVmK_sBcLpa@{\\\\me@iCtOaPyK?zvBjXj~A}KrSsXcU{StCwC}\\\\hKgb@rMoAj@qKkRg@_K~OfEzJwXdJkPx]sQWaIkU{DzGuRkY?n\\\\nYtUqDh`@iOtKqGsr@iMtYkFrBrCwOwTn\\\\gCsXkRrm@pEfH|JmDZnUxKoGtCx\\\\hTyKfCnPtMiPSp^iQvEsj@cV}OsRcGpa@_j@ihAtOgOzGuw@rUx[yHh^dK|BrJam@{MeYsFpGaIaCqKqRbLip@mPjT?sXkGjk@iNnImIyd@xKcp@?ycCvKucAeLm^~Gch@`P}KR}UxObCzBqLlN`HfVee@xTaDye@{BwJ_[if@iWIeeAzOor@eDsoBjk@y_AuCme@eW`e@uDp]mPaPhCclAiV~~@mEmBvGeUoCoKyDtCaFzwAiLp@ek@suBc[sChPyMca@opCsL~d@uOca@cKp@mN_\\\\oNx@pGsVsXoIrX`jB_NkFkEjL~m@x_BuX{k@{Gx@JjTwL_A?`G~VtQyQjSbNtj@uEzbAsLvJmJv}@yKqGmFhKyn@wcBpFooBcPnv@uAuHYc[lOcXHyUiRd`@gNt{@iTii@xKcfBgNva@wNcHb@w`@eNdNiAbVdLfCOxP}T|XuJzFmBcGhF_SoGk_@mKn_@wTvV?_YkPkFqBgQmPsOfQuFNmH{MgHg\\\\nHwa@eYnG_l@oGwg@nUnH{{@ioBfFsOrUt`@}Guz@~RcEeFeWjPvTTaWgOqLe^rRwXci@le@rInPi|@zSwLhThCa]mTud@xOmC~PhXcH_@rG}Qzi@{FeAmVaPmIahArRkSj[|@jHcYng@i\\\\~MvH|Xf}@zBop@dLxEbj@|bAq~@wkCyQc@kElMm\\\\cH}k@du@eW{SsU`D~Bq[_CyHoGzBrIof@cPoc@~McU?lP`D}Jc@cg@__@vy@qMl@sLn\\\\yB{HxXulB`d@{p@tRwlBnHuGxK~@|m@vnC\"],[\"epo_@dv{DhNgNlJnK|BhTsFfJaV?\"],[\"cx|_@fraE?qXhe@nGlFpLeStd@OfVu`@|EzBb{@wKl_AsAs\\\\wQaQxHoa@aMok@`@qu@|RwW?zK\"],[\"alq`@`oeDrDuPvN{F?br@kToG\"],[\"iqo^rv~Dh@aJ|N`y@aD`OqLsU\"],[\"mdu^llhElCkHlHrNlGlaAyXio@\"],[\"qcl_@dm{D|ErFk\\\\h}ATufAvB_OhLF\"]]},\n" +
+	"{\"countryCode\":\"IL\",\"polygons\":[[\"cr`Rg|{Suo@a_Awi@dB}TmL{zA}tBaZvm@_qBgoAisB}~@ulEsmAktGg`Aaw@kB_PkMfHwb@yR}TinC_VoGarBn[me@{RisAod@cSwm@yCwc@mQpX_a@kQcIoC_Sw[_\\\\mKik@ag@i_@d\\\\~E~Nx[tL}VzW|SbN{Tf\\\\qAhFaMxo@tJfGkVxbAiEh[eQt]~Vli@tHfs@zn@vg@~jAw@bNrX`_@hq@eKrCzKbP{B?nGlPoP`PzKpGeGxKxKzLmCvAvGpN}HyQh_Byp@vGwHrQG|x@oRvp@bp@~mAbQhK|v@lMnLrNxZdCxEeKnMb@vYz[~oBs[n`@xKrc@u_@t\\\\|AhPpb@pAeUqNsf@zQsUpHam@gIr@bBcSwb@pEe@mIzUcFdIuRlb@rFbLiEpWnN{MdeAd_A`~Af]tUvu@pEf|@fd@~SgFdJk]mJoeAP}lAuJoc@iaAggByAwi@vw@nBlxAvb@j|@iXza@m@zp@h\\\\zd@|Bxl@lf@~h@nBrYdVvd@xHd_Apc@xsBxg@~i@yL|gA`VxjAqKzv@zc@bdAbStq@dBfcA`\\\\tq@|FnaA`Wxa@hXC`Nwa@rRoyBhRa{Bzr@gl@bDmnEprAug@jl@kl@yDa]zRqjA~M\"]]},\n" +
+	"{\"countryCode\":\"IM\",\"polygons\":[[\"sx~_@payAmPvx@`Ope@oF~M}ByKaKVqPaTyz@yO_b@}dA}v@ci@}Vsv@{Duw@|g@dNdR{IrC}SjM_Gtg@pu@bPgDvy@jbB~DtX\"]]},\n" +
+	"{\"countryCode\":\"IN\",\"polygons\":[[\"sgtTepnn@~YeTdLq]mf@g_Ar@oc@dWaFz`Ar[bp@jDldCooAdeAiJfz@qf@vjC{s@v[cs@t_@gqDla@yt@db@{^bh@m|Aff@kTfj@vD|c@rl@fp@dkB`t@rDfsBkh@v~CtBrc@eKt|AqeBj]wcAxKuvEnNoUzx@jSp[l^ft@iOnGdd@zJzBzLiq@jnBw|A`Qc^tUgChc@xZ|Lb[bXtHxl@bl@dB|Ue\\\\`P}Hzc@hd@tSrd@`lBn~@nV}Bl\\\\dR~s@eD`S{aAlvAqs@pT{h@yGsIzG@lYh_@dg@hFdqBvJdf@dKjAndAok@rS`Jv~@sPp_@|AxLma@~iBctAbYql@~Q_M`xB`m@zMmNrPn@f[gRdCiT~c@_e@z{@riAtLpAzY_i@rtA|IjKsW_Amo@rL{c@yvBg|AjMge@|bAyaA~^yMtStDlKcU~r@mRdRw\\\\~u@sT~Dwm@z\\\\uUjPug@aNy_@mI}bAlNsdAdg@_`@xY__A`f@qb@lEqQuJqg@|c@ei@~\\\\~IhFfXni@}Uh\\\\tMmBsl@pJul@rs@_qBp@av@ji@enAjk@sc@bh@mxBpYaPxHpCtK~m@aT`VjE~HzQtRrOiGlOlKzWpd@~f@t`@d^nm@hIxj@f`@rLvu@xl@b]~aAb`@bHp}@gRbb@fc@nSlC`h@bf@tHzW`JnAt}@yX~BuNhbAtLkDrWzk@}GfGvF|H`v@`rAvSrP`\\\\vSzJhp@q@nTso@x]}\\\\zKok@zYac@ze@eWVep@|f@aq@yy@PuMwQT{V|R{C|J_l@f`@{YjHi]b[ca@bJsw@`b@sSlAek@|WgNhUyhB`dAca@`s@wk@pG|J`FcMzG~@wAeYySkKjByVfRqWrf@{VfXgv@~Ze`@fJem@l`@ge@vc@_aAb@ePmi@}x@zAa_@nsBmgEn]w`@vEgWsVccCbEgPtf@mQleAyKnM_MtCslApZ{rAOmkAn^_u@p[{QvIsSwEgYmZ}VkNeDmYlHgJmRtGc~BvdAcdDqNkMag@dZ_Iqv@vD}s@}i@}k@kAoVtm@{i@c@yWdg@}f@l\\\\wrDpJmQ|aA__@vo@`Abi@pSnQuJjQijAgFob@ha@qaAhc@}PnMyg@zPKmKc`AdHoWlg@iAbPgQtLwx@cEa^_Yq]Lq`@qf@ciB~`@wx@rxA}Ev`@qi@kA}P`VwJmn@glAwFad@j_@i`AlK_q@iQiv@jRwR_@qVfa@gwAveAugCeQkNnBis@ob@y`@eVg{@c]aj@rfA{N`e@wWpE}PuGqs@tVkObNoa@qCaOo^{QqMqd@fA{f@j[uaA{[{y@vK}[y\\\\}XvG{_@}Oc]db@c[`Y}d@aAmRos@gi@yf@oBolCyv@giA`JsoApm@s`@bi@wg@dXgbAqKc`As[ua@tKod@wOob@lJkyAwu@csAo]qk@aBuH~[ci@lJyLwOcSq|Cq]co@r@}[w]u^iPac@vU}XxGgs@tZai@`ToIz]rD|i@wZp~Bz]blA`g@tK_In\\\\~@xa@_Kby@eiAfQxC|HnMlQlt@dw@r[d`@}{@d]gJb`AyBiUia@te@aO`Cyv@nRmWpd@kUfDugAiR_e@oEqi@xb@g~@eCaf@fKmq@hJyNb[tGnDuFwFcn@xPioAcUiLjIsa@yU_sCwKsNud@wNcK}SoB}l@wWgg@`BcTxdAg_CdGyoAmHmtDaMea@l@atAhPo|@qKs]{f@yYcGyRvBia@li@m[gGul@pCwbAka@{kAtDwJzZ_Kb@wHmPoCkGlFwDmLmPn@t@kS`S{]cD_e@sSaV}]}E_c@pI{n@|h@w^nFkfAq]{a@o_@q_Bvg@_Oh]xF~yCaKpj@a`@``@wu@nR}VcCoc@g\\\\ih@uDcWrj@}Q^^igAp[c^eAm{@no@}VfBqu@{Z}UxCon@{^ke@{_@yeAlp@eg@e\\\\yN~QaLYcJs_@qNvTwT{Nu\\\\Wm}@w\\\\me@kHo\\\\en@}_@_f@l\\\\wDn^gn@yWgf@{}@rI{E{A{Zkh@cj@kSmk@kc@qpCy`@mEkVzRiv@}}@{r@{Qwi@soAIaoBcNs{@`I_d@gN{q@xLmg@it@s`@yn@oEg`@sxAgS}Vy@yn@_x@u{@_m@xn@g^mIa@e]}b@{d@wG_iAuz@et@y@aRvTcm@fk@uf@nWzB~CmH|FycCjd@sbCvTwNhMaxAiScjAaj@cTuk@xB}@yTuS{G`Po~Asa@{Quh@r@yF}^`Q_i@_Usw@iEojANei@fr@mTh[{c@IiSwVij@`MeQht@b^hOlaAbVdUxgB}KsAi`@_x@whAdY{w@bNlB~LaX`f@sJbo@eh@r^}H~\\\\kB|Lr{@|Y[~eBtfBzc@rTrYii@gj@}vBvEc`BfaAqzC{ZkeAhDgVd|A}`CdPgBhz@jU`l@{e@pPoC|}@~PjIjXmShIrEl`@pmAnaB~OjDrKbh@lw@bbAbVjLpx@oOlf@lC~aDmhC`P}@|P_R~WvHDvq@{s@|jBqIbMsd@}Eer@lo@{Qlk@jNbb@{Ixe@n\\\\vWtGvUqBl_AlXvdDth@zoAbvAn{@fVha@|Jds@x|@dp@tHzm@jg@|]jBbt@dcAnp@v`@v|AoKxa@bTxRzLn^td@fGhn@bj@ri@}NziAdNtj@qIdMkUxM`I~h@cEdLy]tSqG`{@ht@zRxg@prBcD|Jp\\\\nqAnbAvJgC`DtVbi@fc@rUp`A`e@d_@h|Adc@vX_A`RwM|TceAzPaJns@c@|^|Tnt@tJhn@za@bs@|IfLbOlArWfbAxXdVhb@vg@jLhQzSfXp@hRhSvRg@|Q`UxqEt`Atn@na@ve@~@~GbSi[zZ}^fxAx@zbA_d@|d@kE|RgAt|@tXxXaGfP`Lfu@aiAfv@oEjKpNfW|i@dCdv@c`@~gBwJtc@ua@llCxQtaAkCp^hQlgBz@~z@jb@jPl[ZlOu`@jj@pObVz|AcU`s@fd@`OvUzp@lDj{@yWts@`FjmAuh@nnA{C|Pj^bZkJcHfhArv@SpI~WxSlJ`i@kFhDxIiOdNuAnRtd@|P}v@zXkbAd~AlsApS~Xzm@k}AbWeH}V{N[{sFrn@msA`Bai@t`@sbAxHua@nh@_xCdSebAuQ_n@pDqo@l\\\\}mBl]w{@aCYfVhd@jPgs@dZlGnYtc@n]bKjXyr@d{@nl@dCz_BoNj]hUp[ru@joAfm@fqBm_@xy@jv@xIlw@~SbReNxDoOf`@auBnd@cp@hj@NzLrVfIxcBoHeD|SuQ|MwmCx\\\\kOmLgJfQoz@fRoObS}rAvc@aNcAIwOcImD{PbWqLtAaK}DqHg`@ik@}Qmr@rD{p@cc@kCsg@k`A}MbHq_ByEeg@gMcNg{@mMbd@eVbGmVkLaQwj@EfGim@vVgCjP_Ru@qSc_A_Qko@xBaCyLvPm^ad@zLvAmeA{Ta[ubAaAa[}_@mgC{j@efAvEgFgQhF{Vba@mw@_Ps{@kRoIyStEizAtoBuErj@k\\\\be@sChb@yNpMsIxc@zG||DfMbK}CxSzNrH~@pMaBpYmRxQlNht@u@p]}f@ryBfd@paGyNni@bH|[oJts@jLv`Doe@pqCit@viClFtv@wv@~MyfAsPazA_FgoB`_@khAa_@yInY}Q}UmIbH`DlNwZ~Se_@vBoe@pR{Hdf@ke@v@`AxWna@UcBfPrO~MtL{X~SfVhHwZf^r_@`f@|Io@tM{UjSlBlm@}LlKpI`b@ed@`|@qXrNw\\\\jy@cp@zH{e@fTyOH~AeOcJaDiKx\\\\iTdFmc@d_An`@b^`VgLvKo`@|UyHkBmOlLfAdEuQrJkB|FrKjErZe`@d}@v^z^i`@nX{FpUpPxs@gF~Iu_@oh@of@nd@wMqAyH~Z_RtK{Gfg@k`@fg@eIb_@ql@jI~BtLpzAzb@pIyKwUgJdRebAf~@yU}Ddh@fq@lz@dh@lEhUbbAxWla@na@|M|MiIxQjBdJtNx`Ad_@do@gG`b@aXkQk}@pHgSnsAubBne@wAnQg_@~e@ia@dBqf@bSol@_Q{WeAwU_JJvE}QbZsYjf@pHff@oYjQae@Ngl@~I_Eva@za@|UVdAlJhLcHaQ|aAlUpSsRt|AO~T|LxWeNns@jBhTruAtHfmBj_AuB~p@qVfE{O~Q`Qtu@zk@iOh]~_@xT~EzBzMjcArRdFcUjTkNnf@sAhU_NfqAauDt`@im@jLmbBsZ_OhMaa@tPiMrl@qDjd@ti@xk@nAjWwOfBaN|YrMxj@{Fra@de@bHdn@|U@dZnNfTiDzw@dLhLmOtO|AfGu\\\\ljA{cAeRkVfEkGdyBts@pXuEfRmp@gIk\\\\`UytAvnA|bA`}@xFb{@ea@vR{^`h@vC|X~Pj`Be]`VvPrK{Yl}@uRpRbGjf@oFhWwLvD~Gfm@g`@~d@}H`LbJuClIj_@fC~`@yK|x@eAtD|\\\\nNdAkTng@{\\\\~HvIxM|Y}JhY{k@hRoK~iAkPrc@}Xzb@p@tClQsM`K|OxGt@dKi^|bAyeApSng@xK|g@yK|G`HM~V_j@eBb]bT~m@kGfDbf@oJvKiReH_v@hV{eAuJgQtD}mAiP__@oXia@`a@oc@aa@zDvUxWzRtP}A~HoR~w@lXH|c@qNbAw\\\\gOex@vGen@gZcK~@KhKtz@rXle@gC~XtMdo@pA`p@pRns@p@_Fcd@lPoDz_@rK}C~Kpi@{CvNxAaFrI|g@rBwIjUpLhC`K_H|DjR}RrNe~@_Yms@lNs`@oP_Qd@{_@bUyd@cKcHbKle@dLdt@{JrAzJ~c@dL`p@wJpW|HzMnf@o|@HnG`ViThN`J`C|^aNpThGnBbOe_BdFsTzUhkAcTbi@nFlV_MrIb_@k\\\\pIaFdGfE`I{e@nOclAkPe}@va@mk@rCeiAin@ew@fN_^|gAkMnAvHbTwHpTwYtUcpAdSkFbTnoBuc@|W_ZbOqlA`MwPxWyF|OvDha@d`@~Ipi@dSyCvpA|h@`mAp|Abm@jpA`Bpr@nWh~Ar]jnAnE`wAbo@vtAf{AfzArk@t^xq@rQxx@gCrkDakAzNjF?|m@bN}RxAmn@nXqFdGpc@`Pea@aPe]pXvWbUfq@xqAhjBvb@xOtf@pAcSy]rEyJo\\\\uCzLoGvk@|X~]rm@vD_DqQa`@lp@~mA~^drAzv@nPpm@hn@bLyTdIdQys@bkANjh@yn@lb@dLtCt]eMnVaf@pDyc@tWeOtL{b@njAbpD}FdS~w@lfDh@~`@x{@t}CGbRkVhM{Tkz@nPjCuTme@}EhGeiAmC{Nly@~A|Ub_AhjAnGd_@nZl\\\\r_@~Gnu@ph@tUaFdRh]fMsB{FqHdE_BnR~AiWip@aVhA?gTuObXmPca@}M~G}M_H|Gme@g]mr@|ZuGjRbPvJtXsMnJhNxD}B|KbPaHq@iPkfAuxCbgAztCr}@t`Bl~Ct_E`}@tq@hBzHeSvUv[vJlPyKmPyKxA{Jbj@hWf|@pn@~GhTlvAtv@`vAxhAvr@vw@pP~^?vTdHxDtE{S}_@ip@hnBv_Cxn@fRdbBdrE~y@xcBdxAbwAwKbL~`@Ul}AxdA`PtYcP~QVhL`Lo@lRc^xQr_@MfTdYgO|e@d}AzjArvB_GlG|l@~eBbxAfwCfh@n_@dG~Tj{@fv@d~@b\\\\pe@oH`L}[VuVqGoE{{@nG|\\\\_Jr{@PfrAdP|j@xN}F|RrXqO|Gf]~_@ta@vi@hsAcAnRpW|ZrSzpA~_@t|@nEb_@uNpa@_Bpk@iRnPMnIhLqDeDf`@xKnp@}NeFoInIjHvy@vTbc@je@jBhP|Md_Clm@`j@zBxkAh}A`j@oGym@`_AonBlGbPtCqGpGlW_I|r@VvnBzr@wmAvAeYrOsMdaAlJpC|Kjx@qFvIjs@jcBd{@`fA~pB|o@pLeExbAvs@~r@rUtcCnVlkFoa@f_@g_@pb@uJrTzUzBma@d{@bLplAR`v@f\\\\zBrXhZjX~AyOcXw\\\\~FaHlq@bEnjA}MbmCg}@p_AtP`_D}x@g}Ari@wtAv_ArcA|BcD|Go\\\\dAuKzMhUt@~W|V~hAoh@hSqzArIdAzVyZ{IqKxq@_LjaAnG}B~GjTxB{ByKlMZn_@dOdXgCzVlPt}DhTtnC~k@niBhs@jaAxw@zVfe@_Nge@jhCpxAnZ?naCln@bwBdTf}AgTaPkCnEyMne@s@zClj@fo@|l@se@{r@dIam@xpB}LbbCbHnyK_I`Nne@}WvVeKnlAzJzJbPqyAhDeHtHtGiX|}BcH`B{Hs_@cHtIjBlc@lHgAhHhl@gIne@|k@~dAja@pInRpSdeAeJpJqN|g@dp@~gBlaAlsAxeAhQdAz`@jWjcArNxv@_\\\\dt@{fAbFsmBwTwi@rCcLnVrCjVkKdr@q}@AnQ_x@`qA}MpnAvKzo@iNl|@hNld@bEft@jb@ngA`Ad_@tW|X~ZdjCzrAtjBhv@rb@flAvKwT{\\\\hMcBzRv[hn@rO?nPng@iPt`AvG~a@h]ti@|MvJ`b@rgA~wBn\\\\tVpYdkBfNjT`SuA`C~`@ia@l}BgsClbEyxGrzEmy@x}@}FmH~K{GcLq\\\\lBjWeMvCcRiQfC}`@yKpMyKkTtK|\\\\mGrVh]bNkYgCpLrO|SL}CfE}gBfi@sMe@pL{Goa@{BvKvKuy@jTa@nGr~@oPooBvp@{gB~^udEnTsxAtXoEoH`a@uW?jTjSiEfBqEwKgCf]bAcB_K{RkDroB}Q}gAqCm[hOwD{Fda@qOx}@oD|UiOzaB|UrMo~@qVuZiTd{@uN}Fiv@dBuMxKip@pGac@qGkl@xTe_@x\\\\vHvIsO`Iec@tAmFvJaa@fEqUrQfLzC~oA_U{hAtd@qg@`BmOc\\\\iYaIzAzIkTnP`j@iCtBlRoOzIsvD~}@e`@tZa{Bxt@cRgKcG`RkXjI_zCpe@eqCv~@{W}AnAhGmz@zb@}L|a@mvCf_AcuA~mApC|M{XnRuSrInF{x@yNzIiQsE~OtZqJf\\\\yZfLg@m]cR~NtQ|Wt[wFca@`a@nGpGuGfH}rBff@oXoD`PjT{s@bMk`Bxw@ayDdtAuVbAcPlNmGr@nGiTw`@cr@nI`lAcvBp\\\\eSeGqxDty@cu@jD``@_Gl@cHkhC~XcLaj@o@dMqQjNqDeGsInGh_@`PslBt[}xBniAg}AlP{c@`WkMwAdGgn@w^ny@enCtf@di@aVLoJaJxBbAsOu`@pa@_HgC?xKsO?tCzHxWlAcA`Wmd@hB}NkZr@lf@}bAjM_KfS|LjOiTxK?xKeZpb@gJdBnDcJiQ_EiCsLdG{JcReKeDy`@mFi@eN~Oba@b{@{XzKaTna@gm@|AsHdUu`@rR}G`_@aY}HsRwSoDhH_qB~a@wL|f@uYxZ?}gAxFcTdQ}KuCqGgZbXuRvt@?f[y\\\\gL}Gca@kE|FiIaCj[vp@eBpKom@lQud@wMeQqo@f@z`@tWva@uCfEgo@fSyJkHqGba@oa@?mv@~XmeA`fA}KhAsE}PcA`SmX`V{IqGyl@u@sQkNfM~Tb`@rBc`AjFyBnLcrAxTsExKiTuOaRfZa~@|V{QuAvW}UhBuTmKlPeLyK}BxTcTrLiLgZ|M_BkGeDiNrIvGfR_N`Fy\\\\{D{\\\\~Rql@yG{CtIea@vIkj@UgWyJiMvT}NbAmF{CxFoEk\\\\}BwwAxWkt@r]tOsZoBcJo`A~o@_v@iEgp@da@wQum@}Gle@_OdJcQaB}S|Ki\\\\cJ}eCl`AaXoI{KzQca@}VnPtTgPlL{oAnKmLxF_ClPye@eGxXmSvI}o@dYaCqJwGaj@?yLxy@iSr@_Tze@si@fL_m@wAkSaQ~@yLdr@we@eK{BmKjOog@xPuAh^ew@p[gk@~Fyp@uGKk\\\\sO}Gl\\\\}\\\\hSfB~H}Ng]bAkTkN}JzTgWGfGfUsUx]cQ{HpFuHoUmDSgX_I}EiCtT}Mcy@qKkElIxi@{i@gA{MjNal@uC~\\\\nWrq@XlYxYiTxB`H~HpbA~\\\\aCrJ{\\\\?dL`PiFbBe_@_SiXEtC_H_LmCiGbHaYmGsVlGkMmG{BlFrNzJwDrE}x@i]WjHp]xWgcAgEy@_r@fO_Ty@al@bv@u`@sFmM{r@te@}Bpv@sXve@`IvTe]dWo~@rDs^_mAqD`M`H~d@w`@t`@jTtC~OeLqItSytBjU`PeLeDsJ_XvFoDyIuN]uGxAj\\\\n^u`@g@e_@dRoQkHqk@S}Z{Vit@iCuqAi[cEnGqa@{B_l@{SwMeUwe@uFqy@ub@wu@Vup@aN}SpEaP}\\\\mPbPiT?~L~J_D~GgMpB{VuKdStf@me@oPkSrPcSc^yKe@jExa@e_@|d@eHdB~Acb@{GwOgr@dXvKv`@oGnGdRtC`JhTyl@uP_IdHpEnVr`@fB~GxSyKhMyQsHif@a`Aea@tu@kKVxKbPev@vRoL{c@sAxh@wJ|GqLak@mc@gy@`GwJwQnD|g@r_Bwj@mUey@__Bsf@e^bAsh@m{@amC{KkHiCfGxp@|vBgE|i@lLpd@oFvpBvJbh@wPrLm_B_Win@e_@qGyk@oa@wZ?tOpL|GpB~j@za@fq@wg@rXyeBkTw`@uW}H}c@~[afAyj@ag@}B}TbPc^iEwJe]]g@`}@pQne@yZhqBva@dbA}FbFuJuD`Jj^oCrLyc@dF{KkNcTzCpMrUvr@lSFd`@yTpEeCdN\\\\|RpOj@oA|b@rOeYaVa^x]XxDci@|ZuEdaA~Dvh@d^V|UrIbCcG`HpRvOaQfWo@hVtMmRxGfGmE~VxKoPxGn@gDh\\\\dHpOdLkn@aDo_@jH|EvKgNr]FaB`Ww_@pl@lJlDfHeVrQiJZ`YqMrc@jc@`DfFkBeGuKtO?_Hq_@pFeZyIk]hy@cc@`E}\\\\jg@}OnpBhp@`pAbgAxJkC~Y|Kz\\\\uG|i@ffA~Bfg@`|@`eBBj[dm@xrAjCdToPiKnBjJdv@pv@nUvxAfs@fnBf@d[lNvSs@ba@rRxNdDzwA}N~g@pDnTyv@d{Ba]hw@w\\\\z_@wMxe@ilCloDwrCzaCsn@vUkNr_@omAdnAiTle@a_A`w@{P|^iTpQke@fCzKlM_CvRghAbuAckCr~B_x@~]ulAcWaf@ow@~LmDlPhT`SwMkB_n@eMwR|FwMju@hSv`@oYuEom@{Z{l@cG{iAe^u[_b@~DoA}Rpm@gCpLke@mPoGzCuEwScQ`BwQin@gTje@me@g[_WcEe_@ki@cv@sIyt@rEot@{GcTefC{vAwZcA_q@_`@}f@yo@x@qS}HoJei@{Myb@~NyNjU|d@ti@r[sFlr@rJv@pv@we@~j@pX|EjExc@uKfTmN]vTdLfQeOtk@z{DjRd`@jq@dg@}GhpA}k@vyC}JbtAm|@fdBmj@pkBcuApcCmMvAuE|O{SdMsHrApGqGqXqG|Cfk@qJ`FuVoK}Du[}Vt[iBdXyQnBwFeO_EzAg@xTnJhOiHjIgOeCdFtNeFlIaYyMsO_WuTh]r[aEdDbUcnAt@iEsJzF_g@wJW_A|MoJKoKag@ab@g^kO_a@{`@oS_Aia@ed@_{@?da@jVt]|Cts@~^rTfi@~iBlw@pt@hG{EzM`OoCdzAsJvVuJgC?wNsPeNdHqSaLaZsKyAnFrW{LbScp@zErOxP}DnBot@kKaT{MqJe`@gXeAzNyUyI_OgWwAg@sFhQ{EkPwo@d@avDoiE[gYkLh@m_@fp@yKrG}Jeo@}[kC}Jrf@oc@oRu`A~T{|@aRifAhCmoDrbAu}AbA{_DqDoZoPqUm{@s[{RmyAoS}a@y@ea@gUof@{LqzA`NuFrVnQzv@yLvRs`@bEwrA}S_{@qXrE}a@_o@dBkp@ia@yt@qNzCyPd|@sj@kAiLlMyPz@oa@}LyYgu@_dD|{AmfCrh@_`A`fA{i@fN}d@~a@ulDJyOnLsC~WhS|fAv@dhAkThdAcp@~Tsz@z|@s|@n^qiAqGshA{i@mbE_FgSxPsO|l@nDhuCgH`Zsr@peA}b@~_BsRrRwXxCmvCqYen@wOgf@wXwiCgiDiu@qd@cm@kt@kuCybA{{B}tCuPmfAzMep@vs@m|@~n@uC~eAqj@rRc_@dDsj@eIkr@wnAibEkMejBAydB_x@acE}lBeTyx@}p@qmAadB}w@}\\\\m|Buc@_kAi|@inD{fJqpHa|DesF{tAgt@etBoUupBkvA}~Bml@bE_j@zYoMx]iK}DyL}`@aPzBz@kQcw@}m@aMe`@mp@eE_a@e{@q_@ePsk@en@yOfEiMeZoa@{JDir@e`@eXeIqo@eY_[}VsHuXqp@uRbY@bq@sd@~Q{nAcOi`Bct@y~Ane@{|@jh@md@q[}a@qHgo@wiAw[wPpCit@qr@a[pG{ZwI}HaAsh@kRaR_AoZuPvCaAkJlKie@sHuUdCkUiMTyJeMuKy|@se@uL{^oYw^`Sii@l{@uRb`Aca@|~@dL|gAqQ~i@xGveAsEhU{OtOaw@rS{_@oOor@|Bcg@kSmZ{@{BxRzh@tWbJdXbCpl@_Xze@|Jxx@{YxWsd@{QslAzNu[jaBkg@ra@ql@b{@ym@pEmn@c`AqfBse@oa@bI{|@`fBmZ|HkYkByy@wi@aQixAeZwe@ee@oSeSzFqJpRnEvcAqIzl@fHti@gBhRyZp_@wd@Fqe@y~@iY~Aqt@``@}EzbAuR~[cNzCu\\\\iLeOsZml@eM}\\\\if@mo@dCaOyYeMebBwo@afByAmf@dt@odErc@q{EqH}xBzu@osAvc@ucD}DcrBuf@{o@y^c}@ia@g^{ByTpLm{@kM{aA{o@cmB|U_|@_L_hAel@ax@{z@qGsPkz@bMyi@ah@ox@a]yLk_@pF}KaK\"],[\"_osHellp@zSkI~KlYkk@hm@uTmK\"],[\"}}cE}k}k@bPpGiVaF\"],[\"kceE}u}k@@@f@d@p@PP\\\\o@`AaASEe@Lk@\"],[\"gkmEc}ik@rC`Fx@jB`@nBgB{AwGiI@AXe@ZBd@N\"],[\"cpqE_~gk@@?~BvCgAD[_@Gy@\"],[\"qorEs{}k@^cEJ_A|@`DEbAo@Rs@H\"],[\"mdxEw{kk@hAn@bANp@b@LlBgB?g@q@QkA\"],[\"{s_Fg`qk@pFl@jBh@dB~@wDVyC?_Bu@\"],[\"{kcFsfkk@@@`BrCd@bAPrA?BuBsAe@qA\"],[\"asnFomhx@dDzJ{Ed@_EmD\"],[\"eqeGqlpx@zKeEr@|HsDbG\"],[\"eehCekix@vg@_EhhAhX|SqGnAhZtc@vNgDlL}`@SqPhQ_GoDkJ|Iqb@nELvIur@xZsKjWjGrHqaAI}e@uOsBqXy`@m{@tBoI~TkTrdAyL\"],[\"{|nC_uex@dSt@vp@ts@q@lOga@|My_@eMuHq^}e@sQ~[q@\"],[\"akzCmx_x@xZ}Q|XjBaMvShDxh@gJvGwM}CuArT}KjDs_@_JoHy\\\\`@eLv\\\\cE\"],[\"qf{Cumbx@nm@aDqa@nf@}WoV\"],[\"u|{Cmxax@vYt\\\\k]fAgMqU_K|JxJnBwEdO_q@hKah@oWiCwJrG}Ojh@pO`w@gS\"],[\"alaDghrk@~Z|ElHfY{J^~GgCwC}J\"],[\"c}_Da`zw@`PcYkDbm@c\\\\|Uoi@\\\\kKeKhE_Jzj@s@\"],[\"o}eDygcx@je@yKf]vO{iA~B_F}F\"],[\"ohsDcvsw@~P_Mzb@b@vTfS{Kvi@gk@dMeP{UaSuB\"],[\"akqEuhow@`y@wE`c@`b@bQg@|EgSxa@fWpI~L{V~w@pIpVk}@qS{YlV}dAv@iHeWm_@oG}Zs|@z[mV\"],[\"y{}Eyaqw@pPsMtItOzDzVyK|XmPqC}MwUso@^~IuWnUgL\"],[\"mjaFwaiw@l[kJfHpS_F~Iac@vI\"],[\"wrhFqfxw@xe@}Mz@tIoZfN_^to@c\\\\aTQgT\"],[\"abmFqlyw@d}@tDcf@xTo\\\\wG\"],[\"yj|Feerw@p}@lD|m@~X_k@xFwy@yF{O}N\"],[\"mmbG}lxw@`NuOzT`MrdBpKzPxIiE`QzG`FsCpH{\\\\?xKrCy@fKvWjDlb@cNhIjIuOjDWxL|g@bQrIoKeHsP|]uWdr@rCtc@eTb{@~InUaIpl@_@bYtTtD}Gf]jTwTrXfLiC?na@~\\\\qIl\\\\c_@zNjUrQcLvWtKbW}GdAnLbJe@fZl\\\\tL}BlXrn@vX_UhBbQr\\\\lMr`A|@me@iNnBeObUgF~uBtWhHdVlZjWtTTnAwGqEuGuV?hCyKqQ_BvJ}Nb[mAplAf]a~@`x@oMvAmKcP_GdQgi@zHeCjXm~@eA_RbY}m@q[dTaNGkKlS_AqgD_Mes@aYlFqHwJ}Mp`@gCkIcb@mJmDqXlEyIzMyIgUgG\\\\qLzc@wZ|HasCmAk^sSsHyPiPdUqfAjEuVmPdBuKcJcHu}@aGmZmLiJtOwmA{[mPxKc`@uDiDgLkcAjI_b@oZq`@gAca@}\\\\cUgC{Bwe@aP?hF}VhQgI`h@|Fjg@uB{ByK|H`@hh@jm@oOtDnFfJfPkG`@}T\"],[\"e}iGmdxw@`PoGaPxe@}FsY\"],[\"exdLqxfu@zP_WrU`AWhj@ir@s@\"],[\"{|fLqvvt@i_B_j@oEcPh`AuKld@fAxKfRda@gCaFvu@\"],[\"{ckLsdbu@wBwGnKwKtiAdNiI`a@{LfC\"],[\"{viLqiwt@oa@fAySqImCmNhNqGzRvC\"],[\"wbrE}r`k@_L@uJ_C_E{BcCqA{C}BkBwApG?tPjF\"],[\"q}zEetlk@?A?eCxADx@^r@p@hAn@zHVzHz@nQzDB@aHrAq@NaKkA\"],[\"_wlTsz_n@ymIvhD~A_h@hc@_l@|@oxAda@}r@s@kvA~a@c_AaCioAvKw{A_Xi}@t@_X\"]]},\n" +
+	"{\"countryCode\":\"IO\",\"polygons\":[[\"|eoCekfk@_K_GdOE~Xx\\\\icAhCca@ti@z\\\\_n@hcA?\"],[\"|cmCsufk@dJoEjZ~KtLgBiLzE{VqJin@f\\\\\"],[\"x|`C_qpj@FiFbAhCKdAk@X\"],[\"zw{Bkynj@sEFoA[oAaB`D]zAV~@v@\"],[\"l_xBoipj@sJyLjMzD\"],[\"bgiBgyxj@}BhDk@l@`@yDl@y@n@F\"],[\"z`gBkqak@iD_ElBt@n@x@Hp@\"],[\"hffBubxj@qHgElD^vA|@b@dA\"],[\"zgeBeqxj@q@eDdBj@Hd@a@b@\"],[\"vfeB{q|j@o@^Wd@UR}@SHkA`@Kp@P\"]]},\n" +
+	"{\"countryCode\":\"IQ\",\"polygons\":[[\"ultUyiiZ~PnGhTwGdUcUiAeWpRg\\\\h]jJjq@{G~_@x]hYx@~Hex@jT}l@`m@a^xcAjSbNtLxWg\\\\vq@m[lE}OoJadAdJ_J`x@iJ~UaO|FbNdUiW|t@oC``@iZhb@|PbVMtIqW}TyiAfJ}d@~d@yaAp`A{k@xI}QHst@mNeZw@cnA_Kua@vEca@hRyGfJoTgRuhAnNq[~RpG~a@dn@@rd@~Ndk@c@tj@xIxSz~@zAcC|Wv\\\\yN`VzKjeAco@lf@op@jQoCfa@dOhJki@~s@hWjK}Kt^twAoO~z@fXxG~NhY``@oFne@vJjInHkK|p@bj@`EhTbp@fZnApYbUfi@sd@hm@cLjPlCgXn|B|{@w@xObNhMze@|_AiZbFqJkGkY`RqUr\\\\cAht@lTzWcJnj@~v@fn@zT|KtWvTaZ@ye@fsAsv@~TeYfk@yPtb@mn@`c@mGuYwq@eB{j@dRnAx]u[hb@`n@hJg\\\\aH{m@v_@c[`k@oIhbAmiAlx@mFd^re@|F|m@zLcGrLeo@dRPxUjZbXiLgBgmBhi@u_CzsA{rCznDeiFbKwe@mQmeAfOwo@rf@ei@zk@dDpcAuaAvg@dJnYgu@dg@yWlQm^t{Aw{@nc@gj@lZ~@d|Ef~AfaGvBnBasEfgI[fIchAnX}Wvr@oQ|E_aA`dAk_Arb@fPpe@wYjr@gQfa@kaA`JfW}DzbAyt@t}ByKfw@`Dpq@nShj@ss@xIwJpIteAgGcz@jfC_Evb@xBndEdc@tcBhRv_@nYjTbzC`kAda@bFjdCnlAfkBlwAxlBdoBjShlBuuA`p_@agXtxb@wlJphNklO`g_@gm@|uFglArfP_nA_zAk|@hUkExKvZluBkK|IuzAr^oLkp@}aPtoD{vQold@_k@wjDosFitCcrF|Fae@cEelAcYynAgJe}@uq@me@iLoiBrDuqCrhAawDeX_dBwbAql@y{F{Pu`@utGklI{\\\\nK{Uq~BmaCo_B{J_o@dPoLrOykAy^cnB~e@e`BeFch@~n@uxAqEe^fNmg@Um~AhRaf@wNss@wu@o{@X_|@~Ri{@nYq^|h@iGhX|c@~XvD|_A}`@`Hq^_DsLwg@yUeTqdAy~@m`B~EelA\"]]},\n" +
+	"{\"countryCode\":\"IR\",\"polygons\":[[\"}eeWkcjZfHyg@ptAab@nTwS`KzAhI{]`i@_r@bl@{\\\\rg@qIbG_bBbN?vFeV|Nx@tg@}^xKyV~OvDjLiGxTsx@`HisBpx@w~F{Cwn@cZov@wEiu@pY{sAwCyWuQm[wl@c`@s\\\\{x@e[cQ_q@wx@|Awu@iQuh@_iAar@ok@guBw{@egA_W}rAefBeoDkAk}@yKi]faDgeEhh@m^ld@zrBb^p\\\\nTd@n`@uVpl@uoAhf@eUnXdGdRp^|NlrBxKxB|Upe@tXo@fj@at@bd@syA`d@cBve@mr@eJs`A`]oJvkB{kBg@an@g\\\\az@tEk{@rvBIjkDmm@tf@zA`e@{YpOtA~n@qRjgAwhA`Yul@fu@qrCxXioF_LgbAdHiUxBjTbg@uaD~MyRpUnAf^sLvmAgo@|hA{`BbfDsgIbRms@l_Bw_OkKurBg]yeB}v@mdIer@gqEgd@mnE}M}pGsG}k@sSm]vVl@|Hjb@tMzoAa@taCpGea@zBjPfXsg@jMstBePemAmNwJq_AGuIjBcG`Ti]_Ooh@xWq|Az_@{|@vGjJ}uD{Qma@}Ce_Ayo@uhBd@mjAqt@}rAep@eLk]xLcs@sWkbAqoBgcAelAaJ{v@{HuDkMep@ym@mvA{TibDr_@}}CoKabB`J{x@cFot@gZiQgY|RoG}Bin@erAm@ix@fMsb@wUcyAfY{r@`YglCmw@itAxFcZ~qBwmAju@vIrOyNf[}xAf@yxB`L{k@zRcVl@qs@fd@_aB|IkgAhGiNb{@_Oj[k}AwEekAeb@i^`c@}}AqIi|@}TiLzj@}pCrbAumBwG{}@`^oZzXqBtQpGdw@yLdp@{|@tSxCzIuWwCga@dm@s]cOc]lV_yAne@}~@nNyiAf~A{eAx_AeaAphAek@hHuSuEgiMdFmw@nk@sL`_@hLzOyC|oA`PnYgChR}RdcBqWhf@pO~FbN|X?nUrZpIqc@hd@}_@vlAqH~{@jNdf@i[de@cGvaBhz@`w@~C~Lfp@nn@qAnD~Hl`@eZnVsCjYrRfnAtX~hAzDhDbRf[vNrV`^ft@vF`Ep_@zb@pb@hM~|@vRvWhe@yp@btAmdAtFfyCfLiCxg@j|@tq@fd@|ZvB`}CwXfpAtXrl@qNr^kf@lPoq@pA_vBdHu]zOcOnQlv@ri@|@f^`l@jeCjuBby@|AzxNcfDrcBtH|d@|W|HaJhlD}Fdm@|FjfBeQ|jAw{Ot_@sa@zq@Gpq@c[vxAeWn`@jOlfAi@`iRtuQfyGwnGbSkc@xNwDjm@rGti@{`@t\\\\yEdK{Wp[oMfGyOjWjKlTyYftAwa@tfAau@pdC{tCdb@u`Bbi@acEdj@m~@t]oPxk@as@iQieBlF{Lb|CbOhfB{ZdpEoTxzAtc@d]gMzNtEvC`O~Xkc@gD}PpKmf@a[}pDja@{y@xt@e]`@tc@b^lQ|lAgA|^uQ~QjJtBlXtMxMtrBpJhK`McG`ZsBfvEbXh_@Uhe@fNh[nJnmBnMrLp@nXbU|f@~OuB|b@p]ta@aInEpLsMviA~c@nQzv@hbDfc@jMbxEra@lInHzCdc@lObLvn@_IpTx@b[|QzkEnMbs@zRo_@`T`TvIlKeLeDz`@lHnLxK_Yba@tTpL`j@vDaHtPrM{f@rlCwXfHk[hvAwg@dwFcDhIaGqHuR`N_p@bCqXl_@tJ``Al^l^vSeCvEiUfZmQeXj`ALbZkL`QmKqGwBbHvB|u@lHpDbJsMrJfCqKxx@kOtT|EfwArNbVod@poAxBpcB_Inn@se@ps@dWr`D|Pxo@sFlMfCh^oK`^sy@fk@eK~r@ePlHySfmDpBlgA_DtJ_IoCli@jzCeKx{@aFxO}hAzr@^np@~a@deAcg@cFyYdPQxqC{N|d@mHx{AilBdc@oUdT?dL_v@zRkSqAs^gY}{@zp@{}@hH}^tVc~BaEcpCtg@qd@hd@aIYmPrXqG{\\\\kT?pGpXiP|XdBzNuPjLuViXgJf`@eZbM~Gd_@mPvX?frAi]tnCrXhnCzTzQx|@j_B`RvlBNb|@tz@rdAqGmP`a@f@pm@tc@lNtp@kSbjB`ItVf^zOtNb_AjoAzjB`o@hvAeGxXL`pAyn@tc@{AxsA{HrUev@h_@oLnQfCpaByXbsAr\\\\bkBaCdnAazAjkC{jAr\\\\goArbGyYxUiGfd@yRdJqMnh@wt@pgAg_@xpA}WzLaKiOaAie@eEyFqMpDgVxk@ia@fRmx@nbAo]deCgfAh`BiVvo@b@`hAkHtTpHbmBuJl|@uPfHsHdm@qi@ri@lPzC\\\\xKsjAda@{u@bn@w}@uH}bBrxAerAfe@g}BtLof@|\\\\}IbSsH`_Ao~@pk@s_@l@wE}Kna@{g@_DsEuOvA_TiTa[Q_H`EpApJg]`PzCvM}TpEHv~@lIzZqKd^cg@~C_^yRzGmHy\\\\iCoPnPmpAzBo^|\\\\tQHyeBf}A{K|c@qcCv~Aqk@x|@k[xEuCaKeG`KcTwEmb@jMiOuB{m@|`@cNdt@~H`m@rkBbcEnCzi@kUpPke@x@oUjL_KdpAvJn`Ae^~C{IdLoXgBiCnFbTdItGtRkSjVeYtaAuf@rV{Jlc@uKcPkC{eAbUofAhOqOm_Aae@}AdUm_@tIhQzY{RZ`FnSyK|Mn@joAlUlUxHwc@tRqS~InKiApr@rl@hl@pDcFxKxGlLsCtZ{`@dXgHnpAVlVdK`JbW?ht@sCzv@uMtc@zk@sHrO|\\\\uAzp@kPth@i|Avq@e`@ePmQnGw|@z}@qAd{@wr@nQ_UnQwLpnAggIZoB`sEgaGwBe|Eg~AmZ_Aoc@fj@u{Av{@mQl^eg@xWoYfu@wg@eJqcAtaA{k@eDsf@di@gOvo@lQleAcKve@{nDdiF{sAzrCii@t_CfBfmBcXhLyUkZeRQsLdo@{LbG}F}m@e^se@mx@lFibAliAak@nIw_@b[`Hzm@iJf\\\\ib@an@y]t[eRoAdBzj@tYvq@ac@lGub@ln@gk@xP_UdYgsArv@Axe@wT`Z}KuWgn@{Toj@_w@{WbJit@mTs\\\\bAaRpUjGjYcFpJ}_AhZiM{e@yOcN}{@v@fXo|BkPmCim@bLgi@rd@qYcUgZoAiTcp@cj@aEjK}p@kIoHoe@wJa`@nF_OiYgXyGnO_{@u^uwAkK|K_t@iWiJji@ga@eOkQnCmf@np@keAbo@aV{Kw\\\\xNbC}W{~@{AyIySb@uj@_Oek@Asd@_b@en@_SqGoNp[fRthAgJnTiRxGwEba@~Jta@v@bnAlNdZIrt@yI|Qq`Azk@_e@xaAgJ|d@|TxiAuIpWcVLib@}Pa`@hZ}t@nCeUhW}FcN_V`Oax@hJcKxYlKft@aL`Wck@hTyWf\\\\ggAa`@eXrDq_@lWkT|l@iBtk@qPjPmo@od@kq@zGi]kJqRf\\\\hAdWkd@v]ec@gKy`@vJc[c[gS}@s^bq@}UrMtAvUaVrMkJhh@wbAD_[wHm]`\\\\}QwAk`@w^if@~v@dFxj@}VbAgAzPq^hVmI|\\\\^f}@uL|IaSsMi_@i@g|@ui@uLuVePdCeK}So~@kFs\\\\gg@{XwLeZ`^vIp[aJdm@_uCuCmM`HoGzVsKcIsYlFwx@oRkQ~AaSfj@qv@r`@kQ~FqX}WuWvDy[qNkNbDka@`cAiu@dDmu@`a@kOa\\\\_F}fA|QaiAyScy@mKoIanAwDso@gZkd@e@ci@kaAoBmNn_@ab@\"],[\"oz`O_~g`@~JcLrWnOcH~PgSpAoMuJ\"],[\"gjdOal~_@sA|q@gZz_@aQuY|Dyl@rVyR\"],[\"_mjOejp_@H|y@g]zl@sD}PrPqbB~N{Z\"],[\"obkOm`ka@a[yJsGif@hReBtMvQ\"],[\"{knOw{ha@|Gi[~SqQbRty@~uAleArv@rrAc\\\\~j@`a@|_Ai@lu@vz@dqB~[vcCi~@lIoEyE~F_i@yl@}iC}Wkh@uUsrA}x@gCa_@f]eG{Nn\\\\ivAaj@qxA\"],[\"glpOs~ma@tIwR~OAbJ`WyEvRwNm@\"],[\"quzPcpu]zFaQb^dDaCjKm`@pM\"],[\"akyNsjh`@tOcCy@tW}ElA\"],[\"sahO}tba@jTaEjL|Gv@xUk[mH\"],[\"oghOssv_@nKkKfF`G_Md[\"],[\"sqxNmdr`@fLqBbBnUwL|B\"]]},\n" +
+	"{\"countryCode\":\"IS\",\"polygons\":[[\"iqog@fm{GhLl\\\\jo@llFzIbNpDyUnV}YzPpIf[|_A~Abp@~R~ZzGy`AtOmJ|GrX`C_LvFqjCwSirAzGiY|]cOrDsT|ZoGng@n\\\\td@v|AnF`M|Ic@fVxj@wTm_A~d@|a@~@qk@og@_`G~GaS`e@~g@r]ojAfIds@bdAvjBtZbSo}AkvCgAi]hNqp@}Sna@ba@ihCkDy`BzOyKlHtJtCca@lP|B`GuHhCiJ{Uel@pZcaAxL_@hS~Y|S_H|GrXjTgCnGxeBxK__AfIaAd[`lDxDwHaTs|DbJ}p@fP{WrXzt@lKllFlEgRmEmjF`AgEnV|O|Gj_AHko@dGiJ|GhTdGuc@ui@oaAhn@fCnHaNbO`NvKrr@bPeNdLxe@u@zrAeXl]g`@j~B`PeXtInU`Af~BxKcdEvUi^b`@_iBvG~XkLjtA?hnBna@yeDvM_LbNvg@}Ih`A`FrQzJwbAjJwJiA`lBdLvTrX{e@xKze@vKxiCsI`j@yl@tq@}Fhy@pFic@`QlEdLqr@~TsFdUg~@hJpAeNxgBzId_@`Pkn@|KiDnKnlBtT_`@nCuXwb@eyAhk@|iArVrErNjSnQVfVnTdLb}BiTknBoGjyA~IKtUnj@pa@vkAaPoG`P`c@dLsIsCmX`PqGlIj_@g@`u@sTvw@|SziA}SmKbA|\\\\wUjUlFlF`PeLmDhYaBdGsKaEeXzWuP|aAnI_l@nZuV?jThR{MzRzMSbq@nChMtC{e@rXzpC_HnGf]|gA{Bi_AtF|SvT|`D~GrUzBm\\\\`j@hiBqC`RxG|E|BoGnN~oA~Q~XsEuWnMdOhj@hrArClR_PqXna@f}AxIzrA}EdVnGuThCtTfNu@jIfIyOxDeVdr@dc@fDr@fKiT_A}ObJxb@~DtDmGxFnXqX{BuIcKu_@SaM|Px^i@jKve@hXsX`FlJx@|bA{G~^vEhVuIhAjTdlA~Q|nC_IyI}KxB|MbKoAbQdLuCrXnpAkTiUgCyd@uTfw@~O??~~@pGyKxD`r@n_@xWp@x]|HIx@cIqGg_Bv\\\\dj@aGzd@pGjYj@qLlJ?sCmv@lg@`e@dMt[`^~nCwKlSsXgcAfCfcAeLlv@|GtCrSu\\\\tT}Er@gr@dHvdCx_@|oEiPvvCfEpq@eN|n@bGtKcGbsAqf@vcDgKlcB{FvReBgLwDpG?vtA|Gk_AcD|cDwFvI?bPrGiCcAjfA}g@jgB?ye@iCh]mKgCpIvTsEbX?iTon@|`AlCnNna@aj@_[r{@sM|JuCoGyKdLeMid@{A_i@tTcjAuTda@mKtn@uNyQwMQrp@p}ArYlHsd@pwBfCyeBgCnPeG}G|Bb{@iOgT}QgEcOrGwBpVbFsPrG~@bWbb@lAvo@xK}GucArfFsXxBoGy|AyCvvBzT|e@tT{v@IxvArTtI`JbvDeWhrAtTjiDaPbhD`Pfc@oGfR`Pl}@mAfbAtGpc@cIlOuMqK}Lv@qI~Qca@}ZeAy^}Njz@{z@aFsHkIdNqx@jYe_@dOoG?|GxGmXGakAiCwHsHfBoHeXcJoz@|Gi_@}QabBsNio@oLzXThXaIoEqDw_@xA}JtCtOhAic@_FsI?xKgCyKiTlpAmE{QnF{|AzJwg@mCkHgC|XqGoPgCzKBkz@qKeLbEj`@qJTaGw\\\\?l_AoNfBrAddAaKkWgH`BcTax@eZm]oAu~@sCxUgLwt@pGucBmKmP|Eb[}E|MoG}SeBppBnDht@x^b{@p[t|AaJztAqa@_gAyKemA~Cv}A}LfTy^{Mh@oLc^oNuVizByKrXg]qgA?qa@fJnA|Kuk@l@_s@}B~i@_Pda@oGsX?j_AmRin@iAwz@iC`_A`Pxv@uT_YxO|d@lMbC|W`qApG{BU`c@xVb|@{FrO{R}Ix@|K|u@z[zJzp@qXtc@nGhTke@iTuChTmK{GlK`{@yPxNcScJu]tz@lMko@sUk[oGpGsNmgAgM_Q|f@jdCyDnKui@__AoG|gAqG}G?vK}XiTvKhTcG`HbGhKeAfk@vIt[zGaPxCpx@yC|McAoZiNnT?daAdLui@~BvOqK~i@wD~mFoOhc@zCb~AdQtc@uHvgAyC}T{@nI|Nti@bZlMbE`PnHxbB{TheAkpAdfAuWkbC`SkcCmKgVR{XcQc\\\\NiqAgLzGrAua@rIlCrAgGiFiPfRsDoAeTmMmWe_@pG_Kej@lBcr@le@jn@tTqa@aWj@ab@av@W{PfPe^sXqaBuClv@qXw`BfCdGhCuTbG?pLna@uCme@dPoH~Oez@kTh]oVwr@cCkfBnRokC}GwT~GeuAwCu`BuLePsF~\\\\iWci@}^eYsTaAuFbn@jGjR|GsCtf@l|BuMfqD{Orr@~E|cAeHr[sIci@tC{S_HxLhC|l@y\\\\{e@eJag@eIt@?y^yUug@kGwdAgq@_cCbGca@yKnG{\\\\s}CnGtnD_PqXsXba@fCna@xGoB|@lMyZb_@jRw@vMrM~Xfg@[ba@gc@jrAkM{XwOcpBcUiIkK}Xfd@ttAjDl_AuOqa@gC~KzMje@wEfYbNmCnIrj@yKzNuXq@gg@vZxDxF~f@mFtNtY{QlYpVlNqZb_@uSy@wJcMqBho@bkAeYzBvG{Bp`@aHqB_FbI{Clx@i]wz@{EfEqIp_@|LlB|Pp[eZ`]z\\\\rEbFfL~Fdv@{Mzs@cL{@fClP`YlJxKyJtOr]sCxmAwJv^pBjHfC}GpMxq@nArfBz\\\\fc@lLrqBwGhu@eM[qFtLhCkToJy@yDnJbHrS_Y|mB|FdqDeKhc@ke@yeBi]_EyK_f@dS_hBls@svCeGwt@{Bbv@ucAzvBrX_nD{Pt[gVjiBsQ`c@wTrXe]nGyHoYv|@maH|Sme@bJkE~UpMrAeJuR_g@pGmKiC{v@wKxKyKelA}@|jBeXrq@sIel@M_jC{CyKcG`PgLme@zNzoAw@ngDcMxu@~C|_@}Xls@id@l`@{QeAwK{e@bL_qA`Paj@iCer@l]ojDmCuTse@`eFoaA~qCk\\\\oBkJab@d^upCu@qv@dQqIxFoVa]v\\\\wMxlAgc@tkAiE_sBvQov@sXpt@{BziAyRwXoAmc@yM}WnJcaB~Wy}AxSqYzUy@yRy]U}QfQ_Yr[wDzh@fr@sr@apAtEeKjR}C}Gke@b\\\\jXxPte@cBqc@_`@ie@zOuG|g@hEg]iTov@gLcCyq@rNuHbc@cw@l`@~Hjo@ve@hDoCyeAi_A_PcCtSwq@lRY~AqGyKwKpXfCz\\\\rXqD}V{a@kWoE}Rew@|e@cPfe@a\\\\wTqLsi@`L~aAwr@~jF}Qtj@wZ`YoPqlCrHiyAzN{`@wBqGeLxKuOyvBnD|sAwIfe@aOez@}FzL|Qv_AmAnfAme@sX}Gke@ba@reCuBtFeYmUrBjTxb@faAoI~aBcVfx@kBkxAmRuLeFjd@mQt^gEcRvMcy@eLzKsCocApLgPkBcRgUrt@~@qhAzZmtAe\\\\{jAvVm{A{Lv@qI`Tl@aS`S}`@~VuOlJ{q@vJwNdEzGfEqa@jDfOhW{G`HtHfCaG{Gme@|GyOoLeWlLaAcC{aAfT}b@~Oti@uBkj@nO_i@tCw_AbGrCjI_P~IlGfb@{KjFcr@h]vOuI{\\\\wZbL{Gwx@xNwWbOx]~KyMiAsrAvK_k@fLqF`P~rDxGoJeLinBjCkQlPna@cAyrAjVaJ|KdYbLy\\\\|\\\\~]`Nt\\\\bIl~@lPmyAl^rdAq@xsAwn@taALlx@xGqh@xOaZtTdL|Zke@`BmrB`KgYle@fr@qWidBzY{LxUdLln@baBkT{cBpYq_AnV}Jpw@A`j@e[`OaX`Z]vBeIgKiNgr@`a@gb@}[yjAxNyGaGpCgRz|@sx@bE}\\\\uCuCcu@bi@gj@tFyj@eWiNaRyx@uvCnMo_@|fAlMbCuSoThDBwU|\\\\gChTea@|Be]cC}Lu\\\\_OmPl_AkKcjAri@oMmi@cFsRw\\\\kReE{Bi]e@zLmOvH_LuUcb@Gy_@li@aJsOeQhEco@fk@{^~F}RtYsNkQcQdDsCqKeG{}AaPmk@?_y@jLeBnOeb@tCfCp~@icApGdG`Vwf@nJj@hTcjBhaAgc@rFoLvJok@_QiG^gRpGgg@hE~JhDqFsN{Vur@wJuv@t_@qTbd@s\\\\y{@c[zVwGuGsL_n@wDkrAhIyk@iIh@qCiNdHw@jKqm@qXn\\\\i`@wUiOolA|B{R`SpC}Sqa@oGtCoAk[hEk^d_@lPsPieA`Wkl@xX|W_H}e@rL}_@j`@wPna@|M~M_wB|T}d@tY{O|AiYjd@aH|N_Nf]}@rXet@nRFjIuPyF{FwUzDmVt]w^iFoGcPsTw@qi@lSiO~^|FvLoKvZyTzAa]pm@}GuCoP~Oa\\\\xFi\\\\kB_QwO?k_AvQmvAu@iwAvTaPrAcj@b`@s_Af`@aXlKw]|m@im@{JqA}Y~RoGqr@{BpgAWobBtTgW}SxBqa@ca@{\\\\yFkSuw@iWdA}TacA}IqEpLw~A`e@}c@dLpG`CcNeNeW}GwmA~AcVnGje@fJeRwR__@nZmk@~@kS{Qtc@{VdRwSy_BCoMzSpgAoG{bAvT{v@}Z|d@sP{GiIqSa`@gJcq@ly@cJoC?nG{GoGmKdLgCeLka@ng@gOqQ]mLbLyKwM}GmDyb@rPXlE{JkKrC}Gea@xKma@rCxKzBwI{BuZ_RsSxHwKaTa^fE}s@uEec@`YiqAbKhYpH|B|BqGrCxKpGyKrDjFk@abAxKxB~DcpAj_@}Oba@tz@|GoGzDkk@vRrDfCwiBbOsJf_@apAiDwMuC|G}RgWwf@SqIajCmNoFuJgk@uWyT_Nah@aCot@vKqt@\"],[\"sn{e@puyIbHaDfLrLqInAtC|`@\"],[\"sasg@pv~IvNwPwB|U\"],[\"{cve@tvjKlHyDjMjTuWzH\"],[\"qhhg@r~eJ`RsMe@`TcX|M\"]]},\n" +
+	"{\"countryCode\":\"IT\",\"polygons\":[[\"i~_[ychC~Zqi@nAqf@aVgrAm@}aAwi@a{AbJu~@jXqf@`C{tAea@aX}UsfAiq@yOmUit@gSqL{Uv@_c@fb@kJ{GkVy`Aae@qv@{I_IeJxEuPqQqJyr@dA}PtUeJjtAbMfXiHdPcl@dt@ex@zOin@qG_\\\\jKet@pM{N`s@ph@nUm_Axf@uXrb@pFgDwWxGgf@}o@}d@iPt_@qV|Q}P}T{[lGaMub@wn@sRiTkl@{RiJ}OeZs}@o^it@|GkKhMae@uZIyi@tRcT}Rg^zkAcEpJ{Vjc@u`@fCcuAmKiToWiFtF_]kS}|AlHo[fc@oGvVy`@vW{EHsSqMyj@}JeGsh@|h@em@s]cZpfA}KaJqI|Hu]}AsR_W_XkI_Me`AnDaYtYQbN}d@jG{{AoXuLe[fCoHtf@_TnOwPwNuTiBqKcQ_ZdFgNiPwXqDfLem@{KsiAlTmx@hEaEbNzRnDwBzBqdA`IsUcBanAs[}a@}{@mWe_@sr@sMavAnI{{@{U{d@vJ{_@qFal@hDqu@hL}^ub@{eB_GegA}ZofBlBwMnOJtd@vx@rg@aR|JfHnXivAtp@q@tM_m@t`@iPdLsTtV}wAvDkwBzSqw@jNqdEnS{s@cFiyClI_Q~OkxBfi@tM|Dho@|`@zj@nT|z@vf@~i@lr@_[y@aPaJbHfBm[pWuw@hA_p@tJwC|UzPzc@pdAfLfM~JyC~JrPjWw\\\\gJi{@zJqIt~@l`@jT`AdLqHbh@kzBvy@yp@~IuUl\\\\h\\\\iD~sAmKoJWuo@wT~i@uBoJyInAuRdP_j@x{@sIda@w@hMdS|OfKqA`AsOre@v~A{CrNyMea@wInDyKf`@cFpfAgMrW`Pbh@yK~QrG|EbLeH~DlYfRnBkCum@te@lg@hGdwA|m@|dBbgAd~Ed@jG{\\\\{K{GyKtFyKsJhCjFi^mO_CeTsd@tIvZmVdRzYlg@nJ}D{Bz\\\\gLhCdIzFnN}WeC~l@~HnX|_@pb@pNbC`IaGhTpGnClLhGgDfN|Ht@re@hLjBjTwRkIuJsNf@rBmKrYwCbDrGfWcKvCmUiTqXpHxD|YmMpk@sCiCfLyK?zWrEnCqZnYcU~GbLnGoGkPcJwObJtv@khAoCcGuCdGCiIjLqRx\\\\fLNpG~c@hFbYtYiTzKa\\\\}GbErRhSpHxCqN~IjKbGaPvIzC|EhXu^ll@dIzUxBoG`cAzS`cAoW|fAh@~mAqT`dAs^pdAwcAr`@sz@jz@khAxMgeA|^amAzcCwiE~w@qhCiKuYvc@gf@vF}UfQ[n{FcgCrlEucAt{BupAjoAwyAv{@ggB`_@_Y~z@_qAj^c|Axw@uR~_@opAfLskA~Xe_@vXcjA`LmnDeLgfBfC}dBiM}|CbN{eA`PwKMmJ`fAeB`fBrpD|o@jA`o@og@zaCw|I|Ywl@dmAo`Hp~@ahCpi@uk@fr@coApr@eiDj`@uz@xM}dAvMPkAq_@l]sUb[rBv_@sr@h\\\\}hAruBk{BloAgp@tT?x]hSlS|]hZdMdf@vJz|@bAxK`LeAtQuSdb@yBfk@cl@jvAks@pn@iHqNgOxAeLd]uIcUoLaDeq@bt@ol@lO{BeG}Q`^iJjzEaPleA{v@n{BcLwY}HaBuItKVqi@yNgPbEne@uCiCcCfL`Lzb@aRfTeCnaApO|l@r\\\\~f@vxAzoA`~@n_@rgAvmAn^hBli@cSnq@zv@|p@p^|Qd@p`@gW|RbG|P}QfUip@pGqaBxc@yf@ph@ifBvf@eo@fIyj@li@xU`j@xCtc@oRziAfLn^gTzE}\\\\dNfT|Z?je@vi@oKrDdGrl@iTlg@tLdiApWfdAtq@zwAd|@v^fE}GxcBmP||@rCfc@le@~y@b}BtrAptAnuAlZpg@d]`Lrn@yGxc@|CzoBuPdo@wa@x]{SsM_^pT{^{Mgo@jH}KcJcV}sAcMmOcfCs`Ame@`JwW`b@mRrCwn@wfBmBw|Am[e]cq@_L_f@jCoUvd@ejApk@ugDr]oy@bWym@|e@sVpb@_yArj@csA|Uce@}AusBx|A?zv@js@~lAiExh@qQ`QvAd`@mJeJgOxH}z@btAL~p@{XpZqRnx@sIrAi]cSsOdCmNye@oWkAulBlcAo{@vaAgCrQfPre@?lg@dU|q@{J~b@ve@~xAuG~F}W}CyFa^kc@sv@sTl@{NtRaCb]go@r}@rCbk@zQpKyQrjAz\\\\?yBvT_]{Ass@jRsm@~a@aPp\\\\qXpCur@zc@u`AbpAMtnAlHfRtQkF\\\\rRmMnQ~CdHul@plBQbW`Jv|@|Ohl@fN~MSt[u_Atg@ui@tt@}Jn_@iBdy@eY`z@hJtJqqB`tBkq@njAqNbv@qxA~i@ot@bqAmPnJ_[v_AhAxv@qy@ho@u}Anx@mj@liAgc@pdBoFhfBz_@dPsNfo@}OjKiNuF|@ug@iXcNiX\\\\_ZvPtBrQim@x^iU`l@oYxHmS~[ke@tcCiR_Tcd@_GwMzGcXzpAzDjy@|GuC|DnPoH|J_^tLcTuW_lAmQi}@nJswA~w@_`AziAg|E~g@}bAhk@yj@hw@oTx~@lHbO}f@vhAvHtNxKmPvKzGrBeGnAxGeLxFul@vtAkFle@uz@ltAtAv\\\\eS|e@cMfCad@ttAf]lKqM~f@uTjIuEnPic@hsEznAdxDvKnLdZtDjGtM|ShAtg@x}AnNpMnc@pIve@vf@tOqHjd@t}@jZnbAhRtkBzMv]vBlqCyl@fN_oAs~A{[aMgPyUyIg@{c@f^iSqGv_@zcEes@fyBkYfjB}eAv_Ae_@sCuLkXcWhb@}c@~Ncx@ucAiL`FyU_Oa@qz@acAnb@kTaCaTbl@r@nt@m]p~@aL|I}s@vA{Lzk@iWlHaQdTu`@qrA~OibA}EkSmN~@uAeXwWa[oJkk@`HcOm[a`@og@zHiu@qi@iN|f@sTnNuXvv@_s@tQ_\\\\qEab@`{AiLrKcd@tGs]wJqFsYhFcLeJuDcGen@\",\"qgxYkwqF_AmRqLcJsWyGkOjEjT`u@|MhE\"],[\"mmnUujiFl[o_@fMg@jOhM{Mjj@g\\\\rUgIv@iG}M|IwX\"],[\"e_dVwunFiCsXfLca@~E|EiBfc@\"],[\"cbkVmhnH`PykAd]dr@~ObEG_I`gAfn@biEnrDfa@mCzuAfb@jw@bl@h{AmF`l@y{A|MwDwFdIfOlNyI]oCdIfVvLt_@uSeAmRtDnJrR{IrIkb@dWHaCfHzKrAbDkVzPaF{BtMzInX`P?bQjy@lKdI|lAfg@tlAeXtKfX}\\\\du@tGnNcYr}@pRj~@sOb{@kP`OeP|n@qFhz@w}A~x@{|@hgAui@h~AdF|oCgWpe@{R`hAy~@rzAyNzgAiz@blByVlNoa@np@{Bh{AmYnOsSzb@bA~oDiu@~q@mGvk@eqAnp@cOwVoc@qGyRbHss@gWeNfEm^cs@xDaSu`@w`@lBkYmH_L_^lByImFjFeQl_A}b@b^ur@sa@ecBoFeE}[jIe]{[vGmaAiR{RoGmp@z\\\\s`@nj@eKqCikAfIkPtVyBna@y_AbPivAkn@qnC~Ngf@lDuuBmTebBrFcSoCw\\\\eVykAqn@}|@yQkkBtBuVbMmNrCqp@vMiWqP}v@at@qf@wMdAv\\\\cDjE_\\\\_OmbA\"],[\"qsmV}hcHna@sXnGxKeS`W\"],[\"emnVidbHoNlCyMcNFuSpTkLxBtOnPqG\"],[\"c|oVqhaHcMlj@}Dwl@fFgE\"],[\"_dzV}cdDjAoPnKjNrr@|LjB~Ow}@xd@eU`AyK_HtHss@\"],[\"yn|VcfaDlv@?q@|^yIs@kSlS{HkB\"],[\"o}yWmjoGrCba@eLrX{ScLqGpC`Pk_AjK?\"],[\"y{`Xur`DlGtMj^~Ak@~Uu[aKqPe\\\\mUk@LeNeLaNn_@mH\"],[\"ccwWcg~DbPkThm@hOng@bWdNx]p_Azt@xh@aBf_@uM|d@qo@tkAb[bPyD?gLvW|SlYuFhc@lOhn@wB~g@vNpt@e@rg@jNbJiIdoAvYlQ[hQmR|C`Px^xWz^uFtVlGdNhZtKpAcQ|LvDj`@q{@d}AeCln@pXl^oCdQ{`@|f@sH|c@~U_Np@eY`p@le@jYGz_@kO~a@~J?dLhhAp}AoP|\\\\zK?{TfWsFf^vLz\\\\|[jPaOc@uDxYi]wHyIrFvAnMc{@xK{Bna@yKqGuCpGpEnLmW`JXhM{\\\\fCaAdO_`@|Uyp@ad@gVhH}W~Ye]kRsYhJ{aAyn@soBbKf@kN``@e]Rq]wKje@ke@qXvK~O}ZeRm[_C{[zRoHtd@lHhP?oPpXnPuJi@mUzYmcA{FgFtQwPcx@mYkLuc@rOqoA}FqWvTwKpa@_l@iDa[bHgYb]ci@hKyIxKnN~m@uCtKiTuKiBhMp`@vRkQ`H_SdCgIwS_^wMqVvf@i~A}q@uSnBqGfRgUyGeFkI|FcKx_@sAbd@co@xMmbCgOuo@eZsYaNsi@fCoa@}Sic@wn@sc@eG_Yet@wr@iRgtAqRmLwc@yCiKed@fOmV~XkEuOkCtO?{B_PnGyKaNk@jRc`@?kTvKpG`PkTrX?{`@kOeAga@lIOhCyTbQu@bKfI}GfChn@~O?uK{GtC_CoJbP_EiEkf@rIi\\\\dFbF}Avf@`e@hI~Cxh@xKej@{G_j@~OnPzIaYbJuCoCkKxKqXlHzYzJoDxE|RhXw[hp@kXht@oF\"],[\"uzzX{xsE~a@oZnFhIw\\\\le@eOgL\"],[\"kycYc~jEbc@jG`NvXdMuUtJoBfGnH}a@no@rLbNoIvDnHlW}FnKtLxHe@pr@qKfXsOjBmPwRtCarAiM{BTea@`H|ArBwNei@ug@\"],[\"wbhYae_E`LeH`K|FvIjX{KpIwV}L\"],[\"myuZagpFoPeVbJaYiCda@rm@da@\"],[\"cs{TmdzFlK}FoEtO\"],[\"msoVoewGsFfAmA_HrFgA\"],[\"kmpV_u{G~J}DsAdTyNtD\"],[\"iesVye`GrDqPlGlZ\"],[\"m_uVklhH|GaEjMnK_I`R\"],[\"gx{W{aeGuBcGvHxI\"],[\"sptTsouFzKtEePbt@\"],[\"}pdVexjF~IkCiTp]_DoM\"],[\"qkdXw`xDrf@nEgGbPib@kM\"],[\"oddXiewDzGfUaKzLiSuX\"],[\"q`zXsbhEpAyJpIt@qAvJ\"],[\"k}~XgbdEpMrB{DdU_SeQ\"],[\"wzvZcyoFtDgTfF`@dD|X\"]]},\n" +
+	"{\"countryCode\":\"JE\",\"polygons\":[[\"sba]ttg@pNwe@fMwFvXbImTzeA`PfRqGrRtC|Sin@tC\"]]},\n" +
+	"{\"countryCode\":\"JM\",\"polygons\":[[\"wx~Ixopm@pc@qQ~Vw[j[riAwAbJyKqGjTd_@mIl_@vIzbAqCpp@me@n_@qI``@zDzlAcGucAsGjn@aQj_@fY~M_@fGrw@~NhPhQcGb{@{GeLeWj\\\\nJlo@dXtKhPwCdG`PpLgCzB~XzGsUsCwWha@kCzBf[yFfVyz@n`Ay]n{@jKps@}Jbu@zB|wA}Upg@y\\\\zKoEtMci@tBoHddAof@vRaJlS_l@pQwJtxAtEbX}PtaAiZfTsOcPok@mFkRk\\\\hBoIma@sXaFgUtJaOmIiHnEgrBin@ii@{A{t@tRw}AjI}aCfJe\\\\i@ysApDuUdLcG?oc@xKePGqvCpSoAf]yo@j^wQrFmu@vTg]|@i^vMoY?}m@pLmInO}dA\"]]},\n" +
+	"{\"countryCode\":\"JO\",\"polygons\":[[\"ei}RkpvTv@cNcj@qrAvTwmA`Mi]nIdCjIeJl@c]n\\\\o@rw@ud@h@_w@qFmLn{AqbCvU_eDnMqUaDkWfHwo@utS}le@|aPuoDnLjp@tzAs^jK}IwZmuBjEyKj|@iU~mA~yAjlAjqBpyHlcf@hmHidIx|HkxH|gBroExfEl`BjIlLdwAlnMh_Ff|CbwDv}FfEd`@adBzySaDcG{w@cC_i@_Q}HRuNvX_PuAa_Duv@uq@eBcdAcS{v@{c@yjApK}gAaV_j@xLysByg@e_Aqc@wd@yHsYeV_i@oByl@mf@{d@}B{p@i\\\\{a@l@k|@hXmxAwb@euC_Pwy@u\\\\{Q{Re_BtT{NgLweAlMcQ}L_NdEuZcNqr@eG?dG}GeGykAxKhCoGaYxKm\\\\cPwK~E_HuJaPnPgCoGcPzBsC{Kiq@dK\"]]},\n" +
+	"{\"countryCode\":\"JP\",\"polygons\":[[\"walRoyenAz`@gI|HtMht@f\\\\fMxSyKzUeJnAoCaLsf@eXkGcV\"],[\"_iyRknjoAbK~@hUiSxThd@t}HxpBha@kDfOgXbTbLhwAnK`l@hg@bc@y@~hAta@q@nKcGgC?~m@kTfCgS|Ql@hTyJnPpLfp@`u@jc@lTow@bKG`PzQr@ec@z`@|k@rAn_@tg@rPtTpWdZt{Arm@dsAmh@oBk_@sp@wg@yL{IxEok@kUij@oB}w@~\\\\{MlZau@zC{Dtc@gRv[{Uk\\\\|@e^zLqTdTGGmU}\\\\gXoWaFuUrBmMpPoHnw@vEvXdSzRthBls@nd@bGhiAc\\\\vc@ce@rA{U`t@xKlRj`@iGlg@qc@xO{LhZ|@`nC}q@vYz@_PkFW_l@nv@iGcZjXeh@sf@sg@qhAwXyp@tDgp@hh@sV~m@sR|Fc[yJvH_[kWxVmk@sMiYpH}OjRsJTiRkReN?aL`NcXwi@vS_RaJqXil@mWicAk_Am\\\\}ClK_T{EyDeXp@{l@ie@{W~KqGe]qXhTge@{n@wX{O`PthC_l@khAsE{d@}ZfT_VaK_RlJkbAx}AmeBzKuKxXgSbHyDnm@cWr]tn@pw@sDpM`zAez@ld@bTrW~r@zSe]o@{X_Rk]Syf@hKaSvn@cUvXTxTfM`IeEzUna@RjVxZd[`Gtb@iOgAwL`XwYZ{Qwe@}JsK{Jt@eE~KgRfDg@zs@~\\\\j_Al@vKsKlFx~@vf@pMn`@dh@nc@dBpYyF~BqOs]oa@uRgHgAgHfL{FeQtEgD_RmNp@`Uox@~_@fD|Vw]p[k^xImLpR{_@vB{fAw]lHcWfSaHYsN~PdLpP}@{OkN|CeRbRmOng@jIuSjKte@{KzEdGzU{^kVsV|Q}iAes@|k@iu@_Hk\\\\bg@hK|b@gK\\\\cHpNzJ`MqCxPoUhGdA_IoX}DjIzQqUnBsAdGv^pBpEnVwNrAyHmNoPjTqK@eUz}@}[gGaFkOgVnKo^qDiEoFrLsP}@{MqU_QpUiAzDaNaF_ZbIqf@`ZuFnN{RoUlE{q@cPub@zq@qQmRbYaRI_M_k@pToWgI~Kqv@xJ_KxSpK}@kLxP_GdD}X{]_WiWmeA_G{BhCtZ{S`VuSyf@mMw@oA}VuRkE`LyPzNdBlCiXlM`C~GqNoMsNuCgn@o[kIsJnDnP`^}GfYiO`JuByHpNiXya@}|@}Z{NqWvKg`@qW|BuJyRkXa@at@i_@a_@}Dwk@pAcl@`KhCiBaXpQeK|AmSk\\\\mUmP_c@bEgFrc@bOzMiCAnHvIyBjXbQ|@g[xh@y@`y@ea@bQaW|]kdDiCi^ez@q_AcCcj@zHko@ju@ye@vz@{Czm@lRkCdh@rYpBtD|StH`BqGjw@`w@wAnJmVh@eg@uJmY|Im[aIyEjOqdAwLgq@`h@~QbRvXhYjL|GsSuHkOnAuY`RrYjFpBtCyIdCm]eNgGfSaTuRoKhXkGpA|p@~f@nObW_b@XgkAfEy@~Drc@tT`Z~IgJjZzZk@kY~JVbSbi@eBd\\\\x{@rOkIfTv`@|^|d@dP~BbW~c@fKdRc_@tMrX\"],[\"qusScteqAgCke@dk@jjBjPlDdr@dgBvJjPpFwFfYfUtDlXjQ[lr@dj@lkCpi@ya@tTq@fU{Kc@y}@pr@uKlb@gc@fZiOhdBaJxMpLhqAle@rwAz_@jOxGtm@tUdYeNvExEpK`WnVth@cIfX|IvRvVvPiEsA`UhOtNtv@li@jNM_Ib[tHpTbVjIphAOlIr\\\\vVGxm@al@|JGpDbOmh@nu@jAzLrLhFwIdM`Qxh@o]`q@jVhs@s\\\\tAucAst@p@vw@uQdd@pGeGmCz\\\\~KhCnG{KpA`TgY|RoHqLoL|EeMsJu\\\\vQ_Fj_@`UjM_A|G}]}NfImZgHsR?xKqP}B}JlK?uOeJ}G}Dz]eOt@mB{K}NxTnFtNmIlAAsg@vTgOqZqPoGsTmM|M{FqI|A`TsJlM}GA}MqXlE|`AmHzXwL_ViTqBbGvJaU`FuJsIeHdEgGkMoL`Xb@xl@xb@`oAbZr]aCdQkImDpVj}@ed@ks@iFel@oI`FkD_IqJiy@ik@k{Agw@cv@cUe~@oa@ym@uXyKwb@jCsw@}LsBqYwt@kHgd@yx@yFmb@aZmC{E|Pv@qRkLoGjv@{v@by@m[nOa^kM}gAcJgBiIam@zNwtByHoVm|@}x@{`ABym@tr@vUkfAcD_Qsb@kq@_Xsx@mU}NyEiVlO{m@Tij@yPeKxHaIiQsEg@aIxh@eMIaNoQoO|Raa@`QlGfZqt@Tg`@vX}YeGujAkQ{[bVdGk@eIqM{Ub_@hKfAbKbBmTxi@`Vrq@rE~PoEaE_SbYeOrIuSp[iCd`@jb@dBgk@\"],[\"sg`Uy_gpAtKyGrOrFfYpZ_d@k@eMsF\"],[\"ul`UslfpAmBu@dEuKhAtK\"],[\"}r`UkbfpAPkGbIFvC~P`]lGmBtNaLbEvAfK~PqGhEtD{Mn[_j@yv@\"],[\"cw_T{{lpAr{@tq@pObd@aJjFwOe[eIhb@eKiJmNhQl^~IvTv\\\\~SsO`PlMqGjN~Xhi@kCpIec@pAmTaYpVLqAkKwS_HgAcLaPjTuCrXtCzGpReCtJtO|I~zAdPxc@{MnInXfm@zTyDbExi@jOd\\\\XdQyKpGhOzc@kFbE{GmHaj@`b@sX?iCaPcJjG}D~KxFna@iJpU`Kdd@rcAdlA`l@gLdN~AfTbVhiAaF`_@no@l{@gOhg@`aAP`HsI{@_YiTzA_RgYzDyZnnAiT~Qu\\\\bw@zOzWzB{GyAh\\\\_Ih@sXi[mQxq@lQnbAsEbXtTqApIpJ}[~z@d`@`YyKzKpG`PwReF_MhHno@zb@hVpm@gOjYtKnV}LdJeNqBe`@ff@cJfi@vrAziA}MvUyFOH_Kce@aJoYxAcHjT_[nIcY}]iQuFsz@j_@eg@mL}VyvAqL`p@tCfLsRqNgF_J|P{SaHqg@fG_d@tSiCvE}ReO{JmUvK}Cyq@jQ{PaEh_@f]xKyMifAmMgF}Cwp@uQRk[k]kKlCa\\\\ar@y]_Le@cWwYSvBed@cL}MOch@mWeeA}ZsHiAaMafAmuAtAaK_U{DqnAgkBePeq@sQcEqSi`@i^sPsr@klAaVwaAyTkN}a@uEoTxYgLuAqEweAaKlMaUceAyEoqAqNeAqAud@iPmJ_@oQkOeEhWwg@uKsi@`KcHoGmj@lDwItTpk@nVgEdRqg@~Eck@}UsXsY{uAdRi}AiFwoB_Mq{@~IoOaP{}BkJkZcLuFeEgi@wn@ulB~Fg`@{BaaCrKoZaGaLtI{f@k]ae@}@uXuSob@cG`@ySexAzd@ah@bXqGnGhCsEpKhFvKjx@bk@hKAjAoJ_J[kSaX~PeM`BlR~HT~Pgf@_FiMbI}Efe@hI_X}S~Ae^oN?aAr_@_PvFcN{o@_Yo\\\\tRvEzFaR|UlA}NwSb^VlBsJaAo\\\\g\\\\al@fDaL|FlP|TjKqA}~@wY{M~EbPwMfQkNg\\\\lWwa@{@eXoXvQkAo^gg@zY~TibA}Lge@qNtIeUyEaEfJiPFcVyf@ne@}OfMjKxLyK}@oLcfA_Rs]bQwd@bo@w^QgVdVwOuBeNaVcu@eZsv@{n@oNcH{PrE_Fil@yr@q~@kZms@isEc_EkvAik@wY_BuFjHqa@wIgm@n\\\\ku@KzAzZ{FxB}o@aU_FzCm[cUeXhFk]}e@iTs{@hDw`@m`@mtAuTmYaVqwAiCcXzNoa@vd@EjI~{@v]bIbMeFj@sJpHj@x\\\\|TmDjg@zIpb@ng@vV`Thb@eSti@yIgBfe@pc@dQvFhBuHjLp@bChNhTzC{@ml@pSa\\\\kD}M}WuKcAgMnqAqCzz@th@|TcJ|m@u}AjAqwAqBsTkVm^go@mUgKpB{UcTaa@irCmj@kdCkl@}`BsXqZzDyh@yLiq@kuAkwB}a@ueAqzAecAil@iu@meBgf@{h@e]qnAqbCiAeg@mRcs@o_@kv@kcAkjAyjCeVop@aRixBqaA{{@chAsk@_c@qdEyhAyoAqNog@ap@{mBi`@gjCuEs{@xRwa@de@oJd[pBlZjR`ThAhs@kh@b_@aLxAiCoHkDnJmP`AsIy@xZ}jAoM_[gwAmdAwcAwSuiAeG{f@zo@smA`AoO|GoHhGoAnc@sJgAwV{g@qz@gl@aO{\\\\jMwn@aYgn@z@sYaQ{QwwAm[sl@oBxXy\\\\k@}F{S|FoA{VqGnDc@`l@aYbBwPpLqFp_@uHaDj@{\\\\eGgImgAuLbr@}iAmY_u@vBi[dOgWn~AkDdzAyRlYyQhJy]mAg\\\\}Za`@ee@uJaD|QqAcLq`@_PpKod@hRmSvL|BhL}r@xQ}NlGsY}Vyc@sn@wXuiAoKg]uR}a@rLm`@j^sG|ZzRbNgEyLvKtFf`@lv@kGhq@nMpRvDfs@fUb]iIl]{^BmrAmVmv@eTgf@ag@}b@wBgDaCjd@aw@nMi`A|n@ou@fTuq@cKiaA_c@_p@|_@|@ng@`Tx}ArSrpAxAndCaKtzA{RjbA{`@hRqXyE}Yn}@agAlvBevAzNgFjY|PtZ}f@bNrQ|Z`Bbm@}aAxYkFfQ~J|XqQ|cAsStcBvZdGsBiXsj@fa@hBfTuWle@na@lPxe@|IeJg@{NaWaX|IwLhFpJhVdD`Br^`PlY|D{Nd[vc@@}X{KkT|Mu@bS~i@hKaJ?|StO?W}\\\\pLk@zH~JzA`_@~KkHEkStIpE`J~k@ba@mj@bDfMgHja@jGnJnPea@zBh]tTaN}GxiAf]yKpGvTjMoIJhOsK`NkEhC}GyKdEna@jrA_UmW`VpD`]jf@\\\\|^zg@pWk[vNLiAvMt[xp@bIwEfGie@`f@pi@tTw\\\\{G_H~QqJfV~AsOnPl\\\\jK|GcGtIlVlVuImEgRtIeRiB|PzJ~JfF_Nxb@_JvTzIsRve@}MiAyK~XmP{GqDfFePflAxLx~@bHlLf]~EE~Ig]tKrEv_@b_@dRi@_RlI]f^pf@nm@p^tjArXrs@vBnn@oGjdBov@`]~GfRwKjhDoG||AfT`y@?xgBt\\\\bq@l`Bf]`Fdj@~^`}A~\\\\|dA~j@bl@jG`{@gCda@~_@hkA}Gh{Agi@hhDgyBtLsVlStJkOfStRdbAeIxAjEna@rkA~mBbn@td@ncAhVzp@_Pj`AxJrKjIqA|Ph^hPcIvOnFtXzL|HkEf_Af\\\\bTpa@rcAxZxO|Tf@|ShUrC`s@eZdS_Gr`@eKrAGya@mMme@mPjTgRsGgo@fGaMzI{XoBsNkXyLUs\\\\rIiNti@mE_Z{\\\\{KnF}\\\\aHoHwZzCm^caAmj@gh@h@mJmOkFD|H_V`Csg@~z@`Clf@vN}J`MnYmMh\\\\hMbGwM`RzT`Jm^zMtLlCfx@qXtOzEj]fm@nEzk@xLe[tRHQ|^jNkLlp@nDp]u{@xHfKjOi@g@nWnH|LxHtBrViN~B~i@ki@`HcE{Le{@zp@rC|b@mJzk@ta@roCpPlVt|@qFsGhV~m@pZtLNjAyQp_@dKja@ab@xk@`@`HbY|o@r\\\\xZ``@f^fG|SaDaF``@vg@|`Ac_@|f@yMq@yK`PkVr@_M}Que@jOwSyGuSfDaPoUul@lPa\\\\cN~BibAuEsG_x@d`AuI|aAxYxwAh[nOjJrQ|XuD_L?cBkN|UxIhf@~zAve@fW~PyF|d@v\\\\l`@fo@fk@~LlKqCzJsW~CjC}k@`eDWvm@tPz}@sR|bAaChtA~DriA~o@hrDpEzlAst@mb@nWwVep@a|A}OcMz@}HfWiBuUsBOuRkExP}UwDuT|XTnt@`VpBzBdJqKMLx~Au]nd@kx@}Kro@|\\\\~j@rHrXk\\\\bWqDcZdgAiQdGci@kLuT`LMxH{r@rAqv@gb@k[sBsAtGt`@hMeUpBkAdG|[wDdBlOgLlGhN^}@dl@~Ynh@lUnIvCaKtE`InX|B`lAf`A~s@rHhQyO~]{AxEgr@ro@qiAjPo}@|\\\\kRfAmSbc@OhC|X|GsTtOrOje@wFxKrd@eCxXoK~KnGsi@cLxBrCcGeLyCkGbEChWxK?yKhTzFfk@qU_L{@jIrKpb@bIuIxSxc@}HfTbLlGoGnVtOtIjIqDwD|YvTna@rKzp@pQpVzPf@pIwLvJrElDpNaPfR|SjTbc@ie@t[bCAdOqLlHrLbP`EgMpMQ_CzI|MrF|AhT~NjPOdSvZbUfxBjv@dUfZfNgM`g@|m@~Mfn@`SjOzR}BzChQc]oBeG`oAcb@lwBaZ|b@oNcBcNxIqPp\\\\eMoHoCed@yFvEm_@tp@iItp@uy@zp@sIhT`Gjd@iL\\\\}AqLmN|DyMwR{A|MqJ^u\\\\ut@wMhD{Jrc@sXkTyPtBVcg@qG{@eV`NTtIkb@fQuNrc@w^oZcN_`A{wAgeBoTKoWwXwrA\\\\vExR_P|W|@~`@zNGz@`T}MYhEvT~W{LaNdb@xMf@jOjuAwIbQ[hb@ykA|eCgC`yBnGvQaBnMaM|Ale@ti@oE`a@hR~GiMvyA`I_DpGwe@he@dlA?kT|W|XtHdg@mMtUqAb`@lL`c@xDfFfM}IsWic@bGm[~IHxQjXpGiCiD~Pjo@h\\\\yEpfAhN{AiB~R}s@jk@v_Ah}C}c@w_@uDhL~Jhk@\"],[\"ym}YinyvAbEqp@vDbUiQdnAuCcM\"],[\"alnYs_~wApG_c@rf@`wAFha@~_@pb@fr@na@zBmGmHzo@nEt|AfSrk@`G|x@~WxHnDm[zHvb@nQ`UsEjl@`UgNjKfGfEpfAgP`c@sSd@cC|XlPtXpWvL|Z}\\\\rE|XqNhxAnFzrAaKlm@}YhS}@|TjOlvAb^dwAnr@f`BpuCnaEppDjlC|v@`NvkA{Btq@fPlWvVtc@~M_T|c@oh@~h@ko@d`Bc`@f}Bsx@`cC}K~t@oz@znBky@veAwEv`AuW~q@g]ja@sX`cAcIzoBb_@naCb{@ffBvn@xiBhn@vm@hItWgQn[j@iZgGcFmS~i@e]jIsxAxgBkHr\\\\?~rBrXhs@z`Afx@rr@~Urw@_A`u@{eBfi@wx@oP_fBpRod@bp@uf@rcAwtAfY{qAdl@ey@pK~o@`i@~fAsc@~pBDp]nN|h@{GzDcTwTaJzB{Hvh@vw@`b@na@r}AvUfKrq@eEpSvEf]paBvN|S`b@bSmKtk@tCdVeQxe@}uApc@agAyWof@g_@kXmFgGiZo_@v@oGeLkTeCaj@dCwkAjhA{Sp}@ab@lZqXft@}m@fRqeAgj@kw@yFum@hIyVsEyU_TcMqcB{\\\\_c@iGgX_i@ig@rb@uq@wFqL}[kDso@yg@a_AayA}JuBai@zW{nAf|AcXlDqm@eR_Acm@yWoRuAyLpmBc`E}SkeCfB}EdRdJhJ_JhT}zAgb@isAkjAsiAew@eIkgAzp@ua@uRqrAv`@ap@w\\\\e^oxAkTib@af@}a@moAoQmbCfHkUmEudA}q@_gCq]gwCpYkmDngBqYdFuZqDcw@{g@sf@pFoSyEmCiQfYgHuK_qA_Oo_@kUcA}RmXpBuXrbAc{@~~@yeBtnDyeEfsAcx@vxDgzEzvBcdEpGaa@vf@u`@pg@}zAnf@ckChCb{@jRkTtVqGtI}aCoVke@`BajAlMw_@dO`[h]hLjFq\\\\aRmUge@oOyCkPbFyKvPtCrY_Mb`@}]`Pg_@dYu`FgPuw@moByfCis@w~Agh@k]am@_x@h`@wRbZX|y@xq@dt@xQxgApgA`l@zVhWkA`i@~Vzu@yZt{@a_AhjCgy@je@er@lA|Ra[hx@`KhFbNob@~ExErH{IpMwzA~Mm]_YxKwg@qt@oZqp@\"],[\"yk{MegqjAbNyiAfO_@`s@hf@_W`_CyOwHjAsc@u|@eP\"],[\"m_}MskajAjHwHjKjK}Ajd@aQsI\"],[\"wc_Nuh{jAxz@na@rlA~RrOvQaBvk@sJhJeNaLkQvKgLtZeTw^jPaPkBsa@uvAc_A\"],[\"odcNs{guAlFyCjDxThYrXyc@eE\"],[\"{_cN_~okAhV{g@dJ~i@_CbmA{kA_I_Q`HvHiTju@{f@\"],[\"{jxNmqboAjIwLrWjNgEfX}Y_N\"],[\"}taOiwklAf]fL}g@|w@{Jal@\"],[\"ylgOwexuArC`JlK}AgGtKwv@j]lKca@zLlEdBkNxKtC\"],[\"akjOarimA~z@nQfi@ng@`Hvs@nVvOzCqGbKrCdApr@`}@zbAfEvz@~H~FtJeOba@mGlY_YcCfx@h}@xb@rRwAa@c]l\\\\tOdJfLeA~KhUlQfB`m@mpAlG}HuWew@cg@kcAbKaJur@oX{NiZyaA_SeMeRtA{N|j@mm@pC^ccA|FuGrXnBoBse@gQq`@kQC_|@sfAit@gQ\"],[\"icpOwexuAs@_XtZ[~D`IuElK{OnJ|GkT\"],[\"gfwO}tpmA|J|@rk@xs@nGf`@gLrSq[eFf@gq@\"],[\"}d~O_ivmArNmUlQFde@~\\\\nDf\\\\oa@bWgPgDqa@vKqh@wHgMy_@\"],[\"wedPki|mAmAaQbVqN|CnOuLnMrMpLwL`I?~X_x@jXcBi\\\\`KzBT{\\\\lR|I\"],[\"mdiP}sjnArr@vT{Bdr@\"],[\"y{jPejdnArSiDtPl{@xeAfjAcAd_@jNGfIfPhTy\\\\pRje@fLbBv@`^pXcNmc@zv@e_@xIlHxOgUj{@}FuInCmbA}PgKI|UoKfOcNsUgVci@gOwiAiQiNnPsXgVuIs_@}x@rZ`EYgPyYiQ}ODhHqN\"],[\"aebQyndnAaCkRvH}CpWnS\"],[\"unfQo~gnAla@wOcBrb@i]bRoGkJ\"],[\"cgpQsfwnAps@dPvV`ZjIpOhE`x@gGd]{}@b_@ga@hE{Ao\\\\kc@iZwE}R\"],[\"cjyQor~nA~b@mUhGlIzj@yCfVtNr^kAx`@vLvBbS|R~Kjf@bJ~a@cGdPvHfCxa@xLpU{mAxJzBqJco@sd@y`@}Kio@fF\"],[\"k~sRu`mnArCkTnKgCxe@vOjAfJsLlLce@lIaKgE\"],[\"w~yRgarnAwGk\\\\vGeFxJ`Ttz@fYzL`Ru]fDzP|dAsX`QeKmBmk@gyA`JuK\"],[\"mtzRiemnAhNcQ~X}DhwAwAxhAfoAxQtp@ke@oGbHv^cH{@sXqa@qGy\\\\Pta@}OdQ}w@eHmc@mU{^bP~GeX\"],[\"ed}R_dtmArRaL|C~q@sFj@?lUhHrCrLgM~VtL{RdVvFbs@mMr_@e`@}M`S_HmSwGmy@xEkL{JAkJlW}Kab@_cAbTi@bCyRnIuCfWxC\"],[\"qdfSkhitAx\\\\au@z\\\\`YsJb]wm@z\\\\mIoP\"],[\"uhcSgmxmA}Iwk@dLgG`h@nr@xe@jAuA|PbZx@uA`K}u@i@qMdH}Gp`@_Gi@yJsl@ud@tBwAkIpNo@uAgIqxAcNtZGfGqH\"],[\"wpkSikanAvZaC~t@de@vT~Cxh@hq@Yxj@eTsCvQyTqEqL{K~PqNeIr@gMyVnEqLiRc_@kBkEw_@{MgGpGcH}EgI{NvG\"],[\"y_sSi{uoAnNiQ_F|s@wKyK\"],[\"ksrSguenA~LqM|Gjg@jWxGsRhZmHmA^`N{QnEnGeXgNtO}RbAJmLcb@kEpOep@pYHxHyQ\"],[\"cotSsvuoAnGlKkYhMwMlCwMwHfAoo@`[e\\\\yK{m@eL}G?w`@lTrSzDh_@x_@vE}B~XaUrGMxK~LnG\"],[\"_gxSmjctA_SqCgKcQUqStMuJx_@hX^vP\"],[\"smxS_~{oAmIl}@iZea@wNfG{IaMjAcSnGnGf]oGmEiOzYzC\"],[\"gc{SusdpAjE|ZeTqUyKfLuYud@rl@sLjHrI\"],[\"{o|Sis|mAeHuAtC}QzPbQdQeCrE`Pb]DzXzKbXbb@eOxQ~CtJgjAqDy~@yOhDeLbPvA{G__@iH~BGaF\"],[\"ox~S_c_tAuLcRf^zAf[zPuJxG\"],[\"g}bTuwkqAnQcPzbBlnA|[nC|~@af@jZvlAbOdTdEpk@}Jb@_FlNsRqLrDjU{ZhR_XiO_@mVchA{o@aQc_@s`@{SkL}`@\"],[\"edcT{j_nA~L_OvYvGtWhJze@rh@zP_BpJ`MhHl@sKaTbPpDzRyMpGnCnVrb@kN|Vm@wW}Pe@|FdYwXsAfXhMgF|b@qKcZ{b@hAcPmYdEzQgF|Awl@i^qTbV{o@kT~@ka@qSs\\\\yIn@qByXfNoMzMtJpI_KzHrC\"],[\"_ifTk_btA~M{Rto@kA`@bSuNze@aJbDgo@iB\"],[\"gl~Ty{dpAgBmSnOeT?`_@\"],[\"kncUkykpAhIgH|]r@jIzJ}FlI|F|IjIqHpIpHiCnj@}^zi@uq@qLi[qr@\"],[\"upfV_hosAwAogAnW?foAtm@to@hpA`Rx`AmAnYqPnCgJii@ac@}Aae@ca@cRhPzJxh@iQnHyf@}Dk{@mi@cv@aiAqb@oXeE_[`r@lC~uAnf@l^L\"],[\"caxXypdtAfFyHxb@dZ`m@hFv[rf@_}@vToVcGyMwP\"],[\"ylrYubuwA~Va_@bVpp@uKbC_Pma@g]~oA\"],[\"{fyYegdwA?gCBxB\"],[\"ugqZohguA`HgDvS|NzNxc@}\\\\d~@mMzJgK_HyKpGgWgk@vMah@\"],[\"ouvZ}|auA`OaLzbBtVaP?cc@~Uej@i@uZjToAaKzOyK\"],[\"krrOwj~tA{Hh@sA}BnBoA\"],[\"sdiOch_mAvP[uBz_@yHnA\"],[\"icpOc|bmAn_@fKtQf^\"],[\"wcrQqjnnAzUiY|G`^kXxLhCxIyTpR\"],[\"m{aTsj_qArXiCpr@bP{GrXmD}JoHvA~^rn@{[V_@rl@qGnDqXqGxC}PuPei@\"],[\"_bxMcwiuAcBoCTcElCMjBv@`@~AkArC\"],[\"alyMch~|AqBcDtAwAbDd@HjBo@|B\"],[\"amoNsofuA{SzFrBeM\"],[\"szyNagcoA|G{V|GlJ\"],[\"iofO{xslAxFwLpDhQ\"],[\"ugoO_{kmAvOrBqKt_@aOmS\"],[\"}dqOgcxuAyWa@`FgG\"],[\"m`zOagxuAkGzHkCgA`AcE\"],[\"aj|Ok_vuAmEf@u@iAjAw@\"],[\"qg_P{rgmAnBe@hAaBjFd@uCvDaIp@\"],[\"ie~PwabnAeJDWoIdOsA\"],[\"}_gQyy`nA~AwOvCbH\"],[\"ighQy}hnApFv@cKdXuOgDkBcG\"],[\"_prQgkstAvBmJfFbK\"],[\"_nyQs{~mAc@u@fAy@hAtA\"],[\"}lyQm_inA~HmGpHtKcLdU{IqP\"],[\"}beRefntAg@Qb@Y\"],[\"sceRydntAm@Ad@a@\\\\^\"],[\"uyxRwrhtAiJMjQmI\"],[\"ifpS}t_tASx@Fy@\"],[\"oytSg_ftA~PAqB~XyS_F\"],[\"k~iXacatAdJ[fC|SmOH\"],[\"}wyM{c~|AbI|F{J~FuAcG\"],[\"}gdNyjlkApQ{GfFjFnAfVsHnP{VuM\"],[\"c__O_uvlAzd@`Iw_@pC\"],[\"kp|OwnvuAu@gAjAy@vDxA\"],[\"klmRkwhnAtXrA~HpWcGfRaTlDlFrQ}TgNxTqg@wLeA\"],[\"s{uRmynnAxK}JdOt_@uR_C\"],[\"_t{RgxrnAzSqAhOpMoFnJmZnEePqV`EgI\"],[\"yn~Rgb|oAnNoDbGdLmQbEsImD\"],[\"m~_SkqtmAdNsC|PpNuFjYcb@x@jAiKpRsI{UxE\"],[\"kaaSurumAtj@~Cmc@fg@kAsPjI{KiHH\"],[\"kqaSkawmAj_@hAaO`b@kNbAzCkReWzE|AsN\"],[\"mkiSudymAhRyGlL|LwFt_@sMyG\"],[\"wdmSmkanApByKfHtLC`_@_TcW\"],[\"yiwS{s}oAx]hCfDj[iNqC\"],[\"}mzSmnfpA|a@d]GpPgRy@iAoIqUhA\"],[\"c|zSqp_pAjKiMxIjLwQz^kGoK\"],[\"in|SwbcpAp@}E``@tHdCxh@sNdA\"],[\"g_}SovwoA~LcCxIdKlLnQYnPy\\\\iR\"],[\"qx~SisrpAlNiEfKdMsO~E\"],[\"an_TgvoqAaNkUvJaIdKlS\"],[\"oi`TscypA_CvFaJcUbDiS`RxK\"],[\"mw`TotprAqBsIzObOyHrE\"],[\"_maTksprAqDmKlHhCfEx`@\"],[\"wdhTyynrA~G{NxLhD\"],[\"estUavsrAbSzDlMbx@c\\\\fY\"],[\"{uuN}dmjAB^KtAyBo@\"],[\"cdvNy~kjAhAbToHmK\"],[\"mlyNsnnjAVRm@D\"]]},\n" +
+	"{\"countryCode\":\"KE\",\"polygons\":[[\"cfyAckxTkYqBeKmPv@kWnc@w@JgvApdAiKdg@_~@dFwtNne@gjBbWmSPePz[yJ`|IefPlo@ed@tUah@eD{m@~JegD{Ly^yOqFxXyVjHweAePMne@ce@f\\\\gkBcG}jBdc@u}AkOi{@~PzAhAamAnEgRl]kVa@}Ray@eWktBguBopBus@a~AecEaI_Bcd@a{BqOsIikAweE{Xkf@tj@mr@dj@i_@~c@sbAbw@cg@z`@ay@{BqeDaU_aBfHmb@ePob@t\\\\atAkPu]`dN|qIzpFxjF`~fA_HvuLmrIpXyHrr@dA`p@~n@hl@jXvjAjsAyJrHtBjUsSxFhi@vS{F|n@ng@b{@kVzSo_AsO~ObUnhApArOhLuGpJoe@rJkTn\\\\xGlFpXiCmCcIdHoLhTtEpKgLxdAyCvN_G`@aSnM_M~h@pTvAzLaF~@eIoSuHlRoYbP~@dR|c@ti@aF~KfRqL~Rh@nS}TlMhF|i@t`All@fe@fGtT_NbmAzRd~@|bApaBny@tb@z^oGljAfO~HgLrViC}I{X`FOdt@dj@ri@rEd]fTjs@iAdaArrAx@`Jcj@{KxGdOvo@PxfC~|@aQvp@cLlB~SbBjDcSdO~C]od@pe@iC|iCjq@fQ|`@mHrKeTCjKtPz\\\\uPhAwSxp@r[IlJeXjL_VMdHzI{HjVrDzE|DgEzE_WbJtCfMzm@zXgCgKaH~Csi@nJgK`NjApt@f^jpCln@uF|InKhI|xApa@we@fC`a@jTcAlJpZiHrGhEwAzk@oQ|MxKfCqDjQhk@`m@y}RfvZcoAdf@sGfcAc^uDwI~P{SsBuYgY{GuY{Rg@eEsPkwC|WkNpHodf@fdeAy@dVyTrH?zz@msEzDu|Huc@cdDld@gsCwvB_n@|D_X}PkwAg[c_@a_AcOaNap@{Lib@_w@swAgj@amAsQoKyKtDq`@}c@aK}Eg]m[ia@gMifAkX{FogAtRm]cd@mq@eLmTmMuM}^ap@kWafCkCc\\\\wOae@xZqlAmBoxCt~@_g@m@{N{VgX}Ie[xU_j@dFqGfEtIzMkElMwu@jYogB~[sPd[`LxZ{i@za@suBx^kXpv@iMxEwkAdEes@`Xi[cHaZxIy]g`@mwAgByh@b_ArRd\\\\aCdFem@vV~Cfv@yKqOsOvQmNy\\\\}OeDgFxLvIxm@gDnMih@oNgg@h]cq@cDgStPgi@xFyRb_@iyFq{FoyAeqDmfCciJvSaJer@s{ArOyLjo@nV?e}@xL_n@jlAj]hcBgv@hRcw@\"],[\"~_g@wy`XgFgWlLq^rReKfLtCbAnJsLlGdXrJyFjM|Lzt@xLzDhLyMfEbIkPzGzAzL{`@mF\"]]},\n" +
+	"{\"countryCode\":\"KG\",\"polygons\":[[\"m_wXmr}o@|f@}GvX}Rt^xN|Fx]m@z{ClL~u@bNxQhl@lQzNjUpFhtAhz@rhCwExbAv`@~t@zO~aA|d@heAjDvd@fa@xbAt[b|A~`@lWxM~bA`o@`}A|SzKhb@wKtIjE|Xhm@ldA`t@|o@dgAuMnwCdh@|oB~HvzAwWpiBjHbw@wBvlAiSldAjRtzAxi@zy@`|@tAhr@rfAnUtInhAfEn[fg@~oA|p@~u@lzAwCfR_p@fOmCfQtl@~x@yLj}@pSvv@nc@xd@aFdpApJjw@sR|Nkf@fJag@{PkZr]cn@tFqYdJcLjPvJxo@~jB|rDcMza@pK`m@eAve@eg@jgBxw@~\\\\te@qh@jScDeBt`Bts@vvArm@nv@dp@fxA}FzfBbWh|@jC|g@dPnVnf@~Ln_@v^d`@xF`d@`c@tc@aCzU_g@lhAySx[db@tb@`MlL`PlKptBiIh`BbLhcAdGfNzWnIdU`bBqHn{@xLre@qHfgAxCnc@}V~|B~Tdc@gInb@nQlY}Ads@vL`o@fvAru@g_@~NmTxbAme@lIeStPjMrw@tl@b~A[za@g\\\\dXsc@gV}i@zCuEhUfH||AcHzXyT~Kcd@qWkRxKoPb_@zKzw@do@niAf@z~AnIrS~u@n`@|FbSgO`m@fWhrAeInb@{KnHg`@_@mLhZsj@dT}GdNj@xk@_Op]|Q`y@k@v`@v`@zaAcBxI{h@oDwPxGlj@ti@wMnx@nWne@yGjk@tFjo@_YrcBrAlu@nUli@zFz~@mPr^hVhe@aNxGiuAYwl@fe@uo@_Hm`Boj@xNk\\\\rf@a]oOc{@i_A`Wi}@ia@vLsNIiSobAiuFv@{Tzk@cxAtA}y@na@wr@jZw|B`JiE|LdHvMx\\\\lS|LbR}AtBoLyWyMgFin@wMkVecA{NaKsOiI{f@mX}e@wDwtA}J_TgNkIeNxKsPqUv@u_C{d@_`@fOkQtAer@vSun@`XiUtIqZhB{k@yd@aUnT}Rzn@aGfAiTgU}i@ij@{d@vD_qBuJQkPne@gQ\\\\_Tau@eo@ab@oPsh@mAev@|HkBnI~\\\\rUaqBuj@aSc`@df@k[cCuZjJiEqQf_@mh@j_@euAc@yWqLsNo[qG`Gka@kEgQuh@gFmP}M_y@erD__@wo@qKfBq@bw@eTj^n_@drAu[^}@pbCaGdWwn@dm@yGze@{V\\\\gGdu@w^ff@x]rY`N|lAge@yKqnA~OiDd^zWtm@cWnb@Lz`A}s@v@cf@rLkdAr~@^fHnOnDcM|NtLbNui@_Jo`@hJrAnZ|]|R~e@eYjbAl_@vOpe@u^r`AnhCt@dH~L{Zxi@pZt]we@rToG|TjD|K`l@nBi^rb@wRn|@_A`dAab@pt@rEhc@mRxGqr@aGky@~g@eOjUdh@beCed@z`@_Qba@oOdnBeSjJcOcLsrAwpD}l@_`@s~@_xAaSefBidAuRsGgqAep@iwAiV{iAkVkHgs@vlBaCvf@vWjh@mGf^yZd[yj@_q@qUv@{a@aSuEmH~M}f@uHoMmM|BkSf[mV|D}OklAwOeLak@{DyIu`@g]}^vEet@q_@sb@hBg}@fSye@ca@onA}Lk_Bjs@w_DgH_d@nAeoA`p@erBpQuzChSga@r\\\\sTbQad@gCkuCvQgm@pGeeAjGoHrg@_EbOynBy~Atu@mk@`BggByw@a~@rEqtAuo@wr@uqCeIa{@}i@{SwI{QzTsm@}KwkB}c@|Tz~@siCxZoaBnb@e`@lc@c_A`Nc|AxmAidEhNyjFlKig@wNcs@mRoT_g@gSsEsOrJe}BgI{g@~FwuBdKwt@gDqYjNmRuRoh@zLi`AyJykAgc@omAoIclAnQmgBp[ea@vHk|AkNqw@xC{r@nOcb@kLgw@d@gh@nFci@dN_QuLem@`WuzAmEo{B`H{q@{Q_iA`R}eBn[_y@t\\\\c~DeTc`BtHiSne@|Azb@}Qnp@szAhbAml@pKkzAmE{lAbUkiCbiAmbBt{@_w@\",\"q`lW}`yj@|]~iAnOqRtEyd@\",\"gamWqdmj@{Uh|@op@zj@fGtRn]lMnm@eQjQef@dQ{Ah_@vY~Q}GqMmWL{JlPyJiGmcAyY}FyUpZcOl@_`@ij@}EtK\",\"e}iWu|_j@EjH~R_Cfs@ex@ua@_lAuUtBxCjp@\"]]},\n" +
+	"{\"countryCode\":\"KH\",\"polygons\":[[\"ke~Gg_s`AtOoFjn@vPde@Ynx@jXxNnLyD`U|E`L|p@|Tr_AgCfiAdW`WoNhp@eEp@_a@rHqL~m@yIvWzFzt@{Cxw@mk@hxBgw@loAu@rxErzAnS}KzUjH~q@iLjk@i]vwBgQprAfDhaAnTnXdMvOf^`[`TXhMwn@nl@tMtbA|Pbd@lyBl{BnJhm@}@`bAlGfM|q@v\\\\kBjk@lH_DuFxrAhLfRgMvRdB`]haA_[n~@rX~s@gVnHvDoPtk@hGbq@c]p^m[baCvnAxq@uJrn@|Ub`@lV`HfLaG`Ke^r_A{J~~AjPvLmYx]gG~Xqv@ljAc|@cRyOzSg\\\\fo@gPjChb@pZ~D|aBua@eJ|L{TttA{n@bu@fc@bMlEvQaPnReg@hO}z@xi@tm@jmDyLrf@rlAzx@}Zjg@gRj`BkSfKlf@ph@ztAga@hSIlMbNpd@ldAb}@bo@~Hjq@_Gj`Cv}@hl@lFlN}k@paAfCzg@gd@bFsQxU?|OtKxH{AvhA}SrxA}j@`_@a@`LqWjCvz@fAjd@jYhDl`@aPvg@r`@g[`D~kAma@~IPrUuXlOaLbYkcA{g@aHyjAqIhIaZuBcZuZk^?ksAhf@eTmHyObk@eZxK{Pp^cA|Q`P|BcGlPhJwB`ZpOqDuKjEe@rr@rWpd@bIhVcCnN|JvFb^wMbDgVth@bXnc@~GiApG|XqI`a@yIkGcUxZaVwIocA|G{i@_K{i@dQ}@jRgNqLuPFsYma@jG_KsHT{WnFzG`PuFxFax@jU|GyKcPa[jTwZui@rX{GgCpX`PkUd]yu@hCoGxKve@fCpGna@mPxKeGyKxKkPiTxKm_@cFxE}]cHrQsKaAhChLyKzBtTxKgg@}I_JcUtFc[iKmJjFbQsJjSxKrX}_@lOsf@jf@pgAin@~xAzGin@rXgMoHo{@jHy}@fk@cjB|k@i~@lk@{WzCieBiMim@g^kKz@qkBnkBc_@x~@oc@fDmi@{PovAbYiOyG}HhFsOkMyvApbAsuAt`@giCe@{ZlIwMwJs@cpB{Vys@_Zjb@eLYqx@s`B}kBkm@ys@s{@u^_CapAec@kPgk@ws@ueAuVi`Bo@wv@qRgd@fG_Pu\\\\is@fHef@kWsXpGoT|YcApJe|AfPge@cNsTu@{`@zLcPaU_cA~GeR{VqUjOeNmM{[rZe_CuI_XnGk^sc@gi@zDgc@gNiN~OoKuSwc@pPid@nR_xAtp@i@xb@{RpQwWgIy`@o}@o`@oHiQbn@eG|u@ed@fl@qq@h@ws@gb@kdAhOsPd@wSxSeMqEcOrOa~@|n@}Yvu@keAxB}hB}IcHk\\\\b@kl@ei@qjAxl@a_@xf@cg@~CgY`UcT_BhJcg@MwIiNaD~@qcA{QeRsw@kOb[wn@cLmeAsSgOeNlD?e]eXzFmOeX|o@qR`IeYd[m]nLol@vgAi}@rBaQgQmd@`IuV}fAoy@pTc_@uO_`@}i@w]c@mWi\\\\{Go`@k`@la@wnAov@aV\"],[\"{jlEg`j~@dRmLdR~Ey@hRgNvKoGyKaKdA\"],[\"{qpEuo`~@bNeLhTnXyUjIaNn\\\\eQlHwHc\\\\\"],[\"uy|Eem{}@xs@{BlJhUs[|JimAOpG{B}A}P\"],[\"us_F}h{}@hTyK~XxKuu@nTuHgG\"],[\"}umE{fa~@dWtEwVpRmQ|@{A_RzVjC\"]]},\n" +
+	"{\"countryCode\":\"KI\",\"polygons\":[[\"eeY}srhBjA|OkQ}l@cDon@kBzI{BeEtFqJ`JnDeAts@\"],[\"dk}Ex~s{A~i@uO~BlKaXrJ\"],[\"nulB`|c~AqCiKvHkKjEb\\\\kO|O\"],[\"l`nAhqr}AmHsk@ff@dG\"],[\"viv@raxgB{ExT|Sst@cf@zWsK|\\\\jGpCaPgC|Vwg@vk@cN\"],[\"b}q@iz{jBzB}OxWqVh@vM_]ja@\"],[\"f}o@qcljBeIhDzKoK\"],[\"jrc@uodjB`LgDbPnGi_@?e_@fT\"],[\"t`[wbziBrz@c@|K|L_AbOy]eD{HeNdUpOnL{DoT}Qak@nK\"],[\"|_Y{ntjBtXcU`f@_K}gAti@\"],[\"z`YytljBp`@sDyKtThCuTwRlFec@dg@?_U\"],[\"xnVektiBle@qGiChMuk@tDa_@zQpGcP\"],[\"nzL{vniBvp@sLzQjH}I|EtE}EcJ}GuzAvT\"],[\"fgKe_niBvIqNoAnO}\\\\db@\"],[\"krA{_~hBaEtEq_@yP_LbS}@aMzOqF\"],[\"mwGedbiBv]at@hKwAib@~v@d@|O\"],[\"egO_tqhBQa@OYhABl@Br@CkAhAi@x@\"],[\"uiOcnqhBh@a@z@y@F|@QlAw@~@gBv@?_C\"],[\"uzQmkshBh_@dHzp@zj@}h@wa@ee@wNcUz[oBzHpGxBkTpG\"],[\"_|\\\\oqqhBgCrGyv@vKdCuG\"],[\"wua@qrrhB`e@fCrApUsDcRaMiFyh@`D\"],[\"gwc@uoqhBzd@}YyjAlv@\"],[\"mma@jc}~Aj[sFfBfi@aVhXyRhlAkq@phAYpViInHgP{@kKsb@zNbNlUaDjP_cAgYd\\\\rAwMmNuArYaUak@~CtCiFgLg@jCgF|S{Fm[{O_k@nkA|HjL_MpEcEeGtGil@vQuVzIkn@xY|Qjj@pC\"],[\"_fz@}_mhB`DgLgX_c@kDec@vKhCqChUd]hm@\"],[\"sj|@idqhBnGqCnPnr@\"],[\"wtjAx_f`Bja@qT|KjG~Gh[_HrZuK|CjGgh@{RwCaTb]mV|IvKpXf]uOsLxYkXiAeRmQtCqTd\\\\_M\"],[\"l_wAfvbhB~G_LwCgP|JbQmGzMkLn@mAeQ\"],[\"tqzAnioiBfBqB|@NkBtEaDzDFaB\"],[\"tmzAxwoiBN[FC}@zB_DzCM_@jBsA\"],[\"|rzA~eoiBiHfGoD|Gk@bEaBhBkAcB~CwGnMiM\"],[\"pxuAn|ogBfIuQ~AlQ\"],[\"bd|@ntlgBqKcDhTlC\"],[\"raPyhnfByGdI{FcMjJiE\"],[\"cvf@aqwhBgEvMf\\\\dD_\\\\c@qHoJ`r@gXrHtGcGj\\\\sJoAnHo@|AoZ\"],[\"g~zAn_|`BuGwDbE}L\"]]},\n" +
+	"{\"countryCode\":\"KM\",\"polygons\":[[\"nkoF}fvYxVke@xNaDyJrwBkT|ByIpNag@c@tOcx@\"],[\"n|mFuudZdkAJ~NrIkoAzmAe\\\\`hAwZve@`P}gAqGwKzBwQee@cYcTdAeLqa@\"],[\"p{eFs}oYtd@yRnXlJnDlYkk@hi@}Eri@iLfRuo@f`@mTb@}YsPepCs@ce@qQmJop@hWoW`_CbJ\"]]},\n" +
+	"{\"countryCode\":\"KN\",\"polygons\":[[\"y~nInsee@|J}_@x`@aEtNvEFjg@yUzG\"],[\"_fpIpsfe@zMaOhIA`D|L}k@fb@_Al`@sVtm@cSlQgS_DeL}Sxb@g}@\"]]},\n" +
+	"{\"countryCode\":\"KP\",\"polygons\":[[\"ekqVacjmA|Wjs@n}@?zs@ta@jZf`@h_@xqAnEltCuRl\\\\lQboDcMfz@`FfhChLzh@zh@biAts@p_@z_@hl@j_Ajt@zX`{@rcAa@xP|HpNlc@gm@|`BcZhW~[juA`Brq@lLfRt^aJvTnCsCz\\\\ed@tMc@`Nwi@jCdIzYqRpFsOvXlErHfPwPd_@qGyLjg@s|@bu@aDrQyXtBqWn}BnMtJlImJtJsZoAuTtNy@?sX`PxK?sXrOda@sO`a@~i@sE`KhJ|GcLhIxTrXyEk@pUgKjGbH`YwMXpg@b`@fHxv@nXrVuEvFsm@}P~BsOmKaa@uCfi@{G{FuOeh@bBwi@}NcAfA|QeJjJ}UkRiOhg@bDfLnGuCfCd]xIwZnRqI{Lf[Tl^dLmPjT`j@qGrC?le@qa@aj@yU`_@iJy@tg@bs@uCrn@iRnu@}SE|GfTiP}FaOsj@rJ{k@eE_YgJi@|CzL_M~WcSjDgHkz@nPuMXeLcTiCiDyKuHnDmKtlAbUlnAij@boAjNjKxDxk@mPbnAsd@s_BuXkTlBqW`S_OoVal@i@vo@yPtYwu@sCy`Agi@gFqNpLI_c@iZuy@hSJq`@fM{KsN{ZfGwNwZwDyKvJeLcBrTwS{@kMaUCzIyMaJ{f@lGaTwVqi@jDol@eL_LdLwZ|SqGuOgNd]wg@@s\\\\mP~OsC~Xca@je@gCh]}]pRxL`VlJqMtChHlFxQwIrStIlHuI`X`PsCnAdRsNjTwOsMwVdO{@zHfb@jDTxPqg@x^_v@gHcz@c\\\\az@cl@w@eg@{Bz^ef@aAkIab@gNbHgKeHQai@_SxIog@a_@aEzPwZhFvCkKoPwToGz~@_YsOfCtXiOeAydAcaAeGhCnf@hbBm_@hn@iJiH?fi@`PoGg@z`@_VdK]b^cc@zv@bIxKeIrUiYnKoVjdAuXhNdx@\\\\dAyFbM`P`LyGhCp]j\\\\|b@{VtOoXmTqPe@pAnMyQjWmYuA_LdPgPq@kEnzAsXdL{BfTsXtXaPi]ef@eGuNeMhBsy@qGzv@ye@bXskAunBuR{LeYyk@_Iuj@qd@}PyMgd@}v@ez@nP{WmBgaAo\\\\fOiOcEkq@koC_TgVnI_jAwToBgCiTuWaIcGko@{c@wNvM{VwCml@aYrViV_WsVqo@|Hee@iPof@pK{^uLwk@cHk@DjQmRqg@w_@yQqFyP{\\\\iTqJ~Geo@k}AkyByfBiJwRF_QpLnGuCoTqr@~KsnAon@w_@lEi_@y^Wc_@kTuc@uTh]Q{\\\\rXie@eTuK}I}a@oV}LaIuYvTeI~Sk{@j_@eT|@fPnTgEtIq[rGtC~Oqr@z\\\\zm@rHuQiDiLlG{MaQcPdJkV}@cVxFeDnG|KlEoUbAssCtS}HtPup@uIgNxHksBoQkSgEef@zKwQ{K_P~b@sGsCcUtLhAnEyI_FyWzB}G|G|Gv@kYsPwWynBihA}n@p_@i_@jx@epApi@}L~Ye~@bP{P{GoWcmC~UguC}Awe@kQeZ?k~@{IiFhLaqBuD{Ue`@yMkGgf@m_@yVvAma@ck@cx@eHd@gDxPca@o\\\\yKhTqBoYq^BoHii@gVeMzB_OqNnFzIy[cG}\\\\tk@e~@cYy_@{UoAnBwdA}S_OicAwCcLsOgMlLkJ}TqQyEuOvQmRD_mC_z@{Gqa@mK~OwF}`@z\\\\im@gLyeA|LsDvVxR`KsvA`U}IdlAlGvTiKlc@ye@x^i_AtVeArFiLoBsH}Q~FoH}FoE_TbFmH`j@}G~s@_[~Z{j@dq@sI|NgVdS`_AcUp@oV~s@RxZ|FiAbGhQuHlSzSnMxKg@dEiKlJbBgGpSxMc@n]ff@iUkDiJjLrf@lx@dl@z@p@zNfO`HyIpWfFl\\\\zr@lWyJxJxRnSxKyKzKjPxYjEoBni@`q@rF`d@~R_GnWlJ`Wbn@zSlTr^t}@fY~s@sEb[oa@a@mL|PyLjI_]|r@l\\\\liBn\\\\jVgIpaA?lT{UrcAd]{EjJtBbnArZp`@zG|w@nU`n@vk@h]mTtSl@zUzQ|OraAbTd{@jz@|Fr~@`v@`gA|UxbAlAbg@vKhJo@vSbYpKlRc_@lW~@`LhU_CdXtr@`_BlCxj@|i@bVgB`OiZdW`LfUvOcCdGpp@aQt{AnNrDn[bc@uDxS|w@`SrC~x@hKsF|Ij|@zXhSaNvWls@h_AtRrIfh@gD|FiXbYuLxs@z`@lxBaY|GrJ}GpLqa@wKue@l\\\\`a@|gArLm\\\\eCgYbIuBtI|\\\\cF~Sl~@lKdWkLhUwc@aQmWn]gXuB_n@bT}i@Vis@f`B{oAw@_OpQoFjIeUjd@a`@~Gyn@ngAkiAcPkl@vn@_o@\"],[\"_pdWe~fkA~ErI|Y}Mla@zfAiS_WqEzGim@qV\"],[\"kooVecfkAjTyKoPfr@tG`MqDvDiIqAiLc^\"],[\"es`VoynkAlWtShPp]mAjO\"],[\"__aVihkkAuG|B_Ig^\"],[\"s~aV__ikAxJcFpDpZoEgR\"],[\"o_cWyhbkA_AqKjPfEeB`J\"],[\"mjcWkhbkAfC|w@_P{TmByYxCeN\"],[\"{baWoo`kAkI|@mEiK`NaC\"],[\"onhWeayjAwGqHjI_GbGfK\"]]},\n" +
+	"{\"countryCode\":\"KR\",\"polygons\":[[\"ekqVacjmApjHueDduEsuDblAwvAdh@uDvHcUtx@uWhUcYryA_y@jYe]`~A{\\\\rb@a_@ja@|ItrDge@ffA`_@`lAiNjt@zBft@|`@pjAtAf`Ac]|a@~]b]gl@wv@m~@tEiIha@}Hto@f_@lvBtVzdAba@vUcN~t@|FvX`YiXxFsKxOdKdDzEuLrO_B|MhT`[gCjNbJ|PoG~U~w@rj@lGdx@je@tBfm@dUeDrLdPyPhV~d@dYyHpNpQcAwAfYau@Fja@vWNrGcXdCzWxH]rw@qPpExR|JyNjTjDbPs\\\\pAdPfIcTdY`CpKck@zH~TbN|u@u]bMj@hCaP|Ah]qRpM_Fz~@`N_AfOpx@bUbJoV}gApa@cAfGtk@fMkLfj@fD~AeJcJgEbGoGt\\\\nWlAv_@qHIaDiSgE|m@kNdI{FySkVdMpWnSuQzo@n\\\\tIWfi@gDlJ}MbAsCve@c`A`@e\\\\qHd\\\\tZ`@~MbUmJhCxFbG``@sXpP`c@pGrH`LmGvzAuQvN`^fE~Sl\\\\xCbLq\\\\|]nSdIvb@eOxZqa@_ReZgCgn@dlAhT`E|TsHh^tS~Rbq@gIsZ|p@_M`Boe@{Paa@zPsCxKar@xGl^jVtHbiApMsd@rXfi@z@kVlb@uErVsd@xNkL?bUpG{Y|RgDhIb_@_Ppd@bItYtRiDSyd@bH_Lxa@dg@kClJhJ~P`Q{H@hPu~@vx@~J|^|GgCcPda@oMeBsl@ks@xe@xKqDs`@}[}GsXvKiTkTf`@yW}[cZ{YlOjKnXe[tYzm@f]bAtf@fn@tmAvc@vMbGuC~Zna@xXc@|A~ZbSg@}Sbv@ihA|@gJ`Q~PbMlTwEhDhRhUoOhQ|_@pIiJy@|UtL~ClJj]pz@|QzBfr@o\\\\yBsCz\\\\sg@wVeKbYaV}Ck@bJsUeN}Ag_@yKnPgQcOfQ|{Aw^`[jHbLeKpQkK{B?iL_Th_@ibA_Kp@iQ|]eVtKxFbj@aObNez@yIaD_BpVkM~LiMeApIvQcn@tChBij@xTcBz\\\\wr@_PjIvKi`A}Sri@uCsCu\\\\~`@eRfyAcKuPkEki@nSwm@a^}DxCsy@}FlCsCkTyGhHFrm@ke@oGkJrLjJbOhTgCvh@`U_BhOcLmGqBlGlM`fAo}@g_@oNrMoUnAuQeW_AvU`IjQbReCpEvGqE`]gCqGmPbP?y\\\\mLz@eMmPqh@l`@kB|J`M}CfGdL_k@hT_IyTrMiOsXkPzd@g@~LuK|Fy[|LrA~JeJiq@aPcKzVs`@|YyV{BmPrGzBba@aKwi@sCzKcNyMyYEwYeRhNm\\\\k]dXaqAqm@wIm~@}Qm]~V_^}`@|LjFbaBuN|]oVmAmm@_pAyRmPuQuA}IoSJik@bSq]oRsDub@vu@iIaOh@oi@_RgXoJhPhOzn@{ApjAqXdAiHlWnBnZyQtA}JmjC_\\\\k`AgOoLxe@jnBin@je@uDuGy[va@?dVeMlMdMjUgQoAuGgTh@}k@{\\\\vi@}m@y\\\\oP~i@}Q_j@iG~BqCva@yIlKgCeG_MjHk`@ciA?lc@fShUqC`Ok]|G{RaRcFfZsa@zFwCca@kMwCsMpU_LcN}I|ScQw@{@sM_PdLbR~X~o@uBtRhKaMrb@}l@uEqQ~CaDxOlXtJDfMzv@}X`Px\\\\aPdCgNqGgWhQe\\\\W~T`[dFf_@aQ|KkAy[_LmEiTna@rb@zN}b@zAga@kVvTkK?oPaPfLhCyP_HaKmCxXcTeHaQfLmJaYnRlAa@g[_u@qEsBoMhs@cHjOvLlPsCzNjO~GkDqH{UzH_Qkc@nPgEuEnLeOoLqWmK`PyAaNqXcJkIzCeI|b@iKcMmFnXgGyIjE_WiJHxAaa@fGuCrPxKbI}BkFuQpLeEbj@zFzNgJoaA_Ud^uf@wZtMca@`h@_EoFpQsTKaSiWjd@cWfFxc@kmAxs@cBmGsHsXy@{PaSxFun@nO_Wjg@zGaOgRdC{Ihk@F`_@aK`G`KdLcLg^_LuNdDfC}Xq\\\\zZs]yoAgTaJ}GbGuO{\\\\jGjd@b]dGn@fb@}[vm@uOlGiBmd@oGnGrCeXua@lPmOsCdBnKl`@jLeFty@aj@yDgLqQcFij@_QSvKtTcC~LqKdBzb@|u@i@~PwTiL|@~VqG~@kVq`@_BfW{UpL?ecAhT_H{`@gV`Ce_@mJrJoKfiAmOdZa_@cPkBv_@nNhNqHvNiTuMeOrYkSRaSw]o@|YqYu@ic@`ZocB~NjEknAnt@FpTqy@g]vn@mb@qLs^vHqUmHd@hSscA`@{Xa{@k_Akt@{_@il@us@q_@{h@ciAiL{h@aFghCbMgz@mQcoDtRm\\\\oEmtCi_@yqAkZg`@{s@ua@o}@?\"],[\"wagUkzclAx^uOeAti@wTsGzCzT}TgLn@hUedAhG}LoOiB{JvIiGvNvDj]mHtN{NzBbLtCcL\"],[\"qiaVqualAh@wVfOs@vGrdAc_@uL\"],[\"wzlSilnlAl\\\\hCdc@`h@ff@pSl[|{B`NbTlFdtA}DvtAjXrf@{k@r|@sRlEeh@uTc|@wfAeb@}kB{Ye_E~ZcaAx`@kT\"],[\"io`TczrlAbAge@vTyCjPpl@mKf_@_J_CaEhG\"],[\"ox`TqjclAzv@jTkHzU|LpAxYnz@jAvg@}SeDkH~PfL?yKbL{SwF{OyPa@mPef@aa@lFiF}BwQqHyBgQxSeDcC`CmSpTeYcG_P\"],[\"{ldTyv~lAr`@}T|^`Km[xe@qXiS{NwCiLjG[iT\"],[\"y~dTcv{kA|KgIrUxPcHj_@kItDmX}d@\"],[\"cifToa|kAhLGz@le@hT`I`AvMyIrGaOYcH{JuG{r@\"],[\"_|hT_`}kAqHyG~I{JdXfDdDzy@o\\\\}X\"],[\"k~fTojdmA~gApGa@jq@kd@fLqCfIt`@rRFzGkJ|QiuAfSkk@ml@fOqUb[jS~S}GvPgY\"],[\"c`hTyrqmAfNnBl@|Lf_@eL{Ipa@|^fQ~K}UlRtcAyKkT_HfKqOuBbGjRuPdG{DoRsZaGiCpGjTve@ae@dX{QwTyEo^bK{k@oRdQsSrBsRas@mJnFuPcLvGwLz_@pBfRoH|c@nKcFuQ\"],[\"_rkTcb~kAiEba@uXeGiXud@KkUhMjVxVsI\"],[\"ou{Ua}flA~MkKnNxn@b[nf@eNlZr@pT}M|DeRsf@yEwm@qOeL\"],[\"ci|Uwu{nAjEuIzNXl\\\\dMfAxZePvXgUaA\"],[\"mf_V}{blAfIyLtKlDqX`v@}\\\\wV\"],[\"ai}UkzelA{GxRtDdh@cQ`X_Lc\\\\cn@`^aj@gCkNySiAkJvb@}w@hZn@xx@oP\"],[\"yxdV}dckA|NyD~CrGmBj^jKaP|FvKuNpk@cUoE\"],[\"qmwSeoulA|G_HeDjPaZxHqAyH\"],[\"sz_TmzylAzHyAxKbYeWnY_JWJib@\"],[\"{}`Tkw~lAjHoDjAzKq[xf@wIkX\"],[\"}ubTq{}kAvVLnNbg@kHaKuCnMgNg^_XfL\"],[\"}dbTyt|kAe@~Igf@aDd^{R\"],[\"iodTsbqkA`@kGfa@vd@iPjBeSeK\"],[\"_keTyi_lAbQf@iK`TrB|Oo_@`YdHwy@\"],[\"epfTmf_lA~Q~LaOr`@yKqb@\"],[\"ecgTsn}kAkPtFy@m[{NkEvCgQpi@pUtAhK\"],[\"eqgToldmA|D_Eq@lz@qLxMob@{`@t\\\\vAiNiS\"],[\"s}hT_w`lAv@bLsJz@]gR\"],[\"ipkTol_lApNvBkNzZ\"],[\"u_kTwmsmAlQ`I}f@|LeA_S\"],[\"svuTi~`lAkPQkJe]\"],[\"obvUm{}kAf@`F}XaIfUaa@bLnV\"],[\"kbwUqkelA~I{GlP~PaBbTuT{C\"],[\"osvUu|glA~LFtTjm@wXeLq[zIu@aTjIW\"],[\"q|~UczukAjCgJxKnJ\"],[\"iebVyzbkAhVl@~DnQqN`D\"],[\"k_xSy{jkAoMfUaNiEzUkT\"],[\"kjzSksglA`IaHaBxRhR`[wFrQcXcO\"],[\"y`zS_ailAjTbCsDtOej@cCpC{N\"],[\"q|zSkumlA~FwH~ObFYvd@i]eH\"],[\"ki{Ss{glAtQnB|FfMwHfTaZeE\"],[\"ma}Smt}kAlJMrEpKkIv\\\\aKeF\"],[\"ya}SurllAgPd{@_Jaq@vFwa@dQhI\"],[\"qx}SggqlA~R~_@aMgCaLx`@sNeTvMyK_@uR\"],[\"gb}SmzjlAnKsAmFlp@iKcCeIt^i]QkHgNxC{W\"],[\"kp~SqyjlAmAxD{WiQeGiXj\\\\yVo@vUhXr]\"],[\"wpcTcwhmAhK`GzDj[}LzEgK{GpK{R\"],[\"qagTcpjmAHs_@db@hHwS~`@uGcFqH`]aEkK\"],[\"skgT}tgmAnL{K`JvLuJlS\"],[\"a}gT}qblAlByJfLi@hHfK{JlA_H~R~E|b@eEfJiOwe@cSzB{AyEhL{\\\\hK|O\"],[\"mokTko`lAgLbd@eO`IgFwJrCqc@rL|IrK{UzRwB\"],[\"olvUclnoAP]\\\\NO^\"]]},\n" +
+	"{\"countryCode\":\"KW\",\"polygons\":[[\"gyhQong\\\\b_@aPxfAuK|s@ob@SqOrpBw~@tKpAv@|LmUzi@YfjA|bA`bBda@|YtJl[bRpG}M{qApCuGnIzPbOzBbH}Xgk@{nAhWuW`B}m@tx@`B``A_WdtAmNz|@ci@|Mo`@fa@r@vp@m_@jIog@rSvG`UmCoGxKbLqG|B~O`LPmGyTdk@oEvYiUjC||MqOdDuk@xo@}uAzRkrAra@sw@vd@e~@prPylBeoBgkBmwA{lBg`Aux@kRczCakAoYkTov@mdCyBodE~Dwb@\"],[\"gm~Pkbo\\\\tVw^jVgDuWhq@u@x\\\\{XmF\"],[\"sgcQmko\\\\jg@~]h[hm@oA~YcObNiuAxn@mSjWcW_i@qTuB}BhTgHgEtHwf@_Mi@{M{UaEj^wTfCeFdK}GwXrFsOpwBk`BpUkH\"],[\"q_iQi`j\\\\tSz\\\\}DtKoZmZeAaa@\"]]},\n" +
+	"{\"countryCode\":\"KY\",\"polygons\":[[\"y_yJ`uop@vC{UjVaG~Jh]\\\\n|@lMrSj@rjAmEnEmg@wFmRjRsKcDVkShu@gOkC{[yNaEfCyKqXbGxKqm@\"],[\"_z_Krfzo@Q}l@x^`fA}A~T\"],[\"kz_K|~vo@c`@wmAxKaKzb@ngB\"]]},\n" +
+	"{\"countryCode\":\"KZ\",\"polygons\":[[\"iu}\\\\kpht@yZt`CjV`wAaGlgA~{@ngAl`@hT`YkDzl@uk@va@l^pi@_GhmApzAff@fXzX`sAv@~kAp[|d@~Jda@cFfsBvL|iCn]rg@~fCtqA`dAjN~cA~[bxD_q@~pAvEzbAst@jcAkDvi@`KjPh_AhnAjc@jEniDpjA~mBtJfl@b_@jd@jUfmBwK`Nq\\\\iTeUg@sQlKqUri@xG`xAqM~|@kCn`BjChbA|Phv@_EteBey@~lFuo@|iByObqAwWvk@kCfp@zFh^vz@pMpQaDpi@~Kvj@?hbCpzAxyFzeBbsAhaAfwDrp@tgB|gAr|Blq@|WoUpF_UrH}|A~Qe_AfKeIngBzIzk@jQd`@l[~C|f@wv@vrAyOh{@pu@nwD{Uvm@aH`r@aeAvP_RdOeEhm@da@~kAfgB`mLwI~`BrYdcB}@bx@nc@pa@Gf}@rKtk@{BfgAhoAfxCzQnH`N_Oza@}kA_Mc}ChUsj@t_@cnBlOD~QhgAnJxKh^oPr|Aj`@viCwYlcAlTjl@qg@~n@aKte@e`@`yGyrCbg@pJxi@`_@z^_aApmAa[zUnKjFdYyRtk@]|XbaAn{ClPbGzNuJpc@uhBzVqBje@deCcCbj@lSjV~gBtu@bk@_Mne@a]bb@|GzyBcl@tS`KrHfW_KhZu{@~v@ciAlbBcUjiClEzlAqKjzAibAll@op@rzA{b@|Qoe@}AuHhSdTb`Bu\\\\b~Do[~x@aR|eBzQ~hAaHzq@lEn{BaWtzAtLdm@eN~PoFbi@e@fh@jLfw@oObb@yCzr@jNpw@wHj|Aq[da@oQlgBnIblAfc@nmAxJxkA{Lh`AtRnh@kNlRfDpYeKvt@_GvuBfIzg@sJd}BrErO~f@fSlRnTvNbs@mKhg@iNxjFymAhdEaNb|Amc@b_Aob@d`@yZnaB{~@riC|c@}T|KvkB{Trm@vIzQ|i@zSdI`{@vr@tqCptAto@`~@sEfgBxw@lk@aBx~Auu@cOxnBsg@~DiK|VsCvv@wQfm@fCjuCcQ`d@s\\\\rTiSfa@qQtzCap@drBoAdoAfH~c@ks@v_D|Lj_Br_@tcAwQrp@iBf}@dc@vm@iEvs@dYfT`P~e@bn@zBjWfvAlV}DjSg[lM}BtHnM_N|f@tElHza@`SpUw@xj@~p@xZe[lGg^va@z`A_BpbAdiBplA~EnRoD~ZeZpQwNt]tR|{@fPvYbj@~ZrQhh@rcAdy@je@|uAh_@fd@zZzrDnz@`{ApPds@jObGnZoLdHxrBfO~`@jWrTdLlx@rc@sJraAvMlZru@zDvf@j[rVfMzi@nkAvs@vTxv@tMxEiClJzYSdUzLhs@iEvOgHlDwTl]gJdVlOzCaKdGzB~DfLgKzo@nTd`@{y@frCecAbbBmMzn@ue@aw@ux@_XyPEkKfL_IwRwAvFmS\\\\^jL~LtGqFjMpFlNql@pR_t@`h@dZ`_A_Pfu@jY~eQcIlr@uX~ZcwDnt@kyCxf@}|CvTwDrtHeyFcBuVuWkvIa]teAh~DoeG|lD{iAlxCy{DxxEzBli@tfBtjGiq@txVxzAtaV}FfXwdL~aOoi@vc@c}@dMq\\\\lZ_}Uzmo@thCz_TrtEzi\\\\zvfAm@w@r~@nSxv@`Pz}AlBzmAoKzc@cQnVii@`Xw{@byAcjBfoAs}Ah|AyVxH}a@sBqY`TqgAnhBugDjrIeKll@{Cbn@nu@xkKfqA~mG~qAvdC`xCjkEu~Ai`@{d@pIsVdX{b@{@uTvTsu@mTsLc]orAocAi`@kCyWaY{cA}Skm@bGah@~ZsKvYiZlFuCuEp_@eOrQif@pr@o\\\\y_@eRuf@`BkQrM{Jhs@qNrAe_@h_BmB|eA`G~UnPbL_DrOcv@z_AhDfdAfZnmAuCvKugArcAel@bRmd@zd@sDfUkd@gAyRtPfIpdAxKnR}Gd]|GjrBuSzDglAa\\\\q}Ae@ma@pUsXnBuTha@oR~EEx^oUrXsvAlx@ih@~RsX?k_Azv@}GpXmeBnGc\\\\dh@yPbt@i\\\\xzEwNxh@_a@t\\\\gy@sCgs@bGwJcGbQiIcG{Gkx@yYxUssBuGoiAxHqEbAk_AvHmOiPcPjAmv@~c@wu@hAsZ`HmAvB|IlIcInZ_tAiHgr@{_@yh@}Cgm@FqH`SxCsAw{@xKyv@yFoGsOdKgd@bgApGxiAiJdPk`Azb@so@nuAcC~[sd@nu@{Y}@sQyPyHvWsOaBkJeaA_UgY{Syv@rXyn@cSe@uZtR{ScHaPnGuz@qg@an@}u@{b@qJrYsXxFku@wb@u`Bwg@mc@j_@kTlEut@lPqe@mP}i@pGit@cPwhGb{@_sFsCyx@xKgaA_Zs~AmJ}AkDlJ}Gl`A{LfR{Fvn@g|@hnDiVpMct@qG{Mc\\\\u~@qh@qRge@wvAajA{i@cYwa@Ko`@oUwaB}Vkj@?s_@vPco@xz@bPmj@cStO}U}B`T|f@uBbKsKb@ej@cZtP{G{CaTui@uKrK}DcTmN_Dic@i_@qCkMfKaKnr@ogA|LkWlM_Ari@we@dl@pGvtA{B|gBgWro@wFtq@zEzPz[~Cn^xe@tMjz@qGzl@tLtBnG}X|VyKu@tqAuo@jeA_\\\\yJoAlKna@|VrB`KuHtu@aU_YpL|v@aJxe@k_@pi@sYuKkU|HbDvZnd@nN\\\\tJah@tg@mi@rcEfXtcAzKnqArXxg@eJxc@xOfDx_A|{@uIhEc_@oLfYdm@y@xe@di@vDlWrVoB|IkZqOfH`n@uSjl@hp@b\\\\~EbI{\\\\iCpXlTfFxXln@hi@k@dJqSiE{AhEjItZdfAfsAxBpk@f`@fq@aBrYfX|LuOx[bUrGyPha@pX?uf@~p@tf@sAyCtJrPfQgUbLkOdo@cThFz\\\\_Az\\\\{RO`FgEnUqMkBwLvSnHvCuA|RgZrs@|NyCl_@_h@|RwH}B`e@mWbUvAjK`FkI|XqDzUqf@`RyAnExAiLvXs`@bd@gCxYpm@wi@cGflAdQw\\\\v_@aFco@dxBio@btAx@jo@mk@rfA_JnfBqMwMi\\\\rScMxm@{k@u^eQy^mDme@vFoc@bi@if@zCiNkKq~Aqh@{v@mnEb`Eg`ErjBgUffA}eD|rCob@dpApAvuF}j@lsC~MrMpaAtBxLnM}lAlrCaN[sW}SufApfAsX_y@{Zka@kQfv@}_@aG__@xXyUaV{MfGwyAzbKuyHekDew@cmB_y@yw@m`@}J__@bFwYz]cv@p~B{oH_mAuXeMeMoUmf@wgCsmAo}AuURu]`a@mP~C}^wb@wVdPeKyEuPau@od@e\\\\q`@kl@{H{u@hhDeuFxW{Jvf@ss@tfAw[fWgc@rd@iWe@wf@rb@knA}Sw]kw@}}Dau@e~@e^fFeP|L{Gbj@me@{B_dBxu@}xAxOc~@RyLpNqHfn@gKzAcFiXn^wpA\\\\ww@su@}pBm|@cqAe[ykCmLkWcTqHkr@tn@o{Auv@hEcuEwnAy`CqU}hCoVuhA}JuM{]`FsNcWeIjIoGe|@wOmLgIc]uz@b@gMm^i\\\\wB`NieAzTdUlDsF@u]uQek@}PkA{r@l\\\\{S~@sIgMtGel@bh@yt@jEwwC_Iag@rYaa@hGes@pYwD~NxL~NpkAdg@ii@iAqaB{Ie`@eY_[EiNbIsUpVmArQkMy@u[gWsv@w}@~I{p@i`A~GcjC{b@cb@aD{cAm^_^vE}Ov_AwY|p@wh@`n@mTj[{h@vAukAc`@qdAdLqX`@kn@jJpGzGme@_V_y@t@ajAcJjDcEwIjKe]cDsqAvJce@lYy[l`@aaBdIwCxJdZxGwJ`^Qlj@{p@`U{qBS{o@bSic@lTwMlMwy@p_AcTfv@opAv^w}BpPeClb@rf@h|AhY|k@_b@bNwf@{Jox@sd@at@i`AfVqp@}EyNpMuTqNsWnGsYnsAsN{YsV|KqFiDiNg`ApAw\\\\~^umAnd@sr@rP{~AvLuHtWb@bOcMlqAkiCnGoYoB_jAdb@ib@vFy[lc@_w@wxBydGmMgLoaBmc@pLeaBqFsKq_@gHsP}q@yb@vEuWub@dCqQlr@kv@hHkx@yFgLsi@hQeMkUyCoZvPs`AeJq`C}Qir@pOuO`b@eIlKcn@p}@ke@zTks@lA}]ud@ev@xLirAoFwSmQiHysAx@s[oTdIuxAdO}\\\\wKehAlSwUfEu`@mEkM_c@wSyO{s@tX_c@zVoFnDaxA`Wqm@`e@[dVfY`SrArQgL@oj@xe@qBbFaJgBcs@nj@yf@r\\\\ofArHwhDd\\\\kn@mHueB~^smAhOz@Zjr@lHpOtQcHnSib@}]c}@_Ei~AgQsXidAc}@al@Hgs@w\\\\kN}c@~DyfA|~@iX|b@ilA~RidGcpAowKs^ga@chFabAkPuSiNg{@eV|}@mpAvv@eTnhAfC`_@sQdkDqe@~s@wh@nCeC|fGuS~QeF~bAqD~KaLQqU{q@}Nw@}LaUiQrMaP~mBab@ftAxNtK`Brb@oy@|m@cX}ICw{BqtAkjCwLmq@pCqfBun@ccAiTegAeq@oxA{h@`p@cu@pNyS~jAcaAjF\\\\bp@w[l_@ef@wM}UarAq`@{a@q{@mkBs]js@iCgfA{Tq~@lPs{@Dye@yUy|@tl@iaByNqj@{AiyA`ZcvAcJgn@{Wcd@al@sLm]nSeFhS}Z`rDcm@tjArTnoAg`@`sAaHpmBgMbXq]bQsNeAmf@yf@ib@yImEuICw_@j`@ch@vGwc@uj@kxAgh@xOyIzN~BrwAjVd[v@bUuKtvAyUnZ_HduB_K|OeNiNjLi_AqJ}Tu]}DuDea@wT{Ke^wk@aMy@ya@bhAqCzaAwSzB_OeNmDe|A~SkRnDsTcUiK_n@bVo@eb@qY}u@nC_q@d`@ae@nEkm@vRcAfG_OmFgQsSqDzEkkA_Kw]bDgQ|XyHfDsKtE_{Akt@RwDi_GpF{SzLh@bJvZpS~Dpj@qsAkFkKe\\\\hGsWea@sb@lUwO_R{XmoChImw@_H}r@pC_}@oNuYw\\\\zIyLe\\\\xIgcCwXicAq@ai@mZieBbb@auBqT_dAqO}IyX~[oM}N~Hka@yKkThAyr@qSkhAd@quAyKsThCuZyK}h@`Wkm@hGei@aj@_vB`I}h@vo@ibAc@w|@kS}PiaAvRsMcLiZpAi[sxA`BcbAik@OrO{vD{k@{r@uQcaApD}J`h@oDdImI_}@edHeDs}CkW}q@mBeyBuPwjAgBwfAef@g|@vBsaEwGkn@us@giAkJqg@bPunAeGw`Bor@}i@yGkj@sKoA{_@phA_QiIeItQuMit@zFcZqGkk@tFy}@_G_oAu\\\\gDyQef@qu@cNxM{yAvd@_o@k@{\\\\qi@eCcNdd@_YrHyLoX|UcoB`l@uf@ad@y{AhUexArH{yBdYgoAlx@guAfG}hAja@}_B__@ik@kMe|@aNoBc`@e`@pGajAwWg`AmAua@zJ_}@zPtRdSuAvnAigBfPsIh`ApKrO}Izc@zPpWqZnm@_IvX_x@rDu_A|e@_g@vUvFdTl`AfP~B~r@oAnQcOfTdB|IaSxq@rC`Clm@kLpeApPjSxT}BhC{m@ho@`D~Kk`@lf@}f@}Gob@}s@a_AhKyqAvk@_NeKg{@zKam@wMme@ap@eCaVm\\\\~HeiCjI}T|r@oq@qAyo@cZcE{IzI_Op_AeXkBq]iRiZln@wHn@mCqJfLowAh`@oi@nXeOvDq[pQkDj[nTvYerB`ObIVdZ|JpHcA|]|SgBdZma@zp@t[nPcKgBys@{f@aq@tOahAmCqO{TjBe^bWcFfNz@loAw]eN{Pq[}B}^fGseE`JoOfb@gDpGi\\\\ha@mWlX}xDgUiSzPov@sEwUea@mEmDsc@qH}IuR|HcBcG}Acj@dP_H}Dya@zqB`c@lIj]}Ib|A|Dl`@fVjXhKc\\\\`Lnw@vFbI|v@jNpM`o@hS~AvMeTfTrPp_@kcB~d@nZjQ{f@ao@usAi_@oU{Akc@w[sFxGisAqZwgAbHc_Bb_@_BjCyGaAcYyPiQbAan@rV_PfAkKvVLb`@giAgbAyi@ySpU}j@aJgFkHtA{dBii@wVoIgq@ah@eVmBygAxPcvAsnBy|Fsl@xl@cRyi@oD_cCm~AsrIoa@dCkXub@fRi{BaCcZwSkt@Fge@sEcJ{a@yE{GcL{@icAgNcfAxDcHvLhC`Mx|@zSh_@jRwq@n]eA|y@~m@xi@d|@uQptB`Ej}@deAk}@`^uJjRaR`cImfTpiBw}CnkKa|JjrKaaJlwHwiGniLmuH`Zga@fHmg@su@bk@_GeTdRu_@xAuXsc@cW_Xwt@eAay@iWgp@ot@}NsQ~FqRvXwr@eFuKyQM}zAkj@wS_No^xZybBcP_XXoMdi@qJdWe|@jB_]}MwYzDmWp^~AtoBxz@zJa`@gOckBfAclAjj@qH~a@g^nk@p\\\\bS{XqBudCq]wj@~J}o@eA{kAde@y|A`Ck_@oCe_@wUqb@zCmSeH_XhA}p@nTei@kn@czBgLwNwj@g@iKqOvJ{zCaUgr@en@a^?_kEp\\\\eg@jGudAb^k_AzGibA~a@}e@vRki@`f@PtRiObc@umAfhAuy@dl@vJ`z@cf@|s@sElI_a@pQ{O`@kVoS_l@p`@iz@vBmaAd]}o@jA_k@n_@_]}Guk@pPyn@`\\\\_YfIx@nGbk@fs@B~Sq~@xl@{Njz@s_App@eEvQ{PzGuWkTqjAjSix@gT_vAlb@oc@{Fim@xUse@wOuk@hAmd@vh@vDbFiH]cTcZow@xBsXpb@ch@ejAgz@ou@c~BmVv@oTwx@_`@e[xIum@_Buf@pH_F`[|JjPcUxXnoAj_@|^vPaAfO_Z_Aiw@|Xix@~c@lElIkH~Ya`AzVnPfG}Cfc@e}@vVaN`Ba_BlS}q@\",\"s}xZ}}te@iS{lCqe@_yA}a@mp@kvAyaAiq@oMus@?iq@nMgm@rZkz@v}@o^~t@we@faC?|qCve@jaCrdAlaBxj@r`@lo@fTfmApDhq@qMfm@qZ`kAuwAfn@ozB\"],[\"ebnZust]oe@oIkGqk@j_@xLld@`@`J|YeDtI\"],[\"yqjZm{r]rF~OwHxf@ke@nVm_@xa@gLA_|@ch@}Gme@zIXxMrc@jf@jSzRCdKoL{AuH`i@mHlLoi@gL{\\\\\"],[\"ytkZya~]fLuIjCjf@s`@`FoLp[aT`GrRi^kJiU\"],[\"i{vZadd_@`a@_EbDzNkUhv@m[zUw]}\\\\d@gUpLmV\"],[\"gqg[_bl]~^yJt_@zUiVrVccAh\\\\MwQ\"],[\"s}xZ}}te@sJjkBgn@nzBakAtwAgm@pZiq@pMgmAqDmo@gTyj@s`@sdAmaBwe@kaC?}qCve@gaCn^_u@jz@w}@fm@sZhq@oMts@?hq@nMjvAxaA|a@lp@pe@~xA\"]]},\n" +
+	"{\"countryCode\":\"LA\",\"polygons\":[[\"mmtLeoi}@jj@kVrM`@`d@{_@doA_qBha@oSnX}d@fOhIrViFbb@qeAnl@iTlkAgCh]wJmAak@_KkR{c@qTgq@q@{H}IfHkK~gA}KkS{}@bdBmOt|AvlAmNui@pe@jT|r@jDp^dm@|@q]nNqVp^`BtTsYbh@gLbUii@fcAq`@vn@se@|Qao@c@ib@hVus@sO}x@nf@s]fKiv@vn@o|@oHwVi^lCeLs\\\\e`@i@g^{Pye@ykCu^y[o@gUpRo~@tV_PHcc@hjA{cArCuf@le@yWva@ykAfdAjl@zMf\\\\eMv^nWfLxa@~f@zPkl@{@mxAtl@{`A~WyCjLnRxh@DbIaTiNgoAp[ov@lX|@pc@ed@rDvKvk@mB~Fhn@dP|Hl\\\\fo@tXvNxJee@vb@fFjh@roAhhApe@sE|TnHzl@qr@pmAl@zVpPb\\\\cQlJyL~\\\\pJ|x@fUv\\\\_Qxu@dq@gIxX{`@bn@oJvFlQnc@zLtLlt@|w@f`AvBuZhRkJpVue@aB}f@p\\\\kdAnUo^z[yFcAy]bb@qz@ng@gc@lHys@tk@oc@~f@iw@xCsW|PmNbLax@y@qk@rCqOzTuKr@ak@vUom@a@a]bs@ob@lIrCMfThJ`H~mA~SlPwExd@al@~c@aAxb@qj@_Akb@tb@q@zZak@y^yZ`Lmb@p`@yDti@ak@hZ_G~^e\\\\nn@jFbT{EjTgWrzAmp@l_@o{@|lCs{BttAyfBxFwUuZ{VvAkN`c@eOr^pAxbAiv@~KzCd_@eNzRkt@yNiPhmEcIl[yRzOki@rn@Sfh@mQhMyk@mcAiq@P{MfOyIzv@`DaAwKtWkLfSe]jWsElFo{@hPia@pw@aTn\\\\o{@~g@sd@{AktAvc@uDxw@fj@nK~j@fZjj@mAz]tt@lVlb@ao@xk@kGfb@io@zx@a`@eGam@nNcJz@uOje@_CnDqp@ji@kGvm@mo@bj@hE|@tObe@dFdW`UbWkDnStxAzi@eIxj@e{@pr@`i@f}@mQfl@zi@nv@`Vma@vnAn`@j`@h\\\\zGb@lW|ZtLlZdc@fCzLqTb_@zdAtq@oFbe@vPx]uHvYuaArt@oLnl@e[l]aIdY}o@pRlOdXdX{F?d]dNmD`Zb_@tEpu@c[vn@rw@jOzQdR_ApcAhN`D{Izq@xEzA~S{ApRaRbg@_D`_@yf@pjAyl@jl@di@j\\\\c@|IbHyB|hBwu@jeA}n@|YsO`~@pEbOySdMe@vSiOrPl\\\\|r@fGvt@wBlOgl@pq@{bA`j@ea@hAuJus@uTiOjGgX_QkSqGe]{sAex@}z@hFyn@yDe^~HsXyFeEgMiXbCo@wN{LwCyRfIob@yc@aHvW_e@jS}Pbj@kW`Dwa@aK}r@a~@{_@vBFzc@qPlLyLoDeg@whAcyBe\\\\s\\\\hEci@fl@uHhjAyTnUwiAb_@cUe@ma@gd@qXprB_k@|nBqZ|NsdAtI}n@dbA}}@x_@wb@lw@{d@nIcrAcRayDbWuhBmj@u|BaCcb@dPaL`Sko@z`@uuAr{Cy~Ab}@ea@lr@csAbd@eu@hv@k~Bz_AwR~Y|@x[vZra@ma@hl@iHbaA{e@vzAgO~}AxHdw@|Nx[vZvOni@ke@vVnnA|r@nQtX`i@hw@c@~Z~T`CzImP~g@`Xz~@~q@`gArNfg@pa@eEeRvu@qm@sI}W|FkWbtB{Zr[}Fhd@qYh^ic@rg@c]hKmGjy@lmBzkB|BxKwYjy@xSjN`oA|UzI`y@fXjUqGfMnWlC`KxU|I}LtOn`@b[nTcRfHpL|g@jDxBdByLdRrK|x@ntAbj@~ZzFdUp]nTgTzr@m_@ji@kOtz@yLD}_Aqi@eg@_ByHqIcJlEqS}T}`@Iw]{g@io@y[iYa`@ck@vMqk@gLo^nTm[a@yRyNyXtl@wf@n_@sr@w\\\\}[mo@}YmBsc@cm@mRAkXbQ}g@gMop@hAuaA{c@kWqYoQhDwo@jr@}zBfNsKnSsQxBwn@yKeKi[yLmG_`@_AkRlIcFzQhN`s@m[jkA{@tkA~pAfnAkd@xiAXlOh[dXkQvx@}hClz@{x@s_@oY{_@esBcd@i~@}@jJxIsXri@q{B|gAaErVxOhh@jd@nRvKhYjWK~GnHgN|]s|@~@sOeLypCo_@an@_Wkg@of@_Noa@kY}TxKmjBaj@cgAmFbQbHpv@{FpJkKi@ye@cFwMqPcUrGuOgr@w`A}f@g~AoU|Gsh@{Iad@a_Cc~DsSe@zIgPlqAnHxWsf@p]bPpJcGv^bFfh@u^bAsS_\\\\ov@qKatAjJoh@l]j@mGyl@x`@w]uH_f@k[bCiDe[wQqJkS`MeVdp@gThC_tAyGeXqJgb@nFyI{b@qVkEgM|\\\\uXTiPjOs|@aMagAjo@sUxh@ukAb_@st@yBij@r[qRaUEk^oj@aMiJoK{q@wEk[uc@oAeYpMsQpv@ac@xAyNy^yMaJs_AlJqw@\"]]},\n" +
+	"{\"countryCode\":\"LB\",\"polygons\":[[\"sgeSwslTuMKkPcY{U{GdCaGca@yQsQwA{N`L_SyU}yA{[gb@yo@atAi[ke@qZeeAwY_p@vE}Cm~@_s@}X{BcPa[tGwm@}Kgr@xKeLyKuv@}IsIuMhFuIwl@a[wNua@eK{I_RjJeGe|@{d@}p@ee@_Ci^hKjLoi@VyjCud@q\\\\lc@sc@Cml@vRlA^r\\\\nYjBxWvf@zKuHOi|@rQeI~Xan@hj@`Cp`@sf@bV~Jzf@yX`dAhgA|TfGnDps@j|@`_ApUjLfGaDxWux@xKg@zHpRqRpaAdMhh@_LbPjT|u@~aAv~@pUVbFrLjLjAtYqbArQmBpOlUlGhh@zg@pGxa@n|@`g@h_@lKhk@v[~[nC~RjQbIqX~`@vc@lQvm@xCnd@bSzRhsAo[le@vIn|@\"]]},\n" +
+	"{\"countryCode\":\"LC\",\"polygons\":[[\"ispGfedd@pmBjB|a@lHrCjKna@nPeNlJuPvm@sRfOuq@tAgbAm_@ylAey@zBcKxIxAxM_O\"]]},\n" +
+	"{\"countryCode\":\"LI\",\"polygons\":[[\"gbz[w}xDjKeOtGdCrRaMfRvEnFqQ`]eTd^pTkCb`AqZcUui@bPaTo@\"]]},\n" +
+	"{\"countryCode\":\"LK\",\"polygons\":[[\"moiCoc~p@xdAkDfeCb`@r~@j_@f`@rAdoBlmA|kCdjEtYjjAyJlInR`FxQzf@|]dbChRfX~Af`@fo@dz@?hc@jT|g@{K~^fAnm@{QfLzE~RqJn`@tCzIqa@ttAucAtcAkpAve@kz@lKcdA|\\\\gCqGkb@dD}hDraA{r@fHakAkGohB|\\\\oNcCpr@mPwZkG{Y`U_MuCefFna@?_Hkb@sDkqGv`AkZoEco@nEcR_Su}@c^~i@hTfLiTt`@hSnz@rJrQg@pOaM|XpKjM}RfA_\\\\kIwMqj@dXyf@aTy_AxKmVyKwaDc\\\\uVuYqh@wNy{@oDye@`Nsr@kAw`@dH{u@yqAcgB_c@ebA{FcSn_@yc@fGgr@_yAm\\\\hUkn@tbAze@woBxQgWjV|BvGcLu\\\\ivAv`@stBer@rxAlEnYwFtd@ee@nk@wb@tdACvFfNo@tN_NlHvGg_@`k@uDlLrX_Yer@~mBsr@d]g\\\\ce@kAw}A~X{CfMsx@hmAugAh`@{`Aq{@rlAmr@~k@gZdcAoHf@sDcL{@uv@faC}dBb`DapEng@_a@yG|e@nc@ub@|EwKaSnETuKhmAmc@wEhYzGnBpHcWhMk@cGgL~`@mKtZeYxIvGwKnGyKra@zn@kd@bLrKzEmSm\\\\iRd|@si@`Ry[f_@mLfMmU|o@mOiGsB`CoHba@kTrX`PeCyKjk@qNnApNaIeBgHbJ~g@^ePf_@xSrNcKuUd]wYjBoi@cEuHsMjE_H{\\\\fSuR`iAyMsXnVjY_BnMuY`cCw]rNjJov@tOpa@pC`ZqWgM_Q|gAqa@}G{G`P}BcGyKpX?rXwTnPfKhTiFvM{KkAsPhlA}n@dCzLma@dg@dTlRz@uf@nl@{_@nP?gLo@fL{T`a@_NpZ?pJrHrS{LrHnDiD_Mna@_H}f@kJve@qQdt@sN\"],[\"yrtCwm{p@sp@pGhwAwq@nu@sIbStH_JvKm{@?\"],[\"ggpDi|so@wG`IwGaInOunAbb@ym@yKze@ti@sa@{@|Mol@vt@\"],[\"spxDwfto@lH`I{Bl]ag@pCvK_JrEyY\"],[\"}yzD{mwo@er@la@qb@kK{AsLdAkFfj@KxDyRbNoC~QuZ\"]]},\n" +
+	"{\"countryCode\":\"LR\",\"polygons\":[[\"scfCrc_F}JuWmYg@_ScV}d@_DsEsTea@hJwm@_b@iXa}@efAgx@ahAw{Aw[q_AclB_yAuf@cgAicD}@qRa[ee@wVcJkyAsXyf@wv@nEkcBg]i_@MuDeYjI}Cu\\\\ei@`Hcm@nMsLh\\\\hEbJaJih@mhAqFsk@hG{G_Kk_@}c@cIn@sIhz@aPhScNi_@wJ`M_`@jLkBl_@bSWcc@kJaKbi@e~@`QdXr]{Q`q@`L~Km]|z@oL~W~GkMeM~NcPpn@tLls@qCtlA_s@he@|K`T]hNgLjz@na@|T|Axc@~_@zb@hNo[{_@dZeRwXcSm@gO~DsQbUmK}Awg@xr@oPdMoNBwPlV}Sz\\\\rBi^yx@mEm_@mU{MtUc[gM_a@{s@v@ca@ke@cWoDec@_e@sb@~Deb@gFgl@sXzBoiAng@Q|t@ykA~TgJxsBuV`hBmr@roAiL`Np@bTzSjo@pGdSuJrl@`M|xAjlAzvAjaBrB{e@va@ad@ya@qQlUw]hHgG|PtGbUcGrK`JnFmFeLyc@jNaRqAiK~OwCrWylAkP_uAxQciAjm@ki@ngAnHtGyEeGaM|DuJx[oMbZzGtMyGzb@kj@aX}Uvb@e{@pIz@xOqPyJqRnI`AjU}Uq`@{HvGaS|eAz@~o@qJbt@i\\\\jDjPvUmJPnO`FmFrv@dYh]uK|CoSx]mCjb@bQvA`EaS?g@bFfOjUfYfH|q@_@p]xOuBjR~NbQl{@sAle@yLyC|NbN`Rvq@H|RoO`e@mCxdCvAds@{FdWhHlTaWmDnjB_[dv@u[|R}_@dcAg]|aBuObaBq\\\\xYq]luAedArhBci@riBkn@b{@kVbhAsRpTmSnp@ifApkBmUjGoQve@icA|gAmp@fVeRpt@kTcG{MdIic@j}@i|CvoDwf@rh@i^xDuRdd@u}@vdAwc@lnAwOzC`CsIkGyEii@ldA|B~Jh[qm@jMuCe]vm@g^t}B}g@poAeJfKqIuCvKoPg_@lKg[qS|GrX{\\\\|Mie@vk@gt@paBchAfzDaOpLws@cC}NnGyU~c@\"]]},\n" +
+	"{\"countryCode\":\"LS\",\"polygons\":[[\"bssP_`uPvGid@nYqHzO{j@xn@or@`Ls_A~b@cBlUmXlbB_p@|u@vUpa@~gAd\\\\vCpWgLbEfIbSXh`@hhA~r@t\\\\dn@~CnO_Phi@_F`iBzxEhYl`BkJ||@`Tvs@w@dl@l|@|v@~Wry@rJh@tPiVhMu@|TtKtj@~x@dZ{Hz_@vK|SnW|k@v@gJzwAeV~o@xErV}G~n@wcAryAqbA`j@eOr_@{[zNPtt@i|@`M{j@qV}Ezi@m|@h\\\\ga@|h@cwCdiA{i@to@m^sKlFoKmPkKzGyMkUkYmVksAkMsWiRmGiCkTis@ci@kS`F_SuWgXuCcRgn@yZrCur@ex@gVcMaN`BgCmT_RbOuZil@e[gDwKuTqIrCfCefAqEuOe_@{V`Pa_@m@qUamBkwAzAywA{LqWkc@a\\\\oKgoBiU{k@nOeS`x@qSRwZ~c@kZxTet@\"]]},\n" +
+	"{\"countryCode\":\"LT\",\"polygons\":[[\"{f~`@qlfO`o@uEbm@tl@lb@_FtRpZb~@v]zJcn@oDio@lIufBxOaSrO|TrRzbA`z@`b@qNtnA|IzZmFveBxPrRlb@fDvt@vt@xQvfDjStMxU~f@fg@rAbIfZ~LpGx_A~Fpa@uRnWIpd@lcAbu@lJhn@rr@tNXdJaUeJoqAtWoMUqK~[e^lJxIle@nDhPncAaI|tAcRzQuK_ZiO`P}Du_@gi@bz@z^`w@qDdeBtq@`^dZxs@_J`iBpHlq@fNbAvIhUrl@iW`Pf@hMdRlB|r@qQfWdUhuAvk@f}AoCnjA_WnPqOdd@nPrlAqInmAhPdx@{CtXpQ`cBuXlwAeIe@gO`QgXuVsw@bMmYpNwN|b@cVbV_u@jsClDzh@gHfJsR}DwFl_@uPzO_KdmAzZ~j@_f@rd@qTrOmWrBoo@mCah@oOoZnCaOiOiJj@qa@obA}TwHyk@|OgN`l@gLy@uKzUaCfr@ucAni@fEvoAeGrrBlY`lB{Ht]u]dNnHzd@a^bwBig@|yAe@f~@wz@toAxX~aAaU~@eGwOmWxRwSn@nM`m@yk@qg@}~@zN}ItMs\\\\tAey@|\\\\uh@nl@wZrGws@|Hyo@qGojAbBmFstAep@oWge@g|@cGix@ak@mmBk@iv@abA__GzOojAoBoxDpIcy@vQoe@kf@k`DhEiSzo@id@fKo[eCo^m\\\\md@kHkgAxYwwBeNukC~NmJoBerAvL_lAja@oaBwXgoBtS}z@aAyh@wJac@aw@gvAoRinAfAgXqVuNzKmWvjAmf@j_Am}@dHqUlYmxI``@uT|sCglHnaAe~@pg@y|B\"],[\"upv`@k}xK_Eng@mm@ym@{vAyr@e~@sIqlAzGjMcSrZyGzfBfFd]lSlK{Jzx@ti@hVr]\"]]},\n" +
+	"{\"countryCode\":\"LU\",\"polygons\":[[\"i|q]mnvB|k@dLz{@_c@lD}CqGwEdj@g^hOag@hO{HiB{RvRef@`G}`Ate@tInL{Gfb@r{@lIoHhl@|h@pdAlBqZx}ApA|b@vDaCfA`U`RhQtEhhAsXjS_Jhv@uVp]qMe\\\\wH}DyBpI}d@ua@mMxWs\\\\wA}C~Z}^xO_Pv^kd@sAb@kOwSlWsa@}ZgRq]qNdEaIaT_QYqKuOkQ_DsR}i@iVyK{D}UrOeXyEo[\"]]},\n" +
+	"{\"countryCode\":\"LV\",\"polygons\":[[\"wqbb@qfuOQqlBh|@pIzX}oAn|@u{Brp@Qp_@pIra@xwAhUqf@~NgBbt@fUveAdu@`BoS_SgmAjCwZpZur@|GkBnf@j`@xa@egAb}@wQzW}fAjFyBnThWvf@kk@pi@w@~|@k^doAvi@_HtVr\\\\|qBl[~[t\\\\d}@lb@~U`Vb}@joAt_@yAd_BiSvv@nZbu@i[vdAnBliBb]rq@jl@ho@nMzp@}Fh_AzPn[aF|eAqg@x|BoaAd~@}sCflHa`@tTmYlxIeHpUk_Al}@wjAlf@{KlWpVtNgAfXnRhnA`w@fvAvJ`c@`Axh@uS|z@vXfoBka@naBwL~kAnBdrA_OlJdNtkCyYvwBjHjgAl\\\\ld@dCn^gKn[{o@hd@iEhSjf@j`DwQne@qIby@nBnxD{OnjA`bA~~Fj@hv@`k@lmBbGhx@fe@f|@dp@nWlFrtAwm@jN{s@ra@mj@yDuu@pFs[kSgt@_Adc@}VpGzG`P{GbGzGdLqRaIgJy}@jLi[jh@kvAkh@ot@gCca@xF{IyFke@qaBg~@wbBeg@mRyo@xFwiAqGgUwJ}Oc\\\\w`@oVksBmcCqOogD{wAwmHqFenAh]fKhe@Czc@ug@pvBqiFxMw|@rzAyi@vIyMpbAaWdXop@bPihBp_@yn@jFycAw[a`Dya@wr@ea@mlBqaAghBmPkL_u@aBarB|QsLjImf@cI_l@tImc@hc@ov@nGgUsKnD_l@m]sf@cVsx@q@k}A{Zm]tHas@yMya@wB_o@ae@qyAjDol@xl@c^eMgi@}YxTyIWaBkRbWyYfF_l@lW{d@hLys@zPkIvL_ZyEooA|h@}e@fGuiCvi@kE~j@{xAp`AmoAjVqrAt[}i@wFsYzC}\\\\yZao@_CooA}a@ao@jJwbAfd@e`AxSsqC\"]]},\n" +
+	"{\"countryCode\":\"LY\",\"polygons\":[[\"gagSuu_FpMuj@~N}FvMa]lPqaBoa@~~@f`@m`BbmAaqCxUaYjv@elChTmmFqBcz@ye@i{C}[kw@uBwx@~Gcz@j~@khClDw`D|]qcBxQixBdyBspDpMidB`[oz@hCerBf]ihDzo@e~@hb@aJxt@yf@zaCbAvzDyoAvgBwqAfcAuuAjeA}oDf]icE`AyeFhXudChg@ogCbSo_Cjb@a~@laAsmFxi@{o@vt@}nDdeAmgA~`BswCro@_t@~[qnApr@}yA~OwpA{CkqBoNw|@g|@}vBkNut@}v@yqAojBkfByz@mi@iX}`@mgAsf@cdAg\\\\_jB?mx@bZav@tq@udBbi@y{BnVggBgR{R|CsgCcqA{pCu}CoNqd@_lAwmBgt@eqCyoA_~CVkrC}L_oAsxAgaCoGey@nTmk@~Fiw@mKugCeLyKiAqSjd@wb@vB_rA|w@{kBn}AeqJjNgHrVpOde@}DzVc[rr@xKgChKkT|G~~@rGnYqfAlk@g]nIeYtXwOme@dGlCif@nOaZrCgdDjTsiBna@_bB`PnP~d@_jA~Gs{GsXslAMsf@ps@spDlSuHrp@jAx|@qu@xm@sWhs@|z@bt@|UffAndBzRtFr}BqExaAmMv}CeeA~qDnu@npBnyA|rAjm@bWFh}CalA`z@jG~XeCxgAch@rsAhCjbC_y@pdAkNzkrDvAJdmR`wH?{blArd{CxwGrsR|kF|{MeyHj`K}d@hhAarEzp\\\\ksMbzFk\\\\pc@atClkN_@`Zjq@xeDq_Az|AqJhJw_Bn]yp@`|AuOxFi`GrEsf@lGwgNdzJua@rMm_Asb@gZwYcVcBw}Aw{EsKwJgoAaYs]pGiwAoPmo@dt@mqFv_AqfAoTur@yg@_g@hP}]mj@uyCil@qyFhbB_cImm@quBz@o~AzL{jH~aBgxBvhAwcEncD{dCs|G}M{nAarDunC_w@{w@adAqe@cm@eIyj@vNyx@BkzEruAwg@uEss@wp@u~@ei@aUc_@VgeAqQmk@_{@qt@yeAiLyKcMlFsm@mYsj@sqAu}@{oCwcJch@__AoRaJiYdAopAddAadDcC\"]]},\n" +
+	"{\"countryCode\":\"MA\",\"polygons\":[[\"uj{O~j`GmPuIwg@agAge@kYoy@yTaN{TyR{y@gPepBr@eyAm_@qnBaY_jDcR}Vw_C}nIi_BkyAeUmHwUcd@o|Bc~BwsCypHs~@ykAsfBgpA{Xil@sbC{cBew@gTktCopCqaB}~@mpAgf@mlDeZia@ba@cs@dTut@~oAiKvc@_Wc@wvAcm@euCfHu`@jK{iCqXyc@pXqjCy~A{`AyOowDg{Da`AwVkh@o^wrAaGsK~Oq~@_Zco@lQs\\\\oJeaAi{AmfAafA_t@upAqxCetCu[aDoz@kx@xAmo@iXql@et@yy@_fAytFoMqLcb@mhBuQ{SvAke@eNeo@_z@ukAl@s`@sn@alA{l@mzBifBabCquCm_BwtAucAm`HceDkwCeu@kjFmpB_c@gFenAsd@aq@}HyRsPxAuuA}Xkn@nFaz@_v@ipAPea@mHqHdo@kc@`zAuLhIwG?oPz}@cPxi@s|@r|@ys@nQso@zl@op@|l@wuAvv@quF{fAm|GdFeEdFpKnQ_ObBwgAyDeMg[aJ}Ou\\\\|b@{bAbUinBmAqy@wUqlA}a@eo@zBqXqOyRoqA_o@hGgIfd@vEhZoH|H|JhP}@lJ_LsDyQpIuGxl@vEra@q`@pHobAk~@bjAaQhEj_AihA|T_z@nDg|Ac^_~@vWkwAtK}_@pW]~[aPdm@{qAdAa[`e@{UnlAkbClyAn~@~~@gjA`Hy\\\\~aAraAjr@uf@|bCyn@dpBt]dtAuHbd@fZ`c@ws@lw@e[pS{Ad~A`k@~pAsDzXs^zs@s_@j_@}k@nt@dArXza@nvBusAdZe{@x~BkwDxn@~w@xNfz@hUhOn`BhS|SrSbIwAyFil@`JeV~KcBdUhKqYboXpYdoBjG~rBhP|b@v}CidAbLflB|a@jfCb[vuDoBzp@`Rpi@r`DvGnAfm@~\\\\hi@|gACl^jPzIeAdEyPiVue@lA}It^aYlW_h@va@sHzm@w`@fiAn`ArmAwGjs@|tBzTzgBjC`_BlPfiAr]d|@rmAzdAb|@jvAn`Af|Ct}BxzDxw@b|@zwCncBfiAn`AlPb|@mPls@yw@zTzTzwC?hiDhf@liGtj@ls@if@dlB?|tBdY|dAls@vw@wBtgDfGhTtkDdnFfg@fzAjiAjsBdn@te@xoAfsCr[fHnoR?\"]]},\n" +
+	"{\"countryCode\":\"MC\",\"polygons\":[[\"sjuYmgpCzNvS}A`WmMiCaJmT\"]]},\n" +
+	"{\"countryCode\":\"MD\",\"polygons\":[[\"{pm\\\\e{fO}MoCq\\\\ea@~Vab@m@c^al@bDmBsWiKkH`XqdAqDew@qQxCcEoFlW_jBen@orAjNie@ae@u}@iFc~@lOi@`CqK`E}aBtYup@dd@oe@hNw|AfRuLnWfKtCcHmLgt@jUyEjHgPwPmo@i@of@v\\\\qBjPvXjP~ApBcSiWkWi@kM~KyNhPdJxb@{d@ss@yWuB}`@~YalCju@wSfw@uhAhAwh@eWkZ{Bkf@zK_Nfg@kNtKsf@rq@jc@`T_e@`W~Xd`AhH|p@lg@`s@{VpKqOgIq^pi@si@vFc[~b@sLvPgYqG_^_[qL|Gin@vf@wMhOrUt|@O`Nxh@f_@kYuRi^~Q}Rv]nQhh@rGrX}tAd\\\\ec@lA}v@h\\\\w[s@cWth@iMllAjKdXoJnLfFrApW|L}@lW}i@q@cStMyJlDvCnWif@?ca@j]p^HndBlTrw@qHlKiZ|Col@zt@xY~EnBh]l\\\\pDrGtQ}CjSaZ~XsFxd@u^mBoD~Pxi@xr@{a@ni@vK~Iz`@rBdXrr@yKxIyP{Lg{@WeUbM~r@~sCh^rOns@aOpe@bFba@un@rLoBhy@zn@|u@iJb]lfCnRjFb_@gJrS`D~Nxi@tT?rHdy@h[pHbHz[pZvXhMiVna@_OdLdWf^kA_GtoCrPf`@tRbJoS~SaTH}RhN{Sfp@gRo[aDud@yoAbGwf@`YaQv@uI{K{MpGoMwCe{@lYuc@uCscAaa@y^hUsBmMkQ}@aJsYcNmH}Wp@_o@ya@w\\\\DsPvOehAwPcuAfj@in@lb@aPw@sXbPiSkBwUfKme@da@oGxv@aHDi`@bz@yGyBaI`Zid@lJqk@ja@mRf{@w`@bVaHdPgR}CqEbJmZkGsRf_Awv@|ZaDpRko@dy@i]~R_AmMiTvCme@ti@}GkTkKrX_Y?cSh_@cI_JmK`PyNcJiAtNoOzLvC`O{GfCqGuKkZfi@g^UaIrn@oMkRoDpTkQrNtKxQiNlEy]~x@nBvXyL~m@\"]]},\n" +
+	"{\"countryCode\":\"ME\",\"polygons\":[[\"em{XgghJiOdA{^za@ch@EoBea@sNjByPi_@c^gFemA`{@yXjEqPoQgx@zWwd@}]oBo`B__AnJ_c@mNuZ}YmD`N{w@whBwItFkB{~@`LeTvc@_UwRcSiEc[{~@jd@eV~XrAvLmKaBwOn[wa@}k@a@eMvXga@{BsXkMwO|@il@nMpKla@sI|j@qoBvxAqvAfg@qt@`Jqg@mCs^pb@w\\\\tJmZG}h@uMsb@`UaRt~@gaBzJmaAth@opAvf@bDlNznArWvD~KrNiR|aAzQb^~T_Eha@ki@f^rP~YYdGnF{EbOj\\\\zb@vAlm@|VhaAyNb^kRxIqm@iFo\\\\|MKx[`Yz`@`l@`TfbBxiBb]nIbsAlmAtOoCtd@ow@xn@tLvXwKtNjNdcAuJiYha@eZlvAq\\\\dSub@WgWlb@aQYyQ|TJxVsr@x{@{e@`PyB~RbGbMqGrGhKjWs`@`g@}d@nUKnKtKhBc@xUua@tk@iH_Jl[_uAoP{BsVfMaNeAlRvSkAv}A~IwJbQdDjDoI\"]]},\n" +
+	"{\"countryCode\":\"MF\",\"polygons\":[[\"{e_J~xme@aVvoAiDu^oRwNqFi`@\"]]},\n" +
+	"{\"countryCode\":\"MG\",\"polygons\":[[\"`~lKej_Zyi@aCguAsl@kfAo`Aej@yTwaAf@q@pJwTnGgJ|Xk^bPkl@gWax@|AeLoP{ZsHgLaUoWnAk|@zTwl@rh@eo@hTuzAncAaqDiMuwBrk@erBbtAoQ|Ci{B?qaDnXghBuT{gBpaAoa@fBww@}J_kAcj@{AgNkbBq~@qn@mGLoPqlAmc@chDmwBa[uDqr@nPaUmIhQaFiO{Hua@qCkeA|e@gSn@ke@sVas@mD_Kux@~GexCpQy`@DaSqQmMoLqj@gC`D}Zou@onAq|@{h@ynApeAiQ~m@k@e@kRySgQuLc]gNqAmUvW_LkDgRevBzZsKf`@dEgD}IdKgCeKuIe_@jEuVwOk_@}CgXpNk\\\\uWiLcZhTa|@gY_{@Gs^`h@kEvLabAgUdIy\\\\gLjUde@{LuBie@uc@_Pqj@rGax@jXmGje@~XpaBilAsXgCbP_PwAkKub@Mo@oOrb@ob@{CoEmi@xTy[fe@_Kps@_s@kb@}Ll_@aq@cQ{uAq|AzK{Buu@qgAmDin@sIrZyKmCoG_c@cvAqnBsOyj@?uLjs@eObYko@v^pNbRzVhu@xZxb@nDl@uEwb@sPke@yqCuMJ_E~RuTxKsj@lGpEp`@e]aD_A~Ree@~Ruc@qUev@afAao@iU}[o^oS_q@oZdAzPhd@uPpNqlBucByByKx[gRzk@|@rUaNfzAf{@jkAlEjKyIaPea@_r@kXwLuPdD_Fi_@aD_lByq@kwBwyApm@we@fLqa@x\\\\}ByVwHgRtLoLiPz@qOf`@iPhu@oAca@{Loo@|Dwf@ju@pNvt@s[pUha@z^oa@tRyK{G_p@hTubAwK{\\\\k_@}h@wIoCwMnSa\\\\q@ch@sGmIq\\\\kFm^nG_UtTqGeGba@oy@bkA~Tjf@uA{CqKeSCiMgR_`@rMgc@mTei@cA_q@|aAeWon@{F?oa@f}@yeA`Y}a@_D_KiZ{QdSkn@uD{KmGxC_Umj@{FwGaKpEkJbOxKpHiCiCcL|P_BqUiUyOhK@eVbFqHdk@yHoA{YjjAqSzEuJjK|FpK}EtS{a@a@q^wYqYcOjIui@sCwe@ePe`@jOu@kRhYiQme@gTiC{e@uK?zMwZaaAl_@ea@?|FmNrV{IrTkq@gGeaAg]gj@iRqNmKzIml@g_@os@xKwz@oNyiAwe@mSsU}gAoGye@jX_i@~F_UnUsr@eLgG|JfG|PiQHq}@rg@fKx@kKnXaVoQxHey@|m@c_@sDaYqzAgWwCwWi]sXdJeH{_@}W`DsRwTsIkTfC{TaXa]zd@?|\\\\}\\\\wi@ra@we@uXbCeLxTkw@{d@wBgUzVsVzYiChVmO`q@mAl_@qKsXhZfLxZ{VdLdMzMzIwDbYdKxIye@bNxKfLkEqQ_OzLwJdJ~B`AuQiC}Ik[hDsMkMd_@eX~|@qTcPgX`SwY~PX?x\\\\fUiVuLiRhe@kPx\\\\kDbGp@cGbJcXr@rs@oDz`@nJ~PsF}D_Ov\\\\c`@|e@wLz\\\\xK?gTsg@eRdAmNpGtCz\\\\si@`e@cEbM}c@pKM|HtLtVm_@vk@oV~rB?f_@mRzm@iA?o\\\\~GoD|^nDjt@gIl\\\\_Sve@iCtDmKxd@d@bbAm[r{BsEl_AgM|tApF`g@m]|}AxM`mBoLvw@yQbSjDxFbMl\\\\wa@zq@oJd_Buk@bt@wh@f^oJb_@_^l{@uGjVdIdw@rArOxKjpArS`eBpv@x\\\\rAxbBraAnGxe@y]pK}HtI|@bNkf@ji@ev@zGg`@`a@k]tCwYeGsg@zj@avAxAfNpqBd|@rp@v{@yMvXxGtUiQnsBgb@rfAh^ph@{Bz_@kWhXwy@`e@}OxqAjMtTmBxUeRlz@tDj~@zb@|h@zl@t[a@ne@cZnF_l@bj@|oCtyArp@jvC`|@pk@dEve@wT`aEk`@bsExz@f]qAdWgM|HjOra@`R`{BpNnpCngA`sBn_@ljClfAvbE|w@d~DzmAhgClUlw@dSoV|RlErDrk@oRtdBpr@vjFptA||El}@j\\\\bS~GsAsDyKlQMbbCbo@bpAtLpsGx_B~wI~}C`rAf\\\\leGvh@taCrk@zyAfNlpE`iAcPjMdUlLxHuXfOoArcCb_@jeB|k@|Pd]tWB|rBlo@Ix[da@qG~cBhOboC`cAre@xDjU`_@fRtGfg@kDrN`Fma@zKpq@zEvPp`@fo@rn@wLxVrcA~`BmAtPlTzu@yLt}Az@fgAzSb|AdbCr`H|t@r}@xL~e@dZn^bWbxF_HpOca@dJac@vtAaTzY_RxF{f@vw@gXxaAg\\\\|~Eq_Atl@bi@wu@oE{N}LvAce@jaBsd@vd@{Jbp@s]zp@orAjPclAtr@ykA?mi@ff@k[vHg}@frAqk@bTo|AjIojBiGq{@|IsVeG}gAfX{K{G__As@_j@uaAae@pGk[sOwi@jd@s^~j@agDz\\\\mm@vz@_bB~mAuzBl[cc@fR_dBhC}iBla@wU}Mo@aPpJeLyHcC}_@bCIlT_XpGqw@FuiBqa@~GiFh`@bB}OyKctAiCqaAqz@bEwXyeD?yz@wTxI{Ho_@wf@oDey@xHca@{b@{o@o{A{^{wBqVggAmf@cY{k@kz@u`@sqAeUeSeO`PgCyk@}V\"],[\"hvfIkun]sc@{IeRy^zkDfgArnAlr@_PnDe{A}W\"],[\"f}cGo~n\\\\fPsSnKlBlClRaHfEb@lb@qHdOjG~EkWpNix@sAmBnHgGkEvI}j@_i@AOu]`\\\\bA|g@oW\"]]},\n" +
+	"{\"countryCode\":\"MH\",\"polygons\":[[\"qjlBefreBd@oK_TcAjQcA\"],[\"qdvB{~xgBbMyKuA|L\"],[\"wsiCqr|gBmOqBfCmGdP~U\"],[\"sixAks}eBmI_^cWuS`ZtO\"],[\"qhpB}hofBqWqWe_AeO{j@kp@xUdGrVbb@nvAf^\"],[\"yfuBs}~gBl@bi@_IvG\"],[\"qdgC}rygBqTni@Hvm@uXzU`PeT?}o@\"],[\"uziC_prgBjY~aBsu@t{BwHcH~OgChi@yhB\"],[\"mvmCs`_fB?el@`HbWePvgA}GqA\"],[\"qroCoo{eBkOnLgTqC\"],[\"afyEazzdBlP~P{GtW\"],[\"ybmBq_ffB}Eg@b@qA\"],[\"kxhCcizgByCoD~Y~U\"],[\"e}rCobcfBsIdA}AvMR}O\"],[\"smaDaqdeBpEwBeA~C\"],[\"{kiDg_keBsBPaBFwAw@qAmCxBYxA\\\\\"],[\"cxlDi}ggB`FjCa@nBCHELGHIBsDqAk@aAKmB\"],[\"cqwDc_|fBqIZDeBjD_A\"],[\"stgEukhgBoFBKqApFC\"],[\"cfbFse`dBNs@f@qApAiAlC}@\"],[\"{lcFkd{cB{F?q@{A`Bi@\"],[\"kj|Gw~cfBEy@xC~B`EnEzB~DqF{E\"]]},\n" +
+	"{\"countryCode\":\"MK\",\"polygons\":[[\"wypXmupKpMaUyMsXnQyVoBmLwb@u\\\\eu@rCgk@}LaJeKod@{gCw`@cz@bQeh@nj@{l@xC}^yEwFg]|B{h@wc@Bon@eNdEgCgIjFqR}FuG`GcyB{j@uvAwA_i@_O{ZfTmqAgj@_aCdQ_DjPqi@`jAgtAbg@a^zs@atBXaf@vLgJ`@qWvzAsYtF{Khs@}VxHe_@zZoJ``Azn@loBoLnLxIbe@gAvG|MP~sAhKpQj|A`NhO|LiXvd@la@|`@bFtuAgJpk@dCtk@yKxB}Hn{@|W~~@_Rna@~`@|uAhZfH~v@bdAdXjJqAfq@pe@t_AkT`l@aDj`Ax\\\\lcAyDfeA~K`gCk[jDuFnIsI`_A~Qpk@eKp]idBpf@kJ`o@u`@dFav@zg@aaAnJwHaYmZoIaUx]mQrCqPtRab@zC{Fof@kMaP_|AlTcd@q^qi@vD\"]]},\n" +
+	"{\"countryCode\":\"ML\",\"polygons\":[[\"iq_H`pnFdFqWeLut@h^yi@oS}KOkc@wa@ua@eJqc@qc@ub@oOpEy~@uIyKzJ?pPipAoHi`Dwo@yc@}Ve[m@fH{r@gHgQaj@e}@{SrDrEkh@dRk]n}AsdA~oB_}CpgBkpAorB_v@k`@gq@ox@kLoAwzF|Tc}@u@wTfWaq@VmXiIsgAhEkgAgd@eeBcEczCk{AjPocAoz@lEaNfS}@vbBlKZ{}iAc~NqaBiMfFscBruC}RzI{}x@rjEqkjBnqKSera@j`kAaqsBnaA_Tr{BvTtb@yDhBywAjn@ai@rd@iyBvm@us@fmAoHfy@iiA|Dao@jf@wUmM}f@~XmgAoe@iw@RuWpy@igAflAms@lXofAjm@olGvjA_}CnOgJduCzIlc@qSjU|Brk@wTfMbExWhc@jm@pJpQ|T~t@~TtWuT`[ou@ra@yc@vJcm@ifBu~Ol|h@kCdRrVtgAsApWnK|tFg@tM`Dj_@tq@pYjTrcCzv@x~@oFnp@xl@vuBdWv]ff@jTrgAhs@xo@h_@vu@vwAbKgl@~fHlDnMbp@hEta@|q_@xMzd@zfDvxDxIf_@`Bp~AjQ||@u`@``BzQ~cBiSlqA`t@v@s_Bn{Gjf@buBio@jYZj|CxQlf@n|CzwDzq@xhDxgCrwEhIpvAqAfpAbGxQ`iDlOzIxFvQvi@aBv\\\\{bA`qCcIpn@rc@xsA||@zz@bq@h{ApYnOtAmF~oAlWzh@~Sn{AqS|Odo@cGf\\\\dRf]sWdHmRxYn@dk@iYpk@~JlKtWaPpgAdNzzCcUzO|{Br{@RqBtz@{jBn}C{Rvy@}Cjp@aEvFca@gh@sExViVvNpJlIpEoOzDhBbf@bdAziA`p@fBtWlZ~\\\\jHzAbLqP|I~AjCrx@l^`Nn`Ake@hg@wd@bpA{I~n@zLlLtMnDvWyOvk@dNxgAvi@w@t]od@nf@aUt|@fg@znAm[rBpQqOtOtC`O|\\\\hBfn@nx@x\\\\sN|Rfn@h]~IhE`e@v_@d\\\\\\\\hwBfNx^Zzs@fU`p@laAbbAkDpRbOrf@eDpYjVcYDuU|Ro\\\\bd@_Cdr@xO~Xam@b_BwH~Rf[ruAKr_@jZro@bOxO~b@vLhfA`_Ao@iBeMvpAy\\\\hcB~^bTeCrBqHrNnIzRyDx_@lGvZbPzg@nGwPrk@fXtcCjVzk@jPtOrl@AlDhFaIb\\\\~x@``@Jzv@cf@dsAu^LmGwFhByLgLh@yOgNmFhH{]kJ{PjKmYiEkMjk@y[uQsVpCwRmToPjYaj@zD|Tpt@hBht@zb@pDrU}UrMfAnI~Y{Ifx@_Ube@a]bUW~T|[jKviAhDzU_HzJaUxk@dL`KrRqRbr@fQlq@Un|@px@lUbYeU|Nk@zZfb@NlN_i@dh@a[r_D{x@pDrE`l@qz@rLeQ`UxXha@kKnbAnRbHfPrb@dHoFhI`FvIlYrIgGrTtGrh@t^t^n}@S|d@qk@}Okz@`T}RniA}h@lKpGn`@cE|WgfArh@uzBvHcJxAiElOydA}Vq_A\\\\o[lL_RtYtFvXyEpz@vq@r`@`KxjAoEpHySLan@of@u^uDqK_\\\\{i@oOgMc`@uZ{Bp@ut@cKwQeOqBaBj\\\\kH`D_Pw^gSv_@qMl}@uh@vLjK~NiO~q@u~Apj@nGt^wLvj@q~Ck^e}@fMmS|~@ylAcDeOpPbDzRo\\\\zM{v@qTyZ~Fo{@bjB_SnoBdNhk@pPtDj]qd@dO_D`GwQ|u@fPnMrMcDdp@x\\\\|n@`VjeArUh\\\\bh@~EvY|UuOh{Asz@luB}j@d`CvOpZ{EzFdUrQoGnPbd@dg@r@dh@vg@dAfUb_@xLkCdP|`@|i@xXjDdb@wqAf{@wl@y@oYlg@qe@bXcKlYrIr}@b`@xK~k@bu@lf@zRhJjs@uQhd@ei@rb@}b@d|@gXjJe|@of@ct@r@gEsc@yz@wEyIzFfJhEc^hIbL~F_UvVaP_Ruh@KgUtNmWiNeK_Uol@|BkR~Mge@{IuLpFiDsWeZRyJbG~P`Eb@~Oq^yHi[nUwKmD}Uvr@__@jBy`@bT{WgJgf@d_@uXpCfBhRyPrBhAz`@cPfVjNzN|WhDnUb`@aZpO{KzYuy@oCa}@~lAovAnr@g\\\\it@_T}S}Z}Hyp@?on@~e@cpBiPu|@d\\\\q[`n@yZoAqUhaAeoAdHwTcSwu@oWyGnN_V}DqEpY\"]]},\n" +
+	"{\"countryCode\":\"MM\",\"polygons\":[[\"_glL}bow@_Y{m@msAqSjbAe~A|v@{Xud@}PyAgHrTm\\\\mHkFwd@|FySmJqI_Xsv@RbHghAcZjJ}Pk^onAzCkmAth@us@aFgn@zU_~@oBaOwUas@gd@glAhV{Z{GmAqZx]g^[mOkPm[_{@kb@mgB{@q^iQuaAjCywBcSeZ~Bc]~^_hBvJev@b`@s[?kW_K^y[`iAgv@aLgu@`GgPuXyXfAu|@jE}R~c@}d@y@{bA|^gxAh[{Z_HcSwe@_Aun@oa@yqEu`A}QaUwRf@iRiSgXq@iQ{Swg@kLeVib@gbAyXmAsWgLcOcs@}Iin@{a@ot@uJ}^}Tos@b@{P`J}TbeAaRvMwX~@i|Aec@ae@e_@sUq`Aci@gc@aDuVwJfCoqAobA}Jq\\\\qrBbD{Ryg@a{@it@uSpGeLx]_i@bEyMaIeMjUuj@pI{iAeNsi@|Nin@cj@ud@gG{Lo^cTyRnKya@w`@w|AecAop@kBct@kg@}]uH{m@y|@ep@}Jes@gVia@cvAo{@uh@{oAmXwdDpBm_AuGwUo\\\\wWzIye@kNcb@zQmk@dr@mo@rd@|EpIcMzs@}jBEwq@_XwH}P~QaP|@_bDlhCg]oDut@rPmXcDebAmjAsKch@_PkDqmAoaBsEm`@lSiIkIkX}}@_QqPnCal@ze@iz@kUePfBoUy[qRgH_Gsf@kt@p@qzAor@sDqJhb@ci@iQqb@pMeQvsAah@hD_SkOse@fH_Whb@q\\\\tQa]ha@b@~G}b@`n@_p@n}AlKrd@{j@fHJdKzUra@ib@|z@{Cnp@g^tdAeXpGeTyDaUwMcKioA{OpXgr@aGuQjg@cb@oK_j@nTaSdvB`Bz]yS~cB|T~r@wJvM_XpK_Cn`@tNn{@wMtUrLvj@mU`p@nJhv@wMxt@pTl|AvCro@xa@tb@vHnHwTfg@yPd^lN|OvUsEpKgVbB`Hdb@~N|I`lAaVx@cTv}@sn@hUbHtYji@{V~r@`D|PnU~UhmCloAbH~a@kTrZqNtx@`o@tBrTnTz_AuFpKvUbg@fRvWzi@lg@nh@f@bLaWzP}I|U~MbMnlAh\\\\j]`a@`uAmDjl@mg@nKzC`Bh~@~s@znApL`AvmAsBdbBxN|BwrArt@mYnJb_@`O}BlNem@rUqBbR`Mtf@qJz~Bf~Bhd@ky@vUgHk}@mxAiFg^gg@cl@ip@keCwIyn@~Jg|AgRe`ArDui@n[er@o[{pAgNm|AnR{CdRxItkAjzBbNyHnvAvE~V}oAbh@mCtr@ya@do@|m@r_@}JDq]rGmHjd@_OhWIzY|U^}Y`O{Ght@r^pSTzJsgBv^}PrG}uA~V_Mcl@ghAhe@mj@xEcw@j[t@nt@w\\\\~IdMuLt~@bm@Sfr@ra@pQz^ltAk\\\\~m@xErVqGfs@zm@tJxWn_@Kx`Bts@~HyHnHbRjLuLhQau@lA_eAyNam@lKk_@o@k]tLaz@r[o^wR{W~_@y{@yD}KmPyB|Dcz@rO`D|a@sRrrAx\\\\nbAeS|T_\\\\^ot@nQyd@hHQvXn]tj@`Bxx@il@hDkNoa@__Aq\\\\y_@dFub@|f@_c@yGcfBq\\\\mr@ewAypAu`@uaBqa@gw@hCqUjg@oD~UcT`\\\\wFlZhKd]y@`fA`_BbaA~tBeFvv@f~AnUv`A|f@tOfr@bUsGvMpPxe@bFjKh@zFqJcHqv@lFcQ`j@bgAyKljBvpA|~Ah|Ave@|nCf^qa@nf@uGj^gc@n]rPb}@tr@p^`PpsA_Wb|AcVhf@bCbKj`@s[|n@mKx]uWnYtHr_@`j@lW`zAad@vhAzKb`Ard@lb@nhC|HpS|OtWpm@|@vHaWzRaMpZzYrc@fOjmAne@~w@gRjZzJv]m@tqAxNrj@uFlJoXtBad@t~@C`W}Q`PzAdLlp@lK~s@iAlh@pkAfBfVbv@`Cdr@zm@f{@mWhK|CjHjTdc@i]d{@b@|m@zr@ll@hNdWl[`m@_h@fnCuUh_@vGdJznAte@vbAb@fr@}_@bWsDlVl{Aix@pj@}D|XeTvOcYki@m[vO{f@xSqBpRzNvz@oh@zs@eEfDeOps@gTbOfT`f@rTr\\\\oGz@jMpw@}l@d]aExbBalBnOs]v}@}_@bSg`@zd@mAvlBosB`QtE@iOrKbDdMmGhU{hAbp@kb@xZgEfGsNlYmHkF~MzJrEbXePlOzYl`@bJzLoQ`c@gO~Xy]`^s@pv@ci@vd@h@ngAcTfG}Je~@kaAsq@yQoIaLfTkAnb@}c@vkAvJh^jXbg@xCfTrP`DbNoIjNiCfp@bE`Lxg@vWdA`l@`k@_Vnb@nHrs@sBnlAhTdaDwOv{@nChQlOmVhJoKrXrMlk@|VtAxNnLfIwIzM|DaTz~@nf@h^zBxa@zXmGnl@|Qja@cWpf@|Cvh@aRrgAqLls@{`@~eAmjAtnC}oA`g@s`@p[aj@i@{Utj@e\\\\vu@ciA|Okf@zb@aJ~o@y`@tp@yr@`x@_F|f@a_@`rAHx_BmUf|@RrPxGrk@{IvQzIbFtc@nInFbrApDff@wk@te@fFbq@yLtXcUvm@sGdHkYfVkE`w@_nAji@QjYvIbmDgu@dc@lLaF{v@vfApM~]wa@r_AzFtb@{i@hw@lMft@dg@tO~x@`hAnLj[vXvm@dMznBrjAzv@|QzdA`tA`_@rLoG|]vEhGdX|Fp\\\\mErUrHxHnr@bW|M|BxVvm@rf@`h@vJ|WkQxr@eGr|AzYtVxPpv@fMrrCr~A}XxLuhAlC{^dN}fBiWev@lTg`AYul@`Skt@pAwGuAk@uT`W{Q_OcE}LxIqAeMbJeK{o@}UwR~@qGkTkExZcL~AeVoh@nGuNyJqWab@{CmP`PyUmQa`@zHw@|QsRhJcNeh@meA_Uwq@jV}TgBw@dQoa@yImEgd@aj@xK~A|N}GzEycAaJyWkItAqg@cRmB|Gcj@aQhHuGzk@sJtLgk@uY{NnI|IoC|k@~\\\\K|MfO~RjWtDtF`Qy[zf@uVvAyMiOuEzPuOyK?cPk\\\\mQYaO}GxF|GtyAqPeQqGwt@eTwOgEiQ}SWm^lJuH_EbAx\\\\yQnRaYrAcl@aq@_T~BRfXvYjh@qG|SiT`Nu]iQgNwbAkSsQsq@j~@ob@{EcGgt@qGeDeKpj@mOpIag@uP}@qL{]jBq^wNf@xZuJlA}GkTgE`e@kR_Tyq@tIsXvZuTaNel@zQccBhB_f@vo@k]rEtGdE}k@dKwb@}F|SvK_[tC}QtOeU{CybAlw@c{@z\\\\{BqGeGvb@auA{D}g@xKit@?ye@~Xb[dGzOyKjPV|WhJza@aKntAnK~f@kIps@fPhXoJvBzHkKnKxKfCg[bHyMkLyKnG{HcLsRrCmCaJsK|g@mPqGwEpN}QxDuNyB|AgL{dAM}LxPot@zKm}@w[cc@`F?qG}m@ti@wu@d]yh@rGqk@{KvKjTouAx_@iOeF_ZvWqP?qNsJ_FkWhb@y]bI_Uki@j[gCiJsNhUiIeDaUjMd\\\\tJo[fTpd@cBbLtOiCtN}`Bva@k_AgJkT`WqGiTaPnGg}AoPwGj`@ugAzYca@oTqGgTsr@zSqXaGul@rRwd@OwUhJsNeDkRbLut@{GuIbLcPyBkN~Ku`AhgAc[fCkf@u^ezAqR_lAjPyr@yG_b@{UeOoh@yK`Pz\\\\pr@jDp`C|W~_@uyBhXbPrJaCzNkW~Qok@vN}`AmEf]bL_ThH}XiH?lPsKdDox@kH{l@tX_OyIxI_d@qFaPeNnc@l@xZd^dP_a@|Qua@yDsA`Lec@pKuOje@cL{Bot@thAml@vF`j@bPyKnG|GzKno@wv@bSC|k@le@rZ}Bv~@aa@fYMhw@zRYlZtTyKsCz\\\\vTqUbQu@bq@bUjDtLtJgFjp@|s@ds@~Upb@vf@bXfoBmXnp@}o@|w@yeBbXk_@ie@w@lQ~Vz^tBbU|jAqj@`m@|BrtAs~@`NlHx]nUrZ|fBkW~XsOfk@j_@od@zNSlu@vu@nAnVaWsDt[dZp@nNwTgC||@rx@EjYoo@bRtz@sChe@`Uro@`EjWbM|yAhwAlq@fbACv`AqIdMk|@hBsPyQkTYiLkNcf@aO{`@sAkh@jMcZaIrXhTbh@eL`c@fCpq@|ZvTl[aO~F}n@eVuSx@dt@r]nqA`FnDlW{W|\\\\un@pEoeA}L{RjDba@dLwXhE_n@eXgK}u@cLlYpDxTgI`G|l@vg@d`Am@xlAdJfXdLnEtUnRvLqOloAob@{]kKpGxOjQacApJsYqPwOec@mKcAdLvT_dAjO_b@cVuI}c@e^wS~QzNbHdm@n[~Vxf@|Bta@cLrXnMtV`d@z`A`h@j@`f@o`@nMjIdGqQdLge@s~@{TgQe^{Dez@o_@`MhPfiA|d@bo@pw@bDb]oOtAwr@_q@{n@{U}u@aBg]rJwz@yWuCcPgeApOe_@qObIzTwMtK|gBgC|_A|a@xMlZgw@e_@aJrBjj@v[}K`f@daAzGbgAhp@pNdi@d`@zKYj`@bQ|T{CnF}_@pQmoAiBcgAeMan@n@kWoMmp@aHce@~AuE_B~Dgc@kKkEoZaBwGrJeQoT_h@o@ou@}VseAvK?qXg]}X?vKucAoGkg@iRoI|@_GlPmObAwDmEtK{[oJ{OuYhQe[cAaL{SuMO`Pe]gh@`JyzA`C_WfIaW_CxUea@w\\\\dN}LyA{[bS}t@zJcWlYigAlJst@lWsV}\\\\c\\\\^giBhi@cIpz@wZeAtIic@oPkPm]`PyFlWw^dK{r@`l@}QvGsBwIsy@jGqx@l}@uCopAoGvOlE`Xop@`d@o\\\\rAx\\\\rGlAtHqY|q@yc@\\\\qJkIee@nFi[qN_LvBwMiTzBv\\\\}G|Iem@gAq_@yKas@pC{[{MyVv@~~@`Yhr@cDjc@ld@kGnOc[bEoBjF`KlH`g@c[fz@x@dg@}Rb[pEzSzUfLgC~DpGkt@|gAgVlp@iVdRUrNsfAzUqYd]caBxaAem@kAkMye@|MkHbAaZz`@aOdHxHrXc{@pNkBjLyRpDwp@oe@yz@iu@{b@ko@x@`@zd@uLzAyCiJeEna@upAh}AyPqNzAoZuIpBc[sS_PzKda@hMbIfMoE`XsPdFyQyDyLoSaApQrZvPGd[mT`GpXhTmo@eBum@{`@iFio@mMvFeK_H{F`MdLfE|Bb{@bQbRoLfTqG_KkMv\\\\kFoG{JlK_HmK_H|BjTzSkKpLbRlG}EbT_lApXxe@xKkn@dL~UlPdJdXqIjr@tEvGsX|\\\\vtA}\\\\|cAed@jOq@cY`VeqAh`@uFjI~F~Lnf@z@lr@wPrXmTsWjZ{fBbu@sg@`c@g_@pE?el@sXoVxKyGwe@uO^xRvd@bk@aBpPws@uE}x@aXs]g^i^uD`j@nXu@fI_[rF_lAca@qLtFx~@t^vnA|BfuBthApGdRup@vz@yQyBgj@st@wKip@eD~]{\\\\~[shAnK_FjT`MmA|P`PsItE{BwKsKnPqy@|QcaApt@piAyl@trAgQ~rB|BmbBvo@gcAxeAcr@pS_d@j`@wRhl@aaDp}@_w@pEacA`w@swAp@khAgc@g@c]wY{VhE_Snq@o[dEslAbfAy[yTof@yUkBomBr]atCbHq~A{I\"],[\"aj~D}dzz@f@nWuRx@]eR\"],[\"_nlE}o}z@tLfCxHfQwSz@sGoZ\"],[\"aluEmma{@cNuLtU|E\"],[\"aceFirnz@aJrKzLcU\"],[\"wmxFa{uz@wK^qG}IxRoH\"],[\"qgcEyy~z@PrL~W_HjB|JpYxN`Ppg@nu@l[wUdIyo@mEyy@}aAiDkK\"],[\"wtdEcu|z@yOzG_[sCzKme@jPfCnOdSnn@vH\"],[\"a~kEuiwz@nHpDfBaM|QoJtEbOdL{GhEpLiJ~KaKeHc]zC\"],[\"}usEqub{@jRi]`P{Et[hOeC|PqOyQcAnP\"],[\"crsEo|vz@qWoLfB{Wl\\\\`NbPpZ\"],[\"etrEkh~z@z_@kDr@aKzN|FrL}F~Xb[oHpJmS]kBsLuLrLef@gCu^bYqIhKnGrCiT|BkBrJpY~[yBhMmc@s`@kRaEYkRtJcT`w@iO\"],[\"}iuEikc{@lPxKaPhRye@eVy^qEta@mKjo@|@\"],[\"_uzE_m~z@fCwOtOda@ca@`PgCke@\"],[\"{meF}n~z@~LwPx[eIpOdMbyBnI~XbMiT~OnPhCvCnN~FgJtAdZmN~E}GkTg\\\\nFoJ_OkUpEgUuV_YfC\"],[\"gpeFqoc{@`[eLrtArCvXxK`_@gCca@tn@tIrO{VLH~HmPy@wc@nWqIiOyY~NaMwS}HlY\"],[\"epcFsczz@~i@kTgl@to@yFkIcWrByBrH}p@pCoMyGf]?jX_Wh\\\\qBoBeN\"],[\"gpgFgorz@hJyGj^bCiCbLmM`D\"],[\"irfF{_~z@o^_FhOyMdQrH\"],[\"uahFkh~z@hDpLyPzJ_HmDw@uN\"],[\"svhFezd{@tJya@fHRl@nt@hUlb@bBtVwYlJ_a@aFaPdRk{@Vr`Aoq@zByW\"],[\"_`mFg}zz@cFcUld@qBwO~KfA~Wk[hVea@gCePmO~AiUvNwH\"],[\"iopFyizz@vTxIxKlVrXjEkVdJgA~UyT{NoG`BxKlPuPc@wUso@bUvFnDwUcRkA}V}QvZ{PhMnI\"],[\"w`sFkbc{@|k@?dJrJ_KhLav@}N\"],[\"wmtF{_~z@xXiU~LjCjD~LqL|Q}UeA\"],[\"eztFgruz@mC}OvTmF`MlF\"],[\"ywtFmdb{@tmApElqAdaAqF|\\\\qeAiT}ZxKwM{Isp@|Qov@aGf]ew@d_@rCbc@cPmm@{N\"],[\"}p~Fyy~z@eCgM|IeJxZnT|_AuFrFtFglCle@in@gC`ImL\"],[\"aumGmvwz@hn@zGqj@S\"],[\"wdsGoc_x@~f@VhFnKuv@uH\"],[\"_faHkrdx@ca@k]vYnK\"],[\"o_oHgorz@le@?av@lG\"],[\"odvHob{x@ba@oGnq@tVsHfp@miAer@eHiI\"],[\"uy{Hyzwx@f^kBl{@tVvj@nd@lWfx@zMGj_Ahn@iSaHc|@xTqRsCic@yz@ke@{Si~@_y@{MoR\"],[\"qr_Ik`qz@jwBfJfM|MeEbRwZ~Bo\\\\~^af@_Osl@xQiX_B}Sae@bPst@hVuA\"],[\"_enJ{}ex@vZoGdh@|O|HfWmBz\\\\_dBd{AiTpGiCey@wP{ZdV}XPmp@\"],[\"qfzJujjx@t`@`B|Zj]tJjPcDrO`QtBj@z`@kb@``@o`@fNgSeB_Pje@m[`Pei@_\\\\|@cU`t@g{@yCsd@\"],[\"qzaKwgax@le@gCug@pk@{wAtVjH{W|^cb@\"],[\"}ifKouvw@|pAu^ro@kEgp@xMabBjx@gKs@zGqT\"]]},\n" +
+	"{\"countryCode\":\"MN\",\"polygons\":[[\"qbl]u~efAvrQ`sK|j@kEdSjKdsCjmCjVrGzgCeMxRxDddArqDtiCme@p~BclDpLse@wNoc@c|@sdA_b@wbBpO{~C}WmhEhh@yyCp`BsyC~@iZwp@in@_b@uw@c`CytCiFe]hCoaB}QabCxQ}{@lDowBnMmj@fbCukCbz@qdExrAo^b_@cmBvGyCn\\\\lU|CgWb|@a`Bnc@u]vF}e@hp@keAtg@w`@j~@aIlr@kr@pFqZ`OjH`RmLzv@jKtT}Md[lTzL|V{Ar_@lYdYpLrd@mNjb@iFjkAdIfrBsb@|mD{k@rj@zOzn@_[lMoCbLpC|Qzh@~e@`H`TeQddAnKzb@@np@yUxtAMph@vZn}@jEdt@xX~o@bKp`Apu@j_AtArlAiKb]ki@rb@rNhc@lCtgAf\\\\uJp]`Z~hAxRuDflFuKpxAfc@zx@rV`_B`g@~YvjA|gBjzB~xA`PxBno@yZzy@`r@jSzm@P~x@hiCdpEfd@hmEDzbCzKbtBk`@frC`Y~hBlr@rRp~@bs@bjBngDti@nd@pTdl@zJxnApvAnfCxPhi@oBfl@{N~c@yMdeFik@v}Eqc@pdBmj@xe@ml@ldA[v}DuIh|AvMvRjLbs@vl@n`AtiD|sBrcAfGrsAhfAzd@zOhe@mGdZ_q@pb@eGbj@aXvw@uw@lnAu~Bf~A}`Abw@xEpQvu@hAnx@lcBhsBzO|fBhVff@rkAfcFd`DhvDreA|k@bbAfbB|i@tYvt@veDrZxp@~Dd{Azp@jxCh}@zpBrMxoCoLpuDt]veB}UvaDp@lfCqL~wAxgB~oZdvD~pPx`C~}HlYhaBb{AhsBfErXuc@|q@kFbqFwdCpArdAzxNixCdaKqoBphSqMnd@yj@xu@oqBl}A{`@b|@yLleAsCjfAlH~vBuzA`nVdeA`rImjCvpk@ff@diO_sBlWsqEttGcdB~XoiCtvAktCp_Acv@t`@kLrNwVpvBewAiAmkA{m@|Np`Fiv@rrE{x@xcAwi@vaBwCfx@mo@be@ok@vz@|D`lAoL~pAa}BjdC}n@|tBkAl`AsQvp@bAn{@iO`p@b@zhCyS|{@~XxgFwLpuBzDph@mg@|lBpHnlFgFroA{m@tz@|R~k@uHjhAaNng@qZx]iKzo@t@`r@|Pt~@aQvHaWsDoWvj@coA`Zal@z~@}`Did@uzBgaC{Y{h@}PcImx@`Cca@l\\\\qrAz_@eYwWajCydAsPf_@{bAiKgVvEml@tp@a_@_Feq@b_@oVpb@_Ert@gM|Q{vDbwCij@qJcOjWsv@jCk[fd@{s@z`@gU{Ic_@jpBy[hq@ca@dR}WsFgQjKcAd|@hWtFnKh[?buAu`@lPoLpi@qZlPiJrZqc@`FqExQzDvgAwIlk@rc@|cAqAxhBmDdQseAnoA?rdAwd@b{@{Dzl@sUja@qn@vLgV{Gai@|j@]vt@ym@zdAcNhj@Inj@w]t_A{Dtp@iMnReNrDem@ceAuTwA}\\\\ls@w@li@mQ`u@e_@rNoQjc@yIj@aTgLw\\\\igAqUFkNl^_l@oGug@~J}@yvAs~@_tBeOwAod@pSqu@ad@}Ro~BxD_cBoOco@t[{p@zEklBuVxHm`@kA}G{Qtt@_u@i\\\\{rBwYia@c`@jVuMwDyFcQp`@{kAQiSue@qj@wn@_bDoHkEeJ|EyT|m@iRwIkMtP_e@kDkNkZsHe{BgNk_AaOwOuo@eNiZo}Bud@sjAsFuq@iPk^_@maCsL}Pu`@wGaQ{eAi`AojBQshAgXmpAfBg`Bsm@cd@gO{}@yo@ko@oZm{@mM{|AhJw`DiRos@qk@g_@q_@qCsN_d@bJkWdg@cZ`ZqdApa@wb@nGwVmRkt@_h@uNwIkUyAcmAbHkXds@iFlk@wVhNmy@sE{dElNwfA~OobMt~A_j@r_A_Bjo@{LvViVxSm}@nY{Ijw@_z@kOyeEdL{c@je@y\\\\dJa]k@}lDbe@gzAg^as@sL{tAg`@mh@iDcXfBmW~j@ya@xBwMud@qcA~]goBzd@edA}ScaBjg@cr@eb@_hA|OafDfm@av@zJcf@dZuS`NicAaDsq@yeA}nC_RyCiWdH}ZquBzV_m@c^un@e^swAqX_c@mhCaaBahAkAcd@}Rqc@|A_SjYwK`gAo_@|{@u}A`z@mLcAwWwYaFnBsy@`|AsQ~OcRh@qt@yf@coAyc@u_AzBwuAahAcPyk@|DccAyXkGyh@nBisA_`A_f@arDgW_g@cdAuy@cf@wMc\\\\}[ci@uDuLgR~Hqn@|`@cS`g@ysCnd@}w@tYqpCxEq~A|pAcaCbJav@~AqzHl{ByyJzF{lAlg@{bAyNcmB`OihAI_q@bVi|@eAaq@tQel@vFy}@fi@eiAxh@hc@ha@eHzu@dA|hA_s@tX`HdOeNxr@xU`RsGt^uw@jQuLzi@l`@hQtArb@qRnJmNzKeyAfLwXfv@}l@~HoRhNoyAzk@_fByBs}BpFyk@b}@}m@bBedB|c@udBl@uh@ia@ghA`[{}CmCkr@qLwn@yc@ik@wk@otAqG{~BiTme@uAqhAgV_eAEs~Bmi@isAqJ}u@ba@gaEkCmvAdQo_CvPw`@rVsI`Csp@tTku@eU}fCPmfCdUkqAlz@{rBlkAcs@pi@msApDmSaG}p@dTad@rAgcFnPcp@nEsuAnUyBx[bMdaAcOdn@vP|Ggv@tVpEvHoE|\\\\qw@rLkpA`{@q|@bcA_~BkLgtF`La_AwJwnAbDymA`UylAu@uW~UyMfGcYsEucCfWwv@`h@ibFmHiu@mg@i_An\\\\aq@x]e~AvC{nAqj@kqCss@ckBoRisAeYsg@jL{vAe`@upBuHweFkkA}sGzCkdAzTghAiEgn@um@kiBmK}eAcx@uOsfAoz@egCcwFuc@{jBi@}{@{p@alAqMkr@gl@oyA}K{t@xYusB{IusBnHyy@lx@gwCvh@gh@baAwzAjq@_nBq@_vBzHiuAkjAyqEsHoeBnx@s~E\"]]},\n" +
+	"{\"countryCode\":\"MO\",\"polygons\":[[\"{zoLc}hdAj@uH`KtGjIaPdKlXo\\\\bH\"],[\"u|pLiohdAbH{K~PdScToEeBxE\"]]},\n" +
+	"{\"countryCode\":\"MP\",\"polygons\":[[\"k~sGq`swAkLmm@jLqEdk@hq@yJtw@\"],[\"akeHqt{wAba@?vTyKbi@fY_G`K{v@nPy]kSqHwL\"],[\"aeiHml~wA`BkUzQjRrl@`G_@bM`NnJlXsGaCzh@}}@}FcXsV\"],[\"ut~Hm{|wA`Ma@rBhYqNpXuIeO\"],[\"o~aJ}a~wAtCu`@rWsE|h@lo@jCbWqM~BmGoO\"],[\"uulJo}{wAiMhOyWx@wLcJ\\\\uVzc@gL|K|H\"],[\"eudIgm~wAaKpKiBoIjCsJ\"],[\"srpIkv_xAcK|JsAqHxCsI\"],[\"ibvI}u_xAsExLuPg@pC_QtMoA\"],[\"_z~Jo}vwAqRtMGkP\"],[\"ayeKu|swAqK~M{KqFnKmCg@yO\"],[\"gvoKikmwAgMnGsH_JdJ_G\"]]},\n" +
+	"{\"countryCode\":\"MQ\",\"polygons\":[[\"wmyGnubd@|\\\\vTvBzNiKbIyJyOiQjDlHnQyFtLdCn|Ae^tUg]ye@hKiOiKqL{\\\\xKhC|m@ce@ni@y[vL}RyAif@t]eWeCuQi]I}T|g@orAle@sXcPgr@rXvTwGhKxXNzBaP|G`PxLx@iDwI~G}SdCxKnH}BpY{_@pN_AsCeGf]qCnGnG\"]]},\n" +
+	"{\"countryCode\":\"MR\",\"polygons\":[[\"k|sOnqhDxjc@_z|@rjG_qLRdra@pkjBoqKz}x@sjE|R{IrcBsuChMgFb~NpaB[z}iAwbBmKgS|@mE`NncAnz@j{AkPbEbzCfd@deBiEjgAhIrgAWlXgW`q@t@vT}Tb}@nAvzFnx@jLj`@fq@nrB~u@qgBjpA_pB~|Co}ArdAeRj]sEjh@zSsD`j@d}@fHfQgHzr@d[l@xc@|Vh`Dvo@hpAnH?qPxK{Jx~@tInOqEpc@tb@dJpc@va@ta@Njc@nS|Ki^xi@|IlbAkp@xsAke@tg@ucAhVct@fuAdEbi@{EtO{Yff@ki@rC{Cnj@aQvRmKsANw^uGkB}q@zw@{xAh[cBtR`P|YuB`[mr@~CiLdJ{Rxi@~Afd@ySxEca@wKmc@bc@i`@yByc@nUk]Ama@t`@sh@vJc^b_@fCpe@md@oIx]`rBuQr^i`@sDKvJlc@|g@rEpXul@~\\\\wUdq@mbApGef@nz@}rAfwAaGt`@{SdEgBxb@c[BiQlk@tP`u@aDve@jFb`@uI|\\\\hBh_Dw^re@vHjS~YvQgSfYG|PfMtEnYuN|I|RdIbbAoBtz@~M`u@}S|]`d@~d@P`t@|W`f@cVlkCnKfe@z@xz@cd@`p@`Slf@I~o@bi@de@`iCp^lXzl@zu@fF~z@dVneBrFnVbMkhBkHctDlF}g@eEccBye@k}A_DgoCk~@gi@eHo|@sg@qiC}`AooDoy@okAwQcvDoNgpBzEcuEjTkyAbf@yeBrX}^sCquAjWi{Azo@}w@lt@sVx|@uw@nVgRdRwHqGhHic@yC}a@sOk]}eAmm@kGRQjLtp@rs@qr@sXpCzGnb@ne@hj@nGLvNg_@h@cYgUuVuDyQuVihCg}AkTzGkT{GpGdL}Hx@eWcEwEpJlXlWmIxCefAww@}`@qAso@tYo@mLmVmPac@{Dmb@`UcDtQgSfPch@pPeLlNwM}CgoA~^{RlSyYpBxCtIeGxK{Nqc@A`l@y^xKcGnPvvAQpVtGot@hp@mRd_@wKqGmWpFqSlWwgBpg@nGnGkG`Hk]jGgkBb~@~nAl|@bJuCeB_Fxt@nC`Qb[x]mLpu@bQ_uAxKksCil@eqB{UoDuelAiuUxo@ywBpe@}f@pEai@gGwzButA_}CkvFi_@}dDquAcpEunp@oAeBw~_A\"],[\"m~aKb{~Hia@}R|FuDbsA|QziAzg@as@tRgg@qVkb@aDyKaP\"],[\"ii`Kpw}HtBaKtkA`{@\"],[\"ek`Kfy`Ir`@jCkP|F\"],[\"esqK`n`IrCaGrUzCmMnK\"]]},\n" +
+	"{\"countryCode\":\"MS\",\"polygons\":[[\"e`fIby|d@`f@n@iCdj@iW~Eix@qPzBaPrJzA\"]]},\n" +
+	"{\"countryCode\":\"MT\",\"polygons\":[[\"g~{Toc{GvZ}JzHhBzChQ~KbBaMj_As_@fp@w{@xMyKqa@xKlP|Fyz@|Ref@hPyB\"],[\"gr_UkmuGvXoo@`Ljm@eL|m@{QX\"]]},\n" +
+	"{\"countryCode\":\"MU\",\"polygons\":[[\"f~iKiygb@peAzGrPr_@vMtIf\\\\}Ej[|^nVjhBaKd`ArDfLef@zj@kGvAeA}`@aGwBg_BgCmd@oQkd@qw@ucAkVe]wi@xI_s@fw@eQnHoUdWD\"],[\"fwhEs~na@yBeFrr@_T\"],[\"ri_Kswve@pAaJrXhNtZj_AgRhTuO{EsNu[\"]]},\n" +
+	"{\"countryCode\":\"MV\",\"polygons\":[[\"biL}}sk@fC{DCbCc@l@\"],[\"nbLqwtk@lAx@dAf@t@t@\\\\dBoBOu@{@\"],[\"byKuesk@hEu@_@|@k@Ju@K\"],[\"~eK_nuk@vI|FkEHyD_AmAaB\"],[\"znJgruk@rJj@iQu@\"],[\"fpD}gyk@tD}C~Ai@fCJqB`CcBv@_BJ\"],[\"omCusrk@|@cAh@gA~@c@xBX@@{@nAo@TQFI@eAJ\"],[\"_uCsztk@l@iD?@nB|AAb@eAJ\"],[\"ugD_oqk@B?jEgAB?_@tAWDUF]G]IE?\"],[\"gqD_exk@?@fB|DwB]Qs@Nu@\"],[\"w}Dympk@b@WJk@JKDGl@h@@?s@~A]B\"],[\"kqF{xzk@@?jC~A@?wAj@WH_@MF_A\"],[\"a{F}}ok@BAnDu@DAw@bAo@Jk@O\"],[\"euHasok@rDDqAfAk@?\"],[\"o|Hokzk@B?nDs@a@rAe@Jo@W\"],[\"cpKmjxk@t@Ll@?ZH?@Cb@aAV[MIi@\"],[\"}{Meaxk@t@Ll@?\\\\JCPCPcAVYS?ACQEO\"],[\"ypOeuwk@@?f@JD@h@?NBP@FNFP@@eAX_@KQe@\"],[\"cqcAsnmk@BCj@UZg@ZGb@nAc@z@?@[K[c@\"],[\"aucAawlk@@A\\\\SLG\\\\o@VWRn@?fBUFA?i@e@\"],[\"}jdAgkok@jEjI?BqCqA_AsAEwA\"],[\"wteAusok@j@WPYJOZGb@nAc@|@[I]a@\"],[\"kxgA{wjk@B?dA[l@q@j@QjAv@Yh@a@v@y@?u@e@\"],[\"y~kAibzk@@BhClCZp@aAa@a@m@\"],[\"aemAm~jk@n@IRWLONKFI@@\\\\f@a@dBA@UDC?[i@\"],[\"wcoAquzk@jEC@?aAhAe@h@m@BWy@\"],[\"eirAqepk@@A`@e@Ne@PWf@C?`AWRg@@\"],[\"uruAgo~k@n@d@N`A@B\"],[\"c~uAgo~k@@BhAt@o@A\"],[\"okvAmcxk@tDKa@bAc@?A?m@[\"],[\"{oyAcuxk@B?r@?j@I^BRl@o@b@]M]a@\"],[\"cg{Aqjzk@v@@l@K^DLj@IHa@X_@K[a@\"],[\"ur~Aakpk@@?dH~DBBeEKoAs@OcA\"],[\"st~Amdok@IkB@BTb@K~@\"],[\"ab`Bqoyk@ZvE}Aq@Ek@d@u@\"],[\"iiaBqaok@`AdABBi@S\"],[\"yjaByjok@B?h@HD@FZFX@@\"],[\"c`cB_tqk@B?v@XFj@\"],[\"igcBuvqk@@Av@Ol@[Z?DjAq@l@[OSc@\"],[\"{xcBaqrk@x@DH|@@B\"],[\"c`dB}xrk@@ETu@\\\\gAADK|@\"],[\"uheBq`nk@@@l@NPl@BD\"],[\"{leBkjsk@h@?XbA\"],[\"ineBkpzk@@@`@n@@@SbA\"],[\"udfB_k|k@lCtERhBi@rCAB}@uBe@yBOuB\"],[\"snfBo~rk@BB~@bA]?\"],[\"ktfB{}yk@@@NpB@B]y@BY\"],[\"_dgBoa}k@?B`@j@OlA\"],[\"ojgBel}k@?BR~B?Ba@eA\"],[\"opgBuzok@t@LNv@\"],[\"kvgBmpxk@BAn@Ud@e@^KJb@_@~@[Ba@U\"],[\"czgBabwk@Z_@?ACy@?A?CBe@r@VX~D?@y@\\\\O]Ew@\"],[\"i_iBgswk@PtB@Di@y@\"],[\"cniB{g{k@\\\\p@IfA\"],[\"muiB{zwk@D?bAAZj@BB\"],[\"gxiBmtok@@@d@Zf@H\\\\NJh@oAVWWAo@\"],[\"ahjB}aqk@f@^f@HZNLd@?@cATM@WU?m@\"],[\"wgkB_yyk@d@|@Qv@AB\"],[\"yqnBo{pk@j@b@n@J^P@p@cBRW[?A@IDg@\"],[\"s_{Bkxok@@?f@]@ERc@DGLIh@NQzA]Li@W\"],[\"q_}B{tnk@j@_@Rg@LMDE@?f@PQxAEBWLi@W\"],[\"xuJ}trk@~XgL{UzMuJhAgCsG\"],[\"m~a@c|wk@@Cn@sExBhBGj@?@kAV\"],[\"}gb@crxk@BAvAQhAe@n@RB\\\\LzBoAGA?IGi@]i@k@\"],[\"wtb@{ryk@H{EtBhCAr@_AL\"],[\"u~b@y{zk@rBpCtDfF@?GFEDGFGDy@m@w@k@eAcBuAsE\"],[\"yoc@ig{k@FB`D`A\\\\JcAPk@QMMWY\"],[\"w}c@iuuk@hA~CkBEM_@Nc@HQ\"],[\"wvd@uq{k@bLtAbE|@lDxAHDkSqD\"],[\"gre@yo{k@tEDB?w@h@m@C\"],[\"s{e@g~vk@j@s@Vw@\\\\MbA`Bg@z@a@Gg@Y\"],[\"sqf@cl{k@vAVEr@?@i@e@\"],[\"suf@{ixk@vA?a@N\"],[\"qtg@is{k@dEtAB?eAPk@Oc@e@\"],[\"ueh@wz{k@DeJhHxM\"],[\"_ii@isqk@`@l@@^@\\\\AA\"],[\"sui@}xrk@@?h@Rl@?TJSv@s@JQQCe@\"],[\"y}i@e`sk@f@[tBoA?tBa@Ty@S\"],[\"ylk@wwok@p@f@?@K~@\"],[\"uml@yiok@bFQeAxAq@@m@e@\"],[\"ozl@_owk@J@~@Db@XDB\"],[\"grn@muwk@xFkCvEoAn@QlGO`GvAB?qZlD\"],[\"c}o@w|vk@hFG@?W\\\\_@d@A?o@Dw@a@\"],[\"yzp@}rsk@x@`@?t@?@\"],[\"kks@qynk@Vq@Bu@TUnAd@IbA[Hk@A\"],[\"{rs@khnk@pEU@?}@|Bm@Fk@w@\"],[\"qpt@}eqk@cAeE@?fCrAJr@oAz@\"],[\"mgu@}twk@r@Nh@O`@?Vf@?@}Ax@c@OEm@\"],[\"alu@qqxk@lElDyBYw@q@S}@\"],[\"}nw@{n{k@@CXYLQp@d@K?\"],[\"}uw@gywk@n@Kb@YXINf@@@m@bA[?Uc@\"],[\"ytx@i}pk@tB_Ch@xAGHMP{@?A?\"],[\"uux@sx{k@@?hDj@o@Le@I\"],[\"_zx@ef|k@@?lEfDyBSy@w@Sy@\"],[\"w~x@yfnk@@?bDn@t@r@u@B\"],[\"kf{@oznk@d@WFYBMPWz@\\\\JzA[Ho@Y\"],[\"wt{@cw|k@jAFr@rA\"],[\"{v{@ejpk@@Af@W\\\\i@^Il@fA]|@]Eg@]\"],[\"mr}@krqk@f@YJk@NW~@b@FvAYHq@W\"],[\"cp~@ykqk@@Af@[^g@\\\\Ij@hAADWt@]Ei@]\"],[\"iu_Ayomk@f@WHk@FGHK|@^FxA?BWBq@W\"],[\"ws`Awzzk@@ANQV[BAl@CBA\"],[\"}kbAgk_l@@AzAkB?AMf@Ut@G@\"],[\"gkcAetzk@zAs@@AUn@A@\"],[\"s|gAgzok@j@[\\\\g@Z?b@jAa@z@[G]c@\"],[\"qthAcvok@l@YR]FIZEb@pACF_@p@[I[c@\"],[\"a}iAkuok@j@WLOPWZEb@nAc@x@[I_@a@\"],[\"ccjAiiyk@`@h@j@b@RTg@N}@SK[Jc@\"],[\"kqkAemyk@@?`@Hj@xABD\"],[\"m`lAuowk@@Br@bAU?\"],[\"_moAc`yk@B@b@TLl@?@\"],[\"{zqAkm{k@hd@~CfL`PaMxp@a@oq@\"],[\"mirAwuok@jAmG?@RrAQ~@\"],[\"}xsAml|k@@Bf@~@G|@?D\"],[\"}etAev|k@B?r@Bj@M^BPl@q@b@YI[a@\"],[\"sjtAwf}k@B@l@Np@G@?\\\\FCXIf@?BkAd@SYAo@\"],[\"}xtAoy}k@v@Bj@M^BRh@o@d@]K]_@\"],[\"}tuAc}ok@bIPOnCCb@iDhA\"],[\"yfyA_r{k@B?r@@j@I^@Pl@OJ_@V[M[]\"],[\"qyhBoa}k@tCaB`Dk@fDNbDbAB?_P|A\"],[\"ouiBo{pk@@@`@XjA^Z^_Bf@A?[S?w@\"],[\"yhjBoonk@h@\\\\d@L^LJd@sA\\\\UU?o@\"],[\"cvlB}cqk@@@f@`@d@F\\\\NLd@?BqATWS?q@\"],[\"c{mBeaqk@f@\\\\f@L\\\\JLh@sAVUWCm@\"],[\"aaoBomxk@PFzLhFA@ELA@ABiC{@\"],[\"uuoBwopk@dBRz@z@@?\"],[\"{dpB}fxk@D?z@Gn@_@l@C@?v@jA_AlAk@Qk@q@\"],[\"oppBkipk@@?pD\\\\B?s@t@[Zi@?Ww@\"],[\"oaqBeqyk@@@bE~HBFwCoB{@qACcA\"],[\"epqBgapk@DApGw@_AjA_AN\"],[\"smrBcdyk@B?r@H@?l@?b@?Tj@gA|@]QUq@\"],[\"uqsBimok@rF\\\\_CvA{@K\"],[\"mrsBskxk@xFmBsAjDu@j@\"],[\"kysBicok@@@`@b@j@FTHO\\\\O^sA@KWTk@\"],[\"cyuButuk@@?jA`@EdA\"],[\"ojvBquuk@?@~@~ADFq@i@\"],[\"qwvByauk@@@t@ZLjA\"],[\"agwB}`vk@@@l@Zh@L\\\\NBf@@B{@EYWM_@\"],[\"upwB}`uk@?BdA`Bo@c@\"],[\"k{wBgavk@tEt@qAt@k@Kc@o@\"],[\"_pxBkmqk@BB~@`BB@k@[\"],[\"yzxBaouk@~@Ip@]n@Ct@hA_AlAk@Ok@u@\"],[\"ixyBghsk@x@j@J|@?B\"],[\"g}yBo~tk@@?lBh@p@lADFII\"],[\"{ezBalrk@@?x@d@Hx@?F\"],[\"uqzBcstk@BAr@WHSHU\\\\]lAPYtBE@a@Nu@c@\"],[\"ytzByesk@BD|@zADDi@_@\"],[\"g`{Bamtk@`@n@b@XR\\\\Od@u@OM_@Bk@\"],[\"sk{Bydqk@j@YPi@TSh@LQ`B_@Jg@W\"],[\"gf|Byvjk@?@d@f@l@^PXe@T_AUM_@L_@\"],[\"cx|Bg{nk@j@a@Te@HIHKh@PSzAC@YNg@U\"],[\"yx|Bovqk@bBr@n@x@BB\"],[\"kb_Ci`pk@\\\\h@f@RVRSj@}ASM]?AVc@\"],[\"if_Cohok@j@]Rg@RWj@RSzA_@Je@S\"],[\"}p_Cwjqk@@@p@Fj@KZEPj@YNu@b@_@IKk@\"],[\"ui`C_tqk@^h@d@VVNSl@}ASM_@?ATe@\"],[\"ofaCmcrk@jCxCuBI]c@Ds@\"],[\"u`bCgzrk@nAp@fAVp@d@XvA@@yCOw@s@\"],[\"y_cC{nqk@@?p@HDA`@K^APj@YNu@d@_@QIe@\"],[\"obcCaeok@^f@f@TVTQd@A@}ASM_@Te@\"],[\"qpcCu{sk@K}AZY@?p@?v@SlRpGpG~ClEbGB@cIuA{OiI\"],[\"aldCqitk@n@wHhBzC`@pBm@z@\"],[\"}rdCkupk@?@^f@f@XVPQf@?@_BOKc@Re@\"],[\"ctdCgrsk@\\\\h@f@RVRSl@}ASK_@Tg@\"],[\"azdCaipk@r@Hj@K@?XANh@?@mAr@_@OKi@\"],[\"sydCkurk@@@\\\\h@d@PTTGVER_BUM_@Re@@?\"],[\"}~dC}apk@\\\\f@d@XVNMb@CD?B{AQQe@Vc@\"],[\"mueCkzrk@t@Hh@KZC@ANl@mAr@a@KIk@\"],[\"ccfCw}tk@@?tGPlGtAxB`BaDx@uDQ_E{@aCuA\"],[\"k{fCwiok@F?~@Eh@`@\"],[\"adgCattk@pKjABN?H?BABAFGLaEL}BMaAk@\"],[\"kpgCmtnk@`A?j@X@@\"],[\"ighCikpk@B?lGc@cBrAgA@A?\"],[\"iyhCwxok@@?nB\\\\}@L\"],[\"}oiCqaok@@?zDRsBlBg@CK}@\"],[\"_yiCkonk@\\\\Mf@?h@f@\"]]},\n" +
+	"{\"countryCode\":\"MW\",\"polygons\":[[\"n`aF{{iT|AlxEl_BtFl|@jXdiEb_Cd[~A|oHyvAxeHga@bgBnAdgBqz@rMajCvtBciCnmGggFpmA}h@hqFwiEllCaIjEsX`qD`jAjxCeb@teKra@~j@f|@pPxaBbR~Gu\\\\fp@tIfe@de@nj@jj@z[|[hCdW}GnVdPrn@pE`i@d{@|u@oYjIm]rc@oa@vt@iC|f@uNxKrNzc@@xGxGxVcZpRxHrw@}BtNdGvBtb@aA`~@{Lli@aO}@c]jSoR}CwZcj@cPiFe}@fCaI`Mu@`x@qa@fb@qJrY}TnFaPp]s^tNs|@ru@{Wdo@iW~Ikc@|e@ex@n^wCdp@aKdKsu@xXgnAaKebAr}@sTtd@kPvEyz@uG{Y{[sKi]qs@{e@uk@mHwy@rDwi@}j@og@gPw^wb@ytChIul@eUkh@tSgkAdD_{@bVed@oGug@~Iyo@`n@wz@~`@cHnPp_@`dBd@nl@hG`ShXtOuG`sAd_@dfAw@rPeXr`@dQtUzq@lF\\\\bPmw@r]upAz`Bc`B`t@wi@xf@a{@nEi\\\\lo@qv@~e@vW|PtIj]hg@~ZtAnJ{ZxPke@cBmJ`Xs[tFkd@b^cZldA{OnBkW_b@eg@dZwJfz@{RjFyPwGnBm[g[mw@ib@wByPoa@_WcJa|As[m`@ud@ot@dSedAwHqv@aSgm@rh@yl@tBgaBis@iQqSjLwMeLu]tUyYqXq`@o\\\\qPNqz@yp@}}@mZ{Gm`@se@uK\\\\wI`i@tL`m@qF|ToqA|]gb@p\\\\yh@k@ukBcc@s_@pHmm@kIsnBvIsQrs@c^gL{{@dA{G{D|K}Nm`@eDiI{N}MJweB{x@kpAnu@yjArMkElXkKjC_JoDsDe\\\\cWkRwIoh@nCi]iW{h@_c@aPcsAezAue@nDeLtYs}Abw@sdAGy`@~_@yeAfmB}^uBefAwa@ci@xLgPxa@}n@lk@cQpFcZaEw_@hWsGdo@bm@dJ_DxLiVbMdFn]_PrFe}@yIkLxQKhT}k@hCcIsHqE_WtaAwoAlMcj@}ImQYe|@pb@um@za@gQjIwTx@}XmRkp@`J}w@yOos@lt@upAf]c\\\\{b@{Yeh@_E}ZrJil@wh@|S}h@jzBeaCvEsQf^sGrmBw}An{@e`@j_@hF~xBae@ziAxK|k@mPfc@lF~VaKhB_NhRe@~^aTxYvFnZcP|IlJpxA~Ej_Ax_@vt@yKxr@ui@~IeS`_@cHvM_Q~JxKd]{PgGyTdZyt@|kAim@\"],[\"fkiFyrdTtDsZfRaN|i@tQtHdZeCnK}XdG\"],[\"r}hF_`bTaD_VnIeQbRqGba@xQee@zh@\"]]},\n" +
+	"{\"countryCode\":\"MX\",\"polygons\":[[\"sczN`ghz@vtArPx`Am@hoEj_BrbCfvAt}KfwBj|Hxg@p}BhDxMbOaClk@bKof@dhA`@ykAaPdU}EnjH|[~rDkOvkAdFem@@xfApXzi@bZD`WnXoL`_AlUfDaLoHqKsX`AcO{Jh`@sAbr@lKlxBqXfu@wTnaBqGpaDy|AreA__AziAsfBhYiOjxAxRtn@l^ri@vKweBn`@kW_`@rA}Pqg@yKy|@|gAwaBjuAueA`TsI|OcjBnz@`yAke@tlAaFjvBw^f}Awz@zDqRoIyQtF_Fbq@rCrvAyi@pJsMxsByh@~wC_wAt^cKxKxKnx@{\\\\vqAqgAzg@yy@fb@kQxqCyqCtsDmhCjnA{^`_Ce[da@yZ|b@hApx@_QbbAcrAuE}NjaBak@rKss@piAcUtb@{Vh]_d@zSgx@jT?u\\\\zk@oHha@gT`UxBpZrWyn@pg@{m@Etj@fUyN_Pio@zS{nApXue@oGmIem@`cBwYvAr]{i@hQkq@~Ggv@j@u}D`y@uuAb]_Pp_@isCt`Ae]|jA}cAtz@s\\\\n[gfA{f@onEq|@{iD~Q|M~JmC}Hw{@cKwLyRbAmI}M}Gea@pJ}B|@wKiFgg@qZkOgMbEbeArhDwJcDqT_c@wn@onCqG{vFjT`PnReClD}HqKgWh]fCkTqGgJoOqUv]eVeoDek@g{@ua@oYpWsOdlAgLoaAgC{p@~SyDyaA{[}qBaRsvEnE_eAjj@yu@rMzBxAbh@gUbJdJdO[`S|J}IsCf]xKuChTfLqGgLdLqXgDeUdYyh@ib@ln@wRxBnLoZiDeNvKbGvTsX?xKjTsi@jc@hTxZy[aGwLq]tKpGsXmW~SuHiCpXqgArCyv@eLov@jP_JmJ}EgZbDxKpGfC~XqXwTi]gcAgBe^cb@aZh@o^aVij@}A|QlS~t@}aAwSi[`VbEjg@oYx\\\\s[gTsRoj@wh@em@|t@h_BdL|NvMi@lJ~]eDrXuf@im@ctAogDkk@gr@kn@ayAoz@ip@y^ou@uh@qWm_DaPia@dC{g@cOcQ_KsvAusB_m@oHes@nVylEaOomArHkpBaR_eAeq@qyA_Xx}Azc@vMdKv@hNy_Cgr@aSiPgqAqpCijA}bEoc@_bIaPckAtCwT{z@e_Gen@w{@sXqaB?ap@xKaj@yUm}Ab@ig@kFbi@`Pxr@kY_aA|HsgBth@{~CzEpRcS~nAnZuu@|Eq|AqCkIoIfp@|@_l@fL}gA~JaP?`PfFwDc@us@pQu}AwXi~@oV_OiZdDNne@lTlb@yBrm@yK|Bd[ng@kNxE}W}\\\\fGgy@cf@kcBhLsi@rUk[|mAsp@|Bqw@jf@}In_@bG|eAgD~[cNqBy]~|@d]`Q{BrMnZnaBv\\\\jrCbwB~k@peAba@ba@d{BlpA`EbK|IqBp[d_@~}AnXrs@eAvz@_Tp`An[w_@f@yNqTe_@cAa_@hTnElEfp@qGqGmK~t@bk@eHfNrJzQffBru@k@|FeKgLuPX?rm@pF`Cnk@aE~Q_Wl@sSxe@pGye@gcAfLoa@gLqGtCsXpGdGmCoO_WwHy@bHdLdAqGht@fCer@oa@iCnc@gPnt@rKbVzNb`@vn@nBnUgBkJkU}H|Rrq@dSvVfWbFyKyKhZhQrA{Hpg@jPfLq]e{@}k@oEsk@rXxKiTqa@zx@hl@|k@zMnMeDjeAxb@n}Cza@bkAzm@bcAj@xUvJxj@zAx}Afp@rq@~@g]zGlHvP_|@oLbUlKg]{Bi]xK{EdU{YAua@bxAgVIoV}S_MdC}y@e]uI|Gyp@oFav@zRdl@fi@hl@rM[vHuVcDdK`HhE~]fZr]jKxCwe@wi@ve@vCqN}Mv@qKpHfM|vBrcAxHdbAmH~LlH~[oDnQ~QbWt~@zO~i@``@~\\\\xDxb@vp@pImC`z@dVxYl^vc@xP|Uz^hVjHsbAfnB`Q~r@fQhLfnAbCjA|qb@b|IvD}@fqGrIhFfNoj@zM_D`Ekt@za@uKlEoQvt@oc@bA{ZzgAyk@~Sse@uOiCl@kRfNpGxVc^hRikArJbAbXug@vy@gi@nUqGnQfGgB{Sbf@~GvLqUdNbLiC{v@|QwEaD}ThVwLdFqq@l_@kH@|VzGr@sCoG~FgD`ItBlMbXzKgRkAvKnPgCuCvKnF`FfMqNrPlRb[uWlDvLhJ_GdKbNtImANkJfKvEjDlV`ExnW`lNteHxT}CdeB_pAza@vQvLn^lz@H|g@bVz}AiRzrAxg@[lNgwGtwGidB|vA_LCnMed@t[qItKsOip@jRyI~k@iKnGhKqCm@nIqm@viAwPlHea@|p@}wA|jAuxEf|GajBrrDgC{\\\\nF_JcK_Nag@pZo_@xlBut@zi@nMbr@iFpYas@zqAxBfJh]Q_@pKfF}L~EdMfd@cx@xLa`@qC}O}Sjn@zKckArO}]|GnG~Nk~@x{@}hAsoBtjGwLjmEcRsEq_@}lAqZ}BiVvv@jaA|kAsX|G{PuTmn@`YcOpVtArOh|@d{@?lc@fJsBhGlOxOfExIgg@My{@cToi@nHyBvGfRtFtk@hC_mApEeQlP{DcGpoApPzhBcAns@tJ?hEls@v`@b_@lN|i@~RpNnKoBpP``@~MrDlJduAl[dw@tF~x@fOtMj[hpBdh@~`B`NnDba@ldA{FpxBrVbm@Q|^o^bmAoMpeBi^nt@iNfx@s_@~Wyn@h~BgEtp@`FlM_TtcBeDlpA|GpLyIzw@qnCrxFlKgw@cGoGkTtcAjFlViw@pqEeuC`kCjDhj@lJnEcCn^erA`cH}QrsDgSto@_d@bq@{B|UyB_MgGtXeLuNkH`C[pW|GlDVgHhE|L}g@p[sIdSi`F|oSvBbI_WmAou@|gAeMnEcfA~iDiRmHiOzImR~Vz@jb@wGrAeErVgJrAkHf[cV~@{TbNm_@~l@i|@~g@ys@vnB}Hf\\\\fDzf@`]b[jJd[s~@xpDw]~eDgiAhoD}Btv@um@`zCuh@ntAgbB|n@ya@t`@_Zpo@kVyKaOvG{hCzmDi{@j|By^l}AkRu@yFkQ}SdQvAdPsIe@eAzRbNtFaHzI`NxMgMpFyNr_@i\\\\vhBkRxJmAjRsItElAhh@yj@`@vHlp@mf@teAky@p[sFfRyeA~IiNfQZxTer@z|@scFvjDmsBxSkz@n{@qy@zYoq@}kAgOiyCuj@et@y_@yDeT|]wb@nOcPpRcDpK~Px]iTvrAoLiLaCeVgk@oRue@c_@_Qq[sl@i]jBmWiUuUgkA[kLoIauAzO{g@qMeUwTkm@jSgEjOrGhKohAprBo{Bb~@mmAxr@gx@rNsaCm@{yCpo@iE_I_PzHfCtN}a@zD_]vSmrBz|B_T}ItO|\\\\siCttB{Rrb@u}@ly@Uzc@_d@jBsf@h_@}KgDmp@tZoe@rv@{tAnjAeOzd@ai@rKua@v\\\\cx@rX{rAtrAilCttEo[pOyy@xuAwpAzoCwTlNwEcE`T}KzyAohDkTpa@cEgA|Qi_@cG{B?pGmQ_PckAb_@b@hf@hFtD?g]d^fk@}Yz_@vDhYi^tS}_@zi@`BfPi]lv@rcAmpAq]x~@ak@bu@qr@xMua@zXjQ{q@{Ws@_X`V{gAoPa]tf@ds@gIvp@nDpHrDeHfPdHdEmd@ni@{yA`p@wr@ry@|Gqe@dgAyr@zA}_@sYd@{@hRoVsOtSqRrNux@mQaEqx@ngAWjIvGkC~BnKyDzMgd@d[bIzu@yFv[gVbAxKsXmY`AqXlZrlArVcj@}@c\\\\hWqr@znBgHthAyHqM`MqfAo\\\\dy@~I`p@_JvGuCc^cWrI`FnSyaAuElAfNaPb[bAdc@rf@eF~Q`[mVxLnAh^zIpIlHyFj@vPmk@zq@g]mc@fCw_@xSqNaXsDui@}m@is@qMcS{WiU|A|_Ahf@|^~bA`d@v_@hCfj@yg@|WmVjp@zOuAtr@gy@hKjA{k@jk@}IfqB}L{^vDwc@e_@oGmVxToGbb@hJvIvg@vAgHtEeh@qAkTlT{i@yLmq@bKkp@yAueAyw@yhBcq@bHyEx]hSfi@hFzAeMaz@wX}q@iHzF_`@bWgC|QlKv[gi@b@_H{\\\\pGaHaOkGfB\\\\lPan@jX_HhPlK{G{B|X~QtVct@sEap@lIovAbqAsZxz@lE}`@uY`G{@`SsNdElGfM}LdMnT|MnDiRjK`@~JgQcPzcA~@~Y`KdIqA|QwXjw@_r@xk@sj@pH}mAfm@mEkAph@qc@oiA`To]v]iD~b@hU`WcLxPq[~yByMt]{~@ndAaInm@eFm{@ex@hm@rKdRsAxKsb@vCkH`Qaf@ZwoA|VeX_d@uJvGtJjLuSkDuPc\\\\}Db_@iWqEaE|M}McAlFuFcNsEuC}g@{P{CnCnKmLnc@`H`SuXxhArC~aAeLqXoe@dRDjFz`@dE|TxQfE}Ox^dMiXxRaPz\\\\qGaPiZ~d@|Ojd@^t[uOSoPji@{`@l]yCzQwbAdr@a[zCwFfSab@hLk_@`c@oL`C}WsKxBbUmp@xqCmlAr_@yjB~tBcNka@lRaP_N}EmQrFZnb@wWlYqKjf@_{@~m@yDbe@}K}G{r@`LuA`Gq[mKa\\\\rNmLlUgg@wHaf@pPoHpm@fT|Jc]xo@oRjDscAgYqT|_@sX\\\\ya@fm@qk@tQsQtZgoBxv@aG~n@i_A{Hs[tPenAoB_O|Em\\\\zZoEl_@ux@eG_i@jM}_AjgAguAjy@wwAp]iReJe_AmGkiA~Sfn@{\\\\yXaPwmAoCm[zYuIr`@hQiN_Unn@gf@lU`E|YvSe\\\\lApFaa@d_EmK~DgEzf@cIfL}EkTmeAzN}RpNch@zfA{Sd~@gJvD|Gba@ef@zVvn@bAtJyQxn@to@{@hpAcJd^aXhp@mYxWur@fgBcs@nw@}Ax~@yZrd@}Dhb@gYlWan@|hBiTxIbb@{Ilc@{kA`a@w`@vr@{Jpf@cUra@bZbm@`QvtAK|d@hM~`CnDdk@ak@bMbK~V}E|\\\\m~@dMcEjkBqMxSlJpm@kC|wAmi@b`CxLhN{Fbg@~Nhr@uX`[sZj`Aq\\\\|Kk_@fVwLv@wR`q@sRjFdGpXqPzSwc@oCaT{MeD`P_l@hx@y{@zMi]pSgIbKo_@bx@o{@bQwc@jLwBbPse@nmAcsAlQgGj[bSpq@mn@zm@eZjVhP`WsJ~O`Glb@sSc@cZ_`@w@lA{P_JsHnVoL}IwNtO|BvBwOrGbGbKgL|UbF`VaIc@yZwV_^pHaXlSeN`]c@~t@{VpQcQ`lA_Nr]k~@iK_}@nNoO|HlAaC_TjaBbOvu@ot@fn@xNb`@{Lh^bEfKkWtrA~FpVs`@nk@}H|}@}`AlToeAll@qpA|_@iR|eAqO`a@wa@xn@y[vWvIvi@m|@n]qeBxMyB^dOdSpSvb@xG|t@{g@vGsUh^a[tZyDdPbIcCzFd]dElL{U|\\\\wKjSa[nXuG`Si]kY{c@}qAbuAeaArDkd@jP}HySrFkMpo@gm@`Os`@hTeKv^yu@`uA|AlQor@wD{LrKgJ|^fOh\\\\L|v@_n@``@kFQ`FbOrAtmAa\\\\pPaQrTq@vTzNtXwCvMjMdnAiQtNmWyCtMnXeD|a@gf@UaKncAySxs@ki@jHk`Api@xBn`Au[`_@g[hQbGvp@wXnW_@rcAmw@gAyMjNmSf]sEnq@qf@pn@yOl{@rRbQ|TbnAlHbWaEf^aYx`AkBr[cZfe@yPvXu\\\\nIw^nKosBfOpZ}Mlf@rg@wHq@}a@_h@ga@eXiBoPzMyKq@~DzGod@z@}AiJcD`FmMeUlDgg@jMjArQsZjVcKzy@sfBheAaKrIeb@}Sm_AlXqNvUpQv\\\\rAhPiG~dAomAz]`Gds@kH~cAumCpQdExh@g_@vh@}FxIjHvrAtDlv@hb@vj@xr@H`Rj^~`AdZlJrR|k@nKr@pUd[lEr^tH}Bf@|c@{Lf[gb@td@wh@~Vu|D`j@mhCpvA{W`\\\\_~@rjCu_@zj@glBdhBeyB|jCshC|{FhT}s@a`@b~@ke@te@oVrl@wcAdc@vA_KaW|ZOjIzQaEhL`GyEd`ApR{XxD~^_g@f@ks@fTqw@|x@vCkQcc@|a@cq@dXto@}KnJcLnGpLxKqLqGnPpJpIcIpGg^bJcHsGsK|BpMxJvf@cFmK`[wl@`EcPpO_PgToWhGmKeLqW`H{@lGhb@xO~U}G~TtL_K~HaWkCyLlFiI_Nmi@oJ}TW{FtKwt@mFeu@}_@gfAiAeJ|Fu^cIu@vFoQu_@sCn`@qGsLspAHsz@lJhfCdM}mDzA}iBzj@ot@fd@qD~`@qKzHubAvTye@~c@eMl^lPh[kVpc@}P~@hGzQ}RxO{@~k@u\\\\pWanCtnE}^iAebAxt@_Ns@wFmLbB|Z~MbC`B`OjIcAQnMiY|LsPsb@uk@ib@g_@^cSdK~@`TrLdGfx@hF~Pr`@bl@xFsYly@}DzaA~EpS`_@~VhMLzGlRyKjf@mZ|PcRbd@c|A~w@aZly@qBd^bJpGv@xRaoAfcAiTfi@yDpx@n@bIbLhA{b@d~@fIlOeEpGiSiBoDx[wKfF{t@}@er@xL{Zrm@oOtHqZ~tA}q@t\\\\uPl]jH`HiPhR~@yOy]|FcBrNfRfGqFfSaXvJsEpVzEbGgNbLcr@zQcQhRqBmYpO_PEazAlJ{PlBwgAjHcHzIi`AgGem@lGuR{}@gqBbCkJtTuCp`@nLlZsQhh@cqAn]a]zJ?__@qa@iHsAwFvTgEaAtT_kAyXeU}LNwVhg@rCjYa_@dT`@dEtd@cR|JfGvF``@oMvUgt@dTml@kZeElKgG{HkJnMiHcBsBhH|f@b}@{GnD}YgHivAqnAi@aHvYu@bW~Szn@wZa]gHi\\\\nQWyOxPmO_SuPcP|Q?pQyg@jGut@wa@iX|K`DzKsIhOu}Bmr@yP`QmRaAkZjYwA`^ed@w@_S~IUp{@kVdAyQfWyAnSeg@lHiJpD~B|Kyk@hPRlJg_@jWiBjh@w[nJjH~QuoAzWsJ~b@rI|Hex@hWcC|XwqBtsBw^leCme@f[{Wj`@}Opt@mf@jq@_CzNlHfLgyAj{Bwm@kFci@xG}]vZmDnf@kFhA{lAmPwc@~K}qA~@_f@vQcXbe@cHdo@g]_Y{\\\\pa@pa@cPjEhLaJhKnHvF|m@aUBlNgjA~Ze{AkJslBvO_c@thAm{@dm@Hpt@cwBgOmk@`SiiAjeAoq@x`@irArgBmNlBk@{UoLhKic@aIsVvKwGf\\\\{X|TbQuv@yEaJkV_Nok@{Cuh@jxAsi@lHmDfl@qOlMkiAlLsu@jUgP~NuQ|n@_nBjy@go@dCepBo{m@hZnHrm@nf@lYoCn_@rObxU{ihA~B_e@Cuou@oxGDnAed`@tJqZcAqZrXak@ztAcl@f_Aeo@laA_zCxZuSxVih@vbAsy@zbAymB~i@yTb~AcwBf_@wfAgAqZzx@uhAnSap@db@aOjEi]veA{m@dt@wB`hCijB~tAnKreAeL|`@yYha@eJn[{]frAq`@lpAinBs@qk@dYia@li@u^ro@e`Av[woAjJgyAb[aGbb@a|@xJykAll@yk@f`@mx@wFoZ|IqFuCyKdLzBxCeHxI}~AcFwNke@iJmc@gq@ae@mUyHsJvE}L}Vs^oDyVwTpX}p@kIaMk[qt@ZkaAmc@iTUmc@g]{t@eXmTslAfGsKwOqRrCgcAiTgO_\\\\{DqUa^jx@icC}@{jAcGyHxKsY{GglA~HeFrKmdBwEai@_ToJtJeJ|Q|GfBeL_Oy^vKoNqCsX|NZlt@o]dJkb@f_@|EoN}o@vFiH|a@tCfMoGlZyo@fPs{@tp@qQxkAocCr]gTxTFj^yd@rCsSjdAsUhq@iDha@ic@`P?tFyRbkAkXdr@y\\\\bN_[tg@wIvOoe@pGbQpJlAfK_Kxk@qGza@oZt`@eAlTgj@~XmIrf@}qArYcFnOyTde@}J~Rgf@dHbFfHcEzWk]`YdC|JkNrX?lPaRba@yx@jf@_XCiUtOkKqG}Zbc@gt@jv@mBvDeQbg@uCzu@lNdPzMh^oj@n`@kKrr@xKtOeGxX|LlWeVfByNp^eGlsAeuAdrDcs@fHc^~XwMbe@hH~Q}z@iCy\\\\fL{IuGuHfNaVuAix@~Wia@~PaCrAaWpDeErJtE`Pi]gJal@xRmv@hMyKkJ}HbDiPxMed@~Q~ChAwVh]iWnUqo@xAcvA|PcJmLw_@?}bC~M}TrD{aAthAgvAxK_l@jViMwEeUzJuGmB}Qkh@jBiHeMzAoX}RgL\"],[\"yneJtu`eAvDca@hIdGa@jg@\"],[\"_enJd|ubAlVuT|TdGrEna@m_@xu@q\\\\yGs_@y_@hNwZ\"],[\"o{xJ|isbAhTsOjXpY\"],[\"mq_L~ty_AlVdAuMl_@cKcK\"],[\"mrbLre}_Ad@uR`JcEhZbNgRfx@_JtGeOsS\"],[\"cydLfi_`Ara@nKuK|FkWxt@sx@nQ^_e@jMo_@\"],[\"wihLzgb`AhVwB`GfLkKdE\"],[\"mcxMjf`bApY_Pd[qBcHjk@u\\\\pOumAbVy]sA\"],[\"ic{M~|acAxGo_@jTc\\\\kSl|@nA~dAoIuH\"],[\"kn|M|tecAv^iQ|L}Rfm@zHcb@p^{r@reAan@nsAiGcJnC{lAxC}Of[}J\"],[\"my}M`xibAz[cVjMnNv[rGc@lL}ONAnLuIhBuEuHcIfNmVrFgK{KcGjQcOhEyIgQ~`@aM\"],[\"kciNfiqbAgDoDr^{SnJen@tJkJrd@sAdn@s[dI|`@{MiBgQ~[j@vLm{@|SgJlV\"],[\"wx_NtolcA~RkSzZ{EsJrY_XbF_a@~e@uOuVmf@zIaR`TqHd]zFlM~O]wYf[}kCm|@ksC{f@uEwIh[eBtlB|c@bk@}IdGvNv[pDxY{JlBbPbKzDzaBi|@vQfNnOeA\"],[\"oroNvrlaArXe[vEzIe^beAk@to@sK|JFaeA\"],[\"e{rNhkrbAq^bXaRe@uV{QtfAsK\"],[\"exzNvpxbApSsGiR`[zy@xi@t|@bNmWvPwt@}Luc@cYya@dC}DkYuH}B`HaNgJk\\\\|Y~N\"],[\"k`pOvhdbAzI_d@f]o\\\\\"],[\"wptOrfobA{|@}Eft@PrRqf@~HfA\"],[\"kphPz{heA`q@eSl]~Dzk@iIrTvQbp@qAM~[oFdOo\\\\lOoCnMvM|YeFdFcTuBghAgeAwg@dMyd@uG{JaT|IiM\"],[\"qboPr}{cArBsSnq@wbApHlLiT~M{Stj@\"],[\"s`pPfnvcAu@wg@bb@tBx@n^\"],[\"g{pPtzocAvLzTeIhIxApSeYp`@mBp`@sGL_b@jgA{Sqb@gf@cXwJ|Ggt@BkaAiUuKgO|FgVuPgWeBmf@aNaUjLxIlSgJla@tDvgAeo@ryAhYfk@`WxUkM\"],[\"qtvPjv`dAjFqUzi@|Fct@noAgn@tb@yh@n`Aii@zZmBv[wXvH_s@jo@o\\\\My^{MeS`ChCwLcI{Vfw@igA~m@k[hMpM~^w@lQql@wDsfAnG}GxzArB\"],[\"{clRh|`eApWon@dm@{b@yKrXlHtLwKj\\\\uXfU\"],[\"w}hJ~tht@wTeLpThJ|o@|Bl_@`[c^kU\"],[\"q~lJfngt@|e@cLv\\\\dH\"],[\"osmJda|v@eLke@rX}GrCb{@`n@pi@bClTuTyK|Y`s@~@lb@{PaR\"],[\"ceqKbi}s@fCqGlbAre@bXh\\\\fs@r[`y@|`Ayk@vMqg@NacAg_@ke@ef@xKui@yJch@\"],[\"i`}Kzj|s@mZjWa[f@\"],[\"mphLbgrz@nUa^hFnDaBlQ{StMos@jL\"],[\"cwzLpmuz@ca@uOz^tChWl\\\\\"],[\"{cwPdrdgAjm@kRp}@hBj\\\\jf@_q@fB_TvLeIiFcj@~^mg@dEwR}PmOif@lDeJxZ|Q\"],[\"{}hO|xbdArA}j@pa@uUkZ`nA\"],[\"}|sLbgvu@iIdPuQ|BgQqXyNe@cj@vc@gDnNbI~RoZuNqB_IjYuk@vp@_]jr@`N\"],[\"a_dNj{nbAiFsI~P{C\"],[\"wkfNnxseAHIR?DRIHQ?\"],[\"egmNvkqbAq@yNf]rWoPjA\"],[\"qvpNlqlcArwAg@rd@pJoHpH\"],[\"c~tN|bxbA_GuLlKgJhYxD\"],[\"_dwNj_|bA\\\\cAbE_@n@qBxAn@fBoAr@Wv@`AxCUvAiAdBATv@}ClC{ClBaEKkEQgD~@\"],[\"sg}Nbq|bAwDiMvQHd@vL\"],[\"ivrOrklcAwFjFmMuJbZ_[nVbLmAdL\"],[\"cvvOtkhcAsKnD`F}Z\"],[\"yw_PraieAbNcYrIj@iVza@\"],[\"ogaPxp~bAaKUtTsL\"],[\"gahPbupeAbEeQdEfP{FhF\"],[\"wgwPfdhdAbAyGbb@}FyI|L\"],[\"wthQpvydANmIbOkCwB~O\"],[\"csrQnzzeAnGkEs@rJ\"],[\"_fxRpbqfAj@yBnAs@jE{@pCs@~CAFlAaFdAqCXiA|@\"]]},\n" +
+	"{\"countryCode\":\"MY\",\"polygons\":[[\"cipAw{yfA_g@tf@aXoe@`Lis@dYeSrMcp@jNuE\"],[\"}eyBurh}@nd@qw@uBsMgKlAbBdIwHaFNmLza@quAnZcYhbDawApm@}`Abl@yY~~Aa`ChQks@jb@qi@b`A}x@bcBy~@bbG_lCxf@jBnwBwOle@fJ|pBc[lv@f]tTgCph@fd@|fBmWuGdM`QpIzqAdIFzIjZ|LlrAi]rw@{m@dQzGgCkTjyAx`@beBoP`oDhHdrAkp@`hA{hAbRso@sCq]tNg@lYi\\\\lb@aObe@f@llAqdA|t@uTfe@pCdfCgtA|`AeFtSqN_EmJf]kPpcBmd@zfBaWd`AdI?rVdLvKg@d[{Oli@gZnBsQfU}UnBqd@cv@DvUfWbc@sw@vX{^z[pWwAld@uVp}@]~P`Umb@fsAlPbu@g@ta@t{@|{@}BbU}LnIn^Kfe@`Saj@ne@goApXck@`\\\\_l@xcBu`ArhB_S`_Ai|@z|@aHlRlG`h@_GrW}q@n]so@by@kW`GgnB~rFw`Aj_A}Kt_@qSjEmPve@lPne@ws@xGmW|\\\\{i@~Pum@`pCqPtWapAv_AcRneAe[uI}@`MiGi@}w@aw@im@zCa]~Xy|AbOmj@te@oHxVsfAvv@q{Ali@ai@xaA{h@l\\\\}Qjm@mg@uSnFzd@iZ`n@{}@~@uCoz@cPuSG_VmLdEzJls@_p@hF{g@bv@`Ed[c{@zIei@b^ouCaq@mFb]kWx@cPcMvDwHoX_TpAve@io@`EkHyV}GxKyKiB_CvXka@WeL`P|@xa@oIaLcJtAcJhQzHjPuGtF}cBdo@oYyZys@eNiV|G}X}G}}@d`@{bA}Aat@hTet@fCvBeS{HiEkHtL_hA_Fc}BrOaaCf~@{j@xl@}v@dVse@jEw{@oJsOmLaa@|Eqm@uEaG_LvJoNmCoe@puAoa@hUkc@pD{p@vi@eeBg]a}@~UmAtDsWhSeShnBcP_FyLpFka@_Vcg@vRo]mBsc@`c@uCnRjUjMPn]cYf{AtHnJxClLza@daAf^hf@ySlKge@xp@_f@g_@iw@}`A}UulAa`Exa@sn@~O`Aff@}TvA_LyNaXbVqU~DoVsiAgeAokAsLueAimA\"],[\"yz~Ak}geAsDaZkYeL~LoPxBe\\\\rNuJbA_a@_lAyjAwXio@a_AiIoYyRkGbClIvm@_x@~kAcNNnIrTeaAcPinAmaBwz@ea@hMwGfg@pT~PyKg]_HdWia@|Bev@mEqa@sOiRas@o[yiAyUqp@ajAev@wHaxAen@kQxHzC~L{Rk@_La]ew@{i@mDmQhGqW_ZW~@jb@wTm\\\\wB_Vkh@iPggAe`B_m@}IqpA}hAumB{QaQ{Uyj@eGc_@qc@lg@}d@|k@qGuGhQvXhFfN_YzK}B~g@fHrr@~^xo@nDdEkXmEcLqgAeoAi\\\\mEqw@cm@ot@bP{I_`@qZ`BfC}XyI}Qtg@maAhjAjOzf@aYjhA}M~Eaj@lo@qy@{l@_n@tYmA|McP|GhCzNg[kCoV|j@_a@wFsHzFgIhe@mIdPpHtOmEtq@zHb@th@ja@|a@rx@}d@rV?lPjPpO{TnXM~Tz]tYfnAhO~MmTy}@eBc_CuUr@oEeeAey@_w@gGaZvTiTbz@kOhi@_]xWfQnHfV?~tAdN|F|{@k_@mR_z@nGcN}CiPjMcPuH}Iev@yDkFuHeMut@~Sap@eKiNhqA_nChfA~RhYcAsa@_UkVo@aJa]jv@mt@`Ouf@nZ_KaPeGn^_u@bLqIfLkAbEbJpDyE]kZmSlBcDcGrOs~@qXw_AjaAov@txAzEhz@ndAddBngHaPfcA`Xpl@qu@jk@oKnk@tV|g@jMFwDbWhLhUds@hq@dM^~P}Ypm@sXfe@sc@dZ}a@rDk\\\\tXcRnCyI{KcIrGaSfO_GtVpK`Q_PiNqa@nGy\\\\pGnB|i@k_@|IfKcEhTxRMdTlUtIbfBmHbW`M~KnShjB|m@hnBeOnw@w]rj@cPzGuU~}@ab@tUb@nHjYj^rKkM~v@qNxg@da@~QqPnInd@eOrpAem@|p@k{@d_BjMvVdA`rBqH~{@fH`p@}U~x@xOxRsAhg@bGxNah@ni@~\\\\hU|MwCvCx\\\\zP|TwBrK}d@pSoPt\\\\`Pdr@gMj_AbT|Hjm@xv@gJzOyWfIuMn|@aRtGcC~K`[hMjf@hAxa@f\\\\sGxV|j@pt@rjBjVf^xPlOjYfMHbXm^vmCrZj{Bob@jGbImDjPti@vWd}AvTv]kT|[vTbq@hJ`[h_Bya@li@`e@hThi@ft@fq@p`@lk@eUj^v\\\\tWiHnf@rHvGkHiGeZvEcVv[gXvXqGbKjGnNxu@rbAnqAfAv^tj@pD~U`sAfeAbOt|@gPi@yd@xsAtDre@h~AtzApEnz@xZ~e@he@zh@_BnT~LSb[ik@pmA~t@||Bg[lp@nMjrBzO|Xjn@nd@`eArqBwt@t}@hKhnA}b@dm@_VdfB}Ynj@vHvpAu|@ih@wUjE}JpX_GzhAhWl`@}MzmEvZ~k@dKp_Ahj@nrArNtC|DiPtKoBzrC`z@dQfqB|`Aju@tHtd@iVpvAjSjs@pWhU{f@lRdHf|@ii@hmB|b@bkCnCby@~d@bs@~[tK_FnJdGx`@hQxYuL|\\\\rYdd@uPny@q~@b{@aGz}@_[hCssAzt@sXbiAyVdLc[xh@c{@fZiDjo@mb@zTh@bScWvD}}@vbA_XdI{xAAgLzt@{u@~Oey@}k@q}@c[p}@mJx[rD|f@_WpeAaxBli@sX}D_uCjKoZcNqYbS{UaUuIke@hC}RwPdBoG~q@uMpIwM`AmRkM_M{Lgg@dJ_G~~@na@wFoXpUuQ{D}_@xc@auAl]eCh^ta@yAo\\\\sO}V_l@_A}KuOvXeqAd`AoiAra@e|@mMww@he@mbAwK{YoGhc@eX~KqGnc@|Klj@ye@zdAeq@t`@}XlBwx@eS}E_c@vB_Yja@oY_GgUpJeRwBoLaS`z@qSdH}G~Rmi@zQ{IkTuMtKqK_AqhA{e@kgAcR\\\\ue@uLdO}UoFvGqr@a^}XwAdLlVhPuNrc@lDrt@a^A_O_UnAqKgVpa@qdBsUoKyNpz@oiAwRwr@lOe[uq@fj@ob@fKhEur@oIgTyIfd@m\\\\xZcmAmM}N_J}tAicCmVkvBiBq~AsoBc}Lwz@inDmz@qm@{LbGqm@_y@we@mV}i@wo@}m@aRsv@as@q`AyYazCa|DiiDgbCg]cLqWnCgn@wRoaBfI_CkMtWcjAj`@iw@xKwC|BaQ`jAuZzZgChPvK`N_C~AwtA|fA}b@jq@ox@zYwI|AwX}fA}uA}nAm^{IbA|FnRyKJwa@k\\\\ca@kI{Pm@_EdYmPbJwlB|G}x@|Rgo@cfAsF{x@s\\\\qEi^qb@f_D{En`Bc`@r|@oFpZ{o@\\\\u^v[omAyUoFet@ll@qWdGidBC_uBxg@od@zd@\"],[\"ujqBe~cgAvAoG~Jl@vAxg@{MuH\"],[\"mum@c__cAxJPeEdK|LdLy`@`P{ItQ}`AaBufBrJcr@gIfGqTzl@mRblBO\"],[\"_lu@err~@`^o@~K|Gb@h`@iSxO{WfAeSyS{[`C{OqIrBuH\"],[\"mvvA{zmgA~BcMtPqCzKxq@uc@dR\"],[\"qxyA}sigAoImGHaOvQi^zCbNlO|@yKbNtK`Gym@~tB?ip@eLkRdAkK\"],[\"mxgBi}ieAlm@wEpJ~IyEnVrR`[{x@uQsY{_@\"],[\"wmiBsdf|@vS_Tx_Bda@sBf`AuRkF{aBjIzGmRyKaNoAsW\"],[\"c|xBm}{{@kRyGkL{Ut_ArExJ~Ko_@~V\"],[\"uh{B{d~{@nSyHrXr^kTdLJ`Kbf@j{@ix@`E{Dtd@wl@lG~HqmAkTcZOqNgHw@rp@qk@\"],[\"edcC_iufA~e@gYfRrArVvf@aK`{@kNaD{Jqv@yNzAe@hUmGhA\"],[\"itnCselfA`VqKfW|FwIpJjCfH`VmB|Mxz@xKsJnHxGwAnWyv@g]yKme@\"],[\"elnC_oofAeG}m@tMgUlpAdKvJpHkDzIbNxv@`N|XrXbPw}@lHeo@aF\"],[\"aoz@o}x|@dIfFaBbMgLeO\"],[\"krz@agz|@vYn@jZ~h@w|@sVmC_J\"]]},\n" +
+	"{\"countryCode\":\"MZ\",\"polygons\":[[\"~ekOefrRisEfCmt@p\\\\}pAlKisA}XgbA|MsReGsHrh@uUfa@uyAbZaoAk]_]{XgaBjQ}aA}LmpQh@aaDdhAwpC|Jol@nz@ugDpeA}pAtfAkzDmDqnMv_DyeAu_BiwRe`RrMeWsmAbn@_MoDsRnXszBo}A}zCtLks@yF_WuRcCkrAkOyNohAag@uvAehAyiBeSiIwXeb@_Pb@o`@s`AhBgxAgRo]~j@{n@Z}DbJpXjk@}Hz_@kkB{FqLfCoHve@w}@kBkp@md@agB_ToT~Bmd@`Y_@~fA_i@dJ_UuTcMzJkf@jByYc{@eA_|@iGyIyq@kK_jAr^kVR}i@qmAsKcCoT~Iyd@{b@yz@zu@sl@yKobC`]mSmNwg@nHeXwDgXc`@eiAcBgb@wK{VdJoQha@e`@lHujAyp@wRiBy\\\\f]upA|JapChmAou@su@}fBu_@fR|m@qIplAhGvVwLjSku@xOo{AhmFiMrlFcMlN}a@pGgRr`@q~@bbAkLtvB_Ql`@kBde@kjAhcAkJ`UsOdzAbi@tlAwb@|u@sDxZ|@f{GgcF|BaDgIiq@r\\\\sh@gPsXdLa`AtGob@vj@w\\\\nNcmAhV_mA`FaH}_Asq@ogBshAkmEcsA}rJgfAuyCa{Hed]h\\\\mo@`{@oEvi@yf@b`Bat@tpA{`Blw@s]]cP{q@mFeQuUdXs`@v@sPe_@efAtGasAiXuOiGaSe@ol@q_@adBbHoPvz@_a@rcAgx@`y@tF~z@cVfkAeDjh@uStl@dUndCwKn_@|LtWj^j^bIvi@|j@vy@sDtk@lHps@ze@rKh]zYz[xz@tGjPwErTud@dbAs}@fnA`Kru@yX`KeKvCep@dx@o^jc@}e@hW_JzWeo@r|@su@r^uN`Pq]|ToFpJsYpa@gb@t@ax@`IaMd}@gCbPhFvZbj@nR|Cb]kS`O|@zLmi@u@waBaYwHgm@nDqRyHyVbZyGyG{c@AyKsN}f@tNwt@hCsc@na@kIl]}u@nYai@e{@sn@qEoVePeW|G}[iCkj@{[ee@oj@uIge@t\\\\gp@cR_HqPyaB_k@g|@ueKsa@kxCdb@aqDajAkErXmlC`IiqFviEqmA|h@omGfgFwtBbiCsM`jCegBpz@cgBoAyeHfa@}oHxvAwz@iOsiDyqBm|@kXm_BuFa@gwNfHaJuD}b@~QoSuIW~DgZwSiv@ua@cWuLzCiK}i@ke@ye@zKyeA|OcRvXH`Aw_@rl@ezAp{@}EdOyKiCwXzGsVmPc}@?_hA`QsGfKcV_D_q@e\\\\eeAe~@ir@~Jst@cDyx@iKgTdg@oWl[wj@xMkoA}Ick@lPmVtEcx@g\\\\iT{Pej@aDym@__AuhBam@_@qqAe^kZqj@oT_jBjT_c@sGeZlC{[`r@}w@da@kyAauAedBaKae@dC{Zuz@ktAwCyeBwK{g@pT_eAoKg]geBmcCgc@qrDqi@s}@k`@ikAuaAs~Amy@_w@gn@quAyd@y\\\\k@iSpMxArAwJ_MwOzRoLrXtSzHwClJ_a@vg@oIdGo`@~MwMbEft@zg@vs@xTgCcPyKpk@gbAde@hx@p`@hS|q@oEiTgTvE_Ovd@xGxg@b`@z\\\\uC|g@hp@dc@~Q|Bf]~XuO{KuCx`@u`@kCaQz_AzCjm@rTdYsTb[~Q|o@kLfMqLbl@oDvH}F?oPbb@fTn~@u^bUjL~y@hIto@eOxVzJ?t_@bLn@mBkNpYuVxp@rAfCe_@`RzWds@zYtKkNjD_d@|r@w@~Rga@rGpI}JtIbE~Ilb@iEyAgRhg@uV~RxX_LnVbPgCbCtYbUaM`f@xQsMxG}AjO~d@vl@rQgCf^ah@iMcQ{^rFdEsv@bJwBblAjTbj@qG~m@fCja@pXlFs@{N}Vbr@?{ByKrk@`Yfp@hKba@si@nk@za@j|@gUdiAwHxv@o[xv@_HfWdIdHlW`IiL`O{@hVpq@hVmLtPtBuMqN~C{HbQoCpGfLvBsEaGmReQDeWgm@zLgNj[cA|KwLbj@pG~Oz\\\\_Pf]hcAwKLzOrKmGzKxKnGoGve@fCoYmUkx@_UwPud@jCkXr`@uXnQpE|Ktc@pKtFiEgi@bv@vIr]iRzYqB`g@zHfQ}CxMx_@pg@je@bHp_@y\\\\jRda@sEnGwI?_n@fn@kPcLj_AjJpDvo@e^vN`w@vQpK}Eha@vG~J|^pHdLsi@{QkDgDu[~GsNv`@iLlPrAtE`LfPkEjcA~o@fd@vHzDwH`HbCfWf]oBnTjO|@tf@xj@j}@ze@n|Bj{B~MnYlSxFpI|ZtKkCu[ub@hMqBno@xQpZlZjfAvi@~LdhAtb@lx@fJwIbj@sCpX_]fTze@aPrCfCdXxKmKrHbVlUtGjR|UhhDfgHfd@pb@mDlJdT`FtGfV`@_YjVxBbQ`OxF~iAx]jz@xGfaBiTra@xPwLhLhJzJpk@mJuAp[d\\\\uIpXdRfCrbAroDlZd^yM~Tai@\\\\gK~GxKgCxAlF_FlUdh@o]jIlLdGkK|X~u@bPzpAveAfrBcFrChErNnb@`o@aBrT|sAzxBaBnNlRvO|Dz^t[nXj@|_@`VvAly@t|@pxA|~@aPhCcXze@_YbC}LxKcAla@`OfGwKaS`IsLbgAgTbSqj@`xAbw@k@rn@f[bOiTye@z~@vq@tnBhpBlCrSda@pGqG~OrOgCv@b[nKcCdeAve@dMvHwLDxDxI|YlFo@mQj[oB`Kj]}Mtf@yt@xb@btAiOjStGnPkI~DbjA{GhHot@~C`jAlfApAnSuKyBgFtG`l@|W|AlLaOfTnCjJ~IfDhAsO|HxBhyAd_CpVjL?aPdr@j_A?yKbf@b`@jEdPtx@tf@prDxcEbLhd@l`@ba@tLzQqEtDhl@dy@{J`Xac@pImk@hhA{k@hf@}\\\\bIoG|l@zXfMcLg_@pD{R`qAyo@tG}Yjc@sYx`@nKbJ`M{@dOvIs@{EuRfGmLbw@C`T`IhmAgOtPnJiMt^~DfYwHhDdVsHtVkl@fjArf@vHwMtE~AnHxPuFtQv_@eNtI{ZjLmCzQfAlZvZoIwk@lUcEgFqHtf@ei@tc@aKiK{PrCgGlU~NtJwAyKeUbAy\\\\jTqVp^aAmBeK~J{ClRxKzI{BrXmY`a@|GkNkYjIuO`TYl@uF|XxKo@bRfKHnDoJ~^`DdFdRiFnMjOhDpO_Ytd@[rHqOvGu@~NtXlHoNv`@?qNqNaiAsQjdAnHvu@eD|n@uRfkAgv@ri@kR|oAn@rjAaZpxB}MdzDxQfNsBxOq]z\\\\aT}`@iAiP{QuUrMc|@pGug@_NtUcL_IgGy\\\\jTed@qOqPoUbHsMp~BiI}pAiTfoCvDz_B|]dmA`Ar_Di]cPmGvCaTiLKnDwKzbDvnAn|@yEh_Cxg@zrClJfRrH}j@nD]xXd_@yO~rCvTk_@qa@qT`IcTiDhUwt@a|@aEzKg_@rd@{HfkB|b@pg@}EnjDfwBxeAveA`YpE~h@jm@heC~|G`WzfBr}C|uMxvBnyGe]qa@lAhG`[j`A|KgCcD{KzLdHlcCr|EbuAn_Ant@bS`^qCpD|AuLbFrKbNpe@xI|r@tz@{Jxm@rTzWlQcHgYsEfCe[pfAw_Afz@yQgHyIhJg^v{@_z@_FaVic@rEm`AgOkUgd@|sBpNzlD`FhfDfWjTqGzG`qI\",\"fkiFyrdTln@zV|XeGdCoKuHeZ}i@uQgR`N\",\"r}hF_`bTnS|Ide@{h@ca@yQcRpGoIdQ\"],[\"b}{NuuaSkQ`F{OiMgSeg@|m@hLdElFwMjU\"],[\"d{cLowsTyJwKxdAMbvAbU^vKwcAs@\"],[\"j{|HmnjWdAiThMw@f\\\\pR~d@jFlCnNoOv[ul@~E_J{E\"]]},\n" +
+	"{\"countryCode\":\"NA\",\"polygons\":[[\"`amPm_aInVxX_a@x|@ecBxnBuuAvlAst@xqAuzA`eBcr@`j@}XiCsR~FivAdvAi{@~FqaEzkC}gAhTajA?ab@bOms@hCi]nj@uhAn\\\\_PyAob@vS}YIeKmMmBoPz\\\\eEur@cNgjBlf@kt@`vAe_BfAkTvMmG_Pwm@}Fqn@nOci@`[oSnBcViIsy@|o@k~@q@wdA_V_XlOmrCn_@sm@kRolAcL{wAtb@ciArHm}@xb@ewA~jA}gAoEcrBn|@_eBda@scApCca@cL}CyMaTyIsrA`PooBmDaeBbe@_k@k[pNuEyd@_@wgApJckA~_@s}@pE{w@qNfSoIjXvCpEsC}DgL|XpGmz@yj@}hCb@eqAxJuhDhkAsn@pk@ch@zMqtBj_BqdBprBuj@oEckA~s@ysAz[oyDbnC_uCtxA_j@ne@__C`]sbBfu@icC~]ss@bU{TlM_Xhs@ea@~SolA|R}fI~zDm{@pNk{@~f@_gAd]k`Ar{@ey@vKi`C|dBsj@beAer@jl@kXzj@uxAfScpAze@qv@bv@}sBr_@c_Gpp@wmDmVwG{EhFeb@ms@yiAyXgzAnIk_@gCgRfn@}k@wAqtAsI_WhKsYdFuaA{]cj@gq@ypBc[ic@wQwEm_@mnAwO_~AlTg~@gLc{@x[qgAzr@}^zP~DjfC}aFpj@mc@rHuV_HiV~Gei@uEydAqNmEd@{|qAhl@uUpDuO|m@mVf]_h@~YmMpcAoiAf_@_oAjMspAkLsvA|@st@ra@elBmIa|C|QeWxIid@oPkM?waB~Smr@oBejAkJaWtEm^mPwT?gc@bUs^rAu^tw@_oAcB_~@lOcb@cGkHtV}i@wJev@qOsGua@c}ClAsk@~p@i_BkmIajv@vD_~@{Igi@ze@}pBwVqc@}@ea@dWudCnQqWsDwKtUiXgCaW`P|@jTca@hTmEdL{MkF}H`ZeMpXwq@zM{IuFdaAxRrSzG|f@{K|DlIjPaAvUaSvQxLzL~P|cAz_@hw@pcB|fBtCvc@{_Ans@Gjk@xj@z`@oGxo@b{@|gAjEzZng@rt@{BvKvMvTpT|@rqBdzBrc@daAcEdRo|@jScL{Gga@fY_IiDcXbOoFjVcVtTx@~SoP?{v@ve@eR?uZrXbLraEtmD`l\\\\jKzwH|dfAaE|U~F?`lRnl|BcAjBbt@r]lk@`a@n_Dvh@tb@bq@~PzOjPdSpgAcJr]hM`Nd^`Lrw@cZnD|ZzOvNbWl|@d@`WyMnL{Df`@gd@v_@wOnaCnNzvBvM`c@kGvO~Mzk@yJpv@tLzwAeZnkAeo@vpAmSjgBjMhl@uCd[gDnLih@tD{DlHjQvwAiBhVidAsIwLzEoFzUqv@n]iPsI_Ni^uMgDq]EgiA|`@xAzqAqMdLicA|EgHzZm`@`WwAjLt[poA_HlPdNrOrp@tCzBz\\\\~VuJdIx[~X}GP|a@|VD~e@eUpIzNfd@vD|V`QnGzU{SdFY~Jnh@hn@\"]]},\n" +
+	"{\"countryCode\":\"NC\",\"polygons\":[[\"vpfKuyacBdUhCuIlRaSbEyRwFtB{I\"],[\"~xwLs{feBrYaTjOIuTjTzh@pG{Njv@m_AoGV{Z\"],[\"hntLczxdB_AwM|PgJtH~XpD_K|G~JyFjR\"],[\"zqaL}|reB~uBtEtUbx@eApYkItTkPc@qDbLSro@{c@_RsXvTme@`Goa@dUwDgXfJcb@wb@wr@tc@fLrc@uXv@wh@\"],[\"|tsKsnqdB?}SxKfXqG~O\"],[\"juyKedeeBlUgS~EpSll@fDjRjS_Hhd@wQlDuEdX_S`HyDfx@}Q|a@_h@rc@uOmC}\\\\z\\\\xAke@eHsWex@ij@sLnBoPvg@~Chn@s_@_HiH}`@nFwXkRcNgEiTrXmv@bJkCl`@vOxg@kKzZjK~T_^rF_a@dReKdUzG\"],[\"t|mKgsudBzKsUdJGnG`f@fl@~Rl^gDeAgYnv@`a@lPxq@qGzG{m@cp@ep@Ymu@sZkb@`XqJaX\"],[\"liqLok}dBxIbLpLqGrd@nOrE|TzIKhRp^gAlNeLmTn@zLa_@~PfN`Mi]dFxa@jLnNgNiA|XxI`NaJ~VwQ}KI~W_T~G{@xV{QtTnFvSmFrSkHpFcF_FeGpK}@rW|_@jPb@hOtJ{CDrMmd@{DiLh]_Gea@aE`N_SgA?pXnPnE~OxU_PuBoPvTaPcG~Kbd@wGd^gTfEmR`_@zIbPqGfLoPwC~DeT{NtBkJnPdC{\\\\qER_InPm^IqHbh@cJuFgTdLtJhLxRjAu@lQtNbAk_AnpAo@te@oHl@lDcLcUtTkNkBSdK~N?mBnIcx@lR~Xna@qUzChH|Rwe@jrAoOdRsPmFl@dMsD}HaF|HfJ`^g\\\\rkAqe@fTaS`s@_eAfg@tNzSyJrGfC`UmUiIeBnVsNkA_IzM|\\\\nGlAxKkJnG_ToGqIjRs~@zt@{i@jCwA|OoPqCwBbLmF{AhJ~RqMdGRrJnQtHin@x\\\\aG_K_Qb\\\\wCaNyJrAwFbTwHFbErLqQvWsC~XcPnIcHbXsm@hu@}HdEoAeV}P_@mMl\\\\_nAbk@fCxKqb@|l@gy@dTsL`UyK|AgA{\\\\ca@?mRfTiCvc@qSWzWtT}Uj[wSsLdDaRkFyDaUhMaH{JmOjB_FhH~EbXoPmGuGdKqOwBjOkd@n}AizAnN_\\\\uAi]xV{Mn@eJau@nPvg@euBr_Ag}@fj@kpAxbCajC^kXn]sl@hTskAkA{Sn]i\\\\dk@_IxZ}\\\\~K_t@bj@~IfV_FreAwnBxVuQwKxd@fXy\\\\|XyKb]{aBp\\\\aPmA_J|c@yQuTdC_BsJpJsHiKgCiLzGvAeJbz@{a@nAbOhTc]vDrBsBoOke@`Q{NkBpYy^jEuZjIfIrCkKpXiCuE_Yj~@{tAbXygA|BlPlP{G~J_j@rdBm{Alg@_v@_HcEbP}\\\\nPfTxBym@lPmJxKsz@|Zug@rWwLxZ~F`CuM\"],[\"~hhKkofcB|KwH{Tjk@ke@lB\"],[\"jp`Kw|{bBxNiGbMnO_g@pQo}@jJbMmP\"],[\"nlsL{nqgB\\\\_Ax@d@]x@\"]]},\n" +
+	"{\"countryCode\":\"NE\",\"polygons\":[[\"{lcFwfeAqwAb~@}b@pfApFpWeKrTy`@dVgi@dJaiBztBqr@v`@cIfg@s`@fSa`@ti@lHlFnA|f@xSrUE|Rta@zUo@po@nN~e@wGzP~NzIuDjJ|IzH`G`m@|z@e@py@aTp^i_@tIJYjPfQlL`ApOb_@yDgZp_@czF|qD{X}IyPin@h@}o@aHqLaYYwb@bUol@xEqcAzw@sJxtAdRfV}@lMpw@hi@iOzmDobFdxGsUppAyThFcbDZaXeGtb@wmB_`@{OzPs`@sE_PuRnTkHdo@ul@twAcn@|Mcm@`z@wKvjAe\\\\rFiCvhBqNrGia@oKqrA`zAwZe@wR~ZcUfEyNlSqRyDsa@pWsmA_^_b@nYsvApaBe]tQs^tBirBwh@gv@fU{Pa@_X{TibAIhSmqA{Q_dBt`@a`BkQ}|@qEqoBenD_hEyM{d@ua@}q_@cp@iEmDoMfl@_gHwwAcKi_@wu@is@yo@u`@e_Bog@gXu_BsMop@yl@y~@nFscC{v@qYkTk_@uq@uMaD}tFf@qWoKugArAeRsVm|h@jCg_Eqq]gxZg~_@_tr@{rvA`rE{p\\\\|d@ihAdyHk`KclFi|MlqRawBzxHyFp^ir@rWoTrxGu_ErLdXbWlN`x@@l\\\\gO~p@a}@xxDypD|VeInmGlqCviy@~cDhXfKb_UbdTfgMlmIvjBdp@ts@oIjZvCvG~`@`e@xg@tc@wL~UnDbP~StEfq@zOne@zZnSxc@DneLyaBiBjlDfq@dk@vTd@fI|OdCcIlMlJxNto@mPzBlPxK}G?~B~ToKrBj@fPh`@fgAQta@tIfGkEtPlPmPba@pXpEvTlMuEqGjPl_@zUfMt^tPzHuOtZbQbEbW|c@z]fMtB_IvL~BlQ`]j`@jDnJpJcHzzAaKlNrFzR_IvBuMre@bMbt@wGvc@koA~~BoZ`dA{m@|{DuEb}MbmA~yI``ApuBrj@|Vj\\\\hl@d`CrnBdLn^gNvcC~HxbAkVbkEwn@tcD_LzU{h@t[g`@pf@sIv^tElo@}rAzyAgLnc@yl@d{@o[tnDjJvi@beCfbF`E~h@cHbnAxjA|oALfqA}Thf@k}@tz@ivBjaAilB~dBkpAvvAmVth@sHfl@~Vru@utCzbKrNh{ApGjPhi@hYjSzn@{BnxA~GpbBsXri@bA~Rp{@tgFhoBp~BdLtkArtCh@jsCxTrxCxbBfpCtrDrzCeEflBdPbbAoQvq@`^rh@sDpp@y`@`T~J\"]]},\n" +
+	"{\"countryCode\":\"NF\",\"polygons\":[[\"bwuPa}oeBnNqEjVnPsMbI`E~MuQ`DeNsFGnJ{G_H\"]]},\n" +
+	"{\"countryCode\":\"NG\",\"polygons\":[[\"{lcFwfeA{Qc_@aT_Kqp@x`@sh@rDwq@a^cbAnQglBePszCdEgpCurDsxCybBksCyTstCi@eLukA}zAiaBm`@a~@sp@{yErXsi@_HqbBzBoxAkS{n@ii@iYqGkPsNi{AttC{bK_Wsu@rHgl@lVuh@t}Dw|DhvBkaAvh@_d@pi@_}@MgqAyjA}oAbHcnAaE_i@ceCgbFkJwi@n[unDxl@e{@fLoc@|rA{yAuEmo@rIw^f`@qf@zh@u[~K{Uvn@ucDjVckE_IybAfNwcCeLo^e`CsnBk\\\\il@sj@}Va`AquBcmA_zItEc}Mzm@}{DnZadAjoA__CvGwc@cMct@tMse@~HwBsF{R`KmNbH{zAoJqJk`@kDmQa]wL_CuB~H{]gMcW}c@cQcEtOuZuP{HgMu^m_@{UpGkPmMtEqEwTca@qXmPlPjEuPuIgGPua@i`@ggAk@gPnKsB_C_U|G?mPyKlP{ByNuo@mMmJeCbIgI}OwTe@gq@ek@hBklDhfK_}GvkIieAnlBeFjSkyD|GaPhT?~Sy\\\\zGnGhVwa@~KaCmEqXnOoJ~YlSjr@jHfFdJdq@gMhgBvUzl@n]~p@up@j[{Bpj@rLj_AtbB|Pxo@vq@rv@dFl\\\\n_@rh@r@xYkXtvArcAdcAv{@d`@z_@xg@zt@nEd`En_C|iFvy@b[cDl\\\\hJ``@|m@dLzdAhTbJzk@cIr_A`Tvi@k^daDbi@fi@ddCpc@j`@vV~l@jJjCxCwSrKaEnj@mCnfBtn@~n@yBxtB`Mfl@nSbD~f@fx@h]n`@~~@}Idn@rMhc@cEva@teA}Tbl@jl@eAdd@|X~ZhuBcEt_Az`@~qA}DbvCdkBrYdEp}@oFpbExpDzLgZffAmv@tNwFbMtDtCpf@|\\\\zb@pOnEtBj]dgAtpAr_@xMxWyPd[m@vy@dUnSvMdXby@h|@bPhJfKbOlfAoAvwAef@pUi~BlLaX~JwOhTsIfy@{R|RuoAn[s|@`cC}GhJw]hBuMpJhg@xOjfA~GpW|UxV|AyAfqDiUrR_m@~G_KxLnhCtfDbErMqIdf@dLtJnvC~n@`Ifw@~k@v`@l[|z@lc@zQzNdr@ndAhi@|cAttAv{A~wAd]|EmDbe@rb@bh@hItEdp@sIn]xYvbA{s@d_Afd@nd@mBpv@pT~\\\\oE|sAvObUr^r`CvcAdUpUz]gEhPjf@pz@bKw@~d@oX_FzGzX{EnQk|@}Fh_@xT]zPwMfOoGuCcGlPkNfAsTr_@k\\\\`NqVn^tkA_v@pMmAxEdI|gA}m@r_@pDlIwQpM{@bo@li@kEj_DjK|_@|F`sCbHdKfCkTrCv`@oL`b@{Bjv@qGoGw@lS{V}Jyc@~Pal@oHlRbPv`@~@nNqIvPdQUpWzKjDk@pLqNpt@gPtTfCjRdLxInKuCbRye@`JvCfC|ZuaArw@wb@dFnBr[iTfT_e@`PjVbF`EkJv]aKrLy]hTaMrSiA`GdOrXeVf]fTOrVxK?eKbSqTf@zP|HpS}HfCrTqxAbYyHwHi`@mB|B}K_n@fXzNtNaWzHn\\\\hCjn@}SgCdXcj@f]nk@sSdWdBuKyPxIiOvx@tC|t@a]tMtIkHpYjHkBwN~}@}^l@{TjSob@nHut@yOgLlYqz@rS}k@mHpEjLoPzG?hTz]_FnR_Qxw@kIte@g`@zXfQfgB{X`SwStLb{@{Hzc@it@gCm_@nGogAaPzg@lTviAdCucAxKdLxKj~AgX`MfCA|m@{yAlIcOlHz@|QvLmP~TgEneAvJjF`^oBhc@qJdPzBrOtJwC|BfIrMfoAmPxMqgAqt@?`UpXjT}~@tO`v@eG}Sx\\\\ra@yP~u@bU?mPzJfT_Bze@nGpGyQxPel@cLzBtKuYiAoDxSvGlHddAmLcNpg@m{@dDhTlGqGxKx^kJ~EbF_Utk@wYlTsNmExKvO}Wlb@sHpF|G_PaPx\\\\a~Ax_BeWnPsY~DfC{GsJ?yOjZ{w@t_@vKiTid@hXgtCzr@oIoI|BkR|X_Yaa@dLfC_Y{\\\\b[rObC?dp@woBzNiGmBgF{bAlPqGm[oe@vAe]e]rXy[z@yKiKaDwd@_UeOfOhZvHlp@lu@bSsNhe@pXkE_Abc@qVneAsg@vg@w_@fCaPwRzKgiB}h@o\\\\vFaYhTwE{DuIuOtCiWda@FxJbc@p]sCn`@qRzC}u@cK|aAxb@xTz}@m[p^kq@jXys@yGyCuFpLkWaU?ePsRuQgr@{VkMtt@niBlb@`n@ar@jViaBzjAoUhd@g{@~o@y|Af|Buj@twAc_@xmDiLt~ChLtiGkTtc@{GgZ`UiFcS_UNgh@}MgQcRCcJ{oA}KqYkVeKsIyz@kDtGgF|j@r_@tcA|Yx{A}G~U_e@u@vZ`_@nBdPrWgAff@rUvQiK|LbBbIx{DwCt_B|K|jCymAgFuf@uLks@zBsb@u\\\\cb@hBgHbVuQ|Fwf@iGygArLme@kg@ea@vZkh@wOwxC`LsHiEl@_Ueb@sBccAxk@e`CcCk`A`b@gU_Iy~BiLo_@gOupAhVw{@cCgq@uX{pDnLyKmPux@cF}JdHw_@_Mcw@RzBeo@kNiY{@}XbJg`@sTay@{wBcn@mhBvJmnAkdAy]i@Ek`@gJiSkOkCqq@xMsY{Ec_@knA`Csd@{~@wn@y`AeIk\\\\u]m[{F_|@|p@}XnHy|Ayp@s@mXfRyp@ewBqg@q_A~GsGxWuw@h`@c}@aNoyAlYwd@mEgRfd@wkDhxButAyU\"],[\"wqvAchrCCeZvN`HjNjuAyA~l@_LhLiJyDkKoc@rOsg@\"],[\"mvvAkamC?mc@fo@hTlS|gAkHhZ{sAex@\"]]},\n" +
+	"{\"countryCode\":\"NI\",\"polygons\":[[\"qlwEpzhs@qRps@kq@dUuhAtaAsSxh@_a@r]_m@f`Au^jPs}@~lBi\\\\hNew@ziA{r@v[}e@ff@sd@vN_d@bd@ikBvr@wjCzlE_Rbj@mMr@rXkn@u\\\\pd@gY`MdVoAahAjtAsUjJjKnG{lAxcAkO`GtCyKmVrSwItYnUiXbE`EikAtnBy`@hHsTwJag@{o@_W_FbP|BdBuUhSwXx{@sk@rDqY{Bi_AgJzv@oZsg@}GinC`Ia^saAaeA}|@nFy`@aNw]ie@}@ySbRyh@mVi[i^tBsS|Q}b@}H_jAdSsg@cB{Q`Muw@}CkMkWsG}rBbMy^mGsY~MyVyCwj@kbCo`Bgo@su@}Eyn@|c@bDns@{|@xCki@lb@kVfDeh@vH{HyWeKqNfN}n@qKsIkn@yIgAkT}`@{I_h@yY{IkWip@oy@y\\\\ia@cf@iX{}AqWsEy[f\\\\wKaPoP?_RuO}o@}B{G_DxAkJlQqSyZob@oIsC?fOmPoPkQ`TaCaLsc@yIov@udAqAaeA~HuLvMeHbHbFn@mKzNa@fg@w[wEqJtN{~AtOfCzGe_@uQmm@kh@m[pXiN{@mk@oq@mBdZoy@eLol@g]`PqDyEtSkk@}@qgAgX?tOoa@uOui@qLlKaA{a@}C_LuHh@pG_Y}P|DkKaM|Gwn@yUaFgS{f@oGhCfCyKmf@cJRoMnPuO_HiZiTwNjTqX{Bwn@xE|F~DeKaH{sAlPvTqIbHhFpFfiBx~@hGnTuTmK{BlKiCqMdGaJat@xKaA|GhRx\\\\lp@da@_HeGtEqGj^_JfOuYqJqH{KhTw@gMrMuJiMJ^cIvgGkeAx{@~R||B|pAhSv\\\\fd@fTzgC`f@x_CrOjgAfW`kBzDpsEae@jhE~SvbAsa@ta@qAhMfRtJr`AsElKyW{Tm]`Sow@x@`Bu]qRkNc[gDuVfGc{@aPuYdMwAv_@zQlQxVgDlGcNrX{H~XtBzK}Gne@zs@bV`~@p_@?dGg]x`AtC|OgOcCsRbf@@yl@sOfBoSlx@d\\\\diAzLl|AiHfC|G{KqAsl@pYyKkM_Xw@wUdRoBt]`N{IoGwKrXuObm@jOgDhg@rNsYhv@]fn@tn@nh@wi@GoNi]{CjA_U`oCc]hLdGrXd{@teAxSaAdPpa@pX|hAdBpqBs_@xgB{`A_A_JuEjAvKaJfcA{Hbd@tjBbObNhC|h@qm@rr@~N~h@{Nzd@tCba@oVdUaJaM_NjAoc@|~@_n@~ZnYtr@c^nd@cTpkAsTza@tkAhaCrB~[qbCvgJ}b@vv@pGzS\"],[\"qqtEzaar@xDjEoF`F\"],[\"c_gFzgbr@of@dEuRmCqGyLt^~Ifh@}A\"],[\"}dlFtcuq@}Lh@kNuMrDcF\"],[\"yoxGppoq@D}VrZdA~@jn@\"]]},\n" +
+	"{\"countryCode\":\"NL\",\"polygons\":[[\"c}n_@sokCnd@Op\\\\tM|_AaMhi@vE~lArdAeQ~`@cYwKsn@d_AwmAl|BnDp_Aot@`~@iGze@tOda@`w@fs@sU|zAi^jCiYs\\\\aNlB}YeSkOaVwTjGgKny@sLbEqNyHa@}u@pGoVyWiqBuLkrCfEy|@dIsVpr@aTp[siAeA}g@vGnF~PoC\"],[\"{_u_@wx|B]uG`Hka@CxAXfAp@|@jAp@oChUcC`J\"],[\"y`g_@cciCdxBdUjLjJ_It~AnHlzA{NdMwKft@tOnw@nTOiIzx@|DnYy]ne@}GdzAoh@n]c]{n@mb@jo@el@}sAxIsr@{^}q@c`Axm@gHh\\\\qGc@VyPai@s\\\\}[{r@hG{e@nt@a~@}BecAx{B_yDbYvK\"],[\"wzb_@mfbCxCxInUk@rM{WfJ`l@hh@sMtLy[rLkbBeKul@|t@ym@bq@hU|`@sG|j@lwAr\\\\x`@_HnhB}QuDyE|ImD|aBci@js@lHpv@OfmBkj@vWyL`Wo]oGcE_W_NgHuf@rW}Ylg@~Qb}@{WzW[jNsf@nTqKiFeSwlBiThy@uIwJii@hXo\\\\viAaFgVtDsb@iU}p@xKul@kJeKyGoo@lb@ko@b]zn@xi@c`@rFqwAx]oe@}DoYhI{x@oTNuOow@vKgt@\"],[\"_wx^ggcCtc@r|@pHg@pa@{rAxJ]t_@xh@|RneCnP`h@iFvs@hSoA`ClVwHz@}Dba@oKdMhAtWkTj|@bFfT~Ymf@gF`l@xVh~AjWkSrK`@|EbL_Sd_@xAtOjGF_Ah_AoVr|@wMhJ_F~wArMxiArUnGnP|]zFlqBoTo@wRlcAi^pMyNogAqHr@cn@mg@kEqb@zIkk@mPu}ApWa_BmQOwXtZst@xJrSnZqElXoR}WeMuAoWlv@yKcH_NbY{\\\\oFwDklAoq@oi@}r@ceC}W{WuXkFZkNtX}\\\\yRax@|Ymg@tf@sW~MfHbE~Vn]nGxLaWhm@{\\\\qFyNbDixAmHqv@bi@ks@lD}aBxE}I|QtD\"],[\"mtq^icsB~GfC~Lur@tPjGjJiMpJii@r]|Fzt@waAx^oFbd@zF`FmH~YzGlOr[paA~w@nXsAbDuMkIol@dHfRtFcLno@veBjZ`[gL|b@xDnPjo@yLeDuoAtZjAtL{f@fa@jCrFr\\\\zWzExBlQ|ZiOk@`}BiLnX~Jr[cCjJwTqFcKjd@qVnJ{c@q}@y\\\\_Y?~Xcg@oa@gFnGiMuCcAaP}TyBgCuXqHfMsKyI_JbFpAx\\\\sRr^|Azk@qVfbAoH_g@mXcHiZs[qVo{BgRcd@ue@nc@cjAzVnEst@eEqmA{`@pM_UjZib@zLkIzk@kZrNyAuO\"],[\"gug^umbAmP`u@ydAfJ}X_wBrh@ydChCap@{PckAeWuP|^cuAb@sTkKuMzD}XjVnG`TpWrw@~uCR|hAcH`PsTfBmUdgBzDnz@lXdG\"],[\"qhj^urrAgA~Ma\\\\~LpG`Ta@dw@eY~z@iChc@x^ha@rGxm@of@|tAvDxp@yGrm@gu@fv@k]{eAcIutD~Xea@jKjTrJeyA~LwXdf@y\\\\VkbB\"],[\"wfm^asqAuH~uAoa@n_AwKwp@zCigAxTo`@jNiC\"],[\"oco^iwpAgAh^sOtNaFkKvK}h@\"],[\"unp^{ymAxH}d@`Ii@lLxZnDbz@kJvc@oO|HmRtn@dLlZ}Gdp@eSw@oM}lAhEg{A\"],[\"qdk^ee}Aue@WkKf[bEt\\\\bi@d~@oEzbAkWiFcEzKpTtsAdDqFtNxDdUuUxIbBvA~g@kIhg@g^zJ_Oa@gOqSoL`DePhc@iUmGme@dc@eLiF{J}sAc_@kj@tFswAsMupAas@wfAkB}p@sLeW|Au}@hYya@tGel@nTn@{FmqBoP}]sUoGsMyiA~E_xAvMiJnVs|@xDwkAdNmBjI{k@hb@{L~TkZz[uMjIxHqFjTjGnn@oErt@bjA{Vte@oc@fRbd@pVn{BhZr[lXbHnH~f@g[bIiNfYjP``A?pkBgUHwG~IeBnm@ee@fa@_g@kF}Vv_@vq@vz@cJbiBcGsI\"],[\"kvo^ihoAkSgH}B__@qRz`A{h@nVcOv_@pLtcAkPxDqRw}@pGat@eEwQ_Wl[uOcFkp@lPeJ}mAabAgxAujCsjClEtDnG_^Qee@xYfYh^fKnC{b@kLo`AlMqHaEmYf`@gz@hTKcCpV~KvTxEcQfV}FBg[l]|o@p@k^pFwA`FpQva@kf@cf@_gC`Vqo@bn@lg@pHs@xNngA`O_B}At}@rLdWjB|p@`s@vfArMtpAuFrwAb_@jj@~JltA{Klh@\"],[\"ge}^q`wAkiBov@gtDil@qh@mYi`AcT?sk@vVtClJsMpBeq@yQwg@mJk}@ka@q~@vxAgq@|m@ycAh~@bFaEj^`GdRfVbInh@bgBlh@cNru@qm@?fTdSlb@fSa@fUka@`EykA~T_t@rCz_Ahv@re@`KbaBwUzEqScNqQjPqFcU{Bbl@do@z_DmOdObLbtA{GbPot@kf@Pde@oG~]\"],[\"kil_@{p~AzByK|PnKjn@z{@w@`j@{N|Jya@gOu_AkjAfCeU|[wK\"],[\"sij_@kddBcm@crAcHoh@elAsi@er@cyAq_AgrFaBg_CpNxHrLcEfKoy@jUkGti@fj@nKaDz[f^xYl@rW{sApGb@fHi\\\\|TaG~c@mh@jVzRtMv`@yIrr@dl@|sAxGno@jJdKyKtl@hU|p@uDrb@`FfV{T|ZNhd@h[xkA`AhsBqn@vdA\"],[\"ann_@ih~Aej@gbA{Moi@rAm^bJti@pp@|mA\"],[\"_qr_@w|hBoMadB`KpMrCbu@zG}BrAdGza@taDuHjXwI_FcLqk@\"],[\"mhs_@gymBwB{tC~FoXjRfkDsJxYeHsB\"],[\"abt_@kixBgB{|@pFmPhY~bCqL|A\"],[\"e_g_@yboBzT}Zn\\\\wiAhi@iXtIvJhTiy@hX`tBfx@{Slg@r^|r@beCnq@ni@vCdUTrw@m^jlAaExkAgUja@gS`@eSmb@?gTsu@pm@mh@bNoh@cgBgVcIaGeR`Ek^i~@cFm@gtBgYu|@\"],[\"iz{^qshBz\\\\nF~McYxKbHnWmv@dMtAnR|WpEmXsSoZrt@yJvXuZlQNqW`_BlPt}AaKbb@~Ezq@oUni@bf@~fCwa@jf@aFqQqFvAq@j^m]}o@Cf[gV|FyEbQ_LwTbCqViTJg`@fz@oTsxAmKsLiA}qApFbUpQkPpSbNvU{EaKcaBiw@mh@qBc|@\"]]},\n" +
+	"{\"countryCode\":\"NO\",\"polygons\":[[\"wjci@oxqK|GpdI~dAgtC|`AsHlW`JnaAz~@t_@~s@~g@nuBzf@ipDxmAtjDm_BdfQvA|tFml@faCdUhyCwq@j~AhF|cHzRvzB~DjnH~c@reC_Evq@_JnCnBuM{IkPiMvc@}LpE{KsDoQ{b@mh@ibFnZ_v@zKuDzDqo@}AwJgRxt@eLCySo|A~FcjAvZcw@sCygAnGe_A_Lvc@sDxmA{`@~w@sBjQtJl^gNvv@cd@qlCrO}_AlEaaBtQie@mQ@gGfZ}WxhDye@{tClLie@Qsr@cLzNqK`iAwKoGtThwDe]o`@cGw`@iO`AoDhYc{@mvBoGz\\\\cFaPwAibC_Pcw@mB_xBwMxq@e_@iT{B|gAgHwHgURoJ}Fg]ykAoIbDaWc^c^xNaRwbAvD}c@v\\\\kd@zW|Ig@ur@rMsa@vp@pC~G}KnXrGsYeRyj@eBmIqOhg@iV|U_w@RaFwNAgIjQqKie@Uqv@zVeuBsFIcQxc@sXbtDeLjc@qX~PkM~kAmPkTuLtKuFsBmUokF~QgF|JjKfBw]`_@_YfJkm@pRaBxZgqAdD{|B~L_SpR~PjH`h@yBnj@bHd]lGqE}K}l@rLiL`EpMtJk_@uJcp@tLc|@eDaSoR|d@kg@Q{`@b}@kCdoCg]|XsQzn@wJjDmMcG}Osf@wIqmA~Omi@{ZzStPvqCmLxU{`@pGyo@}vAwYiGuIm_A{Mw\\\\?ayBoUavDlDk\\\\v\\\\|_@dTv@zX~`@pWe`@`kA|{@jNbAtUyMzLxi@dVb_@wZ{aBsk@wUcHjIaj@i]cGnGrC{rBhOgqA}Bw_@y@rq@kWrkB{`@xS_R?gSq`@}JtB}Ksa@uc@_p@rp@nVeBaWqkBmcBre@{@jLyRwg@HgZyi@nA{Zzl@{~@fGaDzRzNrX|BbOvQt{@b[f\\\\ig@l[bCzP|~@dI{QzOxT`SsDzN~UqDhOzm@f\\\\fa@rsBnGyK~a@tt@zBoRql@qkAeb@a`Ckn@io@ea@gu@uS}EwL{Qlb@{eBxNw_CiSbsAsb@fk@eY~_BeLpAsb@m`@uNbCqS~_@wDicBeQ}wA{W{WwV|Saj@c{Dtz@b{BfCge@rGwGnGnP`Rsp@{DaRaPda@cJel@sIjE}H_eAvBoJjHdDbZr`@eDy_@eOiZye@pG?}gAaP|gA}WaI_\\\\kg@}Ce_AvWmfB~MgFhE_VbPlP~H}QbQ_|A|b@yzAv\\\\}_@nP{y@?nm@zDmMjC_e@gIgVwKpWsMt@c[|vAgCc_AyGxBkKfr@gh@fIsVkHo@uz@xBuE`NfEpIeP_p@NmGye@nJxyCyNdi@eJiX{B{fB_Uqo@{MkIjUdwA}CliAbLjTvElt@wVjaA?zpA{^r[{Nqd@tCdrBsXl_A{HmNpWwvDk@wv@~s@{_DyUkv@eKe|A~eAgkAzS}Djd@qo@lTmrCh^rJpOo[lXdc@xy@}~@lVox@xP}cC~`@~~@b^fd@xKcAnBzkBxXtdDnm@oMtg@}fAj|@ihAlp@dE~Xtb@rKbs@~MbtHmzB~oDsPhyEl_@neClc@ng@ny@wkAjZzb@v@ph@sf@`sD\"],[\"}a_i@aalIn\\\\_y@`[l}DkEdNqVeP{Mwh@me@gw@[cH\"],[\"iq`i@_aoIoKT{CgKt@gz@hOst@nOyTze@zvAtErn@wLj[y^dKkStk@qHhA_FcLvf@{jAx@eb@\"],[\"myai@muuH`XdEjRiOb{@h`@ba@hcAoFox@qZy~@rPgTdJtBle@r^lR|e@vKf{@~j@cA`SjVgLaf@ed@sGsMeYcZqzAdAeMjZ}SgDqLcTd@iW|\\\\iQkm@dBcaAuNrFsXnaAmQs@aPscAjJco@cHaFkFnSyU|CtSod@xKslAlToI_Eu}@tTje@lKke@`JzQbRy@|Gg]tOjYxDiOhIf@z]ji@pOcBy]l{ChVreCeBf{@f\\\\te@lv@~mCkT}R}XrTmq@kW}j@__AuMxD}L`]gJv@us@gmA}^}Ve]|E\"],[\"e`bi@{pbIdLySb^tFfFjdAcIxq@mh@frA{O{ZvSicB\"],[\"ueki@g}_Jl^mEdF}IbJvCfO|gBtGvItTyKzKuXnGnv@vZ_k@nAmd@pGxKzSrwHm\\\\}XR~b@zgAlpDvSnQzBoa@jSxs@iBru@_MeIaBiW{BpGcFaDmE_\\\\}FpP|QbrDyHhZiJ`CkRgZjHwp@aMnA{BieB{GsGcGlGsXqgAxKxkA}Gh}AyKyKgCfX}Gih@vCwwAcPyc@xA~UqVnKjLlJCzQiD`d@eLuO?r~@sGfDuBaJbCifC}GjPyBqGqGhn@sC_j@yDjOn@t_CyKoa@oGrC?oyE`PalA{\\\\fhAEweBwGw]oO`}Ca]fb@fCmd@jTuj@wKdG?qv@uXpv@{GaXhn@w{DmPba@_TdNqQfx@uJbHmPsCr@y]~p@c~@hD_XiFiUwKjP{c@hC@aQxc@yg@a\\\\kg@F{Xb^aTnTb]|UlK`JyBsAyO_\\\\aTkN}_@bPoaApGri@bKgFfG|DhLhZlJoe@iR_M\"],[\"{yri@karJ|Xse@xMbAfg@fxArMhjA`QnNzMqc@rJkDjS|h@{BpxAjK~g@bP~cFkT`h@iFdbAuHzNcPgYoG|CjAq`CfVk`@mOaS{Lso@^dcAsj@h`AkBmPrFodGmPrnDeUpNcSsa@xKg}@hOWVcKc\\\\_a@_De{@n]lFrYwLjOqZQma@mJ`Ouo@c`@qf@hgAiW_BdIat@eIkc@rKsUxKxK\"],[\"wdui@oefL?si@xKfX\"],[\"oovi@stcKgEy\\\\fE_]\"],[\"}fvi@gjxKsC{vA`QdiAxJhBlNds@oEdu@a_@|ZsV}XaCec@\"],[\"ikwi@s}yKzLoBjH`x@cP{U\"],[\"oovi@_e~JIk^tRqGjThxAjZvRxs@rrAdUf}Cw^vo@un@pmDqSbUn@u_BcNk^yFtv@_JhGqHmG{LurB`LmZwGm[oRj_@yIbAxBaa@eO}v@bBqJxPcD}Kcg@jCcOv\\\\jSpWu}@p@kPeNb@iKmK}Mw{@|DqWba@qC\"],[\"w~wi@uauKjNu@jEnMsGpr@bPtt@g@`HgXaHgKlEXfPdOE?n\\\\hI{@nAxGcEzm@sTbYy\\\\cEuUyVsLwaAmNoIeAyjBvOqw@~y@j`@\"],[\"{`yi@{geKhTmjBxKnpArIqCpH~_@oCh}BmJtOm^b@}_@ta@me@z_B?aa@mJ~@wLyv@dX{MtWsv@pXuNiBkQqXmPf@qJnJc^xRz@\"],[\"o_ti@ijsKh_@cJjKvGtMx}@eCr_@{K`PuSs@cY}SaDobB\"],[\"sfvi@i~aKlQ_ZvbA|sAbN|qDct@keAyVazAwXar@\"],[\"gvvi@wqkJ}K~DaUoa@kPfE{A{JvGmd@tYwNg\\\\as@hSst@nPtg@nOzyApTrf@}GvRcPmK\"],[\"wvwi@{awK`C|i@sKtOaOrB}RgWvBuNbVec@\"],[\"q_xi@ur|JcKnoBgHxMmOuR}C}Xn^ifB\"],[\"qbzi@oyvJvO{CrKnNxJ[lKz_@c@pk@_UzaAoJ{i@zFiToPL{HaXsU`\\\\Fyd@{IeItA}Y\"],[\"qmdi@}uuIr@sNdTvSvPrq@fRhWlCnYuGhSmZuKaSqa@\"],[\"osyh@igbJjKtSxkAxKbzBa`@bXtL~rBpxCwqAtuJvg@j~@lxAfpG`{CzkB|v@pmAzM~`@nV|yC~l@n`@`jCgaEf{A|LffBnjF`sDxeFrbA~vC~|A{a@dh@B~jAxjGlPp_IzhEwbAh~@bv@vrCl^rzAmAro@xrA|mA`]kJt`AnOpkJiGtwHnh@jiA`Mbt@hWjB`K~oAzLd[GbsAcPvg@mEfvAxHrnA}Ovw@qH}DmKdN_I{B{WylBRe_A}RoUiEmoAyBfTwn@utBuPqIqt@{qAjqAzhD|^jfBiCvrA{NaGkBqv@}I{P}Bz\\\\yKdG?|r@oKc`@oC}|DqGhyBpGjc@qGgCmBhQlBjw@fUhj@[hRcj@o{@yEicAoMaPzGvi@e]qv@nGwIp@ws@`Qya@oA}TqN~PyDjgA_YnKqXd]Iuj@vP_OqGoPle@ucAme@tr@gJuKyDspA?ljBeLi]}Cvd@uN|RgH}^cLtUiXfBro@xd@}Dl]aX}d@kLcDda@vz@aCfTqIzC_[}KuCve@kT|ByGoHoq@aqAlA}c@dIwOtMcCbAe]vTjPpLyqAmD{[qIbQyBpm@i]zScJzf@gOzPw`@wu@rJal@eG`GqNcCgD|INfr@qb@eR}T}W}VwaAa@cd@rXihA?sm@`PkPzBu_@|XyUeB{Jqu@d`@oIhyAiTx`@cIgW{NfFwI}JcRsj@rXdlEqGmGgCna@iFoIid@spBkFyhCuH{Geb@m}B{LgiCfLy]tShs@?}v@n\\\\|\\\\pP]g\\\\{_AmDkjAzJie@eFgGiGvFwG`dCwTwRiJsa@xAwdAvTz\\\\_YelCuQwMm[_mA{IyDyDjGzB~Q`S`tAvUhb@cP`_BtYda@rFpp@iLvZcG{\\\\}Gz\\\\`Rbl@fJzeCtUrr@tNveBsJnDae@eqAwX{aBsDedCuCxpAwKg]Ulu@sUt`@d\\\\wO`RldAsBfw@oHeLqFtCnEpeAeVhVcQcwAkOqQeNpGyI~`@eGge@oa@zvByKspAzPoaArd@gI_@mtAeF}T?lpAqYaL}FtPmPunCtTqpBsMx\\\\sNzeBcZaxAn@amA{EdWxDlrBhZrp@~GfrB}CjT}b@g[cF}~AuOnpCeHyK`LqaBeGk_AxKuz@cV~h@eWf~CiEcRhEgsCxKoTj@mfBuDaOoGxK}@q~AgJiW?jnBdLhtAmEtPgQiFeDixByKrxCaLuTcC_k@pK_mFi[l}DaInEiCwKgE~`@wRhGyBrcAcIgMeIy[Vw_BuDkUqLnKhAep@oPcf@q@mk@zKhHvKhi@rRyZ}AgN{B`P{\\\\aeA_YlQ_Wo[zj@}]|JiYoF_n@gXoY_Ka@cChNkEcOdF}_D~QcWqNiPsHbH}NdcEsXwi@yLxC}FyTyBqv@vUrUnKoCsQg^oGpGgCagChU{l@mL|@{H~MyJxaCaNew@zJ{\\\\eQcpA?uzBpG`a@pK{ChPfYp`@q_A}@yG}FpAuVbl@a_@{u@yD}m@~IaUdAmv@fU}eBdHcFvZfJ`P_mAaPff@eL?iT_Y{\\\\v_AcG_p@dXiqD_Tz\\\\sCqGdFp}@qSzzBxQjjAmDjOoFmi@qHkGyB~mDsL_n@sXaPvJt}AfL~MRheCzGae@bW`dBn@nb@iToo@aPaBzMjlAlEryCmP}r@kbA}uBiIeA_BhQuCuXaHyBWqmBxH_MxK`PpCoQ}i@ekC?rlC}GwKWrc@mJvH}CbYyUai@q_@mdD|Xq}@zBn_@iTr|@zGlL~Swi@`Q~ZnFdpB|BetBgPcf@gK}eA~OkKvChe@nGcLl\\\\tTeQsp@qQ}MkAotBpLmZdr@aj@lPgCh]c{@dMxXzAka@aP}XeRbp@ue@lUiRlk@wTwi@{Bx`@oYgj@mXwtB}BydAeIrQhL`|BzXzhBuJbaDoQxEqJoSiCxK{DyTjEqsAvM}N}IqQcPp\\\\sCmK_K`SxBpUdGmGhCpNoKrs@hIjq@aExHkOeJsYwvAgCve@kOkOb\\\\muCwKbPnGayAgR~cAtFle@sUfr@qGewBrj@w}BkHaVqGda@yKgCkTzaBiTye@bM~{@cMvqBiUuTyPhGtUrPoX|TxG|Fn\\\\_Pzv@f}B~k@dwEqIhcAqX{|A{Gb{@}GsFeGsiE}At~@cMnLqGsXxKj_CgCqGcMjFhCz_@{JhNqFyJ}TemEoEzOjIllCqPc^aMa}@pMqqCvF~RzPh@_d@k_AaPepBLud@~XdXjMkQkVm@oXaj@mLy{A`Hyi@xUsK\\\\}JqW{NuOed@{Y{MwC_z@yK`dCyQ_]qA~KzUdv@oAvsBwUrb@h_@zcBnGea@`HhXSzXoX|m@uu@qpBaDsZ|OdAtGkNyK_E?}ZgMi\\\\mTsGb@yL|GpCdGgn@pGhTnMmVjJbPeC|x@|V{Er@kVeIfDoBoF_NmlBoUoq@kVb`@wI\\\\Rqk@e`@uC}EyQlCkc@bYyNxKbUnJeH{Eea@dGgCx\\\\lT|VsKnIzBdP`Yf]i}AdMaJhh@}DtDmI~Bg[nj@a`BhAqo@sGda@sGnIgKgE{VprB{EvGqO_Li]?aPvi@}@ai@b}@muAdCw`@_PxKqGyKoJ~j@eZdf@aCi\\\\x[mk@b@uw@yIfp@uViVqG|sAkQgN{Tom@fZo{BuKkOyG~dA}Stn@vUl_BuDxb@yKoGyKle@`Cgp@mJaXlCgYqDqEiCpGwB{IvBub@iT~d@jK}mBiT`lAaPpGxKp\\\\tAbqCsCdEgEiLeJsjBqZr|CwTcj@pEicAdN_USuk@|P}iAbMe_@`W{@ve@cuCwZxk@oYliAwKfKeI_FyKvUaMd`BcRzy@dBj`@oClMeGgXgCle@uVw}BcIyhDrSqlBzIoDlRba@q]o|D~T{a@fVqGdI{OvPwaAvMxKbNyKtVnFz@sHgIwQgYlFoEki@iRtQol@jgB{SpIoK{]cGvCo@aq@~]qzCea@tcCiHqlBjI_iBzO_bAcDma@ec@faD`AjyAqr@kp@~OvpBx\\\\h`AdLldCmGb[sEyp@cMuEaQ~_Abe@bvArC~qE}i@~[{JuKgHub@xDgW~MwM~@og@_d@seC_EknH{RwzBiF}cH\"],[\"ensh@gnaHuM~n@ca@}w@[k`@nNcG\"],[\"_huh@oitGXaYpRaPfOfOzC`YmNxb@yLs@Ynu@yHjJs@kWqIbGgBw^\"],[\"cgth@k~pGoKcd@`Pyk@b]b]tBl]xKnPbGflAcGhTdLlT?li@jW}k@rJ|k@iLjNDvOpNfNxKyKpCjKKpa@eCsCuClPgM]}FrQRpUhJ|IhKuM~VnVgP`k@kUcQ_`@s_A{AhO|Rhs@aPiTuChT{Be]oGd]gMuUqIu}@yHlgAo_@mtAjOq_B}b@qr@wHo[lA{f@zYmNlNdX\"],[\"ixxh@{i_HlAl[yLdGo[r}@{Pug@hSumEbNpDhU~|@\"],[\"quuf@kgjFzKaRoCge@fUoWqFxr@\"],[\"k}vf@qwmFfGss@tQfWvNbfAaP|mBs@ij@oL~AsEuIqGqe@uAlQwYm@mSal@jAoXtYyf@\"],[\"iz|f@cerFnMuM~AyRrh@jiBfChmAxLAxLyU|g@lgBcB|Osr@_j@{B~Xi]}X|Bqc@sOaRkJn^ok@ygBmP|GtLva@Qfe@oGwT?jPcWoUsHaBuCvK_OaTmCyHb^o^dNkl@jXq\\\\\"],[\"__bg@eniFjNn\\\\|SmG|Xba@{Gri@{B{KuCtcA}m@wg@yHo~@\"],[\"q_hg@g_wF}O}~@`Eu_Arl@fnD~i@naBj@xTaP{\\\\fCtcA{|@okAgS_tA|CiMpXxT\"],[\"mpig@{qtF~d@~mD_l@usAhAvu@mPmpA{MmHsMeu@yIvj@bExl@oUaeAoJgDrHkZb]kI~Nng@?ym@lKfTrCcj@\"],[\"sy~g@axuGl^hTvRn\\\\d_@eM~PhMj@fTqR~EcQh\\\\_I|aAsXcj@}G|AdOiz@sh@cz@\"],[\"i`qh@e~}FbG}~@lPje@fCsXrF~HnG|j@nHmNyJkUts@eGdAds@cQvf@nLqDhC|N|N_u@vUpSnMvgAfRnOjHjs@}Zke@eNhCkaB_uAk]{z@\"],[\"usqh@ikbGoMoHcAyTpQ}aAveAtk@uI|ZvZxr@__@lMhPvYoXrAmYgk@|Puv@cMkE\"],[\"egvh@i~cHpGna@pGgTvr@jwCyCtHsIy@}Vi_@iKza@~OtOnG{ErEvDvMxj@bG{\\\\rNlb@lZpoC{Bne@nG}BhB`InH`fAoMgLwNfLqXqaBdBfr@{FbLcG}m@mF~LmVp@iDaa@vSkVrAsQ{GhFeLiQtFa`@aWlQpCxLmLrBqGba@eGscAxKc{@qXz\\\\aMydAr^w`@k[cCsEg\\\\bNme@lKFjRli@zAkQ{Rq{@}\\\\uItBeeA}Km`@qBecAxw@juA|AnKyK?\"],[\"mvxh@iw_InMiZdLjz@fQqY`BtHlA|gB~Q~aAmJzP{Qmo@{Bda@wg@a_@kN}_@_FstArHk`@\"],[\"amzh@aeaI|EeE|KhNoFjsArU|~BoHjU`m@z_@bJxXv`@r[lM|e@NjP}m@aD__@lGzd@lLlAxm@tVcYgSti@fSnPxKgCrCri@|B{\\\\lc@dr@iA~XqGoGkK|i@hEx}AwAnVcKzH~@mKiZsf@yG{h@aj@ouAwTlj@nTsFpb@tfAc@pa@j\\\\x`AnCxh@bHnOpG{\\\\nQ~|@`Vb]dJht@cIpGoGyKeHlF}HuG{Nwc@{z@ylAmOen@?mv@qGpgAaFyCo@iv@gCna@yKjEsC`y@_TeWe@_z@oAgF{H~G{Bst@bP_c@{\\\\|d@rC}d@qLnG{BycA`KeXqB{X_R~qAyWva@{XQqFcdBlT_cAcYdm@iAjeBuD`Iqa@tCrC_[cLwR?icA}Gpm@eGhHoK{AmGmk@eLlP{\\\\g]_D{R~Cu~@uHmp@|Fm^~L`Td]}E|^|Vts@fmAfJw@|La]tMyD|j@~~@lq@jW|XsTjT|Rmv@_nC{_@_w@xE}i@iVseC\"],[\"y_|h@gexGuO`PqE_FuVmcBwKv_AsWm^iDed@~b@eNtEe_@cGjTmQ}JqFeUuIzUcM_LbB{b@bi@MgWeaBxBcXbLdFrDwT{M{V}SqCmPd]yKe]|MaW`]iHyc@kZ`UecAsNfGyUbo@oK_Deg@bi@aJ^{Q_o@e@g`@nNaRjOnIpLoa@bGpGnG{\\\\nPpa@pAcQwVub@~F{MrXnGrXrXcTgt@~Bic@z]vSrBln@xKkPpGjPzRqiAdVeA`N~Lbx@jbEwBluBkC~QqGyKwEpb@yPaP{Ls|BuP{`@_Cqn@aNlJaR}AoHhmAdDfS|G{m@xUv]vA|d@qL?`]dc@kDhWfIjTuCzJaT}Bn\\\\ng@cXpk@fp@fb@^dg@lJnVqEt_Ao\\\\eH\"],[\"c~`i@mhnHxRlg@i@hSoQ|Fe]}B{^uTwx@ucA{a@qiC{c@i_@oDiPwK|BqVcdAqPanAhNe]ntA`m@p_@`pAll@r|@rYxeCiO|g@bAvV~JsRnP_E\"],[\"osmg@anzFjYmAdPz`@aP~lAk_@{x@cIS\"],[\"s`qg@_w{FlCaOtc@nPyAlRwIxC|NlJfU}IqQh|@qN}]sStSoGup@jNeM\"],[\"y`nh@yviHvCwDlFxHvDdUtBrw@mAhqB{OzJwPwEb@aq@iSo`@hN_AbJkRbOwkA\"],[\"choh@kcvH~EcA`G~]kP~_BrOrdAyIjsAkMix@e@oqD\"],[\"wznh@{egHmNmEwNs`@hd@eb@jEr~@\"],[\"ov`i@{{cHlO|Vd@fh@mJr_@qRwI}CoY~A{c@\"],[\"c|ch@ilxG|FwAzL~[nGjvA{f@uyA\"],[\"gwvf@q|vGtQjLpwHl`L|x@yxDdHojA`FoU`NuJv}Ca[xgBjnBfExY{q@xwL`Sh|Cbi@|lCzTzl@bzD|qFxc@xkAjlA}]zrBbmCps@ci@|CxcGkvArqB_JbhIgS`]_d@hD{KiXcLtuAwQxJmLwL{HswAaH`h@wSah@v`@jeD{n@a}@oO{bAsSuXuTaoAbH}]aCam@qf@ecAwH}vB_Mg@cEfUqGqG{GbMyIhuAxDzoBcUkByHum@uUe\\\\oVodCwMe^yG}EqHjrCuHpK}g@ajAdExe@~Trm@rg@l^|c@vcCt^bx@`VbqB~^zfAot@akEcAuT~UujAzNgJfMph@l[nQzUli@rZ|sCx^jj@dd@l|BzGbcBlKdZmRjQgNz@eVmUg_AonDijAy|@{cAwfCuKq@aJwO}TzAqg@uy@gVt`Ac`@cLmOsn@gk@ma@cX~kBa\\\\nq@qNhs@cQxWaHsGdR{h@l@_h@dMuL~Mwq@mDoK}Svi@}DsJeFjDmKrvAyKy\\\\fCi]}G_E|Gkp@_YpXmAgN|Ugi@qJaNzBeXyKhT?}XcGzGyRgC_RdXoKga@vOuv@r_@}HnT{w@pEyElSpHcC{aA`L{k@fcA|gA}@aM}Z{_@wTyv@{B~OqXy\\\\pG_TaYadC|GpgCi[iMeEip@aP~SuCii@|GtOtAmMgd@abC|G~xA_YhCwA`J~Nfc@xB}XtL|Ih@vd@uf@`{@w]hNmEmPwElPaGgs@sNm_@vAss@aPdf@cPksDkPb{BeGayAsL?~GduAsXa{@w[ayCqQyk@xKwcBwQrq@gAnw@vMtwAlc@~jBlLbkAnOeBjSzxAx^zm@dK~eBaPqeAg]}o@xE`s@sInZMdk@mHcLmNmhDa^ikBba@hyGmg@o`BnMo~AiOiXxK~d@mPhb@yKur@tCin@}GlKwBgG~FgTqPeaBkBgHoF`RcKaEw^ueBhGgTdO{@aRuQ`V_]z@am@~LyBn@ii@nMg_@yHsnAlEgvAbPwg@FcsA{Le[aK_pAiWkBaMct@oh@kiAhGuwHoOqkJ\"],[\"k|kf@}l{ExJeOF{eAlNcOlYrTqHb~@yN|RrBx_Ag]d_@sHaGb@as@iOvSpChbAiOb\\\\mQkIaD{RlXuxA|JaX\"],[\"orrf@}rwE|@jt@tXv|@pC~p@k_AojDbd@efBfa@zrA_Fxt@\"],[\"kcrf@wvrE`Pl_AoGkC?jTud@sl@iA}u@i[mcBoHSnH}q@lg@`fC\"],[\"knlf@}|~ExReHpGp\\\\qRfv@yIeNcZl@rMqNiHa@\"],[\"gvmf@gu|El_@`WyWvh@f@fl@qKkDk@pGgS_tAnNi_@\"],[\"szvf@avdFxDuH~Vv_AhCaPlKtRO|I{JyBzLzo@qFbe@cRsFiNy]eD}bAbF_V\"],[\"_nqe@kpjF`~BydBxy@zoApkBif@b{Arg@hvCutB~ExbAuSfbBkKhuBtIv_AiPjzAkg@b{@sbAdvC_^r^o\\\\tHqGhZoP|mChW`gB}Ohb@xCfu@}Jb{Dx_@poAdj@ld@hZtKd}@wPzGfJrMn~@z\\\\ni@Ixe@|k@bl@dFbsBcaAt{HqWun@uyAwo@wJ~n@mzAvrCw_AymHy`@gUsg@h@mi@{pAmStw@{uAhMuHn_@qA~sAzKtc@zZn@lBvF{GnhBhMpv@wBvv@w^n`@oMguA^hm@uyAw[km@joAFcVzRac@yMmE|@caAoHpQmSySrGs_@hTme@lYiTlSp[v]|DaVa^_S_kA}IeiBuMmY|Rx`DwQl^iSqHc]k{DbIr}A_T~m@rXoTEfr@uCxYyJtJiLkGcCmr@_LlChAug@iSah@TsKxRdEnFsNoc@qx@lUuo@wWbPoDpa@ma@iaCzq@a~@lRsGlJ|CtU_y@`^ue@p^`Sf]nz@uSqoBbVut@[ixAzc@oI{^{KoGpG_TfzBqXjPeSe`@kPa`CnNkl@mHkm@hO_pAoL}eAxTebAoM}RiBy[~c@iDfSa]~IchIjvAsqB\"],[\"}`xe@udfEp]`gCiLve@yd@zQ{GyGeG~i@kN`MsIaMwKtXeGqG{GfCaSm|BiZo~@dLb{@mPiTuD`EthAlnHwX{M_KnH_Y{kChD~yBqHbUwZahAwZecCyKhr@wJm}@vJaT{b@{uBv@sq@aPna@n\\\\d{BsMoQa[c`B`PzvBiT{\\\\wYwGVqKaPsCsSrQsMeJdQ{y@kFss@_M{NfCrcAkLiO^mUeLpG?sXmD|JeSbD~By_@gGqDqAgh@hNko@sLtDsO~iAsOav@qGdC`Eak@sMtBsCk}@_J~XcVKgCyK`Pmv@sXda@hNoy@wEs^qDbYwWx`@?tv@_N{SzM}nApNis@`\\\\oq@bX_lBfk@la@lOrn@b`@bLfVu`Apg@ty@|T{A`JvOtKp@zcAvfChjAx|@f_AnnDl\\\\xV\"],[\"{pxe@unqDnBiV`Qfj@|[bzCz@zvAb^~dCFxeAyNxb@nG`TcLlLe\\\\sW{Za{@gE{`EuTjnBmV{vCFqWxMwJ}QsLgEs{@pFqFpGvKnVsRkGkx@\"],[\"sqze@ghbD~@h\\\\oIbYwT_yCca@ykCfCti@}Xye@}Bsi@xKnGhGyJt\\\\gHnU|SjEj]hItrDwKqj@\"],[\"{bze@_|qDtGi@`Lrt@qG|e@oTmZ\"],[\"epaf@w}`E~HeG`Hf_@mF~V}E_PgGhV}H}Z\"],[\"ct`e@uynFhc@wTp_@_Af}Hj`B`xAg~C`UizB~lBqcDjL}ErkAf]zs@z}@b{@bUy@~vBr\\\\p`Dj}@md@zbA_V~lDwwCdd@kJtp@tTvf@zt@b}AsShq@|XpkAjwBlg@ltB_DhcBtIfi@s]nS{f@rFag@oE}hA|}Co}@z@_v@l}Agy@jo@ia@`hAga@vLak@xh@wdBffGeR`I}SaPyHfD{y@noAiO~s@kYfh@iEl@st@{vBk|@u`AgX}bAc{BvfBkn@fDaoBhk@c@lw@`a@hoAsW~bAuT`jCiWkCc`@{Xcb@dL_Cdq@pPbCo\\\\|sB{u@`AeWgz@iJG}q@jeCqGlQyJr@uhBi{BHye@{\\\\oi@oVwiAsi@rQ{m@qLej@md@y_@qoA|Jc{DyCgu@|Oib@iWagBnP}mCpGiZn\\\\uH~]s^rbAevCjg@c{@hPkzAuIw_AjKiuBtSgbB\"],[\"aspc@s|fFxFrNfTbCrSmXtJlrA|KzT~_@fRlD|Zdp@bhAgEvTcNyHwOfMaOjq@}_@~ZgLhv@xi@fF]ziDl]rQfNf_@voAxrA|S~o@wY`Vui@iBsY~Qmd@bFzFtI_YhVebA_a@kMqXbiA}e@iaAgWpGwiAyHuXwOqIgjAzB}xAplBaIjl@gHgm@or@m[maAkkAsIl@yc@vv@_HpzAmn@eSwCeJhXeoAcYspCqFqJi]wCwQsYf_@a_@fa@wLha@ahAfy@ko@~u@m}An}@{@|hA}}C`g@nEzf@sF\"],[\"kaoc@ibgFbUkKbg@jFx[pf@z_@|~A~\\\\q@`{BsaAhdAiPn\\\\pU|cA|QpjBpq@xO~_@w@vbBig@WejA|f@cR|Z{AjdBqNxC_B_SaKpP{Rj@nGpX|K_JtK~I_Tz\\\\z\\\\cA?rc@yHqC{P|X{Eb^@tFdT{ClCfPca@|GnSnp@oN`LKhMef@xBbUxTa_@oBa[cUgLvZbPfTsDrO_d@pRyNqI_Rpi@ai@og@x`@tCx@kOuYaG}S_p@woAyrAgNg_@m]sQ\\\\{iDyi@gFKgMrLah@|_@_[`Okq@vOgMbNxHfEwTep@chAmD}Z_`@gR}K{T\"],[\"q{`c@}ewEbFqFtXnUrCrP{GtOa`@kF\"],[\"wrac@abtExHmAxPbRgAxVwMjI_IoOh@wFzGrE\"],[\"aeci@iotPuf@`nAmeAkNkkBujFuu@_gA_kAhwAuo@`b@ouA`oMqKpNcT]yVrdCgmBxgDjFt`A{DlnAzBx_@`Wx]ja@~mCpD`FzEgQ~JjXhPdcCuUhrCjKlbFpZng@jJoFvKfQlXt~@tp@`iAhOvwB~UbZ`YcZvMT`WhZjP~l@zJiKfM|K|MSjVh[j\\\\oUfc@vw@bz@z]xO|@bb@eVln@bLxPwH|IaUlKhBhHdYlUdPvi@r|@cEdxBpk@jpC~\\\\h_@bhAvIpYzoB`]b\\\\j@fO}r@bmAsPvw@qYxmEmU~yAyRxa@_Xj}BfIrwAvs@v_@rQf}Cfi@npDif@xmAfBjhC}^~nDtGpcBqcArVyTzy@{^bo@w\\\\tW}a@~tAq`@bo@_NctHcQgaAwY{Wej@{Ak|@hhAug@|fAom@nMyXudDoB{kByKbAc^gd@_a@__AyP|cCmVnx@yy@|~@mXec@qOn[i^sJmTlrCkd@po@{S|D_fAfkAdKd|AxUjv@_t@z_Dj@vv@qWvvDg[k_CvRsBjQ}Zc{@oP{Gq`@r]cYjKc{@l_AyeBqa@nG{BiTiJ~h@cRnLci@w~B|BmLhTx\\\\wMqdAlm@wLcGgUqVlF}@afBbHyD|Y|b@xe@kT`Pda@|AoVeFmpAvKm_BqKn_@_LjpBsr@{\\\\cDyObIqsBvMkIzEcbFhJeAdj@trAtTrpDaAthA`[p|Awe@}jJsXs}Ang@xKpZuLtAwbAvTjDpMeQvK`a@xEmRq_@{tBvJqNlPjQZsKkV}tA}V_l@yHnvC{_@zjAgRi~BsIiMaPj_AiG_`AeHyJ{@fwA{I`VmP_sDsFje@rFlnBgG{Ky\\\\o{DhC~~@aPkTfCrr@o\\\\_^_s@ssCg]yv@uFqo@rHufDrVoeB{HcBk_@jlA_JnoAqg@_dB__@eJ}S{j@xCimAxg@yhB{@yn@oMkHcLnGkVrr@gCoGsXdlAqG`uCwKn\\\\yHkM{VYyP_nAbLeGbFqb@hI`@zVajB{J}GsOp]gTyFFs{@{GcHgL|gAoGyKaPda@}Ger@cGri@}CcLxA{kBnVio@vKxW}BweBuHnIwPmKwAnImD}e@pGiQpQ~DzUdg@hJpl@{@sa@rM_{@uRkD_Uoo@tb@ev@bVcNhTxKyLw]Rg\\\\_l@u^eIwAo_@ha@sMoMf`@{iBnUtM`Pzv@hJsJuPgk@rIqXmPeUcHam@E}YnUo^oTq{@a@{PfMeVrI_CvPl}@zY|k@xKplAnPaPxMvUn^hkBda@by@|_AbnDkNujBvIwX`NzZ}@bqBnJoVmCczA|LkZ~C`Upe@_BwGvc@tX|gAzBea@pI|QdJkIcLmg@bMwLpu@bnCz_@~D~JrMna@bA~V_e@GqSoPhAkObPsH{BmKqr@`PdL?{e@zKda@tMmS^o[wKiy@uq@yk@yI_k@c^ya@e]ew@aPhhAuIgJo|@qeCglA_dCmY{jAgaAcq@qE_h@wT_PedAubCkq@qtCeCoo@pGpGzG}\\\\~W_BbWnh@dMaIqBeh@bd@z`@`VFjQt^tc@xyD|DaTuMidBjLwiAfr@na@xGyAvOl[rXyKrPnd@nIdD|MoG~Z|KbE_]uOuX?ca@wSlH}GciAcP{[wNcG{@_rAfInGnAoIqCc_B_c@vmAuEfEwKsXiCjT}Sk]}Gcd@lPasB{a@dgBgXob@eLjn@iOqQcHwgCaHaGqIte@q]gZrJ_~DwKj|AyKs`@{Shq@x@ze@qHvAcMwM|Qbn@{EbyAuOti@uP_FqIkVnNcnBaRtXcNdn@yLtEsCuRfKojCxVcShGo[mGiLaO~EgMkJeFz]o[hDgMqZb@_m@yR}ChMyg@rGuDlNrOvByMvL{_BySagBvQuVe[}cA~Qoj@~Vxe@?uKxKfT}Gca@nPtTxBoP_P}cAvAq{A~Oyb@|r@~g@r@`fAxKin@xKr~@xKmKiCduAlKmn@zIbUpFzt@gEz`BgMdNdJ`]`CdvBxKui@~Oba@kNwmAxN{aAhCklAlShc@bIzuAlq@~oDqCiyAcg@m_FaWg}@i@wQ|HeJn]jGbWda@tqAznFda@kTc[kR_I_R}CuT`P|X}SkyCjOt`AdSnWsN_w@tHpOxFmMui@smAuCor@fsAblBb^}Fpt@tHrg@oKf\\\\tEr\\\\bi@lIvt@bDy_@u_@ohAqc@}XeYpOui@tCke@gTm`@qC{Wav@iReDwMie@hAwi@rToE~ByVkTfCkPaa@iCb{@eRhe@uEsAoiA_gAAs_AcZxy@}P_QwkAytCuC_sDxCsLjGjYrAmbBxH_XrTcLzKokApXtcAvCykAbGtz@nG}XlPnr@hCmGnGlG`DsNwZqt@kDqmAsVspBvIsp@nVq@`j@|~@qG}xAsp@uiBl@idAhQy~@~StcAzG_yAnVoaAvZ`jCxKgXiCxeBlGodBeTqrEdIcq@vFyIrF~Uz]_iBnOyDfLk[tBaO{XafAxBcL~r@ugAxOlZfHm@pXqa@xKrxAjHnoF~D`TnMF~FbOvIhfAjNzIrCyObNdQ~ZhrClGsWq]niQkb@nxJvHjK{BcaBzSvf@rGxp@fG{JhDq_A{Fs`@zFsf@bGfhAhFsvBfJgj@a@myAnGpGfCui@vKze@vCoPwCsrBn\\\\auE~i@rxCtXtn@u@_l@m_@c}@bGej@{BqtA~X?`PkThU~VjRxu@z]fd@pG`r@HeUn\\\\bb@mm@mwBQoiCwWooApBgSzZre@jKdg@hJs@}IcGuTgiA`TcZpJ{k@ui@hn@{Gc{@{Gk@wOh^ui@}BkColAbKwTpLna@k@k_AfIgRpoAoCtLnL|B|^uAoaAia@mAqG_Kqf@|H}CcS`E{eCxEuLzGrKfGkIgUolAvW{@zPuS~ToC|QcZpy@LjT~PdMhxAgHfwCc~@rnDt@vdAdOaw@n^gMxQbLxLza@bg@rRtn@plBfD|yBfY|zAnGdmCbNlYzn@bS~~@xq@f_@|}@\"],[\"mc|i@ou~LhFih@dWkPs@zpDqT`rDkXtk@kHcBgHoY|Ues@oPtT_PiA`Icb@cJk[nHuNsKsJvGgQeOsOte@yWqPgMyLdC{CeOhe@wk@jDgoA\"],[\"q_`j@_`mMnGlPlKkT`a@rj@ze@`rBzEzv@hEvOpG{v@dUhfBIvdAoJdMgs@k`@gQN|l@xz@mJxv@g]vz@Lyt@_H{MoQth@_Oa~@yKyKuMaaAzLwO_Kel@wQ|\\\\dFcu@na@VtAaJca@{\\\\mApLqHy@~J_~@qGxKoImNnIenAgXj_AeLeLgFnh@~AaqBfCpGbP}Xz\\\\bL\"],[\"cbcj@y`uMlH`@dOof@pRvO`Lzv@xZ~r@fE~lAqEf`@{\\\\v`@mPjE?b[{NiWkMlNmKkTcNda@kb@am@s@qaAvCkNhGlFpCwJnGnPvB}FeAezA\"],[\"ed`j@y|tLpCveA}E`ImIoKoE|Ol_@nYzInv@sEnGk_@}ZaVgp@sC`PkTc{@fKzuDuBlQkT_e@{G~jAoFsy@nPymB}I_QwNzKGsUn]i|A_l@xX_FsE`TsbAsBwJsOfE{DuWrQadA~Thb@jImUuNic@zb@mUkZkMI}o@{}@n_AuHur@nGpGhD}c@nFaIhCfGc@sj@bDyClLxUdTim@cCoLqGrGmK{a@oLpCnGuz@eMjPmAyv@ga@f~A_Dck@oGlTzIkgA_RrKf]scAm@wb@_KcFmf@`PwAmQ~h@owA|Gb{@pGmj@fC~XvKpGgC~i@rXpG|Gti@nGwK}FveAnO|MpG{\\\\rL`RxBxx@bGea@zOvNaJbk@vAbT|GwMjKnRoG~z@xKma@vKz\\\\oGha@lP|ZqCtM{KgGyF`sA`KrIrJ_Bm@fYxNtM{P~n@bV`L\"],[\"s~hj@uduMfCojB`Yf}BsPjb@mGdo@yK}oAYn{@sJdb@_Dgg@eG`PaFgVxAqnAbG|G|Gu}Ax@thAvE~S|G}G\"],[\"qmjj@_n}NmFil@fJ_E`QzVdP`bAq@~_AfIvi@yGthBfKzFpGwTzG|{Boa@ttAkTiyA}FnaAbMpoA}KrSuIOyKea@gCjToGkt@xGgd@eCgK}GdL_E_HzL}dBvPis@}R~Iqb@d{AiNefAzKoIaEalAjYtMdIsL{Ik`@C_NlIdAwPgnAlDsMhQmArKtYjHf~@|Gsm@vK?zBjTtDkOk@y{@\"],[\"e|ri@gjiQhA{t@d_@n}BeKpc@rBfI|E}HxMl^sCpr@wO{Lg]ez@aPpL{YiTlH_~AlP{BbGmP\"],[\"kq}i@iqgLpKca@tTSr]|ZzFt[kMjOkp@yC{NgP\"],[\"iqkj@abdNhUtd@pFyF`L|J_Rh`BuRyb@dKie@kWtK\"],[\"syoc@{aqE}ZnAiM|f@hM~LsC`[nNxeAlLlSdIqM`RnFeB|u@iIzV}PdFmqAcTc]ib@{SyeAyu@ik@zIuoA|xAqlBfjA{BvOpIxHtX\"],[\"_`oc@eykE`z@uH`ToV`GyY~`@}RhVlLxTn^bLzc@mAj\\\\weB`TsPpYkP~jAjH`HzS{`@gBheA~Qdz@cK~Vbb@t_A`Ly@jc@iy@|]aXzCpnDhHzE`[oDwCv}@wk@xkAaCjp@}ZyLwFlNqoAhl@_Sn`@{k@cDmY~`B{g@h@eEvLeIWsTjf@}Ml{@qIlMwu@zVqb@tgAoUtbLpj@nxEeJl{B~Rx`Bin@au@wwAceAioBwFi|Adw@eYldAma@yaA{Lez@gq@gc@_k@itAm@ydBmw@_aB{]wAi[_r@bc@{aA~a@igCvWePfHkRpUsnClqAu}FzP{NzV`Clt@ev@xZo@tMsyB`\\\\__@pQskBkq@lPcO{IoNqfAoYwC~@}}@|kAulA~sAwb@tgAmaAhZwh@~q@ykCxu@hk@zSxeAhc@pg@bVwA`c@lPlYTzQq]\"],[\"{|lc@{ygEjVc{@n\\\\yH``@~AhSbOkTjnAl`AqqA`OsbAlq@tSv[qj@la@zZuM~bAh_@WdExR`r@jIcC|Mxm@uIca@vThn@~Din@xVx\\\\dGjOaL~MvN`Fxx@_Roa@aI`L|Szv@wHrYbXkIjS`^q@djBeOsSeQfJyJeKik@iD_e@`MaUa_@co@tHqa@tq@cSxIcgAiCwHosD}]`Xkc@hy@aLx@cb@u_AbK_W}Nub@\"],[\"ou_c@_}~DoSl[WxHnK|Coc@nj@~ZjBmB`Hkb@bX}DqFsGdExHpQaBre@h[kr@vVoSxLml@nRv@{GxKzGx\\\\`PgTpGvz@|GqGnC~RoCbaAzPs`@`BvFq@va@bIlBuGzn@tGnGJeZlJbg@yJlXd]nPhCaYeJ}h@zEgk@rXbs@sCaYfHxLqSnoBeh@~Hev@rbBiTfImAtM~Dp_BpVbOjSeMfUzPlMYzGp[us@hpBjN|_AmZzx@fBn^qY~yA~Bvb@tEqFpBjMqC`t@{K|g@eiAvmAaUjk@{VjFo]mQym@nf@ybAb{BwR}j@mTuL_SfQaNjd@cX|FoNqP{Ex[y^de@Xp_@hInLeb@~hC}KlRqc@bLyYwe@in@sDm`@{c@_e@ohBeu@ao@_Sy`BdJm{Bqj@oxEnUubLpb@ugAvu@{VpImM|Mm{@rTkf@dIVdEwLzg@i@lY_aBzk@bD~Ro`@poAil@vFmN|ZxL`Ckp@vk@ykAvCw}@ds@vAbi@kw@bo@uH`U`_@~d@aMhk@hD\"],[\"oc{b@sivDx[`q@q@drCzI~CpCiaAcLti@|HefAvJ_@lKbaBoBm{@bIyZlo@dgBmBvYnGjKsKdc@~OgUhSW|[lXxl@``Bl]f\\\\jH`h@lRlTeHlPx`@fh@vL}AzGpIgHjx@jP|@I~_@~XrcAfCoPrWl\\\\sWzWaWqXu`@jHuMvhAoGwGeRbHaFjb@dMpd@c^zZoUdhAqPbDsLhXqAj_@bIlQiFn_A{g@lT}`@ol@kZjSk\\\\JgKgc@wc@kTuJGow@vs@_D`jBjR`kCrJrPqEt|@qTcD}h@{s@}j@x[sG}Wig@dFc]oEkPkOeSpcBqBbwBcfAlGapAot@kZceA}QrQsq@sDik@s_BgEuz@fSujAiIoLYq_@x^ee@zEy[nNpPbX}F`Nkd@~RgQlTtLvR|j@xbAc{Bxm@of@n]lQzVkF`Ukk@pjA_pAlO{iAsMmz@pY_zAgBo^lZ{x@kN}_Ats@ipB{Gq[mMXgU{PkSdMqVcO_Eq_BlAuMhTgIdv@sbBdh@_I\"],[\"a{nb@ks_DxLZjFhLmLlZpY|T_F~j@mPlPcLoa@ef@j^b@|NrKgJjWC~`@bv@bI{L`FhXrMjhCoD`SrLz@gCjTdLq@tD~GsMlUnP??ttBqJzkAaMbJ`Pvm@}JdKjGll@~QfChGjj@mVnC}Wev@pFyP_YqRba@rcCca@_e@iBzHd`@xp@{\\\\sCxK~XyKze@_LczAsKqW|BdgAo\\\\b{@je@_RLxn@iH|HoEiFsZ`B~i@jTpa@_z@{@peBu_@~u@_FoV}ZaQmBoo@sCxK_JcLgg@yiBPzYt\\\\~jAk_@z\\\\oPeMxEgWeLqG}GlL`D`i@pm@{BuPzmCo`@eoBac@u`@ciA`V{bAxDaIrZ|@d`@_ThYe^w~AqGaAcW`W{Nf\\\\gp@ge@aQsAsVfKo~BmfEmYeDmR_`@hResCbq@pShb@cHrLzY|j@y[|h@zs@pTbDpEu|@sJsPkRakC~CajBnw@ws@tJFvc@jTfKfc@j\\\\KjZkS|`@nl@zg@mThFo_AcImQpAk_@rLiXpPcDnUehAb^{ZeMqd@`Fkb@dRcHnGvGtMwhAt`@kH`WpX\"],[\"scqb@o}{B}dAdqFoQ`Skf@rMiFtaByRlJ@jm@mPdc@wGyB_h@pq@_nAly@kQm]sRcGg`@vDyNgYye@bUn]eq@mJoDi[hn@{BuXoF|Fm@xa@o\\\\aPle@efBfc@bHj_@cHke@mKuCsr@_PiA_@yRlKih@|Xo^fb@qkAnCgtAoGtcAg]je@g]y\\\\_T}v@_j@slGjKhjDvTxvBby@`nAkc@vc@}KsDyGuh@cP`YtCzm@a]rc@_Lv@qIeJyZmrCSphAcc@ua@{`@}aA{Hmq@sV{k@_BcuA}@l{Ala@xbBgCbYaS`PeGq}AyRdVnI^lG|N{JtNvKbN?|QiTxTnOrKcCdY_^w[qd@_rAzG}Gsq@y`@{]m{DaFjW`JthBjPj}@{[mYub@iEoOgM|BrNb}@v]nw@ts@tb@vmAaPvZnGwBpGhKdLsOzNpk@a\\\\vV}Z?_R{eDuHp]rJvp@_BvkAud@`z@nG|QjRoOxRvBxIt]oN`X|MkBxIvI`Ll^_LguAtZwi@jTkCrEv_B~Q|OnI`h@}MeCwIePor@fd@nCdOrGkNlp@zIdW`f@UvQaQvWgMnFqWoIaUtChO~Ptm@E`EpG{k@rX}ZmAGdL~b@XvDjTwp@fh@iBlQs^hFgSu~AgAog@dFqD}Pov@iNmMwKkoAeDmpByQ{`APolCmWaFsmAs_Auo@awC~FovAn]cJli@fShF_LeFa_Awf@{zB_@{gAfk@gUdSsgAtHbkApk@~sA|m@X|QsQjZbeAfU`UtaAn_@f~@oHx@eeArW|d@fTFn~BlfErVgK`QrAfp@fe@zNg\\\\bWaWpG`Ad^v~A~SiY}@e`@`IsZ|wCqYbXj^\"],[\"aeec@aafBvYsXvu@~KfOhv@_`@nYqo@PwJkGbC_^i]`a@oXuTuDnKeRr@YcMpt@{k@\"],[\"usac@oqoBhVwEdD`Ni_@tlBoEmS\"],[\"ymcc@ytiBjPq@bNjLwUnc@uM|DqMyP\"],[\"s`ec@gotBvHes@lUfZ~Fh|@wHtP}Ns@iHoK\"],[\"cvhc@wjdB{Vf@sCea@yKrXiGqUep@es@}Emg@da@aPsXqGiT`Pui@{v@|GrClR{Mrk@rNd_@cBxO|i@tOpD\"],[\"ufjc@m~jBgXaFgKfIwc@iXfGsaB|HuD|WrHi@gWkUyFwE{LPmw@gL?jIvw@sHpYo`@qi@sD_l@uOqIdQur@}Du_@mr@umBuOe{@vz@f`EwKri@oVaPwZuCdKrTh^lK~Jtr@ed@zgAiQgCdKhX}F~TmPm{@kPt@_L_S_Qq{BoL}EgIvTmd@{e@qT_v@qQswBwBjN}T~DhVdb@fJnz@uOq@eg@nSuIai@ie@yh@kMtCqGin@ca@om@_Lew@eYce@|GauApt@zi@bNcCtZ|\\\\jnAvK`a@qG}r@iBmm@}_@mTlEucAsr@sIq\\\\k\\\\i\\\\oO}`@eKevA`GmtAoO_P?j_Cgr@}gAa@hQxUrm@b^zb@dJhs@t_@rgAwE~f@kLkEq`@_y@tBr\\\\f^rt@~VjvBnU`m@yM|k@uc@z\\\\bRqCx_@cb@tGvH{DxSpHtl@dGoj@f]d]vKwKrJrD~L~[gNri@zAlXb{@`r@pBz`@lGpDve@eL|f@lItJ`l@}GgCsAzJnSxHkJd^gRlNyOiJzBsb@cIgTcJkCnGtg@mMbSyPcQmZ`LwTor@xKlcA|GfPtOrCzEf`@aNx]wG_RePfEoPcyA?tcAeXuTjTne@}gAyz@sHk_@oL_JvZl{@yKfCh}ApaAd[`v@nX|PiNfc@}G{KyKvi@sO{\\\\xBroAca@uu@cGfvAaW?kA_c@_Rpu@gV}UsCcjAwTx\\\\kKcGoMhOsHWmWei@hy@oiBpKu{@}Heq@sIlAyUeg@giAbAo`@eSyEhKs^sO|Gvt@g]sRmBfKpd@ns@?hc@bj@hCvGds@t`@jxAs@~]e}@lfB~XqxAiw@pxAtCme@yK|KyI~x@{V|\\\\sKlc@mDea@ju@syDbEaNnUyBfUqV~AgNaPzKjTgr@q[hf@_EeDdLca@sXxK}GpXsOzIy@fk@yPf]aVogAuOpGeGoJiMwoBoDvb@eFnDuGsNp@bNpWryAl[|ScBz`A{OpKzM`AvJzSqHzFuC_Hm@nPgu@yc@uRw`AfJaaBk`@s~BvGe_AiHgf@dI}i@_c@g|ArXy_AdYcKfSkrAcYciCpRgGhB{_@a`@}jAlT}g@tDmq@`c@|aArQ}zAb[|@zB_|@`[sNb\\\\wsHdYmdAreAyp@tUkEvwBfLxbDhoC~PlUt\\\\h|Ah`@jh@hMtIpc@a@t^li@^zgA|p@jiDiIp]mi@gSo]bJ_GnvAto@`wCrmAr_AlW`FQnlCxQz`AUd~@\"],[\"cdnc@}sfBf]uVtOz`@L~KyKeXa\\\\bLg@`KnIcAT~FiLzTlErRjR?pZbXbH{BgPe]`PxKpXqGjMp]yZjV_^Yql@qk@aAnf@uI~BlI`QyKxJ}ZoP?}\\\\sX`Y{Bw]lN}GrMm_@`KkCzAkS\"],[\"cdpc@uejB~b@mDvZln@FbSoGtRR{XaIm@oB~MnH~NkFvVgt@dc@uTzB_Yq\\\\mDxLiCuCeEaZxVaz@\"],[\"eitc@_wlBaBmVtFiA~g@vCdWv\\\\b\\\\uTsCvi@|Mrf@}f@pRnF`MeCpZoVf]aEwu@cP`EwKwLoCarA\"],[\"ixvc@yybBfCba@sZhLqC~FfNnByAfN_Td[eS`CbB_r@aPoGrBfIuNv]aUjE}H}Eoa@jMg@mPvX{Nzp@gx@vLmA|FfHMjSvTe]\"],[\"ub}c@cf`BgUrIbV_h@lLklA|QoZpWkEjQrp@_OxD{HuIeLti@eGoGVfHyN~DhAz\\\\\"],[\"{a`d@yanBxxB|BfHpb@lMvHfCvaAscAhnB_Fct@}h@mcBqf@eW\"],[\"mitc@aifBf\\\\qCjSrPgLhg@{SjSgW}Rv[uKwDeMeh@xXxJqYhMiF\"],[\"qc_d@us}A~Vf@oDfg@qIsHu^fA\"],[\"gfcd@oubBoC`c@mr@rVcNbPyGyQpH{g@`e@cBzEoJul@j@iLsZiKp@xK~e@qEvg@wH~Cs]cF}Oy_@}@qh@xZwmBnB}jAnRcHoFuJsYhC{Nyh@pHoeAuNjYgQcmB|DoIhXbEm`@ur@uAyqCvPwbB|TcDrFzMeL}gA`F}ElZvR\\\\yI}j@ar@iMobAmk@ia@`J{\\\\hJutB`FeKrVoFj@wu@np@w`@xk@~sAuP_x@R_u@rVep@tg@`NkUe_@sOyGyOlEaGji@}u@xp@wXlGwIwfB}Qgb@pWadC_Yzv@_Zoq@wF_oBcTov@dTr~DsClQ_j@nMaGxMeLc@cJgYq_@qNsd@gu@gJsi@_QuUb{@blCgCpr@`Se[xHfAv^zg@|Uoc@na@eN|GgV|Pdi@iL`p@pGv_@dN`NhT`rBmNlUs\\\\_u@xKhcAke@fqEajAwtAw[yKqFpGnlAp|@fGdWqLnV~JdO|f@sUcAdl@pHwSh[}CdS|k@uEv}AqMfp@{TnQhU~}@oH`eAsXin@jTb{@|GftAyDbLwRHpXvS~JhwBnb@nrAwIvv@cSzU`KvVxAfh@{M`ToGqi@yKbrBoNoHnNeeByK`D?eUeVnr@kAznBgLin@vDd_@wKzQvArPeG}D}X}pAmAcg@uPmJaA{MhLso@dj@yXgIoIm]jIeRfg@aKcrBnBiwBiIbp@zLzrFjSdaA_Nn_@kQvCeOi[rPsJgDsH_P?{BeX}GdCnGbXLflAmPgcAaCmaAwGkNuCtChMmpAsHwOtTipBmPy}@}MuRgBse@lUkx@{\\\\hr@zAxs@nQnf@vEtr@_Cv`@wJaAyCdZpCdbAyZr}AyQ[gCegCkThcC|BinDwObaBcPek@~GrdAa@zrCqBfNmRbNuKg@kE{LcKnBgDiOeG|GyFaQdB_i@lPsa@sCaYuO`YmIcg@vDpmAaOsWqYyfBuCnG{Bwq@yKn\\\\cZcl@~BqsCrFen@~V}h@jGx~@rKjLyQ}gEnaA~XyQyY_f@eRuA}Jhb@saAvFsr@aj@flA?upCnPey@v@gw@_Eu\\\\kb@ui@o@qo@|R_dAuPqXoGdlAqGtIrJpkAxYj[hEdTkR~fCzC|dBeLnc@oGh}A`P`r@}S~m@oGo~BqGqPkL~tG`Gnh@{FnMlPx|Ao@~r@bItV{Kvb@ca@aYbG_v@{Zwl@yMoDbCew@mr@xvBgCb}@oGxIiCkTiMp[aF`EgCgLoGpGiCwi@sMoMd[q`A~SzAhQ}\\\\~ZmrA~g@{EpSudAgV_|E|O_oB}Tcu@dSyzAi_@wcAo^mhCfu@oy@nJ{XuOymCkU}_A{EybCvYob@nmAhFnhAy|@AycDvHgeArLmUxm@yJ`l@ui@z_@ycCbm@i`AlMdgBlQOld@ee@dfAfbBfU{Nzp@}dAfU{Hz]vAlw@~`Bl@xdB`[z}@daAty@zLdz@la@xaAc\\\\vsHa[rN{B~{@c[}@{MpwAiLwCoYyy@uDlq@mT|g@``@|jAiBz_@qRfGbYbiCgSjrAeYbKuXpw@`c@ndBeI|i@hHff@wGd_Aj`@r~BgJ`aBtRv`A\"],[\"{zid@o{_Bhl@kNn]fx@c[bUba@na@abAjD{DoUdT}KvCoFgHoD_e@bPt@qPvb@aMkS_BsCkTqG~G\"],[\"{wwd@w_~AwC{u@h]sgDfMz[zAjc@cIuKsJ|Fl\\\\jl@jPttAqArOuPxJvBiQyKuOqGflAsRqe@\"],[\"eycd@oc_BnFu@_E|[eMrEsC|SyN|DjDvHiJlSgGmd@dGlF@ec@tFnD\"],[\"cegd@{e{ApO_JvN`SoRc@bMvPiQvRoR`BkNcb@\"],[\"cmzd@e_cBjP{Rbk@pb@eF`m@oGjBEeSgKwBeVzj@_OmD|Piu@\"],[\"qqsd@um}AiLbAcIk\\\\dd@iM\"],[\"eazd@qtiBel@um@vEoa@mLxO}SpkB}_@oQoHaTjO{gBdZsWnSev@ia@|q@}OTeEiiAyMqk@hGa]`PoKlZ`Bxi@ni@tRnCgi@ax@}_@eJlXaw@lRaCcGmaBlK_bB{\\\\xlD}m@faA_Nve@gL}JlN_mAqGhl@aPrZod@}p@oh@}oDfx@sr@hSsh@jo@qw@bS_ChQnOuOoe@iN~Ei}A~dBm`@hPaMkEqFq}@jZgg@qa@xKmWoyA~R{uB~e@jB|k@w`AxQwK~c@sBhl@ph@hSy]aP_~A|G{\\\\yPxV~QxiAyFt^iItB_a@yf@wn@zE_GiFuC{m@nQg{@eRm~@~]e`AsX|XqN~p@`FrqAeWt~ByZxYiCme@so@nlAmElz@oGkTPx}@x_@xuDkTby@`Dre@aDrK_ZioC`EciCeP~d@jC`uA}Gkv@{DxRzDvgDcPuCkKdLmP_YcG_yCdIu[|ViQsc@sDjEuNg[kcA|p@adBmPbEwKyKyKrXoFsZzAkcBlP`a@jG{Gs@yZ`p@cRsXuOiN|KwQgNvUu`C~Z{g@hCku@lVuN_\\\\_RmNi_CaFnSfKbuCwRpr@{TxAcC}c@hKkMvR~@fAmM{P_GsUjMqNgGac@mbE]iqB~XmoAoOhJwXts@yKmPb_@ziGdY|`BcF~oAqGmZcLdVmTs`A_PcbCa@zk@p_@rxD|EdbFyKiTuI`PqGyBcR}\\\\eFc^dFs`BiTtr@rCxgA_g@zi@oBli@{k@myBoR_yBdf@w|A|SyKlCed@yi@mgBdCaeA`R`SbN~p@rBgOqg@wmDzRoeA`PsOl_AsXrV_fB~^a{@nJg|@xJkNpRuCuNuL}VnMmnA|_FqZ`GaK_W_EpTeRfPqa@vzAwK~IhChXlGcPiA~x@er@ajA{I{v@jQq\\\\xQnBzM_Sb`@aiBbHcEzGbRhS}OrRlCwDqd@`Vs|@Js^yJ~G}Ntz@TwLtKalAd`@soAwa@|r@iQrvAg_@~f@yKe}CeE|zAkRkg@o@jSzP`h@`LffAuO`a@wKe}A_FxTtB~yB}CpHqQqBs_@rf@_K{`@`W{k@xKfCeG}XeLdCsX`s@lJc_EdM{{@e_@~m@mKyY}LwuC`H~Zv^o`@vDmYiO{sAzGohBmBwF{Zo@{Kuc@pA_tAtHo_@zuAiMlSuw@li@zpArg@i@x`@fUz{@zjHrL_BtpAylCvJ_o@tyAvo@pWtn@VxhLzSl~Cnv@`j@h\\\\tf@jM|gAcGldBho@|pAbRDkD~eAt^diB|G~uBjU|_AtOxmCoJzXgu@ny@n^lhCh_@vcAeSxzA|Tbu@}O~nBfV~{E\"],[\"qqqe@cflDdCn_Dy]hWqXp{@mXgbDcHwOsC~i@cUlb@uI}CoF_OdGu[qLqi@jm@koA\"],[\"il_e@a`oB|@sg@`OzH|UkB|Mxv@_@p_BuS~Z?w_A{Ivu@oIoWcGnGoJeKmEirA\"],[\"ytae@{}sB~QyEbNqp@`u@~~B`Edf@wTeXyBlKqO{Dy]zUqM}EsZk~@\"],[\"{ebe@kiyBbGmj@fDbvC{T`i@uCmnB\"],[\"eohe@_zdChGi_@rHiHzJhBjY`vC}L`OmKuT_SaApCad@\"],[\"}jje@emdCmHox@dRcJdJve@~IyMpHtIeYtgAiBcT}ItOeJyF\"],[\"aaoe@k|vCiJybAhJoPzUxXfNlt@oTraAeWe[\"],[\"mpne@gftCf]eh@rIlr@hZ|{@NtUe_@hlAuMej@{HlJgFmJqGjTgCov@bGrGhDs^nO{VaNcE}BjFkPyYyVbLfCef@\"],[\"scre@ym}CxPmYj`@qSfKdhBiBbZuTf]qf@k|A\"],[\"ghre@mudDn\\\\ww@pLpDbGzvBii@rcA{ZcN~ImVyCgPfXqYyFsFkSfC{HaP\"],[\"adte@uqyC?sr@_QjvB_XpPgO_zAeUeq@Iqh@vD{QxVwMjUyb@je@jz@pGtcA\"],[\"qd`e@oamB}DrSaJao@uHkBXcb@hTbG\"],[\"kq`e@eglB`Bjl@eQbFfB{k@\"],[\"i_qe@wkaDdEsEfEfgAo_@xZoDsj@\"],[\"c`_d@o`yEvQrYh]vCpFpJbYrpCiXdoAvCdJln@dS~GqzAxc@wv@rIm@laAjkAnr@l[fHfm@Yhb@_r@xkCiZvh@ugAlaA_tAvb@}kAtlA_A|}@nYvCnNpfAbOzIjq@mPqQrkBa\\\\~^uMryByZn@mt@dv@q^q@eNtXmlAjqFqUrnCgHjRwWdP_b@hgCcc@zaAh[~q@gUzH{p@|dAcZpMiaA}`Bmd@de@mQNmMegBcm@h`A{_@xcCal@ti@ym@xJsLlUwHfeA@xcDohAx|@omAiF}NnK{Kxb@u^eiBjD_fAcREio@}pAfDqmCk_@c~@}~@mr@{Sm~CWyhLbaAu{HeFcsBjq@heAdUfFnz@ywChJFdWfz@zu@aAn\\\\}sBqPcC~Beq@bb@eLb`@zXhWjCtTajCrW_cAaa@ioAb@mw@`oBik@jn@gDb{BwfBfX|bAj|@t`Art@zvBhEm@jYgh@hO_t@zy@ooAxHgD|S`PdRaIpiAigE\"]]},\n" +
+	"{\"countryCode\":\"NP\",\"polygons\":[[\"ad_P{`xt@tH_\\\\pk@`BbsAn]jyAvu@nb@mJnd@vOta@uKb`Ar[fbApKvg@eXfP_\\\\tc@qXh{@ca@l}@gIbc@vA~kApe@~nAdPb]tQjUpV`AlRaY|d@eb@b[|Ob]wGz_@x\\\\|XwK|[z[zy@k[taAgAzf@pMpd@n^zQpC`OcNna@uVjOtGps@qE|Pae@vWsfAzNb]`j@dVf{@nb@x`@oBhs@dQjNweAtgCga@fwA^pVkRvRhQhv@mK~p@k_@h`AvF`d@ln@flAaVvJjA|Pw`@pi@sxA|Ecc@n_Ath@jbBMp`@~Xp]|DrToLdbAcPfQmg@hAeHnWlKb`A{PJoMxg@ic@|Pia@paAfFnb@mVjrAmLrBci@qSwo@aA}aA~^qJlQm\\\\vrDeg@|f@b@xWum@zi@jAnV|i@|k@wD|s@~Hpv@`g@eZpNjMwdAbdDuGb~BfJlRlYmHjNdDlZ|VvEfYwIrSq[zQo^~t@NlkAqZzrAuCrlAoM~LmeAxKuf@lQcEfPrVbcCwEfWo]v`@osBlgE{A`_@li@|x@c@dPwc@~`Am`@fe@gJdm@_[d`@gXfv@sf@zVgRpWkBxVxSjKvAdY{G_AaFbMqG}Jas@vk@adAba@iUxhB}WfNmAdk@ab@rScJrw@c[ba@kHh]g`@zY}J~k@}RzCUzVtMvQxy@Q}f@`q@Wdp@{e@dW{Y`c@{Knk@y]|\\\\oTro@_e@hBa_@sLm[ka@oo@gBwVeK}Hav@gGwF{k@|GjDsWibAuL_CtNu}@xXaJoAuH{Wah@cf@oSmCcb@gc@q}@fRc`@cHc]_bAwu@yl@g`@sLiIyj@e^om@_g@u`@{Wqd@mOmKsOhGgXu\\\\`TaVmKci@xCmJnjBax@lDkiAmYqDkQ}[g[bBmDsa@sMiPcn@kPwi@dEql@eKZcWlR}UbGc\\\\k^cP}Vgo@xc@cfBgG{g@vIo{@`OkPc@{iA`}@jAz]ek@rb@pPjh@kO{Bqb@lNc[|Cof@vPwPDm\\\\r]ef@hR}s@bs@}n@pFak@j\\\\g@pjA_zA{Pmf@bNcSBoZft@sv@OeHeUsG{Bcq@pp@ou@p\\\\nGpLmIoFag@dN_Ph[c@tL{QhVuBnz@ucAjo@}K~PggAsk@cb@kDa|@ca@wjAFgZxNcJaC}UbMeMsDaa@t\\\\eMp@}YxG{Bl]jQh[eTtXyDrR}a@ht@lNlXwP~^mwA~]y[xg@qPdXkfBnNgIfJo^~[fBzJso@bZgb@dAygAkUoOgg@o}@hZiaAxj@pCtK`Rh[pGvM|R`hAs@pOgQoFcg@jSm^}Emv@lMg^kVcj@fJqZhA_w@dNa\\\\qs@uq@hh@iCv\\\\uTzo@qoA`bAyTjd@mc@~s@yBn@{|@sV__@wc@fHuHxJwYmBqS~Hyp@qu@jMwQpe@rGfj@gRxJ}m@nZ_^_CaUrU}e@qYgt@kd@qBox@kV|Muc@qOa^tQqj@ra@aDdC}}@pj@km@_C{w@xaAgdAvJqW`EesAoWyl@tBmLl[bIqSqdBzRaYuJgf@|Huw@wRc`@aj@qb@jPqwA_Gg_@\"]]},\n" +
+	"{\"countryCode\":\"NR\",\"polygons\":[[\"nqHwo{dB`PeKjTdKwA~LeMzDkN_C\"]]},\n" +
+	"{\"countryCode\":\"NU\",\"polygons\":[[\"dlqJ|ktfBv@cOb~@oY~d@pr@dG|SeGvT}Q_EqFlOc^aN}MrCi]uV\"]]},\n" +
+	"{\"countryCode\":\"NZ\",\"polygons\":[[\"fm`^szkdBbLyv@rOv_AwCvtAe`@je@pMoe@qM{cA\"],[\"nh{]c}mdBjEtc@~XcPgCbPxKsCxKjPdCwe@hCv_AxKin@jTxK?ea@rT?hCjTxKqa@dTnGtDxHgUb}@qL`IlPx\\\\mZ}LwT`ZpEdGrMcOcEnd@tLkQvVNtCvYyLn[tQvUmb@jEkXgW_p@}~Ae~@wUyLq@iIrLqGmtAvO|B}Bme@jT{Gp@~^\"],[\"dx`_@othfBjT_Fr[lh@}Alf@a`@~gAjAk|@gb@eRaLgYz^_bA\"],[\"fih]k}clBrw@pIiKl_AgPZi[g`@uFsQ\"],[\"`f_ZxiojBrIsRxIzTjTjGjNlb@qTrF_YyKmMzNaLsh@\"],[\"rkxYv}sjBrDnIk`@rEy]c_@{JmAoKjJmE|dBjUgL~G_x@rc@`p@p\\\\cYxV_AfGqMgNwVn\\\\_G|Sgj@pMgGdPtsAnRlj@xKfCXpj@gFpOaXbEs_@b\\\\q{@y{Ays@l^{Vbl@zB|ZdLj@fCrWkElQ`Ixk@eKdQsXin@gMnCrHm_@sCcw@aEqKk\\\\eMsAkL|X{tBmGijC`GbPbPfC{IvXrHjXby@|s@\"],[\"bzzPlxqkBrBrHqQt@}R~QzE{i@~ZyX{BtQjKdJ\"],[\"~jh\\\\gsudB`PpG|XqG}OfSbCvU\"],[\"joy[u{deBuNgUbFmS`Ord@\"],[\"`wp[gqjeBzErOkIhKgLaRdEmO\"],[\"vvo[ww{eBzLyHb\\\\pY}XbLmBuQ\"],[\"v{u[ucteBdVkd@lA~NkLrY|UhEoEpTvHeFrCltA|k@riAoK~f@v@l]aTdNbKf[lPfC}C|H`Udq@hGeJsKox@na@da@gNCD|FfTj^r@bv@}ZOaX{MqR_t@eDzIms@aHiPwZjAea@mGwIal@dCiBaYeWcUwUv@ci@d`@uM_ImPvGwc@}Ot@iz@qI}VnIi\\\\l~AmmBlF{TzFqF`GhKpGyKzBnN{Bvw@qGgCfB~m@`RqCj\\\\n]sXir@jT__ArOnK_L}|@fPgR~XpG\"],[\"d}yZsawdBv_@eX`d@vF~E|WwK~IhJjCl@lNqq@zOrh@`hA{u@yd@\"],[\"`bqZoe|dBvtA_e@b@h\\\\iS~m@oOzJsy@ql@\"],[\"fom[mpgfB|AnL~LO|JjMuAd]aMbV~SNxEvOeOvzA{z@tZhLloBwK}\\\\oGlz@~QuOnEbGpGqGvFp`@wFtjBwQqXdGs`A{Nl_@Znu@aLtd@tVj@b[gYaAbPwl@~_AuK|B{B}XlIuIgVuc@uOyD_b@|NBzNl]f@zFvWolArt@aJ`[|IfeA|PI{FrdAdMbOiErZlEpWok@n|@mPs]qn@pn@qKBsX|tAeCrkAb~@j\\\\~LbdAoGrr@dGrVsUtv@qIhiEoh@vw@a_@ur@g~@_p@ub@cjAn]n|A`NpJrMiAxQdVmV~InRhG~M~_AySaAQmNq`@_HuPm\\\\wJ{t@eMoIlKbj@iEd]wf@}d@{GbKh_Ar_AhKjz@nPvZmQvK{fBoAkIuq@?cj@|G?aL_a@_JkcCgc@giA_LuFdL`yC}WcReQyt@hCxeAy[hCgI_FuS_pA~Ggq@_HkL}G`e@uQuy@yNiD`^brA|SztANtj@wSpF_rAug@oI}ZpZsvAyb@pYnMrFo[hv@_XcIkT}g@pQuO~G_YnP~OuGku@|KwPnP}DxUt[m@ma@gYkRxRc^xb@kNtCiTkSlBiiAl~A_LsRmLo~@f\\\\cp@kJa^}Dtg@mHxFiU_IjIx{Bcn@zWke@wAkEq_@|^gf@xEiZmGm@oa@fl@}Gp[af@wf@lAyLb[uVz\\\\oG}XiC?in@iV`y@}]|Q}LoVl_@szA}E{Gye@h}Aqc@ok@cE}c@wKxKqSco@]qSh]kR|\\\\rElUcPtJhCLca@}c@dVeXByb@~Pc\\\\_E}N_h@vL}RjGmCd\\\\rNpUgRkv@sOyc@t[mJ}Wv]uw@wb@dq@oNmAwKaj@uOyKgYqv@~\\\\cbAda@mZcYiCyd@tr@{v@vK_~A_zBqSbB?gw@gLiLoJv@oHo`ArPkj@ln@kf@djAb[vf@hv@dq@ba@jvBfWxV`Lj~@sTd[{a@rJgb@x_AbTxgByi@jJu]i@caA{b@qlBnDgMp|AuhAzGml@cHiZmeAscAsIc[ju@ynApYaJlPlY~fC`qAz_ArLvl@_Gx`@_d@|fAiEdd@g`@dd@yDxr@aZng@h@zs@vP||@{NnWyXnKYr\\\\dQ\"],[\"nzaXa{liBd@gIdSqA`a@xKtC`j@`Pd]Bnh@yIof@sZe_@dGuI{ByLyTuHpAjNcJfI\"],[\"~}}WgfaiBgMwJwDhNsUqBhTme@y`@hTke@yKlKwKkMqDb@oMt]u@mPca@?hT}i@qb@nt@VjNdQz\\\\bGp@vQtb@~t@aBfP\"],[\"xz`Xam}hBH_Rj^}O}FmI|FeEeC{G{MvGeWol@sKlLzI|ZcXeOsN{y@aZo_@v[hApA_Ny^u[aN_g@zBgXlOuEsBzg@fG_CtPpKfC|XrGwKpFjIpD`g@yFlQdGlZlKgNpH`NpFyIrXjP~CgDy@sLy[wPoEc~@nEcONhKtNxEsEpY|RcAHdKlMrCzNhXtBaTfNmLdb@rOnM`s@tOcPea@qxAyKl\\\\pBaiAuFyWaHnLvA_d@aNu^h@leA~IlR{B~KmGiCdI~p@a\\\\hAiNmUaYwKzBwTze@rC_a@mWA}QmQjLuKuIeOxCCfIlUtIiY`_@?wTgMxDb@_McLa@l^yz@cMiTpCaTsTwBlAmHnX{SzJVpEjR~OiMim@ydAxQB~e@b_@tVfEtKh^wa@tCdQzI_BjHrl@w]`Sle@vPnrBzQzT{Bos@}UsoAnPa_@i]{mAjaAfq@bNrj@sV_NfGhRs@nGaHiCnH~P~gApr@fq@uC|@wJnXcPiM_IeXtItDwGlk@oa@vyAm`@fCmh@fNrB|jAhv@by@rgAiStTcKh}@vF`hAfLb[yTt_@r`@~Z~~@ruBznAzd@nXpmA~o@rdA|GnFnO_Oxk@te@vWvaAwTti@cYqCuMbWoa@hWaPne@cYuAih@~RoPps@{UdP_u@sT{z@a~@e_AiR{r@_h@cWsc@ylBemBiKuv@mmAkMyc@q`AqdBkbBy`@sS\"],[\"zyeXsouhBo]c}@wlAe_B?kI`NzBiKe_@aYuVpAeOsLf@mOyPvx@zNzeCbiClNvc@wXzm@\"],[\"~l{W{|bhBqe@ipA_nAwxAaFgVpZ~ItA{G}KeJc@cTcKc@gK{RoGhHnGt`@sa@os@wC{zBxLgaBaAb~Cx|@~`@~KsDlCvUxWqXff@fCqGkPna@c]va@w|@kLoa@mScKrFkJsMyXjP}Jrb@|FbBwZhHwE~r@rDl\\\\~c@?iT~aA_WfItAcP~OlUhCzWcPyF{I~F{Cdn@cMlKvOnMkNn@uq@fVyNvX{m@lmAjMhKtv@xlBdmBbWrc@zr@~g@d_AhRzz@`~@~t@rTDtf@d_Aj\\\\xXr[tKrU`Bjh@zOvVoa@t]oz@~TcExh@uZd]qDvi@em@|KkOxWwZvIu]iF}a@g[gx@zB{Xc^yIep@qWw@uTcg@y~@mXw]uh@}[fAaJsqAa_@kUca@gCaP~IwPiq@qWc^kz@tqBqh@f`@oA`y@zNbPcCfLmQjAyj@eV_cAt`A\"],[\"nz_Zm|reBcMzSoKah@yv@iuAcRsSsXiG}M}Z{G~O{KouAy`@itAjXk`@}Ci`AivAqbDkV{Nke@i~@sk@otB}UuWcAif@sZ_~@|Aaf@gY}u@em@sa@}Qwb@w`@}Pqe@}_BmYiLuHaVuQmIkSwl@vF{d@in@og@{A|R|c@nk@cZ_Koh@oj@gF}z@gXwRg@eVoIaAmQwb@a\\\\coAkBic@qdAqaBocAmt@qP}d@yeA{hAqwAc_Aka@}De~@wj@c{AuOks@eSub@u`@_mBkk@_FjJ_`@p@oDajB{v@gnBs@sVspAy|@ks@aXaV{n@{fAqL{Co\\\\iXxNobFvCsIcVqj@{^cMcWvn@kJ~bAu`Axj@dVpV_G{OwWnAay@ph@g`@fv@woBdHJnRx[vPhq@`P_Jba@fC`_@jU`JrqA|[gAv]th@x~@lXtTbg@pWv@xIdp@pRb[p~@[|a@f[t]hFvZwIjOyWdm@}KpDwi@tZe]bEyh@nz@_Uf]{T`BcNuNmPHcV|r@nVrRxt@fj@beAvdBtmBlKlv@|Pt]`\\\\d\\\\zQbDtW`fAl_Aj]dJvjC|[ffBzYdh@xa@lUbZvaBlp@|e@db@|tAt~@nlBtp@v{@Sjo@vcAbcEvl@d`AvmB~|Ady@l`Bd}@hkA_Epl@~b@`_@bp@voBuNl`ApWjsCbsA~oBvv@xXfh@dv@bCxg@ji@fyB|DbmAejAc[oVjM}V~WsPjj@\"],[\"x~lZyengBfjAxq@xq@hdAbs@z`@lhAt]|MoRjMd@zZ~[rTyBl\\\\~e@hNmClFlFwAtYfXqG~Jpt@|LuVtUvMzOxk@`OiAjFm^cFiGjRog@jGI|M~t@vz@pgA{ZopAuk@{v@rP{Gji@rFhC|d@lN|IlHj`CvKjt@zVjm@jr@bm@fcAzY`_@xjAl_@bc@~i@puA|SrTtv@zHjNhd@qGli@pGve@tCa{@pTnKtZv}@iCrv@`R~g@dLgLu@bx@nMpJqIpp@nPt[vBh\\\\yEpO{RHg`@aAci@kPoWxX}|@zN{s@wPog@i@yr@`Zed@xDed@f`@}fAhEy`@~c@wl@~F{_AsL_gCaqAmPmYqY`Jku@xnArIb[leArcAbHhZ{Gll@q|AthAoDfMzb@plBh@baAkJt]ygBxi@y_AcTsJfb@e[za@k~@rTyVaLqgAkGaeA}Zam@aj@sSia@}DcmAiu@osDm`@wd@wv@yXcsA_pBqWksCtNm`Acp@woB_c@a_@aAgZ`GiQra@{CtjB|qAvvCoBdPgd@pdAy^zBu^pj@es@nAgaAjf@w`@lqAoh@l`@g_@zHy~@wF}mA`QuRbn@wFvWoYyW_|@s~@o`BuKwm@qIglA\"],[\"turXa`giBh|@raAfx@bLfr@nf@|^vt@tVjRjAvOb[dZhc@gKiJhi@hl@p{@jiA|c@rIpZ|GgLfbBjv@|^|g@r_@y@n^xVj]nrAtQnGrTnb@jUdhBjh@z_Af{@`h@~OqEjkAlOjxAy]|Hd@aCjQxLuMvFee@jKplAbJvObIme@gK_LyAuv@lViM_a@iJxKeSt^m@oV}^rBsQxLsHmPeX|\\\\uXjPfCdLmGdb@Mb`@zKoGtFvMlN_AhJlPhCiEpIhEvI_rA|TaBdG~GxLtKwH~`@pHtg@gWcApv@cOzUfBhMib@`l@cSmJte@~sEaHzAkF{Lq[u`C_L~TkAje@qUv`@vHzp@bUjOpm@?rIfHpI`nA`n@ztBt~@pwBr@ne@r`@dvAv_@zgAby@trAlJdk@`y@fh@pu@xDxWlYvQtBpJl]jK{LllBiJlZdXdA}PdNeElx@xDz\\\\bRwk@pmEpIflAtKvm@r~@n`BxW~{@wWnYkz@xNuElb@rGbv@{Hx~@m`@f_@mqAnh@kf@v`@oAfaAqj@ds@{Bt^qdAx^ePfd@wvCnBg{AqjAmNkFsa@zCe}@ikAey@m`By{BmmBeg@ccAor@{qCcGgmAup@w{@u~@olBeb@}tAmp@}e@cZwaBya@mU{Yeh@}[gfBeJwjCm_Ak]uWafA{QcDa\\\\e\\\\}Pu]mKmv@wdBumBgj@ceAsRyt@}r@oVuGa\\\\e`@mg@ibAea@tDsh@bSmInPqs@hh@_Sp_@qArIg`@na@iWtMcWbYpCzTku@{Wav@eXoYoZyKsGrO}GoF_p@sdAoXqmA{nA{d@__AsuBs`@_[xTu_@gLc[wFahAbKi}@\"],[\"drlUkg|iBeEwL`PkNnItS`^IvA~r@{FjXuCsCkItLfEjSwXcC?ic@vKoQ\"],[\"xweUibviBbEkYx\\\\qEMxd@sDcH\"],[\"~paUar{iBnVyKzGba@yLfK\"],[\"nscUoncjB`PeP`ThKlDrMwYxItEdWwZxE?fXmPrC|BnM}Q|^{MhCxNeOmBoI{`@}DeTh[qGu`@kIjKcUwCxB_[t[}Y~JzIpW{s@pg@~P\"],[\"rkwUqqriBkoCrfAqBys@vFiNbPhGd{Awt@uMuE{XhHgGej@qGdv@mZij@~MuB_a@cg@g@iNbUyVZ_Pw\\\\nDsNpt@g\\\\zNjN~NcE`H{Ld@eZq]yDhg@rEb~@`InOhc@jQuI|RpSdUe@jZehD|x@sn@l_@svAbuAw[jQu[zBaPcPna@hCiVeO~@yPrq@_c@~KoXbLbInG}^pGlVzGkGnK`C|G}XoP?~\\\\wQsv@ad@fCbLcg@QmA|Ksa@LwKyKsStPySkNsHxVlRtVfAvc@gMn`@_SbCzBqGmOqJ|FiQwCef@si@}mAuOlE}y@syArdA_~@`Sei@hQf@MpLhQoBfIim@|@bpAbr@mSz^zA_i@pUgDhOpZmJpIl@hDfMjKkTnPjTng@eG|L}XeByx@tT`n@}Ghc@fYkU~|Akd@mJp]bFjOmc@fXrVpr@hCme@dMvSdKeOve@aBHyKyUo@sOw\\\\zBcmAdFsLzQ`A`PrNrV{LijAkZb{@mPgG_e@sXrKa@ma@rQsSp[~EzI_GsRytArN_`@j[qYhCnu@nMva@jjAtMbQhe@~YjiC\"],[\"tijUyoijBaAj^mNa@yK~OgC_P\"],[\"b_sV_oqiBgCwIssCoDysAab@erAcMwv@xF?cj@hTkK{G}BbQcY_DsKcLlP}Bmt@eLiJtChT_PxAsL~\\\\lSxVkAdO}XcCqb@ev@cKU}JtTj`@nV}HfPyeAiAm\\\\}OeLuaByKlPiKkN_QdArXna@dBr\\\\_IrIwyB~f@edAdc@mb@rEiQae@ce@qSkFkOaFpHxLzYf`@|^gj@va@ke@q~B_ZkiCcQie@kjAuMoMwa@iCou@xwAXva@qY`Jmq@c[yfAxb@{H~GiR_EoJaQbSkgApU}_@Uir@pSc|@fx@{@{h@qIeHuCve@yKaYuCbPoMyh@kN`BrGbLaEfCcRM{QdM}l@oH_l@jnAui@dI{GcDi@uo@nb@ah@{MiOnZsQ`O`@hKtLtJme@nOuC~V?aGvMtEfGhO}f@`RjG~PkCeM_a@yBvK}FgKnGiVeP{b@xIc_@qC}MpQbAHbWb{@haAn[wSsLkMsRzCmGcGh@gf@nHkM~qAgVt\\\\T`SxJ}I_TtJiJpr@lUlDaSba@gCiTtTdg@kGbFjGxLo[j`AuNdGdXjVxA`TlW|[iI}K`f@lQfKfRcNlWjExb@yIjtAaq@hq@{FvWqTh[bDlYqHbI}E~Hgh@p[iTj}@}GxSi]Dub@`IqMdQ}V~o@o_@pl@wnBpWyDjp@zLb|D|nCl|@sFdq@t_@`wAfrAyYzd@lThSzUxCnb@`u@jj@xXrHpWy@brAmKdu@qWpA_mAsb@wUjLqx@}Wl@pg@yHbUy]oIsc@xOgb@iJa\\\\h\\\\qxAw|@sOgAyk@hLoLfYiH`]bB|`@js@bw@|@|j@rStLhQnb@kBn`AfXt{Axq@|QzOjl@}Vdc@{@dkA\"],[\"l{ySgfahBbAwF`LnGaMxQ\"],[\"hzyWohsjB|fCfeAd`@rt@|jB`r@~aAh~@xlAjuB|sClvGlAzbA_w@vWiv@qHaJvHeVrzAff@`bAaN|e@aP{Ead@`Eut@kTaMbFe@|a@vOf]vPxGtJce@hVzLpB`gAw\\\\jt@sT|DmKgF{Ncq@g`@{f@uYiNyPe^~NsMsD}FiSQoPpN}d@gy@qbAoZs]}c@u}Ak_A|p@yo@nFuYcLgeBuUc_@lQ_dB}X{q@vSoi@xY}Cf@uTuGwp@oi@wmBoGm`A\"],[\"nlyWiv{iB_aA{[q{@oDiCoOo[rEmrAsNenBnh@qdA|dAeq@dx@cTrj@uMfmAoa@w\\\\an@{hAkXm@uLg_@gJzHex@kIud@nMyd@ln@eo@rAsSzg@icAhf@}PrUa\\\\bCiy@es@ab@yJgaBvLcZqRuXgdAyq@}QgXu{AjBo`AiQob@sSuL}@}j@ks@cw@cB}`@xUiw@jc@_L`X|@pxAv|@`\\\\i\\\\fb@hJrc@yOx]nIxHcUm@qg@px@|WvUkL~lArb@pWqAlKeu@x@crAsHqWkj@yXob@au@{UyCmTiSxY{d@lh@mR|bA_Jhe@hS`i@oMpIsLdRPrNa_@ns@t^vMkWpHvBn\\\\gO`dBjg@ndBvQ~LaWg@wg@la@eaAdXyChVc[ze@dAvs@oRdg@~CrZig@bK_tAvUgW`MtIZ~i@thAphAlm@xWlJdVqIj`BnGl`Ani@vmBtGvp@g@tTyY|CwSni@|Xzq@mQ~cBtUb_@bLfeBoFtY\"],[\"|niWuotiBeS|~Bew@lpA{kA|hAcU|_AuUxmC{o@rsAgk@vh@}[|Jcx@Pyx@uYquBy{Fn@}_B{Fe]glAmeBqqAeWvPik@z@ekA|Vec@nJhZlWbOfaBwL`b@xJhy@ds@`\\\\cC|PsUhcAif@rS{g@do@sAxd@mn@td@oMdx@jIfJ{HtLf_@jXl@`n@zhA\"],[\"jqbUoloiBti@zaBeDtWoMjGxIsf@glAec@bBcQ}QrJoDtVhg@fDlWb`@yKyKg@dRePzFaBtVka@aG`Px\\\\kPsAIlHtPjZtQsEpFw`@xUuAeGkWhR}PtOlc@{Njc@}j@be@wAjh@i_@do@el@vGys@ds@_Z{[sN|AeLhTtTyKtLxBjIxV`IrAnp@mp@rcAa]lI_TraAkw@|AmYzFqDvCvKv^qXpTnPzIjz@aqAxa@s}BtfB_tHzxGuJ|Bs_@eX_f@mIeKoWjTwZea@cAvT_Pwa@uEuGa_@m^wOzB|\\\\_KNcBzH~XjAwe@`PjH~If`@gNbJpBjDfUeHhUpPn@bEdQwJbO`JfRzGoDjKzP|u@cAbAzKemAd|@m]sW?`PgXkThKl]l]tK}\\\\x\\\\}Xma@vDpp@is@zs@uNkI`FmVms@c_@a_@gAoeAh[gv@hx@yg@lReuCrqCmk@fmAgT_PiT]hTskA_Tmm@dDafAkMm^xGwH~TzU~m@zApBjNkT|QgLgCsBlKr]nJk@zMnPvKxP}XyPwOxPpGvBwEyG}Mxv@uEed@wMk^hLxEgOtzAoUbz@cbAfY{Dme@jj@fUmAh}@mlBd_@`Tf\\\\i@nCsc@y\\\\aZq|@rE}Lyh@uX?sDaKn`@{A}G}XjTcGzGvr@bKdIro@ea@~Cew@`OqVeLqGe]vTgJiMzNoN_CeVvOqNaF{b@zU}HpDqUlb@ld@lSkUgB}N_o@}J{Gwt@~m@mp@vYoKYyv@|Jk]r[}OtE|XiTnK~BtMsGlF~IpT|QwPdAdTxG|@v@ap@xHsFjWrFh`@y]dYeDmXqIpGaYmKhCpGgVwGgF_]j{@gCkkAui@kn@lN{C|f@tPzMyCzEge@vK~D`RcRtEfHsa@|m@~v@i`@|Imm@rg@iZxArWpFyAzn@{}@vMqDdTdGkDlv@vP_a@tCjKnDsUxJMvGdRbXcj@tUrBz[kOyA~\\\\_QzXwJkCgCzPaIUbGna@cGvMbLjSuY\\\\}S`NvL|AtaAp@bEcM{TkXfG}u@zIiIhKrAtVtW~GgIri@mAtWub@lH}h@vg@eBhOwQ\"],[\"lzxUeukjBdp@u[dGF{BzKvHbDjVe~@nhA{x@}Oz\\\\[n[uFfIqG}Xaa@`t@vBnl@fLoK`P|BpGgXv_@eBgFcZh]}XmDuH~L}P{MgOr@}JzH}JzVuBG{FgBgRux@zXiAiGdg@ia@ll@auBfCgc@fgAy{Bf^}oCfPea@fEcy@jIoVt@nj@dVug@eAcPuUvDnGyeAvK_K_PuNoFupAif@qiAsRsX}i@{McYmf@yz@y`@uPcuAaXcVqI_p@eLkIuOjIdDit@`YpKtT|q@tViHfn@dGfHgM}Hs]hL_EvyAdhAnaBrb@|YlXut@x_BfD~E|s@_CnYvR~[pn@`Lvp@lj@`Cbd@nc@zn@rtBli@_Ftr@`w@j_@~iAfl@le@_SldAb[lvAzM|KtbAtEuY`jBbfA`g@ti@xEbCpYwTvh@w`@vVGlXm|@rFc|D}nCkp@{LqWxDql@vnB_p@n_@eQ|VaIpMEtb@ySh]k}@|Gq[hT_Ifh@cI|EmYpHi[cDwWpTiq@zFktA`q@yb@xImWkEgRbNmQgK|Kaf@}[hIaTmWkVyA\"],[\"rl|UiaukBnGyjCdDyF~IlGbP}XfTcjBtPoVdVsG~g@rg@lk@hTnKnUpnAno@bo@mQhOjBtXx[jYuNno@rUv^gZpr@~XrE_MlNkBlSr]lg@OzmBthCrC|IwSrg@|Jpg@fSyArO}Otc@jOzvAzNk[no@{WzuAqv@~vAuiA`{@}LpX{m@fxCal@or@mi@~E{n@stBcd@oc@mj@aCaLwp@_\\\\qn@oYwR}s@~BgD_Ftt@y_B}YmXoaBsb@wyAehAiL~D|Hr]gHfMgn@eG_^vFkMkp@\"],[\"l}wVgzqkBfn@Rv]zPtBej@fGsSvNwGhZba@pr@dSjTdZiy@vTu[uBeYcOkKhGjI|RqNdb@uD|fEaGdRh~Af`Fxf@tP|l@lu@hr@tTbg@eYzoA_IrW{m@bA_s@pGhAr_@lSvRt`@|u@dMjQxVt_Bnl@b^^bx@bb@ln@js@cGdUbTpU|UvFeLkT|}@bg@nf@`EiXnj@qCpaAsZhg@eg@_Dws@nR{e@eAiVb[eXxCma@daAf@vg@_M`WodBwQadBkg@o\\\\fOqHwBwMjWos@u^sN`_@eRQqIrLai@nMie@iS}bA~Imh@lRawAgrAeq@u_@FmXv`@wVvTwh@IkTom@_KcfAag@tYajBubAuE{M}Kc[mvA~RmdAgl@me@_f@qnAzm@gxC|LqXtiAa{@pv@_wAzW{uA\"]]},\n" +
+	"{\"countryCode\":\"OM\",\"polygons\":[[\"}vmN{yia@dViv@dP|Ap]rx@l@bSmBvFsTOcMyUqT`F\"],[\"c_|Nkgfa@m[yGevAmw@uIqOpa@qAiKaa@xSoPaPal@fCeViN~DaBtObMbd@aj@uCzNqYaHkByQ|XoPgTg]uC`PkKoGyK`PuCdGs^HuNyKtCgCgLzT_DjBbb@~]z`@hQaTcEmZvG}EtShPcK|B?hL`P?f]{\\\\{Fbi@wPdDdGrOcPoGcAdMxRvWfe@mCkTmPpX?|BgcAvd@`y@|HeAqFyN`FwGpJIxGjMdTqLzDoRdL|GaFlR`FdNzM{Hdg@fUlR?tg@ve@eL?r`@nP`r@iB~Dxl@c`@|d@ue@lAkSgRac@dSs`@iRauAaG}c@~K\"],[\"kzfNqfla@pq@yLrqAym@pg@gGl~BqhAxwB{uBnp@yWlbAo|@ldBocCnj@glBny@ifEbDa}AhYqW~J}_@vCmwCjT{z@x\\\\ka@pEwT}@{sAkSuV~Diy@xs@wm@?iLbPsOqGqGvKsCqGiT~[iRrm@cHj_AekAtc@uOj\\\\m[|c@gDpd@wf@~Z{Gz`AgyA||Awg@tR}`@nw@yc@xu@_mAnLhK~BgTmNco@~V}h@?aPfZsJOeMwWlIdA{b@bOqLvf@iK|`CnSlfAdo@fX`\\\\`aBxYvHxWd_BhbAbqAx]~uAny@rXvEdr@dnBpt@|dAdcC`|Bvm@~[fa@tDb`@|f@~KqAbTtc@nVhLpJmD~FwJaPwYlKXhOpPoBzSpa@dLn\\\\n`@|lCvl@zFzTn[rVkMdu@dGds@eIoAmK|n@{d@qE~XgLug@|Bw|@mUqLtDoAz]`\\\\xfAxa@vMlRmDpV|q@tYbWp\\\\iJxLpCnj@`dAdd@fSxv@eNfsAnTvrAd_@vfApl@|m@gRf`AvHzuAmj@nwAnMndAge@xe@dEng@uVzVbNz\\\\faAaEbaChZvvDh}@xuDlg@~aAz|Ap{ApUrJhx@_Ani@~Rzy@hFfgAj_@jXyFpT`T|Qrg@r_@bR~e@lm@rQZuIzgBxKdl@gCdt@~U`kA`F|~C|`@daCjyAhn@lv@laA`j@zTj^Yzk@qk@lg@bCxe@zYxa@uD|MtX~g@vQrIn[`UvJzq@d|@oH~H~PdUx[vqByF`FrCtXqG|X_^zMuJfr@pGnpAeHxk@dHxKpGxpD|Sdl@bX~@zPvPtXlmCfs@ls@jOt`@lM~kDvx@dzCseKlsDgR`q@{c`@hoM{oR{qy@wve@_{K_[xE{gL|qGmuDyAsmAsTc_Boz@kxB{`@{r@wj@qlAsSoy@od@m`AxC}a@bj@kS~Ase@yuB}@egAzK}Zec@ioB}H}EcvAlg@eFvQlKpl@gJrm@adAi_@ob@WuPfT{qAfHat@oc@yd@rMq}AxBgh@mXs\\\\gi@uD_WlL{j@jz@yMqNx^zI~Kp[yZ`j@kQ|LcRXcVoHsP}o@mZoJak@_`@sh@mo@}K\"],[\"yrtIspaa@_KQoDeO|GmP\"],[\"{}uIqyea@fP{FhGiP|Nl]cBhm@mLjD\"],[\"gzqKmo}b@dhAiZvk@daA`c@jUp}@lMdg@`e@rCrShVlWqaAdIkx@uF_Wq[}MgCyKsi@gu@uOym@yb@gj@qQuHyMhIoF\"]]},\n" +
+	"{\"countryCode\":\"PA\",\"polygons\":[[\"c`zDnwkq@bwAkgBtKoV_JkOlv@|Ut]cRjDdWzJpCbN_RfUuGrLsYkI}NkJjFpVgWgR}DfKiHkOsJE_HbPqGiCoGba@fCiN`\\\\pBzUn`@qC{BvKhVuTvg@hCiCzGhIwItHg~@nc@oZqIq}@|HmWuWy[tWmiAmDeJwb@YsVhi@o_@~TeYdb@Eyd@uFqEmO|\\\\cFePhf@si@zB`PzWcLfCyc@zRoX`rAoy@zt@iv@jRsqCqNcqCip@cuBcBad@qaAwz@es@e{Aoe@{iBuDigAga@ydBmfAqoAyYuLgEwNhj@eNuAwK_]}GfGkKce@iAfG{_@~X_O_j@?oo@__Ac^gGyGaO_B`RoZm_@_Lqp@bBiJna@qG{G{GzGsXaPuCnb@ecDaTojC`KgGxDtI|Ffq@lJzEza@{C~@}`AzRy]H_WkSmtA|KqOtHar@_Kuc@fQ}bA`iA{wCt`@}iBxpBgpBkg@vLhD_JbuAkeAxOdCj`@{S`BaNdp@or@kUfH~s@sj@oHwHtd@R|Zii@iDor@pGeLdLlFpHj]hUaBnh@`_@z_@dDvIoh@hf@kRb`AqI`W}b@`~Ast@|gAuQtYuVfVttAxnAtp@~h@cXteA~hB`h@dk@uDxSe^rFyb@x]mOdB}\\\\td@fG~Mnp@^b{@sTrZ|M~Dtg@zuCfn@aCjMc}@vz@y_@?HlLiQtCxAxL{\\\\l_@ye@zVlHpEgf@`DnArK`Jh@}_@jAet@jx@u[wGcaA`_@km@tg@awA~XmZ_QdSgFsBiYfHwPkb@sl@qb@_Gup@nh@yBg]gLzGoG{GvKuO?kRyiAqZ_FmMr]iFbTsj@lt@g]SyXtNcS{BqGwTn\\\\~J{n@`SqXzW{HdB_SdY_KKyRuGwDBlPuZ~U|@xI_l@le@o\\\\`xAceAjZkHmH_YjYm`@wBq]|[pZeE~DmJt{@qFo\\\\hVaDzNxd@`SzLh_@_FrFkMsCgCuYihAzK|G~G`PiLve@hCzBzGkKlK}SsIiHrIba@dLl@~HaZhJfXzLpLhSucAbLfCpGwKzBfkAaN|RmMlc@`PyK`PuTjT_e@hC`PzGqMtHad@o@aj@x\\\\?y\\\\mMrLyT[uIxe@mKuC|B|ZoInHcNrDoQeKqWrB|^lBtHzRyWdRgFn\\\\eb@hUoGuCiTlYzWcA`C|Xkm@lU_|@taCgm@i@uKvOkEsVeR{IbGoNkTm^zPd_@{GvMfW`UnDnR|\\\\{RvX`QwPzb@xIdK{HleAvCbkAjH`a@~RfV`PpGrXeLke@ba@th@q@jExr@rC~GhJkBeGz\\\\xKgCjBzS|PrLphAgBbd@tp@pKe\\\\aRur@hj@lo@~O`w@xRvE`|Av_Cdk@`yAdGfcAaP~Xdz@~y@huAzGna@gVvVm|@faAq]ppAs`BhgAcx@lfAyc@fh@fNbSnhAfC~m@yKf]pGz\\\\?{\\\\rYp|@xf@gEjEtWne@h^gChV~UbtCs@jsAjGpGqVjSeG`Uy_AyV{RnQef@yBoa@j_@s[{K{SnSoGsC{c@nj@iNmGPwLuOlVuTuIaAzPeQpR{L_DkLob@_GY~F`Qiv@|Ah`@~U_KbJvTyKcLl`A}QtCmAhJzGoGtFvExNyVvLzBtTsJdf@h`@mP??nPvToP?nPxK}GeGkT~EsAdk@rAdLxKaYvl@uK`rAiTtO_Czm@gHXiM}VcFpW|KpFyKtTwHaCyPfKe_@yK?hTcQa@eBfMyXcE`C}IaPxKkTgCzAjKdT}Bf@tE}bA`c@|ShCkEpEwZxIrCe]elA~i@zL~GlF_HjTjTmi@bjBeY{KnXn\\\\sOvTnDl\\\\kMpa@vl@vOn@xGoa@cGpGvZmKpR{G}Gkk@tGsAuGwMhA}EcNhAtX~VrIcDrTeTz@rC~i@`PyBxKxb@eIvSqJaI}SpGrXtThToGpAxJLnX_\\\\~zA|Or|A~Xr`@zTdH~r@wAdm@wMxVhPwE|Kei@_FozAxb@cKdN~@dPgf@jb@qm@s|@oU}r@}OyL}rA_Ccb@tKsk@rb@eQjAqVo\\\\kMxFaGaEi^wfA_YwZ{_@pQok@hrAwObJxFnW}vFl@wGgD{Liu@ci@pSoX{R~Kwn@xTcd@n\\\\aTdGu[gHiKw]aFfE}V\"],[\"simCxx|p@?sXf]jTbGuCi[fM{Iz@\"],[\"wtrCf}op@wKme@zBca@`XdYgE|`@j_@je@|@fe@eRgQmEe]\"],[\"inoCf_zp@bAmYtc@iTpGje@cPn{@er@xz@gd@vSoOxCcAiTwg@cNkR_l@mPrC?eLv_A}S|G}SbIYlItVrXrE|b@sT\"],[\"oc_Djudq@}@~XjRsCgZpj@oJeY\"],[\"oi`Dfggq@jKoP`Pxk@m\\\\vZx@cQyUm_@dI}S\"],[\"ygaDxxgo@x\\\\wPzCxVlMiB`LbNcUxIwYsE{QsI\"],[\"i}|Cfsgq@yUyJiC_M~Qq@\"],[\"avbDvrgo@_Mp[cNmFbMsM}DwF\"],[\"uauDz}bq@jTg]fBvWvVjNeGvHmg@`BmOx_@?oa@\"],[\"qyvDh~fq@aPye@fh@sT`ZzG\"],[\"gedDrzco@aIm@vK}OfXaDpH_KjQxFbOmGj\\\\tMgG`ZnO~Jrf@iIut@dh@{XmHsMlHqm@~AwGkAgFye@\"],[\"czjDnbqo@_BkIlPoEwCbO\"],[\"s{pDdcxp@|LcTiAfV\"],[\"{crDnnaq@_EzAlXyXjDjIcGjM\"]]},\n" +
+	"{\"countryCode\":\"PE\",\"polygons\":[[\"r|tIxrli@j|ArkB|@zrAhSxRrYzC`bC_n@pVLzdBjr@faAr`Abn@~tB_BfbBhI~n@_mBjsDai@fg@qo@~pA{j@hUeb@zx@eLrp@qaA`hAkb@d~@kHlYfClVmg@oNopClXigAjfA{Jbp@gTf]eXxfB}{AdyBqM|fA_YzBie@pl@kU`{@gb@dGif@xa@g\\\\to@ma@h{AXj`AsRpv@um@zaAid@ljB_r@zfAaJrjAqg@rQoV|y@i`@rnBmFho@lIhN}MGcRtTcZfbAocA|cBoN|PuMaB}PtJ{c@|fAcExc@gZd[Fze@gLxj@uTf]}g@lIgcBhoFsPbLao@buAIlYq_@zb@mW`s@yUsVsr@vdA{A_RqRXk`@ta@}Ohu@wmAvk@wZda@k[jA}vB~nE_VbPcX|f@ij@h@a_@jXsUkDsRzH{Rjb@g_@|ZiJ`_@yRnE{@eP{J{Dgr@pYiG|R{]lTkGpTd`@hL}S~J_u@XqR{Mic@lIeQ{GoPpNgTs@mDzHfQfIcGdj@oaA_ScDih@pWlC|PwXqE{CsaEml@upBzWygEzbDiw@vSun@EoiAbfAqz@j_@qUEyh@da@}Yhf@sO|Fuf@kQ{VhD_p@df@k|@lrBkw@vHwN|TuItt@q]sQyw@Na`Bp^uKiQwKnAaw@zWiYh`@aQxKeOw@gv@bp@ohBfrDwj@LuHk^aY}@c}Arh@gJaHcj@xMan@dWwUnZ}`@pEyu@~s@kxAhm@aVt[uq@pY}Q{A{Tz]o[n@iN`Pun@bKmw@jo@{I}Gy^dEwz@nc@{[_CcYjGug@uG}k@xk@qaA~i@qc@c@kExJcj@xJmO_LyRfYmZ^qGuGeTbTh@bU}d@tYqUcVoZxCqI|V|EhMxd@mBii@hc@{K_@vFkWaX}Ew^hSaBfXaIxD}YgG}IpSoHuFq\\\\pB{aBlcAwf@wMo_ArJ_q@vd@_y@jjA_JaTuXyAiw@ne@ij@vL{nApuAiNH_nAjlBq`Avs@{XfFyn@zj@}LeQ_K]wbC~zAu_@zBoNiHajAjWgv@rs@m\\\\}CaN|JqjCpjCa{@vSa\\\\zWmw@nfAkaA~nB}wCvrHsd@bf@sgBfzCmVhQuc@zK_y@W_ZqRYcMk_@eLVaJxQ_KlSo|@yaAmn@ky@{CusBfn@qyAvvAqJbd@ux@xh@kZiSkLjFkIoHq`@B}CaTpIiIo@sQwb@cKu\\\\nL}b@fg@adAfm@coAjfAuTeSmY~E{h@sD_c@jLeq@{]gp@|Gwg@sQsl@o|@{k@qa@wEcV}lAgq@c}@emAgg@sHaj@_[ilAqxB_dAao@sOy}Agt@kZxLce@vEwG|c@tBxPqRxf@`EzGsNxWkDbuAfBjjAeb@p`@ry@xk@nh@iTv~@fCbWvOdOxi@xLbpAyZeHulAlzBnxAvVcBlVoc@~AkY}v@unAkv@o^mHog@dOyd@vp@a|@fEuo@fq@ok@pBgVw[auAto@s}@jHs^`u@|KpYgQn|Aa]jVqa@fVwIbKeVrb@sVxJk{BpReXa_@ca@yt@cQqEmj@kKsEc{@vPi`@wQeYm[qd@krAwXk\\\\_c@y@mc@kS_TnUsm@vDwlCcaAejAaH{Qig@q}@qIqc@ce@o~@zB_EwNkt@`@aaAuW}|@qFnKoy@z{@iRqQcf@_]mCiZ|WuLAyXc]co@aZua@_f@ssAc~@cg@am@mcGobVmUad@o~FylIwpJ{bIk~JgcBoD{Bld@ac@mFwjAqQjNosB`[mp@{@gZ_Woq@dBe~@pyAcr@l\\\\qeA`Ssg@zy@c@jXwQvJsWOhF}dAvV_k@qFodAed@gx@k\\\\_LmGsNrNwt@bq@wj@zBaU`j@er@xF_e@c_@_]jbAkj@lTbUdHkl@hOuRoGuZf}@}p@p_AgyAlrAe`@r[rKp_@oX`F}X~HzCxTmL|Lpa@nWsXpm@{Ul]_{AQ_PgQuHnv@yWlFwK{NgTxX]|Bke@l{@ef@dGea@eX{\\\\vS}LdW{z@x^oJ?eLrZaDtLiOlQxKnHwDde@co@`z@}FlQpSxk@nGfg@}s@zK{Bj@c`@pQ{UwNeTfCq]sQoE|[eg@`]Jnc@uT`g@bEhfA_c@zj@fWfZj[nOp@rp@iWtLod@zQmHg[cPrV_j@vu@s_@u\\\\upAhN{YuToP`a@iLyIgQek@iWr]yq@xL{jA|RaPpGuU_J{y@ePe@{DiRvGsm@mw@yfApAoeAeyAieAu\\\\iw@~b@eHwKa`@l`@qvAdj@{IjMiMy]}IuEaNtk@a[zPxK~KaFeYy`ApG}CgL}`@zFsZcQo\\\\q\\\\}JwEgMfCc}@a]bFkGqWhMci@wD}Kd{@}gA`Lyq@bYwCpPaUz_@aIsAs]yQq@{E}Ij\\\\yy@v[oWgF{u@rR}C|GtOrNiF{L}i@xEwLfp@sVoBs_@`^{SoZ}Ztv@sa@hzShjL~AeZ~o@mzAhGk`@wEw_@q]_e@pEsh@xz@yy@~_A{Pzo@}nA`m@ii@bs@{Ql^vOdN|S_@pPyPxQ}YtHgBlYnr@th@oW`OyAlOs_@|c@qn@oBiPdKkAxF~PlJgTxi@`]xt@_Y`Tr`@fUiPn\\\\{\\\\[aAxHjb@p\\\\yIbq@fNdc@rN`I`TmBzFhRbsAjz@qV~UzVzMqLt_@pVh\\\\kWvX~\\\\vZaUt^bKzWd[rEaBvH`NhSeNxMpBnw@~C|PzUpJyAj\\\\fKl[cLiAtPj_@gOhTvGpXmHzZtL|H~HpaAly@doAgCfU~c@`V`z@lxAxAnq@`K~FbFzXlr@zPjOre@xZrJ~E`_@d_@li@x^`RmBf_AfOxNbFtq@vM`B~Hzc@dvAgLfY`Ph~A|]dy@cGxx@fItb@hN`iBruAl{Bfz@x[Hdp@_c@`uBu^`t@~Kjj@~^nJrTlKttAnfCh{Dp]vTrVz_@teC``@jkAso@vp@kLpQrPz@zs@nUpn@sIpq@tx@cW`n@|OdKl\\\\tmBioBfi@cnAvW_E`W|EpKfSuFh]lh@aEzUwU~Uiq@lkAyh@dn@j@de@_Y~t@aLljAgrB`iAsBbl@}d@j^agAbkBgaA|Um_@ja@kXr}@mIn]zFl]|a@tW`CnLza@~eB~lAd@azFjUcwAkAkUdWa~@|AymAb\\\\sg@G{Rvi@kDph@oYpb@pHtVkFfZak@rk@{RfoAnSVuuN{Ok_@nG}Wm`@o_A_o@s_@gd@}zAk]sPwTm]gd@_[_rAuxBip@om@eJ{q@bf@lJfUpStUiFFld@lj@wMpl@yc@hk@~OfGv[dd@pMjsUx@ml@_bAr|@o{@~Lw[pBu~@wMuw@ekAsvC|Ck{ApVav@{FcxAzg]imPtf@bYz`@`i@`OaDlKmY|ZlMxZ`dAr]nP{@vLxh@~`@lxDYjiAqIbgC|Cn]jHd\\\\lVxVJbGrKqAfZdMvG`u@ot@``@lCzf@yQ~~@gE|h@go@|}A{XtK`KnIf~@~IxN~m@vBdc@eOnlAvkBrm@xBaAdg@fmBpS`Nrk@tOtRz|@u@xq@hIbjAyz@`qAyvAbRfJEzYbWlG|ExSlV`Uba@iB~U|b@~c@bG`mA~l@`hIobCjYuTrUag@gM_i@^wk@lb@of@`U_n@zOiEjT|Lfd@dzApRjOpnArF~_@{RnU~PxXzm@vI|j@|v@hY~_Aj~@bj@~VlRx]pNuFxWhOvNtr@fi@`t@tBjPd_AhZrFgj@zi@um@\"],[\"pquG~dom@|RwHyKlXoH{C\"],[\"`cmGh`tm@rMm@?~PkRkG\"],[\"zsjFlmcn@p^__@cMna@sQrI\"],[\"ns{Bz}ip@|MvFpj@{HJbNuYfFic@}L\"]]},\n" +
+	"{\"countryCode\":\"PF\",\"polygons\":[[\"ddmLrnbrAhEpK_L}L\"],[\"vhbLvrfrAz@rUoHuL\"],[\"nv_Lj~irAmBwIrJw@dEpG{IsC\"],[\"ny_LrtkrA_HrEuA~AAyCfAoAdCg@\"],[\"`g_L~qmrAgAbJsDoG\"],[\"za~JvmutA~EgRvRrAfCrNuMcO\"],[\"d}xJlx}sAaIxF|ByJ\"],[\"lwwJ|zusA]xD]bAeAt@c@aEE{Cj@y@\"],[\"davJjbfuAeEcQ|SgCqNvF\"],[\"putJbn{tA_H_OtSaUvDrNwJ}HqEbF\"],[\"fomJ~}usApGgD{JxU\"],[\"hu{IpwivAaGkKbPoFuMxF\"],[\"|axIpn}zAiGqFtEy@lF|A\"],[\"`frI`niuAeK`Z|@{UnVkE\"],[\"~drI`}nsA}DvL_SoV~RlS\"],[\"zbkI~rivA_HfIvJoW\"],[\"|wjIvphzAaBsHjJtJ\"],[\"lagIvo}|AjTsGcc@`R\"],[\"fddIll_wA{Bs@dAgE`EcFjFmCcAbDwA`D\"],[\"jd`I|o|{AtCfFqApCqBi@\"],[\"`iuHxq`xAi]f_@|MsUhHyH\"],[\"j~sHrv~tAuA{UfM`PkBpH\"],[\"b|tHvonyAaVzPqPyIp[cS\"],[\"btsHbalxAtM}A_UdL\"],[\"b~sH~_i}A_PrGbAeJ\"],[\"jfrHbehwAnIkQ`DlL\"],[\"vimH||wwApDoMwU`hA\"],[\"t~jHjjfxAhQnDwXgC}MbLlFyK\"],[\"zshH~tnxAoCjJ`FaPhPuG\"],[\"hohHdb{xAAwGx@kApDUgBvAm@vAa@|A\"],[\"fehHzheyAy@aBtBuExDkGbCaG\"],[\"zifHz`_yA{Xb`@{ExJpDoKfGoJ\"],[\"npeHnhhyAmAl@{@n@{@T{AW\"],[\"b|xGn`axAzAnEbAxE`@~EG`FmBsS\"],[\"nctGbiduAhC`B}BbEwNtMfBiE\"],[\"zfqMrfdyAoKun@nKbL\"],[\"regM`qfzAtCwN`RdCOta@eSt@\"],[\"nobMbblqAgTwn@na@|X\"],[\"`}tLxwk{AhTkRbc@|Hcl@vN\"],[\"x_fL|nztAkFuJhd@}]fHvOqDjP{M|I\"],[\"vgzIfsztAzo@naAkAj_@o_Aaa@ci@opA|@cPtXjA\"],[\"~hyItv`zAfk@oTtZpCfMdTcNpt@enA~k@xKzMxKbp@oLpmAeiAbf@}g@uBgPyPaPet@wAsZ|Msu@x[o_@`jAaDlPwM\"],[\"hbtI|ulzAdH}ErZ~JrZvVeSpk@yY|QqKc\\\\dKmFgCa_@oJeF\"],[\"vofIrid{A?yKnRqBtK`TeRrO\"],[\"j|eIdqm{A~D}\\\\fdA}o@tOf]cAxP~J|EeFjVcmAnI\"],[\"pieIlvd{AfSuJ|NzWcNbCu\\\\yJ\"],[\"bxcIjdm{Ad[bBoVfo@_YuA_Huf@pGxBlH_X\"],[\"xy`Ivms{ApBwOjYeC{GtQ\"],[\"rryH`cjxA_DmPfGqLz]VwH``@wIlE\"],[\"nsjEv}ssAzGye@zj@iIrRnIAj]kTnDmTgH\"],[\"dj`Enm{sAjMsTvx@zr@qm@nGq_@kX\"],[\"dg}DzsvsA_@cMfe@xo@v@naBn^rFsN~q@ea@pQgGkAsh@g_BdBiRxYcYdGon@\"],[\"bbuD|zntAhMgMo@kOpx@~TdFtQin@bh@m[sb@\"],[\"nolDn{ctAnPwB|Ifo@lNnRwc@nGkV}S\"],[\"nikDzintApU{SdV~@eJvU|UbL}DtXzMr_@bNhGuOxZ{_AzSuUiGuBmRbLknAcGwe@\"],[\"lp{Cx{ztAjNpHgFbK{UJwPkLcGwS~DwTrIfV\"],[\"~syOj`awAxKvKlSBXfTom@RaDmL\"],[\"pdjL~{vsA?rr@sCuKf@uKxA{K\"],[\"~mcL|stqAqXti@`EqX\"],[\"`wvKhwpsAiP_Jig@bPdAxMcDeKpTeQ~YkC\"],[\"psiJhkerAuTvI}d@nhA_AvFxIz@yG|GdAy_@r_@yz@hJ_O\"],[\"vufJ|nztAgr@tcAra@ez@\"],[\"dseJbfrrAwz@b{@xV{b@\"],[\"dybJdm}tAqaBh}AhNg]`aAqz@\"],[\"jdwI|u_vAiKuFej@lm@rh@yq@\"],[\"r}sIn`pvAuOje@x@iV\"],[\"botIxmvwAki@ba@`EyH`IsG\"],[\"lorIpizwA}F~C}F`BeHf@kJI\"],[\"tqdIjoovAi[ji@o@l{@[ycA\"],[\"j|cI~bsvAkAv]e_@tq@\"],[\"f`bI~|wvAe\\\\lRsYlt@pIcl@\"],[\"xy`Iho`wAwe@tTjOmR\"],[\"vc~HdxbwA_FrSfAlKaGjDL}Bb@UrAHkBuH`AaIzCsG\"],[\"jh~HlmxwAyz@fk@kjB~d@l~Aea@\"],[\"`pxHt_{wAvCjz@eJa_@uAmO\"],[\"rryH`x~uA_NwCwNuFeMaH}IwG\"],[\"v`wHzootAuHjCWfOlIdQ}Mg\\\\pCoJ\"],[\"`pvHvhrwAye@ti@fE}H\"],[\"xquH`dpwAqlAbl@mKhRbMaZhn@q^\"],[\"p|cHrojyAuY`WqSjx@dHku@lMiT\"],[\"d|aHn{uyAqXdf@|Ei^bGcJ\"],[\"vj}GxyrwAdX|g@er@gRpl@vN\"],[\"|czGpqgxAaY{IuKvRhLqW\"],[\"nlzG|aowAey@{fApNzfAoRelA\"],[\"lrwGbq_xAyKoA?xk@mAip@\"],[\"fj_LvtmrAv@Z@h@mAzAEi@TkA\"],[\"xfuKrn|sAdAxBVz@HbAgBqF\"],[\"lseIvq}vAeCuOlYgQoVbXbJfK\"]]},\n" +
+	"{\"countryCode\":\"PG\",\"polygons\":[[\"hxq@al`uA{BwaA~\\\\sxBhTuKeBgG`R_Qp~BctH`P}CbR}ZcAcmAhc@md@`YtC{Aum@xvAybFzuAkvGxKeq@uCc{@nGqt@zKsXp`@ghDdj@ym@zW_LjQ_h@oFmUpJnBhLgKfKks@nc@gVjYmx@fk@o]dE_Oo@}yAlGnXfV}_@cCsW`NyKoVcU}Mg@qDaT~Je~Bn[}SrgAyE~O_]hTmeBpc@i|@dKkF~IlKxNiBfPkm@~Yo_@~\\\\iDrn@yo@~Su_AuHomAtHgWptB_hBhAcRdg@_VdkAecAt[uu@vPyMpJqEfWnL`j@`Bb|@{Nn_BjK~i@jY`b@]|n@hM`a@cSdBoeAtRkL_PgCdCqGkPoa@~X_n@zLciAvK_EvFqs@|KmPoCgb@|Py_@_B}h@pXgTnEmUp|@smAl]kqAjU_Eh@cjAda@oVd]_u@}FaXzYkTaFeI|Eo~@wSiXzD_]{G}KfR}`AdW}e@`PcGjp@{{@nfBg`Anf@y}@jY?t|@oXp^~Iz_@wElIvK|JcCkBsGvq@dCxO~o@vDtlBtOvTVze@qLxh@pGnt@gCbhBgQl^nUzhBlv@zKjiAyGno@kk@eJi[hXzG|X{Gf]rCti@un@~x@_FrI~Ehi@yKz\\\\kTbNvCdNaYyKcPxKeCvPkw@xSoI~DqYdNgQtDbDlNy_@pk@aO`Iui@`y@_TlK~CgCg]aYxKtYk]fy@_MrPcLdBq|Ad^ww@ti@oRwAec@jFcEuIaq@pQnDj}B}h@~p@b@ls@ySteAbCdb@mj@c@wY~g@u_Ahl@}XzsA}DjKwPvOvBlg@mMrj@kZhKeUlKwtAmLqx@uYc[cAye@pHyKeI}w@{d@ol@dQiF_Ciw@nJqLZmZdQ}IlZvKvCwKxBhTfLyZvKz@`P|O~DnYhhA|Szi@d]biAiZdQgbAle@}gAfG|Dt@g}@hPip@mMmdArXmaB_BieAp^{\\\\|SuB|Qtn@lShoAqB~YfThn@`WnBrnAaqAxWj@dDyXt_@}q@h@q{@xPqe@iHg_@nq@u}@nQ_z@{Agn@dKqh@eH{T`BoYlPxK`Zw[}Lu`BwPe[q@k[tNfLlv@x{BR|s@sWnwA@xOtOzS~VfKnTcHr@ei@tm@mgCxJsMxk@kIh`@ltANvf@nZr_@vL`B?be@uQ~PtQbi@oGdL~OdXaNbRcPkEcCtEtMhg@wOdLmPpr@oFqJuUrLnPtNuGpNcLoBzG|KcPx\\\\zK?qG|XuMwWgJe@fCjKyI}IrHm|@gJea@mPiCiCoPuTfnAxe@rRwx@`_@cc@hlAOzlBjCvWvKgC?jR_P~IbHbR{CtIqGcFea@`u@jT~Xin@vkC|Izx@fOt^sY||@QrdA{\\\\fE?pGyPaZ}LbM`LlFuBdMy[`Lfd@rKrM~QwH~}@dJlMlEneAsNpg@g]xV}@xJhHzCySrXuCpa@cPbL~TpF|LlWcAlt@|XpXmo@`g@xD`d@cQePaOvG|TfM_N`d@fZzi@_s@zI{Tzw@a~@vw@qdArOctBfzA{@zNaW|DyQ`]zCxHjKaF\\\\nMiNre@gHlLai@`Qh@vYxK_PxOn@qCjT{r@bb@qdAx\\\\x@zd@sGrEaIeNwXhMo_@sFaPtKua@kv@sYZ]vJvRdB`XzYv@hUe^`z@?~Xs^hsA}g@bZi]fEoXmGfCwI{M{NcSg@`IjXu_@|FKfSm^dDe`Afo@_^dl@smArt@qcBhYkm@biAyTjI}OgEePdNmEwIsIx@c]fcBbCvc@uTrr@cV`PmEyKwInGcRoG|K~i@pKaHxCdJMde@}O|ZdFbjBir@djAj@zo@sc@`d@bR|HZpJqKv\\\\{XbPfXdI?xe@sXrXlI|FmIfWzBbNoVlV_WaNw{@WmWlHveA`P]v`@{iAjhApl@uChH|DzCv\\\\wRtWuoAjYznA|FnPoPnKxM_]d[ucAne@njBkT|dAoh@rAzNir@bj@nMrEDfUss@fMu]zS~o@gMhFbE{ChIse@vV`PrXtDeO|c@rF`YgTnPhe@eNvPdBp\\\\qr@heCqYpl@iKtMymAxe@eJpj@a^b^pGtC|]oYxLgg@dd@uGptAyq@bv@sHneB{}@nLvHea@xe@cC|m@dVcl@tVoV|QjSl@dfAwc@vgAjIxu@sDlm@yj@hXaMvs@hL{@|K{j@zb@kQt]gy@`gB_n@jP`MhDrjDdLrV|Bdt@xe@t_Dq]vgAyOdwA|NlbA~[f|@gMlq@chAfSoOjLcD`Z~Glx@pZbp@tIb@~BqHk\\\\uk@gBwkAbvAaZf[ia@}Coo@ab@}~@rCc{@b{@cjB|`@o}BfmAubBdmBqrA|wBkEnVpSxMbc@bKjwAxKtIvQv_Al^nt@hUpOn|@~aCx_@~o@aC`n@as@beA_r@~}CxKzXxDplBzQd~@{JtcAjT`dBeLdYf@jj@mg@dv@oDjn@|t@`zAvAv\\\\sGnVeeAfuA}cj@CiDdT{RfA~BxMq^~GcTdRiNoG}^`Uo`@kI_OrHiTca@aNnFiCyLiYuBaJgQwS^yOpUgCeXuV}GqK|GeWaN\"],[\"dhaF_dz|AtCwIrJvBiCxWzKxb@gGrl@nMt\\\\eI`C}GcL_Kfw@iHuCyK|GlPjVcKff@{f@wE~CtM{GnIhTna@yK`BmJqW{HBsCrXgVfRr@vLqVO}OnV{ZuGtUs_@Am\\\\hIqCvfAirC|Ui{A\"],[\"rs|Eaid}ApV{KvTjTiNj]pKbk@aNpEjOzBtEnUqCxXuNjIwEfRcLmPmKzGpMikAsI`BYhPoW|K?}mA\"],[\"jg{Eu{n|A}GyG{\\\\zr@sQhF|Lou@vT{e@tPnK\"],[\"htnEepg|AbLaa@`LeDbTjhA}\\\\bjBsT|XvE__AzM_YyVi|@\"],[\"pcnEute{ApLhPxFaLrQlLoCpR}OnIy@je@z\\\\vFqYpFuVqLgDut@\"],[\"dxlEexa{AlOoOnH|Fhj@u@oCn|@_YjKfNe]NiZug@bl@oJ|@pEmR\"],[\"tlaEstg{AgSij@nBoVzWmIxaApRdg@bVne@?y`@hn@}Xb{Bgk@pBeXcXmWb]jOk@fIpWwUpNec@?{`@xQ}g@hx@iq@cAnQq]xe@qRnUql@fl@wg@dSeh@dKoG{BrMpUx@`Z}`@lDg`@aYpA\"],[\"vo{Dsi`{AtIiAuI|e@hCjt@qUhbA`DhgAus@aq@qR`DiPz\\\\ij@hRk`@gBgP{l@pWmx@pe@wn@uKmk@_KcHpOiWdUqUvO?jPa_@vZdAxGvJlKmUjc@uRne@~v@g_@qG\"],[\"j`vDq{wzAlHiFvs@j[z@mO~HPjQhMCzNeJ|d@wYhBoMtm@sm@rf@oe@{Ai_@mQeYcl@p`@a~@\"],[\"hpqDeek|AzCkIdZjOcJhXtI~HjViX~Op@wPrNxL`]xAtr@{l@bi@dMxIlHs@zAyM|UxIyPrHkPlg@mLfFcH_ClJa[}MB}C}JeEdEon@naA|DrNqWvh@|BtTcDlCiLaUm^`]rIbOj~@xAyx@nS{[yf@b_@_h@lCukDnh@yk@tGgn@nUmC\"],[\"jgjDklf{AtOcObUyAye@nX_Ypc@{l@e`@ek@aOmS|X}Fzm@aKhCkv@we@qEsi@vz@tKpSyQzYJnvAlS\"],[\"xugDgzsvAbC_LdWuMlMB|MvMwXlMoy@|wBem@jm@yOxr@in@tn@oAcXr`@m_@fb@m~B\"],[\"fqcDckovAs`@df@kHsTxGuLn@i|Bh~@aVpEhq@_\\\\`t@\"],[\"xnlBwqjyAh_@uPrv@dE~m@|PsC|GbP?zG|Swe@pG_Rd{@y`@nOg|@nt@o`Ak@oPi]zGqGeCqGx\\\\y\\\\`Ncq@\"],[\"nu`Ckqd~A|t@iTph@hVrHbJer@cAnm@nVoPlNlDdV~y@riAmVjkBsiA|wB{vBbaBhCiTgq@iP{w@D}[hKubA~jCudApc@kLr\\\\aoAtoA_e@fHu_@yCki@j]o`B{WgYzF|FuSgMeEkg@vPmYfZ_Lc@wAsSdMwXbg@sa@`CkUpTmOyK_TnC_y@lOyGkKkStVz@dEmPrdAqb@x]gZ|ZfA`j@sOpG_h@bf@eb@|E}Tl]{S|pAg]p_@s^_JyUf]e_@{@oNcWiMbW}@h\\\\oTpHuVhg@}ZdLea@l~Au_A\"],[\"j_hBwqkyAzYy[vP_@xI~OmDnQoPjH}Ri@\"],[\"ngfBi}ayAfTqX`Yx\\\\oPvT}O`A\"],[\"v|eB_ezxAjt@kT~i@xKzKje@cLff@g`Avy@en@cGq_@az@QkL`d@{k@\"],[\"bxdBs_m}A|eCdW`FdMgL{BdBnHzUvNchCnj@g[cLym@nBdJdYok@eQa`@gd@|BmSxOuLtbA_D\"],[\"|j~Aep_zA{Kq\\\\lI_F~ZdNoHpO\"],[\"jf}AyofxAcQcEcDcRz\\\\yKsCdGhNbARjH\"],[\"duyAqhgzAhQqYf[^ea@x\\\\dGpKfHqKbG|GtClK_KxMwL}E\"],[\"|pwA_obxAv^a_@vm@mCv_@tHj]x}@yYr_@wi@|B}c@cOe_@{]\"],[\"xzsAg|_|AvcBvb@~z@yVxq@}FbbAvNdyAbz@v\\\\bd@bItm@ePbfBtFlJxeB_Adq@om@pEoQnaAeh@|My@vt@d_@bAnRzm@~z@xK~s@~V|_@aDb]_b@|g@Lx]l[l_@{W`WS|gAr_@fGvQ_KhAiSxZwQvYf~@r{@`[lVvZzGna@pbA``AyF~EfT|s@fZdOkDlv@tSlx@_Xxc@naAzNzYxiAcIbRhnA|oBjMlgCkW`j@|Zpm@c[z]vQfPnKuElGrNwQRwI|LjTzg@eI|LsAhkBxKfV?na@e]fa@mvAxx@ug@tgBfWts@eFzl@ve@bL|Irv@}ICaBePg]pC_M`XsZ_PcJpCfK|C\\\\tSgYvhApCdOeG|GoPmPwD`CqNxVs@lSbIvN{i@hHuGnv@qX`a@hTx^sXtg@eOhiAax@xb@a}AgGog@{ZyM_Z`[kt@jT}G`IwOqBmQk`@gh@rFqx@~OoRzKil@yWyYmUsu@rw@cbCbZ{UcNi}ArFoHcO}WhK_JuFkIlKkTsXgRkTit@pG}GiCiT}XgC`NoLv]jDlIaUoNgd@gVkHfKiXcFwo@qTsYkd@qRwk@`j@_YwKsr@}v@y\\\\xKqg@yKyOfG}^qLuOqo@tMmh@hRuMfXvK|Klh@lUdXvLlGtKsIxg@u@xP|]zzAgq@pm@qItQ}RhH_zAyX_Oee@us@_Fyb@lW_iApj@}XcMct@aVwWmXiBgGq[b^eh@eC}Xkn@uz@y}AaLmz@}]yiBeiB_\\\\u`A{Y{ElWelAgO_Yr]ap@kI_Uo]qRgn@yJmc@jT{aBzBg\\\\`RmcAdJsa@na@c^bEeOdNgbAlEwUgRzLomBdKmDsFaVzC_a@lNu[nh@}JhOsr@wFcTkY{RiKsBw[zU_KuD~Jya@{Bgl@cb@{NwBkM|\\\\e]|ZwFhCbXmRnP~{@aD[y`@bNsMrEcn@yFcm@\"],[\"l`nAiqpwAdK_P`^vB`Cre@kg@~E\"],[\"jxbAy_o|AuOaYda@uO\"],[\"jx`Aodq|AhEwXnVI{IvFvApf@\"],[\"|u~@gjk}A|pAsm@f]iLzJxBkhC|hAuTtd@zKsm@\"],[\"ty{@smd|AxMeLxt@lPoy@f}@wa@zF_U}Y~Bmn@\"],[\"pwx@cad{A`GgTpGfToJfIsB~vA|IlGf@h_@{n@ieA\"],[\"|}w@ucy{A`XsC~VfPPdOa_@rSa]r@mLpRcPkGaB{Er`@qX\"],[\"fyu@g_x{AfNhOdGpk@yuAv@tHwg@|MwRz\\\\HrCjIzGgC\"],[\"f_s@_lw{AaFzLoNAcSoWz\\\\uVxIxE\"],[\"lkrAw}m|Alq@nE`OoEvn@~r@|SbG`a@kTle@?jTfXhT?zv@ba@rXhTcCvL~`@nOed@|MyYtc@iN{@{k@lt@qk@|BaRxU_{@rJcdBmS{mA`Ie`EphBsGbXcYnTs`Avs@uXDiYr]uXvLeIn]mP~GcU|`@qRzsBc|@jlByxBzyBk]ruAeg@dZk_AhiBkH~]mWvQ]rPwe@te@wCh[gW|[kKnf@`Gt|@sEho@_N`LcTeLhJyTaSqd@xHgLoHcI}LQ{BxN}NeGyPvu@_d@_K|rAwbBtDg^n[sWdX}bAps@u[vBcgA`QgV~Awi@xg@yg@bAqVzv@qzApd@k[lDc]f_@{SfEadAlTk[jHwr@vSk@x}AqqAhaAqjB|YqEhn@qa@lFcX_OoP`HiGju@w^bZxAzSaMfFmj@zYu`@xKob@oNui@tiAw\\\\dD}d@qG}Gna@aGx`@sa@j_Aw^tOsY\"],[\"xmp@uwyzAnc@zGb_@{G`IrGzMtp@{KrIpLtX}GxKpEnaAwf@tq@y]dIm^lYyIbi@oU`LrCsOw`@gr@_YyvAvx@yeCxX_UfR|C\"],[\"nuj@uffyAh]aa@~VjMhMbY_@db@cKnQcGgCa@c^yKaG_OtC\"],[\"zve@op~xAbLkF~a@jGzBf|@gL{GErKpThL{HxGxb@pIjg@fj@wMzm@tNzd@l@pNqGgCrJt{@eSpqAhCtK{[x_@oDv\\\\hJvE~HkQrPeC|Fr_@oKdPnKf^zTbFhAlHkNzSu`@dJcUuNqC}k@ydAzUhI{[g]mR|G}KuCqVpGe_@_Vw~@dIe[?}xB`YoVyNkUxJkFn\\\\{hCmUkAg]dW\"],[\"~w[kokzAtaAgc@pGv`@_Yda@`QPkBfIqy@`jAmb@lCmp@qXrCwV|m@iaA\"],[\"jevAaqb}AlFuPf^aTtPFdOjLsTj\\\\iO?rXyKxBgJoWkJuZdW}AnN\"],[\"xtuAswa}Aae@pIaDkE\"],[\"dxoAcga|AhUgKhWlG_EdX}VdLoX_Y\"],[\"l|mAogx|A~]sB|RpGzC`PeYxWma@kUyE{T\"],[\"nbmAoiy|AxHmPbOzG{Gv`@\"],[\"fdjEary{A}E{AF{ExDn@\"],[\"n`iEoeu{AkCVgAuD\"],[\"dkgE}zt{AwD_EvAm@jE~C\"],[\"jjcAefgwAhIeKR|M\"],[\"px}@ou|vAwOkT~XeWbJ`KoDlT\"],[\"hz`@ildvAuUsH|B_S\"],[\"hg{Dmud{A`F}DtJdIme@bf@kHyAqFyU|EeO\"],[\"r|dD}{wvAdUn@rCzIsNzH\"],[\"b{bDmcuvAhR}AvAdRy`@nc@sLr]sGaEvAsu@\"],[\"hk}Ci|tvAbP_PdRrMu@pSu`@nT}HuG\"],[\"rh|CgduvA~[gHwRzbAcW~IeEg]\"],[\"dp~Ay{g{AxOwTdQhItBn`@iP`ToQeN\"],[\"t~`AwksvAaJxe@kG|H_PmDgAyO|R}^xJ_C\"],[\"jt_@swpzAh@_KlE`{@qRnH~AqRsP}K\"]]},\n" +
+	"{\"countryCode\":\"PH\",\"polygons\":[[\"_{kB}pwhAvUmZtWfCiCj\\\\sP|OgKM\"],[\"_ztB_|kiAyFwVxFw@pRlt@yT~_@cXtPtZ}y@\"],[\"sufCqzwkAz`@~G{YtR_gAjPgJdZsmAaY~d@ih@\"],[\"weoCix`jA{B~OhT{BpXxz@xK`GKzNiObIif@|B_T}DiGmN|Sca@gXwL\"],[\"ob{Dye_iApa@~Xaj@y\\\\\"],[\"qd|D{v{kArDaFnu@zIpMdV{HuBuCjP}GoGoHnMs`@oMmu@lAgD_JdLqCsCeGnd@s@\"],[\"ermCw`glAdNi]xJjBxvB|sAlf@jEfKdHdAfTqKni@~^|Wva@zCpZ}Xdf@hAeFfRyjAhh@eHrQpDbL`YMyKxKhLpA|n@}WnWe\\\\tLs@joAvP`{Ad@`sBtOiA`Hin@jEwMjT}ZbA_s@vYomAvFebAiGqz@pIeNrb@y`@dWkt@}@gz@ls@o~An_@ir@s@{GzCQ`Wxy@ruAtaB|MxZpXjWny@|U~L`h@hCj~@bo@~tAfOht@mPLeQgPp@j_A__AoFuJ~N}Upk@eFjs@cZxZSt|A_e@fcArCnw@|R~jCdhBvbAbf@~UzS~CxReChf@_nApKwLp^_WnI}RzWcp@kP}~@cj@cXw@am@xQyH~x@pTBnuBbj@jMfKmExh@jGbWqrAr~E_RlKkeBpsE_UpZab@tNiu@dp@sl@zYm`AtLmEgMkyC~JkDdKzLjK{[`ScrAaEs|Aca@qdAm_Bo`@cUsh@a@}b@eOgFfk@{sBfdAc{@l_BgF|gAy|@lw@uQbhAvCfr@~Qrd@xTgA~b@}]f[pF|SrXrEzZlZzCmGxQrIlD~p@u]vWu]lZyB|WvOk_@pv@ye@xa@oVxBmAxUvTnRfDbQcSzYmVpJwLmBiIa^eV}D_U~[_q@`M|t@fi@jScAjIxKdNgCt`@jPpa@nBoG|a@me@|SxQdUzXfBjI`a@{BbHyKy@qBjNkZcF|CqRqxAtTgl@cLoHlExAzg@kTtTzGnt@~o@~aAlu@fi@vQlE@o_@va@hJnM|MsXsCWzEzv@nx@`e@mRxJ`CpXfPvE`[pOhK~xB`Vlb@tXpfA|\\\\xRjUcLnq@me@`~@}o@xV}r@Too@gUew@ohAgc@dIm{AaU}m@on@aHhSu{@wDuZjOiOiDyaCuzAau@msBzAeXa\\\\acBya@oXhS}FbIaZ_\\\\ibCak@wi@gYx]iTzBkT{Vaa@rAay@c^iN{NgPynAlMi\\\\_Hsn@m}@k`@v@mWyJaOmd@xZsGgBkAiPxTyf@gCiNvn@oDvFs[`PqG}u@cUl@gIxInAjMky@zNgI_BmK``But@xrD}V|`A~fAzL|r@zl@dDw~ByuDwLci@eCkdBgIcTsi@oYcZbGyuBqd@iy@os@qIcg@fYkEhFq[n^kb@xB{m@rr@bLkZsOv@u]_PsXa_@t@a[mMsm@`Pcf@_YmsCzB_WiPrHeaAlr@miAfr@wk@y[yq@ct@_Da\\\\~Imp@mRhk@yd@hLqYtG{oAeUcn@si@aNskAZa}Brl@miDfi@{_@gDwv@w_@zYw\\\\xNir@taAgLzUwStCy]|GvCnGwi@`PaPbFoi@`Xq_@~AsSpWmJaPwKtShBtKn\\\\vLxDlM_[_IyVtl@u@dp@ke@dBmRyK}`@k\\\\kb@v@mKzcCpXxKyVnZmMdl@kl@lz@gKzCeGbt@j[tTfh@tTcPpGhC}@nb@lTbd@h\\\\lPtp@_]xB}j@yBcFmPhKpGo|Av]_UjGpCdGoGpE|XtM~@@qIjImAjKvCh]v\\\\z`@}a@|o@b^bPkMc`@a|@hdAjBbTuFxGpMjUbF|g@oLf\\\\~b@v\\\\vFx_@kMlUo]r^kS`Psz@by@pGtcBuWpWlYxHbAxKuO\"],[\"svaEmdnkArVcEpGjR}gA~m@yT``@}c@nKiUiFtZkx@jt@aN\"],[\"ayoEih|jAf^mEbGfFzVro@qGbIs}@_ZcCmP\"],[\"_gpEc~}jAz\\\\ia@dNlFpE~[yIf`@eULsQ}O\"],[\"etpEwmxkAbOaBhD|b@w_@ht@w_@_AyFuL~D}L|h@cQ\"],[\"uewEaq_hAba@}HhCxToVxSk_@?\"],[\"ewyEee_hA`PcHnRzVs@bKuTw@\"],[\"cz`Fwq`kA|DsLfLgAx[|H_@vkA|GnGkl@bd@orAbg@kHcr@nDux@hOoS\"],[\"eebFoxdkAbPsXzi@jNsXfMlC`Lgd@jF_LdUuYjJuPmE|Umb@\"],[\"mmfJwrsiA`i@kPht@fJvg@tZnt@`y@pgAoGlyCv\\\\~QyK~eAcCt|@kTji@qYrh@gFGmUxKsOoGi_@x\\\\gVmM~AfEoIl`@cQ_EjTba@qGvm@|BvRkT_Og^x]qP~}@bb@bz@bD~}@fY~mCrkAje@he@nVhCxRfP~WiGyK}Gd|@~W`OgBaPwTpr@nPzBrO~{@t}@ha@`Jd\\\\je@wt@gUqRw\\\\cd@yKaJkR_Uu@jB|\\\\vg@xKvDfIxd@|pBvQ|VlGno@vcBprBfu@xF`a@iKzIwb@pKqExe@z\\\\pGuTbTta@lUrFxc@dh@fz@dM|f@b\\\\qAvUpj@tEdYmFtTcMt]ki@xiAsOtuByaApGxKfoAopArEW`E`o@zK`Uhd@pB~cC{`@lv@el@d]|@bTcPnc@bPve@g]zpAu`Bzb@w}ArR_yAq[dEaVbWxJucAmS}@mGyIqk@r`Acq@r[dLyKeVsFsFo]}`@qCx@oF~c@vAxa@uGbC_FaHqD|EgBhV_@qWo[cj@~GfBcNaPtCyB_QsSrEqf@i^dCiH{Po_@tLqUeLzGw@eM|M{l@tMeAeE_JpOwL_GkB|BsXqNfIoToBzAgNzQ{IiIkRb[e^vLek@v`@sQzDiP`{@{q@xCwQxm@rDhTg]vu@gC`XhSlt@xBp`@oc@sD}gAmJaYaX_UkRgGut@tT_Tg[kYgEzXjMzB`MgQra@aQhIcLqU{DrHaDsKaVdCrIwe@wKlG?uToPhA|a@_RpAnInMScLiZ~O|G?yKx_AcLqa@cP~EuSrQeHuA}[dXcxAa^}Qn{@ux@n`@srAlZkRzN`AmPnPpG`GqGvg@ba@ve@_M`a@}Crn@dHtJyNld@tB~IbhAlZbBuFpVr@}CiN`a@}ZlRqe@hn@kc@nj@gJ|R__@|g@yOyImApAwEbPcKvA~u@nj@hJlZ}KtJfNlP?rDm_@yw@it@`a@kcAhr@wv@qg@sTtIwi@xUWdKnS`t@~Uv~@sOvtAjGf]rX|TeCfElGrOoGjKdX}Dxk@cn@b^|@|JiOhUkk@pUct@F{Qh]}Ce[uSeNfRiZeCaTyRoJDiZaSwOeZpARph@yKxg@bu@v`@pMta@qG|K|SrVaPpG}IcLVrYzM}CaK|Ke\\\\cLfCrQrVfKcChZc[x[yO~a@al@re@oCdb@xRxm@kbAlR_p@kOaVvAiZl`@{p@fb@ePyBef@rP{_@v_AaOfy@cp@peAy\\\\vZsX}\\\\am@xl@mHzUsCwJeKnJmL~e@tBlV}FnEwMkEoZxi@ea@v\\\\tObLeAfb@{M~YnG~@`oAi{@rs@y@_BtLzJdBnj@qFnb@w_@hc@wt@fIhA_Zvg@dlAou@de@oJb}AgJda@b_Awg@zr@sk@{G}b@hJqpBhqAoYp^Bvd@wGMoIxg@yoAfn@y_@pc@dKzBqNdg@cYv_@}\\\\rcAs]pXwKje@|YxTjm@j_Cra@~^rz@y@zBkPtVhTsEna@hl@~z@yZ`jAvK|o@qG|Xyv@cH{UpG{LnUFfMvu@to@KvHsMfC_[i[uL{@cLjKgo@_AaRhUmHljBpRlAlXwLmAzd@rG`Erc@uIdF|BoDpGuOnLmjA`EmVtIaM}SmOzI}t@i@_QnW}MuI_J|MiCkPyJxGij@eXaQot@gcB_fBsBc]gVuZaSwGuiAxX{g@lXoNna@}IbCcO`{But@~KrX?nGbPqh@kFgJnK~f@rF}E~KbGtC`fAlDxm@q[tOnCngAiMbW|DbOzMQtl@dLfNcJ~EsIr`@iNpKyvAbHsSfr@kn@zY}I{QmK|Puc@e]kAfNwL~CyPp^rkAbT_Ex\\\\aV|\\\\sLyKrCxK{m@nMuhBhAyxAxToGvHa^s@wbB`hAqMyKeRfCnCkWcWkMur@fd@gy@sH_`@x_@_LyOycAQ_n@rXaC`OlX|b@oQhHofAe@gp@yK_h@bMq_B{[ePwi@zAy[hU{D|y@|MdNui@zZa[R{TxMoVvu@eJtMwSjGqj@iMis@xEaH`PvC_YuTdAmQaJl@rCrTqG{@wt@ml@uf@zAef@hZvK?aL|I_}BfV__@wCyh@bRg@cRuXrA_c@sMuaA|BcxAibAy[sDe{BjTogAyBch@eUopA~XmKhe@_JjCakAcKuT_d@_SoA{UtNmb@{Ucp@?av@y]{t@lCiqCccAgq@uByq@bNkH{D_VqXrAwp@}Oaq@ud@cDeMgTnBsVzc@uXpG{Ty^cx@}Aea@aLcTvI}f@bk@wz@jiBsjEdk@_c@yKlH{ToE|q@{`CzDig@kFmj@sQ_`@ad@kWuMmXkgA}SbAsr@dl@eI\"],[\"qzeBotkhAt}AbUmL~Rmi@j@dLpG?nMuTjNje@xKmAzm@|RxKh@fNz^nOsFxRlPfRzEre@{EpEgk@q@ed@qjAiS{LwUgkAkRqEma@ow@`BsObk@sB\"],[\"_s{BqfrhA|^cIzo@zObGxRwEz^iJr@cEyQ_t@kX\"],[\"wp`CmrsiAtQaW`Sj}@`jAf\\\\fVzpArDrz@wWrFuc@tj@yk@dBgJxd@oOxCaYoHeI{hAad@yr@qAgZpUyt@jM}HpEgb@\"],[\"}ygC_ejgAmCtIpTmEtChPyEdc@mUdJgMcQ{Bw`@pF{L\"],[\"ck|CcymfA|[cClJpKtjAqHl^lf@vIqEtAdNojAx`@awAuBtTyK?cHuTgIwCef@\"],[\"uq|CawlfAuTrToS}]~]eM\"],[\"iv~CylofAZwLfL_IWlZyMjC\"],[\"upaDkorfAnKuQfs@sAtLrI`G~_@_s@hKgZ_EgF_B\"],[\"alaDw{pfAsBgJpK}@jWbm@gOlDoa@ke@\"],[\"{trDcedkAzYcNxd@lCxJlR_]h|@sb@fUyUkEqRse@tEsV\"],[\"c_rDg~njA|HgN|K|JjBl^lN|K}Hlk@qk@fw@wT_QnE_JcIs\\\\iQoVeTkFuAiPtd@se@~SpK\"],[\"g~~Dud_lAzXuF`Qhc@cI~o@xKiCme@da@yK?oGlP}Gg]uMrTsGgAq{@ie@wUK_NkOl_Aoa@x`@aF~ZbP\"],[\"gx_Ei|_kAvDtHjd@km@hg@jP}@jn@sOvBYbHh`@|j@jb@zJf_@tgAbFbnCyLxnAeP`h@wv@nDsBla@cJhCct@cPal@ac@CcR}MeHeXsiAcj@gNsImXiNEw\\\\{^aHua@_E{bApEmJlX_C_YoXd[}LrZek@_@{Q|Q_c@b`@cCcCtHre@xF~\\\\wP\"],[\"yq`EwmvkAhRdB`CjHgHlUe^lBcIlV_WbAJbNmIbEu[mGnFiFuHoDeRxK~Ohn@y[`OkU^qN}MqVbArCkPihAlKsGgXy\\\\?tC}\\\\{v@aPnAiKhb@cPxoArM`MxMzS_R~VfFnA_QzZhDnc@sQx\\\\jNn\\\\yEdIbF\"],[\"ibnE{wehA~Vce@xDzKlN`CsAdTxKtCsXfT|m@{BdShy@gDrSap@lEuA`O_TwYag@qYsBqL\"],[\"etpEmu{iAhXyOz`@PvrAj^re@v_@|EtWcPkENtEdXdc@ea@xIiQ`VqBqT_X{UcLhOkVwDmc@wVuJk]yVsEsWwU\"],[\"kprEaqljAj_@hAj|@t`@lqAnR|dA~z@vv@b[pu@vAjr@fU`t@|GflCprAfjBqGj]fEr@bNnONxPgYzOjCaLxDlR~NpHk[l^mI|Yu`@p[{Hla@s`@|t@dAh|Aru@dYfj@~QznAmGtYgSnSkvAtg@at@pFwXfU_N`jA}Qfi@eNfLkCxUmc@x\\\\i\\\\vL{MvSoy@~T}IbJ~GxKqa@qC|GjPecAoCmTbHgqAsp@eGqt@zKqr@eKsg@sh@om@eIwN~EeKwDgJuSmPwnA|IqkAyGyeBda@sr@yz@elAie@ct@?eLbLyK]{c@_Y_Kuy@ak@umA^{[f]gr@gCse@zSc@aMcX~Lsn@zf@oN\"],[\"irrEsxujA~t@rOnaBiTnGbLpx@yBfq@zQtReBlHxLkKdGr^fCvZ~r@fTbLpL|m@pj@hm@hf@rM|QdZ`mBtMlK`PjfElkAxkAtcA~IfYe}@hGqlDy^geAoZqN]qKbPmRkA{EaFnEuFmf@kF}s@mt@si@}N}f@{\\\\ip@cNscBqhAia@pA}eAuc@gw@uk@cgCit@sc@pI_j@cPxKkPs|@xGi~@iu@`DcQjUbCn_AvO~_@bYdL_JuCsVdrAZx_@kH\"],[\"}iyEs~pjAlRwGvDfj@uHpFiu@`Qa_@sX\"],[\"kbmE}i`hAfTm`@`NrHnG|Xv}@zx@f@neAnT~s@tr@tx@hr@n]lz@hIlPfNd]tlApUfzBpSta@`Bd[b\\\\xM`}@kTv`@hCyDjYaVtB`B~Pxr@sHsAsOdQmGn@nOnu@tT`DhOd_D`jBhX|h@`q@bh@zTvfAh@de@vNp_@br@~Mz@|Ity@dVhg@|Zdc@p_AdvAz_B|N`v@fAp~@lg@tC~v@f[lCbKyGhLpYnnAbrAj_B}WFod@}Rwe@?ov@y\\\\eLxBdBaIkUeFeZs^af@eTmOgd@cnAss@qx@i_Aih@oSyMmb@zIyIqGgSqb@kQkIpCs_@qr@_c@wV{Bct@hTqGic@__@k_@op@}X?eVad@cb@sMaYae@uh@wa@_l@aP}Lsd@wIoAun@kaAc|B}tAsLdFwWgh@kz@w]x\\\\aBtGnG`GiIpCoLsTqRy`AjIgNan@fAqY_OmNi\\\\jDcP}m@{WuDhB|]iG~CaX_OaE}NxHyZqRuFw@_MsXbLeLuTf}@iHy{@ez@gMa]{\\\\bPa@aXgSuPmrAeOu]pPRzYia@zCcNtYeXkTs^|TaDmN|MeAqGqGhCaPlKnGjPoG?vKhEgC_AuTbLjC~SePuCoGzi@ic@uCqGnYoOgB_Mqf@jP{TuIuQbWqIjf@_LdDiTqGOlUci@|AuLuBrLuTsf@vSaWwCd\\\\mY?wVgh@oIgg@pZiEyNgRyHwt@dAwZe]{_@sE_G_Jbs@cLbI{HqGoGnUeHtq@xBf_AzZpc@k[hZsE|GdGyE`YhnAbGvg@{OyIse@|FyD~}AlFu@gf@hMiH?fT\"],[\"g|_F_ddhAzm@s@ze@`VgLtFsOuFgClVtOjNy`@ba@_JyFnViYy`@_HhTaPsF}ErFyD}QgFgJpJ\"],[\"_shEewmkAxe@tGfCdRiNfv@hy@kEeZ`f@g}@l\\\\gUEuIv^tI`FxeByKd}AgY|PpEufA`|AqI~~@gTbHkvAoOcz@jb@g`D{g@mxAzL{hAlu@_f@jj@tJll@j_@gGbc@d]{Rh[n@zLaShETpXyh@wLqg@nQef@oQykBpGgO~]uJnBePgLuIfUmCsGii@dVqn@vEaDuW``Ak_Ape@eVme@{Dx@qGvTgY|v@q]lHiU`@a]eOoh@g`Ai~@bGyx@nPyVxfAl@hNk[lNoAqKoJ~k@zGz`C}NdlAbXhw@Qd_@qaAhVkEff@wf@bk@pD`}@mGz[}ScF_HlHyF\"],[\"of_FotdjAv}@fElP|FvCvLzo@rFns@eJhRnMgFxh@n`@bG|Wjb@`LMfGpLmPxFtTnGgCtTxK`PqG?``@xl@~JxD|q@gJzp@f`@sCt^zPtf@zSjQlY`Fhb@`bFpl@~lAjlAvt@tFhh@{SnKiYw@{l@yWwg@}Fif@hC}g@rXcpAsX}dA}h@gfC_Dsh@`Fkd@eNayDuXwh@dM}ZboCe^}Tqm@{CaXyh@nVsh@ne@ag@xT}rA|b@ky@tDma@lsAqsAtTgn@|[aIfDiJ{IcSuYuBdOmZoJlDiR_UgKvB`C}`AvGmNbZkK|Nqj@nb@|i@lJGzDiNkx@auBwi@gm@pd@OhQlYhHmCM}KnH|D\"],[\"chgFofghAjSqc@xBvSpVzE`PoXbP`PpKaHpSpKxZ~p@}j@rExKoP_PpCuXkPoGjPzQdJaEvQg]`PqGyKqa@|\\\\ke@xK|GoVaMWyJyOd_@wWlBal@~GtP\"],[\"m}iFepmhA`M_@hFdMaDd_@tHhV_Ytz@da@tCrCxKiTzRzB~Y}HtVmn@vc@kjAr\\\\h]rTuC|FaPeKac@eBoNi^tC`Yq`@uAzBeY~WsDnGcj@xe@{m@fCkT`PlKDgM~`@mp@gIeDt@gKcy@qBhu@{PgAqPba@ya@dPw@xQpQ\"],[\"wfqFuf{iAht@xIrXnc@sXlc@qd@|XaS`q@g`@pDaRic@pDk}A\"],[\"}vxEmivkAd{@yh@nQ|JuOpXai@lGkSba@lRb_@aWtU|E|Npa@pE{Vhc@zVb[kObDlFzJa[fq@vGzXbZ`QeOlPkcArKqVlOqIvWyCjqAsPxDsbAqH{Ubj@mWpOvU~MuCrRiUjPaX{k@kLbE?oc@cMeQ_S_Msc@bAyZoVpGcLgZhFcJ`XjTbn@qKjUms@xc@}FrWiCsG{BrGmPsGpGwKwKtAsEdQlHbTiPSp@bMe_@jEi_@z]eLbdAk\\\\pn@qlA~~@keBl]g{Adh@iVqCwFmLrWgc@mGyZrTyoAbC_xA}IwzBaLmJcVvJjTe_@_Kg[h\\\\sn@eJkY_SyIVwb@lp@caAf}@mo@lS~UnN`BjJgL`P|GeLaYnPhC_HsXxJxBl@w\\\\hTwi@tg@sV|ELpBlc@xKoPxKz\\\\rJh@p@}YnGfCfTuRpcArp@bhAqG`XkTvGfE}DpO`UjCiCsRbb@yJxN\\\\lMlSfB}FhU|FtIcN~[sClb@sSnRdE?kPfUcEdb@mp@fYt@nc@~i@bb@~EfMqZ_F{RsJfH{I_GhZmg@\"],[\"{meFi}ujAxa@_JpJ~Imk@h\\\\im@phAsYtTmGleAePnRkLuEiBsMaPdGdGnGy\\\\hd@mO~Ba@aJeD|a@sWxEkj@|b@wQbe@xIfe@fz@bd@rtBd_Cib@t@kVuSckAeOzC}HaNwFaHqSqQzIoMwTmJJrCln@iVDaYkR{gAkNqXjRcoAxCdZckAn@hJ`_@_JhPhQrQDyS_\\\\uXqGyBw\\\\f^me@nFob@hk@ob@tTdLaKq]vLuEpEiXb}A{`Aid@Yx[u`@iCqXzrCkcBlI{Aw`@ra@frAwc@\"],[\"meuFwgsiA~i@yRfU\\\\rOzQ}UrNed@rDg]gT\"],[\"mkvF_apiAnDaRzNsEdCbPjg@iC~kB`VtjB~e@xXsM_HbGfN`Njc@rE}MdLeRKk@tOgIvBm^}AnPuMuZiHqc@zq@wUcAqd@aMwFyRwQiHi}Au@u]ecA\"],[\"wupFcvpjAnV}@uqDp`CaMd@dB_Iy[mF`{@elAzpBmb@\"],[\"qgzFeyfjAd^}B`p@km@xYkH}uAjlAcOth@gpA|lA{Qdu@sNnMgi@zBaJwZkNaA|Nw\\\\tbAmr@jr@yVnRwXhD{d@\"],[\"icwFypdiAhy@gKbl@bg@zIhZvWsBhCxIpd@tGhb@oRiE`d@lp@eFsTtXla@`r@ba@nPcP`_@}D`q@w_@tKwO{CxNiH_BsO?bLcU~OpLnCoD`Ikw@fw@aw@lg@wg@{Fub@jIkq@dc@yGjb@w~@jSyU}GasAhL}~Ajy@}g@jv@uDle@uJcFu_@z[eiAdPEtZ~Wr_@gIvPs\\\\`LeZgHcR}TqDc\\\\zGkdBnR_mAiDcIxMc]g^ocBhFcGyNsL?_PbIm@|EdMzq@uj@nBcr@~EkInShAcUeK}S_b@~U}Kly@ogA|g@oY~K}`@zUeG~XpChBuw@lQgOj{@rj@zxCo@\"],[\"m`eGoxoiA~QaPpQjDju@lq@fL}DfZdX~@vHoi@ns@gCjTkn@he@keAeA}}@}X{@_Hn[qUqLyK`L}V}OuQvI}LfNT?iO|MZtKsk@xIiCfCxK\"],[\"wxjGazkhArKiN`JdLye@~d@sXpz@uXxNyYw@mBgJbYsg@lKaj@p_@iTpPzJ\"],[\"s}mGg`}jAbPnPz\\\\oPrCnPfp@?tZuJxCj`@v~@zKa\\\\vX`BdYxI`Qzc@xTeo@t~@wB`Wwf@hLen@yq@wl@wL_c@jDsRmHar@hKoLiKgWbFcQwFbI_GkPmZdAyLzf@e^~h@kF`PeRrL?uOqL\"],[\"cmrGmzoiAt]oi@~[uAqFpXklAxiBa]`U{Q`A_BkV\"],[\"s_`HclriAfRoLdRfHme@pp@uD~XcVvHdBk|@xTfC\"],[\"uddHk|miAbPy_@dL~A}NnLq@hObL|F@}FrUuC|Q~Zzf@eEs@sJnIsFv~@iHp]dK~S~f@sDtMuL~C}^qCiAyJoWTg[~GkOjUgc@xBuXrQqEhQkh@_DwScJ~K}]gPmTnGeo@\"],[\"kepJwzaiAs@}XxM}\\\\lRlk@vC`v@wJtWsJQ\"],[\"qxqJ_gmiAjUeJnEpO~gAp{@wFtReTiCkSaTeM`Lsl@eWyHw`@\"],[\"mpuJyk~hA|IyJto@_MfUgC~OvG_j@da@\"],[\"mjxJypdiArV{KvMf]qZb}@il@`]cPc[fL_n@cFo[\"],[\"{j}JyjmiAtI{Kle@lKf@hR_Xp]_Mg@Uc^\"],[\"gklK_vjiA[cJ`TcTlV|Mv@pJ\"],[\"idoKc_niAfNcM|b@|i@xVuC|Gt]mWEai@gZ\"],[\"mttKglkiApk@r\\\\|CnOoFrIosAkj@dNsM\"],[\"yv}A_t|gAdu@wKxbAj\\\\ajAyBknA`PtD{S\"],[\"}tpB}o~hAjKoGhGbj@eK|OqXmP\"],[\"qhtBovaiArJwWlWb@`DfPvQl@bCp]xZ`]gGfRuZvDcOlNb@|Vp_@vbAqPpRuC|XxVjm@oTjYqXpKw`@aTsYc{@uWiXf@kl@tRcg@lg@gWaPm`@pKi[\"],[\"{_aCaqfiA~z@xF}j@dTkXkT\"],[\"{xsEac|hAdl@pChAx_@}GtIwUaH_Wq`@\"],[\"k_hFgilhAvGrLrx@iHzCnEcqAjg@yKkg@\"],[\"_rjF_fbiAz\\\\sCpGlQoOnKsu@nB\"],[\"kmlDy}ehAdAQAl@\"],[\"qmlDwcfhA_AP`@gA\\\\H\"],[\"oqlDiafhACG`@GLVKH?B[RQg@\"],[\"etlD{bfhAEIb@CJVKH@?]TQe@\"],[\"gf{FolhiA~GzBqBtJ}JdIgMsBAqQpJkG\"],[\"mggGk_|hAiVd[{@mY\"],[\"_mzKmqliATjCmDeB\"],[\"sl{KcxliA{BaAnAgAdFfB\"],[\"cd}AimchA~LwOiB~VaGjC\"],[\"i~}AaqchAqI|ViYlBrI}]vRqC\"],[\"_xaB{wbhAnGv^o`@uMsMeQ|^yG\"],[\"}}hBuqpkArb@?Xha@yKnQeZsY\"],[\"{knBqpwhApJcM`Jbs@qPaD\"],[\"mnoBelxhAdOuNvSdKcEzJ\"],[\"oi{DywqjAtL{Ord@zf@zBda@qStM\"],[\"u`eEiv`kAx[vDfD|UpNzKwCvEeZzC\"],[\"wwfFipciArKo_@vJdRyFvr@aLcG\"],[\"w}jF{{xjA~ImM~Mv^uAtPuRoH\"],[\"_fmFop|hAfSkH|BpFgf@`i@cRnFyOuK`FsP\"],[\"ycqFkdxjAuWlRwSlAxV{YbOkB\"],[\"cp`G{wwjAsNzr@sMwl@~Vgk@`M~F\"],[\"w~aG}pvjA~V`e@aMxt@qZxOdDi`AlGyHsFmH\"],[\"igbGmvrjAjUgFlDvPkXdPs\\\\eB}O|G}DqFbNwl@\"],[\"w`dG}~pjAmVrQhUem@zH`M\"],[\"sr~GkzuiA|K{C~JrLn@`p@sHx@qGwN\"],[\"{v|HezfhA|OmC`F`K_m@`k@a[qe@`VmL\"],[\"ushEwutjAxFcJyFoT`j@ji@TvYs_@cH\"]]},\n" +
+	"{\"countryCode\":\"PK\",\"polygons\":[[\"kyfUe_km@rxAtFvk@o[pPtZp\\\\[zXxj@h^ZzR}LpUwe@|IaaAtUmN|fAsMxKmPcAgzAkLaV?{`@mQcQkVclAfScE|q@zGlSiKlVeaAvc@}i@pD_SxmIwhD`NdOj_@qF`]xL`h@nx@cMxi@rPjz@zz@pGzo@d`AhHz_A}U~{@zo@bmBjMzaAqLl{@zBxTha@f^`iAtyBjBlfBwc@tcD{u@nsApH|xBsc@p{Esn@~|CwA|mAvo@`fBdMdbB`OxYlo@eC|\\\\hf@ll@dMd]zd@x]y@`KmLpKu]~@ws@pt@a`@hY_Bpe@x~@`RpDdb@{RpMyd@gHui@pI{l@oEwcApJqRdS{Fde@nSdZve@`QhxAxy@vi@jYjBlZ}Hz|@afBna@cIpfBre@ln@b`Axm@qEpl@c{@jg@sa@t[kaBrlA{Nrd@zQzYyW}Jyx@~W{e@cCql@cJeX{h@uWzByRlZz@bg@jSnr@}Bz_@nO`w@sSzOuOrEiUyGweApQ_j@eL}gAba@}~@tRc`Ahi@m{@v^aSz^nYre@tLtKx|@xJdMhMUeCjUrHtUmKhe@`AjJtPwC~@nZjR`R`Arh@vI|HqGzZpr@`[qCht@v[vPhy@voAzWpBld@p[z|@kh@x~Aoe@h`Bbt@znAbOrd@_RAcq@tRcYtXpp@|VrHdY~ZdIpo@d`@dXEhr@na@zJhMdZxOgErk@dn@p_@dP~`@d{@lp@dE`Md`@bw@|m@{@jQ`P{BxL|`@hK|DnMy]~i@{Yll@cEjvA|~BnUtpBft@dtBdsFztAppH`|DhnDzfJ~jAh|@l|Btc@|w@|\\\\pmA`dBxx@|p@|lBdT~w@`cE@xdBjMdjBvnAhbEdIjr@eDrj@sRb_@_fApj@_o@tCws@l|@{Mdp@tPlfAz{B|tCjuCxbAbm@jt@hu@pd@viCfiDff@vXdn@vOlvCpYvXyCrRsR|b@_`Brr@qeAfHaZoDiuCrO}l@fSyPlbE~ErhAzi@piApGr|@o^rz@{|@bp@_UjTidAw@ehAiS}fArC_XxOoLtlDK|d@_b@zi@gN~_AafAlfCsh@~cD}{AxYfu@na@|LxP{@hLmMrj@jAxPe|@lTd@zH~\\\\pQvPeBjp@|a@~n@pXsE|S~z@cEvrAmI~UkQhNym@bHsVoQaNtFOfYjMh`AfUnf@x@da@nS|a@`O`rAf_A~b@nPpUpDnZcAz_DsbAt}AiCloD`RhfA_Uz|@nRt`Asf@nc@jC|Jdo@|[sG|Jgp@xKi@l_@fYjLniEZe@`vDjPvo@yN|Ag@pJ~N{AtN`LjBpG{NxUfXdApJd`@xTdNkf@|Nvg@eGfp@`Prh@sBqWh\\\\hN`AsGpU{KpDeLyKoXqCiEyK{[vYie@uAaIj^tcAgX`e@fXuY`Kl@`ZaNjI_AfNsRfEtIjRke@pG~~@tMkTteAzKaMlPnDaCbz@cqA}Fvc@bGbNhMgItNkJR`GrGqOnPjChItKiIyKpi@gCgTsXoPpG~Xg]uCqGna@}Z{O}FaYwNgGjJpMwJj`BcgAp`@iz@fLsLqGsOfC}Gyn@wNvw@_k@iHvKjPq]nFgXlUmImK{LrU{YzE?cj@uMfZ^t]iMlEo`@eOad@_q@eMfMpEzg@}YfP?pc@dQYkj@jhA~S?sXzr@jFnmCeKs@ySy\\\\qJlM_W?gtAmJ{z@oVic@zO_nAldBkKsBvB_OsKmFsOpAiRzUce@dK?zVwp@za@hBx]eFlHsLy@~I`VmAhq@vJz`@~hAlcAkIeiA{Sq[mJLmE}o@fl@gu@kHsSjHoU~OtTvWy`@xHfGuh@xuAyChbAfOzx@jQ~zDrl@|_CqXdrBzGlt@eLeG`Pl_AsHhS`EdPhd@tZhHlSkRrqC|@dmBnOf]pYbPf]?`P_YpGna@uCba@qa@aPaY|e@kBj\\\\tQnO|E|_@g]bp@yHhz@eJfLaKx|A}]wFiJrMoMEk@ub@gJ_HcAtcAlV~i@gCyK|LcH_KeVd_@gRvKlK`EfgAo^|_CtKhfB~l@|n@h@lOvi@w]~OjJ_Qzv@h@j_AiXneBhXtgA}Xx_Dm@zvCdBl^fU~DxDsLxCfn@z^dk@fRnAlMa]vGxB{EbmAwR_d@ia@bJyO`Z_CbcAfLvg@fUbL`a@{VpA`fCtg@bTpKnb@oA`e@ie@TeSuRkl@mMfH~SaPpa@_@faAcs@{R{kEoMc[}QidAdGmOcL{Cec@mIoHcxEsa@gc@kM{v@ibD_d@oQrMwiAoEqLua@`I}b@q]_PtBcU}f@q@oXoMsLoJomBgNi[Tie@cXi_@rBgvEbGaZiKaM}WqEscBqHaO_l@kj@rO}lAfAc^mQa@uc@yt@d]ka@zy@`[|pDqKlf@fD|P_Yjc@wCaO{NuEe]fMyzAuc@epEnTifBzZc|CcOmFzLhQheByk@`s@u]nPej@l~@ci@`cEeb@t`BqdCztCufA`u@gtAva@mTxYkWkKgGxOq[nMeKzWu\\\\xEui@z`@km@sGyNvDcSjc@gyGvnGxmGi{\\\\tIk_Aaw@}hTli@c{FzYifAaEaP_v@_d@yt@u}Dp@cuEhPafCmwDosUe^wp@sk@yg@i~@vjAysBwt@kwBiIqX~Cup@p]gZ}@}gEm|@qLgOgSqaBiaAueAmeAeT{Jaf@{e@kp@qVo`AnB}l@pUwDtPtEnDoKgBm~@~Pco@xAwqA{b@msCod@ecAqD}k@{d@qBKpiAuHvWk~@ra@aJaGtDqnAcZat@ad@me@eHclAk^iq@etAox@nIsi@|Xc]lAe~@hVuwAuPk_@eGzcAgI`L_X_cAd]u{AlJ}Jla@qEt|@o|@uXwtAj@uUwRgf@mg@km@ayAmcA{a@{m@qwBz\\\\uiBuI_jA`X{XaByj@u]iUop@cu@og@ak@`FyJtRcPhCat@c~@eQeEwbAtJiT_Kc\\\\kd@g@ejAsV_`ApPyh@q@kYaWym@FiWwh@i`@aB_o@}iA_iBqSoCmR|HilAtpAmmA}JiQ~Xma@~GsUjcBotA`l@kVzViHxBqQiRq[KqLcZaBgu@`u@kjErJcxBiOssEkYyLxBsa@k`@ykA{^Cmd@i[y{@zEqj@iQkNvAsSlk@i`@bd@yZ|QwZrAcN_Qm@_q@ofAgHan@qkAgnA_u@um@qoB{c@aYqf@n@kh@oz@e_@cTodApbA{Oc@gi@up@iTaFyXrTiy@fAaSrk@}QlQwb@yUwb@rZq`@~Eag@x\\\\mOj[{e@pQeNrs@sa@`u@uJbByp@wb@uVkp@iVsIgJqf@er@m{@cPqp@iZ~E}t@of@dBiWpb@ku@K}[}EkFgl@fL}Jm~@}f@}~@}Ise@c\\\\uKqOieAiUvLyLqKc\\\\wpDcf@mdAaIupA|BkjBsL{o@rE{ZeMo_@}Fi|ApEin@wHeg@bA}mBeJwpApGgxBn_@oeApHgv@wH{c@_i@cUkAgaAkx@}aBwD_YlAsXnX_[iA_Ied@cBoSajAxVmb@V{l@dL}Rju@}\\\\{f@{_@oMmlBf[kz@tSs`Bd_@_ZbhA_IfNkLj@}d@yOsH}Jaf@dZ{aBx_AizA\"],[\"e~tMu|bh@jGiHkGgi@zGcP|TtRtBzO{K|S\"]]},\n" +
+	"{\"countryCode\":\"PL\",\"polygons\":[[\"}~e]wynJiVYsjAx[E|d@c[vcAyw@x^wOH{KePyDpOq[`DrEjo@cUreAbNtn@{VzU{YpaBcEdGoJaCoQvv@dMyAhC_Q~G`AhT|`BaBtUg_@pn@ya@jZwGyFcF~|@wWj]yIgBeFut@wYel@aUvP}UqHmLpO[tMl^ja@eA`cBrNf[_SrU`G|[i]bM_BoJsMbnAiYpM`@|a@ab@dtCpE~OvGAtv@{d@nTie@nh@{OmG`a@pJbBgAtU|NvV~Apf@lx@xgAd@jXw]ty@}g@xRmw@v{@wKvh@g_@`PnBvq@{V`RiAfSeJzD{H{Cx@oK{GfC}Xyn@?qg@cQcWmVaAeDsRwn@|y@qIf}@~Uhb@eMrv@zVve@bBpY_SkI{Y|OcEvQfGhy@ei@~a@`FlbAgNnYwZ`|BlNpt@cb@lHoMf`@c[zR_`@sGmNtYKpb@_TbObRxUyW|_AnPzY|SgUp}@`HLhjBuJAyWa^_{Amz@cmAqU{\\\\eRyTr@mb@xa@ojAtJsq@|qCgVu@oWoRi_@bFyc@ps@k_@zGyTf]aWiEmj@_x@kq@oFss@}e@uZzm@qc@{KqK~GuUkKqa@boAkJuBol@|ZyWnBmPk_@i\\\\mXeLnM}c@iUgcA`dCq_@`^ieAl`DuGsOmRaHcg@xKmv@g{B}r@{[kc@nDue@ae@{O|VifBbZgg@xa@yv@nCoc@nUkZy@{FsXsHdIgCcG}GrCth@gjAjNwgAbd@gd@_a@wSiC|\\\\uZjR}g@kR?}\\\\gr@gChWdZgFfJoGyKsIpGgHdsAkExHoO_CvO`j@|Gea@`LvFfO~t@{FrZin@b}@_SwTlIgc@?suAoeAoiF_UsmCej@{lDed@}jFg_@exB}S{hDxKze@?ucAoGfC?y\\\\kM`BxDlo@cOmn@qYse@nUfZaPqx@mDaGaHlF`H`ZapA{aBnJ}AsFaQ_JIbFjP}MyKc[k_Ao]ofFee@{z@er@o{D{XmkFaTaiB{G{eD~A}sBrxAs`Gh\\\\}e@`XiLbB`J{N~Sqo@rn@au@d|CvB|O~T`IbH?tZke@t\\\\[vBaRyFkIjItBvp@m`@`f@z@z`@yKfQkaA`MkMzRkhBgDyi@pGy\\\\mSmiFiVc_BcYap@nKgk@ry@i}y@kG_j@{Z_k@~JemAtP{OvFm_@rR|DfHgJmD{h@~h@i{Bzq@crA|cAo\\\\bMEfXtVjR{RjXdArmA{YbYyT|pAiOrxCa~AzkA_c@pw@ey@ns@nThf@o_@|`@eCdPpI|oBkLtYpGtQzWla@~qAbQngBtn@lmBrhCtlCdJaQjR~ApXy_CtIvAcA}c@|GwKgEoIjMz@jTkPx@yh@bVic@nw@iWz\\\\x\\\\`j@hChT~O|SiTpM~Ed^vg@zXiCnBdG~I}GbGnPlh@{Eb`@kQpDmQ~SyFrI_]jOxG~]q]lEtJzMcBjRd^rTWtFq_@pn@sa@ni@qjArF}EbHrKvc@a^|a@uCnMcZx[cNtq@meBxItFx@fuArP`UzRmIvDg_@xMdGpHyGjHy`@fMxBtd@}S|NlMvf@_M~\\\\r{@tZx@hZpOvGz_@ZfpBfLxg@ta@vVniAz`CvrChpEppA||AdUhJjL`[dl@ne@nMvd@vaAv}@bWpNtcAeWlUcXfbAgJjfA`b@wC_Nns@i{A`YjEtS{M~HhFu^n}@\\\\feAmYjr@hGdL_Ll|A}St^mGdlA_SdJ}NfaBoGvP}h@~J{_@ng@_\\\\~bAtPve@i`@dh@eNpw@lIhkBwWrgBn]nh@mMfj@xA`b@dKdUlJ_UbNoA~Apa@xe@j|@qX|vAij@nv@Hrs@fQhe@yRpaAl@vHvICkDf`Ab`@xSvAfo@zPf\\\\aAhNdh@d^ld@~HdEdKm_@jeAnWdaAeDpk@qNpDk_@ob@aM`Q{HcEms@rKoD|wAsW{C{Fvo@mcAf_@uT|\\\\g@hKpk@h|@nLz`Ad\\\\pQtH{Av@fK|WpI`DfRmFhm@pIx`Aw_AV\"]]},\n" +
+	"{\"countryCode\":\"PM\",\"polygons\":[[\"_}p[juga@dLzGfCwKfDpMgDbl@qXg]WwZ\"],[\"u|r[bbja@tM}VpQrGtc@r{@s@fNk^`OoWo`@qWaHqtAld@se@xCsOoQzAuMbK`QlLeHrXy_AhUt@tPdNaBtj@dHwAbDgVvk@~@\"]]},\n" +
+	"{\"countryCode\":\"PN\",\"polygons\":[[\"trhNfllnAmAeE?{DfAaDlCeCf@~DEzD{@fD\"],[\"jv`NjfdkAp@xEjB{J\"],[\"tazMjmimAlEqOlRaG~\\\\xDeYhYcR~C\"],[\"vgrMxrxnA?mAbALbB`Bd@jAeBl@o@m@@w@\"]]},\n" +
+	"{\"countryCode\":\"PR\",\"polygons\":[[\"ux`J|hlh@tIkFpZdQ?vJmXr[uKiJ\"],[\"ux`Jhi{f@bPhtAcCpq@sPpHo\\\\upBvHm}@hIiM\"],[\"o_dJf~yf@ke@pa@lIko@bNgB}GhT\"],[\"o_dJ~x`g@bK{@}@yL~RmFvHhFuGtQlRbAmE|P`O~k@feAlj@vG|SjQkEfb@~bAkCdh@nGlp@rX`jAqGzB{CkG}FjGjKjRgD~^sOdUdMfv@}Yz_@~Pz~AaFhCwDhtAcJ`DnEhOrCqGzNpRzDpaAxKzBwTpa@mDxq@jMb[iIxi@jRhAoRbRkPIwP}^gGxWuJO_IgIyObDip@sK_PsL}u@zZkDfXue@dT}c@qdAug@pEiMmJuGg^lVoxB}EoeDdLmkBaIct@bMap@}Fs[fEgo@jJaKsH}I`]gUcIe]}Uje@lQmcA}JoMvIyi@yCgP~Ycq@_GeNjJ~C_EeNvMsIE{QrKwO|AwTeMcY\"]]},\n" +
+	"{\"countryCode\":\"PS\",\"polygons\":[[\"mzgRwm`T`Zwm@zzA|tB|TlLvi@eBto@`_Ai_A`]alAq{A\"],[\"ojoRuijTo`@yKqfBr]ec@{]oMc@yEdKyZeCoLsNaiAwYup@cyA`Sse@F}x@vHsQxp@wGxQi_BnI{A?nGngAyK|GdG?eGpr@dGtZbN~MeEbQ|LveAmMzNfLd_BuTzQzRvy@t\\\\l|AjLxAzi@haAfgBtJnc@Q|lAlJneAeJj]_TfFg|@gd@wu@qEg]uUe_Aa~AzMeeAqWoNcLhEmb@sFeItR{UbFd@lIvb@qEcBbSfIs@qH`m@{QrUpNrf@qAdUiPqb@u\\\\}A\"]]},\n" +
+	"{\"countryCode\":\"PT\",\"polygons\":[[\"_juVpwiC|Cx[br@riAvq@bMbWcMje@p\\\\ze@lPlNoEjM~MrLiYh~Au|Avq@eXxIsMgOeo@z@ip@vkBxn@{I`}@~YvUjKreAjPqAn|@|VhWdP`r@ncAhy@fPdUtYjTrHfx@_e@daEie@eHxVzChh@dkBj_EsHfjB`P_Hu^pc@s^|xAnEffAuKbl@pHzl@yXhwA|Ble@tT|Z?je@jYtdA_ChKpW|h@oGl_@eYuFqaA_l@sQtDoSyXsZ}GwSxHo^qZ_c@eMmhAyKwg@jRs{@_PuDaYaNkB|Chb@{nBmA{N|HwNhm@ocAmp@_mAyXujB|Kgw@~r@cSdg@wFqHrCgMp\\\\e`AbG_@pKqwA}Fi^uSzoAsUdIoTqHyH~MwNgAg@xH|UvWnMkS~M`DkRjhApCt]n[bVvJlb@vLvpB{JHwTuXkp@{BgfA`j@wHibAzLiVyKzB}Gme@er@scAkd@hPqHoCXnM_g@vNs~@ui@eLwTcGer@g]ke@rXpk@~Hd}@pbCveBtx@i@xM|GtKhbAaBrg@xPzUcTzr@^xh@eBnK{~@rBmXgYqq@oSi|@tG{gBqt@mj@mE{]lG{c@na@pCgb@q^qx@rF_ZyDiFwN~Mm_@k\\\\wMo\\\\mf@i[e~@uJwtGe~B_HiH|JsPZaXmPnRiJdq@oUlFwm@{YicFgeAyBgDlP?qTmTbCuKwOzBjAiTeNyKuQvLuRyw@`Dxz@gUpHkXyVgL`EvQ|C}DxFtKxGjz@hN~a@xU}sEyz@{uAzEoKcJeClVkw@b_@_p@pCch@pWom@sDa~Bxa@o^}rAbPrrAcElRiS~JkkA_Gql@m`AcVoFgR_q@__@mZu^cnDm_@ulAnNiJ~c@EaAoh@~RqYvQ`Gbv@leA|UzEpUea@nWr@nAyt@aEa[q\\\\ka@aAo]wX{JqBiK~a@}D{XclBfN_e@`@s`@tRrHbImIaCcd@sSiRxF_\\\\`\\\\uOoUw^cAkj@_T}_AaM}K{e@f@wLcXhZwbAgNyc@jMq\\\\Hiw@}E{MsQ}BpAaRhYyUdCuQiEw`AiLiGhKsVhd@pAtHg_@xnAf`@nq@mUrBaWuMkYbGiz@bUqy@dc@mh@xl@~l@rRbAba@zVpGhc@jK??vKrr@d{@bNfi@|@|r@~bAzbAd^~DxZvWdD~~@bDdL|RbHnoAou@~QmWjIxKtr@n@|cAoNrg@rUfi@wVhl@zZjSsBd`@qb@`e@jn@|Q|CxW`uApZ`P|e@aPbIqd@py@on@`x@l[~a@p@bd@jl@tPqCbq@pPxJbdBcF``@dH~`A_D|eCvt@}Xnj@k|@`RkJtUut@ll@nJbZgBdk@mn@nWjHjUqLrNon@vg@yEiA_iAlYe`@~_@wFjcA|x@|]eE\"],[\"iryRj_aIov@pa@tUgU\"],[\"el}R|{fItQ`J~FzX_AroAgp@l~Be`@xd@cUxEid@_i@nd@ahA{Ou{Arh@kkA~H_mAnPho@\"],[\"wsdSnc~HfMdNkBfTih@sb@w@s_@h]qG\"],[\"ygqUhkzCpBi_@_OmZnGgC`Lr^\"],[\"marUvqhN|^e^fL}AhHhHmJlx@bCh^wZhSuNqR\"],[\"gpbVrckNvFcWpQ_NpUy@xRhNz\\\\`yEkTrcA}@nv@sk@njAaWnTq_@gJoBao@bQwd@|UsHbIksAeLia@dLgXaSeiA\"],[\"c|mVl_dPjAq[`ToMrEnGuCzpAvPlb@aTha@nBtdBep@zv@sZ?gQwf@pCgdAvp@chB\"],[\"kzpV`xmP|ZoGzI|\\\\`G{KtCv_Ays@bgAsCqn@_We\\\\\"],[\"s~pVzv_Pna@opAzB~m@m^dwAz@~b@eP|WePt_Akw@zrAkKbAnv@wnD\"],[\"qvrVpvnO|GlTjT?zGtTqLbgAfF|MaZtlAqm@jW{MiM_Lck@rCe_Bf_@q~@xQa@\"],[\"m_yVtpaPgLxo@qRdEyQmIiCy\\\\f_@qg@~PbB\"],[\"{sbW|o`RfCg]|JwJhd@uDbi@n[q@no@{WtQg[kAgg@uNeHqa@\"],[\"e}fWhw~QnBsTjQq@hQjKfBvRmYdL\"],[\"_riQn`xHXaB|@AGtA\"],[\"i|kQfztH~J{A{AhH\"],[\"gcvVtqoDnJDdQ|V\"]]},\n" +
+	"{\"countryCode\":\"PW\",\"polygons\":[[\"qdiCun}pAlFaGhYvArUn[\"],[\"}mmC}faqAtAdHxSuDbAdS~PtSz@nPeIzH{Sa@xS{RkD}JqXkYiQv@\"],[\"qxnCskaqAnHiXzHuHrKfBgUvZpJtP{OA\"],[\"swuCczdqAdoAgEzd@hS`PmEzd@vUzc@]lI|DyDdSrJpRkq@|PwCfIaN}AwM_NbGmAmBoJwLk@iKhN}SwT}WsEsPwo@w}@nG\"],[\"qoz@yj`oAcG}XdFiDtQp`@\"],[\"sjfBwluoAiH`C}@}BtBaC\"],[\"ymeCyh{pAc^nKzD}SnRC\"],[\"ady@u`moAxImLbFbJmHjK\"],[\"kc}Cq`fqApG_JlShOyNfD\"]]},\n" +
+	"{\"countryCode\":\"PY\",\"polygons\":[[\"pgqLnsfe@}xUeiF}_IiCswG{iEk}GkvBkxEwba@cCohQjqIwtP~gBcMroBhIxAiL~PqJ~PrZnRaOqG_PdFeH~_AqFpb@oy@zh@zKhjA{JbReJ|XtCvIcNwTwDiBeQxj@wd@p`@tr@tF}_@`OqPhJq@|Rf_@~MxBn^ki@}I{ElHoJrs@xTvv@cMf\\\\pAnYtGtc@fb@|XwL|GuO~mBly@|WyDzHiP|n@dMx\\\\oWxh@bZnb@aAjWrKt`@aRve@fVdbAvMlZ{[v@{i@`MePiKyi@eIa@mCwcAvn@_^bAy|ApSkp@hAe[cHaDbFqd@_Oec@lN}GaBi[xQej@_AijAlKqA`Dq[gO}Ed`@cN~@sMg[{\\\\n@og@{TqP`]sh@iOtBi\\\\qj@}|@am@wHy_Aj`Aud@jz@crAnFouBkEiO`XslAkPkG`_A_~@vyAyJfS{Idj@s}@ju@kBzx@lXpfAmNli@dAjZeVjl@Y~PaW~r@aOfp@zOtk@e]~t@zP`uAoFpj@uf@ze@qL|gCkN|Tm`@~Oc}BuPkd@aKgdBk]i_@cn@}xBoX_c@tz@oyB~s@gn@dh@mkApWxa@zc@~Sht@yKpz@e`@bQVjuAdl@hqAgGluAb^`a@rU~eCja@fUR|n@}Uxf@fa@|g@zL~q@`l@hl@hTlx@mJhi@tEv_@wJpCl^jUzDnt@eOhViQ`l@`Pxf@oDxS|a@xr@kK~t@hLz\\\\}NnWfObl@KziAfTt}@px@d\\\\}Drj@~F~KbNcFxe@hKhX`fAra@zInt@nc@pg@fb@iC`TdKb@ff@gLrp@n[jrAfU~Ppr@|JZ|k@~`@lLpGzY`p@oQnr@bMz^nw@dg@rl@qYd}@mh@bWs@nx@{SjvApf@nq@bPnm@`x@vIh}@`k@zBbSqSre@w|@`t@mDrh@td@zaB_v@frAjIpl@l]x|@`A|_Aso@|_BzAjnBus@nyBwj@jbEdEvaA}FjcAjLjiDtVjy@ck@s@y\\\\v^aYPiSgV{Cs^{m@qK~JeTus@a[qFjHcOyB}f@ybBgZzUuLwFx@c_@eGsGsQdGoE_XwbAwFPqb@uVpGyKcPmg@v_@mn@}RuShPgs@m[mg@n@gR_g@gc@h[vKaPum@mVeWm|@{o@egAkQeGuFz[sd@_a@eS~M}Z}l@e`@tJab@cq@yKjT{WySiH}e@uTyE|@c[mQeFmLuUqjAwIik@tr@qr@dRoZl^wg@dSwz@zgA}EteAsQlI?~XuRdr@yk@p}@v_@|u@a@~M{vAztAqm@zpE{qAxvB{`BpsEwrA`mA_[hm@_K`k@cg@lf@ogC``FjJlcAoDjxBmPrX_FpaAqQ|^cA|XoVtI}GnVX|b@mLvWEtbAgc@||@lExVsJtRgk@hZui@~i@cd@qDc^|j@?jT_Tjc@kYfN_m@p~BuSvNqQkEcC`Wu]r^iE|m@kU|_@so@tUwi@hn@gf@rdAua@|]iYBm_@pQkmBh~AsMwFaF|Jk[g@kNjd@q\\\\gKeYzQm@fJsPrBeKtq@sr@|~@qI~_@yYxXsIzd@g^qB\"]]},\n" +
+	"{\"countryCode\":\"QA\",\"polygons\":[[\"sibN}i_^sMa[_OgCoz@hDoeA`a@_h@oFeMpS}y@dJ{tA_D_{@fHgf@_GuF~F_Uy[hc@sMvAuOac@`Kk`@cBiO|\\\\{[cl@zJ{Jbl@tCbPwQ}g@qEsEcFrEcQg]oPvBnm@oXxHcg@qLwZtC|@sGcN{I`UmI{LsMsSl@uChLsxAwT~GyKwt@uYwTaz@k_@kTxBkJsOqb@na@yz@dh@E`IkQ|a@kF`GwH~CobAdSs\\\\rSiJrkAsIfIzEeLrK|NtJnIoE}G{JjMaI|QxSgOlAvKz\\\\~MgY~Ro@bGhc@`j@?lPdKvq@c[lxAe@hGok@|P_JlpCcAbj@`Nf|@bu@vlBlb@|Zxe@vg@pXiHhFm^gJeE`e@gJ|CvJtb@li@|l@`In_@uCzgAeKl^w]v\\\\\"]]},\n" +
+	"{\"countryCode\":\"RE\",\"polygons\":[[\"rd{Kmr`a@fMa^fRqI|rBpYtPpoAy@pbAeb@|vAgTxU{Bb]{k@v`@_b@nCmm@na@wWzCuUiI{Pw[e`@HeCi]cYal@fFoiBnMcl@fV}ZrnAe_@\"]]},\n" +
+	"{\"countryCode\":\"RO\",\"polygons\":[[\"umg\\\\oy}LyF{Vo[eDpLeq@gNuc@yf@mUyOaXnJux@bu@moArL}}@qT__AlJqfCh`@}m@{O__Bd\\\\okA|Ik}@}MsgAoRwe@dDarAdUgx@ri@k[vZuoAnt@gv@oSqqBwsA}uAqKuYoUwqHsR}{A}O}hDy}@_|@_iAoSgf@soF|LkrAdZkn@hNmEuKyQjQsNnDqTnMjRfL{q@vKpGhNsCjZgi@pGtKzGgCwCaOnO{LhAuNxNbJlKaPbI~IbSi_@~X?jKsX|GjTle@ui@hTwC~@lMvLuD|s@av@`P_d@vv@}ZrRg_AlZjGpEcJfR|C`HePv`@cVlRg{@pk@ka@bYcKdCbG`Poa@xGxBh`@cz@`HEnGyv@xZuXj`@wShSjBzCmIxd@}CljBkeAna@cGnVpLhImDz]lHrPwOv\\\\E~n@xa@|Wq@bNlH`JrYjQ|@rBlMx^iUrcA`a@tc@tCd{@mYnMvCzMqGtIzK`Qw@vf@aYxoAcG`Dtd@fRn[zSgp@|RiN`TIvZk\\\\e@mN~Qo]lKfBhp@s]vm@gyCdLerAwI{x@wQzYeJaRiQHQiY~Yi|@wBiMcWaLyg@k|BuTu`@Zo`@mLeXn]wyCp]kq@jToIxq@JdHtCnB|Tx[}Yn@kS`[~TvaCfThPvNpJ{FvX~a@lUp|Fv]t}@|[la@_b@bOaRalA{PiX_UAmUnYvTsC~CdZwSbImp@_D{Nqc@kRt_@aF|m@bj@bfA|d@x@~a@ut@lGbPhCqG`MfGpJ{KfFho@zS~j@xO`F}@iLlIjM`RZ{Fmt@sTqYwK|GzHsj@`OqKlU|NdBrMy_@}U_EjM~IkBmAvWf]~f@|S~p@}SyKtC`VhRcCzDuMrJna@vH?qG{GpGaPhh@zP`LaGiYkKeq@_oAqSuJhnArq@bOp\\\\hu@hw@xTlGm@|P~RhQn_@pAxz@mXaAdO|IhHdaBoXxkA|e@frAjRxBzxAwOhdCqu@duC_yA`j@zZ~uB_n@zg@uBhMlGxc@VdvBwVhYcKfb@e^jTyLh}AiSll@xKrxAf]npAta@~sGbXzbBbZrk@tTrN~f@djAluAp~AbHpcAj\\\\vfAvK|~AuAdZm^`bA}GtiBeRthArBh`Dsc@ptCnYpbAlCf{B_h@t_FcNrb@hBz|A_ZnbAwBtbBdWzwBfDzvC}HzN_Y`G}{@cUeVutAeRuIgRtDsQzlAgaAxeCwn@vDiZpqA{fAfl@kUmCmFeg@s`@aSiAmXbPip@iE_YiRuM{\\\\tg@wJjeAsm@z_AaE~b@vSj[lMjn@ldBziAvFpc@}JvWaQtEqJlR{v@x^}WxuAfLr|@oEx}@kOzU{f@`YyFxpBoOxIqHjUmN}FmS_pB_Kw@{NfMgNlbA}Wrb@mJoFiHqc@me@yKoOi_@cONca@zu@qQzfBmm@l}@vHz\\\\}ZxcAipAlfBgIp]wG~BgVuW}m@r[m_@iMmz@gD{GxKbR~ViCdWo[jY}VvD}d@jg@sNnw@_g@znAao@b]aa@hf@iUgX}@scB_PeNxZgqAcD}f@uJwTwReFsAoTg[jByQcd@~Hi|BtKmTe[ah@qCi[_~@mT}Ru~@yd@fUsj@{[wb@aEaMkz@q\\\\cXsQ_h@iKcAkKtT}e@gSqQaTaGg\\\\ce@aCiTsc@o^iMiQbWqr@{pAeUaI_S_XkYiAqr@cWu_@ob@gIaf@iPqK}|@|FiN{Jm`@ix@qHaf@uu@s[i^wg@mFwkAi\\\\kP_BeRzQ{bA_Hum@o[kh@qE}\\\\m\\\\kFiPil@\"]]},\n" +
+	"{\"countryCode\":\"RS\",\"polygons\":[[\"qpc[wjjK`a@if@`o@c]~f@{nArNow@|d@kg@|VwDn[kYhCeWcR_WzGyKlz@fDl_@hM|m@s[fVtWvG_CfIq]hpAmfB|ZycAwH{\\\\lm@m}@pQ{fBba@{u@bOOnOh_@le@xKhHpc@lJnF|Wsb@fNmbAzNgM~Jv@lS~oBlN|FpHkUnOyIxFypBzf@aYjO{UnEy}@gLs|@|WyuAzv@y^pJmR`QuE|JwWwFqc@mdB{iAmMkn@wSk[`E_c@rm@{_AvJkeAz\\\\ug@hRtMhE~XcPhp@hAlXr`@`SlFdg@jUlCbQwPld@yId\\\\qd@lMu|@`o@iE~GvYrWrYddAfGdCvc@hV`RtBjj@|KtTzrBh^~]kWlk@gIxVke@dw@sFbt@gXb[ko@v@}XpQyOnHui@x_@uk@v\\\\aMv^ob@vR_|@fN_Alh@bQbWt`@rS|I~Yna@rGz_@~x@z]jH~d@wFb_AzG~b@hb@bp@zp@ua@p_@nX~bAnIp`@gu@nXgN|l@hPfGjWbh@r[bCvgAuLdReQ~Chg@jkBb@li@mRr|@~NzZvA~h@zj@tvAmCt~@kn@pd@aNU}Zk~@cf@_@w~@u|@sl@sIqMyVyLfD_Jta@jHtp@_]bg@}MpsBi_AqQwWl{AeNpPct@bM`Ate@ah@|MgSrRgDru@sk@bgB_Y[gBmNaXh[cAd^|^`_AhFjf@bNlAh^gOtG}Y|b@zNf[r\\\\o@fTlT|RvNjk@nPhDpZ{\\\\~L`MpPxpAwf@cD_IhIkd@`xAeEpo@u~@faBaU`RtMrb@F|h@uJlZqb@v\\\\lCr^aJpg@gg@pt@wxApvAw`@d_BuYpY}PLoMqK?{L{a@_UDc]yKq[ni@ag@}QeLdCcc@{_@pF{QuQyfAp[aq@z~@}w@ps@gNx[uZ{G{EkZxWio@mAoeBwPog@yQ}NyOnK|EtJ}Sjc@ki@db@~DlM_]bq@ol@xVoQpgBi^dXkW|Da]kTuu@dFoKa^qVmDevAapAe{Asc@oUrMkGzYnAtbA}JjHh]|~@jG~i@y]fNwE{OnKe]u\\\\sg@_MdG]rRkZcD_g@dGu_@jVoD_y@c^zHiAwLtR{XxBkX_J_e@bG_Jw@_u@cTuIqOpQeCdv@}W`qAaM~`@iXdL_S|`AmKf@uN{a@qWjT_ZyFlCgi@oKeQ{a@bv@xPvn@kTxVy[oD}]ab@uV~^mc@tIw^na@aYmEsEi_@_PhTyKiTuHpBrC{t@{OpB{Fwh@k]oISo[`QcVt@egAg[eZmC}k@mZwm@kRo[_OjFwQ_f@qDsiAnZujAoTwuAxKcaAoFsf@pJwWmAq^\"],[\"w~}Xs{fKmaAiW}j@lp@oPeEwL_YhR}aAeg@uYm]k{C_MaMqZz\\\\oPiDwNkk@mT}Rn@gT}o@yi@gNuAuMj]mg@jIug@_vBr[}j@fBlN~XZrk@cgBfDsu@fSsR`h@}MaAue@bt@cMdNqPvWm{Ah_ApQ|MqsB~\\\\cg@gEicAtTiTpMxVrl@rIv~@t|@bf@^|Zj~@`NTtk@w]]rr@|FtGkFpRfCfIdNeEaDjf@xm@zk@~b@uAwBle@oj@zl@cQdh@v`@bz@nd@zgC`JdKfk@|Ldu@sCbX~ObMbYoQxVxMrXqM`UyOzAyd@_VweAl\\\\a^v@yu@j]uaArgBg@vz@e_@zJ}LfOoOmF_m@p_@\"]]},\n" +
+	"{\"countryCode\":\"RU\",\"polygons\":[[\"sg_]edrt@`[z`ExS`RjI~m@}Gzn@{iAn_@mS|q@}ErfB{RnFgc@d}@gG|C{VoPmd@liA_d@mE}Xhx@~@hw@yWt^qh@qa@yXooAkPbUa[}JqH~E~Atf@yItm@~_@d[nTvx@lVw@nu@b~BdjAfz@qb@bh@yBrXbZnw@\\\\bTcFhHwh@wDiAld@vOtk@yUre@zFhm@mb@nc@fT~uAkShx@jTpjA{GtWwQzPqp@dEkz@r_Ayl@zN_Tp~@gs@CoGck@gIy@a\\\\~XqPxn@|Gtk@o_@~\\\\kA~j@e]|o@wBlaAq`@hz@nS~k@a@jVqQzOmI~`@}s@rEaz@bf@el@wJghAty@cc@tmAuRhOaf@QwRji@_b@|e@{GhbAc^j_AkGtdAq\\\\dg@?~jEdn@`^`Ufr@wJzzChKpOvj@f@fLvNjn@bzBoTdi@iA|p@dH~W{ClSvUpb@nCd_@aCj_@ee@x|AdAzkA_K|o@p]vj@pBtdCcSzXok@q\\\\_b@f^kj@pHgAblAfObkB{J``@uoByz@q^_B{DlW|MvYkB~\\\\eWd|@ei@pJYnMbP~WyZxbB~Mn^jj@vSL|zAtKxQvr@dFpRwXrQ_Gnt@|NhWfp@dA`y@~Wvt@rc@bWyAtXeRt_@~FdTru@ck@gHlg@aZfa@oiLluHmwHviGkrK`aJokK`|JqiBv}CacIlfTkR`Ra^tJeeAj}@aEk}@tQqtByi@e|@}y@_n@o]dAkRvq@{Si_@aMy|@wLiCyDbHfNbfAz@hcAzGbLza@xErEbJGfe@vSjt@`CbZgRh{BjXtb@na@eCl~ArrInD~bCbRxi@rl@yl@rnBx|FyPbvAlBxgA`h@dVnIfq@hi@vVuAzdBfFjH|j@`JxSqUfbAxi@c`@fiAwVMgAjKsV~OcA`n@xPhQ`AbYkCxGc_@~AcHb_BpZvgAyGhsAv[rFzAjc@h_@nU`o@tsAkQzf@_e@oZq_@jcBgTsPwMdTiS_BqMao@}v@kNwFcIaLow@iKb\\\\gVkX}Dm`@|Ic|AmIk]{qBac@|Dxa@eP~G|Abj@bBbGtR}HpH|IlDrc@da@lErEvU{Pnv@fUhSmX|xDia@lWqGh\\\\gb@fDaJnOgGreE|B|^zPp[v]dN{@moAbFgNd^cWzTkBlCpOuO`hAzf@`q@fBxs@oPbK{p@u[eZla@}SfBbA}]}JqHWeZaOcIwYdrBk[oTqQjDwDp[oXdOi`@ni@gLnwAlCpJvHo@hZmn@p]hRdXjB~Nq_AzI{IbZbEpAxo@}r@nq@kI|T_IdiC`Vl\\\\`p@dCvMle@{K`m@dKf{@wk@~MiKxqA|s@`_A|Gnb@mf@|f@_Lj`@io@aDiCzm@yT|BqPkSjLqeAaCmm@yq@sC}I`SgTeBoQbO_s@nAgP_CeTm`AwUwF}e@~f@sDt_AwX~w@om@~HqWpZ{c@{PsO|Ii`AqKgPrIwnAhgBeStA{PuR{J~|@lAta@vWf`AqG`jAb`@d`@`NnBjMd|@~^hk@ka@|_BgG|hAmx@fuAeYfoAsHzyBiUdxA`d@x{Aal@tf@kU`yBfLpN~XsHbNed@pi@dCj@z\\\\wd@~n@yMzyApu@bNxQdf@t\\\\fD~F~nAuFx}@pGjk@{FbZtMht@dIuQ~PhIz_@qhArKnAxGjj@nr@|i@dGv`BcPtnAjJpg@ts@fiAvGjn@wBraEdf@f|@fBvfAtPvjAlBdyBjW|q@dDr}C~|@ddHeIlIah@nDqD|JtQbaAzk@zr@sOzvDhk@NaBbbAh[rxAhZqArMbLhaAwRjS|Pb@v|@wo@hbAaI|h@`j@~uBiGdi@aWjm@xK|h@iCtZxKrTe@puApSjhAiAxr@xKjT_Ija@nM|NxX_\\\\pO|IpT~cAcb@`uBlZheBp@`i@vXhcAyIfcCxLd\\\\v\\\\{InNtYqC~|@~G|r@iIlw@zXloCvO~Qrb@mUrWda@d\\\\iGjFjKqj@psAqS_EcJwZ{Li@qFzSvDh_Gjt@SuE~zAgDrK}XxHcDfQ~Jv]{EjkArSpDlFfQgG~NwRbAoEjm@e`@`e@oC~p@pY|u@n@db@~m@cVbUhKoDrT_TjRlDd|A~NdNvS{BpC{aAxa@chA`Mx@d^vk@vTzKtDda@t]|DpJ|TkLh_AdNhN~J}O~GeuBxUoZtKuvAw@cUkVe[_CswAxI{Nfh@yOtj@jxAwGvc@k`@bh@Bv_@lEtIhb@xIlf@xf@rNdAp]cQfMcX`HqmBf`@asAsTooAbm@ujA|ZarDdFiSl]oS`l@rLzWbd@bJfn@aZbvAzAhyAxNpj@ul@haBxUx|@Exe@mPr{@zTp~@hCffAr]ks@p{@lkBp`@za@|U`rAdf@vMv[m_@]cp@baAkFxS_kAbu@qNzh@ap@dq@nxAhTdgAtn@bcAqCpfBvLlq@ptAjjCBv{BbX|Iny@}m@aBsb@yNuK`b@gtA`P_nBhQsM|L`U|Nv@pUzq@`LPpD_LdF_cAtS_RdC}fGvh@oCpe@_t@rQekDgCa_@dTohAlpAwv@dV}}@hNf{@jPtSbhF`bAr^fa@bpAnwK_ShdG}b@hlA}~@hX_ExfAjN|c@fs@v\\\\`l@IhdAb}@fQrX~Dh~A|]b}@oShb@uQbHmHqO[kr@iO{@__@rmAlHteBe\\\\jn@sHvhDs\\\\nfAoj@xf@fBbs@cF`Jye@pBAnj@sQfLaSsAeVgYae@ZaWpm@oD`xA{VnFuX~b@xOzs@~b@vSlEjMgEt`@mSvUvKdhAeO|\\\\eItxAr[nTxsAy@lQhHnFvSyLhrAtd@dv@mA|]{Tjs@q}@je@mKbn@ab@dIqOtO|Qhr@dJp`CwPr`AxCnZdMjUri@iQxFfLiHjx@mr@jv@eCpQtWtb@xb@wErP|q@p_@fHpFrKqLdaBnaBlc@lMfLvxBxdGmc@~v@wFx[eb@hb@nB~iAoGnYmqAjiCcObMuWc@wLtHsPz~Aod@rr@__@tmAqAv\\\\hNf`ApFhDrV}KrNzYrYosArWoGtTpNxNqMpp@|Eh`AgVrd@`t@zJnx@cNvf@}k@~a@i|AiYmb@sf@qPdCw^v}Bgv@npAq_AbTmMvy@mTvMcShc@Rzo@aUzqBmj@zp@a^PyGvJyJeZeIvCm`@`aBmYx[wJbe@bDrqAkKd]bEvIbJkDu@`jA~U~x@{Gle@kJqGa@jn@eLpXb`@pdAwAtkAk[zh@an@lT}p@vh@w_AvYwE|Ol^~]`DzcAzb@bb@_HbjCzp@h`Av}@_JfWrv@x@t[sQjMqVlAcIrUDhNdY~ZzId`@hApaBeg@hi@_OqkA_OyLqYvDiGds@sY`a@~H`g@kEvwCch@xt@uGdl@rIfMzS_Azr@m\\\\|PjAtQdk@At]mDrF{TeUaNheAh\\\\vBfMl^tz@c@fIb]vOlLnGd|@dIkIrNbWz]aF|JtMnVthApU|hCvnAx`CiEbuEn{Atv@jr@un@bTpHlLjWd[xkCl|@bqAru@|pB]vw@o^vpAbFhXfK{ApHgn@xLqNb~@S|xAyO~cByu@le@zBzGcj@dP}Ld^gF`u@d~@jw@|}D|Sv]sb@jnAd@vf@sd@hWgWfc@mwAji@gOd^q^tRiuArrB_rApaC@nYnXlu@jv@no@tJhj@dQpPvVeP|^vb@lP_Dt]aa@tUSrPlK~{@`qAlf@vgCdMnUtXdMzoH~lAbv@q~BvY{]~^cFl`@|J~x@xw@dw@bmBtyHdkDvyA{bKzMgGxU`V~^yX|_@`GjQgv@zZja@rX~x@tfAqfArW|S`NZ|lAmrCyLoMqaAuB_NsM|j@msCqAwuFnb@epA|eD}rCfUgfAf`EsjBlnEc`Eph@zv@jKp~A{ChNci@hf@wFnc@lDle@dQx^zk@t^bMym@h\\\\sSpMvM~IofBlk@sfAbBo|@jj@_gAbo@exBng@ip@fG~MgYlkAiJ~I}IiOeDfJ~]fm@nTxI|Es[zv@cEmXl[sAvO|`@bbAhTyK`MtMmf@hS{C~Jf]iC_Yz\\\\nk@{TzAbH}^tXeA|OhXsUtR`EiXrUqSfi@~GpFeBrM`R~Gp@fVxKme@f\\\\iXz]`r@pGuCyHuZjCe`@d_@bEvl@s]aFdOtGpZnNdB}TnQwSsMmPxp@i]zUyDhWxOj[FlZ~k@~k@W|U|Im^hIzCe@ddA|^dFbLdb@pa@uLsQhf@iA`S~JzGaPda@z^rk@mR`WrV~k@_RtVdg@fN~I_JjThTwr@ph@kI`u@c_@p[}Fbd@nP~AScVdUeT|j@|IrIw_@nQvHtScD}EdWwa@dQ_Xf[}@lc@_Ob^iMaM?e]oSbiAbm@yYf]glAbe@_LtQrQgT|SgMOlDpHdp@oZfO~Up^|AzJbgArKep@`x@pn@dfAtGc@rl@zfC|z@z@bUtVjKaPn\\\\fu@aGxs@zSec@da@cp@vKzB|GvLbBpf@iOpg@f]gCrVtR|a@pZzU_EvWn_@j[YdNnt@zGrKsHbLvYlr@yKn[ic@z@ay@vVqkB|RsQpf@iM`YmZjKcc@mPaYvJcNjiAoZfl@oi@fyAum@|HiQrXxKoB{XlZoKpYjc@zLtE`PgLrr@le@}GlPbe@{G|HuNfSLrCoKyGcNiToIex@yFgYmQiQzAae@oRyv@qEoUa_@tOcaAzZ`c@bg@jYh|DddA`gBq@zAcIgY}d@jTsHrR|Kpa@np@lt@bAbP`Plp@gCtc@si@bKkd@nVyRvVyn@t~BgQhOiNn_@mgAbfBgmAtj@m_AdlB}mAfl@ar@lfBur@zLqQrWitA|QgOxpAdlAfu@hYr\\\\|Ydu@fhBbSt{A~|@l_@|Bf]hl@de@z`@gC`LjPtHrnAwUvjArM~m@kX|[eGjmAcZ~aAqUdP_o@b@{u@zp@q]dJ}IdTbGhq@i]lMen@|u@}RrG{Ibi@m[`h@en@tPhg@fkBqPpMed@N_DfJaDbz@wTn]kG|u@ia@tj@fDdmA_UlXcDvlA}l@|_@mGrj@qt@roBqMfEkjC{fA_N_AqUpXcTddBjL~oBiExSeb@~Wgv@p|@ePfe@lGfy@eg@fnA_CfZt|Att@oi@dVy[rb@{N~o@W`y@`\\\\b{AZlv@xl@rm@rG|wAlf@bgA{O|`@eK|rAkOxPmQ}@if@yt@gQ~EySbuA{a@xn@qN`j@er@baFk}@|`Bib@WoMht@i\\\\bt@x@r`B`Qnc@ox@dwBkCti@pEvhB`[vwAyNplA{DrtEyZrk@uRby@eZfS^t~@e\\\\pqAxAhsAcq@ntAyWfsAiZdv@bTtqAck@`rD?~i@lNdx@hdBfu@gArMcTt_@ij@nYal@n|Aw[dPgR|k@}mAzcBimA`oAqnAbdC_o@n`A_]xSsUtkAuv@x{@mc@~dBqa@bkFwJvW}w@nj@}Oh[wTbCiBxk@_Yfb@wPaDma@je@gXd{@ne@mZrS|LIvf@ySl`ApGba@eG`p@up@vnAyiAbp@qMwJwJhByq@hfA_iAzzEnBnuAkNtd@ch@`g@qFg~A{n@_pCqKmDwIlMnP`bBwe@dRsE_Jp_@wOuTaEmKaUuKdh@zHr`@j[hd@kg@cVo_@coAdbAi~DfM~NrXhCjErX`MyFeIyaBoLeJfBog@_M`[wBz}@gOfH_@_uBfLaTgf@euAdUbHoGwV~Hic@tJmCdGvXjEgNwZoZdLiIzEqo@sDsDyP~Vgf@kc@bGrX_O_CuYzKhC}X_Tnc@gp@qGyLl^oM|GzLjF`KmWpG`P|ZmKhAdGh]TnVnLwGpSy}BoDsSsR`K{AdChIjSeb@mJcFzB{Ko\\\\pUss@sdB}eDedAsUc|@Ygd@jSrg@?}X`g@bSdHaWnVpCrn@zk@hI_WqE}KuPpEe_CseAyd@}AcLyq@n_AsnBnByqA_l@yEgfAdhDua@~Pq_Av{A}Mja@Tpu@jJv@nGqXxGpMkb@nw@}g@po@{hBbl@cNfNv\\\\}jAbBipAoFaa@sb@au@aEsw@sUcc@`b@eYhQ{{AgCweAaNgMgJvF{Ufl@wFjt@hGdWrM|Eo\\\\fEkNwTi`@iF{Pmd@nGap@g[s{C{]we@{Awk@gt@euAlKyWoG{VnGinA}k@wMaRet@gG`d@jXz]kKfJoP_FyF~MaYgCuH_Oua@~I_O|lB|Rtm@`@~i@nRxGzRqCjOnw@nP|vDvKiHkAr^uMrWin@er@aPyeCgRm[eFtE|PdPhQlnCd{@lmAzQ`cCcPnHoe@i@o]wq@yTcI{HvNVtm@mOnEun@ci@i}@m@o|@uc@{HeTeKyaCmb@o}@zBel@y_AyUci@md@cG}[tAedBtPom@{I{_CrM}k@eDonBbKwpAkLmc@ioAeVo`@tUkL}r@gXvG{YyGof@s^y_@ms@qVwHsKjU~Gjl@gQ`j@mi@sbAcbArp@wHr@wEcPwf@jTsKvyAfCnc@ybBok@ee@yn@}C_[dOm}AeXsf@oWmHsJ`[zTpc@_OjYsIl|Aum@|s@k^mCePosC}n@{SyjAouB{u@cJsx@|{@ie@|OmScKqW{k@}K{AwPzqAha@|bA|E|u@qk@rnBadAzg@}NdfAnMlgAkJvh@gfAxo@mHzOvDdWr`@ni@lCxdA}e@ft@gKf`AwPxd@c_@dMrIlgBgSlwA}p@`b@DvmAviAxCjLn\\\\_Dx_@bPhh@u_Axs@cn@ttB{hAppAwy@i@u]niAat@|ZkCpKjIfpA``@bv@|OxyAkA~tArH|g@jf@vfBd\\\\f\\\\~DzXuHjd@w\\\\c@eV~mApQzy@aCf\\\\}~@h}@kVtf@i@vfD~Xh}@xUnXcClt@oNvX}l@jQgO~y@iNdLyjAv\\\\kNuGyLio@{JtMym@hCwTbd@yUZwYtUsWEwPpb@g]dCoCaa@aOaMqL~[dC`tAeJbQe|Apg@eCzqAvWjoAjCbxAaG`Sk`@nTvAhz@_PpwA~Qfw@aMzz@u_@_|@{SuNcThDbB``@gRje@yGUoDyQa|@cCgj@dk@gV`GuLzd@uM_EgYorDmPuVwX\\\\_XzOcb@|w@wAvd@cLrBu_@jr@Cf^mXaBac@`WwbAu@qWbZwO`g@epA`_BWrgAti@bnBwHtIuV_CoIxJdKfe@aKtd@aE`mBpe@p_Af@|]`f@tjAgLng@jEfiAag@bzBtDz\\\\iK|t@fBlXnTxG~WePnPfWvo@lL`WbQrLvZbX`uAiCzfApJzj@mCnJk^dEmGrMM~y@w]`@q_@bq@_^sIiGni@c\\\\~f@mQcUwRiB}QvN{p@cBiQhV_GyN{HjIxAe_@qFcJqQ~]m`An|@wY_w@se@zDkN`VgVvJmWfhAuv@xg@iVdn@we@al@wFyZc[jAc]sJgJuVdBuiA{Hac@jJsyAnMmRpb@sO`N_sAiFop@xJcbAiIks@mPcHLu_@wa@_t@_Ngk@ak@ua@y@lMcMV^ofA}DuOaI|HqE}tAuRdGul@mHcMlJpA~`@oQeA^|l@oN`Mw^`mAcm@|QaY}w@_V`]I|j@a\\\\zq@m]f`ChSnbCcJhoAyu@{g@up@kP{QkDee@|M`B`_@q[pTa^~nCit@ppBcZ|Nwm@~GkQpUok@xWuZfyAyoAq_Ae\\\\df@{@jaAoTnJeq@fbCmZp@w\\\\gc@q^`FgOc_A_KuJsWlQoFcC^wx@_p@`UmQqGmGvNkJSeYxl@s]pQ_S`f@oVqImg@}aAkKEkZhVqXyVgCnKcUuKeVhCaQrOqE`WjI|`Acc@h]eGx{@sG|FoWkAiUvaAuWlFeVvzBiNb`@vStdAqNxhAfAfq@|F|Vzc@zf@P|jA|v@`~Bct@jpA}Up@eo@mU{Oud@m\\\\zh@cNz~AcTbp@sFrfAx\\\\|iBtHrOpMj@uDz|@cG|Ngi@bOwa@ld@eHxr@tDn{A~Vfb@fAfZ{z@`wAeoAwi@_}@j^qi@v@wf@jk@oTiWg_@vjAc}@vQya@dgAof@k`@}GjBqZtr@kCvZ~RfmAaBnSweAeu@ct@gU_OfBiUpf@sa@ywAq_@qIsp@Po|@t{B{X|oAi|@qIPplBwa@pNeKy`@w`@dBuM_HsTwgAac@jB}Le_@yFhQ}IamBuUs|@oQpNiPjcA{]kEcNxBsFlVqs@VgrAjsAm\\\\zFy^aFin@_e@y_FdyAijA_i@ygAerCyhBuoAo{@g]aJhDgRko@VahAyGuK}Gd{@}QaaB|CeNwJ}A}Nl]gWrSsYpt@c{@m`@s_Afl@_o@gCwX}p@lCcV`Tie@di@eWnNs}AiYoYs`AcE_Wk]{Cid@nl@{pB}Fo]bGah@yQa_@eHyx@sa@ic@_Zz`@?kTg^{m@eM_l@`f@wpKxLsc@|]_zDeW}`@iO_DkE|IsRqWkOpUwEvaCcJx_@_V|HuT|XgSoDySt^_Tn{AGvlB`SdbAuHtcFqS|g@ef@`g@uHze@{l@|d@bPfFM~X}WxoAenBfnB?ui@|]yU`a@kvAiB_KcWhAwTpV{p@o@MoLca@pXsFiErFic@mMsLwPzFyItRza@xgAzIhkBrX~Iz@je@~OsLsApb@t^dk@kBxb@jKdLrCj_Au@vrAoFlQwO|ClWb\\\\cB|LikCg|EeT{z@ki@ox@ww@avBiFcv@isAkqBe|Ao|Fyx@_gAcn@syAie@o`@oUyhAgr@_yA}h@mf@oi@usAucDeaFwbBgfD}XiXicAulDmb@ka@on@gxAwXg[aP_n@qj@eXcp@op@utAwo@ghAcoAoG`Twh@na@mfAbgCsz@dVmIpLyn@dnCau@f_Bw{@z_Fgd@lq@y]duAmrAzuCkOtK}^}hCyVag@yg@kf@qmAyjCkQsP_TuBso@n`@wb@aOmRheAyh@`mAeZnmCiUzd@{m@uVeS|p@og@hU_\\\\opBcPwZgKkCaKxI_Wje@{\\\\cEeIfb@cFjoDeZfr@qi@lg@ss@l[{YmEeZkj@{Hm~AkPqQsGfYwRmRyVxBkE``CsKb\\\\qy@ovAodB{B{l@eeAig@xsAm]qzDpLuz@uDsHa\\\\j@gdAzUm}AzgA{rAlc@koBxzCmw@vq@seAf[io@|fAwdAnu@kaBrpC}j@pVas@g]avC}lE{Qie@_UeQebCwvFuf@iZsw@cJwMyRcuAz~EuzCfgEadAhoKm_EhnCcWdG}zByfDebAet@mQvl@OppD}GlRoJx@wt@qgGa^svA{FszAui@cjAqt@se@{n@cScNmYoGemCgY}zASi|AqHoh@wi@u`Bcg@sRuTuj@cd@xAoTd~@u@wdAb~@snDpDitEoIg[kT_Qqy@M}QbZ_UnC{PtSwWz@b^ehFuAkj@xZmmApPvCdI|S`L|BtVpg@}Gwb@gk@mlAfE_oEoIbGaSl{@_T}Owb@tIuOwYVaz@hk@apBeEsj@yMlCac@`dAaP?gUni@aTu]xBqx@tu@_dC_Aww@zPkpArJyTbFfK`JgKvKm~@uDc_AlFewB|W}oAqAok@tHcGzp@rOnRhk@~TneBgi@pnGfEth@gHp|B_GfEiVyKyM`CeMjO]jUvZuCpT~IjKhrA`Jwu@rI}CpWx\\\\~H?gTghA|M{_BeEgdAnKuT`P`YlKucAlGbIL~g@hTja@jTfjBrFwZgDc_@eTupAuPqTfAsl@uKyDzNyh@sJ_s@zKucCxWvaAxPjM{\\\\glA}G}vBhdAnjCde@hc@x@kKqG}k@cf@ac@_IsUac@elElIyZ}Fst@xJkn@lNlJbRfx@iCqr@dl@djAjIvfAbLhOoJ}cCnJqPpX`oBKkcAdLGqIw`ApIkSfNdSrg@lxBlUr_DzZrZns@kCm_AqX_Je]iQgcEwU_Qic@ojAq`A|AwMs]uDu_AxGcIrJdJgGob@|Byy@mPdLlPibJlJhCrDySpGobBxKwa@vKigEjTtO^uIaQic@kAgb@rEk`@|MeAnEuNmTkz@cLxI_Tb}@eE}TjAi{Avq@orIpPckAdWcd@tIwxBxFaNtKpDvKw\\\\nPo_Ch^ybCrNeUzDso@fk@_}BdQ}fB~UmSnJieAn[u|@nRslCjeAceDrb@oy@hTuO|GscAjTmXzJmeAtYme@db@r@lBwTeLqKri@chEym@p~CwFqOfJo_@gBsOqIxI{@yXdb@o_B~IoqA~MkOjEhLrEsM|gA_|F~l@idBjQurE{k@lg@o^bt@pAcs@bYyiAva@yRt\\\\kd@`Eq`@p_@qw@`b@{_BwAi]nRsc@zLlC~Chr@hs@yyA`Cuk@sPoQaNbAwCiw@zSmQb`@ygA}BskAzDsYp\\\\im@tc@wFra@hf@lPaNti@zGvC}u@vUif@nfAtIpa@`NfXurAkLsg@zE}RnKqMpZ`PvTea@nV|\\\\`m@}IxN|x@~VkVtvAbnArcCtmG`j@|W~Rj_AlXxUl^xjAtMxsAfy@vjCdr@zlF`PntC`[~jAfRvmDsCjx@db@bdGcT`gKmTvhDcbA~mFea@`pF}DtkHkOfqAeXfzHkO~|@FxeAiRvmBkWnh@gN|qAaiArvDqGps@fk@htCdBxs@aEt[qr@|o@}HiVrCucAyJqJ|Ed_@kXrwDjJf[mAhVef@_j@eWhK~k@fl@pI`kAeQde@SfIlLxEg@jOkk@`j@kWzy@xAjPqQ`^rXmGsXdr@xPrAx`@uaAkT`_AfX{\\\\uOrr@pXcj@dAnXurAhcG{hAx_DgT~VgWr@}GmHcPs}AwDhWjCpaB}WltAi@dpBmNzk@hJx_EuOfeBtHfd@?in@lPpGAeyEda@a{@sCti@|IeNrBi`A|M}s@~Qa\\\\vMa@fP~Z}ArfAxK{_AzBxKpIy@`Nhc@x@gmAba@spAvJ|DnPikCxWiZ?ffBv^e_CYoaCd\\\\ewAfItBaLrzBx_@ba@Er|BnMsUg@m~BuClGiEuGyA{}@wIkGm@}WjOaeBzUw[hKmi@bGnV}a@p~DnDvWpGkTKffAvPvqA`MgaAyOspBxGwi@~KoEbJq\\\\fIc_BuVoy@fPsRtGf]fIsiAbJgRxK|gC`PinAdLsR~Fl[AluCfPno@oG_sD~HcXdB|JrY_|CrV{PyLkl@pAqpA`Oig@bNfJdFqF`LihBhk@mjC~NwIdq@m~AvTve@vKoa@lMbLiEqr@`Kwm@~LsGtTnXlHgGvAee@v]dQf\\\\g|@dO`ZsApNy_AhcCfr@?|Gui@~Ove@dNa[xg@}UjG~WvWeA`b@raA`D_UfOgGi@ziB~GtJhQoJgCjyAxKaPbGd{@|KufBpHmHpSfI|TiJ|WkcB|GhTr@sXbNuT~IAlEpa@vK}\\\\rNdFrOcdApTxOvEwCdFqe@vL|IrXb{@nGmn@b]kf@TuRrYaKxIg^pa@pj@lPbgAxKka@kIaeB`PaT~Pj_BhI`MdUiq@p^og@n_@zpAnAp_ApRgrBdRyq@vLfFhKbY`Qoc@zYe{A`DczAlE{GxJzHh\\\\}YxDqe@eUsnBiJeQeh@x_@cBoIfPab@`YsjBz[qs@lPjCnPme@pXaPpBiQoNs`@dr@sgBbF_DjLvNpPqFdQ}e@vLhK`HePvG}nAnMid@fAu|@tZcmAqGmv@`Pwg@eLip@tT}m@l@waBfHoZxe@oo@dOwzAkDk{@eHgm@_h@uB{I}YcA_jBiZo`Byk@uv@_YqFyv@`Xgx@xxAeJcL_Jor@kK_LiGvBqTpdAeL{\\\\rBrlAyJhUy\\\\aEoI|b@vEte@t`@vb@_B|`BzXrrA}`@dgC}zBhrD_v@pw@uVn}@\\\\ts@g_@`ZeMgFqZ|MzBui@kLno@ih@ha@sIgOvBok@~NsRa@_f@wM}MqRi~AqT|Aor@mg@eVfAkJt[{Hi@eOitBnSabCrx@ixEtFgbAlJySrc@uFzVun@xh@k|CtDsrEdUnItTwTmQ`cAdM|]?fnB`K|QjJuDx\\\\eq@pVrt@vFcDgOufAa_@wMuDev@iOeg@hHmrA_N_KwThn@cIu@JiQzQyf@ja@q_G`k@ohC`M{tBvIyEfo@okCkE_iBcCyMyGbGgBoKoL_aBkL~KdPvgA_f@uMmIiXx`@wcCnf@iiAcA{mB|KwaB_JaIyJ|Aqc@`b@w}@le@mJmAcG{UzG_j@sKx@{Tfq@apAjk@}Zxi@uVdNi`@lbBk}BbvCwp@`qAi[~Pu[r@g`A{Wia@oo@{kAazGwr@}vBubB_tCsw@gjK{v@wcCyKmv@aINcbAgeCogA_eByx@}|@cPsq@bLueBli@exD~I\\\\jXrdAi_@wvLvEe^fp@ug@jHiWjDg|AdWe}@x\\\\sVdK~Ov\\\\|zB|_@po@~Od~@jMw\\\\`OqIvAi|@hf@_g@uZ{EkTpN_KdiAad@}{@{Ks`BoTcp@FckAn`AyaCpNgAtOlLjU}FxoA_vAkX}Eyr@lh@{|@gTyk@zm@c]pAoa@sIoz@qa@i[qc@}ZeOee@qlA_fAsTyLgU}L_rAkFpFb@pf@iaAjlAcM{FgH}v@mNmLw]vBcOaXi@~Low@~v@qZ~aAg]_n@jItw@ik@tvBwJbnDgZt`@kb@iBah@mOkpCgbCg`AgWgCaYxKqG}Fq\\\\ePVuCbm@so@ee@gNnBqNxVgZIwCoeAiTsi@uC|xAsXmGqGmn@yBln@we@}yB}Beo@q@|_ArQlnAe^xBaiBqj@uXnIsWbi@mlDphOyZn_@gDeZq@acBrhA_|HxGa~CoIqhDlDqpNlRikDni@caFrb@gP`NuZtXmGpcAmyClAcw@ro@mhB~K|l@bAgp@rx@{Iz\\\\wq@|n@{f@f\\\\cKvPxMs@xhEb_@`gFhVpwK~IhXvJfCrDgZvLsAz\\\\bGlMpM|C|{At_@`uAzw@fcArJeDl^nH`_@wW`Vq_Abl@}}C`Oa|BhPys@vz@_l@bz@giA`j@{WjSmvAtS_e@gCkkD}VotBnB}v@hHcKtKStEzg@tYr_@rUt~@d@aPij@kmBmQwuEus@ylHdNc|AlGuI`PpAqB_E}LwN_P|HuO}Hga@kiAsQgIsw@{C}m@td@ma@_|@}t@lIgMeMaYqfAgYeXwZ~Owk@~z@eLhEeKmR{^c`CvFm\\\\t]lPc@kLqw@wdBsB}_AbNinAg_@mdCvHiZ|B`Y|BkjCbL{k@nGrTlEaS`AgeAoGve@yK{Gme@p{BxCbr@aHjCaSsTwb@jFm_Ax`CQcs@xj@mkB|G}nAkFsdB_iAydIya@ovAca@ojCtQzTrLh~@rMzTeYu_Boh@a|@wZo~@g_@kqC_q@m`Cgp@stAhVchA{D{dBcNqh@cOwbCmQku@jDsu@oGoc@iFgJgC`a@}PsPmB_xAoa@e~EcWchAo@_XnY`UvOjkAtBjGxIoBfEiHoLscAtAshAnNuh@Pje@~FdGfVaEzNxtA`KpMne@qeAoK}~A_l@mjBmGu_A{^u`@}PhPiThtAiWfSwH`ZoPsC_EemAwJ{MsEfGnJzuAn`@~s@aFfqAgU{]cxC}~P{W{sB}a@_sG{VedHzFodCfLdSdKbkBp_@tzMjXck@Fsh@mKpXgF_V~KqnCnRyi@t\\\\vcBlTpUl^wD`NvKdSv{@`\\\\sTl_Ak|Ah~@{WjHvFjB`j@rX`d@if@j{AwBj\\\\pGnTxGwb@tXed@vFpQeXdiCrRpg@iCfb@bp@vbA`P}gAkJayA~DqqErOwh@t@ihAgN}z@um@i{@r@eT`b@p[t_@{Qc@yUa~@kdD~Myc@ty@iu@lJqXt@_wAkFaSgi@yd@wQpCyXde@mBo_@aj@{PoXqd@kCgk@sUcYkDaq@{S{W}WgeBuFu~Dms@}xDrXa_CeD_|DlDgIlT`Py^kn@}Bud@~j@wgCvMqwCoIisCgSus@q\\\\gTe|@m`BkDmjEsY__@_NfRmPgCkZaeCPem@db@|Xx^{d@vNafAvKrXmCou@sKoHoVd_BcN]yInS}MmLkPdBcI}Qgy@ghJbAuvExEiJvM~hDjRxo@hRodClzAmeE`WfAzIbLwGtoAtDji@tf@|r@jMve@j^eGnVy]dl@d\\\\pYkHlq@inJe[cqB}s@qaA{k@xTa~@|fBmQyEiKoVgSk}@tSgkB}T_nF_Maw@}j@wyAc_@q`@oPyf@yFu|AyKne@mUmR{WgE}m@~GqZnRia@vmAt@ja@`Y?nGyK`GpRqOviAglA^_d@n\\\\s^rbAaYpK_\\\\f_Awn@n{@}MEqMpn@g]sGaW_q@_DcfGqLkG_R|SyAan@oGaGqXnV{WmL{Nmg@oJu`BxLghAyKke@rSm`@tCoxAjTebAfQu`FlK}xOfk@afNvjAe_Mn|@weGvi@imBnp@}mDbLfYaF`qA~Iu@vWz\\\\jHwKlWnXpKlA|HcNg^ezAeF}EiTrOgJiCsCsyAzw@ozEpTswFnQkt@~B`|AyBdKwGuE?tcA`Yea@x\\\\khG|l@aeCli@qyEfKabEzKe}@tIkWtKxr@fL`CtXw_Al]c`@pEwrAly@g}Cv}@kkCcGj_AhVav@na@qtEti@sdAfLuFnWta@vNk@bPbLkC{o@pNiKjM|L`GsPge@iwBoKgBuTye@qGk_A_Hle@}IjNeMjAmqAk{BarAeeByoA}t@}W_AkIeb@}a@{W?hcA}Gp`@eEiBgGctA{E}Y?jj@uToaBzGv_C{G{Gw@tPv]nbCu@~~@kh@nmC}r@lr@u_Bn{Cs{@||@{H~BcNmKoVyg@{MtI}nAuJ`Dnn@nOjIhNkOcLzeCaq@`yBuUliBaAzxA_f@fdCmExs@rHt[vG{QxKd@dIhyAn}@a_AGfk@q]~w@yU`Fmv@wEk^nOa|CogAyVk`@cC_X`PaPhTfXrOmKmG}aByVwbA_t@mn@?h]}\\\\iC{T|GcChKn^aGAjL}Ohp@k]~j@ks@oj@ao@kQiPgOgBm\\\\aY~EwKqVq\\\\po@ysAme@yb@o^qXuCki@lo@cIbiAhOf|@t[xzEic@bL_`@dd@ip@}dBm`@ue@_\\\\}C_Kg]}Lvj@~CbKbJkBnMdi@lPkTdMdr@uCt\\\\eM|OkO_Bs_AgvBqg@cT{i@{h@iA{jA}gAyyIiIp@oE}Jot@}tEmcBk_E{Cm^`LcZ}XrXicAmcBin@}ZoL}Yc`@}Aqb@ur@ciAqXcc@e]_c@cLuEmKkHtB{Sq`@{vAeRuIqg@g]z\\\\sSk\\\\mw@wd@_Cqo@eGde@_L~IybA__Dop@wl@u[z@kMk_@cMudAdF_mEnK_AxCbNzLvfCvN_BhEuOoL}kJvEamH}I_kF`FoqCyK{wDfr@euGpLxvBxHuj@{TywChV_gBbCe{Bro@_tEhCdgA`P{KxKgi@rVhp@rKOf`@g^vMuf@|Wp[~h@Nvv@m_@pI_ThO`E|m@fqAnx@xTlj@vpAbh@xIfb@lVbNpVdTprArXbRlu@wYlPfJfMldAdk@jNzHfYtOruAqCba@l\\\\~sAp_@tTt]iU~vAseDdbBwjIjY}WbVvYzX\\\\dlAayBnP}Dl_Ava@je@tB|d@nu@bJ`B|MkEba@er@bh@pIl\\\\_GpXfbBn|@pmBrK\\\\|NqSh\\\\qqAvRaMjh@|\\\\|`@`r@|KwOjT_Czq@glAni@yYnv@hYdd@fw@lN~BvId_@rYsd@~x@{g@tj@}EbP~|Aba@imA~h@oTpb@niAnc@r^j\\\\m@dc@e]fqAq]`o@{fA`h@sbBp^gjC|fBiaH~f@uz@~c@iF~NxXsKrHwQmH{EbOlFf[hVzp@hs@bb@jzAx~D`]}[tKjBhOr^jQdDn|@ohAvTwGhbApDdTnlAlSyFz^ci@|XdVwR|QcCzZnZ|zAvNcC`Nf\\\\lHfhD|PlT|`@qBtIjKz_@bwAxh@_f@hl@h_@~In{AgM~f@~QzwAbUcAhZodBdg@iFi@r^qTlLuEdO|HvDnRkKbPvkCbLq~@tAzKzQ_G~KzFzFl^p_@tk@vIlo@fc@x\\\\wQvkAtOl_A}a@znApLnx@tOcnAfLqN?ve@~OelAhLhOzQ`D|Zaj@~JpRtReh@nTuFxIlXdLeLpKhhAqKvcAmPeUf]`w@yKfpA~Sui@?pmBvK|GxKyq@pHnZqHtvAnPaa@vKpGxKhuD{\\\\`kDaR|y@{ZzM_YajBiGerAcPuUzAoZcYge@oA`Jb@n{AhJlX?gr@bLz|AxK{m@xKblC_PnxAsKjKcHcOeJrdA}Wdm@qf@rb@kD~VnEdIzMq@dLr\\\\dCr{@oPttA~CrNrSid@vCnWwXd|BdGxz@oG`jBdLpfB`MeSzHqaAxVeH|f@xHp^cEz]cy@~a@apBhQguEzi@mkBj`@idFhPay@oRihN`EcpDdSc{Ctv@k_BiI}t@rKik@aI{_@|EsSkd@csBlC_[eJsXyTaQcl@wLac@tRiVq[gDxQzHly@oO_QsW{qAqZwMq[{cHq^}_@yKfCiFgHos@ypHciA}eByLovAiVal@g_@_BmNu[sXjPmx@wc@uKg@yg@h`@ysAe{B_~C_jKidAue@qxAoS}gAti@oz@pRmcAtyA{v@jbCmT`WmjA{mAeQqEqs@ha@qm@sXgYgd@cWefAs}@w~IoN_lG{Za{B}SmvDeIccDvJe`CvDuO|x@}q@vHqBt_@lo@vYkZlcAavEvt@}aAeFoTaGd]_HgPrr@{zBnh@to@fQoVv[aEvYx]nJ`i@xOjIrOyShT{cBvr@bf@d[jo@hTsOxOf@vc@j]|m@keAhp@jv@mKdn@fJbc@lDxEfTsCfkBisJ`KgPjTpJnJgD_SmgBzJeZgEq`@nNaw@wXssBhQarA]wpAoQgaAkB{tAzTahCoGyx@cX~k@aH`lBmPwe@?xgA~\\\\poARdc@yPh~@?ucAcJoYqFhB}E|QhCb{@kUj[j@nIhf@lLoYzl@vK|m@gEvh@vFrk@mVh~@vDduAiBzGwGqFwM}n@w@x{@s\\\\ln@n@fJrUhKxBpiA_JxlA{TtTsjAaKkXgbAr@fe@c^ta@oSqTwb@FeZwSiy@ejBqOwuFgOij@uV~d@y\\\\pL_WfqA}j@f^uO{\\\\wMvPeErS|GnbAib@rJe^f`@}a@eQ{VBw~@j_@}i@~DyNfUiHnsC}f@hmBcK||Din@p}@}[`|@eMncA|Mzl@{GjjAea@leDaXpiAhBpuCgPxuD``AblFhNfVnGwe@pHh\\\\_@zfBdN`hBg_@zcDoG`yBbf@f{E_TxmAo_@vn@oQj@qgAcy@aaAg^ke@rXsr@n_AiiAbwBef@n\\\\qQ{Jgs@amA_TfAwe@s]wf@yBqd@k`@aRxD{Ppf@oMuHoxAyyDkp@gk@e_@miAit@yiAue@gMot@dLi]tb@}uAhoEghAxRw|@bwBir@d]o~@||@is@nfCyh@f~DmMxEk_B}tEer@qrAyXoO_i@da@o]ya@ex@kaG}[oaEtAuq@_xA_tJyWqh@gl@oq@ctAcUub@n@gdBz`@mQuAip@`d@_sAl|CiFgAqe@kqD~\\\\w_B`HunAl_@oqBhO}PpR~m@uG`]jXhj@oGur@h_@_h@v[wfBvSya@xJzBAt`ArL~TjPfBnt@mh@~QcA~Xmb@fCxHtOuu@pXtFz^t{Ahe@tn@~n@Pb[f_Ax[qNdPdt@s@`j@jJpR~\\\\sQn_@bd@bIiF~HvHjSqC|DyQfNoBbH{Mff@}~BtGeH~FnHlLv|@fn@yvAjX||@`FdDrPgIjDf|Bt]dGjWsTbRwp@vj@msFlPgpDhS}w@`@iJ_JhCuDgRlWqlM`DyN|LhSpS{FxG_NhXpAsXg]m\\\\~S}Mqn@~NsjFgH_mBt]wmD`U{\\\\fp@_HjTs]jTkmEb\\\\uqAiSosBpG{iBsCkeAgOa]zJ}TkD{m@oNgHeCpUcUc{@aK~WxUtaAmOvkAfM`d@zGmgAxX~xAgPpwBd]lkAaUjCye@{_@wFjNeLe{@}Sxe@}GkPyLpPpHl}AzMsQfR_kAoFprAy_@|mAgGlA}Xsa@oZuLgJ]iS|SkKr_DfLly@oa@bPaT{v@aLyKsCdMtf@z}CzJmIxEhW}\\\\vyBoA|p@nPyv@lAlSae@xaDse@boAsWfsF{g@fqAqZ~_E{MhMqSyJuM~Air@n~@coAhr@yZzr@_x@c~Ey^wfIzP}{A|uBq{ItAcxAo`@}mFeYwu@ud@}PinAbuCqBriDd`@f}ByQ~kAgJjEwg@}eAkTr]_GcSnQmxBsMwl@us@cuAmc@gaCcOaV~K}aAoQkxBlFcp@uOfCjR{cIgDwhFrMokA~{@whDnb@mkCza@}`H|i@k`FvGsI|ZfRyKlv@wTer@T`oAlSn`@xsB}`Knf@qQzRgf@bMu~B`YyuB}C_}Gg\\\\c}D{RqeFrb@{}AoEyrAxGo|@nY_j@pNpHbu@~hBjElt@jSxVjh@rOx\\\\af@zMjmCheAduIhOhGjQ}K~TLlZu_@|\\\\gL~UbW~YldAlQgBfp@}|@jFxBxLuPnPnBpPqNrXui@nTb]`\\\\nrAlWzY~TdBhSnu@|MhJp\\\\qk@jYChnA{]dRuQrXgCzm@icA}\\\\lLcXhXmt@|Ty_AnO}I{Gqm@nr@eSsvAyDogBmI{C_V`WwPz@yFy_@s[uJaDq_@eZdn@c]ew@kIqGyXhAw^ocAgA}[hGybA~T_TgMqKsb@`LsAcOj\\\\gRnKkTf_@ogB~hAcAtk@bl@rz@|C|N|f@xk@ad@xl@fYn`@dsAneAhcCbRlGpX_LtCfPnWkqAb`@ebAdKomCoGah@yDqD{BnPyE_NkQYqj@h`@iNfg@{`@x^sVdf@eEie@rLwiEwJczBeL?u[sdAoo@qoAoOnQoZaKmLlCyrAr_B_i@TmLzd@cnApg@oc@by@}OeIiQ~IsShh@eI|zAuRx_@{@awAol@p\\\\ua@xe@qXeiAqs@mcAoz@_Ca[{oBgh@ki@qa@hFiVjk@qTfL}\\\\jgAqb@{AoSja@iZd{BoGzvBwCjqBfO`cAeM|UsW~Iop@v~Aml@lqDsXqfAe]{Cq^dLaN|h@cNdLcFx}CaNjr@_TfkDsAfkFgiAphFyk@npA}m@opAcOz{A_c@j_@uQf@el@awAogAcj@s_@hPySf^yQrGof@xbEqGsaByOnOyXxuCfCb{@sOax@OmyAiJg{@iIqAeZpWuPv~CmKbJwNeh@iPeQvEgp@q\\\\eb@sI__A_GcM_V_G_Kca@wDfZxOly@yEvTaj@uCwIme@vFa`DsSkfG{YskDiH}iGnCgy\\\\_XuwCgHwmKuYs_CiFkiC|`@geC]{dG}Ck[eJyLyL|LwDgDa^q`AiByoDqYi{AiUm~MdRqxDrOsMjh@|x@tGd{A~c@jvAxSh~Bt\\\\flAbV~mDzaAzlEngAoErSoU`o@iyFlRqG~Ywq@tIkxBzXyvA~Vc^z^cLzRg]ur@rIsi@baAoSdiBid@~dAsStnAc\\\\vdEa_@~q@{\\\\yBoI~Ggb@}Voe@ygEgOsbJaMel@eB}fE_y@coBnGtz@qW{Rqy@qqEai@e\\\\cMj`@Obh@hC~Gtb@jG~Nff@mItaBoa@`pAgTzLoe@h~@qXj_AmIwb@tEmaDvPacA[q`@iU~b@iHtk@uCzuF_Jb[yKqG{MzvAm_@mp@gXfx@iRr_CoCnnHyQpk@mPcFkTba@kGoRnEsiCzZsuAgOaFmDvOuXtOaPj_CmHy}AjF}_@eWkiCbLcgA`Pi]zG`YbDgO[omE~Vhi@vQ`sBtJqHBghAsXmgA`\\\\kYhIlMzDqNwHe^yKqCg]|\\\\{FsGzF_yCaU|pBsFjK_NgP{HnDnJ~oDwKzOiCxe@aPlKkTr}Ae]kyC}BztDka@~xDuGlzGuTqlAeIzQ}GcDyLac@hDmgAcLct@gVcNsXr{@gC}e@p[wkDfIwEiCveBdc@qbA_Ji~AnPgfBhTjThCer@pGvK|KwNgD_}AlLcgAeIyCcQbf@oQa_@YnbB}JjSo`@a~AfSm|Ay\\\\gJwOh[}WqN_MmUkr@ksDsX`dCmOk{@rB_aBrJq`@~L~[dFmFvMkoAoCaNmPpTiGe_AuOyW{ChOqGucAuF`c@YrdFsSr{@kKjpCtJ~y@qGjYiWcWoVsu@cBkmBjOupFzY}bFoZupAg@{SxIeMkLuQeO{tAsc@eK}Ke[cPszAbIqbA{\\\\hn@uQke@cJe`CqGh]{Uu_BxDkcBeM~P{Cv]vMrhDgCkLqUmiFu`@}eA~Xo{A`I[lBw_E_D}JgOv|@iH|EwFkMiHyfGwMtL{IzjAqIuWmT_rKuc@{rD|Z{b@lBeTi[{|Af@wh@`LnDtEmRgJ_qCtF{oAkIse@qQL_HxKuVqp@jK{dAeVoaGfGazAgSsyAaq@}dS_f@oyHaQycAwExJhN~w@~QhbEmIpzAwLsDaHpb@~Zj|@hGa\\\\~Lbn@ig@v|@}Gxe@yKye@eEvYd_@zbEjK}xAjLvUt@hg@cEfiAgImGeLzIsR|b@aP__AuUlH{AmHiKjBmG}P}Acv@z\\\\wd@_RapAiI`FuNr|@_Fk`ElWh{@xKcv@rOfTlDkWaUq`A}GojBdLke@oG{pCoOzf@fCh`@iQh|@pCpy@aP|`@yDm^bEicG`J{|@_IakBpF{KbGxFvKwv@pGtTdEeMsF_tAcFeKyDjPkHuI_I{rA}M|PkEdiBmEqSlEubDtV{{@CalAsKe|@rDs~@oXesAdO}lH|M{~@lCgdBdKcd@zBhbBjK|Z|Gir@pGd{@jRgTvDzC|J~~A`i@lvAfJbtAjSueByd@}q@eSugAwF}uAhFm{@}LmhByKjn@cC}TiI}fC|DiQlP|\\\\}BuuA`KeOzv@rlCpAsMwTskC`J_|AoOzA{FgSyCwm@uWog@si@gzCmAczAz`@nzBxIHbJa]bPle@mAknAcEwQsE~PoEgAsMio@JmkGuAsJoOtLmSxiAs@h~AgYkeB\\\\_u@`UmfAuRizCiFsc@w@x|BaJb~@kTucAtDww@{Qk\\\\wBitCq\\\\mjBcUcuCbUzSrO|j@hCslAaP_{@bGotC_Fif@rJ{d@`Pvz@pGiT?|xAhTslAwN{_FhN_OtLtqA~O}bApGxKbAsHqJox@dTkx@tGwCxNdJjZff@r_@eErVxFfWfg@jZfvBpGszA_EgYul@_~@eVqzAiPqYiOyE_MjGm]tm@o[iZaOqDoFpFmL`b@{O~EaX``Bwc@pPyIdNwCjyBie@xp@yEj^kE~wCeV?m^`{@uM~z@aLjEuOqb@r_@ckKwMqaM~Q{m@}ZarF}SqaBt`@w_CbP|XwN{zDfKs_AxGefErYko@lCoZ|IsrFgShn@ea@|eGy@luBeGrXyBmPsLnlByB`sEwTpaBsOabBwToi@qGnG{\\\\wT{BzGaLgNgPjE_Bwe@uQc\\\\mKh}Cw^fhAmRoPqAnTmp@mn@wM|GmI{pAbCo`BuKuP}ZcAqEvk@oLeDsHeSyd@skCqf@y}Ac\\\\w~B}y@izC{IaCsEkS{D}hAbLuTSiYkjAqyDe[yfEkDsRcPdL{n@yxDaBsd@tZ_}@qFyrCm~@glGwA{eDtCcU|Zri@tFsRwSe`Hfk@_b@~Lad@|MagAdK{{CzPc~@gPepIlPfXlJui@dMcLxMzFpV_m@dG|BvMcxBvRqjAnGdl@eGn_HnP`TzWxcA`PxnBrCk_ApOrr@tl@tgJxCjhDtQtlAqB`uA|WhlCdC}LoSyhKjR{}@d@ixB_M{nA~LkzAeOaiDh@crBuReqA|GeHjTna@hTh}AxK{\\\\~BjyDpT`_@hImH|@ybCcHgqCyh@{`E~d@svIeBoMmP`pAfAss@aNm{@`PopBpGpXrEgS}IyoD|Gz\\\\|Sk_AhC|GfKg[rL}nAhJzQvHh~BpLov@~ExoAtH~TfCwz@hTvz@gCtcAhKfLrNMv^|rAyBz|BvZ{m@ts@hgAbRlk@}@dh@eO}BiFdYoAhfAjQpQ?qGxKbAjKiM~G`FzEqPpEesDrQqfAaXey@f@elAgP_qCnQ_hEsQu}C}u@}uAeO_CaMpv@wXmWcCpNoJsQi@gmD|Gwz@jKjTqLcdCpE}rByMobFxDq|AeQq{BzKuS?}kB|HqR|AyzB~OwlCag@wxDiDaaCpViuAgAy|ByDcWqGx\\\\mFk]t@myAtHg\\\\lPri@~Uq^wO}w@nNqvEbGri@xKca@xKb{@hK_ZsEcmBjN{cBxlA}gFnpAffFqeAijGliA{qHbGzv@xSwl@vLoDdLhi@|GmKvDzo@wMxu@hCfO~[_rALizAh]yo@ri@b{@nPzvBcJitFeLqh@kQiGmPda@uK_l@Xcv@h\\\\_`BgZb\\\\gFsSsW~cDmGj`@mUvWzBa{BcGmoAxK{UxKbj@vK}G}BqhAhf@_~@be@dKx[{FzKe{@xTf]nGoa@jJ|M`Erx@pG{q@hT_YxKhCxK|m@yKajB{\\\\tTgCwt@vFgb@eLeLxPio@zHrQrXqe@hb@``@|M?j_A|gA`_@?bO~PjPviA|NbI?bpAwq@e`BwTkUiVr}EfE~wBwEie@wIkDkvAnhJ~@ziCvNskAv]mc@f@g{ApSmaB`Py\\\\xNj[uFjo@j_@mpApR~JvOxj@pRoNtAuiCgUfYgLHyXojDxBkPdQ{G|X`a@|MgUlZuxC}Ii~@~_@ah@je@uIkFzt@pHft@dIjGrBwhArHsIls@|g@d}@jvCthArzEpPnJb\\\\~eArk@rcF|YjdFjLfa@jQ}AxVbX`Jj_A|SnYhC}Xhf@toB_Qp~DzBjf@~r@xqDpGppAjHeh@dRlx@|DvyAdc@d`DmH|kCfJ|^fC{k@xKmVjS~Ot[`}AYzcC|Ud]bM~~AoGxI|G|gChNuq@sJuzDdFaV|s@rh@d^ngAfYvzBpYjhAjKv{DbYrqBt^jz@zDfp@zr@vzB|d@|l@iDreAz\\\\u`@jJpy@jiAbnEcPmPaH`c@vg@`sDqMzhF~IhmCjZl[xKwi@tcA`sD~i@l^jTt[bTztAeKt|AbGl_A_ErdBb^~hBjJvIr_@q@t\\\\fWhXvsAjKvKdq@bCrF`GbOlr@vm@flA|j@`jDlRbg@dHpjCxK}B{@__Gq\\\\axBaa@mdAuJqxAoc@wk@mBiT`F}q@|Q}JpWaiDxAi|A|Tu|@~B{fBgR_nDyOrqA}FbzEok@tcFqJvV}bArXa^wXuDah@uXko@h`@ybGiAulAmV_tAzMeuDeHmfBzAknC}G_eAc]kqAsHmmAnNukCwEic@}NkLaJjRcAxgBqLiZkIa~AbC_zAeQezAsE{sC}UkyDsHuRaJk@qMf^yNuAna@{sBeGeW}NpAcSb{@cOn_BmQfEoFsMzy@afE`Bc}Buf@urFsQg`@qGfi@}KyT}OuzDsCbPsN{N_E_]yUojGmJkO_Rd]kR}XqL|}C|q@njFLpoHvLp\\\\zGwTnC~]_\\\\|lA_i@`r@u[{A_GuLaZ{bDgs@yh@o`@gsA{AcmDhPmnDvr@kkF{X_yAuQbUwV{P{FwQ}Km_G|Nq[nI`L|KhoByFnnAfM|a@~bAm{@hb@wvAvi@aeFrUuuEt@kkC}XaxGiZklAaI{Gin@pN_n@xdAqX?lA_N~`@_}@bc@yrBrVaPpw@whAri@giAj_@gSbZfB~KfpA`jAxcBnM{m@`_@eh@pWglCx[hBl[mHpm@bLbh@iThdAdh@jQ{Rvj@vlBrSbxBlKxKvJqC`Yeh@p\\\\iCnZ_u@jZ}pCxZuqJoK`]md@~uKga@r~AcXzLm\\\\~b@oO{LqQio@yF{z@oe@ceBiNyNwWjMsRiFwa@qj@}M\\\\ga@rj@oRo@uWcR_i@cdB`CioAwSoqBgGn[fGrzAoDxi@is@xtCug@~i@iRgwG{f@ixBjCo`JeIwnBkh@i`DaUm{DtT{yMPwkGfd@q|GnOaoF?}sRnSusEjg@m`CtWzfA_DjyA~HrRiGdq@eQh^bDdcArPfTjJhe@fQzNjPgr@pTpa@fO}BzRhOvZqXzPsi@dw@q{GdGqiBvSueBuBkfA|M{gBt_@oc@bDyx@jUeV|s@_zN_H{Wui@lhMmPpf@uM`HaEgKxR{vGpDohGtQshBxHqnCuZmoEbJuwEsJib@fGujBtVbXlT{iAhIklFnUmdAvFwzBwfA|gGoDg_@po@_mH{J}cAiy@zeI{Bum@oPfXoFe|AhSixCzWiRlX_zCmIi{@i`@ifAsVlTci@yt@_CiKpN}QaB}IuO]qH`l@eTlLwJuNgMkzAdLhTxHel@}Dap@cGba@yKme@uIpqAmV|c@?mLmI_@iJlaAgc@hg@cX}q@i@zdB{HbSaJ{v@eRx\\\\kCyLDc_@tR}f@}EqKaXbb@iLtm@qGwCaP|\\\\gJuKtAo_AwTpa@pGucAqXf]eLmFoKhJiNixAsQgp@tKsVvKba@rCyK|NxS`Dqz@xKna@jFiZ{KeuCiFmAdDqs@aHaOaPxo@wR`IoIb@oPmPaPda@nGicAvTaj@|Sxe@rBwh@cAyTgR}TiB{v@mEuKsNCmHyh@yQsQ_@i\\\\vGgL|BfTrCogAvXimA{Ams@pNjAnDkTyHmpAlKxKnG_l@rM{LuDc^f]iCeGmb@uJ{HsM|EeBop@_ImE`UkPfGzGfCie@`PtTjBsUhWlYnOeOaIcUjMkG`BiXaGe\\\\eRsRtGMfGySmJmX~GsUnGnM?__AvKq{@zKzPfCeLvMhaAxIxIjTw`@zGxF_F}d@{GoCuHxOiD_UsFokAhJmXeDwm@gR~d@_Pov@eLuGrC}`@rf@~HnS`[zCiQaOof@yMaIiLjHaEiDk]ijD`[}gAxLvMlOeEhTve@uLzSvDjb@mIvi@nM|JfCcb@vOg\\\\bL`{@oPgbD~G{SvKbLz@ye@fNca@d_@qXnO|PlUsAej@my@ip@xz@Dao@yCwJoVgCaJmg@lBuRp\\\\kMv@qZsK{GqRbEjE_}@aPbPm\\\\}F}Gvu@}Ksh@aEc_Blc@gyFjKmnIvTbv@~Q}mBnc@|gAqGojC`Hwe@aHiz@fCkN|Vze@rIkC|S|m@k@yw@pRcfAeQ{pApAuv@zS{fAxE{hAbP|\\\\}Bsw@pJeTtMvF_@daApWkGj[hu@oA{g@uVmw@kHerAvQ{bB~i@}fC`^cUrQnVzD`r@cX`mGvk@|lKzJr^`QrO`NiQtIhHzSv|@{ColCxw@qqRlHlpAzJjTfCkPhCjMkM~lEyRz_ClBfxAsKjXb_@~r@xBrqHpYgv@nb@qxBdt@kgG~IqmCgIwlD~IawApI}HpRbSlJanA~YijAtCdLzGoSpHtHpFtyAfK|BvCeNjHHlGqeBtJoYnGzJ@fo@pr@dlCmCofCvG{QxUh`BbB`fA_Hlx@xMxp@jAdfA}m@nbBea@jnD{I@uMan@wHlXyb@zkFieAxtDStwB~{@c{BhChhA`KsSfHoqA`c@cvBlB{v@tMyb@j`@wd@|^mqArCiF~^jE`JkEp@cU`YqWvIsz@dj@}[`RtBlU{iAdv@ixAe[adC{NwZuMkB}m@vx@uc@pQmVvZeHoJpsAqxC`KkIdSlDhPmHvq@cqCfAzb@}X`pBn]nbClA`tBdQjo@bLbEje@ga@gIahDnf@i_A``@bGjRcp@niAso@zWkk@x[muBhZ_d@z[gCrMqZtVha@vM~q@eNutCzI}m@`PvKaJ_cBrRaq@rQe@zPad@zGtBrEh]hYyzDzWyPxKglAmLwp@um@gMiHc\\\\fa@yb@nYgIzTc]pr@n@~M__BcFerBub@_hB{p@}}A}cCkkCcUzDqGi`AyMe@aExXeOgNiLsuAnGg{Be_@~xC_OqC}z@uw@yXyDqu@yb@yeBqaB_}B}jDcf@qnB[qyByMg~AfGqeAzk@kdBt_@{F_\\\\`_@kd@lzAiHdmAvKpx@tMhCD|sAlRdLd]sV~Kf|@dTBvCgH|]u~Cdo@ez@hUsKhPe_@vv@qjDl`@_kCdVs`DzP{`DyB{gEaQc{@rVa_BfLZ|]ju@rSgJwZ{Okc@om@_a@|l@uCgr@_SYcL_Ryb@gjCpMqt@wUmv@bLeeA?y_AoInFeJbk@}Ouq@yQy{BiFebFj_@_fElCwvAzIil@rXhAdD{YlSnDu_@_gAjBieAfe@wfAbo@yoFdJjUxCi[cHsk@xK}\\\\hCmv@dTn\\\\`HgXpGdr@jWsoA_@_s@kVwv@hTfT?wi@pQh\\\\J|fBt_@euCbOh@bMm]cA_\\\\iOi^dDof@pGnP`DmSkHs{@kb@bq@_Bjs@sCwCxKhn@_MgBoNrbAgNqx@zPiuAFa|@}M{UbAeq@iSsa@}GlyCgEiAaRg_BhK_hAuBa{@wKlc@?nwDgLbp@kKyTbGnp@mKfc@_u@osBet@wMeMod@yRazF~Sd{@pXkTiG{uAqRcJyM`XcVikAPkp@zYsjAjRahBfp@qZ~Xaj@jK~XpGin@eCk_Byl@s{@?eyArVipAuUyuAlLimBkKvCoFr[eLgLig@~eBur@p~@wx@|TwFkDgH`Ie`@e_@}Heh@iEz|@we@ftAkIpsCqGux@lDw_CoHw\\\\uPyQie@tEyM{Gca@mgAkXsYlQ{~AgBi`AgSsu@eP~LkJvlKv]doArCelA|EhMeHtkDpOyMfJmj@sP|aB{f@nz@qLn@wp@ol@ez@gdB{Boa@aj@saBkBi|DzMqmCuIyyH{Xi{DyQeb@_KmmBqGjnBgTsa@uCtCkOaR_Mg@sJlLg}@pkEsNhB}Shn@{N{EqBgqAbSytIlMghAbj@mcBll@uhLcImaEvPmuIuEqvFzSwhClN}sJtSmdDb`@qlOfd@k}DnOs_GzYsqDvNwl@t\\\\mfFtMve@ySdqTxIfpEi]nn@iChl@qJhTvKvo@yOjb@}Exx@xGtx@f^bxAd|AdtB_j@lnB`@pp@jNfb@oEh~@~Sov@bMgId\\\\__CGwqBac@i~AwDi{BwL{hAe]yic@tXvUzYdhApdBdnDrp@f|ClpAphDQlSiRe@s^yb@mPvFqNeI{VdEsGyWzAgm@mv@cuCcPrcA{In@f@j`BlG~RjTuJr_@~{@sQvSqOcPsPxKoCtx@{KlE{Kwi@yHbP{VntBbGfp@tTstAr\\\\xOdr@|~@r\\\\a`@zAihAtg@wOjTvF|Jhc@jA`pB|ThsCyUxl@c\\\\fqCkO|^r[fH~KkJ~j@_vCzQdKvFdz@vQll@kF|~@|EfXlPmTxEfKzb@|CqOud@\\\\aSjJsNfCtCeL{m@dLo_AeFkj@~Nsr@}p@}rC~E_cBc\\\\mwBgE_fAmTcjAwc@y~@qOwaAySoJeRkt@q`A}|AsFy^qgCmnGeNyw@|BasAeI{_A|GmoCgGcr@lPoxKdd@y~Ij_A_sKfP}eApjAqlCvo@ggAhPqCn[z`@hn@`aEiDxo@agArjCmCjW`Mpr@fCeXh]pgAba@ve@zApYm[biDbV{b@|Io{AvMjQlR~AxC|uArKhWtC}lBcUq|@na@ibLwL_pB~PaS~[}gA`Mb{@kP{BfDvd@|I`G|ZcOfSj{@vT?m`@wzCmB}bBpEm^fCxKjBuK}Jqw@QkdBdAma@nNiUb[|JpY~{@db@hWth@x}Dtb@hqB`GkKiFc|@ib@wsAmCkoAy`AezCiAer@fV`p@nc@nTxKlRmv@}nCcP}`CxPwdAdSixEjMw|@hu@amBnGxGtPw[rWsaAda@ur@fV{fAnSwS~Jt@jHtl@sCb{FbH_NlRwrDbg@cvCnSwvD|KsZjUdDaZcl@?wjFkMmgEgAkIoFpFyDoSMogDzLu[uSueDik@w_Ek{@sfWfIkYrXbPnP_U{YkIa\\\\ic@aTmnEhN{e_@bPa{Fzh@ulFhFk_BdvAk}L|aBiyG|v@__BhdBspBlcAij@z[qFzNjJz[~y@vf@ta@{Ij|BlFr]nPobArCwhAhZpf@xe@iFhRv~@z^`j@pK|@r_@gm@?xSvRsk@b[~RmP}x@bu@g_EXgKuO|\\\\fEwfAtJ_b@r[eCsSkzBp_@wnEgFsh@jj@cx@dVr\\\\~o@rVzfBm~@|]vJ~^uTbSr@vb@tl@lUc{@`OsmC~Vg_AtMyNbWhLdk@fz@|XxKjWbd@p}@bErWhf@t\\\\zW|Z~m@kA~iDnNkLpF_YkCclC{Oou@m`AqkAaUow@u_@lb@yU_QypAk~C}bApHye@|m@mR~@kRvRoPmIqWlh@ch@{f@uZx\\\\qGui@wT|\\\\g]{eBgi@{~@aE}g@eQwa@dCekAoM_q@{LxEsEwHmYwfBmC`NuI}IW_cAsPoQyLPzNoq@aBidC`Pe{AqR}}CcUuwAJ{uAjSukBfAyaAmEstBsp@w}C`Hwq@`TmPzYc~@re@g}Bj`@_vFcR_oGf@g|FzVshDpTuoAoEoyIxJkf@xJ~G?weCs{By_K{n@{eBaA}nAb`@ctAjGuyA|Nz`AdQd_@|t@ivDvMoTpr@pGdr@d]dZkXxd@{EvS{ZnLes@tC}qD|NiwBrr@s{DzC{}AdL}h@b`@i]ptAgHxXaK`Xwj@pPo~@y^odMlPgxAf_@aqAwZln@mJ`GoGaPgCr`AqGpJiJsDea@}qBvAuOnPpCzf@en@u`@tKoTgCyQhT_`@wdCef@at@rPkk@gIecAuEjqA_w@r\\\\wO`q@gMbA_XwSyj@nIuY~`@wZzcAyg@lIgKzZeg@dK{]ne@uFzz@jM~jCmBdaA_QhK_n@ik@qn@c`FyW`Ywt@kEa]nV{i@_IoM`EeP}MsTmAyKx|AgLcO}Cu]`OwoAdUajGvC{lD`q@{fLnL{vK{D}o@jJ{g@jTyCrN{e@lc@efEpOqb@lIha@rJkI_H{hCwV}d@mXzn@kYb|A~R~v@kPcFaPm_AgC{pBpe@uuCrC`PdPof@Q{WwKjT_FyZlW{vAiTgn@sCuh@Em`D|XqyMkU}fKtEyD`H|xBhu@ecH}FmzAsW{GaVfc@y@|~C_Nze@iDgwE|m@iyAvIiEp@nWhFjF`GwEtKubDpp@coCnc@kjGOgyBn\\\\aqDvJcXyCdYbHhS`GudB~u@qrGoJ{u@iJbMuC_MJug@vk@y}FlLyVlTwpBhT{m@tAjiBmZfd@Q|tA}IjTdh@rFfKeKxGal@{GqgAfH{lAgH{q@`OcxBxkDsrLaH`dAmk@hjByRboAf]a_Ah]}X`Pgr@zCelCvmkA?tEx_AhV|gAtg@xh@npAfqCdF|mCpJfp@ho@baDpm@b_Bz]xyBVp\\\\sKzPoVwpCiTeVuIbv@xKh}@xKxyE{Eua@eKnSAt`BrIdaAcUf_@aGr{DqBpMsLqXqXd}A_HeF}IhHqH||@}Ga_Aip@xc@kItxBgr@zi@oa@npC~BllAzZz~Boc@ruBAfVdOkG`ExMOng@xIkQiAsbAdTca@lCy\\\\q[u`GnU{SdGie@xr@ehB~Q}MxKdLxN{l@xHo@d^taDij@j_BcObaDf[pw@jFl}@{PzDwKhQqi@faCv@no@oVzg@rVxw@~p@lu@b_@t~AoJz}AzU~iDcFh]zH`a@mCzyAvv@ptDeAz_EvLko@kE{|Cgm@w{CkE}s@zGajBgW_bErL{h@mC{`@_m@cdC}h@}p@eAso@`[ynApaAsaAdc@{aAlZr{@pJdyAfKdVnVfR~XpG`CmGgPkCs^mv@{G_bBic@uv@tJ_mAx[ibAfb@a`@mOwn@e[cUyi@}pAsHwqBka@shBvG}~Afr@tWxSy@z_Ary@hb@eOpk@kh@dgAefCx^sxAjZusBzA{qBg]fcAy]wwAq^gh@ol@lg@tHhTaQcI~yB}{Bzo@qXhpAk{AkUh`AjUrs@hTxI|GscApGrcAr@c}A~MkaAmIwKuVjIv_Aoa@rXpCpiAu]hfA}JmPxU_OjfAdBnKvYyd@tKos@zKhx@c[`_@yEzc@hCtNbBoOfJlBhJja@cPlFsCn`Af]vz@jIwDzYu_Aab@wtAhDc}BfWaJfEbFbD}IxUhHnItb@f]fi@~Nu_Aw]cvAs_Ars@iZeBgGaJ~_AcWbiBuuBwr@haBvGtXxWk@xNccAnRaGbGelAtYio@U_~@zo@a_B~PwOdg@yA|FxGkBxx@zT`f@`KdCt}@km@lLu_BjUsj@|^sEzKwSvk@kNt\\\\p^zSwAjD~oA~i@jMnXhe@pEd|BzMlS|MdA`RcVlVdH~VuHda@~n@kWlW{Wd{@gk@nwGe`AvjGkT|lFgClhF{BmTaP~m@kl@ui@ac@~GeLkNeAit@qLjBcM}S~ChmAdm@pq@vK}Gv@`o@sU~d@yRuNkJhBam@j`BtCpIfi@kM`OmZlm@ndAlL|@lv@kkAqA{eCpJrO`KfkC`_@d~DwGdOmPqX`Cxe@vFfObMJrGtXpFeA\\\\wZdo@lzCvo@hfArmArrKvgAlkE{\\\\ov@|F~n@wc@td@l_AlCjZxn@xm@r_F|Qf^aP{WsXb_Ct|@knArN~Ud\\\\d|BaCdpAwJyKkDbPzTni@`GfpAcI_WkPra@z@rg@pGgGrEll@vKoC~K}WqAw[xJhi@f\\\\|\\\\lEzh@~`@liAgYz@eQzoAsSjl@~Apa@laAdk@n`@wLfTfHsBb~@pGp]jPbXlPiJpOnMpi@laBxAvZeMdr@eQlSiCba@eL`JdAdk@xVfdAnc@wqBlc@}a@pUzd@kb@tlCp]c~@lMf@`H|h@pb@b`@lArl@cXftBfI|\\\\ti@c{@vK~{@wKxy@nPuKnIyvA|OcToEu_@|NlGvYt_AiCdpAyq@zpC~l@w}Ah_@_XlRrKhAxg@r`@nRpOdVlBdXmTzIk\\\\kHkIb\\\\pTDjDjIpa@}K~BrF`IvlAkLlp@`Vrr@jIbp@iCz\\\\pSgs@hQqFpGbpApXzv@qXaa@w`@|_@j@xJri@yErIpPba@dCrs@pwBz@jlArT|z@vDd|A{Ind@rExH`JuHv@sd@vLhMbOo@~Lpl@`Bto@aPj_@?xk@zHmEPaa@h[qVlFdKrHo`@hQ_EnQ~YtQzgAhw@b[nfClb@f[tsAw\\\\jzAkL`JuQ}IaPve@{F`~@okAzaAgQbBgDuJcGaeAcJda@bCzr@cS`r@_l@r\\\\m_@?sI~Gs_@~lAcr@f}DoIfI?elAi`@rt@aDlk@~O?lJ~NoAbb@lKeTo@jdGrId|@gXhiAaAb|CpNxqB`e@t`DoYnj@nJdJtVsL~^hoB`@`ZcN~i@pXtIdClQu^|{@tg@uVqExx@tMqNvVlfAkMhn@sLjUuMfBwAp^`}@oMzP||AlKeU`L\\\\fWxy@dqExhHh`@pgA|@vsAer@dCub@yXgn@a@{o@mQco@ycAcPo@ie@pQi[gJmg@dZtFgYsDyVec@m^iCdGiMyY}JdKvP`_@}Gti@b[duCfc@xv@nIiIiEmWqe@a~@qKeqA|SrbBxc@jt@hMndAjw@vrBhS~aCsWdIjRhGlRiGdb@nzA`Zhj@c@rZyQtNsXyRXjQxa@~p@{Lzr@lf@oNtAmKmMoKfC_h@l_@yKpXwe@|Il^tmAt{A|G~r@|[hx@k@tRmMdLwPhGmKqGajA|s@epAfdCL`o@xTxTgG`b@z_@uHpEkSfTnQhJbqA~Rbw@hWnDd]yq@_L~mAiS|LwDu\\\\oq@ggAxBxz@wZjx@lEhdBeLqKyF|X|G`Vc@bhBnYaEtQ}Zr@gWcOiZxBsPdy@x`AmCf_Bto@|jBdGgIpl@fIvcAuPvZ~i@Edm@dQ~h@a@}Q|`@vWna@_bAtWcZdlArDxPzIdLr^iF|GiVac@wFb@bDj~AjK``@fVpGrX{CnY}xAtj@aZl\\\\~{@kGlJkO}QsM`F}Mh|@oLwCiKxgA|O`Hf@~d@`KmAfe@er@|ZicAtWxDne@|uBug@{XmCpQdx@`m@qAfr@vI~b@hJqBrD{R}O{k@jYc@ddAljDvh@pbAhzCbrCx{BzkAfiAp`@bbBgS|[gb@~d@{qA`Ve`BFkt@nKwEln@zdAah@qgBerAbRw]cWwSccBpXcn@rEmt@lq@s~A~MkeCj`AcdAbWju@`n@GnSjp@f~@tv@pZ|fB~Sbn@`VpS`x@zMvk@{JvV|Dxy@gm@|oA|j@hbBxBjX}^vJ{qAqVi~@tGwb@oByeApFfGpSga@jg@dYzQm@pQmV~RtRhj@}R|EzPtoAk_AxZfIn{@gQlm@bzChp@lg@xLf@yFfjA}VfcAq_@`i@wX~Hqk@t}@yCvq@uYy|Akn@e\\\\kFiiAiMci@eNoAgSeb@sCgc@ea@ec@wIpH_A|b@mInMrAzQhTrRl^|HpMlSy`@`iBqAxb@tZfa@~Fla@sGhe@lb@`|@xG~FpZimAvb@gh@nOj{@vNxCzu@hwEnd@ht@vMjIjM}U~[vc@`[WnUn^pj@|\\\\tRuAbx@|s@|y@tf@l`@bGvnBfI~yByg@z}@se@nZit@xo@gu@bSs@pe@{~@|F]vNr[dg@sKrTpr@pf@xi@~k@xbBdk@xf@nEjQeIfu@fUdjEkPlm@a`@fPqO|c@pOz`DfUd}A~`BngE`pAlqBnGvn@bd@lbAzZt_@vSbEngAzv@rrBzb@tb@^h[cNvTh]|Nia@bZ}XmKqA{a@r[aC{QlPgPvm@aW|l@lDvLjFOxP{d@nz@lKfTlHgNhKvJdCgf@tm@gIpCkd@na@tCvBq\\\\vLzBrXba@t`@ca@qQjCgLmJFeVzgBsXgJzYj@x_@mS|Rac@xbByIdHmL_CiXse@lZfz@iJ`|@hPv_Bfi@b{@mFtw@fWjnAhc@hcBrrBbwDwq@`^uLeHai@hr@cCl_@dZt~@jSfMvDiWbVpOvOcDxGgz@uTx\\\\oCuMnHw~@pSiWfLpXhL{L~StUwGr\\\\~XyKlIzg@tK|A`Jsn@~OqPjL^hBpOxWbChOdg@sVnp@|XaPzMfL|J}StAgf@t`@xAjH|HnG_PbPti@lP?xKpXyKgr@lFeXbQkP~Spi@b[ea@b[vi@?xe@nN}EtIf]dKfA~LiXda@ha@Md`@tb@sFjW~Zth@IngAj`B`UrFj^``AvVtH~h@nq@lQfBpDfd@~o@`z@wB|[|MnaA~Wnj@zm@zf@zKiHbQtFnGfQcAfc@nL~Unx@lv@zgClpFrqAbaBbM`^uJjFs~@y_Aoy@FaEj`@eR|Ceo@qLif@zp@cLnz@aSpMsdApAoXtVsaEgKcqBnHm`Df\\\\{tBnh@un@jj@i]fLpWmv@`Z[qNs\\\\yWyOoN`gAwe@~g@wqEp_BurOx`BiyBzj@{v@fGg}EpxAeuE|v@we@wBsf@pTirFvc@_|AoH{_Cgb@ioD}Qsl@oR_[e@oY}MwVo_@oClK_KK_QeMol@aHaZcR}mE}sAoxAe~@yh@qo@uGm]fp@dx@|^vJ{c@kc@w^ut@yPf@yy@u~Col@ej@_i@kB{KeI}AgLlP}RdE}^fPePwjFmeEkjAvMeeAj\\\\oo@bdA~Blk@kZo_@is@yoBn_@mj@rTivBuEw|AeMwo@il@uu@um@iQ_`@sh@eLnGFes@pPih@jGqaBoYolDhl@_fA_HsCyUfa@aLyAsGph@goCgiG{yAseF}l@qjAgK{Cb@qR|Vg`@gKtA{Kf[gT{B_tA}`CsJy~@mKhCkr@eeAgPuEmb@obA_n@uNe`@nMcb@}e@aQeFqrC}_G{PakA{y@{cAch@gKsRoReJ{fAeNya@qMynBsrAyvB}Eid@ei@ky@aXsx@wm@w`@aV{p@g^s]cUih@uL{jA}eA{vC}SmW_TiFcZ~NnEhQafAm[et@slCqUsXcMuyBsd@a}@jEilAaUcoAcg@cgAuLyLyKj@uCiPeKm`AnNoo@wE_NgF|K}Ayi@sMiMH}pAjBaLfk@l^fCcKaA_Kiw@_e@gI{XqV_oBnGws@eP__@cUqMoKbXof@fyCi[}k@sF{c@gMeGiMvH_Xs^ow@qeDah@iJo[uo@cOrBye@dk@oJh`AxW`l@yQbNiU_D_~@eu@uh@mKkLug@jDua@aNic@a_AmMub@zNi_@u\\\\c~@xIgPaT_i@vO{Vo\\\\mWjLkOuKwi@]_h@wgA}NalB__@kV_Dk\\\\_WkUyDm|B|^maCnOqlDtd@uk@_@aKeY`Qqf@fmBgLaGqHucD~L{`CyH{a@kPvxD`@hwC}^ppBuLrdBwfAzgBeIj}A]dl@tQlh@`Bf}Cxk@tzAqKr]l@tz@lGjuAfZz|AgRzn@dP`FnOhjA|GxIhY{JdJlNvHvn@bGoD\\\\mc@lSzB~D}l@`Uqi@tFXdIztAdJdCbDiIjFve@h\\\\zu@nl@kIhXmYfLx]pFmAjGav@bQh[p]xPxLr^lFeFtJdGjHpXhAuV|JpBr|@`r@ra@ihB`W{zBtFjLdUyAdLbiA`O}LyJlg@xGj`@dRrU|GyRfMyAtRfz@uKfUyTxGaDhd@_QQzBr]{GE{S}r@{NxFaSlx@p@vl@v[hxAtLoDdGsfAzQ}PuEd_A}\\\\jrAqM|jA~d@rh@s@rc@|OkUtVjn@gBdUxSmFfFbGlInn@jd@pZD`a@xJmKzZlm@oAj\\\\y`@z^x[Q`PyMrLfM}Uxr@la@fBte@``ChQuCjYnUmF|z@bNxEfKf]rHqYrHjDrg@r{Ab@jt@pJy@hLrSrL]~r@h_CbEnf@nWdGrFxQrTlNxc@tJzC~RfQ`BoYn`AtCdObVrNsN~n@~Xpv@mCld@{UkSaClFpObd@dLkEzYbN|Jzi@|QnCfR|z@h@tRcOlJeWwMgL|F}@kl@_S`JoIyY}EzEcE_JoVEy_@~q@_Ly\\\\dLmUg^rFkR_XgBon@oTnAaWsk@kLvImRyUkCfl@hN~w@eKbp@nShpAdYzl@u@`SxNzHIxg@dOkGwHf~@oEkRcVg@eq@_uAyF|HwT}\\\\kn@|UyRf[oGwVkI`GwHfUb@r_@tNnSaEl\\\\uMyGeGuVoEtDuAiw@|Kma@eFmS}CwCqLry@sN}e@mWpCQqu@iI^sT_b@oMvJsBd`@mX{o@sFe`AkYgi@eLdTmR_TwJrNkKg`@uLsBcIhFsC~\\\\om@y_B}PdHoKhVeJ}ViXbQ}DoKaUh[oPDbOp`A`W~c@jTbxAru@dl@n`@vt@qI~m@vLdJe@je@{JpJjUEl@pa@xQdn@sLbc@cOxCsTsPm^bAqReQw@`UpMrAfAjX`Q|Ca`@|jAgEfBuCyX}JmE_MhD}IhTyMhvC|LhjChUd^fS_DfBzHyDlsD`SlPjDfUiShr@iAdxArc@|w@mAbQfVld@o\\\\`rDuEf_DbEnl@tUnk@jR~UjQaDyCnbApYbnCv|@z_AjUtmA}Efn@|El_@lAiMtGxLlm@tWh`@|CrYmN|_@zPjNwRt`@eAwFpbAvd@bkB~zAtoDzPv[tPpHaB|L~NbO{DhJvEzKdJmQh[za@tOyB|UdKvXyI~^lC{Njs@dm@r`Ay@|ZhSdcAzS|QhEj]rOzIlLeBtJd]_HvSbBfc@dXh}@pl@j_AnZvrBxz@tuAuRgK|GdQ|SrE~OhSxObj@dmB~pBdp@xL{OfDua@kKqg@mg@Kp_@rRz_AtOTzi@od@`M`Bn]gOrI|GpRhn@e]wTzA|}AxLld@nt@hBn`@iGh`BevAq]pr@qO~DuG`b@dVdNhZOtLvVpNxDaAzW`RpTr_@sf@~GubBq\\\\_b@mU{@wDk[xPwCxUvQt]i\\\\pEuv@wFaLke@~}@ye@`JMqE~SimAwQkw@`l@uuAsAwc@dHkUaUit@hKo_@xWiDvXem@bImj@bUyY|GdI`N_MtDjc@~c@wWvHv\\\\r[yZ}HnuAlJ|O{Jrx@c@|kAxVrP|MkKPzG}GveAea@~_A_J|k@n@vf@lK|XjAzf@vq@sN{CpIhSte@sH`u@h]xxAkTxxAit@j_A_GvcAkSoQmGk[sH_@`L~k@xTj]yYn~AwCvmAxu@~KzApg@`JMu@gh@|ImBrOnJfCnyC`Mbs@pRhJ|Lh[r_AbZeM|r@vIht@qRth@_EpbAkShTmO|w@aHtOcM?qG|XrA|MlMuI|FzGfUbmB~b@zDnR~[tKfw@xAfaClLbz@{HhdDbS||@}BfIuIsLqaArjAkUh@qJv_@gt@vz@uFyGbMofAkPinAw^}y@pDkhCjPybA}J_l@hH}t@gJurB_\\\\kgAgBs{@_KnEoj@llBlRpaBuVno@uAlgBiHdCePuY{Wtg@_YnEuPhQmZft@sBfg@oSpNeMne@_BoRyC`E}Ofa@~@tNd[p]sMz`DlKv`B`GjFbH}DdGgTjPrPfC{OjM{AnG~HpHdzAuCzW_OmTyJnDwAtbDe]mfDqNu@`LbkB_I`t@oHo@mDp^yRdF_Tf}AcCluAw\\\\~dBcc@f}FrLxbB{Idc@oJ~AnGh]dTk_@ns@pxCyFh_@lEhXvP]~Aq\\\\zIaHdShM|TcjAza@wEeN|{@vXjzAoJ`HjAne@qTp_@uQwHmHcS_CfYtq@b_At^nMx_@uH`B}o@{Xc_@vB_Eb[pGq@wKmWkTpKgm@`Ks@fWvc@xKbAtMeT~WpQaZ`y@hBrYvT|EIp]aMzE{BlY`N|z@}Kd~AchAjZoQ|kBdSthFlSnb@j\\\\ec@`PdLnCju@uWnr@k@rg@|EbOta@`KsMtbAiYnf@eQj_ADpXxJxRi^lxA`@`yB{K`wAyF|LgQjAuWnuBt@dg@tc@hjAlr@uBjX~FdKeLjL|GtNqZnS`XkN~hArFh\\\\jMtLcB|UdUn]wAhm@eQqGoXbc@qP|aAc_@yJcf@c_AcZiQgJvJdHfi@wGpk@v]vDa[tgAmNdJ`HlMfQdyDqHbmA~VlmCgFrsC}G}X?nvBzKyq@?ztC}Btv@?sr@eL|i@dHp|@}IluE_JhTb`Al_GsBnt@wUt{@uKhzAhK~NrCyKznAdhJl~@rfEpnAlyCjpCzpCzzAzzB~vAnpExKhf@w@bq@vn@t|BtdAteBbq@vl@r`Dp{Axu@jMfc@t|AjWbZgHxLn@rb@l\\\\xtBjd@l}@riBvyAuDno@hNdx@fV|b@`k@pX~e@x_A{E`WbRhf@bBjjA~g@znAzh@|Y}GhU`Kpb@`o@pEjWjVyFjp@zNvJjOfj@h@hb@lS~a@tMfKnz@hNfYsKjChJgIrK_h@_CrKrz@dMsFht@bk@nWbaArVtP`WZmDlQhHdHvBli@fJvJ|b@fHfLmE|T_^rB}r@bYlTyOvd@hGpy@d^xHvPfvAvrAxp@zGhc@v_@f`@bMIjKv}Ap`@xk@|Gjb@zI`Jhc@vDbPfOqMhd@vCdUp[hc@nPbr@xi@bcAfO}@N~j@bLlj@fdAjtAxG|~@~Q|\\\\oC~b@vYnUnH`}@bp@ndAve@`F`EjWtd@p]dF`YnNhI|l@xoBhk@beAp@hc@~a@h`AuBjUjg@z|BlPpG`]rgAbu@viAxTlUtVlEf}@ix@xK`LoGk{@n]ybEfIiOr^oJnPg_@cEsnC}WulCvNclE_Puf@af@_g@`FeHxUjJdMaMDgWwUsa@`A}\\\\jLrDtT_j@|m@jn@nPiTfHvMvXgMdDhTcG`]nMnKlSkLxHxCx]ui@xJnKCj[tGx@vT{e@|Zf_@|Zg_@lp@p[fIeFd_@~w@`S{@f[pS`nA}NbUqw@qHc\\\\gXuKkJkb@yAujAfZav@wByTgVyYkrBw`AaH~JzBtfAsWvJ}e@~n@ye@qgAgK{r@yZwWoJ{n@gJiJyDkb@vKqz@?k{@ySwi@|DaN|N~DjbBviCj_@tKwA`T}TqEmLzM`ProApX|GnPqOxFyhApq@e~AeFq[`NmsAaNex@ti@pv@lLhz@|FzPzMtEhCbRtf@~YnZd@|Pxf@fPtFtBbxAd]hi@zHpd@bTsEjVyaA}KkuEyUauC}f@soA}Li_Aah@yrA{iBkr@cw@scCxLyB|ThKdf@}M|^rDbyAt|B~M|Nb`@bDlXz|@~SMrXnRsS_j@|Ew_Bq^kv@wgB_oAiu@sXmb@|Gk}@yo@gX_Fuv@|A~O~~@cr@}d@sX?kYgL{MrFiRvw@wJiz@hf@myB`f@}dFiBmj@obAkvFtIoU~\\\\lDvMcLlPii@cGyTbFfEfGmF|_@yq@fh@itC|Z|GzE{DjRmz@nMdDhb@`k@vLiBbp@c}@jf@cfBpa@iJfS{XdKuj@zVwe@vaAmoFwKr_AzB|ZxF|HbXx@qFig@dW_XpIsv@~IdC~c@wdArFknBb]zDtTdX|@kh@fMfFx[{K|N|b@dS`O`JcH_HddAnV`i@|G_n@f]nl@?rb@qm@ffBgHzk@aYhTgDtpA|HpMhl@}hBhCwb@ju@mSvJiP\\\\}^dXoV~TagAcAgf@vm@fd@hYij@rn@w[dq@hUx_@l[ho@jhAhOqEbl@kc@zO}k@|Q}KxNgk@rYu_@|AeuApc@{HxFfBaMpb@`S|w@lTv\\\\pFrFhEgLve@je@zh@_gAfQyCf[zyAjb@|p@ll@lRbl@ld@fKvjAbUll@`pAtGpGjG?vg@jOdMlVwJqFeo@rb@zLzPrZfDllAvLbKb_@aPnVda@hN{Kli@rFjWeV|d@m@vM|Xjd@gDjK`m@hh@xj@vOcJ`RjB`c@le@f]xIdr@e_@dEjRdo@bYvs@ol@lOjA`n@mTv]`Rvc@yLbt@vGln@oo@oPqt@hM{Ar`AjaCvs@rUdIhRbj@aZrPyn@zKoCvg@tTrgAi`@b]vWjUx@bk@~f@~mAtJhCh}@~GaHg@{Wt[xZjRyAsCsO|R~IzQ_Jx\\\\tKtCne@zKuXzKwCvSvlA^i[pFeE|MfO?}TxEj@?kKkHxAuPoN{FqVdLeFtCcPpr@p[ze@n|@jq@pXhhA~Obj@yC`GlLtV{Kxi@vHnf@p`Adk@fVjMfs@fhA~qBhiAbgA`bAjyAxb@~gAtgAnoAhs@b^fXzBfYfa@lmApt@nz@jPtVh\\\\p[bLtFfp@`kD|kEtv@|]rm@hFft@jx@|gA|d@tj@sCp[lF~y@zwA~eBzv@le@zF~k@nh@b]~v@jTzBvnAp`Br}AbdAv`A|zA|g@xnAvzCr|CdKpe@fdB~hDht@fR`j@|v@?~Otw@t`Ah`@|AlKnVhQ~I~J~\\\\|XdCxF|IcItk@jRlGpGhT~UuL~G~`@vXlWfr@pCvDfP~f@~Tb\\\\hiA{B|\\\\xKnGhTb{@fWv^vSy@hWjLhSvSlLvg@zVbIpQp]t_@kAto@hUpTtr@lr@hQoPf]pa@??cLfIjAw@wVnhAby@jm@~|Ae[zWtMj`@xPi]lVfCt`Att@lRe@p]ffBnMlJjb@ljAfg@zg@|Ebi@~m@hgAsB|O`o@`pAxo@|rCps@ft@~Lxc@rw@vkAiHnu@r_@ncA~P|FuUp[?zo@tOdL?|\\\\fa@b`A|C~jAdKfe@va@xp@{EhrAoMpPwKiY`B}ViFk@ca@rr@_H{GcD|KbFxv@p_@~FrHdMeDt]oTfNqQFmFbTuYgIsSlEtT`r@pGoGHvn@vJrXkRbi@a`@zCgJl|@xKlEdLd]rX}G|Gj]c[zMmrAoG}KjEcKsGoLrGkDmQkOj@oGgLiCpGiT_AnAcPsKwNk\\\\fm@cQyVwJoEmFnEeLfX~BzHhTvCl]pa@n]tpAhg@nh@h\\\\h}@_IbZwTkTvTne@iHvDu{@se@uXet@y\\\\a]wPmCmEbLn_@jWp@vMmLaEsGhL}Fle@fGx]yFnKgH{FiBlF|[~e@nh@rGtHjP|MwXjFvSsPnU|Sx\\\\lFkKiJmPb^rQhu@t~@fYg@cGp\\\\xDjJ`c@bAu@z~@dRxCjGiNdPlE{Esc@~E}FhY~x@nb@xO{Jl_@~HzOdk@|U|NeOxKxVb\\\\jMtNqSbBhLfQeCaLx_@oQqGqFr`@e^bCUbHlPtJEpx@tXv`AsTjm@wAgm@rLwL_@{KuT_JMtdBuKc[wN`CjJbq@yJhNlU`g@`LqAhPal@tUbA_J}Zdj@u|@|CtQr\\\\tApEp_@dgBzr@{NnXeq@rImRnc@q|@jb@_Wq`@a`@zF_b@ni@Bp}@}J`NeWePer@aoBoCw{AyXsTaAoN`Kue@TctAw\\\\uRyGmp@{r@|P_WoAsv@aaAqq@hHag@{i@_p@kEoUsSkk@bC_r@~eA{a@qRiwBpLsnAk\\\\mh@rEyX}EyVe\\\\kgKd{Bqg@vZ}Pb^{]|XmIaA}Kcx@c\\\\wOqEqc@`Ioh@cg@s~A|Be`AgoAqlBgTiGqXvIeTcMoIsz@{v@ye@qPmS_B}Tlo@me@hO{`@flBk_PpR{q@Sc[ip@{qAw\\\\wPav@pQiZwAwqAqRkm@c\\\\qp@{xBCeWgYyGwFa^eLzKkNwTiGhAkM~Z_KmPwWwGiEqO}XhT_d@eVuDuVpF_Tod@yRb@iXyoAue@wo@jSwIo`@qa@y_@zI{UuOgRcGnB_Mo\\\\yYlD}E{L{GfKrCvLeKzE_Scf@eK}CkU~SiJt[gOjDmc@y[{ZsEg_@mt@{WwO{aAuQu\\\\nPsu@y[mm@_Cyv@ka@eHql@iS{Qoa@lVgSsC{_@|Yoj@mQgPme@sg@ki@}Jy~@rDoj@sXel@_p@ci@{Voc@ee@kEse@yp@}Qv@as@hy@_f@tJoWxj@kJoG{g@dYi_@{Omm@k{@us@wAgZqRwLbbA}k@|i@zIpk@{X`t@x`@vlEh]vw@fPxmCde@hi@bOvn@x^dTdJdUuBhjCmNro@~JhqAf^~c@xQnx@pw@hsAn@jRuNv_@dD|\\\\`KzGf^wClu@fx@ba@j@`S|]cQdwB|E~q@bUtv@}CdtA|QrdA{PzbCtTh}@od@tz@iKxh@~RfoClSjaAMjd@uO~o@yG|Mc_A@yf@tTgoBdpCy_@`FeKaFq`@yo@ca@eLg^i^wWd@cMpYoa@tUim@yHkG|YnI|v@{o@zB{a@pw@a~CkyAxIjrAkE|`@gPhLgB~N~R~uBiJt\\\\yqAfmCsEx\\\\sGdGoYqCaPnp@gc@?yPh}@ap@bReIrbBkRhKqf@vAyUl\\\\iAt~@la@lB|PbS_^|kAfY`_A_Gh]yp@|i@_WjiAnDliAwRvBkQk[wSk@}[bpApU~YeGjo@rDzgBrRdWwE`o@jKnj@yC~pAib@~|@xLn\\\\kAfh@in@l}Acp@vMmPhUgM~`A_a@~R{o@aPu^vWw_@xCk`Aes@ap@wKew@nrCoQ|Me`@oPcTBy^lj@mv@kn@mMm@g^lMs\\\\ja@kn@^gVth@agAvn@aj@~dAcx@x}@eyArOiv@kl@cPnBtCnl@nGxGrRkBjK~KB~MoV`Ve^gJkMak@eQmF}H`KoFbw@aXvCs]qViOjMoYppAad@g\\\\a^fImMmJkSpo@k_DjaCcTkPwEq\\\\av@_YyLnNm\\\\`eCsPxAq\\\\mnAqXflA}d@{T_u@tm@kAhn@eh@oFwd@|oAzOfa@oKfVg[~IkL_EyOst@o\\\\|ScPcj@aL`IkCzj@zIpXws@lcAoWhAiJlOrLhvBqT_HyOyc@cUqGaY`s@eW~PhKp}@uX~Z{kAdyEqBp`@tO~lBvRpJrUsI`EhOo\\\\|i@~Bvq@_[|RoH|UaBl}AgS~gAoOvXgp@t]cNdX}@hk@dOvd@}`@f`Ac]f\\\\{WfjAmHv{Ag`@tu@v]fl@uB|LyKmPuLtSl@fKfYhGaZfwA_Cxu@da@z|@rBl}AzSfxAvDxqFpGnj@c[~s@xOp{AvWlu@UjwBp[~yCla@poAzB|iBh\\\\|tAiGz{AbEzoBlJd]tOh@j^rcA|u@`}@nv@xeB~wAhiBv`AnyChi@mKhTtK|SWbUo[|Bk{@cPet@mPqwBn{@wgDl_A~Stx@bg@x\\\\}Ppe@o_AxYvAxU_RrI~FrM}C`j@dr@|i@iDbj@jc@pBxq@|LvNpC~\\\\dUtPbg@|yAbbA`_BlDtg@tf@jZrZjAvg@hn@~ZmBxZrQpG~b@h`@wK~a@`{@dRpGpPuDP~_@~HpA`PaPpG`PvHmChk@fNrD~Q~MB~LzOtVhp@zl@|t@vVgEhR`RnZ{Ifa@jJn_@t`@dG|^fQfJfZ`q@dz@tYpLqGhV`L~Q`VhAl_@xJxI|HgAfCqWpGfBpHipBvQqDpTxRtrAkE`^jPno@hbAxP~gApCh~@uFrh@`W|pB]t{@xd@|kAhc@tZ~H`s@`T|Ktb@vxA|\\\\pXdsAdwDzSnKHzfAo~@tqDaDnhCq^`yBooAppEajAxzAox@r~ErHneBjjAxqE{HhuAp@~uBkq@~mBcaAvzAwh@fh@mx@fwCoHxy@zItsByYtsB|Kzt@fl@nyApMjr@zp@`lAh@|{@tc@zjBdgCbwFrfAnz@bx@tOlK|eAtm@jiBhEfn@{TfhA{CjdAjkA|sGtHveFd`@tpBkLzvAdYrg@nRhsArs@bkBpj@jqCwCznAy]d~Ao\\\\`q@lg@h_AlHhu@ah@hbFgWvv@rEtcCgGbY_VxMt@tWaUxlAcDxmAvJvnAaL`_AjLftFccA~}Ba{@p|@sLjpA}\\\\pw@wHnEuVqE}Gfv@en@wPeaAbOy[cMoUxBoEruAoPbp@sAfcFeT`d@`G|p@qDlSqi@lsAmkAbs@mz@zrBeUjqAQlfCdU|fCuTju@aCrp@sVrIwPv`@eQn_CjClvAca@faEpJ|u@li@hsADr~BfV~dAtAphAhTle@pGz~Bvk@ntAxc@hk@pLvn@lCjr@a[z}Cha@fhAm@th@}c@tdBcBddBc}@|m@qFxk@xBr}B{k@~eBiNnyA_InRgv@|l@gLvX{KdyAoJlNsb@pRiQuA{i@m`@kQtLu^tw@aRrGyr@yUeOdNuXaH}hA~r@{u@eAia@dHyh@ic@gi@diAwFx}@uQdl@dA`q@cVh|@H~p@aOhhAxNbmBmg@zbA{FzlAm{BxyJ_BpzHcJ`v@}pAbaCyEp~AuYppCod@|w@ag@xsC}`@bSsIxTRvXtLfRzq@tIjS|Vbf@vMbdAty@fW~f@~e@`rDhsA~_Axh@oBxXjG}DbcAbPxk@vuA`hAt_A{BboAxc@pt@xf@bRi@rQ_Pry@a|A`FoBvWvYlLbAt}Aaz@n_@}{@vKagA~RkYpc@}Abd@|R`hAjAlhC`aBpX~b@d^rwAb^tn@{V~l@|ZpuBhWeH~QxCxeA|nC`Drq@aNhcAeZtS{Jbf@gm@`v@}O`fDdb@~gAkg@br@|SbaB{d@ddA_^foBtd@pcAyBvM_k@xa@gBlWhDbXf`@lh@rLztAf^`s@ce@fzAj@|lDeJ`]ke@x\\\\eLzc@jOxeEkw@~y@oYzIySl}@wVhVko@zLs_A~Au~A~i@_PnbMmNvfArEzdEiNly@mk@vVes@hFcHjXxAbmAvIjU~g@tNlRjt@oGvVqa@vb@aZpdAeg@bZcJjWrN~c@p_@pCpk@f_@hRns@iJv`DlMz|AnZl{@xo@jo@fOz}@rm@bd@gBf`BfXlpAPrhAh`AnjB`QzeAt`@vGrL|P^laChPj^rFtq@td@rjAhZn}Bto@dN`OvOfNj_ArHd{BjNjZ~d@jDjMuPhRvIxT}m@dJ}EnHjEvn@~aDte@pj@PhSq`@zkAxFbQtMvDb`@kV~MtK`h@nhCaq@xh@k@bXbg@hGtVyH{EjlBu[zp@nObo@yD~bBlLvnBbN|V|l@z[nd@qSdOvAr~@~sB\"],[\"usd`@ot{LjG~i@sy@h}y@oKfk@cj@{lAkwAyfByK?ot@me@wgArX_[qK{QmUfIsb@kEqjBrF{bBiQ_hAyl@w}AsqAutBq~@}~@tFqg@fVvn@vq@|o@h~@zzAvt@`aB|M_PiCo_CvUm{@dF}bAeHgu@`GwTiVevA}LgGq_@~MwtBnU}FmJzDyb@iZkDyX_bAvz@uoAd@g~@hg@}yA`^cwBoH{d@t]eNxE}LfAe^mNwYeI{bAdGsrBgJo`AjJwWh}@_`@`Cgr@tK{UfLx@fNal@f`@kMn`@dFpa@nbAhJk@`OhOnZoC`h@nO|gAX\"],[\"a{c[yxoSqM}Ruj@eE~Lk[kC{t@`bAq|Dm@upAnPg|@vGoPhi@s]lSq`@zAyW}QaOjCcbBfYum@lScLvz@}AtJcLhF}d@`_@_r@kC_^r~EmmEzl@y{AwYarCmpAihA\\\\gNra@hA`_@oqAkBuUue@c\\\\}Os]wAcsAmHuRxHsMdJjD}GaYdLqk@gCgp@hToYqJm^pJ{Q~b@qN|Ir_@eGhs@`RhJbN{BrKh`@pkAvPdc@_\\\\bNnHxMtjBlStMdAhKyXlmBv^`a@nBzyAug@ng@gV~n@wNjgA~Bvs@z^h|@tVtCjKwTfCje@bFjF`ZcBuO|\\\\`Fpf@tZpXhG~d@`bA|l@cB~Y}Xf_@zL`{@lCphB`T~j@t\\\\npBp|@nv@rt@p]?td@vVpPdHvd@xYzTnN`[pZ~eByKpt@d@|pAuTxv@gf@h`@bC~d@wt@xyAmFVqIog@qHwsAqGna@gg@aS_u@`Smg@aZuL_^kd@oEodBtb@uMtNgl@`wA`TptAuMdKsIph@}m@jz@qr@`oBiCviBrXzg@?vk@yKbp@ui@pRy]gZuf@_iA{Dge@}Sc`@j@yj@uSPsTwXseAm_EqFaBiGrL}HgE|Sce@y@_U}}@ofCzF}\\\\}Wcw@`MkW[oSs`@c@mHes@_Q`z@vCbf@aQyOkDfHuOgF_`@xH{LyK\"],[\"kyuf@~rzlBsnkA?hg@qrDxD_rAyFeh@wO~SkIoNfGcV`]{\\\\~Zc`A`AnQ`PwBzHuVdAwzAfLwZxTepDpcB}_FKne@gs@hnBhBfFd]cNjWxC|WkK|SavCvTajAUaw@nd@{lClf@eRnHcX~o@~kAv@{n@cGeSgOsDyD{q@iMo_@^gw@qJiPgwB|uKwK`K`eDwfPgIlcAlAtTdLuCxKjtAdV_nA~EsIzJxF{^gkB`@clAt[{eBwFnt@xDjBxPwWzBlKdPiOgGu}A}GrXv@oSf_Bi~HgPhcBfFkCfNde@pMjErZsnAbJqaAlL_IeCsmEmv@fhEyFp@~}A{}JW|nAp[`i@dQlLdYyv@bDnL|@foCtPfXdAac@xMgHtMcf@jJos@oFw{AfE{w@rXnRnB_lB~F{GoK_ZeQeEqStz@`@xoAea@a]eMbeBsXgbBgEcKuTcEn_Ba|IzFyA`Fb_@dQoLpRs^jHzCbd@wjAmSh}@`WqU~]YzDmI`Dhe@vRtH`Gh~@lNfItKq`C}UetAcYuQmDiNgb@hdAba@g}AdGwHfS\\\\{Aki@`LsXle@a^hP|_AhMaC|C|LzDuFxMhOjIwFfm@dGvSrLbTcHjIbEtNg\\\\|FdEfLoJjFqYxOcKnMow@tIhf@jo@ma@~v@oK`H`KqP|AyCl_@fa@lx@nI`iAlIw@pl@afB}C}aA`j@wcD`Q_CpPiTpHhh@bc@vAvQaE~Hkg@dJg@wBl]dKzJ~F{JtRzNt@scA}Nq]_XmDbB`l@qIcl@gTkd@{E{j@sRgGcIpEn@fd@oPyIbHel@{GkhArOsf@|WnOpWw[~NiAbU`w@bj@jFvIsRqZck@}ZsqAaEvw@uJ`DqD_lAwNyh@oPpGkXmPuRdjAcJeAeJvN{WjvDhG|R{P~a@yA~]ae@gsCoXc{@oeAnAah@jRca@zb@wd@~@mKbsAvD~o@uAvEsS_h@eJ|BkKbx@zNvdC_XkvAnOp|BwBzc@wKsAsHeVbAabOkHo{@_PuCrX}{BfFl]vJ_@dHyQoAsSeQT}HmYlTyeEnEnp@kJ`kAxQ}G|LqfAnGv@~@bN\\\\zlA`R`HzKsJlOfIdO_XxH``@dRO|DqIi@icBaMsa@aJxm@kChHcRa@qDj_@{A_h@_a@sCoMup@t@wEhM|GzSmO`XagDrDygA}Bm^cRC|IudA_QiwAg[psAc[znI{Hq]nC{zBbr@ihM}H_oAmC`HsB{DrPgeA?c_AbL}o@lLqE|Phg@|]cg@na@i~Bz@ql@xOaM~DpZrKdGfXmNfbAgqD`b@qgCdb@aqAf~@}dBdVqoBtSnoAr]bNyGxfApGcPzDwfAkd@ijAgBqgAzXgjA~a@y\\\\vJ~{@gFbsApVwfApDqu@yQq^vMidA{As_@qG{BuC`IsLjdA|Dex@z_@{wChf@ih@ze@fo@`@naAaWnh@lAhw@~T|cBj]ny@hQtuA~a@~rAjDj{@rEdKg@ky@bMiI`j@pa@pXsXvKbGzKcGhD{X|_@`\\\\lG``@dBnp@}M~`CqN`Ne\\\\zyC}a@de@ar@h~C`u@im@pFqk@|Bt`@fJeFx`@g{@lLepBlFsVjWkLqDcYrq@aCpZrf@wFxjAsYxw@lFn}Dx]nxEoGppAyNstDoIvdCe_@xsAjXno@cJvv@iFlA}Mgc@yXvgAaOm^oJnJxFv]wJrFiD`^oMjoDhIxaAsF`j@vP{TSgeB`KfMpQ{DxIevBfNwy@lJqEnUxJ~Wql@zLmBwGtg@nD~YjGyQpNyDvGylAsOiz@oW{\\\\FoPhf@dj@b{@fXbOaJdb@`AnDbe@aTnc@}C`j@dPl|BePtw@fPbTvIuJfN}qAkGoy@zEex@iDoeBhWoU`OjBpKnSgBz_@nVmQ`IwRsKyXlEai@`JaC|NbGjh@lmAlUf`AhRhkB{Cpe@v\\\\hxAxWte@{IhsCzItb@`b@~MvIxr@uAw`A{Zqs@yCe`@bJogArXoa@`Q~Abm@vrD`GcAoJuzAdIc]zu@rkAy@ci@y\\\\ms@h`@gzEfj@aa@dr@_vBAtgBbVn}BwQzjAeZh@cHha@_MVoMn[zDni@tSbpAdMyTqHwwAdI}\\\\bLN~JtOho@~B|Jx_@kVbZAzSbPNj\\\\sPrMzpBs\\\\teBx@`|@aQtEg_@wHaNeb@}a@cZ{Bf]aj@mPwQt^gX~JuNvd@tKp@nv@}f@l\\\\zMtVyEdP~Jd_@|iA`FlpAaq@d|EaRdTmt@|Jgb@jsAqMdbAoUve@sCye@gHfLuAlcBga@`sB}c@wGsS}[gX|HaXh[y@jQpQ`LhUuFrP|RtDhlB{b@|KwBvSzG`JdZg@lVrKhCbj@oPp_E`Ib\\\\eOh[iQe`AoK|HhG~i@oEzZiSbG?j[yZ|w@wJtxAiSlGaPvn@}Vw{@oOpBqFjW_Fa@sUsj@sMqBoNdGaFj]gn@`t@mX}J{_A|[u]psAaFtuAmyAboNfj@jgDd^bf@d]`bAgAp}OwPtmBm^nk@_W_WmY@iIkUqL|@}Wk^mRdGa^xv@`S~m@RtWcX|LgBgH_Elb@_f@lm@ml@jtAsJqTmdAncAna@eoBj@_m@}KsXuTiHeQhQ}NeIW}kAcVwf@kRlSaX}TmGpUkLoC}LfJiXeYqNbHiTs^iHfHdEzY|K|JkDhg@rg@tJjoAtdB|IddAwDbu@ks@psAqDdFyJsCiU{Wyr@rZyNxVlHbThO{Ihs@wDkErhAsNlTcKv}@h]}SzFaa@~_@_\\\\~\\\\yk@bVhThKxb@zGxdD_R~gAu@s}@yG}D{NpdArq@`n@p`Bvd@jnAwe@|VcZzaAgeDrZuoBza@tZlY_e@htA`tCv[_G`K~Tjz@v@xy@xuA]`o@b_@zw@\"],[\"getj@_szlB~rI?`SdmAn\\\\li@|VnvAmA~mI`m@`rEsVbEyxBncBoMkAyVwX_t@ooBeOmL?zKaPob@qh@olDov@y_D}Wed@aKyf@kJ}}B}GpGgHieAuJnI\"],[\"_qij@~rzlBmsI?e@utAyIx]aRcmBxImbFnGi[yZs|C`d@{gLvQosAgCdr@tW{mAoCei@zUywAjKh]pGoPrCpG`Fcj@~w@y|C~JwpAsC}l@lDuH`IvCvRgf@nf@fg@nb@bpAdSppB`ZndAz@pkDnNt_AjSr`J|Qf`AtYbaFqGkKf@xnBs[~eBuGbtB{Ix`@\"],[\"efgYaqooA~TdBtMxREx[cQbq@{BkT}JrLg@sL_PpGpCer@mPpS\"],[\"wnc`@uw~uAzK|CrWwLeEiZmQnIpEsg@yIwGmZvMcYie@kMpG}MgD_EaYdu@kr@bV}Gdb@qn@`nAghAd_@fGtx@ll@`m@tOtDxTxKpGi@mg@fIyIdaBui@woA`n@lI|U|[gItIwSj^mNbBaNnP|KDqUpK{NxKzSbPym@rC~`@lPlPtO{`@wM_]`Y_Rj^wAqBlYxFvFzX{WrVhIuIov@jUiPh{AkZvf@gUveCcVpg@}Sxe@fUd^sFd`BvBz|AjT|gAn_Amc@qGaR~DmVqm@_J`VkdAic@qB`St_Cp~@t`@rXdEkPiIoKbR{E`xBhOqSjUn]dJ|G}Xfd@j@rT_MpPlEJvMfYkIbFeMvW`@~Jg^}U{N~`@kIvr@qa@|\\\\uCeChLxTdUdg@`O|ZyBj\\\\zIbH_JeGuRtFmg@eXyBqZvJs_@}Wfq@eQbb@o`@ffB}OcPz\\\\xKx\\\\~d@uz@f]?tC{KjKvFaCgTuOsA}e@bO~MkOnjDsg@jjDux@|pAaMbxDov@fxDwcC~fA}Xd}Agr@`bA{EnkAax@fp@fCrtBce@|mB}~@vc@gb@|vAawClp@wMnVxKji@mHj[q`@eGpp@oLwAmpAt]wvApoAe_@tj@yKpi@q]bn@ye@vZakAntB{c@beFkMcWaJjzCs\\\\rn@lCpyApKnDbS{p@rIpEjEsFSupExHxwDf`@`zBde@|_Ate@le@do@jTvoAuGh|EjyBhxIp~CjLsFt|@lH`lAwEzlAg\\\\hzBo`BvChTlGeLyAo_@fWex@zsAcuAf`AsO`p@pE~^cVvqAq^rFFMtLjJuNgI{P|EeMlMhMRfe@tNzB|Si_@bl@kV}D{i@|Sk}@eNg]uXlXgR``B}S|KaPaNd[mlAoAa|@k]mUlKwMjc@ySnjAyDdScIvR|EfuAs^hQcArL|LpLqi@xQlZd[~EtH`Qt[nJ`]t\\\\d_APxe@vZsd@zIgY}Hyz@vCmsBds@qMaGua@hKkAiYqXmKcMfN}Atx@tTgT|EnCq_@ji@{Rln@tEvx@aPhrAxKvtAmZ`Ya_A|QzLjaAvTxv@b`@|k@do@t^jlCzlAlbBfUlv@nYr]j@`W`r@bx@tTuMl[udA~aA{f@cAeoB`VitAle@wkArM}f@aDcyGoqCogA?gf@pPug@l`@_l@aIy[dP{g@nFcqAyG}vConAwm@ws@uUqGc|AvNgUaD_qBpXof@pUscCzmBsp@`\\\\qt@xHcv@ucA_YfCiWmUgiAyWoVrFy|@i`@}gA~Gc{A{e@yeCuTe{AzCal@xO_NyKsKfCuTcLucAyGy~AlJe_@rKoUwH{UjB_tB``A{aAkLab@wQkbAsAuTsRuV?wg@el@mk@u]_r@cKe\\\\xGc}@hr@ya@oBo`Aj~@eN{KqPxJ}u@btA{_@dAKhl@kd@~]i_@~eAqMlInOyjAqMiHsEhGc_@@}o@riBmPyUwjB`Rqq@ia@y^rX{c@{GsYnReg@}Jat@gkAclA_e@eGhTqg@ySohC_QmqAkb@}_@lHwt@hn@o^Poe@xSsRqGwTrCgTpXuX{gAgZwk@ul@qpDjDbFhSaOtTbGpGcGlKlJ|WwOnHuf@sH}nAaXeh@yp@ua@cOk]xCg^_FoFoMbLoM`q@zQbl@meAdIeJiU`Eq`@qH}`@fZkb@g@yKwh@wt@w|@~J}Wz[bqAfh@lI`j@egBu~@{YwFqXjEmzBnuCut@fc@el@hnAtTocB\"],[\"{r~c@sgnPnN|TkA~Mu]`HuHeZ\"],[\"}|pf@|bchBzNqpApWgj@|_@htEsBrgAxJt[R~t@}m@{a@qi@qgAuD_e@\"],[\"u|rg@svxi@sBdj@nFrK|Esa@pVd`@~BbzArI~OueAfjEoEzC_EoVaP?ipAb}AaEr^zR`hAeQjaAgLrKgHi\\\\pI{~@_AwwBtc@kZf[eiADeQuVzv@eSvTe@{sB|Iu`@zBbPbC_LvCwgAxKkTa@ylAvJmUz^tBtG|_@\"],[\"sxxh@g}h^fCe{BdH`WnIrrD|Rzm@Cr|@`Qle@vInyAs]qp@wl@mzD\"],[\"msai@}lx`@tCql@rOcg@oGlvAnGhwA\"],[\"yybi@agj`@jA{UtMgJ|Nbc@wChj@\"],[\"udci@m`qaBf\\\\aRh[r@fSpSpDpl@er@si@cOrL_d@x|AyRtiBsxAtCgw@aYiQ~I_Wrj@gNxHs\\\\sO{l@gsByC_{@ps@{GjNnm@nMrL~Smc@d]EpUqMh`@pa@ba@oj@vt@}@\"],[\"urmi@mzsaBpZrMhT{JzGdKlJiDbN`i@rFhtB{^qg@eb@g@gk@nSmEed@gU_LfK{fAdWgY\"],[\"whxk@{rpj@zIvMxKkK|HlChI|o@rQh^wCzn@eD_F}[`Bck@aaAvHcy@\"],[\"ymyj@}ak^gg@`Dyk@{Vm|AoiB{h@y~Cx]}aA^eq@lMvAnEgJia@ujA^kMdOdKxJuDmDaWbI}oAwEsX}JvO_MwOwTrXc@akA{c@`v@kBwYzJyWeLlKoGwOwNro@kKy@tN}iBqGxK}ZghAgMjHgEfj@uJbK`Q{wA]yyAyKjnB{JoGqYdXkJ_LeActA_N~c@uAtq@cSk^{CuuAmQaGwEg]}GxKcPgwBhAltBvVzuB_TqPiLid@qFnGlBhd@eJgIkVykCfOepAZobAwTrcCzFhlGkd@x{BoLwJaEam@aVmIkg@sqAse@k_Gr\\\\}nD{E_m@kKlv@?a{@gL}BgCleBiOdw@sXbBuE}Ht@gnCgLdr@oQfRwCpt@kRpe@}EsWwT`CuHuHsc@c~AsMqdAdHy|@ij@yhCtOopGcZ{_C_e@ksAs]wzFvRevB`a@unBtJ_sBqCufDdHm^fM_Etd@w`K|f@}lAzUyLtf@nbNo@uhJ~Hmp@r[rv@fEna@uFj{@h[p{JbMs}EyPqxAw@{sA~f@|RnEkJrk@jJ|DjYvTxWxA`n@eN`yBdJpoAoLb`DrKr]?}iB~RgfA_Buy@|v@ssAmP~lEpc@{aAbJ~}@n\\\\ye@lRrh@Irh@uWdz@_BruBxMupBlNmIjFco@vG_EdXbIzG~aByA~kCvFxUzGyyE`UkApByIjN~Eli@r|@bZusA~L{CfVea@fJfEfm@plBhYh\\\\fCscArXbj@|XfT~SqXcG|xAbGqGpGxTdCsjBdN_cAlToLbp@MxkBsxAzp@etAd}@gbAxZo|@zaAcb@fL}Tp}BugHfZki@rd@weC~Via@xZ_jAnd@oiDcDa`B|PaGbd@kbBqGx_CfWuaApMhFpZhj@~Exd@jKea@qL|wB}ZhuBnGp}@c_@vwB~ClUda@seApLyrBsGc~@|BoNxKqC~XcjBhCv\\\\mn@ffJgTvlApXa_AkD`fA_`@jx@wTaP{Cyd@eIfQbD`y@bSv\\\\oPdtBxKeHpJes@gC|kAlPc_ApGdr@dHiJtBklAsGsLyK`a@hBi`AhUwy@|Glm@bG_pAnMyk@vHbElQvpA{JzeAjBw}@yKvT?e{@eJfPUzoBak@ddDzKr]vJ_iA`KwJnHvGYlSug@lfBiTnaBrZ{YrS_xAjSy`@dFbPaOnm@zGxe@oPnh@d@n|@yUvgAbP}z@qBgPcLfPlG_y@wEkDeNjIgWl[oQlbAzHdRdM}y@v^e`@yXls@yMvgBmVpw@vGIbTxa@~Bmk@hYk_@wThi@nEv_BlFxItHgA|Zad@oBvKtO`D|Ahd@nHqBtFp^if@~gBaJjDi\\\\zkC_Yh`@gUaBgLhkA_\\\\|n@f]ea@uChn@h]nGpXcjBzBdPnEmVjDem@vLySyOzyBiFnOaIaAiHdz@ed@vfBjG|InKqNvGhj@aPps@rD|z@mm@|uBjCtKdRqa@oLtx@pC`p@ua@~kBx]`Bi[rqAaIbbA_AwhC}Jeb@b_@{x@kDcU_FxKyStE}b@ig@aLlGoUn_@ii@|oBuLcExH{lB}GpGoByRnZoaCaBkSqm@bpA{Ba}BxK}c@sEpoAdNvL|AyJ\\\\{wAmMufBeJ`SpG~k@uD|n@gNjk@qNyX{@qe@wK`WOcbAaPf}@zd@|jAySvwAvWqRfHvUgK|w@oGkKiCjIiG`q@zDx^sg@xmAmV_yAe\\\\ic@c^kQ_N}_C}Waf@oDb_@`Ubj@`[`~C|QzUhZmAjG~a@gDlm@kLh_@iTpGmv@b{@uIaj@cYoVcRut@wLd{@`c@xwAv\\\\pf@rLyCfJdV_RzHoRl^{GkE[dP`IbMdP}WfGbDFvh@fVjA~Ert@mKtL}Y`BaGjTdBvMnUcMiGfq@w\\\\rLdPtr@aC|Neb@{WeLtBsQtj@q_@xFgCzGbCpO|e@tLfIveAbHvInc@}\\\\gV|sAqNrL{]kEpYhnA|OkLdYkgA}@beEvMbkAme@ppA_KbcAgQvi@w\\\\yIkp@jr@iJwAkOr\\\\\"],[\"c|zk@_kwlAiCxFqK_oAt@siBd_@wgFWbsD\"],[\"mk~k@ub`t@d_@j}@dK}B|IhHrGroFs\\\\edA_f@wvF\"],[\"ebmm@yrhz@wKmmBxNtQdo@|_DxN`zDgH|]cOaH{DsT\"],[\"wunm@{|ay@fCmKrDxz@eMvz@y\\\\wz@|Wcq@\"],[\"yupm@om~x@jT~xAtOg]lOda@mJ`uDfHxb@gMhw@uOgr@rParDyCiLsXba@wSmhB\"],[\"m`rm@if}y@zB_wBrXjr@aQhm@h@nmB{Gp^\"],[\"ikpm@mocz@zIkyAtJvqAnZn{A}AvRgPhGaZcNwC~Gef@k}A_Kf]{G_OrG{mBdToZbObw@fExmAjd@`l@c@qR\"],[\"o_wm@}bnx@dLo{BqGvsHkPkn@\"],[\"mnxm@itcy@fCke@rLlYp@zb@sEjcA~FxhDcIdQoKk^sCyzBmVcb@\"],[\"qxql@{_xa@{Lty@~JlfAkA~lBs]tk@gCpfAgXss@sBzTrJbiIaKt`AwIrIcQgj@a_A_oNuMrX~M~kAcElr@dJ~g@mNwI?lv@_]gjBiNt[v[hbIuF|V_k@uq@qGfYzBbnBuOyKyK}\\\\mPqGgCwe@kT{\\\\iLq~AxCaSnGfLxDaSyDuiArXf{@~BwUwVqzBfj@ir@nRcJxKzN}E{s@cdBmjFeXjHaIhbBsLoN_V{o@yYgbCzJ{~@bAklDhGdBlByGdM{jA|BqrBoGdLqGqG}Mle@oVlBeMlOqFd|@wHWaSxYyKgf@uc@uvJoNiRaOn]AhiCeOwJoO{m@a_@wlJcPvi@aSatByOeAwGmMsTcpBj@u_CmO{yBgFkNyK?oGkXfCyyEye@czDcGezDoMr]uCzdCaJbY_b@{kCaGmcJtcAffFwCmv@vCrC|Gs`@t@mh@yDqg@ea@is@gN}eDwRud@cPQyCbPrEhjBkIz\\\\ke@elAsFnQwHi@_Tuq@}Gk|FtEso@wR{fA`L_r@}@yiBfTw^fEcgAcJmsDnK{MfQrSpNwHbKwfA{Xw^xDk{@sLbAmWkqAnNsu@|GxK?{m@bGlKhFqa@{a@wcDcNmvCuQyh@qSouBbD}vAqLc{@|\\\\_p@p@eb@o^{iC?ykAeUsWzMo}AcD}]oEtLuNyHoOk^_C_u@yKxKuF_XoIk_CrRgk@qByh@sV_iBk[kg@gIar@mBsmAj\\\\qqF{GiTwR~w@_c@p`@w[bsAkb@usAqG_hC}XinA{E{gAgZic@aPadCcNuR_M}kCeO}f@aAcfDwSesAmPqvChH}uGhRqlAhDwsAwCohB~Gm^zK~TlBmELsq@h[a`A|EosA`Y|]bXkjBfWhVbQeVpCo_ArSnvApw@laAxKe}AtuAzqFrF|qAdIzCrAqPnQt[x_@pdCtKs|AbGvWfMtiF~w@ltIdf@fhCvZr~Gje@`_Dl@nuApTveAjbAfxQf\\\\luA[duB|t@rhJfAf`Cho@xtErBp_@gReIoLzf@iIkGiH|O`Df{@vKwi@nc@||@pZpfCPnjD~i@pwD`AzeAdn@xxEmDnwCji@fkBzJdEqGt}AhHqJpSx_@nGkP|Gna@xKgLvAxm@dVp[`Hh_@_Gzn@sM|NeXuCyL`LqFkG|Bj_A~O{JfV|Mbh@teBf_@bwDxNfHzFd]|Lw`@zX~UnIhYaChrAkPtLsWkYiIlXvCho@lHoDlPle@dGqGrCxK|GiTnLv_@`Kp}A@pQ}GePoGdQlP~jA}GhrBfOms@bFi|JzZkh@|b@hnA`F`dFle@knBjZ|z@fNbuEkKrgAdA|y@kc@`eCnl@erAVaoAr]c_Ad]k@bF`h@nQt`@aEx~A}vAvtGGzh@xz@q{BgAhs@eLjZnJbp@|SauApg@`v@hNyFlRba@nGaAxIo]nKq@eGxnDeLxiAuNnNuo@flIxKpGd]g}C~G?bNo\\\\j^gfBtTri@f]yt@vOvApGkn@nGdLnGiTdL|d@dGy\\\\?|vBdLykA?d}A|Sqr@rFhe@mR`pA~F`c@_VxMhBjOrRlZnQu_@bFkiCxIfWcDj|@bF`YtT~B~B`Q}KdBaJvSgNfbAbFlMnViT|Xhn@rGe~@pViHpLhw@oL~vBsY~vBawAv`CzCviAzd@{}@jQkMtKzd@{Jp{AvFjh@dKirDryAsbFvK`_AlKwz@dSaBb@kMvTeGjq@hzB_KrjAcTbUqh@`zB}YxkOxNip@xDuxD|MmeBp@}}CjSwSlXwaArOkJ|Mqr@lJrAjImRna@xRzWb_DxKyKgRdmEqx@|oCmAf`@`FvHxKyO~S_`ArCv_AdFmkB`d@_cBzOiaBjJeIdM~EdF_FpAp`@|ElLzJ?tbAzgDuY~y@qAng@hPz@fGq]zV~k@dRsVnCnRm[p{JeXtm@gr@~Q{OvZuDt`@tFl\\\\tIch@bTm^ta@|B`Jbb@oUx}A|HzqEsdAzaGaBjjA~XluCg@`x@hq@xeE_\\\\pfCcUu{@wCceB_SkLoP}~@pD|~@cMnpAwiAa~Ee]cwC{Bm`B_Xk{AiFcbD@toF~SnbAmCrPqX_YtOr_CJxrAhCvVza@fv@tOpxA|GyKkOlcEgHjUsCaPsGri@ec@bpA_Hxx@kNvUa`@aMnS{`ARqcAoa@adCeHvJkGqHcj@ssCyK}aEoOg|@tCin@kH\\\\yEbRyDrdBiGjJy]{v@o`AatEyGwdBdSu{@xGyfAiDey@l]qkEkBm^sHwB_DpG_TbkCaPbEua@toFfAdw@{Mzk@iCii@oGgCcLwo@fHeWbGpGfBmSwCqvCkMyoAfSsNb@iO}NsVuVrcAnDxZcQlxAEp}AeLvg@ij@e`BT{hAsL{dAFkfGmOwyCgGrSbG~tCmPgi@}SteC|S~aCyQq]iNj]rCjaBu\\\\ge@gN`W_ImC_VelC~CyfAdGrCfEsS_e@}qHPmRfIAvK{tA\"],[\"}hun@wjhx@eEptCsHrUqHeCgEi\\\\iBkzAv[coA\"],[\"_cho@oxlw@~SgfDgAz`Co^btEoDsV\"],[\"edno@cfww@nL|t@U|w@rCsCfD~PwAvkAePbr@qNq~B\"],[\"cyno@{yiw@vKgfBlKl_CdLeXxDne@gV|}GnBuqDeTodA\"],[\"mmoo@srwv@rUmbBgFlzA_CpNsEeAgVnhBg]ryFjO_zE\"],[\"ifso@_`d|@`GscAbPvtCz\\\\xmAcG|VqPiQoYQwMi\\\\u@gfAzGnG\"],[\"cazo@wiqx@xKzaBnGoGfF`QpJ`cB_E`g@sXaa@mNeeD\"],[\"kjqYm~exAhC_a@xQjN`JuA|Kxb@CdJuOrEmPnZoMu_@\"],[\"_swYaxsxAzFwd@lZdP~Kvz@vPdUdEfm@pGgCpJnTmNxp@il@q@qj@}uC\"],[\"_wbZgvkxAeLi_Att@jc@eHdh@dIv[xZpVjUzd@hP~sBpd@pd@jq@jrAtZnUvY~@pKbk@dm@zTfOb_@fDlg@r^zl@xxBhSwLlF_YuCcKnV~Elk@udAbS{`CueEgbAaf@uVui@qVgEcPgPkRel@w{@m^clAe}@_B{f@lr@e}A\"],[\"y_vZofyyA|Z_[~JJlJtO~Knc@w@vkBzh@hzAdOhcBxOte@tuB|kCvYho@Ox_AxKrXmg@di@dGj\\\\rZpSrYiNhXzc@nZjRhu@jgBpn@d}@vp@hf@tLll@aF~WvSrc@nb@be@bd@bUeNh{@{ZgF{c@yn@eVrJgWqBp@cH~VmEdJgWsSeVwf@nW_CiSvRud@aEsVsh@ci@aYqCaLfr@eKzNqR}FsCoYdUqg@uL{SwQxDmI{M}Sa{@ch@iaAyVuPmr@iImB}QdPuo@uP{]qx@_v@{VeuAwZbPyXiC{ZpGkOyEu[wi@x@mJ|{@_Z|Z}@vQsg@aNmvCeNu]keBq{BuCiXaPuK{O}`AlCkl@zUq_@vIaBhQfa@\"],[\"wpe[{ewzAuKof@dLoq@sL}w@||Av_CxZthAf`@ra@|TnE|JgJ~CjXvMdHjm@piAdGp}@pI\\\\~Spp@h|At~BlBhT}RpO~PdAhJxMbAxeAor@o[{AuQmUwXmp@{ZmCiPub@_YsOyxA{hAgeA{m@o{@gSicBkQeRqNlQ\"],[\"onw[ow{{AuScl@bKuIjk@`dAz_@`Px^dr@tg@na@r\\\\h}@rg@pj@hNv]kXvv@aYhCmGcXlBsx@uHgUeq@ao@if@e}@iiAgcAuYqh@\"],[\"om|[sqa|AnTwSj^rF{Kdz@mLhIwYeG\"],[\"wqd\\\\iuk|AeDoP|GuFl^oCtUpLdM~i@{ErFeb@qA\"],[\"m_j\\\\g|p|A`UgBn@td@w_@jm@gQmBuAi^\"],[\"qlz\\\\a~b}AxKeJdNzDt|@dbBvU|Ba@|r@}WwKwPcl@kRqTkT?iAsIiMi@\"],[\"kj{\\\\{p~|A`LaPbJn`@{AfYwNjIgOaG\"],[\"gb_]{xi}A~O{UxJa@hR~v@cNrW_`@nS}G}I\"],[\"s~k]wpg}AvBiShP_Fr`@bZgAnUk[vVaOeL\"],[\"m_|]iih~ApXiTno@hCxWn_Ajj@hFtRx]|lAr_ArJrf@h]d]nC~|AhYxjAlwA`aAuAtRg\\\\wF_Jhr@`Evh@uF~EwYuA_[kTee@hBq[`f@_ScHgGeh@bJw_@ar@}jA}BebAyKeLpGme@oKcc@gn@yt@qXuG_z@il@un@cPer@y}AoDaShSmZ\"],[\"as`^yzn~AnImM|w@cPvdAv`A`N?aAjrAka@vx@c]dFmZiR}Fka@kf@k_A\"],[\"ej_^ej}}AeBbXmWxa@aS~KgVaP_Dqj@|Iuu@fY_Ylc@z@\"],[\"{qc`@qd_vAwCeJeAgK|@sI|B`IxBr\\\\\"],[\"csf`@sybsAwSqEoJqt@h[iAnOzl@|_@zPbJj`AsN[o@{R\"],[\"wyh`@c}~rAlv@kPrX|XjXcSlArl@wIjLyF{L}L}@ii@xN\"],[\"sjh`@alqeBnLsYlLiDiJli@kl@v`Ag`@fhA_Ift@akA`qCcUt_@s[bE|OeiA~XgKzT}`B`e@e^vb@uvA\"],[\"ups`@_cvrAlO}Dji@|h@vNh]r[kMfLdGnEnqA{TfKo@fP~L`a@dCvx@vElE`PgL|CpL{Lhi@}b@aRi`@wbAev@_sCbLgYmUgT\"],[\"ijr`@efgsAHqtAfL{PxQb}@|g@tg@tC~Up^lNpf@qJxLpJpV|aAzZnUha@v}@lUzMcVjl@ggCpcBl~@daDijAs]obA_gAyKqk@iZmQs]Y}m@in@h@ik@jNih@jIt@pGss@yDsfBz\\\\gTb{@l_A\"],[\"wml`@gdvdBtn@?w}@ndB_LbIsM{GgHtxA_j@rm@sUbu@oa@lSae@xp@kd@l@wb@rb@mPv`@gIqM_n@`yCiF_eAcb@gr@n[utCyFcm@nPiTr`@le@b|@_NnvBszBl]gmAji@yn@|SzF\"],[\"_zac@iz~zApJu`@rJgA`Jrd@di@|dA\\\\ruAac@sn@uSwfAk[cU\"],[\"o{~b@y|ocBvk@hOzWp]HbkClh@x}AaOrt@fZr]vl@~fBrI~bAv\\\\`b@bt@v~B~d@`k@z_@rNmRvZuQFuQdSgOaSgF}cAqToa@{r@mZ}iAgmAqe@hGsLuHw}@cqA{HfK_Dz_AjOjy@}Oa`@_E{fCet@wdE{j@aeA{a@ydBnBeMx^??cUjs@cL|Lq^r`@_\\\\tIjN\"],[\"awec@ig|}AuF_d@hBySxHB\"],[\"ywrc@szdQzDuDnEnGo^|oA\"],[\"}asc@cs_P`IqCtFzk@kIdJuR}I\"],[\"kusc@{wnOjN\\\\AnUer@tT\"],[\"ksxc@{woPtC_EnOvOuD~j@iSvl@}Z~D]iW\"],[\"anyc@a~kPnN[d@bHc^zo@Ucd@\"],[\"e|hf@m_}T~Zs~@bJhFdEhc@ga@|hA_IHyDwO\"],[\"qcuf@yo{TpXzQ~Pnx@_F`Ho`@hCaO~bBeZhc@aOQoMgJ}DumAuGkGv@{kAxEaQzo@g_@xCzMy[`\\\\`YpH\"],[\"}mdg@blefBuFsKtF{i@fYpSvJr]{MnL\"],[\"}pvg@s|_Yvd@_UnHbGkXjrB}Zpb@qLwPsA_^vCeq@\"],[\"ud~g@gpwRlFoLpKtGkN~a@{GoJ\"],[\"_cfi@gadc@|Rs@_Qrr@qi@r~@e{@hyDe@i`@zt@qpE|Na^pGxK\"],[\"}iji@yswS}GasBpa@mvBzEjOwFh|EyHz^\"],[\"arei@{zu]lX`Fj|@~wA|Hza@eLyK{LvEgNaIsw@os@vFlw@xh@hnBzg@`~@M{b@|Gx@~Qlr@`n@paAdp@lyD?pgArCuC|Mdg@r^xxGgPjo@bMpTdAny@ksAn{DmK}XqGd]tI|\\\\iGfIqi@cK}J}OwJz`@oY}Z_CzTuGvAwi@c`@mg@fCqp@sXmz@gxAa]eiAwl@kuCgHo}@?kcFlyC}lP\"],[\"ufsi@aolfB~QaBdTxYjLkAlAdsAhIqNjM~Mta@sRfl@~m@pIf[pCzcEqnAvuLyi@jeA{ZbgD_NdVm]}i@qn@khB{h@_jDxVghFx[}vBjSuwFlTqyB\"],[\"usri@kl}c@bJ\\\\zTbw@tH~AlPcSgDz~@fDvLtCiC~Nh`AtAd`@kVdhBvTdeAjKqGgR`q@eIptD{HkIaQzZaKcFeD_n@sI_@gt@bwBfBbnAuT~mAoMftC`j@gwBlGjM@dy@oG{BeHrg@mXnc@im@~}Bon@dmAiTgTeLpa@{Bye@qGrTaPyGzB~i@sHpFeBpb@iTi]gEvo@}KbFwEua@na@{}EmYj\\\\yXhhAiShfBwHuNvHkiAoGhCyXclAgE{BiLfRgEoE_CkqAg[g}AdUsUll@q~Cxs@amAzLgy@fb@m@pDqJu@gt@hRmnA|WkIpSgyA?gcAhwAouDkBit@jYye@tMlLlRoCxKw`@\"],[\"abaj@kqza@fSe`AhHfSaTd_B~Put@zJuIiBvm@sQbsAmOfRiCkTmKll@eLlId_@enC\"],[\"}ncj@qobbBbP__AnGhrAoGreA_DkbA\"],[\"qsej@}mtaBpXeSca@toCwF}M}L`Ae@g\\\\vMcb@zAoj@bHiK\"],[\"_qfj@ecbaBnTiXzEfgBoFdy@yPmBgJoUySoCsBt]nJb`@iYtYyKk]Ige@dL{`B\"],[\"}|mj@{tn_@|M~O~MmGdIlGjNda@lJiFhGpElCtv@|HlHzVlBlXe|@hIvJpDddAsUiBmN|YoPxHmUx`B{m@`PmD`IkF`l@wT|XaOvs@fGvbAgEj|@ePp{@wT|YuNiByHmXiW{hDpCehAnRkm@wA_JoZ`_@aDu`@`WkhBvt@scAiPj_A}Z`a@yKjhArXypA|Gb{@lq@aoDoByz@qGzm@eFop@dFo^jRnH\"],[\"astj@ksdsAz\\\\g_@~p@~zB`Ffm@gJ~aAc\\\\~_Ax@nu@iJ`o@rD~^jT_yArCjTbGeG~Cp[ew@b{Euc@olCqE{}CdEgbAgKwmA~Fi{A\"],[\"c`hk@m{wn@~d@cgAnj@|dClStyBps@ddChKfrB{A|oFnFbm@mHtaC_H`]aOvIkWmd@y{@cqEkn@cg@}v@wxDxBirB\"],[\"o`jk@mfsmAlHmp@|[eg@d@|~@pSta@fCv\\\\fCoGdJzoA}SdcEam@hrCuX_vI\"],[\"ubmk@eeimAeIwAmX}{@gH}dEg]o`DxB_lCvd@ynBj`@qJhWzr@jFlnA\"],[\"eglk@{}po@jPsDvG|T`MnwAkXhuF`A`x@{e@|sCu[pSvDfNdTfBgUhNc^qa@abCglIth@cyB`\\\\_Tdf@avAtIkJ?`j@lNwQ|U\\\\tTuOlKpG\"],[\"}zrk@knrl@jG{iA`TwgA{RzwBbG~g@zMvIfQwD|g@na@dWiNlKwZnVnPlB~MgIvk@yKqGqAjrAqPpjAkKbKsJrlAmSxp@cQdP_e@{g@gOc`@kKicD\"],[\"awrk@celhAf]`j@bGyKpBl}HyFhJsOye@qa@jpAiTmaBsBwnC\"],[\"kltk@m}xm@tKeVtL`A|Fd{@oIjcE}Sd{AuOnVmDa_@\"],[\"efuk@exvj@zrBsq@xClKigAdrC_QbjAjUnz@bXx`Kh_@`|B`Gh_CeD|_@}`@~y@|WxcAtMst@dKwHuKflCun@_Vsi@gcA}`@sZiYjCwiAt|@w}@}}HjJoy@}Jiu@{IsyD~_@inDfLcWkAt`Bfa@sNxT`^vZ_EnN`}@jMnIoGgr@zAo|AdMco@mOnF_JeKwMgaAcTySpo@sjB\"],[\"aqyk@{czm@jKj@gb@vvBoWvqHeViw@fDkv@vs@ikH\"],[\"i_{k@szim@zLlb@bRuTtIf|@pAj~DeKcWaQyDf]zeB`HlInOiEjFdGkFza@iYmRo\\\\ot@qa@a{B_OabGbKm[\"],[\"k`wk@yfqvAzv@kw@rMfWbKxiAjFxoCuZ`dGlCxgCot@d`ScdAjcMzAtqDfp@~nIyFv{Aop@~]}_@cvAtJglEmSo`Dkz@cgBmiCqtCcq@omCdJouCmYulCqEqxGx^{mCfXc`EvbBmiGpMcyAf}@u}C`}@cpAtT`{@\"],[\"ktbl@ei`kAzMwm@r^pzAoHd_@fEbl@mTb_AqMkNgF_g@uCda@aI_d@sEuxBtO`hA\"],[\"ytdl@_qnr@~B|OhYkw@hHps@iQd`BoDreDyNfPuHerAtHuvA_QmiCxCiWzJvN\"],[\"ytfl@{b~q@~QuaAvMxx@oHoC}@xR|EfbAhPyj@zExl@a_@jaEfCf}CqGnGaVk_AeNacD\"],[\"yngl@_tkq@le@ojB~[fjAyJji@kCh{BmU`UqUuq@\"],[\"mncl@{hdrAdOwEdKlLpSreAqPdj@gRhNm_Ab`Ecj@hl@oX~aAiE`fAiMxQs^dJiNhV~MerClg@g`Bxh@aeDpfAm|C\"],[\"gfgl@cybuA`NtOxd@gE|d@`Z|Or{@Dx{@xStgCbPxp@rC`bA{QldAku@doAc[`Tks@iGah@mZiT_oA}LmqIhYkgBti@{`@\"],[\"ufkl@mpzeAtRqOzHbCzLvg@qJzdBmKrAcUyTqJod@\"],[\"_enl@isxr@vM}f@vGns@_EvmBxKjqAn\\\\glCzGzTsQnvDcSz_@wXg_C\"],[\"o~ll@s}edAb^yMfFlg@rR_@~^fmAvKgXxHhc@rFhIhCeG~^l}Cht@pjArKfk@q^n}Jap@bkC}Yj~Em`@hkAmRyDqHqYkKmtAjQm~BuKi}@qNeUqNtG}OqRaRjRod@iTcGg_Ara@ynK~H}_J\"],[\"_pol@ytes@lPeaA~TxGlFpsAxTuBUjuBcNr_As\\\\vd@yV{v@\"],[\"_dol@afps@hLilAlLcBvNzy@mLtSiAvdAxKeX`MdRzNtiAcP|{B_R_j@kl@in@aDatA\"],[\"capl@y}qo@j_@~x@~MyEtDjYqBbe@it@dnDvPasAaFaTeJzBwK|nA}LcLoCiXl@un@vKlPrEuX\"],[\"uzrl@}q_s@qEnpA}L}zCoUq|AvKsdBvWeA`MsZdD~OoH~m@vLb|@sUtvA\"],[\"utwl@cxbt@ba@scAhVfe@|Hbs@eQzuAcAjoAtKxWzZavArEojA`LbPqQ~sB[z`GwRlXaL`h@oHsVlJaj@fEkuBcCgb@sKwRmGb{@oKoPmPjTiD_QfHagAnLxK\"],[\"}rzl@kfcq@l\\\\sG}ShlAiQ{a@\"],[\"yd_m@wfeq@|Xea@gAd}CvWcFhJxJdNiFrM|_@fM{JfSY_Bjb@kOb^ibAjU{Cf`@vCvHhMoF?ra@eLj\\\\`PmGfEzUeZbsAoPkPmGxv@i~@caDc@}XnXp_@zIxDhEuX|GjT{Eo|B}DaOwQlZ{Er_@eLcr@oG?qGv`@{Ikf@eEubC`f@jXfIsA|@}QjGvSiK~hAfN}W|Ak}A\"],[\"c`{l@gz`{AdLopAjI`}@dM~[f^lYd[lgAvIt@hRqWfOjEdXpk@fv@h{NtW~o@_CzgGvG|s@m[`gSkeApgGiVbt@qK|xEyR~lCs]pqAkm@b_E}\\\\~kG}Pn|@aUz\\\\o^e~@i`@yEs_@nH`Bqz@iFcLiFxPwLsCsTqxAkQlb@uLpDcf@y\\\\eFk\\\\tXylC~QmRpJ{n@rLnv@nGgC~S`a@pk@odBvQ}~EkGoiBkBwLgKhU~FvxAuZw_AgAcp@dGkEtCin@uIbH_Nfv@gEmXa@ebEjMg~Ag@ubJbWwjBjUbPjUrz@vM~Fl]kgAbIt@hC_`@oC}sDu[ylC|YsqR`Wit@~Q`_@uDk_AlRm}AtBqxA`Pzv@hCaQwLyqAeZqX\"],[\"ykcm@mhrrAyIhB|K{LpKyv@\"],[\"ojdm@m}srAoF_q@|Y`iB\"],[\"ocbm@sa_rAvN`S`M{CdUeh@vO~]rTxuAhCpuF_u@gFuL{`@ss@}o@scAda@uOi]eL~GuOmTyI|@}Roh@ic@mC}DiHxAsHlIzAdi@ieAxTaBrXeZnv@ufE\"],[\"seim@mubq@bGm|AvHjU}Sr_H}Ut`C{Bam@\"],[\"uojm@upyq@fG_JjOrs@qGr}AjGn{ByCzJcK_GqE`SpE|gAqGjkBpGhyA}EvdAoIvJlCu`BaTezA?e{@\"],[\"skbm@{dvwArMcNbI~eEbj@duEvOrR`OuAnVzTpGqE`a@scD`PhhCl[HhXlS~\\\\|vBzc@~iAnPbkFob@zyCmBvqCbI``Bin@phHep@h\\\\eGbpAcIg@yFlT}_@zS}N|aA{QlXe_@_YmNtNkNyMwGkt@_O}IsTku@sEqkAuXcgBuZmQie@bYkIt{@dGfuBmLda@xGxyAgCrmBzG|\\\\bByn@xPgi@bI|_@e@dhFlPc{BdGb{BdPcqAxd@|c@rf@oaA|k@jRtKiC|QcVfr@mbCxf@iQbZ_wApZ}MfPig@hMevE`d@{nGiBagBvf@iy@r\\\\`wCfJd_HoFnp@`Jh_AwJjaAdKlg@fPtN?mv@xGnUjIneBmC~aBicAlmFgF?cKqaAfYgeC{Cej@_m@rfBfBx|Etp@lfHv@t_DlW~_JlVtzBrFroDqT`e@sXjTwKv_AsXvK?jpA_Ibi@j^|wBzCmNz`@z@|FwUwScoAfZvr@to@`h@bq@p~A|TbfCgBndAkb@f|Bye@duKwj@~gDmU~\\\\iy@l`@s\\\\~wAgPrgC}w@tyDkqAbkCo\\\\_H}Xxe@gOumB~Z_p@fCatAwGokAcK{[eKeDmPzSeDvjApH~qAsKzGyBsHuPsAaCe~Asp@djAuVaNcGov@}Tpj@aIjs@_IcK}Lp@uTzv@{f@ypA~HurFlP}~@cAo_@qWrSaLtbAwo@zhBo`@`JuFuJmd@imDwJgdEcZiv@_FtTfSzbAmg@ifB_WctCdLd]lQe^lSkGpEiXwAgKuLY{JuO}GxKmf@}l@c`@qaIvKt}AjFuf@t]cH`e@{rAlt@wtG~N}j@zHu|BjS{t@rF`HkBhe@~MjCpFjVdDoCn^maB`UmxBcRmcAjX_{CzTwUv^~v@`]pMdIwYfNiiG_B{s@kQkn@mP~u@eG}Sy\\\\rxAyj@qXuWDi]jOou@yQcQyMmTwi@aEwnCnZauFsXtcAopA|~Btf@atFdcAetG`|AwyFfRsmFzNipAEgkEaPtOcUelClCc~@`TygAaGgb@lCgv@ji@uwCxi@kdGdAqfAv^qt@nUwbFnN{~@\"],[\"{qpm@qq~y@|Jk@h`@li@jVwIwBx[ePnQqX_Y_XtfApIl}@lYko@cEbzAvIfS`P_}A`MqA|Jpp@xKe]rEpNa^`eBTftDaM`tBcHnXsCiC}@v_F{IxZyKoa@mVpCsFmUz@wiAv\\\\}}@y_@aaBsFgt@rMod@IkyBrZsArA}Roj@cgAaDsf@\"],[\"_nrm@yvcdAqGflBcb@sw@_F_b@~Ke[vOx\\\\~D{zA~IuV\"],[\"kcwm@guicAsC{e@yKda@gCeoBjL_nAoC}UuTyKMsVpLw\\\\pGyeAxK~XxKwe@nGjPdGs]|Gxe@rKa`C~KjuApXiYpHh^{LnyAui@reAoHdvBgW`m@\"],[\"g{xm@_f{y@hFro@~GpS|LeD|K`b@xBvgGkT?qHiUqGcp@bFmn@mZe~A?{pC\"],[\"urxm@kdky@yKes@fCc~@|LfYjJtbAn@h`B_Mbx@kTtKeF{_AfXum@\"],[\"maxm@uynz@nKkDpPqdAnKfk@uI|pBmEpP{Nwn@{JvAmJlHaKfs@mMwaBfJgL\"],[\"kxym@endzAfPtdArYrNfM{MbFzBjCxa@q@d_NgPcVeo@uwFwTwmJlJ|D\"],[\"m|bn@sh|y@je@we@te@beA~\\\\~bCxK_YnMjl@cI~fA~`@boGeXmeAeLm_BsIpSBj]nIdw@xLxeIeHvNw]_uBmMacBdAolAmt@gtJa^ccB\"],[\"kkdn@qbq_AsMshAUii@`JuX|Ed}@jLj^\"],[\"kkdn@wvvu@xU_W|S{v@z[zj@vMv}C_CvvBak@bgBsd@ap@wQax@}AgzD\"],[\"eeen@wj|_ADsz@nIjh@oIzb@\"],[\"sien@ukw`A|Hd@vJlWjN_J~UtjF[jvA{JwSaP{gCeLzmBsc@kgAxRobC\"],[\"qrgn@gcf`AfLmKg@zfAxYvg@gC`mBmHe@_IgUoIt@aSuiBhF_dC\"],[\"wbun@arv`ApG?~Xxs@|PjhBaLjQmIcGiFnZnKtsBg]pxNwRir@_L_zGbF_CrCuhEkOip@|@_mC\"],[\"ukwn@su_`A~d@lrEgOx}BuVsToGaZ|AcgDeM{^gCbDoSyU_Ha`@TmsArSgcB`EnIlMPrGpTc@l`@}LjWlNtiB\"],[\"sy}n@u{i_AbXz\\\\`DyF~PfMlSl{AvtAxfGwKbaBpHdlDft@loKrFtpJwFt`AjV||AvFcPbLxa@fLtmBg]gL{Fnm@pBjeFpTddCwOp^vLt`FcAn{Flf@jzB`j@nkGtRf}@lCbhAhe@plEzDvfBqGzcC~L~xAcIpX?`_AaPea@ca@paBiTqaBaj@scA}KpG_HcL}Fwd@cl@w}Asj@qdCoDqq@_Yf_@mK}IcGhHqRc{@sVm`@yG`KaE_Fej@irAcGcGmRfT_Y?sP}n@gc@w`@c\\\\efCbMuqAdFqaD|KaV_JusAsNrs@o@dnBwKjq@eMuD{Rr^{Ru@y^ky@mWaQ_^ydB`c@}dIeEiTin@nmK_k@ca@{Gq~AcJnj@uVsi@mUigA[itB{Gkd@gKfIwLcM{BhTmPcLuEfCsMk_AgCyiB|i@_sAjJmHhM|BfKmqD_GqIwVrz@gg@pPc_@yb@kNeaAvHg|DvPqzAtCmwA`Xs^`IixBtHgHlPvv@vBxrAj]h`AfCkTle@|gAxKea@le@flArNgOfKjDfJd_@lIbAxdAryBpf@de@cLg}Aq]{sAgOwLoV{~@wv@k{@gGsTJmjC{NkrAmG{OiCvC_Go_@p@_fAmG{`@_SyGkFiy@zB_MtOfXoHa}BbOywBjRwLhTl\\\\rQ}{@nPBbIo`EtLcl@xXi]tTvKnB}XhL`OdCxqA|GeeD`GwLrWbIpHdd@wHkzAyP_ScNjTcEqUbEkzDjTugA~i@kr@tH`JhFkEjk@wy@lVlE\"],[\"edpo@o{jn@nOdRdJfiP}Dla@mRac@_Rxo@vFzi@qS`kFsd@lkA_KJkGg_@xA{jAlFc`@hCxtAvQcd@tAij@gGkuAeK_IeCad@\"],[\"kexo@stcx@xByz@zPcw@rYxh@fN`o@vgAbaKpY`jGyBhvL_He]oG`a@sD_QmJg~B~LmsA_Myj@aVhl@gWhpBuXz`ScEjGoMyM{JgoBaIsUeMjh@wNfeCmPueB}RvKyL|\\\\qGlyC{EgUjU}pLgOasBpOk`DbC_oFh\\\\ikC\"],[\"yqxo@yzi^lK{aBrIbt@gc@fzYyKqrBhCmhEeb@i~@iMq|BaUmzA|Wqt@|BinB|Mtg@lL]nPia@|HuqA\"],[\"gr|o@_viUaI{c@jMu|BpFji@\"],[\"}wfo@w{}{@tIjM|`@gs@`NdLdlA|cIcBhiDrKn_CkD~iEhGt\\\\hEu\\\\lElMq@r|CaNhu@?h}AsCcP_DjTrHvtA?nrBaKpu@xEpoC}FpVoGa{B}D|rEgv@vvE{Azn@cLaP{Lhk@eBzbIcPyv@~PdpGaDnaDiEzJuVtEwKcPqGjz@gOb_@v@p~@pi@d}EEf]kR`e@x@rpA_e@nxBkE|DaY{OwLfSq\\\\bpDeTtE{SuRiT|X_YuCg]}XoWf^pB|`DtOcPfKzx@mSztDsEgmCuMsh@yOd{BkMfZ\\\\tc@yKucAqXer@fDrcBbSfUrGlnA|\\\\~fA~Gc{@vItlBhR~nAuP`kAkTy_CmJzXsD`_AlEbkAmElUmBoMsLftBsJu]~Rs}CcE_lAk|@wkH{CcNqQmDmLm`@}g@}gEqXmdIuVzkBuGn`BiOdV}Aj|@mP_l@gCqt@_Tle@gE_RfEo}@iXsyDmLk^qG|B{PuwAgObOoCkYlRk`BtI?tTyrAyEcbAe\\\\qGeS}uAHuzJxKfCSqk@mO}z@|Jgw@}CmwAyRoaAxKkbAub@wbPdZisC~_@mfA|LezA`Ynr@hJrbBzJgDjR`v@dGie@tInHxQze@dQ|hB`Pin@xK`uAxKucArFpLpj@tcGnA{t@ud@ojGkY{Ii_@qxBqK`TsNiYaAcdBjSygBcB_kAgPgn@oGjTyKsi@iCnGuDyGwKmz@sWpxA}YuhA|Ko{BsFwsGzGo]vKxv@wAgkBbKqZvF|NfD}NzFctBpGle@~IiTxFm{B`Jyi@z[go@hUuwAhJ~KfMan@nOlf@dH~yBzQ_g@hJaBz\\\\xhAzKmEza@jk@n\\\\er@f]l_Av`@y\\\\pRjV~Mk_@n_@zq@bInf@dLxKjDpeAvJfEof@tbEdH`eA~kAuuIvGgPnGvkAjLkW|LyvDfF{S\"],[\"qyzo@ycq]jFtq@oGdhAoh@h_EaMpZ_n@qdF~CemEvZ}aArGfJ~NvvBrc@fm@\"],[\"m`fp@yv`a@mC`}AgI~XkTk_AElbCwGzf@aPenBrDmfAyPqc@gOorMbFo[tF~IvBmFtQsjD`Tg~@fRjJxVfuJ{GyK_InVpMlgH\"],[\"yycp@qzn[|GpYgAnmAnExMhTsT~G~]jKxvFwNn`@uMtDoO_DsXea@_JdX|Lz`CkEnt@`YzqCk`@huAeIfaAmPraG{RisC}k@uwZqGhhAqEmYcAmjHgFa_A_^gnBeLfcA_F{[aHywFzZwlE`BekBqM{rCfSeiCxYabBrBz_BxLrZhi@w_EjYlGdCxMiYvuD_EtfFaJjm@{PqEq@lp@ad@pjBxLdvBdLaa@uCrtAda@c{@aDddCbU`a@hYuoAfEoDhCbF{RdoCtQvuAzY~i@kGnjEhEjT\"],[\"gkip@ug~d@hQi`@pYxHfn@xiCtOucAlIpRpNb_GvKjJdLt}@tOqJrJrwDxDjTrEqaAxSxa@t_@voDyXjmHu]j}CpCjnA~JfMfOnxBlFfkEc_@xvAe\\\\t}CsXmpAiFzt@}HjNeKuPcUvi@kTov@cGnPeLas@gQmWeGyoAsOaVqGve@{SurBoIciHhMit@dL`sDQ}uE~Kg^oSg}BmY}cRoG|GsIcl@zHuaH\"],[\"}vhp@ijnb@cCvyCcMvh@ie@qtEuRynJzKkqBfc@s}@n^nrAgBr{BjNz~AlB|jA\"],[\"yxhp@ud`a@bf@vjPyK`dCcPkO{JrScDhrKoKrRuFuAoMsgA}FcjC`Gas@wKidEtCoYhDyC`F`KlDqj@ib@ecBoEcfAxF_cBxP_eAhc@}`K\"],[\"qugp@ypp^bGea@jV|Ytc@|gIzq@rkC`EtdOxPtm@tAffE~Fx_@jQwkAvNKpEwRrHxGlVrjEeNxuKvM`]pGuO|SfeBpLdP|LgdExRqz@lPxe@|McqAtInLzBb{@|GtKoC~qCg^n_CbIxRhToa@rTvXfSjuAyYf|Ai@|aAzJrk@jJqApEnQpItsFmI~LqGcSoHvAsZs_A{Oy`EkJaWpBlrBmFha@qLelAoFvWpCxiHnOpeAtOi]cAflBjK|cAmLp`CmUmFqDdIoVboCeLtXsJsRac@gqGdGqm@pH}OnKfIzGi^qGq~BvJcoA{Fqq@hCklFsFt[}AgdB}Eya@uRtlDmAliCcSrj@qLe`K_KftAqLsXiDdXAdnD|SvvFuEnZcy@gkK|EspBgUuoBrPuuBlPrTzD{V{DcrAxIkmAsSmrAxDamCsH}X_Kba@oR{oB{Qc_@s@tg@yNtb@yD{xAjHm}BgGmVcGjT}GyKiC|XiT}i@cEx`@dVrhGkTud@gCun@gEj{@zQbzBsKrw@uT{aB{BrcAso@_dL}WylAaXcoGbPs_CfIvaEjNxrA_RkeMkMiqAzh@guBtKlf@hLx~HlK{m@tNlpCpMle@rPwjAw_@cnD~MeeAgSyhAeAomAlQcy@kCud@uFbJcDwF}Gs`B|G|BbTqyA\"],[\"}olp@evuo@hTaiK|`@hVzN|l@r`@flVmUr~Aqu@qzAsR_w@\"],[\"k{mp@qqxb@|JyYrQdw@zKj@xI~YzElxBuDzeA{x@z`G}IkM{JwtAkGtEuGkp@\"],[\"k|jp@gz~a@}GbuA_PeU{KlSwKbgDqJ|TwHkLyCvLym@tsNcSe_@pHso@gBwiHjX{uCxCabEfXqqFp`@}`ArZwkIzV|dEzTvnA{Dx|D\"],[\"c}lp@quh`@cGvzAcXoVmH}|@uE|I{D{C{XcnArXawBjTs}G~Xe{B_UyvHre@qxJ|VeQdnAabSvMwT~Zb_Bjb@tyH{j@zeCyKvtAuOhVg[p~OkCpNaPjIsDhbCgUjiBeNkP{EbNzI|mFwOtfAyM`N\"],[\"wknp@{zx]cLvoA}GiXmKxKkAy}AcEeKwKx\\\\mDeW`@ktDnMux@dVmg@zGfW`AnjBzEfLjHmTnJ|Ao@z{BtQ|kA}Bfo@\"],[\"c`pp@_`{v@jJuOfp@hqFi]tfVaP`r@{NgDwHdQqX_nBtLq_Z\"],[\"o~gp@}btz@JcoBlK_\\\\bL~PrDjeBdPhe@uEheBlLxpBeJjp@`BfeBxKce@je@byAfFoFdNvWxLShW_oAxSb@fMme@rWpIlU{GzPufCpRpSdTpiBpGoG`Hd[pz@hai@iGxiBbTryAgGntBzUbl@~_@hqDjLdhErM~}@y}@j}MqGbKe\\\\p~GeHlqG}Hm~@mKoP`]ipKuFw^wHhRyHhaB_CbbDuKvaByTrf@gNzcA{DmlJdSitJyDgR}Sjn@lKfm`@aPicArC~gCoXm^gLkfCoGrOsGq}GkB~KpN{_HoFyg@cPtjI}XvaCu]arFqC}rHyFsMca@mPcG~XyKkTwSoFuQuf@qv@izEeGqScHj@|TlgFqGpxAxKll@h@h{AmB`SuIjBkn@gqC_Pda@}RucCiEigCmOya@qOdu@uLnMi]udGfFwqBqL}ZkHm_Fe[okEhE}iBgX{nEwl@yxD_Ac`FmPx_IcMoj@}IucL~`@iwCfFda@bJe@p@{gBtDmUnGrGdD_PnEmw@|L}Jje@}aD~FrLtT_FlL}tBvKlv@pGar@fNkXjIiyA~Oye@ba@kyG~GxGzC}uE|FgTvK|XtOitA\"],[\"wjup@in}a@uOngIwJo_@aLiuBuHaOqGje@_Hyh@bAidH}DcSiDvQkDqQ_BsgD`HkWzG`PlKui@dLxK|Di[bFm~Hbe@siBtUndDg@p|HoK`iCeL~GaJflA\"],[\"_xyp@ywce@qCoGoKv_GwUw|EvEco@}HoeDjHdWfFwxPxTeoAj[py@~N|wCuNjdN\"],[\"k~zp@sqqb@`Nf~DeJh|@o\\\\ea@oLq{BwOn_CuOgfCwPstSxLwtBvJaVlP|bAbIsXvKlI\"],[\"cej[ada{AsDnf@gQwP\"],[\"ivh]}co}AxNg\\\\wBmLpu@bQng@xl@p[Zdq@ySpYdK~Ylz@aOffAsh@h@sg@qTsTa]g}@qm@eVfE\"],[\"}qjm@o_`c@gRy{BbCeOtJq@nLvf@`YzqFWdhA\"],[\"{byo@ak_c@iXrpCaMiPio@}hM~RinDjJ_SbZlBfS_x@pa@doFiD|~@dHvCpGbe@wDhfBuEtSqGuO\"],[\"uo{o@ac_a@}o@{~DqJ_QeGv`@qLqGuOpa@qX_YyKdLmGiIaH{vAtOq_I_Ky]gIb@eDuLvAs`EvJin@lWyS~\\\\p\\\\fVoHtLea@zZtaAzUex@rLxKpQtcBqFbuR\"],[\"qa}o@ixb_@aInLyD|wCci@pkBoIskAbAscDsBuOkK_BaDspAwTd\\\\u\\\\olBmC{w@nGg{@oGe_AnGuvBtKjJjJeOx^oqBdW}qEf`@`tA_Plj@p@fm@nLj^pXtO`DlMI`oO\"],[\"ca|o@orbb@fA`_As^z{A_QkNiTrr@gPq[uIKyDbOcRwJ}Vrh@aPgr@oKvRwj@lxDjGml\\\\|WchEXylAf]ewDh]ur@wChkLfI~@tBpQaBhgBnc@hcEhK_\\\\Bi~DdKm[hUj]fCyK|GdXeL~_BbAdfBaM~ZnGjbAjKzP|M}g@rRyKxB}MpFvZ@z_D\"],[\"qvbp@wvy_@wD}a@kMdIeBeE}JkmAuNjfAaKzIoOsFsEsQvLcpFfFu[xAjyAjIlTdGajDdJdMvMok@lKgCde@nmB\"],[\"wvmp@c{wc@ab@{}E_GqgTlPtz@|LyLxMxPjcAn}JsOxqKuMpv@uCfrB{Hp\\\\\"],[\"oxjp@q~he@kNygBge@q~BuH{mPaSm|AuVgR}Uf{@en@o_BmNq{AeFeoDzi@cgIf}@a`C|GqGbPzxAvDqr@`_@co@pHlKzo@tdFjS`p@fSvmEfLlr@~XdzNqGzv@rZ|yI{fAj`IeCpt@aK|OiKiO\"],[\"i`qp@cz~a@eXywIfFsuAdKyb@fN~oChYrq@vE~j@jGbaEoYbvF\"],[\"e}pp@gz}`@gMzt@_`@i]uCzmBqGecAaP|m@rC{iAoGcnAnGwLsCusAvMos@UqjAlNmwA~LolE}Ggc@{KbpAuTdr@qG_Pm\\\\nr@oF}rFnFga@xBnKrEqPsEecAvVa~H~Piw@wJuuBfTefC`Mzy@jL`|Mtm@rmGYhoC_N_CgNn|A`Y~bG\"],[\"wjsp@eyvb@qEb}@{FkBiTy{BsL}xExOomCt[evAlOxYvI`sDeKzxF\"],[\"azxp@y`xd@vJksChLoa@|IdoAuI|tCiXxkB\"],[\"ujlf@icuWnGy@pAzLgEnuC}o@pvAaP`oCcKmRoKqoAlJ{c@qCyyA`Kug@lSaH|KkTfMuoA\"],[\"{kof@ghlWzDlc@uGwB\"],[\"}iof@waoWmO~cA{Kmn@nDgjC|U_a@fy@wJjGtEiD|j@ie@|l@\"],[\"ipuf@ak~TlH}FhExK|A||@yJxGkGeU\"],[\"}rwf@cgbUjA_^jQxxBmQllAoOyCkEiXhSwi@\"],[\"ueuh@ccd`@tJwG`TxOtG|z@aFlLwL[yNaWcPow@pAuDfGlY\"],[\"gevh@e~{_@`Iop@x_@bTtBvVeKv`@aWzC\"],[\"_k|h@u~|\\\\tE_n@aQ|~E\"],[\"ac_i@ajg]xA_K~LpaA\"],[\"oa_i@w{b`@lTkoAqGngB}IdU\"],[\"eu`i@}|k]eCeP~Phw@\"],[\"mnai@}up`@nAeWxKaCwHfbA\"],[\"q{ai@ydq]s@oNnRzaA\"],[\"{zji@yy_h@uI{w@bm@`mBsNntAq^nb@oKsQlM_[\"],[\"}ymi@__bh@r^sIds@vt@mF`|BqOdVcLaIsl@}|AmGudA\"],[\"}y}i@elxg@|E_R}Lz_Auj@jkByg@{o@dRu@xQyP\"],[\"ey`j@q~rg@rYoMeBz`@uPh^oz@pq@eq@pDqv@wWfUk_@|p@zNlq@kx@\"],[\"c`hj@gxsg@tt@lx@Nrm@ggA}v@qe@sn@lLkGpUfQ\"],[\"iloj@ckq_@~E_OcQv~Ak[lq@H_p@\"],[\"sinm@azh|ApIuHnJ`ClShdA}a@pgCyQ_h@wOoaCbBg]pNzG\"],[\"g_an@cxr~AjKeEfA~IwG~cBgPsc@\"],[\"uekn@}aew@dDa]tEDxMnmAcGzl@wPd[qHoo@\"],[\"}zwo@gv{b@rHwa@lJzaAcEhcAaTjj@gCna@AvnDw[ocFpAkRbItCzQep@\"],[\"ix|o@ocvc@`KaZpElVeDjoBwPt~@uFscB\"],[\"_a_p@yse`@`GkKbGlPrVaCtE~MdLn~BoC`XgX{Q{Hbc@sKvDuG_DuKus@\"],[\"_u_p@w}z`@pEyNdQbOx]rcDjDnbBoLnrAuJvF_IakCiInu@aXsnC\"],[\"_rap@e~p`@aN}_DdQ}NxJpCbIhrAcQ``B\"],[\"q|ap@ccn`@nG{AlNxm@hJnxCgFtsA_Imi@}Yma@wRyeAdB_|@\"],[\"oqbp@mvm`@a\\\\l{BsL{cDzQkiBvJkI~T|w@\"],[\"svpp@wvmc@`BmJhH?sPpzMiHm_A\"],[\"_krp@wz}c@lBmDxM~uAo\\\\xpJeKsvBnLglH\"],[\"ijxp@q_sb@cGfy@iSeuAeDwpC`Lua@hIbVrItjB\"],[\"qncYe|yoAlPaO{Rhm@}f@gOzTiO\"],[\"ojzY}qe\\\\rH{Hra@~_A}Efp@mFlAjCjVkJbHaTmV\"],[\"k`wZemh\\\\|J~B}OhR}y@hYef@BaJuWfpAaX\"],[\"kei`@}f~sAyMa_@`SvS{AtQ\"],[\"gtk`@w_jsAd@{^zFd[\"],[\"cbcc@ox}yAuHubAdStYtGeB_Ddj@\"],[\"cpmf@`tghBlJuLxFdBfJ`e@aDj}A{Nba@eJ}AaDeN\"],[\"er~h@g`ch@hIkOpJ|GWjd@{YxmAvGr]yEnYwL{iA\"],[\"mcqj@`ldjBxHyEvFt`@md@xpB\"],[\"sloi@s~xaB~ByGtP|Ld[cGbKxRZ`gAai@vt@cI_@\"],[\"kwqi@awyfB?_}Azt@rkBgCdUmHkA_JrfA\"],[\"m|ej@u`zq@ji@ueB~[|Nd\\\\|uAlWdAlb@hYpViB|QsZpVQbg@~fAnGtoAyi@yh@_\\\\`HaiBkQyUqd@ur@_N}R}N\"],[\"sczm@qcrz@tJbMgH~b@bT`aA{MtFaFfrDeK}iH\"],[\"atan@meju@rGov@xu@lgBlOe^nMjQ~Fpj@eDp_A}`@xgAmXlU_ZakA\"]]},\n" +
+	"{\"countryCode\":\"RW\",\"polygons\":[[\"lct@suuPoi@plAwn@gCgc@pX{PcLuk@bK}VoLaXqp@ye@gTsGar@kh@cd@sv@mL{dDbOkrBg_Agk@ak@sk@kWmEcz@}_Ag~@oJmiAiUeo@hJaXiZwXx\\\\a]`s@iInL}UoE{YyiAwsBgi@oJgJc\\\\{c@_c@e[aJoJuQgk@qKJ}rA`RvJ~KeJfVfAlHmZl_AcZ`[Mv]eo@lWu`Avr@[l~@}_@tFwHuD{MfLqC`gAxGhaAqGr\\\\hLdJwC~O~O~AuQzs@hEbB}Uvc@mTpdAnTt}AzJjUfn@mRlk@j\\\\d^n@pgAsd@vu@cPvHmD|Wr^jq@|A`U_Kna@zp@`_@xFrW_s@t_AsR~q@hxAh@fn@pLxsAfExq@ta@hHjWqCtc@l[nNcJz}@nW~fAuMdp@jMpt@{f@nLgWcKoSjPiR|@}g@vwBjAhc@|LdM\"]]},\n" +
+	"{\"countryCode\":\"SA\",\"polygons\":[[\"sibN}i_^zcAul@v]w\\\\dKm^tC{gAaIo_@}]ki@Xm`@tLoOcD_FdS`Ihe@qFiHqRsg@{`@kTsCx\\\\k_A~Pba@xh@f]~W|c@dYrQhd@dCrBdLlSCfEkh@aOmi@zFih@rYm\\\\pF_ZfoAmA|]uKhgUczQpeEwvp@ur@}q@zgL}qG~ZyEvve@~zKzoRzqy@tnFbtv@fSby@flGdaPnkL`eJvnEzpAlW~QfyAleD{DjvBgyDljC{KdV`_@dqF}u@rlP{`AdaC{Elb@cAhcI`Pxs@{GtaBpPjwB{G`f@je@vf@gN|q@bf@vg@bAvMoEdL}XpCXp`BspA|~AqTfw@q@jm@be@rcAdrAhuAhJwb@jUwQpKfAjYvx@dlArVzn@}If}@pMdn@wSnVah@tMx@rU`Xjl@eF_Dlz@dtAj]hXzg@fI`g@xd@jD|\\\\|bAw]bDkSoG_z@jh@mlAkDuAhJaTpHmLiD{s@zn@oLhWxAbLq[hTqZuGkr@dFmStRoFvg@uaA|UuOx`@je@aPtFrKzg@iEpJxE_kGle@}BlPwNbGyt@xiAqq@r\\\\UdXeg@|_@hCfLucArcAhDpLqNjV`AfMkOxD_~@b~@m]fAwBpKyMp@qb@~b@wJuDgd@z[uH{AiCxWqGwF_JlHpAxNe]eGkCjTgvBvU{|@`q@sJbPzGxKsJfSeaAvg@etAgWkVro@qg@tTypA_XmO|OyRnq@ic@vPuq@pAep@bq@qn@jJ{UmLa^`HqXfcAye@|m@rCgT{w@gA}s@d`@yIvw@vTq@_nApx@gNlVe_@uC}\\\\pv@ca@n_@oO`q@ql@zq@_HeC_Mfm@gp@rq@iCbWbKf`@_Ypv@t]oVgx@zoB_RtHgq@h|@iaAlp@eLaP_R~^e_@tIeU|ScXeGeGh]xKwKuBfKwO|ToYCjKiTsSnDgKjVpJ^kTf^oNzKiMa@nGwJoMfCmFrNzCdRyuA`d@w[tf@sc@bJ_NqGelA|v@uv@ow@_e@~BoHjHiPwDuS`I~MjMid@dP{fAhHcb@yRsF|ClQ~FhBpL}yBdhAss@lJ}J[wDoObS}OBeKooAeWuKk@jTxKeHfCc`AeLsgB__AsHlPf]pKvAhNcpB_F}mBzz@qYaPgg@di@ts@{Ne{@ti@q}@b_@fHgQph@iSfEyVoJqGgz@fk@}Ixp@_IbHqYxKwVyN_k@vBlE~KqrAtt@owDdx@pBdXnISbNsTqLra@m]P}_@vZcvAjYyQb`@eb@lT_Iz[oc@rd@mR|l@az@laAz@zSwk@~cAuO{\\\\tAfZo[iDtHbUdYmBEx_@iwAluBbEh^zSo@XrYiP`XdCjMg}@hy@aTbFqKeEzG}OmTa@ixDrmCse@Z@f]g_@xQvA}j@kNqVs^]mYuLiv@xPyc@}B{f@vHyzAptA{{@l[eF_Jwf@~c@s}Adr@uOda@gt@bg@]pLlSeDzBjFcd@dw@}|@bXmg@iZap@`EuVta@{L~_A{P|M}sAtf@wu@nh@ieBdm@snAlp@goApqAo~A`h@xAhJy{@v~@axA~bAs]aEwZ~PqhCfkCkv@t]}m@jEqaBdaAgN|Yym@xPwt@fo@k\\\\fr@{Mqf@iLrEkA`a@ke@tcAxBbS~G}EmHpSfUzj@gCdi@zGrG{PjAcGwMeAjQd[|RsCdGkTaPlKd]cG?{BbZoPjJnGzGmDbG~LrCpGdPlPgL?fLtVpF|@vLch@fCd@zRuJ^y\\\\u`@cIGxBcR}Q`DaDuJme@}BhCyGoGxGm^gXw{Bov@{tAxNqeBqd@ce@cAwEjIsf@iRyq@hA_sBob@kKmSgW|D{HkMc\\\\zB`dB{ySgEe`@cwDw}Fi_Fg|CewAmnMkImLyfEm`B}gBsoEy|HjxHimHhdIqyHmcf@knAmxBfqB{tVrwOuh`@vlJqhN`gXuxb@tuAap_@kSilBb~@erPtw@ce@jrAsa@|uA{Rtk@yo@pOeDkC}|MfXse@vs@gG|LnDsChMpGpCyKuh@nv@hGl~@c{@lj@~Ip~@a_@lWmvAbJtCvCpVtK|@ba@kk@~p@oi@nb@bDxX`Uym@oJ_H|Aje@|\\\\ca@yK}IbGbcA`T|A{FmPmKna@oAyK}Mh[yJoNwL|Yz@pA|IlPqGyEdNxEvIhCkWcPsQ`[|DjIoMeVSiFg`@n_@qt@nFcEpF`KvB{GeDuJaFnB|F{gBjf@}g@|SuCuApK_MzC~LbLgBla@rIxO{IbNzItVt[ou@_SwShGwMjThTf]|BePsa@rGgT`vAcEi@qIrNqBcFqLiNoAdH}XzXhHf@bRdF}CfIeSiToP~M~@hEyx@iY`UoGaPdHyPyK_Pba@lGdUeF`SoYbfAku@xz@ujBpKg~@bfAa{@xf@{v@`OcAe^jj@oE~i@a]xInMdJlXhB|ToMto@t@pn@yOdRiPtd@irAxp@sNzqAvAnRrQ{KdD`HdKxf@kAph@rIqEvHob@xFsPxWkY|CcPxSle@jb@df@sTfIvKtc@?u@kRuR}@bMiXtIm@fCoTt]oO`OlKf\\\\qEft@gZp_Ait@t\\\\mEqa@fRy[fr@zSoA|OaT_HqG~k@wVdrAefA`LmOcYtFeEcKn]_QxOie@nkAq]tj@v@xj@uR~iAeGlFsEaKwCdKeOdf@qa@hh@kKbSc[d}@yEr[qMvD}N\"],[\"}geIo}vXfkAzCu_@z`AaNwZ_TzRtQ|cAaCnK{n@`eAe_@pJaHd\\\\is@dLrVkk@lV_SnU_AlVcYgAuUhJmFwBac@pM_N{FoQiBpPyLfHeb@kOi@}GtLeY`]wF\"],[\"o}fIivqXy}@ls@aIa`@m\\\\kX_NvCsJtm@aIcHlXko@zv@oB~CcStLwDJtXbHjFrIuBfNwb@nU_C\"],[\"{euOkag]hTclA_B|q@lNdYqG`PoI}HeJdMpFhZhZrRgOr@qZw\\\\\"],[\"gnoNydrUrFsHe@zGrE_A_GhPaJ`Al@vLyLhFlCpK_OiBe@u\\\\jI_M\"],[\"omoNyopUlAaKl`@ne@_u@d~@s]zLxUo\\\\vSox@|CdIlNaB\"],[\"cuqN{unU{G~LgM}GxFwN\"],[\"e_qN{pmUac@jk@h@rVqGeEf@mTt~@a|AnK}A\"],[\"i_rN}uoUy[p@_FcIlGiGhId@k@lFbd@yD\"],[\"umrN}mkU`MrHm]n}@}AnLvGdC}a@|\\\\cc@q_@lXbL~IgDd`@_p@QqLbJgC\"],[\"cquNewhUak@b@sc@e]bg@bUtVoYpZzD~AeMvEpP\"]]},\n" +
+	"{\"countryCode\":\"SB\",\"polygons\":[[\"~edFyy}`Bxc@ap@dIrM?rXtTxKQxGkX~SfCdLcP~Yyh@vNwQlm@u`@jHgCdX`PxK?|XiTln@}GcDaGlJhDvc@ym@jc@cMiAiYiThTu]GwTrkAc|BfXqaA\"],[\"po{E}dp`BlCuTxOsGqXjn@\"],[\"jsgEc_vaBwXxMmNiGl[{VjOa@hErQ\"],[\"xkrEgkbbBaBuEnKyBvBfg@_VbjAlMb[sC~^y`@f}@hFbYiFno@}X`[dL~B{Gd]s_@dZq@`W}QrHsRp\\\\rBpLgi@~_@rCd]ye@yKoVpIdAtVoPuIkKtIkZoQeFrDyTrbA`Hvc@{K|c@_hAoH}@}z@d\\\\kaAjfA_gBJwR|WyTTcZhXwb@gGed@fCwi@rVq[A}]cR{NnDkQr_@}]|sAch@r\\\\yc@ll@sAnNzOpNeC\"],[\"~a`Ekb`eBaP~@vOcO\"],[\"`n~Dko~dB[}Ft@iAf@rA\"],[\"zk_EcszaBkTjPm{@lLmPiFoBoVfTzG\"],[\"~yvDwfoaBfCjKsq@|Kr\\\\ae@tgCgpA|xAkPfX~Gq{@|j@k@rLd[~Isg@b]odBfD_YqI\"],[\"hc_EmcdaBvQ^lIjOlPjfAvNtW{\\\\btAChn@cRpNcZxu@lDrsDsX`yA|Ef_@iu@beAec@dNuBzMwi@hLa`@r_@eT{NiXxKkl@?kGbHi_@{Iye@e}@jFuTn}@_pA`b@yTxIuRUck@_JwS`Koh@}K{\\\\nGol@oGyK?cgAd]_`@tGaXhe@wZaBeVx^wu@h[uS~j@eFs@yW`Sml@\"],[\"zcrDatp`BqYfNoOsP`YoF\"],[\"h{qDy}x`BaPuVuj@kM`@yIt\\\\yO|Kka@ba@z\\\\oOle@tO`R`ClZ{QjD\"],[\"j{oD_hd`B_TqXp_@zJV{JlNx@jV|c@\"],[\"j{oDm{u`BuI~IiIwe@yScCbG_e@tKaHwG{Tz_@cZvj@`VyStUMnFtK?qU|PnDdi@\"],[\"fcoDovc`BbBoPlq@|\\\\zGx\\\\uLrMxCbF_U|NmFsSgMz@{AaZwTxKlB}ReF_EhSwE\"],[\"jfoDmpu`B{DvF}e@yCwEkJt`@yKuBvG\"],[\"`riDule_BdRoTbLR~DrOem@diBsHy@tCqm@sLmK\"],[\"dkjD_lq_BxEmPtIxAhTrg@cX|SmhAca@\"],[\"dqeDq`n_B}Au[tLqQjVfCr@xQzNbRhCu`@`P`PbMeMrMjD`h@|h@pEvWaFfY{l@ro@cjA{KrIuV_IsEjGgCi]?_Paa@vZkA\"],[\"nofDel`_B?zKdl@lDto@ws@a@p_@eQz]wQ\\\\w\\\\rS}_@`o@}UgJmS}^iV}Je\\\\oc@tRk_@v`@aHzm@rV\"],[\"ldeDk|m`BtIyEbHvWu^pc@tBvL_NnQwVlFcZwKgBoo@rs@}E\"],[\"vybDsnibBcHhA|AeM\"],[\"~yzDmqnaBaVje@}x@da@iXrCah@fc@{e@vx@_Cf\\\\uIkBsRnPiGrZkRdAui@r~@_rAjl@qeBl\\\\_`Ala@{n@zBak@fSu^}g@cfAzi@ea@dc@wZvFkHsKjWm~@sHcPcQjTuGaEpEi`@r^w^l`@aKnf@m_@ziAkgArc@uBrQtd@lO{EfXfIfa@_EzUiQ}Dm\\\\zQqUlP?xEmZzWa]pNeJcBdG~JdGjMeSxk@cItKkVtTjOxKyPg@mN`Ms@fCsO|JjXls@qT_DbZjmCazAp}@eFzHpI\"],[\"|r`Dgz{~Axe@aPoIbQqk@pScDhXoGoGqTtXtE{o@\"],[\"n{`Dym}~AoKtTa`@bMsb@z_@hDmj@lJoK\"],[\"ri|Csuu~A~b@eRSdOcOpQgRsA\"],[\"nffDk~h_B~KmMrTr@}v@ze@}QfaAse@`l@meAbGjf@oJdO_S_NyIknArr@pG~~Bv^fO`FrLyK`j@{|@`AkNyKsCuk@mh@_E}u@eb@k_@mc@oKme@|\\\\k_A|{AuAp]wThUbBsFkaAxbAyx@hT?viAyi@h\\\\bOd[cB\"],[\"lt|C_yq~Af|@_B|PzPmbAr]cxAvBaE{IbPcP\"],[\"`u}Cmm{~ArAp^mYl_@}aApa@i`Aka@mNuv@bd@qp@vw@aRhg@Z|j@dSdG`LyMdL\"],[\"`xuCmsu~A~[vC`iArz@f\\\\kIpGnK}BrXoa@N_Gl\\\\ih@{@w^jZ{CbOuQnEdA~Je[pJa[mC}d@wh@?cXzpAscAkDeM\"],[\"x_nCmn}}ArX}\\\\zJ~KoCzv@_`@mT\"],[\"|bhCmhb~AjPa_@v^tIrNngAwTve@clAwe@\"],[\"zbfC_eh~AdGkT?rXna@lPwKhC|GhTmNeR_[|ByDqKe^dXoKw@iI}OfCeL~EhNnO`ErJiW\"],[\"rjbFmf{dBxSAkA_a@rWqBbAdwA{p@jn@gI?}N}Vs@gg@jKah@\"],[\"fk{EiatdBhNlMVzNg]EbJ`OqRfAgTaSVyPnOuM\"],[\"vzoEmaldBfRhHdY`p@}Dp_@pJri@~x@bl@u`@nLhMdXa@tQwMpGud@dAaZo]pTvA|JiNaa@yDy^i\\\\tAwgAyGcStNmc@w@cc@\"],[\"lssCylv_B|KaR|OkCkCdR}ObJjGpE}O`c@|S}G{Bzc@yKv@qVpYfCo[}JDwAkI\"],[\"pfeDcgq`BrWsXhFfGuKze@{|@jeA_UnFm^vl@_J~r@wc@~|@kKb}@gU~JyEbWsLpE|@~Ygp@dfA?lPmPzB{Bjn@g]fTzGnP_UvEiXfx@ua@pGoFxe@_HuCyKbLlGzIoe@bUgG~]sa@bj@qt@?ux@`pAuZ`At`@_yAkNgc@hSsLhImWqI_VfTiHnaAaaAvi@mhAjVt@vKsj@fZ_Q|HuXcHwXjCeXrB|FbJoGhEmUbLwE{FuItSuPt@iSgI{JxKkTeFwHt]eQxvDo_Gzd@tVnBr\\\\~SoNiBuUjb@yV\"],[\"tlrCgat_BwQdPyQ|k@gLw@l\\\\qw@eDgTvLaYlFdInLu@\"],[\"rroCgje_B|\\\\sCmKbPzK~EMhRmKyKwK~y@gL}S\"],[\"jwoC_nr_BdXjCqGjKvTrCiT`TMbLcZ_b@wA}J`P?\"],[\"~toCcqh_Bvg@gL?lp@sNxWeg@}Y\"],[\"ffnCcwc_BpVbArG``@t\\\\~NgJzB_MtgAuRzIyMqDjTdXkThn@tBrr@km@je@|GjYeRV}Yrt@yaEluC}[vw@mhAlsAui@`HkT{CkP{StCsd@nW_ThBme@njAwtAby@ycCbOgKp@}Qfs@so@z`AyH~x@}^{GwK`ReLxIw^t`@mMxLm`@nT}QkImDdEo^_Ich@\"],[\"lqnFub`fBnHoGtDtM\"],[\"npjEyeddBmD~LkMyDrIiM\"],[\"zbyCkl`aBlUsF@~H{VfN_HyH\"],[\"~yaFql|dBbNfBdHhTmPX\"],[\"pngEybndB`DePxVtYa{@Wv`@mA\"],[\"xdwC{op~A~NgEN`W\"]]},\n" +
+	"{\"countryCode\":\"SC\",\"polygons\":[[\"~y|Dskk[pOtDzAbL\"],[\"tu|Da{~[iH?kAoBpBy@\"],[\"rzhBwtp_@`EwC~Aq@|BK_BrAcBr@\"],[\"l`iAib~`@|K{BArCqEbC\"],[\"nwuDubk[xMqQd[bk@c@dm@lNpq@z@zg@wh@zOrPmMhEgw@q[ifArCkT{\\\\bL\"],[\"psuDm~f[oFzCaGmW`F}dA`Kx\\\\eGd]\"],[\"jnkCmqia@w_@iF{Q{P\"],[\"x_zAaay`@gLnTmPm^q]aIEgLfLuFnYpE~`@}k@zj@hFj`@cL_D`TaTxIm@rMmJ}AeBsMwQnG\"],[\"p{vAuhv`@lAlJiIvGsYr@hJqT\"],[\"hqsAof_a@kA~NuQfH{B_PfXsr@L`I|OjF\"],[\"lrgCube_@vEiBSjAo@V_A?\"],[\"tiqBgux`@fFRfA`@sAB\"],[\"`_zAuhx`@XeA`AuAp@aA\\\\PY|@M`Bk@x@o@\\\\\"],[\"zdzAk_y`@Pk@j@gA`Ag@|@Pb@HFX{AnAm@r@g@Ca@XYO\"],[\"`lyAo_{`@tEsAiBrG\"],[\"jgyAca{`@D]j@q@PaAn@Yn@`@Wt@k@`As@b@\"],[\"nzxAe}z`@CiIxG~I\"],[\"frrAac_a@i@iRzGhE\"],[\"bpsAkd~`@TcA~ANLfBwAF\"],[\"t{sAkz}`@d@e@~Ak@?z@oAvA\"],[\"dfqAuf~`@NiAZaBr@bAMrCUfBs@U\"],[\"|usA{maa@dPyNbJvHwE~H\"],[\"jisA{dba@TaBnE_EzC]\\\\tBoBfByDdC\"],[\"~srAocba@DcA~BU|By@DhA{ChA\"],[\"xssA_eca@z@_BtBTdCTc@jAuCT\"],[\"tlxAqqca@tFgE_BtI\"]]},\n" +
+	"{\"countryCode\":\"SD\",\"polygons\":[[\"ogtEeo}L{hDyw@md@a@wyAhWqF~GbBhoAmW|@{MbOiZxfAeTjUyv@r^}\\\\zA}sFan@nYl_B}rAeO}qCnn@qf@~To{Aql@o^fgAer@fmA`l@~d@`LzVzJzt@aDhs@_V|a@khAdk@_eAgZkwBmdAeYo_@i`@mlAewAwnAaYgDmv@vd@egAhKkc@zf@}w@~Jq^tVemBivAmqBinEqq@`HyKjm@uM|P{XxE{WqS{lAhOul@je@gUwJk^}r@id@_cConAbOwXaDgYgb@gq@wMibAucAuNmb@sv@y_@ojBoJkbAnj@od@@wj@c|@ay@iw@kDcl@vRc_BkC_n@}d@azBfJwrCfWwgAT}OyQkFknrA|@eCooRy|e@MR_lyBaxBmdAiW{f@dFoVlYiRbnBdb@?_ba@|qDklFmc@{pGqmCet@Cstu@hKpBdErVvQ{SnjAMztAcMjPpDdrBc{@htB}oAnn@kt@fyAgfA~UrDhHn_@_x@df@[oKfZmXLyL}l@nYaQba@lD|Hek@rEoB~OnIfIzjA_Gl[rRh}@ae@pxBsLjpByc@n{@nBjB`X`GbA|}@jApnCc^laApUllCyn@|r@d@h]tNlp@mB`~@gYfdEy\\\\rRaPtOnBdSgNv`Cea@bzA}`AuNisArQ{Of@kWdYqNdWtCgF}OjNgPbRmeAllAmeAz\\\\is@~QhWvThCfb@ePnYa]jBqy@aVsBaOka@pEyBjMnSjR`AdNxc@{B{\\\\xSmdA_ToHxi@k_@jGzDhFcYwEaIhi@yKz@kKoHaEqYzHfEqIxXaYnf@cK~kAjyA|vB~u@xYfPj`@lu@|d@~B`InIbAxM{Tt]hFtXj_@dFtGtRkc@hBiEfSx_@lx@d`@z^[d\\\\uSl^fJzT`n@dZvk@fkCbn@_Eze@xE|p@xTng@fb@{Nny@dRvn@bDb}@cb@lrAhEzOjf@uM``@`QrSaMtk@uDhtApW|i@ni@`lA|Htz@wb@rb@lBvr@iOjy@vi@bxE~mAnfAhv@vvB|`@tt@_Fr_@t_@|yAt^pfAj}@t~Oi~@nvD|y@r|AiO`yAjd@zn@lCrsCpaBvlEl{@r_@cG|[dUbo@{PtsAbK`ObNmLjj@fc@|tEha@pHdQta@r_EptBvi@dIz_@vc@lkAtUn_@fh@bYpBxSxQ`Tpv@nZv`@dx@`SnnBiSzwDnoAvh@{WzpC`k@~]}Zhc@hN`c@bl@pc@lMK~WzX~NrApJkd@hAsvA|lBp_Av_BpeCxvA~n@`AtdDa^pf@`Nf\\\\a@tu@~q@lwEhlAx{ApRzn@kAMbiBmZdKmjAq@ww@cLcyAwi@mp@vIyeAuEonAb^{eF~vGu~@xu@gy@xuCc`AiYowMx|@ku@w]izC{F{wBgc@mr@{BsBjiHls@xB~oAcLx@h~KjxDieD`pAeFdeDzEfi@sHbrAok@nN|ApRjI`vFneHtqBdp@d`Dr{BtfGvkGtP~j@bHrkEtNxk@yyClnDqyDveHvcCnwFvxA\\\\tl@b\\\\xp@rs@p^doCdZ`jAhg@vkAjm@xz@l~@dt@j_AmHiCdrNm`@~\\\\qbCv~@pFlmAmQ~kGvBv`DdpAhpFkAzi@{W~i@aqGzjF_Q`o@isAjx@sc@z`AgiBjFsi@tg@h_AdxHqBbz@bUj`BhiAld@nn@mFzlA|_@|n@~e@zk@lhApyDlAzzAdeAvyBtKtmBz}@veAPvPjg@xG`fAtr@dvAfZ~b@zP|FxCdb@iXbaEpKhr@aS`mAjSdk@mRfUw^zCk}@qk@gx@uGqLrCO|IrUdVj@bPqHtO}ZxH{}AcWcH_n@ux@ur@kf@nEws@iNeMlO}v@tGwfAkf@}VaB_kBfQkgJ~{EglBrpBel@z_Ac_C~wA\"],[\"kivKyyvUzLkJth@hFdIzI{`@zE\"]]},\n" +
+	"{\"countryCode\":\"SE\",\"polygons\":[[\"wjci@oxqKxa@_sDpGsMfJDtLd\\\\vK|@pFgLvN_gBr_@iyAje@ov@hCc~@ve@wb@hi@g}CpRuKhKypB~w@s~APioCxCoi@|GiCtOspDdY_u@{Did@lc@sz@|[_eBpl@ev@vo@rBzIcIwMawA~y@yn@~}@omD`VhOtKv_AzP~^rnAaAz|Aaj@~R`q@nFaF|b@j`@`Yu[cEkhA|SsiBdm@rGlDmTz\\\\l^jQ|qA`U~EzEsMrXpXlKqCna@it@rWiE~XgZzXg{@du@}gAfs@ck@xGng@bXf\\\\jg@wE`a@hLjUaFhS`Fl`AlnC`MhEll@uTl\\\\zGtcAke@tQke@vHy_Adv@it@|Mc[xf@iUhVH|aA{bAhJDjJfMgBfbApGyKhCb{@|PbRgGrXiLnGd@hw@uUlYOv]dTxh@mGxH{BdlAuGaCeAxKlR`]dE{HzOxDeEdZu\\\\tExOzs@oRpy@rCrFpQkCfLg\\\\lMzv@fFjKzJWlErOq@bNyK_D}SpXyGt~@sMxYt@pXui@zm@qZrqA~@tJhT{\\\\~h@_J~[nUn@vHkMaBuFtNoBhc@dLlc@wTyKcGnGyKh]qGsCkCzk@xOvp@`P}XlFx@wAj[rX}X`OrTaO~gA|NkXbK_eAjZkOtCf]eNjEsF|\\\\hD|MjFqEbIpE|SaPrCxz@zFsdAoKqJ}SjTwAyMbNyI~Eaa@rQl\\\\vNyo@vQ_JlCxMw[npA|AbNzGuB}@tPg[rmBiNd[yUz~A{]jv@rXwM`Oqd@~dA}|EpGtcAbL~OcLvcCt[om@mBaMeG|P[{NnYys@pXfTaPra@~X?xC`HaHf\\\\pJtw@_Hts@j@~IzG}GP`\\\\cLjj@zFlYpGoa@]`dAzH|JfCkKnGpXbWyy@mHqe@z\\\\s{@tS~SnFh[aC~o@kc@fa@ai@~xAf@xSfCyKzFhGZ`YhGjGlPqaBlvAktB`LqDrEnm@hHoJrWzJtGiYhCraAfCrFhTuUpGdXiCrXnPjWjK}EfCfIhC~rApRcApMvZpOkErJ|W~IkDdGti@wVlZnPxRvM{\\\\zG|B~DmJoAejAbLev@~GaCdB~FT|j@f]}r@xFvMdLcIai@rcB`A~M|QlDdPgQvEex@tIoJZrJzKkGdFkd@rXg]aW}Kl[_r@oNiFlAmJnWwH|Du^rGpXeP|\\\\tOxn@oKvL`ExIvOm_@cEao@nPqGjOhE`CuFoJo[fCsX}GzB?mGnP{K~Qnq@bx@h~AaTda@t]}G|Cpv@vt@hnAsBxVlo@deA`KhJ`UsBdYdYfj@rHjW~k@`n@z\\\\tO~~@pK}@FmRpSlTqSxe@h\\\\tj@uBjFgK{CgL`Ub^v\\\\zAjYpa@uCnRvOaDvWuUkCyPtUf}@zIcIfX}e@oItT|Vp\\\\`AfDxkAwLjPxC~b@dMrMvRcK{I|x@nIv^tQbMp]ndAtf@yHlQ~q@oGz\\\\{Sn@uC`N}G{B_e@`kBvFdO|Gg]xNzGlM_j@pIve@z`@oa@ok@niBrXoO`BfLqOjc@zMtI`fAnI`A~NoIvAjTb{@|GwKpX|X?eLhIfI}M`d@lL|IxFiIhCwa@|IpZnMnHcv@fzAi@bT`I}B~@dG_ExfA~B~HrXinBpKt^Ffh@pGiCtD~QiWrKmFkk@{BdlAti@oa@wKlvBjRcp@vSeFw@~{@rXfT?gTvKfc@xT~KcUnm@~CxR|Swe@yBbh@aKvNzNxJ|Gpr@|CuoD|Su_@eHlS|MlWwHn~@fKtErIk\\\\bAcs@|KpEkCfeAjTom@bXp{@_Bj`@gY|a@CjKzGiC?f]vIgGpIfGyBin@pYjWnLj]{FrFgJgJiAbHzWr[aMdqAuIsNpBsu@iIoNkHte@qJjC~\\\\lcAwDhSuh@`M_SkDzAtYoZbNcN~ZyKeLxK~i@}Gvi@dl@i_Bfr@{`@hNfIdSqk@~Jd@|`@{\\\\t]xDyE~jAzZcjB~QcL`U|z@a_@hHkAfIjh@fPdDdRnGyGrV`^aIxbAbPhn@rIO~My\\\\_C~yAy{@jsAlB|KdNrEvMlg@tHB|Vi\\\\lMQfCrX~GmMdTHjM{K`F}l@vTkTnGpPrXiwB~QtbA_RuN{@rTjIlOba@?n\\\\hT|RXhIdOvBqLfUxYpMoTxEvi@~QxBdCpRrJhEn^oKjHdL~MmEvMfIr@si@xKfTtFoIdh@wEsFms@~KmRbMsB`b@xMuArf@qj@bk@sDxRpXuK_BpVmMfEiIzUmAjoAtTspAhP|W~B`f@~G_PzBb{@`Pe}AiCveBxK{e@bGzv@fLkpApEnaAvMyt@nG`Y|G}\\\\pF{AxLtNxM{IiC}^xG`A|Ix^}KnQpVjS|e@c|@lMmm@}GrlAlK_HdL_r@WvYgSlbA`[}r@lXgSlR~d@dGsT|XjPnOaNhDdVvJ{`@zUnRnOcJdGjPrCwv@rKbq@jZ|D`Hi\\\\pTiFZmQrb@mUbD`NtIWr@iM|RQ|G{_@pm@b|Ag@qk@f[saAnGpXzF{HqNerCvWms@bNla@|i@oT`FgSpBik@uU~Gs^sv@iHa_AvFeb@vTyKpXlPn\\\\ypA`_@a[nRiiAbPna@lPmPmPme@zBgqArCkKlP?xKuOfCx\\\\xOsuAbLsWtEtX_S|dBke@hs@`UwGrm@ahAeLna@dWaPrV{t@oJoYb[_@na@oPoT}FeT|FyByl@|Sks@tg@eE|Ze_@go@dZaQ_GaC{^x]wCtjAcp@bU__A|QsOKeFwKfCzBwTbf@hAYvSiz@|{AvZ}ZvSbCx]`f@jFzaA~L|KcIq_E~F_Z`MtEfL`WxDnp@kSdZzQzSf]rfBzMxK?g]|C~BpK~T}GfXtQqOzL`\\\\v]l`Bfc@h`AS`dAvKiThCda@pGkTrGrBjDcIlB_[hFbRsEfiAgHhQ?{\\\\}PtCqChJ~GlUyEbSxH~P`RuG`FrSaFfb@ti@wtAb@|^fF?sCm~@~G_N{YiTeGsf@~Bwk@vHic@pGbL|@aZsEkF{\\\\hT?{\\\\qX~i@j@cc@jJo_@xKsCzKui@vFdFqBnb@hLkv@jIuGvLtOfEx}@yGhc@rBn{@}BpOgLgCbFj`@bErHhTsXCbTxIjAd\\\\crAjJaHiVteAz^{l@}B_KxKpGqBeVdRlBh@fq@fLn`@xFgT`P`lAyKaa@gCpGyFqGTzR`O`t@dUpY[b`@dLqC}B~U|BzExH}Hdd@zb@pX?fCle@da@hC_IrMiYbDuD`\\\\iStXv_@uQxLdQmQkDaD`Tsd@zCktAaPzBnUne@|LoP|Zs^kPmVtThT{GrXve@pe@cAvGuIp_@hNxDzQpGkTnHdEfE}NxMhP}Cjf@rXea@fCzTsXvbAnNsL`a@|JdI}ViCh}AdPkTbHgBfGzJn@`r@hJ?Y`fAoSdn@nGtc@pGqa@`PbLnG_s@jU|_@kNxhA`IbLZy\\\\rOkT|HvdAmZpnL|IsMxIj@?hn@rJkaAoMk]pJoVbQaIcBmLe_@cRtMwo@k@yhAdTgeAeLfCfC{\\\\pT`AhGuZbGnG?aPpIbE~VgaAnGb{@~GqDhTj`Asb@x~DhD~Prj@cvDt\\\\yDgDi_@`QiD{GrxAtTui@|I\\\\e@{PwHbCwAep@fJdE?lPxKfCfCz\\\\~Sc{@tCpGxQ_SdPSDfTvImLvRjSkl@zbBrVaa@zI_H?vOlf@{n@fBfLpKt@RdO|Sui@jEjf@tIgDqXpa@`NtCdN}L?wOpPqKcEcQ`OyBlRsXqGna@rX}XtAj[cUhXdPrFaApd@iTfCtCqXmo@zoAfH~KrGiErTmt@|GxKbPy@gLhx@vK}BsCvOt}AayCmHxk@yl@hy@ya@nkAhQgK`X{w@dv@osArCdGlAeWhMaN?rL|GqGgLbpAzY{Y~A|UnSo\\\\pUhi@iThC?bUjKqLfLpLnGqLzB~OzDkfAft@kd@{AlQjI}FbMhWnLqWhIh\\\\jJwBa@ps@zL~FpGwTbJzL|DsHlNno@~J`IhS{NhHxPt^Rf^yZvI{YvMuIpBlHlU_QgCh]qGlC`ObPoFxd@xK_TxS`Wj]wSzVxRtZcAhi@zRbI{KiHwTvKeDpGbdArVmIjg@zBfEvQoFhX`Lfa@`TxNzj@vGZtPphAds@teBpb@fq@jaAdv@fq@oKjf@c`@sVj@pz@kVzr@hT`b@{GbIeQuKeBbEjSly@hN`GoPnn@dW~V}B|j@{BaPwT`PdUh_AyHxVtChe@aP|IhCnXlP}GaKvz@pDv`AjI[~A`KyNxa@D`^rKlW|QdA~t@mt@vPt_@eHbEtBzy@kGuF_TxK|GdL}GdG~Ixk@tf@`w@xMhe@nVlMb`@jv@zXjOvz@|Fhc@uo@zZwEno@{r@vTtJziAvgB?bhAqZjgBpGha@x@|cClQn]Fjo@nYfkAJ`~@ec@h{D|KnzA}InAwTcR`I}j@aIqUaKr@|BaMa}@ri@yRmFeb@acA}NiKyS|@oTbZd@dViKjDaA~Y_H`FoB{KcLgCgZ|F{_@l`AucAbj@cnAx|Aa~A~{@eQf`@l\\\\yxBvTqc@qGqcAoYiHgHt[mh@h_@gd@zcAoUaUqFob@nNwiA}Fob@__@aZkXS__Abf@p@nvAkSl_@uTvHkUj]uVbLsSgAkk@loA{RteAmw@tH_Qpj@c^|_@sH{J{WvScVjCcAd]eNkYaNfw@{Boa@uCje@}Tq_@kN|@qTlWaCna@aZyc@?f]sI{Rs\\\\nSlN|_@tu@fP}IfC`AbWwO_FgC|XsDmFue@`DkNqVqGrTuCcLcGlPsH_H{q@P{BhKhKpQaa@fSeL_EkAqg@_I{G|Dro@uKj|@~IqAo@~[wMQcd@a`AcArc@_`@rg@c@`ReLw\\\\}KlEkEqGuEo_@cGpGqGyKeElQgs@uf@i\\\\tIwIgJqGtC}@kNgJoEkl@_GkMg]cEtVgn@xYcRem@{FvFvJrf@Idq@xGPnBf\\\\hb@d|@iCna@zKqGuB`TeVj@k`@yVaa@eq@iC|G{EwGcIa]oHxIxBxMzQdXye@bUzf@Znf@hQvk@|mAsXo\\\\r@rZoHrHsJyIaBu\\\\yL`OeNWjXl`@\\\\lNm{@{o@|m@dw@`@jq@jOtLcCvEkl@lFaAg^qKtCiPkYsFnUaO~J{\\\\uIcNeR?xKaPnGaPgC}Zf]_QsCsEjX_w@_b@eU`@yKhTmc@?mRzv@sVgn@u@be@yGzC}k@cp@cKsnA|CaTbl@mWtPuZbRmHhg@V`Fmz@iDig@yO_`@qjBqq@}cA}Qo\\\\qUidAhPa{BraA_]p@{_@}~A}`@_j@mk@aBi`@|c@gTcCoQ{x@fA}|Bud@yzAqkAkwBiq@}Xc}ArSwf@{t@aZcPsUqCed@jJ_xA|dAo{@|`AwyChlAs\\\\q`Dx@_wBc{@cU{s@{}@skAg]kL|E_mBpcDaUhzBaxAf~Cg}Hk`Bq_@~@szDljCc{Asg@qkBhf@yy@{oAsrD|nC{rBcmCklA|]yc@ykAczD}qF{T{l@ci@}lCaSi|Czq@igMubBwqBwUoGkrCr[aNtJgO~`B}x@xxDqwHm`Ls`Bmj@so@yrAszAlAwrCm^i~@cv@{hEvbAmPq_I_kAyjGeh@C_}Aza@sbA_wCasDyeFi\\\\iu@o~@ejDmI_Ig{A}LajCfaE_m@o`@oV}yC{M_a@}v@qmAa{C{kBmxAgpGwg@k~@vqAuuJ_sBqxCcXuLczB``@ykAyKyIwHwVgdDll@gaCwA}tFb`BamPqGqp@}eAgrC{f@hpD_h@ouBu_@_t@oaA{~@mWaJ}`ArH_eAftC\"],[\"qo~a@ymmIzGr]jT|SrZeN~VnIfChL|hAfi@tNve@xKaUf]bf@b`@kFbKpN|_@fIp\\\\tl@`~Btt@bTr[dU[hgCv{@ph@l^nL~e@aF|Haj@pGk`AoH}a@dMwt@uCqXyKerD}|ByR_dA{dCeaBos@mLyPy]em@qCo`@gv@nI{OcFyL\"],[\"mfib@c{rJhYc[nJr|@`VbBsFpS~QjIdMwD_BzrAlN_Avr@n[fKo[h}@p]hEeHxNx@v@}QvKpGbEmiAb\\\\{Ic@pi@jJlb@`h@tjApGnG|JiCbAgVrR_AbNrkAxDzOpGoG|_@hjBvHzK?_j@pRdz@hGnHf_@fC~O{e@?ra@|GqG~Xh\\\\pSfbAgCvg@eg@wg@qRz@}Mmg@__@kNfSxh@eB~LmQcNyJtEmKoG}EtYct@|NgQvd@wVcIsTm^}\\\\iGyPrS_q@lSsd@eHwlA_zBspAiuAsUku@|@sc@oo@cj@oAmg@xQyObMzArWgW{NoC_M{SaJ{CaL`Hy@uG{Bwe@xMpH|D_K_Ncp@\"],[\"ijkb@slwJ`G_h@lKpYoDb]pOtt@`JxLnPwKzSdRyFd[s[x\\\\qk@me@qGqlAhIuA\"],[\"q}lb@wgdF~d@hC~ApLqJWiAdIhd@xZjK|a@uJxh@a\\\\rF}@o_@uDve@mHxCcMwOfBksA\"],[\"_ipb@kreFl`Al@dPh{@e@pp@kMfZbIb_@`L~IaLv_@tTzBqRlEgPnXuQ_Mk_@w|@gNcm@?me@}\\\\gCyBiIxBw_@zL{E\"],[\"w~rb@iqxJnNzq@mFrUeXlO\"],[\"mkdc@_~fJyKxIyKvi@TchA|Wc}AcGb{@jQeIu@q[tJpO\"],[\"mgyc@yhiJhKoI_f@|eBguArMf@ePpXyKzLqWlFgDcGba@jo@mg@nEoMqFwL\"],[\"wm|e@o_vKgQaO`AyWjK{HhKh\\\\mID\"],[\"_gac@k`hJtt@zk@~A`VwJdS}_@_RiW_x@\"],[\"olcc@kplJhAkTrPlw@}RoR\"],[\"ibec@ccnJnBsGdVfUkEx_@cS{T\"],[\"u~ec@owkJiPvV_Ami@pWwXfSnj@\"],[\"szfc@us`JlJcxANj[xGqJlElEPb\\\\cItY\"],[\"qegc@}foJrDoHpArL\"],[\"_`gc@_~pJvK|AeP|V\"],[\"gygc@sspJvO|RyIxSgLwV\"],[\"ufic@kkkJjKze@fRsC{CpMvSo@fDhN_e@t[yFgPnGaKeGsKmAlQ}E{@eUsc@fB{oA\"],[\"evkc@atqJ~DiC`BjI}@hXpWzLObYkF`Bi`@op@\"],[\"uakc@}foJvP|{@ss@ugB\"],[\"cdoc@u_rJnH]~CbOqCzt@sj@{l@fG_Q\"],[\"{uvc@oulJmKlBpCsTj]s`@dBpr@}O`F\"],[\"w_ga@yvqHg@oL|FfOrRpA}Q`a@aKwO\"],[\"kwqb@ulhI~MwFdBxR}PhH\"],[\"qirb@g|hIu@cP`KfVoE~`@{_@jYsKqm@pIhDd@~LzDoJbIjI\"],[\"uv~b@}ydJ}CgUfU`i@`HsOvHvkA{SoS\"],[\"ap_c@}lbJzH`DqEvZ`UTaEp[dJf]qe@a`@e@ueAdGtV\"],[\"{e_c@azxIzx@`MiJ|Uc[zMgbAmPdLqT\"],[\"}jge@}~`JbA`|@eL`Lq]yTrCgiAnV~FzCvQ\"],[\"kpze@isvKfE|BhDhDdCdGJtI}Da@wMcVRuA\"],[\"ie|e@idwKyKo^rk@zNpKlc@ad@aI\"],[\"ql}f@attLvGm@rEtTQpw@{MgLvFiOiI_W\"],[\"_}|f@_uoL}V|BoBuOhDsUvHfAlOsO\"],[\"ez~f@yhxLfIcKkJhe@\"],[\"wl~f@w}pLkR~RyBwW|WuqAvFfOqLt`@|TY\"],[\"g|_g@g~wL}Bsf@~TnZ}Lti@\"],[\"wc`g@kywLsExHwCkX|F{D\"],[\"uobg@un_M~H_MjEbNjM{Ei`@`w@sIoAyAkZ\"],[\"agcg@gxoMdUxFlL~[sNnb@uOaDvFoH}HoDrEaX\"],[\"_nbg@ed{LgIhHgX{KpKoZrU{K\"]]},\n" +
+	"{\"countryCode\":\"SG\",\"polygons\":[[\"mdZonm~@pIsYxWxPri@~sA_@x]gQpTkCbw@kJpNwu@cZeKiNaJalA\"]]},\n" +
+	"{\"countryCode\":\"SH\",\"polygons\":[[\"~`sW~z`EnVkRdFrQih@jfAkNwIiBcd@\"],[\"x_sUbkoF}GuHbAi\\\\pRq`@tc@ht@a[tc@\"],[\"`vuHbwnBgCma@f\\\\eIdRvF|NnW{GnGhMhRoB`TaGxDi^cK\"],[\"|~xCtkwG~d@me@fQta@O`b@uT`Fe^}O\"]]},\n" +
+	"{\"countryCode\":\"SI\",\"polygons\":[[\"c{vZymiGkQj`@iQaCgLbPoGerBeXtXhD_tAm\\\\i\\\\_JtUwy@xp@ch@jzBeLpHkTaAu~@m`@{JpIfJh{@kWv\\\\_KsP_KxCgLgM{c@qdA}U{PuJvCiA~o@qWtw@gBl[`JcHx@`Pmr@~Zwf@_j@oT}z@}`@{j@}Dio@gi@uMxAybBxS_kAq@ii@tZm}@Nk_DnNwZmAa_@zWyV{a@c^iNew@_TqQyGip@az@se@oK_s@~K_TyUgV_G_c@nAyjEjRsm@sGi]eZwTsDsq@}UsDbH}x@mLgkAdSetAlLqQkMh@y]pZoo@]kYgkAWgnBjNi`@vf@|OhS_IdP}YnIEhFs\\\\xJmBzNhYtG_Dts@wlA|YaHi[jaBlRvy@fVzQ`m@wI~IkPzG|@bAjs@qN|\\\\bLtVkA~\\\\f[_@|SfX`Zp|B|Zxi@|@vcAlXdUl`@~GxY_YnTqh@zYzMni@[vUbIl\\\\mIlLf^dBflBzWzLj_@xkB|HpAdPaSyA{\\\\vZ{Y`Hvn@~M~Pdp@qPrXmg@fQrUfMdsBe^|r@Qn]iT~s@HjJzZfMzAvs@qP~HmXleAcRYkWth@cg@zLvk@lr@bk@pQz\\\\`{@cKh_BpMrmAeXrjAdLnEbJeLh_@ny@sDdj@cRzd@\"]]},\n" +
+	"{\"countryCode\":\"SJ\",\"polygons\":[[\"icmj@rozClJaNrKNnRnj@nd@eO|FjMtj@bvFqG`{@jJjkAbeAf`E|T`c@_@dy@mKoGsY`_@wCgk@m^mpA_GumAgXa|@yP}lB_}@kiAeTal@cCmuAyMeg@\"],[\"wqnl@efuJ`[utAdVvGzNb\\\\dKd@pMl\\\\fCqCnLzjA{r@baC{\\\\hn@eOeRqF}`@\"],[\"ahym@_spN?__ApG?lpA~aFdGuCfLlZjUvrAhQpPzL_GhC|GmBjm@uSgNeh@inA_y@kaDo\\\\{i@uCfC\"],[\"e`on@o`|Mni@dnBrXpJvdAzpCp{@z~@b`@taB`p@zlFl^rt@hU{K~Oba@nOrjIoOjjDuKpPmKtE_SafAy[xKiSvj@sI_Dia@}oBmCil@ke@k_AtHvhBfOvQgBzNoQ_`@}JjXqEpiAhMv_Bv^p}@zSfcOtRviDsIzlCk[gg@iOvj@gCcLcB`FWrVuCqa@wKnGsXcuEkGwK{TpCoGeLme@qEye@_lBwNs@sUejAuHcD}N|P{DuJ{E}`AsOtWwKx}C}O|lAiy@bbFgG|@_X_gAil@kwL~EetL}QeuAiO}lGbb@aaFbYexAbPb{@fJS~CvNnIzwCv^p^rQiJnLs\\\\lQgzCxt@caDjJ{bEgYiqAcJuoCd\\\\o~@\"],[\"}uvn@esyKsCrlC~Sh|@Uv_@}FdKwFqd@sCxKgCi]qKt@cL_p@wQjh@yEh_DkOsh@eB_vAkNqCmK~Kw]l_C}Tv{DmKmQie@emLlFc|A{E{f@iPqYqGxKiO_l@nDaxC|[un@gSilB`@giAfMuSaEs_@rNae@wUmmAzC_Pxe@kXpWg_AxM~AxNzTrNsQrSQxG_J~c@vHbOoD|GkT~h@hsV_Cr^\"],[\"ya`o@_wnOzK|l@vK{ArR|dCaJ~[gLgCuA|E{W`iCkMpCuY~e@cSo[[okBfr@{aB\"],[\"cawn@gdlFhOqKhF~LqMjv@}EvbBoX`p@uJqUeH\\\\iwAjzHjEviCu[pr@wTfUeQjBcCrQia@p_@oa@bpAyKyKit@p`DuInMcSc]kMdCo\\\\pr@oJkn@zQgpD|Zo`B`WdDxKelA~X_j@pXdlAxQmy@bRsDrLscAdNbItL_YjUjWkKqtBpX{ZvM_o@tVgvBm@qeArFoPnNbNzHgGh}@qcCrLvA\"],[\"sbdo@k~}PwHqlC`M}XvTdkFbG{By@v|@sc@hdFjTpwHla@jeDcLhbD_R{tB}ZijAs@adCqMmg@aMhEv@mfFjSc_CbPik@\"],[\"_cfo@ohjQ?ytCpGkPzNp|BeGhZ\"],[\"qkho@ypgKa@qeF|WcxDdRki@hQvpA~Cp}BnMtvA_RjfAf@vjAoJbp@_Kww@gPjNoQwQ\"],[\"yimo@ewfK|MajAvIhToKbcA~JjoEqE~XsQiL}Gda@oGgnB\"],[\"ywso@invExKvOEgX|KmP}Btk@lK~o@d@b_AqBfY{QzXiCucAmPttA{BqcB\"],[\"omto@mipEeKxk@kL}h@|@k`BrVqxAjEjP\"],[\"y{~n@ouiKhDhaB`ObLuKdfDtYroBpTgKfSgp@v\\\\bmL`HnRrm@k`AtT|HjHhb@~[vWl_@wpAfPnY`Cfy@xIbQdn@anBpm@lrDsOtmCjChw@zO`u@pXc{@fKdOxLh`AbNvCxDhTtTi]lGyb@tr@oJjx@f~@r_@@z\\\\bk@bPoPtTxEvQ`aAo@tpB}Kl_@jKzzAsBfh@rBdKrO}C|GhRhC~p@xKiTbEfCn]fc@b[baAlh@sFbe@|n@bZuMtThn@~MjCtIfTtEmItIdEsCbuC`IeIhd@kbCl`AzqCvKnSjJcC~KfnA`LjItYsfCdSag@xL`D`Or~@xKqG`I~x@pTzJvSbqB_FxgCiBdOsMTyH~qAf^lu@wM|\\\\wKqXiJvFiMwFqGx\\\\eK{EyI`I_`@~tEySxd@}b@v{BsNnoBsQgO}Mq{ByLw\\\\hAwu@_QusBx@}uAhGoYdHkB|CtHvBcLsFov@iOtFoFaFHuuAaJce@tChhDuTzfBoKtPqL?cIjTpAh_@bK|JqCx}@vHnBnSid@bBvNbH`zAuC~uFyWrwB_LhQoIqHcE`w@{KqOsJ`Uk^xkGfAldByIhm@e[ff@_V}EqGneBsC~JqIF}QgOce@bvBqHfHeGcL{GbL?uz@o\\\\htAwTpCiTcfB}IubFbG}q@bWom@bPvi@bHqKg@eeByJc^cUiGeJqfDfLg}@tGs{LbVgzAl_@mmAb@k{@yNxP?}gAiLfWyOhvCgg@fqB{VhxQo\\\\pxAsJyhAa@_lBuJor@ij@_~TuNey@qGmnIrQa~CiXvvCma@vx@sFsNrFus@mPc{@mKhToGm\\\\pObyCv[|o@bR~oP}ZfoCtMnxC~g@rgCvLneGgNp~@lFp\\\\nGicAdE~jBc@|yBgNnt@dg@pgCkNtk@bF|u@oItd@gJpJsCwa@iOs\\\\aOpUeMbsAsZgKah@`XiTaXye@~q@sXelDVex@~NghAlj@qpBtTgR\\\\uYcImQsWnG_d@~kA}GgEmMetN{KrXct@m{DgIikAUqvApNatA_KsUoMrz@sRpZoN}^mQc_BfCiaAgPk}BrM_vBoFupKoe@{pEuJaN}Dv|@vb@vwEaLljC}HrXyMjI~FlbBmKtq@gRi|@ua@s_@s_@igBqYuk@aH{bAyNa@sBxWtCfcBgNfJqMjb@hi@ttBlGhDxIqHb`@ja@l\\\\hkDhOuBlFt[dNp~IeOr{@kc@xq@gU~JeFt]{KeXeCehBqVwf@yk@aTwe@`m@yKme@sYt`Aph@f{AvZvTxKoPnGxKbDwJjKfBbGsXfJ`~B`R`VdCyK~Fvc@g\\\\jpBaYe]y\\\\rT~@psAfXd`AfFbpAxKc{@pk@?lR{\\\\bG|gA~Xxv@zNqUrFse@dEmbB|e@ey@hCboDfCqCjDlO}Llw@hAjb@xMxW`Poa@lPyK~g@xxCqAfU_Ygb@fCxpAdL~KjTDoZtzAz@l_@fTiy@zKkIqHbyLfNzuA_Zl|@yKk\\\\eLfKgC_GaPhz@?o\\\\sNxIoQ`nCucAjwDmKmhBUeeHmToyB{Fn_@nKvkBuD|qImC|WaSnGuFbQuJdkEyM`hAgKlZ{HpDwFoJoIjOkG`qAoRxi@ug@sv@gGk]|@y`BsLfUyYvqEw`@hxB_SrUiFqpC`s@aiLkEc`D_t@lr@|Lt_F{Frt@qGapA_j@thGke@o{B_HjTea@__AmGsrBaPhn@yKea@aPlKqGmKpGbpAuCz|Apa@cr@nHpKIjZu_@`oAmZ`TzFf{@vHbAtFxVpDwWft@epAfK|L`i@`fHsMxZkBiLsL|Pmg@`H{K`L_Llm@{_@|g@eKnv@}Xnk@sG_n@gOfe@{Pee@oRbImUleAoBbl@}Q~V{JoR]khHiTnlEc`@ebBjJotGqGh@cf@`vCeGdIyWqX}Gf]_GgV`LixDqLuUyKjTfAqp@yQgg@wDm~A`qA_xCvKxKdKc{@eBcPqLbPkDmPrZ}mAcBwWu\\\\cGeVvyA}CoG{KxZkOjEmPuXz@usBj[gxAlMR|E~WdDiZmr@efIi`@qkMzZw~Axe@aMlVfn@pRfbBdGzcM~GqGxs@dmF`BtmBvKbJxK{mAyKknA}@czBwNmqAbBkdGpMuX|T~e@pInk@_Btx@lPyKxKlPdTqzBkGkQmPp]kG{v@jGew@bLoGfKmoApn@ebBd]pCbEfKxFuCjPmxAqMkf@y`@m`@we@|xAyKiTsU`W{Lng@iFbEqBqIqXdj@kTej@sQwkA}Em_C}GjtAgKwRkLuqAyBle@{qA}{DrVi`E|dAopCfc@{~BxH`MxW}Q~FzChT_n@hQ`Bzh@iq@~IrC~b@sc@tJwbAh^aq@zJiu@tL_Ibj@jpAfOg@dGoLImQe`@oy@~Awn@hy@ksCbb@eF~KkLhV_yAfVo_@~Aqu@aKya@eMjVoGgCkIhp@uV|HyMj[a\\\\xTc\\\\tx@s^nVe[zAgn@nvBmQ}DajAla@sj@`e@eKeH_Hm^uI~s@oTrKg_@|t@oCuJcPfd@}OoIkXbc@yK_PkOzPaUcDwOcg@xI}pF{EwTwMdUsHny@eU``@gScUwIkg@yKfTo_@siDlNgbDd[aVzYsiArHaBjI~MvEiGnUafAj@oh@yJoOaK~XmLViQcz@tIo}Dv\\\\cvDpiB}mInStQ~VvzBv_@~jAfCmKvQjSnJ|yA?{aBba@iTnVlPdRyKfItL`JhkAzLoL}RkmCaa@_kAsl@kXed@if@}W}sAa@q`A~t@wlFlh@o]pUaaAxA|KbPf@~I{`@rDtZbOpRdIWjNeX~Uxz@dJxvBvFsd@oBkwBhTi_AlWdSbNuQpB_}CyV{lApU}sDkF}kBrGk_@nMnYrSwSrU{`A|ZlPgC}lAfKwgBja@i|Cjp@yjQpG_qAfKqM`X`VvJcZrPfZ`]r{A`PiTbGxEyH|fApDlwArKPjBzOkKjhBkM~Y{EfyBvL`_@pVxT`AxOoGlrA\"],[\"i}}o@smeSwK_|B`PstE`Op{@~Mv~HtVn|Bxd@nyVoRvrAkF_Kqw@ae[\"],[\"q{~o@gjdJoG~mBqP_V_\\\\ycB`MugDjWohB|f@bdD\"],[\"yebp@y`wM}C}l@`f@wuA`D~dCsLfW\"],[\"e`cp@smyMtJ~m@sNrtCsIgzB\"],[\"{|zo@}tqOdRxTpGyTba@bj@|]wkAbK{GzRxRtJ`c@tM~uDnUxh@jDpvA~OdVdBpp@dKvBjFfSnOzzBbPrm@dZtvFhItIxPgt@~g@um@n^`zAnF{JvEbGxZrqBle@~_H`@faCiZz`@rCf|Af\\\\raCxGnjDjLlQhTqGnGxFl_@d`CeTt_U{Z`uBkYnx@ca@mEqa@_uCdOnmFaIl{@tVpwL}N|bC~JjjLmGzNoGme@k`@`lAkE`lIcZloBaYfnE{b@n_@gNqqBcEysFn`@u~BfMwnMsJvOgW~iHmd@rkByKgzYyFwGgH|MiJg`@yUgzGqIdd@jVrpH_HgT?b{@uLkSq@ws@iIqT~Vho`@vUllBKdsBrMbp@~CfyCgMdx@`Jj|JuAdY{GyKmB|Y_DtEgG_GcKjWUpiCwTpXsJ~{@g]jw@iH`jAwWqqBkJwkCbCk_A}Msb@?dlAoQydALgJbHzBxKicAqJqKwHxOqNggAiM_UtNt{@{Cf_HiKdi@bEtl@uTpr@~I}aA_Ju`AqGxeCmXpmArKjPyKvcCca@_~CbGaT|CiiE_GulBhMmdAoN}u@yK`j@xJw`CbOe_@vYgjCnTsv@yPkjAiNuCoG{rBqKbXGphByNr}AkP|RiSnu@y^tMwTp}@}Gm_@`Ry|DvcAapEaAqoBaPjtA_YykAcG~SiC_ToGfi@yKcv@gCbv@uTqxA_AroFyKnd@yZxBwJsVjFeiAxKma@kD{GkSb`@}MgLnF_zAxJoQ|Ba_CpLxKbGye@pGhCfCcPnRtGx@yKyYut@i@_{BlEaTpLwA|S}gAnPfL`Pwi@iCsyCnJzg@bMnNda@}_AiKssEsP_rAxK~OxKiTrC|GS{O}Kij@sIkBkLqdAlU{gAiAaa@cGd]yKmMt@yhBtc@y^vWzoBj]jhApDm^gUumBpX{sCti@s}AdL|XdGg]xKlP`Bwu@{Nss@aPd]wDc\\\\hTil@yAmWca@npA?sgAsXzv@iTuTeLfCsa@gg@cXhIgVanA}X`~ByMrWiKwA{Ym^wGkj@vGmkC`j@~i@nNae@~@qn@oPr`@}Mu`AeRsR}GxKoC_IuNdV}L}GqMra@yFqR~YayBtKqtD|Kgy@pG~m@fCkTtClNnGvkBbGc{@xKlPvK{eBbErc@xVuElDfEjSpuC|t@d]xZggAwCmbAo\\\\rxAzBeaBqGvO_FeDm@gr@`W_VvFaa@eIgRkR~X_HwEwD{k@zBdG|GeXxKhTnGin@xKrC~AiUiWez@sFcxAyH{PeEjm@qN~PcG{e@qGln@}GqX_KvKsGoPvO__AhFwzAmBkJ}S`uAhC{pBbPoeAkCsTcG|KrDiqAsDoWqLvi@{Byz@aPh}CiL{VoAol@vKkiC~VwiApLbpAbGyKpGbP`Gwb@{Vq~B{SqQ{Pwm@lSwc@xK|\\\\lI_DtV|i@gGeeAfD}~AkQo`EnPnpArPcy@oHymCrFsX~PaPeMks@~Dcr@rXpn@eGarC~Ec}O|c@_zBdJ_rC\"],[\"e`cp@{xcKmIfa@aUsaAv@m`A`Myj@\"],[\"quep@mltKuTjbD?swDxK|X\"],[\"gkfp@aq|Kn@bt@uEjZeOjLoGy_ErChClKuX\"],[\"gzgp@y~qK{F~`AyLdEKe`AfSc{@rMaqDvIxNrIv`BaO~H_@tpA\"]]},\n" +
+	"{\"countryCode\":\"SK\",\"polygons\":[[\"cm}\\\\gfwLpp@|JlLtk@fs@fVpRxYxe@~@vVxTtm@xK`R|h@~q@h`A~d@_E~_AtHzNtU`CngBjVlvAkBpe@ov@haAqf@tQmCtj@{T~LkOvm@pGf_AvZnd@uHdYnQbdA}Nxl@wAjw@k^|yAbUbtDxC~Pr\\\\?|]de@h|Ad{@bPl_@oJ`ZxOjc@u@vUlZfQll@lmBHtZuPbf@fDn[wUb@_H`JbAnj@yQzf@jMfcA|x@dSzNl`@e@jsA~Odk@oBflCjOvaBh\\\\tZvLz^`KgFtq@vBxVii@lEn]tZxm@gHrvAhW|dElAj_GsHhs@{f@xkAE`\\\\sYv[mIba@ucAxrAoLt~AhHxU}Qbp@gIkBeD~HgVeL{SlS}Q~g@{dAfPaJpV{NjJoMeAkOzb@yx@wK_r@gr@es@K}kAso@wu@gv@eOs`@f@_y@zVifAoMip@rQcr@e^awBac@ui@cG_s@ao@cUkDw|@qPcf@c[cP_dAiHab@wYgs@kkCu\\\\o@tAgQma@e|@uXaLkHu}@fIk{@gQkd@fBmvAdFaQv_AWqIy`AlFim@aDgR}WqIw@gKuHzAe\\\\qQoL{`Asl@}_A~Vse@lcAg_@zFwo@rWzCnD}wAls@sKzHbE`MaQrg@~d@`JyV}@ui@cTis@l_@keAeEeKmd@_Ieh@e^`AiN{Pg\\\\wAgo@c`@ySjDg`AwIBm@wHxRqaAgQie@Iss@hj@ov@pX}vAye@k|@_Bqa@cNnAmJ~TgHsKwDsk@lMgj@o]oh@vWsgBmG_wBdL{k@h`@eh@uPwe@zi@qyA~Q}P|h@_KnGwP|NgaB~ReJlGelA|Su^\"]]},\n" +
+	"{\"countryCode\":\"SL\",\"polygons\":[[\"{udDjygEh_@LjcBf]vv@oErXxf@bJjyAde@vVpR`[hcD|@tf@bgAblB~xAv[p_A`hAv{AdfAfx@hX`}@vm@~a@da@iJrErT|d@~C~RbVlYf@|JtWed@zdAqGyKfCba@wq@pn@gr@jwAouCz_LyN_R[{UtOyj@sXx\\\\Vl\\\\uHpNy[oJoYqYHgrA{Kif@}GzBmHm^gKuAq@hl@vJbGhW|rAaHpd@_a@hQiTuCzBhTzG?uMdN}t@y^fCoPyOfCyGkKoGrKje@r\\\\pYlr@sPlcAkZth@o]zDwIiPtM`j@av@fcAoNfLiMo`@lIss@ec@|h@sa@rMel@mUwAbSub@|UqLtUii@sDhQ|hAh`@|l@m_A?y|AxkA{VkKmVjKvFsy@jb@k]pc@os@oAwOaMzQqJaEqRtk@_N}Ds`@sn@xBgGsXoGpGeaAgLmVoGje@__Aoa@zo@xd@zMjmAeKzOqAaMuZwWoGbPz\\\\jPy`@jn@rI`BrMyWfCpGxKgP|L]nWlUfNLClJs_Bfp@__BSgTqWdI}HoGq{B{FxXnBr_AwK`PcSmH_Mei@`IdaAeK|Z}MlC|GrC}GdH|GpF{IjLu\\\\cAiN`I_Uoy@nSgx@q_@ku@un@}ZmBsN{T~Jgd@yKeHaLpKeKkHar@e`@iX{FmR}TwAyDaVqPiLkkAsSaEuJs[aIw@{Our@iN}^kd@_hAcDwQgY_]gyB~`@ssAq\\\\ehC}j@iFQg}K~Kwc@j}@sPpt@cgA`rAwn@`HwPjZoJdPcUhUkDzKuNnb@l@j\\\\cZbItKhCmh@dp@ow@fr@hEfe@zb@tp@hApQc~AbcAbJ`pAsFhxAshApXjLvJxe@nq@ph@`rAfVzdAjh@`JqK}SqC{Qa]|Pmu@oCmJgq@{y@q{@_k@gEm[\"],[\"_yrCb_sFjCbFrc@s@~UjNle@gC`HxQyB`IcHtD?yKwi@|^m{@jwEsDkV_SkRqMubD~DeXzIfHdBeEjAeb@\"]]},\n" +
+	"{\"countryCode\":\"SM\",\"polygons\":[[\"qgxYkwqFgSjZ}MiEkTau@jOkErWxGpLbJ\"]]},\n" +
+	"{\"countryCode\":\"SN\",\"polygons\":[[\"iq_H`pnFvk@yXpEqY~U|DxGoNvu@nWvTbSdoAeHpUiaAxZnAp[an@t|@e\\\\bpBhPnn@_f@xp@?|Z|H~S|Sf\\\\ht@nvAor@`}@_mAty@nCzK{Y`ZqOoUc`@}WiDkN{NbPgViA{`@xPsBgBiRtXqCff@e_@zWfJx`@cT~^kB|Uwr@vKlDh[oUp^xHc@_P_QaElDgDx\\\\sD`HvYtLqFfe@zIjR_Nnl@}BdK~TlWhNfUuNth@J`P~Q~TwVcL_Gb^iIgJiExI{Ftn@rEiPjnAvGxnAlTtfAcAbr@aSts@fS`m@cLnaArc@~u@~YphBy^zZoPhe@sB|V|Pjv@cg@|`@yDhmAgJjEiGla@{PrEyX|f@FvU`j@tRiBjk@cZdRii@}LiW~@}HpP~AzcAmKjr@xG`a@cMjh@gFdiEwBvs[zHba@zbAhlBhgAv|Ch@dxD}OfhA`Lbx@iFhXjy@tuBtGrjAqDvsAbNdd@_\\\\xHo_@ba@wf@h@_c@ej@d@a`@yK{Sye@ah@di@_\\\\kPyEaB_Jz\\\\srA{\\\\ke@fEif@oJqT~PaKsa@}dBhEuWlYu^z@in@xK}MeUok@ToWz`@_t@}Kub@so@uo@wQpKghAbBmGyi@qPsKpNsWaWoH|HtHuDt[dVh{@pyAkCdw@p|@_FlRwQqBmNh~@hTbh@wKzOpE~_@cN~WmJKsDxPun@pXjl@vOzIlP`Pzo@h@nhBd[n\\\\ai@biAuI~@qN_O}CtNkOrDpr@hCsi@ve@nQzPkBlUcN~@wi@o\\\\aUzBvTfCvb@p\\\\tl@kAnd@bbA{UlOgj@kAia@_Y`FyTgH_@kHzTpUze@}S?{ZiO}IbB|HzJ{JpD}v@{\\\\irB}@}i@iZA}aPmmBcHwO{mAxAg{@gR{n@`NuvAcJy~@a[y]ydA{Me`@oh@hnAmtBr[sbBlc@uc@hJwj@sFkj@r[cf@rDad@`ViW`Ocf@xBmgB{_@at@gScfCgL_^oYwP__@mAceArr@mVnu@tlAtjFgi@l`Aeu@rWyRpSeMps@`]jfAaVve@as@vPc[~\\\\eI|tAyLdd@vN|y@n_@l{@aXfVeC`ZtI|[nf@zl@nd@hQnd@pBfBxHkA~jSk`@~Gy\\\\gi@cGdLoWwKo@vKp]~FtWnb@{M|Uqr@sB}o@k`@|GaPeLrC{BuT`WqDlDeOqi@nGmClHwkA}PhrA~e@xMhv@zn@ze@_k@lY_eAvAcWuZjCcb@hLgD}TiWzCcMwLFmOqTjCfOiG~LseAg|A~E_V_M_Xm@jf@iEzOoLa@~CxHxWeD|@~_@tp@`ZxIjZpZrRpC`MmGxh@g]eNwTyZrMlObFx]|LwElq@j_@xkAzBaiCxEks@vTkRdc@mt@rK{Xb\\\\aa@uBkTpIan@ffAorA`i@cx@|t@u[paA[fu@vH~LdGyKxKxKnGcGdLbGw_Ab{@yYcfBeWq]se@e|AunEgwDqwI}uEgN~C}x@gSacAo@_{@eVii@wAcSsL{Pwc@aiCq^ga@{WsIqZ`Bwa@aSmf@bd@ap@{@yz@oKge@bVmkC}Waf@Qat@ad@_e@|S}]_Nau@nBuz@eIcbA}I}RoYtNgMuEF}PfSgY_ZwQwHkSv^se@iBi_DtI}\\\\kFc`@`Dwe@uPau@hQmk@b[CfByb@zSeE`Gu`@|rAgwAdf@oz@lbAqGvUeq@tl@_]sEqXmc@}g@JwJh`@rDtQs^y]arBld@nIgCqe@b^c_@rh@wJla@u`@j]@xc@oUh`@xBlc@cc@ba@vKxSyE_Bgd@zRyi@hLeJlr@_DtBa[aP}YbBuRzxAi[|q@{w@tGjBOv^lKrA`QwRzCoj@ji@sCzYgf@zEuOeEci@bt@guAtcAiVje@ug@\"]]},\n" +
+	"{\"countryCode\":\"SO\",\"polygons\":[[\"mu~B{pj[mn[cp\\\\cl[}vQubb@?__@yzDe[}f@vEcSkEes@s]a_Akh@ma@_HwcA|DweBu^yw@hCopAea@{v@eOoaAwp@_`AqLmg@kq@in@ev@oIg]yRa\\\\}p@fCwK}XgtAz[gj@rEk_Ap`@ep@?ip@l[cfBd`A`Zvn@^|zAhnAxb@qApaAhZhbAnDr[kG~Oc[f@eZxKmHd}@`h@j[lD|dEmMzo@aMtLh`@~y@lHdHhb@|S`OdJoPeO}pBcU|Mkt@vKtr@wgATe_AtRsLh_@dKpPxQkE`z@oYdIiBvHlJh}Atp@tdBjy@`O|b@}Efn@bQ~v@eJxvCvi@phAnDrOlJfhBiTxUfEt}@ve@xZfp@rf@pYb~@[fVfHrrBdxBhuBzg@bc@z\\\\htAjM~Rp\\\\~}Aj}@vbBxg@hcA`dBrhAv`AzaAbLnLsKt\\\\_@dbAnl@fe@~CljDnaBtsDfhCleCxiA`vFzjBlbAyEtu@|Ghn@~T`{ItsE`bAt]p`Ar|@~fBp}@roAliAviB`nA~wDtzAj`B~aAfwFpfFflAlv@ntHvhHjaCtnBniAzrAzv@lm@`rChjDvx@ze@zP``@xcC`uBlzAhiCdtEhrJh~@doC`_EbyGthBxfCnfArdAvTtf@v]lKxy@t`A~Px`@xc@z[bv@d}Arh@x\\\\h^`n@drBpvBtsMxrLbhBvuBpd@lQhFtR~_@vWlZ`AkInE~Xj`@xaExxCzJjRjC~f@rErDpA_KtX`Eds@va@zRxZy`A|Jtd@|B~i@wNf~Ab|@dKRuFkNnOpFt@bMcZ`Tjy@uCf|DhrBpP|Xl_Adn@sr@eAqXxHwuLlrIa~fA~GkP_Ho_FyaFiwN}bJcf@aF_`AqyA}Nyh@_@gdB{}@}lIwc@oi@u`B_e@mpAibBexBw~Fw_Ak{FoD}Uba@wlQqFaQgeTqlR\"],[\"uuzE}yz\\\\tbb@?bl[|vQ?~oRaiR`vx@szEnzFNxl@ao@voA_qAd]{l@lg@uTvb@i{BpYaS|JoWtiAePjPowAfc@ygA~yAgmAf\\\\ciAxMol@bk@}t@b\\\\gLZ{Ygg@{s@qOce@mf@k_@kJm\\\\im@mp@{QupHceEdPw\\\\aWnB`bAiy@hXis@sQqQvwAXt_@wXdIaT|pB}z@rrBuiBpdCmfDflBi{CxI_YnGazBuZ{eBpFacCyOcSaJzBmq@whBsjAceBr@kv@wNuo@{l@ut@yVq}@er@c{@pDw\\\\f[eWwIaQhYey@vP{lAqLssAtr@}{@jImeAge@mcCohA}_CuOyKgX_kAmwA{jBa`AwkCo@_hApt@ktBaQob@aC}aFsD_R{g@}c@gIus@_]cW_LysAkMeZaF_rA~p@gqC\"]]},\n" +
+	"{\"countryCode\":\"SR\",\"polygons\":[[\"is_Ang_`@|bAzz@vl@rTgAoM~GyEjZApGpGf]mL`G|HbYyLdp@tPbNeLllB|fAjxBlw@|\\\\|\\\\vJtj@`o@zVaDf`@rPx]}Avj@}^fFgPqKmYvC}KzWrSx|@sG~Vyz@l|@gh@eKnJrc@bh@n_AsDxHwPVx@jTje@|p@wKzi@rMfTv_@jItFxPkAlaCdVngAyF|QeRzJaMbq@e`@nk@cHv[zOdOh`AfIj_@`Vi@n`@`y@zYdHem@vo@_YteAseAreBhApSzRjKdh@ka@xuBR~f@mWjm@lAbr@yJn_@wPjJg\\\\zp@_@f|@_FbPgtAd{@}fAjVqGvXc[jAwk@ba@oNeDw\\\\jQyReDXx]qXeAaWxQcYeC_GdCrB~PsWvBuc@pX{ScGt@l\\\\wJdCoUyIjCb]_PhPye@xIwUkUgH~AyEv[y^aDgCyKus@rYiQqHM|_@sO}EabA~Im`@oFug@lPhQnc@uLjc@xOtBpLj[mOx[pJzRoWxz@wRdH_}@{KkWxc@e_@nMoa@x_As|Ah[gy@zh@mr@fw@arBbTonAaeAedAUwXgR_|Bys@_eAn\\\\wSnXaTiCq}@in@dA_j@sf@m`@iI_ThH_o@cLyx@bLaPuTcbCqZpX_~@gS[aY|QwGsXg]ot@|_@n@rQrRoG~DtO_s@dDPxYuKJen@}h@}q@}Icl@os@cbDuVui@uMyf@uUme@mcAfOymAtByiBhWmt@`tAwmK`Y}V|t@?wg@qG}p@rRsKsH{CfTyRvI_k@q^aNeb@eEm`CnMi{Dbd@m_AaBet@bY}Zp[vOcEaQu_@uH`Pcn@gEaQnHiRuNg^lKiVuQhXxHrQm@`s@c\\\\bcAuWnT_P}Y{Cal@zCohDtZs{Dr|@}nGl{@m^vn@|Zv`BvQxeBtcAb~ArcBzWfFpqAr`Avu@j@`TlUj{A_Dr[_Zt`AoF`jA`PjbA{IraAqWfe@hIdRyH~Neb@zKmAfw@~JzlCagBfOue@j_Bcn@kCcS`ReQ~p@|Ifn@aA\"]]},\n" +
+	"{\"countryCode\":\"SS\",\"polygons\":[[\"afyAoq|TKfvAoc@v@w@jWdKlPhl@|EiRbw@icBfv@klAk]yL~m@?d}@ko@oVsOxLdr@r{AwS`JlfCbiJnyAdqDtoOjqOjNfYkGxjEokApgB~w@~`BxNns@tVjoF|dA~qBzaAjKcLjx@}_@v[yFr}@eaApPwp@nk@iS~_@v_A|w@jMfkA`TtXyhA|mCkJbq@pCxl@b^zf@~a@hrAduBp`AaLjFg_@gE}Wrc@}c@vGgGjJxYl`@dPlvAkGhEco@eK{jBpVAvi@ud@hiAsPx{AozAth@cRzp@}s@l`@kPxg@uY_IwKbEaUv\\\\w@ji@wL|O}_BhEaS{JmPf_@rArQue@n{@}Jds@jDpL{Ixa@xt@hH~v@x_@tZ`_@~e@zTEde@d\\\\lLqCz\\\\gg@xf@aNr`@cd@rj@xIrr@gE~eAcY~BwMrPjLrd@x}@jb@zOpi@vRfGxAxd@~w@|x@eBd\\\\{ZxKmN`TbBnmAiz@ln@jMn_@ce@`Wuj@w@oK~s@|KnHmApg@wT|W{C~UupBjKsKth@gc@vG{ThR_Edv@uLxVqXhGe^rg@}b@cBcTnWqWdIke@xs@ia@~UkfB~[a~A{YkHnGnCzQmd@UcIvLw[vGqOx[uTxFaM|^xBzUim@ja@~Djg@aXzLaBbn@cf@rGmN~PmPpk@Of_AsKrc@cj@~ZgBbJjSbb@mXyAia@gk@mVqDsNj`@c^fAeSrn@sm@zt@cl@H}BxJoRwTykAym@}Sd@uh@rwA}s@jv@_Ofb@og@~I{ArPcM~Dom@`EiCjb@aLrDcPzk@kU~EwI|n@sVbHyi@bxBuc@fn@hAfUkj@vjA_r@jZos@rmAep@vNqYqu@eVyOi~@|Dq_Ab`@iNhZuCffAy}@raAow@rTiy@rhA_VxrBfEx~@eg@`r@`Oz|@iKjQeJ`{@sTp`@_a@pRsh@wHmy@_b@o\\\\yb@u\\\\nWia@iNqC|g@{KeC}rA}}ByGafAwPkg@weAQumB{}@wyBuK{zAeeAqyDmA{k@mhA}n@_f@{lA}_@on@lFiiAmd@cUk`BpBcz@i_AexHri@ug@fiBkFrc@{`AhsAkx@~Pao@`qG{jFzW_j@jA{i@epAipFwBw`DlQ_lGqFmmApbCw~@l`@_]hCerNk_AlHm~@et@km@yz@ig@wkAeZajAq^eoCyp@ss@ul@c\\\\wxA]wcCowFpyDweHxyCmnDuNyk@cHskEuP_k@ufGwkGe`Ds{BuqBep@avFoeHqRkIoN}AcrAnk@gi@rHeeD{EapAdFkxDheDy@i~K_pAbLms@yBrBkiHlr@zBzwBfc@hzCzFju@v]nwMy|@b`AhYfy@yuCt~@yu@zeF_wGnnAc^xeAtElp@wIbyAvi@vw@bLljAp@lZeKLciBndOuXxj@nLldAriAxIlWjDfbAt^bl@qAfb@{u@|m@tGti@_P`i@fItApBfv@~IdRiLzmA|XdS~D~PzYrFnJsFl^`ElWmZb]~Vfg@oH~MtK`Gp\\\\lbA~t@lp@xXhr@aTdVwTdHcf@cJgZdJkj@h^ac@xGua@aR_gArMaa@vTiT?}t@hTip@|c@c[dVo^pNik@zmAqlAfe@aNn|@{@hu@w|@kAs]f_AqIts@or@f[abB`[ig@r[gKtQrFje@oGfIsy@f_@ct@p\\\\wQjyBw^dv@e]n`AqFvcA{`@bo@yd@rg@oEpZ`HzWkN`^lB`eBu`Aho@_KbdAaeB~RgF~ZhMbe@_VzNySiAcNwm@gt@gAmWrCeWda@kw@yEkt@ne@kjArk@`Qt~@aSxq@d_@raCtAjw@qS\"]]},\n" +
+	"{\"countryCode\":\"ST\",\"polygons\":[[\"m}F{naCbj@mg@~u@oE|gAdr@nDhLxIeCfQjZ?rVb^dLwHdGjK~IyzBpg@q[wDcq@{e@ef@_cA\"],[\"qu_@ewpCrOfTtIuShCvM~^xCbIlNxNvAiT`j@{]n@eHeTif@iLQsf@\"]]},\n" +
+	"{\"countryCode\":\"SV\",\"polygons\":[[\"cekGlv~u@qg@~H{\\\\qCk^gd@uYgNy|@sqAoMia@dQ_m@wCeHoVbBgz@gf@cRe_@oRcfAkk@hTwNzWuN}Lm]~CsDaQhQsGcW_WZ}k@uOqSbKYgE}B|s@{rC}Za\\\\|^cGfLme@n_@iHnQsTt^}IcDod@rHmNjs@qYyEwx@vc@B`HaYnPwGyAsb@fR}oAxqAwC{P}_ArCoSsJcBtBaQaLoX}OsLcB_P__@hDg@uiAvEuVhfAcl@iOyd@`GmQaHwNlAoWaO{Yj[aPta@ku@dmAb_@rl@dBps@rQfMoEl@y[h\\\\sFz`@~z@mTdLfn@dUtd@mr@fJ_@zUpPr_@`z@~HdAvHmM~C`UsHjiBrCplCsCbPyCcMsH_@wR|QjTyKJt`@zBjHtCqXjDp]{UjOfCoGkKqG{SlUwCxFn\\\\sCmP|m@}SbNuC|Z|GplArGcb@kCwv@~XoXuCxe@xQ_mAnJ_KlFjCyQbh@i\\\\j`D{lCbbHkMlrATxwAiXl~AzCboA_q@`Y\"],[\"_x`G|kpt@cJ_CxAsGjZaGjAtQ\"],[\"_waGrdqt@|J_HbHvFaPfI\"]]},\n" +
+	"{\"countryCode\":\"SX\",\"polygons\":[[\"yw_Jzpoe@|P{v@|GbGqIzh@uHhL\"]]},\n" +
+	"{\"countryCode\":\"SY\",\"polygons\":[[\"ep~RokyTgs@{n@mi@uHu]_Wi[dQybAhEgGjVyo@uJiF`Mg\\\\pAcNzT{W}SuL|V_Oy[e\\\\_Fya@o|@{g@qGmGih@qOmUsQlBuYpbAkLkAcFsLqUW_bAw~@kT}u@~KcPeMih@|PqmAaTiEaHhb@wTjYyWiKk|@a_AoDqs@}TgGadAigA{f@xXcV_Kq`@rf@ij@aC_Y`n@sQdINh|@{KtHyWwf@oYkB_@s\\\\wRmABll@mc@rc@td@p\\\\WxjCkLni@_x@dWa^j@mrAxe@_LaHcT|C}Y}Oqu@|Fmv@qm@gw@l\\\\_TqGc{@fE_x@z}@|A|\\\\cVwCyKrXwK{B?lKcRma@ma@pCqGaQgh@cXqYvAid@hVi@el@gh@gXuDcj@`c@wMb\\\\a_Bq}@yIcQwLkCal@eUiDaCmKdC{d@ckBKmVwIqG~G{DgFhPqo@_OmQeAarBiR_Bw[xK{Ojk@kX{HuTlXgk@lLm`@iTwu@oAinAgTkb@_XiNbAlm@_jEn\\\\q`@dg@lA~LcWmRa_@wC}tAfNs~Be|@_aCwPofByg@stBwc@}fA{Mi~@|_@y~CvL{]jfAesA~Nae@qC__E`Ya_Bc@q`@qq@qgI{wAohGwiA{jCuL{dAsm@qqB{LctB`c@q}DsEamDo}@{iIi|Ag}B~UoFnGoZsEoNh[ea@zi@bFvJaKjw@cMttGjlIzPt`@pl@x{F~cBvbA`wDdXtqCshAniBsDle@hLd}@tq@xnAfJdlAbY`e@bEbrF}FnsFhtC~j@vjDzvQnld@hrS|de@y@bpAoMpUwU~dDo{ApbCpFlLi@~v@sw@td@o\\\\n@m@b]kIdJoIeC\"]]},\n" +
+	"{\"countryCode\":\"SZ\",\"polygons\":[[\"x~yNg_oRtUga@rHsh@rRdGfbA}MhsA|Xl_AwF|eAga@fr@mE``DdA{PlX}@|r@vuD~\\\\v_BiB~d@aLkBbsGofAhoFsyBvtBacA]qd@x_@}c@~LpSjZjAfTox@lIywCv@ym@qLwtBifBccAmi@yuBgr@k~AmuBkLsx@xxCumGzC_Q\"]]},\n" +
+	"{\"countryCode\":\"TC\",\"polygons\":[[\"{|fL~ffk@kAcPdQA|TtJdHhQ\"],[\"{|fLnssj@g]rr@cG}GxXsz@`UcQ`OxC\"],[\"mphLvkyj@gAlSog@nHdIyXe@spAfi@}\\\\{\\\\f]hn@iTmPpX|GpGaPvHwC`O\"],[\"sxhLvyck@me@gCdj@s|@wCs_@iOySjFuLrXtTyKzBlPdQ@zW_H?dS`c@{N~E~FuL_GyByKtX~MOpA`Nsa@oQ\"],[\"kyjLvt}j@kTzGiJwJhDoaAdr@wCtKgHz@oRzEfVuThd@en@eCpYtZ\"],[\"_ecLhllj@zXwDjX~H\"],[\"k__Lzcwj@eCqGbHrN\"],[\"gk_Llbvj@oBmBzGKxH`CiDzB\"],[\"ky_Lbymj@~ByIfNfL\"],[\"{gdLlmsj@ve@g@hJlN_PtJaFgR\"],[\"cziL`u_k@aAkBrA_AfC`DMjD\"],[\"qkjLfb_k@bEyC`DzF\"]]},\n" +
+	"{\"countryCode\":\"TD\",\"polygons\":[[\"wl~FquqGifK~|GoeLxaByc@E{ZoS{Ooe@uEgq@cP_T_VoDuc@vLae@yg@wG_a@kZwCus@nIwjBep@ggMmmIujUilTowy@ahDomGmqC}VdIyxDxpD_q@`}@m\\\\fOax@AcWmNsLeXsxGt_EsWnTq^hr@{xHxFmqR`wBswGgsRzblAsd{ChvhA}@xQjFU|OgWvgAgJvrC|d@`zBjC~m@wRb_BjDbl@`y@hw@vj@b|@nd@AjbAoj@njBnJrv@x_@tNlb@hbAtcAfq@vMfYfb@vX`DnnAcOhd@~bCj^|r@fUvJtl@ke@zlAiOzWpSzXyEtM}PxKkm@pq@aHlqBhnEdmBhvAp^uV|w@_Kjc@{f@dgAiKlv@wd@`YfDdwAvnAh`@llAdYn_@jwBldA~dAfZjhAek@~U}a@`Dis@{J{t@aL{Val@_e@dr@gmAn^ggAn{Apl@pf@_U|qCon@|rAdOoYm_B|sF`n@|\\\\{Axv@s^dTkUhZyfAzMcOlW}@cBioApF_H`tBaY`kAhT~OzL|vAlWiBla@_^`lAcNfrC~T`XxMbv@hRlYgCtMb\\\\v_@x_@bN_LznA|O|Qrh@~GnM|o@r_@ve@yErXhYn}@l`AtIbjAo[z^bRbg@`@xCtQna@jSjLbo@yClTdKzRn_B|p@rl@zc@fGvTaPri@dF~Gfv@le@tVrf@bn@vOdKrW{FlM~JfMf}@zMxItK`PqC_A~Kf`A`v@hJnSlh@pHuC`Nh]pZhTj_ArXcGgKxmA~R`DtJnV~b@}EjDzNrh@b\\\\oAfM`Ob`@?~~@{PvZzPfc@wTb[xKjEiAhWzZrUwKzI~NtGpH`p@hMrNo@n`AvTjyAaJpOhMtx@kDt}@rIf_@gPngAzCje@fJnMeGri@xQdp@j\\\\f^nKr]bKfBPrNh]hMnGmIsDiId]iOv^wiAbk@cn@|]ff@~p@nXfgDt`Cv|@~kBhWnKj]LjYzPvIrq@dFrpGvc@hcCkNxgCvZ|c@~Gdo@hYhVyGp\\\\hPzSx\\\\fsA`a@zWrA`M~t@du@wJpYfTvKsA|XfJvHzHf~@jLdOnZPzMf]aC`Voo@vc@k_@vz@{i@Ta\\\\zf@an@J~Krb@tb@nXoAdZxFnHpv@dBzLdIvF~u@b\\\\fn@nQreB|i@`i@zOn^jSjgBcG|Vy\\\\d_@~BxgAkGjk@ebAoi@_dBuG{Fzm@oTn\\\\kxDrz@muE~cB}eBjqAgSn{@k`@jH}ZlX}Uj`@s@p_@w|EvtGwm@rPojE~pDcj@pv@m~@}c@meAueA{mAue@uIw`D~l@qnEgWmmA~IisAoUii@wCga@n@cd@t`@ahB]gb@gd@cvCkwA~~By_@fVanAbuAsr@jMcWfV}`@hMeQdm@gN|For@kJgiBns@s`AeGi{@jZmtBvGso@qF_^sQcPpGgp@_FufA{m@et@n^mg@tJqX_E{BeLyKnGuTaPui@lRiOfUuNj@qNkLeUlHqDqScXzRml@?_NnVbIj\\\\wd@vh@aPoGcPnGsiAmEajAfRgElPaW{GukArS{KfFnBn^o\\\\tTlPvKiIfFw]{Cj@j[_Zx[|G|YiNtBp@bHa_@rBsa@xc@sz@cHaeA`u@\"]]},\n" +
+	"{\"countryCode\":\"TF\",\"polygons\":[[\"polI{~aYyFbP}BqG`A}K\"],[\"zrf]_cui@sXrcAsA_t@~h@uz@nElM\"],[\"tvb]}s~h@rKtLsKbpAmP}\\\\`Dq}@\"],[\"v|_]mdni@bChQkGfOsX}xAhb@x`@\"],[\"rw{\\\\alfi@kq@}x@sLic@?ePda@pa@mEc{@hZdRvVvc@xScGlIzTtDeFpNnv@yClI\"],[\"rfy\\\\magi@tS_Jlp@fh@bFhTim@{JuZ_X\"],[\"rwy\\\\gwki@kKnVcU|MnPcgA\"],[\"nr_]_|`j@rUiKt\\\\xFlXdSr]jd@qJh`AfX|\\\\zd@{_B?jnBmp@`[gLt_AhKdo@sIrgBdDtl@|Xk_AnDpNbSg[vEn\\\\g_@zr@bGxKp]yKq]dlA|r@ucA`[oFjt@_iEwZxk@e`@bIgHyv@nNmaBpZqPdX`i@|@lx@rUqdArRzRlQdmBmUvcCcn@hi@cs@xDmL`Xn@b\\\\hTqGeRvi@do@so@pa@r@nPh]_TjxByg@d{@cAvx@km@n`@zCzMbLkDvArG`_@}S_Jz\\\\uXdXlj@kTjHbNxN~@cLpUwYlDoKtKzXfGbf@yRd\\\\jVxJrXhFhZwAz|AmClX}TpOqr@qGys@kg@uMvKk`@qp@of@hSi@df@mVu^uByh@iT?lH~u@wLhLeCwTsOnb@_d@xPgGyBvQ{V}d@mkAnAzn@aJf`@yKi]?no@mPkDyBzRgQeCgCoWhGmSsv@zG~Dew@goApdAkN}f@TuX}X{BmHuPsQcAoGvGa_@qUqIwc@jAkd@|FdHjLoLxKhCpGuXzk@nrAtz@tm@}KeQpAo]gTxFsEeTbN_Gqh@u`@mUwn@nJ{VdUxp@jMePkMiPnVJtd@|r@~WhOaPsb@|`@hP~Une@bq@~LvKui@wk@~Es[o]jHwV~T{Nzh@{DtEoUeX_uAoh@ut@dTcK~QhKhEgH{C{Jst@c^wYsi@j`@gDjDkJjT|\\\\nG}v@nPhn@dEye@`N?pGxeAgNxpAbk@jhAxEaHmc@ykAhHu_@fR~w@nGig@xJxCbSg{@bPxkAcPscEbVxe@`Jbr@~AkpA{_@in@hTuG}\\\\{c@qRjCcAoZmJDoPkc@_W}rAtIee@hL~Cq@y^iNwX_b@sV_Mif@l@el@vWcfA\"],[\"but\\\\ef|h@rFwEpDlGyCdc@\"],[\"hht\\\\k|~h@rFrJqRrDeJoVfOgE\"],[\"jxh[kks^`TgTrX|ZqGthA_d@t]{^up@tHiYjQiH\"],[\"lub[u~r]cL{x@jSsNxWP|ErRoGfd@\"],[\"tvaV{vhn@aAcXpMeRdRqExOj]uJ~ReRrI\"],[\"f`sVaiin@tLgItApSqQlB\"],[\"l_sLitsWvH}BlMxI{@nQmWt@_@mP|R~@kF{K\"],[\"zyaLe}fWu@Ol@g@\"],[\"~iuHqvg`@wA_CpDG\"],[\"l{`F}qz[iHiF`KgC\"]]},\n" +
+	"{\"countryCode\":\"TG\",\"polygons\":[[\"knxExfBjqA}vKtMz@nAaIpYpFqa@itBsAklCle@`Ore@yDxl@zLl_A~q@toAoCppBjP`pFmcJhT_GxcEsFlmAnQtWqNeDuItPeFfwAiLjrByfBld@yPvbIkFxg@{Uvk@zUl^`Cd~@iN`{WA`Bry@jk@eKn`@eWzc@hCte@eJti@|T`Y}QfUSdPyQr@qOjXyOdXQfM}UxXaOv{A}VvRh|AxE|K`LoC|RxuB|q@pwC{^vD{Dvr@ke@ri@}~@`YsBl{@o]l[qe@vz@il@fTgc@yH_H~c@{VnU{_A~ByOv]mo@xb@sr@yOmc@f_@yMus@oT}JynAD}eB_]mm@dMtIjb@{Q`^iy@dLuq@oGyOa`@ox@iL`BaQwhGhViOmJg`@dKyy@{uAkNnAmDfKa_@pAwR~Vsm@~QaR|g@wu@`bAuhAdj@}]hDkFcKzOaMaSkFm@u`@ol@aSycBlm@mcAeSi^uXsk@jFmWaTs_A_BwOj]iYM{Lzk@|Fto@|UnFzLh`@^dViGzAaYY_Hwb@}JkIgNnn@kZuApAom@eFcJnFcSmJmM}KhDkAjKhCzk@k^jIwP}GbVcZcHwP}{@lNqo@mL{g@j@{TmPgw@jKiAqZgKsAsf@xZi`AgAe[gHiJkNiLvQrCbc@sKoDy]fW_XnBiApn@`IvI{{@t`@}]|i@{XlP{^lhA}X~Dwq@iHsb@u^wvAaJyQ{Pip@g@oUh]~Bzv@\"]]},\n" +
+	"{\"countryCode\":\"TH\",\"polygons\":[[\"umbF_`y}@klAtK_rAtgAicAhEsvAlsAoCsL}Zt]{IW~JjZnJzA~rAuNuCxPob@pLcUrc@e\\\\~kArHdOaEzPe}@lSbCwOiN}[yWwMsKzCf@fPxK{KrI~G`EjWgi@nBaYeJvTfT{\\\\?xGfP}D|UvUw@}GaP~XiCt[eRnKjD}IxUgu@|_A{`@fV{MnH_LyBpGyKmDdGsSwOtGbK}BfHqGyKTt^wPkIzBna@rXeTJiLba@tCch@~\\\\_Ab]ej@xEdMvHv`@mG_Xb]wGjCxByKkIhAaFzRai@p\\\\uSbg@jAxp@fYrOrCdp@zKfECfaA|Uj\\\\c\\\\bz@_WjrCnR||@b_@xGeLf]yIeHkOlMdEjd@eLmG?tKaPmGgC~Oof@aRjDcNg]kP}bApa@yPePiTuC{KgTwk@jP}MdPc_Ayh@ay@nSuZoKaLq]uc@kAeKzG{c@qNnNfS~@pm@sXraCi[zu@ac@qBzx@tLpVdtCwTxe@pWtVfg@`rB~[rRkBvKxB}GtSzDhMdZx^wAjaAyaAtz@}Q~w@`_@jiAdQjb@tUrkA|OfdCuKvt@sRj|@jQjQqOf]uEbjAlg@j{AplAx[|C|ToGxJ|O~r@qCrA|U`PzPrsBtcAvr@bIpaAfb@`c@z@vq@mK~HpIkKpCpFzSt`@dSpy@{Jxk@dLd~@{IiFzIzCz^xi@`Dbw@pd@bRJfTfe@xs@pNrX|\\\\pGmP~^lPb[e]t\\\\hA~Kx^wThTdLf]pj@{Ipa@wa@g@wQlK`GlAfX|aA`^zo@aPvTvKtvAoGbLxKrXfCzkAqPdi@oWla@}El}Aw}@ZnSr`@pa@fc@Zr[cLhGyz@lOcPaDk{@mq@ce@hCac@uX_{@tCqx@}GsLnPi^`VyBpRkJgCcDbn@I|a@sQj~@oIzKpG|gAiC~_DoXpd@am@liAac@pVq[gDiYc`AlLgG`TeDeKpL{Qx_@yWfqA{^`lOmhBjxAwd@~lAgm@zGJg@dOue@hn@mDh\\\\qOmGmn@lImDzGc|Ah@aWhIwFbRpAxSdb@nUaGrIu[{@sW}\\\\gx@x@eq@|RrCxo@le@bh@rtBoTh_@u[hhAq`@dKcNfE}h@nh@gHbLgYdMrTb_@aSrT_k@aDee@yTmS_[~@xuCioBrR{e@oEcMz`Ai{AgBcxBaXa`@hO{aAgYjMyObh@cEgQneAaeDdtE}dC~vCk{DtdBdGteAhmAnkArLriAfeA_EnVcVpUxN`XwA~Kgf@|T_PaAya@rn@tlA``E|`A|Uf_@hw@yp@~e@mKfe@if@xSeaAg^mL{a@oJyCg{AuHo]bYkMQoRkUac@tClBrc@wRn]~Ubg@qFja@~ExLinBbPiSdSuDrW_VlAf]`}@wi@deBqDzp@iUjc@}pAf]}E|IvEx^wJnN`G~Kpm@tE`a@}ErOlLv{@nJiV|JgBhJg]kGgAhMzQxI_e@ve@_RhEpA|OcQeEsVdGsAvMe|@rj@sGzHfCvOq_@hPwa@rdAkc@{AeCuMsRrSg`@`@_}@aj@}EtCbLx\\\\oCpHs\\\\fBfTlc@_e@bb@_j@xFgX{Wmj@mJnm@t`@}DpNuc@MkHxIfTtIxg@t@pB~IsXxx@cf@~X}WwE}p@~IiAjTgLgGwx@`ImCxYoQuByl@b_AyUbMpe@lJpG|KmCdU}MfMsx@vJwZ}\\\\}g@qCdL|XgWjPoGhSs^pCyZpYf]nm@}]`Z|PvQ{ZhMszA~@kWtInPxKkl@dAgEfC`Pdl@qg@k@}SgQ_Jl_@|LzAbWnl@iCfSlJjCyEvKdG?pBxVtEvBtFiRto@cEhi@tVaIdKnPzGi]nTyTvj@qcEru@m\\\\kExh@oVqKsBqTtSer@yK}m@xKcTeRuw@yEylAqf@XmWyLtBqWwSvDrc@gOx@kKs_@yx@n`@c[xB~D{IuOmRqNxJuxDy`AvKoQwVuZ}Vhi@m^w^kRxKwDeKwJdKnCm^qUsLoF~ZzGbGkT?sMs\\\\_[gP|SfTgPtUg\\\\uUxB_PmPqGgElJ_PwKgEiWgLpRiKmAix@wc@cxAkc@giDuj@yr@dG}WjQ{YqD}{@yl@}ByVcW}MNeYmN}\\\\ot@`@wWiLvFo`@a_@sL{dAatA{v@}Q{nBsjAwm@eMk[wXahAoLuO_y@gt@eg@iw@mMub@zi@s_A{F_^va@wfAqM`Fzv@ec@mLcmDfu@kYwIki@Paw@~mAgVjEeHjYwm@rGuXbUcq@xLue@gFgf@vk@crAqDoIoFcFuc@wQ{Isk@zIsPyGg|@Sy_BlUarAI}f@`_@ax@~Eup@xr@_p@x`@{b@`J}Ojf@wu@biAuj@d\\\\h@zUq[`j@ag@r`@unC|oA_fAljAms@z`@sgApLwh@`Rqf@}Cka@bWol@}Q{XlG{Bya@of@i^`T{~@{M}DgIvIyNoL}VuAsMmk@nKsXlViJiQmOw{@oCeaDvOolAiTss@rBob@oHak@~UeAal@yg@wWcEaLhCgp@nIkNaDcNgTsPcg@yCi^kXwkAwJob@|c@gTjAnI`Lrq@xQd~@jaAgG|JogAbTwd@i@qv@bi@a^r@_Yx]ac@fO{LnQm`@cJmO{YcXdP{JsEjF_NmYlHgGrNyZfEcp@jb@iUzhAeMlGsKcDAhOaQuEwlBnsB{d@lAcSf`@w}@|_@oOr]ybB`lBe]`Eqw@|l@{@kMs\\\\nGaf@sTcOgTqs@fTgDdO{s@dEwz@nh@qR{NySpBwOzf@ji@l[wObY}XdTqj@|Dm{Ahx@rDmV|_@cWc@gr@ue@wbAeJ{nAi_@wGgnCtUam@~g@eWm[ml@iN}m@{r@e{@c@ec@h]kHkTiK}Cg{@lWer@{m@cv@aCgBgVmh@qkA_t@hAmp@mK{AeL|QaPBaW`d@u~@nXuBtFmJyNsj@l@uqA{Jw]fRkZoe@_x@gOkmA{Ysc@`MqZ`W{R}@wHuWqm@qS}OohC}Hsd@mb@{Kc`A`d@whAmWazAs_@aj@oYuHy]tW}n@lKk`@r[cCcKbVif@~Vc|AaPqsAur@q^sPc}@fc@o]tGk^pa@of@z`@iAfN}]_HoHkWJwKiYkd@oRyOih@`EsVp{B}gAbUk_@nBgIkJyIdEk@bx@hBdsBbd@nYz_@zx@r_@|hCmz@jQwx@i[eXYmOjd@yiA_qAgnAz@ukAl[kkAiNas@bF{QjRmI~_@~@xLlGdKh[vn@xKrQyBrKoS|zBgNvo@kr@nQiDjWpYtaAzc@np@iA|g@fMjXcQlR@rc@bm@|YlB|[lo@rr@v\\\\vf@o_@xXul@xRxNl[`@n^oTpk@fLbk@wMhY``@ho@x[v]zg@|`@HpS|TbJmExHpIdg@~A|_Api@fTcI|Gwp@l_@ki@fT{r@q]oT{FeUcj@_[}x@otAeRsKeBxLkDyBqL}g@bRgHc[oTuOo`@}I|LaKyUoWmCpGgMgXkU{Iay@aoA}UySkNvYky@}ByKmmB{kBlGky@b]iKhc@sg@pYi^|Fid@zZs[jWctB|W}Fpm@rIdRwu@qa@dEsNgg@_r@agAaX{~@lP_h@aC{I_[_Uiw@b@uXai@}r@oQwVonAc]na@qZmAo[gf@yHew@fO_~Aze@wzAhHcaAj_@gb@h@uMk]wj@bHm]j_Awi@`jAw`@du@iv@bsAcd@da@mr@x~Ac}@tuAs{Cz_Aiw@~e@sOlgB~ArvBhm@`yDcWhw@fOtt@]vm@c~@|}@y_@|n@ebArdAuIpZ}N~j@}nBpXqrBla@fd@bUd@viAc_@xToUtHijAbi@gl@r\\\\iEbyBd\\\\dg@vhAxLnDpPmLG{c@z_@wB|r@`~@va@`KjWaD|Pcj@~d@kS`HwWnb@xc@xRgIzLvCn@vNhXcCdEfMrXxFd^_Ixn@xD|z@iFzsAdx@pGd]~PjSkGfXtThOdT~eAn}@n`@fIx`@qQvWyb@zRup@h@oR~wAqPhd@tSvc@_PnKfNhN{Dfc@rc@fi@oGj^tI~WsZd_ClMz[kOdNzVpU_HdR`U~bA{LbPt@z`@bNrTgPfe@qJd|A}YbAqGnTjWrXgHdf@t\\\\hs@gG~OpRfd@n@vv@rRtsAzrAfhC`fBn]xs@r{@|kBjm@px@r`BdLX~Ykb@zVxs@r@bpBvMvJzZmIfiCd@ruAu`@xvAqbArOjM|HiFhOxGnvAcYli@zPpYY`PaM~Weu@pkBokBjK{@la@b[dqBlPzW{Ch~@mk@bjB}k@x}@gk@n{@kH\"],[\"gevD{pvz@mNzR}KgAzAoN\"],[\"{jyDufz{@`L_BfDvL}BjG\"],[\"o{zDu}y{@zClAb@l@sAXeE\\\\Fy@^g@`@_@\"],[\"il_C{lq{@vGcE~MdXi@`ZsHtH\"],[\"yg~Bgvy{@bNpGkd@jNqRbTwvAmb@lfBs\\\\rN|A\"],[\"m|lCkot{@`Pi]hA|UvXdTkc@lBqMyC\"],[\"uhtCygm{@`UsXhl@kExb@qRnF|IaVvYcc@bO{m@rE\"],[\"{ptCu_o{@r^DzFpHgj@`g@kFaM\"],[\"qh}Cyrc{@zXue@xbBU{HjOuh@xGeQ}J}OnRsq@bM\"],[\"{jyCsfa{@oGeGfRaDvL`X~]^eJz`@ve@~KcCpRkeA~MqG}IiNlR{NmKqK`DiQsH}tB?hLm]he@kG_EkItUk[oIuInIiIxv@xKzKxK\"],[\"ar~Cmoe{@xq@jIaIxX_G|DcFsOiIvAc[yR\"],[\"wrqDyy~z@bj@aP~OpGrFla@sFpGe~@yCeEuN\"],[\"ofzD{na|@|NyKt{@rQxV~XtCll@k{AtKgHwSjIss@\"],[\"ex}Dgba{@vTeB`PrJqLfG\"],[\"gi~Dcy`|@nK{R|cAiMca@`_AkMm@qL`KgVsI\"],[\"otcFqer}@g@}N|NgIdh@~GfCyGf]rC}Xti@eL{Gy`@zGyp@wNzOwH\"],[\"_fjFy~n}@jn@}XvBpG{Xd]|Rz@kExg@lKuCoGnPgr@kAc{@|ZfCke@bM}R\"],[\"wawDwovz@qIvKaKiAwBgY|PHg@pN\"],[\"yznDgu~z@lOaMtKlApp@j[_iAzD}HmD\"]]},\n" +
+	"{\"countryCode\":\"TJ\",\"polygons\":[[\"oc`Xufaj@pNw^lc@wPcd@fs@{KhF\"],[\"_|pWk|hj@dNyKdZj^vDvtAlX|e@hIzf@`KrOdcAzNvMjVfFhn@xWxMuBnLcR|AmS}LwMy\\\\}LeHaJhEkZv|Boa@vr@uA|y@{k@bxAw@zTnbAhuFHhSwLrNh}@ha@h_AaWnOb{@sf@`]cP`ZvaBxl@to@~Gvl@ge@huAX`NyGiVie@lPs^kBug@_Zs`AsAmu@~XscBuFko@xGkk@oWoe@`K{bAwg@i_@vPyGzh@nDbByIw`@{aAj@w`@}Qay@~Nq]k@yk@|GeNrj@eTlLiZf`@^zKoHdIob@gWirAfOam@}FcS_v@o`@oIsSg@{~Aeo@oiA{K{w@nPc_@jRyKbd@pWxT_LbH{XgH}|AtEiU|i@{Crc@fVf\\\\eXZ{a@ul@c~AkMsw@dSuPle@mIlTybAf_@_OgvAsu@wLao@|Aes@oQmYfIob@_Uec@|V_}ByCoc@pHggAyLse@pHo{@eUabB{WoIeGgNwMkyAzCqx@`FuPr{@_FdgA|XxPmBlg@gh@do@eUjv@_hArZhIxGvx@n[vUjl@ma@dp@IlsA_q@pb@ygAp@_{@kKuQe_@lLuHm\\\\ua@kWjKg|@yIoeAdaA}aEdVo^nA{f@fb@kl@rs@kBp`@v`@jTfGhbCgKrP_KtRak@hPwFd{@f@pIiMj^fP`Uof@jP}HffAlp@|`@sBr^i`@fa@sbAfy@edAzLdl@lh@rMfR`hAj^~c@mGpQcu@hm@gf@`oAnJ~uAkJlxAuS`f@tOjF_A|p@rg@tU`r@rzErPvVdFrv@}N|PxDff@{PvKkTaTwDcd@uSm]iw@_Hs@jp@g^fuBT|ZbPze@wFhr@jb@~s@?xX|MxVhj@jg@rB|k@ta@jy@|StdAtvBbrApRdqBkEdj@fXhzApfDngGzK`v@gDtz@cJdYog@jc@au@fNox@zg@cf@|@eb@`Qq|A{Jo^aVgXiCo~@fC_o@aNkq@vGu~Ai[}XbGca@_e@ur@sCy[zd@a@lU``@~bBwSxr@uk@}GisBau@_i@PkQzMe[|gAol@t}@iEfi@sb@f_@sCt\\\\`X`HsKvg@bGnP}B`h@|OdUvG`o@tJmKhSbZlCzX~k@pIvFlUzwA`o@|zAffChTwCfEf\\\\oIrYbb@hCf^gR|K__@b[mPjj@_D|q@vTzBzRhn@rc@tD~PiI|jAuOr_@_YzUfUzgAoH|\\\\xKxv@w@t`BfYxIvYfh@nb@j\\\\lb@ZreAqXvL~CxDaU~F|@x[nThb@js@tIzo@yg@hkAo}AnuAaJx{@hUqQpMb@y@|NyY|SsAbLrI~Nfe@kEeN~d@}@tw@rEbFxKqGv@bMxVpLpGbn@lPfPdGbjApM}GhMhOoDzs@zEzJdy@pEcDbx@dy@`_Bkv@lfAs^dXaS~x@yPhG}f@iDofAaX}dBbKme@oPipCciCm{A_l@mXanAagBwz@u{@mF_RbNyx@t{Aq{@pt@oe@|EmYxRa`B~IaU}Vee@vDiIij@oX{UkYpDkx@ff@oIzRvG`h@kK`XpPxhA{Ht_BgWpMmo@cFeOzEDtQaSxb@o@zq@s`@`|Ace@fAkGgX_qBgXmG}f@}\\\\fAmNk}@}d@kpAtz@obKQu|@gKuq@_KtEan@oy@e`CeO`BqNqMgTf]ib@ye@s[el@zRsOaA~EaLn`Aqc@qGqY}LbAky@~u@}WvAgPpMoJcGrX_W}R}i@tBuz@wOaEiQ`EcOb\\\\xUfwCu`@faAgRghAlAw_AoLea@cKsdBt]unBoFsu@wM?k^nl@mQpAxAq{@s]sCyjClfAiQidAmrAaB}^qX{Mef@lQcx@~sAi}An@_c@ij@ot@w_@_`Cqa@az@gg@cmBy]ua@{`AgPwCqVxGyZpgCcaDpJd@{Opm@tQhCrHwHrSapApYxEpK`Nj`AvdBhGjZf_@x\\\\vQrbAbKzHfb@mRvTlGnLgk@Gcr@fkAcc@xLeX\"],[\"e}iWu|_j@nRwjAyCkp@tUuBta@~kAgs@dx@_S~B\"]]},\n" +
+	"{\"countryCode\":\"TK\",\"polygons\":[[\"vvuDdqngBqB~A_CrAcCdAeCr@jBaDjC}BdDqA~D_@Fr@YLk@D\"],[\"jhfDxzghB{LhIbKgK|MJ\"]]},\n" +
+	"{\"countryCode\":\"TL\",\"polygons\":[[\"rhrDqt}jApiAbM|m@~p@nx@vHxLzLxFz_@af@dAkd@`Z`@dZ|[x[kBfRup@td@m[{oAsa@se@\"],[\"f`nDuyfkA}Wqa@y{@ye@cQed@}aAiEyb@sVcOcy@hB{UsW}aAj@wt@}Pqm@lAwe@aPcP?}~@sVkhAu@m\\\\hXcjBwO}iByXog@oTsaArSw}@rT_Vcm@cjBw@mp@e~@{gBp`@ckA?u]yQqi@rN}GnVot@nKoCxaDnuDhIfr@td@by@xE~}AnYhdArtAhdAn[p~DxtAvgC]v{@~i@`jBcAnt@bx@zdCvn@|fAze@z_@zGjq@n_@`j@svAzVs_@zb@}q@`DoKkIsBmSvNuh@gMuS}@gZ_mAkFom@fn@n]zTlYtt@w@pScVtN\"],[\"va_DyoskAcZmUgJaPzCiJv`Bt`@hJlMuN~a@kLtG\"]]},\n" +
+	"{\"countryCode\":\"TM\",\"polygons\":[[\"qtvTqtkd@k`@vV_|@kNwlApHid@|_@qIpc@oUsZ}X?_GcNif@qOecBpWiR|RoYfC}oAaP{OxCa_@iLok@rLeFlw@tEfiMiHtSqhAdk@y_AdaAg~AzeAoNxiAoe@|~@mV~xAbOb]em@r]vCfa@{ItWuSyCep@z|@ew@xLuQqG{XpBa^nZvGz}@sbAtmB{j@|pC|ThLpIh|@ac@|}Adb@h^vEdkAk[j}Ac{@~NiGhN}IjgAgd@~`Bm@ps@{RbVaLzk@g@xxBg[|xAsOxNku@wI_rBvmAcFfSdCpWz\\\\jZtTfg@aYflCgYzr@vUbyAgMrb@l@hx@hn@drAnG|BfY}RlTlG|Kj~@aJzx@nK`bBq_@jkCxTztDxm@lvAjMdp@zHtD`Jzv@fcAdlAfr@z|ApYzYth@lOj]yLdp@dLpt@|rAe@ljAxo@thB|Cd_AzQla@kJ|uDomAvGykDvm@mrDbJalBk_@m~@yD}fCvXojAwKcx@_f@oUuIsQdEieAch@kc@xt@ce@bVqCdl@dFpKgq@j[lC`Ev\\\\mKsLbRcm@dAyKiTm@pQsh@{LxXjg@lCyGtcAaEqa@z\\\\a`@zFa@pLqYiCuBlEf]dRucAyKxK`P{\\\\yKfC`PoQ_DfMxPvFrq@kKnH`P|\\\\aPsGrCtcAsJgAwHlc@zKlv@vK}\\\\d_@dPvr@_FbEnKswAjNmp@h_@_gAcy@kjAuf@{PkTxBsVx^vg@`c@fVtNqByEcbCna@{g@dGin@kEkG}MxK}NaGfSaYaPwi@nGoXcKkDuY~_AcF{NcO`DiDxm@uUlCkPjRf]{Beg@|i@}AxOnPqGoGlPzJIfUyKeJhTieAeGbBxUkFbF}FwSes@qs@mK|GyEq_@gIzEoa@g]iCrr@_Sbw@vh@riA}b@|cAdMd\\\\hC`l@cPqPbLvaAqCnRaXxQrMbJbTaNzi@mEjrAclAwKx\\\\pXiTeN|Ruh@bY_Ybe@}j@fL}bA~yAs}@sQajAhj@ubCoVmkBanAqUeByIjXiTaBgEiUwTzBe}Ake@ayAhCbN}Z|Zz@le@u`@`j@mPxKqTiCs\\\\tPvb@jm@{`@Xsf@i]gs@fYbLUut@}c@oWfl@}PbOws@yUcl@up@uCcWkNzAiVnLeSxKrChTeL`PdL~b@wOvVc]rVjKfNcIIowAu\\\\gt@zHkf@eNio@wHmC}IrX_HahAZee@vVdHpG_Ux@i}A{GrX}Ski@CbeA}hAz]gSyAu@rNwHa@ySaf@fC_]|^y`Ar]nPlCeVaQcfB{[ej@_q@{^eaAfr@hT{v@iZp\\\\_JyFuIhIcJxp@qQZoQrT}gA~{BaW~`Akd@~_Ay_@v[sPfk@}q@hZayBpLuiAtn@cL|So~@kJ_b@hEme@bv@iFv^hE`ZeJvY~Vr~DtO`p@s@bo@}L|a@piAd~BdhCvuArWrWd^`GxFuH_Wy[fAa^xMoU|ZxKtf@y@tsBjt@|uAaRlhAah@dl@bPaPfTot@w@oc@nVqX?{fAx_@kInVm^rQqGp\\\\ie@ps@eNf@iWePkf@_BiYdScAbRac@lx@axCkkE_rAwdCgqA_nGou@ykKzCcn@dKml@tgDkrIpgAohBpYaT|a@rBxVyHr}Ai|AbjBgoAhs@goAl|@ml@~Mo]n@gaB{e@sjD|VcgPjTmfCmi@uj@w[yq@{Onu@ik@ba@mx@tC{v@f_@i~@eFmhAtLeQuPsb@utAaiAux@wc@gq@kTyy@rHmsC_ZeoCoUnMkT_e@{x@{]sn@`Qij@cbAn@}f@tYi`@iHck@nVwjApZid@l}@aGaEcy@moCfrB}f@hcBwRfNoNePiXmcA`YuqBeC}x@mtAk`@bwCq~DhQwfAeKoeA~EaNdh@qo@zeAsTpLo_AjWqg@kMkcDnBqmB|q@}y@nMei@rQoD`Nh`@~[vDjv@eM`Lto@lM~FzJyN[m_@nQu`Arj@ep@vQke@tW{MbH`PsQzkAbYf`@xTuDnrAoaAtW`l@lR`Lbq@xF|Z{Txp@q_Cpm@sfAnMwf@yR_pBwD{|B~Fwj@jVy\\\\oTeStKyFoCao@pa@i_AdFgp@yUcf@gYeZcb@qMeD_Mx^giCvlAujCzmAk~@teAcAj|Ay`@ln@qf@rr@iTfp@_c@zi@}cBvrBk_@z^eRlp@zGdaAoRjiAwvApoHwgPncCyoCzuCyrG?sa@{Qko@lDu`@~u@ku@xuAsbCjqAguEtdBgmCrcCgxF`Jei@wA{i@iYkm@gC}e@f\\\\ggBx^iw@pO}{@vi@kn@nVk~@rQypB~JeT|\\\\mL~dBjnAlaBEj_A~Nrk@e`@d`@~]lJj@nHoNnT`^hCvJuOnVnJliAgZln@i@ra@_g@bs@{h@hkC{b@x^eGxYx]vLt@nc@`I|L|p@pVht@}@~u@nu@fHdUtD|pG`|@n~CxYx\\\\h_BsJdTnGncCzxAtk@jQbjAqJ|n@`Ij~A|gApd@vkBzc@nV{CbbAla@j~@~PdLpo@qD{Qlz@Mrd@t_@llAlJzqBvb@jk@fRpq@dHpu@CftC~QhKbb@gPbk@__AnSpTbYp_Adh@ObTqM|u@xKp]fdBrt@paBv`@xh@bQtv@_Dbj@aa@zn@XzOnvA`zAqe@~Tic@C{S|Iw\\\\z{@}{@noAaG`v@vVjoBoIhsAqLfh@qw@jdAwEpTqS`E{K~R}K?\"],[\"i|yVwvj_@uSgP|@cQbNjPdX~AxhDa]w}Ate@\"],[\"qcdWyhs_@}ByZtf@kL|JpY\"]]},\n" +
+	"{\"countryCode\":\"TN\",\"polygons\":[[\"gagSuu_FlmDj]`dDbCnpAedAhYeAnR`Jbh@~~@zoCvcJrqAt}@lYrj@mFrm@xKbMxeAhL~z@pt@pQlk@WfeA`Ub_@t~@di@rs@vp@vg@tEjzEsuAxx@Cxj@wNbm@dI`dApe@~v@zw@`rDtnC|MznA~~@zjCy~b@tgHuStNegGjmL_`Ef]e`DpyByGzs@in@jpAwMxjAyM`OejCzR{~BrvAuyBpi@iWdBu|BqVa`AgfAo[srAaiBkh@igAccDohA_xA}SdOej@_b@y}A`JqfBg_@cUgGsuAohA{\\\\dfAgUjMmo@vAqsAk\\\\mfAjGi|@dh@ko@dIuaCkTecA}Xa|@fEabAq\\\\up@aAoJhDmKn`@eMxnAoTg@otAyaD{j@sR_Ula@}H_CiKkwAoSou@}`@|So]pBuQ_w@iEuqAcd@k~@uo@se@{T}^sXijAsXe_@]kqAiX{n@tCwTwXw|@bCgWcQcc@iGqz@dH_Q`@mt@~b@w@dc@dw@le@qZ`@_g@sPoTwTuE}Kzf@zB~XoUif@~Iy{@wJ_q@lKaIfD_k@fc@smAjK~d@gIlBy@jP`Kd^fN}SaAa^lK{HrLt@_HhChT~Yv]aDn^qVjc@oy@xV}Spc@f]qCtOoHuCg@tO~GjYlTzL`Fuc@sQqN`i@_]~I_Un@ar@{Oar@gjAmh@gKy{A{cAg_BwYcHyF_l@uMcWxA}VbJyDjYnF`e@sWrXgC|\\\\aYhIpB`h@vbAfqEziCxItr@tZn{@{CvT~KtTzWzRrp@nP~yAfIxqAmZjc@yf@np@{Wfa@wk@hJe^wEup@vGmJtSpIrTmI|ZspAkAcXrKcQnMwGrFtVl\\\\j@|UeNhFu[pKbPxOjCjmAhDfi@}c@zPed@bK^fGv\\\\n_@l]~d@j\\\\rc@k@~n@v}@js@ZtYnd@|SmHxPpd@b`Avy@tRnx@hv@tPf]dfBvl@f`@tDrw@dYpX`\\\\hjA`wAty@xGfPx{@eIlnAc^vcAkx@jrAogBxa@kbBqOoyAySqr@`UsM~i@|@tOl[`RxI~]mQfJqToe@ajBoTsMoYlOmKqQjDgNsH_NvNkc@to@_j@f^cBlUtOde@aL`QlDsCcQxWwWb[{kAkN`cBrRjF`j@ac@gCeh@hTgnAaEae@{g@vq@\"],[\"a~sSutuErX_j@ve@xv@rX|GwT?tAvH`v@h`@{GlIeTsB_Yt^yAxIhTl[qHnMuYvFaa@kKal@fFeFcVrI_mAsIaS\"],[\"m{cTqcuEmHmMNifAdF}D`Wla@\"],[\"kdfTc`{ElRkWnGzCzg@|fBuIfDc]e]kQo@nEu]gTzAsZ_VxKe^vChO\"]]},\n" +
+	"{\"countryCode\":\"TO\",\"polygons\":[[\"psiJvqdiBnPsXvRChEzCeIvQ`MoAjPbVjTxAaYtOyB_ImPbEbT|JBhQmQdGeQk\\\\eTkL\"],[\"rz~KthwiBfdBnBqf@pf@iCoGqU`@\"],[\"nq{K|fziBjRoG`]|g@``@BnFnRaGxRkXfQqXpgAid@jWeN}D{O}]~WzTvJ{NyCwm@fIqLtP`KxGcH_HaRlEc`@oViO\"],[\"zvaKtnliBaYcUtCkKp_@|Gr@|J\"],[\"~~_KhiziBnWqMj^hGqGnPuVrFeVqD\"],[\"bl`KblkiBe]g]fg@|G\"],[\"`l~J`pjiBnMgFgJdO{YbJ\"],[\"t{nHphejBrGuJrPfBfMjLw@pQsW~G\"],[\"l_sLltpjB`DsHfCpF\"],[\"|onJreriBaIlEsLaHdJgM\"]]},\n" +
+	"{\"countryCode\":\"TR\",\"polygons\":[[\"szaXgmoYhs@qKvTq`Aba@gk@|q@qMtv@ee@rt@oDpYtQdMyCrVnY|ZtFuCbL|GuCnGh[lRjT`c@ch@vm@lRrp@cr@bPsHxElJlc@e]~Q`j@~PaGtt@eyCxHejAcPal@pGkReL{iAlD}j@f~@{yBhn@aj@vOmC}BiGbSsUpFuZf_Aux@l^eMwp@hcBo_@`b@nBlNbi@jaAjd@d@ro@fZ`nAvDlKnIxSby@}Q`iA~E|fAjO`\\\\lu@aa@hu@eDja@acAjNcDx[pNtWwDpX|WjQ_Gpv@s`@`Sgj@jQ_Bvx@nRrYmFrKbInG{VlMaH~tCtC`Jem@wIq[dZa^zXvLr\\\\fg@n~@jFdK|SdPeCtLtVf|@ti@h_@h@`SrMtL}I_@g}@lI}\\\\p^iVfA{P|VcAeFyj@hf@_w@j`@v^|QvAl]a\\\\ntAlLfI{BjJih@`VsMuAwU|UsMr^cq@fS|@b[b[zl@{FyNdS_FdlAx~@l`BdTpdAvg@xU~CrLaHp^}_A|`@_YwDiX}c@}h@hGoYp^_Sh{@Y~{@vu@n{@vNrs@iR`f@Tl~AgNlg@pEd^_o@txAdFbh@_f@d`Bx^bnBsOxkA}P~SrKng@~dCpiBhRntBoYr@wJ`K{i@cFi[da@rEnNoGnZ_VnFh|Af}Bn}@ziIrE`mDac@p}DzLbtBrm@pqBtLzdAviAzjCzwAnhGpq@pgIb@p`@aY`_BpC~~D_O`e@kfAdsAwLz]}_@x~CzMh~@vc@|fAxg@rtBvPnfBd|@~`CgNr~BvC|tAlR`_@_MbWeg@mAo\\\\p`@mm@~iEhNcAjb@~WhnAfTvu@nAl`@hTfk@mLtTmXjXzHzOkk@zi@kL~Jxn@sCxeA~NlQiPpo@zDfFpG_HlVvIbkBJeCzd@`ClKdUhDjC`l@bQvLp}@xIv@|Jo[xu@kAh\\\\ac@vMtDbj@_s@{g@}IXij@zOifCrcBob@eVmDeSg]i_@wQkEsRuk@ic@ea@sc@srAy[iSqbBzLsz@ly@gQ~m@tE`UxvAfoBpExjArEvUhTjTyKfCxW`TvH_CkImHlB_McIy@bG{GaP?aCyG~KmIuCwZnWbu@bTtGad@iu@jRnVll@pTjHnh@oO|h@rH`FzHu_@oGlt@na@d}@ioBhbFPdr@yKuCin@ttAj@to@|[juAl`AdvAln@zuA~eAp|@dd@v}@dt@KdOxo@xv@nXB~IeN}D_`@nKgNjd@t\\\\pd@tCqGfQ~NxIdb@xRlV|ZtIwa@rXtg@hz@aArLqGqGoGtt@dLzk@eGhd@dJnJ`D~z@aGta@z`@fj@}KvzAtn@vtAeKbrA{]f_AiC`a@{m@l{Awh@zo@{Y~Eck@ns@oZbJks@bl@ud@`s@{Yh}B_`@`q@imAv~EeNl@mIdUg]tyFrCjeB_Yzv@d`@~s@nb@|R~~@iCrXxK~XaPti@pG|Vr]`v@z[vV{O|GrCrCaOxStX~KnF?gLje@zd@vK~PiYc@iO`InBzMyWdaA~D`{@vZrGmKpGhTvKuJ~e@xAjGrCeLdQbi@Y~k@~S~t@tCgCzBlTfCyK~NdZn@dJeLqG{Abb@pWf\\\\wKrAgGjTcLhEnBmGcH}A\\\\rc@oGgXmFzoAeQ~e@|DhF_VnDdAvQpR~Rix@zfAiSpn@tCrGmP?qGd]yKgTkYzBmKjPkKmPcd@zD~Ax}@oGaMoPfIwTiLhEwGuQkXrIrBmCmOkRxK}e@pvA{MxIhW`]bGgCfCjTlNRt@zFtTyKeJ_JmMnAfGaJ|XzVjEyBpAlXmJlEuI}McGba@qOfBuKvUsCzZxF~UaOxHxJbOiTlK}SgGu]|BdLpGiN~KmApQhErLhNcAaGrX_HqGwFvZeLdAiAqG}Q|GxJzHlEdd@xNa]vW~PsOrq@dL?]~UaJAiH}UiMdYrB|HrSxK~[sFbNyWdMaC{EjPpJ|KuCbGxK?tOpk@xi@v^lHrMoCnOtRbO}ExLvGhEkAfO_VpJ?_n@_Yoa@qGpGlKlPwOgGeOpMrKdDoJtf@mRin@Tsm@eHIoGtToPkPdLyKc\\\\pFOrMxVz\\\\kF~FtT|gAqGhBpGbb@wK`^xAlu@nQ|T`j@dGaP|gAjTbr@}Gvi@yJjESpRaPjC|@qc@}ZaUaFedBwUmF_BwLzLs[z@_j@yNmWqBg|@jRopAaPmPgJzHq@jSgE_JeJ~IqGg]qXhT?yK}G|G?kTcGlKfCcgAxKaDkT`DhCoPkT_PsClGmKmGrFcSiKg@aQwr@wGlNhHpnBjAxJfJu@cLblA~Od{B|GtCkF|x@pN~^aIjj@tMdBkJ`Tm@va@iTjTxE~QyE`FhTlGiTfCnG|\\\\pPh@`GdOpGuCoA`[o|@nTgLmEaPqa@?fTqGtC?aj@uT?zPyKqLsXpLaAxBm_@pTiLqUy`AoFoEtCbLef@tOwBmd@tKrFgLoTcFhLgKOoZsOb@ff@fZ`WyAdHsFcJyLrD`If[an@kPgItJpPrAfE~L}Ix]qY@wNcYuLpRvC`VpT`NEfN`KiDrHrs@eLpa@sX}GgCeLyk@hAlE~Z{[}F}RfEuJaQ_TN_j@bzB{KyDk@oaAqNut@iRwU_d@qFae@`Mkg@iUyc@hUd@f|@gThk@aO|GgKhq@tDfr@dLtKsXjRoa@hCsX~XlC|Ie_@zHdGvlApg@bG}BpG|O{@vGvIuXbPdMtEuNtSeSxApBfPc\\\\jm@vK?wMbPuV?zSpUo_@pjAuHqBtDsO_SyKwAqL}ItJiQgBnFmGkKmGrXfClJek@wN`@hCkT{Qoc@_d@fNlKoVuO}M}Wbl@rJ``@yS}H{\\\\jTs}@zKkOqCaJcc@tOex@lrA_mAd_@aGfCna@lCuI|TtIjGgTh|@{^sJyOcJ`M_YfCc[yRu@kM~i@}XsCyK`GyC}J}oAwTov@lE_n@wZgn@_G`E}@rqAfPhu@iFaEa]t\\\\a]xr@vT}m@sXba@g]qGwEdHvEjX}GsC{Bz\\\\yK}B}GfXoGiTca@hTqT}|@~Kit@qG|BgCkTsHiAy[tVoGiXlL{Br@qIeGsEyNzFuOca@tEqKoOgQ_BjLwOkIoLjIxKtc@yKrK?rVtOnc@}Uhd@e]pCwMuH?dLqXup@oPk@kc@f_@a]tt@ah@zJuFncAoYuU|EeD~DdJzGwTqMFky@gyAgCpGaP?}Bc^yRuIcIwe@m_@oH_UrC_EtZfPxd@uGrN|]lzEzWbz@{Bfn@vLhV~BdtAqHfWsLrAo~@mXa_@w[ka@iCe_@~Oak@sJcFrJ_tAqRaUyQvGk`@kNkd@oZ__@sWoCiB{Mmt@gHaK}x@cvByqCs@_dB~I}y@yf@q`AzAiiAyKyk@ba@_YrCjTlU_Zd]ufAfG{|@{Lqe@vHq|Aik@qjAcCjx@mg@~XcWdh@qXin@rLwKtFmuBzPig@pM~I~^neA`OyAbDsUyQqgB}CorCpAwuAvKnPkFcu@hOux@iV}rAhVegBi@qeAmd@aWiCke@{\\\\rt@?da@xK|X}D~_@m_@`xAaRqJ_Ws`Ae`@kv@eLqwDeY}cAbLqNgXcm@pBaV~QgS}S{wB`DyiBsJmHiMhExDb_BwTfcArClsCfKx^eTl]{IoEkI`NnG~d@oPoK?uKkRjRuZliAoi@|gAg]xKs[ea@kg@uPmFlLeq@{}@wCye@hx@_wKfBu|CqMcq@g]{g@jTuu@jd@wlElQq]pFgt@qFuwFeYwx@aa@gj@mx@wOoHrMcLsCeDci@_PaPu[kcBuaA}cCcbAmtDsbAm~@wDgv@uOgRnGuIsi@msAK{YsPwT~@sh@m[iyCwtA_xFzZcvIeDipAvTykEaPs}ArMmp@rBwqAcIg`Ao[ow@me@a`@mQ{B|Bkn@pXyK~LgVnBs_@yKkVxKe[lIf}@hp@jN|_@_T~f@ks@fCpGrYcQd\\\\{l@vMi_A`\\\\}s@rHyw@so@eeE_Qkf@bPjPdf@pGu[{JyUcWnXwg@qGgC~~@_w@n`A`HfSaHra@}j@v\\\\_ShUmz@pTmFdS__@~H_b@{Lyh@}Zme@ki@iXnDkuAzk@wlCh_@k\\\\he@{CxT{z@zBkeB|Ra{@pk@{oAgG}r@}^cSiWa^`OyN}C_j@bf@YvZur@|SmPcGiWp`@ueDsDyh@hScf@sDiaCu]a|@bPoX{ByWk\\\\ih@mU}lAqBkrAuUanAhQq`Aye@caBdFef@xx@wtAmDm}@~e@qfBaPy_Ava@}t@hAub@q[{aBer@meB|Cyx@yW_j@}Hal@ud@ad@uWuj@pCaz@eYmjAc`@cp@kEmj@ic@{y@}N{`A{PcTeZwGgf@sr@dUoiAmEcf@bOsA`E{`@xRo_@yf@sq@qKw`@xKwk@gHwuAjJiRKwl@p^k|Bqe@gl@_m@qQgL{z@~HibBzVvIrQrUzRuf@iPwVzQcLrS}i@|`@wZrj@ckAtGqd@hQvO`Lx`@`Caj@pj@sc@eKox@`B}d@jR}`@vM{G\"],[\"{wrXoebPnr@qPv@`i@tg@nBzyAwgAfIxFbTiLhbAwsAtk@u}AbpBsfIxMkgAyEwZnJiXbZ~LfPv[hTsQzf@vP`U|_@hMy@Y|[|\\\\plAaJj_@xEpyA_ExQuReD{GnPeMkCiTbR}A`Nf]uTn\\\\dL}@`Qk^fo@mYb{B?rk@xZd~Anj@xk@}Xd~BrMndCtGjR|SlL`dAh\\\\|j@vy@zb@xYjk@zcAvKjpAf]`c@dGzo@nz@rmCrQtD~NvYv`@tQbb@f`Afd@dk@`Rpq@|[{Qjv@vyA~Dtl@mlBqfAcPdBmWn]gGqGkFrL}DuDcTw~@sm@ydAid@iqA{HqiAi]s]Pua@mWkXsc@`WdLfpBpXv_AwK`e@fH`[kEtOhG`k@uA`cCoa@nVeVwAkVpNmFmp@wg@w[_Uq`@_JzBmTwKnGcGuTgLbFgZ_J~DyVmg@gOdOsIyTsMyBeErOuS`MgX_H{CzL}QuJ{ThHyXiHyItHeKgx@yt@aoA`My^sPkXiY}AcuApX{m@?jL|J}b@~dA_PkFiVfxAgRtIq[eJ}OyXwCc}Amo@qSuJ~Hk\\\\ef@fD{pAgNmZ`JgW{L{XeDyo@eu@gbAbOqnAaWsYlAsb@lT}c@pi@gg@`v@m|AgBeIcJlF_H{_@iHuBmHso@rLcoAgGzFuTwD\"],[\"oaiW{~{Nt@_Mf^~Cy]r{@\"],[\"cooWs`{NxK?vDvLxWbkCcQd_AsTiMu[ol@qUkgBpLu[~f@nD\"],[\"e_yW_e{O@_[jP}Qf]~nAwN|i@eV~DgLeKjGkDgIu^\"],[\"mfuWg}zOpEcFkDrd@qJhIaQcP`FwS\"]]},\n" +
+	"{\"countryCode\":\"TT\",\"polygons\":[[\"c~oElmfd@aArJ~JjFrc@tAnw@uMhNbQ~fAeKng@qe@dR`Qj_@zFfcAgCt@n[fXhc@jLlx@o@h{EzKfp@gOpf@q@|aAbYh{@{GhWgTg\\\\yDcZuSkS}^otAqO}Q{QNcC}FbCejA}XyZm{AbOaoAcOol@vOq]lv@eEfp@sI~EqX}g@iCwgAkKyNvFyFqG?~JaHa\\\\wh@jBqsByJwNaHwn@yB_jB\"],[\"mu|Epf}c@zs@p@zEp_@`_@ve@y@jTzf@fkA}HlC_BdVmHm@gFaUeOwH}p@seAgRmt@xBcU\"]]},\n" +
+	"{\"countryCode\":\"TV\",\"polygons\":[[\"xyeDqyjlBmM_Goc@|And@iJ\"],[\"vr|Cy~zkBSnCaGk@qIwC}FuC\"],[\"jqkCk_ckBm@B}Af@k@DfCy@rAETL\"],[\"|azBmsrjBeGaBGiA|BH\"],[\"rpvBawfkB_GhC|BcK\"],[\"~dnBqcojBqGfEfPmM\"],[\"pqvDuxxlBjLZeTdC\"],[\"nnuDk`xlBhAmBtGeBj@Z_GfBuBvA\"],[\"diqCw{`lBmV~IjLeOnME\"]]},\n" +
+	"{\"countryCode\":\"TW\",\"polygons\":[[\"yhfNc~kiAnz@lr@pn@lI~rAcDnLcGrNs`@tCrXzWoHdK~@jFdMrVeEl]nd@v}@jLlMyGpgArcAtgAta@re@sLt}EhbAhdE`d@fv@h`@dcAnDze@|_@plAz\\\\je@nZba@xg@jg@`GtjAt{AtpAnq@v~@zNhp@|XhtDj@h\\\\vHpLlPrr@{Aea@pl@gCxQpMVhBpLct@lSuw@qHupBhn@ep@f_@{^`c@}b@t|@_@fSc{@xz@z`@i]__@rKgr@dm@w^eCsr@|[o\\\\eA{vAje@uSsPmJpA_A`N`PnPsXzB`B|_@cSsAeLsXqGrXkCsQySrQkRePsNdDyp@}QeD{HmDzG{GwBwAgS{JpPiKyDMqTyv@hTiX{Od@hS_RLeJ{Iq}@e@_nAoXe{AkbA{mAkc@wc@me@qa@iEap@ok@_`Bqg@g|@ww@}kAi`@iCaOk_@k^Gk]yJhAmqA{t@mKfC}\\\\wMuKgPyz@m_@}]s`@ce@w}AeKsiA_Pi]lLgg@_\\\\r\\\\sc@iXkVme@sA{r@tt@aj@cAuTdL~C`MeP~YauBhx@oOPe]~JuV\"],[\"{pmLeheiAdRcNxB~KaPp`@m\\\\fL?sm@\"],[\"u}}MkkggAiBwP`M{Ofm@sG~HbHaJln@tYda@wElTqn@b@}HsTdMyVy@sO\"],[\"e_lM_{_hAda@yKeHlPh\\\\zn@sM`VoDqd@qGvTmP?mK}d@lKfC\"],[\"e_lMep|gAi\\\\cN~F_Irh@~NhDx]wIaS\"],[\"inlM{k~gAgV`P}GeLdOsQzP|E\"],[\"{pyL}gciAqI~@~Bi[vTdC\"],[\"g`iMudghAy|@i_A|^pTvh@rr@\"]]},\n" +
+	"{\"countryCode\":\"TZ\",\"polygons\":[[\"|vvDq~aSsi@jtAm@~Rg_@lWgP~xBkw@lc@cNtUgE~vBqj@x{@bBpVeKnWgA|NdO|^iEpYqWvK{]AiXwJgXb|AdPro@eCdV}_ApiA}`A~Gyj@tdAS|Xi[lS~Uvx@_Zx`@jUju@EfYch@|hAodB~pA{|@f^srBj^mmBtl@}mEjdCyqD~k@ocB|m@caDf~Bog@zo@kk@fvAisAxfBwjBtl@su@li@_u@hXkhDz]sf@oKcaByaAio@{Dqs@xJ{mCt{@adBnXaxAro@qgBv`@ms@v@{zCeq@utAy@{@iqChIaz@}v@a_@aAqf@s|@g`Bix@i]ky@m|@u`B{c@{vAi_AaIyN`H{Z}s@hDk~@{a@m^cAiW{Ue@}Vgx@mqAuOYsKdVyWgVkRuk@aL}DdGoOeKm_@s~@gSuNMcNzKgNkBi_@rW_j@o\\\\hHjy@uMf]vAx[uO`Dm]rw@xZn^eX|Ge_@nc@ucAuTwx@al@xR|p@yEbRcl@o[asAo^if@a\\\\aFaKxC}f@i[cVjQos@kUgn@u}A{JqdAoTwc@lTcB|U{s@iE_BtQ_P_PeJvCs\\\\iLiaApGitAgCtDzMuFvHm~@|_@wr@ZmWt`Aw]do@a[Lm_AbZmHlZgVgA_LdJ{VoP|GeR?inAg]i]oWgw@nIa_@?g}|@xTsHx@eVndf@gdeAjNqHjwC}WdErPzRf@zGtYtYfYzSrBvI_Qb^tDrGgcAboAef@xdSqe[vd@ShRt[xHs[|d@n@lr@`_@ow@Sd_@~IlJpH]pL~UoKnb@eAfGfTfNpGpG`@wG_\\\\f]oGt]l]nu@p^`H{AmPoOtFwBb{Az`@hWd[|\\\\}IxWjGpdAzf@~KlSzBqG~h@rCt`Adf@jcA~EdpAjYhn@iTtSgZli@_ArUvJpq@{Ev[y[fYmIxUa^fEcl@mRl[fJ}]hjBkjBxv@wShGgJyIaIr_AExJsKkTfCTmJfM{YrJmcAjxAwv@bPpGjw@uC[hHtd@tTiOnKfQfYzd@lL`Rj\\\\dUo@n@fYle@qOpo@l^l{AfQpaB_Pfj@z]eCcRoNsL}g@gMjOcPfQ~Gc@ea@|_@_]jj@bMlx@aI{GyKdR|@vyBzx@?bLti@uC}B|GvOzBwOhTlOgDzGxLvp@yNzgAj@vr@ca@pmAbA|X_t@d^mGlLmUtg@kR|QkTLrSeg@pk@lDtUtRfN?en@tOjI~FuZbsBcCoRek@`{@sm@jj@hF~McJxt@lCxPvO|Ch\\\\pY`@sCsXoHuClKwTlQNjRgRjm@sKjN_NbL~F`Y_QxWqB~V~]hAqa@~m@e]p]H|Bz\\\\nZuVdQnn@ra@kElU~S{AaO_b@e\\\\tCyf@fZsu@d}@{d@vu@kEwPsHie@hF^kR~UqeAl_@fDhO_FtMhHvD{WyNkG|Xmc@mPgC?oPsO`j@_YiT~XcPrO}Xzv@{\\\\q@yl@iK}A{MjToFoTh[_Mh\\\\`W?gO`[tFnVuFxd@x\\\\x\\\\|}@zjArnAtaAr~Aj`@hkAbp@lmAt\\\\vbDbmB~qClCh_@kTjt@vKzg@vCxeBtz@jtAeCzZ`K`e@`uAddBea@jyAar@|w@mCz[rGdZkT~b@nT~iBjZpj@pqAd^`m@^~~@thB`Dxm@zPdj@f\\\\hTuEbx@mPlV|Ibk@yMjoAm[vj@eg@nWhKfTbDxx@_Krt@d~@hr@d\\\\deA~C~p@gKbVaQrG?~gAlPb}@{GrVhCvXeOxKq{@|Esl@dzAaAv_@wXI}ObR{KxeAje@xe@hK|i@tL{Cta@bWvShv@_EfZtIV_RnStD|b@gH`J{@x}G}m@lA}kAhm@eZxt@fGxTe]zP_KyKwM~Pa_@bH_JdSyr@ti@wt@xKk_Ay_@qxA_F}ImJoZbPyYwF__@`TiRd@iB~M_W`Kgc@mF}k@lP{iAyK_yB`e@k_@iFo{@d`@smBv}Ag^rGwErQkzBdaC}S|h@hl@vh@|ZsJdh@~Dzb@zYg]b\\\\mt@tpAxOns@aJ|w@lRjp@y@|XkIvT{a@fQqb@tm@Xd|@|IlQmMbj@uaAvoA\"],[\"ndtCyejWpIqHf}@~I|bBxj@X|i@hc@tPtHtW}@tb@eXpY}BuTcNcE}Zie@y^yEiR_dAcGpXsVuk@\"],[\"vmzBuicWfb@cR~YzAxQvNlFjUuE~UaRnPcjA~OfC~LnVwHlPxBmElPsTX{v@dm@rCnGbLgCyDjGvHrAxV_Ccx@ls@if@nLiZmU}iAtOaq@sA}IkCbLqGin@aj@}`AqHiJkJjt@kY`y@Zhm@mJfo@{QhCcPbi@~BfUgGjTxKyBaHfOqOkNsCnDqUgl@p@`_@oO\"],[\"vvhB}geWen@uClAy\\\\oa@tTn@c`@wDaEyKdUp@{l@kMmIJzZ{Pl[r@xLmMvJ|Gc{@cZfAlDbNkTk@VpQmc@eEXmNuJlC{IrXkRcLfC~GsSt@pBiRaImAyRdWga@gCtXoPeLmMr^ek@pSwH{Uk@qFmFxKwBgIeDo_@yBnDmG`r@mDfWrYfn@tCbUqEyPeElB}FpuAfBb_Bld@wMnHt]pItLzTmM_ElFvS\"],[\"vc{CwegWm@ea@xG_Bvt@hr@WjIoY?\"],[\"vtoBic}Vfu@kFh@rMmq@@\"],[\"pjhBu}cWb\\\\q[bEkRtNwCsFxZ_c@|[\"]]},\n" +
+	"{\"countryCode\":\"UA\",\"polygons\":[[\"{qx^ukkRL_z@lGsMj^eElCoJqJ{j@hC{fAcXauAsLwZaWcQwo@mLoPgW_XdPoTyGgBmXhK}t@uD{\\\\`g@czBkEgiAfLog@af@ujAg@}]qe@q_A`EamB`Kud@eKge@nIyJtV~BvHuIui@cnBVsgAdpAa_BvOag@pWcZvbAt@`c@aWlX`BBg^t_@kr@bLsBvAwd@bb@}w@~W{OvX]lPtVfYnrDtM~DtL{d@fVaGfj@ek@`|@bCnDxQxGTfRke@cBa`@bTiDzStNt_@~{@`M{z@_Rgw@~OqwAwAiz@j`@oT`GaSkCcxAwWkoAdC{qAd|Aqg@dJcQeCatApL_\\\\`O`MnC`a@f]eCvPqb@rWDvYuUxU[vTcd@xm@iCzJuMxLho@jNtGxjAw\\\\hNeLfO_z@|l@kQnNwXbCmt@yUoX_Yi}@h@wfDjVuf@|~@i}@`Cg\\\\qQ{y@dV_nAv\\\\b@tHkd@_E{Xe\\\\g\\\\kf@wfBsH}g@jA_uA}OyyAa`@cv@kIgpAjCqK`t@}Zt]oiAvy@h@zhAqpAbn@utBt_Ays@cPih@~Cy_@kLo\\\\wiAyCEwmA|p@ab@fSmwAsImgBb_@eMvPyd@fKg`A|e@gt@mCydAs`@oi@wDeWlH{OffAyo@jJwh@oMmgA|NefA`dA{g@pk@snB}E}u@ia@}bA~IkhAvEoH|KzApWzk@lSbKhe@}Orx@}{@zu@bJxjAnuB|n@zSbKvhC`DvIj^lCtm@}s@rIm|A~NkY{Tqc@j@{NlUqJnd@lo@eOl}A|C~Zde@xn@xbBnk@|@}mBhPu[z[_HvEbPvHs@bbAsp@li@rbAfQaj@}Eyy@vUkFdk@r{@nf@r^zYxGfXwGjL|r@n`@uUr_AzKfYbYiHjeBdDnnBsM|k@zIz_CuPnm@uAddBxXrq@fxApe@{Bdl@lb@n}@dKxaCzHdTn|@tc@h}@l@rx@hj@vGo_AjR}Bxf@dz@ne@h@bPoH~g@lm@`FjTe]wLuNrc@jJh[mKdfBjOjbA}Bh`Cn[tf@|~Ah{AhCzLwNwAcKvLoIti@je@|zB|m@llBvMoDd{@j^~YzYsF|NxBmPyq@gRoa@naA?xe@dLnZ?dh@zTllAlg@l{@j_@vNkJtEfHrLiN\\\\aTfa@dI|rDb_@npAf_A`xAp^vtAtp@rdAduClgCn^hoBo^sj@qGtCuXo`Bo~AqwAwNmArMpTmGlZ}LrD_g@eOwNtj@c^lO~JnL|_@aUpZuCr|@d~@v[no@lId`AmIpSxQbFvG`ZjRrOv|@mFz}Ak^dzBunAhFjO_u@tsBwz@|AmSbLgYtm@kCbbB|Q`O{AxWmSp`@ii@r]wGnPoPf|@jCtm@wGhy@{w@piC?ppA_Mj[tj@dEpM|RgR~R`Kd\\\\lQpJfKsObKxe@nLyKkCxx@q^fh@iAtOrKtKsCxKuU{Nv@d~@wz@yKdk@nd@vIr_@gTd}@`QuDhG|GxFhiAyKbrAfd@hcDq_@puCi]xKmPaPdFbb@kIvs@gb@bp@jD~Raf@tqApXdAwBvq@uSbT_NiSqDihBuYoy@oa@x`@iCt{@oZjdBhLjc@l]jAkYhMegA``BnYyl@uDglBzT{`AhCe{C`]u_CwGir@yKaPqJrJeOaP}]uv@gFwf@i[ub@lq@xrCdCvl@g[vu@n\\\\t~@e\\\\vP{Llz@_]zYcr@tW}XpAwfAqk@wNfF_Hnd@aa@sXuIxdAmh@wReO`HsH|U_`@jHkQpl@yY~HbWhHjPsp@xj@{D~Puc@`XnQf[pCzDqcApa@x\\\\`LkOyGuYnLyA|a@hPjI`\\\\tUbTrOGbY}Xjv@pCrO|s@{QzvAfE`o@dOlJcFtt@aJnFsIeEy|AqnAaGpFnSxLf]hn@qa@fi@t[mHjTi^`x@`w@jP`p@iPjnBhP`hBl[vlAx@tn@bVjUlj@aZf^`Of]fc@bAbf@jNsTxKpApfBlbBh`@dQpr@xkA`_AtcAhn@zkAuk@xExn@pSXzS_T`k@bDzLxh@vHcYrOhRjDrRoL`PhCqX|ZfPht@xSO`Uqb@tKp^eCxKlK?dLra@e`@`EkQzXea@bAsQeHc[xa@~FfChNyK~_@fQlOgHrJlTrqAlGpGuc@mPsc@lz@h~@vU}TgSoHy@gIdPy@kCyOvK{EuW}Jz\\\\wMrFrPtQaMjg@wBds@pO`Nna@m@tHsb@iGwl@vLq]jq@cU|uAkGxbAlLdX[n`@tTt`@xg@j|BbW`LvBhM_Zh|@PhYhQIdJ`RvQ{YvIzx@eLdrAwm@fyCip@r]mKgBaXhu@uRcJsPg`@~FuoCg^jAeLeWoa@~NiMhVqZwXcH{[i[qHsHey@uT?_Oyi@sSaDc_@fJoRkFc]mfC}u@hJiy@{n@sLnBca@tn@qe@cFos@`Oi^sO_s@_tCdUcMf{@VxPzLxKyIeXsr@{`@sBwK_Jza@oi@yi@yr@nD_Qt^lBrFyd@`Z_Y|CkSsGuQm\\\\qDoBi]yY_Fnl@{t@hZ}CpHmKmTsw@IodBk]q^?ba@oWhf@mDwCuMxJp@bSmW|i@}L|@sAqWoLgFeXnJmlAkKuh@hMr@bWi\\\\v[mA|v@e\\\\dc@sX|tAih@sGw]oQ_R|RtRh^g_@jYaNyh@u|@NiOsUwf@vM}Ghn@~ZpLpG~]wPfY_c@rLwFb[qi@ri@fIp^qKpOas@zV}p@mg@e`AiHaW_YaT~d@sq@kc@uKrf@gg@jN{K~MzBjf@dWjZiAvh@gw@thAku@vS_Z`lCtB|`@rs@xWyb@zd@iPeJ_LxNh@jMhWjWqBbSkP_BkPwXw\\\\pBh@nf@vPlo@kHfPkUxElLft@uCbHoWgKgRtLiNv|Aed@ne@uYtp@aE|aBaCpKmOh@e@rLnGnp@`e@t}@kNhe@dn@nrAmW~iBbEnFpQyCpDdw@aXpdAhKjHlBrW|c@{FlKxH{A`W_W`b@p\\\\da@|MnC~D`RjVlpD~hAnSx}@~{@|O|hDrR|{AnUvqHpKtYvsA|uAnSpqBot@fv@wZtoAsi@j[eUfx@eD`rAnRve@|MrgA}Ij}@e\\\\nkAzO~~Ai`@|m@mJpfCpT~~@sL|}@cu@loAoJtx@xO`Xxf@lUfNtc@qLdq@n[dDxFzVcSf[}]gQyJfCiZj`@yNxa@`KrvAcq@`^{g@nm@ItlAs^r]sa@zDcDdY{[kHeHrj@jGfj@_`AuH_e@~D_r@i`AaR}h@um@yKwVyTye@_AqRyYgs@gVmLuk@qp@}JiGeLlYkr@]geAt^o}@_IiFuSzMaYkEiEpFem@vsAvC~MkfAab@gbAfJmUbXucAdWcWqNwaAw}@oMwd@el@oe@kLa[eUiJqpA}|AwrCipEoiA{`Cua@wVgLyg@[gpBwG{_@iZqOuZy@_]s{@wf@~L}NmMud@|SgMyBkHx`@qHxGyMeGwDf_@{RlIsPaUy@guAyIuFuq@leBy[bNoMbZ}a@tCwc@`^cHsKsF|Eoi@pjAqn@ra@uFp_@sTVkRe^{MbBmEuJ_^p]kOyGkRda@oK{Kch@bUkPsMiKcrAjNosAvVgb@qA_XsqAgcDmNsP}s@oWw_@qp@qFszCbEgr@sPqnD_WqsAbPwdCkC}bGjPqbEnZaz@xBipCpQcFpHoOrAqhCp_@euBfCgh@wL_~@xC}oA`EwO`s@}E|Fiv@xa@tM|CmGsAmwA_Sui@pTseC~KyFdn@tXfQk^o`@{o@mi@kCiP{R`BuZn\\\\op@~@ogA_z@_vAbF}TfW}FbPe`@v[kMaZqnAjJexA|_A{SvHmOkDgXxXoNcPgMka@Kq]y\\\\mOyf@sEghAyZwYkIwWnB}d@pLyUrs@ih@tf@YdMeV~c@aS`DiMaRwr@zEwf@w_@oWqFef@yXoj@nC{e@rZuV|Duw@wTac@gK}bDlr@gjBxRcErQ`G`UwVlGsl@jb@udAyi@eXcSoc@sPtBcAgG__@bPmD|QgNd@qHcSeHhQgPdAwF`MyKeLwKje@mKyKeLnPmK}Xun@qG{Bea@g]gLgCnPgQw_@aQjD}`@il@cNoCu@a\\\\cJ_M}KtCqJia@mXiWxAuZmOLq@fQ{UuCuHiXaBstAzX}qAad@cn@oKep@\"],[\"uk{ZejgQojAq`CtyAdgCzm@tmB\"],[\"sgb[iicSzIsi@bG|I{\\\\zeGpGkeBuDq|@jI}RaHug@\"],[\"{le[uynRna@glCsk@dkGgY`aBo^lc@sd@~I`B_Jbl@gSdV{f@\"]]},\n" +
+	"{\"countryCode\":\"UG\",\"polygons\":[[\"vyS{crQK|rAfk@pKnJtQd[`Jzc@~b@fJb\\\\fi@nJzZ~t@`UxL|\\\\r}@aKbc@as@hI_\\\\lWjCxObUpMiJ`XnXxz@Kfe@so@wDsRvHwZeEc`@fN}_AeGan@nLgm@{Bkg@ab@ewAzCa~@gPcMjFcTmT_lAxMynGat@_q@~Eg}@kc@pCkUaxBqYkvAa~@{iAbL_aBqCa^yK}`@}w@sQwgAwbAkh@od@pByc@wMgUmWzHs[{[uXcBuh@wRe\\\\qbDc~BulDavE_jEqxCo^qDqi@lw@{q@xF~Krg@aDzb@mIhKeNsBkm@xl@CdVfd@|QtG~PmZn_@oc@hHuE|y@om@oO_m@`@_mBmh@sI}Oai@mNsp@zDgXlMe]zf@gThGavBwd@c{AcaAe_AL}M`GrQpb@euBq`A_b@irAc^{f@qCyl@jJcq@xhA}mCaTuXkMgkAw_A}w@hS_`@vp@ok@daAqPxFs}@|_@w[bLkx@{aAkK}dA_rBuVkoFyNos@_x@_aBnkAqgBjGyjEkNgYkuGytGvGaQpX}TvZ@fSuPbq@bDd]w[jr@|LfDoMwIym@fFyL|OdDlNx\\\\rOwQxKpO_Dgv@dm@wV`CeFsRe\\\\xh@c_AlwAfBx]f`@`ZyIh[bHds@aXvkAeEhMyEjXqv@ruBy^zi@{a@aLyZrPe[ngB_\\\\vu@kYjEmMuI{MpGgE~i@eFd[yUfX|IzNzV~f@l@nxCu~@plAlB`e@yZb\\\\vO`fCjC`p@jWtM|^lTlMlq@dLl]bd@ngAuRjXzFfMhfAl[ha@|Ef]|c@`KuDp`@nKxK`mArQrwAfj@hb@~v@`p@zLbO`Nb_@`_AjwAf[~W|P~m@}DfsCvvBbdDmd@t|Htc@lsE{D?ja{@oI`_@nWfw@f]h]\"]]},\n" +
+	"{\"countryCode\":\"UM\",\"polygons\":[[\"cjfIlcnfBk@aG|CrL\"],[\"bjFvqt`BiAeL~DdAdCvF\"],[\"yyBr~ujBCiAW{@Fk@vA_@v@nAWf@w@^\"],[\"elCdnujBdCEu@|A\"],[\"{lNr|xjBkFtA]I]Gg@KfAy@\"],[\"_rwJchudB_BwJaKbAlL_J\"],[\"}~ePpmfkBbAwBb@a@PhALnE\"],[\"_afPp_gkBfCfChBxCbAhDVpDyDwAsBoCq@{D\"],[\"eyeItdnfBPeElE`KPpBq@h@mAc@\"],[\"_`fI`ymfB_@?XQ\"],[\"yufJdixl@j@yIzIkARbH\"],[\"o~qB`g|aBbJrFwBhLiEaK}@xP\"],[\"e{|Bb{bbB?iHhH??hH\"]]},\n" +
+	"{\"countryCode\":\"US\",\"polygons\":[[\"i{{\\\\ro|iAC}qyO}JcJq~EX|MqqC|Uku@f|@sStZdBn|Co}@|aAE|PwLv_@_{@`O{eA_FysBld@wd@zHi~E|JoW|l@mO|O{_@cOsdDmH}R_XgD}Ich@sH_oAzFwlDrVuz@fZgrC|SeLlK|r@lMdDnLse@sCkpAxFuUfWkMrg@eBtaAsw@{Oqq@ie@pFaLmLiH}bCpF}O`l@cUdOiSrb@soCfYk]|N`LzMenA`XiHiByu@tI_d@wPu}AiaAi_Csf@izBzGuS`RrDzPkVzd@cOfIsU_LeeBdL_[yRofGzGm\\\\rr@wo@lRym@sQeaBpVyxFclDqaMi]keDvsJe{\\\\vyN}le@hdD{`ArjBe`CtAom@ea@cj@mD}So@gVdLo\\\\_[obAlKiu@fy@f]nt@k`@|SpGzv@}LvMgE~]ch@fUgC~_@_^kAeTs_@cx@|Fol@uDk~AjbAm}AdqCxkBf@q~A`yFonPfViXzia@szFdgIzdDpi@dAp{Ahi@xZaFpk@vFb}@vY`]~]vGxi@zbBxtBhh@v`CvOvWtg@h]`xBjRt[q@`q@_Tjl@ka@rnB}yFyCgvCmkIslU_aBybSgpG_~Vau@w}@sXeE{IzBch@|gA}ZmD{\\\\fYmg@mI_bAbEusBlgAk~AugGeHef@|Bcac@kKuZiqGcuEei@i`AsQ}LsZwwAmIiIsUzDkj@sdA{d@czBya@sWkhA_qAioDmkGkwAadFiDwkaAqWL}}Ak|@wLnYcHy`@o\\\\{d@gAqX`\\\\erAma@ug@i\\\\w~@zH_Yz{@_d@}EgJkVsAuOyTas@]}Q_LcCuXxX_n@kKsa@}r@tw@uq@g[_w@ofAca@u~AoSqIea@~Fyt@oqAiS}KeOcCiTvd@ah@eNiRzK{Oc]oTwKkY`KmDlLoKmAwn@}ZwImTa`@mGee@ifA{pDqh@uvMq`NxOwgBlGyHlv@rFrd@mDvKyIxc@wrAg\\\\gyAeDohAed@{mAlGur@oEa^iOgAeRkTyDa]nOe_AzhAk~Btc@c^rr@cpAb|TcQjXwOjZzXv\\\\oOne@|Vn`@qAnSg[sFcT`WgV`Tas@xDmaB`GuJhg@eBdXzh@nVyExi@m`@ljApYv}@{fAnFeV}^cg@lOkp@vClTnG_Cn@uRri@yc@p]sDng@gg@bYbMvB~KkMbTr`@vA_Nvm@pGhCzVgX~^}G{\\\\iCpG}X_TrCaB_Kbh@}LiFcMsf@xH`Ymi@bXyGf]pa@?rXv^vWzc@tw@dGsCmG|g@jPeA]vLeO~[uN}H}ObLtEbQxBoGlCjGgGxTtXgNtKvEdEiHxRjYsf@xNmB~Lna@wK`B~IaB`_@aN@bE~O_MzBh@pHjPpW`CkPlk@|@rEpTgA~TcSbBw@tHdh@ha@o@tGyT~Mac@sCti@|XqG~Gud@cJhO|MqDrLrS|I|DbQ|ZaP`J~HoC~Nzv@bG{G|JoOj@o]iLlJbOhd@rDiJbOim@`B`@jEjSxEg@~WhMqZl^gEjFfEkE|Qzg@l[eL|HaPcEtCrLiItD_z@vG}KpVzWrDd@rMgh@vKvc@pK_PbS_PrAeD~]pJnBfNmZnKjI|GqG}Gfr@pa@lPzBtO{FzIkj@fBgC|[tm@yP`QlHr@jG}ZhClOpXgBf]zZqRnd@~FjO{TjG|EyC`HlP_TtDxP{c@px@b@j^cTd]pGnv@ca@qIxBuXiTbAuIcNzAuYmU|Jb`@jo@}DvKosA_m@_JfAkBra@vq@fOzByK|JxVoL|Iz`@llAvv@u]fVle@|aBxv@b^[s@ySpLfFtOn`@jYnMmDtP~HnBa@tHfMrFhJsF|L~k@cp@mZTzJbh@~k@wWqCjDlh@_U}LaDzLyTyLaErIdTtVbNqCbLv[d~@tVj[hTef@`Pdf@rC}SlLw_@qH_LpGgf@aWkIbBhl@j_@xg@rDrZwJpRfI{Xn@tEpQ_Un[_N}KuO?hCsOuVhTck@cY[`H`wA~oAnI_Pna@|GsQ}Mj@aJhh@fOfI`PwjCx]ao@kSbs@pbAjJoEiFi\\\\b`Ce[vg@z\\\\_b@pQ{f@kIe_@jPbGvTdNyPzYbKoFpHhn@rr@k_Aoh@yKvAx{@df@lFhTgcAwn@xK`j@kTkTXnGf`AnqBbYhOnH~QlUgCpGba@tHon@zN_Gh]fCnGpa@oGuCrAvJtQxUkLp@pDvSjTxMxVRxLsBsCsXpa@da@b\\\\zo@|Erg@rXz^v\\\\zFtL{G|SlPhC{GvHdQhp@f]hDjFuUvY\\\\tJje@dL{Bc[nKS`qAfz@xu@tB?ba@pNgc@bt@eRrCtCsXrXnRoAdVwo@fC|S~IgJlA{a@dL{JkUu@sO}\\\\`Mc@lC{R`RUvQl^{CdP`RhTtZthBtTpGaLoVhN}H~Sls@fMyA`HeOtBlNkN`PlD`KtLbFrb@uLgL|ZbEjDbFsJeG`^dGjFxKiTzIbSbLgKqEaT~OjBfAoU`VwCmL_v@o`@nRl]}h@fT_w@zM}NtW_A|p@ir@zS_AbYcV|F~HwBjR{ByKi]jRda@nI|C`^n[md@`@uv@fH_Lbl@eLr_@vDjO_Qb[s_AlD{mAtIxd@nAyd@mc@y~B{Im]{NkL_h@`@sNhK}GwTwK~^d]fRq~@|EoYpKoO`h@~GbNlL{JfEpJgZ|b@}J{ImBgVhPkdAdd@_i@ncByv@Up\\\\hYzCuEmMfHaMfiA|ApG~xCvIba@lSpSgUiFzOppA_LpOhh@pe@`LtgAxRbf@}DxOo\\\\uSqaArBa[uTgFfIzJlJgOp_@jT`GfOoEm@nOlZzT}FtMdInCdGd[bVaBu\\\\rd@hd@jOnQ_C|G~LhQzx@iEnQiKeOePpJzKE?j]vKmGda@lv@eT`IwxA`Bi}Ac{@`k@~g@Pr^rm@|SiKf]wo@cOqCzHhTfEkFtPct@z`@tn@?~QaPtVvKwKjTzIrPrDuM~TcGlc@hSjUkJzj@\\\\bf@|PzWxYWf~@pg@jiDqD~B{EkLyL`QPtu@bOpf@iTn\\\\pX`P}Ffs@nOrBiGvOjPde@gN~c@sXVgJbZd[kVzMjErCqGjGxJaFvr@tRpa@cPp{@bPdr@sG|x@vNreAqd@~In|@z_AcBxWvKrXrYvG{Nfn@zInNoErFlWrMnEzOqIjH|IrHnIto@|[z`AfSvVYlq@jO~[bYtOzCbZ~m@ji@NtM|RbDdO{LxD`{@QrS_LlBitBqb@ovAAe^vKca@he@i`@aExJzPbMtDp^gHva@u_@~zAeF~s@hFxfCbfAbd@`g@hDx[yc@yU}FfCp`@nVvQfd@xe@pFtKfW`WbA~HvJhVoOtIo^o@wt@xYinAyo@vNnRkWthBL|yHteAszAuFieAkQwOzDxEjKxKsI|Src@xHnAmAuKbHeAdVbRpFiMdRvOpY}Dvn@~c@lPhArDyOjUtAf]`ZtClS|R~FbU`l@d`@}V`HnC_Yje@gCf]tt@uM|M|F?j_@z\\\\xKvAmTtVcL`\\\\|gAy`@e]{GjP~]n[tJ{AlAdx@vInJ|Gqa@uCca@`j@xx@zv@nc@sChLnGfK`AgOvIaD~YjVtHnY|M}AdPlK|Yzk@hJvp@gKbHme@mIq}@kc@oWmCoSzJaGdS~@fs@wNhJbPjaAyKxKuO?mW~h@_K|CuEle@oXjP\\\\jUssAveBiR`EvG}Img@kGu`@z\\\\oW_]gUuDoOmU}ScAea@ya@eXoz@yRegBuRm[{[xISgVef@oa@hY`{@vk@pPjf@lwCdi@bt@fXzEle@hc@bL~[xXpElRgVfUyE~o@xSdgAc}@fSy`@~g@wUx{@`Df{@uFtk@kp@db@yAdeAwfAlSug@gBaRgKsCfFcDbeBeK}d@nLfB~_@fQcK?|SxKca@xRbx@fVbJyKer@vM_Ry[eUdtBsHdv@hTkjAaIgA|L|P`BiBtPlY{GqLje@hDxGzGo\\\\dM}BrCnGf@iWt[hApr@dd@T~SeQiEdMp[boAxb@OdZhLxLbp@|LzM~YhO~FpJmH~DbIrGyHlOrPtCgRzEzCnZbi@~sEnmDpUn\\\\~Tz@hGcOvGdNqBvTfXdSjeArUjcAvFfEfGkj@h_@o[{AcJ~K{Mg@wTiS?pPsi@{KqRiTm@jKuTiE}NsWmI~C`DdLaQeAg\\\\gc@qGpXqJyMwHtE{Jm^oHhH_IsEmFsb@}MdBkR}TkGnM}NoTqQ|KiGaFhG{S{Byc@cKtGoLaC?sXy]fEgK~k@{\\\\ucAkJhpAl\\\\fz@oKrI{YoQzBpXqZ{SwGsXog@aj@xKd_@?nc@sXoPqGg]uG|Iv@d}@tA~K|MhDuC`Jic@oFcXscAwDnd@qPlPkrAqf@r@jK|QlEba@dc@`j@rRiTnBmRlPsVqXaM|DdF`Ox_@d\\\\`YmK`UzKubBjjBfD~Eb[_HpLzD{OfBgpAd|@eLNeB{SrTeMya@kLoLyd@sMcE_JvOdNrRaAza@sTqQaPdLfCme@eGca@dGxKd^coAl@eYqX}Xu_@uCdb@fVbEdNaSnq@gT{FzBve@}Se]|And@{[f@z_@`RaXtVyKmDbAgOuN~RxY|WgCjJ{`@mA|GrLhs@~HtAtHoa@S}y@gUeSe_@zH~Brr@au@eI_YkPaM}HlAjR`g@uPzIoS{BqRir@yId]qQyB[bG|\\\\zUub@SwHl^pMrObFja@`_@GvM|[{aAoJoq@sX`@uK|VeEhEuu@sQc\\\\wKoA_PdL{BuTiCtCun@kn@tPzg@cC|M}GmGbHjTdl@jVhVeDwJ~Vqg@iCsj@`VajAox@qPoB}G_Y}XoPHkeDkL|xB_r@g}@pGuz@{\\\\ba@sQqYw[gKfqArhBmSSeiAsg@ta@~l@uDtm@hf@jV`Nqa@bGn@dy@zwAoSlKyj@sGiClIxK|K|m@nGvTqK|SlVsx@`PlEfw@pMog@jJqAxKrXxIyAaBpe@`\\\\eXvHzEqXrXqGrXt`@kUtJw^zYt_@iMoAxDp_@yJ_By[|o@tGv_@jHa`@`QeCvA}OtTyJl[us@~XsG~LlGuMhMbBrf@hEw@z^mkAlUje@ySdb@oa@zWvE|IjPf@p_@ct@rYcTzE~F~KoBkW`h@[`Zl`@en@xJ|@gFxXjLsGpGpHzIqCgBgWxJZd^zb@t^{Qt]~Cx\\\\wKv}@x@lxAipAjRxE|RrOo@jTcF`Jib@rF_J`d@kg@py@g_Cb[xwB}CfUiI~OkVta@ccAvVqI|U_ZoHsZzDsSzRlMdL_A~aAol@hJjK`o@gIig@bx@{MvJkc@ImI`MjRsC`VrKyOzTjThGwh@r[mNp`@eHlq@nExb@gO|]icArMoUd`@ni@sT`m@iCoTzr@qMZkQb^utAjc@``@xPjKpTs@rE_^kBtChTxKyKfTxKrTrj@gQf_@{}@hFgf@uW@uXsKmJxErViWmLaGec@qk@sVcDsn@yEzLeUuMmLpIsv@sFw\\\\v_@|\\\\aU`c@jBzFzGni@_B|Dlj@bQpGkF~U|_@qStHt_@mExLeTuCdDpPpl@bY|GqGbGvO~`AbY~ZwFoMxZdn@mi@dBt_@vJyy@aWceBeP_[vMgObYEpGzV~]qu@rb@_FvSgl@~HkmAdNuIaMcc@nDyh@jVsCrVol@~LqGhPdEjEei@lDmDfBdH|{@giClZqF~U|GwTvg@pD~Dni@kRrSvEbAxKb[sX?rXrGmBjI~JjRkTtGfI}Kfs@kJdTq[|Qj^|K\\\\rHsc@`Ro|@~t@eBlUeNhA~BrWoo@zi@yPff@kWfJsDrQe_Aha@qMjr@s]j[gEpf@fE~CrIiNKu`@p[}M|Gkk@fRuTvo@aA|EoYhP}Gf^ck@|_Amp@KsXvSaC~Hqa@leAke@lFsQyDwy@vQ}MjNajAxUfcApWqx@_OeFf@iK`c@pCjEqSffAdGxGlMqg@pX`@xZic@t]hNbGbPuOuBxRnI~G~Wy[nI|S?sX|TyLxHbCnMlhA}bBpzAqJ~V{h@p]sX`_@lRtIhy@cbA`gA{s@br@iuAqJqr@hb@wFrR{m@`HaCvPh\\\\pAsi@~]pAnTpLhVbrAu`@zMePhk@yRzLfAtI_T~\\\\g`@Ga]nMeG~KnGzm@dMvGkShMeSpv@}d@zAx`@fJxMh`@so@`l@lUl_@kXfUbFh`@qFhn@iL`RxK`P`GgAnPc}@?qt@tLyL}Pe^lCwL`]wL|Sup@?kx@jYmW|G_[cWaQfA}J|p@eHvVbJzMkGvHav@jKzGt`@}w@x[uJ|Zf]wT{v@s@}`@z^sv@eTfOqPmIaJbPyKf@cEwKc@uXb^gvBoMsYn@iRrtEgwAz_Eco@b~Aal@pqC}|A`~@cX}GjT}fAr]qn@vi@|B|JgIrNwIkA|BeX{HvAyLhZolBj^gf@~Z}{@~Bs{A|a@s`@MucAz\\\\uFzJvDzHnaBnBdDqJkJO}GuT|p@gGiAzk@sPlBoe@jh@~XqGl\\\\|BbX}NpPcZnC|IbNqFlVs[iTdLwR_Rhl@|@`b@yX|gCiz@UhUwZjY}a@|A{U~WaPzBmG|PcPeD_KzOcDpTfRmQdl@sIxBbGrXca@qOdeAkw@reAkK`j@cFcIcE|FxTzKzPig@v{@cx@|Itj@pGgC?|Xer@llAnRaGxk@is@lElNqYxoA_g@~eArXoP`j@elAdGr}@p_@|a@pJpcA_^|]vMpIaJ`ZomAvYcaAk^_Uj^vVkPdk@l^hu@`BzhAg`@j@mHje@pXhCmjByYauAbDqStXmCiG}OxK{v@sS|YkUsdA}Bwz@bIe_@jR{IeEzLpZvJeGg]zY~LpJxWx^qTbOfBWli@vOeh@xQ{H~EzHhPeLhHtIiHbu@xNyIf@kp@xKsCqp@mY{`BaBwVma@xTmTxDoWac@d\\\\fC_s@qGjYuT{Gt\\\\_f@p_A}i@je@fLff@yL`Dx_@dg@cTzStYpM^rCpa@cNkIy@jZcYlKnc@xEr`@lq@pP}AfJjVxGqCp[pN{LlPzUlK|KyEbFf`@~N`SwBht@yKbJjMtOgf@|m@d^y@vKfL}Sn\\\\?sXig@zGqMdNxd@bNqClMlGdJdLyKcHbUoOfOoGyKkNbb@eIoHyN|Q{H}Q`Ck^oTyRiDfkAbQ|e@nPsXrOpGrLaPjKnGxKaPpD~KuKj^gMu@bKldAkTrf@qZrjBiVfc@xYqOdEkRbZkJ}GoRjDwXjw@cbCjYczBpXuOfX~LyDhl@rLiUbJLwDfc@`Whu@`L}u@qCyHuCzGmG{GtPoIxNpBjlAjfBbFbWeKdcAmd@ll@{Jd^_b@n\\\\kGlUvmB{uA|TesAvTg]eo@kmAha@mx@ee@|PqAm^lN}Pca@yB{[qQzLwJpTtCzQwMfJj[lPq@uCqm@aJaEeEw_@e_@lPpCiRp\\\\u_@tTvTc@hUhb@tBxKx\\\\yKrGhZ~Fpg@zn@bXvHqIfk@qHzA{GkIaUvZxaArI}FvNaj@bGeEvGfHz]fSrUcGsXpXkPoGhxBze@fzByIzDwEwIca@lPu@lJ~UcF~YjIuDvVfN}Cll@bu@xSxr@oPrg@}d@ui@ye@je@gCkIoGlAgLp`@`j@oGNwNj_@_HlMbi@pQqMrIaZzTuArc@baBl~ArxB`cAz~@|s@`YpeB|UhPhRak@}KgbADqr@rXdlA{Kng@tCpaAti@_QtqCjHrq@eCbTiTrCtPjEpFrM|Xd}A{KoGpGba@lPuO`Ihs@h`@laAhtAdlBlkC|xBbYjMpaBbPwr@zQkIlc@gj@wTq^w\\\\to@tu@xX`K`XcB~Tko@~]{EtDeK~Xl\\\\kNjILpLxQwIpGxKwOtNeC|YbOq^hLt@|Zhp@d\\\\tHdOd`Ac^|PbPng@vj@xWfPuI{K_TdMhHv`@d`Axd@d_@~Urs@rA|LcYna@oXqRoGzIvKpEuIrXpi@aEcInV~GqAr`@kv@eC{Ef^`KdWzi@Lrj@pQ{QrC~DzZnjBw[na@gD~S~d@ke@bl@diA_ChLcWhNx]vDz@lRqRbp@c]j_@~XiCi@rtAlMxC?q{AlK__@fLhcAxBux@fP_V|JdBbRxh@nOlIgN{j@}Vm\\\\jd@rLx@rObEmJbHxElZpr@nFds@}fA{CdDrXuv@}Fa@nOnGyKtNpGdjA{Dsa@jx@yx@|UcZoEmDvPuTtLJ|Jda@kTlQ`D~{@_YvVfF|d@s`@xAa[zW_Mbf@`d@nZhs@_}@gZkVt@pD`DhWlBrVtT|YpGvb@z_@vPeBbDyX`KwFv_@hi@gOfThPbPjJa@pLqWnQnTfHbV}Mtp@gPzKdDhM~RmP~K|_@lPm_Ats@~g@xBdh@_Tbr@|ApK|\\\\co@vzAj`AnJzQvQyEna@~EhQdTrv@wWwJdgBtTeh@`Jk|@hXoc@`Ik@rV`L_ExJlv@`p@oC~Rck@bBnGzRpv@}@iIfUlKhBdX}e@qRkBkIgLpO`@pj@tJsi@zv@rk@ef@rKvBtNhf@bGaj@fc@h]tc@iCgCrXdr@sXdi@hCdA{c@|JiFnaBvDq{@~^pj@`Ffz@qw@d[?~OwKnt@lBvgF_bA`SxBrAoKdjAyM`rB{k@zmGweClg@a]|JtCdM}QtuFmdDlnBy|@jk@dh@`xBe@lzAiWb~Ak~@zYS{gC`pA_kApWujBg@anAwQaz@xFyKbb@vNnJmIxw@s]lJkq@}Rm]zz@voAmKd`@eRvqC_d@byLi~Et}AgUxoEw{AaQrKwSt{@n[y`@{AiWpf@il@dfBwl@fMpBxHbT`JwZbaAiSr]rHh\\\\}J~nAi@~PpBb@tHlcGt]zXhOrQ_IlvD~HkeAHaG`FnYnUj`AfKvSra@vn@fOfOhQvi@xArm@pSxUsJlSrHvTwOfiA|bAdRlAdUgNob@d]bMz\\\\~E}WnUqHyT`OvCdVmKjK{GkKqGxBl@f`@xb@rXcBxHgCuCrC|U~SnBgDdLdMnS}Gda@|GrC}GxKnEhFuJhBoJxZrXna@xKzvBsXn\\\\ye@lP}j@sF_\\\\yT|GxBpJkTfW_N|V{x@vKxKd@ee@}SePil@vf@sSlfA}Sz[gw@nQ_`@jZkS{OcAnZ{vAz\\\\cAjYu[dUgQeUiTvOwTv\\\\}Gda@}SvT|FfGcTxg@nG|M_K?eLtTtObPcGpXrO|GoGtOcVpEtIa]sXwQ?l\\\\_KrKqLwBm[vZ{k@tIrXpG{|ArOoXyBej@hTfCoGs{@vGi\\\\xOz^xAyWzjAeWkD~F_Oe]ef@cPuJwNnAy_A}gArOjm@r[p_@z|@b[xHru@uS~Mm^eDaj@`P_sBuNgZ~Wo]{}@wVgJnM~VfFv|@bTvWePfQyIiAnC~Xw^d_@|c@gZ|FaQfr@{Xzc@_EzIpHmWnFrLxK}[hgAmt@dQqO|Oba@eLoGdLopBv{@co@`TiS{A{ItInGtCce@pXmL@|GeLsNTcu@xR{\\\\zk@mFb`@md@fR}HeArXyKlPy\\\\iTeGsC|SuIuItIwk@yKz\\\\wMoc@sF{BpHl^yK}ScGfCgl@k_@scBk_BiaAxRmGlQlEzKz|@xEcAvZ_KxFw|@\\\\qJ`n@sLwK{Sf]nGzBwTfXrReGbUvFj@u[pXpm@|[kfA}AwYnMrXd[iCfi@hTpTeA|KbPyBdRwT~@uD~Smu@ny@jPwAjn@qk@`j@yKqKvMyk@rNco@xm@yp@dEaX_Rxe@pGihCui@}u@nKaLcPgs@}YeGh@fCnGqxAui@syCeSid@dDc^xZsHqGjUqXo_AdA}Nf_@y[fYkV]oGjQea@qD_Zh]cFgLgKrNiUn@pGjPeLxKzFhKwJhf@dD`cAuMjBcHfPsJsFcUpMqSpl@oVtCmi@p^yRnVjApNal@xo@eLh]wkAuC_m@pzAaVrSge@jImz@nu@wi@|aA}F|i@c`AvgBbTl_BmTfRrGhJ}BzS|SjN@`Z|IlU~MxBsCdGjY?_HeGjTcNaU~aAtHzFPcIbO{GbI}x@~ZvBbHtPwKoG}GlyAzb@~z@lFn]de@fq@pc@rwA{G|Hq^iTeC`Ijv@zpAsB~iAtSvgA}A~h@jHzVoVl[ssAtL{LmHp~@q@hZiJvTmO@kRsJsJwt@Dww@|`@_\\\\l]cj@~xAkPpGzTuWjG}Yg}Ap{BrCaj@nJL|I{g@hUyS|T}j@iNm_@yExAiHnUfUlRqMzMq]d@WpNeSiJ|Bti@vKfCuOhn@oa@|m@eb@of@|BoWeXiWRyMsXpRpZfTpEbJqFdIzUp`@el@x[pNhMnC~\\\\~L~P`QcMeDy[zFqQzLsLxJhHpFyCtM}T|GrC_E|K|HuGkjB|vEm~@`uGzFieB}XeUzRiN`Ei[uIwHyEbScGmElb@wpAsC_Eck@hZcOgG|GhZqUtNzFbIvDjyApLhXeHrReY}O`@`T`IvG}MnPpZkApTlj@DjUxOvFoAroBve@f`GeS_b@_JarA}[sg@~I_\\\\eMtEsHld@mTt]aNsAaNk]qGrCrE|J}ZnYfPgDfpAbb@wEbLyQsCk[rJo@pLeL{BwNta@zcA{Mva@n[~Ixd@pZ|@rEpZfJgApD|LfYf|BmP}SuCg]cGtTuGyd@sThBe`@wq@gU`GkKti@zSuTpw@~q@|Wds@sXba@da@{BuCsXpV~NpMdg@tPviFoX}y@lM}d@wVidA_TnFy^da@mX_E{CfGpNlRgOr[mj@hQeh@_RuTj@on@vQqPzf@gWfA}ErKtPmCrK~K|s@|JtPpLtsCdUpJ~IyIfb@cRkC_T|gAxKpGmMdJdIhi@tPvAbUlf@qQt{@{XtMfXjk@H|p@yi@z_AgK`r@lKda@zGykA`GlIBxx@hn@`qDeFjK{HwMuQp^KxRvOxMlCaVlRb@|`@z}@dGqCha@|PdD|FsLdGbHfMmCfS|Wfl@{FrIgCaPiFnEhFp\\\\aE~x@w_@rHrBtm@uS~b@?ht@gc@vToVba@aRxpAtI`b@`|@pt@za@bm@nRtMp[xA|UyJhX{m@vBql@fUc|@mPojB}Yej@cd@i\\\\~a@gx@wn@w_@nGuCz\\\\nP|GqGaP}GeGm[{GBnAhMeFd@m@sXrMkPbg@|XxGtVbQUnDzEuOtk@zW~Wbg@iQsDe_AhTxKb\\\\gg@qHye@eXwOsj@gEsQaf@q[qI`Jyc@zK?{KsXfEuKnIxGrCba@vT?hGnK`Cwi@|JaIpHdFbA|JmIdClK~O`PeLsIqZdWdN{GcPzGuTb\\\\f]~XwT{BrX|S`PaPyB_Yzm@~IwAld@~`@bBd`@~H|Hx[}HZuf@tFgF?vKpXg]in@jhCpr@sr@gCvtAxEiTpz@_[lc@q_BrTtGB}KjIl@hE~NlE}AnC}}BhPpZnIcWeFae@~XaVmVs\\\\tToDgC{GvTyKuCqGjTaP`PvTfTon@LkG}GfCCeS|K}LtCvKnGwKhCpXyK?~Pt[rFd@?in@je@~XqOdCkCbPpX}GeLfLd_@|Zn_@oIY`NwbAdw@rXf@dHld@d_Afg@|JvWqXyB__@m_@oVcA{GgX{u@gUS`HjTpGma@zI`f@|@xRvc@}TfL{J_CcG~Pcv@na@sCaPsD|WzDzE|KeEsO~dAeQ`H{HpWqHp`B{Owd@sOuG_M|BnF~OkXc@hAtZwNpFaSwHoBbUrUjDwSr\\\\zFdTeq@taB?zQxKm@qa@ba@rX`PfCaPtC~StDiB`u@qsBhLtOni@eGyKdLvT{EtOuQtCjKaKxQ|Nu@NzQtQbBxPaIoAoSda@dQtYr^h[n|@_BfHeXuAgUvRkj@aLmSnElTtOqn@f_@|C~Ktd@bToa@?yc@lXnh@l]qHhGpHlReEpToKpCdG|SnIvAzBmPdXxJhH}FvEbEuIxRlz@pG_NjMvYjTsDh[hHfH{En\\\\{LcUwJtCpCfJoLx^pNi@tM|Wm_@nk@dAra@uOyKwDvD{BxR`P|JTlQaPtZqVvcBgTxYwZhFrHkELwUia@a\\\\i@wM`VaDlFwM}CsQle@|G`PsLyKqXoGzG_S{ImLhFmh@j|@_R~@mTvWg`@Lm\\\\_ZeWdDmBhQlMeExThTrRiAfXxKkVfmAgUmDsDrGjObg@Thh@aRiJ}l@fKfDz_@}EfRq{@}B_CxvAjQ`q@{c@|HaP_LbLaNyI_AmQfLuB~c@`JvO{Fx^tS`IbPtt@`Ub]E~GoDyFoLbBuAnIvKzIoGtO`j@yQ}Gel@dL|Xti@iTiIo\\\\~DaU|EpYhEtBzByKpFdGPjk@d`@fvAKrVqj@neFooAxuE}W~jB`Eh_FdO`pBdVfx@c^b]qX`HgImBsIei@eSgWa`AuLiN`Q]vg@rjA|j@hd@pGpQkBfs@ol@~I|HsDbdBjGfg@tgCdxIjt@xbAmGjPwr@_w@iMkk@aWu]c@wn@aXqJtI`N_O`_@vLnZdMnkBsIxBz@{Mei@g[at@sMma@vEgRbRpE|d@mElFrgAjj@pEdZoTtJod@ffA~UjFtV}o@`RtQ~VoR|f@rWvP_X|Iqn@|KxHvB~Xv\\\\{LP_OgL?|XiPvOyi@g@hi@vd@`N_AtVjTf]fq@fh@jQt^uJfDsHz[hTyKtoApD|f@d~@bz@hbAhHd_@vbAdqBfPju@jAhoAjZbsA`b@lAhCyHam@wzAaIkm@bj@thAf^~gBrYfe@fg@j~A{\\\\gc@kn@_mByRx@{BbW|f@`|BuMsLaIki@u@`p@me@aPe@tY`JlUoG}Bj`@jt@k`@vZyXiCkG|GpG`KkTlPpa@yKeAuOrM`Kb[ArIm\\\\z\\\\dlAqJg]{Vl@jMnVcS|Fo@xb@qg@zM_Jfl@rYgBhb@oXh_@hApQcv@bW_PhNle@nImCeNim@xTuh@zM{@bgAd{CgIzZ}f@xEwEjSeNzA_[h\\\\pLnKzKkUtOqEjE`DsPh`@dG`Ihs@qf@~_@pKlV{Jzw@d|@`Npg@_j@wTwJ~G}]_Hz\\\\r]fN}H|b@nQ{Bn\\\\qGqGoa@tCra@b{@xB`PmEeCeFtLlExKzK?xA}H|c@rd@zQof@lWgB}g@{YcJm]gRkM`KiGvf@~B`|Bz`BjFf]sCyKo\\\\na@jKlv@kKoGzBrcAnGpGkKvTzBxKtViTz@go@oG}JrZ`A~[{Lrd@ceA``Fv}Az_@v{@_DvSaPg]oGlGwFk[kCpP}]q@tTda@pGuCxKtTqE|HhYlLI~MonAru@uXlb@|m@g]?zG``@e]vDvKnGmBsCgKzGeKrNtSmF~l@tJqGbBq~@jQkQxFwbA_Xsq@hDwGpv@dShYwF~Rr_@nOkIpEfFbYyF~Flj@tHzF|m@yIrXcg@`KlF_Yna@ve@nGz[cj@`tDom@gLdGbPpXpEiTtg@zDbBgRzUqF|BwTtThCcIwNrFiFza@`L`Li[hMlGpX{Kro@b@bMmT|Dcg@`NjEgAhNzk@pDdHcKaPgZcd@wIqAwMx}@}GtGpoAbIv@|LbY_FzIxKtOzd@{DdDtKsL|MvEdUkVhMyK~k@uhAfvAsDzaA_N|T?|bClLv_@}PbJyAbvAoUpo@i]hWiAvV_R_DyMdd@cDhPjJ|HiMxKyRlv@fJ`l@aPh]sJuEqDdEsA`W_Q`C_Xha@tAhx@gN`VtGtHgLzIhCx\\\\_R|z@ce@iH_YvMgHb^erDbs@msAduAq^dGgBxNmWdVyX}LuOdGsr@yKo`@jKi^nj@eP{M{u@mNcg@tCwDdQkv@lBcc@ft@pG|ZuOjKBhUkf@~Wca@xx@mP`RsX?}JjNaYeC{Wj]gHbEeHcF_Sff@ee@|JoOxTsYbFsf@|qA_YlImTfj@u`@dA{a@nZyk@pGgK~JqJmAqGcQwOne@ug@vIcN~Zer@x\\\\ckAjXuFxRaP?ia@hc@iq@hDkdArUsCrSk^xd@yTGs]fTykAncCup@pQgPr{@mZxo@gMnG}a@uCwFhHnN|o@g_@}EeJjb@mt@n]}N[pCrXwKnN~Nx^gBdL}Q}GuJdJ~SnJvE`i@sKldB_IdFzGflAyKrYbGxH|@zjAkx@hcCpU`^~[zDhTfOsCfcAvOpRgGrKlTrlAzt@dXlc@f]hTTjaAlc@pt@[`Mj[|p@jIvTqXnDxV|Vr^wE|LxHrJ`e@lUlc@fq@je@hJbFvNyI|~AyCdHeL{BtCxK}IpFvFnZg`@lx@ml@xk@yJxkAcb@`|@c[`GkJfyAw[voAso@d`Ami@t^eYha@r@pk@mpAhnBgrAp`@o[z]ia@dJ}`@xYseAdL_uAoKahChjBet@vBweAzm@kEh]eb@`OoS`p@{x@thAfApZg_@vfAc~AbwB_j@xT{bAxmBwbAry@yVhh@yZtSmaA~yCg_Ado@y~@b[yf@xd@mPjmAoAdd`@nxGE{Btuv@cxUzihAo_@sOmYnCsm@of@iZoHdpBn{m@sfAlNoMjIoH~b@yFj@cIwKbHqVp|@c`@sK_Gij@hRwNpZiFu@dKnf@nVWsLtJ_y@l@m`@fOgIyMkR}BwbAfL{fAr\\\\ulCrsBo|AnlCao@zv@mm@d_BgbAxuAsKbw@`S}GoWzPlBtSnDzLxX{ApF|JaWljA_SvH_S}R{QuA}eAh`@ol@jj@mIvQ?pkAjCbr@hSrc@mTn[}Drs@yb@p~A?jWk_@vp@fCnD_lA`g@a^|cAwa@|i@oXj_AFxfAzJrQsLxp@rEzw@_a@bqAiFblArAzbB`OpcBuCrJmk@hV}XbnAgM~EmiA}Zq`@dVe}@kN}]vb@wnAmWe`A~EwTle@_A`ZvIzHwf@ndAgObLyMi@gv@{SfXcCcHcNyTbUue@xKqH`LyFv{@cmBneBzA~NiShy@akAp]s}@vmA{gAdZoInd@cxAndAyn@zzAcX`PsNt\\\\aq@xAsnAxVqBbH}BkQoRbB{Pj]]uGw`@mQfVm^gB{Is]yY}q@qLvHaYsI`CcFrSya@kGsQ~Gex@xa@_Yp[wDvRlMbVuCj\\\\`H~N}Ibv@sc@lu@iu@fn@sC~Xsa@xQyK|Tcc@tGcbAiJso@pZoW~AiOxQ`EnK}VfLse@d@eD_Kyf@iDmgAxKaEiQmK}DDki@fq@y`@hKvVla@_JxMnDfJoQxDnKpU}kAfLcFlFeX|I~ChKwl@tYeTxCyX_IwO_DpK}QjEeIb\\\\ce@hVkt@hImUdQaMld@sKrEIe^kH~A}MjfAkLaCaAxHcNg[aa@nJ}LhHF|e@kb@bWbD_SuRyVmNdCTai@mPsMaKk]vTmp@iS}xAj\\\\gcEgDkDcHnWcImWgUoEuHgg@rCkN`JoBxFpZ~IfFdMcKoC{PeViHte@mlAoXvXeKve@qSdMmDnSdGrn@dYzJjLvYcCzU{NTsRmf@_a@iTdd@fg@nN`b@_EzY`Kp_@gBbRwKyKlAjf@wMhZsFcQsO|GfAha@fx@|m@eMv`@{Azn@gWzWc[zz@tQnVbEri@zUkKhd@tM`S}d@pHn\\\\nPsFXpMxHCtYkg@fKrKmR~Wre@{PrHfc@or@~gAsApr@ez@py@gVh}@cHfF{H{FzAvPtQwFdIzYhIVvBcJq@rg@m|Auo@u|@d\\\\riBsiB{MbCgwAhtAcc@|ScJ~OnIpSag@hDyv@bc@whAdaCuiAj{@{oCzuDcVfI_B{Iei@qUu`AhSce@z[y~Atb@m{@gDyiAm\\\\uSzIkq@jAu]rW{aA~MgSp`@iYjMa`Af_AmHrYm^`FoQvPaMlj@mqAjnB{b@oHamA`e@qiA__@gyB}hAjDoDh_@zPtFaTqHoFkUdC_D_Jea@sKyNqz@_Q~ByD|b@~o@f]jPvM}D|Dwq@cb@ugBkh@oORqJdU_l@vI_^{WibA{SozA}Pot@jKcJqGaPvToUEqe@dVgl@fKs@~XeVre@_m@qZwjAmH{ThGeXpn@qh@ph@abAdK{PzSq\\\\}@uSrMsw@{CaKfHiHeIieAeQu]lBe\\\\bHiN|WcZpJ{BjSyp@zScJlP{z@om@s}@u\\\\upCmc@k\\\\dHyQoNmFoTt_@_Oma@{Cgj@kn@xCiH`YeBKql@_Id\\\\yWtLmL{ED|EdRti@bb@jc@spBy{@mcAqPgI}Tqd@yTzOgRoBgWmUbp@f^dMnIrRg{Dkf@iNwKsVvGmcDcKe`AqQiLgRnMiUkQ`E}DvL`LbT}j@RsdAgLoF}IpLaTiIoCeIdZfHjKs[mEaFrK__@gKg_@jHo[cDiy@u\\\\i{AeA{v@}YmzAuEsRdFeGtQ}IcQc`@iHvUx@mWoYmT|_@w`A}Lzb@_EdNm]_t@nBvBdQuLlS{eAyWdCgRkHeIcC|[lZxKoh@jAySrWqeAiNmg@dTcYib@mTuEgaA`Piq@|W}QbSiCoKfPcJdBeTvUm^hFgn@`a@oOwXd@B}L}Ylu@_Js|@`TwWGgLyHmx@iBcHcGdLaRi~@bVir@nLmC`R{Z`GuoAs[un@fPrhA{_@tz@if@|e@hCd{AiEdd@{T|Q|Rtk@iEhY~Ynh@aFr_@_c@ld@wAbNtIrWvMqCTfWogAiJ_fDN`N}OnCdDla@kOrqBgAhCcb@ue@oRdZgq@_KuBib@nz@_MKqOgWoM?ep@nd@vKme@oa@fPaNeFiEaTEw^rQcH{H}\\\\gAxR{g@d`AfKbGz@fWeIdNzWcAeYljAa`BzSsGkHtFaLxLdCzTuNrE__@wJcC_KpIbDzNcQiEmi@cqCmNz{BwZfHgHvv@pJpRhb@gFpTgR_FbS|JhXwgAcC_zAlPc_A|YyFtSo[pMnBgPgHx[kuBrS}xB~k@wUr`@sThCkLhc@cUnOgB|XkgA`f@mcAzI{j@f[{Yg[kS`I}IkM_b@cLuVnb@}QvDxIk~A~n@ylB`Si_Az@{i@vq@}mBhFop@xFypBkFkv@lQigA_IcSvJi\\\\_Cy}@tCf]pGyRp@glBse@q{@eCaY|Rb`@x@}]rRo]hSaMzN~AdLkV?}Ee`@l@cAwk@pNmDvC{RtSqQ|JmAlIlKmF}RoQiIyZr_@yOLgJmLiIyt@|MkK|GhTnKtCh_CmjBtHdb@tu@fm@rr@dRpF`K{G~P_s@kQkh@tB|^|HnE~IqZvIjSfEh\\\\_PhGdM~r@`]vs@ldAdl@nZlZ|`@|x@dYbImRoAab@o^shBcVeh@uAxPhj@hrBGxWwMnLmqAyw@km@_m@_l@}rB}l@qQk`A{cA?mPbNeA~@gLiVFqv@bYhXet@n~@wVxHtGpF_QfKgCdK`HcA|m@jPdCzKnRaWtg@nVkSxcAsUlDlNoo@jg@zPrHpOiCdKw^~]lY}a@goA|a@{JnCkOdoAd]jLuJjTf]|TwNfVzCeVrhAgp@oc@kNfBpPxKjVdl@dc@~Oj]{PjKdOdLsCrJrQso@fYsM@_CaKsTgFqr@pLdr@dXtVpm@p{@zZ~Tn_AvHpEyGwb@xGiAjT`e@_p@{{Arl@p]zJrRuCu[eXgQrX?vSwInDqN_UfHiEuFqVjBuTe]tTfCaCaQaQqGvn@cz@gW{j@wq@mq@ij@qTiVFz^g`AcImSsH~OcKoBwXmy@eT_A__@|Xia@bAso@fWiLmRdTaS{UsBme@nv@kd@y\\\\ko@pM}X{LaTqYij@aK}ZgbAuWeLmFtQlFhIyf@x~@iSxLuw@hG_Kzi@zQd@tNrM|SgFhYcm@n\\\\qUey@ndBm]hMo`@LcFav@bOe[k[}NmMrE_b@|x@iHna@_UvSaNaDkA~NfTxe@oO`VmNsK}YlWmJkj@fc@u_@oRwFtUw`@a@{Nqm@jB_b@ll@j@eY|Psb@kOkPuY~ImKtT_YfEsC`Mo\\\\mOcIbEoNdh@hc@vg@}DfP{RaJoGjDuMrV`GpDuWUc\\\\fg@sMBuKcMkJdGZv]kPmC|@kj@\"],[\"yjoi@n_auAppuDuEtt@g_Hck@ik@qAeWbaAe~FyKy|@iiAesC{GevJvX}Bv{BvcAvOUb{@mnBdp@yyDby@s^h_@ma@p`IkhRlSiQdeCa|@bo@fNfHuLwC{f@up@gwAscBurGwBamCgJoZggAk_AskBTnF_~@}Dg\\\\aPc[cY}QmPrGuUjx@gQkx@e[epEsmAsrG|y@ijCxm@y~@nIuj@l\\\\kj@lr@~Ajc@hl@bVa_Ap[hMna@oc@r[aeDt`@yR|U_^d\\\\{bBbe@{f@te@rFnFqj@~c@_Gde@ez@|lAmsFfjB__Crs@_eBlc@ik@zRtV~XmHnqB{zCbvAow@rnAwaBjmF_cFz~AyuB`|AaqAvgAzcAn_@i`E~hBdn@xg@krCfb@~Gdl@wa@tk@_BOwdDnsBm~G~TkbD`Pgp@`s@qfAbVmkBj_Aui@|RowBwH_z@lLi[dr@y_@zy@JjeAvoAt[pNtz@o\\\\`j@rOtVyBdeA}v@|v@sCje@ae@p`@pdAjeAvl@lGrOvd@zPhtAhtAt`AntCw]iZKbNzv@jaAmLnViGGuIu^ql@p[_e@bBgL~Mzn@d@`^wKjL~EcExR|_@pQjGxNmV~t@mL|H{a@Ou\\\\xPl@}K}S]sWpj@if@_WqZyuAtOcLo@ww@}c@mS_s@_kA{bAg`ArZzw@bv@hf@|J``@bs@dx@i@pR{\\\\lK~Xlp@lKjq@cD`[mSxXgl@J{b@}|@uVcT}@cbEsRpb@iEgMqGbEpVtj@n@vsAcvApNu|@yKml@hCyc@hTuOyKom@|m@eP}X`Blf@_GtQoc@`]oZfl@qGuCyBfW}P~Di@nLo[gb@uXwz@?jTaa@aj@nOnn@vk@paAp`@p|AyChQ_Y~Y|NzZcBhVtYji@nBnf@da@zbA?`j@`PtcAfTotApPyGji@zZFpc@`SwTdQwAl]fd@xKgCaj@dlA|o@}m@s@lKtPgMtSl@lQtSuu@jbCocAdXkc@|`@]gWzTed@w`@|G}NiGIqz@aHcPyPzW_]g^u^`LgP}aAyrBfGcWg|ApImqC{Yih@G}MgCzMbNx}@gPvvDse@d_@sk@~K}G`pB}w@lYac@nwAo^hB_e@g[hUljB{Opf@uCbEoUoP?}g@qIrFmI|e@un@qgA_No@}D_`@aCjWlj@pgAs\\\\~aB{Ybv@wWlQeQ`h@mMx@i[`f@cDgXrMiXg@{l@ut@hYeV_HlKpZd_@zVwg@peCHbl@ma@yI_AwOyN`\\\\pLhLfCoGQrUcQdBw@|Jzd@fRtEp^kp@zqCsWsf@}QhIeRwSNufC{V{x@{F{_AiH}KuKFvTrr@bGj_AkNjyBaVbVkNydAoSqD~B|hAsZz[wa@{@uVoc@_Ciw@uIkUnJ|lD}[bi@er@qEqGgr@vZqk@nA_`@nv@cjDhn@ykA}G}XcKto@af@|l@aPfC?h^qa@n{AcVxb@uRpdA{XrMwkAEfc@mjGoVjr@uTnxErJ|Q``@~CuQfYrr@?vJvOuArNkbA|gAmT~H}MhYaS}t@f\\\\es@_]zBePuP{Fzt@aS|Ds`@wh@wJzJeGmYsCtCxKhn@lHgInGhCje@le@xS{EfNxg@_Arg@gLrXgNvCmCpSyPxFOtUsXdGKeNoG{@mPtTsXzBiZkQe[~D?wTaR{]cy@xLcN}_@mg@yR}Ou{A?ngAjKnv@ha@e@hPh|@rGcLtXTpe@nv@zKjBfV_\\\\dZvb@gEhs@gvAf_DqGveA`IzJyTbN`Br~@wdAtOod@px@cpAvgA`Pin@yd@nNiWcHug@hN?z\\\\xOjPxa@IkaAdlAe_@wKgPdPoi@vE_Y~Umy@dOqyArqAiYpBuuAkU{JjIfiBhh@_KbvAlN{k@vb@_d@`Nqf@v_@{NbGfCnOcRzTy@gVjk@{c@tIuLjPsRd{@xBjTrxAwnB|qAvE~NkLbGcZtV~DhaAg}@|X~Dxz@uo@zLtf@h~@os@tbBat@|_@EfAtUfc@HlJpp@wShtAmNpXo`C~rAFxKhJnDr`@c^~SYtAhHy_@l|AfKt]zNnpCer@}S?nm@}Gm\\\\tCoa@cLxFua@oH{StZwKaj@sFlh@_j@|_@qHbWmK}G{FnEes@d}@aj@ayAzIfCeNgnBaI~GgJjvBxKkT`Zns@m@lScPvCiTgL_NnZmp@vVoFpYfB|FzNiPr_@[dShs@wEyaA~IkIpGdLfCiJ|XuAtk@bXrRnj@ya@|jBaCo`AiGiJd@hwBsFnMki@lS{Vx@uPqJlGj_@`YvQucAduAhhAy_AhIvNwj@|`CaaAfvBjA`PjKdAfn@qrAlU_OtF|Go@jz@b{@|gA}h@ksAxEs~ApY_YaOmMgDsVlTa{@iDua@l]g_AlHrEjBp_@rCuCq`@xm@hBzTrXkn@rC`Y~Mgy@h[eCtS}o@y^j`@cQP`NobA|SyXpK|Fb]j~@xDbj@dLq^oSgm@zN}l@{VbNxDk^aXmDpa@scApGjP~O{Sn@{]jHuBfCtPdMrF^b]rIeRoDc|@xRb@nEwLzt@gUpY~]jSxyBgBdHuPsLeGdCs]pa@pPf@tAjGqh@vdB~b@oB`F~d@xOoq@aWkCxBof@xOwZ``@`BiKvf@~Arc@uYlx@~@jUzGrUpe@uw@rKaBvThVr@nh@gEfOaWzKqHsEQsQyKzBzCdToHjLxHjQuDpLsKwEyBrHxB`e@mKqLmUtSqBpH~V|G{^zb@pB~eBsbBl_Fk_@`cB{]{wBaPrX`^tvBe`@hTkcAjiCi_@e@mXnK}RbX_xAnxDoJdCq@jdAqEfSuCme@eEtc@bBxpAsLr^`Am_AiX{MgJc[gDzEfPx~BrLmMwTbc@c|@jvDc[bkChC{v@aPf]uC`j@{OiCaCfGtDv\\\\nL~HeVbxAwPhe@`T_iAgGsh@gKzf@t@|n@urAduF{FgL~B}~@}GfCcAaViNiNvLgTeYej@}CvHuIsFog@}|@gGeCga@lv@uhAzc@q_Am{Axf@{~@vg@ch@zo@tVhh@UjHcRns@qZxKjn@lNg`@w@yQoY{S}ZpXs]^ePpM_ZaQ}\\\\tIuIuaAlAytAzW}w@yB}\\\\gCz\\\\}X|r@oItbCmk@tgAgYxTwK|k@yWvc@rZzc@r_@`BxSvjA~~@jxAzhA`gHqC|vDkK`zAc_B`oLcLzGoGsEgGg[bP~Xp@oXwa@ezA{l@JmVhY?mPs\\\\z{@uPc@`Ir{@jHx@lSaM~Nya@fRaC`e@jnDg@~c@sg@vrCus@jeO|ZhwFjJraGx_@b`EkRjlAuAn`CsCkyC_Xb]ym@b}B_Sye@{JtBlIj^}CrvAdGqGrCdn@oEzQoQlF`Ofq@iThhAtCg]qa@da@{Q~m@|DrsAkGtUg]ca@_S|NoHqm@eIdM_\\\\uj@mTvChQfa@uHbTgXoa@gj@yHa]od@qQxA|Fc}@uKaZ{U`BrShrAl]n\\\\fGz_@fa@hVpX|mAr{@`z@dl@d`BwDfrAqQvp@wTpLuKzi@{GjbB~I`sAgDll@kHv@qk@ciB}cAexAoOAhc@veCyCfUkT_n@?~m@wTsX`N|g@vPdL~Dto@ui@{v@uAhNxk@x`Db@tX{JfNco@qwBuLwIzC{OuCcDm]|Nbb@|{@NxXaMaLrBp\\\\hUra@YvRqJhIwTmPt[ti@d\\\\bpAuEddBm\\\\d_@gB_UxJuScUwKxLu}@iIoHmFb\\\\i@udAo[moCyKfY{DcFzDcrAg_@npA|j@fsAb@~YcP{GhQrlAoDl\\\\mObDnOle@c^qIwFjx@}g@h^}DuMvIaWr@}`AcSffAsf@oV~Dix@{HeBaPpr@qF{w@xAuiDaGs\\\\wP~XoIf_BlDzmBdh@ndAtShz@hd@bn@lOrt@mFha@}\\\\{\\\\bPzm@wRgFmBl`@rMv\\\\nVo@bAbK}EbT{_@vBdM~c@mHvMdj@cRtCra@rCiCxRvc@eMnJdFxh@_s@aj@iB`KxSx]gOh@}PeQlF|[by@r`@sBbK{vA_EmKi]y^`ZqN_I}Bb^fK`JlN}Jv_@zLjMlKnLtf@~Eec@zPvOnG_Rpx@_BvAzr@kU}HzEfg@ke@cPxKrr@nGfCjB_MfUxYxE_Y|MoInGrXmErm@mk@li@yXdeA{Ry_@{I?eGsXoa@}X{BxKyKcJiiA_fCyJhDnGba@ca@k_@gCtIlr@j_AlV~m@tkAdeAvEvP_B`Vif@`f@wMy@bc@vcDnGg]ba@lv@lBuW{s@cqBoBg^hIaQzo@lc@~O~ZmIvq@v^m_@pNxNzYLqDjv@jYif@cL`vBx\\\\toBnC_TgPw_AbGsK{Gwj@zGqOnJjoAvJnShp@xx@|DsU{Quo@mRuEgL{]cMw_BdGpGpLaPjKpXdUiCxLrWdW{A}\\\\qr@uOaBqRiT{Vqo@z`@dNdw@oc@vKd_@hi@pv@{B`NuHn@|@b`@mPnM`Bn]js@rgBvXfT?_Wsv@ipB`P}~@dGnGQ_XhMxI|@oE{G{k@jZ_G_CmO_LuGqZpEcU}]bJyEjT`IrAsMgp@uNiNac@dRsVvTbLgDka@vLuGbGyV`Lp@tGfUzHgGRo]hJvBxB~a@tHq@vI|XmHpd@rM_EyKhz@fVo\\\\`NpCWbb@aBnJoJOL~V`RfBPwY`Oc@bN`OfWtv@aPlRnDrQvFj@|E_OzN`A~Zbl@gKg}@ub@ohAnHoUbGzm@dL{m@pa@vKaEcMuh@}[`FsXxt@hv@tCbw@wDnKkO{FsCl\\\\vdAfa@jOla@nIsX`N?`SzStA~Hmc@v\\\\{Jj\\\\`UqE`KjR`DuJ~[b|@}CrKmKcI_BvHeDpy@vOpgAgXhn@fEhl@oIsAtH|u@_M`Hke@sr@iBdg@`^t{@lr@tZpEgGpXba@g}@nGoMnP}GkTsEz\\\\qv@rLwFr[pDfLl_@`Hzg@ua@jKpa@fXnG?ba@lP`_@tq@bg@bYo\\\\?sXdPjDzXoLaPfcAaPqX}Gba@sH{AoHzOwi@sQwO|Cu\\\\h\\\\uAhPvH`I~b@k@~^`PsJtq@lEdLle@{|@piAkMwBjZkK`NkNgCke@leAuKzdAxMpDzMcf@|PgOlBeUzv@b{@i]z\\\\wMvc@`KZxJgPn\\\\vGrKaKpLz\\\\qL~XxCfLja@gLc@hd@xClKrPaFlP`_AsC|XsCoPeGpLyKg]qCdM{Kk@wMk_@gh@gVmGlCgh@s]pM~`@lkCl|BgEtOqI{A{Xh_@wg@_e@SnVz]hnAba@kn@~HMvJ~a@fN_PhIpiAzWfOza@|dBd_@sR~IpBlMdm@dRqCuV``@kRmGkNzZpFd^ySj}AlBrQ|f@ukBfIk@pNfg@iI~qAmU~fAlUzcAtCo\\\\rIhIj@`mApLu[lDjkBgOxNmRcApEh`AcNxYiAaNmInEeL`q@}SjDsX_e@?scAc[bt@uG{BkR}t@mC{v@kQgQj@aiCxSwt@cQzCmTle@aNgEkPgp@?{v@qU_HoA_t@uX`PeUsk@ce@ia@yXmr@kIdRtDnt@djAdhE`R~XnPye@ys@lfFyb@zw@cV|E_tCuaBuvBswDoUmO{aAeGePss@{|AoMcfBpoAaInBkKwKUul@sUgk@gEmpBkwAs_EkjAejF~Gs_@~g@qXxQ{i@vKmpBub@_xB@y{@jTkfA~a@k_I`Qu}@sY|Ta^vbAfGztBk]rmAsF|rAw^ffB}cAppDu^i_@|CyW_T}p@o^}Oq]kc@yKaq@u\\\\o\\\\g}@spG?`jBgCqGsCrNrNxhCvT|bAhr@nvAp}@|XpN`[qGlxDoLdTdRrKwEbfBqNhYeVzJo[wK`Vrd@rVuHcK~k@nd@dm@zYnfC~Srx@dlAvtA|XxrB{A`fA`Mtw@bh@nfAlF`h@`j@~d@jkAe{@^ti@{Thw@vKsC|g@buBpg@fc@f]dlAbi@l{@ta@|BrXgw@xVjkApd@|\\\\nMj{@x[hs@mIbn@hErrAiEtZqGwe@}Dh_@rI`_@ub@fHcBrSpG~xAdMbL}L_h@lB{q@nHoN|XuDlQod@`[goBjQiBvXuu@xLiFbPbKnE`SbGcGvT~d@t`@tOzLxSlOfiAgCxeBnOziCpY|ZoG_nCXk[|JsGj_Ana@pMrs@hMtObHbz@eP|IaBdQ`LrChDsGnGbIsEnbAsk@kh@~@_V}m@npAln@oCth@h[~Gti@m\\\\j_@_H}Gf@jOxWVtMpr@jAwt@xJ_a@dYvKhNj_CmIbYhGdZ~C_h@jX_k@vRgIbOvO`DtyAtQz]dLp{BpSmp@_AeZsMsPnGuX|h@~kBxc@xL|EoO~HpCxAra@rKgCbHti@tCcPbGrXdJgDLgn@dMErBtH|GqGfEfKdLZ|QuTy\\\\qr@sE{d@lJonAeBqqBbd@wu@zKkf@_Guf@|L{j@hXa\\\\hCu]pWsDxBiP|Gri@t@ux@vGcChGpNaAfm@j_Ane@z\\\\j_ApBf]`\\\\lSfMdr@`BlaCfIdGxG}Fr^lRfAnYlG{\\\\vHpIjFlNqArfA`J~Pfi@yXXmVjMuRpNfv@?l~@bLjI`Kjy@`Udg@pQaFoh@qpBhYi^tL~mAnJmr@bMDrJ~Ld@jfArXqh@zGkDdHfKiUjw@rIpFzVeKlL~B~@nIcU`TyOlb@_ZnHsHhU~u@aTxNzDf@hQuVto@dBbT|]wg@x[lVqEpe@aRhC}BjJjGr[zXvRvFvRiOz~@nBvwAnf@fXpBre@`d@kb@dNfj@oCfhAzLeR~Hv@pDrcAvTaP?f]nMw[nJcGfI~EdHps@ul@voBbC|Q`h@|S`Vg^xGj@fRzoB~IgVzm@rCrA`g@yRbOrIdIxBbb@tNrKk[d{@fLlG~MsJ?xv@dNwe@hSWnJaKjO`WcZxaAu@j\\\\pWbNnLp}@fn@|qAtIrl@rXfEuSsdBz@w\\\\pOwAvSzIzLfw@~Xc{@b[lNlQheA`PwTpCvIwKfNr`@lPuBv`@aPf]vK??lKhn@wz@yIhoAsYx[}Bvd@nLdDdUqQrHjKtj@jOt@`Scc@jf@gHnb@xe@wK~O`}@le@d_@}M`}@rT_ZfW~X{N|{@ah@~qA`Wn]`Sy^tSaFgF~KnJrKgCre@l\\\\dg@oBbS|Uy\\\\pEal@zRcRhMdP{Ed~@lKpq@sl@rbA`Slv@_@j_@|Xp_@bXhvAdYnPyW_iBRcz@x_@aS|VbXaWvzCjK~XLoUdR~BtC`lA{Ido@hVtx@bl@xx@xKmGtRj}@~YzU`LgcAaO`N{MOeZuu@l@oNzPcCiCuTzKtCcPcj@`YwtAjV`g@vIzn@iCj_@hTpGsHg[tIwYxJzJfC}Omk@{iB~DkPlExXvZde@fRhpAzMxCsDdMo[xELfI~SnGxGqD?~Oda@gTnGvK_YnG~Ane@tQ_T~PjHxEqHmDmHqE|CdDoPvUaChAtKuTf]bHzHlO{HJdIuTvc@cPke@zIfn@gF|JcKeOaQfMyJiXiTsIFoS}VaSsEfcBjT{m@pa@j_AySJ]hTrLmG~JlGvGaYdRpBdIdh@vPet@jOnEhDbRbGyKxEpHeApq@{GwT{RdPm@~MxKxKoMnc@px@`j@sHxPvA|]hH|IhKoGtAzIcUxk@~Rxu@lCxp@rQn_@qKfVpK|Z`j@dLwEdT{QpK_j@yBtEbn@|MlEdQwQpj@oLbf@|d@zDkKtQ~Ar`@`k@pSbC~Ebz@aBxL}S{\\\\fCle@{Ko[rGg^qQuZgS|x@}Sun@_Yf]wj@kb@mR|n@sC|xA|d@zEtCz\\\\cU~k@pXeAkKrg@`PvKjZqNlCrh@fJwTu@j[mSzZve@qLzI~QwCrm@hJpJ?prBtXin@~Ofw@nPsC`g@z[nEtUiGrI{m@~ZgYnBJbHl`@lZbCddAhHkAjDqv@vHmG~XlLnIfgAuN~]m[aDKps@hx@v}@hl@z}CXnSoQdPzLfvAw@fPgKhFwZ_Rub@oDqu@qw@yBgLrXan@pGocAcFqVuOtgA{QdRoZtgAhMzcC`KeAjq@||@xKmK`}@lKxn@|}@nm@jo@jN|Cj@nY}BjFuCiT{C|P^fz@|^}QnEjIrIy\\\\rRoPhS|ZbH`c@gNbj@iInG{BcPkYaGxNbx@tQzVb^mSjMlAtKll@e_@`[pTvYiAfu@}Vze@wMjHyFuDnIa~@sC{a@{j@piAex@jo@cAdZsL{S{Cro@hXje@~XfFf_@c`AfQyGbFbH{E~I~g@rEuCsPjhAea@gVnt@iJ|INoZiV`Rn@`J|m@jb@pFoCpJrUtB~lAoJ~Yq_@n`@oEga@uJvd@_e@de@iGxh@nGna@sOoa@kG|m@`JtIfQaEeG|\\\\dNmCn@}OvSjGnOqFp[muAtRyI~i@`}ArPjR`TvcA{\\\\f]aPef@eL{B{BsXyGpIbDh]kLl@iOoJ|L{\\\\{NcUqKpUmk@rIoTfOuIgFgCbLme@}rBkg@ulA~@{SpPvd@`TnNfFqfAwd@eoA_IbI[fWmKxCsZsbBkj@oz@`JgChIc\\\\mPax@oh@nCuCjNrEl`@~QjW{tDouF{vB{iGqnA}qKje@rcCpB}cCaFcRpKik@w]qjAy@mc@tc@oGy@fiAhK|TxOrDzM{WaGga@ba@~ItcAc{A}YiRzd@ap@_AyGyY{DiYrw@wp@bOaEnIaFyCfNi_AwCimBfKkSl[aAvDaIsH{c@bNaSfEomAi]hn@cGer@{GpGur@pgDhCn[eb@_PeBnXykAihAid@kCi`Aiu@_f@cqAy\\\\k]qc@ijBmz@yhBgWw~@ii@ccDtOjn@sDa_Agf@wgBcA}p@eG`a@ma@av@yLqv@{_AgzByAkMjo@hbBpKChFo]sYcBsHiOl`@a{@lFqi@oGkr@bP{c@il@fGe]tUqz@hBkYuFkp@km@udCuwDm\\\\apAeB{lAqGrXy\\\\gr@ya@{a@aq@e~BcDeo@bHxE|`@gW~GrGnBeEpCa{ApGfCyKgVgCwg@iMuJwJdSuTaUzGdf@ba@sXuFp}@jI|q@wj@aD_e@hHgI~UxOaG{Ar[oTvVko@Uu{Acg@{lCmWck@we@jCmx@}Vci@fGuuB|Nga@yUv\\\\mTfcBxIvt@iDv]_Zdf@mJaD_QnR{Y_Co}@cd@ec@sh@oNmo@em@ax@sp@a~AsV}kB`FrjAoR`Isr@in@uMnMyRaVmc@uFgXogAicA{Io_@id@nZdr@`ZpLra@x@rNp[jGfq@ru@|i@lu@dsFh_@by@v}@d_GpTxkC_TheAuUlQwXAySxTu]|mBaN~C}Uq[oa@oRmOpEuP{o@eLxKzGjTgEpL{ZkfA~NqbCdb@{y@f]}GfQkTbBcGkTqL}@t[}ExDuKgIyPhJqg@|u@{@ny@{\\\\z`Cov@`_AjRbAfEsRpMJfUcd@lM~AtH|c@``Azu@fd@hjAoI|M}VgNuTle@iIw^iHdC`Jhp@fh@qa@dz@|Mb\\\\uB|S~WnBdY}FjLsKWmRst@mNfMzBx[nGxG@eO`Nf`@dj@wNfQsVjbAac@pEgLdXu@jOie@f[|^hJ|g@fCoPzIfSfBn_@\\\\hc@sOtdAgJtKvAyNiJwHm@nZgSjARpU}PvUcsCfqCud@`|@qR~i@xHrYyCvh@iGeRga@aZ{MbR|@jjAbJaG`[zYzEqNpG~DzDdGoJhS|]gAxTndAsm@ps@_LiCiGrGiDl]jClWnJnFqAbb@}@rFiImH_Uf@fQzy@ad@lErGjRsDvX{MgFuN{e@uf@vp@_GyDqAvO|kBdcGls@vg@aXuAuR~c@xEnl@pO|Rt]loBhH`CwAtx@mB~JePkKtCtT~MzAlFy\\\\p^`z@rWtJlJ}GiG{d@zXzsB~Vhm@~En_AnQdYb@xb@ca@ca@mRha@x`@k@cSvTl@tmD_Nx|@wVipArCc{@dOsHqAaZuVe]mNbMoYop@lA_j@uJ_XsSsLqFfQtEl]jVvX{a@aIa_@xOq{@zDad@`e@sEaTxOuM_J{l@q[odAyKqG?_PsGmAmBtXoLfPnOft@_BjhAjO|\\\\jPsXoDt]_b@no@chA~i@sLjD_K_GuMiXl\\\\l[tPuTcIeOom@{KcOfDeTeNyC}XoVoVxEqKsb@s[bCu[_QcYenAxyAyo@~QijBbwBqu@zOaFx]s]h_@eg@|Quf@yUa]z\\\\kHcw@_Q{F?ba@xLcMfDli@oDpQqLwBt@~IxN`ErKsHhIvHdCoYrPcQhShv@of@`c@}GaPwPuBqn@`hAoTqi@ey@qk@e]aIyVgn@gp@cAof@udBhB}y@_NaNiCvKaPer@cEdQh]`dByAxLwI{BeOxOmJpi@dVme@|T`@v[p~Axp@rQjb@jd@vWhI?`\\\\nGtFrXwNfEn]wMIvG~TtD`EfFaF~BbHbLzz@vK}XhChTvKo\\\\tN|B{ApxArPqyA~[qbApl@v\\\\zg@yKpa@f]`IffAwM|dA~UT~Nna@ds@vmD~P`nClQnyDXzbDkTfiCcc@lv@uf@xNeMkhAqG~XoGsLgu@fyDwc@fn@uS{@kg@`bBiJxIuFmFiNlPeRmPf\\\\d`A}`@drCcZpr@ch@zk@oJqiAqSkHqJaUoOlFyCnNta@`cCeGn{AqXle@cUeo@hBgb@eoAqyDmJ}Dyb@`Yse@y`BoX`i@_P~HiK_Lpi@c_Ao]klAlAuk@rc@hc@`QyPhBu~BvKg]rF_zAr[_FzE{TpJxE~LuIlPn~@rXhLzCl[tSrMdGhTbG}M{|@ucBoEslAik@w^i[moAx@ew@tL}]rKyD|^pNxGqNxQhQp[uNlGoRyDky@iu@{iCgX_UaUrY?ef@ac@dsA}ZtpFsV}jA`NqdAyHoiA}H_MiGvC_SnwB_K{EBcZgPeS{CnTlL|z@xKoApQtw@jd@fk@vB~]eE|}EzIj|BeLzVmTmI{LhYaBju@tTrr@ia@dr@`Cdp@hTbp@eCrx@iJbY{UhP}Teq@aCsgB_g@uEnAgn@_Qg|@cFpIhBzeCfDjGnVkDrBda@_m@tqAqKme@gTuGmPia@qXrr@bQ_NlFaV|Ip`@h[|KxBda@__@zaBoFkCgYkj@oEkq@oe@uAyCd_@qRy~@yQfKeMsJOsr@_JyPeHhEuViw@{D{mAyK?_Usy@kTdByFye@cNzS}@nYlKyKrCpXbn@tw@bHxeAhXr]kBt[nWcChAdn@nMf^~OqGh]l_AzHoGXg_@tZ}@rFlu@tUho@bQzDhWg`@fPzQbLwFxU|D}LlkBcRdc@wX|O{NxAaEqG}KxKcPYiAcS_KfJuHgJaOtgCwM`]yo@|XaZkuA}OsGeRtE_\\\\xzAvQbdB|FlEpG_YpCj_@_J`Sap@ne@o}@_PiRuP~i@~DzKoVea@wyE}G|aBsQdp@{t@b}@}GinAjDgqBaUa_Cc]d~@im@de@wXtD{w@u`@o\\\\yf@aHq\\\\{lAyzAmf@m_Aqa@}\\\\_z@ieBcMio@DktBpEyJnInJnSaKdGgeAbPc[?er@tc@~lA{C}\\\\iVya@o@a]{PzAoCjs@wFfCgWm\\\\hT|m@yIrVhAr|@sXm\\\\uLrL_DbY_LqMqPgpAkrA_lClC|d@d`Ah|B|Gpl@at@xQgT|WwTyKcG`P_@efA}F_EyChh@kSr^}GeRsk@wU{f@ef@qUef@wCqdBzKa^aKaP`KwtAgb@df@cJhzAmPlOuGx[ip@ijBg`@ylDtBg}A|UogBv@k|@xE~@j\\\\st@fP_AfHpKsJ{m@|Be\\\\nM|d@f[l`@`W|sAhUjHcQyZa`@_dBk]cl@|K}u@vg@kw@gJud@kDsyBes@gdC_NqS}SgCgLwT_EqcAu\\\\q}@q_ByxBec@_kAoJsCuOpXwFqAfPcbCvS}q@ba@f}A_W{|HzGiu@yJco@xG}a@k[{}C_j@cpAiUcMsOos@c]{b@{P{g@sSeUoo@kNgq@vVyxAjlAyaBfMsZtL_fAnnCmKaPeLfr@cGcj@oGfgDnWdf@eBjJqXyKsJmSa`@_JeP{`@pQykFsKaRkNzq@{B}nAqc@olAiOgRqr@gFo{@rcA{WjxAef@hdArFmj@oOkz@{ChQbHvY?h{AhMeApVx}@ft@xu@lU`}AeKrJiLnbAcQfe@g@nl@jPgw@pXloBpPl`@jAiUoWqfA~]|z@WjlCnGoa@|EhdA`j@~x@zd@ruCl_@~z@jKzGrXgCbO~PbOOt^tuAsOdW__@hA{Vx^wOiGyGcTqa@pxA?pr@oGkP_c@jkAoRXdEly@d_@`{AvTun@zBa_ApXsi@yBm_A|EhGnIaCbSpi@h]vKd@hReLvc@me@|m@qk@~rCsLtwAnIb{L}BykA{Ghn@xu@x_EtDvz@mP{v@kE|TjEhgAnQ|o@a@bNgRa@uEnQtLkBhBlSvTaj@bGlPyKoaBrNbk@hC~zBwf@t_Gyl@toM{_@|oBkx@teAgl@}@yN}y@_P`NoZoK{_@rfAg@`Mba@qr@is@xtCkFvH_Q}FaL~M{c@ngBi_@xr@yMqAog@_RmWg\\\\pAcPbcApp@fJmPvVwvBiNonB_H?mVsg@c[dK{VkhA|XmnDqFcd@sYfcAqO`iBhCxf@iRroAq]doGdNsi@fTshCa_@`zEkMzwFu_@p_Ca_@rhAan@lmEmItVui@l\\\\iYch@e]muB~BqHpE|E`Yx|AfK|PbOzBtHkMmTsyAoR}i@yJeAsBeuDeHcRkNoAiGbf@sGvIgIsHOsbAwKti@yi@olBcC_c@j\\\\{`AcK{kAk_@agB{IkIcPb{@qXwtA`PrX?er@kKxKq`@ow@ifAyaGlDwjB~Gy]?|m@zOck@nKurEk\\\\i~Cud@vbCuCfuAaLtX_]a_@sTel@ep@emDuTwpBeDi|BbB}QxNWxHeXoMgCcJwi@uOmGah@kdCwMgGw[itGWioI|SgbA{Hj{DzFwD~@gfArZyZfXpAp]`|@fVrRbKoEtYnEx\\\\qXvMtLpSfv@nEveArJhYsAra@hHrHVkbBgH_\\\\`J~ApBcG_Pod@bo@af@pOu\\\\zPknA{JyjEpLoi@lB}eBuSwkB{CguB|Xgn@|UtDrOmH_S{LiSksBbUiq@eXkaC|S{cD`Ple@gCaj@vJsDpHpMpEeg@qE{g@kKba@g[i]gTwk@bAfc@ck@kiA}}@au@cNem@hAczAbGpGvT}m@?ykAkMyj@zDaKbM`@dFjOhTcLhHnJ`K_BrChn@zFqKsFgt@af@kd@y\\\\yK{~@tuAig@jiFj@dqClPhpAxSvZ~IsB|@{RtCjFxKgOtNbQ{p@td@cw@kq@wXmD}YdLgqBzgEsYfwDka@bUmb@ht@yVjGcLeiA{W_UtVii@uVgw@tCyKvzAasBtNa`Azy@nh@`TwIpRok@~v@olAjm@csCqE{{Byj@snCjX}e@jFqq@n[ko@zj@kgCSs{AmX}qF}KuF_}@z@ol@jaA{BkKmMnFgFim@qDtRbErp@nOnFaI`iAbZvh@sLrzAw]~fAmEdi@xKl~CtCdU~JtRvK?fCjTvVhArIjR|BrjCqQ~h@wReIiHr_Aky@rgC_Q`TkMuTmb@hGiWgq@u[zBuMel@oIaJqG|G}I}oCuKnOc@dg@qUh|@nFz_@qVjz@~W~`GuVhCgJzSJbJpKuD|K~HzKoK|GleBiHtEu`@aYar@av@}L|LxCtn@EiV~KcNrlAl_CaAj{@sAhG}GuCoFp^xArgCtM_UlF_j@fAjOi_@rfD}`@dkJ}PjXqt@zCqoAr^ayA~tAqjAtuBmw@`wDufAvyCmGxp@jToa@wBrQ_lAf~DmnApnF}n@xqHk_ArlBid@twBc[|wEbBjzAkFlWuk@chEt@_f@pb@~{DlFur@sCugAaXky@hG}o@mPh]tCucAwTpa@cX{a@sXeDec@an@yNrDsXeLiHbEaOqLay@gGmz@zY{E{GoBstAtJyn@gCeyBdJa|AmK{bKoSi}CmHwtGam@umFqgAwtE{vBgqEmgAecAxBgLxkAzgAeCuQkt@}gAgR|Dw`@{PeL`DeBwRka@oJkQ|HwKti@yKelAwKpX_H}ScG|SeGeJIox@uMiJ}Ilu@uIgC_J}\\\\gCtCuKyP{fBo`Gci@wd@cPnGyBmpAoPzGgCiT_I~EyJaReVikAqeAgrBaZicA|Hkl@|GlPtOoaBjGxQcChhB`PsxA`J`~@fI{JbIrNcI~bB~Xb{@oHu_DcRuo@rAas@gCtTs`@kaAu@atDed@geEnGfC|Ger@uThT{LalAmr@otCsFqLba@`dCg\\\\clAya@euC_gA_lDdO_[kJuoBxHeMzUdN?uOhMrL`@`i@vWx_ApAlu@rEsn@yMi~BzeBhcChAqVaXku@qDyd@dLmOxIyCpGpGhTyK`CuXjr@sz@qQKaWve@ke@xKeNsEsV~Zob@{LaPyUcOw@`PqgAw[_eC`I_o@_WmnA|G`pAsC~xAnGrCxKrxAe\\\\jcAqa@pWiV`s@iAbc@`TlfAkKrCom@_eCkHa~@?jT{v@inBer@_|HxKf[xKlgB_Dk{BxPaTbG~iBbUvtA`Icn@gDu_@dFqIzGxKda@__Akn@hb@uFysDqPtW@`b@gFqNxNsvEuO{jFqAh~@eRrs@`IyrACowEmQinBct@c~D_jA}kEkqAq_Cm_AeaC_\\\\ssAoFew@{\\\\gjAbSk}@qG|gAdXngAn`@{j@|Cy}@zM~DO}_C|F}z@|FiZxKnGrCui@rXti@}Cao@oVwv@|MaQuF}fAxNcmBtSa_@|]uLbKlH~Y`xAbTfUjLxt@|GdjDdEqn@nI}KvKboBiBq}@hBiRpGtXU{x@dHuMtCnGhTca@bPn\\\\hTmKvRpEeFej@iG_EaPvOqNwTvBqr@sHiv@tLoe@iFkFaRzJa@wKzLyi@sRqYg]ToOcJ}n@yaC~@_TxPqRf@o_@iF_EsTbH}Zxx@sXm_ArXmv@{[vCiDtKyGgU~Ma\\\\lRhGpZuI~Vfc@Aoc@gn@gVuKzEgCuXbC_`@xNqWyCsk@zr@_aC~Kb@nOxXd[pN|WrwAmB{cAzS{i@xK?hCqXuG}_BhDymAjF}VrKmM`ErDtEoOaGa}@}aAmsCdFelF}HgaBwQe~@`Bww@tOuv@eGiT|Bwt@zVyV~Fo_@uQe^dh@_~@qN{b@kRzUkA|_@aEmTx@egBbRmgCxZubAlf@t`BpBra@xFyHbJxBxGtp@lEhGrNcBtVge@pOqxBLo|AjKyPqGdsGjTpeAnGwz@uOk`JdGkrCzWtj@Trh@nHsp@tLp~AzRvU`EmgIzFq~@t^kpAqAg[sYxRmJsB_DyNsCc{BwO_yA|Bgw@uOf]sCyeDhTiTwKueAxIeJvg@na@gPieBOurAuj@knCjIyo@wO}hEtFiNnG`PpDebAuLqW|XozDpW_q@yFoe@`P}aAoB{eAx^seBhQwInLvErBopAg\\\\yaBx@kl@`Y_|@cAcd@lIuRyBsiAtIqZxKdLbGiTvKvKdNanAtMa`HfLky@uC{vCoHmA_AoPrCkaK|Qo{AkFg_BdH}a@{LtCzy@inDzDzGcLky@pXqg@dJmu@oEu~AtZvt@bCqOgEsjAtNucCoKwj@vLefBgCugAgVqq@_N_lAFo{BaN{b@xM}g@ba@rCye@e]iTpXkCc\\\\nL_u@_J{N{HksApEixBmScOtQaXqDy`Bze@kb@aDeLmPhOgVk@_@kg@jSchAsAwz@xOkWiDwb@sCfC~A{`@`e@emDjVvEyDul@rHwb@?|XzKct@zGoN|GbGzBca@lH|A~Yw`AlCgeBvGcc@`RmC`DaPJc_@iK}Yf`@afDj`@a~Anc@ev@fIckAaXak@wKvz@\"],[\"i{{\\\\pbcjA?oa@tKf`@\"],[\"gy{Wj_cl@~r@nYdHtXkb@qG_kA_n@zGaF|TxL\"],[\"_ckX|zoj@YxLuZYgB`LkWeGrZeX\"],[\"egvY`soi@mWlNmNwHvHiOh\\\\fB\"],[\"koyYhvoi@f{@l[qRxHqI}Cmd@sZ\"],[\"kb|Yf~zh@tg@XbG`]ea@gBgOmN\"],[\"yg}Y~}~h@zPp_@}GpV|PxUkFfBmN}GmWwk@r@yU\"],[\"wp}Ybwvh@zPbGfB|PyLxLnE~TeKpIoNi\\\\r@yY\"],[\"c__Zjbai@XbGo[uDuDjJaLaYjFwH\"],[\"idaZ~txh@rM_HnEvDqIl[c]kJr@wH\"],[\"oyaZxa`i@tMuDjWrZcGdGeTiBgOaP\"],[\"ckcZ~sfh@pIjJ}j@vQ?kS\"],[\"mnfZjcah@rI{C?n[{LkJ\"],[\"onlZdn|g@iPiCd[q_@rFdA\"],[\"etm`@xw}oAhNyQvS[yCj[}n@zi@_JbYyWxLcOyDrC_PyK{\\\\xKrOsCqX`NiTGaSlLwG~@`KtRwB~@|d@\"],[\"c}o`@hjboA|G{[bGw@x[htAaF|a@{\\\\ba@|CpOgGvG}JkAaEwOeLa}@xGyLeUoh@\\\\gP\"],[\"_hr`@pgapAkRcPqG|b@ia@zFyUa\\\\xb@m}@pr@ca@pa@pr@sXrXfMAgMfr@\"],[\"gkk`@jz~oA|M{RbA~t@}JrEOtm@{\\\\`LKeNme@|gAsT{\\\\Dnw@qPdDgMfYkN{EgCbf@wOqUqC`s@cPhCyHoK{d@vi@sXpG?c{@cM|i@eFrG?eLyKvT?i]wKh]yKye@_KhTsLgCeG}m@dVw@tEkVr^[jNui@|S{BlPuT}Zr|@nIxRlP{v@xKf]xK}u@|m@mH?g]tTrCt[au@jMdFrK}f@z\\\\aXnT|TbNij@\"],[\"}iq`@v}foA{^zGuRv`@fWgMjKxBxTl_@yL|Xml@eZsDnJqOiIxBad@eH_C}Wfa@}GoG}QpPuTgBoMqQ|m@wnBhp@yKvNrGnOcL`WlFfLp\\\\\"],[\"ijv`@bjjpA_K{EhFkk@f^dRxJpn@sLjh@rL|Wm]fPsKE?g]uHvSyPk@mNifAfLoSt\\\\dT\"],[\"shy`@pqmoApfA_qBvUs@kTrVrX{@bObJoJpo@x`@ke@hWhRvHoEec@hz@glBt]?eLe`@sC\"],[\"idy`@~lmpArOsCrExHhBt\\\\vNjL{Bd`@~NeHrs@hYsXf]kR|@iA~QqIy\\\\uSiJeFdS}Mk_@mQqM\"],[\"usz`@ndopA}WmShOem@`LkGdQ`ErIth@oJ`aA\"],[\"}}y`@rlkpAaq@bu@aI}AaG_`ApOw_@dWhC\"],[\"{wz`@lmppA|X`]yKxo@xKbj@e]w^_Fu\\\\yShQuI_PpGqgAvTaD\"],[\"ix`a@`sjpAlHtAhZlbAo_@ll@dNfGdd@kIjFha@sT`r@ce@rc@?sXwTgC}CiKvPcYgKtBuBqGfEyj@rQuPt@_gA}MiU\"],[\"_}ba@dfjpAbBaJlUw@?y\\\\pa@fC|T|Oke@nw@eHc^mP|X\"],[\"epba@ta_~A{JsIqDop@bf@|Gd_@gG`X`XaHzjAuQxDaGrL}V_u@\"],[\"saca@`u{pA{Bqo@xK~FxO~q@ja@~e@sHnd@kHbDqNwe@iVrr@uHi]pBca@`LrG\"],[\"oeca@trvpAmLuf@`Y{MjTlEhKfQaIrXc^lL\"],[\"us|`@dz|nAvFkJL|RvsBmAsPxS|@xL~a@mDlq@hVjYdr@tQdEzHxZe^riAgr@yz@k_@cLaVpH~Ttl@pe@aKnSpTpGdaAaRzc@eJiDiJil@aY{HuGkZ}e@m_@wqAjh@eh@tGzO`LjgAiZ~a@s@bz@ls@tK~_@{ZoXkr@x\\\\{k@y\\\\lRft@rW`Exu@q^pl@~d@l@tNwb@prAmcAhsAst@ywAMgPc[eR_Lnp@kQ|D_WoLsAgd@gU{FkBy{@aBxdA|Pdf@oTzImLi[sLgpBwY`]zOvMzHpl@cI~^oSvD?yfCsGyT{Bz|@oa@tZo@mf@qNo`@zBec@aVmlA`Y}}@brAosA|M`@|g@ko@xQcAlPeR\"],[\"ahfa@poppAFa|@yGqOoDzeAgFhNyJoAvEikA{W{~@hGcbAja@jP`d@qHzThGX|PsQxa@xMrZcHbRle@tiAgAxW~ElFbPwKfJ`r@oXrx@aV{Mqf@er@{NcD\"],[\"guja@vy{oAgWaPoCyjAbh@}L`PzMrLaFpXry@zC{`@mR{j@lQoi@jFa}@zj@m}@tTzc@yKv^rXiTrC|GnPcQhO`@bK{NxXnAmBdPwTdNvTrVdIheA}^by@i`@}[yGzp@qPcGsLnyApQ~LWdQar@ji@{I]fAcIcYaD}OvHqGi}@\"],[\"u|m`@ltpoAbA{JxVqDzBje@hCoG|r@rCiM|aAtH}Ar@fZ}Zv{@xP`Rs\\\\lI}Xyo@{G~QvMd`@{Dld@qXiz@qL~d@cG}\\\\kDfc@yQzGkInZ{BkTuTjT}\\\\qG`D`Mt|@zSv@zg@aKkAyCwX{LuF}Wt[nVjBdLrMlApRmP~YaLr@nG{QsXwTqEh]oIwCiCyaAsCfh@}NbDeQqPcFl]jd@d`@iLpGmr@ui@kf@xm@wNxC|GjIfMa@bVyWzPvA`Cfn@kc@h_Ag\\\\lEqJkEyAxXpWfK`Pxv@sBtq@gFzAkWyjAyKmAjDlmBpGsCcPj_@|Ehr@_UpCkRtZmWgc@|HezAlJ{Yg@cjBkU|cByF`LsLn@cBlTyKav@_Af|@iSp^ez@a`@eZ_u@}Eyo@gCje@ha@|nDc[znAyW|@cDmXwRhZmGaA{CgNhL}Sca@mJvJcj@}VeM{Tih@kc@aDgKjI`R|AgJjb@oe@hYkGuQy{@tKwK`Qkk@aHoK`Lo@vaBqLf\\\\`Cvr@aPlWc[p@sF~JoK}GiHi`@i]f]dKwiDzCwPrJrIrQeJeC}OkD|GiLiBqJ_eAxSwYlSXpMgr@f]?bMaTbd@~\\\\iCfi@je@ke@|GykAmPyKz[{WmGm`@nYgaA`a@{g@`Jm^jRsKbh@qx@v^q[fGhE|b@cP`WxI`G}MnPoAaYuXNoMlpAwr@xBql@pTwb@rRsI|HdEcAdMm^ho@o\\\\drBym@lv@vBzPxKeLtOvT|AoVpKqGpXt\\\\bRb|@vKvLnE}OxMCuUmVmHyj@mMiB{EaMiEshAjZyWtK}s@`FqDaCnk@rXjyAdImBaLio@|QmRaOuZiCen@zZu]~@y[pGxBft@ah@vLvs@{Epf@xc@w@W_MgReHhPwv@kDaRo]mSwDgb@nf@sNjt@ba@?iTjHhFdOnb@uOpTtO}G|G~XbN}Zx^h{AzJiv@gJkS|FcEq\\\\ob@sCgl@jGlJjL{A`P|SnEg]~]|G|Dhk@td@cx@\"],[\"kqla@`b}oAx\\\\pa@h]_TvLzFz[xjBqCv]i~@lsBcm@_f@{S{i@bEegAmKaaAlKye@\"],[\"_kka@neqoAjyAin@xBli@n\\\\tIrLzc@cZxO~AnQqRj\\\\}F~h@ya@nz@oKdHy_@cOkQfKc]iGkq@fYnQqfAdp@sg@rPwZnDgPwJmk@\"],[\"mboa@z{_}AwNaU_EsxAtQfIbRbl@uBpjBkO}Z\"],[\"{bpa@zqz~Ap[mr@wHvcBrLxKxB~iBoUaNoM{\\\\sEmcB\"],[\"sipa@vbg}AtTuOtEpFrDnUiKgPnGrXyUwIqI~R|CdYxVrF`U{X~S`lA`t@rnAm@nQ}QrM_X}Kcd@_k@ai@keB{Cym@\"],[\"apoa@rl`}Ah]tnAeF~dAoQnPe`@mk@gHy_B`C}Xz\\\\}SkIha@\"],[\"octa@vzcpAkF~@gOoXnPe_@H{`@xVgg@x]iYzZ_u@n\\\\wWbJo^nK?d`@b}AeSlf@fHxIjT_YbFx^}CpyAoQrOsg@jCcWmk@i]pXma@sC\"],[\"iina@||upA|\\\\yc@rC|r@bN}SfVrXdNml@x^jAlGlLkn@t_A`r@vBn@_k@b\\\\cIbJl_@b`@gPrh@pSxCnRmPzc@il@wQoRvQqw@eCoFhK~HnIz`@`AnB|Tl^yIbHl}@xVmPEsc@`]wIbPvTx`@wJ~EzO{PpPyH{HuTpr@qKgIeKdQoZyWeIh^gr@xI_FsCvGgSaTiEeLca@mK|DhTdr@?tXaJmSi\\\\xLgCy^}ZzBb@qb@aQg@zQmMh[?kY__@fXg`@qBgFy\\\\uCib@fUd@gUkEr@ah@r\\\\da@rWmd@n{@eD`v@mYap@sOut@p@g]uHiCaB~b@zRh_AyOfWeHme@mS]l@~PlLfDwB`|@c`@tWaPsD{z@~IyEyZfk@eq@{PiAaWfY_QrDwDyLjA}k@jTe_@nG_n@{\\\\z\\\\cGhc@iHw@mKv`@lb@m|CfKgO~SpAPuOxSsC_HeLf_@eRxv@ha@zIvg@dHoRsKya@sLqLuJvAkHg^gYuIc`@lDpSge@mDoS`H__@~s@lf@nMa]fQi@rQgNdVzQnDlUgX`PeJdlAdQqg@~LkEvTtOlIh[\"],[\"w{ya@lnvpAnScmE``@uxBEe`@`l@{k@aEsO_n@ve@`P}xCfS_PhNoa@~c@mTbS`GjOpYd_@yT`gA}L|PdQfCz\\\\egAhlBeq@|\\\\u@nYsS~j@z~@yXjAas@l`@aBnZzNuFqQzN}`AhHqGrCnGsCje@xKii@pa@oL?na@`WqEhTrUn@tW{Mng@bAlr@qN~L{@xh@lPaPJ|KdFgCbEvJfFloBwLzVyx@gBqHzTiTca@JpKuTnCwTrXma@kHwOlDgDaKePrH`@gRcf@zI~Zhe@aAfNoGqGiBdZeYnNhBjZaTnNlK}x@{Fec@{Nn~@i|@znBy[sG\"],[\"}n{a@lni|AdHsM~M~XrFnq@oG`PbWzn@eDl]hJ`^fj@nO`FzUmB`k@kKyKeQ~JmK{NpFjn@mJjKgMyl@oFpHrClVk`AuzAtCqMnH`BhGe[xXtR{\\\\ykAdGmR\"],[\"gs{a@~mwqArIwBlGy]xpAdr@xK`dCiw@eJoTee@{`@a_@{Ax|@eMzEsXyKnGna@cMcIyJnDeKeMkXnJsAiiAvZocAba@ke@gCnm@\"],[\"{gsa@lidqAtnBsDhIzHuWdRl^lEf_@aZdRrDtZmG~k@`PnDgLdLpAn_@rRsA}U|w@tX}E|LwTyKcGnGfCrr@qG}GkDpU_V`IwDvMwT?oAra@uRbEmmBjcB}G{SgIhJuIiJa@gl@wc@iYsY}b@~@vUzc@fo@mB~I_Qz@aYuImGiP\\\\|\\\\~r@jZvNvVtA`ZcYlF}T}D{r@{eAkGrSjq@v`AbJx^gJ~GiLqO{N`EyAgR}`@nA{RcVzYf{AoDh_@mMoG_Dpd@uc@oC{Ach@yP`KgLfe@yPsNyS_{@eEew@qPXoBpyAk[lg@oU~@}KoPgFks@mIhZfPvh@{CnUsVi]ea@}Qy@dkAjIvl@ekAbzAuDgk@jGqoAwG`AaKnpA}k@s}@ab@bCoTip@gAqa@pYs~@zW~~@|LnP|BqGgUwp@aAmLtK_JsLkb@n@iX`FyVpXle@{F{l@`ZusAfc@it@gClv@dL{\\\\`PhTYk[dXwHZys@rUaBhB|KxTa\\\\dXnNlMc\\\\lK}E|JlIzBiRdLcIlP?nGeLzSjDtWoMpHfDxPuRjZfAl_@i]hKrE\"],[\"wphb@pdq|A{BjTuCmKiFxDgBvk@{k@ryAkYid@~^g`BfWch@|m@g]\"],[\"}odb@p{z{AxAsG`EfD|_Az~Ajn@rQ|AdN}Glo@|Gp[yLqOwJr@?jWtNpFh@zImDrd@cSrSdFxd@uWfj@gChhCnPef@nGhhApGoGhT`PmPe_@mKqaBbk@_{BzRyVrw@b{@nBtOuNcC{AbK|UbaAk]fi@wSiMrK|y@eEr\\\\pOp{@iUv`@`ScA~CpFzBsEzH_}@wHuXz\\\\}l@hWvtAqJbaBf]rr@eLna@rR{Ife@~q@sE`Je]dGAza@`Fn@mAmZ`YnO|OoOxNk@pCfdCpH`^dAciBfNdKnG~d@zBuz@hBcLrF|Al^zh@]`XiWfFtu@vd@_FbQpMzEmEsn@lMYlNtp@}Anb@pYxPdc@tjAuJhi@hLfi@aExOi{@qt@q|AusDlP|m@eScJyLvK`c@xZx@vn@scA}m@ae@}aByKbh@dPvOng@dwAt{@vmAoWzViTaPyPaRm`@ejAuTtnDhC|iAlTzrApYiT`L{\\\\qDi_@mc@qv@fAmhBdVbDph@~[rX?jNdUt@t`@hIeObObIaDvMvKrKuJsAiDpJx\\\\|@cGoc@~M`g@a\\\\@cPpMaZ`s@sJbhAwt@uFoTtIcf@^us@ph@_Ovs@fFx{@oJbKiAmT{KiEkRj_@}EaMdJiq@yK~OcUcOoSpJ{Vsr@_E|GiKgDcReUgJ{g@tOmPqEgFy^dBbGwgAkKf]sXqr@gLuC?}m@_Pme@vKaj@|C_rAtm@i[pIrOzEbdAkBysAjHqWjnA_[dRug@dm@}o@hS{gA_]teAue@`NuRdZkl@fDsS{Ecd@fYpSg~@Cmk@{Idn@}t@rpAuEam@vIqxAzFqOjQoE_@yWyH_Cyg@nfA_Qf`Cgg@fSyToGyf@iv@mPeeAlHoRyC}O|XqVpGk`@zvAePzk@yWuHsDmq@lQ|Mcv@wZpm@uIiHiIa`@hi@}r@gLcYqJxAwYfn@sEzb@sU~I}C_Phe@{sC~a@ZlK_T{IhIgpAbAc[~XjDm`@hi@imAsCcGseAf_BcX|x@_H`C_E}Ixq@shDDgKkKdFy@wLrIgjAjOw`@hTpr@yBiTlK~Upx@xRvWwWiFaQgm@f@w[mX|Ssn@ae@gb@`Pke@uQeDmNhLaJwFlAkT`OuRuCkl@rYgc@xTfE~EwIqNcc@dLkTvOrPhRp~@ng@px@gK_v@rZtBvMjN{Sc{@hShRfWfEhAoFyKes@\"],[\"gbkb@rzfrAtPuEvUjGn_@vc@mTlKmGiCsC~X}SaPpEjm@cNgK_QnDcOgQoGd]g]mJ}Rei@~r@qtAdHvR\"],[\"gbmb@nms|Ake@c{@sCtCoFm_@~\\\\}m@Yut@jf@gj@rW_aBpGhcAm]jhBx@fTwa@ld@S|NhTwTv@|mA\"],[\"czpb@hcu{AeBcb@jJiIj]pL`LlRiApYwGfD\"],[\"o~_b@~g|qAqKsCqEhG_Phq@ah@vo@^_h@rq@mgBaRfLmdA`tC_RpT{Bca@dKOR_PaHdBkGy[yCp}@mKjT|NpFZvJoM`PiZca@kd@riAyUtI|Dz_@qg@dt@NbP|M`ImErEmd@qL}Ya\\\\k\\\\`IiKy_@dy@{|Ata@ooAspCnjF{OdLwHcCeJoZ|SibAseA~r@z@yx@hi@aWzP}XbVpEtNyx@eZvf@mWiBe]hJcJ}Z{HhAfF_h@r\\\\aaAcX{DiPuSsZauA~[yj@Msp@ti@e}Ahd@`WvDfVj_@th@oFtg@`Ftw@fH}Q_Ggd@xOwi@zHhCaBncAlJn_@tGwpA~K}B_E_QuNbDaFaf@of@_c@eLmp@uHmFeSrSqGsi@xKer@pGtO~ByIlAcfCbHmEbEbJ|ZqqB~`@gM`GxKjNcG|]va@nW{]oa@zpAsXbaBf`@kj@vLaz@lPcWiCmIvOmIrCsVvOjs@iEnxCsz@|xCmaA|nFhTkl@lQoNlc@gxC`JkTtJMjTivAn\\\\c_@zRxYoAkw@xMyf@k[itAmAwbBn~@oQbh@sW`h@k@vN_QbFpBkAla@xKaPfD~MoB|rBstC|cJmc@vrBtTqXrA}\\\\xHgCnCnFzO_OfBbd@`QfZiCc{@vXgaArTmTf@zl@nJtFcAap@xLcK|LdMvFoPjSeCtI|m@pQ}L|@dc@zPaHvAnUbItF\"],[\"gmpb@hzcqAaRl`@_YbQaUygA|E}q@vtAsjC\"],[\"}aza@fb_qAle@xpAh@`w@xJ~Wkd@aIoXfNoZ{YyQb[qWfDfDkbAaL}K_Dl_@mNpOyRwWuEcu@oPrXcGje@c[}@iBkT|LcEh]q{B_Yba@yKme@?raB_|@x`AAob@y_@_bBgD~MdY`uB{_@pVqTr]ss@lMsf@rWuh@wIat@~Lwm@pXgJ{Ewg@pa@cc@me@uTpGkRcPcE`BzIfW~d@lD_j@ph@uLzb@mQD_NeN}Mhe@{s@bNaYaDIgN|ReKtBoYbVyg@|RiGfn@_t@da@iJoGwgChTseAwHgrBpUgH?~XxKmPdQcCh_@mp@vPkAlc@qs@ne@eMrp@_x@fXzBrs@wL`t@as@z@`Vua@~\\\\y^nv@mfAv\\\\qWjo@gdAp`@qm@zv@rXkn@uMzIw]zb@_U|n@tl@kY{Bba@zL_c@pWkVyOvx@nw@qYlj@lHxE{EmRoOrI}Yz`B_dBjLtS`Dej@~Twl@jm@iFbr@{t@zB|K}ShR?jRjLDsYt|AnGnGfLqX?na@|OilA`ThLeKu`A|p@sPb_@dy@e`@rcAnAtFtO}@JnIsV~NeNfb@vd@qZdMpEtAqX`M{PhLnFcDrYrl@~WrIf^_DrPnL`X|JwHbDhb@nGsCoGjY`PtO\"],[\"_irb@vaw{ApXjKzCsIh\\\\bAxv@jyAoa@~~@wg@zOpA~QjH|WfSzFdKqTsBdn@pGsXfNf@zQwb@GfUtPxSyKxOgEjt@z^dy@_OvGe|@oC~@nL`sAji@vJpv@kOfTlb@]rRlWw`@|aCuSlZqJ`DcEeJuAjiAac@xe@{^uXvMicBxK{IoG{IbLav@yk@tfBqRhWmUow@bPghAsCyaAlQqPgHuHcd@xIuLfS}BsXg]xkAuDucAuUqWtLwXe_@oY`BwS|LuGgCy_Af]`PpGme@pFxHdPwAoH{VlGuSdXxGyL{Zsj@kF}BuG|Bwv@pGfCxBeLhCdL`IkEpJa[w\\\\mz@fP_T~dAjk@hPuEyo@gZnBmTnPv@_m@gi@\"],[\"a}ub@vid|AwJ[uDhLoDgl@sNaK_]iiAAm`AnP{BxU`SlFgF~o@tuA\"],[\"_fzb@fofaByV{oB{Yop@fzChbC~Kvi@kAxiAyn@aCce@vx@d_@uy@ge@eI\"],[\"qy}b@`s|{AfC~eAaKqUmUd@zGajBpGzB?f]\"],[\"eagc@bm~zAiFo^fOg[je@`j@z@ts@yIhJ}Reb@\"],[\"mxec@xou|A_GrP{Mq@aW{l@}@ip@~QiX`Gw@rO`_@\"],[\"sghc@ffwzA?qXvTxK{GrIbBx\\\\dQfg@oZsS\"],[\"iqrc@rdbwAaPgrAzs@|xBp}@h}AtGt^od@a`@\"],[\"cxsc@bviyAtGgEbe@zt@rPvm@?rv@{Kef@?lPwKwKiC|Sc@yU~KgY\"],[\"_|sc@rffyA~e@zi@pRbu@f@jg@qiAciB]i\\\\\"],[\"eitc@j|hyAfAjq@|IjH?aPl]~x@yCfIyZyRqc@ubAcYwTzTiGmA_WhJ}S\"],[\"_vsc@rvlyApK~|@wDna@}GolAoGrcAcPscAgCb{@m`@wyAo@yLfL}Ash@_g@tDsDl_Ajc@\"],[\"{mxc@~dxxAaPqrBzp@bv@z}@pjCff@~y@fa@f]`NiMrJhGkArd@tSrd@v\\\\cThTdWOr}A`ZtN~UjwBkEv`@qg@iQa[a|AeJhi@{Sy_@uIes@sOjTafAamDwPwZmMvCiNic@c[iTtCqX}Im`@_`@~BaSaS}GbP_LiGeKim@xB}PnP|S?gcA\"],[\"wezc@zdiyAjn@rXfGzMgG`y@}T_DqTe_@qJge@\"],[\"_jzc@~lrwAeTqp@h`ArPa@lv@wKdl@\"],[\"kvzc@zffxA?scAjKrcAdLhCdExPOncAf]xbBrc@xaA}Ff]uPzS}m@yvB{G~bAxThb@{Gb[sRvLeVyOiCcUuTgO}J{[[}b@xK|G{B{vBje@pGeQseChFkR\"],[\"a{zc@bdeyApG~i@{v@_j@ba@|GsLgn@eXpGuGwH|@yJjSeCsEa]lRnCnGaPrXvkA|FsL}Bgw@bpAzeAfDsPbc@dRtMzb@gJq@oBnZ{Zy\\\\nE~c@oEpb@kTsXyKrXsLcoAsOeM~ChhA{Gaj@sQ`a@iDad@\"],[\"kj|c@reu{AvT`PfNnh@|UtJtGcIvNjl@mD|^sD{e@iTiCe^}]ySjDmMqOv@gj@tKkP\"],[\"}c_d@fy}wArCsCbGlPfCmPpJpMdz@ftD|SnoCiE|Vw_@{[k@}fB}B{OoG`PmPcjByKzK{CgdA\"],[\"mu_d@`y_yAcG{\\\\iJlBo@rLgLg]`\\\\aa@}Joe@~VzOfE|tAaPaP\"],[\"{{`d@tfhyAsOaKv^ef@fE|m@dLpGye@tn@nGef@_UlKqBsD\"],[\"iy_d@l|lyA_Zl`@wM|CmH}S}GvKoBmc@rOu\\\\tOtIbHoQdTtDzGlS\"],[\"aobd@rikyAp@zPuH|Jc[gCy[oc@wJtVsCin@nNig@jK}ObNv@rUkR|JpIeLpVrUpb@\"],[\"qfye@~uabBxK`P~OyKjDjF_IzPpGxlCuOd_A_Ye_@iCw~BqJ_TjFg`@rCtC\"],[\"mqni@zerbBu\\\\sGyMqYzYfWt`BfDeg@hH\"],[\"ewpi@ldiuAbPwV}k@l{Bqc@jcEz@wv@ne@knD\"],[\"yjri@ztobBa_@}a@kp@gbB`_AtiBlpAhhA\"],[\"}rvi@rwhbBaZod@mNit@twAb{C\"],[\"gqyi@xodbBqi@_mB{PczA`^bpBlh@`{A|\\\\`j@\"],[\"wp|i@lhraB{IdrDdAlm@hPrg@cPgT}Gka@\"],[\"sj_j@hsyyAwJxx@hAt_Ao_@lnB_DniAgDao@vd@avCkDyk@\"],[\"a__Njr{p@lK{GqGxKhKpIxCf[yHuA\"],[\"sl_Nxxzp@?i]pLeClKfn@\"],[\"gf`Nf|vp@vMnNvR`aAy\\\\gr@yKtO`Pw`@sM]mA~PsIoAeArIeLgC\"],[\"ucaNxvnp@aLbICbScPc{@\"],[\"wnbN|lup@zi@wm@tWiHrHxLuQ\\\\\"],[\"uwbNjpkp@f]hcA_]mo@\"],[\"gfdNfcip@lId@PoH`IlHbC~_@\"],[\"eclNhw_p@|h@h[pLuAtl@xq@r@pLtZvMvv@pw@BbK{ElB_GmU}TmF_c@qi@mN_AaPo\\\\gLb@Z|NcJuBnDg[_q@ab@cUmCqMvJaLe_@o[qZ`DsL\"],[\"stpNze}o@|g@tO|DdLwx@c^\"],[\"oltNxt|o@iDxJeKqFcGnGeL_P\"],[\"eycOvacq@`Bw_@kFgDxK_YnPje@wThn@iTbPye@nGhk@oX\"],[\"iohOrseq@fC{G~r@yK\"],[\"mffOlfcq@uNuAex@f[dCaTbX}QhU~Djc@{Tbj@{BoNpO\"],[\"}njOb|eq@zZ{HdZfDav@dL\"],[\"qe`O|kiz@hkB}RgcFvy@g}Cj_AuTfCyHgGcd@hXkj@qFokCfKsr@mPrkDOhlB{ShaEc`A\"],[\"_bvO|kmq@iVtM~GuOpz@ug@\"],[\"ag~Ob_gz@oO}CnBuM`qBvrAz`Bzq@ruA`e@nkApR|Bt[uiA_PwjDgmAyUrDtOg]yaAea@\"],[\"ek|O~aap@wuAza@t`B}r@bz@}MpmGelBgwC`pA\"],[\"ildPbecz@pDwTf^|A`v@`v@|pAb{@mJjD}QgGoy@_o@yHfK\"],[\"elePx|ep@uF\\\\wEt@qJ|CdSkIxD{@\"],[\"ypiPhjzy@zB}Mlc@dGnWz`A``AtoBlfAfrAef@iTq`@xBcO{{@clAcyB{A{k@\"],[\"iyiP`fyy@i_@ob@iRgq@\"],[\"ydmPlvfp@mKi]vQoI`qAv[v|AjF|e@sKkyApg@{fAdMkd@eBHkL\"],[\"_rvPxfov@fAiXtBwM?dU\"],[\"ipvPpqcv@yKmPkA{Cq@cDWsD@cErC?\"],[\"kuzPza|u@pGuCpX|m@yL}B\"],[\"qh|P|yzx@hKew@rwC`tFm\\\\_UmpBi}CkYxB\"],[\"yf_Qzplu@hL_@~Fva@\"],[\"i~~Ph_`w@|B~Vct@noBcPhHm_@}hAxEya@|Zml@vEyi@dMz@nPd^nVxC\"],[\"q|aQr|}r@{\\\\jk@z@enAh_@bM\"],[\"ixcQz_vr@mP}Z`j@tz@hs@hpBtBxp@kL`]dJaZ{Eqh@\"],[\"yzdQndru@x\\\\mP_PpRtTrRyb@JgFiH\"],[\"kceQffou@eC_PpXlGcU|[\"],[\"kceQrtsr@_HkX`RdSrE~a@\"],[\"w|gQnzmu@nITdFnc@\"],[\"yqhQprnu@dIxCvMnHbEdDnHpJXhBkEkEoEeD}DcBaIsEoKyH\"],[\"uajQfxfu@lFuLpf@mSti@iCpr@hEtf@~SaM~Acj@cUom@{Bg|@zQ\"],[\"axkQrvqu@u@cCrB]vD~B}@`D\"],[\"ynjQt}lu@bRdApH|QmW|V|Mxk@uIfGeJwd@mMSke@m_Aph@dj@pHqRnP{@\"],[\"}dmQ~bhu@AcGjC|X\"],[\"edmQjq_u@uC?tCgn@hCfC[dU\"],[\"c~mQflju@ve@rOuC|GyKeCyBje@yE{n@\"],[\"ismQ|hbu@`Iw`AgOngCuCqc@\"],[\"oymQlcwt@nGbuCoMmmBaI_O\"],[\"a|pQrf|s@fCojB~m@r`M{Bpa@\"],[\"wtxQdkvp@si@pG{QkEoT}]wRsHzwCvZ\"],[\"kd`SfufgAtD}CqBxSlL~Zy\\\\zj@uoBnbAlCwYpr@od@\"],[\"sudSvrlo@`a@nPiViB\"],[\"_whSjw|gA~Y}`@lDbW_Ipk@_[tTkEg\\\\\"],[\"kxkShwigAiHvb@eWjRMoi@rTeV}AuLjWibAbKQz_@gd@bPwAnEpLaLn]oAnv@{TfO}MeH_TbF\"],[\"_|tSfzqn@_PoP~i@nPmPz\\\\sMcM\"],[\"ixwSprghA|Sx@nNeWyAmPnYgFGfRf]|cAeQ`h@ip@pl@\"],[\"a~wSjnmhA~BmOtLgEaJ`wAeXaq@\"],[\"wpwSzp}gAhRxQbDj|@zOre@C`|@}Kpb@_QbGyMeEiPza@sJ{HxO{cAmAy\\\\lUem@|Bs_@gOmMyHg[bLcZ\"],[\"axxSn~on@cp@{QwEsN\"],[\"iy`T|ign@mPip@bm@~cB`x@viAif@ua@\"],[\"kddThlvm@~PgPhBvGca@l_A\"],[\"kudT|{|m@cKmeBxFq_@j@fzAd`@diDuNk[\"],[\"_|hTdkpm@mRw`@daB`xB`{BbsA}JjDoQyG{`BihA\"],[\"w~kTx{lm@lEqX~i@rcA\"],[\"c_mTvdkm@eAk^kk@}hBzTl\\\\nOtw@le@fcA\"],[\"sdxTpcam@peAeNlhEzf@xOrPn[|vBiZk[nBiHwg@mbBqfB{R_g@zAwZyKweCh]`V_R\"],[\"_t|Thyem@cHzGwCqIzLo_@le@g]vS~FuBdTy^~J\"],[\"mtzU`tdm@fy@lf@gu@uY\"],[\"}x|Uv~cm@oA{Lf|@fk@\"],[\"queVjnkm@xCkJfOuBx\\\\~G_I`Ov\\\\bBiVT^`Ia[zCp@sV{DsEsPhV}DiM\"],[\"{ahVv_lm@qFn^yL{A_J_RtLoN\"],[\"kfiVxyzl@wz@ae@~cCx{@lzBdtAxUx_@qD~GqGqArAaRmHeDiDhNaFaCiGmVkpBupA\"],[\"omaWfikl@cJlDD{g@lf@tb@xFjT{A`SkOdMmByIfOmR\"],[\"utfW`|fl@sXwT|pC|aBg`@eI\"],[\"mvxW`xuk@~f@veEoHyC}`@{lC}@{w@\"],[\"}rxWxwfl@oH{]v[mMvj@dq@pR`hAsVwDeRaXoc@aEmHaL\"],[\"_rzW|mnk@oTwlAxlA~rFxKl|@yB~c@q_@mdD\"],[\"q`aXht{j@uGnFlC}l@lOfPpqB`eI|Dx`@qOkGcBv]fVsBzCdF|i@piCcAtGyMsu@mExn@lNzVcCff@hLiEzJxThAlOmKjExM|b@yCzg@jJfLzE`h@aE|Q~QzPgH`n@fP`YhNv{@fN`uBzUjx@kNlHzHxYlHId@isAlD|BeBjsBv\\\\djBmG?kf@uiB}Q~d@lDnWbO`NbSmH|F|cAWxHmD|CmEoG{QdYgc@iNiR{[qTyFm[c]tFy\\\\cLsVfBqZdLmLqe@g@]yHlQwNuIaJsLrUaMaGzDs[pSeLmZ@{R}KgJceAvIgBzDbTpGsVeIiA^{GfHoGgIiF}SfOqJgDtF_a@rJ|D|GyK?we@mNl@EjWcQl@lJkPtPwuA_Fkb@sOcRqI|A{EyWtNsLuMmHfCmwDqJo~Agl@{`BsQqOoAeSy^mj@uLmi@hCuPtKExLrYsJhHzh@lbApEz@dE{QbGjBrBh\\\\|XDeKvIpJbh@t_@`d@fBlUlKlDdE{xAm{@_fA{AmX{UcBDgHlQcDfAwPaYwUxTi\\\\uVgC}CkNn`@_\\\\c]ugA`AeTsMM\"],[\"sucXtstj@r]oE_Ajg@_k@qTzEaO\"],[\"iudXdf}j@l@`XePmIdEuJaI{DeDyl@\"],[\"mmgXxfwi@bCwN~cAmk@jOxDl@riB}d@vvArHsyBsNq^}O{I|Sxe@se@iW\"],[\"_ngXz}_j@oUa[ze@sDlCvJ\"],[\"kchXfmaj@|G{c@x^gE|E`uBl\\\\vp@mY|j@uCcg@kh@uh@o`@{cAl@qFtMtEaOySnKkN\"],[\"{tjXrupj@hf@zCzSvSogAeL\"],[\"qplXbtui@dLoGfp@tY|@bK\"],[\"qckXlhoj@y^aJmPo\\\\`jBbGiCnRpQ~V`Db_@gKaA}IqYkNnI{\\\\oI\"],[\"aak[vjtjA|PcOb\\\\u@vErJ{SrEgGlMyTuC\"],[\"ah}[|hviAjYui@l\\\\~~@qOzAuQgTcBnMfCsCtIhTlVhCeEdRmcAuI_XoWeBsH\"],[\"my`\\\\powiArKiIxHnJ}Qlp@qPkE{S~Fw^eMdJg\\\\ba@eLpGdL\"],[\"mkj\\\\x`|iAmBqKbQ}Ldh@kBItJsTbRaX`DjTcMQ}G\"],[\"k`h\\\\rytiAfO{Fp[|W}GpGhApXoZfDcPnOzK~XuBlLmSrXwa@nQiy@s@}DzH|Fj[qa@hn@oZlCosAcz@oI_e@f[iJtTqe@nP^zF~PqOv\\\\jXzS}KtGxVhE`Idl@vFoGu@kc@fUqk@bqBe[iQyNuL|FaTqDzm@arA\"],[\"y`r\\\\ts~iAhPyF{EwP~G}FnXbKeCze@{I_CoIx`@yTKwKuOoKdCyQuQ`NoO\"],[\"e}s\\\\pj{iAtI}JrLlGdBjOi]dLwHmK\"],[\"agr\\\\rvajAz\\\\oa@m@rg@mNbUyIdm@k_A|ViLi]nv@s}A\"],[\"gnu\\\\bk|iApa@~m@uw@bn@lYtGza@g]~CxFPxc@s[f\\\\zXrIiQjQat@ybAt@sd@\"],[\"ypu\\\\j}yiAbMQwJrTq^je@}MY\"],[\"}h}_@b`kdBbEmHvD|D~Bpg@_EjVaI~A{FuW\"],[\"cdm`@|sj`BpGqDzJ`H`BjYeBnK{_@hHgEqS\"],[\"u}t`@n`naBdLw@rCpQmTnp@{LuPg@sb@p@yG\"],[\"aev`@jmraBtGkHlSpK~Gtl@iJ`a@gRoS\"],[\"{qw`@rut`B|JjAuJdj@yJqY\"],[\"_aaa@lqf`BbSwMjKbG}Ord@iOmQ\"],[\"koea@fds~A|LGrChH_O`_@oRa[\"],[\"omha@lbu~AtLiGdH|NeVvI{UkR\"],[\"imka@tgi_BxG}BlH`P{Ntg@kMcYvBg[\"],[\"wabb@bq{|Ax^yAhH|GgIbNgb@nKoTmK`JwU\"],[\"aijb@|m~{AzHsGdLpWqWrsAgMqLeG~DwCwd@fWoH\"],[\"{nkb@fhf|AdEoHjZxTRr[_Ov^mKgP\"],[\"onqb@brg|AyI`x@aLlPoGgNzAqd@bL{[nP}I\"],[\"cayb@t`y`B|HiHfOzSyPLmJ~Vu[mHvEmMnWk@\"],[\"yazb@jo{`BnRfDlH`Xuh@}O\"],[\"cxid@vxtzAfFuSjTb^Cp]\"],[\"gvne@xqmcBhJmKG~Z\"],[\"}mh^t{ilBcLqa@f]sOdSzb@kF`J\"],[\"crj^byflB{EsRzXk^|[tr@\"],[\"uhs^p|blBtFwVfTeDpRtKbBz\\\\g]r^eZi[\"],[\"g|r^ltqjBpL|GbGyKfCfP`PbC_FnHrOnSmH|EjAbTeLdI}g@tCtCyKwThTgJ{iAjFcN`P|m@?kTlKtC{FwZ\"],[\"uhs^dvljB`PcGaP|SbAnm@fRuFkT|XzNnIfQiAuTnG_Hnb@_KzAt@aMkO|DgK{KdMue@zNeIoKgJ\"],[\"yjt^jiljBoGyKl\\\\oG}Sf}AuPgu@nCuQtClP\"],[\"isr^dtokBvKaPpQtSj^tAtMjVwG|TfJ~Dp@hNle@eLfBnVa\\\\`XsKznAyTmLsK_hA_ZyCiPxw@{s@tgBeWgh@iEeyBdXkj@|IbElHq\\\\nH_BKgqAmKgu@jNxApF|K\"],[\"aiu^jlbkBlEs\\\\bRoPfj@jw@nW_DvLjGxGcHr\\\\`QqGl\\\\dL?zFdm@iCdYaV~^g@xg@bMdf@Jj}@|EjRbW~Qm\\\\|Z_Tmc@pGelAgLwG_YgfCoUco@odAeL\"],[\"ubv^pbgjB|Gwe@pO~f@yBdO\"],[\"unr^f`ujB|SoLvTna@kTnKti@x{@oJbMdOn]uCx^|PtGxJ{IhLdAwKpXhNvSs@tQkJbEaF_J_QpL{[W`iAfw@V~d@_j@aFuX{n@eVyCgLwe@gClv@eSsAaI}WbUca@?iaAmXhn@eN}F_Cge@s]xl@kPp@qIkM^ycA{TmZdCyc@hKvGdPsKvJ`EhD|^f]rX?k`@`PzBwDwNrHag@oHSqF_XlIuU\"],[\"ekv^d|ijB?me@rJ~j@cB~l@{FwE\"],[\"oov^teojBsAjOy_@nQ}[{NaEcOKsm@|Wsf@xTaEo@}R`Mrh@nJ~HbGiNfJfL\"],[\"ovx^bithBhJwgB~StaCeK`m@bVduAoA`u@dL~O}Ddk@yIbEIrdAqGuCgCzGpLtd@vAhu@_Ixt@eNIsXbuAqGsCrHey@sHuk@tOpGcGca@vTsXmVeXkNgkBjC_e@|OpG?opA|F}`@pHmC_Mao@hH{W\"],[\"{c~^nifhBtX_aAjPxDtVt~@sExPjW`v@}Ev_A{v@{m@g]}gA\"],[\"oi{^nmfiBpGfVgAdx@dJxL|k@c_AlPYaIdc@vAph@vTiC}Sj`AhG`cA|FnBgCke@bFtJf^rJ_DrSqSdJhFjR{NxRxe@wTiOn_@`BpIfLyKuCvZ|GvZyKdr@fGgF`K~ImO~jBhHl{@vMj^qE|}DaT_oBlA}^`IrE}Gme@`FuW{YBbIk[kLmDv@yOwVgSfO_G}HeWhHiHaDuRdLmM{\\\\cf@pK{jAmCmQgApKyH]aDwq@aGmFWxYiOrJ`DozAoJDoMrUCye@vO{\\\\yP}Md@eRqNuIqEyVaKWaDfb@{Wrd@`IbF|I}DgFbj@yGvOoHmC_Gq{@_QbD?g]aNaImQox@vJc`AbRwRnEeZl[}J|NjH|HrW\"],[\"{}`_@fzogBdRo]fUbHzDba@sEvTaNz@aOcL\"],[\"m`d_@zbcgB|GqXjIj@|e@~a@kAjp@zb@p{@sDvW}TxD}y@k_AyLwl@\"],[\"e|e_@fjyfB~Le]jFzGzGiChCzpA{\\\\eLuCpG\"],[\"_tg_@beqfB|XhChC{Gt]pZ_\\\\jwArR`}@iTj\\\\yGJgJ_WlAem@eRu]gEci@\"],[\"oqh_@|txfBnGin@h]le@uR|i@eLuC\"],[\"mzj_@liqfBtTaP|^f`@fA|PqG{BiDrJab@oC\"],[\"oyq_@|mmeBrCuClG~Nr|@bcD|Qj|AtP~XpG{B|WpUeBpJfOSfs@|v@hOvhA`]tu@zLxCjAnz@pQqK~FhFgKxYf[jx@rT|uAfIuApGhn@kJo^gMaBoG}m@ye@mP?we@qGmKsi@yKxBqLy^ob@qk@|MiS}Kyp@auAyM{GfOoz@{P}f@zPsRmMal@uReExAzKwJMcGxe@}UgVuYxEoc@gY{e@{nAcXymCdZ_m@dCynAhJsF`NhGz^lf@hGyI\"],[\"soz_@rzkdBiC{\\\\pNnVvO_EjI`G?le@xPoKlKda@lKqGq_@ft@_UqCtFoMcSkQbSkBw`@uT\"],[\"_kx_@||ndB|G}JtOa@lC~HnC|hAzBvIpGeLdCxv@nP`j@tDgPfNiGiItPrClO|b@lKwe@~i@zg@mPsIvn@~Qc[xPoD{K~m@wc@xd@pG|Gjo@sc@tP^qK~_@lR~M_Yz\\\\~XrX}MdL`Vz\\\\yKuCgBrPrq@tpD|XxQ}Glj@xKhi@|G{B`^ra@_Ld|@fWzc@cQ~RcEds@aQjj@kn@{zAfC_cA{Gr@eAmObJgs@kTtOiLkW~Iu~AkRbHh@iSeRoFhHyg@_Iii@k[tO|A{wAoJ}r@}@huAyI|[qG}GaS~RkQuPzBy^bPuTiQjBzDqd@g]`j@cIj@yL{UyEwdAbYw[hYqCmPyKlPqXun@`PvIye@aEyKuCtXcGgr@iDz~@}WrpAzGl}@g]vg@yKiC?ba@wStF_FySoVuOka@cr@rCeW}Owu@hGkWg]__ArXuC}Sm\\\\lAmg@~Yg[tEhU~OdFxIcGjTdEsCe_@f]lPej@gjA`H_Rme@yKke@si@xHkEnUfG`EcNkPil@|VaOxMdIeLg]xPvBxUzc@oJz_@lUrKxK|m@xKyKpB`GfHjhAxKg]`Jx\\\\lVjThAcNvMjAsXsX~QiVwUeBmN{^nVkZoe@}QzKmRxRp@\"],[\"}m_`@tzzcBhTcLgCtTlKpGaPtZ}Bb{A\"],[\"gc``@|addB?er@xUlYfJlk@{Gxi@d_@ne@gP|IkE|`@vTti@cPtTsG}DcHzGkOpYye@sX_YctAlDeh@jW}Ax@eZ{HkIjTin@xKpr@\"],[\"qaa`@rc_dBzCkFrKnq@zBqGzOvOuBhYuOtG{PsP}HpUm[wb@?rcAsG{AyMuHcC_TyIgAvQ}WuQay@t`@`i@?yKvKzBvLu_AyC_O~HqG\"],[\"_ap`@x_f`B`E}k@zFkAf\\\\ro@cGnRoMfDwFoP}GrXuIi\\\\dAoH\"],[\"stq`@xah`B|\\\\y^`Tlz@_CvP_TsC?o\\\\g]lIpGlc@yS_QaHfDnGihA\"],[\"mrr`@ruj`B~CqQtHQDdTa[~^|X?sCda@fH~GsLbF}O}[yKhSgLgBtLmf@gLdMuIoa@_Jle@yDwMoRdCkDaSgTuMjDyH~H|@tPvMzOmV\"],[\"q}s`@lar`Bk^oZxDyRz\\\\xKtClPvKnBwMpj@rQzSlQ_V]dt@bO{G~mA|fBd[tNgHzPaW~@eLa_@a_Ac{@sCdr@cGca@o^vm@~LskAca@na@mCoIta@cy@{GqGgr@le@`O{dAiSjMmEc[cTtI}Zke@t[}LhQrb@~G_Jr^xF\"],[\"eby`@zhqbBwD`b@wPu_@hJkG\"],[\"kd}Jfkp}AxLc`@|ViSxO|@vh@|b@dnAlgCbRvcAmA`v@|a@rl@dh@tuAtW`Zz_@zBb]b^dYfIz]db@{YjQag@teA}H~d@mcAbYciCiSazCfz@sd@x`@qf@|Mm_@kLmk@gk@cHgYqr@{_@gNuVwZcFmoApl@mi@l@aUwJcF{]fKey@x\\\\mOvl@imAcA_d@pUqaArxA}|C~t@qq@brAa@oJyh@|J{Pnw@iM\"],[\"whqKbzp~A|OwN`c@~ChDdsA}KhL_h@kx@\"],[\"_wvKfmv~Abg@a[n]jPrRzpA{OpKiu@`EqZjk@cL}CaIog@jGa|@\"],[\"gguK`|e~AzYyLzWdBth@xf@nOtq@]lm@~Zv|@wBt`Ai]nZqNqDojAlLgHhVxLdSsS|t@wm@lg@eT|Ewu@mQiQ{i@j[_l@xr@sZgKo_A_P{\\\\Po]lJka@fj@ye@uAwKjRuN\"],[\"{n|Kbot~A`HaZnS|Jz\\\\zi@`Mrn@w[v}BpFl|B}LfRmTsCwUi[_YjDlWygDaR}MdUgJzFw^\"],[\"_``Lveg_Bn[gd@h\\\\pb@}L~EfPtz@kZhj@aTfHnHtKxEgEx@bYg[dL{MuUuIdQnIdD}JxLdSc@iNdRz]_[rHfBpN`fAeFbL{d@bKsVzXsXzEaWhX_^`@uY~]sEsaBggAuaAwC}ZnRkUn^qGnj@s]|MwTzPdGvZsCx\\\\eh@mKcIcLlG_B{\\\\l`@bGtLkQ\"],[\"eulLbfv`BiCyTbNePd[pP|OqCze@d}@vi@vW{FbNgc@fEge@i^uZ{k@\"],[\"ksoLhjf`BbZcCfc@`Wdx@PfBrNhMmBfe@hs@qSx~AfE~Gwc@de@oPpy@oXbPe\\\\Mw]w^mY_KoFe]uf@qcAnNo^wLwOzD{_@eHcQ~FcYzVy[\"],[\"gak^a|nlBrVcl@zB|i@aG~KhKv\\\\jArx@kJnD}JsFaf@|hBiv@~gAiQzu@mGvtAy_@t|@oL{[~Ti`AqFiPxJ{OfB_p@nMyGnFoc@b{@kpAdEgl@r`@_[m@{`@xK|G\"],[\"uhs^snxkBpGcr@x\\\\{e@?da@si@zgA\"],[\"ekv^u~}kB`Kkb@`RsG`SxQzDzb@c]nb@}XyI\"],[\"k~u^ajvlBl`@lTpWh`A{Ebn@oRxi@gMgDuTxKke@i}A\"],[\"}`y^sqlkBvT{\\\\vKhTjTpGzSzm@bG{DvK`MpJud@xLt@cBdJdLrOeLxe@lKhPkO~BjDbc@pJv\\\\ht@nYwg@`}@qVk`Ac[iZmK}sAocAk[aLuW\"],[\"g_~^cejjBlKgcAvJxe@}D|Q\"],[\"ml`_@qjaiBx^zo@zv@}QpEpr@yKwCgCbYkIQvChnAoIzdA}UoYcBi{@oa@m{@\"],[\"cqd_@migiB}FfGaH_RhTwi@\"],[\"azf_@kgyhBfKla@wSpc@DdXzOlPgCnG|GhCnG}GrChY_Xbj@`p@frAaB~UmO{AmHlJbCnVqG|MxK`JcG~^sx@w\\\\|JriB_AzGsXkTcFvCdFv`AsGtk@er@erBbD_k@mHm^|G{kA`KsItCe]_Kcc@Yo}@`P}b@pXzZpGcPsRmr@vPml@nMfG~K|[dKkv@gBmw@xOnM\"],[\"gc``@xbucBuBmOtImGzV`ZzAzmAqG~K{Bf_AwMyLr@qWyKsRlP_y@\"],[\"_se`@t`cbBxKuC?}StG`i@eYf^\"],[\"uhf`@~ofbBbLgVjT?{B|`AbGqXnChJyZh}A{Z|b@]eKsWtBo@qG|]}gC\"],[\"oip`@hkbbBwTceAbSiQ`^q@pa@|\\\\xFrf@sSbd@eYjSeNuC\"],[\"wbk`@b`pbBtMoMi@p`AyC`HqGoa@sGvWhL`m@cQrZqBvXc^~a@nQne@ha@GjIpJh_@hkBiOrgBdHfuEtNpt@pf@pdA`\\\\~At_@lk@nZjyCoFp|AeKzYk_@jg@iLoBkv@t`@aVmp@eRmvA}NaW}fBmxA{VkEqUuj@oDuo@tTot@a@qv@qG{GmFpG}Uki@iLopAic@qcA_NccB~Hum@{F{cAfGu[zBnKdLqBff@oz@z`AaSrk@mc@lH}^a@{j@wKmTnGca@\"],[\"ijt`@txuaBfCrCjTo{@f]na@}CrH`LpWaPve@gVsV}LzCwHvQje@dGwZdr@aHaDiGeb@\"],[\"afx`@jjz`BrcAxEyVng@sHz{@gMrSsP{ZzCsk@aO}j@\"],[\"s_u`@zu}`BzBwT?b{@|Gy\\\\pX~OmQbVrDj_@{Gf]eZzC~Qfv@le@n\\\\ic@}@uZrVwcA?gc@oj@sI_v@|YgVz[nZxEsMwN}XhCgP{\\\\qEuKiX~PyMzf@p^h^_XfHbTpG_j@\"],[\"owx`@l{v`BmUvdApN``@qKhTkOwFcHiQxAqrA|FyH\"],[\"umaa@zwz_BuRr|AtR`QxK}XrBvVqUpNgSgDkMcl@~BwU\"],[\"}spa@`cofBgCcc@pMm]f_@heAaAr[qX~d@uTvz@\"],[\"eo|a@~_|fBiTwtAzq@bg@lPbu@zLvCxF~O}X}GqBlcAoRxOwI_I}Gel@\"],[\"k|qc@x_adB^}LfHaBpNhZdCny@yLbf@hJdIbNry@Lhf@kLx[nChKfDuQhFrH|TvxAuIbEtAjfArKzKbFerA`Zy_@dMv}@_WlXkUfn@iPrvAb@flBksAr}FoGaPqCfJfHx^sL?pKlM]vU}Oli@kd@fg@xIpGDlWwEfH{\\\\hCkv@plAlByR}OsgAfLymHyX}NaPfCkJoXqMgy@vFgw@qZwJkPi}@x@gVjM{RpGpGwTyz@kKwkAjIhAvAmVwKqIcUdMxKg]fCrCxe@ca@pGpGhGqu@aCyh@yKqT`@un@x[ymArSRfLnSpT}WvTba@`KmLVuSxKnGpGwKla@ba@`Aq]fN{EhG_ZnPxKrLyQoDel@\"],[\"qvyc@xddhBrVmv@hCba@sXjkAbJbeC}VpkAsZ~d@yt@ljDsCkKgd@AyXmeA~o@mJl^iz@`u@{lDyAc_B\"],[\"kvne@frsfBlQqS_`Av_B\"],[\"eqte@pklfBuAf]wBjM_ElIfEaL\"],[\"scre@bb}fBqt@|~Ayj@h`Dp^}iC`Zy_A\"],[\"o{ue@njjgByB`|@~PqsD\"],[\"mgye@frlgBZ_KDpA\"],[\"mgye@frlgBsBhi@i@qG\"],[\"s}re@|u~eBpKwM|OjFxf@xr@pCjW_A~}@yLxu@nMhuDsXksBpQ|_C|Nte@rGqGxM~[rLnq@r^z^jQ}BbJyP`Efa@dW|i@wKzv@sOoDuLjHiOib@eLxDqEfVka@`h@kZl`CaUtj@dLvg@oG|~@mQgZkSOkMzI_Mfz@i`@pfA}ZtgC}G|G{BniAoUhd@tHhmCrYj}@xJvaAjLvAjYfyAab@dxDch@nt@up@xRcG}GwYv@yYkZwKlEyMcb@}Xq@o\\\\{PuRrKmF_GCsa@zJaVjIgEbTtMpOqHdb@ulCoGdr@tTda@vGkb@wGomBqG`j@xOohCzG`HnG{t@sCgeArCtClEq[o]eeBig@qnApHm^oTmmAjBgcBti@ixAnH{s@`s@mZx[t[y@wpAbRiaArBox@rC`v@|Dme@sAamAnHod@le@{\\\\dEmP}BynCbc@o`APef@_Lq`@mPf}A?mdCz\\\\}gE\"],[\"uf|Y`u_i@nG}m@nK^vQpX_A|JiGtTkRYaIjYqJkD\"],[\"om_Zht{h@n\\\\_TnG|\\\\lP_TtJ~c@eHlTk_@dD?iTqR~A_KgI\"],[\"itbZ~{sh@b{@ui@bTdg@h@pY_I~QtM~BvT{MbNpQmS`v@yH|D{EiJuOvPkd@iScHeTaJdDqSmd@\"],[\"w}`Ml`zaBcA]_AG_@WJwAfAHXb@Np@\"],[\"apkMd{ocBnDgBi@bDe@Vi@i@\"],[\"uhgN~dpeBqGvB_@y@|AuAlAIdAT\"],[\"ckvNv}xgBdLbCwA`E}F^\"],[\"se|NbwdiB~Ak@dAm@dAYtANaAnBcANqAW\"],[\"aviPnfykBlDq@x@tAt@zBPtA[`@}@_CoAiA}@e@\"],[\"wox[zq{iArIgTzNpBvH~MqQh[\"],[\"uez[rw~iAzm@mKzN|Nij@`]uXsIiKgc@\"],[\"yr~M~zbq@O{AhAbBv@fEy@I\"],[\"ud_Nv{bq@wChOg@mOfQqD\"],[\"kp`Nhiup@wAiCnAkCxCrAqAhC\"],[\"qz`Nverp@GcBpAc@nC|C~A`Eg@\\\\\"],[\"k`aN`xup@xQeCuG`K\"],[\"abaNnmvp@|SUeNhWwMgB\"],[\"qnaN|msp@tPiFuNlM\"],[\"s}dN~dgp@|A]nFdF`BtCtDpHG~AeCo@kB}BmAkD\"],[\"c`fN|uep@kBkCdASh@BlFhDbK~KfAhE\"],[\"kimNt}mp@|FyClK|OqNdF\"],[\"y{mNrwnp@hO`@{MtR\"],[\"ciwNxmtp@xEcElJbQiK|NvC~GaWPiD{E\"],[\"}kwNj{up@wQz]bKvDyM`B}FqY\"],[\"i{xNpfzp@fHaIrNzIuAvJqSrF\"],[\"itxNbrwp@vU~Aa^xF\"],[\"_fpPfqmq@nKxC_F|L\"],[\"oxpPfdnq@wM]x@kHfJyB\"],[\"_vvPppiv@qBeEbObmA\"],[\"unvPz}dv@|@cAjAKAfCuDvZuIz\\\\kB[tEuc@fDyM\"],[\"wfxPzytq@jBiDfAxPeK}C\"],[\"}~yPfrev@|Ep@iXeJ\"],[\"c`{Pzk{u@g@cHbGbO\"],[\"ki~Pxu|u@~F~KkT|YxAtS_SnPRwc@\"],[\"ik`Qrkju@]k@[aBlBpAxQxQlBpCz@lC\"],[\"qwaQf{hu@iC}D{@oBrAtAhC|D\"],[\"}vbQjygu@bEjGkm@mg@zLtH\"],[\"kifR`}qp@uLgI{C_S`GuPnrAlu@l@dJgO|K\"],[\"upiRjdpp@yPcNrj@cDhe@nS_AxG{g@vA\"],[\"_mrStgdgAlCwEhBnKfMwCbFhLoSkDxKfKiJU\"],[\"wdtUznim@eF{Eqy@zF_b@sRfK{LxQpN|TFjBmh@do@hn@xEhY\"],[\"upaXn|ck@_HsGjOq\\\\l\\\\cBoMzz@\"],[\"icbXzx_k@xK{_@r[~LuUpC}BzS\"],[\"wzdX`lfj@?kDw@}ChAaBt@qClD?@tDw@rFuBvD\"],[\"ejhXnegj@_EiFvGyErKl}@\"],[\"mwiXvidj@Lg[fe@vdAYt]\"],[\"_me[tnijAdb@k~@sOpz@\"],[\"}lf[lvjjAnKwUdMeAkFfP\"],[\"yqm`@dynpAp\\\\\\\\kJdNe\\\\[\"],[\"sfs`@fhaoAla@NaLpg@uMwE\"],[\"cty`@`tjpA_Dok@nOj@|DrUClX\"],[\"c``b@bk}{A~FeSdL~c@\"],[\"u~pb@~|arAuKxBgJ}UoHue@vMwI~O`S\"],[\"iqrb@`wyqAgNuAyDad@bCwt@~V_OlApnA\"],[\"yk~b@~lx{AlFyL|Nne@yPsF\"],[\"a{ac@|sq{AvGsZjF~@e@th@aI~C\"],[\"k`bc@thm{Ax@uRfJiBdDpRgKp]\"],[\"mpbc@z~t{AgCkY~IoPzGnFmBvx@\"],[\"qdhc@f{hxAbBsHhLzGxHrU\"],[\"wxuc@~vb|A`YcBTtLqd@fc@aF}S\"],[\"}vxc@fc}xAf@sItNvA~Z~xAmTh@\"],[\"ql_d@ddshBfLuBuMr[cKnCwLyW\"],[\"aicd@tqrxAsHc[yLqGxW}b@lVle@mAnR\"],[\"eldd@x|wxAdB}H|GbBhMfb@s@|YaKdCB|oAmQox@|GsFgGuz@bS|S\"],[\"mozd@`ehdBnGUkCve@y]qVfPpA\"],[\"yftf@d}peBvIqDvDrLaCd]\"],[\"epcg@l~afB|Pf@oGbY\"],[\"cscg@r{keBsAeWbWpv@sAlL\"],[\"a`dg@jyieByMqfAhOvw@lJrFGeKhSra@_B|TaSmF\"],[\"yyhg@d~ydBi@yMzqAh{F\"],[\"k~kg@dvndBaEg{@fbBj~J\"],[\"camg@h|jdBkAe\\\\`TlfA\"],[\"agng@dpfdBoBm^ng@vyBcQgX\"],[\"s`pg@le_dBDRl@lBzCbWtLfi@QpN|@vDrBrFzDnSp@vIaBhCuDqG\"],[\"s`pg@le_dBYmAiDyUq@gN\"],[\"}qpg@bj|cBzCjU~@`AtAxX\"],[\"}qpg@bj|cBgAcIAiA\"],[\"qvrg@``qcBnByItFnt@nLzTnh@zzG\"]]},\n" +
+	"{\"countryCode\":\"UY\",\"polygons\":[[\"`tlQv`db@bh@aUhJuRaBay@nQkZ}Om[hLyb@iJiOhJyNcOke@mhAy`@mZy_@qAsxCbd@eg@hl@gmAv^qE`Jeb@ha@__@lh@_}@r_@cWhaAyiBniBkuAt[}g@~b@_M`p@pO~qAdCjHwEuC_aBkh@{w@kf@yR_Cop@wo@{@}Fcc@duBqjCbv@cRpPuZxw@{]jr@caBgc@ec@~k@kd@ts@yiAtTkxC`b@iY`^ci@laAqRt}AmuB`u@}nAyRcc@pDgWrWsXnSi_Ate@y\\\\bT_~@bbAmUlx@gf@h~@eHty@sr@tfAazArl@ydCzj@ar@jHsDfS`I|rAljB~l@|Irp@~qAxb@`f@rk@xN`kCiM~}C~NrXqPw@ga@fa@k[_@cSty@vy@jmCnh@piAh_Bvt@~e@ti@|M~`@kHn{@p`C~kAf~Avb@hLnJbfAmPcPaPxKsX{GoPfw@xKi]d]hg@`NoCgEgHvH{SvLuDzNnOdwAfmEf{@flDlN~NdA`KyKgCgN|MiFzb@cQ`PbEz{Bgp@xo@{IbWm@|qAoTzqBfWhyB|{@xoBjFfh@vPxKqNfLkDhUsK}D}C|Ec@~HvP`O?ja@c_@fx@sOdJwRed@uGfHgQbcAfFpl@qYfv@w_@dmCmxAtqB{KbBwa@~v@sCxxBeGlJzGzk@mHpV`Nnf@}OvRS|Nn]thBaA`x@mM[sRz[cXs@_lApiAeGpS_]~OwZhqAwc@lM}~@|s@yf@zt@ekC|Uot@oGet@rCmp@ca@wc@dXqs@si@a~AbI_SuHaGuX^}sAqH{Uw\\\\y`@{sA_UuQjDmp@`m@oqAjCq|Bfd@sNl@krAi|@_ReBaoAfw@a_@iPis@mGgm@lGw^jZub@a^iW_`A}zBij@}ZnCip@fv@iR{Fc[gl@}s@{M_m@ie@_k@bCwdAib@e\\\\`Dqg@f]aJyCyLo{@oW}Ew`BrLikAli@}m@mDasBgkCkk@cQsg@vJ\"]]},\n" +
+	"{\"countryCode\":\"UZ\",\"polygons\":[[\"q`lW}`yj@bu@rPuExd@oOpR\"],[\"_|pWk|hj@fWpqEyLdXgkAbc@Fbr@oLfk@wTmGgb@lRcK{HwQsbAg_@y\\\\iGkZk`AwdBqKaNqYyEsS`pAsHvHuQiCzOqm@qJe@qgCbaDyGxZvCpVz`AfPx]ta@fg@bmBpa@`z@v_@~_Cfn@feA{IbZqnAluAmQbx@zMdf@|^pXlrA`BhQhdAxjCmfAr]rCyAp{@lQqAj^ol@vM?nFru@u]tnBbKrdBnLda@mAv_AfRfhAt`@gaAyUgwCbOc\\\\hQaEvO`EuBtz@|R|i@sX~VnJbGfPqM|WwAjy@_v@|LcApGpYo`Apc@_F`LrO`Adl@{Rxe@r[g]hb@pMfTaBpNd`CdO`n@ny@~JuEfKtq@Pt|@uz@nbK|d@jpAbE~p@hHjK|\\\\gAlG|f@~pBfXjGfXbe@gAr`@a|An@{q@`Syb@EuQdO{Elo@bFfWqMzHu_BqPyhAjKaXwGah@nI{Rjx@gf@jYqDnXzUhIhj@de@wD`U|V``B_JlYyRne@}Ep{@qt@xx@u{A~QcNt{@lF`gBvz@lX`nAl{A~k@hpCbiCt_A|RtjAqMnfA`XiXdTqAdj@{KtZhCpX`PhRc`@`UuDjM~e@|dApTpqAqC|Lch@r]{Mhg@sc@dOkZdx@dLhhDlKpXuN|`AtAbj@oHnNmJk@e`@_^sk@d`@k_A_OmaBD_eBknA}\\\\lLuSxj@kDvfAa[xqAwi@jn@qO|{@y^hw@g\\\\fgBfC|e@hYjm@vAzi@aJdi@scCfxFudBfmCkqAfuEyuArbC_v@ju@mDt`@zQjo@?ra@{uCxrGocCxoCqoHvgPkiAvvAeaAnRmp@{G{^dRwrBj_@{i@|cBgp@~b@sr@hTmn@pf@k|Ax`@ueAbA{mAj~@wlAtjCy^fiCdD~Lbb@pMfYdZxUbf@eFfp@qa@h_AnC`o@uKxFnTdSkVx\\\\_Gvj@vDz|BxR~oBoMvf@qm@rfAyp@p_C}ZzTcq@yFmRaLuWal@orAnaAyTtDcYg`@rQ{kAcHaPuWzMwQje@sj@dp@oQt`AZl_@{JxNmM_GaLuo@kv@dM_\\\\wDaNi`@sQnDoMdi@}q@|y@oBpmBjMjcDkWpg@qLn_A{eArTeh@po@_F`NdKneAiQvfAcwCp~DltAj`@dC|x@aYtqBhXlcAnNdPvRgN|f@icBloCgrB`Eby@m}@`GqZhd@oVvjAhHbk@uYh`@o@|f@hj@bbArn@aQzx@z]jT~d@nUoM~YdoCsHlsCjTxy@vc@fq@`iAtx@rb@ttAdQtPlhAuLh~@dFzv@g_@lx@uChk@ca@zOou@v[xq@bd@r^hD`KkTlfC{Sl|NmvfAr@auEaj\\\\uhC{_T~|U{mo@p\\\\mZb}@eMni@wc@vdL_bO|FgXyzAuaVhq@uxVufBujG{Bmi@x{DyxEziAmxCneG}lDueAi~DjvI`]tVtWdyFbBvDstH||CwTjyCyf@bwDot@tX_[bImr@kY_fQ~Ogu@eZa_A~s@ah@pl@qRqFmNpFkM_MuG_@kLlS]vAwF~HvRjKgLxPDtx@~Wte@`w@lM{n@dcAcbBzy@grCoTe`@fK{o@_EgLeG{B{C`KeVmOm]fJmDvTwOfHis@hEeU{L{YRhCmJuMyEwTyv@okAws@gM{i@k[sV{Dwf@mZsu@saAwMsc@rJeLmx@kWsTgO_a@eHyrBoZnLkOcGqPes@oz@a{A{Z{rDi_@gd@ke@}uAscAey@sQih@cj@_[gPwYkQeeArh@_g@nD_[_FoReiBqlA~AqbAoz@gjBaA{Rjy@s`CjVjHhVziAdp@hwArGfqAhdAtR`SdfBr~@~wA|l@~_@rrAvpDbObLdSkJnOenB~Pca@dd@{`@eh@ceCdOkUjy@_h@pr@`GlRyGsEic@`b@qt@~@adAvRo|@h^sb@al@oBkD}KnG}Tve@sTqZu]zZyi@eH_MohCu@t^s`AwOqe@kbAm_@_f@dY}]}RsAoZn`@iJti@~IuLcNbM}NoOoD_@gHjdAs~@bf@sL|s@w@M{`AbWob@{Wum@hDe^pnA_Pfe@xKaN}lAy]sYv^gf@fGeu@zV]xG{e@vn@em@`GeW|@qbCt[_@o_@erAdTk^p@cw@pKgBfRbRzu@zlCzN|aAlP|Mth@fFjEfQaGja@n[pGpLrNb@xWk_@duAg_@lh@hEpQtZkJj[bCb`@ef@tj@`SsU`qBoI_]}HjBlAdv@nPrh@do@`b@~S`u@fQ]jPoe@tJPwD~pBhj@zd@fU|i@gAhT{n@`GoT|Rxd@`UiBzk@uIpZaXhUwStn@uAdr@gOjQzd@~_@oEj{AvChc@\",\"oc`Xufaj@~@`IzKiFbd@gs@mc@vP\"],[\"gamWqdmj@}Hg\\\\|EuK~_@hj@bOm@xUqZxY|FhGlcAmPxJMzJpMlW_R|Gi_@wYeQzAkQdf@om@dQo]mMgGuRnp@{j@\"]]},\n" +
+	"{\"countryCode\":\"VA\",\"polygons\":[[\"glqXefrFCFGAIC?ST?\"]]},\n" +
+	"{\"countryCode\":\"VC\",\"polygons\":[[\"as}Ffejd@xe@f]qGvTqCoVyK`EyT{\\\\\"],[\"iw_Gptid@nGdJiFrNmYfe@kQ~Ckm@sDuA{Oqm@sReFqN|B}Ybh@qKzjAxG\"],[\"}fuFz_od@~HgHrBhQcLE\"],[\"suwFrsld@nTrDx@lN\"],[\"sizFzcjd@oGoIzNzF\"]]},\n" +
+	"{\"countryCode\":\"VE\",\"polygons\":[[\"wcfDbhsc@zgDk_CtYpGzI~JfPzbAn\\\\fZlV[jl@|QvGf}@x[t`@rJ~\\\\xvAxxAjEj}A|t@fe@hy@|GvD~Qpc@tW`Hh[jOxG|k@uQzIaXnVsCxGcN|`@_PvMFza@zXfd@oH~g@gq@kf@ml@bIq_A`_@al@lSeDjTnFv]`b@nh@~IiAhZlp@xnAzNxw@bk@|d@rCfLiT~k@rCpZ_Qvj@b\\\\aD`VlT|HnyBxr@vd@tk@rL`jAcl@x|@dLb]{GzQ}Rho@fCjAtWf^zS`Oxm@fjA|i@vXrc@dXuInvLynKzRgn@jkB_h@n\\\\gCt]|KtgA~`A|k@rwAlAjUhsAn_@bWlQ~ZpvBaOlj@aBlo@nFpC~d@uQ`NbX~AbmAvIhZvgAnNbVjXgApiBlz@toArEjg@oKtc@`C~Znj@biAay@pjDhDxTdU|KrGh`@vu@~MjArqBhGrL~a@@xg@`MlaBg]|q@vD~a@|UfZl_A{Dpe@aQv[urDx}Cal@x}@aE`m@f~@dZ|FbYmq@`eA{En]zIb}@cL``Abj@xjAa@xXeQhOqzAtZy_@bWsRty@eEvlArSlkCwCpJc~@~_@}UhWsMv[~@p[vRhLjY@rj@ut@zgBss@tiAudAzm@q{@~`@}cAzoAwjAvp@eBb{@x\\\\tgBiQrnAbCnmBahAdpCwbAldAbHjy@n[lWcEtYmfCsDonArIqfEzKyMfrAdFjhAnWjMz|AvNta@zmApfBtAllBbZ|o@~SrItaCxJve@xKxZ|UbqAjjBpq@b_@eA|UiMfBq`A{QoEvZ~u@zwAbv@b`@hs@dnAoMvbA|^hc@|Jdj@nVx^jMdBdGlfAd\\\\rM~lAvJvR~MlGrlAry@nl@xm@tGd_@hVnXdx@kYpYw\\\\h@go@y`@{j@eHgYxIma@rd@zDj|Abp@nzAjv@lr@jQt`A`\\\\na@_Slm@xIvn@oGde@uY`e@weGncHqh@bOae@{Ium@v]eo@P}o@jXut@dCqiDnaAo_@h[gc@kL{`@bAe_@nf@wdAtWoJFm_@mZgc@|Hcq@xsAmUnH{gAlpAc]jQ{H`n@ct@tJiVdSlCzf@kUzd@fHdl@lVjSwi@KmPmIo~EopFiMgb@ihA}t@cYaBgp@`}@wlBdi@sVvP_JnVzAzb@kRbVml@zFwy@|]ozAtOg|@~g@uz@tJmr@}Na]xKyQgC}GvKa]vHwXv[_TmKelA_Eu`@eMq{@{D}`@`GihBySub@|Skh@eGcdAzQiYuD_k@we@?yKui@g}@wf@eUqfA`SgoA_NwbBmvAiTef@ud@xBon@bf@u_@sAmRcViAhXkg@ds@o\\\\f}C|SpX~ArUhXjKnIt`@wBhhBf\\\\paBgJnyArNxsAhTr^g]zjB?bt@}SbhBttArrBin@ht@jTbeAmrOnkLeBbh@lXzx@[tRkj@zqAg@zc@qn@li@tF|d@oO~f@rRbwB|c@bh@pJf^W|lAlQ~\\\\sLdx@{XrIgBzjArJr`@{LjYxA~s@wL`OJb\\\\zMr@Wf`AbJq@~O|n@cQ~yAem@h`AwrAbh@wc@hEy]kEc`@jY}RfaCek@`g@}t@qHcp@lKsqBuR}d@fCoZjSc`@ee@i`@kMVsb@iMeI_Wf@m~Ard@wg@oF_T|Di]ff@wyC|gCg~FhbAeKpIqTsDkPhUpVjoAgNdJav@hCip@nQNjMvjAdoBlDnfBsQbGke@oh@}eDgzAka@ek@kqCkkAeO}Ccf@bKu|@yD{lBq_@a`D}KynA}k@mfAkv@}oBsk@s~@qaAceBgo@yXwaBnCkm@qdH_aDiV_VorA_eIqa@mx@NyQpYfIjJ}CzBbUlNfCj]poAtNpgBrf@llCvQjd@|p@~DrpAgRjeBor@bdAmu@bx@qxAvGc@bGba@wHoAwLrLoEl\\\\|KhN|b@c`@np@iBrn@uUxq@c|@~r@hT~a@_Brf@xNhg@[zr@bmAzoCnxA|Srk@`mBrm@t_Alq@tk@kTl}@ax@|eAq_@eEfJ{c@lMzK?nGvKnK_RvV{IhKjCLbI{MrRxe@mKsXwc@lK}ImK?~d@qm@~Adb@vLdGlPiDsXqG?gc@be@ml@oKoOzUaC`BrKr`@cD`b@xn@uA{k@hL|AlEiHb@tJlG[`OyIjTbPx`@i`@|Oef@~Ccv@i_@msB_f@opAyn@sTeb@ea@yVyv@cV}B{vBhTiTaNs_BaRmi@r^kNnAcB{IeIGseAhw@aEvZajB`e@qi@`{@_lAtc@sc@f\\\\u}@}@gf@`n@uSqAwQkVmc@~Tea@sCsi@na@cAul@fIag@eMsEc`@hJo\\\\qXY~TwWj`@sOo`CabCchGi_@arBnD{a@wGmgAkUcT`P`PmN~@mDcNtCy_@mj@ezAlBqb@c^al@ePsG}NNiDnJmCeEhXma@kPwKfIqY_h@zI}Hfh@mDwMkVxg@~`@wtA~Eo]qIoQdCqK`o@yq@rEaR}E_KqZ{\\\\cMeA}uBfl@pJrDqJhN`Uzo@`@~LiIcB`k@zuC_CxNwJhJoN~@qT}Kge@|KpGaPwWj@yG|HpOlIsz@lZO|I}{As\\\\g^{Xoa@sCwBme@uf@yp@{Ii\\\\pWux@fyAiw@fcCuO`jBme@px@g\\\\fl@}n@~AyTq[kv@dHclAgYmaAzZ{cBtVm\\\\Je{Bfp@_cAjOu|@xZiXx`@w{@nYqTjPeh@gL}G`l@cHt}B}cA|FpDwOz\\\\xK|X~HefAhJ}MxObPnDvd@hKhBhjB_[jjAmo@~]aq@O{kAvLyg@mG{d@jPoHuLsIuLgk@eS{fCiP{rFoa@oaBmLgeHlLw`CsXic@ji@ejB|Cec@fPpQtBl[nUHb`ByhBfYgk@oGz\\\\nG|GbPgLsBgO|e@iz@lBys@ge@`pAsPfLplAasDbv@y_GtTyv@cLtz@vNwOxEqg@c^ijAhCysBq[_]ex@gF|GqXnGxKvFcQ{DgEoEzEsXgVoG{c@nGtC{ByKnFoCoF}KpBmf@iPsPiNuf@cIlBtFbPmDh[cKhD~DmTyKi]aP??rLoHeLt\\\\}Mo_@kJk]koA}VyL?_e@pQmn@yF_cA|Gsp@g]kaC{@tm@m_@ly@yEx}@eLzY|Efp@kKqGjM~`@_Axr@pa@ng@mBnHc}@j]_`@{OrOuQ|P_{@wT}z@|GuIhC}gAgLui@ke@uT~\\\\oa@xUg~BaWmu@b@ie@_TyItO{\\\\}FeD~Acq@y`@aw@lPcN}G_lApLwt@cf@ajCbKgi@db@mmHaPk_@Gkh@}Ku`@nGm\\\\mK{v@lJlRrYbEmEzEzVng@`L~mA{Ent@dEzo@z~@lr@_VpkErUbbDfo@tu@in@}gAm@eVjK{Hr`AiEpHzTyKxRpAz{@nMnDoGoPpXkKjTpXrFqJfUzNkEgl@k|@|NkLcHjTef@kT{o@dEeVt~@}v@`q@?pr@g]j]vQ`G~a@wIpg@vZf[}V|P|EtYkTrClK~SuOlPvGlOoCtPnTcU{JgQ|p@sIBoHsb@xEsJuIpRcAdDwHgFqYrOoa@rS~@aMyMa_@vC_C}KjPw\\\\yFe`@eRe[_Y{Fmf@|KqPmC?wWjl@ct@drB}i@tTrHnDmSpWqR||@i`@rp@oByd@c]qn@ePgqAzGnU_Utx@aZdwAw@lYhFnNpP{EcTgo@gPqlAzBsr@tT`Fin@jYmR`Bee@yMpe@qYb\\\\_Frt@mHrH_`@eX|e@}iBlfBexBhZeLv{@bUucAg]`@yIjd@_Vj_A?_|@uFyh@bSqGjT{_@fReAyVl]ozB{FuJ{GtAyu@`y@m@mTt{@scBzg@k`@sn@hU}Od[fL}f@jsBu}Bfa@aRuBwGyKdIpR{o@oDao@rY{x@~^Lw@kXjToc@rk@qg@nk@oFvJnFgLbG|YdFvh@vzAv_A|gA`e@rOcwAq~AprAt\\\\`_@|k@dVfLlb@fJb\\\\qBxjApi@fnAjQhKzMDlZeLpk@lGblBgTnr@xGl`@dRkC|Qi`@lPke@gCoVna@}g@sC}m@f]aPrX{\\\\c{@k_AuCo_BpPnG|BrVx^zCtFuGc`@iByF{[_bA_[}P}oDv]oh@lM_{Ag@yIav@ec@aG{RfFykB\"],[\"idgDhvfd@rCyKfOeA~S~TwFhMbVsDlA~mCsb@wYf@s\\\\~Ts`@{NbFyYqW\"],[\"yioDdzdd@H_[bPeV|B`PxMiHjQzGxb@zi@le@vaBec@ym@\"],[\"uslDjahd@eE~E{o@aPsL{h@{m@uk@yKgp@x_@vMbJbi@do@ps@`VlI\"],[\"{`qDv|cd@n\\\\`v@oz@iNkQes@jRsB\"],[\"wxrDdzbd@gNod@lVkQdL`j@|]nOpBjL\"],[\"wbrEjp`f@|Rsl@dMsD|JbHb@lOoEhLeJMqGf]wMoB\"],[\"c}tEf~xf@lAcKl[aAbKxWoGeCtCdp@_Htk@aKxMyX}TeFg\\\\\"],[\"snxEf_~e@~cB}c@eC|RtO?wGpMvr@na@yB`p@|S|r@mQw@kAnWqVtKiWdd@pPh]qPbrBkz@wVcQa}AdJqRtWoCdTik@|Doe@kNuRqX?ye@sXgCsXw`@ca@\"],[\"mceFd}sg@?yKnLv`@oLdf@x@o`@kZkUxIqG\"],[\"_}eFrsjg@|AiQrW|Ee@dQmU~^\"],[\"}kgFnzlf@bYo^lXxBqGtt@cL?\"],[\"_`eFvuvg@wAwLjUj}@gBvO\"],[\"uyfFjkvg@f@oL_AhaA\"],[\"qaiFfmhh@`BwKaEhV\"],[\"uziFf{hh@MTm@o@\"],[\"k|iFnyhh@k@Gj@OPD\"],[\"kjjFfyhh@b@q@nAZe@P\"],[\"yrjFz{hh@d@Ga@bA\"],[\"_tjFp_ih@cA\\\\\\\\a@\"],[\"_qqH|}ye@{AhAm@oAl@cBvAMj@^\"],[\"{qfD|_nd@xDin@db@mFzPoY|QbAOpQe`@b[sApz@__@naA\"],[\"shiDrlad@eZaj@``@|EvMgLr@_a@l\\\\xBjA~uB_t@{\\\\\"],[\"klkDrmed@}Fqi@qQoL@gs@yLq[~NfBno@g_@t\\\\hs@`j@fVxTxWnApyC~Vnn@a`Aw@ub@mXaYoh@\"],[\"kw`E|k`e@zTeHzXzDqPvM{XK\"],[\"}lbE`yae@`GsF~KbJgm@`P\"],[\"eicEns~d@nVmBzQdf@mXgF\"],[\"_niEv|fe@lL{HxTnEwe@p^ic@ho@gf@aC\"],[\"mnrEjhdf@|H}F~FxJqCx[_L}I\"]]},\n" +
+	"{\"countryCode\":\"VG\",\"polygons\":[[\"mnfJ|kmf@~LjN}@~`@iGuRi_@qXy@}q@fHcZSzR~MtT}BxQ\"],[\"q}gJzlhf@rVdf@e]wRyKhA?{v@\"],[\"uulJhqgf@c@nZsJpP]ev@pF_UzS_H\"],[\"wpeJzblf@pM\\\\aL`S\"],[\"klgJbgof@tHHwAhZ\"],[\"yehJ|kkf@\\\\_AvEKjBDzDn@zA`Bw@`@cCQ}Ej@{Cc@\"]]},\n" +
+	"{\"countryCode\":\"VI\",\"polygons\":[[\"wuyIr|kf@nTldAnTlkCey@uJ|EsVkP}h@z\\\\cr@}Nwc@\"],[\"kgeJbunf@xWz@sNx{@i[}`@`Vcu@\"],[\"sudJv_rf@jEnC_Un_@L`z@gHbIeLia@jD{d@wK~M`\\\\kz@bMiH\"]]},\n" +
+	"{\"countryCode\":\"VN\",\"polygons\":[[\"ke~Gg_s`Ag}@lQqr@ai@yj@d{@{i@dIoSuxAcWjDeWaUce@eF}@uOcj@iEwm@lo@ki@jGoDpp@ke@~B{@tOoNbJdG`m@{x@``@gb@ho@yk@jGmb@`o@ut@mVlA{]gZkj@oK_k@yw@gj@wc@tDzAjtA_h@rd@o\\\\n{@qw@`TiPha@mFn{@kWrEgSd]uWjL`AvK{v@aDgOxIQzMlcAhq@iMxk@gh@lQsn@R{Oji@m[xRimEbIxNhP{Rjt@e_@dN_L{CybAhv@s^qAac@dOwAjNtZzVyFvUutAxfB}lCr{Bm_@n{@szAlp@kTfWcTzEon@kF__@d\\\\iZ~Fui@`k@q`@xDaLlb@x^xZ{Z`k@ub@p@~@jb@yb@pj@_d@`Ayd@`l@mPvE_nA_TiJaHLgTmIsCcs@nb@`@`]wUnm@s@`k@{TtKsCpOx@pk@cL`x@}PlNyCrW_g@hw@uk@nc@mHxs@og@fc@cb@pz@bAx]{[xFoUn^q\\\\jdA`B|f@qVte@iRjJwBtZ}w@g`AuLmt@oc@{LwFmQcn@nJyXz`@eq@fI~Pyu@gUw\\\\qJ}x@xL_]bQmJqPc\\\\m@{Vpr@qmAoH{l@rE}TihAqe@kh@soAwb@gFyJde@uXwNm\\\\go@eP}H_Gin@wk@lBsDwKqc@dd@mX}@q[nv@hNfoAcI`Tyh@EkLoR_XxCul@z`Az@lxA{Pjl@ya@_g@oWgLdMw^{Mg\\\\gdAkl@wa@xkAme@xWsCtf@ijAzcAIbc@uV~OqRn~@n@fUt^x[xe@xkCf^zPd`@h@dLr\\\\h^mCnHvVwn@n|@gKhv@of@r]rO|x@_Yz}@x@f^kXbr@qjB~}@yPhf@ml@fOmNxUyd@VoNpV}@p]q^em@}r@kDqe@kTlNti@u|AwlAcdBlOjSz}@_hA|KgHjKzH|Ifq@p@zc@pT~JjRlA`k@i]vJmkAfCol@hTcb@peAsVhFgOiIoX|d@ia@nSeoA~pBad@z_@sMa@kj@jVoPs[fHm`@_t@qTajAwlAiGsCsXxPuMe_@{k@cUnKul@z]oKuKy^hNcOdLcq@f[_d@~N}p@pkAgk@j\\\\_nAmEaIw[Pa_@c|@cXnFcQiFqe@o_AoiAmg@lFu\\\\zV{Zr]s@`k@_Z`Vab@cd@q\\\\md@jAai@yYkRqb@ryBkuBxz@_lAqKeO_wB_Wgl@mf@oSm_BrXvFxX_Dxa@s_Awc@_cAww@i`AdQyTaIkL~A{w@wo@{l@mP{]}cB`OgTsf@mZoDgCmN~HeX{o@wmAoTaz@`Gi[sn@{ZgUuh@ne@{R~Pm[j@wUdp@_]jW_a@zz@qMxHuuA|Vgg@d~@_}@yMyLqAgt@{YwVFarAnP}j@rk@mN`LuPPud@_U}wAwUsQxQuy@nY}PcHub@tj@if@Fcd@tNa@jP`[`fBnh@rEdTwXdWdH`RbaBj^fy@iHhUox@xt@eQfSnMn|@iSflA|JdCmLy[{`@xS}_@hCas@|^wa@wJw^fD{V|TiFt\\\\fTjT{HfHif@lq@saAdEyWeG{d@hiAen@}_@so@zKkR|ToAhHeX_P{R|@aq@}Jeo@oSaa@\\\\uSjIaa@lWmMrfAysA|Yle@{HpGaS{FoUxJwCjPxKlGkInBdYxO_WvDzBpGbPwC{BxKsKlAMhQjGdHtFwUpUj@vCjNvWhG~Fn^_GdQtSnBeBvPpXqCfCbLfCuCgCzK~X~IsEzUoZxRb\\\\mH|@vEuQpdApG|B`PiT|Ghn@jTfCx\\\\|BhCiTl{@bLpBdGba@oKrLhXlGrkAdGmKpOpVlHgAfCz\\\\mP|r@er@}r@tCvV`PbPoPdAhNtIiNjEhTfC{N^mDzJhTqGyK|Xz\\\\kPhLjN_Av`@iW~e@PfRfr@yv@n@zXqQbP`PqGfPpKia@rTlB`PyN|SsJa]o]`Mw@dKzGgCiIba@`ExK~GuC_Hhn@~GqX?~Ol\\\\yKrKgp@ta@rEyKtO|KbJdTyZzZ}AxDzF_GvJjb@sV~AkM~i@aPkUfWxLh^eC~`@|ViXvR~g@_Ut^d\\\\gXvLn@n`@jaAjD_SgHiQ|LrFb\\\\sFbIxYnNnJcJ}YhCiSnh@fEhG|EsClGpIgJfs@kH~ExEa[|ZNhWpIo^bd@eLnK`uB`HqClrBjvBuT}Bna@`P`P`j@ui@?ti@vMkTlc@`m@nj@d^W`PbLda@hCqGnGhT|\\\\gCme@fGlBl[pPBtPhp@tXba@hC_MZ`CtO|HQeLoGvToGd}AzBsCzG`P`Pbr@gW`Hu@qGbLhXsIpHjP`QlDpA`QblA|BbP|XgD`P`NhN|p@`Rhn@mLna@cr@xKpGyKjPnPoGz@sRhV}RdlAXoDaKsu@nBhF}Jz~AoYzr@i`@rVqUh@kT`j@kPbnA_rAzFi@rAvJiCgl@le@s`Ark@_n@fJ_DcAtQ~IbJ?gTrX_CsXmG?wTmPcP`BwFr^lC`lAu{@ji@pj@C|JdIkH|J`Dn|@_PnVoPkBz]sMdGeOde@gCxv@rX_yAlFx[vKdA~HeQwKpGcEaMzQiu@vcCkpAhsAyoAnyCmxExPsHxXtB~|@sr@f_@xe@iCg]aJLwH{ObbEsqFhDcRaApWcf@nkAdBzQlv@}vB|GxOgCea@~QzGbN{GiMgTtvAayAwCzZjm@yH|Tss@gTsXuXvCjTir@cPkRtcAe_@_OpYhOg@`K_}Ati@xe@bQIr\\\\ea@aJgRz_@aBzShUgKu_@|FwDwg@rIs\\\\iBqA~LuTyK`P_l@{KwGzKsIvTjTuCf]vUnC`n@yKhXwOnb@mr@~I_D`FbLaFuT|m@{Gtc@oRbgCceBpHgPmAeV|TxHuGbFhExOfRHpIwMg_@{Zh[gClc@ej@le@?{KyG?kTuPlSaTeOeL~G?cPda@eFxVkZf^kAnUug@jMtJtApQdLcLuC|Gpa@tF\\\\~T~EsQjLkBuOuFjgBkS~vAkf@j@pKbH{OtaA_m@|\\\\sDkJtJd{@kF~N~ObGyBmPsCveA__@r|@wEfn@y`@na@sEtHlEtu@a]k@vJkSdMuAjMtHd@z_@_`@rk@aP|dAqGtZsXdhAbAhAjTaj@cAoh@pL~s@fR_BeF~SwO`TnSlLZb}@gMt`@eb@~EtI`PsC}gAhn@bIfD~jA}n@qGcPzYpE~GoUtc@dSeNjT}QaPmPvVpAzIpx@`GdX{\\\\mFaKlFaDxJ`@x@xJzSsCcGbLbGzGxe@_Uii@}D|G_Hl`BtEd]cLbjBktAje@fCfC`PqP}JkBjFfXtTnGtTf]sC`t@yZxTi_@zIdLti@?xBzEk`@~TuT{JuTzKnG`PvT?eLhTe_@{\\\\qt@jRh}Af_Bb{@?fCoGkTyKqGfCrBoInf@jFtZge@n\\\\ApTu\\\\zIlAoCfK|TtNmbAdeArAzPfLqGiCxKrX_PcAcKtj@cJdPmP|GpBiBbJ|SnGoGnPlPmTnH~BjSoKoClOvGzGBqK|a@_MrPnI|c@sEf`@{PvQoV|b@`LdWuPsCjj@wtA|BafA`Y|IlGjRwJnPfBr\\\\mTbQz@ha@nO~NzZhSbF~SsBvByRqZ_YjXnFxYuEp]qWlfBxaAoG`j@ea@vMpBnMpRqKrc@??lPzi@{HtaArB|MpI~St`@}K~eAlYhf@zaAt[aFrl@pGhp@bOx[hSjRfi@nP~Qt_Abj@zM|DnQtRpHaRfHiDtO~S`~Aj^nXjhBbi@qLhp@|Ptz@jYva@|p@htBzi@fj@vSdr@hHpz@|m@ze@uObr@iQwOyYrXeAjT|G|BqMlSrDzYaP?hTlPaP|BxKhTc[wX_r@N_RxKhC{GyNd@}YvWbHvAlQyRzVpDfGcMtXpGrJuHlEzCoHhLkn@|Bfc@rCb[aPpa@z\\\\wT?q\\\\bOo_@iBe_@le@tLb`@c^vY~BzVvHvE~`@iUxq@lWvJzZmJrSbPaGna@pm@g]|GxTg@hKuHeAgJmVgX|X_HIgK{T{M@ePxm@k\\\\rbAu@gAhwByP|lBrUec@tq@kuCpY}n@j\\\\|B`b@z`@fBrSuTtC~^lPvKsHtBlKxRpH_Cbc@{o@jkAadBbcAsXli@h\\\\{b@xc@}JbjAgy@|uAsqCr`@fP_NyCqIrG`c@lN|@|^sr@nr@aj@jcA{}Ch{CqBvRhW{Z|m@eUp`DooDbdAye@pFeVpw@Ivf@zRtVvc@Wl}@yr@dsAekB~rBm}AjqA{_@dfAnaDa~Cla@cKx}Aa`Ank@qGj_@|X~J{NpQV~tCdxKloAppAvtBdy@h_@db@fKvm@raApr@|i@hxCeTxnA}GdDc@mWa[kn@_RvH_DiGpGgp@kTe_@jKva@cGz`@kIHiJsn@we@rCvVp`Ac\\\\hYmiAeVgyBLkyJo[ueAsUs@go@yp@at@q@{Rfp@sOwz@zBoZbKsz@vs@sGrWjDff@yoAtoAtAjg@ro@hb@?tVwgA`N{TjVpGxGgv@~V_KiOoQBpXpa@Y~H}Wl@_D{Yw}@il@~Fk`C_Ikq@c}@co@_s@qsAeiBpa@mf@qh@jSgKfRk`B|Zkg@slA{x@xLsf@um@kmD|z@yi@dg@iO`PoRmEwQgc@cMzn@cu@zTutAdJ}L}aBta@qZ_EkCib@go@fP{Sf\\\\bRxOmjAb|@_Ypv@y]fGwLlY__BkPs_AzJaKd^gL`GmVaH}Uc`@tJsn@wnAyq@l[caCb]q^iGcq@nPuk@oHwD_t@fVo~@sXiaA~ZeBa]fMwRiLgRtFyrAmH~CjBkk@}q@w\\\\mGgM|@abAoJim@myBm{B}Pcd@uMubAvn@ol@YiMa[aT_[mg@qoAoYqrAgDwwBfQkk@h]_r@hL{UkHoS|KsxEszAmoAt@ixBfw@yw@lk@{t@zCwW{F_n@xIsHpLq@~`@ip@dEaWnNgiAeWs_AfC}p@}T}EaLxDaUyNoLox@kXee@Xkn@wP\"],[\"a|zDuly_ApTyq@tYvClDbXuNcBg^r_@`[oQaCvQ}}@bk@iv@jS\"],[\"{pgEqmc`AzEsRlLz@~DvPyNhTqZlnB\"],[\"kkkEoel`AjWoWuCbLfYdNzF~Qpa@d]ki@zC_O}j@\"],[\"q{jEerg`AhTsXjTxv@ca@pGqa@cL|GpCuMjDmRa]zG{Kzq@hO\"],[\"wulEaag`AhCzJiVpUa[}IqM|IeAkT`V}Xba@tC}StO\"],[\"iumFgivaAxKyKnG|XeFxVkQ~M\"],[\"kuiDcgb`Al@rMbWkIrAl`@jWMBjNmOvJcNcF}d@su@\"],[\"_e`Ei`_`Af`@w[nDfDscAxeA\"],[\"{piEgzo~@xkAeA`~An_@tOcJhe@?aMlXa~Bn`@eSjKga@zp@o^bF`B{u@kn@_^oDiL\"],[\"whkEmkf`A{Bda@vT_XtJL|CvJ}c@jj@}~@tJeEsOqNqCgg@dTx@cSt]wf@tQwBhNpGrZyR|KxRtC{M\"],[\"gguKelj`AbGqGuOlP|SsCgC~Xna@_YgCpGvTnGsL??nP{BeL{GrCgChTbLqCeGnKwl@~m@oJ{@oF{QmP}G|K{BxG}XoIsCZuMfOkCdEsZdLyK\"],[\"}xvKave`Anc@}XzNaBbCbG{CfOup@lPvT?kIp]cl@eHvHoW\"],[\"}kwKkbs`Aye@kPh`@~Cz{@zd@vC|Ka_@gJ_J~EwAyT\"],[\"spyKukw`AjTy\\\\}BjK|Tj@fKhSc[yK{KfL\"],[\"spyK}fs`AaHaOddAd\\\\tO|gA{]qp@}l@_RfCmH_PaG\"],[\"ol{Ki~t`AnAqEpoAr_@y@rKm_AwT\"],[\"oh}K{dt`AkB_O|d@|c@aAhM`g@l`@f]fr@qFzHutAk[rCsI{HiHck@eB~Zmi@~R}D\"],[\"ie`Lmvx`AnFgApX`aA\"],[\"yw`L}xy`AqG_pAhUx`@eD|GlPlv@sLkC~GgCmJcJk@t[\"],[\"}amEcxnaA~GgIrTc@iFbR{QL\"],[\"ecuF{qwaAnZgCgb@bv@qGeDBk]\"],[\"ewuK_ap`AtPsEiGtQyRvD\"],[\"}}vK{hp`AxK{CrD|LqN~T\"],[\"ssyKa~x`AeDcRb[bW\"]]},\n" +
+	"{\"countryCode\":\"VU\",\"polygons\":[[\"~jhKeetfBd_@sc@l\\\\`PpG|XsZpx@ug@}I\"],[\"`v{JoflfBrTaj@da@~X|m@|MeL`p@w`@d_@mAlPav@jd@}^nAkXgNib@yAcPgy@lGeIn_@pD~m@qG\"],[\"fypJkjjfB~NnDhW|_@qd@zbAkCxf@wa@dgAym@iHmWlK_l@aCkXaKyRqj@fJsm@jw@iKvB}k@lVeNrI|m@vVaPrb@a}@\"],[\"zoxIo~zeBgCkTtIa@`s@tVpUtf@oGtIjIbp@wQnPjGvEuOxBnGjTwT}BbPti@sT{b@_L{AlBlHcOvCqHxTfDbWtT`PyKtTwe@mPa|@wu@eOgb@jMqGiL_u@nF}Q\"],[\"fkvIkgteBgRq@yPkRyF}UtIkK\"],[\"rwsIwpveBqS{Hjg@}SuDtV\"],[\"~ilIm{weBjJbHpAp`@e]_h@jB}U\"],[\"v|iIqd{eBqBrOcZW_JyZlJsJ\"],[\"tkgIa_teBaUjYo\\\\pB{LzM{l@uEwJgJoZ`BpCs[xcAyb@eDw^`t@cl@bAik@rQuWpBfGjY_Cke@zvArUv}@\"],[\"f`bI_uveB_YjT?w`@rRmF\"],[\"xy`IelteBmV_EwE}Vng@vIhE`T\"],[\"jh~H_yseBxGnYuWdw@rApPii@d~@cO~CoX_r@yF{m@u^_b@qWuH}DcRz`@iUfy@sMna@sw@pMiDrV|X\"],[\"du_IyqleBzVsRhQtZ`d@jW_\\\\vq@qB|b@zRr`@nGuLzF`EtP`d@yBhJ`GhDaKfU{\\\\pV{Ln@jKiT}Hr@er@hZeW\\\\cGxKqlB`Ewo@v_@ba@xv@yKz\\\\kh@rV{UQsa@yQs}@gCqMqX|XucA~TXdm@md@xT~IjFqM~Lv@z\\\\gl@aSM`SyMlPrCnGmPoGkK~XyKlK{\\\\j[a^yAyWrLdC~JeJjTaZwA_O~k@{Lf_@~KzEqH\"],[\"nsrHqv`eBy\\\\zgAu`@jGqPa_@nKsaAl}@A\"],[\"znnHkx_eBgAaUnEzGr^kR|EdH_@n_@oJxS\"],[\"ranHc{seBjIgJhy@tA`rDik@ne@tHjIr^iLnIu_Eli@u{B{Ta@uL\"],[\"|rkHeaoeBxRfFfe@xv@Ih`AqTfe@cpAgqAqPmu@iEidA\"],[\"fgcH_c_eBuTvO`Pce@\"],[\"vqcHgcreBaVtBiPsKtZeR|cC{Q|SyK~tBdG?|\\\\msCR\"],[\"vlnH{m_eBxb@bg@yIpu@vEtq@nLhNbEaE{@jQdZb]cRpe@yJyF_\\\\jEoQr]k{A|w@c}@yQ_i@{@yn@`U{p@|G{QmEyKlPaoBbd@kz@_B_x@_Q{KkYzDeFxyAun@vWae@dEbExmEmn@yAsaAi_@cZq~AYgQ_ZfC_n@vJtO|`@KlMtHpGqM~~@iNsIcNlAiRt|@gGjMjIza@y^bUbCaDtK|SiCv{@wXrTmTzNrGsBpe@\"],[\"zdsGywfeBh]av@he@~Dh]rRbLlUgH`mA{cA}B}SgX\"],[\"bznG_qeeB_TvAod@j`@u\\\\rAwVmLqR{n@bw@ul@xKjK|G_CzM{XfRj_AdVeF`JnG`@bL\"],[\"j_iGcmjeBf]mExNto@sb@yT\"],[\"`{fGycceB|I_JuE_NhOj@`Dd`@k\\\\zJaL{VxDiIdGA\"],[\"toaGoltdBcLwUfHiS~IXxKrQ\"],[\"n|_GoltdBfT|KqJpNmm@{AeRpGrGqX\"],[\"jxeGmivdBuWzG}CqHzHiH\"]]},\n" +
+	"{\"countryCode\":\"WF\",\"polygons\":[[\"feuG|ivkBjn@wz@}GtcAqb@dQwHyC\"],[\"p~`GdpojBna@uQjTr@rFdKzNuB~G`J{EpMm]nI}FoI_XV\"]]},\n" +
+	"{\"countryCode\":\"WS\",\"polygons\":[[\"hnhGrhbhBzu@{Ndg@lU~d@vCq@hTuSvZfU~kCsYl_@{e@vLc|@~jA_LhCiA`Ngc@pScEk^dHok@wXspAcLksBbR}f@\"],[\"jmoGtzugB{A_[jNi^|UyRr[z@_HvnBvGrrAwNfLmMtf@g@jv@ka@r[_Xjv@gLt@cWgKmPip@}EupAxn@yzCpRkP\"]]},\n" +
+	"{\"countryCode\":\"YE\",\"polygons\":[[\"g_rJsev^zc`@ioMfRaq@reKmsDhMxi@mD`V`l@rrA~bA`fEtqB|tCh_Apk@p\\\\{@`pA`j@ng@kRvXzEnRq]`pAz@`d@uM~XhIx[hpBda@vrAzMhW|WlBuIrWvg@j|AnObJ~U}GtMdzAx[bb@rXpaA_DxNlU`m@pxApzJdEdbAzV`z@do@h~@zH|a@jm@vkAaCvStRh`A_SnFdFxu@l~@nbFxf@nhAzM`v@f~@zpB~RdDaI~ZdGtZ`iAxr@tn@zBpm@|gA`s@nTrOja@bl@`d@jNpYo@zpArb@~z@oLnJqBbeApGuC|SbaBeNaBgVf`@uOdlAtOpt@`j@lg@nMl[xg@plBlgCtyChj@roCt_@h~Crb@xu@v[dlAsE~z@lP|s@Kj`CeM`p@lQ~kA`P|wDlVn|Ab{AfjBdV|Fru@`p@zb@|aCz^`n@bsAbd@tg@Bj@nXaM`WkHa@_C{`@wIqCsVbP`PrIs@l`@pTjPoAf[|SC[u]nM`DlJrRoYb{@|Qzb@yVjDsM~NaIzr@|Lji@lnApnAkHhDhh@zeCmKf`@hCo_@wPWxApVtZ~w@pGngAea@d_@w_AzvDdh@zm@`C~b@}v@gGwl@~Co{@zk@}f@jHqa@zZ}n@rS{l@ld@ok@bMsg@wLanBuB{c@sXitA|Gcl@jVir@lEoo@tk@gpAjh@g|FrbAeNnPoIeCfEaJzo@sVea@tCwXeH}z@zXkQyFi[xVeq@dKgz@rc@{RDhm@}OvQ_Uqi@eCcj@jHun@vb@qo@k@eTlJql@rpAcBfY`QnKmB`T}_@fPy@il@cNyDoVpGgc@kTba@bG`_@ym@fWuAtFeIksAoFoo@pQej@x@kp@la@{L{GgUtNyh@iBsCpL}^c^of@wEqc@ei@iXgH_qBiBwiDr^}\\\\}bAyd@kDgIag@iX{g@etAk]~Cmz@kl@dFsUaXuMy@oV`h@en@vSg}@qM{n@|IelAsVkYwx@}XfCiS~n@erAiuAce@scA`Cqw@da@qgAnaAmdAYq`B|XqCnEeLcAwMcf@wg@fN}q@ke@wf@zGaf@qPkwBzGuaBaPys@bAicIzEmb@z`AeaC|u@slPa_@eqFzKeVfyDmjCzDkvBgyAmeDmW_RwnE{pAokLaeJglGeaPgScy@\"],[\"yvkFe_o_@wBcW|HsM\"],[\"ixlFeek_@hEsQ`ReAvBrQoTpk@{GuJ\"],[\"ohmFwg|^xF{QsD{j@`OpJdM{I_Dt{AsMzHtCxZkN|IWh^aVb]wB}`@|Tgr@\"],[\"cpvFcfoY~KoK`LfGkTp[\"],[\"w~sFe{f`@gHk[bDcNjRxr@pT|F|InY`Tv`BhT|O`]|o@a@by@lMpWoFzV|XftCkTlbBar@ph@mnAd{B]ab@}HoOgVsGqc@nCiHim@mXsQm@{J~D}sAdt@qbAdL_s@m]uQ^qqAsa@qdApUi]oEgPdMqMfBwX\"],[\"clkGojaYiVsf@fEuF~k@nXhT~g@\"],[\"muqGedbYgCqCf]kTl_ApKMfPoPmG}Kx[kZnQ\"],[\"mtlHov_Yt`@~`@mCc\\\\rw@xGnWp[}NdMs|@gGi`@{Y{EwL\"]]},\n" +
+	"{\"countryCode\":\"YT\",\"polygons\":[[\"bhxF{_sZoKwKle@?\"],[\"xnwFmyqZzUcN~b@|UtTqNxx@`[hNkJvKxKgC|i@cNt@_AbNkRmK}NhP}EmLhToX_JiFqg@x_@{ByKul@xI{NpVmUjC__@e]nG_ChCfGn\\\\ea@\"]]},\n" +
+	"{\"countryCode\":\"ZA\",\"polygons\":[[\"hmbNkgeKdf@}\\\\tg@ap@xa@}nAv{@woAnsA}p@rRnEdRq\\\\lSkAvKiR|PtBtj@yRrLs[`QqDe@mHjRjBrBe^nSpUlPd@rUa[pO_DdQ|SvLqSzY|Cp\\\\s`@lh@eLxP`FgDiQxm@}VzmBcEnd@mVpyA`v@p}AvpAbh@vI~`@?v\\\\}Ovw@tM|h@sBruAqn@it@ucBaC{a@bWur@tOqqAsYa}DxMal@lIsfBsc@c|@oUoH{p@nDqEmFmPogCmSuc@o|@iv@}fA__@wb@y^mQcz@abAobAu@{y@o`B}iAaRbA{Ucc@ef@sCcRfJig@mb@}]rNg}@qj@q_@K_KcMuWbKmt@cRiR{Yid@qS}w@{cA|@{MtMwIwc@gyA_A_^zPigAoEuo@fYiVjpA{uBvH}a@jVmObIaW~`AihAaEof@pG}KlLz@_QcnBtmAc`BxCm^iIei@dq@eaCt@meBuLup@ee@q`AtKyeBkKmaCeS}k@ix@qoAeoBsn@gpA_SovDivAopA}XcuAdDcH_|@eQw\\\\on@wdDtAomAke@ug@meAqe@ad@ux@ol@kj@ce@mzAytI_oAcq@c\\\\~O{M_EiSi_@jDfCqGi\\\\{VtBqMg[xDhFsQeL{GtCmTaKzGiF{EgXey@sd@_a@jR_FwSy]rMeHcPePiNc{@gXyKgLxBqr@}ZxEmWiTa_@rPaPQmPsb@iUcWlHk@a]mJbCy\\\\ofAoP_Iwg@jDw_@m}@mYkIcSfCsKib@_PqG}IoVu`@}Aa\\\\yU_I_a@k_@__@_Q}m@kBqr@nHmf@sLiVCag@uj@qwB{YsQjAeh@yl@sa@{j@xC}t@ap@eEipAuTsp@nGcpAiTip@nGym@oO_KeK_dAjFkJe@g}@nUic@d^_hBtRoSzWs{@u@cu@r`@yeAqT}~AxK}xAmPme@yK_yAba@a{CyKsg@l[ae@mAiYpWySfJbEpnMw_DjzDlD|pAufAtgDqeAnl@oz@vpC}J`aDehAlpQi@|aA|LfaBkQ~\\\\zX`oAj]tyAcZ`Oxb@a@xb@yxCtmGjLrx@j~AluBxuBfr@bcAli@vtBhfBxm@pLxwCw@nx@mIkAgTqSkZ|c@_Mpd@y_@`cA\\\\ryBwtBnfAioFjBcsG_e@`Lw_BhBwuD_]|@}r@lTwj@bHsaC{GaqI~iBlPlaAbOzZhUh{EnnA``Fbt@`YgCtzCz\\\\lsApw@ld@~KhLtWfCyKtT_DdaApUbdDt_EoKdPjBrWhLhMbPyLoPw`@`e@fc@jc@hlAo@piA`PoGnaDzvDrr@le@fXve@fRlE`zBzgBlnCbqAxWjEfWqEC~WlTbHoPye@lLbBj_BxgBtjHtnDtZn\\\\viDfiBvg@~k@vc@vRt`C~wBpd@dS`kBd{Bt[hkAhoAhkAfc@ry@|SpfAfc@hKfcA~fA||@lf@fOfh@js@~^zpCflCnvBf}Crs@zi@jgAxrBnRnOlp@jpB~\\\\|Zz^nI|~@n_Bj^pY~i@veBj_AvkAfMdk@nYf\\\\gAtOp\\\\lRdbBduDt[jYbb@rpBvX`b@~Dvi@x_@hwAbP|Kp_@x`BoDzmAxEpf@{Obe@}LdrA{BvtAlSdiBf_@naAnd@b[zp@|Jd_@ye@jTcPzGhCgDtRvLji@cLreAlBtm@gk@dgCa@dx@jNr{AjOvWrXyGpKzEpd@`n@`Px@pJmMbP|OcLhXiIpzAlDjr@}J`u@g[|o@oJdwAsW~z@qGxnBke@|xElS|`CvJlXdU`OlKeCrMiXaP~vE_YrGoGx^`[kXpG`CcAb{@m\\\\|sAqR~DgAwLuItVmApZz\\\\ea@jBnJgWlqBjf@doAJroB|NfaAlc@jc@~U}Uj[l|BjMxNr]i@dXhQh[baAxDxt@aYbfBhn@xoCge@tyC{@ryAnVrbAxJy^bLqF|O|OsOnmB`SnpB`}@rbAnk@~pAhT`LtIhn@|Zhm@rRrPtSmG|IlYiAx\\\\}g@lcAnAdcBvK`[ujB`~C~Hr~@mp@of@}VAax@rj@|Drr@gJhv@qZhTmAcOkc@aQd]d_@cAzv@bMxmAvLtTkCzK{m@pCw\\\\kQij@vIuTiU_e@hc@yHdxCtSzn@x^xVvi@kTdt@hCrRgL|AtJm[zf@g_@bPwRaBaZlQeJvVe_AiYrCnPuSvH_`Ayb@sLiSeAgk@}a@sDqbCts@eh@zp@cv@`Gmd@f`@uf@nbA{UoAehAzm@m_@ds@e]~UmE{^v]}Ond@ih@eAwPue@lUcTt^mi@dAeKzGmFz_@nAtQdL{GnKhYsExEapArWwWqJuZvVcR}`@yh@kD}Loe@pf@uj@`H_ZeD{VkmAm_BkWqMcaB}XmbA`M{YiQgX}Ag|D|l@og@Skg@vXupAhWouC`tB{dAjgA}k@tLoXf`@q_Axl@utDvqBwt@`OseAnt@sViAm}CpjBg_A`Myp@z_@g~AhX}nBlq@kaBjQwtAh]mpBdm@uc@r]wu@~X_dA~Jel@lp@ajAho@}^`i@myAnV_EnP_s@ng@m\\\\uIe\\\\}cAoh@in@X_KzSeFoG{U}VaQgd@wDqI{N_f@dU}VEQ}a@_Y|GeIy[_WtJ{B{\\\\sp@uCeNsO~GmPu[qoAvAkLl`@aWfH{ZhcA}EpMeLyA{qAfiA}`@p]DtMfD~Mh^hPrIpv@o]nF{UvL{EhdArIhBiVkQwwAzDmHhh@uDfDoLtCe[kMil@lSkgBdo@wpAdZokAuL{wAxJqv@_N{k@jGwOwMac@oN{vBvOoaCfd@w_@zDg`@xMoLe@aWcWm|@{OwNoD}Zsw@bZe^aLiMaNbJs]eSqgA{OkPcq@_Qwh@ub@aa@o_Ds]mk@kBct@\",\"bssP_`uPkmAnbAyTdt@_d@jZSvZax@pSoOdShUzk@nKfoBjc@`\\\\zLpW{AxwA`mBjwAl@pUaP`_@d_@zVpEtOgCdfApIsCvKtTd[fDtZhl@~QcOfClT`NaBfVbMtr@dx@xZsCbRfn@fXtC~RtWjSaFhs@bi@hCjThRlGjMrWlVjsAjUjY{GxMlPjKmFnKl^rKzi@uo@bwCeiAfa@}h@l|@i\\\\|E{i@zj@pVh|@aMQut@z[{NdOs_@pbAaj@vcAsyA|G_o@yEsVdV_p@fJ{wA}k@w@}SoW{_@wKeZzHuj@_y@}TuKiMt@uPhVsJi@_Xsy@m|@}v@v@el@aTws@jJ}|@iYm`BaiB{xEii@~EoO~Oen@_D_s@u\\\\i`@ihAcSYcEgIqWfLe\\\\wCqa@_hA}u@wUmbB~o@mUlX_c@bBaLr_Ayn@nr@{Ozj@oYpH\"],[\"xrq[_r~UxHebBp[um@z^~RpNxb@uIv~Bg[tO\"],[\"rzm[enbVwLkAaHeU[g[zIcSbQZ`ErV\"]]},\n" +
+	"{\"countryCode\":\"ZM\",\"polygons\":[[\"|vvDq~aS|k@iCJiTjLyQd}@xI~OsFeFo]hVcM~CyLcm@eJrGeo@v_@iWbZ`EbQqF|n@mk@fPya@bi@yLdfAva@|^tBxeAgmBx`@_`@rdAFr}Acw@dLuYte@oDbsAdzA~b@`PhWzh@oCh]vInh@bWjRrDd\\\\~InDjKkCjEmXxjAsMjpAou@veBzx@|MKhIzNl`@dD}K|NzGzDz{@eAb^fLrQss@rnBwIlm@jIr_@qHtkBbc@xh@j@fb@q\\\\nqA}]pF}TuLam@vIai@tK]l`@re@lZzGxp@|}@Opz@n\\\\pPpXp`@uUxYdLt]kLvMhQpSfaBhs@xl@uBfm@sh@pv@`SddAvHnt@eSl`@td@`|Ar[~VbJxPna@hb@vBf[lw@oBl[xPvGzRkFvJgz@dg@eZjW~a@zOoBbZmdAjd@c^r[uFlJaXje@bBzZyPuAoJig@_[uIk]wW}Ppv@_f@`{Hdd]ffAtyCbsA|rJrhAjmErq@ngB`H|_A~lAaFbmAiVv\\\\oNbOk\\\\|]wQnt@iCrXeLhq@|Ndj@{YvLnr@uMtl@dNx\\\\mQdo@`MriAsRjkBh]xeBwCnt@|\\\\dp@rEl~@r|@|hCzk@p}@pe@lZzItcAn{@~m@tOsGdgA|MxPuI|m@bPdr@wKrr@tTle@je@bOtPtE|v@`uA~aFtjAzfBnqArxDhtCxlBzeB|f@z|FreGvl@`DxW~Stn@xy@zBxe@`Pn\\\\sCxMf]dsArUn`@oKnk@}[rc@}f@~cB{Alw@cNlp@cP`Ppa@|gAvK?hX|gA?v`@kWjk@cRvBoBaIuItGoz@dhBv^`fBeLbpBik@dy@kHh_@gj@r_@jF|Hoa@hTkTba@aP}@fC`WuUhXrDvKoQpWeWtdC|@da@vVpc@{e@|pBzIfi@wD~}@~cBfkOaXKca@xe@cEppAoc@lGw[bp@ouAz|@q[nkAap@jdAkfAda@mb@vc@iP|o@g\\\\xJgJv[y}Alx@iKbWee@tZyIxYtDd`@ki@xt@e[lGkDvQ{t@rP_p@aOgHb^sVjAqm@dX?}SobAxf@gk|@^?}mf@qi@~Pgs@|n@qd@jQqmCkf@imBy~@kaAtGkr@xb@_[hBcgD}Fwc@oM_tBfU}eAma@}f@Cy_@k_@yrAp^_xAqAsIvN{pAoG{cArUfEqq@`Vch@plAgEoDub@zHieAd^}n@vKaFpZpK|_@yTpe@vAjp@t_@lM~`@zNkNfAqd@j`@un@yJ{hAe[{PqGeYuZiWwH_WgDkfAs]sgAuLuuCm_@}mArEsF|o@o@vMbXbOsLdWxPhNS|n@qVve@dI~aAyZvC}X|g@gz@pXlCtKmLoM{g@gOeH^s[vk@a`AeCemAfcAopAK}rBdRkf@`Dwi@{LuXoCen@r]qm@lNo_B~Ro`@yAuKuZgYwAwfAoa@}l@eWgK{jAsG}^gXav@~E{GmGgOu`Brj@ePrr@o@lu@cNpeAqsBvp@i\\\\fnBa`@ffAqhAtEaiAwZum@p@yNpXgo@hg@oN`EsoAh_@gZwOaa@tTi_@nAct@bq@s}@|zA_w@fOLhYnVx{@{g@j_@cA|@wXy]gVdEe\\\\hXaXfdBykArXdB~DoJtT}DvNa^`{AeRzXeI`[gZeFaoAx_@we@_s@slAk`@iwAkh@_p@mD_\\\\pUea@[wPdLiFtPlEpIdSrV\\\\jErMf\\\\yHvUmt@Neg@kT_RyxWa@tEfg@~^j_AmPna@ba@~~@vx@hPj[eTnFyY|UY|MrNkZpsAyAdd@cWd^Z~q@dO|e@eKxE`Btg@_kBnw@eg@ft@ih@vViXne@{YnDaDtg@uUxe@q\\\\nRfGtMaYzPNlSag@zJmPvUwlBj\\\\ku@b^qk@Yu|@sr@}c@bAsf@mVel@oGcn@pEseB_k@gp@oCyhA_j@eKuY{`@aTkThT}ZgC_[jVuq@_Fug@vNq{@{@ob@v`@ahAsc@ikBJkbAkXoQwQwn@~h@io@|Dqm@zVyKkTqAhZ}`@`FqW|Yai@hAaZta@oOnx@{U~HaVkKuuAwlCodB}fBchBynAut@kVuv@cBibA|[ssDikc@jz@kOz|@g^ndB_qAb^im@dI{t@kUku@~Yy`@_Vwx@h[mSR}Xxj@udA|`A_H|_AqiAdCeVePso@fXc|AhXvJz]@pWwKhEqYeO}^fA}NdKoWcBqVpj@y{@fE_wBbNuUjw@mc@fP_yBf_@mWl@_S\"]]},\n" +
+	"{\"countryCode\":\"ZW\",\"polygons\":[[\"hpzIkjlNl\\\\in@dLcpBw^afBnz@ehBtIuGnB`IbRwBjWkk@?w`@iX}gAwK?qa@}gAbPaPbNmp@zAmw@|f@_dB|[sc@nKok@sUo`@g]esArCyMaPo\\\\{Bye@ogAynAwl@aD{|FseG{eB}f@itCylBoqAsxDujA{fBauA_bFuE}v@{k@qn@i|@e]er@vK}m@cPyPtIegA}MuOrGo{@_n@{IucAqe@mZ{k@q}@s|@}hCsEm~@}\\\\ep@vCot@i]yeBrRkkBaMsiAlQeo@eNy\\\\tMul@gIui@fcF}B}@g{GrDyZvb@}u@ci@ulArOezAjJaUjjAicAjBee@~Pm`@jLuvBp~@cbAfRs`@|a@qGbMmNhMslFn{AimFju@yOvLkSiGwVpIqlAgR}m@|fBt_@nu@ru@`pCimAtpA}Jx\\\\g]vRhBtjAxp@d`@mHnQia@zVeJfb@vKdiAbBfXb`@dXvDvg@oHlSlNnbCa]rl@xKxz@{u@xd@zb@nT_JrKbC|i@pmAjVS~iAs^xq@jKhGxIdA~{@xYb{@jf@kBbM{J~TtT~h@eJ^_gAld@aYnT_C`gB~Sjp@ld@v}@jBnHwe@pLgCjkBzF|H{_@qXkk@|DcJzn@[n]_k@fxAfRr`AiBc@n`@db@~OhIvXxiBdStvAdhAnhA`g@jOxNbCjrA~VtRjs@xF|zCuLrzBn}ArRoX~LnDrmAcn@sMdWhwRd`Rpz@pyAqWxSlAhYm[`e@xKrg@ca@`{CxK~xAlPle@yK|xApT|~As`@xeAt@bu@{Wr{@uRnSe^~gB}X~o@a@rhAnIhw@nO~JoGxm@`Sn}@ch@`s@y_@lTcA`qAe]di@iq@tOmYqAaj@yWqRxJiXbnB}cAfxCqEpe@xMzv@ob@dxA_OrsDgOpYmVjKmAzIiShGyWmEaoAvg@oeA~w@if@zs@qf@rYy~B{DwcA_TsiA|Ook@qKsb@xAkU~aAEj{CfMdl@eyAwHihAfJgwAbg@aNh`Awc@dk@wDl~@aa@veA{Jf|@oZ`XkPzo@uk@|[w\\\\jhA{b@d\\\\iPrl@_WiLiUvQuIbcAkMjWmvDtjAwvArt@}~@_Kyp@|OabAjmA_n@jUybA~Ieg@vx@qg@r\\\\w~@~eAqaDrjA_k@|w@ei@zXyjAfU\"]]}\n" +
+	"]"
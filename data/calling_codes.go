@@ -0,0 +1,265 @@
+package data
+
+// EXAMPLE DATA
+/*
+  {
+    "countryCode":"AS",
+    "callingCode":"1",
+    "main":false,
+    "prefixes":["684"]
+  }
+*/
+
+// CallingCodeJSONData is the raw JSON for the international calling code (ITU-T E.164) of every country
+// "main" marks the default country of a calling code shared by several countries (e.g., US for +1, RU for +7)
+// and "prefixes" lists the leading national digits (e.g., NANP area codes) that identify a sharing country.
+// Sources: ITU-T E.164 assigned country codes, Google libphonenumber metadata (v9.0, leading digits)
+// Countries without telephone service (BV, HM, UM) are omitted.
+const CallingCodeJSONData = `[
+{"countryCode":"AD","callingCode":"376"},
+{"countryCode":"AE","callingCode":"971"},
+{"countryCode":"AF","callingCode":"93"},
+{"countryCode":"AG","callingCode":"1","main":false,"prefixes":["268"]},
+{"countryCode":"AI","callingCode":"1","main":false,"prefixes":["264"]},
+{"countryCode":"AL","callingCode":"355"},
+{"countryCode":"AM","callingCode":"374"},
+{"countryCode":"AO","callingCode":"244"},
+{"countryCode":"AQ","callingCode":"672","main":false},
+{"countryCode":"AR","callingCode":"54"},
+{"countryCode":"AS","callingCode":"1","main":false,"prefixes":["684"]},
+{"countryCode":"AT","callingCode":"43"},
+{"countryCode":"AU","callingCode":"61","main":true},
+{"countryCode":"AW","callingCode":"297"},
+{"countryCode":"AX","callingCode":"358","main":false,"prefixes":["18"]},
+{"countryCode":"AZ","callingCode":"994"},
+{"countryCode":"BA","callingCode":"387"},
+{"countryCode":"BB","callingCode":"1","main":false,"prefixes":["246"]},
+{"countryCode":"BD","callingCode":"880"},
+{"countryCode":"BE","callingCode":"32"},
+{"countryCode":"BF","callingCode":"226"},
+{"countryCode":"BG","callingCode":"359"},
+{"countryCode":"BH","callingCode":"973"},
+{"countryCode":"BI","callingCode":"257"},
+{"countryCode":"BJ","callingCode":"229"},
+{"countryCode":"BL","callingCode":"590","main":false},
+{"countryCode":"BM","callingCode":"1","main":false,"prefixes":["441"]},
+{"countryCode":"BN","callingCode":"673"},
+{"countryCode":"BO","callingCode":"591"},
+{"countryCode":"BQ","callingCode":"599","main":false,"prefixes":["3","4","7"]},
+{"countryCode":"BR","callingCode":"55"},
+{"countryCode":"BS","callingCode":"1","main":false,"prefixes":["242"]},
+{"countryCode":"BT","callingCode":"975"},
+{"countryCode":"BW","callingCode":"267"},
+{"countryCode":"BY","callingCode":"375"},
+{"countryCode":"BZ","callingCode":"501"},
+{"countryCode":"CA","callingCode":"1","main":false,"prefixes":["204","226","236","249","250","257","263","289","306","343","354","365","367","368","382","403","416","418","428","431","437","438","450","468","474","506","514","519","548","579","581","584","587","604","613","639","647","672","683","705","709","742","753","778","780","782","807","819","825","867","873","879","902","905","942"]},
+{"countryCode":"CC","callingCode":"61","main":false,"prefixes":["89162"]},
+{"countryCode":"CD","callingCode":"243"},
+{"countryCode":"CF","callingCode":"236"},
+{"countryCode":"CG","callingCode":"242"},
+{"countryCode":"CH","callingCode":"41"},
+{"countryCode":"CI","callingCode":"225"},
+{"countryCode":"CK","callingCode":"682"},
+{"countryCode":"CL","callingCode":"56"},
+{"countryCode":"CM","callingCode":"237"},
+{"countryCode":"CN","callingCode":"86"},
+{"countryCode":"CO","callingCode":"57"},
+{"countryCode":"CR","callingCode":"506"},
+{"countryCode":"CU","callingCode":"53"},
+{"countryCode":"CV","callingCode":"238"},
+{"countryCode":"CW","callingCode":"599","main":true},
+{"countryCode":"CX","callingCode":"61","main":false,"prefixes":["89164"]},
+{"countryCode":"CY","callingCode":"357"},
+{"countryCode":"CZ","callingCode":"420"},
+{"countryCode":"DE","callingCode":"49"},
+{"countryCode":"DJ","callingCode":"253"},
+{"countryCode":"DK","callingCode":"45"},
+{"countryCode":"DM","callingCode":"1","main":false,"prefixes":["767"]},
+{"countryCode":"DO","callingCode":"1","main":false,"prefixes":["809","829","849"]},
+{"countryCode":"DZ","callingCode":"213"},
+{"countryCode":"EC","callingCode":"593"},
+{"countryCode":"EE","callingCode":"372"},
+{"countryCode":"EG","callingCode":"20"},
+{"countryCode":"EH","callingCode":"212","main":false,"prefixes":["5288","5289"]},
+{"countryCode":"ER","callingCode":"291"},
+{"countryCode":"ES","callingCode":"34"},
+{"countryCode":"ET","callingCode":"251"},
+{"countryCode":"FI","callingCode":"358","main":true},
+{"countryCode":"FJ","callingCode":"679"},
+{"countryCode":"FK","callingCode":"500","main":true},
+{"countryCode":"FM","callingCode":"691"},
+{"countryCode":"FO","callingCode":"298"},
+{"countryCode":"FR","callingCode":"33"},
+{"countryCode":"GA","callingCode":"241"},
+{"countryCode":"GB","callingCode":"44","main":true},
+{"countryCode":"GD","callingCode":"1","main":false,"prefixes":["473"]},
+{"countryCode":"GE","callingCode":"995"},
+{"countryCode":"GF","callingCode":"594"},
+{"countryCode":"GG","callingCode":"44","main":false,"prefixes":["1481","7781","7839","7911"]},
+{"countryCode":"GH","callingCode":"233"},
+{"countryCode":"GI","callingCode":"350"},
+{"countryCode":"GL","callingCode":"299"},
+{"countryCode":"GM","callingCode":"220"},
+{"countryCode":"GN","callingCode":"224"},
+{"countryCode":"GP","callingCode":"590","main":true},
+{"countryCode":"GQ","callingCode":"240"},
+{"countryCode":"GR","callingCode":"30"},
+{"countryCode":"GS","callingCode":"500","main":false},
+{"countryCode":"GT","callingCode":"502"},
+{"countryCode":"GU","callingCode":"1","main":false,"prefixes":["671"]},
+{"countryCode":"GW","callingCode":"245"},
+{"countryCode":"GY","callingCode":"592"},
+{"countryCode":"HK","callingCode":"852"},
+{"countryCode":"HN","callingCode":"504"},
+{"countryCode":"HR","callingCode":"385"},
+{"countryCode":"HT","callingCode":"509"},
+{"countryCode":"HU","callingCode":"36"},
+{"countryCode":"ID","callingCode":"62"},
+{"countryCode":"IE","callingCode":"353"},
+{"countryCode":"IL","callingCode":"972"},
+{"countryCode":"IM","callingCode":"44","main":false,"prefixes":["1624","74576","7524","7624"]},
+{"countryCode":"IN","callingCode":"91"},
+{"countryCode":"IO","callingCode":"246"},
+{"countryCode":"IQ","callingCode":"964"},
+{"countryCode":"IR","callingCode":"98"},
+{"countryCode":"IS","callingCode":"354"},
+{"countryCode":"IT","callingCode":"39","main":true},
+{"countryCode":"JE","callingCode":"44","main":false,"prefixes":["1534","7509","7700","7797","7829","7937"]},
+{"countryCode":"JM","callingCode":"1","main":false,"prefixes":["658","876"]},
+{"countryCode":"JO","callingCode":"962"},
+{"countryCode":"JP","callingCode":"81"},
+{"countryCode":"KE","callingCode":"254"},
+{"countryCode":"KG","callingCode":"996"},
+{"countryCode":"KH","callingCode":"855"},
+{"countryCode":"KI","callingCode":"686"},
+{"countryCode":"KM","callingCode":"269"},
+{"countryCode":"KN","callingCode":"1","main":false,"prefixes":["869"]},
+{"countryCode":"KP","callingCode":"850"},
+{"countryCode":"KR","callingCode":"82"},
+{"countryCode":"KW","callingCode":"965"},
+{"countryCode":"KY","callingCode":"1","main":false,"prefixes":["345"]},
+{"countryCode":"KZ","callingCode":"7","main":false,"prefixes":["7"]},
+{"countryCode":"LA","callingCode":"856"},
+{"countryCode":"LB","callingCode":"961"},
+{"countryCode":"LC","callingCode":"1","main":false,"prefixes":["758"]},
+{"countryCode":"LI","callingCode":"423"},
+{"countryCode":"LK","callingCode":"94"},
+{"countryCode":"LR","callingCode":"231"},
+{"countryCode":"LS","callingCode":"266"},
+{"countryCode":"LT","callingCode":"370"},
+{"countryCode":"LU","callingCode":"352"},
+{"countryCode":"LV","callingCode":"371"},
+{"countryCode":"LY","callingCode":"218"},
+{"countryCode":"MA","callingCode":"212","main":true},
+{"countryCode":"MC","callingCode":"377"},
+{"countryCode":"MD","callingCode":"373"},
+{"countryCode":"ME","callingCode":"382"},
+{"countryCode":"MF","callingCode":"590","main":false},
+{"countryCode":"MG","callingCode":"261"},
+{"countryCode":"MH","callingCode":"692"},
+{"countryCode":"MK","callingCode":"389"},
+{"countryCode":"ML","callingCode":"223"},
+{"countryCode":"MM","callingCode":"95"},
+{"countryCode":"MN","callingCode":"976"},
+{"countryCode":"MO","callingCode":"853"},
+{"countryCode":"MP","callingCode":"1","main":false,"prefixes":["670"]},
+{"countryCode":"MQ","callingCode":"596"},
+{"countryCode":"MR","callingCode":"222"},
+{"countryCode":"MS","callingCode":"1","main":false,"prefixes":["664"]},
+{"countryCode":"MT","callingCode":"356"},
+{"countryCode":"MU","callingCode":"230"},
+{"countryCode":"MV","callingCode":"960"},
+{"countryCode":"MW","callingCode":"265"},
+{"countryCode":"MX","callingCode":"52"},
+{"countryCode":"MY","callingCode":"60"},
+{"countryCode":"MZ","callingCode":"258"},
+{"countryCode":"NA","callingCode":"264"},
+{"countryCode":"NC","callingCode":"687"},
+{"countryCode":"NE","callingCode":"227"},
+{"countryCode":"NF","callingCode":"672","main":true},
+{"countryCode":"NG","callingCode":"234"},
+{"countryCode":"NI","callingCode":"505"},
+{"countryCode":"NL","callingCode":"31"},
+{"countryCode":"NO","callingCode":"47","main":true},
+{"countryCode":"NP","callingCode":"977"},
+{"countryCode":"NR","callingCode":"674"},
+{"countryCode":"NU","callingCode":"683"},
+{"countryCode":"NZ","callingCode":"64","main":true},
+{"countryCode":"OM","callingCode":"968"},
+{"countryCode":"PA","callingCode":"507"},
+{"countryCode":"PE","callingCode":"51"},
+{"countryCode":"PF","callingCode":"689"},
+{"countryCode":"PG","callingCode":"675"},
+{"countryCode":"PH","callingCode":"63"},
+{"countryCode":"PK","callingCode":"92"},
+{"countryCode":"PL","callingCode":"48"},
+{"countryCode":"PM","callingCode":"508"},
+{"countryCode":"PN","callingCode":"64","main":false},
+{"countryCode":"PR","callingCode":"1","main":false,"prefixes":["787","939"]},
+{"countryCode":"PS","callingCode":"970"},
+{"countryCode":"PT","callingCode":"351"},
+{"countryCode":"PW","callingCode":"680"},
+{"countryCode":"PY","callingCode":"595"},
+{"countryCode":"QA","callingCode":"974"},
+{"countryCode":"RE","callingCode":"262","main":true},
+{"countryCode":"RO","callingCode":"40"},
+{"countryCode":"RS","callingCode":"381"},
+{"countryCode":"RU","callingCode":"7","main":true},
+{"countryCode":"RW","callingCode":"250"},
+{"countryCode":"SA","callingCode":"966"},
+{"countryCode":"SB","callingCode":"677"},
+{"countryCode":"SC","callingCode":"248"},
+{"countryCode":"SD","callingCode":"249"},
+{"countryCode":"SE","callingCode":"46"},
+{"countryCode":"SG","callingCode":"65"},
+{"countryCode":"SH","callingCode":"290"},
+{"countryCode":"SI","callingCode":"386"},
+{"countryCode":"SJ","callingCode":"47","main":false,"prefixes":["79"]},
+{"countryCode":"SK","callingCode":"421"},
+{"countryCode":"SL","callingCode":"232"},
+{"countryCode":"SM","callingCode":"378"},
+{"countryCode":"SN","callingCode":"221"},
+{"countryCode":"SO","callingCode":"252"},
+{"countryCode":"SR","callingCode":"597"},
+{"countryCode":"SS","callingCode":"211"},
+{"countryCode":"ST","callingCode":"239"},
+{"countryCode":"SV","callingCode":"503"},
+{"countryCode":"SX","callingCode":"1","main":false,"prefixes":["721"]},
+{"countryCode":"SY","callingCode":"963"},
+{"countryCode":"SZ","callingCode":"268"},
+{"countryCode":"TC","callingCode":"1","main":false,"prefixes":["649"]},
+{"countryCode":"TD","callingCode":"235"},
+{"countryCode":"TF","callingCode":"262","main":false},
+{"countryCode":"TG","callingCode":"228"},
+{"countryCode":"TH","callingCode":"66"},
+{"countryCode":"TJ","callingCode":"992"},
+{"countryCode":"TK","callingCode":"690"},
+{"countryCode":"TL","callingCode":"670"},
+{"countryCode":"TM","callingCode":"993"},
+{"countryCode":"TN","callingCode":"216"},
+{"countryCode":"TO","callingCode":"676"},
+{"countryCode":"TR","callingCode":"90"},
+{"countryCode":"TT","callingCode":"1","main":false,"prefixes":["868"]},
+{"countryCode":"TV","callingCode":"688"},
+{"countryCode":"TW","callingCode":"886"},
+{"countryCode":"TZ","callingCode":"255"},
+{"countryCode":"UA","callingCode":"380"},
+{"countryCode":"UG","callingCode":"256"},
+{"countryCode":"US","callingCode":"1","main":true},
+{"countryCode":"UY","callingCode":"598"},
+{"countryCode":"UZ","callingCode":"998"},
+{"countryCode":"VA","callingCode":"39","main":false,"prefixes":["06698"]},
+{"countryCode":"VC","callingCode":"1","main":false,"prefixes":["784"]},
+{"countryCode":"VE","callingCode":"58"},
+{"countryCode":"VG","callingCode":"1","main":false,"prefixes":["284"]},
+{"countryCode":"VI","callingCode":"1","main":false,"prefixes":["340"]},
+{"countryCode":"VN","callingCode":"84"},
+{"countryCode":"VU","callingCode":"678"},
+{"countryCode":"WF","callingCode":"681"},
+{"countryCode":"WS","callingCode":"685"},
+{"countryCode":"YE","callingCode":"967"},
+{"countryCode":"YT","callingCode":"262","main":false,"prefixes":["2689","269","639"]},
+{"countryCode":"ZA","callingCode":"27"},
+{"countryCode":"ZM","callingCode":"260"},
+{"countryCode":"ZW","callingCode":"263"}
+]`
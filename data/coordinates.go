@@ -0,0 +1,273 @@
+package data
+
+// EXAMPLE DATA
+/*
+  {
+    "countryCode":"DE",
+    "centroid":{"latitude":50.9617,"longitude":9.6783},
+    "boundingBox":{"north":55.0653,"south":47.2711,"east":15.0221,"west":5.8525},
+    "capital":{"latitude":52.5,"longitude":13.3667}
+  }
+*/
+
+// CoordinatesJSONData is the raw JSON for the geographic coordinates of every country
+// The centroid is a representative point inside the main territory (the label point of Natural Earth),
+// replaced by a point inland of the largest polygon of the boundaries where the label point is at sea
+// (AG, GQ, GS, NZ, ST, TT, VC),
+// the bounding box covers the islands and exclaves of the country, but not the overseas territories with their
+// own ISO 3166-1 code (e.g., FR excludes GP and RE), with "west" greater than "east" when the box crosses the
+// antimeridian (e.g., RU, FJ), and the capital is the location of the capital city. All values are decimal degrees.
+// Source: Natural Earth 1:10m admin-0 and admin-1 boundaries, IANA zone.tab for capital cities
+// Countries without a capital (AQ, BV, HM, IL, IO, PS, TK, UM) have no capital coordinates.
+const CoordinatesJSONData = `[
+{"countryCode":"AD","centroid":{"latitude":42.5476,"longitude":1.5394},"boundingBox":{"north":42.6494,"south":42.4287,"east":1.7651,"west":1.4065},"capital":{"latitude":42.5,"longitude":1.5167}},
+{"countryCode":"AE","centroid":{"latitude":23.4663,"longitude":54.5473},"boundingBox":{"north":26.0748,"south":22.6209,"east":56.3836,"west":51.5693},"capital":{"latitude":24.4539,"longitude":54.3773}},
+{"countryCode":"AF","centroid":{"latitude":34.1643,"longitude":66.4966},"boundingBox":{"north":38.4737,"south":29.3866,"east":74.8923,"west":60.4868},"capital":{"latitude":34.5167,"longitude":69.2}},
+{"countryCode":"AG","centroid":{"latitude":17.1371,"longitude":-61.8132},"boundingBox":{"north":17.7277,"south":16.932,"east":-61.6676,"west":-62.3483},"capital":{"latitude":17.05,"longitude":-61.8}},
+{"countryCode":"AI","centroid":{"latitude":18.243,"longitude":-63.0264},"boundingBox":{"north":18.6013,"south":18.1691,"east":-62.9257,"west":-63.4288},"capital":{"latitude":18.2,"longitude":-63.0667}},
+{"countryCode":"AL","centroid":{"latitude":40.6549,"longitude":20.1138},"boundingBox":{"north":42.6548,"south":39.637,"east":21.0367,"west":19.272},"capital":{"latitude":41.3333,"longitude":19.8333}},
+{"countryCode":"AM","centroid":{"latitude":40.4591,"longitude":44.8006},"boundingBox":{"north":41.2905,"south":38.8637,"east":46.6026,"west":43.4363},"capital":{"latitude":40.1833,"longitude":44.5}},
+{"countryCode":"AO","centroid":{"latitude":-12.1828,"longitude":17.9842},"boundingBox":{"north":-4.3912,"south":-18.0314,"east":24.0617,"west":11.6694},"capital":{"latitude":-8.8,"longitude":13.2333}},
+{"countryCode":"AQ","centroid":{"latitude":-79.8432,"longitude":35.8855},"boundingBox":{"north":-60.5162,"south":-90,"east":180,"west":-180}},
+{"countryCode":"AR","centroid":{"latitude":-33.5012,"longitude":-64.1733},"boundingBox":{"north":-21.7869,"south":-55.052,"east":-53.6616,"west":-73.5727},"capital":{"latitude":-34.6,"longitude":-58.45}},
+{"countryCode":"AS","centroid":{"latitude":-14.3267,"longitude":-170.7472},"boundingBox":{"north":-11.0514,"south":-14.5329,"east":-168.1605,"west":-171.0865},"capital":{"latitude":-14.2667,"longitude":-170.7}},
+{"countryCode":"AT","centroid":{"latitude":47.5189,"longitude":14.1305},"boundingBox":{"north":49.0098,"south":46.3786,"east":17.1483,"west":9.5212},"capital":{"latitude":48.2167,"longitude":16.3333}},
+{"countryCode":"AU","centroid":{"latitude":-24.1295,"longitude":134.0497},"boundingBox":{"north":-9.2402,"south":-54.7504,"east":159.1065,"west":112.9194},"capital":{"latitude":-35.2809,"longitude":149.13}},
+{"countryCode":"AW","centroid":{"latitude":12.5174,"longitude":-69.9728},"boundingBox":{"north":12.6321,"south":12.4177,"east":-69.8768,"west":-70.0624},"capital":{"latitude":12.5,"longitude":-69.9667}},
+{"countryCode":"AX","centroid":{"latitude":60.1565,"longitude":19.8697},"boundingBox":{"north":60.4808,"south":59.9045,"east":21.0967,"west":19.5132},"capital":{"latitude":60.1,"longitude":19.95}},
+{"countryCode":"AZ","centroid":{"latitude":40.4024,"longitude":47.211},"boundingBox":{"north":41.8904,"south":38.3926,"east":50.6257,"west":44.7746},"capital":{"latitude":40.3833,"longitude":49.85}},
+{"countryCode":"BA","centroid":{"latitude":44.0911,"longitude":18.0684},"boundingBox":{"north":45.2845,"south":42.5592,"east":19.6189,"west":15.7161},"capital":{"latitude":43.8667,"longitude":18.4167}},
+{"countryCode":"BB","centroid":{"latitude":13.1637,"longitude":-59.569},"boundingBox":{"north":13.3445,"south":13.0512,"east":-59.4269,"west":-59.6542},"capital":{"latitude":13.1,"longitude":-59.6167}},
+{"countryCode":"BD","centroid":{"latitude":24.215,"longitude":89.685},"boundingBox":{"north":26.6235,"south":20.7387,"east":92.6429,"west":88.0218},"capital":{"latitude":23.7167,"longitude":90.4167}},
+{"countryCode":"BE","centroid":{"latitude":50.7854,"longitude":4.8004},"boundingBox":{"north":51.4962,"south":49.4952,"east":6.3745,"west":2.5218},"capital":{"latitude":50.8333,"longitude":4.3333}},
+{"countryCode":"BF","centroid":{"latitude":12.673,"longitude":-1.3639},"boundingBox":{"north":15.0799,"south":9.3919,"east":2.3902,"west":-5.5226},"capital":{"latitude":12.3667,"longitude":-1.5167}},
+{"countryCode":"BG","centroid":{"latitude":42.5088,"longitude":25.1571},"boundingBox":{"north":44.2284,"south":41.2381,"east":28.6035,"west":22.345},"capital":{"latitude":42.6833,"longitude":23.3167}},
+{"countryCode":"BH","centroid":{"latitude":26.056,"longitude":50.5548},"boundingBox":{"north":26.2874,"south":25.5799,"east":50.8199,"west":50.3805},"capital":{"latitude":26.2285,"longitude":50.586}},
+{"countryCode":"BI","centroid":{"latitude":-3.3328,"longitude":29.9171},"boundingBox":{"north":-2.3031,"south":-4.4633,"east":30.834,"west":28.9869},"capital":{"latitude":-3.3833,"longitude":29.3667}},
+{"countryCode":"BJ","centroid":{"latitude":10.3248,"longitude":2.352},"boundingBox":{"north":12.3992,"south":6.2139,"east":3.8374,"west":0.7599},"capital":{"latitude":6.4833,"longitude":2.6167}},
+{"countryCode":"BL","centroid":{"latitude":17.902,"longitude":-62.8332},"boundingBox":{"north":17.9291,"south":17.882,"east":-62.7917,"west":-62.8673},"capital":{"latitude":17.8833,"longitude":-62.85}},
+{"countryCode":"BM","centroid":{"latitude":32.2966,"longitude":-64.7636},"boundingBox":{"north":32.3887,"south":32.2481,"east":-64.6476,"west":-64.886},"capital":{"latitude":32.2833,"longitude":-64.7667}},
+{"countryCode":"BN","centroid":{"latitude":4.4483,"longitude":114.5519},"boundingBox":{"north":5.0572,"south":4.0167,"east":115.3607,"west":113.9988},"capital":{"latitude":4.9333,"longitude":114.9167}},
+{"countryCode":"BO","centroid":{"latitude":-16.666,"longitude":-64.5934},"boundingBox":{"north":-9.6798,"south":-22.8973,"east":-57.4657,"west":-69.6665},"capital":{"latitude":-19.0196,"longitude":-65.2619}},
+{"countryCode":"BQ","centroid":{"latitude":12.2314,"longitude":-68.3029},"boundingBox":{"north":17.6469,"south":12.022,"east":-62.9451,"west":-68.4174},"capital":{"latitude":12.1508,"longitude":-68.2767}},
+{"countryCode":"BR","centroid":{"latitude":-12.0987,"longitude":-49.5594},"boundingBox":{"north":5.2672,"south":-33.7423,"east":-28.8771,"west":-74.0185},"capital":{"latitude":-15.7939,"longitude":-47.8828}},
+{"countryCode":"BS","centroid":{"latitude":26.4018,"longitude":-77.1467},"boundingBox":{"north":26.9284,"south":20.9124,"east":-72.7462,"west":-79.5944},"capital":{"latitude":25.0833,"longitude":-77.35}},
+{"countryCode":"BT","centroid":{"latitude":27.5367,"longitude":90.0403},"boundingBox":{"north":28.3584,"south":26.6961,"east":92.0888,"west":88.7301},"capital":{"latitude":27.4667,"longitude":89.65}},
+{"countryCode":"BV","centroid":{"latitude":-54.4151,"longitude":3.4168},"boundingBox":{"north":-54.3801,"south":-54.4625,"east":3.4867,"west":3.3456}},
+{"countryCode":"BW","centroid":{"latitude":-22.1026,"longitude":24.1792},"boundingBox":{"north":-17.7818,"south":-26.8918,"east":29.3501,"west":19.9783},"capital":{"latitude":-24.65,"longitude":25.9167}},
+{"countryCode":"BY","centroid":{"latitude":53.8219,"longitude":28.4177},"boundingBox":{"north":56.1568,"south":51.2352,"east":32.7195,"west":23.1656},"capital":{"latitude":53.9,"longitude":27.5667}},
+{"countryCode":"BZ","centroid":{"latitude":17.2021,"longitude":-88.713},"boundingBox":{"north":18.4908,"south":15.8797,"east":-87.7831,"west":-89.2365},"capital":{"latitude":17.2514,"longitude":-88.759}},
+{"countryCode":"CA","centroid":{"latitude":60.3243,"longitude":-101.9107},"boundingBox":{"north":83.1165,"south":41.6691,"east":-52.6166,"west":-141.0056},"capital":{"latitude":45.4215,"longitude":-75.6972}},
+{"countryCode":"CC","centroid":{"latitude":-12.1915,"longitude":96.905},"boundingBox":{"north":-12.1266,"south":-12.2,"east":96.9211,"west":96.8215},"capital":{"latitude":-12.1881,"longitude":96.8289}},
+{"countryCode":"CD","centroid":{"latitude":-1.8582,"longitude":23.4588},"boundingBox":{"north":5.3753,"south":-13.4584,"east":31.2804,"west":12.2105},"capital":{"latitude":-4.3,"longitude":15.3}},
+{"countryCode":"CF","centroid":{"latitude":6.9897,"longitude":20.9069},"boundingBox":{"north":11.0008,"south":2.2365,"east":27.4413,"west":14.3873},"capital":{"latitude":4.3667,"longitude":18.5833}},
+{"countryCode":"CG","centroid":{"latitude":0.1423,"longitude":15.9005},"boundingBox":{"north":3.7083,"south":-5.0196,"east":18.6424,"west":11.114},"capital":{"latitude":-4.2667,"longitude":15.2833}},
+{"countryCode":"CH","centroid":{"latitude":46.7191,"longitude":7.464},"boundingBox":{"north":47.8012,"south":45.8207,"east":10.4666,"west":5.9548},"capital":{"latitude":46.9481,"longitude":7.4474}},
+{"countryCode":"CI","centroid":{"latitude":7.4914,"longitude":-5.5686},"boundingBox":{"north":10.7265,"south":4.3441,"east":-2.5063,"west":-8.6187},"capital":{"latitude":6.8276,"longitude":-5.2893}},
+{"countryCode":"CK","centroid":{"latitude":-21.216,"longitude":-159.7857},"boundingBox":{"north":-8.9467,"south":-21.9389,"east":-157.3128,"west":-165.8245},"capital":{"latitude":-21.2333,"longitude":-159.7667}},
+{"countryCode":"CL","centroid":{"latitude":-38.1518,"longitude":-72.3189},"boundingBox":{"north":-17.5066,"south":-55.9185,"east":-66.4208,"west":-109.4537},"capital":{"latitude":-33.45,"longitude":-70.6667}},
+{"countryCode":"CM","centroid":{"latitude":4.585,"longitude":12.4735},"boundingBox":{"north":13.0811,"south":1.6546,"east":16.2077,"west":8.5051},"capital":{"latitude":3.848,"longitude":11.5021}},
+{"countryCode":"CN","centroid":{"latitude":32.4982,"longitude":106.3373},"boundingBox":{"north":53.5694,"south":15.7754,"east":134.7726,"west":73.6023},"capital":{"latitude":39.9042,"longitude":116.4074}},
+{"countryCode":"CO","centroid":{"latitude":3.3731,"longitude":-73.1743},"boundingBox":{"north":13.5784,"south":-4.2365,"east":-66.8751,"west":-81.7237},"capital":{"latitude":4.6,"longitude":-74.0833}},
+{"countryCode":"CR","centroid":{"latitude":10.0651,"longitude":-84.0779},"boundingBox":{"north":11.2099,"south":5.5151,"east":-82.5628,"west":-87.1177},"capital":{"latitude":9.9333,"longitude":-84.0833}},
+{"countryCode":"CU","centroid":{"latitude":21.334,"longitude":-77.9759},"boundingBox":{"north":23.2656,"south":19.8278,"east":-74.1329,"west":-84.9496},"capital":{"latitude":23.1333,"longitude":-82.3667}},
+{"countryCode":"CV","centroid":{"latitude":15.0748,"longitude":-23.6394},"boundingBox":{"north":17.1966,"south":14.8039,"east":-22.6666,"west":-25.3604},"capital":{"latitude":14.9167,"longitude":-23.5167}},
+{"countryCode":"CW","centroid":{"latitude":12.145,"longitude":-68.9206},"boundingBox":{"north":12.3915,"south":12.0413,"east":-68.7397,"west":-69.1717},"capital":{"latitude":12.1833,"longitude":-69}},
+{"countryCode":"CX","centroid":{"latitude":-10.4913,"longitude":105.648},"boundingBox":{"north":-10.4308,"south":-10.566,"east":105.7147,"west":105.5818},"capital":{"latitude":-10.431,"longitude":105.679}},
+{"countryCode":"CY","centroid":{"latitude":34.9133,"longitude":33.0842},"boundingBox":{"north":35.692,"south":34.625,"east":34.5925,"west":32.2717},"capital":{"latitude":35.1667,"longitude":33.3667}},
+{"countryCode":"CZ","centroid":{"latitude":49.8824,"longitude":15.3776},"boundingBox":{"north":51.04,"south":48.5579,"east":18.8374,"west":12.0761},"capital":{"latitude":50.0833,"longitude":14.4333}},
+{"countryCode":"DE","centroid":{"latitude":50.9617,"longitude":9.6783},"boundingBox":{"north":55.0653,"south":47.2711,"east":15.0221,"west":5.8525},"capital":{"latitude":52.5,"longitude":13.3667}},
+{"countryCode":"DJ","centroid":{"latitude":11.9763,"longitude":42.4988},"boundingBox":{"north":12.7079,"south":10.9298,"east":43.4187,"west":41.7491},"capital":{"latitude":11.6,"longitude":43.15}},
+{"countryCode":"DK","centroid":{"latitude":55.967,"longitude":9.0182},"boundingBox":{"north":57.7512,"south":54.5686,"east":15.1514,"west":8.094},"capital":{"latitude":55.6667,"longitude":12.5833}},
+{"countryCode":"DM","centroid":{"latitude":15.4588,"longitude":-61.345},"boundingBox":{"north":15.6339,"south":15.2018,"east":-61.2493,"west":-61.4889},"capital":{"latitude":15.3,"longitude":-61.4}},
+{"countryCode":"DO","centroid":{"latitude":19.1041,"longitude":-70.654},"boundingBox":{"north":19.9377,"south":17.5456,"east":-68.3286,"west":-72.0098},"capital":{"latitude":18.4667,"longitude":-69.9}},
+{"countryCode":"DZ","centroid":{"latitude":27.3974,"longitude":2.8082},"boundingBox":{"north":37.0939,"south":18.9756,"east":11.9689,"west":-8.6824},"capital":{"latitude":36.7833,"longitude":3.05}},
+{"countryCode":"EC","centroid":{"latitude":-1.2591,"longitude":-78.1884},"boundingBox":{"north":1.6644,"south":-5.0114,"east":-75.2273,"west":-92.0116},"capital":{"latitude":-0.1807,"longitude":-78.4678}},
+{"countryCode":"EE","centroid":{"latitude":58.7249,"longitude":25.8671},"boundingBox":{"north":59.6709,"south":57.5158,"east":28.1865,"west":21.8324},"capital":{"latitude":59.4167,"longitude":24.75}},
+{"countryCode":"EG","centroid":{"latitude":26.1862,"longitude":29.4458},"boundingBox":{"north":31.6565,"south":21.9944,"east":36.8992,"west":24.6883},"capital":{"latitude":30.05,"longitude":31.25}},
+{"countryCode":"EH","centroid":{"latitude":23.9676,"longitude":-12.6303},"boundingBox":{"north":27.6615,"south":20.7669,"east":-8.6808,"west":-17.1046},"capital":{"latitude":27.15,"longitude":-13.2}},
+{"countryCode":"ER","centroid":{"latitude":15.7874,"longitude":38.2856},"boundingBox":{"north":18.0048,"south":12.36,"east":43.1239,"west":36.4236},"capital":{"latitude":15.3333,"longitude":38.8833}},
+{"countryCode":"ES","centroid":{"latitude":40.091,"longitude":-3.4647},"boundingBox":{"north":43.7934,"south":27.6422,"east":4.3371,"west":-18.1672},"capital":{"latitude":40.4,"longitude":-3.6833}},
+{"countryCode":"ET","centroid":{"latitude":8.0328,"longitude":39.0886},"boundingBox":{"north":14.8795,"south":3.4033,"east":47.9792,"west":32.9898},"capital":{"latitude":9.0333,"longitude":38.7}},
+{"countryCode":"FI","centroid":{"latitude":63.2524,"longitude":27.2764},"boundingBox":{"north":70.0753,"south":59.8112,"east":31.5695,"west":20.6232},"capital":{"latitude":60.1667,"longitude":24.9667}},
+{"countryCode":"FJ","centroid":{"latitude":-17.8261,"longitude":177.9754},"boundingBox":{"north":-12.4753,"south":-21.7111,"east":-178.2161,"west":174.5889},"capital":{"latitude":-18.1333,"longitude":178.4167}},
+{"countryCode":"FK","centroid":{"latitude":-51.6089,"longitude":-58.7386},"boundingBox":{"north":-51.0278,"south":-52.4065,"east":-57.7343,"west":-61.3182},"capital":{"latitude":-51.7,"longitude":-57.85}},
+{"countryCode":"FM","centroid":{"latitude":6.8876,"longitude":158.234},"boundingBox":{"north":9.7756,"south":0.9182,"east":163.0466,"west":138.0638},"capital":{"latitude":6.9248,"longitude":158.161}},
+{"countryCode":"FO","centroid":{"latitude":62.1856,"longitude":-7.0584},"boundingBox":{"north":62.3989,"south":61.3941,"east":-6.2758,"west":-7.6442},"capital":{"latitude":62.0167,"longitude":-6.7667}},
+{"countryCode":"FR","centroid":{"latitude":46.6961,"longitude":2.5523},"boundingBox":{"north":51.0875,"south":41.3659,"east":9.5596,"west":-5.1328},"capital":{"latitude":48.8667,"longitude":2.3333}},
+{"countryCode":"GA","centroid":{"latitude":-0.4377,"longitude":11.8359},"boundingBox":{"north":2.3225,"south":-3.9369,"east":14.499,"west":8.6956},"capital":{"latitude":0.3833,"longitude":9.45}},
+{"countryCode":"GB","centroid":{"latitude":54.4027,"longitude":-2.1163},"boundingBox":{"north":60.8479,"south":49.9096,"east":1.7712,"west":-13.6913},"capital":{"latitude":51.5083,"longitude":-0.1253}},
+{"countryCode":"GD","centroid":{"latitude":12.1132,"longitude":-61.6805},"boundingBox":{"north":12.5297,"south":12.0028,"east":-61.4216,"west":-61.7905},"capital":{"latitude":12.05,"longitude":-61.75}},
+{"countryCode":"GE","centroid":{"latitude":41.8701,"longitude":43.7357},"boundingBox":{"north":43.5758,"south":41.0441,"east":46.6948,"west":39.986},"capital":{"latitude":41.7167,"longitude":44.8167}},
+{"countryCode":"GF","centroid":{"latitude":3.8583,"longitude":-53.1324},"boundingBox":{"north":5.7448,"south":2.1107,"east":-51.649,"west":-54.6153},"capital":{"latitude":4.9333,"longitude":-52.3333}},
+{"countryCode":"GG","centroid":{"latitude":49.4635,"longitude":-2.5617},"boundingBox":{"north":49.7314,"south":49.4116,"east":-2.1703,"west":-2.6735},"capital":{"latitude":49.4547,"longitude":-2.5361}},
+{"countryCode":"GH","centroid":{"latitude":7.7176,"longitude":-1.0369},"boundingBox":{"north":11.1629,"south":4.7371,"east":1.188,"west":-3.2625},"capital":{"latitude":5.55,"longitude":-0.2167}},
+{"countryCode":"GI","centroid":{"latitude":36.1294,"longitude":-5.3467},"boundingBox":{"north":36.1411,"south":36.1105,"east":-5.3388,"west":-5.3584},"capital":{"latitude":36.1333,"longitude":-5.35}},
+{"countryCode":"GL","centroid":{"latitude":74.3194,"longitude":-39.3353},"boundingBox":{"north":83.6341,"south":59.7926,"east":-11.3768,"west":-73.0572},"capital":{"latitude":64.1833,"longitude":-51.7333}},
+{"countryCode":"GM","centroid":{"latitude":13.6417,"longitude":-14.9983},"boundingBox":{"north":13.82,"south":13.065,"east":-13.8187,"west":-16.8297},"capital":{"latitude":13.4667,"longitude":-16.65}},
+{"countryCode":"GN","centroid":{"latitude":10.6185,"longitude":-10.0164},"boundingBox":{"north":12.6734,"south":7.1902,"east":-7.6624,"west":-15.0811},"capital":{"latitude":9.5167,"longitude":-13.7167}},
+{"countryCode":"GP","centroid":{"latitude":16.1881,"longitude":-61.6822},"boundingBox":{"north":16.5131,"south":15.847,"east":-60.9892,"west":-61.7978},"capital":{"latitude":16.2333,"longitude":-61.5333}},
+{"countryCode":"GQ","centroid":{"latitude":1.87,"longitude":10.0928},"boundingBox":{"north":3.7724,"south":-1.4757,"east":11.3363,"west":5.612},"capital":{"latitude":3.75,"longitude":8.7833}},
+{"countryCode":"GR","centroid":{"latitude":39.4928,"longitude":21.7257},"boundingBox":{"north":41.7505,"south":34.815,"east":28.2398,"west":19.6265},"capital":{"latitude":37.9667,"longitude":23.7167}},
+{"countryCode":"GS","centroid":{"latitude":-54.7328,"longitude":-35.9979},"boundingBox":{"north":-53.9724,"south":-59.4728,"east":-26.2393,"west":-38.087},"capital":{"latitude":-54.2667,"longitude":-36.5333}},
+{"countryCode":"GT","centroid":{"latitude":14.9821,"longitude":-90.4971},"boundingBox":{"north":17.816,"south":13.7314,"east":-88.2209,"west":-92.2463},"capital":{"latitude":14.6333,"longitude":-90.5167}},
+{"countryCode":"GU","centroid":{"latitude":13.3542,"longitude":144.7036},"boundingBox":{"north":13.6541,"south":13.241,"east":144.9522,"west":144.6242},"capital":{"latitude":13.4667,"longitude":144.75}},
+{"countryCode":"GW","centroid":{"latitude":12.1637,"longitude":-14.5241},"boundingBox":{"north":12.6794,"south":10.9276,"east":-13.6607,"west":-16.7284},"capital":{"latitude":11.85,"longitude":-15.5833}},
+{"countryCode":"GY","centroid":{"latitude":5.1243,"longitude":-58.9426},"boundingBox":{"north":8.558,"south":1.1858,"east":-56.4818,"west":-61.3967},"capital":{"latitude":6.8,"longitude":-58.1667}},
+{"countryCode":"HK","centroid":{"latitude":22.4488,"longitude":114.0978},"boundingBox":{"north":22.5639,"south":22.1771,"east":114.4013,"west":113.8373},"capital":{"latitude":22.2833,"longitude":114.15}},
+{"countryCode":"HM","centroid":{"latitude":-53.1035,"longitude":73.5052},"boundingBox":{"north":-52.9616,"south":-53.1926,"east":73.8122,"west":73.236}},
+{"countryCode":"HN","centroid":{"latitude":14.7948,"longitude":-86.8876},"boundingBox":{"north":17.4186,"south":12.9798,"east":-83.1304,"west":-89.3638},"capital":{"latitude":14.1,"longitude":-87.2167}},
+{"countryCode":"HR","centroid":{"latitude":45.8058,"longitude":16.3724},"boundingBox":{"north":46.547,"south":42.4163,"east":19.4078,"west":13.5015},"capital":{"latitude":45.8,"longitude":15.9667}},
+{"countryCode":"HT","centroid":{"latitude":19.2638,"longitude":-72.2241},"boundingBox":{"north":20.0898,"south":18.0259,"east":-71.6391,"west":-74.4892},"capital":{"latitude":18.5333,"longitude":-72.3333}},
+{"countryCode":"HU","centroid":{"latitude":47.0868,"longitude":19.4479},"boundingBox":{"north":48.5692,"south":45.7413,"east":22.8776,"west":16.094},"capital":{"latitude":47.5,"longitude":19.0833}},
+{"countryCode":"ID","centroid":{"latitude":-0.9544,"longitude":101.8929},"boundingBox":{"north":5.9101,"south":-10.9226,"east":140.9776,"west":95.0127},"capital":{"latitude":-6.1667,"longitude":106.8}},
+{"countryCode":"IE","centroid":{"latitude":53.0787,"longitude":-7.7986},"boundingBox":{"north":55.3864,"south":51.4457,"east":-5.9935,"west":-10.4782},"capital":{"latitude":53.3333,"longitude":-6.25}},
+{"countryCode":"IL","centroid":{"latitude":30.9111,"longitude":34.8479},"boundingBox":{"north":33.4067,"south":29.4897,"east":35.8881,"west":34.2484}},
+{"countryCode":"IM","centroid":{"latitude":54.2208,"longitude":-4.5301},"boundingBox":{"north":54.419,"south":54.057,"east":-4.3119,"west":-4.7902},"capital":{"latitude":54.15,"longitude":-4.4667}},
+{"countryCode":"IN","centroid":{"latitude":22.6869,"longitude":79.3581},"boundingBox":{"north":35.4954,"south":6.7456,"east":97.3623,"west":68.1434},"capital":{"latitude":28.6139,"longitude":77.209}},
+{"countryCode":"IO","centroid":{"latitude":-6.1908,"longitude":71.3483},"boundingBox":{"north":-5.227,"south":-7.4322,"east":72.4946,"west":71.261}},
+{"countryCode":"IQ","centroid":{"latitude":33.094,"longitude":43.2618},"boundingBox":{"north":37.3755,"south":29.0631,"east":48.5593,"west":38.7745},"capital":{"latitude":33.35,"longitude":44.4167}},
+{"countryCode":"IR","centroid":{"latitude":32.1662,"longitude":54.9315},"boundingBox":{"north":39.7715,"south":25.0594,"east":63.3196,"west":44.0149},"capital":{"latitude":35.6667,"longitude":51.4333}},
+{"countryCode":"IS","centroid":{"latitude":64.7793,"longitude":-18.6737},"boundingBox":{"north":66.5642,"south":63.3967,"east":-13.5029,"west":-24.5399},"capital":{"latitude":64.15,"longitude":-21.85}},
+{"countryCode":"IT","centroid":{"latitude":44.7325,"longitude":11.0769},"boundingBox":{"north":47.0852,"south":35.4892,"east":18.5174,"west":6.6027},"capital":{"latitude":41.9,"longitude":12.4833}},
+{"countryCode":"JE","centroid":{"latitude":49.2208,"longitude":-2.0901},"boundingBox":{"north":49.267,"south":49.1713,"east":-2.0083,"west":-2.242},"capital":{"latitude":49.1836,"longitude":-2.1067}},
+{"countryCode":"JM","centroid":{"latitude":18.1371,"longitude":-77.3188},"boundingBox":{"north":18.5251,"south":17.7032,"east":-76.188,"west":-78.3747},"capital":{"latitude":17.9681,"longitude":-76.7933}},
+{"countryCode":"JO","centroid":{"latitude":30.805,"longitude":36.376},"boundingBox":{"north":33.3717,"south":29.19,"east":39.292,"west":34.9494},"capital":{"latitude":31.95,"longitude":35.9333}},
+{"countryCode":"JP","centroid":{"latitude":36.1425,"longitude":138.4422},"boundingBox":{"north":45.5204,"south":24.2121,"east":153.9856,"west":122.9382},"capital":{"latitude":35.6544,"longitude":139.7447}},
+{"countryCode":"KE","centroid":{"latitude":0.549,"longitude":37.9076},"boundingBox":{"north":5.0304,"south":-4.6775,"east":41.885,"west":33.8905},"capital":{"latitude":-1.2833,"longitude":36.8167}},
+{"countryCode":"KG","centroid":{"latitude":41.6685,"longitude":74.5326},"boundingBox":{"north":43.2617,"south":39.1892,"east":80.2576,"west":69.2263},"capital":{"latitude":42.9,"longitude":74.6}},
+{"countryCode":"KH","centroid":{"latitude":12.6476,"longitude":104.5049},"boundingBox":{"north":14.7046,"south":10.4158,"east":107.6105,"west":102.3134},"capital":{"latitude":11.55,"longitude":104.9167}},
+{"countryCode":"KI","centroid":{"latitude":1.8204,"longitude":-157.3846},"boundingBox":{"north":4.7231,"south":-11.4611,"east":-151.781,"west":169.5221},"capital":{"latitude":1.4167,"longitude":173}},
+{"countryCode":"KM","centroid":{"latitude":-11.7277,"longitude":43.3181},"boundingBox":{"north":-11.3613,"south":-12.3803,"east":44.5291,"west":43.2132},"capital":{"latitude":-11.6833,"longitude":43.2667}},
+{"countryCode":"KN","centroid":{"latitude":17.3366,"longitude":-62.758},"boundingBox":{"north":17.4158,"south":17.1005,"east":-62.5368,"west":-62.8611},"capital":{"latitude":17.3,"longitude":-62.7167}},
+{"countryCode":"KP","centroid":{"latitude":39.8853,"longitude":126.4445},"boundingBox":{"north":43.0103,"south":37.6756,"east":130.7,"west":124.2113},"capital":{"latitude":39.0167,"longitude":125.75}},
+{"countryCode":"KR","centroid":{"latitude":36.3849,"longitude":128.1295},"boundingBox":{"north":38.6243,"south":33.1976,"east":131.8625,"west":124.6136},"capital":{"latitude":37.55,"longitude":126.9667}},
+{"countryCode":"KW","centroid":{"latitude":29.4136,"longitude":47.314},"boundingBox":{"north":30.0982,"south":28.5335,"east":48.4328,"west":46.5324},"capital":{"latitude":29.3333,"longitude":47.9833}},
+{"countryCode":"KY","centroid":{"latitude":19.3199,"longitude":-81.2405},"boundingBox":{"north":19.7576,"south":19.2639,"east":-79.7266,"west":-81.4165},"capital":{"latitude":19.3,"longitude":-81.3833}},
+{"countryCode":"KZ","centroid":{"latitude":49.0541,"longitude":68.6855},"boundingBox":{"north":55.4346,"south":40.5847,"east":87.3238,"west":46.4783},"capital":{"latitude":51.1694,"longitude":71.4491}},
+{"countryCode":"LA","centroid":{"latitude":19.4318,"longitude":102.5339},"boundingBox":{"north":22.496,"south":13.9155,"east":107.6644,"west":100.0971},"capital":{"latitude":17.9667,"longitude":102.6}},
+{"countryCode":"LB","centroid":{"latitude":34.1334,"longitude":35.9929},"boundingBox":{"north":34.6875,"south":33.0556,"east":36.6041,"west":35.0996},"capital":{"latitude":33.8833,"longitude":35.5}},
+{"countryCode":"LC","centroid":{"latitude":13.8924,"longitude":-60.9801},"boundingBox":{"north":14.1119,"south":13.7147,"east":-60.883,"west":-61.0785},"capital":{"latitude":14.0167,"longitude":-61}},
+{"countryCode":"LI","centroid":{"latitude":47.1114,"longitude":9.5594},"boundingBox":{"north":47.2628,"south":47.0524,"east":9.6157,"west":9.4759},"capital":{"latitude":47.15,"longitude":9.5167}},
+{"countryCode":"LK","centroid":{"latitude":7.5811,"longitude":80.7048},"boundingBox":{"north":9.8296,"south":5.9237,"east":81.8903,"west":79.6558},"capital":{"latitude":6.9333,"longitude":79.85}},
+{"countryCode":"LR","centroid":{"latitude":6.4472,"longitude":-9.4604},"boundingBox":{"north":8.5654,"south":4.3472,"east":-7.3841,"west":-11.4762},"capital":{"latitude":6.3,"longitude":-10.7833}},
+{"countryCode":"LS","centroid":{"latitude":-29.4802,"longitude":28.2466},"boundingBox":{"north":-28.5708,"south":-30.6588,"east":29.4359,"west":27.0022},"capital":{"latitude":-29.4667,"longitude":27.5}},
+{"countryCode":"LT","centroid":{"latitude":55.1037,"longitude":24.0899},"boundingBox":{"north":56.4426,"south":53.8868,"east":26.8007,"west":20.9246},"capital":{"latitude":54.6833,"longitude":25.3167}},
+{"countryCode":"LU","centroid":{"latitude":49.7337,"longitude":6.0776},"boundingBox":{"north":50.175,"south":49.4413,"east":6.5026,"west":5.7149},"capital":{"latitude":49.6,"longitude":6.15}},
+{"countryCode":"LV","centroid":{"latitude":57.0669,"longitude":25.4587},"boundingBox":{"north":58.0751,"south":55.667,"east":28.2173,"west":20.9686},"capital":{"latitude":56.95,"longitude":24.1}},
+{"countryCode":"LY","centroid":{"latitude":26.6389,"longitude":18.011},"boundingBox":{"north":33.1812,"south":19.4961,"east":25.1563,"west":9.2865},"capital":{"latitude":32.9,"longitude":13.1833}},
+{"countryCode":"MA","centroid":{"latitude":31.6507,"longitude":-7.1873},"boundingBox":{"north":35.9265,"south":21.42,"east":-1.032,"west":-17.0137},"capital":{"latitude":34.0209,"longitude":-6.8416}},
+{"countryCode":"MC","centroid":{"latitude":43.7397,"longitude":7.3983},"boundingBox":{"north":43.7635,"south":43.718,"east":7.4375,"west":7.3658},"capital":{"latitude":43.7384,"longitude":7.4246}},
+{"countryCode":"MD","centroid":{"latitude":47.435,"longitude":28.4879},"boundingBox":{"north":48.486,"south":45.4618,"east":30.1316,"west":26.6179},"capital":{"latitude":47,"longitude":28.8333}},
+{"countryCode":"ME","centroid":{"latitude":42.8031,"longitude":19.1437},"boundingBox":{"north":43.5479,"south":41.8524,"east":20.3552,"west":18.4335},"capital":{"latitude":42.4333,"longitude":19.2667}},
+{"countryCode":"MF","centroid":{"latitude":18.0813,"longitude":-63.0494},"boundingBox":{"north":18.1221,"south":18.0334,"east":-63.0107,"west":-63.1468},"capital":{"latitude":18.0667,"longitude":-63.0833}},
+{"countryCode":"MG","centroid":{"latitude":-18.6283,"longitude":46.7042},"boundingBox":{"north":-11.9436,"south":-25.5986,"east":50.5039,"west":43.2229},"capital":{"latitude":-18.9167,"longitude":47.5167}},
+{"countryCode":"MH","centroid":{"latitude":7.0826,"longitude":171.1936},"boundingBox":{"north":14.6105,"south":4.5738,"east":172.0298,"west":165.2822},"capital":{"latitude":7.15,"longitude":171.2}},
+{"countryCode":"MK","centroid":{"latitude":41.5582,"longitude":21.5558},"boundingBox":{"north":42.3703,"south":40.8494,"east":23.0096,"west":20.4442},"capital":{"latitude":41.9833,"longitude":21.4333}},
+{"countryCode":"ML","centroid":{"latitude":18.6927,"longitude":-2.0385},"boundingBox":{"north":24.9951,"south":10.1401,"east":4.2356,"west":-12.2641},"capital":{"latitude":12.65,"longitude":-8}},
+{"countryCode":"MM","centroid":{"latitude":21.5739,"longitude":95.8045},"boundingBox":{"north":28.5385,"south":9.7907,"east":101.1739,"west":92.175},"capital":{"latitude":19.7633,"longitude":96.0785}},
+{"countryCode":"MN","centroid":{"latitude":45.9975,"longitude":104.1504},"boundingBox":{"north":52.1296,"south":41.5861,"east":119.907,"west":87.7357},"capital":{"latitude":47.9167,"longitude":106.8833}},
+{"countryCode":"MO","centroid":{"latitude":22.1297,"longitude":113.556},"boundingBox":{"north":22.2208,"south":22.1054,"east":113.5875,"west":113.5199},"capital":{"latitude":22.1972,"longitude":113.5417}},
+{"countryCode":"MP","centroid":{"latitude":15.1882,"longitude":145.7344},"boundingBox":{"north":20.5554,"south":14.1107,"east":145.8689,"west":144.9021},"capital":{"latitude":15.2,"longitude":145.75}},
+{"countryCode":"MQ","centroid":{"latitude":14.7245,"longitude":-61.064},"boundingBox":{"north":14.8769,"south":14.4081,"east":-60.8104,"west":-61.2288},"capital":{"latitude":14.6,"longitude":-61.0833}},
+{"countryCode":"MR","centroid":{"latitude":19.5871,"longitude":-9.7403},"boundingBox":{"north":27.2854,"south":14.7344,"east":-4.8216,"west":-17.0812},"capital":{"latitude":18.1,"longitude":-15.95}},
+{"countryCode":"MS","centroid":{"latitude":16.7372,"longitude":-62.1883},"boundingBox":{"north":16.8193,"south":16.6754,"east":-62.1405,"west":-62.2301},"capital":{"latitude":16.7167,"longitude":-62.2167}},
+{"countryCode":"MT","centroid":{"latitude":35.8929,"longitude":14.433},"boundingBox":{"north":36.0756,"south":35.8012,"east":14.5671,"west":14.1836},"capital":{"latitude":35.9,"longitude":14.5167}},
+{"countryCode":"MU","centroid":{"latitude":-20.2995,"longitude":57.5658},"boundingBox":{"north":-10.3239,"south":-20.5173,"east":63.4939,"west":56.5242},"capital":{"latitude":-20.1667,"longitude":57.5}},
+{"countryCode":"MV","centroid":{"latitude":4.1744,"longitude":73.5076},"boundingBox":{"north":7.1072,"south":-0.6886,"east":73.7532,"west":72.6848},"capital":{"latitude":4.1667,"longitude":73.5}},
+{"countryCode":"MW","centroid":{"latitude":-13.3867,"longitude":33.6081},"boundingBox":{"north":-9.3812,"south":-17.1353,"east":35.9043,"west":32.6633},"capital":{"latitude":-13.9626,"longitude":33.7741}},
+{"countryCode":"MX","centroid":{"latitude":23.92,"longitude":-102.2894},"boundingBox":{"north":32.7128,"south":14.5463,"east":-86.7006,"west":-118.3688},"capital":{"latitude":19.4,"longitude":-99.15}},
+{"countryCode":"MY","centroid":{"latitude":2.5287,"longitude":113.8371},"boundingBox":{"north":7.3558,"south":0.8514,"east":119.2781,"west":99.6452},"capital":{"latitude":3.1667,"longitude":101.7}},
+{"countryCode":"MZ","centroid":{"latitude":-13.9432,"longitude":37.8379},"boundingBox":{"north":-10.469,"south":-26.8603,"east":40.848,"west":30.2138},"capital":{"latitude":-25.9667,"longitude":32.5833}},
+{"countryCode":"NA","centroid":{"latitude":-20.5753,"longitude":17.1082},"boundingBox":{"north":-16.9511,"south":-28.9594,"east":25.2598,"west":11.7176},"capital":{"latitude":-22.5667,"longitude":17.1}},
+{"countryCode":"NC","centroid":{"latitude":-21.0647,"longitude":165.084},"boundingBox":{"north":-19.6237,"south":-22.6707,"east":171.3438,"west":163.6157},"capital":{"latitude":-22.2667,"longitude":166.45}},
+{"countryCode":"NE","centroid":{"latitude":17.4462,"longitude":9.5044},"boundingBox":{"north":23.5174,"south":11.6958,"east":15.9703,"west":0.1529},"capital":{"latitude":13.5167,"longitude":2.1167}},
+{"countryCode":"NF","centroid":{"latitude":-29.033,"longitude":167.9545},"boundingBox":{"north":-28.9975,"south":-29.08,"east":167.9963,"west":167.9121},"capital":{"latitude":-29.05,"longitude":167.9667}},
+{"countryCode":"NG","centroid":{"latitude":9.4398,"longitude":7.5032},"boundingBox":{"north":13.8803,"south":4.2722,"east":14.6699,"west":2.6711},"capital":{"latitude":9.0765,"longitude":7.3986}},
+{"countryCode":"NI","centroid":{"latitude":12.6707,"longitude":-85.0693},"boundingBox":{"north":15.031,"south":10.7135,"east":-82.7257,"west":-87.6858},"capital":{"latitude":12.15,"longitude":-86.2833}},
+{"countryCode":"NL","centroid":{"latitude":52.4222,"longitude":5.6114},"boundingBox":{"north":53.5581,"south":50.7475,"east":7.1985,"west":3.3494},"capital":{"latitude":52.3667,"longitude":4.9}},
+{"countryCode":"NO","centroid":{"latitude":61.3571,"longitude":9.68},"boundingBox":{"north":71.1653,"south":57.9931,"east":31.077,"west":4.6438},"capital":{"latitude":59.9167,"longitude":10.75}},
+{"countryCode":"NP","centroid":{"latitude":28.2979,"longitude":83.6399},"boundingBox":{"north":30.4169,"south":26.3438,"east":88.1691,"west":80.0303},"capital":{"latitude":27.7167,"longitude":85.3167}},
+{"countryCode":"NR","centroid":{"latitude":-0.5203,"longitude":166.9326},"boundingBox":{"north":-0.4904,"south":-0.5519,"east":166.9583,"west":166.907},"capital":{"latitude":-0.5167,"longitude":166.9167}},
+{"countryCode":"NU","centroid":{"latitude":-19.046,"longitude":-169.8626},"boundingBox":{"north":-18.964,"south":-19.1428,"east":-169.7829,"west":-169.9504},"capital":{"latitude":-19.0167,"longitude":-169.9167}},
+{"countryCode":"NZ","centroid":{"latitude":-42.623,"longitude":172.6646},"boundingBox":{"north":-29.2219,"south":-52.6003,"east":-176.1139,"west":165.8865},"capital":{"latitude":-41.2865,"longitude":174.7762}},
+{"countryCode":"OM","centroid":{"latitude":22.1204,"longitude":57.3366},"boundingBox":{"north":26.386,"south":16.6424,"east":59.8446,"west":51.9786},"capital":{"latitude":23.6,"longitude":58.5833}},
+{"countryCode":"PA","centroid":{"latitude":8.722,"longitude":-80.3521},"boundingBox":{"north":9.6293,"south":7.2057,"east":-77.1633,"west":-83.0532},"capital":{"latitude":8.9667,"longitude":-79.5333}},
+{"countryCode":"PE","centroid":{"latitude":-12.9767,"longitude":-72.9002},"boundingBox":{"north":-0.0291,"south":-18.3377,"east":-68.6843,"west":-81.3376},"capital":{"latitude":-12.05,"longitude":-77.05}},
+{"countryCode":"PF","centroid":{"latitude":-17.6281,"longitude":-149.4616},"boundingBox":{"north":-7.9501,"south":-27.6412,"east":-134.943,"west":-154.537},"capital":{"latitude":-17.5333,"longitude":-149.5667}},
+{"countryCode":"PG","centroid":{"latitude":-5.6953,"longitude":143.9102},"boundingBox":{"north":-1.3464,"south":-11.6363,"east":155.9675,"west":140.8492},"capital":{"latitude":-9.5,"longitude":147.1667}},
+{"countryCode":"PH","centroid":{"latitude":11.198,"longitude":122.465},"boundingBox":{"north":21.1224,"south":4.6557,"east":126.6177,"west":116.9549},"capital":{"latitude":14.5867,"longitude":120.9678}},
+{"countryCode":"PK","centroid":{"latitude":29.3284,"longitude":68.5456},"boundingBox":{"north":37.0545,"south":23.6945,"east":77.049,"west":60.8444},"capital":{"latitude":33.6844,"longitude":73.0479}},
+{"countryCode":"PL","centroid":{"latitude":51.9903,"longitude":19.4905},"boundingBox":{"north":54.8383,"south":48.994,"east":24.1432,"west":14.1239},"capital":{"latitude":52.25,"longitude":21}},
+{"countryCode":"PM","centroid":{"latitude":47.0403,"longitude":-56.3324},"boundingBox":{"north":47.1413,"south":46.7528,"east":-56.1448,"west":-56.3966},"capital":{"latitude":47.05,"longitude":-56.3333}},
+{"countryCode":"PN","centroid":{"latitude":-24.3646,"longitude":-128.3175},"boundingBox":{"north":-23.9244,"south":-25.0771,"east":-124.7781,"west":-130.7531},"capital":{"latitude":-25.0667,"longitude":-130.0833}},
+{"countryCode":"PR","centroid":{"latitude":18.2347,"longitude":-66.4811},"boundingBox":{"north":18.5228,"south":17.9229,"east":-65.2446,"west":-67.9378},"capital":{"latitude":18.4683,"longitude":-66.1061}},
+{"countryCode":"PS","centroid":{"latitude":32.0474,"longitude":35.2913},"boundingBox":{"north":32.5426,"south":31.2114,"east":35.5725,"west":34.2003}},
+{"countryCode":"PT","centroid":{"latitude":39.6067,"longitude":-8.2718},"boundingBox":{"north":42.1536,"south":30.0292,"east":-6.2059,"west":-31.2849},"capital":{"latitude":38.7167,"longitude":-9.1333}},
+{"countryCode":"PW","centroid":{"latitude":7.5183,"longitude":134.5802},"boundingBox":{"north":8.0966,"south":2.949,"east":134.7273,"west":131.1311},"capital":{"latitude":7.5006,"longitude":134.6243}},
+{"countryCode":"PY","centroid":{"latitude":-21.6745,"longitude":-60.1464},"boundingBox":{"north":-19.2867,"south":-27.5868,"east":-54.2453,"west":-62.6504},"capital":{"latitude":-25.2667,"longitude":-57.6667}},
+{"countryCode":"QA","centroid":{"latitude":25.2374,"longitude":51.1435},"boundingBox":{"north":26.1601,"south":24.5599,"east":51.6165,"west":50.751},"capital":{"latitude":25.2833,"longitude":51.5333}},
+{"countryCode":"RE","centroid":{"latitude":-21.1155,"longitude":55.5405},"boundingBox":{"north":-20.8614,"south":-21.3708,"east":55.8545,"west":55.2254},"capital":{"latitude":-20.8667,"longitude":55.4667}},
+{"countryCode":"RO","centroid":{"latitude":45.7332,"longitude":24.9726},"boundingBox":{"north":48.2748,"south":43.6501,"east":29.6996,"west":20.2428},"capital":{"latitude":44.4333,"longitude":26.1}},
+{"countryCode":"RS","centroid":{"latitude":44.1899,"longitude":20.788},"boundingBox":{"north":46.1739,"south":42.2349,"east":22.9846,"west":18.845},"capital":{"latitude":44.8333,"longitude":20.5}},
+{"countryCode":"RU","centroid":{"latitude":58.2494,"longitude":44.6865},"boundingBox":{"north":81.8587,"south":41.1927,"east":-168.9946,"west":19.6095},"capital":{"latitude":55.7558,"longitude":37.6178}},
+{"countryCode":"RW","centroid":{"latitude":-1.8972,"longitude":30.1039},"boundingBox":{"north":-1.0587,"south":-2.8269,"east":30.8878,"west":28.8572},"capital":{"latitude":-1.95,"longitude":30.0667}},
+{"countryCode":"SA","centroid":{"latitude":23.8069,"longitude":44.6996},"boundingBox":{"north":32.1213,"south":16.371,"east":55.6376,"west":34.5728},"capital":{"latitude":24.6333,"longitude":46.7167}},
+{"countryCode":"SB","centroid":{"latitude":-8.0295,"longitude":159.1705},"boundingBox":{"north":-6.5999,"south":-12.2906,"east":168.8259,"west":155.508},"capital":{"latitude":-9.5333,"longitude":160.2}},
+{"countryCode":"SC","centroid":{"latitude":-4.6767,"longitude":55.4802},"boundingBox":{"north":-3.7911,"south":-9.7555,"east":56.2874,"west":46.2074},"capital":{"latitude":-4.6667,"longitude":55.4667}},
+{"countryCode":"SD","centroid":{"latitude":16.3307,"longitude":29.2607},"boundingBox":{"north":22.227,"south":8.6816,"east":38.6039,"west":21.8094},"capital":{"latitude":15.6,"longitude":32.5333}},
+{"countryCode":"SE","centroid":{"latitude":65.8592,"longitude":19.0171},"boundingBox":{"north":69.0364,"south":55.3427,"east":24.1634,"west":11.1082},"capital":{"latitude":59.3333,"longitude":18.05}},
+{"countryCode":"SG","centroid":{"latitude":1.3666,"longitude":103.8169},"boundingBox":{"north":1.4486,"south":1.2643,"east":104.0034,"west":103.6404},"capital":{"latitude":1.2833,"longitude":103.85}},
+{"countryCode":"SH","centroid":{"latitude":-15.9505,"longitude":-5.7126},"boundingBox":{"north":-7.8779,"south":-40.3979,"east":-5.6504,"west":-14.4177},"capital":{"latitude":-15.9167,"longitude":-5.7}},
+{"countryCode":"SI","centroid":{"latitude":46.0608,"longitude":14.9153},"boundingBox":{"north":46.864,"south":45.4236,"east":16.5153,"west":13.3653},"capital":{"latitude":46.05,"longitude":14.5167}},
+{"countryCode":"SJ","centroid":{"latitude":78.8124,"longitude":18.069},"boundingBox":{"north":80.7701,"south":70.83,"east":33.6404,"west":-9.05},"capital":{"latitude":78,"longitude":16}},
+{"countryCode":"SK","centroid":{"latitude":48.734,"longitude":19.0499},"boundingBox":{"north":49.6018,"south":47.75,"east":22.5396,"west":16.8445},"capital":{"latitude":48.15,"longitude":17.1167}},
+{"countryCode":"SL","centroid":{"latitude":8.6174,"longitude":-11.7637},"boundingBox":{"north":9.996,"south":6.9194,"east":-10.2822,"west":-13.3011},"capital":{"latitude":8.5,"longitude":-13.25}},
+{"countryCode":"SM","centroid":{"latitude":43.9339,"longitude":12.4412},"boundingBox":{"north":43.9826,"south":43.8921,"east":12.4924,"west":12.3856},"capital":{"latitude":43.9167,"longitude":12.4667}},
+{"countryCode":"SN","centroid":{"latitude":15.1381,"longitude":-14.7786},"boundingBox":{"north":16.6914,"south":12.3056,"east":-11.3778,"west":-17.536},"capital":{"latitude":14.6667,"longitude":-17.4333}},
+{"countryCode":"SO","centroid":{"latitude":3.5689,"longitude":45.1924},"boundingBox":{"north":11.9891,"south":-1.6963,"east":51.417,"west":40.9654},"capital":{"latitude":2.0667,"longitude":45.3667}},
+{"countryCode":"SR","centroid":{"latitude":4.144,"longitude":-55.9109},"boundingBox":{"north":6.0116,"south":1.8335,"east":-53.9864,"west":-58.0677},"capital":{"latitude":5.8333,"longitude":-55.1667}},
+{"countryCode":"SS","centroid":{"latitude":7.2305,"longitude":30.3902},"boundingBox":{"north":12.2162,"south":3.4902,"east":35.9208,"west":24.1216},"capital":{"latitude":4.85,"longitude":31.6167}},
+{"countryCode":"ST","centroid":{"latitude":0.347,"longitude":6.6541},"boundingBox":{"north":1.6998,"south":0.0241,"east":7.4627,"west":6.4617},"capital":{"latitude":0.3333,"longitude":6.7333}},
+{"countryCode":"SV","centroid":{"latitude":13.6854,"longitude":-88.8901},"boundingBox":{"north":14.4454,"south":13.1586,"east":-87.6932,"west":-90.1148},"capital":{"latitude":13.7,"longitude":-89.2}},
+{"countryCode":"SX","centroid":{"latitude":18.0409,"longitude":-63.0701},"boundingBox":{"north":18.0621,"south":18.0191,"east":-63.0176,"west":-63.1189},"capital":{"latitude":18.0514,"longitude":-63.0472}},
+{"countryCode":"SY","centroid":{"latitude":35.0066,"longitude":38.2778},"boundingBox":{"north":37.3249,"south":32.313,"east":42.3772,"west":35.7234},"capital":{"latitude":33.5,"longitude":36.3}},
+{"countryCode":"SZ","centroid":{"latitude":-26.5337,"longitude":31.4673},"boundingBox":{"north":-25.736,"south":-27.3163,"east":32.1174,"west":30.7829},"capital":{"latitude":-26.3,"longitude":31.1}},
+{"countryCode":"TC","centroid":{"latitude":21.8166,"longitude":-71.7527},"boundingBox":{"north":21.9592,"south":21.2901,"east":-71.1289,"west":-72.4813},"capital":{"latitude":21.4667,"longitude":-71.1333}},
+{"countryCode":"TD","centroid":{"latitude":15.143,"longitude":18.645},"boundingBox":{"north":23.4447,"south":7.4556,"east":23.9844,"west":13.4492},"capital":{"latitude":12.1167,"longitude":15.05}},
+{"countryCode":"TF","centroid":{"latitude":-49.3037,"longitude":69.1221},"boundingBox":{"north":-11.5506,"south":-49.7216,"east":77.5852,"west":39.7283},"capital":{"latitude":-49.3528,"longitude":70.2175}},
+{"countryCode":"TG","centroid":{"latitude":8.8072,"longitude":1.0581},"boundingBox":{"north":11.135,"south":6.1005,"east":1.7824,"west":-0.1661},"capital":{"latitude":6.1333,"longitude":1.2167}},
+{"countryCode":"TH","centroid":{"latitude":15.4597,"longitude":101.0732},"boundingBox":{"north":20.445,"south":5.6299,"east":105.651,"west":97.3514},"capital":{"latitude":13.75,"longitude":100.5167}},
+{"countryCode":"TJ","centroid":{"latitude":38.1998,"longitude":72.5873},"boundingBox":{"north":41.04,"south":36.6786,"east":75.1641,"west":67.3427},"capital":{"latitude":38.5833,"longitude":68.8}},
+{"countryCode":"TK","centroid":{"latitude":-9.3445,"longitude":-171.195},"boundingBox":{"north":-8.5432,"south":-9.3617,"east":-171.1857,"west":-172.5026}},
+{"countryCode":"TL","centroid":{"latitude":-8.8037,"longitude":125.8547},"boundingBox":{"north":-8.135,"south":-9.5012,"east":127.3132,"west":124.03},"capital":{"latitude":-8.55,"longitude":125.5833}},
+{"countryCode":"TM","centroid":{"latitude":39.8552,"longitude":58.6766},"boundingBox":{"north":42.7912,"south":35.1406,"east":66.6458,"west":52.4377},"capital":{"latitude":37.95,"longitude":58.3833}},
+{"countryCode":"TN","centroid":{"latitude":33.6873,"longitude":9.0079},"boundingBox":{"north":37.3452,"south":30.2289,"east":11.5641,"west":7.4798},"capital":{"latitude":36.8,"longitude":10.1833}},
+{"countryCode":"TO","centroid":{"latitude":-21.21,"longitude":-175.163},"boundingBox":{"north":-15.5595,"south":-22.3388,"east":-173.9143,"west":-176.2193},"capital":{"latitude":-21.1333,"longitude":-175.2}},
+{"countryCode":"TR","centroid":{"latitude":39.3454,"longitude":34.5083},"boundingBox":{"north":42.0988,"south":35.8198,"east":44.807,"west":25.6633},"capital":{"latitude":39.9334,"longitude":32.8597}},
+{"countryCode":"TT","centroid":{"latitude":10.6883,"longitude":-61.1499},"boundingBox":{"north":11.3511,"south":10.0421,"east":-60.5221,"west":-61.9287},"capital":{"latitude":10.65,"longitude":-61.5167}},
+{"countryCode":"TV","centroid":{"latitude":-8.5137,"longitude":179.2096},"boundingBox":{"north":-5.6775,"south":-9.4207,"east":179.9067,"west":176.1253},"capital":{"latitude":-8.5167,"longitude":179.2167}},
+{"countryCode":"TW","centroid":{"latitude":23.6524,"longitude":120.8682},"boundingBox":{"north":25.2874,"south":21.9046,"east":122.0054,"west":118.2796},"capital":{"latitude":25.05,"longitude":121.5}},
+{"countryCode":"TZ","centroid":{"latitude":-6.0519,"longitude":34.9592},"boundingBox":{"north":-0.9858,"south":-11.7313,"east":40.4494,"west":29.321},"capital":{"latitude":-6.163,"longitude":35.7516}},
+{"countryCode":"UA","centroid":{"latitude":49.7247,"longitude":32.1409},"boundingBox":{"north":52.3689,"south":45.2136,"east":40.1595,"west":22.1328},"capital":{"latitude":50.4333,"longitude":30.5167}},
+{"countryCode":"UG","centroid":{"latitude":1.9726,"longitude":32.9486},"boundingBox":{"north":4.2197,"south":-1.4752,"east":35.0065,"west":29.5485},"capital":{"latitude":0.3167,"longitude":32.4167}},
+{"countryCode":"UM","centroid":{"latitude":19.2857,"longitude":166.6445},"boundingBox":{"north":28.2153,"south":-0.3888,"east":-75.0026,"west":166.6194}},
+{"countryCode":"US","centroid":{"latitude":39.5385,"longitude":-97.4826},"boundingBox":{"north":71.4125,"south":18.9061,"east":-66.9773,"west":172.4761},"capital":{"latitude":38.9072,"longitude":-77.0369}},
+{"countryCode":"UY","centroid":{"latitude":-32.9611,"longitude":-55.9669},"boundingBox":{"north":-30.0969,"south":-34.9734,"east":-53.1108,"west":-58.4394},"capital":{"latitude":-34.9092,"longitude":-56.2125}},
+{"countryCode":"UZ","centroid":{"latitude":41.6936,"longitude":64.0054},"boundingBox":{"north":45.5587,"south":37.1851,"east":73.1486,"west":55.9758},"capital":{"latitude":41.3333,"longitude":69.3}},
+{"countryCode":"VA","centroid":{"latitude":41.9033,"longitude":12.4534},"boundingBox":{"north":41.9039,"south":41.9028,"east":12.454,"west":12.4527},"capital":{"latitude":41.9034,"longitude":12.4529}},
+{"countryCode":"VC","centroid":{"latitude":13.2075,"longitude":-61.2397},"boundingBox":{"north":13.3808,"south":12.5852,"east":-61.1239,"west":-61.4598},"capital":{"latitude":13.15,"longitude":-61.2333}},
+{"countryCode":"VE","centroid":{"latitude":7.1825,"longitude":-64.5994},"boundingBox":{"north":15.7029,"south":0.6493,"east":-59.8156,"west":-73.3911},"capital":{"latitude":10.5,"longitude":-66.9333}},
+{"countryCode":"VG","centroid":{"latitude":18.4266,"longitude":-64.6366},"boundingBox":{"north":18.7462,"south":18.3347,"east":-64.2707,"west":-64.774},"capital":{"latitude":18.45,"longitude":-64.6167}},
+{"countryCode":"VI","centroid":{"latitude":17.7467,"longitude":-64.7792},"boundingBox":{"north":18.3866,"south":17.6828,"east":-64.5594,"west":-65.0415},"capital":{"latitude":18.35,"longitude":-64.9333}},
+{"countryCode":"VN","centroid":{"latitude":21.7154,"longitude":105.3873},"boundingBox":{"north":23.3663,"south":8.5656,"east":109.4724,"west":102.1187},"capital":{"latitude":21.0278,"longitude":105.8342}},
+{"countryCode":"VU","centroid":{"latitude":-15.3715,"longitude":166.9088},"boundingBox":{"north":-13.0649,"south":-20.2531,"east":169.8989,"west":166.5205},"capital":{"latitude":-17.6667,"longitude":168.4167}},
+{"countryCode":"WF","centroid":{"latitude":-14.2864,"longitude":-178.1374},"boundingBox":{"north":-13.2089,"south":-14.3194,"east":-176.1256,"west":-178.1857},"capital":{"latitude":-13.3,"longitude":-176.1667}},
+{"countryCode":"WS","centroid":{"latitude":-13.6391,"longitude":-172.4382},"boundingBox":{"north":-13.4628,"south":-14.0528,"east":-171.4377,"west":-172.7826},"capital":{"latitude":-13.8333,"longitude":-171.7333}},
+{"countryCode":"XK","centroid":{"latitude":42.5936,"longitude":20.8607},"boundingBox":{"north":43.2631,"south":41.844,"east":21.7728,"west":20.0248},"capital":{"latitude":42.6629,"longitude":21.1655}},
+{"countryCode":"YE","centroid":{"latitude":15.3282,"longitude":45.8744},"boundingBox":{"north":18.9956,"south":12.1114,"east":54.5403,"west":42.5457},"capital":{"latitude":15.3694,"longitude":44.191}},
+{"countryCode":"YT","centroid":{"latitude":-12.7767,"longitude":45.2769},"boundingBox":{"north":-12.6472,"south":-12.9892,"east":45.2909,"west":45.0425},"capital":{"latitude":-12.7833,"longitude":45.2333}},
+{"countryCode":"ZA","centroid":{"latitude":-29.7088,"longitude":23.6657},"boundingBox":{"north":-22.1265,"south":-46.9658,"east":37.9778,"west":16.47},"capital":{"latitude":-25.7479,"longitude":28.2293}},
+{"countryCode":"ZM","centroid":{"latitude":-14.6608,"longitude":26.3953},"boundingBox":{"north":-8.1941,"south":-18.0692,"east":33.6742,"west":21.9799},"capital":{"latitude":-15.4167,"longitude":28.2833}},
+{"countryCode":"ZW","centroid":{"latitude":-18.9116,"longitude":29.9254},"boundingBox":{"north":-15.6148,"south":-22.3973,"east":33.0428,"west":25.2194},"capital":{"latitude":-17.8333,"longitude":31.05}}
+]`
@@ -0,0 +1,267 @@
+package data
+
+// EXAMPLE DATA
+/*
+  {
+    "countryCode":"CA",
+    "languages":[{"code":"en","official":true},{"code":"fr","official":true}]
+  }
+*/
+
+// CountryLanguageJSONData is the raw JSON for the official and widely spoken languages of every country
+// Codes are ISO 639-1 codes, or ISO 639-3 codes for languages without one (e.g., "fil").
+// The most likely language of the country comes first, followed by the other official languages
+// and the widely spoken languages.
+// Sources: official languages from https://github.com/mledoze/countries, widely spoken languages
+// from the Unicode CLDR locales of each territory and the most likely language from the CLDR likely subtags
+// Antarctica (AQ) has no language and is omitted.
+const CountryLanguageJSONData = `[
+{"countryCode":"AF","languages":[{"code":"fa","official":false},{"code":"prs","official":true},{"code":"ps","official":true},{"code":"tk","official":true},{"code":"uz","official":false}]},
+{"countryCode":"AX","languages":[{"code":"sv","official":true}]},
+{"countryCode":"AL","languages":[{"code":"sq","official":true}]},
+{"countryCode":"DZ","languages":[{"code":"ar","official":true},{"code":"fr","official":false},{"code":"kab","official":false}]},
+{"countryCode":"AS","languages":[{"code":"sm","official":true},{"code":"en","official":true}]},
+{"countryCode":"AD","languages":[{"code":"ca","official":true}]},
+{"countryCode":"AO","languages":[{"code":"pt","official":true},{"code":"ln","official":false}]},
+{"countryCode":"AI","languages":[{"code":"en","official":true}]},
+{"countryCode":"AG","languages":[{"code":"en","official":true}]},
+{"countryCode":"AR","languages":[{"code":"es","official":true},{"code":"gn","official":true}]},
+{"countryCode":"AM","languages":[{"code":"hy","official":true},{"code":"ru","official":true}]},
+{"countryCode":"AW","languages":[{"code":"nl","official":true},{"code":"pap","official":true}]},
+{"countryCode":"AU","languages":[{"code":"en","official":true}]},
+{"countryCode":"AT","languages":[{"code":"de","official":true},{"code":"en","official":false}]},
+{"countryCode":"AZ","languages":[{"code":"az","official":true},{"code":"ru","official":true}]},
+{"countryCode":"BS","languages":[{"code":"en","official":true}]},
+{"countryCode":"BH","languages":[{"code":"ar","official":true}]},
+{"countryCode":"BD","languages":[{"code":"bn","official":true},{"code":"ccp","official":false}]},
+{"countryCode":"BB","languages":[{"code":"en","official":true}]},
+{"countryCode":"BY","languages":[{"code":"be","official":true},{"code":"ru","official":true}]},
+{"countryCode":"BE","languages":[{"code":"nl","official":true},{"code":"de","official":true},{"code":"fr","official":true},{"code":"en","official":false}]},
+{"countryCode":"BZ","languages":[{"code":"en","official":true},{"code":"bjz","official":true},{"code":"es","official":true}]},
+{"countryCode":"BJ","languages":[{"code":"fr","official":true},{"code":"yo","official":false}]},
+{"countryCode":"BM","languages":[{"code":"en","official":true}]},
+{"countryCode":"BT","languages":[{"code":"dz","official":true}]},
+{"countryCode":"BO","languages":[{"code":"es","official":true},{"code":"ay","official":true},{"code":"gn","official":true},{"code":"qu","official":true}]},
+{"countryCode":"BQ","languages":[{"code":"pap","official":false},{"code":"nl","official":true},{"code":"en","official":true}]},
+{"countryCode":"BA","languages":[{"code":"bs","official":true},{"code":"hr","official":true},{"code":"sr","official":true}]},
+{"countryCode":"BW","languages":[{"code":"en","official":true},{"code":"tn","official":true}]},
+{"countryCode":"BV","languages":[{"code":"no","official":true}]},
+{"countryCode":"BR","languages":[{"code":"pt","official":true},{"code":"es","official":false}]},
+{"countryCode":"IO","languages":[{"code":"en","official":true}]},
+{"countryCode":"BN","languages":[{"code":"ms","official":true}]},
+{"countryCode":"BG","languages":[{"code":"bg","official":true}]},
+{"countryCode":"BF","languages":[{"code":"fr","official":true},{"code":"ff","official":false}]},
+{"countryCode":"BI","languages":[{"code":"rn","official":true},{"code":"fr","official":true},{"code":"en","official":false}]},
+{"countryCode":"CV","languages":[{"code":"pt","official":true},{"code":"kea","official":false}]},
+{"countryCode":"KH","languages":[{"code":"km","official":true}]},
+{"countryCode":"CM","languages":[{"code":"fr","official":true},{"code":"en","official":true},{"code":"agq","official":false},{"code":"bas","official":false},{"code":"dua","official":false},{"code":"ewo","official":false},{"code":"ff","official":false},{"code":"jgo","official":false},{"code":"kkj","official":false},{"code":"ksf","official":false},{"code":"mgo","official":false},{"code":"mua","official":false},{"code":"nmg","official":false},{"code":"nnh","official":false},{"code":"yav","official":false}]},
+{"countryCode":"CA","languages":[{"code":"en","official":true},{"code":"fr","official":true}]},
+{"countryCode":"KY","languages":[{"code":"en","official":true}]},
+{"countryCode":"CF","languages":[{"code":"fr","official":true},{"code":"sg","official":true},{"code":"ln","official":false}]},
+{"countryCode":"TD","languages":[{"code":"fr","official":true},{"code":"ar","official":true}]},
+{"countryCode":"CL","languages":[{"code":"es","official":true}]},
+{"countryCode":"CN","languages":[{"code":"zh","official":true},{"code":"bo","official":false},{"code":"ii","official":false},{"code":"ug","official":false},{"code":"yue","official":false}]},
+{"countryCode":"CX","languages":[{"code":"en","official":true}]},
+{"countryCode":"CC","languages":[{"code":"en","official":true}]},
+{"countryCode":"CO","languages":[{"code":"es","official":true}]},
+{"countryCode":"KM","languages":[{"code":"ar","official":true},{"code":"fr","official":true},{"code":"zdj","official":true}]},
+{"countryCode":"CG","languages":[{"code":"fr","official":true},{"code":"kg","official":true},{"code":"ln","official":true}]},
+{"countryCode":"CD","languages":[{"code":"sw","official":true},{"code":"fr","official":true},{"code":"kg","official":true},{"code":"ln","official":true},{"code":"lua","official":true},{"code":"lu","official":false}]},
+{"countryCode":"CK","languages":[{"code":"en","official":true},{"code":"rar","official":true}]},
+{"countryCode":"CR","languages":[{"code":"es","official":true}]},
+{"countryCode":"CI","languages":[{"code":"fr","official":true}]},
+{"countryCode":"HR","languages":[{"code":"hr","official":true}]},
+{"countryCode":"CU","languages":[{"code":"es","official":true}]},
+{"countryCode":"CW","languages":[{"code":"pap","official":true},{"code":"en","official":true},{"code":"nl","official":true}]},
+{"countryCode":"CY","languages":[{"code":"el","official":true},{"code":"tr","official":true},{"code":"en","official":false}]},
+{"countryCode":"CZ","languages":[{"code":"cs","official":true},{"code":"sk","official":true}]},
+{"countryCode":"DK","languages":[{"code":"da","official":true},{"code":"en","official":false},{"code":"fo","official":false}]},
+{"countryCode":"DJ","languages":[{"code":"aa","official":false},{"code":"ar","official":true},{"code":"fr","official":true},{"code":"so","official":false}]},
+{"countryCode":"DM","languages":[{"code":"en","official":true}]},
+{"countryCode":"DO","languages":[{"code":"es","official":true}]},
+{"countryCode":"EC","languages":[{"code":"es","official":true},{"code":"qu","official":false}]},
+{"countryCode":"EG","languages":[{"code":"ar","official":true}]},
+{"countryCode":"SV","languages":[{"code":"es","official":true}]},
+{"countryCode":"GQ","languages":[{"code":"es","official":true},{"code":"fr","official":true},{"code":"pt","official":true}]},
+{"countryCode":"ER","languages":[{"code":"ti","official":true},{"code":"ar","official":true},{"code":"en","official":true}]},
+{"countryCode":"EE","languages":[{"code":"et","official":true}]},
+{"countryCode":"SZ","languages":[{"code":"en","official":true},{"code":"ss","official":true}]},
+{"countryCode":"ET","languages":[{"code":"am","official":true},{"code":"om","official":false},{"code":"so","official":false},{"code":"ti","official":false}]},
+{"countryCode":"FK","languages":[{"code":"en","official":true}]},
+{"countryCode":"FO","languages":[{"code":"fo","official":true},{"code":"da","official":true}]},
+{"countryCode":"FJ","languages":[{"code":"en","official":true},{"code":"fj","official":true},{"code":"hif","official":true}]},
+{"countryCode":"FI","languages":[{"code":"fi","official":true},{"code":"sv","official":true},{"code":"en","official":false},{"code":"se","official":false},{"code":"smn","official":false}]},
+{"countryCode":"FR","languages":[{"code":"fr","official":true},{"code":"br","official":false},{"code":"ca","official":false},{"code":"gsw","official":false}]},
+{"countryCode":"GF","languages":[{"code":"fr","official":true}]},
+{"countryCode":"PF","languages":[{"code":"fr","official":true}]},
+{"countryCode":"TF","languages":[{"code":"fr","official":true}]},
+{"countryCode":"GA","languages":[{"code":"fr","official":true}]},
+{"countryCode":"GM","languages":[{"code":"en","official":true},{"code":"ff","official":false}]},
+{"countryCode":"GE","languages":[{"code":"ka","official":true},{"code":"os","official":false}]},
+{"countryCode":"DE","languages":[{"code":"de","official":true},{"code":"dsb","official":false},{"code":"en","official":false},{"code":"hsb","official":false},{"code":"ksh","official":false},{"code":"nds","official":false}]},
+{"countryCode":"GH","languages":[{"code":"ak","official":false},{"code":"en","official":true},{"code":"ee","official":false},{"code":"ff","official":false},{"code":"ha","official":false}]},
+{"countryCode":"GI","languages":[{"code":"en","official":true}]},
+{"countryCode":"GR","languages":[{"code":"el","official":true}]},
+{"countryCode":"GL","languages":[{"code":"kl","official":true},{"code":"da","official":false}]},
+{"countryCode":"GD","languages":[{"code":"en","official":true}]},
+{"countryCode":"GP","languages":[{"code":"fr","official":true}]},
+{"countryCode":"GU","languages":[{"code":"en","official":true},{"code":"ch","official":true},{"code":"es","official":true}]},
+{"countryCode":"GT","languages":[{"code":"es","official":true}]},
+{"countryCode":"GG","languages":[{"code":"en","official":true},{"code":"fr","official":true},{"code":"nrf","official":true}]},
+{"countryCode":"GN","languages":[{"code":"fr","official":true},{"code":"ff","official":false}]},
+{"countryCode":"GW","languages":[{"code":"pt","official":true},{"code":"ff","official":false}]},
+{"countryCode":"GY","languages":[{"code":"en","official":true}]},
+{"countryCode":"HT","languages":[{"code":"ht","official":true},{"code":"fr","official":true}]},
+{"countryCode":"HM","languages":[{"code":"en","official":true}]},
+{"countryCode":"VA","languages":[{"code":"it","official":true},{"code":"la","official":true}]},
+{"countryCode":"HN","languages":[{"code":"es","official":true}]},
+{"countryCode":"HK","languages":[{"code":"zh","official":true},{"code":"en","official":true},{"code":"yue","official":false}]},
+{"countryCode":"HU","languages":[{"code":"hu","official":true}]},
+{"countryCode":"IS","languages":[{"code":"is","official":true}]},
+{"countryCode":"IN","languages":[{"code":"hi","official":true},{"code":"en","official":true},{"code":"ta","official":true},{"code":"as","official":false},{"code":"bn","official":false},{"code":"bo","official":false},{"code":"brx","official":false},{"code":"ccp","official":false},{"code":"gu","official":false},{"code":"kn","official":false},{"code":"kok","official":false},{"code":"ks","official":false},{"code":"ml","official":false},{"code":"mr","official":false},{"code":"ne","official":false},{"code":"or","official":false},{"code":"pa","official":false},{"code":"te","official":false},{"code":"ur","official":false}]},
+{"countryCode":"ID","languages":[{"code":"id","official":true},{"code":"jv","official":false}]},
+{"countryCode":"IR","languages":[{"code":"fa","official":true},{"code":"ckb","official":false},{"code":"lrc","official":false},{"code":"mzn","official":false}]},
+{"countryCode":"IQ","languages":[{"code":"ar","official":true},{"code":"arc","official":true},{"code":"ckb","official":true},{"code":"lrc","official":false}]},
+{"countryCode":"IE","languages":[{"code":"en","official":true},{"code":"ga","official":true}]},
+{"countryCode":"IM","languages":[{"code":"en","official":true},{"code":"gv","official":true}]},
+{"countryCode":"IL","languages":[{"code":"he","official":true},{"code":"ar","official":true},{"code":"en","official":false}]},
+{"countryCode":"IT","languages":[{"code":"it","official":true},{"code":"de","official":true},{"code":"sc","official":true},{"code":"ca","official":false},{"code":"fur","official":false}]},
+{"countryCode":"JM","languages":[{"code":"en","official":true},{"code":"jam","official":true}]},
+{"countryCode":"JP","languages":[{"code":"ja","official":true}]},
+{"countryCode":"JE","languages":[{"code":"en","official":true},{"code":"fr","official":true},{"code":"nrf","official":true}]},
+{"countryCode":"JO","languages":[{"code":"ar","official":true}]},
+{"countryCode":"KZ","languages":[{"code":"ru","official":true},{"code":"kk","official":true}]},
+{"countryCode":"KE","languages":[{"code":"sw","official":true},{"code":"en","official":true},{"code":"dav","official":false},{"code":"ebu","official":false},{"code":"guz","official":false},{"code":"kam","official":false},{"code":"ki","official":false},{"code":"kln","official":false},{"code":"luo","official":false},{"code":"luy","official":false},{"code":"mas","official":false},{"code":"mer","official":false},{"code":"om","official":false},{"code":"saq","official":false},{"code":"so","official":false},{"code":"teo","official":false}]},
+{"countryCode":"KI","languages":[{"code":"en","official":true},{"code":"gil","official":true}]},
+{"countryCode":"KP","languages":[{"code":"ko","official":true}]},
+{"countryCode":"KR","languages":[{"code":"ko","official":true}]},
+{"countryCode":"KW","languages":[{"code":"ar","official":true}]},
+{"countryCode":"KG","languages":[{"code":"ky","official":true},{"code":"ru","official":true}]},
+{"countryCode":"LA","languages":[{"code":"lo","official":true}]},
+{"countryCode":"LV","languages":[{"code":"lv","official":true}]},
+{"countryCode":"LB","languages":[{"code":"ar","official":true},{"code":"fr","official":true}]},
+{"countryCode":"LS","languages":[{"code":"st","official":true},{"code":"en","official":true}]},
+{"countryCode":"LR","languages":[{"code":"en","official":true},{"code":"ff","official":false},{"code":"vai","official":false}]},
+{"countryCode":"LY","languages":[{"code":"ar","official":true}]},
+{"countryCode":"LI","languages":[{"code":"de","official":true},{"code":"gsw","official":false}]},
+{"countryCode":"LT","languages":[{"code":"lt","official":true}]},
+{"countryCode":"LU","languages":[{"code":"fr","official":true},{"code":"de","official":true},{"code":"lb","official":true},{"code":"pt","official":false}]},
+{"countryCode":"MO","languages":[{"code":"zh","official":true},{"code":"pt","official":true},{"code":"en","official":false}]},
+{"countryCode":"MG","languages":[{"code":"mg","official":true},{"code":"fr","official":true},{"code":"en","official":false}]},
+{"countryCode":"MW","languages":[{"code":"en","official":true},{"code":"ny","official":true}]},
+{"countryCode":"MY","languages":[{"code":"ms","official":true},{"code":"en","official":true},{"code":"ta","official":false}]},
+{"countryCode":"MV","languages":[{"code":"dv","official":true}]},
+{"countryCode":"ML","languages":[{"code":"bm","official":false},{"code":"fr","official":true},{"code":"khq","official":false},{"code":"ses","official":false}]},
+{"countryCode":"MT","languages":[{"code":"mt","official":true},{"code":"en","official":true}]},
+{"countryCode":"MH","languages":[{"code":"en","official":true},{"code":"mh","official":true}]},
+{"countryCode":"MQ","languages":[{"code":"fr","official":true}]},
+{"countryCode":"MR","languages":[{"code":"ar","official":true},{"code":"ff","official":false},{"code":"fr","official":false}]},
+{"countryCode":"MU","languages":[{"code":"mfe","official":true},{"code":"en","official":true},{"code":"fr","official":true}]},
+{"countryCode":"YT","languages":[{"code":"fr","official":true}]},
+{"countryCode":"MX","languages":[{"code":"es","official":true}]},
+{"countryCode":"FM","languages":[{"code":"en","official":true}]},
+{"countryCode":"MD","languages":[{"code":"ro","official":true},{"code":"ru","official":false}]},
+{"countryCode":"MC","languages":[{"code":"fr","official":true}]},
+{"countryCode":"MN","languages":[{"code":"mn","official":true}]},
+{"countryCode":"ME","languages":[{"code":"sr","official":true}]},
+{"countryCode":"MS","languages":[{"code":"en","official":true}]},
+{"countryCode":"MA","languages":[{"code":"ar","official":true},{"code":"zgh","official":true},{"code":"fr","official":false},{"code":"shi","official":false},{"code":"tzm","official":false}]},
+{"countryCode":"MZ","languages":[{"code":"pt","official":true},{"code":"mgh","official":false},{"code":"seh","official":false}]},
+{"countryCode":"MM","languages":[{"code":"my","official":true}]},
+{"countryCode":"NA","languages":[{"code":"af","official":true},{"code":"de","official":true},{"code":"en","official":true},{"code":"hz","official":true},{"code":"hgm","official":true},{"code":"kwn","official":true},{"code":"loz","official":true},{"code":"ng","official":true},{"code":"tn","official":true},{"code":"naq","official":false}]},
+{"countryCode":"NR","languages":[{"code":"en","official":true},{"code":"na","official":true}]},
+{"countryCode":"NP","languages":[{"code":"ne","official":true}]},
+{"countryCode":"NL","languages":[{"code":"nl","official":true},{"code":"en","official":false},{"code":"fy","official":false},{"code":"nds","official":false}]},
+{"countryCode":"NC","languages":[{"code":"fr","official":true}]},
+{"countryCode":"NZ","languages":[{"code":"en","official":true},{"code":"mi","official":true},{"code":"nzs","official":true}]},
+{"countryCode":"NI","languages":[{"code":"es","official":true}]},
+{"countryCode":"NE","languages":[{"code":"ha","official":false},{"code":"fr","official":true},{"code":"dje","official":false},{"code":"ff","official":false},{"code":"twq","official":false}]},
+{"countryCode":"NG","languages":[{"code":"en","official":true},{"code":"ff","official":false},{"code":"ha","official":false},{"code":"ig","official":false},{"code":"yo","official":false}]},
+{"countryCode":"NU","languages":[{"code":"en","official":true},{"code":"niu","official":true}]},
+{"countryCode":"NF","languages":[{"code":"en","official":true},{"code":"pih","official":true}]},
+{"countryCode":"MK","languages":[{"code":"mk","official":true},{"code":"sq","official":false}]},
+{"countryCode":"MP","languages":[{"code":"en","official":true},{"code":"cal","official":true},{"code":"ch","official":true}]},
+{"countryCode":"NO","languages":[{"code":"nb","official":true},{"code":"nn","official":true},{"code":"se","official":true}]},
+{"countryCode":"OM","languages":[{"code":"ar","official":true}]},
+{"countryCode":"PK","languages":[{"code":"ur","official":true},{"code":"en","official":true},{"code":"pa","official":false},{"code":"ps","official":false},{"code":"sd","official":false}]},
+{"countryCode":"PW","languages":[{"code":"pau","official":true},{"code":"en","official":true}]},
+{"countryCode":"PS","languages":[{"code":"ar","official":true}]},
+{"countryCode":"PA","languages":[{"code":"es","official":true}]},
+{"countryCode":"PG","languages":[{"code":"tpi","official":true},{"code":"en","official":true},{"code":"ho","official":true}]},
+{"countryCode":"PY","languages":[{"code":"gn","official":true},{"code":"es","official":true}]},
+{"countryCode":"PE","languages":[{"code":"es","official":true},{"code":"ay","official":true},{"code":"qu","official":true}]},
+{"countryCode":"PH","languages":[{"code":"fil","official":true},{"code":"en","official":true},{"code":"ceb","official":false},{"code":"es","official":false}]},
+{"countryCode":"PN","languages":[{"code":"en","official":true}]},
+{"countryCode":"PL","languages":[{"code":"pl","official":true}]},
+{"countryCode":"PT","languages":[{"code":"pt","official":true}]},
+{"countryCode":"PR","languages":[{"code":"es","official":true},{"code":"en","official":true}]},
+{"countryCode":"QA","languages":[{"code":"ar","official":true}]},
+{"countryCode":"RE","languages":[{"code":"fr","official":true}]},
+{"countryCode":"RO","languages":[{"code":"ro","official":true}]},
+{"countryCode":"RU","languages":[{"code":"ru","official":true},{"code":"ce","official":false},{"code":"cu","official":false},{"code":"os","official":false},{"code":"sah","official":false},{"code":"tt","official":false}]},
+{"countryCode":"RW","languages":[{"code":"rw","official":true},{"code":"en","official":true},{"code":"fr","official":true}]},
+{"countryCode":"BL","languages":[{"code":"fr","official":true}]},
+{"countryCode":"SH","languages":[{"code":"en","official":true}]},
+{"countryCode":"KN","languages":[{"code":"en","official":true}]},
+{"countryCode":"LC","languages":[{"code":"en","official":true}]},
+{"countryCode":"MF","languages":[{"code":"fr","official":true}]},
+{"countryCode":"PM","languages":[{"code":"fr","official":true}]},
+{"countryCode":"VC","languages":[{"code":"en","official":true}]},
+{"countryCode":"WS","languages":[{"code":"sm","official":true},{"code":"en","official":true}]},
+{"countryCode":"SM","languages":[{"code":"it","official":true}]},
+{"countryCode":"ST","languages":[{"code":"pt","official":true}]},
+{"countryCode":"SA","languages":[{"code":"ar","official":true}]},
+{"countryCode":"SN","languages":[{"code":"fr","official":true},{"code":"dyo","official":false},{"code":"ff","official":false},{"code":"wo","official":false}]},
+{"countryCode":"RS","languages":[{"code":"sr","official":true}]},
+{"countryCode":"SC","languages":[{"code":"fr","official":true},{"code":"crs","official":true},{"code":"en","official":true}]},
+{"countryCode":"SL","languages":[{"code":"en","official":true},{"code":"ff","official":false}]},
+{"countryCode":"SG","languages":[{"code":"en","official":true},{"code":"zh","official":true},{"code":"ms","official":true},{"code":"ta","official":true}]},
+{"countryCode":"SX","languages":[{"code":"en","official":true},{"code":"nl","official":true}]},
+{"countryCode":"SK","languages":[{"code":"sk","official":true}]},
+{"countryCode":"SI","languages":[{"code":"sl","official":true},{"code":"en","official":false}]},
+{"countryCode":"SB","languages":[{"code":"en","official":true}]},
+{"countryCode":"SO","languages":[{"code":"so","official":true},{"code":"ar","official":true}]},
+{"countryCode":"ZA","languages":[{"code":"en","official":true},{"code":"af","official":true},{"code":"nr","official":true},{"code":"nso","official":true},{"code":"st","official":true},{"code":"ss","official":true},{"code":"tn","official":true},{"code":"ts","official":true},{"code":"ve","official":true},{"code":"xh","official":true},{"code":"zu","official":true}]},
+{"countryCode":"GS","languages":[{"code":"en","official":true}]},
+{"countryCode":"SS","languages":[{"code":"en","official":true},{"code":"ar","official":false},{"code":"nus","official":false}]},
+{"countryCode":"ES","languages":[{"code":"es","official":true},{"code":"ca","official":true},{"code":"eu","official":true},{"code":"gl","official":true},{"code":"oc","official":true},{"code":"ast","official":false}]},
+{"countryCode":"LK","languages":[{"code":"si","official":true},{"code":"ta","official":true}]},
+{"countryCode":"SD","languages":[{"code":"ar","official":true},{"code":"en","official":true}]},
+{"countryCode":"SR","languages":[{"code":"nl","official":true}]},
+{"countryCode":"SJ","languages":[{"code":"nb","official":false},{"code":"no","official":true}]},
+{"countryCode":"SE","languages":[{"code":"sv","official":true},{"code":"en","official":false},{"code":"se","official":false}]},
+{"countryCode":"CH","languages":[{"code":"de","official":true},{"code":"fr","official":true},{"code":"gsw","official":true},{"code":"it","official":true},{"code":"rm","official":true},{"code":"en","official":false},{"code":"pt","official":false},{"code":"wae","official":false}]},
+{"countryCode":"SY","languages":[{"code":"ar","official":true},{"code":"fr","official":false}]},
+{"countryCode":"TW","languages":[{"code":"zh","official":true}]},
+{"countryCode":"TJ","languages":[{"code":"tg","official":true},{"code":"ru","official":true}]},
+{"countryCode":"TZ","languages":[{"code":"sw","official":true},{"code":"en","official":true},{"code":"asa","official":false},{"code":"bez","official":false},{"code":"jmc","official":false},{"code":"kde","official":false},{"code":"ksb","official":false},{"code":"lag","official":false},{"code":"mas","official":false},{"code":"rof","official":false},{"code":"rwk","official":false},{"code":"sbp","official":false},{"code":"vun","official":false}]},
+{"countryCode":"TH","languages":[{"code":"th","official":true}]},
+{"countryCode":"TL","languages":[{"code":"pt","official":true},{"code":"tet","official":true}]},
+{"countryCode":"TG","languages":[{"code":"fr","official":true},{"code":"ee","official":false}]},
+{"countryCode":"TK","languages":[{"code":"tkl","official":true},{"code":"en","official":true},{"code":"sm","official":true}]},
+{"countryCode":"TO","languages":[{"code":"to","official":true},{"code":"en","official":true}]},
+{"countryCode":"TT","languages":[{"code":"en","official":true}]},
+{"countryCode":"TN","languages":[{"code":"ar","official":true},{"code":"fr","official":false}]},
+{"countryCode":"TR","languages":[{"code":"tr","official":true},{"code":"ku","official":false}]},
+{"countryCode":"TM","languages":[{"code":"tk","official":true},{"code":"ru","official":true}]},
+{"countryCode":"TC","languages":[{"code":"en","official":true}]},
+{"countryCode":"TV","languages":[{"code":"tvl","official":true},{"code":"en","official":true}]},
+{"countryCode":"UG","languages":[{"code":"sw","official":true},{"code":"en","official":true},{"code":"cgg","official":false},{"code":"lg","official":false},{"code":"nyn","official":false},{"code":"teo","official":false},{"code":"xog","official":false}]},
+{"countryCode":"UA","languages":[{"code":"uk","official":true},{"code":"ru","official":true}]},
+{"countryCode":"AE","languages":[{"code":"ar","official":true},{"code":"en","official":false}]},
+{"countryCode":"GB","languages":[{"code":"en","official":true},{"code":"cy","official":false},{"code":"ga","official":false},{"code":"gd","official":false},{"code":"kw","official":false}]},
+{"countryCode":"US","languages":[{"code":"en","official":true},{"code":"chr","official":false},{"code":"es","official":false},{"code":"haw","official":false},{"code":"lkt","official":false}]},
+{"countryCode":"UM","languages":[{"code":"en","official":true}]},
+{"countryCode":"UY","languages":[{"code":"es","official":true}]},
+{"countryCode":"UZ","languages":[{"code":"uz","official":true},{"code":"ru","official":true}]},
+{"countryCode":"VU","languages":[{"code":"bi","official":true},{"code":"en","official":true},{"code":"fr","official":true}]},
+{"countryCode":"VE","languages":[{"code":"es","official":true}]},
+{"countryCode":"VN","languages":[{"code":"vi","official":true}]},
+{"countryCode":"VG","languages":[{"code":"en","official":true}]},
+{"countryCode":"VI","languages":[{"code":"en","official":true}]},
+{"countryCode":"WF","languages":[{"code":"fr","official":true}]},
+{"countryCode":"EH","languages":[{"code":"ar","official":false},{"code":"zgh","official":true},{"code":"mey","official":true},{"code":"es","official":true}]},
+{"countryCode":"YE","languages":[{"code":"ar","official":true}]},
+{"countryCode":"ZM","languages":[{"code":"en","official":true},{"code":"bem","official":false}]},
+{"countryCode":"ZW","languages":[{"code":"sn","official":true},{"code":"bwg","official":true},{"code":"en","official":true},{"code":"kck","official":true},{"code":"ndc","official":true},{"code":"nd","official":true},{"code":"ny","official":true},{"code":"st","official":true},{"code":"toi","official":true},{"code":"tn","official":true},{"code":"ts","official":true},{"code":"ve","official":true},{"code":"xh","official":true},{"code":"zib","official":true}]}
+]`
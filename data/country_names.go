@@ -0,0 +1,271 @@
+package data
+
+// EXAMPLE DATA
+/*
+  {
+    "countryCode":"KR",
+    "commonName":"South Korea",
+    "officialName":"Republic of Korea",
+    "aliases":["Korea (Republic of)","Korea, South"]
+  }
+*/
+
+// CountryNameJSONData is the raw JSON for the common name, official name and aliases of every country
+// The common name is the short English name in everyday use (e.g., "Bolivia") and the official name is the
+// formal English name of the state (e.g., "Plurinational State of Bolivia").
+// Aliases are other English names found in the wild, never repeating the ISO, common or official names:
+// abbreviations (e.g., "UK"), ISO 3166 and UN variants (e.g., "Iran, Islamic Republic of"), spellings
+// without diacritics (e.g., "Curacao") and former names (e.g., "Swaziland").
+// Source: mledoze/countries (via pariz/gountries) for the common and official names, updated for Czechia,
+// Eswatini, North Macedonia and Türkiye, and a curated list of aliases.
+const CountryNameJSONData = `[
+{"countryCode":"AD","commonName":"Andorra","officialName":"Principality of Andorra"},
+{"countryCode":"AE","commonName":"United Arab Emirates","officialName":"United Arab Emirates","aliases":["UAE","Emirates"]},
+{"countryCode":"AF","commonName":"Afghanistan","officialName":"Islamic Republic of Afghanistan"},
+{"countryCode":"AG","commonName":"Antigua and Barbuda","officialName":"Antigua and Barbuda","aliases":["Antigua"]},
+{"countryCode":"AI","commonName":"Anguilla","officialName":"Anguilla"},
+{"countryCode":"AL","commonName":"Albania","officialName":"Republic of Albania"},
+{"countryCode":"AM","commonName":"Armenia","officialName":"Republic of Armenia"},
+{"countryCode":"AO","commonName":"Angola","officialName":"Republic of Angola"},
+{"countryCode":"AQ","commonName":"Antarctica","officialName":"Antarctica"},
+{"countryCode":"AR","commonName":"Argentina","officialName":"Argentine Republic"},
+{"countryCode":"AS","commonName":"American Samoa","officialName":"American Samoa"},
+{"countryCode":"AT","commonName":"Austria","officialName":"Republic of Austria"},
+{"countryCode":"AU","commonName":"Australia","officialName":"Commonwealth of Australia"},
+{"countryCode":"AW","commonName":"Aruba","officialName":"Aruba"},
+{"countryCode":"AX","commonName":"Åland Islands","officialName":"Åland Islands","aliases":["Aland Islands","Aland"]},
+{"countryCode":"AZ","commonName":"Azerbaijan","officialName":"Republic of Azerbaijan"},
+{"countryCode":"BA","commonName":"Bosnia and Herzegovina","officialName":"Bosnia and Herzegovina","aliases":["Bosnia","Bosnia-Herzegovina","Bosnia & Herzegovina"]},
+{"countryCode":"BB","commonName":"Barbados","officialName":"Barbados"},
+{"countryCode":"BD","commonName":"Bangladesh","officialName":"People's Republic of Bangladesh"},
+{"countryCode":"BE","commonName":"Belgium","officialName":"Kingdom of Belgium"},
+{"countryCode":"BF","commonName":"Burkina Faso","officialName":"Burkina Faso","aliases":["Upper Volta"]},
+{"countryCode":"BG","commonName":"Bulgaria","officialName":"Republic of Bulgaria"},
+{"countryCode":"BH","commonName":"Bahrain","officialName":"Kingdom of Bahrain"},
+{"countryCode":"BI","commonName":"Burundi","officialName":"Republic of Burundi"},
+{"countryCode":"BJ","commonName":"Benin","officialName":"Republic of Benin","aliases":["Dahomey"]},
+{"countryCode":"BL","commonName":"Saint Barthélemy","officialName":"Collectivity of Saint Barthélemy","aliases":["Saint Barthelemy","St. Barts","Saint Barts"]},
+{"countryCode":"BM","commonName":"Bermuda","officialName":"Bermuda"},
+{"countryCode":"BN","commonName":"Brunei","officialName":"Nation of Brunei, Abode of Peace"},
+{"countryCode":"BO","commonName":"Bolivia","officialName":"Plurinational State of Bolivia","aliases":["Bolivia, Plurinational State of"]},
+{"countryCode":"BQ","commonName":"Caribbean Netherlands","officialName":"Bonaire, Sint Eustatius and Saba","aliases":["Bonaire","Bonaire, Saint Eustatius and Saba"]},
+{"countryCode":"BR","commonName":"Brazil","officialName":"Federative Republic of Brazil"},
+{"countryCode":"BS","commonName":"Bahamas","officialName":"Commonwealth of the Bahamas","aliases":["The Bahamas","Bahamas, The"]},
+{"countryCode":"BT","commonName":"Bhutan","officialName":"Kingdom of Bhutan"},
+{"countryCode":"BV","commonName":"Bouvet Island","officialName":"Bouvet Island"},
+{"countryCode":"BW","commonName":"Botswana","officialName":"Republic of Botswana","aliases":["Bechuanaland"]},
+{"countryCode":"BY","commonName":"Belarus","officialName":"Republic of Belarus","aliases":["Byelorussia"]},
+{"countryCode":"BZ","commonName":"Belize","officialName":"Belize"},
+{"countryCode":"CA","commonName":"Canada","officialName":"Canada"},
+{"countryCode":"CC","commonName":"Cocos (Keeling) Islands","officialName":"Territory of the Cocos (Keeling) Islands"},
+{"countryCode":"CD","commonName":"DR Congo","officialName":"Democratic Republic of the Congo","aliases":["DRC","Congo-Kinshasa","Congo, The Democratic Republic of the","Congo (Democratic Republic of the)","Democratic Republic of Congo","Zaire"]},
+{"countryCode":"CF","commonName":"Central African Republic","officialName":"Central African Republic"},
+{"countryCode":"CG","commonName":"Republic of the Congo","officialName":"Republic of the Congo","aliases":["Congo-Brazzaville","Congo Republic","Republic of Congo"]},
+{"countryCode":"CH","commonName":"Switzerland","officialName":"Swiss Confederation"},
+{"countryCode":"CI","commonName":"Ivory Coast","officialName":"Republic of Côte d'Ivoire","aliases":["Cote d'Ivoire"]},
+{"countryCode":"CK","commonName":"Cook Islands","officialName":"Cook Islands"},
+{"countryCode":"CL","commonName":"Chile","officialName":"Republic of Chile"},
+{"countryCode":"CM","commonName":"Cameroon","officialName":"Republic of Cameroon"},
+{"countryCode":"CN","commonName":"China","officialName":"People's Republic of China"},
+{"countryCode":"CO","commonName":"Colombia","officialName":"Republic of Colombia"},
+{"countryCode":"CR","commonName":"Costa Rica","officialName":"Republic of Costa Rica"},
+{"countryCode":"CU","commonName":"Cuba","officialName":"Republic of Cuba"},
+{"countryCode":"CV","commonName":"Cape Verde","officialName":"Republic of Cabo Verde"},
+{"countryCode":"CW","commonName":"Curaçao","officialName":"Country of Curaçao","aliases":["Curacao"]},
+{"countryCode":"CX","commonName":"Christmas Island","officialName":"Territory of Christmas Island"},
+{"countryCode":"CY","commonName":"Cyprus","officialName":"Republic of Cyprus"},
+{"countryCode":"CZ","commonName":"Czechia","officialName":"Czech Republic"},
+{"countryCode":"DE","commonName":"Germany","officialName":"Federal Republic of Germany","aliases":["Deutschland"]},
+{"countryCode":"DJ","commonName":"Djibouti","officialName":"Republic of Djibouti"},
+{"countryCode":"DK","commonName":"Denmark","officialName":"Kingdom of Denmark"},
+{"countryCode":"DM","commonName":"Dominica","officialName":"Commonwealth of Dominica"},
+{"countryCode":"DO","commonName":"Dominican Republic","officialName":"Dominican Republic"},
+{"countryCode":"DZ","commonName":"Algeria","officialName":"People's Democratic Republic of Algeria"},
+{"countryCode":"EC","commonName":"Ecuador","officialName":"Republic of Ecuador"},
+{"countryCode":"EE","commonName":"Estonia","officialName":"Republic of Estonia"},
+{"countryCode":"EG","commonName":"Egypt","officialName":"Arab Republic of Egypt"},
+{"countryCode":"EH","commonName":"Western Sahara","officialName":"Western Sahara"},
+{"countryCode":"ER","commonName":"Eritrea","officialName":"State of Eritrea"},
+{"countryCode":"ES","commonName":"Spain","officialName":"Kingdom of Spain"},
+{"countryCode":"ET","commonName":"Ethiopia","officialName":"Federal Democratic Republic of Ethiopia"},
+{"countryCode":"FI","commonName":"Finland","officialName":"Republic of Finland"},
+{"countryCode":"FJ","commonName":"Fiji","officialName":"Republic of Fiji"},
+{"countryCode":"FK","commonName":"Falkland Islands","officialName":"Falkland Islands","aliases":["Falklands","Malvinas"]},
+{"countryCode":"FM","commonName":"Micronesia","officialName":"Federated States of Micronesia","aliases":["Micronesia, Federated States of"]},
+{"countryCode":"FO","commonName":"Faroe Islands","officialName":"Faroe Islands"},
+{"countryCode":"FR","commonName":"France","officialName":"French Republic"},
+{"countryCode":"GA","commonName":"Gabon","officialName":"Gabonese Republic"},
+{"countryCode":"GB","commonName":"United Kingdom","officialName":"United Kingdom of Great Britain and Northern Ireland","aliases":["UK","U.K.","Great Britain","Britain","England","Scotland","Wales","Northern Ireland"]},
+{"countryCode":"GD","commonName":"Grenada","officialName":"Grenada"},
+{"countryCode":"GE","commonName":"Georgia","officialName":"Georgia"},
+{"countryCode":"GF","commonName":"French Guiana","officialName":"French Guiana"},
+{"countryCode":"GG","commonName":"Guernsey","officialName":"Bailiwick of Guernsey"},
+{"countryCode":"GH","commonName":"Ghana","officialName":"Republic of Ghana"},
+{"countryCode":"GI","commonName":"Gibraltar","officialName":"Gibraltar"},
+{"countryCode":"GL","commonName":"Greenland","officialName":"Greenland"},
+{"countryCode":"GM","commonName":"Gambia","officialName":"Republic of the Gambia","aliases":["The Gambia","Gambia, The"]},
+{"countryCode":"GN","commonName":"Guinea","officialName":"Republic of Guinea"},
+{"countryCode":"GP","commonName":"Guadeloupe","officialName":"Guadeloupe"},
+{"countryCode":"GQ","commonName":"Equatorial Guinea","officialName":"Republic of Equatorial Guinea"},
+{"countryCode":"GR","commonName":"Greece","officialName":"Hellenic Republic"},
+{"countryCode":"GS","commonName":"South Georgia","officialName":"South Georgia and the South Sandwich Islands","aliases":["South Georgia and South Sandwich Islands"]},
+{"countryCode":"GT","commonName":"Guatemala","officialName":"Republic of Guatemala"},
+{"countryCode":"GU","commonName":"Guam","officialName":"Guam"},
+{"countryCode":"GW","commonName":"Guinea-Bissau","officialName":"Republic of Guinea-Bissau","aliases":["Guinea Bissau"]},
+{"countryCode":"GY","commonName":"Guyana","officialName":"Co-operative Republic of Guyana"},
+{"countryCode":"HK","commonName":"Hong Kong","officialName":"Hong Kong Special Administrative Region of the People's Republic of China","aliases":["Hong Kong SAR","Hong Kong, China"]},
+{"countryCode":"HM","commonName":"Heard Island and McDonald Islands","officialName":"Heard Island and McDonald Islands","aliases":["Heard and McDonald Islands"]},
+{"countryCode":"HN","commonName":"Honduras","officialName":"Republic of Honduras"},
+{"countryCode":"HR","commonName":"Croatia","officialName":"Republic of Croatia"},
+{"countryCode":"HT","commonName":"Haiti","officialName":"Republic of Haiti"},
+{"countryCode":"HU","commonName":"Hungary","officialName":"Hungary"},
+{"countryCode":"ID","commonName":"Indonesia","officialName":"Republic of Indonesia"},
+{"countryCode":"IE","commonName":"Ireland","officialName":"Ireland","aliases":["Republic of Ireland","Eire"]},
+{"countryCode":"IL","commonName":"Israel","officialName":"State of Israel"},
+{"countryCode":"IM","commonName":"Isle of Man","officialName":"Isle of Man"},
+{"countryCode":"IN","commonName":"India","officialName":"Republic of India"},
+{"countryCode":"IO","commonName":"British Indian Ocean Territory","officialName":"British Indian Ocean Territory"},
+{"countryCode":"IQ","commonName":"Iraq","officialName":"Republic of Iraq"},
+{"countryCode":"IR","commonName":"Iran","officialName":"Islamic Republic of Iran","aliases":["Iran, Islamic Republic of","Persia"]},
+{"countryCode":"IS","commonName":"Iceland","officialName":"Iceland"},
+{"countryCode":"IT","commonName":"Italy","officialName":"Italian Republic"},
+{"countryCode":"JE","commonName":"Jersey","officialName":"Bailiwick of Jersey"},
+{"countryCode":"JM","commonName":"Jamaica","officialName":"Jamaica"},
+{"countryCode":"JO","commonName":"Jordan","officialName":"Hashemite Kingdom of Jordan"},
+{"countryCode":"JP","commonName":"Japan","officialName":"Japan"},
+{"countryCode":"KE","commonName":"Kenya","officialName":"Republic of Kenya"},
+{"countryCode":"KG","commonName":"Kyrgyzstan","officialName":"Kyrgyz Republic","aliases":["Kirghizia"]},
+{"countryCode":"KH","commonName":"Cambodia","officialName":"Kingdom of Cambodia","aliases":["Kampuchea"]},
+{"countryCode":"KI","commonName":"Kiribati","officialName":"Independent and Sovereign Republic of Kiribati"},
+{"countryCode":"KM","commonName":"Comoros","officialName":"Union of the Comoros","aliases":["Comoro Islands"]},
+{"countryCode":"KN","commonName":"Saint Kitts and Nevis","officialName":"Federation of Saint Christopher and Nevis","aliases":["St. Kitts and Nevis","Saint Kitts","St Kitts and Nevis"]},
+{"countryCode":"KP","commonName":"North Korea","officialName":"Democratic People's Republic of Korea","aliases":["Korea, Democratic People's Republic of","DPRK"]},
+{"countryCode":"KR","commonName":"South Korea","officialName":"Republic of Korea","aliases":["Korea (Republic of)","Korea, South"]},
+{"countryCode":"KW","commonName":"Kuwait","officialName":"State of Kuwait"},
+{"countryCode":"KY","commonName":"Cayman Islands","officialName":"Cayman Islands"},
+{"countryCode":"KZ","commonName":"Kazakhstan","officialName":"Republic of Kazakhstan"},
+{"countryCode":"LA","commonName":"Laos","officialName":"Lao People's Democratic Republic","aliases":["Lao PDR"]},
+{"countryCode":"LB","commonName":"Lebanon","officialName":"Lebanese Republic"},
+{"countryCode":"LC","commonName":"Saint Lucia","officialName":"Saint Lucia","aliases":["St. Lucia","St Lucia"]},
+{"countryCode":"LI","commonName":"Liechtenstein","officialName":"Principality of Liechtenstein"},
+{"countryCode":"LK","commonName":"Sri Lanka","officialName":"Democratic Socialist Republic of Sri Lanka","aliases":["Ceylon"]},
+{"countryCode":"LR","commonName":"Liberia","officialName":"Republic of Liberia"},
+{"countryCode":"LS","commonName":"Lesotho","officialName":"Kingdom of Lesotho"},
+{"countryCode":"LT","commonName":"Lithuania","officialName":"Republic of Lithuania"},
+{"countryCode":"LU","commonName":"Luxembourg","officialName":"Grand Duchy of Luxembourg"},
+{"countryCode":"LV","commonName":"Latvia","officialName":"Republic of Latvia"},
+{"countryCode":"LY","commonName":"Libya","officialName":"State of Libya"},
+{"countryCode":"MA","commonName":"Morocco","officialName":"Kingdom of Morocco"},
+{"countryCode":"MC","commonName":"Monaco","officialName":"Principality of Monaco"},
+{"countryCode":"MD","commonName":"Moldova","officialName":"Republic of Moldova"},
+{"countryCode":"ME","commonName":"Montenegro","officialName":"Montenegro"},
+{"countryCode":"MF","commonName":"Saint Martin","officialName":"Saint Martin","aliases":["St. Martin"]},
+{"countryCode":"MG","commonName":"Madagascar","officialName":"Republic of Madagascar"},
+{"countryCode":"MH","commonName":"Marshall Islands","officialName":"Republic of the Marshall Islands"},
+{"countryCode":"MK","commonName":"North Macedonia","officialName":"Republic of North Macedonia","aliases":["Macedonia","Macedonia, the Former Yugoslav Republic of","FYROM"]},
+{"countryCode":"ML","commonName":"Mali","officialName":"Republic of Mali"},
+{"countryCode":"MM","commonName":"Myanmar","officialName":"Republic of the Union of Myanmar","aliases":["Burma"]},
+{"countryCode":"MN","commonName":"Mongolia","officialName":"Mongolia"},
+{"countryCode":"MO","commonName":"Macau","officialName":"Macao Special Administrative Region of the People's Republic of China","aliases":["Macao SAR","Macau, China"]},
+{"countryCode":"MP","commonName":"Northern Mariana Islands","officialName":"Commonwealth of the Northern Mariana Islands"},
+{"countryCode":"MQ","commonName":"Martinique","officialName":"Martinique"},
+{"countryCode":"MR","commonName":"Mauritania","officialName":"Islamic Republic of Mauritania"},
+{"countryCode":"MS","commonName":"Montserrat","officialName":"Montserrat"},
+{"countryCode":"MT","commonName":"Malta","officialName":"Republic of Malta"},
+{"countryCode":"MU","commonName":"Mauritius","officialName":"Republic of Mauritius"},
+{"countryCode":"MV","commonName":"Maldives","officialName":"Republic of the Maldives"},
+{"countryCode":"MW","commonName":"Malawi","officialName":"Republic of Malawi"},
+{"countryCode":"MX","commonName":"Mexico","officialName":"United Mexican States"},
+{"countryCode":"MY","commonName":"Malaysia","officialName":"Malaysia"},
+{"countryCode":"MZ","commonName":"Mozambique","officialName":"Republic of Mozambique"},
+{"countryCode":"NA","commonName":"Namibia","officialName":"Republic of Namibia"},
+{"countryCode":"NC","commonName":"New Caledonia","officialName":"New Caledonia"},
+{"countryCode":"NE","commonName":"Niger","officialName":"Republic of Niger"},
+{"countryCode":"NF","commonName":"Norfolk Island","officialName":"Territory of Norfolk Island"},
+{"countryCode":"NG","commonName":"Nigeria","officialName":"Federal Republic of Nigeria"},
+{"countryCode":"NI","commonName":"Nicaragua","officialName":"Republic of Nicaragua"},
+{"countryCode":"NL","commonName":"Netherlands","officialName":"Netherlands","aliases":["Holland","The Netherlands"]},
+{"countryCode":"NO","commonName":"Norway","officialName":"Kingdom of Norway"},
+{"countryCode":"NP","commonName":"Nepal","officialName":"Federal Democratic Republic of Nepal"},
+{"countryCode":"NR","commonName":"Nauru","officialName":"Republic of Nauru"},
+{"countryCode":"NU","commonName":"Niue","officialName":"Niue"},
+{"countryCode":"NZ","commonName":"New Zealand","officialName":"New Zealand","aliases":["Aotearoa"]},
+{"countryCode":"OM","commonName":"Oman","officialName":"Sultanate of Oman"},
+{"countryCode":"PA","commonName":"Panama","officialName":"Republic of Panama"},
+{"countryCode":"PE","commonName":"Peru","officialName":"Republic of Peru"},
+{"countryCode":"PF","commonName":"French Polynesia","officialName":"French Polynesia"},
+{"countryCode":"PG","commonName":"Papua New Guinea","officialName":"Independent State of Papua New Guinea","aliases":["PNG"]},
+{"countryCode":"PH","commonName":"Philippines","officialName":"Republic of the Philippines"},
+{"countryCode":"PK","commonName":"Pakistan","officialName":"Islamic Republic of Pakistan"},
+{"countryCode":"PL","commonName":"Poland","officialName":"Republic of Poland"},
+{"countryCode":"PM","commonName":"Saint Pierre and Miquelon","officialName":"Saint Pierre and Miquelon","aliases":["St. Pierre and Miquelon"]},
+{"countryCode":"PN","commonName":"Pitcairn Islands","officialName":"Pitcairn, Henderson, Ducie and Oeno Islands"},
+{"countryCode":"PR","commonName":"Puerto Rico","officialName":"Commonwealth of Puerto Rico"},
+{"countryCode":"PS","commonName":"Palestine","officialName":"State of Palestine","aliases":["Palestinian Territories"]},
+{"countryCode":"PT","commonName":"Portugal","officialName":"Portuguese Republic"},
+{"countryCode":"PW","commonName":"Palau","officialName":"Republic of Palau"},
+{"countryCode":"PY","commonName":"Paraguay","officialName":"Republic of Paraguay"},
+{"countryCode":"QA","commonName":"Qatar","officialName":"State of Qatar"},
+{"countryCode":"RE","commonName":"Réunion","officialName":"Réunion"},
+{"countryCode":"RO","commonName":"Romania","officialName":"Romania"},
+{"countryCode":"RS","commonName":"Serbia","officialName":"Republic of Serbia"},
+{"countryCode":"RU","commonName":"Russia","officialName":"Russian Federation"},
+{"countryCode":"RW","commonName":"Rwanda","officialName":"Republic of Rwanda"},
+{"countryCode":"SA","commonName":"Saudi Arabia","officialName":"Kingdom of Saudi Arabia"},
+{"countryCode":"SB","commonName":"Solomon Islands","officialName":"Solomon Islands"},
+{"countryCode":"SC","commonName":"Seychelles","officialName":"Republic of Seychelles"},
+{"countryCode":"SD","commonName":"Sudan","officialName":"Republic of the Sudan"},
+{"countryCode":"SE","commonName":"Sweden","officialName":"Kingdom of Sweden"},
+{"countryCode":"SG","commonName":"Singapore","officialName":"Republic of Singapore"},
+{"countryCode":"SH","commonName":"Saint Helena","officialName":"Saint Helena, Ascension and Tristan da Cunha","aliases":["St. Helena"]},
+{"countryCode":"SI","commonName":"Slovenia","officialName":"Republic of Slovenia"},
+{"countryCode":"SJ","commonName":"Svalbard and Jan Mayen","officialName":"Svalbard and Jan Mayen","aliases":["Svalbard"]},
+{"countryCode":"SK","commonName":"Slovakia","officialName":"Slovak Republic"},
+{"countryCode":"SL","commonName":"Sierra Leone","officialName":"Republic of Sierra Leone"},
+{"countryCode":"SM","commonName":"San Marino","officialName":"Most Serene Republic of San Marino"},
+{"countryCode":"SN","commonName":"Senegal","officialName":"Republic of Senegal"},
+{"countryCode":"SO","commonName":"Somalia","officialName":"Federal Republic of Somalia"},
+{"countryCode":"SR","commonName":"Suriname","officialName":"Republic of Suriname"},
+{"countryCode":"SS","commonName":"South Sudan","officialName":"Republic of South Sudan"},
+{"countryCode":"ST","commonName":"São Tomé and Príncipe","officialName":"Democratic Republic of São Tomé and Príncipe","aliases":["Sao Tome"]},
+{"countryCode":"SV","commonName":"El Salvador","officialName":"Republic of El Salvador"},
+{"countryCode":"SX","commonName":"Sint Maarten","officialName":"Sint Maarten"},
+{"countryCode":"SY","commonName":"Syria","officialName":"Syrian Arab Republic"},
+{"countryCode":"SZ","commonName":"Eswatini","officialName":"Kingdom of Eswatini","aliases":["Swaziland"]},
+{"countryCode":"TC","commonName":"Turks and Caicos Islands","officialName":"Turks and Caicos Islands","aliases":["Turks and Caicos"]},
+{"countryCode":"TD","commonName":"Chad","officialName":"Republic of Chad"},
+{"countryCode":"TF","commonName":"French Southern and Antarctic Lands","officialName":"Territory of the French Southern and Antarctic Lands"},
+{"countryCode":"TG","commonName":"Togo","officialName":"Togolese Republic"},
+{"countryCode":"TH","commonName":"Thailand","officialName":"Kingdom of Thailand","aliases":["Siam"]},
+{"countryCode":"TJ","commonName":"Tajikistan","officialName":"Republic of Tajikistan"},
+{"countryCode":"TK","commonName":"Tokelau","officialName":"Tokelau"},
+{"countryCode":"TL","commonName":"Timor-Leste","officialName":"Democratic Republic of Timor-Leste","aliases":["East Timor"]},
+{"countryCode":"TM","commonName":"Turkmenistan","officialName":"Turkmenistan"},
+{"countryCode":"TN","commonName":"Tunisia","officialName":"Tunisian Republic"},
+{"countryCode":"TO","commonName":"Tonga","officialName":"Kingdom of Tonga"},
+{"countryCode":"TR","commonName":"Turkey","officialName":"Republic of Türkiye","aliases":["Türkiye","Turkiye"]},
+{"countryCode":"TT","commonName":"Trinidad and Tobago","officialName":"Republic of Trinidad and Tobago","aliases":["Trinidad","Trinidad & Tobago"]},
+{"countryCode":"TV","commonName":"Tuvalu","officialName":"Tuvalu"},
+{"countryCode":"TW","commonName":"Taiwan","officialName":"Republic of China","aliases":["Chinese Taipei"]},
+{"countryCode":"TZ","commonName":"Tanzania","officialName":"United Republic of Tanzania"},
+{"countryCode":"UA","commonName":"Ukraine","officialName":"Ukraine"},
+{"countryCode":"UG","commonName":"Uganda","officialName":"Republic of Uganda"},
+{"countryCode":"UM","commonName":"United States Minor Outlying Islands","officialName":"United States Minor Outlying Islands","aliases":["US Minor Outlying Islands"]},
+{"countryCode":"US","commonName":"United States","officialName":"United States of America","aliases":["USA","US","U.S.","U.S.A.","America"]},
+{"countryCode":"UY","commonName":"Uruguay","officialName":"Oriental Republic of Uruguay"},
+{"countryCode":"UZ","commonName":"Uzbekistan","officialName":"Republic of Uzbekistan"},
+{"countryCode":"VA","commonName":"Vatican City","officialName":"Vatican City State","aliases":["Vatican","Holy See (Vatican City State)"]},
+{"countryCode":"VC","commonName":"Saint Vincent and the Grenadines","officialName":"Saint Vincent and the Grenadines","aliases":["St. Vincent and the Grenadines","Saint Vincent"]},
+{"countryCode":"VE","commonName":"Venezuela","officialName":"Bolivarian Republic of Venezuela","aliases":["Venezuela, Bolivarian Republic of"]},
+{"countryCode":"VG","commonName":"British Virgin Islands","officialName":"Virgin Islands","aliases":["BVI","Virgin Islands, British"]},
+{"countryCode":"VI","commonName":"United States Virgin Islands","officialName":"Virgin Islands of the United States","aliases":["USVI","US Virgin Islands","U.S. Virgin Islands","Virgin Islands, U.S."]},
+{"countryCode":"VN","commonName":"Vietnam","officialName":"Socialist Republic of Vietnam"},
+{"countryCode":"VU","commonName":"Vanuatu","officialName":"Republic of Vanuatu"},
+{"countryCode":"WF","commonName":"Wallis and Futuna","officialName":"Territory of the Wallis and Futuna Islands","aliases":["Wallis and Futuna Islands"]},
+{"countryCode":"WS","commonName":"Samoa","officialName":"Independent State of Samoa"},
+{"countryCode":"YE","commonName":"Yemen","officialName":"Republic of Yemen"},
+{"countryCode":"YT","commonName":"Mayotte","officialName":"Department of Mayotte"},
+{"countryCode":"ZA","commonName":"South Africa","officialName":"Republic of South Africa"},
+{"countryCode":"ZM","commonName":"Zambia","officialName":"Republic of Zambia"},
+{"countryCode":"ZW","commonName":"Zimbabwe","officialName":"Republic of Zimbabwe"}
+]`
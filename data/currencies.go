@@ -1,8 +1,14 @@
 // Package data contains the data for the currencies
 package data
 
+// PopulationDataYear is the reference year of the population figures in CountryCurrencyJSONData
+// The gist mirrors the GeoNames country info dump, which is based on the 2010 census round
+const PopulationDataYear = 2010
+
 // CountryCurrencyJSONData is a JSON glob of countries and currencies
 // Source: https://gist.github.com/tiagodealmeida/0b97ccf117252d742dddf098bc6cc58a
+// Currency codes are updated for redenominations since publication (BYN, MRU, SLE, STN, VES, ZWG)
+// and for Croatia joining the euro area (EUR)
 const CountryCurrencyJSONData = `[
             {
                 "countryCode": "AD",
@@ -287,7 +293,7 @@ const CountryCurrencyJSONData = `[
             {
                 "countryCode": "BY",
                 "countryName": "Belarus",
-                "currencyCode": "BYR",
+                "currencyCode": "BYN",
                 "population": "9685000",
                 "capital": "Minsk",
                 "continentName": "Europe"
@@ -783,7 +789,7 @@ const CountryCurrencyJSONData = `[
             {
                 "countryCode": "HR",
                 "countryName": "Croatia",
-                "currencyCode": "HRK",
+                "currencyCode": "EUR",
                 "population": "4284889",
                 "capital": "Zagreb",
                 "continentName": "Europe"
@@ -1207,7 +1213,7 @@ const CountryCurrencyJSONData = `[
             {
                 "countryCode": "MR",
                 "countryName": "Mauritania",
-                "currencyCode": "MRO",
+                "currencyCode": "MRU",
                 "population": "3205060",
                 "capital": "Nouakchott",
                 "continentName": "Africa"
@@ -1623,7 +1629,7 @@ const CountryCurrencyJSONData = `[
             {
                 "countryCode": "SL",
                 "countryName": "Sierra Leone",
-                "currencyCode": "SLL",
+                "currencyCode": "SLE",
                 "population": "5245695",
                 "capital": "Freetown",
                 "continentName": "Africa"
@@ -1671,7 +1677,7 @@ const CountryCurrencyJSONData = `[
             {
                 "countryCode": "ST",
                 "countryName": "São Tomé and Príncipe",
-                "currencyCode": "STD",
+                "currencyCode": "STN",
                 "population": "175808",
                 "capital": "São Tomé",
                 "continentName": "Africa"
@@ -1903,7 +1909,7 @@ const CountryCurrencyJSONData = `[
             {
                 "countryCode": "VE",
                 "countryName": "Venezuela",
-                "currencyCode": "VEF",
+                "currencyCode": "VES",
                 "population": "27223228",
                 "capital": "Caracas",
                 "continentName": "South America"
@@ -1999,7 +2005,7 @@ const CountryCurrencyJSONData = `[
             {
                 "countryCode": "ZW",
                 "countryName": "Zimbabwe",
-                "currencyCode": "ZWL",
+                "currencyCode": "ZWG",
                 "population": "13061000",
                 "capital": "Harare",
                 "continentName": "Africa"
@@ -0,0 +1,35 @@
+package data
+
+// EXAMPLE DATA
+/*
+  {
+    "countryCode":"PA",
+    "currencyCode":"USD",
+    "legalTender":true
+  }
+*/
+
+// AdditionalCurrencyJSONData is the raw JSON for every currency a country uses besides its primary currency
+// Sources: Unicode CLDR supplemental currency data (tender and non-tender fund codes),
+// Reserve Bank of Zimbabwe multi-currency system (USD, ZAR, BWP, GBP, EUR)
+const AdditionalCurrencyJSONData = `[
+{"countryCode":"BO","currencyCode":"BOV","legalTender":false},
+{"countryCode":"BT","currencyCode":"INR","legalTender":true},
+{"countryCode":"CH","currencyCode":"CHE","legalTender":false},
+{"countryCode":"CH","currencyCode":"CHW","legalTender":false},
+{"countryCode":"CL","currencyCode":"CLF","legalTender":false},
+{"countryCode":"CO","currencyCode":"COU","legalTender":false},
+{"countryCode":"HT","currencyCode":"USD","legalTender":true},
+{"countryCode":"LS","currencyCode":"ZAR","legalTender":true},
+{"countryCode":"MX","currencyCode":"MXV","legalTender":false},
+{"countryCode":"NA","currencyCode":"ZAR","legalTender":true},
+{"countryCode":"PA","currencyCode":"USD","legalTender":true},
+{"countryCode":"PS","currencyCode":"JOD","legalTender":true},
+{"countryCode":"US","currencyCode":"USN","legalTender":false},
+{"countryCode":"UY","currencyCode":"UYI","legalTender":false},
+{"countryCode":"ZW","currencyCode":"USD","legalTender":true},
+{"countryCode":"ZW","currencyCode":"ZAR","legalTender":true},
+{"countryCode":"ZW","currencyCode":"BWP","legalTender":true},
+{"countryCode":"ZW","currencyCode":"GBP","legalTender":true},
+{"countryCode":"ZW","currencyCode":"EUR","legalTender":true}
+]`
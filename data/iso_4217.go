@@ -0,0 +1,198 @@
+package data
+
+// EXAMPLE DATA
+/*
+  {
+    "code":"EUR",
+    "numericCode":"978",
+    "name":"Euro",
+    "minorUnits":"2",
+    "symbol":"€",
+    "narrowSymbol":"€"
+  }
+*/
+
+// ISO4217JSONData is the raw JSON for all active currencies (ISO 4217)
+// Source: https://salsa.debian.org/iso-codes-team/iso-codes (iso_4217.json, v4.15.0)
+// Withdrawn since publication: CUC, HRK, SLL, ZWL; added since publication: ZWG (ISO 4217 amendment 177)
+// Symbols: Unicode CLDR (English locale), minor units: ISO 4217 list one ("N.A." when not applicable)
+const ISO4217JSONData = `[
+{"code":"AED","numericCode":"784","name":"UAE Dirham","minorUnits":"2","symbol":"AED","narrowSymbol":"AED"},
+{"code":"AFN","numericCode":"971","name":"Afghani","minorUnits":"2","symbol":"AFN","narrowSymbol":"AFN"},
+{"code":"ALL","numericCode":"008","name":"Lek","minorUnits":"2","symbol":"ALL","narrowSymbol":"ALL"},
+{"code":"AMD","numericCode":"051","name":"Armenian Dram","minorUnits":"2","symbol":"AMD","narrowSymbol":"AMD"},
+{"code":"ANG","numericCode":"532","name":"Netherlands Antillean Guilder","minorUnits":"2","symbol":"ANG","narrowSymbol":"ANG"},
+{"code":"AOA","numericCode":"973","name":"Kwanza","minorUnits":"2","symbol":"AOA","narrowSymbol":"Kz"},
+{"code":"ARS","numericCode":"032","name":"Argentine Peso","minorUnits":"2","symbol":"ARS","narrowSymbol":"$"},
+{"code":"AUD","numericCode":"036","name":"Australian Dollar","minorUnits":"2","symbol":"A$","narrowSymbol":"$"},
+{"code":"AWG","numericCode":"533","name":"Aruban Florin","minorUnits":"2","symbol":"AWG","narrowSymbol":"AWG"},
+{"code":"AZN","numericCode":"944","name":"Azerbaijan Manat","minorUnits":"2","symbol":"AZN","narrowSymbol":"AZN"},
+{"code":"BAM","numericCode":"977","name":"Convertible Mark","minorUnits":"2","symbol":"BAM","narrowSymbol":"KM"},
+{"code":"BBD","numericCode":"052","name":"Barbados Dollar","minorUnits":"2","symbol":"BBD","narrowSymbol":"$"},
+{"code":"BDT","numericCode":"050","name":"Taka","minorUnits":"2","symbol":"BDT","narrowSymbol":"৳"},
+{"code":"BGN","numericCode":"975","name":"Bulgarian Lev","minorUnits":"2","symbol":"BGN","narrowSymbol":"BGN"},
+{"code":"BHD","numericCode":"048","name":"Bahraini Dinar","minorUnits":"3","symbol":"BHD","narrowSymbol":"BHD"},
+{"code":"BIF","numericCode":"108","name":"Burundi Franc","minorUnits":"0","symbol":"BIF","narrowSymbol":"BIF"},
+{"code":"BMD","numericCode":"060","name":"Bermudian Dollar","minorUnits":"2","symbol":"BMD","narrowSymbol":"$"},
+{"code":"BND","numericCode":"096","name":"Brunei Dollar","minorUnits":"2","symbol":"BND","narrowSymbol":"$"},
+{"code":"BOB","numericCode":"068","name":"Boliviano","minorUnits":"2","symbol":"BOB","narrowSymbol":"Bs"},
+{"code":"BOV","numericCode":"984","name":"Mvdol","minorUnits":"2","symbol":"BOV","narrowSymbol":"BOV"},
+{"code":"BRL","numericCode":"986","name":"Brazilian Real","minorUnits":"2","symbol":"R$","narrowSymbol":"R$"},
+{"code":"BSD","numericCode":"044","name":"Bahamian Dollar","minorUnits":"2","symbol":"BSD","narrowSymbol":"$"},
+{"code":"BTN","numericCode":"064","name":"Ngultrum","minorUnits":"2","symbol":"BTN","narrowSymbol":"BTN"},
+{"code":"BWP","numericCode":"072","name":"Pula","minorUnits":"2","symbol":"BWP","narrowSymbol":"P"},
+{"code":"BYN","numericCode":"933","name":"Belarusian Ruble","minorUnits":"2","symbol":"BYN","narrowSymbol":"р."},
+{"code":"BZD","numericCode":"084","name":"Belize Dollar","minorUnits":"2","symbol":"BZD","narrowSymbol":"$"},
+{"code":"CAD","numericCode":"124","name":"Canadian Dollar","minorUnits":"2","symbol":"CA$","narrowSymbol":"$"},
+{"code":"CDF","numericCode":"976","name":"Congolese Franc","minorUnits":"2","symbol":"CDF","narrowSymbol":"CDF"},
+{"code":"CHE","numericCode":"947","name":"WIR Euro","minorUnits":"2","symbol":"CHE","narrowSymbol":"CHE"},
+{"code":"CHF","numericCode":"756","name":"Swiss Franc","minorUnits":"2","symbol":"CHF","narrowSymbol":"CHF"},
+{"code":"CHW","numericCode":"948","name":"WIR Franc","minorUnits":"2","symbol":"CHW","narrowSymbol":"CHW"},
+{"code":"CLF","numericCode":"990","name":"Unidad de Fomento","minorUnits":"4","symbol":"CLF","narrowSymbol":"CLF"},
+{"code":"CLP","numericCode":"152","name":"Chilean Peso","minorUnits":"0","symbol":"CLP","narrowSymbol":"$"},
+{"code":"CNY","numericCode":"156","name":"Yuan Renminbi","minorUnits":"2","symbol":"CN¥","narrowSymbol":"¥"},
+{"code":"COP","numericCode":"170","name":"Colombian Peso","minorUnits":"2","symbol":"COP","narrowSymbol":"$"},
+{"code":"COU","numericCode":"970","name":"Unidad de Valor Real","minorUnits":"2","symbol":"COU","narrowSymbol":"COU"},
+{"code":"CRC","numericCode":"188","name":"Costa Rican Colon","minorUnits":"2","symbol":"CRC","narrowSymbol":"₡"},
+{"code":"CUP","numericCode":"192","name":"Cuban Peso","minorUnits":"2","symbol":"CUP","narrowSymbol":"$"},
+{"code":"CVE","numericCode":"132","name":"Cabo Verde Escudo","minorUnits":"2","symbol":"CVE","narrowSymbol":"CVE"},
+{"code":"CZK","numericCode":"203","name":"Czech Koruna","minorUnits":"2","symbol":"CZK","narrowSymbol":"Kč"},
+{"code":"DJF","numericCode":"262","name":"Djibouti Franc","minorUnits":"0","symbol":"DJF","narrowSymbol":"DJF"},
+{"code":"DKK","numericCode":"208","name":"Danish Krone","minorUnits":"2","symbol":"DKK","narrowSymbol":"kr"},
+{"code":"DOP","numericCode":"214","name":"Dominican Peso","minorUnits":"2","symbol":"DOP","narrowSymbol":"$"},
+{"code":"DZD","numericCode":"012","name":"Algerian Dinar","minorUnits":"2","symbol":"DZD","narrowSymbol":"DZD"},
+{"code":"EGP","numericCode":"818","name":"Egyptian Pound","minorUnits":"2","symbol":"EGP","narrowSymbol":"E£"},
+{"code":"ERN","numericCode":"232","name":"Nakfa","minorUnits":"2","symbol":"ERN","narrowSymbol":"ERN"},
+{"code":"ETB","numericCode":"230","name":"Ethiopian Birr","minorUnits":"2","symbol":"ETB","narrowSymbol":"ETB"},
+{"code":"EUR","numericCode":"978","name":"Euro","minorUnits":"2","symbol":"€","narrowSymbol":"€"},
+{"code":"FJD","numericCode":"242","name":"Fiji Dollar","minorUnits":"2","symbol":"FJD","narrowSymbol":"$"},
+{"code":"FKP","numericCode":"238","name":"Falkland Islands Pound","minorUnits":"2","symbol":"FKP","narrowSymbol":"£"},
+{"code":"GBP","numericCode":"826","name":"Pound Sterling","minorUnits":"2","symbol":"£","narrowSymbol":"£"},
+{"code":"GEL","numericCode":"981","name":"Lari","minorUnits":"2","symbol":"GEL","narrowSymbol":"₾"},
+{"code":"GHS","numericCode":"936","name":"Ghana Cedi","minorUnits":"2","symbol":"GHS","narrowSymbol":"GHS"},
+{"code":"GIP","numericCode":"292","name":"Gibraltar Pound","minorUnits":"2","symbol":"GIP","narrowSymbol":"£"},
+{"code":"GMD","numericCode":"270","name":"Dalasi","minorUnits":"2","symbol":"GMD","narrowSymbol":"GMD"},
+{"code":"GNF","numericCode":"324","name":"Guinean Franc","minorUnits":"0","symbol":"GNF","narrowSymbol":"FG"},
+{"code":"GTQ","numericCode":"320","name":"Quetzal","minorUnits":"2","symbol":"GTQ","narrowSymbol":"Q"},
+{"code":"GYD","numericCode":"328","name":"Guyana Dollar","minorUnits":"2","symbol":"GYD","narrowSymbol":"$"},
+{"code":"HKD","numericCode":"344","name":"Hong Kong Dollar","minorUnits":"2","symbol":"HK$","narrowSymbol":"$"},
+{"code":"HNL","numericCode":"340","name":"Lempira","minorUnits":"2","symbol":"HNL","narrowSymbol":"L"},
+{"code":"HTG","numericCode":"332","name":"Gourde","minorUnits":"2","symbol":"HTG","narrowSymbol":"HTG"},
+{"code":"HUF","numericCode":"348","name":"Forint","minorUnits":"2","symbol":"HUF","narrowSymbol":"Ft"},
+{"code":"IDR","numericCode":"360","name":"Rupiah","minorUnits":"2","symbol":"IDR","narrowSymbol":"Rp"},
+{"code":"ILS","numericCode":"376","name":"New Israeli Sheqel","minorUnits":"2","symbol":"₪","narrowSymbol":"₪"},
+{"code":"INR","numericCode":"356","name":"Indian Rupee","minorUnits":"2","symbol":"₹","narrowSymbol":"₹"},
+{"code":"IQD","numericCode":"368","name":"Iraqi Dinar","minorUnits":"3","symbol":"IQD","narrowSymbol":"IQD"},
+{"code":"IRR","numericCode":"364","name":"Iranian Rial","minorUnits":"2","symbol":"IRR","narrowSymbol":"IRR"},
+{"code":"ISK","numericCode":"352","name":"Iceland Krona","minorUnits":"0","symbol":"ISK","narrowSymbol":"kr"},
+{"code":"JMD","numericCode":"388","name":"Jamaican Dollar","minorUnits":"2","symbol":"JMD","narrowSymbol":"$"},
+{"code":"JOD","numericCode":"400","name":"Jordanian Dinar","minorUnits":"3","symbol":"JOD","narrowSymbol":"JOD"},
+{"code":"JPY","numericCode":"392","name":"Yen","minorUnits":"0","symbol":"¥","narrowSymbol":"¥"},
+{"code":"KES","numericCode":"404","name":"Kenyan Shilling","minorUnits":"2","symbol":"KES","narrowSymbol":"KES"},
+{"code":"KGS","numericCode":"417","name":"Som","minorUnits":"2","symbol":"KGS","narrowSymbol":"KGS"},
+{"code":"KHR","numericCode":"116","name":"Riel","minorUnits":"2","symbol":"KHR","narrowSymbol":"៛"},
+{"code":"KMF","numericCode":"174","name":"Comorian Franc","minorUnits":"0","symbol":"KMF","narrowSymbol":"CF"},
+{"code":"KPW","numericCode":"408","name":"North Korean Won","minorUnits":"2","symbol":"KPW","narrowSymbol":"₩"},
+{"code":"KRW","numericCode":"410","name":"Won","minorUnits":"0","symbol":"₩","narrowSymbol":"₩"},
+{"code":"KWD","numericCode":"414","name":"Kuwaiti Dinar","minorUnits":"3","symbol":"KWD","narrowSymbol":"KWD"},
+{"code":"KYD","numericCode":"136","name":"Cayman Islands Dollar","minorUnits":"2","symbol":"KYD","narrowSymbol":"$"},
+{"code":"KZT","numericCode":"398","name":"Tenge","minorUnits":"2","symbol":"KZT","narrowSymbol":"₸"},
+{"code":"LAK","numericCode":"418","name":"Lao Kip","minorUnits":"2","symbol":"LAK","narrowSymbol":"₭"},
+{"code":"LBP","numericCode":"422","name":"Lebanese Pound","minorUnits":"2","symbol":"LBP","narrowSymbol":"L£"},
+{"code":"LKR","numericCode":"144","name":"Sri Lanka Rupee","minorUnits":"2","symbol":"LKR","narrowSymbol":"Rs"},
+{"code":"LRD","numericCode":"430","name":"Liberian Dollar","minorUnits":"2","symbol":"LRD","narrowSymbol":"$"},
+{"code":"LSL","numericCode":"426","name":"Loti","minorUnits":"2","symbol":"LSL","narrowSymbol":"LSL"},
+{"code":"LYD","numericCode":"434","name":"Libyan Dinar","minorUnits":"3","symbol":"LYD","narrowSymbol":"LYD"},
+{"code":"MAD","numericCode":"504","name":"Moroccan Dirham","minorUnits":"2","symbol":"MAD","narrowSymbol":"MAD"},
+{"code":"MDL","numericCode":"498","name":"Moldovan Leu","minorUnits":"2","symbol":"MDL","narrowSymbol":"MDL"},
+{"code":"MGA","numericCode":"969","name":"Malagasy Ariary","minorUnits":"2","symbol":"MGA","narrowSymbol":"Ar"},
+{"code":"MKD","numericCode":"807","name":"Denar","minorUnits":"2","symbol":"MKD","narrowSymbol":"MKD"},
+{"code":"MMK","numericCode":"104","name":"Kyat","minorUnits":"2","symbol":"MMK","narrowSymbol":"K"},
+{"code":"MNT","numericCode":"496","name":"Tugrik","minorUnits":"2","symbol":"MNT","narrowSymbol":"₮"},
+{"code":"MOP","numericCode":"446","name":"Pataca","minorUnits":"2","symbol":"MOP","narrowSymbol":"MOP"},
+{"code":"MRU","numericCode":"929","name":"Ouguiya","minorUnits":"2","symbol":"MRU","narrowSymbol":"MRU"},
+{"code":"MUR","numericCode":"480","name":"Mauritius Rupee","minorUnits":"2","symbol":"MUR","narrowSymbol":"Rs"},
+{"code":"MVR","numericCode":"462","name":"Rufiyaa","minorUnits":"2","symbol":"MVR","narrowSymbol":"MVR"},
+{"code":"MWK","numericCode":"454","name":"Malawi Kwacha","minorUnits":"2","symbol":"MWK","narrowSymbol":"MWK"},
+{"code":"MXN","numericCode":"484","name":"Mexican Peso","minorUnits":"2","symbol":"MX$","narrowSymbol":"$"},
+{"code":"MXV","numericCode":"979","name":"Mexican Unidad de Inversion (UDI)","minorUnits":"2","symbol":"MXV","narrowSymbol":"MXV"},
+{"code":"MYR","numericCode":"458","name":"Malaysian Ringgit","minorUnits":"2","symbol":"MYR","narrowSymbol":"RM"},
+{"code":"MZN","numericCode":"943","name":"Mozambique Metical","minorUnits":"2","symbol":"MZN","narrowSymbol":"MZN"},
+{"code":"NAD","numericCode":"516","name":"Namibia Dollar","minorUnits":"2","symbol":"NAD","narrowSymbol":"$"},
+{"code":"NGN","numericCode":"566","name":"Naira","minorUnits":"2","symbol":"NGN","narrowSymbol":"₦"},
+{"code":"NIO","numericCode":"558","name":"Cordoba Oro","minorUnits":"2","symbol":"NIO","narrowSymbol":"C$"},
+{"code":"NOK","numericCode":"578","name":"Norwegian Krone","minorUnits":"2","symbol":"NOK","narrowSymbol":"kr"},
+{"code":"NPR","numericCode":"524","name":"Nepalese Rupee","minorUnits":"2","symbol":"NPR","narrowSymbol":"Rs"},
+{"code":"NZD","numericCode":"554","name":"New Zealand Dollar","minorUnits":"2","symbol":"NZ$","narrowSymbol":"$"},
+{"code":"OMR","numericCode":"512","name":"Rial Omani","minorUnits":"3","symbol":"OMR","narrowSymbol":"OMR"},
+{"code":"PAB","numericCode":"590","name":"Balboa","minorUnits":"2","symbol":"PAB","narrowSymbol":"PAB"},
+{"code":"PEN","numericCode":"604","name":"Sol","minorUnits":"2","symbol":"PEN","narrowSymbol":"PEN"},
+{"code":"PGK","numericCode":"598","name":"Kina","minorUnits":"2","symbol":"PGK","narrowSymbol":"PGK"},
+{"code":"PHP","numericCode":"608","name":"Philippine Peso","minorUnits":"2","symbol":"PHP","narrowSymbol":"₱"},
+{"code":"PKR","numericCode":"586","name":"Pakistan Rupee","minorUnits":"2","symbol":"PKR","narrowSymbol":"Rs"},
+{"code":"PLN","numericCode":"985","name":"Zloty","minorUnits":"2","symbol":"PLN","narrowSymbol":"zł"},
+{"code":"PYG","numericCode":"600","name":"Guarani","minorUnits":"0","symbol":"PYG","narrowSymbol":"₲"},
+{"code":"QAR","numericCode":"634","name":"Qatari Rial","minorUnits":"2","symbol":"QAR","narrowSymbol":"QAR"},
+{"code":"RON","numericCode":"946","name":"Romanian Leu","minorUnits":"2","symbol":"RON","narrowSymbol":"lei"},
+{"code":"RSD","numericCode":"941","name":"Serbian Dinar","minorUnits":"2","symbol":"RSD","narrowSymbol":"RSD"},
+{"code":"RUB","numericCode":"643","name":"Russian Ruble","minorUnits":"2","symbol":"RUB","narrowSymbol":"₽"},
+{"code":"RWF","numericCode":"646","name":"Rwanda Franc","minorUnits":"0","symbol":"RWF","narrowSymbol":"RF"},
+{"code":"SAR","numericCode":"682","name":"Saudi Riyal","minorUnits":"2","symbol":"SAR","narrowSymbol":"SAR"},
+{"code":"SBD","numericCode":"090","name":"Solomon Islands Dollar","minorUnits":"2","symbol":"SBD","narrowSymbol":"$"},
+{"code":"SCR","numericCode":"690","name":"Seychelles Rupee","minorUnits":"2","symbol":"SCR","narrowSymbol":"SCR"},
+{"code":"SDG","numericCode":"938","name":"Sudanese Pound","minorUnits":"2","symbol":"SDG","narrowSymbol":"SDG"},
+{"code":"SEK","numericCode":"752","name":"Swedish Krona","minorUnits":"2","symbol":"SEK","narrowSymbol":"kr"},
+{"code":"SGD","numericCode":"702","name":"Singapore Dollar","minorUnits":"2","symbol":"SGD","narrowSymbol":"$"},
+{"code":"SHP","numericCode":"654","name":"Saint Helena Pound","minorUnits":"2","symbol":"SHP","narrowSymbol":"£"},
+{"code":"SLE","numericCode":"925","name":"Leone","minorUnits":"2","symbol":"SLE","narrowSymbol":"SLE"},
+{"code":"SOS","numericCode":"706","name":"Somali Shilling","minorUnits":"2","symbol":"SOS","narrowSymbol":"SOS"},
+{"code":"SRD","numericCode":"968","name":"Surinam Dollar","minorUnits":"2","symbol":"SRD","narrowSymbol":"$"},
+{"code":"SSP","numericCode":"728","name":"South Sudanese Pound","minorUnits":"2","symbol":"SSP","narrowSymbol":"£"},
+{"code":"STN","numericCode":"930","name":"Dobra","minorUnits":"2","symbol":"STN","narrowSymbol":"STN"},
+{"code":"SVC","numericCode":"222","name":"El Salvador Colon","minorUnits":"2","symbol":"SVC","narrowSymbol":"SVC"},
+{"code":"SYP","numericCode":"760","name":"Syrian Pound","minorUnits":"2","symbol":"SYP","narrowSymbol":"£"},
+{"code":"SZL","numericCode":"748","name":"Lilangeni","minorUnits":"2","symbol":"SZL","narrowSymbol":"SZL"},
+{"code":"THB","numericCode":"764","name":"Baht","minorUnits":"2","symbol":"THB","narrowSymbol":"฿"},
+{"code":"TJS","numericCode":"972","name":"Somoni","minorUnits":"2","symbol":"TJS","narrowSymbol":"TJS"},
+{"code":"TMT","numericCode":"934","name":"Turkmenistan New Manat","minorUnits":"2","symbol":"TMT","narrowSymbol":"TMT"},
+{"code":"TND","numericCode":"788","name":"Tunisian Dinar","minorUnits":"3","symbol":"TND","narrowSymbol":"TND"},
+{"code":"TOP","numericCode":"776","name":"Pa’anga","minorUnits":"2","symbol":"TOP","narrowSymbol":"T$"},
+{"code":"TRY","numericCode":"949","name":"Turkish Lira","minorUnits":"2","symbol":"TRY","narrowSymbol":"₺"},
+{"code":"TTD","numericCode":"780","name":"Trinidad and Tobago Dollar","minorUnits":"2","symbol":"TTD","narrowSymbol":"$"},
+{"code":"TWD","numericCode":"901","name":"New Taiwan Dollar","minorUnits":"2","symbol":"NT$","narrowSymbol":"$"},
+{"code":"TZS","numericCode":"834","name":"Tanzanian Shilling","minorUnits":"2","symbol":"TZS","narrowSymbol":"TZS"},
+{"code":"UAH","numericCode":"980","name":"Hryvnia","minorUnits":"2","symbol":"UAH","narrowSymbol":"₴"},
+{"code":"UGX","numericCode":"800","name":"Uganda Shilling","minorUnits":"0","symbol":"UGX","narrowSymbol":"UGX"},
+{"code":"USD","numericCode":"840","name":"US Dollar","minorUnits":"2","symbol":"$","narrowSymbol":"$"},
+{"code":"USN","numericCode":"997","name":"US Dollar (Next day)","minorUnits":"2","symbol":"USN","narrowSymbol":"USN"},
+{"code":"UYI","numericCode":"940","name":"Uruguay Peso en Unidades Indexadas (UI)","minorUnits":"0","symbol":"UYI","narrowSymbol":"UYI"},
+{"code":"UYU","numericCode":"858","name":"Peso Uruguayo","minorUnits":"2","symbol":"UYU","narrowSymbol":"$"},
+{"code":"UYW","numericCode":"927","name":"Unidad Previsional","minorUnits":"4","symbol":"UYW","narrowSymbol":"UYW"},
+{"code":"UZS","numericCode":"860","name":"Uzbekistan Sum","minorUnits":"2","symbol":"UZS","narrowSymbol":"UZS"},
+{"code":"VED","numericCode":"926","name":"Bolívar Soberano","minorUnits":"2","symbol":"VED","narrowSymbol":"VED"},
+{"code":"VES","numericCode":"928","name":"Bolívar Soberano","minorUnits":"2","symbol":"VES","narrowSymbol":"VES"},
+{"code":"VND","numericCode":"704","name":"Dong","minorUnits":"0","symbol":"₫","narrowSymbol":"₫"},
+{"code":"VUV","numericCode":"548","name":"Vatu","minorUnits":"0","symbol":"VUV","narrowSymbol":"VUV"},
+{"code":"WST","numericCode":"882","name":"Tala","minorUnits":"2","symbol":"WST","narrowSymbol":"WST"},
+{"code":"XAF","numericCode":"950","name":"CFA Franc BEAC","minorUnits":"0","symbol":"FCFA","narrowSymbol":"XAF"},
+{"code":"XAG","numericCode":"961","name":"Silver","minorUnits":"N.A.","symbol":"XAG","narrowSymbol":"XAG"},
+{"code":"XAU","numericCode":"959","name":"Gold","minorUnits":"N.A.","symbol":"XAU","narrowSymbol":"XAU"},
+{"code":"XBA","numericCode":"955","name":"Bond Markets Unit European Composite Unit (EURCO)","minorUnits":"N.A.","symbol":"XBA","narrowSymbol":"XBA"},
+{"code":"XBB","numericCode":"956","name":"Bond Markets Unit European Monetary Unit (E.M.U.-6)","minorUnits":"N.A.","symbol":"XBB","narrowSymbol":"XBB"},
+{"code":"XBC","numericCode":"957","name":"Bond Markets Unit European Unit of Account 9 (E.U.A.-9)","minorUnits":"N.A.","symbol":"XBC","narrowSymbol":"XBC"},
+{"code":"XBD","numericCode":"958","name":"Bond Markets Unit European Unit of Account 17 (E.U.A.-17)","minorUnits":"N.A.","symbol":"XBD","narrowSymbol":"XBD"},
+{"code":"XCD","numericCode":"951","name":"East Caribbean Dollar","minorUnits":"2","symbol":"EC$","narrowSymbol":"$"},
+{"code":"XDR","numericCode":"960","name":"SDR (Special Drawing Right)","minorUnits":"N.A.","symbol":"XDR","narrowSymbol":"XDR"},
+{"code":"XOF","numericCode":"952","name":"CFA Franc BCEAO","minorUnits":"0","symbol":"CFA","narrowSymbol":"XOF"},
+{"code":"XPD","numericCode":"964","name":"Palladium","minorUnits":"N.A.","symbol":"XPD","narrowSymbol":"XPD"},
+{"code":"XPF","numericCode":"953","name":"CFP Franc","minorUnits":"0","symbol":"CFPF","narrowSymbol":"XPF"},
+{"code":"XPT","numericCode":"962","name":"Platinum","minorUnits":"N.A.","symbol":"XPT","narrowSymbol":"XPT"},
+{"code":"XSU","numericCode":"994","name":"Sucre","minorUnits":"N.A.","symbol":"XSU","narrowSymbol":"XSU"},
+{"code":"XTS","numericCode":"963","name":"Codes specifically reserved for testing purposes","minorUnits":"N.A.","symbol":"XTS","narrowSymbol":"XTS"},
+{"code":"XUA","numericCode":"965","name":"ADB Unit of Account","minorUnits":"N.A.","symbol":"XUA","narrowSymbol":"XUA"},
+{"code":"XXX","numericCode":"999","name":"The codes assigned for transactions where no currency is involved","minorUnits":"N.A.","symbol":"XXX","narrowSymbol":"XXX"},
+{"code":"YER","numericCode":"886","name":"Yemeni Rial","minorUnits":"2","symbol":"YER","narrowSymbol":"YER"},
+{"code":"ZAR","numericCode":"710","name":"Rand","minorUnits":"2","symbol":"ZAR","narrowSymbol":"R"},
+{"code":"ZMW","numericCode":"967","name":"Zambian Kwacha","minorUnits":"2","symbol":"ZMW","narrowSymbol":"ZK"},
+{"code":"ZWG","numericCode":"924","name":"Zimbabwe Gold","minorUnits":"2","symbol":"ZWG","narrowSymbol":"ZWG"}
+]`
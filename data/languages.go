@@ -0,0 +1,276 @@
+package data
+
+// EXAMPLE DATA
+/*
+  {
+    "alpha2":"pt",
+    "alpha3":"por",
+    "name":"Portuguese"
+  }
+*/
+
+// LanguageJSONData is the raw JSON for every language spoken in at least one country (ISO 639)
+// The alpha-2 code is the ISO 639-1 code (empty when the language has none) and the alpha-3 code is the ISO 639-3 code.
+// Source: https://salsa.debian.org/iso-codes-team/iso-codes (iso_639-3.json, v4.15.0)
+// Names: Unicode CLDR (English locale), falling back to the ISO 639-3 reference name
+const LanguageJSONData = `[
+{"alpha2":"aa","alpha3":"aar","name":"Afar"},
+{"alpha2":"af","alpha3":"afr","name":"Afrikaans"},
+{"alpha2":"","alpha3":"agq","name":"Aghem"},
+{"alpha2":"ak","alpha3":"aka","name":"Akan"},
+{"alpha2":"am","alpha3":"amh","name":"Amharic"},
+{"alpha2":"ar","alpha3":"ara","name":"Arabic"},
+{"alpha2":"","alpha3":"arc","name":"Aramaic"},
+{"alpha2":"","alpha3":"asa","name":"Asu"},
+{"alpha2":"as","alpha3":"asm","name":"Assamese"},
+{"alpha2":"","alpha3":"ast","name":"Asturian"},
+{"alpha2":"ay","alpha3":"aym","name":"Aymara"},
+{"alpha2":"az","alpha3":"aze","name":"Azerbaijani"},
+{"alpha2":"bm","alpha3":"bam","name":"Bambara"},
+{"alpha2":"","alpha3":"bas","name":"Basaa"},
+{"alpha2":"be","alpha3":"bel","name":"Belarusian"},
+{"alpha2":"","alpha3":"bem","name":"Bemba"},
+{"alpha2":"bn","alpha3":"ben","name":"Bangla"},
+{"alpha2":"","alpha3":"bez","name":"Bena"},
+{"alpha2":"bi","alpha3":"bis","name":"Bislama"},
+{"alpha2":"","alpha3":"bjz","name":"Baruga"},
+{"alpha2":"bo","alpha3":"bod","name":"Tibetan"},
+{"alpha2":"bs","alpha3":"bos","name":"Bosnian"},
+{"alpha2":"br","alpha3":"bre","name":"Breton"},
+{"alpha2":"","alpha3":"brx","name":"Bodo"},
+{"alpha2":"bg","alpha3":"bul","name":"Bulgarian"},
+{"alpha2":"","alpha3":"bwg","name":"Barwe"},
+{"alpha2":"","alpha3":"cal","name":"Carolinian"},
+{"alpha2":"ca","alpha3":"cat","name":"Catalan"},
+{"alpha2":"","alpha3":"ccp","name":"Chakma"},
+{"alpha2":"","alpha3":"ceb","name":"Cebuano"},
+{"alpha2":"cs","alpha3":"ces","name":"Czech"},
+{"alpha2":"","alpha3":"cgg","name":"Chiga"},
+{"alpha2":"ch","alpha3":"cha","name":"Chamorro"},
+{"alpha2":"ce","alpha3":"che","name":"Chechen"},
+{"alpha2":"","alpha3":"chr","name":"Cherokee"},
+{"alpha2":"cu","alpha3":"chu","name":"Church Slavic"},
+{"alpha2":"","alpha3":"ckb","name":"Central Kurdish"},
+{"alpha2":"kw","alpha3":"cor","name":"Cornish"},
+{"alpha2":"","alpha3":"crs","name":"Seselwa Creole French"},
+{"alpha2":"cy","alpha3":"cym","name":"Welsh"},
+{"alpha2":"da","alpha3":"dan","name":"Danish"},
+{"alpha2":"","alpha3":"dav","name":"Taita"},
+{"alpha2":"de","alpha3":"deu","name":"German"},
+{"alpha2":"dv","alpha3":"div","name":"Divehi"},
+{"alpha2":"","alpha3":"dje","name":"Zarma"},
+{"alpha2":"","alpha3":"dsb","name":"Lower Sorbian"},
+{"alpha2":"","alpha3":"dua","name":"Duala"},
+{"alpha2":"","alpha3":"dyo","name":"Jola-Fonyi"},
+{"alpha2":"dz","alpha3":"dzo","name":"Dzongkha"},
+{"alpha2":"","alpha3":"ebu","name":"Embu"},
+{"alpha2":"el","alpha3":"ell","name":"Greek"},
+{"alpha2":"en","alpha3":"eng","name":"English"},
+{"alpha2":"et","alpha3":"est","name":"Estonian"},
+{"alpha2":"eu","alpha3":"eus","name":"Basque"},
+{"alpha2":"ee","alpha3":"ewe","name":"Ewe"},
+{"alpha2":"","alpha3":"ewo","name":"Ewondo"},
+{"alpha2":"fo","alpha3":"fao","name":"Faroese"},
+{"alpha2":"fa","alpha3":"fas","name":"Persian"},
+{"alpha2":"fj","alpha3":"fij","name":"Fijian"},
+{"alpha2":"","alpha3":"fil","name":"Filipino"},
+{"alpha2":"fi","alpha3":"fin","name":"Finnish"},
+{"alpha2":"fr","alpha3":"fra","name":"French"},
+{"alpha2":"fy","alpha3":"fry","name":"Western Frisian"},
+{"alpha2":"ff","alpha3":"ful","name":"Fulah"},
+{"alpha2":"","alpha3":"fur","name":"Friulian"},
+{"alpha2":"","alpha3":"gil","name":"Gilbertese"},
+{"alpha2":"gd","alpha3":"gla","name":"Scottish Gaelic"},
+{"alpha2":"ga","alpha3":"gle","name":"Irish"},
+{"alpha2":"gl","alpha3":"glg","name":"Galician"},
+{"alpha2":"gv","alpha3":"glv","name":"Manx"},
+{"alpha2":"gn","alpha3":"grn","name":"Guarani"},
+{"alpha2":"","alpha3":"gsw","name":"Swiss German"},
+{"alpha2":"gu","alpha3":"guj","name":"Gujarati"},
+{"alpha2":"","alpha3":"guz","name":"Gusii"},
+{"alpha2":"ht","alpha3":"hat","name":"Haitian Creole"},
+{"alpha2":"ha","alpha3":"hau","name":"Hausa"},
+{"alpha2":"","alpha3":"haw","name":"Hawaiian"},
+{"alpha2":"he","alpha3":"heb","name":"Hebrew"},
+{"alpha2":"hz","alpha3":"her","name":"Herero"},
+{"alpha2":"","alpha3":"hgm","name":"Haiǁom"},
+{"alpha2":"","alpha3":"hif","name":"Fiji Hindi"},
+{"alpha2":"hi","alpha3":"hin","name":"Hindi"},
+{"alpha2":"ho","alpha3":"hmo","name":"Hiri Motu"},
+{"alpha2":"hr","alpha3":"hrv","name":"Croatian"},
+{"alpha2":"","alpha3":"hsb","name":"Upper Sorbian"},
+{"alpha2":"hu","alpha3":"hun","name":"Hungarian"},
+{"alpha2":"hy","alpha3":"hye","name":"Armenian"},
+{"alpha2":"ig","alpha3":"ibo","name":"Igbo"},
+{"alpha2":"ii","alpha3":"iii","name":"Sichuan Yi"},
+{"alpha2":"id","alpha3":"ind","name":"Indonesian"},
+{"alpha2":"is","alpha3":"isl","name":"Icelandic"},
+{"alpha2":"it","alpha3":"ita","name":"Italian"},
+{"alpha2":"","alpha3":"jam","name":"Jamaican Creole English"},
+{"alpha2":"jv","alpha3":"jav","name":"Javanese"},
+{"alpha2":"","alpha3":"jgo","name":"Ngomba"},
+{"alpha2":"","alpha3":"jmc","name":"Machame"},
+{"alpha2":"ja","alpha3":"jpn","name":"Japanese"},
+{"alpha2":"","alpha3":"kab","name":"Kabyle"},
+{"alpha2":"kl","alpha3":"kal","name":"Kalaallisut"},
+{"alpha2":"","alpha3":"kam","name":"Kamba"},
+{"alpha2":"kn","alpha3":"kan","name":"Kannada"},
+{"alpha2":"ks","alpha3":"kas","name":"Kashmiri"},
+{"alpha2":"ka","alpha3":"kat","name":"Georgian"},
+{"alpha2":"kk","alpha3":"kaz","name":"Kazakh"},
+{"alpha2":"","alpha3":"kck","name":"Kalanga"},
+{"alpha2":"","alpha3":"kde","name":"Makonde"},
+{"alpha2":"","alpha3":"kea","name":"Kabuverdianu"},
+{"alpha2":"km","alpha3":"khm","name":"Khmer"},
+{"alpha2":"","alpha3":"khq","name":"Koyra Chiini"},
+{"alpha2":"ki","alpha3":"kik","name":"Kikuyu"},
+{"alpha2":"rw","alpha3":"kin","name":"Kinyarwanda"},
+{"alpha2":"ky","alpha3":"kir","name":"Kyrgyz"},
+{"alpha2":"","alpha3":"kkj","name":"Kako"},
+{"alpha2":"","alpha3":"kln","name":"Kalenjin"},
+{"alpha2":"","alpha3":"kok","name":"Konkani"},
+{"alpha2":"kg","alpha3":"kon","name":"Kongo"},
+{"alpha2":"ko","alpha3":"kor","name":"Korean"},
+{"alpha2":"","alpha3":"ksb","name":"Shambala"},
+{"alpha2":"","alpha3":"ksf","name":"Bafia"},
+{"alpha2":"","alpha3":"ksh","name":"Colognian"},
+{"alpha2":"ku","alpha3":"kur","name":"Kurdish"},
+{"alpha2":"","alpha3":"kwn","name":"Kwangali"},
+{"alpha2":"","alpha3":"lag","name":"Langi"},
+{"alpha2":"lo","alpha3":"lao","name":"Lao"},
+{"alpha2":"la","alpha3":"lat","name":"Latin"},
+{"alpha2":"lv","alpha3":"lav","name":"Latvian"},
+{"alpha2":"ln","alpha3":"lin","name":"Lingala"},
+{"alpha2":"lt","alpha3":"lit","name":"Lithuanian"},
+{"alpha2":"","alpha3":"lkt","name":"Lakota"},
+{"alpha2":"","alpha3":"loz","name":"Lozi"},
+{"alpha2":"","alpha3":"lrc","name":"Northern Luri"},
+{"alpha2":"lb","alpha3":"ltz","name":"Luxembourgish"},
+{"alpha2":"","alpha3":"lua","name":"Luba-Lulua"},
+{"alpha2":"lu","alpha3":"lub","name":"Luba-Katanga"},
+{"alpha2":"lg","alpha3":"lug","name":"Ganda"},
+{"alpha2":"","alpha3":"luo","name":"Luo"},
+{"alpha2":"","alpha3":"luy","name":"Luyia"},
+{"alpha2":"mh","alpha3":"mah","name":"Marshallese"},
+{"alpha2":"ml","alpha3":"mal","name":"Malayalam"},
+{"alpha2":"mr","alpha3":"mar","name":"Marathi"},
+{"alpha2":"","alpha3":"mas","name":"Masai"},
+{"alpha2":"","alpha3":"mer","name":"Meru"},
+{"alpha2":"","alpha3":"mey","name":"Hassaniyya"},
+{"alpha2":"","alpha3":"mfe","name":"Morisyen"},
+{"alpha2":"","alpha3":"mgh","name":"Makhuwa-Meetto"},
+{"alpha2":"","alpha3":"mgo","name":"Metaʼ"},
+{"alpha2":"mk","alpha3":"mkd","name":"Macedonian"},
+{"alpha2":"mg","alpha3":"mlg","name":"Malagasy"},
+{"alpha2":"mt","alpha3":"mlt","name":"Maltese"},
+{"alpha2":"mn","alpha3":"mon","name":"Mongolian"},
+{"alpha2":"mi","alpha3":"mri","name":"Maori"},
+{"alpha2":"ms","alpha3":"msa","name":"Malay"},
+{"alpha2":"","alpha3":"mua","name":"Mundang"},
+{"alpha2":"my","alpha3":"mya","name":"Burmese"},
+{"alpha2":"","alpha3":"mzn","name":"Mazanderani"},
+{"alpha2":"","alpha3":"naq","name":"Nama"},
+{"alpha2":"na","alpha3":"nau","name":"Nauru"},
+{"alpha2":"nr","alpha3":"nbl","name":"South Ndebele"},
+{"alpha2":"","alpha3":"ndc","name":"Ndau"},
+{"alpha2":"nd","alpha3":"nde","name":"North Ndebele"},
+{"alpha2":"ng","alpha3":"ndo","name":"Ndonga"},
+{"alpha2":"","alpha3":"nds","name":"Low German"},
+{"alpha2":"ne","alpha3":"nep","name":"Nepali"},
+{"alpha2":"","alpha3":"niu","name":"Niuean"},
+{"alpha2":"nl","alpha3":"nld","name":"Dutch"},
+{"alpha2":"","alpha3":"nmg","name":"Kwasio"},
+{"alpha2":"","alpha3":"nnh","name":"Ngiemboon"},
+{"alpha2":"nn","alpha3":"nno","name":"Norwegian Nynorsk"},
+{"alpha2":"nb","alpha3":"nob","name":"Norwegian Bokmål"},
+{"alpha2":"no","alpha3":"nor","name":"Norwegian"},
+{"alpha2":"","alpha3":"nrf","name":"Jèrriais"},
+{"alpha2":"","alpha3":"nso","name":"Northern Sotho"},
+{"alpha2":"","alpha3":"nus","name":"Nuer"},
+{"alpha2":"ny","alpha3":"nya","name":"Nyanja"},
+{"alpha2":"","alpha3":"nyn","name":"Nyankole"},
+{"alpha2":"","alpha3":"nzs","name":"New Zealand Sign Language"},
+{"alpha2":"oc","alpha3":"oci","name":"Occitan"},
+{"alpha2":"or","alpha3":"ori","name":"Odia"},
+{"alpha2":"om","alpha3":"orm","name":"Oromo"},
+{"alpha2":"os","alpha3":"oss","name":"Ossetic"},
+{"alpha2":"pa","alpha3":"pan","name":"Punjabi"},
+{"alpha2":"","alpha3":"pap","name":"Papiamento"},
+{"alpha2":"","alpha3":"pau","name":"Palauan"},
+{"alpha2":"","alpha3":"pih","name":"Pitcairn-Norfolk"},
+{"alpha2":"pl","alpha3":"pol","name":"Polish"},
+{"alpha2":"pt","alpha3":"por","name":"Portuguese"},
+{"alpha2":"","alpha3":"prs","name":"Dari"},
+{"alpha2":"ps","alpha3":"pus","name":"Pashto"},
+{"alpha2":"qu","alpha3":"que","name":"Quechua"},
+{"alpha2":"","alpha3":"rar","name":"Rarotongan"},
+{"alpha2":"","alpha3":"rof","name":"Rombo"},
+{"alpha2":"rm","alpha3":"roh","name":"Romansh"},
+{"alpha2":"ro","alpha3":"ron","name":"Romanian"},
+{"alpha2":"rn","alpha3":"run","name":"Rundi"},
+{"alpha2":"ru","alpha3":"rus","name":"Russian"},
+{"alpha2":"","alpha3":"rwk","name":"Rwa"},
+{"alpha2":"sg","alpha3":"sag","name":"Sango"},
+{"alpha2":"","alpha3":"sah","name":"Sakha"},
+{"alpha2":"","alpha3":"saq","name":"Samburu"},
+{"alpha2":"","alpha3":"sbp","name":"Sangu"},
+{"alpha2":"","alpha3":"seh","name":"Sena"},
+{"alpha2":"","alpha3":"ses","name":"Koyraboro Senni"},
+{"alpha2":"","alpha3":"shi","name":"Tachelhit"},
+{"alpha2":"si","alpha3":"sin","name":"Sinhala"},
+{"alpha2":"sk","alpha3":"slk","name":"Slovak"},
+{"alpha2":"sl","alpha3":"slv","name":"Slovenian"},
+{"alpha2":"se","alpha3":"sme","name":"Northern Sami"},
+{"alpha2":"","alpha3":"smn","name":"Inari Sami"},
+{"alpha2":"sm","alpha3":"smo","name":"Samoan"},
+{"alpha2":"sn","alpha3":"sna","name":"Shona"},
+{"alpha2":"sd","alpha3":"snd","name":"Sindhi"},
+{"alpha2":"so","alpha3":"som","name":"Somali"},
+{"alpha2":"st","alpha3":"sot","name":"Southern Sotho"},
+{"alpha2":"es","alpha3":"spa","name":"Spanish"},
+{"alpha2":"sq","alpha3":"sqi","name":"Albanian"},
+{"alpha2":"sc","alpha3":"srd","name":"Sardinian"},
+{"alpha2":"sr","alpha3":"srp","name":"Serbian"},
+{"alpha2":"ss","alpha3":"ssw","name":"Swati"},
+{"alpha2":"sw","alpha3":"swa","name":"Swahili"},
+{"alpha2":"sv","alpha3":"swe","name":"Swedish"},
+{"alpha2":"ta","alpha3":"tam","name":"Tamil"},
+{"alpha2":"tt","alpha3":"tat","name":"Tatar"},
+{"alpha2":"te","alpha3":"tel","name":"Telugu"},
+{"alpha2":"","alpha3":"teo","name":"Teso"},
+{"alpha2":"","alpha3":"tet","name":"Tetum"},
+{"alpha2":"tg","alpha3":"tgk","name":"Tajik"},
+{"alpha2":"th","alpha3":"tha","name":"Thai"},
+{"alpha2":"ti","alpha3":"tir","name":"Tigrinya"},
+{"alpha2":"","alpha3":"tkl","name":"Tokelau"},
+{"alpha2":"","alpha3":"toi","name":"Tonga (Zambia)"},
+{"alpha2":"to","alpha3":"ton","name":"Tongan"},
+{"alpha2":"","alpha3":"tpi","name":"Tok Pisin"},
+{"alpha2":"tn","alpha3":"tsn","name":"Tswana"},
+{"alpha2":"ts","alpha3":"tso","name":"Tsonga"},
+{"alpha2":"tk","alpha3":"tuk","name":"Turkmen"},
+{"alpha2":"tr","alpha3":"tur","name":"Turkish"},
+{"alpha2":"","alpha3":"tvl","name":"Tuvalu"},
+{"alpha2":"","alpha3":"twq","name":"Tasawaq"},
+{"alpha2":"","alpha3":"tzm","name":"Central Atlas Tamazight"},
+{"alpha2":"ug","alpha3":"uig","name":"Uyghur"},
+{"alpha2":"uk","alpha3":"ukr","name":"Ukrainian"},
+{"alpha2":"ur","alpha3":"urd","name":"Urdu"},
+{"alpha2":"uz","alpha3":"uzb","name":"Uzbek"},
+{"alpha2":"","alpha3":"vai","name":"Vai"},
+{"alpha2":"ve","alpha3":"ven","name":"Venda"},
+{"alpha2":"vi","alpha3":"vie","name":"Vietnamese"},
+{"alpha2":"","alpha3":"vun","name":"Vunjo"},
+{"alpha2":"","alpha3":"wae","name":"Walser"},
+{"alpha2":"wo","alpha3":"wol","name":"Wolof"},
+{"alpha2":"xh","alpha3":"xho","name":"Xhosa"},
+{"alpha2":"","alpha3":"xog","name":"Soga"},
+{"alpha2":"","alpha3":"yav","name":"Yangben"},
+{"alpha2":"yo","alpha3":"yor","name":"Yoruba"},
+{"alpha2":"","alpha3":"yue","name":"Cantonese"},
+{"alpha2":"","alpha3":"zdj","name":"Ngazidja Comorian"},
+{"alpha2":"","alpha3":"zgh","name":"Standard Moroccan Tamazight"},
+{"alpha2":"zh","alpha3":"zho","name":"Chinese"},
+{"alpha2":"","alpha3":"zib","name":"Zimbabwe Sign Language"},
+{"alpha2":"zu","alpha3":"zul","name":"Zulu"}
+]`
@@ -0,0 +1,61 @@
+package data
+
+// EXAMPLE DATA
+/*
+  {
+    "language":"de",
+    "names":{"AD":"Andorra","AE":"Vereinigte Arabische Emirate","AF":"Afghanistan"}
+  }
+*/
+
+// LocalizedNameJSONData is the raw JSON for the names of every country in the major UN and EU languages
+// Languages are BCP 47 tags (e.g., "de", "zh-Hant") and names are keyed by ISO 3166-1 alpha-2 code.
+// The list covers the six UN languages, the 24 EU languages and other widely used languages
+// (e.g., Hindi, Japanese, Norwegian Bokmål), with Chinese in both simplified (zh) and traditional (zh-Hant) script.
+// Source: Unicode CLDR 42 (territory display names, as shipped with ICU 72).
+const LocalizedNameJSONData = `[
+{"language":"ar","names":{"AD":"أندورا","AE":"الإمارات العربية المتحدة","AF":"أفغانستان","AG":"أنتيغوا وبربودا","AI":"أنغويلا","AL":"ألبانيا","AM":"أرمينيا","AO":"أنغولا","AQ":"أنتاركتيكا","AR":"الأرجنتين","AS":"ساموا الأمريكية","AT":"النمسا","AU":"أستراليا","AW":"أروبا","AX":"جزر آلاند","AZ":"أذربيجان","BA":"البوسنة والهرسك","BB":"بربادوس","BD":"بنغلاديش","BE":"بلجيكا","BF":"بوركينا فاسو","BG":"بلغاريا","BH":"البحرين","BI":"بوروندي","BJ":"بنين","BL":"سان بارتليمي","BM":"برمودا","BN":"بروناي","BO":"بوليفيا","BQ":"هولندا الكاريبية","BR":"البرازيل","BS":"جزر البهاما","BT":"بوتان","BV":"جزيرة بوفيه","BW":"بوتسوانا","BY":"بيلاروس","BZ":"بليز","CA":"كندا","CC":"جزر كوكوس (كيلينغ)","CD":"الكونغو - كينشاسا","CF":"جمهورية أفريقيا الوسطى","CG":"الكونغو - برازافيل","CH":"سويسرا","CI":"ساحل العاج","CK":"جزر كوك","CL":"تشيلي","CM":"الكاميرون","CN":"الصين","CO":"كولومبيا","CR":"كوستاريكا","CU":"كوبا","CV":"الرأس الأخضر","CW":"كوراساو","CX":"جزيرة كريسماس","CY":"قبرص","CZ":"التشيك","DE":"ألمانيا","DJ":"جيبوتي","DK":"الدانمرك","DM":"دومينيكا","DO":"جمهورية الدومينيكان","DZ":"الجزائر","EC":"الإكوادور","EE":"إستونيا","EG":"مصر","EH":"الصحراء الغربية","ER":"إريتريا","ES":"إسبانيا","ET":"إثيوبيا","FI":"فنلندا","FJ":"فيجي","FK":"جزر فوكلاند","FM":"ميكرونيزيا","FO":"جزر فارو","FR":"فرنسا","GA":"الغابون","GB":"المملكة المتحدة","GD":"غرينادا","GE":"جورجيا","GF":"غويانا الفرنسية","GG":"غيرنزي","GH":"غانا","GI":"جبل طارق","GL":"غرينلاند","GM":"غامبيا","GN":"غينيا","GP":"غوادلوب","GQ":"غينيا الاستوائية","GR":"اليونان","GS":"جورجيا الجنوبية وجزر ساندويتش الجنوبية","GT":"غواتيمالا","GU":"غوام","GW":"غينيا بيساو","GY":"غيانا","HK":"هونغ كونغ الصينية (منطقة إدارية خاصة)","HM":"جزيرة هيرد وجزر ماكدونالد","HN":"هندوراس","HR":"كرواتيا","HT":"هايتي","HU":"هنغاريا","ID":"إندونيسيا","IE":"أيرلندا","IL":"إسرائيل","IM":"جزيرة مان","IN":"الهند","IO":"الإقليم البريطاني في المحيط الهندي","IQ":"العراق","IR":"إيران","IS":"آيسلندا","IT":"إيطاليا","JE":"جيرسي","JM":"جامايكا","JO":"الأردن","JP":"اليابان","KE":"كينيا","KG":"قيرغيزستان","KH":"كمبوديا","KI":"كيريباتي","KM":"جزر القمر","KN":"سانت كيتس ونيفيس","KP":"كوريا الشمالية","KR":"كوريا الجنوبية","KW":"الكويت","KY":"جزر كايمان","KZ":"كازاخستان","LA":"لاوس","LB":"لبنان","LC":"سانت لوسيا","LI":"ليختنشتاين","LK":"سريلانكا","LR":"ليبيريا","LS":"ليسوتو","LT":"ليتوانيا","LU":"لوكسمبورغ","LV":"لاتفيا","LY":"ليبيا","MA":"المغرب","MC":"موناكو","MD":"مولدوفا","ME":"الجبل الأسود","MF":"سان مارتن","MG":"مدغشقر","MH":"جزر مارشال","MK":"مقدونيا الشمالية","ML":"مالي","MM":"ميانمار (بورما)","MN":"منغوليا","MO":"منطقة ماكاو الإدارية الخاصة","MP":"جزر ماريانا الشمالية","MQ":"جزر المارتينيك","MR":"موريتانيا","MS":"مونتسرات","MT":"مالطا","MU":"موريشيوس","MV":"جزر المالديف","MW":"ملاوي","MX":"المكسيك","MY":"ماليزيا","MZ":"موزمبيق","NA":"ناميبيا","NC":"كاليدونيا الجديدة","NE":"النيجر","NF":"جزيرة نورفولك","NG":"نيجيريا","NI":"نيكاراغوا","NL":"هولندا","NO":"النرويج","NP":"نيبال","NR":"ناورو","NU":"نيوي","NZ":"نيوزيلندا","OM":"عُمان","PA":"بنما","PE":"بيرو","PF":"بولينيزيا الفرنسية","PG":"بابوا غينيا الجديدة","PH":"الفلبين","PK":"باكستان","PL":"بولندا","PM":"سان بيير ومكويلون","PN":"جزر بيتكيرن","PR":"بورتوريكو","PS":"الأراضي الفلسطينية","PT":"البرتغال","PW":"بالاو","PY":"باراغواي","QA":"قطر","RE":"روينيون","RO":"رومانيا","RS":"صربيا","RU":"روسيا","RW":"رواندا","SA":"المملكة العربية السعودية","SB":"جزر سليمان","SC":"سيشل","SD":"السودان","SE":"السويد","SG":"سنغافورة","SH":"سانت هيلينا","SI":"سلوفينيا","SJ":"سفالبارد وجان ماين","SK":"سلوفاكيا","SL":"سيراليون","SM":"سان مارينو","SN":"السنغال","SO":"الصومال","SR":"سورينام","SS":"جنوب السودان","ST":"ساو تومي وبرينسيبي","SV":"السلفادور","SX":"سانت مارتن","SY":"سوريا","SZ":"إسواتيني","TC":"جزر توركس وكايكوس","TD":"تشاد","TF":"الأقاليم الجنوبية الفرنسية","TG":"توغو","TH":"تايلاند","TJ":"طاجيكستان","TK":"توكيلو","TL":"تيمور - ليشتي","TM":"تركمانستان","TN":"تونس","TO":"تونغا","TR":"تركيا","TT":"ترينيداد وتوباغو","TV":"توفالو","TW":"تايوان","TZ":"تنزانيا","UA":"أوكرانيا","UG":"أوغندا","UM":"جزر الولايات المتحدة النائية","US":"الولايات المتحدة","UY":"أورغواي","UZ":"أوزبكستان","VA":"الفاتيكان","VC":"سانت فنسنت وجزر غرينادين","VE":"فنزويلا","VG":"جزر فيرجن البريطانية","VI":"جزر فيرجن التابعة للولايات المتحدة","VN":"فيتنام","VU":"فانواتو","WF":"جزر والس وفوتونا","WS":"ساموا","YE":"اليمن","YT":"مايوت","ZA":"جنوب أفريقيا","ZM":"زامبيا","ZW":"زيمبابوي"}},
+{"language":"bg","names":{"AD":"Андора","AE":"Обединени арабски емирства","AF":"Афганистан","AG":"Антигуа и Барбуда","AI":"Ангуила","AL":"Албания","AM":"Армения","AO":"Ангола","AQ":"Антарктика","AR":"Аржентина","AS":"Американска Самоа","AT":"Австрия","AU":"Австралия","AW":"Аруба","AX":"Оландски острови","AZ":"Азербайджан","BA":"Босна и Херцеговина","BB":"Барбадос","BD":"Бангладеш","BE":"Белгия","BF":"Буркина Фасо","BG":"България","BH":"Бахрейн","BI":"Бурунди","BJ":"Бенин","BL":"Сен Бартелеми","BM":"Бермудски острови","BN":"Бруней Даруссалам","BO":"Боливия","BQ":"Карибска Нидерландия","BR":"Бразилия","BS":"Бахамски острови","BT":"Бутан","BV":"остров Буве","BW":"Ботсвана","BY":"Беларус","BZ":"Белиз","CA":"Канада","CC":"Кокосови острови (острови Кийлинг)","CD":"Конго (Киншаса)","CF":"Централноафриканска република","CG":"Конго (Бразавил)","CH":"Швейцария","CI":"Кот д’Ивоар","CK":"острови Кук","CL":"Чили","CM":"Камерун","CN":"Китай","CO":"Колумбия","CR":"Коста Рика","CU":"Куба","CV":"Кабо Верде","CW":"Кюрасао","CX":"остров Рождество","CY":"Кипър","CZ":"Чехия","DE":"Германия","DJ":"Джибути","DK":"Дания","DM":"Доминика","DO":"Доминиканска република","DZ":"Алжир","EC":"Еквадор","EE":"Естония","EG":"Египет","EH":"Западна Сахара","ER":"Еритрея","ES":"Испания","ET":"Етиопия","FI":"Финландия","FJ":"Фиджи","FK":"Фолкландски острови","FM":"Микронезия","FO":"Фарьорски острови","FR":"Франция","GA":"Габон","GB":"Обединеното кралство","GD":"Гренада","GE":"Грузия","GF":"Френска Гвиана","GG":"Гърнзи","GH":"Гана","GI":"Гибралтар","GL":"Гренландия","GM":"Гамбия","GN":"Гвинея","GP":"Гваделупа","GQ":"Екваториална Гвинея","GR":"Гърция","GS":"Южна Джорджия и Южни Сандвичеви острови","GT":"Гватемала","GU":"Гуам","GW":"Гвинея-Бисау","GY":"Гаяна","HK":"Хонконг, САР на Китай","HM":"острови Хърд и Макдоналд","HN":"Хондурас","HR":"Хърватия","HT":"Хаити","HU":"Унгария","ID":"Индонезия","IE":"Ирландия","IL":"Израел","IM":"остров Ман","IN":"Индия","IO":"Британска територия в Индийския океан","IQ":"Ирак","IR":"Иран","IS":"Исландия","IT":"Италия","JE":"Джърси","JM":"Ямайка","JO":"Йордания","JP":"Япония","KE":"Кения","KG":"Киргизстан","KH":"Камбоджа","KI":"Кирибати","KM":"Коморски острови","KN":"Сейнт Китс и Невис","KP":"Северна Корея","KR":"Южна Корея","KW":"Кувейт","KY":"Кайманови острови","KZ":"Казахстан","LA":"Лаос","LB":"Ливан","LC":"Сейнт Лусия","LI":"Лихтенщайн","LK":"Шри Ланка","LR":"Либерия","LS":"Лесото","LT":"Литва","LU":"Люксембург","LV":"Латвия","LY":"Либия","MA":"Мароко","MC":"Монако","MD":"Молдова","ME":"Черна гора","MF":"Сен Мартен","MG":"Мадагаскар","MH":"Маршалови острови","MK":"Северна Македония","ML":"Мали","MM":"Мианмар (Бирма)","MN":"Монголия","MO":"Макао, САР на Китай","MP":"Северни Мариански острови","MQ":"Мартиника","MR":"Мавритания","MS":"Монтсерат","MT":"Малта","MU":"Мавриций","MV":"Малдиви","MW":"Малави","MX":"Мексико","MY":"Малайзия","MZ":"Мозамбик","NA":"Намибия","NC":"Нова Каледония","NE":"Нигер","NF":"остров Норфолк","NG":"Нигерия","NI":"Никарагуа","NL":"Нидерландия","NO":"Норвегия","NP":"Непал","NR":"Науру","NU":"Ниуе","NZ":"Нова Зеландия","OM":"Оман","PA":"Панама","PE":"Перу","PF":"Френска Полинезия","PG":"Папуа-Нова Гвинея","PH":"Филипини","PK":"Пакистан","PL":"Полша","PM":"Сен Пиер и Микелон","PN":"Острови Питкерн","PR":"Пуерто Рико","PS":"Палестински територии","PT":"Португалия","PW":"Палау","PY":"Парагвай","QA":"Катар","RE":"Реюнион","RO":"Румъния","RS":"Сърбия","RU":"Русия","RW":"Руанда","SA":"Саудитска Арабия","SB":"Соломонови острови","SC":"Сейшели","SD":"Судан","SE":"Швеция","SG":"Сингапур","SH":"Света Елена","SI":"Словения","SJ":"Свалбард и Ян Майен","SK":"Словакия","SL":"Сиера Леоне","SM":"Сан Марино","SN":"Сенегал","SO":"Сомалия","SR":"Суринам","SS":"Южен Судан","ST":"Сао Томе и Принсипи","SV":"Салвадор","SX":"Синт Мартен","SY":"Сирия","SZ":"Есватини","TC":"острови Търкс и Кайкос","TD":"Чад","TF":"Френски южни територии","TG":"Того","TH":"Тайланд","TJ":"Таджикистан","TK":"Токелау","TL":"Тимор Лесте","TM":"Туркменистан","TN":"Тунис","TO":"Тонга","TR":"Турция","TT":"Тринидад и Тобаго","TV":"Тувалу","TW":"Тайван","TZ":"Танзания","UA":"Украйна","UG":"Уганда","UM":"Отдалечени острови на САЩ","US":"Съединени щати","UY":"Уругвай","UZ":"Узбекистан","VA":"Ватикан","VC":"Сейнт Винсънт и Гренадини","VE":"Венецуела","VG":"Британски Вирджински острови","VI":"Американски Вирджински острови","VN":"Виетнам","VU":"Вануату","WF":"Уолис и Футуна","WS":"Самоа","YE":"Йемен","YT":"Майот","ZA":"Южна Африка","ZM":"Замбия","ZW":"Зимбабве"}},
+{"language":"bn","names":{"AD":"আন্ডোরা","AE":"সংযুক্ত আরব আমিরাত","AF":"আফগানিস্তান","AG":"অ্যান্টিগুয়া ও বারবুডা","AI":"অ্যাঙ্গুইলা","AL":"আলবেনিয়া","AM":"আর্মেনিয়া","AO":"অ্যাঙ্গোলা","AQ":"অ্যান্টার্কটিকা","AR":"আর্জেন্টিনা","AS":"আমেরিকান সামোয়া","AT":"অস্ট্রিয়া","AU":"অস্ট্রেলিয়া","AW":"আরুবা","AX":"অলান্ড দ্বীপপুঞ্জ","AZ":"আজারবাইজান","BA":"বসনিয়া ও হার্জেগোভিনা","BB":"বার্বাডোজ","BD":"বাংলাদেশ","BE":"বেলজিয়াম","BF":"বুরকিনা ফাসো","BG":"বুলগেরিয়া","BH":"বাহারিন","BI":"বুরুন্ডি","BJ":"বেনিন","BL":"সেন্ট বার্থেলেমি","BM":"বারমুডা","BN":"ব্রুনেই","BO":"বলিভিয়া","BQ":"ক্যারিবিয়ান নেদারল্যান্ডস","BR":"ব্রাজিল","BS":"বাহামা দ্বীপপুঞ্জ","BT":"ভুটান","BV":"বোভেট দ্বীপ","BW":"বতসোয়ানা","BY":"বেলারুশ","BZ":"বেলিজ","CA":"কানাডা","CC":"কোকোস (কিলিং) দ্বীপপুঞ্জ","CD":"কঙ্গো-কিনশাসা","CF":"মধ্য আফ্রিকার প্রজাতন্ত্র","CG":"কঙ্গো - ব্রাজাভিল","CH":"সুইজারল্যান্ড","CI":"কোট ডি‘আইভোর","CK":"কুক দ্বীপপুঞ্জ","CL":"চিলি","CM":"ক্যামেরুন","CN":"চীন","CO":"কলম্বিয়া","CR":"কোস্টারিকা","CU":"কিউবা","CV":"কেপ ভার্দে","CW":"কুরাসাও","CX":"ক্রিসমাস দ্বীপ","CY":"সাইপ্রাস","CZ":"চেকিয়া","DE":"জার্মানি","DJ":"জিবুতি","DK":"ডেনমার্ক","DM":"ডোমিনিকা","DO":"ডোমেনিকান প্রজাতন্ত্র","DZ":"আলজেরিয়া","EC":"ইকুয়েডর","EE":"এস্তোনিয়া","EG":"মিশর","EH":"পশ্চিম সাহারা","ER":"ইরিত্রিয়া","ES":"স্পেন","ET":"ইথিওপিয়া","FI":"ফিনল্যান্ড","FJ":"ফিজি","FK":"ফকল্যান্ড দ্বীপপুঞ্জ","FM":"মাইক্রোনেশিয়া","FO":"ফ্যারো দ্বীপপুঞ্জ","FR":"ফ্রান্স","GA":"গ্যাবন","GB":"যুক্তরাজ্য","GD":"গ্রেনাডা","GE":"জর্জিয়া","GF":"ফরাসী গায়ানা","GG":"গার্নসি","GH":"ঘানা","GI":"জিব্রাল্টার","GL":"গ্রীনল্যান্ড","GM":"গাম্বিয়া","GN":"গিনি","GP":"গুয়াদেলৌপ","GQ":"নিরক্ষীয় গিনি","GR":"গ্রীস","GS":"দক্ষিণ জর্জিয়া ও দক্ষিণ স্যান্ডউইচ দ্বীপপুঞ্জ","GT":"গুয়াতেমালা","GU":"গুয়াম","GW":"গিনি-বিসাউ","GY":"গিয়ানা","HK":"হংকং এসএআর চীনা","HM":"হার্ড এবং ম্যাকডোনাল্ড দ্বীপপুঞ্জ","HN":"হন্ডুরাস","HR":"ক্রোয়েশিয়া","HT":"হাইতি","HU":"হাঙ্গেরি","ID":"ইন্দোনেশিয়া","IE":"আয়ারল্যান্ড","IL":"ইজরায়েল","IM":"আইল অফ ম্যান","IN":"ভারত","IO":"ব্রিটিশ ভারত মহাসাগরীয় অঞ্চল","IQ":"ইরাক","IR":"ইরান","IS":"আইসল্যান্ড","IT":"ইতালি","JE":"জার্সি","JM":"জামাইকা","JO":"জর্ডন","JP":"জাপান","KE":"কেনিয়া","KG":"কিরগিজিস্তান","KH":"কম্বোডিয়া","KI":"কিরিবাতি","KM":"কমোরোস","KN":"সেন্ট কিটস ও নেভিস","KP":"উত্তর কোরিয়া","KR":"দক্ষিণ কোরিয়া","KW":"কুয়েত","KY":"কেম্যান দ্বীপপুঞ্জ","KZ":"কাজাখস্তান","LA":"লাওস","LB":"লেবানন","LC":"সেন্ট লুসিয়া","LI":"লিচেনস্টেইন","LK":"শ্রীলঙ্কা","LR":"লাইবেরিয়া","LS":"লেসোথো","LT":"লিথুয়ানিয়া","LU":"লাক্সেমবার্গ","LV":"লাটভিয়া","LY":"লিবিয়া","MA":"মোরক্কো","MC":"মোনাকো","MD":"মলডোভা","ME":"মন্টিনিগ্রো","MF":"সেন্ট মার্টিন","MG":"মাদাগাস্কার","MH":"মার্শাল দ্বীপপুঞ্জ","MK":"উত্তর ম্যাসেডোনিয়া","ML":"মালি","MM":"মায়ানমার (বার্মা)","MN":"মঙ্গোলিয়া","MO":"ম্যাকাও এসএআর চীন","MP":"উত্তরাঞ্চলীয় মারিয়ানা দ্বীপপুঞ্জ","MQ":"মার্টিনিক","MR":"মরিতানিয়া","MS":"মন্টসেরাট","MT":"মাল্টা","MU":"মরিশাস","MV":"মালদ্বীপ","MW":"মালাউই","MX":"মেক্সিকো","MY":"মালয়েশিয়া","MZ":"মোজাম্বিক","NA":"নামিবিয়া","NC":"নিউ ক্যালেডোনিয়া","NE":"নাইজার","NF":"নরফোক দ্বীপ","NG":"নাইজেরিয়া","NI":"নিকারাগুয়া","NL":"নেদারল্যান্ডস","NO":"নরওয়ে","NP":"নেপাল","NR":"নাউরু","NU":"নিউয়ে","NZ":"নিউজিল্যান্ড","OM":"ওমান","PA":"পানামা","PE":"পেরু","PF":"ফরাসী পলিনেশিয়া","PG":"পাপুয়া নিউ গিনি","PH":"ফিলিপাইন","PK":"পাকিস্তান","PL":"পোল্যান্ড","PM":"সেন্ট পিয়ের ও মিকুয়েলন","PN":"পিটকেয়ার্ন দ্বীপপুঞ্জ","PR":"পুয়ের্তো রিকো","PS":"প্যালেস্টাইন ভূখণ্ড","PT":"পর্তুগাল","PW":"পালাউ","PY":"প্যারাগুয়ে","QA":"কাতার","RE":"রিইউনিয়ন","RO":"রোমানিয়া","RS":"সার্বিয়া","RU":"রাশিয়া","RW":"রুয়ান্ডা","SA":"সৌদি আরব","SB":"সলোমন দ্বীপপুঞ্জ","SC":"সিসিলি","SD":"সুদান","SE":"সুইডেন","SG":"সিঙ্গাপুর","SH":"সেন্ট হেলেনা","SI":"স্লোভানিয়া","SJ":"স্বালবার্ড ও জান মেয়েন","SK":"স্লোভাকিয়া","SL":"সিয়েরা লিওন","SM":"সান মারিনো","SN":"সেনেগাল","SO":"সোমালিয়া","SR":"সুরিনাম","SS":"দক্ষিণ সুদান","ST":"সাওটোমা ও প্রিন্সিপি","SV":"এল সালভেদর","SX":"সিন্ট মার্টেন","SY":"সিরিয়া","SZ":"ইসওয়াতিনি","TC":"তুর্কস ও কাইকোস দ্বীপপুঞ্জ","TD":"চাদ","TF":"ফরাসী দক্ষিণাঞ্চল","TG":"টোগো","TH":"থাইল্যান্ড","TJ":"তাজিকিস্তান","TK":"টোকেলাউ","TL":"তিমুর-লেস্তে","TM":"তুর্কমেনিস্তান","TN":"তিউনিসিয়া","TO":"টোঙ্গা","TR":"তুরস্ক","TT":"ত্রিনিনাদ ও টোব্যাগো","TV":"টুভালু","TW":"তাইওয়ান","TZ":"তাঞ্জানিয়া","UA":"ইউক্রেন","UG":"উগান্ডা","UM":"যুক্তরাষ্ট্রের পার্শ্ববর্তী দ্বীপপুঞ্জ","US":"মার্কিন যুক্তরাষ্ট্র","UY":"উরুগুয়ে","UZ":"উজবেকিস্তান","VA":"ভ্যাটিকান সিটি","VC":"সেন্ট ভিনসেন্ট ও গ্রেনাডিনস","VE":"ভেনেজুয়েলা","VG":"ব্রিটিশ ভার্জিন দ্বীপপুঞ্জ","VI":"মার্কিন যুক্তরাষ্ট্রীয় ভার্জিন দ্বীপপুঞ্জ","VN":"ভিয়েতনাম","VU":"ভানুয়াটু","WF":"ওয়ালিস ও ফুটুনা","WS":"সামোয়া","YE":"ইয়েমেন","YT":"মায়োত্তে","ZA":"দক্ষিণ আফ্রিকা","ZM":"জাম্বিয়া","ZW":"জিম্বাবোয়ে"}},
+{"language":"cs","names":{"AD":"Andorra","AE":"Spojené arabské emiráty","AF":"Afghánistán","AG":"Antigua a Barbuda","AI":"Anguilla","AL":"Albánie","AM":"Arménie","AO":"Angola","AQ":"Antarktida","AR":"Argentina","AS":"Americká Samoa","AT":"Rakousko","AU":"Austrálie","AW":"Aruba","AX":"Ålandy","AZ":"Ázerbájdžán","BA":"Bosna a Hercegovina","BB":"Barbados","BD":"Bangladéš","BE":"Belgie","BF":"Burkina Faso","BG":"Bulharsko","BH":"Bahrajn","BI":"Burundi","BJ":"Benin","BL":"Svatý Bartoloměj","BM":"Bermudy","BN":"Brunej","BO":"Bolívie","BQ":"Karibské Nizozemsko","BR":"Brazílie","BS":"Bahamy","BT":"Bhútán","BV":"Bouvetův ostrov","BW":"Botswana","BY":"Bělorusko","BZ":"Belize","CA":"Kanada","CC":"Kokosové ostrovy","CD":"Kongo – Kinshasa","CF":"Středoafrická republika","CG":"Kongo – Brazzaville","CH":"Švýcarsko","CI":"Pobřeží slonoviny","CK":"Cookovy ostrovy","CL":"Chile","CM":"Kamerun","CN":"Čína","CO":"Kolumbie","CR":"Kostarika","CU":"Kuba","CV":"Kapverdy","CW":"Curaçao","CX":"Vánoční ostrov","CY":"Kypr","CZ":"Česko","DE":"Německo","DJ":"Džibutsko","DK":"Dánsko","DM":"Dominika","DO":"Dominikánská republika","DZ":"Alžírsko","EC":"Ekvádor","EE":"Estonsko","EG":"Egypt","EH":"Západní Sahara","ER":"Eritrea","ES":"Španělsko","ET":"Etiopie","FI":"Finsko","FJ":"Fidži","FK":"Falklandské ostrovy","FM":"Mikronésie","FO":"Faerské ostrovy","FR":"Francie","GA":"Gabon","GB":"Spojené království","GD":"Grenada","GE":"Gruzie","GF":"Francouzská Guyana","GG":"Guernsey","GH":"Ghana","GI":"Gibraltar","GL":"Grónsko","GM":"Gambie","GN":"Guinea","GP":"Guadeloupe","GQ":"Rovníková Guinea","GR":"Řecko","GS":"Jižní Georgie a Jižní Sandwichovy ostrovy","GT":"Guatemala","GU":"Guam","GW":"Guinea-Bissau","GY":"Guyana","HK":"Hongkong – ZAO Číny","HM":"Heardův ostrov a McDonaldovy ostrovy","HN":"Honduras","HR":"Chorvatsko","HT":"Haiti","HU":"Maďarsko","ID":"Indonésie","IE":"Irsko","IL":"Izrael","IM":"Ostrov Man","IN":"Indie","IO":"Britské indickooceánské území","IQ":"Irák","IR":"Írán","IS":"Island","IT":"Itálie","JE":"Jersey","JM":"Jamajka","JO":"Jordánsko","JP":"Japonsko","KE":"Keňa","KG":"Kyrgyzstán","KH":"Kambodža","KI":"Kiribati","KM":"Komory","KN":"Svatý Kryštof a Nevis","KP":"Severní Korea","KR":"Jižní Korea","KW":"Kuvajt","KY":"Kajmanské ostrovy","KZ":"Kazachstán","LA":"Laos","LB":"Libanon","LC":"Svatá Lucie","LI":"Lichtenštejnsko","LK":"Srí Lanka","LR":"Libérie","LS":"Lesotho","LT":"Litva","LU":"Lucembursko","LV":"Lotyšsko","LY":"Libye","MA":"Maroko","MC":"Monako","MD":"Moldavsko","ME":"Černá Hora","MF":"Svatý Martin (Francie)","MG":"Madagaskar","MH":"Marshallovy ostrovy","MK":"Severní Makedonie","ML":"Mali","MM":"Myanmar (Barma)","MN":"Mongolsko","MO":"Macao – ZAO Číny","MP":"Severní Mariany","MQ":"Martinik","MR":"Mauritánie","MS":"Montserrat","MT":"Malta","MU":"Mauricius","MV":"Maledivy","MW":"Malawi","MX":"Mexiko","MY":"Malajsie","MZ":"Mosambik","NA":"Namibie","NC":"Nová Kaledonie","NE":"Niger","NF":"Norfolk","NG":"Nigérie","NI":"Nikaragua","NL":"Nizozemsko","NO":"Norsko","NP":"Nepál","NR":"Nauru","NU":"Niue","NZ":"Nový Zéland","OM":"Omán","PA":"Panama","PE":"Peru","PF":"Francouzská Polynésie","PG":"Papua-Nová Guinea","PH":"Filipíny","PK":"Pákistán","PL":"Polsko","PM":"Saint-Pierre a Miquelon","PN":"Pitcairnovy ostrovy","PR":"Portoriko","PS":"Palestinská území","PT":"Portugalsko","PW":"Palau","PY":"Paraguay","QA":"Katar","RE":"Réunion","RO":"Rumunsko","RS":"Srbsko","RU":"Rusko","RW":"Rwanda","SA":"Saúdská Arábie","SB":"Šalamounovy ostrovy","SC":"Seychely","SD":"Súdán","SE":"Švédsko","SG":"Singapur","SH":"Svatá Helena","SI":"Slovinsko","SJ":"Špicberky a Jan Mayen","SK":"Slovensko","SL":"Sierra Leone","SM":"San Marino","SN":"Senegal","SO":"Somálsko","SR":"Surinam","SS":"Jižní Súdán","ST":"Svatý Tomáš a Princův ostrov","SV":"Salvador","SX":"Svatý Martin (Nizozemsko)","SY":"Sýrie","SZ":"Eswatini","TC":"Turks a Caicos","TD":"Čad","TF":"Francouzská jižní území","TG":"Togo","TH":"Thajsko","TJ":"Tádžikistán","TK":"Tokelau","TL":"Východní Timor","TM":"Turkmenistán","TN":"Tunisko","TO":"Tonga","TR":"Turecko","TT":"Trinidad a Tobago","TV":"Tuvalu","TW":"Tchaj-wan","TZ":"Tanzanie","UA":"Ukrajina","UG":"Uganda","UM":"Menší odlehlé ostrovy USA","US":"Spojené státy","UY":"Uruguay","UZ":"Uzbekistán","VA":"Vatikán","VC":"Svatý Vincenc a Grenadiny","VE":"Venezuela","VG":"Britské Panenské ostrovy","VI":"Americké Panenské ostrovy","VN":"Vietnam","VU":"Vanuatu","WF":"Wallis a Futuna","WS":"Samoa","YE":"Jemen","YT":"Mayotte","ZA":"Jihoafrická republika","ZM":"Zambie","ZW":"Zimbabwe"}},
+{"language":"da","names":{"AD":"Andorra","AE":"De Forenede Arabiske Emirater","AF":"Afghanistan","AG":"Antigua og Barbuda","AI":"Anguilla","AL":"Albanien","AM":"Armenien","AO":"Angola","AQ":"Antarktis","AR":"Argentina","AS":"Amerikansk Samoa","AT":"Østrig","AU":"Australien","AW":"Aruba","AX":"Åland","AZ":"Aserbajdsjan","BA":"Bosnien-Hercegovina","BB":"Barbados","BD":"Bangladesh","BE":"Belgien","BF":"Burkina Faso","BG":"Bulgarien","BH":"Bahrain","BI":"Burundi","BJ":"Benin","BL":"Saint Barthélemy","BM":"Bermuda","BN":"Brunei","BO":"Bolivia","BQ":"De tidligere Nederlandske Antiller","BR":"Brasilien","BS":"Bahamas","BT":"Bhutan","BV":"Bouvetøen","BW":"Botswana","BY":"Belarus","BZ":"Belize","CA":"Canada","CC":"Cocosøerne","CD":"Congo-Kinshasa","CF":"Den Centralafrikanske Republik","CG":"Congo-Brazzaville","CH":"Schweiz","CI":"Elfenbenskysten","CK":"Cookøerne","CL":"Chile","CM":"Cameroun","CN":"Kina","CO":"Colombia","CR":"Costa Rica","CU":"Cuba","CV":"Kap Verde","CW":"Curaçao","CX":"Juleøen","CY":"Cypern","CZ":"Tjekkiet","DE":"Tyskland","DJ":"Djibouti","DK":"Danmark","DM":"Dominica","DO":"Den Dominikanske Republik","DZ":"Algeriet","EC":"Ecuador","EE":"Estland","EG":"Egypten","EH":"Vestsahara","ER":"Eritrea","ES":"Spanien","ET":"Etiopien","FI":"Finland","FJ":"Fiji","FK":"Falklandsøerne","FM":"Mikronesien","FO":"Færøerne","FR":"Frankrig","GA":"Gabon","GB":"Storbritannien","GD":"Grenada","GE":"Georgien","GF":"Fransk Guyana","GG":"Guernsey","GH":"Ghana","GI":"Gibraltar","GL":"Grønland","GM":"Gambia","GN":"Guinea","GP":"Guadeloupe","GQ":"Ækvatorialguinea","GR":"Grækenland","GS":"South Georgia og De Sydlige Sandwichøer","GT":"Guatemala","GU":"Guam","GW":"Guinea-Bissau","GY":"Guyana","HK":"SAR Hongkong","HM":"Heard Island og McDonald Islands","HN":"Honduras","HR":"Kroatien","HT":"Haiti","HU":"Ungarn","ID":"Indonesien","IE":"Irland","IL":"Israel","IM":"Isle of Man","IN":"Indien","IO":"Det Britiske Territorium i Det Indiske Ocean","IQ":"Irak","IR":"Iran","IS":"Island","IT":"Italien","JE":"Jersey","JM":"Jamaica","JO":"Jordan","JP":"Japan","KE":"Kenya","KG":"Kirgisistan","KH":"Cambodja","KI":"Kiribati","KM":"Comorerne","KN":"Saint Kitts og Nevis","KP":"Nordkorea","KR":"Sydkorea","KW":"Kuwait","KY":"Caymanøerne","KZ":"Kasakhstan","LA":"Laos","LB":"Libanon","LC":"Saint Lucia","LI":"Liechtenstein","LK":"Sri Lanka","LR":"Liberia","LS":"Lesotho","LT":"Litauen","LU":"Luxembourg","LV":"Letland","LY":"Libyen","MA":"Marokko","MC":"Monaco","MD":"Moldova","ME":"Montenegro","MF":"Saint Martin","MG":"Madagaskar","MH":"Marshalløerne","MK":"Nordmakedonien","ML":"Mali","MM":"Myanmar (Burma)","MN":"Mongoliet","MO":"SAR Macao","MP":"Nordmarianerne","MQ":"Martinique","MR":"Mauretanien","MS":"Montserrat","MT":"Malta","MU":"Mauritius","MV":"Maldiverne","MW":"Malawi","MX":"Mexico","MY":"Malaysia","MZ":"Mozambique","NA":"Namibia","NC":"Ny Kaledonien","NE":"Niger","NF":"Norfolk Island","NG":"Nigeria","NI":"Nicaragua","NL":"Nederlandene","NO":"Norge","NP":"Nepal","NR":"Nauru","NU":"Niue","NZ":"New Zealand","OM":"Oman","PA":"Panama","PE":"Peru","PF":"Fransk Polynesien","PG":"Papua Ny Guinea","PH":"Filippinerne","PK":"Pakistan","PL":"Polen","PM":"Saint Pierre og Miquelon","PN":"Pitcairn","PR":"Puerto Rico","PS":"De palæstinensiske områder","PT":"Portugal","PW":"Palau","PY":"Paraguay","QA":"Qatar","RE":"Réunion","RO":"Rumænien","RS":"Serbien","RU":"Rusland","RW":"Rwanda","SA":"Saudi-Arabien","SB":"Salomonøerne","SC":"Seychellerne","SD":"Sudan","SE":"Sverige","SG":"Singapore","SH":"St. Helena","SI":"Slovenien","SJ":"Svalbard og Jan Mayen","SK":"Slovakiet","SL":"Sierra Leone","SM":"San Marino","SN":"Senegal","SO":"Somalia","SR":"Surinam","SS":"Sydsudan","ST":"São Tomé og Príncipe","SV":"El Salvador","SX":"Sint Maarten","SY":"Syrien","SZ":"Eswatini","TC":"Turks- og Caicosøerne","TD":"Tchad","TF":"De Franske Besiddelser i Det Sydlige Indiske Ocean og Antarktis","TG":"Togo","TH":"Thailand","TJ":"Tadsjikistan","TK":"Tokelau","TL":"Timor-Leste","TM":"Turkmenistan","TN":"Tunesien","TO":"Tonga","TR":"Tyrkiet","TT":"Trinidad og Tobago","TV":"Tuvalu","TW":"Taiwan","TZ":"Tanzania","UA":"Ukraine","UG":"Uganda","UM":"Amerikanske oversøiske øer","US":"USA","UY":"Uruguay","UZ":"Usbekistan","VA":"Vatikanstaten","VC":"Saint Vincent og Grenadinerne","VE":"Venezuela","VG":"De Britiske Jomfruøer","VI":"De Amerikanske Jomfruøer","VN":"Vietnam","VU":"Vanuatu","WF":"Wallis og Futuna","WS":"Samoa","YE":"Yemen","YT":"Mayotte","ZA":"Sydafrika","ZM":"Zambia","ZW":"Zimbabwe"}},
+{"language":"de","names":{"AD":"Andorra","AE":"Vereinigte Arabische Emirate","AF":"Afghanistan","AG":"Antigua und Barbuda","AI":"Anguilla","AL":"Albanien","AM":"Armenien","AO":"Angola","AQ":"Antarktis","AR":"Argentinien","AS":"Amerikanisch-Samoa","AT":"Österreich","AU":"Australien","AW":"Aruba","AX":"Ålandinseln","AZ":"Aserbaidschan","BA":"Bosnien und Herzegowina","BB":"Barbados","BD":"Bangladesch","BE":"Belgien","BF":"Burkina Faso","BG":"Bulgarien","BH":"Bahrain","BI":"Burundi","BJ":"Benin","BL":"St. Barthélemy","BM":"Bermuda","BN":"Brunei Darussalam","BO":"Bolivien","BQ":"Karibische Niederlande","BR":"Brasilien","BS":"Bahamas","BT":"Bhutan","BV":"Bouvetinsel","BW":"Botsuana","BY":"Belarus","BZ":"Belize","CA":"Kanada","CC":"Kokosinseln","CD":"Kongo-Kinshasa","CF":"Zentralafrikanische Republik","CG":"Kongo-Brazzaville","CH":"Schweiz","CI":"Côte d’Ivoire","CK":"Cookinseln","CL":"Chile","CM":"Kamerun","CN":"China","CO":"Kolumbien","CR":"Costa Rica","CU":"Kuba","CV":"Cabo Verde","CW":"Curaçao","CX":"Weihnachtsinsel","CY":"Zypern","CZ":"Tschechien","DE":"Deutschland","DJ":"Dschibuti","DK":"Dänemark","DM":"Dominica","DO":"Dominikanische Republik","DZ":"Algerien","EC":"Ecuador","EE":"Estland","EG":"Ägypten","EH":"Westsahara","ER":"Eritrea","ES":"Spanien","ET":"Äthiopien","FI":"Finnland","FJ":"Fidschi","FK":"Falklandinseln","FM":"Mikronesien","FO":"Färöer","FR":"Frankreich","GA":"Gabun","GB":"Vereinigtes Königreich","GD":"Grenada","GE":"Georgien","GF":"Französisch-Guayana","GG":"Guernsey","GH":"Ghana","GI":"Gibraltar","GL":"Grönland","GM":"Gambia","GN":"Guinea","GP":"Guadeloupe","GQ":"Äquatorialguinea","GR":"Griechenland","GS":"Südgeorgien und die Südlichen Sandwichinseln","GT":"Guatemala","GU":"Guam","GW":"Guinea-Bissau","GY":"Guyana","HK":"Sonderverwaltungsregion Hongkong","HM":"Heard und McDonaldinseln","HN":"Honduras","HR":"Kroatien","HT":"Haiti","HU":"Ungarn","ID":"Indonesien","IE":"Irland","IL":"Israel","IM":"Isle of Man","IN":"Indien","IO":"Britisches Territorium im Indischen Ozean","IQ":"Irak","IR":"Iran","IS":"Island","IT":"Italien","JE":"Jersey","JM":"Jamaika","JO":"Jordanien","JP":"Japan","KE":"Kenia","KG":"Kirgisistan","KH":"Kambodscha","KI":"Kiribati","KM":"Komoren","KN":"St. Kitts und Nevis","KP":"Nordkorea","KR":"Südkorea","KW":"Kuwait","KY":"Kaimaninseln","KZ":"Kasachstan","LA":"Laos","LB":"Libanon","LC":"St. Lucia","LI":"Liechtenstein","LK":"Sri Lanka","LR":"Liberia","LS":"Lesotho","LT":"Litauen","LU":"Luxemburg","LV":"Lettland","LY":"Libyen","MA":"Marokko","MC":"Monaco","MD":"Republik Moldau","ME":"Montenegro","MF":"St. Martin","MG":"Madagaskar","MH":"Marshallinseln","MK":"Nordmazedonien","ML":"Mali","MM":"Myanmar","MN":"Mongolei","MO":"Sonderverwaltungsregion Macau","MP":"Nördliche Marianen","MQ":"Martinique","MR":"Mauretanien","MS":"Montserrat","MT":"Malta","MU":"Mauritius","MV":"Malediven","MW":"Malawi","MX":"Mexiko","MY":"Malaysia","MZ":"Mosambik","NA":"Namibia","NC":"Neukaledonien","NE":"Niger","NF":"Norfolkinsel","NG":"Nigeria","NI":"Nicaragua","NL":"Niederlande","NO":"Norwegen","NP":"Nepal","NR":"Nauru","NU":"Niue","NZ":"Neuseeland","OM":"Oman","PA":"Panama","PE":"Peru","PF":"Französisch-Polynesien","PG":"Papua-Neuguinea","PH":"Philippinen","PK":"Pakistan","PL":"Polen","PM":"St. Pierre und Miquelon","PN":"Pitcairninseln","PR":"Puerto Rico","PS":"Palästinensische Autonomiegebiete","PT":"Portugal","PW":"Palau","PY":"Paraguay","QA":"Katar","RE":"Réunion","RO":"Rumänien","RS":"Serbien","RU":"Russland","RW":"Ruanda","SA":"Saudi-Arabien","SB":"Salomonen","SC":"Seychellen","SD":"Sudan","SE":"Schweden","SG":"Singapur","SH":"St. Helena","SI":"Slowenien","SJ":"Spitzbergen und Jan Mayen","SK":"Slowakei","SL":"Sierra Leone","SM":"San Marino","SN":"Senegal","SO":"Somalia","SR":"Suriname","SS":"Südsudan","ST":"São Tomé und Príncipe","SV":"El Salvador","SX":"Sint Maarten","SY":"Syrien","SZ":"Eswatini","TC":"Turks- und Caicosinseln","TD":"Tschad","TF":"Französische Süd- und Antarktisgebiete","TG":"Togo","TH":"Thailand","TJ":"Tadschikistan","TK":"Tokelau","TL":"Timor-Leste","TM":"Turkmenistan","TN":"Tunesien","TO":"Tonga","TR":"Türkei","TT":"Trinidad und Tobago","TV":"Tuvalu","TW":"Taiwan","TZ":"Tansania","UA":"Ukraine","UG":"Uganda","UM":"Amerikanische Überseeinseln","US":"Vereinigte Staaten","UY":"Uruguay","UZ":"Usbekistan","VA":"Vatikanstadt","VC":"St. Vincent und die Grenadinen","VE":"Venezuela","VG":"Britische Jungferninseln","VI":"Amerikanische Jungferninseln","VN":"Vietnam","VU":"Vanuatu","WF":"Wallis und Futuna","WS":"Samoa","YE":"Jemen","YT":"Mayotte","ZA":"Südafrika","ZM":"Sambia","ZW":"Simbabwe"}},
+{"language":"el","names":{"AD":"Ανδόρα","AE":"Ηνωμένα Αραβικά Εμιράτα","AF":"Αφγανιστάν","AG":"Αντίγκουα και Μπαρμπούντα","AI":"Ανγκουίλα","AL":"Αλβανία","AM":"Αρμενία","AO":"Αγκόλα","AQ":"Ανταρκτική","AR":"Αργεντινή","AS":"Αμερικανική Σαμόα","AT":"Αυστρία","AU":"Αυστραλία","AW":"Αρούμπα","AX":"Νήσοι Όλαντ","AZ":"Αζερμπαϊτζάν","BA":"Βοσνία - Ερζεγοβίνη","BB":"Μπαρμπέιντος","BD":"Μπανγκλαντές","BE":"Βέλγιο","BF":"Μπουρκίνα Φάσο","BG":"Βουλγαρία","BH":"Μπαχρέιν","BI":"Μπουρούντι","BJ":"Μπενίν","BL":"Άγιος Βαρθολομαίος","BM":"Βερμούδες","BN":"Μπρουνέι","BO":"Βολιβία","BQ":"Ολλανδία Καραϊβικής","BR":"Βραζιλία","BS":"Μπαχάμες","BT":"Μπουτάν","BV":"Νήσος Μπουβέ","BW":"Μποτσουάνα","BY":"Λευκορωσία","BZ":"Μπελίζ","CA":"Καναδάς","CC":"Νήσοι Κόκος (Κίλινγκ)","CD":"Κονγκό - Κινσάσα","CF":"Κεντροαφρικανική Δημοκρατία","CG":"Κονγκό - Μπραζαβίλ","CH":"Ελβετία","CI":"Ακτή Ελεφαντοστού","CK":"Νήσοι Κουκ","CL":"Χιλή","CM":"Καμερούν","CN":"Κίνα","CO":"Κολομβία","CR":"Κόστα Ρίκα","CU":"Κούβα","CV":"Πράσινο Ακρωτήριο","CW":"Κουρασάο","CX":"Νήσος των Χριστουγέννων","CY":"Κύπρος","CZ":"Τσεχία","DE":"Γερμανία","DJ":"Τζιμπουτί","DK":"Δανία","DM":"Ντομίνικα","DO":"Δομινικανή Δημοκρατία","DZ":"Αλγερία","EC":"Ισημερινός","EE":"Εσθονία","EG":"Αίγυπτος","EH":"Δυτική Σαχάρα","ER":"Ερυθραία","ES":"Ισπανία","ET":"Αιθιοπία","FI":"Φινλανδία","FJ":"Φίτζι","FK":"Νήσοι Φόκλαντ","FM":"Μικρονησία","FO":"Νήσοι Φερόες","FR":"Γαλλία","GA":"Γκαμπόν","GB":"Ηνωμένο Βασίλειο","GD":"Γρενάδα","GE":"Γεωργία","GF":"Γαλλική Γουιάνα","GG":"Γκέρνζι","GH":"Γκάνα","GI":"Γιβραλτάρ","GL":"Γροιλανδία","GM":"Γκάμπια","GN":"Γουινέα","GP":"Γουαδελούπη","GQ":"Ισημερινή Γουινέα","GR":"Ελλάδα","GS":"Νήσοι Νότια Γεωργία και Νότιες Σάντουιτς","GT":"Γουατεμάλα","GU":"Γκουάμ","GW":"Γουινέα Μπισάου","GY":"Γουιάνα","HK":"Χονγκ Κονγκ ΕΔΠ Κίνας","HM":"Νήσοι Χερντ και Μακντόναλντ","HN":"Ονδούρα","HR":"Κροατία","HT":"Αϊτή","HU":"Ουγγαρία","ID":"Ινδονησία","IE":"Ιρλανδία","IL":"Ισραήλ","IM":"Νήσος του Μαν","IN":"Ινδία","IO":"Βρετανικά Εδάφη Ινδικού Ωκεανού","IQ":"Ιράκ","IR":"Ιράν","IS":"Ισλανδία","IT":"Ιταλία","JE":"Τζέρζι","JM":"Τζαμάικα","JO":"Ιορδανία","JP":"Ιαπωνία","KE":"Κένυα","KG":"Κιργιστάν","KH":"Καμπότζη","KI":"Κιριμπάτι","KM":"Κομόρες","KN":"Σεν Κιτς και Νέβις","KP":"Βόρεια Κορέα","KR":"Νότια Κορέα","KW":"Κουβέιτ","KY":"Νήσοι Κέιμαν","KZ":"Καζακστάν","LA":"Λάος","LB":"Λίβανος","LC":"Αγία Λουκία","LI":"Λιχτενστάιν","LK":"Σρι Λάνκα","LR":"Λιβερία","LS":"Λεσότο","LT":"Λιθουανία","LU":"Λουξεμβούργο","LV":"Λετονία","LY":"Λιβύη","MA":"Μαρόκο","MC":"Μονακό","MD":"Μολδαβία","ME":"Μαυροβούνιο","MF":"Άγιος Μαρτίνος (Γαλλικό τμήμα)","MG":"Μαδαγασκάρη","MH":"Νήσοι Μάρσαλ","MK":"Βόρεια Μακεδονία","ML":"Μάλι","MM":"Μιανμάρ (Βιρμανία)","MN":"Μογγολία","MO":"Μακάο ΕΔΠ Κίνας","MP":"Νήσοι Βόρειες Μαριάνες","MQ":"Μαρτινίκα","MR":"Μαυριτανία","MS":"Μονσεράτ","MT":"Μάλτα","MU":"Μαυρίκιος","MV":"Μαλδίβες","MW":"Μαλάουι","MX":"Μεξικό","MY":"Μαλαισία","MZ":"Μοζαμβίκη","NA":"Ναμίμπια","NC":"Νέα Καληδονία","NE":"Νίγηρας","NF":"Νήσος Νόρφολκ","NG":"Νιγηρία","NI":"Νικαράγουα","NL":"Κάτω Χώρες","NO":"Νορβηγία","NP":"Νεπάλ","NR":"Ναουρού","NU":"Νιούε","NZ":"Νέα Ζηλανδία","OM":"Ομάν","PA":"Παναμάς","PE":"Περού","PF":"Γαλλική Πολυνησία","PG":"Παπούα Νέα Γουινέα","PH":"Φιλιππίνες","PK":"Πακιστάν","PL":"Πολωνία","PM":"Σεν Πιερ και Μικελόν","PN":"Νήσοι Πίτκερν","PR":"Πουέρτο Ρίκο","PS":"Παλαιστινιακά Εδάφη","PT":"Πορτογαλία","PW":"Παλάου","PY":"Παραγουάη","QA":"Κατάρ","RE":"Ρεϊνιόν","RO":"Ρουμανία","RS":"Σερβία","RU":"Ρωσία","RW":"Ρουάντα","SA":"Σαουδική Αραβία","SB":"Νήσοι Σολομώντος","SC":"Σεϋχέλλες","SD":"Σουδάν","SE":"Σουηδία","SG":"Σιγκαπούρη","SH":"Αγία Ελένη","SI":"Σλοβενία","SJ":"Σβάλμπαρντ και Γιαν Μαγιέν","SK":"Σλοβακία","SL":"Σιέρα Λεόνε","SM":"Άγιος Μαρίνος","SN":"Σενεγάλη","SO":"Σομαλία","SR":"Σουρινάμ","SS":"Νότιο Σουδάν","ST":"Σάο Τομέ και Πρίνσιπε","SV":"Ελ Σαλβαδόρ","SX":"Άγιος Μαρτίνος (Ολλανδικό τμήμα)","SY":"Συρία","SZ":"Εσουατίνι","TC":"Νήσοι Τερκς και Κάικος","TD":"Τσαντ","TF":"Γαλλικά Νότια Εδάφη","TG":"Τόγκο","TH":"Ταϊλάνδη","TJ":"Τατζικιστάν","TK":"Τοκελάου","TL":"Τιμόρ-Λέστε","TM":"Τουρκμενιστάν","TN":"Τυνησία","TO":"Τόνγκα","TR":"Τουρκία","TT":"Τρινιντάντ και Τομπάγκο","TV":"Τουβαλού","TW":"Ταϊβάν","TZ":"Τανζανία","UA":"Ουκρανία","UG":"Ουγκάντα","UM":"Απομακρυσμένες Νησίδες ΗΠΑ","US":"Ηνωμένες Πολιτείες","UY":"Ουρουγουάη","UZ":"Ουζμπεκιστάν","VA":"Βατικανό","VC":"Άγιος Βικέντιος και Γρεναδίνες","VE":"Βενεζουέλα","VG":"Βρετανικές Παρθένες Νήσοι","VI":"Αμερικανικές Παρθένες Νήσοι","VN":"Βιετνάμ","VU":"Βανουάτου","WF":"Γουάλις και Φουτούνα","WS":"Σαμόα","YE":"Υεμένη","YT":"Μαγιότ","ZA":"Νότια Αφρική","ZM":"Ζάμπια","ZW":"Ζιμπάμπουε"}},
+{"language":"en","names":{"AD":"Andorra","AE":"United Arab Emirates","AF":"Afghanistan","AG":"Antigua & Barbuda","AI":"Anguilla","AL":"Albania","AM":"Armenia","AO":"Angola","AQ":"Antarctica","AR":"Argentina","AS":"American Samoa","AT":"Austria","AU":"Australia","AW":"Aruba","AX":"Åland Islands","AZ":"Azerbaijan","BA":"Bosnia & Herzegovina","BB":"Barbados","BD":"Bangladesh","BE":"Belgium","BF":"Burkina Faso","BG":"Bulgaria","BH":"Bahrain","BI":"Burundi","BJ":"Benin","BL":"St. Barthélemy","BM":"Bermuda","BN":"Brunei","BO":"Bolivia","BQ":"Caribbean Netherlands","BR":"Brazil","BS":"Bahamas","BT":"Bhutan","BV":"Bouvet Island","BW":"Botswana","BY":"Belarus","BZ":"Belize","CA":"Canada","CC":"Cocos (Keeling) Islands","CD":"Congo - Kinshasa","CF":"Central African Republic","CG":"Congo - Brazzaville","CH":"Switzerland","CI":"Côte d’Ivoire","CK":"Cook Islands","CL":"Chile","CM":"Cameroon","CN":"China","CO":"Colombia","CR":"Costa Rica","CU":"Cuba","CV":"Cape Verde","CW":"Curaçao","CX":"Christmas Island","CY":"Cyprus","CZ":"Czechia","DE":"Germany","DJ":"Djibouti","DK":"Denmark","DM":"Dominica","DO":"Dominican Republic","DZ":"Algeria","EC":"Ecuador","EE":"Estonia","EG":"Egypt","EH":"Western Sahara","ER":"Eritrea","ES":"Spain","ET":"Ethiopia","FI":"Finland","FJ":"Fiji","FK":"Falkland Islands","FM":"Micronesia","FO":"Faroe Islands","FR":"France","GA":"Gabon","GB":"United Kingdom","GD":"Grenada","GE":"Georgia","GF":"French Guiana","GG":"Guernsey","GH":"Ghana","GI":"Gibraltar","GL":"Greenland","GM":"Gambia","GN":"Guinea","GP":"Guadeloupe","GQ":"Equatorial Guinea","GR":"Greece","GS":"South Georgia & South Sandwich Islands","GT":"Guatemala","GU":"Guam","GW":"Guinea-Bissau","GY":"Guyana","HK":"Hong Kong SAR China","HM":"Heard & McDonald Islands","HN":"Honduras","HR":"Croatia","HT":"Haiti","HU":"Hungary","ID":"Indonesia","IE":"Ireland","IL":"Israel","IM":"Isle of Man","IN":"India","IO":"British Indian Ocean Territory","IQ":"Iraq","IR":"Iran","IS":"Iceland","IT":"Italy","JE":"Jersey","JM":"Jamaica","JO":"Jordan","JP":"Japan","KE":"Kenya","KG":"Kyrgyzstan","KH":"Cambodia","KI":"Kiribati","KM":"Comoros","KN":"St. Kitts & Nevis","KP":"North Korea","KR":"South Korea","KW":"Kuwait","KY":"Cayman Islands","KZ":"Kazakhstan","LA":"Laos","LB":"Lebanon","LC":"St. Lucia","LI":"Liechtenstein","LK":"Sri Lanka","LR":"Liberia","LS":"Lesotho","LT":"Lithuania","LU":"Luxembourg","LV":"Latvia","LY":"Libya","MA":"Morocco","MC":"Monaco","MD":"Moldova","ME":"Montenegro","MF":"St. Martin","MG":"Madagascar","MH":"Marshall Islands","MK":"North Macedonia","ML":"Mali","MM":"Myanmar (Burma)","MN":"Mongolia","MO":"Macao SAR China","MP":"Northern Mariana Islands","MQ":"Martinique","MR":"Mauritania","MS":"Montserrat","MT":"Malta","MU":"Mauritius","MV":"Maldives","MW":"Malawi","MX":"Mexico","MY":"Malaysia","MZ":"Mozambique","NA":"Namibia","NC":"New Caledonia","NE":"Niger","NF":"Norfolk Island","NG":"Nigeria","NI":"Nicaragua","NL":"Netherlands","NO":"Norway","NP":"Nepal","NR":"Nauru","NU":"Niue","NZ":"New Zealand","OM":"Oman","PA":"Panama","PE":"Peru","PF":"French Polynesia","PG":"Papua New Guinea","PH":"Philippines","PK":"Pakistan","PL":"Poland","PM":"St. Pierre & Miquelon","PN":"Pitcairn Islands","PR":"Puerto Rico","PS":"Palestinian Territories","PT":"Portugal","PW":"Palau","PY":"Paraguay","QA":"Qatar","RE":"Réunion","RO":"Romania","RS":"Serbia","RU":"Russia","RW":"Rwanda","SA":"Saudi Arabia","SB":"Solomon Islands","SC":"Seychelles","SD":"Sudan","SE":"Sweden","SG":"Singapore","SH":"St. Helena","SI":"Slovenia","SJ":"Svalbard & Jan Mayen","SK":"Slovakia","SL":"Sierra Leone","SM":"San Marino","SN":"Senegal","SO":"Somalia","SR":"Suriname","SS":"South Sudan","ST":"São Tomé & Príncipe","SV":"El Salvador","SX":"Sint Maarten","SY":"Syria","SZ":"Eswatini","TC":"Turks & Caicos Islands","TD":"Chad","TF":"French Southern Territories","TG":"Togo","TH":"Thailand","TJ":"Tajikistan","TK":"Tokelau","TL":"Timor-Leste","TM":"Turkmenistan","TN":"Tunisia","TO":"Tonga","TR":"Turkey","TT":"Trinidad & Tobago","TV":"Tuvalu","TW":"Taiwan","TZ":"Tanzania","UA":"Ukraine","UG":"Uganda","UM":"U.S. Outlying Islands","US":"United States","UY":"Uruguay","UZ":"Uzbekistan","VA":"Vatican City","VC":"St. Vincent & Grenadines","VE":"Venezuela","VG":"British Virgin Islands","VI":"U.S. Virgin Islands","VN":"Vietnam","VU":"Vanuatu","WF":"Wallis & Futuna","WS":"Samoa","YE":"Yemen","YT":"Mayotte","ZA":"South Africa","ZM":"Zambia","ZW":"Zimbabwe"}},
+{"language":"es","names":{"AD":"Andorra","AE":"Emiratos Árabes Unidos","AF":"Afganistán","AG":"Antigua y Barbuda","AI":"Anguila","AL":"Albania","AM":"Armenia","AO":"Angola","AQ":"Antártida","AR":"Argentina","AS":"Samoa Americana","AT":"Austria","AU":"Australia","AW":"Aruba","AX":"Islas Aland","AZ":"Azerbaiyán","BA":"Bosnia y Herzegovina","BB":"Barbados","BD":"Bangladés","BE":"Bélgica","BF":"Burkina Faso","BG":"Bulgaria","BH":"Baréin","BI":"Burundi","BJ":"Benín","BL":"San Bartolomé","BM":"Bermudas","BN":"Brunéi","BO":"Bolivia","BQ":"Caribe neerlandés","BR":"Brasil","BS":"Bahamas","BT":"Bután","BV":"Isla Bouvet","BW":"Botsuana","BY":"Bielorrusia","BZ":"Belice","CA":"Canadá","CC":"Islas Cocos","CD":"República Democrática del Congo","CF":"República Centroafricana","CG":"Congo","CH":"Suiza","CI":"Côte d’Ivoire","CK":"Islas Cook","CL":"Chile","CM":"Camerún","CN":"China","CO":"Colombia","CR":"Costa Rica","CU":"Cuba","CV":"Cabo Verde","CW":"Curazao","CX":"Isla de Navidad","CY":"Chipre","CZ":"Chequia","DE":"Alemania","DJ":"Yibuti","DK":"Dinamarca","DM":"Dominica","DO":"República Dominicana","DZ":"Argelia","EC":"Ecuador","EE":"Estonia","EG":"Egipto","EH":"Sáhara Occidental","ER":"Eritrea","ES":"España","ET":"Etiopía","FI":"Finlandia","FJ":"Fiyi","FK":"Islas Malvinas","FM":"Micronesia","FO":"Islas Feroe","FR":"Francia","GA":"Gabón","GB":"Reino Unido","GD":"Granada","GE":"Georgia","GF":"Guayana Francesa","GG":"Guernesey","GH":"Ghana","GI":"Gibraltar","GL":"Groenlandia","GM":"Gambia","GN":"Guinea","GP":"Guadalupe","GQ":"Guinea Ecuatorial","GR":"Grecia","GS":"Islas Georgia del Sur y Sandwich del Sur","GT":"Guatemala","GU":"Guam","GW":"Guinea-Bisáu","GY":"Guyana","HK":"RAE de Hong Kong (China)","HM":"Islas Heard y McDonald","HN":"Honduras","HR":"Croacia","HT":"Haití","HU":"Hungría","ID":"Indonesia","IE":"Irlanda","IL":"Israel","IM":"Isla de Man","IN":"India","IO":"Territorio Británico del Océano Índico","IQ":"Irak","IR":"Irán","IS":"Islandia","IT":"Italia","JE":"Jersey","JM":"Jamaica","JO":"Jordania","JP":"Japón","KE":"Kenia","KG":"Kirguistán","KH":"Camboya","KI":"Kiribati","KM":"Comoras","KN":"San Cristóbal y Nieves","KP":"Corea del Norte","KR":"Corea del Sur","KW":"Kuwait","KY":"Islas Caimán","KZ":"Kazajistán","LA":"Laos","LB":"Líbano","LC":"Santa Lucía","LI":"Liechtenstein","LK":"Sri Lanka","LR":"Liberia","LS":"Lesoto","LT":"Lituania","LU":"Luxemburgo","LV":"Letonia","LY":"Libia","MA":"Marruecos","MC":"Mónaco","MD":"Moldavia","ME":"Montenegro","MF":"San Martín","MG":"Madagascar","MH":"Islas Marshall","MK":"Macedonia del Norte","ML":"Mali","MM":"Myanmar (Birmania)","MN":"Mongolia","MO":"RAE de Macao (China)","MP":"Islas Marianas del Norte","MQ":"Martinica","MR":"Mauritania","MS":"Montserrat","MT":"Malta","MU":"Mauricio","MV":"Maldivas","MW":"Malaui","MX":"México","MY":"Malasia","MZ":"Mozambique","NA":"Namibia","NC":"Nueva Caledonia","NE":"Níger","NF":"Isla Norfolk","NG":"Nigeria","NI":"Nicaragua","NL":"Países Bajos","NO":"Noruega","NP":"Nepal","NR":"Nauru","NU":"Niue","NZ":"Nueva Zelanda","OM":"Omán","PA":"Panamá","PE":"Perú","PF":"Polinesia Francesa","PG":"Papúa Nueva Guinea","PH":"Filipinas","PK":"Pakistán","PL":"Polonia","PM":"San Pedro y Miquelón","PN":"Islas Pitcairn","PR":"Puerto Rico","PS":"Territorios Palestinos","PT":"Portugal","PW":"Palaos","PY":"Paraguay","QA":"Catar","RE":"Reunión","RO":"Rumanía","RS":"Serbia","RU":"Rusia","RW":"Ruanda","SA":"Arabia Saudí","SB":"Islas Salomón","SC":"Seychelles","SD":"Sudán","SE":"Suecia","SG":"Singapur","SH":"Santa Elena","SI":"Eslovenia","SJ":"Svalbard y Jan Mayen","SK":"Eslovaquia","SL":"Sierra Leona","SM":"San Marino","SN":"Senegal","SO":"Somalia","SR":"Surinam","SS":"Sudán del Sur","ST":"Santo Tomé y Príncipe","SV":"El Salvador","SX":"Sint Maarten","SY":"Siria","SZ":"Esuatini","TC":"Islas Turcas y Caicos","TD":"Chad","TF":"Territorios Australes Franceses","TG":"Togo","TH":"Tailandia","TJ":"Tayikistán","TK":"Tokelau","TL":"Timor-Leste","TM":"Turkmenistán","TN":"Túnez","TO":"Tonga","TR":"Turquía","TT":"Trinidad y Tobago","TV":"Tuvalu","TW":"Taiwán","TZ":"Tanzania","UA":"Ucrania","UG":"Uganda","UM":"Islas menores alejadas de EE. UU.","US":"Estados Unidos","UY":"Uruguay","UZ":"Uzbekistán","VA":"Ciudad del Vaticano","VC":"San Vicente y las Granadinas","VE":"Venezuela","VG":"Islas Vírgenes Británicas","VI":"Islas Vírgenes de EE. UU.","VN":"Vietnam","VU":"Vanuatu","WF":"Wallis y Futuna","WS":"Samoa","YE":"Yemen","YT":"Mayotte","ZA":"Sudáfrica","ZM":"Zambia","ZW":"Zimbabue"}},
+{"language":"et","names":{"AD":"Andorra","AE":"Araabia Ühendemiraadid","AF":"Afganistan","AG":"Antigua ja Barbuda","AI":"Anguilla","AL":"Albaania","AM":"Armeenia","AO":"Angola","AQ":"Antarktika","AR":"Argentina","AS":"Ameerika Samoa","AT":"Austria","AU":"Austraalia","AW":"Aruba","AX":"Ahvenamaa","AZ":"Aserbaidžaan","BA":"Bosnia ja Hertsegoviina","BB":"Barbados","BD":"Bangladesh","BE":"Belgia","BF":"Burkina Faso","BG":"Bulgaaria","BH":"Bahrein","BI":"Burundi","BJ":"Benin","BL":"Saint-Barthélemy","BM":"Bermuda","BN":"Brunei","BO":"Boliivia","BQ":"Hollandi Kariibi mere saared","BR":"Brasiilia","BS":"Bahama","BT":"Bhutan","BV":"Bouvet’ saar","BW":"Botswana","BY":"Valgevene","BZ":"Belize","CA":"Kanada","CC":"Kookossaared","CD":"Kongo DV","CF":"Kesk-Aafrika Vabariik","CG":"Kongo Vabariik","CH":"Šveits","CI":"Côte d’Ivoire","CK":"Cooki saared","CL":"Tšiili","CM":"Kamerun","CN":"Hiina","CO":"Colombia","CR":"Costa Rica","CU":"Kuuba","CV":"Roheneemesaared","CW":"Curaçao","CX":"Jõulusaar","CY":"Küpros","CZ":"Tšehhi","DE":"Saksamaa","DJ":"Djibouti","DK":"Taani","DM":"Dominica","DO":"Dominikaani Vabariik","DZ":"Alžeeria","EC":"Ecuador","EE":"Eesti","EG":"Egiptus","EH":"Lääne-Sahara","ER":"Eritrea","ES":"Hispaania","ET":"Etioopia","FI":"Soome","FJ":"Fidži","FK":"Falklandi saared","FM":"Mikroneesia","FO":"Fääri saared","FR":"Prantsusmaa","GA":"Gabon","GB":"Ühendkuningriik","GD":"Grenada","GE":"Gruusia","GF":"Prantsuse Guajaana","GG":"Guernsey","GH":"Ghana","GI":"Gibraltar","GL":"Gröönimaa","GM":"Gambia","GN":"Guinea","GP":"Guadeloupe","GQ":"Ekvatoriaal-Guinea","GR":"Kreeka","GS":"Lõuna-Georgia ja Lõuna-Sandwichi saared","GT":"Guatemala","GU":"Guam","GW":"Guinea-Bissau","GY":"Guyana","HK":"Hongkongi erihalduspiirkond","HM":"Heardi ja McDonaldi saared","HN":"Honduras","HR":"Horvaatia","HT":"Haiti","HU":"Ungari","ID":"Indoneesia","IE":"Iirimaa","IL":"Iisrael","IM":"Mani saar","IN":"India","IO":"Briti India ookeani ala","IQ":"Iraak","IR":"Iraan","IS":"Island","IT":"Itaalia","JE":"Jersey","JM":"Jamaica","JO":"Jordaania","JP":"Jaapan","KE":"Keenia","KG":"Kõrgõzstan","KH":"Kambodža","KI":"Kiribati","KM":"Komoorid","KN":"Saint Kitts ja Nevis","KP":"Põhja-Korea","KR":"Lõuna-Korea","KW":"Kuveit","KY":"Kaimanisaared","KZ":"Kasahstan","LA":"Laos","LB":"Liibanon","LC":"Saint Lucia","LI":"Liechtenstein","LK":"Sri Lanka","LR":"Libeeria","LS":"Lesotho","LT":"Leedu","LU":"Luksemburg","LV":"Läti","LY":"Liibüa","MA":"Maroko","MC":"Monaco","MD":"Moldova","ME":"Montenegro","MF":"Saint-Martin","MG":"Madagaskar","MH":"Marshalli Saared","MK":"Põhja-Makedoonia","ML":"Mali","MM":"Myanmar (Birma)","MN":"Mongoolia","MO":"Macau erihalduspiirkond","MP":"Põhja-Mariaanid","MQ":"Martinique","MR":"Mauritaania","MS":"Montserrat","MT":"Malta","MU":"Mauritius","MV":"Maldiivid","MW":"Malawi","MX":"Mehhiko","MY":"Malaisia","MZ":"Mosambiik","NA":"Namiibia","NC":"Uus-Kaledoonia","NE":"Niger","NF":"Norfolk","NG":"Nigeeria","NI":"Nicaragua","NL":"Holland","NO":"Norra","NP":"Nepal","NR":"Nauru","NU":"Niue","NZ":"Uus-Meremaa","OM":"Omaan","PA":"Panama","PE":"Peruu","PF":"Prantsuse Polüneesia","PG":"Paapua Uus-Guinea","PH":"Filipiinid","PK":"Pakistan","PL":"Poola","PM":"Saint-Pierre ja Miquelon","PN":"Pitcairni saared","PR":"Puerto Rico","PS":"Palestiina alad","PT":"Portugal","PW":"Belau","PY":"Paraguay","QA":"Katar","RE":"Réunion","RO":"Rumeenia","RS":"Serbia","RU":"Venemaa","RW":"Rwanda","SA":"Saudi Araabia","SB":"Saalomoni Saared","SC":"Seišellid","SD":"Sudaan","SE":"Rootsi","SG":"Singapur","SH":"Saint Helena","SI":"Sloveenia","SJ":"Svalbard ja Jan Mayen","SK":"Slovakkia","SL":"Sierra Leone","SM":"San Marino","SN":"Senegal","SO":"Somaalia","SR":"Suriname","SS":"Lõuna-Sudaan","ST":"São Tomé ja Príncipe","SV":"El Salvador","SX":"Sint Maarten","SY":"Süüria","SZ":"Svaasimaa","TC":"Turks ja Caicos","TD":"Tšaad","TF":"Prantsuse Lõunaalad","TG":"Togo","TH":"Tai","TJ":"Tadžikistan","TK":"Tokelau","TL":"Ida-Timor","TM":"Türkmenistan","TN":"Tuneesia","TO":"Tonga","TR":"Türgi","TT":"Trinidad ja Tobago","TV":"Tuvalu","TW":"Taiwan","TZ":"Tansaania","UA":"Ukraina","UG":"Uganda","UM":"Ühendriikide hajasaared","US":"Ameerika Ühendriigid","UY":"Uruguay","UZ":"Usbekistan","VA":"Vatikan","VC":"Saint Vincent ja Grenadiinid","VE":"Venezuela","VG":"Briti Neitsisaared","VI":"USA Neitsisaared","VN":"Vietnam","VU":"Vanuatu","WF":"Wallis ja Futuna","WS":"Samoa","YE":"Jeemen","YT":"Mayotte","ZA":"Lõuna-Aafrika Vabariik","ZM":"Sambia","ZW":"Zimbabwe"}},
+{"language":"fa","names":{"AD":"آندورا","AE":"امارات متحدهٔ عربی","AF":"افغانستان","AG":"آنتیگوا و باربودا","AI":"آنگویلا","AL":"آلبانی","AM":"ارمنستان","AO":"آنگولا","AQ":"جنوبگان","AR":"آرژانتین","AS":"ساموآی امریکا","AT":"اتریش","AU":"استرالیا","AW":"آروبا","AX":"جزایر آلاند","AZ":"جمهوری آذربایجان","BA":"بوسنی و هرزگوین","BB":"باربادوس","BD":"بنگلادش","BE":"بلژیک","BF":"بورکینافاسو","BG":"بلغارستان","BH":"بحرین","BI":"بوروندی","BJ":"بنین","BL":"سن بارتلمی","BM":"برمودا","BN":"برونئی","BO":"بولیوی","BQ":"جزایر کارائیب هلند","BR":"برزیل","BS":"باهاما","BT":"بوتان","BV":"جزیرهٔ بووه","BW":"بوتسوانا","BY":"بلاروس","BZ":"بلیز","CA":"کانادا","CC":"جزایر کوکوس","CD":"کنگو - کینشاسا","CF":"جمهوری افریقای مرکزی","CG":"کنگو - برازویل","CH":"سوئیس","CI":"ساحل عاج","CK":"جزایر کوک","CL":"شیلی","CM":"کامرون","CN":"چین","CO":"کلمبیا","CR":"کاستاریکا","CU":"کوبا","CV":"کیپ‌ورد","CW":"کوراسائو","CX":"جزیرهٔ کریسمس","CY":"قبرس","CZ":"چک","DE":"آلمان","DJ":"جیبوتی","DK":"دانمارک","DM":"دومینیکا","DO":"جمهوری دومینیکن","DZ":"الجزایر","EC":"اکوادور","EE":"استونی","EG":"مصر","EH":"صحرای غربی","ER":"اریتره","ES":"اسپانیا","ET":"اتیوپی","FI":"فنلاند","FJ":"فیجی","FK":"جزایر فالکلند","FM":"میکرونزی","FO":"جزایر فارو","FR":"فرانسه","GA":"گابن","GB":"بریتانیا","GD":"گرنادا","GE":"گرجستان","GF":"گویان فرانسه","GG":"گرنزی","GH":"غنا","GI":"جبل‌الطارق","GL":"گرینلند","GM":"گامبیا","GN":"گینه","GP":"گوادلوپ","GQ":"گینهٔ استوایی","GR":"یونان","GS":"جورجیای جنوبی و جزایر ساندویچ جنوبی","GT":"گواتمالا","GU":"گوام","GW":"گینهٔ بیسائو","GY":"گویان","HK":"هنگ‌کنگ، منطقهٔ ویژهٔ اداری چین","HM":"هرد و جزایر مک‌دونالد","HN":"هندوراس","HR":"کرواسی","HT":"هائیتی","HU":"مجارستان","ID":"اندونزی","IE":"ایرلند","IL":"اسرائیل","IM":"جزیرهٔ من","IN":"هند","IO":"قلمرو بریتانیا در اقیانوس هند","IQ":"عراق","IR":"ایران","IS":"ایسلند","IT":"ایتالیا","JE":"جرزی","JM":"جامائیکا","JO":"اردن","JP":"ژاپن","KE":"کنیا","KG":"قرقیزستان","KH":"کامبوج","KI":"کیریباتی","KM":"کومور","KN":"سنت کیتس و نویس","KP":"کرهٔ شمالی","KR":"کرهٔ جنوبی","KW":"کویت","KY":"جزایر کِیمن","KZ":"قزاقستان","LA":"لائوس","LB":"لبنان","LC":"سنت لوسیا","LI":"لیختن‌اشتاین","LK":"سری‌لانکا","LR":"لیبریا","LS":"لسوتو","LT":"لیتوانی","LU":"لوکزامبورگ","LV":"لتونی","LY":"لیبی","MA":"مراکش","MC":"موناکو","MD":"مولداوی","ME":"مونته‌نگرو","MF":"سنت مارتین","MG":"ماداگاسکار","MH":"جزایر مارشال","MK":"مقدونیهٔ شمالی","ML":"مالی","MM":"میانمار (برمه)","MN":"مغولستان","MO":"ماکائو، منطقهٔ ویژهٔ اداری چین","MP":"جزایر ماریانای شمالی","MQ":"مارتینیک","MR":"موریتانی","MS":"مونت‌سرات","MT":"مالت","MU":"موریس","MV":"مالدیو","MW":"مالاوی","MX":"مکزیک","MY":"مالزی","MZ":"موزامبیک","NA":"نامیبیا","NC":"کالدونیای جدید","NE":"نیجر","NF":"جزیرهٔ نورفولک","NG":"نیجریه","NI":"نیکاراگوئه","NL":"هلند","NO":"نروژ","NP":"نپال","NR":"نائورو","NU":"نیوئه","NZ":"نیوزیلند","OM":"عمان","PA":"پاناما","PE":"پرو","PF":"پلی‌نزی فرانسه","PG":"پاپوا گینهٔ نو","PH":"فیلیپین","PK":"پاکستان","PL":"لهستان","PM":"سن پیر و میکلن","PN":"جزایر پیت‌کرن","PR":"پورتوریکو","PS":"سرزمین‌های فلسطینی","PT":"پرتغال","PW":"پالائو","PY":"پاراگوئه","QA":"قطر","RE":"رئونیون","RO":"رومانی","RS":"صربستان","RU":"روسیه","RW":"رواندا","SA":"عربستان سعودی","SB":"جزایر سلیمان","SC":"سیشل","SD":"سودان","SE":"سوئد","SG":"سنگاپور","SH":"سنت هلن","SI":"اسلوونی","SJ":"سوالبارد و یان ماین","SK":"اسلواکی","SL":"سیرالئون","SM":"سان‌مارینو","SN":"سنگال","SO":"سومالی","SR":"سورینام","SS":"سودان جنوبی","ST":"سائوتومه و پرینسیپ","SV":"السالوادور","SX":"سنت مارتن","SY":"سوریه","SZ":"اسواتینی","TC":"جزایر تورکس و کایکوس","TD":"چاد","TF":"سرزمین‌های جنوبی فرانسه","TG":"توگو","TH":"تایلند","TJ":"تاجیکستان","TK":"توکلائو","TL":"تیمور-لسته","TM":"ترکمنستان","TN":"تونس","TO":"تونگا","TR":"ترکیه","TT":"ترینیداد و توباگو","TV":"تووالو","TW":"تایوان","TZ":"تانزانیا","UA":"اوکراین","UG":"اوگاندا","UM":"جزایر دورافتادهٔ ایالات متحده","US":"ایالات متحده","UY":"اروگوئه","UZ":"ازبکستان","VA":"واتیکان","VC":"سنت وینسنت و گرنادین","VE":"ونزوئلا","VG":"جزایر ویرجین بریتانیا","VI":"جزایر ویرجین ایالات متحده","VN":"ویتنام","VU":"وانواتو","WF":"والیس و فوتونا","WS":"ساموآ","YE":"یمن","YT":"مایوت","ZA":"افریقای جنوبی","ZM":"زامبیا","ZW":"زیمبابوه"}},
+{"language":"fi","names":{"AD":"Andorra","AE":"Arabiemiirikunnat","AF":"Afganistan","AG":"Antigua ja Barbuda","AI":"Anguilla","AL":"Albania","AM":"Armenia","AO":"Angola","AQ":"Antarktis","AR":"Argentiina","AS":"Amerikan Samoa","AT":"Itävalta","AU":"Australia","AW":"Aruba","AX":"Ahvenanmaa","AZ":"Azerbaidžan","BA":"Bosnia ja Hertsegovina","BB":"Barbados","BD":"Bangladesh","BE":"Belgia","BF":"Burkina Faso","BG":"Bulgaria","BH":"Bahrain","BI":"Burundi","BJ":"Benin","BL":"Saint-Barthélemy","BM":"Bermuda","BN":"Brunei","BO":"Bolivia","BQ":"Karibian Alankomaat","BR":"Brasilia","BS":"Bahama","BT":"Bhutan","BV":"Bouvet’nsaari","BW":"Botswana","BY":"Valko-Venäjä","BZ":"Belize","CA":"Kanada","CC":"Kookossaaret (Keelingsaaret)","CD":"Kongon demokraattinen tasavalta","CF":"Keski-Afrikan tasavalta","CG":"Kongon tasavalta","CH":"Sveitsi","CI":"Norsunluurannikko","CK":"Cookinsaaret","CL":"Chile","CM":"Kamerun","CN":"Kiina","CO":"Kolumbia","CR":"Costa Rica","CU":"Kuuba","CV":"Kap Verde","CW":"Curaçao","CX":"Joulusaari","CY":"Kypros","CZ":"Tšekki","DE":"Saksa","DJ":"Djibouti","DK":"Tanska","DM":"Dominica","DO":"Dominikaaninen tasavalta","DZ":"Algeria","EC":"Ecuador","EE":"Viro","EG":"Egypti","EH":"Länsi-Sahara","ER":"Eritrea","ES":"Espanja","ET":"Etiopia","FI":"Suomi","FJ":"Fidži","FK":"Falklandinsaaret","FM":"Mikronesia","FO":"Färsaaret","FR":"Ranska","GA":"Gabon","GB":"Iso-Britannia","GD":"Grenada","GE":"Georgia","GF":"Ranskan Guayana","GG":"Guernsey","GH":"Ghana","GI":"Gibraltar","GL":"Grönlanti","GM":"Gambia","GN":"Guinea","GP":"Guadeloupe","GQ":"Päiväntasaajan Guinea","GR":"Kreikka","GS":"Etelä-Georgia ja Eteläiset Sandwichinsaaret","GT":"Guatemala","GU":"Guam","GW":"Guinea-Bissau","GY":"Guyana","HK":"Hongkong – Kiinan erityishallintoalue","HM":"Heard ja McDonaldinsaaret","HN":"Honduras","HR":"Kroatia","HT":"Haiti","HU":"Unkari","ID":"Indonesia","IE":"Irlanti","IL":"Israel","IM":"Mansaari","IN":"Intia","IO":"Brittiläinen Intian valtameren alue","IQ":"Irak","IR":"Iran","IS":"Islanti","IT":"Italia","JE":"Jersey","JM":"Jamaika","JO":"Jordania","JP":"Japani","KE":"Kenia","KG":"Kirgisia","KH":"Kambodža","KI":"Kiribati","KM":"Komorit","KN":"Saint Kitts ja Nevis","KP":"Pohjois-Korea","KR":"Etelä-Korea","KW":"Kuwait","KY":"Caymansaaret","KZ":"Kazakstan","LA":"Laos","LB":"Libanon","LC":"Saint Lucia","LI":"Liechtenstein","LK":"Sri Lanka","LR":"Liberia","LS":"Lesotho","LT":"Liettua","LU":"Luxemburg","LV":"Latvia","LY":"Libya","MA":"Marokko","MC":"Monaco","MD":"Moldova","ME":"Montenegro","MF":"Saint-Martin","MG":"Madagaskar","MH":"Marshallinsaaret","MK":"Pohjois-Makedonia","ML":"Mali","MM":"Myanmar (Burma)","MN":"Mongolia","MO":"Macao – Kiinan erityishallintoalue","MP":"Pohjois-Mariaanit","MQ":"Martinique","MR":"Mauritania","MS":"Montserrat","MT":"Malta","MU":"Mauritius","MV":"Malediivit","MW":"Malawi","MX":"Meksiko","MY":"Malesia","MZ":"Mosambik","NA":"Namibia","NC":"Uusi-Kaledonia","NE":"Niger","NF":"Norfolkinsaari","NG":"Nigeria","NI":"Nicaragua","NL":"Alankomaat","NO":"Norja","NP":"Nepal","NR":"Nauru","NU":"Niue","NZ":"Uusi-Seelanti","OM":"Oman","PA":"Panama","PE":"Peru","PF":"Ranskan Polynesia","PG":"Papua-Uusi-Guinea","PH":"Filippiinit","PK":"Pakistan","PL":"Puola","PM":"Saint-Pierre ja Miquelon","PN":"Pitcairn","PR":"Puerto Rico","PS":"Palestiinalaisalue","PT":"Portugali","PW":"Palau","PY":"Paraguay","QA":"Qatar","RE":"Réunion","RO":"Romania","RS":"Serbia","RU":"Venäjä","RW":"Ruanda","SA":"Saudi-Arabia","SB":"Salomonsaaret","SC":"Seychellit","SD":"Sudan","SE":"Ruotsi","SG":"Singapore","SH":"Saint Helena","SI":"Slovenia","SJ":"Svalbard ja Jan Mayen","SK":"Slovakia","SL":"Sierra Leone","SM":"San Marino","SN":"Senegal","SO":"Somalia","SR":"Suriname","SS":"Etelä-Sudan","ST":"São Tomé ja Príncipe","SV":"El Salvador","SX":"Sint Maarten","SY":"Syyria","SZ":"Eswatini","TC":"Turks- ja Caicossaaret","TD":"Tšad","TF":"Ranskan eteläiset ja antarktiset alueet","TG":"Togo","TH":"Thaimaa","TJ":"Tadžikistan","TK":"Tokelau","TL":"Itä-Timor","TM":"Turkmenistan","TN":"Tunisia","TO":"Tonga","TR":"Turkki","TT":"Trinidad ja Tobago","TV":"Tuvalu","TW":"Taiwan","TZ":"Tansania","UA":"Ukraina","UG":"Uganda","UM":"Yhdysvaltain erillissaaret","US":"Yhdysvallat","UY":"Uruguay","UZ":"Uzbekistan","VA":"Vatikaani","VC":"Saint Vincent ja Grenadiinit","VE":"Venezuela","VG":"Brittiläiset Neitsytsaaret","VI":"Yhdysvaltain Neitsytsaaret","VN":"Vietnam","VU":"Vanuatu","WF":"Wallis ja Futuna","WS":"Samoa","YE":"Jemen","YT":"Mayotte","ZA":"Etelä-Afrikka","ZM":"Sambia","ZW":"Zimbabwe"}},
+{"language":"fr","names":{"AD":"Andorre","AE":"Émirats arabes unis","AF":"Afghanistan","AG":"Antigua-et-Barbuda","AI":"Anguilla","AL":"Albanie","AM":"Arménie","AO":"Angola","AQ":"Antarctique","AR":"Argentine","AS":"Samoa américaines","AT":"Autriche","AU":"Australie","AW":"Aruba","AX":"Îles Åland","AZ":"Azerbaïdjan","BA":"Bosnie-Herzégovine","BB":"Barbade","BD":"Bangladesh","BE":"Belgique","BF":"Burkina Faso","BG":"Bulgarie","BH":"Bahreïn","BI":"Burundi","BJ":"Bénin","BL":"Saint-Barthélemy","BM":"Bermudes","BN":"Brunei","BO":"Bolivie","BQ":"Pays-Bas caribéens","BR":"Brésil","BS":"Bahamas","BT":"Bhoutan","BV":"Île Bouvet","BW":"Botswana","BY":"Biélorussie","BZ":"Belize","CA":"Canada","CC":"Îles Cocos","CD":"Congo-Kinshasa","CF":"République centrafricaine","CG":"Congo-Brazzaville","CH":"Suisse","CI":"Côte d’Ivoire","CK":"Îles Cook","CL":"Chili","CM":"Cameroun","CN":"Chine","CO":"Colombie","CR":"Costa Rica","CU":"Cuba","CV":"Cap-Vert","CW":"Curaçao","CX":"Île Christmas","CY":"Chypre","CZ":"Tchéquie","DE":"Allemagne","DJ":"Djibouti","DK":"Danemark","DM":"Dominique","DO":"République dominicaine","DZ":"Algérie","EC":"Équateur","EE":"Estonie","EG":"Égypte","EH":"Sahara occidental","ER":"Érythrée","ES":"Espagne","ET":"Éthiopie","FI":"Finlande","FJ":"Fidji","FK":"Îles Malouines","FM":"Micronésie","FO":"Îles Féroé","FR":"France","GA":"Gabon","GB":"Royaume-Uni","GD":"Grenade","GE":"Géorgie","GF":"Guyane française","GG":"Guernesey","GH":"Ghana","GI":"Gibraltar","GL":"Groenland","GM":"Gambie","GN":"Guinée","GP":"Guadeloupe","GQ":"Guinée équatoriale","GR":"Grèce","GS":"Géorgie du Sud-et-les Îles Sandwich du Sud","GT":"Guatemala","GU":"Guam","GW":"Guinée-Bissau","GY":"Guyana","HK":"R.A.S. chinoise de Hong Kong","HM":"Îles Heard-et-MacDonald","HN":"Honduras","HR":"Croatie","HT":"Haïti","HU":"Hongrie","ID":"Indonésie","IE":"Irlande","IL":"Israël","IM":"Île de Man","IN":"Inde","IO":"Territoire britannique de l’océan Indien","IQ":"Irak","IR":"Iran","IS":"Islande","IT":"Italie","JE":"Jersey","JM":"Jamaïque","JO":"Jordanie","JP":"Japon","KE":"Kenya","KG":"Kirghizstan","KH":"Cambodge","KI":"Kiribati","KM":"Comores","KN":"Saint-Christophe-et-Niévès","KP":"Corée du Nord","KR":"Corée du Sud","KW":"Koweït","KY":"Îles Caïmans","KZ":"Kazakhstan","LA":"Laos","LB":"Liban","LC":"Sainte-Lucie","LI":"Liechtenstein","LK":"Sri Lanka","LR":"Liberia","LS":"Lesotho","LT":"Lituanie","LU":"Luxembourg","LV":"Lettonie","LY":"Libye","MA":"Maroc","MC":"Monaco","MD":"Moldavie","ME":"Monténégro","MF":"Saint-Martin","MG":"Madagascar","MH":"Îles Marshall","MK":"Macédoine du Nord","ML":"Mali","MM":"Myanmar (Birmanie)","MN":"Mongolie","MO":"R.A.S. chinoise de Macao","MP":"Îles Mariannes du Nord","MQ":"Martinique","MR":"Mauritanie","MS":"Montserrat","MT":"Malte","MU":"Maurice","MV":"Maldives","MW":"Malawi","MX":"Mexique","MY":"Malaisie","MZ":"Mozambique","NA":"Namibie","NC":"Nouvelle-Calédonie","NE":"Niger","NF":"Île Norfolk","NG":"Nigeria","NI":"Nicaragua","NL":"Pays-Bas","NO":"Norvège","NP":"Népal","NR":"Nauru","NU":"Niue","NZ":"Nouvelle-Zélande","OM":"Oman","PA":"Panama","PE":"Pérou","PF":"Polynésie française","PG":"Papouasie-Nouvelle-Guinée","PH":"Philippines","PK":"Pakistan","PL":"Pologne","PM":"Saint-Pierre-et-Miquelon","PN":"Îles Pitcairn","PR":"Porto Rico","PS":"Territoires palestiniens","PT":"Portugal","PW":"Palaos","PY":"Paraguay","QA":"Qatar","RE":"La Réunion","RO":"Roumanie","RS":"Serbie","RU":"Russie","RW":"Rwanda","SA":"Arabie saoudite","SB":"Îles Salomon","SC":"Seychelles","SD":"Soudan","SE":"Suède","SG":"Singapour","SH":"Sainte-Hélène","SI":"Slovénie","SJ":"Svalbard et Jan Mayen","SK":"Slovaquie","SL":"Sierra Leone","SM":"Saint-Marin","SN":"Sénégal","SO":"Somalie","SR":"Suriname","SS":"Soudan du Sud","ST":"Sao Tomé-et-Principe","SV":"Salvador","SX":"Saint-Martin (partie néerlandaise)","SY":"Syrie","SZ":"Eswatini","TC":"Îles Turques-et-Caïques","TD":"Tchad","TF":"Terres australes françaises","TG":"Togo","TH":"Thaïlande","TJ":"Tadjikistan","TK":"Tokelau","TL":"Timor oriental","TM":"Turkménistan","TN":"Tunisie","TO":"Tonga","TR":"Turquie","TT":"Trinité-et-Tobago","TV":"Tuvalu","TW":"Taïwan","TZ":"Tanzanie","UA":"Ukraine","UG":"Ouganda","UM":"Îles mineures éloignées des États-Unis","US":"États-Unis","UY":"Uruguay","UZ":"Ouzbékistan","VA":"État de la Cité du Vatican","VC":"Saint-Vincent-et-les Grenadines","VE":"Venezuela","VG":"Îles Vierges britanniques","VI":"Îles Vierges des États-Unis","VN":"Viêt Nam","VU":"Vanuatu","WF":"Wallis-et-Futuna","WS":"Samoa","YE":"Yémen","YT":"Mayotte","ZA":"Afrique du Sud","ZM":"Zambie","ZW":"Zimbabwe"}},
+{"language":"ga","names":{"AD":"Andóra","AE":"Aontas na nÉimíríochtaí Arabacha","AF":"an Afganastáin","AG":"Antigua agus Barbúda","AI":"Angaíle","AL":"an Albáin","AM":"an Airméin","AO":"Angóla","AQ":"Antartaice","AR":"an Airgintín","AS":"Samó Mheiriceá","AT":"an Ostair","AU":"an Astráil","AW":"Arúba","AX":"Oileáin Åland","AZ":"an Asarbaiseáin","BA":"an Bhoisnia agus an Heirseagaivéin","BB":"Barbadós","BD":"an Bhanglaidéis","BE":"an Bheilg","BF":"Buircíne Fasó","BG":"an Bhulgáir","BH":"Bairéin","BI":"an Bhurúin","BJ":"Beinin","BL":"Saint Barthélemy","BM":"Beirmiúda","BN":"Brúiné","BO":"an Bholaiv","BQ":"an Ísiltír Chairibeach","BR":"an Bhrasaíl","BS":"na Bahámaí","BT":"an Bhútáin","BV":"Oileán Bouvet","BW":"an Bhotsuáin","BY":"an Bhealarúis","BZ":"an Bheilís","CA":"Ceanada","CC":"Oileáin Cocos (Keeling)","CD":"Poblacht Dhaonlathach an Chongó","CF":"Poblacht na hAfraice Láir","CG":"Congó-Brazzaville","CH":"an Eilvéis","CI":"an Cósta Eabhair","CK":"Oileáin Cook","CL":"an tSile","CM":"Camarún","CN":"an tSín","CO":"an Cholóim","CR":"Cósta Ríce","CU":"Cúba","CV":"Rinn Verde","CW":"Cúrasó","CX":"Oileán na Nollag","CY":"an Chipir","CZ":"an tSeicia","DE":"an Ghearmáin","DJ":"Djibouti","DK":"an Danmhairg","DM":"Doiminice","DO":"an Phoblacht Dhoiminiceach","DZ":"an Ailgéir","EC":"Eacuadór","EE":"an Eastóin","EG":"an Éigipt","EH":"an Sahára Thiar","ER":"an Eiritré","ES":"an Spáinn","ET":"an Aetóip","FI":"an Fhionlainn","FJ":"Fidsí","FK":"Oileáin Fháclainne","FM":"an Mhicrinéis","FO":"Oileáin Fharó","FR":"an Fhrainc","GA":"an Ghabúin","GB":"an Ríocht Aontaithe","GD":"Greanáda","GE":"an tSeoirsia","GF":"Guáin na Fraince","GG":"Geansaí","GH":"Gána","GI":"Giobráltar","GL":"an Ghraonlainn","GM":"an Ghaimbia","GN":"an Ghuine","GP":"Guadalúip","GQ":"an Ghuine Mheánchiorclach","GR":"an Ghréig","GS":"an tSeoirsia Theas agus Oileáin Sandwich Theas","GT":"Guatamala","GU":"Guam","GW":"Guine Bissau","GY":"an Ghuáin","HK":"Sainréigiún Riaracháin Hong Cong, Daonphoblacht na Síne","HM":"Oileán Heard agus Oileáin McDonald","HN":"Hondúras","HR":"an Chróit","HT":"Háítí","HU":"an Ungáir","ID":"an Indinéis","IE":"Éire","IL":"Iosrael","IM":"Oileán Mhanann","IN":"an India","IO":"Críoch Aigéan Indiach na Breataine","IQ":"an Iaráic","IR":"an Iaráin","IS":"an Íoslainn","IT":"an Iodáil","JE":"Geirsí","JM":"Iamáice","JO":"an Iordáin","JP":"an tSeapáin","KE":"an Chéinia","KG":"an Chirgeastáin","KH":"an Chambóid","KI":"Ciribeas","KM":"Oileáin Chomóra","KN":"San Críostóir-Nimheas","KP":"an Chóiré Thuaidh","KR":"an Chóiré Theas","KW":"Cuáit","KY":"Oileáin Cayman","KZ":"an Chasacstáin","LA":"Laos","LB":"an Liobáin","LC":"Saint Lucia","LI":"Lichtinstéin","LK":"Srí Lanca","LR":"an Libéir","LS":"Leosóta","LT":"an Liotuáin","LU":"Lucsamburg","LV":"an Laitvia","LY":"an Libia","MA":"Maracó","MC":"Monacó","MD":"an Mholdóiv","ME":"Montainéagró","MF":"Saint-Martin","MG":"Madagascar","MH":"Oileáin Marshall","MK":"an Mhacadóin Thuaidh","ML":"Mailí","MM":"Maenmar (Burma)","MN":"an Mhongóil","MO":"Sainréigiún Riaracháin Macao, Daonphoblacht na Síne","MP":"na hOileáin Mháirianacha Thuaidh","MQ":"Martinique","MR":"an Mháratáin","MS":"Montsarat","MT":"Málta","MU":"Oileán Mhuirís","MV":"Oileáin Mhaildíve","MW":"an Mhaláiv","MX":"Meicsiceo","MY":"an Mhalaeisia","MZ":"Mósaimbíc","NA":"an Namaib","NC":"an Nua-Chaladóin","NE":"an Nígir","NF":"Oileán Norfolk","NG":"an Nigéir","NI":"Nicearagua","NL":"an Ísiltír","NO":"an Iorua","NP":"Neipeal","NR":"Nárú","NU":"Niue","NZ":"an Nua-Shéalainn","OM":"Óman","PA":"Panama","PE":"Peiriú","PF":"Polainéis na Fraince","PG":"Nua-Ghuine Phapua","PH":"na hOileáin Fhilipíneacha","PK":"an Phacastáin","PL":"an Pholainn","PM":"San Pierre agus Miquelon","PN":"Oileáin Pitcairn","PR":"Pórtó Ríce","PS":"na Críocha Palaistíneacha","PT":"an Phortaingéil","PW":"Oileáin Palau","PY":"Paragua","QA":"Catar","RE":"La Réunion","RO":"an Rómáin","RS":"an tSeirbia","RU":"an Rúis","RW":"Ruanda","SA":"an Araib Shádach","SB":"Oileáin Sholaimh","SC":"na Séiséil","SD":"an tSúdáin","SE":"an tSualainn","SG":"Singeapór","SH":"San Héilin","SI":"an tSlóivéin","SJ":"Svalbard agus Jan Mayen","SK":"an tSlóvaic","SL":"Siarra Leon","SM":"San Mairíne","SN":"an tSeineagáil","SO":"an tSomáil","SR":"Suranam","SS":"an tSúdáin Theas","ST":"São Tomé agus Príncipe","SV":"an tSalvadóir","SX":"Sint Maarten","SY":"an tSiria","SZ":"eSuaitíní","TC":"Oileáin na dTurcach agus Caicos","TD":"Sead","TF":"Críocha Francacha Dheisceart an Domhain","TG":"Tóga","TH":"an Téalainn","TJ":"an Táidsíceastáin","TK":"Tócalá","TL":"Tíomór Thoir","TM":"an Tuircméanastáin","TN":"an Túinéis","TO":"Tonga","TR":"an Tuirc","TT":"Oileán na Tríonóide agus Tobága","TV":"Túvalú","TW":"an Téaváin","TZ":"an Tansáin","UA":"an Úcráin","UG":"Uganda","UM":"Oileáin Imeallacha S.A.M.","US":"Stáit Aontaithe Mheiriceá","UY":"Uragua","UZ":"an Úisbéiceastáin","VA":"Cathair na Vatacáine","VC":"San Uinseann agus na Greanáidíní","VE":"Veiniséala","VG":"Oileáin Bhriotanacha na Maighdean","VI":"Oileáin Mheiriceánacha na Maighdean","VN":"Vítneam","VU":"Vanuatú","WF":"Vailís agus Futúna","WS":"Samó","YE":"Éimin","YT":"Mayotte","ZA":"an Afraic Theas","ZM":"an tSaimbia","ZW":"an tSiombáib"}},
+{"language":"he","names":{"AD":"אנדורה","AE":"איחוד האמירויות הערביות","AF":"אפגניסטן","AG":"אנטיגואה וברבודה","AI":"אנגווילה","AL":"אלבניה","AM":"ארמניה","AO":"אנגולה","AQ":"אנטארקטיקה","AR":"ארגנטינה","AS":"סמואה האמריקנית","AT":"אוסטריה","AU":"אוסטרליה","AW":"ארובה","AX":"איי אולנד","AZ":"אזרבייג׳ן","BA":"בוסניה והרצגובינה","BB":"ברבדוס","BD":"בנגלדש","BE":"בלגיה","BF":"בורקינה פאסו","BG":"בולגריה","BH":"בחריין","BI":"בורונדי","BJ":"בנין","BL":"סנט ברתולומיאו","BM":"ברמודה","BN":"ברוניי","BO":"בוליביה","BQ":"האיים הקריביים ההולנדיים","BR":"ברזיל","BS":"איי בהאמה","BT":"בהוטן","BV":"האי בובה","BW":"בוטסואנה","BY":"בלארוס","BZ":"בליז","CA":"קנדה","CC":"איי קוקוס (קילינג)","CD":"קונגו - קינשאסה","CF":"הרפובליקה המרכז-אפריקאית","CG":"קונגו - ברזאויל","CH":"שווייץ","CI":"חוף השנהב","CK":"איי קוק","CL":"צ׳ילה","CM":"קמרון","CN":"סין","CO":"קולומביה","CR":"קוסטה ריקה","CU":"קובה","CV":"כף ורדה","CW":"קוראסאו","CX":"אי חג המולד","CY":"קפריסין","CZ":"צ׳כיה","DE":"גרמניה","DJ":"ג׳יבוטי","DK":"דנמרק","DM":"דומיניקה","DO":"הרפובליקה הדומיניקנית","DZ":"אלג׳יריה","EC":"אקוודור","EE":"אסטוניה","EG":"מצרים","EH":"סהרה המערבית","ER":"אריתריאה","ES":"ספרד","ET":"אתיופיה","FI":"פינלנד","FJ":"פיג׳י","FK":"איי פוקלנד","FM":"מיקרונזיה","FO":"איי פארו","FR":"צרפת","GA":"גבון","GB":"בריטניה","GD":"גרנדה","GE":"גאורגיה","GF":"גיאנה הצרפתית","GG":"גרנזי","GH":"גאנה","GI":"גיברלטר","GL":"גרינלנד","GM":"גמביה","GN":"גינאה","GP":"גוואדלופ","GQ":"גינאה המשוונית","GR":"יוון","GS":"ג׳ורג׳יה הדרומית ואיי סנדוויץ׳ הדרומיים","GT":"גואטמלה","GU":"גואם","GW":"גינאה-ביסאו","GY":"גיאנה","HK":"הונג קונג (אזור מנהלי מיוחד של סין)","HM":"איי הרד ומקדונלד","HN":"הונדורס","HR":"קרואטיה","HT":"האיטי","HU":"הונגריה","ID":"אינדונזיה","IE":"אירלנד","IL":"ישראל","IM":"האי מאן","IN":"הודו","IO":"הטריטוריה הבריטית באוקיינוס ההודי","IQ":"עיראק","IR":"איראן","IS":"איסלנד","IT":"איטליה","JE":"ג׳רזי","JM":"ג׳מייקה","JO":"ירדן","JP":"יפן","KE":"קניה","KG":"קירגיזסטן","KH":"קמבודיה","KI":"קיריבאטי","KM":"קומורו","KN":"סנט קיטס ונוויס","KP":"קוריאה הצפונית","KR":"קוריאה הדרומית","KW":"כווית","KY":"איי קיימן","KZ":"קזחסטן","LA":"לאוס","LB":"לבנון","LC":"סנט לוסיה","LI":"ליכטנשטיין","LK":"סרי לנקה","LR":"ליבריה","LS":"לסוטו","LT":"ליטא","LU":"לוקסמבורג","LV":"לטביה","LY":"לוב","MA":"מרוקו","MC":"מונקו","MD":"מולדובה","ME":"מונטנגרו","MF":"סן מרטן","MG":"מדגסקר","MH":"איי מרשל","MK":"מקדוניה הצפונית","ML":"מאלי","MM":"מיאנמר (בורמה)","MN":"מונגוליה","MO":"מקאו (אזור מנהלי מיוחד של סין)","MP":"איי מריאנה הצפוניים","MQ":"מרטיניק","MR":"מאוריטניה","MS":"מונסראט","MT":"מלטה","MU":"מאוריציוס","MV":"האיים המלדיביים","MW":"מלאווי","MX":"מקסיקו","MY":"מלזיה","MZ":"מוזמביק","NA":"נמיביה","NC":"קלדוניה החדשה","NE":"ניז׳ר","NF":"האי נורפוק","NG":"ניגריה","NI":"ניקרגואה","NL":"הולנד","NO":"נורווגיה","NP":"נפאל","NR":"נאורו","NU":"ניווה","NZ":"ניו זילנד","OM":"עומאן","PA":"פנמה","PE":"פרו","PF":"פולינזיה הצרפתית","PG":"פפואה גינאה החדשה","PH":"הפיליפינים","PK":"פקיסטן","PL":"פולין","PM":"סנט פייר ומיקלון","PN":"איי פיטקרן","PR":"פוארטו ריקו","PS":"השטחים הפלסטיניים","PT":"פורטוגל","PW":"פלאו","PY":"פרגוואי","QA":"קטאר","RE":"ראוניון","RO":"רומניה","RS":"סרביה","RU":"רוסיה","RW":"רואנדה","SA":"ערב הסעודית","SB":"איי שלמה","SC":"איי סיישל","SD":"סודן","SE":"שוודיה","SG":"סינגפור","SH":"סנט הלנה","SI":"סלובניה","SJ":"סבאלברד ויאן מאיין","SK":"סלובקיה","SL":"סיירה לאון","SM":"סן מרינו","SN":"סנגל","SO":"סומליה","SR":"סורינאם","SS":"דרום סודן","ST":"סאו טומה ופרינסיפה","SV":"אל סלבדור","SX":"סנט מארטן","SY":"סוריה","SZ":"אסוואטיני","TC":"איי טרקס וקייקוס","TD":"צ׳אד","TF":"הטריטוריות הדרומיות של צרפת","TG":"טוגו","TH":"תאילנד","TJ":"טג׳יקיסטן","TK":"טוקלאו","TL":"טימור-לסטה","TM":"טורקמניסטן","TN":"תוניסיה","TO":"טונגה","TR":"טורקיה","TT":"טרינידד וטובגו","TV":"טובאלו","TW":"טייוואן","TZ":"טנזניה","UA":"אוקראינה","UG":"אוגנדה","UM":"האיים המרוחקים הקטנים של ארה״ב","US":"ארצות הברית","UY":"אורוגוואי","UZ":"אוזבקיסטן","VA":"הוותיקן","VC":"סנט וינסנט והגרנדינים","VE":"ונצואלה","VG":"איי הבתולה הבריטיים","VI":"איי הבתולה של ארצות הברית","VN":"וייטנאם","VU":"ונואטו","WF":"איי ווליס ופוטונה","WS":"סמואה","YE":"תימן","YT":"מאיוט","ZA":"דרום אפריקה","ZM":"זמביה","ZW":"זימבבואה"}},
+{"language":"hi","names":{"AD":"एंडोरा","AE":"संयुक्त अरब अमीरात","AF":"अफ़गानिस्तान","AG":"एंटिगुआ और बरबुडा","AI":"एंग्विला","AL":"अल्बानिया","AM":"आर्मेनिया","AO":"अंगोला","AQ":"अंटार्कटिका","AR":"अर्जेंटीना","AS":"अमेरिकी समोआ","AT":"ऑस्ट्रिया","AU":"ऑस्ट्रेलिया","AW":"अरूबा","AX":"एलैंड द्वीपसमूह","AZ":"अज़रबैजान","BA":"बोस्निया और हर्ज़ेगोविना","BB":"बारबाडोस","BD":"बांग्लादेश","BE":"बेल्जियम","BF":"बुर्किना फ़ासो","BG":"बुल्गारिया","BH":"बहरीन","BI":"बुरुंडी","BJ":"बेनिन","BL":"सेंट बार्थेलेमी","BM":"बरमूडा","BN":"ब्रूनेई","BO":"बोलीविया","BQ":"कैरिबियन नीदरलैंड","BR":"ब्राज़ील","BS":"बहामास","BT":"भूटान","BV":"बोवेत द्वीप","BW":"बोत्स्वाना","BY":"बेलारूस","BZ":"बेलीज़","CA":"कनाडा","CC":"कोकोस (कीलिंग) द्वीपसमूह","CD":"कांगो - किंशासा","CF":"मध्य अफ़्रीकी गणराज्य","CG":"कांगो – ब्राज़ाविल","CH":"स्विट्ज़रलैंड","CI":"कोत दिवुआर","CK":"कुक द्वीपसमूह","CL":"चिली","CM":"कैमरून","CN":"चीन","CO":"कोलंबिया","CR":"कोस्टारिका","CU":"क्यूबा","CV":"केप वर्ड","CW":"कुरासाओ","CX":"क्रिसमस द्वीप","CY":"साइप्रस","CZ":"चेकिया","DE":"जर्मनी","DJ":"जिबूती","DK":"डेनमार्क","DM":"डोमिनिका","DO":"डोमिनिकन गणराज्य","DZ":"अल्जीरिया","EC":"इक्वाडोर","EE":"एस्टोनिया","EG":"मिस्र","EH":"पश्चिमी सहारा","ER":"इरिट्रिया","ES":"स्पेन","ET":"इथियोपिया","FI":"फ़िनलैंड","FJ":"फ़िजी","FK":"फ़ॉकलैंड द्वीपसमूह","FM":"माइक्रोनेशिया","FO":"फ़ेरो द्वीपसमूह","FR":"फ़्रांस","GA":"गैबॉन","GB":"यूनाइटेड किंगडम","GD":"ग्रेनाडा","GE":"जॉर्जिया","GF":"फ़्रेंच गुयाना","GG":"गर्नसी","GH":"घाना","GI":"जिब्राल्टर","GL":"ग्रीनलैंड","GM":"गाम्बिया","GN":"गिनी","GP":"ग्वाडेलूप","GQ":"इक्वेटोरियल गिनी","GR":"यूनान","GS":"दक्षिण जॉर्जिया और दक्षिण सैंडविच द्वीपसमूह","GT":"ग्वाटेमाला","GU":"गुआम","GW":"गिनी-बिसाउ","GY":"गुयाना","HK":"हाँग काँग (चीन विशेष प्रशासनिक क्षेत्र)","HM":"हर्ड द्वीप और मैकडोनॉल्ड द्वीपसमूह","HN":"होंडूरास","HR":"क्रोएशिया","HT":"हैती","HU":"हंगरी","ID":"इंडोनेशिया","IE":"आयरलैंड","IL":"इज़राइल","IM":"आइल ऑफ़ मैन","IN":"भारत","IO":"ब्रिटिश हिंद महासागरीय क्षेत्र","IQ":"इराक","IR":"ईरान","IS":"आइसलैंड","IT":"इटली","JE":"जर्सी","JM":"जमैका","JO":"जॉर्डन","JP":"जापान","KE":"केन्या","KG":"किर्गिज़स्तान","KH":"कंबोडिया","KI":"किरिबाती","KM":"कोमोरोस","KN":"सेंट किट्स और नेविस","KP":"उत्तर कोरिया","KR":"दक्षिण कोरिया","KW":"कुवैत","KY":"कैमेन द्वीपसमूह","KZ":"कज़ाखस्तान","LA":"लाओस","LB":"लेबनान","LC":"सेंट लूसिया","LI":"लिचेंस्टीन","LK":"श्रीलंका","LR":"लाइबेरिया","LS":"लेसोथो","LT":"लिथुआनिया","LU":"लग्ज़मबर्ग","LV":"लातविया","LY":"लीबिया","MA":"मोरक्को","MC":"मोनाको","MD":"मॉल्डोवा","ME":"मोंटेनेग्रो","MF":"सेंट मार्टिन","MG":"मेडागास्कर","MH":"मार्शल द्वीपसमूह","MK":"उत्तरी मकदूनिया","ML":"माली","MM":"म्यांमार (बर्मा)","MN":"मंगोलिया","MO":"मकाऊ (विशेष प्रशासनिक क्षेत्र चीन)","MP":"उत्तरी मारियाना द्वीपसमूह","MQ":"मार्टीनिक","MR":"मॉरिटानिया","MS":"मोंटसेरात","MT":"माल्टा","MU":"मॉरीशस","MV":"मालदीव","MW":"मलावी","MX":"मैक्सिको","MY":"मलेशिया","MZ":"मोज़ांबिक","NA":"नामीबिया","NC":"न्यू कैलेडोनिया","NE":"नाइजर","NF":"नॉरफ़ॉक द्वीप","NG":"नाइजीरिया","NI":"निकारागुआ","NL":"नीदरलैंड","NO":"नॉर्वे","NP":"नेपाल","NR":"नाउरु","NU":"नीयू","NZ":"न्यूज़ीलैंड","OM":"ओमान","PA":"पनामा","PE":"पेरू","PF":"फ़्रेंच पोलिनेशिया","PG":"पापुआ न्यू गिनी","PH":"फ़िलिपींस","PK":"पाकिस्तान","PL":"पोलैंड","PM":"सेंट पिएरे और मिक्वेलान","PN":"पिटकैर्न द्वीपसमूह","PR":"पोर्टो रिको","PS":"फ़िलिस्तीनी क्षेत्र","PT":"पुर्तगाल","PW":"पलाऊ","PY":"पराग्वे","QA":"क़तर","RE":"रियूनियन","RO":"रोमानिया","RS":"सर्बिया","RU":"रूस","RW":"रवांडा","SA":"सऊदी अरब","SB":"सोलोमन द्वीपसमूह","SC":"सेशेल्स","SD":"सूडान","SE":"स्वीडन","SG":"सिंगापुर","SH":"सेंट हेलेना","SI":"स्लोवेनिया","SJ":"स्वालबार्ड और जान मायेन","SK":"स्लोवाकिया","SL":"सिएरा लियोन","SM":"सैन मेरीनो","SN":"सेनेगल","SO":"सोमालिया","SR":"सूरीनाम","SS":"दक्षिण सूडान","ST":"साओ टोम और प्रिंसिपे","SV":"अल सल्वाडोर","SX":"सिंट मार्टिन","SY":"सीरिया","SZ":"एस्वाटिनी","TC":"तुर्क और कैकोज़ द्वीपसमूह","TD":"चाड","TF":"फ़्रांसीसी दक्षिणी क्षेत्र","TG":"टोगो","TH":"थाईलैंड","TJ":"ताजिकिस्तान","TK":"तोकेलाउ","TL":"तिमोर-लेस्त","TM":"तुर्कमेनिस्तान","TN":"ट्यूनीशिया","TO":"टोंगा","TR":"तुर्की","TT":"त्रिनिदाद और टोबैगो","TV":"तुवालू","TW":"ताइवान","TZ":"तंज़ानिया","UA":"यूक्रेन","UG":"युगांडा","UM":"यू॰एस॰ आउटलाइंग द्वीपसमूह","US":"संयुक्त राज्य","UY":"उरूग्वे","UZ":"उज़्बेकिस्तान","VA":"वेटिकन सिटी","VC":"सेंट विंसेंट और ग्रेनाडाइंस","VE":"वेनेज़ुएला","VG":"ब्रिटिश वर्जिन द्वीपसमूह","VI":"यू॰एस॰ वर्जिन द्वीपसमूह","VN":"वियतनाम","VU":"वनुआतू","WF":"वालिस और फ़्यूचूना","WS":"समोआ","YE":"यमन","YT":"मायोते","ZA":"दक्षिण अफ़्रीका","ZM":"ज़ाम्बिया","ZW":"ज़िम्बाब्वे"}},
+{"language":"hr","names":{"AD":"Andora","AE":"Ujedinjeni Arapski Emirati","AF":"Afganistan","AG":"Antigva i Barbuda","AI":"Angvila","AL":"Albanija","AM":"Armenija","AO":"Angola","AQ":"Antarktika","AR":"Argentina","AS":"Američka Samoa","AT":"Austrija","AU":"Australija","AW":"Aruba","AX":"Ålandski otoci","AZ":"Azerbajdžan","BA":"Bosna i Hercegovina","BB":"Barbados","BD":"Bangladeš","BE":"Belgija","BF":"Burkina Faso","BG":"Bugarska","BH":"Bahrein","BI":"Burundi","BJ":"Benin","BL":"Saint Barthélemy","BM":"Bermudi","BN":"Brunej","BO":"Bolivija","BQ":"Karipski otoci Nizozemske","BR":"Brazil","BS":"Bahami","BT":"Butan","BV":"Otok Bouvet","BW":"Bocvana","BY":"Bjelorusija","BZ":"Belize","CA":"Kanada","CC":"Kokosovi (Keelingovi) otoci","CD":"Kongo - Kinshasa","CF":"Srednjoafrička Republika","CG":"Kongo - Brazzaville","CH":"Švicarska","CI":"Obala Bjelokosti","CK":"Cookovi otoci","CL":"Čile","CM":"Kamerun","CN":"Kina","CO":"Kolumbija","CR":"Kostarika","CU":"Kuba","CV":"Zelenortska Republika","CW":"Curaçao","CX":"Božićni otok","CY":"Cipar","CZ":"Češka","DE":"Njemačka","DJ":"Džibuti","DK":"Danska","DM":"Dominika","DO":"Dominikanska Republika","DZ":"Alžir","EC":"Ekvador","EE":"Estonija","EG":"Egipat","EH":"Zapadna Sahara","ER":"Eritreja","ES":"Španjolska","ET":"Etiopija","FI":"Finska","FJ":"Fidži","FK":"Falklandski otoci","FM":"Mikronezija","FO":"Farski otoci","FR":"Francuska","GA":"Gabon","GB":"Ujedinjeno Kraljevstvo","GD":"Grenada","GE":"Gruzija","GF":"Francuska Gijana","GG":"Guernsey","GH":"Gana","GI":"Gibraltar","GL":"Grenland","GM":"Gambija","GN":"Gvineja","GP":"Guadalupe","GQ":"Ekvatorska Gvineja","GR":"Grčka","GS":"Južna Georgija i Južni Sendvički Otoci","GT":"Gvatemala","GU":"Guam","GW":"Gvineja Bisau","GY":"Gvajana","HK":"PUP Hong Kong Kina","HM":"Otoci Heard i McDonald","HN":"Honduras","HR":"Hrvatska","HT":"Haiti","HU":"Mađarska","ID":"Indonezija","IE":"Irska","IL":"Izrael","IM":"Otok Man","IN":"Indija","IO":"Britanski Indijskooceanski teritorij","IQ":"Irak","IR":"Iran","IS":"Island","IT":"Italija","JE":"Jersey","JM":"Jamajka","JO":"Jordan","JP":"Japan","KE":"Kenija","KG":"Kirgistan","KH":"Kambodža","KI":"Kiribati","KM":"Komori","KN":"Sveti Kristofor i Nevis","KP":"Sjeverna Koreja","KR":"Južna Koreja","KW":"Kuvajt","KY":"Kajmanski otoci","KZ":"Kazahstan","LA":"Laos","LB":"Libanon","LC":"Sveta Lucija","LI":"Lihtenštajn","LK":"Šri Lanka","LR":"Liberija","LS":"Lesoto","LT":"Litva","LU":"Luksemburg","LV":"Latvija","LY":"Libija","MA":"Maroko","MC":"Monako","MD":"Moldavija","ME":"Crna Gora","MF":"Saint Martin","MG":"Madagaskar","MH":"Maršalovi Otoci","MK":"Sjeverna Makedonija","ML":"Mali","MM":"Mjanmar (Burma)","MN":"Mongolija","MO":"PUP Makao Kina","MP":"Sjevernomarijanski otoci","MQ":"Martinik","MR":"Mauretanija","MS":"Montserrat","MT":"Malta","MU":"Mauricijus","MV":"Maldivi","MW":"Malavi","MX":"Meksiko","MY":"Malezija","MZ":"Mozambik","NA":"Namibija","NC":"Nova Kaledonija","NE":"Niger","NF":"Otok Norfolk","NG":"Nigerija","NI":"Nikaragva","NL":"Nizozemska","NO":"Norveška","NP":"Nepal","NR":"Nauru","NU":"Niue","NZ":"Novi Zeland","OM":"Oman","PA":"Panama","PE":"Peru","PF":"Francuska Polinezija","PG":"Papua Nova Gvineja","PH":"Filipini","PK":"Pakistan","PL":"Poljska","PM":"Saint-Pierre-et-Miquelon","PN":"Otoci Pitcairn","PR":"Portoriko","PS":"Palestinsko područje","PT":"Portugal","PW":"Palau","PY":"Paragvaj","QA":"Katar","RE":"Réunion","RO":"Rumunjska","RS":"Srbija","RU":"Rusija","RW":"Ruanda","SA":"Saudijska Arabija","SB":"Salomonski Otoci","SC":"Sejšeli","SD":"Sudan","SE":"Švedska","SG":"Singapur","SH":"Sveta Helena","SI":"Slovenija","SJ":"Svalbard i Jan Mayen","SK":"Slovačka","SL":"Sijera Leone","SM":"San Marino","SN":"Senegal","SO":"Somalija","SR":"Surinam","SS":"Južni Sudan","ST":"Sveti Toma i Princip","SV":"Salvador","SX":"Sint Maarten","SY":"Sirija","SZ":"Esvatini","TC":"Otoci Turks i Caicos","TD":"Čad","TF":"Francuski južni i antarktički teritoriji","TG":"Togo","TH":"Tajland","TJ":"Tadžikistan","TK":"Tokelau","TL":"Timor-Leste","TM":"Turkmenistan","TN":"Tunis","TO":"Tonga","TR":"Turska","TT":"Trinidad i Tobago","TV":"Tuvalu","TW":"Tajvan","TZ":"Tanzanija","UA":"Ukrajina","UG":"Uganda","UM":"Mali udaljeni otoci SAD-a","US":"Sjedinjene Američke Države","UY":"Urugvaj","UZ":"Uzbekistan","VA":"Vatikanski Grad","VC":"Sveti Vincent i Grenadini","VE":"Venezuela","VG":"Britanski Djevičanski otoci","VI":"Američki Djevičanski otoci","VN":"Vijetnam","VU":"Vanuatu","WF":"Wallis i Futuna","WS":"Samoa","YE":"Jemen","YT":"Mayotte","ZA":"Južnoafrička Republika","ZM":"Zambija","ZW":"Zimbabve"}},
+{"language":"hu","names":{"AD":"Andorra","AE":"Egyesült Arab Emírségek","AF":"Afganisztán","AG":"Antigua és Barbuda","AI":"Anguilla","AL":"Albánia","AM":"Örményország","AO":"Angola","AQ":"Antarktisz","AR":"Argentína","AS":"Amerikai Szamoa","AT":"Ausztria","AU":"Ausztrália","AW":"Aruba","AX":"Åland-szigetek","AZ":"Azerbajdzsán","BA":"Bosznia-Hercegovina","BB":"Barbados","BD":"Banglades","BE":"Belgium","BF":"Burkina Faso","BG":"Bulgária","BH":"Bahrein","BI":"Burundi","BJ":"Benin","BL":"Saint-Barthélemy","BM":"Bermuda","BN":"Brunei","BO":"Bolívia","BQ":"Holland Karib-térség","BR":"Brazília","BS":"Bahama-szigetek","BT":"Bhután","BV":"Bouvet-sziget","BW":"Botswana","BY":"Belarusz","BZ":"Belize","CA":"Kanada","CC":"Kókusz (Keeling)-szigetek","CD":"Kongó – Kinshasa","CF":"Közép-afrikai Köztársaság","CG":"Kongó – Brazzaville","CH":"Svájc","CI":"Elefántcsontpart","CK":"Cook-szigetek","CL":"Chile","CM":"Kamerun","CN":"Kína","CO":"Kolumbia","CR":"Costa Rica","CU":"Kuba","CV":"Zöld-foki Köztársaság","CW":"Curaçao","CX":"Karácsony-sziget","CY":"Ciprus","CZ":"Csehország","DE":"Németország","DJ":"Dzsibuti","DK":"Dánia","DM":"Dominika","DO":"Dominikai Köztársaság","DZ":"Algéria","EC":"Ecuador","EE":"Észtország","EG":"Egyiptom","EH":"Nyugat-Szahara","ER":"Eritrea","ES":"Spanyolország","ET":"Etiópia","FI":"Finnország","FJ":"Fidzsi","FK":"Falkland-szigetek","FM":"Mikronézia","FO":"Feröer szigetek","FR":"Franciaország","GA":"Gabon","GB":"Egyesült Királyság","GD":"Grenada","GE":"Grúzia","GF":"Francia Guyana","GG":"Guernsey","GH":"Ghána","GI":"Gibraltár","GL":"Grönland","GM":"Gambia","GN":"Guinea","GP":"Guadeloupe","GQ":"Egyenlítői-Guinea","GR":"Görögország","GS":"Déli-Georgia és Déli-Sandwich-szigetek","GT":"Guatemala","GU":"Guam","GW":"Bissau-Guinea","GY":"Guyana","HK":"Hongkong KKT","HM":"Heard-sziget és McDonald-szigetek","HN":"Honduras","HR":"Horvátország","HT":"Haiti","HU":"Magyarország","ID":"Indonézia","IE":"Írország","IL":"Izrael","IM":"Man-sziget","IN":"India","IO":"Brit Indiai-óceáni Terület","IQ":"Irak","IR":"Irán","IS":"Izland","IT":"Olaszország","JE":"Jersey","JM":"Jamaica","JO":"Jordánia","JP":"Japán","KE":"Kenya","KG":"Kirgizisztán","KH":"Kambodzsa","KI":"Kiribati","KM":"Comore-szigetek","KN":"Saint Kitts és Nevis","KP":"Észak-Korea","KR":"Dél-Korea","KW":"Kuvait","KY":"Kajmán-szigetek","KZ":"Kazahsztán","LA":"Laosz","LB":"Libanon","LC":"Saint Lucia","LI":"Liechtenstein","LK":"Srí Lanka","LR":"Libéria","LS":"Lesotho","LT":"Litvánia","LU":"Luxemburg","LV":"Lettország","LY":"Líbia","MA":"Marokkó","MC":"Monaco","MD":"Moldova","ME":"Montenegró","MF":"Saint Martin","MG":"Madagaszkár","MH":"Marshall-szigetek","MK":"Észak-Macedónia","ML":"Mali","MM":"Mianmar","MN":"Mongólia","MO":"Makaó KKT","MP":"Északi Mariana-szigetek","MQ":"Martinique","MR":"Mauritánia","MS":"Montserrat","MT":"Málta","MU":"Mauritius","MV":"Maldív-szigetek","MW":"Malawi","MX":"Mexikó","MY":"Malajzia","MZ":"Mozambik","NA":"Namíbia","NC":"Új-Kaledónia","NE":"Niger","NF":"Norfolk-sziget","NG":"Nigéria","NI":"Nicaragua","NL":"Hollandia","NO":"Norvégia","NP":"Nepál","NR":"Nauru","NU":"Niue","NZ":"Új-Zéland","OM":"Omán","PA":"Panama","PE":"Peru","PF":"Francia Polinézia","PG":"Pápua Új-Guinea","PH":"Fülöp-szigetek","PK":"Pakisztán","PL":"Lengyelország","PM":"Saint-Pierre és Miquelon","PN":"Pitcairn-szigetek","PR":"Puerto Rico","PS":"Palesztin Autonómia","PT":"Portugália","PW":"Palau","PY":"Paraguay","QA":"Katar","RE":"Réunion","RO":"Románia","RS":"Szerbia","RU":"Oroszország","RW":"Ruanda","SA":"Szaúd-Arábia","SB":"Salamon-szigetek","SC":"Seychelle-szigetek","SD":"Szudán","SE":"Svédország","SG":"Szingapúr","SH":"Szent Ilona","SI":"Szlovénia","SJ":"Svalbard és Jan Mayen","SK":"Szlovákia","SL":"Sierra Leone","SM":"San Marino","SN":"Szenegál","SO":"Szomália","SR":"Suriname","SS":"Dél-Szudán","ST":"São Tomé és Príncipe","SV":"Salvador","SX":"Sint Maarten","SY":"Szíria","SZ":"Szváziföld","TC":"Turks- és Caicos-szigetek","TD":"Csád","TF":"Francia Déli Területek","TG":"Togo","TH":"Thaiföld","TJ":"Tádzsikisztán","TK":"Tokelau","TL":"Kelet-Timor","TM":"Türkmenisztán","TN":"Tunézia","TO":"Tonga","TR":"Törökország","TT":"Trinidad és Tobago","TV":"Tuvalu","TW":"Tajvan","TZ":"Tanzánia","UA":"Ukrajna","UG":"Uganda","UM":"Az USA lakatlan külbirtokai","US":"Egyesült Államok","UY":"Uruguay","UZ":"Üzbegisztán","VA":"Vatikán","VC":"Saint Vincent és a Grenadine-szigetek","VE":"Venezuela","VG":"Brit Virgin-szigetek","VI":"Amerikai Virgin-szigetek","VN":"Vietnám","VU":"Vanuatu","WF":"Wallis és Futuna","WS":"Szamoa","YE":"Jemen","YT":"Mayotte","ZA":"Dél-afrikai Köztársaság","ZM":"Zambia","ZW":"Zimbabwe"}},
+{"language":"id","names":{"AD":"Andorra","AE":"Uni Emirat Arab","AF":"Afganistan","AG":"Antigua dan Barbuda","AI":"Anguilla","AL":"Albania","AM":"Armenia","AO":"Angola","AQ":"Antarktika","AR":"Argentina","AS":"Samoa Amerika","AT":"Austria","AU":"Australia","AW":"Aruba","AX":"Kepulauan Aland","AZ":"Azerbaijan","BA":"Bosnia dan Herzegovina","BB":"Barbados","BD":"Bangladesh","BE":"Belgia","BF":"Burkina Faso","BG":"Bulgaria","BH":"Bahrain","BI":"Burundi","BJ":"Benin","BL":"Saint Barthélemy","BM":"Bermuda","BN":"Brunei","BO":"Bolivia","BQ":"Belanda Karibia","BR":"Brasil","BS":"Bahama","BT":"Bhutan","BV":"Pulau Bouvet","BW":"Botswana","BY":"Belarus","BZ":"Belize","CA":"Kanada","CC":"Kepulauan Cocos (Keeling)","CD":"Kongo - Kinshasa","CF":"Republik Afrika Tengah","CG":"Kongo - Brazzaville","CH":"Swiss","CI":"Côte d’Ivoire","CK":"Kepulauan Cook","CL":"Cile","CM":"Kamerun","CN":"Tiongkok","CO":"Kolombia","CR":"Kosta Rika","CU":"Kuba","CV":"Tanjung Verde","CW":"Curaçao","CX":"Pulau Natal","CY":"Siprus","CZ":"Ceko","DE":"Jerman","DJ":"Jibuti","DK":"Denmark","DM":"Dominika","DO":"Republik Dominika","DZ":"Aljazair","EC":"Ekuador","EE":"Estonia","EG":"Mesir","EH":"Sahara Barat","ER":"Eritrea","ES":"Spanyol","ET":"Etiopia","FI":"Finlandia","FJ":"Fiji","FK":"Kepulauan Falkland","FM":"Mikronesia","FO":"Kepulauan Faroe","FR":"Prancis","GA":"Gabon","GB":"Inggris Raya","GD":"Grenada","GE":"Georgia","GF":"Guyana Prancis","GG":"Guernsey","GH":"Ghana","GI":"Gibraltar","GL":"Greenland","GM":"Gambia","GN":"Guinea","GP":"Guadeloupe","GQ":"Guinea Ekuatorial","GR":"Yunani","GS":"Georgia Selatan & Kep. Sandwich Selatan","GT":"Guatemala","GU":"Guam","GW":"Guinea-Bissau","GY":"Guyana","HK":"Hong Kong DAK Tiongkok","HM":"Pulau Heard dan Kepulauan McDonald","HN":"Honduras","HR":"Kroasia","HT":"Haiti","HU":"Hungaria","ID":"Indonesia","IE":"Irlandia","IL":"Israel","IM":"Pulau Man","IN":"India","IO":"Wilayah Inggris di Samudra Hindia","IQ":"Irak","IR":"Iran","IS":"Islandia","IT":"Italia","JE":"Jersey","JM":"Jamaika","JO":"Yordania","JP":"Jepang","KE":"Kenya","KG":"Kirgizstan","KH":"Kamboja","KI":"Kiribati","KM":"Komoro","KN":"Saint Kitts dan Nevis","KP":"Korea Utara","KR":"Korea Selatan","KW":"Kuwait","KY":"Kepulauan Cayman","KZ":"Kazakhstan","LA":"Laos","LB":"Lebanon","LC":"Saint Lucia","LI":"Liechtenstein","LK":"Sri Lanka","LR":"Liberia","LS":"Lesotho","LT":"Lituania","LU":"Luksemburg","LV":"Latvia","LY":"Libya","MA":"Maroko","MC":"Monako","MD":"Moldova","ME":"Montenegro","MF":"Saint Martin","MG":"Madagaskar","MH":"Kepulauan Marshall","MK":"Makedonia Utara","ML":"Mali","MM":"Myanmar (Burma)","MN":"Mongolia","MO":"Makau DAK Tiongkok","MP":"Kepulauan Mariana Utara","MQ":"Martinik","MR":"Mauritania","MS":"Montserrat","MT":"Malta","MU":"Mauritius","MV":"Maladewa","MW":"Malawi","MX":"Meksiko","MY":"Malaysia","MZ":"Mozambik","NA":"Namibia","NC":"Kaledonia Baru","NE":"Niger","NF":"Kepulauan Norfolk","NG":"Nigeria","NI":"Nikaragua","NL":"Belanda","NO":"Norwegia","NP":"Nepal","NR":"Nauru","NU":"Niue","NZ":"Selandia Baru","OM":"Oman","PA":"Panama","PE":"Peru","PF":"Polinesia Prancis","PG":"Papua Nugini","PH":"Filipina","PK":"Pakistan","PL":"Polandia","PM":"Saint Pierre dan Miquelon","PN":"Kepulauan Pitcairn","PR":"Puerto Riko","PS":"Wilayah Palestina","PT":"Portugal","PW":"Palau","PY":"Paraguay","QA":"Qatar","RE":"Réunion","RO":"Rumania","RS":"Serbia","RU":"Rusia","RW":"Rwanda","SA":"Arab Saudi","SB":"Kepulauan Solomon","SC":"Seychelles","SD":"Sudan","SE":"Swedia","SG":"Singapura","SH":"Saint Helena","SI":"Slovenia","SJ":"Kepulauan Svalbard dan Jan Mayen","SK":"Slovakia","SL":"Sierra Leone","SM":"San Marino","SN":"Senegal","SO":"Somalia","SR":"Suriname","SS":"Sudan Selatan","ST":"Sao Tome dan Principe","SV":"El Salvador","SX":"Sint Maarten","SY":"Suriah","SZ":"eSwatini","TC":"Kepulauan Turks dan Caicos","TD":"Chad","TF":"Wilayah Selatan Prancis","TG":"Togo","TH":"Thailand","TJ":"Tajikistan","TK":"Tokelau","TL":"Timor Leste","TM":"Turkmenistan","TN":"Tunisia","TO":"Tonga","TR":"Turki","TT":"Trinidad dan Tobago","TV":"Tuvalu","TW":"Taiwan","TZ":"Tanzania","UA":"Ukraina","UG":"Uganda","UM":"Kepulauan Terluar AS","US":"Amerika Serikat","UY":"Uruguay","UZ":"Uzbekistan","VA":"Vatikan","VC":"Saint Vincent dan Grenadine","VE":"Venezuela","VG":"Kepulauan Virgin Britania Raya","VI":"Kepulauan Virgin Amerika Serikat","VN":"Vietnam","VU":"Vanuatu","WF":"Kepulauan Wallis dan Futuna","WS":"Samoa","YE":"Yaman","YT":"Mayotte","ZA":"Afrika Selatan","ZM":"Zambia","ZW":"Zimbabwe"}},
+{"language":"it","names":{"AD":"Andorra","AE":"Emirati Arabi Uniti","AF":"Afghanistan","AG":"Antigua e Barbuda","AI":"Anguilla","AL":"Albania","AM":"Armenia","AO":"Angola","AQ":"Antartide","AR":"Argentina","AS":"Samoa americane","AT":"Austria","AU":"Australia","AW":"Aruba","AX":"Isole Åland","AZ":"Azerbaigian","BA":"Bosnia ed Erzegovina","BB":"Barbados","BD":"Bangladesh","BE":"Belgio","BF":"Burkina Faso","BG":"Bulgaria","BH":"Bahrein","BI":"Burundi","BJ":"Benin","BL":"Saint-Barthélemy","BM":"Bermuda","BN":"Brunei","BO":"Bolivia","BQ":"Caraibi olandesi","BR":"Brasile","BS":"Bahamas","BT":"Bhutan","BV":"Isola Bouvet","BW":"Botswana","BY":"Bielorussia","BZ":"Belize","CA":"Canada","CC":"Isole Cocos (Keeling)","CD":"Congo - Kinshasa","CF":"Repubblica Centrafricana","CG":"Congo-Brazzaville","CH":"Svizzera","CI":"Costa d’Avorio","CK":"Isole Cook","CL":"Cile","CM":"Camerun","CN":"Cina","CO":"Colombia","CR":"Costa Rica","CU":"Cuba","CV":"Capo Verde","CW":"Curaçao","CX":"Isola Christmas","CY":"Cipro","CZ":"Cechia","DE":"Germania","DJ":"Gibuti","DK":"Danimarca","DM":"Dominica","DO":"Repubblica Dominicana","DZ":"Algeria","EC":"Ecuador","EE":"Estonia","EG":"Egitto","EH":"Sahara occidentale","ER":"Eritrea","ES":"Spagna","ET":"Etiopia","FI":"Finlandia","FJ":"Figi","FK":"Isole Falkland","FM":"Micronesia","FO":"Isole Fær Øer","FR":"Francia","GA":"Gabon","GB":"Regno Unito","GD":"Grenada","GE":"Georgia","GF":"Guyana Francese","GG":"Guernsey","GH":"Ghana","GI":"Gibilterra","GL":"Groenlandia","GM":"Gambia","GN":"Guinea","GP":"Guadalupa","GQ":"Guinea Equatoriale","GR":"Grecia","GS":"Georgia del Sud e Sandwich Australi","GT":"Guatemala","GU":"Guam","GW":"Guinea-Bissau","GY":"Guyana","HK":"RAS di Hong Kong","HM":"Isole Heard e McDonald","HN":"Honduras","HR":"Croazia","HT":"Haiti","HU":"Ungheria","ID":"Indonesia","IE":"Irlanda","IL":"Israele","IM":"Isola di Man","IN":"India","IO":"Territorio britannico dell’Oceano Indiano","IQ":"Iraq","IR":"Iran","IS":"Islanda","IT":"Italia","JE":"Jersey","JM":"Giamaica","JO":"Giordania","JP":"Giappone","KE":"Kenya","KG":"Kirghizistan","KH":"Cambogia","KI":"Kiribati","KM":"Comore","KN":"Saint Kitts e Nevis","KP":"Corea del Nord","KR":"Corea del Sud","KW":"Kuwait","KY":"Isole Cayman","KZ":"Kazakistan","LA":"Laos","LB":"Libano","LC":"Saint Lucia","LI":"Liechtenstein","LK":"Sri Lanka","LR":"Liberia","LS":"Lesotho","LT":"Lituania","LU":"Lussemburgo","LV":"Lettonia","LY":"Libia","MA":"Marocco","MC":"Monaco","MD":"Moldavia","ME":"Montenegro","MF":"Saint Martin","MG":"Madagascar","MH":"Isole Marshall","MK":"Macedonia del Nord","ML":"Mali","MM":"Myanmar (Birmania)","MN":"Mongolia","MO":"RAS di Macao","MP":"Isole Marianne settentrionali","MQ":"Martinica","MR":"Mauritania","MS":"Montserrat","MT":"Malta","MU":"Mauritius","MV":"Maldive","MW":"Malawi","MX":"Messico","MY":"Malaysia","MZ":"Mozambico","NA":"Namibia","NC":"Nuova Caledonia","NE":"Niger","NF":"Isola Norfolk","NG":"Nigeria","NI":"Nicaragua","NL":"Paesi Bassi","NO":"Norvegia","NP":"Nepal","NR":"Nauru","NU":"Niue","NZ":"Nuova Zelanda","OM":"Oman","PA":"Panama","PE":"Perù","PF":"Polinesia francese","PG":"Papua Nuova Guinea","PH":"Filippine","PK":"Pakistan","PL":"Polonia","PM":"Saint-Pierre e Miquelon","PN":"Isole Pitcairn","PR":"Portorico","PS":"Territori palestinesi","PT":"Portogallo","PW":"Palau","PY":"Paraguay","QA":"Qatar","RE":"Riunione","RO":"Romania","RS":"Serbia","RU":"Russia","RW":"Ruanda","SA":"Arabia Saudita","SB":"Isole Salomone","SC":"Seychelles","SD":"Sudan","SE":"Svezia","SG":"Singapore","SH":"Sant’Elena","SI":"Slovenia","SJ":"Svalbard e Jan Mayen","SK":"Slovacchia","SL":"Sierra Leone","SM":"San Marino","SN":"Senegal","SO":"Somalia","SR":"Suriname","SS":"Sud Sudan","ST":"São Tomé e Príncipe","SV":"El Salvador","SX":"Sint Maarten","SY":"Siria","SZ":"eSwatini","TC":"Isole Turks e Caicos","TD":"Ciad","TF":"Terre australi francesi","TG":"Togo","TH":"Thailandia","TJ":"Tagikistan","TK":"Tokelau","TL":"Timor Est","TM":"Turkmenistan","TN":"Tunisia","TO":"Tonga","TR":"Turchia","TT":"Trinidad e Tobago","TV":"Tuvalu","TW":"Taiwan","TZ":"Tanzania","UA":"Ucraina","UG":"Uganda","UM":"Isole Minori Esterne degli Stati Uniti","US":"Stati Uniti","UY":"Uruguay","UZ":"Uzbekistan","VA":"Città del Vaticano","VC":"Saint Vincent e Grenadine","VE":"Venezuela","VG":"Isole Vergini Britanniche","VI":"Isole Vergini Americane","VN":"Vietnam","VU":"Vanuatu","WF":"Wallis e Futuna","WS":"Samoa","YE":"Yemen","YT":"Mayotte","ZA":"Sudafrica","ZM":"Zambia","ZW":"Zimbabwe"}},
+{"language":"ja","names":{"AD":"アンドラ","AE":"アラブ首長国連邦","AF":"アフガニスタン","AG":"アンティグア・バーブーダ","AI":"アンギラ","AL":"アルバニア","AM":"アルメニア","AO":"アンゴラ","AQ":"南極","AR":"アルゼンチン","AS":"米領サモア","AT":"オーストリア","AU":"オーストラリア","AW":"アルバ","AX":"オーランド諸島","AZ":"アゼルバイジャン","BA":"ボスニア・ヘルツェゴビナ","BB":"バルバドス","BD":"バングラデシュ","BE":"ベルギー","BF":"ブルキナファソ","BG":"ブルガリア","BH":"バーレーン","BI":"ブルンジ","BJ":"ベナン","BL":"サン・バルテルミー","BM":"バミューダ","BN":"ブルネイ","BO":"ボリビア","BQ":"オランダ領カリブ","BR":"ブラジル","BS":"バハマ","BT":"ブータン","BV":"ブーベ島","BW":"ボツワナ","BY":"ベラルーシ","BZ":"ベリーズ","CA":"カナダ","CC":"ココス(キーリング)諸島","CD":"コンゴ民主共和国(キンシャサ)","CF":"中央アフリカ共和国","CG":"コンゴ共和国(ブラザビル)","CH":"スイス","CI":"コートジボワール","CK":"クック諸島","CL":"チリ","CM":"カメルーン","CN":"中国","CO":"コロンビア","CR":"コスタリカ","CU":"キューバ","CV":"カーボベルデ","CW":"キュラソー","CX":"クリスマス島","CY":"キプロス","CZ":"チェコ","DE":"ドイツ","DJ":"ジブチ","DK":"デンマーク","DM":"ドミニカ国","DO":"ドミニカ共和国","DZ":"アルジェリア","EC":"エクアドル","EE":"エストニア","EG":"エジプト","EH":"西サハラ","ER":"エリトリア","ES":"スペイン","ET":"エチオピア","FI":"フィンランド","FJ":"フィジー","FK":"フォークランド諸島","FM":"ミクロネシア連邦","FO":"フェロー諸島","FR":"フランス","GA":"ガボン","GB":"イギリス","GD":"グレナダ","GE":"ジョージア","GF":"仏領ギアナ","GG":"ガーンジー","GH":"ガーナ","GI":"ジブラルタル","GL":"グリーンランド","GM":"ガンビア","GN":"ギニア","GP":"グアドループ","GQ":"赤道ギニア","GR":"ギリシャ","GS":"サウスジョージア・サウスサンドウィッチ諸島","GT":"グアテマラ","GU":"グアム","GW":"ギニアビサウ","GY":"ガイアナ","HK":"中華人民共和国香港特別行政区","HM":"ハード島・マクドナルド諸島","HN":"ホンジュラス","HR":"クロアチア","HT":"ハイチ","HU":"ハンガリー","ID":"インドネシア","IE":"アイルランド","IL":"イスラエル","IM":"マン島","IN":"インド","IO":"英領インド洋地域","IQ":"イラク","IR":"イラン","IS":"アイスランド","IT":"イタリア","JE":"ジャージー","JM":"ジャマイカ","JO":"ヨルダン","JP":"日本","KE":"ケニア","KG":"キルギス","KH":"カンボジア","KI":"キリバス","KM":"コモロ","KN":"セントクリストファー・ネーヴィス","KP":"北朝鮮","KR":"韓国","KW":"クウェート","KY":"ケイマン諸島","KZ":"カザフスタン","LA":"ラオス","LB":"レバノン","LC":"セントルシア","LI":"リヒテンシュタイン","LK":"スリランカ","LR":"リベリア","LS":"レソト","LT":"リトアニア","LU":"ルクセンブルク","LV":"ラトビア","LY":"リビア","MA":"モロッコ","MC":"モナコ","MD":"モルドバ","ME":"モンテネグロ","MF":"サン・マルタン","MG":"マダガスカル","MH":"マーシャル諸島","MK":"北マケドニア","ML":"マリ","MM":"ミャンマー (ビルマ)","MN":"モンゴル","MO":"中華人民共和国マカオ特別行政区","MP":"北マリアナ諸島","MQ":"マルティニーク","MR":"モーリタニア","MS":"モントセラト","MT":"マルタ","MU":"モーリシャス","MV":"モルディブ","MW":"マラウイ","MX":"メキシコ","MY":"マレーシア","MZ":"モザンビーク","NA":"ナミビア","NC":"ニューカレドニア","NE":"ニジェール","NF":"ノーフォーク島","NG":"ナイジェリア","NI":"ニカラグア","NL":"オランダ","NO":"ノルウェー","NP":"ネパール","NR":"ナウル","NU":"ニウエ","NZ":"ニュージーランド","OM":"オマーン","PA":"パナマ","PE":"ペルー","PF":"仏領ポリネシア","PG":"パプアニューギニア","PH":"フィリピン","PK":"パキスタン","PL":"ポーランド","PM":"サンピエール島・ミクロン島","PN":"ピトケアン諸島","PR":"プエルトリコ","PS":"パレスチナ自治区","PT":"ポルトガル","PW":"パラオ","PY":"パラグアイ","QA":"カタール","RE":"レユニオン","RO":"ルーマニア","RS":"セルビア","RU":"ロシア","RW":"ルワンダ","SA":"サウジアラビア","SB":"ソロモン諸島","SC":"セーシェル","SD":"スーダン","SE":"スウェーデン","SG":"シンガポール","SH":"セントヘレナ","SI":"スロベニア","SJ":"スバールバル諸島・ヤンマイエン島","SK":"スロバキア","SL":"シエラレオネ","SM":"サンマリノ","SN":"セネガル","SO":"ソマリア","SR":"スリナム","SS":"南スーダン","ST":"サントメ・プリンシペ","SV":"エルサルバドル","SX":"シント・マールテン","SY":"シリア","SZ":"エスワティニ","TC":"タークス・カイコス諸島","TD":"チャド","TF":"仏領極南諸島","TG":"トーゴ","TH":"タイ","TJ":"タジキスタン","TK":"トケラウ","TL":"東ティモール","TM":"トルクメニスタン","TN":"チュニジア","TO":"トンガ","TR":"トルコ","TT":"トリニダード・トバゴ","TV":"ツバル","TW":"台湾","TZ":"タンザニア","UA":"ウクライナ","UG":"ウガンダ","UM":"合衆国領有小離島","US":"アメリカ合衆国","UY":"ウルグアイ","UZ":"ウズベキスタン","VA":"バチカン市国","VC":"セントビンセント及びグレナディーン諸島","VE":"ベネズエラ","VG":"英領ヴァージン諸島","VI":"米領ヴァージン諸島","VN":"ベトナム","VU":"バヌアツ","WF":"ウォリス・フツナ","WS":"サモア","YE":"イエメン","YT":"マヨット","ZA":"南アフリカ","ZM":"ザンビア","ZW":"ジンバブエ"}},
+{"language":"ko","names":{"AD":"안도라","AE":"아랍에미리트","AF":"아프가니스탄","AG":"앤티가 바부다","AI":"앵귈라","AL":"알바니아","AM":"아르메니아","AO":"앙골라","AQ":"남극 대륙","AR":"아르헨티나","AS":"아메리칸 사모아","AT":"오스트리아","AU":"오스트레일리아","AW":"아루바","AX":"올란드 제도","AZ":"아제르바이잔","BA":"보스니아 헤르체고비나","BB":"바베이도스","BD":"방글라데시","BE":"벨기에","BF":"부르키나파소","BG":"불가리아","BH":"바레인","BI":"부룬디","BJ":"베냉","BL":"생바르텔레미","BM":"버뮤다","BN":"브루나이","BO":"볼리비아","BQ":"네덜란드령 카리브","BR":"브라질","BS":"바하마","BT":"부탄","BV":"부베섬","BW":"보츠와나","BY":"벨라루스","BZ":"벨리즈","CA":"캐나다","CC":"코코스 제도","CD":"콩고-킨샤사","CF":"중앙 아프리카 공화국","CG":"콩고-브라자빌","CH":"스위스","CI":"코트디부아르","CK":"쿡 제도","CL":"칠레","CM":"카메룬","CN":"중국","CO":"콜롬비아","CR":"코스타리카","CU":"쿠바","CV":"카보베르데","CW":"퀴라소","CX":"크리스마스섬","CY":"키프로스","CZ":"체코","DE":"독일","DJ":"지부티","DK":"덴마크","DM":"도미니카","DO":"도미니카 공화국","DZ":"알제리","EC":"에콰도르","EE":"에스토니아","EG":"이집트","EH":"서사하라","ER":"에리트리아","ES":"스페인","ET":"에티오피아","FI":"핀란드","FJ":"피지","FK":"포클랜드 제도","FM":"미크로네시아","FO":"페로 제도","FR":"프랑스","GA":"가봉","GB":"영국","GD":"그레나다","GE":"조지아","GF":"프랑스령 기아나","GG":"건지","GH":"가나","GI":"지브롤터","GL":"그린란드","GM":"감비아","GN":"기니","GP":"과들루프","GQ":"적도 기니","GR":"그리스","GS":"사우스조지아 사우스샌드위치 제도","GT":"과테말라","GU":"괌","GW":"기니비사우","GY":"가이아나","HK":"홍콩(중국 특별행정구)","HM":"허드 맥도널드 제도","HN":"온두라스","HR":"크로아티아","HT":"아이티","HU":"헝가리","ID":"인도네시아","IE":"아일랜드","IL":"이스라엘","IM":"맨섬","IN":"인도","IO":"영국령 인도양 식민지","IQ":"이라크","IR":"이란","IS":"아이슬란드","IT":"이탈리아","JE":"저지","JM":"자메이카","JO":"요르단","JP":"일본","KE":"케냐","KG":"키르기스스탄","KH":"캄보디아","KI":"키리바시","KM":"코모로","KN":"세인트키츠 네비스","KP":"북한","KR":"대한민국","KW":"쿠웨이트","KY":"케이맨 제도","KZ":"카자흐스탄","LA":"라오스","LB":"레바논","LC":"세인트루시아","LI":"리히텐슈타인","LK":"스리랑카","LR":"라이베리아","LS":"레소토","LT":"리투아니아","LU":"룩셈부르크","LV":"라트비아","LY":"리비아","MA":"모로코","MC":"모나코","MD":"몰도바","ME":"몬테네그로","MF":"생마르탱","MG":"마다가스카르","MH":"마셜 제도","MK":"북마케도니아","ML":"말리","MM":"미얀마","MN":"몽골","MO":"마카오(중국 특별행정구)","MP":"북마리아나제도","MQ":"마르티니크","MR":"모리타니","MS":"몬트세라트","MT":"몰타","MU":"모리셔스","MV":"몰디브","MW":"말라위","MX":"멕시코","MY":"말레이시아","MZ":"모잠비크","NA":"나미비아","NC":"뉴칼레도니아","NE":"니제르","NF":"노퍽섬","NG":"나이지리아","NI":"니카라과","NL":"네덜란드","NO":"노르웨이","NP":"네팔","NR":"나우루","NU":"니우에","NZ":"뉴질랜드","OM":"오만","PA":"파나마","PE":"페루","PF":"프랑스령 폴리네시아","PG":"파푸아뉴기니","PH":"필리핀","PK":"파키스탄","PL":"폴란드","PM":"생피에르 미클롱","PN":"핏케언 제도","PR":"푸에르토리코","PS":"팔레스타인 지구","PT":"포르투갈","PW":"팔라우","PY":"파라과이","QA":"카타르","RE":"레위니옹","RO":"루마니아","RS":"세르비아","RU":"러시아","RW":"르완다","SA":"사우디아라비아","SB":"솔로몬 제도","SC":"세이셸","SD":"수단","SE":"스웨덴","SG":"싱가포르","SH":"세인트헬레나","SI":"슬로베니아","SJ":"스발바르제도-얀마웬섬","SK":"슬로바키아","SL":"시에라리온","SM":"산마리노","SN":"세네갈","SO":"소말리아","SR":"수리남","SS":"남수단","ST":"상투메 프린시페","SV":"엘살바도르","SX":"신트마르턴","SY":"시리아","SZ":"에스와티니","TC":"터크스 케이커스 제도","TD":"차드","TF":"프랑스령 남방 지역","TG":"토고","TH":"태국","TJ":"타지키스탄","TK":"토켈라우","TL":"동티모르","TM":"투르크메니스탄","TN":"튀니지","TO":"통가","TR":"터키","TT":"트리니다드 토바고","TV":"투발루","TW":"대만","TZ":"탄자니아","UA":"우크라이나","UG":"우간다","UM":"미국령 해외 제도","US":"미국","UY":"우루과이","UZ":"우즈베키스탄","VA":"바티칸 시국","VC":"세인트빈센트그레나딘","VE":"베네수엘라","VG":"영국령 버진아일랜드","VI":"미국령 버진아일랜드","VN":"베트남","VU":"바누아투","WF":"왈리스-푸투나 제도","WS":"사모아","YE":"예멘","YT":"마요트","ZA":"남아프리카","ZM":"잠비아","ZW":"짐바브웨"}},
+{"language":"lt","names":{"AD":"Andora","AE":"Jungtiniai Arabų Emyratai","AF":"Afganistanas","AG":"Antigva ir Barbuda","AI":"Angilija","AL":"Albanija","AM":"Armėnija","AO":"Angola","AQ":"Antarktida","AR":"Argentina","AS":"Amerikos Samoa","AT":"Austrija","AU":"Australija","AW":"Aruba","AX":"Alandų Salos","AZ":"Azerbaidžanas","BA":"Bosnija ir Hercegovina","BB":"Barbadosas","BD":"Bangladešas","BE":"Belgija","BF":"Burkina Fasas","BG":"Bulgarija","BH":"Bahreinas","BI":"Burundis","BJ":"Beninas","BL":"Sen Bartelemi","BM":"Bermuda","BN":"Brunėjus","BO":"Bolivija","BQ":"Karibų Nyderlandai","BR":"Brazilija","BS":"Bahamos","BT":"Butanas","BV":"Buvė Sala","BW":"Botsvana","BY":"Baltarusija","BZ":"Belizas","CA":"Kanada","CC":"Kokosų (Kilingo) Salos","CD":"Kongas-Kinšasa","CF":"Centrinės Afrikos Respublika","CG":"Kongas-Brazavilis","CH":"Šveicarija","CI":"Dramblio Kaulo Krantas","CK":"Kuko Salos","CL":"Čilė","CM":"Kamerūnas","CN":"Kinija","CO":"Kolumbija","CR":"Kosta Rika","CU":"Kuba","CV":"Žaliasis Kyšulys","CW":"Kiurasao","CX":"Kalėdų Sala","CY":"Kipras","CZ":"Čekija","DE":"Vokietija","DJ":"Džibutis","DK":"Danija","DM":"Dominika","DO":"Dominikos Respublika","DZ":"Alžyras","EC":"Ekvadoras","EE":"Estija","EG":"Egiptas","EH":"Vakarų Sachara","ER":"Eritrėja","ES":"Ispanija","ET":"Etiopija","FI":"Suomija","FJ":"Fidžis","FK":"Folklando Salos","FM":"Mikronezija","FO":"Farerų Salos","FR":"Prancūzija","GA":"Gabonas","GB":"Jungtinė Karalystė","GD":"Grenada","GE":"Gruzija","GF":"Prancūzijos Gviana","GG":"Gernsis","GH":"Gana","GI":"Gibraltaras","GL":"Grenlandija","GM":"Gambija","GN":"Gvinėja","GP":"Gvadelupa","GQ":"Pusiaujo Gvinėja","GR":"Graikija","GS":"Pietų Džordžija ir Pietų Sandvičo salos","GT":"Gvatemala","GU":"Guamas","GW":"Bisau Gvinėja","GY":"Gajana","HK":"Ypatingasis Administracinis Kinijos Regionas Honkongas","HM":"Herdo ir Makdonaldo Salos","HN":"Hondūras","HR":"Kroatija","HT":"Haitis","HU":"Vengrija","ID":"Indonezija","IE":"Airija","IL":"Izraelis","IM":"Meno Sala","IN":"Indija","IO":"Indijos Vandenyno Britų Sritis","IQ":"Irakas","IR":"Iranas","IS":"Islandija","IT":"Italija","JE":"Džersis","JM":"Jamaika","JO":"Jordanija","JP":"Japonija","KE":"Kenija","KG":"Kirgizija","KH":"Kambodža","KI":"Kiribatis","KM":"Komorai","KN":"Sent Kitsas ir Nevis","KP":"Šiaurės Korėja","KR":"Pietų Korėja","KW":"Kuveitas","KY":"Kaimanų Salos","KZ":"Kazachstanas","LA":"Laosas","LB":"Libanas","LC":"Sent Lusija","LI":"Lichtenšteinas","LK":"Šri Lanka","LR":"Liberija","LS":"Lesotas","LT":"Lietuva","LU":"Liuksemburgas","LV":"Latvija","LY":"Libija","MA":"Marokas","MC":"Monakas","MD":"Moldova","ME":"Juodkalnija","MF":"Sen Martenas","MG":"Madagaskaras","MH":"Maršalo Salos","MK":"Šiaurės Makedonija","ML":"Malis","MM":"Mianmaras (Birma)","MN":"Mongolija","MO":"Ypatingasis Administracinis Kinijos Regionas Makao","MP":"Marianos Šiaurinės Salos","MQ":"Martinika","MR":"Mauritanija","MS":"Montseratas","MT":"Malta","MU":"Mauricijus","MV":"Maldyvai","MW":"Malavis","MX":"Meksika","MY":"Malaizija","MZ":"Mozambikas","NA":"Namibija","NC":"Naujoji Kaledonija","NE":"Nigeris","NF":"Norfolko sala","NG":"Nigerija","NI":"Nikaragva","NL":"Nyderlandai","NO":"Norvegija","NP":"Nepalas","NR":"Nauru","NU":"Niujė","NZ":"Naujoji Zelandija","OM":"Omanas","PA":"Panama","PE":"Peru","PF":"Prancūzijos Polinezija","PG":"Papua Naujoji Gvinėja","PH":"Filipinai","PK":"Pakistanas","PL":"Lenkija","PM":"Sen Pjeras ir Mikelonas","PN":"Pitkerno salos","PR":"Puerto Rikas","PS":"Palestinos teritorija","PT":"Portugalija","PW":"Palau","PY":"Paragvajus","QA":"Kataras","RE":"Reunjonas","RO":"Rumunija","RS":"Serbija","RU":"Rusija","RW":"Ruanda","SA":"Saudo Arabija","SB":"Saliamono Salos","SC":"Seišeliai","SD":"Sudanas","SE":"Švedija","SG":"Singapūras","SH":"Šv. Elenos Sala","SI":"Slovėnija","SJ":"Svalbardas ir Janas Majenas","SK":"Slovakija","SL":"Siera Leonė","SM":"San Marinas","SN":"Senegalas","SO":"Somalis","SR":"Surinamas","SS":"Pietų Sudanas","ST":"San Tomė ir Prinsipė","SV":"Salvadoras","SX":"Sint Martenas","SY":"Sirija","SZ":"Svazilandas","TC":"Terkso ir Kaikoso Salos","TD":"Čadas","TF":"Prancūzijos Pietų sritys","TG":"Togas","TH":"Tailandas","TJ":"Tadžikija","TK":"Tokelau","TL":"Rytų Timoras","TM":"Turkmėnistanas","TN":"Tunisas","TO":"Tonga","TR":"Turkija","TT":"Trinidadas ir Tobagas","TV":"Tuvalu","TW":"Taivanas","TZ":"Tanzanija","UA":"Ukraina","UG":"Uganda","UM":"Jungtinių Valstijų Mažosios Tolimosios Salos","US":"Jungtinės Valstijos","UY":"Urugvajus","UZ":"Uzbekistanas","VA":"Vatikano Miesto Valstybė","VC":"Šventasis Vincentas ir Grenadinai","VE":"Venesuela","VG":"Didžiosios Britanijos Mergelių Salos","VI":"Jungtinių Valstijų Mergelių Salos","VN":"Vietnamas","VU":"Vanuatu","WF":"Volisas ir Futūna","WS":"Samoa","YE":"Jemenas","YT":"Majotas","ZA":"Pietų Afrika","ZM":"Zambija","ZW":"Zimbabvė"}},
+{"language":"lv","names":{"AD":"Andora","AE":"Apvienotie Arābu Emirāti","AF":"Afganistāna","AG":"Antigva un Barbuda","AI":"Angilja","AL":"Albānija","AM":"Armēnija","AO":"Angola","AQ":"Antarktika","AR":"Argentīna","AS":"ASV Samoa","AT":"Austrija","AU":"Austrālija","AW":"Aruba","AX":"Olandes salas","AZ":"Azerbaidžāna","BA":"Bosnija un Hercegovina","BB":"Barbadosa","BD":"Bangladeša","BE":"Beļģija","BF":"Burkinafaso","BG":"Bulgārija","BH":"Bahreina","BI":"Burundija","BJ":"Benina","BL":"Senbartelmī","BM":"Bermudu salas","BN":"Bruneja","BO":"Bolīvija","BQ":"Nīderlandes Karību salas","BR":"Brazīlija","BS":"Bahamu salas","BT":"Butāna","BV":"Buvē sala","BW":"Botsvāna","BY":"Baltkrievija","BZ":"Beliza","CA":"Kanāda","CC":"Kokosu (Kīlinga) salas","CD":"Kongo (Kinšasa)","CF":"Centrālāfrikas Republika","CG":"Kongo (Brazavila)","CH":"Šveice","CI":"Kotdivuāra","CK":"Kuka salas","CL":"Čīle","CM":"Kamerūna","CN":"Ķīna","CO":"Kolumbija","CR":"Kostarika","CU":"Kuba","CV":"Kaboverde","CW":"Kirasao","CX":"Ziemsvētku sala","CY":"Kipra","CZ":"Čehija","DE":"Vācija","DJ":"Džibutija","DK":"Dānija","DM":"Dominika","DO":"Dominikāna","DZ":"Alžīrija","EC":"Ekvadora","EE":"Igaunija","EG":"Ēģipte","EH":"Rietumsahāra","ER":"Eritreja","ES":"Spānija","ET":"Etiopija","FI":"Somija","FJ":"Fidži","FK":"Folklenda salas","FM":"Mikronēzija","FO":"Fēru salas","FR":"Francija","GA":"Gabona","GB":"Apvienotā Karaliste","GD":"Grenāda","GE":"Gruzija","GF":"Francijas Gviāna","GG":"Gērnsija","GH":"Gana","GI":"Gibraltārs","GL":"Grenlande","GM":"Gambija","GN":"Gvineja","GP":"Gvadelupa","GQ":"Ekvatoriālā Gvineja","GR":"Grieķija","GS":"Dienviddžordžija un Dienvidsendviču salas","GT":"Gvatemala","GU":"Guama","GW":"Gvineja-Bisava","GY":"Gajāna","HK":"Ķīnas īpašās pārvaldes apgabals Honkonga","HM":"Hērda sala un Makdonalda salas","HN":"Hondurasa","HR":"Horvātija","HT":"Haiti","HU":"Ungārija","ID":"Indonēzija","IE":"Īrija","IL":"Izraēla","IM":"Menas sala","IN":"Indija","IO":"Indijas okeāna Britu teritorija","IQ":"Irāka","IR":"Irāna","IS":"Islande","IT":"Itālija","JE":"Džērsija","JM":"Jamaika","JO":"Jordānija","JP":"Japāna","KE":"Kenija","KG":"Kirgizstāna","KH":"Kambodža","KI":"Kiribati","KM":"Komoru salas","KN":"Sentkitsa un Nevisa","KP":"Ziemeļkoreja","KR":"Dienvidkoreja","KW":"Kuveita","KY":"Kaimanu salas","KZ":"Kazahstāna","LA":"Laosa","LB":"Libāna","LC":"Sentlūsija","LI":"Lihtenšteina","LK":"Šrilanka","LR":"Libērija","LS":"Lesoto","LT":"Lietuva","LU":"Luksemburga","LV":"Latvija","LY":"Lībija","MA":"Maroka","MC":"Monako","MD":"Moldova","ME":"Melnkalne","MF":"Senmartēna","MG":"Madagaskara","MH":"Māršala salas","MK":"Ziemeļmaķedonija","ML":"Mali","MM":"Mjanma (Birma)","MN":"Mongolija","MO":"ĶTR īpašais administratīvais reģions Makao","MP":"Ziemeļu Marianas salas","MQ":"Martinika","MR":"Mauritānija","MS":"Montserrata","MT":"Malta","MU":"Maurīcija","MV":"Maldīvija","MW":"Malāvija","MX":"Meksika","MY":"Malaizija","MZ":"Mozambika","NA":"Namībija","NC":"Jaunkaledonija","NE":"Nigēra","NF":"Norfolkas sala","NG":"Nigērija","NI":"Nikaragva","NL":"Nīderlande","NO":"Norvēģija","NP":"Nepāla","NR":"Nauru","NU":"Niue","NZ":"Jaunzēlande","OM":"Omāna","PA":"Panama","PE":"Peru","PF":"Francijas Polinēzija","PG":"Papua-Jaungvineja","PH":"Filipīnas","PK":"Pakistāna","PL":"Polija","PM":"Senpjēra un Mikelona","PN":"Pitkērnas salas","PR":"Puertoriko","PS":"Palestīnas teritorijas","PT":"Portugāle","PW":"Palau","PY":"Paragvaja","QA":"Katara","RE":"Reinjona","RO":"Rumānija","RS":"Serbija","RU":"Krievija","RW":"Ruanda","SA":"Saūda Arābija","SB":"Zālamana salas","SC":"Seišelu salas","SD":"Sudāna","SE":"Zviedrija","SG":"Singapūra","SH":"Sv.Helēnas sala","SI":"Slovēnija","SJ":"Svalbāra un Jana Majena sala","SK":"Slovākija","SL":"Sjerraleone","SM":"Sanmarīno","SN":"Senegāla","SO":"Somālija","SR":"Surinama","SS":"Dienvidsudāna","ST":"Santome un Prinsipi","SV":"Salvadora","SX":"Sintmārtena","SY":"Sīrija","SZ":"Svatini","TC":"Tērksas un Kaikosas salas","TD":"Čada","TF":"Francijas Dienvidjūru teritorija","TG":"Togo","TH":"Taizeme","TJ":"Tadžikistāna","TK":"Tokelau","TL":"Austrumtimora","TM":"Turkmenistāna","TN":"Tunisija","TO":"Tonga","TR":"Turcija","TT":"Trinidāda un Tobāgo","TV":"Tuvalu","TW":"Taivāna","TZ":"Tanzānija","UA":"Ukraina","UG":"Uganda","UM":"ASV Mazās Aizjūras salas","US":"Amerikas Savienotās Valstis","UY":"Urugvaja","UZ":"Uzbekistāna","VA":"Vatikāns","VC":"Sentvinsenta un Grenadīnas","VE":"Venecuēla","VG":"Britu Virdžīnas","VI":"ASV Virdžīnas","VN":"Vjetnama","VU":"Vanuatu","WF":"Volisa un Futunas salas","WS":"Samoa","YE":"Jemena","YT":"Majota","ZA":"Dienvidāfrikas Republika","ZM":"Zambija","ZW":"Zimbabve"}},
+{"language":"ms","names":{"AD":"Andorra","AE":"Emiriah Arab Bersatu","AF":"Afghanistan","AG":"Antigua dan Barbuda","AI":"Anguilla","AL":"Albania","AM":"Armenia","AO":"Angola","AQ":"Antartika","AR":"Argentina","AS":"Samoa Amerika","AT":"Austria","AU":"Australia","AW":"Aruba","AX":"Kepulauan Aland","AZ":"Azerbaijan","BA":"Bosnia dan Herzegovina","BB":"Barbados","BD":"Bangladesh","BE":"Belgium","BF":"Burkina Faso","BG":"Bulgaria","BH":"Bahrain","BI":"Burundi","BJ":"Benin","BL":"St. Barthelemy","BM":"Bermuda","BN":"Brunei","BO":"Bolivia","BQ":"Belanda Caribbean","BR":"Brazil","BS":"Bahamas","BT":"Bhutan","BV":"Pulau Bouvet","BW":"Botswana","BY":"Belarus","BZ":"Belize","CA":"Kanada","CC":"Kepulauan Cocos (Keeling)","CD":"Congo - Kinshasa","CF":"Republik Afrika Tengah","CG":"Congo - Brazzaville","CH":"Switzerland","CI":"Cote d’Ivoire","CK":"Kepulauan Cook","CL":"Chile","CM":"Cameroon","CN":"China","CO":"Colombia","CR":"Costa Rica","CU":"Cuba","CV":"Cape Verde","CW":"Curacao","CX":"Pulau Krismas","CY":"Cyprus","CZ":"Czechia","DE":"Jerman","DJ":"Djibouti","DK":"Denmark","DM":"Dominica","DO":"Republik Dominica","DZ":"Algeria","EC":"Ecuador","EE":"Estonia","EG":"Mesir","EH":"Sahara Barat","ER":"Eritrea","ES":"Sepanyol","ET":"Ethiopia","FI":"Finland","FJ":"Fiji","FK":"Kepulauan Falkland","FM":"Micronesia","FO":"Kepulauan Faroe","FR":"Perancis","GA":"Gabon","GB":"United Kingdom","GD":"Grenada","GE":"Georgia","GF":"Guiana Perancis","GG":"Guernsey","GH":"Ghana","GI":"Gibraltar","GL":"Greenland","GM":"Gambia","GN":"Guinea","GP":"Guadeloupe","GQ":"Guinea Khatulistiwa","GR":"Greece","GS":"Kepulauan Georgia Selatan & Sandwich Selatan","GT":"Guatemala","GU":"Guam","GW":"Guinea Bissau","GY":"Guyana","HK":"Hong Kong SAR China","HM":"Kepulauan Heard & McDonald","HN":"Honduras","HR":"Croatia","HT":"Haiti","HU":"Hungary","ID":"Indonesia","IE":"Ireland","IL":"Israel","IM":"Isle of Man","IN":"India","IO":"Wilayah Lautan Hindi British","IQ":"Iraq","IR":"Iran","IS":"Iceland","IT":"Itali","JE":"Jersey","JM":"Jamaica","JO":"Jordan","JP":"Jepun","KE":"Kenya","KG":"Kyrgyzstan","KH":"Kemboja","KI":"Kiribati","KM":"Comoros","KN":"Saint Kitts dan Nevis","KP":"Korea Utara","KR":"Korea Selatan","KW":"Kuwait","KY":"Kepulauan Cayman","KZ":"Kazakhstan","LA":"Laos","LB":"Lubnan","LC":"Saint Lucia","LI":"Liechtenstein","LK":"Sri Lanka","LR":"Liberia","LS":"Lesotho","LT":"Lithuania","LU":"Luxembourg","LV":"Latvia","LY":"Libya","MA":"Maghribi","MC":"Monaco","MD":"Moldova","ME":"Montenegro","MF":"Saint Martin","MG":"Madagaskar","MH":"Kepulauan Marshall","MK":"Macedonia Utara","ML":"Mali","MM":"Myanmar (Burma)","MN":"Mongolia","MO":"Macau SAR China","MP":"Kepulauan Mariana Utara","MQ":"Martinique","MR":"Mauritania","MS":"Montserrat","MT":"Malta","MU":"Mauritius","MV":"Maldives","MW":"Malawi","MX":"Mexico","MY":"Malaysia","MZ":"Mozambique","NA":"Namibia","NC":"New Caledonia","NE":"Niger","NF":"Pulau Norfolk","NG":"Nigeria","NI":"Nicaragua","NL":"Belanda","NO":"Norway","NP":"Nepal","NR":"Nauru","NU":"Niue","NZ":"New Zealand","OM":"Oman","PA":"Panama","PE":"Peru","PF":"Polinesia Perancis","PG":"Papua New Guinea","PH":"Filipina","PK":"Pakistan","PL":"Poland","PM":"Saint Pierre dan Miquelon","PN":"Kepulauan Pitcairn","PR":"Puerto Rico","PS":"Wilayah Palestin","PT":"Portugal","PW":"Palau","PY":"Paraguay","QA":"Qatar","RE":"Reunion","RO":"Romania","RS":"Serbia","RU":"Rusia","RW":"Rwanda","SA":"Arab Saudi","SB":"Kepulauan Solomon","SC":"Seychelles","SD":"Sudan","SE":"Sweden","SG":"Singapura","SH":"Saint Helena","SI":"Slovenia","SJ":"Svalbard dan Jan Mayen","SK":"Slovakia","SL":"Sierra Leone","SM":"San Marino","SN":"Senegal","SO":"Somalia","SR":"Surinam","SS":"Sudan Selatan","ST":"Sao Tome dan Principe","SV":"El Salvador","SX":"Sint Maarten","SY":"Syria","SZ":"Eswatini","TC":"Kepulauan Turks dan Caicos","TD":"Chad","TF":"Wilayah Selatan Perancis","TG":"Togo","TH":"Thailand","TJ":"Tajikistan","TK":"Tokelau","TL":"Timor-Leste","TM":"Turkmenistan","TN":"Tunisia","TO":"Tonga","TR":"Turki","TT":"Trinidad dan Tobago","TV":"Tuvalu","TW":"Taiwan","TZ":"Tanzania","UA":"Ukraine","UG":"Uganda","UM":"Kepulauan Terpencil A.S.","US":"Amerika Syarikat","UY":"Uruguay","UZ":"Uzbekistan","VA":"Kota Vatican","VC":"Saint Vincent dan Grenadines","VE":"Venezuela","VG":"Kepulauan Virgin British","VI":"Kepulauan Virgin A.S.","VN":"Vietnam","VU":"Vanuatu","WF":"Wallis dan Futuna","WS":"Samoa","YE":"Yaman","YT":"Mayotte","ZA":"Afrika Selatan","ZM":"Zambia","ZW":"Zimbabwe"}},
+{"language":"mt","names":{"AD":"Andorra","AE":"l-Emirati Għarab Magħquda","AF":"l-Afganistan","AG":"Antigua u Barbuda","AI":"Anguilla","AL":"l-Albanija","AM":"l-Armenja","AO":"l-Angola","AQ":"l-Antartika","AR":"l-Arġentina","AS":"is-Samoa Amerikana","AT":"l-Awstrija","AU":"l-Awstralja","AW":"Aruba","AX":"il-Gżejjer Aland","AZ":"l-Ażerbajġan","BA":"il-Bożnija-Ħerzegovina","BB":"Barbados","BD":"il-Bangladesh","BE":"il-Belġju","BF":"il-Burkina Faso","BG":"il-Bulgarija","BH":"il-Bahrain","BI":"il-Burundi","BJ":"il-Benin","BL":"Saint Barthélemy","BM":"Bermuda","BN":"il-Brunei","BO":"il-Bolivja","BQ":"in-Netherlands tal-Karibew","BR":"Il-Brażil","BS":"il-Bahamas","BT":"il-Bhutan","BV":"Gżira Bouvet","BW":"il-Botswana","BY":"il-Belarussja","BZ":"il-Belize","CA":"il-Kanada","CC":"Gżejjer Cocos (Keeling)","CD":"ir-Repubblika Demokratika tal-Kongo","CF":"ir-Repubblika Ċentru-Afrikana","CG":"il-Kongo - Brazzaville","CH":"l-Iżvizzera","CI":"il-Kosta tal-Avorju","CK":"Gżejjer Cook","CL":"iċ-Ċili","CM":"il-Kamerun","CN":"iċ-Ċina","CO":"il-Kolombja","CR":"il-Costa Rica","CU":"Kuba","CV":"Cape Verde","CW":"Curaçao","CX":"il-Gżira Christmas","CY":"Ċipru","CZ":"ir-Repubblika Ċeka","DE":"il-Ġermanja","DJ":"il-Djibouti","DK":"id-Danimarka","DM":"Dominica","DO":"ir-Repubblika Dominicana","DZ":"l-Alġerija","EC":"l-Ekwador","EE":"l-Estonja","EG":"l-Eġittu","EH":"is-Saħara tal-Punent","ER":"l-Eritrea","ES":"Spanja","ET":"l-Etjopja","FI":"il-Finlandja","FJ":"Fiġi","FK":"il-Gżejjer Falkland","FM":"il-Mikroneżja","FO":"il-Gżejjer Faeroe","FR":"Franza","GA":"il-Gabon","GB":"ir-Renju Unit","GD":"Grenada","GE":"il-Georgia","GF":"il-Guyana Franċiża","GG":"Guernsey","GH":"il-Ghana","GI":"Ġibiltà","GL":"Greenland","GM":"il-Gambja","GN":"il-Guinea","GP":"Guadeloupe","GQ":"il-Guinea Ekwatorjali","GR":"il-Greċja","GS":"il-Georgia tan-Nofsinhar u l-Gżejjer Sandwich tan-Nofsinhar","GT":"il-Gwatemala","GU":"Guam","GW":"il-Guinea-Bissau","GY":"il-Guyana","HK":"ir-Reġjun Amministrattiv Speċjali ta’ Hong Kong tar-Repubblika tal-Poplu taċ-Ċina","HM":"il-Gżejjer Heard u l-Gżejjer McDonald","HN":"il-Honduras","HR":"il-Kroazja","HT":"il-Haiti","HU":"l-Ungerija","ID":"l-Indoneżja","IE":"l-Irlanda","IL":"Iżrael","IM":"Isle of Man","IN":"l-Indja","IO":"Territorju Brittaniku tal-Oċean Indjan","IQ":"l-Iraq","IR":"l-Iran","IS":"l-Iżlanda","IT":"l-Italja","JE":"Jersey","JM":"il-Ġamajka","JO":"il-Ġordan","JP":"il-Ġappun","KE":"il-Kenja","KG":"il-Kirgiżistan","KH":"il-Kambodja","KI":"Kiribati","KM":"Comoros","KN":"Saint Kitts u Nevis","KP":"il-Korea ta’ Fuq","KR":"il-Korea t’Isfel","KW":"il-Kuwajt","KY":"il-Gżejjer Cayman","KZ":"il-Każakistan","LA":"il-Laos","LB":"il-Libanu","LC":"Saint Lucia","LI":"il-Liechtenstein","LK":"is-Sri Lanka","LR":"il-Liberja","LS":"il-Lesoto","LT":"il-Litwanja","LU":"il-Lussemburgu","LV":"il-Latvja","LY":"il-Libja","MA":"il-Marokk","MC":"Monaco","MD":"il-Moldova","ME":"il-Montenegro","MF":"Saint Martin","MG":"Madagascar","MH":"Gżejjer Marshall","MK":"il-Maċedonja ta’ Fuq","ML":"il-Mali","MM":"il-Myanmar/Burma","MN":"il-Mongolja","MO":"ir-Reġjun Amministrattiv Speċjali tal-Macao tar-Repubblika tal-Poplu taċ-Ċina","MP":"Ġżejjer Mariana tat-Tramuntana","MQ":"Martinique","MR":"il-Mauritania","MS":"Montserrat","MT":"Malta","MU":"Mauritius","MV":"il-Maldivi","MW":"il-Malawi","MX":"il-Messiku","MY":"il-Malasja","MZ":"il-Mozambique","NA":"in-Namibja","NC":"New Caledonia","NE":"in-Niġer","NF":"Gżira Norfolk","NG":"in-Niġerja","NI":"in-Nikaragwa","NL":"in-Netherlands","NO":"in-Norveġja","NP":"in-Nepal","NR":"Nauru","NU":"Niue","NZ":"New Zealand","OM":"l-Oman","PA":"il-Panama","PE":"il-Perù","PF":"Polineżja Franċiża","PG":"Papua New Guinea","PH":"il-Filippini","PK":"il-Pakistan","PL":"il-Polonja","PM":"Saint Pierre u Miquelon","PN":"Gżejjer Pitcairn","PR":"Puerto Rico","PS":"it-Territorji Palestinjani","PT":"il-Portugall","PW":"Palau","PY":"il-Paragwaj","QA":"il-Qatar","RE":"Réunion","RO":"ir-Rumanija","RS":"is-Serbja","RU":"ir-Russja","RW":"ir-Rwanda","SA":"l-Arabja Sawdija","SB":"il-Gżejjer Solomon","SC":"is-Seychelles","SD":"is-Sudan","SE":"l-Iżvezja","SG":"Singapore","SH":"Saint Helena","SI":"is-Slovenja","SJ":"Svalbard u Jan Mayen","SK":"is-Slovakkja","SL":"Sierra Leone","SM":"San Marino","SN":"is-Senegal","SO":"is-Somalja","SR":"is-Suriname","SS":"is-Sudan t’Isfel","ST":"São Tomé u Príncipe","SV":"El Salvador","SX":"Sint Maarten","SY":"is-Sirja","SZ":"l-Eswatini","TC":"il-Gżejjer Turks u Caicos","TD":"iċ-Chad","TF":"It-Territorji Franċiżi tan-Nofsinhar","TG":"it-Togo","TH":"it-Tajlandja","TJ":"it-Taġikistan","TK":"it-Tokelau","TL":"Timor Leste","TM":"it-Turkmenistan","TN":"it-Tuneżija","TO":"Tonga","TR":"it-Turkija","TT":"Trinidad u Tobago","TV":"Tuvalu","TW":"it-Tajwan","TZ":"it-Tanzanija","UA":"l-Ukrajna","UG":"l-Uganda","UM":"Il-Gżejjer Minuri Mbiegħda tal-Istati Uniti","US":"l-Istati Uniti","UY":"l-Urugwaj","UZ":"l-Użbekistan","VA":"l-Istat tal-Belt tal-Vatikan","VC":"Saint Vincent u l-Grenadini","VE":"il-Venezwela","VG":"il-Gżejjer Verġni Brittaniċi","VI":"il-Gżejjer Verġni tal-Istati Uniti","VN":"il-Vjetnam","VU":"Vanuatu","WF":"Wallis u Futuna","WS":"Samoa","YE":"il-Jemen","YT":"Mayotte","ZA":"l-Afrika t’Isfel","ZM":"iż-Żambja","ZW":"iż-Żimbabwe"}},
+{"language":"nb","names":{"AD":"Andorra","AE":"De forente arabiske emirater","AF":"Afghanistan","AG":"Antigua og Barbuda","AI":"Anguilla","AL":"Albania","AM":"Armenia","AO":"Angola","AQ":"Antarktis","AR":"Argentina","AS":"Amerikansk Samoa","AT":"Østerrike","AU":"Australia","AW":"Aruba","AX":"Åland","AZ":"Aserbajdsjan","BA":"Bosnia-Hercegovina","BB":"Barbados","BD":"Bangladesh","BE":"Belgia","BF":"Burkina Faso","BG":"Bulgaria","BH":"Bahrain","BI":"Burundi","BJ":"Benin","BL":"Saint-Barthélemy","BM":"Bermuda","BN":"Brunei","BO":"Bolivia","BQ":"Karibisk Nederland","BR":"Brasil","BS":"Bahamas","BT":"Bhutan","BV":"Bouvetøya","BW":"Botswana","BY":"Hviterussland","BZ":"Belize","CA":"Canada","CC":"Kokosøyene","CD":"Kongo","CF":"Den sentralafrikanske republikk","CG":"Kongo-Brazzaville","CH":"Sveits","CI":"Elfenbenskysten","CK":"Cookøyene","CL":"Chile","CM":"Kamerun","CN":"Kina","CO":"Colombia","CR":"Costa Rica","CU":"Cuba","CV":"Kapp Verde","CW":"Curaçao","CX":"Christmasøya","CY":"Kypros","CZ":"Tsjekkia","DE":"Tyskland","DJ":"Djibouti","DK":"Danmark","DM":"Dominica","DO":"Den dominikanske republikk","DZ":"Algerie","EC":"Ecuador","EE":"Estland","EG":"Egypt","EH":"Vest-Sahara","ER":"Eritrea","ES":"Spania","ET":"Etiopia","FI":"Finland","FJ":"Fiji","FK":"Falklandsøyene","FM":"Mikronesiaføderasjonen","FO":"Færøyene","FR":"Frankrike","GA":"Gabon","GB":"Storbritannia","GD":"Grenada","GE":"Georgia","GF":"Fransk Guyana","GG":"Guernsey","GH":"Ghana","GI":"Gibraltar","GL":"Grønland","GM":"Gambia","GN":"Guinea","GP":"Guadeloupe","GQ":"Ekvatorial-Guinea","GR":"Hellas","GS":"Sør-Georgia og Sør-Sandwichøyene","GT":"Guatemala","GU":"Guam","GW":"Guinea-Bissau","GY":"Guyana","HK":"Hongkong SAR Kina","HM":"Heard- og McDonaldøyene","HN":"Honduras","HR":"Kroatia","HT":"Haiti","HU":"Ungarn","ID":"Indonesia","IE":"Irland","IL":"Israel","IM":"Man","IN":"India","IO":"Det britiske territoriet i Indiahavet","IQ":"Irak","IR":"Iran","IS":"Island","IT":"Italia","JE":"Jersey","JM":"Jamaica","JO":"Jordan","JP":"Japan","KE":"Kenya","KG":"Kirgisistan","KH":"Kambodsja","KI":"Kiribati","KM":"Komorene","KN":"Saint Kitts og Nevis","KP":"Nord-Korea","KR":"Sør-Korea","KW":"Kuwait","KY":"Caymanøyene","KZ":"Kasakhstan","LA":"Laos","LB":"Libanon","LC":"St. Lucia","LI":"Liechtenstein","LK":"Sri Lanka","LR":"Liberia","LS":"Lesotho","LT":"Litauen","LU":"Luxemburg","LV":"Latvia","LY":"Libya","MA":"Marokko","MC":"Monaco","MD":"Moldova","ME":"Montenegro","MF":"Saint-Martin","MG":"Madagaskar","MH":"Marshalløyene","MK":"Nord-Makedonia","ML":"Mali","MM":"Myanmar (Burma)","MN":"Mongolia","MO":"Macao SAR Kina","MP":"Nord-Marianene","MQ":"Martinique","MR":"Mauritania","MS":"Montserrat","MT":"Malta","MU":"Mauritius","MV":"Maldivene","MW":"Malawi","MX":"Mexico","MY":"Malaysia","MZ":"Mosambik","NA":"Namibia","NC":"Ny-Caledonia","NE":"Niger","NF":"Norfolkøya","NG":"Nigeria","NI":"Nicaragua","NL":"Nederland","NO":"Norge","NP":"Nepal","NR":"Nauru","NU":"Niue","NZ":"New Zealand","OM":"Oman","PA":"Panama","PE":"Peru","PF":"Fransk Polynesia","PG":"Papua Ny-Guinea","PH":"Filippinene","PK":"Pakistan","PL":"Polen","PM":"Saint-Pierre-et-Miquelon","PN":"Pitcairnøyene","PR":"Puerto Rico","PS":"Det palestinske området","PT":"Portugal","PW":"Palau","PY":"Paraguay","QA":"Qatar","RE":"Réunion","RO":"Romania","RS":"Serbia","RU":"Russland","RW":"Rwanda","SA":"Saudi-Arabia","SB":"Salomonøyene","SC":"Seychellene","SD":"Sudan","SE":"Sverige","SG":"Singapore","SH":"St. Helena","SI":"Slovenia","SJ":"Svalbard og Jan Mayen","SK":"Slovakia","SL":"Sierra Leone","SM":"San Marino","SN":"Senegal","SO":"Somalia","SR":"Surinam","SS":"Sør-Sudan","ST":"São Tomé og Príncipe","SV":"El Salvador","SX":"Sint Maarten","SY":"Syria","SZ":"Eswatini","TC":"Turks- og Caicosøyene","TD":"Tsjad","TF":"De franske sørterritorier","TG":"Togo","TH":"Thailand","TJ":"Tadsjikistan","TK":"Tokelau","TL":"Øst-Timor","TM":"Turkmenistan","TN":"Tunisia","TO":"Tonga","TR":"Tyrkia","TT":"Trinidad og Tobago","TV":"Tuvalu","TW":"Taiwan","TZ":"Tanzania","UA":"Ukraina","UG":"Uganda","UM":"USAs ytre øyer","US":"USA","UY":"Uruguay","UZ":"Usbekistan","VA":"Vatikanstaten","VC":"St. Vincent og Grenadinene","VE":"Venezuela","VG":"De britiske jomfruøyene","VI":"De amerikanske jomfruøyene","VN":"Vietnam","VU":"Vanuatu","WF":"Wallis og Futuna","WS":"Samoa","YE":"Jemen","YT":"Mayotte","ZA":"Sør-Afrika","ZM":"Zambia","ZW":"Zimbabwe"}},
+{"language":"nl","names":{"AD":"Andorra","AE":"Verenigde Arabische Emiraten","AF":"Afghanistan","AG":"Antigua en Barbuda","AI":"Anguilla","AL":"Albanië","AM":"Armenië","AO":"Angola","AQ":"Antarctica","AR":"Argentinië","AS":"Amerikaans-Samoa","AT":"Oostenrijk","AU":"Australië","AW":"Aruba","AX":"Åland","AZ":"Azerbeidzjan","BA":"Bosnië en Herzegovina","BB":"Barbados","BD":"Bangladesh","BE":"België","BF":"Burkina Faso","BG":"Bulgarije","BH":"Bahrein","BI":"Burundi","BJ":"Benin","BL":"Saint-Barthélemy","BM":"Bermuda","BN":"Brunei","BO":"Bolivia","BQ":"Caribisch Nederland","BR":"Brazilië","BS":"Bahama’s","BT":"Bhutan","BV":"Bouveteiland","BW":"Botswana","BY":"Belarus","BZ":"Belize","CA":"Canada","CC":"Cocoseilanden","CD":"Congo-Kinshasa","CF":"Centraal-Afrikaanse Republiek","CG":"Congo-Brazzaville","CH":"Zwitserland","CI":"Ivoorkust","CK":"Cookeilanden","CL":"Chili","CM":"Kameroen","CN":"China","CO":"Colombia","CR":"Costa Rica","CU":"Cuba","CV":"Kaapverdië","CW":"Curaçao","CX":"Christmaseiland","CY":"Cyprus","CZ":"Tsjechië","DE":"Duitsland","DJ":"Djibouti","DK":"Denemarken","DM":"Dominica","DO":"Dominicaanse Republiek","DZ":"Algerije","EC":"Ecuador","EE":"Estland","EG":"Egypte","EH":"Westelijke Sahara","ER":"Eritrea","ES":"Spanje","ET":"Ethiopië","FI":"Finland","FJ":"Fiji","FK":"Falklandeilanden","FM":"Micronesia","FO":"Faeröer","FR":"Frankrijk","GA":"Gabon","GB":"Verenigd Koninkrijk","GD":"Grenada","GE":"Georgië","GF":"Frans-Guyana","GG":"Guernsey","GH":"Ghana","GI":"Gibraltar","GL":"Groenland","GM":"Gambia","GN":"Guinee","GP":"Guadeloupe","GQ":"Equatoriaal-Guinea","GR":"Griekenland","GS":"Zuid-Georgia en Zuidelijke Sandwicheilanden","GT":"Guatemala","GU":"Guam","GW":"Guinee-Bissau","GY":"Guyana","HK":"Hongkong SAR van China","HM":"Heard en McDonaldeilanden","HN":"Honduras","HR":"Kroatië","HT":"Haïti","HU":"Hongarije","ID":"Indonesië","IE":"Ierland","IL":"Israël","IM":"Isle of Man","IN":"India","IO":"Brits Indische Oceaanterritorium","IQ":"Irak","IR":"Iran","IS":"IJsland","IT":"Italië","JE":"Jersey","JM":"Jamaica","JO":"Jordanië","JP":"Japan","KE":"Kenia","KG":"Kirgizië","KH":"Cambodja","KI":"Kiribati","KM":"Comoren","KN":"Saint Kitts en Nevis","KP":"Noord-Korea","KR":"Zuid-Korea","KW":"Koeweit","KY":"Kaaimaneilanden","KZ":"Kazachstan","LA":"Laos","LB":"Libanon","LC":"Saint Lucia","LI":"Liechtenstein","LK":"Sri Lanka","LR":"Liberia","LS":"Lesotho","LT":"Litouwen","LU":"Luxemburg","LV":"Letland","LY":"Libië","MA":"Marokko","MC":"Monaco","MD":"Moldavië","ME":"Montenegro","MF":"Saint-Martin","MG":"Madagaskar","MH":"Marshalleilanden","MK":"Noord-Macedonië","ML":"Mali","MM":"Myanmar (Birma)","MN":"Mongolië","MO":"Macau SAR van China","MP":"Noordelijke Marianen","MQ":"Martinique","MR":"Mauritanië","MS":"Montserrat","MT":"Malta","MU":"Mauritius","MV":"Maldiven","MW":"Malawi","MX":"Mexico","MY":"Maleisië","MZ":"Mozambique","NA":"Namibië","NC":"Nieuw-Caledonië","NE":"Niger","NF":"Norfolk","NG":"Nigeria","NI":"Nicaragua","NL":"Nederland","NO":"Noorwegen","NP":"Nepal","NR":"Nauru","NU":"Niue","NZ":"Nieuw-Zeeland","OM":"Oman","PA":"Panama","PE":"Peru","PF":"Frans-Polynesië","PG":"Papoea-Nieuw-Guinea","PH":"Filipijnen","PK":"Pakistan","PL":"Polen","PM":"Saint-Pierre en Miquelon","PN":"Pitcairneilanden","PR":"Puerto Rico","PS":"Palestijnse gebieden","PT":"Portugal","PW":"Palau","PY":"Paraguay","QA":"Qatar","RE":"Réunion","RO":"Roemenië","RS":"Servië","RU":"Rusland","RW":"Rwanda","SA":"Saoedi-Arabië","SB":"Salomonseilanden","SC":"Seychellen","SD":"Soedan","SE":"Zweden","SG":"Singapore","SH":"Sint-Helena","SI":"Slovenië","SJ":"Spitsbergen en Jan Mayen","SK":"Slowakije","SL":"Sierra Leone","SM":"San Marino","SN":"Senegal","SO":"Somalië","SR":"Suriname","SS":"Zuid-Soedan","ST":"Sao Tomé en Principe","SV":"El Salvador","SX":"Sint-Maarten","SY":"Syrië","SZ":"Eswatini","TC":"Turks- en Caicoseilanden","TD":"Tsjaad","TF":"Franse Gebieden in de zuidelijke Indische Oceaan","TG":"Togo","TH":"Thailand","TJ":"Tadzjikistan","TK":"Tokelau","TL":"Oost-Timor","TM":"Turkmenistan","TN":"Tunesië","TO":"Tonga","TR":"Turkije","TT":"Trinidad en Tobago","TV":"Tuvalu","TW":"Taiwan","TZ":"Tanzania","UA":"Oekraïne","UG":"Oeganda","UM":"Kleine afgelegen eilanden van de Verenigde Staten","US":"Verenigde Staten","UY":"Uruguay","UZ":"Oezbekistan","VA":"Vaticaanstad","VC":"Saint Vincent en de Grenadines","VE":"Venezuela","VG":"Britse Maagdeneilanden","VI":"Amerikaanse Maagdeneilanden","VN":"Vietnam","VU":"Vanuatu","WF":"Wallis en Futuna","WS":"Samoa","YE":"Jemen","YT":"Mayotte","ZA":"Zuid-Afrika","ZM":"Zambia","ZW":"Zimbabwe"}},
+{"language":"pl","names":{"AD":"Andora","AE":"Zjednoczone Emiraty Arabskie","AF":"Afganistan","AG":"Antigua i Barbuda","AI":"Anguilla","AL":"Albania","AM":"Armenia","AO":"Angola","AQ":"Antarktyda","AR":"Argentyna","AS":"Samoa Amerykańskie","AT":"Austria","AU":"Australia","AW":"Aruba","AX":"Wyspy Alandzkie","AZ":"Azerbejdżan","BA":"Bośnia i Hercegowina","BB":"Barbados","BD":"Bangladesz","BE":"Belgia","BF":"Burkina Faso","BG":"Bułgaria","BH":"Bahrajn","BI":"Burundi","BJ":"Benin","BL":"Saint-Barthélemy","BM":"Bermudy","BN":"Brunei","BO":"Boliwia","BQ":"Niderlandy Karaibskie","BR":"Brazylia","BS":"Bahamy","BT":"Bhutan","BV":"Wyspa Bouveta","BW":"Botswana","BY":"Białoruś","BZ":"Belize","CA":"Kanada","CC":"Wyspy Kokosowe","CD":"Demokratyczna Republika Konga","CF":"Republika Środkowoafrykańska","CG":"Kongo","CH":"Szwajcaria","CI":"Côte d’Ivoire","CK":"Wyspy Cooka","CL":"Chile","CM":"Kamerun","CN":"Chiny","CO":"Kolumbia","CR":"Kostaryka","CU":"Kuba","CV":"Republika Zielonego Przylądka","CW":"Curaçao","CX":"Wyspa Bożego Narodzenia","CY":"Cypr","CZ":"Czechy","DE":"Niemcy","DJ":"Dżibuti","DK":"Dania","DM":"Dominika","DO":"Dominikana","DZ":"Algieria","EC":"Ekwador","EE":"Estonia","EG":"Egipt","EH":"Sahara Zachodnia","ER":"Erytrea","ES":"Hiszpania","ET":"Etiopia","FI":"Finlandia","FJ":"Fidżi","FK":"Falklandy","FM":"Mikronezja","FO":"Wyspy Owcze","FR":"Francja","GA":"Gabon","GB":"Wielka Brytania","GD":"Grenada","GE":"Gruzja","GF":"Gujana Francuska","GG":"Guernsey","GH":"Ghana","GI":"Gibraltar","GL":"Grenlandia","GM":"Gambia","GN":"Gwinea","GP":"Gwadelupa","GQ":"Gwinea Równikowa","GR":"Grecja","GS":"Georgia Południowa i Sandwich Południowy","GT":"Gwatemala","GU":"Guam","GW":"Gwinea Bissau","GY":"Gujana","HK":"SRA Hongkong (Chiny)","HM":"Wyspy Heard i McDonalda","HN":"Honduras","HR":"Chorwacja","HT":"Haiti","HU":"Węgry","ID":"Indonezja","IE":"Irlandia","IL":"Izrael","IM":"Wyspa Man","IN":"Indie","IO":"Brytyjskie Terytorium Oceanu Indyjskiego","IQ":"Irak","IR":"Iran","IS":"Islandia","IT":"Włochy","JE":"Jersey","JM":"Jamajka","JO":"Jordania","JP":"Japonia","KE":"Kenia","KG":"Kirgistan","KH":"Kambodża","KI":"Kiribati","KM":"Komory","KN":"Saint Kitts i Nevis","KP":"Korea Północna","KR":"Korea Południowa","KW":"Kuwejt","KY":"Kajmany","KZ":"Kazachstan","LA":"Laos","LB":"Liban","LC":"Saint Lucia","LI":"Liechtenstein","LK":"Sri Lanka","LR":"Liberia","LS":"Lesotho","LT":"Litwa","LU":"Luksemburg","LV":"Łotwa","LY":"Libia","MA":"Maroko","MC":"Monako","MD":"Mołdawia","ME":"Czarnogóra","MF":"Saint-Martin","MG":"Madagaskar","MH":"Wyspy Marshalla","MK":"Macedonia Północna","ML":"Mali","MM":"Mjanma (Birma)","MN":"Mongolia","MO":"SRA Makau (Chiny)","MP":"Mariany Północne","MQ":"Martynika","MR":"Mauretania","MS":"Montserrat","MT":"Malta","MU":"Mauritius","MV":"Malediwy","MW":"Malawi","MX":"Meksyk","MY":"Malezja","MZ":"Mozambik","NA":"Namibia","NC":"Nowa Kaledonia","NE":"Niger","NF":"Norfolk","NG":"Nigeria","NI":"Nikaragua","NL":"Holandia","NO":"Norwegia","NP":"Nepal","NR":"Nauru","NU":"Niue","NZ":"Nowa Zelandia","OM":"Oman","PA":"Panama","PE":"Peru","PF":"Polinezja Francuska","PG":"Papua-Nowa Gwinea","PH":"Filipiny","PK":"Pakistan","PL":"Polska","PM":"Saint-Pierre i Miquelon","PN":"Pitcairn","PR":"Portoryko","PS":"Terytoria Palestyńskie","PT":"Portugalia","PW":"Palau","PY":"Paragwaj","QA":"Katar","RE":"Reunion","RO":"Rumunia","RS":"Serbia","RU":"Rosja","RW":"Rwanda","SA":"Arabia Saudyjska","SB":"Wyspy Salomona","SC":"Seszele","SD":"Sudan","SE":"Szwecja","SG":"Singapur","SH":"Wyspa Świętej Heleny","SI":"Słowenia","SJ":"Svalbard i Jan Mayen","SK":"Słowacja","SL":"Sierra Leone","SM":"San Marino","SN":"Senegal","SO":"Somalia","SR":"Surinam","SS":"Sudan Południowy","ST":"Wyspy Świętego Tomasza i Książęca","SV":"Salwador","SX":"Sint Maarten","SY":"Syria","SZ":"Eswatini","TC":"Turks i Caicos","TD":"Czad","TF":"Francuskie Terytoria Południowe i Antarktyczne","TG":"Togo","TH":"Tajlandia","TJ":"Tadżykistan","TK":"Tokelau","TL":"Timor Wschodni","TM":"Turkmenistan","TN":"Tunezja","TO":"Tonga","TR":"Turcja","TT":"Trynidad i Tobago","TV":"Tuvalu","TW":"Tajwan","TZ":"Tanzania","UA":"Ukraina","UG":"Uganda","UM":"Dalekie Wyspy Mniejsze Stanów Zjednoczonych","US":"Stany Zjednoczone","UY":"Urugwaj","UZ":"Uzbekistan","VA":"Watykan","VC":"Saint Vincent i Grenadyny","VE":"Wenezuela","VG":"Brytyjskie Wyspy Dziewicze","VI":"Wyspy Dziewicze Stanów Zjednoczonych","VN":"Wietnam","VU":"Vanuatu","WF":"Wallis i Futuna","WS":"Samoa","YE":"Jemen","YT":"Majotta","ZA":"Republika Południowej Afryki","ZM":"Zambia","ZW":"Zimbabwe"}},
+{"language":"pt","names":{"AD":"Andorra","AE":"Emirados Árabes Unidos","AF":"Afeganistão","AG":"Antígua e Barbuda","AI":"Anguila","AL":"Albânia","AM":"Armênia","AO":"Angola","AQ":"Antártida","AR":"Argentina","AS":"Samoa Americana","AT":"Áustria","AU":"Austrália","AW":"Aruba","AX":"Ilhas Aland","AZ":"Azerbaijão","BA":"Bósnia e Herzegovina","BB":"Barbados","BD":"Bangladesh","BE":"Bélgica","BF":"Burquina Faso","BG":"Bulgária","BH":"Barein","BI":"Burundi","BJ":"Benin","BL":"São Bartolomeu","BM":"Bermudas","BN":"Brunei","BO":"Bolívia","BQ":"Países Baixos Caribenhos","BR":"Brasil","BS":"Bahamas","BT":"Butão","BV":"Ilha Bouvet","BW":"Botsuana","BY":"Bielorrússia","BZ":"Belize","CA":"Canadá","CC":"Ilhas Cocos (Keeling)","CD":"Congo - Kinshasa","CF":"República Centro-Africana","CG":"República do Congo","CH":"Suíça","CI":"Costa do Marfim","CK":"Ilhas Cook","CL":"Chile","CM":"Camarões","CN":"China","CO":"Colômbia","CR":"Costa Rica","CU":"Cuba","CV":"Cabo Verde","CW":"Curaçao","CX":"Ilha Christmas","CY":"Chipre","CZ":"Tchéquia","DE":"Alemanha","DJ":"Djibuti","DK":"Dinamarca","DM":"Dominica","DO":"República Dominicana","DZ":"Argélia","EC":"Equador","EE":"Estônia","EG":"Egito","EH":"Saara Ocidental","ER":"Eritreia","ES":"Espanha","ET":"Etiópia","FI":"Finlândia","FJ":"Fiji","FK":"Ilhas Malvinas","FM":"Micronésia","FO":"Ilhas Faroé","FR":"França","GA":"Gabão","GB":"Reino Unido","GD":"Granada","GE":"Geórgia","GF":"Guiana Francesa","GG":"Guernsey","GH":"Gana","GI":"Gibraltar","GL":"Groenlândia","GM":"Gâmbia","GN":"Guiné","GP":"Guadalupe","GQ":"Guiné Equatorial","GR":"Grécia","GS":"Ilhas Geórgia do Sul e Sandwich do Sul","GT":"Guatemala","GU":"Guam","GW":"Guiné-Bissau","GY":"Guiana","HK":"Hong Kong, RAE da China","HM":"Ilhas Heard e McDonald","HN":"Honduras","HR":"Croácia","HT":"Haiti","HU":"Hungria","ID":"Indonésia","IE":"Irlanda","IL":"Israel","IM":"Ilha de Man","IN":"Índia","IO":"Território Britânico do Oceano Índico","IQ":"Iraque","IR":"Irã","IS":"Islândia","IT":"Itália","JE":"Jersey","JM":"Jamaica","JO":"Jordânia","JP":"Japão","KE":"Quênia","KG":"Quirguistão","KH":"Camboja","KI":"Quiribati","KM":"Comores","KN":"São Cristóvão e Névis","KP":"Coreia do Norte","KR":"Coreia do Sul","KW":"Kuwait","KY":"Ilhas Cayman","KZ":"Cazaquistão","LA":"Laos","LB":"Líbano","LC":"Santa Lúcia","LI":"Liechtenstein","LK":"Sri Lanka","LR":"Libéria","LS":"Lesoto","LT":"Lituânia","LU":"Luxemburgo","LV":"Letônia","LY":"Líbia","MA":"Marrocos","MC":"Mônaco","MD":"Moldávia","ME":"Montenegro","MF":"São Martinho","MG":"Madagascar","MH":"Ilhas Marshall","MK":"Macedônia do Norte","ML":"Mali","MM":"Mianmar (Birmânia)","MN":"Mongólia","MO":"Macau, RAE da China","MP":"Ilhas Marianas do Norte","MQ":"Martinica","MR":"Mauritânia","MS":"Montserrat","MT":"Malta","MU":"Maurício","MV":"Maldivas","MW":"Malaui","MX":"México","MY":"Malásia","MZ":"Moçambique","NA":"Namíbia","NC":"Nova Caledônia","NE":"Níger","NF":"Ilha Norfolk","NG":"Nigéria","NI":"Nicarágua","NL":"Países Baixos","NO":"Noruega","NP":"Nepal","NR":"Nauru","NU":"Niue","NZ":"Nova Zelândia","OM":"Omã","PA":"Panamá","PE":"Peru","PF":"Polinésia Francesa","PG":"Papua-Nova Guiné","PH":"Filipinas","PK":"Paquistão","PL":"Polônia","PM":"São Pedro e Miquelão","PN":"Ilhas Pitcairn","PR":"Porto Rico","PS":"Territórios palestinos","PT":"Portugal","PW":"Palau","PY":"Paraguai","QA":"Catar","RE":"Reunião","RO":"Romênia","RS":"Sérvia","RU":"Rússia","RW":"Ruanda","SA":"Arábia Saudita","SB":"Ilhas Salomão","SC":"Seicheles","SD":"Sudão","SE":"Suécia","SG":"Singapura","SH":"Santa Helena","SI":"Eslovênia","SJ":"Svalbard e Jan Mayen","SK":"Eslováquia","SL":"Serra Leoa","SM":"San Marino","SN":"Senegal","SO":"Somália","SR":"Suriname","SS":"Sudão do Sul","ST":"São Tomé e Príncipe","SV":"El Salvador","SX":"Sint Maarten","SY":"Síria","SZ":"Essuatíni","TC":"Ilhas Turcas e Caicos","TD":"Chade","TF":"Territórios Franceses do Sul","TG":"Togo","TH":"Tailândia","TJ":"Tadjiquistão","TK":"Tokelau","TL":"Timor-Leste","TM":"Turcomenistão","TN":"Tunísia","TO":"Tonga","TR":"Turquia","TT":"Trinidad e Tobago","TV":"Tuvalu","TW":"Taiwan","TZ":"Tanzânia","UA":"Ucrânia","UG":"Uganda","UM":"Ilhas Menores Distantes dos EUA","US":"Estados Unidos","UY":"Uruguai","UZ":"Uzbequistão","VA":"Cidade do Vaticano","VC":"São Vicente e Granadinas","VE":"Venezuela","VG":"Ilhas Virgens Britânicas","VI":"Ilhas Virgens Americanas","VN":"Vietnã","VU":"Vanuatu","WF":"Wallis e Futuna","WS":"Samoa","YE":"Iêmen","YT":"Mayotte","ZA":"África do Sul","ZM":"Zâmbia","ZW":"Zimbábue"}},
+{"language":"ro","names":{"AD":"Andorra","AE":"Emiratele Arabe Unite","AF":"Afganistan","AG":"Antigua și Barbuda","AI":"Anguilla","AL":"Albania","AM":"Armenia","AO":"Angola","AQ":"Antarctica","AR":"Argentina","AS":"Samoa Americană","AT":"Austria","AU":"Australia","AW":"Aruba","AX":"Insulele Åland","AZ":"Azerbaidjan","BA":"Bosnia și Herțegovina","BB":"Barbados","BD":"Bangladesh","BE":"Belgia","BF":"Burkina Faso","BG":"Bulgaria","BH":"Bahrain","BI":"Burundi","BJ":"Benin","BL":"Saint-Barthélemy","BM":"Bermuda","BN":"Brunei","BO":"Bolivia","BQ":"Insulele Caraibe Olandeze","BR":"Brazilia","BS":"Bahamas","BT":"Bhutan","BV":"Insula Bouvet","BW":"Botswana","BY":"Belarus","BZ":"Belize","CA":"Canada","CC":"Insulele Cocos (Keeling)","CD":"Congo - Kinshasa","CF":"Republica Centrafricană","CG":"Congo - Brazzaville","CH":"Elveția","CI":"Côte d’Ivoire","CK":"Insulele Cook","CL":"Chile","CM":"Camerun","CN":"China","CO":"Columbia","CR":"Costa Rica","CU":"Cuba","CV":"Capul Verde","CW":"Curaçao","CX":"Insula Christmas","CY":"Cipru","CZ":"Cehia","DE":"Germania","DJ":"Djibouti","DK":"Danemarca","DM":"Dominica","DO":"Republica Dominicană","DZ":"Algeria","EC":"Ecuador","EE":"Estonia","EG":"Egipt","EH":"Sahara Occidentală","ER":"Eritreea","ES":"Spania","ET":"Etiopia","FI":"Finlanda","FJ":"Fiji","FK":"Insulele Falkland","FM":"Micronezia","FO":"Insulele Feroe","FR":"Franța","GA":"Gabon","GB":"Regatul Unit","GD":"Grenada","GE":"Georgia","GF":"Guyana Franceză","GG":"Guernsey","GH":"Ghana","GI":"Gibraltar","GL":"Groenlanda","GM":"Gambia","GN":"Guineea","GP":"Guadelupa","GQ":"Guineea Ecuatorială","GR":"Grecia","GS":"Georgia de Sud și Insulele Sandwich de Sud","GT":"Guatemala","GU":"Guam","GW":"Guineea-Bissau","GY":"Guyana","HK":"R.A.S. Hong Kong, China","HM":"Insula Heard și Insulele McDonald","HN":"Honduras","HR":"Croația","HT":"Haiti","HU":"Ungaria","ID":"Indonezia","IE":"Irlanda","IL":"Israel","IM":"Insula Man","IN":"India","IO":"Teritoriul Britanic din Oceanul Indian","IQ":"Irak","IR":"Iran","IS":"Islanda","IT":"Italia","JE":"Jersey","JM":"Jamaica","JO":"Iordania","JP":"Japonia","KE":"Kenya","KG":"Kârgâzstan","KH":"Cambodgia","KI":"Kiribati","KM":"Comore","KN":"Saint Kitts și Nevis","KP":"Coreea de Nord","KR":"Coreea de Sud","KW":"Kuweit","KY":"Insulele Cayman","KZ":"Kazahstan","LA":"Laos","LB":"Liban","LC":"Sfânta Lucia","LI":"Liechtenstein","LK":"Sri Lanka","LR":"Liberia","LS":"Lesotho","LT":"Lituania","LU":"Luxemburg","LV":"Letonia","LY":"Libia","MA":"Maroc","MC":"Monaco","MD":"Republica Moldova","ME":"Muntenegru","MF":"Sfântul Martin","MG":"Madagascar","MH":"Insulele Marshall","MK":"Macedonia de Nord","ML":"Mali","MM":"Myanmar (Birmania)","MN":"Mongolia","MO":"R.A.S. Macao, China","MP":"Insulele Mariane de Nord","MQ":"Martinica","MR":"Mauritania","MS":"Montserrat","MT":"Malta","MU":"Mauritius","MV":"Maldive","MW":"Malawi","MX":"Mexic","MY":"Malaysia","MZ":"Mozambic","NA":"Namibia","NC":"Noua Caledonie","NE":"Niger","NF":"Insula Norfolk","NG":"Nigeria","NI":"Nicaragua","NL":"Țările de Jos","NO":"Norvegia","NP":"Nepal","NR":"Nauru","NU":"Niue","NZ":"Noua Zeelandă","OM":"Oman","PA":"Panama","PE":"Peru","PF":"Polinezia Franceză","PG":"Papua-Noua Guinee","PH":"Filipine","PK":"Pakistan","PL":"Polonia","PM":"Saint-Pierre și Miquelon","PN":"Insulele Pitcairn","PR":"Puerto Rico","PS":"Teritoriile Palestiniene","PT":"Portugalia","PW":"Palau","PY":"Paraguay","QA":"Qatar","RE":"Réunion","RO":"România","RS":"Serbia","RU":"Rusia","RW":"Rwanda","SA":"Arabia Saudită","SB":"Insulele Solomon","SC":"Seychelles","SD":"Sudan","SE":"Suedia","SG":"Singapore","SH":"Sfânta Elena","SI":"Slovenia","SJ":"Svalbard și Jan Mayen","SK":"Slovacia","SL":"Sierra Leone","SM":"San Marino","SN":"Senegal","SO":"Somalia","SR":"Suriname","SS":"Sudanul de Sud","ST":"São Tomé și Príncipe","SV":"El Salvador","SX":"Sint-Maarten","SY":"Siria","SZ":"Eswatini","TC":"Insulele Turks și Caicos","TD":"Ciad","TF":"Teritoriile Australe și Antarctice Franceze","TG":"Togo","TH":"Thailanda","TJ":"Tadjikistan","TK":"Tokelau","TL":"Timor-Leste","TM":"Turkmenistan","TN":"Tunisia","TO":"Tonga","TR":"Turcia","TT":"Trinidad și Tobago","TV":"Tuvalu","TW":"Taiwan","TZ":"Tanzania","UA":"Ucraina","UG":"Uganda","UM":"Insulele Îndepărtate ale S.U.A.","US":"Statele Unite ale Americii","UY":"Uruguay","UZ":"Uzbekistan","VA":"Statul Cetății Vaticanului","VC":"Saint Vincent și Grenadinele","VE":"Venezuela","VG":"Insulele Virgine Britanice","VI":"Insulele Virgine Americane","VN":"Vietnam","VU":"Vanuatu","WF":"Wallis și Futuna","WS":"Samoa","YE":"Yemen","YT":"Mayotte","ZA":"Africa de Sud","ZM":"Zambia","ZW":"Zimbabwe"}},
+{"language":"ru","names":{"AD":"Андорра","AE":"ОАЭ","AF":"Афганистан","AG":"Антигуа и Барбуда","AI":"Ангилья","AL":"Албания","AM":"Армения","AO":"Ангола","AQ":"Антарктида","AR":"Аргентина","AS":"Американское Самоа","AT":"Австрия","AU":"Австралия","AW":"Аруба","AX":"Аландские о-ва","AZ":"Азербайджан","BA":"Босния и Герцеговина","BB":"Барбадос","BD":"Бангладеш","BE":"Бельгия","BF":"Буркина-Фасо","BG":"Болгария","BH":"Бахрейн","BI":"Бурунди","BJ":"Бенин","BL":"Сен-Бартелеми","BM":"Бермудские о-ва","BN":"Бруней","BO":"Боливия","BQ":"Бонэйр, Синт-Эстатиус и Саба","BR":"Бразилия","BS":"Багамы","BT":"Бутан","BV":"о-в Буве","BW":"Ботсвана","BY":"Беларусь","BZ":"Белиз","CA":"Канада","CC":"Кокосовые о-ва","CD":"Конго - Киншаса","CF":"Центрально-Африканская Республика","CG":"Конго - Браззавиль","CH":"Швейцария","CI":"Кот-д’Ивуар","CK":"о-ва Кука","CL":"Чили","CM":"Камерун","CN":"Китай","CO":"Колумбия","CR":"Коста-Рика","CU":"Куба","CV":"Кабо-Верде","CW":"Кюрасао","CX":"о-в Рождества","CY":"Кипр","CZ":"Чехия","DE":"Германия","DJ":"Джибути","DK":"Дания","DM":"Доминика","DO":"Доминиканская Республика","DZ":"Алжир","EC":"Эквадор","EE":"Эстония","EG":"Египет","EH":"Западная Сахара","ER":"Эритрея","ES":"Испания","ET":"Эфиопия","FI":"Финляндия","FJ":"Фиджи","FK":"Фолклендские о-ва","FM":"Федеративные Штаты Микронезии","FO":"Фарерские о-ва","FR":"Франция","GA":"Габон","GB":"Великобритания","GD":"Гренада","GE":"Грузия","GF":"Французская Гвиана","GG":"Гернси","GH":"Гана","GI":"Гибралтар","GL":"Гренландия","GM":"Гамбия","GN":"Гвинея","GP":"Гваделупа","GQ":"Экваториальная Гвинея","GR":"Греция","GS":"Южная Георгия и Южные Сандвичевы о-ва","GT":"Гватемала","GU":"Гуам","GW":"Гвинея-Бисау","GY":"Гайана","HK":"Гонконг (САР)","HM":"о-ва Херд и Макдональд","HN":"Гондурас","HR":"Хорватия","HT":"Гаити","HU":"Венгрия","ID":"Индонезия","IE":"Ирландия","IL":"Израиль","IM":"о-в Мэн","IN":"Индия","IO":"Британская территория в Индийском океане","IQ":"Ирак","IR":"Иран","IS":"Исландия","IT":"Италия","JE":"Джерси","JM":"Ямайка","JO":"Иордания","JP":"Япония","KE":"Кения","KG":"Киргизия","KH":"Камбоджа","KI":"Кирибати","KM":"Коморы","KN":"Сент-Китс и Невис","KP":"КНДР","KR":"Республика Корея","KW":"Кувейт","KY":"о-ва Кайман","KZ":"Казахстан","LA":"Лаос","LB":"Ливан","LC":"Сент-Люсия","LI":"Лихтенштейн","LK":"Шри-Ланка","LR":"Либерия","LS":"Лесото","LT":"Литва","LU":"Люксембург","LV":"Латвия","LY":"Ливия","MA":"Марокко","MC":"Монако","MD":"Молдова","ME":"Черногория","MF":"Сен-Мартен","MG":"Мадагаскар","MH":"Маршалловы о-ва","MK":"Северная Македония","ML":"Мали","MM":"Мьянма (Бирма)","MN":"Монголия","MO":"Макао (САР)","MP":"Северные Марианские о-ва","MQ":"Мартиника","MR":"Мавритания","MS":"Монтсеррат","MT":"Мальта","MU":"Маврикий","MV":"Мальдивы","MW":"Малави","MX":"Мексика","MY":"Малайзия","MZ":"Мозамбик","NA":"Намибия","NC":"Новая Каледония","NE":"Нигер","NF":"о-в Норфолк","NG":"Нигерия","NI":"Никарагуа","NL":"Нидерланды","NO":"Норвегия","NP":"Непал","NR":"Науру","NU":"Ниуэ","NZ":"Новая Зеландия","OM":"Оман","PA":"Панама","PE":"Перу","PF":"Французская Полинезия","PG":"Папуа — Новая Гвинея","PH":"Филиппины","PK":"Пакистан","PL":"Польша","PM":"Сен-Пьер и Микелон","PN":"о-ва Питкэрн","PR":"Пуэрто-Рико","PS":"Палестинские территории","PT":"Португалия","PW":"Палау","PY":"Парагвай","QA":"Катар","RE":"Реюньон","RO":"Румыния","RS":"Сербия","RU":"Россия","RW":"Руанда","SA":"Саудовская Аравия","SB":"Соломоновы о-ва","SC":"Сейшельские о-ва","SD":"Судан","SE":"Швеция","SG":"Сингапур","SH":"о-в Св. Елены","SI":"Словения","SJ":"Шпицберген и Ян-Майен","SK":"Словакия","SL":"Сьерра-Леоне","SM":"Сан-Марино","SN":"Сенегал","SO":"Сомали","SR":"Суринам","SS":"Южный Судан","ST":"Сан-Томе и Принсипи","SV":"Сальвадор","SX":"Синт-Мартен","SY":"Сирия","SZ":"Эсватини","TC":"Тёркс и Кайкос","TD":"Чад","TF":"Французские Южные территории","TG":"Того","TH":"Таиланд","TJ":"Таджикистан","TK":"Токелау","TL":"Восточный Тимор","TM":"Туркменистан","TN":"Тунис","TO":"Тонга","TR":"Турция","TT":"Тринидад и Тобаго","TV":"Тувалу","TW":"Тайвань","TZ":"Танзания","UA":"Украина","UG":"Уганда","UM":"Внешние малые о-ва (США)","US":"Соединенные Штаты","UY":"Уругвай","UZ":"Узбекистан","VA":"Ватикан","VC":"Сент-Винсент и Гренадины","VE":"Венесуэла","VG":"Виргинские о-ва (Великобритания)","VI":"Виргинские о-ва (США)","VN":"Вьетнам","VU":"Вануату","WF":"Уоллис и Футуна","WS":"Самоа","YE":"Йемен","YT":"Майотта","ZA":"Южно-Африканская Республика","ZM":"Замбия","ZW":"Зимбабве"}},
+{"language":"sk","names":{"AD":"Andorra","AE":"Spojené arabské emiráty","AF":"Afganistan","AG":"Antigua a Barbuda","AI":"Anguilla","AL":"Albánsko","AM":"Arménsko","AO":"Angola","AQ":"Antarktída","AR":"Argentína","AS":"Americká Samoa","AT":"Rakúsko","AU":"Austrália","AW":"Aruba","AX":"Alandy","AZ":"Azerbajdžan","BA":"Bosna a Hercegovina","BB":"Barbados","BD":"Bangladéš","BE":"Belgicko","BF":"Burkina Faso","BG":"Bulharsko","BH":"Bahrajn","BI":"Burundi","BJ":"Benin","BL":"Svätý Bartolomej","BM":"Bermudy","BN":"Brunej","BO":"Bolívia","BQ":"Karibské Holandsko","BR":"Brazília","BS":"Bahamy","BT":"Bhután","BV":"Bouvetov ostrov","BW":"Botswana","BY":"Bielorusko","BZ":"Belize","CA":"Kanada","CC":"Kokosové ostrovy","CD":"Konžská demokratická republika","CF":"Stredoafrická republika","CG":"Konžská republika","CH":"Švajčiarsko","CI":"Pobrežie Slonoviny","CK":"Cookove ostrovy","CL":"Čile","CM":"Kamerun","CN":"Čína","CO":"Kolumbia","CR":"Kostarika","CU":"Kuba","CV":"Kapverdy","CW":"Curaçao","CX":"Vianočný ostrov","CY":"Cyprus","CZ":"Česko","DE":"Nemecko","DJ":"Džibutsko","DK":"Dánsko","DM":"Dominika","DO":"Dominikánska republika","DZ":"Alžírsko","EC":"Ekvádor","EE":"Estónsko","EG":"Egypt","EH":"Západná Sahara","ER":"Eritrea","ES":"Španielsko","ET":"Etiópia","FI":"Fínsko","FJ":"Fidži","FK":"Falklandy","FM":"Mikronézia","FO":"Faerské ostrovy","FR":"Francúzsko","GA":"Gabon","GB":"Spojené kráľovstvo","GD":"Grenada","GE":"Gruzínsko","GF":"Francúzska Guyana","GG":"Guernsey","GH":"Ghana","GI":"Gibraltár","GL":"Grónsko","GM":"Gambia","GN":"Guinea","GP":"Guadeloupe","GQ":"Rovníková Guinea","GR":"Grécko","GS":"Južná Georgia a Južné Sandwichove ostrovy","GT":"Guatemala","GU":"Guam","GW":"Guinea-Bissau","GY":"Guyana","HK":"Hongkong – OAO Číny","HM":"Heardov ostrov a Macdonaldove ostrovy","HN":"Honduras","HR":"Chorvátsko","HT":"Haiti","HU":"Maďarsko","ID":"Indonézia","IE":"Írsko","IL":"Izrael","IM":"Ostrov Man","IN":"India","IO":"Britské indickooceánske územie","IQ":"Irak","IR":"Irán","IS":"Island","IT":"Taliansko","JE":"Jersey","JM":"Jamajka","JO":"Jordánsko","JP":"Japonsko","KE":"Keňa","KG":"Kirgizsko","KH":"Kambodža","KI":"Kiribati","KM":"Komory","KN":"Svätý Krištof a Nevis","KP":"Severná Kórea","KR":"Južná Kórea","KW":"Kuvajt","KY":"Kajmanie ostrovy","KZ":"Kazachstan","LA":"Laos","LB":"Libanon","LC":"Svätá Lucia","LI":"Lichtenštajnsko","LK":"Srí Lanka","LR":"Libéria","LS":"Lesotho","LT":"Litva","LU":"Luxembursko","LV":"Lotyšsko","LY":"Líbya","MA":"Maroko","MC":"Monako","MD":"Moldavsko","ME":"Čierna Hora","MF":"Svätý Martin (fr.)","MG":"Madagaskar","MH":"Marshallove ostrovy","MK":"Severné Macedónsko","ML":"Mali","MM":"Mjanmarsko","MN":"Mongolsko","MO":"Macao – OAO Číny","MP":"Severné Mariány","MQ":"Martinik","MR":"Mauritánia","MS":"Montserrat","MT":"Malta","MU":"Maurícius","MV":"Maldivy","MW":"Malawi","MX":"Mexiko","MY":"Malajzia","MZ":"Mozambik","NA":"Namíbia","NC":"Nová Kaledónia","NE":"Niger","NF":"Norfolk","NG":"Nigéria","NI":"Nikaragua","NL":"Holandsko","NO":"Nórsko","NP":"Nepál","NR":"Nauru","NU":"Niue","NZ":"Nový Zéland","OM":"Omán","PA":"Panama","PE":"Peru","PF":"Francúzska Polynézia","PG":"Papua-Nová Guinea","PH":"Filipíny","PK":"Pakistan","PL":"Poľsko","PM":"Saint Pierre a Miquelon","PN":"Pitcairnove ostrovy","PR":"Portoriko","PS":"Palestínske územia","PT":"Portugalsko","PW":"Palau","PY":"Paraguaj","QA":"Katar","RE":"Réunion","RO":"Rumunsko","RS":"Srbsko","RU":"Rusko","RW":"Rwanda","SA":"Saudská Arábia","SB":"Šalamúnove ostrovy","SC":"Seychely","SD":"Sudán","SE":"Švédsko","SG":"Singapur","SH":"Svätá Helena","SI":"Slovinsko","SJ":"Svalbard a Jan Mayen","SK":"Slovensko","SL":"Sierra Leone","SM":"San Maríno","SN":"Senegal","SO":"Somálsko","SR":"Surinam","SS":"Južný Sudán","ST":"Svätý Tomáš a Princov ostrov","SV":"Salvádor","SX":"Svätý Martin (hol.)","SY":"Sýria","SZ":"Eswatini","TC":"Turks a Caicos","TD":"Čad","TF":"Francúzske južné a antarktické územia","TG":"Togo","TH":"Thajsko","TJ":"Tadžikistan","TK":"Tokelau","TL":"Východný Timor","TM":"Turkménsko","TN":"Tunisko","TO":"Tonga","TR":"Turecko","TT":"Trinidad a Tobago","TV":"Tuvalu","TW":"Taiwan","TZ":"Tanzánia","UA":"Ukrajina","UG":"Uganda","UM":"Menšie odľahlé ostrovy USA","US":"Spojené štáty","UY":"Uruguaj","UZ":"Uzbekistan","VA":"Vatikán","VC":"Svätý Vincent a Grenadíny","VE":"Venezuela","VG":"Britské Panenské ostrovy","VI":"Americké Panenské ostrovy","VN":"Vietnam","VU":"Vanuatu","WF":"Wallis a Futuna","WS":"Samoa","YE":"Jemen","YT":"Mayotte","ZA":"Južná Afrika","ZM":"Zambia","ZW":"Zimbabwe"}},
+{"language":"sl","names":{"AD":"Andora","AE":"Združeni arabski emirati","AF":"Afganistan","AG":"Antigva in Barbuda","AI":"Angvila","AL":"Albanija","AM":"Armenija","AO":"Angola","AQ":"Antarktika","AR":"Argentina","AS":"Ameriška Samoa","AT":"Avstrija","AU":"Avstralija","AW":"Aruba","AX":"Ålandski otoki","AZ":"Azerbajdžan","BA":"Bosna in Hercegovina","BB":"Barbados","BD":"Bangladeš","BE":"Belgija","BF":"Burkina Faso","BG":"Bolgarija","BH":"Bahrajn","BI":"Burundi","BJ":"Benin","BL":"Saint Barthélemy","BM":"Bermudi","BN":"Brunej","BO":"Bolivija","BQ":"Nizozemski Karibi","BR":"Brazilija","BS":"Bahami","BT":"Butan","BV":"Bouvetov otok","BW":"Bocvana","BY":"Belorusija","BZ":"Belize","CA":"Kanada","CC":"Kokosovi otoki","CD":"Demokratična republika Kongo","CF":"Srednjeafriška republika","CG":"Kongo - Brazzaville","CH":"Švica","CI":"Slonokoščena obala","CK":"Cookovi otoki","CL":"Čile","CM":"Kamerun","CN":"Kitajska","CO":"Kolumbija","CR":"Kostarika","CU":"Kuba","CV":"Zelenortski otoki","CW":"Curaçao","CX":"Božični otok","CY":"Ciper","CZ":"Češka","DE":"Nemčija","DJ":"Džibuti","DK":"Danska","DM":"Dominika","DO":"Dominikanska republika","DZ":"Alžirija","EC":"Ekvador","EE":"Estonija","EG":"Egipt","EH":"Zahodna Sahara","ER":"Eritreja","ES":"Španija","ET":"Etiopija","FI":"Finska","FJ":"Fidži","FK":"Falklandski otoki","FM":"Mikronezija","FO":"Ferski otoki","FR":"Francija","GA":"Gabon","GB":"Združeno kraljestvo","GD":"Grenada","GE":"Gruzija","GF":"Francoska Gvajana","GG":"Guernsey","GH":"Gana","GI":"Gibraltar","GL":"Grenlandija","GM":"Gambija","GN":"Gvineja","GP":"Guadeloupe","GQ":"Ekvatorialna Gvineja","GR":"Grčija","GS":"Južna Georgia in Južni Sandwichevi otoki","GT":"Gvatemala","GU":"Guam","GW":"Gvineja Bissau","GY":"Gvajana","HK":"Posebno upravno območje Ljudske republike Kitajske Hongkong","HM":"Heardov otok in McDonaldovi otoki","HN":"Honduras","HR":"Hrvaška","HT":"Haiti","HU":"Madžarska","ID":"Indonezija","IE":"Irska","IL":"Izrael","IM":"Otok Man","IN":"Indija","IO":"Britansko ozemlje v Indijskem oceanu","IQ":"Irak","IR":"Iran","IS":"Islandija","IT":"Italija","JE":"Jersey","JM":"Jamajka","JO":"Jordanija","JP":"Japonska","KE":"Kenija","KG":"Kirgizistan","KH":"Kambodža","KI":"Kiribati","KM":"Komori","KN":"Saint Kitts in Nevis","KP":"Severna Koreja","KR":"Južna Koreja","KW":"Kuvajt","KY":"Kajmanski otoki","KZ":"Kazahstan","LA":"Laos","LB":"Libanon","LC":"Saint Lucia","LI":"Lihtenštajn","LK":"Šrilanka","LR":"Liberija","LS":"Lesoto","LT":"Litva","LU":"Luksemburg","LV":"Latvija","LY":"Libija","MA":"Maroko","MC":"Monako","MD":"Moldavija","ME":"Črna gora","MF":"Saint Martin","MG":"Madagaskar","MH":"Marshallovi otoki","MK":"Severna Makedonija","ML":"Mali","MM":"Mjanmar (Burma)","MN":"Mongolija","MO":"Posebno upravno območje Ljudske republike Kitajske Macao","MP":"Severni Marianski otoki","MQ":"Martinik","MR":"Mavretanija","MS":"Montserrat","MT":"Malta","MU":"Mauritius","MV":"Maldivi","MW":"Malavi","MX":"Mehika","MY":"Malezija","MZ":"Mozambik","NA":"Namibija","NC":"Nova Kaledonija","NE":"Niger","NF":"Norfolški otok","NG":"Nigerija","NI":"Nikaragva","NL":"Nizozemska","NO":"Norveška","NP":"Nepal","NR":"Nauru","NU":"Niue","NZ":"Nova Zelandija","OM":"Oman","PA":"Panama","PE":"Peru","PF":"Francoska Polinezija","PG":"Papua Nova Gvineja","PH":"Filipini","PK":"Pakistan","PL":"Poljska","PM":"Saint Pierre in Miquelon","PN":"Pitcairn","PR":"Portoriko","PS":"Palestinsko ozemlje","PT":"Portugalska","PW":"Palau","PY":"Paragvaj","QA":"Katar","RE":"Reunion","RO":"Romunija","RS":"Srbija","RU":"Rusija","RW":"Ruanda","SA":"Saudova Arabija","SB":"Salomonovi otoki","SC":"Sejšeli","SD":"Sudan","SE":"Švedska","SG":"Singapur","SH":"Sveta Helena","SI":"Slovenija","SJ":"Svalbard in Jan Mayen","SK":"Slovaška","SL":"Sierra Leone","SM":"San Marino","SN":"Senegal","SO":"Somalija","SR":"Surinam","SS":"Južni Sudan","ST":"Sao Tome in Principe","SV":"Salvador","SX":"Sint Maarten","SY":"Sirija","SZ":"Esvatini","TC":"Otoki Turks in Caicos","TD":"Čad","TF":"Francosko južno ozemlje","TG":"Togo","TH":"Tajska","TJ":"Tadžikistan","TK":"Tokelau","TL":"Timor-Leste","TM":"Turkmenistan","TN":"Tunizija","TO":"Tonga","TR":"Turčija","TT":"Trinidad in Tobago","TV":"Tuvalu","TW":"Tajvan","TZ":"Tanzanija","UA":"Ukrajina","UG":"Uganda","UM":"Stranski zunanji otoki Združenih držav","US":"Združene države Amerike","UY":"Urugvaj","UZ":"Uzbekistan","VA":"Vatikan","VC":"Saint Vincent in Grenadine","VE":"Venezuela","VG":"Britanski Deviški otoki","VI":"Ameriški Deviški otoki","VN":"Vietnam","VU":"Vanuatu","WF":"Wallis in Futuna","WS":"Samoa","YE":"Jemen","YT":"Mayotte","ZA":"Južnoafriška republika","ZM":"Zambija","ZW":"Zimbabve"}},
+{"language":"sr","names":{"AD":"Андора","AE":"Уједињени Арапски Емирати","AF":"Авганистан","AG":"Антигва и Барбуда","AI":"Ангвила","AL":"Албанија","AM":"Јерменија","AO":"Ангола","AQ":"Антарктик","AR":"Аргентина","AS":"Америчка Самоа","AT":"Аустрија","AU":"Аустралија","AW":"Аруба","AX":"Оландска Острва","AZ":"Азербејџан","BA":"Босна и Херцеговина","BB":"Барбадос","BD":"Бангладеш","BE":"Белгија","BF":"Буркина Фасо","BG":"Бугарска","BH":"Бахреин","BI":"Бурунди","BJ":"Бенин","BL":"Свети Бартоломеј","BM":"Бермуда","BN":"Брунеј","BO":"Боливија","BQ":"Карипска Холандија","BR":"Бразил","BS":"Бахами","BT":"Бутан","BV":"Острво Буве","BW":"Боцвана","BY":"Белорусија","BZ":"Белизе","CA":"Канада","CC":"Кокосова (Килингова) Острва","CD":"Конго - Киншаса","CF":"Централноафричка Република","CG":"Конго - Бразавил","CH":"Швајцарска","CI":"Обала Слоноваче (Кот д’Ивоар)","CK":"Кукова Острва","CL":"Чиле","CM":"Камерун","CN":"Кина","CO":"Колумбија","CR":"Костарика","CU":"Куба","CV":"Зеленортска Острва","CW":"Курасао","CX":"Божићно Острво","CY":"Кипар","CZ":"Чешка","DE":"Немачка","DJ":"Џибути","DK":"Данска","DM":"Доминика","DO":"Доминиканска Република","DZ":"Алжир","EC":"Еквадор","EE":"Естонија","EG":"Египат","EH":"Западна Сахара","ER":"Еритреја","ES":"Шпанија","ET":"Етиопија","FI":"Финска","FJ":"Фиџи","FK":"Фокландска Острва","FM":"Микронезија","FO":"Фарска Острва","FR":"Француска","GA":"Габон","GB":"Уједињено Краљевство","GD":"Гренада","GE":"Грузија","GF":"Француска Гвајана","GG":"Гернзи","GH":"Гана","GI":"Гибралтар","GL":"Гренланд","GM":"Гамбија","GN":"Гвинеја","GP":"Гваделуп","GQ":"Екваторијална Гвинеја","GR":"Грчка","GS":"Јужна Џорџија и Јужна Сендвичка Острва","GT":"Гватемала","GU":"Гуам","GW":"Гвинеја-Бисао","GY":"Гвајана","HK":"САР Хонгконг (Кина)","HM":"Острво Херд и Мекдоналдова острва","HN":"Хондурас","HR":"Хрватска","HT":"Хаити","HU":"Мађарска","ID":"Индонезија","IE":"Ирска","IL":"Израел","IM":"Острво Ман","IN":"Индија","IO":"Британска територија Индијског океана","IQ":"Ирак","IR":"Иран","IS":"Исланд","IT":"Италија","JE":"Џерзи","JM":"Јамајка","JO":"Јордан","JP":"Јапан","KE":"Кенија","KG":"Киргистан","KH":"Камбоџа","KI":"Кирибати","KM":"Коморска Острва","KN":"Сент Китс и Невис","KP":"Северна Кореја","KR":"Јужна Кореја","KW":"Кувајт","KY":"Кајманска Острва","KZ":"Казахстан","LA":"Лаос","LB":"Либан","LC":"Света Луција","LI":"Лихтенштајн","LK":"Шри Ланка","LR":"Либерија","LS":"Лесото","LT":"Литванија","LU":"Луксембург","LV":"Летонија","LY":"Либија","MA":"Мароко","MC":"Монако","MD":"Молдавија","ME":"Црна Гора","MF":"Свети Мартин (Француска)","MG":"Мадагаскар","MH":"Маршалска Острва","MK":"Северна Македонија","ML":"Мали","MM":"Мијанмар (Бурма)","MN":"Монголија","MO":"САР Макао (Кина)","MP":"Северна Маријанска Острва","MQ":"Мартиник","MR":"Мауританија","MS":"Монсерат","MT":"Малта","MU":"Маурицијус","MV":"Малдиви","MW":"Малави","MX":"Мексико","MY":"Малезија","MZ":"Мозамбик","NA":"Намибија","NC":"Нова Каледонија","NE":"Нигер","NF":"Острво Норфок","NG":"Нигерија","NI":"Никарагва","NL":"Холандија","NO":"Норвешка","NP":"Непал","NR":"Науру","NU":"Ниуе","NZ":"Нови Зеланд","OM":"Оман","PA":"Панама","PE":"Перу","PF":"Француска Полинезија","PG":"Папуа Нова Гвинеја","PH":"Филипини","PK":"Пакистан","PL":"Пољска","PM":"Сен Пјер и Микелон","PN":"Питкерн","PR":"Порторико","PS":"Палестинске територије","PT":"Португалија","PW":"Палау","PY":"Парагвај","QA":"Катар","RE":"Реинион","RO":"Румунија","RS":"Србија","RU":"Русија","RW":"Руанда","SA":"Саудијска Арабија","SB":"Соломонска Острва","SC":"Сејшели","SD":"Судан","SE":"Шведска","SG":"Сингапур","SH":"Света Јелена","SI":"Словенија","SJ":"Свалбард и Јан Мајен","SK":"Словачка","SL":"Сијера Леоне","SM":"Сан Марино","SN":"Сенегал","SO":"Сомалија","SR":"Суринам","SS":"Јужни Судан","ST":"Сао Томе и Принципе","SV":"Салвадор","SX":"Свети Мартин (Холандија)","SY":"Сирија","SZ":"Свазиленд","TC":"Острва Туркс и Каикос","TD":"Чад","TF":"Француске Јужне Територије","TG":"Того","TH":"Тајланд","TJ":"Таџикистан","TK":"Токелау","TL":"Тимор-Лесте (Источни Тимор)","TM":"Туркменистан","TN":"Тунис","TO":"Тонга","TR":"Турска","TT":"Тринидад и Тобаго","TV":"Тувалу","TW":"Тајван","TZ":"Танзанија","UA":"Украјина","UG":"Уганда","UM":"Удаљена острва САД","US":"Сједињене Државе","UY":"Уругвај","UZ":"Узбекистан","VA":"Ватикан","VC":"Сент Винсент и Гренадини","VE":"Венецуела","VG":"Британска Девичанска Острва","VI":"Америчка Девичанска Острва","VN":"Вијетнам","VU":"Вануату","WF":"Валис и Футуна","WS":"Самоа","YE":"Јемен","YT":"Мајот","ZA":"Јужноафричка Република","ZM":"Замбија","ZW":"Зимбабве"}},
+{"language":"sv","names":{"AD":"Andorra","AE":"Förenade Arabemiraten","AF":"Afghanistan","AG":"Antigua och Barbuda","AI":"Anguilla","AL":"Albanien","AM":"Armenien","AO":"Angola","AQ":"Antarktis","AR":"Argentina","AS":"Amerikanska Samoa","AT":"Österrike","AU":"Australien","AW":"Aruba","AX":"Åland","AZ":"Azerbajdzjan","BA":"Bosnien och Hercegovina","BB":"Barbados","BD":"Bangladesh","BE":"Belgien","BF":"Burkina Faso","BG":"Bulgarien","BH":"Bahrain","BI":"Burundi","BJ":"Benin","BL":"S:t Barthélemy","BM":"Bermuda","BN":"Brunei","BO":"Bolivia","BQ":"Karibiska Nederländerna","BR":"Brasilien","BS":"Bahamas","BT":"Bhutan","BV":"Bouvetön","BW":"Botswana","BY":"Vitryssland","BZ":"Belize","CA":"Kanada","CC":"Kokosöarna","CD":"Kongo-Kinshasa","CF":"Centralafrikanska republiken","CG":"Kongo-Brazzaville","CH":"Schweiz","CI":"Côte d’Ivoire","CK":"Cooköarna","CL":"Chile","CM":"Kamerun","CN":"Kina","CO":"Colombia","CR":"Costa Rica","CU":"Kuba","CV":"Kap Verde","CW":"Curaçao","CX":"Julön","CY":"Cypern","CZ":"Tjeckien","DE":"Tyskland","DJ":"Djibouti","DK":"Danmark","DM":"Dominica","DO":"Dominikanska republiken","DZ":"Algeriet","EC":"Ecuador","EE":"Estland","EG":"Egypten","EH":"Västsahara","ER":"Eritrea","ES":"Spanien","ET":"Etiopien","FI":"Finland","FJ":"Fiji","FK":"Falklandsöarna","FM":"Mikronesien","FO":"Färöarna","FR":"Frankrike","GA":"Gabon","GB":"Storbritannien","GD":"Grenada","GE":"Georgien","GF":"Franska Guyana","GG":"Guernsey","GH":"Ghana","GI":"Gibraltar","GL":"Grönland","GM":"Gambia","GN":"Guinea","GP":"Guadeloupe","GQ":"Ekvatorialguinea","GR":"Grekland","GS":"Sydgeorgien och Sydsandwichöarna","GT":"Guatemala","GU":"Guam","GW":"Guinea-Bissau","GY":"Guyana","HK":"Hongkong SAR","HM":"Heardön och McDonaldöarna","HN":"Honduras","HR":"Kroatien","HT":"Haiti","HU":"Ungern","ID":"Indonesien","IE":"Irland","IL":"Israel","IM":"Isle of Man","IN":"Indien","IO":"Brittiska territoriet i Indiska oceanen","IQ":"Irak","IR":"Iran","IS":"Island","IT":"Italien","JE":"Jersey","JM":"Jamaica","JO":"Jordanien","JP":"Japan","KE":"Kenya","KG":"Kirgizistan","KH":"Kambodja","KI":"Kiribati","KM":"Komorerna","KN":"S:t Kitts och Nevis","KP":"Nordkorea","KR":"Sydkorea","KW":"Kuwait","KY":"Caymanöarna","KZ":"Kazakstan","LA":"Laos","LB":"Libanon","LC":"S:t Lucia","LI":"Liechtenstein","LK":"Sri Lanka","LR":"Liberia","LS":"Lesotho","LT":"Litauen","LU":"Luxemburg","LV":"Lettland","LY":"Libyen","MA":"Marocko","MC":"Monaco","MD":"Moldavien","ME":"Montenegro","MF":"Saint-Martin","MG":"Madagaskar","MH":"Marshallöarna","MK":"Nordmakedonien","ML":"Mali","MM":"Myanmar (Burma)","MN":"Mongoliet","MO":"Macao SAR","MP":"Nordmarianerna","MQ":"Martinique","MR":"Mauretanien","MS":"Montserrat","MT":"Malta","MU":"Mauritius","MV":"Maldiverna","MW":"Malawi","MX":"Mexiko","MY":"Malaysia","MZ":"Moçambique","NA":"Namibia","NC":"Nya Kaledonien","NE":"Niger","NF":"Norfolkön","NG":"Nigeria","NI":"Nicaragua","NL":"Nederländerna","NO":"Norge","NP":"Nepal","NR":"Nauru","NU":"Niue","NZ":"Nya Zeeland","OM":"Oman","PA":"Panama","PE":"Peru","PF":"Franska Polynesien","PG":"Papua Nya Guinea","PH":"Filippinerna","PK":"Pakistan","PL":"Polen","PM":"S:t Pierre och Miquelon","PN":"Pitcairnöarna","PR":"Puerto Rico","PS":"Palestinska territorierna","PT":"Portugal","PW":"Palau","PY":"Paraguay","QA":"Qatar","RE":"Réunion","RO":"Rumänien","RS":"Serbien","RU":"Ryssland","RW":"Rwanda","SA":"Saudiarabien","SB":"Salomonöarna","SC":"Seychellerna","SD":"Sudan","SE":"Sverige","SG":"Singapore","SH":"S:t Helena","SI":"Slovenien","SJ":"Svalbard och Jan Mayen","SK":"Slovakien","SL":"Sierra Leone","SM":"San Marino","SN":"Senegal","SO":"Somalia","SR":"Surinam","SS":"Sydsudan","ST":"São Tomé och Príncipe","SV":"El Salvador","SX":"Sint Maarten","SY":"Syrien","SZ":"Eswatini","TC":"Turks- och Caicosöarna","TD":"Tchad","TF":"Franska sydterritorierna","TG":"Togo","TH":"Thailand","TJ":"Tadzjikistan","TK":"Tokelauöarna","TL":"Östtimor","TM":"Turkmenistan","TN":"Tunisien","TO":"Tonga","TR":"Turkiet","TT":"Trinidad och Tobago","TV":"Tuvalu","TW":"Taiwan","TZ":"Tanzania","UA":"Ukraina","UG":"Uganda","UM":"USA:s yttre öar","US":"USA","UY":"Uruguay","UZ":"Uzbekistan","VA":"Vatikanstaten","VC":"S:t Vincent och Grenadinerna","VE":"Venezuela","VG":"Brittiska Jungfruöarna","VI":"Amerikanska Jungfruöarna","VN":"Vietnam","VU":"Vanuatu","WF":"Wallis- och Futunaöarna","WS":"Samoa","YE":"Jemen","YT":"Mayotte","ZA":"Sydafrika","ZM":"Zambia","ZW":"Zimbabwe"}},
+{"language":"sw","names":{"AD":"Andorra","AE":"Falme za Kiarabu","AF":"Afghanistan","AG":"Antigua na Barbuda","AI":"Anguilla","AL":"Albania","AM":"Armenia","AO":"Angola","AQ":"Antaktiki","AR":"Ajentina","AS":"Samoa ya Marekani","AT":"Austria","AU":"Australia","AW":"Aruba","AX":"Visiwa vya Aland","AZ":"Azerbaijani","BA":"Bosnia na Hezegovina","BB":"Babadosi","BD":"Bangladeshi","BE":"Ubelgiji","BF":"Bukinafaso","BG":"Bulgaria","BH":"Bahareni","BI":"Burundi","BJ":"Benin","BL":"St. Barthelemy","BM":"Bermuda","BN":"Brunei","BO":"Bolivia","BQ":"Uholanzi ya Karibiani","BR":"Brazil","BS":"Bahama","BT":"Bhutan","BV":"Kisiwa cha Bouvet","BW":"Botswana","BY":"Belarus","BZ":"Belize","CA":"Kanada","CC":"Visiwa vya Cocos (Keeling)","CD":"Jamhuri ya Kidemokrasia ya Kongo","CF":"Jamhuri ya Afrika ya Kati","CG":"Kongo - Brazzaville","CH":"Uswisi","CI":"Cote d’Ivoire","CK":"Visiwa vya Cook","CL":"Chile","CM":"Kameruni","CN":"Uchina","CO":"Kolombia","CR":"Kostarika","CU":"Kuba","CV":"Cape Verde","CW":"Curacao","CX":"Kisiwa cha Krismasi","CY":"Saiprasi","CZ":"Chechia","DE":"Ujerumani","DJ":"Jibuti","DK":"Denmaki","DM":"Dominika","DO":"Jamhuri ya Dominika","DZ":"Aljeria","EC":"Ecuador","EE":"Estonia","EG":"Misri","EH":"Sahara Magharibi","ER":"Eritrea","ES":"Uhispania","ET":"Ethiopia","FI":"Ufini","FJ":"Fiji","FK":"Visiwa vya Falkland","FM":"Mikronesia","FO":"Visiwa vya Faroe","FR":"Ufaransa","GA":"Gabon","GB":"Ufalme wa Muungano","GD":"Grenada","GE":"Jojia","GF":"Guiana ya Ufaransa","GG":"Guernsey","GH":"Ghana","GI":"Gibraltar","GL":"Greenland","GM":"Gambia","GN":"Gine","GP":"Guadeloupe","GQ":"Guinea ya Ikweta","GR":"Ugiriki","GS":"Visiwa vya Georgia Kusini na Sandwich Kusini","GT":"Guatemala","GU":"Guam","GW":"Ginebisau","GY":"Guyana","HK":"Hong Kong SAR China","HM":"Kisiwa cha Heard na Visiwa vya McDonald","HN":"Honduras","HR":"Croatia","HT":"Haiti","HU":"Hungaria","ID":"Indonesia","IE":"Ayalandi","IL":"Israeli","IM":"Kisiwa cha Man","IN":"India","IO":"Eneo la Uingereza katika Bahari Hindi","IQ":"Iraki","IR":"Iran","IS":"Aisilandi","IT":"Italia","JE":"Jersey","JM":"Jamaika","JO":"Jordan","JP":"Japani","KE":"Kenya","KG":"Kirigizistani","KH":"Kambodia","KI":"Kiribati","KM":"Komoro","KN":"St. Kitts na Nevis","KP":"Korea Kaskazini","KR":"Korea Kusini","KW":"Kuwait","KY":"Visiwa vya Cayman","KZ":"Kazakistani","LA":"Laos","LB":"Lebanon","LC":"St. Lucia","LI":"Liechtenstein","LK":"Sri Lanka","LR":"Liberia","LS":"Lesoto","LT":"Lithuania","LU":"Luxembourg","LV":"Latvia","LY":"Libya","MA":"Morocco","MC":"Monaco","MD":"Moldova","ME":"Montenegro","MF":"St. Martin","MG":"Madagaska","MH":"Visiwa vya Marshall","MK":"Masedonia ya Kaskazini","ML":"Mali","MM":"Myanmar (Burma)","MN":"Mongolia","MO":"Makau SAR China","MP":"Visiwa vya Mariana vya Kaskazini","MQ":"Martinique","MR":"Moritania","MS":"Montserrat","MT":"Malta","MU":"Morisi","MV":"Maldivi","MW":"Malawi","MX":"Meksiko","MY":"Malesia","MZ":"Msumbiji","NA":"Namibia","NC":"New Caledonia","NE":"Niger","NF":"Kisiwa cha Norfolk","NG":"Nigeria","NI":"Nikaragwa","NL":"Uholanzi","NO":"Norway","NP":"Nepal","NR":"Nauru","NU":"Niue","NZ":"Nyuzilandi","OM":"Oman","PA":"Panama","PE":"Peru","PF":"Polynesia ya Ufaransa","PG":"Papua New Guinea","PH":"Ufilipino","PK":"Pakistani","PL":"Poland","PM":"Santapierre na Miquelon","PN":"Visiwa vya Pitcairn","PR":"Puerto Rico","PS":"Maeneo ya Palestina","PT":"Ureno","PW":"Palau","PY":"Paraguay","QA":"Qatar","RE":"Reunion","RO":"Romania","RS":"Serbia","RU":"Urusi","RW":"Rwanda","SA":"Saudia","SB":"Visiwa vya Solomon","SC":"Ushelisheli","SD":"Sudan","SE":"Uswidi","SG":"Singapore","SH":"St. Helena","SI":"Slovenia","SJ":"Svalbard na Jan Mayen","SK":"Slovakia","SL":"Siera Leoni","SM":"San Marino","SN":"Senegali","SO":"Somalia","SR":"Suriname","SS":"Sudan Kusini","ST":"Sao Tome na Principe","SV":"El Salvador","SX":"Sint Maarten","SY":"Syria","SZ":"Eswatini","TC":"Visiwa vya Turks na Caicos","TD":"Chad","TF":"Himaya za Kusini za Kifaranza","TG":"Togo","TH":"Tailandi","TJ":"Tajikistani","TK":"Tokelau","TL":"Timor-Leste","TM":"Turkmenistan","TN":"Tunisia","TO":"Tonga","TR":"Uturuki","TT":"Trinidad na Tobago","TV":"Tuvalu","TW":"Taiwan","TZ":"Tanzania","UA":"Ukraine","UG":"Uganda","UM":"Visiwa Vidogo vya Nje vya Marekani","US":"Marekani","UY":"Uruguay","UZ":"Uzibekistani","VA":"Mji wa Vatican","VC":"St. Vincent na Grenadines","VE":"Venezuela","VG":"Visiwa vya Virgin, Uingereza","VI":"Visiwa vya Virgin, Marekani","VN":"Vietnamu","VU":"Vanuatu","WF":"Wallis na Futuna","WS":"Samoa","YE":"Yemeni","YT":"Mayotte","ZA":"Afrika Kusini","ZM":"Zambia","ZW":"Zimbabwe"}},
+{"language":"th","names":{"AD":"อันดอร์รา","AE":"สหรัฐอาหรับเอมิเรตส์","AF":"อัฟกานิสถาน","AG":"แอนติกาและบาร์บูดา","AI":"แองกวิลลา","AL":"แอลเบเนีย","AM":"อาร์เมเนีย","AO":"แองโกลา","AQ":"แอนตาร์กติกา","AR":"อาร์เจนตินา","AS":"อเมริกันซามัว","AT":"ออสเตรีย","AU":"ออสเตรเลีย","AW":"อารูบา","AX":"หมู่เกาะโอลันด์","AZ":"อาเซอร์ไบจาน","BA":"บอสเนียและเฮอร์เซโกวีนา","BB":"บาร์เบโดส","BD":"บังกลาเทศ","BE":"เบลเยียม","BF":"บูร์กินาฟาโซ","BG":"บัลแกเรีย","BH":"บาห์เรน","BI":"บุรุนดี","BJ":"เบนิน","BL":"เซนต์บาร์เธเลมี","BM":"เบอร์มิวดา","BN":"บรูไน","BO":"โบลิเวีย","BQ":"เนเธอร์แลนด์แคริบเบียน","BR":"บราซิล","BS":"บาฮามาส","BT":"ภูฏาน","BV":"เกาะบูเว","BW":"บอตสวานา","BY":"เบลารุส","BZ":"เบลีซ","CA":"แคนาดา","CC":"หมู่เกาะโคโคส (คีลิง)","CD":"คองโก - กินชาซา","CF":"สาธารณรัฐแอฟริกากลาง","CG":"คองโก - บราซซาวิล","CH":"สวิตเซอร์แลนด์","CI":"โกตดิวัวร์","CK":"หมู่เกาะคุก","CL":"ชิลี","CM":"แคเมอรูน","CN":"จีน","CO":"โคลอมเบีย","CR":"คอสตาริกา","CU":"คิวบา","CV":"เคปเวิร์ด","CW":"คูราเซา","CX":"เกาะคริสต์มาส","CY":"ไซปรัส","CZ":"เช็ก","DE":"เยอรมนี","DJ":"จิบูตี","DK":"เดนมาร์ก","DM":"โดมินิกา","DO":"สาธารณรัฐโดมินิกัน","DZ":"แอลจีเรีย","EC":"เอกวาดอร์","EE":"เอสโตเนีย","EG":"อียิปต์","EH":"ซาฮาราตะวันตก","ER":"เอริเทรีย","ES":"สเปน","ET":"เอธิโอเปีย","FI":"ฟินแลนด์","FJ":"ฟิจิ","FK":"หมู่เกาะฟอล์กแลนด์","FM":"ไมโครนีเซีย","FO":"หมู่เกาะแฟโร","FR":"ฝรั่งเศส","GA":"กาบอง","GB":"สหราชอาณาจักร","GD":"เกรเนดา","GE":"จอร์เจีย","GF":"เฟรนช์เกียนา","GG":"เกิร์นซีย์","GH":"กานา","GI":"ยิบรอลตาร์","GL":"กรีนแลนด์","GM":"แกมเบีย","GN":"กินี","GP":"กวาเดอลูป","GQ":"อิเควทอเรียลกินี","GR":"กรีซ","GS":"เกาะเซาท์จอร์เจียและหมู่เกาะเซาท์แซนด์วิช","GT":"กัวเตมาลา","GU":"กวม","GW":"กินี-บิสเซา","GY":"กายอานา","HK":"เขตปกครองพิเศษฮ่องกงแห่งสาธารณรัฐประชาชนจีน","HM":"เกาะเฮิร์ดและหมู่เกาะแมกดอนัลด์","HN":"ฮอนดูรัส","HR":"โครเอเชีย","HT":"เฮติ","HU":"ฮังการี","ID":"อินโดนีเซีย","IE":"ไอร์แลนด์","IL":"อิสราเอล","IM":"เกาะแมน","IN":"อินเดีย","IO":"บริติชอินเดียนโอเชียนเทร์ริทอรี","IQ":"อิรัก","IR":"อิหร่าน","IS":"ไอซ์แลนด์","IT":"อิตาลี","JE":"เจอร์ซีย์","JM":"จาเมกา","JO":"จอร์แดน","JP":"ญี่ปุ่น","KE":"เคนยา","KG":"คีร์กีซสถาน","KH":"กัมพูชา","KI":"คิริบาส","KM":"คอโมโรส","KN":"เซนต์คิตส์และเนวิส","KP":"เกาหลีเหนือ","KR":"เกาหลีใต้","KW":"คูเวต","KY":"หมู่เกาะเคย์แมน","KZ":"คาซัคสถาน","LA":"ลาว","LB":"เลบานอน","LC":"เซนต์ลูเซีย","LI":"ลิกเตนสไตน์","LK":"ศรีลังกา","LR":"ไลบีเรีย","LS":"เลโซโท","LT":"ลิทัวเนีย","LU":"ลักเซมเบิร์ก","LV":"ลัตเวีย","LY":"ลิเบีย","MA":"โมร็อกโก","MC":"โมนาโก","MD":"มอลโดวา","ME":"มอนเตเนโกร","MF":"เซนต์มาร์ติน","MG":"มาดากัสการ์","MH":"หมู่เกาะมาร์แชลล์","MK":"มาซิโดเนียเหนือ","ML":"มาลี","MM":"เมียนมา (พม่า)","MN":"มองโกเลีย","MO":"เขตปกครองพิเศษมาเก๊าแห่งสาธารณรัฐประชาชนจีน","MP":"หมู่เกาะนอร์เทิร์นมาเรียนา","MQ":"มาร์ตินีก","MR":"มอริเตเนีย","MS":"มอนต์เซอร์รัต","MT":"มอลตา","MU":"มอริเชียส","MV":"มัลดีฟส์","MW":"มาลาวี","MX":"เม็กซิโก","MY":"มาเลเซีย","MZ":"โมซัมบิก","NA":"นามิเบีย","NC":"นิวแคลิโดเนีย","NE":"ไนเจอร์","NF":"เกาะนอร์ฟอล์ก","NG":"ไนจีเรีย","NI":"นิการากัว","NL":"เนเธอร์แลนด์","NO":"นอร์เวย์","NP":"เนปาล","NR":"นาอูรู","NU":"นีอูเอ","NZ":"นิวซีแลนด์","OM":"โอมาน","PA":"ปานามา","PE":"เปรู","PF":"เฟรนช์โปลินีเซีย","PG":"ปาปัวนิวกินี","PH":"ฟิลิปปินส์","PK":"ปากีสถาน","PL":"โปแลนด์","PM":"แซงปีแยร์และมีเกอลง","PN":"หมู่เกาะพิตแคร์น","PR":"เปอร์โตริโก","PS":"ดินแดนปาเลสไตน์","PT":"โปรตุเกส","PW":"ปาเลา","PY":"ปารากวัย","QA":"กาตาร์","RE":"เรอูนียง","RO":"โรมาเนีย","RS":"เซอร์เบีย","RU":"รัสเซีย","RW":"รวันดา","SA":"ซาอุดีอาระเบีย","SB":"หมู่เกาะโซโลมอน","SC":"เซเชลส์","SD":"ซูดาน","SE":"สวีเดน","SG":"สิงคโปร์","SH":"เซนต์เฮเลนา","SI":"สโลวีเนีย","SJ":"สฟาลบาร์และยานไมเอน","SK":"สโลวะเกีย","SL":"เซียร์ราลีโอน","SM":"ซานมาริโน","SN":"เซเนกัล","SO":"โซมาเลีย","SR":"ซูรินาเม","SS":"ซูดานใต้","ST":"เซาตูเมและปรินซิปี","SV":"เอลซัลวาดอร์","SX":"ซินต์มาร์เทน","SY":"ซีเรีย","SZ":"เอสวาตีนี","TC":"หมู่เกาะเติกส์และหมู่เกาะเคคอส","TD":"ชาด","TF":"เฟรนช์เซาเทิร์นเทร์ริทอรีส์","TG":"โตโก","TH":"ไทย","TJ":"ทาจิกิสถาน","TK":"โตเกเลา","TL":"ติมอร์-เลสเต","TM":"เติร์กเมนิสถาน","TN":"ตูนิเซีย","TO":"ตองกา","TR":"ตุรกี","TT":"ตรินิแดดและโตเบโก","TV":"ตูวาลู","TW":"ไต้หวัน","TZ":"แทนซาเนีย","UA":"ยูเครน","UG":"ยูกันดา","UM":"หมู่เกาะรอบนอกของสหรัฐอเมริกา","US":"สหรัฐอเมริกา","UY":"อุรุกวัย","UZ":"อุซเบกิสถาน","VA":"นครวาติกัน","VC":"เซนต์วินเซนต์และเกรนาดีนส์","VE":"เวเนซุเอลา","VG":"หมู่เกาะบริติชเวอร์จิน","VI":"หมู่เกาะเวอร์จินของสหรัฐอเมริกา","VN":"เวียดนาม","VU":"วานูอาตู","WF":"วาลลิสและฟุตูนา","WS":"ซามัว","YE":"เยเมน","YT":"มายอต","ZA":"แอฟริกาใต้","ZM":"แซมเบีย","ZW":"ซิมบับเว"}},
+{"language":"tr","names":{"AD":"Andorra","AE":"Birleşik Arap Emirlikleri","AF":"Afganistan","AG":"Antigua ve Barbuda","AI":"Anguilla","AL":"Arnavutluk","AM":"Ermenistan","AO":"Angola","AQ":"Antarktika","AR":"Arjantin","AS":"Amerikan Samoası","AT":"Avusturya","AU":"Avustralya","AW":"Aruba","AX":"Åland Adaları","AZ":"Azerbaycan","BA":"Bosna-Hersek","BB":"Barbados","BD":"Bangladeş","BE":"Belçika","BF":"Burkina Faso","BG":"Bulgaristan","BH":"Bahreyn","BI":"Burundi","BJ":"Benin","BL":"Saint Barthelemy","BM":"Bermuda","BN":"Brunei","BO":"Bolivya","BQ":"Karayip Hollandası","BR":"Brezilya","BS":"Bahamalar","BT":"Butan","BV":"Bouvet Adası","BW":"Botsvana","BY":"Belarus","BZ":"Belize","CA":"Kanada","CC":"Cocos (Keeling) Adaları","CD":"Kongo - Kinşasa","CF":"Orta Afrika Cumhuriyeti","CG":"Kongo - Brazavil","CH":"İsviçre","CI":"Côte d’Ivoire","CK":"Cook Adaları","CL":"Şili","CM":"Kamerun","CN":"Çin","CO":"Kolombiya","CR":"Kosta Rika","CU":"Küba","CV":"Cape Verde","CW":"Curaçao","CX":"Christmas Adası","CY":"Kıbrıs","CZ":"Çekya","DE":"Almanya","DJ":"Cibuti","DK":"Danimarka","DM":"Dominika","DO":"Dominik Cumhuriyeti","DZ":"Cezayir","EC":"Ekvador","EE":"Estonya","EG":"Mısır","EH":"Batı Sahra","ER":"Eritre","ES":"İspanya","ET":"Etiyopya","FI":"Finlandiya","FJ":"Fiji","FK":"Falkland Adaları","FM":"Mikronezya","FO":"Faroe Adaları","FR":"Fransa","GA":"Gabon","GB":"Birleşik Krallık","GD":"Grenada","GE":"Gürcistan","GF":"Fransız Guyanası","GG":"Guernsey","GH":"Gana","GI":"Cebelitarık","GL":"Grönland","GM":"Gambiya","GN":"Gine","GP":"Guadeloupe","GQ":"Ekvator Ginesi","GR":"Yunanistan","GS":"Güney Georgia ve Güney Sandwich Adaları","GT":"Guatemala","GU":"Guam","GW":"Gine-Bissau","GY":"Guyana","HK":"Çin Hong Kong ÖİB","HM":"Heard Adası ve McDonald Adaları","HN":"Honduras","HR":"Hırvatistan","HT":"Haiti","HU":"Macaristan","ID":"Endonezya","IE":"İrlanda","IL":"İsrail","IM":"Man Adası","IN":"Hindistan","IO":"Britanya Hint Okyanusu Toprakları","IQ":"Irak","IR":"İran","IS":"İzlanda","IT":"İtalya","JE":"Jersey","JM":"Jamaika","JO":"Ürdün","JP":"Japonya","KE":"Kenya","KG":"Kırgızistan","KH":"Kamboçya","KI":"Kiribati","KM":"Komorlar","KN":"Saint Kitts ve Nevis","KP":"Kuzey Kore","KR":"Güney Kore","KW":"Kuveyt","KY":"Cayman Adaları","KZ":"Kazakistan","LA":"Laos","LB":"Lübnan","LC":"Saint Lucia","LI":"Liechtenstein","LK":"Sri Lanka","LR":"Liberya","LS":"Lesotho","LT":"Litvanya","LU":"Lüksemburg","LV":"Letonya","LY":"Libya","MA":"Fas","MC":"Monako","MD":"Moldova","ME":"Karadağ","MF":"Saint Martin","MG":"Madagaskar","MH":"Marshall Adaları","MK":"Kuzey Makedonya","ML":"Mali","MM":"Myanmar (Burma)","MN":"Moğolistan","MO":"Çin Makao ÖİB","MP":"Kuzey Mariana Adaları","MQ":"Martinik","MR":"Moritanya","MS":"Montserrat","MT":"Malta","MU":"Mauritius","MV":"Maldivler","MW":"Malavi","MX":"Meksika","MY":"Malezya","MZ":"Mozambik","NA":"Namibya","NC":"Yeni Kaledonya","NE":"Nijer","NF":"Norfolk Adası","NG":"Nijerya","NI":"Nikaragua","NL":"Hollanda","NO":"Norveç","NP":"Nepal","NR":"Nauru","NU":"Niue","NZ":"Yeni Zelanda","OM":"Umman","PA":"Panama","PE":"Peru","PF":"Fransız Polinezyası","PG":"Papua Yeni Gine","PH":"Filipinler","PK":"Pakistan","PL":"Polonya","PM":"Saint Pierre ve Miquelon","PN":"Pitcairn Adaları","PR":"Porto Riko","PS":"Filistin Bölgeleri","PT":"Portekiz","PW":"Palau","PY":"Paraguay","QA":"Katar","RE":"Reunion","RO":"Romanya","RS":"Sırbistan","RU":"Rusya","RW":"Ruanda","SA":"Suudi Arabistan","SB":"Solomon Adaları","SC":"Seyşeller","SD":"Sudan","SE":"İsveç","SG":"Singapur","SH":"Saint Helena","SI":"Slovenya","SJ":"Svalbard ve Jan Mayen","SK":"Slovakya","SL":"Sierra Leone","SM":"San Marino","SN":"Senegal","SO":"Somali","SR":"Surinam","SS":"Güney Sudan","ST":"Sao Tome ve Principe","SV":"El Salvador","SX":"Sint Maarten","SY":"Suriye","SZ":"Esvatini","TC":"Turks ve Caicos Adaları","TD":"Çad","TF":"Fransız Güney Toprakları","TG":"Togo","TH":"Tayland","TJ":"Tacikistan","TK":"Tokelau","TL":"Timor-Leste","TM":"Türkmenistan","TN":"Tunus","TO":"Tonga","TR":"Türkiye","TT":"Trinidad ve Tobago","TV":"Tuvalu","TW":"Tayvan","TZ":"Tanzanya","UA":"Ukrayna","UG":"Uganda","UM":"ABD Küçük Harici Adaları","US":"Amerika Birleşik Devletleri","UY":"Uruguay","UZ":"Özbekistan","VA":"Vatikan","VC":"Saint Vincent ve Grenadinler","VE":"Venezuela","VG":"Britanya Virjin Adaları","VI":"ABD Virjin Adaları","VN":"Vietnam","VU":"Vanuatu","WF":"Wallis ve Futuna","WS":"Samoa","YE":"Yemen","YT":"Mayotte","ZA":"Güney Afrika","ZM":"Zambiya","ZW":"Zimbabve"}},
+{"language":"uk","names":{"AD":"Андорра","AE":"Обʼєднані Арабські Емірати","AF":"Афганістан","AG":"Антигуа і Барбуда","AI":"Ангілья","AL":"Албанія","AM":"Вірменія","AO":"Ангола","AQ":"Антарктика","AR":"Аргентина","AS":"Американське Самоа","AT":"Австрія","AU":"Австралія","AW":"Аруба","AX":"Аландські Острови","AZ":"Азербайджан","BA":"Боснія і Герцеговина","BB":"Барбадос","BD":"Бангладеш","BE":"Бельгія","BF":"Буркіна-Фасо","BG":"Болгарія","BH":"Бахрейн","BI":"Бурунді","BJ":"Бенін","BL":"Сен-Бартелемі","BM":"Бермудські Острови","BN":"Бруней","BO":"Болівія","BQ":"Карибські Нідерланди","BR":"Бразилія","BS":"Багамські Острови","BT":"Бутан","BV":"Острів Буве","BW":"Ботсвана","BY":"Білорусь","BZ":"Беліз","CA":"Канада","CC":"Кокосові (Кілінг) Острови","CD":"Конго – Кіншаса","CF":"Центральноафриканська Республіка","CG":"Конго – Браззавіль","CH":"Швейцарія","CI":"Кот-дʼІвуар","CK":"Острови Кука","CL":"Чилі","CM":"Камерун","CN":"Китай","CO":"Колумбія","CR":"Коста-Рика","CU":"Куба","CV":"Кабо-Верде","CW":"Кюрасао","CX":"Острів Різдва","CY":"Кіпр","CZ":"Чехія","DE":"Німеччина","DJ":"Джибуті","DK":"Данія","DM":"Домініка","DO":"Домініканська Республіка","DZ":"Алжир","EC":"Еквадор","EE":"Естонія","EG":"Єгипет","EH":"Західна Сахара","ER":"Еритрея","ES":"Іспанія","ET":"Ефіопія","FI":"Фінляндія","FJ":"Фіджі","FK":"Фолклендські Острови","FM":"Мікронезія","FO":"Фарерські Острови","FR":"Франція","GA":"Габон","GB":"Велика Британія","GD":"Гренада","GE":"Грузія","GF":"Французька Гвіана","GG":"Гернсі","GH":"Гана","GI":"Гібралтар","GL":"Гренландія","GM":"Гамбія","GN":"Гвінея","GP":"Гваделупа","GQ":"Екваторіальна Гвінея","GR":"Греція","GS":"Південна Джорджія та Південні Сандвічеві Острови","GT":"Гватемала","GU":"Гуам","GW":"Гвінея-Бісау","GY":"Гаяна","HK":"Гонконг, ОАР Китаю","HM":"Острови Герд і Макдоналд","HN":"Гондурас","HR":"Хорватія","HT":"Гаїті","HU":"Угорщина","ID":"Індонезія","IE":"Ірландія","IL":"Ізраїль","IM":"Острів Мен","IN":"Індія","IO":"Британська територія в Індійському Океані","IQ":"Ірак","IR":"Іран","IS":"Ісландія","IT":"Італія","JE":"Джерсі","JM":"Ямайка","JO":"Йорданія","JP":"Японія","KE":"Кенія","KG":"Киргизстан","KH":"Камбоджа","KI":"Кірибаті","KM":"Комори","KN":"Сент-Кітс і Невіс","KP":"Північна Корея","KR":"Південна Корея","KW":"Кувейт","KY":"Кайманові Острови","KZ":"Казахстан","LA":"Лаос","LB":"Ліван","LC":"Сент-Люсія","LI":"Ліхтенштейн","LK":"Шрі-Ланка","LR":"Ліберія","LS":"Лесото","LT":"Литва","LU":"Люксембург","LV":"Латвія","LY":"Лівія","MA":"Марокко","MC":"Монако","MD":"Молдова","ME":"Чорногорія","MF":"Сен-Мартен","MG":"Мадагаскар","MH":"Маршаллові Острови","MK":"Північна Македонія","ML":"Малі","MM":"Мʼянма (Бірма)","MN":"Монголія","MO":"Макао, ОАР Китаю","MP":"Північні Маріанські Острови","MQ":"Мартиніка","MR":"Мавританія","MS":"Монтсеррат","MT":"Мальта","MU":"Маврикій","MV":"Мальдіви","MW":"Малаві","MX":"Мексика","MY":"Малайзія","MZ":"Мозамбік","NA":"Намібія","NC":"Нова Каледонія","NE":"Нігер","NF":"Острів Норфолк","NG":"Нігерія","NI":"Нікарагуа","NL":"Нідерланди","NO":"Норвегія","NP":"Непал","NR":"Науру","NU":"Ніуе","NZ":"Нова Зеландія","OM":"Оман","PA":"Панама","PE":"Перу","PF":"Французька Полінезія","PG":"Папуа-Нова Гвінея","PH":"Філіппіни","PK":"Пакистан","PL":"Польща","PM":"Сен-Пʼєр і Мікелон","PN":"Острови Піткерн","PR":"Пуерто-Рико","PS":"Палестинські території","PT":"Португалія","PW":"Палау","PY":"Парагвай","QA":"Катар","RE":"Реюньйон","RO":"Румунія","RS":"Сербія","RU":"Росія","RW":"Руанда","SA":"Саудівська Аравія","SB":"Соломонові Острови","SC":"Сейшельські Острови","SD":"Судан","SE":"Швеція","SG":"Сінгапур","SH":"Острів Святої Єлени","SI":"Словенія","SJ":"Шпіцберген та Ян-Маєн","SK":"Словаччина","SL":"Сьєрра-Леоне","SM":"Сан-Марино","SN":"Сенегал","SO":"Сомалі","SR":"Суринам","SS":"Південний Судан","ST":"Сан-Томе і Принсіпі","SV":"Сальвадор","SX":"Сінт-Мартен","SY":"Сирія","SZ":"Есватіні","TC":"Острови Теркс і Кайкос","TD":"Чад","TF":"Французькі Південні Території","TG":"Того","TH":"Таїланд","TJ":"Таджикистан","TK":"Токелау","TL":"Тимор-Лешті","TM":"Туркменістан","TN":"Туніс","TO":"Тонга","TR":"Туреччина","TT":"Тринідад і Тобаго","TV":"Тувалу","TW":"Тайвань","TZ":"Танзанія","UA":"Україна","UG":"Уганда","UM":"Віддалені острови США","US":"Сполучені Штати","UY":"Уругвай","UZ":"Узбекистан","VA":"Ватикан","VC":"Сент-Вінсент і Гренадіни","VE":"Венесуела","VG":"Британські Віргінські острови","VI":"Віргінські Острови (США)","VN":"Вʼєтнам","VU":"Вануату","WF":"Уолліс і Футуна","WS":"Самоа","YE":"Ємен","YT":"Майотта","ZA":"Південно-Африканська Республіка","ZM":"Замбія","ZW":"Зімбабве"}},
+{"language":"ur","names":{"AD":"انڈورا","AE":"متحدہ عرب امارات","AF":"افغانستان","AG":"انٹیگوا اور باربودا","AI":"انگوئیلا","AL":"البانیہ","AM":"آرمینیا","AO":"انگولا","AQ":"انٹارکٹیکا","AR":"ارجنٹینا","AS":"امریکی ساموآ","AT":"آسٹریا","AU":"آسٹریلیا","AW":"اروبا","AX":"آلینڈ آئلینڈز","AZ":"آذربائیجان","BA":"بوسنیا اور ہرزیگووینا","BB":"بارباڈوس","BD":"بنگلہ دیش","BE":"بیلجیم","BF":"برکینا فاسو","BG":"بلغاریہ","BH":"بحرین","BI":"برونڈی","BJ":"بینن","BL":"سینٹ برتھلیمی","BM":"برمودا","BN":"برونائی","BO":"بولیویا","BQ":"کریبیائی نیدرلینڈز","BR":"برازیل","BS":"بہاماس","BT":"بھوٹان","BV":"بؤویٹ آئلینڈ","BW":"بوتسوانا","BY":"بیلاروس","BZ":"بیلائز","CA":"کینیڈا","CC":"کوکوس (کیلنگ) جزائر","CD":"کانگو - کنشاسا","CF":"وسط افریقی جمہوریہ","CG":"کانگو - برازاویلے","CH":"سوئٹزر لینڈ","CI":"کوٹ ڈی آئیوری","CK":"کک آئلینڈز","CL":"چلی","CM":"کیمرون","CN":"چین","CO":"کولمبیا","CR":"کوسٹا ریکا","CU":"کیوبا","CV":"کیپ ورڈی","CW":"کیوراکاؤ","CX":"جزیرہ کرسمس","CY":"قبرص","CZ":"چیکیا","DE":"جرمنی","DJ":"جبوتی","DK":"ڈنمارک","DM":"ڈومنیکا","DO":"جمہوریہ ڈومينيکن","DZ":"الجیریا","EC":"ایکواڈور","EE":"اسٹونیا","EG":"مصر","EH":"مغربی صحارا","ER":"اریٹیریا","ES":"ہسپانیہ","ET":"ایتھوپیا","FI":"فن لینڈ","FJ":"فجی","FK":"فاکلینڈ جزائر","FM":"مائکرونیشیا","FO":"جزائر فارو","FR":"فرانس","GA":"گیبون","GB":"سلطنت متحدہ","GD":"گریناڈا","GE":"جارجیا","GF":"فرینچ گیانا","GG":"گوئرنسی","GH":"گھانا","GI":"جبل الطارق","GL":"گرین لینڈ","GM":"گیمبیا","GN":"گنی","GP":"گواڈیلوپ","GQ":"استوائی گیانا","GR":"یونان","GS":"جنوبی جارجیا اور جنوبی سینڈوچ جزائر","GT":"گواٹے مالا","GU":"گوام","GW":"گنی بساؤ","GY":"گیانا","HK":"ہانگ کانگ SAR چین","HM":"ہیرڈ جزیرہ و میکڈولینڈ جزائر","HN":"ہونڈاروس","HR":"کروشیا","HT":"ہیٹی","HU":"ہنگری","ID":"انڈونیشیا","IE":"آئرلینڈ","IL":"اسرائیل","IM":"آئل آف مین","IN":"بھارت","IO":"برطانوی بحر ہند کا علاقہ","IQ":"عراق","IR":"ایران","IS":"آئس لینڈ","IT":"اٹلی","JE":"جرسی","JM":"جمائیکا","JO":"اردن","JP":"جاپان","KE":"کینیا","KG":"کرغزستان","KH":"کمبوڈیا","KI":"کریباتی","KM":"کوموروس","KN":"سینٹ کٹس اور نیویس","KP":"شمالی کوریا","KR":"جنوبی کوریا","KW":"کویت","KY":"کیمین آئلینڈز","KZ":"قزاخستان","LA":"لاؤس","LB":"لبنان","LC":"سینٹ لوسیا","LI":"لیشٹنسٹائن","LK":"سری لنکا","LR":"لائبیریا","LS":"لیسوتھو","LT":"لیتھونیا","LU":"لکسمبرگ","LV":"لٹویا","LY":"لیبیا","MA":"مراکش","MC":"موناکو","MD":"مالدووا","ME":"مونٹے نیگرو","MF":"سینٹ مارٹن","MG":"مڈغاسکر","MH":"مارشل آئلینڈز","MK":"شمالی مقدونیہ","ML":"مالی","MM":"میانمار (برما)","MN":"منگولیا","MO":"مکاؤ SAR چین","MP":"شمالی ماریانا آئلینڈز","MQ":"مارٹینک","MR":"موریطانیہ","MS":"مونٹسیراٹ","MT":"مالٹا","MU":"ماریشس","MV":"مالدیپ","MW":"ملاوی","MX":"میکسیکو","MY":"ملائشیا","MZ":"موزمبیق","NA":"نامیبیا","NC":"نیو کلیڈونیا","NE":"نائجر","NF":"نارفوک آئلینڈ","NG":"نائجیریا","NI":"نکاراگووا","NL":"نیدر لینڈز","NO":"ناروے","NP":"نیپال","NR":"نؤرو","NU":"نیئو","NZ":"نیوزی لینڈ","OM":"عمان","PA":"پانامہ","PE":"پیرو","PF":"فرانسیسی پولینیشیا","PG":"پاپوآ نیو گنی","PH":"فلپائن","PK":"پاکستان","PL":"پولینڈ","PM":"سینٹ پیئر اور میکلیئون","PN":"پٹکائرن جزائر","PR":"پیورٹو ریکو","PS":"فلسطینی خطے","PT":"پرتگال","PW":"پلاؤ","PY":"پیراگوئے","QA":"قطر","RE":"ری یونین","RO":"رومانیہ","RS":"سربیا","RU":"روس","RW":"روانڈا","SA":"سعودی عرب","SB":"سولومن آئلینڈز","SC":"سشلیز","SD":"سوڈان","SE":"سویڈن","SG":"سنگاپور","SH":"سینٹ ہیلینا","SI":"سلووینیا","SJ":"سوالبرڈ اور جان ماین","SK":"سلوواکیہ","SL":"سیرالیون","SM":"سان مارینو","SN":"سینیگل","SO":"صومالیہ","SR":"سورینام","SS":"جنوبی سوڈان","ST":"ساؤ ٹومے اور پرنسپے","SV":"ال سلواڈور","SX":"سنٹ مارٹن","SY":"شام","SZ":"سواتنی","TC":"ٹرکس اور کیکوس جزائر","TD":"چاڈ","TF":"فرانسیسی جنوبی خطے","TG":"ٹوگو","TH":"تھائی لینڈ","TJ":"تاجکستان","TK":"ٹوکیلاؤ","TL":"تیمور لیسٹ","TM":"ترکمانستان","TN":"تونس","TO":"ٹونگا","TR":"ترکی","TT":"ترینیداد اور ٹوباگو","TV":"ٹووالو","TW":"تائیوان","TZ":"تنزانیہ","UA":"یوکرین","UG":"یوگنڈا","UM":"امریکہ سے باہر کے چھوٹے جزائز","US":"ریاست ہائے متحدہ امریکہ","UY":"یوروگوئے","UZ":"ازبکستان","VA":"ویٹیکن سٹی","VC":"سینٹ ونسنٹ اور گرینیڈائنز","VE":"وینزوئیلا","VG":"برٹش ورجن آئلینڈز","VI":"امریکی ورجن آئلینڈز","VN":"ویتنام","VU":"وینوآٹو","WF":"ویلیز اور فیوٹیونا","WS":"ساموآ","YE":"یمن","YT":"مایوٹ","ZA":"جنوبی افریقہ","ZM":"زامبیا","ZW":"زمبابوے"}},
+{"language":"vi","names":{"AD":"Andorra","AE":"Các Tiểu Vương quốc Ả Rập Thống nhất","AF":"Afghanistan","AG":"Antigua và Barbuda","AI":"Anguilla","AL":"Albania","AM":"Armenia","AO":"Angola","AQ":"Nam Cực","AR":"Argentina","AS":"Samoa thuộc Mỹ","AT":"Áo","AU":"Australia","AW":"Aruba","AX":"Quần đảo Åland","AZ":"Azerbaijan","BA":"Bosnia và Herzegovina","BB":"Barbados","BD":"Bangladesh","BE":"Bỉ","BF":"Burkina Faso","BG":"Bulgaria","BH":"Bahrain","BI":"Burundi","BJ":"Benin","BL":"St. Barthélemy","BM":"Bermuda","BN":"Brunei","BO":"Bolivia","BQ":"Ca-ri-bê Hà Lan","BR":"Brazil","BS":"Bahamas","BT":"Bhutan","BV":"Đảo Bouvet","BW":"Botswana","BY":"Belarus","BZ":"Belize","CA":"Canada","CC":"Quần đảo Cocos (Keeling)","CD":"Congo - Kinshasa","CF":"Cộng hòa Trung Phi","CG":"Congo - Brazzaville","CH":"Thụy Sĩ","CI":"Côte d’Ivoire","CK":"Quần đảo Cook","CL":"Chile","CM":"Cameroon","CN":"Trung Quốc","CO":"Colombia","CR":"Costa Rica","CU":"Cuba","CV":"Cape Verde","CW":"Curaçao","CX":"Đảo Giáng Sinh","CY":"Síp","CZ":"Séc","DE":"Đức","DJ":"Djibouti","DK":"Đan Mạch","DM":"Dominica","DO":"Cộng hòa Dominica","DZ":"Algeria","EC":"Ecuador","EE":"Estonia","EG":"Ai Cập","EH":"Tây Sahara","ER":"Eritrea","ES":"Tây Ban Nha","ET":"Ethiopia","FI":"Phần Lan","FJ":"Fiji","FK":"Quần đảo Falkland","FM":"Micronesia","FO":"Quần đảo Faroe","FR":"Pháp","GA":"Gabon","GB":"Vương quốc Anh","GD":"Grenada","GE":"Georgia","GF":"Guiana thuộc Pháp","GG":"Guernsey","GH":"Ghana","GI":"Gibraltar","GL":"Greenland","GM":"Gambia","GN":"Guinea","GP":"Guadeloupe","GQ":"Guinea Xích Đạo","GR":"Hy Lạp","GS":"Nam Georgia & Quần đảo Nam Sandwich","GT":"Guatemala","GU":"Guam","GW":"Guinea-Bissau","GY":"Guyana","HK":"Đặc khu Hành chính Hồng Kông, Trung Quốc","HM":"Quần đảo Heard và McDonald","HN":"Honduras","HR":"Croatia","HT":"Haiti","HU":"Hungary","ID":"Indonesia","IE":"Ireland","IL":"Israel","IM":"Đảo Man","IN":"Ấn Độ","IO":"Lãnh thổ Ấn Độ Dương thuộc Anh","IQ":"Iraq","IR":"Iran","IS":"Iceland","IT":"Italy","JE":"Jersey","JM":"Jamaica","JO":"Jordan","JP":"Nhật Bản","KE":"Kenya","KG":"Kyrgyzstan","KH":"Campuchia","KI":"Kiribati","KM":"Comoros","KN":"St. Kitts và Nevis","KP":"Triều Tiên","KR":"Hàn Quốc","KW":"Kuwait","KY":"Quần đảo Cayman","KZ":"Kazakhstan","LA":"Lào","LB":"Li-băng","LC":"St. Lucia","LI":"Liechtenstein","LK":"Sri Lanka","LR":"Liberia","LS":"Lesotho","LT":"Litva","LU":"Luxembourg","LV":"Latvia","LY":"Libya","MA":"Ma-rốc","MC":"Monaco","MD":"Moldova","ME":"Montenegro","MF":"St. Martin","MG":"Madagascar","MH":"Quần đảo Marshall","MK":"Bắc Macedonia","ML":"Mali","MM":"Myanmar (Miến Điện)","MN":"Mông Cổ","MO":"Đặc khu Hành chính Macao, Trung Quốc","MP":"Quần đảo Bắc Mariana","MQ":"Martinique","MR":"Mauritania","MS":"Montserrat","MT":"Malta","MU":"Mauritius","MV":"Maldives","MW":"Malawi","MX":"Mexico","MY":"Malaysia","MZ":"Mozambique","NA":"Namibia","NC":"New Caledonia","NE":"Niger","NF":"Đảo Norfolk","NG":"Nigeria","NI":"Nicaragua","NL":"Hà Lan","NO":"Na Uy","NP":"Nepal","NR":"Nauru","NU":"Niue","NZ":"New Zealand","OM":"Oman","PA":"Panama","PE":"Peru","PF":"Polynesia thuộc Pháp","PG":"Papua New Guinea","PH":"Philippines","PK":"Pakistan","PL":"Ba Lan","PM":"Saint Pierre và Miquelon","PN":"Quần đảo Pitcairn","PR":"Puerto Rico","PS":"Lãnh thổ Palestine","PT":"Bồ Đào Nha","PW":"Palau","PY":"Paraguay","QA":"Qatar","RE":"Réunion","RO":"Romania","RS":"Serbia","RU":"Nga","RW":"Rwanda","SA":"Ả Rập Xê-út","SB":"Quần đảo Solomon","SC":"Seychelles","SD":"Sudan","SE":"Thụy Điển","SG":"Singapore","SH":"St. Helena","SI":"Slovenia","SJ":"Svalbard và Jan Mayen","SK":"Slovakia","SL":"Sierra Leone","SM":"San Marino","SN":"Senegal","SO":"Somalia","SR":"Suriname","SS":"Nam Sudan","ST":"São Tomé và Príncipe","SV":"El Salvador","SX":"Sint Maarten","SY":"Syria","SZ":"Eswatini","TC":"Quần đảo Turks và Caicos","TD":"Chad","TF":"Lãnh thổ phía Nam Thuộc Pháp","TG":"Togo","TH":"Thái Lan","TJ":"Tajikistan","TK":"Tokelau","TL":"Timor-Leste","TM":"Turkmenistan","TN":"Tunisia","TO":"Tonga","TR":"Thổ Nhĩ Kỳ","TT":"Trinidad và Tobago","TV":"Tuvalu","TW":"Đài Loan","TZ":"Tanzania","UA":"Ukraina","UG":"Uganda","UM":"Các tiểu đảo xa của Hoa Kỳ","US":"Hoa Kỳ","UY":"Uruguay","UZ":"Uzbekistan","VA":"Thành Vatican","VC":"St. Vincent và Grenadines","VE":"Venezuela","VG":"Quần đảo Virgin thuộc Anh","VI":"Quần đảo Virgin thuộc Hoa Kỳ","VN":"Việt Nam","VU":"Vanuatu","WF":"Wallis và Futuna","WS":"Samoa","YE":"Yemen","YT":"Mayotte","ZA":"Nam Phi","ZM":"Zambia","ZW":"Zimbabwe"}},
+{"language":"zh","names":{"AD":"安道尔","AE":"阿拉伯联合酋长国","AF":"阿富汗","AG":"安提瓜和巴布达","AI":"安圭拉","AL":"阿尔巴尼亚","AM":"亚美尼亚","AO":"安哥拉","AQ":"南极洲","AR":"阿根廷","AS":"美属萨摩亚","AT":"奥地利","AU":"澳大利亚","AW":"阿鲁巴","AX":"奥兰群岛","AZ":"阿塞拜疆","BA":"波斯尼亚和黑塞哥维那","BB":"巴巴多斯","BD":"孟加拉国","BE":"比利时","BF":"布基纳法索","BG":"保加利亚","BH":"巴林","BI":"布隆迪","BJ":"贝宁","BL":"圣巴泰勒米","BM":"百慕大","BN":"文莱","BO":"玻利维亚","BQ":"荷属加勒比区","BR":"巴西","BS":"巴哈马","BT":"不丹","BV":"布韦岛","BW":"博茨瓦纳","BY":"白俄罗斯","BZ":"伯利兹","CA":"加拿大","CC":"科科斯（基林）群岛","CD":"刚果（金）","CF":"中非共和国","CG":"刚果（布）","CH":"瑞士","CI":"科特迪瓦","CK":"库克群岛","CL":"智利","CM":"喀麦隆","CN":"中国","CO":"哥伦比亚","CR":"哥斯达黎加","CU":"古巴","CV":"佛得角","CW":"库拉索","CX":"圣诞岛","CY":"塞浦路斯","CZ":"捷克","DE":"德国","DJ":"吉布提","DK":"丹麦","DM":"多米尼克","DO":"多米尼加共和国","DZ":"阿尔及利亚","EC":"厄瓜多尔","EE":"爱沙尼亚","EG":"埃及","EH":"西撒哈拉","ER":"厄立特里亚","ES":"西班牙","ET":"埃塞俄比亚","FI":"芬兰","FJ":"斐济","FK":"福克兰群岛","FM":"密克罗尼西亚","FO":"法罗群岛","FR":"法国","GA":"加蓬","GB":"英国","GD":"格林纳达","GE":"格鲁吉亚","GF":"法属圭亚那","GG":"根西岛","GH":"加纳","GI":"直布罗陀","GL":"格陵兰","GM":"冈比亚","GN":"几内亚","GP":"瓜德罗普","GQ":"赤道几内亚","GR":"希腊","GS":"南乔治亚和南桑威奇群岛","GT":"危地马拉","GU":"关岛","GW":"几内亚比绍","GY":"圭亚那","HK":"中国香港特别行政区","HM":"赫德岛和麦克唐纳群岛","HN":"洪都拉斯","HR":"克罗地亚","HT":"海地","HU":"匈牙利","ID":"印度尼西亚","IE":"爱尔兰","IL":"以色列","IM":"马恩岛","IN":"印度","IO":"英属印度洋领地","IQ":"伊拉克","IR":"伊朗","IS":"冰岛","IT":"意大利","JE":"泽西岛","JM":"牙买加","JO":"约旦","JP":"日本","KE":"肯尼亚","KG":"吉尔吉斯斯坦","KH":"柬埔寨","KI":"基里巴斯","KM":"科摩罗","KN":"圣基茨和尼维斯","KP":"朝鲜","KR":"韩国","KW":"科威特","KY":"开曼群岛","KZ":"哈萨克斯坦","LA":"老挝","LB":"黎巴嫩","LC":"圣卢西亚","LI":"列支敦士登","LK":"斯里兰卡","LR":"利比里亚","LS":"莱索托","LT":"立陶宛","LU":"卢森堡","LV":"拉脱维亚","LY":"利比亚","MA":"摩洛哥","MC":"摩纳哥","MD":"摩尔多瓦","ME":"黑山","MF":"法属圣马丁","MG":"马达加斯加","MH":"马绍尔群岛","MK":"北马其顿","ML":"马里","MM":"缅甸","MN":"蒙古","MO":"中国澳门特别行政区","MP":"北马里亚纳群岛","MQ":"马提尼克","MR":"毛里塔尼亚","MS":"蒙特塞拉特","MT":"马耳他","MU":"毛里求斯","MV":"马尔代夫","MW":"马拉维","MX":"墨西哥","MY":"马来西亚","MZ":"莫桑比克","NA":"纳米比亚","NC":"新喀里多尼亚","NE":"尼日尔","NF":"诺福克岛","NG":"尼日利亚","NI":"尼加拉瓜","NL":"荷兰","NO":"挪威","NP":"尼泊尔","NR":"瑙鲁","NU":"纽埃","NZ":"新西兰","OM":"阿曼","PA":"巴拿马","PE":"秘鲁","PF":"法属波利尼西亚","PG":"巴布亚新几内亚","PH":"菲律宾","PK":"巴基斯坦","PL":"波兰","PM":"圣皮埃尔和密克隆群岛","PN":"皮特凯恩群岛","PR":"波多黎各","PS":"巴勒斯坦领土","PT":"葡萄牙","PW":"帕劳","PY":"巴拉圭","QA":"卡塔尔","RE":"留尼汪","RO":"罗马尼亚","RS":"塞尔维亚","RU":"俄罗斯","RW":"卢旺达","SA":"沙特阿拉伯","SB":"所罗门群岛","SC":"塞舌尔","SD":"苏丹","SE":"瑞典","SG":"新加坡","SH":"圣赫勒拿","SI":"斯洛文尼亚","SJ":"斯瓦尔巴和扬马延","SK":"斯洛伐克","SL":"塞拉利昂","SM":"圣马力诺","SN":"塞内加尔","SO":"索马里","SR":"苏里南","SS":"南苏丹","ST":"圣多美和普林西比","SV":"萨尔瓦多","SX":"荷属圣马丁","SY":"叙利亚","SZ":"斯威士兰","TC":"特克斯和凯科斯群岛","TD":"乍得","TF":"法属南部领地","TG":"多哥","TH":"泰国","TJ":"塔吉克斯坦","TK":"托克劳","TL":"东帝汶","TM":"土库曼斯坦","TN":"突尼斯","TO":"汤加","TR":"土耳其","TT":"特立尼达和多巴哥","TV":"图瓦卢","TW":"台湾","TZ":"坦桑尼亚","UA":"乌克兰","UG":"乌干达","UM":"美国本土外小岛屿","US":"美国","UY":"乌拉圭","UZ":"乌兹别克斯坦","VA":"梵蒂冈","VC":"圣文森特和格林纳丁斯","VE":"委内瑞拉","VG":"英属维尔京群岛","VI":"美属维尔京群岛","VN":"越南","VU":"瓦努阿图","WF":"瓦利斯和富图纳","WS":"萨摩亚","YE":"也门","YT":"马约特","ZA":"南非","ZM":"赞比亚","ZW":"津巴布韦"}},
+{"language":"zh-Hant","names":{"AD":"安道爾","AE":"阿拉伯聯合大公國","AF":"阿富汗","AG":"安地卡及巴布達","AI":"安奎拉","AL":"阿爾巴尼亞","AM":"亞美尼亞","AO":"安哥拉","AQ":"南極洲","AR":"阿根廷","AS":"美屬薩摩亞","AT":"奧地利","AU":"澳洲","AW":"荷屬阿魯巴","AX":"奧蘭群島","AZ":"亞塞拜然","BA":"波士尼亞與赫塞哥維納","BB":"巴貝多","BD":"孟加拉","BE":"比利時","BF":"布吉納法索","BG":"保加利亞","BH":"巴林","BI":"蒲隆地","BJ":"貝南","BL":"聖巴瑟米","BM":"百慕達","BN":"汶萊","BO":"玻利維亞","BQ":"荷蘭加勒比區","BR":"巴西","BS":"巴哈馬","BT":"不丹","BV":"布威島","BW":"波札那","BY":"白俄羅斯","BZ":"貝里斯","CA":"加拿大","CC":"科克斯（基靈）群島","CD":"剛果（金夏沙）","CF":"中非共和國","CG":"剛果（布拉薩）","CH":"瑞士","CI":"象牙海岸","CK":"庫克群島","CL":"智利","CM":"喀麥隆","CN":"中國","CO":"哥倫比亞","CR":"哥斯大黎加","CU":"古巴","CV":"維德角","CW":"庫拉索","CX":"聖誕島","CY":"賽普勒斯","CZ":"捷克","DE":"德國","DJ":"吉布地","DK":"丹麥","DM":"多米尼克","DO":"多明尼加共和國","DZ":"阿爾及利亞","EC":"厄瓜多","EE":"愛沙尼亞","EG":"埃及","EH":"西撒哈拉","ER":"厄利垂亞","ES":"西班牙","ET":"衣索比亞","FI":"芬蘭","FJ":"斐濟","FK":"福克蘭群島","FM":"密克羅尼西亞","FO":"法羅群島","FR":"法國","GA":"加彭","GB":"英國","GD":"格瑞那達","GE":"喬治亞","GF":"法屬圭亞那","GG":"根息","GH":"迦納","GI":"直布羅陀","GL":"格陵蘭","GM":"甘比亞","GN":"幾內亞","GP":"瓜地洛普","GQ":"赤道幾內亞","GR":"希臘","GS":"南喬治亞與南三明治群島","GT":"瓜地馬拉","GU":"關島","GW":"幾內亞比索","GY":"蓋亞那","HK":"中國香港特別行政區","HM":"赫德島及麥唐納群島","HN":"宏都拉斯","HR":"克羅埃西亞","HT":"海地","HU":"匈牙利","ID":"印尼","IE":"愛爾蘭","IL":"以色列","IM":"曼島","IN":"印度","IO":"英屬印度洋領地","IQ":"伊拉克","IR":"伊朗","IS":"冰島","IT":"義大利","JE":"澤西島","JM":"牙買加","JO":"約旦","JP":"日本","KE":"肯亞","KG":"吉爾吉斯","KH":"柬埔寨","KI":"吉里巴斯","KM":"葛摩","KN":"聖克里斯多福及尼維斯","KP":"北韓","KR":"南韓","KW":"科威特","KY":"開曼群島","KZ":"哈薩克","LA":"寮國","LB":"黎巴嫩","LC":"聖露西亞","LI":"列支敦斯登","LK":"斯里蘭卡","LR":"賴比瑞亞","LS":"賴索托","LT":"立陶宛","LU":"盧森堡","LV":"拉脫維亞","LY":"利比亞","MA":"摩洛哥","MC":"摩納哥","MD":"摩爾多瓦","ME":"蒙特內哥羅","MF":"法屬聖馬丁","MG":"馬達加斯加","MH":"馬紹爾群島","MK":"北馬其頓","ML":"馬利","MM":"緬甸","MN":"蒙古","MO":"中國澳門特別行政區","MP":"北馬利安納群島","MQ":"馬丁尼克","MR":"茅利塔尼亞","MS":"蒙哲臘","MT":"馬爾他","MU":"模里西斯","MV":"馬爾地夫","MW":"馬拉威","MX":"墨西哥","MY":"馬來西亞","MZ":"莫三比克","NA":"納米比亞","NC":"新喀里多尼亞","NE":"尼日","NF":"諾福克島","NG":"奈及利亞","NI":"尼加拉瓜","NL":"荷蘭","NO":"挪威","NP":"尼泊爾","NR":"諾魯","NU":"紐埃島","NZ":"紐西蘭","OM":"阿曼","PA":"巴拿馬","PE":"秘魯","PF":"法屬玻里尼西亞","PG":"巴布亞紐幾內亞","PH":"菲律賓","PK":"巴基斯坦","PL":"波蘭","PM":"聖皮埃與密克隆群島","PN":"皮特肯群島","PR":"波多黎各","PS":"巴勒斯坦自治區","PT":"葡萄牙","PW":"帛琉","PY":"巴拉圭","QA":"卡達","RE":"留尼旺","RO":"羅馬尼亞","RS":"塞爾維亞","RU":"俄羅斯","RW":"盧安達","SA":"沙烏地阿拉伯","SB":"索羅門群島","SC":"塞席爾","SD":"蘇丹","SE":"瑞典","SG":"新加坡","SH":"聖赫勒拿島","SI":"斯洛維尼亞","SJ":"挪威屬斯瓦巴及尖棉","SK":"斯洛伐克","SL":"獅子山","SM":"聖馬利諾","SN":"塞內加爾","SO":"索馬利亞","SR":"蘇利南","SS":"南蘇丹","ST":"聖多美普林西比","SV":"薩爾瓦多","SX":"荷屬聖馬丁","SY":"敘利亞","SZ":"史瓦帝尼","TC":"土克斯及開科斯群島","TD":"查德","TF":"法屬南部屬地","TG":"多哥","TH":"泰國","TJ":"塔吉克","TK":"托克勞群島","TL":"東帝汶","TM":"土庫曼","TN":"突尼西亞","TO":"東加","TR":"土耳其","TT":"千里達及托巴哥","TV":"吐瓦魯","TW":"台灣","TZ":"坦尚尼亞","UA":"烏克蘭","UG":"烏干達","UM":"美國本土外小島嶼","US":"美國","UY":"烏拉圭","UZ":"烏茲別克","VA":"梵蒂岡","VC":"聖文森及格瑞那丁","VE":"委內瑞拉","VG":"英屬維京群島","VI":"美屬維京群島","VN":"越南","VU":"萬那杜","WF":"瓦利斯群島和富圖那群島","WS":"薩摩亞","YE":"葉門","YT":"馬約特島","ZA":"南非","ZM":"尚比亞","ZW":"辛巴威"}}
+]`
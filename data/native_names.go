@@ -0,0 +1,266 @@
+package data
+
+// EXAMPLE DATA
+/*
+  {
+    "countryCode":"BE",
+    "names":[{"language":"nl","name":"België"},{"language":"de","name":"Belgien"},{"language":"fr","name":"Belgique"}]
+  }
+*/
+
+// NativeNameJSONData is the raw JSON for the native names (endonyms) of every country
+// Every name is the name of the country in one of its official languages, as written in that country
+// (e.g., "Schweiz" and "Suisse" for Switzerland, "台灣" in traditional script for Taiwan).
+// Languages are lowercase ISO 639 codes and follow the order of the official languages of the country.
+// Countries whose official languages have no CLDR data (e.g., Antarctica, the Maldives) are not listed.
+// Source: Unicode CLDR (via golang.org/x/text/language/display), updated for Eswatini and North Macedonia,
+// with the Papiamentu name of Curaçao ("Kòrsou"), which CLDR does not cover.
+const NativeNameJSONData = `[
+{"countryCode":"AD","names":[{"language":"ca","name":"Andorra"}]},
+{"countryCode":"AE","names":[{"language":"ar","name":"الإمارات العربية المتحدة"}]},
+{"countryCode":"AF","names":[{"language":"ps","name":"افغانستان"},{"language":"tk","name":"Owganystan"}]},
+{"countryCode":"AG","names":[{"language":"en","name":"Antigua & Barbuda"}]},
+{"countryCode":"AI","names":[{"language":"en","name":"Anguilla"}]},
+{"countryCode":"AL","names":[{"language":"sq","name":"Shqipëri"}]},
+{"countryCode":"AM","names":[{"language":"hy","name":"Հայաստան"},{"language":"ru","name":"Армения"}]},
+{"countryCode":"AO","names":[{"language":"pt","name":"Angola"}]},
+{"countryCode":"AR","names":[{"language":"es","name":"Argentina"}]},
+{"countryCode":"AS","names":[{"language":"en","name":"American Samoa"}]},
+{"countryCode":"AT","names":[{"language":"de","name":"Österreich"}]},
+{"countryCode":"AU","names":[{"language":"en","name":"Australia"}]},
+{"countryCode":"AW","names":[{"language":"nl","name":"Aruba"}]},
+{"countryCode":"AX","names":[{"language":"sv","name":"Åland"}]},
+{"countryCode":"AZ","names":[{"language":"az","name":"Azərbaycan"},{"language":"ru","name":"Азербайджан"}]},
+{"countryCode":"BA","names":[{"language":"bs","name":"Bosna i Hercegovina"},{"language":"sr","name":"Босна и Херцеговина"}]},
+{"countryCode":"BB","names":[{"language":"en","name":"Barbados"}]},
+{"countryCode":"BD","names":[{"language":"bn","name":"বাংলাদেশ"}]},
+{"countryCode":"BE","names":[{"language":"nl","name":"België"},{"language":"de","name":"Belgien"},{"language":"fr","name":"Belgique"}]},
+{"countryCode":"BF","names":[{"language":"fr","name":"Burkina Faso"}]},
+{"countryCode":"BG","names":[{"language":"bg","name":"България"}]},
+{"countryCode":"BH","names":[{"language":"ar","name":"البحرين"}]},
+{"countryCode":"BI","names":[{"language":"rn","name":"Uburundi"},{"language":"fr","name":"Burundi"}]},
+{"countryCode":"BJ","names":[{"language":"fr","name":"Bénin"}]},
+{"countryCode":"BL","names":[{"language":"fr","name":"Saint-Barthélemy"}]},
+{"countryCode":"BM","names":[{"language":"en","name":"Bermuda"}]},
+{"countryCode":"BN","names":[{"language":"ms","name":"Brunei"}]},
+{"countryCode":"BO","names":[{"language":"es","name":"Bolivia"}]},
+{"countryCode":"BQ","names":[{"language":"nl","name":"Caribisch Nederland"},{"language":"en","name":"Caribbean Netherlands"}]},
+{"countryCode":"BR","names":[{"language":"pt","name":"Brasil"}]},
+{"countryCode":"BS","names":[{"language":"en","name":"Bahamas"}]},
+{"countryCode":"BT","names":[{"language":"dz","name":"འབྲུག"}]},
+{"countryCode":"BV","names":[{"language":"no","name":"Bouvetøya"}]},
+{"countryCode":"BW","names":[{"language":"en","name":"Botswana"}]},
+{"countryCode":"BY","names":[{"language":"be","name":"Беларусь"}]},
+{"countryCode":"BZ","names":[{"language":"en","name":"Belize"},{"language":"es","name":"Belice"}]},
+{"countryCode":"CA","names":[{"language":"en","name":"Canada"}]},
+{"countryCode":"CC","names":[{"language":"en","name":"Cocos (Keeling) Islands"}]},
+{"countryCode":"CD","names":[{"language":"sw","name":"Jamhuri ya Kidemokrasia ya Kongo"},{"language":"fr","name":"Congo-Kinshasa"},{"language":"ln","name":"Republíki ya Kongó Demokratíki"}]},
+{"countryCode":"CF","names":[{"language":"fr","name":"République centrafricaine"},{"language":"sg","name":"Ködörösêse tî Bêafrîka"}]},
+{"countryCode":"CG","names":[{"language":"fr","name":"Congo-Brazzaville"},{"language":"ln","name":"Kongo"}]},
+{"countryCode":"CH","names":[{"language":"de","name":"Schweiz"},{"language":"fr","name":"Suisse"},{"language":"gsw","name":"Schwiiz"},{"language":"it","name":"Svizzera"},{"language":"rm","name":"Svizra"}]},
+{"countryCode":"CI","names":[{"language":"fr","name":"Côte d’Ivoire"}]},
+{"countryCode":"CK","names":[{"language":"en","name":"Cook Islands"}]},
+{"countryCode":"CL","names":[{"language":"es","name":"Chile"}]},
+{"countryCode":"CM","names":[{"language":"fr","name":"Cameroun"},{"language":"en","name":"Cameroon"}]},
+{"countryCode":"CN","names":[{"language":"zh","name":"中国"}]},
+{"countryCode":"CO","names":[{"language":"es","name":"Colombia"}]},
+{"countryCode":"CR","names":[{"language":"es","name":"Costa Rica"}]},
+{"countryCode":"CU","names":[{"language":"es","name":"Cuba"}]},
+{"countryCode":"CV","names":[{"language":"pt","name":"Cabo Verde"}]},
+{"countryCode":"CW","names":[{"language":"pap","name":"Kòrsou"},{"language":"en","name":"Curaçao"},{"language":"nl","name":"Curaçao"}]},
+{"countryCode":"CX","names":[{"language":"en","name":"Christmas Island"}]},
+{"countryCode":"CY","names":[{"language":"el","name":"Κύπρος"},{"language":"tr","name":"Kıbrıs"}]},
+{"countryCode":"CZ","names":[{"language":"cs","name":"Česko"}]},
+{"countryCode":"DE","names":[{"language":"de","name":"Deutschland"}]},
+{"countryCode":"DJ","names":[{"language":"ar","name":"جيبوتي"},{"language":"fr","name":"Djibouti"}]},
+{"countryCode":"DK","names":[{"language":"da","name":"Danmark"}]},
+{"countryCode":"DM","names":[{"language":"en","name":"Dominica"}]},
+{"countryCode":"DO","names":[{"language":"es","name":"República Dominicana"}]},
+{"countryCode":"DZ","names":[{"language":"ar","name":"الجزائر"}]},
+{"countryCode":"EC","names":[{"language":"es","name":"Ecuador"}]},
+{"countryCode":"EE","names":[{"language":"et","name":"Eesti"}]},
+{"countryCode":"EG","names":[{"language":"ar","name":"مصر"}]},
+{"countryCode":"EH","names":[{"language":"es","name":"Sáhara Occidental"}]},
+{"countryCode":"ER","names":[{"language":"ti","name":"ኤርትራ"},{"language":"ar","name":"إريتريا"},{"language":"en","name":"Eritrea"}]},
+{"countryCode":"ES","names":[{"language":"es","name":"España"},{"language":"ca","name":"Espanya"},{"language":"eu","name":"Espainia"}]},
+{"countryCode":"ET","names":[{"language":"am","name":"ኢትዮጵያ"}]},
+{"countryCode":"FI","names":[{"language":"fi","name":"Suomi"},{"language":"sv","name":"Finland"}]},
+{"countryCode":"FJ","names":[{"language":"en","name":"Fiji"}]},
+{"countryCode":"FK","names":[{"language":"en","name":"Falkland Islands"}]},
+{"countryCode":"FM","names":[{"language":"en","name":"Micronesia"}]},
+{"countryCode":"FO","names":[{"language":"fo","name":"Føroyar"},{"language":"da","name":"Færøerne"}]},
+{"countryCode":"FR","names":[{"language":"fr","name":"France"}]},
+{"countryCode":"GA","names":[{"language":"fr","name":"Gabon"}]},
+{"countryCode":"GB","names":[{"language":"en","name":"United Kingdom"}]},
+{"countryCode":"GD","names":[{"language":"en","name":"Grenada"}]},
+{"countryCode":"GE","names":[{"language":"ka","name":"საქართველო"}]},
+{"countryCode":"GF","names":[{"language":"fr","name":"Guyane française"}]},
+{"countryCode":"GG","names":[{"language":"en","name":"Guernsey"},{"language":"fr","name":"Guernesey"}]},
+{"countryCode":"GH","names":[{"language":"en","name":"Ghana"}]},
+{"countryCode":"GI","names":[{"language":"en","name":"Gibraltar"}]},
+{"countryCode":"GL","names":[{"language":"kl","name":"Kalaallit Nunaat"}]},
+{"countryCode":"GM","names":[{"language":"en","name":"Gambia"}]},
+{"countryCode":"GN","names":[{"language":"fr","name":"Guinée"}]},
+{"countryCode":"GP","names":[{"language":"fr","name":"Guadeloupe"}]},
+{"countryCode":"GQ","names":[{"language":"es","name":"Guinea Ecuatorial"},{"language":"fr","name":"Guinée équatoriale"},{"language":"pt","name":"Guiné Equatorial"}]},
+{"countryCode":"GR","names":[{"language":"el","name":"Ελλάδα"}]},
+{"countryCode":"GS","names":[{"language":"en","name":"South Georgia & South Sandwich Islands"}]},
+{"countryCode":"GT","names":[{"language":"es","name":"Guatemala"}]},
+{"countryCode":"GU","names":[{"language":"en","name":"Guam"}]},
+{"countryCode":"GW","names":[{"language":"pt","name":"Guiné-Bissau"}]},
+{"countryCode":"GY","names":[{"language":"en","name":"Guyana"}]},
+{"countryCode":"HK","names":[{"language":"zh","name":"中國香港特別行政區"},{"language":"en","name":"Hong Kong SAR China"}]},
+{"countryCode":"HM","names":[{"language":"en","name":"Heard & McDonald Islands"}]},
+{"countryCode":"HN","names":[{"language":"es","name":"Honduras"}]},
+{"countryCode":"HR","names":[{"language":"hr","name":"Hrvatska"}]},
+{"countryCode":"HT","names":[{"language":"fr","name":"Haïti"}]},
+{"countryCode":"HU","names":[{"language":"hu","name":"Magyarország"}]},
+{"countryCode":"ID","names":[{"language":"id","name":"Indonesia"}]},
+{"countryCode":"IE","names":[{"language":"en","name":"Ireland"},{"language":"ga","name":"Éire"}]},
+{"countryCode":"IL","names":[{"language":"he","name":"ישראל"},{"language":"ar","name":"إسرائيل"}]},
+{"countryCode":"IM","names":[{"language":"en","name":"Isle of Man"},{"language":"gv","name":"Ellan Vannin"}]},
+{"countryCode":"IN","names":[{"language":"hi","name":"भारत"},{"language":"en","name":"India"},{"language":"ta","name":"இந்தியா"}]},
+{"countryCode":"IO","names":[{"language":"en","name":"British Indian Ocean Territory"}]},
+{"countryCode":"IQ","names":[{"language":"ar","name":"العراق"},{"language":"ckb","name":"عێراق"}]},
+{"countryCode":"IR","names":[{"language":"fa","name":"ایران"}]},
+{"countryCode":"IS","names":[{"language":"is","name":"Ísland"}]},
+{"countryCode":"IT","names":[{"language":"it","name":"Italia"},{"language":"de","name":"Italien"}]},
+{"countryCode":"JE","names":[{"language":"en","name":"Jersey"}]},
+{"countryCode":"JM","names":[{"language":"en","name":"Jamaica"}]},
+{"countryCode":"JO","names":[{"language":"ar","name":"الأردن"}]},
+{"countryCode":"JP","names":[{"language":"ja","name":"日本"}]},
+{"countryCode":"KE","names":[{"language":"sw","name":"Kenya"}]},
+{"countryCode":"KG","names":[{"language":"ky","name":"Кыргызстан"},{"language":"ru","name":"Киргизия"}]},
+{"countryCode":"KH","names":[{"language":"km","name":"កម្ពុជា"}]},
+{"countryCode":"KI","names":[{"language":"en","name":"Kiribati"}]},
+{"countryCode":"KM","names":[{"language":"ar","name":"جزر القمر"},{"language":"fr","name":"Comores"}]},
+{"countryCode":"KN","names":[{"language":"en","name":"St Kitts & Nevis"}]},
+{"countryCode":"KP","names":[{"language":"ko","name":"조선민주주의인민공화국"}]},
+{"countryCode":"KR","names":[{"language":"ko","name":"대한민국"}]},
+{"countryCode":"KW","names":[{"language":"ar","name":"الكويت"}]},
+{"countryCode":"KY","names":[{"language":"en","name":"Cayman Islands"}]},
+{"countryCode":"KZ","names":[{"language":"ru","name":"Казахстан"},{"language":"kk","name":"Қазақстан"}]},
+{"countryCode":"LA","names":[{"language":"lo","name":"ລາວ"}]},
+{"countryCode":"LB","names":[{"language":"ar","name":"لبنان"},{"language":"fr","name":"Liban"}]},
+{"countryCode":"LC","names":[{"language":"en","name":"St Lucia"}]},
+{"countryCode":"LI","names":[{"language":"de","name":"Liechtenstein"}]},
+{"countryCode":"LK","names":[{"language":"si","name":"ශ්‍රී ලංකාව"},{"language":"ta","name":"இலங்கை"}]},
+{"countryCode":"LR","names":[{"language":"en","name":"Liberia"}]},
+{"countryCode":"LS","names":[{"language":"en","name":"Lesotho"}]},
+{"countryCode":"LT","names":[{"language":"lt","name":"Lietuva"}]},
+{"countryCode":"LU","names":[{"language":"fr","name":"Luxembourg"},{"language":"de","name":"Luxemburg"},{"language":"lb","name":"Lëtzebuerg"}]},
+{"countryCode":"LV","names":[{"language":"lv","name":"Latvija"}]},
+{"countryCode":"LY","names":[{"language":"ar","name":"ليبيا"}]},
+{"countryCode":"MA","names":[{"language":"ar","name":"المغرب"},{"language":"zgh","name":"ⵍⵎⵖⵔⵉⴱ"}]},
+{"countryCode":"MC","names":[{"language":"fr","name":"Monaco"}]},
+{"countryCode":"MD","names":[{"language":"ro","name":"Republica Moldova"}]},
+{"countryCode":"ME","names":[{"language":"sr","name":"Crna Gora"}]},
+{"countryCode":"MF","names":[{"language":"fr","name":"Saint-Martin"}]},
+{"countryCode":"MG","names":[{"language":"mg","name":"Madagasikara"},{"language":"fr","name":"Madagascar"}]},
+{"countryCode":"MH","names":[{"language":"en","name":"Marshall Islands"}]},
+{"countryCode":"MK","names":[{"language":"mk","name":"Северна Македонија"}]},
+{"countryCode":"ML","names":[{"language":"fr","name":"Mali"}]},
+{"countryCode":"MM","names":[{"language":"my","name":"မြန်မာ"}]},
+{"countryCode":"MN","names":[{"language":"mn","name":"Монгол"}]},
+{"countryCode":"MO","names":[{"language":"zh","name":"中國澳門特別行政區"},{"language":"pt","name":"Macau, RAE da China"}]},
+{"countryCode":"MP","names":[{"language":"en","name":"Northern Mariana Islands"}]},
+{"countryCode":"MQ","names":[{"language":"fr","name":"Martinique"}]},
+{"countryCode":"MR","names":[{"language":"ar","name":"موريتانيا"}]},
+{"countryCode":"MS","names":[{"language":"en","name":"Montserrat"}]},
+{"countryCode":"MT","names":[{"language":"mt","name":"Malta"}]},
+{"countryCode":"MU","names":[{"language":"mfe","name":"Moris"},{"language":"en","name":"Mauritius"},{"language":"fr","name":"Maurice"}]},
+{"countryCode":"MW","names":[{"language":"en","name":"Malawi"}]},
+{"countryCode":"MX","names":[{"language":"es","name":"México"}]},
+{"countryCode":"MY","names":[{"language":"ms","name":"Malaysia"}]},
+{"countryCode":"MZ","names":[{"language":"pt","name":"Moçambique"}]},
+{"countryCode":"NA","names":[{"language":"af","name":"Namibië"},{"language":"de","name":"Namibia"}]},
+{"countryCode":"NC","names":[{"language":"fr","name":"Nouvelle-Calédonie"}]},
+{"countryCode":"NE","names":[{"language":"fr","name":"Niger"}]},
+{"countryCode":"NF","names":[{"language":"en","name":"Norfolk Island"}]},
+{"countryCode":"NG","names":[{"language":"en","name":"Nigeria"}]},
+{"countryCode":"NI","names":[{"language":"es","name":"Nicaragua"}]},
+{"countryCode":"NL","names":[{"language":"nl","name":"Nederland"}]},
+{"countryCode":"NO","names":[{"language":"nb","name":"Norge"},{"language":"nn","name":"Noreg"},{"language":"se","name":"Norga"}]},
+{"countryCode":"NP","names":[{"language":"ne","name":"नेपाल"}]},
+{"countryCode":"NR","names":[{"language":"en","name":"Nauru"}]},
+{"countryCode":"NU","names":[{"language":"en","name":"Niue"}]},
+{"countryCode":"NZ","names":[{"language":"en","name":"New Zealand"}]},
+{"countryCode":"OM","names":[{"language":"ar","name":"عُمان"}]},
+{"countryCode":"PA","names":[{"language":"es","name":"Panamá"}]},
+{"countryCode":"PE","names":[{"language":"es","name":"Perú"}]},
+{"countryCode":"PF","names":[{"language":"fr","name":"Polynésie française"}]},
+{"countryCode":"PG","names":[{"language":"en","name":"Papua New Guinea"}]},
+{"countryCode":"PH","names":[{"language":"fil","name":"Pilipinas"},{"language":"en","name":"Philippines"}]},
+{"countryCode":"PK","names":[{"language":"ur","name":"پاکستان"},{"language":"en","name":"Pakistan"}]},
+{"countryCode":"PL","names":[{"language":"pl","name":"Polska"}]},
+{"countryCode":"PM","names":[{"language":"fr","name":"Saint-Pierre-et-Miquelon"}]},
+{"countryCode":"PN","names":[{"language":"en","name":"Pitcairn Islands"}]},
+{"countryCode":"PR","names":[{"language":"es","name":"Puerto Rico"}]},
+{"countryCode":"PS","names":[{"language":"ar","name":"الأراضي الفلسطينية"}]},
+{"countryCode":"PT","names":[{"language":"pt","name":"Portugal"}]},
+{"countryCode":"PW","names":[{"language":"en","name":"Palau"}]},
+{"countryCode":"PY","names":[{"language":"es","name":"Paraguay"}]},
+{"countryCode":"QA","names":[{"language":"ar","name":"قطر"}]},
+{"countryCode":"RE","names":[{"language":"fr","name":"La Réunion"}]},
+{"countryCode":"RO","names":[{"language":"ro","name":"România"}]},
+{"countryCode":"RS","names":[{"language":"sr","name":"Србија"}]},
+{"countryCode":"RU","names":[{"language":"ru","name":"Россия"}]},
+{"countryCode":"RW","names":[{"language":"rw","name":"U Rwanda"},{"language":"en","name":"Rwanda"}]},
+{"countryCode":"SA","names":[{"language":"ar","name":"المملكة العربية السعودية"}]},
+{"countryCode":"SB","names":[{"language":"en","name":"Solomon Islands"}]},
+{"countryCode":"SC","names":[{"language":"fr","name":"Seychelles"}]},
+{"countryCode":"SD","names":[{"language":"ar","name":"السودان"},{"language":"en","name":"Sudan"}]},
+{"countryCode":"SE","names":[{"language":"sv","name":"Sverige"}]},
+{"countryCode":"SG","names":[{"language":"en","name":"Singapore"},{"language":"zh","name":"新加坡"},{"language":"ms","name":"Singapura"},{"language":"ta","name":"சிங்கப்பூர்"}]},
+{"countryCode":"SH","names":[{"language":"en","name":"St Helena"}]},
+{"countryCode":"SI","names":[{"language":"sl","name":"Slovenija"}]},
+{"countryCode":"SJ","names":[{"language":"no","name":"Svalbard og Jan Mayen"}]},
+{"countryCode":"SK","names":[{"language":"sk","name":"Slovensko"}]},
+{"countryCode":"SL","names":[{"language":"en","name":"Sierra Leone"}]},
+{"countryCode":"SM","names":[{"language":"it","name":"San Marino"}]},
+{"countryCode":"SN","names":[{"language":"fr","name":"Sénégal"}]},
+{"countryCode":"SO","names":[{"language":"so","name":"Soomaaliya"},{"language":"ar","name":"الصومال"}]},
+{"countryCode":"SR","names":[{"language":"nl","name":"Suriname"}]},
+{"countryCode":"SS","names":[{"language":"en","name":"South Sudan"}]},
+{"countryCode":"ST","names":[{"language":"pt","name":"São Tomé e Príncipe"}]},
+{"countryCode":"SV","names":[{"language":"es","name":"El Salvador"}]},
+{"countryCode":"SX","names":[{"language":"en","name":"Sint Maarten"},{"language":"nl","name":"Sint-Maarten"}]},
+{"countryCode":"SY","names":[{"language":"ar","name":"سوريا"}]},
+{"countryCode":"SZ","names":[{"language":"en","name":"Eswatini"}]},
+{"countryCode":"TC","names":[{"language":"en","name":"Turks & Caicos Islands"}]},
+{"countryCode":"TD","names":[{"language":"fr","name":"Tchad"},{"language":"ar","name":"تشاد"}]},
+{"countryCode":"TF","names":[{"language":"fr","name":"Terres australes françaises"}]},
+{"countryCode":"TG","names":[{"language":"fr","name":"Togo"}]},
+{"countryCode":"TH","names":[{"language":"th","name":"ไทย"}]},
+{"countryCode":"TJ","names":[{"language":"tg","name":"Тоҷикистон"},{"language":"ru","name":"Таджикистан"}]},
+{"countryCode":"TK","names":[{"language":"en","name":"Tokelau"}]},
+{"countryCode":"TL","names":[{"language":"pt","name":"Timor-Leste"}]},
+{"countryCode":"TM","names":[{"language":"tk","name":"Türkmenistan"},{"language":"ru","name":"Туркменистан"}]},
+{"countryCode":"TN","names":[{"language":"ar","name":"تونس"}]},
+{"countryCode":"TO","names":[{"language":"to","name":"Tonga"}]},
+{"countryCode":"TR","names":[{"language":"tr","name":"Türkiye"}]},
+{"countryCode":"TT","names":[{"language":"en","name":"Trinidad & Tobago"}]},
+{"countryCode":"TV","names":[{"language":"en","name":"Tuvalu"}]},
+{"countryCode":"TW","names":[{"language":"zh","name":"台灣"}]},
+{"countryCode":"TZ","names":[{"language":"sw","name":"Tanzania"}]},
+{"countryCode":"UA","names":[{"language":"uk","name":"Україна"},{"language":"ru","name":"Украина"}]},
+{"countryCode":"UG","names":[{"language":"sw","name":"Uganda"}]},
+{"countryCode":"UM","names":[{"language":"en","name":"U.S. Outlying Islands"}]},
+{"countryCode":"US","names":[{"language":"en","name":"United States"}]},
+{"countryCode":"UY","names":[{"language":"es","name":"Uruguay"}]},
+{"countryCode":"UZ","names":[{"language":"uz","name":"Oʻzbekiston"},{"language":"ru","name":"Узбекистан"}]},
+{"countryCode":"VA","names":[{"language":"it","name":"Città del Vaticano"}]},
+{"countryCode":"VC","names":[{"language":"en","name":"St Vincent & Grenadines"}]},
+{"countryCode":"VE","names":[{"language":"es","name":"Venezuela"}]},
+{"countryCode":"VG","names":[{"language":"en","name":"British Virgin Islands"}]},
+{"countryCode":"VI","names":[{"language":"en","name":"U.S. Virgin Islands"}]},
+{"countryCode":"VN","names":[{"language":"vi","name":"Việt Nam"}]},
+{"countryCode":"VU","names":[{"language":"en","name":"Vanuatu"}]},
+{"countryCode":"WF","names":[{"language":"fr","name":"Wallis-et-Futuna"}]},
+{"countryCode":"WS","names":[{"language":"en","name":"Samoa"}]},
+{"countryCode":"YE","names":[{"language":"ar","name":"اليمن"}]},
+{"countryCode":"YT","names":[{"language":"fr","name":"Mayotte"}]},
+{"countryCode":"ZA","names":[{"language":"en","name":"South Africa"},{"language":"af","name":"Suid-Afrika"},{"language":"zu","name":"iNingizimu Afrika"}]},
+{"countryCode":"ZM","names":[{"language":"en","name":"Zambia"}]},
+{"countryCode":"ZW","names":[{"language":"sn","name":"Zimbabwe"}]}
+]`
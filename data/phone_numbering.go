@@ -0,0 +1,261 @@
+package data
+
+// EXAMPLE DATA
+/*
+  {
+    "countryCode":"GB",
+    "nationalPrefix":"0",
+    "lengths":[7,9,10],
+    "pattern":"[1-357-9]\\d{9}|[18]\\d{8}|8\\d{6}"
+  }
+*/
+
+// PhoneNumberingJSONData is the raw JSON for the national numbering plan of every country with telephone service
+// "nationalPrefix" is the trunk prefix dialed before a national number (e.g., 0 in the UK), "lengths" lists
+// the possible national number lengths and "pattern" is the regular expression every valid national number
+// (without the national prefix) matches, covering its leading digits and length.
+// Source: Google libphonenumber metadata (v9.0, general number descriptions)
+const PhoneNumberingJSONData = `[
+{"countryCode":"AD","nationalPrefix":"","lengths":[6,8,9],"pattern":"(?:1|6\\d)\\d{7}|[135-9]\\d{5}"},
+{"countryCode":"AE","nationalPrefix":"0","lengths":[5,6,7,8,9,10,11,12],"pattern":"(?:[4-7]\\d|9[0-689])\\d{7}|800\\d{2,9}|[2-4679]\\d{7}"},
+{"countryCode":"AF","nationalPrefix":"0","lengths":[9],"pattern":"[2-7]\\d{8}"},
+{"countryCode":"AG","nationalPrefix":"1","lengths":[10],"pattern":"(?:268|[58]\\d\\d|900)\\d{7}"},
+{"countryCode":"AI","nationalPrefix":"1","lengths":[10],"pattern":"(?:264|[58]\\d\\d|900)\\d{7}"},
+{"countryCode":"AL","nationalPrefix":"0","lengths":[6,7,8,9],"pattern":"(?:700\\d\\d|900)\\d{3}|8\\d{5,7}|(?:[2-5]|6\\d)\\d{7}"},
+{"countryCode":"AM","nationalPrefix":"0","lengths":[8],"pattern":"(?:[1-489]\\d|55|60|77)\\d{6}"},
+{"countryCode":"AO","nationalPrefix":"","lengths":[9],"pattern":"[29]\\d{8}"},
+{"countryCode":"AR","nationalPrefix":"0","lengths":[10,11],"pattern":"(?:11|[89]\\d\\d)\\d{8}|[2368]\\d{9}"},
+{"countryCode":"AS","nationalPrefix":"1","lengths":[10],"pattern":"(?:[58]\\d\\d|684|900)\\d{7}"},
+{"countryCode":"AT","nationalPrefix":"0","lengths":[4,5,6,7,8,9,10,11,12,13],"pattern":"1\\d{3,12}|2\\d{6,12}|43(?:(?:0\\d|5[02-9])\\d{3,9}|2\\d{4,5}|[3467]\\d{4}|8\\d{4,6}|9\\d{4,7})|5\\d{4,12}|8\\d{7,12}|9\\d{8,12}|(?:[367]\\d|4[0-24-9])\\d{4,11}"},
+{"countryCode":"AU","nationalPrefix":"0","lengths":[5,6,7,8,9,10,12],"pattern":"1(?:[0-79]\\d{7}(?:\\d(?:\\d{2})?)?|8[0-24-9]\\d{7})|[2-478]\\d{8}|1\\d{4,7}"},
+{"countryCode":"AW","nationalPrefix":"","lengths":[7],"pattern":"(?:[25-79]\\d\\d|800)\\d{4}"},
+{"countryCode":"AX","nationalPrefix":"0","lengths":[5,6,7,8,9,10,11,12],"pattern":"2\\d{4,9}|35\\d{4,5}|(?:60\\d\\d|800)\\d{4,6}|7\\d{5,11}|(?:[14]\\d|3[0-46-9]|50)\\d{4,8}"},
+{"countryCode":"AZ","nationalPrefix":"0","lengths":[9],"pattern":"365\\d{6}|(?:[124579]\\d|60|88)\\d{7}"},
+{"countryCode":"BA","nationalPrefix":"0","lengths":[8,9],"pattern":"6\\d{8}|(?:[35689]\\d|49|70)\\d{6}"},
+{"countryCode":"BB","nationalPrefix":"1","lengths":[10],"pattern":"(?:246|[58]\\d\\d|900)\\d{7}"},
+{"countryCode":"BD","nationalPrefix":"0","lengths":[6,7,8,9,10],"pattern":"[1-469]\\d{9}|8[0-79]\\d{7,8}|[2-79]\\d{8}|[2-9]\\d{7}|[3-9]\\d{6}|[57-9]\\d{5}"},
+{"countryCode":"BE","nationalPrefix":"0","lengths":[8,9],"pattern":"4\\d{8}|[1-9]\\d{7}"},
+{"countryCode":"BF","nationalPrefix":"","lengths":[8],"pattern":"[024-7]\\d{7}"},
+{"countryCode":"BG","nationalPrefix":"0","lengths":[6,7,8,9,12],"pattern":"00800\\d{7}|[2-7]\\d{6,7}|[89]\\d{6,8}|2\\d{5}"},
+{"countryCode":"BH","nationalPrefix":"","lengths":[8],"pattern":"[136-9]\\d{7}"},
+{"countryCode":"BI","nationalPrefix":"","lengths":[8],"pattern":"(?:[267]\\d|31)\\d{6}"},
+{"countryCode":"BJ","nationalPrefix":"","lengths":[8,10],"pattern":"(?:01\\d|8)\\d{7}"},
+{"countryCode":"BL","nationalPrefix":"0","lengths":[9],"pattern":"7090\\d{5}|(?:[56]9|[89]\\d)\\d{7}"},
+{"countryCode":"BM","nationalPrefix":"1","lengths":[10],"pattern":"(?:441|[58]\\d\\d|900)\\d{7}"},
+{"countryCode":"BN","nationalPrefix":"","lengths":[7],"pattern":"[2-578]\\d{6}"},
+{"countryCode":"BO","nationalPrefix":"0","lengths":[8,9],"pattern":"(?:[2-7]\\d\\d|8001)\\d{5}"},
+{"countryCode":"BQ","nationalPrefix":"","lengths":[7],"pattern":"(?:[34]1|7\\d)\\d{5}"},
+{"countryCode":"BR","nationalPrefix":"0","lengths":[8,9,10,11],"pattern":"[1-467]\\d{9,10}|55[0-46-9]\\d{8}|[34]\\d{7}|55\\d{7,8}|(?:5[0-46-9]|[89]\\d)\\d{7,9}"},
+{"countryCode":"BS","nationalPrefix":"1","lengths":[10],"pattern":"(?:242|[58]\\d\\d|900)\\d{7}"},
+{"countryCode":"BT","nationalPrefix":"","lengths":[7,8],"pattern":"[178]\\d{7}|[2-8]\\d{6}"},
+{"countryCode":"BW","nationalPrefix":"","lengths":[7,8,10],"pattern":"(?:0800|(?:[37]|800)\\d)\\d{6}|(?:[2-6]\\d|90)\\d{5}"},
+{"countryCode":"BY","nationalPrefix":"8","lengths":[6,7,8,9,10,11],"pattern":"(?:[12]\\d|33|44|902)\\d{7}|8(?:0[0-79]\\d{5,7}|[1-7]\\d{9})|8(?:1[0-489]|[5-79]\\d)\\d{7}|8[1-79]\\d{6,7}|8[0-79]\\d{5}|8\\d{5}"},
+{"countryCode":"BZ","nationalPrefix":"","lengths":[7,11],"pattern":"(?:0800\\d|[2-8])\\d{6}"},
+{"countryCode":"CA","nationalPrefix":"1","lengths":[7,10],"pattern":"[2-9]\\d{9}|3\\d{6}"},
+{"countryCode":"CC","nationalPrefix":"0","lengths":[6,7,8,9,10,12],"pattern":"1(?:[0-79]\\d{8}(?:\\d{2})?|8[0-24-9]\\d{7})|[148]\\d{8}|1\\d{5,7}"},
+{"countryCode":"CD","nationalPrefix":"0","lengths":[7,8,9,10],"pattern":"(?:(?:[189]|5\\d)\\d|2)\\d{7}|[1-68]\\d{6}"},
+{"countryCode":"CF","nationalPrefix":"","lengths":[8],"pattern":"8776\\d{4}|(?:[27]\\d|61)\\d{6}"},
+{"countryCode":"CG","nationalPrefix":"","lengths":[9],"pattern":"222\\d{6}|(?:0\\d|80)\\d{7}"},
+{"countryCode":"CH","nationalPrefix":"0","lengths":[9,12],"pattern":"8\\d{11}|[2-9]\\d{8}"},
+{"countryCode":"CI","nationalPrefix":"","lengths":[10],"pattern":"[02]\\d{9}"},
+{"countryCode":"CK","nationalPrefix":"","lengths":[5],"pattern":"[2-578]\\d{4}"},
+{"countryCode":"CL","nationalPrefix":"","lengths":[9,10,11],"pattern":"12300\\d{6}|6\\d{9,10}|[2-9]\\d{8}"},
+{"countryCode":"CM","nationalPrefix":"","lengths":[8,9],"pattern":"[26]\\d{8}|88\\d{6,7}"},
+{"countryCode":"CN","nationalPrefix":"0","lengths":[7,8,9,10,11,12],"pattern":"(?:(?:1[03-689]|2\\d)\\d\\d|6)\\d{8}|1\\d{10}|[126]\\d{6}(?:\\d(?:\\d{2})?)?|86\\d{5,6}|(?:[3-579]\\d|8[0-57-9])\\d{5,9}"},
+{"countryCode":"CO","nationalPrefix":"0","lengths":[8,10,11],"pattern":"(?:46|60\\d\\d)\\d{6}|(?:1\\d|[39])\\d{9}"},
+{"countryCode":"CR","nationalPrefix":"","lengths":[8,10],"pattern":"(?:8\\d|90)\\d{8}|(?:[24-8]\\d{3}|3005)\\d{4}"},
+{"countryCode":"CU","nationalPrefix":"0","lengths":[6,7,8,10],"pattern":"(?:[2-7]|8\\d\\d)\\d{7}|[2-47]\\d{6}|[34]\\d{5}"},
+{"countryCode":"CV","nationalPrefix":"","lengths":[7],"pattern":"(?:[2-59]\\d\\d|800)\\d{4}"},
+{"countryCode":"CW","nationalPrefix":"","lengths":[7,8],"pattern":"(?:[34]1|60|(?:7|9\\d)\\d)\\d{5}"},
+{"countryCode":"CX","nationalPrefix":"0","lengths":[6,7,8,9,10,12],"pattern":"1(?:[0-79]\\d{8}(?:\\d{2})?|8[0-24-9]\\d{7})|[148]\\d{8}|1\\d{5,7}"},
+{"countryCode":"CY","nationalPrefix":"","lengths":[8],"pattern":"(?:[279]\\d|[58]0)\\d{6}"},
+{"countryCode":"CZ","nationalPrefix":"","lengths":[9,10,11,12],"pattern":"(?:[2-578]\\d|60)\\d{7}|9\\d{8,11}"},
+{"countryCode":"DE","nationalPrefix":"0","lengths":[4,5,6,7,8,9,10,11,12,13,14,15],"pattern":"[2579]\\d{5,14}|49(?:[34]0|69|8\\d)\\d\\d?|49(?:37|49|60|7[089]|9\\d)\\d{1,3}|49(?:2[024-9]|3[2-689]|7[1-7])\\d{1,8}|(?:1|[368]\\d|4[0-8])\\d{3,13}|49(?:[015]\\d|2[13]|31|[46][1-8])\\d{1,9}"},
+{"countryCode":"DJ","nationalPrefix":"","lengths":[8],"pattern":"(?:2\\d|77)\\d{6}"},
+{"countryCode":"DK","nationalPrefix":"","lengths":[8],"pattern":"[2-9]\\d{7}"},
+{"countryCode":"DM","nationalPrefix":"1","lengths":[10],"pattern":"(?:[58]\\d\\d|767|900)\\d{7}"},
+{"countryCode":"DO","nationalPrefix":"1","lengths":[10],"pattern":"(?:[58]\\d\\d|900)\\d{7}"},
+{"countryCode":"DZ","nationalPrefix":"0","lengths":[8,9],"pattern":"(?:[1-4]|[5-79]\\d|80)\\d{7}"},
+{"countryCode":"EC","nationalPrefix":"0","lengths":[8,9,10,11],"pattern":"1\\d{9,10}|(?:[2-7]|9\\d)\\d{7}"},
+{"countryCode":"EE","nationalPrefix":"","lengths":[7,8,10],"pattern":"8\\d{9}|[4578]\\d{7}|(?:[3-8]\\d|90)\\d{5}"},
+{"countryCode":"EG","nationalPrefix":"0","lengths":[8,9,10],"pattern":"[189]\\d{8,9}|[24-6]\\d{8}|[135]\\d{7}"},
+{"countryCode":"EH","nationalPrefix":"0","lengths":[9],"pattern":"[5-8]\\d{8}"},
+{"countryCode":"ER","nationalPrefix":"0","lengths":[7],"pattern":"[178]\\d{6}"},
+{"countryCode":"ES","nationalPrefix":"","lengths":[9],"pattern":"[5-9]\\d{8}"},
+{"countryCode":"ET","nationalPrefix":"0","lengths":[9],"pattern":"(?:11|[2-57-9]\\d)\\d{7}"},
+{"countryCode":"FI","nationalPrefix":"0","lengths":[5,6,7,8,9,10,11,12],"pattern":"[1-35689]\\d{4}|7\\d{10,11}|(?:[124-7]\\d|3[0-46-9])\\d{8}|[1-9]\\d{5,8}"},
+{"countryCode":"FJ","nationalPrefix":"","lengths":[7,11],"pattern":"45\\d{5}|(?:0800\\d|[235-9])\\d{6}"},
+{"countryCode":"FK","nationalPrefix":"","lengths":[5],"pattern":"[2-7]\\d{4}"},
+{"countryCode":"FM","nationalPrefix":"","lengths":[7],"pattern":"(?:[39]\\d\\d|820)\\d{4}"},
+{"countryCode":"FO","nationalPrefix":"","lengths":[6],"pattern":"[2-9]\\d{5}"},
+{"countryCode":"FR","nationalPrefix":"0","lengths":[9],"pattern":"[1-9]\\d{8}"},
+{"countryCode":"GA","nationalPrefix":"","lengths":[7,8],"pattern":"(?:[067]\\d|11)\\d{6}|[2-7]\\d{6}"},
+{"countryCode":"GB","nationalPrefix":"0","lengths":[7,9,10],"pattern":"[1-357-9]\\d{9}|[18]\\d{8}|8\\d{6}"},
+{"countryCode":"GD","nationalPrefix":"1","lengths":[10],"pattern":"(?:473|[58]\\d\\d|900)\\d{7}"},
+{"countryCode":"GE","nationalPrefix":"0","lengths":[9],"pattern":"(?:[3-57]\\d\\d|800)\\d{6}"},
+{"countryCode":"GF","nationalPrefix":"0","lengths":[9],"pattern":"(?:694\\d|7093)\\d{5}|(?:59|[89]\\d)\\d{7}"},
+{"countryCode":"GG","nationalPrefix":"0","lengths":[7,9,10],"pattern":"(?:1481|[357-9]\\d{3})\\d{6}|8\\d{6}(?:\\d{2})?"},
+{"countryCode":"GH","nationalPrefix":"0","lengths":[8,9],"pattern":"[235]\\d{8}|800\\d{5,6}"},
+{"countryCode":"GI","nationalPrefix":"","lengths":[8],"pattern":"(?:[25]\\d|60)\\d{6}"},
+{"countryCode":"GL","nationalPrefix":"","lengths":[6],"pattern":"(?:19|[2-689]\\d|70)\\d{4}"},
+{"countryCode":"GM","nationalPrefix":"","lengths":[7],"pattern":"[2-9]\\d{6}"},
+{"countryCode":"GN","nationalPrefix":"","lengths":[8,9],"pattern":"722\\d{6}|(?:3|6\\d)\\d{7}"},
+{"countryCode":"GP","nationalPrefix":"0","lengths":[9],"pattern":"7090\\d{5}|(?:[56]9|[89]\\d)\\d{7}"},
+{"countryCode":"GQ","nationalPrefix":"","lengths":[9],"pattern":"222\\d{6}|(?:3\\d|55|[89]0)\\d{7}"},
+{"countryCode":"GR","nationalPrefix":"","lengths":[10,11,12],"pattern":"5005000\\d{3}|8\\d{9,11}|(?:[269]\\d|70)\\d{8}"},
+{"countryCode":"GT","nationalPrefix":"","lengths":[8,11],"pattern":"80\\d{6}|(?:1\\d{3}|[2-7])\\d{7}"},
+{"countryCode":"GU","nationalPrefix":"1","lengths":[10],"pattern":"(?:[58]\\d\\d|671|900)\\d{7}"},
+{"countryCode":"GW","nationalPrefix":"","lengths":[7,9],"pattern":"[49]\\d{8}|4\\d{6}"},
+{"countryCode":"GY","nationalPrefix":"","lengths":[7],"pattern":"(?:[2-8]\\d{3}|9008)\\d{3}"},
+{"countryCode":"HK","nationalPrefix":"","lengths":[5,6,7,8,9,11],"pattern":"8[0-46-9]\\d{6,7}|9\\d{4,7}|(?:[2-7]|9\\d{3})\\d{7}"},
+{"countryCode":"HN","nationalPrefix":"","lengths":[8,11],"pattern":"8\\d{10}|[237-9]\\d{7}"},
+{"countryCode":"HR","nationalPrefix":"0","lengths":[7,8,9],"pattern":"[2-69]\\d{8}|80\\d{5,7}|[1-79]\\d{7}|6\\d{6}"},
+{"countryCode":"HT","nationalPrefix":"","lengths":[8],"pattern":"[2-589]\\d{7}"},
+{"countryCode":"HU","nationalPrefix":"06","lengths":[8,9],"pattern":"[235-7]\\d{8}|[1-9]\\d{7}"},
+{"countryCode":"ID","nationalPrefix":"0","lengths":[7,8,9,10,11,12,13,14,15,16,17],"pattern":"00[1-9]\\d{9,14}|(?:[1-36]|8\\d{5})\\d{6}|00\\d{9}|[1-9]\\d{8,10}|[2-9]\\d{7}"},
+{"countryCode":"IE","nationalPrefix":"0","lengths":[7,8,9,10],"pattern":"(?:1\\d|[2569])\\d{6,8}|4\\d{6,9}|7\\d{8}|8\\d{8,9}"},
+{"countryCode":"IL","nationalPrefix":"0","lengths":[7,8,9,10,11,12],"pattern":"1\\d{6}(?:\\d{3,5})?|[57]\\d{8}|[1-489]\\d{7}"},
+{"countryCode":"IM","nationalPrefix":"0","lengths":[10],"pattern":"1624\\d{6}|(?:[3578]\\d|90)\\d{8}"},
+{"countryCode":"IN","nationalPrefix":"0","lengths":[8,9,10,11,12,13],"pattern":"(?:000800|[2-9]\\d\\d)\\d{7}|1\\d{7,12}"},
+{"countryCode":"IO","nationalPrefix":"","lengths":[7],"pattern":"3\\d{6}"},
+{"countryCode":"IQ","nationalPrefix":"0","lengths":[8,9,10],"pattern":"(?:1|7\\d\\d)\\d{7}|[2-6]\\d{7,8}"},
+{"countryCode":"IR","nationalPrefix":"0","lengths":[4,5,6,7,10],"pattern":"[1-9]\\d{9}|(?:[1-8]\\d\\d|9)\\d{3,4}"},
+{"countryCode":"IS","nationalPrefix":"","lengths":[7,9],"pattern":"(?:38\\d|[4-9])\\d{6}"},
+{"countryCode":"IT","nationalPrefix":"","lengths":[6,7,8,9,10,11,12],"pattern":"0\\d{5,11}|1\\d{8,10}|3(?:[0-8]\\d{7,10}|9\\d{7,8})|(?:43|55|70)\\d{8}|8\\d{5}(?:\\d{2,4})?"},
+{"countryCode":"JE","nationalPrefix":"0","lengths":[10],"pattern":"1534\\d{6}|(?:[3578]\\d|90)\\d{8}"},
+{"countryCode":"JM","nationalPrefix":"1","lengths":[10],"pattern":"(?:[58]\\d\\d|658|900)\\d{7}"},
+{"countryCode":"JO","nationalPrefix":"0","lengths":[8,9],"pattern":"(?:(?:[2689]|7\\d)\\d|32|427|53)\\d{6}"},
+{"countryCode":"JP","nationalPrefix":"0","lengths":[8,9,10,11,12,13,14,15,16,17],"pattern":"00[1-9]\\d{6,14}|[25-9]\\d{9}|(?:00|[1-9]\\d\\d)\\d{6}"},
+{"countryCode":"KE","nationalPrefix":"0","lengths":[7,8,9,10],"pattern":"(?:[17]\\d\\d|900)\\d{6}|(?:2|80)0\\d{6,7}|[4-6]\\d{6,8}"},
+{"countryCode":"KG","nationalPrefix":"0","lengths":[9,10],"pattern":"8\\d{9}|[235-9]\\d{8}"},
+{"countryCode":"KH","nationalPrefix":"0","lengths":[8,9,10],"pattern":"1\\d{9}|[1-9]\\d{7,8}"},
+{"countryCode":"KI","nationalPrefix":"0","lengths":[5,8],"pattern":"(?:[37]\\d|6[0-79])\\d{6}|(?:[2-48]\\d|50)\\d{3}"},
+{"countryCode":"KM","nationalPrefix":"","lengths":[7],"pattern":"[3478]\\d{6}"},
+{"countryCode":"KN","nationalPrefix":"1","lengths":[10],"pattern":"(?:[58]\\d\\d|900)\\d{7}"},
+{"countryCode":"KP","nationalPrefix":"0","lengths":[8,10],"pattern":"85\\d{6}|(?:19\\d|[2-7])\\d{7}"},
+{"countryCode":"KR","nationalPrefix":"0","lengths":[5,6,8,9,10,11,12,13,14],"pattern":"00[1-9]\\d{8,11}|(?:[12]|5\\d{3})\\d{7}|[13-6]\\d{9}|(?:[1-6]\\d|80)\\d{7}|[3-6]\\d{4,5}|(?:00|7)0\\d{8}"},
+{"countryCode":"KW","nationalPrefix":"","lengths":[7,8],"pattern":"18\\d{5}|(?:[2569]\\d|41)\\d{6}"},
+{"countryCode":"KY","nationalPrefix":"1","lengths":[10],"pattern":"(?:345|[58]\\d\\d|900)\\d{7}"},
+{"countryCode":"KZ","nationalPrefix":"8","lengths":[10,14],"pattern":"8\\d{13}|[78]\\d{9}"},
+{"countryCode":"LA","nationalPrefix":"0","lengths":[8,9,10],"pattern":"[23]\\d{9}|3\\d{8}|(?:[235-8]\\d|41)\\d{6}"},
+{"countryCode":"LB","nationalPrefix":"0","lengths":[7,8],"pattern":"[27-9]\\d{7}|[13-9]\\d{6}"},
+{"countryCode":"LC","nationalPrefix":"1","lengths":[10],"pattern":"(?:[58]\\d\\d|758|900)\\d{7}"},
+{"countryCode":"LI","nationalPrefix":"0","lengths":[7,9],"pattern":"[68]\\d{8}|(?:[2378]\\d|90)\\d{5}"},
+{"countryCode":"LK","nationalPrefix":"0","lengths":[9],"pattern":"[1-9]\\d{8}"},
+{"countryCode":"LR","nationalPrefix":"0","lengths":[7,8,9],"pattern":"(?:[2457]\\d|33|88)\\d{7}|(?:2\\d|[4-6])\\d{6}"},
+{"countryCode":"LS","nationalPrefix":"","lengths":[8],"pattern":"(?:[256]\\d\\d|800)\\d{5}"},
+{"countryCode":"LT","nationalPrefix":"0","lengths":[8],"pattern":"(?:[3469]\\d|52|[78]0)\\d{6}"},
+{"countryCode":"LU","nationalPrefix":"","lengths":[4,5,6,7,8,9,10,11],"pattern":"35[013-9]\\d{4,8}|6\\d{8}|35\\d{2,4}|(?:[2457-9]\\d|3[0-46-9])\\d{2,9}"},
+{"countryCode":"LV","nationalPrefix":"","lengths":[8],"pattern":"(?:[268]\\d|78|90)\\d{6}"},
+{"countryCode":"LY","nationalPrefix":"0","lengths":[9],"pattern":"[2-9]\\d{8}"},
+{"countryCode":"MA","nationalPrefix":"0","lengths":[9],"pattern":"[5-8]\\d{8}"},
+{"countryCode":"MC","nationalPrefix":"0","lengths":[8,9],"pattern":"(?:[3489]|[67]\\d)\\d{7}"},
+{"countryCode":"MD","nationalPrefix":"0","lengths":[8],"pattern":"(?:[235-7]\\d|[89]0)\\d{6}"},
+{"countryCode":"ME","nationalPrefix":"0","lengths":[8,9],"pattern":"(?:20|[3-79]\\d)\\d{6}|80\\d{6,7}"},
+{"countryCode":"MF","nationalPrefix":"0","lengths":[9],"pattern":"7090\\d{5}|(?:[56]9|[89]\\d)\\d{7}"},
+{"countryCode":"MG","nationalPrefix":"0","lengths":[9],"pattern":"[23]\\d{8}"},
+{"countryCode":"MH","nationalPrefix":"1","lengths":[7],"pattern":"329\\d{4}|(?:[256]\\d|45)\\d{5}"},
+{"countryCode":"MK","nationalPrefix":"0","lengths":[8],"pattern":"[2-578]\\d{7}"},
+{"countryCode":"ML","nationalPrefix":"","lengths":[8],"pattern":"[24-9]\\d{7}"},
+{"countryCode":"MM","nationalPrefix":"0","lengths":[6,7,8,9,10],"pattern":"1\\d{5,7}|95\\d{6}|(?:[4-7]|9[0-46-9])\\d{6,8}|(?:2|8\\d)\\d{5,8}"},
+{"countryCode":"MN","nationalPrefix":"0","lengths":[8,9,10],"pattern":"[12]\\d{7,9}|[5-9]\\d{7}"},
+{"countryCode":"MO","nationalPrefix":"","lengths":[7,8],"pattern":"0800\\d{3}|(?:28|[68]\\d)\\d{6}"},
+{"countryCode":"MP","nationalPrefix":"1","lengths":[10],"pattern":"[58]\\d{9}|(?:67|90)0\\d{7}"},
+{"countryCode":"MQ","nationalPrefix":"0","lengths":[9],"pattern":"7091\\d{5}|(?:[56]9|[89]\\d)\\d{7}"},
+{"countryCode":"MR","nationalPrefix":"","lengths":[8],"pattern":"(?:[2-4]\\d\\d|800)\\d{5}"},
+{"countryCode":"MS","nationalPrefix":"1","lengths":[10],"pattern":"(?:[58]\\d\\d|664|900)\\d{7}"},
+{"countryCode":"MT","nationalPrefix":"","lengths":[8],"pattern":"3550\\d{4}|(?:[2579]\\d\\d|800)\\d{5}"},
+{"countryCode":"MU","nationalPrefix":"","lengths":[7,8,10],"pattern":"(?:[57]|8\\d\\d)\\d{7}|[2-468]\\d{6}"},
+{"countryCode":"MV","nationalPrefix":"","lengths":[7,10],"pattern":"(?:800|9[0-57-9]\\d)\\d{7}|[34679]\\d{6}"},
+{"countryCode":"MW","nationalPrefix":"0","lengths":[7,9],"pattern":"(?:[1289]\\d|31|77)\\d{7}|1\\d{6}"},
+{"countryCode":"MX","nationalPrefix":"","lengths":[10],"pattern":"[2-9]\\d{9}"},
+{"countryCode":"MY","nationalPrefix":"0","lengths":[8,9,10],"pattern":"1\\d{8,9}|(?:3\\d|[4-9])\\d{7}"},
+{"countryCode":"MZ","nationalPrefix":"","lengths":[8,9],"pattern":"(?:2|8\\d)\\d{7}"},
+{"countryCode":"NA","nationalPrefix":"0","lengths":[8,9],"pattern":"[68]\\d{7,8}"},
+{"countryCode":"NC","nationalPrefix":"","lengths":[6],"pattern":"(?:050|[2-57-9]\\d\\d)\\d{3}"},
+{"countryCode":"NE","nationalPrefix":"","lengths":[8],"pattern":"[027-9]\\d{7}"},
+{"countryCode":"NF","nationalPrefix":"","lengths":[6],"pattern":"[13]\\d{5}"},
+{"countryCode":"NG","nationalPrefix":"0","lengths":[10,11,12,13,14],"pattern":"(?:20|9\\d)\\d{8}|[78]\\d{9,13}"},
+{"countryCode":"NI","nationalPrefix":"","lengths":[8],"pattern":"(?:1800|[25-8]\\d{3})\\d{4}"},
+{"countryCode":"NL","nationalPrefix":"0","lengths":[5,6,7,8,9,10,11],"pattern":"(?:[124-7]\\d\\d|3(?:[02-9]\\d|1[0-8]))\\d{6}|8\\d{6,9}|9\\d{6,10}|1\\d{4,5}"},
+{"countryCode":"NO","nationalPrefix":"","lengths":[5,8],"pattern":"(?:0|[2-9]\\d{3})\\d{4}"},
+{"countryCode":"NP","nationalPrefix":"0","lengths":[8,10,11],"pattern":"(?:1\\d|9)\\d{9}|[1-9]\\d{7}"},
+{"countryCode":"NR","nationalPrefix":"","lengths":[7],"pattern":"(?:222|444|(?:55|8\\d)\\d|666|777|999)\\d{4}"},
+{"countryCode":"NU","nationalPrefix":"","lengths":[4,7],"pattern":"(?:[4-7]|888\\d)\\d{3}"},
+{"countryCode":"NZ","nationalPrefix":"0","lengths":[5,6,7,8,9,10],"pattern":"[1289]\\d{9}|50\\d{5}(?:\\d{2,3})?|[27-9]\\d{7,8}|(?:[34]\\d|6[0-35-9])\\d{6}|8\\d{4,6}"},
+{"countryCode":"OM","nationalPrefix":"","lengths":[7,8,9],"pattern":"(?:1505|[279]\\d{3}|500)\\d{4}|800\\d{5,6}"},
+{"countryCode":"PA","nationalPrefix":"","lengths":[7,8,10,11],"pattern":"(?:00800|8\\d{3})\\d{6}|[68]\\d{7}|[1-57-9]\\d{6}"},
+{"countryCode":"PE","nationalPrefix":"0","lengths":[8,9],"pattern":"(?:[14-8]|9\\d)\\d{7}"},
+{"countryCode":"PF","nationalPrefix":"","lengths":[6,8,9],"pattern":"4\\d{5}(?:\\d{2})?|8\\d{7,8}"},
+{"countryCode":"PG","nationalPrefix":"","lengths":[7,8],"pattern":"(?:180|[78]\\d{3})\\d{4}|(?:[2-589]\\d|64)\\d{5}"},
+{"countryCode":"PH","nationalPrefix":"0","lengths":[6,8,9,10,11,12,13],"pattern":"(?:[2-7]|9\\d)\\d{8}|2\\d{5}|(?:1800|8)\\d{7,9}"},
+{"countryCode":"PK","nationalPrefix":"0","lengths":[8,9,10,11,12],"pattern":"122\\d{6}|[24-8]\\d{10,11}|9(?:[013-9]\\d{8,10}|2(?:[01]\\d\\d|2(?:[06-8]\\d|1[01]))\\d{7})|(?:[2-8]\\d{3}|92(?:[0-7]\\d|8[1-9]))\\d{6}|[24-9]\\d{8}|[89]\\d{7}"},
+{"countryCode":"PL","nationalPrefix":"","lengths":[6,7,8,9,10],"pattern":"(?:6|8\\d\\d)\\d{7}|[1-9]\\d{6}(?:\\d{2})?|[26]\\d{5}"},
+{"countryCode":"PM","nationalPrefix":"0","lengths":[6,9],"pattern":"[78]\\d{8}|[2-9]\\d{5}"},
+{"countryCode":"PR","nationalPrefix":"1","lengths":[10],"pattern":"(?:[589]\\d\\d|787)\\d{7}"},
+{"countryCode":"PS","nationalPrefix":"0","lengths":[8,9,10],"pattern":"[2489]2\\d{6}|(?:1\\d|5)\\d{8}"},
+{"countryCode":"PT","nationalPrefix":"","lengths":[9],"pattern":"1693\\d{5}|(?:[26-9]\\d|30)\\d{7}"},
+{"countryCode":"PW","nationalPrefix":"","lengths":[7],"pattern":"(?:[24-8]\\d\\d|345|900)\\d{4}"},
+{"countryCode":"PY","nationalPrefix":"0","lengths":[6,7,8,9,10,11],"pattern":"[36-8]\\d{5,8}|4\\d{6,8}|59\\d{6}|9\\d{5,10}|(?:2\\d|5[0-8])\\d{6,7}"},
+{"countryCode":"QA","nationalPrefix":"","lengths":[7,8,9,11],"pattern":"800\\d{4}|(?:2|800)\\d{6}|(?:0080|[3-7])\\d{7}"},
+{"countryCode":"RE","nationalPrefix":"0","lengths":[9],"pattern":"709\\d{6}|(?:26|[689]\\d)\\d{7}"},
+{"countryCode":"RO","nationalPrefix":"0","lengths":[6,9],"pattern":"(?:[236-8]\\d|90)\\d{7}|[23]\\d{5}"},
+{"countryCode":"RS","nationalPrefix":"0","lengths":[6,7,8,9,10,11,12],"pattern":"38[02-9]\\d{6,9}|6\\d{7,9}|90\\d{4,8}|38\\d{5,6}|(?:7\\d\\d|800)\\d{3,9}|(?:[12]\\d|3[0-79])\\d{5,10}"},
+{"countryCode":"RU","nationalPrefix":"8","lengths":[10,14],"pattern":"8\\d{13}|[347-9]\\d{9}"},
+{"countryCode":"RW","nationalPrefix":"0","lengths":[8,9],"pattern":"(?:06|[27]\\d\\d|[89]00)\\d{6}"},
+{"countryCode":"SA","nationalPrefix":"0","lengths":[9,10],"pattern":"(?:[15]\\d|800|92)\\d{7}"},
+{"countryCode":"SB","nationalPrefix":"","lengths":[5,7],"pattern":"[6-9]\\d{6}|[1-6]\\d{4}"},
+{"countryCode":"SC","nationalPrefix":"","lengths":[7],"pattern":"(?:[2489]\\d|64)\\d{5}"},
+{"countryCode":"SD","nationalPrefix":"0","lengths":[9],"pattern":"[19]\\d{8}"},
+{"countryCode":"SE","nationalPrefix":"0","lengths":[6,7,8,9,10,12],"pattern":"(?:[26]\\d\\d|9)\\d{9}|[1-9]\\d{8}|[1-689]\\d{7}|[1-4689]\\d{6}|2\\d{5}"},
+{"countryCode":"SG","nationalPrefix":"","lengths":[8,10,11],"pattern":"(?:(?:1\\d|8)\\d\\d|7000)\\d{7}|[3689]\\d{7}"},
+{"countryCode":"SH","nationalPrefix":"","lengths":[4,5],"pattern":"(?:[256]\\d|8)\\d{3}"},
+{"countryCode":"SI","nationalPrefix":"0","lengths":[5,6,7,8],"pattern":"[1-7]\\d{7}|8\\d{4,7}|90\\d{4,6}"},
+{"countryCode":"SJ","nationalPrefix":"","lengths":[5,8],"pattern":"0\\d{4}|(?:[489]\\d|79)\\d{6}"},
+{"countryCode":"SK","nationalPrefix":"0","lengths":[6,7,9],"pattern":"[2-689]\\d{8}|[2-59]\\d{6}|[2-5]\\d{5}"},
+{"countryCode":"SL","nationalPrefix":"0","lengths":[8],"pattern":"(?:[237-9]\\d|66)\\d{6}"},
+{"countryCode":"SM","nationalPrefix":"","lengths":[8,10],"pattern":"(?:0549|[5-7]\\d)\\d{6}"},
+{"countryCode":"SN","nationalPrefix":"","lengths":[9],"pattern":"(?:[378]\\d|93)\\d{7}"},
+{"countryCode":"SO","nationalPrefix":"0","lengths":[6,7,8,9],"pattern":"[346-9]\\d{8}|[12679]\\d{7}|[1-5]\\d{6}|[1348]\\d{5}"},
+{"countryCode":"SR","nationalPrefix":"","lengths":[6,7],"pattern":"(?:[2-5]|[6-9]\\d)\\d{5}"},
+{"countryCode":"SS","nationalPrefix":"0","lengths":[9],"pattern":"[19]\\d{8}"},
+{"countryCode":"ST","nationalPrefix":"","lengths":[7],"pattern":"(?:22|9\\d)\\d{5}"},
+{"countryCode":"SV","nationalPrefix":"","lengths":[7,8,11],"pattern":"[25-7]\\d{7}|(?:80\\d|900)\\d{4}(?:\\d{4})?"},
+{"countryCode":"SX","nationalPrefix":"1","lengths":[10],"pattern":"7215\\d{6}|(?:[58]\\d\\d|900)\\d{7}"},
+{"countryCode":"SY","nationalPrefix":"0","lengths":[8,9],"pattern":"[1-359]\\d{8}|[1-5]\\d{7}"},
+{"countryCode":"SZ","nationalPrefix":"","lengths":[8,9],"pattern":"0800\\d{4}|(?:[237]\\d|900)\\d{6}"},
+{"countryCode":"TC","nationalPrefix":"1","lengths":[10],"pattern":"(?:[58]\\d\\d|649|900)\\d{7}"},
+{"countryCode":"TD","nationalPrefix":"","lengths":[8],"pattern":"(?:22|[3689]\\d|77)\\d{6}"},
+{"countryCode":"TG","nationalPrefix":"","lengths":[8],"pattern":"[279]\\d{7}"},
+{"countryCode":"TH","nationalPrefix":"0","lengths":[8,9,10,13],"pattern":"(?:001800|[2-57]|[689]\\d)\\d{7}|1\\d{7,9}"},
+{"countryCode":"TJ","nationalPrefix":"","lengths":[9],"pattern":"(?:[0-57-9]\\d|66)\\d{7}"},
+{"countryCode":"TK","nationalPrefix":"","lengths":[4,5,6,7],"pattern":"[2-47]\\d{3,6}"},
+{"countryCode":"TL","nationalPrefix":"","lengths":[7,8],"pattern":"7\\d{7}|(?:[2-47]\\d|[89]0)\\d{5}"},
+{"countryCode":"TM","nationalPrefix":"8","lengths":[8],"pattern":"(?:[1-6]\\d|71)\\d{6}"},
+{"countryCode":"TN","nationalPrefix":"","lengths":[8],"pattern":"[2-57-9]\\d{7}"},
+{"countryCode":"TO","nationalPrefix":"","lengths":[5,7],"pattern":"(?:0800|(?:[5-8]\\d\\d|999)\\d)\\d{3}|[2-8]\\d{4}"},
+{"countryCode":"TR","nationalPrefix":"0","lengths":[7,10,12,13],"pattern":"4\\d{6}|8\\d{11,12}|(?:[2-58]\\d\\d|900)\\d{7}"},
+{"countryCode":"TT","nationalPrefix":"1","lengths":[10],"pattern":"(?:[58]\\d\\d|900)\\d{7}"},
+{"countryCode":"TV","nationalPrefix":"","lengths":[5,6,7],"pattern":"(?:2|7\\d\\d|90)\\d{4}"},
+{"countryCode":"TW","nationalPrefix":"0","lengths":[7,8,9,10,11],"pattern":"[2-689]\\d{8}|7\\d{9,10}|[2-8]\\d{7}|2\\d{6}"},
+{"countryCode":"TZ","nationalPrefix":"0","lengths":[9],"pattern":"(?:[25-8]\\d|41|90)\\d{7}"},
+{"countryCode":"UA","nationalPrefix":"0","lengths":[9,10],"pattern":"[89]\\d{9}|[3-9]\\d{8}"},
+{"countryCode":"UG","nationalPrefix":"0","lengths":[9],"pattern":"800\\d{6}|(?:[29]0|[347]\\d)\\d{7}"},
+{"countryCode":"US","nationalPrefix":"1","lengths":[10],"pattern":"[2-9]\\d{9}|3\\d{6}"},
+{"countryCode":"UY","nationalPrefix":"0","lengths":[4,5,6,7,8,9,10,11,12,13],"pattern":"0004\\d{2,9}|[1249]\\d{7}|2\\d{3,4}|(?:[49]\\d|80)\\d{5}"},
+{"countryCode":"UZ","nationalPrefix":"","lengths":[9],"pattern":"(?:20|33|[5-9]\\d)\\d{7}"},
+{"countryCode":"VA","nationalPrefix":"","lengths":[6,7,8,9,10,11,12],"pattern":"0\\d{5,10}|3[0-8]\\d{7,10}|55\\d{8}|8\\d{5}(?:\\d{2,4})?|(?:1\\d|39)\\d{7,8}"},
+{"countryCode":"VC","nationalPrefix":"1","lengths":[10],"pattern":"(?:[58]\\d\\d|784|900)\\d{7}"},
+{"countryCode":"VE","nationalPrefix":"0","lengths":[10],"pattern":"[68]00\\d{7}|(?:[24]\\d|[59]0)\\d{8}"},
+{"countryCode":"VG","nationalPrefix":"1","lengths":[10],"pattern":"(?:284|[58]\\d\\d|900)\\d{7}"},
+{"countryCode":"VI","nationalPrefix":"1","lengths":[10],"pattern":"[58]\\d{9}|(?:34|90)0\\d{7}"},
+{"countryCode":"VN","nationalPrefix":"0","lengths":[7,8,9,10],"pattern":"[12]\\d{9}|[135-9]\\d{8}|[16]\\d{7}|[16-8]\\d{6}"},
+{"countryCode":"VU","nationalPrefix":"","lengths":[5,7],"pattern":"[57-9]\\d{6}|(?:[238]\\d|48)\\d{3}"},
+{"countryCode":"WF","nationalPrefix":"","lengths":[6,9],"pattern":"(?:40|72|8\\d{4})\\d{4}|[89]\\d{5}"},
+{"countryCode":"WS","nationalPrefix":"","lengths":[5,6,7,10],"pattern":"(?:[2-6]|8\\d{5})\\d{4}|[78]\\d{6}|[68]\\d{5}"},
+{"countryCode":"YE","nationalPrefix":"0","lengths":[7,8,9],"pattern":"(?:1|7\\d)\\d{7}|[1-7]\\d{6}"},
+{"countryCode":"YT","nationalPrefix":"0","lengths":[9],"pattern":"(?:639\\d|7093)\\d{5}|(?:26|80|9\\d)\\d{7}"},
+{"countryCode":"ZA","nationalPrefix":"0","lengths":[5,6,7,8,9,10],"pattern":"[1-79]\\d{8}|8\\d{4,9}"},
+{"countryCode":"ZM","nationalPrefix":"0","lengths":[9],"pattern":"800\\d{6}|(?:21|[579]\\d|63)\\d{7}"},
+{"countryCode":"ZW","nationalPrefix":"0","lengths":[5,6,7,8,9,10],"pattern":"2(?:[0-57-9]\\d{6,8}|6[0-24-9]\\d{6,7})|[38]\\d{9}|[35-8]\\d{8}|[3-6]\\d{7}|[1-689]\\d{6}|[1-3569]\\d{5}|[1356]\\d{4}"}
+]`
@@ -0,0 +1,266 @@
+package data
+
+// EXAMPLE DATA
+/*
+  {
+    "countryCode":"MX",
+    "timeZones":["America/Mexico_City","America/Cancun","America/Merida"],
+    "aliases":["America/Ensenada","America/Santa_Isabel","Mexico/BajaNorte"]
+  }
+*/
+
+// TimeZoneJSONData is the raw JSON for the IANA time zones of every country
+// Time zones are listed in the order of zone.tab, which puts the most populous zones first where that
+// makes geographical sense, and aliases are the backward-compatible link names (e.g., "US/Eastern",
+// "Asia/Calcutta") that belong to the country.
+// Source: IANA Time Zone Database (tzdata 2025b, zone.tab and backward links)
+// Countries without a time zone in zone.tab (BV, HM) are omitted.
+const TimeZoneJSONData = `[
+{"countryCode":"AD","timeZones":["Europe/Andorra"]},
+{"countryCode":"AE","timeZones":["Asia/Dubai"]},
+{"countryCode":"AF","timeZones":["Asia/Kabul"]},
+{"countryCode":"AG","timeZones":["America/Antigua"]},
+{"countryCode":"AI","timeZones":["America/Anguilla"]},
+{"countryCode":"AL","timeZones":["Europe/Tirane"]},
+{"countryCode":"AM","timeZones":["Asia/Yerevan"]},
+{"countryCode":"AO","timeZones":["Africa/Luanda"]},
+{"countryCode":"AQ","timeZones":["Antarctica/McMurdo","Antarctica/Casey","Antarctica/Davis","Antarctica/DumontDUrville","Antarctica/Mawson","Antarctica/Palmer","Antarctica/Rothera","Antarctica/Syowa","Antarctica/Troll","Antarctica/Vostok"],"aliases":["Antarctica/South_Pole"]},
+{"countryCode":"AR","timeZones":["America/Argentina/Buenos_Aires","America/Argentina/Cordoba","America/Argentina/Salta","America/Argentina/Jujuy","America/Argentina/Tucuman","America/Argentina/Catamarca","America/Argentina/La_Rioja","America/Argentina/San_Juan","America/Argentina/Mendoza","America/Argentina/San_Luis","America/Argentina/Rio_Gallegos","America/Argentina/Ushuaia"],"aliases":["America/Argentina/ComodRivadavia","America/Buenos_Aires","America/Catamarca","America/Cordoba","America/Jujuy","America/Mendoza","America/Rosario"]},
+{"countryCode":"AS","timeZones":["Pacific/Pago_Pago"],"aliases":["Pacific/Samoa","US/Samoa"]},
+{"countryCode":"AT","timeZones":["Europe/Vienna"]},
+{"countryCode":"AU","timeZones":["Australia/Lord_Howe","Antarctica/Macquarie","Australia/Hobart","Australia/Melbourne","Australia/Sydney","Australia/Broken_Hill","Australia/Brisbane","Australia/Lindeman","Australia/Adelaide","Australia/Darwin","Australia/Perth","Australia/Eucla"],"aliases":["Australia/ACT","Australia/Canberra","Australia/Currie","Australia/LHI","Australia/NSW","Australia/North","Australia/Queensland","Australia/South","Australia/Tasmania","Australia/Victoria","Australia/West","Australia/Yancowinna"]},
+{"countryCode":"AW","timeZones":["America/Aruba"]},
+{"countryCode":"AX","timeZones":["Europe/Mariehamn"]},
+{"countryCode":"AZ","timeZones":["Asia/Baku"]},
+{"countryCode":"BA","timeZones":["Europe/Sarajevo"]},
+{"countryCode":"BB","timeZones":["America/Barbados"]},
+{"countryCode":"BD","timeZones":["Asia/Dhaka"],"aliases":["Asia/Dacca"]},
+{"countryCode":"BE","timeZones":["Europe/Brussels"]},
+{"countryCode":"BF","timeZones":["Africa/Ouagadougou"]},
+{"countryCode":"BG","timeZones":["Europe/Sofia"]},
+{"countryCode":"BH","timeZones":["Asia/Bahrain"]},
+{"countryCode":"BI","timeZones":["Africa/Bujumbura"]},
+{"countryCode":"BJ","timeZones":["Africa/Porto-Novo"]},
+{"countryCode":"BL","timeZones":["America/St_Barthelemy"]},
+{"countryCode":"BM","timeZones":["Atlantic/Bermuda"]},
+{"countryCode":"BN","timeZones":["Asia/Brunei"]},
+{"countryCode":"BO","timeZones":["America/La_Paz"]},
+{"countryCode":"BQ","timeZones":["America/Kralendijk"]},
+{"countryCode":"BR","timeZones":["America/Noronha","America/Belem","America/Fortaleza","America/Recife","America/Araguaina","America/Maceio","America/Bahia","America/Sao_Paulo","America/Campo_Grande","America/Cuiaba","America/Santarem","America/Porto_Velho","America/Boa_Vista","America/Manaus","America/Eirunepe","America/Rio_Branco"],"aliases":["America/Porto_Acre","Brazil/Acre","Brazil/DeNoronha","Brazil/East","Brazil/West"]},
+{"countryCode":"BS","timeZones":["America/Nassau"]},
+{"countryCode":"BT","timeZones":["Asia/Thimphu"],"aliases":["Asia/Thimbu"]},
+{"countryCode":"BW","timeZones":["Africa/Gaborone"]},
+{"countryCode":"BY","timeZones":["Europe/Minsk"]},
+{"countryCode":"BZ","timeZones":["America/Belize"]},
+{"countryCode":"CA","timeZones":["America/St_Johns","America/Halifax","America/Glace_Bay","America/Moncton","America/Goose_Bay","America/Blanc-Sablon","America/Toronto","America/Iqaluit","America/Atikokan","America/Winnipeg","America/Resolute","America/Rankin_Inlet","America/Regina","America/Swift_Current","America/Edmonton","America/Cambridge_Bay","America/Inuvik","America/Creston","America/Dawson_Creek","America/Fort_Nelson","America/Whitehorse","America/Dawson","America/Vancouver"],"aliases":["America/Coral_Harbour","America/Montreal","America/Nipigon","America/Pangnirtung","America/Rainy_River","America/Thunder_Bay","America/Yellowknife","Canada/Atlantic","Canada/Central","Canada/Eastern","Canada/Mountain","Canada/Newfoundland","Canada/Pacific","Canada/Saskatchewan","Canada/Yukon"]},
+{"countryCode":"CC","timeZones":["Indian/Cocos"]},
+{"countryCode":"CD","timeZones":["Africa/Kinshasa","Africa/Lubumbashi"]},
+{"countryCode":"CF","timeZones":["Africa/Bangui"]},
+{"countryCode":"CG","timeZones":["Africa/Brazzaville"]},
+{"countryCode":"CH","timeZones":["Europe/Zurich"]},
+{"countryCode":"CI","timeZones":["Africa/Abidjan"]},
+{"countryCode":"CK","timeZones":["Pacific/Rarotonga"]},
+{"countryCode":"CL","timeZones":["America/Santiago","America/Coyhaique","America/Punta_Arenas","Pacific/Easter"],"aliases":["Chile/Continental","Chile/EasterIsland"]},
+{"countryCode":"CM","timeZones":["Africa/Douala"]},
+{"countryCode":"CN","timeZones":["Asia/Shanghai","Asia/Urumqi"],"aliases":["Asia/Chongqing","Asia/Chungking","Asia/Harbin","Asia/Kashgar"]},
+{"countryCode":"CO","timeZones":["America/Bogota"]},
+{"countryCode":"CR","timeZones":["America/Costa_Rica"]},
+{"countryCode":"CU","timeZones":["America/Havana"]},
+{"countryCode":"CV","timeZones":["Atlantic/Cape_Verde"]},
+{"countryCode":"CW","timeZones":["America/Curacao"]},
+{"countryCode":"CX","timeZones":["Indian/Christmas"]},
+{"countryCode":"CY","timeZones":["Asia/Nicosia","Asia/Famagusta"],"aliases":["Europe/Nicosia"]},
+{"countryCode":"CZ","timeZones":["Europe/Prague"]},
+{"countryCode":"DE","timeZones":["Europe/Berlin","Europe/Busingen"]},
+{"countryCode":"DJ","timeZones":["Africa/Djibouti"]},
+{"countryCode":"DK","timeZones":["Europe/Copenhagen"]},
+{"countryCode":"DM","timeZones":["America/Dominica"]},
+{"countryCode":"DO","timeZones":["America/Santo_Domingo"]},
+{"countryCode":"DZ","timeZones":["Africa/Algiers"]},
+{"countryCode":"EC","timeZones":["America/Guayaquil","Pacific/Galapagos"]},
+{"countryCode":"EE","timeZones":["Europe/Tallinn"]},
+{"countryCode":"EG","timeZones":["Africa/Cairo"]},
+{"countryCode":"EH","timeZones":["Africa/El_Aaiun"]},
+{"countryCode":"ER","timeZones":["Africa/Asmara"],"aliases":["Africa/Asmera"]},
+{"countryCode":"ES","timeZones":["Europe/Madrid","Africa/Ceuta","Atlantic/Canary"]},
+{"countryCode":"ET","timeZones":["Africa/Addis_Ababa"]},
+{"countryCode":"FI","timeZones":["Europe/Helsinki"]},
+{"countryCode":"FJ","timeZones":["Pacific/Fiji"]},
+{"countryCode":"FK","timeZones":["Atlantic/Stanley"]},
+{"countryCode":"FM","timeZones":["Pacific/Chuuk","Pacific/Pohnpei","Pacific/Kosrae"],"aliases":["Pacific/Ponape","Pacific/Truk","Pacific/Yap"]},
+{"countryCode":"FO","timeZones":["Atlantic/Faroe"],"aliases":["Atlantic/Faeroe"]},
+{"countryCode":"FR","timeZones":["Europe/Paris"]},
+{"countryCode":"GA","timeZones":["Africa/Libreville"]},
+{"countryCode":"GB","timeZones":["Europe/London"],"aliases":["Europe/Belfast"]},
+{"countryCode":"GD","timeZones":["America/Grenada"]},
+{"countryCode":"GE","timeZones":["Asia/Tbilisi"]},
+{"countryCode":"GF","timeZones":["America/Cayenne"]},
+{"countryCode":"GG","timeZones":["Europe/Guernsey"]},
+{"countryCode":"GH","timeZones":["Africa/Accra"]},
+{"countryCode":"GI","timeZones":["Europe/Gibraltar"]},
+{"countryCode":"GL","timeZones":["America/Nuuk","America/Danmarkshavn","America/Scoresbysund","America/Thule"],"aliases":["America/Godthab"]},
+{"countryCode":"GM","timeZones":["Africa/Banjul"]},
+{"countryCode":"GN","timeZones":["Africa/Conakry"]},
+{"countryCode":"GP","timeZones":["America/Guadeloupe"]},
+{"countryCode":"GQ","timeZones":["Africa/Malabo"]},
+{"countryCode":"GR","timeZones":["Europe/Athens"]},
+{"countryCode":"GS","timeZones":["Atlantic/South_Georgia"]},
+{"countryCode":"GT","timeZones":["America/Guatemala"]},
+{"countryCode":"GU","timeZones":["Pacific/Guam"]},
+{"countryCode":"GW","timeZones":["Africa/Bissau"]},
+{"countryCode":"GY","timeZones":["America/Guyana"]},
+{"countryCode":"HK","timeZones":["Asia/Hong_Kong"]},
+{"countryCode":"HN","timeZones":["America/Tegucigalpa"]},
+{"countryCode":"HR","timeZones":["Europe/Zagreb"]},
+{"countryCode":"HT","timeZones":["America/Port-au-Prince"]},
+{"countryCode":"HU","timeZones":["Europe/Budapest"]},
+{"countryCode":"ID","timeZones":["Asia/Jakarta","Asia/Pontianak","Asia/Makassar","Asia/Jayapura"],"aliases":["Asia/Ujung_Pandang"]},
+{"countryCode":"IE","timeZones":["Europe/Dublin"]},
+{"countryCode":"IL","timeZones":["Asia/Jerusalem"],"aliases":["Asia/Tel_Aviv"]},
+{"countryCode":"IM","timeZones":["Europe/Isle_of_Man"]},
+{"countryCode":"IN","timeZones":["Asia/Kolkata"],"aliases":["Asia/Calcutta"]},
+{"countryCode":"IO","timeZones":["Indian/Chagos"]},
+{"countryCode":"IQ","timeZones":["Asia/Baghdad"]},
+{"countryCode":"IR","timeZones":["Asia/Tehran"]},
+{"countryCode":"IS","timeZones":["Atlantic/Reykjavik"]},
+{"countryCode":"IT","timeZones":["Europe/Rome"]},
+{"countryCode":"JE","timeZones":["Europe/Jersey"]},
+{"countryCode":"JM","timeZones":["America/Jamaica"]},
+{"countryCode":"JO","timeZones":["Asia/Amman"]},
+{"countryCode":"JP","timeZones":["Asia/Tokyo"]},
+{"countryCode":"KE","timeZones":["Africa/Nairobi"]},
+{"countryCode":"KG","timeZones":["Asia/Bishkek"]},
+{"countryCode":"KH","timeZones":["Asia/Phnom_Penh"]},
+{"countryCode":"KI","timeZones":["Pacific/Tarawa","Pacific/Kanton","Pacific/Kiritimati"],"aliases":["Pacific/Enderbury"]},
+{"countryCode":"KM","timeZones":["Indian/Comoro"]},
+{"countryCode":"KN","timeZones":["America/St_Kitts"]},
+{"countryCode":"KP","timeZones":["Asia/Pyongyang"]},
+{"countryCode":"KR","timeZones":["Asia/Seoul"]},
+{"countryCode":"KW","timeZones":["Asia/Kuwait"]},
+{"countryCode":"KY","timeZones":["America/Cayman"]},
+{"countryCode":"KZ","timeZones":["Asia/Almaty","Asia/Qyzylorda","Asia/Qostanay","Asia/Aqtobe","Asia/Aqtau","Asia/Atyrau","Asia/Oral"]},
+{"countryCode":"LA","timeZones":["Asia/Vientiane"]},
+{"countryCode":"LB","timeZones":["Asia/Beirut"]},
+{"countryCode":"LC","timeZones":["America/St_Lucia"]},
+{"countryCode":"LI","timeZones":["Europe/Vaduz"]},
+{"countryCode":"LK","timeZones":["Asia/Colombo"]},
+{"countryCode":"LR","timeZones":["Africa/Monrovia"]},
+{"countryCode":"LS","timeZones":["Africa/Maseru"]},
+{"countryCode":"LT","timeZones":["Europe/Vilnius"]},
+{"countryCode":"LU","timeZones":["Europe/Luxembourg"]},
+{"countryCode":"LV","timeZones":["Europe/Riga"]},
+{"countryCode":"LY","timeZones":["Africa/Tripoli"]},
+{"countryCode":"MA","timeZones":["Africa/Casablanca"]},
+{"countryCode":"MC","timeZones":["Europe/Monaco"]},
+{"countryCode":"MD","timeZones":["Europe/Chisinau"],"aliases":["Europe/Tiraspol"]},
+{"countryCode":"ME","timeZones":["Europe/Podgorica"]},
+{"countryCode":"MF","timeZones":["America/Marigot"]},
+{"countryCode":"MG","timeZones":["Indian/Antananarivo"]},
+{"countryCode":"MH","timeZones":["Pacific/Majuro","Pacific/Kwajalein"]},
+{"countryCode":"MK","timeZones":["Europe/Skopje"]},
+{"countryCode":"ML","timeZones":["Africa/Bamako"],"aliases":["Africa/Timbuktu"]},
+{"countryCode":"MM","timeZones":["Asia/Yangon"],"aliases":["Asia/Rangoon"]},
+{"countryCode":"MN","timeZones":["Asia/Ulaanbaatar","Asia/Hovd"],"aliases":["Asia/Choibalsan","Asia/Ulan_Bator"]},
+{"countryCode":"MO","timeZones":["Asia/Macau"],"aliases":["Asia/Macao"]},
+{"countryCode":"MP","timeZones":["Pacific/Saipan"]},
+{"countryCode":"MQ","timeZones":["America/Martinique"]},
+{"countryCode":"MR","timeZones":["Africa/Nouakchott"]},
+{"countryCode":"MS","timeZones":["America/Montserrat"]},
+{"countryCode":"MT","timeZones":["Europe/Malta"]},
+{"countryCode":"MU","timeZones":["Indian/Mauritius"]},
+{"countryCode":"MV","timeZones":["Indian/Maldives"]},
+{"countryCode":"MW","timeZones":["Africa/Blantyre"]},
+{"countryCode":"MX","timeZones":["America/Mexico_City","America/Cancun","America/Merida","America/Monterrey","America/Matamoros","America/Chihuahua","America/Ciudad_Juarez","America/Ojinaga","America/Mazatlan","America/Bahia_Banderas","America/Hermosillo","America/Tijuana"],"aliases":["America/Ensenada","America/Santa_Isabel","Mexico/BajaNorte","Mexico/BajaSur","Mexico/General"]},
+{"countryCode":"MY","timeZones":["Asia/Kuala_Lumpur","Asia/Kuching"]},
+{"countryCode":"MZ","timeZones":["Africa/Maputo"]},
+{"countryCode":"NA","timeZones":["Africa/Windhoek"]},
+{"countryCode":"NC","timeZones":["Pacific/Noumea"]},
+{"countryCode":"NE","timeZones":["Africa/Niamey"]},
+{"countryCode":"NF","timeZones":["Pacific/Norfolk"]},
+{"countryCode":"NG","timeZones":["Africa/Lagos"]},
+{"countryCode":"NI","timeZones":["America/Managua"]},
+{"countryCode":"NL","timeZones":["Europe/Amsterdam"]},
+{"countryCode":"NO","timeZones":["Europe/Oslo"]},
+{"countryCode":"NP","timeZones":["Asia/Kathmandu"],"aliases":["Asia/Katmandu"]},
+{"countryCode":"NR","timeZones":["Pacific/Nauru"]},
+{"countryCode":"NU","timeZones":["Pacific/Niue"]},
+{"countryCode":"NZ","timeZones":["Pacific/Auckland","Pacific/Chatham"]},
+{"countryCode":"OM","timeZones":["Asia/Muscat"]},
+{"countryCode":"PA","timeZones":["America/Panama"]},
+{"countryCode":"PE","timeZones":["America/Lima"]},
+{"countryCode":"PF","timeZones":["Pacific/Tahiti","Pacific/Marquesas","Pacific/Gambier"]},
+{"countryCode":"PG","timeZones":["Pacific/Port_Moresby","Pacific/Bougainville"]},
+{"countryCode":"PH","timeZones":["Asia/Manila"]},
+{"countryCode":"PK","timeZones":["Asia/Karachi"]},
+{"countryCode":"PL","timeZones":["Europe/Warsaw"]},
+{"countryCode":"PM","timeZones":["America/Miquelon"]},
+{"countryCode":"PN","timeZones":["Pacific/Pitcairn"]},
+{"countryCode":"PR","timeZones":["America/Puerto_Rico"]},
+{"countryCode":"PS","timeZones":["Asia/Gaza","Asia/Hebron"]},
+{"countryCode":"PT","timeZones":["Europe/Lisbon","Atlantic/Madeira","Atlantic/Azores"]},
+{"countryCode":"PW","timeZones":["Pacific/Palau"]},
+{"countryCode":"PY","timeZones":["America/Asuncion"]},
+{"countryCode":"QA","timeZones":["Asia/Qatar"]},
+{"countryCode":"RE","timeZones":["Indian/Reunion"]},
+{"countryCode":"RO","timeZones":["Europe/Bucharest"]},
+{"countryCode":"RS","timeZones":["Europe/Belgrade"]},
+{"countryCode":"RU","timeZones":["Europe/Kaliningrad","Europe/Moscow","Europe/Kirov","Europe/Volgograd","Europe/Astrakhan","Europe/Saratov","Europe/Ulyanovsk","Europe/Samara","Asia/Yekaterinburg","Asia/Omsk","Asia/Novosibirsk","Asia/Barnaul","Asia/Tomsk","Asia/Novokuznetsk","Asia/Krasnoyarsk","Asia/Irkutsk","Asia/Chita","Asia/Yakutsk","Asia/Khandyga","Asia/Vladivostok","Asia/Ust-Nera","Asia/Magadan","Asia/Sakhalin","Asia/Srednekolymsk","Asia/Kamchatka","Asia/Anadyr"]},
+{"countryCode":"RW","timeZones":["Africa/Kigali"]},
+{"countryCode":"SA","timeZones":["Asia/Riyadh"]},
+{"countryCode":"SB","timeZones":["Pacific/Guadalcanal"]},
+{"countryCode":"SC","timeZones":["Indian/Mahe"]},
+{"countryCode":"SD","timeZones":["Africa/Khartoum"]},
+{"countryCode":"SE","timeZones":["Europe/Stockholm"]},
+{"countryCode":"SG","timeZones":["Asia/Singapore"]},
+{"countryCode":"SH","timeZones":["Atlantic/St_Helena"]},
+{"countryCode":"SI","timeZones":["Europe/Ljubljana"]},
+{"countryCode":"SJ","timeZones":["Arctic/Longyearbyen"],"aliases":["Atlantic/Jan_Mayen"]},
+{"countryCode":"SK","timeZones":["Europe/Bratislava"]},
+{"countryCode":"SL","timeZones":["Africa/Freetown"]},
+{"countryCode":"SM","timeZones":["Europe/San_Marino"]},
+{"countryCode":"SN","timeZones":["Africa/Dakar"]},
+{"countryCode":"SO","timeZones":["Africa/Mogadishu"]},
+{"countryCode":"SR","timeZones":["America/Paramaribo"]},
+{"countryCode":"SS","timeZones":["Africa/Juba"]},
+{"countryCode":"ST","timeZones":["Africa/Sao_Tome"]},
+{"countryCode":"SV","timeZones":["America/El_Salvador"]},
+{"countryCode":"SX","timeZones":["America/Lower_Princes"]},
+{"countryCode":"SY","timeZones":["Asia/Damascus"]},
+{"countryCode":"SZ","timeZones":["Africa/Mbabane"]},
+{"countryCode":"TC","timeZones":["America/Grand_Turk"]},
+{"countryCode":"TD","timeZones":["Africa/Ndjamena"]},
+{"countryCode":"TF","timeZones":["Indian/Kerguelen"]},
+{"countryCode":"TG","timeZones":["Africa/Lome"]},
+{"countryCode":"TH","timeZones":["Asia/Bangkok"]},
+{"countryCode":"TJ","timeZones":["Asia/Dushanbe"]},
+{"countryCode":"TK","timeZones":["Pacific/Fakaofo"]},
+{"countryCode":"TL","timeZones":["Asia/Dili"]},
+{"countryCode":"TM","timeZones":["Asia/Ashgabat"],"aliases":["Asia/Ashkhabad"]},
+{"countryCode":"TN","timeZones":["Africa/Tunis"]},
+{"countryCode":"TO","timeZones":["Pacific/Tongatapu"]},
+{"countryCode":"TR","timeZones":["Europe/Istanbul"],"aliases":["Asia/Istanbul"]},
+{"countryCode":"TT","timeZones":["America/Port_of_Spain"]},
+{"countryCode":"TV","timeZones":["Pacific/Funafuti"]},
+{"countryCode":"TW","timeZones":["Asia/Taipei"]},
+{"countryCode":"TZ","timeZones":["Africa/Dar_es_Salaam"]},
+{"countryCode":"UA","timeZones":["Europe/Simferopol","Europe/Kyiv"],"aliases":["Europe/Kiev","Europe/Uzhgorod","Europe/Zaporozhye"]},
+{"countryCode":"UG","timeZones":["Africa/Kampala"]},
+{"countryCode":"UM","timeZones":["Pacific/Midway","Pacific/Wake"],"aliases":["Pacific/Johnston"]},
+{"countryCode":"US","timeZones":["America/New_York","America/Detroit","America/Kentucky/Louisville","America/Kentucky/Monticello","America/Indiana/Indianapolis","America/Indiana/Vincennes","America/Indiana/Winamac","America/Indiana/Marengo","America/Indiana/Petersburg","America/Indiana/Vevay","America/Chicago","America/Indiana/Tell_City","America/Indiana/Knox","America/Menominee","America/North_Dakota/Center","America/North_Dakota/New_Salem","America/North_Dakota/Beulah","America/Denver","America/Boise","America/Phoenix","America/Los_Angeles","America/Anchorage","America/Juneau","America/Sitka","America/Metlakatla","America/Yakutat","America/Nome","America/Adak","Pacific/Honolulu"],"aliases":["America/Atka","America/Fort_Wayne","America/Indianapolis","America/Knox_IN","America/Louisville","America/Shiprock","US/Alaska","US/Aleutian","US/Arizona","US/Central","US/East-Indiana","US/Eastern","US/Hawaii","US/Indiana-Starke","US/Michigan","US/Mountain","US/Pacific"]},
+{"countryCode":"UY","timeZones":["America/Montevideo"]},
+{"countryCode":"UZ","timeZones":["Asia/Samarkand","Asia/Tashkent"]},
+{"countryCode":"VA","timeZones":["Europe/Vatican"]},
+{"countryCode":"VC","timeZones":["America/St_Vincent"]},
+{"countryCode":"VE","timeZones":["America/Caracas"]},
+{"countryCode":"VG","timeZones":["America/Tortola"]},
+{"countryCode":"VI","timeZones":["America/St_Thomas"],"aliases":["America/Virgin"]},
+{"countryCode":"VN","timeZones":["Asia/Ho_Chi_Minh"],"aliases":["Asia/Saigon"]},
+{"countryCode":"VU","timeZones":["Pacific/Efate"]},
+{"countryCode":"WF","timeZones":["Pacific/Wallis"]},
+{"countryCode":"WS","timeZones":["Pacific/Apia"]},
+{"countryCode":"YE","timeZones":["Asia/Aden"]},
+{"countryCode":"YT","timeZones":["Indian/Mayotte"]},
+{"countryCode":"ZA","timeZones":["Africa/Johannesburg"]},
+{"countryCode":"ZM","timeZones":["Africa/Lusaka"]},
+{"countryCode":"ZW","timeZones":["Africa/Harare"]}
+]`
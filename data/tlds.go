@@ -0,0 +1,260 @@
+package data
+
+// EXAMPLE DATA
+/*
+  {
+    "countryCode":"RU",
+    "tlds":[".ru",".рф"]
+  }
+*/
+
+// TLDJSONData is the raw JSON for the country-code top-level domains (ccTLD) of every country
+// The ASCII ccTLD comes first, followed by the internationalized (IDN) ccTLDs in their Unicode form.
+// Source: IANA Root Zone Database (delegated ccTLDs and IDN ccTLDs)
+// Countries without a delegated ccTLD in use (BL, BQ, EH, MF, UM) are omitted.
+const TLDJSONData = `[
+{"countryCode":"AD","tlds":[".ad"]},
+{"countryCode":"AE","tlds":[".ae",".امارات"]},
+{"countryCode":"AF","tlds":[".af"]},
+{"countryCode":"AG","tlds":[".ag"]},
+{"countryCode":"AI","tlds":[".ai"]},
+{"countryCode":"AL","tlds":[".al"]},
+{"countryCode":"AM","tlds":[".am",".հայ"]},
+{"countryCode":"AO","tlds":[".ao"]},
+{"countryCode":"AQ","tlds":[".aq"]},
+{"countryCode":"AR","tlds":[".ar"]},
+{"countryCode":"AS","tlds":[".as"]},
+{"countryCode":"AT","tlds":[".at"]},
+{"countryCode":"AU","tlds":[".au"]},
+{"countryCode":"AW","tlds":[".aw"]},
+{"countryCode":"AX","tlds":[".ax"]},
+{"countryCode":"AZ","tlds":[".az"]},
+{"countryCode":"BA","tlds":[".ba"]},
+{"countryCode":"BB","tlds":[".bb"]},
+{"countryCode":"BD","tlds":[".bd",".বাংলা"]},
+{"countryCode":"BE","tlds":[".be"]},
+{"countryCode":"BF","tlds":[".bf"]},
+{"countryCode":"BG","tlds":[".bg",".бг"]},
+{"countryCode":"BH","tlds":[".bh",".البحرين"]},
+{"countryCode":"BI","tlds":[".bi"]},
+{"countryCode":"BJ","tlds":[".bj"]},
+{"countryCode":"BM","tlds":[".bm"]},
+{"countryCode":"BN","tlds":[".bn"]},
+{"countryCode":"BO","tlds":[".bo"]},
+{"countryCode":"BR","tlds":[".br"]},
+{"countryCode":"BS","tlds":[".bs"]},
+{"countryCode":"BT","tlds":[".bt"]},
+{"countryCode":"BV","tlds":[".bv"]},
+{"countryCode":"BW","tlds":[".bw"]},
+{"countryCode":"BY","tlds":[".by",".бел"]},
+{"countryCode":"BZ","tlds":[".bz"]},
+{"countryCode":"CA","tlds":[".ca"]},
+{"countryCode":"CC","tlds":[".cc"]},
+{"countryCode":"CD","tlds":[".cd"]},
+{"countryCode":"CF","tlds":[".cf"]},
+{"countryCode":"CG","tlds":[".cg"]},
+{"countryCode":"CH","tlds":[".ch"]},
+{"countryCode":"CI","tlds":[".ci"]},
+{"countryCode":"CK","tlds":[".ck"]},
+{"countryCode":"CL","tlds":[".cl"]},
+{"countryCode":"CM","tlds":[".cm"]},
+{"countryCode":"CN","tlds":[".cn",".中国",".中國"]},
+{"countryCode":"CO","tlds":[".co"]},
+{"countryCode":"CR","tlds":[".cr"]},
+{"countryCode":"CU","tlds":[".cu"]},
+{"countryCode":"CV","tlds":[".cv"]},
+{"countryCode":"CW","tlds":[".cw"]},
+{"countryCode":"CX","tlds":[".cx"]},
+{"countryCode":"CY","tlds":[".cy"]},
+{"countryCode":"CZ","tlds":[".cz"]},
+{"countryCode":"DE","tlds":[".de"]},
+{"countryCode":"DJ","tlds":[".dj"]},
+{"countryCode":"DK","tlds":[".dk"]},
+{"countryCode":"DM","tlds":[".dm"]},
+{"countryCode":"DO","tlds":[".do"]},
+{"countryCode":"DZ","tlds":[".dz",".الجزائر"]},
+{"countryCode":"EC","tlds":[".ec"]},
+{"countryCode":"EE","tlds":[".ee"]},
+{"countryCode":"EG","tlds":[".eg",".مصر"]},
+{"countryCode":"ER","tlds":[".er"]},
+{"countryCode":"ES","tlds":[".es"]},
+{"countryCode":"ET","tlds":[".et"]},
+{"countryCode":"FI","tlds":[".fi"]},
+{"countryCode":"FJ","tlds":[".fj"]},
+{"countryCode":"FK","tlds":[".fk"]},
+{"countryCode":"FM","tlds":[".fm"]},
+{"countryCode":"FO","tlds":[".fo"]},
+{"countryCode":"FR","tlds":[".fr"]},
+{"countryCode":"GA","tlds":[".ga"]},
+{"countryCode":"GB","tlds":[".uk"]},
+{"countryCode":"GD","tlds":[".gd"]},
+{"countryCode":"GE","tlds":[".ge",".გე"]},
+{"countryCode":"GF","tlds":[".gf"]},
+{"countryCode":"GG","tlds":[".gg"]},
+{"countryCode":"GH","tlds":[".gh"]},
+{"countryCode":"GI","tlds":[".gi"]},
+{"countryCode":"GL","tlds":[".gl"]},
+{"countryCode":"GM","tlds":[".gm"]},
+{"countryCode":"GN","tlds":[".gn"]},
+{"countryCode":"GP","tlds":[".gp"]},
+{"countryCode":"GQ","tlds":[".gq"]},
+{"countryCode":"GR","tlds":[".gr",".ελ"]},
+{"countryCode":"GS","tlds":[".gs"]},
+{"countryCode":"GT","tlds":[".gt"]},
+{"countryCode":"GU","tlds":[".gu"]},
+{"countryCode":"GW","tlds":[".gw"]},
+{"countryCode":"GY","tlds":[".gy"]},
+{"countryCode":"HK","tlds":[".hk",".香港"]},
+{"countryCode":"HM","tlds":[".hm"]},
+{"countryCode":"HN","tlds":[".hn"]},
+{"countryCode":"HR","tlds":[".hr"]},
+{"countryCode":"HT","tlds":[".ht"]},
+{"countryCode":"HU","tlds":[".hu"]},
+{"countryCode":"ID","tlds":[".id"]},
+{"countryCode":"IE","tlds":[".ie"]},
+{"countryCode":"IL","tlds":[".il"]},
+{"countryCode":"IM","tlds":[".im"]},
+{"countryCode":"IN","tlds":[".in",".भारत",".ভারত",".ਭਾਰਤ",".ભારત",".இந்தியா",".భారత్",".بھارت",".ಭಾರತ",".ഭാരതം",".ଭାରତ",".ভাৰত",".भारतम्",".भारोत",".ڀارت",".بارت"]},
+{"countryCode":"IO","tlds":[".io"]},
+{"countryCode":"IQ","tlds":[".iq",".عراق"]},
+{"countryCode":"IR","tlds":[".ir",".ایران"]},
+{"countryCode":"IS","tlds":[".is"]},
+{"countryCode":"IT","tlds":[".it"]},
+{"countryCode":"JE","tlds":[".je"]},
+{"countryCode":"JM","tlds":[".jm"]},
+{"countryCode":"JO","tlds":[".jo",".الاردن"]},
+{"countryCode":"JP","tlds":[".jp"]},
+{"countryCode":"KE","tlds":[".ke"]},
+{"countryCode":"KG","tlds":[".kg"]},
+{"countryCode":"KH","tlds":[".kh"]},
+{"countryCode":"KI","tlds":[".ki"]},
+{"countryCode":"KM","tlds":[".km"]},
+{"countryCode":"KN","tlds":[".kn"]},
+{"countryCode":"KP","tlds":[".kp"]},
+{"countryCode":"KR","tlds":[".kr",".한국"]},
+{"countryCode":"KW","tlds":[".kw"]},
+{"countryCode":"KY","tlds":[".ky"]},
+{"countryCode":"KZ","tlds":[".kz",".қаз"]},
+{"countryCode":"LA","tlds":[".la",".ລາວ"]},
+{"countryCode":"LB","tlds":[".lb"]},
+{"countryCode":"LC","tlds":[".lc"]},
+{"countryCode":"LI","tlds":[".li"]},
+{"countryCode":"LK","tlds":[".lk",".ලංකා",".இலங்கை"]},
+{"countryCode":"LR","tlds":[".lr"]},
+{"countryCode":"LS","tlds":[".ls"]},
+{"countryCode":"LT","tlds":[".lt"]},
+{"countryCode":"LU","tlds":[".lu"]},
+{"countryCode":"LV","tlds":[".lv"]},
+{"countryCode":"LY","tlds":[".ly"]},
+{"countryCode":"MA","tlds":[".ma",".المغرب"]},
+{"countryCode":"MC","tlds":[".mc"]},
+{"countryCode":"MD","tlds":[".md"]},
+{"countryCode":"ME","tlds":[".me"]},
+{"countryCode":"MG","tlds":[".mg"]},
+{"countryCode":"MH","tlds":[".mh"]},
+{"countryCode":"MK","tlds":[".mk",".мкд"]},
+{"countryCode":"ML","tlds":[".ml"]},
+{"countryCode":"MM","tlds":[".mm"]},
+{"countryCode":"MN","tlds":[".mn",".мон"]},
+{"countryCode":"MO","tlds":[".mo",".澳門"]},
+{"countryCode":"MP","tlds":[".mp"]},
+{"countryCode":"MQ","tlds":[".mq"]},
+{"countryCode":"MR","tlds":[".mr",".موريتانيا"]},
+{"countryCode":"MS","tlds":[".ms"]},
+{"countryCode":"MT","tlds":[".mt"]},
+{"countryCode":"MU","tlds":[".mu"]},
+{"countryCode":"MV","tlds":[".mv"]},
+{"countryCode":"MW","tlds":[".mw"]},
+{"countryCode":"MX","tlds":[".mx"]},
+{"countryCode":"MY","tlds":[".my",".مليسيا"]},
+{"countryCode":"MZ","tlds":[".mz"]},
+{"countryCode":"NA","tlds":[".na"]},
+{"countryCode":"NC","tlds":[".nc"]},
+{"countryCode":"NE","tlds":[".ne"]},
+{"countryCode":"NF","tlds":[".nf"]},
+{"countryCode":"NG","tlds":[".ng"]},
+{"countryCode":"NI","tlds":[".ni"]},
+{"countryCode":"NL","tlds":[".nl"]},
+{"countryCode":"NO","tlds":[".no"]},
+{"countryCode":"NP","tlds":[".np"]},
+{"countryCode":"NR","tlds":[".nr"]},
+{"countryCode":"NU","tlds":[".nu"]},
+{"countryCode":"NZ","tlds":[".nz"]},
+{"countryCode":"OM","tlds":[".om",".عمان"]},
+{"countryCode":"PA","tlds":[".pa"]},
+{"countryCode":"PE","tlds":[".pe"]},
+{"countryCode":"PF","tlds":[".pf"]},
+{"countryCode":"PG","tlds":[".pg"]},
+{"countryCode":"PH","tlds":[".ph"]},
+{"countryCode":"PK","tlds":[".pk",".پاکستان"]},
+{"countryCode":"PL","tlds":[".pl"]},
+{"countryCode":"PM","tlds":[".pm"]},
+{"countryCode":"PN","tlds":[".pn"]},
+{"countryCode":"PR","tlds":[".pr"]},
+{"countryCode":"PS","tlds":[".ps",".فلسطين"]},
+{"countryCode":"PT","tlds":[".pt"]},
+{"countryCode":"PW","tlds":[".pw"]},
+{"countryCode":"PY","tlds":[".py"]},
+{"countryCode":"QA","tlds":[".qa",".قطر"]},
+{"countryCode":"RE","tlds":[".re"]},
+{"countryCode":"RO","tlds":[".ro"]},
+{"countryCode":"RS","tlds":[".rs",".срб"]},
+{"countryCode":"RU","tlds":[".ru",".рф"]},
+{"countryCode":"RW","tlds":[".rw"]},
+{"countryCode":"SA","tlds":[".sa",".السعودية"]},
+{"countryCode":"SB","tlds":[".sb"]},
+{"countryCode":"SC","tlds":[".sc"]},
+{"countryCode":"SD","tlds":[".sd",".سودان"]},
+{"countryCode":"SE","tlds":[".se"]},
+{"countryCode":"SG","tlds":[".sg",".新加坡",".சிங்கப்பூர்"]},
+{"countryCode":"SH","tlds":[".sh"]},
+{"countryCode":"SI","tlds":[".si"]},
+{"countryCode":"SJ","tlds":[".sj"]},
+{"countryCode":"SK","tlds":[".sk"]},
+{"countryCode":"SL","tlds":[".sl"]},
+{"countryCode":"SM","tlds":[".sm"]},
+{"countryCode":"SN","tlds":[".sn"]},
+{"countryCode":"SO","tlds":[".so"]},
+{"countryCode":"SR","tlds":[".sr"]},
+{"countryCode":"SS","tlds":[".ss"]},
+{"countryCode":"ST","tlds":[".st"]},
+{"countryCode":"SV","tlds":[".sv"]},
+{"countryCode":"SX","tlds":[".sx"]},
+{"countryCode":"SY","tlds":[".sy",".سورية"]},
+{"countryCode":"SZ","tlds":[".sz"]},
+{"countryCode":"TC","tlds":[".tc"]},
+{"countryCode":"TD","tlds":[".td"]},
+{"countryCode":"TF","tlds":[".tf"]},
+{"countryCode":"TG","tlds":[".tg"]},
+{"countryCode":"TH","tlds":[".th",".ไทย"]},
+{"countryCode":"TJ","tlds":[".tj"]},
+{"countryCode":"TK","tlds":[".tk"]},
+{"countryCode":"TL","tlds":[".tl"]},
+{"countryCode":"TM","tlds":[".tm"]},
+{"countryCode":"TN","tlds":[".tn",".تونس"]},
+{"countryCode":"TO","tlds":[".to"]},
+{"countryCode":"TR","tlds":[".tr"]},
+{"countryCode":"TT","tlds":[".tt"]},
+{"countryCode":"TV","tlds":[".tv"]},
+{"countryCode":"TW","tlds":[".tw",".台灣",".台湾"]},
+{"countryCode":"TZ","tlds":[".tz"]},
+{"countryCode":"UA","tlds":[".ua",".укр"]},
+{"countryCode":"UG","tlds":[".ug"]},
+{"countryCode":"US","tlds":[".us"]},
+{"countryCode":"UY","tlds":[".uy"]},
+{"countryCode":"UZ","tlds":[".uz"]},
+{"countryCode":"VA","tlds":[".va"]},
+{"countryCode":"VC","tlds":[".vc"]},
+{"countryCode":"VE","tlds":[".ve"]},
+{"countryCode":"VG","tlds":[".vg"]},
+{"countryCode":"VI","tlds":[".vi"]},
+{"countryCode":"VN","tlds":[".vn"]},
+{"countryCode":"VU","tlds":[".vu"]},
+{"countryCode":"WF","tlds":[".wf"]},
+{"countryCode":"WS","tlds":[".ws"]},
+{"countryCode":"YE","tlds":[".ye",".اليمن"]},
+{"countryCode":"YT","tlds":[".yt"]},
+{"countryCode":"ZA","tlds":[".za"]},
+{"countryCode":"ZM","tlds":[".zm"]},
+{"countryCode":"ZW","tlds":[".zw"]}
+]`
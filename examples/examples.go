@@ -3,9 +3,15 @@
 package main
 
 import (
+	"encoding/json"
+	"errors"
 	"log"
+	"strings"
 
 	"github.com/mrz1836/go-countries"
+	"github.com/mrz1836/go-countries/geo"
+	"github.com/mrz1836/go-countries/phone"
+	"github.com/mrz1836/go-countries/sqlschema"
 )
 
 func main() {
@@ -13,22 +19,142 @@ func main() {
 	usa := countries.GetByName("United States of America")
 	log.Printf("USA alpha-2: %s", usa.Alpha2)
 
+	// Lookup by alias and display the common and official names (United Kingdom)
+	uk := countries.GetByName("UK")
+	log.Printf("UK: %s (officially the %s)", uk.CommonName, uk.OfficialName)
+
+	// Lookup names typed without diacritics or in ISO order (Côte d'Ivoire, Republic of Korea)
+	log.Printf("Cote dIvoire: %s", countries.GetByName("Cote dIvoire").Alpha2)
+	log.Printf("Korea, Republic of: %s", countries.GetByName("Korea, Republic of").CommonName)
+
+	// Display localized and native names, and lookup a country by its name in another language (United States, Mexico)
+	log.Printf("USA in German: %s, in French: %s", usa.LocalizedName("de"), usa.LocalizedName("fr-CA"))
+	log.Printf("Vereinigte Staaten: %s", countries.GetByLocalizedName("Vereinigte Staaten", "de").Alpha2)
+	log.Printf("Mexico natively: %s", countries.GetByAlpha3Code(countries.Alpha3MEX).NativeNames[0].Name)
+
+	// Build the options of a country picker, sorted in German (Ägypten sorts with the A's)
+	for _, option := range countries.PickerOptions("de")[:3] {
+		log.Printf("Picker option: %s %s %s (%s)", option.Flag, option.Label, option.DialCode, option.Value)
+	}
+
 	// Lookup by alpha-2 code (Canada)
-	canada := countries.GetByAlpha2(countries.Alpha2CA)
+	canada := countries.GetByAlpha2Code(countries.Alpha2CA)
 	log.Printf("Canada name: %s", canada.Name)
 
 	// Lookup by alpha-3 code (Mexico)
-	mexico := countries.GetByAlpha3(countries.Alpha3MEX)
+	mexico := countries.GetByAlpha3Code(countries.Alpha3MEX)
 	log.Printf("Mexico capital: %s", mexico.Capital)
 
+	// Convert typed codes, which the compiler keeps apart (Canada)
+	log.Printf("Canada alpha-3: %s, numeric: %s", countries.Alpha2CA.ToAlpha3(), countries.Alpha2CA.ToNumeric())
+
+	// Marshal a country as a compact code, and unmarshal it from an alpha-3 code (Mexico)
+	var order struct {
+		Destination countries.Code `json:"destination"`
+	}
+	if err := json.Unmarshal([]byte(`{"destination":"MEX"}`), &order); err == nil {
+		data, _ := json.Marshal(order)
+		log.Printf("Order destination %s as JSON: %s", order.Destination.Country.Name, data)
+	}
+
+	// Store a country in a database column, and seed a table of countries (Mexico)
+	if value, err := order.Destination.Value(); err == nil {
+		log.Printf("Order destination stored as: %v", value)
+	}
+	if statement, err := sqlschema.CreateTable(sqlschema.Postgres); err == nil {
+		log.Printf("Countries table: %s", strings.SplitN(statement, "\n", 2)[0])
+	}
+
 	// Lookup by numeric country code (US)
 	byCode := countries.GetByCountryCode("840")
 	log.Printf("Country for code 840: %s", byCode.Name)
 
+	// Lookup a country from any identifier, detecting its kind (Mexico)
+	if country, kind := countries.Lookup("484"); country != nil {
+		log.Printf("Lookup 484: %s (%s)", country.Name, kind)
+	}
+
+	// Lookup a code with an error explaining a miss, and a suggestion (United Kingdom)
+	if _, err := countries.LookupAlpha2("UK"); errors.Is(err, countries.ErrNotFound) {
+		log.Printf("Lookup failed: %v", err)
+	}
+
 	// Lookup by ISO 3166-2 code (Canada)
 	byISO := countries.GetByISO31662("ISO 3166-2:CA")
 	log.Printf("Country for ISO 3166-2 'ISO 3166-2:CA': %s", byISO.Name)
 
+	// Lookup the currency of a country (Mexico)
+	peso := mexico.Currency()
+	log.Printf("Mexico currency: %s (%s, %d minor units)", peso.Name, peso.Symbol, peso.MinorUnits)
+
+	// Lookup by international calling code and resolve a phone number prefix (United States)
+	byCallingCode := countries.GetByCallingCode("+1")
+	log.Printf("Country for calling code +1: %s", byCallingCode.Name)
+	log.Printf("Country for +1 212 555 0100: %s", countries.ResolvePhonePrefix("+1 212 555 0100").Name)
+
+	// Parse and validate a phone number in national format (Canada)
+	if number, err := phone.Parse("(416) 555-0100", countries.Alpha2CA); err == nil {
+		log.Printf("Phone number %s belongs to %s", number.E164, number.Country.Name)
+	}
+
+	// Search countries from free text, tolerating typos (Germany)
+	for _, match := range countries.Search("germny", 3) {
+		log.Printf("Search match: %s (%s, score %.2f)", match.Country.Name, match.Field, match.Score)
+	}
+
+	// Display the flag emoji of a country and find a country from its flag emoji
+	log.Printf("%s %s", mexico.FlagEmoji(), mexico.Name)
+	log.Printf("Country for 🇯🇵: %s", countries.GetByFlagEmoji("🇯🇵").Name)
+
+	// Lookup a country by its top-level domain, including punycode for internationalized domains
+	log.Printf("Country for .xn--p1ai: %s", countries.GetByTLD(".xn--p1ai").Name)
+
+	// List the countries where Spanish is an official language
+	for _, country := range countries.CountriesSpeaking("es") {
+		for _, language := range country.Languages {
+			if language.Code == "es" && language.Official {
+				log.Printf("Spanish is official in %s", country.Name)
+			}
+		}
+	}
+
+	// Resolve a locale to its country, or to every country of a UN M.49 area (Latin America)
+	log.Printf("Country for pt_BR: %s", countries.GetByLocale("pt_BR")[0].Name)
+	log.Printf("Countries for es-419: %d", len(countries.GetByLanguageTag("es-419")))
+
+	// Preselect a shipping country from an Accept-Language header
+	if candidates := countries.GetByAcceptLanguage("de-AT, de;q=0.9, en;q=0.5"); len(candidates) > 0 {
+		log.Printf("Preselected country: %s", candidates[0].Name)
+	}
+
+	// Find the country of a time zone and list its time zones (Germany)
+	germany := countries.GetByTimeZone("Europe/Berlin")
+	log.Printf("Time zones of %s: %v", germany.Name, germany.TimeZones)
+
+	// Estimate the distance between two countries and find the countries nearest to a location (Berlin)
+	log.Printf("Germany to Japan: %.0f km", germany.DistanceTo(countries.GetByAlpha2Code(countries.Alpha2JP)))
+	for _, country := range countries.NearestCountries(52.52, 13.405, 3) {
+		log.Printf("Near Berlin: %s", country.Name)
+	}
+
+	// Check whether road transport is possible between two countries (Portugal to Poland)
+	portugal, poland := countries.GetByAlpha2Code(countries.Alpha2PT), countries.GetByAlpha2Code(countries.Alpha2PL)
+	if route := countries.ShortestLandPath(portugal, poland); route != nil {
+		log.Printf("Portugal to Poland crosses %d borders", len(route)-1)
+	}
+
+	// Find the country containing GPS coordinates, offline (Maseru, an enclave within South Africa)
+	if country := geo.CountryAt(-29.31, 27.48); country != nil {
+		log.Printf("Country at -29.31, 27.48: %s", country.Name)
+	}
+
+	// Lookup a subdivision by its ISO 3166-2 code (California)
+	california := countries.GetSubdivision("US-CA")
+	log.Printf("Subdivision US-CA: %s (%s)", california.Name, california.Category)
+
+	// List every subdivision of a country (Canada)
+	log.Printf("Canada subdivisions: %d", len(canada.Subdivisions()))
+
 	// Get the full list of countries
 	all := countries.GetAll()
 	log.Printf("Total countries available: %d", len(all))
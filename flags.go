@@ -0,0 +1,92 @@
+package countries
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	// regionalIndicatorA is the Unicode regional indicator symbol for the letter A (U+1F1E6)
+	regionalIndicatorA = 0x1F1E6
+
+	// regionalIndicatorZ is the Unicode regional indicator symbol for the letter Z (U+1F1FF)
+	regionalIndicatorZ = regionalIndicatorA + 'Z' - 'A'
+
+	// variationSelectorEmoji requests the emoji presentation of the preceding character (U+FE0F)
+	variationSelectorEmoji = '\uFE0F'
+)
+
+// FlagEmoji returns the flag emoji of the Country.
+//
+// This function performs the following steps:
+// - Maps both letters of the alpha-2 code to their Unicode regional indicator symbols (A is U+1F1E6)
+// - Joins the two symbols, which platforms render as the flag of the country
+//
+// Parameters:
+// - None
+//
+// Returns:
+// - Flag emoji as a string of two regional indicator symbols (e.g., "🇩🇪" for Germany),
+// or an empty string when the Alpha2 field is not two uppercase letters
+//
+// Side Effects:
+// - None
+//
+// Notes:
+// - Platforms without flag glyphs (e.g., Windows) display the two letters of the code instead
+// - A few territories share the flag of their parent country (e.g., BV and SJ display the flag of Norway)
+func (c *Country) FlagEmoji() string {
+	if len(c.Alpha2) != 2 || !isUpperLetter(c.Alpha2[0]) || !isUpperLetter(c.Alpha2[1]) {
+		return ""
+	}
+
+	var buf [2 * utf8.UTFMax]byte
+	n := utf8.EncodeRune(buf[:], regionalIndicatorA+rune(c.Alpha2[0]-'A'))
+	n += utf8.EncodeRune(buf[n:], regionalIndicatorA+rune(c.Alpha2[1]-'A'))
+	return string(buf[:n])
+}
+
+// GetByFlagEmoji retrieves a Country by its flag emoji.
+//
+// This function performs the following steps:
+// - Trims surrounding spaces and an optional emoji variation selector (U+FE0F)
+// - Decodes exactly two Unicode regional indicator symbols into an alpha-2 code
+// - Performs a constant-time map lookup using the alpha-2 code
+//
+// - Returns the Country pointer on success
+// - Returns nil if the input is not the flag emoji of a known country
+//
+// Parameters:
+// - emoji: flag emoji (e.g., "🇩🇪")
+//
+// Returns:
+// - Pointer to the Country struct, or nil when no match is found
+//
+// Side Effects:
+// - None
+//
+// Notes:
+// - Flags of subdivisions (e.g., the tag sequence of Scotland) and of organizations (e.g., 🇪🇺 and 🇺🇳) return nil
+// - Returned pointer references package-level data without copying
+func GetByFlagEmoji(emoji string) *Country {
+	emoji = strings.TrimSuffix(strings.TrimSpace(emoji), string(variationSelectorEmoji))
+
+	var alpha2 [2]byte
+	for i := range alpha2 {
+		r, size := utf8.DecodeRuneInString(emoji)
+		if r < regionalIndicatorA || r > regionalIndicatorZ {
+			return nil
+		}
+		alpha2[i] = byte('A' + r - regionalIndicatorA)
+		emoji = emoji[size:]
+	}
+	if emoji != "" {
+		return nil
+	}
+	return byAlpha2[string(alpha2[:])]
+}
+
+// isUpperLetter reports whether the byte is an uppercase ASCII letter
+func isUpperLetter(b byte) bool {
+	return b >= 'A' && b <= 'Z'
+}
@@ -0,0 +1,86 @@
+package countries
+
+import (
+	"fmt"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestCountry_FlagEmoji tests the FlagEmoji method
+func TestCountry_FlagEmoji(t *testing.T) {
+	assert.Equal(t, "🇩🇪", GetByAlpha2Code(Alpha2DE).FlagEmoji())
+	assert.Equal(t, "🇺🇸", GetByAlpha2Code(Alpha2US).FlagEmoji())
+	assert.Equal(t, "\U0001F1EF\U0001F1F5", GetByAlpha2Code(Alpha2JP).FlagEmoji())
+	assert.Empty(t, (&Country{}).FlagEmoji())
+	assert.Empty(t, (&Country{Alpha2: "de"}).FlagEmoji())
+	assert.Empty(t, (&Country{Alpha2: "DEU"}).FlagEmoji())
+
+	for _, c := range countries {
+		emoji := c.FlagEmoji()
+		assert.Equal(t, 2, utf8.RuneCountInString(emoji), "country %s", c.Alpha2)
+		assert.Same(t, c, GetByFlagEmoji(emoji), "country %s", c.Alpha2)
+	}
+}
+
+// TestGetByFlagEmoji tests GetByFlagEmoji with different inputs
+func TestGetByFlagEmoji(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected Alpha2
+	}{
+		{name: "Germany", input: "🇩🇪", expected: Alpha2DE},
+		{name: "Surrounding spaces", input: " 🇫🇷\t", expected: Alpha2FR},
+		{name: "Variation selector", input: "🇬🇧️", expected: Alpha2GB},
+		{name: "European Union", input: "🇪🇺"},
+		{name: "Unassigned code", input: "🇿🇿"},
+		{name: "Single indicator", input: "\U0001F1E9"},
+		{name: "Three indicators", input: "🇩🇪\U0001F1EA"},
+		{name: "Two flags", input: "🇩🇪🇫🇷"},
+		{name: "Letters", input: "DE"},
+		{name: "Scotland", input: "\U0001F3F4\U000E0067\U000E0062\U000E0073\U000E0063\U000E0074\U000E007F"},
+		{name: "Invalid UTF-8", input: "\xf0\x9f\x87"},
+		{name: "Empty", input: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := GetByFlagEmoji(tt.input)
+			if tt.expected == "" {
+				assert.Nil(t, c)
+				return
+			}
+			assert.Equal(t, tt.expected.Country(), c)
+		})
+	}
+}
+
+// ExampleCountry_FlagEmoji is an example of Country.FlagEmoji()
+func ExampleCountry_FlagEmoji() {
+	c := GetByAlpha2Code(Alpha2CA)
+	fmt.Printf("%s %s", c.FlagEmoji(), c.Name)
+	// Output:🇨🇦 Canada
+}
+
+// ExampleGetByFlagEmoji is an example of GetByFlagEmoji()
+func ExampleGetByFlagEmoji() {
+	fmt.Println(GetByFlagEmoji("🇯🇵").Name)
+	// Output:Japan
+}
+
+// BenchmarkCountry_FlagEmoji benchmarks the method FlagEmoji()
+func BenchmarkCountry_FlagEmoji(b *testing.B) {
+	c := GetByAlpha2Code(Alpha2DE)
+	for i := 0; i < b.N; i++ {
+		_ = c.FlagEmoji()
+	}
+}
+
+// BenchmarkGetByFlagEmoji benchmarks the method GetByFlagEmoji()
+func BenchmarkGetByFlagEmoji(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_ = GetByFlagEmoji("🇩🇪")
+	}
+}
@@ -6,6 +6,8 @@ package main
 
 import (
 	"log"
+
+	"github.com/mrz1836/go-countries/data"
 )
 
 // CountriesWithCurrencies is a shim for parsing
@@ -18,41 +20,311 @@ type alternateCountryData struct {
 	CountryCode   string `json:"countryCode"`
 	CountryName   string `json:"countryName"`
 	CurrencyCode  string `json:"currencyCode"`
-	Population    string `json:"population"`
+	Population    int64  `json:"population,string"`
+}
+
+// additionalCurrencies is a shim for parsing the additional currency data
+type additionalCurrencies []*additionalCurrencyData
+
+// additionalCurrencyData is a currency a country uses besides its primary currency
+type additionalCurrencyData struct {
+	CountryCode  string `json:"countryCode"`
+	CurrencyCode string `json:"currencyCode"`
+	LegalTender  bool   `json:"legalTender"`
+}
+
+// boundaryData is the simplified land boundary of a country, every ring being an encoded polyline
+type boundaryData struct {
+	CountryCode string     `json:"countryCode"`
+	Polygons    [][]string `json:"polygons"`
+}
+
+// callingCodes is a shim for parsing the calling code data
+type callingCodes []*callingCodeData
+
+// callingCodeData is the international calling code (ITU-T E.164) of a country
+type callingCodeData struct {
+	CallingCode string   `json:"callingCode"`
+	CountryCode string   `json:"countryCode"`
+	Main        bool     `json:"main"`
+	Prefixes    []string `json:"prefixes"`
+}
+
+// countryCoordinates is a shim for parsing the geographic coordinate data
+type countryCoordinates []*coordinatesData
+
+// coordinatesData is the centroid, bounding box and capital location of a country
+type coordinatesData struct {
+	BoundingBox BoundingBox  `json:"boundingBox"`
+	Capital     *Coordinates `json:"capital"`
+	Centroid    Coordinates  `json:"centroid"`
+	CountryCode string       `json:"countryCode"`
+}
+
+// currencyData is a single ISO 4217 currency entry
+type currencyData struct {
+	Code         string `json:"code"`
+	MinorUnits   string `json:"minorUnits"`
+	Name         string `json:"name"`
+	NarrowSymbol string `json:"narrowSymbol"`
+	NumericCode  string `json:"numericCode"`
+	Symbol       string `json:"symbol"`
+}
+
+// countryLanguages is a shim for parsing the languages spoken in every country
+type countryLanguages []*countryLanguageData
+
+// countryLanguageData is the list of official and widely spoken languages of a country
+type countryLanguageData struct {
+	CountryCode string                `json:"countryCode"`
+	Languages   []*spokenLanguageData `json:"languages"`
+}
+
+// languageData is a single ISO 639 language entry
+type languageData struct {
+	Alpha2 string `json:"alpha2"`
+	Alpha3 string `json:"alpha3"`
+	Name   string `json:"name"`
+}
+
+// phoneNumberingData is the national numbering plan of a country
+type phoneNumberingData struct {
+	CountryCode    string `json:"countryCode"`
+	Lengths        []int  `json:"lengths"`
+	NationalPrefix string `json:"nationalPrefix"`
+	Pattern        string `json:"pattern"`
+}
+
+// spokenLanguageData is a language spoken in a country
+type spokenLanguageData struct {
+	Code     string `json:"code"`
+	Official bool   `json:"official"`
+}
+
+// subdivisionData is a single ISO 3166-2 subdivision entry
+type subdivisionData struct {
+	Code   string `json:"code"`
+	Name   string `json:"name"`
+	Parent string `json:"parent"`
+	Type   string `json:"type"`
+}
+
+// countryTimeZones is a shim for parsing the IANA time zone data
+type countryTimeZones []*timeZoneData
+
+// timeZoneData is the list of IANA time zones of a country and their backward-compatible aliases
+type timeZoneData struct {
+	Aliases     []string `json:"aliases"`
+	CountryCode string   `json:"countryCode"`
+	TimeZones   []string `json:"timeZones"`
+}
+
+// countryBorders is a shim for parsing the land border data
+type countryBorders []*borderData
+
+// borderData is the list of countries sharing a land border with a country
+type borderData struct {
+	Borders     []string `json:"borders"`
+	CountryCode string   `json:"countryCode"`
+	Landlocked  bool     `json:"landlocked"`
+}
+
+// countryNames is a shim for parsing the country name data
+type countryNames []*countryNameData
+
+// countryNameData is the common name, official name and aliases of a country
+type countryNameData struct {
+	Aliases      []string `json:"aliases"`
+	CommonName   string   `json:"commonName"`
+	CountryCode  string   `json:"countryCode"`
+	OfficialName string   `json:"officialName"`
+}
+
+// localizedNameList is a shim for parsing the localized country name data
+type localizedNameList []*localizedNameData
+
+// localizedNameData is the name of every country in a language, by alpha-2 code
+type localizedNameData struct {
+	Language string            `json:"language"`
+	Names    map[string]string `json:"names"`
+}
+
+// countryNativeNames is a shim for parsing the native country name data
+type countryNativeNames []*nativeNameData
+
+// nativeNameData is the list of native names of a country, in the order of its official languages
+type nativeNameData struct {
+	CountryCode string       `json:"countryCode"`
+	Names       []NativeName `json:"names"`
+}
+
+// countryTLDs is a shim for parsing the country-code top-level domain data
+type countryTLDs []*tldData
+
+// tldData is the list of country-code top-level domains (ccTLD) of a country
+type tldData struct {
+	CountryCode string   `json:"countryCode"`
+	TLDs        []string `json:"tlds"`
 }
 
 // Country mirrors the main package struct for code generation
 type Country struct {
-	Alpha2                 string `json:"alpha-2"`
-	Alpha3                 string `json:"alpha-3"`
-	Capital                string `json:"capital"`
-	ContinentName          string `json:"continent_name"`
-	CountryCode            string `json:"country-code"`
-	CurrencyCode           string `json:"currency_code"`
-	IntermediateRegion     string `json:"intermediate-region"`
-	IntermediateRegionCode string `json:"intermediate-region-code"`
-	ISO31662               string `json:"iso_3166-2"`
-	Name                   string `json:"name"`
-	Region                 string `json:"region"`
-	RegionCode             string `json:"region-code"`
-	SubRegion              string `json:"sub-region"`
-	SubRegionCode          string `json:"sub-region-code"`
+	Aliases                []string          `json:"aliases"`
+	Alpha2                 string            `json:"alpha-2"`
+	Alpha3                 string            `json:"alpha-3"`
+	BoundingBox            BoundingBox       `json:"bounding_box"`
+	CallingCodes           []string          `json:"calling_codes"`
+	Capital                string            `json:"capital"`
+	CapitalCoordinates     Coordinates       `json:"capital_coordinates"`
+	Centroid               Coordinates       `json:"centroid"`
+	CommonName             string            `json:"common_name"`
+	ContinentName          string            `json:"continent_name"`
+	CountryCode            string            `json:"country-code"`
+	Currencies             []CountryCurrency `json:"currencies"`
+	CurrencyCode           string            `json:"currency_code"`
+	IntermediateRegion     string            `json:"intermediate-region"`
+	IntermediateRegionCode string            `json:"intermediate-region-code"`
+	ISO31662               string            `json:"iso_3166-2"`
+	Languages              []CountryLanguage `json:"languages"`
+	Name                   string            `json:"name"`
+	NativeNames            []NativeName      `json:"native_names"`
+	OfficialName           string            `json:"official_name"`
+	Population             int64             `json:"population"`
+	PopulationYear         int               `json:"population_year"`
+	Region                 string            `json:"region"`
+	RegionCode             string            `json:"region-code"`
+	SubRegion              string            `json:"sub-region"`
+	SubRegionCode          string            `json:"sub-region-code"`
+	TimeZones              []string          `json:"time_zones"`
+	TLDs                   []string          `json:"tlds"`
+}
+
+// Boundary mirrors the geo package boundary for code generation
+type Boundary struct {
+	CountryAlpha2 string
+	Polygons      [][]string
+}
+
+// BoundaryList is a slice of Boundary pointers
+type BoundaryList []*Boundary
+
+// BoundingBox mirrors the main package struct for code generation
+type BoundingBox struct {
+	East  float64 `json:"east"`
+	North float64 `json:"north"`
+	South float64 `json:"south"`
+	West  float64 `json:"west"`
+}
+
+// Coordinates mirrors the main package struct for code generation
+type Coordinates struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+}
+
+// CountryCurrency mirrors the main package struct for code generation
+type CountryCurrency struct {
+	Code        string
+	LegalTender bool
+	Primary     bool
+}
+
+// Currency mirrors the main package struct for code generation
+type Currency struct {
+	Code         string
+	MinorUnits   int
+	Name         string
+	NarrowSymbol string
+	NumericCode  string
+	Symbol       string
+}
+
+// CurrencyList is a slice of Currency pointers
+type CurrencyList []*Currency
+
+// CountryLanguage mirrors the main package struct for code generation
+type CountryLanguage struct {
+	Code     string
+	Official bool
 }
 
+// Language mirrors the main package struct for code generation
+type Language struct {
+	Alpha2 string
+	Alpha3 string
+	Name   string
+}
+
+// LanguageList is a slice of Language pointers
+type LanguageList []*Language
+
+// NativeName mirrors the main package struct for code generation
+type NativeName struct {
+	Language string `json:"language"`
+	Name     string `json:"name"`
+}
+
+// PhoneRule mirrors the phone package numbering rule for code generation
+type PhoneRule struct {
+	CountryAlpha2  string
+	Lengths        []int
+	NationalPrefix string
+	Pattern        string
+}
+
+// PhoneRuleList is a slice of PhoneRule pointers
+type PhoneRuleList []*PhoneRule
+
+// Subdivision mirrors the main package struct for code generation
+type Subdivision struct {
+	Category      string
+	Code          string
+	CountryAlpha2 string
+	Name          string
+	ParentCode    string
+}
+
+// SubdivisionList is a slice of Subdivision pointers
+type SubdivisionList []*Subdivision
+
 // mapEntry is a helper struct to hold the key and index of a capital in the sorted list
 type mapEntry struct {
 	Key   string
 	Index int
 }
 
+// localizedEntry is a helper struct to hold the names of the countries in a language
+type localizedEntry struct {
+	Key      string            // Lowercase language tag used as the map key (e.g., "zh-hant")
+	Language string            // Language tag as published (e.g., "zh-Hant")
+	Names    map[string]string // Names of the countries by alpha-2 code
+}
+
+// searchTermEntry is a helper struct to hold a folded search term, the field it comes from and the country index
+type searchTermEntry struct {
+	Field string
+	Index int
+	Term  string
+}
+
+// groupEntry is a helper struct to hold a key and the indices of every item sharing that key
+type groupEntry struct {
+	Key     string
+	Indices []int
+}
+
 // CountryList is a slice of Country pointers
 type CountryList []*Country
 
 // main is the entry point for the code generation tool.
-// It loads country and currency data from embedded JSON sources,
-// merges the datasets to enrich country information with currency and capital details,
-// and then generates a Go source file (`countries_data.go`) containing the combined data as Go structs.
+// It loads country, currency, additional currency, ISO 4217, subdivision, calling code, ccTLD, language,
+// time zone, coordinate and localized name data from embedded JSON sources, merges the datasets to enrich country
+// information with currency, capital, population, calling code, top-level domain, language, time zone, name and
+// geographic details, and then generates a Go source file (`countries_data.go`) containing the combined data
+// as Go structs.
 // The generated file is formatted and ready for use in the main package.
+// The national phone numbering rules are generated the same way into the phone subpackage (`phone/phone_data.go`),
+// and the simplified land boundaries into the geo subpackage (`geo/geo_data.go`).
 // This process ensures that the country data remains up to date and consistent with the source JSON.
 func main() {
 	// Create production implementations
@@ -66,6 +338,9 @@ func main() {
 		FileWriter:       fileWriter,
 		TemplateProvider: templateProvider,
 		OutputPath:       "../countries_data.go",
+		PhoneOutputPath:  "../phone/phone_data.go",
+		GeoOutputPath:    "../geo/geo_data.go",
+		PopulationYear:   data.PopulationDataYear,
 		RepoURL:          "https://github.com/mrz1836/go-countries",
 	}
 
@@ -3,20 +3,47 @@ package main
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"go/format"
+	"regexp"
 	"sort"
+	"strconv"
 	"strings"
 	"text/template"
 	"time"
+
+	"github.com/mrz1836/go-countries/internal/names"
+)
+
+// Static errors for malformed source data
+var (
+	errInvalidSubdivisionCode = errors.New("invalid subdivision code")
+	errInvalidMinorUnits      = errors.New("invalid currency minor units")
+	errInvalidCallingCode     = errors.New("invalid calling code")
+	errInvalidPhoneRule       = errors.New("invalid phone numbering rule")
+	errInvalidTLD             = errors.New("invalid top-level domain")
+	errInvalidLanguageCode    = errors.New("invalid language code")
+	errInvalidTimeZone        = errors.New("invalid time zone")
+	errInvalidCoordinates     = errors.New("invalid coordinates")
+	errInvalidBoundary        = errors.New("invalid boundary")
+	errInvalidBorder          = errors.New("invalid border")
+	errInvalidCountryName     = errors.New("invalid country name")
+	errInvalidLocalizedName   = errors.New("invalid localized name")
 )
 
+// minorUnitsNotApplicable is the ISO 4217 marker for currencies without minor units (e.g., gold)
+const minorUnitsNotApplicable = "N.A."
+
 // Generator handles the country data generation process
 type Generator struct {
 	dataLoader       DataLoader
 	fileWriter       FileWriter
 	templateProvider TemplateProvider
 	outputPath       string
+	phoneOutputPath  string
+	geoOutputPath    string
+	populationYear   int
 	repoURL          string
 }
 
@@ -26,9 +53,37 @@ type GeneratorConfig struct {
 	FileWriter       FileWriter
 	TemplateProvider TemplateProvider
 	OutputPath       string
+	PhoneOutputPath  string
+	GeoOutputPath    string
+	PopulationYear   int
 	RepoURL          string
 }
 
+// PackageData holds every dataset rendered into the generated package file
+type PackageData struct {
+	Countries            CountryList
+	Capitals             []mapEntry
+	CallingCodes         []mapEntry
+	CallingCodeCountries []groupEntry
+	PhonePrefixes        []mapEntry
+	RegionCountries      []groupEntry
+	Currencies           CurrencyList
+	CurrencyCountries    []groupEntry
+	Landlocked           []string
+	Languages            LanguageList
+	LanguageCodes        []mapEntry
+	LanguageCountries    []groupEntry
+	LocalizedNames       []localizedEntry
+	Names                []mapEntry
+	SearchTerms          []searchTermEntry
+	Neighbors            []groupEntry
+	Subdivisions         SubdivisionList
+	SubdivisionGroups    []groupEntry
+	SubdivisionNames     []mapEntry
+	TimeZones            []mapEntry
+	TLDs                 []mapEntry
+}
+
 // NewGenerator creates a new Generator instance with the provided dependencies
 func NewGenerator(config GeneratorConfig) *Generator {
 	return &Generator{
@@ -36,6 +91,9 @@ func NewGenerator(config GeneratorConfig) *Generator {
 		fileWriter:       config.FileWriter,
 		templateProvider: config.TemplateProvider,
 		outputPath:       config.OutputPath,
+		phoneOutputPath:  config.PhoneOutputPath,
+		geoOutputPath:    config.GeoOutputPath,
+		populationYear:   config.PopulationYear,
 		repoURL:          config.RepoURL,
 	}
 }
@@ -47,6 +105,25 @@ func (g *Generator) Generate() error {
 		return fmt.Errorf("failed to load countries: %w", err)
 	}
 
+	countryNames, err := g.LoadCountryNames()
+	if err != nil {
+		return fmt.Errorf("failed to load country names: %w", err)
+	}
+
+	g.MergeCountryNames(countries, countryNames)
+
+	nativeNames, err := g.LoadNativeNames()
+	if err != nil {
+		return fmt.Errorf("failed to load native names: %w", err)
+	}
+
+	g.MergeNativeNames(countries, nativeNames)
+
+	localizedNames, err := g.LoadLocalizedNames()
+	if err != nil {
+		return fmt.Errorf("failed to load localized names: %w", err)
+	}
+
 	currencies, err := g.LoadCurrencies()
 	if err != nil {
 		return fmt.Errorf("failed to load currencies: %w", err)
@@ -54,17 +131,127 @@ func (g *Generator) Generate() error {
 
 	g.MergeData(countries, currencies)
 
-	capitals := g.GenerateCapitalMap(countries)
+	additional, err := g.LoadAdditionalCurrencies()
+	if err != nil {
+		return fmt.Errorf("failed to load additional currencies: %w", err)
+	}
+
+	g.MergeAdditionalCurrencies(countries, additional)
+
+	calling, err := g.LoadCallingCodes()
+	if err != nil {
+		return fmt.Errorf("failed to load calling codes: %w", err)
+	}
+
+	g.MergeCallingCodes(countries, calling)
+
+	tlds, err := g.LoadTLDs()
+	if err != nil {
+		return fmt.Errorf("failed to load top-level domains: %w", err)
+	}
+
+	g.MergeTLDs(countries, tlds)
+
+	zones, err := g.LoadTimeZones()
+	if err != nil {
+		return fmt.Errorf("failed to load time zones: %w", err)
+	}
+
+	g.MergeTimeZones(countries, zones)
 
-	code, err := g.GenerateCode(countries, capitals)
+	coordinates, err := g.LoadCoordinates()
+	if err != nil {
+		return fmt.Errorf("failed to load coordinates: %w", err)
+	}
+
+	g.MergeCoordinates(countries, coordinates)
+
+	borders, err := g.LoadBorders()
+	if err != nil {
+		return fmt.Errorf("failed to load borders: %w", err)
+	}
+
+	spoken, err := g.LoadCountryLanguages()
+	if err != nil {
+		return fmt.Errorf("failed to load country languages: %w", err)
+	}
+
+	g.MergeLanguages(countries, spoken)
+
+	languages, err := g.LoadLanguages()
+	if err != nil {
+		return fmt.Errorf("failed to load languages: %w", err)
+	}
+
+	isoCurrencies, err := g.LoadISO4217Currencies()
+	if err != nil {
+		return fmt.Errorf("failed to load ISO 4217 currencies: %w", err)
+	}
+
+	subdivisions, err := g.LoadSubdivisions()
+	if err != nil {
+		return fmt.Errorf("failed to load subdivisions: %w", err)
+	}
+
+	code, err := g.GenerateCode(&PackageData{
+		Countries:            countries,
+		Capitals:             g.GenerateCapitalMap(countries),
+		CallingCodes:         g.GenerateCallingCodeMap(countries, calling),
+		CallingCodeCountries: g.GroupCountriesByCallingCode(countries),
+		PhonePrefixes:        g.GeneratePhonePrefixMap(countries, calling),
+		RegionCountries:      g.GroupCountriesByRegionCode(countries),
+		Currencies:           isoCurrencies,
+		CurrencyCountries:    g.GroupCountriesByCurrency(countries),
+		Landlocked:           g.ListLandlocked(countries, borders),
+		Languages:            languages,
+		LanguageCodes:        g.GenerateLanguageMap(languages),
+		LanguageCountries:    g.GroupCountriesByLanguage(countries),
+		LocalizedNames:       g.GenerateLocalizedNames(countries, localizedNames),
+		Names:                g.GenerateNameMap(countries),
+		Neighbors:            g.GroupNeighbors(countries, borders),
+		SearchTerms:          g.GenerateSearchTerms(countries),
+		Subdivisions:         subdivisions,
+		SubdivisionGroups:    g.GroupSubdivisions(subdivisions),
+		SubdivisionNames:     g.GenerateSubdivisionNameMap(subdivisions),
+		TimeZones:            g.GenerateTimeZoneMap(countries, zones),
+		TLDs:                 g.GenerateTLDMap(countries),
+	})
 	if err != nil {
 		return fmt.Errorf("failed to generate code: %w", err)
 	}
 
-	if err := g.WriteOutput(code); err != nil {
+	if err = g.WriteOutput(code); err != nil {
 		return fmt.Errorf("failed to write output: %w", err)
 	}
 
+	rules, err := g.LoadPhoneRules()
+	if err != nil {
+		return fmt.Errorf("failed to load phone rules: %w", err)
+	}
+
+	phoneCode, err := g.GeneratePhoneCode(rules)
+	if err != nil {
+		return fmt.Errorf("failed to generate phone code: %w", err)
+	}
+
+	if err = g.WritePhoneOutput(phoneCode); err != nil {
+		return fmt.Errorf("failed to write phone output: %w", err)
+	}
+
+	boundaries, err := g.LoadBoundaries()
+	if err != nil {
+		return fmt.Errorf("failed to load boundaries: %w", err)
+	}
+
+	geoCode, err := g.GenerateGeoCode(boundaries)
+	if err != nil {
+		return fmt.Errorf("failed to generate geo code: %w", err)
+	}
+
+	if err = g.WriteGeoOutput(geoCode); err != nil {
+		return fmt.Errorf("failed to write geo output: %w", err)
+	}
+
 	return nil
 }
 
@@ -98,79 +285,1402 @@ func (g *Generator) LoadCurrencies() (countriesWithCurrencies, error) {
 	return currencies, nil
 }
 
-// MergeData combines country and currency data
-func (g *Generator) MergeData(countries CountryList, currencies countriesWithCurrencies) {
-	for index, country := range countries {
-		for _, altCountry := range currencies {
-			if country.Alpha2 == altCountry.CountryCode {
-				countries[index].Capital = altCountry.Capital
-				countries[index].ContinentName = altCountry.ContinentName
-				countries[index].CurrencyCode = altCountry.CurrencyCode
-				break
+// LoadISO4217Currencies loads and parses the ISO 4217 currency definitions
+//
+// Minor units marked "N.A." (precious metals, testing codes, etc.) are stored as -1
+// and the list is sorted by currency code.
+func (g *Generator) LoadISO4217Currencies() (CurrencyList, error) {
+	data, err := g.dataLoader.LoadISO4217Data()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load ISO4217 data: %w", err)
+	}
+
+	var entries []*currencyData
+	if err = json.Unmarshal(data, &entries); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal ISO4217 data: %w", err)
+	}
+
+	currencies := make(CurrencyList, 0, len(entries))
+	for _, entry := range entries {
+		minorUnits := -1
+		if entry.MinorUnits != minorUnitsNotApplicable {
+			if minorUnits, err = strconv.Atoi(entry.MinorUnits); err != nil {
+				return nil, fmt.Errorf("%w: %q for %s", errInvalidMinorUnits, entry.MinorUnits, entry.Code)
 			}
 		}
+
+		currencies = append(currencies, &Currency{
+			Code:         entry.Code,
+			MinorUnits:   minorUnits,
+			Name:         entry.Name,
+			NarrowSymbol: entry.NarrowSymbol,
+			NumericCode:  entry.NumericCode,
+			Symbol:       entry.Symbol,
+		})
 	}
+
+	sort.SliceStable(currencies, func(i, j int) bool {
+		return currencies[i].Code < currencies[j].Code
+	})
+
+	return currencies, nil
 }
 
-// GenerateCapitalMap creates a sorted map of capitals to country indices
-func (g *Generator) GenerateCapitalMap(countries CountryList) []mapEntry {
-	capitalSeen := make(map[string]struct{})
-	var capitalEntries []mapEntry
+// LoadSubdivisions loads and parses the ISO 3166-2 subdivision data
+//
+// Parent codes that omit the country prefix (e.g., "NX" for Azerbaijan) are
+// expanded to full ISO 3166-2 codes, categories are lowercased, source notes in
+// brackets (e.g., "Isle of Anglesey [Sir Ynys Môn GB-YNM]") are removed from the
+// names and the list is sorted by subdivision code.
+func (g *Generator) LoadSubdivisions() (SubdivisionList, error) {
+	data, err := g.dataLoader.LoadSubdivisionData()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load subdivision data: %w", err)
+	}
 
-	for index, country := range countries {
-		if country.Capital != "" {
-			key := strings.ToLower(country.Capital)
-			if _, ok := capitalSeen[key]; !ok {
-				capitalSeen[key] = struct{}{}
-				capitalEntries = append(capitalEntries, mapEntry{Key: key, Index: index})
+	var entries []*subdivisionData
+	if err = json.Unmarshal(data, &entries); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal subdivision data: %w", err)
+	}
+
+	subdivisions := make(SubdivisionList, 0, len(entries))
+	for _, entry := range entries {
+		alpha2, _, found := strings.Cut(entry.Code, "-")
+		if !found {
+			return nil, fmt.Errorf("%w: %q", errInvalidSubdivisionCode, entry.Code)
+		}
+
+		parent := entry.Parent
+		if parent != "" && !strings.Contains(parent, "-") {
+			parent = alpha2 + "-" + parent
+		}
+
+		subdivisions = append(subdivisions, &Subdivision{
+			Category:      strings.ToLower(entry.Type),
+			Code:          entry.Code,
+			CountryAlpha2: alpha2,
+			Name:          trimBracketedNote(entry.Name),
+			ParentCode:    parent,
+		})
+	}
+
+	sort.SliceStable(subdivisions, func(i, j int) bool {
+		return subdivisions[i].Code < subdivisions[j].Code
+	})
+
+	return subdivisions, nil
+}
+
+// LoadAdditionalCurrencies loads and parses the additional (non-primary) currency data
+func (g *Generator) LoadAdditionalCurrencies() (additionalCurrencies, error) {
+	data, err := g.dataLoader.LoadAdditionalCurrencyData()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load additional currency data: %w", err)
+	}
+
+	var additional additionalCurrencies
+	if err := json.Unmarshal(data, &additional); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal additional currency data: %w", err)
+	}
+
+	return additional, nil
+}
+
+// LoadCallingCodes loads and parses the international calling code data
+//
+// Calling codes and their prefixes must be made of digits only, without the leading "+".
+func (g *Generator) LoadCallingCodes() (callingCodes, error) {
+	data, err := g.dataLoader.LoadCallingCodeData()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load calling code data: %w", err)
+	}
+
+	var codes callingCodes
+	if err = json.Unmarshal(data, &codes); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal calling code data: %w", err)
+	}
+
+	for _, entry := range codes {
+		if !isDigits(entry.CallingCode) {
+			return nil, fmt.Errorf("%w: %q for %s", errInvalidCallingCode, entry.CallingCode, entry.CountryCode)
+		}
+		for _, prefix := range entry.Prefixes {
+			if !isDigits(prefix) {
+				return nil, fmt.Errorf("%w: prefix %q for %s", errInvalidCallingCode, prefix, entry.CountryCode)
 			}
 		}
 	}
 
-	sort.Slice(capitalEntries, func(i, j int) bool {
-		return capitalEntries[i].Key < capitalEntries[j].Key
+	return codes, nil
+}
+
+// LoadPhoneRules loads and parses the national phone numbering rules
+//
+// Every rule needs at least one possible length and a valid regular expression,
+// and the list is sorted by country alpha-2 code.
+func (g *Generator) LoadPhoneRules() (PhoneRuleList, error) {
+	data, err := g.dataLoader.LoadPhoneNumberingData()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load phone numbering data: %w", err)
+	}
+
+	var entries []*phoneNumberingData
+	if err = json.Unmarshal(data, &entries); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal phone numbering data: %w", err)
+	}
+
+	rules := make(PhoneRuleList, 0, len(entries))
+	for _, entry := range entries {
+		if len(entry.Lengths) == 0 {
+			return nil, fmt.Errorf("%w: no lengths for %s", errInvalidPhoneRule, entry.CountryCode)
+		}
+		if _, err = regexp.Compile(entry.Pattern); err != nil || entry.Pattern == "" {
+			return nil, fmt.Errorf("%w: pattern %q for %s", errInvalidPhoneRule, entry.Pattern, entry.CountryCode)
+		}
+
+		rules = append(rules, &PhoneRule{
+			CountryAlpha2:  entry.CountryCode,
+			Lengths:        entry.Lengths,
+			NationalPrefix: entry.NationalPrefix,
+			Pattern:        entry.Pattern,
+		})
+	}
+
+	sort.SliceStable(rules, func(i, j int) bool {
+		return rules[i].CountryAlpha2 < rules[j].CountryAlpha2
 	})
 
-	return capitalEntries
+	return rules, nil
 }
 
-// GenerateCode generates the formatted Go source code
-func (g *Generator) GenerateCode(countries CountryList, capitals []mapEntry) ([]byte, error) {
-	templateStr, err := g.templateProvider.GetPackageTemplate()
+// LoadBoundaries loads and parses the simplified land boundaries
+//
+// Every ring must be an encoded polyline of at least three points within the latitude and longitude ranges,
+// and the list is sorted by country alpha-2 code.
+func (g *Generator) LoadBoundaries() (BoundaryList, error) {
+	data, err := g.dataLoader.LoadBoundaryData()
 	if err != nil {
-		return nil, fmt.Errorf("failed to get template: %w", err)
+		return nil, fmt.Errorf("failed to load boundary data: %w", err)
 	}
 
-	tmpl := template.Must(template.New("").Funcs(template.FuncMap{
-		"lower": strings.ToLower,
-	}).Parse(templateStr))
+	var entries []*boundaryData
+	if err = json.Unmarshal(data, &entries); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal boundary data: %w", err)
+	}
 
-	var buf bytes.Buffer
-	if execErr := tmpl.Execute(&buf, struct {
-		Timestamp time.Time
-		URL       string
-		Countries CountryList
-		Capitals  []mapEntry
-	}{
-		Timestamp: time.Now(),
-		URL:       g.repoURL,
-		Countries: countries,
-		Capitals:  capitals,
-	}); execErr != nil {
-		return nil, fmt.Errorf("template execution failed: %w", execErr)
+	boundaries := make(BoundaryList, 0, len(entries))
+	for _, entry := range entries {
+		if len(entry.Polygons) == 0 {
+			return nil, fmt.Errorf("%w: no polygons for %s", errInvalidBoundary, entry.CountryCode)
+		}
+		for _, polygon := range entry.Polygons {
+			for _, ring := range polygon {
+				if points, ok := polylinePoints(ring); !ok || points < 3 {
+					return nil, fmt.Errorf("%w: ring %q for %s", errInvalidBoundary, ring, entry.CountryCode)
+				}
+			}
+		}
+
+		boundaries = append(boundaries, &Boundary{
+			CountryAlpha2: entry.CountryCode,
+			Polygons:      entry.Polygons,
+		})
 	}
 
-	formatted, err := format.Source(buf.Bytes())
+	sort.SliceStable(boundaries, func(i, j int) bool {
+		return boundaries[i].CountryAlpha2 < boundaries[j].CountryAlpha2
+	})
+
+	return boundaries, nil
+}
+
+// LoadTLDs loads and parses the country-code top-level domain data
+//
+// Every top-level domain must be a single label with a leading dot (e.g., ".uk", ".рф").
+func (g *Generator) LoadTLDs() (countryTLDs, error) {
+	data, err := g.dataLoader.LoadTLDData()
 	if err != nil {
-		return nil, fmt.Errorf("failed to format generated source: %w", err)
+		return nil, fmt.Errorf("failed to load TLD data: %w", err)
 	}
 
-	return formatted, nil
+	var tlds countryTLDs
+	if err = json.Unmarshal(data, &tlds); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal TLD data: %w", err)
+	}
+
+	for _, entry := range tlds {
+		for _, tld := range entry.TLDs {
+			label, ok := strings.CutPrefix(tld, ".")
+			if !ok || label == "" || strings.Contains(label, ".") {
+				return nil, fmt.Errorf("%w: %q for %s", errInvalidTLD, tld, entry.CountryCode)
+			}
+		}
+	}
+
+	return tlds, nil
 }
 
-// WriteOutput writes the generated code to the output file
-func (g *Generator) WriteOutput(code []byte) error {
-	file, err := g.fileWriter.Create(g.outputPath)
+// LoadTimeZones loads and parses the IANA time zone data
+//
+// Every time zone and alias must be an "Area/Location" name made of letters, digits and the
+// characters "_", "-", "+" and "/" (e.g., "America/Argentina/Buenos_Aires").
+func (g *Generator) LoadTimeZones() (countryTimeZones, error) {
+	data, err := g.dataLoader.LoadTimeZoneData()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load time zone data: %w", err)
+	}
+
+	var zones countryTimeZones
+	if err = json.Unmarshal(data, &zones); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal time zone data: %w", err)
+	}
+
+	for _, entry := range zones {
+		for _, name := range entry.names() {
+			if !isTimeZoneName(name) {
+				return nil, fmt.Errorf("%w: %q for %s", errInvalidTimeZone, name, entry.CountryCode)
+			}
+		}
+	}
+
+	return zones, nil
+}
+
+// LoadCoordinates loads and parses the geographic coordinate data
+//
+// Every latitude must be within [-90, 90], every longitude within [-180, 180] and the south edge of a
+// bounding box cannot be north of its north edge. The west edge can be east of the east edge when the box
+// crosses the antimeridian.
+func (g *Generator) LoadCoordinates() (countryCoordinates, error) {
+	data, err := g.dataLoader.LoadCoordinatesData()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load coordinate data: %w", err)
+	}
+
+	var coordinates countryCoordinates
+	if err = json.Unmarshal(data, &coordinates); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal coordinate data: %w", err)
+	}
+
+	for _, entry := range coordinates {
+		if !entry.Centroid.valid() || !entry.BoundingBox.valid() || (entry.Capital != nil && !entry.Capital.valid()) {
+			return nil, fmt.Errorf("%w for %s", errInvalidCoordinates, entry.CountryCode)
+		}
+	}
+
+	return coordinates, nil
+}
+
+// LoadBorders loads and parses the land border data
+//
+// Every border must be the uppercase alpha-2 code of another country, listed once, and the country
+// on the other side of the border must list it as well.
+func (g *Generator) LoadBorders() (countryBorders, error) {
+	data, err := g.dataLoader.LoadBorderData()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load border data: %w", err)
+	}
+
+	var borders countryBorders
+	if err = json.Unmarshal(data, &borders); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal border data: %w", err)
+	}
+
+	listed := make(map[string]map[string]bool, len(borders))
+	for _, entry := range borders {
+		listed[entry.CountryCode] = make(map[string]bool, len(entry.Borders))
+		for _, border := range entry.Borders {
+			if len(border) != 2 || !isUpperLetters(border) || border == entry.CountryCode ||
+				listed[entry.CountryCode][border] {
+				return nil, fmt.Errorf("%w: %q for %s", errInvalidBorder, border, entry.CountryCode)
+			}
+			listed[entry.CountryCode][border] = true
+		}
+	}
+
+	for _, entry := range borders {
+		for _, border := range entry.Borders {
+			if !listed[border][entry.CountryCode] {
+				return nil, fmt.Errorf("%w: %s lists %s but not the other way around",
+					errInvalidBorder, entry.CountryCode, border)
+			}
+		}
+	}
+
+	return borders, nil
+}
+
+// LoadCountryNames loads and parses the common names, official names and aliases of the countries
+//
+// Every country needs an uppercase alpha-2 code, a common name and an official name, and its aliases
+// must be unique, case-insensitively, among its names.
+func (g *Generator) LoadCountryNames() (countryNames, error) {
+	data, err := g.dataLoader.LoadCountryNameData()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load country name data: %w", err)
+	}
+
+	var entries countryNames
+	if err = json.Unmarshal(data, &entries); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal country name data: %w", err)
+	}
+
+	for _, entry := range entries {
+		if len(entry.CountryCode) != 2 || !isUpperLetters(entry.CountryCode) {
+			return nil, fmt.Errorf("%w: country code %q", errInvalidCountryName, entry.CountryCode)
+		}
+		if strings.TrimSpace(entry.CommonName) == "" || strings.TrimSpace(entry.OfficialName) == "" {
+			return nil, fmt.Errorf("%w: missing common or official name for %s", errInvalidCountryName, entry.CountryCode)
+		}
+
+		seen := map[string]bool{
+			strings.ToLower(entry.CommonName):   true,
+			strings.ToLower(entry.OfficialName): true,
+		}
+		for _, alias := range entry.Aliases {
+			key := strings.ToLower(alias)
+			if strings.TrimSpace(alias) == "" || seen[key] {
+				return nil, fmt.Errorf("%w: alias %q for %s", errInvalidCountryName, alias, entry.CountryCode)
+			}
+			seen[key] = true
+		}
+	}
+
+	return entries, nil
+}
+
+// LoadLocalizedNames loads and parses the names of the countries in every supported language
+//
+// Every language needs a BCP 47 tag made of a lowercase ISO 639 code and an optional script or region
+// (e.g., "de", "zh-Hant"), listed once, and every name needs an uppercase alpha-2 code.
+func (g *Generator) LoadLocalizedNames() (localizedNameList, error) {
+	data, err := g.dataLoader.LoadLocalizedNameData()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load localized name data: %w", err)
+	}
+
+	var entries localizedNameList
+	if err = json.Unmarshal(data, &entries); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal localized name data: %w", err)
+	}
+
+	seen := make(map[string]bool, len(entries))
+	for _, entry := range entries {
+		key := strings.ToLower(entry.Language)
+		if !isLanguageTag(entry.Language) || seen[key] {
+			return nil, fmt.Errorf("%w: language %q", errInvalidLocalizedName, entry.Language)
+		}
+		seen[key] = true
+
+		for code, name := range entry.Names {
+			if len(code) != 2 || !isUpperLetters(code) || strings.TrimSpace(name) == "" {
+				return nil, fmt.Errorf("%w: %q for %s in %s", errInvalidLocalizedName, name, code, entry.Language)
+			}
+		}
+	}
+
+	return entries, nil
+}
+
+// LoadNativeNames loads and parses the native names of the countries
+//
+// Every country needs an uppercase alpha-2 code, and every name a lowercase ISO 639 language code.
+func (g *Generator) LoadNativeNames() (countryNativeNames, error) {
+	data, err := g.dataLoader.LoadNativeNameData()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load native name data: %w", err)
+	}
+
+	var entries countryNativeNames
+	if err = json.Unmarshal(data, &entries); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal native name data: %w", err)
+	}
+
+	for _, entry := range entries {
+		if len(entry.CountryCode) != 2 || !isUpperLetters(entry.CountryCode) {
+			return nil, fmt.Errorf("%w: country code %q", errInvalidLocalizedName, entry.CountryCode)
+		}
+		for _, name := range entry.Names {
+			if (len(name.Language) != 2 && len(name.Language) != 3) || !isLowerLetters(name.Language) ||
+				strings.TrimSpace(name.Name) == "" {
+				return nil, fmt.Errorf("%w: %q in %q for %s",
+					errInvalidLocalizedName, name.Name, name.Language, entry.CountryCode)
+			}
+		}
+	}
+
+	return entries, nil
+}
+
+// LoadLanguages loads and parses the ISO 639 language definitions
+//
+// Every language needs a lowercase ISO 639-3 code and an optional lowercase ISO 639-1 code,
+// and the list is sorted by ISO 639-3 code.
+func (g *Generator) LoadLanguages() (LanguageList, error) {
+	data, err := g.dataLoader.LoadLanguageData()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load language data: %w", err)
+	}
+
+	var entries []*languageData
+	if err = json.Unmarshal(data, &entries); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal language data: %w", err)
+	}
+
+	languages := make(LanguageList, 0, len(entries))
+	for _, entry := range entries {
+		if len(entry.Alpha3) != 3 || !isLowerLetters(entry.Alpha3) {
+			return nil, fmt.Errorf("%w: alpha-3 %q", errInvalidLanguageCode, entry.Alpha3)
+		}
+		if entry.Alpha2 != "" && (len(entry.Alpha2) != 2 || !isLowerLetters(entry.Alpha2)) {
+			return nil, fmt.Errorf("%w: alpha-2 %q for %s", errInvalidLanguageCode, entry.Alpha2, entry.Alpha3)
+		}
+
+		languages = append(languages, &Language{
+			Alpha2: entry.Alpha2,
+			Alpha3: entry.Alpha3,
+			Name:   entry.Name,
+		})
+	}
+
+	sort.SliceStable(languages, func(i, j int) bool {
+		return languages[i].Alpha3 < languages[j].Alpha3
+	})
+
+	return languages, nil
+}
+
+// LoadCountryLanguages loads and parses the official and widely spoken languages of every country
+//
+// Language codes must be lowercase ISO 639-1 or ISO 639-3 codes (e.g., "en", "fil").
+func (g *Generator) LoadCountryLanguages() (countryLanguages, error) {
+	data, err := g.dataLoader.LoadCountryLanguageData()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load country language data: %w", err)
+	}
+
+	var spoken countryLanguages
+	if err = json.Unmarshal(data, &spoken); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal country language data: %w", err)
+	}
+
+	for _, entry := range spoken {
+		for _, language := range entry.Languages {
+			if (len(language.Code) != 2 && len(language.Code) != 3) || !isLowerLetters(language.Code) {
+				return nil, fmt.Errorf("%w: %q for %s", errInvalidLanguageCode, language.Code, entry.CountryCode)
+			}
+		}
+	}
+
+	return spoken, nil
+}
+
+// MergeData combines country and currency data
+//
+// The merged currency code also becomes the first (primary) entry of the country's currency list,
+// and the population is stamped with the configured population data year.
+func (g *Generator) MergeData(countries CountryList, currencies countriesWithCurrencies) {
+	for index, country := range countries {
+		for _, altCountry := range currencies {
+			if country.Alpha2 == altCountry.CountryCode {
+				countries[index].Capital = altCountry.Capital
+				countries[index].ContinentName = altCountry.ContinentName
+				countries[index].CurrencyCode = altCountry.CurrencyCode
+				countries[index].Population = altCountry.Population
+				countries[index].PopulationYear = g.populationYear
+				if altCountry.CurrencyCode != "" {
+					countries[index].Currencies = []CountryCurrency{
+						{Code: altCountry.CurrencyCode, LegalTender: true, Primary: true},
+					}
+				}
+				break
+			}
+		}
+	}
+}
+
+// MergeAdditionalCurrencies appends every additional currency to its country's currency list
+//
+// Entries keep the order of the source data and duplicates of an existing code are ignored.
+func (g *Generator) MergeAdditionalCurrencies(countries CountryList, additional additionalCurrencies) {
+	byAlpha2 := make(map[string]*Country, len(countries))
+	for _, country := range countries {
+		byAlpha2[country.Alpha2] = country
+	}
+
+	for _, entry := range additional {
+		country, ok := byAlpha2[entry.CountryCode]
+		if !ok || country.usesCurrency(entry.CurrencyCode) {
+			continue
+		}
+		country.Currencies = append(country.Currencies, CountryCurrency{
+			Code:        entry.CurrencyCode,
+			LegalTender: entry.LegalTender,
+		})
+	}
+}
+
+// MergeCallingCodes adds every calling code, prefixed with "+", to its country's calling code list
+//
+// Entries keep the order of the source data, duplicates and unknown countries are ignored.
+func (g *Generator) MergeCallingCodes(countries CountryList, codes callingCodes) {
+	byAlpha2 := make(map[string]*Country, len(countries))
+	for _, country := range countries {
+		byAlpha2[country.Alpha2] = country
+	}
+
+	for _, entry := range codes {
+		country, ok := byAlpha2[entry.CountryCode]
+		if !ok || country.usesCallingCode("+"+entry.CallingCode) {
+			continue
+		}
+		country.CallingCodes = append(country.CallingCodes, "+"+entry.CallingCode)
+	}
+}
+
+// MergeTLDs sets the top-level domains of every country, lowercased and in the order of the source data
+//
+// Unknown countries are ignored.
+func (g *Generator) MergeTLDs(countries CountryList, tlds countryTLDs) {
+	byAlpha2 := make(map[string]*Country, len(countries))
+	for _, country := range countries {
+		byAlpha2[country.Alpha2] = country
+	}
+
+	for _, entry := range tlds {
+		country, ok := byAlpha2[entry.CountryCode]
+		if !ok {
+			continue
+		}
+		for _, tld := range entry.TLDs {
+			country.TLDs = append(country.TLDs, strings.ToLower(tld))
+		}
+	}
+}
+
+// MergeTimeZones sets the time zones of every country in the order of the source data
+//
+// Aliases are only used for lookups and unknown countries are ignored.
+func (g *Generator) MergeTimeZones(countries CountryList, zones countryTimeZones) {
+	byAlpha2 := make(map[string]*Country, len(countries))
+	for _, country := range countries {
+		byAlpha2[country.Alpha2] = country
+	}
+
+	for _, entry := range zones {
+		if country, ok := byAlpha2[entry.CountryCode]; ok {
+			country.TimeZones = append(country.TimeZones, entry.TimeZones...)
+		}
+	}
+}
+
+// MergeCoordinates sets the centroid, bounding box and capital location of every country
+//
+// Countries without a capital keep zero capital coordinates and unknown countries are ignored.
+func (g *Generator) MergeCoordinates(countries CountryList, coordinates countryCoordinates) {
+	byAlpha2 := make(map[string]*Country, len(countries))
+	for _, country := range countries {
+		byAlpha2[country.Alpha2] = country
+	}
+
+	for _, entry := range coordinates {
+		country, ok := byAlpha2[entry.CountryCode]
+		if !ok {
+			continue
+		}
+		country.BoundingBox = entry.BoundingBox
+		country.Centroid = entry.Centroid
+		if entry.Capital != nil {
+			country.CapitalCoordinates = *entry.Capital
+		}
+	}
+}
+
+// MergeCountryNames sets the common name, official name and aliases of every country
+//
+// Unknown countries are ignored.
+func (g *Generator) MergeCountryNames(countries CountryList, entries countryNames) {
+	byAlpha2 := make(map[string]*Country, len(countries))
+	for _, country := range countries {
+		byAlpha2[country.Alpha2] = country
+	}
+
+	for _, entry := range entries {
+		country, ok := byAlpha2[entry.CountryCode]
+		if !ok {
+			continue
+		}
+		country.Aliases = entry.Aliases
+		country.CommonName = entry.CommonName
+		country.OfficialName = entry.OfficialName
+	}
+}
+
+// MergeNativeNames sets the native names of every country
+//
+// Unknown countries are ignored.
+func (g *Generator) MergeNativeNames(countries CountryList, entries countryNativeNames) {
+	byAlpha2 := make(map[string]*Country, len(countries))
+	for _, country := range countries {
+		byAlpha2[country.Alpha2] = country
+	}
+
+	for _, entry := range entries {
+		if country, ok := byAlpha2[entry.CountryCode]; ok {
+			country.NativeNames = entry.Names
+		}
+	}
+}
+
+// MergeLanguages sets the languages of every country in the order of the source data
+//
+// Duplicates of an existing code and unknown countries are ignored.
+func (g *Generator) MergeLanguages(countries CountryList, spoken countryLanguages) {
+	byAlpha2 := make(map[string]*Country, len(countries))
+	for _, country := range countries {
+		byAlpha2[country.Alpha2] = country
+	}
+
+	for _, entry := range spoken {
+		country, ok := byAlpha2[entry.CountryCode]
+		if !ok {
+			continue
+		}
+		for _, language := range entry.Languages {
+			if country.speaksLanguage(language.Code) {
+				continue
+			}
+			country.Languages = append(country.Languages, CountryLanguage{
+				Code:     language.Code,
+				Official: language.Official,
+			})
+		}
+	}
+}
+
+// GenerateCapitalMap creates a sorted map of normalized capitals to country indices
+//
+// Capitals are normalized with names.Normalize (e.g., "Bogotá" to "bogota"), like the runtime lookups.
+func (g *Generator) GenerateCapitalMap(countries CountryList) []mapEntry {
+	capitalSeen := make(map[string]struct{})
+	var capitalEntries []mapEntry
+
+	for index, country := range countries {
+		if country.Capital != "" {
+			key := names.Normalize(country.Capital)
+			if _, ok := capitalSeen[key]; !ok {
+				capitalSeen[key] = struct{}{}
+				capitalEntries = append(capitalEntries, mapEntry{Key: key, Index: index})
+			}
+		}
+	}
+
+	sort.Slice(capitalEntries, func(i, j int) bool {
+		return capitalEntries[i].Key < capitalEntries[j].Key
+	})
+
+	return capitalEntries
+}
+
+// GroupCountriesByCurrency creates a sorted list of currency codes to the indices of the countries using them
+func (g *Generator) GroupCountriesByCurrency(countries CountryList) []groupEntry {
+	var groups []groupEntry
+	positions := make(map[string]int)
+
+	for index, country := range countries {
+		for _, currency := range country.Currencies {
+			position, ok := positions[currency.Code]
+			if !ok {
+				position = len(groups)
+				positions[currency.Code] = position
+				groups = append(groups, groupEntry{Key: currency.Code})
+			}
+			groups[position].Indices = append(groups[position].Indices, index)
+		}
+	}
+
+	sort.Slice(groups, func(i, j int) bool {
+		return groups[i].Key < groups[j].Key
+	})
+
+	return groups
+}
+
+// GroupCountriesByRegionCode creates a sorted list of UN M.49 area codes to the indices of the countries within them
+//
+// Every country belongs to its region (e.g., "019"), sub-region (e.g., "419") and intermediate region
+// (e.g., "005") when they are set.
+func (g *Generator) GroupCountriesByRegionCode(countries CountryList) []groupEntry {
+	var groups []groupEntry
+	positions := make(map[string]int)
+
+	for index, country := range countries {
+		for _, code := range []string{country.RegionCode, country.SubRegionCode, country.IntermediateRegionCode} {
+			if code == "" {
+				continue
+			}
+			position, ok := positions[code]
+			if !ok {
+				position = len(groups)
+				positions[code] = position
+				groups = append(groups, groupEntry{Key: code})
+			}
+			groups[position].Indices = append(groups[position].Indices, index)
+		}
+	}
+
+	sort.Slice(groups, func(i, j int) bool {
+		return groups[i].Key < groups[j].Key
+	})
+
+	return groups
+}
+
+// GenerateCallingCodeMap creates a sorted map of calling codes (digits only) to the index of their main country
+//
+// A calling code shared by several countries (e.g., "1" for the NANP members) belongs to the
+// entry flagged as main, or to the first country listed when none is flagged.
+func (g *Generator) GenerateCallingCodeMap(countries CountryList, codes callingCodes) []mapEntry {
+	positions := alpha2Positions(countries)
+	indices := make(map[string]int)
+
+	for _, entry := range codes {
+		index, ok := positions[entry.CountryCode]
+		if !ok {
+			continue
+		}
+		if _, exists := indices[entry.CallingCode]; !exists || entry.Main {
+			indices[entry.CallingCode] = index
+		}
+	}
+
+	return sortedMapEntries(indices)
+}
+
+// GeneratePhonePrefixMap creates a sorted map of phone number prefixes (digits only) to country indices
+//
+// Every calling code maps to its main country (see GenerateCallingCodeMap) and every calling code
+// followed by one of the country's national prefixes (e.g., "1684" for American Samoa) maps to that
+// country, so a longest-prefix match on a number resolves the most specific country.
+func (g *Generator) GeneratePhonePrefixMap(countries CountryList, codes callingCodes) []mapEntry {
+	positions := alpha2Positions(countries)
+	indices := make(map[string]int)
+
+	for _, entry := range g.GenerateCallingCodeMap(countries, codes) {
+		indices[entry.Key] = entry.Index
+	}
+
+	for _, entry := range codes {
+		index, ok := positions[entry.CountryCode]
+		if !ok {
+			continue
+		}
+		for _, prefix := range entry.Prefixes {
+			indices[entry.CallingCode+prefix] = index
+		}
+	}
+
+	return sortedMapEntries(indices)
+}
+
+// GroupCountriesByCallingCode creates a sorted list of calling codes (digits only) to the indices of the countries using them
+func (g *Generator) GroupCountriesByCallingCode(countries CountryList) []groupEntry {
+	var groups []groupEntry
+	positions := make(map[string]int)
+
+	for index, country := range countries {
+		for _, callingCode := range country.CallingCodes {
+			key := strings.TrimPrefix(callingCode, "+")
+			position, ok := positions[key]
+			if !ok {
+				position = len(groups)
+				positions[key] = position
+				groups = append(groups, groupEntry{Key: key})
+			}
+			groups[position].Indices = append(groups[position].Indices, index)
+		}
+	}
+
+	sort.Slice(groups, func(i, j int) bool {
+		return groups[i].Key < groups[j].Key
+	})
+
+	return groups
+}
+
+// GenerateTLDMap creates a sorted map of top-level domains to country indices
+//
+// Internationalized top-level domains are keyed by both their Unicode form (e.g., ".рф")
+// and their ASCII punycode form (e.g., ".xn--p1ai"). When two countries share a
+// top-level domain the first one wins.
+func (g *Generator) GenerateTLDMap(countries CountryList) []mapEntry {
+	indices := make(map[string]int)
+
+	for index, country := range countries {
+		for _, tld := range country.TLDs {
+			for _, key := range []string{tld, "." + toASCII(strings.TrimPrefix(tld, "."))} {
+				if _, ok := indices[key]; !ok {
+					indices[key] = index
+				}
+			}
+		}
+	}
+
+	return sortedMapEntries(indices)
+}
+
+// GenerateLanguageMap creates a sorted map of ISO 639-1 and ISO 639-3 codes to language indices
+func (g *Generator) GenerateLanguageMap(languages LanguageList) []mapEntry {
+	indices := make(map[string]int, 2*len(languages))
+
+	for index, language := range languages {
+		indices[language.Alpha3] = index
+		if language.Alpha2 != "" {
+			indices[language.Alpha2] = index
+		}
+	}
+
+	return sortedMapEntries(indices)
+}
+
+// GroupCountriesByLanguage creates a sorted list of language codes to the indices of the countries speaking them
+func (g *Generator) GroupCountriesByLanguage(countries CountryList) []groupEntry {
+	var groups []groupEntry
+	positions := make(map[string]int)
+
+	for index, country := range countries {
+		for _, language := range country.Languages {
+			position, ok := positions[language.Code]
+			if !ok {
+				position = len(groups)
+				positions[language.Code] = position
+				groups = append(groups, groupEntry{Key: language.Code})
+			}
+			groups[position].Indices = append(groups[position].Indices, index)
+		}
+	}
+
+	sort.Slice(groups, func(i, j int) bool {
+		return groups[i].Key < groups[j].Key
+	})
+
+	return groups
+}
+
+// GroupNeighbors creates a sorted list of alpha-2 codes to the indices of the countries sharing a land border
+//
+// Neighbors keep the order of the source data and unknown countries on either side are ignored.
+func (g *Generator) GroupNeighbors(countries CountryList, borders countryBorders) []groupEntry {
+	positions := alpha2Positions(countries)
+	var groups []groupEntry
+
+	for _, entry := range borders {
+		if _, ok := positions[entry.CountryCode]; !ok {
+			continue
+		}
+		group := groupEntry{Key: entry.CountryCode}
+		for _, border := range entry.Borders {
+			if index, ok := positions[border]; ok {
+				group.Indices = append(group.Indices, index)
+			}
+		}
+		if len(group.Indices) > 0 {
+			groups = append(groups, group)
+		}
+	}
+
+	sort.Slice(groups, func(i, j int) bool {
+		return groups[i].Key < groups[j].Key
+	})
+
+	return groups
+}
+
+// ListLandlocked creates a sorted list of the alpha-2 codes of the landlocked countries
+//
+// Unknown countries are ignored.
+func (g *Generator) ListLandlocked(countries CountryList, borders countryBorders) []string {
+	positions := alpha2Positions(countries)
+	var codes []string
+
+	for _, entry := range borders {
+		if _, ok := positions[entry.CountryCode]; ok && entry.Landlocked {
+			codes = append(codes, entry.CountryCode)
+		}
+	}
+
+	sort.Strings(codes)
+	return codes
+}
+
+// GenerateSearchTerms creates the search index: the folded names, aliases, alpha codes and capital of every country
+//
+// Terms are folded with names.Fold (e.g., "Côte d'Ivoire" to "cote divoire") and sorted by term and country.
+// The ISO, common and official names share the name field. A term repeated within a country (e.g., a capital
+// named after the country) keeps its first field, in the order name, alpha-2, alpha-3, alias and capital.
+func (g *Generator) GenerateSearchTerms(countries CountryList) []searchTermEntry {
+	var entries []searchTermEntry
+	seen := make(map[mapEntry]struct{})
+
+	for index, country := range countries {
+		for _, field := range []struct {
+			name   string
+			values []string
+		}{
+			{name: "name", values: []string{country.Name, country.CommonName, country.OfficialName}},
+			{name: "alpha2", values: []string{country.Alpha2}},
+			{name: "alpha3", values: []string{country.Alpha3}},
+			{name: "alias", values: country.Aliases},
+			{name: "capital", values: []string{country.Capital}},
+		} {
+			for _, value := range field.values {
+				term := names.Fold(value)
+				if term == "" {
+					continue
+				}
+				if _, ok := seen[mapEntry{Key: term, Index: index}]; ok {
+					continue
+				}
+				seen[mapEntry{Key: term, Index: index}] = struct{}{}
+				entries = append(entries, searchTermEntry{Field: field.name, Index: index, Term: term})
+			}
+		}
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].Term != entries[j].Term {
+			return entries[i].Term < entries[j].Term
+		}
+		return entries[i].Index < entries[j].Index
+	})
+
+	return entries
+}
+
+// GenerateNameMap creates a sorted map of normalized country names to country indices
+//
+// Names are normalized with names.Normalize (e.g., "Korea, Republic of" to "republic of korea"), like the
+// runtime lookups.
+// ISO names take precedence over common names, then official names, then aliases, so an alias never
+// hides the name of another country. Within each kind, when two countries share a name the first one wins.
+func (g *Generator) GenerateNameMap(countries CountryList) []mapEntry {
+	indices := make(map[string]int)
+
+	for _, kind := range []func(*Country) []string{
+		func(c *Country) []string { return []string{c.Name} },
+		func(c *Country) []string { return []string{c.CommonName} },
+		func(c *Country) []string { return []string{c.OfficialName} },
+		func(c *Country) []string { return c.Aliases },
+	} {
+		for index, country := range countries {
+			for _, name := range kind(country) {
+				key := names.Normalize(name)
+				if key == "" {
+					continue
+				}
+				if _, exists := indices[key]; !exists {
+					indices[key] = index
+				}
+			}
+		}
+	}
+
+	return sortedMapEntries(indices)
+}
+
+// GenerateLocalizedNames keeps the names of the known countries in every language, keyed by lowercase tag
+//
+// Languages are sorted by tag, and names of unknown countries are ignored.
+func (g *Generator) GenerateLocalizedNames(countries CountryList, localized localizedNameList) []localizedEntry {
+	positions := alpha2Positions(countries)
+
+	entries := make([]localizedEntry, 0, len(localized))
+	for _, language := range localized {
+		entry := localizedEntry{
+			Key:      strings.ToLower(language.Language),
+			Language: language.Language,
+			Names:    make(map[string]string, len(language.Names)),
+		}
+		for code, name := range language.Names {
+			if _, ok := positions[code]; ok {
+				entry.Names[code] = name
+			}
+		}
+		entries = append(entries, entry)
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Key < entries[j].Key
+	})
+
+	return entries
+}
+
+// GenerateTimeZoneMap creates a sorted map of lowercase time zone names and aliases to country indices
+//
+// When two countries share a name the first one wins.
+func (g *Generator) GenerateTimeZoneMap(countries CountryList, zones countryTimeZones) []mapEntry {
+	positions := alpha2Positions(countries)
+	indices := make(map[string]int)
+
+	for _, entry := range zones {
+		index, ok := positions[entry.CountryCode]
+		if !ok {
+			continue
+		}
+		for _, name := range entry.names() {
+			if _, exists := indices[strings.ToLower(name)]; !exists {
+				indices[strings.ToLower(name)] = index
+			}
+		}
+	}
+
+	return sortedMapEntries(indices)
+}
+
+// GroupSubdivisions creates a sorted list of country alpha-2 codes to subdivision indices
+func (g *Generator) GroupSubdivisions(subdivisions SubdivisionList) []groupEntry {
+	var groups []groupEntry
+	positions := make(map[string]int)
+
+	for index, subdivision := range subdivisions {
+		position, ok := positions[subdivision.CountryAlpha2]
+		if !ok {
+			position = len(groups)
+			positions[subdivision.CountryAlpha2] = position
+			groups = append(groups, groupEntry{Key: subdivision.CountryAlpha2})
+		}
+		groups[position].Indices = append(groups[position].Indices, index)
+	}
+
+	sort.Slice(groups, func(i, j int) bool {
+		return groups[i].Key < groups[j].Key
+	})
+
+	return groups
+}
+
+// trimBracketedNote removes a trailing note in brackets from a subdivision name, such as the local name and
+// code of "Cardiff [Caerdydd GB-CRD]" or the former code of "Skåne län [SE-12]"
+func trimBracketedNote(name string) string {
+	if !strings.HasSuffix(name, "]") {
+		return name
+	}
+	if i := strings.LastIndex(name, "["); i > 0 {
+		return strings.TrimSpace(name[:i])
+	}
+	return name
+}
+
+// GenerateSubdivisionNameMap creates a sorted map of "alpha2:name" keys to subdivision indices
+//
+// Names are only unique within a country, so the key is scoped by the country alpha-2 code.
+// When a country has two subdivisions with the same name the first (lowest code) wins.
+func (g *Generator) GenerateSubdivisionNameMap(subdivisions SubdivisionList) []mapEntry {
+	seen := make(map[string]struct{})
+	var entries []mapEntry
+
+	for index, subdivision := range subdivisions {
+		key := subdivision.CountryAlpha2 + ":" + strings.ToLower(subdivision.Name)
+		if _, ok := seen[key]; !ok {
+			seen[key] = struct{}{}
+			entries = append(entries, mapEntry{Key: key, Index: index})
+		}
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Key < entries[j].Key
+	})
+
+	return entries
+}
+
+// GenerateCode generates the formatted Go source code
+func (g *Generator) GenerateCode(data *PackageData) ([]byte, error) {
+	templateStr, err := g.templateProvider.GetPackageTemplate()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get template: %w", err)
+	}
+
+	return renderSource(templateStr, struct {
+		*PackageData
+
+		Timestamp time.Time
+		URL       string
+	}{
+		PackageData: data,
+		Timestamp:   time.Now(),
+		URL:         g.repoURL,
+	})
+}
+
+// GeneratePhoneCode generates the formatted Go source code of the phone subpackage
+func (g *Generator) GeneratePhoneCode(rules PhoneRuleList) ([]byte, error) {
+	templateStr, err := g.templateProvider.GetPhoneTemplate()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get phone template: %w", err)
+	}
+
+	return renderSource(templateStr, struct {
+		Rules PhoneRuleList
+		URL   string
+	}{
+		Rules: rules,
+		URL:   g.repoURL,
+	})
+}
+
+// GenerateGeoCode generates the formatted Go source code of the geo subpackage
+func (g *Generator) GenerateGeoCode(boundaries BoundaryList) ([]byte, error) {
+	templateStr, err := g.templateProvider.GetGeoTemplate()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get geo template: %w", err)
+	}
+
+	return renderSource(templateStr, struct {
+		Boundaries BoundaryList
+		URL        string
+	}{
+		Boundaries: boundaries,
+		URL:        g.repoURL,
+	})
+}
+
+// renderSource executes the template with the given data and formats the result as Go source code
+func renderSource(templateStr string, data any) ([]byte, error) {
+	tmpl := template.Must(template.New("").Funcs(template.FuncMap{
+		"lower": strings.ToLower,
+	}).Parse(templateStr))
+
+	var buf bytes.Buffer
+	if execErr := tmpl.Execute(&buf, data); execErr != nil {
+		return nil, fmt.Errorf("template execution failed: %w", execErr)
+	}
+
+	formatted, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("failed to format generated source: %w", err)
+	}
+
+	return formatted, nil
+}
+
+// usesCurrency reports whether the country already lists the currency code
+func (c *Country) usesCurrency(code string) bool {
+	for _, currency := range c.Currencies {
+		if currency.Code == code {
+			return true
+		}
+	}
+	return false
+}
+
+// usesCallingCode reports whether the country already lists the calling code
+func (c *Country) usesCallingCode(code string) bool {
+	for _, callingCode := range c.CallingCodes {
+		if callingCode == code {
+			return true
+		}
+	}
+	return false
+}
+
+// speaksLanguage reports whether the country already lists the language code
+func (c *Country) speaksLanguage(code string) bool {
+	for _, language := range c.Languages {
+		if language.Code == code {
+			return true
+		}
+	}
+	return false
+}
+
+// names returns the time zones of the entry followed by their aliases
+func (z *timeZoneData) names() []string {
+	return append(append([]string(nil), z.TimeZones...), z.Aliases...)
+}
+
+// IsZero reports whether the bounding box is unset
+func (b BoundingBox) IsZero() bool {
+	return b == BoundingBox{}
+}
+
+// valid reports whether the edges of the bounding box are within range and its south edge is not north of
+// its north edge
+func (b BoundingBox) valid() bool {
+	return validLatitude(b.North) && validLatitude(b.South) && b.South <= b.North &&
+		validLongitude(b.East) && validLongitude(b.West)
+}
+
+// IsZero reports whether the coordinates are unset
+func (c Coordinates) IsZero() bool {
+	return c == Coordinates{}
+}
+
+// valid reports whether the latitude and longitude are within range
+func (c Coordinates) valid() bool {
+	return validLatitude(c.Latitude) && validLongitude(c.Longitude)
+}
+
+// validLatitude reports whether the value is a latitude in decimal degrees
+func validLatitude(value float64) bool {
+	return value >= -90 && value <= 90
+}
+
+// validLongitude reports whether the value is a longitude in decimal degrees
+func validLongitude(value float64) bool {
+	return value >= -180 && value <= 180
+}
+
+// alpha2Positions indexes the position of every country by its alpha-2 code
+func alpha2Positions(countries CountryList) map[string]int {
+	positions := make(map[string]int, len(countries))
+	for index, country := range countries {
+		positions[country.Alpha2] = index
+	}
+	return positions
+}
+
+// sortedMapEntries converts a key to index map into a list sorted by key
+func sortedMapEntries(indices map[string]int) []mapEntry {
+	entries := make([]mapEntry, 0, len(indices))
+	for key, index := range indices {
+		entries = append(entries, mapEntry{Key: key, Index: index})
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Key < entries[j].Key
+	})
+
+	return entries
+}
+
+// isDigits reports whether the value is a non-empty string of ASCII digits
+func isDigits(value string) bool {
+	if value == "" {
+		return false
+	}
+	for _, r := range value {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// isLowerLetters reports whether the value is a non-empty string of lowercase ASCII letters
+func isLowerLetters(value string) bool {
+	if value == "" {
+		return false
+	}
+	for _, r := range value {
+		if r < 'a' || r > 'z' {
+			return false
+		}
+	}
+	return true
+}
+
+// isUpperLetters reports whether the value is a non-empty string of uppercase ASCII letters
+func isUpperLetters(value string) bool {
+	if value == "" {
+		return false
+	}
+	for _, r := range value {
+		if r < 'A' || r > 'Z' {
+			return false
+		}
+	}
+	return true
+}
+
+// isLanguageTag reports whether the value is a lowercase ISO 639 code, optionally followed by a titlecase
+// ISO 15924 script (e.g., "zh-Hant") or an uppercase ISO 3166-1 region (e.g., "pt-BR")
+func isLanguageTag(value string) bool {
+	base, subtag, found := strings.Cut(value, "-")
+	if (len(base) != 2 && len(base) != 3) || !isLowerLetters(base) {
+		return false
+	}
+	switch {
+	case !found:
+		return true
+	case len(subtag) == 4:
+		return isUpperLetters(subtag[:1]) && isLowerLetters(subtag[1:])
+	default:
+		return len(subtag) == 2 && isUpperLetters(subtag)
+	}
+}
+
+// isTimeZoneName reports whether the value is an "Area/Location" IANA time zone name
+func isTimeZoneName(value string) bool {
+	area, location, found := strings.Cut(value, "/")
+	if !found || area == "" || location == "" || strings.HasSuffix(location, "/") {
+		return false
+	}
+	for _, r := range value {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+		case r == '_', r == '-', r == '+', r == '/':
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// polylinePoints counts the points of an encoded polyline (precision 1e-4) and reports whether every point
+// is a valid latitude and longitude
+func polylinePoints(encoded string) (int, bool) {
+	var points int
+	var lat, lon int64
+	for i := 0; i < len(encoded); points++ {
+		for _, coordinate := range []*int64{&lat, &lon} {
+			var result int64
+			var shift uint
+			for {
+				if i == len(encoded) || encoded[i] < 63 || encoded[i] > 126 || shift > 30 {
+					return 0, false
+				}
+				b := int64(encoded[i]) - 63
+				i++
+				result |= (b & 0x1f) << shift
+				shift += 5
+				if b < 0x20 {
+					break
+				}
+			}
+			if result&1 != 0 {
+				result = ^(result >> 1)
+			} else {
+				result >>= 1
+			}
+			*coordinate += result
+		}
+		if !validLatitude(float64(lat)/1e4) || !validLongitude(float64(lon)/1e4) {
+			return 0, false
+		}
+	}
+	return points, true
+}
+
+// WriteOutput writes the generated code to the output file
+func (g *Generator) WriteOutput(code []byte) error {
+	return g.writeFile(g.outputPath, code)
+}
+
+// WritePhoneOutput writes the generated phone subpackage code to the phone output file
+func (g *Generator) WritePhoneOutput(code []byte) error {
+	return g.writeFile(g.phoneOutputPath, code)
+}
+
+// WriteGeoOutput writes the generated geo subpackage code to the geo output file
+func (g *Generator) WriteGeoOutput(code []byte) error {
+	return g.writeFile(g.geoOutputPath, code)
+}
+
+// writeFile writes the generated code to the file at the given path
+func (g *Generator) writeFile(path string, code []byte) error {
+	file, err := g.fileWriter.Create(path)
 	if err != nil {
 		return fmt.Errorf("failed to create output file: %w", err)
 	}
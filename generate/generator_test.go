@@ -18,6 +18,22 @@ var (
 	errFailedToCreateFile   = errors.New("failed to create file")
 	errLoadError            = errors.New("load error")
 	errCurrencyError        = errors.New("currency error")
+	errSubdivisionError     = errors.New("subdivision error")
+	errISO4217Error         = errors.New("iso 4217 error")
+	errCallingCodeError     = errors.New("calling code error")
+	errPhoneNumberingError  = errors.New("phone numbering error")
+	errTLDError             = errors.New("tld error")
+	errLanguageError        = errors.New("language error")
+	errTimeZoneError        = errors.New("time zone error")
+	errCoordinatesError     = errors.New("coordinates error")
+	errBoundaryError        = errors.New("boundary error")
+	errBorderError          = errors.New("border error")
+	errCountryNameError     = errors.New("country name error")
+	errLocalizedNameError   = errors.New("localized name error")
+	errNativeNameError      = errors.New("native name error")
+
+	errAdditionalCurrencyError = errors.New("additional currency error")
+	errCountryLanguageError    = errors.New("country language error")
 )
 
 func TestNewGenerator(t *testing.T) {
@@ -26,6 +42,7 @@ func TestNewGenerator(t *testing.T) {
 		FileWriter:       &MockFileWriter{},
 		TemplateProvider: &MockTemplateProvider{},
 		OutputPath:       "test.go",
+		PopulationYear:   2010,
 		RepoURL:          "https://github.com/test/repo",
 	}
 
@@ -33,6 +50,7 @@ func TestNewGenerator(t *testing.T) {
 
 	assert.NotNil(t, generator)
 	assert.Equal(t, "test.go", generator.outputPath)
+	assert.Equal(t, 2010, generator.populationYear)
 	assert.Equal(t, "https://github.com/test/repo", generator.repoURL)
 }
 
@@ -86,6 +104,18 @@ func TestGenerator_LoadCurrencies_Success(t *testing.T) {
 	assert.Equal(t, "TC", currencies[0].CountryCode)
 	assert.Equal(t, "Test Country", currencies[0].CountryName)
 	assert.Equal(t, "TST", currencies[0].CurrencyCode)
+	assert.Equal(t, int64(1000000), currencies[0].Population)
+}
+
+func TestGenerator_LoadCurrencies_InvalidPopulation(t *testing.T) {
+	generator, mockLoader, _, _ := NewTestGenerator()
+	mockLoader.CurrencyData = []byte(`[{"countryCode": "TC", "population": "many"}]`)
+
+	currencies, err := generator.LoadCurrencies()
+
+	require.Error(t, err)
+	assert.Nil(t, currencies)
+	assert.Contains(t, err.Error(), "failed to unmarshal currency data")
 }
 
 func TestGenerator_LoadCurrencies_DataLoaderError(t *testing.T) {
@@ -116,44 +146,1435 @@ func TestGenerator_MergeData(t *testing.T) {
 	_ = mockWriter
 	_ = mockTemplate
 
-	countries, err := generator.LoadCountries()
-	require.NoError(t, err)
+	countries, err := generator.LoadCountries()
+	require.NoError(t, err)
+
+	currencies, err := generator.LoadCurrencies()
+	require.NoError(t, err)
+
+	generator.MergeData(countries, currencies)
+	assert.Equal(t, []CountryCurrency{{Code: "TST", LegalTender: true, Primary: true}}, countries[0].Currencies)
+	assert.Equal(t, "Test Capital", countries[0].Capital)
+	assert.Equal(t, "Test Continent", countries[0].ContinentName)
+	assert.Equal(t, "TST", countries[0].CurrencyCode)
+	assert.Equal(t, "Another Capital", countries[1].Capital)
+	assert.Equal(t, "Another Continent", countries[1].ContinentName)
+	assert.Equal(t, "ANO", countries[1].CurrencyCode)
+	assert.Equal(t, int64(1000000), countries[0].Population)
+	assert.Equal(t, 2020, countries[0].PopulationYear)
+	assert.Equal(t, int64(2000000), countries[1].Population)
+	assert.Equal(t, 2020, countries[1].PopulationYear)
+}
+
+func TestGenerator_LoadAdditionalCurrencies_Success(t *testing.T) {
+	generator, _, _, _ := NewTestGenerator()
+
+	additional, err := generator.LoadAdditionalCurrencies()
+
+	require.NoError(t, err)
+	require.Len(t, additional, 4)
+	assert.Equal(t, "TC", additional[0].CountryCode)
+	assert.Equal(t, "ANO", additional[0].CurrencyCode)
+	assert.True(t, additional[0].LegalTender)
+	assert.False(t, additional[1].LegalTender)
+}
+
+func TestGenerator_LoadAdditionalCurrencies_DataLoaderError(t *testing.T) {
+	generator, mockLoader, _, _ := NewTestGenerator()
+	mockLoader.AdditionalCurrencyError = errAdditionalCurrencyError
+
+	additional, err := generator.LoadAdditionalCurrencies()
+
+	require.Error(t, err)
+	assert.Nil(t, additional)
+	assert.Contains(t, err.Error(), "failed to load additional currency data")
+}
+
+func TestGenerator_LoadAdditionalCurrencies_InvalidJSON(t *testing.T) {
+	generator, mockLoader, _, _ := NewTestGenerator()
+	mockLoader.AdditionalCurrencyData = []byte("invalid json")
+
+	additional, err := generator.LoadAdditionalCurrencies()
+
+	require.Error(t, err)
+	assert.Nil(t, additional)
+	assert.Contains(t, err.Error(), "failed to unmarshal additional currency data")
+}
+
+func TestGenerator_MergeAdditionalCurrencies(t *testing.T) {
+	generator, _, _, _ := NewTestGenerator()
+
+	countries, err := generator.LoadCountries()
+	require.NoError(t, err)
+
+	currencies, err := generator.LoadCurrencies()
+	require.NoError(t, err)
+
+	additional, err := generator.LoadAdditionalCurrencies()
+	require.NoError(t, err)
+
+	generator.MergeData(countries, currencies)
+	generator.MergeAdditionalCurrencies(countries, additional)
+
+	// Extra entries keep their order, duplicates of the primary and unknown countries are ignored
+	assert.Equal(t, []CountryCurrency{
+		{Code: "TST", LegalTender: true, Primary: true},
+		{Code: "ANO", LegalTender: true},
+		{Code: "TSF"},
+	}, countries[0].Currencies)
+	assert.Equal(t, []CountryCurrency{{Code: "ANO", LegalTender: true, Primary: true}}, countries[1].Currencies)
+}
+
+func TestGenerator_LoadCallingCodes_Success(t *testing.T) {
+	generator, _, _, _ := NewTestGenerator()
+
+	codes, err := generator.LoadCallingCodes()
+
+	require.NoError(t, err)
+	require.Len(t, codes, 3)
+	assert.Equal(t, "AC", codes[0].CountryCode)
+	assert.Equal(t, "999", codes[0].CallingCode)
+	assert.False(t, codes[0].Main)
+	assert.Equal(t, []string{"12", "345"}, codes[0].Prefixes)
+	assert.True(t, codes[1].Main)
+}
+
+func TestGenerator_LoadCallingCodes_DataLoaderError(t *testing.T) {
+	generator, mockLoader, _, _ := NewTestGenerator()
+	mockLoader.CallingCodeError = errCallingCodeError
+
+	codes, err := generator.LoadCallingCodes()
+
+	require.Error(t, err)
+	assert.Nil(t, codes)
+	assert.Contains(t, err.Error(), "failed to load calling code data")
+}
+
+func TestGenerator_LoadCallingCodes_InvalidJSON(t *testing.T) {
+	generator, mockLoader, _, _ := NewTestGenerator()
+	mockLoader.CallingCodeData = []byte("invalid json")
+
+	codes, err := generator.LoadCallingCodes()
+
+	require.Error(t, err)
+	assert.Nil(t, codes)
+	assert.Contains(t, err.Error(), "failed to unmarshal calling code data")
+}
+
+func TestGenerator_LoadCallingCodes_InvalidCode(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+	}{
+		{name: "Leading plus", data: `[{"countryCode": "TC", "callingCode": "+1"}]`},
+		{name: "Empty", data: `[{"countryCode": "TC", "callingCode": ""}]`},
+		{name: "Invalid prefix", data: `[{"countryCode": "TC", "callingCode": "1", "prefixes": ["68-4"]}]`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			generator, mockLoader, _, _ := NewTestGenerator()
+			mockLoader.CallingCodeData = []byte(tt.data)
+
+			codes, err := generator.LoadCallingCodes()
+
+			require.Error(t, err)
+			require.ErrorIs(t, err, errInvalidCallingCode)
+			assert.Nil(t, codes)
+		})
+	}
+}
+
+func TestGenerator_LoadPhoneRules_Success(t *testing.T) {
+	generator, _, _, _ := NewTestGenerator()
+
+	rules, err := generator.LoadPhoneRules()
+
+	require.NoError(t, err)
+	require.Len(t, rules, 2)
+	assert.Equal(t, "AC", rules[0].CountryAlpha2)
+	assert.Empty(t, rules[0].NationalPrefix)
+	assert.Equal(t, "TC", rules[1].CountryAlpha2)
+	assert.Equal(t, "0", rules[1].NationalPrefix)
+	assert.Equal(t, []int{8, 9}, rules[1].Lengths)
+	assert.Equal(t, `[2-9]\d{7,8}`, rules[1].Pattern)
+}
+
+func TestGenerator_LoadPhoneRules_DataLoaderError(t *testing.T) {
+	generator, mockLoader, _, _ := NewTestGenerator()
+	mockLoader.PhoneNumberingError = errPhoneNumberingError
+
+	rules, err := generator.LoadPhoneRules()
+
+	require.Error(t, err)
+	assert.Nil(t, rules)
+	assert.Contains(t, err.Error(), "failed to load phone numbering data")
+}
+
+func TestGenerator_LoadPhoneRules_InvalidJSON(t *testing.T) {
+	generator, mockLoader, _, _ := NewTestGenerator()
+	mockLoader.PhoneNumberingData = []byte("invalid json")
+
+	rules, err := generator.LoadPhoneRules()
+
+	require.Error(t, err)
+	assert.Nil(t, rules)
+	assert.Contains(t, err.Error(), "failed to unmarshal phone numbering data")
+}
+
+func TestGenerator_LoadPhoneRules_InvalidRule(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+	}{
+		{name: "No lengths", data: `[{"countryCode": "TC", "lengths": [], "pattern": "\\d{8}"}]`},
+		{name: "Empty pattern", data: `[{"countryCode": "TC", "lengths": [8], "pattern": ""}]`},
+		{name: "Invalid pattern", data: `[{"countryCode": "TC", "lengths": [8], "pattern": "[2-9"}]`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			generator, mockLoader, _, _ := NewTestGenerator()
+			mockLoader.PhoneNumberingData = []byte(tt.data)
+
+			rules, err := generator.LoadPhoneRules()
+
+			require.Error(t, err)
+			require.ErrorIs(t, err, errInvalidPhoneRule)
+			assert.Nil(t, rules)
+		})
+	}
+}
+
+func TestGenerator_LoadBoundaries_Success(t *testing.T) {
+	generator, _, _, _ := NewTestGenerator()
+
+	boundaries, err := generator.LoadBoundaries()
+
+	require.NoError(t, err)
+	require.Len(t, boundaries, 2)
+	assert.Equal(t, "AC", boundaries[0].CountryAlpha2)
+	require.Len(t, boundaries[0].Polygons, 1)
+	assert.Len(t, boundaries[0].Polygons[0], 2)
+	assert.Equal(t, "TC", boundaries[1].CountryAlpha2)
+	assert.Equal(t, [][]string{{`_dyKn_gk@_pR??oh\~oR?`}}, boundaries[1].Polygons)
+}
+
+func TestGenerator_LoadBoundaries_DataLoaderError(t *testing.T) {
+	generator, mockLoader, _, _ := NewTestGenerator()
+	mockLoader.BoundaryError = errBoundaryError
+
+	boundaries, err := generator.LoadBoundaries()
+
+	require.Error(t, err)
+	assert.Nil(t, boundaries)
+	assert.Contains(t, err.Error(), "failed to load boundary data")
+}
+
+func TestGenerator_LoadBoundaries_InvalidJSON(t *testing.T) {
+	generator, mockLoader, _, _ := NewTestGenerator()
+	mockLoader.BoundaryData = []byte("invalid json")
+
+	boundaries, err := generator.LoadBoundaries()
+
+	require.Error(t, err)
+	assert.Nil(t, boundaries)
+	assert.Contains(t, err.Error(), "failed to unmarshal boundary data")
+}
+
+func TestGenerator_LoadBoundaries_InvalidBoundary(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+	}{
+		{name: "No polygons", data: `[{"countryCode": "TC", "polygons": []}]`},
+		{name: "Empty ring", data: `[{"countryCode": "TC", "polygons": [[""]]}]`},
+		{name: "Two points", data: `[{"countryCode": "TC", "polygons": [["_dyKn_gk@_pR?"]]}]`},
+		{name: "Truncated point", data: `[{"countryCode": "TC", "polygons": [["_dyKn_gk@_pR??oh\\~oR"]]}]`},
+		{name: "Invalid character", data: `[{"countryCode": "TC", "polygons": [["_dyK n_gk@_pR??oh\\~oR?"]]}]`},
+		{name: "Latitude out of range", data: `[{"countryCode": "TC", "polygons": [["_gsia@??_pR_pR?"]]}]`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			generator, mockLoader, _, _ := NewTestGenerator()
+			mockLoader.BoundaryData = []byte(tt.data)
+
+			boundaries, err := generator.LoadBoundaries()
+
+			require.Error(t, err)
+			require.ErrorIs(t, err, errInvalidBoundary)
+			assert.Nil(t, boundaries)
+		})
+	}
+}
+
+func TestGenerator_LoadTLDs_Success(t *testing.T) {
+	generator, _, _, _ := NewTestGenerator()
+
+	tlds, err := generator.LoadTLDs()
+
+	require.NoError(t, err)
+	require.Len(t, tlds, 3)
+	assert.Equal(t, "TC", tlds[0].CountryCode)
+	assert.Equal(t, []string{".tc", ".тест"}, tlds[0].TLDs)
+}
+
+func TestGenerator_LoadTLDs_DataLoaderError(t *testing.T) {
+	generator, mockLoader, _, _ := NewTestGenerator()
+	mockLoader.TLDError = errTLDError
+
+	tlds, err := generator.LoadTLDs()
+
+	require.Error(t, err)
+	assert.Nil(t, tlds)
+	assert.Contains(t, err.Error(), "failed to load TLD data")
+}
+
+func TestGenerator_LoadTLDs_InvalidJSON(t *testing.T) {
+	generator, mockLoader, _, _ := NewTestGenerator()
+	mockLoader.TLDData = []byte("invalid json")
+
+	tlds, err := generator.LoadTLDs()
+
+	require.Error(t, err)
+	assert.Nil(t, tlds)
+	assert.Contains(t, err.Error(), "failed to unmarshal TLD data")
+}
+
+func TestGenerator_LoadTLDs_InvalidTLD(t *testing.T) {
+	tests := []struct {
+		name string
+		tld  string
+	}{
+		{name: "Missing dot", tld: "uk"},
+		{name: "Dot only", tld: "."},
+		{name: "Second level", tld: ".co.uk"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			generator, mockLoader, _, _ := NewTestGenerator()
+			mockLoader.TLDData = []byte(`[{"countryCode": "GB", "tlds": ["` + tt.tld + `"]}]`)
+
+			tlds, err := generator.LoadTLDs()
+
+			require.Error(t, err)
+			require.ErrorIs(t, err, errInvalidTLD)
+			assert.Nil(t, tlds)
+		})
+	}
+}
+
+func TestGenerator_MergeTLDs(t *testing.T) {
+	generator, _, _, _ := NewTestGenerator()
+
+	countries := CountryList{{Alpha2: "GB"}, {Alpha2: "RU"}, {Alpha2: "BL"}}
+
+	generator.MergeTLDs(countries, countryTLDs{
+		{CountryCode: "GB", TLDs: []string{".UK"}},
+		{CountryCode: "RU", TLDs: []string{".ru", ".РФ"}},
+		{CountryCode: "ZZ", TLDs: []string{".zz"}},
+	})
+
+	assert.Equal(t, []string{".uk"}, countries[0].TLDs)
+	assert.Equal(t, []string{".ru", ".рф"}, countries[1].TLDs)
+	assert.Nil(t, countries[2].TLDs)
+}
+
+func TestGenerator_GroupCountriesByRegionCode(t *testing.T) {
+	generator, _, _, _ := NewTestGenerator()
+
+	countries := CountryList{
+		{Alpha2: "BR", RegionCode: "019", SubRegionCode: "419", IntermediateRegionCode: "005"},
+		{Alpha2: "AQ"},
+		{Alpha2: "US", RegionCode: "019", SubRegionCode: "021"},
+		{Alpha2: "MX", RegionCode: "019", SubRegionCode: "419", IntermediateRegionCode: "013"},
+	}
+
+	groups := generator.GroupCountriesByRegionCode(countries)
+
+	assert.Equal(t, []groupEntry{
+		{Key: "005", Indices: []int{0}},
+		{Key: "013", Indices: []int{3}},
+		{Key: "019", Indices: []int{0, 2, 3}},
+		{Key: "021", Indices: []int{2}},
+		{Key: "419", Indices: []int{0, 3}},
+	}, groups)
+}
+
+func TestGenerator_GenerateTLDMap(t *testing.T) {
+	generator, _, _, _ := NewTestGenerator()
+
+	countries := CountryList{
+		{Alpha2: "RU", TLDs: []string{".ru", ".рф"}},
+		{Alpha2: "AQ"},
+		{Alpha2: "GB", TLDs: []string{".uk"}},
+		{Alpha2: "XX", TLDs: []string{".uk"}},
+	}
+
+	entries := generator.GenerateTLDMap(countries)
+
+	assert.Equal(t, []mapEntry{
+		{Key: ".ru", Index: 0},
+		{Key: ".uk", Index: 2},
+		{Key: ".xn--p1ai", Index: 0},
+		{Key: ".рф", Index: 0},
+	}, entries)
+}
+
+func TestGenerator_LoadTimeZones_Success(t *testing.T) {
+	generator, _, _, _ := NewTestGenerator()
+
+	zones, err := generator.LoadTimeZones()
+
+	require.NoError(t, err)
+	require.Len(t, zones, 3)
+	assert.Equal(t, "AC", zones[1].CountryCode)
+	assert.Equal(t, []string{"Atlantic/St_Helena", "Atlantic/Ascension"}, zones[1].TimeZones)
+	assert.Equal(t, []string{"Test/Ascension"}, zones[1].Aliases)
+	assert.Nil(t, zones[0].Aliases)
+}
+
+func TestGenerator_LoadTimeZones_DataLoaderError(t *testing.T) {
+	generator, mockLoader, _, _ := NewTestGenerator()
+	mockLoader.TimeZoneError = errTimeZoneError
+
+	zones, err := generator.LoadTimeZones()
+
+	require.Error(t, err)
+	assert.Nil(t, zones)
+	assert.Contains(t, err.Error(), "failed to load time zone data")
+}
+
+func TestGenerator_LoadTimeZones_InvalidJSON(t *testing.T) {
+	generator, mockLoader, _, _ := NewTestGenerator()
+	mockLoader.TimeZoneData = []byte("invalid json")
+
+	zones, err := generator.LoadTimeZones()
+
+	require.Error(t, err)
+	assert.Nil(t, zones)
+	assert.Contains(t, err.Error(), "failed to unmarshal time zone data")
+}
+
+func TestGenerator_LoadTimeZones_InvalidName(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+	}{
+		{name: "Missing area", data: `[{"countryCode": "US", "timeZones": ["New_York"]}]`},
+		{name: "Empty location", data: `[{"countryCode": "US", "timeZones": ["America/"]}]`},
+		{name: "Empty area", data: `[{"countryCode": "US", "timeZones": ["/New_York"]}]`},
+		{name: "Space", data: `[{"countryCode": "US", "timeZones": ["America/New York"]}]`},
+		{name: "Invalid alias", data: `[{"countryCode": "US", "timeZones": ["America/New_York"], "aliases": ["EST"]}]`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			generator, mockLoader, _, _ := NewTestGenerator()
+			mockLoader.TimeZoneData = []byte(tt.data)
+
+			zones, err := generator.LoadTimeZones()
+
+			require.Error(t, err)
+			require.ErrorIs(t, err, errInvalidTimeZone)
+			assert.Nil(t, zones)
+		})
+	}
+}
+
+func TestGenerator_MergeTimeZones(t *testing.T) {
+	generator, _, _, _ := NewTestGenerator()
+
+	countries := CountryList{{Alpha2: "US"}, {Alpha2: "BV"}}
+
+	generator.MergeTimeZones(countries, countryTimeZones{
+		{CountryCode: "US", TimeZones: []string{"America/New_York", "America/Chicago"}, Aliases: []string{"US/Eastern"}},
+		{CountryCode: "ZZ", TimeZones: []string{"Etc/Unknown"}},
+	})
+
+	assert.Equal(t, []string{"America/New_York", "America/Chicago"}, countries[0].TimeZones)
+	assert.Nil(t, countries[1].TimeZones)
+}
+
+func TestGenerator_GenerateTimeZoneMap(t *testing.T) {
+	generator, _, _, _ := NewTestGenerator()
+
+	countries := CountryList{{Alpha2: "US"}, {Alpha2: "UA"}, {Alpha2: "RU"}}
+
+	entries := generator.GenerateTimeZoneMap(countries, countryTimeZones{
+		{CountryCode: "US", TimeZones: []string{"America/New_York"}, Aliases: []string{"US/Eastern"}},
+		{CountryCode: "UA", TimeZones: []string{"Europe/Kyiv", "Europe/Simferopol"}},
+		{CountryCode: "RU", TimeZones: []string{"Europe/Moscow", "Europe/Simferopol"}},
+		{CountryCode: "ZZ", TimeZones: []string{"Etc/Unknown"}},
+	})
+
+	assert.Equal(t, []mapEntry{
+		{Key: "america/new_york", Index: 0},
+		{Key: "europe/kyiv", Index: 1},
+		{Key: "europe/moscow", Index: 2},
+		{Key: "europe/simferopol", Index: 1},
+		{Key: "us/eastern", Index: 0},
+	}, entries)
+}
+
+func TestGenerator_LoadCoordinates_Success(t *testing.T) {
+	generator, _, _, _ := NewTestGenerator()
+
+	coordinates, err := generator.LoadCoordinates()
+
+	require.NoError(t, err)
+	require.Len(t, coordinates, 3)
+	assert.Equal(t, "TC", coordinates[0].CountryCode)
+	assert.Equal(t, Coordinates{Latitude: 21.7866, Longitude: -71.9674}, coordinates[0].Centroid)
+	assert.Equal(t, BoundingBox{East: -71.1277, North: 21.9618, South: 21.2903, West: -72.4822},
+		coordinates[0].BoundingBox)
+	assert.Equal(t, &Coordinates{Latitude: 21.4667, Longitude: -71.1333}, coordinates[0].Capital)
+	assert.Nil(t, coordinates[1].Capital)
+}
+
+func TestGenerator_LoadCoordinates_DataLoaderError(t *testing.T) {
+	generator, mockLoader, _, _ := NewTestGenerator()
+	mockLoader.CoordinatesError = errCoordinatesError
+
+	coordinates, err := generator.LoadCoordinates()
+
+	require.Error(t, err)
+	assert.Nil(t, coordinates)
+	assert.Contains(t, err.Error(), "failed to load coordinate data")
+}
+
+func TestGenerator_LoadCoordinates_InvalidJSON(t *testing.T) {
+	generator, mockLoader, _, _ := NewTestGenerator()
+	mockLoader.CoordinatesData = []byte("invalid json")
+
+	coordinates, err := generator.LoadCoordinates()
+
+	require.Error(t, err)
+	assert.Nil(t, coordinates)
+	assert.Contains(t, err.Error(), "failed to unmarshal coordinate data")
+}
+
+func TestGenerator_LoadCoordinates_InvalidCoordinates(t *testing.T) {
+	const box = `"boundingBox": {"north": 1, "south": -1, "east": 1, "west": -1}`
+
+	tests := []struct {
+		name string
+		data string
+	}{
+		{
+			name: "Centroid latitude",
+			data: `[{"countryCode": "ZZ", "centroid": {"latitude": 91, "longitude": 0}, ` + box + `}]`,
+		},
+		{
+			name: "Centroid longitude",
+			data: `[{"countryCode": "ZZ", "centroid": {"latitude": 0, "longitude": -181}, ` + box + `}]`,
+		},
+		{
+			name: "Capital longitude",
+			data: `[{"countryCode": "ZZ", "capital": {"latitude": 0, "longitude": 200}, ` + box + `}]`,
+		},
+		{
+			name: "South of north edge",
+			data: `[{"countryCode": "ZZ", "boundingBox": {"north": -1, "south": 1, "east": 1, "west": -1}}]`,
+		},
+		{
+			name: "Bounding box longitude",
+			data: `[{"countryCode": "ZZ", "boundingBox": {"north": 1, "south": -1, "east": 190, "west": -1}}]`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			generator, mockLoader, _, _ := NewTestGenerator()
+			mockLoader.CoordinatesData = []byte(tt.data)
+
+			coordinates, err := generator.LoadCoordinates()
+
+			require.Error(t, err)
+			require.ErrorIs(t, err, errInvalidCoordinates)
+			assert.Nil(t, coordinates)
+		})
+	}
+}
+
+func TestGenerator_MergeCoordinates(t *testing.T) {
+	generator, _, _, _ := NewTestGenerator()
+
+	countries := CountryList{{Alpha2: "FJ"}, {Alpha2: "AQ"}, {Alpha2: "BV"}}
+
+	generator.MergeCoordinates(countries, countryCoordinates{
+		{
+			CountryCode: "FJ",
+			Centroid:    Coordinates{Latitude: -17.8261, Longitude: 177.9754},
+			BoundingBox: BoundingBox{East: -178.2161, North: -12.4753, South: -21.7111, West: 174.5889},
+			Capital:     &Coordinates{Latitude: -18.1333, Longitude: 178.4167},
+		},
+		{
+			CountryCode: "AQ",
+			Centroid:    Coordinates{Latitude: -79.8432, Longitude: 35.8855},
+			BoundingBox: BoundingBox{East: 180, North: -60.5162, South: -90, West: -180},
+		},
+		{CountryCode: "ZZ", Centroid: Coordinates{Latitude: 1, Longitude: 1}},
+	})
+
+	assert.Equal(t, Coordinates{Latitude: -17.8261, Longitude: 177.9754}, countries[0].Centroid)
+	assert.Equal(t, BoundingBox{East: -178.2161, North: -12.4753, South: -21.7111, West: 174.5889},
+		countries[0].BoundingBox)
+	assert.Equal(t, Coordinates{Latitude: -18.1333, Longitude: 178.4167}, countries[0].CapitalCoordinates)
+	assert.True(t, countries[1].CapitalCoordinates.IsZero())
+	assert.False(t, countries[1].BoundingBox.IsZero())
+	assert.True(t, countries[2].Centroid.IsZero())
+	assert.True(t, countries[2].BoundingBox.IsZero())
+}
+
+func TestGenerator_LoadBorders_Success(t *testing.T) {
+	generator, _, _, _ := NewTestGenerator()
+
+	borders, err := generator.LoadBorders()
+
+	require.NoError(t, err)
+	require.Len(t, borders, 3)
+	assert.Equal(t, &borderData{CountryCode: "TC", Borders: []string{"AC", "ZZ"}}, borders[0])
+	assert.Equal(t, &borderData{CountryCode: "AC", Borders: []string{"TC"}, Landlocked: true}, borders[1])
+}
+
+func TestGenerator_LoadBorders_DataLoaderError(t *testing.T) {
+	generator, mockLoader, _, _ := NewTestGenerator()
+	mockLoader.BorderError = errBorderError
+
+	borders, err := generator.LoadBorders()
+
+	require.Error(t, err)
+	assert.Nil(t, borders)
+	assert.Contains(t, err.Error(), "failed to load border data")
+}
+
+func TestGenerator_LoadBorders_InvalidJSON(t *testing.T) {
+	generator, mockLoader, _, _ := NewTestGenerator()
+	mockLoader.BorderData = []byte("invalid json")
+
+	borders, err := generator.LoadBorders()
+
+	require.Error(t, err)
+	assert.Nil(t, borders)
+	assert.Contains(t, err.Error(), "failed to unmarshal border data")
+}
+
+func TestGenerator_LoadBorders_InvalidBorder(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+	}{
+		{name: "Lowercase", data: `[{"countryCode": "TC", "borders": ["ac"]}, {"countryCode": "AC", "borders": ["TC"]}]`},
+		{name: "Alpha-3", data: `[{"countryCode": "TC", "borders": ["ASC"]}]`},
+		{name: "Empty", data: `[{"countryCode": "TC", "borders": [""]}]`},
+		{name: "Itself", data: `[{"countryCode": "TC", "borders": ["TC"]}]`},
+		{name: "Duplicate", data: `[{"countryCode": "TC", "borders": ["AC", "AC"]}, {"countryCode": "AC", "borders": ["TC"]}]`},
+		{name: "One-sided", data: `[{"countryCode": "TC", "borders": ["AC"]}, {"countryCode": "AC", "borders": []}]`},
+		{name: "Missing country", data: `[{"countryCode": "TC", "borders": ["AC"]}]`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			generator, mockLoader, _, _ := NewTestGenerator()
+			mockLoader.BorderData = []byte(tt.data)
+
+			borders, err := generator.LoadBorders()
+
+			require.Error(t, err)
+			require.ErrorIs(t, err, errInvalidBorder)
+			assert.Nil(t, borders)
+		})
+	}
+}
+
+func TestGenerator_GroupNeighbors(t *testing.T) {
+	generator, _, _, _ := NewTestGenerator()
+
+	countries := CountryList{{Alpha2: "LU"}, {Alpha2: "DE"}, {Alpha2: "FR"}, {Alpha2: "JP"}}
+
+	groups := generator.GroupNeighbors(countries, countryBorders{
+		{CountryCode: "LU", Borders: []string{"BE", "DE", "FR"}, Landlocked: true},
+		{CountryCode: "FR", Borders: []string{"DE", "LU"}},
+		{CountryCode: "DE", Borders: []string{"FR", "LU"}},
+		{CountryCode: "BE", Borders: []string{"LU"}},
+		{CountryCode: "ZZ", Borders: []string{"YY"}},
+	})
+
+	assert.Equal(t, []groupEntry{
+		{Key: "DE", Indices: []int{2, 0}},
+		{Key: "FR", Indices: []int{1, 0}},
+		{Key: "LU", Indices: []int{1, 2}},
+	}, groups)
+}
+
+func TestGenerator_ListLandlocked(t *testing.T) {
+	generator, _, _, _ := NewTestGenerator()
+
+	countries := CountryList{{Alpha2: "LU"}, {Alpha2: "DE"}, {Alpha2: "AT"}}
+
+	codes := generator.ListLandlocked(countries, countryBorders{
+		{CountryCode: "LU", Borders: []string{"DE"}, Landlocked: true},
+		{CountryCode: "DE", Borders: []string{"AT", "LU"}},
+		{CountryCode: "AT", Borders: []string{"DE"}, Landlocked: true},
+		{CountryCode: "ZZ", Landlocked: true},
+	})
+
+	assert.Equal(t, []string{"AT", "LU"}, codes)
+}
+
+func TestGenerator_LoadCountryNames_Success(t *testing.T) {
+	generator, _, _, _ := NewTestGenerator()
+
+	entries, err := generator.LoadCountryNames()
+
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	assert.Equal(t, &countryNameData{
+		Aliases:      []string{"TC Land", "Testland"},
+		CommonName:   "Test Country",
+		CountryCode:  "TC",
+		OfficialName: "Republic of Test Country",
+	}, entries[0])
+	assert.Empty(t, entries[1].Aliases)
+}
+
+func TestGenerator_LoadCountryNames_DataLoaderError(t *testing.T) {
+	generator, mockLoader, _, _ := NewTestGenerator()
+	mockLoader.CountryNameError = errCountryNameError
+
+	entries, err := generator.LoadCountryNames()
+
+	require.Error(t, err)
+	assert.Nil(t, entries)
+	assert.Contains(t, err.Error(), "failed to load country name data")
+}
+
+func TestGenerator_LoadCountryNames_InvalidJSON(t *testing.T) {
+	generator, mockLoader, _, _ := NewTestGenerator()
+	mockLoader.CountryNameData = []byte("invalid json")
+
+	entries, err := generator.LoadCountryNames()
+
+	require.Error(t, err)
+	assert.Nil(t, entries)
+	assert.Contains(t, err.Error(), "failed to unmarshal country name data")
+}
+
+func TestGenerator_LoadCountryNames_InvalidName(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+	}{
+		{name: "Lowercase code", data: `[{"countryCode": "tc", "commonName": "Test", "officialName": "Test"}]`},
+		{name: "Alpha-3 code", data: `[{"countryCode": "TCA", "commonName": "Test", "officialName": "Test"}]`},
+		{name: "Missing common name", data: `[{"countryCode": "TC", "officialName": "Test"}]`},
+		{name: "Blank official name", data: `[{"countryCode": "TC", "commonName": "Test", "officialName": " "}]`},
+		{name: "Blank alias", data: `[{"countryCode": "TC", "commonName": "Test", "officialName": "Test", "aliases": [""]}]`},
+		{name: "Alias of the common name", data: `[{"countryCode": "TC", "commonName": "Test", "officialName": "Test", "aliases": ["TEST"]}]`},
+		{name: "Duplicate alias", data: `[{"countryCode": "TC", "commonName": "Test", "officialName": "Test", "aliases": ["TL", "tl"]}]`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			generator, mockLoader, _, _ := NewTestGenerator()
+			mockLoader.CountryNameData = []byte(tt.data)
+
+			entries, err := generator.LoadCountryNames()
+
+			require.Error(t, err)
+			require.ErrorIs(t, err, errInvalidCountryName)
+			assert.Nil(t, entries)
+		})
+	}
+}
+
+func TestGenerator_MergeCountryNames(t *testing.T) {
+	generator, _, _, _ := NewTestGenerator()
+
+	countries := CountryList{{Alpha2: "TC"}, {Alpha2: "AC"}}
+
+	generator.MergeCountryNames(countries, countryNames{
+		{CountryCode: "TC", CommonName: "Test Country", OfficialName: "Republic of Test Country", Aliases: []string{"TL"}},
+		{CountryCode: "ZZ", CommonName: "Unknown", OfficialName: "Unknown"},
+	})
+
+	assert.Equal(t, "Test Country", countries[0].CommonName)
+	assert.Equal(t, "Republic of Test Country", countries[0].OfficialName)
+	assert.Equal(t, []string{"TL"}, countries[0].Aliases)
+	assert.Empty(t, countries[1].CommonName)
+	assert.Empty(t, countries[1].OfficialName)
+}
+
+func TestGenerator_GenerateNameMap(t *testing.T) {
+	generator, _, _, _ := NewTestGenerator()
+
+	countries := CountryList{
+		{Name: "Korea, Republic of", CommonName: "South Korea", OfficialName: "Republic of Korea", Aliases: []string{"Korea, South"}},
+		{Name: "Congo", CommonName: "Republic of the Congo", OfficialName: "Republic of the Congo", Aliases: []string{"Korea"}},
+		{Name: "Korea", CommonName: "Korea"},
+	}
+
+	names := generator.GenerateNameMap(countries)
+
+	assert.Equal(t, []mapEntry{
+		{Key: "congo", Index: 1},
+		{Key: "korea", Index: 2},
+		{Key: "korea south", Index: 0},
+		{Key: "republic of korea", Index: 0},
+		{Key: "republic of the congo", Index: 1},
+		{Key: "south korea", Index: 0},
+	}, names)
+}
+
+func TestGenerator_LoadLocalizedNames_Success(t *testing.T) {
+	generator, _, _, _ := NewTestGenerator()
+
+	entries, err := generator.LoadLocalizedNames()
+
+	require.NoError(t, err)
+	require.Len(t, entries, 3)
+	assert.Equal(t, "fr", entries[0].Language)
+	assert.Equal(t, map[string]string{"TC": "Pays Test", "AC": "Autre Pays"}, entries[0].Names)
+	assert.Equal(t, "zh-Hant", entries[1].Language)
+}
+
+func TestGenerator_LoadLocalizedNames_DataLoaderError(t *testing.T) {
+	generator, mockLoader, _, _ := NewTestGenerator()
+	mockLoader.LocalizedNameError = errLocalizedNameError
+
+	entries, err := generator.LoadLocalizedNames()
+
+	require.Error(t, err)
+	assert.Nil(t, entries)
+	assert.Contains(t, err.Error(), "failed to load localized name data")
+}
+
+func TestGenerator_LoadLocalizedNames_InvalidJSON(t *testing.T) {
+	generator, mockLoader, _, _ := NewTestGenerator()
+	mockLoader.LocalizedNameData = []byte("invalid json")
+
+	entries, err := generator.LoadLocalizedNames()
+
+	require.Error(t, err)
+	assert.Nil(t, entries)
+	assert.Contains(t, err.Error(), "failed to unmarshal localized name data")
+}
+
+func TestGenerator_LoadLocalizedNames_InvalidName(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+	}{
+		{name: "Missing language", data: `[{"names": {"TC": "Test"}}]`},
+		{name: "Uppercase language", data: `[{"language": "DE", "names": {"TC": "Test"}}]`},
+		{name: "Lowercase script", data: `[{"language": "zh-hant", "names": {"TC": "Test"}}]`},
+		{name: "Lowercase region", data: `[{"language": "pt-br", "names": {"TC": "Test"}}]`},
+		{name: "Locale", data: `[{"language": "de_DE", "names": {"TC": "Test"}}]`},
+		{name: "Duplicate language", data: `[{"language": "de", "names": {}}, {"language": "de", "names": {}}]`},
+		{name: "Lowercase code", data: `[{"language": "de", "names": {"tc": "Test"}}]`},
+		{name: "Blank name", data: `[{"language": "de", "names": {"TC": " "}}]`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			generator, mockLoader, _, _ := NewTestGenerator()
+			mockLoader.LocalizedNameData = []byte(tt.data)
+
+			entries, err := generator.LoadLocalizedNames()
+
+			require.Error(t, err)
+			require.ErrorIs(t, err, errInvalidLocalizedName)
+			assert.Nil(t, entries)
+		})
+	}
+}
+
+func TestGenerator_LoadNativeNames_Success(t *testing.T) {
+	generator, _, _, _ := NewTestGenerator()
+
+	entries, err := generator.LoadNativeNames()
+
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	assert.Equal(t, &nativeNameData{
+		CountryCode: "TC",
+		Names:       []NativeName{{Language: "fr", Name: "Pays Test"}, {Language: "de", Name: "Testland"}},
+	}, entries[0])
+}
+
+func TestGenerator_LoadNativeNames_DataLoaderError(t *testing.T) {
+	generator, mockLoader, _, _ := NewTestGenerator()
+	mockLoader.NativeNameError = errNativeNameError
+
+	entries, err := generator.LoadNativeNames()
+
+	require.Error(t, err)
+	assert.Nil(t, entries)
+	assert.Contains(t, err.Error(), "failed to load native name data")
+}
+
+func TestGenerator_LoadNativeNames_InvalidJSON(t *testing.T) {
+	generator, mockLoader, _, _ := NewTestGenerator()
+	mockLoader.NativeNameData = []byte("invalid json")
+
+	entries, err := generator.LoadNativeNames()
+
+	require.Error(t, err)
+	assert.Nil(t, entries)
+	assert.Contains(t, err.Error(), "failed to unmarshal native name data")
+}
+
+func TestGenerator_LoadNativeNames_InvalidName(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+	}{
+		{name: "Lowercase code", data: `[{"countryCode": "tc", "names": [{"language": "de", "name": "Test"}]}]`},
+		{name: "Missing language", data: `[{"countryCode": "TC", "names": [{"name": "Test"}]}]`},
+		{name: "Language tag", data: `[{"countryCode": "TC", "names": [{"language": "zh-Hant", "name": "Test"}]}]`},
+		{name: "Blank name", data: `[{"countryCode": "TC", "names": [{"language": "de", "name": ""}]}]`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			generator, mockLoader, _, _ := NewTestGenerator()
+			mockLoader.NativeNameData = []byte(tt.data)
+
+			entries, err := generator.LoadNativeNames()
+
+			require.Error(t, err)
+			require.ErrorIs(t, err, errInvalidLocalizedName)
+			assert.Nil(t, entries)
+		})
+	}
+}
+
+func TestGenerator_MergeNativeNames(t *testing.T) {
+	generator, _, _, _ := NewTestGenerator()
+
+	countries := CountryList{{Alpha2: "TC"}, {Alpha2: "AC"}}
+
+	generator.MergeNativeNames(countries, countryNativeNames{
+		{CountryCode: "TC", Names: []NativeName{{Language: "de", Name: "Testland"}}},
+		{CountryCode: "ZZ", Names: []NativeName{{Language: "en", Name: "Unknown"}}},
+	})
+
+	assert.Equal(t, []NativeName{{Language: "de", Name: "Testland"}}, countries[0].NativeNames)
+	assert.Empty(t, countries[1].NativeNames)
+}
+
+func TestGenerator_GenerateLocalizedNames(t *testing.T) {
+	generator, _, _, _ := NewTestGenerator()
+
+	countries := CountryList{{Alpha2: "TC"}, {Alpha2: "AC"}}
+
+	entries := generator.GenerateLocalizedNames(countries, localizedNameList{
+		{Language: "zh-Hant", Names: map[string]string{"TC": "測試國", "ZZ": "未知"}},
+		{Language: "de", Names: map[string]string{"TC": "Testland", "AC": "Anderes Land"}},
+	})
+
+	assert.Equal(t, []localizedEntry{
+		{Key: "de", Language: "de", Names: map[string]string{"AC": "Anderes Land", "TC": "Testland"}},
+		{Key: "zh-hant", Language: "zh-Hant", Names: map[string]string{"TC": "測試國"}},
+	}, entries)
+}
+
+func TestGenerator_LoadLanguages_Success(t *testing.T) {
+	generator, _, _, _ := NewTestGenerator()
+
+	languages, err := generator.LoadLanguages()
+
+	require.NoError(t, err)
+	require.Len(t, languages, 3)
+	assert.Equal(t, &Language{Alpha2: "en", Alpha3: "eng", Name: "English"}, languages[0])
+	assert.Equal(t, &Language{Alpha3: "fil", Name: "Filipino"}, languages[1])
+	assert.Equal(t, "spa", languages[2].Alpha3)
+}
+
+func TestGenerator_LoadLanguages_DataLoaderError(t *testing.T) {
+	generator, mockLoader, _, _ := NewTestGenerator()
+	mockLoader.LanguageError = errLanguageError
+
+	languages, err := generator.LoadLanguages()
+
+	require.Error(t, err)
+	assert.Nil(t, languages)
+	assert.Contains(t, err.Error(), "failed to load language data")
+}
+
+func TestGenerator_LoadLanguages_InvalidJSON(t *testing.T) {
+	generator, mockLoader, _, _ := NewTestGenerator()
+	mockLoader.LanguageData = []byte("invalid json")
+
+	languages, err := generator.LoadLanguages()
+
+	require.Error(t, err)
+	assert.Nil(t, languages)
+	assert.Contains(t, err.Error(), "failed to unmarshal language data")
+}
+
+func TestGenerator_LoadLanguages_InvalidCode(t *testing.T) {
+	tests := []struct {
+		name   string
+		alpha2 string
+		alpha3 string
+	}{
+		{name: "Missing alpha-3", alpha2: "en", alpha3: ""},
+		{name: "Uppercase alpha-3", alpha2: "en", alpha3: "ENG"},
+		{name: "Short alpha-3", alpha2: "en", alpha3: "en"},
+		{name: "Long alpha-2", alpha2: "eng", alpha3: "eng"},
+		{name: "Digits in alpha-2", alpha2: "e1", alpha3: "eng"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			generator, mockLoader, _, _ := NewTestGenerator()
+			mockLoader.LanguageData = []byte(`[{"alpha2": "` + tt.alpha2 + `", "alpha3": "` + tt.alpha3 + `"}]`)
+
+			languages, err := generator.LoadLanguages()
+
+			require.Error(t, err)
+			require.ErrorIs(t, err, errInvalidLanguageCode)
+			assert.Nil(t, languages)
+		})
+	}
+}
+
+func TestGenerator_LoadCountryLanguages_Success(t *testing.T) {
+	generator, _, _, _ := NewTestGenerator()
+
+	spoken, err := generator.LoadCountryLanguages()
+
+	require.NoError(t, err)
+	require.Len(t, spoken, 3)
+	assert.Equal(t, "TC", spoken[0].CountryCode)
+	require.Len(t, spoken[0].Languages, 2)
+	assert.Equal(t, &spokenLanguageData{Code: "en", Official: true}, spoken[0].Languages[0])
+	assert.Equal(t, &spokenLanguageData{Code: "es"}, spoken[0].Languages[1])
+}
+
+func TestGenerator_LoadCountryLanguages_DataLoaderError(t *testing.T) {
+	generator, mockLoader, _, _ := NewTestGenerator()
+	mockLoader.CountryLanguageError = errCountryLanguageError
+
+	spoken, err := generator.LoadCountryLanguages()
+
+	require.Error(t, err)
+	assert.Nil(t, spoken)
+	assert.Contains(t, err.Error(), "failed to load country language data")
+}
+
+func TestGenerator_LoadCountryLanguages_InvalidJSON(t *testing.T) {
+	generator, mockLoader, _, _ := NewTestGenerator()
+	mockLoader.CountryLanguageData = []byte("invalid json")
+
+	spoken, err := generator.LoadCountryLanguages()
+
+	require.Error(t, err)
+	assert.Nil(t, spoken)
+	assert.Contains(t, err.Error(), "failed to unmarshal country language data")
+}
+
+func TestGenerator_LoadCountryLanguages_InvalidCode(t *testing.T) {
+	for _, code := range []string{"", "e", "EN", "engl", "e-n"} {
+		t.Run(code, func(t *testing.T) {
+			generator, mockLoader, _, _ := NewTestGenerator()
+			mockLoader.CountryLanguageData = []byte(`[{"countryCode": "GB", "languages": [{"code": "` + code + `"}]}]`)
+
+			spoken, err := generator.LoadCountryLanguages()
+
+			require.Error(t, err)
+			require.ErrorIs(t, err, errInvalidLanguageCode)
+			assert.Nil(t, spoken)
+		})
+	}
+}
+
+func TestGenerator_MergeLanguages(t *testing.T) {
+	generator, _, _, _ := NewTestGenerator()
+
+	countries := CountryList{{Alpha2: "CA"}, {Alpha2: "PH"}, {Alpha2: "AQ"}}
+
+	generator.MergeLanguages(countries, countryLanguages{
+		{CountryCode: "CA", Languages: []*spokenLanguageData{{Code: "en", Official: true}, {Code: "fr", Official: true}}},
+		{CountryCode: "PH", Languages: []*spokenLanguageData{{Code: "fil", Official: true}, {Code: "es"}}},
+		{CountryCode: "PH", Languages: []*spokenLanguageData{{Code: "fil"}, {Code: "en", Official: true}}},
+		{CountryCode: "ZZ", Languages: []*spokenLanguageData{{Code: "en", Official: true}}},
+	})
+
+	assert.Equal(t, []CountryLanguage{{Code: "en", Official: true}, {Code: "fr", Official: true}}, countries[0].Languages)
+	assert.Equal(t, []CountryLanguage{
+		{Code: "fil", Official: true},
+		{Code: "es"},
+		{Code: "en", Official: true},
+	}, countries[1].Languages)
+	assert.Nil(t, countries[2].Languages)
+}
+
+func TestGenerator_GenerateLanguageMap(t *testing.T) {
+	generator, _, _, _ := NewTestGenerator()
+
+	languages := LanguageList{
+		{Alpha2: "en", Alpha3: "eng", Name: "English"},
+		{Alpha3: "fil", Name: "Filipino"},
+	}
+
+	entries := generator.GenerateLanguageMap(languages)
+
+	assert.Equal(t, []mapEntry{
+		{Key: "en", Index: 0},
+		{Key: "eng", Index: 0},
+		{Key: "fil", Index: 1},
+	}, entries)
+}
+
+func TestGenerator_GroupCountriesByLanguage(t *testing.T) {
+	generator, _, _, _ := NewTestGenerator()
+
+	countries := CountryList{
+		{Alpha2: "CA", Languages: []CountryLanguage{{Code: "en", Official: true}, {Code: "fr", Official: true}}},
+		{Alpha2: "AQ"},
+		{Alpha2: "FR", Languages: []CountryLanguage{{Code: "fr", Official: true}}},
+	}
+
+	groups := generator.GroupCountriesByLanguage(countries)
+
+	assert.Equal(t, []groupEntry{
+		{Key: "en", Indices: []int{0}},
+		{Key: "fr", Indices: []int{0, 2}},
+	}, groups)
+}
+
+func TestToASCII(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{name: "ASCII", input: "uk", expected: "uk"},
+		{name: "Cyrillic", input: "рф", expected: "xn--p1ai"},
+		{name: "Chinese", input: "中国", expected: "xn--fiqs8s"},
+		{name: "Arabic", input: "السعودية", expected: "xn--mgberp4a5d4ar"},
+		{name: "Tamil", input: "சிங்கப்பூர்", expected: "xn--clchc0ea0b2g2a9gcd"},
+		{name: "Mixed", input: "bücher", expected: "xn--bcher-kva"},
+		{name: "RFC 3492 sample", input: "他们为什么不说中文", expected: "xn--ihqwcrb4cv8a8dqg056pqjye"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, toASCII(tt.input))
+		})
+	}
+}
+
+func TestGenerator_MergeCallingCodes(t *testing.T) {
+	generator, _, _, _ := NewTestGenerator()
+
+	countries := CountryList{{Alpha2: "US"}, {Alpha2: "VA"}, {Alpha2: "AQ"}}
+
+	generator.MergeCallingCodes(countries, callingCodes{
+		{CountryCode: "US", CallingCode: "1", Main: true},
+		{CountryCode: "VA", CallingCode: "39", Prefixes: []string{"06698"}},
+		{CountryCode: "VA", CallingCode: "379"},
+		{CountryCode: "VA", CallingCode: "39"},
+		{CountryCode: "ZZ", CallingCode: "998"},
+	})
+
+	assert.Equal(t, []string{"+1"}, countries[0].CallingCodes)
+	assert.Equal(t, []string{"+39", "+379"}, countries[1].CallingCodes)
+	assert.Nil(t, countries[2].CallingCodes)
+}
+
+func TestGenerator_GenerateCallingCodeMap(t *testing.T) {
+	generator, _, _, _ := NewTestGenerator()
+
+	countries := CountryList{{Alpha2: "AS"}, {Alpha2: "US"}, {Alpha2: "DE"}, {Alpha2: "NZ"}, {Alpha2: "PN"}}
+	codes := callingCodes{
+		{CountryCode: "AS", CallingCode: "1", Prefixes: []string{"684"}},
+		{CountryCode: "DE", CallingCode: "49"},
+		{CountryCode: "NZ", CallingCode: "64"},
+		{CountryCode: "PN", CallingCode: "64"},
+		{CountryCode: "US", CallingCode: "1", Main: true},
+		{CountryCode: "ZZ", CallingCode: "998", Main: true},
+	}
+
+	entries := generator.GenerateCallingCodeMap(countries, codes)
+
+	// The main country wins, otherwise the first country listed
+	assert.Equal(t, []mapEntry{
+		{Key: "1", Index: 1},
+		{Key: "49", Index: 2},
+		{Key: "64", Index: 3},
+	}, entries)
+}
+
+func TestGenerator_GeneratePhonePrefixMap(t *testing.T) {
+	generator, _, _, _ := NewTestGenerator()
+
+	countries := CountryList{{Alpha2: "US"}, {Alpha2: "PR"}, {Alpha2: "AS"}}
+	codes := callingCodes{
+		{CountryCode: "AS", CallingCode: "1", Prefixes: []string{"684"}},
+		{CountryCode: "PR", CallingCode: "1", Prefixes: []string{"787", "939"}},
+		{CountryCode: "US", CallingCode: "1", Main: true},
+		{CountryCode: "ZZ", CallingCode: "1", Prefixes: []string{"999"}},
+	}
+
+	entries := generator.GeneratePhonePrefixMap(countries, codes)
+
+	assert.Equal(t, []mapEntry{
+		{Key: "1", Index: 0},
+		{Key: "1684", Index: 2},
+		{Key: "1787", Index: 1},
+		{Key: "1939", Index: 1},
+	}, entries)
+}
+
+func TestGenerator_GroupCountriesByCallingCode(t *testing.T) {
+	generator, _, _, _ := NewTestGenerator()
+
+	countries := CountryList{
+		{Alpha2: "RU", CallingCodes: []string{"+7"}},
+		{Alpha2: "AQ"},
+		{Alpha2: "US", CallingCodes: []string{"+1"}},
+		{Alpha2: "KZ", CallingCodes: []string{"+7"}},
+		{Alpha2: "VA", CallingCodes: []string{"+39", "+379"}},
+	}
+
+	groups := generator.GroupCountriesByCallingCode(countries)
+
+	require.Len(t, groups, 4)
+	assert.Equal(t, groupEntry{Key: "1", Indices: []int{2}}, groups[0])
+	assert.Equal(t, groupEntry{Key: "379", Indices: []int{4}}, groups[1])
+	assert.Equal(t, groupEntry{Key: "39", Indices: []int{4}}, groups[2])
+	assert.Equal(t, groupEntry{Key: "7", Indices: []int{0, 3}}, groups[3])
+}
+
+func TestGenerator_GenerateCapitalMap(t *testing.T) {
+	generator, mockLoader, mockWriter, mockTemplate := NewTestGenerator()
+	_ = mockLoader
+	_ = mockWriter
+	_ = mockTemplate
+
+	countries := CountryList{
+		{Capital: "Washington", Name: "USA"},
+		{Capital: "Ottawa", Name: "Canada"},
+		{Capital: "Berlin", Name: "Germany"},
+		{Capital: "Bogotá", Name: "Colombia"},
+		{Capital: "", Name: "Country without capital"},
+		{Capital: "Washington", Name: "Duplicate capital"},
+	}
+
+	capitals := generator.GenerateCapitalMap(countries)
+
+	assert.Equal(t, []mapEntry{
+		{Key: "berlin", Index: 2},
+		{Key: "bogota", Index: 3},
+		{Key: "ottawa", Index: 1},
+		{Key: "washington", Index: 0},
+	}, capitals)
+}
+
+func TestGenerator_GenerateSearchTerms(t *testing.T) {
+	generator, _, _, _ := NewTestGenerator()
+
+	countries := CountryList{
+		{Alpha2: "CI", Alpha3: "CIV", Capital: "Yamoussoukro", Name: "Côte d'Ivoire"},
+		{Alpha2: "SG", Alpha3: "SGP", Capital: "Singapore", Name: "Singapore"},
+		{Alpha2: "AQ", Alpha3: "ATA", Name: "Antarctica"},
+	}
+
+	terms := generator.GenerateSearchTerms(countries)
+
+	assert.Equal(t, []searchTermEntry{
+		{Field: "name", Index: 2, Term: "antarctica"},
+		{Field: "alpha2", Index: 2, Term: "aq"},
+		{Field: "alpha3", Index: 2, Term: "ata"},
+		{Field: "alpha2", Index: 0, Term: "ci"},
+		{Field: "alpha3", Index: 0, Term: "civ"},
+		{Field: "name", Index: 0, Term: "cote divoire"},
+		{Field: "alpha2", Index: 1, Term: "sg"},
+		{Field: "alpha3", Index: 1, Term: "sgp"},
+		{Field: "name", Index: 1, Term: "singapore"},
+		{Field: "capital", Index: 0, Term: "yamoussoukro"},
+	}, terms)
+}
+
+func TestGenerator_LoadISO4217Currencies_Success(t *testing.T) {
+	generator, _, _, _ := NewTestGenerator()
+
+	currencies, err := generator.LoadISO4217Currencies()
+
+	require.NoError(t, err)
+	require.Len(t, currencies, 2)
+	assert.Equal(t, "ANO", currencies[0].Code)
+	assert.Equal(t, -1, currencies[0].MinorUnits)
+	assert.Equal(t, "TST", currencies[1].Code)
+	assert.Equal(t, "999", currencies[1].NumericCode)
+	assert.Equal(t, "Test Dollar", currencies[1].Name)
+	assert.Equal(t, 2, currencies[1].MinorUnits)
+	assert.Equal(t, "T$", currencies[1].Symbol)
+	assert.Equal(t, "$", currencies[1].NarrowSymbol)
+}
+
+func TestGenerator_LoadISO4217Currencies_DataLoaderError(t *testing.T) {
+	generator, mockLoader, _, _ := NewTestGenerator()
+	mockLoader.ISO4217Error = errISO4217Error
+
+	currencies, err := generator.LoadISO4217Currencies()
+
+	require.Error(t, err)
+	assert.Nil(t, currencies)
+	assert.Contains(t, err.Error(), "failed to load ISO4217 data")
+}
+
+func TestGenerator_LoadISO4217Currencies_InvalidJSON(t *testing.T) {
+	generator, mockLoader, _, _ := NewTestGenerator()
+	mockLoader.ISO4217Data = []byte("invalid json")
+
+	currencies, err := generator.LoadISO4217Currencies()
+
+	require.Error(t, err)
+	assert.Nil(t, currencies)
+	assert.Contains(t, err.Error(), "failed to unmarshal ISO4217 data")
+}
+
+func TestGenerator_LoadISO4217Currencies_InvalidMinorUnits(t *testing.T) {
+	generator, mockLoader, _, _ := NewTestGenerator()
+	mockLoader.ISO4217Data = []byte(`[{"code": "BAD", "numericCode": "000", "minorUnits": "two"}]`)
+
+	currencies, err := generator.LoadISO4217Currencies()
+
+	require.Error(t, err)
+	require.ErrorIs(t, err, errInvalidMinorUnits)
+	assert.Nil(t, currencies)
+}
+
+func TestGenerator_GroupCountriesByCurrency(t *testing.T) {
+	generator, _, _, _ := NewTestGenerator()
+
+	countries := CountryList{
+		{Alpha2: "DE", Currencies: []CountryCurrency{{Code: "EUR", Primary: true}}},
+		{Alpha2: "US", Currencies: []CountryCurrency{{Code: "USD", Primary: true}, {Code: "USN"}}},
+		{Alpha2: "AQ"},
+		{Alpha2: "FR", Currencies: []CountryCurrency{{Code: "EUR", Primary: true}}},
+		{Alpha2: "PA", Currencies: []CountryCurrency{{Code: "PAB", Primary: true}, {Code: "USD"}}},
+	}
+
+	groups := generator.GroupCountriesByCurrency(countries)
+
+	require.Len(t, groups, 4)
+	assert.Equal(t, groupEntry{Key: "EUR", Indices: []int{0, 3}}, groups[0])
+	assert.Equal(t, groupEntry{Key: "PAB", Indices: []int{4}}, groups[1])
+	assert.Equal(t, groupEntry{Key: "USD", Indices: []int{1, 4}}, groups[2])
+	assert.Equal(t, groupEntry{Key: "USN", Indices: []int{1}}, groups[3])
+}
+
+func TestGenerator_LoadSubdivisions_Success(t *testing.T) {
+	generator, _, _, _ := NewTestGenerator()
+
+	subdivisions, err := generator.LoadSubdivisions()
+
+	require.NoError(t, err)
+	require.Len(t, subdivisions, 3)
+	assert.Equal(t, "AC-X", subdivisions[0].Code)
+	assert.Equal(t, "AC", subdivisions[0].CountryAlpha2)
+	assert.Equal(t, "state", subdivisions[0].Category)
+	assert.Equal(t, "TC-01", subdivisions[1].Code)
+	assert.Empty(t, subdivisions[1].ParentCode)
+	assert.Equal(t, "TC-02", subdivisions[2].Code)
+	assert.Equal(t, "province", subdivisions[2].Category)
+	assert.Equal(t, "TC-01", subdivisions[2].ParentCode)
+}
+
+func TestGenerator_LoadSubdivisions_DataLoaderError(t *testing.T) {
+	generator, mockLoader, _, _ := NewTestGenerator()
+	mockLoader.SubdivisionError = errSubdivisionError
+
+	subdivisions, err := generator.LoadSubdivisions()
+
+	require.Error(t, err)
+	assert.Nil(t, subdivisions)
+	assert.Contains(t, err.Error(), "failed to load subdivision data")
+}
+
+func TestGenerator_LoadSubdivisions_InvalidJSON(t *testing.T) {
+	generator, mockLoader, _, _ := NewTestGenerator()
+	mockLoader.SubdivisionData = []byte("invalid json")
+
+	subdivisions, err := generator.LoadSubdivisions()
+
+	require.Error(t, err)
+	assert.Nil(t, subdivisions)
+	assert.Contains(t, err.Error(), "failed to unmarshal subdivision data")
+}
+
+func TestGenerator_LoadSubdivisions_InvalidCode(t *testing.T) {
+	generator, mockLoader, _, _ := NewTestGenerator()
+	mockLoader.SubdivisionData = []byte(`[{"code": "NOPREFIX", "name": "Broken", "type": "State"}]`)
+
+	subdivisions, err := generator.LoadSubdivisions()
+
+	require.Error(t, err)
+	require.ErrorIs(t, err, errInvalidSubdivisionCode)
+	assert.Nil(t, subdivisions)
+}
+
+func TestGenerator_LoadSubdivisions_BracketedNotes(t *testing.T) {
+	generator, mockLoader, _, _ := NewTestGenerator()
+	mockLoader.SubdivisionData = []byte(`[
+		{"code": "GB-AGY", "name": "Isle of Anglesey [Sir Ynys Môn GB-YNM]", "type": "Unitary authority"},
+		{"code": "SE-M", "name": "Skåne län [SE-12]", "type": "County"},
+		{"code": "US-CA", "name": "California", "type": "State"},
+		{"code": "XX-01", "name": "[Unnamed]", "type": "State"}
+	]`)
+
+	subdivisions, err := generator.LoadSubdivisions()
 
-	currencies, err := generator.LoadCurrencies()
 	require.NoError(t, err)
+	require.Len(t, subdivisions, 4)
+	assert.Equal(t, "Isle of Anglesey", subdivisions[0].Name)
+	assert.Equal(t, "Skåne län", subdivisions[1].Name)
+	assert.Equal(t, "California", subdivisions[2].Name)
+	assert.Equal(t, "[Unnamed]", subdivisions[3].Name)
+}
 
-	generator.MergeData(countries, currencies)
-	assert.Equal(t, "Test Capital", countries[0].Capital)
-	assert.Equal(t, "Test Continent", countries[0].ContinentName)
-	assert.Equal(t, "TST", countries[0].CurrencyCode)
-	assert.Equal(t, "Another Capital", countries[1].Capital)
-	assert.Equal(t, "Another Continent", countries[1].ContinentName)
-	assert.Equal(t, "ANO", countries[1].CurrencyCode)
+func TestGenerator_GroupSubdivisions(t *testing.T) {
+	generator, _, _, _ := NewTestGenerator()
+
+	subdivisions := SubdivisionList{
+		{Code: "CA-ON", CountryAlpha2: "CA"},
+		{Code: "US-CA", CountryAlpha2: "US"},
+		{Code: "US-NY", CountryAlpha2: "US"},
+		{Code: "AE-DU", CountryAlpha2: "AE"},
+	}
+
+	groups := generator.GroupSubdivisions(subdivisions)
+
+	require.Len(t, groups, 3)
+	assert.Equal(t, groupEntry{Key: "AE", Indices: []int{3}}, groups[0])
+	assert.Equal(t, groupEntry{Key: "CA", Indices: []int{0}}, groups[1])
+	assert.Equal(t, groupEntry{Key: "US", Indices: []int{1, 2}}, groups[2])
 }
 
-func TestGenerator_GenerateCapitalMap(t *testing.T) {
-	generator, mockLoader, mockWriter, mockTemplate := NewTestGenerator()
-	_ = mockLoader
-	_ = mockWriter
-	_ = mockTemplate
+func TestGenerator_GenerateSubdivisionNameMap(t *testing.T) {
+	generator, _, _, _ := NewTestGenerator()
 
-	countries := CountryList{
-		{Capital: "Washington", Name: "USA"},
-		{Capital: "Ottawa", Name: "Canada"},
-		{Capital: "Berlin", Name: "Germany"},
-		{Capital: "", Name: "Country without capital"},
-		{Capital: "Washington", Name: "Duplicate capital"},
+	subdivisions := SubdivisionList{
+		{Code: "US-CA", CountryAlpha2: "US", Name: "California"},
+		{Code: "MX-BCN", CountryAlpha2: "MX", Name: "Baja California"},
+		{Code: "XX-01", CountryAlpha2: "XX", Name: "Duplicate"},
+		{Code: "XX-02", CountryAlpha2: "XX", Name: "duplicate"},
 	}
 
-	capitals := generator.GenerateCapitalMap(countries)
+	names := generator.GenerateSubdivisionNameMap(subdivisions)
 
-	assert.Len(t, capitals, 3)
-	assert.Equal(t, "berlin", capitals[0].Key)
-	assert.Equal(t, 2, capitals[0].Index)
-	assert.Equal(t, "ottawa", capitals[1].Key)
-	assert.Equal(t, 1, capitals[1].Index)
-	assert.Equal(t, "washington", capitals[2].Key)
-	assert.Equal(t, 0, capitals[2].Index)
+	require.Len(t, names, 3)
+	assert.Equal(t, mapEntry{Key: "MX:baja california", Index: 1}, names[0])
+	assert.Equal(t, mapEntry{Key: "US:california", Index: 0}, names[1])
+	assert.Equal(t, mapEntry{Key: "XX:duplicate", Index: 2}, names[2])
 }
 
 func TestGenerator_GenerateCode_Success(t *testing.T) {
@@ -162,12 +1583,14 @@ func TestGenerator_GenerateCode_Success(t *testing.T) {
 	_ = mockWriter
 	_ = mockTemplate
 
-	countries := CountryList{
-		{Name: "Test Country", Alpha2: "TC"},
+	data := &PackageData{
+		Countries: CountryList{
+			{Name: "Test Country", Alpha2: "TC"},
+		},
+		Capitals: []mapEntry{{Key: "test capital", Index: 0}},
 	}
-	capitals := []mapEntry{{Key: "test capital", Index: 0}}
 
-	code, err := generator.GenerateCode(countries, capitals)
+	code, err := generator.GenerateCode(data)
 
 	require.NoError(t, err)
 	assert.Contains(t, string(code), "package countries")
@@ -179,16 +1602,67 @@ func TestGenerator_GenerateCode_TemplateError(t *testing.T) {
 	generator, _, _, mockTemplate := NewTestGenerator()
 	mockTemplate.Error = errTemplateError
 
-	countries := CountryList{}
-	capitals := []mapEntry{}
-
-	code, err := generator.GenerateCode(countries, capitals)
+	code, err := generator.GenerateCode(&PackageData{})
 
 	require.Error(t, err)
 	assert.Nil(t, code)
 	assert.Contains(t, err.Error(), "failed to get template")
 }
 
+func TestGenerator_GeneratePhoneCode_Success(t *testing.T) {
+	generator, _, _, mockTemplate := NewTestGenerator()
+	phoneTemplate, err := (&DefaultTemplateProvider{}).GetPhoneTemplate()
+	require.NoError(t, err)
+	mockTemplate.PhoneTemplate = phoneTemplate
+
+	code, err := generator.GeneratePhoneCode(PhoneRuleList{
+		{CountryAlpha2: "TC", Lengths: []int{8, 9}, NationalPrefix: "0", Pattern: `[2-9]\d{7,8}`},
+	})
+
+	require.NoError(t, err)
+	assert.Contains(t, string(code), "package phone")
+	assert.Contains(t, string(code), `"TC": {lengths: []int{8, 9}, nationalPrefix: "0", pattern: "[2-9]\\d{7,8}"},`)
+}
+
+func TestGenerator_GeneratePhoneCode_TemplateError(t *testing.T) {
+	generator, _, _, mockTemplate := NewTestGenerator()
+	mockTemplate.PhoneError = errTemplateError
+
+	code, err := generator.GeneratePhoneCode(PhoneRuleList{})
+
+	require.Error(t, err)
+	assert.Nil(t, code)
+	assert.Contains(t, err.Error(), "failed to get phone template")
+}
+
+func TestGenerator_GenerateGeoCode_Success(t *testing.T) {
+	generator, _, _, mockTemplate := NewTestGenerator()
+	geoTemplate, err := (&DefaultTemplateProvider{}).GetGeoTemplate()
+	require.NoError(t, err)
+	mockTemplate.GeoTemplate = geoTemplate
+
+	code, err := generator.GenerateGeoCode(BoundaryList{
+		{CountryAlpha2: "AC", Polygons: [][]string{{"ne}CnezGozD??ozDnzD?", "~f{C~fxGo}@??o}@n}@?"}}},
+		{CountryAlpha2: "TC", Polygons: [][]string{{`_dyKn_gk@_pR??oh\~oR?`}}},
+	})
+
+	require.NoError(t, err)
+	assert.Contains(t, string(code), "//go:build !countries_nogeo\n\npackage geo")
+	assert.Contains(t, string(code), `{alpha2: "AC", polygons: [][]string{{"ne}CnezGozD??ozDnzD?", "~f{C~fxGo}@??o}@n}@?"}}},`)
+	assert.Contains(t, string(code), `{alpha2: "TC", polygons: [][]string{{"_dyKn_gk@_pR??oh\\~oR?"}}},`)
+}
+
+func TestGenerator_GenerateGeoCode_TemplateError(t *testing.T) {
+	generator, _, _, mockTemplate := NewTestGenerator()
+	mockTemplate.GeoError = errTemplateError
+
+	code, err := generator.GenerateGeoCode(BoundaryList{})
+
+	require.Error(t, err)
+	assert.Nil(t, code)
+	assert.Contains(t, err.Error(), "failed to get geo template")
+}
+
 func TestGenerator_WriteOutput_Success(t *testing.T) {
 	generator, _, mockWriter, _ := NewTestGenerator()
 	testCode := []byte("package test\nvar x = 1")
@@ -211,6 +1685,26 @@ func TestGenerator_WriteOutput_CreateError(t *testing.T) {
 	assert.Contains(t, err.Error(), "failed to create output file")
 }
 
+func TestGenerator_WritePhoneOutput_Success(t *testing.T) {
+	generator, _, mockWriter, _ := NewTestGenerator()
+	testCode := []byte("package phone\nvar x = 1")
+
+	err := generator.WritePhoneOutput(testCode)
+
+	require.NoError(t, err)
+	assert.Equal(t, testCode, mockWriter.CreatedFiles["test_phone_output.go"].Bytes())
+}
+
+func TestGenerator_WriteGeoOutput_Success(t *testing.T) {
+	generator, _, mockWriter, _ := NewTestGenerator()
+	testCode := []byte("package geo\nvar x = 1")
+
+	err := generator.WriteGeoOutput(testCode)
+
+	require.NoError(t, err)
+	assert.Equal(t, testCode, mockWriter.CreatedFiles["test_geo_output.go"].Bytes())
+}
+
 func TestGenerator_Generate_EndToEnd(t *testing.T) {
 	generator, _, mockWriter, _ := NewTestGenerator()
 
@@ -223,6 +1717,14 @@ func TestGenerator_Generate_EndToEnd(t *testing.T) {
 	assert.Contains(t, output, "package countries")
 	assert.Contains(t, output, "Test Country")
 	assert.Contains(t, output, "Another Country")
+
+	phoneOutput := mockWriter.CreatedFiles["test_phone_output.go"].String()
+	assert.Contains(t, phoneOutput, "package phone")
+	assert.Contains(t, phoneOutput, `"TC": {pattern:`)
+
+	geoOutput := mockWriter.CreatedFiles["test_geo_output.go"].String()
+	assert.Contains(t, geoOutput, "package geo")
+	assert.Contains(t, geoOutput, `{alpha2: "AC"},`)
 }
 
 func TestGenerator_Generate_LoadCountriesError(t *testing.T) {
@@ -245,6 +1747,176 @@ func TestGenerator_Generate_LoadCurrenciesError(t *testing.T) {
 	assert.Contains(t, err.Error(), "failed to load currencies")
 }
 
+func TestGenerator_Generate_LoadAdditionalCurrenciesError(t *testing.T) {
+	generator, mockLoader, _, _ := NewTestGenerator()
+	mockLoader.AdditionalCurrencyError = errAdditionalCurrencyError
+
+	err := generator.Generate()
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to load additional currencies")
+}
+
+func TestGenerator_Generate_LoadISO4217Error(t *testing.T) {
+	generator, mockLoader, _, _ := NewTestGenerator()
+	mockLoader.ISO4217Error = errISO4217Error
+
+	err := generator.Generate()
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to load ISO 4217 currencies")
+}
+
+func TestGenerator_Generate_LoadSubdivisionsError(t *testing.T) {
+	generator, mockLoader, _, _ := NewTestGenerator()
+	mockLoader.SubdivisionError = errSubdivisionError
+
+	err := generator.Generate()
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to load subdivisions")
+}
+
+func TestGenerator_Generate_LoadCallingCodesError(t *testing.T) {
+	generator, mockLoader, _, _ := NewTestGenerator()
+	mockLoader.CallingCodeError = errCallingCodeError
+
+	err := generator.Generate()
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to load calling codes")
+}
+
+func TestGenerator_Generate_LoadPhoneRulesError(t *testing.T) {
+	generator, mockLoader, _, _ := NewTestGenerator()
+	mockLoader.PhoneNumberingError = errPhoneNumberingError
+
+	err := generator.Generate()
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to load phone rules")
+}
+
+func TestGenerator_Generate_PhoneTemplateError(t *testing.T) {
+	generator, _, _, mockTemplate := NewTestGenerator()
+	mockTemplate.PhoneError = errTemplateError
+
+	err := generator.Generate()
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to generate phone code")
+}
+
+func TestGenerator_Generate_LoadBoundariesError(t *testing.T) {
+	generator, mockLoader, _, _ := NewTestGenerator()
+	mockLoader.BoundaryError = errBoundaryError
+
+	err := generator.Generate()
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to load boundaries")
+}
+
+func TestGenerator_Generate_GeoTemplateError(t *testing.T) {
+	generator, _, _, mockTemplate := NewTestGenerator()
+	mockTemplate.GeoError = errTemplateError
+
+	err := generator.Generate()
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to generate geo code")
+}
+
+func TestGenerator_Generate_LoadTLDsError(t *testing.T) {
+	generator, mockLoader, _, _ := NewTestGenerator()
+	mockLoader.TLDError = errTLDError
+
+	err := generator.Generate()
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to load top-level domains")
+}
+
+func TestGenerator_Generate_LoadCountryLanguagesError(t *testing.T) {
+	generator, mockLoader, _, _ := NewTestGenerator()
+	mockLoader.CountryLanguageError = errCountryLanguageError
+
+	err := generator.Generate()
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to load country languages")
+}
+
+func TestGenerator_Generate_LoadTimeZonesError(t *testing.T) {
+	generator, mockLoader, _, _ := NewTestGenerator()
+	mockLoader.TimeZoneError = errTimeZoneError
+
+	err := generator.Generate()
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to load time zones")
+}
+
+func TestGenerator_Generate_LoadCoordinatesError(t *testing.T) {
+	generator, mockLoader, _, _ := NewTestGenerator()
+	mockLoader.CoordinatesError = errCoordinatesError
+
+	err := generator.Generate()
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to load coordinates")
+}
+
+func TestGenerator_Generate_LoadBordersError(t *testing.T) {
+	generator, mockLoader, _, _ := NewTestGenerator()
+	mockLoader.BorderError = errBorderError
+
+	err := generator.Generate()
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to load borders")
+}
+
+func TestGenerator_Generate_LoadCountryNamesError(t *testing.T) {
+	generator, mockLoader, _, _ := NewTestGenerator()
+	mockLoader.CountryNameError = errCountryNameError
+
+	err := generator.Generate()
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to load country names")
+}
+
+func TestGenerator_Generate_LoadNativeNamesError(t *testing.T) {
+	generator, mockLoader, _, _ := NewTestGenerator()
+	mockLoader.NativeNameError = errNativeNameError
+
+	err := generator.Generate()
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to load native names")
+}
+
+func TestGenerator_Generate_LoadLocalizedNamesError(t *testing.T) {
+	generator, mockLoader, _, _ := NewTestGenerator()
+	mockLoader.LocalizedNameError = errLocalizedNameError
+
+	err := generator.Generate()
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to load localized names")
+}
+
+func TestGenerator_Generate_LoadLanguagesError(t *testing.T) {
+	generator, mockLoader, _, _ := NewTestGenerator()
+	mockLoader.LanguageError = errLanguageError
+
+	err := generator.Generate()
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to load languages")
+}
+
 // Integration tests with real implementations
 func TestEmbeddedDataLoader_Integration(t *testing.T) {
 	loader := &EmbeddedDataLoader{}
@@ -258,6 +1930,81 @@ func TestEmbeddedDataLoader_Integration(t *testing.T) {
 	require.NoError(t, err)
 	assert.NotEmpty(t, currencyData)
 	assert.Contains(t, string(currencyData), "USD")
+
+	additionalData, err := loader.LoadAdditionalCurrencyData()
+	require.NoError(t, err)
+	assert.NotEmpty(t, additionalData)
+	assert.Contains(t, string(additionalData), `"countryCode":"PA"`)
+
+	iso4217Data, err := loader.LoadISO4217Data()
+	require.NoError(t, err)
+	assert.NotEmpty(t, iso4217Data)
+	assert.Contains(t, string(iso4217Data), "EUR")
+
+	subdivisionData, err := loader.LoadSubdivisionData()
+	require.NoError(t, err)
+	assert.NotEmpty(t, subdivisionData)
+	assert.Contains(t, string(subdivisionData), "US-CA")
+
+	callingCodeData, err := loader.LoadCallingCodeData()
+	require.NoError(t, err)
+	assert.NotEmpty(t, callingCodeData)
+	assert.Contains(t, string(callingCodeData), `"countryCode":"AS","callingCode":"1"`)
+
+	phoneNumberingData, err := loader.LoadPhoneNumberingData()
+	require.NoError(t, err)
+	assert.NotEmpty(t, phoneNumberingData)
+	assert.Contains(t, string(phoneNumberingData), `"countryCode":"GB","nationalPrefix":"0"`)
+
+	tldData, err := loader.LoadTLDData()
+	require.NoError(t, err)
+	assert.NotEmpty(t, tldData)
+	assert.Contains(t, string(tldData), `{"countryCode":"GB","tlds":[".uk"]}`)
+
+	languageData, err := loader.LoadLanguageData()
+	require.NoError(t, err)
+	assert.NotEmpty(t, languageData)
+	assert.Contains(t, string(languageData), `{"alpha2":"pt","alpha3":"por","name":"Portuguese"}`)
+
+	countryLanguageData, err := loader.LoadCountryLanguageData()
+	require.NoError(t, err)
+	assert.NotEmpty(t, countryLanguageData)
+	assert.Contains(t, string(countryLanguageData), `{"countryCode":"BR","languages":[{"code":"pt","official":true}`)
+
+	timeZoneData, err := loader.LoadTimeZoneData()
+	require.NoError(t, err)
+	assert.NotEmpty(t, timeZoneData)
+	assert.Contains(t, string(timeZoneData), `{"countryCode":"DE","timeZones":["Europe/Berlin","Europe/Busingen"]}`)
+
+	coordinatesData, err := loader.LoadCoordinatesData()
+	require.NoError(t, err)
+	assert.NotEmpty(t, coordinatesData)
+	assert.Contains(t, string(coordinatesData), `{"countryCode":"DE","centroid":{"latitude":50.9617,"longitude":9.6783}`)
+
+	borderData, err := loader.LoadBorderData()
+	require.NoError(t, err)
+	assert.NotEmpty(t, borderData)
+	assert.Contains(t, string(borderData), `{"countryCode":"LU","borders":["BE","DE","FR"],"landlocked":true}`)
+
+	countryNameData, err := loader.LoadCountryNameData()
+	require.NoError(t, err)
+	assert.NotEmpty(t, countryNameData)
+	assert.Contains(t, string(countryNameData), `{"countryCode":"CZ","commonName":"Czechia","officialName":"Czech Republic"`)
+
+	localizedNameData, err := loader.LoadLocalizedNameData()
+	require.NoError(t, err)
+	assert.NotEmpty(t, localizedNameData)
+	assert.Contains(t, string(localizedNameData), `"DE":"Deutschland"`)
+
+	nativeNameData, err := loader.LoadNativeNameData()
+	require.NoError(t, err)
+	assert.NotEmpty(t, nativeNameData)
+	assert.Contains(t, string(nativeNameData), `{"countryCode":"NO","names":[{"language":"nb","name":"Norge"}`)
+
+	boundaryData, err := loader.LoadBoundaryData()
+	require.NoError(t, err)
+	assert.NotEmpty(t, boundaryData)
+	assert.Contains(t, string(boundaryData), `{"countryCode":"SM","polygons":[["qgxYkwqFgSjZ}MiEkTau@jOkErWxGp`)
 }
 
 func TestOSFileWriter_Integration(t *testing.T) {
@@ -292,6 +2039,31 @@ func TestDefaultTemplateProvider_Integration(t *testing.T) {
 	assert.Contains(t, template, "package countries")
 	assert.Contains(t, template, "var (")
 	assert.Contains(t, template, "countries = []*Country{")
+	assert.Contains(t, template, "currencies = []*Currency{")
+	assert.Contains(t, template, "subdivisions = []*Subdivision{")
+	assert.Contains(t, template, "byPhonePrefix = map[string]*Country{")
+	assert.Contains(t, template, "byTLD = map[string]*Country{")
+	assert.Contains(t, template, "countriesByRegionCode = map[string][]*Country{")
+	assert.Contains(t, template, "byTimeZone = map[string]*Country{")
+	assert.Contains(t, template, "Centroid:               Coordinates{")
+	assert.Contains(t, template, "languages = []*Language{")
+	assert.Contains(t, template, "countriesByLanguage = map[string][]*Country{")
+	assert.Contains(t, template, "range $_, $pair := .Names")
+	assert.Contains(t, template, "searchTerms = []searchTerm{")
+	assert.Contains(t, template, "neighbors = map[string][]*Country{")
+	assert.Contains(t, template, "landlocked = map[string]bool{")
+	assert.Contains(t, template, "localizedNames = map[string]map[string]string{")
+	assert.Contains(t, template, "NativeNames:            []NativeName{")
+
+	phoneTemplate, err := provider.GetPhoneTemplate()
+	require.NoError(t, err)
+	assert.Contains(t, phoneTemplate, "package phone")
+	assert.Contains(t, phoneTemplate, "var rules = map[string]*rule{")
+
+	geoTemplate, err := provider.GetGeoTemplate()
+	require.NoError(t, err)
+	assert.Contains(t, geoTemplate, "//go:build !countries_nogeo")
+	assert.Contains(t, geoTemplate, "var boundaries = []boundary{")
 }
 
 // Benchmark tests
@@ -333,19 +2105,85 @@ func TestGeneratorWithFileData(t *testing.T) {
 	currencyData, err := os.ReadFile("testdata/test_currencies.json")
 	require.NoError(t, err)
 
+	additionalData, err := os.ReadFile("testdata/test_currencies_additional.json")
+	require.NoError(t, err)
+
+	iso4217Data, err := os.ReadFile("testdata/test_iso4217.json")
+	require.NoError(t, err)
+
+	subdivisionData, err := os.ReadFile("testdata/test_subdivisions.json")
+	require.NoError(t, err)
+
+	callingCodeData, err := os.ReadFile("testdata/test_calling_codes.json")
+	require.NoError(t, err)
+
+	phoneNumberingData, err := os.ReadFile("testdata/test_phone_numbering.json")
+	require.NoError(t, err)
+
+	tldData, err := os.ReadFile("testdata/test_tlds.json")
+	require.NoError(t, err)
+
+	languageData, err := os.ReadFile("testdata/test_languages.json")
+	require.NoError(t, err)
+
+	countryLanguageData, err := os.ReadFile("testdata/test_country_languages.json")
+	require.NoError(t, err)
+
+	timeZoneData, err := os.ReadFile("testdata/test_time_zones.json")
+	require.NoError(t, err)
+
+	coordinatesData, err := os.ReadFile("testdata/test_coordinates.json")
+	require.NoError(t, err)
+
+	boundaryData, err := os.ReadFile("testdata/test_boundaries.json")
+	require.NoError(t, err)
+
+	borderData, err := os.ReadFile("testdata/test_borders.json")
+	require.NoError(t, err)
+
+	countryNameData, err := os.ReadFile("testdata/test_country_names.json")
+	require.NoError(t, err)
+
+	localizedNameData, err := os.ReadFile("testdata/test_localized_names.json")
+	require.NoError(t, err)
+
+	nativeNameData, err := os.ReadFile("testdata/test_native_names.json")
+	require.NoError(t, err)
+
 	mockLoader := &MockDataLoader{
-		ISO3166Data:  countryData,
-		CurrencyData: currencyData,
+		ISO3166Data:            countryData,
+		CurrencyData:           currencyData,
+		AdditionalCurrencyData: additionalData,
+		ISO4217Data:            iso4217Data,
+		SubdivisionData:        subdivisionData,
+		CallingCodeData:        callingCodeData,
+		PhoneNumberingData:     phoneNumberingData,
+		TLDData:                tldData,
+		LanguageData:           languageData,
+		CountryLanguageData:    countryLanguageData,
+		TimeZoneData:           timeZoneData,
+		CoordinatesData:        coordinatesData,
+		BoundaryData:           boundaryData,
+		BorderData:             borderData,
+		CountryNameData:        countryNameData,
+		LocalizedNameData:      localizedNameData,
+		NativeNameData:         nativeNameData,
 	}
 
 	mockWriter := NewMockFileWriter()
-	mockTemplate := &MockTemplateProvider{Template: (&TestDataProvider{}).GetSimpleTemplate()}
+	mockTemplate := &MockTemplateProvider{
+		Template:      (&TestDataProvider{}).GetSimpleTemplate(),
+		PhoneTemplate: (&TestDataProvider{}).GetSimplePhoneTemplate(),
+		GeoTemplate:   (&TestDataProvider{}).GetSimpleGeoTemplate(),
+	}
 
 	config := GeneratorConfig{
 		DataLoader:       mockLoader,
 		FileWriter:       mockWriter,
 		TemplateProvider: mockTemplate,
 		OutputPath:       "file_test_output.go",
+		PhoneOutputPath:  "file_test_phone_output.go",
+		GeoOutputPath:    "file_test_geo_output.go",
 		RepoURL:          "https://github.com/test/file-repo",
 	}
 
@@ -358,4 +2196,12 @@ func TestGeneratorWithFileData(t *testing.T) {
 	assert.Contains(t, output, "United States of America")
 	assert.Contains(t, output, "Canada")
 	assert.Contains(t, output, "Germany")
+
+	phoneOutput := mockWriter.CreatedFiles["file_test_phone_output.go"].String()
+	assert.Contains(t, phoneOutput, `"CA": {pattern:`)
+	assert.Contains(t, phoneOutput, `"DE": {pattern:`)
+
+	geoOutput := mockWriter.CreatedFiles["file_test_geo_output.go"].String()
+	assert.Contains(t, geoOutput, `{alpha2: "CA"},`)
+	assert.Contains(t, geoOutput, `{alpha2: "US"},`)
 }
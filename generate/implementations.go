@@ -20,6 +20,81 @@ func (e *EmbeddedDataLoader) LoadCurrencyData() ([]byte, error) {
 	return []byte(data.CountryCurrencyJSONData), nil
 }
 
+// LoadAdditionalCurrencyData returns the embedded additional (non-primary) currency data
+func (e *EmbeddedDataLoader) LoadAdditionalCurrencyData() ([]byte, error) {
+	return []byte(data.AdditionalCurrencyJSONData), nil
+}
+
+// LoadISO4217Data returns the embedded ISO 4217 currency data
+func (e *EmbeddedDataLoader) LoadISO4217Data() ([]byte, error) {
+	return []byte(data.ISO4217JSONData), nil
+}
+
+// LoadSubdivisionData returns the embedded ISO 3166-2 subdivision data
+func (e *EmbeddedDataLoader) LoadSubdivisionData() ([]byte, error) {
+	return []byte(data.ISO31662JSONData), nil
+}
+
+// LoadCallingCodeData returns the embedded international calling code data
+func (e *EmbeddedDataLoader) LoadCallingCodeData() ([]byte, error) {
+	return []byte(data.CallingCodeJSONData), nil
+}
+
+// LoadPhoneNumberingData returns the embedded national phone numbering data
+func (e *EmbeddedDataLoader) LoadPhoneNumberingData() ([]byte, error) {
+	return []byte(data.PhoneNumberingJSONData), nil
+}
+
+// LoadTLDData returns the embedded country-code top-level domain data
+func (e *EmbeddedDataLoader) LoadTLDData() ([]byte, error) {
+	return []byte(data.TLDJSONData), nil
+}
+
+// LoadLanguageData returns the embedded ISO 639 language data
+func (e *EmbeddedDataLoader) LoadLanguageData() ([]byte, error) {
+	return []byte(data.LanguageJSONData), nil
+}
+
+// LoadCountryLanguageData returns the embedded official and widely spoken language data of every country
+func (e *EmbeddedDataLoader) LoadCountryLanguageData() ([]byte, error) {
+	return []byte(data.CountryLanguageJSONData), nil
+}
+
+// LoadTimeZoneData returns the embedded IANA time zone data
+func (e *EmbeddedDataLoader) LoadTimeZoneData() ([]byte, error) {
+	return []byte(data.TimeZoneJSONData), nil
+}
+
+// LoadCoordinatesData returns the embedded geographic coordinate data
+func (e *EmbeddedDataLoader) LoadCoordinatesData() ([]byte, error) {
+	return []byte(data.CoordinatesJSONData), nil
+}
+
+// LoadBoundaryData returns the embedded simplified land boundary data
+func (e *EmbeddedDataLoader) LoadBoundaryData() ([]byte, error) {
+	return []byte(data.BoundaryJSONData), nil
+}
+
+// LoadBorderData returns the embedded land border data
+func (e *EmbeddedDataLoader) LoadBorderData() ([]byte, error) {
+	return []byte(data.BorderJSONData), nil
+}
+
+// LoadCountryNameData returns the embedded common names, official names and aliases of the countries
+func (e *EmbeddedDataLoader) LoadCountryNameData() ([]byte, error) {
+	return []byte(data.CountryNameJSONData), nil
+}
+
+// LoadLocalizedNameData returns the embedded names of the countries in every supported language
+func (e *EmbeddedDataLoader) LoadLocalizedNameData() ([]byte, error) {
+	return []byte(data.LocalizedNameJSONData), nil
+}
+
+// LoadNativeNameData returns the embedded native names of the countries
+func (e *EmbeddedDataLoader) LoadNativeNameData() ([]byte, error) {
+	return []byte(data.NativeNameJSONData), nil
+}
+
 // OSFileWriter provides file operations using the OS filesystem
 type OSFileWriter struct{}
 
@@ -42,27 +117,73 @@ var (
 	countries = []*Country{
 	{{- range .Countries }}
 		{
+			{{- if .Aliases }}
+			Aliases:                []string{ {{- range .Aliases }}{{ printf "%q" . }}, {{ end -}} },
+			{{- end }}
 			Alpha2:                 {{ printf "%q" .Alpha2 }},
 			Alpha3:                 {{ printf "%q" .Alpha3 }},
+			{{- if not .BoundingBox.IsZero }}
+			BoundingBox:            BoundingBox{East: {{ .BoundingBox.East }}, North: {{ .BoundingBox.North }}, South: {{ .BoundingBox.South }}, West: {{ .BoundingBox.West }}},
+			{{- end }}
+			{{- if .CallingCodes }}
+			CallingCodes:           []string{ {{- range .CallingCodes }}{{ printf "%q" . }}, {{ end -}} },
+			{{- end }}
 			Capital:             	{{ printf "%q" .Capital }},
+			{{- if not .CapitalCoordinates.IsZero }}
+			CapitalCoordinates:     Coordinates{Latitude: {{ .CapitalCoordinates.Latitude }}, Longitude: {{ .CapitalCoordinates.Longitude }}},
+			{{- end }}
+			{{- if not .Centroid.IsZero }}
+			Centroid:               Coordinates{Latitude: {{ .Centroid.Latitude }}, Longitude: {{ .Centroid.Longitude }}},
+			{{- end }}
+			CommonName:             {{ printf "%q" .CommonName }},
 			ContinentName:          {{ printf "%q" .ContinentName }},
 			CountryCode:            {{ printf "%q" .CountryCode }},
+			{{- if .Currencies }}
+			Currencies:             []CountryCurrency{ {{- range .Currencies }}{Code: {{ printf "%q" .Code }}, LegalTender: {{ .LegalTender }}, Primary: {{ .Primary }}}, {{ end -}} },
+			{{- end }}
 			CurrencyCode:           {{ printf "%q" .CurrencyCode }},
 			IntermediateRegion:     {{ printf "%q" .IntermediateRegion }},
 			IntermediateRegionCode: {{ printf "%q" .IntermediateRegionCode }},
 			ISO31662:               {{ printf "%q" .ISO31662 }},
+			{{- if .Languages }}
+			Languages:              []CountryLanguage{ {{- range .Languages }}{Code: {{ printf "%q" .Code }}, Official: {{ .Official }}}, {{ end -}} },
+			{{- end }}
 			Name:                   {{ printf "%q" .Name }},
+			{{- if .NativeNames }}
+			NativeNames:            []NativeName{ {{- range .NativeNames }}{Language: {{ printf "%q" .Language }}, Name: {{ printf "%q" .Name }}}, {{ end -}} },
+			{{- end }}
+			OfficialName:           {{ printf "%q" .OfficialName }},
+			Population:             {{ .Population }},
+			PopulationYear:         {{ .PopulationYear }},
 			Region:                 {{ printf "%q" .Region }},
 			RegionCode:             {{ printf "%q" .RegionCode }},
 			SubRegion:              {{ printf "%q" .SubRegion }},
 			SubRegionCode:          {{ printf "%q" .SubRegionCode }},
+			{{- if .TimeZones }}
+			TimeZones:              []string{ {{- range .TimeZones }}{{ printf "%q" . }}, {{ end -}} },
+			{{- end }}
+			{{- if .TLDs }}
+			TLDs:                   []string{ {{- range .TLDs }}{{ printf "%q" . }}, {{ end -}} },
+			{{- end }}
 		},
         {{- end }}
         }
 
         byName = map[string]*Country{
-        {{- range $index, $c := .Countries }}
-                {{ printf "%q" (lower $c.Name) }}: countries[{{ $index }}],
+        {{- range $_, $pair := .Names }}
+                {{ printf "%q" $pair.Key }}: countries[{{ $pair.Index }}],
+        {{- end }}
+        }
+
+        localizedLanguages = []string{
+        {{- range .LocalizedNames }}
+                {{ printf "%q" .Language }},
+        {{- end }}
+        }
+
+        localizedNames = map[string]map[string]string{
+        {{- range .LocalizedNames }}
+                {{ printf "%q" .Key }}: { {{- range $code, $name := .Names }}{{ printf "%q" $code }}: {{ printf "%q" $name }}, {{ end -}} },
         {{- end }}
         }
 
@@ -95,5 +216,156 @@ var (
                 {{ printf "%q" $c.ISO31662 }}: countries[{{ $index }}],
         {{- end }}
         }
+
+        countriesByRegionCode = map[string][]*Country{
+        {{- range $_, $group := .RegionCountries }}
+                {{ printf "%q" $group.Key }}: { {{- range $group.Indices }}countries[{{ . }}], {{ end -}} },
+        {{- end }}
+        }
+
+        byCallingCode = map[string]*Country{
+        {{- range $_, $pair := .CallingCodes }}
+                {{ printf "%q" $pair.Key }}: countries[{{ $pair.Index }}],
+        {{- end }}
+        }
+
+        byPhonePrefix = map[string]*Country{
+        {{- range $_, $pair := .PhonePrefixes }}
+                {{ printf "%q" $pair.Key }}: countries[{{ $pair.Index }}],
+        {{- end }}
+        }
+
+        countriesByCallingCode = map[string][]*Country{
+        {{- range $_, $group := .CallingCodeCountries }}
+                {{ printf "%q" $group.Key }}: { {{- range $group.Indices }}countries[{{ . }}], {{ end -}} },
+        {{- end }}
+        }
+
+        byTLD = map[string]*Country{
+        {{- range $_, $pair := .TLDs }}
+                {{ printf "%q" $pair.Key }}: countries[{{ $pair.Index }}],
+        {{- end }}
+        }
+
+        byTimeZone = map[string]*Country{
+        {{- range $_, $pair := .TimeZones }}
+                {{ printf "%q" $pair.Key }}: countries[{{ $pair.Index }}],
+        {{- end }}
+        }
+
+        searchTerms = []searchTerm{
+        {{- range .SearchTerms }}
+                {country: countries[{{ .Index }}], field: {{ printf "%q" .Field }}, term: {{ printf "%q" .Term }}},
+        {{- end }}
+        }
+
+        neighbors = map[string][]*Country{
+        {{- range $_, $group := .Neighbors }}
+                {{ printf "%q" $group.Key }}: { {{- range $group.Indices }}countries[{{ . }}], {{ end -}} },
+        {{- end }}
+        }
+
+        landlocked = map[string]bool{
+        {{- range .Landlocked }}
+                {{ printf "%q" . }}: true,
+        {{- end }}
+        }
+
+        currencies = []*Currency{
+        {{- range .Currencies }}
+                {Code: {{ printf "%q" .Code }}, MinorUnits: {{ .MinorUnits }}, Name: {{ printf "%q" .Name }}, NarrowSymbol: {{ printf "%q" .NarrowSymbol }}, NumericCode: {{ printf "%q" .NumericCode }}, Symbol: {{ printf "%q" .Symbol }}},
+        {{- end }}
+        }
+
+        byCurrencyCode = map[string]*Currency{
+        {{- range $index, $c := .Currencies }}
+                {{ printf "%q" $c.Code }}: currencies[{{ $index }}],
+        {{- end }}
+        }
+
+        byCurrencyNumeric = map[string]*Currency{
+        {{- range $index, $c := .Currencies }}
+                {{ printf "%q" $c.NumericCode }}: currencies[{{ $index }}],
+        {{- end }}
+        }
+
+        countriesByCurrency = map[string][]*Country{
+        {{- range $_, $group := .CurrencyCountries }}
+                {{ printf "%q" $group.Key }}: { {{- range $group.Indices }}countries[{{ . }}], {{ end -}} },
+        {{- end }}
+        }
+
+        languages = []*Language{
+        {{- range .Languages }}
+                {Alpha2: {{ printf "%q" .Alpha2 }}, Alpha3: {{ printf "%q" .Alpha3 }}, Name: {{ printf "%q" .Name }}},
+        {{- end }}
+        }
+
+        byLanguageCode = map[string]*Language{
+        {{- range $_, $pair := .LanguageCodes }}
+                {{ printf "%q" $pair.Key }}: languages[{{ $pair.Index }}],
+        {{- end }}
+        }
+
+        countriesByLanguage = map[string][]*Country{
+        {{- range $_, $group := .LanguageCountries }}
+                {{ printf "%q" $group.Key }}: { {{- range $group.Indices }}countries[{{ . }}], {{ end -}} },
+        {{- end }}
+        }
+
+        subdivisions = []*Subdivision{
+        {{- range .Subdivisions }}
+                {Category: {{ printf "%q" .Category }}, Code: {{ printf "%q" .Code }}, CountryAlpha2: {{ printf "%q" .CountryAlpha2 }}, Name: {{ printf "%q" .Name }}, ParentCode: {{ printf "%q" .ParentCode }}},
+        {{- end }}
+        }
+
+        bySubdivisionCode = map[string]*Subdivision{
+        {{- range $index, $s := .Subdivisions }}
+                {{ printf "%q" $s.Code }}: subdivisions[{{ $index }}],
+        {{- end }}
+        }
+
+        bySubdivisionName = map[string]*Subdivision{
+        {{- range $_, $pair := .SubdivisionNames }}
+                {{ printf "%q" $pair.Key }}: subdivisions[{{ $pair.Index }}],
+        {{- end }}
+        }
+
+        subdivisionsByCountry = map[string][]*Subdivision{
+        {{- range $_, $group := .SubdivisionGroups }}
+                {{ printf "%q" $group.Key }}: { {{- range $group.Indices }}subdivisions[{{ . }}], {{ end -}} },
+        {{- end }}
+        }
 )`, nil
 }
+
+// GetPhoneTemplate returns the default phone subpackage template string
+func (d *DefaultTemplateProvider) GetPhoneTemplate() (string, error) {
+	return `// Code generated by go generate; DO NOT EDIT.
+// This file was generated by robots at
+// {{ .URL }}
+package phone
+
+var rules = map[string]*rule{
+{{- range .Rules }}
+	{{ printf "%q" .CountryAlpha2 }}: {lengths: []int{ {{- range $i, $l := .Lengths }}{{ if $i }}, {{ end }}{{ $l }}{{ end -}} }, nationalPrefix: {{ printf "%q" .NationalPrefix }}, pattern: {{ printf "%q" .Pattern }}},
+{{- end }}
+}`, nil
+}
+
+// GetGeoTemplate returns the default geo subpackage template string
+func (d *DefaultTemplateProvider) GetGeoTemplate() (string, error) {
+	return `// Code generated by go generate; DO NOT EDIT.
+// This file was generated by robots at
+// {{ .URL }}
+
+//go:build !countries_nogeo
+
+package geo
+
+var boundaries = []boundary{
+{{- range .Boundaries }}
+	{alpha2: {{ printf "%q" .CountryAlpha2 }}, polygons: [][]string{ {{- range .Polygons }}{ {{- range $i, $r := . }}{{ if $i }}, {{ end }}{{ printf "%q" $r }}{{ end -}} }, {{ end -}} }},
+{{- end }}
+}`, nil
+}
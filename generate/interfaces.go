@@ -8,6 +8,21 @@ import (
 type DataLoader interface {
 	LoadISO3166Data() ([]byte, error)
 	LoadCurrencyData() ([]byte, error)
+	LoadAdditionalCurrencyData() ([]byte, error)
+	LoadISO4217Data() ([]byte, error)
+	LoadSubdivisionData() ([]byte, error)
+	LoadCallingCodeData() ([]byte, error)
+	LoadPhoneNumberingData() ([]byte, error)
+	LoadTLDData() ([]byte, error)
+	LoadLanguageData() ([]byte, error)
+	LoadCountryLanguageData() ([]byte, error)
+	LoadTimeZoneData() ([]byte, error)
+	LoadCoordinatesData() ([]byte, error)
+	LoadBoundaryData() ([]byte, error)
+	LoadBorderData() ([]byte, error)
+	LoadCountryNameData() ([]byte, error)
+	LoadLocalizedNameData() ([]byte, error)
+	LoadNativeNameData() ([]byte, error)
 }
 
 // FileWriter handles file operations for output generation
@@ -18,4 +33,6 @@ type FileWriter interface {
 // TemplateProvider provides access to code generation templates
 type TemplateProvider interface {
 	GetPackageTemplate() (string, error)
+	GetPhoneTemplate() (string, error)
+	GetGeoTemplate() (string, error)
 }
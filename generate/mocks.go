@@ -7,10 +7,40 @@ import (
 
 // MockDataLoader is a mock implementation of DataLoader for testing
 type MockDataLoader struct {
-	ISO3166Data   []byte
-	CurrencyData  []byte
-	ISO3166Error  error
-	CurrencyError error
+	ISO3166Data             []byte
+	CurrencyData            []byte
+	AdditionalCurrencyData  []byte
+	ISO4217Data             []byte
+	SubdivisionData         []byte
+	CallingCodeData         []byte
+	PhoneNumberingData      []byte
+	TLDData                 []byte
+	LanguageData            []byte
+	CountryLanguageData     []byte
+	TimeZoneData            []byte
+	CoordinatesData         []byte
+	BoundaryData            []byte
+	BorderData              []byte
+	CountryNameData         []byte
+	LocalizedNameData       []byte
+	NativeNameData          []byte
+	ISO3166Error            error
+	CurrencyError           error
+	AdditionalCurrencyError error
+	ISO4217Error            error
+	SubdivisionError        error
+	CallingCodeError        error
+	PhoneNumberingError     error
+	TLDError                error
+	LanguageError           error
+	CountryLanguageError    error
+	TimeZoneError           error
+	CoordinatesError        error
+	BoundaryError           error
+	BorderError             error
+	CountryNameError        error
+	LocalizedNameError      error
+	NativeNameError         error
 }
 
 func (m *MockDataLoader) LoadISO3166Data() ([]byte, error) {
@@ -27,6 +57,111 @@ func (m *MockDataLoader) LoadCurrencyData() ([]byte, error) {
 	return m.CurrencyData, nil
 }
 
+func (m *MockDataLoader) LoadAdditionalCurrencyData() ([]byte, error) {
+	if m.AdditionalCurrencyError != nil {
+		return nil, m.AdditionalCurrencyError
+	}
+	return m.AdditionalCurrencyData, nil
+}
+
+func (m *MockDataLoader) LoadISO4217Data() ([]byte, error) {
+	if m.ISO4217Error != nil {
+		return nil, m.ISO4217Error
+	}
+	return m.ISO4217Data, nil
+}
+
+func (m *MockDataLoader) LoadSubdivisionData() ([]byte, error) {
+	if m.SubdivisionError != nil {
+		return nil, m.SubdivisionError
+	}
+	return m.SubdivisionData, nil
+}
+
+func (m *MockDataLoader) LoadCallingCodeData() ([]byte, error) {
+	if m.CallingCodeError != nil {
+		return nil, m.CallingCodeError
+	}
+	return m.CallingCodeData, nil
+}
+
+func (m *MockDataLoader) LoadPhoneNumberingData() ([]byte, error) {
+	if m.PhoneNumberingError != nil {
+		return nil, m.PhoneNumberingError
+	}
+	return m.PhoneNumberingData, nil
+}
+
+func (m *MockDataLoader) LoadTLDData() ([]byte, error) {
+	if m.TLDError != nil {
+		return nil, m.TLDError
+	}
+	return m.TLDData, nil
+}
+
+func (m *MockDataLoader) LoadLanguageData() ([]byte, error) {
+	if m.LanguageError != nil {
+		return nil, m.LanguageError
+	}
+	return m.LanguageData, nil
+}
+
+func (m *MockDataLoader) LoadCountryLanguageData() ([]byte, error) {
+	if m.CountryLanguageError != nil {
+		return nil, m.CountryLanguageError
+	}
+	return m.CountryLanguageData, nil
+}
+
+func (m *MockDataLoader) LoadTimeZoneData() ([]byte, error) {
+	if m.TimeZoneError != nil {
+		return nil, m.TimeZoneError
+	}
+	return m.TimeZoneData, nil
+}
+
+func (m *MockDataLoader) LoadCoordinatesData() ([]byte, error) {
+	if m.CoordinatesError != nil {
+		return nil, m.CoordinatesError
+	}
+	return m.CoordinatesData, nil
+}
+
+func (m *MockDataLoader) LoadBoundaryData() ([]byte, error) {
+	if m.BoundaryError != nil {
+		return nil, m.BoundaryError
+	}
+	return m.BoundaryData, nil
+}
+
+func (m *MockDataLoader) LoadBorderData() ([]byte, error) {
+	if m.BorderError != nil {
+		return nil, m.BorderError
+	}
+	return m.BorderData, nil
+}
+
+func (m *MockDataLoader) LoadCountryNameData() ([]byte, error) {
+	if m.CountryNameError != nil {
+		return nil, m.CountryNameError
+	}
+	return m.CountryNameData, nil
+}
+
+func (m *MockDataLoader) LoadLocalizedNameData() ([]byte, error) {
+	if m.LocalizedNameError != nil {
+		return nil, m.LocalizedNameError
+	}
+	return m.LocalizedNameData, nil
+}
+
+func (m *MockDataLoader) LoadNativeNameData() ([]byte, error) {
+	if m.NativeNameError != nil {
+		return nil, m.NativeNameError
+	}
+	return m.NativeNameData, nil
+}
+
 // MockFileWriter is a mock implementation of FileWriter for testing
 type MockFileWriter struct {
 	CreatedFiles map[string]*bytes.Buffer
@@ -63,8 +198,12 @@ func (m *mockWriteCloser) Close() error {
 
 // MockTemplateProvider is a mock implementation of TemplateProvider for testing
 type MockTemplateProvider struct {
-	Template string
-	Error    error
+	Template      string
+	Error         error
+	PhoneTemplate string
+	PhoneError    error
+	GeoTemplate   string
+	GeoError      error
 }
 
 func (m *MockTemplateProvider) GetPackageTemplate() (string, error) {
@@ -74,6 +213,20 @@ func (m *MockTemplateProvider) GetPackageTemplate() (string, error) {
 	return m.Template, nil
 }
 
+func (m *MockTemplateProvider) GetPhoneTemplate() (string, error) {
+	if m.PhoneError != nil {
+		return "", m.PhoneError
+	}
+	return m.PhoneTemplate, nil
+}
+
+func (m *MockTemplateProvider) GetGeoTemplate() (string, error) {
+	if m.GeoError != nil {
+		return "", m.GeoError
+	}
+	return m.GeoTemplate, nil
+}
+
 // TestDataProvider provides sample data for testing
 type TestDataProvider struct{}
 
@@ -129,6 +282,303 @@ func (t *TestDataProvider) GetSampleCurrencyData() []byte {
 	]`)
 }
 
+func (t *TestDataProvider) GetSampleAdditionalCurrencyData() []byte {
+	return []byte(`[
+		{
+			"countryCode": "TC",
+			"currencyCode": "ANO",
+			"legalTender": true
+		},
+		{
+			"countryCode": "TC",
+			"currencyCode": "TSF",
+			"legalTender": false
+		},
+		{
+			"countryCode": "TC",
+			"currencyCode": "TST",
+			"legalTender": true
+		},
+		{
+			"countryCode": "ZZ",
+			"currencyCode": "TST",
+			"legalTender": true
+		}
+	]`)
+}
+
+func (t *TestDataProvider) GetSampleISO4217Data() []byte {
+	return []byte(`[
+		{
+			"code": "TST",
+			"numericCode": "999",
+			"name": "Test Dollar",
+			"minorUnits": "2",
+			"symbol": "T$",
+			"narrowSymbol": "$"
+		},
+		{
+			"code": "ANO",
+			"numericCode": "998",
+			"name": "Another Gold",
+			"minorUnits": "N.A.",
+			"symbol": "ANO",
+			"narrowSymbol": "ANO"
+		}
+	]`)
+}
+
+func (t *TestDataProvider) GetSampleSubdivisionData() []byte {
+	return []byte(`[
+		{
+			"code": "TC-02",
+			"name": "Second Province",
+			"type": "Province",
+			"parent": "01"
+		},
+		{
+			"code": "TC-01",
+			"name": "First Region",
+			"type": "Region"
+		},
+		{
+			"code": "AC-X",
+			"name": "Another State",
+			"type": "State"
+		}
+	]`)
+}
+
+func (t *TestDataProvider) GetSampleCallingCodeData() []byte {
+	return []byte(`[
+		{
+			"countryCode": "AC",
+			"callingCode": "999",
+			"main": false,
+			"prefixes": ["12", "345"]
+		},
+		{
+			"countryCode": "TC",
+			"callingCode": "999",
+			"main": true
+		},
+		{
+			"countryCode": "ZZ",
+			"callingCode": "998"
+		}
+	]`)
+}
+
+func (t *TestDataProvider) GetSamplePhoneNumberingData() []byte {
+	return []byte(`[
+		{
+			"countryCode": "TC",
+			"nationalPrefix": "0",
+			"lengths": [8, 9],
+			"pattern": "[2-9]\\d{7,8}"
+		},
+		{
+			"countryCode": "AC",
+			"nationalPrefix": "",
+			"lengths": [6],
+			"pattern": "1\\d{5}"
+		}
+	]`)
+}
+
+func (t *TestDataProvider) GetSampleTLDData() []byte {
+	return []byte(`[
+		{
+			"countryCode": "TC",
+			"tlds": [".tc", ".тест"]
+		},
+		{
+			"countryCode": "AC",
+			"tlds": [".ac"]
+		},
+		{
+			"countryCode": "ZZ",
+			"tlds": [".zz"]
+		}
+	]`)
+}
+
+func (t *TestDataProvider) GetSampleLanguageData() []byte {
+	return []byte(`[
+		{
+			"alpha2": "en",
+			"alpha3": "eng",
+			"name": "English"
+		},
+		{
+			"alpha2": "",
+			"alpha3": "fil",
+			"name": "Filipino"
+		},
+		{
+			"alpha2": "es",
+			"alpha3": "spa",
+			"name": "Spanish"
+		}
+	]`)
+}
+
+func (t *TestDataProvider) GetSampleCountryLanguageData() []byte {
+	return []byte(`[
+		{
+			"countryCode": "TC",
+			"languages": [{"code": "en", "official": true}, {"code": "es", "official": false}]
+		},
+		{
+			"countryCode": "AC",
+			"languages": [{"code": "fil", "official": true}, {"code": "en", "official": true}]
+		},
+		{
+			"countryCode": "ZZ",
+			"languages": [{"code": "en", "official": true}]
+		}
+	]`)
+}
+
+func (t *TestDataProvider) GetSampleTimeZoneData() []byte {
+	return []byte(`[
+		{
+			"countryCode": "TC",
+			"timeZones": ["America/Grand_Turk"]
+		},
+		{
+			"countryCode": "AC",
+			"timeZones": ["Atlantic/St_Helena", "Atlantic/Ascension"],
+			"aliases": ["Test/Ascension"]
+		},
+		{
+			"countryCode": "ZZ",
+			"timeZones": ["Etc/Unknown"]
+		}
+	]`)
+}
+
+func (t *TestDataProvider) GetSampleCoordinatesData() []byte {
+	return []byte(`[
+		{
+			"countryCode": "TC",
+			"centroid": {"latitude": 21.7866, "longitude": -71.9674},
+			"boundingBox": {"north": 21.9618, "south": 21.2903, "east": -71.1277, "west": -72.4822},
+			"capital": {"latitude": 21.4667, "longitude": -71.1333}
+		},
+		{
+			"countryCode": "AC",
+			"centroid": {"latitude": -7.9467, "longitude": -14.3559},
+			"boundingBox": {"north": -7.8866, "south": -7.9961, "east": -14.3011, "west": -14.4216}
+		},
+		{
+			"countryCode": "ZZ",
+			"centroid": {"latitude": 0, "longitude": 0},
+			"boundingBox": {"north": 1, "south": -1, "east": 1, "west": -1}
+		}
+	]`)
+}
+
+func (t *TestDataProvider) GetSampleBoundaryData() []byte {
+	return []byte(`[
+		{
+			"countryCode": "TC",
+			"polygons": [["_dyKn_gk@_pR??oh\\~oR?"]]
+		},
+		{
+			"countryCode": "AC",
+			"polygons": [["ne}CnezGozD??ozDnzD?", "~f{C~fxGo}@??o}@n}@?"]]
+		}
+	]`)
+}
+
+func (t *TestDataProvider) GetSampleBorderData() []byte {
+	return []byte(`[
+		{
+			"countryCode": "TC",
+			"borders": ["AC", "ZZ"]
+		},
+		{
+			"countryCode": "AC",
+			"borders": ["TC"],
+			"landlocked": true
+		},
+		{
+			"countryCode": "ZZ",
+			"borders": ["TC"],
+			"landlocked": true
+		}
+	]`)
+}
+
+func (t *TestDataProvider) GetSampleCountryNameData() []byte {
+	return []byte(`[
+		{
+			"countryCode": "TC",
+			"commonName": "Test Country",
+			"officialName": "Republic of Test Country",
+			"aliases": ["TC Land", "Testland"]
+		},
+		{
+			"countryCode": "AC",
+			"commonName": "Another Country",
+			"officialName": "Kingdom of Another Country"
+		}
+	]`)
+}
+
+func (t *TestDataProvider) GetSampleLocalizedNameData() []byte {
+	return []byte(`[
+		{
+			"language": "fr",
+			"names": {"TC": "Pays Test", "AC": "Autre Pays"}
+		},
+		{
+			"language": "zh-Hant",
+			"names": {"TC": "測試國", "AC": "另一國", "XX": "未知"}
+		},
+		{
+			"language": "de",
+			"names": {"TC": "Testland", "AC": "Anderes Land"}
+		}
+	]`)
+}
+
+func (t *TestDataProvider) GetSampleNativeNameData() []byte {
+	return []byte(`[
+		{
+			"countryCode": "TC",
+			"names": [{"language": "fr", "name": "Pays Test"}, {"language": "de", "name": "Testland"}]
+		},
+		{
+			"countryCode": "XX",
+			"names": [{"language": "en", "name": "Unknown"}]
+		}
+	]`)
+}
+
+func (t *TestDataProvider) GetSimpleGeoTemplate() string {
+	return `// Test Template
+package geo
+
+var boundaries = []boundary{
+{{- range .Boundaries }}
+	{alpha2: {{ printf "%q" .CountryAlpha2 }}},
+{{- end }}
+}`
+}
+
+func (t *TestDataProvider) GetSimplePhoneTemplate() string {
+	return `// Test Template
+package phone
+
+var rules = map[string]*rule{
+{{- range .Rules }}
+	{{ printf "%q" .CountryAlpha2 }}: {pattern: {{ printf "%q" .Pattern }}},
+{{- end }}
+}`
+}
+
 func (t *TestDataProvider) GetSimpleTemplate() string {
 	return `// Test Template
 package countries
@@ -150,14 +600,31 @@ func NewTestGenerator() (*Generator, *MockDataLoader, *MockFileWriter, *MockTemp
 	dataProvider := &TestDataProvider{}
 
 	mockDataLoader := &MockDataLoader{
-		ISO3166Data:  dataProvider.GetSampleISO3166Data(),
-		CurrencyData: dataProvider.GetSampleCurrencyData(),
+		ISO3166Data:            dataProvider.GetSampleISO3166Data(),
+		CurrencyData:           dataProvider.GetSampleCurrencyData(),
+		AdditionalCurrencyData: dataProvider.GetSampleAdditionalCurrencyData(),
+		ISO4217Data:            dataProvider.GetSampleISO4217Data(),
+		SubdivisionData:        dataProvider.GetSampleSubdivisionData(),
+		CallingCodeData:        dataProvider.GetSampleCallingCodeData(),
+		PhoneNumberingData:     dataProvider.GetSamplePhoneNumberingData(),
+		TLDData:                dataProvider.GetSampleTLDData(),
+		LanguageData:           dataProvider.GetSampleLanguageData(),
+		CountryLanguageData:    dataProvider.GetSampleCountryLanguageData(),
+		TimeZoneData:           dataProvider.GetSampleTimeZoneData(),
+		CoordinatesData:        dataProvider.GetSampleCoordinatesData(),
+		BoundaryData:           dataProvider.GetSampleBoundaryData(),
+		BorderData:             dataProvider.GetSampleBorderData(),
+		CountryNameData:        dataProvider.GetSampleCountryNameData(),
+		LocalizedNameData:      dataProvider.GetSampleLocalizedNameData(),
+		NativeNameData:         dataProvider.GetSampleNativeNameData(),
 	}
 
 	mockFileWriter := NewMockFileWriter()
 
 	mockTemplateProvider := &MockTemplateProvider{
-		Template: dataProvider.GetSimpleTemplate(),
+		Template:      dataProvider.GetSimpleTemplate(),
+		PhoneTemplate: dataProvider.GetSimplePhoneTemplate(),
+		GeoTemplate:   dataProvider.GetSimpleGeoTemplate(),
 	}
 
 	config := GeneratorConfig{
@@ -165,6 +632,9 @@ func NewTestGenerator() (*Generator, *MockDataLoader, *MockFileWriter, *MockTemp
 		FileWriter:       mockFileWriter,
 		TemplateProvider: mockTemplateProvider,
 		OutputPath:       "test_output.go",
+		PhoneOutputPath:  "test_phone_output.go",
+		GeoOutputPath:    "test_geo_output.go",
+		PopulationYear:   2020,
 		RepoURL:          "https://github.com/test/repo",
 	}
 
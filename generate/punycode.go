@@ -0,0 +1,115 @@
+package main
+
+import (
+	"strings"
+)
+
+// Punycode parameters (RFC 3492)
+const (
+	punycodeBase        = 36
+	punycodeDamp        = 700
+	punycodeInitialBias = 72
+	punycodeInitialN    = 128
+	punycodeSkew        = 38
+	punycodeTMax        = 26
+	punycodeTMin        = 1
+)
+
+// toASCII converts a domain label to its ASCII form, encoding non-ASCII labels with punycode and the "xn--" prefix
+//
+// The label is expected to be lowercased and in Unicode normalization form C.
+func toASCII(label string) string {
+	for i := 0; i < len(label); i++ {
+		if label[i] >= 0x80 {
+			return "xn--" + punycodeEncode(label)
+		}
+	}
+	return label
+}
+
+// punycodeEncode encodes a Unicode string with the punycode algorithm (RFC 3492, section 6.3)
+func punycodeEncode(input string) string {
+	runes := []rune(input)
+
+	var out strings.Builder
+	for _, r := range runes {
+		if r < punycodeInitialN {
+			out.WriteRune(r)
+		}
+	}
+
+	basic := out.Len()
+	handled := basic
+	if basic > 0 {
+		out.WriteByte('-')
+	}
+
+	n, delta, bias := rune(punycodeInitialN), 0, punycodeInitialBias
+	for handled < len(runes) {
+		// Find the smallest code point not yet handled
+		next := rune(0x10FFFF)
+		for _, r := range runes {
+			if r >= n && r < next {
+				next = r
+			}
+		}
+
+		delta += int(next-n) * (handled + 1)
+		n = next
+
+		for _, r := range runes {
+			if r < n {
+				delta++
+			}
+			if r != n {
+				continue
+			}
+
+			q := delta
+			for k := punycodeBase; ; k += punycodeBase {
+				t := min(max(k-bias, punycodeTMin), punycodeTMax)
+				if q < t {
+					break
+				}
+				out.WriteByte(punycodeDigit(t + (q-t)%(punycodeBase-t)))
+				q = (q - t) / (punycodeBase - t)
+			}
+			out.WriteByte(punycodeDigit(q))
+
+			bias = punycodeAdapt(delta, handled+1, handled == basic)
+			delta = 0
+			handled++
+		}
+
+		delta++
+		n++
+	}
+
+	return out.String()
+}
+
+// punycodeAdapt is the bias adaptation function (RFC 3492, section 6.1)
+func punycodeAdapt(delta, numPoints int, firstTime bool) int {
+	if firstTime {
+		delta /= punycodeDamp
+	} else {
+		delta /= 2
+	}
+	delta += delta / numPoints
+
+	k := 0
+	for delta > ((punycodeBase-punycodeTMin)*punycodeTMax)/2 {
+		delta /= punycodeBase - punycodeTMin
+		k += punycodeBase
+	}
+
+	return k + (punycodeBase-punycodeTMin+1)*delta/(delta+punycodeSkew)
+}
+
+// punycodeDigit returns the basic code point of a punycode digit (0-25 as a-z, 26-35 as 0-9)
+func punycodeDigit(d int) byte {
+	if d < 26 {
+		return byte('a' + d)
+	}
+	return byte('0' + d - 26)
+}
@@ -0,0 +1,279 @@
+// Package geo resolves geographic coordinates to the countries of the go-countries package, offline.
+//
+// The package embeds simplified boundaries of every country (Natural Earth 1:10m, simplified to about 1 km)
+// and indexes them on a grid of one degree cells, so a lookup only tests the few polygons near a location.
+// Results are deterministic: the same coordinates always resolve to the same country.
+//
+// The boundaries add about 1 MB to binaries. Build with the countries_nogeo tag to leave them out;
+// CountryAt then always returns nil and Available reports false.
+package geo
+
+import (
+	"math"
+	"sync"
+
+	"github.com/mrz1836/go-countries"
+)
+
+const (
+	// cellsPerRow is the number of one degree cells around a parallel
+	cellsPerRow = 360
+
+	// cellRows is the number of one degree cells from pole to pole
+	cellRows = 180
+
+	// polylinePrecision is the scale of the coordinates in the encoded polylines (1e-4 degrees)
+	polylinePrecision = 1e4
+
+	// tolerance is the distance in degrees within which a location outside every boundary is matched
+	// to the nearest one, which absorbs the simplification of coastlines (about 2 km)
+	tolerance = 0.02
+)
+
+// boundary is the generated outline of a country
+type boundary struct {
+	alpha2   string     // ISO 3166-1 alpha-2 code of the country
+	polygons [][]string // Polygons as encoded polylines, the outer ring first and then its holes
+}
+
+// point is a vertex of a ring, in decimal degrees
+type point struct {
+	lat float64
+	lon float64
+}
+
+// box is the bounding box of a polygon, in decimal degrees
+type box struct {
+	east  float64
+	north float64
+	south float64
+	west  float64
+}
+
+// polygon is a decoded polygon of a country with its bounding box
+type polygon struct {
+	area    float64 // Area of the outer ring in square degrees
+	bounds  box
+	country *countries.Country
+	rings   [][]point
+}
+
+// index is the spatial index of every polygon, by one degree cell
+type index struct {
+	cells    [][]int32
+	polygons []polygon
+}
+
+// loadIndex decodes the embedded boundaries and builds the spatial index on the first lookup
+var loadIndex = sync.OnceValue(buildIndex) //nolint:gochecknoglobals // lazily built spatial index
+
+// CountryAt retrieves the country at a geographic location.
+//
+// This function performs the following steps:
+// - Validates the latitude and longitude of the location
+// - Finds the polygons whose bounding box overlaps the one degree cell of the location
+// - Returns the country of the smallest polygon containing the location (even-odd rule, holes excluded)
+// - Otherwise returns the country of the nearest polygon within about 2 km, for locations on the coastline
+//
+// Parameters:
+// - lat: latitude of the location in decimal degrees, within [-90, 90]
+// - lon: longitude of the location in decimal degrees, within [-180, 180]
+//
+// Returns:
+// - Pointer to the Country at the location, or nil for the open sea, invalid locations,
+// or binaries built with the countries_nogeo tag
+//
+// Side Effects:
+// - Decodes the boundaries and builds the spatial index on the first call (a few milliseconds)
+//
+// Notes:
+// - Boundaries are simplified to about 1 km, so locations close to a border may resolve to the neighbour
+// - The smallest polygon wins so that enclaves resolve to themselves (e.g., Vatican City within Italy),
+// as well as where simplified boundaries overlap
+// - Disputed areas resolve to the country administering them in Natural Earth, with a few merges
+// (e.g., Somaliland to Somalia, Northern Cyprus to Cyprus)
+// - The Country pointer references global data and should not be modified
+// - Safe for concurrent use
+func CountryAt(lat, lon float64) *countries.Country {
+	if !(lat >= -90 && lat <= 90) || !(lon >= -180 && lon <= 180) {
+		return nil
+	}
+
+	idx := loadIndex()
+	if len(idx.polygons) == 0 {
+		return nil
+	}
+
+	location := point{lat: lat, lon: lon}
+	candidates := idx.cells[cellOf(lat, lon)]
+	var smallest *polygon
+	for _, i := range candidates {
+		p := &idx.polygons[i]
+		if (smallest == nil || p.area < smallest.area) && p.bounds.contains(location, 0) && p.contains(location) {
+			smallest = p
+		}
+	}
+	if smallest != nil {
+		return smallest.country
+	}
+
+	var nearest *countries.Country
+	best := tolerance
+	for _, i := range candidates {
+		p := &idx.polygons[i]
+		if !p.bounds.contains(location, tolerance) {
+			continue
+		}
+		if d := p.distance(location); d <= best {
+			nearest, best = p.country, d
+		}
+	}
+	return nearest
+}
+
+// Available reports whether the country boundaries are embedded in the binary.
+//
+// Returns:
+// - False when the binary was built with the countries_nogeo tag, in which case CountryAt always returns nil
+//
+// Side Effects:
+// - None
+func Available() bool {
+	return len(boundaries) > 0
+}
+
+// buildIndex decodes the embedded boundaries and assigns every polygon to the cells its bounding box overlaps
+func buildIndex() *index {
+	idx := &index{cells: make([][]int32, cellsPerRow*cellRows)}
+	for _, b := range boundaries {
+		country := countries.GetByAlpha2(b.alpha2)
+		if country == nil {
+			continue
+		}
+		for _, encoded := range b.polygons {
+			p := polygon{country: country, rings: make([][]point, 0, len(encoded))}
+			for _, ring := range encoded {
+				p.rings = append(p.rings, decodePolyline(ring))
+			}
+			p.area, p.bounds = areaOf(p.rings[0]), boundsOf(p.rings[0])
+			idx.polygons = append(idx.polygons, p)
+		}
+	}
+
+	for i, p := range idx.polygons {
+		south, west := cellCoordinates(p.bounds.south-tolerance, p.bounds.west-tolerance)
+		north, east := cellCoordinates(p.bounds.north+tolerance, p.bounds.east+tolerance)
+		for row := south; row <= north; row++ {
+			for col := west; col <= east; col++ {
+				cell := row*cellsPerRow + col
+				idx.cells[cell] = append(idx.cells[cell], int32(i)) //nolint:gosec // fewer polygons than MaxInt32
+			}
+		}
+	}
+	return idx
+}
+
+// cellOf returns the index of the one degree cell containing a location
+func cellOf(lat, lon float64) int {
+	row, col := cellCoordinates(lat, lon)
+	return row*cellsPerRow + col
+}
+
+// cellCoordinates returns the row and column of the one degree cell containing a location,
+// clamping locations beyond the poles and the antimeridian to the edge cells
+func cellCoordinates(lat, lon float64) (row, col int) {
+	row = min(max(int(math.Floor(lat+90)), 0), cellRows-1)
+	col = min(max(int(math.Floor(lon+180)), 0), cellsPerRow-1)
+	return row, col
+}
+
+// decodePolyline decodes a ring encoded with the polyline algorithm (latitude first, precision 1e-4)
+func decodePolyline(encoded string) []point {
+	points := make([]point, 0, len(encoded)/4)
+	var lat, lon int64
+	for i := 0; i < len(encoded); {
+		for _, coordinate := range []*int64{&lat, &lon} {
+			var result int64
+			var shift uint
+			for i < len(encoded) {
+				b := int64(encoded[i]) - 63
+				i++
+				result |= (b & 0x1f) << shift
+				shift += 5
+				if b < 0x20 {
+					break
+				}
+			}
+			if result&1 != 0 {
+				result = ^(result >> 1)
+			} else {
+				result >>= 1
+			}
+			*coordinate += result
+		}
+		points = append(points, point{lat: float64(lat) / polylinePrecision, lon: float64(lon) / polylinePrecision})
+	}
+	return points
+}
+
+// areaOf returns the area of a ring in square degrees (shoelace formula)
+func areaOf(ring []point) float64 {
+	var sum float64
+	for i, j := 0, len(ring)-1; i < len(ring); j, i = i, i+1 {
+		sum += ring[j].lon*ring[i].lat - ring[i].lon*ring[j].lat
+	}
+	return math.Abs(sum) / 2
+}
+
+// boundsOf returns the bounding box of a ring
+func boundsOf(ring []point) box {
+	b := box{east: -180, north: -90, south: 90, west: 180}
+	for _, p := range ring {
+		b.east, b.west = max(b.east, p.lon), min(b.west, p.lon)
+		b.north, b.south = max(b.north, p.lat), min(b.south, p.lat)
+	}
+	return b
+}
+
+// contains reports whether a location is within the box expanded by a margin in degrees
+func (b box) contains(location point, margin float64) bool {
+	return location.lat >= b.south-margin && location.lat <= b.north+margin &&
+		location.lon >= b.west-margin && location.lon <= b.east+margin
+}
+
+// contains reports whether a location is inside the polygon, using the even-odd rule over all its rings
+// so that holes (e.g., Lesotho within South Africa) are excluded
+func (p *polygon) contains(location point) bool {
+	inside := false
+	for _, ring := range p.rings {
+		for i, j := 0, len(ring)-1; i < len(ring); j, i = i, i+1 {
+			a, b := ring[i], ring[j]
+			if (a.lat > location.lat) != (b.lat > location.lat) &&
+				location.lon < (b.lon-a.lon)*(location.lat-a.lat)/(b.lat-a.lat)+a.lon {
+				inside = !inside
+			}
+		}
+	}
+	return inside
+}
+
+// distance returns the distance in degrees from a location to the outer ring of the polygon,
+// with longitudes scaled by the cosine of the latitude
+func (p *polygon) distance(location point) float64 {
+	scale := math.Cos(location.lat * math.Pi / 180)
+	nearest := math.Inf(1)
+	ring := p.rings[0]
+	for i, j := 0, len(ring)-1; i < len(ring); j, i = i, i+1 {
+		ax, ay := (ring[j].lon-location.lon)*scale, ring[j].lat-location.lat
+		bx, by := (ring[i].lon-location.lon)*scale, ring[i].lat-location.lat
+		dx, dy := bx-ax, by-ay
+
+		// Project the location (the origin) on the segment and clamp the projection to its ends
+		t := 0.0
+		if length := dx*dx + dy*dy; length > 0 {
+			t = min(max(-(ax*dx+ay*dy)/length, 0), 1)
+		}
+		nearest = min(nearest, math.Hypot(ax+t*dx, ay+t*dy))
+	}
+	return nearest
+}
@@ -0,0 +1,40 @@
+package geo
+
+import (
+	"math"
+	"testing"
+
+	"github.com/mrz1836/go-countries"
+	"github.com/stretchr/testify/require"
+)
+
+// FuzzCountryAt ensures CountryAt never panics, only resolves valid locations,
+// and always returns the same country for the same location.
+func FuzzCountryAt(f *testing.F) {
+	seed := []struct {
+		lat float64
+		lon float64
+	}{
+		{52.52, 13.405},
+		{-29.31, 27.48},
+		{-16.75, -179.95},
+		{90, 180},
+		{-90, -180},
+		{0, 0},
+		{math.NaN(), 0},
+		{0, math.Inf(-1)},
+	}
+	for _, s := range seed {
+		f.Add(s.lat, s.lon)
+	}
+	f.Fuzz(func(t *testing.T, lat, lon float64) {
+		c := CountryAt(lat, lon)
+		if c == nil {
+			return
+		}
+		require.True(t, lat >= -90 && lat <= 90)
+		require.True(t, lon >= -180 && lon <= 180)
+		require.Same(t, countries.GetByAlpha2(c.Alpha2), c)
+		require.Same(t, c, CountryAt(lat, lon))
+	})
+}
@@ -0,0 +1,6 @@
+//go:build countries_nogeo
+
+package geo
+
+// boundaries is empty when the binary is built with the countries_nogeo tag
+var boundaries []boundary //nolint:gochecknoglobals // replaces the generated boundaries
@@ -0,0 +1,16 @@
+//go:build countries_nogeo
+
+package geo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestCountryAt_NoGeo tests that CountryAt returns nil without the embedded boundaries
+func TestCountryAt_NoGeo(t *testing.T) {
+	assert.False(t, Available())
+	assert.Nil(t, CountryAt(52.52, 13.405))
+	assert.Nil(t, CountryAt(0, 0))
+}
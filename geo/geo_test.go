@@ -0,0 +1,158 @@
+//go:build !countries_nogeo
+
+package geo
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/mrz1836/go-countries"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestCountryAt tests CountryAt with locations around the world
+func TestCountryAt(t *testing.T) {
+	tests := []struct {
+		name     string
+		lat      float64
+		lon      float64
+		expected countries.Alpha2
+	}{
+		{name: "Berlin", lat: 52.52, lon: 13.405, expected: countries.Alpha2DE},
+		{name: "Paris", lat: 48.8566, lon: 2.3522, expected: countries.Alpha2FR},
+		{name: "New York", lat: 40.7128, lon: -74.006, expected: countries.Alpha2US},
+		{name: "Tokyo", lat: 35.6762, lon: 139.6503, expected: countries.Alpha2JP},
+		{name: "Sydney", lat: -33.8688, lon: 151.2093, expected: countries.Alpha2AU},
+		{name: "Vatican City", lat: 41.9029, lon: 12.4534, expected: countries.Alpha2VA},
+		{name: "Rome", lat: 41.8967, lon: 12.4822, expected: countries.Alpha2IT},
+		{name: "Monaco", lat: 43.7384, lon: 7.4246, expected: countries.Alpha2MC},
+		{name: "Maseru, enclave of South Africa", lat: -29.31, lon: 27.48, expected: countries.Alpha2LS},
+		{name: "Bloemfontein", lat: -29.0852, lon: 26.1596, expected: countries.Alpha2ZA},
+		{name: "Anchorage", lat: 61.2181, lon: -149.9003, expected: countries.Alpha2US},
+		{name: "Fiji, west of the antimeridian", lat: -16.7, lon: 179.9, expected: countries.Alpha2FJ},
+		{name: "Fiji, east of the antimeridian", lat: -16.75, lon: -179.95, expected: countries.Alpha2FJ},
+		{name: "Chukotka, east of the antimeridian", lat: 66, lon: -170.5, expected: countries.Alpha2RU},
+		{name: "Cayenne, overseas department", lat: 4.9224, lon: -52.3135, expected: countries.Alpha2GF},
+		{name: "Singapore", lat: 1.3521, lon: 103.8198, expected: countries.Alpha2SG},
+		{name: "South pole", lat: -90, lon: 0, expected: countries.Alpha2AQ},
+		{name: "Gulf of Guinea", lat: 0, lon: 0},
+		{name: "Pacific Ocean", lat: -30, lon: -140},
+		{name: "Latitude out of range", lat: 90.1, lon: 0},
+		{name: "Longitude out of range", lat: 0, lon: 180.1},
+		{name: "NaN", lat: math.NaN(), lon: 0},
+		{name: "Infinity", lat: 0, lon: math.Inf(1)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := CountryAt(tt.lat, tt.lon)
+			if tt.expected == "" {
+				assert.Nil(t, c)
+				return
+			}
+			require.NotNil(t, c)
+			assert.Equal(t, tt.expected, c.Alpha2Code())
+			assert.Same(t, tt.expected.Country(), c)
+		})
+	}
+}
+
+// TestCountryAt_Capitals tests that the capital of every country resolves to that country
+func TestCountryAt_Capitals(t *testing.T) {
+	// Capital coordinates are rounded to the minute, which puts a few of them across a border or in a lagoon
+	skip := map[countries.Alpha2]string{
+		countries.Alpha2CF: "Bangui is rounded across the Ubangi river, in CD",
+		countries.Alpha2KI: "South Tarawa is rounded into the lagoon",
+		countries.Alpha2MH: "Majuro is rounded into the lagoon",
+		countries.Alpha2SX: "Lower Prince's Quarter is within 1 km of the border with MF",
+	}
+
+	for _, c := range countries.GetAll() {
+		if c.Capital == "" || c.CapitalCoordinates == (countries.Coordinates{}) || skip[c.Alpha2Code()] != "" {
+			continue
+		}
+		found := CountryAt(c.CapitalCoordinates.Latitude, c.CapitalCoordinates.Longitude)
+		if assert.NotNil(t, found, "capital of %s", c.Alpha2) {
+			assert.Equal(t, c.Alpha2, found.Alpha2, "capital of %s", c.Alpha2)
+		}
+	}
+}
+
+// TestCountryAt_Centroids tests that the centroid of every country resolves to that country
+func TestCountryAt_Centroids(t *testing.T) {
+	for _, c := range countries.GetAll() {
+		found := CountryAt(c.Centroid.Latitude, c.Centroid.Longitude)
+		if assert.NotNil(t, found, "centroid of %s", c.Alpha2) {
+			assert.Equal(t, c.Alpha2, found.Alpha2, "centroid of %s", c.Alpha2)
+		}
+	}
+}
+
+// TestCountryAt_Deterministic tests that repeated lookups return the same country
+func TestCountryAt_Deterministic(t *testing.T) {
+	for lat := -60.0; lat <= 80; lat += 7.3 {
+		for lon := -180.0; lon <= 180; lon += 11.7 {
+			assert.Same(t, CountryAt(lat, lon), CountryAt(lat, lon))
+		}
+	}
+}
+
+// TestAvailable tests that the boundaries of every country are embedded
+func TestAvailable(t *testing.T) {
+	require.True(t, Available())
+
+	seen := make(map[string]bool, len(boundaries))
+	for _, b := range boundaries {
+		seen[b.alpha2] = true
+		assert.NotNil(t, countries.GetByAlpha2(b.alpha2), "unknown country %s", b.alpha2)
+	}
+	for _, c := range countries.GetAll() {
+		assert.True(t, seen[c.Alpha2], "no boundary for %s", c.Alpha2)
+	}
+}
+
+// TestDecodePolyline tests decodePolyline with the example of the polyline algorithm, read with a precision of 1e-4
+func TestDecodePolyline(t *testing.T) {
+	points := decodePolyline("_p~iF~ps|U_ulLnnqC_mqNvxq`@")
+
+	require.Len(t, points, 3)
+	assert.InDelta(t, 385.0, points[0].lat, 1e-9)
+	assert.InDelta(t, -1202.0, points[0].lon, 1e-9)
+	assert.InDelta(t, 407.0, points[1].lat, 1e-9)
+	assert.Empty(t, decodePolyline(""))
+}
+
+// ExampleCountryAt is an example of CountryAt()
+func ExampleCountryAt() {
+	c := CountryAt(48.8566, 2.3522)
+	fmt.Printf("%s (%s)", c.Name, c.Alpha2)
+	// Output:France (FR)
+}
+
+// ExampleAvailable is an example of Available()
+func ExampleAvailable() {
+	if Available() {
+		fmt.Println(CountryAt(-29.31, 27.48).Name)
+	}
+	// Output:Lesotho
+}
+
+// BenchmarkCountryAt benchmarks the method CountryAt()
+func BenchmarkCountryAt(b *testing.B) {
+	_ = CountryAt(0, 0)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = CountryAt(52.52, 13.405)
+	}
+}
+
+// BenchmarkCountryAt_Sea benchmarks the method CountryAt() in the open sea
+func BenchmarkCountryAt_Sea(b *testing.B) {
+	_ = CountryAt(0, 0)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = CountryAt(-30, -140)
+	}
+}
@@ -0,0 +1,289 @@
+// Package collate sorts strings in the alphabetical order of a language, without external dependencies.
+//
+// It follows the three levels of the Unicode Collation Algorithm: letters are compared first, regardless of
+// accents and case (so "Österreich" sorts with the O's in German), then accents, then case, lowercase first.
+// Latin letters sort before the other scripts, whose letters sort in code point order: the alphabetical order
+// of Greek, Cyrillic, Hebrew, Arabic, Devanagari, Hangul and kana, and the radical-stroke order of Han characters.
+// Chinese sorts the Han characters of the country names right after the Latin letters, by pinyin in the
+// simplified script and by stroke count in the traditional script (e.g., "zh-Hant", "zh-TW"), like CLDR.
+// Spaces and punctuation sort before every letter, so "Saint Lucia" sorts before "Saint-Martin" and "Sainte".
+//
+// The CLDR tailorings of the supported languages reorder the letters that they treat as distinct letters
+// (e.g., "Å" after "Z" in Swedish, "Ch" after "H" in Czech, "Ñ" after "N" in Spanish).
+package collate
+
+import (
+	"bytes"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/mrz1836/go-countries/internal/names"
+)
+
+// Primary weights are multiples of weightStep, so that tailored letters fit between two base letters
+const (
+	weightStep       = 16     // Number of primary weights reserved for every base letter and its tailored letters
+	weightSeparator  = 1      // Spaces and punctuation, collapsed to one separator between words
+	weightDigits     = 0x10   // Ordinal of the digit 0
+	weightLatin      = 0x100  // Ordinal of the letter a
+	weightHan        = 0x120  // Ordinal of the first Han character ordered by Chinese, after the letter z
+	weightOtherBase  = 0x1000 // Ordinal of the code point 0 for letters of other scripts
+	thaiConsonantMin = 'ก'    // Thai character ko kai
+	thaiConsonantMax = 'ฮ'    // Thai character ho nokhuk
+	thaiPrevowelMin  = 'เ'    // Thai character sara e
+	thaiPrevowelMax  = 'ไ'    // Thai character sara ai maimalai
+
+	chineseSimplified  = "zh"      // Key of the pinyin order of Han characters
+	chineseTraditional = "zh-hant" // Key of the stroke order of Han characters
+)
+
+// element is a collation element: a letter, digit, separator or combining mark with its weight at every level
+type element struct {
+	primary   uint32 // Letter, or 0 for combining marks
+	secondary uint16 // Accent, or 0 for unaccented letters
+	tertiary  uint8  // Case, 1 for uppercase
+}
+
+// Collator compares strings in the alphabetical order of a language
+type Collator struct {
+	letters   map[string]element // Primary and secondary weights of the letters tailored by the language
+	maxLength int                // Length in runes of the longest tailored letter (e.g., 3 for "dzs")
+	turkic    bool               // Whether "I" lowercases to "ı" and "İ" to "i"
+}
+
+// New returns a Collator for a language.
+//
+// This function performs the following steps:
+// - Reads the base language of a BCP 47 tag or POSIX locale (e.g., "sv" for "sv-FI" or "sv_SE.UTF-8")
+// - Assigns the letters of the CLDR tailoring of the language their weights after the letter they follow
+// - Assigns the Han characters of the Chinese country names their weights in pinyin or stroke order,
+// for Chinese in the simplified or traditional script
+//
+// Parameters:
+// - lang: language tag in any letter case (e.g., "de", "sv-SE", "tr")
+//
+// Returns:
+// - Collator for the language, using the root order when the language has no tailoring
+//
+// Side Effects:
+// - None
+//
+// Notes:
+// - Norwegian ("no") uses the tailoring of Norwegian Bokmål ("nb")
+// - Chinese uses the stroke order with the "Hant" script or the "TW", "HK" and "MO" regions, and pinyin otherwise
+// - A Collator is safe for concurrent use
+func New(lang string) *Collator {
+	base, subtags, _ := strings.Cut(strings.ToLower(strings.ReplaceAll(strings.TrimSpace(lang), "_", "-")), "-")
+
+	c := &Collator{letters: make(map[string]element), turkic: base == "tr" || base == "az"}
+	for _, rule := range tailorings[base] {
+		weight := c.weightOf(rule.after)
+		for _, group := range rule.letters {
+			weight++
+			for i, letter := range group {
+				c.letters[letter] = element{primary: weight, secondary: uint16(i)} //nolint:gosec // few variants
+				c.maxLength = max(c.maxLength, utf8.RuneCountInString(letter))
+			}
+		}
+	}
+
+	if base == chineseSimplified {
+		for i, r := range []rune(hanOrders[chineseOrder(subtags)]) {
+			c.letters[string(r)] = element{primary: uint32(weightHan+i) * weightStep} //nolint:gosec // few characters
+		}
+		c.maxLength = max(c.maxLength, 1)
+	}
+	return c
+}
+
+// chineseOrder returns the key of the Han order of Chinese from the subtags after "zh" (e.g., "hant-tw"):
+// the stroke order for the traditional script, which Taiwan, Hong Kong and Macao use by default
+func chineseOrder(subtags string) string {
+	for _, subtag := range strings.Split(subtags, "-") {
+		switch subtag {
+		case "hans":
+			return chineseSimplified
+		case "hant", "tw", "hk", "mo":
+			return chineseTraditional
+		}
+	}
+	return chineseSimplified
+}
+
+// Compare compares two strings in the alphabetical order of the language.
+//
+// Parameters:
+// - a: first string
+// - b: second string
+//
+// Returns:
+// - -1 when a sorts before b, 1 when a sorts after b, and 0 when both strings are equal
+//
+// Side Effects:
+// - None
+//
+// Notes:
+// - Strings that only differ in ignored characters (e.g., a trailing space) are ordered by their bytes,
+// so Compare only returns 0 for equal strings
+func (c *Collator) Compare(a, b string) int {
+	if result := bytes.Compare(c.Key(a), c.Key(b)); result != 0 {
+		return result
+	}
+	return strings.Compare(a, b)
+}
+
+// Key returns the sort key of a string.
+//
+// This function performs the following steps:
+// - Splits the string into collation elements: tailored letters, letters (expanding ligatures such as "æ"),
+// digits, combining marks and separators
+// - Appends the primary weights of every element, then their secondary weights, then their tertiary weights,
+// each level ending with a separator lower than every weight
+//
+// Parameters:
+// - s: string to sort
+//
+// Returns:
+// - Sort key whose byte order is the alphabetical order of the language
+//
+// Side Effects:
+// - None
+//
+// Notes:
+// - Sorting the keys of many strings with bytes.Compare is faster than calling Compare for every pair
+func (c *Collator) Key(s string) []byte {
+	elements := c.elements(s)
+
+	key := make([]byte, 0, 7*len(elements)+7)
+	for _, e := range elements {
+		if e.primary != 0 {
+			key = append(key, byte(e.primary>>24), byte(e.primary>>16), byte(e.primary>>8), byte(e.primary))
+		}
+	}
+	key = append(key, 0, 0, 0, 0)
+	for _, e := range elements {
+		key = append(key, byte((e.secondary+1)>>8), byte(e.secondary+1))
+	}
+	key = append(key, 0, 0)
+	for _, e := range elements {
+		key = append(key, e.tertiary+1)
+	}
+	return key
+}
+
+// elements splits a string into collation elements, without leading or trailing separators
+func (c *Collator) elements(s string) []element {
+	runes := reorderThai([]rune(s))
+	elements := make([]element, 0, len(runes))
+
+	for i := 0; i < len(runes); {
+		if e, length, ok := c.tailored(runes[i:]); ok {
+			elements = append(elements, e)
+			i += length
+			continue
+		}
+
+		r := runes[i]
+		i++
+		switch {
+		case unicode.IsMark(r):
+			elements = append(elements, element{secondary: uint16(r)}) //nolint:gosec // marks are in the BMP
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			elements = c.appendLetter(elements, r)
+		case len(elements) > 0 && elements[len(elements)-1].primary != weightSeparator:
+			elements = append(elements, element{primary: weightSeparator})
+		}
+	}
+
+	for len(elements) > 0 && elements[len(elements)-1].primary == weightSeparator {
+		elements = elements[:len(elements)-1]
+	}
+	return elements
+}
+
+// tailored matches the longest letter of the tailoring at the start of the runes
+func (c *Collator) tailored(runes []rune) (element, int, bool) {
+	for length := min(c.maxLength, len(runes)); length > 0; length-- {
+		letter := c.toLower(string(runes[:length]))
+		if e, ok := c.letters[letter]; ok {
+			if unicode.IsUpper(runes[0]) {
+				e.tertiary = 1
+			}
+			return e, length, true
+		}
+	}
+	return element{}, 0, false
+}
+
+// appendLetter appends the collation elements of a letter or digit that is not tailored
+func (c *Collator) appendLetter(elements []element, r rune) []element {
+	var tertiary uint8
+	if unicode.IsUpper(r) {
+		tertiary = 1
+	}
+	lower := []rune(c.toLower(string(r)))[0]
+
+	if base, ok := baseLetters[lower]; ok {
+		secondary := uint16(lower) //nolint:gosec // Greek and Cyrillic letters are in the BMP
+		return append(elements, element{primary: primaryOf(base), secondary: secondary, tertiary: tertiary})
+	}
+	if lower < utf8.RuneSelf || !unicode.Is(unicode.Latin, lower) && !unicode.IsDigit(lower) {
+		return append(elements, element{primary: primaryOf(lower), tertiary: tertiary})
+	}
+
+	// Latin letters with diacritics, ligatures and fullwidth forms sort as their ASCII letters, the accent second
+	folded := names.Fold(string(lower))
+	if folded == "" || folded == string(lower) {
+		return append(elements, element{primary: primaryOf(lower), tertiary: tertiary})
+	}
+	for i, b := range []byte(folded) {
+		e := element{primary: primaryOf(rune(b)), tertiary: tertiary}
+		if i == 0 {
+			e.secondary = uint16(lower) //nolint:gosec // Latin letters are in the BMP
+		}
+		elements = append(elements, e)
+	}
+	return elements
+}
+
+// weightOf returns the primary weight of a tailored or base letter
+func (c *Collator) weightOf(letter string) uint32 {
+	if e, ok := c.letters[letter]; ok {
+		return e.primary
+	}
+	r, _ := utf8.DecodeRuneInString(letter)
+	return primaryOf(r)
+}
+
+// toLower lowercases a string, with the dotted and dotless I of Turkic languages
+func (c *Collator) toLower(s string) string {
+	if c.turkic {
+		return strings.ToLowerSpecial(unicode.TurkishCase, s)
+	}
+	return strings.ToLower(s)
+}
+
+// primaryOf returns the primary weight of a lowercase letter or digit that is not tailored
+func primaryOf(r rune) uint32 {
+	switch {
+	case r >= '0' && r <= '9':
+		return uint32(weightDigits+r-'0') * weightStep
+	case r >= 'a' && r <= 'z':
+		return uint32(weightLatin+r-'a') * weightStep
+	default:
+		return uint32(weightOtherBase+r) * weightStep //nolint:gosec // runes are positive
+	}
+}
+
+// reorderThai moves the Thai vowels written before a consonant after it, as they are pronounced and sorted
+func reorderThai(runes []rune) []rune {
+	for i := 0; i+1 < len(runes); i++ {
+		if runes[i] >= thaiPrevowelMin && runes[i] <= thaiPrevowelMax &&
+			runes[i+1] >= thaiConsonantMin && runes[i+1] <= thaiConsonantMax {
+			runes[i], runes[i+1] = runes[i+1], runes[i]
+			i++
+		}
+	}
+	return runes
+}
@@ -0,0 +1,22 @@
+package collate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// FuzzCollator_Compare ensures Compare is antisymmetric and only returns 0 for equal strings.
+func FuzzCollator_Compare(f *testing.F) {
+	f.Add("sv", "Österrike", "Zambia")
+	f.Add("cs", "Chile", "CH")
+	f.Add("tr", "İran", "Irak")
+	f.Add("th", "เกาหลี", "เ")
+	f.Add("", "\xff", "á")
+	f.Fuzz(func(t *testing.T, lang, a, b string) {
+		c := New(lang)
+		result := c.Compare(a, b)
+		require.Equal(t, -result, c.Compare(b, a))
+		require.Equal(t, a == b, result == 0)
+	})
+}
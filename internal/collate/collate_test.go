@@ -0,0 +1,130 @@
+package collate
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestCollator_Compare tests Compare with pairs of strings that are in order in a language
+func TestCollator_Compare(t *testing.T) {
+	tests := []struct {
+		name   string
+		lang   string
+		before string
+		after  string
+	}{
+		{name: "Letters", lang: "en", before: "Albania", after: "Algeria"},
+		{name: "Case ignored for letters", lang: "en", before: "algeria", after: "Andorra"},
+		{name: "Lowercase first", lang: "en", before: "chad", after: "Chad"},
+		{name: "Accent ignored for letters", lang: "de", before: "Österreich", after: "Pakistan"},
+		{name: "Accent after base letter", lang: "de", before: "Oman", after: "Österreich"},
+		{name: "Accent second", lang: "fr", before: "Equateur", after: "Équateur"},
+		{name: "Space before letters", lang: "fr", before: "Saint Lucia", after: "Saint-Martin"},
+		{name: "Word separator before letters", lang: "fr", before: "Saint-Martin", after: "Sainte-Lucie"},
+		{name: "Ligature expanded", lang: "en", before: "Aeolia", after: "Æthiopia"},
+		{name: "Sharp s expanded", lang: "de", before: "Strasse", after: "Straße"},
+		{name: "Digits before letters", lang: "en", before: "Area 51", after: "Area A"},
+		{name: "Latin before Cyrillic", lang: "ru", before: "Zimbabwe", after: "Австрия"},
+		{name: "Cyrillic", lang: "ru", before: "Австрия", after: "Бельгия"},
+		{name: "Cyrillic yo with ye", lang: "ru", before: "Ёмен", after: "Ж"},
+		{name: "Greek tonos ignored", lang: "el", before: "Αίγυπτος", after: "Αλβανία"},
+		{name: "Swedish a ring after z", lang: "sv", before: "Zambia", after: "Åland"},
+		{name: "Swedish o umlaut last", lang: "sv", before: "Åland", after: "Österrike"},
+		{name: "German a ring with a", lang: "de", before: "Ålandinseln", after: "Albanien"},
+		{name: "Danish ae before o slash", lang: "da", before: "Æbleø", after: "Østrig"},
+		{name: "Danish o slash before a ring", lang: "da", before: "Østrig", after: "Åland"},
+		{name: "Norwegian alias", lang: "no", before: "Zambia", after: "Østerrike"},
+		{name: "Spanish n tilde", lang: "es", before: "Nueva Zelanda", after: "Ñandú"},
+		{name: "Czech ch after h", lang: "cs", before: "Honduras", after: "Chile"},
+		{name: "Czech c caron after c", lang: "cs", before: "Cypr", after: "Čad"},
+		{name: "Czech uppercase digraph", lang: "cs", before: "Hungary", after: "CHILE"},
+		{name: "Polish l stroke", lang: "pl", before: "Luksemburg", after: "Łotwa"},
+		{name: "Polish z dot last", lang: "pl", before: "Zambia", after: "Żółw"},
+		{name: "Hungarian cs after c", lang: "hu", before: "Costa Rica", after: "Csád"},
+		{name: "Hungarian dzs after dz", lang: "hu", before: "Dzaudzsikau", after: "Dzsibuti"},
+		{name: "Estonian z after s caron", lang: "et", before: "Šveits", after: "Zambia"},
+		{name: "Estonian o tilde after w", lang: "et", before: "Wallis", after: "Õun"},
+		{name: "Turkish dotless i before i", lang: "tr", before: "Irak", after: "İran"},
+		{name: "Turkish s cedilla after s", lang: "tr", before: "Suriye", after: "Şili"},
+		{name: "Romanian t comma", lang: "ro", before: "Turcia", after: "Țara"},
+		{name: "Croatian dz caron", lang: "hr", before: "Dubai", after: "Džibuti"},
+		{name: "Ukrainian i after y", lang: "uk", before: "Италія", after: "Індія"},
+		{name: "Serbian je after i", lang: "sr", before: "Италија", after: "Јапан"},
+		{name: "Persian pe after be", lang: "fa", before: "بلژیک", after: "پرو"},
+		{name: "Vietnamese a circumflex with tone", lang: "vi", before: "Azerbaijan", after: "Ấn Độ"},
+		{name: "Thai leading vowel", lang: "th", before: "กรีซ", after: "เกาหลี"},
+		{name: "Chinese pinyin", lang: "zh", before: "阿富汗", after: "不丹"},
+		{name: "Chinese pinyin of the next syllable", lang: "zh", before: "东帝汶", after: "中国"},
+		{name: "Chinese Latin before Han", lang: "zh", before: "Zambia", after: "阿富汗"},
+		{name: "Chinese Han of other names last", lang: "zh", before: "中国", after: "一"},
+		{name: "Simplified Chinese script", lang: "zh-Hans-TW", before: "阿富汗", after: "不丹"},
+		{name: "Traditional Chinese strokes", lang: "zh-Hant", before: "不丹", after: "阿富汗"},
+		{name: "Traditional Chinese same strokes", lang: "zh-Hant", before: "中國", after: "日本"},
+		{name: "Taiwan strokes", lang: "zh-TW", before: "土耳其", after: "中國"},
+		{name: "Hong Kong strokes", lang: "zh_HK", before: "土耳其", after: "中國"},
+		{name: "Unknown language", lang: "tlh", before: "Österreich", after: "Zambia"},
+		{name: "POSIX locale", lang: "sv_SE.UTF-8", before: "Zambia", after: "Österrike"},
+		{name: "Prefix first", lang: "en", before: "Guinea", after: "Guinea-Bissau"},
+		{name: "Trailing space", lang: "en", before: "Chad", after: "Chad "},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := New(tt.lang)
+			assert.Equal(t, -1, c.Compare(tt.before, tt.after))
+			assert.Equal(t, 1, c.Compare(tt.after, tt.before))
+			assert.Equal(t, 0, c.Compare(tt.before, tt.before))
+		})
+	}
+}
+
+// TestCollator_Key tests that keys compare like Compare
+func TestCollator_Key(t *testing.T) {
+	c := New("sv")
+	words := []string{"Österrike", "Zambia", "Åland", "Ägypten", "oman", "Oman", "Öland", ""}
+
+	sort.Slice(words, func(i, j int) bool { return c.Compare(words[i], words[j]) < 0 })
+
+	assert.Equal(t, []string{"", "oman", "Oman", "Zambia", "Åland", "Ägypten", "Öland", "Österrike"}, words)
+	for i := 1; i < len(words); i++ {
+		assert.Negative(t, bytes.Compare(c.Key(words[i-1]), c.Key(words[i])), words[i])
+	}
+}
+
+// TestTailorings tests that every tailored letter is lowercase and fits before the next base letter
+func TestTailorings(t *testing.T) {
+	for lang := range tailorings {
+		c := New(lang)
+		for letter, e := range c.letters {
+			assert.Equal(t, c.toLower(letter), letter, "%s in %s", letter, lang)
+			assert.Less(t, e.primary%weightStep, uint32(weightStep-1), "%s in %s", letter, lang)
+		}
+	}
+}
+
+// ExampleNew is an example of New()
+func ExampleNew() {
+	names := []string{"Österreich", "Zypern", "Oman", "Peru"}
+
+	german := New("de")
+	sort.Slice(names, func(i, j int) bool { return german.Compare(names[i], names[j]) < 0 })
+	fmt.Println(names)
+
+	swedish := New("sv")
+	sort.Slice(names, func(i, j int) bool { return swedish.Compare(names[i], names[j]) < 0 })
+	fmt.Println(names)
+	// Output:[Oman Österreich Peru Zypern]
+	// [Oman Peru Zypern Österreich]
+}
+
+// BenchmarkCollator_Key benchmarks the method Key()
+func BenchmarkCollator_Key(b *testing.B) {
+	c := New("cs")
+	for i := 0; i < b.N; i++ {
+		_ = c.Key("Středoafrická republika")
+	}
+}
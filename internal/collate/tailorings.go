@@ -0,0 +1,197 @@
+package collate
+
+// tailoring places letters that a language treats as distinct after another letter
+type tailoring struct {
+	after   string     // Lowercase base or tailored letter that the letters follow (e.g., "z")
+	letters [][]string // Lowercase letters in alphabetical order, each group sharing a primary weight (e.g., "ä" and "æ")
+}
+
+// baseLetters maps the lowercase Greek and Cyrillic letters with diacritics to their base letters,
+// the diacritic being compared second (Latin letters are folded with names.Fold instead)
+var baseLetters = map[rune]rune{ //nolint:gochecknoglobals // fixed lookup table
+	'ΐ': 'ι', 'ά': 'α', 'έ': 'ε', 'ή': 'η', 'ί': 'ι', 'ΰ': 'υ', 'ς': 'σ', 'ϊ': 'ι', 'ϋ': 'υ', 'ό': 'ο', 'ύ': 'υ', 'ώ': 'ω',
+	'ѐ': 'е', 'ё': 'е', 'ѝ': 'и',
+}
+
+// Letter groups shared by several languages
+var (
+	croatianTailoring = []tailoring{ //nolint:gochecknoglobals // fixed lookup table
+		{after: "c", letters: [][]string{{"č"}, {"ć"}}},
+		{after: "d", letters: [][]string{{"dž"}, {"đ"}}},
+		{after: "l", letters: [][]string{{"lj"}}},
+		{after: "n", letters: [][]string{{"nj"}}},
+		{after: "s", letters: [][]string{{"š"}}},
+		{after: "z", letters: [][]string{{"ž"}}},
+	}
+	norwegianTailoring = []tailoring{ //nolint:gochecknoglobals // fixed lookup table
+		{after: "z", letters: [][]string{{"æ", "ä"}, {"ø", "ö"}, {"å"}}},
+	}
+	swedishTailoring = []tailoring{ //nolint:gochecknoglobals // fixed lookup table
+		{after: "z", letters: [][]string{{"å"}, {"ä", "æ"}, {"ö", "ø"}}},
+	}
+	turkicTailoring = []tailoring{ //nolint:gochecknoglobals // fixed lookup table
+		{after: "c", letters: [][]string{{"ç"}}},
+		{after: "g", letters: [][]string{{"ğ"}}},
+		{after: "h", letters: [][]string{{"ı"}}},
+		{after: "o", letters: [][]string{{"ö"}}},
+		{after: "s", letters: [][]string{{"ş"}}},
+		{after: "u", letters: [][]string{{"ü"}}},
+	}
+)
+
+// tailorings lists the CLDR tailorings of the supported languages by ISO 639 code, in the order they apply
+var tailorings = map[string][]tailoring{ //nolint:gochecknoglobals // fixed lookup table
+	"az": turkicTailoring,
+	"bs": croatianTailoring,
+	"cs": {
+		{after: "c", letters: [][]string{{"č"}}},
+		{after: "h", letters: [][]string{{"ch"}}},
+		{after: "r", letters: [][]string{{"ř"}}},
+		{after: "s", letters: [][]string{{"š"}}},
+		{after: "z", letters: [][]string{{"ž"}}},
+	},
+	"da": norwegianTailoring,
+	"es": {
+		{after: "n", letters: [][]string{{"ñ"}}},
+	},
+	"et": {
+		{after: "s", letters: [][]string{{"š"}, {"z"}, {"ž"}}},
+		{after: "w", letters: [][]string{{"õ"}, {"ä"}, {"ö"}, {"ü"}}},
+	},
+	"fa": {
+		{after: "ب", letters: [][]string{{"پ"}}},
+		{after: "ج", letters: [][]string{{"چ"}}},
+		{after: "ز", letters: [][]string{{"ژ"}}},
+		{after: "ق", letters: [][]string{{"ک", "ك"}}},
+		{after: "ک", letters: [][]string{{"گ"}}},
+		{after: "ن", letters: [][]string{{"و"}, {"ه", "ة"}, {"ی", "ي", "ى"}}},
+	},
+	"fi": swedishTailoring,
+	"hr": croatianTailoring,
+	"hu": {
+		{after: "c", letters: [][]string{{"cs"}}},
+		{after: "d", letters: [][]string{{"dz"}, {"dzs"}}},
+		{after: "g", letters: [][]string{{"gy"}}},
+		{after: "l", letters: [][]string{{"ly"}}},
+		{after: "n", letters: [][]string{{"ny"}}},
+		{after: "o", letters: [][]string{{"ö", "ő"}}},
+		{after: "s", letters: [][]string{{"sz"}}},
+		{after: "t", letters: [][]string{{"ty"}}},
+		{after: "u", letters: [][]string{{"ü", "ű"}}},
+		{after: "z", letters: [][]string{{"zs"}}},
+	},
+	"lt": {
+		{after: "c", letters: [][]string{{"č"}}},
+		{after: "i", letters: [][]string{{"y"}}},
+		{after: "s", letters: [][]string{{"š"}}},
+		{after: "z", letters: [][]string{{"ž"}}},
+	},
+	"lv": {
+		{after: "c", letters: [][]string{{"č"}}},
+		{after: "g", letters: [][]string{{"ģ"}}},
+		{after: "k", letters: [][]string{{"ķ"}}},
+		{after: "l", letters: [][]string{{"ļ"}}},
+		{after: "n", letters: [][]string{{"ņ"}}},
+		{after: "s", letters: [][]string{{"š"}}},
+		{after: "z", letters: [][]string{{"ž"}}},
+	},
+	"mt": {
+		{after: "b", letters: [][]string{{"ċ"}}},
+		{after: "f", letters: [][]string{{"ġ"}}},
+		{after: "g", letters: [][]string{{"għ"}}},
+		{after: "h", letters: [][]string{{"ħ"}}},
+		{after: "y", letters: [][]string{{"ż"}}},
+	},
+	"nb": norwegianTailoring,
+	"nn": norwegianTailoring,
+	"no": norwegianTailoring,
+	"pl": {
+		{after: "a", letters: [][]string{{"ą"}}},
+		{after: "c", letters: [][]string{{"ć"}}},
+		{after: "e", letters: [][]string{{"ę"}}},
+		{after: "l", letters: [][]string{{"ł"}}},
+		{after: "n", letters: [][]string{{"ń"}}},
+		{after: "o", letters: [][]string{{"ó"}}},
+		{after: "s", letters: [][]string{{"ś"}}},
+		{after: "z", letters: [][]string{{"ź"}, {"ż"}}},
+	},
+	"ro": {
+		{after: "a", letters: [][]string{{"ă"}, {"â"}}},
+		{after: "i", letters: [][]string{{"î"}}},
+		{after: "s", letters: [][]string{{"ș", "ş"}}},
+		{after: "t", letters: [][]string{{"ț", "ţ"}}},
+	},
+	"sk": {
+		{after: "a", letters: [][]string{{"ä"}}},
+		{after: "c", letters: [][]string{{"č"}}},
+		{after: "h", letters: [][]string{{"ch"}}},
+		{after: "o", letters: [][]string{{"ô"}}},
+		{after: "s", letters: [][]string{{"š"}}},
+		{after: "z", letters: [][]string{{"ž"}}},
+	},
+	"sl": {
+		{after: "c", letters: [][]string{{"č"}, {"ć"}}},
+		{after: "d", letters: [][]string{{"đ"}}},
+		{after: "s", letters: [][]string{{"š"}}},
+		{after: "z", letters: [][]string{{"ž"}}},
+	},
+	"sr": append([]tailoring{
+		{after: "д", letters: [][]string{{"ђ"}}},
+		{after: "и", letters: [][]string{{"ј"}}},
+		{after: "л", letters: [][]string{{"љ"}}},
+		{after: "н", letters: [][]string{{"њ"}}},
+		{after: "т", letters: [][]string{{"ћ"}}},
+		{after: "ч", letters: [][]string{{"џ"}}},
+	}, croatianTailoring...),
+	"sv": swedishTailoring,
+	"tr": turkicTailoring,
+	"uk": {
+		{after: "г", letters: [][]string{{"ґ"}}},
+		{after: "е", letters: [][]string{{"є"}}},
+		{after: "и", letters: [][]string{{"і"}, {"ї"}}},
+	},
+	"ur": {
+		{after: "ب", letters: [][]string{{"پ"}}},
+		{after: "ت", letters: [][]string{{"ٹ"}}},
+		{after: "ج", letters: [][]string{{"چ"}}},
+		{after: "د", letters: [][]string{{"ڈ"}}},
+		{after: "ر", letters: [][]string{{"ڑ"}}},
+		{after: "ز", letters: [][]string{{"ژ"}}},
+		{after: "ق", letters: [][]string{{"ک", "ك"}}},
+		{after: "ک", letters: [][]string{{"گ"}}},
+		{after: "ن", letters: [][]string{{"ں"}, {"و"}, {"ہ", "ه"}, {"ھ"}, {"ی", "ي", "ى"}, {"ے"}}},
+	},
+	"vi": {
+		{after: "a", letters: [][]string{{"ă", "ắ", "ằ", "ẳ", "ẵ", "ặ"}, {"â", "ấ", "ầ", "ẩ", "ẫ", "ậ"}}},
+		{after: "d", letters: [][]string{{"đ"}}},
+		{after: "e", letters: [][]string{{"ê", "ế", "ề", "ể", "ễ", "ệ"}}},
+		{after: "o", letters: [][]string{{"ô", "ố", "ồ", "ổ", "ỗ", "ộ"}, {"ơ", "ớ", "ờ", "ở", "ỡ", "ợ"}}},
+		{after: "u", letters: [][]string{{"ư", "ứ", "ừ", "ử", "ữ", "ự"}}},
+	},
+}
+
+// hanOrders lists the Han characters of the Chinese country names in the CLDR order of Chinese (ICU 72):
+// pinyin for the simplified script and stroke count for the traditional script. They sort after the Latin
+// letters, and other Han characters sort after them in radical-stroke order.
+var hanOrders = map[string]string{ //nolint:gochecknoglobals // fixed lookup table
+	chineseSimplified: "阿埃爱愛安岸昂奥奧澳巴白百拜班保堡北贝貝本比別别宾賓冰波玻伯帛博不布埔部查朝赤垂" +
+		"茨达達大代丹旦诞誕岛島道得德地登迪帝蒂甸典丁东東都杜度敦顿頓多俄厄恩尔耳爾伐法梵" +
+		"非菲斐芬夫佛福富蓋甘干冈刚岡剛港哥格葛各根公共古瓜关關圭国國果哈海韩韓汗合和荷赫" +
+		"黑宏洪基及吉极極几幾济濟加迦尖柬疆角捷金津京喀卡开開凯科克肯库庫奎拉腊臘来來莱萊" +
+		"賴兰蘭朗劳勞老勒黎里力立利联聯寮列林陵靈领領留琉隆卢盧鲁魯路露律伦倫罗羅洛马馬买" +
+		"買麦麥曼毛茅美门門蒙孟米秘密棉缅緬明模摩莫墨慕拿那纳納奈南瑙內内嫩尼宁纽紐努挪诺" +
+		"諾帕彭蓬皮坡泊葡蒲浦普其奇千乔喬求酋区區群然日瑞撒萨薩塞賽三桑色瑟森沙山尚绍紹舌" +
+		"圣聖獅时時史士属屬斯苏蘇所索他塔台泰坦汤唐陶萄特提廷突图圖土吐托脫脱陀瓦外湾灣宛" +
+		"萬汪旺危威韦维維委文汶挝乌烏西希息席夏鲜香象小辛新行匈叙敘牙亚亞延扬洋也葉伊衣以" +
+		"意義印英屿與嶼约約越赞泽澤札乍寨长哲政支直治智中洲兹茲自子",
+	chineseTraditional: "丁几力三也千土士大子小山干门马不中丹乌內公内冈区厄及夫巴开支文日比毛牙贝韦东乍他" +
+		"代以兰加北卡卢古台史圣外宁尔尼布旦本札瓜瓦甘白皮立长乔买亚伊伐伦共关冰列刚匈印危" +
+		"各合吉吐圭地多安尖屿托扬汗汤百米约老耳自舌色行衣西伯佛克別利别努劳宏岛希库廷时杜" +
+		"来极求汪汶沙甸纳纽角貝赤辛达那里麦亞京來其典凯和国图坡坦垂奇奈委孟宛尚岡岸帕帛延" +
+		"所拉旺昂明東林果治泊法波泽直绍罗肯芬苏诞金門阿陀非俄保兹南叙哈奎威帝度律拜挝政查" +
+		"柬洋洛津洪洲济玻科突約美英茅迦迪酋香倫剛哥哲唐埃埔夏宾島席庫恩息拿挪時朗根格桑泰" +
+		"浦海烏爱特班琉留秘納紐索茨茲诺顿馬勒區國基密得捷敘曼梵紹维脫脱荷莫莱部都陵陶领麥" +
+		"勞博喀喬堡奥富属幾彭提敦斐斯普智朝棉森港湾然登缅联腊菲萄萊象買越開隆韩鲁黑塔塞奧" +
+		"意愛新極獅瑙瑞瑟萬群義聖萨葉葛葡蒂路道達頓圖嫩寨爾福維與蒙蒲蓋賓赫領鲜寮德慕摩撒" +
+		"模緬蓬誕魯黎墨澤澳盧諾賴赞嶼濟聯賽韓薩疆羅臘關蘇屬蘭露靈灣",
+}
@@ -0,0 +1,155 @@
+// Package names folds country and place names into a form that compares equal regardless of case,
+// diacritics, apostrophes, punctuation and spacing.
+//
+// It is shared by the code generator, which folds and normalizes the names stored in the lookup indexes,
+// and by the countries package, which folds and normalizes user input the same way before looking it up.
+package names
+
+import (
+	"strings"
+	"unicode"
+)
+
+// fullwidthOffset is the distance between the fullwidth forms of ASCII characters and ASCII (e.g., "Ａ" is U+FF21)
+const fullwidthOffset = 0xFEE0
+
+// Fold returns the comparison form of a name.
+//
+// This function performs the following steps:
+// - Lowercases every letter and replaces Latin letters with diacritics by their ASCII base letters (e.g., "Côte" to "cote")
+// - Drops apostrophes and the combining marks of Latin letters (e.g., "d'Ivoire" to "divoire"),
+// as well as stray combining marks that follow no letter
+// - Replaces fullwidth letters and digits and ligatures by their ASCII letters (e.g., "Ｊａｐａｎ" to "japan")
+// - Replaces ampersands by "and" and every other punctuation and space sequence by a single space,
+// trimming both ends
+//
+// Parameters:
+// - name: name in any script (e.g., "Korea, Republic of", "Åland Islands")
+//
+// Returns:
+// - Folded name made of lowercase letters and digits separated by single spaces (e.g., "korea republic of")
+//
+// Side Effects:
+// - None
+//
+// Notes:
+// - Letters of other scripts are lowercased but kept (e.g., "Россия" to "россия"), and so are their combining
+// marks, which tell names apart in scripts such as Devanagari (e.g., "टोंगा" and "टोगो")
+// - Names that are already folded are returned without allocating
+func Fold(name string) string {
+	if isFolded(name) {
+		return name
+	}
+
+	var b strings.Builder
+	b.Grow(len(name))
+	pendingSpace, keepMarks := false, false
+	for _, r := range name {
+		switch {
+		case isApostrophe(r):
+			continue
+		case unicode.IsMark(r):
+			if keepMarks {
+				b.WriteRune(r)
+			}
+		case r == '&' || r == '＆':
+			if b.Len() > 0 {
+				b.WriteByte(' ')
+			}
+			b.WriteString("and")
+			pendingSpace, keepMarks = true, false
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			if pendingSpace && b.Len() > 0 {
+				b.WriteByte(' ')
+			}
+			pendingSpace, keepMarks = false, unicode.IsLetter(r) && !unicode.Is(unicode.Latin, r)
+			r = unicode.ToLower(r)
+			if (r >= 'ａ' && r <= 'ｚ') || (r >= '０' && r <= '９') {
+				r -= fullwidthOffset
+			}
+			if folded, ok := foldTable[r]; ok {
+				b.WriteString(folded)
+			} else {
+				b.WriteRune(r)
+			}
+		default:
+			pendingSpace, keepMarks = true, false
+		}
+	}
+	return b.String()
+}
+
+// Normalize returns the lookup form of a country or city name.
+//
+// This function performs the following steps:
+// - Moves a trailing qualifier ending with "of", "of the" or "the" in front of the name,
+// after a comma or in parentheses (e.g., "Korea, Republic of" to "Republic of Korea")
+// - Folds the name with Fold (case, diacritics, apostrophes, punctuation and spacing)
+// - Drops a leading "the" (e.g., "The Gambia" to "gambia")
+//
+// Parameters:
+// - name: name in any format (e.g., "Bolivia (Plurinational State of)", "Bahamas, The")
+//
+// Returns:
+// - Normalized name (e.g., "plurinational state of bolivia", "bahamas")
+//
+// Side Effects:
+// - None
+//
+// Notes:
+// - Other qualifiers are kept in place (e.g., "Virgin Islands (U.S.)" to "virgin islands u s")
+// - "Republic of" is never dropped, as it tells countries apart (e.g., "Congo" and "Republic of the Congo")
+// - Normalized names without a leading "the" are returned without allocating
+func Normalize(name string) string {
+	name = Fold(invertQualifier(name))
+	for strings.HasPrefix(name, "the ") {
+		name = name[len("the "):]
+	}
+	return name
+}
+
+// invertQualifier moves a trailing qualifier such as ", Republic of" or " (Republic of)" in front of the name
+func invertQualifier(name string) string {
+	trimmed := strings.TrimSpace(name)
+
+	var base, qualifier string
+	if strings.HasSuffix(trimmed, ")") {
+		open := strings.LastIndexByte(trimmed, '(')
+		if open < 0 {
+			return name
+		}
+		base, qualifier = trimmed[:open], trimmed[open+1:len(trimmed)-1]
+	} else if comma := strings.LastIndexByte(trimmed, ','); comma >= 0 {
+		base, qualifier = trimmed[:comma], trimmed[comma+1:]
+	} else {
+		return name
+	}
+
+	folded := Fold(qualifier)
+	if folded != "the" && !strings.HasSuffix(folded, " of") && !strings.HasSuffix(folded, " of the") {
+		return name
+	}
+	return qualifier + " " + base
+}
+
+// isFolded reports whether the name only has lowercase ASCII letters and digits separated by single spaces
+func isFolded(name string) bool {
+	for i := 0; i < len(name); i++ {
+		switch c := name[i]; {
+		case c >= 'a' && c <= 'z', c >= '0' && c <= '9':
+		case c == ' ' && i > 0 && i < len(name)-1 && name[i-1] != ' ':
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// isApostrophe reports whether the rune is an apostrophe or a character commonly typed in its place
+func isApostrophe(r rune) bool {
+	switch r {
+	case '\'', '`', '´', 'ʻ', 'ʼ', '‘', '’', '＇':
+		return true
+	}
+	return false
+}
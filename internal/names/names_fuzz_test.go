@@ -0,0 +1,32 @@
+package names
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// FuzzFold ensures Fold is idempotent and always returns a folded name.
+func FuzzFold(f *testing.F) {
+	seed := []string{"Côte d'Ivoire", "Korea, Republic of", "  a  b ", "Россия", "\xff", ""}
+	for _, s := range seed {
+		f.Add(s)
+	}
+	f.Fuzz(func(t *testing.T, name string) {
+		folded := Fold(name)
+		require.Equal(t, folded, Fold(folded))
+	})
+}
+
+// FuzzNormalize ensures Normalize is idempotent and always returns a folded name.
+func FuzzNormalize(f *testing.F) {
+	seed := []string{"Korea, Republic of", "Bahamas, The", "The the Gambia", "(of)", ", the", "\xff", ""}
+	for _, s := range seed {
+		f.Add(s)
+	}
+	f.Fuzz(func(t *testing.T, name string) {
+		normalized := Normalize(name)
+		require.Equal(t, normalized, Fold(normalized))
+		require.Equal(t, normalized, Normalize(normalized))
+	})
+}
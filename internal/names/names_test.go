@@ -0,0 +1,121 @@
+package names
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestFold tests Fold with different names
+func TestFold(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{name: "Already folded", input: "germany", expected: "germany"},
+		{name: "Uppercase", input: "GERMANY", expected: "germany"},
+		{name: "Diacritics", input: "Côte d'Ivoire", expected: "cote divoire"},
+		{name: "Typographic apostrophe", input: "Côte d’Ivoire", expected: "cote divoire"},
+		{name: "Ring above", input: "Åland Islands", expected: "aland islands"},
+		{name: "Cedilla", input: "Curaçao", expected: "curacao"},
+		{name: "Combining marks", input: "Reúnion", expected: "reunion"},
+		{name: "Punctuation", input: "Korea, Republic of", expected: "korea republic of"},
+		{name: "Parentheses", input: "Bolivia (Plurinational State of)", expected: "bolivia plurinational state of"},
+		{name: "Hyphen", input: "Guinea-Bissau", expected: "guinea bissau"},
+		{name: "Spaces", input: "  United \t States  ", expected: "united states"},
+		{name: "Transliterated letters", input: "Straße Ærø Łódź Þórshöfn", expected: "strasse aero lodz thorshofn"},
+		{name: "Vietnamese", input: "Hà Nội", expected: "ha noi"},
+		{name: "Other scripts", input: "Россия", expected: "россия"},
+		{name: "Marks of other scripts", input: "टोंगा", expected: "टोंगा"},
+		{name: "Stray combining mark", input: "\u0301 Chad", expected: "chad"},
+		{name: "Digits", input: "Area 51", expected: "area 51"},
+		{name: "Ampersand", input: "Bosnia & Herzegovina", expected: "bosnia and herzegovina"},
+		{name: "Ampersand without spaces", input: "Trinidad&Tobago", expected: "trinidad and tobago"},
+		{name: "Fullwidth", input: "ＪＡＰＡＮ ２０２４", expected: "japan 2024"},
+		{name: "Ligature", input: "\uFB01ji", expected: "fiji"},
+		{name: "Only punctuation", input: " - ", expected: ""},
+		{name: "Empty", input: "", expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, Fold(tt.input))
+		})
+	}
+}
+
+// TestFold_Allocations tests that folded names are returned without allocating
+func TestFold_Allocations(t *testing.T) {
+	assert.Zero(t, testing.AllocsPerRun(10, func() { _ = Fold("united states of america") }))
+}
+
+// TestNormalize tests Normalize with different names
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{name: "Already normalized", input: "germany", expected: "germany"},
+		{name: "Folded", input: "Côte d’Ivoire", expected: "cote divoire"},
+		{name: "Qualifier after a comma", input: "Korea, Republic of", expected: "republic of korea"},
+		{name: "Qualifier ending with the", input: "Congo, The Democratic Republic of the", expected: "democratic republic of the congo"},
+		{name: "Qualifier in parentheses", input: "Bolivia (Plurinational State of)", expected: "plurinational state of bolivia"},
+		{name: "Qualifier with diacritics", input: "Bolivia, Plurinational State Of ", expected: "plurinational state of bolivia"},
+		{name: "Trailing the", input: "Bahamas, The", expected: "bahamas"},
+		{name: "Leading the", input: "The Gambia", expected: "gambia"},
+		{name: "Leading the in lowercase", input: "the netherlands", expected: "netherlands"},
+		{name: "The inside the name", input: "Saint Vincent and the Grenadines", expected: "saint vincent and the grenadines"},
+		{name: "Other comma", input: "Virgin Islands, U.S.", expected: "virgin islands u s"},
+		{name: "Other parentheses", input: "Falkland Islands (Malvinas)", expected: "falkland islands malvinas"},
+		{name: "Unbalanced parenthesis", input: "Korea Republic of)", expected: "korea republic of"},
+		{name: "Only the", input: "The", expected: "the"},
+		{name: "Empty", input: "", expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, Normalize(tt.input))
+		})
+	}
+}
+
+// TestNormalize_Allocations tests that normalized names are returned without allocating
+func TestNormalize_Allocations(t *testing.T) {
+	assert.Zero(t, testing.AllocsPerRun(10, func() { _ = Normalize("united states of america") }))
+}
+
+// ExampleFold is an example of Fold()
+func ExampleFold() {
+	fmt.Println(Fold("Côte d’Ivoire"))
+	// Output:cote divoire
+}
+
+// ExampleNormalize is an example of Normalize()
+func ExampleNormalize() {
+	fmt.Println(Normalize("Korea, Republic of"))
+	// Output:republic of korea
+}
+
+// BenchmarkFold benchmarks the method Fold()
+func BenchmarkFold(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_ = Fold("Bolivia (Plurinational State of)")
+	}
+}
+
+// BenchmarkFold_Folded benchmarks the method Fold() with a folded name
+func BenchmarkFold_Folded(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_ = Fold("united states of america")
+	}
+}
+
+// BenchmarkNormalize benchmarks the method Normalize()
+func BenchmarkNormalize(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_ = Normalize("Bolivia (Plurinational State of)")
+	}
+}
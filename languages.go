@@ -0,0 +1,99 @@
+package countries
+
+import (
+	"strings"
+)
+
+// Language is a single language (ISO 639)
+type Language struct {
+	Alpha2 string `json:"alpha-2"` // ISO 639-1 code (e.g., pt), empty when the language has none
+	Alpha3 string `json:"alpha-3"` // ISO 639-3 code (e.g., por)
+	Name   string `json:"name"`    // English name of the language
+}
+
+// CountryLanguage is a language spoken within a country
+type CountryLanguage struct {
+	Code     string `json:"code"`     // ISO 639-1 code, or ISO 639-3 code for languages without one (e.g., fil)
+	Official bool   `json:"official"` // Official language of the country (false when only widely spoken)
+}
+
+// GetLanguage retrieves a Language by its ISO 639-1 or ISO 639-3 code in a case-insensitive search.
+//
+// This function performs the following steps:
+// - Trims surrounding spaces and normalizes the provided code to lowercase
+// - Performs a constant-time map lookup using the normalized code
+//
+// - Returns the Language pointer on success
+// - Returns nil if no match is located
+//
+// Parameters:
+// - code: two-letter ISO 639-1 or three-letter ISO 639-3 code used for the lookup (e.g., "pt", "por")
+//
+// Returns:
+// - Pointer to the Language struct, or nil when no match is found
+//
+// Side Effects:
+// - None
+//
+// Notes:
+// - Only languages spoken in at least one country are included
+// - ISO 639-2/B bibliographic codes (e.g., "fre", "ger") are not recognized
+// - Returned pointer references package-level data without copying
+func GetLanguage(code string) *Language {
+	return byLanguageCode[strings.ToLower(strings.TrimSpace(code))]
+}
+
+// CountriesSpeaking provides every Country where the given language is official or widely spoken.
+//
+// This function performs the following steps:
+// - Resolves the language by its ISO 639-1 or ISO 639-3 code
+// - Looks up the countries grouped under the language code
+// - Copies the matching Country pointers into a new slice
+//
+// Parameters:
+// - code: two-letter ISO 639-1 or three-letter ISO 639-3 code used for the lookup (e.g., "es", "spa")
+//
+// Returns:
+// - CountryList in the same order as GetAll, or nil when the language is unknown
+//
+// Side Effects:
+// - None
+//
+// Notes:
+// - Use the Official flag of Country.Languages to keep only the countries where the language is official
+// - The Country pointers reference global data but the returned slice is a copy
+func CountriesSpeaking(code string) CountryList {
+	language := GetLanguage(code)
+	if language == nil {
+		return nil
+	}
+	return append(CountryList(nil), countriesByLanguage[language.code()]...)
+}
+
+// Language returns the most likely Language spoken in the Country.
+//
+// Parameters:
+// - None
+//
+// Returns:
+// - Pointer to the Language struct, or nil when the country has no language (e.g., Antarctica)
+//
+// Side Effects:
+// - None
+//
+// Notes:
+// - The most likely language is the first entry of the Languages field and is usually official
+func (c *Country) Language() *Language {
+	if len(c.Languages) == 0 {
+		return nil
+	}
+	return byLanguageCode[c.Languages[0].Code]
+}
+
+// code returns the code used by CountryLanguage: ISO 639-1 when available, ISO 639-3 otherwise
+func (l *Language) code() string {
+	if l.Alpha2 != "" {
+		return l.Alpha2
+	}
+	return l.Alpha3
+}
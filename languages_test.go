@@ -0,0 +1,161 @@
+package countries
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testLanguageCode = "pt"
+
+// TestLanguages_Loaded tests that the language data is preloaded and consistent
+func TestLanguages_Loaded(t *testing.T) {
+	require.NotEmpty(t, languages)
+	require.NotEmpty(t, byLanguageCode)
+
+	for _, l := range languages {
+		assert.Len(t, l.Alpha3, 3)
+		assert.NotEmpty(t, l.Name, "language %s has no name", l.Alpha3)
+		assert.Equal(t, l, GetLanguage(l.Alpha3))
+		if l.Alpha2 != "" {
+			assert.Len(t, l.Alpha2, 2)
+			assert.Equal(t, l, GetLanguage(l.Alpha2))
+		}
+	}
+
+	// Every language of every country is known and lists the country
+	for _, c := range countries {
+		for _, cl := range c.Languages {
+			l := GetLanguage(cl.Code)
+			require.NotNil(t, l, "country %s speaks unknown language %s", c.Alpha2, cl.Code)
+			assert.Equal(t, cl.Code, l.code())
+			assert.Contains(t, CountriesSpeaking(cl.Code), c)
+		}
+	}
+}
+
+// TestGetLanguage_VariousFormats tests GetLanguage with different input formats
+func TestGetLanguage_VariousFormats(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		expected  string
+		expectNil bool
+	}{
+		{name: "ISO 639-1", input: testLanguageCode, expected: "Portuguese"},
+		{name: "ISO 639-3", input: "por", expected: "Portuguese"},
+		{name: "Uppercase", input: "PT", expected: "Portuguese"},
+		{name: "Surrounding spaces", input: " es ", expected: "Spanish"},
+		{name: "Macrolanguage", input: "zh", expected: "Chinese"},
+		{name: "Without ISO 639-1 code", input: "fil", expected: "Filipino"},
+		{name: "Bibliographic code", input: "fre", expectNil: true},
+		{name: "Unknown", input: "xx", expectNil: true},
+		{name: "Empty", input: "", expectNil: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := GetLanguage(tt.input)
+			if tt.expectNil {
+				require.Nil(t, l)
+				return
+			}
+
+			require.NotNil(t, l)
+			assert.Equal(t, tt.expected, l.Name)
+		})
+	}
+}
+
+// ExampleGetLanguage is an example of GetLanguage()
+func ExampleGetLanguage() {
+	l := GetLanguage(testLanguageCode)
+	fmt.Printf("language: %s alpha-2: %s alpha-3: %s", l.Name, l.Alpha2, l.Alpha3)
+	// Output:language: Portuguese alpha-2: pt alpha-3: por
+}
+
+// BenchmarkGetLanguage benchmarks the method GetLanguage()
+func BenchmarkGetLanguage(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_ = GetLanguage(testLanguageCode)
+	}
+}
+
+// TestCountriesSpeaking tests the CountriesSpeaking function
+func TestCountriesSpeaking(t *testing.T) {
+	t.Run("official and widely spoken", func(t *testing.T) {
+		list := CountriesSpeaking("es")
+		assert.Contains(t, list, GetByAlpha2("ES"))
+		assert.Contains(t, list, GetByAlpha2("MX"))
+		assert.Contains(t, list, GetByAlpha2(testCountryAlpha2))
+		assert.NotContains(t, list, GetByAlpha2("FR"))
+	})
+
+	t.Run("ISO 639-3 code", func(t *testing.T) {
+		assert.Equal(t, CountriesSpeaking("pt"), CountriesSpeaking("POR"))
+	})
+
+	t.Run("without ISO 639-1 code", func(t *testing.T) {
+		list := CountriesSpeaking("fil")
+		require.Len(t, list, 1)
+		assert.Equal(t, "PH", list[0].Alpha2)
+	})
+
+	t.Run("unknown", func(t *testing.T) {
+		assert.Empty(t, CountriesSpeaking("xx"))
+	})
+
+	t.Run("returns a copy", func(t *testing.T) {
+		list := CountriesSpeaking("en")
+		list[0] = &Country{Alpha2: "XX"}
+		assert.NotEqual(t, "XX", CountriesSpeaking("en")[0].Alpha2)
+	})
+}
+
+// ExampleCountriesSpeaking is an example of CountriesSpeaking()
+func ExampleCountriesSpeaking() {
+	for _, c := range CountriesSpeaking("pt") {
+		for _, l := range c.Languages {
+			if l.Code == "pt" && l.Official {
+				fmt.Println(c.Alpha2)
+			}
+		}
+	}
+	// Output:AO
+	// BR
+	// CV
+	// GQ
+	// GW
+	// MO
+	// MZ
+	// PT
+	// ST
+	// TL
+}
+
+// BenchmarkCountriesSpeaking benchmarks the method CountriesSpeaking()
+func BenchmarkCountriesSpeaking(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_ = CountriesSpeaking(testLanguageCode)
+	}
+}
+
+// TestCountry_Languages tests the Languages field and the Language method
+func TestCountry_Languages(t *testing.T) {
+	canada := GetByAlpha2Code(Alpha2CA)
+	assert.Equal(t, []CountryLanguage{{Code: "en", Official: true}, {Code: "fr", Official: true}}, canada.Languages)
+	assert.Equal(t, "English", canada.Language().Name)
+
+	switzerland := GetByAlpha2("CH")
+	require.NotEmpty(t, switzerland.Languages)
+	assert.Equal(t, CountryLanguage{Code: "de", Official: true}, switzerland.Languages[0])
+
+	assert.Equal(t, "Japanese", GetByAlpha2("JP").Language().Name)
+	assert.Equal(t, "Portuguese", GetByAlpha2("BR").Language().Name)
+
+	antarctica := GetByAlpha2("AQ")
+	assert.Nil(t, antarctica.Languages)
+	assert.Nil(t, antarctica.Language())
+}
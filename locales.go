@@ -0,0 +1,145 @@
+package countries
+
+import (
+	"strings"
+)
+
+// worldRegionCode is the UN M.49 code of the whole world (e.g., "en-001")
+const worldRegionCode = "001"
+
+// GetByLanguageTag resolves the region subtag of a BCP 47 language tag to its countries.
+//
+// This function performs the following steps:
+// - Splits the tag into subtags and skips the language, extended language and script subtags
+// - Reads the region subtag, either an ISO 3166-1 alpha-2 code ("BR") or a UN M.49 numeric code ("419")
+// - Resolves an alpha-2 or ISO 3166-1 numeric code to a single country
+// - Resolves a UN M.49 area code to every country of that region, sub-region or intermediate region
+//
+// Parameters:
+// - tag: BCP 47 language tag in any letter case (e.g., "pt-BR", "es-419", "zh-Hant-TW")
+//
+// Returns:
+// - CountryList with one country for a country region, every country of the area for an M.49 region,
+// or nil when the tag has no known region
+//
+// Side Effects:
+// - None
+//
+// Notes:
+// - Areas reuse the RegionCode, SubRegionCode and IntermediateRegionCode of each country, and "001" is the world
+// - M.49 groupings without countries in the data (e.g., "003" North America) return nil
+// - Tags without a region ("pt"), private use tags ("x-...") and malformed tags return nil
+// - The Country pointers reference global data but the returned slice is a copy
+func GetByLanguageTag(tag string) CountryList {
+	region := regionSubtag(strings.Split(strings.TrimSpace(tag), "-"))
+
+	switch {
+	case len(region) == 2:
+		if c := GetByAlpha2(region); c != nil {
+			return CountryList{c}
+		}
+	case region == worldRegionCode:
+		return GetAll()
+	case region != "":
+		if c := GetByCountryCode(region); c != nil {
+			return CountryList{c}
+		}
+		return append(CountryList(nil), countriesByRegionCode[region]...)
+	}
+	return nil
+}
+
+// GetByLocale resolves the region of a locale identifier to its countries.
+//
+// This function performs the following steps:
+// - Strips the POSIX codeset and modifier (e.g., ".UTF-8", "@euro")
+// - Converts underscores to hyphens so POSIX and ICU locales ("pt_BR", "es_419") become BCP 47 tags
+// - Resolves the region subtag with GetByLanguageTag
+//
+// Parameters:
+// - locale: BCP 47 tag or POSIX/ICU locale identifier (e.g., "pt-BR", "es_419", "de_DE.UTF-8@euro")
+//
+// Returns:
+// - CountryList with one country for a country region, every country of the area for an M.49 region,
+// or nil when the locale has no known region
+//
+// Side Effects:
+// - None
+//
+// Notes:
+// - The special "C" and "POSIX" locales have no region and return nil
+// - The Country pointers reference global data but the returned slice is a copy
+func GetByLocale(locale string) CountryList {
+	locale = strings.TrimSpace(locale)
+	if i := strings.IndexAny(locale, ".@"); i >= 0 {
+		locale = locale[:i]
+	}
+	return GetByLanguageTag(strings.ReplaceAll(locale, "_", "-"))
+}
+
+// scriptSubtag returns the script subtag of a BCP 47 tag, lowercased, or an empty string when it has none
+func scriptSubtag(subtags []string) string {
+	if len(subtags) < 2 || len(subtags[0]) > 3 {
+		return ""
+	}
+
+	i := 1
+	for n := 0; n < 3 && i < len(subtags) && len(subtags[i]) == 3 && isLetters(subtags[i]); n++ {
+		i++
+	}
+	if i < len(subtags) && len(subtags[i]) == 4 && isLetters(subtags[i]) {
+		return strings.ToLower(subtags[i])
+	}
+	return ""
+}
+
+// regionSubtag returns the region subtag of a BCP 47 tag, uppercased, or an empty string when it has none
+//
+// The region follows the language, up to three extended language subtags and an optional script subtag.
+func regionSubtag(subtags []string) string {
+	if len(subtags) < 2 || len(subtags[0]) < 2 || len(subtags[0]) > 8 || !isLetters(subtags[0]) {
+		return ""
+	}
+
+	i := 1
+	if len(subtags[0]) <= 3 {
+		for n := 0; n < 3 && i < len(subtags) && len(subtags[i]) == 3 && isLetters(subtags[i]); n++ {
+			i++
+		}
+	}
+	if i < len(subtags) && len(subtags[i]) == 4 && isLetters(subtags[i]) {
+		i++
+	}
+	if i == len(subtags) {
+		return ""
+	}
+
+	switch region := subtags[i]; {
+	case len(region) == 2 && isLetters(region):
+		return strings.ToUpper(region)
+	case len(region) == 3 && isDigits(region):
+		return region
+	default:
+		return ""
+	}
+}
+
+// isLetters reports whether the value is made of ASCII letters only
+func isLetters(value string) bool {
+	for i := 0; i < len(value); i++ {
+		if ch := value[i] | 0x20; ch < 'a' || ch > 'z' {
+			return false
+		}
+	}
+	return true
+}
+
+// isDigits reports whether the value is made of ASCII digits only
+func isDigits(value string) bool {
+	for i := 0; i < len(value); i++ {
+		if value[i] < '0' || value[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
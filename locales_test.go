@@ -0,0 +1,161 @@
+package countries
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testLanguageTag = "pt-BR"
+
+// TestGetByLanguageTag tests GetByLanguageTag with country and UN M.49 region subtags
+func TestGetByLanguageTag(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected []string
+	}{
+		{name: "Language and region", input: testLanguageTag, expected: []string{"BR"}},
+		{name: "Lowercase region", input: "en-gb", expected: []string{"GB"}},
+		{name: "Uppercase language", input: "FR-CA", expected: []string{"CA"}},
+		{name: "Script", input: "zh-Hant-TW", expected: []string{"TW"}},
+		{name: "Extended language", input: "zh-yue-HK", expected: []string{"HK"}},
+		{name: "Three-letter language", input: "fil-PH", expected: []string{"PH"}},
+		{name: "Variant", input: "de-CH-1996", expected: []string{"CH"}},
+		{name: "Extension", input: "en-US-u-ca-gregory", expected: []string{testCountryAlpha2}},
+		{name: "ISO numeric country", input: "pt-076", expected: []string{"BR"}},
+		{name: "Undetermined language", input: "und-JP", expected: []string{"JP"}},
+		{name: "Surrounding spaces", input: " es-MX ", expected: []string{"MX"}},
+		{name: "No region", input: "pt", expected: nil},
+		{name: "Script only", input: "sr-Latn", expected: nil},
+		{name: "Unknown region", input: "en-ZZ", expected: nil},
+		{name: "Unknown area", input: "en-003", expected: nil},
+		{name: "Private use", input: "x-BR", expected: nil},
+		{name: "Underscore", input: "pt_BR", expected: nil},
+		{name: "Empty", input: "", expected: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			list := GetByLanguageTag(tt.input)
+			if tt.expected == nil {
+				require.Nil(t, list)
+				return
+			}
+
+			alpha2 := make([]string, 0, len(list))
+			for _, c := range list {
+				alpha2 = append(alpha2, c.Alpha2)
+			}
+			assert.Equal(t, tt.expected, alpha2)
+		})
+	}
+}
+
+// TestGetByLanguageTag_Areas tests GetByLanguageTag with UN M.49 area codes
+func TestGetByLanguageTag_Areas(t *testing.T) {
+	t.Run("sub-region", func(t *testing.T) {
+		list := GetByLanguageTag("es-419")
+		assert.Contains(t, list, GetByAlpha2("MX"))
+		assert.Contains(t, list, GetByAlpha2("AR"))
+		assert.Contains(t, list, GetByAlpha2("BR"))
+		assert.NotContains(t, list, GetByAlpha2("ES"))
+		assert.NotContains(t, list, GetByAlpha2(testCountryAlpha2))
+		for _, c := range list {
+			assert.Equal(t, "419", c.SubRegionCode)
+		}
+	})
+
+	t.Run("region", func(t *testing.T) {
+		list := GetByLanguageTag("fr-150")
+		assert.Contains(t, list, GetByAlpha2("FR"))
+		for _, c := range list {
+			assert.Equal(t, "150", c.RegionCode)
+		}
+	})
+
+	t.Run("intermediate region", func(t *testing.T) {
+		list := GetByLanguageTag("en-029")
+		assert.Contains(t, list, GetByAlpha2("JM"))
+		for _, c := range list {
+			assert.Equal(t, "029", c.IntermediateRegionCode)
+		}
+	})
+
+	t.Run("world", func(t *testing.T) {
+		assert.Equal(t, GetAll(), GetByLanguageTag("en-001"))
+	})
+
+	t.Run("returns a copy", func(t *testing.T) {
+		list := GetByLanguageTag("es-419")
+		list[0] = &Country{Alpha2: "XX"}
+		assert.NotEqual(t, "XX", GetByLanguageTag("es-419")[0].Alpha2)
+	})
+}
+
+// ExampleGetByLanguageTag is an example of GetByLanguageTag()
+func ExampleGetByLanguageTag() {
+	c := GetByLanguageTag(testLanguageTag)[0]
+	fmt.Printf("country: %s", c.Name)
+	// Output:country: Brazil
+}
+
+// BenchmarkGetByLanguageTag benchmarks the method GetByLanguageTag()
+func BenchmarkGetByLanguageTag(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_ = GetByLanguageTag(testLanguageTag)
+	}
+}
+
+// TestGetByLocale tests GetByLocale with BCP 47, POSIX and ICU locale identifiers
+func TestGetByLocale(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+		count    int
+	}{
+		{name: "BCP 47", input: testLanguageTag, expected: "BR", count: 1},
+		{name: "POSIX", input: "pt_BR", expected: "BR", count: 1},
+		{name: "POSIX with codeset", input: "de_DE.UTF-8", expected: "DE", count: 1},
+		{name: "POSIX with modifier", input: "de_AT@euro", expected: "AT", count: 1},
+		{name: "POSIX with codeset and modifier", input: "sr_RS.UTF-8@latin", expected: "RS", count: 1},
+		{name: "ICU with script", input: "zh_Hans_CN", expected: "CN", count: 1},
+		{name: "M.49 area", input: "es_419", expected: "AI", count: len(GetByLanguageTag("es-419"))},
+		{name: "C locale", input: "C", count: 0},
+		{name: "POSIX locale", input: "POSIX", count: 0},
+		{name: "C with codeset", input: "C.UTF-8", count: 0},
+		{name: "Language only", input: "en", count: 0},
+		{name: "Empty", input: "", count: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			list := GetByLocale(tt.input)
+			require.Len(t, list, tt.count)
+			if tt.count > 0 {
+				assert.Equal(t, tt.expected, list[0].Alpha2)
+			}
+		})
+	}
+}
+
+// ExampleGetByLocale is an example of GetByLocale()
+func ExampleGetByLocale() {
+	for _, c := range GetByLocale("es_419") {
+		if c.Alpha2 == "MX" || c.Alpha2 == "BR" {
+			fmt.Println(c.Name)
+		}
+	}
+	// Output:Brazil
+	// Mexico
+}
+
+// BenchmarkGetByLocale benchmarks the method GetByLocale()
+func BenchmarkGetByLocale(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_ = GetByLocale("pt_BR.UTF-8")
+	}
+}
@@ -0,0 +1,187 @@
+package countries
+
+import (
+	"strings"
+	"sync"
+)
+
+// NativeName is the name of a country in one of its official languages (endonym)
+type NativeName struct {
+	Language string `json:"language"` // ISO 639 code of the language (e.g., "de", "gsw")
+	Name     string `json:"name"`     // Name of the country in that language (e.g., "Schweiz")
+}
+
+// localizedLanguageAliases maps the language tags that the subtag fallback cannot resolve to a supported language
+var localizedLanguageAliases = map[string]string{ //nolint:gochecknoglobals // fixed lookup table
+	"no":    "nb",      // Norwegian is written in Bokmål unless Nynorsk is requested
+	"zh-hk": "zh-hant", // Hong Kong, Macao and Taiwan write Chinese in the traditional script
+	"zh-mo": "zh-hant",
+	"zh-tw": "zh-hant",
+}
+
+// loadLocalizedIndex builds the reverse index of localized and native names on the first lookup
+var loadLocalizedIndex = sync.OnceValue(buildLocalizedIndex) //nolint:gochecknoglobals // lazily built index
+
+// LocalizedName returns the name of the Country in a language.
+//
+// This function performs the following steps:
+// - Converts POSIX locales to BCP 47 tags and lowercases the tag (e.g., "de_DE.UTF-8" to "de-de")
+// - Looks up the most specific supported tag, dropping trailing subtags one by one (e.g., "zh-Hant-TW" to "zh-Hant",
+// "de-AT" to "de")
+// - Falls back to the native name of the Country in that language when the language has no translations
+// (e.g., "rm" for Switzerland)
+//
+// Parameters:
+// - lang: BCP 47 language tag or POSIX locale in any letter case (e.g., "de", "pt-BR", "zh-TW", "fr_CA")
+//
+// Returns:
+// - Name of the Country in the language (e.g., "Deutschland" for Germany and "Vereinigte Staaten" for
+// the United States in "de"), or an empty string when the language is not supported
+//
+// Side Effects:
+// - None
+//
+// Notes:
+// - LocalizedLanguages lists the supported languages: the six UN languages, the 24 EU languages and other
+// widely used languages, from Unicode CLDR
+// - Chinese uses the simplified script ("zh", "zh-CN", "zh-SG") unless the traditional script is requested
+// ("zh-Hant", "zh-TW", "zh-HK", "zh-MO"), and Norwegian ("no") uses Norwegian Bokmål ("nb")
+// - Regional variants share the names of their language (e.g., "pt-PT" returns the Brazilian Portuguese names)
+func (c *Country) LocalizedName(lang string) string {
+	if tag := resolveLanguage(lang, isLocalizedLanguage); tag != "" {
+		if name, ok := localizedNames[tag][c.Alpha2]; ok {
+			return name
+		}
+	}
+
+	base, _, _ := strings.Cut(normalizeLanguageTag(lang), "-")
+	for _, native := range c.NativeNames {
+		if native.Language == base {
+			return native.Name
+		}
+	}
+	return ""
+}
+
+// GetByLocalizedName retrieves a Country by its name in a language, ignoring case, diacritics and punctuation.
+//
+// This function performs the following steps:
+// - Resolves the language like LocalizedName (e.g., "de-AT" to "de", "zh-TW" to "zh-Hant")
+// - Normalizes the name with NormalizeName (e.g., "Österreich" to "osterreich")
+// - Looks the name up among the localized names and the native names in that language
+// - Looks the name up among the names of every language when lang is empty
+//
+// Parameters:
+// - name: name of the country in the language (e.g., "Vereinigte Staaten", "États-Unis", "Deutschland")
+// - lang: BCP 47 language tag or POSIX locale (e.g., "de", "fr_CA"), or an empty string for any language
+//
+// Returns:
+// - Pointer to the Country struct, or nil when the name or the language is unknown
+//
+// Side Effects:
+// - Builds the reverse index of the localized and native names on the first call
+//
+// Notes:
+// - Native names are searched in their own language as well (e.g., "Svizra" in "rm" finds Switzerland)
+// - When lang is empty and several languages use a name for different countries, the name in the language
+// sorted first wins; pass the language to disambiguate
+// - English names (ISO, common and official names and aliases) are found with GetByName
+// - Safe for concurrent use; the result references the internal Country struct without copying
+func GetByLocalizedName(name, lang string) *Country {
+	index := loadLocalizedIndex()
+
+	tag := ""
+	if strings.TrimSpace(lang) != "" {
+		if tag = resolveLanguage(lang, func(tag string) bool { return index[tag] != nil }); tag == "" {
+			return nil
+		}
+	}
+	return index[tag][NormalizeName(name)]
+}
+
+// LocalizedLanguages returns the language tags supported by LocalizedName, sorted alphabetically.
+//
+// Parameters:
+// - None
+//
+// Returns:
+// - Slice of BCP 47 language tags (e.g., "ar", "de", "zh", "zh-Hant")
+//
+// Side Effects:
+// - None
+//
+// Notes:
+// - The returned slice is a copy and can be modified freely
+func LocalizedLanguages() []string {
+	return append([]string(nil), localizedLanguages...)
+}
+
+// isLocalizedLanguage reports whether the lowercase tag has translations
+func isLocalizedLanguage(tag string) bool {
+	_, ok := localizedNames[tag]
+	return ok
+}
+
+// normalizeLanguageTag strips the POSIX codeset and modifier of a locale and lowercases it as a BCP 47 tag
+func normalizeLanguageTag(lang string) string {
+	lang = strings.TrimSpace(lang)
+	if i := strings.IndexAny(lang, ".@"); i >= 0 {
+		lang = lang[:i]
+	}
+	return strings.ToLower(strings.ReplaceAll(lang, "_", "-"))
+}
+
+// resolveLanguage returns the most specific known tag of a language tag, dropping trailing subtags one by one,
+// or an empty string when neither the tag nor its prefixes are known
+func resolveLanguage(lang string, known func(tag string) bool) string {
+	for tag := normalizeLanguageTag(lang); tag != ""; {
+		if known(tag) {
+			return tag
+		}
+		if alias, ok := localizedLanguageAliases[tag]; ok && known(alias) {
+			return alias
+		}
+
+		i := strings.LastIndexByte(tag, '-')
+		if i < 0 {
+			break
+		}
+		tag = tag[:i]
+	}
+	return ""
+}
+
+// buildLocalizedIndex indexes the normalized localized and native names by lowercase language tag,
+// and every name of every language under the empty tag, keeping the first country of each name
+func buildLocalizedIndex() map[string]map[string]*Country {
+	index := map[string]map[string]*Country{"": {}}
+	add := func(tag, name string, c *Country) {
+		key := NormalizeName(name)
+		if key == "" {
+			return
+		}
+		if index[tag] == nil {
+			index[tag] = make(map[string]*Country)
+		}
+		for _, names := range []map[string]*Country{index[tag], index[""]} {
+			if _, exists := names[key]; !exists {
+				names[key] = c
+			}
+		}
+	}
+
+	for _, language := range localizedLanguages {
+		tag := strings.ToLower(language)
+		for _, c := range countries {
+			if name, ok := localizedNames[tag][c.Alpha2]; ok {
+				add(tag, name, c)
+			}
+		}
+	}
+	for _, c := range countries {
+		for _, native := range c.NativeNames {
+			add(native.Language, native.Name, c)
+		}
+	}
+	return index
+}
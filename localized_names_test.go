@@ -0,0 +1,270 @@
+package countries
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestLocalizedNames_Loaded tests that every supported language names every country
+func TestLocalizedNames_Loaded(t *testing.T) {
+	require.Len(t, localizedNames, len(localizedLanguages))
+	require.GreaterOrEqual(t, len(localizedLanguages), 30)
+
+	for _, language := range localizedLanguages {
+		names, ok := localizedNames[strings.ToLower(language)]
+		require.True(t, ok, language)
+		for _, c := range countries {
+			assert.NotEmpty(t, names[c.Alpha2], "%s in %s", c.Alpha2, language)
+		}
+	}
+}
+
+// TestLocalizedLanguages tests the supported languages and that the result is a copy
+func TestLocalizedLanguages(t *testing.T) {
+	languages := LocalizedLanguages()
+
+	// The six UN languages and the 24 EU languages
+	for _, language := range []string{
+		"ar", "en", "es", "fr", "ru", "zh",
+		"bg", "cs", "da", "de", "el", "et", "fi", "ga", "hr", "hu", "it", "lt", "lv", "mt", "nl", "pl", "pt", "ro",
+		"sk", "sl", "sv",
+	} {
+		assert.Contains(t, languages, language)
+	}
+	assert.Contains(t, languages, "zh-Hant")
+	assert.IsIncreasing(t, languages)
+
+	languages[0] = "xx"
+	assert.NotEqual(t, "xx", LocalizedLanguages()[0])
+}
+
+// TestCountry_LocalizedName tests LocalizedName with different countries and language tags
+func TestCountry_LocalizedName(t *testing.T) {
+	tests := []struct {
+		name     string
+		alpha2   string
+		lang     string
+		expected string
+	}{
+		{name: "German", alpha2: "DE", lang: "de", expected: "Deutschland"},
+		{name: "German name of the US", alpha2: "US", lang: "de", expected: "Vereinigte Staaten"},
+		{name: "French", alpha2: "US", lang: "fr", expected: "États-Unis"},
+		{name: "Spanish", alpha2: "DE", lang: "es", expected: "Alemania"},
+		{name: "Russian", alpha2: "DE", lang: "ru", expected: "Германия"},
+		{name: "Arabic", alpha2: "EG", lang: "ar", expected: "مصر"},
+		{name: "English", alpha2: "CI", lang: "en", expected: "Côte d’Ivoire"},
+		{name: "Uppercase tag", alpha2: "DE", lang: "DE", expected: "Deutschland"},
+		{name: "Region subtag", alpha2: "DE", lang: "de-AT", expected: "Deutschland"},
+		{name: "POSIX locale", alpha2: "DE", lang: "fr_CA.UTF-8", expected: "Allemagne"},
+		{name: "Simplified Chinese", alpha2: "TW", lang: "zh", expected: "台湾"},
+		{name: "Simplified Chinese region", alpha2: "TW", lang: "zh-CN", expected: "台湾"},
+		{name: "Traditional Chinese", alpha2: "TW", lang: "zh-Hant", expected: "台灣"},
+		{name: "Traditional Chinese region", alpha2: "TW", lang: "zh-TW", expected: "台灣"},
+		{name: "Traditional Chinese script and region", alpha2: "TW", lang: "zh-Hant-HK", expected: "台灣"},
+		{name: "Norwegian", alpha2: "NO", lang: "no", expected: "Norge"},
+		{name: "Norwegian Bokmål", alpha2: "NO", lang: "nb-NO", expected: "Norge"},
+		{name: "Native name", alpha2: "NO", lang: "nn", expected: "Noreg"},
+		{name: "Native name with region", alpha2: "CH", lang: "rm-CH", expected: "Svizra"},
+		{name: "Eswatini", alpha2: "SZ", lang: "en", expected: "Eswatini"},
+		{name: "North Macedonia", alpha2: "MK", lang: "de", expected: "Nordmazedonien"},
+		{name: "Unsupported language", alpha2: "DE", lang: "tlh", expected: ""},
+		{name: "Native name of another country", alpha2: "DE", lang: "rm", expected: ""},
+		{name: "Empty language", alpha2: "DE", lang: "", expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := GetByAlpha2(tt.alpha2)
+			require.NotNil(t, c)
+			assert.Equal(t, tt.expected, c.LocalizedName(tt.lang))
+		})
+	}
+}
+
+// TestCountry_LocalizedName_Renamed tests the names of North Macedonia and Eswatini in every language
+// against Unicode CLDR 42, since older CLDR releases still used Macedonia and Swaziland
+func TestCountry_LocalizedName_Renamed(t *testing.T) {
+	tests := []struct {
+		language string
+		mk       string
+		sz       string
+	}{
+		{language: "ar", mk: "مقدونيا الشمالية", sz: "إسواتيني"},
+		{language: "bg", mk: "Северна Македония", sz: "Есватини"},
+		{language: "bn", mk: "উত্তর ম্যাসেডোনিয়া", sz: "ইসওয়াতিনি"},
+		{language: "cs", mk: "Severní Makedonie", sz: "Eswatini"},
+		{language: "da", mk: "Nordmakedonien", sz: "Eswatini"},
+		{language: "de", mk: "Nordmazedonien", sz: "Eswatini"},
+		{language: "el", mk: "Βόρεια Μακεδονία", sz: "Εσουατίνι"},
+		{language: "en", mk: "North Macedonia", sz: "Eswatini"},
+		{language: "es", mk: "Macedonia del Norte", sz: "Esuatini"},
+		{language: "et", mk: "Põhja-Makedoonia", sz: "Svaasimaa"},
+		{language: "fa", mk: "مقدونیهٔ شمالی", sz: "اسواتینی"},
+		{language: "fi", mk: "Pohjois-Makedonia", sz: "Eswatini"},
+		{language: "fr", mk: "Macédoine du Nord", sz: "Eswatini"},
+		{language: "ga", mk: "an Mhacadóin Thuaidh", sz: "eSuaitíní"},
+		{language: "he", mk: "מקדוניה הצפונית", sz: "אסוואטיני"},
+		{language: "hi", mk: "उत्तरी मकदूनिया", sz: "एस्वाटिनी"},
+		{language: "hr", mk: "Sjeverna Makedonija", sz: "Esvatini"},
+		{language: "hu", mk: "Észak-Macedónia", sz: "Szváziföld"},
+		{language: "id", mk: "Makedonia Utara", sz: "eSwatini"},
+		{language: "it", mk: "Macedonia del Nord", sz: "eSwatini"},
+		{language: "ja", mk: "北マケドニア", sz: "エスワティニ"},
+		{language: "ko", mk: "북마케도니아", sz: "에스와티니"},
+		{language: "lt", mk: "Šiaurės Makedonija", sz: "Svazilandas"},
+		{language: "lv", mk: "Ziemeļmaķedonija", sz: "Svatini"},
+		{language: "ms", mk: "Macedonia Utara", sz: "Eswatini"},
+		{language: "mt", mk: "il-Maċedonja ta’ Fuq", sz: "l-Eswatini"},
+		{language: "nb", mk: "Nord-Makedonia", sz: "Eswatini"},
+		{language: "nl", mk: "Noord-Macedonië", sz: "Eswatini"},
+		{language: "pl", mk: "Macedonia Północna", sz: "Eswatini"},
+		{language: "pt", mk: "Macedônia do Norte", sz: "Essuatíni"},
+		{language: "ro", mk: "Macedonia de Nord", sz: "Eswatini"},
+		{language: "ru", mk: "Северная Македония", sz: "Эсватини"},
+		{language: "sk", mk: "Severné Macedónsko", sz: "Eswatini"},
+		{language: "sl", mk: "Severna Makedonija", sz: "Esvatini"},
+		{language: "sr", mk: "Северна Македонија", sz: "Свазиленд"},
+		{language: "sv", mk: "Nordmakedonien", sz: "Eswatini"},
+		{language: "sw", mk: "Masedonia ya Kaskazini", sz: "Eswatini"},
+		{language: "th", mk: "มาซิโดเนียเหนือ", sz: "เอสวาตีนี"},
+		{language: "tr", mk: "Kuzey Makedonya", sz: "Esvatini"},
+		{language: "uk", mk: "Північна Македонія", sz: "Есватіні"},
+		{language: "ur", mk: "شمالی مقدونیہ", sz: "سواتنی"},
+		{language: "vi", mk: "Bắc Macedonia", sz: "Eswatini"},
+		{language: "zh", mk: "北马其顿", sz: "斯威士兰"},
+		{language: "zh-Hant", mk: "北馬其頓", sz: "史瓦帝尼"},
+	}
+
+	require.Len(t, tests, len(localizedLanguages))
+	for _, tt := range tests {
+		t.Run(tt.language, func(t *testing.T) {
+			require.True(t, isLocalizedLanguage(strings.ToLower(tt.language)))
+			assert.Equal(t, tt.mk, GetByAlpha2(Alpha2MK).LocalizedName(tt.language))
+			assert.Equal(t, tt.sz, GetByAlpha2(Alpha2SZ).LocalizedName(tt.language))
+		})
+	}
+}
+
+// TestCountry_NativeNames tests the native names of a few countries
+func TestCountry_NativeNames(t *testing.T) {
+	assert.Equal(t, []NativeName{{Language: "de", Name: "Deutschland"}}, GetByAlpha2("DE").NativeNames)
+	assert.Equal(t, []NativeName{{Language: "ja", Name: "日本"}}, GetByAlpha2("JP").NativeNames)
+	assert.Contains(t, GetByAlpha2("BE").NativeNames, NativeName{Language: "fr", Name: "Belgique"})
+	assert.Contains(t, GetByAlpha2("CH").NativeNames, NativeName{Language: "it", Name: "Svizzera"})
+	assert.Contains(t, GetByAlpha2("HK").NativeNames, NativeName{Language: "zh", Name: "中國香港特別行政區"})
+	assert.Equal(t, []NativeName{
+		{Language: "pap", Name: "Kòrsou"}, {Language: "en", Name: "Curaçao"}, {Language: "nl", Name: "Curaçao"},
+	}, GetByAlpha2("CW").NativeNames)
+	assert.Empty(t, GetByAlpha2("AQ").NativeNames)
+}
+
+// TestGetByLocalizedName tests GetByLocalizedName with different names and language tags
+func TestGetByLocalizedName(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		lang     string
+		expected string
+	}{
+		{name: "German", input: "Vereinigte Staaten", lang: "de", expected: "US"},
+		{name: "French", input: "États-Unis", lang: "fr", expected: "US"},
+		{name: "Without diacritics", input: "etats unis", lang: "fr", expected: "US"},
+		{name: "Case", input: "DEUTSCHLAND", lang: "de", expected: "DE"},
+		{name: "Japanese", input: "ドイツ", lang: "ja", expected: "DE"},
+		{name: "Hindi vowel signs", input: "टोंगा", lang: "hi", expected: "TO"},
+		{name: "Region subtag", input: "Österreich", lang: "de-CH", expected: "AT"},
+		{name: "Traditional Chinese", input: "台灣", lang: "zh-TW", expected: "TW"},
+		{name: "Native name", input: "Svizra", lang: "rm", expected: "CH"},
+		{name: "Native name in a supported language", input: "Noreg", lang: "nn-NO", expected: "NO"},
+		{name: "Any language", input: "Nordmazedonien", lang: "", expected: "MK"},
+		{name: "Any language native name", input: "Schwiiz", lang: " ", expected: "CH"},
+		{name: "Papiamentu native name", input: "Korsou", lang: "pap", expected: "CW"},
+		{name: "Shared name in Polish", input: "Kongo", lang: "pl", expected: "CG"},
+		{name: "Shared name in Norwegian", input: "Kongo", lang: "nb", expected: "CD"},
+		{name: "Shared name in any language", input: "Kongo", lang: "", expected: "CD"},
+		{name: "Other language", input: "Deutschland", lang: "fr", expected: ""},
+		{name: "Unsupported language", input: "Deutschland", lang: "tlh", expected: ""},
+		{name: "Unknown name", input: "Atlantis", lang: "", expected: ""},
+		{name: "Empty name", input: "", lang: "de", expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := GetByLocalizedName(tt.input, tt.lang)
+			if tt.expected == "" {
+				assert.Nil(t, c)
+				return
+			}
+			require.NotNil(t, c)
+			assert.Equal(t, tt.expected, c.Alpha2)
+		})
+	}
+}
+
+// TestGetByLocalizedName_RoundTrip tests that every localized and native name finds its own country
+func TestGetByLocalizedName_RoundTrip(t *testing.T) {
+	// A few names refer to different countries in different languages (e.g., "Kongo" is CG in Polish
+	// and CD in Norwegian), and those resolve to the country of the language sorted first in any language
+	first := make(map[string]*Country)
+	for _, language := range localizedLanguages {
+		for _, c := range countries {
+			if key := NormalizeName(c.LocalizedName(language)); first[key] == nil {
+				first[key] = c
+			}
+		}
+	}
+
+	for _, language := range localizedLanguages {
+		for _, c := range countries {
+			name := c.LocalizedName(language)
+			assert.Equal(t, c, GetByLocalizedName(name, language), "%q in %s", name, language)
+			assert.Equal(t, first[NormalizeName(name)], GetByLocalizedName(name, ""), "%q in any language", name)
+		}
+	}
+	for _, c := range countries {
+		for _, native := range c.NativeNames {
+			assert.Equal(t, c, GetByLocalizedName(native.Name, native.Language), "%q in %s", native.Name, native.Language)
+		}
+	}
+}
+
+// ExampleCountry_LocalizedName is an example of Country.LocalizedName()
+func ExampleCountry_LocalizedName() {
+	fmt.Println(GetByAlpha2("DE").LocalizedName("de"))
+	fmt.Println(GetByAlpha2("US").LocalizedName("de"))
+	fmt.Println(GetByAlpha2("US").LocalizedName("fr-CA"))
+	fmt.Println(GetByAlpha2("TW").LocalizedName("zh-TW"))
+	// Output:Deutschland
+	// Vereinigte Staaten
+	// États-Unis
+	// 台灣
+}
+
+// ExampleGetByLocalizedName is an example of GetByLocalizedName()
+func ExampleGetByLocalizedName() {
+	fmt.Println(GetByLocalizedName("Vereinigte Staaten", "de").Alpha2)
+	fmt.Println(GetByLocalizedName("Etats-Unis", "fr").Alpha2)
+	fmt.Println(GetByLocalizedName("Schweiz", "").Alpha2)
+	// Output:US
+	// US
+	// CH
+}
+
+// BenchmarkCountry_LocalizedName benchmarks the method LocalizedName()
+func BenchmarkCountry_LocalizedName(b *testing.B) {
+	c := GetByAlpha2("US")
+	for i := 0; i < b.N; i++ {
+		_ = c.LocalizedName("zh-Hant-TW")
+	}
+}
+
+// BenchmarkGetByLocalizedName benchmarks the method GetByLocalizedName()
+func BenchmarkGetByLocalizedName(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_ = GetByLocalizedName("Vereinigte Staaten", "de")
+	}
+}
@@ -0,0 +1,382 @@
+package countries
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/mrz1836/go-countries/internal/names"
+)
+
+// Errors wrapped by a LookupError, to be checked with errors.Is
+var (
+	ErrEmptyInput    = errors.New("empty input")
+	ErrInvalidFormat = errors.New("invalid format")
+	ErrNotFound      = errors.New("country not found")
+)
+
+// minSuggestionScore is the minimum Search score of a country suggested for an unknown name or code
+const minSuggestionScore = 0.5
+
+// LookupError describes why a Lookup function found no country, with a suggestion when one is close
+type LookupError struct {
+	Err        error    // ErrEmptyInput, ErrInvalidFormat or ErrNotFound
+	Input      string   // Input as given to the Lookup function
+	Identifier string   // Identifier looked up (e.g., "alpha-2 code", "name")
+	Expected   string   // Expected format of the identifier (e.g., "two letters"), empty for names and capitals
+	Suggestion *Country // Country the input most likely refers to, or nil
+	DidYouMean string   // Identifier of the Suggestion in the looked up format (e.g., "US"), or empty
+}
+
+// identifier is a kind of identifier looked up by a Lookup function
+type identifier struct {
+	name     string                  // Name of the identifier in error messages (e.g., "alpha-2 code")
+	expected string                  // Expected format, or empty when any text is accepted
+	valid    func(s string) bool     // Reports whether the input has the expected format
+	get      func(s string) *Country // GetBy function of the identifier
+	value    func(c *Country) string // Identifier of a country, for suggestions
+}
+
+// Identifiers of the Lookup functions
+var (
+	alpha2Identifier = identifier{ //nolint:gochecknoglobals // fixed lookup definition
+		name: "alpha-2 code", expected: "two letters",
+		valid: func(s string) bool { return len(s) == 2 && isLetters(s) },
+		get:   GetByAlpha2[string],
+		value: func(c *Country) string { return c.Alpha2 },
+	}
+	alpha3Identifier = identifier{ //nolint:gochecknoglobals // fixed lookup definition
+		name: "alpha-3 code", expected: "three letters",
+		valid: func(s string) bool { return len(s) == 3 && isLetters(s) },
+		get:   GetByAlpha3[string],
+		value: func(c *Country) string { return c.Alpha3 },
+	}
+	numericIdentifier = identifier{ //nolint:gochecknoglobals // fixed lookup definition
+		name: "numeric code", expected: "three digits",
+		valid: func(s string) bool { return len(s) == 3 && isDigits(s) },
+		get:   GetByCountryCode,
+		value: func(c *Country) string { return c.CountryCode },
+	}
+	iso31662Identifier = identifier{ //nolint:gochecknoglobals // fixed lookup definition
+		name: "ISO 3166-2 code", expected: `"ISO 3166-2:" followed by two letters`,
+		valid: func(s string) bool {
+			return len(s) == len(iso31662Prefix)+2 && strings.EqualFold(s[:len(iso31662Prefix)], iso31662Prefix) &&
+				isLetters(s[len(iso31662Prefix):])
+		},
+		get:   GetByISO31662,
+		value: func(c *Country) string { return c.ISO31662 },
+	}
+	nameIdentifier = identifier{ //nolint:gochecknoglobals // fixed lookup definition
+		name:  "name",
+		valid: func(string) bool { return true },
+		get:   GetByName,
+		value: func(c *Country) string { return c.Name },
+	}
+	capitalIdentifier = identifier{ //nolint:gochecknoglobals // fixed lookup definition
+		name:  "capital",
+		valid: func(string) bool { return true },
+		get:   GetByCapital,
+		value: func(c *Country) string { return c.Capital },
+	}
+)
+
+// iso31662Prefix starts the ISO 3166-2 code of every country
+const iso31662Prefix = "ISO 3166-2:"
+
+// LookupAlpha2 retrieves a Country by its alpha-2 code, or explains why no country was found.
+//
+// This function performs the following steps:
+// - Trims surrounding spaces, as found in CSV files and CHAR columns
+// - Rejects empty input with ErrEmptyInput, and input other than two letters with ErrInvalidFormat
+// - Looks the code up with GetByAlpha2, in any letter case
+// - Suggests the country the input most likely refers to (e.g., "GB" for "UK", "US" for "USA")
+//
+// Parameters:
+// - alpha2: two-letter ISO 3166-1 code (e.g., "US", "de")
+//
+// Returns:
+// - Pointer to the Country struct, or nil and a *LookupError wrapping ErrEmptyInput, ErrInvalidFormat or ErrNotFound
+//
+// Side Effects:
+// - None
+//
+// Notes:
+// - Use errors.Is to tell the errors apart, and errors.As to read the suggestion of the LookupError
+func LookupAlpha2(alpha2 string) (*Country, error) {
+	return lookup(alpha2, alpha2Identifier)
+}
+
+// LookupAlpha3 retrieves a Country by its alpha-3 code, or explains why no country was found.
+//
+// Parameters:
+// - alpha3: three-letter ISO 3166-1 code (e.g., "USA", "deu")
+//
+// Returns:
+// - Pointer to the Country struct, or nil and a *LookupError wrapping ErrEmptyInput, ErrInvalidFormat or ErrNotFound
+//
+// Side Effects:
+// - None
+//
+// Notes:
+// - Works like LookupAlpha2 (e.g., suggests "USA" for "US")
+func LookupAlpha3(alpha3 string) (*Country, error) {
+	return lookup(alpha3, alpha3Identifier)
+}
+
+// LookupCountryCode retrieves a Country by its numeric code, or explains why no country was found.
+//
+// Parameters:
+// - code: three-digit ISO 3166-1 numeric code (e.g., "840", "076")
+//
+// Returns:
+// - Pointer to the Country struct, or nil and a *LookupError wrapping ErrEmptyInput, ErrInvalidFormat or ErrNotFound
+//
+// Side Effects:
+// - None
+//
+// Notes:
+// - Codes without their leading zeros are rejected with ErrInvalidFormat, suggesting the padded code
+// (e.g., "076" for "76")
+func LookupCountryCode(code string) (*Country, error) {
+	return lookup(code, numericIdentifier)
+}
+
+// LookupISO31662 retrieves a Country by its ISO 3166-2 code, or explains why no country was found.
+//
+// Parameters:
+// - iso: ISO 3166-2 code of the country (e.g., "ISO 3166-2:US")
+//
+// Returns:
+// - Pointer to the Country struct, or nil and a *LookupError wrapping ErrEmptyInput, ErrInvalidFormat or ErrNotFound
+//
+// Side Effects:
+// - None
+//
+// Notes:
+// - Bare alpha-2 codes are rejected with ErrInvalidFormat, suggesting the ISO 3166-2 code (e.g., "ISO 3166-2:US")
+func LookupISO31662(iso string) (*Country, error) {
+	return lookup(iso, iso31662Identifier)
+}
+
+// LookupName retrieves a Country by its name or one of its aliases, or explains why no country was found.
+//
+// Parameters:
+// - name: name of the country as accepted by GetByName (e.g., "Germany", "Korea, Republic of", "UK")
+//
+// Returns:
+// - Pointer to the Country struct, or nil and a *LookupError wrapping ErrEmptyInput or ErrNotFound
+//
+// Side Effects:
+// - Splits the terms of the search index into words on the first suggestion
+//
+// Notes:
+// - Misspelled names are suggested the closest country found by Search (e.g., "Germany" for "Germny")
+func LookupName(name string) (*Country, error) {
+	return lookup(name, nameIdentifier)
+}
+
+// LookupCapital retrieves a Country by its capital city, or explains why no country was found.
+//
+// Parameters:
+// - capital: capital city as accepted by GetByCapital (e.g., "Berlin", "Bogotá")
+//
+// Returns:
+// - Pointer to the Country struct, or nil and a *LookupError wrapping ErrEmptyInput or ErrNotFound
+//
+// Side Effects:
+// - Splits the terms of the search index into words on the first suggestion
+//
+// Notes:
+// - Misspelled capitals are suggested the closest country found by Search (e.g., "Ottawa" for "Otawa")
+func LookupCapital(capital string) (*Country, error) {
+	return lookup(capital, capitalIdentifier)
+}
+
+// Error describes the input, the expected format and the suggestion.
+//
+// Parameters:
+// - None
+//
+// Returns:
+// - Message such as `invalid format: "USA" is not an alpha-2 code (two letters); did you mean "US"
+// (United States of America)?`
+//
+// Side Effects:
+// - None
+func (e *LookupError) Error() string {
+	var msg string
+	switch {
+	case errors.Is(e.Err, ErrEmptyInput):
+		msg = fmt.Sprintf("%s: expected %s %s", e.Err, article(e.Identifier), e.Identifier)
+	case errors.Is(e.Err, ErrInvalidFormat):
+		msg = fmt.Sprintf("%s: %q is not %s %s (%s)", e.Err, e.Input, article(e.Identifier), e.Identifier, e.Expected)
+	default:
+		msg = fmt.Sprintf("%s: no country has the %s %q", e.Err, e.Identifier, e.Input)
+	}
+
+	switch {
+	case e.Suggestion == nil || e.DidYouMean == "":
+		return msg
+	case e.DidYouMean == e.Suggestion.Name:
+		return fmt.Sprintf("%s; did you mean %q?", msg, e.DidYouMean)
+	default:
+		return fmt.Sprintf("%s; did you mean %q (%s)?", msg, e.DidYouMean, e.Suggestion.Name)
+	}
+}
+
+// Unwrap returns ErrEmptyInput, ErrInvalidFormat or ErrNotFound, for errors.Is.
+//
+// Parameters:
+// - None
+//
+// Returns:
+// - The sentinel error of the LookupError
+//
+// Side Effects:
+// - None
+func (e *LookupError) Unwrap() error {
+	return e.Err
+}
+
+// lookup looks an identifier up without its surrounding spaces, or returns a *LookupError with a suggestion
+func lookup(input string, id identifier) (*Country, error) {
+	trimmed := strings.TrimSpace(input)
+
+	var err error
+	switch {
+	case trimmed == "":
+		return nil, &LookupError{Err: ErrEmptyInput, Input: input, Identifier: id.name, Expected: id.expected}
+	case !id.valid(trimmed):
+		err = ErrInvalidFormat
+	default:
+		if c := id.get(trimmed); c != nil {
+			return c, nil
+		}
+		err = ErrNotFound
+	}
+
+	lookupErr := &LookupError{Err: err, Input: input, Identifier: id.name, Expected: id.expected}
+	if c := suggest(trimmed); c != nil && id.value(c) != "" {
+		lookupErr.Suggestion, lookupErr.DidYouMean = c, id.value(c)
+	}
+	return nil, lookupErr
+}
+
+// suggest finds the country that an unknown or malformed input most likely refers to: the country of
+// another identifier (e.g., an alpha-3 code given as alpha-2 code), or the best match of Search within a few typos
+func suggest(input string) *Country {
+	if isDigits(input) && len(input) < 3 {
+		input = strings.Repeat("0", 3-len(input)) + input
+	}
+
+	for _, get := range []func(string) *Country{
+		GetByAlpha2[string], GetByAlpha3[string], GetByCountryCode, GetByISO31662, GetByName, GetByCapital,
+	} {
+		if c := get(input); c != nil {
+			return c
+		}
+	}
+	if len(input) == 2 {
+		if c := GetByISO31662(iso31662Prefix + input); c != nil {
+			return c
+		}
+	}
+
+	// Only suggest names and capitals within the typos tolerated by Search, not every prefix of the input
+	matches := Search(input, 1)
+	if len(matches) == 0 || matches[0].Score < minSuggestionScore {
+		return nil
+	}
+	query := names.Fold(input)
+	if distance, _ := editDistance(query, matches[0].Term, nil); distance > maxTypos(query) {
+		return nil
+	}
+	return matches[0].Country
+}
+
+// article returns the indefinite article of an identifier name
+func article(name string) string {
+	if name != "" && strings.ContainsRune("aeiouAEIOU", rune(name[0])) {
+		return "an"
+	}
+	return "a"
+}
+
+// MatchKind is the identifier of a Country that matched the input of Lookup
+type MatchKind string
+
+// Identifiers matched by Lookup, in order of precedence
+const (
+	MatchKindNone     MatchKind = ""         // No country matched
+	MatchKindAlpha2   MatchKind = "alpha2"   // ISO 3166-1 alpha-2 code (e.g., "DE")
+	MatchKindAlpha3   MatchKind = "alpha3"   // ISO 3166-1 alpha-3 code (e.g., "DEU")
+	MatchKindNumeric  MatchKind = "numeric"  // ISO 3166-1 numeric code, with or without leading zeros (e.g., "276")
+	MatchKindISO31662 MatchKind = "iso31662" // ISO 3166-2 code (e.g., "ISO 3166-2:DE")
+	MatchKindName     MatchKind = "name"     // ISO, common or official name, or alias (e.g., "Germany")
+	MatchKindCapital  MatchKind = "capital"  // Capital city (e.g., "Berlin")
+)
+
+// Lookup retrieves a Country from any of its identifiers, detecting the kind of identifier from the input.
+//
+// This function performs the following steps:
+// - Trims surrounding spaces, as found in CSV files and CHAR columns
+// - Tries, in order: the alpha-2 code for two letters, the alpha-3 code for three letters, the numeric code for
+// up to three digits (padding "76" to "076") and the ISO 3166-2 code for "ISO 3166-2:" followed by two letters
+// - Falls back to the names and aliases (e.g., "UK", "UAE"), then to the capitals
+//
+// Parameters:
+// - input: any identifier of a country (e.g., "DE", "deu", "276", "ISO 3166-2:DE", "Germany" or "Berlin")
+//
+// Returns:
+// - Pointer to the Country struct and the kind of identifier that matched, or nil and MatchKindNone
+//
+// Side Effects:
+// - None
+//
+// Notes:
+// - Codes match in any letter case, and names and capitals are normalized like GetByName and GetByCapital
+// - Two- and three-letter codes win over names, so "UK" is an alias of GB because it is not an alpha-2 code
+// - Alpha-2, alpha-3 and numeric code lookups do not allocate
+// - Use the Lookup functions of a single identifier (e.g., LookupAlpha2) to know why nothing matched
+func Lookup(input string) (*Country, MatchKind) {
+	input = strings.TrimSpace(input)
+
+	var code [3]byte
+	switch {
+	case len(input) == 2 && isLetters(input):
+		if c := byAlpha2[string(upperCode(&code, input))]; c != nil {
+			return c, MatchKindAlpha2
+		}
+	case len(input) == 3 && isLetters(input):
+		if c := byAlpha3[string(upperCode(&code, input))]; c != nil {
+			return c, MatchKindAlpha3
+		}
+	case input != "" && len(input) <= 3 && isDigits(input):
+		code = [3]byte{'0', '0', '0'}
+		copy(code[3-len(input):], input)
+		if c := byCode[string(code[:])]; c != nil {
+			return c, MatchKindNumeric
+		}
+		return nil, MatchKindNone
+	case iso31662Identifier.valid(input):
+		if c := GetByISO31662(input); c != nil {
+			return c, MatchKindISO31662
+		}
+	}
+
+	if c := GetByName(input); c != nil {
+		return c, MatchKindName
+	}
+	if c := GetByCapital(input); c != nil {
+		return c, MatchKindCapital
+	}
+	return nil, MatchKindNone
+}
+
+// upperCode uppercases a code of ASCII letters into the buffer, for map lookups that do not allocate
+func upperCode(buf *[3]byte, code string) []byte {
+	for i := 0; i < len(code); i++ {
+		buf[i] = code[i] &^ 0x20
+	}
+	return buf[:len(code)]
+}
@@ -0,0 +1,213 @@
+package countries
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestLookup tests the Lookup functions with known, malformed and unknown identifiers
+func TestLookup(t *testing.T) {
+	tests := []struct {
+		name       string
+		lookup     func(string) (*Country, error)
+		input      string
+		expected   string
+		err        error
+		suggestion string
+	}{
+		{name: "Alpha-2", lookup: LookupAlpha2, input: "US", expected: "US"},
+		{name: "Lowercase alpha-2", lookup: LookupAlpha2, input: "de", expected: "DE"},
+		{name: "Empty alpha-2", lookup: LookupAlpha2, input: "", err: ErrEmptyInput},
+		{name: "Blank alpha-2", lookup: LookupAlpha2, input: "  ", err: ErrEmptyInput},
+		{name: "Alpha-3 as alpha-2", lookup: LookupAlpha2, input: "USA", err: ErrInvalidFormat, suggestion: "US"},
+		{name: "Alpha-2 with surrounding spaces", lookup: LookupAlpha2, input: " US ", expected: "US"},
+		{name: "Alpha-2 with inner space", lookup: LookupAlpha2, input: "U S", err: ErrInvalidFormat, suggestion: "US"},
+		{name: "Numeric code as alpha-2", lookup: LookupAlpha2, input: "12", err: ErrInvalidFormat, suggestion: "DZ"},
+		{name: "Symbol in alpha-2", lookup: LookupAlpha2, input: "U$", err: ErrInvalidFormat},
+		{name: "Prefix of a name as alpha-2", lookup: LookupAlpha2, input: "Ja", err: ErrNotFound},
+		{name: "Alias as alpha-2", lookup: LookupAlpha2, input: "UK", err: ErrNotFound, suggestion: "GB"},
+		{name: "Unassigned alpha-2", lookup: LookupAlpha2, input: "ZZ", err: ErrNotFound},
+		{name: "Alpha-3", lookup: LookupAlpha3, input: "mex", expected: "MX"},
+		{name: "Alpha-2 as alpha-3", lookup: LookupAlpha3, input: "US", err: ErrInvalidFormat, suggestion: "USA"},
+		{name: "Unassigned alpha-3", lookup: LookupAlpha3, input: "XYZ", err: ErrNotFound},
+		{name: "Numeric", lookup: LookupCountryCode, input: "076", expected: "BR"},
+		{name: "Numeric without leading zeros", lookup: LookupCountryCode, input: "76", err: ErrInvalidFormat,
+			suggestion: "076"},
+		{name: "Unassigned numeric", lookup: LookupCountryCode, input: "999", err: ErrNotFound},
+		{name: "Letters as numeric", lookup: LookupCountryCode, input: "BRA", err: ErrInvalidFormat, suggestion: "076"},
+		{name: "ISO 3166-2", lookup: LookupISO31662, input: "ISO 3166-2:CA", expected: "CA"},
+		{name: "Lowercase ISO 3166-2", lookup: LookupISO31662, input: "iso 3166-2:ca", expected: "CA"},
+		{name: "Alpha-2 as ISO 3166-2", lookup: LookupISO31662, input: "CA", err: ErrInvalidFormat,
+			suggestion: "ISO 3166-2:CA"},
+		{name: "Unassigned ISO 3166-2", lookup: LookupISO31662, input: "ISO 3166-2:ZZ", err: ErrNotFound},
+		{name: "Name", lookup: LookupName, input: "Germany", expected: "DE"},
+		{name: "Alias", lookup: LookupName, input: "UK", expected: "GB"},
+		{name: "Misspelled name", lookup: LookupName, input: "Germny", err: ErrNotFound, suggestion: "Germany"},
+		{name: "Name with surrounding spaces", lookup: LookupName, input: "\tGermany\n", expected: "DE"},
+		{name: "Typo of a name starting a longer name", lookup: LookupName, input: "Bolvia", err: ErrNotFound,
+			suggestion: "Bolivia (Plurinational State of)"},
+		{name: "Typo ranked above a longer name", lookup: LookupName, input: "Austira", err: ErrNotFound,
+			suggestion: "Austria"},
+		{name: "Code as name", lookup: LookupName, input: "DEU", err: ErrNotFound, suggestion: "Germany"},
+		{name: "Unknown name", lookup: LookupName, input: "Atlantis", err: ErrNotFound},
+		{name: "Empty name", lookup: LookupName, input: "", err: ErrEmptyInput},
+		{name: "Capital", lookup: LookupCapital, input: "Bogota", expected: "CO"},
+		{name: "Misspelled capital", lookup: LookupCapital, input: "Otawa", err: ErrNotFound, suggestion: "Ottawa"},
+		{name: "Name as capital", lookup: LookupCapital, input: "France", err: ErrNotFound, suggestion: "Paris"},
+		{name: "Country without capital", lookup: LookupCapital, input: "Antarctica", err: ErrNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			country, err := tt.lookup(tt.input)
+			if tt.err == nil {
+				require.NoError(t, err)
+				assert.Same(t, GetByAlpha2(tt.expected), country)
+				return
+			}
+
+			assert.Nil(t, country)
+			require.ErrorIs(t, err, tt.err)
+
+			var lookupErr *LookupError
+			require.ErrorAs(t, err, &lookupErr)
+			assert.Equal(t, tt.input, lookupErr.Input)
+			assert.Equal(t, tt.suggestion, lookupErr.DidYouMean)
+			assert.Equal(t, tt.suggestion == "", lookupErr.Suggestion == nil)
+		})
+	}
+}
+
+// TestLookupError_Error tests the messages of the lookup errors
+func TestLookupError_Error(t *testing.T) {
+	_, err := LookupAlpha2("")
+	require.EqualError(t, err, "empty input: expected an alpha-2 code")
+
+	_, err = LookupAlpha3("US")
+	require.EqualError(t, err,
+		`invalid format: "US" is not an alpha-3 code (three letters); did you mean "USA" (United States of America)?`)
+
+	_, err = LookupCountryCode("999")
+	require.EqualError(t, err, `country not found: no country has the numeric code "999"`)
+
+	_, err = LookupName("Frnace")
+	require.EqualError(t, err, `country not found: no country has the name "Frnace"; did you mean "France"?`)
+
+	_, err = LookupCapital("Pariss")
+	require.EqualError(t, err, `country not found: no country has the capital "Pariss"; did you mean "Paris" (France)?`)
+}
+
+// TestLookup_Universal tests Lookup with every kind of identifier
+func TestLookup_Universal(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+		kind     MatchKind
+	}{
+		{name: "Alpha-2", input: "DE", expected: "DE", kind: MatchKindAlpha2},
+		{name: "Lowercase alpha-2", input: "de", expected: "DE", kind: MatchKindAlpha2},
+		{name: "Padded alpha-2", input: " de ", expected: "DE", kind: MatchKindAlpha2},
+		{name: "Alpha-3", input: "DEU", expected: "DE", kind: MatchKindAlpha3},
+		{name: "Mixed case alpha-3", input: "dEu", expected: "DE", kind: MatchKindAlpha3},
+		{name: "Numeric", input: "276", expected: "DE", kind: MatchKindNumeric},
+		{name: "Numeric without leading zeros", input: "76", expected: "BR", kind: MatchKindNumeric},
+		{name: "Numeric of one digit", input: "4", expected: "AF", kind: MatchKindNumeric},
+		{name: "ISO 3166-2", input: "ISO 3166-2:DE", expected: "DE", kind: MatchKindISO31662},
+		{name: "Lowercase ISO 3166-2", input: "iso 3166-2:de", expected: "DE", kind: MatchKindISO31662},
+		{name: "Name", input: "Germany", expected: "DE", kind: MatchKindName},
+		{name: "Official name", input: "Federal Republic of Germany", expected: "DE", kind: MatchKindName},
+		{name: "Two-letter alias", input: "UK", expected: "GB", kind: MatchKindName},
+		{name: "Three-letter alias", input: "UAE", expected: "AE", kind: MatchKindName},
+		{name: "Capital", input: "Berlin", expected: "DE", kind: MatchKindCapital},
+		{name: "Capital with diacritics", input: "Bogotá", expected: "CO", kind: MatchKindCapital},
+		{name: "Unassigned alpha-2", input: "ZZ", kind: MatchKindNone},
+		{name: "Unassigned numeric", input: "999", kind: MatchKindNone},
+		{name: "Too many digits", input: "0276", kind: MatchKindNone},
+		{name: "Unknown name", input: "Atlantis", kind: MatchKindNone},
+		{name: "Empty", input: "", kind: MatchKindNone},
+		{name: "Blank", input: "   ", kind: MatchKindNone},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			country, kind := Lookup(tt.input)
+			assert.Equal(t, tt.kind, kind)
+			if tt.expected == "" {
+				assert.Nil(t, country)
+				return
+			}
+			assert.Same(t, GetByAlpha2(tt.expected), country)
+		})
+	}
+}
+
+// TestLookup_Allocations tests that code lookups do not allocate
+func TestLookup_Allocations(t *testing.T) {
+	for _, input := range []string{"DE", "de", "DEU", "276", "76"} {
+		assert.Zero(t, testing.AllocsPerRun(100, func() { _, _ = Lookup(input) }), input)
+	}
+}
+
+// ExampleLookupAlpha2 is an example of LookupAlpha2()
+func ExampleLookupAlpha2() {
+	country, _ := LookupAlpha2("de")
+	fmt.Println(country.Name)
+
+	_, err := LookupAlpha2("UK")
+	fmt.Println(err)
+	// Output:Germany
+	// country not found: no country has the alpha-2 code "UK"; did you mean "GB" (United Kingdom of Great Britain and Northern Ireland)?
+}
+
+// ExampleLookupCountryCode is an example of LookupCountryCode()
+func ExampleLookupCountryCode() {
+	_, err := LookupCountryCode("76")
+	fmt.Println(err)
+	// Output:invalid format: "76" is not a numeric code (three digits); did you mean "076" (Brazil)?
+}
+
+// ExampleLookup is an example of Lookup()
+func ExampleLookup() {
+	for _, input := range []string{"de", "DEU", "76", "UK", "Berlin"} {
+		country, kind := Lookup(input)
+		fmt.Printf("%s: %s (%s)\n", input, country.Name, kind)
+	}
+	// Output:de: Germany (alpha2)
+	// DEU: Germany (alpha3)
+	// 76: Brazil (numeric)
+	// UK: United Kingdom of Great Britain and Northern Ireland (name)
+	// Berlin: Germany (capital)
+}
+
+// BenchmarkLookup benchmarks the method Lookup() with an alpha-2 code
+func BenchmarkLookup(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = Lookup("de")
+	}
+}
+
+// BenchmarkLookup_Name benchmarks the method Lookup() with a name
+func BenchmarkLookup_Name(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_, _ = Lookup("Germany")
+	}
+}
+
+// BenchmarkLookupAlpha2 benchmarks the method LookupAlpha2()
+func BenchmarkLookupAlpha2(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_, _ = LookupAlpha2("US")
+	}
+}
+
+// BenchmarkLookupAlpha2_NotFound benchmarks the method LookupAlpha2() with a suggestion
+func BenchmarkLookupAlpha2_NotFound(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_, _ = LookupAlpha2("UK")
+	}
+}
@@ -0,0 +1,33 @@
+package countries
+
+import "github.com/mrz1836/go-countries/internal/names"
+
+// NormalizeName returns the form of a country or city name used by the name lookups.
+//
+// This function performs the following steps:
+// - Moves a trailing qualifier ending with "of", "of the" or "the" in front of the name,
+// after a comma or in parentheses (e.g., "Korea, Republic of" to "Republic of Korea")
+// - Lowercases the name and folds compatibility characters and diacritics to ASCII letters
+// (e.g., "Åland" to "aland", "Ｊａｐａｎ" to "japan")
+// - Drops apostrophes, replaces "&" by "and" and collapses other punctuation and spaces to single spaces
+// - Drops a leading "the" (e.g., "The Gambia" to "gambia")
+//
+// Parameters:
+// - name: country or city name in any format (e.g., "Côte d’Ivoire", "Bahamas, The", "Bosnia & Herzegovina")
+//
+// Returns:
+// - Normalized name (e.g., "cote divoire", "bahamas", "bosnia and herzegovina"), or an empty string
+// when the name has no letters or digits
+//
+// Side Effects:
+// - None
+//
+// Notes:
+// - GetByName and GetByCapital normalize their input with this function, and the generated name
+// and capital maps are keyed by it, so any two spellings with the same normalized form find the same country
+// - "Republic of" is never dropped, as it tells countries apart (e.g., "Congo" and "Republic of the Congo")
+// - Letters of other scripts are lowercased but kept (e.g., "Россия" to "россия")
+// - Names that are already normalized are returned without allocating
+func NormalizeName(name string) string {
+	return names.Normalize(name)
+}
@@ -0,0 +1,70 @@
+package countries
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestNormalizeName tests NormalizeName with different names
+func TestNormalizeName(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{name: "Already normalized", input: "united states of america", expected: "united states of america"},
+		{name: "Case", input: "United States of America", expected: "united states of america"},
+		{name: "Diacritics", input: "Åland Islands", expected: "aland islands"},
+		{name: "Apostrophes", input: "Côte d’Ivoire", expected: "cote divoire"},
+		{name: "Fullwidth", input: "Ｊａｐａｎ", expected: "japan"},
+		{name: "Ampersand", input: "Bosnia & Herzegovina", expected: "bosnia and herzegovina"},
+		{name: "Punctuation and spaces", input: "  Guinea-Bissau ", expected: "guinea bissau"},
+		{name: "Qualifier after a comma", input: "Korea, Republic of", expected: "republic of korea"},
+		{name: "Qualifier in parentheses", input: "Venezuela (Bolivarian Republic of)", expected: "bolivarian republic of venezuela"},
+		{name: "Other qualifier", input: "Saint Martin (French part)", expected: "saint martin french part"},
+		{name: "Trailing the", input: "Gambia, The", expected: "gambia"},
+		{name: "Leading the", input: "The Netherlands", expected: "netherlands"},
+		{name: "Republic of kept", input: "Republic of the Congo", expected: "republic of the congo"},
+		{name: "Only punctuation", input: " .,; ", expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, NormalizeName(tt.input))
+		})
+	}
+}
+
+// TestNormalizeName_LookupKeys tests that every key of the name and capital maps is normalized
+func TestNormalizeName_LookupKeys(t *testing.T) {
+	for key := range byName {
+		assert.Equal(t, key, NormalizeName(key))
+	}
+	for key := range byCapital {
+		assert.Equal(t, key, NormalizeName(key))
+	}
+}
+
+// TestGetByName_Allocations tests that normalized names are looked up without allocating
+func TestGetByName_Allocations(t *testing.T) {
+	assert.Zero(t, testing.AllocsPerRun(10, func() { _ = GetByName("republic of korea") }))
+}
+
+// ExampleNormalizeName is an example of NormalizeName()
+func ExampleNormalizeName() {
+	fmt.Println(NormalizeName("Korea, Republic of"))
+	fmt.Println(NormalizeName("Côte d’Ivoire"))
+	fmt.Println(GetByName("Cote dIvoire").Alpha2)
+	// Output:republic of korea
+	// cote divoire
+	// CI
+}
+
+// BenchmarkNormalizeName benchmarks the method NormalizeName()
+func BenchmarkNormalizeName(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_ = NormalizeName("Bolivia (Plurinational State of)")
+	}
+}
@@ -0,0 +1,276 @@
+// Package phone parses and validates phone numbers using the countries of the go-countries package.
+//
+// Numbers can be given in international format ("+44 20 7946 0018", "0044 20 7946 0018") or in
+// national format together with a default region ("020 7946 0018" for countries.Alpha2GB).
+// Every parsed number is resolved to the same *countries.Country returned by the countries package,
+// including the members of shared calling codes (e.g., Puerto Rico and Canada within +1).
+//
+// Validation follows the national numbering plan of each country: the national number must have one
+// of the possible lengths and match the leading digits of the plan (Google libphonenumber metadata).
+package phone
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/mrz1836/go-countries"
+)
+
+// Errors returned when a phone number cannot be parsed or is not valid
+var (
+	ErrEmptyNumber        = errors.New("phone number is empty")
+	ErrInvalidCharacters  = errors.New("phone number contains invalid characters")
+	ErrInvalidNumber      = errors.New("phone number does not match the numbering plan")
+	ErrTooLong            = errors.New("phone number is too long")
+	ErrTooShort           = errors.New("phone number is too short")
+	ErrUnknownCallingCode = errors.New("unknown calling code")
+	ErrUnknownRegion      = errors.New("unknown or unsupported region")
+)
+
+// maxCallingCodeDigits is the maximum number of digits in an international calling code (ITU-T E.164)
+const maxCallingCodeDigits = 3
+
+// Number is a parsed and validated phone number
+type Number struct {
+	CallingCode    string             `json:"calling_code"`    // International calling code of the country (e.g., +44)
+	Country        *countries.Country `json:"country"`         // Country the number belongs to
+	E164           string             `json:"e164"`            // Number in E.164 format (e.g., +442079460018)
+	NationalNumber string             `json:"national_number"` // National significant number, without the national prefix
+}
+
+// rule is the national numbering plan of a country
+type rule struct {
+	lengths        []int  // Possible lengths of the national significant number
+	nationalPrefix string // Trunk prefix dialed before a national number (e.g., 0 in the UK)
+	pattern        string // Regular expression matched by every valid national significant number
+
+	once sync.Once
+	re   *regexp.Regexp
+}
+
+// Parse parses a phone number and validates it against the numbering plan of its country.
+//
+// This function performs the following steps:
+// - Strips the separators (spaces, dashes, dots, slashes, parentheses) and rejects any other character
+// than digits and a leading "+"
+// - Splits the calling code from numbers starting with "+" or the "00" international prefix
+// - Uses the calling code of the default region for numbers in national format
+// - Resolves the country using the number prefix (e.g., NANP area codes) and the default region
+// - Strips the national prefix (e.g., the leading 0 in the UK) and validates the national number
+//
+// Parameters:
+// - input: phone number in international or national format (e.g., "020 7946 0018")
+// - defaultRegion: ISO 3166-1 alpha-2 code used for numbers in national format (e.g., countries.Alpha2GB)
+//
+// Returns:
+// - Pointer to the parsed Number, or nil with an error when the number cannot be parsed or is not valid
+//
+// Side Effects:
+// - Compiles the numbering plan of a country the first time it is used
+//
+// Notes:
+// - The default region is ignored for numbers in international format unless their calling code is shared
+// - The default region is matched in any letter case; an empty region only accepts numbers in international format
+// - Errors wrap ErrEmptyNumber, ErrInvalidCharacters, ErrUnknownRegion, ErrUnknownCallingCode,
+// ErrTooShort, ErrTooLong or ErrInvalidNumber and can be checked with errors.Is
+// - Country-specific international prefixes other than "00" (e.g., 011 in the US) are not recognized
+func Parse(input string, defaultRegion countries.Alpha2) (*Number, error) {
+	digits, international, err := extractDigits(input)
+	if err != nil {
+		return nil, err
+	}
+
+	region := countries.GetByAlpha2(defaultRegion.String())
+	if region != nil && (rules[region.Alpha2] == nil || len(region.CallingCodes) == 0) {
+		region = nil
+	}
+
+	var callingCode, national string
+	if international {
+		if callingCode, national = splitCallingCode(digits); callingCode == "" {
+			return nil, fmt.Errorf("%w: %q", ErrUnknownCallingCode, input)
+		}
+	} else {
+		if region == nil {
+			return nil, fmt.Errorf("%w: %q", ErrUnknownRegion, defaultRegion)
+		}
+		callingCode, national = strings.TrimPrefix(region.CallingCodes[0], "+"), digits
+	}
+
+	candidates := candidateCountries(callingCode, national, region)
+	for _, c := range candidates {
+		if nationalNumber, ok := rules[c.Alpha2].nationalNumber(national); ok {
+			return &Number{
+				CallingCode:    "+" + callingCode,
+				Country:        c,
+				E164:           "+" + callingCode + nationalNumber,
+				NationalNumber: nationalNumber,
+			}, nil
+		}
+	}
+
+	if len(candidates) == 0 {
+		return nil, fmt.Errorf("%w: +%s", ErrUnknownCallingCode, callingCode)
+	}
+	return nil, rules[candidates[0].Alpha2].validationError(national, input)
+}
+
+// IsValid reports whether a phone number is valid for the numbering plan of its country.
+//
+// Parameters:
+// - input: phone number in international or national format (e.g., "+44 20 7946 0018")
+// - defaultRegion: ISO 3166-1 alpha-2 code used for numbers in national format (e.g., countries.Alpha2GB)
+//
+// Returns:
+// - true when Parse succeeds for the same input and default region
+//
+// Side Effects:
+// - Compiles the numbering plan of a country the first time it is used
+func IsValid(input string, defaultRegion countries.Alpha2) bool {
+	_, err := Parse(input, defaultRegion)
+	return err == nil
+}
+
+// String returns the number in E.164 format.
+func (n *Number) String() string {
+	return n.E164
+}
+
+// extractDigits strips the separators and reports whether the number is in international format
+//
+// Only digits, a leading "+" and the separators (spaces, dashes, dots, slashes, parentheses) are accepted;
+// any other character (e.g., a letter or an extension mark) is an ErrInvalidCharacters error.
+func extractDigits(input string) (string, bool, error) {
+	input = strings.TrimSpace(input)
+	if input == "" {
+		return "", false, ErrEmptyNumber
+	}
+
+	rest, international := strings.CutPrefix(input, "+")
+
+	var b strings.Builder
+	b.Grow(len(rest))
+	for i := 0; i < len(rest); i++ {
+		switch ch := rest[i]; {
+		case ch >= '0' && ch <= '9':
+			b.WriteByte(ch)
+		case ch == ' ' || ch == '-' || ch == '.' || ch == '/' || ch == '(' || ch == ')':
+		default:
+			return "", false, fmt.Errorf("%w: %q", ErrInvalidCharacters, input)
+		}
+	}
+
+	digits := b.String()
+	if !international {
+		// Calling codes never start with a zero, so "00" is always an international prefix
+		digits, international = strings.CutPrefix(digits, "00")
+	}
+	if digits == "" {
+		return "", false, ErrEmptyNumber
+	}
+
+	return digits, international, nil
+}
+
+// splitCallingCode splits the leading international calling code from the digits of an international number
+//
+// Calling codes are prefix-free (ITU-T E.164), so the first known code is the only possible one.
+func splitCallingCode(digits string) (callingCode, national string) {
+	for n := 1; n <= maxCallingCodeDigits && n <= len(digits); n++ {
+		if countries.GetByCallingCode(digits[:n]) != nil {
+			return digits[:n], digits[n:]
+		}
+	}
+	return "", ""
+}
+
+// candidateCountries lists the countries with a numbering plan that use the calling code, most likely first
+//
+// A country identified by a specific prefix (e.g., a NANP area code) comes first, followed by
+// the default region, the main country of the calling code and the remaining countries.
+func candidateCountries(callingCode, national string, region *countries.Country) countries.CountryList {
+	primary := countries.GetByCallingCode(callingCode)
+
+	// Resolve the prefix of the national significant number, without the national prefix of national formats
+	// (e.g., "07911 123456" in the UK resolves like "+44 7911 123456")
+	significant := national
+	for _, c := range []*countries.Country{region, primary} {
+		if c != nil && rules[c.Alpha2] != nil && slices.Contains(c.CallingCodes, "+"+callingCode) {
+			significant = rules[c.Alpha2].trimNationalPrefix(national)
+			break
+		}
+	}
+
+	var ordered countries.CountryList
+	if resolved := countries.ResolvePhonePrefix(callingCode + significant); resolved != primary {
+		ordered = append(ordered, resolved)
+	}
+	ordered = append(ordered, region, primary)
+	ordered = append(ordered, countries.CountriesByCallingCode(callingCode)...)
+
+	candidates := make(countries.CountryList, 0, len(ordered))
+	for _, c := range ordered {
+		if c == nil || rules[c.Alpha2] == nil || slices.Contains(candidates, c) ||
+			!slices.Contains(c.CallingCodes, "+"+callingCode) {
+			continue
+		}
+		candidates = append(candidates, c)
+	}
+	return candidates
+}
+
+// matches reports whether the national significant number has a possible length and matches the pattern
+func (r *rule) matches(national string) bool {
+	if !slices.Contains(r.lengths, len(national)) {
+		return false
+	}
+	r.once.Do(func() {
+		r.re = regexp.MustCompile("^(?:" + r.pattern + ")$")
+	})
+	return r.re.MatchString(national)
+}
+
+// trimNationalPrefix strips the national prefix from digits that are not a valid national significant number
+func (r *rule) trimNationalPrefix(digits string) string {
+	if r.nationalPrefix == "" || r.matches(digits) {
+		return digits
+	}
+	if trimmed, ok := strings.CutPrefix(digits, r.nationalPrefix); ok {
+		return trimmed
+	}
+	return digits
+}
+
+// nationalNumber returns the national significant number, stripping the national prefix when needed
+func (r *rule) nationalNumber(digits string) (string, bool) {
+	if r.matches(digits) {
+		return digits, true
+	}
+	if r.nationalPrefix != "" {
+		if trimmed, ok := strings.CutPrefix(digits, r.nationalPrefix); ok && r.matches(trimmed) {
+			return trimmed, true
+		}
+	}
+	return "", false
+}
+
+// validationError explains why the digits are not a valid national number
+//
+// The national prefix is only ignored when the digits do not already have a possible length.
+func (r *rule) validationError(digits, input string) error {
+	if !slices.Contains(r.lengths, len(digits)) && r.nationalPrefix != "" {
+		digits = strings.TrimPrefix(digits, r.nationalPrefix)
+	}
+	switch {
+	case len(digits) < slices.Min(r.lengths):
+		return fmt.Errorf("%w: %q", ErrTooShort, input)
+	case len(digits) > slices.Max(r.lengths):
+		return fmt.Errorf("%w: %q", ErrTooLong, input)
+	default:
+		return fmt.Errorf("%w: %q", ErrInvalidNumber, input)
+	}
+}
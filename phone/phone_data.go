@@ -0,0 +1,249 @@
+// Code generated by go generate; DO NOT EDIT.
+// This file was generated by robots at
+// https://github.com/mrz1836/go-countries
+package phone
+
+var rules = map[string]*rule{
+	"AD": {lengths: []int{6, 8, 9}, nationalPrefix: "", pattern: "(?:1|6\\d)\\d{7}|[135-9]\\d{5}"},
+	"AE": {lengths: []int{5, 6, 7, 8, 9, 10, 11, 12}, nationalPrefix: "0", pattern: "(?:[4-7]\\d|9[0-689])\\d{7}|800\\d{2,9}|[2-4679]\\d{7}"},
+	"AF": {lengths: []int{9}, nationalPrefix: "0", pattern: "[2-7]\\d{8}"},
+	"AG": {lengths: []int{10}, nationalPrefix: "1", pattern: "(?:268|[58]\\d\\d|900)\\d{7}"},
+	"AI": {lengths: []int{10}, nationalPrefix: "1", pattern: "(?:264|[58]\\d\\d|900)\\d{7}"},
+	"AL": {lengths: []int{6, 7, 8, 9}, nationalPrefix: "0", pattern: "(?:700\\d\\d|900)\\d{3}|8\\d{5,7}|(?:[2-5]|6\\d)\\d{7}"},
+	"AM": {lengths: []int{8}, nationalPrefix: "0", pattern: "(?:[1-489]\\d|55|60|77)\\d{6}"},
+	"AO": {lengths: []int{9}, nationalPrefix: "", pattern: "[29]\\d{8}"},
+	"AR": {lengths: []int{10, 11}, nationalPrefix: "0", pattern: "(?:11|[89]\\d\\d)\\d{8}|[2368]\\d{9}"},
+	"AS": {lengths: []int{10}, nationalPrefix: "1", pattern: "(?:[58]\\d\\d|684|900)\\d{7}"},
+	"AT": {lengths: []int{4, 5, 6, 7, 8, 9, 10, 11, 12, 13}, nationalPrefix: "0", pattern: "1\\d{3,12}|2\\d{6,12}|43(?:(?:0\\d|5[02-9])\\d{3,9}|2\\d{4,5}|[3467]\\d{4}|8\\d{4,6}|9\\d{4,7})|5\\d{4,12}|8\\d{7,12}|9\\d{8,12}|(?:[367]\\d|4[0-24-9])\\d{4,11}"},
+	"AU": {lengths: []int{5, 6, 7, 8, 9, 10, 12}, nationalPrefix: "0", pattern: "1(?:[0-79]\\d{7}(?:\\d(?:\\d{2})?)?|8[0-24-9]\\d{7})|[2-478]\\d{8}|1\\d{4,7}"},
+	"AW": {lengths: []int{7}, nationalPrefix: "", pattern: "(?:[25-79]\\d\\d|800)\\d{4}"},
+	"AX": {lengths: []int{5, 6, 7, 8, 9, 10, 11, 12}, nationalPrefix: "0", pattern: "2\\d{4,9}|35\\d{4,5}|(?:60\\d\\d|800)\\d{4,6}|7\\d{5,11}|(?:[14]\\d|3[0-46-9]|50)\\d{4,8}"},
+	"AZ": {lengths: []int{9}, nationalPrefix: "0", pattern: "365\\d{6}|(?:[124579]\\d|60|88)\\d{7}"},
+	"BA": {lengths: []int{8, 9}, nationalPrefix: "0", pattern: "6\\d{8}|(?:[35689]\\d|49|70)\\d{6}"},
+	"BB": {lengths: []int{10}, nationalPrefix: "1", pattern: "(?:246|[58]\\d\\d|900)\\d{7}"},
+	"BD": {lengths: []int{6, 7, 8, 9, 10}, nationalPrefix: "0", pattern: "[1-469]\\d{9}|8[0-79]\\d{7,8}|[2-79]\\d{8}|[2-9]\\d{7}|[3-9]\\d{6}|[57-9]\\d{5}"},
+	"BE": {lengths: []int{8, 9}, nationalPrefix: "0", pattern: "4\\d{8}|[1-9]\\d{7}"},
+	"BF": {lengths: []int{8}, nationalPrefix: "", pattern: "[024-7]\\d{7}"},
+	"BG": {lengths: []int{6, 7, 8, 9, 12}, nationalPrefix: "0", pattern: "00800\\d{7}|[2-7]\\d{6,7}|[89]\\d{6,8}|2\\d{5}"},
+	"BH": {lengths: []int{8}, nationalPrefix: "", pattern: "[136-9]\\d{7}"},
+	"BI": {lengths: []int{8}, nationalPrefix: "", pattern: "(?:[267]\\d|31)\\d{6}"},
+	"BJ": {lengths: []int{8, 10}, nationalPrefix: "", pattern: "(?:01\\d|8)\\d{7}"},
+	"BL": {lengths: []int{9}, nationalPrefix: "0", pattern: "7090\\d{5}|(?:[56]9|[89]\\d)\\d{7}"},
+	"BM": {lengths: []int{10}, nationalPrefix: "1", pattern: "(?:441|[58]\\d\\d|900)\\d{7}"},
+	"BN": {lengths: []int{7}, nationalPrefix: "", pattern: "[2-578]\\d{6}"},
+	"BO": {lengths: []int{8, 9}, nationalPrefix: "0", pattern: "(?:[2-7]\\d\\d|8001)\\d{5}"},
+	"BQ": {lengths: []int{7}, nationalPrefix: "", pattern: "(?:[34]1|7\\d)\\d{5}"},
+	"BR": {lengths: []int{8, 9, 10, 11}, nationalPrefix: "0", pattern: "[1-467]\\d{9,10}|55[0-46-9]\\d{8}|[34]\\d{7}|55\\d{7,8}|(?:5[0-46-9]|[89]\\d)\\d{7,9}"},
+	"BS": {lengths: []int{10}, nationalPrefix: "1", pattern: "(?:242|[58]\\d\\d|900)\\d{7}"},
+	"BT": {lengths: []int{7, 8}, nationalPrefix: "", pattern: "[178]\\d{7}|[2-8]\\d{6}"},
+	"BW": {lengths: []int{7, 8, 10}, nationalPrefix: "", pattern: "(?:0800|(?:[37]|800)\\d)\\d{6}|(?:[2-6]\\d|90)\\d{5}"},
+	"BY": {lengths: []int{6, 7, 8, 9, 10, 11}, nationalPrefix: "8", pattern: "(?:[12]\\d|33|44|902)\\d{7}|8(?:0[0-79]\\d{5,7}|[1-7]\\d{9})|8(?:1[0-489]|[5-79]\\d)\\d{7}|8[1-79]\\d{6,7}|8[0-79]\\d{5}|8\\d{5}"},
+	"BZ": {lengths: []int{7, 11}, nationalPrefix: "", pattern: "(?:0800\\d|[2-8])\\d{6}"},
+	"CA": {lengths: []int{7, 10}, nationalPrefix: "1", pattern: "[2-9]\\d{9}|3\\d{6}"},
+	"CC": {lengths: []int{6, 7, 8, 9, 10, 12}, nationalPrefix: "0", pattern: "1(?:[0-79]\\d{8}(?:\\d{2})?|8[0-24-9]\\d{7})|[148]\\d{8}|1\\d{5,7}"},
+	"CD": {lengths: []int{7, 8, 9, 10}, nationalPrefix: "0", pattern: "(?:(?:[189]|5\\d)\\d|2)\\d{7}|[1-68]\\d{6}"},
+	"CF": {lengths: []int{8}, nationalPrefix: "", pattern: "8776\\d{4}|(?:[27]\\d|61)\\d{6}"},
+	"CG": {lengths: []int{9}, nationalPrefix: "", pattern: "222\\d{6}|(?:0\\d|80)\\d{7}"},
+	"CH": {lengths: []int{9, 12}, nationalPrefix: "0", pattern: "8\\d{11}|[2-9]\\d{8}"},
+	"CI": {lengths: []int{10}, nationalPrefix: "", pattern: "[02]\\d{9}"},
+	"CK": {lengths: []int{5}, nationalPrefix: "", pattern: "[2-578]\\d{4}"},
+	"CL": {lengths: []int{9, 10, 11}, nationalPrefix: "", pattern: "12300\\d{6}|6\\d{9,10}|[2-9]\\d{8}"},
+	"CM": {lengths: []int{8, 9}, nationalPrefix: "", pattern: "[26]\\d{8}|88\\d{6,7}"},
+	"CN": {lengths: []int{7, 8, 9, 10, 11, 12}, nationalPrefix: "0", pattern: "(?:(?:1[03-689]|2\\d)\\d\\d|6)\\d{8}|1\\d{10}|[126]\\d{6}(?:\\d(?:\\d{2})?)?|86\\d{5,6}|(?:[3-579]\\d|8[0-57-9])\\d{5,9}"},
+	"CO": {lengths: []int{8, 10, 11}, nationalPrefix: "0", pattern: "(?:46|60\\d\\d)\\d{6}|(?:1\\d|[39])\\d{9}"},
+	"CR": {lengths: []int{8, 10}, nationalPrefix: "", pattern: "(?:8\\d|90)\\d{8}|(?:[24-8]\\d{3}|3005)\\d{4}"},
+	"CU": {lengths: []int{6, 7, 8, 10}, nationalPrefix: "0", pattern: "(?:[2-7]|8\\d\\d)\\d{7}|[2-47]\\d{6}|[34]\\d{5}"},
+	"CV": {lengths: []int{7}, nationalPrefix: "", pattern: "(?:[2-59]\\d\\d|800)\\d{4}"},
+	"CW": {lengths: []int{7, 8}, nationalPrefix: "", pattern: "(?:[34]1|60|(?:7|9\\d)\\d)\\d{5}"},
+	"CX": {lengths: []int{6, 7, 8, 9, 10, 12}, nationalPrefix: "0", pattern: "1(?:[0-79]\\d{8}(?:\\d{2})?|8[0-24-9]\\d{7})|[148]\\d{8}|1\\d{5,7}"},
+	"CY": {lengths: []int{8}, nationalPrefix: "", pattern: "(?:[279]\\d|[58]0)\\d{6}"},
+	"CZ": {lengths: []int{9, 10, 11, 12}, nationalPrefix: "", pattern: "(?:[2-578]\\d|60)\\d{7}|9\\d{8,11}"},
+	"DE": {lengths: []int{4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}, nationalPrefix: "0", pattern: "[2579]\\d{5,14}|49(?:[34]0|69|8\\d)\\d\\d?|49(?:37|49|60|7[089]|9\\d)\\d{1,3}|49(?:2[024-9]|3[2-689]|7[1-7])\\d{1,8}|(?:1|[368]\\d|4[0-8])\\d{3,13}|49(?:[015]\\d|2[13]|31|[46][1-8])\\d{1,9}"},
+	"DJ": {lengths: []int{8}, nationalPrefix: "", pattern: "(?:2\\d|77)\\d{6}"},
+	"DK": {lengths: []int{8}, nationalPrefix: "", pattern: "[2-9]\\d{7}"},
+	"DM": {lengths: []int{10}, nationalPrefix: "1", pattern: "(?:[58]\\d\\d|767|900)\\d{7}"},
+	"DO": {lengths: []int{10}, nationalPrefix: "1", pattern: "(?:[58]\\d\\d|900)\\d{7}"},
+	"DZ": {lengths: []int{8, 9}, nationalPrefix: "0", pattern: "(?:[1-4]|[5-79]\\d|80)\\d{7}"},
+	"EC": {lengths: []int{8, 9, 10, 11}, nationalPrefix: "0", pattern: "1\\d{9,10}|(?:[2-7]|9\\d)\\d{7}"},
+	"EE": {lengths: []int{7, 8, 10}, nationalPrefix: "", pattern: "8\\d{9}|[4578]\\d{7}|(?:[3-8]\\d|90)\\d{5}"},
+	"EG": {lengths: []int{8, 9, 10}, nationalPrefix: "0", pattern: "[189]\\d{8,9}|[24-6]\\d{8}|[135]\\d{7}"},
+	"EH": {lengths: []int{9}, nationalPrefix: "0", pattern: "[5-8]\\d{8}"},
+	"ER": {lengths: []int{7}, nationalPrefix: "0", pattern: "[178]\\d{6}"},
+	"ES": {lengths: []int{9}, nationalPrefix: "", pattern: "[5-9]\\d{8}"},
+	"ET": {lengths: []int{9}, nationalPrefix: "0", pattern: "(?:11|[2-57-9]\\d)\\d{7}"},
+	"FI": {lengths: []int{5, 6, 7, 8, 9, 10, 11, 12}, nationalPrefix: "0", pattern: "[1-35689]\\d{4}|7\\d{10,11}|(?:[124-7]\\d|3[0-46-9])\\d{8}|[1-9]\\d{5,8}"},
+	"FJ": {lengths: []int{7, 11}, nationalPrefix: "", pattern: "45\\d{5}|(?:0800\\d|[235-9])\\d{6}"},
+	"FK": {lengths: []int{5}, nationalPrefix: "", pattern: "[2-7]\\d{4}"},
+	"FM": {lengths: []int{7}, nationalPrefix: "", pattern: "(?:[39]\\d\\d|820)\\d{4}"},
+	"FO": {lengths: []int{6}, nationalPrefix: "", pattern: "[2-9]\\d{5}"},
+	"FR": {lengths: []int{9}, nationalPrefix: "0", pattern: "[1-9]\\d{8}"},
+	"GA": {lengths: []int{7, 8}, nationalPrefix: "", pattern: "(?:[067]\\d|11)\\d{6}|[2-7]\\d{6}"},
+	"GB": {lengths: []int{7, 9, 10}, nationalPrefix: "0", pattern: "[1-357-9]\\d{9}|[18]\\d{8}|8\\d{6}"},
+	"GD": {lengths: []int{10}, nationalPrefix: "1", pattern: "(?:473|[58]\\d\\d|900)\\d{7}"},
+	"GE": {lengths: []int{9}, nationalPrefix: "0", pattern: "(?:[3-57]\\d\\d|800)\\d{6}"},
+	"GF": {lengths: []int{9}, nationalPrefix: "0", pattern: "(?:694\\d|7093)\\d{5}|(?:59|[89]\\d)\\d{7}"},
+	"GG": {lengths: []int{7, 9, 10}, nationalPrefix: "0", pattern: "(?:1481|[357-9]\\d{3})\\d{6}|8\\d{6}(?:\\d{2})?"},
+	"GH": {lengths: []int{8, 9}, nationalPrefix: "0", pattern: "[235]\\d{8}|800\\d{5,6}"},
+	"GI": {lengths: []int{8}, nationalPrefix: "", pattern: "(?:[25]\\d|60)\\d{6}"},
+	"GL": {lengths: []int{6}, nationalPrefix: "", pattern: "(?:19|[2-689]\\d|70)\\d{4}"},
+	"GM": {lengths: []int{7}, nationalPrefix: "", pattern: "[2-9]\\d{6}"},
+	"GN": {lengths: []int{8, 9}, nationalPrefix: "", pattern: "722\\d{6}|(?:3|6\\d)\\d{7}"},
+	"GP": {lengths: []int{9}, nationalPrefix: "0", pattern: "7090\\d{5}|(?:[56]9|[89]\\d)\\d{7}"},
+	"GQ": {lengths: []int{9}, nationalPrefix: "", pattern: "222\\d{6}|(?:3\\d|55|[89]0)\\d{7}"},
+	"GR": {lengths: []int{10, 11, 12}, nationalPrefix: "", pattern: "5005000\\d{3}|8\\d{9,11}|(?:[269]\\d|70)\\d{8}"},
+	"GT": {lengths: []int{8, 11}, nationalPrefix: "", pattern: "80\\d{6}|(?:1\\d{3}|[2-7])\\d{7}"},
+	"GU": {lengths: []int{10}, nationalPrefix: "1", pattern: "(?:[58]\\d\\d|671|900)\\d{7}"},
+	"GW": {lengths: []int{7, 9}, nationalPrefix: "", pattern: "[49]\\d{8}|4\\d{6}"},
+	"GY": {lengths: []int{7}, nationalPrefix: "", pattern: "(?:[2-8]\\d{3}|9008)\\d{3}"},
+	"HK": {lengths: []int{5, 6, 7, 8, 9, 11}, nationalPrefix: "", pattern: "8[0-46-9]\\d{6,7}|9\\d{4,7}|(?:[2-7]|9\\d{3})\\d{7}"},
+	"HN": {lengths: []int{8, 11}, nationalPrefix: "", pattern: "8\\d{10}|[237-9]\\d{7}"},
+	"HR": {lengths: []int{7, 8, 9}, nationalPrefix: "0", pattern: "[2-69]\\d{8}|80\\d{5,7}|[1-79]\\d{7}|6\\d{6}"},
+	"HT": {lengths: []int{8}, nationalPrefix: "", pattern: "[2-589]\\d{7}"},
+	"HU": {lengths: []int{8, 9}, nationalPrefix: "06", pattern: "[235-7]\\d{8}|[1-9]\\d{7}"},
+	"ID": {lengths: []int{7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17}, nationalPrefix: "0", pattern: "00[1-9]\\d{9,14}|(?:[1-36]|8\\d{5})\\d{6}|00\\d{9}|[1-9]\\d{8,10}|[2-9]\\d{7}"},
+	"IE": {lengths: []int{7, 8, 9, 10}, nationalPrefix: "0", pattern: "(?:1\\d|[2569])\\d{6,8}|4\\d{6,9}|7\\d{8}|8\\d{8,9}"},
+	"IL": {lengths: []int{7, 8, 9, 10, 11, 12}, nationalPrefix: "0", pattern: "1\\d{6}(?:\\d{3,5})?|[57]\\d{8}|[1-489]\\d{7}"},
+	"IM": {lengths: []int{10}, nationalPrefix: "0", pattern: "1624\\d{6}|(?:[3578]\\d|90)\\d{8}"},
+	"IN": {lengths: []int{8, 9, 10, 11, 12, 13}, nationalPrefix: "0", pattern: "(?:000800|[2-9]\\d\\d)\\d{7}|1\\d{7,12}"},
+	"IO": {lengths: []int{7}, nationalPrefix: "", pattern: "3\\d{6}"},
+	"IQ": {lengths: []int{8, 9, 10}, nationalPrefix: "0", pattern: "(?:1|7\\d\\d)\\d{7}|[2-6]\\d{7,8}"},
+	"IR": {lengths: []int{4, 5, 6, 7, 10}, nationalPrefix: "0", pattern: "[1-9]\\d{9}|(?:[1-8]\\d\\d|9)\\d{3,4}"},
+	"IS": {lengths: []int{7, 9}, nationalPrefix: "", pattern: "(?:38\\d|[4-9])\\d{6}"},
+	"IT": {lengths: []int{6, 7, 8, 9, 10, 11, 12}, nationalPrefix: "", pattern: "0\\d{5,11}|1\\d{8,10}|3(?:[0-8]\\d{7,10}|9\\d{7,8})|(?:43|55|70)\\d{8}|8\\d{5}(?:\\d{2,4})?"},
+	"JE": {lengths: []int{10}, nationalPrefix: "0", pattern: "1534\\d{6}|(?:[3578]\\d|90)\\d{8}"},
+	"JM": {lengths: []int{10}, nationalPrefix: "1", pattern: "(?:[58]\\d\\d|658|900)\\d{7}"},
+	"JO": {lengths: []int{8, 9}, nationalPrefix: "0", pattern: "(?:(?:[2689]|7\\d)\\d|32|427|53)\\d{6}"},
+	"JP": {lengths: []int{8, 9, 10, 11, 12, 13, 14, 15, 16, 17}, nationalPrefix: "0", pattern: "00[1-9]\\d{6,14}|[25-9]\\d{9}|(?:00|[1-9]\\d\\d)\\d{6}"},
+	"KE": {lengths: []int{7, 8, 9, 10}, nationalPrefix: "0", pattern: "(?:[17]\\d\\d|900)\\d{6}|(?:2|80)0\\d{6,7}|[4-6]\\d{6,8}"},
+	"KG": {lengths: []int{9, 10}, nationalPrefix: "0", pattern: "8\\d{9}|[235-9]\\d{8}"},
+	"KH": {lengths: []int{8, 9, 10}, nationalPrefix: "0", pattern: "1\\d{9}|[1-9]\\d{7,8}"},
+	"KI": {lengths: []int{5, 8}, nationalPrefix: "0", pattern: "(?:[37]\\d|6[0-79])\\d{6}|(?:[2-48]\\d|50)\\d{3}"},
+	"KM": {lengths: []int{7}, nationalPrefix: "", pattern: "[3478]\\d{6}"},
+	"KN": {lengths: []int{10}, nationalPrefix: "1", pattern: "(?:[58]\\d\\d|900)\\d{7}"},
+	"KP": {lengths: []int{8, 10}, nationalPrefix: "0", pattern: "85\\d{6}|(?:19\\d|[2-7])\\d{7}"},
+	"KR": {lengths: []int{5, 6, 8, 9, 10, 11, 12, 13, 14}, nationalPrefix: "0", pattern: "00[1-9]\\d{8,11}|(?:[12]|5\\d{3})\\d{7}|[13-6]\\d{9}|(?:[1-6]\\d|80)\\d{7}|[3-6]\\d{4,5}|(?:00|7)0\\d{8}"},
+	"KW": {lengths: []int{7, 8}, nationalPrefix: "", pattern: "18\\d{5}|(?:[2569]\\d|41)\\d{6}"},
+	"KY": {lengths: []int{10}, nationalPrefix: "1", pattern: "(?:345|[58]\\d\\d|900)\\d{7}"},
+	"KZ": {lengths: []int{10, 14}, nationalPrefix: "8", pattern: "8\\d{13}|[78]\\d{9}"},
+	"LA": {lengths: []int{8, 9, 10}, nationalPrefix: "0", pattern: "[23]\\d{9}|3\\d{8}|(?:[235-8]\\d|41)\\d{6}"},
+	"LB": {lengths: []int{7, 8}, nationalPrefix: "0", pattern: "[27-9]\\d{7}|[13-9]\\d{6}"},
+	"LC": {lengths: []int{10}, nationalPrefix: "1", pattern: "(?:[58]\\d\\d|758|900)\\d{7}"},
+	"LI": {lengths: []int{7, 9}, nationalPrefix: "0", pattern: "[68]\\d{8}|(?:[2378]\\d|90)\\d{5}"},
+	"LK": {lengths: []int{9}, nationalPrefix: "0", pattern: "[1-9]\\d{8}"},
+	"LR": {lengths: []int{7, 8, 9}, nationalPrefix: "0", pattern: "(?:[2457]\\d|33|88)\\d{7}|(?:2\\d|[4-6])\\d{6}"},
+	"LS": {lengths: []int{8}, nationalPrefix: "", pattern: "(?:[256]\\d\\d|800)\\d{5}"},
+	"LT": {lengths: []int{8}, nationalPrefix: "0", pattern: "(?:[3469]\\d|52|[78]0)\\d{6}"},
+	"LU": {lengths: []int{4, 5, 6, 7, 8, 9, 10, 11}, nationalPrefix: "", pattern: "35[013-9]\\d{4,8}|6\\d{8}|35\\d{2,4}|(?:[2457-9]\\d|3[0-46-9])\\d{2,9}"},
+	"LV": {lengths: []int{8}, nationalPrefix: "", pattern: "(?:[268]\\d|78|90)\\d{6}"},
+	"LY": {lengths: []int{9}, nationalPrefix: "0", pattern: "[2-9]\\d{8}"},
+	"MA": {lengths: []int{9}, nationalPrefix: "0", pattern: "[5-8]\\d{8}"},
+	"MC": {lengths: []int{8, 9}, nationalPrefix: "0", pattern: "(?:[3489]|[67]\\d)\\d{7}"},
+	"MD": {lengths: []int{8}, nationalPrefix: "0", pattern: "(?:[235-7]\\d|[89]0)\\d{6}"},
+	"ME": {lengths: []int{8, 9}, nationalPrefix: "0", pattern: "(?:20|[3-79]\\d)\\d{6}|80\\d{6,7}"},
+	"MF": {lengths: []int{9}, nationalPrefix: "0", pattern: "7090\\d{5}|(?:[56]9|[89]\\d)\\d{7}"},
+	"MG": {lengths: []int{9}, nationalPrefix: "0", pattern: "[23]\\d{8}"},
+	"MH": {lengths: []int{7}, nationalPrefix: "1", pattern: "329\\d{4}|(?:[256]\\d|45)\\d{5}"},
+	"MK": {lengths: []int{8}, nationalPrefix: "0", pattern: "[2-578]\\d{7}"},
+	"ML": {lengths: []int{8}, nationalPrefix: "", pattern: "[24-9]\\d{7}"},
+	"MM": {lengths: []int{6, 7, 8, 9, 10}, nationalPrefix: "0", pattern: "1\\d{5,7}|95\\d{6}|(?:[4-7]|9[0-46-9])\\d{6,8}|(?:2|8\\d)\\d{5,8}"},
+	"MN": {lengths: []int{8, 9, 10}, nationalPrefix: "0", pattern: "[12]\\d{7,9}|[5-9]\\d{7}"},
+	"MO": {lengths: []int{7, 8}, nationalPrefix: "", pattern: "0800\\d{3}|(?:28|[68]\\d)\\d{6}"},
+	"MP": {lengths: []int{10}, nationalPrefix: "1", pattern: "[58]\\d{9}|(?:67|90)0\\d{7}"},
+	"MQ": {lengths: []int{9}, nationalPrefix: "0", pattern: "7091\\d{5}|(?:[56]9|[89]\\d)\\d{7}"},
+	"MR": {lengths: []int{8}, nationalPrefix: "", pattern: "(?:[2-4]\\d\\d|800)\\d{5}"},
+	"MS": {lengths: []int{10}, nationalPrefix: "1", pattern: "(?:[58]\\d\\d|664|900)\\d{7}"},
+	"MT": {lengths: []int{8}, nationalPrefix: "", pattern: "3550\\d{4}|(?:[2579]\\d\\d|800)\\d{5}"},
+	"MU": {lengths: []int{7, 8, 10}, nationalPrefix: "", pattern: "(?:[57]|8\\d\\d)\\d{7}|[2-468]\\d{6}"},
+	"MV": {lengths: []int{7, 10}, nationalPrefix: "", pattern: "(?:800|9[0-57-9]\\d)\\d{7}|[34679]\\d{6}"},
+	"MW": {lengths: []int{7, 9}, nationalPrefix: "0", pattern: "(?:[1289]\\d|31|77)\\d{7}|1\\d{6}"},
+	"MX": {lengths: []int{10}, nationalPrefix: "", pattern: "[2-9]\\d{9}"},
+	"MY": {lengths: []int{8, 9, 10}, nationalPrefix: "0", pattern: "1\\d{8,9}|(?:3\\d|[4-9])\\d{7}"},
+	"MZ": {lengths: []int{8, 9}, nationalPrefix: "", pattern: "(?:2|8\\d)\\d{7}"},
+	"NA": {lengths: []int{8, 9}, nationalPrefix: "0", pattern: "[68]\\d{7,8}"},
+	"NC": {lengths: []int{6}, nationalPrefix: "", pattern: "(?:050|[2-57-9]\\d\\d)\\d{3}"},
+	"NE": {lengths: []int{8}, nationalPrefix: "", pattern: "[027-9]\\d{7}"},
+	"NF": {lengths: []int{6}, nationalPrefix: "", pattern: "[13]\\d{5}"},
+	"NG": {lengths: []int{10, 11, 12, 13, 14}, nationalPrefix: "0", pattern: "(?:20|9\\d)\\d{8}|[78]\\d{9,13}"},
+	"NI": {lengths: []int{8}, nationalPrefix: "", pattern: "(?:1800|[25-8]\\d{3})\\d{4}"},
+	"NL": {lengths: []int{5, 6, 7, 8, 9, 10, 11}, nationalPrefix: "0", pattern: "(?:[124-7]\\d\\d|3(?:[02-9]\\d|1[0-8]))\\d{6}|8\\d{6,9}|9\\d{6,10}|1\\d{4,5}"},
+	"NO": {lengths: []int{5, 8}, nationalPrefix: "", pattern: "(?:0|[2-9]\\d{3})\\d{4}"},
+	"NP": {lengths: []int{8, 10, 11}, nationalPrefix: "0", pattern: "(?:1\\d|9)\\d{9}|[1-9]\\d{7}"},
+	"NR": {lengths: []int{7}, nationalPrefix: "", pattern: "(?:222|444|(?:55|8\\d)\\d|666|777|999)\\d{4}"},
+	"NU": {lengths: []int{4, 7}, nationalPrefix: "", pattern: "(?:[4-7]|888\\d)\\d{3}"},
+	"NZ": {lengths: []int{5, 6, 7, 8, 9, 10}, nationalPrefix: "0", pattern: "[1289]\\d{9}|50\\d{5}(?:\\d{2,3})?|[27-9]\\d{7,8}|(?:[34]\\d|6[0-35-9])\\d{6}|8\\d{4,6}"},
+	"OM": {lengths: []int{7, 8, 9}, nationalPrefix: "", pattern: "(?:1505|[279]\\d{3}|500)\\d{4}|800\\d{5,6}"},
+	"PA": {lengths: []int{7, 8, 10, 11}, nationalPrefix: "", pattern: "(?:00800|8\\d{3})\\d{6}|[68]\\d{7}|[1-57-9]\\d{6}"},
+	"PE": {lengths: []int{8, 9}, nationalPrefix: "0", pattern: "(?:[14-8]|9\\d)\\d{7}"},
+	"PF": {lengths: []int{6, 8, 9}, nationalPrefix: "", pattern: "4\\d{5}(?:\\d{2})?|8\\d{7,8}"},
+	"PG": {lengths: []int{7, 8}, nationalPrefix: "", pattern: "(?:180|[78]\\d{3})\\d{4}|(?:[2-589]\\d|64)\\d{5}"},
+	"PH": {lengths: []int{6, 8, 9, 10, 11, 12, 13}, nationalPrefix: "0", pattern: "(?:[2-7]|9\\d)\\d{8}|2\\d{5}|(?:1800|8)\\d{7,9}"},
+	"PK": {lengths: []int{8, 9, 10, 11, 12}, nationalPrefix: "0", pattern: "122\\d{6}|[24-8]\\d{10,11}|9(?:[013-9]\\d{8,10}|2(?:[01]\\d\\d|2(?:[06-8]\\d|1[01]))\\d{7})|(?:[2-8]\\d{3}|92(?:[0-7]\\d|8[1-9]))\\d{6}|[24-9]\\d{8}|[89]\\d{7}"},
+	"PL": {lengths: []int{6, 7, 8, 9, 10}, nationalPrefix: "", pattern: "(?:6|8\\d\\d)\\d{7}|[1-9]\\d{6}(?:\\d{2})?|[26]\\d{5}"},
+	"PM": {lengths: []int{6, 9}, nationalPrefix: "0", pattern: "[78]\\d{8}|[2-9]\\d{5}"},
+	"PR": {lengths: []int{10}, nationalPrefix: "1", pattern: "(?:[589]\\d\\d|787)\\d{7}"},
+	"PS": {lengths: []int{8, 9, 10}, nationalPrefix: "0", pattern: "[2489]2\\d{6}|(?:1\\d|5)\\d{8}"},
+	"PT": {lengths: []int{9}, nationalPrefix: "", pattern: "1693\\d{5}|(?:[26-9]\\d|30)\\d{7}"},
+	"PW": {lengths: []int{7}, nationalPrefix: "", pattern: "(?:[24-8]\\d\\d|345|900)\\d{4}"},
+	"PY": {lengths: []int{6, 7, 8, 9, 10, 11}, nationalPrefix: "0", pattern: "[36-8]\\d{5,8}|4\\d{6,8}|59\\d{6}|9\\d{5,10}|(?:2\\d|5[0-8])\\d{6,7}"},
+	"QA": {lengths: []int{7, 8, 9, 11}, nationalPrefix: "", pattern: "800\\d{4}|(?:2|800)\\d{6}|(?:0080|[3-7])\\d{7}"},
+	"RE": {lengths: []int{9}, nationalPrefix: "0", pattern: "709\\d{6}|(?:26|[689]\\d)\\d{7}"},
+	"RO": {lengths: []int{6, 9}, nationalPrefix: "0", pattern: "(?:[236-8]\\d|90)\\d{7}|[23]\\d{5}"},
+	"RS": {lengths: []int{6, 7, 8, 9, 10, 11, 12}, nationalPrefix: "0", pattern: "38[02-9]\\d{6,9}|6\\d{7,9}|90\\d{4,8}|38\\d{5,6}|(?:7\\d\\d|800)\\d{3,9}|(?:[12]\\d|3[0-79])\\d{5,10}"},
+	"RU": {lengths: []int{10, 14}, nationalPrefix: "8", pattern: "8\\d{13}|[347-9]\\d{9}"},
+	"RW": {lengths: []int{8, 9}, nationalPrefix: "0", pattern: "(?:06|[27]\\d\\d|[89]00)\\d{6}"},
+	"SA": {lengths: []int{9, 10}, nationalPrefix: "0", pattern: "(?:[15]\\d|800|92)\\d{7}"},
+	"SB": {lengths: []int{5, 7}, nationalPrefix: "", pattern: "[6-9]\\d{6}|[1-6]\\d{4}"},
+	"SC": {lengths: []int{7}, nationalPrefix: "", pattern: "(?:[2489]\\d|64)\\d{5}"},
+	"SD": {lengths: []int{9}, nationalPrefix: "0", pattern: "[19]\\d{8}"},
+	"SE": {lengths: []int{6, 7, 8, 9, 10, 12}, nationalPrefix: "0", pattern: "(?:[26]\\d\\d|9)\\d{9}|[1-9]\\d{8}|[1-689]\\d{7}|[1-4689]\\d{6}|2\\d{5}"},
+	"SG": {lengths: []int{8, 10, 11}, nationalPrefix: "", pattern: "(?:(?:1\\d|8)\\d\\d|7000)\\d{7}|[3689]\\d{7}"},
+	"SH": {lengths: []int{4, 5}, nationalPrefix: "", pattern: "(?:[256]\\d|8)\\d{3}"},
+	"SI": {lengths: []int{5, 6, 7, 8}, nationalPrefix: "0", pattern: "[1-7]\\d{7}|8\\d{4,7}|90\\d{4,6}"},
+	"SJ": {lengths: []int{5, 8}, nationalPrefix: "", pattern: "0\\d{4}|(?:[489]\\d|79)\\d{6}"},
+	"SK": {lengths: []int{6, 7, 9}, nationalPrefix: "0", pattern: "[2-689]\\d{8}|[2-59]\\d{6}|[2-5]\\d{5}"},
+	"SL": {lengths: []int{8}, nationalPrefix: "0", pattern: "(?:[237-9]\\d|66)\\d{6}"},
+	"SM": {lengths: []int{8, 10}, nationalPrefix: "", pattern: "(?:0549|[5-7]\\d)\\d{6}"},
+	"SN": {lengths: []int{9}, nationalPrefix: "", pattern: "(?:[378]\\d|93)\\d{7}"},
+	"SO": {lengths: []int{6, 7, 8, 9}, nationalPrefix: "0", pattern: "[346-9]\\d{8}|[12679]\\d{7}|[1-5]\\d{6}|[1348]\\d{5}"},
+	"SR": {lengths: []int{6, 7}, nationalPrefix: "", pattern: "(?:[2-5]|[6-9]\\d)\\d{5}"},
+	"SS": {lengths: []int{9}, nationalPrefix: "0", pattern: "[19]\\d{8}"},
+	"ST": {lengths: []int{7}, nationalPrefix: "", pattern: "(?:22|9\\d)\\d{5}"},
+	"SV": {lengths: []int{7, 8, 11}, nationalPrefix: "", pattern: "[25-7]\\d{7}|(?:80\\d|900)\\d{4}(?:\\d{4})?"},
+	"SX": {lengths: []int{10}, nationalPrefix: "1", pattern: "7215\\d{6}|(?:[58]\\d\\d|900)\\d{7}"},
+	"SY": {lengths: []int{8, 9}, nationalPrefix: "0", pattern: "[1-359]\\d{8}|[1-5]\\d{7}"},
+	"SZ": {lengths: []int{8, 9}, nationalPrefix: "", pattern: "0800\\d{4}|(?:[237]\\d|900)\\d{6}"},
+	"TC": {lengths: []int{10}, nationalPrefix: "1", pattern: "(?:[58]\\d\\d|649|900)\\d{7}"},
+	"TD": {lengths: []int{8}, nationalPrefix: "", pattern: "(?:22|[3689]\\d|77)\\d{6}"},
+	"TG": {lengths: []int{8}, nationalPrefix: "", pattern: "[279]\\d{7}"},
+	"TH": {lengths: []int{8, 9, 10, 13}, nationalPrefix: "0", pattern: "(?:001800|[2-57]|[689]\\d)\\d{7}|1\\d{7,9}"},
+	"TJ": {lengths: []int{9}, nationalPrefix: "", pattern: "(?:[0-57-9]\\d|66)\\d{7}"},
+	"TK": {lengths: []int{4, 5, 6, 7}, nationalPrefix: "", pattern: "[2-47]\\d{3,6}"},
+	"TL": {lengths: []int{7, 8}, nationalPrefix: "", pattern: "7\\d{7}|(?:[2-47]\\d|[89]0)\\d{5}"},
+	"TM": {lengths: []int{8}, nationalPrefix: "8", pattern: "(?:[1-6]\\d|71)\\d{6}"},
+	"TN": {lengths: []int{8}, nationalPrefix: "", pattern: "[2-57-9]\\d{7}"},
+	"TO": {lengths: []int{5, 7}, nationalPrefix: "", pattern: "(?:0800|(?:[5-8]\\d\\d|999)\\d)\\d{3}|[2-8]\\d{4}"},
+	"TR": {lengths: []int{7, 10, 12, 13}, nationalPrefix: "0", pattern: "4\\d{6}|8\\d{11,12}|(?:[2-58]\\d\\d|900)\\d{7}"},
+	"TT": {lengths: []int{10}, nationalPrefix: "1", pattern: "(?:[58]\\d\\d|900)\\d{7}"},
+	"TV": {lengths: []int{5, 6, 7}, nationalPrefix: "", pattern: "(?:2|7\\d\\d|90)\\d{4}"},
+	"TW": {lengths: []int{7, 8, 9, 10, 11}, nationalPrefix: "0", pattern: "[2-689]\\d{8}|7\\d{9,10}|[2-8]\\d{7}|2\\d{6}"},
+	"TZ": {lengths: []int{9}, nationalPrefix: "0", pattern: "(?:[25-8]\\d|41|90)\\d{7}"},
+	"UA": {lengths: []int{9, 10}, nationalPrefix: "0", pattern: "[89]\\d{9}|[3-9]\\d{8}"},
+	"UG": {lengths: []int{9}, nationalPrefix: "0", pattern: "800\\d{6}|(?:[29]0|[347]\\d)\\d{7}"},
+	"US": {lengths: []int{10}, nationalPrefix: "1", pattern: "[2-9]\\d{9}|3\\d{6}"},
+	"UY": {lengths: []int{4, 5, 6, 7, 8, 9, 10, 11, 12, 13}, nationalPrefix: "0", pattern: "0004\\d{2,9}|[1249]\\d{7}|2\\d{3,4}|(?:[49]\\d|80)\\d{5}"},
+	"UZ": {lengths: []int{9}, nationalPrefix: "", pattern: "(?:20|33|[5-9]\\d)\\d{7}"},
+	"VA": {lengths: []int{6, 7, 8, 9, 10, 11, 12}, nationalPrefix: "", pattern: "0\\d{5,10}|3[0-8]\\d{7,10}|55\\d{8}|8\\d{5}(?:\\d{2,4})?|(?:1\\d|39)\\d{7,8}"},
+	"VC": {lengths: []int{10}, nationalPrefix: "1", pattern: "(?:[58]\\d\\d|784|900)\\d{7}"},
+	"VE": {lengths: []int{10}, nationalPrefix: "0", pattern: "[68]00\\d{7}|(?:[24]\\d|[59]0)\\d{8}"},
+	"VG": {lengths: []int{10}, nationalPrefix: "1", pattern: "(?:284|[58]\\d\\d|900)\\d{7}"},
+	"VI": {lengths: []int{10}, nationalPrefix: "1", pattern: "[58]\\d{9}|(?:34|90)0\\d{7}"},
+	"VN": {lengths: []int{7, 8, 9, 10}, nationalPrefix: "0", pattern: "[12]\\d{9}|[135-9]\\d{8}|[16]\\d{7}|[16-8]\\d{6}"},
+	"VU": {lengths: []int{5, 7}, nationalPrefix: "", pattern: "[57-9]\\d{6}|(?:[238]\\d|48)\\d{3}"},
+	"WF": {lengths: []int{6, 9}, nationalPrefix: "", pattern: "(?:40|72|8\\d{4})\\d{4}|[89]\\d{5}"},
+	"WS": {lengths: []int{5, 6, 7, 10}, nationalPrefix: "", pattern: "(?:[2-6]|8\\d{5})\\d{4}|[78]\\d{6}|[68]\\d{5}"},
+	"YE": {lengths: []int{7, 8, 9}, nationalPrefix: "0", pattern: "(?:1|7\\d)\\d{7}|[1-7]\\d{6}"},
+	"YT": {lengths: []int{9}, nationalPrefix: "0", pattern: "(?:639\\d|7093)\\d{5}|(?:26|80|9\\d)\\d{7}"},
+	"ZA": {lengths: []int{5, 6, 7, 8, 9, 10}, nationalPrefix: "0", pattern: "[1-79]\\d{8}|8\\d{4,9}"},
+	"ZM": {lengths: []int{9}, nationalPrefix: "0", pattern: "800\\d{6}|(?:21|[579]\\d|63)\\d{7}"},
+	"ZW": {lengths: []int{5, 6, 7, 8, 9, 10}, nationalPrefix: "0", pattern: "2(?:[0-57-9]\\d{6,8}|6[0-24-9]\\d{6,7})|[38]\\d{9}|[35-8]\\d{8}|[3-6]\\d{7}|[1-689]\\d{6}|[1-3569]\\d{5}|[1356]\\d{4}"},
+}
@@ -0,0 +1,42 @@
+package phone
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/mrz1836/go-countries"
+	"github.com/stretchr/testify/require"
+)
+
+// FuzzParse ensures Parse never panics and that every parsed number
+// is consistent with its country and round-trips through its E.164 form.
+func FuzzParse(f *testing.F) {
+	seed := []struct {
+		input  string
+		region countries.Alpha2
+	}{
+		{testNationalNumber, countries.Alpha2GB},
+		{"+1 (684) 633-1234", ""},
+		{"8 495 123 45 67", countries.Alpha2RU},
+		{"0044", countries.Alpha2FR},
+		{"+", ""},
+		{"", "zz"},
+	}
+	for _, s := range seed {
+		f.Add(s.input, s.region.String())
+	}
+	f.Fuzz(func(t *testing.T, input, region string) {
+		n, err := Parse(input, countries.Alpha2(region))
+		if err != nil {
+			require.Nil(t, n)
+			return
+		}
+		require.Contains(t, n.Country.CallingCodes, n.CallingCode)
+		require.Equal(t, n.CallingCode+n.NationalNumber, n.E164)
+		require.True(t, strings.HasPrefix(n.E164, "+"))
+
+		reparsed, err := Parse(n.E164, "")
+		require.NoError(t, err)
+		require.Equal(t, n.E164, reparsed.E164)
+	})
+}
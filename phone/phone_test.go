@@ -0,0 +1,226 @@
+package phone
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/mrz1836/go-countries"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testNationalNumber = "020 7946 0018"
+	testE164           = "+442079460018"
+)
+
+// TestRules_Loaded tests that the numbering plans are preloaded and consistent with the countries package
+func TestRules_Loaded(t *testing.T) {
+	require.NotEmpty(t, rules)
+
+	for alpha2, r := range rules {
+		c := countries.GetByAlpha2(alpha2)
+		require.NotNil(t, c, "numbering plan for unknown country %s", alpha2)
+		assert.NotEmpty(t, c.CallingCodes, "country %s has no calling code", alpha2)
+		assert.NotEmpty(t, r.lengths)
+		assert.NotPanics(t, func() { r.matches("0") }, "country %s has an invalid pattern", alpha2)
+	}
+}
+
+// TestParse_Valid tests Parse with valid numbers in international and national format
+func TestParse_Valid(t *testing.T) {
+	tests := []struct {
+		name           string
+		input          string
+		region         countries.Alpha2
+		expectedAlpha2 countries.Alpha2
+		expectedE164   string
+		expectedNumber string
+	}{
+		{
+			name: "National with prefix", input: testNationalNumber, region: countries.Alpha2GB,
+			expectedAlpha2: countries.Alpha2GB, expectedE164: testE164, expectedNumber: "2079460018",
+		},
+		{
+			name: "International", input: "+44 20 7946 0018", region: "",
+			expectedAlpha2: countries.Alpha2GB, expectedE164: testE164, expectedNumber: "2079460018",
+		},
+		{
+			name: "International prefix", input: "0044 20 7946 0018", region: countries.Alpha2FR,
+			expectedAlpha2: countries.Alpha2GB, expectedE164: testE164, expectedNumber: "2079460018",
+		},
+		{
+			name: "International with national prefix", input: "+44 (0)20 7946 0018", region: "",
+			expectedAlpha2: countries.Alpha2GB, expectedE164: testE164, expectedNumber: "2079460018",
+		},
+		{
+			name: "United States", input: "(212) 555-0100", region: countries.Alpha2US,
+			expectedAlpha2: countries.Alpha2US, expectedE164: "+12125550100", expectedNumber: "2125550100",
+		},
+		{
+			name: "NANP national prefix", input: "1-212-555-0100", region: countries.Alpha2US,
+			expectedAlpha2: countries.Alpha2US, expectedE164: "+12125550100", expectedNumber: "2125550100",
+		},
+		{
+			name: "NANP area code from default region", input: "(684) 633-1234", region: countries.Alpha2US,
+			expectedAlpha2: countries.Alpha2AS, expectedE164: "+16846331234", expectedNumber: "6846331234",
+		},
+		{
+			name: "Puerto Rico", input: "+1 787 555 0100", region: "",
+			expectedAlpha2: countries.Alpha2PR, expectedE164: "+17875550100", expectedNumber: "7875550100",
+		},
+		{
+			name: "Canada", input: "+1 416 555 0100", region: countries.Alpha2US,
+			expectedAlpha2: countries.Alpha2CA, expectedE164: "+14165550100", expectedNumber: "4165550100",
+		},
+		{
+			name: "Russia national prefix", input: "8 (495) 123-45-67", region: countries.Alpha2RU,
+			expectedAlpha2: countries.Alpha2RU, expectedE164: "+74951234567", expectedNumber: "4951234567",
+		},
+		{
+			name: "Kazakhstan", input: "+7 701 123 4567", region: "",
+			expectedAlpha2: countries.Alpha2KZ, expectedE164: "+77011234567", expectedNumber: "7011234567",
+		},
+		{
+			name: "Italy keeps the leading zero", input: "06 1234 5678", region: countries.Alpha2IT,
+			expectedAlpha2: countries.Alpha2IT, expectedE164: "+390612345678", expectedNumber: "0612345678",
+		},
+		{
+			name: "Vatican", input: "+39 06 698 12345", region: "",
+			expectedAlpha2: countries.Alpha2VA, expectedE164: "+390669812345", expectedNumber: "0669812345",
+		},
+		{
+			name: "Germany", input: "030/1234567", region: countries.Alpha2DE,
+			expectedAlpha2: countries.Alpha2DE, expectedE164: "+49301234567", expectedNumber: "301234567",
+		},
+		{
+			name: "France dotted", input: "01.23.45.67.89", region: countries.Alpha2FR,
+			expectedAlpha2: countries.Alpha2FR, expectedE164: "+33123456789", expectedNumber: "123456789",
+		},
+		{
+			name: "Lowercase region", input: "020 7946 0018", region: "gb",
+			expectedAlpha2: countries.Alpha2GB, expectedE164: testE164, expectedNumber: "2079460018",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			n, err := Parse(tt.input, tt.region)
+			require.NoError(t, err)
+			require.NotNil(t, n)
+
+			assert.Equal(t, tt.expectedAlpha2.String(), n.Country.Alpha2)
+			assert.Same(t, tt.expectedAlpha2.Country(), n.Country)
+			assert.Equal(t, tt.expectedE164, n.E164)
+			assert.Equal(t, tt.expectedE164, n.String())
+			assert.Equal(t, tt.expectedNumber, n.NationalNumber)
+			assert.Contains(t, n.Country.CallingCodes, n.CallingCode)
+		})
+	}
+}
+
+// TestParse_Invalid tests Parse with numbers that cannot be parsed or are not valid
+func TestParse_Invalid(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       string
+		region      countries.Alpha2
+		expectedErr error
+	}{
+		{name: "Empty", input: "", region: countries.Alpha2GB, expectedErr: ErrEmptyNumber},
+		{name: "Spaces only", input: "   ", region: countries.Alpha2GB, expectedErr: ErrEmptyNumber},
+		{name: "Plus only", input: "+", region: countries.Alpha2GB, expectedErr: ErrEmptyNumber},
+		{name: "Letters", input: "020 7946 ABCD", region: countries.Alpha2GB, expectedErr: ErrInvalidCharacters},
+		{name: "Extension", input: "+44 20 7946 0018 ext. 12", region: "", expectedErr: ErrInvalidCharacters},
+		{name: "Unknown region", input: testNationalNumber, region: "ZZ", expectedErr: ErrUnknownRegion},
+		{name: "Missing region", input: testNationalNumber, region: "", expectedErr: ErrUnknownRegion},
+		{name: "No telephone service", input: "123456", region: countries.Alpha2BV, expectedErr: ErrUnknownRegion},
+		{name: "Unknown calling code", input: "+999 123 456", region: "", expectedErr: ErrUnknownCallingCode},
+		{name: "Too short", input: "020 79", region: countries.Alpha2GB, expectedErr: ErrTooShort},
+		{name: "Too long", input: "+1 212 555 01001", region: "", expectedErr: ErrTooLong},
+		{name: "Invalid leading digits", input: "+1 123 555 0100", region: "", expectedErr: ErrInvalidNumber},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			n, err := Parse(tt.input, tt.region)
+			require.Error(t, err)
+			require.ErrorIs(t, err, tt.expectedErr)
+			assert.Nil(t, n)
+		})
+	}
+}
+
+// TestParse_SameCountryInBothFormats tests that a number resolves to the same country in international and
+// national format, whatever the national prefix
+func TestParse_SameCountryInBothFormats(t *testing.T) {
+	tests := []struct {
+		name          string
+		international string
+		national      string
+		region        countries.Alpha2
+		expected      countries.Alpha2
+	}{
+		{name: "Guernsey mobile", international: "+44 7911 123456", national: "07911 123456",
+			region: countries.Alpha2GB, expected: countries.Alpha2GG},
+		{name: "Guernsey mobile from Guernsey", international: "+44 7911 123456", national: "07911 123456",
+			region: countries.Alpha2GG, expected: countries.Alpha2GG},
+		{name: "London landline", international: "+44 20 7946 0018", national: testNationalNumber,
+			region: countries.Alpha2GB, expected: countries.Alpha2GB},
+		{name: "NANP area code", international: "+1 684 633 1234", national: "1 684 633 1234",
+			region: countries.Alpha2US, expected: countries.Alpha2AS},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			international, err := Parse(tt.international, "")
+			require.NoError(t, err)
+			national, err := Parse(tt.national, tt.region)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expected.String(), international.Country.Alpha2)
+			assert.Equal(t, tt.expected.String(), national.Country.Alpha2)
+			assert.Equal(t, international.E164, national.E164)
+		})
+	}
+}
+
+// TestIsValid tests the IsValid function
+func TestIsValid(t *testing.T) {
+	assert.True(t, IsValid(testNationalNumber, countries.Alpha2GB))
+	assert.True(t, IsValid(testE164, ""))
+	assert.False(t, IsValid("020 7946", countries.Alpha2GB))
+	assert.False(t, IsValid(testNationalNumber, ""))
+}
+
+// ExampleParse is an example of Parse()
+func ExampleParse() {
+	n, err := Parse(testNationalNumber, countries.Alpha2GB)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Printf("country: %s e164: %s national: %s", n.Country.Alpha2, n.E164, n.NationalNumber)
+	// Output:country: GB e164: +442079460018 national: 2079460018
+}
+
+// ExampleParse_sharedCallingCode is an example of Parse() resolving a NANP member country
+func ExampleParse_sharedCallingCode() {
+	n, _ := Parse("+1 (787) 555-0100", "")
+	fmt.Printf("country: %s calling code: %s", n.Country.Name, n.CallingCode)
+	// Output:country: Puerto Rico calling code: +1
+}
+
+// BenchmarkParse benchmarks the method Parse()
+func BenchmarkParse(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_, _ = Parse(testNationalNumber, countries.Alpha2GB)
+	}
+}
+
+// BenchmarkParse_International benchmarks the method Parse() with a number in international format
+func BenchmarkParse_International(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_, _ = Parse(testE164, "")
+	}
+}
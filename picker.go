@@ -0,0 +1,56 @@
+package countries
+
+// PickerOption is a country in a country picker (e.g., a select box or a phone number prefix dropdown)
+type PickerOption struct {
+	DialCode string `json:"dial_code"` // Main international calling code (e.g., "+49"), empty when the country has none
+	Flag     string `json:"flag"`      // Flag emoji (e.g., "🇩🇪")
+	Label    string `json:"label"`     // Name of the country in the language of the picker (e.g., "Deutschland")
+	Value    string `json:"value"`     // ISO 3166-1 alpha-2 code (e.g., "DE")
+}
+
+// PickerOptions lists every country as an option of a country picker, sorted for a language.
+//
+// This function performs the following steps:
+// - Sorts every country by its name in the language with SortByLocalizedName
+// - Builds an option with the alpha-2 code as value, the localized name as label, the flag emoji and the main
+// calling code of every country
+//
+// Parameters:
+// - lang: BCP 47 language tag or POSIX locale of the user interface (e.g., "de", "sv-SE", "pt_BR")
+//
+// Returns:
+// - Slice of one PickerOption per country, in the alphabetical order of the language
+//
+// Side Effects:
+// - None
+//
+// Notes:
+// - Labels fall back to the English common name when the language has no translations (see LocalizedLanguages)
+// - The options are ready to serialize to JSON for frontends, which no longer need to sort the names themselves
+// - The returned slice is new on every call and can be modified freely
+func PickerOptions(lang string) []PickerOption {
+	list := GetAll().SortByLocalizedName(lang)
+
+	options := make([]PickerOption, 0, len(list))
+	for _, c := range list {
+		option := PickerOption{
+			Flag:  c.FlagEmoji(),
+			Label: c.displayName(lang),
+			Value: c.Alpha2,
+		}
+		if len(c.CallingCodes) > 0 {
+			option.DialCode = c.CallingCodes[0]
+		}
+		options = append(options, option)
+	}
+	return options
+}
+
+// displayName returns the name of the Country in a language, or its English common name
+// when the language has no translations
+func (c *Country) displayName(lang string) string {
+	if name := c.LocalizedName(lang); name != "" {
+		return name
+	}
+	return c.CommonName
+}
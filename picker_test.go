@@ -0,0 +1,78 @@
+package countries
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestPickerOptions tests the options of every country in different languages
+func TestPickerOptions(t *testing.T) {
+	t.Run("every country once", func(t *testing.T) {
+		options := PickerOptions("de")
+		require.Len(t, options, 249)
+
+		seen := make(map[string]bool, len(options))
+		for _, option := range options {
+			assert.False(t, seen[option.Value], option.Value)
+			seen[option.Value] = true
+			assert.NotEmpty(t, option.Label, option.Value)
+			assert.NotEmpty(t, option.Flag, option.Value)
+		}
+	})
+
+	t.Run("option fields", func(t *testing.T) {
+		for _, option := range PickerOptions("de") {
+			if option.Value == "DE" {
+				assert.Equal(t, PickerOption{DialCode: "+49", Flag: "🇩🇪", Label: "Deutschland", Value: "DE"}, option)
+			}
+		}
+	})
+
+	t.Run("sorted in the order of the language", func(t *testing.T) {
+		options := PickerOptions("sv")
+		assert.Equal(t, "AF", options[0].Value)
+		assert.Equal(t, "Österrike", options[len(options)-2].Label)
+		assert.Equal(t, "Östtimor", options[len(options)-1].Label)
+	})
+
+	t.Run("unsupported language uses the common names", func(t *testing.T) {
+		options := PickerOptions("tlh")
+		assert.Equal(t, "Afghanistan", options[0].Label)
+		assert.Equal(t, "Zimbabwe", options[len(options)-1].Label)
+	})
+
+	t.Run("country without a calling code", func(t *testing.T) {
+		for _, option := range PickerOptions("en") {
+			if c := GetByAlpha2(option.Value); len(c.CallingCodes) == 0 {
+				assert.Empty(t, option.DialCode, option.Value)
+			}
+		}
+	})
+
+	t.Run("json", func(t *testing.T) {
+		data, err := json.Marshal(PickerOptions("fr")[0])
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"dial_code":"+93","flag":"🇦🇫","label":"Afghanistan","value":"AF"}`, string(data))
+	})
+}
+
+// ExamplePickerOptions is an example of PickerOptions()
+func ExamplePickerOptions() {
+	for _, option := range PickerOptions("de")[:3] {
+		fmt.Println(option.Value, option.Flag, option.Label, option.DialCode)
+	}
+	// Output:AF 🇦🇫 Afghanistan +93
+	// EG 🇪🇬 Ägypten +20
+	// AX 🇦🇽 Ålandinseln +358
+}
+
+// BenchmarkPickerOptions benchmarks the method PickerOptions()
+func BenchmarkPickerOptions(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_ = PickerOptions("de")
+	}
+}
@@ -0,0 +1,234 @@
+package countries
+
+import (
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/mrz1836/go-countries/internal/names"
+)
+
+// MatchField is the field of a Country that matched a search query
+type MatchField string
+
+// Fields matched by Search
+const (
+	MatchFieldAlias   MatchField = "alias"   // Alias of the country (e.g., "Great Britain")
+	MatchFieldAlpha2  MatchField = "alpha2"  // ISO 3166-1 alpha-2 code (exact matches only)
+	MatchFieldAlpha3  MatchField = "alpha3"  // ISO 3166-1 alpha-3 code (exact matches only)
+	MatchFieldCapital MatchField = "capital" // Capital city
+	MatchFieldName    MatchField = "name"    // ISO, common or official name of the country
+)
+
+// Scores given by Search to each kind of match, from 0 (no match) to 1 (exact match)
+const (
+	scoreExact        = 1.0  // The query equals the term
+	scorePrefix       = 0.8  // The query starts the term, plus up to scorePrefixBonus for longer queries
+	scorePrefixBonus  = 0.15 // Share of the term covered by a prefix query
+	scoreTokens       = 0.6  // Every word of the query starts a word of the term, plus up to scoreTokensBonus
+	scoreTokensBonus  = 0.15 // Share of the words of the term covered by the query
+	scorePartial      = 0.25 // Some words of the query start a word of the term, plus up to scorePartialBonus
+	scorePartialBonus = 0.3  // Share of the words of the query found in the term
+	scoreFuzzy        = 0.7  // The query is within a few typos of the term or its beginning, times the similarity
+	scoreFuzzyWhole   = 0.05 // Bonus of a fuzzy match against the whole term over one against its beginning
+	scoreCapitalRatio = 0.9  // Capital matches rank below name matches of the same kind
+	minScore          = 0.4  // Matches below this score are dropped
+	minFuzzyLength    = 4    // Queries shorter than this are not matched with typos
+)
+
+// Match is a country found by Search with its relevance
+type Match struct {
+	Country *Country   `json:"country"` // Country found
+	Field   MatchField `json:"field"`   // Field of the country that matched best
+	Score   float64    `json:"score"`   // Relevance from 0 to 1, where 1 is an exact match
+	Term    string     `json:"term"`    // Folded value of the field that matched (e.g., "united states of america")
+}
+
+// searchTerm is a folded name, alias, code or capital of a country in the generated search index
+type searchTerm struct {
+	country *Country
+	field   MatchField
+	term    string
+}
+
+// loadSearchTokens splits every term of the search index into words on the first search
+var loadSearchTokens = sync.OnceValue(func() [][]string { //nolint:gochecknoglobals // lazily built index
+	tokens := make([][]string, len(searchTerms))
+	for i, t := range searchTerms {
+		tokens[i] = strings.Fields(t.term)
+	}
+	return tokens
+})
+
+// Search finds the countries best matching a free-text query, for autocomplete and fuzzy lookups.
+//
+// This function performs the following steps:
+// - Folds the query (case, diacritics, apostrophes and punctuation) like the terms of the search index
+// - Scores every name, alias, alpha-2 code, alpha-3 code and capital of the index against the query:
+// exact match, prefix match, word overlap (in any order) and edit distance for typos
+// - Keeps the best match of every country and sorts the matches by score, then by population
+//
+// Parameters:
+// - query: free text such as "united states", "USA", "Cote d'Ivoire", "bolvia" or "Ottawa"
+// - limit: maximum number of matches to return
+//
+// Returns:
+// - Slice of at most limit matches, best first, or nil when the query is empty, nothing matches or limit is not positive
+//
+// Side Effects:
+// - Splits the terms of the search index into words on the first call
+//
+// Notes:
+// - Scores range from 0.4 to 1; only exact matches (name, alias, alpha code or capital) score 1
+// - Alpha codes only match exactly, so "us" finds the United States but "u" does not
+// - Typos are only tolerated for queries of at least 4 characters (one typo, or two from 8 characters)
+// - Safe for concurrent use; the Country pointers reference global data
+func Search(query string, limit int) []Match {
+	query = names.Fold(query)
+	if query == "" || limit <= 0 {
+		return nil
+	}
+
+	queryTokens := strings.Fields(query)
+	tokens := loadSearchTokens()
+	best := make(map[*Country]int)
+	var matches []Match
+	var distances []int
+
+	for i, t := range searchTerms {
+		var score float64
+		score, distances = scoreTerm(query, queryTokens, t, tokens[i], distances)
+		if t.field == MatchFieldCapital {
+			score *= scoreCapitalRatio
+		}
+		if score < minScore {
+			continue
+		}
+
+		if position, ok := best[t.country]; ok {
+			if score > matches[position].Score {
+				matches[position] = Match{Country: t.country, Field: t.field, Score: score, Term: t.term}
+			}
+			continue
+		}
+		best[t.country] = len(matches)
+		matches = append(matches, Match{Country: t.country, Field: t.field, Score: score, Term: t.term})
+	}
+
+	sort.Slice(matches, func(i, j int) bool {
+		if matches[i].Score != matches[j].Score {
+			return matches[i].Score > matches[j].Score
+		}
+		if matches[i].Country.Population != matches[j].Country.Population {
+			return matches[i].Country.Population > matches[j].Country.Population
+		}
+		return matches[i].Country.Alpha2 < matches[j].Country.Alpha2
+	})
+
+	if len(matches) > limit {
+		matches = matches[:limit]
+	}
+	if len(matches) == 0 {
+		return nil
+	}
+	return matches
+}
+
+// scoreTerm scores a folded query against a term of the search index, reusing the distances buffer
+func scoreTerm(query string, queryTokens []string, t searchTerm, termTokens []string, distances []int) (float64, []int) {
+	switch {
+	case t.term == query:
+		return scoreExact, distances
+	case t.field == MatchFieldAlpha2 || t.field == MatchFieldAlpha3:
+		return 0, distances
+	case strings.HasPrefix(t.term, query):
+		return scorePrefix + scorePrefixBonus*float64(len(query))/float64(len(t.term)), distances
+	}
+
+	var score float64
+	if matched := matchedTokens(queryTokens, termTokens); matched == len(queryTokens) {
+		score = scoreTokens + scoreTokensBonus*min(float64(matched)/float64(len(termTokens)), 1)
+	} else if matched > 0 {
+		score = scorePartial + scorePartialBonus*float64(matched)/float64(len(queryTokens))
+	}
+
+	maxDistance := maxTypos(query)
+	if maxDistance == 0 {
+		return score, distances
+	}
+
+	// Compare the query with the whole term, then with the beginnings of the term around the query length,
+	// so that "bolvia" ranks "bolivia" above "bolivarian republic of venezuela"
+	distance, distances := editDistance(query, t.term, distances)
+	if distance <= maxDistance {
+		return max(score, scoreFuzzy*(1-float64(distance)/float64(len(query)))+scoreFuzzyWhole), distances
+	}
+	for _, n := range []int{len(query) - 1, len(query), len(query) + 1} {
+		if n > 0 && n < len(t.term) {
+			var d int
+			d, distances = editDistance(query, t.term[:n], distances)
+			distance = min(distance, d)
+		}
+	}
+	if distance <= maxDistance {
+		score = max(score, scoreFuzzy*(1-float64(distance)/float64(len(query))))
+	}
+	return score, distances
+}
+
+// maxTypos returns the edit distance tolerated for a folded query: none below minFuzzyLength characters,
+// one typo, or two from twice that length
+func maxTypos(query string) int {
+	switch {
+	case len(query) < minFuzzyLength:
+		return 0
+	case len(query) < 2*minFuzzyLength:
+		return 1
+	default:
+		return 2
+	}
+}
+
+// matchedTokens counts the query words that start a word of the term
+func matchedTokens(queryTokens, termTokens []string) int {
+	matched := 0
+	for _, q := range queryTokens {
+		for _, t := range termTokens {
+			if strings.HasPrefix(t, q) {
+				matched++
+				break
+			}
+		}
+	}
+	return matched
+}
+
+// editDistance computes the optimal string alignment distance between two strings (insertions, deletions,
+// substitutions and transpositions of adjacent characters), reusing the rows buffer
+func editDistance(a, b string, rows []int) (int, []int) {
+	width := len(b) + 1
+	if cap(rows) < 3*width {
+		rows = make([]int, 3*width)
+	}
+	rows = rows[:3*width]
+	previous, current, beforePrevious := rows[:width], rows[width:2*width], rows[2*width:]
+
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+			if i > 1 && j > 1 && a[i-1] == b[j-2] && a[i-2] == b[j-1] {
+				current[j] = min(current[j], beforePrevious[j-2]+1)
+			}
+		}
+		beforePrevious, previous, current = previous, current, beforePrevious
+	}
+	return previous[len(b)], rows
+}
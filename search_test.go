@@ -0,0 +1,151 @@
+package countries
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/mrz1836/go-countries/internal/names"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSearchTerms_Loaded tests that the search index is preloaded, folded and sorted
+func TestSearchTerms_Loaded(t *testing.T) {
+	require.NotEmpty(t, searchTerms)
+
+	indexed := make(map[*Country]bool, len(countries))
+	for i, st := range searchTerms {
+		assert.Equal(t, names.Fold(st.term), st.term)
+		if i > 0 {
+			assert.LessOrEqual(t, searchTerms[i-1].term, st.term)
+		}
+		indexed[st.country] = true
+	}
+	assert.Len(t, indexed, len(countries))
+}
+
+// TestSearch tests Search with different queries
+func TestSearch(t *testing.T) {
+	tests := []struct {
+		name     string
+		query    string
+		expected Alpha2
+		field    MatchField
+		exact    bool
+	}{
+		{name: "Exact name", query: "Germany", expected: Alpha2DE, field: MatchFieldName, exact: true},
+		{name: "Common name", query: "United States", expected: Alpha2US, field: MatchFieldName, exact: true},
+		{name: "Official name", query: "Republic of Korea", expected: Alpha2KR, field: MatchFieldName, exact: true},
+		{name: "Prefix", query: "United Sta", expected: Alpha2US, field: MatchFieldName},
+		{name: "Alpha-3", query: "USA", expected: Alpha2US, field: MatchFieldAlpha3, exact: true},
+		{name: "Alpha-2", query: "de", expected: Alpha2DE, field: MatchFieldAlpha2, exact: true},
+		{name: "Prefix of official name", query: "Plurinational", expected: Alpha2BO, field: MatchFieldName},
+		{name: "Without diacritics", query: "Cote d'Ivoire", expected: Alpha2CI, field: MatchFieldName, exact: true},
+		{name: "Without apostrophe", query: "cote divoire", expected: Alpha2CI, field: MatchFieldName, exact: true},
+		{name: "Typo", query: "germny", expected: Alpha2DE, field: MatchFieldName},
+		{name: "Transposition", query: "Bolviia", expected: Alpha2BO, field: MatchFieldName},
+		{name: "Typo of a name starting a longer name", query: "bolvia", expected: Alpha2BO, field: MatchFieldName},
+		{name: "Typo ranked above a longer name", query: "austira", expected: Alpha2AT, field: MatchFieldName},
+		{name: "Autocomplete", query: "switz", expected: Alpha2CH, field: MatchFieldName},
+		{name: "Words in any order", query: "korea republic", expected: Alpha2KR, field: MatchFieldName},
+		{name: "Word inside the name", query: "Grenadines", expected: Alpha2VC, field: MatchFieldName},
+		{name: "Alias", query: "Great Britain", expected: Alpha2GB, field: MatchFieldAlias, exact: true},
+		{name: "Former name", query: "Burma", expected: Alpha2MM, field: MatchFieldAlias, exact: true},
+		{name: "Alias with a typo", query: "Hollnd", expected: Alpha2NL, field: MatchFieldAlias},
+		{name: "Capital", query: "Ottawa", expected: Alpha2CA, field: MatchFieldCapital},
+		{name: "Capital with diacritics", query: "Bogota", expected: Alpha2CO, field: MatchFieldCapital},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matches := Search(tt.query, 3)
+			require.NotEmpty(t, matches)
+			assert.Equal(t, tt.expected.String(), matches[0].Country.Alpha2)
+			assert.Equal(t, tt.field, matches[0].Field)
+			assert.Equal(t, tt.exact, matches[0].Score == 1)
+			assert.Greater(t, matches[0].Score, 0.5)
+		})
+	}
+}
+
+// TestSearch_Ranking tests the order, limit and uniqueness of the matches
+func TestSearch_Ranking(t *testing.T) {
+	matches := Search("korea", 10)
+	require.Len(t, matches, 2)
+	assert.Equal(t, Alpha2KR.String(), matches[0].Country.Alpha2)
+	assert.Equal(t, Alpha2KP.String(), matches[1].Country.Alpha2)
+
+	matches = Search("guinea", 10)
+	require.GreaterOrEqual(t, len(matches), 4)
+	seen := make(map[string]bool)
+	for i, m := range matches {
+		assert.False(t, seen[m.Country.Alpha2], "duplicate country %s", m.Country.Alpha2)
+		seen[m.Country.Alpha2] = true
+		assert.GreaterOrEqual(t, m.Score, minScore)
+		assert.LessOrEqual(t, m.Score, 1.0)
+		if i > 0 {
+			assert.GreaterOrEqual(t, matches[i-1].Score, m.Score)
+		}
+	}
+
+	assert.Len(t, Search("a", 5), 5)
+	assert.Len(t, Search("a", 1), 1)
+}
+
+// TestSearch_NoMatch tests Search with queries that do not match
+func TestSearch_NoMatch(t *testing.T) {
+	assert.Nil(t, Search("", 5))
+	assert.Nil(t, Search("  ,.  ", 5))
+	assert.Nil(t, Search("Germany", 0))
+	assert.Nil(t, Search("Germany", -1))
+	assert.Nil(t, Search("xqzw", 5))
+	assert.Nil(t, Search("Atlantis Kingdomless Nowhere", 5))
+}
+
+// TestEditDistance tests editDistance with different strings
+func TestEditDistance(t *testing.T) {
+	tests := []struct {
+		a        string
+		b        string
+		expected int
+	}{
+		{a: "", b: "", expected: 0},
+		{a: "abc", b: "", expected: 3},
+		{a: "", b: "abc", expected: 3},
+		{a: "germany", b: "germany", expected: 0},
+		{a: "germny", b: "germany", expected: 1},
+		{a: "gremany", b: "germany", expected: 1},
+		{a: "kitten", b: "sitting", expected: 3},
+		{a: "ca", b: "abc", expected: 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.a+"/"+tt.b, func(t *testing.T) {
+			distance, _ := editDistance(tt.a, tt.b, nil)
+			assert.Equal(t, tt.expected, distance)
+		})
+	}
+}
+
+// ExampleSearch is an example of Search()
+func ExampleSearch() {
+	for _, m := range Search("united states", 2) {
+		fmt.Printf("%s (%s, %.2f)\n", m.Country.Name, m.Field, m.Score)
+	}
+	// Output:United States of America (name, 1.00)
+	// Virgin Islands (U.S.) (name, 0.87)
+}
+
+// BenchmarkSearch benchmarks the method Search()
+func BenchmarkSearch(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_ = Search("united st", 5)
+	}
+}
+
+// BenchmarkSearch_Typo benchmarks the method Search() with a typo
+func BenchmarkSearch_Typo(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_ = Search("germny", 5)
+	}
+}
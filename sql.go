@@ -0,0 +1,218 @@
+package countries
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ErrUnsupportedScanType is returned when a database value of another type than text or integer is scanned
+var ErrUnsupportedScanType = errors.New("unsupported type for a country column")
+
+// Scan implements sql.Scanner for a column storing any code or name in the formats of Accept.
+//
+// This function performs the following steps:
+// - Sets the Country to nil for NULL
+// - Reads integers as numeric codes (e.g., a SMALLINT column storing 840)
+// - Parses text like UnmarshalText, ignoring the padding of CHAR columns
+//
+// Parameters:
+// - src: value of the column as returned by the database driver
+//
+// Returns:
+// - A *CodeError wrapping ErrUnknownCode when the value is not a known country in the accepted formats,
+// or an error wrapping ErrUnsupportedScanType for other types
+//
+// Side Effects:
+// - Sets the Country of the Code
+//
+// Notes:
+// - Value stores the alpha-2 code; use the Alpha3 or Numeric types for columns storing other codes
+func (c *Code) Scan(src any) error {
+	c.Country = nil
+	text, null, err := scanText(src)
+	if err != nil || null {
+		return err
+	}
+	if text == "" {
+		return &CodeError{Accept: c.Accept}
+	}
+	return c.UnmarshalText([]byte(text))
+}
+
+// Value implements driver.Valuer, storing the alpha-2 code.
+//
+// Parameters:
+// - None
+//
+// Returns:
+// - Alpha-2 code (e.g., "US"), or nil (NULL) for the zero Code
+// - Always a nil error
+//
+// Side Effects:
+// - None
+func (c Code) Value() (driver.Value, error) {
+	if c.Country == nil {
+		return nil, nil //nolint:nilnil // NULL is a nil value
+	}
+	return c.Country.Alpha2, nil
+}
+
+// Scan implements sql.Scanner for a column storing alpha-2 codes (e.g., CHAR(2)).
+//
+// Parameters:
+// - src: value of the column as returned by the database driver
+//
+// Returns:
+// - A *CodeError wrapping ErrUnknownCode when the value is not an assigned alpha-2 code,
+// or an error wrapping ErrUnsupportedScanType when the value is not text
+//
+// Side Effects:
+// - Sets the code in uppercase, or to an empty code for NULL
+func (a *Alpha2) Scan(src any) error {
+	text, err := scanCode(src, CodeFormatAlpha2, func(s string) bool { return Alpha2(s).Valid() })
+	*a = Alpha2(text)
+	return err
+}
+
+// Value implements driver.Valuer.
+//
+// Parameters:
+// - None
+//
+// Returns:
+// - The code (e.g., "US"), or nil (NULL) for an empty code
+// - A *CodeError when the code is not assigned, so that unknown codes are never stored
+//
+// Side Effects:
+// - None
+func (a Alpha2) Value() (driver.Value, error) {
+	return codeValue(string(a), a.Valid(), CodeFormatAlpha2)
+}
+
+// Scan implements sql.Scanner for a column storing alpha-3 codes (e.g., CHAR(3)).
+//
+// Parameters:
+// - src: value of the column as returned by the database driver
+//
+// Returns:
+// - A *CodeError wrapping ErrUnknownCode when the value is not an assigned alpha-3 code,
+// or an error wrapping ErrUnsupportedScanType when the value is not text
+//
+// Side Effects:
+// - Sets the code in uppercase, or to an empty code for NULL
+func (a *Alpha3) Scan(src any) error {
+	text, err := scanCode(src, CodeFormatAlpha3, func(s string) bool { return Alpha3(s).Valid() })
+	*a = Alpha3(text)
+	return err
+}
+
+// Value implements driver.Valuer.
+//
+// Parameters:
+// - None
+//
+// Returns:
+// - The code (e.g., "USA"), or nil (NULL) for an empty code
+// - A *CodeError when the code is not assigned, so that unknown codes are never stored
+//
+// Side Effects:
+// - None
+func (a Alpha3) Value() (driver.Value, error) {
+	return codeValue(string(a), a.Valid(), CodeFormatAlpha3)
+}
+
+// Scan implements sql.Scanner for a column storing numeric codes (e.g., SMALLINT).
+//
+// Parameters:
+// - src: value of the column as returned by the database driver, an integer or its text
+//
+// Returns:
+// - A *CodeError wrapping ErrUnknownCode when the value is not an assigned numeric code,
+// or an error wrapping ErrUnsupportedScanType when the value is neither an integer nor text
+//
+// Side Effects:
+// - Sets the code, or 0 for NULL
+func (n *Numeric) Scan(src any) error {
+	*n = 0
+	text, null, err := scanText(src)
+	if err != nil || null {
+		return err
+	}
+
+	value, err := strconv.ParseUint(text, 10, 16)
+	if err != nil || !Numeric(value).Valid() {
+		return &CodeError{Value: text, Accept: CodeFormatNumeric}
+	}
+	*n = Numeric(value)
+	return nil
+}
+
+// Value implements driver.Valuer.
+//
+// Parameters:
+// - None
+//
+// Returns:
+// - The code as an int64 (e.g., 840), or nil (NULL) for 0
+// - A *CodeError when the code is not assigned, so that unknown codes are never stored
+//
+// Side Effects:
+// - None
+func (n Numeric) Value() (driver.Value, error) {
+	if n == 0 {
+		return nil, nil //nolint:nilnil // NULL is a nil value
+	}
+	if !n.Valid() {
+		return nil, &CodeError{Value: n.String(), Accept: CodeFormatNumeric}
+	}
+	return int64(n), nil
+}
+
+// scanText converts a database value to text without surrounding spaces, and reports whether it is NULL
+func scanText(src any) (string, bool, error) {
+	switch v := src.(type) {
+	case nil:
+		return "", true, nil
+	case string:
+		return strings.TrimSpace(v), false, nil
+	case []byte:
+		return strings.TrimSpace(string(v)), false, nil
+	case int64:
+		return strconv.FormatInt(v, 10), false, nil
+	default:
+		return "", false, fmt.Errorf("%w: %T", ErrUnsupportedScanType, src)
+	}
+}
+
+// scanCode converts a database value to an uppercase code that must be valid, or an empty code for NULL
+func scanCode(src any, format CodeFormat, valid func(string) bool) (string, error) {
+	if _, ok := src.(int64); ok {
+		return "", fmt.Errorf("%w: %T", ErrUnsupportedScanType, src)
+	}
+
+	text, null, err := scanText(src)
+	if err != nil || null {
+		return "", err
+	}
+
+	code := strings.ToUpper(text)
+	if !valid(code) {
+		return "", &CodeError{Value: text, Accept: format}
+	}
+	return code, nil
+}
+
+// codeValue returns a code to store, nil (NULL) for an empty code, or a *CodeError when the code is not assigned
+func codeValue(code string, valid bool, format CodeFormat) (driver.Value, error) {
+	switch {
+	case code == "":
+		return nil, nil //nolint:nilnil // NULL is a nil value
+	case !valid:
+		return nil, &CodeError{Value: code, Accept: format}
+	default:
+		return code, nil
+	}
+}
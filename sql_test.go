@@ -0,0 +1,189 @@
+package countries
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Interfaces implemented by the code types
+var (
+	_ sql.Scanner   = (*Code)(nil)
+	_ driver.Valuer = Code{}
+	_ sql.Scanner   = (*Alpha2)(nil)
+	_ driver.Valuer = Alpha2("")
+	_ sql.Scanner   = (*Alpha3)(nil)
+	_ driver.Valuer = Alpha3("")
+	_ sql.Scanner   = (*Numeric)(nil)
+	_ driver.Valuer = Numeric(0)
+)
+
+// TestCode_Scan tests scanning a Code from different database values
+func TestCode_Scan(t *testing.T) {
+	tests := []struct {
+		name     string
+		src      any
+		accept   CodeFormat
+		expected string
+	}{
+		{name: "Alpha-2 text", src: "DE", expected: "DE"},
+		{name: "Alpha-2 bytes", src: []byte("de"), expected: "DE"},
+		{name: "Padded CHAR column", src: "DEU   ", expected: "DE"},
+		{name: "Numeric integer", src: int64(276), expected: "DE"},
+		{name: "Numeric text", src: "276", expected: "DE"},
+		{name: "Name", src: "Germany", expected: "DE"},
+		{name: "Strict alpha-2", src: "DE", accept: CodeFormatAlpha2, expected: "DE"},
+		{name: "NULL", src: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code := Code{Country: GetByAlpha2("FR"), Accept: tt.accept}
+			require.NoError(t, code.Scan(tt.src))
+			assert.Equal(t, tt.expected, code.String())
+		})
+	}
+}
+
+// TestCode_Scan_Errors tests that unknown values and unsupported types are rejected
+func TestCode_Scan_Errors(t *testing.T) {
+	tests := []struct {
+		name   string
+		src    any
+		accept CodeFormat
+		value  string
+	}{
+		{name: "Unknown code", src: "ZZ", value: "ZZ"},
+		{name: "Unknown integer", src: int64(999), value: "999"},
+		{name: "Negative integer", src: int64(-276), value: "-276"},
+		{name: "Empty text", src: "  ", value: ""},
+		{name: "Strict alpha-2", src: "DEU", accept: CodeFormatAlpha2, value: "DEU"},
+		{name: "Strict numeric", src: int64(276), accept: CodeFormatAlpha2, value: "276"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code := Code{Country: GetByAlpha2("FR"), Accept: tt.accept}
+			err := code.Scan(tt.src)
+			require.ErrorIs(t, err, ErrUnknownCode)
+
+			var codeErr *CodeError
+			require.ErrorAs(t, err, &codeErr)
+			assert.Equal(t, tt.value, codeErr.Value)
+			assert.Nil(t, code.Country)
+		})
+	}
+
+	t.Run("Unsupported type", func(t *testing.T) {
+		var code Code
+		require.ErrorIs(t, code.Scan(time.Now()), ErrUnsupportedScanType)
+		require.ErrorIs(t, code.Scan(2.5), ErrUnsupportedScanType)
+	})
+}
+
+// TestCode_Value tests the values stored for a Code
+func TestCode_Value(t *testing.T) {
+	value, err := Code{Country: GetByAlpha2("DE")}.Value()
+	require.NoError(t, err)
+	assert.Equal(t, "DE", value)
+
+	value, err = Code{}.Value()
+	require.NoError(t, err)
+	assert.Nil(t, value)
+}
+
+// TestAlpha2_SQL tests scanning and storing alpha-2 codes
+func TestAlpha2_SQL(t *testing.T) {
+	var code Alpha2
+	require.NoError(t, code.Scan("us "))
+	assert.Equal(t, Alpha2US, code)
+	require.NoError(t, code.Scan([]byte("MX")))
+	assert.Equal(t, Alpha2MX, code)
+	require.NoError(t, code.Scan(nil))
+	assert.Empty(t, code)
+
+	require.ErrorIs(t, code.Scan("USA"), ErrUnknownCode)
+	assert.Empty(t, code)
+	require.ErrorIs(t, code.Scan("ZZ"), ErrUnknownCode)
+	require.ErrorIs(t, code.Scan(int64(840)), ErrUnsupportedScanType)
+
+	value, err := Alpha2US.Value()
+	require.NoError(t, err)
+	assert.Equal(t, "US", value)
+	value, err = Alpha2("").Value()
+	require.NoError(t, err)
+	assert.Nil(t, value)
+	_, err = Alpha2("ZZ").Value()
+	require.ErrorIs(t, err, ErrUnknownCode)
+}
+
+// TestAlpha3_SQL tests scanning and storing alpha-3 codes
+func TestAlpha3_SQL(t *testing.T) {
+	var code Alpha3
+	require.NoError(t, code.Scan("usa"))
+	assert.Equal(t, Alpha3USA, code)
+	require.NoError(t, code.Scan(nil))
+	assert.Empty(t, code)
+
+	require.ErrorIs(t, code.Scan("US"), ErrUnknownCode)
+	require.ErrorIs(t, code.Scan(true), ErrUnsupportedScanType)
+
+	value, err := Alpha3USA.Value()
+	require.NoError(t, err)
+	assert.Equal(t, "USA", value)
+	_, err = Alpha3("ZZZ").Value()
+	require.ErrorIs(t, err, ErrUnknownCode)
+}
+
+// TestNumeric_SQL tests scanning and storing numeric codes
+func TestNumeric_SQL(t *testing.T) {
+	var code Numeric
+	require.NoError(t, code.Scan(int64(840)))
+	assert.Equal(t, NumericUS, code)
+	require.NoError(t, code.Scan([]byte("040")))
+	assert.Equal(t, NumericAT, code)
+	require.NoError(t, code.Scan(nil))
+	assert.Zero(t, code)
+
+	var codeErr *CodeError
+	require.ErrorAs(t, code.Scan(int64(999)), &codeErr)
+	assert.Equal(t, "999", codeErr.Value)
+	assert.Equal(t, CodeFormatNumeric, codeErr.Accept)
+	require.ErrorIs(t, code.Scan(int64(1<<20)), ErrUnknownCode)
+	require.ErrorIs(t, code.Scan("USA"), ErrUnknownCode)
+	require.ErrorIs(t, code.Scan(840.0), ErrUnsupportedScanType)
+
+	value, err := NumericUS.Value()
+	require.NoError(t, err)
+	assert.Equal(t, int64(840), value)
+	value, err = Numeric(0).Value()
+	require.NoError(t, err)
+	assert.Nil(t, value)
+	_, err = Numeric(999).Value()
+	require.ErrorIs(t, err, ErrUnknownCode)
+}
+
+// ExampleCode_Scan is an example of Code.Scan()
+func ExampleCode_Scan() {
+	var code Code
+	if err := code.Scan([]byte("DEU")); err == nil {
+		fmt.Println(code.Country.Name)
+	}
+	fmt.Println(code.Scan("Atlantis"))
+	// Output:Germany
+	// unknown country: "Atlantis" is not a known alpha-2 code, alpha-3 code, numeric code or name
+}
+
+// BenchmarkCode_Scan benchmarks the method Code.Scan()
+func BenchmarkCode_Scan(b *testing.B) {
+	src := []byte("US")
+	for i := 0; i < b.N; i++ {
+		var code Code
+		_ = code.Scan(src)
+	}
+}
@@ -0,0 +1,290 @@
+// Package sqlschema emits the SQL statements creating and seeding a countries table from the go-countries package.
+//
+// The table stores the codes, names and main attributes of every country, keyed by alpha-2 code, so that other
+// tables can reference it with a foreign key and columns scanned into countries.Code, countries.Alpha2,
+// countries.Alpha3 or countries.Numeric:
+//
+//	CREATE TABLE users (
+//	  id BIGINT PRIMARY KEY,
+//	  country CHAR(2) NOT NULL REFERENCES countries (alpha2)
+//	);
+//
+// The statements are written for PostgreSQL, SQLite and MySQL, and use plain identifiers that need no quoting.
+package sqlschema
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/mrz1836/go-countries"
+)
+
+// ErrUnknownDialect is returned for a dialect other than PostgreSQL, SQLite and MySQL
+var ErrUnknownDialect = errors.New("unknown SQL dialect")
+
+// TableName is the name of the table created and seeded by the statements
+const TableName = "countries"
+
+// Dialect is the SQL dialect of a database, named like its database/sql driver
+type Dialect string
+
+// Supported dialects
+const (
+	Postgres Dialect = "postgres" // PostgreSQL, also accepted as "postgresql" and "pgx"
+	SQLite   Dialect = "sqlite"   // SQLite, also accepted as "sqlite3"
+	MySQL    Dialect = "mysql"    // MySQL and MariaDB
+)
+
+// dialectAliases maps the other names of the dialects, such as database/sql driver names, to the dialects
+var dialectAliases = map[string]Dialect{ //nolint:gochecknoglobals // fixed lookup table
+	"pgx":        Postgres,
+	"postgresql": Postgres,
+	"sqlite3":    SQLite,
+	"mariadb":    MySQL,
+}
+
+// column is a column of the countries table
+type column struct {
+	name       string                           // Column name
+	definition string                           // Type and constraints, with %s standing for the text type
+	value      func(c *countries.Country) value // Value of the column for a country
+}
+
+// value is a column value: a string, an integer, or NULL
+type value struct {
+	text    string
+	integer int64
+	kind    valueKind
+}
+
+// valueKind is the kind of a column value
+type valueKind uint8
+
+// Kinds of column values
+const (
+	kindNull valueKind = iota
+	kindText
+	kindInteger
+)
+
+// columns lists the columns of the countries table, in order
+var columns = []column{ //nolint:gochecknoglobals // fixed table definition
+	{name: "alpha2", definition: "CHAR(2) PRIMARY KEY", value: func(c *countries.Country) value {
+		return text(c.Alpha2)
+	}},
+	{name: "alpha3", definition: "CHAR(3) NOT NULL UNIQUE", value: func(c *countries.Country) value {
+		return text(c.Alpha3)
+	}},
+	{name: "numeric_code", definition: "SMALLINT NOT NULL UNIQUE", value: func(c *countries.Country) value {
+		return integer(int64(c.NumericCode()))
+	}},
+	{name: "name", definition: "%s NOT NULL", value: func(c *countries.Country) value {
+		return text(c.Name)
+	}},
+	{name: "common_name", definition: "%s NOT NULL", value: func(c *countries.Country) value {
+		return text(c.CommonName)
+	}},
+	{name: "official_name", definition: "%s NOT NULL", value: func(c *countries.Country) value {
+		return text(c.OfficialName)
+	}},
+	{name: "capital", definition: "%s", value: func(c *countries.Country) value {
+		return nullableText(c.Capital)
+	}},
+	{name: "region", definition: "%s", value: func(c *countries.Country) value {
+		return nullableText(c.Region)
+	}},
+	{name: "sub_region", definition: "%s", value: func(c *countries.Country) value {
+		return nullableText(c.SubRegion)
+	}},
+	{name: "currency_code", definition: "CHAR(3)", value: func(c *countries.Country) value {
+		return nullableText(c.CurrencyCode)
+	}},
+	{name: "calling_code", definition: "VARCHAR(8)", value: func(c *countries.Country) value {
+		if len(c.CallingCodes) == 0 {
+			return value{}
+		}
+		return text(c.CallingCodes[0])
+	}},
+	{name: "flag", definition: "VARCHAR(16) NOT NULL", value: func(c *countries.Country) value {
+		return text(c.FlagEmoji())
+	}},
+	{name: "population", definition: "BIGINT NOT NULL", value: func(c *countries.Country) value {
+		return integer(c.Population)
+	}},
+}
+
+// CreateTable returns the CREATE TABLE statement of the countries table.
+//
+// This function performs the following steps:
+// - Resolves the dialect, accepting the database/sql driver names (e.g., "pgx", "sqlite3")
+// - Writes one line per column, with the text type of the dialect (TEXT, or VARCHAR(255) in MySQL)
+// - Sets the utf8mb4 character set in MySQL, for flag emojis
+//
+// Parameters:
+// - dialect: SQL dialect of the database (Postgres, SQLite or MySQL)
+//
+// Returns:
+// - CREATE TABLE statement ending with a semicolon and a newline, or an error wrapping ErrUnknownDialect
+//
+// Side Effects:
+// - None
+//
+// Notes:
+// - The table is keyed by alpha-2 code, and the alpha-3 and numeric codes are unique
+// - Optional values (e.g., the capital of Antarctica) are NULL
+func CreateTable(dialect Dialect) (string, error) {
+	dialect, err := resolve(dialect)
+	if err != nil {
+		return "", err
+	}
+
+	textType := "TEXT"
+	if dialect == MySQL {
+		textType = "VARCHAR(255)"
+	}
+
+	var sb strings.Builder
+	sb.WriteString("CREATE TABLE " + TableName + " (\n")
+	for i, col := range columns {
+		definition := col.definition
+		if strings.Contains(definition, "%s") {
+			definition = fmt.Sprintf(definition, textType)
+		}
+		sb.WriteString("  " + col.name + " " + definition)
+		if i < len(columns)-1 {
+			sb.WriteByte(',')
+		}
+		sb.WriteByte('\n')
+	}
+	sb.WriteByte(')')
+	if dialect == MySQL {
+		sb.WriteString(" DEFAULT CHARSET=utf8mb4")
+	}
+	sb.WriteString(";\n")
+	return sb.String(), nil
+}
+
+// Insert returns the INSERT statement seeding the countries table with every country.
+//
+// This function performs the following steps:
+// - Resolves the dialect like CreateTable
+// - Writes one row per country of countries.GetAll(), in the order of the package
+// - Quotes text by doubling single quotes, and escapes backslashes in MySQL
+//
+// Parameters:
+// - dialect: SQL dialect of the database (Postgres, SQLite or MySQL)
+//
+// Returns:
+// - INSERT statement with one row per line, ending with a semicolon and a newline,
+// or an error wrapping ErrUnknownDialect
+//
+// Side Effects:
+// - None
+//
+// Notes:
+// - The statement inserts every country at once; run it in a transaction with CreateTable to seed a database
+func Insert(dialect Dialect) (string, error) {
+	dialect, err := resolve(dialect)
+	if err != nil {
+		return "", err
+	}
+
+	names := make([]string, len(columns))
+	for i, col := range columns {
+		names[i] = col.name
+	}
+
+	var sb strings.Builder
+	sb.WriteString("INSERT INTO " + TableName + " (" + strings.Join(names, ", ") + ") VALUES\n")
+	all := countries.GetAll()
+	for i, c := range all {
+		sb.WriteString("  (")
+		for j, col := range columns {
+			if j > 0 {
+				sb.WriteString(", ")
+			}
+			writeValue(&sb, col.value(c), dialect)
+		}
+		if i < len(all)-1 {
+			sb.WriteString("),\n")
+		} else {
+			sb.WriteString(");\n")
+		}
+	}
+	return sb.String(), nil
+}
+
+// Seed returns the CREATE TABLE and INSERT statements of the countries table, separated by a blank line.
+//
+// Parameters:
+// - dialect: SQL dialect of the database (Postgres, SQLite or MySQL)
+//
+// Returns:
+// - SQL script creating and seeding the table, or an error wrapping ErrUnknownDialect
+//
+// Side Effects:
+// - None
+//
+// Notes:
+// - Drivers that run one statement per call (e.g., database/sql with MySQL) need CreateTable and Insert
+// to be executed separately
+func Seed(dialect Dialect) (string, error) {
+	create, err := CreateTable(dialect)
+	if err != nil {
+		return "", err
+	}
+	insert, err := Insert(dialect)
+	if err != nil {
+		return "", err
+	}
+	return create + "\n" + insert, nil
+}
+
+// resolve returns the supported dialect of a dialect name in any letter case
+func resolve(dialect Dialect) (Dialect, error) {
+	name := strings.ToLower(strings.TrimSpace(string(dialect)))
+	switch Dialect(name) {
+	case Postgres, SQLite, MySQL:
+		return Dialect(name), nil
+	}
+	if alias, ok := dialectAliases[name]; ok {
+		return alias, nil
+	}
+	return "", fmt.Errorf("%w: %q", ErrUnknownDialect, string(dialect))
+}
+
+// writeValue writes a column value as an SQL literal
+func writeValue(sb *strings.Builder, v value, dialect Dialect) {
+	switch v.kind {
+	case kindText:
+		s := strings.ReplaceAll(v.text, "'", "''")
+		if dialect == MySQL {
+			s = strings.ReplaceAll(s, `\`, `\\`)
+		}
+		sb.WriteString("'" + s + "'")
+	case kindInteger:
+		sb.WriteString(strconv.FormatInt(v.integer, 10))
+	default:
+		sb.WriteString("NULL")
+	}
+}
+
+// text returns a text value
+func text(s string) value {
+	return value{text: s, kind: kindText}
+}
+
+// nullableText returns a text value, or NULL for an empty string
+func nullableText(s string) value {
+	if s == "" {
+		return value{}
+	}
+	return text(s)
+}
+
+// integer returns an integer value
+func integer(n int64) value {
+	return value{integer: n, kind: kindInteger}
+}
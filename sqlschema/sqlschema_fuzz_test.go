@@ -0,0 +1,26 @@
+package sqlschema
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// FuzzSeed ensures Seed either rejects a dialect or returns a script
+// creating and seeding the countries table.
+func FuzzSeed(f *testing.F) {
+	for _, seed := range []string{"postgres", "SQLITE3", " mysql ", "oracle", ""} {
+		f.Add(seed)
+	}
+	f.Fuzz(func(t *testing.T, dialect string) {
+		seed, err := Seed(Dialect(dialect))
+		if err != nil {
+			require.ErrorIs(t, err, ErrUnknownDialect)
+			require.Empty(t, seed)
+			return
+		}
+		require.True(t, strings.HasPrefix(seed, "CREATE TABLE countries ("))
+		require.Contains(t, seed, "\nINSERT INTO countries (")
+	})
+}
@@ -0,0 +1,141 @@
+package sqlschema
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/mrz1836/go-countries"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestCreateTable tests the CREATE TABLE statement in every dialect
+func TestCreateTable(t *testing.T) {
+	tests := []struct {
+		name     string
+		dialect  Dialect
+		textType string
+		suffix   string
+	}{
+		{name: "PostgreSQL", dialect: Postgres, textType: "TEXT", suffix: ");\n"},
+		{name: "PostgreSQL driver name", dialect: "pgx", textType: "TEXT", suffix: ");\n"},
+		{name: "SQLite", dialect: SQLite, textType: "TEXT", suffix: ");\n"},
+		{name: "SQLite driver name", dialect: "sqlite3", textType: "TEXT", suffix: ");\n"},
+		{name: "MySQL", dialect: MySQL, textType: "VARCHAR(255)", suffix: ") DEFAULT CHARSET=utf8mb4;\n"},
+		{name: "Uppercase", dialect: "MySQL", textType: "VARCHAR(255)", suffix: ") DEFAULT CHARSET=utf8mb4;\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			statement, err := CreateTable(tt.dialect)
+			require.NoError(t, err)
+			assert.True(t, strings.HasPrefix(statement, "CREATE TABLE countries (\n  alpha2 CHAR(2) PRIMARY KEY,\n"))
+			assert.Contains(t, statement, "  numeric_code SMALLINT NOT NULL UNIQUE,\n")
+			assert.Contains(t, statement, "  name "+tt.textType+" NOT NULL,\n")
+			assert.Contains(t, statement, "  capital "+tt.textType+",\n")
+			assert.True(t, strings.HasSuffix(statement, "  population BIGINT NOT NULL\n"+tt.suffix))
+			assert.Equal(t, len(columns)+2, strings.Count(statement, "\n"))
+		})
+	}
+}
+
+// TestInsert tests the INSERT statement in every dialect
+func TestInsert(t *testing.T) {
+	for _, dialect := range []Dialect{Postgres, SQLite, MySQL} {
+		t.Run(string(dialect), func(t *testing.T) {
+			statement, err := Insert(dialect)
+			require.NoError(t, err)
+
+			lines := strings.Split(strings.TrimSuffix(statement, "\n"), "\n")
+			require.Len(t, lines, len(countries.GetAll())+1)
+			assert.Equal(t, "INSERT INTO countries (alpha2, alpha3, numeric_code, name, common_name, official_name, "+
+				"capital, region, sub_region, currency_code, calling_code, flag, population) VALUES", lines[0])
+			assert.Equal(t, "  ('AF', 'AFG', 4, 'Afghanistan', 'Afghanistan', 'Islamic Republic of Afghanistan', "+
+				"'Kabul', 'Asia', 'Southern Asia', 'AFN', '+93', '🇦🇫', 29121286),", lines[1])
+			assert.Contains(t, lines, "  ('AQ', 'ATA', 10, 'Antarctica', 'Antarctica', 'Antarctica', "+
+				"NULL, NULL, NULL, NULL, '+672', '🇦🇶', 0),")
+			assert.Contains(t, statement, "'Côte d''Ivoire', 'Ivory Coast', 'Republic of Côte d''Ivoire'")
+			assert.True(t, strings.HasSuffix(statement, ");\n"))
+		})
+	}
+}
+
+// TestWriteValue tests the SQL literals of column values
+func TestWriteValue(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    value
+		dialect  Dialect
+		expected string
+	}{
+		{name: "Text", value: text("Chad"), dialect: Postgres, expected: "'Chad'"},
+		{name: "Quote", value: text("N'Djamena"), dialect: Postgres, expected: "'N''Djamena'"},
+		{name: "Backslash", value: text(`a\b`), dialect: Postgres, expected: `'a\b'`},
+		{name: "MySQL backslash", value: text(`a\'b`), dialect: MySQL, expected: `'a\\''b'`},
+		{name: "Empty text", value: text(""), dialect: SQLite, expected: "''"},
+		{name: "Nullable text", value: nullableText(""), dialect: SQLite, expected: "NULL"},
+		{name: "Integer", value: integer(840), dialect: SQLite, expected: "840"},
+		{name: "Null", value: value{}, dialect: MySQL, expected: "NULL"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var sb strings.Builder
+			writeValue(&sb, tt.value, tt.dialect)
+			assert.Equal(t, tt.expected, sb.String())
+		})
+	}
+}
+
+// TestSeed tests that Seed joins CreateTable and Insert
+func TestSeed(t *testing.T) {
+	create, err := CreateTable(Postgres)
+	require.NoError(t, err)
+	insert, err := Insert(Postgres)
+	require.NoError(t, err)
+
+	seed, err := Seed("postgresql")
+	require.NoError(t, err)
+	assert.Equal(t, create+"\n"+insert, seed)
+}
+
+// TestUnknownDialect tests that every function rejects unknown dialects
+func TestUnknownDialect(t *testing.T) {
+	for _, dialect := range []Dialect{"", "oracle", "sqlserver"} {
+		_, err := CreateTable(dialect)
+		require.ErrorIs(t, err, ErrUnknownDialect)
+		_, err = Insert(dialect)
+		require.ErrorIs(t, err, ErrUnknownDialect)
+		_, err = Seed(dialect)
+		require.ErrorIs(t, err, ErrUnknownDialect)
+	}
+}
+
+// ExampleCreateTable is an example of CreateTable()
+func ExampleCreateTable() {
+	statement, _ := CreateTable(SQLite)
+	fmt.Print(statement)
+	// Output:CREATE TABLE countries (
+	//   alpha2 CHAR(2) PRIMARY KEY,
+	//   alpha3 CHAR(3) NOT NULL UNIQUE,
+	//   numeric_code SMALLINT NOT NULL UNIQUE,
+	//   name TEXT NOT NULL,
+	//   common_name TEXT NOT NULL,
+	//   official_name TEXT NOT NULL,
+	//   capital TEXT,
+	//   region TEXT,
+	//   sub_region TEXT,
+	//   currency_code CHAR(3),
+	//   calling_code VARCHAR(8),
+	//   flag VARCHAR(16) NOT NULL,
+	//   population BIGINT NOT NULL
+	// );
+}
+
+// BenchmarkInsert benchmarks the method Insert()
+func BenchmarkInsert(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_, _ = Insert(Postgres)
+	}
+}
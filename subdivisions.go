@@ -0,0 +1,126 @@
+package countries
+
+import (
+	"strings"
+)
+
+// SubdivisionList is a list of subdivision structs
+type SubdivisionList []*Subdivision
+
+// Subdivision is a single principal subdivision of a country (ISO 3166-2)
+type Subdivision struct {
+	Category      string `json:"category"`        // Category of the subdivision (e.g., state, province, emirate)
+	Code          string `json:"code"`            // ISO 3166-2 subdivision code (e.g., US-CA)
+	CountryAlpha2 string `json:"country_alpha-2"` // ISO 3166-1 alpha-2 code of the country
+	Name          string `json:"name"`            // Name of the subdivision
+	ParentCode    string `json:"parent_code"`     // ISO 3166-2 code of the parent subdivision (if applicable)
+}
+
+// GetSubdivision retrieves a Subdivision by its ISO 3166-2 code in a case-insensitive search.
+//
+// This function performs the following steps:
+// - Normalizes the provided code to uppercase
+// - Performs a constant-time map lookup using the normalized code
+//
+// - Returns the Subdivision pointer on success
+// - Returns nil if no match is located
+//
+// Parameters:
+// - code: ISO 3166-2 subdivision code used for the lookup (e.g., "US-CA")
+//
+// Returns:
+// - Pointer to the Subdivision struct, or nil when no match is found
+//
+// Side Effects:
+// - None
+//
+// Notes:
+// - Lookup uses a map for constant-time retrieval
+// - Returned pointer references package-level data without copying
+func GetSubdivision(code string) *Subdivision {
+	return bySubdivisionCode[strings.ToUpper(code)]
+}
+
+// Subdivisions provides a copy of every Subdivision that belongs to the Country.
+//
+// This function performs the following steps:
+// - Looks up the subdivisions using the country alpha-2 code
+// - Copies the matching Subdivision pointers into a new slice
+//
+// Parameters:
+// - None
+//
+// Returns:
+// - SubdivisionList sorted by subdivision code, or nil when the country has no subdivisions
+//
+// Side Effects:
+// - None
+//
+// Notes:
+// - The Subdivision pointers reference global data but the returned slice is a copy
+// - Nested subdivisions (e.g., GB-ABD under GB-SCT) are included in the same flat list
+func (c *Country) Subdivisions() SubdivisionList {
+	return append(SubdivisionList(nil), subdivisionsByCountry[c.Alpha2]...)
+}
+
+// SubdivisionByName retrieves one of the Country's subdivisions by its name in a case-insensitive search.
+//
+// This function performs the following steps:
+// - Scopes the lowercased name by the country alpha-2 code
+// - Performs a constant-time map lookup using the scoped key
+//
+// - Returns the Subdivision pointer when found
+// - Returns nil if no matching subdivision exists
+//
+// Parameters:
+// - name: subdivision name used for the lookup (e.g., "California")
+//
+// Returns:
+// - Pointer to the Subdivision struct, or nil when no match is found
+//
+// Side Effects:
+// - None
+//
+// Notes:
+// - Names are matched against the ISO 3166-2 spelling, including diacritics (e.g., "Baden-Württemberg")
+// - When two subdivisions of the same country share a name, the one with the lowest code is returned
+func (c *Country) SubdivisionByName(name string) *Subdivision {
+	return bySubdivisionName[c.Alpha2+":"+strings.ToLower(name)]
+}
+
+// Country returns the Country the Subdivision belongs to.
+//
+// Parameters:
+// - None
+//
+// Returns:
+// - Pointer to the Country struct, or nil when the country is unknown
+//
+// Side Effects:
+// - None
+//
+// Notes:
+// - Lookup uses the alpha-2 map for constant-time retrieval
+func (s *Subdivision) Country() *Country {
+	return byAlpha2[s.CountryAlpha2]
+}
+
+// Parent returns the parent Subdivision, if any.
+//
+// Parameters:
+// - None
+//
+// Returns:
+// - Pointer to the parent Subdivision struct, or nil for top-level subdivisions
+//
+// Side Effects:
+// - None
+//
+// Notes:
+// - For example, GB-ABD (Aberdeenshire) returns GB-SCT (Scotland)
+func (s *Subdivision) Parent() *Subdivision {
+	if s.ParentCode == "" {
+		return nil
+	}
+	return bySubdivisionCode[s.ParentCode]
+}
@@ -0,0 +1,152 @@
+package countries
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testSubdivisionCode = "US-CA"
+	testSubdivisionName = "California"
+)
+
+// TestSubdivisions_Loaded tests that the subdivision data is preloaded and consistent
+func TestSubdivisions_Loaded(t *testing.T) {
+	require.NotEmpty(t, subdivisions)
+	assert.Len(t, bySubdivisionCode, len(subdivisions))
+
+	for _, s := range subdivisions {
+		require.NotNil(t, s.Country(), "subdivision %s has no country", s.Code)
+		assert.True(t, strings.HasPrefix(s.Code, s.CountryAlpha2+"-"))
+		assert.NotEmpty(t, s.Name)
+		assert.NotEmpty(t, s.Category)
+		if s.ParentCode != "" {
+			require.NotNil(t, s.Parent(), "subdivision %s has unknown parent %s", s.Code, s.ParentCode)
+			assert.Equal(t, s.CountryAlpha2, s.Parent().CountryAlpha2)
+		}
+	}
+}
+
+// TestGetSubdivision_VariousFormats tests GetSubdivision with different input cases
+func TestGetSubdivision_VariousFormats(t *testing.T) {
+	tests := []struct {
+		name             string
+		input            string
+		expectedName     string
+		expectedCategory string
+		expectNil        bool
+	}{
+		{name: "US state", input: testSubdivisionCode, expectedName: testSubdivisionName, expectedCategory: "state"},
+		{name: "Lowercase", input: "ca-on", expectedName: "Ontario", expectedCategory: "province"},
+		{name: "Emirate", input: "AE-DU", expectedName: "Dubayy", expectedCategory: "emirate"},
+		{name: "Nested", input: "GB-ABD", expectedName: "Aberdeenshire", expectedCategory: "council area"},
+		{name: "Unknown code", input: "US-ZZ", expectNil: true},
+		{name: "Country code only", input: "US", expectNil: true},
+		{name: "Empty", input: "", expectNil: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := GetSubdivision(tt.input)
+			if tt.expectNil {
+				require.Nil(t, s)
+				return
+			}
+
+			require.NotNil(t, s)
+			assert.Equal(t, tt.expectedName, s.Name)
+			assert.Equal(t, tt.expectedCategory, s.Category)
+		})
+	}
+}
+
+// ExampleGetSubdivision is an example of GetSubdivision()
+func ExampleGetSubdivision() {
+	s := GetSubdivision(testSubdivisionCode)
+	fmt.Printf("subdivision: %s category: %s country: %s", s.Name, s.Category, s.Country().Alpha2)
+	// Output:subdivision: California category: state country: US
+}
+
+// BenchmarkGetSubdivision benchmarks the method GetSubdivision()
+func BenchmarkGetSubdivision(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_ = GetSubdivision(testSubdivisionCode)
+	}
+}
+
+// TestCountry_Subdivisions tests the Subdivisions method
+func TestCountry_Subdivisions(t *testing.T) {
+	t.Run("united states", func(t *testing.T) {
+		list := GetByAlpha2(testCountryAlpha2).Subdivisions()
+		require.Len(t, list, 57) // 50 states, 1 district and 6 outlying areas
+		for i := 1; i < len(list); i++ {
+			assert.Less(t, list[i-1].Code, list[i].Code)
+		}
+	})
+
+	t.Run("no subdivisions", func(t *testing.T) {
+		assert.Empty(t, GetByAlpha2Code(Alpha2AQ).Subdivisions())
+	})
+
+	t.Run("returns a copy", func(t *testing.T) {
+		list := GetByAlpha2(testCountryAlpha2).Subdivisions()
+		list[0] = &Subdivision{Code: "XX-XX"}
+		assert.NotEqual(t, "XX-XX", GetByAlpha2(testCountryAlpha2).Subdivisions()[0].Code)
+	})
+}
+
+// TestCountry_SubdivisionByName tests the SubdivisionByName method
+func TestCountry_SubdivisionByName(t *testing.T) {
+	tests := []struct {
+		name         string
+		alpha2       string
+		input        string
+		expectedCode string
+		expectNil    bool
+	}{
+		{name: "Exact", alpha2: testCountryAlpha2, input: testSubdivisionName, expectedCode: testSubdivisionCode},
+		{name: "Lowercase", alpha2: testCountryAlpha2, input: "new york", expectedCode: "US-NY"},
+		{name: "Diacritics", alpha2: "DE", input: "Baden-Württemberg", expectedCode: "DE-BW"},
+		{name: "Name with a source note", alpha2: "GB", input: "Isle of Anglesey", expectedCode: "GB-AGY"},
+		{name: "Former code note", alpha2: "SE", input: "Skåne län", expectedCode: "SE-M"},
+		{name: "Other country", alpha2: "MX", input: testSubdivisionName, expectNil: true},
+		{name: "Unknown", alpha2: testCountryAlpha2, input: "Atlantis", expectNil: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := GetByAlpha2(tt.alpha2).SubdivisionByName(tt.input)
+			if tt.expectNil {
+				require.Nil(t, s)
+				return
+			}
+
+			require.NotNil(t, s)
+			assert.Equal(t, tt.expectedCode, s.Code)
+		})
+	}
+}
+
+// TestSubdivision_Parent tests the Parent method
+func TestSubdivision_Parent(t *testing.T) {
+	parent := GetSubdivision("GB-ABD").Parent()
+	require.NotNil(t, parent)
+	assert.Equal(t, "GB-SCT", parent.Code)
+	assert.Nil(t, parent.Parent())
+
+	// Parent codes without a country prefix in the source data are expanded
+	parent = GetSubdivision("AZ-ORD").Parent()
+	require.NotNil(t, parent)
+	assert.Equal(t, "AZ-NX", parent.Code)
+}
+
+// ExampleCountry_SubdivisionByName is an example of Country.SubdivisionByName()
+func ExampleCountry_SubdivisionByName() {
+	s := GetByAlpha2Code(Alpha2CA).SubdivisionByName("british columbia")
+	fmt.Printf("subdivision: %s code: %s", s.Name, s.Code)
+	// Output:subdivision: British Columbia code: CA-BC
+}
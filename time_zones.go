@@ -0,0 +1,63 @@
+package countries
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// GetByTimeZone retrieves the Country of an IANA time zone in a case-insensitive search.
+//
+// This function performs the following steps:
+// - Trims surrounding spaces and normalizes the time zone name to lowercase
+// - Performs a constant-time map lookup using the normalized name
+//
+// - Returns the Country pointer on success
+// - Returns nil if the time zone does not belong to a country
+//
+// Parameters:
+// - name: IANA time zone name (e.g., "Europe/Berlin") or backward-compatible alias (e.g., "US/Eastern")
+//
+// Returns:
+// - Pointer to the Country struct, or nil when no match is found
+//
+// Side Effects:
+// - None
+//
+// Notes:
+// - Time zones follow zone.tab, so every time zone belongs to exactly one country
+// (e.g., "Europe/Busingen" is Germany even though it uses the rules of "Europe/Zurich")
+// - Zones without a country ("UTC", "Etc/GMT+5") and deprecated abbreviations ("EST") return nil
+// - Returned pointer references package-level data without copying
+func GetByTimeZone(name string) *Country {
+	return byTimeZone[strings.ToLower(strings.TrimSpace(name))]
+}
+
+// Locations loads the time.Location of every time zone of the Country.
+//
+// This function performs the following steps:
+// - Loads every name of the TimeZones field with time.LoadLocation, in the same order
+//
+// Parameters:
+// - None
+//
+// Returns:
+// - Slice of *time.Location, most populous time zone first (empty when the country has no time zone)
+// - Error when a time zone is missing from the time zone database of the system
+//
+// Side Effects:
+// - Reads the time zone database (see time.LoadLocation)
+//
+// Notes:
+// - Import "time/tzdata" in the main package when the system may not provide a time zone database
+func (c *Country) Locations() ([]*time.Location, error) {
+	locations := make([]*time.Location, 0, len(c.TimeZones))
+	for _, name := range c.TimeZones {
+		location, err := time.LoadLocation(name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load time zone %s of %s: %w", name, c.Alpha2, err)
+		}
+		locations = append(locations, location)
+	}
+	return locations, nil
+}
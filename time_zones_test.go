@@ -0,0 +1,141 @@
+package countries
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+	_ "time/tzdata" // embed the time zone database so the tests do not depend on the system
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testTimeZone = "Europe/Berlin"
+
+// TestTimeZones_Loaded tests that the time zone data is preloaded and matches time.LoadLocation
+func TestTimeZones_Loaded(t *testing.T) {
+	require.NotEmpty(t, byTimeZone)
+
+	for _, c := range countries {
+		for _, name := range c.TimeZones {
+			_, err := time.LoadLocation(name)
+			require.NoError(t, err, "country %s has time zone %s", c.Alpha2, name)
+			assert.Equal(t, c, GetByTimeZone(name))
+		}
+	}
+
+	// Every key, including the aliases, is a time zone known to time.LoadLocation
+	for name := range byTimeZone {
+		assert.Equal(t, strings.ToLower(name), name)
+	}
+	for _, alias := range []string{"US/Eastern", "Asia/Calcutta", "Europe/Kiev", "America/Buenos_Aires"} {
+		_, err := time.LoadLocation(alias)
+		require.NoError(t, err)
+	}
+}
+
+// TestGetByTimeZone_VariousFormats tests GetByTimeZone with different input formats
+func TestGetByTimeZone_VariousFormats(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		expected  string
+		expectNil bool
+	}{
+		{name: "Canonical", input: testTimeZone, expected: "DE"},
+		{name: "Second zone of a country", input: "Europe/Busingen", expected: "DE"},
+		{name: "Lowercase", input: "america/new_york", expected: testCountryAlpha2},
+		{name: "Surrounding spaces", input: " Asia/Tokyo ", expected: "JP"},
+		{name: "Three levels", input: "America/Argentina/Buenos_Aires", expected: "AR"},
+		{name: "Same rules as another country", input: "Europe/Vaduz", expected: "LI"},
+		{name: "Alias", input: "US/Eastern", expected: testCountryAlpha2},
+		{name: "Renamed zone", input: "Asia/Calcutta", expected: "IN"},
+		{name: "Renamed city", input: "Europe/Kiev", expected: "UA"},
+		{name: "Alias of a territory", input: "US/Samoa", expected: "AS"},
+		{name: "Alias linked to another country", input: "Atlantic/Jan_Mayen", expected: "SJ"},
+		{name: "Antarctica", input: "Antarctica/McMurdo", expected: "AQ"},
+		{name: "UTC", input: "UTC", expectNil: true},
+		{name: "Etc", input: "Etc/GMT+5", expectNil: true},
+		{name: "Abbreviation", input: "EST", expectNil: true},
+		{name: "Unknown", input: "Mars/Olympus_Mons", expectNil: true},
+		{name: "Empty", input: "", expectNil: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := GetByTimeZone(tt.input)
+			if tt.expectNil {
+				require.Nil(t, c)
+				return
+			}
+
+			require.NotNil(t, c)
+			assert.Equal(t, tt.expected, c.Alpha2)
+		})
+	}
+}
+
+// ExampleGetByTimeZone is an example of GetByTimeZone()
+func ExampleGetByTimeZone() {
+	c := GetByTimeZone(testTimeZone)
+	fmt.Printf("country: %s time zones: %v", c.Name, c.TimeZones)
+	// Output:country: Germany time zones: [Europe/Berlin Europe/Busingen]
+}
+
+// BenchmarkGetByTimeZone benchmarks the method GetByTimeZone()
+func BenchmarkGetByTimeZone(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_ = GetByTimeZone(testTimeZone)
+	}
+}
+
+// TestCountry_TimeZones tests the TimeZones field
+func TestCountry_TimeZones(t *testing.T) {
+	us := GetByAlpha2(testCountryAlpha2)
+	require.NotEmpty(t, us.TimeZones)
+	assert.Equal(t, "America/New_York", us.TimeZones[0])
+	assert.Contains(t, us.TimeZones, "Pacific/Honolulu")
+
+	assert.Equal(t, []string{"Asia/Tokyo"}, GetByAlpha2("JP").TimeZones)
+	assert.Nil(t, GetByAlpha2("BV").TimeZones)
+}
+
+// TestCountry_Locations tests the Locations method
+func TestCountry_Locations(t *testing.T) {
+	t.Run("every zone", func(t *testing.T) {
+		c := GetByAlpha2("AU")
+		locations, err := c.Locations()
+		require.NoError(t, err)
+		require.Len(t, locations, len(c.TimeZones))
+		for i, location := range locations {
+			assert.Equal(t, c.TimeZones[i], location.String())
+		}
+	})
+
+	t.Run("no zone", func(t *testing.T) {
+		locations, err := GetByAlpha2("HM").Locations()
+		require.NoError(t, err)
+		assert.Empty(t, locations)
+	})
+
+	t.Run("unknown zone", func(t *testing.T) {
+		c := &Country{Alpha2: "ZZ", TimeZones: []string{"Mars/Olympus_Mons"}}
+		locations, err := c.Locations()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Mars/Olympus_Mons")
+		assert.Nil(t, locations)
+	})
+}
+
+// ExampleCountry_Locations is an example of Country.Locations()
+func ExampleCountry_Locations() {
+	locations, err := GetByAlpha2("JP").Locations()
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	noon := time.Date(2024, time.January, 1, 12, 0, 0, 0, time.UTC)
+	fmt.Println(noon.In(locations[0]).Format(time.RFC3339))
+	// Output:2024-01-01T21:00:00+09:00
+}
@@ -0,0 +1,36 @@
+package countries
+
+import (
+	"strings"
+)
+
+// GetByTLD retrieves a Country by its country-code top-level domain (ccTLD) in a case-insensitive search.
+//
+// This function performs the following steps:
+// - Trims surrounding spaces and adds the leading dot when it is missing
+// - Normalizes the top-level domain to lowercase (including non-ASCII letters)
+// - Performs a constant-time map lookup using the normalized top-level domain
+//
+// - Returns the Country pointer on success
+// - Returns nil if the top-level domain is not a known ccTLD
+//
+// Parameters:
+// - tld: top-level domain with or without the leading dot (e.g., ".io", "UK", ".рф", ".xn--p1ai")
+//
+// Returns:
+// - Pointer to the Country struct, or nil when no match is found
+//
+// Side Effects:
+// - None
+//
+// Notes:
+// - Internationalized ccTLDs match in their Unicode form (".рф") and their punycode form (".xn--p1ai")
+// - Only the top-level label is matched; pass "uk" rather than "example.co.uk"
+// - Generic top-level domains (e.g., ".com") and ".eu" do not belong to a country and return nil
+func GetByTLD(tld string) *Country {
+	tld = strings.ToLower(strings.TrimSpace(tld))
+	if !strings.HasPrefix(tld, ".") {
+		tld = "." + tld
+	}
+	return byTLD[tld]
+}
@@ -0,0 +1,99 @@
+package countries
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testTLD = ".io"
+
+// TestTLDs_Loaded tests that the top-level domain data is preloaded and consistent
+func TestTLDs_Loaded(t *testing.T) {
+	require.NotEmpty(t, byTLD)
+
+	for _, c := range countries {
+		for _, tld := range c.TLDs {
+			assert.True(t, strings.HasPrefix(tld, "."), "country %s has top-level domain %s", c.Alpha2, tld)
+			assert.Equal(t, strings.ToLower(tld), tld)
+			assert.Equal(t, c, GetByTLD(tld))
+		}
+	}
+
+	// Every key is either a listed top-level domain or the punycode form of one
+	for tld, c := range byTLD {
+		if strings.HasPrefix(tld, ".xn--") {
+			continue
+		}
+		assert.Contains(t, c.TLDs, tld)
+	}
+}
+
+// TestGetByTLD_VariousFormats tests GetByTLD with different input formats
+func TestGetByTLD_VariousFormats(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		expected  string
+		expectNil bool
+	}{
+		{name: "Lowercase", input: testTLD, expected: "IO"},
+		{name: "Uppercase", input: ".DE", expected: "DE"},
+		{name: "Without dot", input: "fr", expected: "FR"},
+		{name: "Surrounding spaces", input: " .jp ", expected: "JP"},
+		{name: "United Kingdom", input: ".uk", expected: "GB"},
+		{name: "IDN Unicode", input: ".рф", expected: "RU"},
+		{name: "IDN uppercase", input: ".РФ", expected: "RU"},
+		{name: "IDN punycode", input: ".xn--p1ai", expected: "RU"},
+		{name: "IDN punycode uppercase", input: "XN--FIQS8S", expected: "CN"},
+		{name: "IDN Arabic", input: ".السعودية", expected: "SA"},
+		{name: "IDN Traditional Chinese", input: ".台灣", expected: "TW"},
+		{name: "Second-level domain", input: ".co.uk", expectNil: true},
+		{name: "Generic", input: ".com", expectNil: true},
+		{name: "European Union", input: ".eu", expectNil: true},
+		{name: "Not in use", input: ".gb", expectNil: true},
+		{name: "Empty", input: "", expectNil: true},
+		{name: "Dot only", input: ".", expectNil: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := GetByTLD(tt.input)
+			if tt.expectNil {
+				require.Nil(t, c)
+				return
+			}
+
+			require.NotNil(t, c)
+			assert.Equal(t, tt.expected, c.Alpha2)
+		})
+	}
+}
+
+// TestCountry_TLDs tests the TLDs field
+func TestCountry_TLDs(t *testing.T) {
+	assert.Equal(t, []string{".uk"}, GetByAlpha2("GB").TLDs)
+	assert.Equal(t, []string{".ru", ".рф"}, GetByAlpha2("RU").TLDs)
+	assert.Equal(t, []string{".cn", ".中国", ".中國"}, GetByAlpha2("CN").TLDs)
+	assert.Nil(t, GetByAlpha2("BL").TLDs)
+}
+
+// ExampleGetByTLD is an example of GetByTLD()
+func ExampleGetByTLD() {
+	for _, tld := range []string{testTLD, ".uk", ".xn--p1ai"} {
+		fmt.Printf("%s: %s\n", tld, GetByTLD(tld).Alpha2)
+	}
+	// Output:.io: IO
+	// .uk: GB
+	// .xn--p1ai: RU
+}
+
+// BenchmarkGetByTLD benchmarks the method GetByTLD()
+func BenchmarkGetByTLD(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_ = GetByTLD(testTLD)
+	}
+}